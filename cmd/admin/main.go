@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		showUsage()
+		os.Exit(1)
+	}
+
+	command, args := os.Args[1], os.Args[2:]
+	if command == "help" || command == "-h" || command == "--help" {
+		showUsage()
+		return
+	}
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Connect to PostgreSQL only; Redis is not needed for user management
+	db, err := database.NewPostgresDBWithoutMigrations(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+
+	authService := auth.NewService(cfg.JWT.Secret, 24*time.Hour, repository.NewRefreshTokenRepository(db))
+	admin := auth.NewUserAdmin(authService, db)
+	ctx := context.Background()
+
+	if err := run(ctx, admin, command, args); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run executes a single admin command
+func run(ctx context.Context, admin *auth.UserAdmin, command string, args []string) error {
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	user := flags.String("user", "", "User ID, username or email")
+
+	switch command {
+	case "create":
+		username := flags.String("username", "", "Username (required)")
+		email := flags.String("email", "", "Email address (required)")
+		role := flags.String("role", string(models.RoleUser), "Role: Administrator, User or Commenter")
+		password := flags.String("password", "", "Password (read from stdin if omitted)")
+		flags.Parse(args)
+
+		pw, err := passwordOrPrompt(*password)
+		if err != nil {
+			return err
+		}
+		created, err := admin.CreateUser(ctx, auth.CreateUserRequest{
+			Username: *username,
+			Email:    *email,
+			Password: pw,
+			Role:     models.UserRole(*role),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Created user %s (%s) with role %s\n", created.Username, created.ID, created.Role)
+
+	case "list":
+		flags.Parse(args)
+		users, err := admin.ListUsers(ctx)
+		if err != nil {
+			return err
+		}
+		printUsers(users)
+
+	case "reset-password":
+		password := flags.String("password", "", "New password (read from stdin if omitted)")
+		flags.Parse(args)
+
+		pw, err := passwordOrPrompt(*password)
+		if err != nil {
+			return err
+		}
+		updated, err := admin.ResetPassword(ctx, requireUser(*user), pw)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Password reset for %s; existing sessions expired\n", updated.Username)
+
+	case "promote":
+		flags.Parse(args)
+		updated, err := admin.SetRole(ctx, requireUser(*user), models.RoleAdministrator)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s is now an %s\n", updated.Username, models.RoleAdministrator)
+
+	case "deactivate":
+		flags.Parse(args)
+		updated, err := admin.Deactivate(ctx, requireUser(*user))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Deactivated %s; existing sessions expired\n", updated.Username)
+
+	case "activate":
+		flags.Parse(args)
+		updated, err := admin.Reactivate(ctx, requireUser(*user))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Reactivated %s\n", updated.Username)
+
+	case "expire-sessions":
+		flags.Parse(args)
+		updated, err := admin.ExpireSessions(ctx, requireUser(*user))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Expired sessions of %s\n", updated.Username)
+
+	default:
+		showUsage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+
+	return nil
+}
+
+// requireUser exits with usage help if the -user flag is missing
+func requireUser(user string) string {
+	if user == "" {
+		fmt.Fprintln(os.Stderr, "Error: -user is required")
+		os.Exit(1)
+	}
+	return user
+}
+
+// passwordOrPrompt returns the given password or reads one line from stdin
+func passwordOrPrompt(password string) (string, error) {
+	if password != "" {
+		return password, nil
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// printUsers prints users as a table
+func printUsers(users []models.User) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tUSERNAME\tEMAIL\tROLE\tSTATUS")
+	for _, user := range users {
+		status := "active"
+		if !user.IsActive() {
+			status = "deactivated " + user.DeactivatedAt.Format("2006-01-02")
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", user.ID, user.Username, user.Email, user.Role, status)
+	}
+	writer.Flush()
+}
+
+// showUsage prints command help
+func showUsage() {
+	fmt.Println("Usage:")
+	fmt.Println("  go run cmd/admin/main.go create -username NAME -email EMAIL [-role ROLE] [-password PASSWORD]")
+	fmt.Println("  go run cmd/admin/main.go list")
+	fmt.Println("  go run cmd/admin/main.go reset-password -user USER [-password PASSWORD]")
+	fmt.Println("  go run cmd/admin/main.go promote -user USER")
+	fmt.Println("  go run cmd/admin/main.go deactivate -user USER")
+	fmt.Println("  go run cmd/admin/main.go activate -user USER")
+	fmt.Println("  go run cmd/admin/main.go expire-sessions -user USER")
+	fmt.Println()
+	fmt.Println("USER is a user ID, username or email. Passwords are read from stdin when -password is omitted.")
+	fmt.Println("Expiring sessions revokes refresh tokens; access tokens already issued stay valid until they expire (24 hours).")
+	fmt.Println("Database settings are read from the same environment variables as the server (DB_HOST, DB_USER, ...).")
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"product-requirements-management/internal/docs"
+	"strings"
 )
 
 func main() {
@@ -14,6 +15,7 @@ func main() {
 		outputFile = flag.String("output", "docs-metrics.json", "Output file for metrics")
 		format     = flag.String("format", "json", "Output format: json, text, or summary")
 		verbose    = flag.Bool("verbose", false, "Verbose output")
+		accessLogs = flag.String("access-log", "", "Comma-separated server log files to count the requests to deprecated endpoints in")
 	)
 	flag.Parse()
 
@@ -25,6 +27,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *accessLogs != "" {
+		if err := docs.AnalyzeDeprecatedTraffic(metrics, strings.Split(*accessLogs, ",")); err != nil {
+			fmt.Fprintf(os.Stderr, "Error analyzing access logs: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// Output based on format
 	switch *format {
 	case "json":
@@ -101,6 +110,30 @@ func outputText(metrics *docs.DocumentationMetrics, verbose bool) {
 	fmt.Printf("  Query Parameter Examples: %d\n", metrics.ExampleCoverage.QueryParameterExamples)
 	fmt.Println()
 
+	// Deprecated Endpoints
+	deprecations := metrics.Deprecations
+	fmt.Println("⏳ Deprecated Endpoints:")
+	fmt.Printf("  Deprecated: %d\n", deprecations.DeprecatedEndpoints)
+	if deprecations.RequestsAnalyzed > 0 {
+		fmt.Printf("  Still Receiving Traffic: %d (of %d requests analyzed)\n",
+			deprecations.EndpointsWithTraffic, deprecations.RequestsAnalyzed)
+	}
+	for _, endpoint := range deprecations.Endpoints {
+		fmt.Printf("  %s %s (since %s", endpoint.Method, endpoint.Path, endpoint.Since)
+		if endpoint.Sunset != "" {
+			fmt.Printf(", sunset %s", endpoint.Sunset)
+		}
+		fmt.Print(")")
+		if deprecations.RequestsAnalyzed > 0 {
+			fmt.Printf(": %d requests", endpoint.Requests)
+			if endpoint.LastSeen != nil {
+				fmt.Printf(", last %s", endpoint.LastSeen.Format("2006-01-02 15:04:05"))
+			}
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+
 	// Tag Distribution
 	if verbose && len(metrics.TagDistribution) > 0 {
 		fmt.Println("🏷️  Tag Distribution:")
@@ -159,6 +192,13 @@ func outputSummary(metrics *docs.DocumentationMetrics) {
 		metrics.EndpointCoverage.CoveragePercentage,
 		metrics.EndpointCoverage.DocumentedEndpoints,
 		metrics.EndpointCoverage.TotalEndpoints)
+	if metrics.Deprecations.RequestsAnalyzed > 0 {
+		fmt.Printf("Deprecated Endpoints: %d (%d still receiving traffic)\n",
+			metrics.Deprecations.DeprecatedEndpoints,
+			metrics.Deprecations.EndpointsWithTraffic)
+	} else {
+		fmt.Printf("Deprecated Endpoints: %d\n", metrics.Deprecations.DeprecatedEndpoints)
+	}
 
 	if len(metrics.Recommendations) > 0 {
 		fmt.Printf("Top Recommendation: %s\n", metrics.Recommendations[0])
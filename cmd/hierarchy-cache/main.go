@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+func main() {
+	ctx := context.Background()
+	check := flag.Bool("check", false, "Report whether the hierarchy cache is consistent without rebuilding it")
+	flag.Usage = showUsage
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Connect to PostgreSQL only; the hierarchy cache does not use Redis
+	db, err := database.NewPostgresDBWithoutMigrations(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+
+	hierarchyCacheService := service.NewHierarchyCacheService(repository.NewRepositories(db, nil))
+
+	if *check {
+		report, err := hierarchyCacheService.Check(ctx)
+		if err != nil {
+			log.Fatalf("Failed to check hierarchy cache: %v", err)
+		}
+		printReport(report)
+		if !report.Consistent {
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Println("Rebuilding hierarchy cache...")
+	rebuild, err := hierarchyCacheService.Rebuild(ctx)
+	if err != nil {
+		log.Fatalf("Failed to rebuild hierarchy cache: %v", err)
+	}
+	fmt.Printf("Rebuilt %d hierarchy cache entries in %dms\n", rebuild.Entries, rebuild.DurationMs)
+}
+
+// printReport prints the hierarchy cache consistency report
+func printReport(report *service.HierarchyCacheReport) {
+	status := "consistent"
+	if !report.Consistent {
+		status = "inconsistent"
+	}
+	fmt.Printf("Status: %s\n", status)
+	fmt.Printf("Entries: %d, missing: %d, stale: %d, orphaned: %d\n", report.Entries, report.Missing, report.Stale, report.Orphaned)
+	if len(report.Samples) == 0 {
+		return
+	}
+	fmt.Println()
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "PROBLEM\tTYPE\tREFERENCE\tID")
+	for _, sample := range report.Samples {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", sample.Problem, sample.EntityType, sample.ReferenceID, sample.EntityID)
+	}
+	writer.Flush()
+}
+
+// showUsage prints command help
+func showUsage() {
+	fmt.Println("Usage:")
+	fmt.Println("  go run cmd/hierarchy-cache/main.go         # Rebuild the hierarchy cache")
+	fmt.Println("  go run cmd/hierarchy-cache/main.go -check  # Report whether the hierarchy cache is consistent")
+	fmt.Println()
+	fmt.Println("The hierarchy cache holds one row per epic, user story, acceptance criteria and requirement naming its")
+	fmt.Println("ancestors; breadcrumb, outline and progress endpoints read from it. Database triggers keep it up to date.")
+	fmt.Println("Rebuild it when -check reports it inconsistent, for example after writes that bypassed the triggers.")
+	fmt.Println("-check exits with status 1 when the cache is inconsistent.")
+	fmt.Println("Database settings are read from the same environment variables as the server (DB_HOST, DB_USER, ...).")
+}
@@ -14,9 +14,12 @@ import (
 
 // InitFlags holds command-line flags for initialization
 type InitFlags struct {
-	DryRun  bool
-	Verbose bool
-	Help    bool
+	DryRun   bool
+	Verbose  bool
+	Help     bool
+	SeedPath string
+	// OnlineMigrations runs migrations without blocking writes: concurrent index builds and batched backfills
+	OnlineMigrations bool
 }
 
 func main() {
@@ -70,8 +73,30 @@ func main() {
 		"action":    "validation_completed",
 	}).Info("Environment validation completed successfully")
 
+	// Load and validate the seed bundle before touching the database
+	var seedBundle *initService.SeedBundle
+	if flags.SeedPath != "" {
+		seedBundle, err = initService.LoadSeedBundle(flags.SeedPath)
+		if err != nil {
+			logger.WithContextAndFields(ctx, map[string]interface{}{
+				"component": "init_main",
+				"action":    "seed_bundle_invalid",
+				"seed_path": flags.SeedPath,
+				"error":     err.Error(),
+			}).Error("Seed bundle validation failed")
+			fmt.Fprintf(os.Stderr, "Seed bundle error: %v\n", err)
+			os.Exit(initService.ExitConfigError)
+		}
+
+		logger.WithContextAndFields(ctx, map[string]interface{}{
+			"component": "init_main",
+			"action":    "seed_bundle_loaded",
+			"seed_path": flags.SeedPath,
+		}).Info("Seed bundle loaded and validated")
+	}
+
 	// Run initialization process
-	if err := runInitialization(cfg, flags, ctx); err != nil {
+	if err := runInitialization(cfg, flags, seedBundle, ctx); err != nil {
 		// Enhanced error logging with structured information
 		errorFields := map[string]interface{}{
 			"component": "init_main",
@@ -145,6 +170,8 @@ func parseFlags() (*InitFlags, error) {
 	flag.BoolVar(&flags.Verbose, "verbose", false, "Enable verbose logging output")
 	flag.BoolVar(&flags.Help, "help", false, "Show usage information")
 	flag.BoolVar(&flags.Help, "h", false, "Show usage information (shorthand)")
+	flag.StringVar(&flags.SeedPath, "seed", "", "Path to a YAML or JSON seed bundle to load after initialization")
+	flag.BoolVar(&flags.OnlineMigrations, "online-migrations", false, "Build indexes concurrently and run marked backfills in batches")
 
 	flag.Parse()
 
@@ -201,7 +228,7 @@ func validateEnvironment(cfg *config.Config, ctx context.Context) error {
 }
 
 // runInitialization orchestrates the initialization process
-func runInitialization(cfg *config.Config, flags *InitFlags, ctx context.Context) error {
+func runInitialization(cfg *config.Config, flags *InitFlags, seedBundle *initService.SeedBundle, ctx context.Context) error {
 	logger.WithContextAndFields(ctx, map[string]interface{}{
 		"component": "init_main",
 		"action":    "start_orchestration",
@@ -222,6 +249,9 @@ func runInitialization(cfg *config.Config, flags *InitFlags, ctx context.Context
 		}
 		defer service.Close()
 
+		if seedBundle != nil {
+			fmt.Printf("✓ Seed bundle %s is valid\n", flags.SeedPath)
+		}
 		fmt.Println("✓ Dry run validation completed - no issues found")
 		return nil
 	}
@@ -243,6 +273,11 @@ func runInitialization(cfg *config.Config, flags *InitFlags, ctx context.Context
 	}
 	defer service.Close()
 
+	if seedBundle != nil {
+		service.SetSeedBundle(seedBundle)
+	}
+	service.SetOnlineMigrations(flags.OnlineMigrations)
+
 	// Run the initialization
 	logger.WithContextAndFields(ctx, map[string]interface{}{
 		"component": "init_main",
@@ -276,6 +311,11 @@ func showUsage() {
 	fmt.Println("OPTIONS:")
 	fmt.Println("    -dry-run     Perform validation checks without making changes")
 	fmt.Println("    -verbose     Enable verbose logging output")
+	fmt.Println("    -seed PATH   Load a seed bundle (.yaml, .yml or .json) with requirement types,")
+	fmt.Println("                 relationship types, status models, templates and a demo workspace")
+	fmt.Println("    -online-migrations")
+	fmt.Println("                 Run migrations without blocking writes: build indexes CONCURRENTLY")
+	fmt.Println("                 and run statements marked \"-- migrate:backfill\" in batches")
 	fmt.Println("    -help, -h    Show this usage information")
 	fmt.Println()
 	fmt.Println("REQUIRED ENVIRONMENT VARIABLES:")
@@ -310,4 +350,7 @@ func showUsage() {
 	fmt.Println()
 	fmt.Println("    # Run with verbose logging")
 	fmt.Println("    init -verbose")
+	fmt.Println()
+	fmt.Println("    # Initialize with organization-specific configuration")
+	fmt.Println("    init -seed seed.example.yaml")
 }
@@ -12,9 +12,14 @@ import (
 
 func main() {
 	var (
-		up      = flag.Bool("up", false, "Run migrations up")
-		down    = flag.Bool("down", false, "Rollback one migration")
-		version = flag.Bool("version", false, "Show current migration version")
+		up        = flag.Bool("up", false, "Run migrations up")
+		down      = flag.Bool("down", false, "Rollback one migration")
+		version   = flag.Bool("version", false, "Show current migration version")
+		preflight = flag.Bool("preflight", false, "Analyze pending migrations for blocking operations without running them")
+		online    = flag.Bool("online", false, "With -up, build indexes concurrently and run marked backfills in batches")
+		batchSize = flag.Int("batch-size", database.DefaultBackfillBatchSize, "Rows per backfill batch in the online mode")
+		pause     = flag.Duration("batch-pause", 0, "Pause between backfill batches in the online mode")
+		force     = flag.Bool("force", false, "With -up, run migrations despite blocking pre-flight findings")
 	)
 	flag.Parse()
 
@@ -35,7 +40,40 @@ func main() {
 	migrationManager := database.NewMigrationManager(db.Postgres, "migrations")
 
 	switch {
+	case *preflight:
+		report, err := migrationManager.Preflight()
+		if err != nil {
+			log.Fatalf("Failed to analyze migrations: %v", err)
+		}
+		printPreflight(report)
+		if len(report.Disruptive(false)) > 0 {
+			os.Exit(1)
+		}
+
 	case *up:
+		report, err := migrationManager.Preflight()
+		if err != nil {
+			log.Fatalf("Failed to analyze migrations: %v", err)
+		}
+		printPreflight(report)
+		if disruptive := report.Disruptive(*online); len(disruptive) > 0 && !*force {
+			log.Fatalf("Pending migrations contain %d blocking operations; fix them, use -online where it handles them or rerun with -force", len(disruptive))
+		}
+
+		if *online {
+			fmt.Println("Running migrations online...")
+			applied, err := migrationManager.RunMigrationsOnline(database.OnlineMigrationOptions{
+				BatchSize:  *batchSize,
+				BatchPause: *pause,
+				Progress:   printProgress,
+			})
+			if err != nil {
+				log.Fatalf("Failed to run migrations: %v", err)
+			}
+			fmt.Printf("Migrations completed successfully (%d applied)\n", applied)
+			break
+		}
+
 		fmt.Println("Running migrations...")
 		if err := migrationManager.RunMigrations(); err != nil {
 			log.Fatalf("Failed to run migrations: %v", err)
@@ -58,9 +96,39 @@ func main() {
 
 	default:
 		fmt.Println("Usage:")
-		fmt.Println("  go run cmd/migrate/main.go -up      # Run migrations")
-		fmt.Println("  go run cmd/migrate/main.go -down    # Rollback one migration")
-		fmt.Println("  go run cmd/migrate/main.go -version # Show current version")
+		fmt.Println("  go run cmd/migrate/main.go -up             # Run migrations")
+		fmt.Println("  go run cmd/migrate/main.go -up -online     # Run migrations without blocking writes")
+		fmt.Println("  go run cmd/migrate/main.go -preflight      # Check pending migrations for blocking operations")
+		fmt.Println("  go run cmd/migrate/main.go -down           # Rollback one migration")
+		fmt.Println("  go run cmd/migrate/main.go -version        # Show current version")
+		fmt.Println()
+		fmt.Println("Online mode options: -batch-size N, -batch-pause DURATION; -force runs despite blocking findings")
 		os.Exit(1)
 	}
 }
+
+// printPreflight prints the findings of the pre-flight analysis
+func printPreflight(report *database.PreflightReport) {
+	fmt.Printf("Pre-flight: %d pending migrations after version %d, %d findings\n",
+		report.Pending(), report.CurrentVersion, len(report.Findings))
+	for _, finding := range report.Findings {
+		fmt.Println("  " + finding.String())
+	}
+}
+
+// printProgress prints the progress of an online migration run
+func printProgress(p database.MigrationProgress) {
+	switch {
+	case p.Done:
+		fmt.Printf("  %06d_%s done\n", p.Version, p.Name)
+	case p.Operation == database.OnlineOperationBackfill:
+		percent := 100.0
+		if p.RowsTotal > 0 {
+			percent = float64(p.RowsDone) * 100 / float64(p.RowsTotal)
+		}
+		fmt.Printf("  %06d_%s [%d/%d] backfill %d/%d rows (%.0f%%) %s\n",
+			p.Version, p.Name, p.Step, p.Steps, p.RowsDone, p.RowsTotal, percent, p.Statement)
+	default:
+		fmt.Printf("  %06d_%s [%d/%d] %s %s\n", p.Version, p.Name, p.Step, p.Steps, p.Operation, p.Statement)
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+func main() {
+	var (
+		tables   = flag.String("tables", "", "Comma-separated tables whose search indexes are rebuilt (default all)")
+		throttle = flag.Duration("throttle", service.DefaultReindexThrottle, "Pause between two index rebuilds")
+		health   = flag.Bool("health", false, "Report the health of the search indexes without rebuilding them")
+	)
+	flag.Usage = showUsage
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Redis is needed to clear cached search results after the rebuild
+	db, err := database.InitializeWithoutMigrations(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer db.Close()
+
+	repos := repository.NewRepositories(db.Postgres, db.Redis)
+	searchService := service.NewSearchService(db.Postgres, db.Redis, repos.Epic, repos.UserStory, repos.AcceptanceCriteria, repos.Requirement, repos.SteeringDocument)
+	searchIndexService := service.NewSearchIndexService(repos.SearchIndex, searchService)
+
+	// Interrupting stops after the index being rebuilt
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if *health {
+		report, err := searchIndexService.Health(ctx)
+		if err != nil {
+			log.Fatalf("Failed to check search index health: %v", err)
+		}
+		printHealth(report)
+		if report.Status == service.SearchIndexUnhealthy {
+			os.Exit(1)
+		}
+		return
+	}
+
+	options := service.ReindexOptions{Throttle: *throttle}
+	for _, table := range strings.Split(*tables, ",") {
+		if table = strings.TrimSpace(table); table != "" {
+			options.Tables = append(options.Tables, table)
+		}
+	}
+
+	fmt.Println("Rebuilding search indexes...")
+	run, err := searchIndexService.Reindex(ctx, options, printProgress)
+	if err != nil {
+		if run == nil {
+			log.Fatalf("Failed to rebuild search indexes: %v", err)
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Rebuilt %d search indexes in %s\n", run.Completed, run.FinishedAt.Sub(run.StartedAt).Round(time.Millisecond))
+}
+
+// printProgress prints the index rebuilt last
+func printProgress(run service.ReindexRun) {
+	index := run.Indexes[len(run.Indexes)-1]
+	if index.Error != "" {
+		fmt.Printf("[%d/%d] %s (%s) failed after %dms: %s\n", run.Completed, run.Total, index.IndexName, index.TableName, index.DurationMs, index.Error)
+		return
+	}
+	fmt.Printf("[%d/%d] %s (%s) rebuilt in %dms\n", run.Completed, run.Total, index.IndexName, index.TableName, index.DurationMs)
+}
+
+// printHealth prints the search index health as tables
+func printHealth(report *service.SearchIndexHealth) {
+	fmt.Printf("Status: %s\n", report.Status)
+	for _, problem := range report.Problems {
+		fmt.Printf("  - %s\n", problem)
+	}
+	fmt.Println()
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "INDEX\tTABLE\tVALID\tSIZE\tPENDING")
+	for _, index := range report.Indexes {
+		pending := "n/a"
+		if index.PendingDocuments != nil {
+			pending = fmt.Sprint(*index.PendingDocuments)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%t\t%d kB\t%s\n", index.IndexName, index.TableName, index.Valid, index.SizeBytes/1024, pending)
+	}
+	writer.Flush()
+	fmt.Println()
+
+	writer = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "TABLE\tROWS\tMODIFIED SINCE ANALYZE\tLAST ANALYZED")
+	for _, table := range report.Tables {
+		analyzed := "never"
+		if table.LastAnalyzedAt != nil {
+			analyzed = table.LastAnalyzedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(writer, "%s\t%d\t%d\t%s\n", table.TableName, table.LiveRows, table.ModifiedSinceAnalyze, analyzed)
+	}
+	writer.Flush()
+
+	if report.PendingDocumentsUnavailable {
+		fmt.Println()
+		fmt.Println("Pending documents are only reported when the pgstattuple extension is installed.")
+	}
+}
+
+// showUsage prints command help
+func showUsage() {
+	fmt.Println("Usage:")
+	fmt.Println("  go run cmd/reindex/main.go [-tables TABLES] [-throttle DURATION]  # Rebuild search indexes")
+	fmt.Println("  go run cmd/reindex/main.go -health                                # Report search index health")
+	fmt.Println()
+	fmt.Println("Rebuilds the full-text search indexes one at a time with REINDEX CONCURRENTLY, so writes are not blocked,")
+	fmt.Println("refreshes the statistics of their tables and clears cached search results. Run it after bulk imports or")
+	fmt.Println("migrations that bypass the application. TABLES is a comma-separated list such as requirements,user_stories.")
+	fmt.Println("Database settings are read from the same environment variables as the server (DB_HOST, DB_USER, ...).")
+}
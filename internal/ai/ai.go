@@ -0,0 +1,127 @@
+// Package ai abstracts the language model providers behind the AI features, so that features can run on
+// OpenAI-compatible APIs, Anthropic or a local Ollama server depending on configuration.
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"product-requirements-management/internal/apperrors"
+)
+
+var (
+	ErrNotConfigured = apperrors.New(apperrors.KindUnavailable, "LLM_NOT_CONFIGURED", "no language model provider is configured")
+	ErrUnavailable   = apperrors.New(apperrors.KindUnavailable, "LLM_UNAVAILABLE", "the language model provider failed to respond")
+)
+
+// Supported providers
+const (
+	ProviderOpenAI    = "openai"    // OpenAI or any compatible server, such as vLLM or LM Studio
+	ProviderAnthropic = "anthropic" // Anthropic Messages API
+	ProviderOllama    = "ollama"    // Local Ollama server
+)
+
+// Provider defines the interface of a language model backend
+type Provider interface {
+	// Name identifies the provider in usage records, such as openai
+	Name() string
+	// Complete returns the whole reply of the model
+	Complete(ctx context.Context, req Request) (*Completion, error)
+	// Stream passes the reply to onDelta as the model generates it and returns the whole reply at the end.
+	// An error returned by onDelta aborts the request.
+	Stream(ctx context.Context, req Request, onDelta func(text string) error) (*Completion, error)
+}
+
+// Request is a prompt for a language model
+type Request struct {
+	Model     string // Model to use; empty for the default model of the provider
+	System    string // System instructions
+	Prompt    string
+	MaxTokens int // Maximum reply length; 0 for the default of the provider
+}
+
+// Completion is the reply of a language model with the tokens the provider billed for it
+type Completion struct {
+	Model        string // Model that generated the reply
+	Text         string
+	InputTokens  int
+	OutputTokens int
+}
+
+// Config selects and configures a provider
+type Config struct {
+	Provider     string // openai, anthropic or ollama
+	BaseURL      string // Base URL of the API; defaults to the public API of the provider or a local Ollama server
+	APIKey       string
+	Model        string // Default model
+	MaxTokens    int
+	Timeout      time.Duration // Timeout of a single attempt
+	MaxRetries   int           // Retries of requests that failed with a temporary error
+	RetryBackoff time.Duration // Delay before the first retry, doubled for every further retry
+}
+
+// Default base URLs of the providers
+var defaultBaseURLs = map[string]string{
+	ProviderOpenAI:    "https://api.openai.com/v1",
+	ProviderAnthropic: "https://api.anthropic.com/v1",
+	ProviderOllama:    "http://localhost:11434",
+}
+
+// NewProvider creates the configured provider, retrying temporary failures when cfg.MaxRetries is set
+func NewProvider(cfg Config) (Provider, error) {
+	baseURL := strings.TrimRight(cfg.BaseURL, "/")
+	if baseURL == "" {
+		baseURL = defaultBaseURLs[cfg.Provider]
+	}
+	base := httpProvider{
+		baseURL:   baseURL,
+		apiKey:    cfg.APIKey,
+		model:     cfg.Model,
+		maxTokens: cfg.MaxTokens,
+		timeout:   cfg.Timeout,
+	}
+
+	var provider Provider
+	switch cfg.Provider {
+	case ProviderOpenAI:
+		provider = &openAIProvider{base}
+	case ProviderAnthropic:
+		provider = &anthropicProvider{base}
+	case ProviderOllama:
+		provider = &ollamaProvider{base}
+	default:
+		return nil, fmt.Errorf("unknown language model provider %q", cfg.Provider)
+	}
+
+	if cfg.MaxRetries > 0 {
+		provider = &retryingProvider{
+			Provider:   provider,
+			maxRetries: cfg.MaxRetries,
+			backoff:    cfg.RetryBackoff,
+			sleep:      sleepContext,
+		}
+	}
+	return provider, nil
+}
+
+// httpProvider holds the settings shared by the HTTP providers
+type httpProvider struct {
+	baseURL   string
+	apiKey    string
+	model     string
+	maxTokens int
+	timeout   time.Duration
+}
+
+// resolve fills in the default model and reply length of a request
+func (p httpProvider) resolve(req Request) Request {
+	if req.Model == "" {
+		req.Model = p.model
+	}
+	if req.MaxTokens <= 0 {
+		req.MaxTokens = p.maxTokens
+	}
+	return req
+}
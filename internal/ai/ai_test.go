@@ -0,0 +1,237 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// providerRequest is the union of the request bodies of the providers
+type providerRequest struct {
+	Model     string `json:"model"`
+	MaxTokens int    `json:"max_tokens"`
+	System    string `json:"system"`
+	Stream    bool   `json:"stream"`
+	Messages  []struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	} `json:"messages"`
+	Options struct {
+		NumPredict int `json:"num_predict"`
+	} `json:"options"`
+}
+
+// newProviderServer serves canned replies of all providers, streamed when the request asks for it
+func newProviderServer(t *testing.T, request *providerRequest, headers *http.Header) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*headers, *request = r.Header, providerRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		var reply string
+		switch {
+		case r.URL.Path == "/v1/chat/completions" && request.Stream:
+			reply = "data: {\"choices\":[{\"delta\":{\"content\":\"from \"}}]}\n\n" +
+				"data: {\"choices\":[{\"delta\":{\"content\":\"openai\"}}]}\n\n" +
+				"data: {\"choices\":[],\"usage\":{\"prompt_tokens\":12,\"completion_tokens\":3}}\n\n" +
+				"data: [DONE]\n\n"
+		case r.URL.Path == "/v1/chat/completions":
+			reply = `{"choices":[{"message":{"role":"assistant","content":"from openai"}}],"usage":{"prompt_tokens":12,"completion_tokens":3}}`
+		case r.URL.Path == "/v1/messages" && request.Stream:
+			reply = "event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"usage\":{\"input_tokens\":10}}}\n\n" +
+				"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"delta\":{\"type\":\"text_delta\",\"text\":\"from \"}}\n\n" +
+				"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"delta\":{\"type\":\"text_delta\",\"text\":\"anthropic\"}}\n\n" +
+				"event: message_delta\ndata: {\"type\":\"message_delta\",\"usage\":{\"output_tokens\":4}}\n\n" +
+				"event: message_stop\ndata: {\"type\":\"message_stop\"}\n\n"
+		case r.URL.Path == "/v1/messages":
+			reply = `{"content":[{"type":"text","text":"from "},{"type":"text","text":"anthropic"}],"usage":{"input_tokens":10,"output_tokens":4}}`
+		case r.URL.Path == "/api/chat" && request.Stream:
+			reply = `{"message":{"content":"from "},"done":false}` + "\n" +
+				`{"message":{"content":"ollama"},"done":false}` + "\n" +
+				`{"message":{"content":""},"done":true,"prompt_eval_count":8,"eval_count":2}` + "\n"
+		case r.URL.Path == "/api/chat":
+			reply = `{"message":{"role":"assistant","content":"from ollama"},"done":true,"prompt_eval_count":8,"eval_count":2}`
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			reply = `{"error":"unknown model"}`
+		}
+		_, _ = w.Write([]byte(reply))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProviders(t *testing.T) {
+	var request providerRequest
+	var headers http.Header
+	server := newProviderServer(t, &request, &headers)
+
+	tests := []struct {
+		provider string
+		baseURL  string
+		reply    Completion
+	}{
+		{ProviderOpenAI, server.URL + "/v1/", Completion{Model: "model-a", Text: "from openai", InputTokens: 12, OutputTokens: 3}},
+		{ProviderAnthropic, server.URL + "/v1", Completion{Model: "model-a", Text: "from anthropic", InputTokens: 10, OutputTokens: 4}},
+		{ProviderOllama, server.URL, Completion{Model: "model-a", Text: "from ollama", InputTokens: 8, OutputTokens: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			provider, err := NewProvider(Config{Provider: tt.provider, BaseURL: tt.baseURL, APIKey: "secret", Model: "model-a", MaxTokens: 100, Timeout: time.Second})
+			require.NoError(t, err)
+			assert.Equal(t, tt.provider, provider.Name())
+
+			completion, err := provider.Complete(context.Background(), Request{System: "be brief", Prompt: "hello"})
+			require.NoError(t, err)
+			assert.Equal(t, &tt.reply, completion)
+			assert.Equal(t, "model-a", request.Model)
+			assert.Equal(t, "hello", request.Messages[len(request.Messages)-1].Content)
+
+			var deltas []string
+			completion, err = provider.Stream(context.Background(), Request{Model: "model-b", Prompt: "hello", MaxTokens: 50}, func(text string) error {
+				deltas = append(deltas, text)
+				return nil
+			})
+			require.NoError(t, err)
+			assert.True(t, request.Stream)
+			assert.Equal(t, "model-b", request.Model)
+			assert.Equal(t, tt.reply.Text, strings.Join(deltas, ""))
+			assert.Len(t, deltas, 2)
+			expected := tt.reply
+			expected.Model = "model-b"
+			assert.Equal(t, &expected, completion)
+		})
+	}
+
+	t.Run("request details", func(t *testing.T) {
+		anthropic, err := NewProvider(Config{Provider: ProviderAnthropic, BaseURL: server.URL + "/v1", APIKey: "secret", Model: "model-a", MaxTokens: 100})
+		require.NoError(t, err)
+		_, err = anthropic.Complete(context.Background(), Request{System: "be brief", Prompt: "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "secret", headers.Get("x-api-key"))
+		assert.Equal(t, anthropicVersion, headers.Get("anthropic-version"))
+		assert.Equal(t, "be brief", request.System)
+		assert.Equal(t, 100, request.MaxTokens)
+
+		ollama, err := NewProvider(Config{Provider: ProviderOllama, BaseURL: server.URL, Model: "llama3", MaxTokens: 100})
+		require.NoError(t, err)
+		_, err = ollama.Complete(context.Background(), Request{System: "be brief", Prompt: "hello"})
+		require.NoError(t, err)
+		assert.Empty(t, headers.Get("Authorization"))
+		assert.Equal(t, 100, request.Options.NumPredict)
+		require.Len(t, request.Messages, 2)
+		assert.Equal(t, "system", request.Messages[0].Role)
+	})
+
+	t.Run("provider errors", func(t *testing.T) {
+		provider, err := NewProvider(Config{Provider: ProviderOpenAI, BaseURL: server.URL, Model: "model-a"})
+		require.NoError(t, err)
+		_, err = provider.Complete(context.Background(), Request{Prompt: "hello"})
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.Contains(t, err.Error(), "400")
+		assert.False(t, isTemporary(err))
+
+		_, err = NewProvider(Config{Provider: "acme"})
+		assert.Error(t, err)
+	})
+
+	t.Run("stream aborted by the caller", func(t *testing.T) {
+		provider, err := NewProvider(Config{Provider: ProviderOllama, BaseURL: server.URL, Model: "llama3"})
+		require.NoError(t, err)
+		stop := errors.New("stop")
+		_, err = provider.Stream(context.Background(), Request{Prompt: "hello"}, func(text string) error { return stop })
+		assert.ErrorIs(t, err, stop)
+	})
+}
+
+func TestRetryingProvider(t *testing.T) {
+	attempts := 0
+	status := http.StatusTooManyRequests
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"message":{"content":"finally"},"done":true}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{Provider: ProviderOllama, BaseURL: server.URL, Model: "llama3", MaxRetries: 2})
+	require.NoError(t, err)
+	var delays []time.Duration
+	provider.(*retryingProvider).sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	t.Run("retries temporary failures with growing delays", func(t *testing.T) {
+		completion, err := provider.Complete(context.Background(), Request{Prompt: "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "finally", completion.Text)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, []time.Duration{defaultRetryBackoff, 2 * defaultRetryBackoff}, delays)
+	})
+
+	t.Run("gives up after the configured retries", func(t *testing.T) {
+		attempts, status = -10, http.StatusBadGateway
+		_, err := provider.Complete(context.Background(), Request{Prompt: "hello"})
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.Equal(t, -7, attempts)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		attempts, status = 0, http.StatusUnauthorized
+		_, err := provider.Complete(context.Background(), Request{Prompt: "hello"})
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+// flakyStreamProvider fails every stream with a temporary error after sending the given deltas
+type flakyStreamProvider struct {
+	Provider
+	deltas   []string
+	attempts int
+}
+
+func (p *flakyStreamProvider) Stream(ctx context.Context, req Request, onDelta func(text string) error) (*Completion, error) {
+	p.attempts++
+	for _, delta := range p.deltas {
+		if err := onDelta(delta); err != nil {
+			return nil, err
+		}
+	}
+	return nil, &temporaryError{ErrUnavailable}
+}
+
+func TestRetryingProviderStream(t *testing.T) {
+	noSleep := func(ctx context.Context, d time.Duration) error { return nil }
+
+	t.Run("retries streams that sent nothing", func(t *testing.T) {
+		flaky := &flakyStreamProvider{}
+		provider := &retryingProvider{Provider: flaky, maxRetries: 2, sleep: noSleep}
+		_, err := provider.Stream(context.Background(), Request{}, func(text string) error { return nil })
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.Equal(t, 3, flaky.attempts)
+	})
+
+	t.Run("does not retry streams that sent text", func(t *testing.T) {
+		flaky := &flakyStreamProvider{deltas: []string{"partial"}}
+		provider := &retryingProvider{Provider: flaky, maxRetries: 2, sleep: noSleep}
+		var deltas []string
+		_, err := provider.Stream(context.Background(), Request{}, func(text string) error {
+			deltas = append(deltas, text)
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.Equal(t, 1, flaky.attempts)
+		assert.Equal(t, []string{"partial"}, deltas)
+	})
+}
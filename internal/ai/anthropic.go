@@ -0,0 +1,129 @@
+package ai
+
+import (
+	"context"
+	"strings"
+)
+
+// anthropicVersion is the Messages API version the requests are written for
+const anthropicVersion = "2023-06-01"
+
+// anthropicProvider calls the Anthropic Messages API
+type anthropicProvider struct {
+	httpProvider
+}
+
+// Name identifies the provider
+func (p *anthropicProvider) Name() string {
+	return ProviderAnthropic
+}
+
+// Complete sends the prompt as a user message and returns the text blocks of the reply
+func (p *anthropicProvider) Complete(ctx context.Context, req Request) (*Completion, error) {
+	req = p.resolve(req)
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	var message struct {
+		Content []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"content"`
+		Usage struct {
+			InputTokens  int `json:"input_tokens"`
+			OutputTokens int `json:"output_tokens"`
+		} `json:"usage"`
+	}
+	if err := postJSON(ctx, p.baseURL+"/messages", p.headers(), p.body(req, false), &message); err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	for _, block := range message.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+	return &Completion{
+		Model:        req.Model,
+		Text:         text.String(),
+		InputTokens:  message.Usage.InputTokens,
+		OutputTokens: message.Usage.OutputTokens,
+	}, nil
+}
+
+// Stream reads the server-sent events of the message: the input tokens arrive with message_start, the text
+// with content_block_delta and the output tokens with message_delta
+func (p *anthropicProvider) Stream(ctx context.Context, req Request, onDelta func(text string) error) (*Completion, error) {
+	req = p.resolve(req)
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	completion := &Completion{Model: req.Model}
+	var text strings.Builder
+	err := postLines(ctx, p.baseURL+"/messages", p.headers(), p.body(req, true), func(line string) error {
+		data, ok := serverSentData(line)
+		if !ok {
+			return nil
+		}
+		var event struct {
+			Type    string `json:"type"`
+			Message struct {
+				Usage struct {
+					InputTokens int `json:"input_tokens"`
+				} `json:"usage"`
+			} `json:"message"`
+			Delta struct {
+				Type string `json:"type"`
+				Text string `json:"text"`
+			} `json:"delta"`
+			Usage struct {
+				OutputTokens int `json:"output_tokens"`
+			} `json:"usage"`
+		}
+		if err := decodeStreamEvent(data, &event); err != nil {
+			return err
+		}
+		switch event.Type {
+		case "message_start":
+			completion.InputTokens = event.Message.Usage.InputTokens
+		case "message_delta":
+			completion.OutputTokens = event.Usage.OutputTokens
+		case "content_block_delta":
+			if event.Delta.Type == "text_delta" && event.Delta.Text != "" {
+				text.WriteString(event.Delta.Text)
+				return onDelta(event.Delta.Text)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	completion.Text = text.String()
+	return completion, nil
+}
+
+// headers authenticate with the API key and select the API version
+func (p *anthropicProvider) headers() map[string]string {
+	return map[string]string{
+		"x-api-key":         p.apiKey,
+		"anthropic-version": anthropicVersion,
+	}
+}
+
+// body builds the Messages API request
+func (p *anthropicProvider) body(req Request, stream bool) map[string]interface{} {
+	body := map[string]interface{}{
+		"model":      req.Model,
+		"max_tokens": req.MaxTokens,
+		"system":     req.System,
+		"messages": []map[string]string{
+			{"role": "user", "content": req.Prompt},
+		},
+	}
+	if stream {
+		body["stream"] = true
+	}
+	return body
+}
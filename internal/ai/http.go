@@ -0,0 +1,135 @@
+package ai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxResponseSize limits the response bodies read from providers
+const maxResponseSize = 4 << 20
+
+// temporaryError marks a provider failure that may succeed when retried, such as a rate limit,
+// a server error or a network failure
+type temporaryError struct {
+	err error
+}
+
+func (e *temporaryError) Error() string { return e.err.Error() }
+func (e *temporaryError) Unwrap() error { return e.err }
+
+// isTemporary reports whether a failed request may be retried
+func isTemporary(err error) bool {
+	var temporary *temporaryError
+	return errors.As(err, &temporary)
+}
+
+// post sends a JSON request to a provider and returns the response once its status is checked; the
+// caller closes the body. Failures are reported as ErrUnavailable with the cause.
+func post(ctx context.Context, endpoint string, headers map[string]string, body interface{}) (*http.Response, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			// Canceled by the caller; retrying would not help
+			return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+		}
+		return nil, &temporaryError{fmt.Errorf("%w: %v", ErrUnavailable, err)}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+		err := fmt.Errorf("%w: provider returned %d: %s", ErrUnavailable, resp.StatusCode, strings.TrimSpace(string(responseBody)))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return nil, &temporaryError{err}
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// postJSON sends a JSON request to a provider and decodes the JSON response into result
+func postJSON(ctx context.Context, endpoint string, headers map[string]string, body, result interface{}) error {
+	resp, err := post(ctx, endpoint, headers, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return &temporaryError{fmt.Errorf("%w: %v", ErrUnavailable, err)}
+	}
+	if err := json.Unmarshal(responseBody, result); err != nil {
+		return fmt.Errorf("%w: invalid provider response: %v", ErrUnavailable, err)
+	}
+	return nil
+}
+
+// postLines sends a JSON request to a provider and passes every non-empty line of the streamed response
+// to onLine until the response ends or onLine returns an error
+func postLines(ctx context.Context, endpoint string, headers map[string]string, body interface{}, onLine func(line string) error) error {
+	resp, err := post(ctx, endpoint, headers, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, maxResponseSize))
+	scanner.Buffer(make([]byte, 64*1024), maxResponseSize)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if err := onLine(line); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w: reading stream: %v", ErrUnavailable, err)
+	}
+	return nil
+}
+
+// serverSentData returns the data of a server-sent event line, or false for other lines such as event names
+func serverSentData(line string) (string, bool) {
+	if !strings.HasPrefix(line, "data:") {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(line, "data:")), true
+}
+
+// decodeStreamEvent decodes a JSON event of a streamed response
+func decodeStreamEvent(data string, event interface{}) error {
+	if err := json.Unmarshal([]byte(data), event); err != nil {
+		return fmt.Errorf("%w: invalid stream event: %v", ErrUnavailable, err)
+	}
+	return nil
+}
+
+// withTimeout bounds a single attempt when a timeout is configured
+func (p httpProvider) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, p.timeout)
+}
@@ -0,0 +1,98 @@
+package ai
+
+import (
+	"context"
+	"strings"
+)
+
+// ollamaProvider calls the chat API of a local Ollama server, so AI features can run without a cloud vendor
+type ollamaProvider struct {
+	httpProvider
+}
+
+// ollamaChunk is a response, or a line of a streamed response, of the Ollama chat API; the token counts
+// arrive with the final chunk
+type ollamaChunk struct {
+	Message struct {
+		Content string `json:"content"`
+	} `json:"message"`
+	Done            bool `json:"done"`
+	PromptEvalCount int  `json:"prompt_eval_count"`
+	EvalCount       int  `json:"eval_count"`
+}
+
+// Name identifies the provider
+func (p *ollamaProvider) Name() string {
+	return ProviderOllama
+}
+
+// Complete sends the system instructions and the prompt as a chat and returns the reply
+func (p *ollamaProvider) Complete(ctx context.Context, req Request) (*Completion, error) {
+	req = p.resolve(req)
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	var chunk ollamaChunk
+	if err := postJSON(ctx, p.baseURL+"/api/chat", p.headers(), p.body(req, false), &chunk); err != nil {
+		return nil, err
+	}
+	return &Completion{
+		Model:        req.Model,
+		Text:         chunk.Message.Content,
+		InputTokens:  chunk.PromptEvalCount,
+		OutputTokens: chunk.EvalCount,
+	}, nil
+}
+
+// Stream reads the newline-delimited JSON chunks of the reply
+func (p *ollamaProvider) Stream(ctx context.Context, req Request, onDelta func(text string) error) (*Completion, error) {
+	req = p.resolve(req)
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	completion := &Completion{Model: req.Model}
+	var text strings.Builder
+	err := postLines(ctx, p.baseURL+"/api/chat", p.headers(), p.body(req, true), func(line string) error {
+		var chunk ollamaChunk
+		if err := decodeStreamEvent(line, &chunk); err != nil {
+			return err
+		}
+		if chunk.Done {
+			completion.InputTokens, completion.OutputTokens = chunk.PromptEvalCount, chunk.EvalCount
+		}
+		if chunk.Message.Content == "" {
+			return nil
+		}
+		text.WriteString(chunk.Message.Content)
+		return onDelta(chunk.Message.Content)
+	})
+	if err != nil {
+		return nil, err
+	}
+	completion.Text = text.String()
+	return completion, nil
+}
+
+// headers authenticate with the API key when Ollama runs behind an authenticating proxy
+func (p *ollamaProvider) headers() map[string]string {
+	headers := map[string]string{}
+	if p.apiKey != "" {
+		headers["Authorization"] = "Bearer " + p.apiKey
+	}
+	return headers
+}
+
+// body builds the chat request; num_predict limits the reply length
+func (p *ollamaProvider) body(req Request, stream bool) map[string]interface{} {
+	return map[string]interface{}{
+		"model":  req.Model,
+		"stream": stream,
+		"messages": []map[string]string{
+			{"role": "system", "content": req.System},
+			{"role": "user", "content": req.Prompt},
+		},
+		"options": map[string]interface{}{
+			"num_predict": req.MaxTokens,
+		},
+	}
+}
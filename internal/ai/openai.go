@@ -0,0 +1,117 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// openAIProvider calls the chat completions API of OpenAI or any compatible server
+type openAIProvider struct {
+	httpProvider
+}
+
+// openAIUsage is the token usage reported by the chat completions API
+type openAIUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+// Name identifies the provider
+func (p *openAIProvider) Name() string {
+	return ProviderOpenAI
+}
+
+// Complete sends the system instructions and the prompt as a chat and returns the first choice
+func (p *openAIProvider) Complete(ctx context.Context, req Request) (*Completion, error) {
+	req = p.resolve(req)
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	var completion struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+		Usage openAIUsage `json:"usage"`
+	}
+	if err := postJSON(ctx, p.baseURL+"/chat/completions", p.headers(), p.body(req, false), &completion); err != nil {
+		return nil, err
+	}
+	if len(completion.Choices) == 0 {
+		return nil, fmt.Errorf("%w: response has no choices", ErrUnavailable)
+	}
+	return &Completion{
+		Model:        req.Model,
+		Text:         completion.Choices[0].Message.Content,
+		InputTokens:  completion.Usage.PromptTokens,
+		OutputTokens: completion.Usage.CompletionTokens,
+	}, nil
+}
+
+// Stream reads the server-sent chunks of the chat completion; the usage arrives in the last chunk
+func (p *openAIProvider) Stream(ctx context.Context, req Request, onDelta func(text string) error) (*Completion, error) {
+	req = p.resolve(req)
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	completion := &Completion{Model: req.Model}
+	var text strings.Builder
+	err := postLines(ctx, p.baseURL+"/chat/completions", p.headers(), p.body(req, true), func(line string) error {
+		data, ok := serverSentData(line)
+		if !ok || data == "[DONE]" {
+			return nil
+		}
+		var chunk struct {
+			Choices []struct {
+				Delta struct {
+					Content string `json:"content"`
+				} `json:"delta"`
+			} `json:"choices"`
+			Usage *openAIUsage `json:"usage"`
+		}
+		if err := decodeStreamEvent(data, &chunk); err != nil {
+			return err
+		}
+		if chunk.Usage != nil {
+			completion.InputTokens, completion.OutputTokens = chunk.Usage.PromptTokens, chunk.Usage.CompletionTokens
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			return nil
+		}
+		text.WriteString(chunk.Choices[0].Delta.Content)
+		return onDelta(chunk.Choices[0].Delta.Content)
+	})
+	if err != nil {
+		return nil, err
+	}
+	completion.Text = text.String()
+	return completion, nil
+}
+
+// headers authenticate with the API key when one is configured; local servers often need none
+func (p *openAIProvider) headers() map[string]string {
+	headers := map[string]string{}
+	if p.apiKey != "" {
+		headers["Authorization"] = "Bearer " + p.apiKey
+	}
+	return headers
+}
+
+// body builds the chat completion request
+func (p *openAIProvider) body(req Request, stream bool) map[string]interface{} {
+	body := map[string]interface{}{
+		"model":      req.Model,
+		"max_tokens": req.MaxTokens,
+		"messages": []map[string]string{
+			{"role": "system", "content": req.System},
+			{"role": "user", "content": req.Prompt},
+		},
+	}
+	if stream {
+		body["stream"] = true
+		body["stream_options"] = map[string]bool{"include_usage": true}
+	}
+	return body
+}
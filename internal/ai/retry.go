@@ -0,0 +1,86 @@
+package ai
+
+import (
+	"context"
+	"time"
+)
+
+// defaultRetryBackoff is the delay before the first retry when none is configured
+const defaultRetryBackoff = 500 * time.Millisecond
+
+// retryingProvider retries requests that failed with a temporary error, doubling the delay every time
+type retryingProvider struct {
+	Provider
+	maxRetries int
+	backoff    time.Duration
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+// Complete retries the request until it succeeds, fails permanently or the retries are used up
+func (p *retryingProvider) Complete(ctx context.Context, req Request) (*Completion, error) {
+	var completion *Completion
+	err := p.retry(ctx, func() error {
+		var err error
+		completion, err = p.Provider.Complete(ctx, req)
+		return err
+	})
+	return completion, err
+}
+
+// Stream retries the request only while nothing was streamed, so callers never see a reply twice
+func (p *retryingProvider) Stream(ctx context.Context, req Request, onDelta func(text string) error) (*Completion, error) {
+	var completion *Completion
+	streamed := false
+	err := p.retry(ctx, func() error {
+		var err error
+		completion, err = p.Provider.Stream(ctx, req, func(text string) error {
+			streamed = true
+			return onDelta(text)
+		})
+		if err != nil && streamed {
+			return &permanentError{err}
+		}
+		return err
+	})
+	if permanent, ok := err.(*permanentError); ok {
+		err = permanent.err
+	}
+	return completion, err
+}
+
+// retry calls attempt until it succeeds or fails with an error that is not temporary
+func (p *retryingProvider) retry(ctx context.Context, attempt func() error) error {
+	backoff := p.backoff
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+	for retries := 0; ; retries++ {
+		err := attempt()
+		if err == nil || retries >= p.maxRetries || !isTemporary(err) {
+			return err
+		}
+		if sleepErr := p.sleep(ctx, backoff); sleepErr != nil {
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// permanentError stops the retries of a stream that already delivered text
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+// sleepContext waits for d unless the context ends first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
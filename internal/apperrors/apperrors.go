@@ -0,0 +1,181 @@
+// Package apperrors defines domain errors that carry a kind and a machine-readable code,
+// so that the transport layers map them to responses in one place.
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Kind classifies a domain error independently of the transport
+type Kind int
+
+// Error kinds
+const (
+	KindInternal        Kind = iota // Unexpected failure; details are not shown to clients
+	KindInvalid                     // The request is malformed or violates a business rule
+	KindUnauthenticated             // The caller is not authenticated
+	KindForbidden                   // The caller may not perform the operation
+	KindNotFound                    // A referenced entity does not exist
+	KindConflict                    // The operation conflicts with the current state
+	KindLocked                      // The entity is locked by another user
+	KindUnprocessable               // The request is well-formed but its content cannot be processed
+	KindUnavailable                 // A dependency the operation needs is not configured or not reachable
+)
+
+// Machine-readable codes shared by several errors
+const (
+	CodeInternal                = "INTERNAL_ERROR"
+	CodeValidation              = "VALIDATION_ERROR"
+	CodeAuthenticationRequired  = "AUTHENTICATION_REQUIRED"
+	CodeInsufficientPermissions = "INSUFFICIENT_PERMISSIONS"
+	CodeEntityNotFound          = "ENTITY_NOT_FOUND"
+	CodeConflict                = "CONFLICT"
+	CodeEntityLocked            = "ENTITY_LOCKED"
+	CodeServiceUnavailable      = "SERVICE_UNAVAILABLE"
+)
+
+// String returns the name of the kind
+func (k Kind) String() string {
+	switch k {
+	case KindInvalid:
+		return "invalid"
+	case KindUnauthenticated:
+		return "unauthenticated"
+	case KindForbidden:
+		return "forbidden"
+	case KindNotFound:
+		return "not_found"
+	case KindConflict:
+		return "conflict"
+	case KindLocked:
+		return "locked"
+	case KindUnprocessable:
+		return "unprocessable"
+	case KindUnavailable:
+		return "unavailable"
+	default:
+		return "internal"
+	}
+}
+
+// HTTPStatus returns the HTTP status code of the kind
+func (k Kind) HTTPStatus() int {
+	switch k {
+	case KindInvalid:
+		return http.StatusBadRequest
+	case KindUnauthenticated:
+		return http.StatusUnauthorized
+	case KindForbidden:
+		return http.StatusForbidden
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	case KindLocked:
+		return http.StatusLocked
+	case KindUnprocessable:
+		return http.StatusUnprocessableEntity
+	case KindUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// DefaultCode returns the code used for errors of the kind that do not define their own
+func (k Kind) DefaultCode() string {
+	switch k {
+	case KindInvalid, KindUnprocessable:
+		return CodeValidation
+	case KindUnauthenticated:
+		return CodeAuthenticationRequired
+	case KindForbidden:
+		return CodeInsufficientPermissions
+	case KindNotFound:
+		return CodeEntityNotFound
+	case KindConflict:
+		return CodeConflict
+	case KindLocked:
+		return CodeEntityLocked
+	case KindUnavailable:
+		return CodeServiceUnavailable
+	default:
+		return CodeInternal
+	}
+}
+
+// Error is a domain error with a kind and a machine-readable code.
+// Errors created with New are sentinels compared with errors.Is; Wrap classifies another error.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Err     error // Wrapped cause, if any
+}
+
+// New creates a domain error; an empty code falls back to the default code of the kind
+func New(kind Kind, code, message string) *Error {
+	if code == "" {
+		code = kind.DefaultCode()
+	}
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// Wrap classifies err as a domain error; the message defaults to the message of err
+func Wrap(err error, kind Kind, code, message string) *Error {
+	if code == "" {
+		code = kind.DefaultCode()
+	}
+	if message == "" && err != nil {
+		message = err.Error()
+	}
+	return &Error{Kind: kind, Code: code, Message: message, Err: err}
+}
+
+// Error returns the message, followed by the wrapped cause when it adds information
+func (e *Error) Error() string {
+	if e.Err == nil || e.Err.Error() == e.Message {
+		return e.Message
+	}
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %v", e.Message, e.Err)
+}
+
+// Unwrap returns the wrapped cause
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// As returns the outermost domain error in the chain of err
+func As(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+	return nil, false
+}
+
+// KindOf returns the kind of err; errors without a domain error in their chain are internal
+func KindOf(err error) Kind {
+	if domainErr, ok := As(err); ok {
+		return domainErr.Kind
+	}
+	return KindInternal
+}
+
+// CodeOf returns the machine-readable code of err
+func CodeOf(err error) string {
+	if domainErr, ok := As(err); ok {
+		return domainErr.Code
+	}
+	return CodeInternal
+}
+
+// HTTPStatus returns the HTTP status code of err
+func HTTPStatus(err error) int {
+	return KindOf(err).HTTPStatus()
+}
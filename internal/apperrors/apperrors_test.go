@@ -0,0 +1,62 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_DefaultsCodeToKind(t *testing.T) {
+	err := New(KindNotFound, "", "epic not found")
+	assert.Equal(t, CodeEntityNotFound, err.Code)
+	assert.Equal(t, "epic not found", err.Error())
+
+	err = New(KindConflict, "GLOSSARY_TERM_EXISTS", "glossary term already exists")
+	assert.Equal(t, "GLOSSARY_TERM_EXISTS", err.Code)
+}
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("connection reset")
+	err := Wrap(cause, KindInternal, "", "failed to load epic")
+	assert.Equal(t, "failed to load epic: connection reset", err.Error())
+	assert.ErrorIs(t, err, cause)
+
+	err = Wrap(cause, KindInvalid, "", "")
+	assert.Equal(t, "connection reset", err.Error())
+	assert.Equal(t, CodeValidation, err.Code)
+}
+
+func TestClassification(t *testing.T) {
+	notFound := New(KindNotFound, "EPIC_NOT_FOUND", "epic not found")
+	wrapped := fmt.Errorf("failed to update epic: %w", notFound)
+
+	assert.ErrorIs(t, wrapped, notFound)
+	assert.Equal(t, KindNotFound, KindOf(wrapped))
+	assert.Equal(t, "EPIC_NOT_FOUND", CodeOf(wrapped))
+	assert.Equal(t, http.StatusNotFound, HTTPStatus(wrapped))
+
+	plain := errors.New("boom")
+	assert.Equal(t, KindInternal, KindOf(plain))
+	assert.Equal(t, CodeInternal, CodeOf(plain))
+	assert.Equal(t, http.StatusInternalServerError, HTTPStatus(plain))
+}
+
+func TestKindHTTPStatus(t *testing.T) {
+	statuses := map[Kind]int{
+		KindInternal:        http.StatusInternalServerError,
+		KindInvalid:         http.StatusBadRequest,
+		KindUnauthenticated: http.StatusUnauthorized,
+		KindForbidden:       http.StatusForbidden,
+		KindNotFound:        http.StatusNotFound,
+		KindConflict:        http.StatusConflict,
+		KindLocked:          http.StatusLocked,
+		KindUnprocessable:   http.StatusUnprocessableEntity,
+		KindUnavailable:     http.StatusServiceUnavailable,
+	}
+	for kind, status := range statuses {
+		assert.Equal(t, status, kind.HTTPStatus(), kind.String())
+	}
+}
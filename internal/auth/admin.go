@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"product-requirements-management/internal/models"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUserNotFound      = errors.New("user not found")
+	ErrUserAlreadyExists = errors.New("username or email already exists")
+	ErrInvalidRole       = errors.New("invalid role: use Administrator, User or Commenter")
+)
+
+// resetPasswordRequest applies the same password rules as ChangePasswordRequest
+type resetPasswordRequest struct {
+	NewPassword string `binding:"required,min=8"`
+}
+
+// UserAdmin performs operator-level user management directly against the database,
+// applying the same validation and password hashing as the authentication API
+type UserAdmin struct {
+	service *Service
+	db      *gorm.DB
+}
+
+// NewUserAdmin creates a new user administration instance
+func NewUserAdmin(service *Service, db *gorm.DB) *UserAdmin {
+	return &UserAdmin{
+		service: service,
+		db:      db,
+	}
+}
+
+// CreateUser creates a new user account
+func (a *UserAdmin) CreateUser(ctx context.Context, req CreateUserRequest) (*models.User, error) {
+	if err := binding.Validator.ValidateStruct(&req); err != nil {
+		return nil, err
+	}
+	if !req.Role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+
+	var count int64
+	if err := a.db.WithContext(ctx).Model(&models.User{}).
+		Where("username = ? OR email = ?", req.Username, req.Email).
+		Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to check existing users: %w", err)
+	}
+	if count > 0 {
+		return nil, ErrUserAlreadyExists
+	}
+
+	passwordHash, err := a.service.HashPassword(req.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	user := &models.User{
+		ID:           uuid.New(),
+		Username:     req.Username,
+		Email:        req.Email,
+		PasswordHash: passwordHash,
+		Role:         req.Role,
+	}
+	if err := a.db.WithContext(ctx).Create(user).Error; err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return user, nil
+}
+
+// ListUsers retrieves all users ordered by username
+func (a *UserAdmin) ListUsers(ctx context.Context) ([]models.User, error) {
+	var users []models.User
+	if err := a.db.WithContext(ctx).Order("username").Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return users, nil
+}
+
+// ResetPassword sets a new password for a user and ends their sessions
+func (a *UserAdmin) ResetPassword(ctx context.Context, identifier, newPassword string) (*models.User, error) {
+	if err := binding.Validator.ValidateStruct(&resetPasswordRequest{NewPassword: newPassword}); err != nil {
+		return nil, err
+	}
+
+	user, err := a.findUser(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := a.service.HashPassword(newPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	if err := a.db.WithContext(ctx).Model(user).Update("password_hash", passwordHash).Error; err != nil {
+		return nil, fmt.Errorf("failed to update password: %w", err)
+	}
+
+	if err := a.service.RevokeAllRefreshTokens(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to expire sessions: %w", err)
+	}
+	return user, nil
+}
+
+// SetRole changes the role of a user
+func (a *UserAdmin) SetRole(ctx context.Context, identifier string, role models.UserRole) (*models.User, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+
+	user, err := a.findUser(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.db.WithContext(ctx).Model(user).Update("role", role).Error; err != nil {
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+	return user, nil
+}
+
+// Deactivate blocks a user from logging in and ends their sessions
+// Entities created by or assigned to the user are kept
+func (a *UserAdmin) Deactivate(ctx context.Context, identifier string) (*models.User, error) {
+	user, err := a.findUser(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsActive() {
+		if err := a.db.WithContext(ctx).Model(user).Update("deactivated_at", time.Now()).Error; err != nil {
+			return nil, fmt.Errorf("failed to deactivate user: %w", err)
+		}
+	}
+
+	if err := a.service.RevokeAllRefreshTokens(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to expire sessions: %w", err)
+	}
+	return user, nil
+}
+
+// Reactivate allows a deactivated user to log in again
+func (a *UserAdmin) Reactivate(ctx context.Context, identifier string) (*models.User, error) {
+	user, err := a.findUser(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.db.WithContext(ctx).Model(user).Update("deactivated_at", nil).Error; err != nil {
+		return nil, fmt.Errorf("failed to reactivate user: %w", err)
+	}
+	return user, nil
+}
+
+// ExpireSessions revokes all refresh tokens of a user
+// Access tokens already issued stay valid until they expire
+func (a *UserAdmin) ExpireSessions(ctx context.Context, identifier string) (*models.User, error) {
+	user, err := a.findUser(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.service.RevokeAllRefreshTokens(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("failed to expire sessions: %w", err)
+	}
+	return user, nil
+}
+
+// findUser looks up a user by UUID, username or email
+func (a *UserAdmin) findUser(ctx context.Context, identifier string) (*models.User, error) {
+	query := a.db.WithContext(ctx)
+	if id, err := uuid.Parse(identifier); err == nil {
+		query = query.Where("id = ?", id)
+	} else {
+		query = query.Where("username = ? OR email = ?", identifier, identifier)
+	}
+
+	var user models.User
+	if err := query.First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return &user, nil
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+)
+
+func setupUserAdmin(t *testing.T) (*UserAdmin, *Service) {
+	db := setupTestDB(t)
+	service := NewService("test-secret", time.Hour, &mockRefreshTokenRepository{db: db})
+	return NewUserAdmin(service, db), service
+}
+
+func TestUserAdmin_CreateUser(t *testing.T) {
+	admin, service := setupUserAdmin(t)
+	ctx := context.Background()
+
+	user, err := admin.CreateUser(ctx, CreateUserRequest{
+		Username: "operator",
+		Email:    "operator@example.com",
+		Password: "securepass123",
+		Role:     models.RoleAdministrator,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleAdministrator, user.Role)
+	assert.NoError(t, service.VerifyPassword("securepass123", user.PasswordHash))
+
+	tests := []struct {
+		name     string
+		req      CreateUserRequest
+		expected error
+	}{
+		{"duplicate username", CreateUserRequest{Username: "operator", Email: "other@example.com", Password: "securepass123", Role: models.RoleUser}, ErrUserAlreadyExists},
+		{"invalid role", CreateUserRequest{Username: "someone", Email: "someone@example.com", Password: "securepass123", Role: "Owner"}, ErrInvalidRole},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := admin.CreateUser(ctx, tt.req)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	_, err = admin.CreateUser(ctx, CreateUserRequest{Username: "short", Email: "short@example.com", Password: "short", Role: models.RoleUser})
+	assert.Error(t, err)
+
+	_, err = admin.CreateUser(ctx, CreateUserRequest{Username: "bademail", Email: "not-an-email", Password: "securepass123", Role: models.RoleUser})
+	assert.Error(t, err)
+}
+
+func TestUserAdmin_DeactivateExpiresSessions(t *testing.T) {
+	admin, service := setupUserAdmin(t)
+	ctx := context.Background()
+
+	user, err := admin.CreateUser(ctx, CreateUserRequest{
+		Username: "leaver",
+		Email:    "leaver@example.com",
+		Password: "securepass123",
+		Role:     models.RoleUser,
+	})
+	require.NoError(t, err)
+
+	refreshToken, err := service.GenerateRefreshToken(ctx, user)
+	require.NoError(t, err)
+
+	_, err = admin.Deactivate(ctx, "leaver@example.com")
+	require.NoError(t, err)
+
+	_, _, err = service.ValidateRefreshToken(ctx, refreshToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	users, err := admin.ListUsers(ctx)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.False(t, users[0].IsActive())
+
+	// Refresh tokens are rejected while the account is deactivated
+	staleToken, err := service.GenerateRefreshToken(ctx, user)
+	require.NoError(t, err)
+	_, _, err = service.ValidateRefreshToken(ctx, staleToken)
+	assert.ErrorIs(t, err, ErrUserDeactivated)
+
+	_, err = admin.Reactivate(ctx, user.ID.String())
+	require.NoError(t, err)
+	users, err = admin.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.True(t, users[0].IsActive())
+}
+
+func TestUserAdmin_ResetPasswordAndPromote(t *testing.T) {
+	admin, service := setupUserAdmin(t)
+	ctx := context.Background()
+
+	_, err := admin.CreateUser(ctx, CreateUserRequest{
+		Username: "forgetful",
+		Email:    "forgetful@example.com",
+		Password: "securepass123",
+		Role:     models.RoleUser,
+	})
+	require.NoError(t, err)
+
+	_, err = admin.ResetPassword(ctx, "forgetful", "short")
+	assert.Error(t, err)
+
+	_, err = admin.ResetPassword(ctx, "forgetful", "brandnewpass")
+	require.NoError(t, err)
+
+	_, err = admin.SetRole(ctx, "forgetful", models.RoleAdministrator)
+	require.NoError(t, err)
+
+	users, err := admin.ListUsers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleAdministrator, users[0].Role)
+	assert.NoError(t, service.VerifyPassword("brandnewpass", users[0].PasswordHash))
+
+	_, err = admin.ExpireSessions(ctx, "nobody")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
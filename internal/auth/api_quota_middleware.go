@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EnforceAPIQuota creates middleware that counts authenticated requests per user and token and rejects them
+// with 429 once the daily quota of the user is used up. It must run after Authorize; requests to public routes
+// are neither counted nor limited. Failures to count a request are logged and let the request through.
+func EnforceAPIQuota(usageService service.APIUsageService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		claims, ok := GetCurrentUser(c)
+		if !ok {
+			c.Next()
+			return
+		}
+		userID, err := uuid.Parse(claims.UserID)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		req := service.APIRequest{UserID: userID, Role: claims.Role, At: time.Now()}
+		if patID, ok := GetPATID(c); ok {
+			req.TokenID = &patID
+		}
+
+		status, err := usageService.RecordRequest(ctx, req)
+		if err != nil {
+			if logger.Logger != nil {
+				logger.WithFields(map[string]interface{}{
+					"component": "api_quota",
+					"user_id":   claims.UserID,
+					"error":     err.Error(),
+				}).Error("Failed to record API request for quota enforcement")
+			}
+			c.Next()
+			return
+		}
+
+		if status.Limited {
+			c.Header("X-RateLimit-Limit", strconv.FormatInt(status.Limit, 10))
+			c.Header("X-RateLimit-Remaining", strconv.FormatInt(status.Remaining, 10))
+			c.Header("X-RateLimit-Reset", strconv.FormatInt(status.ResetAt.Unix(), 10))
+		}
+		if status.Exceeded {
+			retryAfter := int64(math.Ceil(time.Until(status.ResetAt).Seconds()))
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+			c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+
+			message := fmt.Sprintf("Daily API quota of %d requests exceeded", status.Limit)
+			if status.Scope == service.APIQuotaScopePATDaily {
+				message = fmt.Sprintf("Daily quota of %d requests with personal access tokens exceeded", status.Limit)
+			}
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error": gin.H{
+					"code":     "QUOTA_EXCEEDED",
+					"message":  message + ". The quota resets at " + status.ResetAt.Format(time.RFC3339) + ".",
+					"scope":    status.Scope,
+					"limit":    status.Limit,
+					"reset_at": status.ResetAt,
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAPIUsageService returns a fixed quota status and records the requests it was asked to count
+type fakeAPIUsageService struct {
+	service.APIUsageService
+	status   *service.APIQuotaStatus
+	err      error
+	requests []service.APIRequest
+}
+
+func (f *fakeAPIUsageService) RecordRequest(ctx context.Context, req service.APIRequest) (*service.APIQuotaStatus, error) {
+	f.requests = append(f.requests, req)
+	return f.status, f.err
+}
+
+func TestEnforceAPIQuota(t *testing.T) {
+	_, authService, policies := setupPolicyRouter(t)
+	usage := &fakeAPIUsageService{}
+
+	router := gin.New()
+	app := router.Group("", authService.Authorize(policies, nil), EnforceAPIQuota(usage))
+	app.GET("/public", func(c *gin.Context) { c.Status(http.StatusOK) })
+	app.GET("/entities/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	get := func(path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	token := tokenForRole(t, authService, models.RoleUser)
+	resetAt := time.Now().UTC().Add(90 * time.Minute).Truncate(time.Second)
+
+	t.Run("public requests are not counted", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, get("/public", "").Code)
+		assert.Empty(t, usage.requests)
+	})
+
+	t.Run("counts requests and reports the remaining quota", func(t *testing.T) {
+		usage.status = &service.APIQuotaStatus{Limited: true, Scope: service.APIQuotaScopeDaily, Limit: 100, Remaining: 41, ResetAt: resetAt}
+		w := get("/entities/1", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "100", w.Header().Get("X-RateLimit-Limit"))
+		assert.Equal(t, "41", w.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, strconv.FormatInt(resetAt.Unix(), 10), w.Header().Get("X-RateLimit-Reset"))
+		assert.Len(t, usage.requests, 1)
+		assert.Equal(t, models.RoleUser, usage.requests[0].Role)
+		assert.NotEqual(t, uuid.Nil, usage.requests[0].UserID)
+		assert.Nil(t, usage.requests[0].TokenID)
+	})
+
+	t.Run("rejects requests once the quota is used up", func(t *testing.T) {
+		usage.status = &service.APIQuotaStatus{Limited: true, Exceeded: true, Scope: service.APIQuotaScopePATDaily, Limit: 2, ResetAt: resetAt}
+		w := get("/entities/1", token)
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"QUOTA_EXCEEDED"`)
+		assert.Contains(t, w.Body.String(), "Daily quota of 2 requests with personal access tokens exceeded")
+		assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+		retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+		assert.NoError(t, err)
+		assert.InDelta(t, 90*60, retryAfter, 5)
+	})
+
+	t.Run("lets requests through when they cannot be counted", func(t *testing.T) {
+		usage.status, usage.err = nil, errors.New("database unavailable")
+		assert.Equal(t, http.StatusOK, get("/entities/1", token).Code)
+	})
+}
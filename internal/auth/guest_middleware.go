@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// guestRoutes are the routes a guest token may use; the :id of epic routes must be the epic of the invitation
+var guestRoutes = map[string]bool{
+	policyKey(http.MethodGet, "/auth/profile"):                             true,
+	policyKey(http.MethodGet, "/api/v1/epics/:id"):                         true,
+	policyKey(http.MethodGet, "/api/v1/epics/:id/comments"):                true,
+	policyKey(http.MethodPost, "/api/v1/epics/:id/comments"):               true,
+	policyKey(http.MethodPost, "/api/v1/epics/:id/comments/inline"):        true,
+	policyKey(http.MethodGet, "/api/v1/epics/:id/comments/inline/visible"): true,
+	policyKey(http.MethodGet, "/api/v1/epics/:id/comments/lock"):           true,
+	policyKey(http.MethodPut, "/api/v1/epics/:id/comments/draft"):          true,
+	policyKey(http.MethodGet, "/api/v1/epics/:id/comments/draft"):          true,
+	policyKey(http.MethodDelete, "/api/v1/epics/:id/comments/draft"):       true,
+	policyKey(http.MethodGet, "/api/v1/users/me/comment-drafts"):           true,
+}
+
+// RestrictGuests creates middleware that limits guest tokens to reading and commenting on the epic of their
+// invitation, and rejects them once the invitation is revoked or expired. It must run after Authorize;
+// requests without a guest token pass through.
+func RestrictGuests(guestInvitationService service.GuestInvitationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		claims, ok := GetCurrentUser(c)
+		if !ok || !claims.IsGuest() {
+			c.Next()
+			return
+		}
+
+		invitationID, err := uuid.Parse(claims.GuestInvitationID)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			c.Abort()
+			return
+		}
+		invitation, err := guestInvitationService.CheckAccess(ctx, invitationID, time.Now())
+		if err != nil {
+			if errors.Is(err, service.ErrGuestInvitationInvalid) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Guest invitation revoked or expired"})
+			} else {
+				if logger.Logger != nil {
+					logger.WithFields(map[string]interface{}{
+						"component":     "guest_access",
+						"invitation_id": claims.GuestInvitationID,
+						"error":         err.Error(),
+					}).Error("Failed to check guest invitation")
+				}
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check guest access"})
+			}
+			c.Abort()
+			return
+		}
+
+		if !guestRoutes[policyKey(c.Request.Method, c.FullPath())] {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Guests can only read and comment on the epic they were invited to"})
+			c.Abort()
+			return
+		}
+		if id := c.Param("id"); id != "" && id != invitation.EpicID.String() &&
+			(invitation.Epic == nil || !strings.EqualFold(id, invitation.Epic.ReferenceID)) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Guests can only read and comment on the epic they were invited to"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGuestInvitationService grants access for the invitations it holds
+type fakeGuestInvitationService struct {
+	service.GuestInvitationService
+	invitations map[uuid.UUID]*models.GuestInvitation
+}
+
+func (f *fakeGuestInvitationService) CheckAccess(ctx context.Context, invitationID uuid.UUID, now time.Time) (*models.GuestInvitation, error) {
+	invitation, ok := f.invitations[invitationID]
+	if !ok || !invitation.IsActive(now) {
+		return nil, service.ErrGuestInvitationInvalid
+	}
+	return invitation, nil
+}
+
+func TestRestrictGuests(t *testing.T) {
+	logger.Init(&config.LogConfig{Level: "error", Format: "text"})
+	_, authService, policies := setupPolicyRouter(t)
+	policies.Require(http.MethodGet, "/api/v1/epics/:id", models.RoleCommenter)
+	policies.Require(http.MethodPost, "/api/v1/epics/:id/comments", models.RoleCommenter)
+	policies.Require(http.MethodGet, "/api/v1/epics/:id/user-stories", models.RoleCommenter)
+
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001"}
+	guest := &models.User{ID: uuid.New(), Username: "guest-1", Role: models.RoleCommenter}
+	active := &models.GuestInvitation{ID: uuid.New(), EpicID: epic.ID, Epic: epic, GuestID: guest.ID, ExpiresAt: time.Now().Add(time.Hour)}
+	revoked := &models.GuestInvitation{ID: uuid.New(), EpicID: epic.ID, Epic: epic, GuestID: guest.ID, ExpiresAt: time.Now().Add(time.Hour)}
+	guests := &fakeGuestInvitationService{invitations: map[uuid.UUID]*models.GuestInvitation{active.ID: active}}
+
+	router := gin.New()
+	app := router.Group("", authService.Authorize(policies, nil), RestrictGuests(guests))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	app.GET("/entities/:id", ok)
+	app.GET("/api/v1/epics/:id", ok)
+	app.POST("/api/v1/epics/:id/comments", ok)
+	app.GET("/api/v1/epics/:id/user-stories", ok)
+
+	send := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	token, err := authService.GenerateGuestToken(active, guest)
+	require.NoError(t, err)
+
+	t.Run("guest tokens expire with the invitation", func(t *testing.T) {
+		claims, err := authService.ValidateToken(token)
+		require.NoError(t, err)
+		assert.True(t, claims.IsGuest())
+		assert.Equal(t, models.RoleCommenter, claims.Role)
+		assert.Equal(t, active.ID.String(), claims.GuestInvitationID)
+		assert.Equal(t, active.ExpiresAt.Unix(), claims.ExpiresAt.Unix())
+	})
+
+	t.Run("allows reading and commenting on the epic", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/api/v1/epics/"+epic.ID.String(), token).Code)
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/api/v1/epics/ep-001", token).Code)
+		assert.Equal(t, http.StatusOK, send(http.MethodPost, "/api/v1/epics/EP-001/comments", token).Code)
+	})
+
+	t.Run("rejects other epics and routes", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, send(http.MethodGet, "/api/v1/epics/EP-002", token).Code)
+		assert.Equal(t, http.StatusForbidden, send(http.MethodGet, "/api/v1/epics/EP-001/user-stories", token).Code)
+		assert.Equal(t, http.StatusForbidden, send(http.MethodGet, "/entities/1", token).Code)
+	})
+
+	t.Run("rejects tokens of revoked invitations", func(t *testing.T) {
+		revokedToken, err := authService.GenerateGuestToken(revoked, guest)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, send(http.MethodGet, "/api/v1/epics/EP-001", revokedToken).Code)
+	})
+
+	t.Run("does not restrict other tokens", func(t *testing.T) {
+		userToken, err := authService.GenerateToken(&models.User{ID: uuid.New(), Username: "user", Role: models.RoleUser})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/api/v1/epics/EP-002", userToken).Code)
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/entities/1", userToken).Code)
+	})
+}
@@ -67,6 +67,7 @@ type UserResponse struct {
 	Username  string          `json:"username" example:"john_doe"`                       // Unique username
 	Email     string          `json:"email" example:"john.doe@example.com"`              // User email address
 	Role      models.UserRole `json:"role" example:"User"`                               // User role determining permissions
+	Team      *string         `json:"team,omitempty" example:"Payments"`                 // Team the user belongs to (omitted when not set)
 	CreatedAt time.Time       `json:"created_at" example:"2023-01-01T00:00:00Z"`         // Account creation timestamp
 	UpdatedAt time.Time       `json:"updated_at" example:"2023-01-02T12:30:00Z"`         // Last account update timestamp
 }
@@ -143,6 +144,11 @@ func (h *Handlers) Login(c *gin.Context) {
 		return
 	}
 
+	if !user.IsActive() {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Account is deactivated"})
+		return
+	}
+
 	token, err := h.service.GenerateToken(&user)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
@@ -164,6 +170,7 @@ func (h *Handlers) Login(c *gin.Context) {
 			Username:  user.Username,
 			Email:     user.Email,
 			Role:      user.Role,
+			Team:      user.Team,
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
 		},
@@ -210,6 +217,15 @@ func (h *Handlers) RefreshToken(c *gin.Context) {
 			})
 			return
 		}
+		if err == ErrUserDeactivated {
+			c.JSON(http.StatusUnauthorized, ErrorResponse{
+				Error: ErrorDetail{
+					Code:    "USER_DEACTIVATED",
+					Message: "Account is deactivated",
+				},
+			})
+			return
+		}
 		c.JSON(http.StatusUnauthorized, ErrorResponse{
 			Error: ErrorDetail{
 				Code:    "INVALID_REFRESH_TOKEN",
@@ -301,7 +317,7 @@ func (h *Handlers) CreateUser(c *gin.Context) {
 	}
 
 	// Validate role
-	if req.Role != models.RoleAdministrator && req.Role != models.RoleUser && req.Role != models.RoleCommenter {
+	if !req.Role.IsValid() {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid role"})
 		return
 	}
@@ -331,6 +347,7 @@ func (h *Handlers) CreateUser(c *gin.Context) {
 		Username:  user.Username,
 		Email:     user.Email,
 		Role:      user.Role,
+		Team:      user.Team,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -363,6 +380,7 @@ func (h *Handlers) GetUsers(c *gin.Context) {
 			Username:  user.Username,
 			Email:     user.Email,
 			Role:      user.Role,
+			Team:      user.Team,
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
 		})
@@ -402,6 +420,7 @@ func (h *Handlers) GetUser(c *gin.Context) {
 		Username:  user.Username,
 		Email:     user.Email,
 		Role:      user.Role,
+		Team:      user.Team,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -454,7 +473,7 @@ func (h *Handlers) UpdateUser(c *gin.Context) {
 	}
 	if req.Role != "" {
 		// Validate role
-		if req.Role != models.RoleAdministrator && req.Role != models.RoleUser && req.Role != models.RoleCommenter {
+		if !req.Role.IsValid() {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid role"})
 			return
 		}
@@ -471,6 +490,7 @@ func (h *Handlers) UpdateUser(c *gin.Context) {
 		Username:  user.Username,
 		Email:     user.Email,
 		Role:      user.Role,
+		Team:      user.Team,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -520,6 +540,18 @@ func (h *Handlers) DeleteUser(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+// JWKS handles publishing the public keys used to sign access tokens
+// @Summary Get JSON Web Key Set
+// @Description Get the public keys (RS256 or EdDSA) that access tokens are signed with, including previous keys whose tokens are still valid. The set is empty when tokens are signed with a shared HS256 secret.
+// @Tags authentication
+// @Produce json
+// @Success 200 {object} JWKS "JSON Web Key Set"
+// @Router /.well-known/jwks.json [get]
+func (h *Handlers) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.service.KeySet().JWKS())
+}
+
 // GetProfile handles getting current user profile
 // @Summary Get current user profile
 // @Description Get authenticated user's profile information
@@ -553,6 +585,7 @@ func (h *Handlers) GetProfile(c *gin.Context) {
 		Username:  user.Username,
 		Email:     user.Email,
 		Role:      user.Role,
+		Team:      user.Team,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
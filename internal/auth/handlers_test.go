@@ -2,6 +2,7 @@ package auth
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -48,41 +49,41 @@ type mockRefreshTokenRepository struct {
 	db *gorm.DB
 }
 
-func (m *mockRefreshTokenRepository) Create(token *models.RefreshToken) error {
+func (m *mockRefreshTokenRepository) Create(ctx context.Context, token *models.RefreshToken) error {
 	return m.db.Create(token).Error
 }
 
-func (m *mockRefreshTokenRepository) FindByTokenHash(tokenHash string) (*models.RefreshToken, error) {
+func (m *mockRefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
 	var token models.RefreshToken
 	err := m.db.Where("token_hash = ?", tokenHash).First(&token).Error
 	return &token, err
 }
 
-func (m *mockRefreshTokenRepository) FindByUserID(userID uuid.UUID) ([]*models.RefreshToken, error) {
+func (m *mockRefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.RefreshToken, error) {
 	var tokens []*models.RefreshToken
 	err := m.db.Where("user_id = ?", userID).Find(&tokens).Error
 	return tokens, err
 }
 
-func (m *mockRefreshTokenRepository) FindAll() ([]*models.RefreshToken, error) {
+func (m *mockRefreshTokenRepository) FindAll(ctx context.Context) ([]*models.RefreshToken, error) {
 	var tokens []*models.RefreshToken
 	err := m.db.Find(&tokens).Error
 	return tokens, err
 }
 
-func (m *mockRefreshTokenRepository) Update(token *models.RefreshToken) error {
+func (m *mockRefreshTokenRepository) Update(ctx context.Context, token *models.RefreshToken) error {
 	return m.db.Save(token).Error
 }
 
-func (m *mockRefreshTokenRepository) Delete(id uuid.UUID) error {
+func (m *mockRefreshTokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return m.db.Delete(&models.RefreshToken{}, "id = ?", id).Error
 }
 
-func (m *mockRefreshTokenRepository) DeleteByUserID(userID uuid.UUID) error {
+func (m *mockRefreshTokenRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
 	return m.db.Delete(&models.RefreshToken{}, "user_id = ?", userID).Error
 }
 
-func (m *mockRefreshTokenRepository) DeleteExpired() (int64, error) {
+func (m *mockRefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
 	result := m.db.Where("expires_at < ?", time.Now()).Delete(&models.RefreshToken{})
 	return result.RowsAffected, result.Error
 }
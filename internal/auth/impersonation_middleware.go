@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// impersonationBlockedRoutes are the routes an impersonation token may not use: they would change
+// the credentials of the impersonated user, hand out credentials that outlive the session, such as
+// feed tokens and guest invitations, or set up report deliveries that keep running after it
+var impersonationBlockedRoutes = map[string]bool{
+	policyKey(http.MethodPost, "/auth/change-password"):                     true,
+	policyKey(http.MethodPost, "/api/v1/pats"):                              true,
+	policyKey(http.MethodDelete, "/api/v1/pats/:id"):                        true,
+	policyKey(http.MethodPost, "/api/v1/users/me/calendar-feeds"):           true,
+	policyKey(http.MethodPost, "/api/v1/users/me/change-feeds"):             true,
+	policyKey(http.MethodPost, "/api/v1/epics/:id/invitations"):             true,
+	policyKey(http.MethodPost, "/api/v1/reports/:id/schedules"):             true,
+	policyKey(http.MethodPut, "/api/v1/reports/:id/schedules/:schedule_id"): true,
+}
+
+// AuditImpersonation creates middleware that records every request made with an impersonation token,
+// including requests rejected by authorization, in the impersonation session and the security log.
+// It must run before Authorize so that it sees the response to rejected requests.
+// Failures to record a request are logged and do not affect the response.
+func AuditImpersonation(impersonationService service.ImpersonationService) gin.HandlerFunc {
+	securityLogger := NewSecurityLogger()
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		c.Next()
+
+		claims, ok := GetCurrentUser(c)
+		if !ok || !claims.IsImpersonation() {
+			return
+		}
+		sessionID, err := uuid.Parse(claims.ImpersonationID)
+		if err != nil {
+			return
+		}
+
+		path := c.Request.URL.RequestURI()
+		status := c.Writer.Status()
+		securityLogger.LogImpersonatedRequest(c.Request.Context(), claims, c.Request.Method, path, status, c.ClientIP())
+		if err := impersonationService.RecordRequest(ctx, sessionID, c.Request.Method, path, status, time.Now()); err != nil {
+			if logger.Logger != nil {
+				logger.WithFields(map[string]interface{}{
+					"component":  "impersonation",
+					"session_id": claims.ImpersonationID,
+					"error":      err.Error(),
+				}).Error("Failed to record impersonated request")
+			}
+		}
+	}
+}
+
+// impersonationAllowed reports whether an impersonation token may be used for a route
+func impersonationAllowed(method, path string) bool {
+	return !impersonationBlockedRoutes[policyKey(method, path)]
+}
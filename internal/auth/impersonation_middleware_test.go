@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeImpersonationService records the impersonated requests it was asked to record
+type fakeImpersonationService struct {
+	service.ImpersonationService
+	requests []models.ImpersonatedRequest
+}
+
+func (f *fakeImpersonationService) RecordRequest(ctx context.Context, sessionID uuid.UUID, method, path string, statusCode int, at time.Time) error {
+	f.requests = append(f.requests, models.ImpersonatedRequest{SessionID: sessionID, Method: method, Path: path, StatusCode: statusCode, CreatedAt: at})
+	return nil
+}
+
+func TestAuditImpersonation(t *testing.T) {
+	logger.Init(&config.LogConfig{Level: "error", Format: "text"})
+	_, authService, policies := setupPolicyRouter(t)
+	policies.Require(http.MethodPost, "/auth/change-password", models.RoleCommenter)
+	policies.Require(http.MethodPost, "/api/v1/users/me/calendar-feeds", models.RoleCommenter)
+	policies.Require(http.MethodPost, "/api/v1/users/me/change-feeds", models.RoleCommenter)
+	policies.Require(http.MethodPost, "/api/v1/epics/:id/invitations", models.RoleCommenter)
+	policies.Require(http.MethodPost, "/api/v1/reports/:id/schedules", models.RoleCommenter)
+	policies.Require(http.MethodPut, "/api/v1/reports/:id/schedules/:schedule_id", models.RoleCommenter)
+	impersonation := &fakeImpersonationService{}
+
+	router := gin.New()
+	app := router.Group("", AuditImpersonation(impersonation), authService.Authorize(policies, nil))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	app.GET("/entities/:id", ok)
+	app.PUT("/entities/:id", ok)
+	app.POST("/auth/change-password", ok)
+	app.POST("/api/v1/users/me/calendar-feeds", ok)
+	app.POST("/api/v1/users/me/change-feeds", ok)
+	app.POST("/api/v1/epics/:id/invitations", ok)
+	app.POST("/api/v1/reports/:id/schedules", ok)
+	app.PUT("/api/v1/reports/:id/schedules/:schedule_id", ok)
+
+	send := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	now := time.Now()
+	session := &models.ImpersonationSession{ID: uuid.New(), AdminID: uuid.New(), UserID: uuid.New(), StartedAt: now, ExpiresAt: now.Add(time.Hour)}
+	user := &models.User{ID: session.UserID, Username: "commenter", Role: models.RoleCommenter}
+	token, err := authService.GenerateImpersonationToken(session, user)
+	require.NoError(t, err)
+
+	t.Run("impersonation tokens act as the user", func(t *testing.T) {
+		claims, err := authService.ValidateToken(token)
+		require.NoError(t, err)
+		assert.True(t, claims.IsImpersonation())
+		assert.Equal(t, user.ID.String(), claims.UserID)
+		assert.Equal(t, models.RoleCommenter, claims.Role)
+		assert.Equal(t, session.AdminID.String(), claims.ImpersonatorID)
+		assert.Equal(t, session.ExpiresAt.Unix(), claims.ExpiresAt.Unix())
+	})
+
+	t.Run("records requests including rejected ones", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/entities/1?include=risk", token).Code)
+		assert.Equal(t, http.StatusForbidden, send(http.MethodPut, "/entities/1", token).Code)
+		require.Len(t, impersonation.requests, 2)
+		assert.Equal(t, session.ID, impersonation.requests[0].SessionID)
+		assert.Equal(t, "/entities/1?include=risk", impersonation.requests[0].Path)
+		assert.Equal(t, http.StatusOK, impersonation.requests[0].StatusCode)
+		assert.Equal(t, http.MethodPut, impersonation.requests[1].Method)
+		assert.Equal(t, http.StatusForbidden, impersonation.requests[1].StatusCode)
+	})
+
+	t.Run("blocks credential changes", func(t *testing.T) {
+		w := send(http.MethodPost, "/auth/change-password", token)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "Not allowed while impersonating a user")
+		assert.Len(t, impersonation.requests, 3)
+	})
+
+	t.Run("blocks feed tokens that outlive the session", func(t *testing.T) {
+		for _, path := range []string{"/api/v1/users/me/calendar-feeds", "/api/v1/users/me/change-feeds"} {
+			w := send(http.MethodPost, path, token)
+			assert.Equal(t, http.StatusForbidden, w.Code, path)
+			assert.Contains(t, w.Body.String(), "Not allowed while impersonating a user", path)
+		}
+		assert.Len(t, impersonation.requests, 5)
+	})
+
+	t.Run("blocks guest invitations", func(t *testing.T) {
+		w := send(http.MethodPost, "/api/v1/epics/1/invitations", token)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "Not allowed while impersonating a user")
+		assert.Len(t, impersonation.requests, 6)
+	})
+
+	t.Run("blocks report schedules that outlive the session", func(t *testing.T) {
+		for _, r := range []struct{ method, path string }{
+			{http.MethodPost, "/api/v1/reports/1/schedules"},
+			{http.MethodPut, "/api/v1/reports/1/schedules/2"},
+		} {
+			w := send(r.method, r.path, token)
+			assert.Equal(t, http.StatusForbidden, w.Code, r.path)
+			assert.Contains(t, w.Body.String(), "Not allowed while impersonating a user", r.path)
+		}
+		assert.Len(t, impersonation.requests, 8)
+	})
+
+	t.Run("ignores regular tokens", func(t *testing.T) {
+		regular := tokenForRole(t, authService, models.RoleCommenter)
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/entities/1", regular).Code)
+		assert.Equal(t, http.StatusOK, send(http.MethodPost, "/auth/change-password", regular).Code)
+		assert.Equal(t, http.StatusOK, send(http.MethodPost, "/api/v1/users/me/calendar-feeds", regular).Code)
+		assert.Equal(t, http.StatusOK, send(http.MethodPost, "/api/v1/epics/1/invitations", regular).Code)
+		assert.Equal(t, http.StatusOK, send(http.MethodPost, "/api/v1/reports/1/schedules", regular).Code)
+		assert.Len(t, impersonation.requests, 8)
+	})
+}
@@ -0,0 +1,328 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"product-requirements-management/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported access token signing algorithms
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// minRSAKeyBits is the smallest RSA key accepted for signing or verification
+const minRSAKeyBits = 2048
+
+var ErrUnsupportedKey = errors.New("unsupported key: use an RSA (2048 bits or more) or Ed25519 key in PEM format")
+
+// SigningKey is a key used to sign or verify access tokens
+type SigningKey struct {
+	ID     string
+	Method jwt.SigningMethod
+
+	signKey   interface{} // nil for keys that are only accepted for verification
+	verifyKey interface{}
+}
+
+// KeySet holds the key new access tokens are signed with and the previous keys whose
+// tokens are still accepted, so keys can be rotated without logging out every user
+type KeySet struct {
+	current  *SigningKey
+	previous []*SigningKey
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewHMACKey creates an HS256 key from a shared secret
+// The key ID is derived from the secret so it stays stable across restarts
+func NewHMACKey(secret []byte) *SigningKey {
+	sum := sha256.Sum256(secret)
+	return &SigningKey{
+		ID:        "hs256-" + hex.EncodeToString(sum[:8]),
+		Method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+	}
+}
+
+// ParsePrivateKeyPEM parses an RSA (RS256) or Ed25519 (EdDSA) private key
+// The key ID is the RFC 7638 thumbprint of the public key
+func ParsePrivateKeyPEM(data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrUnsupportedKey)
+	}
+
+	var privateKey interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: unexpected PEM block %q", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return newAsymmetricKey(key, &key.PublicKey)
+	case ed25519.PrivateKey:
+		return newAsymmetricKey(key, key.Public())
+	default:
+		return nil, ErrUnsupportedKey
+	}
+}
+
+// ParseVerificationKeyPEM parses a public key, or the public part of a private key,
+// that is accepted for verifying tokens but never used for signing
+func ParseVerificationKeyPEM(data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrUnsupportedKey)
+	}
+
+	var publicKey interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY", "PRIVATE KEY":
+		key, err := ParsePrivateKeyPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		key.signKey = nil
+		return key, nil
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: unexpected PEM block %q", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	return newAsymmetricKey(nil, publicKey)
+}
+
+// newAsymmetricKey builds a signing key for an RSA or Ed25519 key pair
+func newAsymmetricKey(privateKey crypto.PrivateKey, publicKey crypto.PublicKey) (*SigningKey, error) {
+	key := &SigningKey{verifyKey: publicKey}
+	if privateKey != nil {
+		key.signKey = privateKey
+	}
+
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSAKeyBits {
+			return nil, ErrUnsupportedKey
+		}
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, ErrUnsupportedKey
+	}
+
+	key.ID = key.jwk().thumbprint()
+	return key, nil
+}
+
+// NewKeySet creates a key set that signs with current and also accepts tokens signed with previous keys
+func NewKeySet(current *SigningKey, previous ...*SigningKey) *KeySet {
+	return &KeySet{
+		current:  current,
+		previous: previous,
+	}
+}
+
+// NewKeySetFromConfig builds the key set from the JWT configuration
+//
+// With HS256 tokens are signed with JWT_SECRET; with RS256 or EdDSA they are signed with the key
+// in JWT_PRIVATE_KEY_FILE. Tokens signed with JWT_PREVIOUS_SECRET or any key in JWT_PREVIOUS_KEY_FILES
+// stay valid until they expire, which allows rotating keys or switching algorithms without a logout.
+func NewKeySetFromConfig(cfg config.JWTConfig) (*KeySet, error) {
+	var current *SigningKey
+	switch strings.ToUpper(cfg.Algorithm) {
+	case "", AlgorithmHS256:
+		if cfg.Secret == "" {
+			return nil, errors.New("JWT_SECRET is required for HS256")
+		}
+		current = NewHMACKey([]byte(cfg.Secret))
+	case strings.ToUpper(AlgorithmRS256), strings.ToUpper(AlgorithmEdDSA):
+		if cfg.PrivateKeyFile == "" {
+			return nil, fmt.Errorf("JWT_PRIVATE_KEY_FILE is required for %s", cfg.Algorithm)
+		}
+		data, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWT private key: %w", err)
+		}
+		current, err = ParsePrivateKeyPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(current.Method.Alg(), cfg.Algorithm) {
+			return nil, fmt.Errorf("JWT private key is a %s key but JWT_ALGORITHM is %s", current.Method.Alg(), cfg.Algorithm)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported JWT_ALGORITHM %q: use HS256, RS256 or EdDSA", cfg.Algorithm)
+	}
+
+	var previous []*SigningKey
+	if cfg.PreviousSecret != "" {
+		previous = append(previous, NewHMACKey([]byte(cfg.PreviousSecret)))
+	}
+	for _, path := range strings.Split(cfg.PreviousKeyFiles, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read previous JWT key: %w", err)
+		}
+		key, err := ParseVerificationKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous JWT key %s: %w", path, err)
+		}
+		previous = append(previous, key)
+	}
+
+	return NewKeySet(current, previous...), nil
+}
+
+// Current returns the key new tokens are signed with
+func (ks *KeySet) Current() *SigningKey {
+	return ks.current
+}
+
+// all returns the current key followed by the previous keys
+func (ks *KeySet) all() []*SigningKey {
+	return append([]*SigningKey{ks.current}, ks.previous...)
+}
+
+// sign creates a signed token with the current key and its key ID in the header
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.current.Method, claims)
+	token.Header["kid"] = ks.current.ID
+	return token.SignedString(ks.current.signKey)
+}
+
+// verificationKey is a jwt.Keyfunc that selects the key by the token's key ID
+// Tokens issued before key IDs were introduced carry no kid and are checked against the HS256 keys
+func (ks *KeySet) verificationKey(token *jwt.Token) (interface{}, error) {
+	keys := ks.all()
+
+	kid, _ := token.Header["kid"].(string)
+	if kid != "" {
+		for _, key := range keys {
+			if key.ID == kid && key.Method.Alg() == token.Method.Alg() {
+				return key.verifyKey, nil
+			}
+		}
+		return nil, ErrInvalidToken
+	}
+
+	var legacyKeys jwt.VerificationKeySet
+	for _, key := range keys {
+		if key.Method == jwt.SigningMethodHS256 && token.Method.Alg() == AlgorithmHS256 {
+			legacyKeys.Keys = append(legacyKeys.Keys, key.verifyKey)
+		}
+	}
+	if len(legacyKeys.Keys) == 0 {
+		return nil, ErrInvalidToken
+	}
+	return legacyKeys, nil
+}
+
+// JWKS returns the public keys of the set; shared HS256 secrets are never published
+func (ks *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for _, key := range ks.all() {
+		if key.Method == jwt.SigningMethodHS256 {
+			continue
+		}
+		jwks.Keys = append(jwks.Keys, key.jwk())
+	}
+	return jwks
+}
+
+// jwk converts the public part of an asymmetric key to JWK format
+func (k *SigningKey) jwk() JWK {
+	jwk := JWK{
+		KeyID: k.ID,
+		Use:   "sig",
+	}
+	switch pub := k.verifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.Algorithm = AlgorithmRS256
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Algorithm = AlgorithmEdDSA
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// thumbprint computes the RFC 7638 JWK thumbprint from the required members in lexicographic order
+func (j JWK) thumbprint() string {
+	var members interface{}
+	switch j.KeyType {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{j.E, j.KeyType, j.N}
+	default:
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{j.Curve, j.KeyType, j.X}
+	}
+
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
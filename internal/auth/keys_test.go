@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/models"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateRSAKeyPEM(t *testing.T) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func generateEd25519KeyPEM(t *testing.T) []byte {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func writeKeyFile(t *testing.T, data []byte) string {
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func testUser() *models.User {
+	return &models.User{ID: uuid.New(), Username: "testuser", Role: models.RoleUser}
+}
+
+func TestKeySet_AsymmetricSigning(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyPEM    func(t *testing.T) []byte
+		algorithm string
+		keyType   string
+	}{
+		{"RS256", generateRSAKeyPEM, AlgorithmRS256, "RSA"},
+		{"EdDSA", generateEd25519KeyPEM, AlgorithmEdDSA, "OKP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePrivateKeyPEM(tt.keyPEM(t))
+			require.NoError(t, err)
+			service := NewServiceWithKeys(NewKeySet(key), time.Hour, nil)
+
+			tokenString, err := service.GenerateToken(testUser())
+			require.NoError(t, err)
+
+			token, _, err := jwt.NewParser().ParseUnverified(tokenString, &Claims{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.algorithm, token.Header["alg"])
+			assert.Equal(t, key.ID, token.Header["kid"])
+
+			claims, err := service.ValidateToken(tokenString)
+			require.NoError(t, err)
+			assert.Equal(t, "testuser", claims.Username)
+
+			jwks := service.KeySet().JWKS()
+			require.Len(t, jwks.Keys, 1)
+			assert.Equal(t, tt.keyType, jwks.Keys[0].KeyType)
+			assert.Equal(t, key.ID, jwks.Keys[0].KeyID)
+		})
+	}
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	oldKeyPEM := generateRSAKeyPEM(t)
+	oldKey, err := ParsePrivateKeyPEM(oldKeyPEM)
+	require.NoError(t, err)
+	oldService := NewServiceWithKeys(NewKeySet(oldKey), time.Hour, nil)
+	oldToken, err := oldService.GenerateToken(testUser())
+	require.NoError(t, err)
+
+	legacyToken, err := NewService("old-secret", time.Hour, nil).GenerateToken(testUser())
+	require.NoError(t, err)
+
+	newKey, err := ParsePrivateKeyPEM(generateEd25519KeyPEM(t))
+	require.NoError(t, err)
+	previousKey, err := ParseVerificationKeyPEM(oldKeyPEM)
+	require.NoError(t, err)
+	rotated := NewServiceWithKeys(NewKeySet(newKey, previousKey, NewHMACKey([]byte("old-secret"))), time.Hour, nil)
+
+	_, err = rotated.ValidateToken(oldToken)
+	assert.NoError(t, err, "tokens signed with the previous key stay valid")
+	_, err = rotated.ValidateToken(legacyToken)
+	assert.NoError(t, err, "tokens signed with the previous secret stay valid")
+
+	// The previous key is published but never used for signing
+	assert.Len(t, rotated.KeySet().JWKS().Keys, 2)
+	newToken, err := rotated.GenerateToken(testUser())
+	require.NoError(t, err)
+	_, err = oldService.ValidateToken(newToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// Once the previous key is dropped its tokens are rejected
+	_, err = NewServiceWithKeys(NewKeySet(newKey), time.Hour, nil).ValidateToken(oldToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestKeySet_RejectsForgedTokens(t *testing.T) {
+	rsaKeyPEM := generateRSAKeyPEM(t)
+	rsaKey, err := ParsePrivateKeyPEM(rsaKeyPEM)
+	require.NoError(t, err)
+	service := NewServiceWithKeys(NewKeySet(rsaKey), time.Hour, nil)
+
+	claims := Claims{
+		UserID:   uuid.New().String(),
+		Username: "attacker",
+		Role:     models.RoleAdministrator,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+
+	// HS256 token keyed with the public key under the RSA key ID
+	publicDER, err := x509.MarshalPKIXPublicKey(rsaKey.verifyKey)
+	require.NoError(t, err)
+	confused := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	confused.Header["kid"] = rsaKey.ID
+	confusedString, err := confused.SignedString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+	require.NoError(t, err)
+	_, err = service.ValidateToken(confusedString)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// Unsigned token
+	unsigned := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+	unsignedString, err := unsigned.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	_, err = service.ValidateToken(unsignedString)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// Token signed with an unknown key
+	otherKey, err := ParsePrivateKeyPEM(generateRSAKeyPEM(t))
+	require.NoError(t, err)
+	otherToken, err := NewServiceWithKeys(NewKeySet(otherKey), time.Hour, nil).GenerateToken(testUser())
+	require.NoError(t, err)
+	_, err = service.ValidateToken(otherToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestNewKeySetFromConfig(t *testing.T) {
+	rsaPath := writeKeyFile(t, generateRSAKeyPEM(t))
+	edPath := writeKeyFile(t, generateEd25519KeyPEM(t))
+
+	keys, err := NewKeySetFromConfig(config.JWTConfig{Secret: "secret", Algorithm: "HS256"})
+	require.NoError(t, err)
+	assert.Equal(t, jwt.SigningMethodHS256, keys.Current().Method)
+	assert.Empty(t, keys.JWKS().Keys, "shared secrets are never published")
+
+	keys, err = NewKeySetFromConfig(config.JWTConfig{
+		Algorithm:        "EdDSA",
+		PrivateKeyFile:   edPath,
+		PreviousSecret:   "secret",
+		PreviousKeyFiles: rsaPath,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, jwt.SigningMethodEdDSA, keys.Current().Method)
+	assert.Len(t, keys.JWKS().Keys, 2)
+
+	tests := []struct {
+		name string
+		cfg  config.JWTConfig
+	}{
+		{"missing private key", config.JWTConfig{Algorithm: "RS256"}},
+		{"algorithm mismatch", config.JWTConfig{Algorithm: "RS256", PrivateKeyFile: edPath}},
+		{"unsupported algorithm", config.JWTConfig{Algorithm: "HS512", Secret: "secret"}},
+		{"missing previous key file", config.JWTConfig{Secret: "secret", PreviousKeyFiles: "/nonexistent.pem"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeySetFromConfig(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
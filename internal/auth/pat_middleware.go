@@ -15,6 +15,7 @@ const (
 	PATPrefix            = "mcp_pat_"
 	AuthMethodContextKey = "auth_method"
 	UserIDContextKey     = "user_id"
+	PATIDContextKey      = "pat_id"
 )
 
 // PATMiddleware creates authentication middleware that supports both PAT and JWT tokens
@@ -72,7 +73,7 @@ func authenticateWithPAT(c *gin.Context, patService service.PATService, token st
 	ctx := WithClientInfo(c.Request.Context(), clientIP, userAgent)
 
 	// Validate PAT token and get associated user
-	user, err := patService.ValidateToken(ctx, token)
+	user, pat, err := patService.AuthenticateToken(ctx, token)
 	if err != nil {
 		// Log authentication failure with client info
 		securityLogger := NewSecurityLogger()
@@ -92,6 +93,8 @@ func authenticateWithPAT(c *gin.Context, patService service.PATService, token st
 			reason = "token_mismatch"
 		case service.ErrPATUserNotFound:
 			reason = "user_not_found"
+		case service.ErrPATUserDeactivated:
+			reason = "user_deactivated"
 		}
 
 		securityLogger.LogPATAuthFailure(ctx, reason, "mcp_pat_", clientIP, userAgent)
@@ -114,6 +117,7 @@ func authenticateWithPAT(c *gin.Context, patService service.PATService, token st
 	c.Set(UserContextKey, user)
 	c.Set(UserIDContextKey, user.ID.String())
 	c.Set(AuthMethodContextKey, "pat")
+	c.Set(PATIDContextKey, pat.ID)
 
 	return nil
 }
@@ -150,6 +154,17 @@ func IsPATAuthenticated(c *gin.Context) bool {
 	return ok && method == "pat"
 }
 
+// GetPATID extracts the ID of the personal access token the request was authenticated with
+func GetPATID(c *gin.Context) (uuid.UUID, bool) {
+	value, exists := c.Get(PATIDContextKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+
+	patID, ok := value.(uuid.UUID)
+	return patID, ok
+}
+
 // IsJWTAuthenticated checks if the current request was authenticated using JWT
 func IsJWTAuthenticated(c *gin.Context) bool {
 	method, ok := GetAuthMethod(c)
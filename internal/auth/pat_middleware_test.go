@@ -61,6 +61,14 @@ func (m *MockPATService) ValidateToken(ctx context.Context, token string) (*mode
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
+func (m *MockPATService) AuthenticateToken(ctx context.Context, token string) (*models.User, *models.PersonalAccessToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*models.User), args.Get(1).(*models.PersonalAccessToken), args.Error(2)
+}
+
 func (m *MockPATService) UpdateLastUsed(ctx context.Context, patID uuid.UUID) error {
 	args := m.Called(ctx, patID)
 	return args.Error(0)
@@ -138,7 +146,9 @@ func TestPATMiddleware_ValidPATToken(t *testing.T) {
 		Role:     models.RoleUser,
 	}
 
-	mockPATService.On("ValidateToken", mock.Anything, "mcp_pat_validtoken123").Return(testUser, nil)
+	testPAT := &models.PersonalAccessToken{ID: uuid.New(), UserID: testUser.ID, Name: "CI"}
+
+	mockPATService.On("AuthenticateToken", mock.Anything, "mcp_pat_validtoken123").Return(testUser, testPAT, nil)
 
 	router := gin.New()
 	router.Use(PATMiddleware(authService, mockPATService))
@@ -160,6 +170,11 @@ func TestPATMiddleware_ValidPATToken(t *testing.T) {
 		assert.True(t, exists)
 		assert.Equal(t, testUser.ID, user.ID)
 
+		// Verify the token is identified
+		patID, exists := GetPATID(c)
+		assert.True(t, exists)
+		assert.Equal(t, testPAT.ID, patID)
+
 		c.JSON(http.StatusOK, gin.H{"message": "success"})
 	})
 
@@ -182,7 +197,7 @@ func TestPATMiddleware_InvalidPATToken(t *testing.T) {
 	authService := NewService("test-secret", time.Hour, nil)
 	mockPATService := &MockPATService{}
 
-	mockPATService.On("ValidateToken", mock.Anything, "mcp_pat_invalidtoken").Return(nil, errors.New("invalid token"))
+	mockPATService.On("AuthenticateToken", mock.Anything, "mcp_pat_invalidtoken").Return(nil, nil, errors.New("invalid token"))
 
 	router := gin.New()
 	router.Use(PATMiddleware(authService, mockPATService))
@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RoutePolicy declares who may call a route
+type RoutePolicy struct {
+	Method string
+	Path   string // route path as registered in gin, e.g. /api/v1/epics/:id
+	Public bool
+	// Role is the minimum role required; ignored for public routes
+	Role models.UserRole
+	// AllowPAT accepts personal access tokens in addition to JWT access tokens
+	AllowPAT bool
+}
+
+// RoutePolicies is the single source of truth for route authorization.
+// It is enforced by Authorize and used to document required roles in the API specification.
+type RoutePolicies struct {
+	policies map[string]RoutePolicy
+}
+
+// NewRoutePolicies creates an empty policy set
+func NewRoutePolicies() *RoutePolicies {
+	return &RoutePolicies{
+		policies: make(map[string]RoutePolicy),
+	}
+}
+
+// Public declares a route that does not require authentication
+func (p *RoutePolicies) Public(method, path string) {
+	p.add(RoutePolicy{Method: method, Path: path, Public: true})
+}
+
+// Require declares a route that requires a JWT access token with the given role or higher
+func (p *RoutePolicies) Require(method, path string, role models.UserRole) {
+	p.add(RoutePolicy{Method: method, Path: path, Role: role})
+}
+
+// RequireWithPAT declares a route that requires a JWT or personal access token with the given role or higher
+func (p *RoutePolicies) RequireWithPAT(method, path string, role models.UserRole) {
+	p.add(RoutePolicy{Method: method, Path: path, Role: role, AllowPAT: true})
+}
+
+// add registers a policy; declaring the same route twice is a programming error
+func (p *RoutePolicies) add(policy RoutePolicy) {
+	key := policyKey(policy.Method, policy.Path)
+	if _, exists := p.policies[key]; exists {
+		panic(fmt.Sprintf("duplicate route policy for %s %s", policy.Method, policy.Path))
+	}
+	if !policy.Public && !policy.Role.IsValid() {
+		panic(fmt.Sprintf("invalid role %q in route policy for %s %s", policy.Role, policy.Method, policy.Path))
+	}
+	p.policies[key] = policy
+}
+
+// Lookup returns the policy of a route
+func (p *RoutePolicies) Lookup(method, path string) (RoutePolicy, bool) {
+	policy, ok := p.policies[policyKey(method, path)]
+	return policy, ok
+}
+
+// All returns all policies ordered by path and method
+func (p *RoutePolicies) All() []RoutePolicy {
+	policies := make([]RoutePolicy, 0, len(p.policies))
+	for _, policy := range p.policies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Path != policies[j].Path {
+			return policies[i].Path < policies[j].Path
+		}
+		return policies[i].Method < policies[j].Method
+	})
+	return policies
+}
+
+// Verify checks that the policies cover exactly the given routes, so that a route
+// cannot be added without deciding who may call it and stale policies do not linger
+func (p *RoutePolicies) Verify(routes gin.RoutesInfo) error {
+	var problems []string
+	seen := make(map[string]bool)
+	for _, route := range routes {
+		key := policyKey(route.Method, route.Path)
+		seen[key] = true
+		if _, ok := p.policies[key]; !ok {
+			problems = append(problems, "no policy for "+key)
+		}
+	}
+	for _, policy := range p.All() {
+		if key := policyKey(policy.Method, policy.Path); !seen[key] {
+			problems = append(problems, "policy for unregistered route "+key)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("route policies out of sync: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// Description returns a human-readable summary of the policy
+func (p RoutePolicy) Description() string {
+	if p.Public {
+		return "No authentication required."
+	}
+
+	var roles string
+	switch p.Role {
+	case models.RoleAdministrator:
+		roles = "Administrator role required."
+	case models.RoleUser:
+		roles = "User or Administrator role required."
+	default:
+		roles = "Any authenticated user (Commenter, User or Administrator)."
+	}
+	if p.AllowPAT {
+		roles += " Accepts JWT access tokens and personal access tokens."
+	}
+	return roles
+}
+
+func policyKey(method, path string) string {
+	return method + " " + path
+}
+
+// Authorize creates middleware that authenticates and authorizes every request according to its route policy.
+// Requests to routes without a policy are denied.
+func (s *Service) Authorize(policies *RoutePolicies, patService service.PATService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.FullPath()
+		if path == "" {
+			// Unmatched route; let gin respond with 404
+			c.Next()
+			return
+		}
+
+		policy, ok := policies.Lookup(c.Request.Method, path)
+		if !ok {
+			if logger.Logger != nil {
+				logger.WithFields(map[string]interface{}{
+					"component": "authorization",
+					"method":    c.Request.Method,
+					"path":      path,
+				}).Error("Denied request to route without authorization policy")
+			}
+			c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			c.Abort()
+			return
+		}
+
+		if policy.Public {
+			c.Next()
+			return
+		}
+
+		authHeader := c.GetHeader(AuthorizationHeader)
+		if authHeader == "" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
+			c.Abort()
+			return
+		}
+		if !strings.HasPrefix(authHeader, BearerPrefix) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Bearer token required"})
+			c.Abort()
+			return
+		}
+
+		tokenString := strings.TrimPrefix(authHeader, BearerPrefix)
+		if strings.HasPrefix(tokenString, PATPrefix) {
+			if !policy.AllowPAT || patService == nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Personal access tokens are not accepted for this endpoint"})
+				c.Abort()
+				return
+			}
+			if err := authenticateWithPAT(c, patService, tokenString); err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+				c.Abort()
+				return
+			}
+		} else if err := authenticateWithJWT(c, s, tokenString); err != nil {
+			switch err {
+			case ErrTokenExpired:
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Token expired"})
+			case ErrInvalidToken:
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			default:
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication failed"})
+			}
+			c.Abort()
+			return
+		}
+
+		claims, _ := GetCurrentUser(c)
+		if err := s.CheckPermission(claims.Role, policy.Role); err != nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+			c.Abort()
+			return
+		}
+		if claims.IsImpersonation() && !impersonationAllowed(c.Request.Method, path) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Not allowed while impersonating a user"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"product-requirements-management/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPolicyRouter(t *testing.T) (*gin.Engine, *Service, *RoutePolicies) {
+	gin.SetMode(gin.TestMode)
+	service := NewService("test-secret", time.Hour, nil)
+
+	policies := NewRoutePolicies()
+	policies.Public(http.MethodGet, "/public")
+	policies.Require(http.MethodGet, "/entities/:id", models.RoleCommenter)
+	policies.Require(http.MethodPut, "/entities/:id", models.RoleUser)
+	policies.Require(http.MethodDelete, "/config/:id", models.RoleAdministrator)
+
+	router := gin.New()
+	app := router.Group("", service.Authorize(policies, nil))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	app.GET("/public", ok)
+	app.GET("/entities/:id", ok)
+	app.PUT("/entities/:id", ok)
+	app.DELETE("/config/:id", ok)
+	app.POST("/undeclared", ok)
+
+	return router, service, policies
+}
+
+func tokenForRole(t *testing.T, service *Service, role models.UserRole) string {
+	token, err := service.GenerateToken(&models.User{ID: uuid.New(), Username: "tester", Role: role})
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthorize(t *testing.T) {
+	router, service, _ := setupPolicyRouter(t)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		token    string
+		expected int
+	}{
+		{"public route without token", http.MethodGet, "/public", "", http.StatusOK},
+		{"missing token", http.MethodGet, "/entities/1", "", http.StatusUnauthorized},
+		{"invalid token", http.MethodGet, "/entities/1", "not-a-token", http.StatusUnauthorized},
+		{"PAT on JWT-only route", http.MethodGet, "/entities/1", PATPrefix + "abc", http.StatusUnauthorized},
+		{"commenter can read", http.MethodGet, "/entities/1", tokenForRole(t, service, models.RoleCommenter), http.StatusOK},
+		{"commenter cannot edit", http.MethodPut, "/entities/1", tokenForRole(t, service, models.RoleCommenter), http.StatusForbidden},
+		{"user can edit", http.MethodPut, "/entities/1", tokenForRole(t, service, models.RoleUser), http.StatusOK},
+		{"user cannot configure", http.MethodDelete, "/config/1", tokenForRole(t, service, models.RoleUser), http.StatusForbidden},
+		{"administrator can configure", http.MethodDelete, "/config/1", tokenForRole(t, service, models.RoleAdministrator), http.StatusOK},
+		{"route without policy is denied", http.MethodPost, "/undeclared", tokenForRole(t, service, models.RoleAdministrator), http.StatusForbidden},
+		{"unknown route", http.MethodGet, "/missing", "", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set(AuthorizationHeader, BearerPrefix+tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestRoutePolicies_Verify(t *testing.T) {
+	router, _, policies := setupPolicyRouter(t)
+
+	err := policies.Verify(router.Routes())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no policy for POST /undeclared")
+
+	policies.Require(http.MethodPost, "/undeclared", models.RoleUser)
+	assert.NoError(t, policies.Verify(router.Routes()))
+
+	policies.Require(http.MethodGet, "/removed", models.RoleUser)
+	err = policies.Verify(router.Routes())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "policy for unregistered route GET /removed")
+
+	assert.Panics(t, func() { policies.Public(http.MethodGet, "/public") })
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/models"
 )
 
 // ClientInfo contains client information for security logging
@@ -55,6 +56,10 @@ const (
 	SecurityEventAuthSuccess      SecurityEvent = "auth_success"
 	SecurityEventAuthFailure      SecurityEvent = "auth_failure"
 	SecurityEventAuthMethodSwitch SecurityEvent = "auth_method_switch"
+
+	// Impersonation Events
+	SecurityEventImpersonationStarted SecurityEvent = "impersonation_started"
+	SecurityEventImpersonatedRequest  SecurityEvent = "impersonated_request"
 )
 
 // SecurityLogger handles security event logging without exposing sensitive information
@@ -82,6 +87,11 @@ type SecurityEventData struct {
 	UserAgent   string        `json:"user_agent,omitempty"`
 	Reason      string        `json:"reason,omitempty"`
 	Count       int           `json:"count,omitempty"`
+	AdminID     *uuid.UUID    `json:"admin_id,omitempty"`
+	SessionID   *uuid.UUID    `json:"session_id,omitempty"`
+	Method      string        `json:"method,omitempty"`
+	Path        string        `json:"path,omitempty"`
+	StatusCode  int           `json:"status_code,omitempty"`
 	Timestamp   time.Time     `json:"timestamp"`
 }
 
@@ -244,6 +254,47 @@ func (sl *SecurityLogger) LogAuthMethodSwitch(ctx context.Context, userID uuid.U
 	sl.logSecurityEvent(ctx, data, "Authentication method switched from "+fromMethod+" to "+toMethod)
 }
 
+// LogImpersonationStarted logs an administrator starting to impersonate a user
+func (sl *SecurityLogger) LogImpersonationStarted(ctx context.Context, session *models.ImpersonationSession, username string) {
+	data := SecurityEventData{
+		Event:     SecurityEventImpersonationStarted,
+		UserID:    &session.UserID,
+		Username:  username,
+		AdminID:   &session.AdminID,
+		SessionID: &session.ID,
+		ClientIP:  session.ClientIP,
+		UserAgent: session.UserAgent,
+		Reason:    session.Reason,
+		Timestamp: time.Now(),
+	}
+
+	sl.logSecurityEvent(ctx, data, "Impersonation session started")
+}
+
+// LogImpersonatedRequest logs a request made by an administrator impersonating a user
+func (sl *SecurityLogger) LogImpersonatedRequest(ctx context.Context, claims *Claims, method, path string, statusCode int, clientIP string) {
+	data := SecurityEventData{
+		Event:      SecurityEventImpersonatedRequest,
+		Username:   claims.Username,
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+		ClientIP:   clientIP,
+		Timestamp:  time.Now(),
+	}
+	if userID, err := uuid.Parse(claims.UserID); err == nil {
+		data.UserID = &userID
+	}
+	if adminID, err := uuid.Parse(claims.ImpersonatorID); err == nil {
+		data.AdminID = &adminID
+	}
+	if sessionID, err := uuid.Parse(claims.ImpersonationID); err == nil {
+		data.SessionID = &sessionID
+	}
+
+	sl.logSecurityEvent(ctx, data, "Impersonated request")
+}
+
 // logSecurityEvent logs a security event with structured logging
 func (sl *SecurityLogger) logSecurityEvent(ctx context.Context, data SecurityEventData, message string) {
 	entry := logger.WithContext(ctx).WithFields(logrus.Fields{
@@ -266,6 +317,8 @@ func (sl *SecurityLogger) logSecurityEvent(ctx context.Context, data SecurityEve
 		entry.Info(message)
 	case SecurityEventPATAuthSuccess, SecurityEventAuthSuccess:
 		entry.Info(message)
+	case SecurityEventImpersonationStarted:
+		entry.Warn(message)
 	default:
 		entry.Info(message)
 	}
@@ -12,6 +12,7 @@ import (
 	"product-requirements-management/internal/repository"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -20,6 +21,7 @@ var (
 	ErrInvalidToken       = errors.New("invalid token")
 	ErrTokenExpired       = errors.New("token expired")
 	ErrInsufficientRole   = errors.New("insufficient role permissions")
+	ErrUserDeactivated    = errors.New("user account is deactivated")
 )
 
 // Claims represents the JWT claims
@@ -27,21 +29,43 @@ type Claims struct {
 	UserID   string          `json:"user_id"`
 	Username string          `json:"username"`
 	Role     models.UserRole `json:"role"`
+	// ImpersonatorID is the administrator acting as the user; set only on impersonation tokens
+	ImpersonatorID string `json:"impersonator_id,omitempty"`
+	// ImpersonationID is the impersonation session the token was issued for
+	ImpersonationID string `json:"impersonation_id,omitempty"`
+	// GuestInvitationID is the invitation a guest token was issued for; set only on guest tokens
+	GuestInvitationID string `json:"guest_invitation_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IsImpersonation reports whether the token was issued to an administrator impersonating the user
+func (c *Claims) IsImpersonation() bool {
+	return c.ImpersonationID != ""
+}
+
+// IsGuest reports whether the token was issued to an external reviewer for a guest invitation
+func (c *Claims) IsGuest() bool {
+	return c.GuestInvitationID != ""
+}
+
 // Service handles authentication operations
 type Service struct {
-	jwtSecret          []byte
+	keys               *KeySet
 	tokenDuration      time.Duration
 	refreshTokenRepo   repository.RefreshTokenRepository
 	refreshTokenExpiry time.Duration
 }
 
-// NewService creates a new authentication service
+// NewService creates a new authentication service that signs access tokens with an HS256 secret
 func NewService(jwtSecret string, tokenDuration time.Duration, refreshTokenRepo repository.RefreshTokenRepository) *Service {
+	return NewServiceWithKeys(NewKeySet(NewHMACKey([]byte(jwtSecret))), tokenDuration, refreshTokenRepo)
+}
+
+// NewServiceWithKeys creates a new authentication service that signs access tokens with the
+// current key of the set and accepts tokens signed with any of its keys
+func NewServiceWithKeys(keys *KeySet, tokenDuration time.Duration, refreshTokenRepo repository.RefreshTokenRepository) *Service {
 	return &Service{
-		jwtSecret:          []byte(jwtSecret),
+		keys:               keys,
 		tokenDuration:      tokenDuration,
 		refreshTokenRepo:   refreshTokenRepo,
 		refreshTokenExpiry: 30 * 24 * time.Hour, // 30 days
@@ -75,18 +99,52 @@ func (s *Service) GenerateToken(user *models.User) (string, error) {
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.jwtSecret)
+	return s.keys.sign(claims)
+}
+
+// GenerateImpersonationToken generates a JWT token that lets the administrator of an impersonation
+// session act as the impersonated user until the session expires
+func (s *Service) GenerateImpersonationToken(session *models.ImpersonationSession, user *models.User) (string, error) {
+	claims := Claims{
+		UserID:          user.ID.String(),
+		Username:        user.Username,
+		Role:            user.Role,
+		ImpersonatorID:  session.AdminID.String(),
+		ImpersonationID: session.ID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        session.ID.String(),
+			ExpiresAt: jwt.NewNumericDate(session.ExpiresAt),
+			IssuedAt:  jwt.NewNumericDate(session.StartedAt),
+			NotBefore: jwt.NewNumericDate(session.StartedAt),
+		},
+	}
+
+	return s.keys.sign(claims)
+}
+
+// GenerateGuestToken generates a JWT token that lets the guest of an invitation read and comment on its epic
+// until the invitation expires
+func (s *Service) GenerateGuestToken(invitation *models.GuestInvitation, user *models.User) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID:            user.ID.String(),
+		Username:          user.Username,
+		Role:              models.RoleCommenter,
+		GuestInvitationID: invitation.ID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(invitation.ExpiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	return s.keys.sign(claims)
 }
 
 // ValidateToken validates a JWT token and returns the claims
 func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return s.jwtSecret, nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, s.keys.verificationKey,
+		jwt.WithValidMethods([]string{AlgorithmHS256, AlgorithmRS256, AlgorithmEdDSA}))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -102,6 +160,11 @@ func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
 	return nil, ErrInvalidToken
 }
 
+// KeySet returns the keys used to sign and verify access tokens
+func (s *Service) KeySet() *KeySet {
+	return s.keys
+}
+
 // CheckPermission checks if a user has the required permission level
 func (s *Service) CheckPermission(userRole models.UserRole, requiredRole models.UserRole) error {
 	// Define role hierarchy: Administrator > User > Commenter
@@ -169,7 +232,7 @@ func (s *Service) GenerateRefreshToken(ctx context.Context, user *models.User) (
 		ExpiresAt: time.Now().Add(s.refreshTokenExpiry),
 	}
 
-	if err := s.refreshTokenRepo.Create(refreshToken); err != nil {
+	if err := s.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
 		return "", fmt.Errorf("failed to store refresh token: %w", err)
 	}
 
@@ -180,7 +243,7 @@ func (s *Service) GenerateRefreshToken(ctx context.Context, user *models.User) (
 func (s *Service) ValidateRefreshToken(ctx context.Context, token string) (*models.User, string, error) {
 	// Find all refresh tokens (we need to check hashes)
 	// In production, consider adding a token prefix/identifier to optimize this
-	allTokens, err := s.refreshTokenRepo.FindAll()
+	allTokens, err := s.refreshTokenRepo.FindAll(ctx)
 	if err != nil {
 		return nil, "", ErrInvalidToken
 	}
@@ -201,14 +264,14 @@ func (s *Service) ValidateRefreshToken(ctx context.Context, token string) (*mode
 	// Check expiration
 	if matchedToken.IsExpired() {
 		// Clean up expired token
-		s.refreshTokenRepo.Delete(matchedToken.ID)
+		s.refreshTokenRepo.Delete(ctx, matchedToken.ID)
 		return nil, "", ErrTokenExpired
 	}
 
 	// Update last used timestamp
 	now := time.Now()
 	matchedToken.LastUsedAt = &now
-	s.refreshTokenRepo.Update(matchedToken)
+	s.refreshTokenRepo.Update(ctx, matchedToken)
 
 	// Get user from database
 	var user models.User
@@ -216,6 +279,12 @@ func (s *Service) ValidateRefreshToken(ctx context.Context, token string) (*mode
 		return nil, "", ErrInvalidToken
 	}
 
+	// Deactivated users cannot obtain new tokens
+	if !user.IsActive() {
+		s.refreshTokenRepo.Delete(ctx, matchedToken.ID)
+		return nil, "", ErrUserDeactivated
+	}
+
 	// Generate new refresh token (token rotation)
 	newRefreshToken, err := s.GenerateRefreshToken(ctx, &user)
 	if err != nil {
@@ -223,7 +292,7 @@ func (s *Service) ValidateRefreshToken(ctx context.Context, token string) (*mode
 	}
 
 	// Revoke old token
-	s.refreshTokenRepo.Delete(matchedToken.ID)
+	s.refreshTokenRepo.Delete(ctx, matchedToken.ID)
 
 	return &user, newRefreshToken, nil
 }
@@ -231,21 +300,27 @@ func (s *Service) ValidateRefreshToken(ctx context.Context, token string) (*mode
 // RevokeRefreshToken invalidates a refresh token
 func (s *Service) RevokeRefreshToken(ctx context.Context, token string) error {
 	// Find and delete the token by comparing hashes
-	allTokens, err := s.refreshTokenRepo.FindAll()
+	allTokens, err := s.refreshTokenRepo.FindAll(ctx)
 	if err != nil {
 		return ErrInvalidToken
 	}
 
 	for _, rt := range allTokens {
 		if err := bcrypt.CompareHashAndPassword([]byte(rt.TokenHash), []byte(token)); err == nil {
-			return s.refreshTokenRepo.Delete(rt.ID)
+			return s.refreshTokenRepo.Delete(ctx, rt.ID)
 		}
 	}
 
 	return ErrInvalidToken
 }
 
+// RevokeAllRefreshTokens invalidates every refresh token of a user, ending all of their sessions
+// once their current access tokens expire
+func (s *Service) RevokeAllRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	return s.refreshTokenRepo.DeleteByUserID(ctx, userID)
+}
+
 // CleanupExpiredTokens removes expired refresh tokens
 func (s *Service) CleanupExpiredTokens(ctx context.Context) (int64, error) {
-	return s.refreshTokenRepo.DeleteExpired()
+	return s.refreshTokenRepo.DeleteExpired(ctx)
 }
@@ -20,7 +20,7 @@ func TestNewService(t *testing.T) {
 	service := NewService(secret, duration, nil)
 
 	assert.NotNil(t, service)
-	assert.Equal(t, []byte(secret), service.jwtSecret)
+	assert.Equal(t, NewHMACKey([]byte(secret)), service.keys.Current())
 	assert.Equal(t, duration, service.tokenDuration)
 }
 
@@ -280,12 +280,12 @@ func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
 	}
 }
 
-func (m *MockRefreshTokenRepository) Create(token *models.RefreshToken) error {
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *models.RefreshToken) error {
 	m.tokens = append(m.tokens, token)
 	return nil
 }
 
-func (m *MockRefreshTokenRepository) FindByTokenHash(tokenHash string) (*models.RefreshToken, error) {
+func (m *MockRefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
 	for _, t := range m.tokens {
 		if t.TokenHash == tokenHash {
 			return t, nil
@@ -294,7 +294,7 @@ func (m *MockRefreshTokenRepository) FindByTokenHash(tokenHash string) (*models.
 	return nil, ErrInvalidToken
 }
 
-func (m *MockRefreshTokenRepository) FindByUserID(userID uuid.UUID) ([]*models.RefreshToken, error) {
+func (m *MockRefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.RefreshToken, error) {
 	result := make([]*models.RefreshToken, 0)
 	for _, t := range m.tokens {
 		if t.UserID == userID {
@@ -304,11 +304,11 @@ func (m *MockRefreshTokenRepository) FindByUserID(userID uuid.UUID) ([]*models.R
 	return result, nil
 }
 
-func (m *MockRefreshTokenRepository) FindAll() ([]*models.RefreshToken, error) {
+func (m *MockRefreshTokenRepository) FindAll(ctx context.Context) ([]*models.RefreshToken, error) {
 	return m.tokens, nil
 }
 
-func (m *MockRefreshTokenRepository) Update(token *models.RefreshToken) error {
+func (m *MockRefreshTokenRepository) Update(ctx context.Context, token *models.RefreshToken) error {
 	for i, t := range m.tokens {
 		if t.ID == token.ID {
 			m.tokens[i] = token
@@ -318,7 +318,7 @@ func (m *MockRefreshTokenRepository) Update(token *models.RefreshToken) error {
 	return ErrInvalidToken
 }
 
-func (m *MockRefreshTokenRepository) Delete(id uuid.UUID) error {
+func (m *MockRefreshTokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	for i, t := range m.tokens {
 		if t.ID == id {
 			m.tokens = append(m.tokens[:i], m.tokens[i+1:]...)
@@ -328,7 +328,7 @@ func (m *MockRefreshTokenRepository) Delete(id uuid.UUID) error {
 	return ErrInvalidToken
 }
 
-func (m *MockRefreshTokenRepository) DeleteByUserID(userID uuid.UUID) error {
+func (m *MockRefreshTokenRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
 	newTokens := make([]*models.RefreshToken, 0)
 	for _, t := range m.tokens {
 		if t.UserID != userID {
@@ -339,7 +339,7 @@ func (m *MockRefreshTokenRepository) DeleteByUserID(userID uuid.UUID) error {
 	return nil
 }
 
-func (m *MockRefreshTokenRepository) DeleteExpired() (int64, error) {
+func (m *MockRefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
 	count := int64(0)
 	newTokens := make([]*models.RefreshToken, 0)
 	now := time.Now()
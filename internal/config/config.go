@@ -3,23 +3,49 @@ package config
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	Server        ServerConfig
-	Database      DatabaseConfig
-	Redis         RedisConfig
-	JWT           JWTConfig
-	Log           LogConfig
-	Observability ObservabilityConfig
+	Server            ServerConfig
+	Database          DatabaseConfig
+	Redis             RedisConfig
+	JWT               JWTConfig
+	Log               LogConfig
+	Observability     ObservabilityConfig
+	SMTP              SMTPConfig
+	Digest            DigestConfig
+	Staleness         StalenessConfig
+	HierarchyCache    HierarchyCacheConfig
+	Spelling          SpellingConfig
+	Reports           ReportsConfig
+	Calendar          CalendarConfig
+	Feeds             FeedsConfig
+	GuestInvitations  GuestInvitationsConfig
+	CommentDrafts     CommentDraftsConfig
+	CORS              CORSConfig
+	Security          SecurityHeadersConfig
+	RequestLimits     RequestLimitsConfig
+	Compression       CompressionConfig
+	RequestValidation RequestValidationConfig
+	Lint              LintConfig
+	Events            EventsConfig
+	Search            SearchConfig
+	Localization      LocalizationConfig
+	Demo              DemoConfig
+	LLM               LLMConfig
+	Moderation        ModerationConfig
 }
 
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
 	Port string
 	Host string
+	// GRPCPort is the port of the internal gRPC API; empty disables it
+	GRPCPort string
 }
 
 // DatabaseConfig holds database connection configuration
@@ -30,6 +56,18 @@ type DatabaseConfig struct {
 	Password string
 	DBName   string
 	SSLMode  string
+	// Connection pool settings; durations are in minutes and zero values fall back to
+	// 100 open and 10 idle connections, a 60 minute lifetime and no idle timeout
+	MaxOpenConns           int
+	MaxIdleConns           int
+	ConnMaxLifetimeMinutes int
+	ConnMaxIdleTimeMinutes int
+	// SlowQueryThresholdMs is the duration above which queries are logged as slow (0 disables)
+	SlowQueryThresholdMs int
+	// SlowQueryLogSize is the number of recent slow queries kept for the admin report
+	SlowQueryLogSize int
+	// QueryTimeoutMs bounds the duration of every query on top of the request context (0 disables)
+	QueryTimeoutMs int
 }
 
 // RedisConfig holds Redis connection configuration
@@ -43,6 +81,13 @@ type RedisConfig struct {
 // JWTConfig holds JWT-related configuration
 type JWTConfig struct {
 	Secret string
+	// Algorithm is HS256 (signs with Secret), RS256 or EdDSA (signs with PrivateKeyFile)
+	Algorithm      string
+	PrivateKeyFile string
+	// PreviousSecret and PreviousKeyFiles (comma-separated PEM files) are still accepted
+	// for verification so tokens issued before a key rotation stay valid until they expire
+	PreviousSecret   string
+	PreviousKeyFiles string
 }
 
 // LogConfig holds logging configuration
@@ -61,12 +106,209 @@ type ObservabilityConfig struct {
 	TracingEndpoint string
 }
 
+// SMTPConfig holds outgoing mail configuration
+type SMTPConfig struct {
+	Host     string // Empty disables sending; emails are logged instead
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// DigestConfig holds activity digest job configuration
+type DigestConfig struct {
+	Enabled              bool
+	CheckIntervalMinutes int
+	BaseURL              string // Public API base URL used to build unsubscribe links
+}
+
+// StalenessConfig holds staleness policy job configuration
+type StalenessConfig struct {
+	Enabled              bool
+	CheckIntervalMinutes int
+}
+
+// HierarchyCacheConfig holds hierarchy cache consistency check job configuration
+type HierarchyCacheConfig struct {
+	Enabled              bool
+	CheckIntervalMinutes int
+	Repair               bool // Rebuild the cache when the check finds it inconsistent
+}
+
+// SpellingConfig holds spelling and terminology analysis job configuration
+type SpellingConfig struct {
+	Enabled              bool
+	CheckIntervalMinutes int
+	DictionaryFile       string // Word list, one word per line; without it only terminology is checked
+}
+
+// ReportsConfig holds scheduled report delivery configuration
+type ReportsConfig struct {
+	SchedulesEnabled     bool
+	CheckIntervalMinutes int // How often due schedules are looked for; cron expressions have minute resolution
+}
+
+// CalendarConfig holds iCal feed configuration
+type CalendarConfig struct {
+	BaseURL string // Public API base URL used to build calendar feed URLs
+}
+
+// FeedsConfig holds Atom change feed configuration
+type FeedsConfig struct {
+	BaseURL string // Public API base URL used to build feed and entity URLs
+}
+
+// GuestInvitationsConfig holds guest invitation configuration
+type GuestInvitationsConfig struct {
+	BaseURL                string // Public API base URL guests are told to sign in at
+	CleanupEnabled         bool
+	CleanupIntervalMinutes int // How often guests without an active invitation are deactivated
+}
+
+// CommentDraftsConfig holds comment draft configuration
+type CommentDraftsConfig struct {
+	TTLDays                int // Days a comment draft is kept after it was last saved
+	CleanupEnabled         bool
+	CleanupIntervalMinutes int // How often expired comment drafts are deleted
+}
+
+// LintConfig holds text quality check configuration
+type LintConfig struct {
+	EARSOnSave bool // Return EARS lint warnings when acceptance criteria are created or updated
+}
+
+// DemoConfig holds demo mode configuration. In demo mode every session gets its own seeded
+// PostgreSQL schema, which is dropped when the session expires.
+type DemoConfig struct {
+	Enabled              bool
+	SessionTTLMinutes    int
+	MaxSessions          int
+	CheckIntervalMinutes int
+	SeedFile             string // Seed bundle with the demo data; a built-in sample workspace when empty
+}
+
+// LLMConfig holds the language model provider used for AI features such as comment summaries
+type LLMConfig struct {
+	Provider       string // "openai" (any OpenAI-compatible API), "anthropic", "ollama" or empty to disable AI features
+	APIURL         string // Base URL of the API; defaults to the public API of the provider or a local Ollama server
+	APIKey         string
+	Model          string
+	MaxTokens      int
+	TimeoutSeconds int
+	MaxRetries     int               // Retries of rate limited, failed or timed out requests
+	RetryBackoffMs int               // Delay before the first retry, doubled for every further retry
+	FeatureModels  map[string]string // Model per AI feature, overriding Model
+
+	// Cost tracking; prices are in US dollars per million tokens
+	InputPricePerMTokens  float64
+	OutputPricePerMTokens float64
+	MonthlyBudget         float64 // US dollars per calendar month; 0 disables budget alerts
+	BudgetAlertPercent    int     // Share of the budget at which administrators are warned, besides 100%
+	LogContent            bool    // Store prompts and responses with the usage records
+}
+
+// ModerationConfig holds the content moderation of comments; moderation is off when no keywords are set
+// and the AI classifier is disabled
+type ModerationConfig struct {
+	BlockedKeywords []string // Comments containing one of these words or phrases are not published
+	FlaggedKeywords []string // Comments containing one of these words or phrases are published and queued for review
+	AIClassifier    bool     // Classify comments with the language model; requires LLM_PROVIDER
+}
+
+// SearchConfig holds full-text search configuration
+type SearchConfig struct {
+	// Languages are the PostgreSQL text search configurations queries are matched in, such as english and russian
+	Languages []string
+	// CountMode is how totals of queries with more matches than a page are computed: exact or estimated
+	CountMode         string
+	MaxResults        int // Most results a search pages through; has_more is false past it
+	CountCacheSeconds int // How long totals of repeated identical queries are reused; 0 disables the reuse
+}
+
+// LocalizationConfig holds the locales of entity content and its translations
+type LocalizationConfig struct {
+	ContentLocale      string   // Locale entities are written in, such as en
+	TranslationLocales []string // Locales entities are translated into, always covered by the translation completeness report
+}
+
+// localePattern matches a language code with an optional region, such as ru or pt-BR
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}([-_][A-Za-z]{2})?$`)
+
+// searchLanguages lists the text search configurations PostgreSQL ships with
+var searchLanguages = map[string]bool{
+	"simple": true, "arabic": true, "armenian": true, "basque": true, "catalan": true, "danish": true,
+	"dutch": true, "english": true, "finnish": true, "french": true, "german": true, "greek": true,
+	"hindi": true, "hungarian": true, "indonesian": true, "irish": true, "italian": true, "lithuanian": true,
+	"nepali": true, "norwegian": true, "portuguese": true, "romanian": true, "russian": true, "serbian": true,
+	"spanish": true, "swedish": true, "tamil": true, "turkish": true, "yiddish": true,
+}
+
+// EventsConfig holds entity event publishing configuration
+type EventsConfig struct {
+	Publisher        string // "kafka", "nats", "log" or empty to disable publishing
+	KafkaRESTURL     string // Kafka REST Proxy base URL, such as http://localhost:8082
+	NATSURL          string // NATS server URL, such as nats://localhost:4222
+	TopicPrefix      string // Events are published to <prefix>.<entity type>
+	BatchSize        int
+	PollIntervalMs   int
+	PublishTimeoutMs int
+	RetentionDays    int // Days published events stay in the outbox (0 keeps them)
+}
+
+// CORSConfig holds cross-origin resource sharing configuration
+type CORSConfig struct {
+	AllowedOrigins   []string // "*" allows any origin
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	ExposedHeaders   []string
+	AllowCredentials bool
+	MaxAgeSeconds    int
+}
+
+// SecurityHeadersConfig holds HTTP security response header configuration
+type SecurityHeadersConfig struct {
+	HSTSEnabled           bool
+	HSTSMaxAgeSeconds     int
+	HSTSIncludeSubdomains bool
+	ContentSecurityPolicy string // Applied to API responses
+	SwaggerCSP            string // Applied to the Swagger UI, which needs inline scripts and styles
+	FrameOptions          string
+	ReferrerPolicy        string
+}
+
+// RequestLimitsConfig holds request body size limits
+type RequestLimitsConfig struct {
+	MaxBodyBytes int64
+	// RouteMaxBodyBytes overrides MaxBodyBytes for paths starting with the key; the longest prefix wins
+	RouteMaxBodyBytes map[string]int64
+	// MultipartMemoryBytes is the part of a multipart upload kept in memory; the rest spills to temporary files
+	MultipartMemoryBytes int64
+}
+
+// CompressionConfig holds the compression of responses negotiated with Accept-Encoding
+type CompressionConfig struct {
+	Enabled bool
+	// MinSizeBytes is the smallest response body that is compressed; smaller bodies cost more to compress than they save
+	MinSizeBytes int64
+	// ContentTypes lists the compressed media types; an entry such as text/* matches every subtype
+	ContentTypes []string
+	// Level is the brotli and gzip compression level from 1 (fastest) to 9 (smallest)
+	Level int
+}
+
+// RequestValidationConfig holds the runtime validation of requests against the Swagger specification
+type RequestValidationConfig struct {
+	// Enabled rejects requests that deviate from the documented contract; not allowed in production
+	Enabled bool
+}
+
 // Load loads configuration from environment variables with defaults
 func Load() (*Config, error) {
 	cfg := &Config{
 		Server: ServerConfig{
-			Port: getEnv("SERVER_PORT", "8080"),
-			Host: getEnv("SERVER_HOST", "0.0.0.0"),
+			Port:     getEnv("SERVER_PORT", "8080"),
+			Host:     getEnv("SERVER_HOST", "0.0.0.0"),
+			GRPCPort: getEnv("GRPC_PORT", ""),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -75,6 +317,15 @@ func Load() (*Config, error) {
 			Password: getEnv("DB_PASSWORD", ""),
 			DBName:   getEnv("DB_NAME", "requirements_db"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+			MaxOpenConns:           getEnvAsInt("DB_MAX_OPEN_CONNS", 100),
+			MaxIdleConns:           getEnvAsInt("DB_MAX_IDLE_CONNS", 10),
+			ConnMaxLifetimeMinutes: getEnvAsInt("DB_CONN_MAX_LIFETIME_MINUTES", 60),
+			ConnMaxIdleTimeMinutes: getEnvAsInt("DB_CONN_MAX_IDLE_TIME_MINUTES", 0),
+
+			SlowQueryThresholdMs: getEnvAsInt("DB_SLOW_QUERY_THRESHOLD_MS", 200),
+			SlowQueryLogSize:     getEnvAsInt("DB_SLOW_QUERY_LOG_SIZE", 100),
+			QueryTimeoutMs:       getEnvAsInt("DB_QUERY_TIMEOUT_MS", 30000),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -83,7 +334,11 @@ func Load() (*Config, error) {
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
 		JWT: JWTConfig{
-			Secret: getEnv("JWT_SECRET", "your-secret-key"),
+			Secret:           getEnv("JWT_SECRET", "your-secret-key"),
+			Algorithm:        getEnv("JWT_ALGORITHM", "HS256"),
+			PrivateKeyFile:   getEnv("JWT_PRIVATE_KEY_FILE", ""),
+			PreviousSecret:   getEnv("JWT_PREVIOUS_SECRET", ""),
+			PreviousKeyFiles: getEnv("JWT_PREVIOUS_KEY_FILES", ""),
 		},
 		Log: LogConfig{
 			Level:  getEnv("LOG_LEVEL", "info"),
@@ -97,16 +352,360 @@ func Load() (*Config, error) {
 			TracingEnabled:  getEnvAsBool("TRACING_ENABLED", true),
 			TracingEndpoint: getEnv("TRACING_ENDPOINT", "http://localhost:4318/v1/traces"),
 		},
+		SMTP: SMTPConfig{
+			Host:     getEnv("SMTP_HOST", ""),
+			Port:     getEnv("SMTP_PORT", "587"),
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("SMTP_FROM", "noreply@example.com"),
+		},
+		Digest: DigestConfig{
+			Enabled:              getEnvAsBool("DIGEST_ENABLED", true),
+			CheckIntervalMinutes: getEnvAsInt("DIGEST_CHECK_INTERVAL_MINUTES", 60),
+			BaseURL:              getEnv("DIGEST_BASE_URL", "http://localhost:8080"),
+		},
+		Staleness: StalenessConfig{
+			Enabled:              getEnvAsBool("STALENESS_ENABLED", true),
+			CheckIntervalMinutes: getEnvAsInt("STALENESS_CHECK_INTERVAL_MINUTES", 60),
+		},
+		HierarchyCache: HierarchyCacheConfig{
+			Enabled:              getEnvAsBool("HIERARCHY_CACHE_CHECK_ENABLED", true),
+			CheckIntervalMinutes: getEnvAsInt("HIERARCHY_CACHE_CHECK_INTERVAL_MINUTES", 360),
+			Repair:               getEnvAsBool("HIERARCHY_CACHE_REPAIR", true),
+		},
+		Spelling: SpellingConfig{
+			Enabled:              getEnvAsBool("SPELLING_ENABLED", true),
+			CheckIntervalMinutes: getEnvAsInt("SPELLING_CHECK_INTERVAL_MINUTES", 360),
+			DictionaryFile:       getEnv("SPELLING_DICTIONARY_FILE", ""),
+		},
+		Reports: ReportsConfig{
+			SchedulesEnabled:     getEnvAsBool("REPORT_SCHEDULES_ENABLED", true),
+			CheckIntervalMinutes: getEnvAsInt("REPORT_SCHEDULES_CHECK_INTERVAL_MINUTES", 1),
+		},
+		Calendar: CalendarConfig{
+			BaseURL: getEnv("CALENDAR_BASE_URL", getEnv("DIGEST_BASE_URL", "http://localhost:8080")),
+		},
+		Feeds: FeedsConfig{
+			BaseURL: getEnv("FEEDS_BASE_URL", getEnv("DIGEST_BASE_URL", "http://localhost:8080")),
+		},
+		GuestInvitations: GuestInvitationsConfig{
+			BaseURL:                getEnv("GUEST_INVITATIONS_BASE_URL", getEnv("DIGEST_BASE_URL", "http://localhost:8080")),
+			CleanupEnabled:         getEnvAsBool("GUEST_CLEANUP_ENABLED", true),
+			CleanupIntervalMinutes: getEnvAsInt("GUEST_CLEANUP_INTERVAL_MINUTES", 60),
+		},
+		CommentDrafts: CommentDraftsConfig{
+			TTLDays:                getEnvAsInt("COMMENT_DRAFT_TTL_DAYS", 14),
+			CleanupEnabled:         getEnvAsBool("COMMENT_DRAFT_CLEANUP_ENABLED", true),
+			CleanupIntervalMinutes: getEnvAsInt("COMMENT_DRAFT_CLEANUP_INTERVAL_MINUTES", 60),
+		},
+		Lint: LintConfig{
+			EARSOnSave: getEnvAsBool("EARS_LINT_ON_SAVE", false),
+		},
+		Events: EventsConfig{
+			Publisher:        strings.ToLower(getEnv("EVENTS_PUBLISHER", "")),
+			KafkaRESTURL:     getEnv("EVENTS_KAFKA_REST_URL", ""),
+			NATSURL:          getEnv("EVENTS_NATS_URL", ""),
+			TopicPrefix:      getEnv("EVENTS_TOPIC_PREFIX", "rms"),
+			BatchSize:        getEnvAsInt("EVENTS_BATCH_SIZE", 100),
+			PollIntervalMs:   getEnvAsInt("EVENTS_POLL_INTERVAL_MS", 1000),
+			PublishTimeoutMs: getEnvAsInt("EVENTS_PUBLISH_TIMEOUT_MS", 5000),
+			RetentionDays:    getEnvAsInt("EVENTS_RETENTION_DAYS", 7),
+		},
+		Search: SearchConfig{
+			Languages:         getEnvAsList("SEARCH_LANGUAGES", "english"),
+			CountMode:         getEnv("SEARCH_COUNT_MODE", "exact"),
+			MaxResults:        getEnvAsInt("SEARCH_MAX_RESULTS", 1000),
+			CountCacheSeconds: getEnvAsInt("SEARCH_COUNT_CACHE_SECONDS", 60),
+		},
+		Localization: LocalizationConfig{
+			ContentLocale:      getEnv("CONTENT_LOCALE", "en"),
+			TranslationLocales: getEnvAsList("TRANSLATION_LOCALES", ""),
+		},
+		Demo: DemoConfig{
+			Enabled:              getEnvAsBool("DEMO_ENABLED", false),
+			SessionTTLMinutes:    getEnvAsInt("DEMO_SESSION_TTL_MINUTES", 60),
+			MaxSessions:          getEnvAsInt("DEMO_MAX_SESSIONS", 20),
+			CheckIntervalMinutes: getEnvAsInt("DEMO_CHECK_INTERVAL_MINUTES", 1),
+			SeedFile:             getEnv("DEMO_SEED_FILE", ""),
+		},
+		LLM: LLMConfig{
+			Provider:       strings.ToLower(getEnv("LLM_PROVIDER", "")),
+			APIURL:         getEnv("LLM_API_URL", ""),
+			APIKey:         getEnv("LLM_API_KEY", ""),
+			Model:          getEnv("LLM_MODEL", ""),
+			MaxTokens:      getEnvAsInt("LLM_MAX_TOKENS", 1024),
+			TimeoutSeconds: getEnvAsInt("LLM_TIMEOUT_SECONDS", 60),
+			MaxRetries:     getEnvAsInt("LLM_MAX_RETRIES", 2),
+			RetryBackoffMs: getEnvAsInt("LLM_RETRY_BACKOFF_MS", 500),
+			FeatureModels:  getEnvAsMap("LLM_FEATURE_MODELS"),
+
+			InputPricePerMTokens:  getEnvAsFloat("LLM_INPUT_PRICE_PER_MTOK", 0),
+			OutputPricePerMTokens: getEnvAsFloat("LLM_OUTPUT_PRICE_PER_MTOK", 0),
+			MonthlyBudget:         getEnvAsFloat("LLM_MONTHLY_BUDGET", 0),
+			BudgetAlertPercent:    getEnvAsInt("LLM_BUDGET_ALERT_PERCENT", 80),
+			LogContent:            getEnvAsBool("LLM_LOG_CONTENT", true),
+		},
+		Moderation: ModerationConfig{
+			BlockedKeywords: getEnvAsList("MODERATION_BLOCKED_KEYWORDS", ""),
+			FlaggedKeywords: getEnvAsList("MODERATION_FLAGGED_KEYWORDS", ""),
+			AIClassifier:    getEnvAsBool("MODERATION_AI_CLASSIFIER", false),
+		},
+		CORS: LoadCORSConfig(),
+		RequestValidation: RequestValidationConfig{
+			Enabled: getEnvAsBool("REQUEST_VALIDATION_ENABLED", false),
+		},
+		Security: SecurityHeadersConfig{
+			HSTSEnabled:           getEnvAsBool("SECURITY_HSTS_ENABLED", getEnv("ENVIRONMENT", "development") == "production"),
+			HSTSMaxAgeSeconds:     getEnvAsInt("SECURITY_HSTS_MAX_AGE", 31536000),
+			HSTSIncludeSubdomains: getEnvAsBool("SECURITY_HSTS_INCLUDE_SUBDOMAINS", true),
+			ContentSecurityPolicy: getEnv("SECURITY_CSP", "default-src 'none'; frame-ancestors 'none'"),
+			SwaggerCSP:            getEnv("SECURITY_SWAGGER_CSP", "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; frame-ancestors 'none'"),
+			FrameOptions:          getEnv("SECURITY_FRAME_OPTIONS", "DENY"),
+			ReferrerPolicy:        getEnv("SECURITY_REFERRER_POLICY", "no-referrer"),
+		},
 	}
 
+	requestLimits, err := loadRequestLimitsConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.RequestLimits = requestLimits
+
+	compression, err := loadCompressionConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Compression = compression
+
 	// Validate required configuration
-	if cfg.JWT.Secret == "" || cfg.JWT.Secret == "your-secret-key" {
+	if strings.EqualFold(cfg.JWT.Algorithm, "HS256") && (cfg.JWT.Secret == "" || cfg.JWT.Secret == "your-secret-key") {
 		return nil, fmt.Errorf("JWT_SECRET must be set in production")
 	}
+	if !strings.EqualFold(cfg.JWT.Algorithm, "HS256") && cfg.JWT.PrivateKeyFile == "" {
+		return nil, fmt.Errorf("JWT_PRIVATE_KEY_FILE must be set when JWT_ALGORITHM is %s", cfg.JWT.Algorithm)
+	}
+	if cfg.Database.MaxOpenConns < 1 {
+		return nil, fmt.Errorf("DB_MAX_OPEN_CONNS must be at least 1")
+	}
+	if cfg.Database.MaxIdleConns < 1 || cfg.Database.MaxIdleConns > cfg.Database.MaxOpenConns {
+		return nil, fmt.Errorf("DB_MAX_IDLE_CONNS must be between 1 and DB_MAX_OPEN_CONNS")
+	}
+	if cfg.Database.ConnMaxLifetimeMinutes < 0 || cfg.Database.ConnMaxIdleTimeMinutes < 0 {
+		return nil, fmt.Errorf("DB_CONN_MAX_LIFETIME_MINUTES and DB_CONN_MAX_IDLE_TIME_MINUTES must not be negative")
+	}
+	if err := cfg.Events.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Localization.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Search.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Demo.validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.LLM.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Moderation.AIClassifier && cfg.LLM.Provider == "" {
+		return nil, fmt.Errorf("LLM_PROVIDER must be set when MODERATION_AI_CLASSIFIER is enabled")
+	}
+	if cfg.Observability.Environment == "production" && cfg.CORS.AllowCredentials && cfg.CORS.AllowsAnyOrigin() {
+		return nil, fmt.Errorf("CORS_ALLOWED_ORIGINS must list explicit origins in production when CORS_ALLOW_CREDENTIALS is enabled")
+	}
+	if cfg.Observability.Environment == "production" && cfg.RequestValidation.Enabled {
+		return nil, fmt.Errorf("REQUEST_VALIDATION_ENABLED must not be set in production")
+	}
 
 	return cfg, nil
 }
 
+// validate checks that the selected event publisher is configured
+func (c EventsConfig) validate() error {
+	switch c.Publisher {
+	case "", "log":
+	case "kafka":
+		if c.KafkaRESTURL == "" {
+			return fmt.Errorf("EVENTS_KAFKA_REST_URL must be set when EVENTS_PUBLISHER is kafka")
+		}
+	case "nats":
+		if c.NATSURL == "" {
+			return fmt.Errorf("EVENTS_NATS_URL must be set when EVENTS_PUBLISHER is nats")
+		}
+	default:
+		return fmt.Errorf("EVENTS_PUBLISHER must be kafka, nats or log, got %q", c.Publisher)
+	}
+	if c.Publisher != "" && (c.BatchSize < 1 || c.PollIntervalMs < 1 || c.PublishTimeoutMs < 1 || c.RetentionDays < 0) {
+		return fmt.Errorf("EVENTS_BATCH_SIZE, EVENTS_POLL_INTERVAL_MS and EVENTS_PUBLISH_TIMEOUT_MS must be positive and EVENTS_RETENTION_DAYS must not be negative")
+	}
+	return nil
+}
+
+// validate checks that every search language is a text search configuration PostgreSQL ships with
+// and that the count settings are usable
+func (c SearchConfig) validate() error {
+	for _, language := range c.Languages {
+		if !searchLanguages[language] {
+			return fmt.Errorf("SEARCH_LANGUAGES contains unknown text search configuration %q", language)
+		}
+	}
+	if c.CountMode != "exact" && c.CountMode != "estimated" {
+		return fmt.Errorf("SEARCH_COUNT_MODE must be exact or estimated, got %q", c.CountMode)
+	}
+	if c.MaxResults < 1 || c.CountCacheSeconds < 0 {
+		return fmt.Errorf("SEARCH_MAX_RESULTS must be positive and SEARCH_COUNT_CACHE_SECONDS must not be negative")
+	}
+	return nil
+}
+
+// validate checks that the content and translation locales are language codes with an optional region
+func (c LocalizationConfig) validate() error {
+	if !localePattern.MatchString(c.ContentLocale) {
+		return fmt.Errorf("CONTENT_LOCALE must be a language code such as en or pt-BR, got %q", c.ContentLocale)
+	}
+	for _, locale := range c.TranslationLocales {
+		if !localePattern.MatchString(locale) {
+			return fmt.Errorf("TRANSLATION_LOCALES contains invalid locale %q", locale)
+		}
+	}
+	return nil
+}
+
+// validate checks the session limits when demo mode is enabled
+func (c DemoConfig) validate() error {
+	if c.Enabled && (c.SessionTTLMinutes < 1 || c.MaxSessions < 1 || c.CheckIntervalMinutes < 1) {
+		return fmt.Errorf("DEMO_SESSION_TTL_MINUTES, DEMO_MAX_SESSIONS and DEMO_CHECK_INTERVAL_MINUTES must be positive when DEMO_ENABLED is set")
+	}
+	return nil
+}
+
+// validate checks that the selected language model provider is configured
+func (c LLMConfig) validate() error {
+	switch c.Provider {
+	case "":
+		return nil
+	case "openai", "anthropic", "ollama":
+	default:
+		return fmt.Errorf("LLM_PROVIDER must be openai, anthropic or ollama, got %q", c.Provider)
+	}
+	if c.Model == "" {
+		return fmt.Errorf("LLM_MODEL must be set when LLM_PROVIDER is %s", c.Provider)
+	}
+	if c.MaxTokens < 1 || c.TimeoutSeconds < 1 {
+		return fmt.Errorf("LLM_MAX_TOKENS and LLM_TIMEOUT_SECONDS must be positive")
+	}
+	if c.MaxRetries < 0 || c.RetryBackoffMs < 0 {
+		return fmt.Errorf("LLM_MAX_RETRIES and LLM_RETRY_BACKOFF_MS must not be negative")
+	}
+	for feature, model := range c.FeatureModels {
+		if feature == "" || model == "" {
+			return fmt.Errorf("LLM_FEATURE_MODELS entries must have the form feature=model")
+		}
+	}
+	if c.InputPricePerMTokens < 0 || c.OutputPricePerMTokens < 0 || c.MonthlyBudget < 0 {
+		return fmt.Errorf("LLM_INPUT_PRICE_PER_MTOK, LLM_OUTPUT_PRICE_PER_MTOK and LLM_MONTHLY_BUDGET must not be negative")
+	}
+	if c.BudgetAlertPercent < 1 || c.BudgetAlertPercent > 100 {
+		return fmt.Errorf("LLM_BUDGET_ALERT_PERCENT must be between 1 and 100, got %d", c.BudgetAlertPercent)
+	}
+	return nil
+}
+
+// LoadCORSConfig loads CORS configuration from environment variables with development defaults
+func LoadCORSConfig() CORSConfig {
+	return CORSConfig{
+		AllowedOrigins:   getEnvAsList("CORS_ALLOWED_ORIGINS", "http://localhost:3000,http://localhost:5173,http://localhost:8080"),
+		AllowedMethods:   getEnvAsList("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS"),
+		AllowedHeaders:   getEnvAsList("CORS_ALLOWED_HEADERS", "Origin,Content-Type,Content-Length,Accept-Encoding,X-CSRF-Token,Authorization,X-Request-ID,X-Auth-Skip,X-Suppress-Warnings"),
+		ExposedHeaders:   getEnvAsList("CORS_EXPOSED_HEADERS", "Content-Length"),
+		AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", true),
+		MaxAgeSeconds:    getEnvAsInt("CORS_MAX_AGE", 86400),
+	}
+}
+
+// loadRequestLimitsConfig loads request body limits; sizes accept B, KB, MB and GB suffixes
+func loadRequestLimitsConfig() (RequestLimitsConfig, error) {
+	maxBody, err := ParseByteSize(getEnv("REQUEST_MAX_BODY_SIZE", "1MB"))
+	if err != nil {
+		return RequestLimitsConfig{}, fmt.Errorf("invalid REQUEST_MAX_BODY_SIZE: %w", err)
+	}
+	multipartMemory, err := ParseByteSize(getEnv("REQUEST_MULTIPART_MEMORY", "8MB"))
+	if err != nil {
+		return RequestLimitsConfig{}, fmt.Errorf("invalid REQUEST_MULTIPART_MEMORY: %w", err)
+	}
+
+	routeLimits := make(map[string]int64)
+	for _, entry := range getEnvAsList("REQUEST_ROUTE_MAX_BODY_SIZES", "/api/v1/mcp=4MB") {
+		prefix, size, found := strings.Cut(entry, "=")
+		if !found || !strings.HasPrefix(prefix, "/") {
+			return RequestLimitsConfig{}, fmt.Errorf("invalid REQUEST_ROUTE_MAX_BODY_SIZES entry %q: use /path/prefix=SIZE", entry)
+		}
+		limit, err := ParseByteSize(size)
+		if err != nil {
+			return RequestLimitsConfig{}, fmt.Errorf("invalid REQUEST_ROUTE_MAX_BODY_SIZES entry %q: %w", entry, err)
+		}
+		routeLimits[strings.TrimSpace(prefix)] = limit
+	}
+
+	return RequestLimitsConfig{
+		MaxBodyBytes:         maxBody,
+		RouteMaxBodyBytes:    routeLimits,
+		MultipartMemoryBytes: multipartMemory,
+	}, nil
+}
+
+// loadCompressionConfig loads the compression of responses; the minimum size accepts B, KB, MB and GB suffixes
+func loadCompressionConfig() (CompressionConfig, error) {
+	minSize, err := ParseByteSize(getEnv("COMPRESSION_MIN_SIZE", "1KB"))
+	if err != nil {
+		return CompressionConfig{}, fmt.Errorf("invalid COMPRESSION_MIN_SIZE: %w", err)
+	}
+	level := getEnvAsInt("COMPRESSION_LEVEL", 5)
+	if level < 1 || level > 9 {
+		return CompressionConfig{}, fmt.Errorf("COMPRESSION_LEVEL must be between 1 and 9")
+	}
+
+	return CompressionConfig{
+		Enabled:      getEnvAsBool("COMPRESSION_ENABLED", true),
+		MinSizeBytes: minSize,
+		ContentTypes: getEnvAsList("COMPRESSION_CONTENT_TYPES", "application/json,application/problem+json,text/*,application/javascript,application/xml,application/atom+xml,text/calendar"),
+		Level:        level,
+	}, nil
+}
+
+// ParseByteSize parses sizes such as 512KB, 4MB or 1048576 (bytes)
+func ParseByteSize(value string) (int64, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10}, {"B", 1}} {
+		if strings.HasSuffix(value, unit.suffix) {
+			value = strings.TrimSpace(strings.TrimSuffix(value, unit.suffix))
+			multiplier = unit.size
+			break
+		}
+	}
+
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size <= 0 {
+		return 0, fmt.Errorf("size must be a positive number of bytes, KB, MB or GB")
+	}
+	return size * multiplier, nil
+}
+
+// AllowsAnyOrigin reports whether the wildcard origin is configured
+func (c CORSConfig) AllowsAnyOrigin() bool {
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			return true
+		}
+	}
+	return false
+}
+
 // getEnv gets an environment variable with a fallback value
 func getEnv(key, fallback string) string {
 	if value := os.Getenv(key); value != "" {
@@ -125,6 +724,27 @@ func getEnvAsInt(key string, fallback int) int {
 	return fallback
 }
 
+// getEnvAsMap gets a comma-separated environment variable of key=value pairs; an entry without "=" is
+// kept with an empty value so that validation can report it
+func getEnvAsMap(key string) map[string]string {
+	values := make(map[string]string)
+	for _, entry := range getEnvAsList(key, "") {
+		name, value, _ := strings.Cut(entry, "=")
+		values[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return values
+}
+
+// getEnvAsFloat gets an environment variable as a floating point number with a fallback value
+func getEnvAsFloat(key string, fallback float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return fallback
+}
+
 // getEnvAsBool gets an environment variable as boolean with a fallback value
 func getEnvAsBool(key string, fallback bool) bool {
 	if value := os.Getenv(key); value != "" {
@@ -134,3 +754,14 @@ func getEnvAsBool(key string, fallback bool) bool {
 	}
 	return fallback
 }
+
+// getEnvAsList gets a comma-separated environment variable as a list of trimmed, non-empty values
+func getEnvAsList(key, fallback string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, fallback), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -11,13 +12,17 @@ import (
 	"gorm.io/gorm/logger"
 
 	"product-requirements-management/internal/config"
+	applogger "product-requirements-management/internal/logger"
 	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
 )
 
 // DB holds database connections
 type DB struct {
 	Postgres *gorm.DB
 	Redis    *redis.Client
+	// SlowQueries records queries slower than the configured threshold; nil when disabled
+	SlowQueries *SlowQueryLog
 }
 
 // New creates new database connections
@@ -28,6 +33,15 @@ func New(cfg *config.Config) (*DB, error) {
 		return nil, fmt.Errorf("failed to initialize PostgreSQL: %w", err)
 	}
 
+	// Record slow queries with the handler and service that issued them
+	var slowQueries *SlowQueryLog
+	if cfg.Database.SlowQueryThresholdMs > 0 {
+		slowQueries = NewSlowQueryLog(time.Duration(cfg.Database.SlowQueryThresholdMs)*time.Millisecond, cfg.Database.SlowQueryLogSize, applogger.Logger)
+		if err := pg.Use(slowQueries); err != nil {
+			return nil, fmt.Errorf("failed to register slow query log: %w", err)
+		}
+	}
+
 	// Initialize Redis connection
 	rdb, err := initRedis(cfg.Redis)
 	if err != nil {
@@ -35,8 +49,9 @@ func New(cfg *config.Config) (*DB, error) {
 	}
 
 	db := &DB{
-		Postgres: pg,
-		Redis:    rdb,
+		Postgres:    pg,
+		Redis:       rdb,
+		SlowQueries: slowQueries,
 	}
 
 	// Initialize models (auto-migrate and seed default data)
@@ -60,10 +75,21 @@ func NewPostgresDBWithoutMigrations(cfg *config.Config) (*gorm.DB, error) {
 	return initPostgreSQL(cfg.Database)
 }
 
+// NewSchemaPostgresDB creates a PostgreSQL connection whose unqualified table names resolve to the given
+// schema. Extensions such as uuid-ossp stay reachable in the public schema.
+func NewSchemaPostgresDB(cfg config.DatabaseConfig, schema string) (*gorm.DB, error) {
+	return openPostgreSQL(cfg, fmt.Sprintf(" search_path=%s,public", schema))
+}
+
 // initPostgreSQL initializes PostgreSQL connection with GORM
 func initPostgreSQL(cfg config.DatabaseConfig) (*gorm.DB, error) {
+	return openPostgreSQL(cfg, "")
+}
+
+// openPostgreSQL connects with the given extra connection parameters appended to the DSN
+func openPostgreSQL(cfg config.DatabaseConfig, params string) (*gorm.DB, error) {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=UTC",
-		cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port, cfg.SSLMode)
+		cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port, cfg.SSLMode) + params
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
@@ -75,20 +101,58 @@ func initPostgreSQL(cfg config.DatabaseConfig) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to PostgreSQL: %w", err)
 	}
 
+	// Record text version history for epics, user stories, acceptance criteria and requirements
+	if err := repository.RegisterVersioningCallbacks(db); err != nil {
+		return nil, err
+	}
+	if err := repository.RegisterAuditCallbacks(db); err != nil {
+		return nil, err
+	}
+	// Reject changes to the requirements of frozen epics, whichever service or tool makes them
+	if err := repository.RegisterFreezeCallbacks(db); err != nil {
+		return nil, err
+	}
+
 	// Configure connection pool
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
 	}
 
-	// Set connection pool settings
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
-	sqlDB.SetConnMaxLifetime(time.Hour)
+	configurePool(sqlDB, cfg)
+
+	// Bound every query, also those issued outside of a request
+	if err := db.Use(NewQueryTimeout(time.Duration(cfg.QueryTimeoutMs) * time.Millisecond)); err != nil {
+		return nil, fmt.Errorf("failed to register query timeout: %w", err)
+	}
 
 	return db, nil
 }
 
+// configurePool applies the connection pool settings, falling back to the defaults for unset values
+func configurePool(sqlDB *sql.DB, cfg config.DatabaseConfig) {
+	maxOpen := cfg.MaxOpenConns
+	if maxOpen <= 0 {
+		maxOpen = 100
+	}
+	maxIdle := cfg.MaxIdleConns
+	if maxIdle <= 0 {
+		maxIdle = 10
+	}
+	if maxIdle > maxOpen {
+		maxIdle = maxOpen
+	}
+	lifetime := time.Hour
+	if cfg.ConnMaxLifetimeMinutes > 0 {
+		lifetime = time.Duration(cfg.ConnMaxLifetimeMinutes) * time.Minute
+	}
+
+	sqlDB.SetMaxOpenConns(maxOpen)
+	sqlDB.SetMaxIdleConns(maxIdle)
+	sqlDB.SetConnMaxLifetime(lifetime)
+	sqlDB.SetConnMaxIdleTime(time.Duration(cfg.ConnMaxIdleTimeMinutes) * time.Minute)
+}
+
 // initRedis initializes Redis connection
 func initRedis(cfg config.RedisConfig) (*redis.Client, error) {
 	rdb := redis.NewClient(&redis.Options{
@@ -2,16 +2,77 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 )
 
+// poolSaturationWarning is the share of the maximum open connections in use above which the pool is reported as near saturation
+const poolSaturationWarning = 0.8
+
 // HealthStatus represents the health status of a database component
 type HealthStatus struct {
 	Status  string `json:"status"`
 	Message string `json:"message,omitempty"`
 }
 
+// PoolStats describes the PostgreSQL connection pool; wait and closed counts are cumulative since startup
+type PoolStats struct {
+	MaxOpenConnections int     `json:"max_open_connections"`
+	OpenConnections    int     `json:"open_connections"`
+	InUse              int     `json:"in_use"`
+	Idle               int     `json:"idle"`
+	Utilization        float64 `json:"utilization"`
+	WaitCount          int64   `json:"wait_count"`
+	WaitDurationMs     float64 `json:"wait_duration_ms"`
+	MaxIdleClosed      int64   `json:"max_idle_closed"`
+	MaxIdleTimeClosed  int64   `json:"max_idle_time_closed"`
+	MaxLifetimeClosed  int64   `json:"max_lifetime_closed"`
+}
+
+// NewPoolStats converts sql.DBStats into pool statistics; utilization is the share of the maximum open connections in use
+func NewPoolStats(stats sql.DBStats) PoolStats {
+	poolStats := PoolStats{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDurationMs:     durationMs(stats.WaitDuration),
+		MaxIdleClosed:      stats.MaxIdleClosed,
+		MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+	}
+	if stats.MaxOpenConnections > 0 {
+		poolStats.Utilization = float64(stats.InUse) / float64(stats.MaxOpenConnections)
+	}
+	return poolStats
+}
+
+// Health reports whether the pool is saturated (every connection in use with callers waiting)
+// or near saturation, and healthy otherwise
+func (s PoolStats) Health() HealthStatus {
+	switch {
+	case s.MaxOpenConnections > 0 && s.InUse >= s.MaxOpenConnections && s.WaitCount > 0:
+		return HealthStatus{Status: "warning", Message: fmt.Sprintf("Connection pool saturated (in use: %d of %d, waits: %d)", s.InUse, s.MaxOpenConnections, s.WaitCount)}
+	case s.Utilization >= poolSaturationWarning:
+		return HealthStatus{Status: "warning", Message: fmt.Sprintf("Connection pool near saturation (in use: %d of %d)", s.InUse, s.MaxOpenConnections)}
+	}
+	return HealthStatus{Status: "healthy", Message: fmt.Sprintf("Connection pool is healthy (in use: %d of %d, idle: %d)", s.InUse, s.MaxOpenConnections, s.Idle)}
+}
+
+// PoolStats returns the PostgreSQL connection pool statistics
+func (db *DB) PoolStats() (PoolStats, error) {
+	if db.Postgres == nil {
+		return PoolStats{}, fmt.Errorf("PostgreSQL connection not initialized")
+	}
+	sqlDB, err := db.Postgres.DB()
+	if err != nil {
+		return PoolStats{}, fmt.Errorf("failed to get SQL DB: %w", err)
+	}
+	return NewPoolStats(sqlDB.Stats()), nil
+}
+
 // HealthCheck represents the overall health check result
 type HealthCheck struct {
 	PostgreSQL HealthStatus `json:"postgresql"`
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPoolStats(t *testing.T) {
+	stats := NewPoolStats(sql.DBStats{
+		MaxOpenConnections: 20,
+		OpenConnections:    12,
+		InUse:              5,
+		Idle:               7,
+		WaitCount:          3,
+		WaitDuration:       250 * time.Millisecond,
+		MaxIdleClosed:      4,
+	})
+
+	assert.Equal(t, 20, stats.MaxOpenConnections)
+	assert.Equal(t, 0.25, stats.Utilization)
+	assert.Equal(t, int64(3), stats.WaitCount)
+	assert.Equal(t, 250.0, stats.WaitDurationMs)
+	assert.Equal(t, int64(4), stats.MaxIdleClosed)
+	assert.Equal(t, "healthy", stats.Health().Status)
+}
+
+func TestPoolStats_Health(t *testing.T) {
+	tests := []struct {
+		name    string
+		stats   sql.DBStats
+		status  string
+		message string
+	}{
+		{"healthy", sql.DBStats{MaxOpenConnections: 10, InUse: 2, Idle: 3}, "healthy", "Connection pool is healthy"},
+		{"near saturation", sql.DBStats{MaxOpenConnections: 10, InUse: 8}, "warning", "near saturation"},
+		{"saturated", sql.DBStats{MaxOpenConnections: 10, InUse: 10, WaitCount: 5}, "warning", "saturated"},
+		{"unlimited pool", sql.DBStats{InUse: 50}, "healthy", "Connection pool is healthy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health := NewPoolStats(tt.stats).Health()
+			assert.Equal(t, tt.status, health.Status)
+			assert.Contains(t, health.Message, tt.message)
+		})
+	}
+}
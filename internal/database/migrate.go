@@ -2,6 +2,7 @@ package database
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"product-requirements-management/internal/config"
@@ -39,6 +40,16 @@ func RunMigrations(db *gorm.DB, cfg *config.Config) error {
 
 // RunMigrationsWithConnection runs migrations using the provided database connection
 func RunMigrationsWithConnection(db *gorm.DB, migrationsDir string) error {
+	return runMigrations(migrationsDir, "")
+}
+
+// RunMigrationsInSchema runs all migrations into an existing schema, which gets its own migration history
+func RunMigrationsInSchema(migrationsDir, schema string) error {
+	return runMigrations(migrationsDir, "&search_path="+url.QueryEscape(schema+",public"))
+}
+
+// runMigrations applies pending migrations, passing params as extra connection URL parameters
+func runMigrations(migrationsDir, params string) error {
 	// Get absolute path for migrations directory
 	absPath, err := filepath.Abs(migrationsDir)
 	if err != nil {
@@ -58,7 +69,7 @@ func RunMigrationsWithConnection(db *gorm.DB, migrationsDir string) error {
 	sslmode := getEnvOrDefault("DB_SSLMODE", "disable")
 
 	databaseURL := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
-		user, password, host, port, dbname, sslmode)
+		user, password, host, port, dbname, sslmode) + params
 
 	migrator, err := migrate.New(
 		fmt.Sprintf("file://%s", absPath),
@@ -205,3 +216,40 @@ func (m *MigrationManager) GetPendingMigrations() (int, error) {
 	_ = version
 	return 0, nil
 }
+
+// Preflight analyzes the migrations newer than the recorded version for operations that block or fail on
+// populated tables
+func (m *MigrationManager) Preflight() (*PreflightReport, error) {
+	migrations, err := LoadMigrations(m.migrationsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PreflightReport{}
+	if m.db.Migrator().HasTable("schema_migrations") {
+		if report.CurrentVersion, _, err = (migrationVersions{db: m.db}).get(); err != nil {
+			return nil, err
+		}
+	}
+	for _, migration := range migrations {
+		if migration.Version > report.CurrentVersion {
+			report.Migrations = append(report.Migrations, migration)
+		}
+	}
+	report.Findings = AnalyzeMigrations(report.Migrations)
+	return report, nil
+}
+
+// RunMigrationsOnline runs all pending migrations in the online mode: index builds on existing tables use
+// CREATE INDEX CONCURRENTLY and statements marked with a backfill directive run in batches. It returns the
+// number of migrations applied.
+func (m *MigrationManager) RunMigrationsOnline(opts OnlineMigrationOptions) (int, error) {
+	migrations, err := LoadMigrations(m.migrationsDir)
+	if err != nil {
+		return 0, err
+	}
+	if len(migrations) == 0 {
+		return 0, fmt.Errorf("no migration files found in %s", m.migrationsDir)
+	}
+	return runOnlineMigrations(m.db, migrations, opts)
+}
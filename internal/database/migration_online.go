@@ -0,0 +1,442 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	migratedb "github.com/golang-migrate/migrate/v4/database"
+	"gorm.io/gorm"
+)
+
+// DefaultBackfillBatchSize is the number of rows a backfill changes per batch unless configured otherwise
+const DefaultBackfillBatchSize = 1000
+
+// backfillDirective marks an UPDATE or DELETE that the online mode runs in batches:
+//
+//	-- migrate:backfill batch_size=500 key=id
+//	UPDATE requirements SET priority = 3 WHERE priority IS NULL;
+//
+// Each batch changes up to batch_size rows selected by their key column (default id) until no row matches,
+// so the WHERE clause must exclude the rows the statement has already changed.
+const backfillDirective = "backfill"
+
+// Operations of the steps an online migration is split into
+const (
+	OnlineOperationTransaction     = "transaction"      // Regular statements, run together in one transaction
+	OnlineOperationConcurrentIndex = "concurrent_index" // CREATE or DROP INDEX CONCURRENTLY, run outside a transaction
+	OnlineOperationBackfill        = "backfill"         // Batched UPDATE or DELETE, each batch in its own transaction
+)
+
+// OnlineMigrationOptions configures an online migration run
+type OnlineMigrationOptions struct {
+	BatchSize  int                   // Rows per backfill batch unless the directive sets batch_size; defaults to DefaultBackfillBatchSize
+	BatchPause time.Duration         // Pause between backfill batches, giving replicas and autovacuum time to catch up
+	Progress   MigrationProgressFunc // Called when a step starts, after every backfill batch and when a migration completes
+}
+
+// MigrationProgress reports the progress of an online migration run
+type MigrationProgress struct {
+	Version   uint
+	Name      string
+	Step      int // 1-based step of the migration
+	Steps     int
+	Operation string
+	Statement string // Abbreviated statement of concurrent index and backfill steps
+	RowsDone  int64  // Rows changed so far by a backfill
+	RowsTotal int64  // Rows matching a backfill when it started, plus rows matched since
+	Done      bool   // The migration is complete
+}
+
+// MigrationProgressFunc receives progress reports of an online migration run
+type MigrationProgressFunc func(MigrationProgress)
+
+// onlineStep is a unit of an online migration
+type onlineStep struct {
+	operation  string
+	statements []string // transaction: statements in order; concurrent_index: the single statement
+	backfill   *backfill
+}
+
+// backfill is an UPDATE or DELETE split into batches by key
+type backfill struct {
+	table     string
+	key       string
+	batchSize int
+	head      string // Statement up to its WHERE clause
+	condition string // WHERE clause without the keyword
+}
+
+var (
+	backfillUpdatePattern  = regexp.MustCompile(`(?is)^UPDATE\s+(?:ONLY\s+)?([^\s(]+)\s+SET\s`)
+	backfillDeletePattern  = regexp.MustCompile(`(?is)^DELETE\s+FROM\s+(?:ONLY\s+)?([^\s(]+)\s+WHERE\s`)
+	identifierPattern      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	createIndexKeyword     = regexp.MustCompile(`(?is)^(CREATE\s+(?:UNIQUE\s+)?INDEX)\s+`)
+	dropIndexKeyword       = regexp.MustCompile(`(?is)^(DROP\s+INDEX)\s+`)
+	errBackfillNotBatched  = errors.New("backfill does not converge: its WHERE clause must exclude the rows it has changed")
+	errDirtyMigrationState = errors.New("database is in a dirty migration state - manual intervention required")
+)
+
+// parseBackfill validates a statement marked as backfill and splits it at its WHERE clause
+func parseBackfill(statement MigrationStatement) (*backfill, error) {
+	options := statement.Directives[backfillDirective]
+	b := &backfill{key: "id"}
+	if key, ok := options["key"]; ok {
+		if !identifierPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid backfill key column %q", key)
+		}
+		b.key = key
+	}
+	if size, ok := options["batch_size"]; ok {
+		n, err := strconv.Atoi(size)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid backfill batch_size %q", size)
+		}
+		b.batchSize = n
+	}
+
+	code := statement.Code
+	isDelete := false
+	match := backfillUpdatePattern.FindStringSubmatch(code)
+	if match == nil {
+		match, isDelete = backfillDeletePattern.FindStringSubmatch(code), true
+	}
+	if match == nil {
+		return nil, errors.New("a backfill must be an UPDATE ... SET or DELETE FROM of a single table without an alias")
+	}
+	b.table = match[1]
+
+	where := -1
+	for i, keyword := range topLevelKeywords(code) {
+		switch {
+		case keyword.word == "WHERE":
+			if where < 0 {
+				where = keyword.offset
+			}
+		case isDelete && i == 0 && keyword.word == "FROM":
+			// The FROM of DELETE FROM itself
+		default:
+			// Joins and RETURNING cannot be batched by key
+			return nil, fmt.Errorf("a backfill cannot use %s", keyword.word)
+		}
+	}
+	if where < 0 {
+		return nil, errors.New("a backfill needs a WHERE clause selecting the rows still to change")
+	}
+	b.head = strings.TrimSpace(code[:where])
+	b.condition = strings.TrimSpace(code[where+len("WHERE"):])
+	return b, nil
+}
+
+// countSQL counts the rows still to change
+func (b *backfill) countSQL() string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", b.table, b.condition)
+}
+
+// batchSQL changes the next batch of rows
+func (b *backfill) batchSQL(batchSize int) string {
+	return fmt.Sprintf("%s WHERE %s IN (SELECT %s FROM %s WHERE %s LIMIT %d)", b.head, b.key, b.key, b.table, b.condition, batchSize)
+}
+
+// sqlKeyword is a keyword found outside parentheses, literals and quoted identifiers
+type sqlKeyword struct {
+	word   string
+	offset int
+}
+
+// topLevelKeywords finds WHERE, FROM, USING and RETURNING at parenthesis depth zero
+func topLevelKeywords(code string) []sqlKeyword {
+	var keywords []sqlKeyword
+	depth := 0
+	for i := 0; i < len(code); {
+		switch c := code[i]; {
+		case c == '\'' || c == '"':
+			i += quotedLength(code[i:], c)
+			continue
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && (i == 0 || !isIdentifierByte(code[i-1])):
+			for _, word := range []string{"WHERE", "FROM", "USING", "RETURNING"} {
+				end := i + len(word)
+				if end <= len(code) && strings.EqualFold(code[i:end], word) && (end == len(code) || !isIdentifierByte(code[end])) {
+					keywords = append(keywords, sqlKeyword{word: word, offset: i})
+				}
+			}
+		}
+		i++
+	}
+	return keywords
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '.' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// planOnlineMigration splits a migration into steps. Index builds and drops on tables that existed before the
+// pending migrations run concurrently and marked backfills run in batches; everything in between runs in
+// transactions. created collects the tables created by the pending migrations planned so far.
+func planOnlineMigration(migration Migration, created map[string]bool) ([]onlineStep, error) {
+	var steps []onlineStep
+	appendToTransaction := func(code string) {
+		if n := len(steps); n > 0 && steps[n-1].operation == OnlineOperationTransaction {
+			steps[n-1].statements = append(steps[n-1].statements, code)
+			return
+		}
+		steps = append(steps, onlineStep{operation: OnlineOperationTransaction, statements: []string{code}})
+	}
+
+	for _, statement := range migration.Statements() {
+		if _, ok := statement.Directives[backfillDirective]; ok {
+			b, err := parseBackfill(statement)
+			if err != nil {
+				return nil, fmt.Errorf("migration %d statement %d: %w", migration.Version, statement.Index, err)
+			}
+			steps = append(steps, onlineStep{operation: OnlineOperationBackfill, backfill: b})
+			continue
+		}
+
+		sql := statement.normalized()
+		switch {
+		case createTablePattern.MatchString(sql):
+			created[tableName(createTablePattern.FindStringSubmatch(sql)[1])] = true
+			appendToTransaction(statement.Code)
+
+		case createIndexPattern.MatchString(sql):
+			match := createIndexPattern.FindStringSubmatch(sql)
+			switch {
+			case match[2] != "":
+				steps = append(steps, onlineStep{operation: OnlineOperationConcurrentIndex, statements: []string{statement.Code}})
+			case created[tableName(match[3])]:
+				appendToTransaction(statement.Code)
+			default:
+				steps = append(steps, onlineStep{
+					operation:  OnlineOperationConcurrentIndex,
+					statements: []string{createIndexKeyword.ReplaceAllString(statement.Code, "$1 CONCURRENTLY ")},
+				})
+			}
+
+		case dropIndexPattern.MatchString(sql):
+			match := dropIndexPattern.FindStringSubmatch(sql)
+			switch {
+			case match[1] != "":
+				steps = append(steps, onlineStep{operation: OnlineOperationConcurrentIndex, statements: []string{statement.Code}})
+			case match[3] == "" && !strings.Contains(sql, ","):
+				steps = append(steps, onlineStep{
+					operation:  OnlineOperationConcurrentIndex,
+					statements: []string{dropIndexKeyword.ReplaceAllString(statement.Code, "$1 CONCURRENTLY ")},
+				})
+			default:
+				appendToTransaction(statement.Code)
+			}
+
+		default:
+			appendToTransaction(statement.Code)
+		}
+	}
+	return steps, nil
+}
+
+// runOnlineMigrations applies the migrations newer than the recorded version step by step on a single
+// connection, recording the version like golang-migrate does so both modes can be mixed. It returns the
+// number of migrations applied.
+func runOnlineMigrations(db *gorm.DB, migrations []Migration, opts OnlineMigrationOptions) (int, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBackfillBatchSize
+	}
+	progress := opts.Progress
+	if progress == nil {
+		progress = func(MigrationProgress) {}
+	}
+
+	applied := 0
+	err := db.Connection(func(conn *gorm.DB) error {
+		if conn.Dialector.Name() == "postgres" {
+			unlock, err := lockMigrations(conn)
+			if err != nil {
+				return err
+			}
+			defer unlock()
+		}
+
+		versions := migrationVersions{db: conn}
+		if err := versions.ensureTable(); err != nil {
+			return err
+		}
+		current, dirty, err := versions.get()
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("%w (version: %d)", errDirtyMigrationState, current)
+		}
+
+		created := make(map[string]bool)
+		for _, migration := range migrations {
+			if migration.Version <= current {
+				continue
+			}
+			steps, err := planOnlineMigration(migration, created)
+			if err != nil {
+				return err
+			}
+
+			if err := versions.set(migration.Version, true); err != nil {
+				return err
+			}
+			for i, step := range steps {
+				report := MigrationProgress{
+					Version:   migration.Version,
+					Name:      migration.Name,
+					Step:      i + 1,
+					Steps:     len(steps),
+					Operation: step.operation,
+				}
+				if err := runOnlineStep(conn, step, report, opts, progress); err != nil {
+					return fmt.Errorf("migration %d (%s) failed in step %d of %d: %w", migration.Version, migration.Name, i+1, len(steps), err)
+				}
+			}
+			if err := versions.set(migration.Version, false); err != nil {
+				return err
+			}
+
+			applied++
+			progress(MigrationProgress{Version: migration.Version, Name: migration.Name, Step: len(steps), Steps: len(steps), Done: true})
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// runOnlineStep executes one step of an online migration
+func runOnlineStep(conn *gorm.DB, step onlineStep, report MigrationProgress, opts OnlineMigrationOptions, progress MigrationProgressFunc) error {
+	switch step.operation {
+	case OnlineOperationTransaction:
+		progress(report)
+		return conn.Transaction(func(tx *gorm.DB) error {
+			for _, statement := range step.statements {
+				if err := tx.Exec(statement).Error; err != nil {
+					return fmt.Errorf("%w in %q", err, abbreviateStatement(statement))
+				}
+			}
+			return nil
+		})
+
+	case OnlineOperationConcurrentIndex:
+		report.Statement = abbreviateStatement(step.statements[0])
+		progress(report)
+		return conn.Exec(step.statements[0]).Error
+
+	case OnlineOperationBackfill:
+		b := step.backfill
+		batchSize := b.batchSize
+		if batchSize == 0 {
+			batchSize = opts.BatchSize
+		}
+		report.Statement = abbreviateStatement(b.head)
+
+		if err := conn.Raw(b.countSQL()).Scan(&report.RowsTotal).Error; err != nil {
+			return err
+		}
+		progress(report)
+
+		// Rows written while the backfill runs may match too, so allow for some more batches than counted
+		maxBatches := 2*(report.RowsTotal/int64(batchSize)+1) + 10
+		for batch := int64(1); ; batch++ {
+			result := conn.Exec(b.batchSQL(batchSize))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return nil
+			}
+			report.RowsDone += result.RowsAffected
+			if report.RowsDone > report.RowsTotal {
+				report.RowsTotal = report.RowsDone
+			}
+			progress(report)
+
+			if batch >= maxBatches {
+				return errBackfillNotBatched
+			}
+			if opts.BatchPause > 0 {
+				time.Sleep(opts.BatchPause)
+			}
+		}
+	}
+	return fmt.Errorf("unknown migration step %q", step.operation)
+}
+
+// lockMigrations takes the advisory lock golang-migrate uses, so online and standard runs exclude each other
+func lockMigrations(conn *gorm.DB) (func(), error) {
+	var names struct {
+		Database string
+		Schema   string
+	}
+	if err := conn.Raw("SELECT current_database() AS database, current_schema() AS schema").Scan(&names).Error; err != nil {
+		return nil, fmt.Errorf("failed to read database name: %w", err)
+	}
+	lockID, err := migratedb.GenerateAdvisoryLockId(names.Database, names.Schema, "schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.Exec("SELECT pg_advisory_lock(?)", lockID).Error; err != nil {
+		return nil, fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	return func() {
+		conn.Exec("SELECT pg_advisory_unlock(?)", lockID)
+	}, nil
+}
+
+// migrationVersions reads and writes the schema_migrations table maintained by golang-migrate
+type migrationVersions struct {
+	db *gorm.DB
+}
+
+func (v migrationVersions) ensureTable() error {
+	if err := v.db.Exec("CREATE TABLE IF NOT EXISTS schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)").Error; err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+	return nil
+}
+
+// get returns the recorded version; 0 when no migration has run
+func (v migrationVersions) get() (uint, bool, error) {
+	var rows []struct {
+		Version int64
+		Dirty   bool
+	}
+	if err := v.db.Raw("SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&rows).Error; err != nil {
+		return 0, false, fmt.Errorf("failed to read migration version: %w", err)
+	}
+	if len(rows) == 0 || rows[0].Version < 0 {
+		return 0, false, nil
+	}
+	return uint(rows[0].Version), rows[0].Dirty, nil
+}
+
+func (v migrationVersions) set(version uint, dirty bool) error {
+	err := v.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM schema_migrations").Error; err != nil {
+			return err
+		}
+		return tx.Exec("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)", version, dirty).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record migration version %d: %w", version, err)
+	}
+	return nil
+}
+
+// abbreviateStatement collapses the whitespace of a statement and shortens it to 80 characters for progress output
+func abbreviateStatement(statement string) string {
+	statement = strings.TrimSpace(whitespacePattern.ReplaceAllString(statement, " "))
+	if len(statement) > 80 {
+		return statement[:77] + "..."
+	}
+	return statement
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupOnlineMigrationDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	// Every connection to :memory: is a database of its own
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
+
+func TestParseBackfill(t *testing.T) {
+	statement := MigrationStatement{
+		Code:       "UPDATE requirements SET priority = 3 WHERE priority IS NULL AND title <> 'where'",
+		Directives: map[string]map[string]string{backfillDirective: {"batch_size": "500"}},
+	}
+	b, err := parseBackfill(statement)
+	require.NoError(t, err)
+	assert.Equal(t, "requirements", b.table)
+	assert.Equal(t, "id", b.key)
+	assert.Equal(t, 500, b.batchSize)
+	assert.Equal(t, "SELECT COUNT(*) FROM requirements WHERE priority IS NULL AND title <> 'where'", b.countSQL())
+	assert.Equal(t,
+		"UPDATE requirements SET priority = 3 WHERE id IN (SELECT id FROM requirements WHERE priority IS NULL AND title <> 'where' LIMIT 10)",
+		b.batchSQL(10))
+
+	statement = MigrationStatement{
+		Code:       "DELETE FROM audit_logs WHERE created_at < NOW() - INTERVAL '1 year'",
+		Directives: map[string]map[string]string{backfillDirective: {"key": "log_id"}},
+	}
+	b, err = parseBackfill(statement)
+	require.NoError(t, err)
+	assert.Equal(t, "DELETE FROM audit_logs WHERE log_id IN (SELECT log_id FROM audit_logs WHERE created_at < NOW() - INTERVAL '1 year' LIMIT 5)", b.batchSQL(5))
+
+	for _, invalid := range []MigrationStatement{
+		{Code: "UPDATE t SET a = 1 WHERE a IS NULL RETURNING id", Directives: map[string]map[string]string{backfillDirective: {}}},
+		{Code: "DELETE FROM t USING u WHERE t.u_id = u.id", Directives: map[string]map[string]string{backfillDirective: {}}},
+		{Code: "UPDATE t SET a = 1 WHERE a IS NULL", Directives: map[string]map[string]string{backfillDirective: {"key": "id; DROP TABLE t"}}},
+		{Code: "UPDATE t SET a = 1 WHERE a IS NULL", Directives: map[string]map[string]string{backfillDirective: {"batch_size": "0"}}},
+		{Code: "INSERT INTO t SELECT * FROM u", Directives: map[string]map[string]string{backfillDirective: {}}},
+	} {
+		_, err := parseBackfill(invalid)
+		assert.Error(t, err, invalid.Code)
+	}
+}
+
+func TestPlanOnlineMigration(t *testing.T) {
+	created := map[string]bool{}
+	steps, err := planOnlineMigration(Migration{Version: 7, SQL: `
+CREATE TABLE labels (id uuid PRIMARY KEY, name text);
+CREATE INDEX idx_labels_name ON labels (name);
+ALTER TABLE requirements ADD COLUMN label_id uuid;
+CREATE UNIQUE INDEX idx_requirements_label ON requirements (label_id);
+-- migrate:backfill
+UPDATE requirements SET label_id = NULL WHERE label_id IS NOT NULL;
+DROP INDEX idx_requirements_old;
+COMMENT ON TABLE labels IS 'Labels';`}, created)
+	require.NoError(t, err)
+
+	require.Len(t, steps, 5)
+	assert.Equal(t, OnlineOperationTransaction, steps[0].operation)
+	assert.Len(t, steps[0].statements, 3)
+	assert.Equal(t, OnlineOperationConcurrentIndex, steps[1].operation)
+	assert.Equal(t, "CREATE UNIQUE INDEX CONCURRENTLY idx_requirements_label ON requirements (label_id)", steps[1].statements[0])
+	assert.Equal(t, OnlineOperationBackfill, steps[2].operation)
+	assert.Equal(t, "DROP INDEX CONCURRENTLY idx_requirements_old", steps[3].statements[0])
+	assert.Equal(t, OnlineOperationTransaction, steps[4].operation)
+	assert.True(t, created["labels"])
+}
+
+func TestRunOnlineMigrations(t *testing.T) {
+	db := setupOnlineMigrationDB(t)
+	migrations := []Migration{
+		{Version: 1, Name: "create_items", SQL: `
+CREATE TABLE items (id integer PRIMARY KEY, name text, processed boolean NOT NULL DEFAULT false);
+INSERT INTO items (id, name) VALUES (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd'), (5, 'e');`},
+		{Version: 2, Name: "process_items", SQL: `
+-- migrate:backfill batch_size=2
+UPDATE items SET processed = true WHERE processed = false;`},
+	}
+
+	var reports []MigrationProgress
+	applied, err := runOnlineMigrations(db, migrations, OnlineMigrationOptions{
+		Progress: func(p MigrationProgress) { reports = append(reports, p) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, applied)
+
+	var processed int64
+	require.NoError(t, db.Raw("SELECT COUNT(*) FROM items WHERE processed").Scan(&processed).Error)
+	assert.Equal(t, int64(5), processed)
+
+	var backfill []MigrationProgress
+	for _, report := range reports {
+		if report.Operation == OnlineOperationBackfill {
+			backfill = append(backfill, report)
+		}
+	}
+	require.Len(t, backfill, 4)
+	assert.Equal(t, int64(5), backfill[0].RowsTotal)
+	assert.Equal(t, []int64{0, 2, 4, 5}, []int64{backfill[0].RowsDone, backfill[1].RowsDone, backfill[2].RowsDone, backfill[3].RowsDone})
+	assert.True(t, reports[len(reports)-1].Done)
+
+	version, dirty, err := migrationVersions{db: db}.get()
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), version)
+	assert.False(t, dirty)
+
+	t.Run("skips applied migrations", func(t *testing.T) {
+		applied, err := runOnlineMigrations(db, migrations, OnlineMigrationOptions{})
+		require.NoError(t, err)
+		assert.Zero(t, applied)
+	})
+
+	t.Run("leaves a failed migration dirty", func(t *testing.T) {
+		failing := append(migrations, Migration{Version: 3, Name: "broken", SQL: "ALTER TABLE missing ADD COLUMN a text;"})
+		_, err := runOnlineMigrations(db, failing, OnlineMigrationOptions{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "migration 3 (broken) failed in step 1 of 1")
+
+		_, err = runOnlineMigrations(db, failing, OnlineMigrationOptions{})
+		assert.ErrorIs(t, err, errDirtyMigrationState)
+	})
+}
+
+func TestRunOnlineMigrations_BackfillThatDoesNotConverge(t *testing.T) {
+	db := setupOnlineMigrationDB(t)
+	migrations := []Migration{
+		{Version: 1, Name: "create_items", SQL: `
+CREATE TABLE items (id integer PRIMARY KEY, counter integer NOT NULL DEFAULT 0);
+INSERT INTO items (id) VALUES (1), (2), (3);`},
+		{Version: 2, Name: "increment", SQL: `
+-- migrate:backfill batch_size=1
+UPDATE items SET counter = counter + 1 WHERE counter >= 0;`},
+	}
+
+	_, err := runOnlineMigrations(db, migrations, OnlineMigrationOptions{})
+	assert.ErrorIs(t, err, errBackfillNotBatched)
+}
@@ -0,0 +1,250 @@
+package database
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PreflightSeverity classifies a pre-flight finding
+type PreflightSeverity string
+
+const (
+	// PreflightBlocking marks operations that lock a table against writes for a time that grows with its size
+	PreflightBlocking PreflightSeverity = "blocking"
+	// PreflightFailure marks statements that fail on a populated table or in the standard migration mode
+	PreflightFailure PreflightSeverity = "failure"
+	// PreflightWarning marks operations that are safe to run but need care during a rolling deployment
+	PreflightWarning PreflightSeverity = "warning"
+)
+
+// PreflightFinding is a potentially disruptive operation found in a pending migration
+type PreflightFinding struct {
+	Version    uint              `json:"version"`
+	Migration  string            `json:"migration"`
+	Statement  int               `json:"statement"`
+	Table      string            `json:"table"`
+	Severity   PreflightSeverity `json:"severity"`
+	Operation  string            `json:"operation"`
+	Message    string            `json:"message"`
+	Suggestion string            `json:"suggestion,omitempty"`
+	// Online reports whether the online migration mode runs the operation without the problem
+	Online bool `json:"online"`
+}
+
+// String formats the finding for command line output
+func (f PreflightFinding) String() string {
+	s := fmt.Sprintf("[%s] %06d_%s statement %d (%s): %s", f.Severity, f.Version, f.Migration, f.Statement, f.Table, f.Message)
+	if f.Online {
+		s += " Handled by the online mode."
+	} else if f.Suggestion != "" {
+		s += " " + f.Suggestion
+	}
+	return s
+}
+
+// PreflightReport is the outcome of analyzing the pending migrations
+type PreflightReport struct {
+	CurrentVersion uint               `json:"current_version"`
+	Migrations     []Migration        `json:"-"`
+	Findings       []PreflightFinding `json:"findings"`
+}
+
+// Pending returns the number of migrations analyzed
+func (r *PreflightReport) Pending() int {
+	return len(r.Migrations)
+}
+
+// Disruptive returns the blocking and failing findings, optionally leaving out those the online mode handles
+func (r *PreflightReport) Disruptive(online bool) []PreflightFinding {
+	var findings []PreflightFinding
+	for _, finding := range r.Findings {
+		if finding.Severity == PreflightWarning || (online && finding.Online) {
+			continue
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
+var (
+	createTablePattern     = regexp.MustCompile(`^CREATE (?:UNLOGGED |TEMP |TEMPORARY )?TABLE (?:IF NOT EXISTS )?([^\s(]+)`)
+	createIndexPattern     = regexp.MustCompile(`^CREATE (UNIQUE )?INDEX (CONCURRENTLY )?(?:IF NOT EXISTS )?(?:[^\s(]+ )?ON (?:ONLY )?([^\s(]+)`)
+	dropIndexPattern       = regexp.MustCompile(`^DROP INDEX (CONCURRENTLY )?(?:IF EXISTS )?([^\s,;]+)( CASCADE)?`)
+	alterTablePattern      = regexp.MustCompile(`^ALTER TABLE (?:IF EXISTS )?(?:ONLY )?([^\s(]+) `)
+	updatePattern          = regexp.MustCompile(`^UPDATE (?:ONLY )?([^\s(]+)`)
+	deletePattern          = regexp.MustCompile(`^DELETE FROM (?:ONLY )?([^\s(]+)`)
+	lockingCommandPattern  = regexp.MustCompile(`^(VACUUM FULL|CLUSTER|LOCK TABLE|REINDEX)\b`)
+	reindexConcurrently    = regexp.MustCompile(`^REINDEX (?:\(.*\) )?(?:INDEX|TABLE|SCHEMA|DATABASE) CONCURRENTLY\b`)
+	alterColumnTypePattern = regexp.MustCompile(`ALTER (?:COLUMN )?\S+ (?:SET DATA )?TYPE `)
+	setNotNullPattern      = regexp.MustCompile(`ALTER (?:COLUMN )?\S+ SET NOT NULL`)
+	addColumnPattern       = regexp.MustCompile(`ADD (?:COLUMN )?(?:IF NOT EXISTS )?([^\s,]+) ([^,]*)`)
+	addConstraintPattern   = regexp.MustCompile(`ADD (?:CONSTRAINT \S+ )?(FOREIGN KEY|CHECK|UNIQUE|PRIMARY KEY|EXCLUDE)\b([^,]*)`)
+	renamePattern          = regexp.MustCompile(`\bRENAME (?:COLUMN |CONSTRAINT )?\S+ TO\b|\bRENAME TO\b`)
+	dropColumnPattern      = regexp.MustCompile(`\bDROP (?:COLUMN )?(?:IF EXISTS )?([^\s,]+)`)
+	volatileDefaultPattern = regexp.MustCompile(`DEFAULT .*\b(UUID_GENERATE_V[14]|GEN_RANDOM_UUID|RANDOM|CLOCK_TIMESTAMP|TIMEOFDAY)\s*\(`)
+)
+
+// tableConstraintKeywords start table constraints rather than column definitions after ADD
+var tableConstraintKeywords = map[string]bool{"CONSTRAINT": true, "PRIMARY": true, "FOREIGN": true, "CHECK": true, "UNIQUE": true, "EXCLUDE": true}
+
+// droppedNonColumnKeywords follow DROP in ALTER TABLE actions that do not drop a column
+var droppedNonColumnKeywords = map[string]bool{"CONSTRAINT": true, "DEFAULT": true, "NOT": true, "EXPRESSION": true, "IDENTITY": true}
+
+// AnalyzeMigrations looks for operations in the given pending migrations that block or fail on populated tables.
+// Tables created by the pending migrations themselves are empty when they are changed, so they are not reported.
+func AnalyzeMigrations(migrations []Migration) []PreflightFinding {
+	findings := []PreflightFinding{}
+	created := make(map[string]bool)
+
+	for _, migration := range migrations {
+		statements := migration.Statements()
+		for _, statement := range statements {
+			add := func(table string, severity PreflightSeverity, operation, message, suggestion string, online bool) {
+				findings = append(findings, PreflightFinding{
+					Version:    migration.Version,
+					Migration:  migration.Name,
+					Statement:  statement.Index,
+					Table:      table,
+					Severity:   severity,
+					Operation:  operation,
+					Message:    message,
+					Suggestion: suggestion,
+					Online:     online,
+				})
+			}
+			sql := statement.normalized()
+
+			if _, ok := statement.Directives[backfillDirective]; ok {
+				if _, err := parseBackfill(statement); err != nil {
+					add("", PreflightFailure, "backfill", err.Error(), "", false)
+				}
+				continue
+			}
+
+			switch {
+			case createTablePattern.MatchString(sql):
+				created[tableName(createTablePattern.FindStringSubmatch(sql)[1])] = true
+
+			case createIndexPattern.MatchString(sql):
+				match := createIndexPattern.FindStringSubmatch(sql)
+				table := tableName(match[3])
+				if match[2] != "" {
+					if len(statements) > 1 {
+						add(table, PreflightFailure, "create_index_concurrently",
+							"CREATE INDEX CONCURRENTLY cannot run inside the transaction of a multi-statement migration.",
+							"Move it to a migration file of its own.", true)
+					}
+				} else if !created[table] {
+					add(table, PreflightBlocking, "create_index",
+						"Building the index blocks writes to the table until it is complete.",
+						"Use CREATE INDEX CONCURRENTLY in a migration file of its own.", true)
+				}
+
+			case dropIndexPattern.MatchString(sql):
+				match := dropIndexPattern.FindStringSubmatch(sql)
+				if match[1] == "" {
+					add(tableName(match[2]), PreflightWarning, "drop_index",
+						"Dropping the index waits for an exclusive lock on its table, queuing all queries behind running ones.",
+						"Use DROP INDEX CONCURRENTLY in a migration file of its own.", match[3] == "")
+				}
+
+			case alterTablePattern.MatchString(sql):
+				table := tableName(alterTablePattern.FindStringSubmatch(sql)[1])
+				if created[table] {
+					continue
+				}
+				for _, finding := range analyzeAlterTable(sql) {
+					add(table, finding.Severity, finding.Operation, finding.Message, finding.Suggestion, false)
+				}
+
+			case updatePattern.MatchString(sql) || deletePattern.MatchString(sql):
+				match := updatePattern.FindStringSubmatch(sql)
+				if match == nil {
+					match = deletePattern.FindStringSubmatch(sql)
+				}
+				if table := tableName(match[1]); !created[table] {
+					add(table, PreflightBlocking, "backfill",
+						"Changing all matching rows in a single transaction holds row locks until it commits and bloats the table.",
+						"Mark it with a \"-- migrate:backfill\" directive and run it in the online mode, which changes the rows in batches.", false)
+				}
+
+			case lockingCommandPattern.MatchString(sql) && !reindexConcurrently.MatchString(sql):
+				add("", PreflightBlocking, strings.ToLower(strings.ReplaceAll(lockingCommandPattern.FindString(sql), " ", "_")),
+					"The command holds an exclusive lock for as long as it runs.", "", false)
+			}
+		}
+	}
+	return findings
+}
+
+// analyzeAlterTable reports the disruptive actions of an ALTER TABLE statement on an existing table
+func analyzeAlterTable(sql string) []PreflightFinding {
+	var findings []PreflightFinding
+	add := func(severity PreflightSeverity, operation, message, suggestion string) {
+		findings = append(findings, PreflightFinding{Severity: severity, Operation: operation, Message: message, Suggestion: suggestion})
+	}
+
+	if alterColumnTypePattern.MatchString(sql) {
+		add(PreflightBlocking, "alter_column_type",
+			"Changing the column type rewrites the table and its indexes under an exclusive lock.",
+			"Add a new column, backfill it in batches and switch over in a later release.")
+	}
+	if setNotNullPattern.MatchString(sql) {
+		add(PreflightBlocking, "set_not_null",
+			"SET NOT NULL scans the whole table under an exclusive lock.",
+			"Add a CHECK (column IS NOT NULL) NOT VALID constraint, VALIDATE it in a later migration, then set NOT NULL.")
+	}
+	for _, match := range addColumnPattern.FindAllStringSubmatch(sql, -1) {
+		if tableConstraintKeywords[match[1]] {
+			continue
+		}
+		definition := match[2]
+		switch {
+		case volatileDefaultPattern.MatchString(definition):
+			add(PreflightBlocking, "add_column_volatile_default",
+				"Adding a column with a volatile default rewrites the table under an exclusive lock.",
+				"Add the column without a default, set the default afterwards and backfill existing rows in batches.")
+		case strings.Contains(definition, "NOT NULL") && !strings.Contains(definition, "DEFAULT"):
+			add(PreflightFailure, "add_column_not_null",
+				"Adding a NOT NULL column without a default fails when the table has rows.",
+				"Add a default or add the column as nullable and backfill it.")
+		}
+	}
+	for _, match := range addConstraintPattern.FindAllStringSubmatch(sql, -1) {
+		switch match[1] {
+		case "FOREIGN KEY", "CHECK":
+			if !strings.Contains(match[2], "NOT VALID") {
+				add(PreflightBlocking, "add_constraint",
+					"Adding a validated "+strings.ToLower(match[1])+" constraint scans the table while blocking writes.",
+					"Add it NOT VALID and VALIDATE CONSTRAINT in a later migration.")
+			}
+		default:
+			if !strings.Contains(match[2], "USING INDEX") {
+				add(PreflightBlocking, "add_constraint",
+					"Adding a "+strings.ToLower(match[1])+" constraint builds an index while blocking writes.",
+					"Create a unique index CONCURRENTLY first and add the constraint USING INDEX.")
+			}
+		}
+	}
+	if renamePattern.MatchString(sql) {
+		add(PreflightWarning, "rename",
+			"Renaming breaks application instances still running the previous release.",
+			"Add the new name alongside the old one and remove the old one in a later release.")
+	}
+	for _, match := range dropColumnPattern.FindAllStringSubmatch(sql, -1) {
+		if !droppedNonColumnKeywords[match[1]] {
+			add(PreflightWarning, "drop_column",
+				"Dropping a column breaks application instances still reading it.",
+				"Stop using the column in one release and drop it in the next.")
+			break
+		}
+	}
+	return findings
+}
+
+// tableName normalizes a table name from a statement: unquoted, lower case and without the public schema
+func tableName(name string) string {
+	name = strings.ToLower(strings.ReplaceAll(name, `"`, ""))
+	return strings.TrimPrefix(name, "public.")
+}
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"000010_add_index.up.sql":     "CREATE INDEX idx ON t (a);",
+		"000010_add_index.down.sql":   "DROP INDEX idx;",
+		"000002_create_table.up.sql":  "CREATE TABLE t (a int);",
+		"README.md":                   "not a migration",
+		"000003_broken.up.sql.backup": "ignored",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	migrations, err := LoadMigrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, uint(2), migrations[0].Version)
+	assert.Equal(t, "create_table", migrations[0].Name)
+	assert.Equal(t, uint(10), migrations[1].Version)
+	assert.Equal(t, "CREATE INDEX idx ON t (a);", migrations[1].SQL)
+}
+
+func TestMigrationStatements(t *testing.T) {
+	migration := Migration{SQL: `
+-- Comment with a ; semicolon
+INSERT INTO t (name) VALUES ('a;b'), ("quoted;identifier");
+/* block ; comment */
+CREATE FUNCTION f() RETURNS trigger AS $body$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$body$ LANGUAGE plpgsql;
+
+-- migrate:backfill batch_size=50 key=uuid
+UPDATE t SET name = 'x' WHERE name IS NULL
+`}
+
+	statements := migration.Statements()
+	require.Len(t, statements, 3)
+	assert.Equal(t, 1, statements[0].Index)
+	assert.Equal(t, `INSERT INTO t (name) VALUES ('a;b'), ("quoted;identifier")`, statements[0].Code)
+	assert.Contains(t, statements[1].Code, "RETURN NEW;\nEND;\n$body$ LANGUAGE plpgsql")
+	assert.Empty(t, statements[1].Directives)
+	assert.Equal(t, "UPDATE t SET name = 'x' WHERE name IS NULL", statements[2].Code)
+	assert.Equal(t, map[string]string{"batch_size": "50", "key": "uuid"}, statements[2].Directives[backfillDirective])
+}
+
+func TestAnalyzeMigrations(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "create_items", SQL: `
+CREATE TABLE items (id uuid PRIMARY KEY, name text);
+CREATE INDEX idx_items_name ON items (name);
+ALTER TABLE items ADD COLUMN code text NOT NULL;`},
+		{Version: 2, Name: "change_requirements", SQL: `
+CREATE INDEX idx_requirements_title ON requirements (title);
+ALTER TABLE requirements ALTER COLUMN priority TYPE bigint;
+ALTER TABLE requirements ADD COLUMN external_id text NOT NULL;
+ALTER TABLE requirements ADD COLUMN token uuid DEFAULT gen_random_uuid();
+ALTER TABLE requirements ADD COLUMN archived boolean NOT NULL DEFAULT false;
+ALTER TABLE requirements ADD CONSTRAINT fk_owner FOREIGN KEY (owner_id) REFERENCES users(id) NOT VALID;
+ALTER TABLE requirements ADD CONSTRAINT chk_priority CHECK (priority > 0);
+ALTER TABLE requirements DROP CONSTRAINT old_check, DROP COLUMN legacy;
+UPDATE requirements SET archived = true WHERE status = 'Obsolete';
+-- migrate:backfill
+UPDATE requirements SET external_id = id::text WHERE external_id IS NULL;
+DROP INDEX idx_old;`},
+		{Version: 3, Name: "concurrent_index", SQL: `CREATE INDEX CONCURRENTLY idx_epics_title ON epics (title);`},
+	}
+
+	findings := AnalyzeMigrations(migrations)
+
+	type key struct {
+		version   uint
+		statement int
+		operation string
+	}
+	got := make(map[key]PreflightFinding)
+	for _, finding := range findings {
+		got[key{finding.Version, finding.Statement, finding.Operation}] = finding
+	}
+	assert.Len(t, findings, 8)
+
+	createIndex := got[key{2, 1, "create_index"}]
+	assert.Equal(t, PreflightBlocking, createIndex.Severity)
+	assert.Equal(t, "requirements", createIndex.Table)
+	assert.True(t, createIndex.Online)
+
+	assert.Equal(t, PreflightBlocking, got[key{2, 2, "alter_column_type"}].Severity)
+	assert.Equal(t, PreflightFailure, got[key{2, 3, "add_column_not_null"}].Severity)
+	assert.Equal(t, PreflightBlocking, got[key{2, 4, "add_column_volatile_default"}].Severity)
+	assert.Equal(t, PreflightBlocking, got[key{2, 7, "add_constraint"}].Severity)
+	assert.Equal(t, PreflightWarning, got[key{2, 8, "drop_column"}].Severity)
+	assert.Equal(t, PreflightBlocking, got[key{2, 9, "backfill"}].Severity)
+	assert.False(t, got[key{2, 9, "backfill"}].Online)
+	assert.Equal(t, PreflightWarning, got[key{2, 11, "drop_index"}].Severity)
+
+	report := &PreflightReport{Migrations: migrations, Findings: findings}
+	assert.Equal(t, 3, report.Pending())
+	assert.Len(t, report.Disruptive(false), 6)
+	assert.Len(t, report.Disruptive(true), 5)
+}
+
+func TestAnalyzeMigrations_ConcurrentIndexInMultiStatementMigration(t *testing.T) {
+	findings := AnalyzeMigrations([]Migration{{Version: 4, Name: "indexes", SQL: `
+CREATE INDEX CONCURRENTLY idx_a ON requirements (a);
+CREATE INDEX CONCURRENTLY idx_b ON requirements (b);`}})
+
+	require.Len(t, findings, 2)
+	assert.Equal(t, PreflightFailure, findings[0].Severity)
+	assert.Equal(t, "create_index_concurrently", findings[0].Operation)
+	assert.True(t, findings[0].Online)
+}
+
+func TestAnalyzeMigrations_InvalidBackfill(t *testing.T) {
+	findings := AnalyzeMigrations([]Migration{{Version: 5, Name: "backfill", SQL: `
+-- migrate:backfill
+UPDATE requirements r SET title = e.title FROM epics e WHERE r.epic_id = e.id;
+-- migrate:backfill
+UPDATE requirements SET title = 'x';`}})
+
+	require.Len(t, findings, 2)
+	assert.Equal(t, PreflightFailure, findings[0].Severity)
+	assert.Equal(t, "backfill", findings[0].Operation)
+	assert.Contains(t, findings[1].Message, "needs a WHERE clause")
+}
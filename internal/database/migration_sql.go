@@ -0,0 +1,188 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Migration is the up migration of a numbered migration file pair
+type Migration struct {
+	Version uint
+	Name    string
+	Path    string
+	SQL     string
+}
+
+// MigrationStatement is a single SQL statement of a migration
+type MigrationStatement struct {
+	Index int    // 1-based position in the migration
+	Code  string // Statement without comments and the terminating semicolon
+	// Directives are the "-- migrate:<name> key=value ..." comments written above the statement
+	Directives map[string]map[string]string
+}
+
+var (
+	migrationFilePattern      = regexp.MustCompile(`^(\d+)_(.+)\.up\.sql$`)
+	migrationDirectivePattern = regexp.MustCompile(`^--\s*migrate:([a-z_]+)(.*)$`)
+	whitespacePattern         = regexp.MustCompile(`\s+`)
+)
+
+// LoadMigrations reads the up migrations of a directory in version order
+func LoadMigrations(dir string) ([]Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	var migrations []Migration
+	seen := make(map[uint]string)
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+		if other, ok := seen[uint(version)]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d in %s and %s", version, other, entry.Name())
+		}
+		seen[uint(version)] = entry.Name()
+
+		path := filepath.Join(dir, entry.Name())
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+		migrations = append(migrations, Migration{
+			Version: uint(version),
+			Name:    match[2],
+			Path:    path,
+			SQL:     string(content),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Statements splits the migration into its statements. Semicolons in string literals, quoted identifiers,
+// comments and dollar-quoted function bodies do not end a statement.
+func (m Migration) Statements() []MigrationStatement {
+	var statements []MigrationStatement
+	var code strings.Builder
+	directives := make(map[string]map[string]string)
+
+	flush := func() {
+		text := strings.TrimSpace(code.String())
+		if text != "" {
+			statements = append(statements, MigrationStatement{
+				Index:      len(statements) + 1,
+				Code:       text,
+				Directives: directives,
+			})
+			directives = make(map[string]map[string]string)
+		}
+		code.Reset()
+	}
+
+	sql := m.SQL
+	for i := 0; i < len(sql); {
+		switch {
+		case strings.HasPrefix(sql[i:], "--"):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			if match := migrationDirectivePattern.FindStringSubmatch(strings.TrimSpace(sql[i : i+end])); match != nil {
+				directives[match[1]] = parseDirectiveOptions(match[2])
+			}
+			code.WriteByte(' ')
+			i += end
+		case strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				end = len(sql) - i - 4
+			}
+			code.WriteByte(' ')
+			i += end + 4
+		case sql[i] == '\'' || sql[i] == '"':
+			end := quotedLength(sql[i:], sql[i])
+			code.WriteString(sql[i : i+end])
+			i += end
+		case sql[i] == '$':
+			if tag := dollarQuoteTag(sql[i:]); tag != "" {
+				end := strings.Index(sql[i+len(tag):], tag)
+				if end < 0 {
+					end = len(sql) - i - 2*len(tag)
+				}
+				code.WriteString(sql[i : i+2*len(tag)+end])
+				i += 2*len(tag) + end
+				continue
+			}
+			code.WriteByte(sql[i])
+			i++
+		case sql[i] == ';':
+			flush()
+			i++
+		default:
+			code.WriteByte(sql[i])
+			i++
+		}
+	}
+	flush()
+	return statements
+}
+
+// normalized returns the statement in upper case with whitespace collapsed, for matching keywords
+func (s MigrationStatement) normalized() string {
+	return strings.ToUpper(whitespacePattern.ReplaceAllString(s.Code, " "))
+}
+
+// quotedLength returns the length of the quoted literal or identifier at the start of s, including the quotes;
+// doubled quotes inside it are escapes
+func quotedLength(s string, quote byte) int {
+	for i := 1; i < len(s); i++ {
+		if s[i] == quote {
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// dollarQuoteTag returns the dollar quote opening s, such as $$ or $body$, or "" if s does not start with one
+func dollarQuoteTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '$':
+			return s[:i+1]
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 1 && c >= '0' && c <= '9'):
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// parseDirectiveOptions parses the key=value options of a migration directive
+func parseDirectiveOptions(s string) map[string]string {
+	options := make(map[string]string)
+	for _, field := range strings.Fields(s) {
+		key, value, _ := strings.Cut(field, "=")
+		options[strings.ToLower(key)] = value
+	}
+	return options
+}
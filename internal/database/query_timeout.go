@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Statement instance keys of the query timeout callbacks
+const (
+	queryTimeoutParentKey = "query_timeout:parent_context"
+	queryTimeoutCancelKey = "query_timeout:cancel"
+)
+
+// QueryTimeout is a GORM plugin that cancels statements running longer than a timeout.
+// The timeout is derived from the statement context, so a cancelled request context
+// still cancels its queries earlier, and a shorter deadline of the caller is kept.
+// Row and Rows statements are not bounded because their results are read after the callbacks return.
+type QueryTimeout struct {
+	timeout time.Duration
+}
+
+// NewQueryTimeout creates a query timeout plugin; a zero timeout disables it
+func NewQueryTimeout(timeout time.Duration) *QueryTimeout {
+	return &QueryTimeout{timeout: timeout}
+}
+
+// Name returns the plugin name
+func (q *QueryTimeout) Name() string {
+	return "query_timeout"
+}
+
+// Initialize registers callbacks that wrap every create, query, update, delete and raw statement
+func (q *QueryTimeout) Initialize(db *gorm.DB) error {
+	if q.timeout <= 0 {
+		return nil
+	}
+
+	if err := db.Callback().Create().Before("*").Register("query_timeout:before_create", q.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Create().After("*").Register("query_timeout:after_create", q.afterCallback); err != nil {
+		return err
+	}
+
+	if err := db.Callback().Query().Before("*").Register("query_timeout:before_query", q.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().After("*").Register("query_timeout:after_query", q.afterCallback); err != nil {
+		return err
+	}
+
+	if err := db.Callback().Update().Before("*").Register("query_timeout:before_update", q.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().After("*").Register("query_timeout:after_update", q.afterCallback); err != nil {
+		return err
+	}
+
+	if err := db.Callback().Delete().Before("*").Register("query_timeout:before_delete", q.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().After("*").Register("query_timeout:after_delete", q.afterCallback); err != nil {
+		return err
+	}
+
+	if err := db.Callback().Raw().Before("*").Register("query_timeout:before_raw", q.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Raw().After("*").Register("query_timeout:after_raw", q.afterCallback); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// beforeCallback bounds the statement context unless the caller already set an earlier deadline
+func (q *QueryTimeout) beforeCallback(db *gorm.DB) {
+	parent := db.Statement.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	if deadline, ok := parent.Deadline(); ok && time.Until(deadline) <= q.timeout {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(parent, q.timeout)
+	db.InstanceSet(queryTimeoutParentKey, parent)
+	db.InstanceSet(queryTimeoutCancelKey, cancel)
+	db.Statement.Context = ctx
+}
+
+// afterCallback restores the caller's context, so statements reusing the same chain get a fresh timeout
+func (q *QueryTimeout) afterCallback(db *gorm.DB) {
+	if parent, ok := db.InstanceGet(queryTimeoutParentKey); ok {
+		db.Statement.Context = parent.(context.Context)
+	}
+	if cancel, ok := db.InstanceGet(queryTimeoutCancelKey); ok {
+		cancel.(context.CancelFunc)()
+	}
+}
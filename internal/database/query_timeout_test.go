@@ -0,0 +1,83 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// setupQueryTimeoutDB opens a database bounded by timeout and returns the deadlines seen by queries
+func setupQueryTimeoutDB(t *testing.T, timeout time.Duration) (*gorm.DB, *[]time.Time) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&slowQueryItem{}))
+	require.NoError(t, db.Use(NewQueryTimeout(timeout)))
+
+	deadlines := &[]time.Time{}
+	err = db.Callback().Query().Before("gorm:query").Register("test:capture_deadline", func(db *gorm.DB) {
+		deadline, _ := db.Statement.Context.Deadline()
+		*deadlines = append(*deadlines, deadline)
+	})
+	require.NoError(t, err)
+	return db, deadlines
+}
+
+func TestQueryTimeout_BoundsStatements(t *testing.T) {
+	db, deadlines := setupQueryTimeoutDB(t, time.Minute)
+
+	var items []slowQueryItem
+	require.NoError(t, db.Find(&items).Error)
+	require.Len(t, *deadlines, 1)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), (*deadlines)[0], 5*time.Second)
+}
+
+func TestQueryTimeout_KeepsEarlierDeadline(t *testing.T) {
+	db, deadlines := setupQueryTimeoutDB(t, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	expected, _ := ctx.Deadline()
+
+	var items []slowQueryItem
+	require.NoError(t, db.WithContext(ctx).Find(&items).Error)
+	require.Len(t, *deadlines, 1)
+	assert.Equal(t, expected, (*deadlines)[0])
+}
+
+func TestQueryTimeout_ReusedChainGetsFreshTimeout(t *testing.T) {
+	db, deadlines := setupQueryTimeoutDB(t, time.Minute)
+	require.NoError(t, db.Create(&slowQueryItem{Name: "first"}).Error)
+
+	query := db.Model(&slowQueryItem{}).Where("name = ?", "first")
+	var count int64
+	require.NoError(t, query.Count(&count).Error)
+	var items []slowQueryItem
+	require.NoError(t, query.Find(&items).Error)
+
+	assert.Equal(t, int64(1), count)
+	assert.Len(t, items, 1)
+	assert.Len(t, *deadlines, 2)
+}
+
+func TestQueryTimeout_CancelledContext(t *testing.T) {
+	db, _ := setupQueryTimeoutDB(t, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var items []slowQueryItem
+	assert.ErrorIs(t, db.WithContext(ctx).Find(&items).Error, context.Canceled)
+}
+
+func TestQueryTimeout_Disabled(t *testing.T) {
+	db, deadlines := setupQueryTimeoutDB(t, 0)
+
+	var items []slowQueryItem
+	require.NoError(t, db.Find(&items).Error)
+	require.Len(t, *deadlines, 1)
+	assert.True(t, (*deadlines)[0].IsZero())
+}
@@ -0,0 +1,337 @@
+package database
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// maxSlowQuerySQLLength caps the SQL text kept per slow query
+const maxSlowQuerySQLLength = 2000
+
+// SlowQuery is a recorded query that took longer than the slow query threshold
+// @Description Query slower than the threshold with the handler and service that issued it
+type SlowQuery struct {
+	SQL        string    `json:"sql" example:"SELECT * FROM \"epics\" WHERE assignee_id = $1"`
+	Table      string    `json:"table,omitempty" example:"epics"`
+	Operation  string    `json:"operation" example:"query"`
+	DurationMs float64   `json:"duration_ms" example:"350.2"`
+	Rows       int64     `json:"rows" example:"120"`
+	Handler    string    `json:"handler,omitempty" example:"handlers.(*EpicHandler).ListEpics"`
+	Service    string    `json:"service,omitempty" example:"service.(*epicService).ListEpics"`
+	Repository string    `json:"repository,omitempty" example:"repository.(*epicRepository).ListWithIncludes"`
+	Error      string    `json:"error,omitempty"`
+	OccurredAt time.Time `json:"occurred_at" example:"2023-01-01T10:00:00Z"`
+}
+
+// SlowQueryGroup aggregates the recorded slow queries sharing the same SQL and handler
+// @Description Slow queries grouped by SQL and calling handler, slowest in total first
+type SlowQueryGroup struct {
+	SQL             string    `json:"sql"`
+	Handler         string    `json:"handler,omitempty"`
+	Service         string    `json:"service,omitempty"`
+	Count           int       `json:"count" example:"12"`
+	TotalDurationMs float64   `json:"total_duration_ms" example:"4200.5"`
+	MaxDurationMs   float64   `json:"max_duration_ms" example:"612.3"`
+	LastSeenAt      time.Time `json:"last_seen_at" example:"2023-01-01T10:00:00Z"`
+}
+
+// SlowQueryReport summarizes query durations and the most recent slow queries
+// @Description Rolling report of the most recent slow queries; counters cover all queries since startup or the last reset
+type SlowQueryReport struct {
+	ThresholdMs     float64          `json:"threshold_ms" example:"200"`
+	Capacity        int              `json:"capacity" example:"100"`
+	Since           time.Time        `json:"since" example:"2023-01-01T00:00:00Z"`
+	TotalQueries    int64            `json:"total_queries" example:"15230"`
+	SlowQueries     int64            `json:"slow_queries" example:"48"`
+	TotalDurationMs float64          `json:"total_duration_ms" example:"81234.7"`
+	Groups          []SlowQueryGroup `json:"groups"`
+	Recent          []SlowQuery      `json:"recent"`
+}
+
+// SlowQueryLog is a GORM plugin that times every query, logs queries slower than a threshold
+// together with the handler and service that issued them, and keeps the most recent ones in memory
+type SlowQueryLog struct {
+	threshold time.Duration
+	capacity  int
+	logger    *logrus.Logger
+
+	mu            sync.Mutex
+	since         time.Time
+	totalQueries  int64
+	slowQueries   int64
+	totalDuration time.Duration
+	recent        []SlowQuery // ring buffer of the last capacity slow queries
+	next          int
+}
+
+// NewSlowQueryLog creates a slow query log keeping the last capacity queries slower than threshold
+func NewSlowQueryLog(threshold time.Duration, capacity int, logger *logrus.Logger) *SlowQueryLog {
+	if capacity <= 0 {
+		capacity = 100
+	}
+	return &SlowQueryLog{
+		threshold: threshold,
+		capacity:  capacity,
+		logger:    logger,
+		since:     time.Now().UTC(),
+	}
+}
+
+// Name returns the plugin name
+func (l *SlowQueryLog) Name() string {
+	return "slow_query_log"
+}
+
+// Initialize registers timing callbacks around every kind of statement
+func (l *SlowQueryLog) Initialize(db *gorm.DB) error {
+	// Create callback
+	if err := db.Callback().Create().Before("gorm:create").Register("slow_query:before_create", l.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Create().After("gorm:create").Register("slow_query:after_create", l.afterCallback("create")); err != nil {
+		return err
+	}
+
+	// Query callback
+	if err := db.Callback().Query().Before("gorm:query").Register("slow_query:before_query", l.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Query().After("gorm:query").Register("slow_query:after_query", l.afterCallback("select")); err != nil {
+		return err
+	}
+
+	// Update callback
+	if err := db.Callback().Update().Before("gorm:update").Register("slow_query:before_update", l.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Update().After("gorm:update").Register("slow_query:after_update", l.afterCallback("update")); err != nil {
+		return err
+	}
+
+	// Delete callback
+	if err := db.Callback().Delete().Before("gorm:delete").Register("slow_query:before_delete", l.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Delete().After("gorm:delete").Register("slow_query:after_delete", l.afterCallback("delete")); err != nil {
+		return err
+	}
+
+	// Row and raw callbacks cover Scan, Row and Exec with hand-written SQL
+	if err := db.Callback().Row().Before("gorm:row").Register("slow_query:before_row", l.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Row().After("gorm:row").Register("slow_query:after_row", l.afterCallback("select")); err != nil {
+		return err
+	}
+	if err := db.Callback().Raw().Before("gorm:raw").Register("slow_query:before_raw", l.beforeCallback); err != nil {
+		return err
+	}
+	if err := db.Callback().Raw().After("gorm:raw").Register("slow_query:after_raw", l.afterCallback("raw")); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// beforeCallback stores the statement start time
+func (l *SlowQueryLog) beforeCallback(db *gorm.DB) {
+	db.InstanceSet("slow_query:start_time", time.Now())
+}
+
+// afterCallback records the statement duration and captures the statement if it was slow
+func (l *SlowQueryLog) afterCallback(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, exists := db.InstanceGet("slow_query:start_time")
+		if !exists {
+			return
+		}
+		startTime, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		duration := time.Since(startTime)
+
+		slow := l.threshold > 0 && duration >= l.threshold
+		l.mu.Lock()
+		l.totalQueries++
+		l.totalDuration += duration
+		l.mu.Unlock()
+		if !slow {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" && db.Statement.Schema != nil {
+			table = db.Statement.Schema.Table
+		}
+		query := SlowQuery{
+			SQL:        truncateSQL(db.Statement.SQL.String()),
+			Table:      table,
+			Operation:  operation,
+			DurationMs: durationMs(duration),
+			Rows:       db.Statement.RowsAffected,
+			OccurredAt: startTime.UTC(),
+		}
+		if db.Error != nil && db.Error != gorm.ErrRecordNotFound {
+			query.Error = db.Error.Error()
+		}
+		query.Handler, query.Service, query.Repository = queryCallers()
+
+		l.record(query)
+		l.log(query)
+	}
+}
+
+// record appends a slow query to the ring buffer
+func (l *SlowQueryLog) record(query SlowQuery) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.slowQueries++
+	if len(l.recent) < l.capacity {
+		l.recent = append(l.recent, query)
+		return
+	}
+	l.recent[l.next] = query
+	l.next = (l.next + 1) % l.capacity
+}
+
+// log writes a structured warning for a slow query
+func (l *SlowQueryLog) log(query SlowQuery) {
+	if l.logger == nil {
+		return
+	}
+	fields := logrus.Fields{
+		"sql":          query.SQL,
+		"table":        query.Table,
+		"operation":    query.Operation,
+		"duration_ms":  query.DurationMs,
+		"threshold_ms": durationMs(l.threshold),
+		"rows":         query.Rows,
+	}
+	if query.Handler != "" {
+		fields["handler"] = query.Handler
+	}
+	if query.Service != "" {
+		fields["service"] = query.Service
+	}
+	if query.Repository != "" {
+		fields["repository"] = query.Repository
+	}
+	if query.Error != "" {
+		fields["error"] = query.Error
+	}
+	l.logger.WithFields(fields).Warn("Slow database query")
+}
+
+// Report returns the query counters and the recorded slow queries, newest first
+func (l *SlowQueryLog) Report() SlowQueryReport {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	report := SlowQueryReport{
+		ThresholdMs:     durationMs(l.threshold),
+		Capacity:        l.capacity,
+		Since:           l.since,
+		TotalQueries:    l.totalQueries,
+		SlowQueries:     l.slowQueries,
+		TotalDurationMs: durationMs(l.totalDuration),
+		Recent:          make([]SlowQuery, 0, len(l.recent)),
+	}
+
+	// The oldest entry sits at next once the buffer has wrapped
+	for i := len(l.recent) - 1; i >= 0; i-- {
+		report.Recent = append(report.Recent, l.recent[(l.next+i)%len(l.recent)])
+	}
+
+	groups := make(map[string]*SlowQueryGroup)
+	order := make([]string, 0)
+	for _, query := range report.Recent {
+		key := query.SQL + "\x00" + query.Handler
+		group, ok := groups[key]
+		if !ok {
+			group = &SlowQueryGroup{SQL: query.SQL, Handler: query.Handler, Service: query.Service, LastSeenAt: query.OccurredAt}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.Count++
+		group.TotalDurationMs += query.DurationMs
+		if query.DurationMs > group.MaxDurationMs {
+			group.MaxDurationMs = query.DurationMs
+		}
+	}
+	report.Groups = make([]SlowQueryGroup, 0, len(order))
+	for _, key := range order {
+		report.Groups = append(report.Groups, *groups[key])
+	}
+	sort.SliceStable(report.Groups, func(i, j int) bool {
+		return report.Groups[i].TotalDurationMs > report.Groups[j].TotalDurationMs
+	})
+
+	return report
+}
+
+// Reset clears the recorded slow queries and counters
+func (l *SlowQueryLog) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.since = time.Now().UTC()
+	l.totalQueries = 0
+	l.slowQueries = 0
+	l.totalDuration = 0
+	l.recent = nil
+	l.next = 0
+}
+
+// queryCallers walks the call stack for the innermost handler (or MCP tool),
+// service and repository functions that issued the current statement
+func queryCallers() (handler, service, repository string) {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		handler, service, repository = classifyCaller(frame.Function, handler, service, repository)
+		if !more || (handler != "" && service != "") {
+			break
+		}
+	}
+	return handler, service, repository
+}
+
+// classifyCaller fills the first empty layer matching a fully qualified function name
+func classifyCaller(function, handler, service, repository string) (string, string, string) {
+	name := function
+	if i := strings.LastIndex(function, "/"); i >= 0 {
+		name = function[i+1:]
+	}
+	switch {
+	case handler == "" && (strings.Contains(function, "/internal/handlers.") || strings.Contains(function, "/internal/mcp/")):
+		handler = name
+	case service == "" && strings.Contains(function, "/internal/service."):
+		service = name
+	case repository == "" && strings.Contains(function, "/internal/repository."):
+		repository = name
+	}
+	return handler, service, repository
+}
+
+// truncateSQL shortens very long statements such as bulk inserts
+func truncateSQL(sql string) string {
+	if len(sql) <= maxSlowQuerySQLLength {
+		return sql
+	}
+	return sql[:maxSlowQuerySQLLength] + "..."
+}
+
+// durationMs converts a duration to fractional milliseconds
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,87 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type slowQueryItem struct {
+	ID   uint
+	Name string
+}
+
+func setupSlowQueryDB(t *testing.T, slowQueries *SlowQueryLog) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(slowQueries))
+	require.NoError(t, db.AutoMigrate(&slowQueryItem{}))
+	slowQueries.Reset()
+	return db
+}
+
+func TestSlowQueryLog_RecordsQueriesAboveThreshold(t *testing.T) {
+	slowQueries := NewSlowQueryLog(time.Nanosecond, 2, nil)
+	db := setupSlowQueryDB(t, slowQueries)
+
+	require.NoError(t, db.Create(&slowQueryItem{Name: "first"}).Error)
+	var items []slowQueryItem
+	require.NoError(t, db.Where("name = ?", "first").Find(&items).Error)
+	require.NoError(t, db.Where("name = ?", "first").Find(&items).Error)
+
+	report := slowQueries.Report()
+	assert.Equal(t, int64(3), report.TotalQueries)
+	assert.Equal(t, int64(3), report.SlowQueries)
+	assert.Equal(t, 2, report.Capacity)
+
+	// Only the two most recent queries are kept, newest first
+	require.Len(t, report.Recent, 2)
+	for _, query := range report.Recent {
+		assert.Equal(t, "select", query.Operation)
+		assert.Equal(t, "slow_query_items", query.Table)
+		assert.Contains(t, query.SQL, "name = ?")
+		assert.Equal(t, int64(1), query.Rows)
+	}
+	require.Len(t, report.Groups, 1)
+	assert.Equal(t, 2, report.Groups[0].Count)
+
+	slowQueries.Reset()
+	report = slowQueries.Report()
+	assert.Zero(t, report.TotalQueries)
+	assert.Empty(t, report.Recent)
+	assert.Empty(t, report.Groups)
+}
+
+func TestSlowQueryLog_IgnoresFastQueries(t *testing.T) {
+	slowQueries := NewSlowQueryLog(time.Hour, 10, nil)
+	db := setupSlowQueryDB(t, slowQueries)
+
+	require.NoError(t, db.Create(&slowQueryItem{Name: "fast"}).Error)
+
+	report := slowQueries.Report()
+	assert.Equal(t, int64(1), report.TotalQueries)
+	assert.Zero(t, report.SlowQueries)
+	assert.Empty(t, report.Recent)
+}
+
+func TestClassifyCaller(t *testing.T) {
+	var handler, service, repository string
+	for _, function := range []string{
+		"gorm.io/gorm.(*DB).Find",
+		"product-requirements-management/internal/repository.(*epicRepository).List",
+		"product-requirements-management/internal/service.(*epicService).ListEpics",
+		"product-requirements-management/internal/repository.(*BaseRepository[...]).GetByID",
+		"product-requirements-management/internal/handlers.(*EpicHandler).ListEpics",
+	} {
+		handler, service, repository = classifyCaller(function, handler, service, repository)
+	}
+
+	assert.Equal(t, "handlers.(*EpicHandler).ListEpics", handler)
+	assert.Equal(t, "service.(*epicService).ListEpics", service)
+	assert.Equal(t, "repository.(*epicRepository).List", repository)
+}
@@ -0,0 +1,14 @@
+package deprecation
+
+import "net/http"
+
+// Endpoints returns the registry of deprecated routes. Deprecate a route here, keep it working until its sunset
+// date and mark its handler @Deprecated so that the Swagger specification agrees.
+func Endpoints() *Registry {
+	r := NewRegistry()
+
+	// Unpaginated; the comment inbox filters by resolution status with paging
+	r.Deprecate(http.MethodGet, "/api/v1/comments/status/:status", "2026-10-17", "2027-04-30", "GET /api/v1/comments?is_resolved=true")
+
+	return r
+}
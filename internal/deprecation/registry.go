@@ -0,0 +1,160 @@
+// Package deprecation declares the deprecated API routes. Its registry is the single source of truth for
+// deprecations: responses of deprecated routes announce them in Deprecation, Sunset and Link headers, the
+// documentation generator badges them and docs-metrics counts the traffic they still receive.
+package deprecation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// dateLayout is the layout of the dates in deprecation declarations
+const dateLayout = "2006-01-02"
+
+// Endpoint describes a deprecated route
+type Endpoint struct {
+	Method string
+	Path   string // route path as registered in gin, e.g. /api/v1/comments/status/:status
+	// Since is the day the route was deprecated
+	Since time.Time
+	// Sunset is the day the route will be removed; zero when no removal is scheduled
+	Sunset time.Time
+	// Replacement is the method and path of the route to use instead, e.g. GET /api/v1/comments?is_resolved=true
+	Replacement string
+}
+
+// Registry holds the deprecated routes
+type Registry struct {
+	endpoints map[string]Endpoint
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{endpoints: make(map[string]Endpoint)}
+}
+
+// Deprecate declares a deprecated route. since and sunset are dates such as 2026-10-17; sunset may be empty.
+// Invalid dates and declaring the same route twice are programming errors.
+func (r *Registry) Deprecate(method, path, since, sunset, replacement string) {
+	endpoint := Endpoint{Method: method, Path: path, Since: mustParseDate(since), Replacement: replacement}
+	if sunset != "" {
+		endpoint.Sunset = mustParseDate(sunset)
+		if !endpoint.Sunset.After(endpoint.Since) {
+			panic(fmt.Sprintf("sunset of %s %s is not after its deprecation", method, path))
+		}
+	}
+
+	key := endpointKey(method, path)
+	if _, exists := r.endpoints[key]; exists {
+		panic(fmt.Sprintf("duplicate deprecation for %s %s", method, path))
+	}
+	r.endpoints[key] = endpoint
+}
+
+// Lookup returns the deprecation of a route
+func (r *Registry) Lookup(method, path string) (Endpoint, bool) {
+	endpoint, ok := r.endpoints[endpointKey(method, path)]
+	return endpoint, ok
+}
+
+// Match returns the deprecation of the route serving a request path such as /api/v1/comments/status/resolved
+func (r *Registry) Match(method, requestPath string) (Endpoint, bool) {
+	for _, endpoint := range r.All() {
+		if endpoint.Method == method && matchPath(endpoint.Path, requestPath) {
+			return endpoint, true
+		}
+	}
+	return Endpoint{}, false
+}
+
+// All returns all deprecated routes ordered by path and method
+func (r *Registry) All() []Endpoint {
+	endpoints := make([]Endpoint, 0, len(r.endpoints))
+	for _, endpoint := range r.endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Path != endpoints[j].Path {
+			return endpoints[i].Path < endpoints[j].Path
+		}
+		return endpoints[i].Method < endpoints[j].Method
+	})
+	return endpoints
+}
+
+// Verify checks that every deprecated route is registered, so that removed routes do not linger in the registry
+func (r *Registry) Verify(routes gin.RoutesInfo) error {
+	registered := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		registered[endpointKey(route.Method, route.Path)] = true
+	}
+
+	var problems []string
+	for _, endpoint := range r.All() {
+		if key := endpointKey(endpoint.Method, endpoint.Path); !registered[key] {
+			problems = append(problems, "deprecation of unregistered route "+key)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("route deprecations out of sync: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// ReplacementPath returns the path of the replacement without its method, or an empty string without one
+func (e Endpoint) ReplacementPath() string {
+	if e.Replacement == "" {
+		return ""
+	}
+	fields := strings.Fields(e.Replacement)
+	return fields[len(fields)-1]
+}
+
+// Description returns a human-readable summary of the deprecation
+func (e Endpoint) Description() string {
+	description := "Deprecated since " + e.Since.Format(dateLayout) + "."
+	if !e.Sunset.IsZero() {
+		description += " Will be removed on " + e.Sunset.Format(dateLayout) + "."
+	}
+	if e.Replacement != "" {
+		description += " Use " + e.Replacement + " instead."
+	}
+	return description
+}
+
+// matchPath reports whether a request path matches a gin route path with :param and *param segments
+func matchPath(routePath, requestPath string) bool {
+	routeSegments := strings.Split(strings.Trim(routePath, "/"), "/")
+	requestSegments := strings.Split(strings.Trim(requestPath, "/"), "/")
+	for i, segment := range routeSegments {
+		if strings.HasPrefix(segment, "*") {
+			return true
+		}
+		if i >= len(requestSegments) {
+			return false
+		}
+		if !strings.HasPrefix(segment, ":") && segment != requestSegments[i] {
+			return false
+		}
+		if strings.HasPrefix(segment, ":") && requestSegments[i] == "" {
+			return false
+		}
+	}
+	return len(routeSegments) == len(requestSegments)
+}
+
+func mustParseDate(value string) time.Time {
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		panic(fmt.Sprintf("invalid deprecation date %q: %v", value, err))
+	}
+	return date
+}
+
+func endpointKey(method, path string) string {
+	return method + " " + path
+}
@@ -0,0 +1,81 @@
+package deprecation
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	registry.Deprecate(http.MethodGet, "/items/status/:status", "2026-10-17", "2027-04-30", "GET /items?status=open")
+	registry.Deprecate(http.MethodDelete, "/files/*path", "2026-01-05", "", "")
+
+	t.Run("lookup by route path", func(t *testing.T) {
+		endpoint, ok := registry.Lookup(http.MethodGet, "/items/status/:status")
+		require.True(t, ok)
+		assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), endpoint.Since)
+		assert.Equal(t, time.Date(2027, 4, 30, 0, 0, 0, 0, time.UTC), endpoint.Sunset)
+		assert.Equal(t, "/items?status=open", endpoint.ReplacementPath())
+		assert.Equal(t, "Deprecated since 2026-10-17. Will be removed on 2027-04-30. Use GET /items?status=open instead.", endpoint.Description())
+
+		_, ok = registry.Lookup(http.MethodPost, "/items/status/:status")
+		assert.False(t, ok)
+	})
+
+	t.Run("match request paths", func(t *testing.T) {
+		tests := []struct {
+			method string
+			path   string
+			want   bool
+		}{
+			{http.MethodGet, "/items/status/open", true},
+			{http.MethodGet, "/items/status/open/", true},
+			{http.MethodGet, "/items/status/", false},
+			{http.MethodGet, "/items/status/open/extra", false},
+			{http.MethodGet, "/items/other/open", false},
+			{http.MethodPut, "/items/status/open", false},
+			{http.MethodDelete, "/files/a/b/c.txt", true},
+		}
+		for _, tt := range tests {
+			_, ok := registry.Match(tt.method, tt.path)
+			assert.Equal(t, tt.want, ok, "%s %s", tt.method, tt.path)
+		}
+	})
+
+	t.Run("all ordered by path", func(t *testing.T) {
+		all := registry.All()
+		require.Len(t, all, 2)
+		assert.Equal(t, "/files/*path", all[0].Path)
+		assert.Equal(t, "Deprecated since 2026-01-05.", all[0].Description())
+	})
+
+	t.Run("verify against registered routes", func(t *testing.T) {
+		routes := gin.RoutesInfo{
+			{Method: http.MethodGet, Path: "/items/status/:status"},
+			{Method: http.MethodDelete, Path: "/files/*path"},
+		}
+		assert.NoError(t, registry.Verify(routes))
+
+		err := registry.Verify(routes[:1])
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "DELETE /files/*path")
+	})
+}
+
+func TestDeprecateRejectsInvalidDeclarations(t *testing.T) {
+	registry := NewRegistry()
+	registry.Deprecate(http.MethodGet, "/items", "2026-10-17", "", "")
+
+	assert.Panics(t, func() { registry.Deprecate(http.MethodGet, "/items", "2026-10-18", "", "") })
+	assert.Panics(t, func() { registry.Deprecate(http.MethodGet, "/other", "17.10.2026", "", "") })
+	assert.Panics(t, func() { registry.Deprecate(http.MethodGet, "/other", "2026-10-17", "2026-10-01", "") })
+}
+
+func TestEndpointsAreValid(t *testing.T) {
+	assert.NotPanics(t, func() { Endpoints() })
+}
@@ -332,7 +332,7 @@ func containsErrorHandling(ifStmt *ast.IfStmt) bool {
 // Validation functions
 
 func validatePublicEndpointsConsistent(t *testing.T, routeReqs []AuthenticationRequirement, specReqs map[string]AuthenticationRequirement) {
-	publicEndpoints := []string{"/auth/login", "/ready", "/live"}
+	publicEndpoints := []string{"/auth/login", "/auth/invitations/accept", "/ready", "/live"}
 
 	for _, endpoint := range publicEndpoints {
 		// Find in route requirements
@@ -527,7 +527,7 @@ func validateSecurityRequirementsDocumented(t *testing.T, spec map[string]interf
 	require.True(t, ok, "Should have paths section")
 
 	hasSecurityRequirements := false
-	publicEndpoints := []string{"/auth/login", "/ready", "/live"}
+	publicEndpoints := []string{"/auth/login", "/auth/invitations/accept", "/ready", "/live"}
 
 	for pathName, pathValue := range paths {
 		pathMap, ok := pathValue.(map[string]interface{})
@@ -0,0 +1,177 @@
+package docs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"product-requirements-management/internal/deprecation"
+)
+
+// DeprecationMetrics tracks the deprecated endpoints and the traffic they still receive according to access logs
+type DeprecationMetrics struct {
+	DeprecatedEndpoints  int                       `json:"deprecated_endpoints"`
+	EndpointsWithTraffic int                       `json:"endpoints_with_traffic"`
+	RequestsAnalyzed     int                       `json:"requests_analyzed"`
+	Endpoints            []DeprecatedEndpointUsage `json:"endpoints"`
+}
+
+// DeprecatedEndpointUsage details the traffic of a deprecated endpoint
+type DeprecatedEndpointUsage struct {
+	Method      string     `json:"method"`
+	Path        string     `json:"path"`
+	Since       string     `json:"since"`
+	Sunset      string     `json:"sunset,omitempty"`
+	Replacement string     `json:"replacement,omitempty"`
+	Requests    int        `json:"requests"`
+	LastSeen    *time.Time `json:"last_seen,omitempty"`
+}
+
+// analyzeDeprecations lists the deprecated endpoints of the deprecation registry
+func analyzeDeprecations(metrics *DocumentationMetrics, registry *deprecation.Registry) {
+	metrics.Deprecations = DeprecationMetrics{Endpoints: []DeprecatedEndpointUsage{}}
+	for _, endpoint := range registry.All() {
+		usage := DeprecatedEndpointUsage{
+			Method:      endpoint.Method,
+			Path:        endpoint.Path,
+			Since:       endpoint.Since.Format("2006-01-02"),
+			Replacement: endpoint.Replacement,
+		}
+		if !endpoint.Sunset.IsZero() {
+			usage.Sunset = endpoint.Sunset.Format("2006-01-02")
+		}
+		metrics.Deprecations.Endpoints = append(metrics.Deprecations.Endpoints, usage)
+	}
+	metrics.Deprecations.DeprecatedEndpoints = len(metrics.Deprecations.Endpoints)
+}
+
+// AnalyzeDeprecatedTraffic counts the requests to deprecated endpoints in access logs written by the server
+// in JSON or text format, and recommends contacting the clients of deprecated endpoints that still receive traffic
+func AnalyzeDeprecatedTraffic(metrics *DocumentationMetrics, logFiles []string) error {
+	registry := deprecation.Endpoints()
+	for _, logFile := range logFiles {
+		file, err := os.Open(logFile)
+		if err != nil {
+			return fmt.Errorf("failed to open access log: %w", err)
+		}
+		err = countDeprecatedRequests(&metrics.Deprecations, registry, file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read access log %s: %w", logFile, err)
+		}
+	}
+
+	metrics.Deprecations.EndpointsWithTraffic = 0
+	for _, usage := range metrics.Deprecations.Endpoints {
+		if usage.Requests == 0 {
+			continue
+		}
+		metrics.Deprecations.EndpointsWithTraffic++
+		requests := "requests"
+		if usage.Requests == 1 {
+			requests = "request"
+		}
+		recommendation := fmt.Sprintf("Deprecated endpoint %s %s still received %d %s", usage.Method, usage.Path, usage.Requests, requests)
+		if usage.Replacement != "" {
+			recommendation += "; move its clients to " + usage.Replacement
+		}
+		if usage.Sunset != "" {
+			recommendation += " before " + usage.Sunset
+		}
+		metrics.Recommendations = append(metrics.Recommendations, recommendation)
+	}
+	return nil
+}
+
+// countDeprecatedRequests adds the requests of an access log to the usage of the deprecated endpoints they hit.
+// Request log entries are those with a method, a path and a status; other log lines are skipped.
+func countDeprecatedRequests(usage *DeprecationMetrics, registry *deprecation.Registry, log io.Reader) error {
+	scanner := bufio.NewScanner(log)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fields := parseLogLine(scanner.Text())
+		method, path := fields["method"], fields["path"]
+		if method == "" || path == "" || fields["status"] == "" {
+			continue
+		}
+		usage.RequestsAnalyzed++
+
+		endpoint, ok := registry.Match(method, path)
+		if !ok {
+			continue
+		}
+		for i := range usage.Endpoints {
+			entry := &usage.Endpoints[i]
+			if entry.Method != endpoint.Method || entry.Path != endpoint.Path {
+				continue
+			}
+			entry.Requests++
+			if seen, err := time.Parse(time.RFC3339, fields["time"]); err == nil && (entry.LastSeen == nil || seen.After(*entry.LastSeen)) {
+				entry.LastSeen = &seen
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// parseLogLine returns the fields of a logrus log line in JSON or text (key=value) format
+func parseLogLine(line string) map[string]string {
+	line = strings.TrimSpace(line)
+	fields := make(map[string]string)
+	if strings.HasPrefix(line, "{") {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return fields
+		}
+		for key, value := range entry {
+			switch v := value.(type) {
+			case string:
+				fields[key] = v
+			case float64:
+				fields[key] = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+		}
+		return fields
+	}
+
+	for line != "" {
+		separator := strings.IndexByte(line, '=')
+		if separator <= 0 {
+			break
+		}
+		key := line[:separator]
+		line = line[separator+1:]
+
+		var value string
+		if strings.HasPrefix(line, `"`) {
+			// Quoted values escape quotes and backslashes, so the value ends at the first unescaped quote
+			end := 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(line) {
+				break
+			}
+			unquoted, err := strconv.Unquote(line[:end+1])
+			if err != nil {
+				unquoted = line[1:end]
+			}
+			value, line = unquoted, line[end+1:]
+		} else if space := strings.IndexByte(line, ' '); space >= 0 {
+			value, line = line[:space], line[space:]
+		} else {
+			value, line = line, ""
+		}
+		fields[key] = value
+		line = strings.TrimLeft(line, " ")
+	}
+	return fields
+}
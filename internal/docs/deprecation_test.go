@@ -0,0 +1,79 @@
+package docs
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/deprecation"
+)
+
+func TestCountDeprecatedRequests(t *testing.T) {
+	registry := deprecation.NewRegistry()
+	registry.Deprecate(http.MethodGet, "/api/v1/items/status/:status", "2026-10-17", "2027-04-30", "GET /api/v1/items?status=open")
+	registry.Deprecate(http.MethodDelete, "/api/v1/items/:id", "2026-10-01", "", "")
+
+	metrics := &DocumentationMetrics{}
+	analyzeDeprecations(metrics, registry)
+	require.Equal(t, 2, metrics.Deprecations.DeprecatedEndpoints)
+
+	log := strings.Join([]string{
+		`{"level":"info","method":"GET","msg":"HTTP Request","path":"/api/v1/items/status/open","status":200,"time":"2026-10-18T09:15:00.000Z"}`,
+		`{"level":"info","method":"GET","msg":"HTTP Request","path":"/api/v1/items/status/closed","status":200,"time":"2026-10-18T11:30:00.000Z"}`,
+		`{"level":"info","method":"GET","msg":"HTTP Request","path":"/api/v1/items","status":200,"time":"2026-10-18T11:31:00.000Z"}`,
+		`time="2026-10-18T10:00:00.000Z" level=info msg="HTTP request completed" method=GET path=/api/v1/items/status/open status=200 user_agent="client \"v2\""`,
+		`time="2026-10-18T10:00:00.000Z" level=info msg="HTTP request started" method=GET path=/api/v1/items/status/open`,
+		`time="2026-10-18T10:01:00.000Z" level=info msg="Server started" port=8080`,
+		`not a log line`,
+	}, "\n")
+	require.NoError(t, countDeprecatedRequests(&metrics.Deprecations, registry, strings.NewReader(log)))
+
+	assert.Equal(t, 4, metrics.Deprecations.RequestsAnalyzed)
+	byStatus := metrics.Deprecations.Endpoints[1]
+	assert.Equal(t, "/api/v1/items/status/:status", byStatus.Path)
+	assert.Equal(t, "2027-04-30", byStatus.Sunset)
+	assert.Equal(t, 3, byStatus.Requests)
+	require.NotNil(t, byStatus.LastSeen)
+	assert.Equal(t, time.Date(2026, 10, 18, 11, 30, 0, 0, time.UTC), byStatus.LastSeen.UTC())
+	assert.Zero(t, metrics.Deprecations.Endpoints[0].Requests)
+}
+
+func TestAnalyzeDeprecatedTraffic(t *testing.T) {
+	metrics := &DocumentationMetrics{}
+	analyzeDeprecations(metrics, deprecation.Endpoints())
+	require.NotEmpty(t, metrics.Deprecations.Endpoints)
+	endpoint := metrics.Deprecations.Endpoints[0]
+
+	logFile := filepath.Join(t.TempDir(), "access.log")
+	path := strings.ReplaceAll(endpoint.Path, ":", "")
+	line := `{"method":"` + endpoint.Method + `","path":"` + path + `","status":200,"time":"2026-10-18T09:15:00Z"}` + "\n"
+	require.NoError(t, os.WriteFile(logFile, []byte(line+line), 0644))
+
+	require.NoError(t, AnalyzeDeprecatedTraffic(metrics, []string{logFile}))
+	assert.Equal(t, 1, metrics.Deprecations.EndpointsWithTraffic)
+	assert.Equal(t, 2, metrics.Deprecations.Endpoints[0].Requests)
+	require.Len(t, metrics.Recommendations, 1)
+	assert.Contains(t, metrics.Recommendations[0], "still received 2 requests")
+
+	assert.Error(t, AnalyzeDeprecatedTraffic(metrics, []string{filepath.Join(t.TempDir(), "missing.log")}))
+}
+
+func TestParseLogLine(t *testing.T) {
+	fields := parseLogLine(`time="2026-10-18T10:00:00.000Z" level=info msg="say \"hi\" \\o/" path=/x status=404`)
+	assert.Equal(t, map[string]string{
+		"time":   "2026-10-18T10:00:00.000Z",
+		"level":  "info",
+		"msg":    `say "hi" \o/`,
+		"path":   "/x",
+		"status": "404",
+	}, fields)
+
+	assert.Equal(t, "200", parseLogLine(`{"status":200}`)["status"])
+	assert.Empty(t, parseLogLine(`{broken`))
+}
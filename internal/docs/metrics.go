@@ -12,6 +12,8 @@ import (
 	"regexp"
 	"strings"
 	"time"
+
+	"product-requirements-management/internal/deprecation"
 )
 
 // DocumentationMetrics represents comprehensive documentation quality metrics
@@ -21,6 +23,7 @@ type DocumentationMetrics struct {
 	EndpointCoverage   EndpointCoverageMetrics   `json:"endpoint_coverage"`
 	ExampleCoverage    ExampleCoverageMetrics    `json:"example_coverage"`
 	QualityScore       QualityScoreMetrics       `json:"quality_score"`
+	Deprecations       DeprecationMetrics        `json:"deprecations"`
 	TagDistribution    map[string]int            `json:"tag_distribution"`
 	MissingAnnotations []MissingAnnotationInfo   `json:"missing_annotations"`
 	Recommendations    []string                  `json:"recommendations"`
@@ -92,6 +95,9 @@ func GenerateDocumentationMetrics() (*DocumentationMetrics, error) {
 		return nil, fmt.Errorf("failed to analyze example coverage: %w", err)
 	}
 
+	// List deprecated endpoints; AnalyzeDeprecatedTraffic adds their traffic from access logs
+	analyzeDeprecations(metrics, deprecation.Endpoints())
+
 	// Calculate quality scores
 	calculateQualityScores(metrics)
 
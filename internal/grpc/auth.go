@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/service"
+)
+
+// authorizationMetadata is the metadata key of the bearer token; gRPC lower-cases metadata keys
+const authorizationMetadata = "authorization"
+
+type claimsContextKey struct{}
+
+// ClaimsFromContext returns the identity of the caller of a gRPC call
+func ClaimsFromContext(ctx context.Context) (*auth.Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*auth.Claims)
+	return claims, ok
+}
+
+// authenticator authenticates gRPC calls with the bearer token in their metadata
+type authenticator struct {
+	authService *auth.Service
+	patService  service.PATService
+}
+
+// unary rejects calls without a valid token and adds the caller's claims to the context.
+// Every role may use the read-only API.
+func (a *authenticator) unary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	claims, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(context.WithValue(ctx, claimsContextKey{}, claims), req)
+}
+
+func (a *authenticator) authenticate(ctx context.Context) (*auth.Claims, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadata)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata required")
+	}
+	if !strings.HasPrefix(values[0], auth.BearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "bearer token required")
+	}
+	token := strings.TrimPrefix(values[0], auth.BearerPrefix)
+
+	if strings.HasPrefix(token, auth.PATPrefix) {
+		if a.patService == nil {
+			return nil, status.Error(codes.Unauthenticated, "personal access tokens are not accepted")
+		}
+		user, _, err := a.patService.AuthenticateToken(ctx, token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return &auth.Claims{UserID: user.ID.String(), Username: user.Username, Role: user.Role}, nil
+	}
+
+	claims, err := a.authService.ValidateToken(token)
+	if err != nil {
+		if errors.Is(err, auth.ErrTokenExpired) {
+			return nil, status.Error(codes.Unauthenticated, "token expired")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return claims, nil
+}
@@ -0,0 +1,417 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"product-requirements-management/internal/grpc/rmsv1"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// Paging limits of list calls, matching the REST API
+const (
+	defaultListLimit = 50
+	maxListLimit     = 100
+)
+
+// entityTypeNames maps the API entity types to the names used by the services
+var entityTypeNames = map[rmsv1.EntityType]models.EntityType{
+	rmsv1.EntityType_ENTITY_TYPE_EPIC:                models.EntityTypeEpic,
+	rmsv1.EntityType_ENTITY_TYPE_USER_STORY:          models.EntityTypeUserStory,
+	rmsv1.EntityType_ENTITY_TYPE_ACCEPTANCE_CRITERIA: models.EntityTypeAcceptanceCriteria,
+	rmsv1.EntityType_ENTITY_TYPE_REQUIREMENT:         models.EntityTypeRequirement,
+}
+
+// requirementsServer implements rmsv1.RequirementsServiceServer
+type requirementsServer struct {
+	rmsv1.UnimplementedRequirementsServiceServer
+
+	services Services
+	logger   *logrus.Logger
+}
+
+// GetEntity returns an entity by UUID or reference ID
+func (s *requirementsServer) GetEntity(ctx context.Context, req *rmsv1.GetEntityRequest) (*rmsv1.Entity, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	entity, err := s.getEntity(ctx, req.GetType(), req.GetId())
+	if err != nil {
+		return nil, s.toStatus("GetEntity", err)
+	}
+	return entity, nil
+}
+
+// ListEntities lists the entities of a type with the filters of the request
+func (s *requirementsServer) ListEntities(ctx context.Context, req *rmsv1.ListEntitiesRequest) (*rmsv1.ListEntitiesResponse, error) {
+	limit, offset, err := paging(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := optionalUUID("parent_id", req.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+	assigneeID, err := optionalUUID("assignee_id", req.GetAssigneeId())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rmsv1.ListEntitiesResponse{}
+	switch req.GetType() {
+	case rmsv1.EntityType_ENTITY_TYPE_EPIC:
+		if parentID != nil {
+			return nil, status.Error(codes.InvalidArgument, "epics have no parent")
+		}
+		filters := service.EpicFilters{AssigneeID: assigneeID, Limit: limit, Offset: offset}
+		if req.GetStatus() != "" {
+			epicStatus := models.EpicStatus(req.GetStatus())
+			filters.Status = &epicStatus
+		}
+		epics, total, err := s.services.Entities.Epic.ListEpics(ctx, filters)
+		if err != nil {
+			return nil, s.toStatus("ListEntities", err)
+		}
+		for i := range epics {
+			response.Entities = append(response.Entities, epicEntity(&epics[i]))
+		}
+		response.TotalCount = total
+
+	case rmsv1.EntityType_ENTITY_TYPE_USER_STORY:
+		filters := service.UserStoryFilters{EpicID: parentID, AssigneeID: assigneeID, Limit: limit, Offset: offset}
+		if req.GetStatus() != "" {
+			userStoryStatus := models.UserStoryStatus(req.GetStatus())
+			filters.Status = &userStoryStatus
+		}
+		userStories, total, err := s.services.Entities.UserStory.ListUserStories(ctx, filters)
+		if err != nil {
+			return nil, s.toStatus("ListEntities", err)
+		}
+		for i := range userStories {
+			response.Entities = append(response.Entities, userStoryEntity(&userStories[i]))
+		}
+		response.TotalCount = total
+
+	case rmsv1.EntityType_ENTITY_TYPE_ACCEPTANCE_CRITERIA:
+		if req.GetStatus() != "" || assigneeID != nil {
+			return nil, status.Error(codes.InvalidArgument, "acceptance criteria have no status or assignee")
+		}
+		filters := service.AcceptanceCriteriaFilters{UserStoryID: parentID, Limit: limit, Offset: offset}
+		acceptanceCriteria, total, err := s.services.Entities.AcceptanceCriteria.ListAcceptanceCriteria(ctx, filters)
+		if err != nil {
+			return nil, s.toStatus("ListEntities", err)
+		}
+		for i := range acceptanceCriteria {
+			response.Entities = append(response.Entities, acceptanceCriteriaEntity(&acceptanceCriteria[i]))
+		}
+		response.TotalCount = total
+
+	case rmsv1.EntityType_ENTITY_TYPE_REQUIREMENT:
+		filters := service.RequirementFilters{UserStoryID: parentID, AssigneeID: assigneeID, Limit: limit, Offset: offset}
+		if req.GetStatus() != "" {
+			requirementStatus := models.RequirementStatus(req.GetStatus())
+			filters.Status = &requirementStatus
+		}
+		requirements, total, err := s.services.Entities.Requirement.ListRequirements(ctx, filters)
+		if err != nil {
+			return nil, s.toStatus("ListEntities", err)
+		}
+		for i := range requirements {
+			response.Entities = append(response.Entities, requirementEntity(&requirements[i]))
+		}
+		response.TotalCount = total
+
+	default:
+		return nil, invalidEntityType(req.GetType())
+	}
+	return response, nil
+}
+
+// Search runs a full-text search over the entity types of the request
+func (s *requirementsServer) Search(ctx context.Context, req *rmsv1.SearchRequest) (*rmsv1.SearchResponse, error) {
+	limit, offset, err := paging(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	types := req.GetTypes()
+	if len(types) == 0 {
+		types = []rmsv1.EntityType{
+			rmsv1.EntityType_ENTITY_TYPE_EPIC,
+			rmsv1.EntityType_ENTITY_TYPE_USER_STORY,
+			rmsv1.EntityType_ENTITY_TYPE_ACCEPTANCE_CRITERIA,
+			rmsv1.EntityType_ENTITY_TYPE_REQUIREMENT,
+		}
+	}
+	entityTypes := make([]string, 0, len(types))
+	for _, entityType := range types {
+		name, ok := entityTypeNames[entityType]
+		if !ok {
+			return nil, invalidEntityType(entityType)
+		}
+		entityTypes = append(entityTypes, string(name))
+	}
+
+	results, err := s.services.Search.Search(ctx, service.SearchOptions{
+		Query:       req.GetQuery(),
+		EntityTypes: entityTypes,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return nil, s.toStatus("Search", err)
+	}
+
+	response := &rmsv1.SearchResponse{TotalCount: results.Total}
+	for _, result := range results.Results {
+		item := &rmsv1.SearchResult{
+			Type:        apiEntityType(models.EntityType(result.Type)),
+			Id:          result.ID.String(),
+			ReferenceId: result.ReferenceID,
+			Title:       result.Title,
+			Description: result.Description,
+			Status:      result.Status,
+			Relevance:   result.Relevance,
+		}
+		if result.Priority != nil {
+			item.Priority = int32(*result.Priority)
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response, nil
+}
+
+// GetHierarchy returns the direct children of an entity, or a page of epics when no parent is given
+func (s *requirementsServer) GetHierarchy(ctx context.Context, req *rmsv1.GetHierarchyRequest) (*rmsv1.GetHierarchyResponse, error) {
+	if req.GetParentType() == rmsv1.EntityType_ENTITY_TYPE_UNSPECIFIED {
+		limit, offset, err := paging(req.GetLimit(), req.GetOffset())
+		if err != nil {
+			return nil, err
+		}
+		nodes, total, err := s.services.Navigation.ListEpicNodes(ctx, service.HierarchyFilters{Limit: limit, Offset: offset})
+		if err != nil {
+			return nil, s.toStatus("GetHierarchy", err)
+		}
+		return &rmsv1.GetHierarchyResponse{Nodes: hierarchyNodes(nodes), TotalCount: total}, nil
+	}
+
+	if req.GetParentId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "parent_id is required with parent_type")
+	}
+	name, ok := entityTypeNames[req.GetParentType()]
+	if !ok {
+		return nil, invalidEntityType(req.GetParentType())
+	}
+	parentID, err := uuid.Parse(req.GetParentId())
+	if err != nil {
+		parent, err := s.getEntity(ctx, req.GetParentType(), req.GetParentId())
+		if err != nil {
+			return nil, s.toStatus("GetHierarchy", err)
+		}
+		parentID = uuid.MustParse(parent.GetId())
+	}
+
+	nodes, err := s.services.Navigation.ListChildNodes(ctx, string(name), parentID)
+	if err != nil {
+		return nil, s.toStatus("GetHierarchy", err)
+	}
+	return &rmsv1.GetHierarchyResponse{Nodes: hierarchyNodes(nodes), TotalCount: int64(len(nodes))}, nil
+}
+
+// getEntity gets an entity by UUID or reference ID
+func (s *requirementsServer) getEntity(ctx context.Context, entityType rmsv1.EntityType, idOrReference string) (*rmsv1.Entity, error) {
+	id, parseErr := uuid.Parse(idOrReference)
+	byID := parseErr == nil
+
+	switch entityType {
+	case rmsv1.EntityType_ENTITY_TYPE_EPIC:
+		var epic *models.Epic
+		var err error
+		if byID {
+			epic, err = s.services.Entities.Epic.GetEpicByID(ctx, id)
+		} else {
+			epic, err = s.services.Entities.Epic.GetEpicByReferenceID(ctx, idOrReference)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return epicEntity(epic), nil
+
+	case rmsv1.EntityType_ENTITY_TYPE_USER_STORY:
+		var userStory *models.UserStory
+		var err error
+		if byID {
+			userStory, err = s.services.Entities.UserStory.GetUserStoryByID(ctx, id)
+		} else {
+			userStory, err = s.services.Entities.UserStory.GetUserStoryByReferenceID(ctx, idOrReference)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return userStoryEntity(userStory), nil
+
+	case rmsv1.EntityType_ENTITY_TYPE_ACCEPTANCE_CRITERIA:
+		var acceptanceCriteria *models.AcceptanceCriteria
+		var err error
+		if byID {
+			acceptanceCriteria, err = s.services.Entities.AcceptanceCriteria.GetAcceptanceCriteriaByID(ctx, id)
+		} else {
+			acceptanceCriteria, err = s.services.Entities.AcceptanceCriteria.GetAcceptanceCriteriaByReferenceID(ctx, idOrReference)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return acceptanceCriteriaEntity(acceptanceCriteria), nil
+
+	case rmsv1.EntityType_ENTITY_TYPE_REQUIREMENT:
+		var requirement *models.Requirement
+		var err error
+		if byID {
+			requirement, err = s.services.Entities.Requirement.GetRequirementByID(ctx, id)
+		} else {
+			requirement, err = s.services.Entities.Requirement.GetRequirementByReferenceID(ctx, idOrReference)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return requirementEntity(requirement), nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", service.ErrInvalidEntityType, entityType)
+	}
+}
+
+func (s *requirementsServer) toStatus(method string, err error) error {
+	return statusError(s.logger, method, err)
+}
+
+// paging validates the limit and offset of a list call and applies the default limit
+func paging(limit, offset int32) (int, int, error) {
+	if limit < 0 || limit > maxListLimit {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxListLimit)
+	}
+	if offset < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	return int(limit), int(offset), nil
+}
+
+// optionalUUID parses an optional UUID field of a request
+func optionalUUID(field, value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be a UUID", field)
+	}
+	return &id, nil
+}
+
+func invalidEntityType(entityType rmsv1.EntityType) error {
+	return status.Errorf(codes.InvalidArgument, "unsupported entity type %s", entityType)
+}
+
+func apiEntityType(entityType models.EntityType) rmsv1.EntityType {
+	for apiType, name := range entityTypeNames {
+		if name == entityType {
+			return apiType
+		}
+	}
+	return rmsv1.EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func epicEntity(epic *models.Epic) *rmsv1.Entity {
+	return &rmsv1.Entity{
+		Type:        rmsv1.EntityType_ENTITY_TYPE_EPIC,
+		Id:          epic.ID.String(),
+		ReferenceId: epic.ReferenceID,
+		Title:       epic.Title,
+		Description: stringValue(epic.Description),
+		Status:      string(epic.Status),
+		Priority:    int32(epic.Priority),
+		CreatorId:   epic.CreatorID.String(),
+		AssigneeId:  epic.AssigneeID.String(),
+		CreatedAt:   timestamppb.New(epic.CreatedAt),
+		UpdatedAt:   timestamppb.New(epic.UpdatedAt),
+	}
+}
+
+func userStoryEntity(userStory *models.UserStory) *rmsv1.Entity {
+	return &rmsv1.Entity{
+		Type:        rmsv1.EntityType_ENTITY_TYPE_USER_STORY,
+		Id:          userStory.ID.String(),
+		ReferenceId: userStory.ReferenceID,
+		Title:       userStory.Title,
+		Description: stringValue(userStory.Description),
+		Status:      string(userStory.Status),
+		Priority:    int32(userStory.Priority),
+		ParentId:    userStory.EpicID.String(),
+		CreatorId:   userStory.CreatorID.String(),
+		AssigneeId:  userStory.AssigneeID.String(),
+		CreatedAt:   timestamppb.New(userStory.CreatedAt),
+		UpdatedAt:   timestamppb.New(userStory.UpdatedAt),
+	}
+}
+
+func acceptanceCriteriaEntity(acceptanceCriteria *models.AcceptanceCriteria) *rmsv1.Entity {
+	return &rmsv1.Entity{
+		Type:        rmsv1.EntityType_ENTITY_TYPE_ACCEPTANCE_CRITERIA,
+		Id:          acceptanceCriteria.ID.String(),
+		ReferenceId: acceptanceCriteria.ReferenceID,
+		Description: acceptanceCriteria.Description,
+		ParentId:    acceptanceCriteria.UserStoryID.String(),
+		CreatorId:   acceptanceCriteria.AuthorID.String(),
+		CreatedAt:   timestamppb.New(acceptanceCriteria.CreatedAt),
+		UpdatedAt:   timestamppb.New(acceptanceCriteria.UpdatedAt),
+	}
+}
+
+func requirementEntity(requirement *models.Requirement) *rmsv1.Entity {
+	return &rmsv1.Entity{
+		Type:        rmsv1.EntityType_ENTITY_TYPE_REQUIREMENT,
+		Id:          requirement.ID.String(),
+		ReferenceId: requirement.ReferenceID,
+		Title:       requirement.Title,
+		Description: stringValue(requirement.Description),
+		Status:      string(requirement.Status),
+		Priority:    int32(requirement.Priority),
+		ParentId:    requirement.UserStoryID.String(),
+		CreatorId:   requirement.CreatorID.String(),
+		AssigneeId:  requirement.AssigneeID.String(),
+		CreatedAt:   timestamppb.New(requirement.CreatedAt),
+		UpdatedAt:   timestamppb.New(requirement.UpdatedAt),
+	}
+}
+
+func hierarchyNodes(nodes []service.HierarchyNode) []*rmsv1.HierarchyNode {
+	result := make([]*rmsv1.HierarchyNode, len(nodes))
+	for i, node := range nodes {
+		result[i] = &rmsv1.HierarchyNode{
+			Type:          apiEntityType(models.EntityType(node.Type)),
+			Id:            node.ID.String(),
+			ReferenceId:   node.ReferenceID,
+			Title:         node.Title,
+			Status:        node.Status,
+			ChildrenCount: node.ChildrenCount,
+		}
+	}
+	return result
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
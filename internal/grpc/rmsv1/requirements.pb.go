@@ -0,0 +1,1008 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: rms/v1/requirements.proto
+
+package rmsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EntityType identifies a level of the requirements hierarchy.
+type EntityType int32
+
+const (
+	EntityType_ENTITY_TYPE_UNSPECIFIED         EntityType = 0
+	EntityType_ENTITY_TYPE_EPIC                EntityType = 1
+	EntityType_ENTITY_TYPE_USER_STORY          EntityType = 2
+	EntityType_ENTITY_TYPE_ACCEPTANCE_CRITERIA EntityType = 3
+	EntityType_ENTITY_TYPE_REQUIREMENT         EntityType = 4
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "ENTITY_TYPE_UNSPECIFIED",
+		1: "ENTITY_TYPE_EPIC",
+		2: "ENTITY_TYPE_USER_STORY",
+		3: "ENTITY_TYPE_ACCEPTANCE_CRITERIA",
+		4: "ENTITY_TYPE_REQUIREMENT",
+	}
+	EntityType_value = map[string]int32{
+		"ENTITY_TYPE_UNSPECIFIED":         0,
+		"ENTITY_TYPE_EPIC":                1,
+		"ENTITY_TYPE_USER_STORY":          2,
+		"ENTITY_TYPE_ACCEPTANCE_CRITERIA": 3,
+		"ENTITY_TYPE_REQUIREMENT":         4,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rms_v1_requirements_proto_enumTypes[0].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_rms_v1_requirements_proto_enumTypes[0]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{0}
+}
+
+// Entity is an epic, user story, acceptance criterion or requirement.
+type Entity struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Type        EntityType             `protobuf:"varint,1,opt,name=type,proto3,enum=rms.v1.EntityType" json:"type,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ReferenceId string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// Empty for acceptance criteria.
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Empty for acceptance criteria.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// 1 (critical) to 4 (low); 0 for acceptance criteria.
+	Priority int32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Epic of a user story, user story of an acceptance criterion or requirement; empty for epics.
+	ParentId string `protobuf:"bytes,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Author of an acceptance criterion.
+	CreatorId string `protobuf:"bytes,9,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	// Empty for acceptance criteria.
+	AssigneeId    string                 `protobuf:"bytes,10,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Entity) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *Entity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Entity) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *Entity) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Entity) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Entity) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Entity) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Entity) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Entity) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Entity) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *Entity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Entity) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetEntityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  EntityType             `protobuf:"varint,1,opt,name=type,proto3,enum=rms.v1.EntityType" json:"type,omitempty"`
+	// UUID or reference ID such as EP-001.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntityRequest) Reset() {
+	*x = GetEntityRequest{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntityRequest) ProtoMessage() {}
+
+func (x *GetEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntityRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityRequest) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetEntityRequest) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *GetEntityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEntitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  EntityType             `protobuf:"varint,1,opt,name=type,proto3,enum=rms.v1.EntityType" json:"type,omitempty"`
+	// UUID of the parent entity; not supported for epics.
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Not supported for acceptance criteria.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Not supported for acceptance criteria.
+	AssigneeId string `protobuf:"bytes,4,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	// Defaults to 50; at most 100.
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntitiesRequest) Reset() {
+	*x = ListEntitiesRequest{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntitiesRequest) ProtoMessage() {}
+
+func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEntitiesRequest) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ListEntitiesRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListEntitiesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListEntitiesRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *ListEntitiesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEntitiesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListEntitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entities      []*Entity              `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntitiesResponse) Reset() {
+	*x = ListEntitiesResponse{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntitiesResponse) ProtoMessage() {}
+
+func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEntitiesResponse) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *ListEntitiesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// All entity types when empty.
+	Types []EntityType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=rms.v1.EntityType" json:"types,omitempty"`
+	// Defaults to 50; at most 100.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []EntityType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          EntityType             `protobuf:"varint,1,opt,name=type,proto3,enum=rms.v1.EntityType" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Priority      int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Relevance     float64                `protobuf:"fixed64,8,opt,name=relevance,proto3" json:"relevance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchResult) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchResult) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SearchResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchResult) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SearchResult) GetRelevance() float64 {
+	if x != nil {
+		return x.Relevance
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetHierarchyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The epics are listed when unspecified.
+	ParentType EntityType `protobuf:"varint,1,opt,name=parent_type,json=parentType,proto3,enum=rms.v1.EntityType" json:"parent_type,omitempty"`
+	// UUID or reference ID of the parent.
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Paging of the epics; defaults to 50, at most 100.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHierarchyRequest) Reset() {
+	*x = GetHierarchyRequest{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHierarchyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHierarchyRequest) ProtoMessage() {}
+
+func (x *GetHierarchyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHierarchyRequest.ProtoReflect.Descriptor instead.
+func (*GetHierarchyRequest) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHierarchyRequest) GetParentType() EntityType {
+	if x != nil {
+		return x.ParentType
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *GetHierarchyRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *GetHierarchyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetHierarchyRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// HierarchyNode is an entity of the hierarchy without its content.
+type HierarchyNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          EntityType             `protobuf:"varint,1,opt,name=type,proto3,enum=rms.v1.EntityType" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ChildrenCount int64                  `protobuf:"varint,6,opt,name=children_count,json=childrenCount,proto3" json:"children_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HierarchyNode) Reset() {
+	*x = HierarchyNode{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HierarchyNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HierarchyNode) ProtoMessage() {}
+
+func (x *HierarchyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HierarchyNode.ProtoReflect.Descriptor instead.
+func (*HierarchyNode) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HierarchyNode) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *HierarchyNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HierarchyNode) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *HierarchyNode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *HierarchyNode) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HierarchyNode) GetChildrenCount() int64 {
+	if x != nil {
+		return x.ChildrenCount
+	}
+	return 0
+}
+
+type GetHierarchyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*HierarchyNode       `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHierarchyResponse) Reset() {
+	*x = GetHierarchyResponse{}
+	mi := &file_rms_v1_requirements_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHierarchyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHierarchyResponse) ProtoMessage() {}
+
+func (x *GetHierarchyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rms_v1_requirements_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHierarchyResponse.ProtoReflect.Descriptor instead.
+func (*GetHierarchyResponse) Descriptor() ([]byte, []int) {
+	return file_rms_v1_requirements_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetHierarchyResponse) GetNodes() []*HierarchyNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetHierarchyResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+var File_rms_v1_requirements_proto protoreflect.FileDescriptor
+
+const file_rms_v1_requirements_proto_rawDesc = "" +
+	"\n" +
+	"\x19rms/v1/requirements.proto\x12\x06rms.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x03\n" +
+	"\x06Entity\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.rms.v1.EntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12\x1b\n" +
+	"\tparent_id\x18\b \x01(\tR\bparentId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\t \x01(\tR\tcreatorId\x12\x1f\n" +
+	"\vassignee_id\x18\n" +
+	" \x01(\tR\n" +
+	"assigneeId\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x10GetEntityRequest\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.rms.v1.EntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xc1\x01\n" +
+	"\x13ListEntitiesRequest\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.rms.v1.EntityTypeR\x04type\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1f\n" +
+	"\vassignee_id\x18\x04 \x01(\tR\n" +
+	"assigneeId\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"c\n" +
+	"\x14ListEntitiesResponse\x12*\n" +
+	"\bentities\x18\x01 \x03(\v2\x0e.rms.v1.EntityR\bentities\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"}\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12(\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x12.rms.v1.EntityTypeR\x05types\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xf3\x01\n" +
+	"\fSearchResult\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.rms.v1.EntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12\x1c\n" +
+	"\trelevance\x18\b \x01(\x01R\trelevance\"a\n" +
+	"\x0eSearchResponse\x12.\n" +
+	"\aresults\x18\x01 \x03(\v2\x14.rms.v1.SearchResultR\aresults\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"\x95\x01\n" +
+	"\x13GetHierarchyRequest\x123\n" +
+	"\vparent_type\x18\x01 \x01(\x0e2\x12.rms.v1.EntityTypeR\n" +
+	"parentType\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xbf\x01\n" +
+	"\rHierarchyNode\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.rms.v1.EntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12%\n" +
+	"\x0echildren_count\x18\x06 \x01(\x03R\rchildrenCount\"d\n" +
+	"\x14GetHierarchyResponse\x12+\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x15.rms.v1.HierarchyNodeR\x05nodes\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount*\x9d\x01\n" +
+	"\n" +
+	"EntityType\x12\x1b\n" +
+	"\x17ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ENTITY_TYPE_EPIC\x10\x01\x12\x1a\n" +
+	"\x16ENTITY_TYPE_USER_STORY\x10\x02\x12#\n" +
+	"\x1fENTITY_TYPE_ACCEPTANCE_CRITERIA\x10\x03\x12\x1b\n" +
+	"\x17ENTITY_TYPE_REQUIREMENT\x10\x042\x9b\x02\n" +
+	"\x13RequirementsService\x125\n" +
+	"\tGetEntity\x12\x18.rms.v1.GetEntityRequest\x1a\x0e.rms.v1.Entity\x12I\n" +
+	"\fListEntities\x12\x1b.rms.v1.ListEntitiesRequest\x1a\x1c.rms.v1.ListEntitiesResponse\x127\n" +
+	"\x06Search\x12\x15.rms.v1.SearchRequest\x1a\x16.rms.v1.SearchResponse\x12I\n" +
+	"\fGetHierarchy\x12\x1b.rms.v1.GetHierarchyRequest\x1a\x1c.rms.v1.GetHierarchyResponseB;Z9product-requirements-management/internal/grpc/rmsv1;rmsv1b\x06proto3"
+
+var (
+	file_rms_v1_requirements_proto_rawDescOnce sync.Once
+	file_rms_v1_requirements_proto_rawDescData []byte
+)
+
+func file_rms_v1_requirements_proto_rawDescGZIP() []byte {
+	file_rms_v1_requirements_proto_rawDescOnce.Do(func() {
+		file_rms_v1_requirements_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rms_v1_requirements_proto_rawDesc), len(file_rms_v1_requirements_proto_rawDesc)))
+	})
+	return file_rms_v1_requirements_proto_rawDescData
+}
+
+var file_rms_v1_requirements_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rms_v1_requirements_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rms_v1_requirements_proto_goTypes = []any{
+	(EntityType)(0),               // 0: rms.v1.EntityType
+	(*Entity)(nil),                // 1: rms.v1.Entity
+	(*GetEntityRequest)(nil),      // 2: rms.v1.GetEntityRequest
+	(*ListEntitiesRequest)(nil),   // 3: rms.v1.ListEntitiesRequest
+	(*ListEntitiesResponse)(nil),  // 4: rms.v1.ListEntitiesResponse
+	(*SearchRequest)(nil),         // 5: rms.v1.SearchRequest
+	(*SearchResult)(nil),          // 6: rms.v1.SearchResult
+	(*SearchResponse)(nil),        // 7: rms.v1.SearchResponse
+	(*GetHierarchyRequest)(nil),   // 8: rms.v1.GetHierarchyRequest
+	(*HierarchyNode)(nil),         // 9: rms.v1.HierarchyNode
+	(*GetHierarchyResponse)(nil),  // 10: rms.v1.GetHierarchyResponse
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_rms_v1_requirements_proto_depIdxs = []int32{
+	0,  // 0: rms.v1.Entity.type:type_name -> rms.v1.EntityType
+	11, // 1: rms.v1.Entity.created_at:type_name -> google.protobuf.Timestamp
+	11, // 2: rms.v1.Entity.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: rms.v1.GetEntityRequest.type:type_name -> rms.v1.EntityType
+	0,  // 4: rms.v1.ListEntitiesRequest.type:type_name -> rms.v1.EntityType
+	1,  // 5: rms.v1.ListEntitiesResponse.entities:type_name -> rms.v1.Entity
+	0,  // 6: rms.v1.SearchRequest.types:type_name -> rms.v1.EntityType
+	0,  // 7: rms.v1.SearchResult.type:type_name -> rms.v1.EntityType
+	6,  // 8: rms.v1.SearchResponse.results:type_name -> rms.v1.SearchResult
+	0,  // 9: rms.v1.GetHierarchyRequest.parent_type:type_name -> rms.v1.EntityType
+	0,  // 10: rms.v1.HierarchyNode.type:type_name -> rms.v1.EntityType
+	9,  // 11: rms.v1.GetHierarchyResponse.nodes:type_name -> rms.v1.HierarchyNode
+	2,  // 12: rms.v1.RequirementsService.GetEntity:input_type -> rms.v1.GetEntityRequest
+	3,  // 13: rms.v1.RequirementsService.ListEntities:input_type -> rms.v1.ListEntitiesRequest
+	5,  // 14: rms.v1.RequirementsService.Search:input_type -> rms.v1.SearchRequest
+	8,  // 15: rms.v1.RequirementsService.GetHierarchy:input_type -> rms.v1.GetHierarchyRequest
+	1,  // 16: rms.v1.RequirementsService.GetEntity:output_type -> rms.v1.Entity
+	4,  // 17: rms.v1.RequirementsService.ListEntities:output_type -> rms.v1.ListEntitiesResponse
+	7,  // 18: rms.v1.RequirementsService.Search:output_type -> rms.v1.SearchResponse
+	10, // 19: rms.v1.RequirementsService.GetHierarchy:output_type -> rms.v1.GetHierarchyResponse
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_rms_v1_requirements_proto_init() }
+func file_rms_v1_requirements_proto_init() {
+	if File_rms_v1_requirements_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rms_v1_requirements_proto_rawDesc), len(file_rms_v1_requirements_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rms_v1_requirements_proto_goTypes,
+		DependencyIndexes: file_rms_v1_requirements_proto_depIdxs,
+		EnumInfos:         file_rms_v1_requirements_proto_enumTypes,
+		MessageInfos:      file_rms_v1_requirements_proto_msgTypes,
+	}.Build()
+	File_rms_v1_requirements_proto = out.File
+	file_rms_v1_requirements_proto_goTypes = nil
+	file_rms_v1_requirements_proto_depIdxs = nil
+}
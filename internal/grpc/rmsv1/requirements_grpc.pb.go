@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rms/v1/requirements.proto
+
+package rmsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RequirementsService_GetEntity_FullMethodName    = "/rms.v1.RequirementsService/GetEntity"
+	RequirementsService_ListEntities_FullMethodName = "/rms.v1.RequirementsService/ListEntities"
+	RequirementsService_Search_FullMethodName       = "/rms.v1.RequirementsService/Search"
+	RequirementsService_GetHierarchy_FullMethodName = "/rms.v1.RequirementsService/GetHierarchy"
+)
+
+// RequirementsServiceClient is the client API for RequirementsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RequirementsService exposes the read paths of the requirements hierarchy to internal consumers.
+// Every call must carry an "authorization: Bearer <token>" metadata entry with a JWT or a personal access token.
+type RequirementsServiceClient interface {
+	// GetEntity returns an entity by UUID or reference ID.
+	GetEntity(ctx context.Context, in *GetEntityRequest, opts ...grpc.CallOption) (*Entity, error)
+	// ListEntities lists the entities of a type, optionally limited to the children of a parent entity.
+	ListEntities(ctx context.Context, in *ListEntitiesRequest, opts ...grpc.CallOption) (*ListEntitiesResponse, error)
+	// Search runs a full-text search over epics, user stories, acceptance criteria and requirements.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// GetHierarchy returns the direct children of an entity, or the epics when no parent is given.
+	GetHierarchy(ctx context.Context, in *GetHierarchyRequest, opts ...grpc.CallOption) (*GetHierarchyResponse, error)
+}
+
+type requirementsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRequirementsServiceClient(cc grpc.ClientConnInterface) RequirementsServiceClient {
+	return &requirementsServiceClient{cc}
+}
+
+func (c *requirementsServiceClient) GetEntity(ctx context.Context, in *GetEntityRequest, opts ...grpc.CallOption) (*Entity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Entity)
+	err := c.cc.Invoke(ctx, RequirementsService_GetEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requirementsServiceClient) ListEntities(ctx context.Context, in *ListEntitiesRequest, opts ...grpc.CallOption) (*ListEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntitiesResponse)
+	err := c.cc.Invoke(ctx, RequirementsService_ListEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requirementsServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, RequirementsService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *requirementsServiceClient) GetHierarchy(ctx context.Context, in *GetHierarchyRequest, opts ...grpc.CallOption) (*GetHierarchyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHierarchyResponse)
+	err := c.cc.Invoke(ctx, RequirementsService_GetHierarchy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RequirementsServiceServer is the server API for RequirementsService service.
+// All implementations must embed UnimplementedRequirementsServiceServer
+// for forward compatibility.
+//
+// RequirementsService exposes the read paths of the requirements hierarchy to internal consumers.
+// Every call must carry an "authorization: Bearer <token>" metadata entry with a JWT or a personal access token.
+type RequirementsServiceServer interface {
+	// GetEntity returns an entity by UUID or reference ID.
+	GetEntity(context.Context, *GetEntityRequest) (*Entity, error)
+	// ListEntities lists the entities of a type, optionally limited to the children of a parent entity.
+	ListEntities(context.Context, *ListEntitiesRequest) (*ListEntitiesResponse, error)
+	// Search runs a full-text search over epics, user stories, acceptance criteria and requirements.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// GetHierarchy returns the direct children of an entity, or the epics when no parent is given.
+	GetHierarchy(context.Context, *GetHierarchyRequest) (*GetHierarchyResponse, error)
+	mustEmbedUnimplementedRequirementsServiceServer()
+}
+
+// UnimplementedRequirementsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRequirementsServiceServer struct{}
+
+func (UnimplementedRequirementsServiceServer) GetEntity(context.Context, *GetEntityRequest) (*Entity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntity not implemented")
+}
+func (UnimplementedRequirementsServiceServer) ListEntities(context.Context, *ListEntitiesRequest) (*ListEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntities not implemented")
+}
+func (UnimplementedRequirementsServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedRequirementsServiceServer) GetHierarchy(context.Context, *GetHierarchyRequest) (*GetHierarchyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHierarchy not implemented")
+}
+func (UnimplementedRequirementsServiceServer) mustEmbedUnimplementedRequirementsServiceServer() {}
+func (UnimplementedRequirementsServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRequirementsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RequirementsServiceServer will
+// result in compilation errors.
+type UnsafeRequirementsServiceServer interface {
+	mustEmbedUnimplementedRequirementsServiceServer()
+}
+
+func RegisterRequirementsServiceServer(s grpc.ServiceRegistrar, srv RequirementsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRequirementsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RequirementsService_ServiceDesc, srv)
+}
+
+func _RequirementsService_GetEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequirementsServiceServer).GetEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequirementsService_GetEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequirementsServiceServer).GetEntity(ctx, req.(*GetEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequirementsService_ListEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequirementsServiceServer).ListEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequirementsService_ListEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequirementsServiceServer).ListEntities(ctx, req.(*ListEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequirementsService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequirementsServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequirementsService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequirementsServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RequirementsService_GetHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHierarchyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RequirementsServiceServer).GetHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RequirementsService_GetHierarchy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RequirementsServiceServer).GetHierarchy(ctx, req.(*GetHierarchyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RequirementsService_ServiceDesc is the grpc.ServiceDesc for RequirementsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RequirementsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rms.v1.RequirementsService",
+	HandlerType: (*RequirementsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEntity",
+			Handler:    _RequirementsService_GetEntity_Handler,
+		},
+		{
+			MethodName: "ListEntities",
+			Handler:    _RequirementsService_ListEntities_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _RequirementsService_Search_Handler,
+		},
+		{
+			MethodName: "GetHierarchy",
+			Handler:    _RequirementsService_GetHierarchy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rms/v1/requirements.proto",
+}
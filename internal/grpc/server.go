@@ -0,0 +1,110 @@
+// Package grpc serves the read paths of the requirements hierarchy over gRPC for internal consumers.
+// The API is defined in proto/rms/v1/requirements.proto; the Go stubs in rmsv1 are generated with make proto.
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/grpc/rmsv1"
+	"product-requirements-management/internal/service"
+)
+
+// Searcher runs full-text searches; implemented by *service.SearchService
+type Searcher interface {
+	Search(ctx context.Context, options service.SearchOptions) (*service.SearchResponse, error)
+}
+
+// Services holds the services behind the gRPC API
+type Services struct {
+	Entities   *service.EntityServices
+	Search     Searcher
+	Navigation service.NavigationService
+}
+
+// Server is the gRPC server of the requirements API
+type Server struct {
+	server *grpc.Server
+	logger *logrus.Logger
+}
+
+// NewServer creates a gRPC server that authenticates calls with JWTs and, when patService is set, personal access tokens
+func NewServer(services Services, authService *auth.Service, patService service.PATService, logger *logrus.Logger) *Server {
+	authenticator := &authenticator{authService: authService, patService: patService}
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		recoverUnary(logger),
+		authenticator.unary,
+	))
+	rmsv1.RegisterRequirementsServiceServer(server, &requirementsServer{services: services, logger: logger})
+
+	return &Server{server: server, logger: logger}
+}
+
+// Serve accepts connections on the listener until Stop is called
+func (s *Server) Serve(listener net.Listener) error {
+	return s.server.Serve(listener)
+}
+
+// Stop stops accepting connections and waits for running calls until ctx is done
+func (s *Server) Stop(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+	}
+}
+
+// recoverUnary turns panics in handlers into internal errors
+func recoverUnary(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.WithFields(logrus.Fields{
+					"method": info.FullMethod,
+					"panic":  r,
+				}).Error("Recovered from panic in gRPC handler")
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// statusError converts a service error to a gRPC status; internal errors are logged and not shown to clients
+func statusError(logger *logrus.Logger, method string, err error) error {
+	var code codes.Code
+	switch apperrors.KindOf(err) {
+	case apperrors.KindInvalid, apperrors.KindUnprocessable:
+		code = codes.InvalidArgument
+	case apperrors.KindUnauthenticated:
+		code = codes.Unauthenticated
+	case apperrors.KindForbidden:
+		code = codes.PermissionDenied
+	case apperrors.KindNotFound:
+		code = codes.NotFound
+	case apperrors.KindConflict, apperrors.KindLocked:
+		code = codes.FailedPrecondition
+	case apperrors.KindUnavailable:
+		code = codes.Unavailable
+	default:
+		logger.WithFields(logrus.Fields{
+			"method": method,
+			"error":  err.Error(),
+		}).Error("gRPC call failed")
+		return status.Error(codes.Internal, "internal error")
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/grpc/rmsv1"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+type fakeSearcher struct {
+	options  service.SearchOptions
+	response *service.SearchResponse
+}
+
+func (f *fakeSearcher) Search(_ context.Context, options service.SearchOptions) (*service.SearchResponse, error) {
+	f.options = options
+	return f.response, nil
+}
+
+type testFixture struct {
+	client   rmsv1.RequirementsServiceClient
+	token    string
+	searcher *fakeSearcher
+	epic     models.Epic
+	story    models.UserStory
+}
+
+func setupTestServer(t *testing.T) *testFixture {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.ReferenceRedirect{}, &models.UserStory{}, &models.AcceptanceCriteria{}, &models.Requirement{}))
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog,
+		Priority: models.PriorityHigh, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(&epic).Error)
+	story := models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: epic.ID, Title: "Pay by card",
+		Status: models.UserStoryStatusBacklog, Priority: models.PriorityHigh, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(&story).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	searcher := &fakeSearcher{response: &service.SearchResponse{}}
+	services := Services{
+		Entities: service.NewEntityServices(repos),
+		Search:   searcher,
+		Navigation: service.NewNavigationService(repos.Epic, repos.UserStory, repos.AcceptanceCriteria, repos.Requirement,
+			repos.RequirementRelationship, repos.User, repos.Hierarchy),
+	}
+	authService := auth.NewService("test-secret", time.Hour, nil)
+	token, err := authService.GenerateToken(user)
+	require.NoError(t, err)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+	server := NewServer(services, authService, nil, logger)
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() { server.Stop(context.Background()) })
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return &testFixture{client: rmsv1.NewRequirementsServiceClient(conn), token: token, searcher: searcher, epic: epic, story: story}
+}
+
+func (f *testFixture) authorized() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationMetadata, auth.BearerPrefix+f.token)
+}
+
+func TestServer_RejectsUnauthenticatedCalls(t *testing.T) {
+	f := setupTestServer(t)
+
+	_, err := f.client.GetEntity(context.Background(), &rmsv1.GetEntityRequest{Type: rmsv1.EntityType_ENTITY_TYPE_EPIC, Id: "EP-001"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), authorizationMetadata, auth.BearerPrefix+"not-a-token")
+	_, err = f.client.GetEntity(ctx, &rmsv1.GetEntityRequest{Type: rmsv1.EntityType_ENTITY_TYPE_EPIC, Id: "EP-001"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServer_GetEntity(t *testing.T) {
+	f := setupTestServer(t)
+
+	entity, err := f.client.GetEntity(f.authorized(), &rmsv1.GetEntityRequest{Type: rmsv1.EntityType_ENTITY_TYPE_USER_STORY, Id: "US-001"})
+	require.NoError(t, err)
+	assert.Equal(t, f.story.ID.String(), entity.GetId())
+	assert.Equal(t, f.epic.ID.String(), entity.GetParentId())
+	assert.Equal(t, "Pay by card", entity.GetTitle())
+	assert.Equal(t, int32(models.PriorityHigh), entity.GetPriority())
+
+	entity, err = f.client.GetEntity(f.authorized(), &rmsv1.GetEntityRequest{Type: rmsv1.EntityType_ENTITY_TYPE_EPIC, Id: f.epic.ID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, "EP-001", entity.GetReferenceId())
+
+	_, err = f.client.GetEntity(f.authorized(), &rmsv1.GetEntityRequest{Type: rmsv1.EntityType_ENTITY_TYPE_EPIC, Id: "EP-999"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = f.client.GetEntity(f.authorized(), &rmsv1.GetEntityRequest{Id: "EP-001"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_ListEntities(t *testing.T) {
+	f := setupTestServer(t)
+
+	response, err := f.client.ListEntities(f.authorized(), &rmsv1.ListEntitiesRequest{
+		Type:     rmsv1.EntityType_ENTITY_TYPE_USER_STORY,
+		ParentId: f.epic.ID.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), response.GetTotalCount())
+	require.Len(t, response.GetEntities(), 1)
+	assert.Equal(t, "US-001", response.GetEntities()[0].GetReferenceId())
+
+	_, err = f.client.ListEntities(f.authorized(), &rmsv1.ListEntitiesRequest{Type: rmsv1.EntityType_ENTITY_TYPE_EPIC, ParentId: f.story.ID.String()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = f.client.ListEntities(f.authorized(), &rmsv1.ListEntitiesRequest{Type: rmsv1.EntityType_ENTITY_TYPE_EPIC, Limit: 101})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_Search(t *testing.T) {
+	f := setupTestServer(t)
+	priority := 2
+	f.searcher.response = &service.SearchResponse{
+		Results: []service.SearchResult{{ID: f.story.ID, ReferenceID: "US-001", Type: "user_story", Title: "Pay by card", Priority: &priority, Relevance: 0.5}},
+		Total:   1,
+	}
+
+	response, err := f.client.Search(f.authorized(), &rmsv1.SearchRequest{Query: "card"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"epic", "user_story", "acceptance_criteria", "requirement"}, f.searcher.options.EntityTypes)
+	assert.Equal(t, defaultListLimit, f.searcher.options.Limit)
+	require.Len(t, response.GetResults(), 1)
+	assert.Equal(t, rmsv1.EntityType_ENTITY_TYPE_USER_STORY, response.GetResults()[0].GetType())
+	assert.Equal(t, int32(2), response.GetResults()[0].GetPriority())
+}
+
+func TestServer_GetHierarchy(t *testing.T) {
+	f := setupTestServer(t)
+
+	response, err := f.client.GetHierarchy(f.authorized(), &rmsv1.GetHierarchyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), response.GetTotalCount())
+	require.Len(t, response.GetNodes(), 1)
+	assert.Equal(t, rmsv1.EntityType_ENTITY_TYPE_EPIC, response.GetNodes()[0].GetType())
+	assert.Equal(t, int64(1), response.GetNodes()[0].GetChildrenCount())
+
+	response, err = f.client.GetHierarchy(f.authorized(), &rmsv1.GetHierarchyRequest{
+		ParentType: rmsv1.EntityType_ENTITY_TYPE_EPIC,
+		ParentId:   "EP-001",
+	})
+	require.NoError(t, err)
+	require.Len(t, response.GetNodes(), 1)
+	assert.Equal(t, f.story.ID.String(), response.GetNodes()[0].GetId())
+}
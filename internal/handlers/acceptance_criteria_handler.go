@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"strconv"
@@ -19,6 +20,12 @@ type AcceptanceCriteriaListResponse = ListResponse[models.AcceptanceCriteria]
 type AcceptanceCriteriaHandler struct {
 	acceptanceCriteriaService service.AcceptanceCriteriaService
 	userStoryService          service.UserStoryService
+	lintService               service.AcceptanceCriteriaLintService
+	lintOnSave                bool
+	favoriteService           service.FavoriteService
+	warningService            service.ValidationWarningService
+	countService              service.EntityCountService
+	translationService        service.TranslationService
 }
 
 // NewAcceptanceCriteriaHandler creates a new acceptance criteria handler instance
@@ -29,6 +36,33 @@ func NewAcceptanceCriteriaHandler(acceptanceCriteriaService service.AcceptanceCr
 	}
 }
 
+// SetFavoriteService enables the is_favorite flag (include=is_favorite) on ListAcceptanceCriteria
+func (h *AcceptanceCriteriaHandler) SetFavoriteService(favoriteService service.FavoriteService) {
+	h.favoriteService = favoriteService
+}
+
+// SetCountService enables aggregate counts (include=comments_count and similar) on GetAcceptanceCriteria and ListAcceptanceCriteria
+func (h *AcceptanceCriteriaHandler) SetCountService(countService service.EntityCountService) {
+	h.countService = countService
+}
+
+// SetTranslationService enables translated titles and descriptions, chosen with Accept-Language, on GetAcceptanceCriteria and ListAcceptanceCriteria
+func (h *AcceptanceCriteriaHandler) SetTranslationService(translationService service.TranslationService) {
+	h.translationService = translationService
+}
+
+// SetWarningService enables validation warnings (a warnings array) on acceptance criteria create and update responses
+func (h *AcceptanceCriteriaHandler) SetWarningService(warningService service.ValidationWarningService) {
+	h.warningService = warningService
+}
+
+// SetLintService enables EARS linting. When lintOnSave is true, create and update
+// responses include the lint result of the saved description as a warning.
+func (h *AcceptanceCriteriaHandler) SetLintService(lintService service.AcceptanceCriteriaLintService, lintOnSave bool) {
+	h.lintService = lintService
+	h.lintOnSave = lintOnSave
+}
+
 // CreateAcceptanceCriteria handles both POST /api/v1/acceptance-criteria and POST /api/v1/user-stories/:id/acceptance-criteria
 // @Summary Create acceptance criteria (standalone or within a user story)
 // @Description Create new acceptance criteria. When called via /api/v1/user-stories/:id/acceptance-criteria, the user story ID from the URL path will be used as the parent. When called via /api/v1/acceptance-criteria, the user_story_id must be provided in the request body.
@@ -38,13 +72,15 @@ func NewAcceptanceCriteriaHandler(acceptanceCriteriaService service.AcceptanceCr
 // @Security BearerAuth
 // @Param id path string false "User story UUID (only for nested creation)" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param acceptance_criteria body service.CreateAcceptanceCriteriaRequest true "Acceptance criteria creation request"
-// @Success 201 {object} models.AcceptanceCriteria "Successfully created acceptance criteria"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 201 {object} service.LintedAcceptanceCriteria "Successfully created acceptance criteria; lint is included when EARS linting on save is enabled; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid user story ID format, request body, user story not found, or author not found"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/acceptance-criteria [post]
 // @Router /api/v1/user-stories/{id}/acceptance-criteria [post]
 func (h *AcceptanceCriteriaHandler) CreateAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
 	// Check if this is a nested creation (user story ID in path)
 	userStoryIDParam := c.Param("id")
 	var isNestedCreation bool
@@ -90,7 +126,7 @@ func (h *AcceptanceCriteriaHandler) CreateAcceptanceCriteria(c *gin.Context) {
 		req.UserStoryID = userStoryID
 	}
 
-	acceptanceCriteria, err := h.acceptanceCriteriaService.CreateAcceptanceCriteria(req)
+	acceptanceCriteria, err := h.acceptanceCriteriaService.CreateAcceptanceCriteria(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserStoryNotFound):
@@ -109,23 +145,28 @@ func (h *AcceptanceCriteriaHandler) CreateAcceptanceCriteria(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, acceptanceCriteria)
+	h.respondWithLint(c, http.StatusCreated, acceptanceCriteria)
 }
 
 // GetAcceptanceCriteria handles GET /api/v1/acceptance-criteria/:id
 // @Summary Get acceptance criteria by ID or reference ID
-// @Description Retrieve specific acceptance criteria by its UUID or human-readable reference ID (e.g., AC-001). Returns the acceptance criteria with all its properties including the testable condition and associated user story.
+// @Description Retrieve specific acceptance criteria by its UUID or human-readable reference ID (e.g., AC-001). Returns the acceptance criteria with all its properties including the testable condition and associated user story. A retired reference ID answers 301 Moved Permanently with the successor in the Location header.
 // @Tags acceptance-criteria
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Acceptance criteria UUID or reference ID" example("123e4567-e89b-12d3-a456-426614174000")
+// @Param include query string false "Aggregate counts to embed (comma-separated): comments_count, unresolved_comments_count, requirements_count" example("comments_count,requirements_count")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
 // @Success 200 {object} models.AcceptanceCriteria "Successfully retrieved acceptance criteria"
+// @Success 301 {object} ReferenceMovedResponse "Retired reference ID; the Location header points to the successor"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 404 {object} map[string]interface{} "Acceptance criteria not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/acceptance-criteria/{id} [get]
 func (h *AcceptanceCriteriaHandler) GetAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -133,9 +174,9 @@ func (h *AcceptanceCriteriaHandler) GetAcceptanceCriteria(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		acceptanceCriteria, err = h.acceptanceCriteriaService.GetAcceptanceCriteriaByID(id)
+		acceptanceCriteria, err = h.acceptanceCriteriaService.GetAcceptanceCriteriaByID(ctx, id)
 	} else {
-		acceptanceCriteria, err = h.acceptanceCriteriaService.GetAcceptanceCriteriaByReferenceID(idParam)
+		acceptanceCriteria, err = h.acceptanceCriteriaService.GetAcceptanceCriteriaByReferenceID(ctx, idParam)
 	}
 
 	if err != nil {
@@ -151,7 +192,31 @@ func (h *AcceptanceCriteriaHandler) GetAcceptanceCriteria(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, acceptanceCriteria)
+	if redirectRetiredReference(c, idParam, acceptanceCriteria.ReferenceID, models.EntityTypeAcceptanceCriteria, acceptanceCriteria.ID) {
+		return
+	}
+
+	if err := localizeEntities(c, h.translationService, models.EntityTypeAcceptanceCriteria, []*models.AcceptanceCriteria{acceptanceCriteria}, func(e *models.AcceptanceCriteria) uuid.UUID { return e.ID }, translateAcceptanceCriteria); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get acceptance criteria",
+		})
+		return
+	}
+
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, service.CompactAcceptanceCriteria(acceptanceCriteria))
+		return
+	}
+
+	response, err := includeEntityFields(c, h.countService, models.EntityTypeAcceptanceCriteria, acceptanceCriteria.ID, acceptanceCriteria)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get acceptance criteria",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // UpdateAcceptanceCriteria handles PUT /api/v1/acceptance-criteria/:id
@@ -163,13 +228,15 @@ func (h *AcceptanceCriteriaHandler) GetAcceptanceCriteria(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path string true "Acceptance criteria UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param acceptance_criteria body service.UpdateAcceptanceCriteriaRequest true "Acceptance criteria update request with optional fields"
-// @Success 200 {object} models.AcceptanceCriteria "Successfully updated acceptance criteria"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 200 {object} service.LintedAcceptanceCriteria "Successfully updated acceptance criteria; lint is included when EARS linting on save is enabled; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid acceptance criteria ID format or request body"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 404 {object} map[string]interface{} "Acceptance criteria not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/acceptance-criteria/{id} [put]
 func (h *AcceptanceCriteriaHandler) UpdateAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for updates)
@@ -190,7 +257,7 @@ func (h *AcceptanceCriteriaHandler) UpdateAcceptanceCriteria(c *gin.Context) {
 		return
 	}
 
-	acceptanceCriteria, err := h.acceptanceCriteriaService.UpdateAcceptanceCriteria(id, req)
+	acceptanceCriteria, err := h.acceptanceCriteriaService.UpdateAcceptanceCriteria(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrAcceptanceCriteriaNotFound):
@@ -205,7 +272,7 @@ func (h *AcceptanceCriteriaHandler) UpdateAcceptanceCriteria(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, acceptanceCriteria)
+	h.respondWithLint(c, http.StatusOK, acceptanceCriteria)
 }
 
 // DeleteAcceptanceCriteria handles DELETE /api/v1/acceptance-criteria/:id
@@ -225,6 +292,7 @@ func (h *AcceptanceCriteriaHandler) UpdateAcceptanceCriteria(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/acceptance-criteria/{id} [delete]
 func (h *AcceptanceCriteriaHandler) DeleteAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for deletes)
@@ -239,7 +307,7 @@ func (h *AcceptanceCriteriaHandler) DeleteAcceptanceCriteria(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.acceptanceCriteriaService.DeleteAcceptanceCriteria(id, force)
+	err = h.acceptanceCriteriaService.DeleteAcceptanceCriteria(ctx, id, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrAcceptanceCriteriaNotFound):
@@ -276,14 +344,18 @@ func (h *AcceptanceCriteriaHandler) DeleteAcceptanceCriteria(c *gin.Context) {
 // @Security BearerAuth
 // @Param user_story_id query string false "Filter by user story UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param author_id query string false "Filter by author UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174001")
+// @Param include query string false "Comma-separated: is_favorite adds the current user's favorite flag to each item; comments_count, unresolved_comments_count and requirements_count add aggregate counts" example("is_favorite,comments_count")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
 // @Param order_by query string false "Order by field (e.g., 'created_at DESC', 'reference_id ASC')" example("created_at DESC")
 // @Param limit query integer false "Maximum number of results" minimum(1) maximum(100) example(50)
 // @Param offset query integer false "Number of results to skip" minimum(0) example(0)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
 // @Success 200 {object} map[string]interface{} "Successfully retrieved acceptance criteria list with pagination info"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/acceptance-criteria [get]
 func (h *AcceptanceCriteriaHandler) ListAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
 	var filters service.AcceptanceCriteriaFilters
 
 	// Parse query parameters
@@ -315,7 +387,7 @@ func (h *AcceptanceCriteriaHandler) ListAcceptanceCriteria(c *gin.Context) {
 		}
 	}
 
-	acceptanceCriteria, totalCount, err := h.acceptanceCriteriaService.ListAcceptanceCriteria(filters)
+	acceptanceCriteria, totalCount, err := h.acceptanceCriteriaService.ListAcceptanceCriteria(ctx, filters)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": gin.H{
@@ -332,8 +404,39 @@ func (h *AcceptanceCriteriaHandler) ListAcceptanceCriteria(c *gin.Context) {
 		limit = filters.Limit
 	}
 
+	if err := localizeEntities(c, h.translationService, models.EntityTypeAcceptanceCriteria, entityPointers(acceptanceCriteria), func(e *models.AcceptanceCriteria) uuid.UUID { return e.ID }, translateAcceptanceCriteria); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to list acceptance criteria",
+			},
+		})
+		return
+	}
+
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, gin.H{
+			"data":        service.CompactAcceptanceCriteriaList(acceptanceCriteria),
+			"total_count": totalCount,
+			"limit":       limit,
+			"offset":      filters.Offset,
+		})
+		return
+	}
+
+	data, err := includeListFields(c, h.favoriteService, h.countService, models.EntityTypeAcceptanceCriteria, acceptanceCriteria, func(ac *models.AcceptanceCriteria) uuid.UUID { return ac.ID })
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to list acceptance criteria",
+			},
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"data":        acceptanceCriteria,
+		"data":        data,
 		"total_count": totalCount,
 		"limit":       limit,
 		"offset":      filters.Offset,
@@ -357,6 +460,7 @@ func (h *AcceptanceCriteriaHandler) ListAcceptanceCriteria(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/users/{id}/acceptance-criteria [get]
 func (h *AcceptanceCriteriaHandler) GetAcceptanceCriteriaByAuthor(c *gin.Context) {
+	ctx := c.Request.Context()
 	authorIDParam := c.Param("id")
 
 	// Parse author ID (UUID only)
@@ -392,7 +496,7 @@ func (h *AcceptanceCriteriaHandler) GetAcceptanceCriteriaByAuthor(c *gin.Context
 	}
 	params.SetDefaults()
 
-	acceptanceCriteria, totalCount, err := h.acceptanceCriteriaService.GetAcceptanceCriteriaByAuthor(authorID, params.Limit, params.Offset)
+	acceptanceCriteria, totalCount, err := h.acceptanceCriteriaService.GetAcceptanceCriteriaByAuthor(ctx, authorID, params.Limit, params.Offset)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserNotFound):
@@ -410,3 +514,66 @@ func (h *AcceptanceCriteriaHandler) GetAcceptanceCriteriaByAuthor(c *gin.Context
 	// Use standardized list response format
 	SendListResponse(c, acceptanceCriteria, totalCount, params.Limit, params.Offset)
 }
+
+// LintAcceptanceCriteria handles POST /api/v1/acceptance-criteria/:id/lint
+// @Summary Lint acceptance criteria against EARS patterns
+// @Description Check the acceptance criteria description against the EARS templates (WHEN/IF/WHILE/WHERE ... THEN the system SHALL ...) and return structured findings with byte offsets. The criteria are valid when no finding has error severity; warnings point out ambiguous wording.
+// @Tags acceptance-criteria
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Acceptance criteria UUID or reference ID" example("AC-001")
+// @Success 200 {object} service.AcceptanceCriteriaLintReport "Lint report"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Acceptance criteria not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/acceptance-criteria/{id}/lint [post]
+func (h *AcceptanceCriteriaHandler) LintAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
+	if h.lintService == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Linting is not configured",
+			},
+		})
+		return
+	}
+
+	report, err := h.lintService.LintAcceptanceCriteria(ctx, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": gin.H{
+					"code":    "ACCEPTANCE_CRITERIA_NOT_FOUND",
+					"message": "Acceptance criteria not found",
+				},
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to lint acceptance criteria",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// respondWithLint writes saved acceptance criteria, adding EARS lint warnings when linting on save is enabled
+// and validation warnings when a warning service is configured
+func (h *AcceptanceCriteriaHandler) respondWithLint(c *gin.Context, status int, acceptanceCriteria *models.AcceptanceCriteria) {
+	ctx := c.Request.Context()
+	var response json.Marshaler = acceptanceCriteria
+	if h.lintOnSave && h.lintService != nil {
+		response = service.LintedAcceptanceCriteria{
+			AcceptanceCriteria: *acceptanceCriteria,
+			Lint:               h.lintService.LintDescription(ctx, acceptanceCriteria.Description),
+		}
+	}
+
+	respondWithWarnings(c, status, h.warningService, models.EntityTypeAcceptanceCriteria, acceptanceCriteria.ID, response)
+}
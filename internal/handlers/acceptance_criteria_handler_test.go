@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -13,10 +14,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"product-requirements-management/internal/auth"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/service"
+	"product-requirements-management/internal/validation"
 )
 
 // MockAcceptanceCriteriaService is a mock implementation of AcceptanceCriteriaService
@@ -24,7 +27,7 @@ type MockAcceptanceCriteriaService struct {
 	mock.Mock
 }
 
-func (m *MockAcceptanceCriteriaService) CreateAcceptanceCriteria(req service.CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) CreateAcceptanceCriteria(ctx context.Context, req service.CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -32,7 +35,7 @@ func (m *MockAcceptanceCriteriaService) CreateAcceptanceCriteria(req service.Cre
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByID(id uuid.UUID) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByID(ctx context.Context, id uuid.UUID) (*models.AcceptanceCriteria, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,7 +43,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByID(id uuid.UUID)
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(referenceID string) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -48,7 +51,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(refer
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) UpdateAcceptanceCriteria(id uuid.UUID, req service.UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) UpdateAcceptanceCriteria(ctx context.Context, id uuid.UUID, req service.UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -56,12 +59,12 @@ func (m *MockAcceptanceCriteriaService) UpdateAcceptanceCriteria(id uuid.UUID, r
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) DeleteAcceptanceCriteria(id uuid.UUID, force bool) error {
+func (m *MockAcceptanceCriteriaService) DeleteAcceptanceCriteria(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockAcceptanceCriteriaService) ListAcceptanceCriteria(filters service.AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error) {
+func (m *MockAcceptanceCriteriaService) ListAcceptanceCriteria(ctx context.Context, filters service.AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error) {
 	args := m.Called(filters)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -69,7 +72,7 @@ func (m *MockAcceptanceCriteriaService) ListAcceptanceCriteria(filters service.A
 	return args.Get(0).([]models.AcceptanceCriteria), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(ctx context.Context, userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
 	args := m.Called(userStoryID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -77,7 +80,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(userSto
 	return args.Get(0).([]models.AcceptanceCriteria), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
 	args := m.Called(authorID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -85,7 +88,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(authorID u
 	return args.Get(0).([]models.AcceptanceCriteria), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockAcceptanceCriteriaService) ValidateUserStoryHasAcceptanceCriteria(userStoryID uuid.UUID) error {
+func (m *MockAcceptanceCriteriaService) ValidateUserStoryHasAcceptanceCriteria(ctx context.Context, userStoryID uuid.UUID) error {
 	args := m.Called(userStoryID)
 	return args.Error(0)
 }
@@ -658,3 +661,37 @@ func TestAcceptanceCriteriaHandler_GetAcceptanceCriteriaByAuthor(t *testing.T) {
 		})
 	}
 }
+
+func TestAcceptanceCriteriaHandler_UpdateAcceptanceCriteria_LintOnSave(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := new(MockAcceptanceCriteriaService)
+	handler := NewAcceptanceCriteriaHandler(mockService, new(MockUserStoryService))
+	handler.SetLintService(service.NewAcceptanceCriteriaLintService(nil, validation.NewEARSLinter()), true)
+
+	router := gin.New()
+	router.PUT("/api/v1/acceptance-criteria/:id", handler.UpdateAcceptanceCriteria)
+
+	id := uuid.New()
+	description := "WHEN the session expires THEN the system should log the user out"
+	mockService.On("UpdateAcceptanceCriteria", id, service.UpdateAcceptanceCriteriaRequest{Description: &description}).
+		Return(&models.AcceptanceCriteria{ID: id, ReferenceID: "AC-001", Description: description}, nil)
+
+	body, _ := json.Marshal(map[string]string{"description": description})
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/acceptance-criteria/"+id.String(), bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		ReferenceID string                    `json:"reference_id"`
+		Lint        validation.EARSLintResult `json:"lint"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "AC-001", response.ReferenceID)
+	assert.False(t, response.Lint.Valid)
+	require.Len(t, response.Lint.Findings, 1)
+	assert.Equal(t, "weak-modal", response.Lint.Findings[0].Rule)
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/service"
+)
+
+// ActivityHandler handles HTTP requests for activity feeds
+type ActivityHandler struct {
+	activityService service.ActivityService
+}
+
+// NewActivityHandler creates a new activity handler instance
+func NewActivityHandler(activityService service.ActivityService) *ActivityHandler {
+	return &ActivityHandler{
+		activityService: activityService,
+	}
+}
+
+// GetEntityActivity handles GET /api/v1/{entityType}/:id/activity
+// @Summary Get the activity feed of an entity
+// @Description Retrieve a chronological feed of creations, edits, status changes, assignments, comments and relationship changes for an entity, newest first, with cursor pagination
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Maximum number of events to return (default 50, max 100)"
+// @Success 200 {object} service.ActivityFeedResponse "Activity feed page"
+// @Failure 400 {object} map[string]interface{} "Invalid cursor or limit"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/activity [get]
+// @Router /api/v1/user-stories/{id}/activity [get]
+// @Router /api/v1/acceptance-criteria/{id}/activity [get]
+// @Router /api/v1/requirements/{id}/activity [get]
+func (h *ActivityHandler) GetEntityActivity(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	limit, ok := parseActivityLimit(c)
+	if !ok {
+		return
+	}
+
+	feed, err := h.activityService.GetEntityActivity(ctx, entityType, c.Param("id"), c.Query("cursor"), limit)
+	if err != nil {
+		respondWithError(c, err, "Failed to get activity")
+		return
+	}
+
+	c.JSON(http.StatusOK, feed)
+}
+
+// GetMyActivity handles GET /api/v1/users/me/activity
+// @Summary Get the current user's activity feed
+// @Description Retrieve a chronological feed of changes made by the current user and changes to entities assigned to the current user, newest first, with cursor pagination
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Maximum number of events to return (default 50, max 100)"
+// @Success 200 {object} service.ActivityFeedResponse "Activity feed page"
+// @Failure 400 {object} map[string]interface{} "Invalid cursor or limit"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/activity [get]
+func (h *ActivityHandler) GetMyActivity(c *gin.Context) {
+	ctx := c.Request.Context()
+	userIDParam, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return
+	}
+
+	limit, ok := parseActivityLimit(c)
+	if !ok {
+		return
+	}
+
+	feed, err := h.activityService.GetUserActivity(ctx, uuid.MustParse(userIDParam), c.Query("cursor"), limit)
+	if err != nil {
+		respondWithError(c, err, "Failed to get activity")
+		return
+	}
+
+	c.JSON(http.StatusOK, feed)
+}
+
+// parseActivityLimit parses the optional limit query parameter
+// Writes a 400 response and returns false if the parameter is malformed
+func parseActivityLimit(c *gin.Context) (int, bool) {
+	raw := c.Query("limit")
+	if raw == "" {
+		return 0, true
+	}
+
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid limit parameter",
+			},
+		})
+		return 0, false
+	}
+	return limit, true
+}
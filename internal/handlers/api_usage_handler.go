@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// APIQuotaListResponse represents the response for listing API quotas
+type APIQuotaListResponse = ListResponse[models.APIQuota]
+
+// APIUsageHandler handles HTTP requests for API usage and daily quotas
+type APIUsageHandler struct {
+	usageService service.APIUsageService
+}
+
+// NewAPIUsageHandler creates a new API usage handler instance
+func NewAPIUsageHandler(usageService service.APIUsageService) *APIUsageHandler {
+	return &APIUsageHandler{
+		usageService: usageService,
+	}
+}
+
+// GetUsage handles GET /api/v1/admin/usage
+// @Summary Get API usage
+// @Description Retrieve the authenticated API requests of every user on a UTC day, split by JWT session and personal access token, with the daily quota that applies to each user. Requests rejected because a quota was used up are not counted.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param date query string false "UTC day of the report (YYYY-MM-DD, default today)" example(2023-01-01)
+// @Success 200 {object} service.APIUsageReport "API usage"
+// @Failure 400 {object} map[string]interface{} "Invalid date"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/usage [get]
+func (h *APIUsageHandler) GetUsage(c *gin.Context) {
+	ctx := c.Request.Context()
+	day := time.Now().UTC()
+	if raw := c.Query("date"); raw != "" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid date parameter, expected YYYY-MM-DD",
+				},
+			})
+			return
+		}
+		day = parsed
+	}
+
+	report, err := h.usageService.GetUsage(ctx, day)
+	if err != nil {
+		respondWithError(c, err, "Failed to get API usage")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ListQuotas handles GET /api/v1/admin/quotas
+// @Summary List API quotas
+// @Description Retrieve the daily API quotas of roles followed by those of individual users
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} APIQuotaListResponse "API quotas"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/quotas [get]
+func (h *APIUsageHandler) ListQuotas(c *gin.Context) {
+	ctx := c.Request.Context()
+	quotas, err := h.usageService.ListQuotas(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to list API quotas")
+		return
+	}
+
+	SendListResponse(c, quotas, int64(len(quotas)), len(quotas), 0)
+}
+
+// SetRoleQuota handles PUT /api/v1/admin/quotas/roles/:role
+// @Summary Set the API quota of a role
+// @Description Limit the requests per UTC day of every User or Commenter, in total and with personal access tokens. Users over a limit receive 429 with X-RateLimit-Reset and Retry-After headers until the next UTC day. A user quota takes the place of the role quota. Administrators are never limited.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role path string true "Role" Enums(User, Commenter)
+// @Param quota body service.APIQuotaRequest true "Daily limits"
+// @Success 200 {object} models.APIQuota "Role quota"
+// @Failure 400 {object} map[string]interface{} "Invalid role or limits"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/quotas/roles/{role} [put]
+func (h *APIUsageHandler) SetRoleQuota(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req service.APIQuotaRequest
+	if !h.bindQuotaRequest(c, &req) {
+		return
+	}
+
+	quota, err := h.usageService.SetRoleQuota(ctx, models.UserRole(c.Param("role")), req)
+	if err != nil {
+		respondWithError(c, err, "Failed to set API quota")
+		return
+	}
+
+	c.JSON(http.StatusOK, quota)
+}
+
+// SetUserQuota handles PUT /api/v1/admin/quotas/users/:id
+// @Summary Set the API quota of a user
+// @Description Limit the requests per UTC day of a single user, for example an automation account, in total and with personal access tokens. The user quota takes the place of the quota of the user's role.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User UUID" format(uuid)
+// @Param quota body service.APIQuotaRequest true "Daily limits"
+// @Success 200 {object} models.APIQuota "User quota"
+// @Failure 400 {object} map[string]interface{} "Invalid user ID or limits, or the user is an administrator"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "User not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/quotas/users/{id} [put]
+func (h *APIUsageHandler) SetUserQuota(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := h.parseUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.APIQuotaRequest
+	if !h.bindQuotaRequest(c, &req) {
+		return
+	}
+
+	quota, err := h.usageService.SetUserQuota(ctx, userID, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to set API quota")
+		return
+	}
+
+	c.JSON(http.StatusOK, quota)
+}
+
+// DeleteUserQuota handles DELETE /api/v1/admin/quotas/users/:id
+// @Summary Remove the API quota of a user
+// @Description Remove the quota of a user, so that the quota of the user's role applies again
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User UUID" format(uuid)
+// @Success 204 "Quota removed"
+// @Failure 400 {object} map[string]interface{} "Invalid user ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "The user has no quota"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/quotas/users/{id} [delete]
+func (h *APIUsageHandler) DeleteUserQuota(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := h.parseUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.usageService.DeleteUserQuota(ctx, userID); err != nil {
+		respondWithError(c, err, "Failed to remove API quota")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindQuotaRequest binds the quota request body, writing a 400 response if it is invalid
+func (h *APIUsageHandler) bindQuotaRequest(c *gin.Context, req *service.APIQuotaRequest) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// parseUserID parses the user ID path parameter, writing a 400 response if it is invalid
+func (h *APIUsageHandler) parseUserID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid user ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
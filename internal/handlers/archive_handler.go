@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// ArchiveHandler handles HTTP requests for archiving epics and user stories
+type ArchiveHandler struct {
+	archiveService service.ArchiveService
+}
+
+// NewArchiveHandler creates a new archive handler instance
+func NewArchiveHandler(archiveService service.ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{
+		archiveService: archiveService,
+	}
+}
+
+// Archive handles POST /api/v1/{entityType}/:id/archive
+// @Summary Archive an epic or user story
+// @Description Hide a completed epic or user story from default lists and search without deleting it. Children, comments and history are kept, and the entity stays reachable by ID. Use include_archived=true on list and search endpoints to see archived entities. Archiving an archived entity changes nothing.
+// @Tags archive
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} map[string]interface{} "Archived entity"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 423 {object} map[string]interface{} "Entity is locked for editing by another user"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/archive [post]
+// @Router /api/v1/user-stories/{id}/archive [post]
+func (h *ArchiveHandler) Archive(c *gin.Context) {
+	h.setArchived(c, true)
+}
+
+// Unarchive handles POST /api/v1/{entityType}/:id/unarchive
+// @Summary Restore an archived epic or user story
+// @Description Return an archived epic or user story to default lists and search. Unarchiving an entity that is not archived changes nothing.
+// @Tags archive
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} map[string]interface{} "Restored entity"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 423 {object} map[string]interface{} "Entity is locked for editing by another user"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/unarchive [post]
+// @Router /api/v1/user-stories/{id}/unarchive [post]
+func (h *ArchiveHandler) Unarchive(c *gin.Context) {
+	h.setArchived(c, false)
+}
+
+func (h *ArchiveHandler) setArchived(c *gin.Context, archived bool) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	entity, err := h.archiveService.SetArchived(ctx, entityType, c.Param("id"), archived, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to update archival state")
+		return
+	}
+
+	c.JSON(http.StatusOK, entity)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// AssigneeSuggestionHandler handles HTTP requests for assignee suggestions
+type AssigneeSuggestionHandler struct {
+	suggestionService service.AssigneeSuggestionService
+}
+
+// NewAssigneeSuggestionHandler creates a new assignee suggestion handler instance
+func NewAssigneeSuggestionHandler(suggestionService service.AssigneeSuggestionService) *AssigneeSuggestionHandler {
+	return &AssigneeSuggestionHandler{
+		suggestionService: suggestionService,
+	}
+}
+
+// SuggestAssignees handles GET /api/v1/{entityType}/:id/assignee-suggestions
+// @Summary Suggest assignees for an entity
+// @Description Rank the active users who can edit the entity as its assignee, best fit first, for triage. The score starts at 40, adds 10 for the User role, 5 per change the user made in the same epic in the last 90 days up to 50, and subtracts 5 per open epic, user story or requirement assigned to the user up to 50. Each suggestion lists the reasons behind its score. Commenters are never suggested.
+// @Tags assignment
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param limit query int false "Maximum number of suggestions to return (default 5, max 20)"
+// @Success 200 {object} service.AssigneeSuggestions "Ranked assignee suggestions"
+// @Failure 400 {object} ErrorResponse "Invalid limit"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Insufficient permissions"
+// @Failure 404 {object} ErrorResponse "Entity not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/assignee-suggestions [get]
+// @Router /api/v1/user-stories/{id}/assignee-suggestions [get]
+// @Router /api/v1/requirements/{id}/assignee-suggestions [get]
+func (h *AssigneeSuggestionHandler) SuggestAssignees(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var limit int
+	if raw := c.Query("limit"); raw != "" {
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 1 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid limit parameter",
+			}})
+			return
+		}
+		limit = value
+	}
+
+	suggestions, err := h.suggestionService.SuggestAssignees(ctx, entityType, c.Param("id"), limit)
+	if err != nil {
+		respondWithError(c, err, "Failed to suggest assignees")
+		return
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
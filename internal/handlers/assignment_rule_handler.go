@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// AssignmentRuleListResponse represents the response for listing assignment rules
+type AssignmentRuleListResponse = ListResponse[models.AssignmentRule]
+
+// AssignmentRuleHandler handles HTTP requests for managing assignment rules
+type AssignmentRuleHandler struct {
+	ruleService service.AssignmentRuleService
+}
+
+// NewAssignmentRuleHandler creates a new assignment rule handler instance
+func NewAssignmentRuleHandler(ruleService service.AssignmentRuleService) *AssignmentRuleHandler {
+	return &AssignmentRuleHandler{
+		ruleService: ruleService,
+	}
+}
+
+// CreateRule handles POST /api/v1/admin/assignment-rules
+// @Summary Create an assignment rule
+// @Description Add a rule that assigns new epics, user stories or requirements created without an assignee. Rules are evaluated by ascending priority when an entity is created; the first enabled rule whose conditions all match assigns it, and without a match the creator is the assignee. Conditions are the epic (user stories and requirements), the role of the creating user and the requirement type (requirements).
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param rule body service.AssignmentRuleRequest true "Assignment rule"
+// @Success 201 {object} models.AssignmentRule "Created assignment rule"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or conditions"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Assignee, epic or requirement type not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/assignment-rules [post]
+func (h *AssignmentRuleHandler) CreateRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.AssignmentRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	rule, err := h.ruleService.CreateRule(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to create assignment rule")
+		return
+	}
+
+	c.JSON(http.StatusCreated, rule)
+}
+
+// ListRules handles GET /api/v1/admin/assignment-rules
+// @Summary List assignment rules
+// @Description Retrieve the assignment rules in evaluation order: by ascending priority, then by creation time
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type query string false "Only rules for this entity type" Enums(epic, user_story, requirement)
+// @Success 200 {object} AssignmentRuleListResponse "Assignment rules"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/assignment-rules [get]
+func (h *AssignmentRuleHandler) ListRules(c *gin.Context) {
+	ctx := c.Request.Context()
+	var entityType *models.EntityType
+	if value := c.Query("entity_type"); value != "" {
+		filter := models.EntityType(value)
+		entityType = &filter
+	}
+
+	rules, err := h.ruleService.ListRules(ctx, entityType)
+	if err != nil {
+		respondWithError(c, err, "Failed to list assignment rules")
+		return
+	}
+
+	SendListResponse(c, rules, int64(len(rules)), len(rules), 0)
+}
+
+// GetRule handles GET /api/v1/admin/assignment-rules/:id
+// @Summary Get an assignment rule
+// @Description Retrieve an assignment rule by its UUID
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Assignment rule UUID" format(uuid)
+// @Success 200 {object} models.AssignmentRule "Assignment rule"
+// @Failure 400 {object} map[string]interface{} "Invalid rule ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Rule not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/assignment-rules/{id} [get]
+func (h *AssignmentRuleHandler) GetRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parseRuleID(c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.ruleService.GetRule(ctx, id)
+	if err != nil {
+		respondWithError(c, err, "Failed to get assignment rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// UpdateRule handles PUT /api/v1/admin/assignment-rules/:id
+// @Summary Replace an assignment rule
+// @Description Replace the name, priority, conditions and assignee of an assignment rule; omitted conditions are removed
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Assignment rule UUID" format(uuid)
+// @Param rule body service.AssignmentRuleRequest true "Assignment rule"
+// @Success 200 {object} models.AssignmentRule "Updated assignment rule"
+// @Failure 400 {object} map[string]interface{} "Invalid rule ID, request body or conditions"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Rule, assignee, epic or requirement type not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/assignment-rules/{id} [put]
+func (h *AssignmentRuleHandler) UpdateRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parseRuleID(c)
+	if !ok {
+		return
+	}
+
+	var req service.AssignmentRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	rule, err := h.ruleService.UpdateRule(ctx, id, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to update assignment rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// DeleteRule handles DELETE /api/v1/admin/assignment-rules/:id
+// @Summary Delete an assignment rule
+// @Description Remove an assignment rule
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Assignment rule UUID" format(uuid)
+// @Success 204 "Rule deleted"
+// @Failure 400 {object} map[string]interface{} "Invalid rule ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Rule not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/assignment-rules/{id} [delete]
+func (h *AssignmentRuleHandler) DeleteRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parseRuleID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.ruleService.DeleteRule(ctx, id); err != nil {
+		respondWithError(c, err, "Failed to delete assignment rule")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// EvaluateRules handles POST /api/v1/admin/assignment-rules/evaluate
+// @Summary Dry-run the assignment rules
+// @Description Evaluate the assignment rules for an entity that would be created, without creating it. Returns the assignee it would get, the matching rule and the decision of every rule of its entity type in evaluation order.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity body service.EvaluateAssignmentRulesRequest true "Entity to evaluate"
+// @Success 200 {object} service.AssignmentRuleEvaluation "Evaluation result"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Creator, epic or user story not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/assignment-rules/evaluate [post]
+func (h *AssignmentRuleHandler) EvaluateRules(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req service.EvaluateAssignmentRulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	evaluation, err := h.ruleService.Evaluate(ctx, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to evaluate assignment rules")
+		return
+	}
+
+	c.JSON(http.StatusOK, evaluation)
+}
+
+// parseRuleID parses the rule ID path parameter, writing a 400 response if it is invalid
+func (h *AssignmentRuleHandler) parseRuleID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid assignment rule ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+	"product-requirements-management/internal/validation"
+)
+
+// boardEntityTypes maps board path segments to entity types
+var boardEntityTypes = map[string]models.EntityType{
+	"epics":        models.EntityTypeEpic,
+	"user-stories": models.EntityTypeUserStory,
+	"requirements": models.EntityTypeRequirement,
+}
+
+// BoardHandler handles HTTP requests for Kanban boards
+type BoardHandler struct {
+	boardService service.BoardService
+}
+
+// NewBoardHandler creates a new board handler instance
+func NewBoardHandler(boardService service.BoardService) *BoardHandler {
+	return &BoardHandler{
+		boardService: boardService,
+	}
+}
+
+// GetBoard handles GET /api/v1/boards/:entityType
+// @Summary Get a Kanban board
+// @Description Retrieve epics, user stories or requirements grouped into board columns with WIP counts. With group_by=status (default) the columns follow the default status model of the entity type in status order; cards with a status outside the model get their own column. Cards are ordered by their board rank, then by priority and age.
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entityType path string true "Entity type" Enums(epics, user-stories, requirements)
+// @Param group_by query string false "Column grouping" Enums(status, priority, assignee) default(status)
+// @Param epic_id query string false "Only user stories or requirements of this epic" format(uuid)
+// @Param user_story_id query string false "Only requirements of this user story" format(uuid)
+// @Param assignee_id query string false "Only cards assigned to this user" format(uuid)
+// @Success 200 {object} service.Board "Board columns with cards"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type, grouping or filter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/boards/{entityType} [get]
+func (h *BoardHandler) GetBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, ok := boardEntityTypes[c.Param("entityType")]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Boards are available for epics, user-stories and requirements",
+			},
+		})
+		return
+	}
+
+	query := service.BoardQuery{GroupBy: c.Query("group_by")}
+	for param, target := range map[string]**uuid.UUID{
+		"epic_id":       &query.EpicID,
+		"user_story_id": &query.UserStoryID,
+		"assignee_id":   &query.AssigneeID,
+	} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid " + param + " format",
+				},
+			})
+			return
+		}
+		*target = &id
+	}
+
+	board, err := h.boardService.GetBoard(ctx, entityType, query)
+	if err != nil {
+		h.handleError(c, err, "Failed to get board")
+		return
+	}
+
+	c.JSON(http.StatusOK, board)
+}
+
+// MoveCard handles POST /api/v1/boards/move
+// @Summary Move a board card
+// @Description Change the status of a card and its position in the target column in a single call. The card is placed before before_id, or at the bottom of the column when before_id is omitted. Omit status to reorder a card within its column. Status changes follow the same transition rules as the status endpoints.
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param move body service.MoveBoardCardRequest true "Card move"
+// @Success 200 {object} service.BoardCard "Moved card"
+// @Failure 400 {object} map[string]interface{} "Invalid request, status, transition or before_id"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/boards/move [post]
+func (h *BoardHandler) MoveCard(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req service.MoveBoardCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	card, err := h.boardService.MoveCard(ctx, req)
+	if err != nil {
+		h.handleError(c, err, "Failed to move card")
+		return
+	}
+
+	c.JSON(http.StatusOK, card)
+}
+
+// handleError maps board service errors to HTTP responses
+func (h *BoardHandler) handleError(c *gin.Context, err error, fallbackMessage string) {
+	if statusErr, ok := validation.GetStatusValidationError(err); ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": statusErr.Message,
+			},
+		})
+		return
+	}
+
+	respondWithError(c, err, fallbackMessage)
+}
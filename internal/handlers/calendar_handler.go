@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/service"
+)
+
+// calendarContentType is the media type of iCal feeds
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// CalendarHandler handles HTTP requests for iCal feeds of due dates and milestones
+type CalendarHandler struct {
+	calendarService service.CalendarService
+}
+
+// NewCalendarHandler creates a new calendar handler instance
+func NewCalendarHandler(calendarService service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{
+		calendarService: calendarService,
+	}
+}
+
+// GetMyCalendar handles GET /api/v1/users/me/calendar.ics
+// @Summary Get the current user's calendar
+// @Description Retrieve an iCal feed with the due dates of the epics (as milestones) and user stories assigned to the current user. Calendar clients that cannot send a bearer token should subscribe to a feed URL created with POST /api/v1/users/me/calendar-feeds.
+// @Tags calendar
+// @Accept json
+// @Produce text/calendar
+// @Security BearerAuth
+// @Success 200 {string} string "iCalendar document"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/calendar.ics [get]
+func (h *CalendarHandler) GetMyCalendar(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	calendar, err := h.calendarService.UserCalendar(ctx, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get calendar")
+		return
+	}
+
+	writeCalendar(c, "my-calendar.ics", calendar)
+}
+
+// GetEpicCalendar handles GET /api/v1/epics/:id/calendar.ics
+// @Summary Get an epic's calendar
+// @Description Retrieve an iCal feed with the epic's due date as a milestone and the due dates of its user stories
+// @Tags calendar
+// @Accept json
+// @Produce text/calendar
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Success 200 {string} string "iCalendar document"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/calendar.ics [get]
+func (h *CalendarHandler) GetEpicCalendar(c *gin.Context) {
+	ctx := c.Request.Context()
+	calendar, err := h.calendarService.EpicCalendar(ctx, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to get epic calendar")
+		return
+	}
+
+	writeCalendar(c, c.Param("id")+".ics", calendar)
+}
+
+// GetFeedCalendar handles GET /api/v1/calendar/:token
+// @Summary Get a calendar by feed URL
+// @Description Retrieve the iCal feed behind a feed URL created with POST /api/v1/users/me/calendar-feeds. The token in the URL authenticates the request, so calendar clients can subscribe without signing in. Revoked feeds return 404.
+// @Tags calendar
+// @Accept json
+// @Produce text/calendar
+// @Param token path string true "Feed token, optionally followed by .ics"
+// @Success 200 {string} string "iCalendar document"
+// @Failure 404 {object} map[string]interface{} "Unknown or revoked feed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/calendar/{token} [get]
+func (h *CalendarHandler) GetFeedCalendar(c *gin.Context) {
+	ctx := c.Request.Context()
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	calendar, err := h.calendarService.FeedCalendar(ctx, token)
+	if err != nil {
+		respondWithError(c, err, "Failed to get calendar")
+		return
+	}
+
+	writeCalendar(c, "calendar.ics", calendar)
+}
+
+// CreateFeed handles POST /api/v1/users/me/calendar-feeds
+// @Summary Create a calendar feed URL
+// @Description Create a secret feed URL for subscribing to the current user's calendar, or to an epic's calendar when epic_id is set, from Outlook, Google Calendar and other clients. The URL is only returned once; revoke it with DELETE when it leaks.
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param feed body service.CreateCalendarFeedRequest false "Feed scope"
+// @Success 201 {object} service.CalendarFeedCreated "Created feed with its URL"
+// @Failure 400 {object} map[string]interface{} "Invalid request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/calendar-feeds [post]
+func (h *CalendarHandler) CreateFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateCalendarFeedRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid request body: " + err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	feed, err := h.calendarService.CreateFeed(ctx, userID, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to create calendar feed")
+		return
+	}
+
+	c.JSON(http.StatusCreated, feed)
+}
+
+// ListFeeds handles GET /api/v1/users/me/calendar-feeds
+// @Summary List calendar feed URLs
+// @Description List the current user's calendar feeds. Feed URLs are not included; they are only shown when a feed is created.
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Calendar feeds"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/calendar-feeds [get]
+func (h *CalendarHandler) ListFeeds(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	feeds, err := h.calendarService.ListFeeds(ctx, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to list calendar feeds")
+		return
+	}
+
+	SendListResponse(c, feeds, int64(len(feeds)), len(feeds), 0)
+}
+
+// RevokeFeed handles DELETE /api/v1/users/me/calendar-feeds/:id
+// @Summary Revoke a calendar feed URL
+// @Description Revoke one of the current user's calendar feeds; calendar clients subscribed to its URL stop receiving updates
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Calendar feed ID"
+// @Success 204 "Feed revoked"
+// @Failure 400 {object} map[string]interface{} "Invalid feed ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Feed not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/calendar-feeds/{id} [delete]
+func (h *CalendarHandler) RevokeFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	feedID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid calendar feed ID format",
+			},
+		})
+		return
+	}
+
+	if err := h.calendarService.RevokeFeed(ctx, userID, feedID); err != nil {
+		respondWithError(c, err, "Failed to revoke calendar feed")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// writeCalendar writes an iCalendar document response
+func writeCalendar(c *gin.Context, filename string, calendar []byte) {
+	c.Header("Content-Disposition", `inline; filename="`+filename+`"`)
+	c.Data(http.StatusOK, calendarContentType, calendar)
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/service"
+)
+
+// atomContentType is the media type of Atom feeds
+const atomContentType = "application/atom+xml; charset=utf-8"
+
+// ChangeFeedHandler handles HTTP requests for Atom feeds of recent changes
+type ChangeFeedHandler struct {
+	changeFeedService service.ChangeFeedService
+}
+
+// NewChangeFeedHandler creates a new change feed handler instance
+func NewChangeFeedHandler(changeFeedService service.ChangeFeedService) *ChangeFeedHandler {
+	return &ChangeFeedHandler{
+		changeFeedService: changeFeedService,
+	}
+}
+
+// GetWorkspaceFeed handles GET /api/v1/changes.atom
+// @Summary Get the workspace change feed
+// @Description Retrieve an Atom feed of the most recent changes across all epics, user stories, acceptance criteria and requirements: created entities, edits, status changes, assignments, relationships and new comments. Feed readers that cannot send a bearer token should use a feed URL created with POST /api/v1/users/me/change-feeds.
+// @Tags feeds
+// @Accept json
+// @Produce application/atom+xml
+// @Security BearerAuth
+// @Param limit query int false "Maximum number of entries (default 50, max 200)"
+// @Success 200 {string} string "Atom feed document"
+// @Failure 400 {object} map[string]interface{} "Invalid limit"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/changes.atom [get]
+func (h *ChangeFeedHandler) GetWorkspaceFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	limit, ok := parseActivityLimit(c)
+	if !ok {
+		return
+	}
+
+	feed, err := h.changeFeedService.WorkspaceFeed(ctx, limit)
+	if err != nil {
+		respondWithError(c, err, "Failed to get change feed")
+		return
+	}
+
+	c.Data(http.StatusOK, atomContentType, feed)
+}
+
+// GetEpicFeed handles GET /api/v1/epics/:id/changes.atom
+// @Summary Get an epic's change feed
+// @Description Retrieve an Atom feed of the most recent changes to an epic and to the user stories, acceptance criteria and requirements below it
+// @Tags feeds
+// @Accept json
+// @Produce application/atom+xml
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Param limit query int false "Maximum number of entries (default 50, max 200)"
+// @Success 200 {string} string "Atom feed document"
+// @Failure 400 {object} map[string]interface{} "Invalid limit"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/changes.atom [get]
+func (h *ChangeFeedHandler) GetEpicFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	limit, ok := parseActivityLimit(c)
+	if !ok {
+		return
+	}
+
+	feed, err := h.changeFeedService.EpicFeed(ctx, c.Param("id"), limit)
+	if err != nil {
+		respondWithError(c, err, "Failed to get epic change feed")
+		return
+	}
+
+	c.Data(http.StatusOK, atomContentType, feed)
+}
+
+// GetTokenFeed handles GET /api/v1/feeds/:token
+// @Summary Get a change feed by feed URL
+// @Description Retrieve the Atom feed behind a feed URL created with POST /api/v1/users/me/change-feeds. The token in the URL authenticates the request, so feed readers and other tools can poll it without signing in. Revoked feeds return 404.
+// @Tags feeds
+// @Accept json
+// @Produce application/atom+xml
+// @Param token path string true "Feed token, optionally followed by .atom"
+// @Param limit query int false "Maximum number of entries (default 50, max 200)"
+// @Success 200 {string} string "Atom feed document"
+// @Failure 400 {object} map[string]interface{} "Invalid limit"
+// @Failure 404 {object} map[string]interface{} "Unknown or revoked feed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/feeds/{token} [get]
+func (h *ChangeFeedHandler) GetTokenFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	limit, ok := parseActivityLimit(c)
+	if !ok {
+		return
+	}
+
+	feed, err := h.changeFeedService.TokenFeed(ctx, strings.TrimSuffix(c.Param("token"), ".atom"), limit)
+	if err != nil {
+		respondWithError(c, err, "Failed to get change feed")
+		return
+	}
+
+	c.Data(http.StatusOK, atomContentType, feed)
+}
+
+// CreateFeed handles POST /api/v1/users/me/change-feeds
+// @Summary Create a change feed URL
+// @Description Create a secret Atom feed URL for changes across the workspace, or to an epic when epic_id is set. The URL is only returned once; revoke it with DELETE when it leaks.
+// @Tags feeds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param feed body service.CreateChangeFeedRequest false "Feed scope"
+// @Success 201 {object} service.ChangeFeedCreated "Created feed with its URL"
+// @Failure 400 {object} map[string]interface{} "Invalid request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/change-feeds [post]
+func (h *ChangeFeedHandler) CreateFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateChangeFeedRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid request body: " + err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	feed, err := h.changeFeedService.CreateFeed(ctx, userID, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to create change feed")
+		return
+	}
+
+	c.JSON(http.StatusCreated, feed)
+}
+
+// ListFeeds handles GET /api/v1/users/me/change-feeds
+// @Summary List change feed URLs
+// @Description List the current user's change feeds. Feed URLs are not included; they are only shown when a feed is created.
+// @Tags feeds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Change feeds"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/change-feeds [get]
+func (h *ChangeFeedHandler) ListFeeds(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	feeds, err := h.changeFeedService.ListFeeds(ctx, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to list change feeds")
+		return
+	}
+
+	SendListResponse(c, feeds, int64(len(feeds)), len(feeds), 0)
+}
+
+// RevokeFeed handles DELETE /api/v1/users/me/change-feeds/:id
+// @Summary Revoke a change feed URL
+// @Description Revoke one of the current user's change feeds; its URL stops working immediately
+// @Tags feeds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Change feed ID"
+// @Success 204 "Feed revoked"
+// @Failure 400 {object} map[string]interface{} "Invalid feed ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Feed not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/change-feeds/{id} [delete]
+func (h *ChangeFeedHandler) RevokeFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	feedID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid change feed ID format",
+			},
+		})
+		return
+	}
+
+	if err := h.changeFeedService.RevokeFeed(ctx, userID, feedID); err != nil {
+		respondWithError(c, err, "Failed to revoke change feed")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// ChangeProposalHandler handles HTTP requests for suggested entity edits
+type ChangeProposalHandler struct {
+	proposalService service.ChangeProposalService
+}
+
+// NewChangeProposalHandler creates a new change proposal handler instance
+func NewChangeProposalHandler(proposalService service.ChangeProposalService) *ChangeProposalHandler {
+	return &ChangeProposalHandler{
+		proposalService: proposalService,
+	}
+}
+
+// CreateProposal handles POST /api/v1/{entityType}/:id/proposals
+// @Summary Propose a change to an entity
+// @Description Suggest a new title, description or priority instead of editing the entity directly. The proposal waits in the entity's queue until an editor accepts or rejects it. Acceptance criteria proposals only support a description.
+// @Tags proposals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param proposal body service.CreateChangeProposalRequest true "Proposed change"
+// @Success 201 {object} models.ChangeProposal "Change proposed"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or no change proposed"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/proposals [post]
+// @Router /api/v1/user-stories/{id}/proposals [post]
+// @Router /api/v1/acceptance-criteria/{id}/proposals [post]
+// @Router /api/v1/requirements/{id}/proposals [post]
+func (h *ChangeProposalHandler) CreateProposal(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateChangeProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	proposal, err := h.proposalService.CreateProposal(ctx, entityType, c.Param("id"), userID, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to create change proposal")
+		return
+	}
+
+	c.JSON(http.StatusCreated, proposal)
+}
+
+// ListProposals handles GET /api/v1/{entityType}/:id/proposals
+// @Summary List the change proposals of an entity
+// @Description Retrieve the review queue of an entity, oldest first, with proposers and reviewers. Use status=pending for the open proposals.
+// @Tags proposals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param status query string false "Filter by status" Enums(pending, accepted, rejected, withdrawn)
+// @Success 200 {object} map[string]interface{} "Change proposals of the entity"
+// @Failure 400 {object} map[string]interface{} "Invalid status"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/proposals [get]
+// @Router /api/v1/user-stories/{id}/proposals [get]
+// @Router /api/v1/acceptance-criteria/{id}/proposals [get]
+// @Router /api/v1/requirements/{id}/proposals [get]
+func (h *ChangeProposalHandler) ListProposals(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var status *models.ChangeProposalStatus
+	if statusParam := c.Query("status"); statusParam != "" {
+		value := models.ChangeProposalStatus(statusParam)
+		status = &value
+	}
+
+	proposals, err := h.proposalService.ListProposals(ctx, entityType, c.Param("id"), status)
+	if err != nil {
+		respondWithError(c, err, "Failed to list change proposals")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"proposals": proposals,
+		"count":     len(proposals),
+	})
+}
+
+// AcceptProposal handles POST /api/v1/{entityType}/:id/proposals/:proposal_id/accept
+// @Summary Accept a change proposal
+// @Description Apply a pending proposal to the entity. The proposer is credited in the entity's activity. Fails with 409 if the entity was modified after the change was proposed unless force=true is given.
+// @Tags proposals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param proposal_id path string true "Change proposal UUID" format(uuid)
+// @Param force query bool false "Apply even if the entity changed after the proposal was made"
+// @Success 200 {object} service.AcceptChangeProposalResponse "Accepted proposal and updated entity"
+// @Failure 400 {object} map[string]interface{} "Invalid proposal ID or force parameter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or proposal not found"
+// @Failure 409 {object} map[string]interface{} "Proposal already closed or entity modified since the proposal"
+// @Failure 423 {object} map[string]interface{} "Entity is locked by another user"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/proposals/{proposal_id}/accept [post]
+// @Router /api/v1/user-stories/{id}/proposals/{proposal_id}/accept [post]
+// @Router /api/v1/acceptance-criteria/{id}/proposals/{proposal_id}/accept [post]
+// @Router /api/v1/requirements/{id}/proposals/{proposal_id}/accept [post]
+func (h *ChangeProposalHandler) AcceptProposal(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+	proposalID, ok := parseProposalID(c)
+	if !ok {
+		return
+	}
+
+	force := false
+	if forceParam := c.Query("force"); forceParam != "" {
+		parsed, err := strconv.ParseBool(forceParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid force parameter",
+				},
+			})
+			return
+		}
+		force = parsed
+	}
+
+	result, err := h.proposalService.AcceptProposal(ctx, entityType, c.Param("id"), proposalID, userID, force)
+	if err != nil {
+		respondWithError(c, err, "Failed to accept change proposal")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RejectProposal handles POST /api/v1/{entityType}/:id/proposals/:proposal_id/reject
+// @Summary Reject a change proposal
+// @Description Close a pending proposal without applying it. The reason is shown to the proposer.
+// @Tags proposals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param proposal_id path string true "Change proposal UUID" format(uuid)
+// @Param rejection body service.RejectChangeProposalRequest true "Rejection reason"
+// @Success 200 {object} models.ChangeProposal "Rejected proposal"
+// @Failure 400 {object} map[string]interface{} "Invalid proposal ID or missing reason"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or proposal not found"
+// @Failure 409 {object} map[string]interface{} "Proposal already closed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/proposals/{proposal_id}/reject [post]
+// @Router /api/v1/user-stories/{id}/proposals/{proposal_id}/reject [post]
+// @Router /api/v1/acceptance-criteria/{id}/proposals/{proposal_id}/reject [post]
+// @Router /api/v1/requirements/{id}/proposals/{proposal_id}/reject [post]
+func (h *ChangeProposalHandler) RejectProposal(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+	proposalID, ok := parseProposalID(c)
+	if !ok {
+		return
+	}
+
+	var req service.RejectChangeProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	proposal, err := h.proposalService.RejectProposal(ctx, entityType, c.Param("id"), proposalID, userID, req.Reason)
+	if err != nil {
+		respondWithError(c, err, "Failed to reject change proposal")
+		return
+	}
+
+	c.JSON(http.StatusOK, proposal)
+}
+
+// WithdrawProposal handles DELETE /api/v1/{entityType}/:id/proposals/:proposal_id
+// @Summary Withdraw a change proposal
+// @Description Close the current user's own pending proposal. The proposal stays in the queue with the status withdrawn.
+// @Tags proposals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param proposal_id path string true "Change proposal UUID" format(uuid)
+// @Success 200 {object} models.ChangeProposal "Withdrawn proposal"
+// @Failure 400 {object} map[string]interface{} "Invalid proposal ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Proposal made by another user"
+// @Failure 404 {object} map[string]interface{} "Entity or proposal not found"
+// @Failure 409 {object} map[string]interface{} "Proposal already closed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/proposals/{proposal_id} [delete]
+// @Router /api/v1/user-stories/{id}/proposals/{proposal_id} [delete]
+// @Router /api/v1/acceptance-criteria/{id}/proposals/{proposal_id} [delete]
+// @Router /api/v1/requirements/{id}/proposals/{proposal_id} [delete]
+func (h *ChangeProposalHandler) WithdrawProposal(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+	proposalID, ok := parseProposalID(c)
+	if !ok {
+		return
+	}
+
+	proposal, err := h.proposalService.WithdrawProposal(ctx, entityType, c.Param("id"), proposalID, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to withdraw change proposal")
+		return
+	}
+
+	c.JSON(http.StatusOK, proposal)
+}
+
+// parseProposalID parses the proposal ID route parameter, writing a 400 response if it is invalid
+func parseProposalID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("proposal_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid change proposal ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// CommentDraftListResponse represents the response for listing the comment drafts of the current user
+type CommentDraftListResponse = ListResponse[models.CommentDraft]
+
+// CommentDraftHandler handles HTTP requests for autosaved comment drafts
+type CommentDraftHandler struct {
+	commentDraftService service.CommentDraftService
+}
+
+// NewCommentDraftHandler creates a new comment draft handler instance
+func NewCommentDraftHandler(commentDraftService service.CommentDraftService) *CommentDraftHandler {
+	return &CommentDraftHandler{
+		commentDraftService: commentDraftService,
+	}
+}
+
+// SaveDraft handles PUT /api/v1/{entityType}/:id/comments/draft
+// @Summary Autosave a comment draft
+// @Description Save the current user's private draft of a new comment on an entity, or of a reply when parent_comment_id is set. Each user has one draft per entity and one per comment thread; saving replaces it and extends its expiry. Drafts are never shown to other users and are not removed when the comment is posted, so clients should discard them.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param draft body service.SaveCommentDraftRequest true "Draft content"
+// @Success 200 {object} models.CommentDraft "Saved draft"
+// @Failure 400 {object} ErrorResponse "Invalid request body or parent comment on another entity"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Entity or parent comment not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/comments/draft [put]
+// @Router /api/v1/user-stories/{id}/comments/draft [put]
+// @Router /api/v1/acceptance-criteria/{id}/comments/draft [put]
+// @Router /api/v1/requirements/{id}/comments/draft [put]
+func (h *CommentDraftHandler) SaveDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.SaveCommentDraftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	draft, err := h.commentDraftService.SaveDraft(ctx, entityType, c.Param("id"), userID, req, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to save comment draft")
+		return
+	}
+
+	c.JSON(http.StatusOK, draft)
+}
+
+// GetDraft handles GET /api/v1/{entityType}/:id/comments/draft
+// @Summary Get a comment draft
+// @Description Retrieve the current user's unexpired draft of a new comment on an entity, or of a reply when parent_comment_id is set.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param parent_comment_id query string false "Comment the draft replies to" format(uuid)
+// @Success 200 {object} models.CommentDraft "Comment draft"
+// @Failure 400 {object} ErrorResponse "Invalid parent comment ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Entity or draft not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/comments/draft [get]
+// @Router /api/v1/user-stories/{id}/comments/draft [get]
+// @Router /api/v1/acceptance-criteria/{id}/comments/draft [get]
+// @Router /api/v1/requirements/{id}/comments/draft [get]
+func (h *CommentDraftHandler) GetDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+	parentCommentID, ok := parseParentCommentQuery(c)
+	if !ok {
+		return
+	}
+
+	draft, err := h.commentDraftService.GetDraft(ctx, entityType, c.Param("id"), userID, parentCommentID, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to get comment draft")
+		return
+	}
+
+	c.JSON(http.StatusOK, draft)
+}
+
+// DiscardDraft handles DELETE /api/v1/{entityType}/:id/comments/draft
+// @Summary Discard a comment draft
+// @Description Delete the current user's draft of a new comment on an entity, or of a reply when parent_comment_id is set, e.g. once the comment is posted.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param parent_comment_id query string false "Comment the draft replies to" format(uuid)
+// @Success 204 "Draft discarded"
+// @Failure 400 {object} ErrorResponse "Invalid parent comment ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Entity or draft not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/comments/draft [delete]
+// @Router /api/v1/user-stories/{id}/comments/draft [delete]
+// @Router /api/v1/acceptance-criteria/{id}/comments/draft [delete]
+// @Router /api/v1/requirements/{id}/comments/draft [delete]
+func (h *CommentDraftHandler) DiscardDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+	parentCommentID, ok := parseParentCommentQuery(c)
+	if !ok {
+		return
+	}
+
+	if err := h.commentDraftService.DiscardDraft(ctx, entityType, c.Param("id"), userID, parentCommentID); err != nil {
+		respondWithError(c, err, "Failed to discard comment draft")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMyDrafts handles GET /api/v1/users/me/comment-drafts
+// @Summary List the current user's comment drafts
+// @Description Retrieve the unexpired comment drafts of the current user across all entities, most recently saved first.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} CommentDraftListResponse "Comment drafts of the current user"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/users/me/comment-drafts [get]
+func (h *CommentDraftHandler) ListMyDrafts(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	drafts, err := h.commentDraftService.ListUserDrafts(ctx, userID, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to list comment drafts")
+		return
+	}
+
+	SendListResponse(c, drafts, int64(len(drafts)), len(drafts), 0)
+}
+
+// parseParentCommentQuery reads the optional parent_comment_id query parameter selecting a reply draft
+func parseParentCommentQuery(c *gin.Context) (*uuid.UUID, bool) {
+	value := c.Query("parent_comment_id")
+	if value == "" {
+		return nil, true
+	}
+	parentCommentID, err := uuid.Parse(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid parent comment ID format",
+			},
+		})
+		return nil, false
+	}
+	return &parentCommentID, true
+}
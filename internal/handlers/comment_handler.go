@@ -3,6 +3,7 @@ package handlers
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -40,12 +41,15 @@ func NewCommentHandler(commentService service.CommentService) *CommentHandler {
 // @Failure 400 {object} map[string]string "Invalid request - malformed entity ID, invalid entity type, missing required fields, or invalid inline comment data"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Entity not found or parent comment not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
+// @Failure 423 {object} ErrorResponse "Comment thread is locked; the message gives the reason"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/epics/{id}/comments [post]
 // @Router /api/v1/user-stories/{id}/comments [post]
 // @Router /api/v1/acceptance-criteria/{id}/comments [post]
 // @Router /api/v1/requirements/{id}/comments [post]
 func (h *CommentHandler) CreateComment(c *gin.Context) {
+	ctx := c.Request.Context()
 	entityIDParam := c.Param("id")
 
 	// Determine entity type from the route path
@@ -99,7 +103,7 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 	req.EntityID = entityID
 	req.AuthorID = uuid.MustParse(authorID)
 
-	comment, err := h.commentService.CreateComment(req)
+	comment, err := h.commentService.CreateComment(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentInvalidEntityType):
@@ -126,6 +130,10 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Content cannot be empty",
 			})
+		case errors.Is(err, service.ErrInvalidCommentCategory):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid category. Use 'question', 'blocker' or 'suggestion'",
+			})
 		case errors.Is(err, service.ErrInvalidInlineCommentData):
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Inline comments require linked_text, text_position_start, and text_position_end",
@@ -138,6 +146,9 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Linked text cannot be empty for inline comments",
 			})
+		case errors.Is(err, service.ErrCommentBlocked), errors.Is(err, service.ErrCommentThreadLocked):
+			// The message names the moderation record the author can appeal or why the thread is locked
+			respondWithError(c, err, "Failed to create comment")
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to create comment",
@@ -153,6 +164,7 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 // @Summary Get all comments for an entity
 // @Description Retrieve all comments for a specific entity with optional filtering by status and threading. Supports both flat and threaded comment structures.
 // @Tags comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param entityType path string true "Entity type" Enums(epic,user_story,acceptance_criteria,requirement)
@@ -160,13 +172,15 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 // @Param threaded query boolean false "Return comments in threaded structure"
 // @Param inline query boolean false "Return only inline comments"
 // @Param status query string false "Filter by resolution status" Enums(resolved,unresolved)
-// @Success 200 {object} map[string]interface{} "Successfully retrieved comments" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
+// @Param category query string false "Filter by comment category" Enums(question,blocker,suggestion)
+// @Success 200 {object} map[string]interface{} "Successfully retrieved comments with per-category counts" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false, "category": "question"}], "count": 1, "category_counts": {"question": 1}})
 // @Failure 400 {object} map[string]string "Invalid entity type or malformed entity ID"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Entity not found"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/{entityType}/{id}/comments [get]
 func (h *CommentHandler) GetCommentsByEntity(c *gin.Context) {
+	ctx := c.Request.Context()
 	entityTypeParam := c.Param("entityType")
 	entityIDParam := c.Param("id")
 
@@ -195,11 +209,11 @@ func (h *CommentHandler) GetCommentsByEntity(c *gin.Context) {
 
 	if inlineOnly {
 		// Use visible inline comments to exclude hidden ones
-		comments, err = h.commentService.GetVisibleInlineComments(entityType, entityID)
+		comments, err = h.commentService.GetVisibleInlineComments(ctx, entityType, entityID)
 	} else if threaded {
-		comments, err = h.commentService.GetThreadedComments(entityType, entityID)
+		comments, err = h.commentService.GetThreadedComments(ctx, entityType, entityID)
 	} else {
-		comments, err = h.commentService.GetCommentsByEntity(entityType, entityID)
+		comments, err = h.commentService.GetCommentsByEntity(ctx, entityType, entityID)
 	}
 
 	if err != nil {
@@ -241,9 +255,30 @@ func (h *CommentHandler) GetCommentsByEntity(c *gin.Context) {
 		comments = filteredComments
 	}
 
+	// Apply category filter if specified
+	if categoryFilter := c.Query("category"); categoryFilter != "" {
+		filteredComments := make([]service.CommentResponse, 0)
+		for _, comment := range comments {
+			if comment.Category != nil && string(*comment.Category) == categoryFilter {
+				filteredComments = append(filteredComments, comment)
+			}
+		}
+		comments = filteredComments
+	}
+
+	// Count comments per category so blocking concerns stay visible in general discussion
+	categoryCounts, err := h.commentService.GetCategoryCounts(ctx, entityType, entityID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get comments",
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"comments": comments,
-		"count":    len(comments),
+		"comments":        comments,
+		"count":           len(comments),
+		"category_counts": categoryCounts,
 	})
 }
 
@@ -251,6 +286,7 @@ func (h *CommentHandler) GetCommentsByEntity(c *gin.Context) {
 // @Summary Get a specific comment by ID
 // @Description Retrieve a single comment by its unique identifier, including author information and thread context.
 // @Tags comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Comment ID" format(uuid)
@@ -261,6 +297,7 @@ func (h *CommentHandler) GetCommentsByEntity(c *gin.Context) {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/{id} [get]
 func (h *CommentHandler) GetComment(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -271,7 +308,7 @@ func (h *CommentHandler) GetComment(c *gin.Context) {
 		return
 	}
 
-	comment, err := h.commentService.GetComment(id)
+	comment, err := h.commentService.GetComment(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrCommentNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -301,9 +338,11 @@ func (h *CommentHandler) GetComment(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Invalid comment ID format, invalid request body, or empty content"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Comment not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/{id} [put]
 func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -323,7 +362,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 		return
 	}
 
-	comment, err := h.commentService.UpdateComment(id, req)
+	comment, err := h.commentService.UpdateComment(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentNotFound):
@@ -334,6 +373,13 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Content cannot be empty",
 			})
+		case errors.Is(err, service.ErrInvalidCommentCategory):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid category. Use 'question', 'blocker' or 'suggestion'",
+			})
+		case errors.Is(err, service.ErrCommentBlocked):
+			// The message names the moderation record the author can appeal
+			respondWithError(c, err, "Failed to update comment")
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to update comment",
@@ -349,6 +395,8 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 // @Summary Delete a comment
 // @Description Delete a comment by ID. Comments with replies cannot be deleted to maintain thread integrity.
 // @Tags comments
+// @Accept json
+// @Produce json
 // @Security BearerAuth
 // @Param id path string true "Comment ID" format(uuid)
 // @Success 204 "Successfully deleted comment"
@@ -359,6 +407,7 @@ func (h *CommentHandler) UpdateComment(c *gin.Context) {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/{id} [delete]
 func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -369,7 +418,7 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
 		return
 	}
 
-	err = h.commentService.DeleteComment(id)
+	err = h.commentService.DeleteComment(ctx, id)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentNotFound):
@@ -395,6 +444,7 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
 // @Summary Mark a comment as resolved
 // @Description Mark a comment as resolved to indicate that the issue or question has been addressed.
 // @Tags comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Comment ID" format(uuid)
@@ -405,6 +455,7 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/{id}/resolve [post]
 func (h *CommentHandler) ResolveComment(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -415,7 +466,7 @@ func (h *CommentHandler) ResolveComment(c *gin.Context) {
 		return
 	}
 
-	comment, err := h.commentService.ResolveComment(id)
+	comment, err := h.commentService.ResolveComment(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrCommentNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -436,6 +487,7 @@ func (h *CommentHandler) ResolveComment(c *gin.Context) {
 // @Summary Mark a comment as unresolved
 // @Description Mark a previously resolved comment as unresolved to reopen the discussion or issue.
 // @Tags comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Comment ID" format(uuid)
@@ -446,6 +498,7 @@ func (h *CommentHandler) ResolveComment(c *gin.Context) {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/{id}/unresolve [post]
 func (h *CommentHandler) UnresolveComment(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -456,7 +509,7 @@ func (h *CommentHandler) UnresolveComment(c *gin.Context) {
 		return
 	}
 
-	comment, err := h.commentService.UnresolveComment(id)
+	comment, err := h.commentService.UnresolveComment(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrCommentNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -475,8 +528,10 @@ func (h *CommentHandler) UnresolveComment(c *gin.Context) {
 
 // GetCommentsByStatus handles GET /api/v1/comments/status/:status
 // @Summary Get comments by resolution status
-// @Description Retrieve all comments filtered by their resolution status (resolved or unresolved) across all entities.
+// @Description Retrieve all comments filtered by their resolution status (resolved or unresolved) across all entities. Unpaginated; use GET /api/v1/comments?is_resolved= instead.
 // @Tags comments
+// @Deprecated
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param status path string true "Resolution status" Enums(resolved,unresolved)
@@ -486,6 +541,7 @@ func (h *CommentHandler) UnresolveComment(c *gin.Context) {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/status/{status} [get]
 func (h *CommentHandler) GetCommentsByStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	statusParam := c.Param("status")
 
 	var isResolved bool
@@ -501,7 +557,7 @@ func (h *CommentHandler) GetCommentsByStatus(c *gin.Context) {
 		return
 	}
 
-	comments, err := h.commentService.GetCommentsByStatus(isResolved)
+	comments, err := h.commentService.GetCommentsByStatus(ctx, isResolved)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to get comments by status",
@@ -516,10 +572,139 @@ func (h *CommentHandler) GetCommentsByStatus(c *gin.Context) {
 	})
 }
 
+// ListComments handles GET /api/v1/comments
+// @Summary List comments across all entities
+// @Description Retrieve comments across all entities with optional filtering by category, resolution status, entity type, author and entity assignee, newest first. Serves as a reviewer inbox: is_resolved=false&assignee_of_entity=me lists open comments on everything assigned to the current user, and category=blocker&status=unresolved finds open blocking concerns.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param category query string false "Filter by comment category" Enums(question,blocker,suggestion)
+// @Param status query string false "Filter by resolution status" Enums(resolved,unresolved)
+// @Param is_resolved query boolean false "Filter by resolution status (alternative to status)"
+// @Param entity_type query string false "Filter by entity type" Enums(epic,user_story,acceptance_criteria,requirement)
+// @Param author_id query string false "Filter by author UUID, or 'me' for the current user"
+// @Param assignee_of_entity query string false "Only comments on entities assigned to this user UUID, or 'me' for the current user"
+// @Param limit query int false "Maximum number of comments to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of comments to skip for pagination" minimum(0) default(0)
+// @Success 200 {object} CommentListResponse "Successfully retrieved comments"
+// @Failure 400 {object} map[string]string "Invalid category, status, entity type or user filter"
+// @Failure 401 {object} map[string]string "Authentication required"
+// @Failure 500 {object} map[string]string "Internal server error"
+// @Router /api/v1/comments [get]
+func (h *CommentHandler) ListComments(c *gin.Context) {
+	ctx := c.Request.Context()
+	filters := service.CommentFilters{
+		Limit: 50,
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	if offset := c.Query("offset"); offset != "" {
+		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
+			filters.Offset = o
+		}
+	}
+
+	if categoryParam := c.Query("category"); categoryParam != "" {
+		category := models.CommentCategory(categoryParam)
+		filters.Category = &category
+	}
+
+	if entityTypeParam := c.Query("entity_type"); entityTypeParam != "" {
+		entityType := models.EntityType(entityTypeParam)
+		filters.EntityType = &entityType
+	}
+
+	switch c.Query("status") {
+	case "":
+	case "resolved":
+		isResolved := true
+		filters.IsResolved = &isResolved
+	case "unresolved":
+		isResolved := false
+		filters.IsResolved = &isResolved
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid status. Use 'resolved' or 'unresolved'",
+		})
+		return
+	}
+
+	if isResolvedParam := c.Query("is_resolved"); isResolvedParam != "" {
+		isResolved, err := strconv.ParseBool(isResolvedParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid is_resolved. Use 'true' or 'false'",
+			})
+			return
+		}
+		filters.IsResolved = &isResolved
+	}
+
+	var ok bool
+	if filters.AuthorID, ok = parseUserFilter(c, "author_id"); !ok {
+		return
+	}
+	if filters.EntityAssigneeID, ok = parseUserFilter(c, "assignee_of_entity"); !ok {
+		return
+	}
+
+	comments, totalCount, err := h.commentService.ListComments(ctx, filters)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidCommentCategory):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid category. Use 'question', 'blocker' or 'suggestion'",
+			})
+		case errors.Is(err, service.ErrCommentInvalidEntityType):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid entity type",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to list comments",
+			})
+		}
+		return
+	}
+
+	SendListResponse(c, comments, totalCount, filters.Limit, filters.Offset)
+}
+
+// parseUserFilter parses a user UUID query parameter, resolving "me" to the current user
+// Writes a 400 response and returns false if the parameter is malformed
+func parseUserFilter(c *gin.Context, name string) (*uuid.UUID, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, true
+	}
+
+	if value == "me" {
+		if currentUserID, ok := auth.GetCurrentUserID(c); ok {
+			value = currentUserID
+		}
+	}
+
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid " + name + ". Use a user UUID or 'me'",
+		})
+		return nil, false
+	}
+	return &userID, true
+}
+
 // GetCommentReplies handles GET /api/v1/comments/:id/replies
 // @Summary Get replies to a specific comment
 // @Description Retrieve all direct replies to a specific comment with pagination support. Returns replies in chronological order (oldest first) to maintain conversation flow. Each reply includes author information and metadata for building threaded comment interfaces.
 // @Tags comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Parent comment ID" format(uuid)
@@ -532,6 +717,7 @@ func (h *CommentHandler) GetCommentsByStatus(c *gin.Context) {
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/{id}/replies [get]
 func (h *CommentHandler) GetCommentReplies(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -556,7 +742,7 @@ func (h *CommentHandler) GetCommentReplies(c *gin.Context) {
 	pagination.SetDefaults()
 
 	// Get paginated replies through the comment service
-	replies, totalCount, err := h.commentService.GetCommentRepliesWithPagination(id, pagination.Limit, pagination.Offset)
+	replies, totalCount, err := h.commentService.GetCommentRepliesWithPagination(ctx, id, pagination.Limit, pagination.Offset)
 	if err != nil {
 		if errors.Is(err, service.ErrCommentNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -587,9 +773,12 @@ func (h *CommentHandler) GetCommentReplies(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Invalid parent comment ID format, invalid request body, empty content, or author not found"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Parent comment not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
+// @Failure 423 {object} ErrorResponse "Comment thread is locked; the message gives the reason"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/comments/{id}/replies [post]
 func (h *CommentHandler) CreateCommentReply(c *gin.Context) {
+	ctx := c.Request.Context()
 	parentIDParam := c.Param("id")
 
 	parentID, err := uuid.Parse(parentIDParam)
@@ -601,7 +790,7 @@ func (h *CommentHandler) CreateCommentReply(c *gin.Context) {
 	}
 
 	// Get parent comment to extract entity type and ID
-	parentComment, err := h.commentService.GetComment(parentID)
+	parentComment, err := h.commentService.GetComment(ctx, parentID)
 	if err != nil {
 		if errors.Is(err, service.ErrCommentNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -639,7 +828,7 @@ func (h *CommentHandler) CreateCommentReply(c *gin.Context) {
 	req.ParentCommentID = &parentID
 	req.AuthorID = uuid.MustParse(authorID)
 
-	comment, err := h.commentService.CreateComment(req)
+	comment, err := h.commentService.CreateComment(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentAuthorNotFound):
@@ -650,6 +839,13 @@ func (h *CommentHandler) CreateCommentReply(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Content cannot be empty",
 			})
+		case errors.Is(err, service.ErrInvalidCommentCategory):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid category. Use 'question', 'blocker' or 'suggestion'",
+			})
+		case errors.Is(err, service.ErrCommentBlocked), errors.Is(err, service.ErrCommentThreadLocked):
+			// The message names the moderation record the author can appeal or why the thread is locked
+			respondWithError(c, err, "Failed to create reply")
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to create reply",
@@ -675,9 +871,12 @@ func (h *CommentHandler) CreateCommentReply(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Invalid request - missing inline comment data, invalid text positions, or empty linked text"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Entity not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
+// @Failure 423 {object} ErrorResponse "Comment thread is locked; the message gives the reason"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/{entityType}/{id}/comments/inline [post]
 func (h *CommentHandler) CreateInlineComment(c *gin.Context) {
+	ctx := c.Request.Context()
 	entityTypeParam := c.Param("entityType")
 	entityIDParam := c.Param("id")
 
@@ -714,7 +913,7 @@ func (h *CommentHandler) CreateInlineComment(c *gin.Context) {
 		return
 	}
 
-	comment, err := h.commentService.CreateComment(req)
+	comment, err := h.commentService.CreateComment(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentInvalidEntityType):
@@ -733,6 +932,10 @@ func (h *CommentHandler) CreateInlineComment(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Content cannot be empty",
 			})
+		case errors.Is(err, service.ErrInvalidCommentCategory):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid category. Use 'question', 'blocker' or 'suggestion'",
+			})
 		case errors.Is(err, service.ErrInvalidInlineCommentData):
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Inline comments require linked_text, text_position_start, and text_position_end",
@@ -745,6 +948,9 @@ func (h *CommentHandler) CreateInlineComment(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Linked text cannot be empty for inline comments",
 			})
+		case errors.Is(err, service.ErrCommentBlocked), errors.Is(err, service.ErrCommentThreadLocked):
+			// The message names the moderation record the author can appeal or why the thread is locked
+			respondWithError(c, err, "Failed to create inline comment")
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error":   "Failed to create inline comment",
@@ -770,6 +976,8 @@ func (h *CommentHandler) CreateInlineComment(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Invalid request - missing inline comment data or invalid text positions"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Epic not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
+// @Failure 423 {object} ErrorResponse "Comment thread is locked; the message gives the reason"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/epics/{id}/comments/inline [post]
 func (h *CommentHandler) CreateEpicInlineComment(c *gin.Context) {
@@ -789,6 +997,8 @@ func (h *CommentHandler) CreateEpicInlineComment(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Invalid request - missing inline comment data or invalid text positions"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "User story not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
+// @Failure 423 {object} ErrorResponse "Comment thread is locked; the message gives the reason"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/user-stories/{id}/comments/inline [post]
 func (h *CommentHandler) CreateUserStoryInlineComment(c *gin.Context) {
@@ -808,6 +1018,8 @@ func (h *CommentHandler) CreateUserStoryInlineComment(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Invalid request - missing inline comment data or invalid text positions"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Acceptance criteria not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
+// @Failure 423 {object} ErrorResponse "Comment thread is locked; the message gives the reason"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/acceptance-criteria/{id}/comments/inline [post]
 func (h *CommentHandler) CreateAcceptanceCriteriaInlineComment(c *gin.Context) {
@@ -827,6 +1039,8 @@ func (h *CommentHandler) CreateAcceptanceCriteriaInlineComment(c *gin.Context) {
 // @Failure 400 {object} map[string]string "Invalid request - missing inline comment data or invalid text positions"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Requirement not found"
+// @Failure 422 {object} map[string]interface{} "Comment blocked by content moderation"
+// @Failure 423 {object} ErrorResponse "Comment thread is locked; the message gives the reason"
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/requirements/{id}/comments/inline [post]
 func (h *CommentHandler) CreateRequirementInlineComment(c *gin.Context) {
@@ -835,6 +1049,7 @@ func (h *CommentHandler) CreateRequirementInlineComment(c *gin.Context) {
 
 // createInlineCommentForEntity is a helper function for entity-specific inline comment creation
 func (h *CommentHandler) createInlineCommentForEntity(c *gin.Context, entityType models.EntityType) {
+	ctx := c.Request.Context()
 	entityIDParam := c.Param("id")
 
 	// Parse entity ID
@@ -877,7 +1092,7 @@ func (h *CommentHandler) createInlineCommentForEntity(c *gin.Context, entityType
 		return
 	}
 
-	comment, err := h.commentService.CreateComment(req)
+	comment, err := h.commentService.CreateComment(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentInvalidEntityType):
@@ -896,6 +1111,10 @@ func (h *CommentHandler) createInlineCommentForEntity(c *gin.Context, entityType
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Content cannot be empty",
 			})
+		case errors.Is(err, service.ErrInvalidCommentCategory):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid category. Use 'question', 'blocker' or 'suggestion'",
+			})
 		case errors.Is(err, service.ErrInvalidInlineCommentData):
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Inline comments require linked_text, text_position_start, and text_position_end",
@@ -908,6 +1127,9 @@ func (h *CommentHandler) createInlineCommentForEntity(c *gin.Context, entityType
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Linked text cannot be empty for inline comments",
 			})
+		case errors.Is(err, service.ErrCommentBlocked), errors.Is(err, service.ErrCommentThreadLocked):
+			// The message names the moderation record the author can appeal or why the thread is locked
+			respondWithError(c, err, "Failed to create inline comment")
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error":   "Failed to create inline comment",
@@ -924,6 +1146,7 @@ func (h *CommentHandler) createInlineCommentForEntity(c *gin.Context, entityType
 // @Summary Get visible inline comments for an entity
 // @Description Retrieve all inline comments that are still valid (visible) for an entity, excluding those that may have become invalid due to text changes. Requires authentication.
 // @Tags comments,inline-comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param entityType path string true "Entity type" Enums(epic,user_story,acceptance_criteria,requirement)
@@ -935,6 +1158,7 @@ func (h *CommentHandler) createInlineCommentForEntity(c *gin.Context, entityType
 // @Failure 500 {object} map[string]string "Internal server error"
 // @Router /api/v1/{entityType}/{id}/comments/inline/visible [get]
 func (h *CommentHandler) GetVisibleInlineComments(c *gin.Context) {
+	ctx := c.Request.Context()
 	entityTypeParam := c.Param("entityType")
 	entityIDParam := c.Param("id")
 
@@ -950,7 +1174,7 @@ func (h *CommentHandler) GetVisibleInlineComments(c *gin.Context) {
 		return
 	}
 
-	comments, err := h.commentService.GetVisibleInlineComments(entityType, entityID)
+	comments, err := h.commentService.GetVisibleInlineComments(ctx, entityType, entityID)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentInvalidEntityType):
@@ -979,6 +1203,7 @@ func (h *CommentHandler) GetVisibleInlineComments(c *gin.Context) {
 // @Summary Get visible inline comments for an epic
 // @Description Retrieve all visible inline comments for a specific epic, excluding those invalidated by text changes. Requires authentication.
 // @Tags epics,comments,inline-comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Epic ID" format(uuid)
@@ -996,6 +1221,7 @@ func (h *CommentHandler) GetEpicVisibleInlineComments(c *gin.Context) {
 // @Summary Get visible inline comments for a user story
 // @Description Retrieve all visible inline comments for a specific user story, excluding those invalidated by text changes. Requires authentication.
 // @Tags user-stories,comments,inline-comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "User Story ID" format(uuid)
@@ -1013,6 +1239,7 @@ func (h *CommentHandler) GetUserStoryVisibleInlineComments(c *gin.Context) {
 // @Summary Get visible inline comments for acceptance criteria
 // @Description Retrieve all visible inline comments for specific acceptance criteria, excluding those invalidated by text changes. Requires authentication.
 // @Tags acceptance-criteria,comments,inline-comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Acceptance Criteria ID" format(uuid)
@@ -1030,6 +1257,7 @@ func (h *CommentHandler) GetAcceptanceCriteriaVisibleInlineComments(c *gin.Conte
 // @Summary Get visible inline comments for a requirement
 // @Description Retrieve all visible inline comments for a specific requirement, excluding those invalidated by text changes. Requires authentication.
 // @Tags requirements,comments,inline-comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Requirement ID" format(uuid)
@@ -1045,6 +1273,7 @@ func (h *CommentHandler) GetRequirementVisibleInlineComments(c *gin.Context) {
 
 // getVisibleInlineCommentsForEntity is a helper function for entity-specific visible inline comments retrieval
 func (h *CommentHandler) getVisibleInlineCommentsForEntity(c *gin.Context, entityType models.EntityType) {
+	ctx := c.Request.Context()
 	entityIDParam := c.Param("id")
 
 	// Parse entity ID
@@ -1056,7 +1285,7 @@ func (h *CommentHandler) getVisibleInlineCommentsForEntity(c *gin.Context, entit
 		return
 	}
 
-	comments, err := h.commentService.GetVisibleInlineComments(entityType, entityID)
+	comments, err := h.commentService.GetVisibleInlineComments(ctx, entityType, entityID)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrCommentInvalidEntityType):
@@ -1097,6 +1326,7 @@ func (h *CommentHandler) getVisibleInlineCommentsForEntity(c *gin.Context, entit
 // @Failure 500 {object} map[string]string "Internal server error during validation"
 // @Router /api/v1/{entityType}/{id}/comments/inline/validate [post]
 func (h *CommentHandler) ValidateInlineComments(c *gin.Context) {
+	ctx := c.Request.Context()
 	entityTypeParam := c.Param("entityType")
 	entityIDParam := c.Param("id")
 
@@ -1124,7 +1354,7 @@ func (h *CommentHandler) ValidateInlineComments(c *gin.Context) {
 		return
 	}
 
-	err = h.commentService.ValidateInlineCommentsAfterTextChange(entityType, entityID, req.NewDescription)
+	err = h.commentService.ValidateInlineCommentsAfterTextChange(ctx, entityType, entityID, req.NewDescription)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to validate inline comments",
@@ -1212,6 +1442,7 @@ func (h *CommentHandler) ValidateRequirementInlineComments(c *gin.Context) {
 
 // validateInlineCommentsForEntity is a helper function for entity-specific inline comment validation
 func (h *CommentHandler) validateInlineCommentsForEntity(c *gin.Context, entityType models.EntityType) {
+	ctx := c.Request.Context()
 	entityIDParam := c.Param("id")
 
 	// Parse entity ID
@@ -1235,7 +1466,7 @@ func (h *CommentHandler) validateInlineCommentsForEntity(c *gin.Context, entityT
 		return
 	}
 
-	err = h.commentService.ValidateInlineCommentsAfterTextChange(entityType, entityID, req.NewDescription)
+	err = h.commentService.ValidateInlineCommentsAfterTextChange(ctx, entityType, entityID, req.NewDescription)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to validate inline comments",
@@ -1253,13 +1484,15 @@ func (h *CommentHandler) validateInlineCommentsForEntity(c *gin.Context, entityT
 // @Summary Get all comments for an epic
 // @Description Retrieve all comments for a specific epic with optional filtering by status and threading. Supports both flat and threaded comment structures.
 // @Tags epics,comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Epic ID" format(uuid)
 // @Param threaded query boolean false "Return comments in threaded structure"
 // @Param inline query boolean false "Return only inline comments"
 // @Param status query string false "Filter by resolution status" Enums(resolved,unresolved)
-// @Success 200 {object} map[string]interface{} "Successfully retrieved epic comments" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
+// @Param category query string false "Filter by comment category" Enums(question,blocker,suggestion)
+// @Success 200 {object} map[string]interface{} "Successfully retrieved epic comments with per-category counts" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
 // @Failure 400 {object} map[string]string "Invalid epic ID format"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Epic not found"
@@ -1273,13 +1506,15 @@ func (h *CommentHandler) GetEpicComments(c *gin.Context) {
 // @Summary Get all comments for a user story
 // @Description Retrieve all comments for a specific user story with optional filtering by status and threading. Supports both flat and threaded comment structures.
 // @Tags user-stories,comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "User Story ID" format(uuid)
 // @Param threaded query boolean false "Return comments in threaded structure"
 // @Param inline query boolean false "Return only inline comments"
 // @Param status query string false "Filter by resolution status" Enums(resolved,unresolved)
-// @Success 200 {object} map[string]interface{} "Successfully retrieved user story comments" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
+// @Param category query string false "Filter by comment category" Enums(question,blocker,suggestion)
+// @Success 200 {object} map[string]interface{} "Successfully retrieved user story comments with per-category counts" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
 // @Failure 400 {object} map[string]string "Invalid user story ID format"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "User story not found"
@@ -1293,13 +1528,15 @@ func (h *CommentHandler) GetUserStoryComments(c *gin.Context) {
 // @Summary Get all comments for acceptance criteria
 // @Description Retrieve all comments for specific acceptance criteria with optional filtering by status and threading. Supports both flat and threaded comment structures.
 // @Tags acceptance-criteria,comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Acceptance Criteria ID" format(uuid)
 // @Param threaded query boolean false "Return comments in threaded structure"
 // @Param inline query boolean false "Return only inline comments"
 // @Param status query string false "Filter by resolution status" Enums(resolved,unresolved)
-// @Success 200 {object} map[string]interface{} "Successfully retrieved acceptance criteria comments" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
+// @Param category query string false "Filter by comment category" Enums(question,blocker,suggestion)
+// @Success 200 {object} map[string]interface{} "Successfully retrieved acceptance criteria comments with per-category counts" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
 // @Failure 400 {object} map[string]string "Invalid acceptance criteria ID format"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Acceptance criteria not found"
@@ -1313,13 +1550,15 @@ func (h *CommentHandler) GetAcceptanceCriteriaComments(c *gin.Context) {
 // @Summary Get all comments for a requirement
 // @Description Retrieve all comments for a specific requirement with optional filtering by status and threading. Supports both flat and threaded comment structures.
 // @Tags requirements,comments
+// @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Requirement ID" format(uuid)
 // @Param threaded query boolean false "Return comments in threaded structure"
 // @Param inline query boolean false "Return only inline comments"
 // @Param status query string false "Filter by resolution status" Enums(resolved,unresolved)
-// @Success 200 {object} map[string]interface{} "Successfully retrieved requirement comments" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
+// @Param category query string false "Filter by comment category" Enums(question,blocker,suggestion)
+// @Success 200 {object} map[string]interface{} "Successfully retrieved requirement comments with per-category counts" example({"comments": [{"id": "123e4567-e89b-12d3-a456-426614174000", "content": "This needs clarification", "is_resolved": false}], "count": 1})
 // @Failure 400 {object} map[string]string "Invalid requirement ID format"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 404 {object} map[string]string "Requirement not found"
@@ -1331,6 +1570,7 @@ func (h *CommentHandler) GetRequirementComments(c *gin.Context) {
 
 // getCommentsForEntity is a helper function for entity-specific comment retrieval
 func (h *CommentHandler) getCommentsForEntity(c *gin.Context, entityType models.EntityType) {
+	ctx := c.Request.Context()
 	entityIDParam := c.Param("id")
 
 	// Parse entity ID
@@ -1355,11 +1595,11 @@ func (h *CommentHandler) getCommentsForEntity(c *gin.Context, entityType models.
 
 	if inlineOnly {
 		// Use visible inline comments to exclude hidden ones
-		comments, err = h.commentService.GetVisibleInlineComments(entityType, entityID)
+		comments, err = h.commentService.GetVisibleInlineComments(ctx, entityType, entityID)
 	} else if threaded {
-		comments, err = h.commentService.GetThreadedComments(entityType, entityID)
+		comments, err = h.commentService.GetThreadedComments(ctx, entityType, entityID)
 	} else {
-		comments, err = h.commentService.GetCommentsByEntity(entityType, entityID)
+		comments, err = h.commentService.GetCommentsByEntity(ctx, entityType, entityID)
 	}
 
 	if err != nil {
@@ -1401,8 +1641,137 @@ func (h *CommentHandler) getCommentsForEntity(c *gin.Context, entityType models.
 		comments = filteredComments
 	}
 
+	// Apply category filter if specified
+	if categoryFilter := c.Query("category"); categoryFilter != "" {
+		filteredComments := make([]service.CommentResponse, 0)
+		for _, comment := range comments {
+			if comment.Category != nil && string(*comment.Category) == categoryFilter {
+				filteredComments = append(filteredComments, comment)
+			}
+		}
+		comments = filteredComments
+	}
+
+	// Count comments per category so blocking concerns stay visible in general discussion
+	categoryCounts, err := h.commentService.GetCategoryCounts(ctx, entityType, entityID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get comments",
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"comments": comments,
-		"count":    len(comments),
+		"comments":        comments,
+		"count":           len(comments),
+		"category_counts": categoryCounts,
 	})
 }
+
+// GetCommentThreadLock handles GET /api/v1/{entityType}/:id/comments/lock
+// @Summary Get the comment thread lock of an entity
+// @Description Retrieve the lock on the comment thread of an entity, with its reason and the user who locked it.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} models.CommentThreadLock "Comment thread lock"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Entity not found or comment thread not locked"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/comments/lock [get]
+// @Router /api/v1/user-stories/{id}/comments/lock [get]
+// @Router /api/v1/acceptance-criteria/{id}/comments/lock [get]
+// @Router /api/v1/requirements/{id}/comments/lock [get]
+func (h *CommentHandler) GetCommentThreadLock(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	lock, err := h.commentService.GetThreadLock(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to get comment thread lock")
+		return
+	}
+
+	c.JSON(http.StatusOK, lock)
+}
+
+// LockCommentThread handles POST /api/v1/{entityType}/:id/comments/lock
+// @Summary Lock the comment thread of an entity
+// @Description Lock the comment thread of an entity, e.g. after a decision is recorded. While the thread is locked, new comments and replies by anyone but administrators are rejected with 423 Locked and the lock reason. Locking a locked thread replaces its reason.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param lock body service.LockCommentThreadRequest true "Lock reason"
+// @Success 200 {object} models.CommentThreadLock "Comment thread locked"
+// @Failure 400 {object} ErrorResponse "Invalid request body or empty reason"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Insufficient permissions"
+// @Failure 404 {object} ErrorResponse "Entity not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/comments/lock [post]
+// @Router /api/v1/user-stories/{id}/comments/lock [post]
+// @Router /api/v1/acceptance-criteria/{id}/comments/lock [post]
+// @Router /api/v1/requirements/{id}/comments/lock [post]
+func (h *CommentHandler) LockCommentThread(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.LockCommentThreadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+			Code:    "VALIDATION_ERROR",
+			Message: "Invalid request body: " + err.Error(),
+		}})
+		return
+	}
+
+	lock, err := h.commentService.LockThread(ctx, entityType, c.Param("id"), req.Reason, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to lock comment thread")
+		return
+	}
+
+	c.JSON(http.StatusOK, lock)
+}
+
+// UnlockCommentThread handles DELETE /api/v1/{entityType}/:id/comments/lock
+// @Summary Unlock the comment thread of an entity
+// @Description Reopen the comment thread of an entity to new comments and replies.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 204 "Comment thread unlocked"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Insufficient permissions"
+// @Failure 404 {object} ErrorResponse "Entity not found or comment thread not locked"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/comments/lock [delete]
+// @Router /api/v1/user-stories/{id}/comments/lock [delete]
+// @Router /api/v1/acceptance-criteria/{id}/comments/lock [delete]
+// @Router /api/v1/requirements/{id}/comments/lock [delete]
+func (h *CommentHandler) UnlockCommentThread(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.UnlockThread(ctx, entityType, c.Param("id")); err != nil {
+		respondWithError(c, err, "Failed to unlock comment thread")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,7 +25,7 @@ type MockCommentService struct {
 	mock.Mock
 }
 
-func (m *MockCommentService) CreateComment(req service.CreateCommentRequest) (*service.CommentResponse, error) {
+func (m *MockCommentService) CreateComment(ctx context.Context, req service.CreateCommentRequest) (*service.CommentResponse, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -32,7 +33,7 @@ func (m *MockCommentService) CreateComment(req service.CreateCommentRequest) (*s
 	return args.Get(0).(*service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) GetComment(id uuid.UUID) (*service.CommentResponse, error) {
+func (m *MockCommentService) GetComment(ctx context.Context, id uuid.UUID) (*service.CommentResponse, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,7 +41,7 @@ func (m *MockCommentService) GetComment(id uuid.UUID) (*service.CommentResponse,
 	return args.Get(0).(*service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) UpdateComment(id uuid.UUID, req service.UpdateCommentRequest) (*service.CommentResponse, error) {
+func (m *MockCommentService) UpdateComment(ctx context.Context, id uuid.UUID, req service.UpdateCommentRequest) (*service.CommentResponse, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -48,12 +49,12 @@ func (m *MockCommentService) UpdateComment(id uuid.UUID, req service.UpdateComme
 	return args.Get(0).(*service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) DeleteComment(id uuid.UUID) error {
+func (m *MockCommentService) DeleteComment(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockCommentService) GetCommentsByEntity(entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
+func (m *MockCommentService) GetCommentsByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
 	args := m.Called(entityType, entityID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -61,7 +62,7 @@ func (m *MockCommentService) GetCommentsByEntity(entityType models.EntityType, e
 	return args.Get(0).([]service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) GetThreadedComments(entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
+func (m *MockCommentService) GetThreadedComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
 	args := m.Called(entityType, entityID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -69,7 +70,7 @@ func (m *MockCommentService) GetThreadedComments(entityType models.EntityType, e
 	return args.Get(0).([]service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) GetCommentsByStatus(isResolved bool) ([]service.CommentResponse, error) {
+func (m *MockCommentService) GetCommentsByStatus(ctx context.Context, isResolved bool) ([]service.CommentResponse, error) {
 	args := m.Called(isResolved)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -77,7 +78,7 @@ func (m *MockCommentService) GetCommentsByStatus(isResolved bool) ([]service.Com
 	return args.Get(0).([]service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) GetInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
+func (m *MockCommentService) GetInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
 	args := m.Called(entityType, entityID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -85,7 +86,7 @@ func (m *MockCommentService) GetInlineComments(entityType models.EntityType, ent
 	return args.Get(0).([]service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) ResolveComment(id uuid.UUID) (*service.CommentResponse, error) {
+func (m *MockCommentService) ResolveComment(ctx context.Context, id uuid.UUID) (*service.CommentResponse, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -93,7 +94,7 @@ func (m *MockCommentService) ResolveComment(id uuid.UUID) (*service.CommentRespo
 	return args.Get(0).(*service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) UnresolveComment(id uuid.UUID) (*service.CommentResponse, error) {
+func (m *MockCommentService) UnresolveComment(ctx context.Context, id uuid.UUID) (*service.CommentResponse, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -101,7 +102,7 @@ func (m *MockCommentService) UnresolveComment(id uuid.UUID) (*service.CommentRes
 	return args.Get(0).(*service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) GetVisibleInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
+func (m *MockCommentService) GetVisibleInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]service.CommentResponse, error) {
 	args := m.Called(entityType, entityID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -109,12 +110,12 @@ func (m *MockCommentService) GetVisibleInlineComments(entityType models.EntityTy
 	return args.Get(0).([]service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) ValidateInlineCommentsAfterTextChange(entityType models.EntityType, entityID uuid.UUID, newDescription string) error {
+func (m *MockCommentService) ValidateInlineCommentsAfterTextChange(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, newDescription string) error {
 	args := m.Called(entityType, entityID, newDescription)
 	return args.Error(0)
 }
 
-func (m *MockCommentService) GetCommentReplies(parentID uuid.UUID) ([]service.CommentResponse, error) {
+func (m *MockCommentService) GetCommentReplies(ctx context.Context, parentID uuid.UUID) ([]service.CommentResponse, error) {
 	args := m.Called(parentID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -122,7 +123,7 @@ func (m *MockCommentService) GetCommentReplies(parentID uuid.UUID) ([]service.Co
 	return args.Get(0).([]service.CommentResponse), args.Error(1)
 }
 
-func (m *MockCommentService) GetCommentRepliesWithPagination(parentID uuid.UUID, limit, offset int) ([]service.CommentResponse, int64, error) {
+func (m *MockCommentService) GetCommentRepliesWithPagination(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]service.CommentResponse, int64, error) {
 	args := m.Called(parentID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, 0, args.Error(2)
@@ -130,6 +131,43 @@ func (m *MockCommentService) GetCommentRepliesWithPagination(parentID uuid.UUID,
 	return args.Get(0).([]service.CommentResponse), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockCommentService) ListComments(ctx context.Context, filters service.CommentFilters) ([]service.CommentResponse, int64, error) {
+	args := m.Called(filters)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]service.CommentResponse), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockCommentService) GetCategoryCounts(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (map[models.CommentCategory]int64, error) {
+	args := m.Called(entityType, entityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[models.CommentCategory]int64), args.Error(1)
+}
+
+func (m *MockCommentService) GetThreadLock(ctx context.Context, entityType models.EntityType, idOrReference string) (*models.CommentThreadLock, error) {
+	args := m.Called(entityType, idOrReference)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CommentThreadLock), args.Error(1)
+}
+
+func (m *MockCommentService) LockThread(ctx context.Context, entityType models.EntityType, idOrReference, reason string, userID uuid.UUID) (*models.CommentThreadLock, error) {
+	args := m.Called(entityType, idOrReference, reason, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CommentThreadLock), args.Error(1)
+}
+
+func (m *MockCommentService) UnlockThread(ctx context.Context, entityType models.EntityType, idOrReference string) error {
+	args := m.Called(entityType, idOrReference)
+	return args.Error(0)
+}
+
 func setupCommentHandler() (*CommentHandler, *MockCommentService, *auth.Service) {
 	mockService := &MockCommentService{}
 	handler := NewCommentHandler(mockService)
@@ -150,6 +188,7 @@ func setupGinRouter(handler *CommentHandler, authService *auth.Service) *gin.Eng
 		authenticated.Use(authService.Middleware())
 		authenticated.Use(authService.RequireCommenter())
 		{
+			authenticated.GET("/comments", handler.ListComments)
 			authenticated.GET("/comments/:id", handler.GetComment)
 			authenticated.PUT("/comments/:id", handler.UpdateComment)
 			authenticated.DELETE("/comments/:id", handler.DeleteComment)
@@ -164,6 +203,9 @@ func setupGinRouter(handler *CommentHandler, authService *auth.Service) *gin.Eng
 			{
 				epics.GET("/:id/comments", handler.GetEpicComments)
 				epics.POST("/:id/comments", handler.CreateComment)
+				epics.GET("/:id/comments/lock", handler.GetCommentThreadLock)
+				epics.POST("/:id/comments/lock", handler.LockCommentThread)
+				epics.DELETE("/:id/comments/lock", handler.UnlockCommentThread)
 			}
 
 			userStories := authenticated.Group("/user-stories")
@@ -397,6 +439,8 @@ func TestGetCommentsByEntity(t *testing.T) {
 				}
 				mockService.On("GetCommentsByEntity", models.EntityTypeEpic, entityID).
 					Return(expectedComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeEpic, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			useAuth:        true,
@@ -429,6 +473,8 @@ func TestGetCommentsByEntity(t *testing.T) {
 				}
 				mockService.On("GetThreadedComments", models.EntityTypeEpic, entityID).
 					Return(expectedComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeEpic, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			useAuth:        true,
@@ -458,6 +504,8 @@ func TestGetCommentsByEntity(t *testing.T) {
 				}
 				mockService.On("GetVisibleInlineComments", models.EntityTypeEpic, entityID).
 					Return(expectedComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeEpic, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			useAuth:        true,
@@ -1089,6 +1137,132 @@ func TestGetCommentsByStatus(t *testing.T) {
 	}
 }
 
+func TestListComments(t *testing.T) {
+	handler, mockService, authService := setupCommentHandler()
+	router := setupGinRouter(handler, authService)
+
+	testUser := createTestUser()
+	token, err := createTestToken(authService, testUser)
+	assert.NoError(t, err)
+
+	blocker := models.CommentCategoryBlocker
+	unresolved := false
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		mockSetup      func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:        "unresolved blockers across entities",
+			queryParams: "?category=blocker&status=unresolved",
+			mockSetup: func() {
+				expectedComments := []service.CommentResponse{
+					{
+						ID:         uuid.New(),
+						EntityType: models.EntityTypeRequirement,
+						EntityID:   uuid.New(),
+						Content:    "Blocking concern",
+						Category:   &blocker,
+					},
+				}
+				mockService.On("ListComments", service.CommentFilters{
+					Category:   &blocker,
+					IsResolved: &unresolved,
+					Limit:      50,
+				}).Return(expectedComments, int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "invalid category",
+			queryParams: "?category=nitpick",
+			mockSetup: func() {
+				mockService.On("ListComments", mock.AnythingOfType("service.CommentFilters")).
+					Return(nil, int64(0), service.ErrInvalidCommentCategory)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid category. Use 'question', 'blocker' or 'suggestion'",
+		},
+		{
+			name:        "unresolved inbox for entities assigned to current user",
+			queryParams: "?is_resolved=false&assignee_of_entity=me",
+			mockSetup: func() {
+				expectedComments := []service.CommentResponse{
+					{
+						ID:         uuid.New(),
+						EntityType: models.EntityTypeRequirement,
+						EntityID:   uuid.New(),
+						Content:    "Blocking concern",
+						Category:   &blocker,
+					},
+				}
+				mockService.On("ListComments", service.CommentFilters{
+					IsResolved:       &unresolved,
+					EntityAssigneeID: &testUser.ID,
+					Limit:            50,
+				}).Return(expectedComments, int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid author filter",
+			queryParams:    "?author_id=someone",
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid author_id. Use a user UUID or 'me'",
+		},
+		{
+			name:           "invalid is_resolved",
+			queryParams:    "?is_resolved=maybe",
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid is_resolved. Use 'true' or 'false'",
+		},
+		{
+			name:           "invalid status",
+			queryParams:    "?status=open",
+			mockSetup:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid status. Use 'resolved' or 'unresolved'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService.ExpectedCalls = nil
+			mockService.Calls = nil
+
+			tt.mockSetup()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/comments"+tt.queryParams, nil)
+			addAuthHeader(req, token)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedError != "" {
+				var response map[string]interface{}
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedError, response["error"])
+			} else {
+				var response ListResponse[service.CommentResponse]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), response.TotalCount)
+				assert.Equal(t, blocker, *response.Data[0].Category)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
 func TestCommentFiltering(t *testing.T) {
 	handler, mockService, authService := setupCommentHandler()
 	router := setupGinRouter(handler, authService)
@@ -1139,6 +1313,8 @@ func TestCommentFiltering(t *testing.T) {
 				}
 				mockService.On("GetCommentsByEntity", models.EntityTypeEpic, entityID).
 					Return(allComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeEpic, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -1170,6 +1346,8 @@ func TestCommentFiltering(t *testing.T) {
 				}
 				mockService.On("GetCommentsByEntity", models.EntityTypeEpic, entityID).
 					Return(allComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeEpic, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -1201,6 +1379,8 @@ func TestCommentFiltering(t *testing.T) {
 				}
 				mockService.On("GetCommentsByEntity", models.EntityTypeEpic, entityID).
 					Return(allComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeEpic, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  2,
@@ -1232,6 +1412,8 @@ func TestCommentFiltering(t *testing.T) {
 				}
 				mockService.On("GetCommentsByEntity", models.EntityTypeEpic, entityID).
 					Return(allComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeEpic, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  2,
@@ -1273,6 +1455,8 @@ func TestCommentFiltering(t *testing.T) {
 				}
 				mockService.On("GetThreadedComments", models.EntityTypeUserStory, entityID).
 					Return(allComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeUserStory, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -1315,6 +1499,8 @@ func TestCommentFiltering(t *testing.T) {
 				}
 				mockService.On("GetVisibleInlineComments", models.EntityTypeRequirement, entityID).
 					Return(allComments, nil)
+				mockService.On("GetCategoryCounts", models.EntityTypeRequirement, entityID).
+					Return(map[models.CommentCategory]int64{}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -1472,3 +1658,78 @@ func TestUnresolveComment(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentThreadLock(t *testing.T) {
+	handler, mockService, authService := setupCommentHandler()
+	router := setupGinRouter(handler, authService)
+
+	testUser := createTestUser()
+	token, err := createTestToken(authService, testUser)
+	assert.NoError(t, err)
+
+	entityID := uuid.New()
+	lockPath := "/api/v1/epics/EP-001/comments/lock"
+
+	serve := func(method, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		addAuthHeader(req, token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	errorCode := func(w *httptest.ResponseRecorder) string {
+		var response ErrorResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response.Error.Code
+	}
+
+	t.Run("lock thread", func(t *testing.T) {
+		lock := &models.CommentThreadLock{EntityType: models.EntityTypeEpic, EntityID: entityID, Reason: "Decided: cards only", LockedBy: testUser.ID}
+		mockService.On("LockThread", models.EntityTypeEpic, "EP-001", "Decided: cards only", testUser.ID).Return(lock, nil).Once()
+
+		w := serve(http.MethodPost, lockPath, `{"reason":"Decided: cards only"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response models.CommentThreadLock
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Decided: cards only", response.Reason)
+	})
+
+	t.Run("lock without reason", func(t *testing.T) {
+		w := serve(http.MethodPost, lockPath, `{}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "VALIDATION_ERROR", errorCode(w))
+	})
+
+	t.Run("comment on locked thread", func(t *testing.T) {
+		mockService.On("CreateComment", mock.AnythingOfType("service.CreateCommentRequest")).
+			Return(nil, fmt.Errorf("%w: Decided: cards only", service.ErrCommentThreadLocked)).Once()
+
+		w := serve(http.MethodPost, "/api/v1/epics/"+entityID.String()+"/comments", `{"content":"What about PayPal?"}`)
+
+		assert.Equal(t, http.StatusLocked, w.Code)
+		assert.Equal(t, "COMMENT_THREAD_LOCKED", errorCode(w))
+		assert.Contains(t, w.Body.String(), "Decided: cards only")
+	})
+
+	t.Run("unlock thread", func(t *testing.T) {
+		mockService.On("UnlockThread", models.EntityTypeEpic, "EP-001").Return(nil).Once()
+
+		w := serve(http.MethodDelete, lockPath, "")
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("unlock thread that is not locked", func(t *testing.T) {
+		mockService.On("UnlockThread", models.EntityTypeEpic, "EP-001").Return(service.ErrCommentThreadNotLocked).Once()
+
+		w := serve(http.MethodDelete, lockPath, "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "COMMENT_THREAD_NOT_LOCKED", errorCode(w))
+	})
+
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// CommentModerationHandler handles HTTP requests for the review of moderated comments
+type CommentModerationHandler struct {
+	moderationService service.CommentModerationService
+}
+
+// NewCommentModerationHandler creates a new comment moderation handler instance
+func NewCommentModerationHandler(moderationService service.CommentModerationService) *CommentModerationHandler {
+	return &CommentModerationHandler{
+		moderationService: moderationService,
+	}
+}
+
+// ListQueue handles GET /api/v1/admin/comment-moderation
+// @Summary List the comment moderation queue
+// @Description List comments caught by content moderation, oldest first, with the verdict, the appeal of the author and the review. Without a status, the comments waiting for a moderator are listed: flagged comments, which are published, and appeals against blocked or rejected comments.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status" Enums(flagged, blocked, appealed, approved, rejected)
+// @Param limit query int false "Maximum number of records to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of records to skip for pagination" minimum(0) default(0)
+// @Success 200 {object} map[string]interface{} "Moderation records with the total count"
+// @Failure 400 {object} map[string]interface{} "Invalid status"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/comment-moderation [get]
+func (h *CommentModerationHandler) ListQueue(c *gin.Context) {
+	ctx := c.Request.Context()
+	filters := service.CommentModerationFilters{}
+	filters.Limit, filters.Offset = parseModerationPage(c)
+	if statusParam := c.Query("status"); statusParam != "" {
+		status := models.ModerationStatus(statusParam)
+		filters.Status = &status
+	}
+
+	records, total, err := h.moderationService.ListQueue(ctx, filters)
+	if err != nil {
+		respondWithError(c, err, "Failed to list moderation queue")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"records":     records,
+		"count":       len(records),
+		"total_count": total,
+	})
+}
+
+// ApproveComment handles POST /api/v1/admin/comment-moderation/:id/approve
+// @Summary Approve a moderated comment
+// @Description Publish a comment caught by content moderation. A blocked comment or edit is applied as the author wrote it and the content of a rejected comment is restored; a flagged comment leaves the queue. The note is shown to the author.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Moderation record UUID" format(uuid)
+// @Param review body service.ReviewModerationRequest false "Note for the author"
+// @Success 200 {object} models.CommentModeration "Approved record"
+// @Failure 400 {object} map[string]interface{} "Invalid record ID or request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Moderation record, comment or entity not found"
+// @Failure 409 {object} map[string]interface{} "Comment already reviewed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/comment-moderation/{id}/approve [post]
+func (h *CommentModerationHandler) ApproveComment(c *gin.Context) {
+	h.review(c, h.moderationService.Approve, "Failed to approve comment")
+}
+
+// RejectComment handles POST /api/v1/admin/comment-moderation/:id/reject
+// @Summary Reject a moderated comment
+// @Description Keep a comment caught by content moderation unpublished. The content of a flagged comment is replaced by a removal notice and kept in the moderation record. The author may appeal a rejection once unless it answered an appeal.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Moderation record UUID" format(uuid)
+// @Param review body service.ReviewModerationRequest false "Note for the author"
+// @Success 200 {object} models.CommentModeration "Rejected record"
+// @Failure 400 {object} map[string]interface{} "Invalid record ID or request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Moderation record not found"
+// @Failure 409 {object} map[string]interface{} "Comment already reviewed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/comment-moderation/{id}/reject [post]
+func (h *CommentModerationHandler) RejectComment(c *gin.Context) {
+	h.review(c, h.moderationService.Reject, "Failed to reject comment")
+}
+
+// ListMyModerations handles GET /api/v1/comments/moderation
+// @Summary List moderation decisions on my comments
+// @Description List the comments of the current user that content moderation flagged or blocked, oldest first, with the verdict and the review. Blocked and rejected comments can be appealed.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Maximum number of records to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of records to skip for pagination" minimum(0) default(0)
+// @Success 200 {object} map[string]interface{} "Moderation records with the total count"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/comments/moderation [get]
+func (h *CommentModerationHandler) ListMyModerations(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentModerationUser(c)
+	if !ok {
+		return
+	}
+
+	limit, offset := parseModerationPage(c)
+	records, total, err := h.moderationService.ListForAuthor(ctx, userID, limit, offset)
+	if err != nil {
+		respondWithError(c, err, "Failed to list moderation records")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"records":     records,
+		"count":       len(records),
+		"total_count": total,
+	})
+}
+
+// AppealModeration handles POST /api/v1/comments/moderation/:id/appeal
+// @Summary Appeal a moderation decision
+// @Description Ask a moderator to review a blocked or rejected comment. Only the author can appeal, once per comment.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Moderation record UUID" format(uuid)
+// @Param appeal body service.AppealModerationRequest true "Reason for the appeal"
+// @Success 200 {object} models.CommentModeration "Appealed record"
+// @Failure 400 {object} map[string]interface{} "Invalid record ID or missing reason"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Not the author of the comment"
+// @Failure 404 {object} map[string]interface{} "Moderation record not found"
+// @Failure 409 {object} map[string]interface{} "Comment is not blocked or rejected, or was already appealed"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/comments/moderation/{id}/appeal [post]
+func (h *CommentModerationHandler) AppealModeration(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentModerationUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseModerationID(c)
+	if !ok {
+		return
+	}
+
+	var req service.AppealModerationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	record, err := h.moderationService.Appeal(ctx, id, userID, req.Reason)
+	if err != nil {
+		respondWithError(c, err, "Failed to appeal moderation decision")
+		return
+	}
+
+	c.JSON(http.StatusOK, record)
+}
+
+// review applies the decision of the current administrator to a moderation record
+func (h *CommentModerationHandler) review(c *gin.Context, decide func(ctx context.Context, id, reviewerID uuid.UUID, note string) (*models.CommentModeration, error), fallbackMessage string) {
+	reviewerID, ok := currentModerationUser(c)
+	if !ok {
+		return
+	}
+	id, ok := parseModerationID(c)
+	if !ok {
+		return
+	}
+
+	// The note is optional, so is the body
+	var req service.ReviewModerationRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid request body: " + err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	record, err := decide(c.Request.Context(), id, reviewerID, req.Note)
+	if err != nil {
+		respondWithError(c, err, fallbackMessage)
+		return
+	}
+
+	c.JSON(http.StatusOK, record)
+}
+
+// currentModerationUser returns the authenticated user, responding with 401 when there is none
+func currentModerationUser(c *gin.Context) (uuid.UUID, bool) {
+	userID, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return uuid.MustParse(userID), true
+}
+
+// parseModerationID parses the moderation record ID path parameter, responding with 400 when it is invalid
+func parseModerationID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid moderation record ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// parseModerationPage reads the limit (default 50, at most 100) and offset query parameters
+func parseModerationPage(c *gin.Context) (int, int) {
+	limit, offset := 50, 0
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(c.Query("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+	return limit, offset
+}
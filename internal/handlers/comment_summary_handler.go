@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// CommentSummaryHandler handles HTTP requests for AI summaries of entity discussions
+type CommentSummaryHandler struct {
+	summaryService service.CommentSummaryService
+}
+
+// NewCommentSummaryHandler creates a new comment summary handler instance
+func NewCommentSummaryHandler(summaryService service.CommentSummaryService) *CommentSummaryHandler {
+	return &CommentSummaryHandler{
+		summaryService: summaryService,
+	}
+}
+
+// SummarizeComments handles POST /api/v1/{entityType}/:id/comments/summarize
+// @Summary Summarize the discussion on an entity
+// @Description Summarize the comments on an entity with the configured language model into an overview, open questions, decisions and action items. The summary is cached until a comment is added, edited or deleted. With post set, the summary is also added to the entity as a resolved system comment, which later summaries leave out.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param request body service.SummarizeCommentsRequest false "Summary options"
+// @Success 200 {object} service.CommentSummary "Discussion summary"
+// @Failure 400 {object} map[string]interface{} "Invalid request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 422 {object} map[string]interface{} "Entity has no comments"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Failure 503 {object} map[string]interface{} "No language model provider is configured or the provider failed"
+// @Router /api/v1/epics/{id}/comments/summarize [post]
+// @Router /api/v1/user-stories/{id}/comments/summarize [post]
+// @Router /api/v1/acceptance-criteria/{id}/comments/summarize [post]
+// @Router /api/v1/requirements/{id}/comments/summarize [post]
+func (h *CommentSummaryHandler) SummarizeComments(c *gin.Context) {
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.SummarizeCommentsRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid request body: " + err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	summary, err := h.summaryService.Summarize(c.Request.Context(), entityType, c.Param("id"), req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to summarize comments")
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
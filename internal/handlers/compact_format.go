@@ -0,0 +1,12 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// compactFormatRequested reports whether the format query parameter asks for compact entities
+func compactFormatRequested(c *gin.Context) bool {
+	return c.Query("format") == service.EntityFormatCompact
+}
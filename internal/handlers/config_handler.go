@@ -60,6 +60,7 @@ func NewConfigHandler(configService service.ConfigService) *ConfigHandler {
 //	@Failure		500					{object}	ErrorResponse							"Internal server error"
 //	@Router			/api/v1/config/requirement-types [post]
 func (h *ConfigHandler) CreateRequirementType(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateRequirementTypeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -69,7 +70,7 @@ func (h *ConfigHandler) CreateRequirementType(c *gin.Context) {
 		return
 	}
 
-	requirementType, err := h.configService.CreateRequirementType(req)
+	requirementType, err := h.configService.CreateRequirementType(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementTypeNameExists):
@@ -103,6 +104,7 @@ func (h *ConfigHandler) CreateRequirementType(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse			"Internal server error"
 //	@Router			/api/v1/config/requirement-types/{id} [get]
 func (h *ConfigHandler) GetRequirementType(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -113,7 +115,7 @@ func (h *ConfigHandler) GetRequirementType(c *gin.Context) {
 		return
 	}
 
-	requirementType, err := h.configService.GetRequirementTypeByID(id)
+	requirementType, err := h.configService.GetRequirementTypeByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrRequirementTypeNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -141,7 +143,7 @@ func (h *ConfigHandler) GetRequirementType(c *gin.Context) {
 //	@Param			id					path		string									true	"Requirement type ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174000")
 //	@Param			requirement_type	body		service.UpdateRequirementTypeRequest	true	"Requirement type update request"
 //	@Success		200					{object}	models.RequirementType					"Successfully updated requirement type"
-//	@Failure		400					{object}	ErrorResponse							"Invalid request body or UUID format"
+//	@Failure		400					{object}	ErrorResponse							"Invalid request body, allowed links or UUID format"
 //	@Failure		401					{object}	ErrorResponse							"Authentication required"
 //	@Failure		403					{object}	ErrorResponse							"Administrator role required"
 //	@Failure		404					{object}	ErrorResponse							"Requirement type not found"
@@ -149,6 +151,7 @@ func (h *ConfigHandler) GetRequirementType(c *gin.Context) {
 //	@Failure		500					{object}	ErrorResponse							"Internal server error"
 //	@Router			/api/v1/config/requirement-types/{id} [put]
 func (h *ConfigHandler) UpdateRequirementType(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -168,7 +171,7 @@ func (h *ConfigHandler) UpdateRequirementType(c *gin.Context) {
 		return
 	}
 
-	requirementType, err := h.configService.UpdateRequirementType(id, req)
+	requirementType, err := h.configService.UpdateRequirementType(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementTypeNotFound):
@@ -209,6 +212,7 @@ func (h *ConfigHandler) UpdateRequirementType(c *gin.Context) {
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/api/v1/config/requirement-types/{id} [delete]
 func (h *ConfigHandler) DeleteRequirementType(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -222,7 +226,7 @@ func (h *ConfigHandler) DeleteRequirementType(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.configService.DeleteRequirementType(id, force)
+	err = h.configService.DeleteRequirementType(ctx, id, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementTypeNotFound):
@@ -261,6 +265,7 @@ func (h *ConfigHandler) DeleteRequirementType(c *gin.Context) {
 //	@Failure		500			{object}	ErrorResponse									"Internal server error"
 //	@Router			/api/v1/config/requirement-types [get]
 func (h *ConfigHandler) ListRequirementTypes(c *gin.Context) {
+	ctx := c.Request.Context()
 	var filters service.RequirementTypeFilters
 
 	if orderBy := c.Query("order_by"); orderBy != "" {
@@ -279,7 +284,7 @@ func (h *ConfigHandler) ListRequirementTypes(c *gin.Context) {
 		}
 	}
 
-	requirementTypes, totalCount, err := h.configService.ListRequirementTypes(filters)
+	requirementTypes, totalCount, err := h.configService.ListRequirementTypes(ctx, filters)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to list requirement types",
@@ -295,7 +300,7 @@ func (h *ConfigHandler) ListRequirementTypes(c *gin.Context) {
 // CreateRelationshipType handles POST /api/v1/config/relationship-types
 //
 //	@Summary		Create a new relationship type
-//	@Description	Creates a new relationship type for defining how requirements relate to each other. Common types include depends_on, blocks, relates_to, conflicts_with, and derives_from. Requires Administrator role.
+//	@Description	Creates a new relationship type for defining how entities relate to each other. Common types include depends_on, blocks, relates_to, conflicts_with, derives_from and duplicates. allowed_links restricts the entity types the type can link as comma-separated source:target pairs (* matches any type); empty allows any pair. Requires Administrator role.
 //	@Tags			configuration
 //	@Accept			json
 //	@Produce		json
@@ -309,6 +314,7 @@ func (h *ConfigHandler) ListRequirementTypes(c *gin.Context) {
 //	@Failure		500					{object}	ErrorResponse							"Internal server error"
 //	@Router			/api/v1/config/relationship-types [post]
 func (h *ConfigHandler) CreateRelationshipType(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateRelationshipTypeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -318,13 +324,17 @@ func (h *ConfigHandler) CreateRelationshipType(c *gin.Context) {
 		return
 	}
 
-	relationshipType, err := h.configService.CreateRelationshipType(req)
+	relationshipType, err := h.configService.CreateRelationshipType(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRelationshipTypeNameExists):
 			c.JSON(http.StatusConflict, gin.H{
 				"error": "Relationship type name already exists",
 			})
+		case errors.Is(err, service.ErrInvalidAllowedLinks):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to create relationship type",
@@ -352,6 +362,7 @@ func (h *ConfigHandler) CreateRelationshipType(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse				"Internal server error"
 //	@Router			/api/v1/config/relationship-types/{id} [get]
 func (h *ConfigHandler) GetRelationshipType(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -362,7 +373,7 @@ func (h *ConfigHandler) GetRelationshipType(c *gin.Context) {
 		return
 	}
 
-	relationshipType, err := h.configService.GetRelationshipTypeByID(id)
+	relationshipType, err := h.configService.GetRelationshipTypeByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrRelationshipTypeNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -398,6 +409,7 @@ func (h *ConfigHandler) GetRelationshipType(c *gin.Context) {
 //	@Failure		500					{object}	ErrorResponse							"Internal server error"
 //	@Router			/api/v1/config/relationship-types/{id} [put]
 func (h *ConfigHandler) UpdateRelationshipType(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -417,7 +429,7 @@ func (h *ConfigHandler) UpdateRelationshipType(c *gin.Context) {
 		return
 	}
 
-	relationshipType, err := h.configService.UpdateRelationshipType(id, req)
+	relationshipType, err := h.configService.UpdateRelationshipType(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRelationshipTypeNotFound):
@@ -428,6 +440,10 @@ func (h *ConfigHandler) UpdateRelationshipType(c *gin.Context) {
 			c.JSON(http.StatusConflict, gin.H{
 				"error": "Relationship type name already exists",
 			})
+		case errors.Is(err, service.ErrInvalidAllowedLinks):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to update relationship type",
@@ -442,7 +458,7 @@ func (h *ConfigHandler) UpdateRelationshipType(c *gin.Context) {
 // DeleteRelationshipType handles DELETE /api/v1/config/relationship-types/:id
 //
 //	@Summary		Delete relationship type
-//	@Description	Deletes a relationship type. By default, deletion is prevented if there are requirement relationships using this type. Use force=true to override this protection (use with caution). Requires Administrator role.
+//	@Description	Deletes a relationship type. By default, deletion is prevented if there are requirement or entity relationships using this type. Use force=true to override this protection (use with caution). Requires Administrator role.
 //	@Tags			configuration
 //	@Accept			json
 //	@Produce		json
@@ -458,6 +474,7 @@ func (h *ConfigHandler) UpdateRelationshipType(c *gin.Context) {
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/api/v1/config/relationship-types/{id} [delete]
 func (h *ConfigHandler) DeleteRelationshipType(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -471,7 +488,7 @@ func (h *ConfigHandler) DeleteRelationshipType(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.configService.DeleteRelationshipType(id, force)
+	err = h.configService.DeleteRelationshipType(ctx, id, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRelationshipTypeNotFound):
@@ -510,6 +527,7 @@ func (h *ConfigHandler) DeleteRelationshipType(c *gin.Context) {
 //	@Failure		500			{object}	ErrorResponse									"Internal server error"
 //	@Router			/api/v1/config/relationship-types [get]
 func (h *ConfigHandler) ListRelationshipTypes(c *gin.Context) {
+	ctx := c.Request.Context()
 	var filters service.RelationshipTypeFilters
 
 	if orderBy := c.Query("order_by"); orderBy != "" {
@@ -528,7 +546,7 @@ func (h *ConfigHandler) ListRelationshipTypes(c *gin.Context) {
 		}
 	}
 
-	relationshipTypes, totalCount, err := h.configService.ListRelationshipTypes(filters)
+	relationshipTypes, totalCount, err := h.configService.ListRelationshipTypes(ctx, filters)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to list relationship types",
@@ -558,6 +576,7 @@ func (h *ConfigHandler) ListRelationshipTypes(c *gin.Context) {
 //	@Failure		500				{object}	ErrorResponse						"Internal server error"
 //	@Router			/api/v1/config/status-models [post]
 func (h *ConfigHandler) CreateStatusModel(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateStatusModelRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -567,7 +586,7 @@ func (h *ConfigHandler) CreateStatusModel(c *gin.Context) {
 		return
 	}
 
-	statusModel, err := h.configService.CreateStatusModel(req)
+	statusModel, err := h.configService.CreateStatusModel(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusModelNameExists):
@@ -605,6 +624,7 @@ func (h *ConfigHandler) CreateStatusModel(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse		"Internal server error"
 //	@Router			/api/v1/config/status-models/{id} [get]
 func (h *ConfigHandler) GetStatusModel(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -615,7 +635,7 @@ func (h *ConfigHandler) GetStatusModel(c *gin.Context) {
 		return
 	}
 
-	statusModel, err := h.configService.GetStatusModelByID(id)
+	statusModel, err := h.configService.GetStatusModelByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrStatusModelNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -651,6 +671,7 @@ func (h *ConfigHandler) GetStatusModel(c *gin.Context) {
 //	@Failure		500				{object}	ErrorResponse						"Internal server error"
 //	@Router			/api/v1/config/status-models/{id} [put]
 func (h *ConfigHandler) UpdateStatusModel(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -670,7 +691,7 @@ func (h *ConfigHandler) UpdateStatusModel(c *gin.Context) {
 		return
 	}
 
-	statusModel, err := h.configService.UpdateStatusModel(id, req)
+	statusModel, err := h.configService.UpdateStatusModel(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusModelNotFound):
@@ -710,6 +731,7 @@ func (h *ConfigHandler) UpdateStatusModel(c *gin.Context) {
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/api/v1/config/status-models/{id} [delete]
 func (h *ConfigHandler) DeleteStatusModel(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -723,7 +745,7 @@ func (h *ConfigHandler) DeleteStatusModel(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.configService.DeleteStatusModel(id, force)
+	err = h.configService.DeleteStatusModel(ctx, id, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusModelNotFound):
@@ -758,6 +780,7 @@ func (h *ConfigHandler) DeleteStatusModel(c *gin.Context) {
 //	@Failure		500			{object}	ErrorResponse															"Internal server error"
 //	@Router			/api/v1/config/status-models [get]
 func (h *ConfigHandler) ListStatusModels(c *gin.Context) {
+	ctx := c.Request.Context()
 	var filters service.StatusModelFilters
 
 	if entityType := c.Query("entity_type"); entityType != "" {
@@ -780,7 +803,7 @@ func (h *ConfigHandler) ListStatusModels(c *gin.Context) {
 		}
 	}
 
-	statusModels, totalCount, err := h.configService.ListStatusModels(filters)
+	statusModels, totalCount, err := h.configService.ListStatusModels(ctx, filters)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to list status models",
@@ -806,9 +829,10 @@ func (h *ConfigHandler) ListStatusModels(c *gin.Context) {
 //	@Failure		500			{object}	ErrorResponse		"Internal server error"
 //	@Router			/api/v1/config/status-models/default/{entity_type} [get]
 func (h *ConfigHandler) GetDefaultStatusModel(c *gin.Context) {
+	ctx := c.Request.Context()
 	entityTypeParam := c.Param("entity_type")
 
-	statusModel, err := h.configService.GetDefaultStatusModelByEntityType(models.EntityType(entityTypeParam))
+	statusModel, err := h.configService.GetDefaultStatusModelByEntityType(ctx, models.EntityType(entityTypeParam))
 	if err != nil {
 		if errors.Is(err, service.ErrStatusModelNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -837,13 +861,14 @@ func (h *ConfigHandler) GetDefaultStatusModel(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Param			status	body		service.CreateStatusRequest	true	"Status creation request"
 //	@Success		201		{object}	models.Status				"Successfully created status"
-//	@Failure		400		{object}	ErrorResponse				"Invalid request body, validation error, or status model not found"
+//	@Failure		400		{object}	ErrorResponse				"Invalid request body, validation error, invalid color, or status model not found"
 //	@Failure		401		{object}	ErrorResponse				"Authentication required"
 //	@Failure		403		{object}	ErrorResponse				"Administrator role required"
-//	@Failure		409		{object}	ErrorResponse				"Status name already exists in this model"
+//	@Failure		409		{object}	ErrorResponse				"Status name already exists in this model, or first status of the model not initial"
 //	@Failure		500		{object}	ErrorResponse				"Internal server error"
 //	@Router			/api/v1/config/statuses [post]
 func (h *ConfigHandler) CreateStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateStatusRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -853,7 +878,7 @@ func (h *ConfigHandler) CreateStatus(c *gin.Context) {
 		return
 	}
 
-	status, err := h.configService.CreateStatus(req)
+	status, err := h.configService.CreateStatus(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusModelNotFound):
@@ -864,6 +889,14 @@ func (h *ConfigHandler) CreateStatus(c *gin.Context) {
 			c.JSON(http.StatusConflict, gin.H{
 				"error": "Status name already exists in this model",
 			})
+		case errors.Is(err, service.ErrInvalidStatusColor):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		case errors.Is(err, service.ErrNoInitialStatus):
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to create status",
@@ -891,6 +924,7 @@ func (h *ConfigHandler) CreateStatus(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse	"Internal server error"
 //	@Router			/api/v1/config/statuses/{id} [get]
 func (h *ConfigHandler) GetStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -901,7 +935,7 @@ func (h *ConfigHandler) GetStatus(c *gin.Context) {
 		return
 	}
 
-	status, err := h.configService.GetStatusByID(id)
+	status, err := h.configService.GetStatusByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrStatusNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -937,6 +971,7 @@ func (h *ConfigHandler) GetStatus(c *gin.Context) {
 //	@Failure		500		{object}	ErrorResponse				"Internal server error"
 //	@Router			/api/v1/config/statuses/{id} [put]
 func (h *ConfigHandler) UpdateStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -956,7 +991,7 @@ func (h *ConfigHandler) UpdateStatus(c *gin.Context) {
 		return
 	}
 
-	status, err := h.configService.UpdateStatus(id, req)
+	status, err := h.configService.UpdateStatus(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusNotFound):
@@ -967,6 +1002,14 @@ func (h *ConfigHandler) UpdateStatus(c *gin.Context) {
 			c.JSON(http.StatusConflict, gin.H{
 				"error": "Status name already exists in this model",
 			})
+		case errors.Is(err, service.ErrInvalidStatusColor):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		case errors.Is(err, service.ErrNoInitialStatus):
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to update status",
@@ -996,6 +1039,7 @@ func (h *ConfigHandler) UpdateStatus(c *gin.Context) {
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/api/v1/config/statuses/{id} [delete]
 func (h *ConfigHandler) DeleteStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -1009,13 +1053,17 @@ func (h *ConfigHandler) DeleteStatus(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.configService.DeleteStatus(id, force)
+	err = h.configService.DeleteStatus(ctx, id, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusNotFound):
 			c.JSON(http.StatusNotFound, gin.H{
 				"error": "Status not found",
 			})
+		case errors.Is(err, service.ErrNoInitialStatus):
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error": "Failed to delete status",
@@ -1042,6 +1090,7 @@ func (h *ConfigHandler) DeleteStatus(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse		"Internal server error"
 //	@Router			/api/v1/config/status-models/{id}/statuses [get]
 func (h *ConfigHandler) ListStatusesByModel(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -1052,7 +1101,7 @@ func (h *ConfigHandler) ListStatusesByModel(c *gin.Context) {
 		return
 	}
 
-	statuses, totalCount, err := h.configService.ListStatusesByModel(id)
+	statuses, totalCount, err := h.configService.ListStatusesByModel(ctx, id)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to list statuses",
@@ -1085,6 +1134,7 @@ func (h *ConfigHandler) ListStatusesByModel(c *gin.Context) {
 //	@Failure		500			{object}	ErrorResponse							"Internal server error"
 //	@Router			/api/v1/config/status-transitions [post]
 func (h *ConfigHandler) CreateStatusTransition(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateStatusTransitionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -1094,7 +1144,7 @@ func (h *ConfigHandler) CreateStatusTransition(c *gin.Context) {
 		return
 	}
 
-	transition, err := h.configService.CreateStatusTransition(req)
+	transition, err := h.configService.CreateStatusTransition(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusModelNotFound):
@@ -1140,6 +1190,7 @@ func (h *ConfigHandler) CreateStatusTransition(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse			"Internal server error"
 //	@Router			/api/v1/config/status-transitions/{id} [get]
 func (h *ConfigHandler) GetStatusTransition(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -1150,7 +1201,7 @@ func (h *ConfigHandler) GetStatusTransition(c *gin.Context) {
 		return
 	}
 
-	transition, err := h.configService.GetStatusTransitionByID(id)
+	transition, err := h.configService.GetStatusTransitionByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrStatusTransitionNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -1185,6 +1236,7 @@ func (h *ConfigHandler) GetStatusTransition(c *gin.Context) {
 //	@Failure		500			{object}	ErrorResponse							"Internal server error"
 //	@Router			/api/v1/config/status-transitions/{id} [put]
 func (h *ConfigHandler) UpdateStatusTransition(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -1204,7 +1256,7 @@ func (h *ConfigHandler) UpdateStatusTransition(c *gin.Context) {
 		return
 	}
 
-	transition, err := h.configService.UpdateStatusTransition(id, req)
+	transition, err := h.configService.UpdateStatusTransition(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusTransitionNotFound):
@@ -1239,6 +1291,7 @@ func (h *ConfigHandler) UpdateStatusTransition(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse	"Internal server error"
 //	@Router			/api/v1/config/status-transitions/{id} [delete]
 func (h *ConfigHandler) DeleteStatusTransition(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -1249,7 +1302,7 @@ func (h *ConfigHandler) DeleteStatusTransition(c *gin.Context) {
 		return
 	}
 
-	err = h.configService.DeleteStatusTransition(id)
+	err = h.configService.DeleteStatusTransition(ctx, id)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrStatusTransitionNotFound):
@@ -1282,6 +1335,7 @@ func (h *ConfigHandler) DeleteStatusTransition(c *gin.Context) {
 //	@Failure		500	{object}	ErrorResponse					"Internal server error"
 //	@Router			/api/v1/config/status-models/{id}/transitions [get]
 func (h *ConfigHandler) ListStatusTransitionsByModel(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	id, err := uuid.Parse(idParam)
@@ -1292,7 +1346,7 @@ func (h *ConfigHandler) ListStatusTransitionsByModel(c *gin.Context) {
 		return
 	}
 
-	transitions, totalCount, err := h.configService.ListStatusTransitionsByModel(id)
+	transitions, totalCount, err := h.configService.ListStatusTransitionsByModel(ctx, id)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to list status transitions",
@@ -1305,3 +1359,399 @@ func (h *ConfigHandler) ListStatusTransitionsByModel(c *gin.Context) {
 	offset := 0
 	SendListResponse(c, transitions, totalCount, limit, offset)
 }
+
+// Status model sub-resource handlers
+
+// CreateModelStatus handles POST /api/v1/config/status-models/:id/statuses
+//
+//	@Summary		Add a status to a status model
+//	@Description	Creates a status within the status model in the path. The first status of a model must be initial, and color must be a #rrggbb hex color code. Requires Administrator role.
+//	@Tags			configuration
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string								true	"Status model ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174000")
+//	@Param			status	body		service.CreateModelStatusRequest	true	"Status creation request"
+//	@Success		201		{object}	models.Status						"Successfully created status"
+//	@Failure		400		{object}	ErrorResponse						"Invalid request body, UUID format or color"
+//	@Failure		401		{object}	ErrorResponse						"Authentication required"
+//	@Failure		403		{object}	ErrorResponse						"Administrator role required"
+//	@Failure		404		{object}	ErrorResponse						"Status model not found"
+//	@Failure		409		{object}	ErrorResponse						"Status name already exists in this model, or first status of the model not initial"
+//	@Failure		500		{object}	ErrorResponse						"Internal server error"
+//	@Router			/api/v1/config/status-models/{id}/statuses [post]
+func (h *ConfigHandler) CreateModelStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	modelID, ok := parseStatusModelID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateModelStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	status, err := h.configService.CreateStatus(ctx, service.CreateStatusRequest{
+		StatusModelID: modelID,
+		Name:          req.Name,
+		Description:   req.Description,
+		Color:         req.Color,
+		IsInitial:     req.IsInitial,
+		IsFinal:       req.IsFinal,
+		Order:         req.Order,
+	})
+	if err != nil {
+		handleStatusModelEditorError(c, err, "Failed to create status")
+		return
+	}
+
+	c.JSON(http.StatusCreated, status)
+}
+
+// UpdateModelStatus handles PUT /api/v1/config/status-models/:id/statuses/:status_id
+//
+//	@Summary		Update a status of a status model
+//	@Description	Updates a status of the status model in the path. Only provided fields will be updated. The last initial status of a model cannot be unset. Requires Administrator role.
+//	@Tags			configuration
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string						true	"Status model ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174000")
+//	@Param			status_id	path		string						true	"Status ID (UUID)"			example("123e4567-e89b-12d3-a456-426614174001")
+//	@Param			status		body		service.UpdateStatusRequest	true	"Status update request"
+//	@Success		200			{object}	models.Status				"Successfully updated status"
+//	@Failure		400			{object}	ErrorResponse				"Invalid request body, UUID format or color"
+//	@Failure		401			{object}	ErrorResponse				"Authentication required"
+//	@Failure		403			{object}	ErrorResponse				"Administrator role required"
+//	@Failure		404			{object}	ErrorResponse				"Status not found in this status model"
+//	@Failure		409			{object}	ErrorResponse				"Status name already exists, or the status is the model's last initial status"
+//	@Failure		500			{object}	ErrorResponse				"Internal server error"
+//	@Router			/api/v1/config/status-models/{id}/statuses/{status_id} [put]
+func (h *ConfigHandler) UpdateModelStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	status, ok := h.loadModelStatus(c)
+	if !ok {
+		return
+	}
+
+	var req service.UpdateStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updated, err := h.configService.UpdateStatus(ctx, status.ID, req)
+	if err != nil {
+		handleStatusModelEditorError(c, err, "Failed to update status")
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteModelStatus handles DELETE /api/v1/config/status-models/:id/statuses/:status_id
+//
+//	@Summary		Delete a status of a status model
+//	@Description	Deletes a status of the status model in the path together with its transitions. The last initial status can only be deleted once it is the model's only status. Requires Administrator role.
+//	@Tags			configuration
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path	string	true	"Status model ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174000")
+//	@Param			status_id	path	string	true	"Status ID (UUID)"			example("123e4567-e89b-12d3-a456-426614174001")
+//	@Success		204			"Successfully deleted status (no content)"
+//	@Failure		400			{object}	ErrorResponse	"Invalid UUID format"
+//	@Failure		401			{object}	ErrorResponse	"Authentication required"
+//	@Failure		403			{object}	ErrorResponse	"Administrator role required"
+//	@Failure		404			{object}	ErrorResponse	"Status not found in this status model"
+//	@Failure		409			{object}	ErrorResponse	"Status is the model's last initial status"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/api/v1/config/status-models/{id}/statuses/{status_id} [delete]
+func (h *ConfigHandler) DeleteModelStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	status, ok := h.loadModelStatus(c)
+	if !ok {
+		return
+	}
+
+	if err := h.configService.DeleteStatus(ctx, status.ID, false); err != nil {
+		handleStatusModelEditorError(c, err, "Failed to delete status")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// ReorderModelStatuses handles PUT /api/v1/config/status-models/:id/statuses/order
+//
+//	@Summary		Reorder the statuses of a status model
+//	@Description	Sets the display order of a status model's statuses to their position in status_ids, which must list every status of the model exactly once. Requires Administrator role.
+//	@Tags			configuration
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Status model ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174000")
+//	@Param			order	body		service.ReorderStatusesRequest	true	"Status IDs in display order"
+//	@Success		200		{object}	StatusListResponse				"Statuses in their new order"
+//	@Failure		400		{object}	ErrorResponse					"Invalid request body or UUID format, or status_ids not listing every status once"
+//	@Failure		401		{object}	ErrorResponse					"Authentication required"
+//	@Failure		403		{object}	ErrorResponse					"Administrator role required"
+//	@Failure		404		{object}	ErrorResponse					"Status model not found"
+//	@Failure		500		{object}	ErrorResponse					"Internal server error"
+//	@Router			/api/v1/config/status-models/{id}/statuses/order [put]
+func (h *ConfigHandler) ReorderModelStatuses(c *gin.Context) {
+	ctx := c.Request.Context()
+	modelID, ok := parseStatusModelID(c)
+	if !ok {
+		return
+	}
+
+	var req service.ReorderStatusesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	statuses, err := h.configService.ReorderStatuses(ctx, modelID, req.StatusIDs)
+	if err != nil {
+		handleStatusModelEditorError(c, err, "Failed to reorder statuses")
+		return
+	}
+
+	SendListResponse(c, statuses, int64(len(statuses)), len(statuses), 0)
+}
+
+// CreateModelStatusTransition handles POST /api/v1/config/status-models/:id/transitions
+//
+//	@Summary		Add a transition to a status model
+//	@Description	Creates a transition between two different statuses of the status model in the path. Requires Administrator role.
+//	@Tags			configuration
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string										true	"Status model ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174000")
+//	@Param			transition	body		service.CreateModelStatusTransitionRequest	true	"Status transition creation request"
+//	@Success		201			{object}	models.StatusTransition						"Successfully created status transition"
+//	@Failure		400			{object}	ErrorResponse								"Invalid request body or UUID format, or statuses not belonging to the model"
+//	@Failure		401			{object}	ErrorResponse								"Authentication required"
+//	@Failure		403			{object}	ErrorResponse								"Administrator role required"
+//	@Failure		404			{object}	ErrorResponse								"Status model not found"
+//	@Failure		409			{object}	ErrorResponse								"Status transition already exists"
+//	@Failure		500			{object}	ErrorResponse								"Internal server error"
+//	@Router			/api/v1/config/status-models/{id}/transitions [post]
+func (h *ConfigHandler) CreateModelStatusTransition(c *gin.Context) {
+	ctx := c.Request.Context()
+	modelID, ok := parseStatusModelID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateModelStatusTransitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	transition, err := h.configService.CreateStatusTransition(ctx, service.CreateStatusTransitionRequest{
+		StatusModelID: modelID,
+		FromStatusID:  req.FromStatusID,
+		ToStatusID:    req.ToStatusID,
+		Name:          req.Name,
+		Description:   req.Description,
+	})
+	if err != nil {
+		handleStatusModelEditorError(c, err, "Failed to create status transition")
+		return
+	}
+
+	c.JSON(http.StatusCreated, transition)
+}
+
+// UpdateModelStatusTransition handles PUT /api/v1/config/status-models/:id/transitions/:transition_id
+//
+//	@Summary		Update a transition of a status model
+//	@Description	Updates the name and description of a transition of the status model in the path. Requires Administrator role.
+//	@Tags			configuration
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id				path		string									true	"Status model ID (UUID)"		example("123e4567-e89b-12d3-a456-426614174000")
+//	@Param			transition_id	path		string									true	"Status transition ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174001")
+//	@Param			transition		body		service.UpdateStatusTransitionRequest	true	"Status transition update request"
+//	@Success		200				{object}	models.StatusTransition					"Successfully updated status transition"
+//	@Failure		400				{object}	ErrorResponse							"Invalid request body or UUID format"
+//	@Failure		401				{object}	ErrorResponse							"Authentication required"
+//	@Failure		403				{object}	ErrorResponse							"Administrator role required"
+//	@Failure		404				{object}	ErrorResponse							"Status transition not found in this status model"
+//	@Failure		500				{object}	ErrorResponse							"Internal server error"
+//	@Router			/api/v1/config/status-models/{id}/transitions/{transition_id} [put]
+func (h *ConfigHandler) UpdateModelStatusTransition(c *gin.Context) {
+	ctx := c.Request.Context()
+	transition, ok := h.loadModelStatusTransition(c)
+	if !ok {
+		return
+	}
+
+	var req service.UpdateStatusTransitionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	updated, err := h.configService.UpdateStatusTransition(ctx, transition.ID, req)
+	if err != nil {
+		handleStatusModelEditorError(c, err, "Failed to update status transition")
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteModelStatusTransition handles DELETE /api/v1/config/status-models/:id/transitions/:transition_id
+//
+//	@Summary		Delete a transition of a status model
+//	@Description	Deletes a transition of the status model in the path. Requires Administrator role.
+//	@Tags			configuration
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id				path	string	true	"Status model ID (UUID)"		example("123e4567-e89b-12d3-a456-426614174000")
+//	@Param			transition_id	path	string	true	"Status transition ID (UUID)"	example("123e4567-e89b-12d3-a456-426614174001")
+//	@Success		204				"Successfully deleted status transition (no content)"
+//	@Failure		400				{object}	ErrorResponse	"Invalid UUID format"
+//	@Failure		401				{object}	ErrorResponse	"Authentication required"
+//	@Failure		403				{object}	ErrorResponse	"Administrator role required"
+//	@Failure		404				{object}	ErrorResponse	"Status transition not found in this status model"
+//	@Failure		500				{object}	ErrorResponse	"Internal server error"
+//	@Router			/api/v1/config/status-models/{id}/transitions/{transition_id} [delete]
+func (h *ConfigHandler) DeleteModelStatusTransition(c *gin.Context) {
+	ctx := c.Request.Context()
+	transition, ok := h.loadModelStatusTransition(c)
+	if !ok {
+		return
+	}
+
+	if err := h.configService.DeleteStatusTransition(ctx, transition.ID); err != nil {
+		handleStatusModelEditorError(c, err, "Failed to delete status transition")
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// parseStatusModelID parses the status model ID path parameter, writing a 400 response when it is invalid
+func parseStatusModelID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid status model ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// loadModelStatus loads the status named by the status_id path parameter, answering 404 when it
+// belongs to another status model than the one in the path
+func (h *ConfigHandler) loadModelStatus(c *gin.Context) (*models.Status, bool) {
+	ctx := c.Request.Context()
+	modelID, ok := parseStatusModelID(c)
+	if !ok {
+		return nil, false
+	}
+	statusID, err := uuid.Parse(c.Param("status_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid status ID format",
+		})
+		return nil, false
+	}
+
+	status, err := h.configService.GetStatusByID(ctx, statusID)
+	if err == nil && status.StatusModelID != modelID {
+		err = service.ErrStatusNotFound
+	}
+	if err != nil {
+		handleStatusModelEditorError(c, err, "Failed to get status")
+		return nil, false
+	}
+	return status, true
+}
+
+// loadModelStatusTransition loads the transition named by the transition_id path parameter, answering
+// 404 when it belongs to another status model than the one in the path
+func (h *ConfigHandler) loadModelStatusTransition(c *gin.Context) (*models.StatusTransition, bool) {
+	ctx := c.Request.Context()
+	modelID, ok := parseStatusModelID(c)
+	if !ok {
+		return nil, false
+	}
+	transitionID, err := uuid.Parse(c.Param("transition_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid status transition ID format",
+		})
+		return nil, false
+	}
+
+	transition, err := h.configService.GetStatusTransitionByID(ctx, transitionID)
+	if err == nil && transition.StatusModelID != modelID {
+		err = service.ErrStatusTransitionNotFound
+	}
+	if err != nil {
+		handleStatusModelEditorError(c, err, "Failed to get status transition")
+		return nil, false
+	}
+	return transition, true
+}
+
+// handleStatusModelEditorError maps status and transition errors of the status model sub-resources to HTTP responses
+func handleStatusModelEditorError(c *gin.Context, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, service.ErrStatusModelNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Status model not found",
+		})
+	case errors.Is(err, service.ErrStatusNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Status not found",
+		})
+	case errors.Is(err, service.ErrStatusTransitionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Status transition not found",
+		})
+	case errors.Is(err, service.ErrStatusNameExists),
+		errors.Is(err, service.ErrTransitionExists),
+		errors.Is(err, service.ErrNoInitialStatus):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+	case errors.Is(err, service.ErrInvalidStatusColor),
+		errors.Is(err, service.ErrInvalidStatusOrder),
+		errors.Is(err, service.ErrInvalidStatusTransition):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": fallbackMessage,
+		})
+	}
+}
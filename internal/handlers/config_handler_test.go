@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -21,170 +22,175 @@ type MockConfigService struct {
 	mock.Mock
 }
 
-func (m *MockConfigService) CreateRequirementType(req service.CreateRequirementTypeRequest) (*models.RequirementType, error) {
+func (m *MockConfigService) CreateRequirementType(ctx context.Context, req service.CreateRequirementTypeRequest) (*models.RequirementType, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigService) GetRequirementTypeByID(id uuid.UUID) (*models.RequirementType, error) {
+func (m *MockConfigService) GetRequirementTypeByID(ctx context.Context, id uuid.UUID) (*models.RequirementType, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigService) GetRequirementTypeByName(name string) (*models.RequirementType, error) {
+func (m *MockConfigService) GetRequirementTypeByName(ctx context.Context, name string) (*models.RequirementType, error) {
 	args := m.Called(name)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigService) UpdateRequirementType(id uuid.UUID, req service.UpdateRequirementTypeRequest) (*models.RequirementType, error) {
+func (m *MockConfigService) UpdateRequirementType(ctx context.Context, id uuid.UUID, req service.UpdateRequirementTypeRequest) (*models.RequirementType, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigService) DeleteRequirementType(id uuid.UUID, force bool) error {
+func (m *MockConfigService) DeleteRequirementType(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigService) ListRequirementTypes(filters service.RequirementTypeFilters) ([]models.RequirementType, int64, error) {
+func (m *MockConfigService) ListRequirementTypes(ctx context.Context, filters service.RequirementTypeFilters) ([]models.RequirementType, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.RequirementType), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigService) CreateRelationshipType(req service.CreateRelationshipTypeRequest) (*models.RelationshipType, error) {
+func (m *MockConfigService) CreateRelationshipType(ctx context.Context, req service.CreateRelationshipTypeRequest) (*models.RelationshipType, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigService) GetRelationshipTypeByID(id uuid.UUID) (*models.RelationshipType, error) {
+func (m *MockConfigService) GetRelationshipTypeByID(ctx context.Context, id uuid.UUID) (*models.RelationshipType, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigService) GetRelationshipTypeByName(name string) (*models.RelationshipType, error) {
+func (m *MockConfigService) GetRelationshipTypeByName(ctx context.Context, name string) (*models.RelationshipType, error) {
 	args := m.Called(name)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigService) UpdateRelationshipType(id uuid.UUID, req service.UpdateRelationshipTypeRequest) (*models.RelationshipType, error) {
+func (m *MockConfigService) UpdateRelationshipType(ctx context.Context, id uuid.UUID, req service.UpdateRelationshipTypeRequest) (*models.RelationshipType, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigService) DeleteRelationshipType(id uuid.UUID, force bool) error {
+func (m *MockConfigService) DeleteRelationshipType(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigService) ListRelationshipTypes(filters service.RelationshipTypeFilters) ([]models.RelationshipType, int64, error) {
+func (m *MockConfigService) ListRelationshipTypes(ctx context.Context, filters service.RelationshipTypeFilters) ([]models.RelationshipType, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.RelationshipType), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigService) ValidateRequirementType(typeID uuid.UUID) error {
+func (m *MockConfigService) ValidateRequirementType(ctx context.Context, typeID uuid.UUID) error {
 	args := m.Called(typeID)
 	return args.Error(0)
 }
 
-func (m *MockConfigService) ValidateRelationshipType(typeID uuid.UUID) error {
+func (m *MockConfigService) ValidateRelationshipType(ctx context.Context, typeID uuid.UUID) error {
 	args := m.Called(typeID)
 	return args.Error(0)
 }
 
 // Status Model methods
-func (m *MockConfigService) CreateStatusModel(req service.CreateStatusModelRequest) (*models.StatusModel, error) {
+func (m *MockConfigService) CreateStatusModel(ctx context.Context, req service.CreateStatusModelRequest) (*models.StatusModel, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigService) GetStatusModelByID(id uuid.UUID) (*models.StatusModel, error) {
+func (m *MockConfigService) GetStatusModelByID(ctx context.Context, id uuid.UUID) (*models.StatusModel, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigService) GetStatusModelByEntityTypeAndName(entityType models.EntityType, name string) (*models.StatusModel, error) {
+func (m *MockConfigService) GetStatusModelByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (*models.StatusModel, error) {
 	args := m.Called(entityType, name)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigService) GetDefaultStatusModelByEntityType(entityType models.EntityType) (*models.StatusModel, error) {
+func (m *MockConfigService) GetDefaultStatusModelByEntityType(ctx context.Context, entityType models.EntityType) (*models.StatusModel, error) {
 	args := m.Called(entityType)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigService) UpdateStatusModel(id uuid.UUID, req service.UpdateStatusModelRequest) (*models.StatusModel, error) {
+func (m *MockConfigService) UpdateStatusModel(ctx context.Context, id uuid.UUID, req service.UpdateStatusModelRequest) (*models.StatusModel, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigService) DeleteStatusModel(id uuid.UUID, force bool) error {
+func (m *MockConfigService) DeleteStatusModel(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigService) ListStatusModels(filters service.StatusModelFilters) ([]models.StatusModel, int64, error) {
+func (m *MockConfigService) ListStatusModels(ctx context.Context, filters service.StatusModelFilters) ([]models.StatusModel, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.StatusModel), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigService) ListStatusModelsByEntityType(entityType models.EntityType) ([]models.StatusModel, error) {
+func (m *MockConfigService) ListStatusModelsByEntityType(ctx context.Context, entityType models.EntityType) ([]models.StatusModel, error) {
 	args := m.Called(entityType)
 	return args.Get(0).([]models.StatusModel), args.Error(1)
 }
 
 // Status methods
-func (m *MockConfigService) CreateStatus(req service.CreateStatusRequest) (*models.Status, error) {
+func (m *MockConfigService) CreateStatus(ctx context.Context, req service.CreateStatusRequest) (*models.Status, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockConfigService) GetStatusByID(id uuid.UUID) (*models.Status, error) {
+func (m *MockConfigService) GetStatusByID(ctx context.Context, id uuid.UUID) (*models.Status, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockConfigService) UpdateStatus(id uuid.UUID, req service.UpdateStatusRequest) (*models.Status, error) {
+func (m *MockConfigService) UpdateStatus(ctx context.Context, id uuid.UUID, req service.UpdateStatusRequest) (*models.Status, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockConfigService) DeleteStatus(id uuid.UUID, force bool) error {
+func (m *MockConfigService) DeleteStatus(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigService) ListStatusesByModel(statusModelID uuid.UUID) ([]models.Status, int64, error) {
+func (m *MockConfigService) ListStatusesByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.Status, int64, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).([]models.Status), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockConfigService) ReorderStatuses(ctx context.Context, statusModelID uuid.UUID, statusIDs []uuid.UUID) ([]models.Status, error) {
+	args := m.Called(statusModelID, statusIDs)
+	return args.Get(0).([]models.Status), args.Error(1)
+}
+
 // Status Transition methods
-func (m *MockConfigService) CreateStatusTransition(req service.CreateStatusTransitionRequest) (*models.StatusTransition, error) {
+func (m *MockConfigService) CreateStatusTransition(ctx context.Context, req service.CreateStatusTransitionRequest) (*models.StatusTransition, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.StatusTransition), args.Error(1)
 }
 
-func (m *MockConfigService) GetStatusTransitionByID(id uuid.UUID) (*models.StatusTransition, error) {
+func (m *MockConfigService) GetStatusTransitionByID(ctx context.Context, id uuid.UUID) (*models.StatusTransition, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.StatusTransition), args.Error(1)
 }
 
-func (m *MockConfigService) UpdateStatusTransition(id uuid.UUID, req service.UpdateStatusTransitionRequest) (*models.StatusTransition, error) {
+func (m *MockConfigService) UpdateStatusTransition(ctx context.Context, id uuid.UUID, req service.UpdateStatusTransitionRequest) (*models.StatusTransition, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.StatusTransition), args.Error(1)
 }
 
-func (m *MockConfigService) DeleteStatusTransition(id uuid.UUID) error {
+func (m *MockConfigService) DeleteStatusTransition(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockConfigService) ListStatusTransitionsByModel(statusModelID uuid.UUID) ([]models.StatusTransition, int64, error) {
+func (m *MockConfigService) ListStatusTransitionsByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.StatusTransition, int64, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).([]models.StatusTransition), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigService) ValidateStatusTransition(entityType models.EntityType, fromStatus, toStatus string) error {
+func (m *MockConfigService) ValidateStatusTransition(ctx context.Context, entityType models.EntityType, fromStatus, toStatus string) error {
 	args := m.Called(entityType, fromStatus, toStatus)
 	return args.Error(0)
 }
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// CoverageHandler handles HTTP requests for coverage reports
+type CoverageHandler struct {
+	coverageService service.CoverageService
+}
+
+// NewCoverageHandler creates a new coverage handler instance
+func NewCoverageHandler(coverageService service.CoverageService) *CoverageHandler {
+	return &CoverageHandler{
+		coverageService: coverageService,
+	}
+}
+
+// GetEpicCoverage handles GET /api/v1/epics/:id/coverage
+// @Summary Get an epic's coverage report
+// @Description Report the user stories without acceptance criteria or requirements, the acceptance criteria without requirements and the requirements without acceptance criteria of an epic. Obsolete requirements are left out of the counts. Entities of the epic that still depend_on an obsolete requirement are reported as DEPENDS_ON_OBSOLETE warnings naming the requirement that superseded it.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Success 200 {object} service.CoverageReport "Coverage report"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/coverage [get]
+func (h *CoverageHandler) GetEpicCoverage(c *gin.Context) {
+	ctx := c.Request.Context()
+	report, err := h.coverageService.GetEpicCoverage(ctx, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to build coverage report")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
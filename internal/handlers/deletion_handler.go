@@ -60,6 +60,7 @@ type DeleteRequirementRequest struct {
 //	@Security		BearerAuth
 //	@Router			/api/epics/{id}/validate-deletion [get]
 func (h *DeletionHandler) ValidateEpicDeletion(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -83,7 +84,7 @@ func (h *DeletionHandler) ValidateEpicDeletion(c *gin.Context) {
 		"action":  "validate_deletion",
 	}).Info("Validating epic deletion")
 
-	depInfo, err := h.deletionService.ValidateEpicDeletion(epicID)
+	depInfo, err := h.deletionService.ValidateEpicDeletion(ctx, epicID)
 	if err != nil {
 		if err == service.ErrEpicNotFound {
 			c.JSON(http.StatusNotFound, ErrorResponse{
@@ -130,6 +131,7 @@ func (h *DeletionHandler) ValidateEpicDeletion(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/epics/{id}/delete [delete]
 func (h *DeletionHandler) DeleteEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first
@@ -182,7 +184,7 @@ func (h *DeletionHandler) DeleteEpic(c *gin.Context) {
 		"action":  "delete",
 	}).Info("Deleting epic")
 
-	result, err := h.deletionService.DeleteEpicWithValidation(epicID, userUUID, req.Force)
+	result, err := h.deletionService.DeleteEpicWithValidation(ctx, epicID, userUUID, req.Force)
 	if err != nil {
 		switch err {
 		case service.ErrEpicNotFound:
@@ -247,6 +249,7 @@ func (h *DeletionHandler) DeleteEpic(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/user-stories/{id}/validate-deletion [get]
 func (h *DeletionHandler) ValidateUserStoryDeletion(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	var userStoryID uuid.UUID
@@ -267,7 +270,7 @@ func (h *DeletionHandler) ValidateUserStoryDeletion(c *gin.Context) {
 		"action":        "validate_deletion",
 	}).Info("Validating user story deletion")
 
-	depInfo, err := h.deletionService.ValidateUserStoryDeletion(userStoryID)
+	depInfo, err := h.deletionService.ValidateUserStoryDeletion(ctx, userStoryID)
 	if err != nil {
 		if err == service.ErrUserStoryNotFound {
 			c.JSON(http.StatusNotFound, ErrorResponse{
@@ -314,6 +317,7 @@ func (h *DeletionHandler) ValidateUserStoryDeletion(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/user-stories/{id}/delete [delete]
 func (h *DeletionHandler) DeleteUserStory(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	var userStoryID uuid.UUID
@@ -364,7 +368,7 @@ func (h *DeletionHandler) DeleteUserStory(c *gin.Context) {
 		"action":        "delete",
 	}).Info("Deleting user story")
 
-	result, err := h.deletionService.DeleteUserStoryWithValidation(userStoryID, userUUID, req.Force)
+	result, err := h.deletionService.DeleteUserStoryWithValidation(ctx, userStoryID, userUUID, req.Force)
 	if err != nil {
 		switch err {
 		case service.ErrUserStoryNotFound:
@@ -429,6 +433,7 @@ func (h *DeletionHandler) DeleteUserStory(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/acceptance-criteria/{id}/validate-deletion [get]
 func (h *DeletionHandler) ValidateAcceptanceCriteriaDeletion(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	var acceptanceCriteriaID uuid.UUID
@@ -449,7 +454,7 @@ func (h *DeletionHandler) ValidateAcceptanceCriteriaDeletion(c *gin.Context) {
 		"action":                 "validate_deletion",
 	}).Info("Validating acceptance criteria deletion")
 
-	depInfo, err := h.deletionService.ValidateAcceptanceCriteriaDeletion(acceptanceCriteriaID)
+	depInfo, err := h.deletionService.ValidateAcceptanceCriteriaDeletion(ctx, acceptanceCriteriaID)
 	if err != nil {
 		if err == service.ErrAcceptanceCriteriaNotFound {
 			c.JSON(http.StatusNotFound, ErrorResponse{
@@ -496,6 +501,7 @@ func (h *DeletionHandler) ValidateAcceptanceCriteriaDeletion(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/acceptance-criteria/{id}/delete [delete]
 func (h *DeletionHandler) DeleteAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	var acceptanceCriteriaID uuid.UUID
@@ -546,7 +552,7 @@ func (h *DeletionHandler) DeleteAcceptanceCriteria(c *gin.Context) {
 		"action":                 "delete",
 	}).Info("Deleting acceptance criteria")
 
-	result, err := h.deletionService.DeleteAcceptanceCriteriaWithValidation(acceptanceCriteriaID, userUUID, req.Force)
+	result, err := h.deletionService.DeleteAcceptanceCriteriaWithValidation(ctx, acceptanceCriteriaID, userUUID, req.Force)
 	if err != nil {
 		switch err {
 		case service.ErrAcceptanceCriteriaNotFound:
@@ -611,6 +617,7 @@ func (h *DeletionHandler) DeleteAcceptanceCriteria(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/requirements/{id}/validate-deletion [get]
 func (h *DeletionHandler) ValidateRequirementDeletion(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	var requirementID uuid.UUID
@@ -631,7 +638,7 @@ func (h *DeletionHandler) ValidateRequirementDeletion(c *gin.Context) {
 		"action":         "validate_deletion",
 	}).Info("Validating requirement deletion")
 
-	depInfo, err := h.deletionService.ValidateRequirementDeletion(requirementID)
+	depInfo, err := h.deletionService.ValidateRequirementDeletion(ctx, requirementID)
 	if err != nil {
 		if err == service.ErrRequirementNotFound {
 			c.JSON(http.StatusNotFound, ErrorResponse{
@@ -678,6 +685,7 @@ func (h *DeletionHandler) ValidateRequirementDeletion(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/requirements/{id}/delete [delete]
 func (h *DeletionHandler) DeleteRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	var requirementID uuid.UUID
@@ -728,7 +736,7 @@ func (h *DeletionHandler) DeleteRequirement(c *gin.Context) {
 		"action":         "delete",
 	}).Info("Deleting requirement")
 
-	result, err := h.deletionService.DeleteRequirementWithValidation(requirementID, userUUID, req.Force)
+	result, err := h.deletionService.DeleteRequirementWithValidation(ctx, requirementID, userUUID, req.Force)
 	if err != nil {
 		switch err {
 		case service.ErrRequirementNotFound:
@@ -794,6 +802,7 @@ func (h *DeletionHandler) DeleteRequirement(c *gin.Context) {
 //	@Security		BearerAuth
 //	@Router			/api/deletion/confirm [get]
 func (h *DeletionHandler) GetDeletionConfirmation(c *gin.Context) {
+	ctx := c.Request.Context()
 	entityType := c.Query("entity_type")
 	idParam := c.Query("id")
 
@@ -828,13 +837,13 @@ func (h *DeletionHandler) GetDeletionConfirmation(c *gin.Context) {
 
 	switch entityType {
 	case "epic":
-		depInfo, err = h.deletionService.ValidateEpicDeletion(entityID)
+		depInfo, err = h.deletionService.ValidateEpicDeletion(ctx, entityID)
 	case "user_story":
-		depInfo, err = h.deletionService.ValidateUserStoryDeletion(entityID)
+		depInfo, err = h.deletionService.ValidateUserStoryDeletion(ctx, entityID)
 	case "acceptance_criteria":
-		depInfo, err = h.deletionService.ValidateAcceptanceCriteriaDeletion(entityID)
+		depInfo, err = h.deletionService.ValidateAcceptanceCriteriaDeletion(ctx, entityID)
 	case "requirement":
-		depInfo, err = h.deletionService.ValidateRequirementDeletion(entityID)
+		depInfo, err = h.deletionService.ValidateRequirementDeletion(ctx, entityID)
 	default:
 		c.JSON(http.StatusBadRequest, ErrorResponse{
 			Error: ErrorDetail{
@@ -875,13 +884,42 @@ func (h *DeletionHandler) GetDeletionConfirmation(c *gin.Context) {
 	c.JSON(http.StatusOK, depInfo)
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error ErrorDetail `json:"error"`
-}
+// GetDeletionImpact returns the impact tree of deleting an entity
+//
+//	@Summary		Preview deletion impact
+//	@Description	Returns every descendant, relationship and comment that deleting the entity would remove, and the requirements it would unlink, with totals and the cascade depth. The schema is the same for all entity types.
+//	@Tags			deletion
+//	@Accept		json
+//	@Produce		json
+//	@Param			id	path		string	true	"Entity UUID or reference ID"
+//	@Success		200	{object}	service.DeletionImpact
+//	@Failure		400	{object}	ErrorResponse
+//	@Failure		401	{object}	ErrorResponse
+//	@Failure		404	{object}	ErrorResponse
+//	@Failure		500	{object}	ErrorResponse
+//	@Security		BearerAuth
+//	@Router			/api/v1/epics/{id}/deletion-impact [get]
+//	@Router			/api/v1/user-stories/{id}/deletion-impact [get]
+//	@Router			/api/v1/acceptance-criteria/{id}/deletion-impact [get]
+//	@Router			/api/v1/requirements/{id}/deletion-impact [get]
+func (h *DeletionHandler) GetDeletionImpact(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, ok := entityTypeFromPath(c.FullPath())
+	if !ok {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "INVALID_ENTITY_TYPE",
+				Message: "Invalid entity type in route",
+			},
+		})
+		return
+	}
+
+	impact, err := h.deletionService.GetDeletionImpact(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to preview deletion impact")
+		return
+	}
 
-// ErrorDetail represents error details
-type ErrorDetail struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	c.JSON(http.StatusOK, impact)
 }
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/service"
 )
 
@@ -21,7 +23,7 @@ type MockDeletionService struct {
 	mock.Mock
 }
 
-func (m *MockDeletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
+func (m *MockDeletionService) DeleteEpicWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
 	args := m.Called(id, userID, force)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -29,7 +31,7 @@ func (m *MockDeletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid
 	return args.Get(0).(*service.DeletionResult), args.Error(1)
 }
 
-func (m *MockDeletionService) DeleteUserStoryWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
+func (m *MockDeletionService) DeleteUserStoryWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
 	args := m.Called(id, userID, force)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -37,7 +39,7 @@ func (m *MockDeletionService) DeleteUserStoryWithValidation(id uuid.UUID, userID
 	return args.Get(0).(*service.DeletionResult), args.Error(1)
 }
 
-func (m *MockDeletionService) DeleteAcceptanceCriteriaWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
+func (m *MockDeletionService) DeleteAcceptanceCriteriaWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
 	args := m.Called(id, userID, force)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -45,7 +47,7 @@ func (m *MockDeletionService) DeleteAcceptanceCriteriaWithValidation(id uuid.UUI
 	return args.Get(0).(*service.DeletionResult), args.Error(1)
 }
 
-func (m *MockDeletionService) DeleteRequirementWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
+func (m *MockDeletionService) DeleteRequirementWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*service.DeletionResult, error) {
 	args := m.Called(id, userID, force)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -53,7 +55,7 @@ func (m *MockDeletionService) DeleteRequirementWithValidation(id uuid.UUID, user
 	return args.Get(0).(*service.DeletionResult), args.Error(1)
 }
 
-func (m *MockDeletionService) ValidateEpicDeletion(id uuid.UUID) (*service.DependencyInfo, error) {
+func (m *MockDeletionService) ValidateEpicDeletion(ctx context.Context, id uuid.UUID) (*service.DependencyInfo, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -61,7 +63,7 @@ func (m *MockDeletionService) ValidateEpicDeletion(id uuid.UUID) (*service.Depen
 	return args.Get(0).(*service.DependencyInfo), args.Error(1)
 }
 
-func (m *MockDeletionService) ValidateUserStoryDeletion(id uuid.UUID) (*service.DependencyInfo, error) {
+func (m *MockDeletionService) ValidateUserStoryDeletion(ctx context.Context, id uuid.UUID) (*service.DependencyInfo, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -69,7 +71,7 @@ func (m *MockDeletionService) ValidateUserStoryDeletion(id uuid.UUID) (*service.
 	return args.Get(0).(*service.DependencyInfo), args.Error(1)
 }
 
-func (m *MockDeletionService) ValidateAcceptanceCriteriaDeletion(id uuid.UUID) (*service.DependencyInfo, error) {
+func (m *MockDeletionService) ValidateAcceptanceCriteriaDeletion(ctx context.Context, id uuid.UUID) (*service.DependencyInfo, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -77,7 +79,7 @@ func (m *MockDeletionService) ValidateAcceptanceCriteriaDeletion(id uuid.UUID) (
 	return args.Get(0).(*service.DependencyInfo), args.Error(1)
 }
 
-func (m *MockDeletionService) ValidateRequirementDeletion(id uuid.UUID) (*service.DependencyInfo, error) {
+func (m *MockDeletionService) ValidateRequirementDeletion(ctx context.Context, id uuid.UUID) (*service.DependencyInfo, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -85,6 +87,14 @@ func (m *MockDeletionService) ValidateRequirementDeletion(id uuid.UUID) (*servic
 	return args.Get(0).(*service.DependencyInfo), args.Error(1)
 }
 
+func (m *MockDeletionService) GetDeletionImpact(ctx context.Context, entityType models.EntityType, idOrReference string) (*service.DeletionImpact, error) {
+	args := m.Called(entityType, idOrReference)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.DeletionImpact), args.Error(1)
+}
+
 // Test setup helper
 func setupDeletionHandlerTest() (*DeletionHandler, *MockDeletionService, *gin.Engine) {
 	gin.SetMode(gin.TestMode)
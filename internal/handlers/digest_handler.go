@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/service"
+)
+
+// DigestHandler handles HTTP requests for activity digest preferences
+type DigestHandler struct {
+	digestService service.DigestService
+}
+
+// NewDigestHandler creates a new digest handler instance
+func NewDigestHandler(digestService service.DigestService) *DigestHandler {
+	return &DigestHandler{
+		digestService: digestService,
+	}
+}
+
+// GetPreferences handles GET /api/v1/users/me/digest
+// @Summary Get the current user's digest preferences
+// @Description Retrieve how often the current user receives the activity digest email. Digests are disabled until a frequency is chosen.
+// @Tags digest
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.DigestSubscription "Digest preferences"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/digest [get]
+func (h *DigestHandler) GetPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	subscription, err := h.digestService.GetPreferences(ctx, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get digest preferences")
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
+
+// UpdatePreferences handles PUT /api/v1/users/me/digest
+// @Summary Update the current user's digest preferences
+// @Description Choose how often the current user receives an email summarizing activity on entities assigned to them: none, daily or weekly
+// @Tags digest
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param preferences body service.UpdateDigestPreferencesRequest true "Digest preferences"
+// @Success 200 {object} models.DigestSubscription "Updated digest preferences"
+// @Failure 400 {object} map[string]interface{} "Invalid frequency"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/digest [put]
+func (h *DigestHandler) UpdatePreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.UpdateDigestPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	subscription, err := h.digestService.UpdatePreferences(ctx, userID, req.Frequency)
+	if err != nil {
+		respondWithError(c, err, "Failed to update digest preferences")
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
+
+// Unsubscribe handles GET /api/v1/digest/unsubscribe
+// @Summary Unsubscribe from activity digest emails
+// @Description Disable activity digest emails using the token from the unsubscribe link in a digest email. No authentication is required.
+// @Tags digest
+// @Accept json
+// @Produce json
+// @Param token query string true "Unsubscribe token from the digest email"
+// @Success 200 {object} map[string]interface{} "Unsubscribed"
+// @Failure 400 {object} map[string]interface{} "Missing token"
+// @Failure 404 {object} map[string]interface{} "Unknown token"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/digest/unsubscribe [get]
+func (h *DigestHandler) Unsubscribe(c *gin.Context) {
+	ctx := c.Request.Context()
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "token parameter is required",
+			},
+		})
+		return
+	}
+
+	if err := h.digestService.Unsubscribe(ctx, token); err != nil {
+		respondWithError(c, err, "Failed to unsubscribe")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "You have been unsubscribed from activity digest emails",
+	})
+}
+
+// currentUserID extracts the current user ID from the token
+// Writes a 401 response and returns false if the user is not authenticated
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userIDParam, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userIDParam)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "Invalid user ID in token",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return userID, true
+}
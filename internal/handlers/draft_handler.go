@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/service"
+)
+
+// DraftHandler handles HTTP requests for private, unpublished entity drafts
+type DraftHandler struct {
+	draftService service.DraftService
+}
+
+// NewDraftHandler creates a new draft handler instance
+func NewDraftHandler(draftService service.DraftService) *DraftHandler {
+	return &DraftHandler{
+		draftService: draftService,
+	}
+}
+
+// SaveDraft handles PUT /api/v1/{entityType}/:id/draft
+// @Summary Save a private draft of an entity's text
+// @Description Create or replace the current user's private draft of an entity's title and description. Drafts are not visible to other users and do not affect inline comments until published.
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param draft body service.SaveDraftRequest true "Draft content"
+// @Success 200 {object} service.DraftResponse "Draft saved"
+// @Failure 400 {object} map[string]interface{} "Invalid request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/draft [put]
+// @Router /api/v1/user-stories/{id}/draft [put]
+// @Router /api/v1/acceptance-criteria/{id}/draft [put]
+// @Router /api/v1/requirements/{id}/draft [put]
+func (h *DraftHandler) SaveDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.SaveDraftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	draft, err := h.draftService.SaveDraft(ctx, entityType, c.Param("id"), userID, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to save draft")
+		return
+	}
+
+	c.JSON(http.StatusOK, draft)
+}
+
+// GetDraft handles GET /api/v1/{entityType}/:id/draft
+// @Summary Get the current user's draft of an entity
+// @Description Retrieve the current user's private draft of an entity. The is_stale flag is set when the entity changed after the draft was started.
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} service.DraftResponse "Draft found"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or draft not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/draft [get]
+// @Router /api/v1/user-stories/{id}/draft [get]
+// @Router /api/v1/acceptance-criteria/{id}/draft [get]
+// @Router /api/v1/requirements/{id}/draft [get]
+func (h *DraftHandler) GetDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	draft, err := h.draftService.GetDraft(ctx, entityType, c.Param("id"), userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get draft")
+		return
+	}
+
+	c.JSON(http.StatusOK, draft)
+}
+
+// DiscardDraft handles DELETE /api/v1/{entityType}/:id/draft
+// @Summary Discard the current user's draft of an entity
+// @Description Delete the current user's private draft without publishing it
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 204 "Draft discarded"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or draft not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/draft [delete]
+// @Router /api/v1/user-stories/{id}/draft [delete]
+// @Router /api/v1/acceptance-criteria/{id}/draft [delete]
+// @Router /api/v1/requirements/{id}/draft [delete]
+func (h *DraftHandler) DiscardDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.draftService.DiscardDraft(ctx, entityType, c.Param("id"), userID); err != nil {
+		respondWithError(c, err, "Failed to discard draft")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PublishDraft handles POST /api/v1/{entityType}/:id/draft/publish
+// @Summary Publish the current user's draft of an entity
+// @Description Apply the current user's draft to the entity, revalidate inline comments against the new text and delete the draft. Returns 409 if the entity changed after the draft was started, unless force=true.
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param force query boolean false "Publish even if the entity changed after the draft was started"
+// @Success 200 {object} map[string]interface{} "Updated entity"
+// @Failure 400 {object} map[string]interface{} "Invalid force parameter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or draft not found"
+// @Failure 409 {object} map[string]interface{} "Entity changed after the draft was started"
+// @Failure 423 {object} map[string]interface{} "Entity is locked by another user"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/draft/publish [post]
+// @Router /api/v1/user-stories/{id}/draft/publish [post]
+// @Router /api/v1/acceptance-criteria/{id}/draft/publish [post]
+// @Router /api/v1/requirements/{id}/draft/publish [post]
+func (h *DraftHandler) PublishDraft(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	force := false
+	if forceParam := c.Query("force"); forceParam != "" {
+		parsed, err := strconv.ParseBool(forceParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid force parameter",
+				},
+			})
+			return
+		}
+		force = parsed
+	}
+
+	entity, err := h.draftService.PublishDraft(ctx, entityType, c.Param("id"), userID, force)
+	if err != nil {
+		respondWithError(c, err, "Failed to publish draft")
+		return
+	}
+
+	c.JSON(http.StatusOK, entity)
+}
+
+// ListMyDrafts handles GET /api/v1/drafts
+// @Summary List the current user's drafts
+// @Description Retrieve all private drafts owned by the current user, most recently saved first
+// @Tags drafts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Drafts owned by the current user"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/drafts [get]
+func (h *DraftHandler) ListMyDrafts(c *gin.Context) {
+	ctx := c.Request.Context()
+	userIDParam, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return
+	}
+
+	drafts, err := h.draftService.ListUserDrafts(ctx, uuid.MustParse(userIDParam))
+	if err != nil {
+		respondWithError(c, err, "Failed to list drafts")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"drafts": drafts,
+		"count":  len(drafts),
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+)
+
+// entityTypeFromPath determines the entity type from a route path such as /api/v1/epics/:id/presence
+func entityTypeFromPath(path string) (models.EntityType, bool) {
+	switch {
+	case strings.Contains(path, "/epics/"):
+		return models.EntityTypeEpic, true
+	case strings.Contains(path, "/user-stories/"):
+		return models.EntityTypeUserStory, true
+	case strings.Contains(path, "/acceptance-criteria/"):
+		return models.EntityTypeAcceptanceCriteria, true
+	case strings.Contains(path, "/requirements/"):
+		return models.EntityTypeRequirement, true
+	default:
+		return "", false
+	}
+}
+
+// parseEntityRequestContext extracts the entity type from the route and the current user ID from the token
+func parseEntityRequestContext(c *gin.Context) (models.EntityType, uuid.UUID, bool) {
+	entityType, ok := entityTypeFromPath(c.FullPath())
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid entity type in route",
+			},
+		})
+		return "", uuid.Nil, false
+	}
+
+	userIDParam, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return "", uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userIDParam)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "Invalid user ID in token",
+			},
+		})
+		return "", uuid.Nil, false
+	}
+
+	return entityType, userID, true
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/service"
+)
+
+// EntityLinkListResponse represents the response for listing the links of an entity
+type EntityLinkListResponse = ListResponse[service.EntityLink]
+
+// EntityRelationshipHandler handles HTTP requests for typed links between entities of any type
+type EntityRelationshipHandler struct {
+	entityRelationshipService service.EntityRelationshipService
+}
+
+// NewEntityRelationshipHandler creates a new entity relationship handler instance
+func NewEntityRelationshipHandler(entityRelationshipService service.EntityRelationshipService) *EntityRelationshipHandler {
+	return &EntityRelationshipHandler{
+		entityRelationshipService: entityRelationshipService,
+	}
+}
+
+// CreateLink handles POST /api/v1/entity-relationships
+// @Summary Link two entities
+// @Description Create a typed link between two epics, user stories, acceptance criteria or requirements, e.g. epic duplicates epic or user story depends_on user story. Entities are given by UUID or reference ID and the relationship type by name or UUID. The relationship type's allowed_links decide which entity type pairs it can link. Links between two requirements are also listed by the requirement relationship endpoints.
+// @Tags relationships
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body service.CreateEntityLinkRequest true "Link to create"
+// @Success 201 {object} service.EntityLink "Link created"
+// @Failure 400 {object} map[string]interface{} "Invalid request, self link or entity types not allowed by the relationship type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or relationship type not found"
+// @Failure 409 {object} map[string]interface{} "Link already exists"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/entity-relationships [post]
+func (h *EntityRelationshipHandler) CreateLink(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateEntityLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+	req.CreatedBy = userID
+
+	link, err := h.entityRelationshipService.CreateLink(ctx, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to create relationship")
+		return
+	}
+
+	c.JSON(http.StatusCreated, link)
+}
+
+// ListLinks handles GET /api/v1/{entityType}/:id/entity-relationships
+// @Summary List the links of an entity
+// @Description Retrieve the links from and to an entity, oldest first. direction tells whether the entity is the source (outgoing) or the target (incoming) of each link. For requirements the list includes requirement relationships.
+// @Tags relationships
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} EntityLinkListResponse "Links of the entity"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/entity-relationships [get]
+// @Router /api/v1/user-stories/{id}/entity-relationships [get]
+// @Router /api/v1/acceptance-criteria/{id}/entity-relationships [get]
+// @Router /api/v1/requirements/{id}/entity-relationships [get]
+func (h *EntityRelationshipHandler) ListLinks(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	links, err := h.entityRelationshipService.ListLinks(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to list relationships")
+		return
+	}
+
+	SendListResponse(c, links, int64(len(links)), len(links), 0)
+}
+
+// DeleteLink handles DELETE /api/v1/entity-relationships/:id
+// @Summary Remove a link between entities
+// @Description Delete a link created with POST /api/v1/entity-relationships, including links between two requirements
+// @Tags relationships
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Link ID (UUID)"
+// @Success 204 "Link removed"
+// @Failure 400 {object} map[string]interface{} "Invalid link ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Link not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/entity-relationships/{id} [delete]
+func (h *EntityRelationshipHandler) DeleteLink(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid relationship ID format",
+			},
+		})
+		return
+	}
+
+	if err := h.entityRelationshipService.DeleteLink(ctx, id); err != nil {
+		respondWithError(c, err, "Failed to delete relationship")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// EntityVersionHandler handles HTTP requests for entity version history and diffs
+type EntityVersionHandler struct {
+	versionService service.EntityVersionService
+}
+
+// NewEntityVersionHandler creates a new entity version handler instance
+func NewEntityVersionHandler(versionService service.EntityVersionService) *EntityVersionHandler {
+	return &EntityVersionHandler{
+		versionService: versionService,
+	}
+}
+
+// ListVersions handles GET /api/v1/{entityType}/:id/versions
+// @Summary List versions of an entity
+// @Description Retrieve the recorded versions of an entity's title and description, oldest first. A new version is recorded whenever the title or description changes.
+// @Tags versions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} map[string]interface{} "Versions of the entity"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/versions [get]
+// @Router /api/v1/user-stories/{id}/versions [get]
+// @Router /api/v1/acceptance-criteria/{id}/versions [get]
+// @Router /api/v1/requirements/{id}/versions [get]
+func (h *EntityVersionHandler) ListVersions(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	versions, err := h.versionService.ListVersions(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to list versions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"versions": versions,
+		"count":    len(versions),
+	})
+}
+
+// GetDiff handles GET /api/v1/{entityType}/:id/diff
+// @Summary Get a word-level diff between two versions of an entity
+// @Description Compute a word-level diff of an entity's description between two versions, with inserted and deleted runs annotated. to_version defaults to the latest version and from_version to the version before it; from_version=0 diffs against the empty text.
+// @Tags versions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param from_version query int false "Base version number (0 for the empty text)"
+// @Param to_version query int false "Target version number (defaults to the latest version)"
+// @Success 200 {object} service.VersionDiffResponse "Description diff"
+// @Failure 400 {object} map[string]interface{} "Invalid version parameters"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or version not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/diff [get]
+// @Router /api/v1/user-stories/{id}/diff [get]
+// @Router /api/v1/acceptance-criteria/{id}/diff [get]
+// @Router /api/v1/requirements/{id}/diff [get]
+func (h *EntityVersionHandler) GetDiff(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	fromVersion, ok := parseOptionalVersion(c, "from_version")
+	if !ok {
+		return
+	}
+	toVersion, ok := parseOptionalVersion(c, "to_version")
+	if !ok {
+		return
+	}
+
+	diff, err := h.versionService.DiffVersions(ctx, entityType, c.Param("id"), fromVersion, toVersion)
+	if err != nil {
+		respondWithError(c, err, "Failed to compute diff")
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+// parseOptionalVersion parses a non-negative version number query parameter
+// Writes a 400 response and returns false if the parameter is malformed
+func parseOptionalVersion(c *gin.Context, name string) (*int, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, true
+	}
+
+	version, err := strconv.Atoi(raw)
+	if err != nil || version < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid " + name + " parameter",
+			},
+		})
+		return nil, false
+	}
+	return &version, true
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/service"
+)
+
+// EpicBundleImportHandler handles HTTP requests for importing epic bundles
+type EpicBundleImportHandler struct {
+	importService service.EpicBundleImportService
+}
+
+// NewEpicBundleImportHandler creates a new epic bundle import handler instance
+func NewEpicBundleImportHandler(importService service.EpicBundleImportService) *EpicBundleImportHandler {
+	return &EpicBundleImportHandler{
+		importService: importService,
+	}
+}
+
+// Import handles POST /api/v1/import/bundle
+// @Summary Import an epic bundle
+// @Description Create the epic hierarchy of a bundle exported with GET /api/v1/epics/{id}/export?format=bundle in one transaction, owned by and assigned to the caller. Entities get new reference IDs. Unless include_comments is false, the bundle's general and inline comments are recreated with their threads, resolution state and positions; authors are matched to existing users by username, then by email, and comments of unknown authors are attributed to the "imported" user. An invalid bundle creates nothing.
+// @Tags import
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param include_comments query bool false "Import the bundle's comments" default(true)
+// @Param request body service.EpicBundle true "Epic bundle"
+// @Success 201 {object} service.EpicBundleImportResult "Created entities with their reference IDs"
+// @Failure 400 {object} map[string]interface{} "Malformed bundle or unsupported schema version"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 422 {object} map[string]interface{} "Bundle validation errors"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/import/bundle [post]
+func (h *EpicBundleImportHandler) Import(c *gin.Context) {
+	ctx := c.Request.Context()
+	creatorID, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return
+	}
+
+	options := service.EpicBundleImportOptions{IncludeComments: true}
+	if value := c.Query("include_comments"); value != "" {
+		includeComments, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "include_comments must be true or false",
+				},
+			})
+			return
+		}
+		options.IncludeComments = includeComments
+	}
+
+	var bundle service.EpicBundle
+	if err := c.ShouldBindJSON(&bundle); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	result, err := h.importService.Import(ctx, &bundle, uuid.MustParse(creatorID), options)
+	if err != nil {
+		respondWithError(c, err, "Failed to import bundle")
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
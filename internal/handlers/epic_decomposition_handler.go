@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/service"
+)
+
+// EpicDecompositionHandler handles HTTP requests for AI-assisted breakdown of epics into user stories
+type EpicDecompositionHandler struct {
+	decompositionService service.EpicDecompositionService
+}
+
+// NewEpicDecompositionHandler creates a new epic decomposition handler instance
+func NewEpicDecompositionHandler(decompositionService service.EpicDecompositionService) *EpicDecompositionHandler {
+	return &EpicDecompositionHandler{
+		decompositionService: decompositionService,
+	}
+}
+
+// DecomposeEpic handles POST /api/v1/epics/:id/decompose
+// @Summary Propose user stories for an epic
+// @Description Send the epic description, its linked steering documents and the titles of its existing user stories to the configured language model and return the proposed user stories with acceptance criteria as a draft. Nothing is created; edit the draft as needed and send it to the accept endpoint.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic UUID or reference ID"
+// @Success 200 {object} service.EpicDecomposition "Proposed user stories"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Failure 503 {object} map[string]interface{} "No language model provider is configured or the provider failed"
+// @Router /api/v1/epics/{id}/decompose [post]
+func (h *EpicDecompositionHandler) DecomposeEpic(c *gin.Context) {
+	userID, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return
+	}
+
+	decomposition, err := h.decompositionService.Decompose(c.Request.Context(), c.Param("id"), uuid.MustParse(userID))
+	if err != nil {
+		respondWithError(c, err, "Failed to decompose epic")
+		return
+	}
+
+	c.JSON(http.StatusOK, decomposition)
+}
+
+// AcceptDecomposition handles POST /api/v1/epics/:id/decompose/accept
+// @Summary Create proposed user stories in an epic
+// @Description Create the user stories and acceptance criteria of a decomposition draft in the epic in one transaction, owned by and assigned to the caller. The entities are marked as AI-generated with the configured model. When any user story is invalid nothing is created.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic UUID or reference ID"
+// @Param request body service.AcceptDecompositionRequest true "User stories to create"
+// @Success 201 {object} service.AcceptedDecomposition "Created user stories with their acceptance criteria"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or no user stories"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 422 {object} map[string]interface{} "Invalid user stories"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/decompose/accept [post]
+func (h *EpicDecompositionHandler) AcceptDecomposition(c *gin.Context) {
+	ctx := c.Request.Context()
+	creatorID, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return
+	}
+
+	var req service.AcceptDecompositionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	accepted, err := h.decompositionService.Accept(ctx, c.Param("id"), req, uuid.MustParse(creatorID))
+	if err != nil {
+		respondWithError(c, err, "Failed to create proposed user stories")
+		return
+	}
+
+	c.JSON(http.StatusCreated, accepted)
+}
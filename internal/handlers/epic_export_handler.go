@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// EpicExportHandler handles HTTP requests for epic document exports
+type EpicExportHandler struct {
+	exportService service.EpicExportService
+}
+
+// NewEpicExportHandler creates a new epic export handler instance
+func NewEpicExportHandler(exportService service.EpicExportService) *EpicExportHandler {
+	return &EpicExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportEpic handles GET /api/v1/epics/:id/export
+// @Summary Export an epic hierarchy as a document
+// @Description Render an epic with its user stories, acceptance criteria and requirements as a Markdown document for pasting into wikis and review docs, or as a JSON bundle for POST /api/v1/import/bundle. With include_comments, a Markdown document gets unresolved inline comments as footnotes anchored after their linked text, and a bundle carries all general and inline comments with their threads and resolution state.
+// @Tags epics
+// @Accept json
+// @Produce text/markdown
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Param format query string false "Document format" Enums(markdown, bundle) default(markdown)
+// @Param include_comments query bool false "Include comments in the document" default(false)
+// @Success 200 {string} string "Markdown document or JSON bundle"
+// @Failure 400 {object} map[string]interface{} "Invalid format or include_comments value"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/export [get]
+func (h *EpicExportHandler) ExportEpic(c *gin.Context) {
+	ctx := c.Request.Context()
+	options := service.EpicExportOptions{
+		Format: c.DefaultQuery("format", service.EpicExportFormatMarkdown),
+	}
+	if value := c.Query("include_comments"); value != "" {
+		includeComments, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "include_comments must be true or false",
+				},
+			})
+			return
+		}
+		options.IncludeComments = includeComments
+	}
+
+	export, err := h.exportService.ExportEpic(ctx, c.Param("id"), options)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="`+export.Name+`.`+export.Extension+`"`)
+	c.Data(http.StatusOK, export.ContentType, []byte(export.Content))
+}
+
+// handleError maps epic export service errors to HTTP responses
+func (h *EpicExportHandler) handleError(c *gin.Context, err error) {
+	respondWithError(c, err, "Failed to export epic")
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// EpicFrozenResponse is returned with 423 Locked when a change is rejected by the freeze of an epic
+type EpicFrozenResponse struct {
+	Error  ErrorDetail        `json:"error"`
+	Freeze service.EpicFreeze `json:"freeze"`
+}
+
+// EpicFreezeHandler handles HTTP requests for freezing the requirements of epics
+type EpicFreezeHandler struct {
+	epicFreezeService service.EpicFreezeService
+}
+
+// NewEpicFreezeHandler creates a new epic freeze handler instance
+func NewEpicFreezeHandler(epicFreezeService service.EpicFreezeService) *EpicFreezeHandler {
+	return &EpicFreezeHandler{
+		epicFreezeService: epicFreezeService,
+	}
+}
+
+// FreezeEpic handles POST /api/v1/epics/:id/freeze
+// @Summary Freeze the requirements of an epic
+// @Description Freeze an epic during a release stabilization window. While it is frozen, changes to its user stories, acceptance criteria and requirements by anyone but administrators are rejected with 423 Locked and the freeze reason. The freeze is recorded in the activity of the epic. Freezing a frozen epic replaces its reason.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic UUID or reference ID"
+// @Param freeze body service.FreezeEpicRequest true "Freeze reason"
+// @Success 200 {object} models.Epic "Frozen epic"
+// @Failure 400 {object} ErrorResponse "Invalid request body or reason"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Administrator role required"
+// @Failure 404 {object} ErrorResponse "Epic not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/freeze [post]
+func (h *EpicFreezeHandler) FreezeEpic(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.FreezeEpicRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+			Code:    "VALIDATION_ERROR",
+			Message: "Invalid request body: " + err.Error(),
+		}})
+		return
+	}
+
+	epic, err := h.epicFreezeService.Freeze(ctx, c.Param("id"), req.Reason, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to freeze epic")
+		return
+	}
+
+	c.JSON(http.StatusOK, epic)
+}
+
+// UnfreezeEpic handles DELETE /api/v1/epics/:id/freeze
+// @Summary Unfreeze the requirements of an epic
+// @Description Lift the freeze of an epic so that its user stories, acceptance criteria and requirements can be changed again. The change is recorded in the activity of the epic. Unfreezing an epic that is not frozen changes nothing.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic UUID or reference ID"
+// @Success 200 {object} models.Epic "Unfrozen epic"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Administrator role required"
+// @Failure 404 {object} ErrorResponse "Epic not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/freeze [delete]
+func (h *EpicFreezeHandler) UnfreezeEpic(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	epic, err := h.epicFreezeService.Unfreeze(ctx, c.Param("id"), userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to unfreeze epic")
+		return
+	}
+
+	c.JSON(http.StatusOK, epic)
+}
+
+// EnforceFreeze returns middleware that applies the freeze of epics to a request. The repositories reject changes
+// to the children of frozen epics whichever handler, import or MCP tool makes them; administrators are exempt.
+// A request that fails because of a freeze is answered with 423 Locked and the freeze, whatever error response
+// its handler chose.
+func (h *EpicFreezeHandler) EnforceFreeze() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		if role, ok := auth.GetCurrentUserRole(c); ok && role == models.RoleAdministrator {
+			c.Request = c.Request.WithContext(repository.WithFreezeExemption(ctx))
+			c.Next()
+			return
+		}
+
+		recorder := &repository.FreezeRecorder{}
+		c.Request = c.Request.WithContext(repository.WithFreezeRecorder(ctx, recorder))
+		writer := &freezeResponseWriter{ResponseWriter: c.Writer, recorder: recorder}
+		c.Writer = writer
+		defer func() { c.Writer = writer.ResponseWriter }()
+		c.Next()
+	}
+}
+
+// freezeResponseWriter replaces the error response of a request that failed because of a freeze
+type freezeResponseWriter struct {
+	gin.ResponseWriter
+	recorder *repository.FreezeRecorder
+	replaced bool
+}
+
+func (w *freezeResponseWriter) WriteHeader(status int) {
+	if w.replaced {
+		return
+	}
+	rejected := w.recorder.Rejected()
+	if status < http.StatusBadRequest || rejected == nil {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+
+	w.replaced = true
+	body, err := json.Marshal(EpicFrozenResponse{
+		Error: ErrorDetail{
+			Code:    "EPIC_FROZEN",
+			Message: "Requirements of epic " + rejected.ReferenceID + " are frozen: " + rejected.Reason,
+		},
+		Freeze: service.EpicFreeze{
+			EpicID:      rejected.EpicID,
+			ReferenceID: rejected.ReferenceID,
+			Reason:      rejected.Reason,
+			FrozenBy:    rejected.FrozenBy,
+			FrozenAt:    rejected.FrozenAt,
+		},
+	})
+	if err != nil {
+		w.replaced = false
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.ResponseWriter.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.ResponseWriter.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(http.StatusLocked)
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+func (w *freezeResponseWriter) Write(data []byte) (int, error) {
+	if w.replaced {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *freezeResponseWriter) WriteString(data string) (int, error) {
+	if w.replaced {
+		return len(data), nil
+	}
+	return w.ResponseWriter.WriteString(data)
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// MockEpicFreezeService is a mock implementation of EpicFreezeService
+type MockEpicFreezeService struct {
+	mock.Mock
+}
+
+func (m *MockEpicFreezeService) Freeze(ctx context.Context, epicIDOrRef, reason string, userID uuid.UUID) (*models.Epic, error) {
+	args := m.Called(epicIDOrRef, reason, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Epic), args.Error(1)
+}
+
+func (m *MockEpicFreezeService) Unfreeze(ctx context.Context, epicIDOrRef string, userID uuid.UUID) (*models.Epic, error) {
+	args := m.Called(epicIDOrRef, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Epic), args.Error(1)
+}
+
+func TestEpicFreezeHandler_EnforceFreeze(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}))
+	require.NoError(t, repository.RegisterFreezeCallbacks(db))
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	user := &models.User{ID: uuid.New(), Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, session.Create(user).Error)
+	reason := "Release 2.4 stabilization"
+	frozen := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Accounts", Status: models.EpicStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	open := &models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Billing", Status: models.EpicStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create([]*models.Epic{frozen, open}).Error)
+	frozenStory := &models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: frozen.ID, Title: "Sign up", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	openStory := &models.UserStory{ID: uuid.New(), ReferenceID: "US-002", EpicID: open.ID, Title: "Pay", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create([]*models.UserStory{frozenStory, openStory}).Error)
+	require.NoError(t, db.Model(frozen).Updates(map[string]interface{}{"frozen": true, "freeze_reason": reason}).Error)
+
+	newRouter := func(role models.UserRole) *gin.Engine {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set(auth.ClaimsContextKey, &auth.Claims{UserID: uuid.New().String(), Role: role})
+		})
+		router.Use(NewEpicFreezeHandler(&MockEpicFreezeService{}).EnforceFreeze())
+		router.PUT("/api/v1/user-stories/:id", func(c *gin.Context) {
+			err := db.WithContext(c.Request.Context()).Model(&models.UserStory{}).Where("id = ?", c.Param("id")).Update("title", "Renamed").Error
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			c.JSON(http.StatusOK, gin.H{"id": c.Param("id")})
+		})
+		return router
+	}
+	update := func(role models.UserRole, storyID uuid.UUID) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		newRouter(role).ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/v1/user-stories/"+storyID.String(), nil))
+		return w
+	}
+
+	t.Run("answers changes rejected by a freeze with 423", func(t *testing.T) {
+		w := update(models.RoleUser, frozenStory.ID)
+
+		assert.Equal(t, http.StatusLocked, w.Code)
+		var response EpicFrozenResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "EPIC_FROZEN", response.Error.Code)
+		assert.Equal(t, frozen.ID, response.Freeze.EpicID)
+		assert.Equal(t, "EP-001", response.Freeze.ReferenceID)
+		assert.Equal(t, "Release 2.4 stabilization", response.Freeze.Reason)
+	})
+
+	t.Run("lets changes of other epics through", func(t *testing.T) {
+		w := update(models.RoleUser, openStory.ID)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), openStory.ID.String())
+	})
+
+	t.Run("administrators bypass the freeze", func(t *testing.T) {
+		w := update(models.RoleAdministrator, frozenStory.ID)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var stored models.UserStory
+		require.NoError(t, db.First(&stored, "id = ?", frozenStory.ID).Error)
+		assert.Equal(t, "Renamed", stored.Title)
+	})
+}
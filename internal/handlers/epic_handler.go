@@ -16,7 +16,11 @@ import (
 
 // EpicHandler handles HTTP requests for epic operations
 type EpicHandler struct {
-	epicService service.EpicService
+	epicService        service.EpicService
+	favoriteService    service.FavoriteService
+	warningService     service.ValidationWarningService
+	countService       service.EntityCountService
+	translationService service.TranslationService
 }
 
 // NewEpicHandler creates a new epic handler instance
@@ -26,6 +30,26 @@ func NewEpicHandler(epicService service.EpicService) *EpicHandler {
 	}
 }
 
+// SetFavoriteService enables the is_favorite flag (include=is_favorite) on ListEpics
+func (h *EpicHandler) SetFavoriteService(favoriteService service.FavoriteService) {
+	h.favoriteService = favoriteService
+}
+
+// SetCountService enables aggregate counts (include=comments_count and similar) on GetEpic and ListEpics
+func (h *EpicHandler) SetCountService(countService service.EntityCountService) {
+	h.countService = countService
+}
+
+// SetTranslationService enables translated titles and descriptions, chosen with Accept-Language, on GetEpic and ListEpics
+func (h *EpicHandler) SetTranslationService(translationService service.TranslationService) {
+	h.translationService = translationService
+}
+
+// SetWarningService enables validation warnings (a warnings array) on epic create and update responses
+func (h *EpicHandler) SetWarningService(warningService service.ValidationWarningService) {
+	h.warningService = warningService
+}
+
 // CreateEpic handles POST /api/v1/epics
 // @Summary Create a new epic
 // @Description Create a new epic with the provided details. The epic will be assigned a unique reference ID (EP-XXX format) and default status of "Backlog". Requires User or Administrator role.
@@ -34,13 +58,15 @@ func NewEpicHandler(epicService service.EpicService) *EpicHandler {
 // @Produce json
 // @Security BearerAuth
 // @Param epic body service.CreateEpicRequest true "Epic creation request"
-// @Success 201 {object} models.Epic "Successfully created epic"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 201 {object} models.Epic "Successfully created epic; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid request body, creator/assignee not found, or invalid priority"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 403 {object} map[string]interface{} "User or Administrator role required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics [post]
 func (h *EpicHandler) CreateEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateEpicRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -67,7 +93,7 @@ func (h *EpicHandler) CreateEpic(c *gin.Context) {
 	// Set the creator ID from the authenticated user
 	req.CreatorID = uuid.MustParse(creatorID)
 
-	epic, err := h.epicService.CreateEpic(req)
+	epic, err := h.epicService.CreateEpic(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserNotFound):
@@ -77,41 +103,34 @@ func (h *EpicHandler) CreateEpic(c *gin.Context) {
 					"message": "Creator or assignee not found",
 				},
 			})
-		case errors.Is(err, service.ErrInvalidPriority):
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": "Invalid priority value",
-				},
-			})
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to create epic",
-				},
-			})
+			respondWithError(c, err, "Failed to create epic")
 		}
 		return
 	}
 
-	c.JSON(http.StatusCreated, epic)
+	respondWithWarnings(c, http.StatusCreated, h.warningService, models.EntityTypeEpic, epic.ID, epic)
 }
 
 // GetEpic handles GET /api/v1/epics/:id
 // @Summary Get an epic by ID or reference ID
-// @Description Retrieve a single epic by its UUID or reference ID (e.g., EP-001). Supports both formats for flexible access. Requires authentication.
+// @Description Retrieve a single epic by its UUID or reference ID (e.g., EP-001). Supports both formats for flexible access. Requires authentication. A retired reference ID, such as the one of a merged epic, answers 301 Moved Permanently with the successor in the Location header.
 // @Tags epics
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Epic ID (UUID) or reference ID (EP-XXX)" example("123e4567-e89b-12d3-a456-426614174000")
+// @Param include query string false "Aggregate counts to embed (comma-separated): comments_count, unresolved_comments_count, user_stories_count" example("comments_count,user_stories_count")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
 // @Success 200 {object} models.Epic "Epic found successfully"
+// @Success 301 {object} ReferenceMovedResponse "Retired reference ID; the Location header points to the successor"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 404 {object} map[string]interface{} "Epic not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id} [get]
 func (h *EpicHandler) GetEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -119,9 +138,9 @@ func (h *EpicHandler) GetEpic(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		epic, err = h.epicService.GetEpicByID(id)
+		epic, err = h.epicService.GetEpicByID(ctx, id)
 	} else {
-		epic, err = h.epicService.GetEpicByReferenceID(idParam)
+		epic, err = h.epicService.GetEpicByReferenceID(ctx, idParam)
 	}
 
 	if err != nil {
@@ -143,7 +162,37 @@ func (h *EpicHandler) GetEpic(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, epic)
+	if redirectRetiredReference(c, idParam, epic.ReferenceID, models.EntityTypeEpic, epic.ID) {
+		return
+	}
+
+	if err := localizeEntities(c, h.translationService, models.EntityTypeEpic, []*models.Epic{epic}, func(e *models.Epic) uuid.UUID { return e.ID }, translateEpic); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to get epic",
+			},
+		})
+		return
+	}
+
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, service.CompactEpic(epic))
+		return
+	}
+
+	response, err := includeEntityFields(c, h.countService, models.EntityTypeEpic, epic.ID, epic)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to get epic",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // UpdateEpic handles PUT /api/v1/epics/:id
@@ -155,7 +204,8 @@ func (h *EpicHandler) GetEpic(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path string true "Epic UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param epic body service.UpdateEpicRequest true "Epic update request"
-// @Success 200 {object} models.Epic "Epic updated successfully"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 200 {object} models.Epic "Epic updated successfully; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid request body, epic ID format, assignee not found, invalid priority, invalid status, or invalid status transition"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 403 {object} map[string]interface{} "User or Administrator role required"
@@ -163,6 +213,7 @@ func (h *EpicHandler) GetEpic(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id} [put]
 func (h *EpicHandler) UpdateEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for updates)
@@ -188,16 +239,9 @@ func (h *EpicHandler) UpdateEpic(c *gin.Context) {
 		return
 	}
 
-	epic, err := h.epicService.UpdateEpic(id, req)
+	epic, err := h.epicService.UpdateEpic(ctx, id, req)
 	if err != nil {
 		switch {
-		case errors.Is(err, service.ErrEpicNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Epic not found",
-				},
-			})
 		case errors.Is(err, service.ErrUserNotFound):
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": gin.H{
@@ -205,39 +249,13 @@ func (h *EpicHandler) UpdateEpic(c *gin.Context) {
 					"message": "Assignee not found",
 				},
 			})
-		case errors.Is(err, service.ErrInvalidPriority):
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": "Invalid priority value",
-				},
-			})
-		case errors.Is(err, service.ErrInvalidEpicStatus):
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": "Invalid epic status",
-				},
-			})
-		case errors.Is(err, service.ErrInvalidStatusTransition):
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": "Invalid status transition",
-				},
-			})
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to update epic",
-				},
-			})
+			respondWithError(c, err, "Failed to update epic")
 		}
 		return
 	}
 
-	c.JSON(http.StatusOK, epic)
+	respondWithWarnings(c, http.StatusOK, h.warningService, models.EntityTypeEpic, epic.ID, epic)
 }
 
 // DeleteEpic handles DELETE /api/v1/epics/:id
@@ -258,6 +276,7 @@ func (h *EpicHandler) UpdateEpic(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id} [delete]
 func (h *EpicHandler) DeleteEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for deletes)
@@ -275,31 +294,9 @@ func (h *EpicHandler) DeleteEpic(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.epicService.DeleteEpic(id, force)
+	err = h.epicService.DeleteEpic(ctx, id, force)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrEpicNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Epic not found",
-				},
-			})
-		case errors.Is(err, service.ErrEpicHasUserStories):
-			c.JSON(http.StatusConflict, gin.H{
-				"error": gin.H{
-					"code":    "DELETION_CONFLICT",
-					"message": "Epic has associated user stories and cannot be deleted. Use force=true to delete with dependencies",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to delete epic",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to delete epic")
 		return
 	}
 
@@ -317,15 +314,21 @@ func (h *EpicHandler) DeleteEpic(c *gin.Context) {
 // @Param assignee_id query string false "Filter by assignee UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174002")
 // @Param status query string false "Filter by epic status" Enums(Backlog,Draft,In Progress,Done,Cancelled) example("Backlog")
 // @Param priority query integer false "Filter by priority level" minimum(1) maximum(4) example(1)
-// @Param include query string false "Include related entities (comma-separated)" example("creator,assignee") example("user_stories,comments")
+// @Param include_archived query boolean false "Include archived epics" default(false) example(true)
+// @Param include query string false "Include related entities (comma-separated); is_favorite adds the current user's favorite flag; comments_count, unresolved_comments_count and user_stories_count add aggregate counts; comment_counts adds a {total, unresolved} object" example("creator,assignee") example("user_stories,comments,is_favorite")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
 // @Param order_by query string false "Order results by field" example("created_at DESC")
+// @Param sort query string false "Comma-separated sort keys field:asc or field:desc, taking precedence over order_by; ties are broken by ID. Fields: reference_id, title, priority, status, due_date, created_at, updated_at" example("priority:asc,updated_at:desc")
 // @Param limit query integer false "Maximum number of results to return" minimum(1) maximum(100) default(50) example(20)
 // @Param offset query integer false "Number of results to skip for pagination" minimum(0) default(0) example(0)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
 // @Success 200 {object} map[string]interface{} "List of epics with count"
+// @Failure 400 {object} ErrorResponse "Invalid sort keys"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics [get]
 func (h *EpicHandler) ListEpics(c *gin.Context) {
+	ctx := c.Request.Context()
 	var filters service.EpicFilters
 
 	// Parse query parameters
@@ -353,6 +356,8 @@ func (h *EpicHandler) ListEpics(c *gin.Context) {
 		}
 	}
 
+	filters.IncludeArchived = c.Query("include_archived") == "true"
+
 	if include := c.Query("include"); include != "" {
 		// Split comma-separated includes and trim whitespace
 		includes := make([]string, 0)
@@ -368,6 +373,7 @@ func (h *EpicHandler) ListEpics(c *gin.Context) {
 	if orderBy := c.Query("order_by"); orderBy != "" {
 		filters.OrderBy = orderBy
 	}
+	filters.Sort = c.Query("sort")
 
 	if limit := c.Query("limit"); limit != "" {
 		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
@@ -381,8 +387,19 @@ func (h *EpicHandler) ListEpics(c *gin.Context) {
 		}
 	}
 
-	epics, totalCount, err := h.epicService.ListEpics(filters)
+	epics, totalCount, err := h.epicService.ListEpics(ctx, filters)
 	if err != nil {
+		respondWithError(c, err, "Failed to list epics")
+		return
+	}
+
+	// Set default limit if not specified
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+
+	if err := localizeEntities(c, h.translationService, models.EntityTypeEpic, entityPointers(epics), func(e *models.Epic) uuid.UUID { return e.ID }, translateEpic); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": gin.H{
 				"code":    "INTERNAL_ERROR",
@@ -392,14 +409,30 @@ func (h *EpicHandler) ListEpics(c *gin.Context) {
 		return
 	}
 
-	// Set default limit if not specified
-	limit := 50
-	if filters.Limit > 0 {
-		limit = filters.Limit
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, gin.H{
+			"data":        service.CompactEpics(epics),
+			"total_count": totalCount,
+			"limit":       limit,
+			"offset":      filters.Offset,
+		})
+		return
+	}
+
+	data, err := includeListFields(c, h.favoriteService, h.countService, models.EntityTypeEpic, epics, func(e *models.Epic) uuid.UUID { return e.ID },
+		commentCountFields(h.countService, models.EntityTypeEpic))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to list epics",
+			},
+		})
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"data":        epics,
+		"data":        data,
 		"total_count": totalCount,
 		"limit":       limit,
 		"offset":      filters.Offset,
@@ -420,6 +453,7 @@ func (h *EpicHandler) ListEpics(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id}/user-stories [get]
 func (h *EpicHandler) GetEpicWithUserStories(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -427,11 +461,11 @@ func (h *EpicHandler) GetEpicWithUserStories(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		epic, err = h.epicService.GetEpicWithUserStories(id)
+		epic, err = h.epicService.GetEpicWithUserStories(ctx, id)
 	} else {
 		// For reference ID, first get the epic, then get with user stories
-		if tempEpic, tempErr := h.epicService.GetEpicByReferenceID(idParam); tempErr == nil {
-			epic, err = h.epicService.GetEpicWithUserStories(tempEpic.ID)
+		if tempEpic, tempErr := h.epicService.GetEpicByReferenceID(ctx, idParam); tempErr == nil {
+			epic, err = h.epicService.GetEpicWithUserStories(ctx, tempEpic.ID)
 		} else {
 			err = tempErr
 		}
@@ -475,6 +509,7 @@ func (h *EpicHandler) GetEpicWithUserStories(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id}/status [patch]
 func (h *EpicHandler) ChangeEpicStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for status changes)
@@ -501,38 +536,9 @@ func (h *EpicHandler) ChangeEpicStatus(c *gin.Context) {
 		return
 	}
 
-	epic, err := h.epicService.ChangeEpicStatus(id, req.Status)
+	epic, err := h.epicService.ChangeEpicStatus(ctx, id, req.Status)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrEpicNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Epic not found",
-				},
-			})
-		case errors.Is(err, service.ErrInvalidEpicStatus):
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": "Invalid epic status",
-				},
-			})
-		case errors.Is(err, service.ErrInvalidStatusTransition):
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": "Invalid status transition",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to change epic status",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to change epic status")
 		return
 	}
 
@@ -555,6 +561,7 @@ func (h *EpicHandler) ChangeEpicStatus(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id}/assign [patch]
 func (h *EpicHandler) AssignEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for assignments)
@@ -581,16 +588,9 @@ func (h *EpicHandler) AssignEpic(c *gin.Context) {
 		return
 	}
 
-	epic, err := h.epicService.AssignEpic(id, req.AssigneeID)
+	epic, err := h.epicService.AssignEpic(ctx, id, req.AssigneeID)
 	if err != nil {
 		switch {
-		case errors.Is(err, service.ErrEpicNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Epic not found",
-				},
-			})
 		case errors.Is(err, service.ErrUserNotFound):
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": gin.H{
@@ -599,12 +599,7 @@ func (h *EpicHandler) AssignEpic(c *gin.Context) {
 				},
 			})
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to assign epic",
-				},
-			})
+			respondWithError(c, err, "Failed to assign epic")
 		}
 		return
 	}
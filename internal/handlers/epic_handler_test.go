@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"product-requirements-management/internal/auth"
 	"product-requirements-management/internal/models"
@@ -25,7 +27,7 @@ type MockEpicService struct {
 	mock.Mock
 }
 
-func (m *MockEpicService) CreateEpic(req service.CreateEpicRequest) (*models.Epic, error) {
+func (m *MockEpicService) CreateEpic(ctx context.Context, req service.CreateEpicRequest) (*models.Epic, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -33,7 +35,7 @@ func (m *MockEpicService) CreateEpic(req service.CreateEpicRequest) (*models.Epi
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) GetEpicByID(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicByID(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -41,7 +43,7 @@ func (m *MockEpicService) GetEpicByID(id uuid.UUID) (*models.Epic, error) {
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) GetEpicByReferenceID(referenceID string) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -49,7 +51,7 @@ func (m *MockEpicService) GetEpicByReferenceID(referenceID string) (*models.Epic
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) UpdateEpic(id uuid.UUID, req service.UpdateEpicRequest) (*models.Epic, error) {
+func (m *MockEpicService) UpdateEpic(ctx context.Context, id uuid.UUID, req service.UpdateEpicRequest) (*models.Epic, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -57,17 +59,17 @@ func (m *MockEpicService) UpdateEpic(id uuid.UUID, req service.UpdateEpicRequest
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) DeleteEpic(id uuid.UUID, force bool) error {
+func (m *MockEpicService) DeleteEpic(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockEpicService) ListEpics(filters service.EpicFilters) ([]models.Epic, int64, error) {
+func (m *MockEpicService) ListEpics(ctx context.Context, filters service.EpicFilters) ([]models.Epic, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.Epic), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockEpicService) GetEpicWithUserStories(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicWithUserStories(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -75,7 +77,7 @@ func (m *MockEpicService) GetEpicWithUserStories(id uuid.UUID) (*models.Epic, er
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) GetEpicWithCompleteHierarchy(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicWithCompleteHierarchy(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -83,7 +85,7 @@ func (m *MockEpicService) GetEpicWithCompleteHierarchy(id uuid.UUID) (*models.Ep
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) ChangeEpicStatus(id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error) {
+func (m *MockEpicService) ChangeEpicStatus(ctx context.Context, id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error) {
 	args := m.Called(id, newStatus)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -91,7 +93,7 @@ func (m *MockEpicService) ChangeEpicStatus(id uuid.UUID, newStatus models.EpicSt
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) AssignEpic(id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) AssignEpic(ctx context.Context, id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id, assigneeID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -200,6 +202,7 @@ func TestEpicHandler_GetEpic(t *testing.T) {
 		epicID         string
 		setupMock      func(*MockEpicService)
 		expectedStatus int
+		location       string
 	}{
 		{
 			name:   "successful get epic by UUID",
@@ -227,6 +230,20 @@ func TestEpicHandler_GetEpic(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:   "retired reference ID redirects to the successor",
+			epicID: "EP-005",
+			setupMock: func(mockService *MockEpicService) {
+				epic := &models.Epic{
+					ID:          uuid.New(),
+					ReferenceID: "EP-002",
+					Title:       "Merged Epic",
+				}
+				mockService.On("GetEpicByReferenceID", "EP-005").Return(epic, nil)
+			},
+			expectedStatus: http.StatusMovedPermanently,
+			location:       "/epics/EP-002",
+		},
 		{
 			name:   "epic not found",
 			epicID: uuid.New().String(),
@@ -253,11 +270,47 @@ func TestEpicHandler_GetEpic(t *testing.T) {
 			router.ServeHTTP(w, req)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.location, w.Header().Get("Location"))
 			mockService.AssertExpectations(t)
 		})
 	}
 }
 
+// contextRecordingEpicService records the context the handler passes to the epic service
+type contextRecordingEpicService struct {
+	*MockEpicService
+	ctx context.Context
+}
+
+func (s *contextRecordingEpicService) GetEpicByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error) {
+	s.ctx = ctx
+	return s.MockEpicService.GetEpicByReferenceID(ctx, referenceID)
+}
+
+func TestEpicHandler_PassesRequestContext(t *testing.T) {
+	mockService := new(MockEpicService)
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Test Epic"}
+	mockService.On("GetEpicByReferenceID", "EP-001").Return(epic, nil)
+	recorder := &contextRecordingEpicService{MockEpicService: mockService}
+
+	handler := NewEpicHandler(recorder)
+	router, authService := setupEpicTestRouter()
+	router.Use(authService.Middleware())
+	router.GET("/epics/:id", handler.GetEpic)
+
+	type requestKey struct{}
+	req, err := createAuthenticatedEpicRequest("GET", "/epics/EP-001", nil, authService)
+	assert.NoError(t, err)
+	req = req.WithContext(context.WithValue(req.Context(), requestKey{}, "request"))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, recorder.ctx)
+	assert.Equal(t, "request", recorder.ctx.Value(requestKey{}))
+	mockService.AssertExpectations(t)
+}
+
 func TestEpicHandler_ListEpics(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -285,6 +338,25 @@ func TestEpicHandler_ListEpics(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:        "sort keys are passed to the service",
+			queryParams: "?sort=priority:asc,updated_at:desc&order_by=title",
+			setupMock: func(mockService *MockEpicService) {
+				mockService.On("ListEpics", mock.MatchedBy(func(filters service.EpicFilters) bool {
+					return filters.Sort == "priority:asc,updated_at:desc" && filters.OrderBy == "title"
+				})).Return([]models.Epic{}, int64(0), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "invalid sort keys",
+			queryParams: "?sort=description:asc",
+			setupMock: func(mockService *MockEpicService) {
+				mockService.On("ListEpics", mock.AnythingOfType("service.EpicFilters")).
+					Return([]models.Epic(nil), int64(0), fmt.Errorf("%w: cannot sort by description", service.ErrInvalidSort))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// EpicMergeHandler handles HTTP requests for finding and merging duplicate epics
+type EpicMergeHandler struct {
+	mergeService service.EpicMergeService
+}
+
+// NewEpicMergeHandler creates a new epic merge handler instance
+func NewEpicMergeHandler(mergeService service.EpicMergeService) *EpicMergeHandler {
+	return &EpicMergeHandler{
+		mergeService: mergeService,
+	}
+}
+
+// FindDuplicates handles GET /api/v1/epics/duplicates
+// @Summary Find epics with duplicate titles
+// @Description List groups of epics whose titles only differ in case, spacing or punctuation, oldest epic first. Candidates for POST /api/v1/epics/merge.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Groups of epics with the same normalized title"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/duplicates [get]
+func (h *EpicMergeHandler) FindDuplicates(c *gin.Context) {
+	ctx := c.Request.Context()
+	groups, err := h.mergeService.FindDuplicateTitles(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to find duplicate epics")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"groups": groups,
+		"count":  len(groups),
+	})
+}
+
+// MergeEpics handles POST /api/v1/epics/merge
+// @Summary Merge a duplicate epic into another epic
+// @Description Move the user stories, steering document links, comments and relationships of the source epic to the target epic, then delete the source epic. Moved comment threads start with a note on where they came from, and the source reference ID keeps resolving to the target epic. Set dry_run to preview everything that would move without changing anything.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param merge body service.MergeEpicsRequest true "Epics to merge"
+// @Success 200 {object} service.EpicMergeResult "Epics merged, or the preview of a dry run"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or an epic merged into itself"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 423 {object} map[string]interface{} "One of the epics is locked for editing by another user"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/merge [post]
+func (h *EpicMergeHandler) MergeEpics(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.MergeEpicsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	result, err := h.mergeService.MergeEpics(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to merge epics")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// EpicParentHandler handles HTTP requests for nesting epics under parent epics
+type EpicParentHandler struct {
+	epicParentService service.EpicParentService
+}
+
+// NewEpicParentHandler creates a new epic parent handler instance
+func NewEpicParentHandler(epicParentService service.EpicParentService) *EpicParentHandler {
+	return &EpicParentHandler{
+		epicParentService: epicParentService,
+	}
+}
+
+// SetParentEpic handles PUT /api/v1/epics/:id/parent
+// @Summary Nest an epic under a parent epic
+// @Description Nest an epic under a parent epic to model an initiative spanning several epics, or make it a top-level epic again with a null parent_epic_id. An epic cannot be nested under itself or under an epic nested under it. Nested epics are listed under their parent in the hierarchy tree, outline and path, and the progress of an epic rolls up the epics nested under it.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Param request body service.SetParentEpicRequest true "Parent epic"
+// @Success 200 {object} models.Epic "Updated epic"
+// @Failure 400 {object} ErrorResponse "Invalid request body, self or circular nesting"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Epic or parent epic not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/parent [put]
+func (h *EpicParentHandler) SetParentEpic(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.SetParentEpicRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+			Code:    "VALIDATION_ERROR",
+			Message: "Invalid request body: " + err.Error(),
+		}})
+		return
+	}
+
+	epic, err := h.epicParentService.SetParent(ctx, c.Param("id"), req.ParentEpicID, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to set parent epic")
+		return
+	}
+
+	c.JSON(http.StatusOK, epic)
+}
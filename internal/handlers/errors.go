@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/apperrors"
+)
+
+// ErrorResponse represents an error response
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail represents error details
+type ErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// respondWithError writes err as an error response with the HTTP status and code of its domain error kind.
+// Internal and unclassified errors are reported with fallbackMessage so that their details stay in the logs.
+func respondWithError(c *gin.Context, err error, fallbackMessage string) {
+	status := apperrors.HTTPStatus(err)
+	detail := ErrorDetail{Code: apperrors.CodeOf(err), Message: err.Error()}
+	if apperrors.KindOf(err) == apperrors.KindInternal {
+		detail = ErrorDetail{Code: apperrors.CodeInternal, Message: fallbackMessage}
+	}
+	c.JSON(status, ErrorResponse{Error: detail})
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/service"
+)
+
+func TestRespondWithError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedCode    string
+		expectedMessage string
+	}{
+		{
+			name:            "domain error",
+			err:             service.ErrGlossaryTermExists,
+			expectedStatus:  http.StatusConflict,
+			expectedCode:    "GLOSSARY_TERM_EXISTS",
+			expectedMessage: "glossary term already exists",
+		},
+		{
+			name:            "wrapped domain error keeps its details",
+			err:             fmt.Errorf("%w: depth 5", service.ErrInvalidGraphDepth),
+			expectedStatus:  http.StatusBadRequest,
+			expectedCode:    "VALIDATION_ERROR",
+			expectedMessage: "depth must be between 1 and 3: depth 5",
+		},
+		{
+			name:            "unclassified error is hidden",
+			err:             errors.New("pq: connection refused"),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    "INTERNAL_ERROR",
+			expectedMessage: "Failed to load",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			respondWithError(c, tt.err, "Failed to load")
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			var response ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.expectedCode, response.Error.Code)
+			assert.Equal(t, tt.expectedMessage, response.Error.Message)
+		})
+	}
+}
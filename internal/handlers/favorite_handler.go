@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// FavoriteItemListResponse represents the response for listing favorite entities
+type FavoriteItemListResponse = ListResponse[service.FavoriteItem]
+
+// includeIsFavorite is the include value that adds the current user's is_favorite flag to list responses
+const includeIsFavorite = "is_favorite"
+
+// FavoriteHandler handles HTTP requests for pinned entities
+type FavoriteHandler struct {
+	favoriteService service.FavoriteService
+}
+
+// NewFavoriteHandler creates a new favorite handler instance
+func NewFavoriteHandler(favoriteService service.FavoriteService) *FavoriteHandler {
+	return &FavoriteHandler{
+		favoriteService: favoriteService,
+	}
+}
+
+// AddFavorite handles POST /api/v1/{entityType}/:id/favorite
+// @Summary Pin an entity as a favorite
+// @Description Add an entity to the current user's favorites. Pinning an entity that is already a favorite has no effect.
+// @Tags favorites
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} service.FavoriteItem "Entity pinned"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/favorite [post]
+// @Router /api/v1/user-stories/{id}/favorite [post]
+// @Router /api/v1/acceptance-criteria/{id}/favorite [post]
+// @Router /api/v1/requirements/{id}/favorite [post]
+func (h *FavoriteHandler) AddFavorite(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.favoriteService.AddFavorite(ctx, entityType, c.Param("id"), userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to add favorite")
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// RemoveFavorite handles DELETE /api/v1/{entityType}/:id/favorite
+// @Summary Unpin a favorite entity
+// @Description Remove an entity from the current user's favorites
+// @Tags favorites
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 204 "Entity unpinned"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found or not a favorite"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/favorite [delete]
+// @Router /api/v1/user-stories/{id}/favorite [delete]
+// @Router /api/v1/acceptance-criteria/{id}/favorite [delete]
+// @Router /api/v1/requirements/{id}/favorite [delete]
+func (h *FavoriteHandler) RemoveFavorite(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.favoriteService.RemoveFavorite(ctx, entityType, c.Param("id"), userID); err != nil {
+		respondWithError(c, err, "Failed to remove favorite")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetMyFavorites handles GET /api/v1/users/me/favorites
+// @Summary List the current user's favorite entities
+// @Description Retrieve the entities the current user pinned, most recently pinned first. Entities deleted since they were pinned are left out.
+// @Tags favorites
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} FavoriteItemListResponse "Favorite entities"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/favorites [get]
+func (h *FavoriteHandler) GetMyFavorites(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.favoriteService.ListFavorites(ctx, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to list favorites")
+		return
+	}
+
+	SendListResponse(c, items, int64(len(items)), len(items), 0)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// MockFavoriteService is a mock implementation of FavoriteService
+type MockFavoriteService struct {
+	mock.Mock
+}
+
+func (m *MockFavoriteService) AddFavorite(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) (*service.FavoriteItem, error) {
+	args := m.Called(entityType, idOrReference, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.FavoriteItem), args.Error(1)
+}
+
+func (m *MockFavoriteService) RemoveFavorite(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) error {
+	args := m.Called(entityType, idOrReference, userID)
+	return args.Error(0)
+}
+
+func (m *MockFavoriteService) ListFavorites(ctx context.Context, userID uuid.UUID) ([]service.FavoriteItem, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]service.FavoriteItem), args.Error(1)
+}
+
+func (m *MockFavoriteService) FavoriteIDs(ctx context.Context, userID uuid.UUID, entityType models.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	args := m.Called(userID, entityType, entityIDs)
+	return args.Get(0).(map[uuid.UUID]bool), args.Error(1)
+}
+
+func TestEpicHandler_ListEpics_IsFavorite(t *testing.T) {
+	pinned := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Pinned"}
+	other := models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Other"}
+
+	tests := []struct {
+		name          string
+		query         string
+		expectFlagged bool
+	}{
+		{name: "flags requested", query: "?include=creator, is_favorite", expectFlagged: true},
+		{name: "flags not requested", query: "", expectFlagged: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			epicService := new(MockEpicService)
+			epicService.On("ListEpics", mock.AnythingOfType("service.EpicFilters")).Return([]models.Epic{pinned, other}, int64(2), nil)
+			favoriteService := new(MockFavoriteService)
+			if tt.expectFlagged {
+				favoriteService.On("FavoriteIDs", mock.AnythingOfType("uuid.UUID"), models.EntityTypeEpic, []uuid.UUID{pinned.ID, other.ID}).
+					Return(map[uuid.UUID]bool{pinned.ID: true}, nil)
+			}
+
+			handler := NewEpicHandler(epicService)
+			handler.SetFavoriteService(favoriteService)
+			router, authService := setupEpicTestRouter()
+			router.Use(authService.Middleware())
+			router.GET("/epics", handler.ListEpics)
+
+			req, err := createAuthenticatedEpicRequest("GET", "/epics"+tt.query, nil, authService)
+			require.NoError(t, err)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			var response struct {
+				Data []map[string]interface{} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			require.Len(t, response.Data, 2)
+			assert.Equal(t, "EP-001", response.Data[0]["reference_id"])
+			if tt.expectFlagged {
+				assert.Equal(t, true, response.Data[0]["is_favorite"])
+				assert.Equal(t, false, response.Data[1]["is_favorite"])
+			} else {
+				assert.NotContains(t, response.Data[0], "is_favorite")
+			}
+			favoriteService.AssertExpectations(t)
+		})
+	}
+}
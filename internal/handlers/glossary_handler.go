@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// GlossaryTermListResponse represents the response for listing glossary terms
+type GlossaryTermListResponse = ListResponse[models.GlossaryTerm]
+
+// GlossaryHandler handles HTTP requests for the glossary of defined terms
+type GlossaryHandler struct {
+	glossaryService service.GlossaryService
+}
+
+// NewGlossaryHandler creates a new glossary handler instance
+func NewGlossaryHandler(glossaryService service.GlossaryService) *GlossaryHandler {
+	return &GlossaryHandler{
+		glossaryService: glossaryService,
+	}
+}
+
+// CreateTerm handles POST /api/v1/glossary/terms
+// @Summary Add a glossary term
+// @Description Define a term of the project vocabulary. Terms are unique regardless of case and are recognized in requirement text.
+// @Tags glossary
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param term body service.CreateGlossaryTermRequest true "Glossary term"
+// @Success 201 {object} models.GlossaryTerm "Created glossary term"
+// @Failure 400 {object} map[string]interface{} "Invalid request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 409 {object} map[string]interface{} "Term already exists"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/glossary/terms [post]
+func (h *GlossaryHandler) CreateTerm(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateGlossaryTermRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	term, err := h.glossaryService.CreateTerm(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to create glossary term")
+		return
+	}
+
+	c.JSON(http.StatusCreated, term)
+}
+
+// ListTerms handles GET /api/v1/glossary/terms
+// @Summary List glossary terms
+// @Description Retrieve all glossary terms in alphabetical order
+// @Tags glossary
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} GlossaryTermListResponse "Glossary terms"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/glossary/terms [get]
+func (h *GlossaryHandler) ListTerms(c *gin.Context) {
+	ctx := c.Request.Context()
+	terms, err := h.glossaryService.ListTerms(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to list glossary terms")
+		return
+	}
+
+	SendListResponse(c, terms, int64(len(terms)), len(terms), 0)
+}
+
+// GetTerm handles GET /api/v1/glossary/terms/:id
+// @Summary Get a glossary term
+// @Description Retrieve a glossary term by its UUID
+// @Tags glossary
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Glossary term UUID" format(uuid)
+// @Success 200 {object} models.GlossaryTerm "Glossary term"
+// @Failure 400 {object} map[string]interface{} "Invalid term ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Term not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/glossary/terms/{id} [get]
+func (h *GlossaryHandler) GetTerm(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parseTermID(c)
+	if !ok {
+		return
+	}
+
+	term, err := h.glossaryService.GetTerm(ctx, id)
+	if err != nil {
+		respondWithError(c, err, "Failed to get glossary term")
+		return
+	}
+
+	c.JSON(http.StatusOK, term)
+}
+
+// UpdateTerm handles PUT /api/v1/glossary/terms/:id
+// @Summary Update a glossary term
+// @Description Change the text or definition of a glossary term. Only provided fields are updated.
+// @Tags glossary
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Glossary term UUID" format(uuid)
+// @Param term body service.UpdateGlossaryTermRequest true "Glossary term changes"
+// @Success 200 {object} models.GlossaryTerm "Updated glossary term"
+// @Failure 400 {object} map[string]interface{} "Invalid term ID or request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Term not found"
+// @Failure 409 {object} map[string]interface{} "Term already exists"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/glossary/terms/{id} [put]
+func (h *GlossaryHandler) UpdateTerm(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parseTermID(c)
+	if !ok {
+		return
+	}
+
+	var req service.UpdateGlossaryTermRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	term, err := h.glossaryService.UpdateTerm(ctx, id, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to update glossary term")
+		return
+	}
+
+	c.JSON(http.StatusOK, term)
+}
+
+// DeleteTerm handles DELETE /api/v1/glossary/terms/:id
+// @Summary Delete a glossary term
+// @Description Remove a term from the glossary
+// @Tags glossary
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Glossary term UUID" format(uuid)
+// @Success 204 "Term deleted"
+// @Failure 400 {object} map[string]interface{} "Invalid term ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Term not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/glossary/terms/{id} [delete]
+func (h *GlossaryHandler) DeleteTerm(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parseTermID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.glossaryService.DeleteTerm(ctx, id); err != nil {
+		respondWithError(c, err, "Failed to delete glossary term")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetUndefinedTermsReport handles GET /api/v1/glossary/undefined-terms
+// @Summary Report undefined terms
+// @Description Quality report of capitalized terms (such as "Payment Gateway" or "SLA") used in requirement titles and descriptions that have no glossary definition, most frequent first. Capitalized words that only start a sentence are ignored.
+// @Tags glossary
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.UndefinedTermsReport "Undefined terms report"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/glossary/undefined-terms [get]
+func (h *GlossaryHandler) GetUndefinedTermsReport(c *gin.Context) {
+	ctx := c.Request.Context()
+	report, err := h.glossaryService.GetUndefinedTermsReport(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to build undefined terms report")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// parseTermID parses the term ID path parameter, writing a 400 response if it is invalid
+func (h *GlossaryHandler) parseTermID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid glossary term ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
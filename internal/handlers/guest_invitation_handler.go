@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// GuestInvitationListResponse represents the response for listing the guest invitations of an epic
+type GuestInvitationListResponse = ListResponse[models.GuestInvitation]
+
+// AcceptGuestInvitationRequest represents a guest signing in with an invitation token
+// @Description Request payload for signing in with a guest invitation token
+type AcceptGuestInvitationRequest struct {
+	Token string `json:"token" binding:"required" example:"Xq3b9T0m1Yk..."` // Invitation token from the invitation email
+}
+
+// AcceptGuestInvitationResponse represents the access token of a guest
+// @Description Access token limited to reading and commenting on the epic of the invitation
+type AcceptGuestInvitationResponse struct {
+	Token      string                 `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."` // JWT access token of the guest; it cannot be refreshed
+	ExpiresAt  time.Time              `json:"expires_at" example:"2023-01-08T00:00:00Z"`               // Token expiration timestamp, the expiry of the invitation
+	Invitation models.GuestInvitation `json:"invitation"`                                              // Accepted invitation with the guest account
+}
+
+// GuestInvitationHandler handles HTTP requests for inviting external reviewers to comment on an epic
+type GuestInvitationHandler struct {
+	authService            *auth.Service
+	guestInvitationService service.GuestInvitationService
+}
+
+// NewGuestInvitationHandler creates a new guest invitation handler instance
+func NewGuestInvitationHandler(authService *auth.Service, guestInvitationService service.GuestInvitationService) *GuestInvitationHandler {
+	return &GuestInvitationHandler{
+		authService:            authService,
+		guestInvitationService: guestInvitationService,
+	}
+}
+
+// CreateInvitation handles POST /api/v1/epics/:id/invitations
+// @Summary Invite an external reviewer to an epic
+// @Description Email an invitation token to an external reviewer. Signing in with it grants Commenter access to this epic only, until the invitation expires or is revoked. The guest account of the address is created on its first invitation; addresses of registered users cannot be invited. The token is only returned here.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Param invitation body service.CreateGuestInvitationRequest true "Email address and expiry"
+// @Success 201 {object} service.GuestInvitationCreated "Created invitation with its token"
+// @Failure 400 {object} ErrorResponse "Invalid email address or expiry"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "User role required"
+// @Failure 404 {object} ErrorResponse "Epic not found"
+// @Failure 409 {object} ErrorResponse "Email belongs to a registered user"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/invitations [post]
+func (h *GuestInvitationHandler) CreateInvitation(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateGuestInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	invitation, err := h.guestInvitationService.CreateInvitation(ctx, c.Param("id"), req, userID, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to create guest invitation")
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// ListInvitations handles GET /api/v1/epics/:id/invitations
+// @Summary List the guest invitations of an epic
+// @Description List the invitations of external reviewers to an epic with their status, newest first. Tokens are not included.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Success 200 {object} GuestInvitationListResponse "Guest invitations"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "User role required"
+// @Failure 404 {object} ErrorResponse "Epic not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/invitations [get]
+func (h *GuestInvitationHandler) ListInvitations(c *gin.Context) {
+	ctx := c.Request.Context()
+	invitations, err := h.guestInvitationService.ListInvitations(ctx, c.Param("id"), time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to list guest invitations")
+		return
+	}
+
+	SendListResponse(c, invitations, int64(len(invitations)), len(invitations), 0)
+}
+
+// RevokeInvitation handles DELETE /api/v1/epics/:id/invitations/:invitation_id
+// @Summary Revoke a guest invitation
+// @Description Revoke an invitation to an epic. The guest loses access immediately, including with tokens already issued; the guest account is deactivated by the next guest cleanup unless another invitation is active.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Param invitation_id path string true "Invitation ID" format(uuid)
+// @Success 204 "Invitation revoked"
+// @Failure 400 {object} ErrorResponse "Invalid invitation ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "User role required"
+// @Failure 404 {object} ErrorResponse "Epic or invitation not found"
+// @Failure 409 {object} ErrorResponse "Invitation already revoked"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/epics/{id}/invitations/{invitation_id} [delete]
+func (h *GuestInvitationHandler) RevokeInvitation(c *gin.Context) {
+	ctx := c.Request.Context()
+	invitationID, err := uuid.Parse(c.Param("invitation_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid invitation ID format",
+			},
+		})
+		return
+	}
+
+	if err := h.guestInvitationService.RevokeInvitation(ctx, c.Param("id"), invitationID, time.Now()); err != nil {
+		respondWithError(c, err, "Failed to revoke guest invitation")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AcceptInvitation handles POST /auth/invitations/accept
+// @Summary Sign in with a guest invitation
+// @Description Exchange an invitation token for an access token that can read and comment on the epic of the invitation only. The invitation token can be used again until the invitation expires or is revoked; the access token cannot be refreshed and expires with the invitation.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Param request body AcceptGuestInvitationRequest true "Invitation token"
+// @Success 200 {object} AcceptGuestInvitationResponse "Guest access token"
+// @Failure 400 {object} ErrorResponse "Invalid request body"
+// @Failure 401 {object} ErrorResponse "Invitation unknown, revoked or expired"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /auth/invitations/accept [post]
+func (h *GuestInvitationHandler) AcceptInvitation(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req AcceptGuestInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	invitation, err := h.guestInvitationService.AcceptInvitation(ctx, req.Token, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to accept guest invitation")
+		return
+	}
+
+	token, err := h.authService.GenerateGuestToken(invitation, invitation.Guest)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "INTERNAL_ERROR",
+				Message: "Failed to generate guest token",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, AcceptGuestInvitationResponse{
+		Token:      token,
+		ExpiresAt:  invitation.ExpiresAt,
+		Invitation: *invitation,
+	})
+}
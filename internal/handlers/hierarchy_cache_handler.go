@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// HierarchyCacheHandler handles HTTP requests for the maintenance of the hierarchy cache
+type HierarchyCacheHandler struct {
+	hierarchyCacheService service.HierarchyCacheService
+}
+
+// NewHierarchyCacheHandler creates a new hierarchy cache handler instance
+func NewHierarchyCacheHandler(hierarchyCacheService service.HierarchyCacheService) *HierarchyCacheHandler {
+	return &HierarchyCacheHandler{
+		hierarchyCacheService: hierarchyCacheService,
+	}
+}
+
+// Check handles GET /api/v1/admin/hierarchy-cache/check
+// @Summary Check the hierarchy cache
+// @Description Compare the hierarchy cache read by the breadcrumb, outline and progress endpoints with the epics, user stories, acceptance criteria and requirements it is built from. Reports entities missing from the cache, cache rows that differ from their entity and rows of deleted entities, with up to 20 samples.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.HierarchyCacheReport "Consistency report"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/admin/hierarchy-cache/check [get]
+func (h *HierarchyCacheHandler) Check(c *gin.Context) {
+	ctx := c.Request.Context()
+	report, err := h.hierarchyCacheService.Check(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to check hierarchy cache")
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// Rebuild handles POST /api/v1/admin/hierarchy-cache/rebuild
+// @Summary Rebuild the hierarchy cache
+// @Description Replace the hierarchy cache with rows built from the epics, user stories, acceptance criteria and requirements in a single transaction. Database triggers keep the cache up to date; rebuild it when a check finds it inconsistent, for example after writes that bypassed the triggers.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.HierarchyCacheRebuild "Cache rebuilt"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/admin/hierarchy-cache/rebuild [post]
+func (h *HierarchyCacheHandler) Rebuild(c *gin.Context) {
+	ctx := c.Request.Context()
+	rebuild, err := h.hierarchyCacheService.Rebuild(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to rebuild hierarchy cache")
+		return
+	}
+	c.JSON(http.StatusOK, rebuild)
+}
@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// ImpersonationSessionListResponse represents the response for listing impersonation sessions
+type ImpersonationSessionListResponse = ListResponse[models.ImpersonationSession]
+
+// ImpersonatedRequestListResponse represents the response for listing the requests of an impersonation session
+type ImpersonatedRequestListResponse = ListResponse[models.ImpersonatedRequest]
+
+// ImpersonateRequest represents a request to impersonate a user
+// @Description Request payload for starting an impersonation session
+type ImpersonateRequest struct {
+	Reason string `json:"reason" binding:"required" example:"Ticket 4711: user cannot see epic EP-012"` // Why the user is impersonated, kept in the audit log (required, at most 500 characters)
+}
+
+// ImpersonateResponse represents the token of an impersonation session
+// @Description Short-lived access token acting as the impersonated user
+type ImpersonateResponse struct {
+	Token     string                      `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."` // JWT access token acting as the user; it cannot be refreshed
+	ExpiresAt time.Time                   `json:"expires_at" example:"2023-01-01T10:15:00Z"`               // Token expiration timestamp
+	Session   models.ImpersonationSession `json:"session"`                                                 // Recorded impersonation session
+}
+
+// ImpersonationHandler handles HTTP requests for administrators impersonating users
+type ImpersonationHandler struct {
+	authService          *auth.Service
+	impersonationService service.ImpersonationService
+}
+
+// NewImpersonationHandler creates a new impersonation handler instance
+func NewImpersonationHandler(authService *auth.Service, impersonationService service.ImpersonationService) *ImpersonationHandler {
+	return &ImpersonationHandler{
+		authService:          authService,
+		impersonationService: impersonationService,
+	}
+}
+
+// Impersonate handles POST /auth/impersonate/:user_id
+// @Summary Impersonate a user
+// @Description Issue a 15-minute access token acting as a user, to reproduce the permission and visibility problems they report. The session and every request made with the token are recorded in the impersonation audit log. Administrators and deactivated users cannot be impersonated, and the token cannot be refreshed, change the password of the user, manage their personal access tokens, create feeds, invite guests or schedule reports.
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param user_id path string true "UUID of the user to impersonate" format(uuid)
+// @Param request body ImpersonateRequest true "Reason for the impersonation"
+// @Success 201 {object} ImpersonateResponse "Impersonation token"
+// @Failure 400 {object} map[string]interface{} "Invalid user ID, missing reason, deactivated user or own account"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required or the user is an administrator"
+// @Failure 404 {object} map[string]interface{} "User not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /auth/impersonate/{user_id} [post]
+func (h *ImpersonationHandler) Impersonate(c *gin.Context) {
+	ctx := c.Request.Context()
+	adminID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid user ID format",
+			},
+		})
+		return
+	}
+
+	var req ImpersonateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	session, err := h.impersonationService.StartSession(ctx, service.StartImpersonationRequest{
+		AdminID:   adminID,
+		UserID:    userID,
+		Reason:    req.Reason,
+		ClientIP:  c.ClientIP(),
+		UserAgent: c.GetHeader("User-Agent"),
+	}, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to start impersonation session")
+		return
+	}
+
+	token, err := h.authService.GenerateImpersonationToken(session, session.User)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to generate impersonation token",
+			},
+		})
+		return
+	}
+	auth.NewSecurityLogger().LogImpersonationStarted(c.Request.Context(), session, session.User.Username)
+
+	c.JSON(http.StatusCreated, ImpersonateResponse{
+		Token:     token,
+		ExpiresAt: session.ExpiresAt,
+		Session:   *session,
+	})
+}
+
+// ListSessions handles GET /api/v1/admin/impersonation-sessions
+// @Summary List impersonation sessions
+// @Description Retrieve the impersonation audit log: who impersonated whom, when and why, newest first
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param admin_id query string false "Only sessions of this administrator" format(uuid)
+// @Param user_id query string false "Only sessions impersonating this user" format(uuid)
+// @Param limit query int false "Maximum number of sessions to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of sessions to skip" minimum(0) default(0)
+// @Success 200 {object} ImpersonationSessionListResponse "Impersonation sessions"
+// @Failure 400 {object} map[string]interface{} "Invalid administrator or user ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/impersonation-sessions [get]
+func (h *ImpersonationHandler) ListSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	var filter repository.ImpersonationSessionFilter
+	for param, target := range map[string]**uuid.UUID{"admin_id": &filter.AdminID, "user_id": &filter.UserID} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid " + param + " format",
+				},
+			})
+			return
+		}
+		*target = &id
+	}
+	filter.Limit, filter.Offset = parseImpersonationPage(c)
+
+	sessions, total, err := h.impersonationService.ListSessions(ctx, filter)
+	if err != nil {
+		respondWithError(c, err, "Failed to list impersonation sessions")
+		return
+	}
+
+	SendListResponse(c, sessions, total, filter.Limit, filter.Offset)
+}
+
+// GetSession handles GET /api/v1/admin/impersonation-sessions/:id
+// @Summary Get an impersonation session
+// @Description Retrieve an impersonation session with the administrator and the impersonated user
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Impersonation session UUID" format(uuid)
+// @Success 200 {object} models.ImpersonationSession "Impersonation session"
+// @Failure 400 {object} map[string]interface{} "Invalid session ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Impersonation session not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/impersonation-sessions/{id} [get]
+func (h *ImpersonationHandler) GetSession(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseImpersonationSessionID(c)
+	if !ok {
+		return
+	}
+
+	session, err := h.impersonationService.GetSession(ctx, id)
+	if err != nil {
+		respondWithError(c, err, "Failed to get impersonation session")
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// ListRequests handles GET /api/v1/admin/impersonation-sessions/:id/requests
+// @Summary List the requests of an impersonation session
+// @Description Retrieve every request made with the token of an impersonation session, including rejected ones, oldest first
+// @Tags authentication
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Impersonation session UUID" format(uuid)
+// @Param limit query int false "Maximum number of requests to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of requests to skip" minimum(0) default(0)
+// @Success 200 {object} ImpersonatedRequestListResponse "Requests of the session"
+// @Failure 400 {object} map[string]interface{} "Invalid session ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Impersonation session not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/impersonation-sessions/{id}/requests [get]
+func (h *ImpersonationHandler) ListRequests(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseImpersonationSessionID(c)
+	if !ok {
+		return
+	}
+	limit, offset := parseImpersonationPage(c)
+
+	requests, total, err := h.impersonationService.ListRequests(ctx, id, limit, offset)
+	if err != nil {
+		respondWithError(c, err, "Failed to list impersonated requests")
+		return
+	}
+
+	SendListResponse(c, requests, total, limit, offset)
+}
+
+// parseImpersonationSessionID parses the session ID path parameter, responding with 400 when it is invalid
+func parseImpersonationSessionID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid impersonation session ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// parseImpersonationPage reads the limit (default 50, at most 100) and offset query parameters
+func parseImpersonationPage(c *gin.Context) (int, int) {
+	limit, offset := 50, 0
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(c.Query("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+	return limit, offset
+}
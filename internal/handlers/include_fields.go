@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// includeCommentCounts is the include value that adds total and unresolved comment counts to list responses
+const includeCommentCounts = "comment_counts"
+
+// requestedIncludes returns the trimmed, non-empty values of the comma-separated include query parameter
+func requestedIncludes(c *gin.Context) []string {
+	var includes []string
+	for _, include := range strings.Split(c.Query("include"), ",") {
+		if trimmed := strings.TrimSpace(include); trimmed != "" {
+			includes = append(includes, trimmed)
+		}
+	}
+	return includes
+}
+
+// favoritesRequested reports whether the include query parameter asks for is_favorite flags
+func favoritesRequested(c *gin.Context) bool {
+	for _, include := range requestedIncludes(c) {
+		if include == includeIsFavorite {
+			return true
+		}
+	}
+	return false
+}
+
+// includeSource computes fields of its own, such as the risk of requirements, for the requested include
+// values. It returns nil when none of its fields was requested.
+type includeSource func(ctx context.Context, includes []string, ids []uuid.UUID) (map[uuid.UUID]map[string]interface{}, error)
+
+// commentCountFields returns a source computing the comment_counts field, the total and unresolved comment
+// counts of each entity, with one grouped query when include=comment_counts was requested
+func commentCountFields(countService service.EntityCountService, entityType models.EntityType) includeSource {
+	return func(ctx context.Context, includes []string, ids []uuid.UUID) (map[uuid.UUID]map[string]interface{}, error) {
+		if countService == nil || !slices.Contains(includes, includeCommentCounts) {
+			return nil, nil
+		}
+		counts, err := countService.CommentCounts(ctx, entityType, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		fields := make(map[uuid.UUID]map[string]interface{}, len(counts))
+		for id, commentCounts := range counts {
+			fields[id] = map[string]interface{}{includeCommentCounts: commentCounts}
+		}
+		return fields, nil
+	}
+}
+
+// includeListFields adds the fields requested with the include query parameter to listed entities:
+// is_favorite, the current user's favorite flag, aggregate counts such as comments_count and the fields
+// of the given sources, computed for the whole list at once. The entities are returned unchanged when
+// nothing was requested or the services are not enabled.
+func includeListFields[T any, PT interface {
+	*T
+	json.Marshaler
+}](c *gin.Context, favoriteService service.FavoriteService, countService service.EntityCountService, entityType models.EntityType, entities []T, idOf func(*T) uuid.UUID, sources ...includeSource) (interface{}, error) {
+	ids := make([]uuid.UUID, len(entities))
+	for i := range entities {
+		ids[i] = idOf(&entities[i])
+	}
+
+	fields, err := includedFields(c, favoriteService, countService, entityType, ids, sources...)
+	if err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return entities, nil
+	}
+
+	decorated := make([]service.EntityWithFields, len(entities))
+	for i := range entities {
+		decorated[i] = service.EntityWithFields{Entity: PT(&entities[i]), Fields: fields[ids[i]]}
+	}
+	return decorated, nil
+}
+
+// includeEntityFields adds the aggregate counts and source fields requested with the include query parameter
+// to a single entity. The entity is returned unchanged when nothing was requested or counts are not enabled.
+func includeEntityFields(c *gin.Context, countService service.EntityCountService, entityType models.EntityType, id uuid.UUID, entity json.Marshaler, sources ...includeSource) (interface{}, error) {
+	fields, err := includedFields(c, nil, countService, entityType, []uuid.UUID{id}, sources...)
+	if err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return entity, nil
+	}
+	return service.EntityWithFields{Entity: entity, Fields: fields[id]}, nil
+}
+
+// includedFields computes the requested include fields of each entity, or returns nil when none apply
+func includedFields(c *gin.Context, favoriteService service.FavoriteService, countService service.EntityCountService, entityType models.EntityType, ids []uuid.UUID, sources ...includeSource) (map[uuid.UUID]map[string]interface{}, error) {
+	ctx := c.Request.Context()
+	var fields map[uuid.UUID]map[string]interface{}
+	set := func(id uuid.UUID, name string, value interface{}) {
+		if fields == nil {
+			fields = make(map[uuid.UUID]map[string]interface{}, len(ids))
+		}
+		if fields[id] == nil {
+			fields[id] = make(map[string]interface{})
+		}
+		fields[id][name] = value
+	}
+
+	if favoriteService != nil && favoritesRequested(c) {
+		userIDParam, _ := auth.GetCurrentUserID(c)
+		if userID, err := uuid.Parse(userIDParam); err == nil {
+			favorites, err := favoriteService.FavoriteIDs(ctx, userID, entityType, ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range ids {
+				set(id, includeIsFavorite, favorites[id])
+			}
+		}
+	}
+
+	if countService != nil {
+		if names := countService.RequestedCounts(ctx, entityType, requestedIncludes(c)); len(names) > 0 {
+			counts, err := countService.Counts(ctx, entityType, ids, names)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range ids {
+				for _, name := range names {
+					set(id, name, counts[id][name])
+				}
+			}
+		}
+	}
+
+	for _, source := range sources {
+		sourceFields, err := source(ctx, requestedIncludes(c), ids)
+		if err != nil {
+			return nil, err
+		}
+		for id, values := range sourceFields {
+			for name, value := range values {
+				set(id, name, value)
+			}
+		}
+	}
+
+	return fields, nil
+}
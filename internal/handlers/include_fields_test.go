@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// stubCountService counts every requested aggregate as 2 and supports only comments_count
+type stubCountService struct {
+	requestedIDs []uuid.UUID
+}
+
+func (s *stubCountService) RequestedCounts(ctx context.Context, entityType models.EntityType, includes []string) []string {
+	for _, include := range includes {
+		if include == "comments_count" {
+			return []string{include}
+		}
+	}
+	return nil
+}
+
+func (s *stubCountService) Counts(ctx context.Context, entityType models.EntityType, ids []uuid.UUID, counts []string) (map[uuid.UUID]map[string]int64, error) {
+	s.requestedIDs = ids
+	result := make(map[uuid.UUID]map[string]int64)
+	for _, id := range ids {
+		result[id] = map[string]int64{"comments_count": 2}
+	}
+	return result, nil
+}
+
+func (s *stubCountService) CommentCounts(ctx context.Context, entityType models.EntityType, ids []uuid.UUID) (map[uuid.UUID]repository.CommentCounts, error) {
+	s.requestedIDs = ids
+	result := make(map[uuid.UUID]repository.CommentCounts)
+	for _, id := range ids {
+		result[id] = repository.CommentCounts{Total: 3, Unresolved: 1}
+	}
+	return result, nil
+}
+
+func TestIncludeListFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	epics := []models.Epic{{ID: uuid.New(), ReferenceID: "EP-001"}, {ID: uuid.New(), ReferenceID: "EP-002"}}
+	countService := &stubCountService{}
+
+	listWith := func(include string) []map[string]interface{} {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/epics?include="+include, nil)
+		data, err := includeListFields(c, nil, countService, models.EntityTypeEpic, epics, func(e *models.Epic) uuid.UUID { return e.ID })
+		require.NoError(t, err)
+
+		encoded, err := json.Marshal(data)
+		require.NoError(t, err)
+		var decoded []map[string]interface{}
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		return decoded
+	}
+
+	decoded := listWith("creator,%20comments_count")
+	require.Len(t, decoded, 2)
+	assert.Equal(t, "EP-002", decoded[1]["reference_id"])
+	assert.Equal(t, float64(2), decoded[1]["comments_count"])
+	assert.Equal(t, []uuid.UUID{epics[0].ID, epics[1].ID}, countService.requestedIDs)
+
+	decoded = listWith("creator")
+	require.Len(t, decoded, 2)
+	assert.NotContains(t, decoded[0], "comments_count")
+}
+
+func TestCommentCountFields(t *testing.T) {
+	ctx := context.Background()
+	gin.SetMode(gin.TestMode)
+	epics := []models.Epic{{ID: uuid.New(), ReferenceID: "EP-001"}, {ID: uuid.New(), ReferenceID: "EP-002"}}
+	countService := &stubCountService{}
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/epics?include=comment_counts", nil)
+	data, err := includeListFields(c, nil, countService, models.EntityTypeEpic, epics, func(e *models.Epic) uuid.UUID { return e.ID },
+		commentCountFields(countService, models.EntityTypeEpic))
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{epics[0].ID, epics[1].ID}, countService.requestedIDs)
+
+	encoded, err := json.Marshal(data)
+	require.NoError(t, err)
+	var decoded []map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Len(t, decoded, 2)
+	assert.Equal(t, map[string]interface{}{"total": float64(3), "unresolved": float64(1)}, decoded[1]["comment_counts"])
+
+	fields, err := commentCountFields(countService, models.EntityTypeEpic)(ctx, []string{"comments_count"}, []uuid.UUID{epics[0].ID})
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+
+	fields, err = commentCountFields(nil, models.EntityTypeEpic)(ctx, []string{"comment_counts"}, []uuid.UUID{epics[0].ID})
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+}
+
+func TestIncludeEntityFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001"}
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/epics/EP-001?include=comments_count", nil)
+	response, err := includeEntityFields(c, &stubCountService{}, models.EntityTypeEpic, epic.ID, epic)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"comments_count": int64(2)}, response.(service.EntityWithFields).Fields)
+
+	response, err = includeEntityFields(c, nil, models.EntityTypeEpic, epic.ID, epic)
+	require.NoError(t, err)
+	assert.Same(t, epic, response)
+}
+
+func TestIncludeSources(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	requirement := &models.Requirement{ID: uuid.New(), ReferenceID: "REQ-001"}
+	handler := &RequirementHandler{riskService: stubRiskService{}}
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/requirements/REQ-001?include=risk,comments_count", nil)
+	response, err := includeEntityFields(c, &stubCountService{}, models.EntityTypeRequirement, requirement.ID, requirement, handler.riskFields)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"comments_count": int64(2),
+		"risk":           service.RequirementRisk{Score: 15, Level: service.RiskLevelLow, MissingAcceptanceCriteria: true},
+	}, response.(service.EntityWithFields).Fields)
+
+	c, _ = gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/requirements/REQ-001", nil)
+	response, err = includeEntityFields(c, nil, models.EntityTypeRequirement, requirement.ID, requirement, handler.riskFields)
+	require.NoError(t, err)
+	assert.Same(t, requirement, response)
+}
+
+// stubRiskService scores every requirement as missing acceptance criteria
+type stubRiskService struct {
+	service.RequirementRiskService
+}
+
+func (stubRiskService) Scores(ctx context.Context, ids []uuid.UUID, now time.Time) (map[uuid.UUID]service.RequirementRisk, error) {
+	scores := make(map[uuid.UUID]service.RequirementRisk, len(ids))
+	for _, id := range ids {
+		scores[id] = service.RequirementRisk{Score: 15, Level: service.RiskLevelLow, MissingAcceptanceCriteria: true}
+	}
+	return scores, nil
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// LLMUsageHandler handles HTTP requests for language model usage and cost reports
+type LLMUsageHandler struct {
+	usageService service.LLMUsageService
+}
+
+// NewLLMUsageHandler creates a new language model usage handler instance
+func NewLLMUsageHandler(usageService service.LLMUsageService) *LLMUsageHandler {
+	return &LLMUsageHandler{
+		usageService: usageService,
+	}
+}
+
+// GetUsage handles GET /api/v1/admin/llm-usage
+// @Summary Get AI usage and cost
+// @Description Retrieve the language model calls of AI features in a calendar month (UTC): calls, failures, tokens, estimated cost and average latency in total, per feature and for the users with the highest cost. Costs are estimated from the configured token prices. When a monthly budget is configured, its state is included; administrators are emailed when spending reaches the alert threshold and the whole budget.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param month query string false "Month as YYYY-MM (default current month)"
+// @Param top query int false "Number of users with the highest cost (default 10, max 100)"
+// @Success 200 {object} service.LLMUsageReport "AI usage"
+// @Failure 400 {object} map[string]interface{} "Invalid query parameter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/llm-usage [get]
+func (h *LLMUsageHandler) GetUsage(c *gin.Context) {
+	ctx := c.Request.Context()
+	var query service.LLMUsageQuery
+	if raw := c.Query("month"); raw != "" {
+		month, err := time.Parse("2006-01", raw)
+		if err != nil {
+			h.invalidParameter(c, "month")
+			return
+		}
+		query.Month = month
+	}
+	if raw := c.Query("top"); raw != "" {
+		top, err := strconv.Atoi(raw)
+		if err != nil || top < 1 {
+			h.invalidParameter(c, "top")
+			return
+		}
+		query.Top = top
+	}
+
+	usage, err := h.usageService.GetUsage(ctx, query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to get AI usage",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
+
+// ListCalls handles GET /api/v1/admin/llm-usage/calls
+// @Summary List AI calls
+// @Description List the most recent language model calls, newest first, with provider, model, tokens, latency, estimated cost and error. Prompts and responses are included unless content logging is disabled with LLM_LOG_CONTENT.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param feature query string false "AI feature, such as comment_summary or epic_decomposition"
+// @Param user_id query string false "User the calls were made for"
+// @Param limit query int false "Number of calls (default 50, max 500)"
+// @Success 200 {array} models.LLMCall "Language model calls"
+// @Failure 400 {object} map[string]interface{} "Invalid query parameter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/llm-usage/calls [get]
+func (h *LLMUsageHandler) ListCalls(c *gin.Context) {
+	ctx := c.Request.Context()
+	filter := repository.LLMCallFilter{Feature: c.Query("feature")}
+	if raw := c.Query("user_id"); raw != "" {
+		userID, err := uuid.Parse(raw)
+		if err != nil {
+			h.invalidParameter(c, "user_id")
+			return
+		}
+		filter.UserID = &userID
+	}
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			h.invalidParameter(c, "limit")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	calls, err := h.usageService.ListCalls(ctx, filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to list AI calls",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, calls)
+}
+
+// invalidParameter responds with a validation error for a query parameter
+func (h *LLMUsageHandler) invalidParameter(c *gin.Context, param string) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error": gin.H{
+			"code":    "VALIDATION_ERROR",
+			"message": "Invalid " + param + " parameter",
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// acceptedLanguages returns the language tags of an Accept-Language header, most preferred first.
+// Tags with q=0 are left out; tags with an invalid weight count as q=1.
+func acceptedLanguages(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+	var tags []weightedTag
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if ok && strings.TrimSpace(name) == "q" {
+				if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality > 0 {
+			tags = append(tags, weightedTag{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].quality > tags[j].quality })
+
+	languages := make([]string, len(tags))
+	for i := range tags {
+		languages[i] = tags[i].tag
+	}
+	return languages
+}
+
+// localizeEntities replaces the titles and descriptions of entities with their translation into the locale
+// preferred by the Accept-Language header of the request, and sets the Content-Language header to the
+// locales of the response. Nothing changes when no translation service is set.
+func localizeEntities[T any](c *gin.Context, translations service.TranslationService, entityType models.EntityType, entities []*T, id func(*T) uuid.UUID, translate func(*T, *models.EntityTranslation)) error {
+	ctx := c.Request.Context()
+	if translations == nil {
+		return nil
+	}
+	c.Writer.Header().Add("Vary", "Accept-Language")
+
+	ids := make([]uuid.UUID, len(entities))
+	for i, entity := range entities {
+		ids[i] = id(entity)
+	}
+	var found map[uuid.UUID]*models.EntityTranslation
+	if accepted := acceptedLanguages(c.GetHeader("Accept-Language")); len(accepted) > 0 {
+		var err error
+		if found, err = translations.Translations(ctx, entityType, ids, accepted); err != nil {
+			return err
+		}
+	}
+
+	var locales []string
+	for i, entity := range entities {
+		locale := translations.ContentLocale(ctx)
+		if translation, ok := found[ids[i]]; ok {
+			translate(entity, translation)
+			locale = translation.Locale
+		}
+		if !slices.Contains(locales, locale) {
+			locales = append(locales, locale)
+		}
+	}
+	if len(locales) > 0 {
+		c.Header("Content-Language", strings.Join(locales, ", "))
+	}
+	return nil
+}
+
+// entityPointers returns pointers to the elements of a slice, so that they can be localized in place
+func entityPointers[T any](entities []T) []*T {
+	pointers := make([]*T, len(entities))
+	for i := range entities {
+		pointers[i] = &entities[i]
+	}
+	return pointers
+}
+
+// translateEpic applies a translation to an epic; fields the translation leaves out are kept
+func translateEpic(epic *models.Epic, translation *models.EntityTranslation) {
+	if translation.Title != nil {
+		epic.Title = *translation.Title
+	}
+	if translation.Description != nil {
+		epic.Description = translation.Description
+	}
+}
+
+// translateUserStory applies a translation to a user story; fields the translation leaves out are kept
+func translateUserStory(userStory *models.UserStory, translation *models.EntityTranslation) {
+	if translation.Title != nil {
+		userStory.Title = *translation.Title
+	}
+	if translation.Description != nil {
+		userStory.Description = translation.Description
+	}
+}
+
+// translateAcceptanceCriteria applies a translation to acceptance criteria
+func translateAcceptanceCriteria(acceptanceCriteria *models.AcceptanceCriteria, translation *models.EntityTranslation) {
+	if translation.Description != nil {
+		acceptanceCriteria.Description = *translation.Description
+	}
+}
+
+// translateRequirement applies a translation to a requirement; fields the translation leaves out are kept
+func translateRequirement(requirement *models.Requirement, translation *models.EntityTranslation) {
+	if translation.Title != nil {
+		requirement.Title = *translation.Title
+	}
+	if translation.Description != nil {
+		requirement.Description = translation.Description
+	}
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// stubTranslationService translates the entities it knows into Russian when Russian is accepted
+type stubTranslationService struct {
+	service.TranslationService
+	titles map[uuid.UUID]string
+}
+
+func (s *stubTranslationService) ContentLocale(ctx context.Context) string { return "en" }
+
+func (s *stubTranslationService) Translations(ctx context.Context, entityType models.EntityType, ids []uuid.UUID, accepted []string) (map[uuid.UUID]*models.EntityTranslation, error) {
+	found := make(map[uuid.UUID]*models.EntityTranslation)
+	if accepted[0] != "ru" {
+		return found, nil
+	}
+	for _, id := range ids {
+		if title, ok := s.titles[id]; ok {
+			found[id] = &models.EntityTranslation{EntityType: entityType, EntityID: id, Locale: "ru", Title: &title}
+		}
+	}
+	return found, nil
+}
+
+func TestAcceptedLanguages(t *testing.T) {
+	assert.Equal(t, []string{"ru-RU", "ru", "en"}, acceptedLanguages("en;q=0.5, ru-RU, ru;q=0.9"))
+	assert.Equal(t, []string{"de", "*"}, acceptedLanguages("de, fr;q=0, *;q=0.1"))
+	assert.Equal(t, []string{"pt-BR", "en"}, acceptedLanguages("pt-BR;q=bad, en;q=0.3"))
+	assert.Empty(t, acceptedLanguages(""))
+}
+
+func TestLocalizeEntities(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	translated := models.Epic{ID: uuid.New(), Title: "Authentication"}
+	untranslated := models.Epic{ID: uuid.New(), Title: "Reporting"}
+	translations := &stubTranslationService{titles: map[uuid.UUID]string{translated.ID: "Аутентификация"}}
+
+	localize := func(acceptLanguage string, translations service.TranslationService) ([]models.Epic, http.Header) {
+		epics := []models.Epic{translated, untranslated}
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/epics", nil)
+		if acceptLanguage != "" {
+			c.Request.Header.Set("Accept-Language", acceptLanguage)
+		}
+		require.NoError(t, localizeEntities(c, translations, models.EntityTypeEpic, entityPointers(epics), func(epic *models.Epic) uuid.UUID { return epic.ID }, translateEpic))
+		return epics, w.Header()
+	}
+
+	t.Run("translates to the preferred locale", func(t *testing.T) {
+		epics, header := localize("ru, en;q=0.8", translations)
+		assert.Equal(t, "Аутентификация", epics[0].Title)
+		assert.Equal(t, "Reporting", epics[1].Title)
+		assert.Equal(t, "ru, en", header.Get("Content-Language"))
+		assert.Equal(t, "Accept-Language", header.Get("Vary"))
+	})
+
+	t.Run("keeps the original text without Accept-Language", func(t *testing.T) {
+		epics, header := localize("", translations)
+		assert.Equal(t, "Authentication", epics[0].Title)
+		assert.Equal(t, "en", header.Get("Content-Language"))
+	})
+
+	t.Run("does nothing without a translation service", func(t *testing.T) {
+		epics, header := localize("ru", nil)
+		assert.Equal(t, "Authentication", epics[0].Title)
+		assert.Empty(t, header.Get("Content-Language"))
+		assert.Empty(t, header.Get("Vary"))
+	})
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/service"
+)
+
+// MarkdownImportHandler handles HTTP requests for importing entity hierarchies from Markdown
+type MarkdownImportHandler struct {
+	importService service.MarkdownImportService
+}
+
+// NewMarkdownImportHandler creates a new Markdown import handler instance
+func NewMarkdownImportHandler(importService service.MarkdownImportService) *MarkdownImportHandler {
+	return &MarkdownImportHandler{
+		importService: importService,
+	}
+}
+
+// MarkdownImportRequest is the Markdown outline to preview or import
+type MarkdownImportRequest struct {
+	Content string `json:"content" binding:"required" example:"# Accounts"`
+}
+
+// PreviewImport handles POST /api/v1/import/markdown/preview
+// @Summary Preview a Markdown import
+// @Description Parse a Markdown outline and return the entities it would create with per-node validation errors, without creating anything. "#" headings are epics, "##" headings are user stories, list items under a user story are acceptance criteria (EARS lint findings are returned as warnings) and table rows with a Title column (and optional Type, Priority and Description columns) are requirements. Paragraphs become descriptions and "**Priority:** High" lines set priorities.
+// @Tags import
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body MarkdownImportRequest true "Markdown outline"
+// @Success 200 {object} service.MarkdownImportPlan "Parsed entities; valid is false when the import would be rejected"
+// @Failure 400 {object} map[string]interface{} "Missing or oversized content"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/import/markdown/preview [post]
+func (h *MarkdownImportHandler) PreviewImport(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req MarkdownImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	plan, err := h.importService.Preview(ctx, req.Content)
+	if err != nil {
+		h.handleError(c, err, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, plan)
+}
+
+// Import handles POST /api/v1/import/markdown
+// @Summary Import entities from Markdown
+// @Description Create the epics, user stories, acceptance criteria and requirements of a Markdown outline in one transaction, owned by and assigned to the caller. The outline format is described on the preview endpoint. When any node has errors nothing is created and the parsed plan is returned with status 422.
+// @Tags import
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body MarkdownImportRequest true "Markdown outline"
+// @Success 201 {object} service.MarkdownImportPlan "Created entities with their IDs and reference IDs"
+// @Failure 400 {object} map[string]interface{} "Missing or oversized content"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 422 {object} map[string]interface{} "Validation errors; the plan is returned in the error details"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/import/markdown [post]
+func (h *MarkdownImportHandler) Import(c *gin.Context) {
+	ctx := c.Request.Context()
+	creatorID, ok := auth.GetCurrentUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User authentication required",
+			},
+		})
+		return
+	}
+
+	var req MarkdownImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	plan, err := h.importService.Import(ctx, req.Content, uuid.MustParse(creatorID))
+	if err != nil {
+		h.handleError(c, err, plan)
+		return
+	}
+
+	c.JSON(http.StatusCreated, plan)
+}
+
+// handleError maps Markdown import service errors to HTTP responses
+func (h *MarkdownImportHandler) handleError(c *gin.Context, err error, plan *service.MarkdownImportPlan) {
+	if errors.Is(err, service.ErrImportInvalid) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": gin.H{
+				"code":    "IMPORT_INVALID",
+				"message": "Markdown import has validation errors; nothing was created",
+				"details": plan,
+			},
+		})
+		return
+	}
+	respondWithError(c, err, "Failed to import markdown")
+}
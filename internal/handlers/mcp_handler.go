@@ -19,6 +19,7 @@ import (
 	"product-requirements-management/internal/service"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -32,6 +33,7 @@ type MCPHandler struct {
 	mcpLogger         *MCPLogger
 	errorMapper       *jsonrpc.ErrorMapper
 	resourceService   service.ResourceService
+	usageService      service.MCPUsageService
 }
 
 // NewMCPHandler creates a new MCP handler instance
@@ -83,6 +85,16 @@ func NewMCPHandler(
 	return handler
 }
 
+// SetUsageService enables recording MCP tool calls in the audit log
+func (h *MCPHandler) SetUsageService(usageService service.MCPUsageService) {
+	h.usageService = usageService
+}
+
+// SetTransactionRunner enables the batch_execute tool, which runs its steps in transactions provided by the runner
+func (h *MCPHandler) SetTransactionRunner(transactions tools.TransactionRunner) {
+	h.toolsHandler.SetTransactionRunner(transactions)
+}
+
 // Process handles MCP protocol requests
 // @Summary Process MCP request
 // @Description Process a Model Context Protocol request using JSON-RPC 2.0
@@ -455,6 +467,10 @@ func (h *MCPHandler) wrapHandler(method string, handler func(context.Context, in
 		result, err := handler(requestCtx, params)
 		duration := time.Since(startTime)
 
+		if method == "tools/call" {
+			h.recordToolCall(c, params, user, duration, err)
+		}
+
 		if err != nil {
 			// Log the error
 			h.mcpLogger.LogError(requestCtx, method, err, user)
@@ -526,6 +542,43 @@ func (h *MCPHandler) logToolCallAuditEvent(ctx context.Context, params any, _ an
 	}
 }
 
+// recordToolCall records a tool call in the audit log; failures to record are logged and do not fail the call
+func (h *MCPHandler) recordToolCall(c *gin.Context, params interface{}, user *models.User, duration time.Duration, callErr error) {
+	ctx := c.Request.Context()
+	if h.usageService == nil {
+		return
+	}
+	paramsMap, ok := params.(map[string]interface{})
+	if !ok {
+		return
+	}
+	toolName, ok := paramsMap["name"].(string)
+	if !ok || toolName == "" {
+		return
+	}
+
+	record := service.ToolCallRecord{
+		ToolName: toolName,
+		Duration: duration,
+		Err:      callErr,
+	}
+	if args, ok := paramsMap["arguments"].(map[string]interface{}); ok {
+		record.Arguments = args
+	}
+	if userID, ok := auth.GetCurrentUserID(c); ok {
+		if id, err := uuid.Parse(userID); err == nil {
+			record.UserID = &id
+		}
+	} else if user != nil && user.ID != uuid.Nil {
+		userID := user.ID
+		record.UserID = &userID
+	}
+
+	if err := h.usageService.RecordToolCall(ctx, record); err != nil {
+		h.mcpLogger.LogError(c.Request.Context(), "tools/call", err, user)
+	}
+}
+
 // extractResourceInfoFromToolCall extracts resource information from tool call parameters
 func (h *MCPHandler) extractResourceInfoFromToolCall(toolName string, params map[string]interface{}) (string, string) {
 	// Extract resource type and ID based on tool name
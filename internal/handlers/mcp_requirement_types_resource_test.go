@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -23,67 +24,67 @@ type MockRequirementTypeRepository struct {
 	mock.Mock
 }
 
-func (m *MockRequirementTypeRepository) Create(entity *models.RequirementType) error {
+func (m *MockRequirementTypeRepository) Create(ctx context.Context, entity *models.RequirementType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementTypeRepository) GetByID(id uuid.UUID) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RequirementType, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) Update(entity *models.RequirementType) error {
+func (m *MockRequirementTypeRepository) Update(ctx context.Context, entity *models.RequirementType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementTypeRepository) Delete(id uuid.UUID) error {
+func (m *MockRequirementTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockRequirementTypeRepository) List(filter map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) List(ctx context.Context, filter map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementType, error) {
 	args := m.Called(filter, orderBy, limit, offset)
 	return args.Get(0).([]models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) Count(filter map[string]interface{}) (int64, error) {
+func (m *MockRequirementTypeRepository) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
 	args := m.Called(filter)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) GetByName(name string) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByName(ctx context.Context, name string) (*models.RequirementType, error) {
 	args := m.Called(name)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) ExistsByName(name string) (bool, error) {
+func (m *MockRequirementTypeRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	args := m.Called(name)
 	return args.Get(0).(bool), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) GetByReferenceID(referenceID string) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.RequirementType, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.RequirementType, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockRequirementTypeRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Get(0).(bool), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockRequirementTypeRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(bool), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockRequirementTypeRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -118,9 +118,9 @@ func (rh *ResourceHandler) handleResourceByScheme(ctx context.Context, parsedURI
 }
 
 // handleEpicResource handles epic:// URIs
-func (rh *ResourceHandler) handleEpicResource(_ context.Context, parsedURI *ParsedURI) (interface{}, error) {
+func (rh *ResourceHandler) handleEpicResource(ctx context.Context, parsedURI *ParsedURI) (interface{}, error) {
 	// Get the epic by reference ID
-	epic, err := rh.epicService.GetEpicByReferenceID(parsedURI.ReferenceID)
+	epic, err := rh.epicService.GetEpicByReferenceID(ctx, parsedURI.ReferenceID)
 	if err != nil {
 		if err == service.ErrEpicNotFound {
 			return nil, jsonrpc.NewJSONRPCError(-32002, "Epic not found", nil)
@@ -128,6 +128,8 @@ func (rh *ResourceHandler) handleEpicResource(_ context.Context, parsedURI *Pars
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get epic: %v", err))
 	}
 
+	parsedURI = followRetiredReference(parsedURI, epic.ReferenceID)
+
 	// Handle sub-paths
 	switch parsedURI.SubPath {
 	case "":
@@ -135,14 +137,14 @@ func (rh *ResourceHandler) handleEpicResource(_ context.Context, parsedURI *Pars
 		return rh.formatEpicResource(parsedURI, epic), nil
 	case "hierarchy":
 		// Return epic with full hierarchy
-		epicWithUserStories, err := rh.epicService.GetEpicWithUserStories(epic.ID)
+		epicWithUserStories, err := rh.epicService.GetEpicWithUserStories(ctx, epic.ID)
 		if err != nil {
 			return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get epic hierarchy: %v", err))
 		}
 		return rh.formatEpicHierarchyResource(parsedURI, epicWithUserStories), nil
 	case "user-stories":
 		// Return just the user stories
-		userStories, err := rh.userStoryService.GetUserStoriesByEpic(epic.ID)
+		userStories, err := rh.userStoryService.GetUserStoriesByEpic(ctx, epic.ID)
 		if err != nil {
 			return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get user stories: %v", err))
 		}
@@ -153,9 +155,9 @@ func (rh *ResourceHandler) handleEpicResource(_ context.Context, parsedURI *Pars
 }
 
 // handleUserStoryResource handles user-story:// URIs
-func (rh *ResourceHandler) handleUserStoryResource(_ context.Context, parsedURI *ParsedURI) (interface{}, error) {
+func (rh *ResourceHandler) handleUserStoryResource(ctx context.Context, parsedURI *ParsedURI) (interface{}, error) {
 	// Get the user story by reference ID
-	userStory, err := rh.userStoryService.GetUserStoryByReferenceID(parsedURI.ReferenceID)
+	userStory, err := rh.userStoryService.GetUserStoryByReferenceID(ctx, parsedURI.ReferenceID)
 	if err != nil {
 		if err == service.ErrUserStoryNotFound {
 			return nil, jsonrpc.NewJSONRPCError(-32002, "User story not found", nil)
@@ -163,6 +165,8 @@ func (rh *ResourceHandler) handleUserStoryResource(_ context.Context, parsedURI
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get user story: %v", err))
 	}
 
+	parsedURI = followRetiredReference(parsedURI, userStory.ReferenceID)
+
 	// Handle sub-paths
 	switch parsedURI.SubPath {
 	case "":
@@ -170,14 +174,14 @@ func (rh *ResourceHandler) handleUserStoryResource(_ context.Context, parsedURI
 		return rh.formatUserStoryResource(parsedURI, userStory), nil
 	case "requirements":
 		// Return requirements for this user story
-		requirements, err := rh.requirementService.GetRequirementsByUserStory(userStory.ID)
+		requirements, err := rh.requirementService.GetRequirementsByUserStory(ctx, userStory.ID)
 		if err != nil {
 			return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get requirements: %v", err))
 		}
 		return rh.formatRequirementsResource(parsedURI, requirements), nil
 	case "acceptance-criteria":
 		// Return acceptance criteria for this user story
-		acceptanceCriteria, _, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByUserStory(userStory.ID, 100, 0)
+		acceptanceCriteria, _, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByUserStory(ctx, userStory.ID, 100, 0)
 		if err != nil {
 			return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get acceptance criteria: %v", err))
 		}
@@ -188,9 +192,9 @@ func (rh *ResourceHandler) handleUserStoryResource(_ context.Context, parsedURI
 }
 
 // handleRequirementResource handles requirement:// URIs
-func (rh *ResourceHandler) handleRequirementResource(_ context.Context, parsedURI *ParsedURI) (interface{}, error) {
+func (rh *ResourceHandler) handleRequirementResource(ctx context.Context, parsedURI *ParsedURI) (interface{}, error) {
 	// Get the requirement by reference ID
-	requirement, err := rh.requirementService.GetRequirementByReferenceID(parsedURI.ReferenceID)
+	requirement, err := rh.requirementService.GetRequirementByReferenceID(ctx, parsedURI.ReferenceID)
 	if err != nil {
 		if err == service.ErrRequirementNotFound {
 			return nil, jsonrpc.NewJSONRPCError(-32002, "Requirement not found", nil)
@@ -198,6 +202,8 @@ func (rh *ResourceHandler) handleRequirementResource(_ context.Context, parsedUR
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get requirement: %v", err))
 	}
 
+	parsedURI = followRetiredReference(parsedURI, requirement.ReferenceID)
+
 	// Handle sub-paths
 	switch parsedURI.SubPath {
 	case "":
@@ -205,7 +211,7 @@ func (rh *ResourceHandler) handleRequirementResource(_ context.Context, parsedUR
 		return rh.formatRequirementResource(parsedURI, requirement), nil
 	case "relationships":
 		// Return requirement with relationships
-		requirementWithRelationships, err := rh.requirementService.GetRequirementWithRelationships(requirement.ID)
+		requirementWithRelationships, err := rh.requirementService.GetRequirementWithRelationships(ctx, requirement.ID)
 		if err != nil {
 			return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get requirement relationships: %v", err))
 		}
@@ -216,9 +222,9 @@ func (rh *ResourceHandler) handleRequirementResource(_ context.Context, parsedUR
 }
 
 // handleAcceptanceCriteriaResource handles acceptance-criteria:// URIs
-func (rh *ResourceHandler) handleAcceptanceCriteriaResource(_ context.Context, parsedURI *ParsedURI) (interface{}, error) {
+func (rh *ResourceHandler) handleAcceptanceCriteriaResource(ctx context.Context, parsedURI *ParsedURI) (interface{}, error) {
 	// Get the acceptance criteria by reference ID
-	acceptanceCriteria, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByReferenceID(parsedURI.ReferenceID)
+	acceptanceCriteria, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByReferenceID(ctx, parsedURI.ReferenceID)
 	if err != nil {
 		if err == service.ErrAcceptanceCriteriaNotFound {
 			return nil, jsonrpc.NewJSONRPCError(-32002, "Acceptance criteria not found", nil)
@@ -226,6 +232,8 @@ func (rh *ResourceHandler) handleAcceptanceCriteriaResource(_ context.Context, p
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get acceptance criteria: %v", err))
 	}
 
+	parsedURI = followRetiredReference(parsedURI, acceptanceCriteria.ReferenceID)
+
 	// Handle sub-paths (currently no sub-paths supported for acceptance criteria)
 	switch parsedURI.SubPath {
 	case "":
@@ -573,6 +581,17 @@ func (rh *ResourceHandler) formatAcceptanceCriteriaListResource(parsedURI *Parse
 	}
 }
 
+// followRetiredReference points the parsed URI to the current reference ID of the entity it resolved to,
+// so that contents read through a retired reference ID carry the URI of the successor
+func followRetiredReference(parsedURI *ParsedURI, current string) *ParsedURI {
+	if _, err := uuid.Parse(parsedURI.ReferenceID); err == nil || strings.EqualFold(parsedURI.ReferenceID, current) {
+		return parsedURI
+	}
+	followed := *parsedURI
+	followed.ReferenceID = current
+	return &followed
+}
+
 // buildURIFromParsed rebuilds a URI string from a ParsedURI
 func (rh *ResourceHandler) buildURIFromParsed(parsedURI *ParsedURI) string {
 	uri, _ := rh.uriParser.BuildURI(parsedURI.Scheme, parsedURI.ReferenceID, parsedURI.SubPath, parsedURI.Parameters)
@@ -585,7 +604,7 @@ func (rh *ResourceHandler) buildURIFromParsed(parsedURI *ParsedURI) string {
 // - requirements://epics/EP-001
 // Also supports collection resources:
 // - requirements://epics (returns all epics)
-func (rh *ResourceHandler) convertRequirementsURI(_ context.Context, uri string) (string, error) {
+func (rh *ResourceHandler) convertRequirementsURI(ctx context.Context, uri string) (string, error) {
 	// Parse requirements:// URI format: requirements://epics/{id} or requirements://epics
 	parts := strings.Split(strings.TrimPrefix(uri, "requirements://"), "/")
 	if len(parts) < 1 {
@@ -608,28 +627,28 @@ func (rh *ResourceHandler) convertRequirementsURI(_ context.Context, uri string)
 	// Convert based on entity type
 	switch entityType {
 	case "epics":
-		referenceID, err := rh.getEpicReferenceID(entityID)
+		referenceID, err := rh.getEpicReferenceID(ctx, entityID)
 		if err != nil {
 			return "", fmt.Errorf("epic not found: %v", err)
 		}
 		return fmt.Sprintf("epic://%s", referenceID), nil
 
 	case "user-stories":
-		referenceID, err := rh.getUserStoryReferenceID(entityID)
+		referenceID, err := rh.getUserStoryReferenceID(ctx, entityID)
 		if err != nil {
 			return "", fmt.Errorf("user story not found: %v", err)
 		}
 		return fmt.Sprintf("user-story://%s", referenceID), nil
 
 	case "requirements":
-		referenceID, err := rh.getRequirementReferenceID(entityID)
+		referenceID, err := rh.getRequirementReferenceID(ctx, entityID)
 		if err != nil {
 			return "", fmt.Errorf("requirement not found: %v", err)
 		}
 		return fmt.Sprintf("requirement://%s", referenceID), nil
 
 	case "acceptance-criteria":
-		referenceID, err := rh.getAcceptanceCriteriaReferenceID(entityID)
+		referenceID, err := rh.getAcceptanceCriteriaReferenceID(ctx, entityID)
 		if err != nil {
 			return "", fmt.Errorf("acceptance criteria not found: %v", err)
 		}
@@ -641,11 +660,11 @@ func (rh *ResourceHandler) convertRequirementsURI(_ context.Context, uri string)
 }
 
 // getEpicReferenceID gets epic reference ID from either UUID or reference ID
-func (rh *ResourceHandler) getEpicReferenceID(id string) (string, error) {
+func (rh *ResourceHandler) getEpicReferenceID(ctx context.Context, id string) (string, error) {
 	// Try to parse as UUID first
 	if epicUUID, err := uuid.Parse(id); err == nil {
 		// It's a UUID, get by ID
-		epic, err := rh.epicService.GetEpicByID(epicUUID)
+		epic, err := rh.epicService.GetEpicByID(ctx, epicUUID)
 		if err != nil {
 			return "", err
 		}
@@ -655,7 +674,7 @@ func (rh *ResourceHandler) getEpicReferenceID(id string) (string, error) {
 	// Not a UUID, assume it's a reference ID - validate and return
 	if rh.isValidReferenceID(id, "EP") {
 		// Verify it exists by trying to get it
-		_, err := rh.epicService.GetEpicByReferenceID(id)
+		_, err := rh.epicService.GetEpicByReferenceID(ctx, id)
 		if err != nil {
 			return "", err
 		}
@@ -666,11 +685,11 @@ func (rh *ResourceHandler) getEpicReferenceID(id string) (string, error) {
 }
 
 // getUserStoryReferenceID gets user story reference ID from either UUID or reference ID
-func (rh *ResourceHandler) getUserStoryReferenceID(id string) (string, error) {
+func (rh *ResourceHandler) getUserStoryReferenceID(ctx context.Context, id string) (string, error) {
 	// Try to parse as UUID first
 	if userStoryUUID, err := uuid.Parse(id); err == nil {
 		// It's a UUID, get by ID
-		userStory, err := rh.userStoryService.GetUserStoryByID(userStoryUUID)
+		userStory, err := rh.userStoryService.GetUserStoryByID(ctx, userStoryUUID)
 		if err != nil {
 			return "", err
 		}
@@ -680,7 +699,7 @@ func (rh *ResourceHandler) getUserStoryReferenceID(id string) (string, error) {
 	// Not a UUID, assume it's a reference ID - validate and return
 	if rh.isValidReferenceID(id, "US") {
 		// Verify it exists by trying to get it
-		_, err := rh.userStoryService.GetUserStoryByReferenceID(id)
+		_, err := rh.userStoryService.GetUserStoryByReferenceID(ctx, id)
 		if err != nil {
 			return "", err
 		}
@@ -691,11 +710,11 @@ func (rh *ResourceHandler) getUserStoryReferenceID(id string) (string, error) {
 }
 
 // getRequirementReferenceID gets requirement reference ID from either UUID or reference ID
-func (rh *ResourceHandler) getRequirementReferenceID(id string) (string, error) {
+func (rh *ResourceHandler) getRequirementReferenceID(ctx context.Context, id string) (string, error) {
 	// Try to parse as UUID first
 	if requirementUUID, err := uuid.Parse(id); err == nil {
 		// It's a UUID, get by ID
-		requirement, err := rh.requirementService.GetRequirementByID(requirementUUID)
+		requirement, err := rh.requirementService.GetRequirementByID(ctx, requirementUUID)
 		if err != nil {
 			return "", err
 		}
@@ -705,7 +724,7 @@ func (rh *ResourceHandler) getRequirementReferenceID(id string) (string, error)
 	// Not a UUID, assume it's a reference ID - validate and return
 	if rh.isValidReferenceID(id, "REQ") {
 		// Verify it exists by trying to get it
-		_, err := rh.requirementService.GetRequirementByReferenceID(id)
+		_, err := rh.requirementService.GetRequirementByReferenceID(ctx, id)
 		if err != nil {
 			return "", err
 		}
@@ -716,11 +735,11 @@ func (rh *ResourceHandler) getRequirementReferenceID(id string) (string, error)
 }
 
 // getAcceptanceCriteriaReferenceID gets acceptance criteria reference ID from either UUID or reference ID
-func (rh *ResourceHandler) getAcceptanceCriteriaReferenceID(id string) (string, error) {
+func (rh *ResourceHandler) getAcceptanceCriteriaReferenceID(ctx context.Context, id string) (string, error) {
 	// Try to parse as UUID first
 	if acUUID, err := uuid.Parse(id); err == nil {
 		// It's a UUID, get by ID
-		ac, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByID(acUUID)
+		ac, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByID(ctx, acUUID)
 		if err != nil {
 			return "", err
 		}
@@ -730,7 +749,7 @@ func (rh *ResourceHandler) getAcceptanceCriteriaReferenceID(id string) (string,
 	// Not a UUID, assume it's a reference ID - validate and return
 	if rh.isValidReferenceID(id, "AC") {
 		// Verify it exists by trying to get it
-		_, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByReferenceID(id)
+		_, err := rh.acceptanceCriteriaService.GetAcceptanceCriteriaByReferenceID(ctx, id)
 		if err != nil {
 			return "", err
 		}
@@ -793,9 +812,9 @@ func (rh *ResourceHandler) handleCollectionResource(ctx context.Context, uri str
 }
 
 // handleEpicsCollection handles requirements://epics collection resource
-func (rh *ResourceHandler) handleEpicsCollection(_ context.Context, uri string) (interface{}, error) {
+func (rh *ResourceHandler) handleEpicsCollection(ctx context.Context, uri string) (interface{}, error) {
 	// Get all epics using the existing ListEpics method with no filters
-	epics, _, err := rh.epicService.ListEpics(service.EpicFilters{
+	epics, _, err := rh.epicService.ListEpics(ctx, service.EpicFilters{
 		Limit: 1000, // Set a reasonable limit for collection resources
 	})
 	if err != nil {
@@ -807,9 +826,9 @@ func (rh *ResourceHandler) handleEpicsCollection(_ context.Context, uri string)
 }
 
 // handleUserStoriesCollection handles requirements://user-stories collection resource
-func (rh *ResourceHandler) handleUserStoriesCollection(_ context.Context, uri string) (interface{}, error) {
+func (rh *ResourceHandler) handleUserStoriesCollection(ctx context.Context, uri string) (interface{}, error) {
 	// Get all user stories using the existing ListUserStories method with no filters
-	userStories, _, err := rh.userStoryService.ListUserStories(service.UserStoryFilters{
+	userStories, _, err := rh.userStoryService.ListUserStories(ctx, service.UserStoryFilters{
 		Limit: 1000, // Set a reasonable limit for collection resources
 	})
 	if err != nil {
@@ -821,9 +840,9 @@ func (rh *ResourceHandler) handleUserStoriesCollection(_ context.Context, uri st
 }
 
 // handleRequirementsCollection handles requirements://requirements collection resource
-func (rh *ResourceHandler) handleRequirementsCollection(_ context.Context, uri string) (interface{}, error) {
+func (rh *ResourceHandler) handleRequirementsCollection(ctx context.Context, uri string) (interface{}, error) {
 	// Get all requirements using the existing ListRequirements method with no filters
-	requirements, _, err := rh.requirementService.ListRequirements(service.RequirementFilters{
+	requirements, _, err := rh.requirementService.ListRequirements(ctx, service.RequirementFilters{
 		Limit: 1000, // Set a reasonable limit for collection resources
 	})
 	if err != nil {
@@ -835,9 +854,9 @@ func (rh *ResourceHandler) handleRequirementsCollection(_ context.Context, uri s
 }
 
 // handleAcceptanceCriteriaCollection handles requirements://acceptance-criteria collection resource
-func (rh *ResourceHandler) handleAcceptanceCriteriaCollection(_ context.Context, uri string) (interface{}, error) {
+func (rh *ResourceHandler) handleAcceptanceCriteriaCollection(ctx context.Context, uri string) (interface{}, error) {
 	// Get all acceptance criteria using the existing ListAcceptanceCriteria method with no filters
-	acceptanceCriteria, _, err := rh.acceptanceCriteriaService.ListAcceptanceCriteria(service.AcceptanceCriteriaFilters{
+	acceptanceCriteria, _, err := rh.acceptanceCriteriaService.ListAcceptanceCriteria(ctx, service.AcceptanceCriteriaFilters{
 		Limit: 1000, // Set a reasonable limit for collection resources
 	})
 	if err != nil {
@@ -1130,9 +1149,9 @@ func (rh *ResourceHandler) handleActivePromptResource(ctx context.Context, uri s
 // handleRequirementTypesCollection handles requirements://requirements-types collection resource
 // Requirements: REQ-038 - Типы требований должны отображаться в виде ресурса requirements://requirements-types
 // Requirements: REQ-039 - Система должна возвращать список типов требований в определённом формате
-func (rh *ResourceHandler) handleRequirementTypesCollection(_ context.Context, uri string) (interface{}, error) {
+func (rh *ResourceHandler) handleRequirementTypesCollection(ctx context.Context, uri string) (interface{}, error) {
 	// Get all requirement types using the repository
-	requirementTypes, err := rh.requirementTypeRepo.List(nil, "name ASC", 1000, 0)
+	requirementTypes, err := rh.requirementTypeRepo.List(ctx, nil, "name ASC", 1000, 0)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get requirement types: %v", err))
 	}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/service"
+)
+
+// recordingUsageService collects recorded tool calls
+type recordingUsageService struct {
+	calls []service.ToolCallRecord
+}
+
+func (s *recordingUsageService) RecordToolCall(ctx context.Context, call service.ToolCallRecord) error {
+	s.calls = append(s.calls, call)
+	return nil
+}
+
+func (s *recordingUsageService) GetUsage(ctx context.Context, query service.MCPUsageQuery) (*service.MCPUsage, error) {
+	return &service.MCPUsage{}, nil
+}
+
+func TestMCPHandler_RecordsToolCalls(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	usage := &recordingUsageService{}
+	handler := NewMCPHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	handler.SetUsageService(usage)
+
+	process := func(body string) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest("POST", "/api/v1/mcp", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		handler.Process(c)
+	}
+
+	process(`{"jsonrpc":"2.0","id":1,"method":"ping"}`)
+	process(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"no_such_tool","arguments":{"epic":"EP-001"}}}`)
+
+	require.Len(t, usage.calls, 1)
+	call := usage.calls[0]
+	assert.Equal(t, "no_such_tool", call.ToolName)
+	assert.Equal(t, map[string]interface{}{"epic": "EP-001"}, call.Arguments)
+	assert.Error(t, call.Err)
+	assert.Nil(t, call.UserID)
+}
+
+func TestMCPHandler_WithoutUsageService(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewMCPHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("POST", "/api/v1/mcp", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"no_such_tool"}}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	assert.NotPanics(t, func() { handler.Process(c) })
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// MCPUsageHandler handles HTTP requests for MCP tool call analytics
+type MCPUsageHandler struct {
+	usageService service.MCPUsageService
+}
+
+// NewMCPUsageHandler creates a new MCP usage handler instance
+func NewMCPUsageHandler(usageService service.MCPUsageService) *MCPUsageHandler {
+	return &MCPUsageHandler{
+		usageService: usageService,
+	}
+}
+
+// GetUsage handles GET /api/v1/admin/mcp-usage
+// @Summary Get MCP tool usage
+// @Description Retrieve aggregates of the MCP tool calls recorded in the audit log: calls, failures and average and maximum latency per tool, the users agents made the most calls as, and the latest failed calls with their error and arguments digest. Arguments are stored only as a SHA-256 digest, so repeated identical calls share a digest.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param days query int false "Days covered by the report (default 7, max 365)"
+// @Param top query int false "Number of most active users and recent failures (default 10, max 100)"
+// @Success 200 {object} service.MCPUsage "MCP tool usage"
+// @Failure 400 {object} map[string]interface{} "Invalid query parameter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/mcp-usage [get]
+func (h *MCPUsageHandler) GetUsage(c *gin.Context) {
+	ctx := c.Request.Context()
+	var query service.MCPUsageQuery
+	for param, target := range map[string]*int{
+		"days": &query.Days,
+		"top":  &query.Top,
+	} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid " + param + " parameter",
+				},
+			})
+			return
+		}
+		*target = value
+	}
+
+	usage, err := h.usageService.GetUsage(ctx, query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to get MCP usage",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// MetaHandler handles HTTP requests describing the API itself
+type MetaHandler struct {
+	enumService service.EnumService
+}
+
+// NewMetaHandler creates a new meta handler instance
+func NewMetaHandler(enumService service.EnumService) *MetaHandler {
+	return &MetaHandler{
+		enumService: enumService,
+	}
+}
+
+// GetEnums handles GET /api/v1/meta/enums
+// @Summary List enum values
+// @Description List the enum values the API uses with display labels, in the order clients should show them: the statuses of epics, user stories and requirements from their default status models, priorities, entity types, configured relationship types and comment categories. Client and SDK generators can read them instead of hard-coding them.
+// @Tags meta
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.EnumRegistry "Enum values"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/meta/enums [get]
+func (h *MetaHandler) GetEnums(c *gin.Context) {
+	ctx := c.Request.Context()
+	registry, err := h.enumService.Enums(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to list enum values")
+		return
+	}
+	c.JSON(http.StatusOK, registry)
+}
@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -12,6 +14,9 @@ import (
 	"product-requirements-management/internal/service"
 )
 
+// HierarchyNodeListResponse represents the response for listing hierarchy tree nodes
+type HierarchyNodeListResponse = ListResponse[service.HierarchyNode]
+
 // NavigationHandler handles HTTP requests for hierarchical navigation
 type NavigationHandler struct {
 	navigationService service.NavigationService
@@ -25,61 +30,30 @@ func NewNavigationHandler(navigationService service.NavigationService) *Navigati
 }
 
 // GetHierarchy handles GET /api/v1/hierarchy
+// @Summary Get the full hierarchy
+// @Description Retrieve the epics with their user stories, acceptance criteria and requirements. Filters apply to the epics.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param creator_id query string false "Filter by creator UUID" format(uuid)
+// @Param assignee_id query string false "Filter by assignee UUID" format(uuid)
+// @Param status query string false "Filter by status"
+// @Param priority query int false "Filter by priority (1-4)" minimum(1) maximum(4)
+// @Param order_by query string false "Field to sort by"
+// @Param order_dir query string false "Sort direction" Enums(asc, desc)
+// @Param limit query int false "Maximum number of epics to return" minimum(1) default(50)
+// @Param offset query int false "Number of epics to skip" minimum(0) default(0)
+// @Param expand query string false "Levels to include, comma separated" example("user_stories,requirements")
+// @Success 200 {object} service.HierarchyResponse "Hierarchy"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy [get]
 func (h *NavigationHandler) GetHierarchy(c *gin.Context) {
-	var filters service.HierarchyFilters
-
-	// Parse query parameters for filtering
-	if creatorID := c.Query("creator_id"); creatorID != "" {
-		if id, err := uuid.Parse(creatorID); err == nil {
-			filters.CreatorID = &id
-		}
-	}
-
-	if assigneeID := c.Query("assignee_id"); assigneeID != "" {
-		if id, err := uuid.Parse(assigneeID); err == nil {
-			filters.AssigneeID = &id
-		}
-	}
-
-	if status := c.Query("status"); status != "" {
-		filters.Status = &status
-	}
-
-	if priority := c.Query("priority"); priority != "" {
-		if p, err := strconv.Atoi(priority); err == nil && p >= 1 && p <= 4 {
-			prio := models.Priority(p)
-			filters.Priority = &prio
-		}
-	}
-
-	// Parse sorting parameters
-	if orderBy := c.Query("order_by"); orderBy != "" {
-		filters.OrderBy = orderBy
-	}
-
-	if orderDir := c.Query("order_dir"); orderDir != "" {
-		filters.OrderDirection = orderDir
-	}
-
-	// Parse pagination parameters
-	if limit := c.Query("limit"); limit != "" {
-		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
-			filters.Limit = l
-		}
-	}
-
-	if offset := c.Query("offset"); offset != "" {
-		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
-			filters.Offset = o
-		}
-	}
-
-	// Parse expansion parameters
-	if expand := c.Query("expand"); expand != "" {
-		filters.Expand = expand
-	}
+	ctx := c.Request.Context()
+	filters := parseHierarchyFilters(c)
 
-	hierarchy, err := h.navigationService.GetHierarchy(filters)
+	hierarchy, err := h.navigationService.GetHierarchy(ctx, filters)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to get hierarchy",
@@ -91,7 +65,24 @@ func (h *NavigationHandler) GetHierarchy(c *gin.Context) {
 }
 
 // GetEpicHierarchy handles GET /api/v1/hierarchy/epics/:id
+// @Summary Get the hierarchy of an epic
+// @Description Retrieve an epic with the levels below it. Add format=compact for the compact representation. Answers conditional requests with Last-Modified.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Epic UUID or reference ID (EP-XXX)"
+// @Param expand query string false "Levels to include, comma separated (default user_stories,requirements)"
+// @Param order_by query string false "Field to sort children by"
+// @Param order_dir query string false "Sort direction" Enums(asc, desc)
+// @Success 200 {object} service.EpicHierarchy "Epic hierarchy"
+// @Success 304 "Not modified since If-Modified-Since"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy/epics/{id} [get]
 func (h *NavigationHandler) GetEpicHierarchy(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -102,7 +93,7 @@ func (h *NavigationHandler) GetEpicHierarchy(c *gin.Context) {
 		epicID = id
 	} else {
 		// Need to resolve reference ID to UUID
-		epic, resolveErr := h.navigationService.GetEpicByReferenceID(idParam)
+		epic, resolveErr := h.navigationService.GetEpicByReferenceID(ctx, idParam)
 		if resolveErr != nil {
 			if errors.Is(resolveErr, service.ErrEpicNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -128,7 +119,8 @@ func (h *NavigationHandler) GetEpicHierarchy(c *gin.Context) {
 	orderBy := c.Query("order_by")
 	orderDirection := c.Query("order_dir")
 
-	epicHierarchy, err := h.navigationService.GetEpicHierarchy(epicID, expand, orderBy, orderDirection)
+	lastModified := h.lastModified(ctx, "epic", epicID)
+	epicHierarchy, err := h.navigationService.GetEpicHierarchy(ctx, epicID, expand, orderBy, orderDirection)
 	if err != nil {
 		if errors.Is(err, service.ErrEpicNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -142,11 +134,34 @@ func (h *NavigationHandler) GetEpicHierarchy(c *gin.Context) {
 		return
 	}
 
+	setLastModified(c, lastModified)
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, service.CompactEpicHierarchy(epicHierarchy))
+		return
+	}
+
 	c.JSON(http.StatusOK, epicHierarchy)
 }
 
 // GetUserStoryHierarchy handles GET /api/v1/hierarchy/user-stories/:id
+// @Summary Get the hierarchy of a user story
+// @Description Retrieve a user story with its requirements and acceptance criteria. Answers conditional requests with Last-Modified.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User story UUID or reference ID (US-XXX)"
+// @Param expand query string false "Levels to include, comma separated (default requirements,acceptance_criteria)"
+// @Param order_by query string false "Field to sort children by"
+// @Param order_dir query string false "Sort direction" Enums(asc, desc)
+// @Success 200 {object} service.UserStoryHierarchy "User story hierarchy"
+// @Success 304 "Not modified since If-Modified-Since"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "User story not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy/user-stories/{id} [get]
 func (h *NavigationHandler) GetUserStoryHierarchy(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -157,7 +172,7 @@ func (h *NavigationHandler) GetUserStoryHierarchy(c *gin.Context) {
 		userStoryID = id
 	} else {
 		// Need to resolve reference ID to UUID
-		userStory, resolveErr := h.navigationService.GetUserStoryByReferenceID(idParam)
+		userStory, resolveErr := h.navigationService.GetUserStoryByReferenceID(ctx, idParam)
 		if resolveErr != nil {
 			if errors.Is(resolveErr, service.ErrUserStoryNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -183,7 +198,8 @@ func (h *NavigationHandler) GetUserStoryHierarchy(c *gin.Context) {
 	orderBy := c.Query("order_by")
 	orderDirection := c.Query("order_dir")
 
-	userStoryHierarchy, err := h.navigationService.GetUserStoryHierarchy(userStoryID, expand, orderBy, orderDirection)
+	lastModified := h.lastModified(ctx, "user_story", userStoryID)
+	userStoryHierarchy, err := h.navigationService.GetUserStoryHierarchy(ctx, userStoryID, expand, orderBy, orderDirection)
 	if err != nil {
 		if errors.Is(err, service.ErrUserStoryNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -197,11 +213,236 @@ func (h *NavigationHandler) GetUserStoryHierarchy(c *gin.Context) {
 		return
 	}
 
+	setLastModified(c, lastModified)
 	c.JSON(http.StatusOK, userStoryHierarchy)
 }
 
 // GetEntityPath handles GET /api/v1/hierarchy/path/:entity_type/:id
+// @Summary Get the path of an entity
+// @Description Retrieve the ancestors of an entity from its epic down to the entity itself, for breadcrumbs.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type path string true "Entity type" Enums(epic, user_story, acceptance_criteria, requirement)
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} map[string]interface{} "Path elements under the path key"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy/path/{entity_type}/{id} [get]
 func (h *NavigationHandler) GetEntityPath(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, entityID, ok := h.resolveEntity(c)
+	if !ok {
+		return
+	}
+
+	path, err := h.navigationService.GetEntityPath(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Entity not found",
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to get entity path",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"path": path,
+	})
+}
+
+// ListEpicNodes handles GET /api/v1/hierarchy/epics
+// @Summary List the epic nodes of the hierarchy
+// @Description List the epics as tree nodes with their child counts, for lazily loaded trees.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param creator_id query string false "Filter by creator UUID" format(uuid)
+// @Param assignee_id query string false "Filter by assignee UUID" format(uuid)
+// @Param status query string false "Filter by status"
+// @Param priority query int false "Filter by priority (1-4)" minimum(1) maximum(4)
+// @Param order_by query string false "Field to sort by"
+// @Param order_dir query string false "Sort direction" Enums(asc, desc)
+// @Param limit query int false "Maximum number of epics to return" minimum(1) default(50)
+// @Param offset query int false "Number of epics to skip" minimum(0) default(0)
+// @Success 200 {object} HierarchyNodeListResponse "Epic nodes"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy/epics [get]
+func (h *NavigationHandler) ListEpicNodes(c *gin.Context) {
+	ctx := c.Request.Context()
+	filters := parseHierarchyFilters(c)
+
+	nodes, totalCount, err := h.navigationService.ListEpicNodes(ctx, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to list epics",
+		})
+		return
+	}
+
+	limit := filters.Limit
+	if limit == 0 {
+		limit = 50
+	}
+	SendListResponse(c, nodes, totalCount, limit, filters.Offset)
+}
+
+// ListChildNodes handles GET /api/v1/hierarchy/children/:entity_type/:id
+// @Summary List the child nodes of an entity
+// @Description List the entities directly below an entity as tree nodes, for lazily loaded trees. Answers conditional requests with Last-Modified.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type path string true "Entity type" Enums(epic, user_story, acceptance_criteria, requirement)
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} HierarchyNodeListResponse "Child nodes"
+// @Success 304 "Not modified since If-Modified-Since"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy/children/{entity_type}/{id} [get]
+func (h *NavigationHandler) ListChildNodes(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, entityID, ok := h.resolveEntity(c)
+	if !ok {
+		return
+	}
+
+	lastModified := h.lastModified(ctx, entityType, entityID)
+	nodes, err := h.navigationService.ListChildNodes(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Entity not found",
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to list child entities",
+			})
+		}
+		return
+	}
+
+	setLastModified(c, lastModified)
+	SendListResponse(c, nodes, int64(len(nodes)), len(nodes), 0)
+}
+
+// GetOutline handles GET /api/v1/hierarchy/outline/:entity_type/:id
+// @Summary Get the outline of an entity
+// @Description Retrieve the numbered outline of an entity and everything below it. Answers conditional requests with Last-Modified.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type path string true "Entity type" Enums(epic, user_story, acceptance_criteria, requirement)
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} service.HierarchyOutline "Outline"
+// @Success 304 "Not modified since If-Modified-Since"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy/outline/{entity_type}/{id} [get]
+func (h *NavigationHandler) GetOutline(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, entityID, ok := h.resolveEntity(c)
+	if !ok {
+		return
+	}
+
+	lastModified := h.lastModified(ctx, entityType, entityID)
+	outline, err := h.navigationService.GetOutline(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Entity not found",
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to get hierarchy outline",
+			})
+		}
+		return
+	}
+
+	setLastModified(c, lastModified)
+	c.JSON(http.StatusOK, outline)
+}
+
+// GetProgress handles GET /api/v1/hierarchy/progress/:entity_type/:id
+// @Summary Get the progress of an entity
+// @Description Retrieve the completion of an entity rolled up from the entities below it. Answers conditional requests with Last-Modified.
+// @Tags navigation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type path string true "Entity type" Enums(epic, user_story, acceptance_criteria, requirement)
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} service.HierarchyProgress "Progress"
+// @Success 304 "Not modified since If-Modified-Since"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/hierarchy/progress/{entity_type}/{id} [get]
+func (h *NavigationHandler) GetProgress(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, entityID, ok := h.resolveEntity(c)
+	if !ok {
+		return
+	}
+
+	lastModified := h.lastModified(ctx, entityType, entityID)
+	progress, err := h.navigationService.GetProgress(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Entity not found",
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to get hierarchy progress",
+			})
+		}
+		return
+	}
+
+	setLastModified(c, lastModified)
+	c.JSON(http.StatusOK, progress)
+}
+
+// lastModified returns when an entity or its descendants last changed, or the zero time if that is unknown.
+// It is read before the response is loaded so that a change made in between is never hidden.
+func (h *NavigationHandler) lastModified(ctx context.Context, entityType string, entityID uuid.UUID) time.Time {
+	lastModified, err := h.navigationService.GetLastModified(ctx, entityType, entityID)
+	if err != nil {
+		return time.Time{}
+	}
+	return lastModified
+}
+
+// setLastModified sets the Last-Modified header used to answer conditional requests
+func setLastModified(c *gin.Context, lastModified time.Time) {
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// resolveEntity validates the entity_type path parameter and resolves the id path parameter,
+// a UUID or reference ID, writing an error response when either is invalid
+func (h *NavigationHandler) resolveEntity(c *gin.Context) (string, uuid.UUID, bool) {
+	ctx := c.Request.Context()
 	entityType := c.Param("entity_type")
 	idParam := c.Param("id")
 
@@ -210,37 +451,79 @@ func (h *NavigationHandler) GetEntityPath(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Invalid entity type",
 		})
-		return
+		return "", uuid.Nil, false
 	}
 
 	// Try to parse as UUID first, then as reference ID
-	var entityID uuid.UUID
-	var err error
+	if id, err := uuid.Parse(idParam); err == nil {
+		return entityType, id, true
+	}
+	entityID, err := h.navigationService.ResolveReferenceID(ctx, entityType, idParam)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Entity not found",
+		})
+		return "", uuid.Nil, false
+	}
+	return entityType, entityID, true
+}
 
-	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		entityID = id
-	} else {
-		// Need to resolve reference ID to UUID based on entity type
-		entityID, err = h.navigationService.ResolveReferenceID(entityType, idParam)
-		if err != nil {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": "Entity not found",
-			})
-			return
+// parseHierarchyFilters reads the filter, sorting, pagination and expansion query parameters of hierarchy listings
+func parseHierarchyFilters(c *gin.Context) service.HierarchyFilters {
+	var filters service.HierarchyFilters
+
+	// Parse query parameters for filtering
+	if creatorID := c.Query("creator_id"); creatorID != "" {
+		if id, err := uuid.Parse(creatorID); err == nil {
+			filters.CreatorID = &id
 		}
 	}
 
-	path, err := h.navigationService.GetEntityPath(entityType, entityID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to get entity path",
-		})
-		return
+	if assigneeID := c.Query("assignee_id"); assigneeID != "" {
+		if id, err := uuid.Parse(assigneeID); err == nil {
+			filters.AssigneeID = &id
+		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"path": path,
-	})
+	if status := c.Query("status"); status != "" {
+		filters.Status = &status
+	}
+
+	if priority := c.Query("priority"); priority != "" {
+		if p, err := strconv.Atoi(priority); err == nil && p >= 1 && p <= 4 {
+			prio := models.Priority(p)
+			filters.Priority = &prio
+		}
+	}
+
+	// Parse sorting parameters
+	if orderBy := c.Query("order_by"); orderBy != "" {
+		filters.OrderBy = orderBy
+	}
+
+	if orderDir := c.Query("order_dir"); orderDir != "" {
+		filters.OrderDirection = orderDir
+	}
+
+	// Parse pagination parameters
+	if limit := c.Query("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
+			filters.Limit = l
+		}
+	}
+
+	if offset := c.Query("offset"); offset != "" {
+		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
+			filters.Offset = o
+		}
+	}
+
+	// Parse expansion parameters
+	if expand := c.Query("expand"); expand != "" {
+		filters.Expand = expand
+	}
+
+	return filters
 }
 
 // isValidEntityType checks if the entity type is valid for navigation
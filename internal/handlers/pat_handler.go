@@ -94,20 +94,8 @@ func (h *PATHandler) CreatePAT(c *gin.Context) {
 					"message": "Token name already exists",
 				},
 			})
-		case errors.Is(err, service.ErrPATInvalidScopes):
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": "Invalid scopes specified",
-				},
-			})
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to create PAT",
-				},
-			})
+			respondWithError(c, err, "Failed to create PAT")
 		}
 		return
 	}
@@ -180,12 +168,7 @@ func (h *PATHandler) ListPATs(c *gin.Context) {
 				},
 			})
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to list PATs",
-				},
-			})
+			respondWithError(c, err, "Failed to list PATs")
 		}
 		return
 	}
@@ -249,29 +232,7 @@ func (h *PATHandler) RevokePAT(c *gin.Context) {
 
 	err = h.patService.RevokePAT(c.Request.Context(), patID, userUUID)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrPATNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "PAT not found",
-				},
-			})
-		case errors.Is(err, service.ErrPATUnauthorized):
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": gin.H{
-					"code":    "INSUFFICIENT_PERMISSIONS",
-					"message": "Unauthorized access to token",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to revoke PAT",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to revoke PAT")
 		return
 	}
 
@@ -62,6 +62,14 @@ func (m *MockPATService) ValidateToken(ctx context.Context, token string) (*mode
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
+func (m *MockPATService) AuthenticateToken(ctx context.Context, token string) (*models.User, *models.PersonalAccessToken, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*models.User), args.Get(1).(*models.PersonalAccessToken), args.Error(2)
+}
+
 func (m *MockPATService) UpdateLastUsed(ctx context.Context, patID uuid.UUID) error {
 	args := m.Called(ctx, patID)
 	return args.Error(0)
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// PortfolioHandler handles HTTP requests for the portfolio view of epics
+type PortfolioHandler struct {
+	portfolioService service.PortfolioService
+}
+
+// NewPortfolioHandler creates a new portfolio handler instance
+func NewPortfolioHandler(portfolioService service.PortfolioService) *PortfolioHandler {
+	return &PortfolioHandler{
+		portfolioService: portfolioService,
+	}
+}
+
+// GetPortfolio handles GET /api/v1/portfolio
+// @Summary Get the portfolio of epics
+// @Description Aggregate the status of all epics, or of the epics matching the filters, for executive dashboards: the number of epics per status and per priority, the latest changes to the epics and everything below them, the requirements with the highest risk score and the open epics and user stories due in the coming days. Archived epics are left out unless include_archived=true.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Only epics in this status" Enums(Backlog, Draft, In Progress, Done, Cancelled)
+// @Param priority query int false "Only epics with this priority" minimum(1) maximum(4)
+// @Param assignee_id query string false "Only epics assigned to this user" format(uuid)
+// @Param creator_id query string false "Only epics created by this user" format(uuid)
+// @Param include_archived query boolean false "Include archived epics" default(false)
+// @Param days query int false "Days ahead covered by upcoming due dates (default 30, max 365)"
+// @Param limit query int false "Number of activity events, risks and due dates (default 10, max 50)"
+// @Success 200 {object} service.Portfolio "Aggregated status of the epics"
+// @Failure 400 {object} ErrorResponse "Invalid query parameter"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/portfolio [get]
+func (h *PortfolioHandler) GetPortfolio(c *gin.Context) {
+	ctx := c.Request.Context()
+	query := service.PortfolioQuery{IncludeArchived: c.Query("include_archived") == "true"}
+	if value := c.Query("status"); value != "" {
+		status := models.EpicStatus(value)
+		query.Status = &status
+	}
+	if value := c.Query("priority"); value != "" {
+		priority, err := strconv.Atoi(value)
+		if err != nil {
+			respondWithPortfolioParamError(c, "priority")
+			return
+		}
+		p := models.Priority(priority)
+		query.Priority = &p
+	}
+	for param, target := range map[string]**uuid.UUID{"assignee_id": &query.AssigneeID, "creator_id": &query.CreatorID} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			respondWithPortfolioParamError(c, param)
+			return
+		}
+		*target = &id
+	}
+	for param, target := range map[string]*int{"days": &query.Days, "limit": &query.Limit} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 1 {
+			respondWithPortfolioParamError(c, param)
+			return
+		}
+		*target = value
+	}
+
+	portfolio, err := h.portfolioService.GetPortfolio(ctx, query)
+	if err != nil {
+		respondWithError(c, err, "Failed to get portfolio")
+		return
+	}
+
+	c.JSON(http.StatusOK, portfolio)
+}
+
+// respondWithPortfolioParamError reports a malformed query parameter of the portfolio
+func respondWithPortfolioParamError(c *gin.Context, param string) {
+	c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+		Code:    "VALIDATION_ERROR",
+		Message: "Invalid " + param + " parameter",
+	}})
+}
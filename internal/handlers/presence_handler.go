@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// PresenceHandler handles HTTP requests for presence indicators and soft edit locks
+type PresenceHandler struct {
+	presenceService service.PresenceService
+}
+
+// NewPresenceHandler creates a new presence handler instance
+func NewPresenceHandler(presenceService service.PresenceService) *PresenceHandler {
+	return &PresenceHandler{
+		presenceService: presenceService,
+	}
+}
+
+// RegisterPresence handles POST /api/v1/{entityType}/:id/presence
+// @Summary Register presence on an entity
+// @Description Register or refresh (heartbeat) that the current user is viewing or editing an entity. Presence expires automatically after 60 seconds without a heartbeat.
+// @Tags presence
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param presence body service.PresenceRequest false "Presence mode"
+// @Success 200 {object} models.EntityPresence "Presence registered"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or presence mode"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/presence [post]
+// @Router /api/v1/user-stories/{id}/presence [post]
+// @Router /api/v1/acceptance-criteria/{id}/presence [post]
+// @Router /api/v1/requirements/{id}/presence [post]
+func (h *PresenceHandler) RegisterPresence(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.PresenceRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid request body: " + err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	presence, err := h.presenceService.Heartbeat(ctx, entityType, c.Param("id"), userID, req.Mode)
+	if err != nil {
+		respondWithError(c, err, "Failed to register presence")
+		return
+	}
+
+	c.JSON(http.StatusOK, presence)
+}
+
+// GetPresence handles GET /api/v1/{entityType}/:id/presence
+// @Summary Get active users on an entity
+// @Description Retrieve the users currently viewing or editing an entity together with its active edit lock, if any
+// @Tags presence
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} service.PresenceResponse "Active users and edit lock"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/presence [get]
+// @Router /api/v1/user-stories/{id}/presence [get]
+// @Router /api/v1/acceptance-criteria/{id}/presence [get]
+// @Router /api/v1/requirements/{id}/presence [get]
+func (h *PresenceHandler) GetPresence(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	presence, err := h.presenceService.GetPresence(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to get presence")
+		return
+	}
+
+	c.JSON(http.StatusOK, presence)
+}
+
+// LeavePresence handles DELETE /api/v1/{entityType}/:id/presence
+// @Summary Remove presence from an entity
+// @Description Remove the current user's presence from an entity (e.g. when the page is closed)
+// @Tags presence
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 204 "Presence removed"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/presence [delete]
+// @Router /api/v1/user-stories/{id}/presence [delete]
+// @Router /api/v1/acceptance-criteria/{id}/presence [delete]
+// @Router /api/v1/requirements/{id}/presence [delete]
+func (h *PresenceHandler) LeavePresence(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.presenceService.Leave(ctx, entityType, c.Param("id"), userID); err != nil {
+		respondWithError(c, err, "Failed to remove presence")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AcquireLock handles POST /api/v1/{entityType}/:id/lock
+// @Summary Acquire or refresh an edit lock
+// @Description Acquire a soft edit lock on an entity, or refresh it if already held by the current user. While the lock is active, updates by other users are rejected with 423 Locked.
+// @Tags presence
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param lock body service.EditLockRequest false "Lock duration"
+// @Success 200 {object} models.EditLock "Lock acquired"
+// @Failure 400 {object} map[string]interface{} "Invalid request body"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 423 {object} map[string]interface{} "Entity is locked by another user"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/lock [post]
+// @Router /api/v1/user-stories/{id}/lock [post]
+// @Router /api/v1/acceptance-criteria/{id}/lock [post]
+// @Router /api/v1/requirements/{id}/lock [post]
+func (h *PresenceHandler) AcquireLock(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.EditLockRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid request body: " + err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	lock, err := h.presenceService.AcquireLock(ctx, entityType, c.Param("id"), userID, time.Duration(req.TTLSeconds)*time.Second)
+	if err != nil {
+		if errors.Is(err, service.ErrEditLockHeld) && lock != nil {
+			c.JSON(http.StatusLocked, gin.H{
+				"error": gin.H{
+					"code":    "ENTITY_LOCKED",
+					"message": "Entity is locked for editing by another user",
+				},
+				"lock": lock,
+			})
+			return
+		}
+		respondWithError(c, err, "Failed to acquire edit lock")
+		return
+	}
+
+	c.JSON(http.StatusOK, lock)
+}
+
+// ReleaseLock handles DELETE /api/v1/{entityType}/:id/lock
+// @Summary Release an edit lock
+// @Description Release the soft edit lock on an entity. Only the lock holder or an administrator can release an active lock.
+// @Tags presence
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 204 "Lock released"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Lock is held by another user"
+// @Failure 404 {object} map[string]interface{} "Entity or lock not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/lock [delete]
+// @Router /api/v1/user-stories/{id}/lock [delete]
+// @Router /api/v1/acceptance-criteria/{id}/lock [delete]
+// @Router /api/v1/requirements/{id}/lock [delete]
+func (h *PresenceHandler) ReleaseLock(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	role, _ := auth.GetCurrentUserRole(c)
+	currentUser := &models.User{ID: userID, Role: role}
+
+	if err := h.presenceService.ReleaseLock(ctx, entityType, c.Param("id"), currentUser); err != nil {
+		respondWithError(c, err, "Failed to release edit lock")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// EnforceEditLock returns middleware that rejects updates to an entity locked by another user
+// The entity type is derived from the route path and the entity ID from the :id parameter
+func (h *PresenceHandler) EnforceEditLock() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		entityType, ok := entityTypeFromPath(c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		entityID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			// Let the handler report the invalid ID
+			c.Next()
+			return
+		}
+
+		userIDParam, ok := auth.GetCurrentUserID(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if err := h.presenceService.CheckEditAllowed(ctx, entityType, entityID, uuid.MustParse(userIDParam)); err != nil {
+			if errors.Is(err, service.ErrEditLockHeld) {
+				c.AbortWithStatusJSON(http.StatusLocked, gin.H{
+					"error": gin.H{
+						"code":    "ENTITY_LOCKED",
+						"message": "Entity is locked for editing by another user",
+					},
+				})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error": gin.H{
+					"code":    "INTERNAL_ERROR",
+					"message": "Failed to check edit lock",
+				},
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -82,6 +82,7 @@ func (ph *PromptHandler) CreatePrompt(c *gin.Context) {
 // @Summary List prompts
 // @Description List all prompts with pagination
 // @Tags prompts
+// @Accept json
 // @Produce json
 // @Param limit query int false "Number of items per page (default: 50, max: 100)"
 // @Param offset query int false "Number of items to skip (default: 0)"
@@ -138,6 +139,7 @@ func (ph *PromptHandler) ListPrompts(c *gin.Context) {
 // @Summary Get prompt by ID
 // @Description Get a prompt by UUID or reference ID
 // @Tags prompts
+// @Accept json
 // @Produce json
 // @Param id path string true "Prompt UUID or reference ID (e.g., PROMPT-001)"
 // @Success 200 {object} models.Prompt
@@ -239,6 +241,7 @@ func (ph *PromptHandler) UpdatePrompt(c *gin.Context) {
 // @Summary Delete prompt
 // @Description Delete a prompt (requires Administrator role)
 // @Tags prompts
+// @Accept json
 // @Produce json
 // @Param id path string true "Prompt UUID or reference ID (e.g., PROMPT-001)"
 // @Success 204
@@ -291,6 +294,7 @@ func (ph *PromptHandler) DeletePrompt(c *gin.Context) {
 // @Summary Activate prompt
 // @Description Activate a prompt and deactivate all others (requires Administrator role)
 // @Tags prompts
+// @Accept json
 // @Produce json
 // @Param id path string true "Prompt UUID or reference ID (e.g., PROMPT-001)"
 // @Success 200 {object} map[string]string
@@ -343,6 +347,7 @@ func (ph *PromptHandler) ActivatePrompt(c *gin.Context) {
 // @Summary Get active prompt
 // @Description Get the currently active prompt
 // @Tags prompts
+// @Accept json
 // @Produce json
 // @Success 200 {object} models.Prompt
 // @Failure 401 {object} ErrorResponse
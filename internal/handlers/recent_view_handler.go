@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// RecentItemListResponse represents the response for listing recently viewed entities
+type RecentItemListResponse = ListResponse[service.RecentItem]
+
+// RecentViewHandler handles HTTP requests for recently viewed entities
+type RecentViewHandler struct {
+	recentViewService service.RecentViewService
+}
+
+// NewRecentViewHandler creates a new recent view handler instance
+func NewRecentViewHandler(recentViewService service.RecentViewService) *RecentViewHandler {
+	return &RecentViewHandler{
+		recentViewService: recentViewService,
+	}
+}
+
+// RecordView handles POST /api/v1/{entityType}/:id/viewed
+// @Summary Record that the current user viewed an entity
+// @Description Add an entity to the current user's recently viewed items, or move it to the top if it was viewed before. Only the latest 50 views are kept.
+// @Tags recent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} service.RecentItem "View recorded"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/viewed [post]
+// @Router /api/v1/user-stories/{id}/viewed [post]
+// @Router /api/v1/acceptance-criteria/{id}/viewed [post]
+// @Router /api/v1/requirements/{id}/viewed [post]
+func (h *RecentViewHandler) RecordView(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.recentViewService.RecordView(ctx, entityType, c.Param("id"), userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to record view")
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+// GetMyRecent handles GET /api/v1/users/me/recent
+// @Summary List the current user's recently viewed entities
+// @Description Retrieve the distinct entities the current user viewed most recently, newest first, with the time of the last view. Entities deleted since they were viewed are left out.
+// @Tags recent
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Maximum number of entities to return (default 20, max 50)"
+// @Success 200 {object} RecentItemListResponse "Recently viewed entities"
+// @Failure 400 {object} map[string]interface{} "Invalid limit"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/recent [get]
+func (h *RecentViewHandler) GetMyRecent(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	limit, ok := parseActivityLimit(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.recentViewService.ListRecent(ctx, userID, limit)
+	if err != nil {
+		respondWithError(c, err, "Failed to list recently viewed entities")
+		return
+	}
+
+	SendListResponse(c, items, int64(len(items)), len(items), 0)
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// ResolveReferencesRequest lists the reference IDs to resolve
+type ResolveReferencesRequest struct {
+	ReferenceIDs []string `json:"reference_ids" binding:"required" example:"EP-001,US-002,REQ-010"`
+}
+
+// ReferenceHandler handles HTTP requests for resolving reference IDs
+type ReferenceHandler struct {
+	referenceService service.ReferenceService
+}
+
+// NewReferenceHandler creates a new reference handler instance
+func NewReferenceHandler(referenceService service.ReferenceService) *ReferenceHandler {
+	return &ReferenceHandler{
+		referenceService: referenceService,
+	}
+}
+
+// Resolve handles POST /api/v1/resolve
+// @Summary Resolve reference IDs
+// @Description Resolve up to 500 reference IDs of mixed entity types (EP-, US-, REQ-, AC- and STD-) to their entity type, UUID, title and status in one request. Results keep the request order with duplicates removed; reference IDs without a matching entity are returned with found set to false.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ResolveReferencesRequest true "Reference IDs to resolve"
+// @Success 200 {object} service.ResolveResult "Resolved reference IDs"
+// @Failure 400 {object} map[string]interface{} "Missing or too many reference IDs"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/resolve [post]
+func (h *ReferenceHandler) Resolve(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req ResolveReferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	result, err := h.referenceService.Resolve(ctx, req.ReferenceIDs)
+	if err != nil {
+		if errors.Is(err, service.ErrNoReferenceIDs) || errors.Is(err, service.ErrTooManyReferenceIDs) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": err.Error(),
+				},
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to resolve reference IDs",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
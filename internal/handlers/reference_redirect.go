@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+)
+
+// ReferenceMovedResponse is returned with 301 Moved Permanently when an entity is requested by a retired
+// reference ID; the Location header points to the successor
+type ReferenceMovedResponse struct {
+	ReferenceID string            `json:"reference_id" example:"EP-005"`                     // Requested, retired reference ID
+	RedirectTo  string            `json:"redirect_to" example:"EP-002"`                      // Reference ID of the successor
+	EntityType  models.EntityType `json:"entity_type" example:"epic"`                        // Type of the successor
+	ID          uuid.UUID         `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"` // UUID of the successor
+	Location    string            `json:"location" example:"/api/v1/epics/EP-002"`           // Path of the successor, as in the Location header
+}
+
+// redirectRetiredReference answers 301 Moved Permanently when the requested reference ID resolved to an entity
+// with another reference ID, i.e. a retired reference ID resolved to its successor. The Location header keeps
+// the query string. It reports whether it answered.
+func redirectRetiredReference(c *gin.Context, requested, current string, entityType models.EntityType, id uuid.UUID) bool {
+	if _, err := uuid.Parse(requested); err == nil || strings.EqualFold(strings.TrimSpace(requested), current) {
+		return false
+	}
+
+	location := path.Join(path.Dir(c.Request.URL.Path), current)
+	header := location
+	if c.Request.URL.RawQuery != "" {
+		header += "?" + c.Request.URL.RawQuery
+	}
+	c.Header("Location", header)
+	c.JSON(http.StatusMovedPermanently, ReferenceMovedResponse{
+		ReferenceID: strings.ToUpper(strings.TrimSpace(requested)),
+		RedirectTo:  current,
+		EntityType:  entityType,
+		ID:          id,
+		Location:    location,
+	})
+	return true
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// ReferenceRedirectListResponse represents the response for listing reference redirects
+type ReferenceRedirectListResponse = ListResponse[models.ReferenceRedirect]
+
+// ReferenceRedirectHandler handles HTTP requests for the redirects of retired reference IDs
+type ReferenceRedirectHandler struct {
+	redirectService service.ReferenceRedirectService
+}
+
+// NewReferenceRedirectHandler creates a new reference redirect handler instance
+func NewReferenceRedirectHandler(redirectService service.ReferenceRedirectService) *ReferenceRedirectHandler {
+	return &ReferenceRedirectHandler{
+		redirectService: redirectService,
+	}
+}
+
+// CreateRedirect handles POST /api/v1/admin/reference-redirects
+// @Summary Redirect a retired reference ID
+// @Description Point a reference ID that no longer exists, such as the one of an entity migrated from another system, to its successor. Requests by the retired reference ID then answer 301 Moved Permanently and reference resolution reports the successor. A target that is itself a retired reference ID resolves to its successor.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param redirect body service.CreateReferenceRedirectRequest true "Retired reference ID and successor"
+// @Success 201 {object} models.ReferenceRedirect "Created redirect"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, reference ID or entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Successor not found"
+// @Failure 409 {object} map[string]interface{} "Reference ID belongs to an entity or is already redirected"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/reference-redirects [post]
+func (h *ReferenceRedirectHandler) CreateRedirect(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateReferenceRedirectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	redirect, err := h.redirectService.CreateRedirect(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to create reference redirect")
+		return
+	}
+
+	c.JSON(http.StatusCreated, redirect)
+}
+
+// ListRedirects handles GET /api/v1/admin/reference-redirects
+// @Summary List reference redirects
+// @Description Retrieve the redirects of retired reference IDs, newest first, both those recorded by epic merges and those added for migrated entities
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type query string false "Only redirects to this entity type" Enums(epic, user_story, acceptance_criteria, requirement)
+// @Param limit query int false "Maximum number of redirects to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of redirects to skip" minimum(0) default(0)
+// @Success 200 {object} ReferenceRedirectListResponse "Reference redirects"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/reference-redirects [get]
+func (h *ReferenceRedirectHandler) ListRedirects(c *gin.Context) {
+	ctx := c.Request.Context()
+	var entityType *models.EntityType
+	if value := c.Query("entity_type"); value != "" {
+		parsed := models.EntityType(value)
+		entityType = &parsed
+	}
+	limit, offset := 50, 0
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(c.Query("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+
+	redirects, total, err := h.redirectService.ListRedirects(ctx, entityType, limit, offset)
+	if err != nil {
+		respondWithError(c, err, "Failed to list reference redirects")
+		return
+	}
+
+	SendListResponse(c, redirects, total, limit, offset)
+}
+
+// DeleteRedirect handles DELETE /api/v1/admin/reference-redirects/:reference_id
+// @Summary Delete a reference redirect
+// @Description Remove the redirect of a retired reference ID, which then no longer resolves
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param reference_id path string true "Retired reference ID" example(REQ-104)
+// @Success 204 "Redirect deleted"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Redirect not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/reference-redirects/{reference_id} [delete]
+func (h *ReferenceRedirectHandler) DeleteRedirect(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.redirectService.DeleteRedirect(ctx, c.Param("reference_id")); err != nil {
+		respondWithError(c, err, "Failed to delete reference redirect")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// Media types of exported relationship graphs
+const (
+	dotContentType     = "text/vnd.graphviz; charset=utf-8"
+	mermaidContentType = "text/plain; charset=utf-8"
+)
+
+// RelationshipGraphHandler handles HTTP requests for relationship graph diagram exports
+type RelationshipGraphHandler struct {
+	graphService service.RelationshipGraphService
+}
+
+// NewRelationshipGraphHandler creates a new relationship graph handler instance
+func NewRelationshipGraphHandler(graphService service.RelationshipGraphService) *RelationshipGraphHandler {
+	return &RelationshipGraphHandler{
+		graphService: graphService,
+	}
+}
+
+// ExportEpicGraph handles GET /api/v1/epics/:id/relationships/export
+// @Summary Export an epic's relationship graph
+// @Description Render the requirements of an epic, grouped by user story, and the relationships between them as a Graphviz DOT digraph or a Mermaid flowchart for embedding in wikis and generated docs. Requirements of other epics reached by a relationship are drawn dashed.
+// @Tags epics
+// @Accept json
+// @Produce text/plain
+// @Security BearerAuth
+// @Param id path string true "Epic ID (UUID or reference ID like EP-001)"
+// @Param format query string false "Diagram format" Enums(mermaid, dot) default(mermaid)
+// @Success 200 {string} string "Diagram source"
+// @Failure 400 {object} map[string]interface{} "Invalid format"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Epic not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/relationships/export [get]
+func (h *RelationshipGraphHandler) ExportEpicGraph(c *gin.Context) {
+	ctx := c.Request.Context()
+	format := c.DefaultQuery("format", service.RelationshipGraphFormatMermaid)
+
+	graph, err := h.graphService.EpicGraph(ctx, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to export relationship graph")
+		return
+	}
+
+	h.writeGraph(c, graph, format)
+}
+
+// ExportRequirementGraph handles GET /api/v1/requirements/:id/relationships/export
+// @Summary Export a requirement's relationship neighborhood
+// @Description Render the requirements within depth relationships of a requirement, grouped by user story, as a Graphviz DOT digraph or a Mermaid flowchart. Requirements of other epics are drawn dashed.
+// @Tags requirements
+// @Accept json
+// @Produce text/plain
+// @Security BearerAuth
+// @Param id path string true "Requirement ID (UUID or reference ID like REQ-001)"
+// @Param format query string false "Diagram format" Enums(mermaid, dot) default(mermaid)
+// @Param depth query int false "Number of relationship hops to follow (1-3)" default(1)
+// @Success 200 {string} string "Diagram source"
+// @Failure 400 {object} map[string]interface{} "Invalid format or depth"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Requirement not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/requirements/{id}/relationships/export [get]
+func (h *RelationshipGraphHandler) ExportRequirementGraph(c *gin.Context) {
+	ctx := c.Request.Context()
+	format := c.DefaultQuery("format", service.RelationshipGraphFormatMermaid)
+
+	depth := service.DefaultRelationshipGraphDepth
+	if value := c.Query("depth"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			respondWithError(c, service.ErrInvalidGraphDepth, "")
+			return
+		}
+		depth = parsed
+	}
+
+	graph, err := h.graphService.RequirementGraph(ctx, c.Param("id"), depth)
+	if err != nil {
+		respondWithError(c, err, "Failed to export relationship graph")
+		return
+	}
+
+	h.writeGraph(c, graph, format)
+}
+
+// writeGraph renders a graph in the requested format and writes it as a text response
+func (h *RelationshipGraphHandler) writeGraph(c *gin.Context, graph *service.RelationshipGraph, format string) {
+	diagram, err := service.RenderRelationshipGraph(graph, format)
+	if err != nil {
+		respondWithError(c, err, "Failed to render relationship graph")
+		return
+	}
+
+	contentType, extension := mermaidContentType, ".mmd"
+	if format == service.RelationshipGraphFormatDOT {
+		contentType, extension = dotContentType, ".dot"
+	}
+	c.Header("Content-Disposition", `inline; filename="`+graph.Name+`-relationships`+extension+`"`)
+	c.Data(http.StatusOK, contentType, []byte(diagram))
+}
@@ -0,0 +1,303 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// ReportListResponse represents the response for listing the reports that can be scheduled
+type ReportListResponse = ListResponse[service.ReportDefinition]
+
+// ReportScheduleListResponse represents the response for listing report schedules
+type ReportScheduleListResponse = ListResponse[models.ReportSchedule]
+
+// ReportHandler handles HTTP requests for the report catalog and scheduled report delivery
+type ReportHandler struct {
+	catalog         service.ReportCatalog
+	scheduleService service.ReportScheduleService
+	userRepo        repository.UserRepository
+}
+
+// NewReportHandler creates a new report handler instance
+func NewReportHandler(catalog service.ReportCatalog, scheduleService service.ReportScheduleService, userRepo repository.UserRepository) *ReportHandler {
+	return &ReportHandler{
+		catalog:         catalog,
+		scheduleService: scheduleService,
+		userRepo:        userRepo,
+	}
+}
+
+// ListReports handles GET /api/v1/reports
+// @Summary List schedulable reports
+// @Description Retrieve the reports that can be delivered on a schedule; their IDs are used in /api/v1/reports/{id}/schedules
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ReportListResponse "Reports"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Router /api/v1/reports [get]
+func (h *ReportHandler) ListReports(c *gin.Context) {
+	reports := h.catalog.ListReports()
+	SendListResponse(c, reports, int64(len(reports)), len(reports), 0)
+}
+
+// CreateSchedule handles POST /api/v1/reports/:id/schedules
+// @Summary Schedule a report
+// @Description Deliver a report on a cron schedule (five fields or @hourly, @daily, @weekly, @monthly, evaluated in the given time zone) as a CSV or PDF file, either emailed to recipients as an attachment or posted to a webhook as the request body. Webhooks are only delivered to hosts with public addresses; hosts resolving to loopback, private or link-local addresses are refused, also after redirects. When a delivery fails the owner is alerted by email once until a delivery succeeds again.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" example(stale)
+// @Param schedule body service.ReportScheduleRequest true "Report schedule"
+// @Success 201 {object} models.ReportSchedule "Created schedule"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, cron expression, time zone, format or delivery target"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "User or Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Report not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/{id}/schedules [post]
+func (h *ReportHandler) CreateSchedule(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.ReportScheduleRequest
+	if !h.bindScheduleRequest(c, &req) {
+		return
+	}
+
+	schedule, err := h.scheduleService.CreateSchedule(ctx, c.Param("id"), req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to create report schedule")
+		return
+	}
+
+	c.JSON(http.StatusCreated, schedule)
+}
+
+// ListSchedules handles GET /api/v1/reports/:id/schedules
+// @Summary List the schedules of a report
+// @Description Retrieve the delivery schedules of a report with the outcome of their last run
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" example(stale)
+// @Success 200 {object} ReportScheduleListResponse "Report schedules"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Report not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/{id}/schedules [get]
+func (h *ReportHandler) ListSchedules(c *gin.Context) {
+	ctx := c.Request.Context()
+	schedules, err := h.scheduleService.ListSchedules(ctx, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to list report schedules")
+		return
+	}
+
+	SendListResponse(c, schedules, int64(len(schedules)), len(schedules), 0)
+}
+
+// GetSchedule handles GET /api/v1/reports/:id/schedules/:schedule_id
+// @Summary Get a report schedule
+// @Description Retrieve a delivery schedule of a report
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" example(stale)
+// @Param schedule_id path string true "Schedule UUID" format(uuid)
+// @Success 200 {object} models.ReportSchedule "Report schedule"
+// @Failure 400 {object} map[string]interface{} "Invalid schedule ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Report or schedule not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/{id}/schedules/{schedule_id} [get]
+func (h *ReportHandler) GetSchedule(c *gin.Context) {
+	ctx := c.Request.Context()
+	scheduleID, ok := h.parseScheduleID(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.scheduleService.GetSchedule(ctx, c.Param("id"), scheduleID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get report schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+// UpdateSchedule handles PUT /api/v1/reports/:id/schedules/:schedule_id
+// @Summary Replace a report schedule
+// @Description Replace the timing, format and delivery target of a schedule. Only its owner and administrators may change it.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" example(stale)
+// @Param schedule_id path string true "Schedule UUID" format(uuid)
+// @Param schedule body service.ReportScheduleRequest true "Report schedule"
+// @Success 200 {object} models.ReportSchedule "Updated schedule"
+// @Failure 400 {object} map[string]interface{} "Invalid schedule ID, request body, cron expression, time zone, format or delivery target"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Not the owner of the schedule"
+// @Failure 404 {object} map[string]interface{} "Report or schedule not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/{id}/schedules/{schedule_id} [put]
+func (h *ReportHandler) UpdateSchedule(c *gin.Context) {
+	ctx := c.Request.Context()
+	scheduleID, ok := h.parseScheduleID(c)
+	if !ok {
+		return
+	}
+	currentUser, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+
+	var req service.ReportScheduleRequest
+	if !h.bindScheduleRequest(c, &req) {
+		return
+	}
+
+	schedule, err := h.scheduleService.UpdateSchedule(ctx, c.Param("id"), scheduleID, req, currentUser)
+	if err != nil {
+		respondWithError(c, err, "Failed to update report schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+// DeleteSchedule handles DELETE /api/v1/reports/:id/schedules/:schedule_id
+// @Summary Delete a report schedule
+// @Description Stop delivering a report. Only the owner of the schedule and administrators may delete it.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" example(stale)
+// @Param schedule_id path string true "Schedule UUID" format(uuid)
+// @Success 204 "Schedule deleted"
+// @Failure 400 {object} map[string]interface{} "Invalid schedule ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Not the owner of the schedule"
+// @Failure 404 {object} map[string]interface{} "Report or schedule not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/{id}/schedules/{schedule_id} [delete]
+func (h *ReportHandler) DeleteSchedule(c *gin.Context) {
+	ctx := c.Request.Context()
+	scheduleID, ok := h.parseScheduleID(c)
+	if !ok {
+		return
+	}
+	currentUser, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.scheduleService.DeleteSchedule(ctx, c.Param("id"), scheduleID, currentUser); err != nil {
+		respondWithError(c, err, "Failed to delete report schedule")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RunSchedule handles POST /api/v1/reports/:id/schedules/:schedule_id/run
+// @Summary Deliver a scheduled report now
+// @Description Deliver the report of a schedule immediately, for example to check a new webhook. The outcome is recorded in last_status and last_error like a scheduled run. Only the owner of the schedule and administrators may run it.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Report ID" example(stale)
+// @Param schedule_id path string true "Schedule UUID" format(uuid)
+// @Success 200 {object} models.ReportSchedule "Schedule with the outcome of the run"
+// @Failure 400 {object} map[string]interface{} "Invalid schedule ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Not the owner of the schedule"
+// @Failure 404 {object} map[string]interface{} "Report or schedule not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/{id}/schedules/{schedule_id}/run [post]
+func (h *ReportHandler) RunSchedule(c *gin.Context) {
+	ctx := c.Request.Context()
+	scheduleID, ok := h.parseScheduleID(c)
+	if !ok {
+		return
+	}
+	currentUser, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.scheduleService.RunSchedule(ctx, c.Param("id"), scheduleID, currentUser)
+	if err != nil {
+		respondWithError(c, err, "Failed to run report schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+// bindScheduleRequest binds the schedule request body, writing a 400 response if it is invalid
+func (h *ReportHandler) bindScheduleRequest(c *gin.Context, req *service.ReportScheduleRequest) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// currentUser loads the authenticated user, writing a 401 response if there is none
+func (h *ReportHandler) currentUser(c *gin.Context) (*models.User, bool) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return nil, false
+	}
+	user, err := h.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": gin.H{
+				"code":    "AUTHENTICATION_REQUIRED",
+				"message": "User not found",
+			},
+		})
+		return nil, false
+	}
+	return user, true
+}
+
+// parseScheduleID parses the schedule ID path parameter, writing a 400 response if it is invalid
+func (h *ReportHandler) parseScheduleID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("schedule_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid report schedule ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -1,9 +1,13 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -19,6 +23,13 @@ type RequirementListResponse = ListResponse[models.Requirement]
 // RequirementHandler handles HTTP requests for requirement operations
 type RequirementHandler struct {
 	requirementService service.RequirementService
+	glossaryService    service.GlossaryService
+	favoriteService    service.FavoriteService
+	supersession       service.SupersessionService
+	warningService     service.ValidationWarningService
+	countService       service.EntityCountService
+	riskService        service.RequirementRiskService
+	translationService service.TranslationService
 }
 
 // NewRequirementHandler creates a new requirement handler instance
@@ -28,6 +39,58 @@ func NewRequirementHandler(requirementService service.RequirementService) *Requi
 	}
 }
 
+// SetFavoriteService enables the is_favorite flag (include=is_favorite) on ListRequirements
+func (h *RequirementHandler) SetFavoriteService(favoriteService service.FavoriteService) {
+	h.favoriteService = favoriteService
+}
+
+// SetCountService enables aggregate counts (include=comments_count and similar) on GetRequirement and ListRequirements
+func (h *RequirementHandler) SetCountService(countService service.EntityCountService) {
+	h.countService = countService
+}
+
+// SetTranslationService enables translated titles and descriptions, chosen with Accept-Language, on GetRequirement and ListRequirements
+func (h *RequirementHandler) SetTranslationService(translationService service.TranslationService) {
+	h.translationService = translationService
+}
+
+// SetRiskService enables risk scores (include=risk) on GetRequirement and ListRequirements
+func (h *RequirementHandler) SetRiskService(riskService service.RequirementRiskService) {
+	h.riskService = riskService
+}
+
+// riskFields computes the risk field of the requirements when include=risk was requested
+func (h *RequirementHandler) riskFields(ctx context.Context, includes []string, ids []uuid.UUID) (map[uuid.UUID]map[string]interface{}, error) {
+	if h.riskService == nil || !slices.Contains(includes, includeRisk) {
+		return nil, nil
+	}
+	scores, err := h.riskService.Scores(ctx, ids, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[uuid.UUID]map[string]interface{}, len(scores))
+	for id, risk := range scores {
+		fields[id] = map[string]interface{}{includeRisk: risk}
+	}
+	return fields, nil
+}
+
+// SetWarningService enables validation warnings (a warnings array) on requirement create and update responses
+func (h *RequirementHandler) SetWarningService(warningService service.ValidationWarningService) {
+	h.warningService = warningService
+}
+
+// SetSupersessionService enables recording the superseding requirement (superseded_by) on ChangeRequirementStatus
+func (h *RequirementHandler) SetSupersessionService(supersessionService service.SupersessionService) {
+	h.supersession = supersessionService
+}
+
+// SetGlossaryService enables glossary term annotations (annotate_terms=true) on GetRequirement
+func (h *RequirementHandler) SetGlossaryService(glossaryService service.GlossaryService) {
+	h.glossaryService = glossaryService
+}
+
 // CreateRequirement handles both POST /api/v1/requirements and POST /api/v1/user-stories/:id/requirements
 // @Summary Create a requirement (standalone or within a user story)
 // @Description Create a new detailed requirement. When called via /api/v1/user-stories/:id/requirements, the user story ID from the URL path will be used as the parent. When called via /api/v1/requirements, the user_story_id must be provided in the request body.
@@ -37,13 +100,15 @@ func NewRequirementHandler(requirementService service.RequirementService) *Requi
 // @Security BearerAuth
 // @Param id path string false "User story UUID (only for nested creation)" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param requirement body service.CreateRequirementRequest true "Requirement creation request"
-// @Success 201 {object} models.Requirement "Successfully created requirement"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 201 {object} models.Requirement "Successfully created requirement; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid user story ID format, request body, creator/assignee not found, user story not found, requirement type not found, acceptance criteria not found, or invalid priority"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements [post]
 // @Router /api/v1/user-stories/{id}/requirements [post]
 func (h *RequirementHandler) CreateRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
 	// Check if this is a nested creation (user story ID in path)
 	userStoryIDParam := c.Param("id")
 	var isNestedCreation bool
@@ -89,7 +154,7 @@ func (h *RequirementHandler) CreateRequirement(c *gin.Context) {
 		req.UserStoryID = userStoryID
 	}
 
-	requirement, err := h.requirementService.CreateRequirement(req)
+	requirement, err := h.requirementService.CreateRequirement(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserNotFound):
@@ -120,23 +185,29 @@ func (h *RequirementHandler) CreateRequirement(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, requirement)
+	respondWithWarnings(c, http.StatusCreated, h.warningService, models.EntityTypeRequirement, requirement.ID, requirement)
 }
 
 // GetRequirement handles GET /api/v1/requirements/:id
 // @Summary Get a requirement by ID or reference ID
-// @Description Retrieve a specific requirement by its UUID or human-readable reference ID (e.g., REQ-001). Returns the requirement with all its properties and relationships.
+// @Description Retrieve a specific requirement by its UUID or human-readable reference ID (e.g., REQ-001). Returns the requirement with all its properties and relationships. With annotate_terms=true the response also lists the glossary terms found in the title and description as term_annotations. A retired reference ID answers 301 Moved Permanently with the successor in the Location header.
 // @Tags requirements
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Requirement UUID or reference ID" example("123e4567-e89b-12d3-a456-426614174000")
-// @Success 200 {object} models.Requirement "Successfully retrieved requirement"
+// @Param annotate_terms query bool false "Include glossary term annotations"
+// @Param include query string false "Fields to embed (comma-separated): comments_count, unresolved_comments_count, acceptance_criteria_count and relationships_count add aggregate counts; risk adds the risk score with its factors" example("comments_count,risk")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
+// @Success 200 {object} service.AnnotatedRequirement "Successfully retrieved requirement"
+// @Success 301 {object} ReferenceMovedResponse "Retired reference ID; the Location header points to the successor"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 404 {object} map[string]interface{} "Requirement not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/{id} [get]
 func (h *RequirementHandler) GetRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -144,9 +215,9 @@ func (h *RequirementHandler) GetRequirement(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		requirement, err = h.requirementService.GetRequirementByID(id)
+		requirement, err = h.requirementService.GetRequirementByID(ctx, id)
 	} else {
-		requirement, err = h.requirementService.GetRequirementByReferenceID(idParam)
+		requirement, err = h.requirementService.GetRequirementByReferenceID(ctx, idParam)
 	}
 
 	if err != nil {
@@ -162,7 +233,43 @@ func (h *RequirementHandler) GetRequirement(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, requirement)
+	if redirectRetiredReference(c, idParam, requirement.ReferenceID, models.EntityTypeRequirement, requirement.ID) {
+		return
+	}
+
+	if err := localizeEntities(c, h.translationService, models.EntityTypeRequirement, []*models.Requirement{requirement}, func(e *models.Requirement) uuid.UUID { return e.ID }, translateRequirement); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get requirement",
+		})
+		return
+	}
+
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, service.CompactRequirement(requirement))
+		return
+	}
+
+	var entity json.Marshaler = requirement
+	if c.Query("annotate_terms") == "true" && h.glossaryService != nil {
+		annotated, err := h.glossaryService.AnnotateRequirement(ctx, requirement)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to annotate glossary terms",
+			})
+			return
+		}
+		entity = annotated
+	}
+
+	response, err := includeEntityFields(c, h.countService, models.EntityTypeRequirement, requirement.ID, entity, h.riskFields)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get requirement",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // UpdateRequirement handles PUT /api/v1/requirements/:id
@@ -174,13 +281,15 @@ func (h *RequirementHandler) GetRequirement(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path string true "Requirement UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param requirement body service.UpdateRequirementRequest true "Requirement update request with optional fields"
-// @Success 200 {object} models.Requirement "Successfully updated requirement"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 200 {object} models.Requirement "Successfully updated requirement; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid requirement ID format, request body, assignee not found, requirement type not found, acceptance criteria not found, invalid priority, invalid requirement status, or invalid status transition"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 404 {object} map[string]interface{} "Requirement not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/{id} [put]
 func (h *RequirementHandler) UpdateRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for updates)
@@ -201,7 +310,7 @@ func (h *RequirementHandler) UpdateRequirement(c *gin.Context) {
 		return
 	}
 
-	requirement, err := h.requirementService.UpdateRequirement(id, req)
+	requirement, err := h.requirementService.UpdateRequirement(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementNotFound):
@@ -240,7 +349,7 @@ func (h *RequirementHandler) UpdateRequirement(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, requirement)
+	respondWithWarnings(c, http.StatusOK, h.warningService, models.EntityTypeRequirement, requirement.ID, requirement)
 }
 
 // DeleteRequirement handles DELETE /api/v1/requirements/:id
@@ -260,6 +369,7 @@ func (h *RequirementHandler) UpdateRequirement(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/{id} [delete]
 func (h *RequirementHandler) DeleteRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for deletes)
@@ -274,7 +384,7 @@ func (h *RequirementHandler) DeleteRequirement(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.requirementService.DeleteRequirement(id, force)
+	err = h.requirementService.DeleteRequirement(ctx, id, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementNotFound):
@@ -311,14 +421,20 @@ func (h *RequirementHandler) DeleteRequirement(c *gin.Context) {
 // @Param status query string false "Filter by requirement status" Enums(draft, in_review, approved, implemented, tested, rejected) example("draft")
 // @Param priority query integer false "Filter by priority level" minimum(1) maximum(4) example(2)
 // @Param type_id query string false "Filter by requirement type UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174004")
+// @Param include query string false "Comma-separated: is_favorite adds the current user's favorite flag to each item; comments_count, unresolved_comments_count, acceptance_criteria_count and relationships_count add aggregate counts; comment_counts adds a {total, unresolved} object; risk adds the risk score with its factors" example("is_favorite,comments_count")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
 // @Param order_by query string false "Order by field (e.g., 'created_at DESC', 'priority ASC')" example("created_at DESC")
+// @Param sort query string false "Comma-separated sort keys field:asc or field:desc, taking precedence over order_by; ties are broken by ID. Fields: reference_id, title, priority, status, created_at, updated_at" example("priority:asc,updated_at:desc")
 // @Param limit query integer false "Maximum number of results" minimum(1) maximum(100) example(50)
 // @Param offset query integer false "Number of results to skip" minimum(0) example(0)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
 // @Success 200 {object} map[string]interface{} "Successfully retrieved requirements list with pagination info"
+// @Failure 400 {object} ErrorResponse "Invalid sort keys"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements [get]
 func (h *RequirementHandler) ListRequirements(c *gin.Context) {
+	ctx := c.Request.Context()
 	var filters service.RequirementFilters
 
 	// Parse query parameters
@@ -367,6 +483,7 @@ func (h *RequirementHandler) ListRequirements(c *gin.Context) {
 	if orderBy := c.Query("order_by"); orderBy != "" {
 		filters.OrderBy = orderBy
 	}
+	filters.Sort = c.Query("sort")
 
 	if limit := c.Query("limit"); limit != "" {
 		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
@@ -380,8 +497,19 @@ func (h *RequirementHandler) ListRequirements(c *gin.Context) {
 		}
 	}
 
-	requirements, totalCount, err := h.requirementService.ListRequirements(filters)
+	requirements, totalCount, err := h.requirementService.ListRequirements(ctx, filters)
 	if err != nil {
+		respondWithError(c, err, "Failed to list requirements")
+		return
+	}
+
+	// Set default limit if not specified
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+
+	if err := localizeEntities(c, h.translationService, models.EntityTypeRequirement, entityPointers(requirements), func(e *models.Requirement) uuid.UUID { return e.ID }, translateRequirement); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": gin.H{
 				"code":    "INTERNAL_ERROR",
@@ -391,14 +519,30 @@ func (h *RequirementHandler) ListRequirements(c *gin.Context) {
 		return
 	}
 
-	// Set default limit if not specified
-	limit := 50
-	if filters.Limit > 0 {
-		limit = filters.Limit
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, gin.H{
+			"data":        service.CompactRequirements(requirements),
+			"total_count": totalCount,
+			"limit":       limit,
+			"offset":      filters.Offset,
+		})
+		return
+	}
+
+	data, err := includeListFields(c, h.favoriteService, h.countService, models.EntityTypeRequirement, requirements, func(r *models.Requirement) uuid.UUID { return r.ID },
+		h.riskFields, commentCountFields(h.countService, models.EntityTypeRequirement))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to list requirements",
+			},
+		})
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"data":        requirements,
+		"data":        data,
 		"total_count": totalCount,
 		"limit":       limit,
 		"offset":      filters.Offset,
@@ -419,6 +563,7 @@ func (h *RequirementHandler) ListRequirements(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/{id}/relationships [get]
 func (h *RequirementHandler) GetRequirementWithRelationships(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -426,11 +571,11 @@ func (h *RequirementHandler) GetRequirementWithRelationships(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		requirement, err = h.requirementService.GetRequirementWithRelationships(id)
+		requirement, err = h.requirementService.GetRequirementWithRelationships(ctx, id)
 	} else {
 		// For reference ID, first get the requirement, then get with relationships
-		if tempRequirement, tempErr := h.requirementService.GetRequirementByReferenceID(idParam); tempErr == nil {
-			requirement, err = h.requirementService.GetRequirementWithRelationships(tempRequirement.ID)
+		if tempRequirement, tempErr := h.requirementService.GetRequirementByReferenceID(ctx, idParam); tempErr == nil {
+			requirement, err = h.requirementService.GetRequirementWithRelationships(ctx, tempRequirement.ID)
 		} else {
 			err = tempErr
 		}
@@ -454,20 +599,21 @@ func (h *RequirementHandler) GetRequirementWithRelationships(c *gin.Context) {
 
 // ChangeRequirementStatus handles PATCH /api/v1/requirements/:id/status
 // @Summary Change requirement status
-// @Description Update the status of a requirement. Status transitions are validated according to business rules to ensure proper workflow progression (e.g., draft → in_review → approved → implemented → tested).
+// @Description Update the status of a requirement. Status transitions are validated according to business rules to ensure proper workflow progression (e.g., draft → in_review → approved → implemented → tested). When marking a requirement Obsolete, superseded_by (UUID or reference ID) records the requirement that supersedes it. Leaving Obsolete clears the superseding requirement.
 // @Tags requirements
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Requirement UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
-// @Param status body object true "Status change request" example({"status":"in_review"})
+// @Param status body object true "Status change request" example({"status":"Obsolete","superseded_by":"REQ-010"})
 // @Success 200 {object} models.Requirement "Successfully changed requirement status"
-// @Failure 400 {object} map[string]interface{} "Invalid requirement ID format, request body, invalid requirement status, or invalid status transition"
+// @Failure 400 {object} map[string]interface{} "Invalid requirement ID format, request body, invalid requirement status, invalid status transition, or invalid superseding requirement"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
-// @Failure 404 {object} map[string]interface{} "Requirement not found"
+// @Failure 404 {object} map[string]interface{} "Requirement or superseding requirement not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/{id}/status [patch]
 func (h *RequirementHandler) ChangeRequirementStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for status changes)
@@ -480,7 +626,8 @@ func (h *RequirementHandler) ChangeRequirementStatus(c *gin.Context) {
 	}
 
 	var req struct {
-		Status models.RequirementStatus `json:"status" binding:"required"`
+		Status       models.RequirementStatus `json:"status" binding:"required"`
+		SupersededBy string                   `json:"superseded_by,omitempty"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -491,7 +638,22 @@ func (h *RequirementHandler) ChangeRequirementStatus(c *gin.Context) {
 		return
 	}
 
-	requirement, err := h.requirementService.ChangeRequirementStatus(id, req.Status)
+	// Check the superseding requirement before changing the status so a bad reference changes nothing
+	supersede := req.SupersededBy != "" && h.supersession != nil
+	if supersede {
+		if req.Status != models.RequirementStatusObsolete {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "superseded_by requires status Obsolete",
+			})
+			return
+		}
+		if _, err := h.supersession.ResolveSuccessor(ctx, id, req.SupersededBy); err != nil {
+			respondWithError(c, err, "Failed to change requirement status")
+			return
+		}
+	}
+
+	requirement, err := h.requirementService.ChangeRequirementStatus(ctx, id, req.Status)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementNotFound):
@@ -514,6 +676,13 @@ func (h *RequirementHandler) ChangeRequirementStatus(c *gin.Context) {
 		return
 	}
 
+	if supersede {
+		if requirement, err = h.supersession.SetSupersededBy(ctx, id.String(), &req.SupersededBy); err != nil {
+			respondWithError(c, err, "Failed to record supersession")
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, requirement)
 }
 
@@ -533,6 +702,7 @@ func (h *RequirementHandler) ChangeRequirementStatus(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/{id}/assign [patch]
 func (h *RequirementHandler) AssignRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for assignments)
@@ -556,7 +726,7 @@ func (h *RequirementHandler) AssignRequirement(c *gin.Context) {
 		return
 	}
 
-	requirement, err := h.requirementService.AssignRequirement(id, req.AssigneeID)
+	requirement, err := h.requirementService.AssignRequirement(ctx, id, req.AssigneeID)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementNotFound):
@@ -593,6 +763,7 @@ func (h *RequirementHandler) AssignRequirement(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/relationships [post]
 func (h *RequirementHandler) CreateRelationship(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateRelationshipRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -602,7 +773,7 @@ func (h *RequirementHandler) CreateRelationship(c *gin.Context) {
 		return
 	}
 
-	relationship, err := h.requirementService.CreateRelationship(req)
+	relationship, err := h.requirementService.CreateRelationship(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrRequirementNotFound):
@@ -651,6 +822,7 @@ func (h *RequirementHandler) CreateRelationship(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirement-relationships/{id} [delete]
 func (h *RequirementHandler) DeleteRelationship(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for deletes)
@@ -662,7 +834,7 @@ func (h *RequirementHandler) DeleteRelationship(c *gin.Context) {
 		return
 	}
 
-	err = h.requirementService.DeleteRelationship(id)
+	err = h.requirementService.DeleteRelationship(ctx, id)
 	if err != nil {
 		if errors.Is(err, service.ErrRequirementNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -695,6 +867,7 @@ func (h *RequirementHandler) DeleteRelationship(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/{id}/relationships [get]
 func (h *RequirementHandler) GetRelationshipsByRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse pagination parameters
@@ -724,7 +897,7 @@ func (h *RequirementHandler) GetRelationshipsByRequirement(c *gin.Context) {
 		requirementID = id
 	} else {
 		// For reference ID, first get the requirement ID
-		if requirement, tempErr := h.requirementService.GetRequirementByReferenceID(idParam); tempErr == nil {
+		if requirement, tempErr := h.requirementService.GetRequirementByReferenceID(ctx, idParam); tempErr == nil {
 			requirementID = requirement.ID
 		} else {
 			err = tempErr
@@ -744,7 +917,7 @@ func (h *RequirementHandler) GetRelationshipsByRequirement(c *gin.Context) {
 		return
 	}
 
-	relationships, totalCount, err := h.requirementService.GetRelationshipsByRequirementWithPagination(requirementID, limit, offset)
+	relationships, totalCount, err := h.requirementService.GetRelationshipsByRequirementWithPagination(ctx, requirementID, limit, offset)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to get relationships",
@@ -771,6 +944,7 @@ func (h *RequirementHandler) GetRelationshipsByRequirement(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/requirements/search [get]
 func (h *RequirementHandler) SearchRequirements(c *gin.Context) {
+	ctx := c.Request.Context()
 	searchText := c.Query("q")
 	if searchText == "" {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -799,7 +973,7 @@ func (h *RequirementHandler) SearchRequirements(c *gin.Context) {
 		offset = 0 // Default offset
 	}
 
-	requirements, totalCount, err := h.requirementService.SearchRequirementsWithPagination(searchText, limit, offset)
+	requirements, totalCount, err := h.requirementService.SearchRequirementsWithPagination(ctx, searchText, limit, offset)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Failed to search requirements",
@@ -809,3 +983,129 @@ func (h *RequirementHandler) SearchRequirements(c *gin.Context) {
 
 	SendListResponse(c, requirements, totalCount, limit, offset)
 }
+
+// GetLinkedAcceptanceCriteria handles GET /api/v1/requirements/:id/acceptance-criteria
+// @Summary List the acceptance criteria a requirement covers
+// @Description Retrieve all acceptance criteria linked to a requirement in the order they were linked. The requirement's acceptance_criteria_id names the primary one, which places the requirement in the hierarchy.
+// @Tags requirements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Requirement UUID or reference ID" example("REQ-001")
+// @Success 200 {object} AcceptanceCriteriaListResponse "Linked acceptance criteria"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Requirement not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/requirements/{id}/acceptance-criteria [get]
+func (h *RequirementHandler) GetLinkedAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
+	requirementID, err := h.requirementID(c)
+	if err != nil {
+		respondWithError(c, err, "Failed to get requirement")
+		return
+	}
+
+	acceptanceCriteria, err := h.requirementService.GetLinkedAcceptanceCriteria(ctx, requirementID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get linked acceptance criteria")
+		return
+	}
+
+	SendListResponse(c, acceptanceCriteria, int64(len(acceptanceCriteria)), len(acceptanceCriteria), 0)
+}
+
+// LinkAcceptanceCriteria handles POST /api/v1/requirements/:id/acceptance-criteria
+// @Summary Link acceptance criteria to a requirement
+// @Description Link acceptance criteria of the requirement's user story to a requirement, which may cover several of them. Linking an already linked acceptance criteria has no effect. A requirement without acceptance criteria gets the first one as its primary acceptance criteria.
+// @Tags requirements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Requirement UUID or reference ID" example("REQ-001")
+// @Param request body service.LinkAcceptanceCriteriaRequest true "Acceptance criteria to link"
+// @Success 200 {object} models.Requirement "Requirement with its acceptance_criteria_ids"
+// @Failure 400 {object} ErrorResponse "Invalid request body or acceptance criteria of another user story"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Requirement or acceptance criteria not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/requirements/{id}/acceptance-criteria [post]
+func (h *RequirementHandler) LinkAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
+	requirementID, err := h.requirementID(c)
+	if err != nil {
+		respondWithError(c, err, "Failed to get requirement")
+		return
+	}
+
+	var req service.LinkAcceptanceCriteriaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+			Code:    "VALIDATION_ERROR",
+			Message: "Invalid request body: " + err.Error(),
+		}})
+		return
+	}
+
+	requirement, err := h.requirementService.LinkAcceptanceCriteria(ctx, requirementID, req.AcceptanceCriteriaIDs)
+	if err != nil {
+		respondWithError(c, err, "Failed to link acceptance criteria")
+		return
+	}
+
+	c.JSON(http.StatusOK, requirement)
+}
+
+// UnlinkAcceptanceCriteria handles DELETE /api/v1/requirements/:id/acceptance-criteria/:ac_id
+// @Summary Unlink an acceptance criteria from a requirement
+// @Description Remove the link between a requirement and an acceptance criteria. When the primary acceptance criteria is unlinked, the earliest remaining one becomes primary; a requirement without links is listed directly under its user story.
+// @Tags requirements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Requirement UUID or reference ID" example("REQ-001")
+// @Param ac_id path string true "Acceptance criteria UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174002")
+// @Success 200 {object} models.Requirement "Requirement with its remaining acceptance_criteria_ids"
+// @Failure 400 {object} ErrorResponse "Invalid acceptance criteria ID format"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Requirement not found or not linked to the acceptance criteria"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/requirements/{id}/acceptance-criteria/{ac_id} [delete]
+func (h *RequirementHandler) UnlinkAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
+	requirementID, err := h.requirementID(c)
+	if err != nil {
+		respondWithError(c, err, "Failed to get requirement")
+		return
+	}
+
+	acceptanceCriteriaID, err := uuid.Parse(c.Param("ac_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+			Code:    "VALIDATION_ERROR",
+			Message: "Invalid acceptance criteria ID format",
+		}})
+		return
+	}
+
+	requirement, err := h.requirementService.UnlinkAcceptanceCriteria(ctx, requirementID, acceptanceCriteriaID)
+	if err != nil {
+		respondWithError(c, err, "Failed to unlink acceptance criteria")
+		return
+	}
+
+	c.JSON(http.StatusOK, requirement)
+}
+
+// requirementID resolves the requirement UUID or reference ID of the id path parameter
+func (h *RequirementHandler) requirementID(c *gin.Context) (uuid.UUID, error) {
+	ctx := c.Request.Context()
+	idParam := c.Param("id")
+	if id, err := uuid.Parse(idParam); err == nil {
+		return id, nil
+	}
+	requirement, err := h.requirementService.GetRequirementByReferenceID(ctx, idParam)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return requirement.ID, nil
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -24,7 +25,7 @@ type MockRequirementService struct {
 	mock.Mock
 }
 
-func (m *MockRequirementService) CreateRequirement(req service.CreateRequirementRequest) (*models.Requirement, error) {
+func (m *MockRequirementService) CreateRequirement(ctx context.Context, req service.CreateRequirementRequest) (*models.Requirement, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -32,7 +33,7 @@ func (m *MockRequirementService) CreateRequirement(req service.CreateRequirement
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) GetRequirementByID(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementService) GetRequirementByID(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,7 +41,7 @@ func (m *MockRequirementService) GetRequirementByID(id uuid.UUID) (*models.Requi
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) GetRequirementByReferenceID(referenceID string) (*models.Requirement, error) {
+func (m *MockRequirementService) GetRequirementByReferenceID(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -48,7 +49,7 @@ func (m *MockRequirementService) GetRequirementByReferenceID(referenceID string)
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) UpdateRequirement(id uuid.UUID, req service.UpdateRequirementRequest) (*models.Requirement, error) {
+func (m *MockRequirementService) UpdateRequirement(ctx context.Context, id uuid.UUID, req service.UpdateRequirementRequest) (*models.Requirement, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -56,17 +57,17 @@ func (m *MockRequirementService) UpdateRequirement(id uuid.UUID, req service.Upd
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) DeleteRequirement(id uuid.UUID, force bool) error {
+func (m *MockRequirementService) DeleteRequirement(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockRequirementService) ListRequirements(filters service.RequirementFilters) ([]models.Requirement, int64, error) {
+func (m *MockRequirementService) ListRequirements(ctx context.Context, filters service.RequirementFilters) ([]models.Requirement, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.Requirement), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockRequirementService) GetRequirementWithRelationships(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementService) GetRequirementWithRelationships(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -74,12 +75,12 @@ func (m *MockRequirementService) GetRequirementWithRelationships(id uuid.UUID) (
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) GetRequirementsByUserStory(userStoryID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockRequirementService) GetRequirementsByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(userStoryID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) ChangeRequirementStatus(id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error) {
+func (m *MockRequirementService) ChangeRequirementStatus(ctx context.Context, id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error) {
 	args := m.Called(id, newStatus)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -87,7 +88,7 @@ func (m *MockRequirementService) ChangeRequirementStatus(id uuid.UUID, newStatus
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) AssignRequirement(id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementService) AssignRequirement(ctx context.Context, id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id, assigneeID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -95,7 +96,7 @@ func (m *MockRequirementService) AssignRequirement(id uuid.UUID, assigneeID uuid
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) CreateRelationship(req service.CreateRelationshipRequest) (*models.RequirementRelationship, error) {
+func (m *MockRequirementService) CreateRelationship(ctx context.Context, req service.CreateRelationshipRequest) (*models.RequirementRelationship, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -103,31 +104,52 @@ func (m *MockRequirementService) CreateRelationship(req service.CreateRelationsh
 	return args.Get(0).(*models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementService) DeleteRelationship(id uuid.UUID) error {
+func (m *MockRequirementService) DeleteRelationship(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockRequirementService) GetRelationshipsByRequirement(requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockRequirementService) GetRelationshipsByRequirement(ctx context.Context, requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
 	args := m.Called(requirementID)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementService) SearchRequirements(searchText string) ([]models.Requirement, error) {
+func (m *MockRequirementService) SearchRequirements(ctx context.Context, searchText string) ([]models.Requirement, error) {
 	args := m.Called(searchText)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) GetRelationshipsByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
+func (m *MockRequirementService) GetRelationshipsByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
 	args := m.Called(requirementID, limit, offset)
 	return args.Get(0).([]models.RequirementRelationship), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockRequirementService) SearchRequirementsWithPagination(searchText string, limit, offset int) ([]models.Requirement, int64, error) {
+func (m *MockRequirementService) SearchRequirementsWithPagination(ctx context.Context, searchText string, limit, offset int) ([]models.Requirement, int64, error) {
 	args := m.Called(searchText, limit, offset)
 	return args.Get(0).([]models.Requirement), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockRequirementService) GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+	args := m.Called(requirementID)
+	return args.Get(0).([]models.AcceptanceCriteria), args.Error(1)
+}
+
+func (m *MockRequirementService) LinkAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID, acceptanceCriteriaIDs []uuid.UUID) (*models.Requirement, error) {
+	args := m.Called(requirementID, acceptanceCriteriaIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Requirement), args.Error(1)
+}
+
+func (m *MockRequirementService) UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) (*models.Requirement, error) {
+	args := m.Called(requirementID, acceptanceCriteriaID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Requirement), args.Error(1)
+}
+
 func setupRequirementTestRouter() (*gin.Engine, *MockRequirementService, *auth.Service) {
 	gin.SetMode(gin.TestMode)
 
@@ -154,6 +176,9 @@ func setupRequirementTestRouter() (*gin.Engine, *MockRequirementService, *auth.S
 		v1.DELETE("/requirement-relationships/:id", handler.DeleteRelationship)
 		v1.PATCH("/requirements/:id/status", handler.ChangeRequirementStatus)
 		v1.PATCH("/requirements/:id/assign", handler.AssignRequirement)
+		v1.GET("/requirements/:id/acceptance-criteria", handler.GetLinkedAcceptanceCriteria)
+		v1.POST("/requirements/:id/acceptance-criteria", handler.LinkAcceptanceCriteria)
+		v1.DELETE("/requirements/:id/acceptance-criteria/:ac_id", handler.UnlinkAcceptanceCriteria)
 	}
 
 	return router, mockService, authService
@@ -707,3 +732,90 @@ func TestRequirementHandler_SearchRequirements(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 }
+
+func TestRequirementHandler_LinkAcceptanceCriteria(t *testing.T) {
+	t.Run("successful link", func(t *testing.T) {
+		router, mockService, authService := setupRequirementTestRouter()
+
+		requirementID, ac1ID, ac2ID := uuid.New(), uuid.New(), uuid.New()
+		requirement := &models.Requirement{ID: requirementID, ReferenceID: "REQ-001", AcceptanceCriteriaID: &ac1ID}
+
+		mockService.On("LinkAcceptanceCriteria", requirementID, []uuid.UUID{ac1ID, ac2ID}).Return(requirement, nil)
+
+		body, _ := json.Marshal(service.LinkAcceptanceCriteriaRequest{AcceptanceCriteriaIDs: []uuid.UUID{ac1ID, ac2ID}})
+		req, err := createAuthenticatedRequirementRequest("POST", "/api/v1/requirements/"+requirementID.String()+"/acceptance-criteria", bytes.NewBuffer(body), authService)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("empty acceptance criteria list", func(t *testing.T) {
+		router, mockService, authService := setupRequirementTestRouter()
+
+		requirementID := uuid.New()
+		req, err := createAuthenticatedRequirementRequest("POST", "/api/v1/requirements/"+requirementID.String()+"/acceptance-criteria",
+			bytes.NewBufferString(`{"acceptance_criteria_ids":[]}`), authService)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "LinkAcceptanceCriteria", mock.Anything, mock.Anything)
+	})
+
+	t.Run("acceptance criteria of another user story", func(t *testing.T) {
+		router, mockService, authService := setupRequirementTestRouter()
+
+		requirementID, acID := uuid.New(), uuid.New()
+		mockService.On("LinkAcceptanceCriteria", requirementID, []uuid.UUID{acID}).Return(nil, service.ErrAcceptanceCriteriaOfOtherUserStory)
+
+		body, _ := json.Marshal(service.LinkAcceptanceCriteriaRequest{AcceptanceCriteriaIDs: []uuid.UUID{acID}})
+		req, err := createAuthenticatedRequirementRequest("POST", "/api/v1/requirements/"+requirementID.String()+"/acceptance-criteria", bytes.NewBuffer(body), authService)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestRequirementHandler_UnlinkAcceptanceCriteria(t *testing.T) {
+	t.Run("successful unlink", func(t *testing.T) {
+		router, mockService, authService := setupRequirementTestRouter()
+
+		requirementID, acID := uuid.New(), uuid.New()
+		mockService.On("UnlinkAcceptanceCriteria", requirementID, acID).Return(&models.Requirement{ID: requirementID}, nil)
+
+		req, err := createAuthenticatedRequirementRequest("DELETE", "/api/v1/requirements/"+requirementID.String()+"/acceptance-criteria/"+acID.String(), nil, authService)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("acceptance criteria not linked", func(t *testing.T) {
+		router, mockService, authService := setupRequirementTestRouter()
+
+		requirementID, acID := uuid.New(), uuid.New()
+		mockService.On("UnlinkAcceptanceCriteria", requirementID, acID).Return(nil, service.ErrAcceptanceCriteriaNotLinked)
+
+		req, err := createAuthenticatedRequirementRequest("DELETE", "/api/v1/requirements/"+requirementID.String()+"/acceptance-criteria/"+acID.String(), nil, authService)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// RequirementRiskReportResponse represents the response of the requirement risk report
+type RequirementRiskReportResponse = ListResponse[service.RequirementRiskEntry]
+
+// includeRisk is the include value that adds the risk score of requirements to requirement responses
+const includeRisk = "risk"
+
+// RequirementRiskHandler handles HTTP requests for the requirement risk report
+type RequirementRiskHandler struct {
+	riskService service.RequirementRiskService
+}
+
+// NewRequirementRiskHandler creates a new requirement risk handler instance
+func NewRequirementRiskHandler(riskService service.RequirementRiskService) *RequirementRiskHandler {
+	return &RequirementRiskHandler{
+		riskService: riskService,
+	}
+}
+
+// GetRiskReport handles GET /api/v1/reports/requirement-risk
+// @Summary Requirement risk report
+// @Description Rank requirements by risk score, highest first, to decide what to review first. The score adds 10 points per "blocks" relationship, 20 per unresolved blocker comment, 15 when no acceptance criteria are linked and 5 per week in Draft, up to 30. Ties go to the higher priority. The same score is available on requirements with include=risk.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Only requirements in this status; Draft and Active by default" Enums(Draft, Active, Obsolete)
+// @Param epic_id query string false "Only requirements of this epic" format(uuid)
+// @Param assignee_id query string false "Only requirements assigned to this user" format(uuid)
+// @Param min_score query int false "Only requirements scoring at least this" minimum(0) default(0)
+// @Param limit query int false "Maximum number of requirements to return (1-200)" minimum(1) maximum(200) default(50)
+// @Success 200 {object} RequirementRiskReportResponse "Requirements ranked by risk"
+// @Failure 400 {object} map[string]interface{} "Invalid status, ID or minimum score"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/requirement-risk [get]
+func (h *RequirementRiskHandler) GetRiskReport(c *gin.Context) {
+	ctx := c.Request.Context()
+	var filter service.RequirementRiskReportFilter
+	if value := c.Query("status"); value != "" {
+		status := models.RequirementStatus(value)
+		filter.Status = &status
+	}
+	for param, target := range map[string]**uuid.UUID{"epic_id": &filter.EpicID, "assignee_id": &filter.AssigneeID} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid " + param + " format",
+				},
+			})
+			return
+		}
+		*target = &id
+	}
+	if value := c.Query("min_score"); value != "" {
+		minScore, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid min_score format",
+				},
+			})
+			return
+		}
+		filter.MinScore = minScore
+	}
+	if limit, err := strconv.Atoi(c.Query("limit")); err == nil && limit > 0 {
+		filter.Limit = limit
+	}
+
+	entries, err := h.riskService.GetRiskReport(ctx, filter, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to get requirement risk report")
+		return
+	}
+
+	SendListResponse(c, entries, int64(len(entries)), len(entries), 0)
+}
@@ -51,6 +51,3 @@ func SendListResponse[T any](c *gin.Context, data []T, totalCount int64, limit,
 	}
 	c.JSON(http.StatusOK, response)
 }
-
-// Note: ErrorResponse and ErrorDetail types are defined in deletion_handler.go
-// We'll use those existing types for consistency
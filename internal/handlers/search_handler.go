@@ -18,6 +18,7 @@ import (
 // SearchServiceInterface defines the interface for search service
 type SearchServiceInterface interface {
 	Search(ctx context.Context, options service.SearchOptions) (*service.SearchResponse, error)
+	Suggest(ctx context.Context, query string, limit int) ([]service.SearchSuggestion, error)
 	InvalidateCache(ctx context.Context) error
 }
 
@@ -29,11 +30,15 @@ type SearchHandler struct {
 
 // SearchSuggestionsResponse represents the response for search suggestions
 type SearchSuggestionsResponse struct {
-	Titles       []string `json:"titles"`
-	ReferenceIDs []string `json:"reference_ids"`
-	Statuses     []string `json:"statuses"`
+	Suggestions  []service.SearchSuggestion `json:"suggestions"`
+	Titles       []string                   `json:"titles"`
+	ReferenceIDs []string                   `json:"reference_ids"`
+	Statuses     []string                   `json:"statuses"`
 }
 
+// maxSuggestionsLimit caps the number of suggestions returned per request
+const maxSuggestionsLimit = 50
+
 // NewSearchHandler creates a new search handler
 func NewSearchHandler(searchService SearchServiceInterface, logger *logrus.Logger) *SearchHandler {
 	return &SearchHandler{
@@ -55,13 +60,13 @@ type SearchRequest struct {
 // Search handles search requests
 //
 //	@Summary		Search across all entities
-//	@Description	Performs full-text search and filtering across epics, user stories, acceptance criteria, and requirements. Supports PostgreSQL full-text search with ranking and comprehensive filtering options. Results are cached for performance. Requires authentication.
+//	@Description	Performs full-text search and filtering across epics, user stories, acceptance criteria, requirements, steering documents, and requirement and relationship type names. Supports PostgreSQL full-text search with ranking and comprehensive filtering options. A search pages through at most SEARCH_MAX_RESULTS results: has_more tells whether another page can be fetched and capped that more results match than the cap. Totals of broad queries are counted or, with SEARCH_COUNT_MODE=estimated, estimated (total_estimated), and are reused for the other pages of the same query. Results are cached for performance. Requires authentication.
 //	@Tags			search
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			query					query		string	false	"Full-text search query. Searches across titles, descriptions, and reference IDs. Supports PostgreSQL text search syntax with automatic prefix matching."	example("user authentication")
-//	@Param			entity_types			query		string	false	"Comma-separated list of entity types to search (epic, user_story, acceptance_criteria, requirement). Defaults to all types if not specified."					example("epic,user_story")
+//	@Param			entity_types			query		string	false	"Comma-separated list of entity types to search (epic, user_story, acceptance_criteria, requirement, steering_document, requirement_type, relationship_type). Defaults to all types if not specified."					example("epic,user_story")
 //	@Param			creator_id				query		string	false	"Filter by creator ID (UUID format)"																																		example("123e4567-e89b-12d3-a456-426614174000")
 //	@Param			assignee_id				query		string	false	"Filter by assignee ID (UUID format)"																																		example("123e4567-e89b-12d3-a456-426614174001")
 //	@Param			priority				query		int		false	"Filter by priority level (1=Critical, 2=High, 3=Medium, 4=Low)"																											example(1)
@@ -73,6 +78,7 @@ type SearchRequest struct {
 //	@Param			acceptance_criteria_id	query		string	false	"Filter by parent acceptance criteria ID (UUID format). Returns requirements within the acceptance criteria."														example("123e4567-e89b-12d3-a456-426614174004")
 //	@Param			requirement_type_id		query		string	false	"Filter by requirement type ID (UUID format). Only applies to requirement entities."																					example("123e4567-e89b-12d3-a456-426614174005")
 //	@Param			author_id				query		string	false	"Filter by author ID (UUID format). Applies to comments and acceptance criteria."																						example("123e4567-e89b-12d3-a456-426614174006")
+//	@Param			include_archived		query		boolean	false	"Also match archived epics and user stories"										default(false)	example(true)
 //	@Param			sort_by					query		string	false	"Sort by field: priority, created_at, updated_at, title, relevance (relevance only available with query)"														default(created_at)	example("priority")
 //	@Param			sort_order				query		string	false	"Sort order: asc (ascending) or desc (descending)"																													default(desc)			example("asc")
 //	@Param			limit					query		int		false	"Maximum number of results to return (1-100)"																															default(50)				example(20)
@@ -123,6 +129,7 @@ func (h *SearchHandler) Search(c *gin.Context) {
 	logger.WithFields(logrus.Fields{
 		"total_results": response.Total,
 		"returned":      len(response.Results),
+		"has_more":      response.HasMore,
 	}).Info("Search completed successfully")
 
 	c.JSON(http.StatusOK, response)
@@ -269,6 +276,8 @@ func (h *SearchHandler) parseSearchOptions(c *gin.Context) (service.SearchOption
 		filters.CreatedTo = &createdTo
 	}
 
+	filters.IncludeArchived = c.Query("include_archived") == "true"
+
 	options.Filters = filters
 	return options, nil
 }
@@ -276,18 +285,18 @@ func (h *SearchHandler) parseSearchOptions(c *gin.Context) (service.SearchOption
 // SearchSuggestions handles search suggestion requests
 //
 //	@Summary		Get search suggestions
-//	@Description	Provides search suggestions based on partial query input. Returns matching titles, reference IDs, and available status values to help users construct effective search queries. Requires authentication.
+//	@Description	Provides search suggestions based on partial query input. Titles and reference IDs are matched case-insensitively by prefix, by word with up to one typo (for example "authentcation" or "EP-010" for "EP-001"), and by trigram similarity. Returns ranked suggestions with entity type and status, the matching titles and reference IDs, and available status values to help users construct effective search queries. Requires authentication.
 //	@Tags			search
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			query	query		string	true	"Partial search query for generating suggestions. Minimum 2 characters recommended."	example("auth")
 //	@Param			limit	query		int		false	"Maximum number of suggestions per category (1-50)"										default(10)	example(5)
-//	@Success		200		{object}	SearchSuggestionsResponse	"Search suggestions grouped by category"
+//	@Success		200		{object}	SearchSuggestionsResponse	"Ranked suggestions, with titles and reference IDs grouped by category"
 //	@Failure		400		{object}	ErrorResponse				"Invalid parameters (missing query, invalid limit)"
 //	@Failure		401		{object}	ErrorResponse				"Authentication required"
 //	@Failure		500		{object}	ErrorResponse				"Internal server error during suggestion generation"
-//	@Router			/api/search/suggestions [get]
+//	@Router			/api/v1/search/suggestions [get]
 func (h *SearchHandler) SearchSuggestions(c *gin.Context) {
 	correlationID, _ := c.Get("correlation_id")
 	logger := h.logger.WithField("correlation_id", correlationID)
@@ -309,19 +318,41 @@ func (h *SearchHandler) SearchSuggestions(c *gin.Context) {
 			limit = parsedLimit
 		}
 	}
+	if limit > maxSuggestionsLimit {
+		limit = maxSuggestionsLimit
+	}
 
 	logger.WithFields(logrus.Fields{
 		"query": query,
 		"limit": limit,
 	}).Info("Getting search suggestions")
 
-	// For now, return empty suggestions
-	// In a full implementation, this would query the database for matching titles/reference IDs
-	suggestions := map[string][]string{
-		"titles":        []string{},
-		"reference_ids": []string{},
-		"statuses":      []string{"Backlog", "Draft", "In Progress", "Done", "Cancelled", "Active", "Obsolete"},
+	suggestions, err := h.searchService.Suggest(c.Request.Context(), query, limit)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get search suggestions")
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "SUGGESTIONS_FAILED",
+				Message: "Failed to get search suggestions",
+			},
+		})
+		return
+	}
+
+	response := SearchSuggestionsResponse{
+		Suggestions:  suggestions,
+		Titles:       []string{},
+		ReferenceIDs: []string{},
+		Statuses:     []string{"Backlog", "Draft", "In Progress", "Done", "Cancelled", "Active", "Obsolete"},
+	}
+	seenTitles := make(map[string]bool)
+	for _, suggestion := range suggestions {
+		response.ReferenceIDs = append(response.ReferenceIDs, suggestion.ReferenceID)
+		if suggestion.MatchedOn == "title" && !seenTitles[suggestion.Title] {
+			seenTitles[suggestion.Title] = true
+			response.Titles = append(response.Titles, suggestion.Title)
+		}
 	}
 
-	c.JSON(http.StatusOK, suggestions)
+	c.JSON(http.StatusOK, response)
 }
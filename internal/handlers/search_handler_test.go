@@ -33,6 +33,11 @@ func (m *MockSearchService) SearchByReferenceID(ctx context.Context, referenceID
 	return args.Get(0).(*service.SearchResponse), args.Error(1)
 }
 
+func (m *MockSearchService) Suggest(ctx context.Context, query string, limit int) ([]service.SearchSuggestion, error) {
+	args := m.Called(ctx, query, limit)
+	return args.Get(0).([]service.SearchSuggestion), args.Error(1)
+}
+
 func (m *MockSearchService) InvalidateCache(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -332,25 +337,34 @@ func TestSearchHandler_SearchSuggestions_Success(t *testing.T) {
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
 
-	req := httptest.NewRequest("GET", "/api/search/suggestions?query=test", nil)
+	req := httptest.NewRequest("GET", "/api/v1/search/suggestions?query=test&limit=100", nil)
 	c.Request = req
 
 	// Set correlation ID
 	c.Set("correlation_id", "test-correlation-id")
 
+	suggestions := []service.SearchSuggestion{
+		{EntityType: "epic", ID: uuid.New(), ReferenceID: "EP-001", Title: "Test Epic", Status: "Backlog", MatchedOn: "title", Match: "prefix", Score: 0.85},
+		{EntityType: "requirement", ID: uuid.New(), ReferenceID: "REQ-007", Title: "Tset coverage", Status: "Draft", MatchedOn: "title", Match: "typo", Score: 0.6},
+	}
+	mockService.On("Suggest", mock.Anything, "test", 50).Return(suggestions, nil)
+
 	// Execute
 	handler.SearchSuggestions(c)
 
 	// Assert
 	assert.Equal(t, http.StatusOK, w.Code)
 
-	var response map[string][]string
+	var response SearchSuggestionsResponse
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
-	assert.Contains(t, response, "titles")
-	assert.Contains(t, response, "reference_ids")
-	assert.Contains(t, response, "statuses")
-	assert.Contains(t, response["statuses"], "Backlog")
+	assert.Len(t, response.Suggestions, 2)
+	assert.Equal(t, "epic", response.Suggestions[0].EntityType)
+	assert.Equal(t, []string{"Test Epic", "Tset coverage"}, response.Titles)
+	assert.Equal(t, []string{"EP-001", "REQ-007"}, response.ReferenceIDs)
+	assert.Contains(t, response.Statuses, "Backlog")
+
+	mockService.AssertExpectations(t)
 }
 
 func TestSearchHandler_SearchSuggestions_MissingQuery(t *testing.T) {
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// SearchIndexHandler handles HTTP requests for the maintenance of the full-text search indexes
+type SearchIndexHandler struct {
+	searchIndexService service.SearchIndexService
+}
+
+// NewSearchIndexHandler creates a new search index handler instance
+func NewSearchIndexHandler(searchIndexService service.SearchIndexService) *SearchIndexHandler {
+	return &SearchIndexHandler{
+		searchIndexService: searchIndexService,
+	}
+}
+
+// StartReindex handles POST /api/v1/admin/search-index/reindex
+// @Summary Rebuild the search indexes
+// @Description Start rebuilding the full-text search indexes in the background, one index at a time without blocking writes, and refresh the statistics of their tables. Run it after bulk imports or migrations that bypass the application. Follow the progress with GET /api/v1/admin/search-index/reindex.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param tables query string false "Comma-separated tables whose search indexes are rebuilt (default all)" example(requirements,user_stories)
+// @Param throttle_ms query int false "Pause between two index rebuilds in milliseconds (default 500, max 60000)"
+// @Success 202 {object} service.ReindexRun "Reindex started"
+// @Failure 400 {object} ErrorResponse "Invalid query parameter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 409 {object} ErrorResponse "A reindex is already running"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/admin/search-index/reindex [post]
+func (h *SearchIndexHandler) StartReindex(c *gin.Context) {
+	ctx := c.Request.Context()
+	options := service.ReindexOptions{Throttle: service.DefaultReindexThrottle}
+	if raw := c.Query("tables"); raw != "" {
+		for _, table := range strings.Split(raw, ",") {
+			if table = strings.TrimSpace(table); table != "" {
+				options.Tables = append(options.Tables, table)
+			}
+		}
+	}
+	if raw := c.Query("throttle_ms"); raw != "" {
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid throttle_ms parameter",
+			}})
+			return
+		}
+		options.Throttle = time.Duration(value) * time.Millisecond
+	}
+
+	run, err := h.searchIndexService.StartReindex(ctx, options)
+	if err != nil {
+		respondWithError(c, err, "Failed to start search reindex")
+		return
+	}
+	c.JSON(http.StatusAccepted, run)
+}
+
+// GetReindexStatus handles GET /api/v1/admin/search-index/reindex
+// @Summary Get search reindex progress
+// @Description Retrieve the progress of the running search reindex, or the result of the most recent one since startup
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.ReindexRun "Reindex progress"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} ErrorResponse "No reindex has been started"
+// @Router /api/v1/admin/search-index/reindex [get]
+func (h *SearchIndexHandler) GetReindexStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+	run, err := h.searchIndexService.ReindexStatus(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to get search reindex progress")
+		return
+	}
+	c.JSON(http.StatusOK, run)
+}
+
+// GetHealth handles GET /api/v1/admin/search-index/health
+// @Summary Get search index health
+// @Description Report whether every full-text search index is valid, how many documents are not yet merged into the indexes and how many rows changed since the statistics of their tables were refreshed. Pending documents are only reported when the pgstattuple extension is installed. Responds with 503 when an index is missing or invalid, so the endpoint can serve as a probe.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.SearchIndexHealth "Search indexes are healthy or degraded"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Failure 503 {object} service.SearchIndexHealth "Search indexes are unhealthy"
+// @Router /api/v1/admin/search-index/health [get]
+func (h *SearchIndexHandler) GetHealth(c *gin.Context) {
+	health, err := h.searchIndexService.Health(c.Request.Context())
+	if err != nil {
+		respondWithError(c, err, "Failed to check search index health")
+		return
+	}
+
+	status := http.StatusOK
+	if health.Status == service.SearchIndexUnhealthy {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, health)
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/service"
+)
+
+// stubSearchIndexService records the options of started reindexes
+type stubSearchIndexService struct {
+	service.SearchIndexService
+	started []service.ReindexOptions
+	err     error
+	health  *service.SearchIndexHealth
+}
+
+func (s *stubSearchIndexService) StartReindex(ctx context.Context, options service.ReindexOptions) (*service.ReindexRun, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.started = append(s.started, options)
+	return &service.ReindexRun{Status: service.ReindexStatusRunning, ThrottleMs: options.Throttle.Milliseconds()}, nil
+}
+
+func (s *stubSearchIndexService) Health(_ context.Context) (*service.SearchIndexHealth, error) {
+	return s.health, nil
+}
+
+func setupSearchIndexRouter(svc service.SearchIndexService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := NewSearchIndexHandler(svc)
+	router.POST("/api/v1/admin/search-index/reindex", handler.StartReindex)
+	router.GET("/api/v1/admin/search-index/health", handler.GetHealth)
+	return router
+}
+
+func TestSearchIndexHandler_StartReindex(t *testing.T) {
+	svc := &stubSearchIndexService{}
+	router := setupSearchIndexRouter(svc)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/search-index/reindex?tables=requirements,%20epics&throttle_ms=250", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	require.Len(t, svc.started, 1)
+	assert.Equal(t, []string{"requirements", "epics"}, svc.started[0].Tables)
+	assert.Equal(t, 250*time.Millisecond, svc.started[0].Throttle)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/api/v1/admin/search-index/reindex", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, service.DefaultReindexThrottle, svc.started[1].Throttle)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/api/v1/admin/search-index/reindex?throttle_ms=soon", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	svc.err = service.ErrReindexInProgress
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/api/v1/admin/search-index/reindex", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	var response ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "REINDEX_IN_PROGRESS", response.Error.Code)
+}
+
+func TestSearchIndexHandler_GetHealth(t *testing.T) {
+	svc := &stubSearchIndexService{health: &service.SearchIndexHealth{Status: service.SearchIndexDegraded}}
+	router := setupSearchIndexRouter(svc)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/search-index/health", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code, "degraded indexes still serve searches")
+
+	svc.health.Status = service.SearchIndexUnhealthy
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/api/v1/admin/search-index/health", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// SignOffListResponse represents the response for listing the sign-off log
+type SignOffListResponse = ListResponse[models.SignOff]
+
+// UnsignedRequirementsResponse represents the response of the unsigned requirements report
+type UnsignedRequirementsResponse = ListResponse[service.UnsignedRequirement]
+
+// SignOffHandler handles HTTP requests for stakeholder sign-offs of requirements and user stories
+type SignOffHandler struct {
+	signOffService service.SignOffService
+}
+
+// NewSignOffHandler creates a new sign-off handler instance
+func NewSignOffHandler(signOffService service.SignOffService) *SignOffHandler {
+	return &SignOffHandler{
+		signOffService: signOffService,
+	}
+}
+
+// AddStakeholder handles POST /api/v1/admin/sign-off-stakeholders
+// @Summary Designate a sign-off stakeholder
+// @Description Allow a user to formally sign off requirements and user stories in a role, such as Product Owner or Compliance Officer. A user can be designated in several roles.
+// @Tags sign-offs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param stakeholder body service.AddSignOffStakeholderRequest true "User and role"
+// @Success 201 {object} models.SignOffStakeholder "Created designation"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or role"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "User not found"
+// @Failure 409 {object} map[string]interface{} "User is already designated in this role"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/sign-off-stakeholders [post]
+func (h *SignOffHandler) AddStakeholder(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.AddSignOffStakeholderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	stakeholder, err := h.signOffService.AddStakeholder(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to designate sign-off stakeholder")
+		return
+	}
+
+	c.JSON(http.StatusCreated, stakeholder)
+}
+
+// ListStakeholders handles GET /api/v1/admin/sign-off-stakeholders
+// @Summary List sign-off stakeholders
+// @Description Retrieve the users designated to sign off and their roles, ordered by role
+// @Tags sign-offs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query string false "Only the designations of this user" format(uuid)
+// @Success 200 {object} map[string]interface{} "Designations"
+// @Failure 400 {object} map[string]interface{} "Invalid user ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/sign-off-stakeholders [get]
+func (h *SignOffHandler) ListStakeholders(c *gin.Context) {
+	ctx := c.Request.Context()
+	var userID *uuid.UUID
+	if value := c.Query("user_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid user_id format",
+				},
+			})
+			return
+		}
+		userID = &id
+	}
+
+	stakeholders, err := h.signOffService.ListStakeholders(ctx, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to list sign-off stakeholders")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"stakeholders": stakeholders,
+		"count":        len(stakeholders),
+	})
+}
+
+// RemoveStakeholder handles DELETE /api/v1/admin/sign-off-stakeholders/:id
+// @Summary Revoke a sign-off stakeholder designation
+// @Description Stop a user from signing off in a role. The sign-offs the user made in the role stay in the log.
+// @Tags sign-offs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Designation UUID" format(uuid)
+// @Success 204 "Designation revoked"
+// @Failure 400 {object} map[string]interface{} "Invalid designation ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Designation not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/sign-off-stakeholders/{id} [delete]
+func (h *SignOffHandler) RemoveStakeholder(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid designation ID format",
+			},
+		})
+		return
+	}
+
+	if err := h.signOffService.RemoveStakeholder(ctx, id); err != nil {
+		respondWithError(c, err, "Failed to revoke sign-off stakeholder")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SignOff handles POST /api/v1/{entityType}/:id/sign-offs
+// @Summary Sign off a requirement or user story
+// @Description Formally accept the current version of a requirement or user story in a role the caller is designated in. The sign-off records the version, the signer, the role and an optional digital signature hash, and cannot be changed or removed. Pass the reviewed version to refuse the sign-off when the text changed in the meantime.
+// @Tags sign-offs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param sign_off body service.SignOffRequest true "Sign-off details"
+// @Success 201 {object} models.SignOff "Recorded sign-off"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, role or signature hash"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Caller is not designated in the role"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 409 {object} map[string]interface{} "Entity changed since the reviewed version, or the version was already signed off in the role"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/user-stories/{id}/sign-offs [post]
+// @Router /api/v1/requirements/{id}/sign-offs [post]
+func (h *SignOffHandler) SignOff(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.SignOffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	signOff, err := h.signOffService.SignOff(ctx, entityType, c.Param("id"), userID, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to sign off")
+		return
+	}
+
+	c.JSON(http.StatusCreated, signOff)
+}
+
+// ListSignOffs handles GET /api/v1/{entityType}/:id/sign-offs
+// @Summary List the sign-offs of a requirement or user story
+// @Description Retrieve the sign-offs of a requirement or user story, oldest first, with the signers
+// @Tags sign-offs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} map[string]interface{} "Sign-offs of the entity"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/user-stories/{id}/sign-offs [get]
+// @Router /api/v1/requirements/{id}/sign-offs [get]
+func (h *SignOffHandler) ListSignOffs(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	signOffs, err := h.signOffService.ListSignOffs(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to list sign-offs")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sign_offs": signOffs,
+		"count":     len(signOffs),
+	})
+}
+
+// ListLog handles GET /api/v1/sign-offs
+// @Summary Sign-off log
+// @Description Retrieve the sign-offs of all requirements and user stories, newest first, for audits. Sign-offs of deleted entities are kept.
+// @Tags sign-offs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type query string false "Only sign-offs of this entity type" Enums(requirement, user_story)
+// @Param signer_id query string false "Only sign-offs by this stakeholder" format(uuid)
+// @Param from query string false "Only sign-offs at or after this time (RFC3339)" format(date-time)
+// @Param to query string false "Only sign-offs before this time (RFC3339)" format(date-time)
+// @Param limit query int false "Maximum number of sign-offs to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of sign-offs to skip" minimum(0) default(0)
+// @Success 200 {object} SignOffListResponse "Sign-offs"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type, signer ID or time"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/sign-offs [get]
+func (h *SignOffHandler) ListLog(c *gin.Context) {
+	ctx := c.Request.Context()
+	filter := repository.SignOffFilter{Limit: 50}
+	if value := c.Query("entity_type"); value != "" {
+		entityType := models.EntityType(value)
+		filter.EntityType = &entityType
+	}
+	if value := c.Query("signer_id"); value != "" {
+		signerID, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid signer_id format",
+				},
+			})
+			return
+		}
+		filter.SignerID = &signerID
+	}
+	for param, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid " + param + " format, expected RFC3339",
+				},
+			})
+			return
+		}
+		*target = &parsed
+	}
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		filter.Limit = l
+	}
+	if o, err := strconv.Atoi(c.Query("offset")); err == nil && o >= 0 {
+		filter.Offset = o
+	}
+
+	signOffs, total, err := h.signOffService.ListLog(ctx, filter)
+	if err != nil {
+		respondWithError(c, err, "Failed to list sign-offs")
+		return
+	}
+
+	SendListResponse(c, signOffs, total, filter.Limit, filter.Offset)
+}
+
+// GetUnsignedReport handles GET /api/v1/reports/unsigned-requirements
+// @Summary Unsigned requirements report
+// @Description List the Active requirements that were never signed off or were changed since their latest sign-off, ordered by reference ID, for audits
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param epic_id query string false "Only requirements of this epic" format(uuid)
+// @Success 200 {object} UnsignedRequirementsResponse "Unsigned requirements"
+// @Failure 400 {object} map[string]interface{} "Invalid epic ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/unsigned-requirements [get]
+func (h *SignOffHandler) GetUnsignedReport(c *gin.Context) {
+	ctx := c.Request.Context()
+	var epicID *uuid.UUID
+	if value := c.Query("epic_id"); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid epic_id format",
+				},
+			})
+			return
+		}
+		epicID = &id
+	}
+
+	entries, err := h.signOffService.GetUnsignedReport(ctx, epicID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get unsigned requirements report")
+		return
+	}
+
+	SendListResponse(c, entries, int64(len(entries)), len(entries), 0)
+}
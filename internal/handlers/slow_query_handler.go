@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/database"
+)
+
+// SlowQueryReporter exposes the recorded slow queries
+type SlowQueryReporter interface {
+	Report() database.SlowQueryReport
+	Reset()
+}
+
+// SlowQueryHandler handles HTTP requests for the slow query report
+type SlowQueryHandler struct {
+	reporter SlowQueryReporter
+}
+
+// NewSlowQueryHandler creates a new slow query handler instance; a nil reporter means slow query logging is disabled
+func NewSlowQueryHandler(reporter SlowQueryReporter) *SlowQueryHandler {
+	return &SlowQueryHandler{
+		reporter: reporter,
+	}
+}
+
+// GetSlowQueries handles GET /api/v1/admin/slow-queries
+// @Summary Get slow query report
+// @Description Retrieve the most recent database queries slower than DB_SLOW_QUERY_THRESHOLD_MS, newest first, with the handler, service and repository that issued them. Queries are also grouped by SQL and handler, slowest in total first. Counters cover all queries since startup or the last reset.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} database.SlowQueryReport "Slow query report"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Slow query logging is disabled"
+// @Router /api/v1/admin/slow-queries [get]
+func (h *SlowQueryHandler) GetSlowQueries(c *gin.Context) {
+	if !h.enabled(c) {
+		return
+	}
+	c.JSON(http.StatusOK, h.reporter.Report())
+}
+
+// ResetSlowQueries handles DELETE /api/v1/admin/slow-queries
+// @Summary Reset slow query report
+// @Description Clear the recorded slow queries and query counters
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 204 "Slow query report cleared"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Slow query logging is disabled"
+// @Router /api/v1/admin/slow-queries [delete]
+func (h *SlowQueryHandler) ResetSlowQueries(c *gin.Context) {
+	if !h.enabled(c) {
+		return
+	}
+	h.reporter.Reset()
+	c.Status(http.StatusNoContent)
+}
+
+// enabled writes a not found response when slow query logging is disabled
+func (h *SlowQueryHandler) enabled(c *gin.Context) bool {
+	if h.reporter != nil {
+		return true
+	}
+	c.JSON(http.StatusNotFound, gin.H{
+		"error": gin.H{
+			"code":    "SLOW_QUERY_LOG_DISABLED",
+			"message": "Slow query logging is disabled; set DB_SLOW_QUERY_THRESHOLD_MS to enable it",
+		},
+	})
+	return false
+}
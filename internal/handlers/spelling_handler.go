@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// SpellingHandler handles HTTP requests for the spelling and terminology consistency report
+type SpellingHandler struct {
+	spellingService service.SpellingService
+}
+
+// NewSpellingHandler creates a new spelling handler instance
+func NewSpellingHandler(spellingService service.SpellingService) *SpellingHandler {
+	return &SpellingHandler{
+		spellingService: spellingService,
+	}
+}
+
+// GetReport handles GET /api/v1/reports/spelling
+// @Summary Spelling and terminology report
+// @Description Misspelled words and terms written in several ways (such as "log in", "log-in" and "login") in the titles and descriptions of each epic and its user stories, acceptance criteria and requirements. Words are checked against the configured dictionary and the glossary terms; glossary terms are also the preferred way to write a term. The report is refreshed by the spelling job, so recent edits show up after its next run.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.SpellingReport "Spelling report"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/spelling [get]
+func (h *SpellingHandler) GetReport(c *gin.Context) {
+	ctx := c.Request.Context()
+	report, err := h.spellingService.GetReport(ctx, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to build spelling report")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// RunAnalysis handles POST /api/v1/admin/spelling-report/run
+// @Summary Refresh the spelling report now
+// @Description Run the spelling job immediately instead of waiting for the scheduler and return the refreshed report
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.SpellingReport "Refreshed spelling report"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/spelling-report/run [post]
+func (h *SpellingHandler) RunAnalysis(c *gin.Context) {
+	ctx := c.Request.Context()
+	report, err := h.spellingService.Analyze(ctx, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to analyze spelling")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// StalenessPolicyListResponse represents the response for listing staleness policies
+type StalenessPolicyListResponse = ListResponse[models.StalenessPolicy]
+
+// StaleReportResponse represents the response of the stale report
+type StaleReportResponse = ListResponse[service.StaleReportEntry]
+
+// StalenessHandler handles HTTP requests for staleness policies and the stale report
+type StalenessHandler struct {
+	stalenessService service.StalenessService
+}
+
+// NewStalenessHandler creates a new staleness handler instance
+func NewStalenessHandler(stalenessService service.StalenessService) *StalenessHandler {
+	return &StalenessHandler{
+		stalenessService: stalenessService,
+	}
+}
+
+// CreatePolicy handles POST /api/v1/admin/staleness-policies
+// @Summary Create a staleness policy
+// @Description Add a policy such as "requirements Active with no update for 14 days". The staleness job marks matching entities as stale and emails their assignees once; entities still stale escalate_after_days after they were marked are reported to the escalation user. Marks are removed once an entity is updated or leaves the status.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param policy body service.StalenessPolicyRequest true "Staleness policy"
+// @Success 201 {object} models.StalenessPolicy "Created staleness policy"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, status or escalation"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Escalation user not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/staleness-policies [post]
+func (h *StalenessHandler) CreatePolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.StalenessPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	policy, err := h.stalenessService.CreatePolicy(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to create staleness policy")
+		return
+	}
+
+	c.JSON(http.StatusCreated, policy)
+}
+
+// ListPolicies handles GET /api/v1/admin/staleness-policies
+// @Summary List staleness policies
+// @Description Retrieve every staleness policy ordered by name
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} StalenessPolicyListResponse "Staleness policies"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/staleness-policies [get]
+func (h *StalenessHandler) ListPolicies(c *gin.Context) {
+	ctx := c.Request.Context()
+	policies, err := h.stalenessService.ListPolicies(ctx)
+	if err != nil {
+		respondWithError(c, err, "Failed to list staleness policies")
+		return
+	}
+
+	SendListResponse(c, policies, int64(len(policies)), len(policies), 0)
+}
+
+// GetPolicy handles GET /api/v1/admin/staleness-policies/:id
+// @Summary Get a staleness policy
+// @Description Retrieve a staleness policy by its UUID
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Staleness policy UUID" format(uuid)
+// @Success 200 {object} models.StalenessPolicy "Staleness policy"
+// @Failure 400 {object} map[string]interface{} "Invalid policy ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Policy not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/staleness-policies/{id} [get]
+func (h *StalenessHandler) GetPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parsePolicyID(c)
+	if !ok {
+		return
+	}
+
+	policy, err := h.stalenessService.GetPolicy(ctx, id)
+	if err != nil {
+		respondWithError(c, err, "Failed to get staleness policy")
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// UpdatePolicy handles PUT /api/v1/admin/staleness-policies/:id
+// @Summary Replace a staleness policy
+// @Description Replace the conditions and escalation of a staleness policy; entities that no longer match lose their stale mark on the next run
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Staleness policy UUID" format(uuid)
+// @Param policy body service.StalenessPolicyRequest true "Staleness policy"
+// @Success 200 {object} models.StalenessPolicy "Updated staleness policy"
+// @Failure 400 {object} map[string]interface{} "Invalid policy ID, request body, status or escalation"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Policy or escalation user not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/staleness-policies/{id} [put]
+func (h *StalenessHandler) UpdatePolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parsePolicyID(c)
+	if !ok {
+		return
+	}
+
+	var req service.StalenessPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	policy, err := h.stalenessService.UpdatePolicy(ctx, id, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to update staleness policy")
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// DeletePolicy handles DELETE /api/v1/admin/staleness-policies/:id
+// @Summary Delete a staleness policy
+// @Description Remove a staleness policy and the stale marks it set
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Staleness policy UUID" format(uuid)
+// @Success 204 "Policy deleted"
+// @Failure 400 {object} map[string]interface{} "Invalid policy ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Policy not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/staleness-policies/{id} [delete]
+func (h *StalenessHandler) DeletePolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := h.parsePolicyID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.stalenessService.DeletePolicy(ctx, id); err != nil {
+		respondWithError(c, err, "Failed to delete staleness policy")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RunPolicies handles POST /api/v1/admin/staleness-policies/run
+// @Summary Evaluate the staleness policies now
+// @Description Run the staleness job immediately instead of waiting for the scheduler: mark stale entities, remove outdated marks, email assignees and send due escalations
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} service.StalenessRunSummary "Outcome of the run"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/staleness-policies/run [post]
+func (h *StalenessHandler) RunPolicies(c *gin.Context) {
+	ctx := c.Request.Context()
+	summary, err := h.stalenessService.EvaluatePolicies(ctx, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to evaluate staleness policies")
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// GetStaleReport handles GET /api/v1/reports/stale
+// @Summary Stale entities report
+// @Description List the entities currently marked stale by the staleness policies, least recently updated first. Marks are refreshed by the staleness job, so an entity updated since the last run is listed until the next one.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param entity_type query string false "Only entities of this type" Enums(epic, user_story, requirement)
+// @Param policy_id query string false "Only entities marked by this policy" format(uuid)
+// @Param assignee_id query string false "Only entities assigned to this user" format(uuid)
+// @Success 200 {object} StaleReportResponse "Stale entities"
+// @Failure 400 {object} map[string]interface{} "Invalid entity type or ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/stale [get]
+func (h *StalenessHandler) GetStaleReport(c *gin.Context) {
+	ctx := c.Request.Context()
+	var filter repository.StaleReportFilter
+	if value := c.Query("entity_type"); value != "" {
+		entityType := models.EntityType(value)
+		filter.EntityType = &entityType
+	}
+	for param, target := range map[string]**uuid.UUID{"policy_id": &filter.PolicyID, "assignee_id": &filter.AssigneeID} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid " + param + " format",
+				},
+			})
+			return
+		}
+		*target = &id
+	}
+
+	entries, err := h.stalenessService.GetStaleReport(ctx, filter, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to get stale report")
+		return
+	}
+
+	SendListResponse(c, entries, int64(len(entries)), len(entries), 0)
+}
+
+// parsePolicyID parses the policy ID path parameter, writing a 400 response if it is invalid
+func (h *StalenessHandler) parsePolicyID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid staleness policy ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// StatisticsHandler handles HTTP requests for workspace usage statistics
+type StatisticsHandler struct {
+	statisticsService service.StatisticsService
+}
+
+// NewStatisticsHandler creates a new statistics handler instance
+func NewStatisticsHandler(statisticsService service.StatisticsService) *StatisticsHandler {
+	return &StatisticsHandler{
+		statisticsService: statisticsService,
+	}
+}
+
+// GetStatistics handles GET /api/v1/admin/statistics
+// @Summary Get workspace statistics
+// @Description Retrieve total counts per entity type, entities created per time bucket with running totals, comments per day, the most active users by audited changes and the epics with the most user stories and requirements. Results are cached for five minutes; pass refresh=true to recompute them.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param interval query string false "Growth bucket size" Enums(day, week, month) default(week)
+// @Param periods query int false "Number of growth buckets ending with the current one (default 12, max 100)"
+// @Param days query int false "Days covered by comments per day and top contributors (default 30, max 365)"
+// @Param top query int false "Number of top contributors and largest epics (default 10, max 50)"
+// @Param refresh query bool false "Bypass the cache"
+// @Success 200 {object} service.Statistics "Workspace statistics"
+// @Failure 400 {object} map[string]interface{} "Invalid query parameter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/statistics [get]
+func (h *StatisticsHandler) GetStatistics(c *gin.Context) {
+	ctx := c.Request.Context()
+	query := service.StatisticsQuery{
+		Interval: c.Query("interval"),
+		Refresh:  c.Query("refresh") == "true",
+	}
+	for param, target := range map[string]*int{
+		"periods": &query.Periods,
+		"days":    &query.Days,
+		"top":     &query.Top,
+	} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil || value < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid " + param + " parameter",
+				},
+			})
+			return
+		}
+		*target = value
+	}
+
+	stats, err := h.statisticsService.GetStatistics(ctx, query)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidStatisticsInterval) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": err.Error(),
+				},
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to get statistics",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -21,168 +22,173 @@ type MockConfigServiceForStatusModel struct {
 	mock.Mock
 }
 
-func (m *MockConfigServiceForStatusModel) CreateStatusModel(req service.CreateStatusModelRequest) (*models.StatusModel, error) {
+func (m *MockConfigServiceForStatusModel) CreateStatusModel(ctx context.Context, req service.CreateStatusModelRequest) (*models.StatusModel, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetStatusModelByID(id uuid.UUID) (*models.StatusModel, error) {
+func (m *MockConfigServiceForStatusModel) GetStatusModelByID(ctx context.Context, id uuid.UUID) (*models.StatusModel, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetStatusModelByEntityTypeAndName(entityType models.EntityType, name string) (*models.StatusModel, error) {
+func (m *MockConfigServiceForStatusModel) GetStatusModelByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (*models.StatusModel, error) {
 	args := m.Called(entityType, name)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetDefaultStatusModelByEntityType(entityType models.EntityType) (*models.StatusModel, error) {
+func (m *MockConfigServiceForStatusModel) GetDefaultStatusModelByEntityType(ctx context.Context, entityType models.EntityType) (*models.StatusModel, error) {
 	args := m.Called(entityType)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) UpdateStatusModel(id uuid.UUID, req service.UpdateStatusModelRequest) (*models.StatusModel, error) {
+func (m *MockConfigServiceForStatusModel) UpdateStatusModel(ctx context.Context, id uuid.UUID, req service.UpdateStatusModelRequest) (*models.StatusModel, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) DeleteStatusModel(id uuid.UUID, force bool) error {
+func (m *MockConfigServiceForStatusModel) DeleteStatusModel(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigServiceForStatusModel) ListStatusModels(filters service.StatusModelFilters) ([]models.StatusModel, int64, error) {
+func (m *MockConfigServiceForStatusModel) ListStatusModels(ctx context.Context, filters service.StatusModelFilters) ([]models.StatusModel, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.StatusModel), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigServiceForStatusModel) ListStatusModelsByEntityType(entityType models.EntityType) ([]models.StatusModel, error) {
+func (m *MockConfigServiceForStatusModel) ListStatusModelsByEntityType(ctx context.Context, entityType models.EntityType) ([]models.StatusModel, error) {
 	args := m.Called(entityType)
 	return args.Get(0).([]models.StatusModel), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) CreateStatus(req service.CreateStatusRequest) (*models.Status, error) {
+func (m *MockConfigServiceForStatusModel) CreateStatus(ctx context.Context, req service.CreateStatusRequest) (*models.Status, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetStatusByID(id uuid.UUID) (*models.Status, error) {
+func (m *MockConfigServiceForStatusModel) GetStatusByID(ctx context.Context, id uuid.UUID) (*models.Status, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) UpdateStatus(id uuid.UUID, req service.UpdateStatusRequest) (*models.Status, error) {
+func (m *MockConfigServiceForStatusModel) UpdateStatus(ctx context.Context, id uuid.UUID, req service.UpdateStatusRequest) (*models.Status, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) DeleteStatus(id uuid.UUID, force bool) error {
+func (m *MockConfigServiceForStatusModel) DeleteStatus(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigServiceForStatusModel) ListStatusesByModel(statusModelID uuid.UUID) ([]models.Status, int64, error) {
+func (m *MockConfigServiceForStatusModel) ListStatusesByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.Status, int64, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).([]models.Status), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigServiceForStatusModel) CreateStatusTransition(req service.CreateStatusTransitionRequest) (*models.StatusTransition, error) {
+func (m *MockConfigServiceForStatusModel) ReorderStatuses(ctx context.Context, statusModelID uuid.UUID, statusIDs []uuid.UUID) ([]models.Status, error) {
+	args := m.Called(statusModelID, statusIDs)
+	return args.Get(0).([]models.Status), args.Error(1)
+}
+
+func (m *MockConfigServiceForStatusModel) CreateStatusTransition(ctx context.Context, req service.CreateStatusTransitionRequest) (*models.StatusTransition, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.StatusTransition), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetStatusTransitionByID(id uuid.UUID) (*models.StatusTransition, error) {
+func (m *MockConfigServiceForStatusModel) GetStatusTransitionByID(ctx context.Context, id uuid.UUID) (*models.StatusTransition, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.StatusTransition), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) UpdateStatusTransition(id uuid.UUID, req service.UpdateStatusTransitionRequest) (*models.StatusTransition, error) {
+func (m *MockConfigServiceForStatusModel) UpdateStatusTransition(ctx context.Context, id uuid.UUID, req service.UpdateStatusTransitionRequest) (*models.StatusTransition, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.StatusTransition), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) DeleteStatusTransition(id uuid.UUID) error {
+func (m *MockConfigServiceForStatusModel) DeleteStatusTransition(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockConfigServiceForStatusModel) ListStatusTransitionsByModel(statusModelID uuid.UUID) ([]models.StatusTransition, int64, error) {
+func (m *MockConfigServiceForStatusModel) ListStatusTransitionsByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.StatusTransition, int64, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).([]models.StatusTransition), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigServiceForStatusModel) ValidateStatusTransition(entityType models.EntityType, fromStatus, toStatus string) error {
+func (m *MockConfigServiceForStatusModel) ValidateStatusTransition(ctx context.Context, entityType models.EntityType, fromStatus, toStatus string) error {
 	args := m.Called(entityType, fromStatus, toStatus)
 	return args.Error(0)
 }
 
 // Add the missing methods from ConfigService interface
-func (m *MockConfigServiceForStatusModel) CreateRequirementType(req service.CreateRequirementTypeRequest) (*models.RequirementType, error) {
+func (m *MockConfigServiceForStatusModel) CreateRequirementType(ctx context.Context, req service.CreateRequirementTypeRequest) (*models.RequirementType, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetRequirementTypeByID(id uuid.UUID) (*models.RequirementType, error) {
+func (m *MockConfigServiceForStatusModel) GetRequirementTypeByID(ctx context.Context, id uuid.UUID) (*models.RequirementType, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetRequirementTypeByName(name string) (*models.RequirementType, error) {
+func (m *MockConfigServiceForStatusModel) GetRequirementTypeByName(ctx context.Context, name string) (*models.RequirementType, error) {
 	args := m.Called(name)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) UpdateRequirementType(id uuid.UUID, req service.UpdateRequirementTypeRequest) (*models.RequirementType, error) {
+func (m *MockConfigServiceForStatusModel) UpdateRequirementType(ctx context.Context, id uuid.UUID, req service.UpdateRequirementTypeRequest) (*models.RequirementType, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) DeleteRequirementType(id uuid.UUID, force bool) error {
+func (m *MockConfigServiceForStatusModel) DeleteRequirementType(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigServiceForStatusModel) ListRequirementTypes(filters service.RequirementTypeFilters) ([]models.RequirementType, int64, error) {
+func (m *MockConfigServiceForStatusModel) ListRequirementTypes(ctx context.Context, filters service.RequirementTypeFilters) ([]models.RequirementType, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.RequirementType), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigServiceForStatusModel) CreateRelationshipType(req service.CreateRelationshipTypeRequest) (*models.RelationshipType, error) {
+func (m *MockConfigServiceForStatusModel) CreateRelationshipType(ctx context.Context, req service.CreateRelationshipTypeRequest) (*models.RelationshipType, error) {
 	args := m.Called(req)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetRelationshipTypeByID(id uuid.UUID) (*models.RelationshipType, error) {
+func (m *MockConfigServiceForStatusModel) GetRelationshipTypeByID(ctx context.Context, id uuid.UUID) (*models.RelationshipType, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) GetRelationshipTypeByName(name string) (*models.RelationshipType, error) {
+func (m *MockConfigServiceForStatusModel) GetRelationshipTypeByName(ctx context.Context, name string) (*models.RelationshipType, error) {
 	args := m.Called(name)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) UpdateRelationshipType(id uuid.UUID, req service.UpdateRelationshipTypeRequest) (*models.RelationshipType, error) {
+func (m *MockConfigServiceForStatusModel) UpdateRelationshipType(ctx context.Context, id uuid.UUID, req service.UpdateRelationshipTypeRequest) (*models.RelationshipType, error) {
 	args := m.Called(id, req)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigServiceForStatusModel) DeleteRelationshipType(id uuid.UUID, force bool) error {
+func (m *MockConfigServiceForStatusModel) DeleteRelationshipType(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockConfigServiceForStatusModel) ListRelationshipTypes(filters service.RelationshipTypeFilters) ([]models.RelationshipType, int64, error) {
+func (m *MockConfigServiceForStatusModel) ListRelationshipTypes(ctx context.Context, filters service.RelationshipTypeFilters) ([]models.RelationshipType, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.RelationshipType), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockConfigServiceForStatusModel) ValidateRequirementType(typeID uuid.UUID) error {
+func (m *MockConfigServiceForStatusModel) ValidateRequirementType(ctx context.Context, typeID uuid.UUID) error {
 	args := m.Called(typeID)
 	return args.Error(0)
 }
 
-func (m *MockConfigServiceForStatusModel) ValidateRelationshipType(typeID uuid.UUID) error {
+func (m *MockConfigServiceForStatusModel) ValidateRelationshipType(ctx context.Context, typeID uuid.UUID) error {
 	args := m.Called(typeID)
 	return args.Error(0)
 }
@@ -0,0 +1,466 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// IncidentListResponse represents the response for listing incidents
+type IncidentListResponse = ListResponse[models.Incident]
+
+// MaintenanceWindowListResponse represents the response for listing maintenance windows
+type MaintenanceWindowListResponse = ListResponse[models.MaintenanceWindow]
+
+// StatusPageHandler handles HTTP requests for the public status page and the incidents and maintenance
+// windows announced on it
+type StatusPageHandler struct {
+	statusPageService service.StatusPageService
+}
+
+// NewStatusPageHandler creates a new status page handler instance
+func NewStatusPageHandler(statusPageService service.StatusPageService) *StatusPageHandler {
+	return &StatusPageHandler{
+		statusPageService: statusPageService,
+	}
+}
+
+// GetStatus handles GET /status
+// @Summary Public status page
+// @Description Report the availability of the service components, open incidents and those resolved in the last 7 days, and upcoming maintenance windows. The endpoint needs no authentication, is cached for 30 seconds and, unlike the health checks, exposes no internal details.
+// @Tags status
+// @Accept json
+// @Produce json
+// @Success 200 {object} service.StatusPage "Status of the service"
+// @Router /status [get]
+func (h *StatusPageHandler) GetStatus(c *gin.Context) {
+	page := h.statusPageService.GetStatusPage(c.Request.Context(), time.Now().UTC())
+	c.Header("Cache-Control", "public, max-age=30")
+	c.JSON(http.StatusOK, page)
+}
+
+// CreateIncident handles POST /api/v1/admin/incidents
+// @Summary Open an incident
+// @Description Announce an outage or degradation on the status page with its first update. Open incidents lower the status of the affected components according to their impact.
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param incident body service.CreateIncidentRequest true "Incident"
+// @Success 201 {object} models.Incident "Opened incident"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, status, impact or components"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/incidents [post]
+func (h *StatusPageHandler) CreateIncident(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.CreateIncidentRequest
+	if !bindStatusPageRequest(c, &req) {
+		return
+	}
+
+	incident, err := h.statusPageService.CreateIncident(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to create incident")
+		return
+	}
+
+	c.JSON(http.StatusCreated, incident)
+}
+
+// ListIncidents handles GET /api/v1/admin/incidents
+// @Summary List incidents
+// @Description Retrieve incidents, most recently started first
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param resolved query bool false "Only resolved (true) or open (false) incidents"
+// @Param limit query int false "Maximum number of incidents to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of incidents to skip" minimum(0) default(0)
+// @Success 200 {object} IncidentListResponse "Incidents"
+// @Failure 400 {object} map[string]interface{} "Invalid resolved filter"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/incidents [get]
+func (h *StatusPageHandler) ListIncidents(c *gin.Context) {
+	ctx := c.Request.Context()
+	var resolved *bool
+	if value := c.Query("resolved"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid resolved format",
+				},
+			})
+			return
+		}
+		resolved = &parsed
+	}
+	limit, offset := statusPagePagination(c)
+
+	incidents, total, err := h.statusPageService.ListIncidents(ctx, resolved, limit, offset)
+	if err != nil {
+		respondWithError(c, err, "Failed to list incidents")
+		return
+	}
+
+	SendListResponse(c, incidents, total, limit, offset)
+}
+
+// GetIncident handles GET /api/v1/admin/incidents/:id
+// @Summary Get an incident
+// @Description Retrieve an incident with its timeline, oldest update first
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident UUID" format(uuid)
+// @Success 200 {object} models.Incident "Incident"
+// @Failure 400 {object} map[string]interface{} "Invalid incident ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Incident not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/incidents/{id} [get]
+func (h *StatusPageHandler) GetIncident(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseStatusPageID(c, "incident")
+	if !ok {
+		return
+	}
+
+	incident, err := h.statusPageService.GetIncident(ctx, id)
+	if err != nil {
+		respondWithError(c, err, "Failed to get incident")
+		return
+	}
+
+	c.JSON(http.StatusOK, incident)
+}
+
+// UpdateIncident handles PUT /api/v1/admin/incidents/:id
+// @Summary Update an incident
+// @Description Replace the title, impact and affected components of an incident. Post an update to change its status.
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident UUID" format(uuid)
+// @Param incident body service.IncidentRequest true "Incident details"
+// @Success 200 {object} models.Incident "Updated incident"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, impact or components"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Incident not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/incidents/{id} [put]
+func (h *StatusPageHandler) UpdateIncident(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseStatusPageID(c, "incident")
+	if !ok {
+		return
+	}
+	var req service.IncidentRequest
+	if !bindStatusPageRequest(c, &req) {
+		return
+	}
+
+	incident, err := h.statusPageService.UpdateIncident(ctx, id, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to update incident")
+		return
+	}
+
+	c.JSON(http.StatusOK, incident)
+}
+
+// PostIncidentUpdate handles POST /api/v1/admin/incidents/:id/updates
+// @Summary Post an incident update
+// @Description Add a message to the timeline of an incident and move it to a status. Resolving the incident restores its components; an update of a resolved incident reopens it.
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident UUID" format(uuid)
+// @Param update body service.IncidentUpdateRequest true "Update"
+// @Success 201 {object} models.Incident "Incident with the new update"
+// @Failure 400 {object} map[string]interface{} "Invalid request body or status"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Incident not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/incidents/{id}/updates [post]
+func (h *StatusPageHandler) PostIncidentUpdate(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	id, ok := parseStatusPageID(c, "incident")
+	if !ok {
+		return
+	}
+	var req service.IncidentUpdateRequest
+	if !bindStatusPageRequest(c, &req) {
+		return
+	}
+
+	incident, err := h.statusPageService.PostIncidentUpdate(ctx, id, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to post incident update")
+		return
+	}
+
+	c.JSON(http.StatusCreated, incident)
+}
+
+// DeleteIncident handles DELETE /api/v1/admin/incidents/:id
+// @Summary Delete an incident
+// @Description Remove an incident opened by mistake, with its timeline. Resolve real incidents instead, so that they stay on the status page.
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident UUID" format(uuid)
+// @Success 204 "Incident deleted"
+// @Failure 400 {object} map[string]interface{} "Invalid incident ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Incident not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/incidents/{id} [delete]
+func (h *StatusPageHandler) DeleteIncident(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseStatusPageID(c, "incident")
+	if !ok {
+		return
+	}
+
+	if err := h.statusPageService.DeleteIncident(ctx, id); err != nil {
+		respondWithError(c, err, "Failed to delete incident")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateMaintenanceWindow handles POST /api/v1/admin/maintenance-windows
+// @Summary Schedule maintenance
+// @Description Announce planned maintenance on the status page. While a window is in progress its components are shown under maintenance.
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param window body service.MaintenanceWindowRequest true "Maintenance window"
+// @Success 201 {object} models.MaintenanceWindow "Scheduled maintenance window"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, components or period"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/maintenance-windows [post]
+func (h *StatusPageHandler) CreateMaintenanceWindow(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req service.MaintenanceWindowRequest
+	if !bindStatusPageRequest(c, &req) {
+		return
+	}
+
+	window, err := h.statusPageService.CreateMaintenanceWindow(ctx, req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to create maintenance window")
+		return
+	}
+
+	c.JSON(http.StatusCreated, window)
+}
+
+// ListMaintenanceWindows handles GET /api/v1/admin/maintenance-windows
+// @Summary List maintenance windows
+// @Description Retrieve maintenance windows, earliest first
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param upcoming query bool false "Only windows that are not over yet"
+// @Param limit query int false "Maximum number of windows to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of windows to skip" minimum(0) default(0)
+// @Success 200 {object} MaintenanceWindowListResponse "Maintenance windows"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/maintenance-windows [get]
+func (h *StatusPageHandler) ListMaintenanceWindows(c *gin.Context) {
+	ctx := c.Request.Context()
+	var endingAfter *time.Time
+	if c.Query("upcoming") == "true" {
+		now := time.Now().UTC()
+		endingAfter = &now
+	}
+	limit, offset := statusPagePagination(c)
+
+	windows, total, err := h.statusPageService.ListMaintenanceWindows(ctx, endingAfter, limit, offset)
+	if err != nil {
+		respondWithError(c, err, "Failed to list maintenance windows")
+		return
+	}
+
+	SendListResponse(c, windows, total, limit, offset)
+}
+
+// GetMaintenanceWindow handles GET /api/v1/admin/maintenance-windows/:id
+// @Summary Get a maintenance window
+// @Description Retrieve a maintenance window
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Maintenance window UUID" format(uuid)
+// @Success 200 {object} models.MaintenanceWindow "Maintenance window"
+// @Failure 400 {object} map[string]interface{} "Invalid maintenance window ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Maintenance window not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/maintenance-windows/{id} [get]
+func (h *StatusPageHandler) GetMaintenanceWindow(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseStatusPageID(c, "maintenance window")
+	if !ok {
+		return
+	}
+
+	window, err := h.statusPageService.GetMaintenanceWindow(ctx, id)
+	if err != nil {
+		respondWithError(c, err, "Failed to get maintenance window")
+		return
+	}
+
+	c.JSON(http.StatusOK, window)
+}
+
+// UpdateMaintenanceWindow handles PUT /api/v1/admin/maintenance-windows/:id
+// @Summary Update a maintenance window
+// @Description Replace a maintenance window, for example to reschedule it
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Maintenance window UUID" format(uuid)
+// @Param window body service.MaintenanceWindowRequest true "Maintenance window"
+// @Success 200 {object} models.MaintenanceWindow "Updated maintenance window"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, components or period"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Maintenance window not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/maintenance-windows/{id} [put]
+func (h *StatusPageHandler) UpdateMaintenanceWindow(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseStatusPageID(c, "maintenance window")
+	if !ok {
+		return
+	}
+	var req service.MaintenanceWindowRequest
+	if !bindStatusPageRequest(c, &req) {
+		return
+	}
+
+	window, err := h.statusPageService.UpdateMaintenanceWindow(ctx, id, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to update maintenance window")
+		return
+	}
+
+	c.JSON(http.StatusOK, window)
+}
+
+// DeleteMaintenanceWindow handles DELETE /api/v1/admin/maintenance-windows/:id
+// @Summary Cancel a maintenance window
+// @Description Remove a maintenance window from the status page
+// @Tags status
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Maintenance window UUID" format(uuid)
+// @Success 204 "Maintenance window cancelled"
+// @Failure 400 {object} map[string]interface{} "Invalid maintenance window ID"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Maintenance window not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/admin/maintenance-windows/{id} [delete]
+func (h *StatusPageHandler) DeleteMaintenanceWindow(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, ok := parseStatusPageID(c, "maintenance window")
+	if !ok {
+		return
+	}
+
+	if err := h.statusPageService.DeleteMaintenanceWindow(ctx, id); err != nil {
+		respondWithError(c, err, "Failed to delete maintenance window")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindStatusPageRequest binds a JSON request body, answering 400 when it is invalid
+func bindStatusPageRequest(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// parseStatusPageID parses the ID path parameter of an incident or maintenance window
+func parseStatusPageID(c *gin.Context, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid " + name + " ID format",
+			},
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// statusPagePagination reads the limit (1-100, 50 by default) and offset query parameters
+func statusPagePagination(c *gin.Context) (int, int) {
+	limit, offset := 50, 0
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(c.Query("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+	return limit, offset
+}
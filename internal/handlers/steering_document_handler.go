@@ -34,12 +34,13 @@ func NewSteeringDocumentHandler(steeringDocumentService service.SteeringDocument
 
 // getCurrentUser is a helper function to get the current user object from JWT claims
 func (h *SteeringDocumentHandler) getCurrentUser(c *gin.Context) (*models.User, error) {
+	ctx := c.Request.Context()
 	userID, ok := auth.GetCurrentUserID(c)
 	if !ok {
 		return nil, errors.New("user authentication required")
 	}
 
-	user, err := h.userRepo.GetByID(uuid.MustParse(userID))
+	user, err := h.userRepo.GetByID(ctx, uuid.MustParse(userID))
 	if err != nil {
 		return nil, errors.New("user not found")
 	}
@@ -62,6 +63,7 @@ func (h *SteeringDocumentHandler) getCurrentUser(c *gin.Context) (*models.User,
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/steering-documents [post]
 func (h *SteeringDocumentHandler) CreateSteeringDocument(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateSteeringDocumentRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -85,16 +87,9 @@ func (h *SteeringDocumentHandler) CreateSteeringDocument(c *gin.Context) {
 		return
 	}
 
-	doc, err := h.steeringDocumentService.CreateSteeringDocument(req, currentUser)
+	doc, err := h.steeringDocumentService.CreateSteeringDocument(ctx, req, currentUser)
 	if err != nil {
 		switch {
-		case errors.Is(err, service.ErrUnauthorizedAccess):
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": gin.H{
-					"code":    "INSUFFICIENT_PERMISSIONS",
-					"message": "User or Administrator role required",
-				},
-			})
 		case errors.Is(err, service.ErrUserNotFound):
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": gin.H{
@@ -110,12 +105,7 @@ func (h *SteeringDocumentHandler) CreateSteeringDocument(c *gin.Context) {
 				},
 			})
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to create steering document",
-				},
-			})
+			respondWithError(c, err, "Failed to create steering document")
 		}
 		return
 	}
@@ -137,6 +127,7 @@ func (h *SteeringDocumentHandler) CreateSteeringDocument(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/steering-documents/{id} [get]
 func (h *SteeringDocumentHandler) GetSteeringDocument(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Get current user
@@ -155,9 +146,9 @@ func (h *SteeringDocumentHandler) GetSteeringDocument(c *gin.Context) {
 	var doc *models.SteeringDocument
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		doc, err = h.steeringDocumentService.GetSteeringDocumentByID(id, currentUser)
+		doc, err = h.steeringDocumentService.GetSteeringDocumentByID(ctx, id, currentUser)
 	} else {
-		doc, err = h.steeringDocumentService.GetSteeringDocumentByReferenceID(idParam, currentUser)
+		doc, err = h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, idParam, currentUser)
 	}
 
 	if err != nil {
@@ -199,6 +190,7 @@ func (h *SteeringDocumentHandler) GetSteeringDocument(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/steering-documents/{id} [put]
 func (h *SteeringDocumentHandler) UpdateSteeringDocument(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Get current user
@@ -219,7 +211,7 @@ func (h *SteeringDocumentHandler) UpdateSteeringDocument(c *gin.Context) {
 		id = parsedID
 	} else {
 		// For reference ID, first get the document to get its UUID
-		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(idParam, currentUser)
+		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, idParam, currentUser)
 		if err != nil {
 			if errors.Is(err, service.ErrSteeringDocumentNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -252,31 +244,9 @@ func (h *SteeringDocumentHandler) UpdateSteeringDocument(c *gin.Context) {
 		return
 	}
 
-	doc, err := h.steeringDocumentService.UpdateSteeringDocument(id, req, currentUser)
+	doc, err := h.steeringDocumentService.UpdateSteeringDocument(ctx, id, req, currentUser)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrSteeringDocumentNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Steering document not found",
-				},
-			})
-		case errors.Is(err, service.ErrUnauthorizedAccess):
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": gin.H{
-					"code":    "INSUFFICIENT_PERMISSIONS",
-					"message": "You can only update your own steering documents",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to update steering document",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to update steering document")
 		return
 	}
 
@@ -298,6 +268,7 @@ func (h *SteeringDocumentHandler) UpdateSteeringDocument(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/steering-documents/{id} [delete]
 func (h *SteeringDocumentHandler) DeleteSteeringDocument(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Get current user
@@ -318,7 +289,7 @@ func (h *SteeringDocumentHandler) DeleteSteeringDocument(c *gin.Context) {
 		id = parsedID
 	} else {
 		// For reference ID, first get the document to get its UUID
-		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(idParam, currentUser)
+		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, idParam, currentUser)
 		if err != nil {
 			if errors.Is(err, service.ErrSteeringDocumentNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -340,31 +311,9 @@ func (h *SteeringDocumentHandler) DeleteSteeringDocument(c *gin.Context) {
 		id = doc.ID
 	}
 
-	err = h.steeringDocumentService.DeleteSteeringDocument(id, currentUser)
+	err = h.steeringDocumentService.DeleteSteeringDocument(ctx, id, currentUser)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrSteeringDocumentNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Steering document not found",
-				},
-			})
-		case errors.Is(err, service.ErrUnauthorizedAccess):
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": gin.H{
-					"code":    "INSUFFICIENT_PERMISSIONS",
-					"message": "You can only delete your own steering documents",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to delete steering document",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to delete steering document")
 		return
 	}
 
@@ -388,6 +337,7 @@ func (h *SteeringDocumentHandler) DeleteSteeringDocument(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/steering-documents [get]
 func (h *SteeringDocumentHandler) ListSteeringDocuments(c *gin.Context) {
+	ctx := c.Request.Context()
 	// Get current user
 	currentUser, err := h.getCurrentUser(c)
 	if err != nil {
@@ -424,7 +374,7 @@ func (h *SteeringDocumentHandler) ListSteeringDocuments(c *gin.Context) {
 		}
 	}
 
-	docs, totalCount, err := h.steeringDocumentService.ListSteeringDocuments(filters, currentUser)
+	docs, totalCount, err := h.steeringDocumentService.ListSteeringDocuments(ctx, filters, currentUser)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": gin.H{
@@ -467,6 +417,7 @@ func (h *SteeringDocumentHandler) ListSteeringDocuments(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{epic_id}/steering-documents/{doc_id} [post]
 func (h *SteeringDocumentHandler) LinkSteeringDocumentToEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	epicIDParam := c.Param("id")
 	docIDParam := c.Param("doc_id")
 
@@ -488,7 +439,7 @@ func (h *SteeringDocumentHandler) LinkSteeringDocumentToEpic(c *gin.Context) {
 		epicID = id
 	} else {
 		// For reference ID, first get the epic to get its UUID
-		epic, err := h.epicService.GetEpicByReferenceID(epicIDParam)
+		epic, err := h.epicService.GetEpicByReferenceID(ctx, epicIDParam)
 		if err != nil {
 			if errors.Is(err, service.ErrEpicNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -516,7 +467,7 @@ func (h *SteeringDocumentHandler) LinkSteeringDocumentToEpic(c *gin.Context) {
 		docID = id
 	} else {
 		// For reference ID, first get the document to get its UUID
-		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(docIDParam, currentUser)
+		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, docIDParam, currentUser)
 		if err != nil {
 			if errors.Is(err, service.ErrSteeringDocumentNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -538,45 +489,9 @@ func (h *SteeringDocumentHandler) LinkSteeringDocumentToEpic(c *gin.Context) {
 		docID = doc.ID
 	}
 
-	err = h.steeringDocumentService.LinkSteeringDocumentToEpic(docID, epicID, currentUser)
+	err = h.steeringDocumentService.LinkSteeringDocumentToEpic(ctx, docID, epicID, currentUser)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrSteeringDocumentNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Steering document not found",
-				},
-			})
-		case errors.Is(err, service.ErrEpicNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Epic not found",
-				},
-			})
-		case errors.Is(err, service.ErrLinkAlreadyExists):
-			c.JSON(http.StatusConflict, gin.H{
-				"error": gin.H{
-					"code":    "CONFLICT",
-					"message": "Link already exists",
-				},
-			})
-		case errors.Is(err, service.ErrUnauthorizedAccess):
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": gin.H{
-					"code":    "INSUFFICIENT_PERMISSIONS",
-					"message": "You can only link your own steering documents",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to link steering document to epic",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to link steering document to epic")
 		return
 	}
 
@@ -602,6 +517,7 @@ func (h *SteeringDocumentHandler) LinkSteeringDocumentToEpic(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{epic_id}/steering-documents/{doc_id} [delete]
 func (h *SteeringDocumentHandler) UnlinkSteeringDocumentFromEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	epicIDParam := c.Param("id")
 	docIDParam := c.Param("doc_id")
 
@@ -623,7 +539,7 @@ func (h *SteeringDocumentHandler) UnlinkSteeringDocumentFromEpic(c *gin.Context)
 		epicID = id
 	} else {
 		// For reference ID, first get the epic to get its UUID
-		epic, err := h.epicService.GetEpicByReferenceID(epicIDParam)
+		epic, err := h.epicService.GetEpicByReferenceID(ctx, epicIDParam)
 		if err != nil {
 			if errors.Is(err, service.ErrEpicNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -651,7 +567,7 @@ func (h *SteeringDocumentHandler) UnlinkSteeringDocumentFromEpic(c *gin.Context)
 		docID = id
 	} else {
 		// For reference ID, first get the document to get its UUID
-		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(docIDParam, currentUser)
+		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, docIDParam, currentUser)
 		if err != nil {
 			if errors.Is(err, service.ErrSteeringDocumentNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -673,38 +589,9 @@ func (h *SteeringDocumentHandler) UnlinkSteeringDocumentFromEpic(c *gin.Context)
 		docID = doc.ID
 	}
 
-	err = h.steeringDocumentService.UnlinkSteeringDocumentFromEpic(docID, epicID, currentUser)
+	err = h.steeringDocumentService.UnlinkSteeringDocumentFromEpic(ctx, docID, epicID, currentUser)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrSteeringDocumentNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Steering document not found",
-				},
-			})
-		case errors.Is(err, service.ErrEpicNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Epic not found",
-				},
-			})
-		case errors.Is(err, service.ErrUnauthorizedAccess):
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": gin.H{
-					"code":    "INSUFFICIENT_PERMISSIONS",
-					"message": "You can only unlink your own steering documents",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to unlink steering document from epic",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to unlink steering document from epic")
 		return
 	}
 
@@ -728,6 +615,7 @@ func (h *SteeringDocumentHandler) UnlinkSteeringDocumentFromEpic(c *gin.Context)
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id}/steering-documents [get]
 func (h *SteeringDocumentHandler) GetEpicSteeringDocuments(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Get current user
@@ -757,7 +645,7 @@ func (h *SteeringDocumentHandler) GetEpicSteeringDocuments(c *gin.Context) {
 		epicID = id
 	} else {
 		// For reference ID, first get the epic to get its UUID
-		epic, err := h.epicService.GetEpicByReferenceID(idParam)
+		epic, err := h.epicService.GetEpicByReferenceID(ctx, idParam)
 		if err != nil {
 			if errors.Is(err, service.ErrEpicNotFound) {
 				c.JSON(http.StatusNotFound, gin.H{
@@ -779,36 +667,14 @@ func (h *SteeringDocumentHandler) GetEpicSteeringDocuments(c *gin.Context) {
 		epicID = epic.ID
 	}
 
-	docs, totalCount, err := h.steeringDocumentService.GetSteeringDocumentsByEpicIDWithPagination(
+	docs, totalCount, err := h.steeringDocumentService.GetSteeringDocumentsByEpicIDWithPagination(ctx,
 		epicID,
 		paginationParams.Limit,
 		paginationParams.Offset,
 		currentUser,
 	)
 	if err != nil {
-		switch {
-		case errors.Is(err, service.ErrEpicNotFound):
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": gin.H{
-					"code":    "ENTITY_NOT_FOUND",
-					"message": "Epic not found",
-				},
-			})
-		case errors.Is(err, service.ErrUnauthorizedAccess):
-			c.JSON(http.StatusForbidden, gin.H{
-				"error": gin.H{
-					"code":    "INSUFFICIENT_PERMISSIONS",
-					"message": "Insufficient permissions to view steering documents",
-				},
-			})
-		default:
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": gin.H{
-					"code":    "INTERNAL_ERROR",
-					"message": "Failed to get steering documents for epic",
-				},
-			})
-		}
+		respondWithError(c, err, "Failed to get steering documents for epic")
 		return
 	}
 
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -17,57 +18,57 @@ type MockSteeringDocumentService struct {
 	mock.Mock
 }
 
-func (m *MockSteeringDocumentService) CreateSteeringDocument(req service.CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) CreateSteeringDocument(ctx context.Context, req service.CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(req, currentUser)
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentByID(id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentByID(ctx context.Context, id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(id, currentUser)
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentByReferenceID(referenceID string, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentByReferenceID(ctx context.Context, referenceID string, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(referenceID, currentUser)
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) UpdateSteeringDocument(id uuid.UUID, req service.UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) UpdateSteeringDocument(ctx context.Context, id uuid.UUID, req service.UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(id, req, currentUser)
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) DeleteSteeringDocument(id uuid.UUID, currentUser *models.User) error {
+func (m *MockSteeringDocumentService) DeleteSteeringDocument(ctx context.Context, id uuid.UUID, currentUser *models.User) error {
 	args := m.Called(id, currentUser)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentService) ListSteeringDocuments(filters service.SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
+func (m *MockSteeringDocumentService) ListSteeringDocuments(ctx context.Context, filters service.SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
 	args := m.Called(filters, currentUser)
 	return args.Get(0).([]models.SteeringDocument), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockSteeringDocumentService) SearchSteeringDocuments(query string, currentUser *models.User) ([]models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) SearchSteeringDocuments(ctx context.Context, query string, currentUser *models.User) ([]models.SteeringDocument, error) {
 	args := m.Called(query, currentUser)
 	return args.Get(0).([]models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicID(epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicID(ctx context.Context, epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error) {
 	args := m.Called(epicID, currentUser)
 	return args.Get(0).([]models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination(epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination(ctx context.Context, epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
 	args := m.Called(epicID, limit, offset, currentUser)
 	return args.Get(0).([]models.SteeringDocument), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockSteeringDocumentService) LinkSteeringDocumentToEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
+func (m *MockSteeringDocumentService) LinkSteeringDocumentToEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
 	args := m.Called(steeringDocumentID, epicID, currentUser)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentService) UnlinkSteeringDocumentFromEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
+func (m *MockSteeringDocumentService) UnlinkSteeringDocumentFromEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
 	args := m.Called(steeringDocumentID, epicID, currentUser)
 	return args.Error(0)
 }
@@ -77,33 +78,49 @@ type MockUserRepository struct {
 	mock.Mock
 }
 
-func (m *MockUserRepository) GetByID(id uuid.UUID) (*models.User, error) {
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
 // Add other required methods as stubs
-func (m *MockUserRepository) Create(entity *models.User) error { return nil }
-func (m *MockUserRepository) GetByReferenceID(referenceID string) (*models.User, error) {
+func (m *MockUserRepository) Create(ctx context.Context, entity *models.User) error { return nil }
+func (m *MockUserRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.User, error) {
 	return nil, nil
 }
-func (m *MockUserRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.User, error) {
+func (m *MockUserRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.User, error) {
 	return nil, nil
 }
-func (m *MockUserRepository) Update(entity *models.User) error { return nil }
-func (m *MockUserRepository) Delete(id uuid.UUID) error        { return nil }
-func (m *MockUserRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.User, error) {
+func (m *MockUserRepository) Update(ctx context.Context, entity *models.User) error { return nil }
+func (m *MockUserRepository) Delete(ctx context.Context, id uuid.UUID) error        { return nil }
+func (m *MockUserRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.User, error) {
 	return nil, nil
 }
-func (m *MockUserRepository) Count(filters map[string]interface{}) (int64, error)  { return 0, nil }
-func (m *MockUserRepository) Exists(id uuid.UUID) (bool, error)                    { return false, nil }
-func (m *MockUserRepository) ExistsByReferenceID(referenceID string) (bool, error) { return false, nil }
-func (m *MockUserRepository) WithTransaction(fn func(*gorm.DB) error) error        { return nil }
-func (m *MockUserRepository) GetDB() *gorm.DB                                      { return nil }
-func (m *MockUserRepository) GetByUsername(username string) (*models.User, error)  { return nil, nil }
-func (m *MockUserRepository) GetByEmail(email string) (*models.User, error)        { return nil, nil }
-func (m *MockUserRepository) ExistsByUsername(username string) (bool, error)       { return false, nil }
-func (m *MockUserRepository) ExistsByEmail(email string) (bool, error)             { return false, nil }
+func (m *MockUserRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	return 0, nil
+}
+func (m *MockUserRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+func (m *MockUserRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
+	return false, nil
+}
+func (m *MockUserRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
+	return nil
+}
+func (m *MockUserRepository) GetDB() *gorm.DB { return nil }
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	return false, nil
+}
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	return false, nil
+}
 
 func TestNewSteeringDocumentHandler(t *testing.T) {
 	mockService := &MockSteeringDocumentService{}
@@ -119,6 +136,7 @@ func TestNewSteeringDocumentHandler(t *testing.T) {
 }
 
 func TestSteeringDocumentHandler_CreateSteeringDocument_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -149,7 +167,7 @@ func TestSteeringDocumentHandler_CreateSteeringDocument_Success(t *testing.T) {
 	mockService.On("CreateSteeringDocument", req, user).Return(expectedDoc, nil)
 
 	// Execute
-	result, err := handler.steeringDocumentService.CreateSteeringDocument(req, user)
+	result, err := handler.steeringDocumentService.CreateSteeringDocument(ctx, req, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -158,6 +176,7 @@ func TestSteeringDocumentHandler_CreateSteeringDocument_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentHandler_CreateSteeringDocument_ValidationError(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -170,7 +189,7 @@ func TestSteeringDocumentHandler_CreateSteeringDocument_ValidationError(t *testi
 	mockService.On("CreateSteeringDocument", req, user).Return((*models.SteeringDocument)(nil), service.ErrValidation)
 
 	// Execute
-	result, err := handler.steeringDocumentService.CreateSteeringDocument(req, user)
+	result, err := handler.steeringDocumentService.CreateSteeringDocument(ctx, req, user)
 
 	// Assert
 	assert.Error(t, err)
@@ -180,6 +199,7 @@ func TestSteeringDocumentHandler_CreateSteeringDocument_ValidationError(t *testi
 }
 
 func TestSteeringDocumentHandler_GetSteeringDocumentByID_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -199,7 +219,7 @@ func TestSteeringDocumentHandler_GetSteeringDocumentByID_Success(t *testing.T) {
 	mockService.On("GetSteeringDocumentByID", docID, user).Return(expectedDoc, nil)
 
 	// Execute
-	result, err := handler.steeringDocumentService.GetSteeringDocumentByID(docID, user)
+	result, err := handler.steeringDocumentService.GetSteeringDocumentByID(ctx, docID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -208,6 +228,7 @@ func TestSteeringDocumentHandler_GetSteeringDocumentByID_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentHandler_GetSteeringDocumentByID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -220,7 +241,7 @@ func TestSteeringDocumentHandler_GetSteeringDocumentByID_NotFound(t *testing.T)
 	mockService.On("GetSteeringDocumentByID", docID, user).Return((*models.SteeringDocument)(nil), service.ErrSteeringDocumentNotFound)
 
 	// Execute
-	result, err := handler.steeringDocumentService.GetSteeringDocumentByID(docID, user)
+	result, err := handler.steeringDocumentService.GetSteeringDocumentByID(ctx, docID, user)
 
 	// Assert
 	assert.Error(t, err)
@@ -230,6 +251,7 @@ func TestSteeringDocumentHandler_GetSteeringDocumentByID_NotFound(t *testing.T)
 }
 
 func TestSteeringDocumentHandler_GetSteeringDocumentByReferenceID_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -249,7 +271,7 @@ func TestSteeringDocumentHandler_GetSteeringDocumentByReferenceID_Success(t *tes
 	mockService.On("GetSteeringDocumentByReferenceID", referenceID, user).Return(expectedDoc, nil)
 
 	// Execute
-	result, err := handler.steeringDocumentService.GetSteeringDocumentByReferenceID(referenceID, user)
+	result, err := handler.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, referenceID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -258,6 +280,7 @@ func TestSteeringDocumentHandler_GetSteeringDocumentByReferenceID_Success(t *tes
 }
 
 func TestSteeringDocumentHandler_UpdateSteeringDocument_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -282,7 +305,7 @@ func TestSteeringDocumentHandler_UpdateSteeringDocument_Success(t *testing.T) {
 	mockService.On("UpdateSteeringDocument", docID, req, user).Return(expectedDoc, nil)
 
 	// Execute
-	result, err := handler.steeringDocumentService.UpdateSteeringDocument(docID, req, user)
+	result, err := handler.steeringDocumentService.UpdateSteeringDocument(ctx, docID, req, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -291,6 +314,7 @@ func TestSteeringDocumentHandler_UpdateSteeringDocument_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentHandler_UpdateSteeringDocument_AccessDenied(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -304,7 +328,7 @@ func TestSteeringDocumentHandler_UpdateSteeringDocument_AccessDenied(t *testing.
 	mockService.On("UpdateSteeringDocument", docID, req, user).Return((*models.SteeringDocument)(nil), service.ErrInsufficientPermissions)
 
 	// Execute
-	result, err := handler.steeringDocumentService.UpdateSteeringDocument(docID, req, user)
+	result, err := handler.steeringDocumentService.UpdateSteeringDocument(ctx, docID, req, user)
 
 	// Assert
 	assert.Error(t, err)
@@ -314,6 +338,7 @@ func TestSteeringDocumentHandler_UpdateSteeringDocument_AccessDenied(t *testing.
 }
 
 func TestSteeringDocumentHandler_DeleteSteeringDocument_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -326,7 +351,7 @@ func TestSteeringDocumentHandler_DeleteSteeringDocument_Success(t *testing.T) {
 	mockService.On("DeleteSteeringDocument", docID, user).Return(nil)
 
 	// Execute
-	err := handler.steeringDocumentService.DeleteSteeringDocument(docID, user)
+	err := handler.steeringDocumentService.DeleteSteeringDocument(ctx, docID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -334,6 +359,7 @@ func TestSteeringDocumentHandler_DeleteSteeringDocument_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentHandler_ListSteeringDocuments_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -354,7 +380,7 @@ func TestSteeringDocumentHandler_ListSteeringDocuments_Success(t *testing.T) {
 	mockService.On("ListSteeringDocuments", filters, user).Return(expectedDocs, expectedTotal, nil)
 
 	// Execute
-	result, total, err := handler.steeringDocumentService.ListSteeringDocuments(filters, user)
+	result, total, err := handler.steeringDocumentService.ListSteeringDocuments(ctx, filters, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -364,6 +390,7 @@ func TestSteeringDocumentHandler_ListSteeringDocuments_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentHandler_SearchSteeringDocuments_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -379,7 +406,7 @@ func TestSteeringDocumentHandler_SearchSteeringDocuments_Success(t *testing.T) {
 	mockService.On("SearchSteeringDocuments", query, user).Return(expectedDocs, nil)
 
 	// Execute
-	result, err := handler.steeringDocumentService.SearchSteeringDocuments(query, user)
+	result, err := handler.steeringDocumentService.SearchSteeringDocuments(ctx, query, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -388,6 +415,7 @@ func TestSteeringDocumentHandler_SearchSteeringDocuments_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentHandler_LinkSteeringDocumentToEpic_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -401,7 +429,7 @@ func TestSteeringDocumentHandler_LinkSteeringDocumentToEpic_Success(t *testing.T
 	mockService.On("LinkSteeringDocumentToEpic", docID, epicID, user).Return(nil)
 
 	// Execute
-	err := handler.steeringDocumentService.LinkSteeringDocumentToEpic(docID, epicID, user)
+	err := handler.steeringDocumentService.LinkSteeringDocumentToEpic(ctx, docID, epicID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -409,6 +437,7 @@ func TestSteeringDocumentHandler_LinkSteeringDocumentToEpic_Success(t *testing.T
 }
 
 func TestSteeringDocumentHandler_UnlinkSteeringDocumentFromEpic_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -422,7 +451,7 @@ func TestSteeringDocumentHandler_UnlinkSteeringDocumentFromEpic_Success(t *testi
 	mockService.On("UnlinkSteeringDocumentFromEpic", docID, epicID, user).Return(nil)
 
 	// Execute
-	err := handler.steeringDocumentService.UnlinkSteeringDocumentFromEpic(docID, epicID, user)
+	err := handler.steeringDocumentService.UnlinkSteeringDocumentFromEpic(ctx, docID, epicID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -430,6 +459,7 @@ func TestSteeringDocumentHandler_UnlinkSteeringDocumentFromEpic_Success(t *testi
 }
 
 func TestSteeringDocumentHandler_GetSteeringDocumentsByEpicID_Success(t *testing.T) {
+	ctx := context.Background()
 	mockService := &MockSteeringDocumentService{}
 	mockEpicService := &MockEpicService{}
 	mockUserRepo := &MockUserRepository{}
@@ -446,7 +476,7 @@ func TestSteeringDocumentHandler_GetSteeringDocumentsByEpicID_Success(t *testing
 	mockService.On("GetSteeringDocumentsByEpicID", epicID, user).Return(expectedDocs, nil)
 
 	// Execute
-	result, err := handler.steeringDocumentService.GetSteeringDocumentsByEpicID(epicID, user)
+	result, err := handler.steeringDocumentService.GetSteeringDocumentsByEpicID(ctx, epicID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// SupersessionHandler handles HTTP requests for obsolete requirement supersession
+type SupersessionHandler struct {
+	supersessionService service.SupersessionService
+}
+
+// NewSupersessionHandler creates a new supersession handler instance
+func NewSupersessionHandler(supersessionService service.SupersessionService) *SupersessionHandler {
+	return &SupersessionHandler{
+		supersessionService: supersessionService,
+	}
+}
+
+// GetSupersession handles GET /api/v1/requirements/:id/supersession
+// @Summary Get a requirement's supersession chain
+// @Description Retrieve the requirements a requirement superseded, directly or transitively, and the chain of requirements superseding it. current is the requirement in effect at the end of the chain, or the requirement itself when it has not been superseded.
+// @Tags requirements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Requirement ID (UUID or reference ID like REQ-001)"
+// @Success 200 {object} service.SupersessionChain "Supersession chain"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Requirement not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/requirements/{id}/supersession [get]
+func (h *SupersessionHandler) GetSupersession(c *gin.Context) {
+	ctx := c.Request.Context()
+	chain, err := h.supersessionService.GetChain(ctx, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to get supersession chain")
+		return
+	}
+
+	c.JSON(http.StatusOK, chain)
+}
+
+// SetSupersession handles PUT /api/v1/requirements/:id/supersession
+// @Summary Record which requirement supersedes an obsolete requirement
+// @Description Record the requirement superseding an obsolete requirement, or clear it with a null superseded_by. The superseding requirement may itself be obsolete only when it has been superseded in turn, and chains cannot loop back.
+// @Tags requirements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Requirement ID (UUID or reference ID like REQ-001)"
+// @Param request body service.SetSupersessionRequest true "Superseding requirement"
+// @Success 200 {object} models.Requirement "Updated requirement"
+// @Failure 400 {object} map[string]interface{} "Requirement not obsolete, self or circular supersession, or obsolete successor"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Requirement or superseding requirement not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/requirements/{id}/supersession [put]
+func (h *SupersessionHandler) SetSupersession(c *gin.Context) {
+	ctx := c.Request.Context()
+	var req service.SetSupersessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	requirement, err := h.supersessionService.SetSupersededBy(ctx, c.Param("id"), req.SupersededBy)
+	if err != nil {
+		respondWithError(c, err, "Failed to record supersession")
+		return
+	}
+
+	c.JSON(http.StatusOK, requirement)
+}
+
+// SplitRequirement handles POST /api/v1/requirements/:id/split
+// @Summary Split a requirement into several requirements
+// @Description Create the given parts in the requirement's user story, each derived from it. Parts take the requirement's type and priority unless they set their own, and copy only the relationships listed for them. The split requirement becomes obsolete and superseded by the first part.
+// @Tags requirements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Requirement ID (UUID or reference ID like REQ-001)"
+// @Param request body service.SplitRequirementRequest true "Parts to split the requirement into"
+// @Success 201 {object} service.RequirementSplit "Obsolete original and the new requirements"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, obsolete requirement, invalid priority or relationship not belonging to the requirement"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Requirement or requirement type not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/requirements/{id}/split [post]
+func (h *SupersessionHandler) SplitRequirement(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.SplitRequirementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	split, err := h.supersessionService.Split(ctx, c.Param("id"), req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to split requirement")
+		return
+	}
+
+	c.JSON(http.StatusCreated, split)
+}
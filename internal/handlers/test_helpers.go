@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"product-requirements-management/internal/models"
 
 	"github.com/google/uuid"
@@ -10,35 +11,35 @@ import (
 // mockRefreshTokenRepository is a minimal mock for testing
 type mockRefreshTokenRepository struct{}
 
-func (m *mockRefreshTokenRepository) Create(token *models.RefreshToken) error {
+func (m *mockRefreshTokenRepository) Create(ctx context.Context, token *models.RefreshToken) error {
 	return nil
 }
 
-func (m *mockRefreshTokenRepository) FindByTokenHash(tokenHash string) (*models.RefreshToken, error) {
+func (m *mockRefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
 	return nil, nil
 }
 
-func (m *mockRefreshTokenRepository) FindByUserID(userID uuid.UUID) ([]*models.RefreshToken, error) {
+func (m *mockRefreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.RefreshToken, error) {
 	return nil, nil
 }
 
-func (m *mockRefreshTokenRepository) FindAll() ([]*models.RefreshToken, error) {
+func (m *mockRefreshTokenRepository) FindAll(ctx context.Context) ([]*models.RefreshToken, error) {
 	return nil, nil
 }
 
-func (m *mockRefreshTokenRepository) Update(token *models.RefreshToken) error {
+func (m *mockRefreshTokenRepository) Update(ctx context.Context, token *models.RefreshToken) error {
 	return nil
 }
 
-func (m *mockRefreshTokenRepository) Delete(id uuid.UUID) error {
+func (m *mockRefreshTokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (m *mockRefreshTokenRepository) DeleteByUserID(userID uuid.UUID) error {
+func (m *mockRefreshTokenRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
 	return nil
 }
 
-func (m *mockRefreshTokenRepository) DeleteExpired() (int64, error) {
+func (m *mockRefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
 	return 0, nil
 }
 
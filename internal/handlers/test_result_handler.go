@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// TestRunListResponse represents the response for listing imported test runs
+type TestRunListResponse = ListResponse[models.TestRun]
+
+// TestResultHandler handles HTTP requests for importing CI test results
+type TestResultHandler struct {
+	testResultService service.TestResultService
+}
+
+// NewTestResultHandler creates a new test result handler instance
+func NewTestResultHandler(testResultService service.TestResultService) *TestResultHandler {
+	return &TestResultHandler{
+		testResultService: testResultService,
+	}
+}
+
+// ImportResults handles POST /api/v1/test-results/import
+// @Summary Import test results
+// @Description Record a CI test run and update the verification state of the acceptance criteria and requirements its tests reference. The body is a JUnit XML report, whose test cases reference entities by AC-/REQ- IDs in their class names, names or property values, or a JSON object mapping reference IDs to "pass", "fail" or "skip". An entity becomes failed when one of its tests failed and verified when its tests passed; skipped tests leave it unchanged. The format defaults from the Content-Type.
+// @Tags test-results
+// @Accept xml
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param format query string false "Report format; defaults to junit for XML and json for JSON bodies" Enums(junit, json)
+// @Param name query string false "Name of the run, e.g. the CI job"
+// @Param commit query string false "Commit the tests ran against"
+// @Param branch query string false "Branch the tests ran on"
+// @Param build_url query string false "Link to the CI build"
+// @Param report body string true "JUnit XML report or JSON object of results"
+// @Success 201 {object} service.TestResultsImport "Imported run with its results and unknown references"
+// @Failure 400 {object} ErrorResponse "Invalid format or malformed report"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "User role required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/test-results/import [post]
+func (h *TestResultHandler) ImportResults(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	content, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	format := c.Query("format")
+	if format == "" {
+		switch contentType := c.ContentType(); {
+		case strings.Contains(contentType, "xml"):
+			format = service.TestResultsFormatJUnit
+		case strings.Contains(contentType, "json"):
+			format = service.TestResultsFormatJSON
+		}
+	}
+
+	imported, err := h.testResultService.ImportResults(ctx, service.ImportTestResultsRequest{
+		Format:    format,
+		Content:   content,
+		Name:      c.Query("name"),
+		CommitSHA: c.Query("commit"),
+		Branch:    c.Query("branch"),
+		BuildURL:  c.Query("build_url"),
+	}, userID, time.Now())
+	if err != nil {
+		respondWithError(c, err, "Failed to import test results")
+		return
+	}
+
+	c.JSON(http.StatusCreated, imported)
+}
+
+// ListRuns handles GET /api/v1/test-runs
+// @Summary List imported test runs
+// @Description List imported test runs with their counts, newest first. Results are included when getting a single run.
+// @Tags test-results
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Maximum number of runs to return (1-100)" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of runs to skip" minimum(0) default(0)
+// @Success 200 {object} TestRunListResponse "Test runs"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/test-runs [get]
+func (h *TestResultHandler) ListRuns(c *gin.Context) {
+	ctx := c.Request.Context()
+	limit, offset := parseTestRunPage(c)
+	runs, total, err := h.testResultService.ListRuns(ctx, limit, offset)
+	if err != nil {
+		respondWithError(c, err, "Failed to list test runs")
+		return
+	}
+
+	SendListResponse(c, runs, total, limit, offset)
+}
+
+// GetRun handles GET /api/v1/test-runs/:id
+// @Summary Get an imported test run
+// @Description Retrieve an imported test run with its results matched to acceptance criteria and requirements.
+// @Tags test-results
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Test run ID" format(uuid)
+// @Success 200 {object} models.TestRun "Test run with its results"
+// @Failure 400 {object} ErrorResponse "Invalid test run ID"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Test run not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/test-runs/{id} [get]
+func (h *TestResultHandler) GetRun(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid test run ID format",
+			},
+		})
+		return
+	}
+
+	run, err := h.testResultService.GetRun(ctx, id)
+	if err != nil {
+		respondWithError(c, err, "Failed to get test run")
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
+
+// GetVerification handles GET /api/v1/{entityType}/:id/verification
+// @Summary Get the verification state of an entity
+// @Description Retrieve the verification state of an acceptance criteria or requirement set by the latest imported test run with results for it.
+// @Tags test-results
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} models.EntityVerification "Verification state with the run that set it"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 404 {object} ErrorResponse "Entity not found or no test results imported for it"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/acceptance-criteria/{id}/verification [get]
+// @Router /api/v1/requirements/{id}/verification [get]
+func (h *TestResultHandler) GetVerification(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	verification, err := h.testResultService.GetVerification(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to get verification")
+		return
+	}
+
+	c.JSON(http.StatusOK, verification)
+}
+
+// parseTestRunPage reads the limit and offset of a test run listing
+func parseTestRunPage(c *gin.Context) (int, int) {
+	limit, offset := 50, 0
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(c.Query("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+	return limit, offset
+}
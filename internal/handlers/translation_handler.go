@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// TranslationListResponse represents the response for listing the translations of an entity
+type TranslationListResponse = ListResponse[models.EntityTranslation]
+
+// TranslationCompletenessResponse represents the response of the translation completeness report
+type TranslationCompletenessResponse = ListResponse[service.TranslationCompleteness]
+
+// TranslationHandler handles HTTP requests for translations of entity titles and descriptions
+type TranslationHandler struct {
+	translationService service.TranslationService
+}
+
+// NewTranslationHandler creates a new translation handler instance
+func NewTranslationHandler(translationService service.TranslationService) *TranslationHandler {
+	return &TranslationHandler{
+		translationService: translationService,
+	}
+}
+
+// SaveTranslation handles PUT /api/v1/{entityType}/:id/translations/:locale
+// @Summary Translate an entity into a locale
+// @Description Create or replace the translation of an entity's title and description into a locale such as ru or pt-BR. Requests preferring the locale with Accept-Language get the entity in it. Acceptance criteria only have a description to translate; a translation without a description keeps the original one.
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param locale path string true "Locale of the translation" example(ru)
+// @Param translation body service.SaveTranslationRequest true "Translated text"
+// @Success 200 {object} models.EntityTranslation "Translation saved"
+// @Failure 400 {object} map[string]interface{} "Invalid request body, locale or missing title"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "User or Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/translations/{locale} [put]
+// @Router /api/v1/user-stories/{id}/translations/{locale} [put]
+// @Router /api/v1/acceptance-criteria/{id}/translations/{locale} [put]
+// @Router /api/v1/requirements/{id}/translations/{locale} [put]
+func (h *TranslationHandler) SaveTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, userID, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	var req service.SaveTranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	translation, err := h.translationService.SaveTranslation(ctx, entityType, c.Param("id"), c.Param("locale"), req, userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to save translation")
+		return
+	}
+
+	c.JSON(http.StatusOK, translation)
+}
+
+// GetTranslation handles GET /api/v1/{entityType}/:id/translations/:locale
+// @Summary Get the translation of an entity
+// @Description Retrieve the translation of an entity's title and description into a locale
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param locale path string true "Locale of the translation" example(ru)
+// @Success 200 {object} models.EntityTranslation "Translation found"
+// @Failure 400 {object} map[string]interface{} "Invalid locale"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity or translation not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/translations/{locale} [get]
+// @Router /api/v1/user-stories/{id}/translations/{locale} [get]
+// @Router /api/v1/acceptance-criteria/{id}/translations/{locale} [get]
+// @Router /api/v1/requirements/{id}/translations/{locale} [get]
+func (h *TranslationHandler) GetTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	translation, err := h.translationService.GetTranslation(ctx, entityType, c.Param("id"), c.Param("locale"))
+	if err != nil {
+		respondWithError(c, err, "Failed to get translation")
+		return
+	}
+
+	c.JSON(http.StatusOK, translation)
+}
+
+// ListTranslations handles GET /api/v1/{entityType}/:id/translations
+// @Summary List the translations of an entity
+// @Description Retrieve every translation of an entity ordered by locale
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Success 200 {object} TranslationListResponse "Translations of the entity"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "Entity not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/translations [get]
+// @Router /api/v1/user-stories/{id}/translations [get]
+// @Router /api/v1/acceptance-criteria/{id}/translations [get]
+// @Router /api/v1/requirements/{id}/translations [get]
+func (h *TranslationHandler) ListTranslations(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	translations, err := h.translationService.ListTranslations(ctx, entityType, c.Param("id"))
+	if err != nil {
+		respondWithError(c, err, "Failed to list translations")
+		return
+	}
+
+	SendListResponse(c, translations, int64(len(translations)), len(translations), 0)
+}
+
+// DeleteTranslation handles DELETE /api/v1/{entityType}/:id/translations/:locale
+// @Summary Delete the translation of an entity
+// @Description Remove the translation of an entity into a locale; requests preferring the locale then get the original text
+// @Tags translations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Entity UUID or reference ID"
+// @Param locale path string true "Locale of the translation" example(ru)
+// @Success 204 "Translation deleted"
+// @Failure 400 {object} map[string]interface{} "Invalid locale"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 403 {object} map[string]interface{} "User or Administrator role required"
+// @Failure 404 {object} map[string]interface{} "Entity or translation not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/epics/{id}/translations/{locale} [delete]
+// @Router /api/v1/user-stories/{id}/translations/{locale} [delete]
+// @Router /api/v1/acceptance-criteria/{id}/translations/{locale} [delete]
+// @Router /api/v1/requirements/{id}/translations/{locale} [delete]
+func (h *TranslationHandler) DeleteTranslation(c *gin.Context) {
+	ctx := c.Request.Context()
+	entityType, _, ok := parseEntityRequestContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.translationService.DeleteTranslation(ctx, entityType, c.Param("id"), c.Param("locale")); err != nil {
+		respondWithError(c, err, "Failed to delete translation")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetCompletenessReport handles GET /api/v1/reports/translation-completeness
+// @Summary Translation completeness report
+// @Description Count the epics, user stories, acceptance criteria and requirements that are translated, partially translated (title or description missing) and untranslated per locale. Without a locale the report covers the locales in TRANSLATION_LOCALES and every other locale entities were translated into. Archived epics and user stories are left out.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param locale query string false "Only this locale" example(ru)
+// @Success 200 {object} TranslationCompletenessResponse "Completeness per locale and entity type"
+// @Failure 400 {object} map[string]interface{} "Invalid locale"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/reports/translation-completeness [get]
+func (h *TranslationHandler) GetCompletenessReport(c *gin.Context) {
+	ctx := c.Request.Context()
+	report, err := h.translationService.GetCompletenessReport(ctx, c.Query("locale"))
+	if err != nil {
+		respondWithError(c, err, "Failed to get translation completeness report")
+		return
+	}
+
+	SendListResponse(c, report, int64(len(report)), len(report), 0)
+}
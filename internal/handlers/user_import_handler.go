@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// UserImportHandler handles HTTP requests for importing users in bulk
+type UserImportHandler struct {
+	userImportService service.UserImportService
+}
+
+// NewUserImportHandler creates a new user import handler instance
+func NewUserImportHandler(userImportService service.UserImportService) *UserImportHandler {
+	return &UserImportHandler{
+		userImportService: userImportService,
+	}
+}
+
+// ImportUsers handles POST /auth/users/import
+// @Summary Import users from CSV
+// @Description Create user accounts in bulk from a CSV file (Administrator role required). The header names the columns: username and email are required, role and team are optional. Roles are matched by name in any case; other values can be mapped to roles with role_map, and rows without a role get the default role. Every created user gets a temporary password, emailed to them in the background or returned in the results; each returned password is recorded in the audit log of its user. Each row is validated and created on its own, so valid rows are imported even when other rows fail; the results report every row. At most 1000 rows can be imported at once.
+// @Tags authentication
+// @Accept text/csv
+// @Produce json
+// @Security BearerAuth
+// @Param delivery query string false "How temporary passwords reach the users; emailed passwords are never returned" Enums(email, response) default(email)
+// @Param default_role query string false "Role of rows without one" Enums(Administrator, User, Commenter) default(User)
+// @Param role_map query []string false "Role column value mapped to a role as value:role, e.g. Engineer:User; repeat for each value" collectionFormat(multi)
+// @Param users body string true "CSV file of users"
+// @Success 200 {object} service.UserImport "Result of every row"
+// @Failure 400 {object} ErrorResponse "Malformed CSV, too many rows or invalid options"
+// @Failure 401 {object} ErrorResponse "Authentication required"
+// @Failure 403 {object} ErrorResponse "Administrator role required"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /auth/users/import [post]
+func (h *UserImportHandler) ImportUsers(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	content, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: ErrorDetail{
+				Code:    "VALIDATION_ERROR",
+				Message: "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	var roleMap map[string]models.UserRole
+	for _, mapping := range c.QueryArray("role_map") {
+		value, role, found := strings.Cut(mapping, ":")
+		if !found || strings.TrimSpace(value) == "" {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: ErrorDetail{
+					Code:    "VALIDATION_ERROR",
+					Message: "Invalid role_map parameter, expected value:role",
+				},
+			})
+			return
+		}
+		if roleMap == nil {
+			roleMap = make(map[string]models.UserRole)
+		}
+		roleMap[value] = models.UserRole(strings.TrimSpace(role))
+	}
+
+	imported, err := h.userImportService.ImportUsers(ctx, service.ImportUsersRequest{
+		Content:     content,
+		Delivery:    c.Query("delivery"),
+		ActorID:     userID,
+		DefaultRole: models.UserRole(c.Query("default_role")),
+		RoleMap:     roleMap,
+	})
+	if err != nil {
+		respondWithError(c, err, "Failed to import users")
+		return
+	}
+
+	c.JSON(http.StatusOK, imported)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// UserPreferenceHandler handles HTTP requests for the client preferences of users
+type UserPreferenceHandler struct {
+	preferenceService service.UserPreferenceService
+}
+
+// NewUserPreferenceHandler creates a new user preference handler instance
+func NewUserPreferenceHandler(preferenceService service.UserPreferenceService) *UserPreferenceHandler {
+	return &UserPreferenceHandler{
+		preferenceService: preferenceService,
+	}
+}
+
+// GetPreferences handles GET /api/v1/users/me/preferences
+// @Summary Get the current user's client preferences
+// @Description Retrieve the settings clients such as the web UI, the CLI or MCP clients stored for the current user, as a JSON object per namespace. Use the namespace parameter to retrieve only some namespaces.
+// @Tags preferences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param namespace query []string false "Namespaces to retrieve, repeated or comma-separated (default: all)" collectionFormat(multi)
+// @Success 200 {object} service.UserPreferences "Preferences by namespace"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/preferences [get]
+func (h *UserPreferenceHandler) GetPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var namespaces []string
+	for _, param := range c.QueryArray("namespace") {
+		for _, namespace := range strings.Split(param, ",") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+	}
+
+	preferences, err := h.preferenceService.GetPreferences(ctx, userID, namespaces)
+	if err != nil {
+		respondWithError(c, err, "Failed to get preferences")
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// UpdatePreferences handles PUT /api/v1/users/me/preferences
+// @Summary Save the current user's client preferences
+// @Description Save settings as a JSON object per namespace, such as web.board or cli. Namespaces left out of the request are kept and a null value removes a namespace. A namespace holds up to 16 KiB and a user up to 50 namespaces and 64 KiB in total.
+// @Tags preferences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param preferences body service.UpdateUserPreferencesRequest true "Preferences by namespace"
+// @Success 200 {object} service.UserPreferences "All preferences of the user after the update"
+// @Failure 400 {object} map[string]interface{} "Invalid namespace or value, or size limit exceeded"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/users/me/preferences [put]
+func (h *UserPreferenceHandler) UpdatePreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req service.UpdateUserPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": "Invalid request body: " + err.Error(),
+			},
+		})
+		return
+	}
+
+	preferences, err := h.preferenceService.UpdatePreferences(ctx, userID, req)
+	if err != nil {
+		respondWithError(c, err, "Failed to update preferences")
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
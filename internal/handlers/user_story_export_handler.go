@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/service"
+)
+
+// UserStoryExportHandler handles HTTP requests for user story exports
+type UserStoryExportHandler struct {
+	exportService service.UserStoryExportService
+}
+
+// NewUserStoryExportHandler creates a new user story export handler instance
+func NewUserStoryExportHandler(exportService service.UserStoryExportService) *UserStoryExportHandler {
+	return &UserStoryExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportUserStory handles GET /api/v1/user-stories/:id/export
+// @Summary Export a user story as a Gherkin feature file
+// @Description Render a user story as a Gherkin .feature file to bootstrap automated tests. Each acceptance criteria becomes a scenario tagged with its reference ID; its EARS clauses become the steps: WHILE and WHERE clauses Given steps, WHEN and IF clauses When steps, and the SHALL response a Then step. Criteria not written in EARS become a single Then step marked for review.
+// @Tags user-stories
+// @Accept json
+// @Produce text/plain
+// @Security BearerAuth
+// @Param id path string true "User story ID (UUID or reference ID like US-001)"
+// @Param format query string false "Document format" Enums(gherkin) default(gherkin)
+// @Success 200 {string} string "Gherkin feature file"
+// @Failure 400 {object} map[string]interface{} "Invalid format"
+// @Failure 401 {object} map[string]interface{} "Authentication required"
+// @Failure 404 {object} map[string]interface{} "User story not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /api/v1/user-stories/{id}/export [get]
+func (h *UserStoryExportHandler) ExportUserStory(c *gin.Context) {
+	ctx := c.Request.Context()
+	export, err := h.exportService.ExportUserStory(ctx, c.Param("id"), c.DefaultQuery("format", service.UserStoryExportFormatGherkin))
+	if err != nil {
+		respondWithError(c, err, "Failed to export user story")
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="`+export.Name+`.`+export.Extension+`"`)
+	c.Data(http.StatusOK, export.ContentType, []byte(export.Content))
+}
@@ -16,7 +16,11 @@ import (
 
 // UserStoryHandler handles HTTP requests for user story operations
 type UserStoryHandler struct {
-	userStoryService service.UserStoryService
+	userStoryService   service.UserStoryService
+	favoriteService    service.FavoriteService
+	warningService     service.ValidationWarningService
+	countService       service.EntityCountService
+	translationService service.TranslationService
 }
 
 // NewUserStoryHandler creates a new user story handler instance
@@ -26,6 +30,26 @@ func NewUserStoryHandler(userStoryService service.UserStoryService) *UserStoryHa
 	}
 }
 
+// SetFavoriteService enables the is_favorite flag (include=is_favorite) on ListUserStories
+func (h *UserStoryHandler) SetFavoriteService(favoriteService service.FavoriteService) {
+	h.favoriteService = favoriteService
+}
+
+// SetCountService enables aggregate counts (include=comments_count and similar) on GetUserStory and ListUserStories
+func (h *UserStoryHandler) SetCountService(countService service.EntityCountService) {
+	h.countService = countService
+}
+
+// SetTranslationService enables translated titles and descriptions, chosen with Accept-Language, on GetUserStory and ListUserStories
+func (h *UserStoryHandler) SetTranslationService(translationService service.TranslationService) {
+	h.translationService = translationService
+}
+
+// SetWarningService enables validation warnings (a warnings array) on user story create and update responses
+func (h *UserStoryHandler) SetWarningService(warningService service.ValidationWarningService) {
+	h.warningService = warningService
+}
+
 // CreateUserStory handles POST /api/v1/user-stories
 // @Summary Create a new user story
 // @Description Create a new user story with the provided details. The epic_id must be specified in the request body to establish the parent-child relationship. The user story description should follow the template format: 'As [role], I want [function], so that [goal]'.
@@ -34,12 +58,14 @@ func NewUserStoryHandler(userStoryService service.UserStoryService) *UserStoryHa
 // @Produce json
 // @Security BearerAuth
 // @Param user_story body service.CreateUserStoryRequest true "User story creation request"
-// @Success 201 {object} models.UserStory "Successfully created user story"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 201 {object} models.UserStory "Successfully created user story; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid request body, epic_id required, creator/assignee not found, epic not found, invalid priority, or invalid user story template"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories [post]
 func (h *UserStoryHandler) CreateUserStory(c *gin.Context) {
+	ctx := c.Request.Context()
 	var req service.CreateUserStoryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -69,7 +95,7 @@ func (h *UserStoryHandler) CreateUserStory(c *gin.Context) {
 	// Set the creator ID from the authenticated user
 	req.CreatorID = uuid.MustParse(creatorID)
 
-	userStory, err := h.userStoryService.CreateUserStory(req)
+	userStory, err := h.userStoryService.CreateUserStory(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserNotFound):
@@ -96,7 +122,7 @@ func (h *UserStoryHandler) CreateUserStory(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, userStory)
+	respondWithWarnings(c, http.StatusCreated, h.warningService, models.EntityTypeUserStory, userStory.ID, userStory)
 }
 
 // CreateUserStoryInEpic handles POST /api/v1/epics/:id/user-stories
@@ -108,12 +134,14 @@ func (h *UserStoryHandler) CreateUserStory(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path string true "Epic UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param user_story body service.CreateUserStoryRequest true "User story creation request (epic_id will be overridden by path parameter)"
-// @Success 201 {object} models.UserStory "Successfully created user story within epic"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 201 {object} models.UserStory "Successfully created user story within epic; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid epic ID format, request body, creator/assignee not found, epic not found, invalid priority, or invalid user story template"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/epics/{id}/user-stories [post]
 func (h *UserStoryHandler) CreateUserStoryInEpic(c *gin.Context) {
+	ctx := c.Request.Context()
 	epicIDParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -151,7 +179,7 @@ func (h *UserStoryHandler) CreateUserStoryInEpic(c *gin.Context) {
 	req.CreatorID = uuid.MustParse(creatorID)
 	req.EpicID = epicID
 
-	userStory, err := h.userStoryService.CreateUserStory(req)
+	userStory, err := h.userStoryService.CreateUserStory(ctx, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserNotFound):
@@ -178,23 +206,28 @@ func (h *UserStoryHandler) CreateUserStoryInEpic(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, userStory)
+	respondWithWarnings(c, http.StatusCreated, h.warningService, models.EntityTypeUserStory, userStory.ID, userStory)
 }
 
 // GetUserStory handles GET /api/v1/user-stories/:id
 // @Summary Get a user story by ID or reference ID
-// @Description Retrieve a specific user story by its UUID or human-readable reference ID (e.g., US-001). Returns the user story with basic information excluding related entities.
+// @Description Retrieve a specific user story by its UUID or human-readable reference ID (e.g., US-001). Returns the user story with basic information excluding related entities. A retired reference ID answers 301 Moved Permanently with the successor in the Location header.
 // @Tags user-stories
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "User story UUID or reference ID" example("123e4567-e89b-12d3-a456-426614174000") example("US-001")
+// @Param include query string false "Aggregate counts to embed (comma-separated): comments_count, unresolved_comments_count, acceptance_criteria_count, requirements_count" example("comments_count,requirements_count")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
 // @Success 200 {object} models.UserStory "Successfully retrieved user story"
+// @Success 301 {object} ReferenceMovedResponse "Retired reference ID; the Location header points to the successor"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 404 {object} map[string]interface{} "User story not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories/{id} [get]
 func (h *UserStoryHandler) GetUserStory(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Try to parse as UUID first, then as reference ID
@@ -202,9 +235,9 @@ func (h *UserStoryHandler) GetUserStory(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		userStory, err = h.userStoryService.GetUserStoryByID(id)
+		userStory, err = h.userStoryService.GetUserStoryByID(ctx, id)
 	} else {
-		userStory, err = h.userStoryService.GetUserStoryByReferenceID(idParam)
+		userStory, err = h.userStoryService.GetUserStoryByReferenceID(ctx, idParam)
 	}
 
 	if err != nil {
@@ -220,7 +253,31 @@ func (h *UserStoryHandler) GetUserStory(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, userStory)
+	if redirectRetiredReference(c, idParam, userStory.ReferenceID, models.EntityTypeUserStory, userStory.ID) {
+		return
+	}
+
+	if err := localizeEntities(c, h.translationService, models.EntityTypeUserStory, []*models.UserStory{userStory}, func(e *models.UserStory) uuid.UUID { return e.ID }, translateUserStory); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get user story",
+		})
+		return
+	}
+
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, service.CompactUserStory(userStory))
+		return
+	}
+
+	response, err := includeEntityFields(c, h.countService, models.EntityTypeUserStory, userStory.ID, userStory)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to get user story",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // UpdateUserStory handles PUT /api/v1/user-stories/:id
@@ -232,13 +289,15 @@ func (h *UserStoryHandler) GetUserStory(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path string true "User story UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174000")
 // @Param user_story body service.UpdateUserStoryRequest true "User story update request"
-// @Success 200 {object} models.UserStory "Successfully updated user story"
+// @Param X-Suppress-Warnings header bool false "Leave validation warnings out of the response"
+// @Success 200 {object} models.UserStory "Successfully updated user story; includes validation warnings unless suppressed"
 // @Failure 400 {object} map[string]interface{} "Invalid user story ID format, request body, assignee not found, invalid priority, invalid status, invalid status transition, or invalid user story template"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 404 {object} map[string]interface{} "User story not found"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories/{id} [put]
 func (h *UserStoryHandler) UpdateUserStory(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for updates)
@@ -259,7 +318,7 @@ func (h *UserStoryHandler) UpdateUserStory(c *gin.Context) {
 		return
 	}
 
-	userStory, err := h.userStoryService.UpdateUserStory(id, req)
+	userStory, err := h.userStoryService.UpdateUserStory(ctx, id, req)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserStoryNotFound):
@@ -294,7 +353,7 @@ func (h *UserStoryHandler) UpdateUserStory(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, userStory)
+	respondWithWarnings(c, http.StatusOK, h.warningService, models.EntityTypeUserStory, userStory.ID, userStory)
 }
 
 // DeleteUserStory handles DELETE /api/v1/user-stories/:id
@@ -314,6 +373,7 @@ func (h *UserStoryHandler) UpdateUserStory(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories/{id} [delete]
 func (h *UserStoryHandler) DeleteUserStory(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for deletes)
@@ -328,7 +388,7 @@ func (h *UserStoryHandler) DeleteUserStory(c *gin.Context) {
 	// Check for force parameter
 	force := c.Query("force") == "true"
 
-	err = h.userStoryService.DeleteUserStory(id, force)
+	err = h.userStoryService.DeleteUserStory(ctx, id, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserStoryNotFound):
@@ -363,15 +423,21 @@ func (h *UserStoryHandler) DeleteUserStory(c *gin.Context) {
 // @Param assignee_id query string false "Filter by assignee UUID" format(uuid) example("123e4567-e89b-12d3-a456-426614174002")
 // @Param status query string false "Filter by user story status" Enums(Backlog,Draft,In Progress,Done,Cancelled) example("Backlog")
 // @Param priority query integer false "Filter by priority level" minimum(1) maximum(4) example(2)
-// @Param include query string false "Include related entities (comma-separated)" example("epic,creator,assignee") example("acceptance_criteria,requirements,comments")
+// @Param include_archived query boolean false "Include archived user stories" default(false) example(true)
+// @Param include query string false "Include related entities (comma-separated); is_favorite adds the current user's favorite flag; comments_count, unresolved_comments_count, acceptance_criteria_count and requirements_count add aggregate counts; comment_counts adds a {total, unresolved} object" example("epic,creator,assignee") example("acceptance_criteria,requirements,comments,is_favorite")
+// @Param format query string false "Return compact, token-efficient entities keyed by reference ID: no UUIDs, timestamps or nulls, and descriptions truncated to 200 characters" Enums(compact)
 // @Param order_by query string false "Sort order for results" example("created_at DESC") example("priority ASC") example("title ASC")
+// @Param sort query string false "Comma-separated sort keys field:asc or field:desc, taking precedence over order_by; ties are broken by ID. Fields: reference_id, title, priority, status, due_date, created_at, updated_at" example("priority:asc,updated_at:desc")
 // @Param limit query integer false "Maximum number of results to return" minimum(1) maximum(100) default(50) example(20)
 // @Param offset query integer false "Number of results to skip for pagination" minimum(0) default(0) example(0)
+// @Param Accept-Language header string false "Preferred locales, such as ru, en;q=0.5; titles and descriptions are returned in the preferred translation when one exists, with the locale in Content-Language"
 // @Success 200 {object} map[string]interface{} "Successfully retrieved user stories list with pagination info"
+// @Failure 400 {object} ErrorResponse "Invalid sort keys"
 // @Failure 401 {object} map[string]interface{} "Authentication required"
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories [get]
 func (h *UserStoryHandler) ListUserStories(c *gin.Context) {
+	ctx := c.Request.Context()
 	var filters service.UserStoryFilters
 
 	// Parse query parameters
@@ -405,6 +471,8 @@ func (h *UserStoryHandler) ListUserStories(c *gin.Context) {
 		}
 	}
 
+	filters.IncludeArchived = c.Query("include_archived") == "true"
+
 	if include := c.Query("include"); include != "" {
 		// Split comma-separated includes and trim whitespace
 		includes := make([]string, 0)
@@ -420,6 +488,7 @@ func (h *UserStoryHandler) ListUserStories(c *gin.Context) {
 	if orderBy := c.Query("order_by"); orderBy != "" {
 		filters.OrderBy = orderBy
 	}
+	filters.Sort = c.Query("sort")
 
 	if limit := c.Query("limit"); limit != "" {
 		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
@@ -433,8 +502,19 @@ func (h *UserStoryHandler) ListUserStories(c *gin.Context) {
 		}
 	}
 
-	userStories, totalCount, err := h.userStoryService.ListUserStories(filters)
+	userStories, totalCount, err := h.userStoryService.ListUserStories(ctx, filters)
 	if err != nil {
+		respondWithError(c, err, "Failed to list user stories")
+		return
+	}
+
+	// Set default limit if not specified
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+
+	if err := localizeEntities(c, h.translationService, models.EntityTypeUserStory, entityPointers(userStories), func(e *models.UserStory) uuid.UUID { return e.ID }, translateUserStory); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": gin.H{
 				"code":    "INTERNAL_ERROR",
@@ -444,14 +524,30 @@ func (h *UserStoryHandler) ListUserStories(c *gin.Context) {
 		return
 	}
 
-	// Set default limit if not specified
-	limit := 50
-	if filters.Limit > 0 {
-		limit = filters.Limit
+	if compactFormatRequested(c) {
+		c.JSON(http.StatusOK, gin.H{
+			"data":        service.CompactUserStories(userStories),
+			"total_count": totalCount,
+			"limit":       limit,
+			"offset":      filters.Offset,
+		})
+		return
+	}
+
+	data, err := includeListFields(c, h.favoriteService, h.countService, models.EntityTypeUserStory, userStories, func(us *models.UserStory) uuid.UUID { return us.ID },
+		commentCountFields(h.countService, models.EntityTypeUserStory))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to list user stories",
+			},
+		})
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"data":        userStories,
+		"data":        data,
 		"total_count": totalCount,
 		"limit":       limit,
 		"offset":      filters.Offset,
@@ -472,6 +568,7 @@ func (h *UserStoryHandler) ListUserStories(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories/{id}/acceptance-criteria [get]
 func (h *UserStoryHandler) GetUserStoryWithAcceptanceCriteria(c *gin.Context) {
+	ctx := c.Request.Context()
 	// TODO: should accept reference_id like US-001
 	idParam := c.Param("id")
 
@@ -480,11 +577,11 @@ func (h *UserStoryHandler) GetUserStoryWithAcceptanceCriteria(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		userStory, err = h.userStoryService.GetUserStoryWithAcceptanceCriteria(id)
+		userStory, err = h.userStoryService.GetUserStoryWithAcceptanceCriteria(ctx, id)
 	} else {
 		// For reference ID, first get the user story, then get with acceptance criteria
-		if tempUserStory, tempErr := h.userStoryService.GetUserStoryByReferenceID(idParam); tempErr == nil {
-			userStory, err = h.userStoryService.GetUserStoryWithAcceptanceCriteria(tempUserStory.ID)
+		if tempUserStory, tempErr := h.userStoryService.GetUserStoryByReferenceID(ctx, idParam); tempErr == nil {
+			userStory, err = h.userStoryService.GetUserStoryWithAcceptanceCriteria(ctx, tempUserStory.ID)
 		} else {
 			err = tempErr
 		}
@@ -520,6 +617,7 @@ func (h *UserStoryHandler) GetUserStoryWithAcceptanceCriteria(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories/{id}/requirements [get]
 func (h *UserStoryHandler) GetUserStoryWithRequirements(c *gin.Context) {
+	ctx := c.Request.Context()
 	// TODO: should accept reference_id like US-001
 	idParam := c.Param("id")
 
@@ -528,11 +626,11 @@ func (h *UserStoryHandler) GetUserStoryWithRequirements(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		userStory, err = h.userStoryService.GetUserStoryWithRequirements(id)
+		userStory, err = h.userStoryService.GetUserStoryWithRequirements(ctx, id)
 	} else {
 		// For reference ID, first get the user story, then get with requirements
-		if tempUserStory, tempErr := h.userStoryService.GetUserStoryByReferenceID(idParam); tempErr == nil {
-			userStory, err = h.userStoryService.GetUserStoryWithRequirements(tempUserStory.ID)
+		if tempUserStory, tempErr := h.userStoryService.GetUserStoryByReferenceID(ctx, idParam); tempErr == nil {
+			userStory, err = h.userStoryService.GetUserStoryWithRequirements(ctx, tempUserStory.ID)
 		} else {
 			err = tempErr
 		}
@@ -570,6 +668,7 @@ func (h *UserStoryHandler) GetUserStoryWithRequirements(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories/{id}/status [patch]
 func (h *UserStoryHandler) ChangeUserStoryStatus(c *gin.Context) {
+	ctx := c.Request.Context()
 	// TODO: should accept reference_id like US-001
 	idParam := c.Param("id")
 
@@ -577,10 +676,10 @@ func (h *UserStoryHandler) ChangeUserStoryStatus(c *gin.Context) {
 	var err error
 
 	if id, parseErr := uuid.Parse(idParam); parseErr == nil {
-		userStory, err = h.userStoryService.GetUserStoryByID(id)
+		userStory, err = h.userStoryService.GetUserStoryByID(ctx, id)
 	} else {
 		// For reference ID, first get the user story, then get with requirements
-		userStory, err = h.userStoryService.GetUserStoryByReferenceID(idParam)
+		userStory, err = h.userStoryService.GetUserStoryByReferenceID(ctx, idParam)
 	}
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -601,7 +700,7 @@ func (h *UserStoryHandler) ChangeUserStoryStatus(c *gin.Context) {
 		return
 	}
 
-	userStory, err = h.userStoryService.ChangeUserStoryStatus(userStory.ID, req.Status)
+	userStory, err = h.userStoryService.ChangeUserStoryStatus(ctx, userStory.ID, req.Status)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserStoryNotFound):
@@ -643,6 +742,7 @@ func (h *UserStoryHandler) ChangeUserStoryStatus(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{} "Internal server error"
 // @Router /api/v1/user-stories/{id}/assign [patch]
 func (h *UserStoryHandler) AssignUserStory(c *gin.Context) {
+	ctx := c.Request.Context()
 	idParam := c.Param("id")
 
 	// Parse ID (UUID only for assignments)
@@ -666,7 +766,7 @@ func (h *UserStoryHandler) AssignUserStory(c *gin.Context) {
 		return
 	}
 
-	userStory, err := h.userStoryService.AssignUserStory(id, req.AssigneeID)
+	userStory, err := h.userStoryService.AssignUserStory(ctx, id, req.AssigneeID)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrUserStoryNotFound):
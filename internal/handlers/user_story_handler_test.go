@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -24,7 +25,7 @@ type MockUserStoryService struct {
 	mock.Mock
 }
 
-func (m *MockUserStoryService) CreateUserStory(req service.CreateUserStoryRequest) (*models.UserStory, error) {
+func (m *MockUserStoryService) CreateUserStory(ctx context.Context, req service.CreateUserStoryRequest) (*models.UserStory, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -32,7 +33,7 @@ func (m *MockUserStoryService) CreateUserStory(req service.CreateUserStoryReques
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) GetUserStoryByID(id uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryService) GetUserStoryByID(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,7 +41,7 @@ func (m *MockUserStoryService) GetUserStoryByID(id uuid.UUID) (*models.UserStory
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) GetUserStoryByReferenceID(referenceID string) (*models.UserStory, error) {
+func (m *MockUserStoryService) GetUserStoryByReferenceID(ctx context.Context, referenceID string) (*models.UserStory, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -48,7 +49,7 @@ func (m *MockUserStoryService) GetUserStoryByReferenceID(referenceID string) (*m
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) UpdateUserStory(id uuid.UUID, req service.UpdateUserStoryRequest) (*models.UserStory, error) {
+func (m *MockUserStoryService) UpdateUserStory(ctx context.Context, id uuid.UUID, req service.UpdateUserStoryRequest) (*models.UserStory, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -56,17 +57,17 @@ func (m *MockUserStoryService) UpdateUserStory(id uuid.UUID, req service.UpdateU
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) DeleteUserStory(id uuid.UUID, force bool) error {
+func (m *MockUserStoryService) DeleteUserStory(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockUserStoryService) ListUserStories(filters service.UserStoryFilters) ([]models.UserStory, int64, error) {
+func (m *MockUserStoryService) ListUserStories(ctx context.Context, filters service.UserStoryFilters) ([]models.UserStory, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.UserStory), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockUserStoryService) GetUserStoryWithAcceptanceCriteria(id uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryService) GetUserStoryWithAcceptanceCriteria(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -74,7 +75,7 @@ func (m *MockUserStoryService) GetUserStoryWithAcceptanceCriteria(id uuid.UUID)
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) GetUserStoryWithRequirements(id uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryService) GetUserStoryWithRequirements(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -82,12 +83,12 @@ func (m *MockUserStoryService) GetUserStoryWithRequirements(id uuid.UUID) (*mode
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) GetUserStoriesByEpic(epicID uuid.UUID) ([]models.UserStory, error) {
+func (m *MockUserStoryService) GetUserStoriesByEpic(ctx context.Context, epicID uuid.UUID) ([]models.UserStory, error) {
 	args := m.Called(epicID)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) ChangeUserStoryStatus(id uuid.UUID, newStatus models.UserStoryStatus) (*models.UserStory, error) {
+func (m *MockUserStoryService) ChangeUserStoryStatus(ctx context.Context, id uuid.UUID, newStatus models.UserStoryStatus) (*models.UserStory, error) {
 	args := m.Called(id, newStatus)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -95,7 +96,7 @@ func (m *MockUserStoryService) ChangeUserStoryStatus(id uuid.UUID, newStatus mod
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) AssignUserStory(id uuid.UUID, assigneeID uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryService) AssignUserStory(ctx context.Context, id uuid.UUID, assigneeID uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id, assigneeID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -103,7 +104,7 @@ func (m *MockUserStoryService) AssignUserStory(id uuid.UUID, assigneeID uuid.UUI
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryService) GetUUIDByReferenceID(referenceID string) (uuid.UUID, error) {
+func (m *MockUserStoryService) GetUUIDByReferenceID(ctx context.Context, referenceID string) (uuid.UUID, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(uuid.UUID), args.Error(1)
 }
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// SuppressWarningsHeader is the request header that leaves validation warnings out of create and update responses
+const SuppressWarningsHeader = "X-Suppress-Warnings"
+
+// respondWithWarnings writes a saved entity, adding its validation warnings as a warnings array
+// unless no warning service is configured or the client sent X-Suppress-Warnings: true
+func respondWithWarnings(c *gin.Context, status int, warningService service.ValidationWarningService,
+	entityType models.EntityType, id uuid.UUID, entity json.Marshaler) {
+	ctx := c.Request.Context()
+	if warningService == nil || warningsSuppressed(c) {
+		c.JSON(status, entity)
+		return
+	}
+
+	warnings, err := warningService.Check(ctx, entityType, id)
+	if err != nil {
+		// Warnings are hints; failing to compute them must not fail a request that already succeeded
+		c.JSON(status, entity)
+		return
+	}
+	c.JSON(status, service.EntityWithWarnings{Entity: entity, Warnings: warnings})
+}
+
+// warningsSuppressed reports whether the client asked to leave validation warnings out of the response
+func warningsSuppressed(c *gin.Context) bool {
+	suppressed, _ := strconv.ParseBool(c.GetHeader(SuppressWarningsHeader))
+	return suppressed
+}
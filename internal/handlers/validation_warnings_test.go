@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// stubWarningService returns fixed validation warnings
+type stubWarningService struct {
+	warnings []service.ValidationWarning
+	calls    int
+}
+
+func (s *stubWarningService) Check(ctx context.Context, entityType models.EntityType, id uuid.UUID) ([]service.ValidationWarning, error) {
+	s.calls++
+	return s.warnings, nil
+}
+
+func TestRespondWithWarnings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Accounts"}
+	warningService := &stubWarningService{warnings: []service.ValidationWarning{
+		{Code: service.WarningCodeShortDescription, Field: "description", Message: "Description is missing"},
+	}}
+
+	respond := func(suppress string) map[string]interface{} {
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/epics", nil)
+		if suppress != "" {
+			c.Request.Header.Set(SuppressWarningsHeader, suppress)
+		}
+		respondWithWarnings(c, http.StatusCreated, warningService, models.EntityTypeEpic, epic.ID, epic)
+		require.Equal(t, http.StatusCreated, recorder.Code)
+
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+		return body
+	}
+
+	body := respond("")
+	assert.Equal(t, "EP-001", body["reference_id"])
+	assert.Len(t, body["warnings"], 1)
+
+	body = respond("true")
+	assert.Equal(t, "EP-001", body["reference_id"])
+	assert.NotContains(t, body, "warnings")
+	assert.Equal(t, 1, warningService.calls)
+}
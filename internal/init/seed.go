@@ -0,0 +1,574 @@
+package init
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/models"
+)
+
+// SeedBundle describes organization-specific configuration and demo data loaded during initialization
+// instead of (or on top of) the defaults created by migrations
+type SeedBundle struct {
+	RequirementTypes  []SeedNamedItem    `json:"requirement_types" yaml:"requirement_types"`
+	RelationshipTypes []SeedNamedItem    `json:"relationship_types" yaml:"relationship_types"`
+	StatusModels      []SeedStatusModel  `json:"status_models" yaml:"status_models"`
+	Templates         []SeedTemplate     `json:"templates" yaml:"templates"`
+	DemoWorkspace     *SeedDemoWorkspace `json:"demo_workspace" yaml:"demo_workspace"`
+}
+
+// SeedNamedItem is a requirement type or relationship type
+type SeedNamedItem struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// SeedStatusModel is a status model with its statuses and transitions
+// Statuses are ordered as listed; transitions reference statuses by name
+type SeedStatusModel struct {
+	EntityType  models.EntityType `json:"entity_type" yaml:"entity_type"`
+	Name        string            `json:"name" yaml:"name"`
+	Description string            `json:"description" yaml:"description"`
+	IsDefault   bool              `json:"is_default" yaml:"is_default"`
+	Statuses    []SeedStatus      `json:"statuses" yaml:"statuses"`
+	Transitions []SeedTransition  `json:"transitions" yaml:"transitions"`
+}
+
+// SeedStatus is a single status of a status model
+type SeedStatus struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	Color       string `json:"color" yaml:"color"`
+	IsInitial   bool   `json:"is_initial" yaml:"is_initial"`
+	IsFinal     bool   `json:"is_final" yaml:"is_final"`
+}
+
+// SeedTransition is an allowed transition between two statuses of a status model
+type SeedTransition struct {
+	From string `json:"from" yaml:"from"`
+	To   string `json:"to" yaml:"to"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// SeedTemplate is a prompt template
+type SeedTemplate struct {
+	Name        string         `json:"name" yaml:"name"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Content     string         `json:"content" yaml:"content"`
+	Role        models.MCPRole `json:"role" yaml:"role"`
+	IsActive    bool           `json:"is_active" yaml:"is_active"`
+}
+
+// SeedDemoWorkspace is sample content created and assigned to the admin user
+type SeedDemoWorkspace struct {
+	Epics []SeedEpic `json:"epics" yaml:"epics"`
+}
+
+// SeedEpic is a demo epic with its user stories
+type SeedEpic struct {
+	Title       string          `json:"title" yaml:"title"`
+	Description string          `json:"description" yaml:"description"`
+	Priority    models.Priority `json:"priority" yaml:"priority"`
+	UserStories []SeedUserStory `json:"user_stories" yaml:"user_stories"`
+}
+
+// SeedUserStory is a demo user story with its acceptance criteria and requirements
+type SeedUserStory struct {
+	Title              string            `json:"title" yaml:"title"`
+	Description        string            `json:"description" yaml:"description"`
+	Priority           models.Priority   `json:"priority" yaml:"priority"`
+	AcceptanceCriteria []string          `json:"acceptance_criteria" yaml:"acceptance_criteria"`
+	Requirements       []SeedRequirement `json:"requirements" yaml:"requirements"`
+}
+
+// SeedRequirement is a demo requirement; Type is the name of a requirement type
+type SeedRequirement struct {
+	Title       string          `json:"title" yaml:"title"`
+	Description string          `json:"description" yaml:"description"`
+	Type        string          `json:"type" yaml:"type"`
+	Priority    models.Priority `json:"priority" yaml:"priority"`
+}
+
+// SeedSummary counts the records created or updated from a seed bundle
+type SeedSummary struct {
+	RequirementTypes   int `json:"requirement_types"`
+	RelationshipTypes  int `json:"relationship_types"`
+	StatusModels       int `json:"status_models"`
+	Templates          int `json:"templates"`
+	Epics              int `json:"epics"`
+	UserStories        int `json:"user_stories"`
+	AcceptanceCriteria int `json:"acceptance_criteria"`
+	Requirements       int `json:"requirements"`
+}
+
+// LoadSeedBundle reads and validates a seed bundle from a .yaml, .yml or .json file
+// Unknown fields are rejected so that typos do not silently drop configuration
+func LoadSeedBundle(path string) (*SeedBundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read seed bundle: %w", err)
+	}
+
+	var bundle SeedBundle
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&bundle); err != nil {
+			return nil, fmt.Errorf("failed to parse seed bundle YAML: %w", err)
+		}
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&bundle); err != nil {
+			return nil, fmt.Errorf("failed to parse seed bundle JSON: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported seed bundle format %q: use .yaml, .yml or .json", filepath.Ext(path))
+	}
+
+	if err := bundle.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid seed bundle: %w", err)
+	}
+	return &bundle, nil
+}
+
+// Validate checks the bundle for missing fields, duplicates and dangling references
+func (b *SeedBundle) Validate() error {
+	var problems []string
+	addProblem := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	checkNamedItems := func(kind string, items []SeedNamedItem) {
+		seen := make(map[string]bool)
+		for i, item := range items {
+			if strings.TrimSpace(item.Name) == "" {
+				addProblem("%s[%d]: name is required", kind, i)
+			} else if seen[item.Name] {
+				addProblem("%s[%d]: duplicate name %q", kind, i, item.Name)
+			}
+			seen[item.Name] = true
+		}
+	}
+	checkNamedItems("requirement_types", b.RequirementTypes)
+	checkNamedItems("relationship_types", b.RelationshipTypes)
+
+	seenModels := make(map[string]bool)
+	defaultModels := make(map[models.EntityType]bool)
+	for i, statusModel := range b.StatusModels {
+		prefix := fmt.Sprintf("status_models[%d]", i)
+		if !models.IsValidEntityType(statusModel.EntityType) {
+			addProblem("%s: invalid entity_type %q", prefix, statusModel.EntityType)
+		}
+		if strings.TrimSpace(statusModel.Name) == "" {
+			addProblem("%s: name is required", prefix)
+		}
+		key := string(statusModel.EntityType) + "/" + statusModel.Name
+		if seenModels[key] {
+			addProblem("%s: duplicate status model %q for %s", prefix, statusModel.Name, statusModel.EntityType)
+		}
+		seenModels[key] = true
+		if statusModel.IsDefault {
+			if defaultModels[statusModel.EntityType] {
+				addProblem("%s: more than one default status model for %s", prefix, statusModel.EntityType)
+			}
+			defaultModels[statusModel.EntityType] = true
+		}
+
+		statuses := make(map[string]bool)
+		initialCount := 0
+		for j, status := range statusModel.Statuses {
+			if strings.TrimSpace(status.Name) == "" {
+				addProblem("%s.statuses[%d]: name is required", prefix, j)
+			} else if statuses[status.Name] {
+				addProblem("%s.statuses[%d]: duplicate status %q", prefix, j, status.Name)
+			}
+			statuses[status.Name] = true
+			if status.IsInitial {
+				initialCount++
+			}
+		}
+		if len(statusModel.Statuses) == 0 {
+			addProblem("%s: at least one status is required", prefix)
+		} else if initialCount != 1 {
+			addProblem("%s: exactly one status must be initial", prefix)
+		}
+		for j, transition := range statusModel.Transitions {
+			if !statuses[transition.From] {
+				addProblem("%s.transitions[%d]: unknown from status %q", prefix, j, transition.From)
+			}
+			if !statuses[transition.To] {
+				addProblem("%s.transitions[%d]: unknown to status %q", prefix, j, transition.To)
+			}
+		}
+	}
+
+	seenTemplates := make(map[string]bool)
+	activeTemplates := 0
+	for i, template := range b.Templates {
+		prefix := fmt.Sprintf("templates[%d]", i)
+		if strings.TrimSpace(template.Name) == "" {
+			addProblem("%s: name is required", prefix)
+		} else if seenTemplates[template.Name] {
+			addProblem("%s: duplicate name %q", prefix, template.Name)
+		}
+		seenTemplates[template.Name] = true
+		if strings.TrimSpace(template.Title) == "" {
+			addProblem("%s: title is required", prefix)
+		}
+		if strings.TrimSpace(template.Content) == "" {
+			addProblem("%s: content is required", prefix)
+		}
+		if template.Role != "" && !template.Role.IsValid() {
+			addProblem("%s: invalid role %q", prefix, template.Role)
+		}
+		if template.IsActive {
+			activeTemplates++
+		}
+	}
+	if activeTemplates > 1 {
+		addProblem("templates: at most one template can be active")
+	}
+
+	if b.DemoWorkspace != nil {
+		checkPriority := func(prefix string, priority models.Priority) {
+			if priority != 0 && (priority < models.PriorityCritical || priority > models.PriorityLow) {
+				addProblem("%s: priority must be between 1 and 4", prefix)
+			}
+		}
+		for i, epic := range b.DemoWorkspace.Epics {
+			prefix := fmt.Sprintf("demo_workspace.epics[%d]", i)
+			if strings.TrimSpace(epic.Title) == "" {
+				addProblem("%s: title is required", prefix)
+			}
+			checkPriority(prefix, epic.Priority)
+			for j, userStory := range epic.UserStories {
+				storyPrefix := fmt.Sprintf("%s.user_stories[%d]", prefix, j)
+				if strings.TrimSpace(userStory.Title) == "" {
+					addProblem("%s: title is required", storyPrefix)
+				}
+				checkPriority(storyPrefix, userStory.Priority)
+				for k, criteria := range userStory.AcceptanceCriteria {
+					if strings.TrimSpace(criteria) == "" {
+						addProblem("%s.acceptance_criteria[%d]: description is required", storyPrefix, k)
+					}
+				}
+				for k, requirement := range userStory.Requirements {
+					requirementPrefix := fmt.Sprintf("%s.requirements[%d]", storyPrefix, k)
+					if strings.TrimSpace(requirement.Title) == "" {
+						addProblem("%s: title is required", requirementPrefix)
+					}
+					if strings.TrimSpace(requirement.Type) == "" {
+						addProblem("%s: type is required", requirementPrefix)
+					}
+					checkPriority(requirementPrefix, requirement.Priority)
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// SeedApplier writes a seed bundle to the database
+type SeedApplier struct {
+	db *gorm.DB
+}
+
+// NewSeedApplier creates a new seed applier instance
+func NewSeedApplier(db *gorm.DB) *SeedApplier {
+	return &SeedApplier{db: db}
+}
+
+// Apply writes the bundle in a single transaction. Requirement and relationship types are created or have
+// their description updated by name; status models and templates with the same name replace existing ones;
+// demo workspace entities are created by and assigned to the owner.
+func (sa *SeedApplier) Apply(ctx context.Context, bundle *SeedBundle, owner *models.User) (*SeedSummary, error) {
+	summary := &SeedSummary{}
+
+	err := sa.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range bundle.RequirementTypes {
+			record := &models.RequirementType{}
+			if err := upsertNamedItem(tx, record, item); err != nil {
+				return fmt.Errorf("failed to seed requirement type %q: %w", item.Name, err)
+			}
+			summary.RequirementTypes++
+		}
+
+		for _, item := range bundle.RelationshipTypes {
+			record := &models.RelationshipType{}
+			if err := upsertNamedItem(tx, record, item); err != nil {
+				return fmt.Errorf("failed to seed relationship type %q: %w", item.Name, err)
+			}
+			summary.RelationshipTypes++
+		}
+
+		for _, statusModel := range bundle.StatusModels {
+			if err := seedStatusModel(tx, statusModel); err != nil {
+				return fmt.Errorf("failed to seed status model %q: %w", statusModel.Name, err)
+			}
+			summary.StatusModels++
+		}
+
+		for _, template := range bundle.Templates {
+			if err := seedTemplate(tx, template, owner); err != nil {
+				return fmt.Errorf("failed to seed template %q: %w", template.Name, err)
+			}
+			summary.Templates++
+		}
+
+		if bundle.DemoWorkspace != nil {
+			if err := seedDemoWorkspace(tx, bundle.DemoWorkspace, owner, summary); err != nil {
+				return fmt.Errorf("failed to seed demo workspace: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithContextAndFields(ctx, map[string]interface{}{
+		"component":           "seed_applier",
+		"action":              "bundle_applied",
+		"requirement_types":   summary.RequirementTypes,
+		"relationship_types":  summary.RelationshipTypes,
+		"status_models":       summary.StatusModels,
+		"templates":           summary.Templates,
+		"epics":               summary.Epics,
+		"user_stories":        summary.UserStories,
+		"acceptance_criteria": summary.AcceptanceCriteria,
+		"requirements":        summary.Requirements,
+	}).Info("Seed bundle applied")
+
+	return summary, nil
+}
+
+// upsertNamedItem creates a requirement or relationship type, or updates the description of an existing one
+func upsertNamedItem(tx *gorm.DB, record interface{}, item SeedNamedItem) error {
+	var description *string
+	if item.Description != "" {
+		description = &item.Description
+	}
+
+	result := tx.Where("name = ?", item.Name).Limit(1).Find(record)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return tx.Model(record).Update("description", description).Error
+	}
+
+	switch r := record.(type) {
+	case *models.RequirementType:
+		r.Name, r.Description = item.Name, description
+	case *models.RelationshipType:
+		r.Name, r.Description = item.Name, description
+	}
+	return tx.Create(record).Error
+}
+
+// seedStatusModel replaces any status model of the same entity type and name
+func seedStatusModel(tx *gorm.DB, seed SeedStatusModel) error {
+	var existing models.StatusModel
+	result := tx.Where("entity_type = ? AND name = ?", seed.EntityType, seed.Name).Limit(1).Find(&existing)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		if err := tx.Where("status_model_id = ?", existing.ID).Delete(&models.StatusTransition{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("status_model_id = ?", existing.ID).Delete(&models.Status{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&existing).Error; err != nil {
+			return err
+		}
+	}
+
+	if seed.IsDefault {
+		if err := tx.Model(&models.StatusModel{}).
+			Where("entity_type = ? AND is_default = ?", seed.EntityType, true).
+			Update("is_default", false).Error; err != nil {
+			return err
+		}
+	}
+
+	statusModel := &models.StatusModel{
+		EntityType:  seed.EntityType,
+		Name:        seed.Name,
+		Description: optionalString(seed.Description),
+		IsDefault:   seed.IsDefault,
+	}
+	if err := tx.Create(statusModel).Error; err != nil {
+		return err
+	}
+
+	statusIDs := make(map[string]*models.Status)
+	for i, seedStatus := range seed.Statuses {
+		status := &models.Status{
+			StatusModelID: statusModel.ID,
+			Name:          seedStatus.Name,
+			Description:   optionalString(seedStatus.Description),
+			Color:         optionalString(seedStatus.Color),
+			IsInitial:     seedStatus.IsInitial,
+			IsFinal:       seedStatus.IsFinal,
+			Order:         i + 1,
+		}
+		if err := tx.Create(status).Error; err != nil {
+			return err
+		}
+		statusIDs[status.Name] = status
+	}
+
+	for _, seedTransition := range seed.Transitions {
+		transition := &models.StatusTransition{
+			StatusModelID: statusModel.ID,
+			FromStatusID:  statusIDs[seedTransition.From].ID,
+			ToStatusID:    statusIDs[seedTransition.To].ID,
+			Name:          optionalString(seedTransition.Name),
+		}
+		if err := tx.Create(transition).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seedTemplate creates a prompt template, replacing any prompt with the same name
+func seedTemplate(tx *gorm.DB, seed SeedTemplate, owner *models.User) error {
+	if err := tx.Where("name = ?", seed.Name).Delete(&models.Prompt{}).Error; err != nil {
+		return err
+	}
+
+	if seed.IsActive {
+		if err := tx.Model(&models.Prompt{}).Where("is_active = ?", true).Update("is_active", false).Error; err != nil {
+			return err
+		}
+	}
+
+	prompt := &models.Prompt{
+		Name:        seed.Name,
+		Title:       seed.Title,
+		Description: optionalString(seed.Description),
+		Content:     seed.Content,
+		Role:        seed.Role,
+		IsActive:    seed.IsActive,
+		CreatorID:   owner.ID,
+	}
+	return tx.Create(prompt).Error
+}
+
+// seedDemoWorkspace creates the demo epics with their user stories, acceptance criteria and requirements
+func seedDemoWorkspace(tx *gorm.DB, workspace *SeedDemoWorkspace, owner *models.User, summary *SeedSummary) error {
+	requirementTypes := make(map[string]*models.RequirementType)
+	requirementType := func(name string) (*models.RequirementType, error) {
+		if cached, ok := requirementTypes[name]; ok {
+			return cached, nil
+		}
+		var found models.RequirementType
+		result := tx.Where("name = ?", name).Limit(1).Find(&found)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, fmt.Errorf("unknown requirement type %q", name)
+		}
+		requirementTypes[name] = &found
+		return &found, nil
+	}
+
+	for _, seedEpic := range workspace.Epics {
+		epic := &models.Epic{
+			CreatorID:   owner.ID,
+			AssigneeID:  owner.ID,
+			Priority:    priorityOrDefault(seedEpic.Priority),
+			Title:       seedEpic.Title,
+			Description: optionalString(seedEpic.Description),
+		}
+		if err := tx.Create(epic).Error; err != nil {
+			return fmt.Errorf("failed to create epic %q: %w", seedEpic.Title, err)
+		}
+		summary.Epics++
+
+		for _, seedStory := range seedEpic.UserStories {
+			userStory := &models.UserStory{
+				EpicID:      epic.ID,
+				CreatorID:   owner.ID,
+				AssigneeID:  owner.ID,
+				Priority:    priorityOrDefault(seedStory.Priority),
+				Title:       seedStory.Title,
+				Description: optionalString(seedStory.Description),
+			}
+			if err := tx.Create(userStory).Error; err != nil {
+				return fmt.Errorf("failed to create user story %q: %w", seedStory.Title, err)
+			}
+			summary.UserStories++
+
+			for _, description := range seedStory.AcceptanceCriteria {
+				criteria := &models.AcceptanceCriteria{
+					UserStoryID: userStory.ID,
+					AuthorID:    owner.ID,
+					Description: description,
+				}
+				if err := tx.Create(criteria).Error; err != nil {
+					return fmt.Errorf("failed to create acceptance criteria for %q: %w", seedStory.Title, err)
+				}
+				summary.AcceptanceCriteria++
+			}
+
+			for _, seedRequirement := range seedStory.Requirements {
+				requirementTypeRecord, err := requirementType(seedRequirement.Type)
+				if err != nil {
+					return fmt.Errorf("failed to create requirement %q: %w", seedRequirement.Title, err)
+				}
+				requirement := &models.Requirement{
+					UserStoryID: userStory.ID,
+					CreatorID:   owner.ID,
+					AssigneeID:  owner.ID,
+					Priority:    priorityOrDefault(seedRequirement.Priority),
+					TypeID:      requirementTypeRecord.ID,
+					Title:       seedRequirement.Title,
+					Description: optionalString(seedRequirement.Description),
+				}
+				if err := tx.Create(requirement).Error; err != nil {
+					return fmt.Errorf("failed to create requirement %q: %w", seedRequirement.Title, err)
+				}
+				summary.Requirements++
+			}
+		}
+	}
+	return nil
+}
+
+// priorityOrDefault returns the priority, or medium when it was not set in the bundle
+func priorityOrDefault(priority models.Priority) models.Priority {
+	if priority == 0 {
+		return models.PriorityMedium
+	}
+	return priority
+}
+
+// optionalString returns nil for an empty string
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
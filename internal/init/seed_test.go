@@ -0,0 +1,185 @@
+package init
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// seedReferenceIDGenerator generates sequential reference IDs without PostgreSQL functions
+type seedReferenceIDGenerator struct {
+	prefix string
+	next   int
+}
+
+func (g *seedReferenceIDGenerator) Generate(tx *gorm.DB, model interface{}) (string, error) {
+	g.next++
+	return fmt.Sprintf("%s-%03d", g.prefix, g.next), nil
+}
+
+func setupSeedTestDB(t *testing.T) (*gorm.DB, *models.User) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(
+		&models.User{},
+		&models.RequirementType{},
+		&models.RelationshipType{},
+		&models.StatusModel{},
+		&models.Status{},
+		&models.StatusTransition{},
+		&models.Prompt{},
+		&models.Epic{},
+		&models.UserStory{},
+		&models.AcceptanceCriteria{},
+		&models.Requirement{},
+	))
+
+	models.SetEpicGenerator(&seedReferenceIDGenerator{prefix: "EP"})
+	models.SetUserStoryGenerator(&seedReferenceIDGenerator{prefix: "US"})
+	models.SetAcceptanceCriteriaGenerator(&seedReferenceIDGenerator{prefix: "AC"})
+	models.SetRequirementGenerator(&seedReferenceIDGenerator{prefix: "REQ"})
+	models.SetPromptGenerator(&seedReferenceIDGenerator{prefix: "PROMPT"})
+
+	owner := &models.User{
+		ID:           uuid.New(),
+		Username:     "admin",
+		Email:        "admin@localhost",
+		PasswordHash: "hash",
+		Role:         models.RoleAdministrator,
+	}
+	require.NoError(t, db.Create(owner).Error)
+	require.NoError(t, db.Create(&models.RequirementType{Name: "Functional"}).Error)
+
+	return db, owner
+}
+
+func writeSeedFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadSeedBundle(t *testing.T) {
+	t.Run("example bundle is valid", func(t *testing.T) {
+		bundle, err := LoadSeedBundle(filepath.Join("..", "..", "seed.example.yaml"))
+		require.NoError(t, err)
+		assert.Len(t, bundle.RequirementTypes, 2)
+		require.NotNil(t, bundle.DemoWorkspace)
+		assert.Len(t, bundle.DemoWorkspace.Epics, 1)
+	})
+
+	t.Run("json bundle", func(t *testing.T) {
+		path := writeSeedFile(t, "seed.json", `{"relationship_types": [{"name": "verifies"}]}`)
+		bundle, err := LoadSeedBundle(path)
+		require.NoError(t, err)
+		assert.Equal(t, "verifies", bundle.RelationshipTypes[0].Name)
+	})
+
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected string
+	}{
+		{"unknown field", "seed.yaml", "requirement_type:\n  - name: Security\n", "field requirement_type not found"},
+		{"unsupported format", "seed.toml", "", "unsupported seed bundle format"},
+		{"duplicate type", "seed.yaml", "requirement_types:\n  - name: Security\n  - name: Security\n", "duplicate name"},
+		{"invalid entity type", "seed.yaml", "status_models:\n  - entity_type: task\n    name: Flow\n    statuses:\n      - {name: Open, is_initial: true}\n", "invalid entity_type"},
+		{"no initial status", "seed.yaml", "status_models:\n  - entity_type: epic\n    name: Flow\n    statuses:\n      - {name: Open}\n", "exactly one status must be initial"},
+		{"unknown transition status", "seed.yaml", "status_models:\n  - entity_type: epic\n    name: Flow\n    statuses:\n      - {name: Open, is_initial: true}\n    transitions:\n      - {from: Open, to: Closed}\n", "unknown to status \"Closed\""},
+		{"invalid template role", "seed.json", `{"templates": [{"name": "t", "title": "T", "content": "c", "role": "system"}]}`, "invalid role"},
+		{"requirement without type", "seed.yaml", "demo_workspace:\n  epics:\n    - title: Epic\n      user_stories:\n        - title: Story\n          requirements:\n            - title: Requirement\n", "type is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadSeedBundle(writeSeedFile(t, tt.file, tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func TestSeedApplier_Apply(t *testing.T) {
+	db, owner := setupSeedTestDB(t)
+	ctx := context.Background()
+
+	bundle, err := LoadSeedBundle(filepath.Join("..", "..", "seed.example.yaml"))
+	require.NoError(t, err)
+	bundle.RequirementTypes = append(bundle.RequirementTypes, SeedNamedItem{Name: "Functional", Description: "Custom description"})
+
+	summary, err := NewSeedApplier(db).Apply(ctx, bundle, owner)
+	require.NoError(t, err)
+	assert.Equal(t, &SeedSummary{
+		RequirementTypes:   3,
+		RelationshipTypes:  1,
+		StatusModels:       1,
+		Templates:          1,
+		Epics:              1,
+		UserStories:        1,
+		AcceptanceCriteria: 2,
+		Requirements:       2,
+	}, summary)
+
+	var functional models.RequirementType
+	require.NoError(t, db.Where("name = ?", "Functional").First(&functional).Error)
+	require.NotNil(t, functional.Description)
+	assert.Equal(t, "Custom description", *functional.Description)
+
+	var statusModel models.StatusModel
+	require.NoError(t, db.Preload("Statuses").Preload("Transitions").Where("name = ?", "Regulated Workflow").First(&statusModel).Error)
+	assert.True(t, statusModel.IsDefault)
+	assert.Len(t, statusModel.Statuses, 4)
+	assert.Len(t, statusModel.Transitions, 4)
+
+	var requirement models.Requirement
+	require.NoError(t, db.Where("title = ?", "Reset links are single use").First(&requirement).Error)
+	assert.Equal(t, owner.ID, requirement.AssigneeID)
+	assert.Equal(t, models.PriorityCritical, requirement.Priority)
+
+	// Applying again replaces status models and templates instead of duplicating them
+	bundle.DemoWorkspace = nil
+	_, err = NewSeedApplier(db).Apply(ctx, bundle, owner)
+	require.NoError(t, err)
+
+	var statusModels, prompts int64
+	db.Model(&models.StatusModel{}).Count(&statusModels)
+	db.Model(&models.Prompt{}).Count(&prompts)
+	assert.Equal(t, int64(1), statusModels)
+	assert.Equal(t, int64(1), prompts)
+}
+
+func TestSeedApplier_RollsBackOnError(t *testing.T) {
+	db, owner := setupSeedTestDB(t)
+
+	bundle := &SeedBundle{
+		RelationshipTypes: []SeedNamedItem{{Name: "verifies"}},
+		DemoWorkspace: &SeedDemoWorkspace{Epics: []SeedEpic{{
+			Title: "Epic",
+			UserStories: []SeedUserStory{{
+				Title:        "Story",
+				Requirements: []SeedRequirement{{Title: "Requirement", Type: "Missing"}},
+			}},
+		}}},
+	}
+
+	_, err := NewSeedApplier(db).Apply(context.Background(), bundle, owner)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown requirement type \"Missing\"")
+
+	var relationshipTypes, epics int64
+	db.Model(&models.RelationshipType{}).Count(&relationshipTypes)
+	db.Model(&models.Epic{}).Count(&epics)
+	assert.Zero(t, relationshipTypes)
+	assert.Zero(t, epics)
+}
@@ -26,6 +26,8 @@ type InitService struct {
 	safetyChecker *SafetyChecker
 	migrator      *database.MigrationManager
 	adminCreator  *AdminCreator
+	seedBundle    *SeedBundle
+	online        bool
 	startTime     time.Time
 	correlationID string
 	ctx           context.Context
@@ -91,6 +93,17 @@ func NewInitService(cfg *config.Config) (*InitService, error) {
 	return service, nil
 }
 
+// SetSeedBundle configures a validated seed bundle to apply after the admin user is created
+func (s *InitService) SetSeedBundle(bundle *SeedBundle) {
+	s.seedBundle = bundle
+}
+
+// SetOnlineMigrations makes initialization run migrations in the online mode, building indexes concurrently and
+// running marked backfills in batches
+func (s *InitService) SetOnlineMigrations(online bool) {
+	s.online = online
+}
+
 // Initialize runs the complete initialization process
 func (s *InitService) Initialize() error {
 	var stepSummaries []StepSummary
@@ -206,7 +219,24 @@ func (s *InitService) Initialize() error {
 		"role":     adminUser.Role,
 	}))
 
-	// Step 7: Log success and next steps
+	// Step 7: Apply seed bundle (optional)
+	if s.seedBundle != nil {
+		stepCtx = logger.WithInitializationStep(s.ctx, "seed_bundle")
+		stepStart = time.Now()
+		seedSummary, err := NewSeedApplier(s.db).Apply(stepCtx, s.seedBundle, adminUser)
+		if err != nil {
+			s.logStepFailure("seed_bundle", stepStart, err)
+			initErr := NewCreationError("Seed bundle could not be applied", err).
+				WithStep("seed_bundle").
+				WithCorrelationID(s.correlationID).
+				WithContext("duration", time.Since(stepStart).String())
+			s.errorReporter.ReportError(initErr)
+			return initErr
+		}
+		stepSummaries = append(stepSummaries, s.createStepSummary("seed_bundle", stepStart, time.Now(), "success", seedSummary))
+	}
+
+	// Step 8: Log success and next steps
 	s.logSuccessAndNextSteps(stepSummaries, adminUser.Username, migrationsApplied)
 
 	return nil
@@ -519,12 +549,76 @@ func (s *InitService) runMigrations(ctx context.Context) (int, error) {
 		return 0, fmt.Errorf("database is in dirty state (version: %d) - manual intervention required", version)
 	}
 
+	// Report operations of the pending migrations that would block writes on populated tables
+	report, err := s.migrator.Preflight()
+	if err != nil {
+		logger.WithContextAndFields(ctx, map[string]interface{}{
+			"action": "migration_preflight_failed",
+			"error":  err.Error(),
+		}).Warn("Failed to analyze pending migrations")
+	} else {
+		for _, finding := range report.Findings {
+			logger.WithContextAndFields(ctx, map[string]interface{}{
+				"action":    "migration_preflight_finding",
+				"version":   finding.Version,
+				"migration": finding.Migration,
+				"statement": finding.Statement,
+				"table":     finding.Table,
+				"severity":  finding.Severity,
+				"operation": finding.Operation,
+				"online":    finding.Online,
+			}).Warn(finding.Message)
+		}
+		logger.WithContextAndFields(ctx, map[string]interface{}{
+			"action":     "migration_preflight_completed",
+			"pending":    report.Pending(),
+			"findings":   len(report.Findings),
+			"disruptive": len(report.Disruptive(s.online)),
+		}).Info("Pending migrations analyzed")
+	}
+
 	// Run migrations using the migration manager
 	logger.WithContextAndFields(ctx, map[string]interface{}{
 		"action":        "execute_migrations",
 		"is_first_time": isFirstTime,
+		"online":        s.online,
 	}).Info("Executing database migrations")
 
+	if s.online {
+		applied, err := s.migrator.RunMigrationsOnline(database.OnlineMigrationOptions{
+			Progress: func(p database.MigrationProgress) {
+				logger.WithContextAndFields(ctx, map[string]interface{}{
+					"action":     "migration_progress",
+					"version":    p.Version,
+					"migration":  p.Name,
+					"step":       p.Step,
+					"steps":      p.Steps,
+					"operation":  p.Operation,
+					"statement":  p.Statement,
+					"rows_done":  p.RowsDone,
+					"rows_total": p.RowsTotal,
+					"done":       p.Done,
+				}).Info("Migration progress")
+			},
+		})
+		if err != nil {
+			logger.WithContextAndFields(ctx, map[string]interface{}{
+				"action": "migration_failed",
+				"error":  err.Error(),
+			}).Error("Failed to run migrations")
+			return 0, fmt.Errorf("failed to run migrations: %w", err)
+		}
+
+		logger.WithContextAndFields(ctx, map[string]interface{}{
+			"action":             "migrations_completed",
+			"duration":           time.Since(stepStart).String(),
+			"status":             "success",
+			"migrations_applied": applied,
+			"online":             true,
+		}).Info("Database migrations completed successfully")
+		return applied, nil
+	}
+
 	if err := s.migrator.RunMigrations(); err != nil {
 		logger.WithContextAndFields(ctx, map[string]interface{}{
 			"action": "migration_failed",
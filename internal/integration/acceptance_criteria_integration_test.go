@@ -2,6 +2,7 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -84,6 +85,7 @@ func (suite *AcceptanceCriteriaIntegrationTestSuite) SetupSuite() {
 }
 
 func (suite *AcceptanceCriteriaIntegrationTestSuite) SetupTest() {
+	ctx := context.Background()
 	// Clean up database before each test
 	suite.db.Exec("DELETE FROM acceptance_criteria")
 	suite.db.Exec("DELETE FROM user_stories")
@@ -104,7 +106,7 @@ func (suite *AcceptanceCriteriaIntegrationTestSuite) SetupTest() {
 		Title:       "Test Epic",
 		Description: stringPtr("Epic description"),
 	}
-	err := suite.epicRepo.Create(suite.testEpic)
+	err := suite.epicRepo.Create(ctx, suite.testEpic)
 	suite.Require().NoError(err)
 
 	// Create test user story
@@ -120,7 +122,7 @@ func (suite *AcceptanceCriteriaIntegrationTestSuite) SetupTest() {
 		Title:       "Test User Story",
 		Description: &description,
 	}
-	err = suite.userStoryRepo.Create(suite.testUserStory)
+	err = suite.userStoryRepo.Create(ctx, suite.testUserStory)
 	suite.Require().NoError(err)
 }
 
@@ -424,6 +426,7 @@ func (suite *AcceptanceCriteriaIntegrationTestSuite) TestDeleteNonExistentAccept
 
 // Helper function to create test acceptance criteria
 func (suite *AcceptanceCriteriaIntegrationTestSuite) createTestAcceptanceCriteria(description string) *models.AcceptanceCriteria {
+	ctx := context.Background()
 	acceptanceCriteria := &models.AcceptanceCriteria{
 		ID:          uuid.New(),
 		UserStoryID: suite.testUserStory.ID,
@@ -431,7 +434,7 @@ func (suite *AcceptanceCriteriaIntegrationTestSuite) createTestAcceptanceCriteri
 		Description: description,
 	}
 
-	err := suite.acceptanceCriteriaRepo.Create(acceptanceCriteria)
+	err := suite.acceptanceCriteriaRepo.Create(ctx, acceptanceCriteria)
 	suite.Require().NoError(err)
 
 	return acceptanceCriteria
@@ -31,7 +31,7 @@ func setupCommentIntegrationTest(t *testing.T) (*gin.Engine, *gorm.DB, *auth.Ser
 	repos := repository.NewRepositories(db, nil)
 
 	// Initialize services
-	commentService := service.NewCommentService(repos)
+	commentService := service.NewCommentService(repos, nil)
 	mockRefreshTokenRepo := &mockRefreshTokenRepository{}
 	authService := auth.NewService("test-secret-key", 24*time.Hour, mockRefreshTokenRepo)
 
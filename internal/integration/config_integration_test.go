@@ -2,6 +2,7 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -378,6 +379,7 @@ func TestConfigIntegration_RelationshipTypeLifecycle(t *testing.T) {
 }
 
 func TestConfigIntegration_ValidationIntegration(t *testing.T) {
+	ctx := context.Background()
 	_, db, configService := setupConfigIntegrationTest(t)
 
 	t.Run("validate requirement type integration", func(t *testing.T) {
@@ -387,12 +389,12 @@ func TestConfigIntegration_ValidationIntegration(t *testing.T) {
 		require.NoError(t, err)
 
 		// Test validation with valid type
-		err = configService.ValidateRequirementType(reqType.ID)
+		err = configService.ValidateRequirementType(ctx, reqType.ID)
 		assert.NoError(t, err)
 
 		// Test validation with invalid type
 		invalidID := uuid.New()
-		err = configService.ValidateRequirementType(invalidID)
+		err = configService.ValidateRequirementType(ctx, invalidID)
 		assert.Error(t, err)
 		assert.Equal(t, service.ErrRequirementTypeNotFound, err)
 	})
@@ -404,12 +406,12 @@ func TestConfigIntegration_ValidationIntegration(t *testing.T) {
 		require.NoError(t, err)
 
 		// Test validation with valid type
-		err = configService.ValidateRelationshipType(relType.ID)
+		err = configService.ValidateRelationshipType(ctx, relType.ID)
 		assert.NoError(t, err)
 
 		// Test validation with invalid type
 		invalidID := uuid.New()
-		err = configService.ValidateRelationshipType(invalidID)
+		err = configService.ValidateRelationshipType(ctx, invalidID)
 		assert.Error(t, err)
 		assert.Equal(t, service.ErrRelationshipTypeNotFound, err)
 	})
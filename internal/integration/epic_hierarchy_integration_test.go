@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -333,6 +334,7 @@ func (suite *EpicHierarchyIntegrationTestSuite) TestEpicHierarchy_UUIDFormat() {
 // Helper methods
 
 func (suite *EpicHierarchyIntegrationTestSuite) createTestEpic(title string, priority models.Priority, status models.EpicStatus) *models.Epic {
+	ctx := context.Background()
 	epic := &models.Epic{
 		ID:          uuid.New(),
 		CreatorID:   suite.testUser.ID,
@@ -343,13 +345,14 @@ func (suite *EpicHierarchyIntegrationTestSuite) createTestEpic(title string, pri
 		Description: stringPtr("Test epic description"),
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	require.NoError(suite.T(), err)
 
 	return epic
 }
 
 func (suite *EpicHierarchyIntegrationTestSuite) createTestUserStory(epicID uuid.UUID, title string, priority models.Priority, status models.UserStoryStatus) *models.UserStory {
+	ctx := context.Background()
 	us := &models.UserStory{
 		ID:          uuid.New(),
 		EpicID:      epicID,
@@ -361,13 +364,14 @@ func (suite *EpicHierarchyIntegrationTestSuite) createTestUserStory(epicID uuid.
 		Description: stringPtr("Test user story description"),
 	}
 
-	err := suite.userStoryRepo.Create(us)
+	err := suite.userStoryRepo.Create(ctx, us)
 	require.NoError(suite.T(), err)
 
 	return us
 }
 
 func (suite *EpicHierarchyIntegrationTestSuite) createTestRequirement(userStoryID uuid.UUID, title string, priority models.Priority, status models.RequirementStatus) *models.Requirement {
+	ctx := context.Background()
 	// Get a requirement type
 	reqType, err := suite.testDatabase.GetRequirementType("Functional")
 	require.NoError(suite.T(), err)
@@ -384,13 +388,14 @@ func (suite *EpicHierarchyIntegrationTestSuite) createTestRequirement(userStoryI
 		Description: stringPtr("Test requirement description"),
 	}
 
-	err = suite.requirementRepo.Create(req)
+	err = suite.requirementRepo.Create(ctx, req)
 	require.NoError(suite.T(), err)
 
 	return req
 }
 
 func (suite *EpicHierarchyIntegrationTestSuite) createTestAcceptanceCriteria(userStoryID uuid.UUID, description string) *models.AcceptanceCriteria {
+	ctx := context.Background()
 	ac := &models.AcceptanceCriteria{
 		ID:          uuid.New(),
 		UserStoryID: userStoryID,
@@ -398,7 +403,7 @@ func (suite *EpicHierarchyIntegrationTestSuite) createTestAcceptanceCriteria(use
 		Description: description,
 	}
 
-	err := suite.acRepo.Create(ac)
+	err := suite.acRepo.Create(ctx, ac)
 	require.NoError(suite.T(), err)
 
 	return ac
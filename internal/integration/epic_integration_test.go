@@ -2,6 +2,7 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -120,6 +121,7 @@ func (suite *EpicIntegrationTestSuite) TestCreateEpic() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestGetEpic() {
+	ctx := context.Background()
 	// Create epic first
 	epic := &models.Epic{
 		ID:          uuid.New(),
@@ -132,7 +134,7 @@ func (suite *EpicIntegrationTestSuite) TestGetEpic() {
 		Description: stringPtr("Test epic description"),
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	suite.Require().NoError(err)
 
 	// Get epic by ID
@@ -153,6 +155,7 @@ func (suite *EpicIntegrationTestSuite) TestGetEpic() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestGetEpicByReferenceID() {
+	ctx := context.Background()
 	// Create epic first
 	epic := &models.Epic{
 		ID:          uuid.New(),
@@ -165,7 +168,7 @@ func (suite *EpicIntegrationTestSuite) TestGetEpicByReferenceID() {
 		Description: stringPtr("Test epic description"),
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	suite.Require().NoError(err)
 
 	// Get epic by reference ID
@@ -185,6 +188,7 @@ func (suite *EpicIntegrationTestSuite) TestGetEpicByReferenceID() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestUpdateEpic() {
+	ctx := context.Background()
 	// Create epic first
 	epic := &models.Epic{
 		ID:          uuid.New(),
@@ -197,7 +201,7 @@ func (suite *EpicIntegrationTestSuite) TestUpdateEpic() {
 		Description: stringPtr("Original description"),
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	suite.Require().NoError(err)
 
 	// Update epic
@@ -228,6 +232,7 @@ func (suite *EpicIntegrationTestSuite) TestUpdateEpic() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestDeleteEpic() {
+	ctx := context.Background()
 	// Create epic first
 	epic := &models.Epic{
 		ID:          uuid.New(),
@@ -239,7 +244,7 @@ func (suite *EpicIntegrationTestSuite) TestDeleteEpic() {
 		Title:       "Epic to Delete",
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	suite.Require().NoError(err)
 
 	// Delete epic
@@ -260,6 +265,7 @@ func (suite *EpicIntegrationTestSuite) TestDeleteEpic() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestListEpics() {
+	ctx := context.Background()
 	// Create multiple epics
 	epics := []*models.Epic{
 		{
@@ -283,7 +289,7 @@ func (suite *EpicIntegrationTestSuite) TestListEpics() {
 	}
 
 	for _, epic := range epics {
-		err := suite.epicRepo.Create(epic)
+		err := suite.epicRepo.Create(ctx, epic)
 		suite.Require().NoError(err)
 	}
 
@@ -309,6 +315,7 @@ func (suite *EpicIntegrationTestSuite) TestListEpics() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestListEpicsWithStatusFilter() {
+	ctx := context.Background()
 	// Create multiple epics
 	epics := []*models.Epic{
 		{
@@ -332,7 +339,7 @@ func (suite *EpicIntegrationTestSuite) TestListEpicsWithStatusFilter() {
 	}
 
 	for _, epic := range epics {
-		err := suite.epicRepo.Create(epic)
+		err := suite.epicRepo.Create(ctx, epic)
 		suite.Require().NoError(err)
 	}
 
@@ -359,6 +366,7 @@ func (suite *EpicIntegrationTestSuite) TestListEpicsWithStatusFilter() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestChangeEpicStatus() {
+	ctx := context.Background()
 	// Create epic first
 	epic := &models.Epic{
 		ID:          uuid.New(),
@@ -370,7 +378,7 @@ func (suite *EpicIntegrationTestSuite) TestChangeEpicStatus() {
 		Title:       "Epic for Status Change",
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	suite.Require().NoError(err)
 
 	// Change status
@@ -397,6 +405,7 @@ func (suite *EpicIntegrationTestSuite) TestChangeEpicStatus() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestAssignEpic() {
+	ctx := context.Background()
 	// Create epic first
 	epic := &models.Epic{
 		ID:          uuid.New(),
@@ -408,7 +417,7 @@ func (suite *EpicIntegrationTestSuite) TestAssignEpic() {
 		Title:       "Epic for Assignment",
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	suite.Require().NoError(err)
 
 	// Assign to admin user
@@ -435,6 +444,7 @@ func (suite *EpicIntegrationTestSuite) TestAssignEpic() {
 }
 
 func (suite *EpicIntegrationTestSuite) TestGetEpicWithUserStories() {
+	ctx := context.Background()
 	// Create epic first
 	epic := &models.Epic{
 		ID:          uuid.New(),
@@ -446,7 +456,7 @@ func (suite *EpicIntegrationTestSuite) TestGetEpicWithUserStories() {
 		Title:       "Epic with User Stories",
 	}
 
-	err := suite.epicRepo.Create(epic)
+	err := suite.epicRepo.Create(ctx, epic)
 	suite.Require().NoError(err)
 
 	// Get epic with user stories (should be empty initially)
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -250,11 +251,12 @@ type NavigationTestData struct {
 
 // setupNavigationTestData creates test data for navigation tests
 func setupNavigationTestData(t *testing.T, testDB *TestDatabase, user *models.User) *NavigationTestData {
+	ctx := context.Background()
 	// Create repositories
 	repos := repository.NewRepositories(testDB.DB, nil)
 
 	// Get or create requirement type
-	reqType, err := repos.RequirementType.GetByName("Functional")
+	reqType, err := repos.RequirementType.GetByName(ctx, "Functional")
 	if err != nil {
 		// Create if doesn't exist
 		reqType = &models.RequirementType{
@@ -262,7 +264,7 @@ func setupNavigationTestData(t *testing.T, testDB *TestDatabase, user *models.Us
 			Name:        "TestFunctional",
 			Description: stringPtr("Test functional requirement"),
 		}
-		err = repos.RequirementType.Create(reqType)
+		err = repos.RequirementType.Create(ctx, reqType)
 		require.NoError(t, err)
 	}
 
@@ -277,7 +279,7 @@ func setupNavigationTestData(t *testing.T, testDB *TestDatabase, user *models.Us
 		Title:       "Test Epic",
 		Description: stringPtr("Test epic description"),
 	}
-	err = repos.Epic.Create(epic)
+	err = repos.Epic.Create(ctx, epic)
 	require.NoError(t, err)
 
 	// Create user story
@@ -292,7 +294,7 @@ func setupNavigationTestData(t *testing.T, testDB *TestDatabase, user *models.Us
 		Title:       "Test User Story",
 		Description: stringPtr("As a user, I want to test, so that I can verify functionality"),
 	}
-	err = repos.UserStory.Create(userStory)
+	err = repos.UserStory.Create(ctx, userStory)
 	require.NoError(t, err)
 
 	// Create acceptance criteria
@@ -303,7 +305,7 @@ func setupNavigationTestData(t *testing.T, testDB *TestDatabase, user *models.Us
 		AuthorID:    user.ID,
 		Description: "WHEN user performs action THEN system SHALL respond",
 	}
-	err = repos.AcceptanceCriteria.Create(acceptanceCriteria)
+	err = repos.AcceptanceCriteria.Create(ctx, acceptanceCriteria)
 	require.NoError(t, err)
 
 	// Create requirement
@@ -320,7 +322,7 @@ func setupNavigationTestData(t *testing.T, testDB *TestDatabase, user *models.Us
 		Title:                "Test Requirement",
 		Description:          stringPtr("Test requirement description"),
 	}
-	err = repos.Requirement.Create(requirement)
+	err = repos.Requirement.Create(ctx, requirement)
 	require.NoError(t, err)
 
 	return &NavigationTestData{
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -101,6 +102,7 @@ func (suite *RequirementIntegrationTestSuite) SetupSuite() {
 }
 
 func (suite *RequirementIntegrationTestSuite) SetupTest() {
+	ctx := context.Background()
 	// Clean up database before each test
 	suite.db.Exec("DELETE FROM requirement_relationships")
 	suite.db.Exec("DELETE FROM requirements")
@@ -123,7 +125,7 @@ func (suite *RequirementIntegrationTestSuite) SetupTest() {
 		Status:     models.EpicStatusBacklog,
 		Title:      "Test Epic",
 	}
-	err := suite.epicRepo.Create(suite.testEpic)
+	err := suite.epicRepo.Create(ctx, suite.testEpic)
 	suite.Require().NoError(err)
 
 	// Create test user story
@@ -138,7 +140,7 @@ func (suite *RequirementIntegrationTestSuite) SetupTest() {
 		Title:       "Test User Story",
 		Description: &description,
 	}
-	err = suite.userStoryRepo.Create(suite.testUserStory)
+	err = suite.userStoryRepo.Create(ctx, suite.testUserStory)
 	suite.Require().NoError(err)
 
 	// Create test acceptance criteria
@@ -148,7 +150,7 @@ func (suite *RequirementIntegrationTestSuite) SetupTest() {
 		AuthorID:    suite.testUser.ID,
 		Description: "WHEN user performs action THEN system SHALL respond",
 	}
-	err = suite.acceptanceCriteriaRepo.Create(suite.testAcceptanceCriteria)
+	err = suite.acceptanceCriteriaRepo.Create(ctx, suite.testAcceptanceCriteria)
 	suite.Require().NoError(err)
 
 	// Create test requirement type
@@ -157,7 +159,7 @@ func (suite *RequirementIntegrationTestSuite) SetupTest() {
 		Name:        "Functional",
 		Description: stringPtr("Functional requirements"),
 	}
-	err = suite.requirementTypeRepo.Create(suite.testRequirementType)
+	err = suite.requirementTypeRepo.Create(ctx, suite.testRequirementType)
 	suite.Require().NoError(err)
 
 	// Create test relationship type
@@ -166,7 +168,7 @@ func (suite *RequirementIntegrationTestSuite) SetupTest() {
 		Name:        "depends_on",
 		Description: stringPtr("Dependency relationship"),
 	}
-	err = suite.relationshipTypeRepo.Create(suite.testRelationshipType)
+	err = suite.relationshipTypeRepo.Create(ctx, suite.testRelationshipType)
 	suite.Require().NoError(err)
 }
 
@@ -246,6 +248,7 @@ func (suite *RequirementIntegrationTestSuite) TestCreateRequirementInUserStory()
 }
 
 func (suite *RequirementIntegrationTestSuite) TestGetRequirement() {
+	ctx := context.Background()
 	// Create a requirement first
 	requirement := &models.Requirement{
 		ID:          uuid.New(),
@@ -258,7 +261,7 @@ func (suite *RequirementIntegrationTestSuite) TestGetRequirement() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Test Requirement",
 	}
-	err := suite.requirementRepo.Create(requirement)
+	err := suite.requirementRepo.Create(ctx, requirement)
 	suite.Require().NoError(err)
 
 	// Test get by UUID
@@ -280,6 +283,7 @@ func (suite *RequirementIntegrationTestSuite) TestGetRequirement() {
 }
 
 func (suite *RequirementIntegrationTestSuite) TestUpdateRequirement() {
+	ctx := context.Background()
 	// Create a requirement first
 	requirement := &models.Requirement{
 		ID:          uuid.New(),
@@ -292,7 +296,7 @@ func (suite *RequirementIntegrationTestSuite) TestUpdateRequirement() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Original Title",
 	}
-	err := suite.requirementRepo.Create(requirement)
+	err := suite.requirementRepo.Create(ctx, requirement)
 	suite.Require().NoError(err)
 
 	// Update request
@@ -329,6 +333,7 @@ func (suite *RequirementIntegrationTestSuite) TestUpdateRequirement() {
 }
 
 func (suite *RequirementIntegrationTestSuite) TestDeleteRequirement() {
+	ctx := context.Background()
 	// Create a requirement first
 	requirement := &models.Requirement{
 		ID:          uuid.New(),
@@ -341,7 +346,7 @@ func (suite *RequirementIntegrationTestSuite) TestDeleteRequirement() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Test Requirement",
 	}
-	err := suite.requirementRepo.Create(requirement)
+	err := suite.requirementRepo.Create(ctx, requirement)
 	suite.Require().NoError(err)
 
 	// Delete request
@@ -355,11 +360,12 @@ func (suite *RequirementIntegrationTestSuite) TestDeleteRequirement() {
 	suite.Equal(http.StatusNoContent, w.Code)
 
 	// Verify requirement is deleted
-	_, err = suite.requirementRepo.GetByID(requirement.ID)
+	_, err = suite.requirementRepo.GetByID(ctx, requirement.ID)
 	suite.Error(err)
 }
 
 func (suite *RequirementIntegrationTestSuite) TestCreateRelationship() {
+	ctx := context.Background()
 	// Create two requirements
 	requirement1 := &models.Requirement{
 		ID:          uuid.New(),
@@ -372,7 +378,7 @@ func (suite *RequirementIntegrationTestSuite) TestCreateRelationship() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Requirement 1",
 	}
-	err := suite.requirementRepo.Create(requirement1)
+	err := suite.requirementRepo.Create(ctx, requirement1)
 	suite.Require().NoError(err)
 
 	requirement2 := &models.Requirement{
@@ -386,7 +392,7 @@ func (suite *RequirementIntegrationTestSuite) TestCreateRelationship() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Requirement 2",
 	}
-	err = suite.requirementRepo.Create(requirement2)
+	err = suite.requirementRepo.Create(ctx, requirement2)
 	suite.Require().NoError(err)
 
 	// Create relationship
@@ -421,6 +427,7 @@ func (suite *RequirementIntegrationTestSuite) TestCreateRelationship() {
 }
 
 func (suite *RequirementIntegrationTestSuite) TestListRequirements() {
+	ctx := context.Background()
 	// Create multiple requirements
 	for i := range 3 {
 		requirement := &models.Requirement{
@@ -434,7 +441,7 @@ func (suite *RequirementIntegrationTestSuite) TestListRequirements() {
 			TypeID:      suite.testRequirementType.ID,
 			Title:       fmt.Sprintf("Test Requirement %d", i+1),
 		}
-		err := suite.requirementRepo.Create(requirement)
+		err := suite.requirementRepo.Create(ctx, requirement)
 		suite.Require().NoError(err)
 	}
 
@@ -461,6 +468,7 @@ func (suite *RequirementIntegrationTestSuite) TestListRequirements() {
 }
 
 func (suite *RequirementIntegrationTestSuite) TestSearchRequirements() {
+	ctx := context.Background()
 	// Create requirements with different titles
 	requirement1 := &models.Requirement{
 		ID:          uuid.New(),
@@ -473,7 +481,7 @@ func (suite *RequirementIntegrationTestSuite) TestSearchRequirements() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Login functionality requirement",
 	}
-	err := suite.requirementRepo.Create(requirement1)
+	err := suite.requirementRepo.Create(ctx, requirement1)
 	suite.Require().NoError(err)
 
 	requirement2 := &models.Requirement{
@@ -487,7 +495,7 @@ func (suite *RequirementIntegrationTestSuite) TestSearchRequirements() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Database connection requirement",
 	}
-	err = suite.requirementRepo.Create(requirement2)
+	err = suite.requirementRepo.Create(ctx, requirement2)
 	suite.Require().NoError(err)
 
 	// Search for "login"
@@ -509,6 +517,7 @@ func (suite *RequirementIntegrationTestSuite) TestSearchRequirements() {
 }
 
 func (suite *RequirementIntegrationTestSuite) TestChangeRequirementStatus() {
+	ctx := context.Background()
 	// Create a requirement first
 	requirement := &models.Requirement{
 		ID:          uuid.New(),
@@ -521,7 +530,7 @@ func (suite *RequirementIntegrationTestSuite) TestChangeRequirementStatus() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Test Requirement",
 	}
-	err := suite.requirementRepo.Create(requirement)
+	err := suite.requirementRepo.Create(ctx, requirement)
 	suite.Require().NoError(err)
 
 	// Change status
@@ -550,6 +559,7 @@ func (suite *RequirementIntegrationTestSuite) TestChangeRequirementStatus() {
 }
 
 func (suite *RequirementIntegrationTestSuite) TestAssignRequirement() {
+	ctx := context.Background()
 	// Create a requirement first
 	requirement := &models.Requirement{
 		ID:          uuid.New(),
@@ -562,7 +572,7 @@ func (suite *RequirementIntegrationTestSuite) TestAssignRequirement() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Test Requirement",
 	}
-	err := suite.requirementRepo.Create(requirement)
+	err := suite.requirementRepo.Create(ctx, requirement)
 	suite.Require().NoError(err)
 
 	// Assign to admin user
@@ -591,6 +601,7 @@ func (suite *RequirementIntegrationTestSuite) TestAssignRequirement() {
 }
 
 func (suite *RequirementIntegrationTestSuite) TestGetRequirementWithRelationships() {
+	ctx := context.Background()
 	// Create two requirements
 	requirement1 := &models.Requirement{
 		ID:          uuid.New(),
@@ -603,7 +614,7 @@ func (suite *RequirementIntegrationTestSuite) TestGetRequirementWithRelationship
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Requirement 1",
 	}
-	err := suite.requirementRepo.Create(requirement1)
+	err := suite.requirementRepo.Create(ctx, requirement1)
 	suite.Require().NoError(err)
 
 	requirement2 := &models.Requirement{
@@ -617,7 +628,7 @@ func (suite *RequirementIntegrationTestSuite) TestGetRequirementWithRelationship
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Requirement 2",
 	}
-	err = suite.requirementRepo.Create(requirement2)
+	err = suite.requirementRepo.Create(ctx, requirement2)
 	suite.Require().NoError(err)
 
 	// Create relationship
@@ -628,7 +639,7 @@ func (suite *RequirementIntegrationTestSuite) TestGetRequirementWithRelationship
 		RelationshipTypeID:  suite.testRelationshipType.ID,
 		CreatedBy:           suite.testUser.ID,
 	}
-	err = suite.requirementRelationshipRepo.Create(relationship)
+	err = suite.requirementRelationshipRepo.Create(ctx, relationship)
 	suite.Require().NoError(err)
 
 	// Get requirement with relationships
@@ -652,6 +663,7 @@ func (suite *RequirementIntegrationTestSuite) TestGetRequirementWithRelationship
 }
 
 func (suite *RequirementIntegrationTestSuite) TestDeleteRelationship() {
+	ctx := context.Background()
 	// Create two requirements
 	requirement1 := &models.Requirement{
 		ID:          uuid.New(),
@@ -664,7 +676,7 @@ func (suite *RequirementIntegrationTestSuite) TestDeleteRelationship() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Requirement 1",
 	}
-	err := suite.requirementRepo.Create(requirement1)
+	err := suite.requirementRepo.Create(ctx, requirement1)
 	suite.Require().NoError(err)
 
 	requirement2 := &models.Requirement{
@@ -678,7 +690,7 @@ func (suite *RequirementIntegrationTestSuite) TestDeleteRelationship() {
 		TypeID:      suite.testRequirementType.ID,
 		Title:       "Requirement 2",
 	}
-	err = suite.requirementRepo.Create(requirement2)
+	err = suite.requirementRepo.Create(ctx, requirement2)
 	suite.Require().NoError(err)
 
 	// Create relationship
@@ -689,7 +701,7 @@ func (suite *RequirementIntegrationTestSuite) TestDeleteRelationship() {
 		RelationshipTypeID:  suite.testRelationshipType.ID,
 		CreatedBy:           suite.testUser.ID,
 	}
-	err = suite.requirementRelationshipRepo.Create(relationship)
+	err = suite.requirementRelationshipRepo.Create(ctx, relationship)
 	suite.Require().NoError(err)
 
 	// Delete relationship
@@ -703,7 +715,7 @@ func (suite *RequirementIntegrationTestSuite) TestDeleteRelationship() {
 	suite.Equal(http.StatusNoContent, w.Code)
 
 	// Verify relationship is deleted
-	_, err = suite.requirementRelationshipRepo.GetByID(relationship.ID)
+	_, err = suite.requirementRelationshipRepo.GetByID(ctx, relationship.ID)
 	suite.Error(err)
 }
 
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -76,6 +77,7 @@ func (suite *UserStoryIntegrationTestSuite) SetupSuite() {
 }
 
 func (suite *UserStoryIntegrationTestSuite) SetupTest() {
+	ctx := context.Background()
 	// Clean up database before each test
 	suite.db.Exec("DELETE FROM user_stories")
 	suite.db.Exec("DELETE FROM epics")
@@ -95,7 +97,7 @@ func (suite *UserStoryIntegrationTestSuite) SetupTest() {
 		Title:       "Test Epic",
 		Description: stringPtr("Test epic description"),
 	}
-	err := suite.epicRepo.Create(suite.testEpic)
+	err := suite.epicRepo.Create(ctx, suite.testEpic)
 	suite.Require().NoError(err)
 }
 
@@ -217,6 +219,7 @@ func (suite *UserStoryIntegrationTestSuite) TestCreateUserStoryRequiresAuthentic
 }
 
 func (suite *UserStoryIntegrationTestSuite) TestGetUserStory() {
+	ctx := context.Background()
 	// Create a user story first
 	description := "As a user, I want to view my profile, so that I can see my information"
 	userStory := &models.UserStory{
@@ -230,7 +233,7 @@ func (suite *UserStoryIntegrationTestSuite) TestGetUserStory() {
 		Title:       "View Profile",
 		Description: &description,
 	}
-	err := suite.userStoryRepo.Create(userStory)
+	err := suite.userStoryRepo.Create(ctx, userStory)
 	suite.Require().NoError(err)
 
 	// Test get by UUID
@@ -257,6 +260,7 @@ func (suite *UserStoryIntegrationTestSuite) TestGetUserStory() {
 }
 
 func (suite *UserStoryIntegrationTestSuite) TestUpdateUserStory() {
+	ctx := context.Background()
 	// Create a user story first
 	description := "As a user, I want to edit my profile, so that I can update my information"
 	userStory := &models.UserStory{
@@ -270,7 +274,7 @@ func (suite *UserStoryIntegrationTestSuite) TestUpdateUserStory() {
 		Title:       "Edit Profile",
 		Description: &description,
 	}
-	err := suite.userStoryRepo.Create(userStory)
+	err := suite.userStoryRepo.Create(ctx, userStory)
 	suite.Require().NoError(err)
 
 	// Update the user story
@@ -303,6 +307,7 @@ func (suite *UserStoryIntegrationTestSuite) TestUpdateUserStory() {
 }
 
 func (suite *UserStoryIntegrationTestSuite) TestUpdateUserStoryWithInvalidTemplate() {
+	ctx := context.Background()
 	// Create a user story first
 	description := "As a user, I want to edit my profile, so that I can update my information"
 	userStory := &models.UserStory{
@@ -316,7 +321,7 @@ func (suite *UserStoryIntegrationTestSuite) TestUpdateUserStoryWithInvalidTempla
 		Title:       "Edit Profile",
 		Description: &description,
 	}
-	err := suite.userStoryRepo.Create(userStory)
+	err := suite.userStoryRepo.Create(ctx, userStory)
 	suite.Require().NoError(err)
 
 	// Try to update with invalid template
@@ -342,6 +347,7 @@ func (suite *UserStoryIntegrationTestSuite) TestUpdateUserStoryWithInvalidTempla
 }
 
 func (suite *UserStoryIntegrationTestSuite) TestDeleteUserStory() {
+	ctx := context.Background()
 	// Create a user story first
 	description := "As a user, I want to delete my account, so that I can remove my data"
 	userStory := &models.UserStory{
@@ -355,7 +361,7 @@ func (suite *UserStoryIntegrationTestSuite) TestDeleteUserStory() {
 		Title:       "Delete Account",
 		Description: &description,
 	}
-	err := suite.userStoryRepo.Create(userStory)
+	err := suite.userStoryRepo.Create(ctx, userStory)
 	suite.Require().NoError(err)
 
 	// Delete the user story
@@ -367,12 +373,13 @@ func (suite *UserStoryIntegrationTestSuite) TestDeleteUserStory() {
 	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
 
 	// Verify it's deleted
-	_, err = suite.userStoryRepo.GetByID(userStory.ID)
+	_, err = suite.userStoryRepo.GetByID(ctx, userStory.ID)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), repository.ErrNotFound, err)
 }
 
 func (suite *UserStoryIntegrationTestSuite) TestListUserStories() {
+	ctx := context.Background()
 	// Create multiple user stories
 	descriptions := []string{
 		"As a user, I want to login, so that I can access my account",
@@ -392,7 +399,7 @@ func (suite *UserStoryIntegrationTestSuite) TestListUserStories() {
 			Title:       fmt.Sprintf("User Story %d", i+1),
 			Description: &desc,
 		}
-		err := suite.userStoryRepo.Create(userStory)
+		err := suite.userStoryRepo.Create(ctx, userStory)
 		suite.Require().NoError(err)
 	}
 
@@ -429,6 +436,7 @@ func (suite *UserStoryIntegrationTestSuite) TestListUserStories() {
 }
 
 func (suite *UserStoryIntegrationTestSuite) TestChangeUserStoryStatus() {
+	ctx := context.Background()
 	// Create a user story first
 	description := "As a user, I want to change my password, so that I can secure my account"
 	userStory := &models.UserStory{
@@ -442,7 +450,7 @@ func (suite *UserStoryIntegrationTestSuite) TestChangeUserStoryStatus() {
 		Title:       "Change Password",
 		Description: &description,
 	}
-	err := suite.userStoryRepo.Create(userStory)
+	err := suite.userStoryRepo.Create(ctx, userStory)
 	suite.Require().NoError(err)
 
 	// Change status
@@ -467,6 +475,7 @@ func (suite *UserStoryIntegrationTestSuite) TestChangeUserStoryStatus() {
 }
 
 func (suite *UserStoryIntegrationTestSuite) TestAssignUserStory() {
+	ctx := context.Background()
 	// Create another user for assignment
 	assignee := &models.User{
 		ID:       uuid.New(),
@@ -474,7 +483,7 @@ func (suite *UserStoryIntegrationTestSuite) TestAssignUserStory() {
 		Email:    "assignee@example.com",
 		Role:     models.RoleUser,
 	}
-	err := suite.userRepo.Create(assignee)
+	err := suite.userRepo.Create(ctx, assignee)
 	suite.Require().NoError(err)
 
 	// Create a user story first
@@ -490,7 +499,7 @@ func (suite *UserStoryIntegrationTestSuite) TestAssignUserStory() {
 		Title:       "Reset Password",
 		Description: &description,
 	}
-	err = suite.userStoryRepo.Create(userStory)
+	err = suite.userStoryRepo.Create(ctx, userStory)
 	suite.Require().NoError(err)
 
 	// Assign to different user
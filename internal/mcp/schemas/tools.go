@@ -123,6 +123,10 @@ func GetSupportedTools() []ToolDefinition {
 						"type":        "string",
 						"description": "Order results by field and direction (default: \"created_at DESC\")",
 					},
+					"sort": map[string]interface{}{
+						"type":        "string",
+						"description": "Comma-separated sort keys with direction, taking precedence over order_by (e.g., \"priority:asc,updated_at:desc\"); fields: reference_id, title, priority, status, due_date, created_at, updated_at",
+					},
 					"limit": map[string]interface{}{
 						"type":        "integer",
 						"description": "Maximum number of results to return (default: 50, max: 100)",
@@ -344,7 +348,7 @@ func GetSupportedTools() []ToolDefinition {
 						"description": "Entity types to search (optional, defaults to all)",
 						"items": map[string]interface{}{
 							"type": "string",
-							"enum": []string{"epic", "user_story", "acceptance_criteria", "requirement"},
+							"enum": []string{"epic", "user_story", "acceptance_criteria", "requirement", "steering_document", "requirement_type", "relationship_type"},
 						},
 					},
 					"limit": map[string]interface{}{
@@ -704,6 +708,12 @@ func GetSupportedTools() []ToolDefinition {
 						"type":        "string",
 						"description": "User story reference ID (e.g., US-001) to retrieve requirements for",
 					},
+					"format": map[string]interface{}{
+						"type":        "string",
+						"description": "Output format: full returns complete requirement objects, compact returns token-efficient entities keyed by reference ID without UUIDs, timestamps or nulls and with descriptions truncated to 200 characters",
+						"enum":        []string{"full", "compact"},
+						"default":     "full",
+					},
 				},
 				"required": []string{"user_story"},
 			},
@@ -720,10 +730,53 @@ func GetSupportedTools() []ToolDefinition {
 						"description": "Epic reference ID (e.g., EP-001) or UUID to retrieve hierarchy for. Supports both reference ID format (EP-XXX) and UUID format.",
 						"pattern":     "^(EP-\\d+|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$",
 					},
+					"format": map[string]interface{}{
+						"type":        "string",
+						"description": "Output format: tree returns the ASCII tree, compact returns nested JSON entities keyed by reference ID without UUIDs, timestamps or nulls and with descriptions truncated to 200 characters",
+						"enum":        []string{"tree", "compact"},
+						"default":     "tree",
+					},
 				},
 				"required": []string{"epic"},
 			},
 		},
+		{
+			Name:        "batch_execute",
+			Title:       "Execute Tool Calls in a Batch",
+			Description: "Execute an ordered list of tool calls in a single transaction and return the result of each step. When a step fails, all changes of the batch are rolled back and the remaining steps are skipped. Arguments can reference results of earlier steps with placeholders: ${id} is the reference ID of the entity returned by the step with that id, and ${id.field} is any field of its result (for example ${epic.id}). Supported tools: create_epic, update_epic, list_epics, epic_hierarchy, create_user_story, update_user_story, get_user_story_requirements, create_requirement, update_requirement, create_relationship and create_acceptance_criteria.",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"steps": map[string]interface{}{
+						"type":        "array",
+						"description": "Tool calls to execute in order (at most 50)",
+						"minItems":    1,
+						"maxItems":    50,
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"id": map[string]interface{}{
+									"type":        "string",
+									"description": "Name that later steps use to reference this step's result in placeholders",
+									"pattern":     "^[A-Za-z0-9_-]+$",
+								},
+								"tool": map[string]interface{}{
+									"type":        "string",
+									"description": "Name of the tool to call",
+									"enum":        []string{"create_epic", "update_epic", "list_epics", "epic_hierarchy", "create_user_story", "update_user_story", "get_user_story_requirements", "create_requirement", "update_requirement", "create_relationship", "create_acceptance_criteria"},
+								},
+								"arguments": map[string]interface{}{
+									"type":        "object",
+									"description": "Arguments of the tool call; string values may contain ${id} or ${id.field} placeholders",
+								},
+							},
+							"required": []string{"tool"},
+						},
+					},
+				},
+				"required": []string{"steps"},
+			},
+		},
 	}
 }
 
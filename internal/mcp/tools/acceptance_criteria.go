@@ -73,7 +73,7 @@ func (h *AcceptanceCriteriaHandler) Create(ctx context.Context, args map[string]
 
 	// Parse user story ID (UUID or reference ID format)
 	userStoryID, err := parseUUIDOrReferenceID(userStoryIDStr, func(refID string) (interface{}, error) {
-		return h.userStoryService.GetUserStoryByReferenceID(refID)
+		return h.userStoryService.GetUserStoryByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'user_story_id': not a valid UUID or reference ID")
@@ -86,7 +86,7 @@ func (h *AcceptanceCriteriaHandler) Create(ctx context.Context, args map[string]
 		Description: description,
 	}
 
-	acceptanceCriteria, err := h.acceptanceCriteriaService.CreateAcceptanceCriteria(req)
+	acceptanceCriteria, err := h.acceptanceCriteriaService.CreateAcceptanceCriteria(ctx, req)
 	if err != nil {
 		// Map service errors to appropriate JSON-RPC error codes
 		switch err {
@@ -19,7 +19,7 @@ type MockAcceptanceCriteriaService struct {
 	mock.Mock
 }
 
-func (m *MockAcceptanceCriteriaService) CreateAcceptanceCriteria(req service.CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) CreateAcceptanceCriteria(ctx context.Context, req service.CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -27,7 +27,7 @@ func (m *MockAcceptanceCriteriaService) CreateAcceptanceCriteria(req service.Cre
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByID(id uuid.UUID) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByID(ctx context.Context, id uuid.UUID) (*models.AcceptanceCriteria, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -35,7 +35,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByID(id uuid.UUID)
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(referenceID string) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -43,7 +43,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(refer
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) UpdateAcceptanceCriteria(id uuid.UUID, req service.UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaService) UpdateAcceptanceCriteria(ctx context.Context, id uuid.UUID, req service.UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -51,12 +51,12 @@ func (m *MockAcceptanceCriteriaService) UpdateAcceptanceCriteria(id uuid.UUID, r
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaService) DeleteAcceptanceCriteria(id uuid.UUID, force bool) error {
+func (m *MockAcceptanceCriteriaService) DeleteAcceptanceCriteria(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockAcceptanceCriteriaService) ListAcceptanceCriteria(filters service.AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error) {
+func (m *MockAcceptanceCriteriaService) ListAcceptanceCriteria(ctx context.Context, filters service.AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error) {
 	args := m.Called(filters)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -64,7 +64,7 @@ func (m *MockAcceptanceCriteriaService) ListAcceptanceCriteria(filters service.A
 	return args.Get(0).([]models.AcceptanceCriteria), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(ctx context.Context, userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
 	args := m.Called(userStoryID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -72,7 +72,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(userSto
 	return args.Get(0).([]models.AcceptanceCriteria), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
+func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
 	args := m.Called(authorID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -80,7 +80,7 @@ func (m *MockAcceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(authorID u
 	return args.Get(0).([]models.AcceptanceCriteria), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockAcceptanceCriteriaService) ValidateUserStoryHasAcceptanceCriteria(userStoryID uuid.UUID) error {
+func (m *MockAcceptanceCriteriaService) ValidateUserStoryHasAcceptanceCriteria(ctx context.Context, userStoryID uuid.UUID) error {
 	args := m.Called(userStoryID)
 	return args.Error(0)
 }
@@ -0,0 +1,305 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"product-requirements-management/internal/jsonrpc"
+	"product-requirements-management/internal/mcp/types"
+)
+
+// maxBatchSteps is the largest number of tool calls accepted by batch_execute
+const maxBatchSteps = 50
+
+// Batch step statuses
+const (
+	BatchStepSucceeded  = "succeeded"
+	BatchStepFailed     = "failed"
+	BatchStepRolledBack = "rolled_back"
+	BatchStepSkipped    = "skipped"
+)
+
+// batchTools are the tools that can run inside batch_execute; they only use services bound to the transaction
+var batchTools = map[string]bool{
+	ToolCreateEpic:               true,
+	ToolUpdateEpic:               true,
+	ToolListEpics:                true,
+	ToolEpicHierarchy:            true,
+	ToolCreateUserStory:          true,
+	ToolUpdateUserStory:          true,
+	ToolGetUserStoryRequirements: true,
+	ToolCreateRequirement:        true,
+	ToolUpdateRequirement:        true,
+	ToolCreateRelationship:       true,
+	ToolCreateAcceptanceCriteria: true,
+}
+
+// batchPlaceholder matches ${step} and ${step.field.path} references to results of earlier steps
+var batchPlaceholder = regexp.MustCompile(`\$\{([A-Za-z0-9_-]+)((?:\.[A-Za-z0-9_]+)*)\}`)
+
+// TransactionRunner runs fn with a tools handler whose services share one database transaction.
+// Returning an error from fn rolls the transaction back.
+type TransactionRunner func(ctx context.Context, fn func(ctx context.Context, handler *Handler) error) error
+
+// BatchStep is one tool call of a batch
+type BatchStep struct {
+	ID        string                 `json:"id,omitempty"`
+	Tool      string                 `json:"tool"`
+	Arguments map[string]interface{} `json:"arguments,omitempty"`
+}
+
+// BatchStepResult is the outcome of one batch step
+type BatchStepResult struct {
+	Index   int         `json:"index"`
+	ID      string      `json:"id,omitempty"`
+	Tool    string      `json:"tool"`
+	Status  string      `json:"status"`
+	Message string      `json:"message,omitempty"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// BatchHandler handles the batch_execute tool
+type BatchHandler struct {
+	transactions TransactionRunner
+}
+
+// NewBatchHandler creates a new batch handler; a nil runner disables batch execution
+func NewBatchHandler(transactions TransactionRunner) *BatchHandler {
+	return &BatchHandler{transactions: transactions}
+}
+
+// GetSupportedTools returns the list of tools this handler supports
+func (h *BatchHandler) GetSupportedTools() []string {
+	return []string{ToolBatchExecute}
+}
+
+// HandleTool processes a specific tool call for this domain
+func (h *BatchHandler) HandleTool(ctx context.Context, toolName string, args map[string]interface{}) (interface{}, error) {
+	switch toolName {
+	case ToolBatchExecute:
+		return h.Execute(ctx, args)
+	default:
+		return nil, jsonrpc.NewMethodNotFoundError(fmt.Sprintf("Unknown batch tool: %s", toolName))
+	}
+}
+
+// Execute runs the steps in order in one transaction.
+// Each step may reference results of earlier steps with ${id.field} placeholders in its arguments;
+// ${id} alone is the reference ID of the entity the step returned.
+// When a step fails the transaction is rolled back and later steps are skipped.
+func (h *BatchHandler) Execute(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	if h.transactions == nil {
+		return nil, jsonrpc.NewInternalError("Batch execution is not available")
+	}
+
+	steps, err := parseBatchSteps(args)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchStepResult, len(steps))
+	for i, step := range steps {
+		results[i] = BatchStepResult{Index: i, ID: step.ID, Tool: step.Tool, Status: BatchStepSkipped}
+	}
+
+	failed := -1
+	errStepFailed := errors.New("batch step failed")
+	err = h.transactions(ctx, func(ctx context.Context, handler *Handler) error {
+		outputs := make(map[string]interface{}, len(steps))
+		for i, step := range steps {
+			result, err := runBatchStep(ctx, handler, step, outputs)
+			if err != nil {
+				failed = i
+				results[i].Status = BatchStepFailed
+				results[i].Error = batchErrorMessage(err)
+				return errStepFailed
+			}
+
+			results[i].Status = BatchStepSucceeded
+			results[i].Message, results[i].Result = toolResponseContent(result)
+			if step.ID != "" {
+				outputs[step.ID] = results[i].Result
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStepFailed) {
+		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to execute batch: %v", err))
+	}
+
+	if failed < 0 {
+		message := fmt.Sprintf("Executed %d steps", len(steps))
+		return types.CreateDataResponse(message, map[string]interface{}{"success": true, "steps": results}), nil
+	}
+
+	for i := 0; i < failed; i++ {
+		results[i].Status = BatchStepRolledBack
+	}
+	message := fmt.Sprintf("Batch failed at step %d (%s): %s. All changes were rolled back.",
+		failed, steps[failed].Tool, results[failed].Error)
+	return types.CreateDataResponse(message, map[string]interface{}{"success": false, "steps": results}), nil
+}
+
+// parseBatchSteps validates the steps argument before anything is executed
+func parseBatchSteps(args map[string]interface{}) ([]BatchStep, error) {
+	rawSteps, ok := args["steps"].([]interface{})
+	if !ok || len(rawSteps) == 0 {
+		return nil, jsonrpc.NewInvalidParamsError("Missing or invalid 'steps' argument")
+	}
+	if len(rawSteps) > maxBatchSteps {
+		return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("A batch can have at most %d steps", maxBatchSteps))
+	}
+
+	steps := make([]BatchStep, len(rawSteps))
+	ids := make(map[string]bool, len(rawSteps))
+	for i, rawStep := range rawSteps {
+		stepMap, ok := rawStep.(map[string]interface{})
+		if !ok {
+			return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Step %d must be an object", i))
+		}
+
+		step := BatchStep{}
+		step.Tool, _ = getStringArg(stepMap, "tool")
+		if !batchTools[step.Tool] {
+			return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Step %d: tool '%s' cannot be used in a batch", i, step.Tool))
+		}
+
+		if id, exists := stepMap["id"]; exists {
+			step.ID, ok = id.(string)
+			if !ok || !batchPlaceholder.MatchString("${"+step.ID+"}") {
+				return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Step %d: 'id' may only contain letters, digits, '_' and '-'", i))
+			}
+			if ids[step.ID] {
+				return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Step %d: duplicate id '%s'", i, step.ID))
+			}
+			ids[step.ID] = true
+		}
+
+		if arguments, exists := stepMap["arguments"]; exists {
+			step.Arguments, ok = arguments.(map[string]interface{})
+			if !ok {
+				return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Step %d: 'arguments' must be an object", i))
+			}
+		}
+		steps[i] = step
+	}
+	return steps, nil
+}
+
+// runBatchStep resolves the placeholders of a step and calls its tool
+func runBatchStep(ctx context.Context, handler *Handler, step BatchStep, outputs map[string]interface{}) (interface{}, error) {
+	arguments, err := resolveBatchValue(step.Arguments, outputs)
+	if err != nil {
+		return nil, err
+	}
+	return handler.HandleToolsCall(ctx, map[string]interface{}{
+		"name":      step.Tool,
+		"arguments": arguments,
+	})
+}
+
+// resolveBatchValue replaces placeholders in all strings of an argument value.
+// A string that is exactly one placeholder takes the referenced value with its JSON type.
+func resolveBatchValue(value interface{}, outputs map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			item, err := resolveBatchValue(item, outputs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = item
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, item := range v {
+			item, err := resolveBatchValue(item, outputs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = item
+		}
+		return resolved, nil
+	case string:
+		if match := batchPlaceholder.FindStringSubmatch(v); match != nil && match[0] == v {
+			return lookupBatchOutput(match[1], match[2], outputs)
+		}
+
+		var lookupErr error
+		resolved := batchPlaceholder.ReplaceAllStringFunc(v, func(placeholder string) string {
+			match := batchPlaceholder.FindStringSubmatch(placeholder)
+			output, err := lookupBatchOutput(match[1], match[2], outputs)
+			if err != nil {
+				lookupErr = err
+				return placeholder
+			}
+			return fmt.Sprint(output)
+		})
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		return resolved, nil
+	default:
+		return value, nil
+	}
+}
+
+// lookupBatchOutput follows a dotted field path into the result of an earlier step
+func lookupBatchOutput(id, path string, outputs map[string]interface{}) (interface{}, error) {
+	value, ok := outputs[id]
+	if !ok {
+		return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Placeholder refers to unknown or later step '%s'", id))
+	}
+
+	fields := strings.Split(strings.TrimPrefix(path, "."), ".")
+	if path == "" {
+		fields = []string{"reference_id"}
+	}
+	for _, field := range fields {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Result of step '%s' has no field '%s'", id, field))
+		}
+		if value, ok = object[field]; !ok {
+			return nil, jsonrpc.NewInvalidParamsError(fmt.Sprintf("Result of step '%s' has no field '%s'", id, field))
+		}
+	}
+	return value, nil
+}
+
+// toolResponseContent splits a tool response into its message and decoded data
+func toolResponseContent(result interface{}) (string, interface{}) {
+	response, ok := result.(*types.ToolResponse)
+	if !ok || len(response.Content) == 0 {
+		return "", result
+	}
+
+	message := response.Content[0].Text
+	if len(response.Content) < 2 {
+		return message, nil
+	}
+	var data interface{}
+	if err := json.Unmarshal([]byte(response.Content[1].Text), &data); err != nil {
+		return message, response.Content[1].Text
+	}
+	return message, data
+}
+
+// batchErrorMessage is the message of a step error, including the details of JSON-RPC errors
+func batchErrorMessage(err error) string {
+	var rpcErr *jsonrpc.JSONRPCError
+	if errors.As(err, &rpcErr) {
+		if rpcErr.Data != nil {
+			return fmt.Sprintf("%s: %v", rpcErr.Message, rpcErr.Data)
+		}
+		return rpcErr.Message
+	}
+	return err.Error()
+}
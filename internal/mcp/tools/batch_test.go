@@ -0,0 +1,177 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/mcp/types"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/service"
+)
+
+// batchTestRunner runs batches with the given epic service and records rollbacks
+type batchTestRunner struct {
+	epicService *MockEpicService
+	runs        int
+	rolledBack  bool
+}
+
+func (r *batchTestRunner) run(ctx context.Context, fn func(context.Context, *Handler) error) error {
+	r.runs++
+	err := fn(ctx, NewHandler(r.epicService, &MockUserService{}, nil, nil, nil, nil, nil, nil))
+	r.rolledBack = err != nil
+	return err
+}
+
+// batchSteps decodes the per-step results of a batch_execute response
+func batchSteps(t *testing.T, result interface{}) (string, bool, []BatchStepResult) {
+	response, ok := result.(*types.ToolResponse)
+	require.True(t, ok)
+	require.Len(t, response.Content, 2)
+
+	var data struct {
+		Success bool              `json:"success"`
+		Steps   []BatchStepResult `json:"steps"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(response.Content[1].Text), &data))
+	return response.Content[0].Text, data.Success, data.Steps
+}
+
+func TestBatchHandler_Execute_ResolvesPlaceholders(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Username: "agent"}
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-007", Title: "Accounts", Priority: models.PriorityHigh, Status: models.EpicStatusBacklog}
+	renamed := *epic
+	renamed.Title = "Accounts v2"
+
+	epicService := &MockEpicService{}
+	epicService.On("CreateEpic", mock.MatchedBy(func(req service.CreateEpicRequest) bool {
+		return req.Title == "Accounts" && req.CreatorID == user.ID
+	})).Return(epic, nil).Once()
+	epicService.On("GetEpicByReferenceID", "EP-007").Return(epic, nil).Once()
+	epicService.On("UpdateEpic", epic.ID, mock.MatchedBy(func(req service.UpdateEpicRequest) bool {
+		return req.Title != nil && *req.Title == "Accounts v2"
+	})).Return(&renamed, nil).Once()
+
+	runner := &batchTestRunner{epicService: epicService}
+	handler := NewBatchHandler(runner.run)
+
+	result, err := handler.HandleTool(createContextWithUser(user), ToolBatchExecute, map[string]interface{}{
+		"steps": []interface{}{
+			map[string]interface{}{"id": "epic", "tool": "create_epic", "arguments": map[string]interface{}{"title": "Accounts", "priority": float64(2)}},
+			map[string]interface{}{"tool": "update_epic", "arguments": map[string]interface{}{"epic_id": "${epic}", "title": "${epic.title} v2"}},
+		},
+	})
+	require.NoError(t, err)
+
+	message, success, steps := batchSteps(t, result)
+	assert.Equal(t, "Executed 2 steps", message)
+	assert.True(t, success)
+	require.Len(t, steps, 2)
+	assert.Equal(t, BatchStepSucceeded, steps[0].Status)
+	assert.Equal(t, "epic", steps[0].ID)
+	assert.Equal(t, BatchStepSucceeded, steps[1].Status)
+	assert.Contains(t, steps[1].Message, "Accounts v2")
+	assert.False(t, runner.rolledBack)
+	epicService.AssertExpectations(t)
+}
+
+func TestBatchHandler_Execute_RollsBackOnFailure(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Username: "agent"}
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-008", Title: "Billing", Priority: models.PriorityHigh, Status: models.EpicStatusBacklog}
+
+	epicService := &MockEpicService{}
+	epicService.On("CreateEpic", mock.Anything).Return(epic, nil).Once()
+
+	runner := &batchTestRunner{epicService: epicService}
+	handler := NewBatchHandler(runner.run)
+
+	result, err := handler.Execute(createContextWithUser(user), map[string]interface{}{
+		"steps": []interface{}{
+			map[string]interface{}{"id": "epic", "tool": "create_epic", "arguments": map[string]interface{}{"title": "Billing", "priority": float64(2)}},
+			map[string]interface{}{"tool": "update_epic", "arguments": map[string]interface{}{"epic_id": "${story.id}"}},
+			map[string]interface{}{"tool": "list_epics"},
+		},
+	})
+	require.NoError(t, err)
+
+	message, success, steps := batchSteps(t, result)
+	assert.False(t, success)
+	assert.Contains(t, message, "step 1 (update_epic)")
+	assert.Contains(t, message, "rolled back")
+	require.Len(t, steps, 3)
+	assert.Equal(t, BatchStepRolledBack, steps[0].Status)
+	assert.Equal(t, BatchStepFailed, steps[1].Status)
+	assert.Contains(t, steps[1].Error, "unknown or later step 'story'")
+	assert.Equal(t, BatchStepSkipped, steps[2].Status)
+	assert.True(t, runner.rolledBack)
+	epicService.AssertExpectations(t)
+}
+
+func TestBatchHandler_Execute_ValidatesSteps(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps interface{}
+	}{
+		{"missing steps", nil},
+		{"empty steps", []interface{}{}},
+		{"tool outside batches", []interface{}{map[string]interface{}{"tool": "search_global"}}},
+		{"nested batch", []interface{}{map[string]interface{}{"tool": "batch_execute"}}},
+		{"invalid id", []interface{}{map[string]interface{}{"id": "my epic", "tool": "list_epics"}}},
+		{"duplicate id", []interface{}{
+			map[string]interface{}{"id": "a", "tool": "list_epics"},
+			map[string]interface{}{"id": "a", "tool": "list_epics"},
+		}},
+		{"arguments not an object", []interface{}{map[string]interface{}{"tool": "list_epics", "arguments": "x"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &batchTestRunner{epicService: &MockEpicService{}}
+			handler := NewBatchHandler(runner.run)
+
+			_, err := handler.Execute(createContextWithUser(&models.User{ID: uuid.New()}), map[string]interface{}{"steps": tt.steps})
+			assert.Error(t, err)
+			assert.Zero(t, runner.runs, "nothing may run when validation fails")
+		})
+	}
+}
+
+func TestBatchHandler_Execute_Disabled(t *testing.T) {
+	handler := NewBatchHandler(nil)
+
+	_, err := handler.Execute(createContextWithUser(&models.User{ID: uuid.New()}), map[string]interface{}{
+		"steps": []interface{}{map[string]interface{}{"tool": "list_epics"}},
+	})
+	assert.Error(t, err)
+}
+
+func TestResolveBatchValue(t *testing.T) {
+	outputs := map[string]interface{}{
+		"epic": map[string]interface{}{"id": "5d1c", "reference_id": "EP-001", "priority": float64(2)},
+	}
+
+	resolved, err := resolveBatchValue(map[string]interface{}{
+		"epic":     "${epic}",
+		"priority": "${epic.priority}",
+		"title":    "Story for ${epic} (${epic.id})",
+		"tags":     []interface{}{"${epic.reference_id}", "plain"},
+		"count":    float64(3),
+	}, outputs)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"epic":     "EP-001",
+		"priority": float64(2),
+		"title":    "Story for EP-001 (5d1c)",
+		"tags":     []interface{}{"EP-001", "plain"},
+		"count":    float64(3),
+	}, resolved)
+
+	_, err = resolveBatchValue("${epic.missing}", outputs)
+	assert.Error(t, err)
+}
@@ -41,4 +41,13 @@ const (
 	ToolActivatePrompt  = "activate_prompt"
 	ToolListPrompts     = "list_prompts"
 	ToolGetActivePrompt = "get_active_prompt"
+
+	// Batch tools
+	ToolBatchExecute = "batch_execute"
+)
+
+// Output formats of read tools; service.EntityFormatCompact selects compact entities
+const (
+	hierarchyFormatTree    = "tree"
+	requirementsFormatFull = "full"
 )
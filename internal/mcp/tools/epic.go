@@ -96,7 +96,7 @@ func (h *EpicHandler) Create(ctx context.Context, args map[string]interface{}) (
 		Description: &description,
 	}
 
-	epic, err := h.epicService.CreateEpic(req)
+	epic, err := h.epicService.CreateEpic(ctx, req)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to create epic: %v", err))
 	}
@@ -115,7 +115,7 @@ func (h *EpicHandler) Update(ctx context.Context, args map[string]interface{}) (
 
 	// Parse epic ID (UUID or reference ID)
 	epicID, err := parseUUIDOrReferenceID(epicIDStr, func(refID string) (interface{}, error) {
-		return h.epicService.GetEpicByReferenceID(refID)
+		return h.epicService.GetEpicByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'epic_id': not a valid UUID or reference ID")
@@ -156,7 +156,7 @@ func (h *EpicHandler) Update(ctx context.Context, args map[string]interface{}) (
 	}
 
 	// Update the epic
-	epic, err := h.epicService.UpdateEpic(epicID, req)
+	epic, err := h.epicService.UpdateEpic(ctx, epicID, req)
 	if err != nil {
 		// Check for status validation errors and provide specific error messages
 		if statusErr, ok := validation.GetStatusValidationError(err); ok {
@@ -210,7 +210,7 @@ func (h *EpicHandler) List(ctx context.Context, args map[string]interface{}) (in
 			if h.userService == nil {
 				return nil, jsonrpc.NewInternalError("User service not configured")
 			}
-			user, err := h.userService.GetByName(creator)
+			user, err := h.userService.GetByName(ctx, creator)
 			if err != nil {
 				if errors.Is(err, service.ErrUserNotFound) {
 					return nil, jsonrpc.NewInvalidParamsError("Creator user not found")
@@ -236,7 +236,7 @@ func (h *EpicHandler) List(ctx context.Context, args map[string]interface{}) (in
 			if h.userService == nil {
 				return nil, jsonrpc.NewInternalError("User service not configured")
 			}
-			user, err := h.userService.GetByName(assignee)
+			user, err := h.userService.GetByName(ctx, assignee)
 			if err != nil {
 				if errors.Is(err, service.ErrUserNotFound) {
 					return nil, jsonrpc.NewInvalidParamsError("Creator user not found")
@@ -287,6 +287,10 @@ func (h *EpicHandler) List(ctx context.Context, args map[string]interface{}) (in
 		filters.OrderBy = orderBy
 	}
 
+	if sort, ok := getStringArg(args, "sort"); ok {
+		filters.Sort = sort
+	}
+
 	if limit, ok := getIntArg(args, "limit"); ok {
 		if limit <= 0 {
 			return nil, jsonrpc.NewInvalidParamsError("Invalid 'limit' value")
@@ -301,8 +305,11 @@ func (h *EpicHandler) List(ctx context.Context, args map[string]interface{}) (in
 		filters.Offset = offset
 	}
 
-	epics, totalCount, err := h.epicService.ListEpics(filters)
+	epics, totalCount, err := h.epicService.ListEpics(ctx, filters)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidSort) {
+			return nil, jsonrpc.NewInvalidParamsError(err.Error())
+		}
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to list epics: %v", err))
 	}
 
@@ -356,16 +363,21 @@ func (h *EpicHandler) GetHierarchy(ctx context.Context, args map[string]interfac
 		return nil, jsonrpc.NewInvalidParamsError("Missing or invalid 'epic' argument")
 	}
 
+	format, _ := getStringArg(args, "format")
+	if format != "" && format != hierarchyFormatTree && format != service.EntityFormatCompact {
+		return nil, jsonrpc.NewInvalidParamsError("Invalid 'format': must be one of tree, compact")
+	}
+
 	// Parse UUID or reference ID
 	epicID, err := parseUUIDOrReferenceID(epicIDStr, func(refID string) (interface{}, error) {
-		return h.epicService.GetEpicByReferenceID(refID)
+		return h.epicService.GetEpicByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'epic': not a valid UUID or reference ID")
 	}
 
 	// Retrieve epic with complete hierarchy
-	epic, err := h.epicService.GetEpicWithCompleteHierarchy(epicID)
+	epic, err := h.epicService.GetEpicWithCompleteHierarchy(ctx, epicID)
 	if err != nil {
 		if errors.Is(err, service.ErrEpicNotFound) {
 			return nil, jsonrpc.NewInvalidParamsError("Epic not found")
@@ -373,6 +385,11 @@ func (h *EpicHandler) GetHierarchy(ctx context.Context, args map[string]interfac
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to retrieve hierarchy: %v", err))
 	}
 
+	if format == service.EntityFormatCompact {
+		message := fmt.Sprintf("Hierarchy of epic %s", epic.ReferenceID)
+		return types.CreateCompactResponse(message, service.CompactEpic(epic)), nil
+	}
+
 	// Format as ASCII tree
 	treeOutput := h.formatTree(epic)
 
@@ -25,7 +25,7 @@ type MockUserService struct {
 	mock.Mock
 }
 
-func (m *MockEpicService) CreateEpic(req service.CreateEpicRequest) (*models.Epic, error) {
+func (m *MockEpicService) CreateEpic(ctx context.Context, req service.CreateEpicRequest) (*models.Epic, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -33,7 +33,7 @@ func (m *MockEpicService) CreateEpic(req service.CreateEpicRequest) (*models.Epi
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) UpdateEpic(id uuid.UUID, req service.UpdateEpicRequest) (*models.Epic, error) {
+func (m *MockEpicService) UpdateEpic(ctx context.Context, id uuid.UUID, req service.UpdateEpicRequest) (*models.Epic, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -41,7 +41,7 @@ func (m *MockEpicService) UpdateEpic(id uuid.UUID, req service.UpdateEpicRequest
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) GetEpicByReferenceID(refID string) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicByReferenceID(ctx context.Context, refID string) (*models.Epic, error) {
 	args := m.Called(refID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -50,7 +50,7 @@ func (m *MockEpicService) GetEpicByReferenceID(refID string) (*models.Epic, erro
 }
 
 // Implement other required methods to satisfy the interface
-func (m *MockEpicService) GetEpicByID(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicByID(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -58,17 +58,17 @@ func (m *MockEpicService) GetEpicByID(id uuid.UUID) (*models.Epic, error) {
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) DeleteEpic(id uuid.UUID, force bool) error {
+func (m *MockEpicService) DeleteEpic(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockEpicService) ListEpics(filters service.EpicFilters) ([]models.Epic, int64, error) {
+func (m *MockEpicService) ListEpics(ctx context.Context, filters service.EpicFilters) ([]models.Epic, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.Epic), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockEpicService) GetEpicWithUserStories(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicWithUserStories(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -76,7 +76,7 @@ func (m *MockEpicService) GetEpicWithUserStories(id uuid.UUID) (*models.Epic, er
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) GetEpicWithCompleteHierarchy(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) GetEpicWithCompleteHierarchy(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -84,7 +84,7 @@ func (m *MockEpicService) GetEpicWithCompleteHierarchy(id uuid.UUID) (*models.Ep
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) ChangeEpicStatus(id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error) {
+func (m *MockEpicService) ChangeEpicStatus(ctx context.Context, id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error) {
 	args := m.Called(id, newStatus)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -92,7 +92,7 @@ func (m *MockEpicService) ChangeEpicStatus(id uuid.UUID, newStatus models.EpicSt
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicService) AssignEpic(id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicService) AssignEpic(ctx context.Context, id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id, assigneeID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -100,7 +100,7 @@ func (m *MockEpicService) AssignEpic(id uuid.UUID, assigneeID *uuid.UUID) (*mode
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockUserService) GetByName(name string) (*models.User, error) {
+func (m *MockUserService) GetByName(ctx context.Context, name string) (*models.User, error) {
 	args := m.Called(name)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -1400,6 +1400,64 @@ func TestEpicHandler_GetHierarchy_Integration(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+// TestEpicHandler_GetHierarchy_CompactFormat tests the compact JSON output of epic_hierarchy
+func TestEpicHandler_GetHierarchy_CompactFormat(t *testing.T) {
+	mockService := &MockEpicService{}
+	handler := NewEpicHandler(mockService, &MockUserService{})
+
+	epicID := uuid.New()
+	description := "Sign up\nand sign in"
+	epic := &models.Epic{
+		ID:          epicID,
+		ReferenceID: "EP-001",
+		Title:       "Accounts",
+		Status:      models.EpicStatusBacklog,
+		Priority:    models.PriorityHigh,
+		Description: &description,
+		UserStories: []models.UserStory{
+			{
+				ReferenceID: "US-001",
+				Title:       "Sign up",
+				Status:      models.UserStoryStatusDraft,
+				Priority:    models.PriorityMedium,
+				Requirements: []models.Requirement{
+					{ReferenceID: "REQ-001", Title: "Password rule", Status: models.RequirementStatusDraft, Priority: models.PriorityLow},
+				},
+			},
+		},
+	}
+
+	mockService.On("GetEpicByReferenceID", "EP-001").Return(epic, nil).Once()
+	mockService.On("GetEpicWithCompleteHierarchy", epicID).Return(epic, nil).Once()
+
+	result, err := handler.HandleTool(context.Background(), "epic_hierarchy", map[string]interface{}{
+		"epic":   "EP-001",
+		"format": "compact",
+	})
+	assert.NoError(t, err)
+
+	response, ok := result.(*types.ToolResponse)
+	assert.True(t, ok)
+	assert.Len(t, response.Content, 2)
+	assert.Equal(t, `{"ref":"EP-001","title":"Accounts","status":"Backlog","priority":2,"desc":"Sign up and sign in","children":[`+
+		`{"ref":"US-001","title":"Sign up","status":"Draft","priority":3,"children":[`+
+		`{"ref":"REQ-001","title":"Password rule","status":"Draft","priority":4}]}]}`, response.Content[1].Text)
+
+	mockService.AssertExpectations(t)
+}
+
+// TestEpicHandler_GetHierarchy_InvalidFormat tests that unknown formats are rejected
+func TestEpicHandler_GetHierarchy_InvalidFormat(t *testing.T) {
+	handler := NewEpicHandler(&MockEpicService{}, &MockUserService{})
+
+	_, err := handler.HandleTool(context.Background(), "epic_hierarchy", map[string]interface{}{
+		"epic":   "EP-001",
+		"format": "yaml",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid params")
+}
+
 // TestEpicHandler_formatSteeringDocument tests the formatSteeringDocument method
 func TestEpicHandler_formatSteeringDocument(t *testing.T) {
 	handler := NewEpicHandler(nil, nil)
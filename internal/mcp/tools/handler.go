@@ -17,6 +17,7 @@ type Handler struct {
 	searchHandler             *SearchHandler
 	steeringDocumentHandler   *SteeringDocumentHandler
 	promptHandler             *PromptHandler
+	batchHandler              *BatchHandler
 
 	// Tool routing map for O(1) lookup performance
 	toolRoutes map[string]ToolHandler
@@ -41,6 +42,7 @@ func NewHandler(
 	searchHandler := NewSearchHandler(searchService, requirementService)
 	steeringDocumentHandler := NewSteeringDocumentHandler(steeringDocumentService, epicService)
 	promptHandler := NewPromptHandler(promptService)
+	batchHandler := NewBatchHandler(nil)
 
 	// Create tool routing map for efficient lookup
 	toolRoutes := make(map[string]ToolHandler)
@@ -80,6 +82,11 @@ func NewHandler(
 		toolRoutes[tool] = promptHandler
 	}
 
+	// Register Batch tools
+	for _, tool := range batchHandler.GetSupportedTools() {
+		toolRoutes[tool] = batchHandler
+	}
+
 	return &Handler{
 		epicHandler:               epicHandler,
 		userStoryHandler:          userStoryHandler,
@@ -88,10 +95,16 @@ func NewHandler(
 		searchHandler:             searchHandler,
 		steeringDocumentHandler:   steeringDocumentHandler,
 		promptHandler:             promptHandler,
+		batchHandler:              batchHandler,
 		toolRoutes:                toolRoutes,
 	}
 }
 
+// SetTransactionRunner enables batch_execute, running batches in transactions provided by the runner
+func (h *Handler) SetTransactionRunner(transactions TransactionRunner) {
+	h.batchHandler.transactions = transactions
+}
+
 // GetAllSupportedTools returns a list of all tools supported by all domain handlers
 func (h *Handler) GetAllSupportedTools() []string {
 	var allTools []string
@@ -103,6 +116,7 @@ func (h *Handler) GetAllSupportedTools() []string {
 	allTools = append(allTools, h.searchHandler.GetSupportedTools()...)
 	allTools = append(allTools, h.steeringDocumentHandler.GetSupportedTools()...)
 	allTools = append(allTools, h.promptHandler.GetSupportedTools()...)
+	allTools = append(allTools, h.batchHandler.GetSupportedTools()...)
 
 	return allTools
 }
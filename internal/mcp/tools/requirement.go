@@ -73,9 +73,9 @@ func (h *RequirementHandler) Create(ctx context.Context, args map[string]interfa
 	var userStory *models.UserStory
 	userStoryUUID, err := uuid.Parse(userStoryIDStr)
 	if err == nil {
-		userStory, err = h.userStoryService.GetUserStoryByID(userStoryUUID)
+		userStory, err = h.userStoryService.GetUserStoryByID(ctx, userStoryUUID)
 	} else {
-		userStory, err = h.userStoryService.GetUserStoryByReferenceID(userStoryIDStr)
+		userStory, err = h.userStoryService.GetUserStoryByReferenceID(ctx, userStoryIDStr)
 	}
 
 	if userStory == nil {
@@ -129,7 +129,7 @@ func (h *RequirementHandler) Create(ctx context.Context, args map[string]interfa
 		Description:          &description,
 	}
 
-	requirement, err := h.requirementService.CreateRequirement(req)
+	requirement, err := h.requirementService.CreateRequirement(ctx, req)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to create requirement: %v", err))
 	}
@@ -148,7 +148,7 @@ func (h *RequirementHandler) Update(ctx context.Context, args map[string]interfa
 
 	// Parse requirement ID (UUID or reference ID)
 	requirementID, err := parseUUIDOrReferenceID(requirementIDStr, func(refID string) (interface{}, error) {
-		return h.requirementService.GetRequirementByReferenceID(refID)
+		return h.requirementService.GetRequirementByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'requirement_id': not a valid UUID or reference ID")
@@ -189,7 +189,7 @@ func (h *RequirementHandler) Update(ctx context.Context, args map[string]interfa
 	}
 
 	// Update the requirement
-	requirement, err := h.requirementService.UpdateRequirement(requirementID, req)
+	requirement, err := h.requirementService.UpdateRequirement(ctx, requirementID, req)
 	if err != nil {
 		// Check for status validation errors and provide specific error messages
 		if statusErr, ok := validation.GetStatusValidationError(err); ok {
@@ -243,7 +243,7 @@ func (h *RequirementHandler) CreateRelationship(ctx context.Context, args map[st
 
 	// Parse source requirement ID (UUID or reference ID)
 	sourceID, err := parseUUIDOrReferenceID(sourceIDStr, func(refID string) (interface{}, error) {
-		return h.requirementService.GetRequirementByReferenceID(refID)
+		return h.requirementService.GetRequirementByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'source_requirement_id': not a valid UUID or reference ID")
@@ -251,7 +251,7 @@ func (h *RequirementHandler) CreateRelationship(ctx context.Context, args map[st
 
 	// Parse target requirement ID (UUID or reference ID)
 	targetID, err := parseUUIDOrReferenceID(targetIDStr, func(refID string) (interface{}, error) {
-		return h.requirementService.GetRequirementByReferenceID(refID)
+		return h.requirementService.GetRequirementByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'target_requirement_id': not a valid UUID or reference ID")
@@ -271,7 +271,7 @@ func (h *RequirementHandler) CreateRelationship(ctx context.Context, args map[st
 		CreatedBy:           user.ID,
 	}
 
-	relationship, err := h.requirementService.CreateRelationship(req)
+	relationship, err := h.requirementService.CreateRelationship(ctx, req)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to create relationship: %v", err))
 	}
@@ -20,7 +20,7 @@ type MockRequirementService struct {
 	mock.Mock
 }
 
-func (m *MockRequirementService) CreateRequirement(req service.CreateRequirementRequest) (*models.Requirement, error) {
+func (m *MockRequirementService) CreateRequirement(ctx context.Context, req service.CreateRequirementRequest) (*models.Requirement, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -28,7 +28,7 @@ func (m *MockRequirementService) CreateRequirement(req service.CreateRequirement
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) UpdateRequirement(id uuid.UUID, req service.UpdateRequirementRequest) (*models.Requirement, error) {
+func (m *MockRequirementService) UpdateRequirement(ctx context.Context, id uuid.UUID, req service.UpdateRequirementRequest) (*models.Requirement, error) {
 	args := m.Called(id, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -36,7 +36,7 @@ func (m *MockRequirementService) UpdateRequirement(id uuid.UUID, req service.Upd
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) GetRequirementByReferenceID(refID string) (*models.Requirement, error) {
+func (m *MockRequirementService) GetRequirementByReferenceID(ctx context.Context, refID string) (*models.Requirement, error) {
 	args := m.Called(refID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -44,7 +44,7 @@ func (m *MockRequirementService) GetRequirementByReferenceID(refID string) (*mod
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) CreateRelationship(req service.CreateRelationshipRequest) (*models.RequirementRelationship, error) {
+func (m *MockRequirementService) CreateRelationship(ctx context.Context, req service.CreateRelationshipRequest) (*models.RequirementRelationship, error) {
 	args := m.Called(req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -53,7 +53,7 @@ func (m *MockRequirementService) CreateRelationship(req service.CreateRelationsh
 }
 
 // Implement other required methods to satisfy the interface
-func (m *MockRequirementService) GetRequirementByID(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementService) GetRequirementByID(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -61,22 +61,22 @@ func (m *MockRequirementService) GetRequirementByID(id uuid.UUID) (*models.Requi
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) DeleteRequirement(id uuid.UUID, force bool) error {
+func (m *MockRequirementService) DeleteRequirement(ctx context.Context, id uuid.UUID, force bool) error {
 	args := m.Called(id, force)
 	return args.Error(0)
 }
 
-func (m *MockRequirementService) ListRequirements(filters service.RequirementFilters) ([]models.Requirement, int64, error) {
+func (m *MockRequirementService) ListRequirements(ctx context.Context, filters service.RequirementFilters) ([]models.Requirement, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.Requirement), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockRequirementService) SearchRequirements(query string) ([]models.Requirement, error) {
+func (m *MockRequirementService) SearchRequirements(ctx context.Context, query string) ([]models.Requirement, error) {
 	args := m.Called(query)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) GetRequirementWithRelationships(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementService) GetRequirementWithRelationships(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -84,7 +84,7 @@ func (m *MockRequirementService) GetRequirementWithRelationships(id uuid.UUID) (
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) ChangeRequirementStatus(id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error) {
+func (m *MockRequirementService) ChangeRequirementStatus(ctx context.Context, id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error) {
 	args := m.Called(id, newStatus)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -92,7 +92,7 @@ func (m *MockRequirementService) ChangeRequirementStatus(id uuid.UUID, newStatus
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) AssignRequirement(id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementService) AssignRequirement(ctx context.Context, id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id, assigneeID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -100,27 +100,48 @@ func (m *MockRequirementService) AssignRequirement(id uuid.UUID, assigneeID uuid
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementService) DeleteRelationship(id uuid.UUID) error {
+func (m *MockRequirementService) DeleteRelationship(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockRequirementService) GetRelationshipsByRequirement(requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockRequirementService) GetRelationshipsByRequirement(ctx context.Context, requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
 	args := m.Called(requirementID)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementService) GetRelationshipsByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
+func (m *MockRequirementService) GetRelationshipsByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
 	args := m.Called(requirementID, limit, offset)
 	return args.Get(0).([]models.RequirementRelationship), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockRequirementService) SearchRequirementsWithPagination(searchText string, limit, offset int) ([]models.Requirement, int64, error) {
+func (m *MockRequirementService) SearchRequirementsWithPagination(ctx context.Context, searchText string, limit, offset int) ([]models.Requirement, int64, error) {
 	args := m.Called(searchText, limit, offset)
 	return args.Get(0).([]models.Requirement), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockRequirementService) GetRequirementsByUserStory(userStoryID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockRequirementService) GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+	args := m.Called(requirementID)
+	return args.Get(0).([]models.AcceptanceCriteria), args.Error(1)
+}
+
+func (m *MockRequirementService) LinkAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID, acceptanceCriteriaIDs []uuid.UUID) (*models.Requirement, error) {
+	args := m.Called(requirementID, acceptanceCriteriaIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Requirement), args.Error(1)
+}
+
+func (m *MockRequirementService) UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) (*models.Requirement, error) {
+	args := m.Called(requirementID, acceptanceCriteriaID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Requirement), args.Error(1)
+}
+
+func (m *MockRequirementService) GetRequirementsByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(userStoryID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
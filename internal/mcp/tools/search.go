@@ -127,7 +127,7 @@ func (h *SearchHandler) Requirements(ctx context.Context, args map[string]interf
 	}
 
 	// Perform the search using the requirement service
-	requirements, err := h.requirementService.SearchRequirements(query)
+	requirements, err := h.requirementService.SearchRequirements(ctx, query)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Requirements search failed: %v", err))
 	}
@@ -100,7 +100,7 @@ func (h *SteeringDocumentHandler) ListSteeringDocuments(ctx context.Context, arg
 	}
 
 	// List steering documents
-	docs, total, err := h.steeringDocumentService.ListSteeringDocuments(filters, user)
+	docs, total, err := h.steeringDocumentService.ListSteeringDocuments(ctx, filters, user)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to list steering documents: %v", err))
 	}
@@ -151,7 +151,7 @@ func (h *SteeringDocumentHandler) CreateSteeringDocument(ctx context.Context, ar
 		EpicID:      epicID,
 	}
 
-	doc, err := h.steeringDocumentService.CreateSteeringDocument(req, user)
+	doc, err := h.steeringDocumentService.CreateSteeringDocument(ctx, req, user)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to create steering document: %v", err))
 	}
@@ -183,13 +183,13 @@ func (h *SteeringDocumentHandler) GetSteeringDocument(ctx context.Context, args
 
 	// Try to parse as UUID first, then as reference ID
 	if steeringDocID, err := uuid.Parse(steeringDocIDStr); err == nil {
-		doc, err = h.steeringDocumentService.GetSteeringDocumentByID(steeringDocID, user)
+		doc, err = h.steeringDocumentService.GetSteeringDocumentByID(ctx, steeringDocID, user)
 		if err != nil {
 			return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get steering document by ID: %v", err))
 		}
 	} else {
 		// Try to get by reference ID
-		doc, err = h.steeringDocumentService.GetSteeringDocumentByReferenceID(steeringDocIDStr, user)
+		doc, err = h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, steeringDocIDStr, user)
 		if err != nil {
 			return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get steering document by reference ID: %v", err))
 		}
@@ -222,7 +222,7 @@ func (h *SteeringDocumentHandler) UpdateSteeringDocument(ctx context.Context, ar
 		steeringDocID = parsedID
 	} else {
 		// Try to get by reference ID
-		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(steeringDocIDStr, user)
+		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, steeringDocIDStr, user)
 		if err != nil {
 			return nil, jsonrpc.NewInvalidParamsError("Invalid 'steering_document_id': not a valid UUID or reference ID")
 		}
@@ -241,7 +241,7 @@ func (h *SteeringDocumentHandler) UpdateSteeringDocument(ctx context.Context, ar
 	}
 
 	// Update the steering document
-	doc, err := h.steeringDocumentService.UpdateSteeringDocument(steeringDocID, req, user)
+	doc, err := h.steeringDocumentService.UpdateSteeringDocument(ctx, steeringDocID, req, user)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to update steering document: %v", err))
 	}
@@ -277,7 +277,7 @@ func (h *SteeringDocumentHandler) LinkSteeringToEpic(ctx context.Context, args m
 		steeringDocID = parsedID
 	} else {
 		// Try to get by reference ID
-		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(steeringDocIDStr, user)
+		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, steeringDocIDStr, user)
 		if err != nil {
 			return nil, jsonrpc.NewInvalidParamsError("Invalid 'steering_document_id': not a valid UUID or reference ID")
 		}
@@ -290,7 +290,7 @@ func (h *SteeringDocumentHandler) LinkSteeringToEpic(ctx context.Context, args m
 		epicID = parsedID
 	} else {
 		// Try to get by reference ID
-		epic, err := h.epicService.GetEpicByReferenceID(epicIDStr)
+		epic, err := h.epicService.GetEpicByReferenceID(ctx, epicIDStr)
 		if err != nil {
 			return nil, jsonrpc.NewInvalidParamsError("Invalid 'epic_id': not a valid UUID or reference ID")
 		}
@@ -298,7 +298,7 @@ func (h *SteeringDocumentHandler) LinkSteeringToEpic(ctx context.Context, args m
 	}
 
 	// Create the link
-	err = h.steeringDocumentService.LinkSteeringDocumentToEpic(steeringDocID, epicID, user)
+	err = h.steeringDocumentService.LinkSteeringDocumentToEpic(ctx, steeringDocID, epicID, user)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to link steering document to epic: %v", err))
 	}
@@ -333,7 +333,7 @@ func (h *SteeringDocumentHandler) UnlinkSteeringFromEpic(ctx context.Context, ar
 		steeringDocID = parsedID
 	} else {
 		// Try to get by reference ID
-		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(steeringDocIDStr, user)
+		doc, err := h.steeringDocumentService.GetSteeringDocumentByReferenceID(ctx, steeringDocIDStr, user)
 		if err != nil {
 			return nil, jsonrpc.NewInvalidParamsError("Invalid 'steering_document_id': not a valid UUID or reference ID")
 		}
@@ -346,7 +346,7 @@ func (h *SteeringDocumentHandler) UnlinkSteeringFromEpic(ctx context.Context, ar
 		epicID = parsedID
 	} else {
 		// Try to get by reference ID
-		epic, err := h.epicService.GetEpicByReferenceID(epicIDStr)
+		epic, err := h.epicService.GetEpicByReferenceID(ctx, epicIDStr)
 		if err != nil {
 			return nil, jsonrpc.NewInvalidParamsError("Invalid 'epic_id': not a valid UUID or reference ID")
 		}
@@ -354,7 +354,7 @@ func (h *SteeringDocumentHandler) UnlinkSteeringFromEpic(ctx context.Context, ar
 	}
 
 	// Remove the link
-	err = h.steeringDocumentService.UnlinkSteeringDocumentFromEpic(steeringDocID, epicID, user)
+	err = h.steeringDocumentService.UnlinkSteeringDocumentFromEpic(ctx, steeringDocID, epicID, user)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to unlink steering document from epic: %v", err))
 	}
@@ -385,7 +385,7 @@ func (h *SteeringDocumentHandler) GetEpicSteeringDocuments(ctx context.Context,
 		epicID = parsedID
 	} else {
 		// Try to get by reference ID
-		epic, err := h.epicService.GetEpicByReferenceID(epicIDStr)
+		epic, err := h.epicService.GetEpicByReferenceID(ctx, epicIDStr)
 		if err != nil {
 			return nil, jsonrpc.NewInvalidParamsError("Invalid 'epic_id': not a valid UUID or reference ID")
 		}
@@ -393,7 +393,7 @@ func (h *SteeringDocumentHandler) GetEpicSteeringDocuments(ctx context.Context,
 	}
 
 	// Get steering documents for the epic
-	docs, err := h.steeringDocumentService.GetSteeringDocumentsByEpicID(epicID, user)
+	docs, err := h.steeringDocumentService.GetSteeringDocumentsByEpicID(ctx, epicID, user)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to get steering documents for epic: %v", err))
 	}
@@ -20,7 +20,7 @@ type MockSteeringDocumentService struct {
 	mock.Mock
 }
 
-func (m *MockSteeringDocumentService) CreateSteeringDocument(req service.CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) CreateSteeringDocument(ctx context.Context, req service.CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(req, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -28,7 +28,7 @@ func (m *MockSteeringDocumentService) CreateSteeringDocument(req service.CreateS
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentByID(id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentByID(ctx context.Context, id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(id, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -36,7 +36,7 @@ func (m *MockSteeringDocumentService) GetSteeringDocumentByID(id uuid.UUID, curr
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentByReferenceID(referenceID string, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentByReferenceID(ctx context.Context, referenceID string, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(referenceID, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -44,7 +44,7 @@ func (m *MockSteeringDocumentService) GetSteeringDocumentByReferenceID(reference
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) UpdateSteeringDocument(id uuid.UUID, req service.UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) UpdateSteeringDocument(ctx context.Context, id uuid.UUID, req service.UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
 	args := m.Called(id, req, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -52,12 +52,12 @@ func (m *MockSteeringDocumentService) UpdateSteeringDocument(id uuid.UUID, req s
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) DeleteSteeringDocument(id uuid.UUID, currentUser *models.User) error {
+func (m *MockSteeringDocumentService) DeleteSteeringDocument(ctx context.Context, id uuid.UUID, currentUser *models.User) error {
 	args := m.Called(id, currentUser)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentService) ListSteeringDocuments(filters service.SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
+func (m *MockSteeringDocumentService) ListSteeringDocuments(ctx context.Context, filters service.SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
 	args := m.Called(filters, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -65,7 +65,7 @@ func (m *MockSteeringDocumentService) ListSteeringDocuments(filters service.Stee
 	return args.Get(0).([]models.SteeringDocument), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockSteeringDocumentService) SearchSteeringDocuments(query string, currentUser *models.User) ([]models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) SearchSteeringDocuments(ctx context.Context, query string, currentUser *models.User) ([]models.SteeringDocument, error) {
 	args := m.Called(query, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -73,7 +73,7 @@ func (m *MockSteeringDocumentService) SearchSteeringDocuments(query string, curr
 	return args.Get(0).([]models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicID(epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicID(ctx context.Context, epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error) {
 	args := m.Called(epicID, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -81,7 +81,7 @@ func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicID(epicID uuid.U
 	return args.Get(0).([]models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination(epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
+func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination(ctx context.Context, epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
 	args := m.Called(epicID, limit, offset, currentUser)
 	if args.Get(0) == nil {
 		return nil, args.Get(1).(int64), args.Error(2)
@@ -89,12 +89,12 @@ func (m *MockSteeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination
 	return args.Get(0).([]models.SteeringDocument), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockSteeringDocumentService) LinkSteeringDocumentToEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
+func (m *MockSteeringDocumentService) LinkSteeringDocumentToEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
 	args := m.Called(steeringDocumentID, epicID, currentUser)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentService) UnlinkSteeringDocumentFromEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
+func (m *MockSteeringDocumentService) UnlinkSteeringDocumentFromEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
 	args := m.Called(steeringDocumentID, epicID, currentUser)
 	return args.Error(0)
 }
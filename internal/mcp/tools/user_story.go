@@ -78,7 +78,7 @@ func (h *UserStoryHandler) Create(ctx context.Context, args map[string]interface
 
 	// Parse epic ID (UUID or reference ID)
 	epicID, err := parseUUIDOrReferenceID(epicIDStr, func(refID string) (interface{}, error) {
-		return h.epicService.GetEpicByReferenceID(refID)
+		return h.epicService.GetEpicByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'epic_id': not a valid UUID or reference ID")
@@ -111,7 +111,7 @@ func (h *UserStoryHandler) Create(ctx context.Context, args map[string]interface
 		Description: &description,
 	}
 
-	userStory, err := h.userStoryService.CreateUserStory(req)
+	userStory, err := h.userStoryService.CreateUserStory(ctx, req)
 	if err != nil {
 		return nil, jsonrpc.NewInternalError(fmt.Sprintf("Failed to create user story: %v", err))
 	}
@@ -134,7 +134,7 @@ func (h *UserStoryHandler) Update(ctx context.Context, args map[string]interface
 
 	// Parse user story ID (UUID or reference ID)
 	userStoryID, err := parseUUIDOrReferenceID(userStoryIDStr, func(refID string) (interface{}, error) {
-		return h.userStoryService.GetUserStoryByReferenceID(refID)
+		return h.userStoryService.GetUserStoryByReferenceID(ctx, refID)
 	})
 	if err != nil {
 		return nil, jsonrpc.NewInvalidParamsError("Invalid 'user_story_id': not a valid UUID or reference ID")
@@ -175,7 +175,7 @@ func (h *UserStoryHandler) Update(ctx context.Context, args map[string]interface
 	}
 
 	// Update the user story
-	userStory, err := h.userStoryService.UpdateUserStory(userStoryID, req)
+	userStory, err := h.userStoryService.UpdateUserStory(ctx, userStoryID, req)
 	if err != nil {
 		// Check for status validation errors and provide specific error messages
 		if statusErr, ok := validation.GetStatusValidationError(err); ok {
@@ -215,9 +215,14 @@ func (h *UserStoryHandler) GetRequirements(ctx context.Context, args map[string]
 		return nil, jsonrpc.NewInvalidParamsError("Missing or invalid 'user_story' argument")
 	}
 
+	format, _ := getStringArg(args, "format")
+	if format != "" && format != requirementsFormatFull && format != service.EntityFormatCompact {
+		return nil, jsonrpc.NewInvalidParamsError("Invalid 'format': must be one of full, compact")
+	}
+
 	// Parse user story ID using existing parseUUIDOrReferenceID helper
 	var userStoryID uuid.UUID
-	if userStory, err := h.userStoryService.GetUserStoryByReferenceID(userStoryIDStr); err == nil && userStory != nil {
+	if userStory, err := h.userStoryService.GetUserStoryByReferenceID(ctx, userStoryIDStr); err == nil && userStory != nil {
 		userStoryID = userStory.ID
 	} else {
 		return nil, jsonrpc.NewInvalidParamsError("User story not found")
@@ -238,12 +243,16 @@ func (h *UserStoryHandler) GetRequirements(ctx context.Context, args map[string]
 		message = fmt.Sprintf("Found %d requirements for user story %s.", len(requirements), userStoryIDStr)
 	}
 
+	if format == service.EntityFormatCompact {
+		return types.CreateCompactResponse(message, service.CompactRequirements(requirements)), nil
+	}
+
 	// Return response using types.CreateSuccessResponse
 	return types.CreateDataResponse(message, requirements), nil
 }
 
 // getRequirementsWithRelatedData retrieves requirements with all related data preloaded
-func (h *UserStoryHandler) getRequirementsWithRelatedData(_ context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
+func (h *UserStoryHandler) getRequirementsWithRelatedData(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
 	// Create RequirementFilters with UserStoryID filter
 	filters := service.RequirementFilters{
 		UserStoryID: &userStoryID,
@@ -253,7 +262,7 @@ func (h *UserStoryHandler) getRequirementsWithRelatedData(_ context.Context, use
 	}
 
 	// Call requirementService.ListRequirements with filters
-	requirements, _, err := h.requirementService.ListRequirements(filters)
+	requirements, _, err := h.requirementService.ListRequirements(ctx, filters)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list requirements: %w", err)
 	}
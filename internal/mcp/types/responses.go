@@ -52,3 +52,22 @@ func CreateSuccessResponse(message string) *ToolResponse {
 func CreateDataResponse(message string, data interface{}) *ToolResponse {
 	return CreateToolResponse(message, data)
 }
+
+// CreateCompactResponse creates a response with a message and data serialized without indentation
+func CreateCompactResponse(message string, data interface{}) *ToolResponse {
+	content := []ContentItem{
+		{
+			Type: "text",
+			Text: message,
+		},
+	}
+
+	if jsonData, err := json.Marshal(data); err == nil {
+		content = append(content, ContentItem{
+			Type: "text",
+			Text: string(jsonData),
+		})
+	}
+
+	return &ToolResponse{Content: content}
+}
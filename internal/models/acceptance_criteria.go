@@ -40,13 +40,15 @@ type AcceptanceCriteria struct {
 	CreatedAt   time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                                                                                                // Timestamp when the acceptance criteria was created
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at" example:"2023-01-02T12:30:00Z"`                                                                                                                // Timestamp when the acceptance criteria was last modified
 	Description string    `gorm:"not null" json:"description" validate:"required" example:"WHEN a user enters valid credentials THEN the system SHALL authenticate the user and redirect to the dashboard"` // EARS format description of the acceptance criteria
+	AIGenerated bool      `gorm:"not null;default:false" json:"ai_generated" example:"false"`                                                                                                               // Whether the acceptance criteria was generated by a language model
+	AIModel     *string   `json:"ai_model,omitempty" example:"openai/gpt-4o"`                                                                                                                               // Provider and model that generated the acceptance criteria
 
 	// Relationships - These fields are populated when explicitly preloaded and included in JSON via custom MarshalJSON
 	// @Description Parent user story that this acceptance criteria belongs to (included only when preloaded via repository methods)
 	UserStory UserStory `gorm:"foreignKey:UserStoryID;constraint:OnDelete:CASCADE" json:"-"`
 	// @Description User who authored this acceptance criteria (included only when preloaded via repository methods)
 	Author User `gorm:"foreignKey:AuthorID;constraint:OnDelete:RESTRICT" json:"-"`
-	// @Description Requirements linked to this acceptance criteria (included only when preloaded)
+	// @Description Requirements having this acceptance criteria as their primary one (included only when preloaded)
 	Requirements []Requirement `gorm:"foreignKey:AcceptanceCriteriaID;constraint:OnDelete:SET NULL" json:"requirements,omitempty"`
 	// @Description Comments associated with this acceptance criteria (included only when preloaded)
 	Comments []Comment `gorm:"polymorphic:Entity;polymorphicValue:acceptance_criteria" json:"comments,omitempty"`
@@ -126,6 +128,12 @@ func (ac *AcceptanceCriteria) MarshalJSON() ([]byte, error) {
 		"created_at":    ac.CreatedAt,
 		"updated_at":    ac.UpdatedAt,
 		"description":   ac.Description,
+		"ai_generated":  ac.AIGenerated,
+	}
+
+	// Only include the model of AI-generated acceptance criteria
+	if ac.AIModel != nil {
+		result["ai_model"] = *ac.AIModel
 	}
 
 	// Only include user_story if it has been populated (has a title, indicating it was preloaded)
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIUsageCounter counts the API requests a user made on a day with one credential
+// @Description Number of authenticated API requests a user made on a UTC day, per personal access token
+type APIUsageCounter struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                      // Unique identifier for the counter
+	UserID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_api_usage_counters_user_token_day" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174001"`  // ID of the user who made the requests
+	TokenID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_api_usage_counters_user_token_day" json:"token_id" example:"123e4567-e89b-12d3-a456-426614174002"` // Personal access token the requests were made with; the nil UUID for JWT sessions
+	Day           time.Time `gorm:"type:date;not null;uniqueIndex:idx_api_usage_counters_user_token_day;index" json:"day" example:"2023-01-01T00:00:00Z"`                // UTC day the requests were made on
+	Requests      int64     `gorm:"not null;default:0" json:"requests" example:"42"`                                                                                     // Number of requests
+	LastRequestAt time.Time `gorm:"not null" json:"last_request_at" example:"2023-01-01T17:45:00Z"`                                                                      // Timestamp of the latest request
+}
+
+// BeforeCreate sets the ID if not already set
+func (c *APIUsageCounter) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the APIUsageCounter model
+func (APIUsageCounter) TableName() string {
+	return "api_usage_counters"
+}
+
+// APIQuota limits the number of API requests per day, either for every user with a role or for a single user.
+// A user quota replaces the quota of the user's role. Administrators are never limited.
+// @Description Daily API request quota of a role or a user; unset limits are unlimited
+type APIQuota struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                // Unique identifier for the quota
+	Role          *UserRole  `gorm:"type:varchar(20);uniqueIndex" json:"role,omitempty" example:"User"`                             // Role the quota applies to (role quotas)
+	UserID        *uuid.UUID `gorm:"type:uuid;uniqueIndex" json:"user_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"` // User the quota applies to (user quotas)
+	DailyLimit    *int64     `json:"daily_limit,omitempty" example:"10000"`                                                         // Requests allowed per UTC day with any credential
+	PATDailyLimit *int64     `gorm:"column:pat_daily_limit" json:"pat_daily_limit,omitempty" example:"2000"`                        // Requests allowed per UTC day with personal access tokens
+	CreatedAt     time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                     // Timestamp when the quota was created
+	UpdatedAt     time.Time  `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                     // Timestamp when the quota was last changed
+
+	// User is the user of a user quota (populated when preloaded)
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (q *APIQuota) BeforeCreate(tx *gorm.DB) error {
+	if q.ID == uuid.Nil {
+		q.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the APIQuota model
+func (APIQuota) TableName() string {
+	return "api_quotas"
+}
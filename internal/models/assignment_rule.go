@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AssignmentRule assigns new entities to a user when they are created without an assignee
+// @Description Server-side default assignee for new epics, user stories or requirements. Rules are evaluated by ascending priority and the first enabled rule whose conditions all match assigns the entity; unset conditions match any entity.
+type AssignmentRule struct {
+	ID                uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                // Unique identifier of the rule
+	Name              string     `gorm:"not null" json:"name" validate:"required,max=255" example:"Checkout requirements to Alice"`     // Name shown to administrators
+	EntityType        EntityType `gorm:"not null;index" json:"entity_type" example:"requirement"`                                       // Type of entity the rule applies to: epic, user_story or requirement
+	Priority          int        `gorm:"not null;default:0" json:"priority" example:"10"`                                               // Evaluation order; lower values are evaluated first
+	Enabled           bool       `gorm:"not null" json:"enabled" example:"true"`                                                        // Disabled rules are skipped
+	EpicID            *uuid.UUID `gorm:"type:uuid" json:"epic_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`             // Condition: the entity belongs to this epic (user stories and requirements)
+	CreatorRole       *UserRole  `json:"creator_role,omitempty" example:"Commenter"`                                                    // Condition: the entity is created by a user with this role
+	RequirementTypeID *uuid.UUID `gorm:"type:uuid" json:"requirement_type_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"` // Condition: the requirement has this type (requirements only)
+	AssigneeID        uuid.UUID  `gorm:"type:uuid;not null" json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174003"`          // User assigned to matching entities
+	CreatorID         uuid.UUID  `gorm:"type:uuid;not null" json:"creator_id" example:"123e4567-e89b-12d3-a456-426614174004"`           // Administrator who created the rule
+	CreatedAt         time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                     // Timestamp when the rule was created
+	UpdatedAt         time.Time  `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                     // Timestamp when the rule was last changed
+
+	// Assignee is the user assigned by the rule (populated when preloaded)
+	Assignee *User `gorm:"foreignKey:AssigneeID;constraint:OnDelete:RESTRICT" json:"assignee,omitempty"`
+	// Epic is the epic of the epic condition; rules are removed together with their epic
+	Epic *Epic `gorm:"foreignKey:EpicID;constraint:OnDelete:CASCADE" json:"-"`
+	// RequirementType is the type of the requirement type condition; rules are removed together with their type
+	RequirementType *RequirementType `gorm:"foreignKey:RequirementTypeID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (ar *AssignmentRule) BeforeCreate(tx *gorm.DB) error {
+	if ar.ID == uuid.Nil {
+		ar.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the AssignmentRule model
+func (AssignmentRule) TableName() string {
+	return "assignment_rules"
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AuditAction represents the kind of change recorded in the audit log
+// @Description Kind of change recorded for an entity
+// @Example "status_changed"
+type AuditAction string
+
+const (
+	AuditActionCreated             AuditAction = "created"              // Entity was created
+	AuditActionEdited              AuditAction = "edited"               // Title or description was changed
+	AuditActionStatusChanged       AuditAction = "status_changed"       // Workflow status was changed
+	AuditActionAssigned            AuditAction = "assigned"             // Assignee was changed
+	AuditActionCommented           AuditAction = "commented"            // Comment was added
+	AuditActionRelationshipAdded   AuditAction = "relationship_added"   // Requirement relationship was added
+	AuditActionRelationshipRemoved AuditAction = "relationship_removed" // Requirement relationship was removed
+	AuditActionProposalAccepted    AuditAction = "proposal_accepted"    // Change proposed by the actor was applied
+	AuditActionMerged              AuditAction = "merged"               // Another epic was merged into the entity
+	AuditActionArchived            AuditAction = "archived"             // Entity was archived
+	AuditActionUnarchived          AuditAction = "unarchived"           // Entity was restored from the archive
+	AuditActionFrozen              AuditAction = "frozen"               // Requirements of the epic were frozen
+	AuditActionUnfrozen            AuditAction = "unfrozen"             // Requirements freeze of the epic was lifted
+	AuditActionPasswordIssued      AuditAction = "password_issued"      // Temporary password of the user was handed to an administrator
+)
+
+// EntityTypeUser is the entity type of audit events about user accounts
+const EntityTypeUser EntityType = "user"
+
+// AuditEvent represents a single recorded change to an entity
+// @Description Immutable audit log entry describing a change to an epic, user story, acceptance criteria or requirement
+type AuditEvent struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                   // Unique identifier for the event
+	EntityType EntityType  `gorm:"not null;index:idx_audit_events_entity" json:"entity_type" example:"requirement"`                                  // Type of the changed entity
+	EntityID   uuid.UUID   `gorm:"type:uuid;not null;index:idx_audit_events_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the changed entity
+	Action     AuditAction `gorm:"not null" json:"action" example:"status_changed"`                                                                  // Kind of change
+	ActorID    *uuid.UUID  `gorm:"type:uuid;index" json:"actor_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`                         // User who made the change (omitted when unknown)
+	Field      string      `json:"field,omitempty" example:"status"`                                                                                 // Changed field for edits, status changes and assignments
+	OldValue   *string     `json:"old_value,omitempty" example:"Draft"`                                                                              // Previous value (omitted for text edits)
+	NewValue   *string     `json:"new_value,omitempty" example:"Active"`                                                                             // New value (omitted for text edits)
+	RelatedID  *uuid.UUID  `gorm:"type:uuid" json:"related_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174003"`                             // Related comment or requirement for comment and relationship events
+	CreatedAt  time.Time   `gorm:"not null;index" json:"created_at" example:"2023-01-01T10:00:00Z"`                                                  // Timestamp when the change happened
+
+	// Actor is the user who made the change (populated when preloaded)
+	Actor *User `gorm:"foreignKey:ActorID;constraint:OnDelete:SET NULL" json:"actor,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (e *AuditEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the AuditEvent model
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BoardRank stores the position of an entity card on Kanban boards
+// @Description Position of an entity card within its board column; lower ranks are shown first
+type BoardRank struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                        // Unique identifier for the rank record
+	EntityType EntityType `gorm:"not null;uniqueIndex:idx_board_ranks_entity" json:"entity_type" example:"user_story"`                                   // Type of the ranked entity
+	EntityID   uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_board_ranks_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the ranked entity
+	Rank       int        `gorm:"not null" json:"rank" example:"1024"`                                                                                   // Position of the card; lower ranks come first
+	UpdatedAt  time.Time  `json:"updated_at" example:"2023-01-01T10:00:00Z"`                                                                             // Timestamp when the card was last moved
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *BoardRank) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the BoardRank model
+func (BoardRank) TableName() string {
+	return "board_ranks"
+}
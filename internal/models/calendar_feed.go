@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CalendarFeed represents a revocable, tokenized iCal feed URL
+// @Description iCal feed subscription of a user; the feed URL is only returned when the feed is created
+type CalendarFeed struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`         // Unique identifier for the feed
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the user who owns the feed
+	EpicID     *uuid.UUID `gorm:"type:uuid" json:"epic_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`      // Epic covered by the feed; empty for the user's personal feed
+	TokenHash  string     `gorm:"size:64;not null;uniqueIndex" json:"-"`                                                  // SHA-256 hash of the feed token (never exposed in JSON)
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2023-01-15T10:30:00Z"`                                  // Timestamp when a calendar client last fetched the feed
+	CreatedAt  time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                              // Timestamp when the feed was created
+}
+
+// BeforeCreate sets the ID if not already set
+func (f *CalendarFeed) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the CalendarFeed model
+func (CalendarFeed) TableName() string {
+	return "calendar_feeds"
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ChangeProposalStatus represents the review state of a change proposal
+// @Description Review state of a change proposal
+// @Example "pending"
+type ChangeProposalStatus string
+
+const (
+	ChangeProposalPending   ChangeProposalStatus = "pending"   // Waiting for review
+	ChangeProposalAccepted  ChangeProposalStatus = "accepted"  // Applied to the entity by a reviewer
+	ChangeProposalRejected  ChangeProposalStatus = "rejected"  // Declined by a reviewer
+	ChangeProposalWithdrawn ChangeProposalStatus = "withdrawn" // Withdrawn by the proposer
+)
+
+// IsValid reports whether the status is one of the supported values
+func (s ChangeProposalStatus) IsValid() bool {
+	switch s {
+	case ChangeProposalPending, ChangeProposalAccepted, ChangeProposalRejected, ChangeProposalWithdrawn:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChangeProposal is a suggested edit of an entity's title, description or priority that an editor accepts or rejects
+// @Description Suggested edit of an entity, made by a user who may not edit it directly and reviewed by an editor
+type ChangeProposal struct {
+	ID              uuid.UUID            `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                       // Unique identifier of the proposal
+	EntityType      EntityType           `gorm:"not null;index:idx_change_proposals_entity" json:"entity_type" example:"requirement"`                                  // Type of the entity the change is proposed for
+	EntityID        uuid.UUID            `gorm:"type:uuid;not null;index:idx_change_proposals_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the entity the change is proposed for
+	ProposerID      uuid.UUID            `gorm:"type:uuid;not null;index" json:"proposer_id" example:"123e4567-e89b-12d3-a456-426614174002"`                           // User who proposed the change
+	Title           *string              `json:"title,omitempty" example:"User authentication must support OAuth 2.0 and SAML"`                                        // Proposed title (not supported for acceptance criteria)
+	Description     *string              `gorm:"type:text" json:"description,omitempty" example:"The system shall support OAuth 2.0 and SAML..."`                      // Proposed description
+	Priority        *Priority            `json:"priority,omitempty" example:"2"`                                                                                       // Proposed priority (not supported for acceptance criteria)
+	Rationale       string               `gorm:"type:text" json:"rationale,omitempty" example:"SAML is required by enterprise customers"`                              // Why the change is proposed
+	Status          ChangeProposalStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status" example:"pending"`                                          // Review state
+	BaseUpdatedAt   time.Time            `gorm:"not null" json:"base_updated_at" example:"2023-01-01T00:00:00Z"`                                                       // Entity updated_at when the change was proposed (used for conflict detection)
+	ReviewerID      *uuid.UUID           `gorm:"type:uuid" json:"reviewer_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174003"`                                // Editor who accepted or rejected the proposal
+	ReviewedAt      *time.Time           `json:"reviewed_at,omitempty" example:"2023-01-02T09:00:00Z"`                                                                 // When the proposal was accepted, rejected or withdrawn
+	RejectionReason *string              `gorm:"type:text" json:"rejection_reason,omitempty" example:"Covered by REQ-012"`                                             // Reason given by the reviewer when rejecting
+	CreatedAt       time.Time            `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                            // Timestamp when the change was proposed
+	UpdatedAt       time.Time            `json:"updated_at" example:"2023-01-02T09:00:00Z"`                                                                            // Timestamp when the proposal was last changed
+
+	// Proposer is the user who proposed the change (populated when preloaded)
+	Proposer *User `gorm:"foreignKey:ProposerID;constraint:OnDelete:CASCADE" json:"proposer,omitempty"`
+	// Reviewer is the editor who reviewed the proposal (populated when preloaded)
+	Reviewer *User `gorm:"foreignKey:ReviewerID;constraint:OnDelete:SET NULL" json:"reviewer,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (p *ChangeProposal) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the ChangeProposal model
+func (ChangeProposal) TableName() string {
+	return "change_proposals"
+}
+
+// IsStale checks if the entity was modified after the change was proposed
+func (p *ChangeProposal) IsStale(entityUpdatedAt time.Time) bool {
+	return entityUpdatedAt.After(p.BaseUpdatedAt)
+}
@@ -20,18 +20,40 @@ const (
 	EntityTypeRequirement        EntityType = "requirement"         // Requirement - detailed technical requirement
 )
 
+// CommentCategory classifies the intent of a comment
+// @Description Optional category of a comment used to separate blocking concerns from general discussion
+// @Example "blocker"
+type CommentCategory string
+
+const (
+	CommentCategoryQuestion   CommentCategory = "question"   // Question - asks for clarification
+	CommentCategoryBlocker    CommentCategory = "blocker"    // Blocker - must be addressed before the entity can progress
+	CommentCategorySuggestion CommentCategory = "suggestion" // Suggestion - proposes an optional improvement
+)
+
+// IsValid checks if the comment category is one of the supported values
+func (c CommentCategory) IsValid() bool {
+	switch c {
+	case CommentCategoryQuestion, CommentCategoryBlocker, CommentCategorySuggestion:
+		return true
+	}
+	return false
+}
+
 // Comment represents a comment on any entity in the system
 // @Description A comment that can be attached to any entity, supporting both general and inline comments with threading
 type Comment struct {
-	ID              uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                         // Unique identifier for the comment
-	EntityType      EntityType `gorm:"not null" json:"entity_type" validate:"required" example:"epic"`                                                         // Type of entity this comment is attached to
-	EntityID        uuid.UUID  `gorm:"not null" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"`                                               // ID of the entity this comment is attached to
-	ParentCommentID *uuid.UUID `json:"parent_comment_id" example:"123e4567-e89b-12d3-a456-426614174002"`                                                       // Optional ID of parent comment for threaded discussions
-	AuthorID        uuid.UUID  `gorm:"not null" json:"author_id" example:"123e4567-e89b-12d3-a456-426614174003"`                                               // ID of the user who authored this comment
-	CreatedAt       time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                                              // Timestamp when the comment was created
-	UpdatedAt       time.Time  `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                                              // Timestamp when the comment was last updated
-	Content         string     `gorm:"not null" json:"content" validate:"required" example:"This requirement needs clarification on the authentication flow."` // Text content of the comment
-	IsResolved      bool       `json:"is_resolved" example:"false"`                                                                                            // Whether this comment has been resolved
+	ID              uuid.UUID        `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                         // Unique identifier for the comment
+	EntityType      EntityType       `gorm:"not null" json:"entity_type" validate:"required" example:"epic"`                                                         // Type of entity this comment is attached to
+	EntityID        uuid.UUID        `gorm:"not null" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"`                                               // ID of the entity this comment is attached to
+	ParentCommentID *uuid.UUID       `json:"parent_comment_id" example:"123e4567-e89b-12d3-a456-426614174002"`                                                       // Optional ID of parent comment for threaded discussions
+	AuthorID        uuid.UUID        `gorm:"not null" json:"author_id" example:"123e4567-e89b-12d3-a456-426614174003"`                                               // ID of the user who authored this comment
+	CreatedAt       time.Time        `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                                              // Timestamp when the comment was created
+	UpdatedAt       time.Time        `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                                              // Timestamp when the comment was last updated
+	Content         string           `gorm:"not null" json:"content" validate:"required" example:"This requirement needs clarification on the authentication flow."` // Text content of the comment
+	IsResolved      bool             `json:"is_resolved" example:"false"`                                                                                            // Whether this comment has been resolved
+	Category        *CommentCategory `gorm:"type:varchar(20);index" json:"category,omitempty" example:"blocker"`                                                     // Optional category: question, blocker or suggestion
+	IsSystem        bool             `gorm:"not null;default:false" json:"is_system" example:"false"`                                                                // Whether the comment was generated by the system, such as a discussion summary
 
 	// For inline comments
 	LinkedText        *string `json:"linked_text" example:"OAuth 2.0 authentication flow"` // Text that this inline comment is linked to
@@ -148,6 +170,12 @@ func (c *Comment) MarshalJSON() ([]byte, error) {
 		"updated_at":  c.UpdatedAt,
 		"content":     c.Content,
 		"is_resolved": c.IsResolved,
+		"is_system":   c.IsSystem,
+	}
+
+	// Only include category if it's set
+	if c.Category != nil {
+		result["category"] = *c.Category
 	}
 
 	// Only include parent_comment_id if it's not nil
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CommentDraft represents an autosaved, unposted comment or reply
+// @Description Private draft of a comment on an entity or of a reply in a comment thread; it expires when it is not saved again for a while
+type CommentDraft struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                     // Unique identifier for the draft
+	EntityType      EntityType `gorm:"not null;index:idx_comment_drafts_entity" json:"entity_type" example:"requirement"`                                  // Type of the commented entity
+	EntityID        uuid.UUID  `gorm:"type:uuid;not null;index:idx_comment_drafts_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the commented entity
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174002"`                             // ID of the draft author
+	ParentCommentID *uuid.UUID `gorm:"type:uuid" json:"parent_comment_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174003"`                        // Comment the draft replies to; empty for a new top-level comment
+	Content         string     `gorm:"type:text;not null" json:"content" example:"I think the retry policy needs an upper bound because..."`               // Drafted comment text
+	ExpiresAt       time.Time  `gorm:"not null;index" json:"expires_at" example:"2023-01-15T11:00:00Z"`                                                    // When the draft is deleted unless it is saved again
+	CreatedAt       time.Time  `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                          // Timestamp when the draft was created
+	UpdatedAt       time.Time  `json:"updated_at" example:"2023-01-01T11:00:00Z"`                                                                          // Timestamp when the draft was last saved
+}
+
+// BeforeCreate sets the ID if not already set
+func (d *CommentDraft) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the CommentDraft model
+func (CommentDraft) TableName() string {
+	return "comment_drafts"
+}
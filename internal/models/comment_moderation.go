@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ModerationStatus represents the review state of a moderated comment
+// @Description Review state of a comment caught by content moderation
+// @Example "flagged"
+type ModerationStatus string
+
+const (
+	ModerationFlagged  ModerationStatus = "flagged"  // Published and waiting for review
+	ModerationBlocked  ModerationStatus = "blocked"  // Not published; the author may appeal
+	ModerationAppealed ModerationStatus = "appealed" // The author asked a moderator to review a blocked or rejected comment
+	ModerationApproved ModerationStatus = "approved" // Published after review
+	ModerationRejected ModerationStatus = "rejected" // Removed or kept unpublished after review
+)
+
+// IsValid reports whether the status is one of the supported values
+func (s ModerationStatus) IsValid() bool {
+	switch s {
+	case ModerationFlagged, ModerationBlocked, ModerationAppealed, ModerationApproved, ModerationRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPending reports whether the record waits for a moderator
+func (s ModerationStatus) IsPending() bool {
+	return s == ModerationFlagged || s == ModerationAppealed
+}
+
+// ModerationAction is the comment operation that was moderated
+// @Description Comment operation caught by content moderation
+// @Example "create"
+type ModerationAction string
+
+const (
+	ModerationActionCreate ModerationAction = "create" // A new comment or reply
+	ModerationActionUpdate ModerationAction = "update" // An edit of an existing comment
+)
+
+// CommentModeration records a comment that content moderation flagged or blocked, and its review
+// @Description Comment caught by content moderation, with the verdict, the appeal of the author and the review of a moderator
+type CommentModeration struct {
+	ID           uuid.UUID        `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`               // Unique identifier of the record
+	CommentID    *uuid.UUID       `gorm:"type:uuid;index" json:"comment_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`   // Moderated comment; empty for a blocked new comment until it is approved
+	AuthorID     uuid.UUID        `gorm:"type:uuid;not null;index" json:"author_id" example:"123e4567-e89b-12d3-a456-426614174002"`     // Author of the comment
+	EntityType   EntityType       `gorm:"not null" json:"entity_type" example:"epic"`                                                   // Type of the entity the comment is attached to
+	EntityID     uuid.UUID        `gorm:"type:uuid;not null" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174003"`           // ID of the entity the comment is attached to
+	Action       ModerationAction `gorm:"type:varchar(20);not null" json:"action" example:"create"`                                     // Whether a new comment or an edit was moderated
+	Content      string           `gorm:"type:text;not null" json:"content" example:"This is a terrible idea."`                         // Content that was moderated
+	Request      string           `gorm:"type:text" json:"-"`                                                                           // Blocked request, applied when the comment is approved
+	Status       ModerationStatus `gorm:"type:varchar(20);not null;index" json:"status" example:"flagged"`                              // Review state
+	Reason       string           `gorm:"type:text;not null" json:"reason" example:"contains flagged keyword \"terrible\""`             // Why moderation caught the comment
+	Source       string           `gorm:"type:varchar(20);not null" json:"source" example:"keywords"`                                   // Rule that caught the comment: keywords or ai
+	AppealReason *string          `gorm:"type:text" json:"appeal_reason,omitempty" example:"The word is quoted from customer feedback"` // Why the author appealed
+	ReviewerID   *uuid.UUID       `gorm:"type:uuid" json:"reviewer_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174004"`        // Moderator who approved or rejected the comment
+	ReviewedAt   *time.Time       `json:"reviewed_at,omitempty" example:"2023-01-02T09:00:00Z"`                                         // When the comment was approved or rejected
+	ReviewNote   *string          `gorm:"type:text" json:"review_note,omitempty" example:"Quoted feedback, fine in context"`            // Note of the moderator shown to the author
+	CreatedAt    time.Time        `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                    // When moderation caught the comment
+	UpdatedAt    time.Time        `json:"updated_at" example:"2023-01-02T09:00:00Z"`                                                    // When the record last changed
+
+	// Author is the author of the comment (populated when preloaded)
+	Author *User `gorm:"foreignKey:AuthorID;constraint:OnDelete:CASCADE" json:"author,omitempty"`
+	// Reviewer is the moderator who reviewed the comment (populated when preloaded)
+	Reviewer *User `gorm:"foreignKey:ReviewerID;constraint:OnDelete:SET NULL" json:"reviewer,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (m *CommentModeration) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the CommentModeration model
+func (CommentModeration) TableName() string {
+	return "comment_moderations"
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CommentThreadLock closes the comment thread of an entity to new comments and replies
+// @Description Lock on the comment thread of an entity, e.g. after a decision is recorded. While it is in place only administrators can comment.
+type CommentThreadLock struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                 // Unique identifier for the lock
+	EntityType EntityType `gorm:"not null;uniqueIndex:idx_comment_thread_locks_entity" json:"entity_type" example:"requirement"`                                  // Type of the entity whose thread is locked
+	EntityID   uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_comment_thread_locks_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the entity whose thread is locked
+	Reason     string     `gorm:"type:text;not null" json:"reason" example:"Decision recorded: passwords are hashed with Argon2id"`                               // Why the thread was locked, shown to users trying to comment
+	LockedBy   uuid.UUID  `gorm:"type:uuid;not null" json:"locked_by" example:"123e4567-e89b-12d3-a456-426614174002"`                                             // ID of the user who locked the thread
+	LockedAt   time.Time  `gorm:"not null" json:"locked_at" example:"2023-01-01T12:00:00Z"`                                                                       // When the thread was locked
+
+	// Relationships
+	Locker *User `gorm:"foreignKey:LockedBy;constraint:OnDelete:CASCADE" json:"locker,omitempty"` // User who locked the thread (populated when preloaded)
+}
+
+// BeforeCreate sets the ID if not already set
+func (l *CommentThreadLock) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the CommentThreadLock model
+func (CommentThreadLock) TableName() string {
+	return "comment_thread_locks"
+}
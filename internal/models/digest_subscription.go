@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DigestFrequency represents how often a user receives the activity digest email
+// @Description How often the activity digest email is sent
+// @Example "daily"
+type DigestFrequency string
+
+const (
+	DigestFrequencyNone   DigestFrequency = "none"   // Digest emails are disabled
+	DigestFrequencyDaily  DigestFrequency = "daily"  // Digest is sent once a day
+	DigestFrequencyWeekly DigestFrequency = "weekly" // Digest is sent once a week
+)
+
+// IsValid reports whether the frequency is one of the supported values
+func (f DigestFrequency) IsValid() bool {
+	switch f {
+	case DigestFrequencyNone, DigestFrequencyDaily, DigestFrequencyWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+// Period returns the time covered by one digest, or zero when digests are disabled
+func (f DigestFrequency) Period() time.Duration {
+	switch f {
+	case DigestFrequencyDaily:
+		return 24 * time.Hour
+	case DigestFrequencyWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// DigestSubscription stores a user's activity digest preferences
+// @Description Activity digest email preferences of a user
+type DigestSubscription struct {
+	UserID           uuid.UUID       `gorm:"type:uuid;primary_key" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"` // User receiving the digest
+	Frequency        DigestFrequency `gorm:"type:varchar(20);not null;default:'none'" json:"frequency" example:"daily"`           // How often the digest is sent
+	UnsubscribeToken string          `gorm:"not null;uniqueIndex" json:"-"`                                                       // Secret token used by the unsubscribe link
+	LastSentAt       *time.Time      `json:"last_sent_at,omitempty" example:"2023-01-01T08:00:00Z"`                               // When the last digest was sent
+	CreatedAt        time.Time       `json:"created_at" example:"2023-01-01T00:00:00Z"`                                           // Timestamp when preferences were first saved
+	UpdatedAt        time.Time       `json:"updated_at" example:"2023-01-01T00:00:00Z"`                                           // Timestamp when preferences were last changed
+
+	// User is the digest recipient (populated when preloaded)
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName returns the table name for the DigestSubscription model
+func (DigestSubscription) TableName() string {
+	return "digest_subscriptions"
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EntityDraft represents a private, unpublished edit of an entity's title and description
+// @Description Per-user draft of an entity's text that stays private until it is published
+type EntityDraft struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                               // Unique identifier for the draft
+	EntityType    EntityType `gorm:"not null;uniqueIndex:idx_entity_drafts_entity_user" json:"entity_type" example:"requirement"`                                  // Type of the drafted entity
+	EntityID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_entity_drafts_entity_user" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the drafted entity
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_entity_drafts_entity_user" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174002"`   // ID of the draft author
+	Title         *string    `json:"title,omitempty" example:"User authentication must support OAuth 2.0 and SAML"`                                                // Drafted title (not used for acceptance criteria)
+	Description   string     `gorm:"type:text;not null" json:"description" example:"The system shall support OAuth 2.0 and SAML authentication flows..."`          // Drafted description
+	BaseUpdatedAt time.Time  `gorm:"not null" json:"base_updated_at" example:"2023-01-01T00:00:00Z"`                                                               // Entity updated_at when the draft was started (used for conflict detection)
+	CreatedAt     time.Time  `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                                    // Timestamp when the draft was created
+	UpdatedAt     time.Time  `json:"updated_at" example:"2023-01-01T11:00:00Z"`                                                                                    // Timestamp when the draft was last saved
+}
+
+// BeforeCreate sets the ID if not already set
+func (d *EntityDraft) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the EntityDraft model
+func (EntityDraft) TableName() string {
+	return "entity_drafts"
+}
+
+// IsStale checks if the entity was modified after the draft was started
+func (d *EntityDraft) IsStale(entityUpdatedAt time.Time) bool {
+	return entityUpdatedAt.After(d.BaseUpdatedAt)
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EntityRelationship represents a typed link between two entities of any supported type.
+// Links between two requirements are stored as RequirementRelationship instead.
+// @Description Typed link from a source entity to a target entity
+type EntityRelationship struct {
+	ID                 uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                            // Unique identifier for the relationship
+	SourceType         EntityType `gorm:"not null;uniqueIndex:idx_entity_relationships_unique;index:idx_entity_relationships_source" json:"source_type" example:"epic"`              // Type of the source entity
+	SourceID           uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_entity_relationships_unique;index:idx_entity_relationships_source" json:"source_id"`                     // ID of the source entity
+	TargetType         EntityType `gorm:"not null;uniqueIndex:idx_entity_relationships_unique;index:idx_entity_relationships_target" json:"target_type" example:"epic"`              // Type of the target entity
+	TargetID           uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_entity_relationships_unique;index:idx_entity_relationships_target" json:"target_id"`                     // ID of the target entity
+	RelationshipTypeID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_entity_relationships_unique" json:"relationship_type_id" example:"123e4567-e89b-12d3-a456-426614174001"` // Type of the relationship
+	CreatedAt          time.Time  `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                                                 // Timestamp when the link was created
+	CreatedBy          uuid.UUID  `gorm:"type:uuid;not null" json:"created_by" example:"123e4567-e89b-12d3-a456-426614174002"`                                                       // ID of the user who created the link
+
+	// Relationships
+	RelationshipType RelationshipType `gorm:"foreignKey:RelationshipTypeID;constraint:OnDelete:RESTRICT" json:"relationship_type,omitempty"`
+	Creator          User             `gorm:"foreignKey:CreatedBy;constraint:OnDelete:RESTRICT" json:"-"`
+}
+
+// BeforeCreate sets the ID if not already set and validates the relationship
+func (er *EntityRelationship) BeforeCreate(tx *gorm.DB) error {
+	if er.ID == uuid.Nil {
+		er.ID = uuid.New()
+	}
+
+	// An entity cannot be linked to itself
+	if er.SourceType == er.TargetType && er.SourceID == er.TargetID {
+		return gorm.ErrInvalidData
+	}
+
+	return nil
+}
+
+// TableName returns the table name for the EntityRelationship model
+func (EntityRelationship) TableName() string {
+	return "entity_relationships"
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EntityTranslation holds the title and description of an entity in another locale
+// @Description Translation of an entity's title and description into a locale; entities are returned in it when the locale is preferred with Accept-Language
+type EntityTranslation struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                       // Unique identifier for the translation
+	EntityType  EntityType `gorm:"not null;uniqueIndex:idx_entity_translations_entity_locale" json:"entity_type" example:"requirement"`                                  // Type of the translated entity
+	EntityID    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_entity_translations_entity_locale" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the translated entity
+	Locale      string     `gorm:"not null;uniqueIndex:idx_entity_translations_entity_locale;index" json:"locale" example:"ru"`                                          // Locale of the translation, such as ru or pt-BR
+	Title       *string    `json:"title,omitempty" example:"Аутентификация пользователей должна поддерживать OAuth 2.0"`                                                 // Translated title (not used for acceptance criteria)
+	Description *string    `gorm:"type:text" json:"description,omitempty" example:"Система должна поддерживать аутентификацию OAuth 2.0..."`                             // Translated description
+	UpdatedBy   uuid.UUID  `gorm:"type:uuid;not null" json:"updated_by" example:"123e4567-e89b-12d3-a456-426614174002"`                                                  // User who last saved the translation
+	CreatedAt   time.Time  `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                                            // Timestamp when the translation was created
+	UpdatedAt   time.Time  `json:"updated_at" example:"2023-01-01T11:00:00Z"`                                                                                            // Timestamp when the translation was last saved
+}
+
+// BeforeCreate sets the ID if not already set
+func (t *EntityTranslation) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the EntityTranslation model
+func (EntityTranslation) TableName() string {
+	return "entity_translations"
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EntityVersion represents a snapshot of an entity's title and description after a change
+// @Description Immutable snapshot of an entity's text, numbered sequentially per entity starting at 1
+type EntityVersion struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                    // Unique identifier for the version
+	EntityType    EntityType `gorm:"not null;uniqueIndex:idx_entity_versions_entity_version" json:"entity_type" example:"requirement"`                                  // Type of the versioned entity
+	EntityID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_entity_versions_entity_version" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the versioned entity
+	VersionNumber int        `gorm:"not null;uniqueIndex:idx_entity_versions_entity_version" json:"version_number" example:"3"`                                         // Sequential version number within the entity
+	Title         string     `json:"title" example:"User authentication must support OAuth 2.0"`                                                                        // Title at this version (empty for acceptance criteria)
+	Description   string     `gorm:"type:text" json:"description" example:"The system shall support OAuth 2.0 authentication flow..."`                                  // Description at this version
+	CreatedAt     time.Time  `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                                         // Timestamp when the version was recorded
+}
+
+// BeforeCreate sets the ID if not already set
+func (v *EntityVersion) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the EntityVersion model
+func (EntityVersion) TableName() string {
+	return "entity_versions"
+}
@@ -113,6 +113,46 @@ type Epic struct {
 	// @Example "Implement a comprehensive user authentication and authorization system with JWT tokens, role-based access control, and secure password management."
 	Description *string `json:"description,omitempty" validate:"omitempty,max=50000"`
 
+	// DueDate is the date the epic is planned to be delivered
+	// @Description Planned delivery date of the epic; shown as a milestone in calendar feeds (optional)
+	// @Example "2023-03-31T00:00:00Z"
+	DueDate *time.Time `gorm:"type:date" json:"due_date,omitempty"`
+
+	// ParentEpicID is the UUID of the epic this epic is nested under
+	// @Description UUID of the parent epic grouping this epic into a larger initiative (omitted for top-level epics)
+	// @Example "123e4567-e89b-12d3-a456-426614174004"
+	ParentEpicID *uuid.UUID `gorm:"type:uuid;index" json:"parent_epic_id,omitempty"`
+
+	// Archived hides the epic from default lists and search without deleting it
+	// @Description Whether the epic is archived; archived epics are only listed and searched with include_archived=true
+	// @Example false
+	Archived bool `gorm:"not null;default:false;index" json:"archived"`
+
+	// ArchivedAt is the timestamp when the epic was archived
+	// @Description Timestamp when the epic was archived (RFC3339 format, only set while archived)
+	// @Example "2023-06-30T09:00:00Z"
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Frozen blocks changes to the user stories, acceptance criteria and requirements of the epic
+	// @Description Whether the requirements of the epic are frozen; only administrators can change its children while frozen
+	// @Example false
+	Frozen bool `gorm:"not null;default:false" json:"frozen"`
+
+	// FrozenAt is the timestamp when the epic was frozen
+	// @Description Timestamp when the epic was frozen (RFC3339 format, only set while frozen)
+	// @Example "2023-06-01T09:00:00Z"
+	FrozenAt *time.Time `json:"frozen_at,omitempty"`
+
+	// FrozenBy is the UUID of the user who froze the epic
+	// @Description UUID of the user who froze the epic (only set while frozen)
+	// @Example "123e4567-e89b-12d3-a456-426614174003"
+	FrozenBy *uuid.UUID `gorm:"type:uuid" json:"frozen_by,omitempty"`
+
+	// FreezeReason explains why the epic is frozen
+	// @Description Why the epic is frozen, shown to users whose changes are rejected (only set while frozen)
+	// @Example "Release 2.4 stabilization"
+	FreezeReason *string `gorm:"type:text" json:"freeze_reason,omitempty"`
+
 	// Relationships - These fields are populated when explicitly requested and contain related entities
 
 	// Creator contains the user information of who created the epic
@@ -229,6 +269,7 @@ func (e *Epic) MarshalJSON() ([]byte, error) {
 		"priority":     e.Priority,
 		"status":       e.Status,
 		"title":        e.Title,
+		"archived":     e.Archived,
 	}
 
 	// Only include description if it's not nil
@@ -236,6 +277,16 @@ func (e *Epic) MarshalJSON() ([]byte, error) {
 		result["description"] = *e.Description
 	}
 
+	// Only include due date if it's set
+	if e.DueDate != nil {
+		result["due_date"] = *e.DueDate
+	}
+
+	// Only include the archival time while the epic is archived
+	if e.ArchivedAt != nil {
+		result["archived_at"] = *e.ArchivedAt
+	}
+
 	// Only include creator if it has been populated (has a username, indicating it was preloaded)
 	if e.Creator.Username != "" {
 		result["creator"] = e.Creator
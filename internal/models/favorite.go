@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Favorite represents an entity a user pinned for quick access
+// @Description Entity bookmarked by a user
+type Favorite struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                           // Unique identifier for the favorite
+	EntityType EntityType `gorm:"not null;uniqueIndex:idx_favorites_user_entity" json:"entity_type" example:"epic"`                                         // Type of the pinned entity
+	EntityID   uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_favorites_user_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the pinned entity
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_favorites_user_entity" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174002"`   // ID of the user who pinned the entity
+	CreatedAt  time.Time  `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                                // Timestamp when the entity was pinned
+}
+
+// BeforeCreate sets the ID if not already set
+func (f *Favorite) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the Favorite model
+func (Favorite) TableName() string {
+	return "favorites"
+}
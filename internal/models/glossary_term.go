@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GlossaryTerm is a defined term of the project vocabulary
+// @Description Glossary entry used to keep requirement language consistent; terms are matched case-insensitively
+type GlossaryTerm struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                // Unique identifier of the term
+	Term       string    `gorm:"not null" json:"term" validate:"required,max=255" example:"Service Level Agreement"`                                            // The term as it should be written
+	Definition string    `gorm:"type:text;not null" json:"definition" validate:"required" example:"Contractual commitment on availability and response times."` // Meaning of the term
+	CreatorID  uuid.UUID `gorm:"type:uuid;not null" json:"creator_id" example:"123e4567-e89b-12d3-a456-426614174001"`                                           // User who added the term
+	CreatedAt  time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                                                     // Timestamp when the term was added
+	UpdatedAt  time.Time `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                                                     // Timestamp when the term was last changed
+
+	// Creator is the user who added the term (populated when preloaded)
+	Creator *User `gorm:"foreignKey:CreatorID;constraint:OnDelete:RESTRICT" json:"creator,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (gt *GlossaryTerm) BeforeCreate(tx *gorm.DB) error {
+	if gt.ID == uuid.Nil {
+		gt.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the GlossaryTerm model
+func (GlossaryTerm) TableName() string {
+	return "glossary_terms"
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GuestInvitationStatus represents the state of a guest invitation
+type GuestInvitationStatus string
+
+const (
+	GuestInvitationPending  GuestInvitationStatus = "pending"  // Sent and not used yet
+	GuestInvitationAccepted GuestInvitationStatus = "accepted" // Used by the guest to sign in
+	GuestInvitationRevoked  GuestInvitationStatus = "revoked"  // Revoked before it expired
+	GuestInvitationExpired  GuestInvitationStatus = "expired"  // Past its expiry
+)
+
+// GuestInvitation grants an external reviewer Commenter access to a single epic until it expires or is revoked
+// @Description Invitation of an external reviewer by email. The guest can read and comment on the epic only; the invitation token is only returned when the invitation is created.
+type GuestInvitation struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`          // Unique identifier for the invitation
+	EpicID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174001"`  // Epic the guest may read and comment on
+	Email      string     `gorm:"not null" json:"email" example:"reviewer@partner.example.com"`                            // Email address the invitation was sent to
+	GuestID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"guest_id" example:"123e4567-e89b-12d3-a456-426614174002"` // Commenter account of the guest
+	TokenHash  string     `gorm:"size:64;not null;uniqueIndex" json:"-"`                                                   // SHA-256 hash of the invitation token (never exposed in JSON)
+	InvitedBy  uuid.UUID  `gorm:"type:uuid;not null" json:"invited_by" example:"123e4567-e89b-12d3-a456-426614174003"`     // ID of the user who sent the invitation
+	ExpiresAt  time.Time  `gorm:"not null;index" json:"expires_at" example:"2023-01-08T00:00:00Z"`                         // When the invitation and the access it grants expire
+	AcceptedAt *time.Time `json:"accepted_at,omitempty" example:"2023-01-02T09:00:00Z"`                                    // When the guest first signed in with the invitation
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" example:"2023-01-03T09:00:00Z"`                                     // When the invitation was revoked
+	CreatedAt  time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                               // When the invitation was sent
+
+	// Status is derived from the timestamps when the invitation is returned by the API
+	Status GuestInvitationStatus `gorm:"-" json:"status" example:"pending"`
+
+	// Relationships
+	Epic    *Epic `gorm:"foreignKey:EpicID;constraint:OnDelete:CASCADE" json:"-"`                    // Epic the invitation is for
+	Guest   *User `gorm:"foreignKey:GuestID;constraint:OnDelete:CASCADE" json:"guest,omitempty"`     // Guest account (populated when preloaded)
+	Inviter *User `gorm:"foreignKey:InvitedBy;constraint:OnDelete:CASCADE" json:"inviter,omitempty"` // User who sent the invitation (populated when preloaded)
+}
+
+// BeforeCreate sets the ID if not already set
+func (i *GuestInvitation) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the GuestInvitation model
+func (GuestInvitation) TableName() string {
+	return "guest_invitations"
+}
+
+// StatusAt returns the state of the invitation at a point in time
+func (i *GuestInvitation) StatusAt(now time.Time) GuestInvitationStatus {
+	switch {
+	case i.RevokedAt != nil:
+		return GuestInvitationRevoked
+	case !now.Before(i.ExpiresAt):
+		return GuestInvitationExpired
+	case i.AcceptedAt != nil:
+		return GuestInvitationAccepted
+	default:
+		return GuestInvitationPending
+	}
+}
+
+// IsActive reports whether the invitation still grants access at a point in time
+func (i *GuestInvitation) IsActive(now time.Time) bool {
+	return i.RevokedAt == nil && now.Before(i.ExpiresAt)
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HierarchyChange records when the descendants of an epic, user story or acceptance criteria last changed.
+// Rows are maintained by database triggers whenever a child is created, updated, moved or deleted.
+type HierarchyChange struct {
+	EntityType EntityType `gorm:"primaryKey;size:50" json:"entity_type" example:"epic"`                                 // Type of the parent entity
+	EntityID   uuid.UUID  `gorm:"type:uuid;primaryKey" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"` // ID of the parent entity
+	ChangedAt  time.Time  `gorm:"not null" json:"changed_at" example:"2023-01-01T10:00:00Z"`                            // Timestamp of the latest change below the entity
+}
+
+// TableName returns the table name for the HierarchyChange model
+func (HierarchyChange) TableName() string {
+	return "hierarchy_changes"
+}
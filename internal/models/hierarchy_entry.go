@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HierarchyEntry is the denormalized hierarchy cache row of an epic, user story, acceptance criteria or
+// requirement naming all of its ancestors. Rows name themselves as their own user story or acceptance criteria,
+// so the subtree of an entity is selected by a single column. Database triggers keep the rows up to date.
+type HierarchyEntry struct {
+	EntityType           EntityType `gorm:"primaryKey;size:50" json:"entity_type" example:"requirement"`                                            // Type of the entity
+	EntityID             uuid.UUID  `gorm:"type:uuid;primaryKey" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"`                   // ID of the entity
+	ReferenceID          string     `gorm:"size:20;not null" json:"reference_id" example:"REQ-001"`                                                 // Reference ID of the entity
+	Title                string     `gorm:"not null" json:"title" example:"Password rules"`                                                         // Title of the entity; the description of acceptance criteria
+	Status               string     `gorm:"size:50;not null;default:''" json:"status" example:"Active"`                                             // Status of the entity; empty for acceptance criteria
+	EpicID               uuid.UUID  `gorm:"type:uuid;not null;index" json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174001"`                 // Epic of the entity, or the epic itself
+	UserStoryID          *uuid.UUID `gorm:"type:uuid;index" json:"user_story_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`          // User story of the entity, or the user story itself
+	AcceptanceCriteriaID *uuid.UUID `gorm:"type:uuid;index" json:"acceptance_criteria_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174003"` // Linked acceptance criteria, or the acceptance criteria itself
+	CreatedAt            time.Time  `gorm:"not null;autoCreateTime:false" json:"created_at" example:"2023-01-01T10:00:00Z"`                         // Creation timestamp of the entity
+	UpdatedAt            time.Time  `gorm:"not null;autoUpdateTime:false" json:"updated_at" example:"2023-01-02T12:30:00Z"`                         // Last update timestamp of the entity
+}
+
+// TableName returns the table name for the HierarchyEntry model
+func (HierarchyEntry) TableName() string {
+	return "hierarchy_entries"
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ImpersonationSession records an administrator acting as another user to reproduce a problem they reported
+// @Description Session in which an administrator acts as another user with a short-lived access token
+type ImpersonationSession struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`          // Unique identifier of the session
+	AdminID   uuid.UUID `gorm:"type:uuid;not null;index" json:"admin_id" example:"123e4567-e89b-12d3-a456-426614174001"` // Administrator who impersonates
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174002"`  // Impersonated user
+	Reason    string    `gorm:"type:text;not null" json:"reason" example:"Ticket 4711: user cannot see epic EP-012"`     // Why the user is impersonated
+	ClientIP  string    `json:"client_ip,omitempty" example:"203.0.113.7"`                                               // Address the session was started from
+	UserAgent string    `json:"user_agent,omitempty" example:"Mozilla/5.0"`                                              // Client the session was started from
+	StartedAt time.Time `gorm:"not null;index" json:"started_at" example:"2023-01-01T10:00:00Z"`                         // When the token was issued
+	ExpiresAt time.Time `gorm:"not null" json:"expires_at" example:"2023-01-01T10:15:00Z"`                               // When the token expires
+
+	// Admin is the impersonating administrator (populated when preloaded)
+	Admin *User `gorm:"foreignKey:AdminID;constraint:OnDelete:RESTRICT" json:"admin,omitempty"`
+	// User is the impersonated user (populated when preloaded)
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:RESTRICT" json:"user,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *ImpersonationSession) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the ImpersonationSession model
+func (ImpersonationSession) TableName() string {
+	return "impersonation_sessions"
+}
+
+// ImpersonatedRequest records a request made with the token of an impersonation session
+// @Description Request made by an administrator while impersonating a user
+type ImpersonatedRequest struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                              // Unique identifier of the request
+	SessionID  uuid.UUID `gorm:"type:uuid;not null;index:idx_impersonated_requests_session" json:"session_id" example:"123e4567-e89b-12d3-a456-426614174001"` // Impersonation session the request was made in
+	Method     string    `gorm:"not null" json:"method" example:"GET"`                                                                                        // HTTP method
+	Path       string    `gorm:"not null" json:"path" example:"/api/v1/epics/EP-012"`                                                                         // Requested path with the query string
+	StatusCode int       `gorm:"not null" json:"status_code" example:"404"`                                                                                   // HTTP status of the response
+	CreatedAt  time.Time `gorm:"not null;index:idx_impersonated_requests_session" json:"created_at" example:"2023-01-01T10:01:00Z"`                           // When the request was made
+
+	// Session is the impersonation session (populated when preloaded)
+	Session *ImpersonationSession `gorm:"foreignKey:SessionID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *ImpersonatedRequest) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the ImpersonatedRequest model
+func (ImpersonatedRequest) TableName() string {
+	return "impersonated_requests"
+}
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IncidentStatus represents the progress of an incident
+// @Description Progress of an incident, as shown on the status page
+// @Example "investigating"
+type IncidentStatus string
+
+const (
+	IncidentInvestigating IncidentStatus = "investigating" // The cause is being looked into
+	IncidentIdentified    IncidentStatus = "identified"    // The cause is known and a fix is underway
+	IncidentMonitoring    IncidentStatus = "monitoring"    // A fix was applied and is being watched
+	IncidentResolved      IncidentStatus = "resolved"      // The incident is over
+)
+
+// IsValid reports whether the status is one of the supported values
+func (s IncidentStatus) IsValid() bool {
+	switch s {
+	case IncidentInvestigating, IncidentIdentified, IncidentMonitoring, IncidentResolved:
+		return true
+	default:
+		return false
+	}
+}
+
+// IncidentImpact represents how badly an incident affects its components
+// @Description How badly an incident affects its components: minor degrades them, major partially breaks them, critical takes them down
+// @Example "major"
+type IncidentImpact string
+
+const (
+	IncidentImpactMinor    IncidentImpact = "minor"    // Components are slow or degraded
+	IncidentImpactMajor    IncidentImpact = "major"    // Components are partially unavailable
+	IncidentImpactCritical IncidentImpact = "critical" // Components are unavailable
+)
+
+// IsValid reports whether the impact is one of the supported values
+func (i IncidentImpact) IsValid() bool {
+	switch i {
+	case IncidentImpactMinor, IncidentImpactMajor, IncidentImpactCritical:
+		return true
+	default:
+		return false
+	}
+}
+
+// Incident is an outage or degradation of the service announced on the public status page
+// @Description Outage or degradation announced on the public status page, with its timeline of updates
+type Incident struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`      // Unique identifier of the incident
+	Title      string         `gorm:"not null" json:"title" example:"Elevated API error rates"`                            // Short public description
+	Status     IncidentStatus `gorm:"type:varchar(20);not null;index" json:"status" example:"investigating"`               // Progress of the incident
+	Impact     IncidentImpact `gorm:"type:varchar(20);not null" json:"impact" example:"major"`                             // How badly the components are affected
+	Components []string       `gorm:"serializer:json;type:text" json:"components" example:"api"`                           // IDs of the affected status page components
+	StartedAt  time.Time      `gorm:"not null;index" json:"started_at" example:"2023-01-01T10:00:00Z"`                     // When the incident started
+	ResolvedAt *time.Time     `json:"resolved_at,omitempty" example:"2023-01-01T11:30:00Z"`                                // When the incident was resolved
+	CreatedBy  uuid.UUID      `gorm:"type:uuid;not null" json:"created_by" example:"123e4567-e89b-12d3-a456-426614174001"` // Administrator who opened the incident
+	CreatedAt  time.Time      `json:"created_at" example:"2023-01-01T10:05:00Z"`                                           // Timestamp when the incident was opened
+	UpdatedAt  time.Time      `json:"updated_at" example:"2023-01-01T11:30:00Z"`                                           // Timestamp when the incident was last changed
+
+	// Updates is the timeline of the incident, oldest first (populated when preloaded)
+	Updates []IncidentUpdate `gorm:"foreignKey:IncidentID;constraint:OnDelete:CASCADE" json:"updates,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (i *Incident) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the Incident model
+func (Incident) TableName() string {
+	return "incidents"
+}
+
+// IncidentUpdate is a message posted to the timeline of an incident
+// @Description Message posted to the timeline of an incident together with the status it moved the incident to
+type IncidentUpdate struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174002"`                    // Unique identifier of the update
+	IncidentID uuid.UUID      `gorm:"type:uuid;not null;index" json:"incident_id" example:"123e4567-e89b-12d3-a456-426614174000"`        // Incident the update belongs to
+	Status     IncidentStatus `gorm:"type:varchar(20);not null" json:"status" example:"identified"`                                      // Status of the incident after the update
+	Message    string         `gorm:"type:text;not null" json:"message" example:"A faulty deployment was identified and is rolled back"` // Public message
+	CreatedBy  uuid.UUID      `gorm:"type:uuid;not null" json:"created_by" example:"123e4567-e89b-12d3-a456-426614174001"`               // Administrator who posted the update
+	CreatedAt  time.Time      `json:"created_at" example:"2023-01-01T10:20:00Z"`                                                         // Timestamp of the update
+}
+
+// BeforeCreate sets the ID if not already set
+func (u *IncidentUpdate) BeforeCreate(tx *gorm.DB) error {
+	if u.ID == uuid.Nil {
+		u.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the IncidentUpdate model
+func (IncidentUpdate) TableName() string {
+	return "incident_updates"
+}
+
+// MaintenanceWindow is planned work announced on the public status page
+// @Description Planned maintenance announced on the public status page
+type MaintenanceWindow struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174003"`          // Unique identifier of the window
+	Title       string    `gorm:"not null" json:"title" example:"Database upgrade"`                                        // Short public description
+	Description string    `gorm:"type:text" json:"description,omitempty" example:"Writes are paused for up to 10 minutes"` // Public details
+	Components  []string  `gorm:"serializer:json;type:text" json:"components" example:"database"`                          // IDs of the status page components under maintenance
+	StartsAt    time.Time `gorm:"not null;index" json:"starts_at" example:"2023-01-07T02:00:00Z"`                          // Start of the window
+	EndsAt      time.Time `gorm:"not null;index" json:"ends_at" example:"2023-01-07T03:00:00Z"`                            // End of the window
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"created_by" example:"123e4567-e89b-12d3-a456-426614174001"`     // Administrator who scheduled the window
+	CreatedAt   time.Time `json:"created_at" example:"2023-01-01T10:00:00Z"`                                               // Timestamp when the window was scheduled
+	UpdatedAt   time.Time `json:"updated_at" example:"2023-01-01T10:00:00Z"`                                               // Timestamp when the window was last changed
+}
+
+// BeforeCreate sets the ID if not already set
+func (w *MaintenanceWindow) BeforeCreate(tx *gorm.DB) error {
+	if w.ID == uuid.Nil {
+		w.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the MaintenanceWindow model
+func (MaintenanceWindow) TableName() string {
+	return "maintenance_windows"
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LLMCall represents a single request to the language model provider made by an AI feature
+// @Description Usage record of a language model request with its token counts, latency and estimated cost
+type LLMCall struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`          // Unique identifier for the call
+	Feature      string     `gorm:"not null;index" json:"feature" example:"comment_summary"`                                 // AI feature that made the request
+	UserID       *uuid.UUID `gorm:"type:uuid;index" json:"user_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"` // User the request was made for (omitted when unknown)
+	Provider     string     `gorm:"not null" json:"provider" example:"openai"`                                               // Language model provider
+	Model        string     `gorm:"not null" json:"model" example:"gpt-4o"`                                                  // Model the request was sent to
+	InputTokens  int        `gorm:"not null" json:"input_tokens" example:"1250"`                                             // Prompt tokens billed by the provider
+	OutputTokens int        `gorm:"not null" json:"output_tokens" example:"310"`                                             // Reply tokens billed by the provider
+	LatencyMs    int64      `gorm:"not null" json:"latency_ms" example:"2400"`                                               // Time until the provider replied in milliseconds
+	CostUSD      float64    `gorm:"column:cost_usd;not null" json:"cost_usd" example:"0.0062"`                               // Estimated cost from the configured token prices
+	Success      bool       `gorm:"not null" json:"success" example:"true"`                                                  // Whether the provider returned a reply
+	ErrorMessage string     `json:"error_message,omitempty" example:"provider returned 429"`                                 // Error of failed calls
+	Prompt       *string    `json:"prompt,omitempty"`                                                                        // System instructions and prompt (omitted when content logging is off)
+	Response     *string    `json:"response,omitempty"`                                                                      // Reply of the model (omitted when content logging is off)
+	CreatedAt    time.Time  `gorm:"not null;index" json:"created_at" example:"2023-01-01T10:00:00Z"`                         // Timestamp when the call was made
+
+	// User is the user the request was made for (populated when preloaded)
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:SET NULL" json:"user,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (c *LLMCall) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the LLMCall model
+func (LLMCall) TableName() string {
+	return "llm_calls"
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MCPToolCall represents a single recorded MCP tool invocation
+// @Description Audit log entry of an MCP tool call made by an agent
+type MCPToolCall struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                         // Unique identifier for the call
+	ToolName     string     `gorm:"not null;index" json:"tool_name" example:"create_requirement"`                                           // Name of the invoked tool
+	ArgsDigest   string     `gorm:"not null" json:"args_digest" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"` // SHA-256 of the canonical JSON arguments, identical for repeated calls
+	UserID       *uuid.UUID `gorm:"type:uuid;index" json:"user_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`                // User the agent authenticated as (omitted when unknown)
+	DurationMs   int64      `gorm:"not null" json:"duration_ms" example:"42"`                                                               // Time spent handling the call in milliseconds
+	Success      bool       `gorm:"not null;index" json:"success" example:"true"`                                                           // Whether the tool returned a result
+	ErrorMessage string     `json:"error_message,omitempty" example:"Invalid params"`                                                       // Error returned to the agent for failed calls
+	CreatedAt    time.Time  `gorm:"not null;index" json:"created_at" example:"2023-01-01T10:00:00Z"`                                        // Timestamp when the call was made
+
+	// User is the user the agent authenticated as (populated when preloaded)
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:SET NULL" json:"user,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (c *MCPToolCall) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the MCPToolCall model
+func (MCPToolCall) TableName() string {
+	return "mcp_tool_calls"
+}
@@ -14,6 +14,7 @@ func AllModels() []interface{} {
 		&RequirementType{},
 		&RelationshipType{},
 		&Requirement{},
+		&RequirementAcceptanceCriteria{},
 		&RequirementRelationship{},
 		&Comment{},
 		&StatusModel{},
@@ -23,6 +24,48 @@ func AllModels() []interface{} {
 		&RefreshToken{},
 		&SteeringDocument{},
 		&Prompt{},
+		&EntityPresence{},
+		&EditLock{},
+		&EntityDraft{},
+		&EntityVersion{},
+		&AuditEvent{},
+		&DigestSubscription{},
+		&GlossaryTerm{},
+		&RecentView{},
+		&Favorite{},
+		&BoardRank{},
+		&CalendarFeed{},
+		&ChangeFeed{},
+		&EntityRelationship{},
+		&MCPToolCall{},
+		&LLMCall{},
+		&OutboxEvent{},
+		&AssignmentRule{},
+		&StalenessPolicy{},
+		&StaleEntity{},
+		&ReportSchedule{},
+		&APIUsageCounter{},
+		&APIQuota{},
+		&UserPreference{},
+		&ChangeProposal{},
+		&CommentModeration{},
+		&CommentThreadLock{},
+		&GuestInvitation{},
+		&CommentDraft{},
+		&TestRun{},
+		&TestResult{},
+		&EntityVerification{},
+		&ReferenceRedirect{},
+		&EntityTranslation{},
+		&SignOffStakeholder{},
+		&SignOff{},
+		&Incident{},
+		&IncidentUpdate{},
+		&MaintenanceWindow{},
+		&ImpersonationSession{},
+		&ImpersonatedRequest{},
+		&HierarchyChange{},
+		&HierarchyEntry{},
 	}
 }
 
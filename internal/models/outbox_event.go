@@ -0,0 +1,55 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EntityEventSchemaVersion is the version of the EntityEvent JSON schema.
+// It is increased when a field is removed or changes meaning; new fields keep the version.
+const EntityEventSchemaVersion = 1
+
+// EntityEventAction is the lifecycle change an entity event describes
+type EntityEventAction string
+
+const (
+	EntityEventCreated EntityEventAction = "created" // Entity was created
+	EntityEventUpdated EntityEventAction = "updated" // Entity was changed
+	EntityEventDeleted EntityEventAction = "deleted" // Entity was deleted
+)
+
+// EntityEvent is the versioned JSON document published for a lifecycle change of an entity
+// @Description Lifecycle event of an epic, user story, acceptance criteria or requirement, as published to the event bus
+type EntityEvent struct {
+	SchemaVersion int               `json:"schema_version" example:"1"`                               // Version of this schema
+	ID            uuid.UUID         `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`        // Unique event ID; consumers use it to drop duplicates
+	Type          string            `json:"type" example:"requirement.updated"`                       // Entity type and action
+	EntityType    EntityType        `json:"entity_type" example:"requirement"`                        // Type of the changed entity
+	EntityID      uuid.UUID         `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the changed entity
+	Action        EntityEventAction `json:"action" example:"updated"`                                 // Lifecycle change
+	ReferenceID   string            `json:"reference_id,omitempty" example:"REQ-001"`                 // Human-readable ID of the entity
+	OccurredAt    time.Time         `json:"occurred_at" example:"2023-01-01T10:00:00Z"`               // Time of the change
+	Data          json.RawMessage   `json:"data" swaggertype:"object"`                                // State of the entity after the change; the last state for deletions
+}
+
+// OutboxEvent is an entity event waiting to be published. Events are written in the transaction of
+// the change they describe, so an event is published exactly when its change is committed.
+type OutboxEvent struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement" json:"id" example:"42"`                                               // Position in the outbox; events are published in this order
+	EventID     uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex" json:"event_id" example:"123e4567-e89b-12d3-a456-426614174000"` // ID of the published event
+	EntityType  EntityType `gorm:"not null" json:"entity_type" example:"requirement"`                                             // Type of the changed entity
+	EntityID    uuid.UUID  `gorm:"type:uuid;not null" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"`            // ID of the changed entity
+	EventType   string     `gorm:"not null" json:"event_type" example:"requirement.updated"`                                      // Entity type and action
+	Payload     string     `gorm:"type:text;not null" json:"payload"`                                                             // EntityEvent as JSON
+	Attempts    int        `gorm:"not null;default:0" json:"attempts" example:"0"`                                                // Failed publish attempts
+	LastError   string     `json:"last_error,omitempty" example:""`                                                               // Error of the last failed attempt
+	CreatedAt   time.Time  `gorm:"not null" json:"created_at" example:"2023-01-01T10:00:00Z"`                                     // Time the event was recorded
+	PublishedAt *time.Time `gorm:"index" json:"published_at,omitempty" example:"2023-01-01T10:00:01Z"`                            // Time the event was published (omitted while pending)
+}
+
+// TableName returns the table name for the OutboxEvent model
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
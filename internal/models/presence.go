@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PresenceMode represents what a user is doing with an entity
+// @Description Activity mode reported by a client for an entity (viewing or editing)
+// @Example "viewing"
+type PresenceMode string
+
+const (
+	PresenceModeViewing PresenceMode = "viewing" // User has the entity open for reading
+	PresenceModeEditing PresenceMode = "editing" // User is actively editing the entity
+)
+
+// EntityPresence represents a user's short-lived presence on an entity
+// @Description Heartbeat record telling other clients who is currently viewing or editing an entity
+type EntityPresence struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                  // Unique identifier for the presence record
+	EntityType EntityType   `gorm:"not null;uniqueIndex:idx_entity_presences_entity_user" json:"entity_type" example:"requirement"`                                  // Type of entity the user is present on
+	EntityID   uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_entity_presences_entity_user" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the entity the user is present on
+	UserID     uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_entity_presences_entity_user" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174002"`   // ID of the present user
+	Mode       PresenceMode `gorm:"not null" json:"mode" example:"viewing"`                                                                                          // Whether the user is viewing or editing
+	LastSeenAt time.Time    `gorm:"not null" json:"last_seen_at" example:"2023-01-01T12:00:00Z"`                                                                     // Timestamp of the last heartbeat
+	ExpiresAt  time.Time    `gorm:"not null;index" json:"expires_at" example:"2023-01-01T12:01:00Z"`                                                                 // Presence is ignored after this timestamp
+
+	// Relationships
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"` // Present user (populated when preloaded)
+}
+
+// BeforeCreate sets the ID if not already set
+func (p *EntityPresence) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the EntityPresence model
+func (EntityPresence) TableName() string {
+	return "entity_presences"
+}
+
+// IsExpired checks if the presence heartbeat has timed out
+func (p *EntityPresence) IsExpired() bool {
+	return time.Now().After(p.ExpiresAt)
+}
+
+// EditLock represents a soft, time-limited edit lock on an entity
+// @Description Soft edit lock preventing concurrent overwrites of an entity until it is released or expires
+type EditLock struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                       // Unique identifier for the lock
+	EntityType EntityType `gorm:"not null;uniqueIndex:idx_edit_locks_entity" json:"entity_type" example:"requirement"`                                  // Type of the locked entity
+	EntityID   uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_edit_locks_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the locked entity
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174002"`                               // ID of the lock holder
+	AcquiredAt time.Time  `gorm:"not null" json:"acquired_at" example:"2023-01-01T12:00:00Z"`                                                           // Timestamp when the lock was first acquired
+	ExpiresAt  time.Time  `gorm:"not null;index" json:"expires_at" example:"2023-01-01T12:05:00Z"`                                                      // Lock is released automatically after this timestamp
+
+	// Relationships
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"` // Lock holder (populated when preloaded)
+}
+
+// BeforeCreate sets the ID if not already set
+func (l *EditLock) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the EditLock model
+func (EditLock) TableName() string {
+	return "edit_locks"
+}
+
+// IsExpired checks if the lock TTL has elapsed
+func (l *EditLock) IsExpired() bool {
+	return time.Now().After(l.ExpiresAt)
+}
+
+// IsHeldBy checks if the lock is currently held by the given user
+func (l *EditLock) IsHeldBy(userID uuid.UUID) bool {
+	return l.UserID == userID && !l.IsExpired()
+}
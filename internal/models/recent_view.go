@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RecentView records when a user last viewed an entity
+// @Description Last time a user viewed an entity, used for "recent items" navigation
+type RecentView struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                              // Unique identifier for the view record
+	EntityType EntityType `gorm:"not null;uniqueIndex:idx_recent_views_user_entity" json:"entity_type" example:"requirement"`                                  // Type of the viewed entity
+	EntityID   uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_recent_views_user_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the viewed entity
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_recent_views_user_entity" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174002"`   // ID of the viewing user
+	ViewedAt   time.Time  `gorm:"not null" json:"viewed_at" example:"2023-01-01T10:00:00Z"`                                                                    // Timestamp of the most recent view
+}
+
+// BeforeCreate sets the ID if not already set
+func (v *RecentView) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the RecentView model
+func (RecentView) TableName() string {
+	return "recent_views"
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RedirectReason tells why a reference ID was retired
+// @Description Why a reference ID was retired: merged when its entity was merged into another, migrated when an administrator pointed it to a successor
+// @Example "merged"
+type RedirectReason string
+
+const (
+	RedirectReasonMerged   RedirectReason = "merged"   // The entity was merged into its successor
+	RedirectReasonMigrated RedirectReason = "migrated" // The entity was migrated and replaced by its successor
+)
+
+// ReferenceRedirect points a retired reference ID to the entity that succeeded it, so bookmarks and stored
+// references keep working after entities are merged or migrated
+// @Description Retired reference ID that resolves to its successor entity
+type ReferenceRedirect struct {
+	ReferenceID string         `gorm:"primaryKey" json:"reference_id" example:"EP-005"`                                                                         // Retired reference ID
+	EntityType  EntityType     `gorm:"not null;index:idx_reference_redirects_entity" json:"entity_type" example:"epic"`                                         // Type of the successor entity
+	EntityID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_reference_redirects_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"` // Successor entity the reference ID resolves to
+	SourceID    *uuid.UUID     `gorm:"type:uuid" json:"source_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`                                     // ID of the retired entity, when it existed here
+	Reason      RedirectReason `gorm:"not null" json:"reason" example:"merged"`                                                                                 // Why the reference ID was retired
+	CreatedBy   *uuid.UUID     `gorm:"type:uuid" json:"created_by,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`                                    // User who merged the entities or added the redirect
+	CreatedAt   time.Time      `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                               // Timestamp of the redirect
+}
+
+// TableName returns the table name for the ReferenceRedirect model
+func (ReferenceRedirect) TableName() string {
+	return "reference_redirects"
+}
@@ -1,19 +1,27 @@
 package models
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
-// RelationshipType represents a configurable type of relationship between requirements
+// EntityTypeWildcard matches any entity type in the allowed links of a relationship type
+const EntityTypeWildcard = "*"
+
+// RelationshipType represents a configurable type of relationship between entities
 type RelationshipType struct {
 	ID          uuid.UUID `gorm:"type:uuid;primary_key" json:"id"`
 	Name        string    `gorm:"uniqueIndex;not null" json:"name"`
 	Description *string   `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	// AllowedLinks lists the source:target entity type pairs the type can link, separated by
+	// commas, with * matching any type. Empty allows links between any two entity types.
+	AllowedLinks string    `gorm:"type:text;not null;default:''" json:"allowed_links" example:"epic:epic,user_story:user_story"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 
 	// Relationships
 	RequirementRelationships []RequirementRelationship `gorm:"foreignKey:RelationshipTypeID;constraint:OnDelete:RESTRICT" json:"requirement_relationships,omitempty"`
@@ -37,6 +45,58 @@ func (rt *RelationshipType) HasRelationships() bool {
 	return len(rt.RequirementRelationships) > 0
 }
 
+// EntityLinkRule allows links from one entity type to another; either side may be EntityTypeWildcard
+type EntityLinkRule struct {
+	SourceType EntityType
+	TargetType EntityType
+}
+
+// Matches reports whether the rule allows a link from source to target
+func (r EntityLinkRule) Matches(source, target EntityType) bool {
+	return (r.SourceType == EntityTypeWildcard || r.SourceType == source) &&
+		(r.TargetType == EntityTypeWildcard || r.TargetType == target)
+}
+
+// ParseEntityLinkRules parses allowed links such as "epic:epic, user_story:*"
+func ParseEntityLinkRules(value string) ([]EntityLinkRule, error) {
+	rules := make([]EntityLinkRule, 0)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		source, target, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid allowed link %q: use source_type:target_type", entry)
+		}
+		rule := EntityLinkRule{SourceType: EntityType(strings.TrimSpace(source)), TargetType: EntityType(strings.TrimSpace(target))}
+		for _, entityType := range []EntityType{rule.SourceType, rule.TargetType} {
+			if entityType != EntityTypeWildcard && !IsValidEntityType(entityType) {
+				return nil, fmt.Errorf("invalid allowed link %q: unknown entity type %q", entry, entityType)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// AllowsLink reports whether the relationship type can link an entity of the source type to one of the target type
+func (rt *RelationshipType) AllowsLink(source, target EntityType) bool {
+	rules, err := ParseEntityLinkRules(rt.AllowedLinks)
+	if err != nil {
+		return false
+	}
+	if len(rules) == 0 {
+		return true
+	}
+	for _, rule := range rules {
+		if rule.Matches(source, target) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetDefaultRelationshipTypes returns the default relationship types that should be created
 func GetDefaultRelationshipTypes() []RelationshipType {
 	return []RelationshipType{
@@ -60,5 +120,10 @@ func GetDefaultRelationshipTypes() []RelationshipType {
 			Name:        "derives_from",
 			Description: stringPtr("This requirement is derived from another requirement"),
 		},
+		{
+			Name:         "duplicates",
+			Description:  stringPtr("This entity duplicates another entity of the same type"),
+			AllowedLinks: "epic:epic,user_story:user_story,acceptance_criteria:acceptance_criteria,requirement:requirement",
+		},
 	}
 }
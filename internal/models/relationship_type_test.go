@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEntityLinkRules(t *testing.T) {
+	rules, err := ParseEntityLinkRules(" epic:epic, user_story:* ,")
+	require.NoError(t, err)
+	assert.Equal(t, []EntityLinkRule{
+		{SourceType: EntityTypeEpic, TargetType: EntityTypeEpic},
+		{SourceType: EntityTypeUserStory, TargetType: EntityTypeWildcard},
+	}, rules)
+
+	rules, err = ParseEntityLinkRules("")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	_, err = ParseEntityLinkRules("epic")
+	assert.Error(t, err)
+	_, err = ParseEntityLinkRules("epic:theme")
+	assert.Error(t, err)
+}
+
+func TestRelationshipTypeAllowsLink(t *testing.T) {
+	anyPair := RelationshipType{Name: "relates_to"}
+	assert.True(t, anyPair.AllowsLink(EntityTypeEpic, EntityTypeRequirement))
+
+	sameType := RelationshipType{Name: "duplicates", AllowedLinks: "epic:epic,user_story:user_story"}
+	assert.True(t, sameType.AllowsLink(EntityTypeEpic, EntityTypeEpic))
+	assert.True(t, sameType.AllowsLink(EntityTypeUserStory, EntityTypeUserStory))
+	assert.False(t, sameType.AllowsLink(EntityTypeEpic, EntityTypeUserStory))
+
+	wildcard := RelationshipType{Name: "depends_on", AllowedLinks: "*:requirement"}
+	assert.True(t, wildcard.AllowsLink(EntityTypeUserStory, EntityTypeRequirement))
+	assert.False(t, wildcard.AllowsLink(EntityTypeRequirement, EntityTypeUserStory))
+
+	invalid := RelationshipType{Name: "broken", AllowedLinks: "epic"}
+	assert.False(t, invalid.AllowsLink(EntityTypeEpic, EntityTypeEpic))
+}
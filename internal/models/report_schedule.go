@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReportFormat represents the file format a scheduled report is delivered in
+// @Description File format of a delivered report
+// @Example "csv"
+type ReportFormat string
+
+const (
+	ReportFormatCSV ReportFormat = "csv" // Comma separated values with a header row
+	ReportFormatPDF ReportFormat = "pdf" // Printable table
+)
+
+// IsValid reports whether the format is one of the supported values
+func (f ReportFormat) IsValid() bool {
+	return f == ReportFormatCSV || f == ReportFormatPDF
+}
+
+// ReportDeliveryChannel represents how a scheduled report is delivered
+// @Description Delivery channel of a scheduled report
+// @Example "email"
+type ReportDeliveryChannel string
+
+const (
+	ReportDeliveryEmail   ReportDeliveryChannel = "email"   // Emailed to the recipients as an attachment
+	ReportDeliveryWebhook ReportDeliveryChannel = "webhook" // Posted to the webhook URL as the request body
+)
+
+// IsValid reports whether the channel is one of the supported values
+func (c ReportDeliveryChannel) IsValid() bool {
+	return c == ReportDeliveryEmail || c == ReportDeliveryWebhook
+}
+
+// Report schedule run outcomes
+const (
+	ReportRunSucceeded = "succeeded"
+	ReportRunFailed    = "failed"
+)
+
+// ReportSchedule delivers a report on a cron schedule
+// @Description Schedule delivering a report by email or webhook. The report job runs due schedules, records the outcome of each run and alerts the owner by email when a delivery starts failing.
+type ReportSchedule struct {
+	ID                  uuid.UUID             `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`        // Unique identifier of the schedule
+	ReportID            string                `gorm:"not null;index" json:"report_id" example:"stale"`                                       // Report delivered by the schedule
+	Name                string                `gorm:"not null" json:"name" validate:"required,max=255" example:"Weekly stale items for ops"` // Name used in email subjects and alerts
+	CronExpression      string                `gorm:"not null" json:"cron_expression" example:"0 8 * * 1"`                                   // Five field cron expression or @hourly, @daily, @weekly, @monthly
+	Timezone            string                `gorm:"not null" json:"timezone" example:"Europe/Berlin"`                                      // Time zone the cron expression is evaluated in
+	Format              ReportFormat          `gorm:"type:varchar(10);not null" json:"format" example:"csv"`                                 // File format of the delivered report
+	Channel             ReportDeliveryChannel `gorm:"type:varchar(20);not null" json:"channel" example:"email"`                              // How the report is delivered
+	Recipients          []string              `gorm:"serializer:json;type:text" json:"recipients,omitempty" example:"ops@example.com"`       // Email recipients (email channel)
+	WebhookURL          *string               `json:"webhook_url,omitempty" example:"https://hooks.example.com/reports"`                     // URL the report is posted to (webhook channel)
+	Enabled             bool                  `gorm:"not null" json:"enabled" example:"true"`                                                // Disabled schedules are not run
+	NextRunAt           *time.Time            `gorm:"index" json:"next_run_at,omitempty" example:"2023-01-09T08:00:00Z"`                     // When the schedule runs next; unset while disabled
+	LastRunAt           *time.Time            `json:"last_run_at,omitempty" example:"2023-01-02T08:00:00Z"`                                  // When the schedule last ran
+	LastStatus          *string               `json:"last_status,omitempty" example:"succeeded"`                                             // Outcome of the last run: succeeded or failed
+	LastError           *string               `json:"last_error,omitempty" example:"webhook responded with status 503"`                      // Error of the last run when it failed
+	ConsecutiveFailures int                   `gorm:"not null;default:0" json:"consecutive_failures" example:"0"`                            // Failed runs since the last successful one
+	OwnerID             uuid.UUID             `gorm:"type:uuid;not null" json:"owner_id" example:"123e4567-e89b-12d3-a456-426614174001"`     // User who created the schedule and receives failure alerts
+	CreatedAt           time.Time             `json:"created_at" example:"2023-01-01T00:00:00Z"`                                             // Timestamp when the schedule was created
+	UpdatedAt           time.Time             `json:"updated_at" example:"2023-01-02T08:00:00Z"`                                             // Timestamp when the schedule was last changed
+
+	// Owner is the user who created the schedule (populated when preloaded)
+	Owner *User `gorm:"foreignKey:OwnerID;constraint:OnDelete:CASCADE" json:"owner,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (rs *ReportSchedule) BeforeCreate(tx *gorm.DB) error {
+	if rs.ID == uuid.Nil {
+		rs.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the ReportSchedule model
+func (ReportSchedule) TableName() string {
+	return "report_schedules"
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Package-level generator instance for requirements.
@@ -47,7 +48,7 @@ type Requirement struct {
 	ID                   uuid.UUID         `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                                                                                                                                            // Unique identifier for the requirement
 	ReferenceID          string            `gorm:"uniqueIndex;not null" json:"reference_id" example:"REQ-001"`                                                                                                                                                                                                // Human-readable reference identifier
 	UserStoryID          uuid.UUID         `gorm:"not null" json:"user_story_id" example:"123e4567-e89b-12d3-a456-426614174001"`                                                                                                                                                                              // ID of the parent user story
-	AcceptanceCriteriaID *uuid.UUID        `json:"acceptance_criteria_id" example:"123e4567-e89b-12d3-a456-426614174002"`                                                                                                                                                                                     // Optional ID of linked acceptance criteria
+	AcceptanceCriteriaID *uuid.UUID        `json:"acceptance_criteria_id" example:"123e4567-e89b-12d3-a456-426614174002"`                                                                                                                                                                                     // Optional ID of the primary acceptance criteria, which places the requirement in the hierarchy
 	CreatorID            uuid.UUID         `gorm:"not null" json:"creator_id" example:"123e4567-e89b-12d3-a456-426614174003"`                                                                                                                                                                                 // ID of the user who created the requirement
 	AssigneeID           uuid.UUID         `gorm:"not null" json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174004"`                                                                                                                                                                                // ID of the user assigned to implement the requirement
 	CreatedAt            time.Time         `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                                                                                                                                                                                 // Timestamp when the requirement was created
@@ -57,12 +58,15 @@ type Requirement struct {
 	TypeID               uuid.UUID         `gorm:"not null" json:"type_id" example:"123e4567-e89b-12d3-a456-426614174005"`                                                                                                                                                                                    // ID of the requirement type (Functional, Non-Functional, etc.)
 	Title                string            `gorm:"not null" json:"title" validate:"required,max=500" example:"User authentication must support OAuth 2.0"`                                                                                                                                                    // Brief title describing the requirement
 	Description          *string           `json:"description" validate:"omitempty,max=50000" example:"The system shall support OAuth 2.0 authentication flow with support for Google, GitHub, and Microsoft providers. The implementation must handle token refresh and provide secure session management."` // Detailed description of the requirement
+	SupersededByID       *uuid.UUID        `gorm:"index" json:"superseded_by_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174006"`                                                                                                                                                                    // ID of the requirement that supersedes this obsolete requirement
 
 	// Relationships - These fields are populated when explicitly preloaded and included in JSON via custom MarshalJSON
 	// @Description Parent user story containing this requirement (included only when preloaded via repository methods)
 	UserStory UserStory `gorm:"foreignKey:UserStoryID;constraint:OnDelete:CASCADE" json:"-"`
-	// @Description Optional linked acceptance criteria (included only when preloaded via repository methods)
+	// @Description Optional primary acceptance criteria (included only when preloaded via repository methods)
 	AcceptanceCriteria *AcceptanceCriteria `gorm:"foreignKey:AcceptanceCriteriaID;constraint:OnDelete:SET NULL" json:"-"`
+	// @Description Links to all acceptance criteria covered by this requirement, the primary one included (included as acceptance_criteria_ids only when preloaded)
+	AcceptanceCriteriaLinks []RequirementAcceptanceCriteria `gorm:"foreignKey:RequirementID;constraint:OnDelete:CASCADE" json:"-"`
 	// @Description User who created this requirement (included only when preloaded via repository methods)
 	Creator User `gorm:"foreignKey:CreatorID;constraint:OnDelete:RESTRICT" json:"-"`
 	// @Description User assigned to implement this requirement (included only when preloaded via repository methods)
@@ -104,6 +108,15 @@ func (r *Requirement) BeforeUpdate(tx *gorm.DB) error {
 	return nil
 }
 
+// AfterSave links the primary acceptance criteria, so that it is always one of the linked acceptance criteria
+func (r *Requirement) AfterSave(tx *gorm.DB) error {
+	if r.ID == uuid.Nil || r.AcceptanceCriteriaID == nil {
+		return nil
+	}
+	link := RequirementAcceptanceCriteria{RequirementID: r.ID, AcceptanceCriteriaID: *r.AcceptanceCriteriaID}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&link).Error
+}
+
 // TableName returns the table name for the Requirement model
 func (Requirement) TableName() string {
 	return "requirements"
@@ -165,6 +178,15 @@ func (r *Requirement) IsLinkedToAcceptanceCriteria() bool {
 	return r.AcceptanceCriteriaID != nil
 }
 
+// GetAcceptanceCriteriaIDs returns the IDs of the linked acceptance criteria in the order they were linked
+func (r *Requirement) GetAcceptanceCriteriaIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(r.AcceptanceCriteriaLinks))
+	for i, link := range r.AcceptanceCriteriaLinks {
+		ids[i] = link.AcceptanceCriteriaID
+	}
+	return ids
+}
+
 // MarshalJSON implements custom JSON marshaling for Requirement
 // This ensures that related objects are only included when they are actually populated
 func (r *Requirement) MarshalJSON() ([]byte, error) {
@@ -188,11 +210,21 @@ func (r *Requirement) MarshalJSON() ([]byte, error) {
 		result["acceptance_criteria_id"] = *r.AcceptanceCriteriaID
 	}
 
+	// Only include acceptance_criteria_ids if the links have been populated
+	if len(r.AcceptanceCriteriaLinks) > 0 {
+		result["acceptance_criteria_ids"] = r.GetAcceptanceCriteriaIDs()
+	}
+
 	// Only include description if it's not nil
 	if r.Description != nil {
 		result["description"] = *r.Description
 	}
 
+	// Only include superseded_by_id if it's not nil
+	if r.SupersededByID != nil {
+		result["superseded_by_id"] = *r.SupersededByID
+	}
+
 	// Only include user_story if it has been populated (has a title, indicating it was preloaded)
 	if r.UserStory.Title != "" {
 		result["user_story"] = r.UserStory
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RequirementAcceptanceCriteria links a requirement to an acceptance criteria it covers. A requirement may cover
+// several acceptance criteria of its user story; its primary acceptance criteria, which places it in the hierarchy,
+// is always one of them.
+// @Description Link between a requirement and an acceptance criteria it covers
+type RequirementAcceptanceCriteria struct {
+	RequirementID        uuid.UUID `gorm:"type:uuid;primaryKey" json:"requirement_id" example:"123e4567-e89b-12d3-a456-426614174000"`               // ID of the requirement
+	AcceptanceCriteriaID uuid.UUID `gorm:"type:uuid;primaryKey;index" json:"acceptance_criteria_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the covered acceptance criteria
+	CreatedAt            time.Time `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                               // Timestamp when the link was created
+
+	Requirement        Requirement        `gorm:"foreignKey:RequirementID;constraint:OnDelete:CASCADE" json:"-"`
+	AcceptanceCriteria AcceptanceCriteria `gorm:"foreignKey:AcceptanceCriteriaID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName returns the table name for the RequirementAcceptanceCriteria model
+func (RequirementAcceptanceCriteria) TableName() string {
+	return "requirement_acceptance_criteria"
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SignOffStakeholder designates a user to sign off requirements and user stories in a role
+// @Description User designated by an administrator to formally accept requirements and user stories in a role
+type SignOffStakeholder struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                   // Unique identifier of the designation
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_sign_off_stakeholders_user_role" json:"user_id" example:"123e4567-e89b-12d3-a456-426614174001"` // Designated user
+	Role      string    `gorm:"not null;uniqueIndex:idx_sign_off_stakeholders_user_role" json:"role" example:"Product Owner"`                                     // Role the user signs off in
+	CreatedBy uuid.UUID `gorm:"type:uuid;not null" json:"created_by" example:"123e4567-e89b-12d3-a456-426614174002"`                                              // Administrator who designated the user
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T10:00:00Z"`                                                                                        // Timestamp of the designation
+
+	// User is the designated user (populated when preloaded)
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *SignOffStakeholder) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the SignOffStakeholder model
+func (SignOffStakeholder) TableName() string {
+	return "sign_off_stakeholders"
+}
+
+// SignOff records the formal acceptance of a version of a requirement or user story by a stakeholder
+// @Description Immutable record of a stakeholder formally accepting a version of a requirement or user story
+type SignOff struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                                                // Unique identifier of the sign-off
+	EntityType    EntityType `gorm:"not null;index:idx_sign_offs_entity;uniqueIndex:idx_sign_offs_entity_signer_version" json:"entity_type" example:"requirement"`                                  // Type of the accepted entity
+	EntityID      uuid.UUID  `gorm:"type:uuid;not null;index:idx_sign_offs_entity;uniqueIndex:idx_sign_offs_entity_signer_version" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the accepted entity
+	VersionNumber int        `gorm:"not null;uniqueIndex:idx_sign_offs_entity_signer_version" json:"version_number" example:"3"`                                                                    // Version of the entity text that was accepted; 0 when the text predates the version history
+	SignerID      uuid.UUID  `gorm:"type:uuid;not null;index;uniqueIndex:idx_sign_offs_entity_signer_version" json:"signer_id" example:"123e4567-e89b-12d3-a456-426614174002"`                      // Stakeholder who signed off
+	Role          string     `gorm:"not null;uniqueIndex:idx_sign_offs_entity_signer_version" json:"role" example:"Product Owner"`                                                                  // Role the stakeholder signed off in
+	SignatureHash *string    `json:"signature_hash,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`                                                           // Hex digest of a digital signature made outside the system
+	Comment       string     `gorm:"type:text" json:"comment,omitempty" example:"Accepted for release 2.1"`                                                                                         // Remarks of the stakeholder
+	SignedAt      time.Time  `gorm:"not null;index" json:"signed_at" example:"2023-01-01T10:00:00Z"`                                                                                                // Timestamp of the sign-off
+
+	// Signer is the stakeholder who signed off (populated when preloaded)
+	Signer *User `gorm:"foreignKey:SignerID;constraint:OnDelete:RESTRICT" json:"signer,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (s *SignOff) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the SignOff model
+func (SignOff) TableName() string {
+	return "sign_offs"
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StalenessPolicy marks entities as stale when they have not been updated for a number of days
+// @Description Staleness policy such as "requirements Active with no update for 14 days". The staleness job marks matching entities as stale, emails their assignees and, when configured, escalates entities that stay stale to another user.
+type StalenessPolicy struct {
+	ID                uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`           // Unique identifier of the policy
+	Name              string     `gorm:"not null" json:"name" validate:"required,max=255" example:"Idle active requirements"`      // Name shown in notifications and reports
+	EntityType        EntityType `gorm:"not null;index" json:"entity_type" example:"requirement"`                                  // Type of entity the policy applies to: epic, user_story or requirement
+	Status            *string    `json:"status,omitempty" example:"Active"`                                                        // Only entities in this status are checked; unset checks every status
+	MaxIdleDays       int        `gorm:"not null" json:"max_idle_days" example:"14"`                                               // Days without an update after which an entity is stale
+	EscalateAfterDays *int       `json:"escalate_after_days,omitempty" example:"7"`                                                // Days an entity stays stale before it is escalated
+	EscalateToID      *uuid.UUID `gorm:"type:uuid" json:"escalate_to_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"` // User notified about escalated entities
+	Enabled           bool       `gorm:"not null" json:"enabled" example:"true"`                                                   // Disabled policies are not evaluated and mark nothing
+	CreatorID         uuid.UUID  `gorm:"type:uuid;not null" json:"creator_id" example:"123e4567-e89b-12d3-a456-426614174002"`      // Administrator who created the policy
+	CreatedAt         time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                // Timestamp when the policy was created
+	UpdatedAt         time.Time  `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                // Timestamp when the policy was last changed
+
+	// EscalateTo is the user escalated entities are reported to (populated when preloaded)
+	EscalateTo *User `gorm:"foreignKey:EscalateToID;constraint:OnDelete:SET NULL" json:"escalate_to,omitempty"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (sp *StalenessPolicy) BeforeCreate(tx *gorm.DB) error {
+	if sp.ID == uuid.Nil {
+		sp.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the StalenessPolicy model
+func (StalenessPolicy) TableName() string {
+	return "staleness_policies"
+}
+
+// StaleEntity records that an entity matches a staleness policy
+// @Description Stale mark of an entity; it is removed by the staleness job once the entity is updated or no longer matches the policy
+type StaleEntity struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                  // Unique identifier of the mark
+	PolicyID    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_stale_entities_policy_entity" json:"policy_id" example:"123e4567-e89b-12d3-a456-426614174001"` // Policy the entity matches
+	EntityType  EntityType `gorm:"not null" json:"entity_type" example:"requirement"`                                                                               // Type of the stale entity
+	EntityID    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_stale_entities_policy_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174002"` // ID of the stale entity
+	DetectedAt  time.Time  `gorm:"not null" json:"detected_at" example:"2023-01-15T08:00:00Z"`                                                                      // When the staleness job first found the entity stale
+	NotifiedAt  *time.Time `json:"notified_at,omitempty" example:"2023-01-15T08:00:00Z"`                                                                            // When the assignee was emailed
+	EscalatedAt *time.Time `json:"escalated_at,omitempty" example:"2023-01-22T08:00:00Z"`                                                                           // When the entity was escalated
+
+	// Policy is the matched policy; marks are removed together with their policy
+	Policy *StalenessPolicy `gorm:"foreignKey:PolicyID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// BeforeCreate sets the ID if not already set
+func (se *StaleEntity) BeforeCreate(tx *gorm.DB) error {
+	if se.ID == uuid.Nil {
+		se.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the StaleEntity model
+func (StaleEntity) TableName() string {
+	return "stale_entities"
+}
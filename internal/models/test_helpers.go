@@ -23,6 +23,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 		&UserStory{},
 		&Requirement{},
 		&AcceptanceCriteria{},
+		&RequirementAcceptanceCriteria{},
 		&RequirementType{},
 		&RelationshipType{},
 		&RequirementRelationship{},
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TestResultStatus is the outcome of a test case
+type TestResultStatus string
+
+const (
+	TestResultPassed  TestResultStatus = "passed"
+	TestResultFailed  TestResultStatus = "failed"
+	TestResultSkipped TestResultStatus = "skipped"
+)
+
+// VerificationStatus is the verification state of an acceptance criteria or requirement
+type VerificationStatus string
+
+const (
+	VerificationUnverified VerificationStatus = "unverified" // No test result imported yet
+	VerificationVerified   VerificationStatus = "verified"   // The tests of the latest run passed
+	VerificationFailed     VerificationStatus = "failed"     // A test of the latest run failed
+)
+
+// TestRun records a test run imported from a CI pipeline
+// @Description Test run imported from a CI pipeline, with its counts and the results matched to acceptance criteria and requirements
+type TestRun struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`       // Unique identifier of the run
+	Name       string    `gorm:"not null" json:"name" example:"checkout-service #1432"`                                // Name of the run, e.g. the CI job
+	Format     string    `gorm:"not null" json:"format" example:"junit"`                                               // Format the results were imported from: junit or json
+	CommitSHA  *string   `json:"commit_sha,omitempty" example:"9fceb02d0ae598e95dc970b74767f19372d61af8"`              // Commit the tests ran against
+	Branch     *string   `json:"branch,omitempty" example:"main"`                                                      // Branch the tests ran on
+	BuildURL   *string   `json:"build_url,omitempty" example:"https://ci.example.com/builds/1432"`                     // Link to the CI build
+	ImportedBy uuid.UUID `gorm:"type:uuid;not null" json:"imported_by" example:"123e4567-e89b-12d3-a456-426614174001"` // User whose token imported the run
+	ImportedAt time.Time `gorm:"not null;index" json:"imported_at" example:"2023-01-01T12:00:00Z"`                     // When the run was imported
+	Passed     int       `gorm:"not null;default:0" json:"passed" example:"12"`                                        // Matched results that passed
+	Failed     int       `gorm:"not null;default:0" json:"failed" example:"1"`                                         // Matched results that failed
+	Skipped    int       `gorm:"not null;default:0" json:"skipped" example:"0"`                                        // Matched results that were skipped
+	Unmatched  int       `gorm:"not null;default:0" json:"unmatched" example:"3"`                                      // Test cases without a known acceptance criteria or requirement reference
+
+	// Relationships
+	Results  []TestResult `gorm:"foreignKey:TestRunID;constraint:OnDelete:CASCADE" json:"results,omitempty"`    // Results matched to entities (populated when preloaded)
+	Importer *User        `gorm:"foreignKey:ImportedBy;constraint:OnDelete:RESTRICT" json:"importer,omitempty"` // User who imported the run (populated when preloaded)
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *TestRun) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the TestRun model
+func (TestRun) TableName() string {
+	return "test_runs"
+}
+
+// TestResult is the outcome of a test case for an acceptance criteria or requirement it references
+// @Description Outcome of a test case for an acceptance criteria or requirement referenced by the test
+type TestResult struct {
+	ID          uuid.UUID        `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                   // Unique identifier of the result
+	TestRunID   uuid.UUID        `gorm:"type:uuid;not null;index" json:"test_run_id" example:"123e4567-e89b-12d3-a456-426614174001"`                       // Run the result belongs to
+	EntityType  EntityType       `gorm:"not null;index:idx_test_results_entity" json:"entity_type" example:"acceptance_criteria"`                          // Type of the verified entity
+	EntityID    uuid.UUID        `gorm:"type:uuid;not null;index:idx_test_results_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174002"` // ID of the verified entity
+	ReferenceID string           `gorm:"not null" json:"reference_id" example:"AC-001"`                                                                    // Reference ID the test named
+	TestName    string           `gorm:"type:text;not null" json:"test_name" example:"LoginTest.AC-001 accepts valid credentials"`                         // Test case, prefixed with its class for JUnit results
+	Status      TestResultStatus `gorm:"not null" json:"status" example:"passed"`                                                                          // Outcome of the test case
+	Message     string           `gorm:"type:text" json:"message,omitempty" example:"expected 302, got 500"`                                               // Failure message
+}
+
+// BeforeCreate sets the ID if not already set
+func (r *TestResult) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the TestResult model
+func (TestResult) TableName() string {
+	return "test_results"
+}
+
+// EntityVerification is the verification state of an acceptance criteria or requirement from its latest test run
+// @Description Verification state of an acceptance criteria or requirement, set by the latest imported run with results for it
+type EntityVerification struct {
+	ID         uuid.UUID          `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`                                                 // Unique identifier of the verification
+	EntityType EntityType         `gorm:"not null;uniqueIndex:idx_entity_verifications_entity" json:"entity_type" example:"acceptance_criteria"`                          // Type of the verified entity
+	EntityID   uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_entity_verifications_entity" json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"` // ID of the verified entity
+	Status     VerificationStatus `gorm:"not null" json:"status" example:"verified"`                                                                                      // Verified when the tests of the latest run passed, failed when one failed
+	TestRunID  uuid.UUID          `gorm:"type:uuid;not null" json:"test_run_id" example:"123e4567-e89b-12d3-a456-426614174002"`                                           // Run that set the status
+	VerifiedAt time.Time          `gorm:"not null" json:"verified_at" example:"2023-01-01T12:00:00Z"`                                                                     // When the run was imported
+
+	// Relationships
+	TestRun *TestRun `gorm:"foreignKey:TestRunID;constraint:OnDelete:CASCADE" json:"test_run,omitempty"` // Run that set the status (populated when preloaded)
+}
+
+// BeforeCreate sets the ID if not already set
+func (v *EntityVerification) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
+
+// TableName returns the table name for the EntityVerification model
+func (EntityVerification) TableName() string {
+	return "entity_verifications"
+}
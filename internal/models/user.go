@@ -18,16 +18,23 @@ const (
 	RoleCommenter     UserRole = "Commenter"     // Commenter - can only add comments, limited editing capabilities
 )
 
+// IsValid reports whether the role is one of the supported roles
+func (r UserRole) IsValid() bool {
+	return r == RoleAdministrator || r == RoleUser || r == RoleCommenter
+}
+
 // User represents a system user
 // @Description A user account in the system with authentication and role-based permissions
 type User struct {
-	ID           uuid.UUID `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`             // Unique identifier for the user
-	Username     string    `gorm:"uniqueIndex;not null" json:"username" validate:"required,min=3,max=50" example:"john_doe"`   // Unique username for login
-	Email        string    `gorm:"uniqueIndex;not null" json:"email" validate:"required,email" example:"john.doe@example.com"` // Unique email address for login and notifications
-	PasswordHash string    `gorm:"not null" json:"-"`                                                                          // Hashed password (never exposed in JSON responses)
-	Role         UserRole  `gorm:"not null" json:"role" validate:"required" example:"User"`                                    // User role determining permissions
-	CreatedAt    time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                  // Timestamp when the user account was created
-	UpdatedAt    time.Time `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                  // Timestamp when the user account was last updated
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key" json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`             // Unique identifier for the user
+	Username      string     `gorm:"uniqueIndex;not null" json:"username" validate:"required,min=3,max=50" example:"john_doe"`   // Unique username for login
+	Email         string     `gorm:"uniqueIndex;not null" json:"email" validate:"required,email" example:"john.doe@example.com"` // Unique email address for login and notifications
+	PasswordHash  string     `gorm:"not null" json:"-"`                                                                          // Hashed password (never exposed in JSON responses)
+	Role          UserRole   `gorm:"not null" json:"role" validate:"required" example:"User"`                                    // User role determining permissions
+	DeactivatedAt *time.Time `gorm:"index" json:"deactivated_at,omitempty" example:"2023-06-01T00:00:00Z"`                       // Timestamp when the account was deactivated (omitted for active accounts)
+	Team          *string    `gorm:"type:varchar(100);index" json:"team,omitempty" example:"Payments"`                           // Team the user belongs to (optional)
+	CreatedAt     time.Time  `json:"created_at" example:"2023-01-01T00:00:00Z"`                                                  // Timestamp when the user account was created
+	UpdatedAt     time.Time  `json:"updated_at" example:"2023-01-02T12:30:00Z"`                                                  // Timestamp when the user account was last updated
 
 	// Relationships (excluded from JSON to prevent circular references and reduce payload size)
 	CreatedEpics               []Epic                    `gorm:"foreignKey:CreatorID" json:"-"`  // Epics created by this user
@@ -56,6 +63,11 @@ func (User) TableName() string {
 	return "users"
 }
 
+// IsActive checks if the user account has not been deactivated
+func (u *User) IsActive() bool {
+	return u.DeactivatedAt == nil
+}
+
 // IsAdministrator checks if the user has administrator role
 func (u *User) IsAdministrator() bool {
 	return u.Role == RoleAdministrator
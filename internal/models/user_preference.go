@@ -0,0 +1,25 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserPreference stores one namespace of a user's client preferences
+// @Description Client settings of a user, such as default filters, board layouts or notification settings, stored as a JSON object per namespace
+type UserPreference struct {
+	UserID    uuid.UUID       `gorm:"type:uuid;primary_key" json:"-"`                                        // Owner of the preferences
+	Namespace string          `gorm:"type:varchar(64);primary_key" json:"namespace" example:"web.board"`     // Client-chosen namespace, such as web.board or cli
+	Value     json.RawMessage `gorm:"serializer:json;type:jsonb;not null" json:"value" swaggertype:"object"` // Preferences of the namespace as a JSON object
+	UpdatedAt time.Time       `json:"updated_at" example:"2023-01-01T00:00:00Z"`                             // Timestamp when the namespace was last saved
+
+	// User is the owner of the preferences (populated when preloaded)
+	User *User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName returns the table name for the UserPreference model
+func (UserPreference) TableName() string {
+	return "user_preferences"
+}
@@ -107,6 +107,31 @@ type UserStory struct {
 	// @Example "As a registered user, I want to log in with my email and password, so that I can access my personalized dashboard and account features."
 	Description *string `json:"description,omitempty" validate:"omitempty,max=50000"`
 
+	// DueDate is the date the user story is due
+	// @Description Date the user story is due; shown in calendar feeds (optional)
+	// @Example "2023-02-28T00:00:00Z"
+	DueDate *time.Time `gorm:"type:date" json:"due_date,omitempty"`
+
+	// Archived hides the user story from default lists and search without deleting it
+	// @Description Whether the user story is archived; archived user stories are only listed and searched with include_archived=true
+	// @Example false
+	Archived bool `gorm:"not null;default:false;index" json:"archived"`
+
+	// ArchivedAt is the timestamp when the user story was archived
+	// @Description Timestamp when the user story was archived (RFC3339 format, only set while archived)
+	// @Example "2023-06-30T09:00:00Z"
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// AIGenerated marks a user story proposed by a language model and accepted by a user
+	// @Description Whether the user story was generated by a language model, such as by epic decomposition
+	// @Example false
+	AIGenerated bool `gorm:"not null;default:false" json:"ai_generated"`
+
+	// AIModel is the language model that generated the user story
+	// @Description Provider and model that generated the user story (only set for AI-generated user stories)
+	// @Example "openai/gpt-4o"
+	AIModel *string `json:"ai_model,omitempty"`
+
 	// Relationships
 	// Epic contains the epic information this user story belongs to
 	// @Description Epic that contains this user story (populated when requested with ?include=epic)
@@ -34,9 +34,10 @@ type HealthResponse struct {
 
 // CheckResult represents individual health check result
 type CheckResult struct {
-	Status  string `json:"status"`
-	Message string `json:"message,omitempty"`
-	Latency string `json:"latency,omitempty"`
+	Status  string      `json:"status"`
+	Message string      `json:"message,omitempty"`
+	Latency string      `json:"latency,omitempty"`
+	Details interface{} `json:"details,omitempty"`
 }
 
 // BasicHealth returns basic health status (liveness probe)
@@ -173,20 +174,13 @@ func (h *HealthChecker) DeepHealth(c *gin.Context) {
 			overallHealthy = false
 		}
 
-		// Additional database performance checks
-		if sqlDB, err := h.db.Postgres.DB(); err == nil {
-			stats := sqlDB.Stats()
+		// Connection pool usage, to diagnose saturation under load
+		if poolStats, err := h.db.PoolStats(); err == nil {
+			poolHealth := poolStats.Health()
 			response.Checks["database_connections"] = CheckResult{
-				Status:  "healthy",
-				Message: "Connection pool is healthy",
-			}
-
-			// Check for connection pool issues
-			if stats.OpenConnections > 50 { // Assuming max 100 connections
-				response.Checks["database_connections"] = CheckResult{
-					Status:  "warning",
-					Message: "High number of database connections",
-				}
+				Status:  poolHealth.Status,
+				Message: poolHealth.Message,
+				Details: poolStats,
 			}
 		}
 	}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"database/sql"
 	"strconv"
 	"time"
 
@@ -22,6 +23,11 @@ type Metrics struct {
 	DatabaseQueries       *prometheus.CounterVec
 	DatabaseQueryDuration *prometheus.HistogramVec
 
+	// Database connection pool metrics, mirrored from sql.DBStats (cumulative since startup)
+	DatabaseConnectionWaits        *prometheus.GaugeVec
+	DatabaseConnectionWaitDuration *prometheus.GaugeVec
+	DatabaseConnectionsClosed      *prometheus.GaugeVec
+
 	// Business metrics
 	EntitiesTotal   *prometheus.CounterVec
 	EntitiesCreated *prometheus.CounterVec
@@ -107,6 +113,29 @@ func Init(serviceName, version string) *Metrics {
 			[]string{"database", "operation", "table"},
 		),
 
+		// Database connection pool metrics
+		DatabaseConnectionWaits: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "database_connection_waits",
+				Help: "Total number of times a query waited for a free pool connection",
+			},
+			[]string{"database"},
+		),
+		DatabaseConnectionWaitDuration: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "database_connection_wait_duration_seconds",
+				Help: "Total time spent waiting for a free pool connection in seconds",
+			},
+			[]string{"database"},
+		),
+		DatabaseConnectionsClosed: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "database_connections_closed",
+				Help: "Total number of pool connections closed, by reason",
+			},
+			[]string{"database", "reason"},
+		),
+
 		// Business metrics
 		EntitiesTotal: promauto.NewCounterVec(
 			prometheus.CounterOpts{
@@ -252,6 +281,20 @@ func (m *Metrics) RecordDatabaseConnection(database, state string, count float64
 	m.DatabaseConnections.WithLabelValues(database, state).Set(count)
 }
 
+// RecordDatabasePoolStats records connection pool usage, waits and closed connections
+func (m *Metrics) RecordDatabasePoolStats(database string, stats sql.DBStats) {
+	m.RecordDatabaseConnection(database, "open", float64(stats.OpenConnections))
+	m.RecordDatabaseConnection(database, "idle", float64(stats.Idle))
+	m.RecordDatabaseConnection(database, "in_use", float64(stats.InUse))
+	m.RecordDatabaseConnection(database, "max_open", float64(stats.MaxOpenConnections))
+
+	m.DatabaseConnectionWaits.WithLabelValues(database).Set(float64(stats.WaitCount))
+	m.DatabaseConnectionWaitDuration.WithLabelValues(database).Set(stats.WaitDuration.Seconds())
+	m.DatabaseConnectionsClosed.WithLabelValues(database, "max_idle").Set(float64(stats.MaxIdleClosed))
+	m.DatabaseConnectionsClosed.WithLabelValues(database, "max_idle_time").Set(float64(stats.MaxIdleTimeClosed))
+	m.DatabaseConnectionsClosed.WithLabelValues(database, "max_lifetime").Set(float64(stats.MaxLifetimeClosed))
+}
+
 // RecordDatabaseQuery records database query metrics
 func (m *Metrics) RecordDatabaseQuery(database, operation, table string, duration time.Duration) {
 	m.DatabaseQueries.WithLabelValues(database, operation, table).Inc()
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"database/sql"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -10,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -88,6 +90,27 @@ func TestRecordDatabaseConnection(t *testing.T) {
 	assert.NotNil(t, metrics.DatabaseConnections)
 }
 
+func TestRecordDatabasePoolStats(t *testing.T) {
+	metrics, cleanup := setupTestMetrics(t)
+	defer cleanup()
+
+	metrics.RecordDatabasePoolStats("postgresql", sql.DBStats{
+		MaxOpenConnections: 20,
+		OpenConnections:    12,
+		InUse:              10,
+		Idle:               2,
+		WaitCount:          7,
+		WaitDuration:       1500 * time.Millisecond,
+		MaxLifetimeClosed:  3,
+	})
+
+	assert.Equal(t, 10.0, testutil.ToFloat64(metrics.DatabaseConnections.WithLabelValues("postgresql", "in_use")))
+	assert.Equal(t, 20.0, testutil.ToFloat64(metrics.DatabaseConnections.WithLabelValues("postgresql", "max_open")))
+	assert.Equal(t, 7.0, testutil.ToFloat64(metrics.DatabaseConnectionWaits.WithLabelValues("postgresql")))
+	assert.Equal(t, 1.5, testutil.ToFloat64(metrics.DatabaseConnectionWaitDuration.WithLabelValues("postgresql")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(metrics.DatabaseConnectionsClosed.WithLabelValues("postgresql", "max_lifetime")))
+}
+
 func TestRecordDatabaseQuery(t *testing.T) {
 	metrics, cleanup := setupTestMetrics(t)
 	defer cleanup()
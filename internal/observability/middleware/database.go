@@ -157,10 +157,7 @@ func (p *DatabaseMetricsPlugin) startConnectionMonitoring(db *gorm.DB) {
 				continue
 			}
 
-			stats := sqlDB.Stats()
-			p.metrics.RecordDatabaseConnection("postgresql", "open", float64(stats.OpenConnections))
-			p.metrics.RecordDatabaseConnection("postgresql", "idle", float64(stats.Idle))
-			p.metrics.RecordDatabaseConnection("postgresql", "in_use", float64(stats.InUse))
+			p.metrics.RecordDatabasePoolStats("postgresql", sqlDB.Stats())
 		}
 	}()
 }
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"github.com/google/uuid"
@@ -22,45 +23,45 @@ func NewAcceptanceCriteriaRepository(db *gorm.DB) AcceptanceCriteriaRepository {
 }
 
 // GetByUserStory retrieves acceptance criteria by user story ID
-func (r *acceptanceCriteriaRepository) GetByUserStory(userStoryID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+func (r *acceptanceCriteriaRepository) GetByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.AcceptanceCriteria, error) {
 	var criteria []models.AcceptanceCriteria
-	if err := r.GetDB().Where("user_story_id = ?", userStoryID).Find(&criteria).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("user_story_id = ?", userStoryID).Find(&criteria).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return criteria, nil
 }
 
 // GetByAuthor retrieves acceptance criteria by author ID
-func (r *acceptanceCriteriaRepository) GetByAuthor(authorID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+func (r *acceptanceCriteriaRepository) GetByAuthor(ctx context.Context, authorID uuid.UUID) ([]models.AcceptanceCriteria, error) {
 	var criteria []models.AcceptanceCriteria
-	if err := r.GetDB().Where("author_id = ?", authorID).Find(&criteria).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("author_id = ?", authorID).Find(&criteria).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return criteria, nil
 }
 
-// HasRequirements checks if acceptance criteria has any associated requirements
-func (r *acceptanceCriteriaRepository) HasRequirements(id uuid.UUID) (bool, error) {
+// HasRequirements checks if acceptance criteria has any linked requirements
+func (r *acceptanceCriteriaRepository) HasRequirements(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.Requirement{}).Where("acceptance_criteria_id = ?", id).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.RequirementAcceptanceCriteria{}).Where("acceptance_criteria_id = ?", id).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
 
 // CountByUserStory returns the count of acceptance criteria for a user story
-func (r *acceptanceCriteriaRepository) CountByUserStory(userStoryID uuid.UUID) (int64, error) {
+func (r *acceptanceCriteriaRepository) CountByUserStory(ctx context.Context, userStoryID uuid.UUID) (int64, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.AcceptanceCriteria{}).Where("user_story_id = ?", userStoryID).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.AcceptanceCriteria{}).Where("user_story_id = ?", userStoryID).Count(&count).Error; err != nil {
 		return 0, r.handleDBError(err)
 	}
 	return count, nil
 }
 
 // GetByIDWithPreloads retrieves acceptance criteria by its ID with UserStory and Author preloaded
-func (r *acceptanceCriteriaRepository) GetByIDWithPreloads(id uuid.UUID) (*models.AcceptanceCriteria, error) {
+func (r *acceptanceCriteriaRepository) GetByIDWithPreloads(ctx context.Context, id uuid.UUID) (*models.AcceptanceCriteria, error) {
 	var criteria models.AcceptanceCriteria
-	if err := r.GetDB().
+	if err := r.GetDB().WithContext(ctx).
 		Preload("UserStory").
 		Preload("Author").
 		Where("id = ?", id).First(&criteria).Error; err != nil {
@@ -73,9 +74,9 @@ func (r *acceptanceCriteriaRepository) GetByIDWithPreloads(id uuid.UUID) (*model
 }
 
 // GetByReferenceIDWithPreloads retrieves acceptance criteria by its reference ID with UserStory and Author preloaded
-func (r *acceptanceCriteriaRepository) GetByReferenceIDWithPreloads(referenceID string) (*models.AcceptanceCriteria, error) {
+func (r *acceptanceCriteriaRepository) GetByReferenceIDWithPreloads(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error) {
 	var criteria models.AcceptanceCriteria
-	if err := r.GetDB().
+	if err := r.GetDB().WithContext(ctx).
 		Preload("UserStory").
 		Preload("Author").
 		Where("reference_id = ?", referenceID).First(&criteria).Error; err != nil {
@@ -88,10 +89,10 @@ func (r *acceptanceCriteriaRepository) GetByReferenceIDWithPreloads(referenceID
 }
 
 // ListWithPreloads retrieves acceptance criteria with UserStory and Author preloaded
-func (r *acceptanceCriteriaRepository) ListWithPreloads(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.AcceptanceCriteria, error) {
+func (r *acceptanceCriteriaRepository) ListWithPreloads(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.AcceptanceCriteria, error) {
 	var criteria []models.AcceptanceCriteria
 
-	query := r.GetDB().
+	query := r.GetDB().WithContext(ctx).
 		Preload("UserStory").
 		Preload("Author")
 
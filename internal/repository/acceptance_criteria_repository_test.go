@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -29,6 +30,7 @@ func setupAcceptanceCriteriaTestDB(t *testing.T) *gorm.DB {
 		&models.AcceptanceCriteria{},
 		&models.Requirement{},
 		&models.RequirementType{},
+		&models.RequirementAcceptanceCriteria{},
 	)
 	require.NoError(t, err)
 
@@ -54,6 +56,7 @@ func createTestAcceptanceCriteria(t *testing.T, db *gorm.DB, userStory *models.U
 // TestAcceptanceCriteriaRepository_Create tests the Create method
 // References: AC-749 - Create returns acceptance criteria ID and commits; insertion errors surface without committing
 func TestAcceptanceCriteriaRepository_Create(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -68,7 +71,7 @@ func TestAcceptanceCriteriaRepository_Create(t *testing.T) {
 		ReferenceID: "AC-001", // Set manually to avoid PostgreSQL function call
 	}
 
-	err := repo.Create(ac)
+	err := repo.Create(ctx, ac)
 	require.NoError(t, err)
 	assert.NotEmpty(t, ac.ID)
 	assert.Equal(t, "AC-001", ac.ReferenceID)
@@ -78,6 +81,7 @@ func TestAcceptanceCriteriaRepository_Create(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByID tests the GetByID method
 // References: AC-750 - Get by ID returns full acceptance criteria; missing record yields not-found
 func TestAcceptanceCriteriaRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -87,7 +91,7 @@ func TestAcceptanceCriteriaRepository_GetByID(t *testing.T) {
 	ac := createTestAcceptanceCriteria(t, db, userStory, user, "AC-001")
 
 	// Test successful retrieval
-	retrieved, err := repo.GetByID(ac.ID)
+	retrieved, err := repo.GetByID(ctx, ac.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, ac.ID, retrieved.ID)
 	assert.Equal(t, ac.ReferenceID, retrieved.ReferenceID)
@@ -97,11 +101,12 @@ func TestAcceptanceCriteriaRepository_GetByID(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByID_NotFound tests GetByID with non-existent ID
 // References: AC-750 - Missing record yields not-found
 func TestAcceptanceCriteriaRepository_GetByID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
 	nonExistentID := uuid.New()
-	ac, err := repo.GetByID(nonExistentID)
+	ac, err := repo.GetByID(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, ac)
@@ -110,6 +115,7 @@ func TestAcceptanceCriteriaRepository_GetByID_NotFound(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByReferenceID tests the GetByReferenceID method
 // References: AC-750 - Get by ReferenceID returns full acceptance criteria; missing record yields not-found
 func TestAcceptanceCriteriaRepository_GetByReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -119,7 +125,7 @@ func TestAcceptanceCriteriaRepository_GetByReferenceID(t *testing.T) {
 	ac := createTestAcceptanceCriteria(t, db, userStory, user, "AC-001")
 
 	// Test successful retrieval
-	retrieved, err := repo.GetByReferenceID(ac.ReferenceID)
+	retrieved, err := repo.GetByReferenceID(ctx, ac.ReferenceID)
 	assert.NoError(t, err)
 	assert.Equal(t, ac.ID, retrieved.ID)
 	assert.Equal(t, ac.ReferenceID, retrieved.ReferenceID)
@@ -129,10 +135,11 @@ func TestAcceptanceCriteriaRepository_GetByReferenceID(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByReferenceID_NotFound tests GetByReferenceID with non-existent reference ID
 // References: AC-750 - Missing record yields not-found
 func TestAcceptanceCriteriaRepository_GetByReferenceID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
-	ac, err := repo.GetByReferenceID("AC-999")
+	ac, err := repo.GetByReferenceID(ctx, "AC-999")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, ac)
@@ -141,6 +148,7 @@ func TestAcceptanceCriteriaRepository_GetByReferenceID_NotFound(t *testing.T) {
 // TestAcceptanceCriteriaRepository_Update tests the Update method
 // References: AC-751 - Update performs a single UPDATE; conflicts/errors propagate without committing
 func TestAcceptanceCriteriaRepository_Update(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -153,11 +161,11 @@ func TestAcceptanceCriteriaRepository_Update(t *testing.T) {
 	newDescription := "WHEN user logs out THEN the system SHALL clear session"
 	ac.Description = newDescription
 
-	err := repo.Update(ac)
+	err := repo.Update(ctx, ac)
 	require.NoError(t, err)
 
 	// Verify the update
-	retrieved, err := repo.GetByID(ac.ID)
+	retrieved, err := repo.GetByID(ctx, ac.ID)
 	require.NoError(t, err)
 	assert.Equal(t, newDescription, retrieved.Description)
 }
@@ -165,6 +173,7 @@ func TestAcceptanceCriteriaRepository_Update(t *testing.T) {
 // TestAcceptanceCriteriaRepository_Delete tests the Delete method
 // References: AC-752 - Delete removes acceptance criteria; FK/relationship conflicts surface and record remains
 func TestAcceptanceCriteriaRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -174,11 +183,11 @@ func TestAcceptanceCriteriaRepository_Delete(t *testing.T) {
 	ac := createTestAcceptanceCriteria(t, db, userStory, user, "AC-001")
 
 	// Delete the acceptance criteria
-	err := repo.Delete(ac.ID)
+	err := repo.Delete(ctx, ac.ID)
 	require.NoError(t, err)
 
 	// Verify deletion
-	retrieved, err := repo.GetByID(ac.ID)
+	retrieved, err := repo.GetByID(ctx, ac.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
@@ -187,6 +196,7 @@ func TestAcceptanceCriteriaRepository_Delete(t *testing.T) {
 // TestAcceptanceCriteriaRepository_Create_DuplicateReferenceID tests insertion error with duplicate reference ID
 // References: AC-749 - insertion errors surface without committing
 func TestAcceptanceCriteriaRepository_Create_DuplicateReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -205,12 +215,12 @@ func TestAcceptanceCriteriaRepository_Create_DuplicateReferenceID(t *testing.T)
 		ReferenceID: "AC-001", // Duplicate!
 	}
 
-	err := repo.Create(ac2)
+	err := repo.Create(ctx, ac2)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "UNIQUE constraint")
 
 	// Verify original record still exists and no side effects
-	retrieved, err := repo.GetByReferenceID("AC-001")
+	retrieved, err := repo.GetByReferenceID(ctx, "AC-001")
 	assert.NoError(t, err)
 	assert.Equal(t, ac1.ID, retrieved.ID)
 	assert.Equal(t, ac1.Description, retrieved.Description)
@@ -223,6 +233,7 @@ func TestAcceptanceCriteriaRepository_Create_DuplicateReferenceID(t *testing.T)
 // TestAcceptanceCriteriaRepository_Delete_WithRequirements tests FK constraint on delete with requirements
 // References: AC-752 - FK/relationship conflicts surface and record remains
 func TestAcceptanceCriteriaRepository_Delete_WithRequirements(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -249,11 +260,11 @@ func TestAcceptanceCriteriaRepository_Delete_WithRequirements(t *testing.T) {
 	require.NoError(t, err)
 
 	// Delete acceptance criteria - should succeed with SET NULL
-	err = repo.Delete(ac.ID)
+	err = repo.Delete(ctx, ac.ID)
 	assert.NoError(t, err)
 
 	// Verify AC is deleted
-	retrieved, err := repo.GetByID(ac.ID)
+	retrieved, err := repo.GetByID(ctx, ac.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
@@ -268,6 +279,7 @@ func TestAcceptanceCriteriaRepository_Delete_WithRequirements(t *testing.T) {
 // TestAcceptanceCriteriaRepository_CountByUserStory tests counting acceptance criteria for a user story
 // References: AC-753 - CRUD tests are isolated on mocked or in-memory DB, idempotent and independent
 func TestAcceptanceCriteriaRepository_CountByUserStory(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -281,7 +293,7 @@ func TestAcceptanceCriteriaRepository_CountByUserStory(t *testing.T) {
 	createTestAcceptanceCriteria(t, db, userStory, user, "AC-003")
 
 	// Count acceptance criteria for user story
-	count, err := repo.CountByUserStory(userStory.ID)
+	count, err := repo.CountByUserStory(ctx, userStory.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(3), count)
 }
@@ -289,6 +301,7 @@ func TestAcceptanceCriteriaRepository_CountByUserStory(t *testing.T) {
 // TestAcceptanceCriteriaRepository_HasRequirements tests checking if acceptance criteria has requirements
 // References: AC-753 - CRUD tests are isolated on mocked or in-memory DB, idempotent and independent
 func TestAcceptanceCriteriaRepository_HasRequirements(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -298,7 +311,7 @@ func TestAcceptanceCriteriaRepository_HasRequirements(t *testing.T) {
 	ac := createTestAcceptanceCriteria(t, db, userStory, user, "AC-001")
 
 	// Initially should have no requirements
-	hasReqs, err := repo.HasRequirements(ac.ID)
+	hasReqs, err := repo.HasRequirements(ctx, ac.ID)
 	assert.NoError(t, err)
 	assert.False(t, hasReqs)
 
@@ -318,7 +331,7 @@ func TestAcceptanceCriteriaRepository_HasRequirements(t *testing.T) {
 	require.NoError(t, err)
 
 	// Now should have requirements
-	hasReqs, err = repo.HasRequirements(ac.ID)
+	hasReqs, err = repo.HasRequirements(ctx, ac.ID)
 	assert.NoError(t, err)
 	assert.True(t, hasReqs)
 }
@@ -328,6 +341,7 @@ func TestAcceptanceCriteriaRepository_HasRequirements(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByUserStory tests filtering by user story ID
 // References: AC-754 - Filter by user_story_id returns only matching acceptance criteria; missing story yields empty result
 func TestAcceptanceCriteriaRepository_GetByUserStory(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -342,18 +356,18 @@ func TestAcceptanceCriteriaRepository_GetByUserStory(t *testing.T) {
 	createTestAcceptanceCriteria(t, db, userStory2, user, "AC-003")
 
 	// Test filter by userStory1
-	result, err := repo.GetByUserStory(userStory1.ID)
+	result, err := repo.GetByUserStory(ctx, userStory1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by userStory2
-	result, err = repo.GetByUserStory(userStory2.ID)
+	result, err = repo.GetByUserStory(ctx, userStory2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by non-existent user story
 	nonExistentID := uuid.New()
-	result, err = repo.GetByUserStory(nonExistentID)
+	result, err = repo.GetByUserStory(ctx, nonExistentID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -361,6 +375,7 @@ func TestAcceptanceCriteriaRepository_GetByUserStory(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByAuthor tests filtering by author ID
 // References: AC-755 - Filter by author_id returns only authored acceptance criteria; unknown author yields empty result
 func TestAcceptanceCriteriaRepository_GetByAuthor(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -375,18 +390,18 @@ func TestAcceptanceCriteriaRepository_GetByAuthor(t *testing.T) {
 	createTestAcceptanceCriteria(t, db, userStory, user2, "AC-003")
 
 	// Test filter by user1
-	result, err := repo.GetByAuthor(user1.ID)
+	result, err := repo.GetByAuthor(ctx, user1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by user2
-	result, err = repo.GetByAuthor(user2.ID)
+	result, err = repo.GetByAuthor(ctx, user2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by non-existent author
 	nonExistentUserID := uuid.New()
-	result, err = repo.GetByAuthor(nonExistentUserID)
+	result, err = repo.GetByAuthor(ctx, nonExistentUserID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -394,6 +409,7 @@ func TestAcceptanceCriteriaRepository_GetByAuthor(t *testing.T) {
 // TestAcceptanceCriteriaRepository_ListWithPreloads tests listing with preloads
 // References: AC-757 - With preload enabled, comments/requirements/user_story load efficiently (minimal queries)
 func TestAcceptanceCriteriaRepository_ListWithPreloads(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -409,7 +425,7 @@ func TestAcceptanceCriteriaRepository_ListWithPreloads(t *testing.T) {
 	filters := map[string]interface{}{
 		"user_story_id": userStory.ID,
 	}
-	result, err := repo.ListWithPreloads(filters, "created_at ASC", 10, 0)
+	result, err := repo.ListWithPreloads(ctx, filters, "created_at ASC", 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	// Verify preloads
@@ -420,6 +436,7 @@ func TestAcceptanceCriteriaRepository_ListWithPreloads(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByIDWithPreloads tests GetByID with preloads
 // References: AC-757 - With preload enabled, comments/requirements/user_story load efficiently (minimal queries)
 func TestAcceptanceCriteriaRepository_GetByIDWithPreloads(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -429,7 +446,7 @@ func TestAcceptanceCriteriaRepository_GetByIDWithPreloads(t *testing.T) {
 	ac := createTestAcceptanceCriteria(t, db, userStory, user, "AC-001")
 
 	// Get acceptance criteria with preloads
-	retrieved, err := repo.GetByIDWithPreloads(ac.ID)
+	retrieved, err := repo.GetByIDWithPreloads(ctx, ac.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, ac.ID, retrieved.ID)
 	// Verify preloads
@@ -440,6 +457,7 @@ func TestAcceptanceCriteriaRepository_GetByIDWithPreloads(t *testing.T) {
 // TestAcceptanceCriteriaRepository_GetByReferenceIDWithPreloads tests GetByReferenceID with preloads
 // References: AC-757 - With preload enabled, comments/requirements/user_story load efficiently (minimal queries)
 func TestAcceptanceCriteriaRepository_GetByReferenceIDWithPreloads(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -449,7 +467,7 @@ func TestAcceptanceCriteriaRepository_GetByReferenceIDWithPreloads(t *testing.T)
 	ac := createTestAcceptanceCriteria(t, db, userStory, user, "AC-001")
 
 	// Get acceptance criteria with preloads
-	retrieved, err := repo.GetByReferenceIDWithPreloads(ac.ReferenceID)
+	retrieved, err := repo.GetByReferenceIDWithPreloads(ctx, ac.ReferenceID)
 	assert.NoError(t, err)
 	assert.Equal(t, ac.ID, retrieved.ID)
 	// Verify preloads
@@ -460,6 +478,7 @@ func TestAcceptanceCriteriaRepository_GetByReferenceIDWithPreloads(t *testing.T)
 // TestAcceptanceCriteriaRepository_ListWithPreloads_Pagination tests pagination with preloads
 // References: AC-758 - DB interactions in filter/preload tests are mocked/verified; no external dependencies
 func TestAcceptanceCriteriaRepository_ListWithPreloads_Pagination(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -476,17 +495,17 @@ func TestAcceptanceCriteriaRepository_ListWithPreloads_Pagination(t *testing.T)
 	filters := map[string]interface{}{
 		"user_story_id": userStory.ID,
 	}
-	result, err := repo.ListWithPreloads(filters, "created_at ASC", 3, 0)
+	result, err := repo.ListWithPreloads(ctx, filters, "created_at ASC", 3, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 3)
 
 	// Test listing with limit and offset
-	result, err = repo.ListWithPreloads(filters, "created_at ASC", 2, 2)
+	result, err = repo.ListWithPreloads(ctx, filters, "created_at ASC", 2, 2)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test listing all
-	result, err = repo.ListWithPreloads(filters, "created_at ASC", 10, 0)
+	result, err = repo.ListWithPreloads(ctx, filters, "created_at ASC", 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 5)
 }
@@ -494,6 +513,7 @@ func TestAcceptanceCriteriaRepository_ListWithPreloads_Pagination(t *testing.T)
 // TestAcceptanceCriteriaRepository_WithLinkedRequirements tests acceptance criteria with linked requirements
 // References: AC-758 - DB interactions in filter/preload tests are mocked/verified; no external dependencies
 func TestAcceptanceCriteriaRepository_WithLinkedRequirements(t *testing.T) {
+	ctx := context.Background()
 	db := setupAcceptanceCriteriaTestDB(t)
 	repo := NewAcceptanceCriteriaRepository(db)
 
@@ -533,7 +553,7 @@ func TestAcceptanceCriteriaRepository_WithLinkedRequirements(t *testing.T) {
 	require.NoError(t, err)
 
 	// Verify that acceptance criteria has requirements
-	hasReqs, err := repo.HasRequirements(ac.ID)
+	hasReqs, err := repo.HasRequirements(ctx, ac.ID)
 	assert.NoError(t, err)
 	assert.True(t, hasReqs)
 
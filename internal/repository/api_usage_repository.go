@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// APIUsageCount is the number of requests a user made on a day
+type APIUsageCount struct {
+	Requests    int64 // Requests with any credential
+	PATRequests int64 // Requests with personal access tokens
+}
+
+// APIUsageItem is a daily usage counter with the user and token it belongs to
+type APIUsageItem struct {
+	UserID        uuid.UUID
+	Username      string
+	Role          models.UserRole
+	TokenID       uuid.UUID
+	TokenName     *string // Unset for JWT sessions and deleted tokens
+	Requests      int64
+	LastRequestAt time.Time
+}
+
+// apiUsageRepository implements APIUsageRepository interface
+type apiUsageRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIUsageRepository creates a new API usage repository instance
+func NewAPIUsageRepository(db *gorm.DB) APIUsageRepository {
+	return &apiUsageRepository{db: db}
+}
+
+// Increment counts a request of a user with a token on a day, creating the counter on the first request
+func (r *apiUsageRepository) Increment(ctx context.Context, userID, tokenID uuid.UUID, day, at time.Time) error {
+	counter := &models.APIUsageCounter{
+		UserID:        userID,
+		TokenID:       tokenID,
+		Day:           day,
+		Requests:      1,
+		LastRequestAt: at,
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "token_id"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"requests":        gorm.Expr("api_usage_counters.requests + 1"),
+			"last_request_at": at,
+		}),
+	}).Create(counter).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// CountForDay sums the requests a user made on a day
+func (r *apiUsageRepository) CountForDay(ctx context.Context, userID uuid.UUID, day time.Time) (APIUsageCount, error) {
+	var count APIUsageCount
+	err := r.db.WithContext(ctx).Model(&models.APIUsageCounter{}).
+		Select("COALESCE(SUM(requests), 0) AS requests, COALESCE(SUM(CASE WHEN token_id <> ? THEN requests ELSE 0 END), 0) AS pat_requests", uuid.Nil).
+		Where("user_id = ? AND day = ?", userID, day).
+		Scan(&count).Error
+	if err != nil {
+		return APIUsageCount{}, handleDBError(err)
+	}
+	return count, nil
+}
+
+// LockForDay serializes the quota checks of a user on a day until the end of the transaction, so concurrent
+// requests cannot all pass a check before any of them is counted. The counters of a user are spread over
+// tokens and may not exist yet, so an advisory lock stands in for row locks. Databases other than PostgreSQL
+// have no advisory locks and skip it.
+func (r *apiUsageRepository) LockForDay(ctx context.Context, userID uuid.UUID, day time.Time) error {
+	db := r.db.WithContext(ctx)
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+	key := userID.String() + "/" + day.Format("2006-01-02")
+	return handleDBError(db.Exec("SELECT pg_advisory_xact_lock(hashtext('api_usage'), hashtext(?))", key).Error)
+}
+
+// ListByDay retrieves the counters of a day with their users and token names, ordered by user
+func (r *apiUsageRepository) ListByDay(ctx context.Context, day time.Time) ([]APIUsageItem, error) {
+	var items []APIUsageItem
+	err := r.db.WithContext(ctx).Table("api_usage_counters AS c").
+		Select("c.user_id, u.username, u.role, c.token_id, t.name AS token_name, c.requests, c.last_request_at").
+		Joins("JOIN users u ON u.id = c.user_id").
+		Joins("LEFT JOIN personal_access_tokens t ON t.id = c.token_id").
+		Where("c.day = ?", day).
+		Order("u.username ASC, c.requests DESC, c.token_id ASC").
+		Scan(&items).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return items, nil
+}
+
+// apiQuotaRepository implements APIQuotaRepository interface
+type apiQuotaRepository struct {
+	*BaseRepository[models.APIQuota]
+}
+
+// NewAPIQuotaRepository creates a new API quota repository instance
+func NewAPIQuotaRepository(db *gorm.DB) APIQuotaRepository {
+	return &apiQuotaRepository{
+		BaseRepository: NewBaseRepository[models.APIQuota](db),
+	}
+}
+
+// ListAll retrieves role quotas followed by user quotas, with users preloaded
+func (r *apiQuotaRepository) ListAll(ctx context.Context) ([]models.APIQuota, error) {
+	var quotas []models.APIQuota
+	if err := r.GetDB().WithContext(ctx).Preload("User").Order("role IS NULL, role ASC, created_at ASC").Find(&quotas).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return quotas, nil
+}
+
+// GetByRole retrieves the quota of a role
+func (r *apiQuotaRepository) GetByRole(ctx context.Context, role models.UserRole) (*models.APIQuota, error) {
+	var quota models.APIQuota
+	if err := r.GetDB().WithContext(ctx).Where("role = ?", role).First(&quota).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return &quota, nil
+}
+
+// GetByUser retrieves the quota of a user
+func (r *apiQuotaRepository) GetByUser(ctx context.Context, userID uuid.UUID) (*models.APIQuota, error) {
+	var quota models.APIQuota
+	if err := r.GetDB().WithContext(ctx).Where("user_id = ?", userID).First(&quota).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return &quota, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// assigneeSuggestionRepository implements AssigneeSuggestionRepository interface
+type assigneeSuggestionRepository struct {
+	db *gorm.DB
+}
+
+// NewAssigneeSuggestionRepository creates a new assignee suggestion repository instance
+func NewAssigneeSuggestionRepository(db *gorm.DB) AssigneeSuggestionRepository {
+	return &assigneeSuggestionRepository{db: db}
+}
+
+// ListCandidates returns the active users with one of the roles and counts their open assigned items with a single query
+func (r *assigneeSuggestionRepository) ListCandidates(ctx context.Context, roles []models.UserRole) ([]AssigneeCandidate, error) {
+	epicsClosed := []models.EpicStatus{models.EpicStatusDone, models.EpicStatusCancelled}
+	storiesClosed := []models.UserStoryStatus{models.UserStoryStatusDone, models.UserStoryStatusCancelled}
+	requirementsOpen := []models.RequirementStatus{models.RequirementStatusDraft, models.RequirementStatusActive}
+
+	var candidates []AssigneeCandidate
+	err := r.db.WithContext(ctx).Model(&models.User{}).
+		Select("users.id AS user_id, users.username, users.role, "+
+			"(SELECT COUNT(*) FROM epics WHERE epics.assignee_id = users.id AND epics.archived = ? AND epics.status NOT IN ?) + "+
+			"(SELECT COUNT(*) FROM user_stories WHERE user_stories.assignee_id = users.id AND user_stories.archived = ? AND user_stories.status NOT IN ?) + "+
+			"(SELECT COUNT(*) FROM requirements WHERE requirements.assignee_id = users.id AND requirements.status IN ?) AS open_items",
+			false, epicsClosed, false, storiesClosed, requirementsOpen).
+		Where("users.deactivated_at IS NULL AND users.role IN ?", roles).
+		Order("users.username ASC").
+		Scan(&candidates).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return candidates, nil
+}
+
+// CountEpicChanges counts the audited changes each user made since the given time to an epic and to the
+// user stories, acceptance criteria and requirements below it
+func (r *assigneeSuggestionRepository) CountEpicChanges(ctx context.Context, epicID uuid.UUID, since time.Time) (map[uuid.UUID]int64, error) {
+	condition, args := epicEntityCondition(epicID)
+	var rows []struct {
+		ActorID uuid.UUID
+		Changes int64
+	}
+	err := r.db.WithContext(ctx).Model(&models.AuditEvent{}).
+		Select("actor_id, COUNT(*) AS changes").
+		Where(condition, args...).
+		Where("actor_id IS NOT NULL AND created_at >= ?", since).
+		Group("actor_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+
+	changes := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		changes[row.ActorID] = row.Changes
+	}
+	return changes, nil
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// assignmentRuleRepository implements AssignmentRuleRepository interface
+type assignmentRuleRepository struct {
+	*BaseRepository[models.AssignmentRule]
+}
+
+// NewAssignmentRuleRepository creates a new assignment rule repository instance
+func NewAssignmentRuleRepository(db *gorm.DB) AssignmentRuleRepository {
+	return &assignmentRuleRepository{
+		BaseRepository: NewBaseRepository[models.AssignmentRule](db),
+	}
+}
+
+// ListOrdered retrieves the rules in evaluation order, optionally of one entity type, with assignees preloaded.
+// Rules of equal priority are evaluated in the order they were created.
+func (r *assignmentRuleRepository) ListOrdered(ctx context.Context, entityType *models.EntityType) ([]models.AssignmentRule, error) {
+	query := r.GetDB().WithContext(ctx).Preload("Assignee")
+	if entityType != nil {
+		query = query.Where("entity_type = ?", *entityType)
+	}
+
+	var rules []models.AssignmentRule
+	if err := query.Order("priority ASC, created_at ASC, id ASC").Find(&rules).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return rules, nil
+}
@@ -0,0 +1,344 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+const (
+	auditPreviousKey             = "audit:previous"
+	auditDeletedRelationshipsKey = "audit:deleted_relationships"
+)
+
+// RegisterAuditCallbacks registers GORM callbacks that record audit events for creations, status changes,
+// assignments and text edits of epics, user stories, acceptance criteria and requirements, as well as
+// comments and requirement relationships. Events are written on the same connection (and transaction)
+// as the change itself. Updates carry no actor because the acting user is not known at the data layer.
+func RegisterAuditCallbacks(db *gorm.DB) error {
+	callbacks := []struct {
+		name     string
+		register func() error
+	}{
+		{"create", func() error {
+			return db.Callback().Create().After("gorm:create").Register("audit:record_create", recordAuditCreate)
+		}},
+		{"update capture", func() error {
+			return db.Callback().Update().Before("gorm:update").Register("audit:capture_previous", captureAuditPrevious)
+		}},
+		{"update", func() error {
+			return db.Callback().Update().After("gorm:update").Register("audit:record_update", recordAuditUpdate)
+		}},
+		{"delete capture", func() error {
+			return db.Callback().Delete().Before("gorm:delete").Register("audit:capture_deleted", captureDeletedRelationships)
+		}},
+		{"delete", func() error {
+			return db.Callback().Delete().After("gorm:delete").Register("audit:record_delete", recordAuditDelete)
+		}},
+	}
+
+	for _, callback := range callbacks {
+		if err := callback.register(); err != nil {
+			return fmt.Errorf("failed to register %s audit callback: %w", callback.name, err)
+		}
+	}
+	return nil
+}
+
+// auditSnapshot holds the audited fields of an entity
+type auditSnapshot struct {
+	entityType  models.EntityType
+	entityID    uuid.UUID
+	creatorID   uuid.UUID
+	title       string
+	description string
+	status      string
+	assigneeID  *uuid.UUID
+}
+
+// auditSnapshotOf extracts the audited fields from a single entity
+func auditSnapshotOf(dest interface{}) (*auditSnapshot, bool) {
+	var snapshot *auditSnapshot
+	switch entity := dest.(type) {
+	case *models.Epic:
+		snapshot = &auditSnapshot{entityType: models.EntityTypeEpic, entityID: entity.ID, creatorID: entity.CreatorID,
+			title: entity.Title, status: string(entity.Status), assigneeID: &entity.AssigneeID}
+		if entity.Description != nil {
+			snapshot.description = *entity.Description
+		}
+	case *models.UserStory:
+		snapshot = &auditSnapshot{entityType: models.EntityTypeUserStory, entityID: entity.ID, creatorID: entity.CreatorID,
+			title: entity.Title, status: string(entity.Status), assigneeID: &entity.AssigneeID}
+		if entity.Description != nil {
+			snapshot.description = *entity.Description
+		}
+	case *models.AcceptanceCriteria:
+		snapshot = &auditSnapshot{entityType: models.EntityTypeAcceptanceCriteria, entityID: entity.ID, creatorID: entity.AuthorID,
+			description: entity.Description}
+	case *models.Requirement:
+		snapshot = &auditSnapshot{entityType: models.EntityTypeRequirement, entityID: entity.ID, creatorID: entity.CreatorID,
+			title: entity.Title, status: string(entity.Status), assigneeID: &entity.AssigneeID}
+		if entity.Description != nil {
+			snapshot.description = *entity.Description
+		}
+	default:
+		return nil, false
+	}
+
+	if snapshot.entityID == uuid.Nil {
+		return nil, false
+	}
+	return snapshot, true
+}
+
+// loadAuditSnapshot loads the stored state of an entity before it is updated
+func loadAuditSnapshot(tx *gorm.DB, dest interface{}, id uuid.UUID) (*auditSnapshot, error) {
+	var stored interface{}
+	switch dest.(type) {
+	case *models.Epic:
+		stored = &models.Epic{}
+	case *models.UserStory:
+		stored = &models.UserStory{}
+	case *models.AcceptanceCriteria:
+		stored = &models.AcceptanceCriteria{}
+	case *models.Requirement:
+		stored = &models.Requirement{}
+	default:
+		return nil, nil
+	}
+
+	result := tx.Where("id = ?", id).Limit(1).Find(stored)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	snapshot, _ := auditSnapshotOf(stored)
+	return snapshot, nil
+}
+
+// recordAuditCreate records creation of entities, comments and relationships
+func recordAuditCreate(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+
+	var events []models.AuditEvent
+	switch created := db.Statement.Dest.(type) {
+	case *models.Comment:
+		events = append(events, models.AuditEvent{
+			EntityType: created.EntityType,
+			EntityID:   created.EntityID,
+			Action:     models.AuditActionCommented,
+			ActorID:    &created.AuthorID,
+			RelatedID:  &created.ID,
+		})
+	case *models.RequirementRelationship:
+		events = append(events, requirementRelationshipAuditEvents(created, models.AuditActionRelationshipAdded, &created.CreatedBy)...)
+	case *models.EntityRelationship:
+		events = append(events, relationshipAuditEvents(created.SourceType, created.SourceID, created.TargetType, created.TargetID,
+			created.RelationshipTypeID, models.AuditActionRelationshipAdded, &created.CreatedBy)...)
+	default:
+		snapshot, ok := auditSnapshotOf(db.Statement.Dest)
+		if !ok {
+			return
+		}
+		events = append(events, models.AuditEvent{
+			EntityType: snapshot.entityType,
+			EntityID:   snapshot.entityID,
+			Action:     models.AuditActionCreated,
+			ActorID:    &snapshot.creatorID,
+		})
+	}
+
+	writeAuditEvents(db, events)
+}
+
+// captureAuditPrevious stores the entity state before an update so changes can be detected afterwards
+func captureAuditPrevious(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+
+	current, ok := auditSnapshotOf(db.Statement.Dest)
+	if !ok {
+		return
+	}
+
+	previous, err := loadAuditSnapshot(db.Session(&gorm.Session{NewDB: true}), db.Statement.Dest, current.entityID)
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to load entity state for audit: %w", err))
+		return
+	}
+	if previous != nil {
+		db.InstanceSet(auditPreviousKey, previous)
+	}
+}
+
+// recordAuditUpdate records status changes, assignments and text edits
+func recordAuditUpdate(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+
+	value, ok := db.InstanceGet(auditPreviousKey)
+	if !ok {
+		return
+	}
+	previous := value.(*auditSnapshot)
+
+	current, ok := auditSnapshotOf(db.Statement.Dest)
+	if !ok {
+		return
+	}
+
+	var events []models.AuditEvent
+	change := func(action models.AuditAction, field string, oldValue, newValue *string) {
+		events = append(events, models.AuditEvent{
+			EntityType: current.entityType,
+			EntityID:   current.entityID,
+			Action:     action,
+			Field:      field,
+			OldValue:   oldValue,
+			NewValue:   newValue,
+		})
+	}
+
+	if previous.status != current.status {
+		change(models.AuditActionStatusChanged, "status", &previous.status, &current.status)
+	}
+	if previous.assigneeID != nil && current.assigneeID != nil && *previous.assigneeID != *current.assigneeID {
+		oldAssignee, newAssignee := previous.assigneeID.String(), current.assigneeID.String()
+		change(models.AuditActionAssigned, "assignee_id", &oldAssignee, &newAssignee)
+	}
+	if previous.title != current.title {
+		change(models.AuditActionEdited, "title", &previous.title, &current.title)
+	}
+	// Description values are not copied into the audit log; use the version history for the text
+	if previous.description != current.description {
+		change(models.AuditActionEdited, "description", nil, nil)
+	}
+
+	writeAuditEvents(db, events)
+}
+
+// captureDeletedRelationships stores the audit events for the requirement and entity relationships about to be deleted
+func captureDeletedRelationships(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+
+	var targetID uuid.UUID
+	switch target := db.Statement.Model.(type) {
+	case *models.RequirementRelationship:
+		targetID = target.ID
+	case *models.EntityRelationship:
+		targetID = target.ID
+	default:
+		return
+	}
+
+	tx := db.Session(&gorm.Session{NewDB: true})
+	if where, ok := db.Statement.Clauses["WHERE"]; ok {
+		tx = tx.Clauses(where.Expression)
+	} else if targetID != uuid.Nil {
+		tx = tx.Where("id = ?", targetID)
+	} else {
+		return
+	}
+
+	var events []models.AuditEvent
+	switch db.Statement.Model.(type) {
+	case *models.RequirementRelationship:
+		var relationships []models.RequirementRelationship
+		if err := tx.Find(&relationships).Error; err != nil {
+			db.AddError(fmt.Errorf("failed to load relationships for audit: %w", err))
+			return
+		}
+		for i := range relationships {
+			events = append(events, requirementRelationshipAuditEvents(&relationships[i], models.AuditActionRelationshipRemoved, nil)...)
+		}
+	case *models.EntityRelationship:
+		var relationships []models.EntityRelationship
+		if err := tx.Find(&relationships).Error; err != nil {
+			db.AddError(fmt.Errorf("failed to load relationships for audit: %w", err))
+			return
+		}
+		for _, relationship := range relationships {
+			events = append(events, relationshipAuditEvents(relationship.SourceType, relationship.SourceID, relationship.TargetType, relationship.TargetID,
+				relationship.RelationshipTypeID, models.AuditActionRelationshipRemoved, nil)...)
+		}
+	}
+	db.InstanceSet(auditDeletedRelationshipsKey, events)
+}
+
+// recordAuditDelete records removal of requirement and entity relationships
+func recordAuditDelete(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+
+	value, ok := db.InstanceGet(auditDeletedRelationshipsKey)
+	if !ok {
+		return
+	}
+
+	writeAuditEvents(db, value.([]models.AuditEvent))
+}
+
+// requirementRelationshipAuditEvents builds one event for each side of a requirement relationship
+func requirementRelationshipAuditEvents(relationship *models.RequirementRelationship, action models.AuditAction, actorID *uuid.UUID) []models.AuditEvent {
+	return relationshipAuditEvents(models.EntityTypeRequirement, relationship.SourceRequirementID, models.EntityTypeRequirement, relationship.TargetRequirementID,
+		relationship.RelationshipTypeID, action, actorID)
+}
+
+// relationshipAuditEvents builds one event for each side of a relationship
+func relationshipAuditEvents(sourceType models.EntityType, sourceID uuid.UUID, targetType models.EntityType, targetID uuid.UUID,
+	typeID uuid.UUID, action models.AuditAction, actorID *uuid.UUID) []models.AuditEvent {
+	relationshipType := typeID.String()
+	return []models.AuditEvent{
+		{
+			EntityType: sourceType,
+			EntityID:   sourceID,
+			Action:     action,
+			ActorID:    actorID,
+			Field:      "relationship_type_id",
+			NewValue:   &relationshipType,
+			RelatedID:  &targetID,
+		},
+		{
+			EntityType: targetType,
+			EntityID:   targetID,
+			Action:     action,
+			ActorID:    actorID,
+			Field:      "relationship_type_id",
+			NewValue:   &relationshipType,
+			RelatedID:  &sourceID,
+		},
+	}
+}
+
+// writeAuditEvents stores audit events on the statement's connection
+func writeAuditEvents(db *gorm.DB, events []models.AuditEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	repo := NewAuditRepository(statementConnection(db))
+	for i := range events {
+		if err := repo.Create(db.Statement.Context, &events[i]); err != nil {
+			db.AddError(fmt.Errorf("failed to record audit event: %w", err))
+			return
+		}
+	}
+}
+
+// statementConnection returns a fresh database handle on the connection and context of a callback's statement,
+// so repositories can run statements of their own inside the transaction of the statement
+func statementConnection(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true, Initialized: true}).Session(&gorm.Session{NewDB: true})
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func setupAuditTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{}, &models.Requirement{}, &models.Comment{}, &models.AuditEvent{})
+	require.NoError(t, err)
+
+	require.NoError(t, RegisterAuditCallbacks(db))
+	return db
+}
+
+func TestAuditCallbacks_RecordEntityChanges(t *testing.T) {
+	ctx := context.Background()
+	db := setupAuditTestDB(t)
+	epicRepo := NewEpicRepository(db)
+	userRepo := NewUserRepository(db)
+	auditRepo := NewAuditRepository(db)
+
+	epic, creator := createTestEpic(t, epicRepo, userRepo, "Audited", models.EpicStatusBacklog, models.PriorityHigh)
+	other := &models.User{Username: "other", Email: "other@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, userRepo.Create(ctx, other))
+
+	epic.Status = models.EpicStatusInProgress
+	epic.AssigneeID = other.ID
+	require.NoError(t, epicRepo.Update(ctx, epic))
+
+	description := "New description"
+	epic.Description = &description
+	require.NoError(t, epicRepo.Update(ctx, epic))
+
+	comment := &models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: other.ID, Content: "Looks good"}
+	require.NoError(t, db.Create(comment).Error)
+
+	events, err := auditRepo.ListByEntity(ctx, models.EntityTypeEpic, epic.ID, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+
+	actions := make(map[models.AuditAction]models.AuditEvent)
+	for _, event := range events {
+		actions[event.Action] = event
+	}
+
+	created := actions[models.AuditActionCreated]
+	require.NotNil(t, created.ActorID)
+	assert.Equal(t, creator.ID, *created.ActorID)
+	require.NotNil(t, created.Actor)
+
+	statusChanged := actions[models.AuditActionStatusChanged]
+	assert.Equal(t, string(models.EpicStatusBacklog), *statusChanged.OldValue)
+	assert.Equal(t, string(models.EpicStatusInProgress), *statusChanged.NewValue)
+
+	assigned := actions[models.AuditActionAssigned]
+	assert.Equal(t, other.ID.String(), *assigned.NewValue)
+
+	edited := actions[models.AuditActionEdited]
+	assert.Equal(t, "description", edited.Field)
+	assert.Nil(t, edited.NewValue)
+
+	commented := actions[models.AuditActionCommented]
+	assert.Equal(t, comment.ID, *commented.RelatedID)
+
+	// The new assignee sees all events on the epic; the creator only their own creation
+	forOther, err := auditRepo.ListForUser(ctx, other.ID, nil, 0)
+	require.NoError(t, err)
+	assert.Len(t, forOther, 5)
+
+	forCreator, err := auditRepo.ListForUser(ctx, creator.ID, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, forCreator, 1)
+	assert.Equal(t, models.AuditActionCreated, forCreator[0].Action)
+}
+
+func TestAuditRepository_ListByEntity_Cursor(t *testing.T) {
+	ctx := context.Background()
+	db := setupAuditTestDB(t)
+	epicRepo := NewEpicRepository(db)
+	auditRepo := NewAuditRepository(db)
+
+	epic, _ := createTestEpic(t, epicRepo, NewUserRepository(db), "Paged", models.EpicStatusBacklog, models.PriorityHigh)
+	for _, status := range []models.EpicStatus{models.EpicStatusInProgress, models.EpicStatusDone, models.EpicStatusCancelled} {
+		epic.Status = status
+		require.NoError(t, epicRepo.Update(ctx, epic))
+	}
+
+	firstPage, err := auditRepo.ListByEntity(ctx, models.EntityTypeEpic, epic.ID, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, firstPage, 2)
+
+	last := firstPage[len(firstPage)-1]
+	secondPage, err := auditRepo.ListByEntity(ctx, models.EntityTypeEpic, epic.ID, &AuditCursor{CreatedAt: last.CreatedAt, ID: last.ID}, 2)
+	require.NoError(t, err)
+	require.Len(t, secondPage, 2)
+
+	seen := make(map[string]bool)
+	for _, event := range append(firstPage, secondPage...) {
+		assert.False(t, seen[event.ID.String()])
+		seen[event.ID.String()] = true
+	}
+	assert.False(t, secondPage[0].CreatedAt.After(last.CreatedAt))
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// auditRepository implements AuditRepository interface
+type auditRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditRepository creates a new audit repository instance
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+// Create records a new audit event
+func (r *auditRepository) Create(ctx context.Context, event *models.AuditEvent) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// ListByEntity retrieves audit events for an entity, newest first, starting after the cursor
+func (r *auditRepository) ListByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, cursor *AuditCursor, limit int) ([]models.AuditEvent, error) {
+	query := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+	return r.listPage(query, cursor, limit)
+}
+
+// ListForUser retrieves audit events made by the user or on entities assigned to the user,
+// newest first, starting after the cursor
+func (r *auditRepository) ListForUser(ctx context.Context, userID uuid.UUID, cursor *AuditCursor, limit int) ([]models.AuditEvent, error) {
+	condition, args := assignedEntityCondition(userID)
+	query := r.db.WithContext(ctx).Where("actor_id = ? OR "+condition, append([]interface{}{userID}, args...)...)
+	return r.listPage(query, cursor, limit)
+}
+
+// ListAssignedSince retrieves events on entities assigned to the user made by other users after the given time,
+// oldest first
+func (r *auditRepository) ListAssignedSince(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]models.AuditEvent, error) {
+	condition, args := assignedEntityCondition(userID)
+	query := r.db.WithContext(ctx).Where(condition, args...).
+		Where("actor_id IS NULL OR actor_id <> ?", userID).
+		Where("created_at > ?", since)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var events []models.AuditEvent
+	if err := query.Preload("Actor").Order("created_at ASC, id ASC").Find(&events).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return events, nil
+}
+
+// ListRecent retrieves the most recent audit events of all entities, newest first
+func (r *auditRepository) ListRecent(ctx context.Context, limit int) ([]models.AuditEvent, error) {
+	return r.listPage(r.db.WithContext(ctx), nil, limit)
+}
+
+// ListForEpic retrieves the most recent audit events of an epic and of the user stories,
+// acceptance criteria and requirements below it, newest first
+func (r *auditRepository) ListForEpic(ctx context.Context, epicID uuid.UUID, limit int) ([]models.AuditEvent, error) {
+	condition, args := epicEntityCondition(epicID)
+	return r.listPage(r.db.WithContext(ctx).Where(condition, args...), nil, limit)
+}
+
+// CreateToolCall records an MCP tool invocation
+func (r *auditRepository) CreateToolCall(ctx context.Context, call *models.MCPToolCall) error {
+	if err := r.db.WithContext(ctx).Create(call).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// SummarizeToolCalls aggregates MCP tool calls made since the given time per tool, most called first
+func (r *auditRepository) SummarizeToolCalls(ctx context.Context, since time.Time) ([]MCPToolUsage, error) {
+	var usage []MCPToolUsage
+	err := r.db.WithContext(ctx).Model(&models.MCPToolCall{}).
+		Select("tool_name, COUNT(*) AS calls, "+
+			"SUM(CASE WHEN success THEN 0 ELSE 1 END) AS failures, "+
+			"AVG(duration_ms) AS avg_duration_ms, MAX(duration_ms) AS max_duration_ms").
+		Where("created_at >= ?", since).
+		Group("tool_name").
+		Order("calls DESC, tool_name").
+		Scan(&usage).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return usage, nil
+}
+
+// SummarizeToolCallsByUser aggregates MCP tool calls made since the given time per user, most active first
+func (r *auditRepository) SummarizeToolCallsByUser(ctx context.Context, since time.Time, limit int) ([]MCPUserUsage, error) {
+	var usage []MCPUserUsage
+	err := r.db.WithContext(ctx).Table("mcp_tool_calls").
+		Select("users.id AS user_id, users.username AS username, COUNT(*) AS calls, "+
+			"SUM(CASE WHEN mcp_tool_calls.success THEN 0 ELSE 1 END) AS failures").
+		Joins("JOIN users ON users.id = mcp_tool_calls.user_id").
+		Where("mcp_tool_calls.created_at >= ?", since).
+		Group("users.id, users.username").
+		Order("calls DESC, username").
+		Limit(limit).
+		Scan(&usage).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return usage, nil
+}
+
+// ListFailedToolCalls retrieves the most recent failed MCP tool calls made since the given time, newest first
+func (r *auditRepository) ListFailedToolCalls(ctx context.Context, since time.Time, limit int) ([]models.MCPToolCall, error) {
+	query := r.db.WithContext(ctx).Where("success = ? AND created_at >= ?", false, since)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var calls []models.MCPToolCall
+	if err := query.Preload("User").Order("created_at DESC, id DESC").Find(&calls).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return calls, nil
+}
+
+// listPage applies keyset pagination on (created_at, id) and loads the actors
+func (r *auditRepository) listPage(query *gorm.DB, cursor *AuditCursor, limit int) ([]models.AuditEvent, error) {
+	if cursor != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var events []models.AuditEvent
+	if err := query.Preload("Actor").Order("created_at DESC, id DESC").Find(&events).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return events, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *auditRepository) GetDB() *gorm.DB {
+	return r.db
+}
+
+// epicEntityCondition matches audit events and comments of the given epics and of the user stories,
+// acceptance criteria and requirements below them; epics is an epic ID, a list of IDs or a subquery
+func epicEntityCondition(epics interface{}) (string, []interface{}) {
+	condition := "((entity_type = ? AND entity_id IN (?)) OR " +
+		"(entity_type = ? AND entity_id IN (SELECT id FROM user_stories WHERE epic_id IN (?))) OR " +
+		"(entity_type = ? AND entity_id IN (SELECT r.id FROM requirements r JOIN user_stories us ON us.id = r.user_story_id WHERE us.epic_id IN (?))) OR " +
+		"(entity_type = ? AND entity_id IN (SELECT ac.id FROM acceptance_criteria ac JOIN user_stories us ON us.id = ac.user_story_id WHERE us.epic_id IN (?))))"
+	args := []interface{}{
+		models.EntityTypeEpic, epics,
+		models.EntityTypeUserStory, epics,
+		models.EntityTypeRequirement, epics,
+		models.EntityTypeAcceptanceCriteria, epics,
+	}
+	return condition, args
+}
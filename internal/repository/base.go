@@ -1,18 +1,21 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"product-requirements-management/internal/apperrors"
 )
 
 var (
-	ErrNotFound     = errors.New("record not found")
-	ErrInvalidID    = errors.New("invalid ID format")
-	ErrDuplicateKey = errors.New("duplicate key violation")
-	ErrForeignKey   = errors.New("foreign key constraint violation")
+	ErrNotFound     = apperrors.New(apperrors.KindNotFound, apperrors.CodeEntityNotFound, "record not found")
+	ErrInvalidID    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid ID format")
+	ErrDuplicateKey = apperrors.New(apperrors.KindConflict, apperrors.CodeConflict, "duplicate key violation")
+	ErrForeignKey   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "foreign key constraint violation")
 )
 
 // BaseRepository provides common CRUD operations for all entities
@@ -28,17 +31,17 @@ func NewBaseRepository[T any](db *gorm.DB) *BaseRepository[T] {
 }
 
 // Create creates a new entity in the database
-func (r *BaseRepository[T]) Create(entity *T) error {
-	if err := r.db.Create(entity).Error; err != nil {
+func (r *BaseRepository[T]) Create(ctx context.Context, entity *T) error {
+	if err := r.db.WithContext(ctx).Create(entity).Error; err != nil {
 		return r.handleDBError(err)
 	}
 	return nil
 }
 
 // GetByID retrieves an entity by its UUID
-func (r *BaseRepository[T]) GetByID(id uuid.UUID) (*T, error) {
+func (r *BaseRepository[T]) GetByID(ctx context.Context, id uuid.UUID) (*T, error) {
 	var entity T
-	if err := r.db.Where("id = ?", id).First(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&entity).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -48,9 +51,9 @@ func (r *BaseRepository[T]) GetByID(id uuid.UUID) (*T, error) {
 }
 
 // GetByReferenceID retrieves an entity by its reference ID (case-sensitive)
-func (r *BaseRepository[T]) GetByReferenceID(referenceID string) (*T, error) {
+func (r *BaseRepository[T]) GetByReferenceID(ctx context.Context, referenceID string) (*T, error) {
 	var entity T
-	if err := r.db.Where("reference_id = ?", referenceID).First(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("reference_id = ?", referenceID).First(&entity).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -60,16 +63,16 @@ func (r *BaseRepository[T]) GetByReferenceID(referenceID string) (*T, error) {
 }
 
 // GetByReferenceIDCaseInsensitive retrieves an entity by its reference ID (case-insensitive)
-func (r *BaseRepository[T]) GetByReferenceIDCaseInsensitive(referenceID string) (*T, error) {
+func (r *BaseRepository[T]) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*T, error) {
 	var entity T
 
 	// Use ILIKE for PostgreSQL, LOWER() LIKE for SQLite compatibility
 	var err error
-	if r.db.Dialector.Name() == "postgres" {
-		err = r.db.Where("reference_id ILIKE ?", referenceID).First(&entity).Error
+	if r.db.WithContext(ctx).Dialector.Name() == "postgres" {
+		err = r.db.WithContext(ctx).Where("reference_id ILIKE ?", referenceID).First(&entity).Error
 	} else {
 		// SQLite and other databases - use LOWER() LIKE for case-insensitive matching
-		err = r.db.Where("LOWER(reference_id) LIKE LOWER(?)", referenceID).First(&entity).Error
+		err = r.db.WithContext(ctx).Where("LOWER(reference_id) LIKE LOWER(?)", referenceID).First(&entity).Error
 	}
 
 	if err != nil {
@@ -82,26 +85,26 @@ func (r *BaseRepository[T]) GetByReferenceIDCaseInsensitive(referenceID string)
 }
 
 // Update updates an existing entity in the database
-func (r *BaseRepository[T]) Update(entity *T) error {
-	if err := r.db.Save(entity).Error; err != nil {
+func (r *BaseRepository[T]) Update(ctx context.Context, entity *T) error {
+	if err := r.db.WithContext(ctx).Save(entity).Error; err != nil {
 		return r.handleDBError(err)
 	}
 	return nil
 }
 
 // Delete deletes an entity by its UUID
-func (r *BaseRepository[T]) Delete(id uuid.UUID) error {
+func (r *BaseRepository[T]) Delete(ctx context.Context, id uuid.UUID) error {
 	var entity T
-	if err := r.db.Where("id = ?", id).Delete(&entity).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&entity).Error; err != nil {
 		return r.handleDBError(err)
 	}
 	return nil
 }
 
 // List retrieves entities with optional filtering, sorting, and pagination
-func (r *BaseRepository[T]) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]T, error) {
+func (r *BaseRepository[T]) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]T, error) {
 	var entities []T
-	query := r.db.Model(new(T))
+	query := r.db.WithContext(ctx).Model(new(T))
 
 	// Apply filters
 	for field, value := range filters {
@@ -129,9 +132,9 @@ func (r *BaseRepository[T]) List(filters map[string]interface{}, orderBy string,
 }
 
 // Count returns the total number of entities matching the given filters
-func (r *BaseRepository[T]) Count(filters map[string]interface{}) (int64, error) {
+func (r *BaseRepository[T]) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	var count int64
-	query := r.db.Model(new(T))
+	query := r.db.WithContext(ctx).Model(new(T))
 
 	// Apply filters
 	for field, value := range filters {
@@ -146,18 +149,18 @@ func (r *BaseRepository[T]) Count(filters map[string]interface{}) (int64, error)
 }
 
 // Exists checks if an entity exists by its UUID
-func (r *BaseRepository[T]) Exists(id uuid.UUID) (bool, error) {
+func (r *BaseRepository[T]) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.db.Model(new(T)).Where("id = ?", id).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(new(T)).Where("id = ?", id).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
 
 // ExistsByReferenceID checks if an entity exists by its reference ID
-func (r *BaseRepository[T]) ExistsByReferenceID(referenceID string) (bool, error) {
+func (r *BaseRepository[T]) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	var count int64
-	if err := r.db.Model(new(T)).Where("reference_id = ?", referenceID).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(new(T)).Where("reference_id = ?", referenceID).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
@@ -183,8 +186,8 @@ func (r *BaseRepository[T]) handleDBError(err error) error {
 }
 
 // WithTransaction executes a function within a database transaction
-func (r *BaseRepository[T]) WithTransaction(fn func(*gorm.DB) error) error {
-	return r.db.Transaction(fn)
+func (r *BaseRepository[T]) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
+	return r.db.WithContext(ctx).Transaction(fn)
 }
 
 // GetDB returns the underlying database connection
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -39,6 +40,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 }
 
 func TestBaseRepository_Create(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -47,12 +49,13 @@ func TestBaseRepository_Create(t *testing.T) {
 		Name:        "Test Entity",
 	}
 
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	assert.NoError(t, err)
 	assert.NotEqual(t, uuid.Nil, entity.ID)
 }
 
 func TestBaseRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -61,28 +64,30 @@ func TestBaseRepository_GetByID(t *testing.T) {
 		ReferenceID: "TEST-001",
 		Name:        "Test Entity",
 	}
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	require.NoError(t, err)
 
 	// Get by ID
-	retrieved, err := repo.GetByID(entity.ID)
+	retrieved, err := repo.GetByID(ctx, entity.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, entity.ID, retrieved.ID)
 	assert.Equal(t, entity.Name, retrieved.Name)
 }
 
 func TestBaseRepository_GetByID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
 	nonExistentID := uuid.New()
-	retrieved, err := repo.GetByID(nonExistentID)
+	retrieved, err := repo.GetByID(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestBaseRepository_GetByReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -91,27 +96,29 @@ func TestBaseRepository_GetByReferenceID(t *testing.T) {
 		ReferenceID: "TEST-001",
 		Name:        "Test Entity",
 	}
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	require.NoError(t, err)
 
 	// Get by reference ID
-	retrieved, err := repo.GetByReferenceID("TEST-001")
+	retrieved, err := repo.GetByReferenceID(ctx, "TEST-001")
 	assert.NoError(t, err)
 	assert.Equal(t, entity.ID, retrieved.ID)
 	assert.Equal(t, entity.Name, retrieved.Name)
 }
 
 func TestBaseRepository_GetByReferenceID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
-	retrieved, err := repo.GetByReferenceID("NON-EXISTENT")
+	retrieved, err := repo.GetByReferenceID(ctx, "NON-EXISTENT")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestBaseRepository_GetByReferenceIDCaseInsensitive(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -120,39 +127,41 @@ func TestBaseRepository_GetByReferenceIDCaseInsensitive(t *testing.T) {
 		ReferenceID: "TEST-001",
 		Name:        "Test Entity",
 	}
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	require.NoError(t, err)
 
 	// Test case-insensitive retrieval with lowercase
-	retrieved, err := repo.GetByReferenceIDCaseInsensitive("test-001")
+	retrieved, err := repo.GetByReferenceIDCaseInsensitive(ctx, "test-001")
 	assert.NoError(t, err)
 	assert.Equal(t, entity.ID, retrieved.ID)
 	assert.Equal(t, entity.Name, retrieved.Name)
 
 	// Test case-insensitive retrieval with uppercase
-	retrieved, err = repo.GetByReferenceIDCaseInsensitive("TEST-001")
+	retrieved, err = repo.GetByReferenceIDCaseInsensitive(ctx, "TEST-001")
 	assert.NoError(t, err)
 	assert.Equal(t, entity.ID, retrieved.ID)
 	assert.Equal(t, entity.Name, retrieved.Name)
 
 	// Test case-insensitive retrieval with mixed case
-	retrieved, err = repo.GetByReferenceIDCaseInsensitive("Test-001")
+	retrieved, err = repo.GetByReferenceIDCaseInsensitive(ctx, "Test-001")
 	assert.NoError(t, err)
 	assert.Equal(t, entity.ID, retrieved.ID)
 	assert.Equal(t, entity.Name, retrieved.Name)
 }
 
 func TestBaseRepository_GetByReferenceIDCaseInsensitive_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
-	retrieved, err := repo.GetByReferenceIDCaseInsensitive("non-existent")
+	retrieved, err := repo.GetByReferenceIDCaseInsensitive(ctx, "non-existent")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestBaseRepository_Update(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -161,21 +170,22 @@ func TestBaseRepository_Update(t *testing.T) {
 		ReferenceID: "TEST-001",
 		Name:        "Test Entity",
 	}
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	require.NoError(t, err)
 
 	// Update entity
 	entity.Name = "Updated Entity"
-	err = repo.Update(entity)
+	err = repo.Update(ctx, entity)
 	assert.NoError(t, err)
 
 	// Verify update
-	retrieved, err := repo.GetByID(entity.ID)
+	retrieved, err := repo.GetByID(ctx, entity.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, "Updated Entity", retrieved.Name)
 }
 
 func TestBaseRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -184,21 +194,22 @@ func TestBaseRepository_Delete(t *testing.T) {
 		ReferenceID: "TEST-001",
 		Name:        "Test Entity",
 	}
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	require.NoError(t, err)
 
 	// Delete entity
-	err = repo.Delete(entity.ID)
+	err = repo.Delete(ctx, entity.ID)
 	assert.NoError(t, err)
 
 	// Verify deletion
-	retrieved, err := repo.GetByID(entity.ID)
+	retrieved, err := repo.GetByID(ctx, entity.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestBaseRepository_List(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -210,34 +221,35 @@ func TestBaseRepository_List(t *testing.T) {
 	}
 
 	for _, entity := range entities {
-		err := repo.Create(entity)
+		err := repo.Create(ctx, entity)
 		require.NoError(t, err)
 	}
 
 	// List all entities
-	retrieved, err := repo.List(nil, "", 0, 0)
+	retrieved, err := repo.List(ctx, nil, "", 0, 0)
 	assert.NoError(t, err)
 	assert.Len(t, retrieved, 3)
 
 	// List with limit
-	retrieved, err = repo.List(nil, "", 2, 0)
+	retrieved, err = repo.List(ctx, nil, "", 2, 0)
 	assert.NoError(t, err)
 	assert.Len(t, retrieved, 2)
 
 	// List with offset
-	retrieved, err = repo.List(nil, "", 0, 1)
+	retrieved, err = repo.List(ctx, nil, "", 0, 1)
 	assert.NoError(t, err)
 	assert.Len(t, retrieved, 2)
 
 	// List with filter
 	filters := map[string]interface{}{"name": "Entity 1"}
-	retrieved, err = repo.List(filters, "", 0, 0)
+	retrieved, err = repo.List(ctx, filters, "", 0, 0)
 	assert.NoError(t, err)
 	assert.Len(t, retrieved, 1)
 	assert.Equal(t, "Entity 1", retrieved[0].Name)
 }
 
 func TestBaseRepository_Count(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -249,23 +261,24 @@ func TestBaseRepository_Count(t *testing.T) {
 	}
 
 	for _, entity := range entities {
-		err := repo.Create(entity)
+		err := repo.Create(ctx, entity)
 		require.NoError(t, err)
 	}
 
 	// Count all entities
-	count, err := repo.Count(nil)
+	count, err := repo.Count(ctx, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(3), count)
 
 	// Count with filter
 	filters := map[string]interface{}{"name": "Entity 1"}
-	count, err = repo.Count(filters)
+	count, err = repo.Count(ctx, filters)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(2), count)
 }
 
 func TestBaseRepository_Exists(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -274,22 +287,23 @@ func TestBaseRepository_Exists(t *testing.T) {
 		ReferenceID: "TEST-001",
 		Name:        "Test Entity",
 	}
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	require.NoError(t, err)
 
 	// Check existence
-	exists, err := repo.Exists(entity.ID)
+	exists, err := repo.Exists(ctx, entity.ID)
 	assert.NoError(t, err)
 	assert.True(t, exists)
 
 	// Check non-existence
 	nonExistentID := uuid.New()
-	exists, err = repo.Exists(nonExistentID)
+	exists, err = repo.Exists(ctx, nonExistentID)
 	assert.NoError(t, err)
 	assert.False(t, exists)
 }
 
 func TestBaseRepository_ExistsByReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
@@ -298,26 +312,27 @@ func TestBaseRepository_ExistsByReferenceID(t *testing.T) {
 		ReferenceID: "TEST-001",
 		Name:        "Test Entity",
 	}
-	err := repo.Create(entity)
+	err := repo.Create(ctx, entity)
 	require.NoError(t, err)
 
 	// Check existence
-	exists, err := repo.ExistsByReferenceID("TEST-001")
+	exists, err := repo.ExistsByReferenceID(ctx, "TEST-001")
 	assert.NoError(t, err)
 	assert.True(t, exists)
 
 	// Check non-existence
-	exists, err = repo.ExistsByReferenceID("NON-EXISTENT")
+	exists, err = repo.ExistsByReferenceID(ctx, "NON-EXISTENT")
 	assert.NoError(t, err)
 	assert.False(t, exists)
 }
 
 func TestBaseRepository_WithTransaction(t *testing.T) {
+	ctx := context.Background()
 	db := setupTestDB(t)
 	repo := NewBaseRepository[TestEntity](db)
 
 	// Test successful transaction
-	err := repo.WithTransaction(func(tx *gorm.DB) error {
+	err := repo.WithTransaction(ctx, func(tx *gorm.DB) error {
 		entity := &TestEntity{
 			ReferenceID: "TEST-001",
 			Name:        "Test Entity",
@@ -327,7 +342,7 @@ func TestBaseRepository_WithTransaction(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Verify entity was created
-	count, err := repo.Count(nil)
+	count, err := repo.Count(ctx, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), count)
 }
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// boardRankRepository implements BoardRankRepository interface
+type boardRankRepository struct {
+	db *gorm.DB
+}
+
+// NewBoardRankRepository creates a new board rank repository instance
+func NewBoardRankRepository(db *gorm.DB) BoardRankRepository {
+	return &boardRankRepository{db: db}
+}
+
+// ListByEntityType retrieves the ranks of all cards of an entity type
+func (r *boardRankRepository) ListByEntityType(ctx context.Context, entityType models.EntityType) ([]models.BoardRank, error) {
+	var ranks []models.BoardRank
+	if err := r.db.WithContext(ctx).Where("entity_type = ?", entityType).Find(&ranks).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return ranks, nil
+}
+
+// SaveAll creates or updates the given ranks in a single transaction
+func (r *boardRankRepository) SaveAll(ctx context.Context, ranks []models.BoardRank) error {
+	if len(ranks) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range ranks {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"rank", "updated_at"}),
+			}).Create(&ranks[i]).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetDB returns the underlying database connection
+func (r *boardRankRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// calendarFeedRepository implements CalendarFeedRepository interface
+type calendarFeedRepository struct {
+	db *gorm.DB
+}
+
+// NewCalendarFeedRepository creates a new calendar feed repository instance
+func NewCalendarFeedRepository(db *gorm.DB) CalendarFeedRepository {
+	return &calendarFeedRepository{db: db}
+}
+
+// Create stores a new calendar feed
+func (r *calendarFeedRepository) Create(ctx context.Context, feed *models.CalendarFeed) error {
+	if err := r.db.WithContext(ctx).Create(feed).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetByTokenHash retrieves the feed matching a token hash
+func (r *calendarFeedRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&feed).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &feed, nil
+}
+
+// ListByUser retrieves all feeds of a user, newest first
+func (r *calendarFeedRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.CalendarFeed, error) {
+	var feeds []models.CalendarFeed
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC, id").Find(&feeds).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return feeds, nil
+}
+
+// DeleteForUser revokes a feed owned by a user
+func (r *calendarFeedRepository) DeleteForUser(ctx context.Context, id, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&models.CalendarFeed{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// UpdateLastUsed records when a feed was last fetched
+func (r *calendarFeedRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&models.CalendarFeed{}).Where("id = ?", id).Update("last_used_at", usedAt).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// ListDueEpics retrieves the epics matching the filters that have a due date, in due date order
+func (r *calendarFeedRepository) ListDueEpics(ctx context.Context, filters map[string]interface{}) ([]models.Epic, error) {
+	var epics []models.Epic
+	err := r.db.WithContext(ctx).Where(filters).Where("due_date IS NOT NULL").Order("due_date, reference_id").Find(&epics).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return epics, nil
+}
+
+// ListDueUserStories retrieves the user stories matching the filters that have a due date, in due date order
+func (r *calendarFeedRepository) ListDueUserStories(ctx context.Context, filters map[string]interface{}) ([]models.UserStory, error) {
+	var userStories []models.UserStory
+	err := r.db.WithContext(ctx).Where(filters).Where("due_date IS NOT NULL").Order("due_date, reference_id").Find(&userStories).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return userStories, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *calendarFeedRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// changeFeedRepository implements ChangeFeedRepository interface
+type changeFeedRepository struct {
+	db *gorm.DB
+}
+
+// NewChangeFeedRepository creates a new change feed repository instance
+func NewChangeFeedRepository(db *gorm.DB) ChangeFeedRepository {
+	return &changeFeedRepository{db: db}
+}
+
+// Create stores a new change feed
+func (r *changeFeedRepository) Create(ctx context.Context, feed *models.ChangeFeed) error {
+	if err := r.db.WithContext(ctx).Create(feed).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetByTokenHash retrieves the feed matching a token hash
+func (r *changeFeedRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.ChangeFeed, error) {
+	var feed models.ChangeFeed
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&feed).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &feed, nil
+}
+
+// ListByUser retrieves all feeds of a user, newest first
+func (r *changeFeedRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.ChangeFeed, error) {
+	var feeds []models.ChangeFeed
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC, id").Find(&feeds).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return feeds, nil
+}
+
+// DeleteForUser revokes a feed owned by a user
+func (r *changeFeedRepository) DeleteForUser(ctx context.Context, id, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&models.ChangeFeed{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// UpdateLastUsed records when a feed was last fetched
+func (r *changeFeedRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	if err := r.db.WithContext(ctx).Model(&models.ChangeFeed{}).Where("id = ?", id).Update("last_used_at", usedAt).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetDB returns the underlying database connection
+func (r *changeFeedRepository) GetDB() *gorm.DB {
+	return r.db
+}
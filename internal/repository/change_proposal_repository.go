@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// changeProposalRepository implements ChangeProposalRepository interface
+type changeProposalRepository struct {
+	*BaseRepository[models.ChangeProposal]
+}
+
+// NewChangeProposalRepository creates a new change proposal repository instance
+func NewChangeProposalRepository(db *gorm.DB) ChangeProposalRepository {
+	return &changeProposalRepository{
+		BaseRepository: NewBaseRepository[models.ChangeProposal](db),
+	}
+}
+
+// ListByEntity retrieves the proposals of an entity, optionally with a single status, oldest first,
+// with proposers and reviewers preloaded
+func (r *changeProposalRepository) ListByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, status *models.ChangeProposalStatus) ([]models.ChangeProposal, error) {
+	query := r.GetDB().WithContext(ctx).Preload("Proposer").Preload("Reviewer").
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var proposals []models.ChangeProposal
+	if err := query.Order("created_at ASC, id ASC").Find(&proposals).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return proposals, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// commentDraftRepository implements CommentDraftRepository interface
+type commentDraftRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentDraftRepository creates a new comment draft repository instance
+func NewCommentDraftRepository(db *gorm.DB) CommentDraftRepository {
+	return &commentDraftRepository{db: db}
+}
+
+// Get retrieves a user's draft for an entity or one of its comment threads, including expired drafts not cleaned up yet
+func (r *commentDraftRepository) Get(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID, parentCommentID *uuid.UUID) (*models.CommentDraft, error) {
+	var draft models.CommentDraft
+	if err := r.threadScope(ctx, entityType, entityID, userID, parentCommentID).First(&draft).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &draft, nil
+}
+
+// Create creates a new draft
+func (r *commentDraftRepository) Create(ctx context.Context, draft *models.CommentDraft) error {
+	if err := r.db.WithContext(ctx).Create(draft).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// Update updates an existing draft
+func (r *commentDraftRepository) Update(ctx context.Context, draft *models.CommentDraft) error {
+	if err := r.db.WithContext(ctx).Save(draft).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// Delete removes a user's draft for an entity or one of its comment threads
+func (r *commentDraftRepository) Delete(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID, parentCommentID *uuid.UUID) error {
+	result := r.threadScope(ctx, entityType, entityID, userID, parentCommentID).Delete(&models.CommentDraft{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListByUser retrieves the unexpired drafts of a user, most recently saved first
+func (r *commentDraftRepository) ListByUser(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.CommentDraft, error) {
+	var drafts []models.CommentDraft
+	err := r.db.WithContext(ctx).Where("user_id = ? AND expires_at > ?", userID, now).
+		Order("updated_at DESC, id").
+		Find(&drafts).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return drafts, nil
+}
+
+// DeleteExpired removes the drafts that expired and returns how many were removed
+func (r *commentDraftRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&models.CommentDraft{})
+	if result.Error != nil {
+		return 0, handleDBError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// threadScope selects the draft of a user for an entity, or for a comment thread of it when parentCommentID is set
+func (r *commentDraftRepository) threadScope(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID, parentCommentID *uuid.UUID) *gorm.DB {
+	query := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND user_id = ?", entityType, entityID, userID)
+	if parentCommentID == nil {
+		return query.Where("parent_comment_id IS NULL")
+	}
+	return query.Where("parent_comment_id = ?", *parentCommentID)
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// commentModerationRepository implements CommentModerationRepository interface
+type commentModerationRepository struct {
+	*BaseRepository[models.CommentModeration]
+}
+
+// NewCommentModerationRepository creates a new comment moderation repository instance
+func NewCommentModerationRepository(db *gorm.DB) CommentModerationRepository {
+	return &commentModerationRepository{
+		BaseRepository: NewBaseRepository[models.CommentModeration](db),
+	}
+}
+
+// ListWithFilters retrieves moderation records, oldest first so the queue is worked in order, with authors
+// and reviewers preloaded
+func (r *commentModerationRepository) ListWithFilters(ctx context.Context, filters CommentModerationFilters) ([]models.CommentModeration, int64, error) {
+	query := r.GetDB().WithContext(ctx).Model(&models.CommentModeration{})
+	if len(filters.Statuses) > 0 {
+		query = query.Where("status IN ?", filters.Statuses)
+	}
+	if filters.AuthorID != nil {
+		query = query.Where("author_id = ?", *filters.AuthorID)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	var records []models.CommentModeration
+	if err := query.Preload("Author").Preload("Reviewer").Order("created_at ASC, id ASC").Find(&records).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+	return records, totalCount, nil
+}
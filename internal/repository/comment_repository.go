@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -20,9 +21,9 @@ func NewCommentRepository(db *gorm.DB) CommentRepository {
 }
 
 // GetByEntity retrieves comments by entity type and ID
-func (r *commentRepository) GetByEntity(entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
+func (r *commentRepository) GetByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
 	var comments []models.Comment
-	if err := r.GetDB().Preload("Author").Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Where("entity_type = ? AND entity_id = ?", entityType, entityID).
 		Order("created_at ASC").Find(&comments).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
@@ -30,9 +31,9 @@ func (r *commentRepository) GetByEntity(entityType models.EntityType, entityID u
 }
 
 // GetByAuthor retrieves comments by author ID
-func (r *commentRepository) GetByAuthor(authorID uuid.UUID) ([]models.Comment, error) {
+func (r *commentRepository) GetByAuthor(ctx context.Context, authorID uuid.UUID) ([]models.Comment, error) {
 	var comments []models.Comment
-	if err := r.GetDB().Preload("Author").Where("author_id = ?", authorID).
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Where("author_id = ?", authorID).
 		Order("created_at DESC").Find(&comments).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
@@ -40,9 +41,9 @@ func (r *commentRepository) GetByAuthor(authorID uuid.UUID) ([]models.Comment, e
 }
 
 // GetByParent retrieves replies to a parent comment
-func (r *commentRepository) GetByParent(parentID uuid.UUID) ([]models.Comment, error) {
+func (r *commentRepository) GetByParent(ctx context.Context, parentID uuid.UUID) ([]models.Comment, error) {
 	var comments []models.Comment
-	if err := r.GetDB().Preload("Author").Where("parent_comment_id = ?", parentID).
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Where("parent_comment_id = ?", parentID).
 		Order("created_at ASC").Find(&comments).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
@@ -50,17 +51,17 @@ func (r *commentRepository) GetByParent(parentID uuid.UUID) ([]models.Comment, e
 }
 
 // GetByParentWithPagination retrieves replies to a parent comment with pagination
-func (r *commentRepository) GetByParentWithPagination(parentID uuid.UUID, limit, offset int) ([]models.Comment, int64, error) {
+func (r *commentRepository) GetByParentWithPagination(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]models.Comment, int64, error) {
 	var comments []models.Comment
 	var totalCount int64
 
 	// Get total count
-	if err := r.GetDB().Model(&models.Comment{}).Where("parent_comment_id = ?", parentID).Count(&totalCount).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.Comment{}).Where("parent_comment_id = ?", parentID).Count(&totalCount).Error; err != nil {
 		return nil, 0, r.handleDBError(err)
 	}
 
 	// Get paginated results
-	if err := r.GetDB().Preload("Author").Where("parent_comment_id = ?", parentID).
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Where("parent_comment_id = ?", parentID).
 		Order("created_at ASC").Limit(limit).Offset(offset).Find(&comments).Error; err != nil {
 		return nil, 0, r.handleDBError(err)
 	}
@@ -69,9 +70,9 @@ func (r *commentRepository) GetByParentWithPagination(parentID uuid.UUID, limit,
 }
 
 // GetThreaded retrieves comments in threaded format for an entity
-func (r *commentRepository) GetThreaded(entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
+func (r *commentRepository) GetThreaded(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
 	var comments []models.Comment
-	if err := r.GetDB().Preload("Author").Preload("Replies").Preload("Replies.Author").
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Preload("Replies").Preload("Replies.Author").
 		Where("entity_type = ? AND entity_id = ? AND parent_comment_id IS NULL", entityType, entityID).
 		Order("created_at ASC").Find(&comments).Error; err != nil {
 		return nil, r.handleDBError(err)
@@ -80,9 +81,9 @@ func (r *commentRepository) GetThreaded(entityType models.EntityType, entityID u
 }
 
 // GetByStatus retrieves comments by resolution status
-func (r *commentRepository) GetByStatus(isResolved bool) ([]models.Comment, error) {
+func (r *commentRepository) GetByStatus(ctx context.Context, isResolved bool) ([]models.Comment, error) {
 	var comments []models.Comment
-	if err := r.GetDB().Preload("Author").Where("is_resolved = ?", isResolved).
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Where("is_resolved = ?", isResolved).
 		Order("created_at DESC").Find(&comments).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
@@ -90,9 +91,9 @@ func (r *commentRepository) GetByStatus(isResolved bool) ([]models.Comment, erro
 }
 
 // GetInlineComments retrieves inline comments for an entity
-func (r *commentRepository) GetInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
+func (r *commentRepository) GetInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
 	var comments []models.Comment
-	if err := r.GetDB().Preload("Author").Where("entity_type = ? AND entity_id = ? AND linked_text IS NOT NULL",
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Where("entity_type = ? AND entity_id = ? AND linked_text IS NOT NULL",
 		entityType, entityID).Order("text_position_start ASC").Find(&comments).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
@@ -100,10 +101,120 @@ func (r *commentRepository) GetInlineComments(entityType models.EntityType, enti
 }
 
 // GetByID retrieves a comment by ID with author information
-func (r *commentRepository) GetByID(id uuid.UUID) (*models.Comment, error) {
+func (r *commentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Comment, error) {
 	var comment models.Comment
-	if err := r.GetDB().Preload("Author").Where("id = ?", id).First(&comment).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("Author").Where("id = ?", id).First(&comment).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return &comment, nil
 }
+
+// ListWithFilters retrieves comments across entities with optional filtering, newest first
+func (r *commentRepository) ListWithFilters(ctx context.Context, filters CommentFilters) ([]models.Comment, int64, error) {
+	var comments []models.Comment
+	var totalCount int64
+
+	query := r.GetDB().WithContext(ctx).Model(&models.Comment{})
+
+	// Apply filters
+	if filters.EntityType != nil {
+		query = query.Where("entity_type = ?", *filters.EntityType)
+	}
+	if filters.Category != nil {
+		query = query.Where("category = ?", *filters.Category)
+	}
+	if filters.IsResolved != nil {
+		query = query.Where("is_resolved = ?", *filters.IsResolved)
+	}
+	if filters.AuthorID != nil {
+		query = query.Where("author_id = ?", *filters.AuthorID)
+	}
+	if filters.EntityAssigneeID != nil {
+		condition, args := assignedEntityCondition(*filters.EntityAssigneeID)
+		query = query.Where(condition, args...)
+	}
+
+	// Count total records
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+
+	// Apply pagination
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+
+	if err := query.Preload("Author").Order("created_at DESC").Find(&comments).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+
+	return comments, totalCount, nil
+}
+
+// CountByCategory returns the number of comments per category for an entity
+// Uncategorized comments are not counted
+func (r *commentRepository) CountByCategory(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (map[models.CommentCategory]int64, error) {
+	var rows []struct {
+		Category models.CommentCategory
+		Count    int64
+	}
+	if err := r.GetDB().WithContext(ctx).Model(&models.Comment{}).
+		Select("category, COUNT(*) AS count").
+		Where("entity_type = ? AND entity_id = ? AND category IS NOT NULL", entityType, entityID).
+		Group("category").
+		Scan(&rows).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+
+	counts := make(map[models.CommentCategory]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Category] = row.Count
+	}
+	return counts, nil
+}
+
+// CountByEntities returns the total and unresolved comment counts of the given entities of a type in a
+// single grouped query. Entities without comments are left out.
+func (r *commentRepository) CountByEntities(ctx context.Context, entityType models.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]CommentCounts, error) {
+	counts := make(map[uuid.UUID]CommentCounts, len(entityIDs))
+	if len(entityIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		EntityID   uuid.UUID
+		Total      int64
+		Unresolved int64
+	}
+	if err := r.GetDB().WithContext(ctx).Model(&models.Comment{}).
+		Select("entity_id, COUNT(*) AS total, SUM(CASE WHEN is_resolved = ? THEN 1 ELSE 0 END) AS unresolved", false).
+		Where("entity_type = ? AND entity_id IN ?", entityType, entityIDs).
+		Group("entity_id").
+		Scan(&rows).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+
+	for _, row := range rows {
+		counts[row.EntityID] = CommentCounts{Total: row.Total, Unresolved: row.Unresolved}
+	}
+	return counts, nil
+}
+
+// assignedEntityCondition builds a condition matching rows whose (entity_type, entity_id) refer to
+// an entity assigned to the user. Acceptance criteria match through the assignee of their user story.
+func assignedEntityCondition(assigneeID uuid.UUID) (string, []interface{}) {
+	condition := "((entity_type = ? AND entity_id IN (SELECT id FROM epics WHERE assignee_id = ?)) OR " +
+		"(entity_type = ? AND entity_id IN (SELECT id FROM user_stories WHERE assignee_id = ?)) OR " +
+		"(entity_type = ? AND entity_id IN (SELECT id FROM requirements WHERE assignee_id = ?)) OR " +
+		"(entity_type = ? AND entity_id IN (SELECT ac.id FROM acceptance_criteria ac JOIN user_stories us ON us.id = ac.user_story_id WHERE us.assignee_id = ?)))"
+	args := []interface{}{
+		models.EntityTypeEpic, assigneeID,
+		models.EntityTypeUserStory, assigneeID,
+		models.EntityTypeRequirement, assigneeID,
+		models.EntityTypeAcceptanceCriteria, assigneeID,
+	}
+	return condition, args
+}
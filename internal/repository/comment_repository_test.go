@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func setupCommentTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{}, &models.Requirement{}, &models.Comment{})
+	require.NoError(t, err)
+
+	return db
+}
+
+func createTestCategorizedComment(t *testing.T, repo CommentRepository, author *models.User, entityType models.EntityType, entityID uuid.UUID, category *models.CommentCategory, resolved bool) {
+	ctx := context.Background()
+	comment := &models.Comment{
+		EntityType: entityType,
+		EntityID:   entityID,
+		AuthorID:   author.ID,
+		Content:    "Comment",
+		IsResolved: resolved,
+		Category:   category,
+	}
+	require.NoError(t, repo.Create(ctx, comment))
+}
+
+func TestCommentRepository_Categories(t *testing.T) {
+	ctx := context.Background()
+	db := setupCommentTestDB(t)
+	repo := NewCommentRepository(db)
+	author := createTestUserForPresence(t, db, "commenter")
+
+	blocker := models.CommentCategoryBlocker
+	question := models.CommentCategoryQuestion
+	requirementID := uuid.New()
+	epicID := uuid.New()
+
+	createTestCategorizedComment(t, repo, author, models.EntityTypeRequirement, requirementID, &blocker, false)
+	createTestCategorizedComment(t, repo, author, models.EntityTypeRequirement, requirementID, &blocker, true)
+	createTestCategorizedComment(t, repo, author, models.EntityTypeRequirement, requirementID, &question, false)
+	createTestCategorizedComment(t, repo, author, models.EntityTypeRequirement, requirementID, nil, false)
+	createTestCategorizedComment(t, repo, author, models.EntityTypeEpic, epicID, &blocker, false)
+
+	t.Run("count by category ignores uncategorized comments", func(t *testing.T) {
+		counts, err := repo.CountByCategory(ctx, models.EntityTypeRequirement, requirementID)
+		require.NoError(t, err)
+		assert.Equal(t, map[models.CommentCategory]int64{blocker: 2, question: 1}, counts)
+	})
+
+	t.Run("count by entities groups total and unresolved comments", func(t *testing.T) {
+		counts, err := repo.CountByEntities(ctx, models.EntityTypeRequirement, []uuid.UUID{requirementID, epicID, uuid.New()})
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]CommentCounts{requirementID: {Total: 4, Unresolved: 3}}, counts)
+
+		counts, err = repo.CountByEntities(ctx, models.EntityTypeEpic, nil)
+		require.NoError(t, err)
+		assert.Empty(t, counts)
+	})
+
+	t.Run("list unresolved blockers across entities", func(t *testing.T) {
+		unresolved := false
+		comments, total, err := repo.ListWithFilters(ctx, CommentFilters{Category: &blocker, IsResolved: &unresolved})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, comments, 2)
+		assert.Equal(t, "commenter", comments[0].Author.Username)
+	})
+
+	t.Run("list with entity type filter and pagination", func(t *testing.T) {
+		entityType := models.EntityTypeRequirement
+		comments, total, err := repo.ListWithFilters(ctx, CommentFilters{EntityType: &entityType, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		assert.Len(t, comments, 2)
+	})
+}
+
+func TestCommentRepository_ListWithFilters_Inbox(t *testing.T) {
+	ctx := context.Background()
+	db := setupCommentTestDB(t)
+	repo := NewCommentRepository(db)
+
+	reviewer := createUserStoryTestUser(t, db, "reviewer")
+	other := createUserStoryTestUser(t, db, "other")
+	epic := createUserStoryTestEpic(t, db, other, "EP-001")
+	assignedStory := createUserStoryTestUserStory(t, db, epic, other, reviewer, "US-001")
+	otherStory := createUserStoryTestUserStory(t, db, epic, other, other, "US-002")
+	criteria := createTestAcceptanceCriteria(t, db, assignedStory, other, "AC-001")
+
+	createTestCategorizedComment(t, repo, other, models.EntityTypeUserStory, assignedStory.ID, nil, false)
+	createTestCategorizedComment(t, repo, other, models.EntityTypeUserStory, assignedStory.ID, nil, true)
+	createTestCategorizedComment(t, repo, other, models.EntityTypeAcceptanceCriteria, criteria.ID, nil, false)
+	createTestCategorizedComment(t, repo, reviewer, models.EntityTypeUserStory, otherStory.ID, nil, false)
+	createTestCategorizedComment(t, repo, reviewer, models.EntityTypeEpic, epic.ID, nil, false)
+
+	t.Run("unresolved comments on entities assigned to user", func(t *testing.T) {
+		unresolved := false
+		comments, total, err := repo.ListWithFilters(ctx, CommentFilters{EntityAssigneeID: &reviewer.ID, IsResolved: &unresolved})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		for _, comment := range comments {
+			assert.Contains(t, []uuid.UUID{assignedStory.ID, criteria.ID}, comment.EntityID)
+		}
+	})
+
+	t.Run("comments by author", func(t *testing.T) {
+		_, total, err := repo.ListWithFilters(ctx, CommentFilters{AuthorID: &reviewer.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+}
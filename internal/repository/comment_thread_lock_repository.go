@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// commentThreadLockRepository implements CommentThreadLockRepository interface
+type commentThreadLockRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentThreadLockRepository creates a new comment thread lock repository instance
+func NewCommentThreadLockRepository(db *gorm.DB) CommentThreadLockRepository {
+	return &commentThreadLockRepository{db: db}
+}
+
+// GetByEntity returns the lock on the comment thread of an entity with the user who locked it
+func (r *commentThreadLockRepository) GetByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.CommentThreadLock, error) {
+	var lock models.CommentThreadLock
+	err := r.db.WithContext(ctx).Preload("Locker").
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		First(&lock).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &lock, nil
+}
+
+// Upsert locks the comment thread of an entity, replacing the reason and locker of an existing lock
+func (r *commentThreadLockRepository) Upsert(ctx context.Context, lock *models.CommentThreadLock) error {
+	err := r.db.WithContext(ctx).Omit("Locker").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "locked_by", "locked_at"}),
+	}).Create(lock).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// DeleteByEntity unlocks the comment thread of an entity; returns ErrNotFound if it is not locked
+func (r *commentThreadLockRepository) DeleteByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Delete(&models.CommentThreadLock{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// obsoleteDependencyType is the relationship type whose links to obsolete requirements are reported
+const obsoleteDependencyType = "depends_on"
+
+// coverageRepository implements CoverageRepository interface
+type coverageRepository struct {
+	db *gorm.DB
+}
+
+// NewCoverageRepository creates a new coverage repository instance
+func NewCoverageRepository(db *gorm.DB) CoverageRepository {
+	return &coverageRepository{db: db}
+}
+
+// ListUserStoryCoverage retrieves the user stories of an epic with their acceptance criteria and active requirement counts
+func (r *coverageRepository) ListUserStoryCoverage(ctx context.Context, epicID uuid.UUID) ([]CoverageItem, error) {
+	var items []CoverageItem
+	err := r.db.WithContext(ctx).Table("user_stories").
+		Select("user_stories.id, user_stories.reference_id, user_stories.title, user_stories.status, "+
+			"(SELECT COUNT(*) FROM acceptance_criteria WHERE acceptance_criteria.user_story_id = user_stories.id) AS acceptance_criteria_count, "+
+			"(SELECT COUNT(*) FROM requirements WHERE requirements.user_story_id = user_stories.id AND requirements.status <> ?) AS requirement_count",
+			models.RequirementStatusObsolete).
+		Where("user_stories.epic_id = ?", epicID).
+		Order("user_stories.reference_id ASC").
+		Scan(&items).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return items, nil
+}
+
+// ListAcceptanceCriteriaCoverage retrieves the acceptance criteria of an epic with the number of active requirements linked to them.
+// Acceptance criteria have no title; their description is used instead.
+func (r *coverageRepository) ListAcceptanceCriteriaCoverage(ctx context.Context, epicID uuid.UUID) ([]CoverageItem, error) {
+	var items []CoverageItem
+	err := r.db.WithContext(ctx).Table("acceptance_criteria").
+		Select("acceptance_criteria.id, acceptance_criteria.reference_id, acceptance_criteria.description AS title, "+
+			"(SELECT COUNT(*) FROM requirement_acceptance_criteria JOIN requirements ON requirements.id = requirement_acceptance_criteria.requirement_id "+
+			"WHERE requirement_acceptance_criteria.acceptance_criteria_id = acceptance_criteria.id AND requirements.status <> ?) AS requirement_count",
+			models.RequirementStatusObsolete).
+		Joins("JOIN user_stories ON user_stories.id = acceptance_criteria.user_story_id").
+		Where("user_stories.epic_id = ?", epicID).
+		Order("acceptance_criteria.reference_id ASC").
+		Scan(&items).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return items, nil
+}
+
+// ListRequirementCoverage retrieves the requirements of an epic with the number of acceptance criteria they are linked to
+func (r *coverageRepository) ListRequirementCoverage(ctx context.Context, epicID uuid.UUID) ([]CoverageItem, error) {
+	var items []CoverageItem
+	err := r.db.WithContext(ctx).Table("requirements").
+		Select("requirements.id, requirements.reference_id, requirements.title, requirements.status, "+
+			"(SELECT COUNT(*) FROM requirement_acceptance_criteria WHERE requirement_acceptance_criteria.requirement_id = requirements.id) AS acceptance_criteria_count").
+		Joins("JOIN user_stories ON user_stories.id = requirements.user_story_id").
+		Where("user_stories.epic_id = ?", epicID).
+		Order("requirements.reference_id ASC").
+		Scan(&items).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return items, nil
+}
+
+// ListObsoleteDependencies retrieves the depends_on relationships from the epic, its user stories,
+// acceptance criteria and active requirements to obsolete requirements. Requirement relationships
+// and entity relationships are both covered.
+func (r *coverageRepository) ListObsoleteDependencies(ctx context.Context, epicID uuid.UUID) ([]ObsoleteDependency, error) {
+	var requirementDependencies []ObsoleteDependency
+	err := r.db.WithContext(ctx).Table("requirement_relationships").
+		Select("requirement_relationships.id AS relationship_id, ? AS source_type, "+
+			"requirement_relationships.source_requirement_id AS source_id, targets.id AS target_id, "+
+			"targets.superseded_by_id AS target_superseded_by_id", models.EntityTypeRequirement).
+		Joins("JOIN relationship_types ON relationship_types.id = requirement_relationships.relationship_type_id").
+		Joins("JOIN requirements AS sources ON sources.id = requirement_relationships.source_requirement_id").
+		Joins("JOIN user_stories ON user_stories.id = sources.user_story_id").
+		Joins("JOIN requirements AS targets ON targets.id = requirement_relationships.target_requirement_id").
+		Where("relationship_types.name = ? AND user_stories.epic_id = ?", obsoleteDependencyType, epicID).
+		Where("sources.status <> ? AND targets.status = ?", models.RequirementStatusObsolete, models.RequirementStatusObsolete).
+		Order("requirement_relationships.created_at ASC").
+		Scan(&requirementDependencies).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+
+	userStories := r.db.WithContext(ctx).Table("user_stories").Select("id").Where("epic_id = ?", epicID)
+	acceptanceCriteria := r.db.WithContext(ctx).Table("acceptance_criteria").Select("acceptance_criteria.id").
+		Joins("JOIN user_stories ON user_stories.id = acceptance_criteria.user_story_id").
+		Where("user_stories.epic_id = ?", epicID)
+	requirements := r.db.WithContext(ctx).Table("requirements").Select("requirements.id").
+		Joins("JOIN user_stories ON user_stories.id = requirements.user_story_id").
+		Where("user_stories.epic_id = ? AND requirements.status <> ?", epicID, models.RequirementStatusObsolete)
+
+	var entityDependencies []ObsoleteDependency
+	err = r.db.WithContext(ctx).Table("entity_relationships").
+		Select("entity_relationships.id AS relationship_id, entity_relationships.source_type, "+
+			"entity_relationships.source_id, targets.id AS target_id, targets.superseded_by_id AS target_superseded_by_id").
+		Joins("JOIN relationship_types ON relationship_types.id = entity_relationships.relationship_type_id").
+		Joins("JOIN requirements AS targets ON targets.id = entity_relationships.target_id").
+		Where("relationship_types.name = ? AND entity_relationships.target_type = ? AND targets.status = ?",
+			obsoleteDependencyType, models.EntityTypeRequirement, models.RequirementStatusObsolete).
+		Where(r.db.WithContext(ctx).Where("entity_relationships.source_type = ? AND entity_relationships.source_id = ?", models.EntityTypeEpic, epicID).
+			Or("entity_relationships.source_type = ? AND entity_relationships.source_id IN (?)", models.EntityTypeUserStory, userStories).
+			Or("entity_relationships.source_type = ? AND entity_relationships.source_id IN (?)", models.EntityTypeAcceptanceCriteria, acceptanceCriteria).
+			Or("entity_relationships.source_type = ? AND entity_relationships.source_id IN (?)", models.EntityTypeRequirement, requirements)).
+		Order("entity_relationships.created_at ASC").
+		Scan(&entityDependencies).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+
+	return append(requirementDependencies, entityDependencies...), nil
+}
+
+// GetDB returns the underlying database connection
+func (r *coverageRepository) GetDB() *gorm.DB {
+	return r.db
+}
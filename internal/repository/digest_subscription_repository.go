@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// digestSubscriptionRepository implements DigestSubscriptionRepository interface
+type digestSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewDigestSubscriptionRepository creates a new digest subscription repository instance
+func NewDigestSubscriptionRepository(db *gorm.DB) DigestSubscriptionRepository {
+	return &digestSubscriptionRepository{db: db}
+}
+
+// GetByUserID retrieves the digest preferences of a user
+func (r *digestSubscriptionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.DigestSubscription, error) {
+	var subscription models.DigestSubscription
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&subscription).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &subscription, nil
+}
+
+// GetByUnsubscribeToken retrieves the digest preferences matching an unsubscribe token
+func (r *digestSubscriptionRepository) GetByUnsubscribeToken(ctx context.Context, token string) (*models.DigestSubscription, error) {
+	var subscription models.DigestSubscription
+	if err := r.db.WithContext(ctx).Where("unsubscribe_token = ?", token).First(&subscription).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &subscription, nil
+}
+
+// Save creates or updates the digest preferences of a user
+func (r *digestSubscriptionRepository) Save(ctx context.Context, subscription *models.DigestSubscription) error {
+	if err := r.db.WithContext(ctx).Save(subscription).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// ListActive retrieves all subscriptions with digests enabled, with their users loaded
+func (r *digestSubscriptionRepository) ListActive(ctx context.Context) ([]models.DigestSubscription, error) {
+	var subscriptions []models.DigestSubscription
+	err := r.db.WithContext(ctx).Preload("User").
+		Where("frequency <> ?", models.DigestFrequencyNone).
+		Order("user_id").
+		Find(&subscriptions).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return subscriptions, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *digestSubscriptionRepository) GetDB() *gorm.DB {
+	return r.db
+}
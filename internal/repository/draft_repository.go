@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// draftRepository implements DraftRepository interface
+type draftRepository struct {
+	db *gorm.DB
+}
+
+// NewDraftRepository creates a new draft repository instance
+func NewDraftRepository(db *gorm.DB) DraftRepository {
+	return &draftRepository{db: db}
+}
+
+// Get retrieves a user's draft for an entity
+func (r *draftRepository) Get(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) (*models.EntityDraft, error) {
+	var draft models.EntityDraft
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND user_id = ?", entityType, entityID, userID).
+		First(&draft).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &draft, nil
+}
+
+// Create creates a new draft
+func (r *draftRepository) Create(ctx context.Context, draft *models.EntityDraft) error {
+	if err := r.db.WithContext(ctx).Create(draft).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// Update updates an existing draft
+func (r *draftRepository) Update(ctx context.Context, draft *models.EntityDraft) error {
+	if err := r.db.WithContext(ctx).Save(draft).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// Delete removes a user's draft for an entity
+func (r *draftRepository) Delete(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND user_id = ?", entityType, entityID, userID).
+		Delete(&models.EntityDraft{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListByUser retrieves all drafts owned by a user, most recently saved first
+func (r *draftRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.EntityDraft, error) {
+	var drafts []models.EntityDraft
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("updated_at DESC").Find(&drafts).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return drafts, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *draftRepository) GetDB() *gorm.DB {
+	return r.db
+}
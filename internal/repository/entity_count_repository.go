@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// countQuery is a correlated subquery counting related rows of the entity row it is selected for
+type countQuery struct {
+	name string
+	sql  string
+	args []interface{}
+}
+
+// entityCountTables maps entity types to the tables their counts are selected from
+var entityCountTables = map[EntityType]string{
+	models.EntityTypeEpic:               "epics",
+	models.EntityTypeUserStory:          "user_stories",
+	models.EntityTypeAcceptanceCriteria: "acceptance_criteria",
+	models.EntityTypeRequirement:        "requirements",
+}
+
+// commentCountQueries returns the comment counts of an entity type
+func commentCountQueries(entityType EntityType, table string) []countQuery {
+	return []countQuery{
+		{CountComments, "SELECT COUNT(*) FROM comments WHERE comments.entity_type = ? AND comments.entity_id = " + table + ".id", []interface{}{entityType}},
+		{CountUnresolvedComments, "SELECT COUNT(*) FROM comments WHERE comments.entity_type = ? AND comments.entity_id = " + table + ".id AND comments.is_resolved = ?", []interface{}{entityType, false}},
+	}
+}
+
+// entityCountQueries lists the counts available per entity type
+var entityCountQueries = map[EntityType][]countQuery{
+	models.EntityTypeEpic: append(commentCountQueries(models.EntityTypeEpic, "epics"),
+		countQuery{CountUserStories, "SELECT COUNT(*) FROM user_stories WHERE user_stories.epic_id = epics.id", nil},
+	),
+	models.EntityTypeUserStory: append(commentCountQueries(models.EntityTypeUserStory, "user_stories"),
+		countQuery{CountAcceptanceCriteria, "SELECT COUNT(*) FROM acceptance_criteria WHERE acceptance_criteria.user_story_id = user_stories.id", nil},
+		countQuery{CountRequirements, "SELECT COUNT(*) FROM requirements WHERE requirements.user_story_id = user_stories.id", nil},
+	),
+	models.EntityTypeAcceptanceCriteria: append(commentCountQueries(models.EntityTypeAcceptanceCriteria, "acceptance_criteria"),
+		countQuery{CountRequirements, "SELECT COUNT(*) FROM requirement_acceptance_criteria WHERE requirement_acceptance_criteria.acceptance_criteria_id = acceptance_criteria.id", nil},
+	),
+	models.EntityTypeRequirement: append(commentCountQueries(models.EntityTypeRequirement, "requirements"),
+		countQuery{CountAcceptanceCriteria, "SELECT COUNT(*) FROM requirement_acceptance_criteria WHERE requirement_acceptance_criteria.requirement_id = requirements.id", nil},
+		countQuery{CountRelationships, "SELECT COUNT(*) FROM requirement_relationships WHERE requirement_relationships.source_requirement_id = requirements.id " +
+			"OR requirement_relationships.target_requirement_id = requirements.id", nil},
+	),
+}
+
+// entityCountRepository implements EntityCountRepository interface
+type entityCountRepository struct {
+	db *gorm.DB
+}
+
+// NewEntityCountRepository creates a new entity count repository instance
+func NewEntityCountRepository(db *gorm.DB) EntityCountRepository {
+	return &entityCountRepository{db: db}
+}
+
+// SupportedCounts returns the names of the counts available for an entity type
+func (r *entityCountRepository) SupportedCounts(ctx context.Context, entityType EntityType) []string {
+	queries := entityCountQueries[entityType]
+	names := make([]string, len(queries))
+	for i, query := range queries {
+		names[i] = query.name
+	}
+	return names
+}
+
+// CountAggregates computes the requested counts of the given entities in a single query.
+// Counts not supported for the entity type are ignored.
+func (r *entityCountRepository) CountAggregates(ctx context.Context, entityType EntityType, ids []uuid.UUID, counts []string) (map[uuid.UUID]map[string]int64, error) {
+	table, ok := entityCountTables[entityType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported entity type %q", entityType)
+	}
+
+	requested := make(map[string]bool, len(counts))
+	for _, name := range counts {
+		requested[name] = true
+	}
+	columns := []string{table + ".id"}
+	var names []string
+	var args []interface{}
+	for _, query := range entityCountQueries[entityType] {
+		if requested[query.name] {
+			columns = append(columns, "("+query.sql+") AS "+query.name)
+			names = append(names, query.name)
+			args = append(args, query.args...)
+		}
+	}
+
+	result := make(map[uuid.UUID]map[string]int64, len(ids))
+	if len(ids) == 0 || len(names) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.db.WithContext(ctx).Table(table).
+		Select(strings.Join(columns, ", "), args...).
+		Where(table+".id IN ?", ids).
+		Rows()
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uuid.UUID
+		values := make([]int64, len(names))
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, handleDBError(err)
+		}
+
+		entityCounts := make(map[string]int64, len(names))
+		for i, name := range names {
+			entityCounts[name] = values[i]
+		}
+		result[id] = entityCounts
+	}
+	if err := rows.Err(); err != nil {
+		return nil, handleDBError(err)
+	}
+	return result, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *entityCountRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// entityRelationshipRepository implements EntityRelationshipRepository interface
+type entityRelationshipRepository struct {
+	db *gorm.DB
+}
+
+// NewEntityRelationshipRepository creates a new entity relationship repository instance
+func NewEntityRelationshipRepository(db *gorm.DB) EntityRelationshipRepository {
+	return &entityRelationshipRepository{db: db}
+}
+
+// Create links two entities
+func (r *entityRelationshipRepository) Create(ctx context.Context, relationship *models.EntityRelationship) error {
+	if err := r.db.WithContext(ctx).Create(relationship).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetByID retrieves a link with its relationship type
+func (r *entityRelationshipRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.EntityRelationship, error) {
+	var relationship models.EntityRelationship
+	if err := r.db.WithContext(ctx).Preload("RelationshipType").Where("id = ?", id).First(&relationship).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &relationship, nil
+}
+
+// Delete removes a link
+func (r *entityRelationshipRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.EntityRelationship{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Exists checks whether two entities are already linked with a relationship type
+func (r *entityRelationshipRepository) Exists(ctx context.Context, sourceType models.EntityType, sourceID uuid.UUID, targetType models.EntityType, targetID uuid.UUID, typeID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.EntityRelationship{}).
+		Where("source_type = ? AND source_id = ? AND target_type = ? AND target_id = ? AND relationship_type_id = ?",
+			sourceType, sourceID, targetType, targetID, typeID).
+		Count(&count).Error
+	if err != nil {
+		return false, handleDBError(err)
+	}
+	return count > 0, nil
+}
+
+// ListByEntity retrieves the links from and to an entity with their relationship types, oldest first
+func (r *entityRelationshipRepository) ListByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.EntityRelationship, error) {
+	var relationships []models.EntityRelationship
+	err := r.db.WithContext(ctx).Preload("RelationshipType").
+		Where("(source_type = ? AND source_id = ?) OR (target_type = ? AND target_id = ?)", entityType, entityID, entityType, entityID).
+		Order("created_at, id").
+		Find(&relationships).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return relationships, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *entityRelationshipRepository) GetDB() *gorm.DB {
+	return r.db
+}
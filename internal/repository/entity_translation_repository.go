@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// translatedTables describes the table of each translatable entity type: whether it has a title to
+// translate and whether it can be archived
+var translatedTables = map[models.EntityType]struct {
+	table      string
+	titled     bool
+	archivable bool
+}{
+	models.EntityTypeEpic:               {table: "epics", titled: true, archivable: true},
+	models.EntityTypeUserStory:          {table: "user_stories", titled: true, archivable: true},
+	models.EntityTypeAcceptanceCriteria: {table: "acceptance_criteria"},
+	models.EntityTypeRequirement:        {table: "requirements", titled: true},
+}
+
+// entityTranslationRepository implements EntityTranslationRepository interface
+type entityTranslationRepository struct {
+	db *gorm.DB
+}
+
+// NewEntityTranslationRepository creates a new entity translation repository instance
+func NewEntityTranslationRepository(db *gorm.DB) EntityTranslationRepository {
+	return &entityTranslationRepository{db: db}
+}
+
+// Get retrieves the translation of an entity into a locale
+func (r *entityTranslationRepository) Get(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, locale string) (*models.EntityTranslation, error) {
+	var translation models.EntityTranslation
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND locale = ?", entityType, entityID, locale).
+		First(&translation).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return &translation, nil
+}
+
+// ListByEntity retrieves the translations of an entity ordered by locale
+func (r *entityTranslationRepository) ListByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.EntityTranslation, error) {
+	var translations []models.EntityTranslation
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("locale ASC").Find(&translations).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return translations, nil
+}
+
+// ListByEntities retrieves the translations of the given entities into any of the given locales
+func (r *entityTranslationRepository) ListByEntities(ctx context.Context, entityType models.EntityType, entityIDs []uuid.UUID, locales []string) ([]models.EntityTranslation, error) {
+	var translations []models.EntityTranslation
+	if len(entityIDs) == 0 || len(locales) == 0 {
+		return translations, nil
+	}
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id IN ? AND locale IN ?", entityType, entityIDs, locales).
+		Find(&translations).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return translations, nil
+}
+
+// ListLocales returns the locales with at least one translation in alphabetical order
+func (r *entityTranslationRepository) ListLocales(ctx context.Context) ([]string, error) {
+	var locales []string
+	err := r.db.WithContext(ctx).Model(&models.EntityTranslation{}).Distinct("locale").Order("locale ASC").Pluck("locale", &locales).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return locales, nil
+}
+
+// CountCompleteness counts the entities of a type by how far they are translated into a locale. An entity
+// is translated when the translation has a title, if the entity type has one, and a description whenever
+// the entity has one.
+func (r *entityTranslationRepository) CountCompleteness(ctx context.Context, entityType models.EntityType, locale string) (*TranslationCounts, error) {
+	source, ok := translatedTables[entityType]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	complete := "t.description IS NOT NULL AND t.description <> ''"
+	if source.titled {
+		complete = "t.title IS NOT NULL AND t.title <> '' AND (e.description IS NULL OR e.description = '' OR (" + complete + "))"
+	}
+	query := r.db.WithContext(ctx).Table(source.table+" AS e").
+		Select("COUNT(*) AS total, COUNT(t.id) AS with_translation, "+
+			"COALESCE(SUM(CASE WHEN "+complete+" THEN 1 ELSE 0 END), 0) AS translated").
+		Joins("LEFT JOIN entity_translations t ON t.entity_type = ? AND t.entity_id = e.id AND t.locale = ?", entityType, locale)
+	if source.archivable {
+		query = query.Where("e.archived = ?", false)
+	}
+
+	var row struct {
+		Total           int64
+		WithTranslation int64
+		Translated      int64
+	}
+	if err := query.Scan(&row).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &TranslationCounts{Total: row.Total, Translated: row.Translated, Partial: row.WithTranslation - row.Translated}, nil
+}
+
+// Create creates a new translation
+func (r *entityTranslationRepository) Create(ctx context.Context, translation *models.EntityTranslation) error {
+	return handleDBError(r.db.WithContext(ctx).Create(translation).Error)
+}
+
+// Update updates an existing translation
+func (r *entityTranslationRepository) Update(ctx context.Context, translation *models.EntityTranslation) error {
+	return handleDBError(r.db.WithContext(ctx).Save(translation).Error)
+}
+
+// Delete removes the translation of an entity into a locale
+func (r *entityTranslationRepository) Delete(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, locale string) error {
+	result := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND locale = ?", entityType, entityID, locale).
+		Delete(&models.EntityTranslation{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// RegisterVersioningCallbacks registers GORM callbacks that record an entity version whenever
+// the title or description of an epic, user story, acceptance criteria or requirement is saved.
+// Versions are written on the same connection (and transaction) as the entity itself.
+func RegisterVersioningCallbacks(db *gorm.DB) error {
+	if err := db.Callback().Create().After("gorm:create").Register("versioning:record_create", recordEntityVersion); err != nil {
+		return fmt.Errorf("failed to register create versioning callback: %w", err)
+	}
+	if err := db.Callback().Update().After("gorm:update").Register("versioning:record_update", recordEntityVersion); err != nil {
+		return fmt.Errorf("failed to register update versioning callback: %w", err)
+	}
+	return nil
+}
+
+// recordEntityVersion appends a new version if the saved entity's text differs from its latest version
+func recordEntityVersion(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+
+	snapshot, ok := entityVersionSnapshot(db.Statement.Dest)
+	if !ok {
+		return
+	}
+
+	ctx := db.Statement.Context
+	repo := NewEntityVersionRepository(statementConnection(db))
+	latest, err := repo.GetLatest(ctx, snapshot.EntityType, snapshot.EntityID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		db.AddError(fmt.Errorf("failed to get latest entity version: %w", err))
+		return
+	}
+
+	if latest != nil {
+		if latest.Title == snapshot.Title && latest.Description == snapshot.Description {
+			return
+		}
+		snapshot.VersionNumber = latest.VersionNumber + 1
+	} else {
+		snapshot.VersionNumber = 1
+	}
+
+	if err := repo.Create(ctx, snapshot); err != nil {
+		db.AddError(fmt.Errorf("failed to record entity version: %w", err))
+	}
+}
+
+// entityVersionSnapshot builds a version from a single saved entity
+// Partial updates (maps, column updates) and batch operations are not versioned
+func entityVersionSnapshot(dest interface{}) (*models.EntityVersion, bool) {
+	var (
+		entityType  models.EntityType
+		entityID    uuid.UUID
+		title       string
+		description *string
+	)
+
+	switch entity := dest.(type) {
+	case *models.Epic:
+		entityType, entityID, title, description = models.EntityTypeEpic, entity.ID, entity.Title, entity.Description
+	case *models.UserStory:
+		entityType, entityID, title, description = models.EntityTypeUserStory, entity.ID, entity.Title, entity.Description
+	case *models.AcceptanceCriteria:
+		entityType, entityID, description = models.EntityTypeAcceptanceCriteria, entity.ID, &entity.Description
+	case *models.Requirement:
+		entityType, entityID, title, description = models.EntityTypeRequirement, entity.ID, entity.Title, entity.Description
+	default:
+		return nil, false
+	}
+
+	if entityID == uuid.Nil {
+		return nil, false
+	}
+
+	version := &models.EntityVersion{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Title:      title,
+	}
+	if description != nil {
+		version.Description = *description
+	}
+	return version, true
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func setupEntityVersionTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&models.User{}, &models.Epic{}, &models.EntityVersion{})
+	require.NoError(t, err)
+
+	require.NoError(t, RegisterVersioningCallbacks(db))
+	return db
+}
+
+func TestVersioningCallbacks_RecordTextChanges(t *testing.T) {
+	ctx := context.Background()
+	db := setupEntityVersionTestDB(t)
+	epicRepo := NewEpicRepository(db)
+	versionRepo := NewEntityVersionRepository(db)
+
+	epic, _ := createTestEpic(t, epicRepo, NewUserRepository(db), "Versioned", models.EpicStatusBacklog, models.PriorityHigh)
+
+	latest, err := versionRepo.GetLatest(ctx, models.EntityTypeEpic, epic.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, latest.VersionNumber)
+	assert.Equal(t, "Versioned", latest.Title)
+	assert.Empty(t, latest.Description)
+
+	description := "First description"
+	epic.Description = &description
+	require.NoError(t, epicRepo.Update(ctx, epic))
+
+	// Saving without a text change does not record a version
+	epic.Status = models.EpicStatusInProgress
+	require.NoError(t, epicRepo.Update(ctx, epic))
+
+	versions, err := versionRepo.ListByEntity(ctx, models.EntityTypeEpic, epic.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[1].VersionNumber)
+	assert.Equal(t, "First description", versions[1].Description)
+
+	second, err := versionRepo.GetByNumber(ctx, models.EntityTypeEpic, epic.ID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, versions[1].ID, second.ID)
+
+	_, err = versionRepo.GetByNumber(ctx, models.EntityTypeEpic, epic.ID, 3)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestVersioningCallbacks_RolledBackWithTransaction(t *testing.T) {
+	ctx := context.Background()
+	db := setupEntityVersionTestDB(t)
+	epicRepo := NewEpicRepository(db)
+	versionRepo := NewEntityVersionRepository(db)
+
+	epic, _ := createTestEpic(t, epicRepo, NewUserRepository(db), "Rollback", models.EpicStatusBacklog, models.PriorityHigh)
+
+	errAbort := errors.New("abort")
+	err := db.Transaction(func(tx *gorm.DB) error {
+		description := "Discarded description"
+		epic.Description = &description
+		if err := NewEpicRepository(tx).Update(ctx, epic); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	versions, err := versionRepo.ListByEntity(ctx, models.EntityTypeEpic, epic.ID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+}
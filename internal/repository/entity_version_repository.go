@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// entityVersionRepository implements EntityVersionRepository interface
+type entityVersionRepository struct {
+	db *gorm.DB
+}
+
+// NewEntityVersionRepository creates a new entity version repository instance
+func NewEntityVersionRepository(db *gorm.DB) EntityVersionRepository {
+	return &entityVersionRepository{db: db}
+}
+
+// Create creates a new entity version
+func (r *entityVersionRepository) Create(ctx context.Context, version *models.EntityVersion) error {
+	if err := r.db.WithContext(ctx).Create(version).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetLatest retrieves the most recent version of an entity
+func (r *entityVersionRepository) GetLatest(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.EntityVersion, error) {
+	var version models.EntityVersion
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("version_number DESC").
+		First(&version).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &version, nil
+}
+
+// GetByNumber retrieves a specific version of an entity
+func (r *entityVersionRepository) GetByNumber(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, versionNumber int) (*models.EntityVersion, error) {
+	var version models.EntityVersion
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND version_number = ?", entityType, entityID, versionNumber).
+		First(&version).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &version, nil
+}
+
+// ListByEntity retrieves all versions of an entity, oldest first
+func (r *entityVersionRepository) ListByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.EntityVersion, error) {
+	var versions []models.EntityVersion
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("version_number ASC").
+		Find(&versions).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return versions, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *entityVersionRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"product-requirements-management/internal/models"
 )
@@ -22,9 +24,9 @@ func NewEpicRepository(db *gorm.DB) EpicRepository {
 }
 
 // GetWithUserStories retrieves an epic with its user stories
-func (r *epicRepository) GetWithUserStories(id uuid.UUID) (*models.Epic, error) {
+func (r *epicRepository) GetWithUserStories(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	var epic models.Epic
-	if err := r.GetDB().Preload("UserStories").Where("id = ?", id).First(&epic).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("UserStories").Where("id = ?", id).First(&epic).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -34,54 +36,54 @@ func (r *epicRepository) GetWithUserStories(id uuid.UUID) (*models.Epic, error)
 }
 
 // GetByCreator retrieves epics by creator ID
-func (r *epicRepository) GetByCreator(creatorID uuid.UUID) ([]models.Epic, error) {
+func (r *epicRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.Epic, error) {
 	var epics []models.Epic
-	if err := r.GetDB().Where("creator_id = ?", creatorID).Find(&epics).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("creator_id = ?", creatorID).Find(&epics).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return epics, nil
 }
 
 // GetByAssignee retrieves epics by assignee ID
-func (r *epicRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.Epic, error) {
+func (r *epicRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.Epic, error) {
 	var epics []models.Epic
-	if err := r.GetDB().Where("assignee_id = ?", assigneeID).Find(&epics).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("assignee_id = ?", assigneeID).Find(&epics).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return epics, nil
 }
 
 // GetByStatus retrieves epics by status
-func (r *epicRepository) GetByStatus(status models.EpicStatus) ([]models.Epic, error) {
+func (r *epicRepository) GetByStatus(ctx context.Context, status models.EpicStatus) ([]models.Epic, error) {
 	var epics []models.Epic
-	if err := r.GetDB().Where("status = ?", status).Find(&epics).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("status = ?", status).Find(&epics).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return epics, nil
 }
 
 // GetByPriority retrieves epics by priority
-func (r *epicRepository) GetByPriority(priority models.Priority) ([]models.Epic, error) {
+func (r *epicRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.Epic, error) {
 	var epics []models.Epic
-	if err := r.GetDB().Where("priority = ?", priority).Find(&epics).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("priority = ?", priority).Find(&epics).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return epics, nil
 }
 
 // HasUserStories checks if an epic has any user stories
-func (r *epicRepository) HasUserStories(id uuid.UUID) (bool, error) {
+func (r *epicRepository) HasUserStories(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.UserStory{}).Where("epic_id = ?", id).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.UserStory{}).Where("epic_id = ?", id).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
 
 // GetByIDWithUsers retrieves an epic by its ID with creator and assignee preloaded
-func (r *epicRepository) GetByIDWithUsers(id uuid.UUID) (*models.Epic, error) {
+func (r *epicRepository) GetByIDWithUsers(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	var epic models.Epic
-	if err := r.GetDB().Preload("Creator").Preload("Assignee").Where("id = ?", id).First(&epic).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("Creator").Preload("Assignee").Where("id = ?", id).First(&epic).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -91,9 +93,9 @@ func (r *epicRepository) GetByIDWithUsers(id uuid.UUID) (*models.Epic, error) {
 }
 
 // GetByReferenceIDWithUsers retrieves an epic by its reference ID with creator and assignee preloaded
-func (r *epicRepository) GetByReferenceIDWithUsers(referenceID string) (*models.Epic, error) {
+func (r *epicRepository) GetByReferenceIDWithUsers(ctx context.Context, referenceID string) (*models.Epic, error) {
 	var epic models.Epic
-	if err := r.GetDB().Preload("Creator").Preload("Assignee").Where("reference_id = ?", referenceID).First(&epic).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("Creator").Preload("Assignee").Where("reference_id = ?", referenceID).First(&epic).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -103,14 +105,14 @@ func (r *epicRepository) GetByReferenceIDWithUsers(referenceID string) (*models.
 }
 
 // GetByReferenceIDWithUsersCaseInsensitive retrieves an epic by its reference ID (case-insensitive) with creator and assignee preloaded
-func (r *epicRepository) GetByReferenceIDWithUsersCaseInsensitive(referenceID string) (*models.Epic, error) {
+func (r *epicRepository) GetByReferenceIDWithUsersCaseInsensitive(ctx context.Context, referenceID string) (*models.Epic, error) {
 	var epic models.Epic
 
-	query := r.GetDB().Preload("Creator").Preload("Assignee")
+	query := r.GetDB().WithContext(ctx).Preload("Creator").Preload("Assignee")
 	var err error
 
 	// Use ILIKE for PostgreSQL, LOWER() LIKE for SQLite compatibility
-	if r.GetDB().Dialector.Name() == "postgres" {
+	if r.GetDB().WithContext(ctx).Dialector.Name() == "postgres" {
 		err = query.Where("reference_id ILIKE ?", referenceID).First(&epic).Error
 	} else {
 		// SQLite and other databases - use LOWER() LIKE for case-insensitive matching
@@ -127,10 +129,10 @@ func (r *epicRepository) GetByReferenceIDWithUsersCaseInsensitive(referenceID st
 }
 
 // ListWithIncludes retrieves epics with optional related entity preloading
-func (r *epicRepository) ListWithIncludes(filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.Epic, error) {
+func (r *epicRepository) ListWithIncludes(ctx context.Context, filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.Epic, error) {
 	var epics []models.Epic
 
-	query := r.GetDB().Model(&models.Epic{})
+	query := r.GetDB().WithContext(ctx).Model(&models.Epic{})
 
 	// Apply includes (preloads)
 	for _, include := range includes {
@@ -175,9 +177,9 @@ func (r *epicRepository) ListWithIncludes(filters map[string]interface{}, includ
 // This method loads the complete hierarchy: Epic → [SteeringDocuments, UserStories] → [Requirements, AcceptanceCriteria]
 // SteeringDocuments and UserStories are loaded at the same level under Epic
 // Requirements and AcceptanceCriteria are loaded at the same level under each UserStory
-func (r *epicRepository) GetCompleteHierarchy(id uuid.UUID) (*models.Epic, error) {
+func (r *epicRepository) GetCompleteHierarchy(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	var epic models.Epic
-	err := r.GetDB().
+	err := r.GetDB().WithContext(ctx).
 		// Load steering documents with ordering
 		Preload("SteeringDocuments", func(db *gorm.DB) *gorm.DB {
 			return db.Order("created_at ASC")
@@ -207,3 +209,26 @@ func (r *epicRepository) GetCompleteHierarchy(id uuid.UUID) (*models.Epic, error
 	}
 	return &epic, nil
 }
+
+// LockNesting serializes changes of the parents of epics until the end of the transaction. Locking the epics
+// involved is not enough: concurrent changes of disjoint epics can together form a cycle. The lock is the one
+// the trigger guarding against cycles takes. Databases other than PostgreSQL have no advisory locks and skip it.
+func (r *epicRepository) LockNesting(ctx context.Context) error {
+	db := r.GetDB().WithContext(ctx)
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+	return r.handleDBError(db.Exec("SELECT pg_advisory_xact_lock(hashtext('epic_parents'))").Error)
+}
+
+// LockForUpdate retrieves epics and locks their rows until the end of the transaction. The rows are locked in ID
+// order, so transactions locking the same epics cannot deadlock.
+func (r *epicRepository) LockForUpdate(ctx context.Context, ids ...uuid.UUID) ([]models.Epic, error) {
+	var epics []models.Epic
+	err := r.GetDB().WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).Order("id").Find(&epics).Error
+	if err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return epics, nil
+}
@@ -18,6 +18,7 @@ import (
 
 // BenchmarkEpicRepository_Create benchmarks epic creation
 func BenchmarkEpicRepository_Create(b *testing.B) {
+	ctx := context.Background()
 	db := setupBenchmarkDB(b)
 	defer cleanupBenchmarkDB(db)
 
@@ -37,7 +38,7 @@ func BenchmarkEpicRepository_Create(b *testing.B) {
 			Description: benchStringPtr(fmt.Sprintf("Benchmark description %d", i)),
 		}
 
-		err := repo.Create(epic)
+		err := repo.Create(ctx, epic)
 		if err != nil {
 			b.Fatal(err)
 		}
@@ -46,6 +47,7 @@ func BenchmarkEpicRepository_Create(b *testing.B) {
 
 // BenchmarkEpicRepository_GetByID benchmarks epic retrieval by ID
 func BenchmarkEpicRepository_GetByID(b *testing.B) {
+	ctx := context.Background()
 	db := setupBenchmarkDB(b)
 	defer cleanupBenchmarkDB(db)
 
@@ -65,7 +67,7 @@ func BenchmarkEpicRepository_GetByID(b *testing.B) {
 			Title:       fmt.Sprintf("Benchmark Epic %d", i),
 			Description: benchStringPtr(fmt.Sprintf("Benchmark description %d", i)),
 		}
-		err := repo.Create(epic)
+		err := repo.Create(ctx, epic)
 		require.NoError(b, err)
 		epicIDs = append(epicIDs, epic.ID)
 	}
@@ -73,7 +75,7 @@ func BenchmarkEpicRepository_GetByID(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		id := epicIDs[i%len(epicIDs)]
-		_, err := repo.GetByID(id)
+		_, err := repo.GetByID(ctx, id)
 		if err != nil {
 			b.Fatal(err)
 		}
@@ -82,6 +84,7 @@ func BenchmarkEpicRepository_GetByID(b *testing.B) {
 
 // BenchmarkEpicRepository_List benchmarks epic listing with filters
 func BenchmarkEpicRepository_List(b *testing.B) {
+	ctx := context.Background()
 	db := setupBenchmarkDB(b)
 	defer cleanupBenchmarkDB(db)
 
@@ -100,7 +103,7 @@ func BenchmarkEpicRepository_List(b *testing.B) {
 			Title:       fmt.Sprintf("Benchmark Epic %d", i),
 			Description: benchStringPtr(fmt.Sprintf("Benchmark description %d", i)),
 		}
-		err := repo.Create(epic)
+		err := repo.Create(ctx, epic)
 		require.NoError(b, err)
 	}
 
@@ -108,7 +111,7 @@ func BenchmarkEpicRepository_List(b *testing.B) {
 		filters := make(map[string]interface{})
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			_, err := repo.List(filters, "created_at", 100, 0)
+			_, err := repo.List(ctx, filters, "created_at", 100, 0)
 			if err != nil {
 				b.Fatal(err)
 			}
@@ -121,7 +124,7 @@ func BenchmarkEpicRepository_List(b *testing.B) {
 		}
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			_, err := repo.List(filters, "created_at", 100, 0)
+			_, err := repo.List(ctx, filters, "created_at", 100, 0)
 			if err != nil {
 				b.Fatal(err)
 			}
@@ -134,7 +137,7 @@ func BenchmarkEpicRepository_List(b *testing.B) {
 		}
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			_, err := repo.List(filters, "created_at", 100, 0)
+			_, err := repo.List(ctx, filters, "created_at", 100, 0)
 			if err != nil {
 				b.Fatal(err)
 			}
@@ -144,6 +147,7 @@ func BenchmarkEpicRepository_List(b *testing.B) {
 
 // BenchmarkEpicRepository_Update benchmarks epic updates
 func BenchmarkEpicRepository_Update(b *testing.B) {
+	ctx := context.Background()
 	db := setupBenchmarkDB(b)
 	defer cleanupBenchmarkDB(db)
 
@@ -163,7 +167,7 @@ func BenchmarkEpicRepository_Update(b *testing.B) {
 			Title:       fmt.Sprintf("Benchmark Epic %d", i),
 			Description: benchStringPtr(fmt.Sprintf("Benchmark description %d", i)),
 		}
-		err := repo.Create(epic)
+		err := repo.Create(ctx, epic)
 		require.NoError(b, err)
 		epics = append(epics, epic)
 	}
@@ -172,7 +176,7 @@ func BenchmarkEpicRepository_Update(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		epic := epics[i%len(epics)]
 		epic.Title = fmt.Sprintf("Updated Benchmark Epic %d", i)
-		err := repo.Update(epic)
+		err := repo.Update(ctx, epic)
 		if err != nil {
 			b.Fatal(err)
 		}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -33,6 +34,7 @@ func setupEpicTestDB(t *testing.T) *gorm.DB {
 }
 
 func createTestEpic(t *testing.T, repo EpicRepository, userRepo UserRepository, title string, status models.EpicStatus, priority models.Priority) (*models.Epic, *models.User) {
+	ctx := context.Background()
 	// Create a test user first with unique username/email
 	username := "testuser_" + title
 	email := "test_" + title + "@example.com"
@@ -42,7 +44,7 @@ func createTestEpic(t *testing.T, repo EpicRepository, userRepo UserRepository,
 		PasswordHash: "hashed_password",
 		Role:         models.RoleUser,
 	}
-	err := userRepo.Create(user)
+	err := userRepo.Create(ctx, user)
 	require.NoError(t, err)
 
 	epic := &models.Epic{
@@ -54,7 +56,7 @@ func createTestEpic(t *testing.T, repo EpicRepository, userRepo UserRepository,
 		Title:       title,
 	}
 
-	err = repo.Create(epic)
+	err = repo.Create(ctx, epic)
 	require.NoError(t, err)
 
 	return epic, user
@@ -73,6 +75,7 @@ func TestEpicRepository_Create(t *testing.T) {
 }
 
 func TestEpicRepository_GetWithUserStories(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -90,11 +93,11 @@ func TestEpicRepository_GetWithUserStories(t *testing.T) {
 		Status:      models.UserStoryStatusBacklog,
 		Title:       "Test User Story",
 	}
-	err := userStoryRepo.Create(userStory)
+	err := userStoryRepo.Create(ctx, userStory)
 	require.NoError(t, err)
 
 	// Get epic with user stories
-	retrieved, err := epicRepo.GetWithUserStories(epic.ID)
+	retrieved, err := epicRepo.GetWithUserStories(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, epic.ID, retrieved.ID)
 	assert.Len(t, retrieved.UserStories, 1)
@@ -102,6 +105,7 @@ func TestEpicRepository_GetWithUserStories(t *testing.T) {
 }
 
 func TestEpicRepository_GetByCreator(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -109,13 +113,14 @@ func TestEpicRepository_GetByCreator(t *testing.T) {
 	epic, user := createTestEpic(t, epicRepo, userRepo, "Test Epic", models.EpicStatusBacklog, models.PriorityHigh)
 
 	// Get epics by creator
-	epics, err := epicRepo.GetByCreator(user.ID)
+	epics, err := epicRepo.GetByCreator(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Len(t, epics, 1)
 	assert.Equal(t, epic.ID, epics[0].ID)
 }
 
 func TestEpicRepository_GetByAssignee(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -123,13 +128,14 @@ func TestEpicRepository_GetByAssignee(t *testing.T) {
 	epic, user := createTestEpic(t, epicRepo, userRepo, "Test Epic", models.EpicStatusBacklog, models.PriorityHigh)
 
 	// Get epics by assignee
-	epics, err := epicRepo.GetByAssignee(user.ID)
+	epics, err := epicRepo.GetByAssignee(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Len(t, epics, 1)
 	assert.Equal(t, epic.ID, epics[0].ID)
 }
 
 func TestEpicRepository_GetByStatus(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -139,13 +145,14 @@ func TestEpicRepository_GetByStatus(t *testing.T) {
 	createTestEpic(t, epicRepo, userRepo, "Epic 2", models.EpicStatusInProgress, models.PriorityMedium)
 
 	// Get epics by status
-	epics, err := epicRepo.GetByStatus(models.EpicStatusBacklog)
+	epics, err := epicRepo.GetByStatus(ctx, models.EpicStatusBacklog)
 	assert.NoError(t, err)
 	assert.Len(t, epics, 1)
 	assert.Equal(t, models.EpicStatusBacklog, epics[0].Status)
 }
 
 func TestEpicRepository_GetByPriority(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -155,13 +162,14 @@ func TestEpicRepository_GetByPriority(t *testing.T) {
 	createTestEpic(t, epicRepo, userRepo, "Epic 2", models.EpicStatusBacklog, models.PriorityLow)
 
 	// Get epics by priority
-	epics, err := epicRepo.GetByPriority(models.PriorityHigh)
+	epics, err := epicRepo.GetByPriority(ctx, models.PriorityHigh)
 	assert.NoError(t, err)
 	assert.Len(t, epics, 1)
 	assert.Equal(t, models.PriorityHigh, epics[0].Priority)
 }
 
 func TestEpicRepository_HasUserStories(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -170,7 +178,7 @@ func TestEpicRepository_HasUserStories(t *testing.T) {
 	epic, user := createTestEpic(t, epicRepo, userRepo, "Test Epic", models.EpicStatusBacklog, models.PriorityHigh)
 
 	// Initially should have no user stories
-	hasUserStories, err := epicRepo.HasUserStories(epic.ID)
+	hasUserStories, err := epicRepo.HasUserStories(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.False(t, hasUserStories)
 
@@ -184,16 +192,17 @@ func TestEpicRepository_HasUserStories(t *testing.T) {
 		Status:      models.UserStoryStatusBacklog,
 		Title:       "Test User Story",
 	}
-	err = userStoryRepo.Create(userStory)
+	err = userStoryRepo.Create(ctx, userStory)
 	require.NoError(t, err)
 
 	// Now should have user stories
-	hasUserStories, err = epicRepo.HasUserStories(epic.ID)
+	hasUserStories, err = epicRepo.HasUserStories(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.True(t, hasUserStories)
 }
 
 func TestEpicRepository_GetByReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -201,13 +210,14 @@ func TestEpicRepository_GetByReferenceID(t *testing.T) {
 	epic, _ := createTestEpic(t, epicRepo, userRepo, "Test Epic", models.EpicStatusBacklog, models.PriorityHigh)
 
 	// Get by reference ID
-	retrieved, err := epicRepo.GetByReferenceID(epic.ReferenceID)
+	retrieved, err := epicRepo.GetByReferenceID(ctx, epic.ReferenceID)
 	assert.NoError(t, err)
 	assert.Equal(t, epic.ID, retrieved.ID)
 	assert.Equal(t, epic.Title, retrieved.Title)
 }
 
 func TestEpicRepository_Update(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -217,17 +227,39 @@ func TestEpicRepository_Update(t *testing.T) {
 	// Update epic
 	epic.Status = models.EpicStatusInProgress
 	epic.Title = "Updated Epic"
-	err := epicRepo.Update(epic)
+	err := epicRepo.Update(ctx, epic)
 	assert.NoError(t, err)
 
 	// Verify update
-	retrieved, err := epicRepo.GetByID(epic.ID)
+	retrieved, err := epicRepo.GetByID(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, models.EpicStatusInProgress, retrieved.Status)
 	assert.Equal(t, "Updated Epic", retrieved.Title)
 }
 
+func TestEpicRepository_LockForUpdate(t *testing.T) {
+	ctx := context.Background()
+	db := setupEpicTestDB(t)
+	epicRepo := NewEpicRepository(db)
+	userRepo := NewUserRepository(db)
+
+	first, _ := createTestEpic(t, epicRepo, userRepo, "First Epic", models.EpicStatusBacklog, models.PriorityHigh)
+	second, _ := createTestEpic(t, epicRepo, userRepo, "Second Epic", models.EpicStatusBacklog, models.PriorityHigh)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		require.NoError(t, NewEpicRepository(tx).LockNesting(ctx), "databases without advisory locks skip the lock")
+		locked, err := NewEpicRepository(tx).LockForUpdate(ctx, second.ID, first.ID, uuid.New())
+		require.NoError(t, err)
+		require.Len(t, locked, 2, "missing epics are skipped")
+		assert.ElementsMatch(t, []uuid.UUID{first.ID, second.ID}, []uuid.UUID{locked[0].ID, locked[1].ID})
+		assert.Less(t, locked[0].ID.String(), locked[1].ID.String(), "rows are locked in ID order")
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
 func TestEpicRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -235,17 +267,18 @@ func TestEpicRepository_Delete(t *testing.T) {
 	epic, _ := createTestEpic(t, epicRepo, userRepo, "Test Epic", models.EpicStatusBacklog, models.PriorityHigh)
 
 	// Delete epic
-	err := epicRepo.Delete(epic.ID)
+	err := epicRepo.Delete(ctx, epic.ID)
 	assert.NoError(t, err)
 
 	// Verify deletion
-	retrieved, err := epicRepo.GetByID(epic.ID)
+	retrieved, err := epicRepo.GetByID(ctx, epic.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestEpicRepository_GetCompleteHierarchy(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -258,7 +291,7 @@ func TestEpicRepository_GetCompleteHierarchy(t *testing.T) {
 		PasswordHash: "hashed_password",
 		Role:         models.RoleUser,
 	}
-	err := userRepo.Create(user)
+	err := userRepo.Create(ctx, user)
 	require.NoError(t, err)
 
 	// Create requirement type
@@ -278,7 +311,7 @@ func TestEpicRepository_GetCompleteHierarchy(t *testing.T) {
 		Status:      models.EpicStatusBacklog,
 		Title:       "Test Epic for Hierarchy",
 	}
-	err = epicRepo.Create(epic)
+	err = epicRepo.Create(ctx, epic)
 	require.NoError(t, err)
 
 	// Create user story 1
@@ -291,7 +324,7 @@ func TestEpicRepository_GetCompleteHierarchy(t *testing.T) {
 		Status:      models.UserStoryStatusBacklog,
 		Title:       "User Story 1",
 	}
-	err = userStoryRepo.Create(userStory1)
+	err = userStoryRepo.Create(ctx, userStory1)
 	require.NoError(t, err)
 
 	// Create user story 2
@@ -304,7 +337,7 @@ func TestEpicRepository_GetCompleteHierarchy(t *testing.T) {
 		Status:      models.UserStoryStatusBacklog,
 		Title:       "User Story 2",
 	}
-	err = userStoryRepo.Create(userStory2)
+	err = userStoryRepo.Create(ctx, userStory2)
 	require.NoError(t, err)
 
 	// Create requirements for user story 1
@@ -368,7 +401,7 @@ func TestEpicRepository_GetCompleteHierarchy(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test GetCompleteHierarchy
-	retrieved, err := epicRepo.GetCompleteHierarchy(epic.ID)
+	retrieved, err := epicRepo.GetCompleteHierarchy(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrieved)
 
@@ -404,6 +437,7 @@ func TestEpicRepository_GetCompleteHierarchy(t *testing.T) {
 }
 
 func TestEpicRepository_GetCompleteHierarchy_EmptyUserStories(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -415,7 +449,7 @@ func TestEpicRepository_GetCompleteHierarchy_EmptyUserStories(t *testing.T) {
 		PasswordHash: "hashed_password",
 		Role:         models.RoleUser,
 	}
-	err := userRepo.Create(user)
+	err := userRepo.Create(ctx, user)
 	require.NoError(t, err)
 
 	// Create epic without user stories
@@ -427,11 +461,11 @@ func TestEpicRepository_GetCompleteHierarchy_EmptyUserStories(t *testing.T) {
 		Status:      models.EpicStatusBacklog,
 		Title:       "Empty Epic",
 	}
-	err = epicRepo.Create(epic)
+	err = epicRepo.Create(ctx, epic)
 	require.NoError(t, err)
 
 	// Test GetCompleteHierarchy
-	retrieved, err := epicRepo.GetCompleteHierarchy(epic.ID)
+	retrieved, err := epicRepo.GetCompleteHierarchy(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrieved)
 	assert.Equal(t, epic.ID, retrieved.ID)
@@ -439,11 +473,12 @@ func TestEpicRepository_GetCompleteHierarchy_EmptyUserStories(t *testing.T) {
 }
 
 func TestEpicRepository_GetCompleteHierarchy_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 
 	// Test with non-existent epic ID
-	retrieved, err := epicRepo.GetCompleteHierarchy(uuid.New())
+	retrieved, err := epicRepo.GetCompleteHierarchy(ctx, uuid.New())
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
@@ -455,6 +490,7 @@ func strPtr(s string) *string {
 }
 
 func TestEpicRepository_GetCompleteHierarchy_WithSteeringDocuments(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -467,7 +503,7 @@ func TestEpicRepository_GetCompleteHierarchy_WithSteeringDocuments(t *testing.T)
 		PasswordHash: "hashed_password",
 		Role:         models.RoleUser,
 	}
-	err := userRepo.Create(user)
+	err := userRepo.Create(ctx, user)
 	require.NoError(t, err)
 
 	// Create requirement type
@@ -487,7 +523,7 @@ func TestEpicRepository_GetCompleteHierarchy_WithSteeringDocuments(t *testing.T)
 		Status:      models.EpicStatusBacklog,
 		Title:       "Test Epic with Steering Documents",
 	}
-	err = epicRepo.Create(epic)
+	err = epicRepo.Create(ctx, epic)
 	require.NoError(t, err)
 
 	// Create steering documents
@@ -523,7 +559,7 @@ func TestEpicRepository_GetCompleteHierarchy_WithSteeringDocuments(t *testing.T)
 		Status:      models.UserStoryStatusBacklog,
 		Title:       "User Story with Requirements",
 	}
-	err = userStoryRepo.Create(userStory)
+	err = userStoryRepo.Create(ctx, userStory)
 	require.NoError(t, err)
 
 	// Create requirement
@@ -551,7 +587,7 @@ func TestEpicRepository_GetCompleteHierarchy_WithSteeringDocuments(t *testing.T)
 	require.NoError(t, err)
 
 	// Test GetCompleteHierarchy
-	retrieved, err := epicRepo.GetCompleteHierarchy(epic.ID)
+	retrieved, err := epicRepo.GetCompleteHierarchy(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrieved)
 
@@ -584,6 +620,7 @@ func TestEpicRepository_GetCompleteHierarchy_WithSteeringDocuments(t *testing.T)
 }
 
 func TestEpicRepository_GetCompleteHierarchy_OnlySteeringDocuments(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -595,7 +632,7 @@ func TestEpicRepository_GetCompleteHierarchy_OnlySteeringDocuments(t *testing.T)
 		PasswordHash: "hashed_password",
 		Role:         models.RoleUser,
 	}
-	err := userRepo.Create(user)
+	err := userRepo.Create(ctx, user)
 	require.NoError(t, err)
 
 	// Create epic
@@ -607,7 +644,7 @@ func TestEpicRepository_GetCompleteHierarchy_OnlySteeringDocuments(t *testing.T)
 		Status:      models.EpicStatusBacklog,
 		Title:       "Epic with Only Steering Documents",
 	}
-	err = epicRepo.Create(epic)
+	err = epicRepo.Create(ctx, epic)
 	require.NoError(t, err)
 
 	// Create steering document
@@ -625,7 +662,7 @@ func TestEpicRepository_GetCompleteHierarchy_OnlySteeringDocuments(t *testing.T)
 	require.NoError(t, err)
 
 	// Test GetCompleteHierarchy
-	retrieved, err := epicRepo.GetCompleteHierarchy(epic.ID)
+	retrieved, err := epicRepo.GetCompleteHierarchy(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrieved)
 
@@ -642,6 +679,7 @@ func TestEpicRepository_GetCompleteHierarchy_OnlySteeringDocuments(t *testing.T)
 }
 
 func TestEpicRepository_GetCompleteHierarchy_NoSteeringDocuments(t *testing.T) {
+	ctx := context.Background()
 	db := setupEpicTestDB(t)
 	epicRepo := NewEpicRepository(db)
 	userRepo := NewUserRepository(db)
@@ -654,7 +692,7 @@ func TestEpicRepository_GetCompleteHierarchy_NoSteeringDocuments(t *testing.T) {
 		PasswordHash: "hashed_password",
 		Role:         models.RoleUser,
 	}
-	err := userRepo.Create(user)
+	err := userRepo.Create(ctx, user)
 	require.NoError(t, err)
 
 	// Create epic
@@ -666,7 +704,7 @@ func TestEpicRepository_GetCompleteHierarchy_NoSteeringDocuments(t *testing.T) {
 		Status:      models.EpicStatusBacklog,
 		Title:       "Epic without Steering Documents",
 	}
-	err = epicRepo.Create(epic)
+	err = epicRepo.Create(ctx, epic)
 	require.NoError(t, err)
 
 	// Create user story
@@ -679,11 +717,11 @@ func TestEpicRepository_GetCompleteHierarchy_NoSteeringDocuments(t *testing.T) {
 		Status:      models.UserStoryStatusBacklog,
 		Title:       "User Story without Steering Docs",
 	}
-	err = userStoryRepo.Create(userStory)
+	err = userStoryRepo.Create(ctx, userStory)
 	require.NoError(t, err)
 
 	// Test GetCompleteHierarchy
-	retrieved, err := epicRepo.GetCompleteHierarchy(epic.ID)
+	retrieved, err := epicRepo.GetCompleteHierarchy(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrieved)
 
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,6 +13,7 @@ import (
 
 // ExampleUsage demonstrates how to use the repository layer
 func ExampleUsage() {
+	ctx := context.Background()
 	// Setup database (in real application, this would be PostgreSQL)
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	if err != nil {
@@ -41,7 +43,7 @@ func ExampleUsage() {
 	repos := NewRepositories(db, nil)
 
 	// Example workflow: Create User -> Epic -> UserStory -> AcceptanceCriteria -> Requirement
-	err = repos.WithTransaction(func(txRepos *Repositories) error {
+	err = repos.WithTransaction(ctx, func(txRepos *Repositories) error {
 		// 1. Create a user
 		user := &models.User{
 			Username:     "john_doe",
@@ -49,7 +51,7 @@ func ExampleUsage() {
 			PasswordHash: "hashed_password_here",
 			Role:         models.RoleUser,
 		}
-		if err := txRepos.User.Create(user); err != nil {
+		if err := txRepos.User.Create(ctx, user); err != nil {
 			return fmt.Errorf("failed to create user: %w", err)
 		}
 		fmt.Printf("Created user: %s (ID: %s)\n", user.Username, user.ID)
@@ -64,7 +66,7 @@ func ExampleUsage() {
 			Title:       "User Authentication System",
 			Description: stringPtr("Implement comprehensive user authentication and authorization system"),
 		}
-		if err := txRepos.Epic.Create(epic); err != nil {
+		if err := txRepos.Epic.Create(ctx, epic); err != nil {
 			return fmt.Errorf("failed to create epic: %w", err)
 		}
 		fmt.Printf("Created epic: %s (ID: %s)\n", epic.Title, epic.ID)
@@ -80,7 +82,7 @@ func ExampleUsage() {
 			Title:       "User Login",
 			Description: stringPtr("As a user, I want to log in to the system, so that I can access my account"),
 		}
-		if err := txRepos.UserStory.Create(userStory); err != nil {
+		if err := txRepos.UserStory.Create(ctx, userStory); err != nil {
 			return fmt.Errorf("failed to create user story: %w", err)
 		}
 		fmt.Printf("Created user story: %s (ID: %s)\n", userStory.Title, userStory.ID)
@@ -92,13 +94,13 @@ func ExampleUsage() {
 			AuthorID:    user.ID,
 			Description: "WHEN user enters valid credentials THEN system SHALL authenticate user and redirect to dashboard",
 		}
-		if err := txRepos.AcceptanceCriteria.Create(acceptanceCriteria); err != nil {
+		if err := txRepos.AcceptanceCriteria.Create(ctx, acceptanceCriteria); err != nil {
 			return fmt.Errorf("failed to create acceptance criteria: %w", err)
 		}
 		fmt.Printf("Created acceptance criteria: %s (ID: %s)\n", acceptanceCriteria.ReferenceID, acceptanceCriteria.ID)
 
 		// 5. Get a requirement type
-		reqTypes, err := txRepos.RequirementType.List(nil, "", 1, 0)
+		reqTypes, err := txRepos.RequirementType.List(ctx, nil, "", 1, 0)
 		if err != nil {
 			return fmt.Errorf("failed to get requirement types: %w", err)
 		}
@@ -119,7 +121,7 @@ func ExampleUsage() {
 			Title:                "Implement JWT Authentication",
 			Description:          stringPtr("System must implement JWT-based authentication with secure token generation and validation"),
 		}
-		if err := txRepos.Requirement.Create(requirement); err != nil {
+		if err := txRepos.Requirement.Create(ctx, requirement); err != nil {
 			return fmt.Errorf("failed to create requirement: %w", err)
 		}
 		fmt.Printf("Created requirement: %s (ID: %s)\n", requirement.Title, requirement.ID)
@@ -135,7 +137,7 @@ func ExampleUsage() {
 	fmt.Println("\n--- Querying Examples ---")
 
 	// Get all epics
-	epics, err := repos.Epic.List(nil, "created_at DESC", 0, 0)
+	epics, err := repos.Epic.List(ctx, nil, "created_at DESC", 0, 0)
 	if err != nil {
 		log.Fatal("Failed to list epics:", err)
 	}
@@ -143,7 +145,7 @@ func ExampleUsage() {
 
 	// Get epic with user stories
 	if len(epics) > 0 {
-		epicWithStories, err := repos.Epic.GetWithUserStories(epics[0].ID)
+		epicWithStories, err := repos.Epic.GetWithUserStories(ctx, epics[0].ID)
 		if err != nil {
 			log.Fatal("Failed to get epic with user stories:", err)
 		}
@@ -151,14 +153,14 @@ func ExampleUsage() {
 	}
 
 	// Search requirements by text
-	requirements, err := repos.Requirement.SearchByText("JWT")
+	requirements, err := repos.Requirement.SearchByText(ctx, "JWT")
 	if err != nil {
 		log.Fatal("Failed to search requirements:", err)
 	}
 	fmt.Printf("Found %d requirements containing 'JWT'\n", len(requirements))
 
 	// Get requirements by status
-	draftRequirements, err := repos.Requirement.GetByStatus(models.RequirementStatusDraft)
+	draftRequirements, err := repos.Requirement.GetByStatus(ctx, models.RequirementStatusDraft)
 	if err != nil {
 		log.Fatal("Failed to get draft requirements:", err)
 	}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// favoriteRepository implements FavoriteRepository interface
+type favoriteRepository struct {
+	db *gorm.DB
+}
+
+// NewFavoriteRepository creates a new favorite repository instance
+func NewFavoriteRepository(db *gorm.DB) FavoriteRepository {
+	return &favoriteRepository{db: db}
+}
+
+// Create pins an entity for a user; pinning an already pinned entity is a no-op
+func (r *favoriteRepository) Create(ctx context.Context, favorite *models.Favorite) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(favorite).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// Get retrieves a user's favorite for an entity
+func (r *favoriteRepository) Get(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) (*models.Favorite, error) {
+	var favorite models.Favorite
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND user_id = ?", entityType, entityID, userID).
+		First(&favorite).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return &favorite, nil
+}
+
+// Delete unpins an entity for a user
+func (r *favoriteRepository) Delete(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND user_id = ?", entityType, entityID, userID).
+		Delete(&models.Favorite{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListByUser retrieves all favorites of a user, most recently pinned first
+func (r *favoriteRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.Favorite, error) {
+	var favorites []models.Favorite
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC, id").Find(&favorites).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return favorites, nil
+}
+
+// ListFavoriteEntityIDs returns which of the given entities the user pinned
+func (r *favoriteRepository) ListFavoriteEntityIDs(ctx context.Context, userID uuid.UUID, entityType models.EntityType, entityIDs []uuid.UUID) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0)
+	if len(entityIDs) == 0 {
+		return ids, nil
+	}
+	err := r.db.WithContext(ctx).Model(&models.Favorite{}).
+		Where("user_id = ? AND entity_type = ? AND entity_id IN ?", userID, entityType, entityIDs).
+		Pluck("entity_id", &ids).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return ids, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *favoriteRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -0,0 +1,418 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+)
+
+// ErrEpicFrozen is returned for changes to the user stories, acceptance criteria and requirements of a frozen epic
+var ErrEpicFrozen = apperrors.New(apperrors.KindLocked, "EPIC_FROZEN", "requirements of the epic are frozen")
+
+// EpicFrozenError tells which freeze rejected a change; it matches ErrEpicFrozen with errors.Is
+type EpicFrozenError struct {
+	EpicID      uuid.UUID
+	ReferenceID string
+	Reason      string
+	FrozenBy    *uuid.UUID
+	FrozenAt    *time.Time
+}
+
+// Error returns the sentinel message followed by the freeze reason
+func (e *EpicFrozenError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrEpicFrozen.Error(), e.Reason)
+}
+
+// Unwrap returns ErrEpicFrozen
+func (e *EpicFrozenError) Unwrap() error {
+	return ErrEpicFrozen
+}
+
+// freezeExemptionKey is the context key that lets changes through the freeze of epics
+type freezeExemptionKey struct{}
+
+// freezeRecorderKey is the context key of the FreezeRecorder of a request
+type freezeRecorderKey struct{}
+
+// WithFreezeExemption returns a context whose changes are not rejected by the freeze of epics, for administrators
+func WithFreezeExemption(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freezeExemptionKey{}, true)
+}
+
+// FreezeRecorder remembers the freeze that rejected a change made with its context, so that callers
+// which replace errors with their own can still tell that the change was rejected by a freeze
+type FreezeRecorder struct {
+	mu       sync.Mutex
+	rejected *EpicFrozenError
+}
+
+// Rejected returns the freeze that rejected a change, or nil
+func (r *FreezeRecorder) Rejected() *EpicFrozenError {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rejected
+}
+
+// WithFreezeRecorder returns a context whose rejected changes are remembered by recorder
+func WithFreezeRecorder(ctx context.Context, recorder *FreezeRecorder) context.Context {
+	return context.WithValue(ctx, freezeRecorderKey{}, recorder)
+}
+
+// freezeParent describes how the rows of a guarded table name their parent
+type freezeParent struct {
+	column     string
+	field      string
+	entityType models.EntityType
+}
+
+// freezeParents maps the tables of the children of epics to the parent column of their rows
+var freezeParents = map[string]freezeParent{
+	"user_stories":                    {column: "epic_id", field: "EpicID", entityType: models.EntityTypeEpic},
+	"acceptance_criteria":             {column: "user_story_id", field: "UserStoryID", entityType: models.EntityTypeUserStory},
+	"requirements":                    {column: "user_story_id", field: "UserStoryID", entityType: models.EntityTypeUserStory},
+	"requirement_acceptance_criteria": {column: "requirement_id", field: "RequirementID", entityType: models.EntityTypeRequirement},
+}
+
+// freezeEntityTables are the guarded tables whose rows belong to the entity named by their entity_type and entity_id
+var freezeEntityTables = map[string]bool{
+	"entity_translations":  true,
+	"entity_verifications": true,
+}
+
+// freezeTarget is an entity whose epic decides whether a change may be made
+type freezeTarget struct {
+	EntityType models.EntityType
+	EntityID   uuid.UUID
+}
+
+// RegisterFreezeCallbacks registers GORM callbacks that reject creations, updates and deletions of the user stories,
+// acceptance criteria and requirements of frozen epics, of the links between requirements and acceptance criteria
+// and of the translations and verification states of those entities, as well as moves of frozen epics in the epic
+// hierarchy. Changes are checked whichever service, import or MCP tool makes them, against the epic both before and
+// after a move. Changes made with a context from WithFreezeExemption are let through.
+func RegisterFreezeCallbacks(db *gorm.DB) error {
+	callbacks := []struct {
+		name     string
+		register func() error
+	}{
+		{"create", func() error {
+			return db.Callback().Create().Before("gorm:create").Register("freeze:check_create", checkFreezeCreate)
+		}},
+		{"update", func() error {
+			return db.Callback().Update().Before("gorm:update").Register("freeze:check_update", checkFreezeUpdate)
+		}},
+		{"delete", func() error {
+			return db.Callback().Delete().Before("gorm:delete").Register("freeze:check_delete", checkFreezeDelete)
+		}},
+	}
+
+	for _, callback := range callbacks {
+		if err := callback.register(); err != nil {
+			return fmt.Errorf("failed to register %s freeze callback: %w", callback.name, err)
+		}
+	}
+	return nil
+}
+
+// freezeChecked reports whether the statement changes a guarded table and is not exempt from the freeze
+func freezeChecked(db *gorm.DB) bool {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return false
+	}
+	if exempt, _ := db.Statement.Context.Value(freezeExemptionKey{}).(bool); exempt {
+		return false
+	}
+	table := db.Statement.Schema.Table
+	_, parented := freezeParents[table]
+	return parented || freezeEntityTables[table] || table == "epics"
+}
+
+// checkFreezeCreate rejects entities created in frozen epics
+func checkFreezeCreate(db *gorm.DB) {
+	if !freezeChecked(db) || db.Statement.Schema.Table == "epics" {
+		return
+	}
+	rejectFrozen(db, freezeTargetsOf(db.Statement.Schema.Table, db.Statement.ReflectValue))
+}
+
+// checkFreezeUpdate rejects changes to entities of frozen epics and moves into or out of them
+func checkFreezeUpdate(db *gorm.DB) {
+	if !freezeChecked(db) {
+		return
+	}
+	table := db.Statement.Schema.Table
+	if table == "epics" {
+		checkFrozenEpicMove(db)
+		return
+	}
+
+	targets, err := storedFreezeTargets(db)
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to check epic freeze: %w", err))
+		return
+	}
+	if parent, ok := freezeParents[table]; ok {
+		if id, ok := updatedParentID(db.Statement.Dest, parent); ok {
+			targets = append(targets, freezeTarget{EntityType: parent.entityType, EntityID: id})
+		}
+	}
+	rejectFrozen(db, targets)
+}
+
+// checkFreezeDelete rejects deletions of entities of frozen epics
+func checkFreezeDelete(db *gorm.DB) {
+	if !freezeChecked(db) || db.Statement.Schema.Table == "epics" {
+		return
+	}
+	targets, err := storedFreezeTargets(db)
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to check epic freeze: %w", err))
+		return
+	}
+	rejectFrozen(db, targets)
+}
+
+// freezeTargetsOf returns the parents of the given rows of a guarded table
+func freezeTargetsOf(table string, rows reflect.Value) []freezeTarget {
+	rows = reflect.Indirect(rows)
+	var values []reflect.Value
+	switch rows.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rows.Len(); i++ {
+			values = append(values, reflect.Indirect(rows.Index(i)))
+		}
+	case reflect.Struct:
+		values = append(values, rows)
+	}
+
+	var targets []freezeTarget
+	for _, value := range values {
+		if parent, ok := freezeParents[table]; ok {
+			if id, ok := value.FieldByName(parent.field).Interface().(uuid.UUID); ok && id != uuid.Nil {
+				targets = append(targets, freezeTarget{EntityType: parent.entityType, EntityID: id})
+			}
+			continue
+		}
+		entityType, typeOK := value.FieldByName("EntityType").Interface().(models.EntityType)
+		entityID, idOK := value.FieldByName("EntityID").Interface().(uuid.UUID)
+		if typeOK && idOK && entityID != uuid.Nil {
+			targets = append(targets, freezeTarget{EntityType: entityType, EntityID: entityID})
+		}
+	}
+	return targets
+}
+
+// storedFreezeTargets returns the parents of the stored rows a statement changes: those matching its WHERE clause,
+// or the row of its single entity
+func storedFreezeTargets(db *gorm.DB) ([]freezeTarget, error) {
+	table := db.Statement.Schema.Table
+	query := statementConnection(db).Model(reflect.New(db.Statement.Schema.ModelType).Interface())
+	if where, ok := db.Statement.Clauses["WHERE"]; ok {
+		query = query.Clauses(where.Expression)
+	} else if id, ok := freezeRowID(db.Statement.ReflectValue); ok {
+		query = query.Where("id = ?", id)
+	} else {
+		return freezeTargetsOf(table, db.Statement.ReflectValue), nil
+	}
+
+	if parent, ok := freezeParents[table]; ok {
+		var ids []uuid.UUID
+		if err := query.Pluck(parent.column, &ids).Error; err != nil {
+			return nil, err
+		}
+		targets := make([]freezeTarget, 0, len(ids))
+		for _, id := range ids {
+			targets = append(targets, freezeTarget{EntityType: parent.entityType, EntityID: id})
+		}
+		return targets, nil
+	}
+	var targets []freezeTarget
+	err := query.Select("entity_type", "entity_id").Find(&targets).Error
+	return targets, err
+}
+
+// freezeRowID returns the ID of a single entity with an ID field
+func freezeRowID(value reflect.Value) (uuid.UUID, bool) {
+	value = reflect.Indirect(value)
+	if value.Kind() != reflect.Struct {
+		return uuid.Nil, false
+	}
+	field := value.FieldByName("ID")
+	if !field.IsValid() {
+		return uuid.Nil, false
+	}
+	id, ok := field.Interface().(uuid.UUID)
+	return id, ok && id != uuid.Nil
+}
+
+// updatedParentID returns the parent an update moves rows to, if it sets one
+func updatedParentID(dest interface{}, parent freezeParent) (uuid.UUID, bool) {
+	var value interface{}
+	switch updates := dest.(type) {
+	case map[string]interface{}:
+		if v, ok := updates[parent.column]; ok {
+			value = v
+		} else {
+			value = updates[parent.field]
+		}
+	default:
+		field := reflect.Indirect(reflect.ValueOf(dest))
+		if field.Kind() != reflect.Struct {
+			return uuid.Nil, false
+		}
+		if field = field.FieldByName(parent.field); !field.IsValid() {
+			return uuid.Nil, false
+		}
+		value = field.Interface()
+	}
+
+	switch id := value.(type) {
+	case uuid.UUID:
+		return id, id != uuid.Nil
+	case *uuid.UUID:
+		if id != nil {
+			return *id, *id != uuid.Nil
+		}
+	case string:
+		parsed, err := uuid.Parse(id)
+		return parsed, err == nil
+	}
+	return uuid.Nil, false
+}
+
+// checkFrozenEpicMove rejects updates that move frozen epics to another parent epic
+func checkFrozenEpicMove(db *gorm.DB) {
+	var newParent *uuid.UUID
+	switch updates := db.Statement.Dest.(type) {
+	case map[string]interface{}:
+		value, ok := updates["parent_epic_id"]
+		if !ok {
+			if value, ok = updates["ParentEpicID"]; !ok {
+				return
+			}
+		}
+		switch id := value.(type) {
+		case uuid.UUID:
+			newParent = &id
+		case *uuid.UUID:
+			newParent = id
+		}
+	case *models.Epic:
+		newParent = updates.ParentEpicID
+	default:
+		return
+	}
+
+	query := statementConnection(db).Model(&models.Epic{}).Where("frozen = ?", true)
+	if where, ok := db.Statement.Clauses["WHERE"]; ok {
+		query = query.Clauses(where.Expression)
+	} else if id, ok := freezeRowID(db.Statement.ReflectValue); ok {
+		query = query.Where("id = ?", id)
+	} else {
+		return
+	}
+	var epics []models.Epic
+	if err := query.Find(&epics).Error; err != nil {
+		db.AddError(fmt.Errorf("failed to check epic freeze: %w", err))
+		return
+	}
+	for i := range epics {
+		if !sameParentEpic(epics[i].ParentEpicID, newParent) {
+			rejectChange(db, &epics[i])
+			return
+		}
+	}
+}
+
+// sameParentEpic reports whether two optional parent epic IDs are equal
+func sameParentEpic(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// rejectFrozen rejects the statement if the epic of any of the targets is frozen
+func rejectFrozen(db *gorm.DB, targets []freezeTarget) {
+	if len(targets) == 0 {
+		return
+	}
+	tx := statementConnection(db)
+	epicIDs, err := owningEpicIDs(tx, targets)
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to check epic freeze: %w", err))
+		return
+	}
+	if len(epicIDs) == 0 {
+		return
+	}
+
+	var epics []models.Epic
+	if err := tx.Where("id IN ? AND frozen = ?", epicIDs, true).Limit(1).Find(&epics).Error; err != nil {
+		db.AddError(fmt.Errorf("failed to check epic freeze: %w", err))
+		return
+	}
+	if len(epics) > 0 {
+		rejectChange(db, &epics[0])
+	}
+}
+
+// rejectChange fails the statement with the freeze of an epic and remembers it for the request
+func rejectChange(db *gorm.DB, epic *models.Epic) {
+	rejection := &EpicFrozenError{
+		EpicID:      epic.ID,
+		ReferenceID: epic.ReferenceID,
+		FrozenBy:    epic.FrozenBy,
+		FrozenAt:    epic.FrozenAt,
+	}
+	if epic.FreezeReason != nil {
+		rejection.Reason = *epic.FreezeReason
+	}
+	if recorder, ok := db.Statement.Context.Value(freezeRecorderKey{}).(*FreezeRecorder); ok {
+		recorder.mu.Lock()
+		recorder.rejected = rejection
+		recorder.mu.Unlock()
+	}
+	db.AddError(rejection)
+}
+
+// owningEpicIDs returns the IDs of the epics the targets belong to
+func owningEpicIDs(tx *gorm.DB, targets []freezeTarget) ([]uuid.UUID, error) {
+	ids := make(map[models.EntityType][]uuid.UUID)
+	for _, target := range targets {
+		ids[target.EntityType] = append(ids[target.EntityType], target.EntityID)
+	}
+
+	epicIDs := ids[models.EntityTypeEpic]
+	if storyIDs := ids[models.EntityTypeUserStory]; len(storyIDs) > 0 {
+		var found []uuid.UUID
+		if err := tx.Model(&models.UserStory{}).Where("id IN ?", storyIDs).Pluck("epic_id", &found).Error; err != nil {
+			return nil, err
+		}
+		epicIDs = append(epicIDs, found...)
+	}
+	for entityType, table := range map[models.EntityType]string{
+		models.EntityTypeAcceptanceCriteria: "acceptance_criteria",
+		models.EntityTypeRequirement:        "requirements",
+	} {
+		if len(ids[entityType]) == 0 {
+			continue
+		}
+		var found []uuid.UUID
+		err := tx.Table(table).Joins("JOIN user_stories ON user_stories.id = "+table+".user_story_id").
+			Where(table+".id IN ?", ids[entityType]).Pluck("user_stories.epic_id", &found).Error
+		if err != nil {
+			return nil, err
+		}
+		epicIDs = append(epicIDs, found...)
+	}
+	return epicIDs, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func TestFreezeCallbacks(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.RequirementAcceptanceCriteria{}, &models.EntityTranslation{}))
+	require.NoError(t, RegisterFreezeCallbacks(db))
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	user := &models.User{ID: uuid.New(), Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, session.Create(user).Error)
+	newEpic := func(reference string) *models.Epic {
+		epic := &models.Epic{ID: uuid.New(), ReferenceID: reference, Title: reference, Status: models.EpicStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+		require.NoError(t, session.Create(epic).Error)
+		return epic
+	}
+	frozen, open, parent := newEpic("EP-001"), newEpic("EP-002"), newEpic("EP-003")
+	story := &models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: frozen.ID, Title: "Sign up", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	openStory := &models.UserStory{ID: uuid.New(), ReferenceID: "US-002", EpicID: open.ID, Title: "Sign in", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create([]*models.UserStory{story, openStory}).Error)
+	ac := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: story.ID, AuthorID: user.ID, Description: "WHEN the form is submitted THEN the system SHALL create an account"}
+	require.NoError(t, session.Create(ac).Error)
+	requirement := &models.Requirement{ID: uuid.New(), ReferenceID: "REQ-001", UserStoryID: story.ID, Title: "Password rule", Status: models.RequirementStatusActive, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(requirement).Error)
+	require.NoError(t, session.Create(&models.RequirementAcceptanceCriteria{RequirementID: requirement.ID, AcceptanceCriteriaID: ac.ID}).Error)
+
+	reason := "Release 2.4 stabilization"
+	require.NoError(t, db.Model(frozen).Updates(map[string]interface{}{"frozen": true, "freeze_reason": reason}).Error)
+
+	t.Run("rejects changes to the children of frozen epics", func(t *testing.T) {
+		recorder := &FreezeRecorder{}
+		requirement.Title = "Password length rule"
+		err := NewRequirementRepository(db).Update(WithFreezeRecorder(ctx, recorder), requirement)
+		var frozenErr *EpicFrozenError
+		require.ErrorAs(t, err, &frozenErr)
+		assert.ErrorIs(t, err, ErrEpicFrozen)
+		assert.Equal(t, frozen.ID, frozenErr.EpicID)
+		assert.Equal(t, "EP-001", frozenErr.ReferenceID)
+		assert.Equal(t, reason, frozenErr.Reason)
+		assert.Same(t, frozenErr, recorder.Rejected(), "the rejection is recorded for the request")
+
+		created := &models.Requirement{ID: uuid.New(), ReferenceID: "REQ-002", UserStoryID: story.ID, Title: "Lockout", Status: models.RequirementStatusDraft, CreatorID: user.ID, AssigneeID: user.ID}
+		assert.ErrorIs(t, session.WithContext(ctx).Create(created).Error, ErrEpicFrozen)
+		assert.ErrorIs(t, db.WithContext(ctx).Model(&models.AcceptanceCriteria{}).Where("user_story_id = ?", story.ID).Update("description", "Changed").Error, ErrEpicFrozen)
+		assert.ErrorIs(t, db.WithContext(ctx).Where("requirement_id = ?", requirement.ID).Delete(&models.RequirementAcceptanceCriteria{}).Error, ErrEpicFrozen)
+		assert.ErrorIs(t, db.WithContext(ctx).Delete(&models.UserStory{}, "id = ?", story.ID).Error, ErrEpicFrozen)
+
+		title := "Правило пароля"
+		translation := &models.EntityTranslation{EntityType: models.EntityTypeRequirement, EntityID: requirement.ID, Locale: "ru", Title: &title, UpdatedBy: user.ID}
+		assert.ErrorIs(t, db.WithContext(ctx).Create(translation).Error, ErrEpicFrozen)
+	})
+
+	t.Run("rejects moves into and out of frozen epics", func(t *testing.T) {
+		assert.ErrorIs(t, db.WithContext(ctx).Model(&models.UserStory{}).Where("id = ?", openStory.ID).Update("epic_id", frozen.ID).Error, ErrEpicFrozen)
+		assert.ErrorIs(t, db.WithContext(ctx).Model(&models.Requirement{}).Where("id = ?", requirement.ID).Update("user_story_id", openStory.ID).Error, ErrEpicFrozen)
+	})
+
+	t.Run("rejects moves of frozen epics in the hierarchy", func(t *testing.T) {
+		var stored models.Epic
+		require.NoError(t, db.First(&stored, "id = ?", frozen.ID).Error)
+		stored.ParentEpicID = &parent.ID
+		assert.ErrorIs(t, NewEpicRepository(db).Update(ctx, &stored), ErrEpicFrozen)
+
+		stored.ParentEpicID = nil
+		stored.Title = "Accounts"
+		assert.NoError(t, NewEpicRepository(db).Update(ctx, &stored), "the frozen epic itself can still be edited")
+	})
+
+	t.Run("lets changes of other epics and exempt changes through", func(t *testing.T) {
+		openStory.Title = "Sign in with a passkey"
+		assert.NoError(t, NewUserStoryRepository(db, nil).Update(ctx, openStory))
+
+		requirement.Title = "Password length rule"
+		assert.NoError(t, NewRequirementRepository(db).Update(WithFreezeExemption(ctx), requirement))
+	})
+}
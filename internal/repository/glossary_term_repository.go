@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// glossaryTermRepository implements GlossaryTermRepository interface
+type glossaryTermRepository struct {
+	*BaseRepository[models.GlossaryTerm]
+}
+
+// NewGlossaryTermRepository creates a new glossary term repository instance
+func NewGlossaryTermRepository(db *gorm.DB) GlossaryTermRepository {
+	return &glossaryTermRepository{
+		BaseRepository: NewBaseRepository[models.GlossaryTerm](db),
+	}
+}
+
+// GetByTerm retrieves a glossary term by its text (case-insensitive)
+func (r *glossaryTermRepository) GetByTerm(ctx context.Context, term string) (*models.GlossaryTerm, error) {
+	var glossaryTerm models.GlossaryTerm
+	if err := r.GetDB().WithContext(ctx).Where("LOWER(term) = ?", strings.ToLower(term)).First(&glossaryTerm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, r.handleDBError(err)
+	}
+	return &glossaryTerm, nil
+}
+
+// ListAll retrieves all glossary terms ordered alphabetically
+func (r *glossaryTermRepository) ListAll(ctx context.Context) ([]models.GlossaryTerm, error) {
+	var terms []models.GlossaryTerm
+	if err := r.GetDB().WithContext(ctx).Order("LOWER(term) ASC").Find(&terms).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return terms, nil
+}
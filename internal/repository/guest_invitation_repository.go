@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// guestInvitationRepository implements GuestInvitationRepository interface
+type guestInvitationRepository struct {
+	db *gorm.DB
+}
+
+// NewGuestInvitationRepository creates a new guest invitation repository instance
+func NewGuestInvitationRepository(db *gorm.DB) GuestInvitationRepository {
+	return &guestInvitationRepository{db: db}
+}
+
+// Create stores a new guest invitation
+func (r *guestInvitationRepository) Create(ctx context.Context, invitation *models.GuestInvitation) error {
+	if err := r.db.WithContext(ctx).Omit("Epic", "Guest", "Inviter").Create(invitation).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetByID retrieves an invitation with its epic, guest and inviter
+func (r *guestInvitationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.GuestInvitation, error) {
+	var invitation models.GuestInvitation
+	if err := r.db.WithContext(ctx).Preload("Epic").Preload("Guest").Preload("Inviter").First(&invitation, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &invitation, nil
+}
+
+// GetByTokenHash retrieves the invitation matching a token hash with its epic and guest
+func (r *guestInvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*models.GuestInvitation, error) {
+	var invitation models.GuestInvitation
+	if err := r.db.WithContext(ctx).Preload("Epic").Preload("Guest").Where("token_hash = ?", tokenHash).First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &invitation, nil
+}
+
+// ListByEpic retrieves the invitations of an epic with their guests and inviters, newest first
+func (r *guestInvitationRepository) ListByEpic(ctx context.Context, epicID uuid.UUID) ([]models.GuestInvitation, error) {
+	var invitations []models.GuestInvitation
+	err := r.db.WithContext(ctx).Preload("Guest").Preload("Inviter").
+		Where("epic_id = ?", epicID).
+		Order("created_at DESC, id").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return invitations, nil
+}
+
+// MarkAccepted records when the guest first signed in with an invitation; later sign-ins keep the first time
+func (r *guestInvitationRepository) MarkAccepted(ctx context.Context, id uuid.UUID, at time.Time) error {
+	err := r.db.WithContext(ctx).Model(&models.GuestInvitation{}).
+		Where("id = ? AND accepted_at IS NULL", id).
+		Update("accepted_at", at).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// Revoke records the revocation of an invitation; returns ErrNotFound if it does not exist or is already revoked
+func (r *guestInvitationRepository) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result := r.db.WithContext(ctx).Model(&models.GuestInvitation{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// IsGuest reports whether a user account was created for a guest invitation
+func (r *guestInvitationRepository) IsGuest(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.GuestInvitation{}).Where("guest_id = ?", userID).Count(&count).Error; err != nil {
+		return false, handleDBError(err)
+	}
+	return count > 0, nil
+}
+
+// DeactivateExpiredGuests deactivates the active guest accounts none of whose invitations still grants access,
+// and returns how many were deactivated. The accounts are kept so that their comments keep their author.
+func (r *guestInvitationRepository) DeactivateExpiredGuests(ctx context.Context, now time.Time) (int64, error) {
+	expiredGuests := r.db.WithContext(ctx).Model(&models.GuestInvitation{}).
+		Select("guest_id").
+		Group("guest_id").
+		Having("COUNT(CASE WHEN revoked_at IS NULL AND expires_at > ? THEN 1 END) = 0", now)
+
+	result := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("deactivated_at IS NULL AND id IN (?)", expiredGuests).
+		Update("deactivated_at", now)
+	if result.Error != nil {
+		return 0, handleDBError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
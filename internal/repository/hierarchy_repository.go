@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// hierarchyRepository implements HierarchyRepository interface
+type hierarchyRepository struct {
+	db *gorm.DB
+}
+
+// NewHierarchyRepository creates a new hierarchy repository instance
+func NewHierarchyRepository(db *gorm.DB) HierarchyRepository {
+	return &hierarchyRepository{db: db}
+}
+
+// epicNodeColumns selects an epic as a hierarchy node counting its child epics and user stories
+const epicNodeColumns = "epics.id, epics.reference_id, epics.title, epics.status, " +
+	"(SELECT COUNT(*) FROM epics AS child_epics WHERE child_epics.parent_epic_id = epics.id) + " +
+	"(SELECT COUNT(*) FROM user_stories WHERE user_stories.epic_id = epics.id) AS children_count"
+
+// epicTreeQuery selects the IDs of an epic and all epics nested under it
+const epicTreeQuery = `WITH RECURSIVE epic_tree(id) AS (
+	SELECT id FROM epics WHERE id = ?
+	UNION
+	SELECT epics.id FROM epics JOIN epic_tree ON epics.parent_epic_id = epic_tree.id
+) SELECT id FROM epic_tree`
+
+// epicAncestorsQuery selects the epics an epic is nested under, the root first. The parents cannot form a cycle,
+// a trigger rejects them (see migration 000057); the depth limit only bounds the recursion of databases without it.
+const epicAncestorsQuery = `WITH RECURSIVE ancestors(id, parent_epic_id, depth) AS (
+	SELECT id, parent_epic_id, 0 FROM epics WHERE id = ?
+	UNION ALL
+	SELECT epics.id, epics.parent_epic_id, ancestors.depth + 1 FROM epics JOIN ancestors ON epics.id = ancestors.parent_epic_id
+	WHERE ancestors.depth < 100
+) SELECT epics.* FROM epics JOIN ancestors ON epics.id = ancestors.id WHERE ancestors.depth > 0 ORDER BY ancestors.depth DESC`
+
+// ListEpicNodes retrieves a page of top-level epics with their child epic and user story counts, oldest first
+func (r *hierarchyRepository) ListEpicNodes(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]HierarchyNodeRow, int64, error) {
+	query := r.db.WithContext(ctx).Table("epics").Where("epics.parent_epic_id IS NULL")
+	for field, value := range filters {
+		query = query.Where("epics."+field+" = ?", value)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+
+	var rows []HierarchyNodeRow
+	err := query.
+		Select(epicNodeColumns).
+		Order("epics.created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	return rows, total, nil
+}
+
+// ListChildEpicNodes retrieves the epics nested directly under an epic with their child epic and user story counts
+func (r *hierarchyRepository) ListChildEpicNodes(ctx context.Context, parentEpicID uuid.UUID) ([]HierarchyNodeRow, error) {
+	var rows []HierarchyNodeRow
+	err := r.db.WithContext(ctx).Table("epics").
+		Select(epicNodeColumns).
+		Where("epics.parent_epic_id = ?", parentEpicID).
+		Order("epics.created_at ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return rows, nil
+}
+
+// ListEpicAncestors retrieves the epics an epic is nested under, the top-level epic first
+func (r *hierarchyRepository) ListEpicAncestors(ctx context.Context, epicID uuid.UUID) ([]models.Epic, error) {
+	var ancestors []models.Epic
+	if err := r.db.WithContext(ctx).Raw(epicAncestorsQuery, epicID).Scan(&ancestors).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return ancestors, nil
+}
+
+// ListEpicParents maps the given epics to the epics they are nested under; top-level epics are left out
+func (r *hierarchyRepository) ListEpicParents(ctx context.Context, epicIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	parents := make(map[uuid.UUID]uuid.UUID)
+	if len(epicIDs) == 0 {
+		return parents, nil
+	}
+
+	var epics []models.Epic
+	err := r.db.WithContext(ctx).Select("id", "parent_epic_id").
+		Where("id IN ? AND parent_epic_id IS NOT NULL", epicIDs).
+		Find(&epics).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	for _, epic := range epics {
+		parents[epic.ID] = *epic.ParentEpicID
+	}
+	return parents, nil
+}
+
+// ListUserStoryNodes retrieves the user stories of an epic with their acceptance criteria and requirement counts.
+// Requirements linked to acceptance criteria are counted under each of their acceptance criteria, not the user story.
+func (r *hierarchyRepository) ListUserStoryNodes(ctx context.Context, epicID uuid.UUID) ([]HierarchyNodeRow, error) {
+	var rows []HierarchyNodeRow
+	err := r.db.WithContext(ctx).Table("user_stories").
+		Select("user_stories.id, user_stories.reference_id, user_stories.title, user_stories.status, "+
+			"(SELECT COUNT(*) FROM acceptance_criteria WHERE acceptance_criteria.user_story_id = user_stories.id) + "+
+			"(SELECT COUNT(*) FROM requirements WHERE requirements.user_story_id = user_stories.id AND requirements.acceptance_criteria_id IS NULL) AS children_count").
+		Where("user_stories.epic_id = ?", epicID).
+		Order("user_stories.created_at ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return rows, nil
+}
+
+// ListAcceptanceCriteriaNodes retrieves the acceptance criteria of a user story with their linked requirement counts.
+// Acceptance criteria have no title; their description is used instead.
+func (r *hierarchyRepository) ListAcceptanceCriteriaNodes(ctx context.Context, userStoryID uuid.UUID) ([]HierarchyNodeRow, error) {
+	var rows []HierarchyNodeRow
+	err := r.db.WithContext(ctx).Table("acceptance_criteria").
+		Select("acceptance_criteria.id, acceptance_criteria.reference_id, acceptance_criteria.description AS title, "+
+			"(SELECT COUNT(*) FROM requirement_acceptance_criteria WHERE requirement_acceptance_criteria.acceptance_criteria_id = acceptance_criteria.id) AS children_count").
+		Where("acceptance_criteria.user_story_id = ?", userStoryID).
+		Order("acceptance_criteria.created_at ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return rows, nil
+}
+
+// ListRequirementNodes retrieves the requirements of a user story linked to the given acceptance criteria,
+// or the requirements not linked to any acceptance criteria when acceptanceCriteriaID is nil. A requirement
+// linked to several acceptance criteria is listed under each of them.
+func (r *hierarchyRepository) ListRequirementNodes(ctx context.Context, userStoryID uuid.UUID, acceptanceCriteriaID *uuid.UUID) ([]HierarchyNodeRow, error) {
+	query := r.db.WithContext(ctx).Table("requirements").
+		Select("requirements.id, requirements.reference_id, requirements.title, requirements.status, 0 AS children_count").
+		Where("requirements.user_story_id = ?", userStoryID)
+	if acceptanceCriteriaID != nil {
+		query = query.Where(linkedToAcceptanceCriteria, *acceptanceCriteriaID)
+	} else {
+		query = query.Where("requirements.acceptance_criteria_id IS NULL")
+	}
+
+	var rows []HierarchyNodeRow
+	if err := query.Order("requirements.created_at ASC").Scan(&rows).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return rows, nil
+}
+
+// GetLastChange returns when a child of the entity, or of its descendants, was last created, updated or deleted,
+// or nil if none was recorded
+func (r *hierarchyRepository) GetLastChange(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*time.Time, error) {
+	var change models.HierarchyChange
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).First(&change).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return &change.ChangedAt, nil
+}
+
+// hierarchyEntriesQuery selects the hierarchy cache rows of all entities from the tables they are cached from
+const hierarchyEntriesQuery = `
+SELECT 'epic' AS entity_type, epics.id AS entity_id, epics.reference_id, epics.title, epics.status,
+	epics.id AS epic_id, NULL AS user_story_id, NULL AS acceptance_criteria_id, epics.created_at, epics.updated_at
+FROM epics
+UNION ALL
+SELECT 'user_story', user_stories.id, user_stories.reference_id, user_stories.title, user_stories.status,
+	user_stories.epic_id, user_stories.id, NULL, user_stories.created_at, user_stories.updated_at
+FROM user_stories
+UNION ALL
+SELECT 'acceptance_criteria', acceptance_criteria.id, acceptance_criteria.reference_id, acceptance_criteria.description, '',
+	user_stories.epic_id, acceptance_criteria.user_story_id, acceptance_criteria.id,
+	acceptance_criteria.created_at, acceptance_criteria.updated_at
+FROM acceptance_criteria JOIN user_stories ON user_stories.id = acceptance_criteria.user_story_id
+UNION ALL
+SELECT 'requirement', requirements.id, requirements.reference_id, requirements.title, requirements.status,
+	user_stories.epic_id, requirements.user_story_id, requirements.acceptance_criteria_id,
+	requirements.created_at, requirements.updated_at
+FROM requirements JOIN user_stories ON user_stories.id = requirements.user_story_id`
+
+// GetEntry retrieves the hierarchy cache row of an entity
+func (r *hierarchyRepository) GetEntry(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.HierarchyEntry, error) {
+	var entry models.HierarchyEntry
+	if err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).First(&entry).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &entry, nil
+}
+
+// ListEntriesByIDs retrieves the hierarchy cache rows of the given entities
+func (r *hierarchyRepository) ListEntriesByIDs(ctx context.Context, entityIDs []uuid.UUID) ([]models.HierarchyEntry, error) {
+	var entries []models.HierarchyEntry
+	if len(entityIDs) == 0 {
+		return entries, nil
+	}
+	if err := r.db.WithContext(ctx).Where("entity_id IN ?", entityIDs).Find(&entries).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return entries, nil
+}
+
+// ListSubtreeEntries retrieves the hierarchy cache rows of an entity and all of its descendants, oldest first.
+// The subtree of an epic holds the epics nested under it with their subtrees; the subtree of acceptance criteria
+// holds the requirements linked to it.
+func (r *hierarchyRepository) ListSubtreeEntries(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.HierarchyEntry, error) {
+	query := r.db.WithContext(ctx).Model(&models.HierarchyEntry{})
+	switch entityType {
+	case models.EntityTypeEpic:
+		query = query.Where("epic_id IN (?)", r.db.WithContext(ctx).Raw(epicTreeQuery, entityID))
+	case models.EntityTypeUserStory:
+		query = query.Where("user_story_id = ?", entityID)
+	case models.EntityTypeAcceptanceCriteria:
+		query = query.Where("acceptance_criteria_id = ?", entityID)
+	default:
+		query = query.Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+	}
+
+	var entries []models.HierarchyEntry
+	if err := query.Order("created_at ASC").Find(&entries).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return entries, nil
+}
+
+// ListEntries retrieves all hierarchy cache rows
+func (r *hierarchyRepository) ListEntries(ctx context.Context) ([]models.HierarchyEntry, error) {
+	var entries []models.HierarchyEntry
+	if err := r.db.WithContext(ctx).Find(&entries).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return entries, nil
+}
+
+// ComputeEntries builds the hierarchy cache rows from the tables they are cached from without storing them
+func (r *hierarchyRepository) ComputeEntries(ctx context.Context) ([]models.HierarchyEntry, error) {
+	var entries []models.HierarchyEntry
+	if err := r.db.WithContext(ctx).Raw(hierarchyEntriesQuery).Scan(&entries).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return entries, nil
+}
+
+// RebuildEntries replaces the hierarchy cache with rows built from the tables they are cached from
+// and returns the number of rows written
+func (r *hierarchyRepository) RebuildEntries(ctx context.Context) (int64, error) {
+	var written int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM hierarchy_entries").Error; err != nil {
+			return err
+		}
+		result := tx.Exec("INSERT INTO hierarchy_entries (entity_type, entity_id, reference_id, title, status, epic_id, " +
+			"user_story_id, acceptance_criteria_id, created_at, updated_at)" + hierarchyEntriesQuery)
+		written = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, handleDBError(err)
+	}
+	return written, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *hierarchyRepository) GetDB() *gorm.DB {
+	return r.db
+}
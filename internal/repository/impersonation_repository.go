@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// impersonationRepository implements ImpersonationRepository interface
+type impersonationRepository struct {
+	db *gorm.DB
+}
+
+// NewImpersonationRepository creates a new impersonation repository instance
+func NewImpersonationRepository(db *gorm.DB) ImpersonationRepository {
+	return &impersonationRepository{db: db}
+}
+
+// CreateSession records the start of an impersonation session
+func (r *impersonationRepository) CreateSession(ctx context.Context, session *models.ImpersonationSession) error {
+	return handleDBError(r.db.WithContext(ctx).Create(session).Error)
+}
+
+// GetSession retrieves an impersonation session with the administrator and the user preloaded
+func (r *impersonationRepository) GetSession(ctx context.Context, id uuid.UUID) (*models.ImpersonationSession, error) {
+	var session models.ImpersonationSession
+	if err := r.db.WithContext(ctx).Preload("Admin").Preload("User").Where("id = ?", id).First(&session).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &session, nil
+}
+
+// ListSessions retrieves the impersonation sessions matching the filter, newest first,
+// with the administrators and users preloaded
+func (r *impersonationRepository) ListSessions(ctx context.Context, filter ImpersonationSessionFilter) ([]models.ImpersonationSession, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.ImpersonationSession{})
+	if filter.AdminID != nil {
+		query = query.Where("admin_id = ?", *filter.AdminID)
+	}
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	var sessions []models.ImpersonationSession
+	if err := query.Preload("Admin").Preload("User").Order("started_at DESC, id ASC").
+		Limit(filter.Limit).Offset(filter.Offset).Find(&sessions).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	return sessions, total, nil
+}
+
+// CreateRequest records a request made in an impersonation session
+func (r *impersonationRepository) CreateRequest(ctx context.Context, request *models.ImpersonatedRequest) error {
+	return handleDBError(r.db.WithContext(ctx).Create(request).Error)
+}
+
+// ListRequests retrieves the requests made in an impersonation session, oldest first
+func (r *impersonationRepository) ListRequests(ctx context.Context, sessionID uuid.UUID, limit, offset int) ([]models.ImpersonatedRequest, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.ImpersonatedRequest{}).Where("session_id = ?", sessionID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	var requests []models.ImpersonatedRequest
+	if err := query.Order("created_at ASC, id ASC").Limit(limit).Offset(offset).Find(&requests).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	return requests, total, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,6 +27,43 @@ type (
 	PersonalAccessToken     = models.PersonalAccessToken
 	SteeringDocument        = models.SteeringDocument
 	RefreshToken            = models.RefreshToken
+	EntityPresence          = models.EntityPresence
+	EditLock                = models.EditLock
+	EntityDraft             = models.EntityDraft
+	EntityVersion           = models.EntityVersion
+	CommentCategory         = models.CommentCategory
+	AuditEvent              = models.AuditEvent
+	MCPToolCall             = models.MCPToolCall
+	DigestSubscription      = models.DigestSubscription
+	GlossaryTerm            = models.GlossaryTerm
+	AssignmentRule          = models.AssignmentRule
+	StalenessPolicy         = models.StalenessPolicy
+	StaleEntity             = models.StaleEntity
+	ReportSchedule          = models.ReportSchedule
+	APIQuota                = models.APIQuota
+	UserPreference          = models.UserPreference
+	ChangeProposal          = models.ChangeProposal
+	CommentModeration       = models.CommentModeration
+	CommentThreadLock       = models.CommentThreadLock
+	GuestInvitation         = models.GuestInvitation
+	CommentDraft            = models.CommentDraft
+	TestRun                 = models.TestRun
+	EntityVerification      = models.EntityVerification
+	ReferenceRedirect       = models.ReferenceRedirect
+	EntityTranslation       = models.EntityTranslation
+	SignOffStakeholder      = models.SignOffStakeholder
+	SignOff                 = models.SignOff
+	Incident                = models.Incident
+	IncidentUpdate          = models.IncidentUpdate
+	MaintenanceWindow       = models.MaintenanceWindow
+	ImpersonationSession    = models.ImpersonationSession
+	ImpersonatedRequest     = models.ImpersonatedRequest
+	RecentView              = models.RecentView
+	Favorite                = models.Favorite
+	BoardRank               = models.BoardRank
+	CalendarFeed            = models.CalendarFeed
+	ChangeFeed              = models.ChangeFeed
+	EntityRelationship      = models.EntityRelationship
 	EpicStatus              = models.EpicStatus
 	UserStoryStatus         = models.UserStoryStatus
 	RequirementStatus       = models.RequirementStatus
@@ -35,185 +73,228 @@ type (
 
 // Repository defines the common interface for all repositories
 type Repository[T any] interface {
-	Create(entity *T) error
-	GetByID(id uuid.UUID) (*T, error)
-	GetByReferenceID(referenceID string) (*T, error)
-	GetByReferenceIDCaseInsensitive(referenceID string) (*T, error)
-	Update(entity *T) error
-	Delete(id uuid.UUID) error
-	List(filters map[string]interface{}, orderBy string, limit, offset int) ([]T, error)
-	Count(filters map[string]interface{}) (int64, error)
-	Exists(id uuid.UUID) (bool, error)
-	ExistsByReferenceID(referenceID string) (bool, error)
-	WithTransaction(fn func(*gorm.DB) error) error
+	Create(ctx context.Context, entity *T) error
+	GetByID(ctx context.Context, id uuid.UUID) (*T, error)
+	GetByReferenceID(ctx context.Context, referenceID string) (*T, error)
+	GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*T, error)
+	Update(ctx context.Context, entity *T) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]T, error)
+	Count(ctx context.Context, filters map[string]interface{}) (int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error)
+	WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error
 	GetDB() *gorm.DB
 }
 
 // UserRepository defines user-specific repository operations
 type UserRepository interface {
 	Repository[User]
-	GetByUsername(username string) (*User, error)
-	GetByEmail(email string) (*User, error)
-	ExistsByUsername(username string) (bool, error)
-	ExistsByEmail(email string) (bool, error)
+	GetByUsername(ctx context.Context, username string) (*User, error)
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	ExistsByUsername(ctx context.Context, username string) (bool, error)
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
 }
 
 // EpicRepository defines epic-specific repository operations
 type EpicRepository interface {
 	Repository[Epic]
-	GetWithUserStories(id uuid.UUID) (*Epic, error)
-	GetByCreator(creatorID uuid.UUID) ([]Epic, error)
-	GetByAssignee(assigneeID uuid.UUID) ([]Epic, error)
-	GetByStatus(status EpicStatus) ([]Epic, error)
-	GetByPriority(priority Priority) ([]Epic, error)
-	HasUserStories(id uuid.UUID) (bool, error)
-	GetByIDWithUsers(id uuid.UUID) (*Epic, error)
-	GetByReferenceIDWithUsers(referenceID string) (*Epic, error)
-	GetByReferenceIDWithUsersCaseInsensitive(referenceID string) (*Epic, error)
-	ListWithIncludes(filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]Epic, error)
-	GetCompleteHierarchy(id uuid.UUID) (*Epic, error)
+	GetWithUserStories(ctx context.Context, id uuid.UUID) (*Epic, error)
+	GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]Epic, error)
+	GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]Epic, error)
+	GetByStatus(ctx context.Context, status EpicStatus) ([]Epic, error)
+	GetByPriority(ctx context.Context, priority Priority) ([]Epic, error)
+	HasUserStories(ctx context.Context, id uuid.UUID) (bool, error)
+	GetByIDWithUsers(ctx context.Context, id uuid.UUID) (*Epic, error)
+	GetByReferenceIDWithUsers(ctx context.Context, referenceID string) (*Epic, error)
+	GetByReferenceIDWithUsersCaseInsensitive(ctx context.Context, referenceID string) (*Epic, error)
+	ListWithIncludes(ctx context.Context, filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]Epic, error)
+	GetCompleteHierarchy(ctx context.Context, id uuid.UUID) (*Epic, error)
+	LockForUpdate(ctx context.Context, ids ...uuid.UUID) ([]Epic, error)
+	LockNesting(ctx context.Context) error
 }
 
 // UserStoryRepository defines user story-specific repository operations
 type UserStoryRepository interface {
 	Repository[UserStory]
-	GetWithAcceptanceCriteria(id uuid.UUID) (*UserStory, error)
-	GetWithRequirements(id uuid.UUID) (*UserStory, error)
-	GetByEpic(epicID uuid.UUID) ([]UserStory, error)
-	GetByCreator(creatorID uuid.UUID) ([]UserStory, error)
-	GetByAssignee(assigneeID uuid.UUID) ([]UserStory, error)
-	GetByStatus(status UserStoryStatus) ([]UserStory, error)
-	GetByPriority(priority Priority) ([]UserStory, error)
-	HasAcceptanceCriteria(id uuid.UUID) (bool, error)
-	HasRequirements(id uuid.UUID) (bool, error)
-	GetByIDWithUsers(id uuid.UUID) (*UserStory, error)
-	GetByReferenceIDWithUsers(referenceID string) (*UserStory, error)
-	GetByReferenceIDWithUsersCaseInsensitive(referenceID string) (*UserStory, error)
-	ListWithIncludes(filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]UserStory, error)
-	GetUUIDByReferenceID(referenceID string) (uuid.UUID, error)
+	GetWithAcceptanceCriteria(ctx context.Context, id uuid.UUID) (*UserStory, error)
+	GetWithRequirements(ctx context.Context, id uuid.UUID) (*UserStory, error)
+	GetByEpic(ctx context.Context, epicID uuid.UUID) ([]UserStory, error)
+	GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]UserStory, error)
+	GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]UserStory, error)
+	GetByStatus(ctx context.Context, status UserStoryStatus) ([]UserStory, error)
+	GetByPriority(ctx context.Context, priority Priority) ([]UserStory, error)
+	HasAcceptanceCriteria(ctx context.Context, id uuid.UUID) (bool, error)
+	HasRequirements(ctx context.Context, id uuid.UUID) (bool, error)
+	GetByIDWithUsers(ctx context.Context, id uuid.UUID) (*UserStory, error)
+	GetByReferenceIDWithUsers(ctx context.Context, referenceID string) (*UserStory, error)
+	GetByReferenceIDWithUsersCaseInsensitive(ctx context.Context, referenceID string) (*UserStory, error)
+	ListWithIncludes(ctx context.Context, filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]UserStory, error)
+	GetUUIDByReferenceID(ctx context.Context, referenceID string) (uuid.UUID, error)
 }
 
 // AcceptanceCriteriaRepository defines acceptance criteria-specific repository operations
 type AcceptanceCriteriaRepository interface {
 	Repository[AcceptanceCriteria]
-	GetByUserStory(userStoryID uuid.UUID) ([]AcceptanceCriteria, error)
-	GetByAuthor(authorID uuid.UUID) ([]AcceptanceCriteria, error)
-	HasRequirements(id uuid.UUID) (bool, error)
-	CountByUserStory(userStoryID uuid.UUID) (int64, error)
-	GetByIDWithPreloads(id uuid.UUID) (*AcceptanceCriteria, error)
-	GetByReferenceIDWithPreloads(referenceID string) (*AcceptanceCriteria, error)
-	ListWithPreloads(filters map[string]interface{}, orderBy string, limit, offset int) ([]AcceptanceCriteria, error)
+	GetByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]AcceptanceCriteria, error)
+	GetByAuthor(ctx context.Context, authorID uuid.UUID) ([]AcceptanceCriteria, error)
+	HasRequirements(ctx context.Context, id uuid.UUID) (bool, error)
+	CountByUserStory(ctx context.Context, userStoryID uuid.UUID) (int64, error)
+	GetByIDWithPreloads(ctx context.Context, id uuid.UUID) (*AcceptanceCriteria, error)
+	GetByReferenceIDWithPreloads(ctx context.Context, referenceID string) (*AcceptanceCriteria, error)
+	ListWithPreloads(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]AcceptanceCriteria, error)
 }
 
 // RequirementRepository defines requirement-specific repository operations
 type RequirementRepository interface {
 	Repository[Requirement]
-	GetWithRelationships(id uuid.UUID) (*Requirement, error)
-	GetByUserStory(userStoryID uuid.UUID) ([]Requirement, error)
-	GetByAcceptanceCriteria(acceptanceCriteriaID uuid.UUID) ([]Requirement, error)
-	GetByCreator(creatorID uuid.UUID) ([]Requirement, error)
-	GetByAssignee(assigneeID uuid.UUID) ([]Requirement, error)
-	GetByStatus(status RequirementStatus) ([]Requirement, error)
-	GetByPriority(priority Priority) ([]Requirement, error)
-	GetByType(typeID uuid.UUID) ([]Requirement, error)
-	HasRelationships(id uuid.UUID) (bool, error)
-	SearchByText(searchText string) ([]Requirement, error)
-	SearchByTextWithPagination(searchText string, limit, offset int) ([]Requirement, int64, error)
-	GetByIDWithPreloads(id uuid.UUID) (*Requirement, error)
-	GetByReferenceIDWithPreloads(referenceID string) (*Requirement, error)
-	ListWithPreloads(filters map[string]interface{}, orderBy string, limit, offset int) ([]Requirement, error)
+	GetWithRelationships(ctx context.Context, id uuid.UUID) (*Requirement, error)
+	GetByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]Requirement, error)
+	GetByAcceptanceCriteria(ctx context.Context, acceptanceCriteriaID uuid.UUID) ([]Requirement, error)
+	GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]Requirement, error)
+	GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]Requirement, error)
+	GetByStatus(ctx context.Context, status RequirementStatus) ([]Requirement, error)
+	GetByPriority(ctx context.Context, priority Priority) ([]Requirement, error)
+	GetByType(ctx context.Context, typeID uuid.UUID) ([]Requirement, error)
+	HasRelationships(ctx context.Context, id uuid.UUID) (bool, error)
+	SearchByText(ctx context.Context, searchText string) ([]Requirement, error)
+	SearchByTextWithPagination(ctx context.Context, searchText string, limit, offset int) ([]Requirement, int64, error)
+	GetByIDWithPreloads(ctx context.Context, id uuid.UUID) (*Requirement, error)
+	GetByReferenceIDWithPreloads(ctx context.Context, referenceID string) (*Requirement, error)
+	ListWithPreloads(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]Requirement, error)
+	GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]AcceptanceCriteria, error)
+	LinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error
+	UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error
 }
 
 // RequirementTypeRepository defines requirement type-specific repository operations
 type RequirementTypeRepository interface {
 	Repository[RequirementType]
-	GetByName(name string) (*RequirementType, error)
-	ExistsByName(name string) (bool, error)
+	GetByName(ctx context.Context, name string) (*RequirementType, error)
+	ExistsByName(ctx context.Context, name string) (bool, error)
 }
 
 // RelationshipTypeRepository defines relationship type-specific repository operations
 type RelationshipTypeRepository interface {
 	Repository[RelationshipType]
-	GetByName(name string) (*RelationshipType, error)
-	ExistsByName(name string) (bool, error)
+	GetByName(ctx context.Context, name string) (*RelationshipType, error)
+	ExistsByName(ctx context.Context, name string) (bool, error)
+	CountEntityRelationships(ctx context.Context, typeID uuid.UUID) (int64, error)
 }
 
 // RequirementRelationshipRepository defines requirement relationship-specific repository operations
 type RequirementRelationshipRepository interface {
 	Repository[RequirementRelationship]
-	GetBySourceRequirement(sourceID uuid.UUID) ([]RequirementRelationship, error)
-	GetByTargetRequirement(targetID uuid.UUID) ([]RequirementRelationship, error)
-	GetByRequirement(requirementID uuid.UUID) ([]RequirementRelationship, error)
-	GetByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]RequirementRelationship, int64, error)
-	GetByType(typeID uuid.UUID) ([]RequirementRelationship, error)
-	ExistsRelationship(sourceID, targetID, typeID uuid.UUID) (bool, error)
+	GetBySourceRequirement(ctx context.Context, sourceID uuid.UUID) ([]RequirementRelationship, error)
+	GetByTargetRequirement(ctx context.Context, targetID uuid.UUID) ([]RequirementRelationship, error)
+	GetByRequirement(ctx context.Context, requirementID uuid.UUID) ([]RequirementRelationship, error)
+	GetByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]RequirementRelationship, int64, error)
+	GetByType(ctx context.Context, typeID uuid.UUID) ([]RequirementRelationship, error)
+	ExistsRelationship(ctx context.Context, sourceID, targetID, typeID uuid.UUID) (bool, error)
+	ListByRequirements(ctx context.Context, requirementIDs []uuid.UUID) ([]RequirementRelationship, error)
+	ListGraphNodes(ctx context.Context, filters map[string]interface{}) ([]RequirementGraphNode, error)
+}
+
+// RequirementGraphNode is a requirement with the user story and epic it belongs to
+type RequirementGraphNode struct {
+	ID                   uuid.UUID
+	ReferenceID          string
+	Title                string
+	Status               string
+	UserStoryID          uuid.UUID
+	UserStoryReferenceID string
+	UserStoryTitle       string
+	EpicID               uuid.UUID
+}
+
+// CommentFilters defines filtering options for cross-entity comment queries
+type CommentFilters struct {
+	EntityType *EntityType
+	Category   *CommentCategory
+	IsResolved *bool
+	AuthorID   *uuid.UUID
+	// EntityAssigneeID limits comments to entities assigned to this user
+	// Acceptance criteria are matched through the assignee of their user story
+	EntityAssigneeID *uuid.UUID
+	Limit            int
+	Offset           int
 }
 
 // CommentRepository defines comment-specific repository operations
 type CommentRepository interface {
 	Repository[Comment]
-	GetByEntity(entityType EntityType, entityID uuid.UUID) ([]Comment, error)
-	GetByAuthor(authorID uuid.UUID) ([]Comment, error)
-	GetByParent(parentID uuid.UUID) ([]Comment, error)
-	GetByParentWithPagination(parentID uuid.UUID, limit, offset int) ([]Comment, int64, error)
-	GetThreaded(entityType EntityType, entityID uuid.UUID) ([]Comment, error)
-	GetByStatus(isResolved bool) ([]Comment, error)
-	GetInlineComments(entityType EntityType, entityID uuid.UUID) ([]Comment, error)
+	GetByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]Comment, error)
+	GetByAuthor(ctx context.Context, authorID uuid.UUID) ([]Comment, error)
+	GetByParent(ctx context.Context, parentID uuid.UUID) ([]Comment, error)
+	GetByParentWithPagination(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]Comment, int64, error)
+	GetThreaded(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]Comment, error)
+	GetByStatus(ctx context.Context, isResolved bool) ([]Comment, error)
+	GetInlineComments(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]Comment, error)
+	ListWithFilters(ctx context.Context, filters CommentFilters) ([]Comment, int64, error)
+	CountByCategory(ctx context.Context, entityType EntityType, entityID uuid.UUID) (map[CommentCategory]int64, error)
+	CountByEntities(ctx context.Context, entityType EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]CommentCounts, error)
+}
+
+// CommentCounts counts the comments of an entity
+type CommentCounts struct {
+	Total      int64 `json:"total"`
+	Unresolved int64 `json:"unresolved"`
 }
 
 // StatusModelRepository defines status model-specific repository operations
 type StatusModelRepository interface {
-	Create(statusModel *StatusModel) error
-	GetByID(id uuid.UUID) (*StatusModel, error)
-	GetByEntityTypeAndName(entityType EntityType, name string) (*StatusModel, error)
-	GetDefaultByEntityType(entityType EntityType) (*StatusModel, error)
-	Update(statusModel *StatusModel) error
-	Delete(id uuid.UUID) error
-	List(filters map[string]interface{}, orderBy string, limit, offset int) ([]StatusModel, error)
-	Count(filters map[string]interface{}) (int64, error)
-	ListByEntityType(entityType EntityType) ([]StatusModel, error)
-	Exists(id uuid.UUID) (bool, error)
-	ExistsByEntityTypeAndName(entityType EntityType, name string) (bool, error)
+	Create(ctx context.Context, statusModel *StatusModel) error
+	GetByID(ctx context.Context, id uuid.UUID) (*StatusModel, error)
+	GetByEntityTypeAndName(ctx context.Context, entityType EntityType, name string) (*StatusModel, error)
+	GetDefaultByEntityType(ctx context.Context, entityType EntityType) (*StatusModel, error)
+	Update(ctx context.Context, statusModel *StatusModel) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]StatusModel, error)
+	Count(ctx context.Context, filters map[string]interface{}) (int64, error)
+	ListByEntityType(ctx context.Context, entityType EntityType) ([]StatusModel, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByEntityTypeAndName(ctx context.Context, entityType EntityType, name string) (bool, error)
 }
 
 // StatusRepository defines status-specific repository operations
 type StatusRepository interface {
-	Create(status *Status) error
-	GetByID(id uuid.UUID) (*Status, error)
-	GetByStatusModelID(statusModelID uuid.UUID) ([]Status, error)
-	GetByName(statusModelID uuid.UUID, name string) (*Status, error)
-	Update(status *Status) error
-	Delete(id uuid.UUID) error
-	List(filters map[string]interface{}, orderBy string, limit, offset int) ([]Status, error)
-	CountByStatusModelID(statusModelID uuid.UUID) (int64, error)
-	Exists(id uuid.UUID) (bool, error)
-	ExistsByName(statusModelID uuid.UUID, name string) (bool, error)
+	Create(ctx context.Context, status *Status) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Status, error)
+	GetByStatusModelID(ctx context.Context, statusModelID uuid.UUID) ([]Status, error)
+	GetByName(ctx context.Context, statusModelID uuid.UUID, name string) (*Status, error)
+	Update(ctx context.Context, status *Status) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]Status, error)
+	CountByStatusModelID(ctx context.Context, statusModelID uuid.UUID) (int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByName(ctx context.Context, statusModelID uuid.UUID, name string) (bool, error)
+	UpdateOrder(ctx context.Context, statusModelID uuid.UUID, statusIDs []uuid.UUID) error
 }
 
 // StatusTransitionRepository defines status transition-specific repository operations
 type StatusTransitionRepository interface {
-	Create(transition *StatusTransition) error
-	GetByID(id uuid.UUID) (*StatusTransition, error)
-	GetByStatusModelID(statusModelID uuid.UUID) ([]StatusTransition, error)
-	GetByFromStatus(fromStatusID uuid.UUID) ([]StatusTransition, error)
-	Update(transition *StatusTransition) error
-	Delete(id uuid.UUID) error
-	List(filters map[string]interface{}, orderBy string, limit, offset int) ([]StatusTransition, error)
-	CountByStatusModelID(statusModelID uuid.UUID) (int64, error)
-	Exists(id uuid.UUID) (bool, error)
-	ExistsByTransition(statusModelID, fromStatusID, toStatusID uuid.UUID) (bool, error)
+	Create(ctx context.Context, transition *StatusTransition) error
+	GetByID(ctx context.Context, id uuid.UUID) (*StatusTransition, error)
+	GetByStatusModelID(ctx context.Context, statusModelID uuid.UUID) ([]StatusTransition, error)
+	GetByFromStatus(ctx context.Context, fromStatusID uuid.UUID) ([]StatusTransition, error)
+	Update(ctx context.Context, transition *StatusTransition) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]StatusTransition, error)
+	CountByStatusModelID(ctx context.Context, statusModelID uuid.UUID) (int64, error)
+	Exists(ctx context.Context, id uuid.UUID) (bool, error)
+	ExistsByTransition(ctx context.Context, statusModelID, fromStatusID, toStatusID uuid.UUID) (bool, error)
 }
 
 // PersonalAccessTokenRepository defines personal access token-specific repository operations
 type PersonalAccessTokenRepository interface {
 	Repository[PersonalAccessToken]
-	GetByUserID(userID uuid.UUID) ([]PersonalAccessToken, error)
-	GetByUserIDWithPagination(userID uuid.UUID, limit, offset int) ([]PersonalAccessToken, int64, error)
-	GetByUserIDWithPaginationAndPreloads(userID uuid.UUID, limit, offset int) ([]PersonalAccessToken, int64, error)
-	GetHashesByPrefix(prefix string) ([]PersonalAccessToken, error)
-	UpdateLastUsed(id uuid.UUID, lastUsedAt *time.Time) error
-	DeleteExpired() (int64, error)
-	ExistsByUserIDAndName(userID uuid.UUID, name string) (bool, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]PersonalAccessToken, error)
+	GetByUserIDWithPagination(ctx context.Context, userID uuid.UUID, limit, offset int) ([]PersonalAccessToken, int64, error)
+	GetByUserIDWithPaginationAndPreloads(ctx context.Context, userID uuid.UUID, limit, offset int) ([]PersonalAccessToken, int64, error)
+	GetHashesByPrefix(ctx context.Context, prefix string) ([]PersonalAccessToken, error)
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt *time.Time) error
+	DeleteExpired(ctx context.Context) (int64, error)
+	ExistsByUserIDAndName(ctx context.Context, userID uuid.UUID, name string) (bool, error)
 }
 
 // SteeringDocumentFilters defines filtering options for steering document queries
@@ -228,23 +309,655 @@ type SteeringDocumentFilters struct {
 // SteeringDocumentRepository defines steering document-specific repository operations
 type SteeringDocumentRepository interface {
 	Repository[SteeringDocument]
-	ListWithFilters(filters SteeringDocumentFilters) ([]SteeringDocument, int64, error)
-	Search(query string) ([]SteeringDocument, error)
-	GetByEpicID(epicID uuid.UUID) ([]SteeringDocument, error)
-	GetByEpicIDWithPagination(epicID uuid.UUID, limit, offset int) ([]SteeringDocument, int64, error)
-	LinkToEpic(steeringDocumentID, epicID uuid.UUID) error
-	UnlinkFromEpic(steeringDocumentID, epicID uuid.UUID) error
+	ListWithFilters(ctx context.Context, filters SteeringDocumentFilters) ([]SteeringDocument, int64, error)
+	Search(ctx context.Context, query string) ([]SteeringDocument, error)
+	GetByEpicID(ctx context.Context, epicID uuid.UUID) ([]SteeringDocument, error)
+	GetByEpicIDWithPagination(ctx context.Context, epicID uuid.UUID, limit, offset int) ([]SteeringDocument, int64, error)
+	LinkToEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID) error
+	UnlinkFromEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID) error
 }
 
 // RefreshTokenRepository defines refresh token-specific repository operations
 type RefreshTokenRepository interface {
-	Create(token *RefreshToken) error
-	FindByTokenHash(tokenHash string) (*RefreshToken, error)
-	FindByUserID(userID uuid.UUID) ([]*RefreshToken, error)
-	FindAll() ([]*RefreshToken, error)
-	Update(token *RefreshToken) error
-	Delete(id uuid.UUID) error
-	DeleteByUserID(userID uuid.UUID) error
-	DeleteExpired() (int64, error)
+	Create(ctx context.Context, token *RefreshToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*RefreshToken, error)
+	FindAll(ctx context.Context) ([]*RefreshToken, error)
+	Update(ctx context.Context, token *RefreshToken) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
+	DeleteExpired(ctx context.Context) (int64, error)
+	GetDB() *gorm.DB
+}
+
+// PresenceRepository defines presence and edit lock repository operations
+type PresenceRepository interface {
+	UpsertPresence(ctx context.Context, presence *EntityPresence) error
+	DeletePresence(ctx context.Context, entityType EntityType, entityID, userID uuid.UUID) error
+	GetActivePresence(ctx context.Context, entityType EntityType, entityID uuid.UUID, now time.Time) ([]EntityPresence, error)
+	GetLock(ctx context.Context, entityType EntityType, entityID uuid.UUID) (*EditLock, error)
+	CreateLock(ctx context.Context, lock *EditLock) error
+	UpdateLock(ctx context.Context, lock *EditLock) error
+	DeleteLock(ctx context.Context, entityType EntityType, entityID uuid.UUID) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+	GetDB() *gorm.DB
+}
+
+// DraftRepository defines entity draft repository operations
+type DraftRepository interface {
+	Get(ctx context.Context, entityType EntityType, entityID, userID uuid.UUID) (*EntityDraft, error)
+	Create(ctx context.Context, draft *EntityDraft) error
+	Update(ctx context.Context, draft *EntityDraft) error
+	Delete(ctx context.Context, entityType EntityType, entityID, userID uuid.UUID) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]EntityDraft, error)
+	GetDB() *gorm.DB
+}
+
+// EntityVersionRepository defines entity text version history operations
+type EntityVersionRepository interface {
+	Create(ctx context.Context, version *EntityVersion) error
+	GetLatest(ctx context.Context, entityType EntityType, entityID uuid.UUID) (*EntityVersion, error)
+	GetByNumber(ctx context.Context, entityType EntityType, entityID uuid.UUID, versionNumber int) (*EntityVersion, error)
+	ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]EntityVersion, error)
+	GetDB() *gorm.DB
+}
+
+// AuditCursor identifies a position in a chronological audit feed (newest first)
+type AuditCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// AuditRepository defines audit log operations
+type AuditRepository interface {
+	Create(ctx context.Context, event *AuditEvent) error
+	ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID, cursor *AuditCursor, limit int) ([]AuditEvent, error)
+	ListForUser(ctx context.Context, userID uuid.UUID, cursor *AuditCursor, limit int) ([]AuditEvent, error)
+	ListAssignedSince(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]AuditEvent, error)
+	ListRecent(ctx context.Context, limit int) ([]AuditEvent, error)
+	ListForEpic(ctx context.Context, epicID uuid.UUID, limit int) ([]AuditEvent, error)
+	CreateToolCall(ctx context.Context, call *MCPToolCall) error
+	SummarizeToolCalls(ctx context.Context, since time.Time) ([]MCPToolUsage, error)
+	SummarizeToolCallsByUser(ctx context.Context, since time.Time, limit int) ([]MCPUserUsage, error)
+	ListFailedToolCalls(ctx context.Context, since time.Time, limit int) ([]MCPToolCall, error)
+	GetDB() *gorm.DB
+}
+
+// MCPToolUsage aggregates the calls of one MCP tool
+type MCPToolUsage struct {
+	ToolName      string  `json:"tool_name" example:"create_requirement"`
+	Calls         int64   `json:"calls" example:"120"`
+	Failures      int64   `json:"failures" example:"3"`
+	AvgDurationMs float64 `json:"avg_duration_ms" example:"35.5"`
+	MaxDurationMs int64   `json:"max_duration_ms" example:"410"`
+}
+
+// MCPUserUsage aggregates the MCP tool calls made as one user
+type MCPUserUsage struct {
+	UserID   uuid.UUID `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username string    `json:"username" example:"jdoe"`
+	Calls    int64     `json:"calls" example:"80"`
+	Failures int64     `json:"failures" example:"2"`
+}
+
+// LLMUsageRepository defines operations on the usage log of language model calls
+type LLMUsageRepository interface {
+	CreateCall(ctx context.Context, call *models.LLMCall) error
+	SumCost(ctx context.Context, since, until time.Time) (float64, error)
+	SummarizeByFeature(ctx context.Context, since, until time.Time) ([]LLMFeatureUsage, error)
+	SummarizeByUser(ctx context.Context, since, until time.Time, limit int) ([]LLMUserUsage, error)
+	ListCalls(ctx context.Context, filter LLMCallFilter) ([]models.LLMCall, error)
+}
+
+// DigestSubscriptionRepository defines activity digest preference operations
+type DigestSubscriptionRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*DigestSubscription, error)
+	GetByUnsubscribeToken(ctx context.Context, token string) (*DigestSubscription, error)
+	Save(ctx context.Context, subscription *DigestSubscription) error
+	ListActive(ctx context.Context) ([]DigestSubscription, error)
+	GetDB() *gorm.DB
+}
+
+// GlossaryTermRepository defines glossary term-specific repository operations
+type GlossaryTermRepository interface {
+	Repository[GlossaryTerm]
+	GetByTerm(ctx context.Context, term string) (*GlossaryTerm, error)
+	ListAll(ctx context.Context) ([]GlossaryTerm, error)
+}
+
+// AssignmentRuleRepository defines assignment rule-specific repository operations
+type AssignmentRuleRepository interface {
+	Repository[AssignmentRule]
+	ListOrdered(ctx context.Context, entityType *EntityType) ([]AssignmentRule, error)
+}
+
+// StalenessPolicyRepository defines staleness policy-specific repository operations
+type StalenessPolicyRepository interface {
+	Repository[StalenessPolicy]
+	ListAll(ctx context.Context) ([]StalenessPolicy, error)
+}
+
+// StaleEntityRepository defines operations on the stale marks set by the staleness job
+type StaleEntityRepository interface {
+	Repository[StaleEntity]
+	ListByPolicy(ctx context.Context, policyID uuid.UUID) ([]StaleEntity, error)
+	DeleteByPolicy(ctx context.Context, policyID uuid.UUID) error
+	ListCandidates(ctx context.Context, entityType EntityType, status *string, updatedBefore time.Time) ([]StaleCandidate, error)
+	ListReport(ctx context.Context, filter StaleReportFilter) ([]StaleReportItem, error)
+}
+
+// ReportScheduleRepository defines report schedule-specific repository operations
+type ReportScheduleRepository interface {
+	Repository[ReportSchedule]
+	ListByReport(ctx context.Context, reportID string) ([]ReportSchedule, error)
+	ListDue(ctx context.Context, now time.Time) ([]ReportSchedule, error)
+}
+
+// APIUsageRepository defines operations on the daily API usage counters
+type APIUsageRepository interface {
+	Increment(ctx context.Context, userID, tokenID uuid.UUID, day, at time.Time) error
+	CountForDay(ctx context.Context, userID uuid.UUID, day time.Time) (APIUsageCount, error)
+	LockForDay(ctx context.Context, userID uuid.UUID, day time.Time) error
+	ListByDay(ctx context.Context, day time.Time) ([]APIUsageItem, error)
+}
+
+// APIQuotaRepository defines API quota-specific repository operations
+type APIQuotaRepository interface {
+	Repository[APIQuota]
+	ListAll(ctx context.Context) ([]APIQuota, error)
+	GetByRole(ctx context.Context, role models.UserRole) (*APIQuota, error)
+	GetByUser(ctx context.Context, userID uuid.UUID) (*APIQuota, error)
+}
+
+// UserPreferenceRepository defines operations on the client preferences of users
+type UserPreferenceRepository interface {
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]UserPreference, error)
+	Apply(ctx context.Context, userID uuid.UUID, save []UserPreference, remove []string) error
+}
+
+// ChangeProposalRepository defines change proposal-specific repository operations
+type ChangeProposalRepository interface {
+	Repository[ChangeProposal]
+	ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID, status *models.ChangeProposalStatus) ([]ChangeProposal, error)
+}
+
+// CommentModerationFilters defines filtering options for the comment moderation queue
+type CommentModerationFilters struct {
+	// Statuses limits the records to these review states; empty matches all
+	Statuses []models.ModerationStatus
+	AuthorID *uuid.UUID
+	Limit    int
+	Offset   int
+}
+
+// CommentModerationRepository defines comment moderation-specific repository operations
+type CommentModerationRepository interface {
+	Repository[CommentModeration]
+	ListWithFilters(ctx context.Context, filters CommentModerationFilters) ([]CommentModeration, int64, error)
+}
+
+// CommentThreadLockRepository defines comment thread lock repository operations
+type CommentThreadLockRepository interface {
+	GetByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) (*CommentThreadLock, error)
+	Upsert(ctx context.Context, lock *CommentThreadLock) error
+	DeleteByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) error
+}
+
+// CommentDraftRepository defines comment draft repository operations.
+// A nil parent comment ID addresses the draft of a new top-level comment.
+type CommentDraftRepository interface {
+	Get(ctx context.Context, entityType EntityType, entityID, userID uuid.UUID, parentCommentID *uuid.UUID) (*CommentDraft, error)
+	Create(ctx context.Context, draft *CommentDraft) error
+	Update(ctx context.Context, draft *CommentDraft) error
+	Delete(ctx context.Context, entityType EntityType, entityID, userID uuid.UUID, parentCommentID *uuid.UUID) error
+	ListByUser(ctx context.Context, userID uuid.UUID, now time.Time) ([]CommentDraft, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// TestRunRepository defines repository operations for imported test runs and the verification state they set
+type TestRunRepository interface {
+	Create(ctx context.Context, run *TestRun) error
+	GetByID(ctx context.Context, id uuid.UUID) (*TestRun, error)
+	List(ctx context.Context, limit, offset int) ([]TestRun, int64, error)
+	UpsertVerification(ctx context.Context, verification *EntityVerification) error
+	GetVerification(ctx context.Context, entityType EntityType, entityID uuid.UUID) (*EntityVerification, error)
+}
+
+// GuestInvitationRepository defines guest invitation repository operations
+type GuestInvitationRepository interface {
+	Create(ctx context.Context, invitation *GuestInvitation) error
+	GetByID(ctx context.Context, id uuid.UUID) (*GuestInvitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*GuestInvitation, error)
+	ListByEpic(ctx context.Context, epicID uuid.UUID) ([]GuestInvitation, error)
+	MarkAccepted(ctx context.Context, id uuid.UUID, at time.Time) error
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+	IsGuest(ctx context.Context, userID uuid.UUID) (bool, error)
+	DeactivateExpiredGuests(ctx context.Context, now time.Time) (int64, error)
+}
+
+// ReferenceRedirectRepository defines operations on retired reference IDs and the entities they resolve to
+type ReferenceRedirectRepository interface {
+	GetByReferenceID(ctx context.Context, referenceID string) (*ReferenceRedirect, error)
+	ListByReferenceIDs(ctx context.Context, referenceIDs []string) ([]ReferenceRedirect, error)
+	ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]ReferenceRedirect, error)
+	List(ctx context.Context, entityType *EntityType, limit, offset int) ([]ReferenceRedirect, int64, error)
+	Create(ctx context.Context, redirect *ReferenceRedirect) error
+	Delete(ctx context.Context, referenceID string) error
+	Retarget(ctx context.Context, entityType EntityType, fromID, toID uuid.UUID) error
+}
+
+// EntityTranslationRepository defines operations on translations of entity titles and descriptions
+type EntityTranslationRepository interface {
+	Get(ctx context.Context, entityType EntityType, entityID uuid.UUID, locale string) (*EntityTranslation, error)
+	ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]EntityTranslation, error)
+	ListByEntities(ctx context.Context, entityType EntityType, entityIDs []uuid.UUID, locales []string) ([]EntityTranslation, error)
+	ListLocales(ctx context.Context) ([]string, error)
+	CountCompleteness(ctx context.Context, entityType EntityType, locale string) (*TranslationCounts, error)
+	Create(ctx context.Context, translation *EntityTranslation) error
+	Update(ctx context.Context, translation *EntityTranslation) error
+	Delete(ctx context.Context, entityType EntityType, entityID uuid.UUID, locale string) error
+}
+
+// TranslationCounts counts the entities of a type by how far they are translated into a locale
+type TranslationCounts struct {
+	Total      int64 // Entities of the type; archived epics and user stories are left out
+	Translated int64 // Entities whose title and description are both translated
+	Partial    int64 // Entities with a translation that misses the title or the description
+}
+
+// SignOffRepository defines operations on the designated stakeholders and their sign-offs.
+// Sign-offs are immutable: they are only created and listed.
+type SignOffRepository interface {
+	CreateStakeholder(ctx context.Context, stakeholder *SignOffStakeholder) error
+	GetStakeholder(ctx context.Context, userID uuid.UUID, role string) (*SignOffStakeholder, error)
+	ListStakeholders(ctx context.Context, userID *uuid.UUID) ([]SignOffStakeholder, error)
+	DeleteStakeholder(ctx context.Context, id uuid.UUID) error
+	Create(ctx context.Context, signOff *SignOff) error
+	Exists(ctx context.Context, entityType EntityType, entityID, signerID uuid.UUID, role string, versionNumber int) (bool, error)
+	ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]SignOff, error)
+	List(ctx context.Context, filter SignOffFilter) ([]SignOff, int64, error)
+	ListSignOffStates(ctx context.Context, epicID *uuid.UUID) ([]RequirementSignOffState, error)
+}
+
+// SignOffFilter narrows the sign-off log
+type SignOffFilter struct {
+	EntityType *EntityType
+	SignerID   *uuid.UUID
+	From       *time.Time // Signed at or after
+	To         *time.Time // Signed before
+	Limit      int
+	Offset     int
+}
+
+// RequirementSignOffState is an Active requirement with its current version and its latest sign-off
+type RequirementSignOffState struct {
+	RequirementID    uuid.UUID  `json:"requirement_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID      string     `json:"reference_id" example:"REQ-012"`
+	Title            string     `json:"title" example:"Refund within 14 days"`
+	Priority         Priority   `json:"priority" example:"2"`
+	AssigneeID       uuid.UUID  `json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	AssigneeUsername string     `json:"assignee_username" example:"jdoe"`
+	UserStoryID      uuid.UUID  `json:"user_story_id" example:"123e4567-e89b-12d3-a456-426614174002"`
+	EpicID           uuid.UUID  `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174003"`
+	CurrentVersion   int        `json:"current_version" example:"4"`                             // Latest version of the requirement text; 0 when it predates the version history
+	SignedVersion    *int       `json:"signed_version,omitempty" example:"3"`                    // Latest version that was signed off, omitted when never signed off
+	LastSignedAt     *time.Time `json:"last_signed_at,omitempty" example:"2023-01-01T10:00:00Z"` // When the requirement was last signed off
+}
+
+// IncidentRepository defines incident-specific repository operations
+type IncidentRepository interface {
+	Repository[Incident]
+	GetWithUpdates(ctx context.Context, id uuid.UUID) (*Incident, error)
+	ListWithFilters(ctx context.Context, resolved *bool, limit, offset int) ([]Incident, int64, error)
+	ListForStatusPage(ctx context.Context, resolvedSince time.Time, limit int) ([]Incident, error)
+	CreateUpdate(ctx context.Context, update *IncidentUpdate) error
+}
+
+// MaintenanceWindowRepository defines maintenance window-specific repository operations
+type MaintenanceWindowRepository interface {
+	Repository[MaintenanceWindow]
+	ListWithFilters(ctx context.Context, endingAfter *time.Time, limit, offset int) ([]MaintenanceWindow, int64, error)
+}
+
+// ImpersonationRepository defines operations on the audit log of administrators impersonating users.
+// Sessions and their requests are only created and listed.
+type ImpersonationRepository interface {
+	CreateSession(ctx context.Context, session *ImpersonationSession) error
+	GetSession(ctx context.Context, id uuid.UUID) (*ImpersonationSession, error)
+	ListSessions(ctx context.Context, filter ImpersonationSessionFilter) ([]ImpersonationSession, int64, error)
+	CreateRequest(ctx context.Context, request *ImpersonatedRequest) error
+	ListRequests(ctx context.Context, sessionID uuid.UUID, limit, offset int) ([]ImpersonatedRequest, int64, error)
+}
+
+// ImpersonationSessionFilter narrows the impersonation sessions
+type ImpersonationSessionFilter struct {
+	AdminID *uuid.UUID
+	UserID  *uuid.UUID
+	Limit   int
+	Offset  int
+}
+
+// StaleCandidate is an entity that has not been updated since a staleness cutoff
+type StaleCandidate struct {
+	ID          uuid.UUID
+	ReferenceID string
+	Title       string
+	Status      string
+	AssigneeID  uuid.UUID
+	UpdatedAt   time.Time
+}
+
+// StaleReportFilter narrows the stale report
+type StaleReportFilter struct {
+	EntityType *EntityType
+	PolicyID   *uuid.UUID
+	AssigneeID *uuid.UUID
+}
+
+// StaleReportItem is a stale entity with the policy it matches
+type StaleReportItem struct {
+	EntityType       EntityType `json:"entity_type" example:"requirement"`
+	EntityID         uuid.UUID  `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID      string     `json:"reference_id" example:"REQ-012"`
+	Title            string     `json:"title" example:"Refund within 14 days"`
+	Status           string     `json:"status" example:"Active"`
+	AssigneeID       uuid.UUID  `json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	AssigneeUsername string     `json:"assignee_username" example:"jdoe"`
+	LastUpdatedAt    time.Time  `json:"last_updated_at" example:"2023-01-01T00:00:00Z"`
+	PolicyID         uuid.UUID  `json:"policy_id" example:"123e4567-e89b-12d3-a456-426614174002"`
+	PolicyName       string     `json:"policy_name" example:"Idle active requirements"`
+	DetectedAt       time.Time  `json:"detected_at" example:"2023-01-15T08:00:00Z"`
+	NotifiedAt       *time.Time `json:"notified_at,omitempty" example:"2023-01-15T08:00:00Z"`
+	EscalatedAt      *time.Time `json:"escalated_at,omitempty" example:"2023-01-22T08:00:00Z"`
+}
+
+// RecentViewRepository defines per-user recently viewed entity operations
+type RecentViewRepository interface {
+	Upsert(ctx context.Context, view *RecentView) error
+	ListByUser(ctx context.Context, userID uuid.UUID, limit int) ([]RecentView, error)
+	DeleteAllButLatest(ctx context.Context, userID uuid.UUID, keep int) error
+	GetDB() *gorm.DB
+}
+
+// FavoriteRepository defines per-user pinned entity operations
+type FavoriteRepository interface {
+	Create(ctx context.Context, favorite *Favorite) error
+	Get(ctx context.Context, entityType EntityType, entityID, userID uuid.UUID) (*Favorite, error)
+	Delete(ctx context.Context, entityType EntityType, entityID, userID uuid.UUID) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Favorite, error)
+	ListFavoriteEntityIDs(ctx context.Context, userID uuid.UUID, entityType EntityType, entityIDs []uuid.UUID) ([]uuid.UUID, error)
+	GetDB() *gorm.DB
+}
+
+// BoardRankRepository defines Kanban board card ordering operations
+type BoardRankRepository interface {
+	ListByEntityType(ctx context.Context, entityType EntityType) ([]BoardRank, error)
+	SaveAll(ctx context.Context, ranks []BoardRank) error
+	GetDB() *gorm.DB
+}
+
+// CalendarFeedRepository defines tokenized iCal feed operations
+type CalendarFeedRepository interface {
+	Create(ctx context.Context, feed *CalendarFeed) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*CalendarFeed, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]CalendarFeed, error)
+	DeleteForUser(ctx context.Context, id, userID uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+	ListDueEpics(ctx context.Context, filters map[string]interface{}) ([]Epic, error)
+	ListDueUserStories(ctx context.Context, filters map[string]interface{}) ([]UserStory, error)
+	GetDB() *gorm.DB
+}
+
+// ChangeFeedRepository defines tokenized Atom change feed operations
+type ChangeFeedRepository interface {
+	Create(ctx context.Context, feed *ChangeFeed) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*ChangeFeed, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]ChangeFeed, error)
+	DeleteForUser(ctx context.Context, id, userID uuid.UUID) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+	GetDB() *gorm.DB
+}
+
+// ContributorActivity is the number of audited changes made by a user
+type ContributorActivity struct {
+	UserID   uuid.UUID `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username string    `json:"username" example:"jdoe"`
+	Changes  int64     `json:"changes" example:"42"`
+	Comments int64     `json:"comments" example:"7"`
+}
+
+// EpicSize is the number of user stories and requirements below an epic
+type EpicSize struct {
+	ID           uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID  string    `json:"reference_id" example:"EP-001"`
+	Title        string    `json:"title" example:"User Authentication System"`
+	UserStories  int64     `json:"user_stories" example:"12"`
+	Requirements int64     `json:"requirements" example:"48"`
+}
+
+// StatisticsRepository defines aggregate queries for usage statistics
+type StatisticsRepository interface {
+	CountAll(ctx context.Context, model interface{}) (int64, error)
+	CountCreatedInBuckets(ctx context.Context, model interface{}, boundaries []time.Time) ([]int64, error)
+	CountCreatedBefore(ctx context.Context, model interface{}, before time.Time) (int64, error)
+	TopContributors(ctx context.Context, since time.Time, limit int) ([]ContributorActivity, error)
+	LargestEpics(ctx context.Context, limit int) ([]EpicSize, error)
+	GetDB() *gorm.DB
+}
+
+// OutboxRepository defines operations on the outbox of entity events waiting to be published
+type OutboxRepository interface {
+	Create(ctx context.Context, events []models.OutboxEvent) error
+	ListPending(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	CountPending(ctx context.Context) (int64, error)
+	MarkPublished(ctx context.Context, ids []int64, publishedAt time.Time) error
+	RecordFailure(ctx context.Context, id int64, message string) error
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error)
+	GetDB() *gorm.DB
+}
+
+// SearchIndex is a full-text search index of an entity table
+type SearchIndex struct {
+	IndexName string `json:"index_name" example:"idx_requirements_search"`
+	TableName string `json:"table_name" example:"requirements"`
+	Valid     bool   `json:"valid" example:"true"`
+	SizeBytes int64  `json:"size_bytes" example:"1048576"`
+}
+
+// SearchTableActivity is the write activity of a table with search indexes
+type SearchTableActivity struct {
+	TableName            string     `json:"table_name" example:"requirements"`
+	LiveRows             int64      `json:"live_rows" example:"1200"`
+	ModifiedSinceAnalyze int64      `json:"modified_since_analyze" example:"35"`
+	LastAnalyzedAt       *time.Time `json:"last_analyzed_at,omitempty" example:"2023-01-01T10:00:00Z"`
+}
+
+// SearchIndexRepository defines maintenance operations on the full-text search indexes
+type SearchIndexRepository interface {
+	ListSearchIndexes(ctx context.Context) ([]SearchIndex, error)
+	TableActivity(ctx context.Context, tables []string) ([]SearchTableActivity, error)
+	PendingEntries(ctx context.Context, index string) (int64, bool, error)
+	RebuildIndex(ctx context.Context, index string) error
+	AnalyzeTable(ctx context.Context, table string) error
+	GetDB() *gorm.DB
+}
+
+// ReferenceMatch is an entity found by its reference ID
+type ReferenceMatch struct {
+	EntityType  string
+	ID          uuid.UUID
+	ReferenceID string
+	Title       string
+	Status      string
+}
+
+// ReferenceRepository defines lookups of entities of any type by reference ID
+type ReferenceRepository interface {
+	FindByReferenceIDs(ctx context.Context, referenceIDs []string) ([]ReferenceMatch, error)
+	FindByIDs(ctx context.Context, entityType string, ids []uuid.UUID) ([]ReferenceMatch, error)
+	GetDB() *gorm.DB
+}
+
+// EntityRelationshipRepository defines operations for links between entities of any type
+type EntityRelationshipRepository interface {
+	Create(ctx context.Context, relationship *EntityRelationship) error
+	GetByID(ctx context.Context, id uuid.UUID) (*EntityRelationship, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Exists(ctx context.Context, sourceType EntityType, sourceID uuid.UUID, targetType EntityType, targetID uuid.UUID, typeID uuid.UUID) (bool, error)
+	ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]EntityRelationship, error)
+	GetDB() *gorm.DB
+}
+
+// CoverageItem is an entity of an epic with the number of acceptance criteria and active requirements linked to it
+type CoverageItem struct {
+	ID                      uuid.UUID
+	ReferenceID             string
+	Title                   string
+	Status                  string
+	AcceptanceCriteriaCount int64
+	RequirementCount        int64
+}
+
+// ObsoleteDependency is a depends_on relationship from an entity to an obsolete requirement
+type ObsoleteDependency struct {
+	RelationshipID       uuid.UUID
+	SourceType           EntityType
+	SourceID             uuid.UUID
+	TargetID             uuid.UUID
+	TargetSupersededByID *uuid.UUID
+}
+
+// CoverageRepository defines aggregate queries for epic coverage reports
+type CoverageRepository interface {
+	ListUserStoryCoverage(ctx context.Context, epicID uuid.UUID) ([]CoverageItem, error)
+	ListAcceptanceCriteriaCoverage(ctx context.Context, epicID uuid.UUID) ([]CoverageItem, error)
+	ListRequirementCoverage(ctx context.Context, epicID uuid.UUID) ([]CoverageItem, error)
+	ListObsoleteDependencies(ctx context.Context, epicID uuid.UUID) ([]ObsoleteDependency, error)
+	GetDB() *gorm.DB
+}
+
+// HierarchyNodeRow is an entity of the epic hierarchy with the number of its direct children
+type HierarchyNodeRow struct {
+	ID            uuid.UUID
+	ReferenceID   string
+	Title         string
+	Status        string
+	ChildrenCount int64
+}
+
+// HierarchyRepository defines queries for lazily loaded hierarchy trees
+type HierarchyRepository interface {
+	ListEpicNodes(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]HierarchyNodeRow, int64, error)
+	ListChildEpicNodes(ctx context.Context, parentEpicID uuid.UUID) ([]HierarchyNodeRow, error)
+	ListEpicAncestors(ctx context.Context, epicID uuid.UUID) ([]models.Epic, error)
+	ListEpicParents(ctx context.Context, epicIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+	ListUserStoryNodes(ctx context.Context, epicID uuid.UUID) ([]HierarchyNodeRow, error)
+	ListAcceptanceCriteriaNodes(ctx context.Context, userStoryID uuid.UUID) ([]HierarchyNodeRow, error)
+	ListRequirementNodes(ctx context.Context, userStoryID uuid.UUID, acceptanceCriteriaID *uuid.UUID) ([]HierarchyNodeRow, error)
+	GetLastChange(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*time.Time, error)
+	GetEntry(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.HierarchyEntry, error)
+	ListEntriesByIDs(ctx context.Context, entityIDs []uuid.UUID) ([]models.HierarchyEntry, error)
+	ListSubtreeEntries(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.HierarchyEntry, error)
+	ListEntries(ctx context.Context) ([]models.HierarchyEntry, error)
+	ComputeEntries(ctx context.Context) ([]models.HierarchyEntry, error)
+	RebuildEntries(ctx context.Context) (int64, error)
+	GetDB() *gorm.DB
+}
+
+// Aggregate counts that can be embedded in entity responses
+const (
+	CountComments           = "comments_count"
+	CountUnresolvedComments = "unresolved_comments_count"
+	CountUserStories        = "user_stories_count"
+	CountAcceptanceCriteria = "acceptance_criteria_count"
+	CountRequirements       = "requirements_count"
+	CountRelationships      = "relationships_count"
+)
+
+// EntityCountRepository defines aggregate count queries over lists of entities
+type EntityCountRepository interface {
+	SupportedCounts(ctx context.Context, entityType EntityType) []string
+	CountAggregates(ctx context.Context, entityType EntityType, ids []uuid.UUID, counts []string) (map[uuid.UUID]map[string]int64, error)
 	GetDB() *gorm.DB
 }
+
+// RequirementRiskRepository defines the queries behind requirement risk scores
+type RequirementRiskRepository interface {
+	ListRiskFactors(ctx context.Context, filter RequirementRiskFilter) ([]RequirementRiskFactors, error)
+}
+
+// RequirementRiskFilter narrows the requirements whose risk factors are listed. A non-nil, empty IDs matches nothing.
+type RequirementRiskFilter struct {
+	IDs        []uuid.UUID
+	Statuses   []RequirementStatus
+	EpicID     *uuid.UUID
+	AssigneeID *uuid.UUID
+	Portfolio  *PortfolioFilter // Only requirements of the epics in the portfolio
+}
+
+// RequirementRiskFactors is a requirement with the facts its risk score is computed from
+type RequirementRiskFactors struct {
+	RequirementID             uuid.UUID         `json:"requirement_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID               string            `json:"reference_id" example:"REQ-012"`
+	Title                     string            `json:"title" example:"Refund within 14 days"`
+	Status                    RequirementStatus `json:"status" example:"Draft"`
+	Priority                  Priority          `json:"priority" example:"2"`
+	AssigneeID                uuid.UUID         `json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	AssigneeUsername          string            `json:"assignee_username" example:"jdoe"`
+	UserStoryID               uuid.UUID         `json:"user_story_id" example:"123e4567-e89b-12d3-a456-426614174002"`
+	EpicID                    uuid.UUID         `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174003"`
+	CreatedAt                 time.Time         `json:"-"`
+	DraftSince                time.Time         `json:"-" gorm:"-"`
+	MissingAcceptanceCriteria bool              `json:"-"`
+	BlockingRelationships     int64             `json:"-"`
+	UnresolvedBlockers        int64             `json:"-"`
+}
+
+// PortfolioRepository defines the aggregate queries behind the portfolio view of epics
+type PortfolioRepository interface {
+	CountEpics(ctx context.Context, filter PortfolioFilter) ([]PortfolioEpicCount, error)
+	ListRecentActivity(ctx context.Context, filter PortfolioFilter, limit int) ([]AuditEvent, error)
+	ListDueDates(ctx context.Context, filter PortfolioFilter, from, to time.Time, limit int) ([]PortfolioDueDate, error)
+}
+
+// PortfolioFilter selects the epics of the portfolio; archived epics are left out unless IncludeArchived is set
+type PortfolioFilter struct {
+	Status          *EpicStatus
+	Priority        *Priority
+	AssigneeID      *uuid.UUID
+	CreatorID       *uuid.UUID
+	IncludeArchived bool
+}
+
+// PortfolioEpicCount is the number of epics in the portfolio with a status and priority
+type PortfolioEpicCount struct {
+	Status   EpicStatus
+	Priority Priority
+	Count    int64
+}
+
+// PortfolioDueDate is an open epic or user story of the portfolio with a due date
+type PortfolioDueDate struct {
+	EntityType  EntityType `json:"entity_type" example:"user_story"`
+	EntityID    uuid.UUID  `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string     `json:"reference_id" example:"US-012"`
+	Title       string     `json:"title" example:"Password reset by email"`
+	Status      string     `json:"status" example:"In Progress"`
+	DueDate     time.Time  `json:"due_date" example:"2023-03-31T00:00:00Z"`
+	EpicID      uuid.UUID  `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	AssigneeID  uuid.UUID  `json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174002"`
+}
+
+// AssigneeSuggestionRepository defines the queries behind assignee suggestions
+type AssigneeSuggestionRepository interface {
+	ListCandidates(ctx context.Context, roles []models.UserRole) ([]AssigneeCandidate, error)
+	CountEpicChanges(ctx context.Context, epicID uuid.UUID, since time.Time) (map[uuid.UUID]int64, error)
+}
+
+// AssigneeCandidate is an active user who could be assigned, with the open items assigned to them
+type AssigneeCandidate struct {
+	UserID    uuid.UUID
+	Username  string
+	Role      models.UserRole
+	OpenItems int64 // Epics and user stories neither done nor cancelled and Draft or Active requirements, archived ones excluded
+}
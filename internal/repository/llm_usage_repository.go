@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// llmUsageColumns aggregates call counts, tokens, cost and latency of llm_calls rows
+const llmUsageColumns = "COUNT(*) AS calls, " +
+	"SUM(CASE WHEN llm_calls.success THEN 0 ELSE 1 END) AS failures, " +
+	"COALESCE(SUM(llm_calls.input_tokens), 0) AS input_tokens, " +
+	"COALESCE(SUM(llm_calls.output_tokens), 0) AS output_tokens, " +
+	"COALESCE(SUM(llm_calls.cost_usd), 0) AS cost_usd, " +
+	"COALESCE(AVG(llm_calls.latency_ms), 0) AS avg_latency_ms"
+
+// LLMFeatureUsage aggregates the language model calls of one AI feature
+type LLMFeatureUsage struct {
+	Feature      string  `json:"feature" example:"comment_summary"`
+	Calls        int64   `json:"calls" example:"120"`
+	Failures     int64   `json:"failures" example:"2"`
+	InputTokens  int64   `json:"input_tokens" example:"150000"`
+	OutputTokens int64   `json:"output_tokens" example:"36000"`
+	CostUSD      float64 `json:"cost_usd" example:"0.735"`
+	AvgLatencyMs float64 `json:"avg_latency_ms" example:"2350.5"`
+}
+
+// LLMUserUsage aggregates the language model calls made for one user
+type LLMUserUsage struct {
+	UserID       uuid.UUID `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username     string    `json:"username" example:"jdoe"`
+	Calls        int64     `json:"calls" example:"80"`
+	Failures     int64     `json:"failures" example:"1"`
+	InputTokens  int64     `json:"input_tokens" example:"98000"`
+	OutputTokens int64     `json:"output_tokens" example:"21000"`
+	CostUSD      float64   `json:"cost_usd" example:"0.455"`
+	AvgLatencyMs float64   `json:"avg_latency_ms" example:"2100"`
+}
+
+// LLMCallFilter selects language model calls to list
+type LLMCallFilter struct {
+	Feature string
+	UserID  *uuid.UUID
+	Limit   int
+}
+
+// llmUsageRepository implements LLMUsageRepository interface
+type llmUsageRepository struct {
+	db *gorm.DB
+}
+
+// NewLLMUsageRepository creates a new language model usage repository instance
+func NewLLMUsageRepository(db *gorm.DB) LLMUsageRepository {
+	return &llmUsageRepository{db: db}
+}
+
+// CreateCall records a language model call
+func (r *llmUsageRepository) CreateCall(ctx context.Context, call *models.LLMCall) error {
+	if err := r.db.WithContext(ctx).Create(call).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// SumCost adds up the estimated cost of the calls made in [since, until)
+func (r *llmUsageRepository) SumCost(ctx context.Context, since, until time.Time) (float64, error) {
+	var total float64
+	err := r.db.WithContext(ctx).Model(&models.LLMCall{}).
+		Select("COALESCE(SUM(cost_usd), 0)").
+		Where("created_at >= ? AND created_at < ?", since, until).
+		Scan(&total).Error
+	if err != nil {
+		return 0, handleDBError(err)
+	}
+	return total, nil
+}
+
+// SummarizeByFeature aggregates the calls made in [since, until) per feature, most expensive first
+func (r *llmUsageRepository) SummarizeByFeature(ctx context.Context, since, until time.Time) ([]LLMFeatureUsage, error) {
+	var usage []LLMFeatureUsage
+	err := r.db.WithContext(ctx).Model(&models.LLMCall{}).
+		Select("llm_calls.feature AS feature, "+llmUsageColumns).
+		Where("llm_calls.created_at >= ? AND llm_calls.created_at < ?", since, until).
+		Group("llm_calls.feature").
+		Order("cost_usd DESC, calls DESC, feature").
+		Scan(&usage).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return usage, nil
+}
+
+// SummarizeByUser aggregates the calls made in [since, until) per user, most expensive first
+func (r *llmUsageRepository) SummarizeByUser(ctx context.Context, since, until time.Time, limit int) ([]LLMUserUsage, error) {
+	var usage []LLMUserUsage
+	err := r.db.WithContext(ctx).Table("llm_calls").
+		Select("users.id AS user_id, users.username AS username, "+llmUsageColumns).
+		Joins("JOIN users ON users.id = llm_calls.user_id").
+		Where("llm_calls.created_at >= ? AND llm_calls.created_at < ?", since, until).
+		Group("users.id, users.username").
+		Order("cost_usd DESC, calls DESC, username").
+		Limit(limit).
+		Scan(&usage).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return usage, nil
+}
+
+// ListCalls retrieves the most recent calls matching the filter, newest first
+func (r *llmUsageRepository) ListCalls(ctx context.Context, filter LLMCallFilter) ([]models.LLMCall, error) {
+	query := r.db.WithContext(ctx).Model(&models.LLMCall{})
+	if filter.Feature != "" {
+		query = query.Where("feature = ?", filter.Feature)
+	}
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var calls []models.LLMCall
+	if err := query.Preload("User").Order("created_at DESC, id DESC").Find(&calls).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return calls, nil
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+const (
+	outboxTargetsKey = "outbox:targets"
+	outboxDeletedKey = "outbox:deleted"
+)
+
+// outboxEntityTypes maps the tables whose lifecycle changes are published to their entity types
+var outboxEntityTypes = map[string]models.EntityType{
+	"epics":               models.EntityTypeEpic,
+	"user_stories":        models.EntityTypeUserStory,
+	"acceptance_criteria": models.EntityTypeAcceptanceCriteria,
+	"requirements":        models.EntityTypeRequirement,
+}
+
+// RegisterOutboxCallbacks registers GORM callbacks that record an outbox event for every creation, update and
+// deletion of epics, user stories, acceptance criteria and requirements. Events are written on the same
+// connection (and transaction) as the change itself and carry the state of the entity after the change.
+// Statements without a model, such as raw SQL or db.Table(...), are not recorded.
+func RegisterOutboxCallbacks(db *gorm.DB) error {
+	callbacks := []struct {
+		name     string
+		register func() error
+	}{
+		{"create", func() error {
+			return db.Callback().Create().After("gorm:create").Register("outbox:record_create", recordOutboxCreate)
+		}},
+		{"update capture", func() error {
+			return db.Callback().Update().Before("gorm:update").Register("outbox:capture_updated", captureOutboxTargets)
+		}},
+		{"update", func() error {
+			return db.Callback().Update().After("gorm:update").Register("outbox:record_update", recordOutboxUpdate)
+		}},
+		{"delete capture", func() error {
+			return db.Callback().Delete().Before("gorm:delete").Register("outbox:capture_deleted", captureOutboxDeleted)
+		}},
+		{"delete", func() error {
+			return db.Callback().Delete().After("gorm:delete").Register("outbox:record_delete", recordOutboxDelete)
+		}},
+	}
+
+	for _, callback := range callbacks {
+		if err := callback.register(); err != nil {
+			return fmt.Errorf("failed to register %s outbox callback: %w", callback.name, err)
+		}
+	}
+	return nil
+}
+
+// outboxEntityType returns the entity type of the statement's table, if its changes are published
+func outboxEntityType(db *gorm.DB) (models.EntityType, bool) {
+	if db.Statement.Schema == nil {
+		return "", false
+	}
+	entityType, ok := outboxEntityTypes[db.Statement.Schema.Table]
+	return entityType, ok
+}
+
+// recordOutboxCreate records creation of the statement's entities
+func recordOutboxCreate(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+	entityType, ok := outboxEntityType(db)
+	if !ok {
+		return
+	}
+	writeOutboxEvents(db, entityType, models.EntityEventCreated, db.Statement.ReflectValue)
+}
+
+// captureOutboxTargets stores the IDs of the entities about to be updated
+func captureOutboxTargets(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+	if _, ok := outboxEntityType(db); !ok {
+		return
+	}
+
+	if id := entityIDOf(db.Statement.ReflectValue); id != uuid.Nil {
+		db.InstanceSet(outboxTargetsKey, []uuid.UUID{id})
+		return
+	}
+	where, ok := db.Statement.Clauses["WHERE"]
+	if !ok {
+		return
+	}
+
+	ids, err := outboxEntityIDs(db, where.Expression)
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to load entities for outbox: %w", err))
+		return
+	}
+	db.InstanceSet(outboxTargetsKey, ids)
+}
+
+// recordOutboxUpdate records the state of the updated entities
+func recordOutboxUpdate(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+	entityType, ok := outboxEntityType(db)
+	if !ok {
+		return
+	}
+	value, ok := db.InstanceGet(outboxTargetsKey)
+	if !ok || len(value.([]uuid.UUID)) == 0 {
+		return
+	}
+
+	// Reload the entities, since partial updates do not carry their full state
+	updated, err := loadOutboxEntities(db, value.([]uuid.UUID))
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to load entities for outbox: %w", err))
+		return
+	}
+	writeOutboxEvents(db, entityType, models.EntityEventUpdated, updated)
+}
+
+// captureOutboxDeleted stores the last state of the entities about to be deleted
+func captureOutboxDeleted(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+	if _, ok := outboxEntityType(db); !ok {
+		return
+	}
+
+	var ids []uuid.UUID
+	if where, ok := db.Statement.Clauses["WHERE"]; ok {
+		var err error
+		if ids, err = outboxEntityIDs(db, where.Expression); err != nil {
+			db.AddError(fmt.Errorf("failed to load entities for outbox: %w", err))
+			return
+		}
+	} else if id := entityIDOf(db.Statement.ReflectValue); id != uuid.Nil {
+		ids = []uuid.UUID{id}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	deleted, err := loadOutboxEntities(db, ids)
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to load entities for outbox: %w", err))
+		return
+	}
+	db.InstanceSet(outboxDeletedKey, deleted)
+}
+
+// recordOutboxDelete records deletion of the captured entities
+func recordOutboxDelete(db *gorm.DB) {
+	if db.Error != nil || db.RowsAffected == 0 {
+		return
+	}
+	entityType, ok := outboxEntityType(db)
+	if !ok {
+		return
+	}
+	value, ok := db.InstanceGet(outboxDeletedKey)
+	if !ok {
+		return
+	}
+	writeOutboxEvents(db, entityType, models.EntityEventDeleted, value.(reflect.Value))
+}
+
+// outboxEntityIDs returns the IDs of the entities of the statement's model matching a WHERE clause
+func outboxEntityIDs(db *gorm.DB, where clause.Expression) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	model := reflect.New(db.Statement.Schema.ModelType).Interface()
+	err := db.Session(&gorm.Session{NewDB: true}).Model(model).Clauses(where).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// loadOutboxEntities loads the stored state of entities of the statement's model
+func loadOutboxEntities(db *gorm.DB, ids []uuid.UUID) (reflect.Value, error) {
+	entities := reflect.New(reflect.SliceOf(db.Statement.Schema.ModelType))
+	err := db.Session(&gorm.Session{NewDB: true}).Where("id IN ?", ids).Find(entities.Interface()).Error
+	return entities.Elem(), err
+}
+
+// entityIDOf returns the ID of a single entity, or uuid.Nil for slices and entities without an ID
+func entityIDOf(value reflect.Value) uuid.UUID {
+	value = reflect.Indirect(value)
+	if value.Kind() != reflect.Struct {
+		return uuid.Nil
+	}
+	if id, ok := value.FieldByName("ID").Interface().(uuid.UUID); ok {
+		return id
+	}
+	return uuid.Nil
+}
+
+// writeOutboxEvents stores an event for each entity on the statement's connection
+func writeOutboxEvents(db *gorm.DB, entityType models.EntityType, action models.EntityEventAction, entities reflect.Value) {
+	entities = reflect.Indirect(entities)
+	var values []reflect.Value
+	switch entities.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < entities.Len(); i++ {
+			values = append(values, reflect.Indirect(entities.Index(i)))
+		}
+	case reflect.Struct:
+		values = append(values, entities)
+	}
+
+	now := time.Now().UTC()
+	events := make([]models.OutboxEvent, 0, len(values))
+	for _, value := range values {
+		event, err := newOutboxEvent(entityType, action, value, now)
+		if err != nil {
+			db.AddError(fmt.Errorf("failed to record outbox event: %w", err))
+			return
+		}
+		events = append(events, *event)
+	}
+
+	if err := NewOutboxRepository(statementConnection(db)).Create(db.Statement.Context, events); err != nil {
+		db.AddError(fmt.Errorf("failed to record outbox event: %w", err))
+	}
+}
+
+// newOutboxEvent builds the outbox event of a change to an entity
+func newOutboxEvent(entityType models.EntityType, action models.EntityEventAction, entity reflect.Value, occurredAt time.Time) (*models.OutboxEvent, error) {
+	data, err := json.Marshal(entity.Addr().Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.EntityEvent{
+		SchemaVersion: models.EntityEventSchemaVersion,
+		ID:            uuid.New(),
+		Type:          fmt.Sprintf("%s.%s", entityType, action),
+		EntityType:    entityType,
+		EntityID:      entityIDOf(entity),
+		Action:        action,
+		OccurredAt:    occurredAt,
+		Data:          data,
+	}
+	if referenceID := entity.FieldByName("ReferenceID"); referenceID.IsValid() && referenceID.Kind() == reflect.String {
+		event.ReferenceID = referenceID.String()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OutboxEvent{
+		EventID:    event.ID,
+		EntityType: entityType,
+		EntityID:   event.EntityID,
+		EventType:  event.Type,
+		Payload:    string(payload),
+		CreatedAt:  occurredAt,
+	}, nil
+}
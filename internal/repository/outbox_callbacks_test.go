@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func setupOutboxTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{}, &models.Requirement{}, &models.Comment{}, &models.OutboxEvent{})
+	require.NoError(t, err)
+
+	require.NoError(t, RegisterOutboxCallbacks(db))
+	return db
+}
+
+func decodeOutboxEvents(t *testing.T, db *gorm.DB) []models.EntityEvent {
+	var rows []models.OutboxEvent
+	require.NoError(t, db.Order("id").Find(&rows).Error)
+
+	events := make([]models.EntityEvent, len(rows))
+	for i, row := range rows {
+		require.NoError(t, json.Unmarshal([]byte(row.Payload), &events[i]))
+		assert.Equal(t, row.EventID, events[i].ID)
+		assert.Equal(t, row.EventType, events[i].Type)
+		assert.Nil(t, row.PublishedAt)
+	}
+	return events
+}
+
+func TestOutboxCallbacks_RecordLifecycle(t *testing.T) {
+	ctx := context.Background()
+	db := setupOutboxTestDB(t)
+	epicRepo := NewEpicRepository(db)
+	userRepo := NewUserRepository(db)
+
+	epic, _ := createTestEpic(t, epicRepo, userRepo, "Published", models.EpicStatusBacklog, models.PriorityHigh)
+
+	epic.Status = models.EpicStatusInProgress
+	require.NoError(t, epicRepo.Update(ctx, epic))
+	require.NoError(t, db.Model(&models.Epic{}).Where("id = ?", epic.ID).Update("title", "Renamed").Error)
+	require.NoError(t, db.Delete(&models.Epic{}, epic.ID).Error)
+
+	// Comments are not published
+	comment := &models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: epic.CreatorID, Content: "Looks good"}
+	require.NoError(t, db.Create(comment).Error)
+
+	events := decodeOutboxEvents(t, db)
+	require.Len(t, events, 4)
+	assert.Equal(t, []string{"epic.created", "epic.updated", "epic.updated", "epic.deleted"},
+		[]string{events[0].Type, events[1].Type, events[2].Type, events[3].Type})
+
+	for _, event := range events {
+		assert.Equal(t, models.EntityEventSchemaVersion, event.SchemaVersion)
+		assert.Equal(t, models.EntityTypeEpic, event.EntityType)
+		assert.Equal(t, epic.ID, event.EntityID)
+		assert.Equal(t, epic.ReferenceID, event.ReferenceID)
+		assert.False(t, event.OccurredAt.IsZero())
+	}
+
+	var state models.Epic
+	require.NoError(t, json.Unmarshal(events[1].Data, &state))
+	assert.Equal(t, models.EpicStatusInProgress, state.Status)
+	require.NoError(t, json.Unmarshal(events[2].Data, &state))
+	assert.Equal(t, "Renamed", state.Title, "partial updates publish the reloaded entity")
+	require.NoError(t, json.Unmarshal(events[3].Data, &state))
+	assert.Equal(t, "Renamed", state.Title, "deletions publish the last state")
+}
+
+func TestOutboxCallbacks_BatchChanges(t *testing.T) {
+	db := setupOutboxTestDB(t)
+	epicRepo := NewEpicRepository(db)
+	userRepo := NewUserRepository(db)
+
+	first, _ := createTestEpic(t, epicRepo, userRepo, "First", models.EpicStatusBacklog, models.PriorityHigh)
+	second, _ := createTestEpic(t, epicRepo, userRepo, "Second", models.EpicStatusBacklog, models.PriorityLow)
+	require.NoError(t, db.Where("1 = 1").Delete(&models.OutboxEvent{}).Error)
+
+	require.NoError(t, db.Model(&models.Epic{}).Where("status = ?", models.EpicStatusBacklog).Update("status", models.EpicStatusDone).Error)
+	require.NoError(t, db.Where("priority = ?", models.PriorityLow).Delete(&models.Epic{}).Error)
+
+	events := decodeOutboxEvents(t, db)
+	require.Len(t, events, 3)
+	updated := map[string]bool{events[0].EntityID.String(): true, events[1].EntityID.String(): true}
+	assert.True(t, updated[first.ID.String()])
+	assert.True(t, updated[second.ID.String()])
+	assert.Equal(t, "epic.deleted", events[2].Type)
+	assert.Equal(t, second.ID, events[2].EntityID)
+}
+
+func TestOutboxCallbacks_RolledBackChangesAreNotRecorded(t *testing.T) {
+	db := setupOutboxTestDB(t)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	epicRepo := NewEpicRepository(db)
+	userRepo := NewUserRepository(db)
+
+	epic, _ := createTestEpic(t, epicRepo, userRepo, "Kept", models.EpicStatusBacklog, models.PriorityHigh)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		require.NoError(t, tx.Model(epic).Update("title", "Discarded").Error)
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	events := decodeOutboxEvents(t, db)
+	require.Len(t, events, 1)
+	assert.Equal(t, "epic.created", events[0].Type)
+}
+
+func TestOutboxRepository_Dispatch(t *testing.T) {
+	ctx := context.Background()
+	db := setupOutboxTestDB(t)
+	repo := NewOutboxRepository(db)
+	epicRepo := NewEpicRepository(db)
+	userRepo := NewUserRepository(db)
+
+	epic, _ := createTestEpic(t, epicRepo, userRepo, "Dispatched", models.EpicStatusBacklog, models.PriorityHigh)
+	epic.Title = "Changed"
+	require.NoError(t, epicRepo.Update(ctx, epic))
+
+	pending, err := repo.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Less(t, pending[0].ID, pending[1].ID)
+
+	require.NoError(t, repo.RecordFailure(ctx, pending[0].ID, "connection refused"))
+	require.NoError(t, repo.RecordFailure(ctx, pending[0].ID, "connection refused"))
+	failed, err := repo.ListPending(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, failed[0].Attempts)
+	assert.Equal(t, "connection refused", failed[0].LastError)
+
+	publishedAt := time.Now().UTC().Add(-time.Hour)
+	require.NoError(t, repo.MarkPublished(ctx, []int64{pending[0].ID}, publishedAt))
+	count, err := repo.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	deleted, err := repo.DeletePublishedBefore(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	remaining, err := repo.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, pending[1].ID, remaining[0].ID)
+}
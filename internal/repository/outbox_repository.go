@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// outboxRepository implements OutboxRepository interface
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository creates a new outbox repository instance
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// Create records events waiting to be published
+func (r *outboxRepository) Create(ctx context.Context, events []models.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(&events).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// ListPending returns the oldest events that are not yet published, in outbox order
+func (r *outboxRepository) ListPending(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	var events []models.OutboxEvent
+	if err := r.db.WithContext(ctx).Where("published_at IS NULL").Order("id").Limit(limit).Find(&events).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return events, nil
+}
+
+// CountPending counts the events that are not yet published
+func (r *outboxRepository) CountPending(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("published_at IS NULL").Count(&count).Error; err != nil {
+		return 0, handleDBError(err)
+	}
+	return count, nil
+}
+
+// MarkPublished records the publication of events
+func (r *outboxRepository) MarkPublished(ctx context.Context, ids []int64, publishedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("id IN ?", ids).
+		Updates(map[string]interface{}{"published_at": publishedAt, "last_error": ""}).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// RecordFailure counts a failed publish attempt of an event
+func (r *outboxRepository) RecordFailure(ctx context.Context, id int64, message string) error {
+	err := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"attempts": gorm.Expr("attempts + 1"), "last_error": message}).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// DeletePublishedBefore removes events published before the given time and returns their number
+func (r *outboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("published_at IS NOT NULL AND published_at < ?", before).Delete(&models.OutboxEvent{})
+	if result.Error != nil {
+		return 0, handleDBError(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *outboxRepository) GetDB() *gorm.DB {
+	return r.db
+}
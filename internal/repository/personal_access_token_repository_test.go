@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -39,6 +40,7 @@ func createTestUserForPATRepo(t *testing.T, db *gorm.DB, username string) *model
 }
 
 func createTestPAT(t *testing.T, repo PersonalAccessTokenRepository, userID uuid.UUID, name string) *models.PersonalAccessToken {
+	ctx := context.Background()
 	pat := &models.PersonalAccessToken{
 		UserID:    userID,
 		Name:      name,
@@ -47,12 +49,13 @@ func createTestPAT(t *testing.T, repo PersonalAccessTokenRepository, userID uuid
 		Scopes:    `["full_access"]`,
 	}
 
-	err := repo.Create(pat)
+	err := repo.Create(ctx, pat)
 	require.NoError(t, err)
 	return pat
 }
 
 func TestPersonalAccessTokenRepository_Create(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -65,12 +68,13 @@ func TestPersonalAccessTokenRepository_Create(t *testing.T) {
 		Scopes:    `["full_access"]`,
 	}
 
-	err := repo.Create(pat)
+	err := repo.Create(ctx, pat)
 	assert.NoError(t, err)
 	assert.NotEqual(t, uuid.Nil, pat.ID)
 }
 
 func TestPersonalAccessTokenRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -79,7 +83,7 @@ func TestPersonalAccessTokenRepository_GetByID(t *testing.T) {
 	originalPAT := createTestPAT(t, repo, user.ID, "Test Token")
 
 	// Retrieve by ID
-	retrievedPAT, err := repo.GetByID(originalPAT.ID)
+	retrievedPAT, err := repo.GetByID(ctx, originalPAT.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedPAT)
 	assert.Equal(t, originalPAT.ID, retrievedPAT.ID)
@@ -88,6 +92,7 @@ func TestPersonalAccessTokenRepository_GetByID(t *testing.T) {
 }
 
 func TestPersonalAccessTokenRepository_GetByUserID(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user1 := createTestUserForPATRepo(t, db, "user1")
@@ -101,16 +106,17 @@ func TestPersonalAccessTokenRepository_GetByUserID(t *testing.T) {
 	createTestPAT(t, repo, user2.ID, "Token 3")
 
 	// Get PATs for user1
-	user1PATs, err := repo.GetByUserID(user1.ID)
+	user1PATs, err := repo.GetByUserID(ctx, user1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, user1PATs, 2)
 
 	// Get PATs for user2
-	user2PATs, err := repo.GetByUserID(user2.ID)
+	user2PATs, err := repo.GetByUserID(ctx, user2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, user2PATs, 1)
 }
 func TestPersonalAccessTokenRepository_GetByUserIDWithPagination(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -121,25 +127,26 @@ func TestPersonalAccessTokenRepository_GetByUserIDWithPagination(t *testing.T) {
 	}
 
 	// Test pagination
-	pats, total, err := repo.GetByUserIDWithPagination(user.ID, 2, 0)
+	pats, total, err := repo.GetByUserIDWithPagination(ctx, user.ID, 2, 0)
 	assert.NoError(t, err)
 	assert.Len(t, pats, 2)
 	assert.Equal(t, int64(5), total)
 
 	// Test second page
-	pats, total, err = repo.GetByUserIDWithPagination(user.ID, 2, 2)
+	pats, total, err = repo.GetByUserIDWithPagination(ctx, user.ID, 2, 2)
 	assert.NoError(t, err)
 	assert.Len(t, pats, 2)
 	assert.Equal(t, int64(5), total)
 
 	// Test last page
-	pats, total, err = repo.GetByUserIDWithPagination(user.ID, 2, 4)
+	pats, total, err = repo.GetByUserIDWithPagination(ctx, user.ID, 2, 4)
 	assert.NoError(t, err)
 	assert.Len(t, pats, 1)
 	assert.Equal(t, int64(5), total)
 }
 
 func TestPersonalAccessTokenRepository_GetHashesByPrefix(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -147,27 +154,28 @@ func TestPersonalAccessTokenRepository_GetHashesByPrefix(t *testing.T) {
 	// Create PATs with different prefixes
 	pat1 := createTestPAT(t, repo, user.ID, "Token 1")
 	pat1.Prefix = "mcp_pat_"
-	repo.Update(pat1)
+	repo.Update(ctx, pat1)
 
 	pat2 := createTestPAT(t, repo, user.ID, "Token 2")
 	pat2.Prefix = "other_"
-	repo.Update(pat2)
+	repo.Update(ctx, pat2)
 
 	pat3 := createTestPAT(t, repo, user.ID, "Token 3")
 	pat3.Prefix = "mcp_pat_"
-	repo.Update(pat3)
+	repo.Update(ctx, pat3)
 
 	// Get PATs by prefix
-	mcpPATs, err := repo.GetHashesByPrefix("mcp_pat_")
+	mcpPATs, err := repo.GetHashesByPrefix(ctx, "mcp_pat_")
 	assert.NoError(t, err)
 	assert.Len(t, mcpPATs, 2)
 
-	otherPATs, err := repo.GetHashesByPrefix("other_")
+	otherPATs, err := repo.GetHashesByPrefix(ctx, "other_")
 	assert.NoError(t, err)
 	assert.Len(t, otherPATs, 1)
 }
 
 func TestPersonalAccessTokenRepository_UpdateLastUsed(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -177,17 +185,18 @@ func TestPersonalAccessTokenRepository_UpdateLastUsed(t *testing.T) {
 
 	// Update last used timestamp
 	now := time.Now()
-	err := repo.UpdateLastUsed(pat.ID, &now)
+	err := repo.UpdateLastUsed(ctx, pat.ID, &now)
 	assert.NoError(t, err)
 
 	// Verify update
-	updatedPAT, err := repo.GetByID(pat.ID)
+	updatedPAT, err := repo.GetByID(ctx, pat.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, updatedPAT.LastUsedAt)
 	assert.WithinDuration(t, now, *updatedPAT.LastUsedAt, time.Second)
 }
 
 func TestPersonalAccessTokenRepository_DeleteExpired(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -196,29 +205,30 @@ func TestPersonalAccessTokenRepository_DeleteExpired(t *testing.T) {
 	expiredPAT := createTestPAT(t, repo, user.ID, "Expired Token")
 	pastTime := time.Now().Add(-24 * time.Hour)
 	expiredPAT.ExpiresAt = &pastTime
-	repo.Update(expiredPAT)
+	repo.Update(ctx, expiredPAT)
 
 	// Create non-expired PAT
 	validPAT := createTestPAT(t, repo, user.ID, "Valid Token")
 	futureTime := time.Now().Add(24 * time.Hour)
 	validPAT.ExpiresAt = &futureTime
-	repo.Update(validPAT)
+	repo.Update(ctx, validPAT)
 
 	// Create PAT with no expiration
 	createTestPAT(t, repo, user.ID, "No Expiration Token")
 
 	// Delete expired tokens
-	deletedCount, err := repo.DeleteExpired()
+	deletedCount, err := repo.DeleteExpired(ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(1), deletedCount)
 
 	// Verify only expired token was deleted
-	allPATs, err := repo.GetByUserID(user.ID)
+	allPATs, err := repo.GetByUserID(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Len(t, allPATs, 2)
 }
 
 func TestPersonalAccessTokenRepository_ExistsByUserIDAndName(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -227,23 +237,24 @@ func TestPersonalAccessTokenRepository_ExistsByUserIDAndName(t *testing.T) {
 	createTestPAT(t, repo, user.ID, "Existing Token")
 
 	// Test existing token
-	exists, err := repo.ExistsByUserIDAndName(user.ID, "Existing Token")
+	exists, err := repo.ExistsByUserIDAndName(ctx, user.ID, "Existing Token")
 	assert.NoError(t, err)
 	assert.True(t, exists)
 
 	// Test non-existing token
-	exists, err = repo.ExistsByUserIDAndName(user.ID, "Non-existing Token")
+	exists, err = repo.ExistsByUserIDAndName(ctx, user.ID, "Non-existing Token")
 	assert.NoError(t, err)
 	assert.False(t, exists)
 
 	// Test with different user
 	user2 := createTestUserForPATRepo(t, db, "user2")
-	exists, err = repo.ExistsByUserIDAndName(user2.ID, "Existing Token")
+	exists, err = repo.ExistsByUserIDAndName(ctx, user2.ID, "Existing Token")
 	assert.NoError(t, err)
 	assert.False(t, exists)
 }
 
 func TestPersonalAccessTokenRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupPATRepositoryTestDB(t)
 	repo := NewPersonalAccessTokenRepository(db)
 	user := createTestUserForPATRepo(t, db, "testuser")
@@ -251,11 +262,11 @@ func TestPersonalAccessTokenRepository_Delete(t *testing.T) {
 	pat := createTestPAT(t, repo, user.ID, "Test Token")
 
 	// Delete PAT
-	err := repo.Delete(pat.ID)
+	err := repo.Delete(ctx, pat.ID)
 	assert.NoError(t, err)
 
 	// Verify deletion
-	_, err = repo.GetByID(pat.ID)
+	_, err = repo.GetByID(ctx, pat.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 }
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// portfolioRepository implements PortfolioRepository interface
+type portfolioRepository struct {
+	db *gorm.DB
+}
+
+// NewPortfolioRepository creates a new portfolio repository instance
+func NewPortfolioRepository(db *gorm.DB) PortfolioRepository {
+	return &portfolioRepository{db: db}
+}
+
+// portfolioEpics returns a subquery selecting the IDs of the epics in the portfolio
+func portfolioEpics(db *gorm.DB, filter PortfolioFilter) *gorm.DB {
+	query := db.Model(&models.Epic{}).Select("id")
+	if !filter.IncludeArchived {
+		query = query.Where("archived = ?", false)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.Priority != nil {
+		query = query.Where("priority = ?", *filter.Priority)
+	}
+	if filter.AssigneeID != nil {
+		query = query.Where("assignee_id = ?", *filter.AssigneeID)
+	}
+	if filter.CreatorID != nil {
+		query = query.Where("creator_id = ?", *filter.CreatorID)
+	}
+	return query
+}
+
+// CountEpics counts the epics in the portfolio per status and priority with a single aggregate query
+func (r *portfolioRepository) CountEpics(ctx context.Context, filter PortfolioFilter) ([]PortfolioEpicCount, error) {
+	var counts []PortfolioEpicCount
+	err := r.db.WithContext(ctx).Model(&models.Epic{}).
+		Select("status, priority, COUNT(*) AS count").
+		Where("id IN (?)", portfolioEpics(r.db.WithContext(ctx), filter)).
+		Group("status, priority").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return counts, nil
+}
+
+// ListRecentActivity retrieves the most recent audit events of the epics in the portfolio and of the
+// user stories, acceptance criteria and requirements below them, newest first
+func (r *portfolioRepository) ListRecentActivity(ctx context.Context, filter PortfolioFilter, limit int) ([]AuditEvent, error) {
+	condition, args := epicEntityCondition(portfolioEpics(r.db.WithContext(ctx), filter))
+	query := r.db.WithContext(ctx).Where(condition, args...)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var events []AuditEvent
+	if err := query.Preload("Actor").Order("created_at DESC, id DESC").Find(&events).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return events, nil
+}
+
+// ListDueDates returns the epics of the portfolio and their user stories that are neither done nor cancelled
+// and are due from the start up to the end of the range, soonest first
+func (r *portfolioRepository) ListDueDates(ctx context.Context, filter PortfolioFilter, from, to time.Time, limit int) ([]PortfolioDueDate, error) {
+	epics := portfolioEpics(r.db.WithContext(ctx), filter)
+	sources := []struct {
+		model    interface{}
+		columns  string
+		epicCond string
+		closed   []string
+	}{
+		{&models.Epic{}, "'epic' AS entity_type, id AS entity_id, id AS epic_id", "id IN (?)",
+			[]string{string(models.EpicStatusDone), string(models.EpicStatusCancelled)}},
+		{&models.UserStory{}, "'user_story' AS entity_type, id AS entity_id, epic_id", "epic_id IN (?)",
+			[]string{string(models.UserStoryStatusDone), string(models.UserStoryStatusCancelled)}},
+	}
+
+	var items []PortfolioDueDate
+	for _, source := range sources {
+		query := r.db.WithContext(ctx).Model(source.model).
+			Select(source.columns+", reference_id, title, status, due_date, assignee_id").
+			Where(source.epicCond, epics).
+			Where("status NOT IN ?", source.closed).
+			Where("due_date >= ? AND due_date < ?", from, to).
+			Order("due_date ASC, reference_id ASC")
+		if limit > 0 {
+			query = query.Limit(limit)
+		}
+
+		var rows []PortfolioDueDate
+		if err := query.Scan(&rows).Error; err != nil {
+			return nil, handleDBError(err)
+		}
+		items = append(items, rows...)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].DueDate.Equal(items[j].DueDate) {
+			return items[i].DueDate.Before(items[j].DueDate)
+		}
+		return items[i].ReferenceID < items[j].ReferenceID
+	})
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// presenceRepository implements PresenceRepository interface
+type presenceRepository struct {
+	db *gorm.DB
+}
+
+// NewPresenceRepository creates a new presence repository instance
+func NewPresenceRepository(db *gorm.DB) PresenceRepository {
+	return &presenceRepository{db: db}
+}
+
+// UpsertPresence creates or refreshes a user's presence on an entity
+func (r *presenceRepository) UpsertPresence(ctx context.Context, presence *models.EntityPresence) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"mode", "last_seen_at", "expires_at"}),
+	}).Create(presence).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// DeletePresence removes a user's presence on an entity
+func (r *presenceRepository) DeletePresence(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ? AND user_id = ?", entityType, entityID, userID).
+		Delete(&models.EntityPresence{}).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetActivePresence returns all non-expired presence records for an entity
+func (r *presenceRepository) GetActivePresence(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, now time.Time) ([]models.EntityPresence, error) {
+	var presences []models.EntityPresence
+	err := r.db.WithContext(ctx).Preload("User").
+		Where("entity_type = ? AND entity_id = ? AND expires_at > ?", entityType, entityID, now).
+		Order("last_seen_at DESC").
+		Find(&presences).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return presences, nil
+}
+
+// GetLock returns the edit lock for an entity, expired or not
+func (r *presenceRepository) GetLock(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.EditLock, error) {
+	var lock models.EditLock
+	err := r.db.WithContext(ctx).Preload("User").
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		First(&lock).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &lock, nil
+}
+
+// CreateLock creates a new edit lock; fails with ErrDuplicateKey if the entity is already locked
+func (r *presenceRepository) CreateLock(ctx context.Context, lock *models.EditLock) error {
+	if err := r.db.WithContext(ctx).Omit("User").Create(lock).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// UpdateLock updates an existing edit lock
+func (r *presenceRepository) UpdateLock(ctx context.Context, lock *models.EditLock) error {
+	if err := r.db.WithContext(ctx).Omit("User").Save(lock).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// DeleteLock releases the edit lock on an entity
+func (r *presenceRepository) DeleteLock(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Delete(&models.EditLock{}).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// DeleteExpired removes stale presence records and expired locks
+func (r *presenceRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	var total int64
+
+	result := r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&models.EntityPresence{})
+	if result.Error != nil {
+		return 0, handleDBError(result.Error)
+	}
+	total += result.RowsAffected
+
+	result = r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&models.EditLock{})
+	if result.Error != nil {
+		return total, handleDBError(result.Error)
+	}
+	total += result.RowsAffected
+
+	return total, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *presenceRepository) GetDB() *gorm.DB {
+	return r.db
+}
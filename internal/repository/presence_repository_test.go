@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func setupPresenceTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	err = db.AutoMigrate(&models.User{}, &models.EntityPresence{}, &models.EditLock{})
+	require.NoError(t, err)
+
+	return db
+}
+
+func createTestUserForPresence(t *testing.T, db *gorm.DB, username string) *models.User {
+	user := &models.User{
+		Username:     username,
+		Email:        username + "@example.com",
+		PasswordHash: "hashed_password",
+		Role:         models.RoleUser,
+	}
+	require.NoError(t, db.Create(user).Error)
+	return user
+}
+
+func TestPresenceRepository_UpsertPresence(t *testing.T) {
+	ctx := context.Background()
+	db := setupPresenceTestDB(t)
+	repo := NewPresenceRepository(db)
+	user := createTestUserForPresence(t, db, "viewer")
+	entityID := uuid.New()
+	now := time.Now().UTC()
+
+	first := &models.EntityPresence{
+		EntityType: models.EntityTypeRequirement,
+		EntityID:   entityID,
+		UserID:     user.ID,
+		Mode:       models.PresenceModeViewing,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(time.Minute),
+	}
+	require.NoError(t, repo.UpsertPresence(ctx, first))
+
+	// A second heartbeat for the same user/entity updates the existing row
+	second := &models.EntityPresence{
+		EntityType: models.EntityTypeRequirement,
+		EntityID:   entityID,
+		UserID:     user.ID,
+		Mode:       models.PresenceModeEditing,
+		LastSeenAt: now.Add(10 * time.Second),
+		ExpiresAt:  now.Add(70 * time.Second),
+	}
+	require.NoError(t, repo.UpsertPresence(ctx, second))
+
+	presences, err := repo.GetActivePresence(ctx, models.EntityTypeRequirement, entityID, now)
+	require.NoError(t, err)
+	require.Len(t, presences, 1)
+	assert.Equal(t, models.PresenceModeEditing, presences[0].Mode)
+	require.NotNil(t, presences[0].User)
+	assert.Equal(t, "viewer", presences[0].User.Username)
+
+	// Expired presence is not returned
+	presences, err = repo.GetActivePresence(ctx, models.EntityTypeRequirement, entityID, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, presences)
+
+	require.NoError(t, repo.DeletePresence(ctx, models.EntityTypeRequirement, entityID, user.ID))
+	presences, err = repo.GetActivePresence(ctx, models.EntityTypeRequirement, entityID, now)
+	require.NoError(t, err)
+	assert.Empty(t, presences)
+}
+
+func TestPresenceRepository_Locks(t *testing.T) {
+	ctx := context.Background()
+	db := setupPresenceTestDB(t)
+	repo := NewPresenceRepository(db)
+	holder := createTestUserForPresence(t, db, "holder")
+	other := createTestUserForPresence(t, db, "other")
+	entityID := uuid.New()
+	now := time.Now().UTC()
+
+	_, err := repo.GetLock(ctx, models.EntityTypeEpic, entityID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	lock := &models.EditLock{
+		EntityType: models.EntityTypeEpic,
+		EntityID:   entityID,
+		UserID:     holder.ID,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(5 * time.Minute),
+	}
+	require.NoError(t, repo.CreateLock(ctx, lock))
+
+	// Only one lock per entity
+	duplicate := &models.EditLock{
+		EntityType: models.EntityTypeEpic,
+		EntityID:   entityID,
+		UserID:     other.ID,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(5 * time.Minute),
+	}
+	assert.Error(t, repo.CreateLock(ctx, duplicate))
+
+	found, err := repo.GetLock(ctx, models.EntityTypeEpic, entityID)
+	require.NoError(t, err)
+	assert.Equal(t, holder.ID, found.UserID)
+
+	found.ExpiresAt = now.Add(10 * time.Minute)
+	require.NoError(t, repo.UpdateLock(ctx, found))
+
+	removed, err := repo.DeleteExpired(ctx, now.Add(20*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), removed)
+
+	_, err = repo.GetLock(ctx, models.EntityTypeEpic, entityID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// recentViewRepository implements RecentViewRepository interface
+type recentViewRepository struct {
+	db *gorm.DB
+}
+
+// NewRecentViewRepository creates a new recent view repository instance
+func NewRecentViewRepository(db *gorm.DB) RecentViewRepository {
+	return &recentViewRepository{db: db}
+}
+
+// Upsert records a view, refreshing viewed_at if the user viewed the entity before
+func (r *recentViewRepository) Upsert(ctx context.Context, view *models.RecentView) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"viewed_at"}),
+	}).Create(view).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// ListByUser retrieves a user's most recent views, newest first
+func (r *recentViewRepository) ListByUser(ctx context.Context, userID uuid.UUID, limit int) ([]models.RecentView, error) {
+	var views []models.RecentView
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("viewed_at DESC, id")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Find(&views).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return views, nil
+}
+
+// DeleteAllButLatest removes a user's views except the keep most recent ones
+func (r *recentViewRepository) DeleteAllButLatest(ctx context.Context, userID uuid.UUID, keep int) error {
+	latest := r.db.WithContext(ctx).Model(&models.RecentView{}).
+		Select("id").
+		Where("user_id = ?", userID).
+		Order("viewed_at DESC, id").
+		Limit(keep)
+	err := r.db.WithContext(ctx).Where("user_id = ? AND id NOT IN (?)", userID, latest).
+		Delete(&models.RecentView{}).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetDB returns the underlying database connection
+func (r *recentViewRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -15,6 +16,7 @@ import (
 // TestReferenceIDLookupIntegration tests that all entity repositories properly support
 // direct reference ID lookups as required by task 3.1
 func TestReferenceIDLookupIntegration(t *testing.T) {
+	ctx := context.Background()
 	// Setup in-memory SQLite database
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
@@ -59,22 +61,22 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			Priority:    models.PriorityHigh,
 			CreatorID:   user.ID,
 		}
-		err := epicRepo.Create(epic)
+		err := epicRepo.Create(ctx, epic)
 		require.NoError(t, err)
 
 		// Test case-sensitive lookup
-		retrieved, err := epicRepo.GetByReferenceID("EP-001")
+		retrieved, err := epicRepo.GetByReferenceID(ctx, "EP-001")
 		assert.NoError(t, err)
 		assert.Equal(t, epic.ID, retrieved.ID)
 		assert.Equal(t, "EP-001", retrieved.ReferenceID)
 
 		// Test case-insensitive lookup
-		retrieved, err = epicRepo.GetByReferenceIDCaseInsensitive("ep-001")
+		retrieved, err = epicRepo.GetByReferenceIDCaseInsensitive(ctx, "ep-001")
 		assert.NoError(t, err)
 		assert.Equal(t, epic.ID, retrieved.ID)
 
 		// Test not found
-		_, err = epicRepo.GetByReferenceID("EP-999")
+		_, err = epicRepo.GetByReferenceID(ctx, "EP-999")
 		assert.Error(t, err)
 		assert.Equal(t, ErrNotFound, err)
 	})
@@ -92,7 +94,7 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			Priority:    models.PriorityHigh,
 			CreatorID:   user.ID,
 		}
-		err := epicRepo.Create(epic)
+		err := epicRepo.Create(ctx, epic)
 		require.NoError(t, err)
 
 		userStory := &models.UserStory{
@@ -103,22 +105,22 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			EpicID:      epic.ID,
 			CreatorID:   user.ID,
 		}
-		err = userStoryRepo.Create(userStory)
+		err = userStoryRepo.Create(ctx, userStory)
 		require.NoError(t, err)
 
 		// Test case-sensitive lookup
-		retrieved, err := userStoryRepo.GetByReferenceID("US-001")
+		retrieved, err := userStoryRepo.GetByReferenceID(ctx, "US-001")
 		assert.NoError(t, err)
 		assert.Equal(t, userStory.ID, retrieved.ID)
 		assert.Equal(t, "US-001", retrieved.ReferenceID)
 
 		// Test case-insensitive lookup
-		retrieved, err = userStoryRepo.GetByReferenceIDCaseInsensitive("us-001")
+		retrieved, err = userStoryRepo.GetByReferenceIDCaseInsensitive(ctx, "us-001")
 		assert.NoError(t, err)
 		assert.Equal(t, userStory.ID, retrieved.ID)
 
 		// Test not found
-		_, err = userStoryRepo.GetByReferenceID("US-999")
+		_, err = userStoryRepo.GetByReferenceID(ctx, "US-999")
 		assert.Error(t, err)
 		assert.Equal(t, ErrNotFound, err)
 	})
@@ -137,7 +139,7 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			Priority:    models.PriorityHigh,
 			CreatorID:   user.ID,
 		}
-		err := epicRepo.Create(epic)
+		err := epicRepo.Create(ctx, epic)
 		require.NoError(t, err)
 
 		userStory := &models.UserStory{
@@ -148,7 +150,7 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			EpicID:      epic.ID,
 			CreatorID:   user.ID,
 		}
-		err = userStoryRepo.Create(userStory)
+		err = userStoryRepo.Create(ctx, userStory)
 		require.NoError(t, err)
 
 		ac := &models.AcceptanceCriteria{
@@ -158,22 +160,22 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			UserStoryID: userStory.ID,
 			AuthorID:    user.ID,
 		}
-		err = acRepo.Create(ac)
+		err = acRepo.Create(ctx, ac)
 		require.NoError(t, err)
 
 		// Test case-sensitive lookup
-		retrieved, err := acRepo.GetByReferenceID("AC-001")
+		retrieved, err := acRepo.GetByReferenceID(ctx, "AC-001")
 		assert.NoError(t, err)
 		assert.Equal(t, ac.ID, retrieved.ID)
 		assert.Equal(t, "AC-001", retrieved.ReferenceID)
 
 		// Test case-insensitive lookup
-		retrieved, err = acRepo.GetByReferenceIDCaseInsensitive("ac-001")
+		retrieved, err = acRepo.GetByReferenceIDCaseInsensitive(ctx, "ac-001")
 		assert.NoError(t, err)
 		assert.Equal(t, ac.ID, retrieved.ID)
 
 		// Test not found
-		_, err = acRepo.GetByReferenceID("AC-999")
+		_, err = acRepo.GetByReferenceID(ctx, "AC-999")
 		assert.Error(t, err)
 		assert.Equal(t, ErrNotFound, err)
 	})
@@ -192,7 +194,7 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			Priority:    models.PriorityHigh,
 			CreatorID:   user.ID,
 		}
-		err := epicRepo.Create(epic)
+		err := epicRepo.Create(ctx, epic)
 		require.NoError(t, err)
 
 		userStory := &models.UserStory{
@@ -203,7 +205,7 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			EpicID:      epic.ID,
 			CreatorID:   user.ID,
 		}
-		err = userStoryRepo.Create(userStory)
+		err = userStoryRepo.Create(ctx, userStory)
 		require.NoError(t, err)
 
 		req := &models.Requirement{
@@ -215,22 +217,22 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			TypeID:      reqType.ID,
 			CreatorID:   user.ID,
 		}
-		err = reqRepo.Create(req)
+		err = reqRepo.Create(ctx, req)
 		require.NoError(t, err)
 
 		// Test case-sensitive lookup
-		retrieved, err := reqRepo.GetByReferenceID("REQ-001")
+		retrieved, err := reqRepo.GetByReferenceID(ctx, "REQ-001")
 		assert.NoError(t, err)
 		assert.Equal(t, req.ID, retrieved.ID)
 		assert.Equal(t, "REQ-001", retrieved.ReferenceID)
 
 		// Test case-insensitive lookup
-		retrieved, err = reqRepo.GetByReferenceIDCaseInsensitive("req-001")
+		retrieved, err = reqRepo.GetByReferenceIDCaseInsensitive(ctx, "req-001")
 		assert.NoError(t, err)
 		assert.Equal(t, req.ID, retrieved.ID)
 
 		// Test not found
-		_, err = reqRepo.GetByReferenceID("REQ-999")
+		_, err = reqRepo.GetByReferenceID(ctx, "REQ-999")
 		assert.Error(t, err)
 		assert.Equal(t, ErrNotFound, err)
 	})
@@ -245,22 +247,22 @@ func TestReferenceIDLookupIntegration(t *testing.T) {
 			Title:       "Test Steering Document",
 			CreatorID:   user.ID,
 		}
-		err = steeringRepo.Create(steering)
+		err = steeringRepo.Create(ctx, steering)
 		require.NoError(t, err)
 
 		// Test case-sensitive lookup
-		retrieved, err := steeringRepo.GetByReferenceID("STD-001")
+		retrieved, err := steeringRepo.GetByReferenceID(ctx, "STD-001")
 		assert.NoError(t, err)
 		assert.Equal(t, steering.ID, retrieved.ID)
 		assert.Equal(t, "STD-001", retrieved.ReferenceID)
 
 		// Test case-insensitive lookup
-		retrieved, err = steeringRepo.GetByReferenceIDCaseInsensitive("std-001")
+		retrieved, err = steeringRepo.GetByReferenceIDCaseInsensitive(ctx, "std-001")
 		assert.NoError(t, err)
 		assert.Equal(t, steering.ID, retrieved.ID)
 
 		// Test not found
-		_, err = steeringRepo.GetByReferenceID("STD-999")
+		_, err = steeringRepo.GetByReferenceID(ctx, "STD-999")
 		assert.Error(t, err)
 		assert.Equal(t, ErrNotFound, err)
 	})
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// referenceRedirectRepository implements ReferenceRedirectRepository interface
+type referenceRedirectRepository struct {
+	db *gorm.DB
+}
+
+// NewReferenceRedirectRepository creates a new reference redirect repository instance
+func NewReferenceRedirectRepository(db *gorm.DB) ReferenceRedirectRepository {
+	return &referenceRedirectRepository{db: db}
+}
+
+// GetByReferenceID retrieves the redirect of a retired reference ID
+func (r *referenceRedirectRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.ReferenceRedirect, error) {
+	var redirect models.ReferenceRedirect
+	if err := r.db.WithContext(ctx).Where("reference_id = ?", referenceID).First(&redirect).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &redirect, nil
+}
+
+// ListByReferenceIDs retrieves the redirects of the given retired reference IDs; reference IDs without one are skipped
+func (r *referenceRedirectRepository) ListByReferenceIDs(ctx context.Context, referenceIDs []string) ([]models.ReferenceRedirect, error) {
+	var redirects []models.ReferenceRedirect
+	if len(referenceIDs) == 0 {
+		return redirects, nil
+	}
+	if err := r.db.WithContext(ctx).Where("reference_id IN ?", referenceIDs).Find(&redirects).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return redirects, nil
+}
+
+// ListByEntity retrieves the redirects resolving to an entity ordered by reference ID
+func (r *referenceRedirectRepository) ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]models.ReferenceRedirect, error) {
+	var redirects []models.ReferenceRedirect
+	if err := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID).Order("reference_id").Find(&redirects).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return redirects, nil
+}
+
+// List retrieves the redirects, optionally of one entity type, newest first
+func (r *referenceRedirectRepository) List(ctx context.Context, entityType *EntityType, limit, offset int) ([]models.ReferenceRedirect, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.ReferenceRedirect{})
+	if entityType != nil {
+		query = query.Where("entity_type = ?", *entityType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	var redirects []models.ReferenceRedirect
+	if err := query.Order("created_at DESC, reference_id ASC").Limit(limit).Offset(offset).Find(&redirects).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	return redirects, total, nil
+}
+
+// Create records a redirect
+func (r *referenceRedirectRepository) Create(ctx context.Context, redirect *models.ReferenceRedirect) error {
+	return handleDBError(r.db.WithContext(ctx).Create(redirect).Error)
+}
+
+// Delete removes the redirect of a reference ID
+func (r *referenceRedirectRepository) Delete(ctx context.Context, referenceID string) error {
+	result := r.db.WithContext(ctx).Where("reference_id = ?", referenceID).Delete(&models.ReferenceRedirect{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Retarget points the redirects resolving to one entity to another entity of the same type
+func (r *referenceRedirectRepository) Retarget(ctx context.Context, entityType EntityType, fromID, toID uuid.UUID) error {
+	return handleDBError(r.db.WithContext(ctx).Model(&models.ReferenceRedirect{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, fromID).
+		Update("entity_id", toID).Error)
+}
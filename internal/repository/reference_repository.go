@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// referenceSource describes the table holding the entities with a reference ID prefix
+type referenceSource struct {
+	entityType string
+	model      interface{}
+	columns    string
+}
+
+// referenceSources maps reference ID prefixes to their entities. Acceptance criteria have no
+// title or status; their description is returned as the title.
+var referenceSources = map[string]referenceSource{
+	"EP":  {string(models.EntityTypeEpic), &models.Epic{}, "id, reference_id, title, status"},
+	"US":  {string(models.EntityTypeUserStory), &models.UserStory{}, "id, reference_id, title, status"},
+	"REQ": {string(models.EntityTypeRequirement), &models.Requirement{}, "id, reference_id, title, status"},
+	"AC":  {string(models.EntityTypeAcceptanceCriteria), &models.AcceptanceCriteria{}, "id, reference_id, description AS title, '' AS status"},
+	"STD": {"steering_document", &models.SteeringDocument{}, "id, reference_id, title, '' AS status"},
+}
+
+// referenceRepository implements ReferenceRepository interface
+type referenceRepository struct {
+	db *gorm.DB
+}
+
+// NewReferenceRepository creates a new reference repository instance
+func NewReferenceRepository(db *gorm.DB) ReferenceRepository {
+	return &referenceRepository{db: db}
+}
+
+// FindByReferenceIDs returns the entities matching the given reference IDs with one query per
+// entity type. Reference IDs must be upper-case; unknown prefixes and missing entities are skipped.
+func (r *referenceRepository) FindByReferenceIDs(ctx context.Context, referenceIDs []string) ([]ReferenceMatch, error) {
+	byPrefix := make(map[string][]string)
+	prefixes := make([]string, 0)
+	for _, referenceID := range referenceIDs {
+		prefix, _, found := strings.Cut(referenceID, "-")
+		if _, known := referenceSources[prefix]; !found || !known {
+			continue
+		}
+		if _, seen := byPrefix[prefix]; !seen {
+			prefixes = append(prefixes, prefix)
+		}
+		byPrefix[prefix] = append(byPrefix[prefix], referenceID)
+	}
+
+	matches := make([]ReferenceMatch, 0, len(referenceIDs))
+	for _, prefix := range prefixes {
+		source := referenceSources[prefix]
+		var rows []ReferenceMatch
+		err := r.db.WithContext(ctx).Model(source.model).
+			Select(source.columns).
+			Where("reference_id IN ?", byPrefix[prefix]).
+			Scan(&rows).Error
+		if err != nil {
+			return nil, handleDBError(err)
+		}
+		for _, row := range rows {
+			row.EntityType = source.entityType
+			matches = append(matches, row)
+		}
+	}
+	return matches, nil
+}
+
+// FindByIDs returns the entities of one type matching the given IDs; missing entities and entity types
+// without reference IDs are skipped
+func (r *referenceRepository) FindByIDs(ctx context.Context, entityType string, ids []uuid.UUID) ([]ReferenceMatch, error) {
+	matches := make([]ReferenceMatch, 0, len(ids))
+	if len(ids) == 0 {
+		return matches, nil
+	}
+	for _, source := range referenceSources {
+		if source.entityType != entityType {
+			continue
+		}
+		var rows []ReferenceMatch
+		if err := r.db.WithContext(ctx).Model(source.model).Select(source.columns).Where("id IN ?", ids).Scan(&rows).Error; err != nil {
+			return nil, handleDBError(err)
+		}
+		for _, row := range rows {
+			row.EntityType = source.entityType
+			matches = append(matches, row)
+		}
+	}
+	return matches, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *referenceRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -21,17 +22,17 @@ func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
 }
 
 // Create creates a new refresh token
-func (r *refreshTokenRepository) Create(token *models.RefreshToken) error {
-	if err := r.db.Create(token).Error; err != nil {
+func (r *refreshTokenRepository) Create(ctx context.Context, token *models.RefreshToken) error {
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
 		return handleDBError(err)
 	}
 	return nil
 }
 
 // FindByTokenHash finds a refresh token by its hash
-func (r *refreshTokenRepository) FindByTokenHash(tokenHash string) (*models.RefreshToken, error) {
+func (r *refreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
 	var token models.RefreshToken
-	if err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -41,34 +42,34 @@ func (r *refreshTokenRepository) FindByTokenHash(tokenHash string) (*models.Refr
 }
 
 // FindByUserID finds all refresh tokens for a user
-func (r *refreshTokenRepository) FindByUserID(userID uuid.UUID) ([]*models.RefreshToken, error) {
+func (r *refreshTokenRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*models.RefreshToken, error) {
 	var tokens []*models.RefreshToken
-	if err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&tokens).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Find(&tokens).Error; err != nil {
 		return nil, handleDBError(err)
 	}
 	return tokens, nil
 }
 
 // FindAll finds all refresh tokens
-func (r *refreshTokenRepository) FindAll() ([]*models.RefreshToken, error) {
+func (r *refreshTokenRepository) FindAll(ctx context.Context) ([]*models.RefreshToken, error) {
 	var tokens []*models.RefreshToken
-	if err := r.db.Find(&tokens).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&tokens).Error; err != nil {
 		return nil, handleDBError(err)
 	}
 	return tokens, nil
 }
 
 // Update updates a refresh token
-func (r *refreshTokenRepository) Update(token *models.RefreshToken) error {
-	if err := r.db.Save(token).Error; err != nil {
+func (r *refreshTokenRepository) Update(ctx context.Context, token *models.RefreshToken) error {
+	if err := r.db.WithContext(ctx).Save(token).Error; err != nil {
 		return handleDBError(err)
 	}
 	return nil
 }
 
 // Delete deletes a refresh token by ID
-func (r *refreshTokenRepository) Delete(id uuid.UUID) error {
-	result := r.db.Delete(&models.RefreshToken{}, "id = ?", id)
+func (r *refreshTokenRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.RefreshToken{}, "id = ?", id)
 	if result.Error != nil {
 		return handleDBError(result.Error)
 	}
@@ -79,16 +80,16 @@ func (r *refreshTokenRepository) Delete(id uuid.UUID) error {
 }
 
 // DeleteByUserID deletes all refresh tokens for a user
-func (r *refreshTokenRepository) DeleteByUserID(userID uuid.UUID) error {
-	if err := r.db.Delete(&models.RefreshToken{}, "user_id = ?", userID).Error; err != nil {
+func (r *refreshTokenRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&models.RefreshToken{}, "user_id = ?", userID).Error; err != nil {
 		return handleDBError(err)
 	}
 	return nil
 }
 
 // DeleteExpired deletes all expired refresh tokens
-func (r *refreshTokenRepository) DeleteExpired() (int64, error) {
-	result := r.db.Delete(&models.RefreshToken{}, "expires_at < ?", time.Now())
+func (r *refreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result := r.db.WithContext(ctx).Delete(&models.RefreshToken{}, "expires_at < ?", time.Now())
 	if result.Error != nil {
 		return 0, handleDBError(result.Error)
 	}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -39,19 +40,21 @@ func createTestUserForRefreshToken(t *testing.T, db *gorm.DB) *models.User {
 }
 
 func createTestRefreshToken(t *testing.T, repo RefreshTokenRepository, userID uuid.UUID, tokenHash string, expiresAt time.Time) *models.RefreshToken {
+	ctx := context.Background()
 	token := &models.RefreshToken{
 		UserID:    userID,
 		TokenHash: tokenHash,
 		ExpiresAt: expiresAt,
 	}
 
-	err := repo.Create(token)
+	err := repo.Create(ctx, token)
 	require.NoError(t, err)
 
 	return token
 }
 
 func TestRefreshTokenRepository_Create(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -62,13 +65,14 @@ func TestRefreshTokenRepository_Create(t *testing.T) {
 		ExpiresAt: time.Now().Add(30 * 24 * time.Hour),
 	}
 
-	err := repo.Create(token)
+	err := repo.Create(ctx, token)
 	assert.NoError(t, err)
 	assert.NotEqual(t, uuid.Nil, token.ID)
 	assert.NotZero(t, token.CreatedAt)
 }
 
 func TestRefreshTokenRepository_FindByTokenHash_Existing(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -79,7 +83,7 @@ func TestRefreshTokenRepository_FindByTokenHash_Existing(t *testing.T) {
 	created := createTestRefreshToken(t, repo, user.ID, tokenHash, expiresAt)
 
 	// Find by token hash
-	retrieved, err := repo.FindByTokenHash(tokenHash)
+	retrieved, err := repo.FindByTokenHash(ctx, tokenHash)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrieved)
 	assert.Equal(t, created.ID, retrieved.ID)
@@ -88,17 +92,19 @@ func TestRefreshTokenRepository_FindByTokenHash_Existing(t *testing.T) {
 }
 
 func TestRefreshTokenRepository_FindByTokenHash_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 
 	// Try to find non-existent token
-	retrieved, err := repo.FindByTokenHash("nonexistent_hash")
+	retrieved, err := repo.FindByTokenHash(ctx, "nonexistent_hash")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestRefreshTokenRepository_FindByUserID_MultipleTokens(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -112,7 +118,7 @@ func TestRefreshTokenRepository_FindByUserID_MultipleTokens(t *testing.T) {
 	token3 := createTestRefreshToken(t, repo, user.ID, "hash3", expiresAt)
 
 	// Find all tokens for user
-	tokens, err := repo.FindByUserID(user.ID)
+	tokens, err := repo.FindByUserID(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Len(t, tokens, 3)
 
@@ -123,17 +129,19 @@ func TestRefreshTokenRepository_FindByUserID_MultipleTokens(t *testing.T) {
 }
 
 func TestRefreshTokenRepository_FindByUserID_NoTokens(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
 
 	// Find tokens for user with no tokens
-	tokens, err := repo.FindByUserID(user.ID)
+	tokens, err := repo.FindByUserID(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Empty(t, tokens)
 }
 
 func TestRefreshTokenRepository_Update(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -146,17 +154,18 @@ func TestRefreshTokenRepository_Update(t *testing.T) {
 	now := time.Now()
 	token.LastUsedAt = &now
 
-	err := repo.Update(token)
+	err := repo.Update(ctx, token)
 	assert.NoError(t, err)
 
 	// Verify update
-	retrieved, err := repo.FindByTokenHash("hash1")
+	retrieved, err := repo.FindByTokenHash(ctx, "hash1")
 	assert.NoError(t, err)
 	assert.NotNil(t, retrieved.LastUsedAt)
 	assert.WithinDuration(t, now, *retrieved.LastUsedAt, time.Second)
 }
 
 func TestRefreshTokenRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -166,27 +175,29 @@ func TestRefreshTokenRepository_Delete(t *testing.T) {
 	token := createTestRefreshToken(t, repo, user.ID, "hash1", expiresAt)
 
 	// Delete token
-	err := repo.Delete(token.ID)
+	err := repo.Delete(ctx, token.ID)
 	assert.NoError(t, err)
 
 	// Verify deletion
-	retrieved, err := repo.FindByTokenHash("hash1")
+	retrieved, err := repo.FindByTokenHash(ctx, "hash1")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestRefreshTokenRepository_Delete_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 
 	// Try to delete non-existent token
-	err := repo.Delete(uuid.New())
+	err := repo.Delete(ctx, uuid.New())
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 }
 
 func TestRefreshTokenRepository_DeleteByUserID(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -198,16 +209,17 @@ func TestRefreshTokenRepository_DeleteByUserID(t *testing.T) {
 	createTestRefreshToken(t, repo, user.ID, "hash3", expiresAt)
 
 	// Delete all tokens for user
-	err := repo.DeleteByUserID(user.ID)
+	err := repo.DeleteByUserID(ctx, user.ID)
 	assert.NoError(t, err)
 
 	// Verify all tokens are deleted
-	tokens, err := repo.FindByUserID(user.ID)
+	tokens, err := repo.FindByUserID(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Empty(t, tokens)
 }
 
 func TestRefreshTokenRepository_DeleteExpired(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -223,12 +235,12 @@ func TestRefreshTokenRepository_DeleteExpired(t *testing.T) {
 	validToken2 := createTestRefreshToken(t, repo, user.ID, "valid2", validTime)
 
 	// Delete expired tokens
-	count, err := repo.DeleteExpired()
+	count, err := repo.DeleteExpired(ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(2), count)
 
 	// Verify only valid tokens remain
-	tokens, err := repo.FindByUserID(user.ID)
+	tokens, err := repo.FindByUserID(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Len(t, tokens, 2)
 
@@ -239,6 +251,7 @@ func TestRefreshTokenRepository_DeleteExpired(t *testing.T) {
 }
 
 func TestRefreshTokenRepository_DeleteExpired_NoExpiredTokens(t *testing.T) {
+	ctx := context.Background()
 	db := setupRefreshTokenTestDB(t)
 	repo := NewRefreshTokenRepository(db)
 	user := createTestUserForRefreshToken(t, db)
@@ -249,12 +262,12 @@ func TestRefreshTokenRepository_DeleteExpired_NoExpiredTokens(t *testing.T) {
 	createTestRefreshToken(t, repo, user.ID, "valid2", validTime)
 
 	// Delete expired tokens
-	count, err := repo.DeleteExpired()
+	count, err := repo.DeleteExpired(ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), count)
 
 	// Verify all tokens remain
-	tokens, err := repo.FindByUserID(user.ID)
+	tokens, err := repo.FindByUserID(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Len(t, tokens, 2)
 }
@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"product-requirements-management/internal/models"
@@ -21,9 +23,9 @@ func NewRelationshipTypeRepository(db *gorm.DB) RelationshipTypeRepository {
 }
 
 // GetByName retrieves a relationship type by name
-func (r *relationshipTypeRepository) GetByName(name string) (*models.RelationshipType, error) {
+func (r *relationshipTypeRepository) GetByName(ctx context.Context, name string) (*models.RelationshipType, error) {
 	var relType models.RelationshipType
-	if err := r.GetDB().Where("name = ?", name).First(&relType).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("name = ?", name).First(&relType).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -33,10 +35,19 @@ func (r *relationshipTypeRepository) GetByName(name string) (*models.Relationshi
 }
 
 // ExistsByName checks if a relationship type exists by name
-func (r *relationshipTypeRepository) ExistsByName(name string) (bool, error) {
+func (r *relationshipTypeRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.RelationshipType{}).Where("name = ?", name).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.RelationshipType{}).Where("name = ?", name).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
+
+// CountEntityRelationships counts the links between entities that use a relationship type
+func (r *relationshipTypeRepository) CountEntityRelationships(ctx context.Context, typeID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.GetDB().WithContext(ctx).Model(&models.EntityRelationship{}).Where("relationship_type_id = ?", typeID).Count(&count).Error; err != nil {
+		return 0, r.handleDBError(err)
+	}
+	return count, nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// reportScheduleRepository implements ReportScheduleRepository interface
+type reportScheduleRepository struct {
+	*BaseRepository[models.ReportSchedule]
+}
+
+// NewReportScheduleRepository creates a new report schedule repository instance
+func NewReportScheduleRepository(db *gorm.DB) ReportScheduleRepository {
+	return &reportScheduleRepository{
+		BaseRepository: NewBaseRepository[models.ReportSchedule](db),
+	}
+}
+
+// ListByReport retrieves the schedules of a report in creation order, with owners preloaded
+func (r *reportScheduleRepository) ListByReport(ctx context.Context, reportID string) ([]models.ReportSchedule, error) {
+	var schedules []models.ReportSchedule
+	if err := r.GetDB().WithContext(ctx).Preload("Owner").Where("report_id = ?", reportID).Order("created_at ASC, id ASC").Find(&schedules).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return schedules, nil
+}
+
+// ListDue retrieves the enabled schedules whose next run is due, longest overdue first
+func (r *reportScheduleRepository) ListDue(ctx context.Context, now time.Time) ([]models.ReportSchedule, error) {
+	var schedules []models.ReportSchedule
+	err := r.GetDB().WithContext(ctx).Preload("Owner").
+		Where("enabled = ? AND next_run_at IS NOT NULL AND next_run_at <= ?", true, now).
+		Order("next_run_at ASC").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return schedules, nil
+}
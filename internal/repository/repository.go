@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
@@ -22,6 +23,48 @@ type Repositories struct {
 	PersonalAccessToken     PersonalAccessTokenRepository
 	SteeringDocument        SteeringDocumentRepository
 	RefreshToken            RefreshTokenRepository
+	Presence                PresenceRepository
+	Draft                   DraftRepository
+	EntityVersion           EntityVersionRepository
+	Audit                   AuditRepository
+	LLMUsage                LLMUsageRepository
+	DigestSubscription      DigestSubscriptionRepository
+	GlossaryTerm            GlossaryTermRepository
+	AssignmentRule          AssignmentRuleRepository
+	StalenessPolicy         StalenessPolicyRepository
+	StaleEntity             StaleEntityRepository
+	ReportSchedule          ReportScheduleRepository
+	APIUsage                APIUsageRepository
+	APIQuota                APIQuotaRepository
+	UserPreference          UserPreferenceRepository
+	ChangeProposal          ChangeProposalRepository
+	CommentModeration       CommentModerationRepository
+	CommentThreadLock       CommentThreadLockRepository
+	GuestInvitation         GuestInvitationRepository
+	CommentDraft            CommentDraftRepository
+	TestRun                 TestRunRepository
+	ReferenceRedirect       ReferenceRedirectRepository
+	EntityTranslation       EntityTranslationRepository
+	SignOff                 SignOffRepository
+	Incident                IncidentRepository
+	MaintenanceWindow       MaintenanceWindowRepository
+	Impersonation           ImpersonationRepository
+	RecentView              RecentViewRepository
+	Favorite                FavoriteRepository
+	BoardRank               BoardRankRepository
+	CalendarFeed            CalendarFeedRepository
+	ChangeFeed              ChangeFeedRepository
+	Statistics              StatisticsRepository
+	SearchIndex             SearchIndexRepository
+	Outbox                  OutboxRepository
+	Reference               ReferenceRepository
+	EntityRelationship      EntityRelationshipRepository
+	Coverage                CoverageRepository
+	Hierarchy               HierarchyRepository
+	EntityCount             EntityCountRepository
+	RequirementRisk         RequirementRiskRepository
+	Portfolio               PortfolioRepository
+	AssigneeSuggestion      AssigneeSuggestionRepository
 }
 
 // NewRepositories creates a new instance of all repositories
@@ -42,31 +85,57 @@ func NewRepositories(db *gorm.DB, redis *redis.Client) *Repositories {
 		PersonalAccessToken:     NewPersonalAccessTokenRepository(db),
 		SteeringDocument:        NewSteeringDocumentRepository(db),
 		RefreshToken:            NewRefreshTokenRepository(db),
+		Presence:                NewPresenceRepository(db),
+		Draft:                   NewDraftRepository(db),
+		EntityVersion:           NewEntityVersionRepository(db),
+		Audit:                   NewAuditRepository(db),
+		LLMUsage:                NewLLMUsageRepository(db),
+		DigestSubscription:      NewDigestSubscriptionRepository(db),
+		GlossaryTerm:            NewGlossaryTermRepository(db),
+		AssignmentRule:          NewAssignmentRuleRepository(db),
+		StalenessPolicy:         NewStalenessPolicyRepository(db),
+		StaleEntity:             NewStaleEntityRepository(db),
+		ReportSchedule:          NewReportScheduleRepository(db),
+		APIUsage:                NewAPIUsageRepository(db),
+		APIQuota:                NewAPIQuotaRepository(db),
+		UserPreference:          NewUserPreferenceRepository(db),
+		ChangeProposal:          NewChangeProposalRepository(db),
+		CommentModeration:       NewCommentModerationRepository(db),
+		CommentThreadLock:       NewCommentThreadLockRepository(db),
+		GuestInvitation:         NewGuestInvitationRepository(db),
+		CommentDraft:            NewCommentDraftRepository(db),
+		TestRun:                 NewTestRunRepository(db),
+		ReferenceRedirect:       NewReferenceRedirectRepository(db),
+		EntityTranslation:       NewEntityTranslationRepository(db),
+		SignOff:                 NewSignOffRepository(db),
+		Incident:                NewIncidentRepository(db),
+		MaintenanceWindow:       NewMaintenanceWindowRepository(db),
+		Impersonation:           NewImpersonationRepository(db),
+		RecentView:              NewRecentViewRepository(db),
+		Favorite:                NewFavoriteRepository(db),
+		BoardRank:               NewBoardRankRepository(db),
+		CalendarFeed:            NewCalendarFeedRepository(db),
+		ChangeFeed:              NewChangeFeedRepository(db),
+		Statistics:              NewStatisticsRepository(db),
+		SearchIndex:             NewSearchIndexRepository(db),
+		Outbox:                  NewOutboxRepository(db),
+		Reference:               NewReferenceRepository(db),
+		EntityRelationship:      NewEntityRelationshipRepository(db),
+		Coverage:                NewCoverageRepository(db),
+		Hierarchy:               NewHierarchyRepository(db),
+		EntityCount:             NewEntityCountRepository(db),
+		RequirementRisk:         NewRequirementRiskRepository(db),
+		Portfolio:               NewPortfolioRepository(db),
+		AssigneeSuggestion:      NewAssigneeSuggestionRepository(db),
 	}
 }
 
 // WithTransaction executes a function within a database transaction
-// This is a convenience method that can be used when multiple repositories need to work together
-func (r *Repositories) WithTransaction(fn func(*Repositories) error) error {
-	return r.User.WithTransaction(func(tx *gorm.DB) error {
-		// Create new repository instances with the transaction
-		txRepos := &Repositories{
-			User:                    NewUserRepository(tx),
-			Epic:                    NewEpicRepository(tx),
-			UserStory:               NewUserStoryRepository(tx, nil),
-			AcceptanceCriteria:      NewAcceptanceCriteriaRepository(tx),
-			Requirement:             NewRequirementRepository(tx),
-			RequirementType:         NewRequirementTypeRepository(tx),
-			RelationshipType:        NewRelationshipTypeRepository(tx),
-			RequirementRelationship: NewRequirementRelationshipRepository(tx),
-			Comment:                 NewCommentRepository(tx),
-			StatusModel:             NewStatusModelRepository(tx),
-			Status:                  NewStatusRepository(tx),
-			StatusTransition:        NewStatusTransitionRepository(tx),
-			PersonalAccessToken:     NewPersonalAccessTokenRepository(tx),
-			SteeringDocument:        NewSteeringDocumentRepository(tx),
-			RefreshToken:            NewRefreshTokenRepository(tx),
-		}
-		return fn(txRepos)
+// This is a convenience method that can be used when multiple repositories need to work together.
+// It always starts a new transaction; use a UnitOfWork to join the transaction of the caller.
+func (r *Repositories) WithTransaction(ctx context.Context, fn func(*Repositories) error) error {
+	return r.User.WithTransaction(ctx, func(tx *gorm.DB) error {
+		// Create new repository instances with the transaction; the user story cache is bypassed
+		return fn(NewRepositories(tx, nil))
 	})
 }
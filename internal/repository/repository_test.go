@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,12 +40,13 @@ func TestNewRepositories(t *testing.T) {
 }
 
 func TestRepositories_WithTransaction(t *testing.T) {
+	ctx := context.Background()
 	db := setupRepositoryTestDB(t)
 	repos := NewRepositories(db, nil)
 
 	// Test successful transaction
 	var createdUser *models.User
-	err := repos.WithTransaction(func(txRepos *Repositories) error {
+	err := repos.WithTransaction(ctx, func(txRepos *Repositories) error {
 		user := &models.User{
 			Username:     "testuser",
 			Email:        "test@example.com",
@@ -52,7 +54,7 @@ func TestRepositories_WithTransaction(t *testing.T) {
 			Role:         models.RoleUser,
 		}
 
-		if err := txRepos.User.Create(user); err != nil {
+		if err := txRepos.User.Create(ctx, user); err != nil {
 			return err
 		}
 
@@ -64,17 +66,18 @@ func TestRepositories_WithTransaction(t *testing.T) {
 	assert.NotNil(t, createdUser)
 
 	// Verify user was created
-	retrieved, err := repos.User.GetByID(createdUser.ID)
+	retrieved, err := repos.User.GetByID(ctx, createdUser.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, createdUser.Username, retrieved.Username)
 }
 
 func TestRepositories_WithTransaction_Rollback(t *testing.T) {
+	ctx := context.Background()
 	db := setupRepositoryTestDB(t)
 	repos := NewRepositories(db, nil)
 
 	// Test transaction rollback
-	err := repos.WithTransaction(func(txRepos *Repositories) error {
+	err := repos.WithTransaction(ctx, func(txRepos *Repositories) error {
 		user := &models.User{
 			Username:     "testuser",
 			Email:        "test@example.com",
@@ -82,7 +85,7 @@ func TestRepositories_WithTransaction_Rollback(t *testing.T) {
 			Role:         models.RoleUser,
 		}
 
-		if err := txRepos.User.Create(user); err != nil {
+		if err := txRepos.User.Create(ctx, user); err != nil {
 			return err
 		}
 
@@ -93,12 +96,13 @@ func TestRepositories_WithTransaction_Rollback(t *testing.T) {
 	assert.Error(t, err)
 
 	// Verify user was not created due to rollback
-	count, err := repos.User.Count(nil)
+	count, err := repos.User.Count(ctx, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), count)
 }
 
 func TestRepositories_IntegrationWorkflow(t *testing.T) {
+	ctx := context.Background()
 	db := setupRepositoryTestDB(t)
 	repos := NewRepositories(db, nil)
 
@@ -107,7 +111,7 @@ func TestRepositories_IntegrationWorkflow(t *testing.T) {
 	require.NoError(t, err)
 
 	// Create a complete workflow: User -> Epic -> UserStory -> AcceptanceCriteria -> Requirement
-	err = repos.WithTransaction(func(txRepos *Repositories) error {
+	err = repos.WithTransaction(ctx, func(txRepos *Repositories) error {
 		// Create user
 		user := &models.User{
 			Username:     "testuser",
@@ -115,7 +119,7 @@ func TestRepositories_IntegrationWorkflow(t *testing.T) {
 			PasswordHash: "hashed_password",
 			Role:         models.RoleUser,
 		}
-		if err := txRepos.User.Create(user); err != nil {
+		if err := txRepos.User.Create(ctx, user); err != nil {
 			return err
 		}
 
@@ -128,7 +132,7 @@ func TestRepositories_IntegrationWorkflow(t *testing.T) {
 			Status:      models.EpicStatusBacklog,
 			Title:       "Test Epic",
 		}
-		if err := txRepos.Epic.Create(epic); err != nil {
+		if err := txRepos.Epic.Create(ctx, epic); err != nil {
 			return err
 		}
 
@@ -142,7 +146,7 @@ func TestRepositories_IntegrationWorkflow(t *testing.T) {
 			Status:      models.UserStoryStatusBacklog,
 			Title:       "Test User Story",
 		}
-		if err := txRepos.UserStory.Create(userStory); err != nil {
+		if err := txRepos.UserStory.Create(ctx, userStory); err != nil {
 			return err
 		}
 
@@ -153,12 +157,12 @@ func TestRepositories_IntegrationWorkflow(t *testing.T) {
 			AuthorID:    user.ID,
 			Description: "WHEN user performs action THEN system SHALL respond",
 		}
-		if err := txRepos.AcceptanceCriteria.Create(acceptanceCriteria); err != nil {
+		if err := txRepos.AcceptanceCriteria.Create(ctx, acceptanceCriteria); err != nil {
 			return err
 		}
 
 		// Get a requirement type
-		reqTypes, err := txRepos.RequirementType.List(nil, "", 1, 0)
+		reqTypes, err := txRepos.RequirementType.List(ctx, nil, "", 1, 0)
 		if err != nil {
 			return err
 		}
@@ -178,7 +182,7 @@ func TestRepositories_IntegrationWorkflow(t *testing.T) {
 			TypeID:               reqTypes[0].ID,
 			Title:                "Test Requirement",
 		}
-		if err := txRepos.Requirement.Create(requirement); err != nil {
+		if err := txRepos.Requirement.Create(ctx, requirement); err != nil {
 			return err
 		}
 
@@ -188,19 +192,19 @@ func TestRepositories_IntegrationWorkflow(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Verify the complete hierarchy was created
-	epics, err := repos.Epic.List(nil, "", 0, 0)
+	epics, err := repos.Epic.List(ctx, nil, "", 0, 0)
 	assert.NoError(t, err)
 	assert.Len(t, epics, 1)
 
-	userStories, err := repos.UserStory.GetByEpic(epics[0].ID)
+	userStories, err := repos.UserStory.GetByEpic(ctx, epics[0].ID)
 	assert.NoError(t, err)
 	assert.Len(t, userStories, 1)
 
-	acceptanceCriteria, err := repos.AcceptanceCriteria.GetByUserStory(userStories[0].ID)
+	acceptanceCriteria, err := repos.AcceptanceCriteria.GetByUserStory(ctx, userStories[0].ID)
 	assert.NoError(t, err)
 	assert.Len(t, acceptanceCriteria, 1)
 
-	requirements, err := repos.Requirement.GetByUserStory(userStories[0].ID)
+	requirements, err := repos.Requirement.GetByUserStory(ctx, userStories[0].ID)
 	assert.NoError(t, err)
 	assert.Len(t, requirements, 1)
 }
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -20,27 +21,27 @@ func NewRequirementRelationshipRepository(db *gorm.DB) RequirementRelationshipRe
 }
 
 // GetBySourceRequirement retrieves relationships by source requirement ID
-func (r *requirementRelationshipRepository) GetBySourceRequirement(sourceID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (r *requirementRelationshipRepository) GetBySourceRequirement(ctx context.Context, sourceID uuid.UUID) ([]models.RequirementRelationship, error) {
 	var relationships []models.RequirementRelationship
-	if err := r.GetDB().Where("source_requirement_id = ?", sourceID).Find(&relationships).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("source_requirement_id = ?", sourceID).Find(&relationships).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return relationships, nil
 }
 
 // GetByTargetRequirement retrieves relationships by target requirement ID
-func (r *requirementRelationshipRepository) GetByTargetRequirement(targetID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (r *requirementRelationshipRepository) GetByTargetRequirement(ctx context.Context, targetID uuid.UUID) ([]models.RequirementRelationship, error) {
 	var relationships []models.RequirementRelationship
-	if err := r.GetDB().Where("target_requirement_id = ?", targetID).Find(&relationships).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("target_requirement_id = ?", targetID).Find(&relationships).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return relationships, nil
 }
 
 // GetByRequirement retrieves all relationships for a requirement (both source and target)
-func (r *requirementRelationshipRepository) GetByRequirement(requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (r *requirementRelationshipRepository) GetByRequirement(ctx context.Context, requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
 	var relationships []models.RequirementRelationship
-	if err := r.GetDB().Where("source_requirement_id = ? OR target_requirement_id = ?",
+	if err := r.GetDB().WithContext(ctx).Where("source_requirement_id = ? OR target_requirement_id = ?",
 		requirementID, requirementID).Find(&relationships).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
@@ -48,18 +49,18 @@ func (r *requirementRelationshipRepository) GetByRequirement(requirementID uuid.
 }
 
 // GetByRequirementWithPagination retrieves relationships for a requirement with pagination
-func (r *requirementRelationshipRepository) GetByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
+func (r *requirementRelationshipRepository) GetByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
 	var relationships []models.RequirementRelationship
 	var totalCount int64
 
 	// Get total count
-	if err := r.GetDB().Model(&models.RequirementRelationship{}).Where("source_requirement_id = ? OR target_requirement_id = ?",
+	if err := r.GetDB().WithContext(ctx).Model(&models.RequirementRelationship{}).Where("source_requirement_id = ? OR target_requirement_id = ?",
 		requirementID, requirementID).Count(&totalCount).Error; err != nil {
 		return nil, 0, r.handleDBError(err)
 	}
 
 	// Get paginated results
-	if err := r.GetDB().Where("source_requirement_id = ? OR target_requirement_id = ?",
+	if err := r.GetDB().WithContext(ctx).Where("source_requirement_id = ? OR target_requirement_id = ?",
 		requirementID, requirementID).Limit(limit).Offset(offset).Find(&relationships).Error; err != nil {
 		return nil, 0, r.handleDBError(err)
 	}
@@ -68,21 +69,52 @@ func (r *requirementRelationshipRepository) GetByRequirementWithPagination(requi
 }
 
 // GetByType retrieves relationships by relationship type ID
-func (r *requirementRelationshipRepository) GetByType(typeID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (r *requirementRelationshipRepository) GetByType(ctx context.Context, typeID uuid.UUID) ([]models.RequirementRelationship, error) {
 	var relationships []models.RequirementRelationship
-	if err := r.GetDB().Where("relationship_type_id = ?", typeID).Find(&relationships).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("relationship_type_id = ?", typeID).Find(&relationships).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return relationships, nil
 }
 
 // ExistsRelationship checks if a specific relationship exists
-func (r *requirementRelationshipRepository) ExistsRelationship(sourceID, targetID, typeID uuid.UUID) (bool, error) {
+func (r *requirementRelationshipRepository) ExistsRelationship(ctx context.Context, sourceID, targetID, typeID uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.RequirementRelationship{}).
+	if err := r.GetDB().WithContext(ctx).Model(&models.RequirementRelationship{}).
 		Where("source_requirement_id = ? AND target_requirement_id = ? AND relationship_type_id = ?",
 			sourceID, targetID, typeID).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
+
+// ListByRequirements retrieves the relationships from or to any of the given requirements with their relationship types
+func (r *requirementRelationshipRepository) ListByRequirements(ctx context.Context, requirementIDs []uuid.UUID) ([]models.RequirementRelationship, error) {
+	var relationships []models.RequirementRelationship
+	if len(requirementIDs) == 0 {
+		return relationships, nil
+	}
+	if err := r.GetDB().WithContext(ctx).Preload("RelationshipType").
+		Where("source_requirement_id IN ? OR target_requirement_id IN ?", requirementIDs, requirementIDs).
+		Order("created_at ASC").Find(&relationships).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return relationships, nil
+}
+
+// ListGraphNodes retrieves requirements with their user story and epic, ordered by reference ID.
+// Filter keys are qualified column names such as "requirements.id" or "user_stories.epic_id".
+func (r *requirementRelationshipRepository) ListGraphNodes(ctx context.Context, filters map[string]interface{}) ([]RequirementGraphNode, error) {
+	var nodes []RequirementGraphNode
+	if err := r.GetDB().WithContext(ctx).Table("requirements").
+		Select("requirements.id, requirements.reference_id, requirements.title, requirements.status, " +
+			"user_stories.id AS user_story_id, user_stories.reference_id AS user_story_reference_id, " +
+			"user_stories.title AS user_story_title, user_stories.epic_id").
+		Joins("JOIN user_stories ON user_stories.id = requirements.user_story_id").
+		Where(filters).
+		Order("requirements.reference_id ASC").
+		Scan(&nodes).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return nodes, nil
+}
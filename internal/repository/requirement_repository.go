@@ -1,14 +1,19 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"product-requirements-management/internal/models"
 )
 
+// linkedToAcceptanceCriteria selects the requirements linked to an acceptance criteria
+const linkedToAcceptanceCriteria = "requirements.id IN (SELECT requirement_id FROM requirement_acceptance_criteria WHERE acceptance_criteria_id = ?)"
+
 // requirementRepository implements RequirementRepository interface
 type requirementRepository struct {
 	*BaseRepository[models.Requirement]
@@ -22,9 +27,9 @@ func NewRequirementRepository(db *gorm.DB) RequirementRepository {
 }
 
 // GetWithRelationships retrieves a requirement with its relationships
-func (r *requirementRepository) GetWithRelationships(id uuid.UUID) (*models.Requirement, error) {
+func (r *requirementRepository) GetWithRelationships(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	var requirement models.Requirement
-	if err := r.GetDB().Preload("SourceRelationships").Preload("TargetRelationships").Where("id = ?", id).First(&requirement).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("SourceRelationships").Preload("TargetRelationships").Where("id = ?", id).First(&requirement).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -34,74 +39,74 @@ func (r *requirementRepository) GetWithRelationships(id uuid.UUID) (*models.Requ
 }
 
 // GetByUserStory retrieves requirements by user story ID
-func (r *requirementRepository) GetByUserStory(userStoryID uuid.UUID) ([]models.Requirement, error) {
+func (r *requirementRepository) GetByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
 	var requirements []models.Requirement
-	if err := r.GetDB().Where("user_story_id = ?", userStoryID).Find(&requirements).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("user_story_id = ?", userStoryID).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return requirements, nil
 }
 
-// GetByAcceptanceCriteria retrieves requirements by acceptance criteria ID
-func (r *requirementRepository) GetByAcceptanceCriteria(acceptanceCriteriaID uuid.UUID) ([]models.Requirement, error) {
+// GetByAcceptanceCriteria retrieves the requirements linked to an acceptance criteria
+func (r *requirementRepository) GetByAcceptanceCriteria(ctx context.Context, acceptanceCriteriaID uuid.UUID) ([]models.Requirement, error) {
 	var requirements []models.Requirement
-	if err := r.GetDB().Where("acceptance_criteria_id = ?", acceptanceCriteriaID).Find(&requirements).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where(linkedToAcceptanceCriteria, acceptanceCriteriaID).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return requirements, nil
 }
 
 // GetByCreator retrieves requirements by creator ID
-func (r *requirementRepository) GetByCreator(creatorID uuid.UUID) ([]models.Requirement, error) {
+func (r *requirementRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.Requirement, error) {
 	var requirements []models.Requirement
-	if err := r.GetDB().Where("creator_id = ?", creatorID).Find(&requirements).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("creator_id = ?", creatorID).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return requirements, nil
 }
 
 // GetByAssignee retrieves requirements by assignee ID
-func (r *requirementRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.Requirement, error) {
+func (r *requirementRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.Requirement, error) {
 	var requirements []models.Requirement
-	if err := r.GetDB().Where("assignee_id = ?", assigneeID).Find(&requirements).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("assignee_id = ?", assigneeID).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return requirements, nil
 }
 
 // GetByStatus retrieves requirements by status
-func (r *requirementRepository) GetByStatus(status models.RequirementStatus) ([]models.Requirement, error) {
+func (r *requirementRepository) GetByStatus(ctx context.Context, status models.RequirementStatus) ([]models.Requirement, error) {
 	var requirements []models.Requirement
-	if err := r.GetDB().Where("status = ?", status).Find(&requirements).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("status = ?", status).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return requirements, nil
 }
 
 // GetByPriority retrieves requirements by priority
-func (r *requirementRepository) GetByPriority(priority models.Priority) ([]models.Requirement, error) {
+func (r *requirementRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.Requirement, error) {
 	var requirements []models.Requirement
-	if err := r.GetDB().Where("priority = ?", priority).Find(&requirements).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("priority = ?", priority).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return requirements, nil
 }
 
 // GetByType retrieves requirements by type ID
-func (r *requirementRepository) GetByType(typeID uuid.UUID) ([]models.Requirement, error) {
+func (r *requirementRepository) GetByType(ctx context.Context, typeID uuid.UUID) ([]models.Requirement, error) {
 	var requirements []models.Requirement
-	if err := r.GetDB().Where("type_id = ?", typeID).Find(&requirements).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("type_id = ?", typeID).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return requirements, nil
 }
 
 // HasRelationships checks if a requirement has any relationships
-func (r *requirementRepository) HasRelationships(id uuid.UUID) (bool, error) {
+func (r *requirementRepository) HasRelationships(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
 
 	// Check source relationships
-	if err := r.GetDB().Model(&models.RequirementRelationship{}).Where("source_requirement_id = ?", id).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.RequirementRelationship{}).Where("source_requirement_id = ?", id).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	if count > 0 {
@@ -109,7 +114,7 @@ func (r *requirementRepository) HasRelationships(id uuid.UUID) (bool, error) {
 	}
 
 	// Check target relationships
-	if err := r.GetDB().Model(&models.RequirementRelationship{}).Where("target_requirement_id = ?", id).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.RequirementRelationship{}).Where("target_requirement_id = ?", id).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 
@@ -117,12 +122,12 @@ func (r *requirementRepository) HasRelationships(id uuid.UUID) (bool, error) {
 }
 
 // SearchByText performs full-text search on requirements
-func (r *requirementRepository) SearchByText(searchText string) ([]models.Requirement, error) {
+func (r *requirementRepository) SearchByText(ctx context.Context, searchText string) ([]models.Requirement, error) {
 	var requirements []models.Requirement
 
 	// Use LIKE for compatibility with SQLite (tests) and PostgreSQL
 	searchPattern := "%" + searchText + "%"
-	if err := r.GetDB().Where("title LIKE ? OR description LIKE ? OR reference_id LIKE ?",
+	if err := r.GetDB().WithContext(ctx).Where("title LIKE ? OR description LIKE ? OR reference_id LIKE ?",
 		searchPattern, searchPattern, searchPattern).Find(&requirements).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
@@ -131,7 +136,7 @@ func (r *requirementRepository) SearchByText(searchText string) ([]models.Requir
 }
 
 // SearchByTextWithPagination performs full-text search on requirements with pagination
-func (r *requirementRepository) SearchByTextWithPagination(searchText string, limit, offset int) ([]models.Requirement, int64, error) {
+func (r *requirementRepository) SearchByTextWithPagination(ctx context.Context, searchText string, limit, offset int) ([]models.Requirement, int64, error) {
 	var requirements []models.Requirement
 	var totalCount int64
 
@@ -139,13 +144,13 @@ func (r *requirementRepository) SearchByTextWithPagination(searchText string, li
 	searchPattern := "%" + searchText + "%"
 
 	// Get total count
-	if err := r.GetDB().Model(&models.Requirement{}).Where("title LIKE ? OR description LIKE ? OR reference_id LIKE ?",
+	if err := r.GetDB().WithContext(ctx).Model(&models.Requirement{}).Where("title LIKE ? OR description LIKE ? OR reference_id LIKE ?",
 		searchPattern, searchPattern, searchPattern).Count(&totalCount).Error; err != nil {
 		return nil, 0, r.handleDBError(err)
 	}
 
 	// Get paginated results
-	if err := r.GetDB().Where("title LIKE ? OR description LIKE ? OR reference_id LIKE ?",
+	if err := r.GetDB().WithContext(ctx).Where("title LIKE ? OR description LIKE ? OR reference_id LIKE ?",
 		searchPattern, searchPattern, searchPattern).Limit(limit).Offset(offset).Find(&requirements).Error; err != nil {
 		return nil, 0, r.handleDBError(err)
 	}
@@ -154,13 +159,14 @@ func (r *requirementRepository) SearchByTextWithPagination(searchText string, li
 }
 
 // GetByIDWithPreloads retrieves a requirement by its ID with all relationships preloaded
-func (r *requirementRepository) GetByIDWithPreloads(id uuid.UUID) (*models.Requirement, error) {
+func (r *requirementRepository) GetByIDWithPreloads(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	var requirement models.Requirement
-	if err := r.GetDB().
+	if err := r.GetDB().WithContext(ctx).
 		Preload("Creator").
 		Preload("Assignee").
 		Preload("UserStory").
 		Preload("AcceptanceCriteria").
+		Preload("AcceptanceCriteriaLinks", orderAcceptanceCriteriaLinks).
 		Preload("Type").
 		Where("id = ?", id).First(&requirement).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -172,13 +178,14 @@ func (r *requirementRepository) GetByIDWithPreloads(id uuid.UUID) (*models.Requi
 }
 
 // GetByReferenceIDWithPreloads retrieves a requirement by its reference ID with all relationships preloaded
-func (r *requirementRepository) GetByReferenceIDWithPreloads(referenceID string) (*models.Requirement, error) {
+func (r *requirementRepository) GetByReferenceIDWithPreloads(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	var requirement models.Requirement
-	if err := r.GetDB().
+	if err := r.GetDB().WithContext(ctx).
 		Preload("Creator").
 		Preload("Assignee").
 		Preload("UserStory").
 		Preload("AcceptanceCriteria").
+		Preload("AcceptanceCriteriaLinks", orderAcceptanceCriteriaLinks).
 		Preload("Type").
 		Where("reference_id = ?", referenceID).First(&requirement).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -190,10 +197,10 @@ func (r *requirementRepository) GetByReferenceIDWithPreloads(referenceID string)
 }
 
 // ListWithPreloads retrieves requirements with all relationships preloaded
-func (r *requirementRepository) ListWithPreloads(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
+func (r *requirementRepository) ListWithPreloads(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
 	var requirements []models.Requirement
 
-	query := r.GetDB().
+	query := r.GetDB().WithContext(ctx).
 		Preload("Creator").
 		Preload("Assignee").
 		Preload("UserStory").
@@ -201,9 +208,7 @@ func (r *requirementRepository) ListWithPreloads(filters map[string]interface{},
 		Preload("Type")
 
 	// Apply filters
-	for key, value := range filters {
-		query = query.Where(key+" = ?", value)
-	}
+	query = applyRequirementFilters(query, filters)
 
 	// Apply ordering
 	if orderBy != "" {
@@ -224,3 +229,100 @@ func (r *requirementRepository) ListWithPreloads(filters map[string]interface{},
 
 	return requirements, nil
 }
+
+// Count returns the number of requirements matching the given filters
+func (r *requirementRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	var count int64
+	if err := applyRequirementFilters(r.GetDB().WithContext(ctx).Model(&models.Requirement{}), filters).Count(&count).Error; err != nil {
+		return 0, r.handleDBError(err)
+	}
+	return count, nil
+}
+
+// GetLinkedAcceptanceCriteria retrieves the acceptance criteria linked to a requirement in the order they were linked
+func (r *requirementRepository) GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+	var acceptanceCriteria []models.AcceptanceCriteria
+	err := r.GetDB().WithContext(ctx).
+		Joins("JOIN requirement_acceptance_criteria ON requirement_acceptance_criteria.acceptance_criteria_id = acceptance_criteria.id").
+		Where("requirement_acceptance_criteria.requirement_id = ?", requirementID).
+		Order("requirement_acceptance_criteria.created_at ASC, acceptance_criteria.reference_id ASC").
+		Find(&acceptanceCriteria).Error
+	if err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return acceptanceCriteria, nil
+}
+
+// LinkAcceptanceCriteria links a requirement to an acceptance criteria. Linking is idempotent; the acceptance
+// criteria becomes the primary one of a requirement without any.
+func (r *requirementRepository) LinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error {
+	err := r.WithTransaction(ctx, func(tx *gorm.DB) error {
+		var requirement models.Requirement
+		if err := tx.Where("id = ?", requirementID).First(&requirement).Error; err != nil {
+			return err
+		}
+		link := models.RequirementAcceptanceCriteria{RequirementID: requirementID, AcceptanceCriteriaID: acceptanceCriteriaID}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&link).Error; err != nil {
+			return err
+		}
+		if requirement.AcceptanceCriteriaID != nil {
+			return nil
+		}
+		requirement.AcceptanceCriteriaID = &acceptanceCriteriaID
+		return tx.Save(&requirement).Error
+	})
+	return r.handleDBError(err)
+}
+
+// UnlinkAcceptanceCriteria removes the link between a requirement and an acceptance criteria. When the primary
+// acceptance criteria is unlinked, the earliest remaining link becomes the primary one. Returns ErrNotFound
+// if the requirement is not linked to the acceptance criteria.
+func (r *requirementRepository) UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error {
+	err := r.WithTransaction(ctx, func(tx *gorm.DB) error {
+		result := tx.Where("requirement_id = ? AND acceptance_criteria_id = ?", requirementID, acceptanceCriteriaID).
+			Delete(&models.RequirementAcceptanceCriteria{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		var requirement models.Requirement
+		if err := tx.Where("id = ?", requirementID).First(&requirement).Error; err != nil {
+			return err
+		}
+		if requirement.AcceptanceCriteriaID == nil || *requirement.AcceptanceCriteriaID != acceptanceCriteriaID {
+			return nil
+		}
+
+		var remaining []models.RequirementAcceptanceCriteria
+		if err := orderAcceptanceCriteriaLinks(tx.Where("requirement_id = ?", requirementID)).Limit(1).Find(&remaining).Error; err != nil {
+			return err
+		}
+		requirement.AcceptanceCriteriaID = nil
+		if len(remaining) > 0 {
+			requirement.AcceptanceCriteriaID = &remaining[0].AcceptanceCriteriaID
+		}
+		return tx.Save(&requirement).Error
+	})
+	return r.handleDBError(err)
+}
+
+// orderAcceptanceCriteriaLinks orders the acceptance criteria links of requirements oldest first
+func orderAcceptanceCriteriaLinks(db *gorm.DB) *gorm.DB {
+	return db.Order("created_at ASC, acceptance_criteria_id ASC")
+}
+
+// applyRequirementFilters applies equality filters to a requirement query. The acceptance_criteria_id filter
+// matches all requirements linked to the acceptance criteria, not only those having it as their primary one.
+func applyRequirementFilters(query *gorm.DB, filters map[string]interface{}) *gorm.DB {
+	for key, value := range filters {
+		if key == "acceptance_criteria_id" {
+			query = query.Where(linkedToAcceptanceCriteria, value)
+			continue
+		}
+		query = query.Where(key+" = ?", value)
+	}
+	return query
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -31,6 +32,7 @@ func setupRequirementTestDB(t *testing.T) *gorm.DB {
 		&models.AcceptanceCriteria{},
 		&models.RequirementRelationship{},
 		&models.RelationshipType{},
+		&models.RequirementAcceptanceCriteria{},
 	)
 	require.NoError(t, err)
 
@@ -72,6 +74,7 @@ func createTestRequirement(t *testing.T, db *gorm.DB, userStory *models.UserStor
 // TestRequirementRepository_Create tests the Create method
 // References: AC-739 - Create returns requirement ID and commits; insert errors surface without committing
 func TestRequirementRepository_Create(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -90,7 +93,7 @@ func TestRequirementRepository_Create(t *testing.T) {
 		ReferenceID: "REQ-001", // Set manually to avoid PostgreSQL function call
 	}
 
-	err := repo.Create(requirement)
+	err := repo.Create(ctx, requirement)
 	require.NoError(t, err)
 	assert.NotEmpty(t, requirement.ID)
 	assert.Equal(t, "REQ-001", requirement.ReferenceID)
@@ -100,6 +103,7 @@ func TestRequirementRepository_Create(t *testing.T) {
 // TestRequirementRepository_GetByID tests the GetByID method
 // References: AC-740 - Get by ID returns full requirement; missing record yields not-found
 func TestRequirementRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -110,7 +114,7 @@ func TestRequirementRepository_GetByID(t *testing.T) {
 	requirement := createTestRequirement(t, db, userStory, user, reqType, "REQ-001")
 
 	// Test successful retrieval
-	retrieved, err := repo.GetByID(requirement.ID)
+	retrieved, err := repo.GetByID(ctx, requirement.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, requirement.ID, retrieved.ID)
 	assert.Equal(t, requirement.ReferenceID, retrieved.ReferenceID)
@@ -120,11 +124,12 @@ func TestRequirementRepository_GetByID(t *testing.T) {
 // TestRequirementRepository_GetByID_NotFound tests GetByID with non-existent ID
 // References: AC-740 - Missing record yields not-found
 func TestRequirementRepository_GetByID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
 	nonExistentID := uuid.New()
-	req, err := repo.GetByID(nonExistentID)
+	req, err := repo.GetByID(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, req)
@@ -133,6 +138,7 @@ func TestRequirementRepository_GetByID_NotFound(t *testing.T) {
 // TestRequirementRepository_GetByReferenceID tests the GetByReferenceID method
 // References: AC-740 - Get by ReferenceID returns full requirement; missing record yields not-found
 func TestRequirementRepository_GetByReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -143,7 +149,7 @@ func TestRequirementRepository_GetByReferenceID(t *testing.T) {
 	requirement := createTestRequirement(t, db, userStory, user, reqType, "REQ-001")
 
 	// Test successful retrieval
-	retrieved, err := repo.GetByReferenceID(requirement.ReferenceID)
+	retrieved, err := repo.GetByReferenceID(ctx, requirement.ReferenceID)
 	assert.NoError(t, err)
 	assert.Equal(t, requirement.ID, retrieved.ID)
 	assert.Equal(t, requirement.ReferenceID, retrieved.ReferenceID)
@@ -153,10 +159,11 @@ func TestRequirementRepository_GetByReferenceID(t *testing.T) {
 // TestRequirementRepository_GetByReferenceID_NotFound tests GetByReferenceID with non-existent reference ID
 // References: AC-740 - Missing record yields not-found
 func TestRequirementRepository_GetByReferenceID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
-	req, err := repo.GetByReferenceID("REQ-999")
+	req, err := repo.GetByReferenceID(ctx, "REQ-999")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, req)
@@ -165,6 +172,7 @@ func TestRequirementRepository_GetByReferenceID_NotFound(t *testing.T) {
 // TestRequirementRepository_Update tests the Update method
 // References: AC-741 - Update performs a single UPDATE; conflicts/errors propagate without committing
 func TestRequirementRepository_Update(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -179,11 +187,11 @@ func TestRequirementRepository_Update(t *testing.T) {
 	requirement.Title = newTitle
 	requirement.Status = models.RequirementStatusActive
 
-	err := repo.Update(requirement)
+	err := repo.Update(ctx, requirement)
 	require.NoError(t, err)
 
 	// Verify the update
-	retrieved, err := repo.GetByID(requirement.ID)
+	retrieved, err := repo.GetByID(ctx, requirement.ID)
 	require.NoError(t, err)
 	assert.Equal(t, newTitle, retrieved.Title)
 	assert.Equal(t, models.RequirementStatusActive, retrieved.Status)
@@ -192,6 +200,7 @@ func TestRequirementRepository_Update(t *testing.T) {
 // TestRequirementRepository_Delete tests the Delete method
 // References: AC-742 - Delete removes requirement; FK/relationship conflicts surface and record remains
 func TestRequirementRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -202,11 +211,11 @@ func TestRequirementRepository_Delete(t *testing.T) {
 	requirement := createTestRequirement(t, db, userStory, user, reqType, "REQ-001")
 
 	// Delete the requirement
-	err := repo.Delete(requirement.ID)
+	err := repo.Delete(ctx, requirement.ID)
 	require.NoError(t, err)
 
 	// Verify deletion
-	retrieved, err := repo.GetByID(requirement.ID)
+	retrieved, err := repo.GetByID(ctx, requirement.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
@@ -217,6 +226,7 @@ func TestRequirementRepository_Delete(t *testing.T) {
 // Note: RequirementRepository.Create() has retry logic in PostgreSQL, but in SQLite with FK constraints enabled,
 // the UNIQUE constraint is enforced immediately. This test validates the constraint is respected.
 func TestRequirementRepository_Create_DuplicateReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -236,7 +246,7 @@ func TestRequirementRepository_Create_DuplicateReferenceID(t *testing.T) {
 		TypeID:      reqType.ID,
 		ReferenceID: "REQ-001",
 	}
-	err := baseRepo.Create(req1)
+	err := baseRepo.Create(ctx, req1)
 	require.NoError(t, err)
 
 	// Try to create second requirement with same ReferenceID using BaseRepository
@@ -250,12 +260,12 @@ func TestRequirementRepository_Create_DuplicateReferenceID(t *testing.T) {
 		ReferenceID: "REQ-001", // Duplicate!
 	}
 
-	err = baseRepo.Create(req2)
+	err = baseRepo.Create(ctx, req2)
 	assert.Error(t, err) // Should fail with constraint error
 	assert.Contains(t, err.Error(), "UNIQUE")
 
 	// Verify original record still exists and is unchanged
-	retrieved, err := repo.GetByReferenceID("REQ-001")
+	retrieved, err := repo.GetByReferenceID(ctx, "REQ-001")
 	assert.NoError(t, err)
 	assert.Equal(t, req1.ID, retrieved.ID)
 	assert.Equal(t, req1.Title, retrieved.Title)
@@ -268,6 +278,7 @@ func TestRequirementRepository_Create_DuplicateReferenceID(t *testing.T) {
 // TestRequirementRepository_Delete_WithRelationships tests FK constraint on delete with relationships
 // References: AC-742 - FK/relationship conflicts surface and record remains
 func TestRequirementRepository_Delete_WithRelationships(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -302,11 +313,11 @@ func TestRequirementRepository_Delete_WithRelationships(t *testing.T) {
 	require.NoError(t, err)
 
 	// Delete source requirement - should succeed with CASCADE
-	err = repo.Delete(sourceReq.ID)
+	err = repo.Delete(ctx, sourceReq.ID)
 	assert.NoError(t, err)
 
 	// Verify requirement is deleted
-	retrieved, err := repo.GetByID(sourceReq.ID)
+	retrieved, err := repo.GetByID(ctx, sourceReq.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
@@ -320,6 +331,7 @@ func TestRequirementRepository_Delete_WithRelationships(t *testing.T) {
 // TestRequirementRepository_GetWithRelationships tests GetWithRelationships method
 // References: AC-743 - CRUD tests are isolated on mocked or in-memory DB, idempotent and independent
 func TestRequirementRepository_GetWithRelationships(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -352,7 +364,7 @@ func TestRequirementRepository_GetWithRelationships(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get requirement with relationships
-	retrieved, err := repo.GetWithRelationships(sourceReq.ID)
+	retrieved, err := repo.GetWithRelationships(ctx, sourceReq.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, sourceReq.ID, retrieved.ID)
 	assert.Len(t, retrieved.SourceRelationships, 1)
@@ -364,6 +376,7 @@ func TestRequirementRepository_GetWithRelationships(t *testing.T) {
 // TestRequirementRepository_GetByUserStory tests filtering by user story ID
 // References: AC-744 - Filter by user_story_id returns only matching requirements; missing story yields empty result
 func TestRequirementRepository_GetByUserStory(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -379,18 +392,18 @@ func TestRequirementRepository_GetByUserStory(t *testing.T) {
 	createTestRequirement(t, db, userStory2, user, reqType, "REQ-003")
 
 	// Test filter by userStory1
-	result, err := repo.GetByUserStory(userStory1.ID)
+	result, err := repo.GetByUserStory(ctx, userStory1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by userStory2
-	result, err = repo.GetByUserStory(userStory2.ID)
+	result, err = repo.GetByUserStory(ctx, userStory2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by non-existent user story
 	nonExistentID := uuid.New()
-	result, err = repo.GetByUserStory(nonExistentID)
+	result, err = repo.GetByUserStory(ctx, nonExistentID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -398,6 +411,7 @@ func TestRequirementRepository_GetByUserStory(t *testing.T) {
 // TestRequirementRepository_GetByType tests filtering by type ID
 // References: AC-745 - Filter by type_id returns only that type; unknown type_id yields empty result
 func TestRequirementRepository_GetByType(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -414,18 +428,18 @@ func TestRequirementRepository_GetByType(t *testing.T) {
 	createTestRequirement(t, db, userStory, user, reqTypeNonFunctional, "REQ-003")
 
 	// Test filter by Functional type
-	result, err := repo.GetByType(reqTypeFunctional.ID)
+	result, err := repo.GetByType(ctx, reqTypeFunctional.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by Non-Functional type
-	result, err = repo.GetByType(reqTypeNonFunctional.ID)
+	result, err = repo.GetByType(ctx, reqTypeNonFunctional.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by non-existent type
 	nonExistentID := uuid.New()
-	result, err = repo.GetByType(nonExistentID)
+	result, err = repo.GetByType(ctx, nonExistentID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -433,6 +447,7 @@ func TestRequirementRepository_GetByType(t *testing.T) {
 // TestRequirementRepository_GetByStatus tests filtering by status
 // References: AC-746 - Filter by status returns only matching requirements; invalid status yields empty result
 func TestRequirementRepository_GetByStatus(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -455,17 +470,17 @@ func TestRequirementRepository_GetByStatus(t *testing.T) {
 	db.Save(req3)
 
 	// Test filter by Draft status
-	result, err := repo.GetByStatus(models.RequirementStatusDraft)
+	result, err := repo.GetByStatus(ctx, models.RequirementStatusDraft)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by Active status
-	result, err = repo.GetByStatus(models.RequirementStatusActive)
+	result, err = repo.GetByStatus(ctx, models.RequirementStatusActive)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by Obsolete status (should be empty)
-	result, err = repo.GetByStatus(models.RequirementStatusObsolete)
+	result, err = repo.GetByStatus(ctx, models.RequirementStatusObsolete)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -473,6 +488,7 @@ func TestRequirementRepository_GetByStatus(t *testing.T) {
 // TestRequirementRepository_GetByIDWithPreloads tests preloading of related entities
 // References: AC-747 - With preload enabled, acceptance_criteria and relationships load in minimal queries
 func TestRequirementRepository_GetByIDWithPreloads(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -502,11 +518,11 @@ func TestRequirementRepository_GetByIDWithPreloads(t *testing.T) {
 		AcceptanceCriteriaID: &ac.ID,
 		ReferenceID:          "REQ-001",
 	}
-	err = repo.Create(requirement)
+	err = repo.Create(ctx, requirement)
 	require.NoError(t, err)
 
 	// Get requirement with preloads
-	retrieved, err := repo.GetByIDWithPreloads(requirement.ID)
+	retrieved, err := repo.GetByIDWithPreloads(ctx, requirement.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, requirement.ID, retrieved.ID)
 	// Verify preloads
@@ -521,6 +537,7 @@ func TestRequirementRepository_GetByIDWithPreloads(t *testing.T) {
 // TestRequirementRepository_ListWithPreloads tests listing with preloads
 // References: AC-748 - DB interactions in filter/preload tests are mocked/verified; no external dependencies
 func TestRequirementRepository_ListWithPreloads(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -537,7 +554,7 @@ func TestRequirementRepository_ListWithPreloads(t *testing.T) {
 	filters := map[string]interface{}{
 		"user_story_id": userStory.ID,
 	}
-	result, err := repo.ListWithPreloads(filters, "created_at ASC", 10, 0)
+	result, err := repo.ListWithPreloads(ctx, filters, "created_at ASC", 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	// Verify preloads
@@ -549,6 +566,7 @@ func TestRequirementRepository_ListWithPreloads(t *testing.T) {
 // TestRequirementRepository_GetByAcceptanceCriteria tests filtering by acceptance criteria ID
 // References: AC-748 - DB interactions in filter/preload tests are mocked/verified; no external dependencies
 func TestRequirementRepository_GetByAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
 	db := setupRequirementTestDB(t)
 	repo := NewRequirementRepository(db)
 
@@ -587,7 +605,7 @@ func TestRequirementRepository_GetByAcceptanceCriteria(t *testing.T) {
 		AcceptanceCriteriaID: &ac1.ID,
 		ReferenceID:          "REQ-001",
 	}
-	err = repo.Create(req1)
+	err = repo.Create(ctx, req1)
 	require.NoError(t, err)
 
 	req2 := &models.Requirement{
@@ -600,16 +618,69 @@ func TestRequirementRepository_GetByAcceptanceCriteria(t *testing.T) {
 		AcceptanceCriteriaID: &ac1.ID,
 		ReferenceID:          "REQ-002",
 	}
-	err = repo.Create(req2)
+	err = repo.Create(ctx, req2)
 	require.NoError(t, err)
 
 	// Test filter by ac1
-	result, err := repo.GetByAcceptanceCriteria(ac1.ID)
+	result, err := repo.GetByAcceptanceCriteria(ctx, ac1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by ac2 (should be empty)
-	result, err = repo.GetByAcceptanceCriteria(ac2.ID)
+	result, err = repo.GetByAcceptanceCriteria(ctx, ac2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
+
+// TestRequirementRepository_LinkAcceptanceCriteria tests linking a requirement to several acceptance criteria
+func TestRequirementRepository_LinkAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
+	db := setupRequirementTestDB(t)
+	repo := NewRequirementRepository(db)
+
+	user := createUserStoryTestUser(t, db, "testuser")
+	epic := createUserStoryTestEpic(t, db, user, "EP-001")
+	userStory := createUserStoryTestUserStory(t, db, epic, user, user, "US-001")
+	reqType := createTestRequirementType(t, db, "Functional")
+	ac1 := createTestAcceptanceCriteria(t, db, userStory, user, "AC-001")
+	ac2 := createTestAcceptanceCriteria(t, db, userStory, user, "AC-002")
+	requirement := createTestRequirement(t, db, userStory, user, reqType, "REQ-001")
+
+	// The first linked acceptance criteria becomes the primary one
+	require.NoError(t, repo.LinkAcceptanceCriteria(ctx, requirement.ID, ac1.ID))
+	require.NoError(t, repo.LinkAcceptanceCriteria(ctx, requirement.ID, ac2.ID))
+	require.NoError(t, repo.LinkAcceptanceCriteria(ctx, requirement.ID, ac2.ID), "linking is idempotent")
+
+	retrieved, err := repo.GetByIDWithPreloads(ctx, requirement.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retrieved.AcceptanceCriteriaID)
+	assert.Equal(t, ac1.ID, *retrieved.AcceptanceCriteriaID)
+	assert.ElementsMatch(t, []uuid.UUID{ac1.ID, ac2.ID}, retrieved.GetAcceptanceCriteriaIDs())
+
+	linked, err := repo.GetLinkedAcceptanceCriteria(ctx, requirement.ID)
+	require.NoError(t, err)
+	assert.Len(t, linked, 2)
+
+	// The requirement is found through both acceptance criteria
+	for _, ac := range []*models.AcceptanceCriteria{ac1, ac2} {
+		result, err := repo.GetByAcceptanceCriteria(ctx, ac.ID)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, requirement.ID, result[0].ID)
+	}
+
+	// Unlinking the primary acceptance criteria promotes the remaining one
+	require.NoError(t, repo.UnlinkAcceptanceCriteria(ctx, requirement.ID, ac1.ID))
+	retrieved, err = repo.GetByID(ctx, requirement.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retrieved.AcceptanceCriteriaID)
+	assert.Equal(t, ac2.ID, *retrieved.AcceptanceCriteriaID)
+
+	err = repo.UnlinkAcceptanceCriteria(ctx, requirement.ID, ac1.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, repo.UnlinkAcceptanceCriteria(ctx, requirement.ID, ac2.ID))
+	retrieved, err = repo.GetByID(ctx, requirement.ID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved.AcceptanceCriteriaID)
+}
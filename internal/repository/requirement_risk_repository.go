@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// requirementRiskRepository implements RequirementRiskRepository interface
+type requirementRiskRepository struct {
+	db *gorm.DB
+}
+
+// NewRequirementRiskRepository creates a new requirement risk repository instance
+func NewRequirementRiskRepository(db *gorm.DB) RequirementRiskRepository {
+	return &requirementRiskRepository{db: db}
+}
+
+// ListRiskFactors returns the risk factors of the requirements matching the filter, ordered by reference ID
+func (r *requirementRiskRepository) ListRiskFactors(ctx context.Context, filter RequirementRiskFilter) ([]RequirementRiskFactors, error) {
+	if filter.IDs != nil && len(filter.IDs) == 0 {
+		return nil, nil
+	}
+
+	query := r.db.WithContext(ctx).Table("requirements").
+		Select("requirements.id AS requirement_id, requirements.reference_id, requirements.title, requirements.status, "+
+			"requirements.priority, requirements.assignee_id, users.username AS assignee_username, "+
+			"requirements.user_story_id, user_stories.epic_id, requirements.created_at, "+
+			"requirements.acceptance_criteria_id IS NULL AS missing_acceptance_criteria, "+
+			"(SELECT COUNT(*) FROM requirement_relationships "+
+			"JOIN relationship_types ON relationship_types.id = requirement_relationships.relationship_type_id "+
+			"WHERE relationship_types.name = ? AND (requirement_relationships.source_requirement_id = requirements.id "+
+			"OR requirement_relationships.target_requirement_id = requirements.id)) AS blocking_relationships, "+
+			"(SELECT COUNT(*) FROM comments WHERE comments.entity_type = ? AND comments.entity_id = requirements.id "+
+			"AND comments.category = ? AND comments.is_resolved = ?) AS unresolved_blockers",
+			"blocks", models.EntityTypeRequirement, models.CommentCategoryBlocker, false).
+		Joins("JOIN user_stories ON user_stories.id = requirements.user_story_id").
+		Joins("LEFT JOIN users ON users.id = requirements.assignee_id")
+	if filter.IDs != nil {
+		query = query.Where("requirements.id IN ?", filter.IDs)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("requirements.status IN ?", filter.Statuses)
+	}
+	if filter.EpicID != nil {
+		query = query.Where("user_stories.epic_id = ?", *filter.EpicID)
+	}
+	if filter.AssigneeID != nil {
+		query = query.Where("requirements.assignee_id = ?", *filter.AssigneeID)
+	}
+	if filter.Portfolio != nil {
+		query = query.Where("user_stories.epic_id IN (?)", portfolioEpics(r.db.WithContext(ctx), *filter.Portfolio))
+	}
+
+	var items []RequirementRiskFactors
+	if err := query.Order("requirements.reference_id ASC").Scan(&items).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	if err := r.fillDraftSince(ctx, items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// fillDraftSince sets when each Draft requirement last entered Draft: its latest status change to Draft,
+// or its creation when it never changed status
+func (r *requirementRiskRepository) fillDraftSince(ctx context.Context, items []RequirementRiskFactors) error {
+	var ids []uuid.UUID
+	for i := range items {
+		if items[i].Status == models.RequirementStatusDraft {
+			items[i].DraftSince = items[i].CreatedAt
+			ids = append(ids, items[i].RequirementID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var changes []struct {
+		EntityID  uuid.UUID
+		CreatedAt time.Time
+	}
+	err := r.db.WithContext(ctx).Model(&models.AuditEvent{}).
+		Select("entity_id, created_at").
+		Where("entity_type = ? AND action = ? AND new_value = ? AND entity_id IN ?",
+			models.EntityTypeRequirement, models.AuditActionStatusChanged, string(models.RequirementStatusDraft), ids).
+		Scan(&changes).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+
+	latest := make(map[uuid.UUID]time.Time, len(changes))
+	for _, change := range changes {
+		if change.CreatedAt.After(latest[change.EntityID]) {
+			latest[change.EntityID] = change.CreatedAt
+		}
+	}
+	for i := range items {
+		if since, ok := latest[items[i].RequirementID]; ok && since.After(items[i].DraftSince) {
+			items[i].DraftSince = since
+		}
+	}
+	return nil
+}
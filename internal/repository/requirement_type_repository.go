@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"gorm.io/gorm"
@@ -21,9 +22,9 @@ func NewRequirementTypeRepository(db *gorm.DB) RequirementTypeRepository {
 }
 
 // GetByName retrieves a requirement type by name
-func (r *requirementTypeRepository) GetByName(name string) (*models.RequirementType, error) {
+func (r *requirementTypeRepository) GetByName(ctx context.Context, name string) (*models.RequirementType, error) {
 	var reqType models.RequirementType
-	if err := r.GetDB().Where("name = ?", name).First(&reqType).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("name = ?", name).First(&reqType).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -33,9 +34,9 @@ func (r *requirementTypeRepository) GetByName(name string) (*models.RequirementT
 }
 
 // ExistsByName checks if a requirement type exists by name
-func (r *requirementTypeRepository) ExistsByName(name string) (bool, error) {
+func (r *requirementTypeRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.RequirementType{}).Where("name = ?", name).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.RequirementType{}).Where("name = ?", name).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
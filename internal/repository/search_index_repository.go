@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// searchIndexRepository implements SearchIndexRepository interface for PostgreSQL
+type searchIndexRepository struct {
+	db *gorm.DB
+}
+
+// NewSearchIndexRepository creates a new search index repository instance
+func NewSearchIndexRepository(db *gorm.DB) SearchIndexRepository {
+	return &searchIndexRepository{db: db}
+}
+
+// ListSearchIndexes returns the full-text search indexes, i.e. the indexes on to_tsvector expressions,
+// ordered by table and index name
+func (r *searchIndexRepository) ListSearchIndexes(ctx context.Context) ([]SearchIndex, error) {
+	var indexes []SearchIndex
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT i.relname AS index_name, t.relname AS table_name, x.indisvalid AS valid,
+			pg_relation_size(i.oid) AS size_bytes
+		FROM pg_index x
+		JOIN pg_class i ON i.oid = x.indexrelid
+		JOIN pg_class t ON t.oid = x.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE n.nspname = current_schema()
+			AND pg_get_indexdef(x.indexrelid) ILIKE '%to_tsvector%'
+		ORDER BY t.relname, i.relname`).
+		Scan(&indexes).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return indexes, nil
+}
+
+// TableActivity returns the live rows and the rows modified since the last analyze of each table
+func (r *searchIndexRepository) TableActivity(ctx context.Context, tables []string) ([]SearchTableActivity, error) {
+	activity := []SearchTableActivity{}
+	if len(tables) == 0 {
+		return activity, nil
+	}
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT relname AS table_name, n_live_tup AS live_rows, n_mod_since_analyze AS modified_since_analyze,
+			GREATEST(last_analyze, last_autoanalyze) AS last_analyzed_at
+		FROM pg_stat_user_tables
+		WHERE schemaname = current_schema() AND relname IN ?
+		ORDER BY relname`, tables).
+		Scan(&activity).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return activity, nil
+}
+
+// PendingEntries returns the number of entries in the pending list of a GIN index, which are not yet
+// merged into the main index structure. The second result is false when the pgstattuple extension
+// needed to inspect the index is not installed.
+func (r *searchIndexRepository) PendingEntries(ctx context.Context, index string) (int64, bool, error) {
+	var installed int64
+	if err := r.db.WithContext(ctx).Raw("SELECT COUNT(*) FROM pg_extension WHERE extname = 'pgstattuple'").
+		Scan(&installed).Error; err != nil {
+		return 0, false, handleDBError(err)
+	}
+	if installed == 0 {
+		return 0, false, nil
+	}
+
+	var pending int64
+	if err := r.db.WithContext(ctx).Raw("SELECT pending_tuples FROM pgstatginindex(?::regclass)", quoteIdentifier(index)).
+		Scan(&pending).Error; err != nil {
+		return 0, false, handleDBError(err)
+	}
+	return pending, true, nil
+}
+
+// RebuildIndex rebuilds an index without blocking writes to its table.
+// REINDEX CONCURRENTLY cannot run inside a transaction and may take longer than the query timeout,
+// so the statement runs on the connection pool directly and is bounded by ctx only.
+func (r *searchIndexRepository) RebuildIndex(ctx context.Context, index string) error {
+	return r.execMaintenance(ctx, "REINDEX INDEX CONCURRENTLY "+quoteIdentifier(index))
+}
+
+// AnalyzeTable refreshes the planner statistics of a table, so searches use the rebuilt indexes
+func (r *searchIndexRepository) AnalyzeTable(ctx context.Context, table string) error {
+	return r.execMaintenance(ctx, "ANALYZE "+quoteIdentifier(table))
+}
+
+// execMaintenance runs a maintenance statement outside of GORM callbacks and transactions
+func (r *searchIndexRepository) execMaintenance(ctx context.Context, statement string) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	if _, err := sqlDB.ExecContext(ctx, statement); err != nil {
+		return fmt.Errorf("%s: %w", statement, handleDBError(err))
+	}
+	return nil
+}
+
+// GetDB returns the underlying database connection
+func (r *searchIndexRepository) GetDB() *gorm.DB {
+	return r.db
+}
+
+// quoteIdentifier quotes a PostgreSQL identifier read from the catalog
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// signOffRepository implements SignOffRepository interface
+type signOffRepository struct {
+	db *gorm.DB
+}
+
+// NewSignOffRepository creates a new sign-off repository instance
+func NewSignOffRepository(db *gorm.DB) SignOffRepository {
+	return &signOffRepository{db: db}
+}
+
+// CreateStakeholder designates a user to sign off in a role
+func (r *signOffRepository) CreateStakeholder(ctx context.Context, stakeholder *models.SignOffStakeholder) error {
+	return handleDBError(r.db.WithContext(ctx).Create(stakeholder).Error)
+}
+
+// GetStakeholder retrieves the designation of a user in a role
+func (r *signOffRepository) GetStakeholder(ctx context.Context, userID uuid.UUID, role string) (*models.SignOffStakeholder, error) {
+	var stakeholder models.SignOffStakeholder
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND role = ?", userID, role).First(&stakeholder).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return &stakeholder, nil
+}
+
+// ListStakeholders retrieves the designations, optionally of one user, ordered by role with the users preloaded
+func (r *signOffRepository) ListStakeholders(ctx context.Context, userID *uuid.UUID) ([]models.SignOffStakeholder, error) {
+	query := r.db.WithContext(ctx).Preload("User")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	var stakeholders []models.SignOffStakeholder
+	if err := query.Order("role ASC, created_at ASC").Find(&stakeholders).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return stakeholders, nil
+}
+
+// DeleteStakeholder removes a designation; the sign-offs made in it are kept
+func (r *signOffRepository) DeleteStakeholder(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.SignOffStakeholder{})
+	if result.Error != nil {
+		return handleDBError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Create records a sign-off
+func (r *signOffRepository) Create(ctx context.Context, signOff *models.SignOff) error {
+	return handleDBError(r.db.WithContext(ctx).Create(signOff).Error)
+}
+
+// Exists checks whether a stakeholder already signed off a version of an entity in a role
+func (r *signOffRepository) Exists(ctx context.Context, entityType EntityType, entityID, signerID uuid.UUID, role string, versionNumber int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.SignOff{}).
+		Where("entity_type = ? AND entity_id = ? AND signer_id = ? AND role = ? AND version_number = ?",
+			entityType, entityID, signerID, role, versionNumber).
+		Count(&count).Error
+	if err != nil {
+		return false, handleDBError(err)
+	}
+	return count > 0, nil
+}
+
+// ListByEntity retrieves the sign-offs of an entity, oldest first, with the signers preloaded
+func (r *signOffRepository) ListByEntity(ctx context.Context, entityType EntityType, entityID uuid.UUID) ([]models.SignOff, error) {
+	var signOffs []models.SignOff
+	err := r.db.WithContext(ctx).Preload("Signer").
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Order("signed_at ASC, id ASC").
+		Find(&signOffs).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return signOffs, nil
+}
+
+// List retrieves the sign-offs matching the filter, newest first, with the signers preloaded
+func (r *signOffRepository) List(ctx context.Context, filter SignOffFilter) ([]models.SignOff, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.SignOff{})
+	if filter.EntityType != nil {
+		query = query.Where("entity_type = ?", *filter.EntityType)
+	}
+	if filter.SignerID != nil {
+		query = query.Where("signer_id = ?", *filter.SignerID)
+	}
+	if filter.From != nil {
+		query = query.Where("signed_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("signed_at < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	var signOffs []models.SignOff
+	if err := query.Preload("Signer").Order("signed_at DESC, id ASC").Limit(filter.Limit).Offset(filter.Offset).Find(&signOffs).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	return signOffs, total, nil
+}
+
+// ListSignOffStates returns the Active requirements with their current version and their latest sign-off,
+// ordered by reference ID
+func (r *signOffRepository) ListSignOffStates(ctx context.Context, epicID *uuid.UUID) ([]RequirementSignOffState, error) {
+	query := r.db.WithContext(ctx).Table("requirements").
+		Select("requirements.id AS requirement_id, requirements.reference_id, requirements.title, requirements.priority, "+
+			"requirements.assignee_id, users.username AS assignee_username, requirements.user_story_id, user_stories.epic_id, "+
+			"COALESCE((SELECT MAX(entity_versions.version_number) FROM entity_versions "+
+			"WHERE entity_versions.entity_type = ? AND entity_versions.entity_id = requirements.id), 0) AS current_version",
+			models.EntityTypeRequirement).
+		Joins("JOIN user_stories ON user_stories.id = requirements.user_story_id").
+		Joins("LEFT JOIN users ON users.id = requirements.assignee_id").
+		Where("requirements.status = ?", models.RequirementStatusActive)
+	if epicID != nil {
+		query = query.Where("user_stories.epic_id = ?", *epicID)
+	}
+
+	var states []RequirementSignOffState
+	if err := query.Order("requirements.reference_id ASC").Scan(&states).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	if err := r.fillLatestSignOffs(ctx, states); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+// fillLatestSignOffs sets the latest signed version and sign-off time of each requirement that was signed off
+func (r *signOffRepository) fillLatestSignOffs(ctx context.Context, states []RequirementSignOffState) error {
+	if len(states) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(states))
+	for i := range states {
+		ids[i] = states[i].RequirementID
+	}
+
+	var signOffs []models.SignOff
+	err := r.db.WithContext(ctx).Select("entity_id, version_number, signed_at").
+		Where("entity_type = ? AND entity_id IN ?", models.EntityTypeRequirement, ids).
+		Find(&signOffs).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+
+	latest := make(map[uuid.UUID]*models.SignOff, len(signOffs))
+	for i := range signOffs {
+		signOff := &signOffs[i]
+		current, ok := latest[signOff.EntityID]
+		if !ok {
+			latest[signOff.EntityID] = &models.SignOff{VersionNumber: signOff.VersionNumber, SignedAt: signOff.SignedAt}
+			continue
+		}
+		if signOff.VersionNumber > current.VersionNumber {
+			current.VersionNumber = signOff.VersionNumber
+		}
+		if signOff.SignedAt.After(current.SignedAt) {
+			current.SignedAt = signOff.SignedAt
+		}
+	}
+	for i := range states {
+		if signOff, ok := latest[states[i].RequirementID]; ok {
+			states[i].SignedVersion = &signOff.VersionNumber
+			states[i].LastSignedAt = &signOff.SignedAt
+		}
+	}
+	return nil
+}
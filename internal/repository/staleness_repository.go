@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// staleEntityTables maps the entity types staleness policies apply to onto their tables
+var staleEntityTables = map[models.EntityType]string{
+	models.EntityTypeEpic:        "epics",
+	models.EntityTypeUserStory:   "user_stories",
+	models.EntityTypeRequirement: "requirements",
+}
+
+// stalenessPolicyRepository implements StalenessPolicyRepository interface
+type stalenessPolicyRepository struct {
+	*BaseRepository[models.StalenessPolicy]
+}
+
+// NewStalenessPolicyRepository creates a new staleness policy repository instance
+func NewStalenessPolicyRepository(db *gorm.DB) StalenessPolicyRepository {
+	return &stalenessPolicyRepository{
+		BaseRepository: NewBaseRepository[models.StalenessPolicy](db),
+	}
+}
+
+// ListAll retrieves every policy ordered by name, with escalation users preloaded
+func (r *stalenessPolicyRepository) ListAll(ctx context.Context) ([]models.StalenessPolicy, error) {
+	var policies []models.StalenessPolicy
+	if err := r.GetDB().WithContext(ctx).Preload("EscalateTo").Order("name ASC, created_at ASC").Find(&policies).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return policies, nil
+}
+
+// staleEntityRepository implements StaleEntityRepository interface
+type staleEntityRepository struct {
+	*BaseRepository[models.StaleEntity]
+}
+
+// NewStaleEntityRepository creates a new stale entity repository instance
+func NewStaleEntityRepository(db *gorm.DB) StaleEntityRepository {
+	return &staleEntityRepository{
+		BaseRepository: NewBaseRepository[models.StaleEntity](db),
+	}
+}
+
+// ListByPolicy retrieves the stale marks of a policy
+func (r *staleEntityRepository) ListByPolicy(ctx context.Context, policyID uuid.UUID) ([]models.StaleEntity, error) {
+	var marks []models.StaleEntity
+	if err := r.GetDB().WithContext(ctx).Where("policy_id = ?", policyID).Find(&marks).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return marks, nil
+}
+
+// DeleteByPolicy removes every stale mark of a policy
+func (r *staleEntityRepository) DeleteByPolicy(ctx context.Context, policyID uuid.UUID) error {
+	if err := r.GetDB().WithContext(ctx).Where("policy_id = ?", policyID).Delete(&models.StaleEntity{}).Error; err != nil {
+		return r.handleDBError(err)
+	}
+	return nil
+}
+
+// ListCandidates retrieves the entities of a type last updated before the cutoff, optionally in one status
+func (r *staleEntityRepository) ListCandidates(ctx context.Context, entityType models.EntityType, status *string, updatedBefore time.Time) ([]StaleCandidate, error) {
+	table, ok := staleEntityTables[entityType]
+	if !ok {
+		return nil, fmt.Errorf("entity type %s cannot become stale", entityType)
+	}
+
+	query := r.GetDB().WithContext(ctx).Table(table).
+		Select("id, reference_id, title, status, assignee_id, updated_at").
+		Where("updated_at < ?", updatedBefore)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var candidates []StaleCandidate
+	if err := query.Order("reference_id ASC").Scan(&candidates).Error; err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return candidates, nil
+}
+
+// ListReport retrieves the stale entities with their assignees and policies, oldest update first.
+// Marks of entities deleted since the last staleness run are left out.
+func (r *staleEntityRepository) ListReport(ctx context.Context, filter StaleReportFilter) ([]StaleReportItem, error) {
+	var items []StaleReportItem
+	for _, entityType := range []models.EntityType{models.EntityTypeEpic, models.EntityTypeUserStory, models.EntityTypeRequirement} {
+		if filter.EntityType != nil && *filter.EntityType != entityType {
+			continue
+		}
+		table := staleEntityTables[entityType]
+
+		query := r.GetDB().WithContext(ctx).Table("stale_entities").
+			Select("stale_entities.entity_type, stale_entities.entity_id, "+
+				table+".reference_id, "+table+".title, "+table+".status, "+table+".assignee_id, "+table+".updated_at AS last_updated_at, "+
+				"users.username AS assignee_username, stale_entities.policy_id, staleness_policies.name AS policy_name, "+
+				"stale_entities.detected_at, stale_entities.notified_at, stale_entities.escalated_at").
+			Joins("JOIN "+table+" ON "+table+".id = stale_entities.entity_id").
+			Joins("JOIN staleness_policies ON staleness_policies.id = stale_entities.policy_id").
+			Joins("LEFT JOIN users ON users.id = "+table+".assignee_id").
+			Where("stale_entities.entity_type = ?", entityType)
+		if filter.PolicyID != nil {
+			query = query.Where("stale_entities.policy_id = ?", *filter.PolicyID)
+		}
+		if filter.AssigneeID != nil {
+			query = query.Where(table+".assignee_id = ?", *filter.AssigneeID)
+		}
+
+		var typeItems []StaleReportItem
+		if err := query.Order(table + ".updated_at ASC, " + table + ".reference_id ASC").Scan(&typeItems).Error; err != nil {
+			return nil, r.handleDBError(err)
+		}
+		items = append(items, typeItems...)
+	}
+	return items, nil
+}
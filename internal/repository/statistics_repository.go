@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// statisticsRepository implements StatisticsRepository interface
+type statisticsRepository struct {
+	db *gorm.DB
+}
+
+// NewStatisticsRepository creates a new statistics repository instance
+func NewStatisticsRepository(db *gorm.DB) StatisticsRepository {
+	return &statisticsRepository{db: db}
+}
+
+// CountAll counts the rows of a model's table
+func (r *statisticsRepository) CountAll(ctx context.Context, model interface{}) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(model).Count(&count).Error; err != nil {
+		return 0, handleDBError(err)
+	}
+	return count, nil
+}
+
+// CountCreatedInBuckets counts the rows of a model's table created in each interval between consecutive
+// boundaries with a single aggregate query. It returns one count per interval.
+func (r *statisticsRepository) CountCreatedInBuckets(ctx context.Context, model interface{}, boundaries []time.Time) ([]int64, error) {
+	if len(boundaries) < 2 {
+		return []int64{}, nil
+	}
+
+	// Map every row to the index of its interval; boundaries are ascending so the first match wins
+	var bucket strings.Builder
+	args := make([]interface{}, 0, len(boundaries)+1)
+	bucket.WriteString("CASE")
+	for i := 1; i < len(boundaries); i++ {
+		fmt.Fprintf(&bucket, " WHEN created_at < ? THEN %d", i-1)
+		args = append(args, boundaries[i])
+	}
+	bucket.WriteString(" END")
+
+	var rows []struct {
+		Bucket int
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Model(model).
+		Select(bucket.String()+" AS bucket, COUNT(*) AS count", args...).
+		Where("created_at >= ? AND created_at < ?", boundaries[0], boundaries[len(boundaries)-1]).
+		Group("bucket").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+
+	counts := make([]int64, len(boundaries)-1)
+	for _, row := range rows {
+		if row.Bucket >= 0 && row.Bucket < len(counts) {
+			counts[row.Bucket] = row.Count
+		}
+	}
+	return counts, nil
+}
+
+// CountCreatedBefore counts the rows of a model's table created before the given time
+func (r *statisticsRepository) CountCreatedBefore(ctx context.Context, model interface{}, before time.Time) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(model).Where("created_at < ?", before).Count(&count).Error; err != nil {
+		return 0, handleDBError(err)
+	}
+	return count, nil
+}
+
+// TopContributors returns the users with the most audited changes since the given time
+func (r *statisticsRepository) TopContributors(ctx context.Context, since time.Time, limit int) ([]ContributorActivity, error) {
+	var contributors []ContributorActivity
+	err := r.db.WithContext(ctx).Table("audit_events").
+		Select("users.id AS user_id, users.username AS username, COUNT(*) AS changes, "+
+			"SUM(CASE WHEN audit_events.action = ? THEN 1 ELSE 0 END) AS comments", models.AuditActionCommented).
+		Joins("JOIN users ON users.id = audit_events.actor_id").
+		Where("audit_events.created_at >= ?", since).
+		Group("users.id, users.username").
+		Order("changes DESC, username").
+		Limit(limit).
+		Scan(&contributors).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return contributors, nil
+}
+
+// LargestEpics returns the epics with the most user stories, then the most requirements
+func (r *statisticsRepository) LargestEpics(ctx context.Context, limit int) ([]EpicSize, error) {
+	var epics []EpicSize
+	err := r.db.WithContext(ctx).Table("epics").
+		Select("epics.id AS id, epics.reference_id AS reference_id, epics.title AS title, " +
+			"COUNT(DISTINCT user_stories.id) AS user_stories, COUNT(requirements.id) AS requirements").
+		Joins("LEFT JOIN user_stories ON user_stories.epic_id = epics.id").
+		Joins("LEFT JOIN requirements ON requirements.user_story_id = user_stories.id").
+		Group("epics.id, epics.reference_id, epics.title").
+		Order("user_stories DESC, requirements DESC, reference_id").
+		Limit(limit).
+		Scan(&epics).Error
+	if err != nil {
+		return nil, handleDBError(err)
+	}
+	return epics, nil
+}
+
+// GetDB returns the underlying database connection
+func (r *statisticsRepository) GetDB() *gorm.DB {
+	return r.db
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"product-requirements-management/internal/models"
 
 	"github.com/google/uuid"
@@ -18,14 +19,14 @@ func NewStatusModelRepository(db *gorm.DB) StatusModelRepository {
 }
 
 // Create creates a new status model
-func (r *statusModelRepository) Create(statusModel *models.StatusModel) error {
-	return r.db.Create(statusModel).Error
+func (r *statusModelRepository) Create(ctx context.Context, statusModel *models.StatusModel) error {
+	return r.db.WithContext(ctx).Create(statusModel).Error
 }
 
 // GetByID retrieves a status model by ID with its statuses and transitions
-func (r *statusModelRepository) GetByID(id uuid.UUID) (*models.StatusModel, error) {
+func (r *statusModelRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.StatusModel, error) {
 	var statusModel models.StatusModel
-	err := r.db.Preload("Statuses").Preload("Transitions").Where("id = ?", id).First(&statusModel).Error
+	err := r.db.WithContext(ctx).Preload("Statuses").Preload("Transitions").Where("id = ?", id).First(&statusModel).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, ErrNotFound
@@ -36,9 +37,9 @@ func (r *statusModelRepository) GetByID(id uuid.UUID) (*models.StatusModel, erro
 }
 
 // GetByEntityTypeAndName retrieves a status model by entity type and name
-func (r *statusModelRepository) GetByEntityTypeAndName(entityType models.EntityType, name string) (*models.StatusModel, error) {
+func (r *statusModelRepository) GetByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (*models.StatusModel, error) {
 	var statusModel models.StatusModel
-	err := r.db.Preload("Statuses").Preload("Transitions").
+	err := r.db.WithContext(ctx).Preload("Statuses").Preload("Transitions").
 		Where("entity_type = ? AND name = ?", entityType, name).First(&statusModel).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -50,9 +51,9 @@ func (r *statusModelRepository) GetByEntityTypeAndName(entityType models.EntityT
 }
 
 // GetDefaultByEntityType retrieves the default status model for an entity type
-func (r *statusModelRepository) GetDefaultByEntityType(entityType models.EntityType) (*models.StatusModel, error) {
+func (r *statusModelRepository) GetDefaultByEntityType(ctx context.Context, entityType models.EntityType) (*models.StatusModel, error) {
 	var statusModel models.StatusModel
-	err := r.db.Preload("Statuses").Preload("Transitions").
+	err := r.db.WithContext(ctx).Preload("Statuses").Preload("Transitions").
 		Where("entity_type = ? AND is_default = ?", entityType, true).First(&statusModel).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -64,20 +65,20 @@ func (r *statusModelRepository) GetDefaultByEntityType(entityType models.EntityT
 }
 
 // Update updates an existing status model
-func (r *statusModelRepository) Update(statusModel *models.StatusModel) error {
-	return r.db.Save(statusModel).Error
+func (r *statusModelRepository) Update(ctx context.Context, statusModel *models.StatusModel) error {
+	return r.db.WithContext(ctx).Save(statusModel).Error
 }
 
 // Delete deletes a status model by ID
-func (r *statusModelRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&models.StatusModel{}, id).Error
+func (r *statusModelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.StatusModel{}, id).Error
 }
 
 // List retrieves status models with filtering, ordering, and pagination
-func (r *statusModelRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusModel, error) {
+func (r *statusModelRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusModel, error) {
 	var statusModels []models.StatusModel
 
-	query := r.db.Preload("Statuses").Preload("Transitions")
+	query := r.db.WithContext(ctx).Preload("Statuses").Preload("Transitions")
 
 	// Apply filters
 	for key, value := range filters {
@@ -102,24 +103,24 @@ func (r *statusModelRepository) List(filters map[string]interface{}, orderBy str
 }
 
 // ListByEntityType retrieves all status models for a specific entity type
-func (r *statusModelRepository) ListByEntityType(entityType models.EntityType) ([]models.StatusModel, error) {
+func (r *statusModelRepository) ListByEntityType(ctx context.Context, entityType models.EntityType) ([]models.StatusModel, error) {
 	var statusModels []models.StatusModel
-	err := r.db.Preload("Statuses").Preload("Transitions").
+	err := r.db.WithContext(ctx).Preload("Statuses").Preload("Transitions").
 		Where("entity_type = ?", entityType).Find(&statusModels).Error
 	return statusModels, err
 }
 
 // Exists checks if a status model exists by ID
-func (r *statusModelRepository) Exists(id uuid.UUID) (bool, error) {
+func (r *statusModelRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.StatusModel{}).Where("id = ?", id).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.StatusModel{}).Where("id = ?", id).Count(&count).Error
 	return count > 0, err
 }
 
 // Count returns the total number of status models matching the given filters
-func (r *statusModelRepository) Count(filters map[string]interface{}) (int64, error) {
+func (r *statusModelRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	var count int64
-	query := r.db.Model(&models.StatusModel{})
+	query := r.db.WithContext(ctx).Model(&models.StatusModel{})
 
 	// Apply filters
 	for key, value := range filters {
@@ -131,8 +132,8 @@ func (r *statusModelRepository) Count(filters map[string]interface{}) (int64, er
 }
 
 // ExistsByEntityTypeAndName checks if a status model exists by entity type and name
-func (r *statusModelRepository) ExistsByEntityTypeAndName(entityType models.EntityType, name string) (bool, error) {
+func (r *statusModelRepository) ExistsByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.StatusModel{}).Where("entity_type = ? AND name = ?", entityType, name).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.StatusModel{}).Where("entity_type = ? AND name = ?", entityType, name).Count(&count).Error
 	return count > 0, err
 }
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+// incidentRepository implements IncidentRepository interface
+type incidentRepository struct {
+	*BaseRepository[models.Incident]
+}
+
+// NewIncidentRepository creates a new incident repository instance
+func NewIncidentRepository(db *gorm.DB) IncidentRepository {
+	return &incidentRepository{
+		BaseRepository: NewBaseRepository[models.Incident](db),
+	}
+}
+
+// GetWithUpdates retrieves an incident with its timeline, oldest update first
+func (r *incidentRepository) GetWithUpdates(ctx context.Context, id uuid.UUID) (*models.Incident, error) {
+	var incident models.Incident
+	err := r.GetDB().WithContext(ctx).Preload("Updates", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC, id ASC")
+	}).Where("id = ?", id).First(&incident).Error
+	if err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return &incident, nil
+}
+
+// ListWithFilters retrieves incidents, optionally only open or resolved ones, most recently started first
+func (r *incidentRepository) ListWithFilters(ctx context.Context, resolved *bool, limit, offset int) ([]models.Incident, int64, error) {
+	query := r.GetDB().WithContext(ctx).Model(&models.Incident{})
+	if resolved != nil {
+		if *resolved {
+			query = query.Where("status = ?", models.IncidentResolved)
+		} else {
+			query = query.Where("status <> ?", models.IncidentResolved)
+		}
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+	var incidents []models.Incident
+	if err := query.Order("started_at DESC, id ASC").Limit(limit).Offset(offset).Find(&incidents).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+	return incidents, total, nil
+}
+
+// ListForStatusPage retrieves the open incidents and those resolved since a time, most recently started first,
+// with their timelines
+func (r *incidentRepository) ListForStatusPage(ctx context.Context, resolvedSince time.Time, limit int) ([]models.Incident, error) {
+	var incidents []models.Incident
+	err := r.GetDB().WithContext(ctx).Preload("Updates", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC, id ASC")
+	}).
+		Where("status <> ? OR resolved_at >= ?", models.IncidentResolved, resolvedSince).
+		Order("started_at DESC, id ASC").
+		Limit(limit).
+		Find(&incidents).Error
+	if err != nil {
+		return nil, r.handleDBError(err)
+	}
+	return incidents, nil
+}
+
+// CreateUpdate posts an update to the timeline of an incident
+func (r *incidentRepository) CreateUpdate(ctx context.Context, update *models.IncidentUpdate) error {
+	return r.handleDBError(r.GetDB().WithContext(ctx).Create(update).Error)
+}
+
+// maintenanceWindowRepository implements MaintenanceWindowRepository interface
+type maintenanceWindowRepository struct {
+	*BaseRepository[models.MaintenanceWindow]
+}
+
+// NewMaintenanceWindowRepository creates a new maintenance window repository instance
+func NewMaintenanceWindowRepository(db *gorm.DB) MaintenanceWindowRepository {
+	return &maintenanceWindowRepository{
+		BaseRepository: NewBaseRepository[models.MaintenanceWindow](db),
+	}
+}
+
+// ListWithFilters retrieves maintenance windows, optionally only those not over by a time, earliest first
+func (r *maintenanceWindowRepository) ListWithFilters(ctx context.Context, endingAfter *time.Time, limit, offset int) ([]models.MaintenanceWindow, int64, error) {
+	query := r.GetDB().WithContext(ctx).Model(&models.MaintenanceWindow{})
+	if endingAfter != nil {
+		query = query.Where("ends_at > ?", *endingAfter)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+	var windows []models.MaintenanceWindow
+	if err := query.Order("starts_at ASC, id ASC").Limit(limit).Offset(offset).Find(&windows).Error; err != nil {
+		return nil, 0, r.handleDBError(err)
+	}
+	return windows, total, nil
+}
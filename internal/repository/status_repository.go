@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"product-requirements-management/internal/models"
 
 	"github.com/google/uuid"
@@ -18,14 +19,14 @@ func NewStatusRepository(db *gorm.DB) StatusRepository {
 }
 
 // Create creates a new status
-func (r *statusRepository) Create(status *models.Status) error {
-	return r.db.Create(status).Error
+func (r *statusRepository) Create(ctx context.Context, status *models.Status) error {
+	return r.db.WithContext(ctx).Create(status).Error
 }
 
 // GetByID retrieves a status by ID
-func (r *statusRepository) GetByID(id uuid.UUID) (*models.Status, error) {
+func (r *statusRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Status, error) {
 	var status models.Status
-	err := r.db.Preload("StatusModel").Where("id = ?", id).First(&status).Error
+	err := r.db.WithContext(ctx).Preload("StatusModel").Where("id = ?", id).First(&status).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, ErrNotFound
@@ -36,16 +37,16 @@ func (r *statusRepository) GetByID(id uuid.UUID) (*models.Status, error) {
 }
 
 // GetByStatusModelID retrieves all statuses for a status model
-func (r *statusRepository) GetByStatusModelID(statusModelID uuid.UUID) ([]models.Status, error) {
+func (r *statusRepository) GetByStatusModelID(ctx context.Context, statusModelID uuid.UUID) ([]models.Status, error) {
 	var statuses []models.Status
-	err := r.db.Where("status_model_id = ?", statusModelID).Order("\"order\" ASC").Find(&statuses).Error
+	err := r.db.WithContext(ctx).Where("status_model_id = ?", statusModelID).Order("\"order\" ASC").Find(&statuses).Error
 	return statuses, err
 }
 
 // GetByName retrieves a status by name within a status model
-func (r *statusRepository) GetByName(statusModelID uuid.UUID, name string) (*models.Status, error) {
+func (r *statusRepository) GetByName(ctx context.Context, statusModelID uuid.UUID, name string) (*models.Status, error) {
 	var status models.Status
-	err := r.db.Where("status_model_id = ? AND name = ?", statusModelID, name).First(&status).Error
+	err := r.db.WithContext(ctx).Where("status_model_id = ? AND name = ?", statusModelID, name).First(&status).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, ErrNotFound
@@ -56,20 +57,20 @@ func (r *statusRepository) GetByName(statusModelID uuid.UUID, name string) (*mod
 }
 
 // Update updates an existing status
-func (r *statusRepository) Update(status *models.Status) error {
-	return r.db.Save(status).Error
+func (r *statusRepository) Update(ctx context.Context, status *models.Status) error {
+	return r.db.WithContext(ctx).Save(status).Error
 }
 
 // Delete deletes a status by ID
-func (r *statusRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&models.Status{}, id).Error
+func (r *statusRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.Status{}, id).Error
 }
 
 // List retrieves statuses with filtering, ordering, and pagination
-func (r *statusRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Status, error) {
+func (r *statusRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Status, error) {
 	var statuses []models.Status
 
-	query := r.db.Preload("StatusModel")
+	query := r.db.WithContext(ctx).Preload("StatusModel")
 
 	// Apply filters
 	for key, value := range filters {
@@ -96,22 +97,37 @@ func (r *statusRepository) List(filters map[string]interface{}, orderBy string,
 }
 
 // Exists checks if a status exists by ID
-func (r *statusRepository) Exists(id uuid.UUID) (bool, error) {
+func (r *statusRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.Status{}).Where("id = ?", id).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.Status{}).Where("id = ?", id).Count(&count).Error
 	return count > 0, err
 }
 
 // CountByStatusModelID counts statuses for a specific status model
-func (r *statusRepository) CountByStatusModelID(statusModelID uuid.UUID) (int64, error) {
+func (r *statusRepository) CountByStatusModelID(ctx context.Context, statusModelID uuid.UUID) (int64, error) {
 	var count int64
-	err := r.db.Model(&models.Status{}).Where("status_model_id = ?", statusModelID).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.Status{}).Where("status_model_id = ?", statusModelID).Count(&count).Error
 	return count, err
 }
 
 // ExistsByName checks if a status exists by name within a status model
-func (r *statusRepository) ExistsByName(statusModelID uuid.UUID, name string) (bool, error) {
+func (r *statusRepository) ExistsByName(ctx context.Context, statusModelID uuid.UUID, name string) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.Status{}).Where("status_model_id = ? AND name = ?", statusModelID, name).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.Status{}).Where("status_model_id = ? AND name = ?", statusModelID, name).Count(&count).Error
 	return count > 0, err
 }
+
+// UpdateOrder sets the order of the statuses of a status model to their position in statusIDs in one transaction
+func (r *statusRepository) UpdateOrder(ctx context.Context, statusModelID uuid.UUID, statusIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range statusIDs {
+			err := tx.Model(&models.Status{}).
+				Where("id = ? AND status_model_id = ?", id, statusModelID).
+				Update("order", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
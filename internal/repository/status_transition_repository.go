@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"product-requirements-management/internal/models"
 
 	"github.com/google/uuid"
@@ -18,14 +19,14 @@ func NewStatusTransitionRepository(db *gorm.DB) StatusTransitionRepository {
 }
 
 // Create creates a new status transition
-func (r *statusTransitionRepository) Create(transition *models.StatusTransition) error {
-	return r.db.Create(transition).Error
+func (r *statusTransitionRepository) Create(ctx context.Context, transition *models.StatusTransition) error {
+	return r.db.WithContext(ctx).Create(transition).Error
 }
 
 // GetByID retrieves a status transition by ID
-func (r *statusTransitionRepository) GetByID(id uuid.UUID) (*models.StatusTransition, error) {
+func (r *statusTransitionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.StatusTransition, error) {
 	var transition models.StatusTransition
-	err := r.db.Preload("StatusModel").Preload("FromStatus").Preload("ToStatus").
+	err := r.db.WithContext(ctx).Preload("StatusModel").Preload("FromStatus").Preload("ToStatus").
 		Where("id = ?", id).First(&transition).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -37,35 +38,35 @@ func (r *statusTransitionRepository) GetByID(id uuid.UUID) (*models.StatusTransi
 }
 
 // GetByStatusModelID retrieves all transitions for a status model
-func (r *statusTransitionRepository) GetByStatusModelID(statusModelID uuid.UUID) ([]models.StatusTransition, error) {
+func (r *statusTransitionRepository) GetByStatusModelID(ctx context.Context, statusModelID uuid.UUID) ([]models.StatusTransition, error) {
 	var transitions []models.StatusTransition
-	err := r.db.Preload("FromStatus").Preload("ToStatus").
+	err := r.db.WithContext(ctx).Preload("FromStatus").Preload("ToStatus").
 		Where("status_model_id = ?", statusModelID).Find(&transitions).Error
 	return transitions, err
 }
 
 // GetByFromStatus retrieves all transitions from a specific status
-func (r *statusTransitionRepository) GetByFromStatus(fromStatusID uuid.UUID) ([]models.StatusTransition, error) {
+func (r *statusTransitionRepository) GetByFromStatus(ctx context.Context, fromStatusID uuid.UUID) ([]models.StatusTransition, error) {
 	var transitions []models.StatusTransition
-	err := r.db.Preload("ToStatus").Where("from_status_id = ?", fromStatusID).Find(&transitions).Error
+	err := r.db.WithContext(ctx).Preload("ToStatus").Where("from_status_id = ?", fromStatusID).Find(&transitions).Error
 	return transitions, err
 }
 
 // Update updates an existing status transition
-func (r *statusTransitionRepository) Update(transition *models.StatusTransition) error {
-	return r.db.Save(transition).Error
+func (r *statusTransitionRepository) Update(ctx context.Context, transition *models.StatusTransition) error {
+	return r.db.WithContext(ctx).Save(transition).Error
 }
 
 // Delete deletes a status transition by ID
-func (r *statusTransitionRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&models.StatusTransition{}, id).Error
+func (r *statusTransitionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.StatusTransition{}, id).Error
 }
 
 // List retrieves status transitions with filtering, ordering, and pagination
-func (r *statusTransitionRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusTransition, error) {
+func (r *statusTransitionRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusTransition, error) {
 	var transitions []models.StatusTransition
 
-	query := r.db.Preload("StatusModel").Preload("FromStatus").Preload("ToStatus")
+	query := r.db.WithContext(ctx).Preload("StatusModel").Preload("FromStatus").Preload("ToStatus")
 
 	// Apply filters
 	for key, value := range filters {
@@ -90,23 +91,23 @@ func (r *statusTransitionRepository) List(filters map[string]interface{}, orderB
 }
 
 // Exists checks if a status transition exists by ID
-func (r *statusTransitionRepository) Exists(id uuid.UUID) (bool, error) {
+func (r *statusTransitionRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.StatusTransition{}).Where("id = ?", id).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.StatusTransition{}).Where("id = ?", id).Count(&count).Error
 	return count > 0, err
 }
 
 // CountByStatusModelID counts status transitions for a specific status model
-func (r *statusTransitionRepository) CountByStatusModelID(statusModelID uuid.UUID) (int64, error) {
+func (r *statusTransitionRepository) CountByStatusModelID(ctx context.Context, statusModelID uuid.UUID) (int64, error) {
 	var count int64
-	err := r.db.Model(&models.StatusTransition{}).Where("status_model_id = ?", statusModelID).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.StatusTransition{}).Where("status_model_id = ?", statusModelID).Count(&count).Error
 	return count, err
 }
 
 // ExistsByTransition checks if a specific transition exists
-func (r *statusTransitionRepository) ExistsByTransition(statusModelID, fromStatusID, toStatusID uuid.UUID) (bool, error) {
+func (r *statusTransitionRepository) ExistsByTransition(ctx context.Context, statusModelID, fromStatusID, toStatusID uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.StatusTransition{}).
+	err := r.db.WithContext(ctx).Model(&models.StatusTransition{}).
 		Where("status_model_id = ? AND from_status_id = ? AND to_status_id = ?", statusModelID, fromStatusID, toStatusID).
 		Count(&count).Error
 	return count > 0, err
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"product-requirements-management/internal/models"
@@ -21,17 +22,17 @@ func NewSteeringDocumentRepository(db *gorm.DB) SteeringDocumentRepository {
 }
 
 // Create creates a new steering document
-func (r *steeringDocumentRepository) Create(doc *models.SteeringDocument) error {
-	if err := r.db.Create(doc).Error; err != nil {
+func (r *steeringDocumentRepository) Create(ctx context.Context, doc *models.SteeringDocument) error {
+	if err := r.db.WithContext(ctx).Create(doc).Error; err != nil {
 		return fmt.Errorf("failed to create steering document: %w", err)
 	}
 	return nil
 }
 
 // GetByID retrieves a steering document by ID
-func (r *steeringDocumentRepository) GetByID(id uuid.UUID) (*models.SteeringDocument, error) {
+func (r *steeringDocumentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SteeringDocument, error) {
 	var doc models.SteeringDocument
-	err := r.db.Preload("Creator").First(&doc, "id = ?", id).Error
+	err := r.db.WithContext(ctx).Preload("Creator").First(&doc, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
@@ -42,9 +43,9 @@ func (r *steeringDocumentRepository) GetByID(id uuid.UUID) (*models.SteeringDocu
 }
 
 // GetByReferenceID retrieves a steering document by reference ID
-func (r *steeringDocumentRepository) GetByReferenceID(referenceID string) (*models.SteeringDocument, error) {
+func (r *steeringDocumentRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.SteeringDocument, error) {
 	var doc models.SteeringDocument
-	err := r.db.Preload("Creator").First(&doc, "reference_id = ?", referenceID).Error
+	err := r.db.WithContext(ctx).Preload("Creator").First(&doc, "reference_id = ?", referenceID).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
@@ -55,14 +56,14 @@ func (r *steeringDocumentRepository) GetByReferenceID(referenceID string) (*mode
 }
 
 // GetByReferenceIDCaseInsensitive retrieves a steering document by reference ID (case-insensitive)
-func (r *steeringDocumentRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.SteeringDocument, error) {
+func (r *steeringDocumentRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.SteeringDocument, error) {
 	var doc models.SteeringDocument
 
-	query := r.db.Preload("Creator")
+	query := r.db.WithContext(ctx).Preload("Creator")
 	var err error
 
 	// Use ILIKE for PostgreSQL, LOWER() LIKE for SQLite compatibility
-	if r.db.Dialector.Name() == "postgres" {
+	if r.db.WithContext(ctx).Dialector.Name() == "postgres" {
 		err = query.Where("reference_id ILIKE ?", referenceID).First(&doc).Error
 	} else {
 		// SQLite and other databases - use LOWER() LIKE for case-insensitive matching
@@ -79,16 +80,16 @@ func (r *steeringDocumentRepository) GetByReferenceIDCaseInsensitive(referenceID
 }
 
 // Update updates a steering document
-func (r *steeringDocumentRepository) Update(doc *models.SteeringDocument) error {
-	if err := r.db.Save(doc).Error; err != nil {
+func (r *steeringDocumentRepository) Update(ctx context.Context, doc *models.SteeringDocument) error {
+	if err := r.db.WithContext(ctx).Save(doc).Error; err != nil {
 		return fmt.Errorf("failed to update steering document: %w", err)
 	}
 	return nil
 }
 
 // Delete deletes a steering document
-func (r *steeringDocumentRepository) Delete(id uuid.UUID) error {
-	result := r.db.Delete(&models.SteeringDocument{}, "id = ?", id)
+func (r *steeringDocumentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.SteeringDocument{}, "id = ?", id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete steering document: %w", result.Error)
 	}
@@ -99,11 +100,11 @@ func (r *steeringDocumentRepository) Delete(id uuid.UUID) error {
 }
 
 // ListWithFilters retrieves steering documents with optional filtering
-func (r *steeringDocumentRepository) ListWithFilters(filters SteeringDocumentFilters) ([]models.SteeringDocument, int64, error) {
+func (r *steeringDocumentRepository) ListWithFilters(ctx context.Context, filters SteeringDocumentFilters) ([]models.SteeringDocument, int64, error) {
 	var docs []models.SteeringDocument
 	var totalCount int64
 
-	query := r.db.Model(&models.SteeringDocument{}).Preload("Creator")
+	query := r.db.WithContext(ctx).Model(&models.SteeringDocument{}).Preload("Creator")
 
 	// Apply filters
 	if filters.CreatorID != nil {
@@ -163,10 +164,10 @@ func (r *steeringDocumentRepository) ListWithFilters(filters SteeringDocumentFil
 }
 
 // Search performs full-text search on steering documents
-func (r *steeringDocumentRepository) Search(query string) ([]models.SteeringDocument, error) {
+func (r *steeringDocumentRepository) Search(ctx context.Context, query string) ([]models.SteeringDocument, error) {
 	var docs []models.SteeringDocument
 
-	searchQuery := r.db.Model(&models.SteeringDocument{}).
+	searchQuery := r.db.WithContext(ctx).Model(&models.SteeringDocument{}).
 		Preload("Creator").
 		Where("to_tsvector('english', title || ' ' || COALESCE(description, '')) @@ plainto_tsquery('english', ?)", query).
 		Order("ts_rank(to_tsvector('english', title || ' ' || COALESCE(description, '')), plainto_tsquery('english', ?)) DESC")
@@ -179,10 +180,10 @@ func (r *steeringDocumentRepository) Search(query string) ([]models.SteeringDocu
 }
 
 // GetByEpicID retrieves steering documents linked to an epic
-func (r *steeringDocumentRepository) GetByEpicID(epicID uuid.UUID) ([]models.SteeringDocument, error) {
+func (r *steeringDocumentRepository) GetByEpicID(ctx context.Context, epicID uuid.UUID) ([]models.SteeringDocument, error) {
 	var docs []models.SteeringDocument
 
-	err := r.db.
+	err := r.db.WithContext(ctx).
 		Preload("Creator").
 		Joins("JOIN epic_steering_documents esd ON steering_documents.id = esd.steering_document_id").
 		Where("esd.epic_id = ?", epicID).
@@ -196,12 +197,12 @@ func (r *steeringDocumentRepository) GetByEpicID(epicID uuid.UUID) ([]models.Ste
 }
 
 // GetByEpicIDWithPagination retrieves steering documents linked to an epic with pagination
-func (r *steeringDocumentRepository) GetByEpicIDWithPagination(epicID uuid.UUID, limit, offset int) ([]models.SteeringDocument, int64, error) {
+func (r *steeringDocumentRepository) GetByEpicIDWithPagination(ctx context.Context, epicID uuid.UUID, limit, offset int) ([]models.SteeringDocument, int64, error) {
 	var docs []models.SteeringDocument
 	var totalCount int64
 
 	// Count total records
-	countQuery := r.db.Model(&models.SteeringDocument{}).
+	countQuery := r.db.WithContext(ctx).Model(&models.SteeringDocument{}).
 		Joins("JOIN epic_steering_documents esd ON steering_documents.id = esd.steering_document_id").
 		Where("esd.epic_id = ?", epicID)
 
@@ -210,7 +211,7 @@ func (r *steeringDocumentRepository) GetByEpicIDWithPagination(epicID uuid.UUID,
 	}
 
 	// Get paginated results
-	query := r.db.
+	query := r.db.WithContext(ctx).
 		Preload("Creator").
 		Joins("JOIN epic_steering_documents esd ON steering_documents.id = esd.steering_document_id").
 		Where("esd.epic_id = ?", epicID).
@@ -231,13 +232,13 @@ func (r *steeringDocumentRepository) GetByEpicIDWithPagination(epicID uuid.UUID,
 }
 
 // LinkToEpic creates a link between a steering document and an epic
-func (r *steeringDocumentRepository) LinkToEpic(steeringDocumentID, epicID uuid.UUID) error {
+func (r *steeringDocumentRepository) LinkToEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID) error {
 	link := models.EpicSteeringDocument{
 		EpicID:             epicID,
 		SteeringDocumentID: steeringDocumentID,
 	}
 
-	if err := r.db.Create(&link).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(&link).Error; err != nil {
 		// Check if it's a duplicate key error
 		if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "UNIQUE constraint") {
 			return fmt.Errorf("steering document is already linked to this epic")
@@ -249,8 +250,8 @@ func (r *steeringDocumentRepository) LinkToEpic(steeringDocumentID, epicID uuid.
 }
 
 // UnlinkFromEpic removes the link between a steering document and an epic
-func (r *steeringDocumentRepository) UnlinkFromEpic(steeringDocumentID, epicID uuid.UUID) error {
-	result := r.db.Where("steering_document_id = ? AND epic_id = ?", steeringDocumentID, epicID).
+func (r *steeringDocumentRepository) UnlinkFromEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("steering_document_id = ? AND epic_id = ?", steeringDocumentID, epicID).
 		Delete(&models.EpicSteeringDocument{})
 
 	if result.Error != nil {
@@ -265,14 +266,14 @@ func (r *steeringDocumentRepository) UnlinkFromEpic(steeringDocumentID, epicID u
 }
 
 // WithTransaction executes a function within a database transaction
-func (r *steeringDocumentRepository) WithTransaction(fn func(*gorm.DB) error) error {
-	return r.db.Transaction(fn)
+func (r *steeringDocumentRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
+	return r.db.WithContext(ctx).Transaction(fn)
 }
 
 // List retrieves steering documents with basic filtering
-func (r *steeringDocumentRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.SteeringDocument, error) {
+func (r *steeringDocumentRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.SteeringDocument, error) {
 	var docs []models.SteeringDocument
-	query := r.db.Model(&models.SteeringDocument{}).Preload("Creator")
+	query := r.db.WithContext(ctx).Model(&models.SteeringDocument{}).Preload("Creator")
 
 	// Apply filters
 	for key, value := range filters {
@@ -302,9 +303,9 @@ func (r *steeringDocumentRepository) List(filters map[string]interface{}, orderB
 }
 
 // Count returns the total number of steering documents matching the filters
-func (r *steeringDocumentRepository) Count(filters map[string]interface{}) (int64, error) {
+func (r *steeringDocumentRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	var count int64
-	query := r.db.Model(&models.SteeringDocument{})
+	query := r.db.WithContext(ctx).Model(&models.SteeringDocument{})
 
 	// Apply filters
 	for key, value := range filters {
@@ -319,9 +320,9 @@ func (r *steeringDocumentRepository) Count(filters map[string]interface{}) (int6
 }
 
 // Exists checks if a steering document exists by ID
-func (r *steeringDocumentRepository) Exists(id uuid.UUID) (bool, error) {
+func (r *steeringDocumentRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.SteeringDocument{}).Where("id = ?", id).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.SteeringDocument{}).Where("id = ?", id).Count(&count).Error
 	if err != nil {
 		return false, fmt.Errorf("failed to check steering document existence: %w", err)
 	}
@@ -329,9 +330,9 @@ func (r *steeringDocumentRepository) Exists(id uuid.UUID) (bool, error) {
 }
 
 // ExistsByReferenceID checks if a steering document exists by reference ID
-func (r *steeringDocumentRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (r *steeringDocumentRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	var count int64
-	err := r.db.Model(&models.SteeringDocument{}).Where("reference_id = ?", referenceID).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&models.SteeringDocument{}).Where("reference_id = ?", referenceID).Count(&count).Error
 	if err != nil {
 		return false, fmt.Errorf("failed to check steering document existence by reference ID: %w", err)
 	}
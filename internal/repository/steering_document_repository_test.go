@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -28,6 +29,7 @@ func setupSteeringDocumentTestDB(t *testing.T) *gorm.DB {
 }
 
 func TestSteeringDocumentRepository_GetByReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupSteeringDocumentTestDB(t)
 	repo := NewSteeringDocumentRepository(db)
 
@@ -51,11 +53,11 @@ func TestSteeringDocumentRepository_GetByReferenceID(t *testing.T) {
 		ReferenceID: "STD-001", // Set manually to avoid generator call
 	}
 
-	err = repo.Create(doc)
+	err = repo.Create(ctx, doc)
 	require.NoError(t, err)
 
 	// Retrieve the document by reference ID
-	retrieved, err := repo.GetByReferenceID(doc.ReferenceID)
+	retrieved, err := repo.GetByReferenceID(ctx, doc.ReferenceID)
 	assert.NoError(t, err)
 	assert.Equal(t, doc.ID, retrieved.ID)
 	assert.Equal(t, doc.ReferenceID, retrieved.ReferenceID)
@@ -63,6 +65,7 @@ func TestSteeringDocumentRepository_GetByReferenceID(t *testing.T) {
 }
 
 func TestSteeringDocumentRepository_ListWithFilters(t *testing.T) {
+	ctx := context.Background()
 	db := setupSteeringDocumentTestDB(t)
 	repo := NewSteeringDocumentRepository(db)
 
@@ -102,7 +105,7 @@ func TestSteeringDocumentRepository_ListWithFilters(t *testing.T) {
 	}
 
 	for _, doc := range docs {
-		err = repo.Create(doc)
+		err = repo.Create(ctx, doc)
 		require.NoError(t, err)
 	}
 
@@ -111,14 +114,14 @@ func TestSteeringDocumentRepository_ListWithFilters(t *testing.T) {
 		Limit:  10,
 		Offset: 0,
 	}
-	result, total, err := repo.ListWithFilters(filters)
+	result, total, err := repo.ListWithFilters(ctx, filters)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(3), total)
 	assert.Len(t, result, 3)
 
 	// Test filtering by creator
 	filters.CreatorID = &user1.ID
-	result, total, err = repo.ListWithFilters(filters)
+	result, total, err = repo.ListWithFilters(ctx, filters)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(2), total)
 	assert.Len(t, result, 2)
@@ -127,13 +130,14 @@ func TestSteeringDocumentRepository_ListWithFilters(t *testing.T) {
 	filters.CreatorID = nil
 	filters.Limit = 2
 	filters.Offset = 1
-	result, total, err = repo.ListWithFilters(filters)
+	result, total, err = repo.ListWithFilters(ctx, filters)
 	assert.NoError(t, err)
 	assert.Equal(t, int64(3), total)
 	assert.Len(t, result, 2)
 }
 
 func TestSteeringDocumentRepository_LinkToEpic(t *testing.T) {
+	ctx := context.Background()
 	db := setupSteeringDocumentTestDB(t)
 	repo := NewSteeringDocumentRepository(db)
 
@@ -165,26 +169,27 @@ func TestSteeringDocumentRepository_LinkToEpic(t *testing.T) {
 		CreatorID:   user.ID,
 		ReferenceID: "STD-001", // Set manually to avoid generator call
 	}
-	err = repo.Create(doc)
+	err = repo.Create(ctx, doc)
 	require.NoError(t, err)
 
 	// Link document to epic
-	err = repo.LinkToEpic(doc.ID, epic.ID)
+	err = repo.LinkToEpic(ctx, doc.ID, epic.ID)
 	assert.NoError(t, err)
 
 	// Verify the link exists
-	docs, err := repo.GetByEpicID(epic.ID)
+	docs, err := repo.GetByEpicID(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.Len(t, docs, 1)
 	assert.Equal(t, doc.ID, docs[0].ID)
 
 	// Test duplicate link (should fail)
-	err = repo.LinkToEpic(doc.ID, epic.ID)
+	err = repo.LinkToEpic(ctx, doc.ID, epic.ID)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "already linked")
 }
 
 func TestSteeringDocumentRepository_UnlinkFromEpic(t *testing.T) {
+	ctx := context.Background()
 	db := setupSteeringDocumentTestDB(t)
 	repo := NewSteeringDocumentRepository(db)
 
@@ -216,44 +221,46 @@ func TestSteeringDocumentRepository_UnlinkFromEpic(t *testing.T) {
 		CreatorID:   user.ID,
 		ReferenceID: "STD-002", // Set manually to avoid generator call
 	}
-	err = repo.Create(doc)
+	err = repo.Create(ctx, doc)
 	require.NoError(t, err)
 
 	// Link document to epic
-	err = repo.LinkToEpic(doc.ID, epic.ID)
+	err = repo.LinkToEpic(ctx, doc.ID, epic.ID)
 	require.NoError(t, err)
 
 	// Verify the link exists
-	docs, err := repo.GetByEpicID(epic.ID)
+	docs, err := repo.GetByEpicID(ctx, epic.ID)
 	require.NoError(t, err)
 	assert.Len(t, docs, 1)
 
 	// Unlink document from epic
-	err = repo.UnlinkFromEpic(doc.ID, epic.ID)
+	err = repo.UnlinkFromEpic(ctx, doc.ID, epic.ID)
 	assert.NoError(t, err)
 
 	// Verify the link is removed
-	docs, err = repo.GetByEpicID(epic.ID)
+	docs, err = repo.GetByEpicID(ctx, epic.ID)
 	assert.NoError(t, err)
 	assert.Len(t, docs, 0)
 }
 
 func TestSteeringDocumentRepository_GetByID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupSteeringDocumentTestDB(t)
 	repo := NewSteeringDocumentRepository(db)
 
 	nonExistentID := uuid.New()
-	doc, err := repo.GetByID(nonExistentID)
+	doc, err := repo.GetByID(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, doc)
 }
 
 func TestSteeringDocumentRepository_GetByReferenceID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupSteeringDocumentTestDB(t)
 	repo := NewSteeringDocumentRepository(db)
 
-	doc, err := repo.GetByReferenceID("STD-999")
+	doc, err := repo.GetByReferenceID(ctx, "STD-999")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, doc)
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// testRunRepository implements TestRunRepository interface
+type testRunRepository struct {
+	db *gorm.DB
+}
+
+// NewTestRunRepository creates a new test run repository instance
+func NewTestRunRepository(db *gorm.DB) TestRunRepository {
+	return &testRunRepository{db: db}
+}
+
+// Create records a test run together with its results
+func (r *testRunRepository) Create(ctx context.Context, run *models.TestRun) error {
+	if err := r.db.WithContext(ctx).Omit("Importer").Create(run).Error; err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetByID retrieves a test run with its results and importer
+func (r *testRunRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.TestRun, error) {
+	var run models.TestRun
+	err := r.db.WithContext(ctx).Preload("Results", func(db *gorm.DB) *gorm.DB {
+		return db.Order("reference_id, test_name")
+	}).Preload("Importer").First(&run, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &run, nil
+}
+
+// List retrieves test runs without their results, newest first
+func (r *testRunRepository) List(ctx context.Context, limit, offset int) ([]models.TestRun, int64, error) {
+	query := r.db.WithContext(ctx).Model(&models.TestRun{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	var runs []models.TestRun
+	if err := query.Preload("Importer").Order("imported_at DESC, id ASC").
+		Limit(limit).Offset(offset).Find(&runs).Error; err != nil {
+		return nil, 0, handleDBError(err)
+	}
+	return runs, total, nil
+}
+
+// UpsertVerification sets the verification state of an entity, replacing the state of an earlier run
+func (r *testRunRepository) UpsertVerification(ctx context.Context, verification *models.EntityVerification) error {
+	err := r.db.WithContext(ctx).Omit("TestRun").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "test_run_id", "verified_at"}),
+	}).Create(verification).Error
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
+
+// GetVerification retrieves the verification state of an entity with the run that set it
+func (r *testRunRepository) GetVerification(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.EntityVerification, error) {
+	var verification models.EntityVerification
+	err := r.db.WithContext(ctx).Preload("TestRun").
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		First(&verification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, handleDBError(err)
+	}
+	return &verification, nil
+}
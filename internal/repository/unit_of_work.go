@@ -0,0 +1,52 @@
+package repository
+
+import "context"
+
+// transactionContextKey is the context key of the repositories of a running unit of work
+type transactionContextKey struct{}
+
+// UnitOfWork runs operations that span several repositories and services atomically.
+// The repositories bound to the running transaction travel in the context passed to fn,
+// so units of work started with that context join the outer transaction instead of
+// committing on their own: everything commits when the outermost function returns nil
+// and rolls back when any of them returns an error.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context, tx *Repositories) error) error
+}
+
+// unitOfWork implements UnitOfWork interface
+type unitOfWork struct {
+	repos *Repositories
+}
+
+// NewUnitOfWork creates a unit of work that starts transactions on the given repositories
+func NewUnitOfWork(repos *Repositories) UnitOfWork {
+	return &unitOfWork{repos: repos}
+}
+
+// Do runs fn in the transaction carried by ctx, or in a new transaction when ctx carries none
+func (u *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context, tx *Repositories) error) error {
+	if tx, ok := TransactionFromContext(ctx); ok {
+		return fn(ctx, tx)
+	}
+	return u.repos.WithTransaction(ctx, func(tx *Repositories) error {
+		return fn(context.WithValue(ctx, transactionContextKey{}, tx), tx)
+	})
+}
+
+// TransactionFromContext returns the repositories of the unit of work running in ctx
+func TransactionFromContext(ctx context.Context) (*Repositories, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	tx, ok := ctx.Value(transactionContextKey{}).(*Repositories)
+	return tx, ok
+}
+
+// WithContext returns the repositories of the unit of work running in ctx, or r outside of one
+func (r *Repositories) WithContext(ctx context.Context) *Repositories {
+	if tx, ok := TransactionFromContext(ctx); ok {
+		return tx
+	}
+	return r
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func setupUnitOfWorkTestDB(t *testing.T) *Repositories {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	require.NoError(t, db.AutoMigrate(&models.User{}))
+	return NewRepositories(db, nil)
+}
+
+func newUnitOfWorkTestUser(username string) *models.User {
+	return &models.User{Username: username, Email: username + "@example.com", PasswordHash: "hash", Role: models.RoleUser}
+}
+
+func TestUnitOfWork_CommitsOnSuccess(t *testing.T) {
+	ctx := context.Background()
+	repos := setupUnitOfWorkTestDB(t)
+	uow := NewUnitOfWork(repos)
+
+	err := uow.Do(context.Background(), func(ctx context.Context, tx *Repositories) error {
+		assert.Same(t, tx, repos.WithContext(ctx))
+		return tx.User.Create(ctx, newUnitOfWorkTestUser("alice"))
+	})
+	require.NoError(t, err)
+
+	_, err = repos.User.GetByUsername(ctx, "alice")
+	assert.NoError(t, err)
+}
+
+func TestUnitOfWork_RollsBackOnError(t *testing.T) {
+	ctx := context.Background()
+	repos := setupUnitOfWorkTestDB(t)
+	uow := NewUnitOfWork(repos)
+	errFailed := errors.New("failed")
+
+	err := uow.Do(context.Background(), func(ctx context.Context, tx *Repositories) error {
+		require.NoError(t, tx.User.Create(ctx, newUnitOfWorkTestUser("alice")))
+		return errFailed
+	})
+	assert.ErrorIs(t, err, errFailed)
+
+	_, err = repos.User.GetByUsername(ctx, "alice")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestUnitOfWork_NestedJoinsOuterTransaction(t *testing.T) {
+	ctx := context.Background()
+	repos := setupUnitOfWorkTestDB(t)
+	uow := NewUnitOfWork(repos)
+	errFailed := errors.New("failed")
+
+	err := uow.Do(context.Background(), func(ctx context.Context, outer *Repositories) error {
+		require.NoError(t, outer.User.Create(ctx, newUnitOfWorkTestUser("alice")))
+
+		err := uow.Do(ctx, func(ctx context.Context, inner *Repositories) error {
+			assert.Same(t, outer, inner)
+			return inner.User.Create(ctx, newUnitOfWorkTestUser("bob"))
+		})
+		require.NoError(t, err)
+		return errFailed
+	})
+	assert.ErrorIs(t, err, errFailed)
+
+	// The nested unit of work did not commit on its own
+	for _, username := range []string{"alice", "bob"} {
+		_, err = repos.User.GetByUsername(ctx, username)
+		assert.ErrorIs(t, err, ErrNotFound, username)
+	}
+}
+
+func TestRepositories_WithContextOutsideUnitOfWork(t *testing.T) {
+	repos := setupUnitOfWorkTestDB(t)
+
+	assert.Same(t, repos, repos.WithContext(context.Background()))
+	_, ok := TransactionFromContext(context.Background())
+	assert.False(t, ok)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"product-requirements-management/internal/models"
+)
+
+// userPreferenceRepository implements UserPreferenceRepository interface
+type userPreferenceRepository struct {
+	db *gorm.DB
+}
+
+// NewUserPreferenceRepository creates a new user preference repository instance
+func NewUserPreferenceRepository(db *gorm.DB) UserPreferenceRepository {
+	return &userPreferenceRepository{db: db}
+}
+
+// ListByUser retrieves all preference namespaces of a user ordered by namespace
+func (r *userPreferenceRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.UserPreference, error) {
+	var preferences []models.UserPreference
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("namespace").Find(&preferences).Error; err != nil {
+		return nil, handleDBError(err)
+	}
+	return preferences, nil
+}
+
+// Apply saves and removes preference namespaces of a user in one transaction
+func (r *userPreferenceRepository) Apply(ctx context.Context, userID uuid.UUID, save []models.UserPreference, remove []string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(remove) > 0 {
+			if err := tx.Where("user_id = ? AND namespace IN ?", userID, remove).Delete(&models.UserPreference{}).Error; err != nil {
+				return err
+			}
+		}
+		for i := range save {
+			save[i].UserID = userID
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "namespace"}},
+				DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+			}).Create(&save[i]).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return handleDBError(err)
+	}
+	return nil
+}
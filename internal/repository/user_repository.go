@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"gorm.io/gorm"
@@ -21,9 +22,9 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 }
 
 // GetByUsername retrieves a user by username
-func (r *userRepository) GetByUsername(username string) (*models.User, error) {
+func (r *userRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
-	if err := r.GetDB().Where("username = ?", username).First(&user).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("username = ?", username).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -33,9 +34,9 @@ func (r *userRepository) GetByUsername(username string) (*models.User, error) {
 }
 
 // GetByEmail retrieves a user by email
-func (r *userRepository) GetByEmail(email string) (*models.User, error) {
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
-	if err := r.GetDB().Where("email = ?", email).First(&user).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -45,18 +46,18 @@ func (r *userRepository) GetByEmail(email string) (*models.User, error) {
 }
 
 // ExistsByUsername checks if a user exists by username
-func (r *userRepository) ExistsByUsername(username string) (bool, error) {
+func (r *userRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.User{}).Where("username = ?", username).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.User{}).Where("username = ?", username).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
 
 // ExistsByEmail checks if a user exists by email
-func (r *userRepository) ExistsByEmail(email string) (bool, error) {
+func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
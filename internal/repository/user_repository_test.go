@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,7 @@ func setupUserTestDB(t *testing.T) *gorm.DB {
 }
 
 func createTestUser(t *testing.T, repo UserRepository, username, email string, role models.UserRole) *models.User {
+	ctx := context.Background()
 	user := &models.User{
 		Username:     username,
 		Email:        email,
@@ -30,13 +32,14 @@ func createTestUser(t *testing.T, repo UserRepository, username, email string, r
 		Role:         role,
 	}
 
-	err := repo.Create(user)
+	err := repo.Create(ctx, user)
 	require.NoError(t, err)
 
 	return user
 }
 
 func TestUserRepository_Create(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -47,12 +50,13 @@ func TestUserRepository_Create(t *testing.T) {
 		Role:         models.RoleUser,
 	}
 
-	err := repo.Create(user)
+	err := repo.Create(ctx, user)
 	assert.NoError(t, err)
 	assert.NotNil(t, user.ID)
 }
 
 func TestUserRepository_GetByUsername(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -60,7 +64,7 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 	user := createTestUser(t, repo, "testuser", "test@example.com", models.RoleUser)
 
 	// Get by username
-	retrieved, err := repo.GetByUsername("testuser")
+	retrieved, err := repo.GetByUsername(ctx, "testuser")
 	assert.NoError(t, err)
 	assert.Equal(t, user.ID, retrieved.ID)
 	assert.Equal(t, user.Username, retrieved.Username)
@@ -68,16 +72,37 @@ func TestUserRepository_GetByUsername(t *testing.T) {
 }
 
 func TestUserRepository_GetByUsername_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
-	retrieved, err := repo.GetByUsername("nonexistent")
+	retrieved, err := repo.GetByUsername(ctx, "nonexistent")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
+func TestUserRepository_CancelledContext(t *testing.T) {
+	db := setupUserTestDB(t)
+	repo := NewUserRepository(db)
+	createTestUser(t, repo, "testuser", "test@example.com", models.RoleUser)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	retrieved, err := repo.GetByUsername(ctx, "testuser")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, retrieved)
+
+	repos := NewRepositories(db, nil)
+	err = repos.WithTransaction(ctx, func(tx *Repositories) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestUserRepository_GetByEmail(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -85,7 +110,7 @@ func TestUserRepository_GetByEmail(t *testing.T) {
 	user := createTestUser(t, repo, "testuser", "test@example.com", models.RoleUser)
 
 	// Get by email
-	retrieved, err := repo.GetByEmail("test@example.com")
+	retrieved, err := repo.GetByEmail(ctx, "test@example.com")
 	assert.NoError(t, err)
 	assert.Equal(t, user.ID, retrieved.ID)
 	assert.Equal(t, user.Username, retrieved.Username)
@@ -93,16 +118,18 @@ func TestUserRepository_GetByEmail(t *testing.T) {
 }
 
 func TestUserRepository_GetByEmail_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
-	retrieved, err := repo.GetByEmail("nonexistent@example.com")
+	retrieved, err := repo.GetByEmail(ctx, "nonexistent@example.com")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestUserRepository_ExistsByUsername(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -110,17 +137,18 @@ func TestUserRepository_ExistsByUsername(t *testing.T) {
 	createTestUser(t, repo, "testuser", "test@example.com", models.RoleUser)
 
 	// Check existence
-	exists, err := repo.ExistsByUsername("testuser")
+	exists, err := repo.ExistsByUsername(ctx, "testuser")
 	assert.NoError(t, err)
 	assert.True(t, exists)
 
 	// Check non-existence
-	exists, err = repo.ExistsByUsername("nonexistent")
+	exists, err = repo.ExistsByUsername(ctx, "nonexistent")
 	assert.NoError(t, err)
 	assert.False(t, exists)
 }
 
 func TestUserRepository_ExistsByEmail(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -128,17 +156,18 @@ func TestUserRepository_ExistsByEmail(t *testing.T) {
 	createTestUser(t, repo, "testuser", "test@example.com", models.RoleUser)
 
 	// Check existence
-	exists, err := repo.ExistsByEmail("test@example.com")
+	exists, err := repo.ExistsByEmail(ctx, "test@example.com")
 	assert.NoError(t, err)
 	assert.True(t, exists)
 
 	// Check non-existence
-	exists, err = repo.ExistsByEmail("nonexistent@example.com")
+	exists, err = repo.ExistsByEmail(ctx, "nonexistent@example.com")
 	assert.NoError(t, err)
 	assert.False(t, exists)
 }
 
 func TestUserRepository_Update(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -147,16 +176,17 @@ func TestUserRepository_Update(t *testing.T) {
 
 	// Update user
 	user.Role = models.RoleAdministrator
-	err := repo.Update(user)
+	err := repo.Update(ctx, user)
 	assert.NoError(t, err)
 
 	// Verify update
-	retrieved, err := repo.GetByID(user.ID)
+	retrieved, err := repo.GetByID(ctx, user.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, models.RoleAdministrator, retrieved.Role)
 }
 
 func TestUserRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -164,17 +194,18 @@ func TestUserRepository_Delete(t *testing.T) {
 	user := createTestUser(t, repo, "testuser", "test@example.com", models.RoleUser)
 
 	// Delete user
-	err := repo.Delete(user.ID)
+	err := repo.Delete(ctx, user.ID)
 	assert.NoError(t, err)
 
 	// Verify deletion
-	retrieved, err := repo.GetByID(user.ID)
+	retrieved, err := repo.GetByID(ctx, user.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
 }
 
 func TestUserRepository_List(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserTestDB(t)
 	repo := NewUserRepository(db)
 
@@ -184,13 +215,13 @@ func TestUserRepository_List(t *testing.T) {
 	createTestUser(t, repo, "user3", "user3@example.com", models.RoleCommenter)
 
 	// List all users
-	users, err := repo.List(nil, "", 0, 0)
+	users, err := repo.List(ctx, nil, "", 0, 0)
 	assert.NoError(t, err)
 	assert.Len(t, users, 3)
 
 	// List users by role
 	filters := map[string]interface{}{"role": models.RoleUser}
-	users, err = repo.List(filters, "", 0, 0)
+	users, err = repo.List(ctx, filters, "", 0, 0)
 	assert.NoError(t, err)
 	assert.Len(t, users, 1)
 	assert.Equal(t, models.RoleUser, users[0].Role)
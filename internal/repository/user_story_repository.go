@@ -28,9 +28,9 @@ func NewUserStoryRepository(db *gorm.DB, redis *redis.Client) UserStoryRepositor
 }
 
 // GetWithAcceptanceCriteria retrieves a user story with its acceptance criteria
-func (r *userStoryRepository) GetWithAcceptanceCriteria(id uuid.UUID) (*models.UserStory, error) {
+func (r *userStoryRepository) GetWithAcceptanceCriteria(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	var userStory models.UserStory
-	if err := r.GetDB().Preload("AcceptanceCriteria").Where("id = ?", id).First(&userStory).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("AcceptanceCriteria").Where("id = ?", id).First(&userStory).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -40,9 +40,9 @@ func (r *userStoryRepository) GetWithAcceptanceCriteria(id uuid.UUID) (*models.U
 }
 
 // GetWithRequirements retrieves a user story with its requirements
-func (r *userStoryRepository) GetWithRequirements(id uuid.UUID) (*models.UserStory, error) {
+func (r *userStoryRepository) GetWithRequirements(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	var userStory models.UserStory
-	if err := r.GetDB().Preload("Requirements").Where("id = ?", id).First(&userStory).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("Requirements").Where("id = ?", id).First(&userStory).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -52,72 +52,72 @@ func (r *userStoryRepository) GetWithRequirements(id uuid.UUID) (*models.UserSto
 }
 
 // GetByEpic retrieves user stories by epic ID
-func (r *userStoryRepository) GetByEpic(epicID uuid.UUID) ([]models.UserStory, error) {
+func (r *userStoryRepository) GetByEpic(ctx context.Context, epicID uuid.UUID) ([]models.UserStory, error) {
 	var userStories []models.UserStory
-	if err := r.GetDB().Where("epic_id = ?", epicID).Find(&userStories).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("epic_id = ?", epicID).Find(&userStories).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return userStories, nil
 }
 
 // GetByCreator retrieves user stories by creator ID
-func (r *userStoryRepository) GetByCreator(creatorID uuid.UUID) ([]models.UserStory, error) {
+func (r *userStoryRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.UserStory, error) {
 	var userStories []models.UserStory
-	if err := r.GetDB().Where("creator_id = ?", creatorID).Find(&userStories).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("creator_id = ?", creatorID).Find(&userStories).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return userStories, nil
 }
 
 // GetByAssignee retrieves user stories by assignee ID
-func (r *userStoryRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.UserStory, error) {
+func (r *userStoryRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.UserStory, error) {
 	var userStories []models.UserStory
-	if err := r.GetDB().Where("assignee_id = ?", assigneeID).Find(&userStories).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("assignee_id = ?", assigneeID).Find(&userStories).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return userStories, nil
 }
 
 // GetByStatus retrieves user stories by status
-func (r *userStoryRepository) GetByStatus(status models.UserStoryStatus) ([]models.UserStory, error) {
+func (r *userStoryRepository) GetByStatus(ctx context.Context, status models.UserStoryStatus) ([]models.UserStory, error) {
 	var userStories []models.UserStory
-	if err := r.GetDB().Where("status = ?", status).Find(&userStories).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("status = ?", status).Find(&userStories).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return userStories, nil
 }
 
 // GetByPriority retrieves user stories by priority
-func (r *userStoryRepository) GetByPriority(priority models.Priority) ([]models.UserStory, error) {
+func (r *userStoryRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.UserStory, error) {
 	var userStories []models.UserStory
-	if err := r.GetDB().Where("priority = ?", priority).Find(&userStories).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Where("priority = ?", priority).Find(&userStories).Error; err != nil {
 		return nil, r.handleDBError(err)
 	}
 	return userStories, nil
 }
 
 // HasAcceptanceCriteria checks if a user story has any acceptance criteria
-func (r *userStoryRepository) HasAcceptanceCriteria(id uuid.UUID) (bool, error) {
+func (r *userStoryRepository) HasAcceptanceCriteria(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.AcceptanceCriteria{}).Where("user_story_id = ?", id).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.AcceptanceCriteria{}).Where("user_story_id = ?", id).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
 
 // HasRequirements checks if a user story has any requirements
-func (r *userStoryRepository) HasRequirements(id uuid.UUID) (bool, error) {
+func (r *userStoryRepository) HasRequirements(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	if err := r.GetDB().Model(&models.Requirement{}).Where("user_story_id = ?", id).Count(&count).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&models.Requirement{}).Where("user_story_id = ?", id).Count(&count).Error; err != nil {
 		return false, r.handleDBError(err)
 	}
 	return count > 0, nil
 }
 
 // GetByIDWithUsers retrieves a user story by its ID with creator, assignee, and epic preloaded
-func (r *userStoryRepository) GetByIDWithUsers(id uuid.UUID) (*models.UserStory, error) {
+func (r *userStoryRepository) GetByIDWithUsers(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	var userStory models.UserStory
-	if err := r.GetDB().Preload("Creator").Preload("Assignee").Preload("Epic").Where("id = ?", id).First(&userStory).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("Creator").Preload("Assignee").Preload("Epic").Where("id = ?", id).First(&userStory).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -127,9 +127,9 @@ func (r *userStoryRepository) GetByIDWithUsers(id uuid.UUID) (*models.UserStory,
 }
 
 // GetByReferenceIDWithUsers retrieves a user story by its reference ID with creator, assignee, and epic preloaded
-func (r *userStoryRepository) GetByReferenceIDWithUsers(referenceID string) (*models.UserStory, error) {
+func (r *userStoryRepository) GetByReferenceIDWithUsers(ctx context.Context, referenceID string) (*models.UserStory, error) {
 	var userStory models.UserStory
-	if err := r.GetDB().Preload("Creator").Preload("Assignee").Preload("Epic").Where("reference_id = ?", referenceID).First(&userStory).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Preload("Creator").Preload("Assignee").Preload("Epic").Where("reference_id = ?", referenceID).First(&userStory).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrNotFound
 		}
@@ -139,14 +139,14 @@ func (r *userStoryRepository) GetByReferenceIDWithUsers(referenceID string) (*mo
 }
 
 // GetByReferenceIDWithUsersCaseInsensitive retrieves a user story by its reference ID (case-insensitive) with creator and assignee preloaded
-func (r *userStoryRepository) GetByReferenceIDWithUsersCaseInsensitive(referenceID string) (*models.UserStory, error) {
+func (r *userStoryRepository) GetByReferenceIDWithUsersCaseInsensitive(ctx context.Context, referenceID string) (*models.UserStory, error) {
 	var userStory models.UserStory
 
-	query := r.GetDB().Preload("Creator").Preload("Assignee")
+	query := r.GetDB().WithContext(ctx).Preload("Creator").Preload("Assignee")
 	var err error
 
 	// Use ILIKE for PostgreSQL, LOWER() LIKE for SQLite compatibility
-	if r.GetDB().Dialector.Name() == "postgres" {
+	if r.GetDB().WithContext(ctx).Dialector.Name() == "postgres" {
 		err = query.Where("reference_id ILIKE ?", referenceID).First(&userStory).Error
 	} else {
 		// SQLite and other databases - use LOWER() LIKE for case-insensitive matching
@@ -163,10 +163,10 @@ func (r *userStoryRepository) GetByReferenceIDWithUsersCaseInsensitive(reference
 }
 
 // ListWithIncludes retrieves user stories with optional related entity preloading
-func (r *userStoryRepository) ListWithIncludes(filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.UserStory, error) {
+func (r *userStoryRepository) ListWithIncludes(ctx context.Context, filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.UserStory, error) {
 	var userStories []models.UserStory
 
-	query := r.GetDB().Model(&models.UserStory{})
+	query := r.GetDB().WithContext(ctx).Model(&models.UserStory{})
 
 	// Apply includes (preloads)
 	for _, include := range includes {
@@ -212,9 +212,8 @@ func (r *userStoryRepository) ListWithIncludes(filters map[string]interface{}, i
 }
 
 // GetUUIDByReferenceID retrieves only the UUID of a user story by its reference ID for efficient UUID-only queries
-func (r *userStoryRepository) GetUUIDByReferenceID(referenceID string) (uuid.UUID, error) {
+func (r *userStoryRepository) GetUUIDByReferenceID(ctx context.Context, referenceID string) (uuid.UUID, error) {
 	cacheKey := fmt.Sprintf("user_story_ref:%s", referenceID)
-	ctx := context.Background()
 	// Try Redis cache first if available
 	if r.Redis != nil {
 		if cachedUUID, err := r.Redis.Get(ctx, cacheKey).Result(); err == nil {
@@ -226,7 +225,7 @@ func (r *userStoryRepository) GetUUIDByReferenceID(referenceID string) (uuid.UUI
 
 	// Fallback to repository/database
 	var userStoryID uuid.UUID
-	if err := r.GetDB().Model(&models.UserStory{}).
+	if err := r.GetDB().WithContext(ctx).Model(&models.UserStory{}).
 		Select("id").
 		Where("reference_id = ?", referenceID).
 		First(&userStoryID).Error; err != nil {
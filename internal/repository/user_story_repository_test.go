@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -83,6 +84,7 @@ func createUserStoryTestUserStory(t *testing.T, db *gorm.DB, epic *models.Epic,
 // TestUserStoryRepository_Create tests the Create method
 // References: AC-759 - Create user story returns ID and commits; insertion error surfaces without committing
 func TestUserStoryRepository_Create(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -98,7 +100,7 @@ func TestUserStoryRepository_Create(t *testing.T) {
 		ReferenceID: "US-001", // Set manually to avoid PostgreSQL function call
 	}
 
-	err := repo.Create(userStory)
+	err := repo.Create(ctx, userStory)
 	require.NoError(t, err)
 	assert.NotEmpty(t, userStory.ID)
 	assert.Equal(t, "US-001", userStory.ReferenceID)
@@ -108,6 +110,7 @@ func TestUserStoryRepository_Create(t *testing.T) {
 // TestUserStoryRepository_GetByID tests the GetByID method
 // References: AC-760 - Get by ID returns full user story; missing record yields not-found error
 func TestUserStoryRepository_GetByID(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -116,7 +119,7 @@ func TestUserStoryRepository_GetByID(t *testing.T) {
 	userStory := createUserStoryTestUserStory(t, db, epic, user, user, "US-001")
 
 	// Test successful retrieval
-	retrieved, err := repo.GetByID(userStory.ID)
+	retrieved, err := repo.GetByID(ctx, userStory.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, userStory.ID, retrieved.ID)
 	assert.Equal(t, userStory.ReferenceID, retrieved.ReferenceID)
@@ -126,11 +129,12 @@ func TestUserStoryRepository_GetByID(t *testing.T) {
 // TestUserStoryRepository_GetByID_NotFound tests GetByID with non-existent ID
 // References: AC-760 - Missing record yields not-found error
 func TestUserStoryRepository_GetByID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
 	nonExistentID := uuid.New()
-	us, err := repo.GetByID(nonExistentID)
+	us, err := repo.GetByID(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, us)
@@ -139,6 +143,7 @@ func TestUserStoryRepository_GetByID_NotFound(t *testing.T) {
 // TestUserStoryRepository_GetByReferenceID tests the GetByReferenceID method
 // References: AC-760 - Get by ReferenceID returns full user story; missing record yields not-found error
 func TestUserStoryRepository_GetByReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -147,7 +152,7 @@ func TestUserStoryRepository_GetByReferenceID(t *testing.T) {
 	userStory := createUserStoryTestUserStory(t, db, epic, user, user, "US-001")
 
 	// Test successful retrieval
-	retrieved, err := repo.GetByReferenceID(userStory.ReferenceID)
+	retrieved, err := repo.GetByReferenceID(ctx, userStory.ReferenceID)
 	assert.NoError(t, err)
 	assert.Equal(t, userStory.ID, retrieved.ID)
 	assert.Equal(t, userStory.ReferenceID, retrieved.ReferenceID)
@@ -157,10 +162,11 @@ func TestUserStoryRepository_GetByReferenceID(t *testing.T) {
 // TestUserStoryRepository_GetByReferenceID_NotFound tests GetByReferenceID with non-existent reference ID
 // References: AC-760 - Missing record yields not-found error
 func TestUserStoryRepository_GetByReferenceID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
-	us, err := repo.GetByReferenceID("US-999")
+	us, err := repo.GetByReferenceID(ctx, "US-999")
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, us)
@@ -169,6 +175,7 @@ func TestUserStoryRepository_GetByReferenceID_NotFound(t *testing.T) {
 // TestUserStoryRepository_Update tests the Update method
 // References: AC-761 - Update performs a single UPDATE; conflicts/errors propagate without committing
 func TestUserStoryRepository_Update(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -181,11 +188,11 @@ func TestUserStoryRepository_Update(t *testing.T) {
 	userStory.Title = newTitle
 	userStory.Status = models.UserStoryStatusInProgress
 
-	err := repo.Update(userStory)
+	err := repo.Update(ctx, userStory)
 	require.NoError(t, err)
 
 	// Verify the update
-	retrieved, err := repo.GetByID(userStory.ID)
+	retrieved, err := repo.GetByID(ctx, userStory.ID)
 	require.NoError(t, err)
 	assert.Equal(t, newTitle, retrieved.Title)
 	assert.Equal(t, models.UserStoryStatusInProgress, retrieved.Status)
@@ -194,6 +201,7 @@ func TestUserStoryRepository_Update(t *testing.T) {
 // TestUserStoryRepository_Delete tests the Delete method
 // References: AC-762 - Delete removes user story; FK/relationship conflicts surface and record remains
 func TestUserStoryRepository_Delete(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -202,11 +210,11 @@ func TestUserStoryRepository_Delete(t *testing.T) {
 	userStory := createUserStoryTestUserStory(t, db, epic, user, user, "US-001")
 
 	// Delete the user story
-	err := repo.Delete(userStory.ID)
+	err := repo.Delete(ctx, userStory.ID)
 	require.NoError(t, err)
 
 	// Verify deletion
-	retrieved, err := repo.GetByID(userStory.ID)
+	retrieved, err := repo.GetByID(ctx, userStory.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
@@ -215,6 +223,7 @@ func TestUserStoryRepository_Delete(t *testing.T) {
 // TestUserStoryRepository_Create_DuplicateReferenceID tests insertion error with duplicate reference ID
 // References: AC-759 - insertion error surfaces without committing
 func TestUserStoryRepository_Create_DuplicateReferenceID(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -234,12 +243,12 @@ func TestUserStoryRepository_Create_DuplicateReferenceID(t *testing.T) {
 		ReferenceID: "US-001", // Duplicate!
 	}
 
-	err := repo.Create(us2)
+	err := repo.Create(ctx, us2)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "UNIQUE constraint")
 
 	// Verify original record still exists and no side effects
-	retrieved, err := repo.GetByReferenceID("US-001")
+	retrieved, err := repo.GetByReferenceID(ctx, "US-001")
 	assert.NoError(t, err)
 	assert.Equal(t, us1.ID, retrieved.ID)
 	assert.Equal(t, us1.Title, retrieved.Title)
@@ -252,6 +261,7 @@ func TestUserStoryRepository_Create_DuplicateReferenceID(t *testing.T) {
 // TestUserStoryRepository_Delete_WithAcceptanceCriteria tests FK constraint on delete
 // References: AC-762 - FK/relationship conflicts surface and record remains
 func TestUserStoryRepository_Delete_WithAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -270,11 +280,11 @@ func TestUserStoryRepository_Delete_WithAcceptanceCriteria(t *testing.T) {
 	require.NoError(t, err)
 
 	// Delete user story - should succeed with CASCADE
-	err = repo.Delete(userStory.ID)
+	err = repo.Delete(ctx, userStory.ID)
 	assert.NoError(t, err)
 
 	// Verify user story is deleted
-	retrieved, err := repo.GetByID(userStory.ID)
+	retrieved, err := repo.GetByID(ctx, userStory.ID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrNotFound, err)
 	assert.Nil(t, retrieved)
@@ -288,6 +298,7 @@ func TestUserStoryRepository_Delete_WithAcceptanceCriteria(t *testing.T) {
 // TestUserStoryRepository_GetWithAcceptanceCriteria tests GetWithAcceptanceCriteria method
 // References: AC-763 - CRUD tests are isolated on mocked or in-memory DB, idempotent and independent
 func TestUserStoryRepository_GetWithAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -306,7 +317,7 @@ func TestUserStoryRepository_GetWithAcceptanceCriteria(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get user story with acceptance criteria
-	retrieved, err := repo.GetWithAcceptanceCriteria(userStory.ID)
+	retrieved, err := repo.GetWithAcceptanceCriteria(ctx, userStory.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, userStory.ID, retrieved.ID)
 	assert.Len(t, retrieved.AcceptanceCriteria, 1)
@@ -316,6 +327,7 @@ func TestUserStoryRepository_GetWithAcceptanceCriteria(t *testing.T) {
 // TestUserStoryRepository_GetWithRequirements tests GetWithRequirements method
 // References: AC-763 - CRUD tests are isolated on mocked or in-memory DB, idempotent and independent
 func TestUserStoryRepository_GetWithRequirements(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -347,7 +359,7 @@ func TestUserStoryRepository_GetWithRequirements(t *testing.T) {
 	require.NoError(t, err)
 
 	// Get user story with requirements
-	retrieved, err := repo.GetWithRequirements(userStory.ID)
+	retrieved, err := repo.GetWithRequirements(ctx, userStory.ID)
 	assert.NoError(t, err)
 	assert.Equal(t, userStory.ID, retrieved.ID)
 	assert.Len(t, retrieved.Requirements, 1)
@@ -359,6 +371,7 @@ func TestUserStoryRepository_GetWithRequirements(t *testing.T) {
 // TestUserStoryRepository_ListWithIncludes_Pagination tests pagination with limit and offset
 // References: AC-764 - List with limit/offset returns correct slice and ordering; invalid limit/offset handled gracefully
 func TestUserStoryRepository_ListWithIncludes_Pagination(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -371,17 +384,17 @@ func TestUserStoryRepository_ListWithIncludes_Pagination(t *testing.T) {
 	}
 
 	// Test listing with limit
-	result, err := repo.ListWithIncludes(map[string]interface{}{}, []string{}, "created_at ASC", 3, 0)
+	result, err := repo.ListWithIncludes(ctx, map[string]interface{}{}, []string{}, "created_at ASC", 3, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 3)
 
 	// Test listing with limit and offset
-	result, err = repo.ListWithIncludes(map[string]interface{}{}, []string{}, "created_at ASC", 2, 2)
+	result, err = repo.ListWithIncludes(ctx, map[string]interface{}{}, []string{}, "created_at ASC", 2, 2)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test listing all (limit 0 means no limit)
-	result, err = repo.ListWithIncludes(map[string]interface{}{}, []string{}, "created_at ASC", 0, 0)
+	result, err = repo.ListWithIncludes(ctx, map[string]interface{}{}, []string{}, "created_at ASC", 0, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 5)
 }
@@ -389,6 +402,7 @@ func TestUserStoryRepository_ListWithIncludes_Pagination(t *testing.T) {
 // TestUserStoryRepository_GetByStatus tests filtering by status
 // References: AC-765 - Filter by status returns only matching stories; unknown status yields empty result
 func TestUserStoryRepository_GetByStatus(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -409,17 +423,17 @@ func TestUserStoryRepository_GetByStatus(t *testing.T) {
 	db.Save(us3)
 
 	// Test filter by Backlog status
-	result, err := repo.GetByStatus(models.UserStoryStatusBacklog)
+	result, err := repo.GetByStatus(ctx, models.UserStoryStatusBacklog)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by InProgress status
-	result, err = repo.GetByStatus(models.UserStoryStatusInProgress)
+	result, err = repo.GetByStatus(ctx, models.UserStoryStatusInProgress)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by Done status (should be empty)
-	result, err = repo.GetByStatus(models.UserStoryStatusDone)
+	result, err = repo.GetByStatus(ctx, models.UserStoryStatusDone)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -427,6 +441,7 @@ func TestUserStoryRepository_GetByStatus(t *testing.T) {
 // TestUserStoryRepository_GetByEpic tests filtering by epic ID
 // References: AC-766 - Filter by epic_id returns only stories of the specified epic; missing epic yields empty result
 func TestUserStoryRepository_GetByEpic(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -440,18 +455,18 @@ func TestUserStoryRepository_GetByEpic(t *testing.T) {
 	createUserStoryTestUserStory(t, db, epic2, user, user, "US-003")
 
 	// Test filter by epic1
-	result, err := repo.GetByEpic(epic1.ID)
+	result, err := repo.GetByEpic(ctx, epic1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by epic2
-	result, err = repo.GetByEpic(epic2.ID)
+	result, err = repo.GetByEpic(ctx, epic2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by non-existent epic
 	nonExistentEpicID := uuid.New()
-	result, err = repo.GetByEpic(nonExistentEpicID)
+	result, err = repo.GetByEpic(ctx, nonExistentEpicID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -459,6 +474,7 @@ func TestUserStoryRepository_GetByEpic(t *testing.T) {
 // TestUserStoryRepository_GetByAssignee tests filtering by assignee ID
 // References: AC-767 - Filter by assignee_id returns only stories assigned to that user; missing assignee yields empty result
 func TestUserStoryRepository_GetByAssignee(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -472,18 +488,18 @@ func TestUserStoryRepository_GetByAssignee(t *testing.T) {
 	createUserStoryTestUserStory(t, db, epic, user1, user2, "US-003")
 
 	// Test filter by user1
-	result, err := repo.GetByAssignee(user1.ID)
+	result, err := repo.GetByAssignee(ctx, user1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by user2
-	result, err = repo.GetByAssignee(user2.ID)
+	result, err = repo.GetByAssignee(ctx, user2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by non-existent assignee
 	nonExistentUserID := uuid.New()
-	result, err = repo.GetByAssignee(nonExistentUserID)
+	result, err = repo.GetByAssignee(ctx, nonExistentUserID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -491,6 +507,7 @@ func TestUserStoryRepository_GetByAssignee(t *testing.T) {
 // TestUserStoryRepository_GetByCreator tests filtering by creator ID
 // References: AC-768 - DB interactions in filter/pagination tests are mocked/verified; no external dependencies
 func TestUserStoryRepository_GetByCreator(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -504,18 +521,18 @@ func TestUserStoryRepository_GetByCreator(t *testing.T) {
 	createUserStoryTestUserStory(t, db, epic, user2, user2, "US-003")
 
 	// Test filter by user1
-	result, err := repo.GetByCreator(user1.ID)
+	result, err := repo.GetByCreator(ctx, user1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
 	// Test filter by user2
-	result, err = repo.GetByCreator(user2.ID)
+	result, err = repo.GetByCreator(ctx, user2.ID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 
 	// Test filter by non-existent creator
 	nonExistentUserID := uuid.New()
-	result, err = repo.GetByCreator(nonExistentUserID)
+	result, err = repo.GetByCreator(ctx, nonExistentUserID)
 	assert.NoError(t, err)
 	assert.Len(t, result, 0)
 }
@@ -523,6 +540,7 @@ func TestUserStoryRepository_GetByCreator(t *testing.T) {
 // TestUserStoryRepository_ListWithIncludes_WithFiltersAndPreloads tests complex filtering with includes
 // References: AC-768 - DB interactions in filter/pagination tests are mocked/verified; no external dependencies
 func TestUserStoryRepository_ListWithIncludes_WithFiltersAndPreloads(t *testing.T) {
+	ctx := context.Background()
 	db := setupUserStoryTestDB(t)
 	repo := NewUserStoryRepository(db, nil)
 
@@ -537,7 +555,7 @@ func TestUserStoryRepository_ListWithIncludes_WithFiltersAndPreloads(t *testing.
 	filters := map[string]interface{}{
 		"epic_id": epic.ID,
 	}
-	result, err := repo.ListWithIncludes(filters, []string{"epic", "creator", "assignee"}, "created_at ASC", 10, 0)
+	result, err := repo.ListWithIncludes(ctx, filters, []string{"epic", "creator", "assignee"}, "created_at ASC", 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	// Verify preloads worked (epic should have a title)
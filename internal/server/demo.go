@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/server/demo"
+	"product-requirements-management/internal/server/routes"
+)
+
+// newDemoManager creates the demo session manager. Each session is served by its own sandboxed copy of
+// the application routes on the session's schema: the shared Redis cache, background jobs and event
+// publishing are off so sessions cannot affect each other or the shared data, and emails, webhooks and
+// AI features are disabled so visitors cannot reach other hosts or spend the language model budget.
+// Sessions sign access tokens with keys of their own, which the shared routes do not accept.
+func newDemoManager(cfg *config.Config, db *database.DB) (*demo.Manager, error) {
+	sessionCfg := *cfg
+	sessionCfg.Redis.Host = ""
+	newHandler := func(sessionDB *database.DB, keys *auth.KeySet) http.Handler {
+		router := gin.New()
+		routes.Setup(router, &sessionCfg, sessionDB, routes.Sandboxed(keys))
+		return router
+	}
+
+	provisioner := demo.NewPostgresProvisioner(cfg.Database, db.Postgres, "migrations")
+	manager, err := demo.NewManager(cfg.Demo, provisioner, newHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions live in memory; schemas of sessions from a previous run can no longer be reached
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if dropped, err := manager.DropOrphanedSchemas(ctx); err != nil {
+		logger.Warnf("Failed to drop demo schemas of a previous run: %v", err)
+	} else if dropped > 0 {
+		logger.Infof("Dropped %d demo schemas of a previous run", dropped)
+	}
+	return manager, nil
+}
@@ -0,0 +1,400 @@
+// Package demo implements demo mode: every demo session gets its own seeded copy of the data in a separate
+// PostgreSQL schema, served by its own set of routes and dropped when the session expires, so visitors can
+// try the full API, including destructive operations, without touching shared data.
+package demo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/handlers"
+	initService "product-requirements-management/internal/init"
+	"product-requirements-management/internal/models"
+)
+
+const (
+	// TokenPrefix marks demo session tokens, which are sent as bearer tokens
+	TokenPrefix = "demo_"
+	// SchemaPrefix prefixes the PostgreSQL schemas holding demo sessions
+	SchemaPrefix = "demo_"
+	// SessionsPath is where demo sessions are created and ended
+	SessionsPath = "/demo/sessions"
+)
+
+var (
+	ErrSessionLimit   = apperrors.New(apperrors.KindConflict, "DEMO_SESSION_LIMIT", "all demo sessions are in use, try again later")
+	ErrSessionExpired = apperrors.New(apperrors.KindUnauthenticated, "DEMO_SESSION_EXPIRED", "demo session expired or unknown")
+)
+
+// Provisioner creates and drops the PostgreSQL schemas holding demo sessions
+type Provisioner interface {
+	// Create creates a schema with the full database structure and default data, and connects to it
+	Create(ctx context.Context, schema string) (*database.DB, error)
+	// Drop removes a schema with all its data
+	Drop(ctx context.Context, schema string) error
+	// Schemas lists the existing demo schemas
+	Schemas(ctx context.Context) ([]string, error)
+}
+
+// HandlerFactory builds the routes serving one demo session from its database. The routes must sign and
+// verify access tokens with the session's keys only, so tokens issued in a session are worthless elsewhere.
+type HandlerFactory func(db *database.DB, keys *auth.KeySet) http.Handler
+
+// Session is an isolated demo dataset
+type Session struct {
+	Token     string
+	Schema    string
+	ExpiresAt time.Time
+	User      *models.User
+
+	accessToken string       // JWT of User, valid until the session expires
+	keys        *auth.KeySet // Keys of the session's access tokens, generated for the session alone
+	db          *database.DB
+	handler     http.Handler
+}
+
+// SessionResponse is returned when a demo session is created
+// @Description Demo session credentials; send the token as a bearer token to use the full API on the session's own data
+type SessionResponse struct {
+	Token     string    `json:"token" example:"demo_6Zk3q0yq1mU2..."`
+	ExpiresAt time.Time `json:"expires_at" example:"2024-01-01T13:00:00Z"`
+	Username  string    `json:"username" example:"demo"`
+	Role      string    `json:"role" example:"Administrator"`
+}
+
+// Manager provisions demo sessions, routes their requests and wipes them after their TTL.
+// Sessions are kept in memory, so demo mode requires a single server instance.
+type Manager struct {
+	cfg         config.DemoConfig
+	provisioner Provisioner
+	newHandler  HandlerFactory
+	bundle      *initService.SeedBundle
+	now         func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+	pending  int // Sessions being provisioned; they count toward the limit
+}
+
+// NewManager creates a demo session manager
+func NewManager(cfg config.DemoConfig, provisioner Provisioner, newHandler HandlerFactory) (*Manager, error) {
+	bundle := DefaultSeedBundle()
+	if cfg.SeedFile != "" {
+		var err error
+		if bundle, err = initService.LoadSeedBundle(cfg.SeedFile); err != nil {
+			return nil, fmt.Errorf("failed to load demo seed bundle: %w", err)
+		}
+	}
+
+	return &Manager{
+		cfg:         cfg,
+		provisioner: provisioner,
+		newHandler:  newHandler,
+		bundle:      bundle,
+		now:         time.Now,
+		sessions:    make(map[string]*Session),
+	}, nil
+}
+
+// TTL returns how long a demo session lives
+func (m *Manager) TTL() time.Duration {
+	return time.Duration(m.cfg.SessionTTLMinutes) * time.Minute
+}
+
+// CreateSession provisions a schema, seeds it with the demo data and a demo administrator, and
+// returns the session
+func (m *Manager) CreateSession(ctx context.Context) (*Session, error) {
+	m.mu.Lock()
+	if len(m.sessions)+m.pending >= m.cfg.MaxSessions {
+		m.mu.Unlock()
+		return nil, ErrSessionLimit
+	}
+	m.pending++
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.pending--
+		m.mu.Unlock()
+	}()
+
+	suffix, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+	token, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, err
+	}
+	session := &Session{
+		Token:     TokenPrefix + token,
+		Schema:    SchemaPrefix + suffix,
+		ExpiresAt: m.now().Add(m.TTL()),
+	}
+
+	if err := m.provision(ctx, session); err != nil {
+		if session.db != nil {
+			session.db.Close()
+		}
+		if dropErr := m.provisioner.Drop(context.Background(), session.Schema); dropErr != nil {
+			err = fmt.Errorf("%w (dropping the schema also failed: %v)", err, dropErr)
+		}
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.sessions[session.Token] = session
+	m.mu.Unlock()
+	return session, nil
+}
+
+func (m *Manager) provision(ctx context.Context, session *Session) error {
+	db, err := m.provisioner.Create(ctx, session.Schema)
+	if err != nil {
+		return fmt.Errorf("failed to create demo schema: %w", err)
+	}
+	session.db = db
+
+	// Access tokens of the session are signed with a key of its own: the demo user is an administrator, and
+	// tokens it issues in the session, such as those of users it creates, must not work on the shared routes
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return fmt.Errorf("failed to generate demo signing key: %w", err)
+	}
+	session.keys = auth.NewKeySet(auth.NewHMACKey(secret))
+	tokens := auth.NewServiceWithKeys(session.keys, m.TTL(), nil)
+
+	// The password is never shown; the session token is the only credential of the demo user
+	password, err := randomString(24, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return err
+	}
+	passwordHash, err := tokens.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("failed to hash demo password: %w", err)
+	}
+	session.User = &models.User{
+		Username:     "demo",
+		Email:        "demo@example.com",
+		PasswordHash: passwordHash,
+		Role:         models.RoleAdministrator,
+	}
+	if err := db.Postgres.WithContext(ctx).Create(session.User).Error; err != nil {
+		return fmt.Errorf("failed to create demo user: %w", err)
+	}
+
+	if _, err := initService.NewSeedApplier(db.Postgres).Apply(ctx, m.bundle, session.User); err != nil {
+		return fmt.Errorf("failed to seed demo data: %w", err)
+	}
+
+	if session.accessToken, err = tokens.GenerateToken(session.User); err != nil {
+		return fmt.Errorf("failed to issue demo access token: %w", err)
+	}
+	session.handler = m.newHandler(db, session.keys)
+	return nil
+}
+
+// Lookup returns the live session of a token
+func (m *Manager) Lookup(token string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	session, ok := m.sessions[token]
+	if !ok || !m.now().Before(session.ExpiresAt) {
+		return nil, ErrSessionExpired
+	}
+	return session, nil
+}
+
+// EndSession drops a session and its data right away
+func (m *Manager) EndSession(ctx context.Context, token string) error {
+	m.mu.Lock()
+	session, ok := m.sessions[token]
+	delete(m.sessions, token)
+	m.mu.Unlock()
+
+	if !ok {
+		return ErrSessionExpired
+	}
+	return m.wipe(ctx, session)
+}
+
+// ExpireSessions drops the sessions whose TTL has passed and returns how many were dropped
+func (m *Manager) ExpireSessions(ctx context.Context, now time.Time) (int, error) {
+	m.mu.Lock()
+	var expired []*Session
+	for token, session := range m.sessions {
+		if !now.Before(session.ExpiresAt) {
+			expired = append(expired, session)
+			delete(m.sessions, token)
+		}
+	}
+	m.mu.Unlock()
+
+	var firstErr error
+	for _, session := range expired {
+		if err := m.wipe(ctx, session); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return len(expired), firstErr
+}
+
+// DropOrphanedSchemas drops demo schemas without a live session, such as those left behind by a restart
+func (m *Manager) DropOrphanedSchemas(ctx context.Context) (int, error) {
+	schemas, err := m.provisioner.Schemas(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list demo schemas: %w", err)
+	}
+
+	m.mu.Lock()
+	live := make(map[string]bool, len(m.sessions))
+	for _, session := range m.sessions {
+		live[session.Schema] = true
+	}
+	m.mu.Unlock()
+
+	dropped := 0
+	for _, schema := range schemas {
+		if live[schema] || !strings.HasPrefix(schema, SchemaPrefix) {
+			continue
+		}
+		if err := m.provisioner.Drop(ctx, schema); err != nil {
+			return dropped, fmt.Errorf("failed to drop demo schema %s: %w", schema, err)
+		}
+		dropped++
+	}
+	return dropped, nil
+}
+
+func (m *Manager) wipe(ctx context.Context, session *Session) error {
+	if session.db != nil {
+		session.db.Close()
+	}
+	if err := m.provisioner.Drop(ctx, session.Schema); err != nil {
+		return fmt.Errorf("failed to drop demo schema %s: %w", session.Schema, err)
+	}
+	return nil
+}
+
+// Middleware serves requests bearing a demo session token from the routes of that session, authenticated
+// as its demo administrator. Other requests continue to the shared routes.
+func (m *Manager) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := strings.TrimPrefix(c.GetHeader("Authorization"), auth.BearerPrefix)
+		if !strings.HasPrefix(token, TokenPrefix) || strings.HasPrefix(c.Request.URL.Path, SessionsPath) {
+			c.Next()
+			return
+		}
+
+		session, err := m.Lookup(token)
+		if err != nil {
+			respondWithError(c, err, "Failed to find demo session")
+			c.Abort()
+			return
+		}
+
+		c.Request.Header.Set("Authorization", auth.BearerPrefix+session.accessToken)
+		session.handler.ServeHTTP(c.Writer, c.Request)
+		c.Abort()
+	}
+}
+
+// RegisterRoutes registers the public endpoints that start and end demo sessions
+func (m *Manager) RegisterRoutes(router gin.IRouter) {
+	router.POST(SessionsPath, m.handleCreate)
+	router.DELETE(SessionsPath, m.handleEnd)
+}
+
+// handleCreate handles POST /demo/sessions
+// @Summary Start a demo session
+// @Description Provision an isolated copy of the sample data for trying the API. Send the returned token as a bearer token: every request with it runs as an administrator on the session's own data, which is deleted when the session expires. Only available when demo mode is enabled.
+// @Tags demo
+// @Produce json
+// @Success 201 {object} SessionResponse "Demo session"
+// @Failure 409 {object} handlers.ErrorResponse "All demo sessions are in use"
+// @Failure 500 {object} handlers.ErrorResponse "Internal server error"
+// @Router /demo/sessions [post]
+func (m *Manager) handleCreate(c *gin.Context) {
+	session, err := m.CreateSession(c.Request.Context())
+	if err != nil {
+		respondWithError(c, err, "Failed to create demo session")
+		return
+	}
+
+	c.JSON(http.StatusCreated, SessionResponse{
+		Token:     session.Token,
+		ExpiresAt: session.ExpiresAt,
+		Username:  session.User.Username,
+		Role:      string(session.User.Role),
+	})
+}
+
+// handleEnd handles DELETE /demo/sessions
+// @Summary End a demo session
+// @Description Delete the demo session of the bearer token and its data before it expires
+// @Tags demo
+// @Security BearerAuth
+// @Success 204 "Session ended"
+// @Failure 401 {object} handlers.ErrorResponse "Demo session expired or unknown"
+// @Failure 500 {object} handlers.ErrorResponse "Internal server error"
+// @Router /demo/sessions [delete]
+func (m *Manager) handleEnd(c *gin.Context) {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), auth.BearerPrefix)
+	if err := m.EndSession(c.Request.Context(), token); err != nil {
+		respondWithError(c, err, "Failed to end demo session")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RunSessionCleanup drops expired demo sessions every interval until ctx is cancelled
+func RunSessionCleanup(ctx context.Context, manager *Manager, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			expired, err := manager.ExpireSessions(ctx, now)
+			if err != nil {
+				logger.WithError(err).Error("Demo session cleanup failed")
+			}
+			if expired > 0 {
+				logger.WithField("sessions", expired).Info("Expired demo sessions dropped")
+			}
+		}
+	}
+}
+
+// respondWithError writes err like the API handlers do, hiding the details of internal errors
+func respondWithError(c *gin.Context, err error, fallbackMessage string) {
+	detail := handlers.ErrorDetail{Code: apperrors.CodeOf(err), Message: err.Error()}
+	if apperrors.KindOf(err) == apperrors.KindInternal {
+		detail = handlers.ErrorDetail{Code: apperrors.CodeInternal, Message: fallbackMessage}
+	}
+	c.JSON(apperrors.HTTPStatus(err), handlers.ErrorResponse{Error: detail})
+}
+
+func randomString(size int, encode func([]byte) string) (string, error) {
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		return "", fmt.Errorf("failed to generate random value: %w", err)
+	}
+	return encode(data), nil
+}
@@ -0,0 +1,224 @@
+package demo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/models"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(&config.LogConfig{Level: "error", Format: "text"})
+	os.Exit(m.Run())
+}
+
+// sequentialIDGenerator generates reference IDs without PostgreSQL functions
+type sequentialIDGenerator struct {
+	prefix string
+	next   int
+}
+
+func (g *sequentialIDGenerator) Generate(tx *gorm.DB, model interface{}) (string, error) {
+	g.next++
+	return fmt.Sprintf("%s-%03d", g.prefix, g.next), nil
+}
+
+// memoryProvisioner keeps every demo schema in its own in-memory SQLite database
+type memoryProvisioner struct {
+	schemas map[string]*gorm.DB
+}
+
+func (p *memoryProvisioner) Create(ctx context.Context, schema string) (*database.DB, error) {
+	db, err := gorm.Open(sqlite.Open("file:"+schema+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&models.User{}, &models.RequirementType{}, &models.Epic{}, &models.UserStory{},
+		&models.AcceptanceCriteria{}, &models.Requirement{}); err != nil {
+		return nil, err
+	}
+	for _, name := range []string{"Functional", "Non-Functional", "Interface", "Data", "Business Rule"} {
+		if err := db.Create(&models.RequirementType{Name: name}).Error; err != nil {
+			return nil, err
+		}
+	}
+	p.schemas[schema] = db
+	return &database.DB{Postgres: db}, nil
+}
+
+func (p *memoryProvisioner) Drop(ctx context.Context, schema string) error {
+	delete(p.schemas, schema)
+	return nil
+}
+
+func (p *memoryProvisioner) Schemas(ctx context.Context) ([]string, error) {
+	var schemas []string
+	for schema := range p.schemas {
+		schemas = append(schemas, schema)
+	}
+	return schemas, nil
+}
+
+func setupDemoManager(t *testing.T, maxSessions int) (*Manager, *memoryProvisioner, *gin.Engine) {
+	gin.SetMode(gin.TestMode)
+	models.SetEpicGenerator(&sequentialIDGenerator{prefix: "EP"})
+	models.SetUserStoryGenerator(&sequentialIDGenerator{prefix: "US"})
+	models.SetAcceptanceCriteriaGenerator(&sequentialIDGenerator{prefix: "AC"})
+	models.SetRequirementGenerator(&sequentialIDGenerator{prefix: "REQ"})
+
+	sharedKeys, err := auth.NewKeySetFromConfig(config.JWTConfig{Secret: "demo-test-secret", Algorithm: auth.AlgorithmHS256})
+	require.NoError(t, err)
+	sharedTokens := auth.NewServiceWithKeys(sharedKeys, time.Hour, nil)
+
+	// Each session answers with the epics of its own database and the user of the access token
+	newHandler := func(db *database.DB, keys *auth.KeySet) http.Handler {
+		tokens := auth.NewServiceWithKeys(keys, time.Hour, nil)
+		router := gin.New()
+		router.GET("/api/v1/epics", func(c *gin.Context) {
+			claims, err := tokens.ValidateToken(c.GetHeader("Authorization")[len(auth.BearerPrefix):])
+			if err != nil {
+				c.Status(http.StatusUnauthorized)
+				return
+			}
+			var count int64
+			db.Postgres.Model(&models.Epic{}).Count(&count)
+			c.JSON(http.StatusOK, gin.H{"user": claims.Username, "epics": count})
+		})
+		router.DELETE("/api/v1/epics", func(c *gin.Context) {
+			db.Postgres.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&models.Epic{})
+			c.Status(http.StatusNoContent)
+		})
+		return router
+	}
+
+	provisioner := &memoryProvisioner{schemas: make(map[string]*gorm.DB)}
+	manager, err := NewManager(config.DemoConfig{SessionTTLMinutes: 60, MaxSessions: maxSessions}, provisioner, newHandler)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(manager.Middleware())
+	manager.RegisterRoutes(router)
+	router.GET("/api/v1/epics", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"shared": true}) })
+	router.GET("/api/v1/users", func(c *gin.Context) {
+		if _, err := sharedTokens.ValidateToken(strings.TrimPrefix(c.GetHeader("Authorization"), auth.BearerPrefix)); err != nil {
+			c.Status(http.StatusUnauthorized)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"shared": true})
+	})
+	return manager, provisioner, router
+}
+
+func serveDemo(router *gin.Engine, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", auth.BearerPrefix+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestManager_Sessions(t *testing.T) {
+	manager, provisioner, router := setupDemoManager(t, 2)
+
+	first, err := manager.CreateSession(context.Background())
+	require.NoError(t, err)
+	second, err := manager.CreateSession(context.Background())
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Schema, second.Schema)
+	assert.Len(t, provisioner.schemas, 2)
+
+	t.Run("seeds the demo data", func(t *testing.T) {
+		var epics, requirements int64
+		provisioner.schemas[first.Schema].Model(&models.Epic{}).Count(&epics)
+		provisioner.schemas[first.Schema].Model(&models.Requirement{}).Count(&requirements)
+		assert.Equal(t, int64(2), epics)
+		assert.Equal(t, int64(5), requirements)
+	})
+
+	t.Run("routes requests to the session data", func(t *testing.T) {
+		w := serveDemo(router, http.MethodGet, "/api/v1/epics", first.Token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"user": "demo", "epics": 2}`, w.Body.String())
+
+		// Destructive operations only affect the session
+		assert.Equal(t, http.StatusNoContent, serveDemo(router, http.MethodDelete, "/api/v1/epics", first.Token).Code)
+		assert.JSONEq(t, `{"user": "demo", "epics": 0}`, serveDemo(router, http.MethodGet, "/api/v1/epics", first.Token).Body.String())
+		assert.JSONEq(t, `{"user": "demo", "epics": 2}`, serveDemo(router, http.MethodGet, "/api/v1/epics", second.Token).Body.String())
+
+		assert.JSONEq(t, `{"shared": true}`, serveDemo(router, http.MethodGet, "/api/v1/epics", "").Body.String())
+		assert.Equal(t, http.StatusUnauthorized, serveDemo(router, http.MethodGet, "/api/v1/epics", "demo_unknown").Code)
+	})
+
+	t.Run("tokens issued in a session are rejected by the shared routes", func(t *testing.T) {
+		assert.NotEqual(t, first.keys.Current().ID, second.keys.Current().ID)
+		assert.Equal(t, http.StatusUnauthorized, serveDemo(router, http.MethodGet, "/api/v1/users", first.accessToken).Code)
+
+		// A token for any user of the session, signed with the session keys, is just as worthless
+		sessionTokens := auth.NewServiceWithKeys(first.keys, time.Hour, nil)
+		token, err := sessionTokens.GenerateToken(&models.User{ID: first.User.ID, Username: "intruder", Role: models.RoleAdministrator})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, serveDemo(router, http.MethodGet, "/api/v1/users", token).Code)
+
+		sharedKeys, err := auth.NewKeySetFromConfig(config.JWTConfig{Secret: "demo-test-secret", Algorithm: auth.AlgorithmHS256})
+		require.NoError(t, err)
+		shared, err := auth.NewServiceWithKeys(sharedKeys, time.Hour, nil).GenerateToken(first.User)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, serveDemo(router, http.MethodGet, "/api/v1/users", shared).Code)
+	})
+
+	t.Run("limits concurrent sessions", func(t *testing.T) {
+		assert.Equal(t, http.StatusConflict, serveDemo(router, http.MethodPost, SessionsPath, "").Code)
+	})
+
+	t.Run("drops expired sessions", func(t *testing.T) {
+		manager.now = func() time.Time { return first.ExpiresAt }
+		assert.Equal(t, http.StatusUnauthorized, serveDemo(router, http.MethodGet, "/api/v1/epics", first.Token).Code)
+
+		expired, err := manager.ExpireSessions(context.Background(), second.ExpiresAt)
+		require.NoError(t, err)
+		assert.Equal(t, 2, expired)
+		assert.Empty(t, provisioner.schemas)
+	})
+}
+
+func TestManager_Endpoints(t *testing.T) {
+	manager, provisioner, router := setupDemoManager(t, 5)
+
+	w := serveDemo(router, http.MethodPost, SessionsPath, "")
+	require.Equal(t, http.StatusCreated, w.Code)
+	var session SessionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &session))
+	assert.Regexp(t, `^demo_[A-Za-z0-9_-]{43}$`, session.Token)
+	assert.Equal(t, "Administrator", session.Role)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), session.ExpiresAt, time.Minute)
+
+	assert.Equal(t, http.StatusNoContent, serveDemo(router, http.MethodDelete, SessionsPath, session.Token).Code)
+	assert.Empty(t, provisioner.schemas)
+	assert.Equal(t, http.StatusUnauthorized, serveDemo(router, http.MethodDelete, SessionsPath, session.Token).Code)
+
+	// Schemas without a session, such as those of a previous run, are dropped
+	_, err := provisioner.Create(context.Background(), "demo_orphan")
+	require.NoError(t, err)
+	dropped, err := manager.DropOrphanedSchemas(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+}
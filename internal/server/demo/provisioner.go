@@ -0,0 +1,73 @@
+package demo
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/models"
+)
+
+// schemaNamePattern guards schema names, which are written into DDL statements
+var schemaNamePattern = regexp.MustCompile(`^demo_[a-z0-9]+$`)
+
+// sessionPoolSize bounds the connections of each demo session, which serves a single visitor
+const sessionPoolSize = 2
+
+// postgresProvisioner keeps demo sessions in schemas of the application database
+type postgresProvisioner struct {
+	cfg           config.DatabaseConfig
+	db            *gorm.DB // Connection to the shared schema, used for DDL
+	migrationsDir string
+}
+
+// NewPostgresProvisioner creates a provisioner that runs the migrations in migrationsDir into every demo schema
+func NewPostgresProvisioner(cfg config.DatabaseConfig, db *gorm.DB, migrationsDir string) Provisioner {
+	cfg.MaxOpenConns = sessionPoolSize
+	cfg.MaxIdleConns = 1
+	return &postgresProvisioner{cfg: cfg, db: db, migrationsDir: migrationsDir}
+}
+
+func (p *postgresProvisioner) Create(ctx context.Context, schema string) (*database.DB, error) {
+	if !schemaNamePattern.MatchString(schema) {
+		return nil, fmt.Errorf("invalid demo schema name %q", schema)
+	}
+	if err := p.db.WithContext(ctx).Exec(fmt.Sprintf(`CREATE SCHEMA "%s"`, schema)).Error; err != nil {
+		return nil, err
+	}
+	if err := database.RunMigrationsInSchema(p.migrationsDir, schema); err != nil {
+		return nil, err
+	}
+
+	pg, err := database.NewSchemaPostgresDB(p.cfg, schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := models.SeedDefaultData(pg); err != nil {
+		db := &database.DB{Postgres: pg}
+		db.Close()
+		return nil, fmt.Errorf("failed to seed default data: %w", err)
+	}
+	// Demo sessions do not share the Redis cache, so cached results cannot leak between sessions
+	return &database.DB{Postgres: pg}, nil
+}
+
+func (p *postgresProvisioner) Drop(ctx context.Context, schema string) error {
+	if !schemaNamePattern.MatchString(schema) {
+		return fmt.Errorf("invalid demo schema name %q", schema)
+	}
+	return p.db.WithContext(ctx).Exec(fmt.Sprintf(`DROP SCHEMA IF EXISTS "%s" CASCADE`, schema)).Error
+}
+
+func (p *postgresProvisioner) Schemas(ctx context.Context) ([]string, error) {
+	var schemas []string
+	err := p.db.WithContext(ctx).Raw(
+		`SELECT schema_name FROM information_schema.schemata WHERE schema_name LIKE ? ORDER BY schema_name`,
+		`demo\_%`,
+	).Scan(&schemas).Error
+	return schemas, err
+}
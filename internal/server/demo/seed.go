@@ -0,0 +1,62 @@
+package demo
+
+import (
+	initService "product-requirements-management/internal/init"
+	"product-requirements-management/internal/models"
+)
+
+// DefaultSeedBundle returns the sample workspace every demo session starts with when no seed file is configured
+func DefaultSeedBundle() *initService.SeedBundle {
+	return &initService.SeedBundle{
+		DemoWorkspace: &initService.SeedDemoWorkspace{
+			Epics: []initService.SeedEpic{
+				{
+					Title:       "Customer Self-Service Portal",
+					Description: "Let customers manage their accounts without contacting support",
+					Priority:    models.PriorityHigh,
+					UserStories: []initService.SeedUserStory{
+						{
+							Title:       "Password reset",
+							Description: "As a customer, I want to reset my password, so that I can regain access to my account",
+							AcceptanceCriteria: []string{
+								"WHEN the customer requests a reset THEN the system SHALL send a reset link to the registered email",
+								"WHEN a reset link is older than 1 hour THEN the system SHALL reject it",
+							},
+							Requirements: []initService.SeedRequirement{
+								{Title: "Reset links are single use", Type: "Functional", Priority: models.PriorityCritical},
+								{Title: "Reset emails are sent within 30 seconds", Type: "Non-Functional"},
+							},
+						},
+						{
+							Title:       "Invoice history",
+							Description: "As a customer, I want to download past invoices, so that I can file my expenses",
+							AcceptanceCriteria: []string{
+								"WHEN the customer opens the billing page THEN the system SHALL list invoices of the last 24 months",
+							},
+							Requirements: []initService.SeedRequirement{
+								{Title: "Invoices are available as PDF", Type: "Interface"},
+							},
+						},
+					},
+				},
+				{
+					Title:       "Order Tracking",
+					Description: "Show customers where their orders are without calling the warehouse",
+					UserStories: []initService.SeedUserStory{
+						{
+							Title:       "Delivery notifications",
+							Description: "As a customer, I want to be notified when my order ships, so that I can plan to receive it",
+							AcceptanceCriteria: []string{
+								"WHEN an order leaves the warehouse THEN the system SHALL notify the customer with the tracking number",
+							},
+							Requirements: []initService.SeedRequirement{
+								{Title: "Tracking numbers are stored with the shipment", Type: "Data"},
+								{Title: "Customers can opt out of notifications", Type: "Business Rule"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
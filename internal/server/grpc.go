@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/config"
+	"product-requirements-management/internal/database"
+	grpcapi "product-requirements-management/internal/grpc"
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/service"
+)
+
+// newGRPCServer creates the internal gRPC API with its own services on the shared database connections
+func newGRPCServer(cfg *config.Config, db *database.DB) (*grpcapi.Server, error) {
+	repos := repository.NewRepositories(db.Postgres, db.Redis)
+
+	jwtKeys, err := auth.NewKeySetFromConfig(cfg.JWT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWT signing keys: %w", err)
+	}
+	authService := auth.NewServiceWithKeys(jwtKeys, 24*time.Hour, repos.RefreshToken)
+	patService := service.NewPATService(
+		repos.PersonalAccessToken,
+		repos.User,
+		service.NewSecureTokenGenerator(),
+		service.NewDefaultBcryptHashService(),
+	)
+
+	searchService := service.NewSearchService(
+		db.Postgres,
+		db.Redis,
+		repos.Epic,
+		repos.UserStory,
+		repos.AcceptanceCriteria,
+		repos.Requirement,
+		repos.SteeringDocument,
+	)
+	searchService.SetLanguages(cfg.Search.Languages)
+	searchService.SetCounting(cfg.Search.CountMode, cfg.Search.MaxResults, time.Duration(cfg.Search.CountCacheSeconds)*time.Second)
+
+	services := grpcapi.Services{
+		Entities: service.NewEntityServices(repos),
+		Search:   searchService,
+		Navigation: service.NewNavigationService(
+			repos.Epic,
+			repos.UserStory,
+			repos.AcceptanceCriteria,
+			repos.Requirement,
+			repos.RequirementRelationship,
+			repos.User,
+			repos.Hierarchy,
+		),
+	}
+
+	return grpcapi.NewServer(services, authService, patService, logger.Logger), nil
+}
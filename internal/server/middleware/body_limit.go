@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"product-requirements-management/internal/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BodyLimit returns a gin.HandlerFunc that caps the size of request bodies.
+// The limit is cfg.MaxBodyBytes unless a cfg.RouteMaxBodyBytes prefix matches the request path.
+//
+// Bodies are never buffered by the middleware: requests that declare a larger Content-Length are
+// rejected before anything is read, and other bodies are counted while handlers stream them, so a
+// chunked upload is cut off as soon as it crosses the limit. Either way the client gets a 413 with
+// a structured error, even if the handler was already decoding the body.
+func BodyLimit(cfg config.RequestLimitsConfig) gin.HandlerFunc {
+	prefixes := make([]string, 0, len(cfg.RouteMaxBodyBytes))
+	for prefix := range cfg.RouteMaxBodyBytes {
+		prefixes = append(prefixes, prefix)
+	}
+	// Longest prefix first so the most specific route group wins
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) > len(prefixes[j]) })
+
+	limitFor := func(path string) int64 {
+		for _, prefix := range prefixes {
+			if path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/") {
+				return cfg.RouteMaxBodyBytes[prefix]
+			}
+		}
+		return cfg.MaxBodyBytes
+	}
+
+	return func(c *gin.Context) {
+		limit := limitFor(c.Request.URL.Path)
+		if limit <= 0 || c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > limit {
+			abortRequestTooLarge(c, limit)
+			return
+		}
+
+		writer := &bodyLimitWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Request.Body = &limitedBody{
+			reader: http.MaxBytesReader(writer, c.Request.Body, limit),
+			onExceeded: func() {
+				abortRequestTooLarge(c, limit)
+				writer.discard = true
+			},
+		}
+
+		c.Next()
+	}
+}
+
+// IsRequestTooLarge reports whether err was caused by a request body exceeding the BodyLimit
+func IsRequestTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
+
+func abortRequestTooLarge(c *gin.Context, limit int64) {
+	c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+		"error": gin.H{
+			"code":    "REQUEST_TOO_LARGE",
+			"message": fmt.Sprintf("Request body exceeds the maximum allowed size of %d bytes", limit),
+		},
+	})
+}
+
+// limitedBody reports the first read past the limit so the 413 response is written
+// before the handler turns the read error into a response of its own
+type limitedBody struct {
+	reader     io.ReadCloser
+	onExceeded func()
+	exceeded   bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.reader.Read(p)
+	if err != nil && !b.exceeded && IsRequestTooLarge(err) {
+		b.exceeded = true
+		b.onExceeded()
+	}
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.reader.Close()
+}
+
+// bodyLimitWriter drops everything a handler writes after the 413 response was sent
+type bodyLimitWriter struct {
+	gin.ResponseWriter
+	discard bool
+}
+
+func (w *bodyLimitWriter) WriteHeader(code int) {
+	if !w.discard {
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *bodyLimitWriter) Write(data []byte) (int, error) {
+	if w.discard {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *bodyLimitWriter) WriteString(s string) (int, error) {
+	if w.discard {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"product-requirements-management/internal/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := config.RequestLimitsConfig{
+		MaxBodyBytes:      16,
+		RouteMaxBodyBytes: map[string]int64{"/api/v1/mcp": 64},
+	}
+
+	router := gin.New()
+	router.Use(BodyLimit(cfg))
+	echo := func(c *gin.Context) {
+		var body map[string]interface{}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "VALIDATION_ERROR", "message": err.Error()}})
+			return
+		}
+		c.JSON(http.StatusOK, body)
+	}
+	router.POST("/api/v1/epics", echo)
+	router.POST("/api/v1/mcp", echo)
+
+	largeJSON := `{"title":"` + strings.Repeat("x", 40) + `"}`
+
+	t.Run("body within limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/epics", strings.NewReader(`{"a":"b"}`)))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("declared content length over limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/epics", strings.NewReader(largeJSON)))
+		assertRequestTooLarge(t, w)
+	})
+
+	t.Run("streamed body over limit", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/epics", io.NopCloser(strings.NewReader(largeJSON)))
+		req.ContentLength = -1
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assertRequestTooLarge(t, w)
+	})
+
+	t.Run("route group override", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/mcp", strings.NewReader(largeJSON)))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func assertRequestTooLarge(t *testing.T, w *httptest.ResponseRecorder) {
+	t.Helper()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	var response map[string]map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "REQUEST_TOO_LARGE", response["error"]["code"])
+}
@@ -0,0 +1,319 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"product-requirements-management/internal/config"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// compressionEncoder is a content coding responses can be compressed with
+type compressionEncoder struct {
+	name string
+	// get returns a compressing writer to w that must be closed and then handed back to put
+	get func(w io.Writer) compressingWriter
+	put func(compressingWriter)
+}
+
+// compressingWriter is a compressor whose buffered output can be flushed for streamed responses
+type compressingWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// Compression returns a gin.HandlerFunc that compresses response bodies with the coding the client prefers in
+// Accept-Encoding. Only bodies of the configured content types reaching cfg.MinSizeBytes are compressed, so the
+// middleware holds back the start of each body until it knows its size or the handler flushes. Responses that
+// already carry a Content-Encoding, HEAD requests and responses without a body are passed through unchanged.
+//
+// brotli (br) and gzip are offered; brotli compresses text better and wins when the client accepts both equally.
+func Compression(cfg config.CompressionConfig) gin.HandlerFunc {
+	if !cfg.Enabled {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	encoders := []compressionEncoder{brotliEncoder(cfg.Level), gzipEncoder(cfg.Level)}
+	names := make([]string, len(encoders))
+	for i, encoder := range encoders {
+		names[i] = encoder.name
+	}
+	minSize := int(cfg.MinSizeBytes)
+	contentTypes := compressibleTypes(cfg.ContentTypes)
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+
+		name := negotiateEncoding(c.GetHeader("Accept-Encoding"), names)
+		if name == "" {
+			c.Next()
+			return
+		}
+		var encoder compressionEncoder
+		for _, candidate := range encoders {
+			if candidate.name == name {
+				encoder = candidate
+			}
+		}
+
+		writer := &compressionWriter{ResponseWriter: c.Writer, encoder: encoder, minSize: minSize, contentTypes: contentTypes}
+		c.Writer = writer
+		defer func() {
+			writer.finish()
+			c.Writer = writer.ResponseWriter
+		}()
+		c.Next()
+	}
+}
+
+// gzipEncoder compresses with gzip at the given level, reusing writers between responses
+func gzipEncoder(level int) compressionEncoder {
+	pool := sync.Pool{New: func() interface{} {
+		writer, err := gzip.NewWriterLevel(io.Discard, level)
+		if err != nil {
+			writer = gzip.NewWriter(io.Discard)
+		}
+		return writer
+	}}
+	return compressionEncoder{
+		name: "gzip",
+		get: func(w io.Writer) compressingWriter {
+			writer := pool.Get().(*gzip.Writer)
+			writer.Reset(w)
+			return writer
+		},
+		put: func(writer compressingWriter) {
+			pool.Put(writer)
+		},
+	}
+}
+
+// brotliEncoder compresses with brotli at the given level, reusing writers between responses
+func brotliEncoder(level int) compressionEncoder {
+	pool := sync.Pool{New: func() interface{} {
+		return brotli.NewWriterLevel(io.Discard, level)
+	}}
+	return compressionEncoder{
+		name: "br",
+		get: func(w io.Writer) compressingWriter {
+			writer := pool.Get().(*brotli.Writer)
+			writer.Reset(w)
+			return writer
+		},
+		put: func(writer compressingWriter) {
+			pool.Put(writer)
+		},
+	}
+}
+
+// contentTypeMatcher matches media types against the configured content types
+type contentTypeMatcher struct {
+	exact    map[string]bool
+	prefixes []string // From entries such as text/*
+}
+
+// compressibleTypes builds the matcher of the configured content types
+func compressibleTypes(contentTypes []string) contentTypeMatcher {
+	matcher := contentTypeMatcher{exact: make(map[string]bool, len(contentTypes))}
+	for _, contentType := range contentTypes {
+		contentType = strings.ToLower(strings.TrimSpace(contentType))
+		if strings.HasSuffix(contentType, "/*") {
+			matcher.prefixes = append(matcher.prefixes, strings.TrimSuffix(contentType, "*"))
+		} else if contentType != "" {
+			matcher.exact[contentType] = true
+		}
+	}
+	return matcher
+}
+
+// matches reports whether a Content-Type header names a compressible media type
+func (m contentTypeMatcher) matches(header string) bool {
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return false
+	}
+	if m.exact[mediaType] {
+		return true
+	}
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(mediaType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateEncoding picks the supported content coding with the highest quality in an Accept-Encoding header,
+// preferring the earlier supported coding on ties. It returns an empty string when none is acceptable.
+func negotiateEncoding(acceptEncoding string, supported []string) string {
+	qualities := make(map[string]float64)
+	wildcard := -1.0
+	for _, entry := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(entry, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			if key, value, ok := strings.Cut(strings.TrimSpace(param), "="); ok && strings.EqualFold(key, "q") {
+				if parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+					quality = parsed
+				}
+			}
+		}
+		if coding == "*" {
+			wildcard = quality
+		} else {
+			qualities[coding] = quality
+		}
+	}
+
+	best, bestQuality := "", 0.0
+	for _, coding := range supported {
+		quality, listed := qualities[coding]
+		if !listed {
+			quality = wildcard
+		}
+		if quality > bestQuality {
+			best, bestQuality = coding, quality
+		}
+	}
+	return best
+}
+
+// compressionWriter holds back the start of a response body until it reaches the minimum size, then either
+// compresses the rest of the response or passes it through
+type compressionWriter struct {
+	gin.ResponseWriter
+	encoder      compressionEncoder
+	minSize      int
+	contentTypes contentTypeMatcher
+
+	status     int
+	buffer     bytes.Buffer
+	size       int
+	decided    bool
+	compressor compressingWriter
+}
+
+func (w *compressionWriter) WriteHeader(status int) {
+	if !w.decided {
+		w.status = status
+	}
+}
+
+func (w *compressionWriter) WriteHeaderNow() {}
+
+func (w *compressionWriter) Write(data []byte) (int, error) {
+	w.size += len(data)
+	if !w.decided {
+		w.buffer.Write(data)
+		if w.buffer.Len() < w.minSize {
+			return len(data), nil
+		}
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	}
+	if w.compressor != nil {
+		return w.compressor.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *compressionWriter) WriteString(data string) (int, error) {
+	return w.Write([]byte(data))
+}
+
+// Flush sends what was written so far, compressing it when the response qualifies
+func (w *compressionWriter) Flush() {
+	if !w.decided {
+		if err := w.decide(); err != nil {
+			return
+		}
+	}
+	if w.compressor != nil {
+		_ = w.compressor.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *compressionWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.decided = true
+	return w.ResponseWriter.Hijack()
+}
+
+func (w *compressionWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *compressionWriter) Written() bool {
+	return w.status != 0 || w.size > 0
+}
+
+// Size returns the number of uncompressed body bytes written by the handler
+func (w *compressionWriter) Size() int {
+	return w.size
+}
+
+// decide sends the headers and the held-back body, compressed when the response qualifies
+func (w *compressionWriter) decide() error {
+	w.decided = true
+	header := w.ResponseWriter.Header()
+	if w.buffer.Len() > 0 && w.buffer.Len() >= w.minSize && header.Get("Content-Encoding") == "" &&
+		w.contentTypes.matches(header.Get("Content-Type")) && bodyAllowed(w.Status()) {
+		header.Set("Content-Encoding", w.encoder.name)
+		header.Del("Content-Length")
+		w.compressor = w.encoder.get(w.ResponseWriter)
+	}
+
+	w.ResponseWriter.WriteHeader(w.Status())
+	body := w.buffer.Bytes()
+	w.buffer = bytes.Buffer{}
+	if len(body) == 0 {
+		w.ResponseWriter.WriteHeaderNow()
+		return nil
+	}
+	if w.compressor != nil {
+		_, err := w.compressor.Write(body)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(body)
+	return err
+}
+
+// finish sends a response that stayed below the minimum size and completes a compressed one
+func (w *compressionWriter) finish() {
+	if !w.decided {
+		_ = w.decide()
+	}
+	if w.compressor != nil {
+		_ = w.compressor.Close()
+		w.encoder.put(w.compressor)
+		w.compressor = nil
+	}
+}
+
+// bodyAllowed reports whether a response with the status can have a body
+func bodyAllowed(status int) bool {
+	return status >= http.StatusOK && status != http.StatusNoContent && status != http.StatusNotModified
+}
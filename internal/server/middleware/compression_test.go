@@ -0,0 +1,196 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"product-requirements-management/internal/config"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	supported := []string{"br", "gzip"}
+	cases := map[string]string{
+		"":                          "",
+		"gzip":                      "gzip",
+		"GZIP, deflate":             "gzip",
+		"br":                        "br",
+		"gzip, deflate, br":         "br",
+		"br;q=1.0, gzip;q=0.8":      "br",
+		"br;q=0.5, gzip":            "gzip",
+		"gzip;q=0":                  "",
+		"identity":                  "",
+		"*":                         "br",
+		"*;q=0":                     "",
+		"gzip;q=0, *":               "br",
+		"br;q=0, gzip;q=0, *":       "",
+		"deflate, *;q=0.5":          "br",
+		"gzip; q=0.001":             "gzip",
+		"identity;q=1, gzip;q=0.5":  "gzip",
+		"deflate;q=0.5, identity;q": "",
+	}
+	for header, expected := range cases {
+		assert.Equal(t, expected, negotiateEncoding(header, supported), header)
+	}
+}
+
+func TestCompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := config.CompressionConfig{
+		Enabled:      true,
+		MinSizeBytes: 1024,
+		Level:        5,
+		ContentTypes: []string{"application/json", "text/*"},
+	}
+	large := strings.Repeat(`{"title":"Checkout"},`, 200)
+
+	router := gin.New()
+	router.Use(Compression(cfg))
+	router.GET("/large", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(large))
+	})
+	router.GET("/small", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": "e1"})
+	})
+	router.GET("/text", func(c *gin.Context) {
+		c.String(http.StatusCreated, large)
+	})
+	router.GET("/image", func(c *gin.Context) {
+		c.Data(http.StatusOK, "image/png", []byte(large))
+	})
+	router.GET("/encoded", func(c *gin.Context) {
+		c.Header("Content-Encoding", "gzip")
+		c.Data(http.StatusOK, "application/json", []byte(large))
+	})
+	router.GET("/empty", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	request := func(path, acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	decompress := func(t *testing.T, w *httptest.ResponseRecorder) string {
+		reader, err := gzip.NewReader(w.Body)
+		require.NoError(t, err)
+		body, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	t.Run("compresses large JSON", func(t *testing.T) {
+		w := request("/large", "gzip, deflate")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+		assert.Empty(t, w.Header().Get("Content-Length"))
+		assert.Less(t, w.Body.Len(), len(large))
+		assert.Equal(t, large, decompress(t, w))
+	})
+
+	t.Run("compresses with brotli when the client prefers it", func(t *testing.T) {
+		w := request("/large", "gzip, deflate, br")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "br", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+		assert.Empty(t, w.Header().Get("Content-Length"))
+		assert.Less(t, w.Body.Len(), len(large))
+		body, err := io.ReadAll(brotli.NewReader(w.Body))
+		require.NoError(t, err)
+		assert.Equal(t, large, string(body))
+	})
+
+	t.Run("compresses allowed content type prefixes and keeps the status", func(t *testing.T) {
+		w := request("/text", "gzip")
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, large, decompress(t, w))
+	})
+
+	t.Run("leaves small responses uncompressed", func(t *testing.T) {
+		w := request("/small", "gzip")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+		assert.JSONEq(t, `{"id":"e1"}`, w.Body.String())
+	})
+
+	t.Run("leaves other content types uncompressed", func(t *testing.T) {
+		w := request("/image", "gzip")
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Equal(t, large, w.Body.String())
+	})
+
+	t.Run("leaves encoded responses alone", func(t *testing.T) {
+		w := request("/encoded", "gzip")
+		assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+		assert.Equal(t, large, w.Body.String())
+	})
+
+	t.Run("leaves responses without a body alone", func(t *testing.T) {
+		w := request("/empty", "gzip")
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Zero(t, w.Body.Len())
+	})
+
+	t.Run("honours the client's preferences", func(t *testing.T) {
+		for _, acceptEncoding := range []string{"", "identity", "gzip;q=0", "deflate", "br;q=0, gzip;q=0"} {
+			w := request("/large", acceptEncoding)
+			assert.Empty(t, w.Header().Get("Content-Encoding"), acceptEncoding)
+			assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"), acceptEncoding)
+			assert.Equal(t, large, w.Body.String(), acceptEncoding)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		disabled := gin.New()
+		disabled.Use(Compression(config.CompressionConfig{}))
+		disabled.GET("/large", func(c *gin.Context) {
+			c.Data(http.StatusOK, "application/json", []byte(large))
+		})
+		req := httptest.NewRequest(http.MethodGet, "/large", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		disabled.ServeHTTP(w, req)
+		assert.Empty(t, w.Header().Get("Content-Encoding"))
+		assert.Equal(t, large, w.Body.String())
+	})
+}
+
+func TestCompressionWithFieldSelection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Compression(config.CompressionConfig{Enabled: true, MinSizeBytes: 64, Level: 5, ContentTypes: []string{"application/json"}}))
+	router.Use(FieldSelection())
+	router.GET("/epics", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": "e1", "title": strings.Repeat("Checkout ", 20), "description": "Long text"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/epics?fields=id,title", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	reader, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"e1","title":"`+strings.Repeat("Checkout ", 20)+`"}`, string(body))
+}
@@ -0,0 +1,282 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ConditionalGet returns a gin.HandlerFunc that answers conditional GET requests. Successful JSON responses get
+// an ETag computed from their body and are replaced by 304 Not Modified when it matches If-None-Match. Without
+// If-None-Match, If-Modified-Since is compared with the Last-Modified time of the response.
+//
+// Handlers that know when an entity and its descendants last changed set Last-Modified themselves. Otherwise it
+// is the latest updated_at of a single entity response and the entities nested in it. Responses listing entities
+// get no Last-Modified from their body, since removed entities leave no trace in it; their ETag still changes.
+// Neither do responses whose content changes without their updated_at: responses to requests with include,
+// translated responses, and responses with fields computed per user or from other entities.
+//
+// Other responses, including streams and hijacked connections, are passed through unchanged.
+func ConditionalGet() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		writer := &conditionalGetWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if !writer.buffering {
+			if !writer.passthrough {
+				c.Writer.WriteHeader(writer.Status())
+				c.Writer.WriteHeaderNow()
+			}
+			return
+		}
+
+		body := writer.body.Bytes()
+		header := c.Writer.Header()
+		etag := responseETag(body)
+		header.Set("ETag", etag)
+		lastModified, _ := http.ParseTime(header.Get("Last-Modified"))
+		if header.Get("Last-Modified") == "" && !derivedResponse(c.Request, header) {
+			if updatedAt, ok := latestUpdatedAt(body); ok {
+				lastModified = updatedAt
+			}
+		}
+		if !lastModified.IsZero() {
+			header.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		}
+		if header.Get("Cache-Control") == "" {
+			// Caches may keep the response but must revalidate it before reuse
+			header.Set("Cache-Control", "private, no-cache")
+		}
+
+		if notModified(c.Request, etag, lastModified) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		c.Writer.WriteHeader(http.StatusOK)
+		_, _ = c.Writer.Write(body)
+	}
+}
+
+// responseETag returns a weak entity tag for a response body; it is weak because compression changes the bytes sent
+func responseETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// notModified reports whether a conditional request can be answered with 304 Not Modified
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		return etagMatches(ifNoneMatch, etag)
+	}
+	if lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	// HTTP dates have second precision
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// etagMatches compares an If-None-Match header with an entity tag using weak comparison
+func etagMatches(ifNoneMatch, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// derivedFields are response fields computed per user or from other entities, whose changes leave the
+// updated_at of the response untouched
+var derivedFields = map[string]bool{
+	"comment_count":  true,
+	"children_count": true,
+	"favorited_at":   true,
+	"is_favorite":    true,
+	"is_stale":       true,
+	"risk":           true,
+	"translations":   true,
+	"warnings":       true,
+}
+
+// derivedResponse reports whether a response was shaped by the request beyond the entity it shows:
+// extra data added by include, or content translated for the Accept-Language header
+func derivedResponse(r *http.Request, header http.Header) bool {
+	if r.URL.Query().Has("include") {
+		return true
+	}
+	return r.Header.Get("Accept-Language") != "" && header.Get("Content-Language") != ""
+}
+
+// latestUpdatedAt returns the latest updated_at of a single entity response and the entities nested in it.
+// It reports false for other responses, for responses embedding lists of entities and for responses with
+// derived fields.
+func latestUpdatedAt(body []byte) (time.Time, bool) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return time.Time{}, false
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return time.Time{}, false
+	}
+	if _, ok := object["updated_at"]; !ok {
+		return time.Time{}, false
+	}
+
+	var latest time.Time
+	if !collectUpdatedAt(object, &latest) || latest.IsZero() {
+		return time.Time{}, false
+	}
+	return latest, true
+}
+
+// collectUpdatedAt raises latest to the updated_at values of an object and the objects nested in it.
+// It returns false when it finds a list of entities or a derived field.
+func collectUpdatedAt(value interface{}, latest *time.Time) bool {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for name, field := range typed {
+			if name == "updated_at" {
+				if text, ok := field.(string); ok {
+					if updatedAt, err := time.Parse(time.RFC3339Nano, text); err == nil && updatedAt.After(*latest) {
+						*latest = updatedAt
+					}
+				}
+				continue
+			}
+			if derivedFields[name] || !collectUpdatedAt(field, latest) {
+				return false
+			}
+		}
+	case []interface{}:
+		for _, item := range typed {
+			if object, ok := item.(map[string]interface{}); ok {
+				if _, ok := object["updated_at"]; ok {
+					return false
+				}
+			}
+			if !collectUpdatedAt(item, latest) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// conditionalGetWriter holds back successful JSON responses so they can be replaced by 304 Not Modified,
+// and passes other responses through as soon as they start
+type conditionalGetWriter struct {
+	gin.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	buffering   bool
+	passthrough bool
+}
+
+func (w *conditionalGetWriter) WriteHeader(status int) {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if !w.buffering {
+		w.status = status
+	}
+}
+
+func (w *conditionalGetWriter) WriteHeaderNow() {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *conditionalGetWriter) Write(data []byte) (int, error) {
+	if !w.buffering && !w.passthrough {
+		if w.Status() == http.StatusOK && strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+			w.buffering = true
+		} else {
+			w.pass()
+		}
+	}
+	if w.buffering {
+		return w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *conditionalGetWriter) WriteString(data string) (int, error) {
+	return w.Write([]byte(data))
+}
+
+// Flush sends the response as it is; flushed responses are streamed and never answered conditionally
+func (w *conditionalGetWriter) Flush() {
+	if !w.passthrough {
+		w.pass()
+		if w.body.Len() > 0 {
+			_, _ = w.ResponseWriter.Write(w.body.Bytes())
+			w.body.Reset()
+		}
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *conditionalGetWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.buffering = false
+	w.passthrough = true
+	return w.ResponseWriter.Hijack()
+}
+
+// pass stops holding back the response and sends its status
+func (w *conditionalGetWriter) pass() {
+	status := w.Status()
+	w.buffering = false
+	w.passthrough = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *conditionalGetWriter) Status() int {
+	if w.passthrough {
+		return w.ResponseWriter.Status()
+	}
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *conditionalGetWriter) Written() bool {
+	if w.passthrough {
+		return w.ResponseWriter.Written()
+	}
+	return w.status != 0 || w.body.Len() > 0
+}
+
+func (w *conditionalGetWriter) Size() int {
+	if w.passthrough {
+		return w.ResponseWriter.Size()
+	}
+	return w.body.Len()
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestUpdatedAt(t *testing.T) {
+	updatedAt, ok := latestUpdatedAt([]byte(`{"id":"e1","updated_at":"2024-01-01T10:00:00Z","creator":{"updated_at":"2024-02-01T10:00:00.5Z"}}`))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 2, 1, 10, 0, 0, 500000000, time.UTC), updatedAt)
+
+	for _, body := range []string{
+		`{"id":"e1"}`,
+		`[{"id":"e1","updated_at":"2024-01-01T10:00:00Z"}]`,
+		`{"data":[{"id":"e1","updated_at":"2024-01-01T10:00:00Z"}],"total_count":1}`,
+		`{"id":"e1","updated_at":"2024-01-01T10:00:00Z","user_stories":[{"id":"s1","updated_at":"2024-01-01T10:00:00Z"}]}`,
+		`{"id":"e1","updated_at":"2024-01-01T10:00:00Z","comment_count":3}`,
+		`{"id":"r1","updated_at":"2024-01-01T10:00:00Z","favorite":{"favorited_at":"2024-01-01T10:00:00Z"}}`,
+		`not json`,
+	} {
+		_, ok := latestUpdatedAt([]byte(body))
+		assert.False(t, ok, body)
+	}
+}
+
+func TestConditionalGet(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	updatedAt := time.Date(2024, 1, 1, 10, 0, 0, 250000000, time.UTC)
+	router := gin.New()
+	router.Use(ConditionalGet())
+	router.GET("/epics/:id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id"), "updated_at": updatedAt})
+	})
+	router.GET("/translated/:id", func(c *gin.Context) {
+		c.Header("Content-Language", "ru")
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id"), "updated_at": updatedAt})
+	})
+	router.GET("/epics", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": []gin.H{{"id": "e1", "updated_at": updatedAt}}, "total_count": 1})
+	})
+	router.GET("/hierarchy", func(c *gin.Context) {
+		c.Header("Last-Modified", updatedAt.Add(time.Hour).Format(http.TimeFormat))
+		c.JSON(http.StatusOK, gin.H{"data": []gin.H{{"id": "s1", "updated_at": updatedAt}}})
+	})
+	router.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Epic not found"})
+	})
+	router.GET("/text", func(c *gin.Context) {
+		c.String(http.StatusOK, "plain")
+	})
+	router.GET("/stream", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		_, _ = c.Writer.Write([]byte(`{"chunk":1}`))
+		c.Writer.Flush()
+		_, _ = c.Writer.Write([]byte(`{"chunk":2}`))
+	})
+
+	request := func(path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("sets validators", func(t *testing.T) {
+		w := request("/epics/e1", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, w.Header().Get("ETag"))
+		assert.Equal(t, "Mon, 01 Jan 2024 10:00:00 GMT", w.Header().Get("Last-Modified"))
+		assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+		assert.JSONEq(t, `{"id":"e1","updated_at":"2024-01-01T10:00:00.25Z"}`, w.Body.String())
+	})
+
+	t.Run("answers If-None-Match", func(t *testing.T) {
+		etag := request("/epics/e1", nil).Header().Get("ETag")
+
+		for _, ifNoneMatch := range []string{etag, `"other", ` + etag, etag[2:], "*"} {
+			w := request("/epics/e1", map[string]string{"If-None-Match": ifNoneMatch})
+			assert.Equal(t, http.StatusNotModified, w.Code, ifNoneMatch)
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+			assert.Empty(t, w.Body.String())
+		}
+
+		w := request("/epics/e2", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("answers If-Modified-Since", func(t *testing.T) {
+		w := request("/epics/e1", map[string]string{"If-Modified-Since": "Mon, 01 Jan 2024 10:00:00 GMT"})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, "Mon, 01 Jan 2024 10:00:00 GMT", w.Header().Get("Last-Modified"))
+
+		w = request("/epics/e1", map[string]string{"If-Modified-Since": "Mon, 01 Jan 2024 09:59:59 GMT"})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// If-None-Match takes precedence
+		w = request("/epics/e1", map[string]string{"If-Modified-Since": "Mon, 01 Jan 2024 10:00:00 GMT", "If-None-Match": `"other"`})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("lists only carry an ETag", func(t *testing.T) {
+		w := request("/epics", map[string]string{"If-Modified-Since": "Mon, 01 Jan 2024 12:00:00 GMT"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Last-Modified"))
+		etag := w.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+
+		w = request("/epics", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("requests shaping the response only carry an ETag", func(t *testing.T) {
+		for _, r := range []struct {
+			path    string
+			headers map[string]string
+		}{
+			{"/epics/e1?include=creator", nil},
+			{"/translated/e1", map[string]string{"Accept-Language": "ru"}},
+		} {
+			headers := map[string]string{"If-Modified-Since": "Mon, 01 Jan 2024 12:00:00 GMT"}
+			for name, value := range r.headers {
+				headers[name] = value
+			}
+			w := request(r.path, headers)
+			assert.Equal(t, http.StatusOK, w.Code, r.path)
+			assert.Empty(t, w.Header().Get("Last-Modified"), r.path)
+			assert.NotEmpty(t, w.Header().Get("ETag"), r.path)
+		}
+
+		w := request("/translated/e1", nil)
+		assert.Equal(t, "Mon, 01 Jan 2024 10:00:00 GMT", w.Header().Get("Last-Modified"), "untranslated responses keep Last-Modified")
+	})
+
+	t.Run("uses the Last-Modified set by the handler", func(t *testing.T) {
+		w := request("/hierarchy", map[string]string{"If-Modified-Since": "Mon, 01 Jan 2024 10:30:00 GMT"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Mon, 01 Jan 2024 11:00:00 GMT", w.Header().Get("Last-Modified"))
+
+		w = request("/hierarchy", map[string]string{"If-Modified-Since": "Mon, 01 Jan 2024 11:00:00 GMT"})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("passes other responses through", func(t *testing.T) {
+		w := request("/missing", map[string]string{"If-None-Match": "*"})
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, w.Header().Get("ETag"))
+		assert.JSONEq(t, `{"error":"Epic not found"}`, w.Body.String())
+
+		w = request("/text", map[string]string{"If-None-Match": "*"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("ETag"))
+		assert.Equal(t, "plain", w.Body.String())
+
+		w = request("/stream", map[string]string{"If-None-Match": "*"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("ETag"))
+		assert.Equal(t, `{"chunk":1}{"chunk":2}`, w.Body.String())
+	})
+}
@@ -1,56 +1,62 @@
 package middleware
 
 import (
-	"os"
+	"strconv"
 	"strings"
 
+	"product-requirements-management/internal/config"
+
 	"github.com/gin-gonic/gin"
 )
 
-// CORS returns a gin.HandlerFunc for handling CORS
+// CORS returns a gin.HandlerFunc for handling CORS configured from CORS_* environment variables
 func CORS() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		origin := c.Request.Header.Get("Origin")
+	return CORSWithConfig(config.LoadCORSConfig())
+}
 
-		// Get allowed origins from environment or use default
-		allowedOriginsEnv := os.Getenv("CORS_ALLOWED_ORIGINS")
-		if allowedOriginsEnv == "" {
-			// Default to common development origins
-			allowedOriginsEnv = "http://localhost:3000,http://localhost:5173,http://localhost:8080"
-		}
+// CORSWithConfig returns a gin.HandlerFunc for handling CORS with the given configuration
+func CORSWithConfig(cfg config.CORSConfig) gin.HandlerFunc {
+	allowedMethods := strings.Join(cfg.AllowedMethods, ", ")
+	allowedHeaders := strings.Join(cfg.AllowedHeaders, ", ")
+	exposedHeaders := strings.Join(cfg.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(cfg.MaxAgeSeconds)
 
-		// Split allowed origins
-		allowedOrigins := strings.Split(allowedOriginsEnv, ",")
+	fallbackOrigin := ""
+	if len(cfg.AllowedOrigins) > 0 {
+		fallbackOrigin = cfg.AllowedOrigins[0]
+	}
+
+	return func(c *gin.Context) {
+		origin := c.Request.Header.Get("Origin")
 
 		// Check if the request origin is allowed
 		originAllowed := false
-		for _, allowedOrigin := range allowedOrigins {
-			trimmedOrigin := strings.TrimSpace(allowedOrigin)
-			if trimmedOrigin == "*" || trimmedOrigin == origin {
+		for _, allowedOrigin := range cfg.AllowedOrigins {
+			if allowedOrigin == "*" || allowedOrigin == origin {
 				originAllowed = true
 				break
 			}
 		}
 
 		// Set CORS headers
-		if originAllowed {
-			if origin != "" {
-				// Use the actual origin instead of wildcard when credentials are used
-				c.Header("Access-Control-Allow-Origin", origin)
-			} else {
-				// Fallback to first allowed origin if no origin header
-				c.Header("Access-Control-Allow-Origin", strings.TrimSpace(allowedOrigins[0]))
+		if originAllowed && origin != "" {
+			// Use the actual origin instead of wildcard so credentials can be used
+			c.Header("Access-Control-Allow-Origin", origin)
+			c.Header("Vary", "Origin")
+			if cfg.AllowCredentials {
+				c.Header("Access-Control-Allow-Credentials", "true")
 			}
-			c.Header("Access-Control-Allow-Credentials", "true")
-		} else {
-			// If origin not allowed, don't set credentials header
-			c.Header("Access-Control-Allow-Origin", strings.TrimSpace(allowedOrigins[0]))
+		} else if fallbackOrigin != "" {
+			// Browsers reject the response for any other origin
+			c.Header("Access-Control-Allow-Origin", fallbackOrigin)
 		}
 
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, X-Request-ID, X-Auth-Skip")
-		c.Header("Access-Control-Expose-Headers", "Content-Length")
-		c.Header("Access-Control-Max-Age", "86400") // Cache preflight for 24 hours
+		c.Header("Access-Control-Allow-Methods", allowedMethods)
+		c.Header("Access-Control-Allow-Headers", allowedHeaders)
+		if exposedHeaders != "" {
+			c.Header("Access-Control-Expose-Headers", exposedHeaders)
+		}
+		c.Header("Access-Control-Max-Age", maxAge) // Cache preflight
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"product-requirements-management/internal/deprecation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecation returns a gin.HandlerFunc that announces deprecated routes to clients: the Deprecation header
+// (RFC 9745) carries the deprecation date, the Sunset header (RFC 8594) the removal date and the Link header
+// points to the replacement.
+func Deprecation(registry *deprecation.Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		endpoint, ok := registry.Lookup(c.Request.Method, c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		c.Header("Deprecation", fmt.Sprintf("@%d", endpoint.Since.Unix()))
+		if !endpoint.Sunset.IsZero() {
+			c.Header("Sunset", endpoint.Sunset.UTC().Format(http.TimeFormat))
+		}
+		if replacement := endpoint.ReplacementPath(); replacement != "" {
+			c.Header("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", replacement))
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"product-requirements-management/internal/deprecation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	registry := deprecation.NewRegistry()
+	registry.Deprecate(http.MethodGet, "/items/status/:status", "2026-10-17", "2027-04-30", "GET /items?status=open")
+	registry.Deprecate(http.MethodDelete, "/items/:id", "2026-01-05", "", "")
+
+	router := gin.New()
+	router.Use(Deprecation(registry))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/items/status/:status", ok)
+	router.GET("/items/:id", ok)
+	router.DELETE("/items/:id", ok)
+
+	t.Run("deprecated route with sunset and replacement", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items/status/open", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "@1792195200", w.Header().Get("Deprecation"))
+		assert.Equal(t, "Fri, 30 Apr 2027 00:00:00 GMT", w.Header().Get("Sunset"))
+		assert.Equal(t, `</items?status=open>; rel="successor-version"`, w.Header().Get("Link"))
+	})
+
+	t.Run("deprecated route without sunset", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/items/1", nil))
+
+		assert.Equal(t, "@1767571200", w.Header().Get("Deprecation"))
+		assert.Empty(t, w.Header().Get("Sunset"))
+		assert.Empty(t, w.Header().Get("Link"))
+	})
+
+	t.Run("other method on the same path", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items/1", nil))
+
+		assert.Empty(t, w.Header().Get("Deprecation"))
+	})
+}
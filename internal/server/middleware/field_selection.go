@@ -0,0 +1,228 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FieldsParam is the query parameter that selects the fields of a response
+const FieldsParam = "fields"
+
+// maxFieldSelectionDepth limits how deeply field selections can be nested
+const maxFieldSelectionDepth = 5
+
+// fieldSelection maps the selected field names to the selection of their nested fields;
+// a nil selection keeps the whole value
+type fieldSelection map[string]fieldSelection
+
+// FieldSelection returns a gin.HandlerFunc that trims successful JSON responses to GET requests down to the
+// fields listed in the fields query parameter, such as fields=id,reference_id,title,status. Nested objects and
+// arrays of objects are selected with parentheses: fields=id,user_stories(id,title).
+//
+// The selection applies to the items of list responses, whose data, total_count, limit and offset are kept,
+// to each element of a top-level array and to single objects otherwise. Unknown fields are ignored.
+// Requests without the parameter, error responses and responses other than JSON are passed through unchanged.
+func FieldSelection() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw, ok := c.GetQuery(FieldsParam)
+		if !ok || c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		selection, err := parseFieldSelection(raw)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Invalid fields parameter: " + err.Error(),
+				},
+			})
+			return
+		}
+
+		writer := &fieldSelectionWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		body := writer.body.Bytes()
+		status := writer.Status()
+		if status >= http.StatusOK && status < http.StatusMultipleChoices &&
+			strings.HasPrefix(c.Writer.Header().Get("Content-Type"), "application/json") {
+			if selected, err := selectResponseFields(body, selection); err == nil {
+				body = selected
+			}
+		}
+		c.Writer.WriteHeader(status)
+		_, _ = c.Writer.Write(body)
+	}
+}
+
+// parseFieldSelection parses a comma-separated list of field names with parenthesized nested selections
+func parseFieldSelection(raw string) (fieldSelection, error) {
+	selection, rest, err := parseFieldList(raw, 0)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("unexpected %q", rest[:1])
+	}
+	return selection, nil
+}
+
+// parseFieldList parses field names up to the end of the input or an unmatched closing parenthesis,
+// which is returned with the rest of the input
+func parseFieldList(raw string, depth int) (fieldSelection, string, error) {
+	if depth > maxFieldSelectionDepth {
+		return nil, "", fmt.Errorf("selections can be nested at most %d levels deep", maxFieldSelectionDepth)
+	}
+
+	selection := fieldSelection{}
+	for {
+		end := strings.IndexAny(raw, ",()")
+		if end < 0 {
+			end = len(raw)
+		}
+		name := strings.TrimSpace(raw[:end])
+		if !isFieldName(name) {
+			return nil, "", fmt.Errorf("invalid field name %q", name)
+		}
+		raw = raw[end:]
+
+		var nested fieldSelection
+		if strings.HasPrefix(raw, "(") {
+			var err error
+			nested, raw, err = parseFieldList(raw[1:], depth+1)
+			if err != nil {
+				return nil, "", err
+			}
+			if !strings.HasPrefix(raw, ")") {
+				return nil, "", fmt.Errorf("missing ) after the fields of %q", name)
+			}
+			raw = strings.TrimLeft(raw[1:], " ")
+		}
+		if existing, ok := selection[name]; ok {
+			nested = mergeFieldSelections(existing, nested)
+		}
+		selection[name] = nested
+
+		if !strings.HasPrefix(raw, ",") {
+			return selection, raw, nil
+		}
+		raw = raw[1:]
+	}
+}
+
+// mergeFieldSelections combines two selections of the same field; selecting the whole field wins
+func mergeFieldSelections(a, b fieldSelection) fieldSelection {
+	if a == nil || b == nil {
+		return nil
+	}
+	for name, nested := range b {
+		if existing, ok := a[name]; ok {
+			nested = mergeFieldSelections(existing, nested)
+		}
+		a[name] = nested
+	}
+	return a
+}
+
+// isFieldName reports whether name consists of letters, digits and underscores
+func isFieldName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r != '_' && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// selectResponseFields applies a field selection to a JSON response body
+func selectResponseFields(body []byte, selection fieldSelection) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	// List responses keep their envelope; the selection applies to their items
+	if object, ok := value.(map[string]interface{}); ok {
+		if _, selected := selection["data"]; !selected {
+			if data, ok := object["data"]; ok {
+				object["data"] = selectFields(data, selection)
+				return json.Marshal(object)
+			}
+		}
+	}
+	return json.Marshal(selectFields(value, selection))
+}
+
+// selectFields keeps the selected fields of an object or of each object in an array
+func selectFields(value interface{}, selection fieldSelection) interface{} {
+	if selection == nil {
+		return value
+	}
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		selected := make(map[string]interface{}, len(selection))
+		for name, nested := range selection {
+			if field, ok := typed[name]; ok {
+				selected[name] = selectFields(field, nested)
+			}
+		}
+		return selected
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = selectFields(item, selection)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+// fieldSelectionWriter holds back the response so its fields can be selected before it is sent
+type fieldSelectionWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *fieldSelectionWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *fieldSelectionWriter) WriteHeaderNow() {}
+
+func (w *fieldSelectionWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *fieldSelectionWriter) WriteString(data string) (int, error) {
+	return w.body.WriteString(data)
+}
+
+func (w *fieldSelectionWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *fieldSelectionWriter) Written() bool {
+	return w.status != 0 || w.body.Len() > 0
+}
+
+func (w *fieldSelectionWriter) Size() int {
+	return w.body.Len()
+}
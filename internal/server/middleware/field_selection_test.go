@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFieldSelection(t *testing.T) {
+	selection, err := parseFieldSelection("id, title,user_stories(id,requirements(id)),user_stories(title),author")
+	require.NoError(t, err)
+	assert.Equal(t, fieldSelection{
+		"id":           nil,
+		"title":        nil,
+		"author":       nil,
+		"user_stories": {"id": nil, "title": nil, "requirements": {"id": nil}},
+	}, selection)
+
+	selection, err = parseFieldSelection("user_stories(id),user_stories")
+	require.NoError(t, err)
+	assert.Equal(t, fieldSelection{"user_stories": nil}, selection)
+
+	for _, invalid := range []string{"", "id,", "id,,title", "user_stories(id", "id)", "user_stories()", "title-case", "a(b(c(d(e(f(g))))))"} {
+		_, err := parseFieldSelection(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestFieldSelection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	epic := gin.H{
+		"id": "e1", "reference_id": "EP-001", "title": "Checkout", "priority": 1,
+		"user_stories": []gin.H{{"id": "s1", "title": "Pay", "description": "Long text"}},
+		"creator":      gin.H{"id": "u1", "username": "alice", "email": "alice@example.com"},
+	}
+	router := gin.New()
+	router.Use(FieldSelection())
+	router.GET("/epics", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": []gin.H{epic}, "total_count": 1, "limit": 50, "offset": 0})
+	})
+	router.GET("/epics/:id", func(c *gin.Context) { c.JSON(http.StatusOK, epic) })
+	router.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "ENTITY_NOT_FOUND", "message": "Epic not found"}})
+	})
+	router.GET("/text", func(c *gin.Context) { c.String(http.StatusOK, "id,title") })
+	router.POST("/epics", func(c *gin.Context) { c.JSON(http.StatusCreated, epic) })
+
+	send := func(method, path, fields string) *httptest.ResponseRecorder {
+		if fields != "" {
+			path += "?fields=" + url.QueryEscape(fields)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	t.Run("selects the fields of list items and keeps the envelope", func(t *testing.T) {
+		w := send(http.MethodGet, "/epics", "id,reference_id,user_stories(id,title)")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":[{"id":"e1","reference_id":"EP-001","user_stories":[{"id":"s1","title":"Pay"}]}],
+			"total_count":1,"limit":50,"offset":0}`, w.Body.String())
+	})
+
+	t.Run("selects the fields of single entities", func(t *testing.T) {
+		w := send(http.MethodGet, "/epics/e1", "title,priority,creator(username),unknown")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"title":"Checkout","priority":1,"creator":{"username":"alice"}}`, w.Body.String())
+	})
+
+	t.Run("passes other responses through", func(t *testing.T) {
+		assert.Contains(t, send(http.MethodGet, "/epics/e1", "").Body.String(), `"description":"Long text"`)
+		w := send(http.MethodGet, "/missing", "id")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), `"ENTITY_NOT_FOUND"`)
+		assert.Equal(t, "id,title", send(http.MethodGet, "/text", "id").Body.String())
+		w = send(http.MethodPost, "/epics", "id")
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"reference_id"`)
+	})
+
+	t.Run("rejects invalid selections", func(t *testing.T) {
+		w := send(http.MethodGet, "/epics", "user_stories(id")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"VALIDATION_ERROR"`)
+	})
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/swagger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// RequestValidation returns a gin.HandlerFunc that rejects requests deviating from the documented API
+// contract with a 400 listing every violation. Routes without a documented operation pass through.
+//
+// The JSON body of documented operations is read and restored for the handler, so the middleware must
+// run after BodyLimit. It is meant for development and staging, where it surfaces drift between the
+// handlers and the Swagger specification at runtime.
+func RequestValidation(validator *swagger.RequestValidator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		operation := validator.Operation(c.Request.Method, c.FullPath())
+		if operation == nil {
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if operation.HasBody() && c.Request.Body != nil && c.Request.Body != http.NoBody {
+			var err error
+			body, err = io.ReadAll(c.Request.Body)
+			if err != nil {
+				if !c.IsAborted() {
+					c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+						"error": gin.H{"code": "INVALID_REQUEST_BODY", "message": "Failed to read request body"},
+					})
+				}
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		violations := operation.Validate(c.Param, c.Request.URL.Query(), c.GetHeader, body)
+		if len(violations) == 0 {
+			c.Next()
+			return
+		}
+
+		if logger.Logger != nil {
+			logger.Logger.WithFields(logrus.Fields{
+				"method":     c.Request.Method,
+				"route":      c.FullPath(),
+				"violations": violations,
+			}).Warn("Request deviates from the documented API contract")
+		}
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": gin.H{
+				"code":       "CONTRACT_VIOLATION",
+				"message":    "Request does not match the documented API contract",
+				"violations": violations,
+			},
+		})
+	}
+}
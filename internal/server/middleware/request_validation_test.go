@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"product-requirements-management/internal/swagger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validator, err := swagger.NewRequestValidator(`{
+		"swagger": "2.0",
+		"paths": {
+			"/api/v1/epics": {
+				"post": {"parameters": [{"name": "epic", "in": "body", "required": true, "schema": {"$ref": "#/definitions/CreateEpic"}}]}
+			}
+		},
+		"definitions": {
+			"CreateEpic": {
+				"type": "object",
+				"required": ["title"],
+				"properties": {"title": {"type": "string", "maxLength": 10}}
+			}
+		}
+	}`)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(RequestValidation(validator))
+	echo := func(c *gin.Context) {
+		var body map[string]interface{}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "VALIDATION_ERROR", "message": err.Error()}})
+			return
+		}
+		c.JSON(http.StatusOK, body)
+	}
+	router.POST("/api/v1/epics", echo)
+	router.POST("/api/v1/undocumented", echo)
+
+	t.Run("valid request reaches the handler with its body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/epics", strings.NewReader(`{"title":"Checkout"}`)))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"title":"Checkout"}`, w.Body.String())
+	})
+
+	t.Run("request deviating from the contract", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/epics", strings.NewReader(`{"title":"A very long title","owner":"alice"}`)))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response struct {
+			Error struct {
+				Code       string              `json:"code"`
+				Violations []swagger.Violation `json:"violations"`
+			} `json:"error"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "CONTRACT_VIOLATION", response.Error.Code)
+		assert.ElementsMatch(t, []swagger.Violation{
+			{Location: "body", Field: "title", Message: "must be at most 10 characters"},
+			{Location: "body", Field: "owner", Message: "is not a documented field"},
+		}, response.Error.Violations)
+	})
+
+	t.Run("undocumented route passes through", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/undocumented", strings.NewReader(`{"owner":"alice"}`)))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"product-requirements-management/internal/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SecurityHeaders returns a gin.HandlerFunc that sets standard security response headers.
+// Requests under one of docsBasePaths, such as the Swagger UI and the documentation site, get the
+// Swagger content security policy instead of the API one.
+func SecurityHeaders(cfg config.SecurityHeadersConfig, docsBasePaths ...string) gin.HandlerFunc {
+	hsts := ""
+	if cfg.HSTSEnabled {
+		hsts = fmt.Sprintf("max-age=%d", cfg.HSTSMaxAgeSeconds)
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(c *gin.Context) {
+		c.Header("X-Content-Type-Options", "nosniff")
+		if cfg.FrameOptions != "" {
+			c.Header("X-Frame-Options", cfg.FrameOptions)
+		}
+		if cfg.ReferrerPolicy != "" {
+			c.Header("Referrer-Policy", cfg.ReferrerPolicy)
+		}
+		if hsts != "" {
+			c.Header("Strict-Transport-Security", hsts)
+		}
+
+		csp := cfg.ContentSecurityPolicy
+		for _, basePath := range docsBasePaths {
+			if basePath != "" && strings.HasPrefix(c.Request.URL.Path, basePath+"/") {
+				csp = cfg.SwaggerCSP
+				break
+			}
+		}
+		if csp != "" {
+			c.Header("Content-Security-Policy", csp)
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"product-requirements-management/internal/config"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := config.SecurityHeadersConfig{
+		HSTSEnabled:           true,
+		HSTSMaxAgeSeconds:     31536000,
+		HSTSIncludeSubdomains: true,
+		ContentSecurityPolicy: "default-src 'none'",
+		SwaggerCSP:            "default-src 'self'; script-src 'self' 'unsafe-inline'",
+		FrameOptions:          "DENY",
+		ReferrerPolicy:        "no-referrer",
+	}
+
+	router := gin.New()
+	router.Use(SecurityHeaders(cfg, "/swagger", "/docs/site"))
+	router.GET("/api/v1/epics", func(c *gin.Context) { c.JSON(200, gin.H{}) })
+	router.GET("/swagger/*any", func(c *gin.Context) { c.String(200, "ui") })
+	router.GET("/docs/site/*filepath", func(c *gin.Context) { c.String(200, "site") })
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/epics", nil))
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "no-referrer", w.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "max-age=31536000; includeSubDomains", w.Header().Get("Strict-Transport-Security"))
+	assert.Equal(t, "default-src 'none'", w.Header().Get("Content-Security-Policy"))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/swagger/index.html", nil))
+	assert.Equal(t, cfg.SwaggerCSP, w.Header().Get("Content-Security-Policy"))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/docs/site/1.0.0/index.html", nil))
+	assert.Equal(t, cfg.SwaggerCSP, w.Header().Get("Content-Security-Policy"))
+
+	// HSTS is off outside production by default
+	cfg.HSTSEnabled = false
+	router = gin.New()
+	router.Use(SecurityHeaders(cfg, "/swagger"))
+	router.GET("/api/v1/epics", func(c *gin.Context) { c.JSON(200, gin.H{}) })
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/epics", nil))
+	assert.Empty(t, w.Header().Get("Strict-Transport-Security"))
+}
+
+func TestCORSWithConfig_Credentials(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(CORSWithConfig(config.CORSConfig{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		AllowCredentials: false,
+		MaxAgeSeconds:    600,
+	}))
+	router.GET("/test", func(c *gin.Context) { c.JSON(200, gin.H{}) })
+
+	req := httptest.NewRequest("OPTIONS", "/test", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, 204, w.Code)
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(t, "GET, POST", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Authorization, Content-Type", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+}
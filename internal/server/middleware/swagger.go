@@ -36,6 +36,11 @@ func SetupSwaggerRoutes(router *gin.Engine, cfg *config.Config) {
 		if swaggerCfg.Enabled {
 			logger.Logger.Infof("Setting up Swagger documentation for environment: %s", environment)
 			logger.Logger.Infof("Swagger UI will be available at: %s/index.html", swaggerCfg.BasePath)
+			if swaggerCfg.SiteAvailable() {
+				logger.Logger.Infof("Documentation site will be available at: %s/", swagger.SitePath)
+			} else {
+				logger.Logger.Infof("Documentation site not found in %s; run make docs-generate-site to build it", swaggerCfg.SiteDir)
+			}
 
 			// Log security settings
 			if swaggerCfg.SecurityConfig.RequireAuth {
@@ -0,0 +1,368 @@
+package routes
+
+import (
+	"net/http"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/models"
+)
+
+// routePolicies declares who may call each route registered in Setup, following the permission matrix
+// in docs/security-guide.md: any authenticated user can view entities and comment, Users and
+// Administrators can change entities, and only Administrators manage users, prompts and configuration.
+// The policies are enforced by auth.Service.Authorize and published in the Swagger documentation.
+func routePolicies() *auth.RoutePolicies {
+	const (
+		commenter = models.RoleCommenter
+		user      = models.RoleUser
+		admin     = models.RoleAdministrator
+	)
+
+	p := auth.NewRoutePolicies()
+
+	// Health, key discovery and session routes
+	p.Public(http.MethodGet, "/ready")
+	p.Public(http.MethodGet, "/live")
+	p.Public(http.MethodGet, "/status")
+	p.Public(http.MethodGet, "/.well-known/jwks.json")
+	p.Public(http.MethodPost, "/auth/login")
+	p.Public(http.MethodPost, "/auth/refresh")
+	p.Public(http.MethodPost, "/auth/logout")
+	p.Public(http.MethodPost, "/auth/invitations/accept")
+	p.RequireWithPAT(http.MethodGet, "/auth/profile", commenter)
+	p.Require(http.MethodPost, "/auth/change-password", commenter)
+
+	// User management
+	p.Require(http.MethodPost, "/auth/users", admin)
+	p.Require(http.MethodPost, "/auth/users/import", admin)
+	p.Require(http.MethodGet, "/auth/users", admin)
+	p.Require(http.MethodGet, "/auth/users/:id", admin)
+	p.Require(http.MethodPut, "/auth/users/:id", admin)
+	p.Require(http.MethodDelete, "/auth/users/:id", admin)
+	p.Require(http.MethodPost, "/auth/impersonate/:user_id", admin)
+
+	// Personal access tokens and MCP
+	p.Require(http.MethodPost, "/api/v1/pats", user)
+	p.Require(http.MethodGet, "/api/v1/pats", user)
+	p.Require(http.MethodDelete, "/api/v1/pats/:id", user)
+	p.RequireWithPAT(http.MethodPost, "/api/v1/mcp", commenter)
+
+	// Search and navigation
+	p.Require(http.MethodGet, "/api/v1/search", commenter)
+	p.Require(http.MethodGet, "/api/v1/search/suggestions", commenter)
+	p.Require(http.MethodPost, "/api/v1/resolve", commenter)
+	p.Require(http.MethodGet, "/api/v1/meta/enums", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy/epics", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy/epics/:id", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy/user-stories/:id", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy/path/:entity_type/:id", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy/children/:entity_type/:id", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy/outline/:entity_type/:id", commenter)
+	p.Require(http.MethodGet, "/api/v1/hierarchy/progress/:entity_type/:id", commenter)
+
+	// Epics
+	p.Require(http.MethodGet, "/api/v1/epics/:id/user-stories", commenter)
+	p.Require(http.MethodPost, "/api/v1/epics/:id/user-stories", user)
+	p.Require(http.MethodGet, "/api/v1/epics/:id/steering-documents", commenter)
+	p.Require(http.MethodPost, "/api/v1/epics/:id/steering-documents/:doc_id", user)
+	p.Require(http.MethodDelete, "/api/v1/epics/:id/steering-documents/:doc_id", user)
+	p.Require(http.MethodGet, "/api/v1/epics/duplicates", commenter)
+	p.Require(http.MethodPost, "/api/v1/epics/merge", user)
+	p.Require(http.MethodPost, "/api/v1/epics/:id/decompose", user)
+	p.Require(http.MethodPost, "/api/v1/epics/:id/decompose/accept", user)
+	p.Require(http.MethodPut, "/api/v1/epics/:id/parent", user)
+
+	// Requirements freeze of epics
+	p.Require(http.MethodPost, "/api/v1/epics/:id/freeze", admin)
+	p.Require(http.MethodDelete, "/api/v1/epics/:id/freeze", admin)
+
+	// Archival of epics and user stories
+	for _, base := range []string{"/api/v1/epics", "/api/v1/user-stories"} {
+		p.Require(http.MethodPost, base+"/:id/archive", user)
+		p.Require(http.MethodPost, base+"/:id/unarchive", user)
+	}
+
+	// User stories
+	p.Require(http.MethodGet, "/api/v1/user-stories/:id/acceptance-criteria", commenter)
+	p.Require(http.MethodPost, "/api/v1/user-stories/:id/acceptance-criteria", user)
+	p.Require(http.MethodGet, "/api/v1/user-stories/:id/requirements", commenter)
+	p.Require(http.MethodPost, "/api/v1/user-stories/:id/requirements", user)
+
+	// Requirements and relationships
+	p.Require(http.MethodGet, "/api/v1/requirements/search", commenter)
+	p.Require(http.MethodGet, "/api/v1/requirements/:id/relationships", commenter)
+	p.Require(http.MethodGet, "/api/v1/requirements/:id/acceptance-criteria", commenter)
+	p.Require(http.MethodPost, "/api/v1/requirements/:id/acceptance-criteria", user)
+	p.Require(http.MethodDelete, "/api/v1/requirements/:id/acceptance-criteria/:ac_id", user)
+	p.Require(http.MethodPost, "/api/v1/requirements/relationships", user)
+	p.Require(http.MethodDelete, "/api/v1/requirement-relationships/:id", user)
+	p.Require(http.MethodPost, "/api/v1/entity-relationships", user)
+	p.Require(http.MethodDelete, "/api/v1/entity-relationships/:id", user)
+	p.Require(http.MethodGet, "/api/v1/epics/:id/relationships/export", commenter)
+	p.Require(http.MethodGet, "/api/v1/requirements/:id/relationships/export", commenter)
+	p.Require(http.MethodGet, "/api/v1/epics/:id/export", commenter)
+	p.Require(http.MethodGet, "/api/v1/user-stories/:id/export", commenter)
+	p.Require(http.MethodPost, "/api/v1/import/markdown/preview", user)
+	p.Require(http.MethodPost, "/api/v1/import/markdown", user)
+	p.Require(http.MethodPost, "/api/v1/import/bundle", user)
+	p.Require(http.MethodGet, "/api/v1/requirements/:id/supersession", commenter)
+	p.Require(http.MethodPut, "/api/v1/requirements/:id/supersession", user)
+	p.Require(http.MethodPost, "/api/v1/requirements/:id/split", user)
+	p.Require(http.MethodGet, "/api/v1/epics/:id/coverage", commenter)
+	p.Require(http.MethodPost, "/api/v1/test-results/import", user)
+	p.Require(http.MethodGet, "/api/v1/test-runs", commenter)
+	p.Require(http.MethodGet, "/api/v1/test-runs/:id", commenter)
+	p.Require(http.MethodGet, "/api/v1/acceptance-criteria/:id/verification", commenter)
+	p.Require(http.MethodGet, "/api/v1/requirements/:id/verification", commenter)
+
+	// Routes shared by epics, user stories, acceptance criteria and requirements
+	for _, base := range []string{"/api/v1/epics", "/api/v1/user-stories", "/api/v1/acceptance-criteria", "/api/v1/requirements"} {
+		// CRUD and deletion
+		p.Require(http.MethodPost, base, user)
+		p.Require(http.MethodGet, base, commenter)
+		p.Require(http.MethodGet, base+"/:id", commenter)
+		p.Require(http.MethodPut, base+"/:id", user)
+		p.Require(http.MethodDelete, base+"/:id", user)
+		p.Require(http.MethodGet, base+"/:id/validate-deletion", user)
+		p.Require(http.MethodGet, base+"/:id/deletion-impact", user)
+		p.Require(http.MethodDelete, base+"/:id/delete", user)
+		if base != "/api/v1/acceptance-criteria" {
+			p.Require(http.MethodPatch, base+"/:id/status", user)
+			p.Require(http.MethodPatch, base+"/:id/assign", user)
+		}
+
+		// Comments
+		p.Require(http.MethodGet, base+"/:id/comments", commenter)
+		p.Require(http.MethodPost, base+"/:id/comments", commenter)
+		p.Require(http.MethodPost, base+"/:id/comments/inline", commenter)
+		p.Require(http.MethodGet, base+"/:id/comments/inline/visible", commenter)
+		p.Require(http.MethodPost, base+"/:id/comments/inline/validate", commenter)
+		p.Require(http.MethodPost, base+"/:id/comments/summarize", commenter)
+		p.Require(http.MethodGet, base+"/:id/comments/lock", commenter)
+		p.Require(http.MethodPost, base+"/:id/comments/lock", user)
+		p.Require(http.MethodDelete, base+"/:id/comments/lock", user)
+		p.Require(http.MethodPut, base+"/:id/comments/draft", commenter)
+		p.Require(http.MethodGet, base+"/:id/comments/draft", commenter)
+		p.Require(http.MethodDelete, base+"/:id/comments/draft", commenter)
+
+		// Presence is shown to viewers; edit locks and drafts are for editors
+		p.Require(http.MethodPost, base+"/:id/presence", commenter)
+		p.Require(http.MethodGet, base+"/:id/presence", commenter)
+		p.Require(http.MethodDelete, base+"/:id/presence", commenter)
+		p.Require(http.MethodPost, base+"/:id/lock", user)
+		p.Require(http.MethodDelete, base+"/:id/lock", user)
+		p.Require(http.MethodPut, base+"/:id/draft", user)
+		p.Require(http.MethodGet, base+"/:id/draft", user)
+		p.Require(http.MethodDelete, base+"/:id/draft", user)
+		p.Require(http.MethodPost, base+"/:id/draft/publish", user)
+
+		// Translations are read with the entity and written by editors
+		p.Require(http.MethodGet, base+"/:id/translations", commenter)
+		p.Require(http.MethodPut, base+"/:id/translations/:locale", user)
+		p.Require(http.MethodGet, base+"/:id/translations/:locale", commenter)
+		p.Require(http.MethodDelete, base+"/:id/translations/:locale", user)
+
+		// Change proposals are made by commenters and reviewed by editors
+		p.Require(http.MethodPost, base+"/:id/proposals", commenter)
+		p.Require(http.MethodGet, base+"/:id/proposals", commenter)
+		p.Require(http.MethodPost, base+"/:id/proposals/:proposal_id/accept", user)
+		p.Require(http.MethodPost, base+"/:id/proposals/:proposal_id/reject", user)
+		p.Require(http.MethodDelete, base+"/:id/proposals/:proposal_id", commenter)
+
+		// History and activity
+		p.Require(http.MethodGet, base+"/:id/versions", commenter)
+		p.Require(http.MethodGet, base+"/:id/diff", commenter)
+		p.Require(http.MethodGet, base+"/:id/activity", commenter)
+		p.Require(http.MethodPost, base+"/:id/viewed", commenter)
+		p.Require(http.MethodPost, base+"/:id/favorite", commenter)
+		p.Require(http.MethodDelete, base+"/:id/favorite", commenter)
+		p.Require(http.MethodGet, base+"/:id/entity-relationships", commenter)
+	}
+	p.Require(http.MethodPost, "/api/v1/acceptance-criteria/:id/lint", commenter)
+	p.Require(http.MethodGet, "/api/v1/deletion/confirm", user)
+	p.Require(http.MethodGet, "/api/v1/drafts", user)
+
+	// Assignee suggestions support triage by those who can assign
+	for _, base := range []string{"/api/v1/epics", "/api/v1/user-stories", "/api/v1/requirements"} {
+		p.Require(http.MethodGet, base+"/:id/assignee-suggestions", user)
+	}
+
+	// Sign-offs are made by designated stakeholders, whatever their role, and read for audits
+	for _, base := range []string{"/api/v1/user-stories", "/api/v1/requirements"} {
+		p.Require(http.MethodPost, base+"/:id/sign-offs", commenter)
+		p.Require(http.MethodGet, base+"/:id/sign-offs", commenter)
+	}
+	p.Require(http.MethodGet, "/api/v1/sign-offs", commenter)
+
+	// Steering documents
+	p.Require(http.MethodPost, "/api/v1/steering-documents", user)
+	p.Require(http.MethodGet, "/api/v1/steering-documents", commenter)
+	p.Require(http.MethodGet, "/api/v1/steering-documents/:id", commenter)
+	p.Require(http.MethodPut, "/api/v1/steering-documents/:id", user)
+	p.Require(http.MethodDelete, "/api/v1/steering-documents/:id", user)
+
+	// Prompts
+	p.Require(http.MethodGet, "/api/v1/prompts", commenter)
+	p.Require(http.MethodGet, "/api/v1/prompts/active", commenter)
+	p.Require(http.MethodGet, "/api/v1/prompts/:id", commenter)
+	p.Require(http.MethodPost, "/api/v1/prompts", admin)
+	p.Require(http.MethodPut, "/api/v1/prompts/:id", admin)
+	p.Require(http.MethodDelete, "/api/v1/prompts/:id", admin)
+	p.Require(http.MethodPatch, "/api/v1/prompts/:id/activate", admin)
+
+	// Administration
+	p.Require(http.MethodGet, "/api/v1/admin/statistics", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/mcp-usage", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/llm-usage", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/llm-usage/calls", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/comment-moderation", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/comment-moderation/:id/approve", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/comment-moderation/:id/reject", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/usage", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/quotas", admin)
+	p.Require(http.MethodPut, "/api/v1/admin/quotas/roles/:role", admin)
+	p.Require(http.MethodPut, "/api/v1/admin/quotas/users/:id", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/quotas/users/:id", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/slow-queries", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/slow-queries", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/search-index/health", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/search-index/reindex", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/search-index/reindex", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/hierarchy-cache/check", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/hierarchy-cache/rebuild", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/assignment-rules", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/assignment-rules", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/assignment-rules/evaluate", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/assignment-rules/:id", admin)
+	p.Require(http.MethodPut, "/api/v1/admin/assignment-rules/:id", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/assignment-rules/:id", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/staleness-policies", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/staleness-policies", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/staleness-policies/run", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/staleness-policies/:id", admin)
+	p.Require(http.MethodPut, "/api/v1/admin/staleness-policies/:id", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/staleness-policies/:id", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/spelling-report/run", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/reference-redirects", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/reference-redirects", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/reference-redirects/:reference_id", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/sign-off-stakeholders", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/sign-off-stakeholders", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/sign-off-stakeholders/:id", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/incidents", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/incidents", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/incidents/:id", admin)
+	p.Require(http.MethodPut, "/api/v1/admin/incidents/:id", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/incidents/:id", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/incidents/:id/updates", admin)
+	p.Require(http.MethodPost, "/api/v1/admin/maintenance-windows", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/maintenance-windows", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/maintenance-windows/:id", admin)
+	p.Require(http.MethodPut, "/api/v1/admin/maintenance-windows/:id", admin)
+	p.Require(http.MethodDelete, "/api/v1/admin/maintenance-windows/:id", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/impersonation-sessions", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/impersonation-sessions/:id", admin)
+	p.Require(http.MethodGet, "/api/v1/admin/impersonation-sessions/:id/requests", admin)
+
+	// Configuration
+	for _, base := range []string{"/api/v1/config/requirement-types", "/api/v1/config/relationship-types", "/api/v1/config/status-models"} {
+		p.Require(http.MethodPost, base, admin)
+		p.Require(http.MethodGet, base, admin)
+		p.Require(http.MethodGet, base+"/:id", admin)
+		p.Require(http.MethodPut, base+"/:id", admin)
+		p.Require(http.MethodDelete, base+"/:id", admin)
+	}
+	p.Require(http.MethodGet, "/api/v1/config/status-models/default/:entity_type", admin)
+	p.Require(http.MethodGet, "/api/v1/config/status-models/:id/statuses", admin)
+	p.Require(http.MethodGet, "/api/v1/config/status-models/:id/transitions", admin)
+	p.Require(http.MethodPost, "/api/v1/config/status-models/:id/statuses", admin)
+	p.Require(http.MethodPut, "/api/v1/config/status-models/:id/statuses/order", admin)
+	p.Require(http.MethodPut, "/api/v1/config/status-models/:id/statuses/:status_id", admin)
+	p.Require(http.MethodDelete, "/api/v1/config/status-models/:id/statuses/:status_id", admin)
+	p.Require(http.MethodPost, "/api/v1/config/status-models/:id/transitions", admin)
+	p.Require(http.MethodPut, "/api/v1/config/status-models/:id/transitions/:transition_id", admin)
+	p.Require(http.MethodDelete, "/api/v1/config/status-models/:id/transitions/:transition_id", admin)
+	for _, base := range []string{"/api/v1/config/statuses", "/api/v1/config/status-transitions"} {
+		p.Require(http.MethodPost, base, admin)
+		p.Require(http.MethodGet, base+"/:id", admin)
+		p.Require(http.MethodPut, base+"/:id", admin)
+		p.Require(http.MethodDelete, base+"/:id", admin)
+	}
+
+	// Comments
+	p.Require(http.MethodGet, "/api/v1/comments", commenter)
+	p.Require(http.MethodGet, "/api/v1/comments/moderation", commenter)
+	p.Require(http.MethodPost, "/api/v1/comments/moderation/:id/appeal", commenter)
+	p.Require(http.MethodGet, "/api/v1/comments/:id", commenter)
+	p.Require(http.MethodPut, "/api/v1/comments/:id", commenter)
+	p.Require(http.MethodDelete, "/api/v1/comments/:id", commenter)
+	p.Require(http.MethodPost, "/api/v1/comments/:id/resolve", commenter)
+	p.Require(http.MethodPost, "/api/v1/comments/:id/unresolve", commenter)
+	p.Require(http.MethodGet, "/api/v1/comments/status/:status", commenter)
+	p.Require(http.MethodGet, "/api/v1/comments/:id/replies", commenter)
+	p.Require(http.MethodPost, "/api/v1/comments/:id/replies", commenter)
+
+	// Personal activity, recent items, favorites, comment drafts, digest settings and client preferences
+	p.Require(http.MethodGet, "/api/v1/users/me/activity", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/recent", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/favorites", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/comment-drafts", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/digest", commenter)
+	p.Require(http.MethodPut, "/api/v1/users/me/digest", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/preferences", commenter)
+	p.Require(http.MethodPut, "/api/v1/users/me/preferences", commenter)
+	p.Public(http.MethodGet, "/api/v1/digest/unsubscribe")
+
+	// Kanban boards
+	p.Require(http.MethodGet, "/api/v1/boards/:entityType", commenter)
+	p.Require(http.MethodPost, "/api/v1/boards/move", user)
+
+	// Calendar feeds
+	p.Require(http.MethodGet, "/api/v1/epics/:id/calendar.ics", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/calendar.ics", commenter)
+	p.Require(http.MethodPost, "/api/v1/users/me/calendar-feeds", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/calendar-feeds", commenter)
+	p.Require(http.MethodDelete, "/api/v1/users/me/calendar-feeds/:id", commenter)
+	p.Public(http.MethodGet, "/api/v1/calendar/:token")
+
+	// Guest invitations
+	p.Require(http.MethodGet, "/api/v1/epics/:id/invitations", user)
+	p.Require(http.MethodPost, "/api/v1/epics/:id/invitations", user)
+	p.Require(http.MethodDelete, "/api/v1/epics/:id/invitations/:invitation_id", user)
+
+	// Atom change feeds
+	p.Require(http.MethodGet, "/api/v1/changes.atom", commenter)
+	p.Require(http.MethodGet, "/api/v1/epics/:id/changes.atom", commenter)
+	p.Require(http.MethodPost, "/api/v1/users/me/change-feeds", commenter)
+	p.Require(http.MethodGet, "/api/v1/users/me/change-feeds", commenter)
+	p.Require(http.MethodDelete, "/api/v1/users/me/change-feeds/:id", commenter)
+	p.Public(http.MethodGet, "/api/v1/feeds/:token")
+
+	// Glossary
+	p.Require(http.MethodPost, "/api/v1/glossary/terms", user)
+	p.Require(http.MethodGet, "/api/v1/glossary/terms", commenter)
+	p.Require(http.MethodGet, "/api/v1/glossary/terms/:id", commenter)
+	p.Require(http.MethodPut, "/api/v1/glossary/terms/:id", user)
+	p.Require(http.MethodDelete, "/api/v1/glossary/terms/:id", user)
+	p.Require(http.MethodGet, "/api/v1/glossary/undefined-terms", commenter)
+
+	// Portfolio
+	p.Require(http.MethodGet, "/api/v1/portfolio", commenter)
+
+	// Reports and scheduled report delivery
+	p.Require(http.MethodGet, "/api/v1/reports", commenter)
+	p.Require(http.MethodGet, "/api/v1/reports/stale", commenter)
+	p.Require(http.MethodGet, "/api/v1/reports/spelling", commenter)
+	p.Require(http.MethodGet, "/api/v1/reports/requirement-risk", commenter)
+	p.Require(http.MethodGet, "/api/v1/reports/translation-completeness", commenter)
+	p.Require(http.MethodGet, "/api/v1/reports/unsigned-requirements", commenter)
+	p.Require(http.MethodPost, "/api/v1/reports/:id/schedules", user)
+	p.Require(http.MethodGet, "/api/v1/reports/:id/schedules", user)
+	p.Require(http.MethodGet, "/api/v1/reports/:id/schedules/:schedule_id", user)
+	p.Require(http.MethodPut, "/api/v1/reports/:id/schedules/:schedule_id", user)
+	p.Require(http.MethodDelete, "/api/v1/reports/:id/schedules/:schedule_id", user)
+	p.Require(http.MethodPost, "/api/v1/reports/:id/schedules/:schedule_id/run", user)
+
+	return p
+}
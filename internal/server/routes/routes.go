@@ -2,30 +2,82 @@ package routes
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"product-requirements-management/internal/ai"
 	"product-requirements-management/internal/auth"
 	"product-requirements-management/internal/config"
 	"product-requirements-management/internal/database"
+	"product-requirements-management/internal/deprecation"
 	"product-requirements-management/internal/handlers"
 	"product-requirements-management/internal/logger"
+	"product-requirements-management/internal/mcp/tools"
 	"product-requirements-management/internal/repository"
 	"product-requirements-management/internal/server/middleware"
 	"product-requirements-management/internal/service"
+	"product-requirements-management/internal/swagger"
+	"product-requirements-management/internal/validation"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+// Option changes how Setup builds the application
+type Option func(*setupOptions)
+
+// setupOptions holds the choices made by the options passed to Setup
+type setupOptions struct {
+	sandboxed bool
+	jwtKeys   *auth.KeySet
+	shutdown  *Shutdown
+}
+
+// Shutdown collects the work the application must finish before the server exits, such as sending queued emails
+type Shutdown struct {
+	hooks []func(ctx context.Context) error
+}
+
+// Run finishes the work of the application once the server stops serving requests, until ctx is done
+func (s *Shutdown) Run(ctx context.Context) error {
+	var errs []error
+	for _, hook := range s.hooks {
+		if err := hook(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WithShutdown registers the work to finish when the server shuts down with shutdown; without it, that work is
+// abandoned when the process exits
+func WithShutdown(shutdown *Shutdown) Option {
+	return func(o *setupOptions) {
+		o.shutdown = shutdown
+	}
+}
+
+// Sandboxed builds the application for a throwaway session such as a demo: no background jobs are
+// started, emails are discarded, webhooks are refused and AI features are disabled, so the session's
+// users can neither reach other hosts nor spend the language model budget. Access tokens are signed and
+// verified with the session's own keys instead of the configured ones, so tokens issued in the session
+// are rejected everywhere else.
+func Sandboxed(jwtKeys *auth.KeySet) Option {
+	return func(o *setupOptions) {
+		o.sandboxed = true
+		o.jwtKeys = jwtKeys
+	}
+}
+
 // Setup configures all routes for the application
-func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
+func Setup(router *gin.Engine, cfg *config.Config, db *database.DB, opts ...Option) {
+	var options setupOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	// Setup Swagger documentation routes
 	middleware.SetupSwaggerRoutes(router, cfg)
 
-	// Note: Health check endpoints are handled by the health checker in server.go
-	// Only keeping non-conflicting health endpoints here
-	router.GET("/ready", readinessCheck(db))
-	router.GET("/live", livenessCheck)
-
 	// Initialize repositories
 	repos := repository.NewRepositories(db.Postgres, db.Redis)
 
@@ -41,19 +93,13 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 	}
 
 	// Initialize services
-	epicService := service.NewEpicService(repos.Epic, repos.User)
-	userService := service.NewUserService(repos.User)
-	userStoryService := service.NewUserStoryService(repos.UserStory, repos.Epic, repos.User)
-	acceptanceCriteriaService := service.NewAcceptanceCriteriaService(repos.AcceptanceCriteria, repos.UserStory, repos.User)
-	requirementService := service.NewRequirementService(
-		repos.Requirement,
-		repos.RequirementType,
-		repos.RelationshipType,
-		repos.RequirementRelationship,
-		repos.UserStory,
-		repos.AcceptanceCriteria,
-		repos.User,
-	)
+	entityServices := service.NewEntityServices(repos)
+	entityUnitOfWork := service.NewEntityUnitOfWork(repository.NewUnitOfWork(repos))
+	epicService := entityServices.Epic
+	userService := entityServices.User
+	userStoryService := entityServices.UserStory
+	acceptanceCriteriaService := entityServices.AcceptanceCriteria
+	requirementService := entityServices.Requirement
 	configService := service.NewConfigService(
 		repos.RequirementType,
 		repos.RelationshipType,
@@ -70,10 +116,150 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 		repos.Requirement,
 		repos.RequirementRelationship,
 		repos.Comment,
+		repos.EntityRelationship,
 		repos.User,
 		logger.Logger,
 	)
-	commentService := service.NewCommentService(repos)
+
+	// Initialize the mailer, the language model client of AI features and comment moderation
+	var mailer service.Mailer
+	webhookClient := service.NewWebhookClient(30 * time.Second)
+	switch {
+	case options.sandboxed:
+		mailer = service.NewDiscardMailer()
+		webhookClient = service.NewDisabledWebhookClient(30 * time.Second)
+	case cfg.SMTP.Host != "":
+		mailer = service.NewSMTPMailer(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.From)
+	default:
+		mailer = service.NewLogMailer(logger.Logger)
+	}
+	llmUsageService := service.NewLLMUsageService(repos, service.LLMUsageSettings{
+		InputPricePerMTokens:  cfg.LLM.InputPricePerMTokens,
+		OutputPricePerMTokens: cfg.LLM.OutputPricePerMTokens,
+		MonthlyBudget:         cfg.LLM.MonthlyBudget,
+		BudgetAlertPercent:    cfg.LLM.BudgetAlertPercent,
+		LogContent:            cfg.LLM.LogContent,
+	}, mailer, logger.Logger)
+	var llmClient service.LLMClient
+	if !options.sandboxed {
+		llmClient = newLLMClient(cfg.LLM, llmUsageService)
+	}
+	commentService := service.NewCommentService(repos, newCommentModerator(cfg.Moderation, llmClient))
+	commentModerationService := service.NewCommentModerationService(repos)
+	presenceService := service.NewPresenceService(repos)
+	draftService := service.NewDraftService(
+		repos,
+		epicService,
+		userStoryService,
+		acceptanceCriteriaService,
+		requirementService,
+		commentService,
+		presenceService,
+	)
+	changeProposalService := service.NewChangeProposalService(
+		repos,
+		epicService,
+		userStoryService,
+		acceptanceCriteriaService,
+		requirementService,
+		commentService,
+		presenceService,
+	)
+	epicMergeService := service.NewEpicMergeService(repos, presenceService)
+	archiveService := service.NewArchiveService(repos, presenceService)
+	epicFreezeService := service.NewEpicFreezeService(repos)
+	epicParentService := service.NewEpicParentService(repos)
+	entityVersionService := service.NewEntityVersionService(repos)
+	activityService := service.NewActivityService(repos)
+
+	// Initialize activity digest service and start its scheduler
+	digestService := service.NewDigestService(repos, mailer, cfg.Digest.BaseURL, logger.Logger)
+	glossaryService := service.NewGlossaryService(repos)
+	assignmentRuleService := service.NewAssignmentRuleService(repos)
+	assigneeSuggestionService := service.NewAssigneeSuggestionService(repos)
+	stalenessService := service.NewStalenessService(repos, mailer, logger.Logger)
+	hierarchyCacheService := service.NewHierarchyCacheService(repos)
+	var dictionary []string
+	if cfg.Spelling.DictionaryFile != "" {
+		words, err := service.LoadSpellingDictionary(cfg.Spelling.DictionaryFile)
+		if err != nil {
+			logger.Logger.WithError(err).Error("Failed to load spelling dictionary, only terminology will be checked")
+		}
+		dictionary = words
+	}
+	spellingService := service.NewSpellingService(repos, dictionary)
+	translationService := service.NewTranslationService(repos, cfg.Localization.ContentLocale, cfg.Localization.TranslationLocales)
+	signOffService := service.NewSignOffService(repos)
+	statusPageService := service.NewStatusPageService(repos, statusComponents(db))
+	impersonationService := service.NewImpersonationService(repos)
+	reportCatalog := service.NewReportCatalog(stalenessService, glossaryService, spellingService, translationService, signOffService)
+	reportScheduleService := service.NewReportScheduleService(repos, reportCatalog, mailer, webhookClient, logger.Logger)
+	recentViewService := service.NewRecentViewService(repos)
+	favoriteService := service.NewFavoriteService(repos)
+	userPreferenceService := service.NewUserPreferenceService(repos)
+	boardService := service.NewBoardService(repos, epicService, userStoryService, requirementService)
+	calendarService := service.NewCalendarService(repos, cfg.Calendar.BaseURL)
+	changeFeedService := service.NewChangeFeedService(repos, cfg.Feeds.BaseURL)
+	statisticsService := service.NewStatisticsService(repos)
+	requirementRiskService := service.NewRequirementRiskService(repos)
+	portfolioService := service.NewPortfolioService(repos)
+	mcpUsageService := service.NewMCPUsageService(repos)
+	apiUsageService := service.NewAPIUsageService(repos)
+	referenceService := service.NewReferenceService(repos)
+	referenceRedirectService := service.NewReferenceRedirectService(repos)
+	entityRelationshipService := service.NewEntityRelationshipService(repos)
+	relationshipGraphService := service.NewRelationshipGraphService(repos)
+	epicExportService := service.NewEpicExportService(repos)
+	userStoryExportService := service.NewUserStoryExportService(repos)
+	markdownImportService := service.NewMarkdownImportService(repos, validation.NewEARSLinter())
+	epicBundleImportService := service.NewEpicBundleImportService(repos)
+	testResultService := service.NewTestResultService(repos)
+	supersessionService := service.NewSupersessionService(repos)
+	coverageService := service.NewCoverageService(repos)
+	validationWarningService := service.NewValidationWarningService(repos)
+	entityCountService := service.NewEntityCountService(repos)
+	enumService := service.NewEnumService(repos)
+	acceptanceCriteriaLintService := service.NewAcceptanceCriteriaLintService(repos, validation.NewEARSLinter())
+	guestInvitationService := service.NewGuestInvitationService(repos, mailer, cfg.GuestInvitations.BaseURL, logger.Logger)
+	commentDraftService := service.NewCommentDraftService(repos, time.Duration(cfg.CommentDrafts.TTLDays)*24*time.Hour)
+	// Background jobs and event publishing are shared by the whole server, sandboxed sessions run none
+	if !options.sandboxed {
+		if cfg.Digest.Enabled && cfg.Digest.CheckIntervalMinutes > 0 {
+			interval := time.Duration(cfg.Digest.CheckIntervalMinutes) * time.Minute
+			go service.RunDigestScheduler(context.Background(), digestService, interval, logger.Logger)
+		}
+		if cfg.Staleness.Enabled && cfg.Staleness.CheckIntervalMinutes > 0 {
+			interval := time.Duration(cfg.Staleness.CheckIntervalMinutes) * time.Minute
+			go service.RunStalenessScheduler(context.Background(), stalenessService, interval, logger.Logger)
+		}
+		if cfg.HierarchyCache.Enabled && cfg.HierarchyCache.CheckIntervalMinutes > 0 {
+			interval := time.Duration(cfg.HierarchyCache.CheckIntervalMinutes) * time.Minute
+			go service.RunHierarchyCacheChecker(context.Background(), hierarchyCacheService, interval, cfg.HierarchyCache.Repair, logger.Logger)
+		}
+		if cfg.Spelling.Enabled && cfg.Spelling.CheckIntervalMinutes > 0 {
+			interval := time.Duration(cfg.Spelling.CheckIntervalMinutes) * time.Minute
+			go service.RunSpellingScheduler(context.Background(), spellingService, interval, logger.Logger)
+		}
+		if cfg.Reports.SchedulesEnabled && cfg.Reports.CheckIntervalMinutes > 0 {
+			interval := time.Duration(cfg.Reports.CheckIntervalMinutes) * time.Minute
+			go service.RunReportScheduler(context.Background(), reportScheduleService, interval, logger.Logger)
+		}
+		if cfg.GuestInvitations.CleanupEnabled && cfg.GuestInvitations.CleanupIntervalMinutes > 0 {
+			interval := time.Duration(cfg.GuestInvitations.CleanupIntervalMinutes) * time.Minute
+			go service.RunGuestCleanup(context.Background(), guestInvitationService, interval, logger.Logger)
+		}
+		if cfg.CommentDrafts.CleanupEnabled && cfg.CommentDrafts.CleanupIntervalMinutes > 0 {
+			interval := time.Duration(cfg.CommentDrafts.CleanupIntervalMinutes) * time.Minute
+			go service.RunCommentDraftCleanup(context.Background(), commentDraftService, interval, logger.Logger)
+		}
+
+		// Record entity lifecycle events in the outbox and publish them to the event bus
+		if cfg.Events.Publisher != "" {
+			if err := startEventDispatcher(cfg.Events, db, repos); err != nil {
+				logger.Logger.WithError(err).Error("Failed to start event publishing, entity events will not be published")
+			}
+		}
+	}
 
 	// Initialize search service
 	var searchService *service.SearchService
@@ -99,6 +285,11 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 		)
 	}
 
+	searchService.SetLanguages(cfg.Search.Languages)
+	searchService.SetCounting(cfg.Search.CountMode, cfg.Search.MaxResults, time.Duration(cfg.Search.CountCacheSeconds)*time.Second)
+
+	searchIndexService := service.NewSearchIndexService(repos.SearchIndex, searchService)
+
 	// Initialize navigation service
 	navigationService := service.NewNavigationService(
 		repos.Epic,
@@ -107,6 +298,7 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 		repos.Requirement,
 		repos.RequirementRelationship,
 		repos.User,
+		repos.Hierarchy,
 	)
 
 	// Initialize steering document service
@@ -123,8 +315,17 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 	resourceService := service.SetupResourceServiceForMCPHandler(repos, logger.Logger)
 
 	// Initialize auth service and handlers
-	authService := auth.NewService(cfg.JWT.Secret, 24*time.Hour, repos.RefreshToken) // 24 hours token duration
+	jwtKeys := options.jwtKeys
+	if jwtKeys == nil {
+		var err error
+		if jwtKeys, err = auth.NewKeySetFromConfig(cfg.JWT); err != nil {
+			logger.Logger.WithError(err).Fatal("Failed to load JWT signing keys")
+		}
+	}
+	authService := auth.NewServiceWithKeys(jwtKeys, 24*time.Hour, repos.RefreshToken) // 24 hours token duration
 	authHandler := auth.NewHandlers(authService, db.Postgres)
+	impersonationHandler := handlers.NewImpersonationHandler(authService, impersonationService)
+	guestInvitationHandler := handlers.NewGuestInvitationHandler(authService, guestInvitationService)
 
 	// Initialize PAT service and handler
 	tokenGenerator := service.NewSecureTokenGenerator()
@@ -132,43 +333,156 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 	patService := service.NewPATService(repos.PersonalAccessToken, repos.User, tokenGenerator, hashService)
 	patHandler := handlers.NewPATHandler(patService)
 
+	// Initialize user import service and handler; imported users are told to sign in at the public API base URL.
+	// Account emails are sent in the background, so an import does not wait for the SMTP server, and the queued
+	// emails are sent before the server exits.
+	userImportMailer := mailer
+	if !options.sandboxed {
+		mailQueue := service.NewMailQueue(mailer, service.MaxUserImportRows)
+		go service.RunMailQueue(context.Background(), mailQueue, logger.Logger)
+		if options.shutdown != nil {
+			options.shutdown.hooks = append(options.shutdown.hooks, mailQueue.Drain)
+		}
+		userImportMailer = mailQueue
+	}
+	userImportService := service.NewUserImportService(repos, hashService, userImportMailer, cfg.Digest.BaseURL, logger.Logger)
+	userImportHandler := handlers.NewUserImportHandler(userImportService)
+
 	// Initialize handlers
 	epicHandler := handlers.NewEpicHandler(epicService)
+	epicHandler.SetFavoriteService(favoriteService)
+	epicHandler.SetWarningService(validationWarningService)
+	epicHandler.SetCountService(entityCountService)
+	epicHandler.SetTranslationService(translationService)
 	userStoryHandler := handlers.NewUserStoryHandler(userStoryService)
+	userStoryHandler.SetFavoriteService(favoriteService)
+	userStoryHandler.SetWarningService(validationWarningService)
+	userStoryHandler.SetCountService(entityCountService)
+	userStoryHandler.SetTranslationService(translationService)
 	acceptanceCriteriaHandler := handlers.NewAcceptanceCriteriaHandler(acceptanceCriteriaService, userStoryService)
+	acceptanceCriteriaHandler.SetLintService(acceptanceCriteriaLintService, cfg.Lint.EARSOnSave)
+	acceptanceCriteriaHandler.SetFavoriteService(favoriteService)
+	acceptanceCriteriaHandler.SetWarningService(validationWarningService)
+	acceptanceCriteriaHandler.SetCountService(entityCountService)
+	acceptanceCriteriaHandler.SetTranslationService(translationService)
 	requirementHandler := handlers.NewRequirementHandler(requirementService)
+	requirementHandler.SetGlossaryService(glossaryService)
+	requirementHandler.SetFavoriteService(favoriteService)
+	requirementHandler.SetSupersessionService(supersessionService)
+	requirementHandler.SetWarningService(validationWarningService)
+	requirementHandler.SetCountService(entityCountService)
+	requirementHandler.SetRiskService(requirementRiskService)
+	requirementHandler.SetTranslationService(translationService)
 	configHandler := handlers.NewConfigHandler(configService)
 	deletionHandler := handlers.NewDeletionHandler(deletionService, logger.Logger)
 	commentHandler := handlers.NewCommentHandler(commentService)
+	commentModerationHandler := handlers.NewCommentModerationHandler(commentModerationService)
+	commentDraftHandler := handlers.NewCommentDraftHandler(commentDraftService)
+	commentSummaryHandler := handlers.NewCommentSummaryHandler(service.NewCommentSummaryService(repos, commentService, llmClient))
+	epicDecompositionHandler := handlers.NewEpicDecompositionHandler(service.NewEpicDecompositionService(repos, llmClient, llmModelName(cfg.LLM, service.LLMFeatureEpicDecomposition)))
+	presenceHandler := handlers.NewPresenceHandler(presenceService)
+	draftHandler := handlers.NewDraftHandler(draftService)
+	translationHandler := handlers.NewTranslationHandler(translationService)
+	signOffHandler := handlers.NewSignOffHandler(signOffService)
+	statusPageHandler := handlers.NewStatusPageHandler(statusPageService)
+	changeProposalHandler := handlers.NewChangeProposalHandler(changeProposalService)
+	epicMergeHandler := handlers.NewEpicMergeHandler(epicMergeService)
+	archiveHandler := handlers.NewArchiveHandler(archiveService)
+	epicFreezeHandler := handlers.NewEpicFreezeHandler(epicFreezeService)
+	epicParentHandler := handlers.NewEpicParentHandler(epicParentService)
+	entityVersionHandler := handlers.NewEntityVersionHandler(entityVersionService)
+	activityHandler := handlers.NewActivityHandler(activityService)
+	digestHandler := handlers.NewDigestHandler(digestService)
+	userPreferenceHandler := handlers.NewUserPreferenceHandler(userPreferenceService)
+	glossaryHandler := handlers.NewGlossaryHandler(glossaryService)
+	assignmentRuleHandler := handlers.NewAssignmentRuleHandler(assignmentRuleService)
+	assigneeSuggestionHandler := handlers.NewAssigneeSuggestionHandler(assigneeSuggestionService)
+	stalenessHandler := handlers.NewStalenessHandler(stalenessService)
+	spellingHandler := handlers.NewSpellingHandler(spellingService)
+	requirementRiskHandler := handlers.NewRequirementRiskHandler(requirementRiskService)
+	portfolioHandler := handlers.NewPortfolioHandler(portfolioService)
+	reportHandler := handlers.NewReportHandler(reportCatalog, reportScheduleService, repos.User)
+	recentViewHandler := handlers.NewRecentViewHandler(recentViewService)
+	favoriteHandler := handlers.NewFavoriteHandler(favoriteService)
+	boardHandler := handlers.NewBoardHandler(boardService)
+	metaHandler := handlers.NewMetaHandler(enumService)
+	calendarHandler := handlers.NewCalendarHandler(calendarService)
+	changeFeedHandler := handlers.NewChangeFeedHandler(changeFeedService)
+	statisticsHandler := handlers.NewStatisticsHandler(statisticsService)
+	mcpUsageHandler := handlers.NewMCPUsageHandler(mcpUsageService)
+	llmUsageHandler := handlers.NewLLMUsageHandler(llmUsageService)
+	apiUsageHandler := handlers.NewAPIUsageHandler(apiUsageService)
+	var slowQueryReporter handlers.SlowQueryReporter
+	if db.SlowQueries != nil {
+		slowQueryReporter = db.SlowQueries
+	}
+	slowQueryHandler := handlers.NewSlowQueryHandler(slowQueryReporter)
 	searchHandler := handlers.NewSearchHandler(searchService, logger.Logger)
+	searchIndexHandler := handlers.NewSearchIndexHandler(searchIndexService)
+	hierarchyCacheHandler := handlers.NewHierarchyCacheHandler(hierarchyCacheService)
+	referenceHandler := handlers.NewReferenceHandler(referenceService)
+	referenceRedirectHandler := handlers.NewReferenceRedirectHandler(referenceRedirectService)
+	entityRelationshipHandler := handlers.NewEntityRelationshipHandler(entityRelationshipService)
+	relationshipGraphHandler := handlers.NewRelationshipGraphHandler(relationshipGraphService)
+	epicExportHandler := handlers.NewEpicExportHandler(epicExportService)
+	userStoryExportHandler := handlers.NewUserStoryExportHandler(userStoryExportService)
+	markdownImportHandler := handlers.NewMarkdownImportHandler(markdownImportService)
+	epicBundleImportHandler := handlers.NewEpicBundleImportHandler(epicBundleImportService)
+	testResultHandler := handlers.NewTestResultHandler(testResultService)
+	supersessionHandler := handlers.NewSupersessionHandler(supersessionService)
+	coverageHandler := handlers.NewCoverageHandler(coverageService)
 	navigationHandler := handlers.NewNavigationHandler(navigationService)
 	steeringDocumentHandler := handlers.NewSteeringDocumentHandler(steeringDocumentService, epicService, repos.User)
 	promptHandler := handlers.NewPromptHandler(promptService, logger.Logger)
 	mcpHandler := handlers.NewMCPHandler(epicService, userService, userStoryService, requirementService, acceptanceCriteriaService, searchService, steeringDocumentService, promptService, resourceService, repos.RequirementType)
+	mcpHandler.SetUsageService(mcpUsageService)
+	mcpHandler.SetTransactionRunner(mcpTransactionRunner(entityUnitOfWork))
+
+	// All routes below are authenticated and authorized centrally according to routePolicies;
+	// a route without a policy makes startup fail. Authenticated requests count against the daily API quota of the user,
+	// requests made with impersonation tokens are recorded in the impersonation audit log,
+	// and guest tokens are limited to the epic they were invited to.
+	// Changes to the requirements of frozen epics are rejected for anyone but administrators.
+	// Responses of deprecated routes carry Deprecation, Sunset and Link headers.
+	policies := routePolicies()
+	deprecations := deprecation.Endpoints()
+	registeredBefore := router.Routes()
+	app := router.Group("", middleware.Deprecation(deprecations), auth.AuditImpersonation(impersonationService), authService.Authorize(policies, patService), auth.RestrictGuests(guestInvitationService), auth.EnforceAPIQuota(apiUsageService), epicFreezeHandler.EnforceFreeze())
+
+	// Note: Health check endpoints are handled by the health checker in server.go
+	// Only keeping non-conflicting health endpoints here
+	app.GET("/ready", readinessCheck(db))
+	app.GET("/live", livenessCheck)
+	app.GET("/status", statusPageHandler.GetStatus)
+	app.GET("/.well-known/jwks.json", authHandler.JWKS)
 
 	// Authentication routes (no /api/v1 prefix for auth)
-	authGroup := router.Group("/auth")
+	authGroup := app.Group("/auth")
 	{
 		authGroup.POST("/login", authHandler.Login)
 		authGroup.POST("/refresh", authHandler.RefreshToken)
 		authGroup.POST("/logout", authHandler.Logout)
-		authGroup.GET("/profile", authService.Middleware(), authHandler.GetProfile)
-		authGroup.POST("/change-password", authService.Middleware(), authHandler.ChangePassword)
+		authGroup.GET("/profile", authHandler.GetProfile)
+		authGroup.POST("/change-password", authHandler.ChangePassword)
 
 		// Admin-only user management routes
-		authGroup.POST("/users", authService.Middleware(), authService.RequireAdministrator(), authHandler.CreateUser)
-		authGroup.GET("/users", authService.Middleware(), authService.RequireAdministrator(), authHandler.GetUsers)
-		authGroup.GET("/users/:id", authService.Middleware(), authService.RequireAdministrator(), authHandler.GetUser)
-		authGroup.PUT("/users/:id", authService.Middleware(), authService.RequireAdministrator(), authHandler.UpdateUser)
-		authGroup.DELETE("/users/:id", authService.Middleware(), authService.RequireAdministrator(), authHandler.DeleteUser)
+		authGroup.POST("/users", authHandler.CreateUser)
+		authGroup.POST("/users/import", userImportHandler.ImportUsers)
+		authGroup.GET("/users", authHandler.GetUsers)
+		authGroup.GET("/users/:id", authHandler.GetUser)
+		authGroup.PUT("/users/:id", authHandler.UpdateUser)
+		authGroup.DELETE("/users/:id", authHandler.DeleteUser)
+		authGroup.POST("/impersonate/:user_id", impersonationHandler.Impersonate)
+
+		// Guest reviewers sign in with their invitation token
+		authGroup.POST("/invitations/accept", guestInvitationHandler.AcceptInvitation)
 	}
 
 	// API v1 routes
-	v1 := router.Group("/api/v1")
+	v1 := app.Group("/api/v1")
 	{
 		// Personal Access Token routes
 		pats := v1.Group("/pats")
-		pats.Use(authService.Middleware()) // Support both PAT and JWT authentication
 		// pats.Use(middleware.PATRateLimit()) // Apply rate limiting for PAT endpoints
 		{
 			pats.POST("", patHandler.CreatePAT)       // Create new PAT
@@ -177,34 +491,49 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 		}
 
 		// MCP (Model Context Protocol) routes
-		v1.POST("/mcp", auth.PATMiddleware(authService, patService), mcpHandler.Process)
+		v1.POST("/mcp", mcpHandler.Process)
 
 		// Search routes
-		v1.GET("/search", authService.Middleware(), searchHandler.Search)
-		v1.GET("/search/suggestions", authService.Middleware(), searchHandler.SearchSuggestions)
+		v1.GET("/search", searchHandler.Search)
+		v1.GET("/search/suggestions", searchHandler.SearchSuggestions)
+		v1.POST("/resolve", referenceHandler.Resolve)
+
+		// API metadata routes
+		v1.GET("/meta/enums", metaHandler.GetEnums)
 
 		// Hierarchy and navigation routes
 		hierarchy := v1.Group("/hierarchy")
-		hierarchy.Use(authService.Middleware()) // Add authentication middleware
 		{
 			hierarchy.GET("", navigationHandler.GetHierarchy)
+			hierarchy.GET("/epics", navigationHandler.ListEpicNodes)
 			hierarchy.GET("/epics/:id", navigationHandler.GetEpicHierarchy)
 			hierarchy.GET("/user-stories/:id", navigationHandler.GetUserStoryHierarchy)
 			hierarchy.GET("/path/:entity_type/:id", navigationHandler.GetEntityPath)
+			hierarchy.GET("/children/:entity_type/:id", navigationHandler.ListChildNodes)
+			hierarchy.GET("/outline/:entity_type/:id", navigationHandler.GetOutline)
+			hierarchy.GET("/progress/:entity_type/:id", navigationHandler.GetProgress)
 		}
 		// Epic routes
 		epics := v1.Group("/epics")
-		epics.Use(authService.Middleware()) // Add authentication middleware
 		{
 			epics.POST("", epicHandler.CreateEpic)
 			epics.GET("", epicHandler.ListEpics)
+			epics.GET("/duplicates", epicMergeHandler.FindDuplicates)
+			epics.POST("/merge", epicMergeHandler.MergeEpics)
 			epics.GET("/:id", epicHandler.GetEpic)
-			epics.PUT("/:id", epicHandler.UpdateEpic)
+			epics.PUT("/:id", presenceHandler.EnforceEditLock(), epicHandler.UpdateEpic)
 			epics.DELETE("/:id", epicHandler.DeleteEpic)
 			epics.GET("/:id/user-stories", epicHandler.GetEpicWithUserStories)
 			epics.POST("/:id/user-stories", userStoryHandler.CreateUserStoryInEpic)
 			epics.PATCH("/:id/status", epicHandler.ChangeEpicStatus)
 			epics.PATCH("/:id/assign", epicHandler.AssignEpic)
+			epics.POST("/:id/archive", archiveHandler.Archive)
+			epics.POST("/:id/unarchive", archiveHandler.Unarchive)
+			epics.POST("/:id/freeze", epicFreezeHandler.FreezeEpic)
+			epics.DELETE("/:id/freeze", epicFreezeHandler.UnfreezeEpic)
+			epics.PUT("/:id/parent", epicParentHandler.SetParentEpic)
+			epics.POST("/:id/decompose", epicDecompositionHandler.DecomposeEpic)
+			epics.POST("/:id/decompose/accept", epicDecompositionHandler.AcceptDecomposition)
 			// Comprehensive deletion routes
 			epics.GET("/:id/validate-deletion", deletionHandler.ValidateEpicDeletion)
 			epics.DELETE("/:id/delete", deletionHandler.DeleteEpic)
@@ -212,12 +541,11 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 
 		// User Story routes
 		userStories := v1.Group("/user-stories")
-		userStories.Use(authService.Middleware()) // Add authentication middleware
 		{
 			userStories.POST("", userStoryHandler.CreateUserStory)
 			userStories.GET("", userStoryHandler.ListUserStories)
 			userStories.GET("/:id", userStoryHandler.GetUserStory)
-			userStories.PUT("/:id", userStoryHandler.UpdateUserStory)
+			userStories.PUT("/:id", presenceHandler.EnforceEditLock(), userStoryHandler.UpdateUserStory)
 			userStories.DELETE("/:id", userStoryHandler.DeleteUserStory)
 			userStories.GET("/:id/acceptance-criteria", userStoryHandler.GetUserStoryWithAcceptanceCriteria)
 			userStories.POST("/:id/acceptance-criteria", acceptanceCriteriaHandler.CreateAcceptanceCriteria)
@@ -225,6 +553,8 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 			userStories.POST("/:id/requirements", requirementHandler.CreateRequirement)
 			userStories.PATCH("/:id/status", userStoryHandler.ChangeUserStoryStatus)
 			userStories.PATCH("/:id/assign", userStoryHandler.AssignUserStory)
+			userStories.POST("/:id/archive", archiveHandler.Archive)
+			userStories.POST("/:id/unarchive", archiveHandler.Unarchive)
 			// Comprehensive deletion routes
 			userStories.GET("/:id/validate-deletion", deletionHandler.ValidateUserStoryDeletion)
 			userStories.DELETE("/:id/delete", deletionHandler.DeleteUserStory)
@@ -232,32 +562,35 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 
 		// Acceptance Criteria routes
 		acceptanceCriteria := v1.Group("/acceptance-criteria")
-		acceptanceCriteria.Use(authService.Middleware()) // Add authentication middleware
 		{
 			acceptanceCriteria.POST("", acceptanceCriteriaHandler.CreateAcceptanceCriteria)
 			acceptanceCriteria.GET("", acceptanceCriteriaHandler.ListAcceptanceCriteria)
 			acceptanceCriteria.GET("/:id", acceptanceCriteriaHandler.GetAcceptanceCriteria)
-			acceptanceCriteria.PUT("/:id", acceptanceCriteriaHandler.UpdateAcceptanceCriteria)
+			acceptanceCriteria.PUT("/:id", presenceHandler.EnforceEditLock(), acceptanceCriteriaHandler.UpdateAcceptanceCriteria)
 			acceptanceCriteria.DELETE("/:id", acceptanceCriteriaHandler.DeleteAcceptanceCriteria)
 			// Comprehensive deletion routes
 			acceptanceCriteria.GET("/:id/validate-deletion", deletionHandler.ValidateAcceptanceCriteriaDeletion)
 			acceptanceCriteria.DELETE("/:id/delete", deletionHandler.DeleteAcceptanceCriteria)
+			// EARS linting
+			acceptanceCriteria.POST("/:id/lint", acceptanceCriteriaHandler.LintAcceptanceCriteria)
 		}
 
 		// Requirement routes
 		requirements := v1.Group("/requirements")
-		requirements.Use(authService.Middleware()) // Add authentication middleware
 		{
 			requirements.POST("", requirementHandler.CreateRequirement)
 			requirements.GET("", requirementHandler.ListRequirements)
 			requirements.GET("/search", requirementHandler.SearchRequirements)
 			requirements.GET("/:id", requirementHandler.GetRequirement)
-			requirements.PUT("/:id", requirementHandler.UpdateRequirement)
+			requirements.PUT("/:id", presenceHandler.EnforceEditLock(), requirementHandler.UpdateRequirement)
 			requirements.DELETE("/:id", requirementHandler.DeleteRequirement)
 			requirements.GET("/:id/relationships", requirementHandler.GetRequirementWithRelationships)
 			requirements.PATCH("/:id/status", requirementHandler.ChangeRequirementStatus)
 			requirements.PATCH("/:id/assign", requirementHandler.AssignRequirement)
 			requirements.POST("/relationships", requirementHandler.CreateRelationship)
+			requirements.GET("/:id/acceptance-criteria", requirementHandler.GetLinkedAcceptanceCriteria)
+			requirements.POST("/:id/acceptance-criteria", requirementHandler.LinkAcceptanceCriteria)
+			requirements.DELETE("/:id/acceptance-criteria/:ac_id", requirementHandler.UnlinkAcceptanceCriteria)
 			// Comprehensive deletion routes
 			requirements.GET("/:id/validate-deletion", deletionHandler.ValidateRequirementDeletion)
 			requirements.DELETE("/:id/delete", deletionHandler.DeleteRequirement)
@@ -268,7 +601,6 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 
 		// Steering Document routes
 		steeringDocuments := v1.Group("/steering-documents")
-		steeringDocuments.Use(authService.Middleware()) // Add authentication middleware
 		{
 			steeringDocuments.POST("", steeringDocumentHandler.CreateSteeringDocument)
 			steeringDocuments.GET("", steeringDocumentHandler.ListSteeringDocuments)
@@ -284,7 +616,6 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 
 		// Prompt routes (admin only for CRUD operations)
 		prompts := v1.Group("/prompts")
-		prompts.Use(authService.Middleware()) // Add authentication middleware
 		{
 			// Public read operations (all authenticated users)
 			prompts.GET("", promptHandler.ListPrompts)
@@ -292,15 +623,71 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 			prompts.GET("/:id", promptHandler.GetPrompt)
 
 			// Admin-only operations
-			prompts.POST("", authService.RequireAdministrator(), promptHandler.CreatePrompt)
-			prompts.PUT("/:id", authService.RequireAdministrator(), promptHandler.UpdatePrompt)
-			prompts.DELETE("/:id", authService.RequireAdministrator(), promptHandler.DeletePrompt)
-			prompts.PATCH("/:id/activate", authService.RequireAdministrator(), promptHandler.ActivatePrompt)
+			prompts.POST("", promptHandler.CreatePrompt)
+			prompts.PUT("/:id", promptHandler.UpdatePrompt)
+			prompts.DELETE("/:id", promptHandler.DeletePrompt)
+			prompts.PATCH("/:id/activate", promptHandler.ActivatePrompt)
+		}
+
+		// Administration routes (admin only)
+		admin := v1.Group("/admin")
+		{
+			admin.GET("/statistics", statisticsHandler.GetStatistics)
+			admin.GET("/mcp-usage", mcpUsageHandler.GetUsage)
+			admin.GET("/llm-usage", llmUsageHandler.GetUsage)
+			admin.GET("/llm-usage/calls", llmUsageHandler.ListCalls)
+			admin.GET("/comment-moderation", commentModerationHandler.ListQueue)
+			admin.POST("/comment-moderation/:id/approve", commentModerationHandler.ApproveComment)
+			admin.POST("/comment-moderation/:id/reject", commentModerationHandler.RejectComment)
+			admin.GET("/usage", apiUsageHandler.GetUsage)
+			admin.GET("/quotas", apiUsageHandler.ListQuotas)
+			admin.PUT("/quotas/roles/:role", apiUsageHandler.SetRoleQuota)
+			admin.PUT("/quotas/users/:id", apiUsageHandler.SetUserQuota)
+			admin.DELETE("/quotas/users/:id", apiUsageHandler.DeleteUserQuota)
+			admin.GET("/slow-queries", slowQueryHandler.GetSlowQueries)
+			admin.DELETE("/slow-queries", slowQueryHandler.ResetSlowQueries)
+			admin.GET("/search-index/health", searchIndexHandler.GetHealth)
+			admin.GET("/search-index/reindex", searchIndexHandler.GetReindexStatus)
+			admin.POST("/search-index/reindex", searchIndexHandler.StartReindex)
+			admin.GET("/hierarchy-cache/check", hierarchyCacheHandler.Check)
+			admin.POST("/hierarchy-cache/rebuild", hierarchyCacheHandler.Rebuild)
+			admin.POST("/assignment-rules", assignmentRuleHandler.CreateRule)
+			admin.GET("/assignment-rules", assignmentRuleHandler.ListRules)
+			admin.POST("/assignment-rules/evaluate", assignmentRuleHandler.EvaluateRules)
+			admin.GET("/assignment-rules/:id", assignmentRuleHandler.GetRule)
+			admin.PUT("/assignment-rules/:id", assignmentRuleHandler.UpdateRule)
+			admin.DELETE("/assignment-rules/:id", assignmentRuleHandler.DeleteRule)
+			admin.POST("/staleness-policies", stalenessHandler.CreatePolicy)
+			admin.GET("/staleness-policies", stalenessHandler.ListPolicies)
+			admin.POST("/staleness-policies/run", stalenessHandler.RunPolicies)
+			admin.GET("/staleness-policies/:id", stalenessHandler.GetPolicy)
+			admin.PUT("/staleness-policies/:id", stalenessHandler.UpdatePolicy)
+			admin.DELETE("/staleness-policies/:id", stalenessHandler.DeletePolicy)
+			admin.POST("/spelling-report/run", spellingHandler.RunAnalysis)
+			admin.POST("/reference-redirects", referenceRedirectHandler.CreateRedirect)
+			admin.GET("/reference-redirects", referenceRedirectHandler.ListRedirects)
+			admin.DELETE("/reference-redirects/:reference_id", referenceRedirectHandler.DeleteRedirect)
+			admin.POST("/sign-off-stakeholders", signOffHandler.AddStakeholder)
+			admin.GET("/sign-off-stakeholders", signOffHandler.ListStakeholders)
+			admin.DELETE("/sign-off-stakeholders/:id", signOffHandler.RemoveStakeholder)
+			admin.POST("/incidents", statusPageHandler.CreateIncident)
+			admin.GET("/incidents", statusPageHandler.ListIncidents)
+			admin.GET("/incidents/:id", statusPageHandler.GetIncident)
+			admin.PUT("/incidents/:id", statusPageHandler.UpdateIncident)
+			admin.DELETE("/incidents/:id", statusPageHandler.DeleteIncident)
+			admin.POST("/incidents/:id/updates", statusPageHandler.PostIncidentUpdate)
+			admin.POST("/maintenance-windows", statusPageHandler.CreateMaintenanceWindow)
+			admin.GET("/maintenance-windows", statusPageHandler.ListMaintenanceWindows)
+			admin.GET("/maintenance-windows/:id", statusPageHandler.GetMaintenanceWindow)
+			admin.PUT("/maintenance-windows/:id", statusPageHandler.UpdateMaintenanceWindow)
+			admin.DELETE("/maintenance-windows/:id", statusPageHandler.DeleteMaintenanceWindow)
+			admin.GET("/impersonation-sessions", impersonationHandler.ListSessions)
+			admin.GET("/impersonation-sessions/:id", impersonationHandler.GetSession)
+			admin.GET("/impersonation-sessions/:id/requests", impersonationHandler.ListRequests)
 		}
 
 		// Configuration routes (admin only)
 		config := v1.Group("/config")
-		config.Use(authService.Middleware(), authService.RequireAdministrator()) // Add authentication and admin middleware
 		{
 			// Requirement Type routes
 			requirementTypes := config.Group("/requirement-types")
@@ -332,7 +719,14 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 				statusModels.DELETE("/:id", configHandler.DeleteStatusModel)
 				statusModels.GET("/default/:entity_type", configHandler.GetDefaultStatusModel)
 				statusModels.GET("/:id/statuses", configHandler.ListStatusesByModel)
+				statusModels.POST("/:id/statuses", configHandler.CreateModelStatus)
+				statusModels.PUT("/:id/statuses/order", configHandler.ReorderModelStatuses)
+				statusModels.PUT("/:id/statuses/:status_id", configHandler.UpdateModelStatus)
+				statusModels.DELETE("/:id/statuses/:status_id", configHandler.DeleteModelStatus)
 				statusModels.GET("/:id/transitions", configHandler.ListStatusTransitionsByModel)
+				statusModels.POST("/:id/transitions", configHandler.CreateModelStatusTransition)
+				statusModels.PUT("/:id/transitions/:transition_id", configHandler.UpdateModelStatusTransition)
+				statusModels.DELETE("/:id/transitions/:transition_id", configHandler.DeleteModelStatusTransition)
 			}
 
 			// Status routes
@@ -355,12 +749,14 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 		}
 
 		// General deletion confirmation route
-		v1.GET("/deletion/confirm", authService.Middleware(), deletionHandler.GetDeletionConfirmation)
+		v1.GET("/deletion/confirm", deletionHandler.GetDeletionConfirmation)
 
 		// Comment routes
 		comments := v1.Group("/comments")
-		comments.Use(authService.Middleware()) // Add authentication middleware
 		{
+			comments.GET("", commentHandler.ListComments)
+			comments.GET("/moderation", commentModerationHandler.ListMyModerations)
+			comments.POST("/moderation/:id/appeal", commentModerationHandler.AppealModeration)
 			comments.GET("/:id", commentHandler.GetComment)
 			comments.PUT("/:id", commentHandler.UpdateComment)
 			comments.DELETE("/:id", commentHandler.DeleteComment)
@@ -399,6 +795,318 @@ func Setup(router *gin.Engine, cfg *config.Config, db *database.DB) {
 		requirements.POST("/:id/comments/inline", commentHandler.CreateRequirementInlineComment)
 		requirements.GET("/:id/comments/inline/visible", commentHandler.GetRequirementVisibleInlineComments)
 		requirements.POST("/:id/comments/inline/validate", commentHandler.ValidateRequirementInlineComments)
+
+		// Comment thread locks
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.GET("/:id/comments/lock", commentHandler.GetCommentThreadLock)
+			group.POST("/:id/comments/lock", commentHandler.LockCommentThread)
+			group.DELETE("/:id/comments/lock", commentHandler.UnlockCommentThread)
+		}
+
+		// Autosaved comment drafts
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.PUT("/:id/comments/draft", commentDraftHandler.SaveDraft)
+			group.GET("/:id/comments/draft", commentDraftHandler.GetDraft)
+			group.DELETE("/:id/comments/draft", commentDraftHandler.DiscardDraft)
+		}
+		v1.GET("/users/me/comment-drafts", commentDraftHandler.ListMyDrafts)
+
+		// AI discussion summaries
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.POST("/:id/comments/summarize", commentSummaryHandler.SummarizeComments)
+		}
+
+		// Presence and soft edit lock routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.POST("/:id/presence", presenceHandler.RegisterPresence)
+			group.GET("/:id/presence", presenceHandler.GetPresence)
+			group.DELETE("/:id/presence", presenceHandler.LeavePresence)
+			group.POST("/:id/lock", presenceHandler.AcquireLock)
+			group.DELETE("/:id/lock", presenceHandler.ReleaseLock)
+		}
+
+		// Private draft routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.PUT("/:id/draft", draftHandler.SaveDraft)
+			group.GET("/:id/draft", draftHandler.GetDraft)
+			group.DELETE("/:id/draft", draftHandler.DiscardDraft)
+			group.POST("/:id/draft/publish", draftHandler.PublishDraft)
+		}
+		v1.GET("/drafts", draftHandler.ListMyDrafts)
+
+		// Translation routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.GET("/:id/translations", translationHandler.ListTranslations)
+			group.PUT("/:id/translations/:locale", translationHandler.SaveTranslation)
+			group.GET("/:id/translations/:locale", translationHandler.GetTranslation)
+			group.DELETE("/:id/translations/:locale", translationHandler.DeleteTranslation)
+		}
+
+		// Assignee suggestion routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, requirements} {
+			group.GET("/:id/assignee-suggestions", assigneeSuggestionHandler.SuggestAssignees)
+		}
+
+		// Sign-off routes
+		for _, group := range []*gin.RouterGroup{userStories, requirements} {
+			group.POST("/:id/sign-offs", signOffHandler.SignOff)
+			group.GET("/:id/sign-offs", signOffHandler.ListSignOffs)
+		}
+		v1.GET("/sign-offs", signOffHandler.ListLog)
+
+		// Change proposal routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.POST("/:id/proposals", changeProposalHandler.CreateProposal)
+			group.GET("/:id/proposals", changeProposalHandler.ListProposals)
+			group.POST("/:id/proposals/:proposal_id/accept", changeProposalHandler.AcceptProposal)
+			group.POST("/:id/proposals/:proposal_id/reject", changeProposalHandler.RejectProposal)
+			group.DELETE("/:id/proposals/:proposal_id", changeProposalHandler.WithdrawProposal)
+		}
+
+		// Deletion impact routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.GET("/:id/deletion-impact", deletionHandler.GetDeletionImpact)
+		}
+
+		// Version history and diff routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.GET("/:id/versions", entityVersionHandler.ListVersions)
+			group.GET("/:id/diff", entityVersionHandler.GetDiff)
+		}
+
+		// Activity feed routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.GET("/:id/activity", activityHandler.GetEntityActivity)
+		}
+		v1.GET("/users/me/activity", activityHandler.GetMyActivity)
+
+		// Recently viewed routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.POST("/:id/viewed", recentViewHandler.RecordView)
+		}
+		v1.GET("/users/me/recent", recentViewHandler.GetMyRecent)
+
+		// Favorite routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.POST("/:id/favorite", favoriteHandler.AddFavorite)
+			group.DELETE("/:id/favorite", favoriteHandler.RemoveFavorite)
+		}
+		v1.GET("/users/me/favorites", favoriteHandler.GetMyFavorites)
+
+		// Entity relationship routes
+		for _, group := range []*gin.RouterGroup{epics, userStories, acceptanceCriteria, requirements} {
+			group.GET("/:id/entity-relationships", entityRelationshipHandler.ListLinks)
+		}
+		v1.POST("/entity-relationships", entityRelationshipHandler.CreateLink)
+		v1.DELETE("/entity-relationships/:id", entityRelationshipHandler.DeleteLink)
+
+		// Relationship graph export routes
+		epics.GET("/:id/relationships/export", relationshipGraphHandler.ExportEpicGraph)
+		requirements.GET("/:id/relationships/export", relationshipGraphHandler.ExportRequirementGraph)
+
+		// Epic document and user story feature file export routes
+		epics.GET("/:id/export", epicExportHandler.ExportEpic)
+		userStories.GET("/:id/export", userStoryExportHandler.ExportUserStory)
+
+		// Markdown import routes
+		v1.POST("/import/markdown/preview", markdownImportHandler.PreviewImport)
+		v1.POST("/import/markdown", markdownImportHandler.Import)
+
+		// Epic bundle import routes
+		v1.POST("/import/bundle", epicBundleImportHandler.Import)
+
+		// Supersession and coverage routes
+		requirements.GET("/:id/supersession", supersessionHandler.GetSupersession)
+		requirements.PUT("/:id/supersession", supersessionHandler.SetSupersession)
+		requirements.POST("/:id/split", supersessionHandler.SplitRequirement)
+		epics.GET("/:id/coverage", coverageHandler.GetEpicCoverage)
+
+		// Test result import and verification routes
+		v1.POST("/test-results/import", testResultHandler.ImportResults)
+		v1.GET("/test-runs", testResultHandler.ListRuns)
+		v1.GET("/test-runs/:id", testResultHandler.GetRun)
+		acceptanceCriteria.GET("/:id/verification", testResultHandler.GetVerification)
+		requirements.GET("/:id/verification", testResultHandler.GetVerification)
+
+		// Kanban board routes
+		boards := v1.Group("/boards")
+		{
+			boards.GET("/:entityType", boardHandler.GetBoard)
+			boards.POST("/move", boardHandler.MoveCard)
+		}
+
+		// Calendar feed routes
+		epics.GET("/:id/calendar.ics", calendarHandler.GetEpicCalendar)
+		v1.GET("/users/me/calendar.ics", calendarHandler.GetMyCalendar)
+		v1.POST("/users/me/calendar-feeds", calendarHandler.CreateFeed)
+		v1.GET("/users/me/calendar-feeds", calendarHandler.ListFeeds)
+		v1.DELETE("/users/me/calendar-feeds/:id", calendarHandler.RevokeFeed)
+		v1.GET("/calendar/:token", calendarHandler.GetFeedCalendar)
+
+		// Guest invitation routes
+		epics.GET("/:id/invitations", guestInvitationHandler.ListInvitations)
+		epics.POST("/:id/invitations", guestInvitationHandler.CreateInvitation)
+		epics.DELETE("/:id/invitations/:invitation_id", guestInvitationHandler.RevokeInvitation)
+
+		// Atom change feed routes
+		v1.GET("/changes.atom", changeFeedHandler.GetWorkspaceFeed)
+		epics.GET("/:id/changes.atom", changeFeedHandler.GetEpicFeed)
+		v1.POST("/users/me/change-feeds", changeFeedHandler.CreateFeed)
+		v1.GET("/users/me/change-feeds", changeFeedHandler.ListFeeds)
+		v1.DELETE("/users/me/change-feeds/:id", changeFeedHandler.RevokeFeed)
+		v1.GET("/feeds/:token", changeFeedHandler.GetTokenFeed)
+
+		// Activity digest routes
+		v1.GET("/users/me/digest", digestHandler.GetPreferences)
+		v1.PUT("/users/me/digest", digestHandler.UpdatePreferences)
+		v1.GET("/digest/unsubscribe", digestHandler.Unsubscribe)
+
+		// Client preference routes
+		v1.GET("/users/me/preferences", userPreferenceHandler.GetPreferences)
+		v1.PUT("/users/me/preferences", userPreferenceHandler.UpdatePreferences)
+
+		// Glossary routes
+		glossary := v1.Group("/glossary")
+		{
+			glossary.POST("/terms", glossaryHandler.CreateTerm)
+			glossary.GET("/terms", glossaryHandler.ListTerms)
+			glossary.GET("/terms/:id", glossaryHandler.GetTerm)
+			glossary.PUT("/terms/:id", glossaryHandler.UpdateTerm)
+			glossary.DELETE("/terms/:id", glossaryHandler.DeleteTerm)
+			glossary.GET("/undefined-terms", glossaryHandler.GetUndefinedTermsReport)
+		}
+
+		// Portfolio route
+		v1.GET("/portfolio", portfolioHandler.GetPortfolio)
+
+		// Report routes
+		reports := v1.Group("/reports")
+		{
+			reports.GET("", reportHandler.ListReports)
+			reports.GET("/stale", stalenessHandler.GetStaleReport)
+			reports.GET("/spelling", spellingHandler.GetReport)
+			reports.GET("/requirement-risk", requirementRiskHandler.GetRiskReport)
+			reports.GET("/translation-completeness", translationHandler.GetCompletenessReport)
+			reports.GET("/unsigned-requirements", signOffHandler.GetUnsignedReport)
+			reports.POST("/:id/schedules", reportHandler.CreateSchedule)
+			reports.GET("/:id/schedules", reportHandler.ListSchedules)
+			reports.GET("/:id/schedules/:schedule_id", reportHandler.GetSchedule)
+			reports.PUT("/:id/schedules/:schedule_id", reportHandler.UpdateSchedule)
+			reports.DELETE("/:id/schedules/:schedule_id", reportHandler.DeleteSchedule)
+			reports.POST("/:id/schedules/:schedule_id/run", reportHandler.RunSchedule)
+		}
+	}
+
+	addedRoutes := routesAddedSince(router, registeredBefore)
+	if err := policies.Verify(addedRoutes); err != nil {
+		logger.Logger.WithError(err).Fatal("Route authorization policies are incomplete")
+	}
+	if err := deprecations.Verify(addedRoutes); err != nil {
+		logger.Logger.WithError(err).Fatal("Route deprecations are out of date")
+	}
+	swagger.DocumentRoutePolicies(policies.All())
+}
+
+// routesAddedSince returns the routes registered after the given snapshot
+func routesAddedSince(router *gin.Engine, before gin.RoutesInfo) gin.RoutesInfo {
+	existing := make(map[string]bool, len(before))
+	for _, route := range before {
+		existing[route.Method+" "+route.Path] = true
+	}
+
+	var added gin.RoutesInfo
+	for _, route := range router.Routes() {
+		if !existing[route.Method+" "+route.Path] {
+			added = append(added, route)
+		}
+	}
+	return added
+}
+
+// startEventDispatcher registers the outbox callbacks and starts publishing outbox events in the background
+func startEventDispatcher(cfg config.EventsConfig, db *database.DB, repos *repository.Repositories) error {
+	timeout := time.Duration(cfg.PublishTimeoutMs) * time.Millisecond
+	var publisher service.EventPublisher
+	switch cfg.Publisher {
+	case "kafka":
+		publisher = service.NewKafkaRESTPublisher(cfg.KafkaRESTURL, timeout)
+	case "nats":
+		var err error
+		if publisher, err = service.NewNATSPublisher(cfg.NATSURL, timeout); err != nil {
+			return err
+		}
+	default:
+		publisher = service.NewLogEventPublisher(logger.Logger)
+	}
+
+	if err := repository.RegisterOutboxCallbacks(db.Postgres); err != nil {
+		return err
+	}
+
+	retention := time.Duration(cfg.RetentionDays) * 24 * time.Hour
+	dispatcher := service.NewEventDispatcher(repos.Outbox, publisher, cfg.TopicPrefix, cfg.BatchSize, retention)
+	interval := time.Duration(cfg.PollIntervalMs) * time.Millisecond
+	go service.RunEventDispatcher(context.Background(), dispatcher, interval, logger.Logger)
+
+	logger.Logger.WithField("publisher", cfg.Publisher).Info("Entity event publishing enabled")
+	return nil
+}
+
+// newLLMClient creates the client of the configured language model provider, recording its calls in the
+// usage log; nil disables AI features
+func newLLMClient(cfg config.LLMConfig, usage service.LLMUsageService) service.LLMClient {
+	if cfg.Provider == "" {
+		return nil
+	}
+	provider, err := ai.NewProvider(ai.Config{
+		Provider:     cfg.Provider,
+		BaseURL:      cfg.APIURL,
+		APIKey:       cfg.APIKey,
+		Model:        cfg.Model,
+		MaxTokens:    cfg.MaxTokens,
+		Timeout:      time.Duration(cfg.TimeoutSeconds) * time.Second,
+		MaxRetries:   cfg.MaxRetries,
+		RetryBackoff: time.Duration(cfg.RetryBackoffMs) * time.Millisecond,
+	})
+	if err != nil {
+		logger.Logger.WithError(err).Error("AI features disabled")
+		return nil
+	}
+	return service.NewLLMClient(provider, llmModels(cfg), usage, logger.Logger)
+}
+
+// newCommentModerator creates the configured moderation pipeline of comments: keyword rules first, then the
+// AI classifier; nil disables moderation
+func newCommentModerator(cfg config.ModerationConfig, llmClient service.LLMClient) service.CommentModerator {
+	var keywords, classifier service.CommentModerator
+	if len(cfg.BlockedKeywords) > 0 || len(cfg.FlaggedKeywords) > 0 {
+		keywords = service.NewKeywordModerator(cfg.BlockedKeywords, cfg.FlaggedKeywords)
+	}
+	if cfg.AIClassifier && llmClient != nil {
+		classifier = service.NewAIModerator(llmClient, logger.Logger)
+	}
+	return service.NewModerationPipeline(keywords, classifier)
+}
+
+// llmModels selects the configured model of each AI feature
+func llmModels(cfg config.LLMConfig) service.LLMModels {
+	return service.LLMModels{Default: cfg.Model, Features: cfg.FeatureModels}
+}
+
+// llmModelName identifies the provider and model of a feature in the provenance of AI-generated entities
+func llmModelName(cfg config.LLMConfig, feature string) string {
+	if cfg.Provider == "" {
+		return ""
+	}
+	return cfg.Provider + "/" + llmModels(cfg).For(feature)
+}
+
+// mcpTransactionRunner runs MCP tool batches with entity services bound to one unit of work
+func mcpTransactionRunner(uow service.EntityUnitOfWork) tools.TransactionRunner {
+	return func(ctx context.Context, fn func(context.Context, *tools.Handler) error) error {
+		return uow.Do(ctx, func(ctx context.Context, s *service.EntityServices) error {
+			return fn(ctx, tools.NewHandler(s.Epic, s.User, s.UserStory, s.Requirement, s.AcceptanceCriteria, nil, nil, nil))
+		})
 	}
 }
 
@@ -421,6 +1129,20 @@ func readinessCheck(db *database.DB) gin.HandlerFunc {
 	}
 }
 
+// statusComponents lists the components shown on the public status page. The API is up whenever it answers;
+// the database and the cache are checked.
+func statusComponents(db *database.DB) []service.StatusComponent {
+	return []service.StatusComponent{
+		{ID: "api", Name: "API"},
+		{ID: "database", Name: "Database", Check: func(ctx context.Context) bool {
+			return db.CheckHealth(ctx).PostgreSQL.Status == "healthy"
+		}},
+		{ID: "cache", Name: "Cache", Check: func(ctx context.Context) bool {
+			return db.CheckHealth(ctx).Redis.Status == "healthy"
+		}},
+	}
+}
+
 // livenessCheck indicates if the service is alive
 func livenessCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
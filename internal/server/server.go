@@ -3,17 +3,21 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"product-requirements-management/internal/config"
 	"product-requirements-management/internal/database"
+	grpcapi "product-requirements-management/internal/grpc"
 	"product-requirements-management/internal/logger"
 	"product-requirements-management/internal/observability"
 	"product-requirements-management/internal/observability/health"
 	obsMiddleware "product-requirements-management/internal/observability/middleware"
+	"product-requirements-management/internal/server/demo"
 	"product-requirements-management/internal/server/middleware"
 	"product-requirements-management/internal/server/routes"
+	"product-requirements-management/internal/swagger"
 	"syscall"
 	"time"
 
@@ -26,6 +30,8 @@ type Server struct {
 	router        *gin.Engine
 	db            *database.DB
 	observability *observability.Observability
+	grpcServer    *grpcapi.Server
+	shutdown      *routes.Shutdown
 	startTime     time.Time
 }
 
@@ -67,10 +73,28 @@ func New(cfg *config.Config) (*Server, error) {
 
 	// Create Gin router
 	router := gin.New()
+	// Multipart uploads beyond this size spill to temporary files instead of memory
+	router.MaxMultipartMemory = cfg.RequestLimits.MultipartMemoryBytes
 
 	// Add core middleware
 	router.Use(middleware.Recovery())
-	router.Use(middleware.CORS())
+	router.Use(middleware.SecurityHeaders(cfg.Security, swagger.DefaultSwaggerConfig().BasePath, swagger.SitePath))
+	router.Use(middleware.CORSWithConfig(cfg.CORS))
+	router.Use(middleware.BodyLimit(cfg.RequestLimits))
+	router.Use(middleware.Compression(cfg.Compression))
+	router.Use(middleware.ConditionalGet())
+	router.Use(middleware.FieldSelection())
+
+	// Reject requests that deviate from the Swagger specification (development and staging only)
+	if cfg.RequestValidation.Enabled {
+		validator, err := swagger.NewRegisteredRequestValidator()
+		if err != nil {
+			logger.Warnf("Request validation disabled: %v", err)
+		} else {
+			logger.Info("Validating requests against the Swagger specification")
+			router.Use(middleware.RequestValidation(validator))
+		}
+	}
 
 	// Add observability middleware
 	if obs.Metrics != nil || obs.Tracer != nil {
@@ -88,6 +112,18 @@ func New(cfg *config.Config) (*Server, error) {
 		}
 	}
 
+	// Serve demo sessions from their own schemas; this must precede the shared routes
+	if cfg.Demo.Enabled {
+		demoManager, err := newDemoManager(cfg, db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize demo mode: %w", err)
+		}
+		router.Use(demoManager.Middleware())
+		demoManager.RegisterRoutes(router)
+		go demo.RunSessionCleanup(ctx, demoManager, time.Duration(cfg.Demo.CheckIntervalMinutes)*time.Minute, logger.Logger)
+		logger.Infof("Demo mode enabled: sessions last %d minutes, at most %d at a time", cfg.Demo.SessionTTLMinutes, cfg.Demo.MaxSessions)
+	}
+
 	// Setup health check routes
 	healthChecker := health.NewHealthChecker(db, obs.Metrics)
 	healthChecker.SetupHealthRoutes(router)
@@ -96,7 +132,17 @@ func New(cfg *config.Config) (*Server, error) {
 	obs.SetupMetricsEndpoint(router)
 
 	// Setup application routes
-	routes.Setup(router, cfg, db)
+	shutdown := &routes.Shutdown{}
+	routes.Setup(router, cfg, db, routes.WithShutdown(shutdown))
+
+	// Setup the internal gRPC API when a port is configured
+	var grpcServer *grpcapi.Server
+	if cfg.Server.GRPCPort != "" {
+		grpcServer, err = newGRPCServer(cfg, db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize gRPC server: %w", err)
+		}
+	}
 
 	// Start uptime recording
 	obs.StartUptimeRecording(ctx, startTime)
@@ -106,6 +152,8 @@ func New(cfg *config.Config) (*Server, error) {
 		router:        router,
 		db:            db,
 		observability: obs,
+		grpcServer:    grpcServer,
+		shutdown:      shutdown,
 		startTime:     startTime,
 	}, nil
 }
@@ -127,6 +175,20 @@ func (s *Server) Start() error {
 		}
 	}()
 
+	if s.grpcServer != nil {
+		grpcAddr := fmt.Sprintf("%s:%s", s.config.Server.Host, s.config.Server.GRPCPort)
+		listener, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen for gRPC on %s: %w", grpcAddr, err)
+		}
+		go func() {
+			logger.Infof("Starting gRPC server on %s", grpcAddr)
+			if err := s.grpcServer.Serve(listener); err != nil {
+				logger.Fatalf("Failed to start gRPC server: %v", err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -138,6 +200,10 @@ func (s *Server) Start() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	if s.grpcServer != nil {
+		s.grpcServer.Stop(ctx)
+	}
+
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Errorf("Server forced to shutdown: %v", err)
 		// Still try to close database connections
@@ -147,6 +213,11 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	// Finish the work of requests served before the shutdown, such as sending queued emails
+	if err := s.shutdown.Run(ctx); err != nil {
+		logger.Errorf("Failed to finish pending work: %v", err)
+	}
+
 	// Shutdown observability
 	if s.observability != nil {
 		if err := s.observability.Shutdown(ctx); err != nil {
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/validation"
+)
+
+// AcceptanceCriteriaLintService defines the interface for checking acceptance criteria against EARS patterns
+type AcceptanceCriteriaLintService interface {
+	LintAcceptanceCriteria(ctx context.Context, idOrReference string) (*AcceptanceCriteriaLintReport, error)
+	LintDescription(ctx context.Context, description string) validation.EARSLintResult
+}
+
+// AcceptanceCriteriaLintReport is the EARS lint result of stored acceptance criteria
+// @Description EARS lint findings for acceptance criteria; the criteria are valid when there are no error findings
+type AcceptanceCriteriaLintReport struct {
+	AcceptanceCriteriaID uuid.UUID `json:"acceptance_criteria_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID          string    `json:"reference_id" example:"AC-001"`
+	validation.EARSLintResult
+}
+
+// LintedAcceptanceCriteria is acceptance criteria returned together with EARS lint warnings
+// @Description Acceptance criteria with the EARS lint result of its description
+type LintedAcceptanceCriteria struct {
+	models.AcceptanceCriteria
+	Lint validation.EARSLintResult `json:"lint"`
+}
+
+// MarshalJSON implements custom JSON marshaling for LintedAcceptanceCriteria.
+// The embedded acceptance criteria's MarshalJSON would otherwise hide the lint result.
+func (lac LintedAcceptanceCriteria) MarshalJSON() ([]byte, error) {
+	return marshalWithFields(&lac.AcceptanceCriteria, map[string]interface{}{
+		"lint": lac.Lint,
+	})
+}
+
+// acceptanceCriteriaLintService implements AcceptanceCriteriaLintService interface
+type acceptanceCriteriaLintService struct {
+	repos  *repository.Repositories
+	linter validation.EARSLinter
+}
+
+// NewAcceptanceCriteriaLintService creates a new acceptance criteria lint service instance
+func NewAcceptanceCriteriaLintService(repos *repository.Repositories, linter validation.EARSLinter) AcceptanceCriteriaLintService {
+	return &acceptanceCriteriaLintService{
+		repos:  repos,
+		linter: linter,
+	}
+}
+
+// LintAcceptanceCriteria lints the description of stored acceptance criteria
+func (s *acceptanceCriteriaLintService) LintAcceptanceCriteria(ctx context.Context, idOrReference string) (*AcceptanceCriteriaLintReport, error) {
+	id, err := resolveEntityID(ctx, s.repos, models.EntityTypeAcceptanceCriteria, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	text, err := loadEntityText(ctx, s.repos, models.EntityTypeAcceptanceCriteria, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AcceptanceCriteriaLintReport{
+		AcceptanceCriteriaID: id,
+		ReferenceID:          text.ReferenceID,
+		EARSLintResult:       s.linter.Lint(text.Description),
+	}, nil
+}
+
+// LintDescription lints an acceptance criteria description that has not been stored
+func (s *acceptanceCriteriaLintService) LintDescription(ctx context.Context, description string) validation.EARSLintResult {
+	return s.linter.Lint(description)
+}
@@ -1,32 +1,34 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 )
 
 var (
-	ErrAcceptanceCriteriaNotFound          = errors.New("acceptance criteria not found")
-	ErrAcceptanceCriteriaHasRequirements   = errors.New("acceptance criteria has associated requirements and cannot be deleted")
-	ErrUserStoryMustHaveAcceptanceCriteria = errors.New("user story must have at least one acceptance criteria")
+	ErrAcceptanceCriteriaNotFound          = apperrors.New(apperrors.KindNotFound, "ACCEPTANCE_CRITERIA_NOT_FOUND", "acceptance criteria not found")
+	ErrAcceptanceCriteriaHasRequirements   = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "acceptance criteria has associated requirements and cannot be deleted")
+	ErrUserStoryMustHaveAcceptanceCriteria = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "user story must have at least one acceptance criteria")
 )
 
 // AcceptanceCriteriaService defines the interface for acceptance criteria business logic
 type AcceptanceCriteriaService interface {
-	CreateAcceptanceCriteria(req CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error)
-	GetAcceptanceCriteriaByID(id uuid.UUID) (*models.AcceptanceCriteria, error)
-	GetAcceptanceCriteriaByReferenceID(referenceID string) (*models.AcceptanceCriteria, error)
-	UpdateAcceptanceCriteria(id uuid.UUID, req UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error)
-	DeleteAcceptanceCriteria(id uuid.UUID, force bool) error
-	ListAcceptanceCriteria(filters AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error)
-	GetAcceptanceCriteriaByUserStory(userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error)
-	GetAcceptanceCriteriaByAuthor(authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error)
-	ValidateUserStoryHasAcceptanceCriteria(userStoryID uuid.UUID) error
+	CreateAcceptanceCriteria(ctx context.Context, req CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error)
+	GetAcceptanceCriteriaByID(ctx context.Context, id uuid.UUID) (*models.AcceptanceCriteria, error)
+	GetAcceptanceCriteriaByReferenceID(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error)
+	UpdateAcceptanceCriteria(ctx context.Context, id uuid.UUID, req UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error)
+	DeleteAcceptanceCriteria(ctx context.Context, id uuid.UUID, force bool) error
+	ListAcceptanceCriteria(ctx context.Context, filters AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error)
+	GetAcceptanceCriteriaByUserStory(ctx context.Context, userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error)
+	GetAcceptanceCriteriaByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error)
+	ValidateUserStoryHasAcceptanceCriteria(ctx context.Context, userStoryID uuid.UUID) error
 }
 
 // CreateAcceptanceCriteriaRequest represents the request to create acceptance criteria
@@ -55,6 +57,8 @@ type acceptanceCriteriaService struct {
 	acceptanceCriteriaRepo repository.AcceptanceCriteriaRepository
 	userStoryRepo          repository.UserStoryRepository
 	userRepo               repository.UserRepository
+	// redirectRepo resolves retired reference IDs when set
+	redirectRepo repository.ReferenceRedirectRepository
 }
 
 // NewAcceptanceCriteriaService creates a new acceptance criteria service instance
@@ -71,16 +75,16 @@ func NewAcceptanceCriteriaService(
 }
 
 // CreateAcceptanceCriteria creates new acceptance criteria
-func (s *acceptanceCriteriaService) CreateAcceptanceCriteria(req CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
+func (s *acceptanceCriteriaService) CreateAcceptanceCriteria(ctx context.Context, req CreateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
 	// Validate user story exists
-	if exists, err := s.userStoryRepo.Exists(req.UserStoryID); err != nil {
+	if exists, err := s.userStoryRepo.Exists(ctx, req.UserStoryID); err != nil {
 		return nil, fmt.Errorf("failed to check user story existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserStoryNotFound
 	}
 
 	// Validate author exists
-	if exists, err := s.userRepo.Exists(req.AuthorID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, req.AuthorID); err != nil {
 		return nil, fmt.Errorf("failed to check author existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
@@ -93,7 +97,7 @@ func (s *acceptanceCriteriaService) CreateAcceptanceCriteria(req CreateAcceptanc
 		Description: req.Description,
 	}
 
-	if err := s.acceptanceCriteriaRepo.Create(acceptanceCriteria); err != nil {
+	if err := s.acceptanceCriteriaRepo.Create(ctx, acceptanceCriteria); err != nil {
 		return nil, fmt.Errorf("failed to create acceptance criteria: %w", err)
 	}
 
@@ -101,8 +105,8 @@ func (s *acceptanceCriteriaService) CreateAcceptanceCriteria(req CreateAcceptanc
 }
 
 // GetAcceptanceCriteriaByID retrieves acceptance criteria by its ID with UserStory and Author preloaded
-func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByID(id uuid.UUID) (*models.AcceptanceCriteria, error) {
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByIDWithPreloads(id)
+func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByID(ctx context.Context, id uuid.UUID) (*models.AcceptanceCriteria, error) {
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByIDWithPreloads(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrAcceptanceCriteriaNotFound
@@ -112,12 +116,13 @@ func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByID(id uuid.UUID) (*mo
 	return acceptanceCriteria, nil
 }
 
-// GetAcceptanceCriteriaByReferenceID retrieves acceptance criteria by its reference ID
-func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(referenceID string) (*models.AcceptanceCriteria, error) {
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByReferenceID(referenceID)
+// GetAcceptanceCriteriaByReferenceID retrieves acceptance criteria by its reference ID.
+// A retired reference ID resolves to its successor.
+func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error) {
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByReferenceID(ctx, referenceID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, ErrAcceptanceCriteriaNotFound
+			return getRedirected(ctx, s.redirectRepo, models.EntityTypeAcceptanceCriteria, referenceID, ErrAcceptanceCriteriaNotFound, s.GetAcceptanceCriteriaByID)
 		}
 		return nil, fmt.Errorf("failed to get acceptance criteria: %w", err)
 	}
@@ -125,8 +130,8 @@ func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByReferenceID(reference
 }
 
 // UpdateAcceptanceCriteria updates existing acceptance criteria
-func (s *acceptanceCriteriaService) UpdateAcceptanceCriteria(id uuid.UUID, req UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(id)
+func (s *acceptanceCriteriaService) UpdateAcceptanceCriteria(ctx context.Context, id uuid.UUID, req UpdateAcceptanceCriteriaRequest) (*models.AcceptanceCriteria, error) {
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrAcceptanceCriteriaNotFound
@@ -139,18 +144,18 @@ func (s *acceptanceCriteriaService) UpdateAcceptanceCriteria(id uuid.UUID, req U
 		acceptanceCriteria.Description = *req.Description
 	}
 
-	if err := s.acceptanceCriteriaRepo.Update(acceptanceCriteria); err != nil {
+	if err := s.acceptanceCriteriaRepo.Update(ctx, acceptanceCriteria); err != nil {
 		return nil, fmt.Errorf("failed to update acceptance criteria: %w", err)
 	}
 
 	// Reload with preloads to return complete data
-	return s.acceptanceCriteriaRepo.GetByIDWithPreloads(id)
+	return s.acceptanceCriteriaRepo.GetByIDWithPreloads(ctx, id)
 }
 
 // DeleteAcceptanceCriteria deletes acceptance criteria with dependency validation
-func (s *acceptanceCriteriaService) DeleteAcceptanceCriteria(id uuid.UUID, force bool) error {
+func (s *acceptanceCriteriaService) DeleteAcceptanceCriteria(ctx context.Context, id uuid.UUID, force bool) error {
 	// Check if acceptance criteria exists
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(id)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrAcceptanceCriteriaNotFound
@@ -160,7 +165,7 @@ func (s *acceptanceCriteriaService) DeleteAcceptanceCriteria(id uuid.UUID, force
 
 	// Check for requirements unless force delete
 	if !force {
-		hasRequirements, err := s.acceptanceCriteriaRepo.HasRequirements(id)
+		hasRequirements, err := s.acceptanceCriteriaRepo.HasRequirements(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to check requirements: %w", err)
 		}
@@ -171,7 +176,7 @@ func (s *acceptanceCriteriaService) DeleteAcceptanceCriteria(id uuid.UUID, force
 
 	// Check if this is the last acceptance criteria for the user story
 	if !force {
-		count, err := s.acceptanceCriteriaRepo.CountByUserStory(acceptanceCriteria.UserStoryID)
+		count, err := s.acceptanceCriteriaRepo.CountByUserStory(ctx, acceptanceCriteria.UserStoryID)
 		if err != nil {
 			return fmt.Errorf("failed to count acceptance criteria: %w", err)
 		}
@@ -181,7 +186,7 @@ func (s *acceptanceCriteriaService) DeleteAcceptanceCriteria(id uuid.UUID, force
 	}
 
 	// Delete the acceptance criteria (cascade will handle requirements if force=true)
-	if err := s.acceptanceCriteriaRepo.Delete(id); err != nil {
+	if err := s.acceptanceCriteriaRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete acceptance criteria: %w", err)
 	}
 
@@ -189,7 +194,7 @@ func (s *acceptanceCriteriaService) DeleteAcceptanceCriteria(id uuid.UUID, force
 }
 
 // ListAcceptanceCriteria retrieves acceptance criteria with optional filtering and relationships preloaded
-func (s *acceptanceCriteriaService) ListAcceptanceCriteria(filters AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error) {
+func (s *acceptanceCriteriaService) ListAcceptanceCriteria(ctx context.Context, filters AcceptanceCriteriaFilters) ([]models.AcceptanceCriteria, int64, error) {
 	// Build filter map
 	filterMap := make(map[string]interface{})
 
@@ -201,7 +206,7 @@ func (s *acceptanceCriteriaService) ListAcceptanceCriteria(filters AcceptanceCri
 	}
 
 	// Get total count with filters
-	totalCount, err := s.acceptanceCriteriaRepo.Count(filterMap)
+	totalCount, err := s.acceptanceCriteriaRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count acceptance criteria: %w", err)
 	}
@@ -219,7 +224,7 @@ func (s *acceptanceCriteriaService) ListAcceptanceCriteria(filters AcceptanceCri
 	}
 
 	// Always use the method with preloads to include UserStory and Author by default
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.ListWithPreloads(filterMap, orderBy, limit, filters.Offset)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.ListWithPreloads(ctx, filterMap, orderBy, limit, filters.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list acceptance criteria: %w", err)
 	}
@@ -228,9 +233,9 @@ func (s *acceptanceCriteriaService) ListAcceptanceCriteria(filters AcceptanceCri
 }
 
 // GetAcceptanceCriteriaByUserStory retrieves acceptance criteria by user story ID with pagination
-func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
+func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(ctx context.Context, userStoryID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
 	// Validate user story exists
-	if exists, err := s.userStoryRepo.Exists(userStoryID); err != nil {
+	if exists, err := s.userStoryRepo.Exists(ctx, userStoryID); err != nil {
 		return nil, 0, fmt.Errorf("failed to check user story existence: %w", err)
 	} else if !exists {
 		return nil, 0, ErrUserStoryNotFound
@@ -250,13 +255,13 @@ func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(userStoryID
 	}
 
 	// Get total count for this user story
-	totalCount, err := s.acceptanceCriteriaRepo.Count(filterMap)
+	totalCount, err := s.acceptanceCriteriaRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count acceptance criteria by user story: %w", err)
 	}
 
 	// Get paginated results
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.List(filterMap, "created_at DESC", limit, offset)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.List(ctx, filterMap, "created_at DESC", limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get acceptance criteria by user story: %w", err)
 	}
@@ -265,9 +270,9 @@ func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByUserStory(userStoryID
 }
 
 // GetAcceptanceCriteriaByAuthor retrieves acceptance criteria by author ID with pagination
-func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
+func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.AcceptanceCriteria, int64, error) {
 	// Validate author exists
-	if exists, err := s.userRepo.Exists(authorID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, authorID); err != nil {
 		return nil, 0, fmt.Errorf("failed to check author existence: %w", err)
 	} else if !exists {
 		return nil, 0, ErrUserNotFound
@@ -287,13 +292,13 @@ func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(authorID uuid.
 	}
 
 	// Get total count for this author
-	totalCount, err := s.acceptanceCriteriaRepo.Count(filterMap)
+	totalCount, err := s.acceptanceCriteriaRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count acceptance criteria by author: %w", err)
 	}
 
 	// Get paginated results
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.List(filterMap, "created_at DESC", limit, offset)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.List(ctx, filterMap, "created_at DESC", limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get acceptance criteria by author: %w", err)
 	}
@@ -302,8 +307,8 @@ func (s *acceptanceCriteriaService) GetAcceptanceCriteriaByAuthor(authorID uuid.
 }
 
 // ValidateUserStoryHasAcceptanceCriteria validates that a user story has at least one acceptance criteria
-func (s *acceptanceCriteriaService) ValidateUserStoryHasAcceptanceCriteria(userStoryID uuid.UUID) error {
-	count, err := s.acceptanceCriteriaRepo.CountByUserStory(userStoryID)
+func (s *acceptanceCriteriaService) ValidateUserStoryHasAcceptanceCriteria(ctx context.Context, userStoryID uuid.UUID) error {
+	count, err := s.acceptanceCriteriaRepo.CountByUserStory(ctx, userStoryID)
 	if err != nil {
 		return fmt.Errorf("failed to count acceptance criteria: %w", err)
 	}
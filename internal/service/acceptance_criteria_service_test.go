@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -18,12 +19,12 @@ type MockAcceptanceCriteriaRepository struct {
 	mock.Mock
 }
 
-func (m *MockAcceptanceCriteriaRepository) Create(entity *models.AcceptanceCriteria) error {
+func (m *MockAcceptanceCriteriaRepository) Create(ctx context.Context, entity *models.AcceptanceCriteria) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockAcceptanceCriteriaRepository) GetByID(id uuid.UUID) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.AcceptanceCriteria, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -31,7 +32,7 @@ func (m *MockAcceptanceCriteriaRepository) GetByID(id uuid.UUID) (*models.Accept
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) GetByReferenceID(referenceID string) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -39,7 +40,7 @@ func (m *MockAcceptanceCriteriaRepository) GetByReferenceID(referenceID string)
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -47,17 +48,17 @@ func (m *MockAcceptanceCriteriaRepository) GetByReferenceIDCaseInsensitive(refer
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) Update(entity *models.AcceptanceCriteria) error {
+func (m *MockAcceptanceCriteriaRepository) Update(ctx context.Context, entity *models.AcceptanceCriteria) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockAcceptanceCriteriaRepository) Delete(id uuid.UUID) error {
+func (m *MockAcceptanceCriteriaRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockAcceptanceCriteriaRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.AcceptanceCriteria, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -65,22 +66,22 @@ func (m *MockAcceptanceCriteriaRepository) List(filters map[string]interface{},
 	return args.Get(0).([]models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockAcceptanceCriteriaRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockAcceptanceCriteriaRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Get(0).(bool), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockAcceptanceCriteriaRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(bool), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockAcceptanceCriteriaRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -93,7 +94,7 @@ func (m *MockAcceptanceCriteriaRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockAcceptanceCriteriaRepository) GetByUserStory(userStoryID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) GetByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.AcceptanceCriteria, error) {
 	args := m.Called(userStoryID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -101,7 +102,7 @@ func (m *MockAcceptanceCriteriaRepository) GetByUserStory(userStoryID uuid.UUID)
 	return args.Get(0).([]models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) GetByAuthor(authorID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) GetByAuthor(ctx context.Context, authorID uuid.UUID) ([]models.AcceptanceCriteria, error) {
 	args := m.Called(authorID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -109,17 +110,17 @@ func (m *MockAcceptanceCriteriaRepository) GetByAuthor(authorID uuid.UUID) ([]mo
 	return args.Get(0).([]models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) HasRequirements(id uuid.UUID) (bool, error) {
+func (m *MockAcceptanceCriteriaRepository) HasRequirements(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Get(0).(bool), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) CountByUserStory(userStoryID uuid.UUID) (int64, error) {
+func (m *MockAcceptanceCriteriaRepository) CountByUserStory(ctx context.Context, userStoryID uuid.UUID) (int64, error) {
 	args := m.Called(userStoryID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) GetByIDWithPreloads(id uuid.UUID) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) GetByIDWithPreloads(ctx context.Context, id uuid.UUID) (*models.AcceptanceCriteria, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -127,7 +128,7 @@ func (m *MockAcceptanceCriteriaRepository) GetByIDWithPreloads(id uuid.UUID) (*m
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) GetByReferenceIDWithPreloads(referenceID string) (*models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) GetByReferenceIDWithPreloads(ctx context.Context, referenceID string) (*models.AcceptanceCriteria, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -135,7 +136,7 @@ func (m *MockAcceptanceCriteriaRepository) GetByReferenceIDWithPreloads(referenc
 	return args.Get(0).(*models.AcceptanceCriteria), args.Error(1)
 }
 
-func (m *MockAcceptanceCriteriaRepository) ListWithPreloads(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.AcceptanceCriteria, error) {
+func (m *MockAcceptanceCriteriaRepository) ListWithPreloads(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.AcceptanceCriteria, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -144,6 +145,7 @@ func (m *MockAcceptanceCriteriaRepository) ListWithPreloads(filters map[string]i
 }
 
 func TestAcceptanceCriteriaService_CreateAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
 	mockAcceptanceCriteriaRepo := new(MockAcceptanceCriteriaRepository)
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -209,7 +211,7 @@ func TestAcceptanceCriteriaService_CreateAcceptanceCriteria(t *testing.T) {
 
 			tt.setupMocks()
 
-			result, err := service.CreateAcceptanceCriteria(tt.request)
+			result, err := service.CreateAcceptanceCriteria(ctx, tt.request)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -231,6 +233,7 @@ func TestAcceptanceCriteriaService_CreateAcceptanceCriteria(t *testing.T) {
 }
 
 func TestAcceptanceCriteriaService_GetAcceptanceCriteriaByID(t *testing.T) {
+	ctx := context.Background()
 	mockAcceptanceCriteriaRepo := new(MockAcceptanceCriteriaRepository)
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -275,7 +278,7 @@ func TestAcceptanceCriteriaService_GetAcceptanceCriteriaByID(t *testing.T) {
 
 			tt.setupMocks()
 
-			result, err := service.GetAcceptanceCriteriaByID(tt.id)
+			result, err := service.GetAcceptanceCriteriaByID(ctx, tt.id)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -293,6 +296,7 @@ func TestAcceptanceCriteriaService_GetAcceptanceCriteriaByID(t *testing.T) {
 }
 
 func TestAcceptanceCriteriaService_DeleteAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
 	mockAcceptanceCriteriaRepo := new(MockAcceptanceCriteriaRepository)
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -376,7 +380,7 @@ func TestAcceptanceCriteriaService_DeleteAcceptanceCriteria(t *testing.T) {
 
 			tt.setupMocks()
 
-			err := service.DeleteAcceptanceCriteria(tt.id, tt.force)
+			err := service.DeleteAcceptanceCriteria(ctx, tt.id, tt.force)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -391,6 +395,7 @@ func TestAcceptanceCriteriaService_DeleteAcceptanceCriteria(t *testing.T) {
 }
 
 func TestAcceptanceCriteriaService_ValidateUserStoryHasAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
 	mockAcceptanceCriteriaRepo := new(MockAcceptanceCriteriaRepository)
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -430,7 +435,7 @@ func TestAcceptanceCriteriaService_ValidateUserStoryHasAcceptanceCriteria(t *tes
 
 			tt.setupMocks()
 
-			err := service.ValidateUserStoryHasAcceptanceCriteria(tt.userStoryID)
+			err := service.ValidateUserStoryHasAcceptanceCriteria(ctx, tt.userStoryID)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+const (
+	defaultActivityLimit = 50
+	maxActivityLimit     = 100
+)
+
+var (
+	ErrInvalidActivityCursor = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid activity cursor")
+)
+
+// ActivityService defines the interface for reading activity feeds built from the audit log
+type ActivityService interface {
+	GetEntityActivity(ctx context.Context, entityType models.EntityType, idOrReference string, cursor string, limit int) (*ActivityFeedResponse, error)
+	GetUserActivity(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*ActivityFeedResponse, error)
+}
+
+// ActivityFeedResponse represents a page of activity events, newest first
+// @Description Page of activity events, newest first. Pass next_cursor as the cursor parameter to fetch the following page.
+type ActivityFeedResponse struct {
+	Events []models.AuditEvent `json:"events"`
+	// NextCursor is the cursor for the next page (omitted on the last page)
+	NextCursor string `json:"next_cursor,omitempty" example:"MjAyMy0wMS0wMVQxMDowMDowMFp8MTIzZTQ1NjctZTg5Yi0xMmQzLWE0NTYtNDI2NjE0MTc0MDAw"`
+}
+
+// activityService implements ActivityService interface
+type activityService struct {
+	auditRepo repository.AuditRepository
+	repos     *repository.Repositories
+}
+
+// NewActivityService creates a new activity service instance
+func NewActivityService(repos *repository.Repositories) ActivityService {
+	return &activityService{
+		auditRepo: repos.Audit,
+		repos:     repos,
+	}
+}
+
+// GetEntityActivity retrieves the activity feed of a single entity
+func (s *activityService) GetEntityActivity(ctx context.Context, entityType models.EntityType, idOrReference string, cursor string, limit int) (*ActivityFeedResponse, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.page(cursor, limit, func(after *repository.AuditCursor, pageLimit int) ([]models.AuditEvent, error) {
+		return s.auditRepo.ListByEntity(ctx, entityType, entityID, after, pageLimit)
+	})
+}
+
+// GetUserActivity retrieves changes made by the user and changes to entities assigned to the user
+func (s *activityService) GetUserActivity(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*ActivityFeedResponse, error) {
+	return s.page(cursor, limit, func(after *repository.AuditCursor, pageLimit int) ([]models.AuditEvent, error) {
+		return s.auditRepo.ListForUser(ctx, userID, after, pageLimit)
+	})
+}
+
+// page fetches one page of events and computes the cursor of the next page
+func (s *activityService) page(cursor string, limit int, list func(after *repository.AuditCursor, pageLimit int) ([]models.AuditEvent, error)) (*ActivityFeedResponse, error) {
+	if limit <= 0 {
+		limit = defaultActivityLimit
+	}
+	if limit > maxActivityLimit {
+		limit = maxActivityLimit
+	}
+
+	after, err := decodeActivityCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra event to find out whether there is a next page
+	events, err := list(after, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activity: %w", err)
+	}
+
+	response := &ActivityFeedResponse{Events: events}
+	if len(events) > limit {
+		response.Events = events[:limit]
+		last := response.Events[limit-1]
+		response.NextCursor = encodeActivityCursor(last.CreatedAt, last.ID)
+	}
+	if response.Events == nil {
+		response.Events = []models.AuditEvent{}
+	}
+	return response, nil
+}
+
+// encodeActivityCursor builds an opaque cursor from the position of the last returned event
+func encodeActivityCursor(createdAt time.Time, id uuid.UUID) string {
+	raw := createdAt.UTC().Format(time.RFC3339Nano) + "|" + id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeActivityCursor parses a cursor produced by encodeActivityCursor; an empty cursor means the first page
+func decodeActivityCursor(cursor string) (*repository.AuditCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidActivityCursor
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 {
+		return nil, ErrInvalidActivityCursor
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, ErrInvalidActivityCursor
+	}
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, ErrInvalidActivityCursor
+	}
+
+	return &repository.AuditCursor{CreatedAt: createdAt, ID: id}, nil
+}
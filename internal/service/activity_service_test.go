@@ -0,0 +1,32 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityCursor_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 123456789, time.UTC)
+	id := uuid.New()
+
+	cursor, err := decodeActivityCursor(encodeActivityCursor(createdAt, id))
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	assert.True(t, createdAt.Equal(cursor.CreatedAt))
+	assert.Equal(t, id, cursor.ID)
+}
+
+func TestActivityCursor_Invalid(t *testing.T) {
+	cursor, err := decodeActivityCursor("")
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	for _, invalid := range []string{"not base64!", "bm8tc2VwYXJhdG9y", "bm90LWEtdGltZXx4"} {
+		_, err := decodeActivityCursor(invalid)
+		assert.ErrorIs(t, err, ErrInvalidActivityCursor, invalid)
+	}
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrAPIQuotaNotFound      = apperrors.New(apperrors.KindNotFound, "API_QUOTA_NOT_FOUND", "API quota not found")
+	ErrInvalidAPIQuotaRole   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "role must be one of: User, Commenter")
+	ErrInvalidAPIQuotaLimit  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "daily_limit and pat_daily_limit must not be negative")
+	ErrAPIQuotaAdministrator = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "administrators are not limited by API quotas")
+)
+
+// API quota scopes, naming the limit a quota status refers to
+const (
+	APIQuotaScopeDaily    = "daily"     // Requests per UTC day with any credential
+	APIQuotaScopePATDaily = "pat_daily" // Requests per UTC day with personal access tokens
+)
+
+// apiQuotaCacheTTL is how long quotas are cached before they are read again, so that quota changes
+// made through another instance take effect
+const apiQuotaCacheTTL = 30 * time.Second
+
+// APIUsageService defines the interface for counting API requests, enforcing daily quotas and reporting usage
+type APIUsageService interface {
+	RecordRequest(ctx context.Context, req APIRequest) (*APIQuotaStatus, error)
+	GetUsage(ctx context.Context, day time.Time) (*APIUsageReport, error)
+	ListQuotas(ctx context.Context) ([]models.APIQuota, error)
+	SetRoleQuota(ctx context.Context, role models.UserRole, req APIQuotaRequest) (*models.APIQuota, error)
+	SetUserQuota(ctx context.Context, userID uuid.UUID, req APIQuotaRequest) (*models.APIQuota, error)
+	DeleteUserQuota(ctx context.Context, userID uuid.UUID) error
+}
+
+// APIRequest describes an authenticated API request
+type APIRequest struct {
+	UserID  uuid.UUID
+	Role    models.UserRole
+	TokenID *uuid.UUID // Personal access token of the request; unset for JWT sessions
+	At      time.Time
+}
+
+// APIQuotaStatus is the quota state of a user after a request
+type APIQuotaStatus struct {
+	Limited   bool      // A quota applies to the request
+	Exceeded  bool      // The quota is used up; the request was rejected and not counted
+	Scope     string    // Limit closest to being reached: daily or pat_daily
+	Limit     int64     // Requests allowed per day by that limit
+	Remaining int64     // Requests left today under that limit
+	ResetAt   time.Time // Start of the next UTC day, when usage starts over
+}
+
+// APIQuotaRequest represents the request to set the daily quota of a role or a user
+// @Description Daily API request limits; omit a limit or set it to null to lift it
+type APIQuotaRequest struct {
+	DailyLimit    *int64 `json:"daily_limit" example:"10000"`    // Requests per UTC day with any credential
+	PATDailyLimit *int64 `json:"pat_daily_limit" example:"2000"` // Requests per UTC day with personal access tokens
+}
+
+// APIUsageReport is the API usage of all users on a day
+// @Description Requests per user and token on a UTC day with the quota that applies to each user
+type APIUsageReport struct {
+	Day      string         `json:"day" example:"2023-01-01"`                // UTC day of the report
+	ResetAt  time.Time      `json:"reset_at" example:"2023-01-02T00:00:00Z"` // When the counters of the day start over
+	Requests int64          `json:"requests" example:"1250"`                 // Requests of all users
+	Users    []APIUserUsage `json:"users"`                                   // Users by number of requests, most active first
+}
+
+// APIUserUsage is the API usage of a user on a day
+type APIUserUsage struct {
+	UserID        uuid.UUID       `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Username      string          `json:"username" example:"ci-bot"`
+	Role          models.UserRole `json:"role" example:"User"`
+	Requests      int64           `json:"requests" example:"900"`                         // Requests with any credential
+	PATRequests   int64           `json:"pat_requests" example:"850"`                     // Requests with personal access tokens
+	DailyLimit    *int64          `json:"daily_limit,omitempty" example:"10000"`          // Unset when unlimited
+	PATDailyLimit *int64          `json:"pat_daily_limit,omitempty" example:"2000"`       // Unset when unlimited
+	QuotaSource   string          `json:"quota_source,omitempty" example:"role"`          // user or role; unset when no quota applies
+	LastRequestAt time.Time       `json:"last_request_at" example:"2023-01-01T17:45:00Z"` // Timestamp of the latest request
+	Tokens        []APITokenUsage `json:"tokens"`                                         // Requests per credential, most used first
+}
+
+// APITokenUsage is the number of requests a user made with one credential on a day
+type APITokenUsage struct {
+	TokenID       *uuid.UUID `json:"token_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"` // Personal access token; unset for JWT sessions
+	TokenName     *string    `json:"token_name,omitempty" example:"CI pipeline"`                        // Unset for JWT sessions and deleted tokens
+	Requests      int64      `json:"requests" example:"850"`
+	LastRequestAt time.Time  `json:"last_request_at" example:"2023-01-01T17:45:00Z"`
+}
+
+// apiUsageService implements APIUsageService interface
+type apiUsageService struct {
+	repos *repository.Repositories
+
+	mu            sync.Mutex
+	roleQuotas    map[models.UserRole]*models.APIQuota
+	userQuotas    map[uuid.UUID]*models.APIQuota
+	quotasLoaded  time.Time
+	quotasInvalid bool
+}
+
+// NewAPIUsageService creates a new API usage service instance
+func NewAPIUsageService(repos *repository.Repositories) APIUsageService {
+	return &apiUsageService{
+		repos:         repos,
+		quotasInvalid: true,
+	}
+}
+
+// RecordRequest checks the quota of the requesting user and counts the request unless the quota is used up.
+// Requests of administrators are counted but never limited.
+func (s *apiUsageService) RecordRequest(ctx context.Context, req APIRequest) (*APIQuotaStatus, error) {
+	at := req.At.UTC()
+	day := apiUsageDay(at)
+	status := &APIQuotaStatus{ResetAt: day.AddDate(0, 0, 1)}
+
+	tokenID := uuid.Nil
+	if req.TokenID != nil {
+		tokenID = *req.TokenID
+	}
+
+	if req.Role != models.RoleAdministrator {
+		quota, _, err := s.quotaFor(ctx, req.UserID, req.Role, at)
+		if err != nil {
+			return nil, err
+		}
+		if quota != nil && (quota.DailyLimit != nil || (req.TokenID != nil && quota.PATDailyLimit != nil)) {
+			// The check and the count are one transaction under a lock, so concurrent requests cannot overrun the quota
+			err := s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+				if err := tx.APIUsage.LockForDay(ctx, req.UserID, day); err != nil {
+					return fmt.Errorf("failed to lock API usage: %w", err)
+				}
+				count, err := tx.APIUsage.CountForDay(ctx, req.UserID, day)
+				if err != nil {
+					return fmt.Errorf("failed to count API requests: %w", err)
+				}
+
+				check := func(scope string, limit *int64, used int64) {
+					if limit == nil {
+						return
+					}
+					if remaining := *limit - used; !status.Limited || remaining < status.Remaining {
+						status.Limited, status.Scope, status.Limit, status.Remaining = true, scope, *limit, remaining
+					}
+				}
+				check(APIQuotaScopeDaily, quota.DailyLimit, count.Requests)
+				if req.TokenID != nil {
+					check(APIQuotaScopePATDaily, quota.PATDailyLimit, count.PATRequests)
+				}
+
+				if status.Remaining <= 0 {
+					status.Exceeded, status.Remaining = true, 0
+					return nil
+				}
+				status.Remaining--
+				if err := tx.APIUsage.Increment(ctx, req.UserID, tokenID, day, at); err != nil {
+					return fmt.Errorf("failed to count API request: %w", err)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return status, nil
+		}
+	}
+
+	if err := s.repos.APIUsage.Increment(ctx, req.UserID, tokenID, day, at); err != nil {
+		return nil, fmt.Errorf("failed to count API request: %w", err)
+	}
+	return status, nil
+}
+
+// GetUsage reports the requests of every user on the UTC day of the given time
+func (s *apiUsageService) GetUsage(ctx context.Context, day time.Time) (*APIUsageReport, error) {
+	day = apiUsageDay(day.UTC())
+	items, err := s.repos.APIUsage.ListByDay(ctx, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API usage: %w", err)
+	}
+
+	report := &APIUsageReport{
+		Day:     day.Format("2006-01-02"),
+		ResetAt: day.AddDate(0, 0, 1),
+		Users:   []APIUserUsage{},
+	}
+	byUser := make(map[uuid.UUID]*APIUserUsage)
+	var order []uuid.UUID
+	for _, item := range items {
+		usage, ok := byUser[item.UserID]
+		if !ok {
+			usage = &APIUserUsage{
+				UserID:   item.UserID,
+				Username: item.Username,
+				Role:     item.Role,
+				Tokens:   []APITokenUsage{},
+			}
+			byUser[item.UserID] = usage
+			order = append(order, item.UserID)
+		}
+
+		token := APITokenUsage{
+			TokenName:     item.TokenName,
+			Requests:      item.Requests,
+			LastRequestAt: item.LastRequestAt,
+		}
+		if item.TokenID != uuid.Nil {
+			tokenID := item.TokenID
+			token.TokenID = &tokenID
+			usage.PATRequests += item.Requests
+		}
+		usage.Tokens = append(usage.Tokens, token)
+		usage.Requests += item.Requests
+		if item.LastRequestAt.After(usage.LastRequestAt) {
+			usage.LastRequestAt = item.LastRequestAt
+		}
+		report.Requests += item.Requests
+	}
+
+	for _, userID := range order {
+		usage := byUser[userID]
+		if usage.Role != models.RoleAdministrator {
+			quota, source, err := s.quotaFor(ctx, userID, usage.Role, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			if quota != nil {
+				usage.DailyLimit, usage.PATDailyLimit, usage.QuotaSource = quota.DailyLimit, quota.PATDailyLimit, source
+			}
+		}
+		report.Users = append(report.Users, *usage)
+	}
+	sort.SliceStable(report.Users, func(i, j int) bool {
+		return report.Users[i].Requests > report.Users[j].Requests
+	})
+	return report, nil
+}
+
+// ListQuotas retrieves the role quotas followed by the user quotas
+func (s *apiUsageService) ListQuotas(ctx context.Context) ([]models.APIQuota, error) {
+	quotas, err := s.repos.APIQuota.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API quotas: %w", err)
+	}
+	return quotas, nil
+}
+
+// SetRoleQuota creates or replaces the quota of every user with a non-administrator role
+func (s *apiUsageService) SetRoleQuota(ctx context.Context, role models.UserRole, req APIQuotaRequest) (*models.APIQuota, error) {
+	if role == models.RoleAdministrator {
+		return nil, ErrAPIQuotaAdministrator
+	}
+	if !role.IsValid() {
+		return nil, ErrInvalidAPIQuotaRole
+	}
+	if err := validateAPIQuotaRequest(req); err != nil {
+		return nil, err
+	}
+
+	quota, err := s.repos.APIQuota.GetByRole(ctx, role)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get API quota: %w", err)
+	}
+	return s.saveQuota(ctx, quota, &models.APIQuota{Role: &role}, req)
+}
+
+// SetUserQuota creates or replaces the quota of a user, which takes the place of the quota of the user's role
+func (s *apiUsageService) SetUserQuota(ctx context.Context, userID uuid.UUID, req APIQuotaRequest) (*models.APIQuota, error) {
+	if err := validateAPIQuotaRequest(req); err != nil {
+		return nil, err
+	}
+	user, err := s.repos.User.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Role == models.RoleAdministrator {
+		return nil, ErrAPIQuotaAdministrator
+	}
+
+	quota, err := s.repos.APIQuota.GetByUser(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get API quota: %w", err)
+	}
+	saved, err := s.saveQuota(ctx, quota, &models.APIQuota{UserID: &userID}, req)
+	if err != nil {
+		return nil, err
+	}
+	saved.User = user
+	return saved, nil
+}
+
+// DeleteUserQuota removes the quota of a user, so that the quota of the user's role applies again
+func (s *apiUsageService) DeleteUserQuota(ctx context.Context, userID uuid.UUID) error {
+	quota, err := s.repos.APIQuota.GetByUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrAPIQuotaNotFound
+		}
+		return fmt.Errorf("failed to get API quota: %w", err)
+	}
+	if err := s.repos.APIQuota.Delete(ctx, quota.ID); err != nil {
+		return fmt.Errorf("failed to delete API quota: %w", err)
+	}
+	s.invalidateQuotas()
+	return nil
+}
+
+// saveQuota updates an existing quota with the limits of the request or creates the given new one
+func (s *apiUsageService) saveQuota(ctx context.Context, existing, created *models.APIQuota, req APIQuotaRequest) (*models.APIQuota, error) {
+	quota := existing
+	if quota == nil {
+		quota = created
+	}
+	quota.DailyLimit, quota.PATDailyLimit = req.DailyLimit, req.PATDailyLimit
+
+	if existing == nil {
+		if err := s.repos.APIQuota.Create(ctx, quota); err != nil {
+			return nil, fmt.Errorf("failed to create API quota: %w", err)
+		}
+	} else if err := s.repos.APIQuota.Update(ctx, quota); err != nil {
+		return nil, fmt.Errorf("failed to update API quota: %w", err)
+	}
+	s.invalidateQuotas()
+	return quota, nil
+}
+
+// quotaFor returns the quota that applies to a user and whether it is a user or role quota;
+// nil if the user is unlimited
+func (s *apiUsageService) quotaFor(ctx context.Context, userID uuid.UUID, role models.UserRole, now time.Time) (*models.APIQuota, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.quotasInvalid || now.Sub(s.quotasLoaded) > apiQuotaCacheTTL || now.Before(s.quotasLoaded) {
+		quotas, err := s.repos.APIQuota.ListAll(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to load API quotas: %w", err)
+		}
+		s.roleQuotas = make(map[models.UserRole]*models.APIQuota)
+		s.userQuotas = make(map[uuid.UUID]*models.APIQuota)
+		for i := range quotas {
+			switch {
+			case quotas[i].UserID != nil:
+				s.userQuotas[*quotas[i].UserID] = &quotas[i]
+			case quotas[i].Role != nil:
+				s.roleQuotas[*quotas[i].Role] = &quotas[i]
+			}
+		}
+		s.quotasLoaded, s.quotasInvalid = now, false
+	}
+
+	if quota, ok := s.userQuotas[userID]; ok {
+		return quota, "user", nil
+	}
+	if quota, ok := s.roleQuotas[role]; ok {
+		return quota, "role", nil
+	}
+	return nil, "", nil
+}
+
+// invalidateQuotas makes the next quota lookup read the quotas again
+func (s *apiUsageService) invalidateQuotas() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quotasInvalid = true
+}
+
+// validateAPIQuotaRequest rejects negative limits
+func validateAPIQuotaRequest(req APIQuotaRequest) error {
+	if (req.DailyLimit != nil && *req.DailyLimit < 0) || (req.PATDailyLimit != nil && *req.PATDailyLimit < 0) {
+		return ErrInvalidAPIQuotaLimit
+	}
+	return nil
+}
+
+// apiUsageDay returns the start of the UTC day of t
+func apiUsageDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestAPIUsageService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.PersonalAccessToken{}, &models.APIUsageCounter{}, &models.APIQuota{}))
+
+	alice := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	bot := &models.User{Username: "ci-bot", Email: "bot@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	admin := &models.User{Username: "admin", Email: "admin@example.com", PasswordHash: "hash", Role: models.RoleAdministrator}
+	for _, user := range []*models.User{alice, bot, admin} {
+		require.NoError(t, db.Create(user).Error)
+	}
+	pat := &models.PersonalAccessToken{UserID: bot.ID, Name: "CI pipeline", TokenHash: "hash"}
+	require.NoError(t, db.Create(pat).Error)
+
+	svc := NewAPIUsageService(repository.NewRepositories(db, nil))
+	now := time.Date(2024, time.January, 10, 22, 30, 0, 0, time.UTC)
+	limit := func(n int64) *int64 { return &n }
+	record := func(user *models.User, tokenID *uuid.UUID, at time.Time) *APIQuotaStatus {
+		status, err := svc.RecordRequest(ctx, APIRequest{UserID: user.ID, Role: user.Role, TokenID: tokenID, At: at})
+		require.NoError(t, err)
+		return status
+	}
+
+	t.Run("counts requests without limits until a quota is set", func(t *testing.T) {
+		status := record(alice, nil, now)
+		assert.False(t, status.Limited)
+		assert.False(t, status.Exceeded)
+		assert.Equal(t, time.Date(2024, time.January, 11, 0, 0, 0, 0, time.UTC), status.ResetAt)
+	})
+
+	t.Run("limits requests with personal access tokens", func(t *testing.T) {
+		_, err := svc.SetRoleQuota(ctx, models.RoleUser, APIQuotaRequest{DailyLimit: limit(5), PATDailyLimit: limit(2)})
+		require.NoError(t, err)
+
+		status := record(bot, nil, now)
+		assert.True(t, status.Limited)
+		assert.Equal(t, APIQuotaScopeDaily, status.Scope)
+		assert.Equal(t, int64(4), status.Remaining)
+
+		status = record(bot, &pat.ID, now)
+		assert.Equal(t, APIQuotaScopePATDaily, status.Scope)
+		assert.Equal(t, int64(1), status.Remaining)
+		record(bot, &pat.ID, now)
+
+		status = record(bot, &pat.ID, now)
+		assert.True(t, status.Exceeded)
+		assert.Equal(t, int64(2), status.Limit)
+		assert.Zero(t, status.Remaining)
+
+		status = record(bot, nil, now)
+		assert.False(t, status.Exceeded, "sessions are only limited by the daily limit")
+		assert.Equal(t, int64(1), status.Remaining)
+
+		status = record(bot, &pat.ID, now.Add(2*time.Hour))
+		assert.False(t, status.Exceeded, "usage starts over on the next UTC day")
+	})
+
+	t.Run("user quotas replace role quotas", func(t *testing.T) {
+		_, err := svc.SetUserQuota(ctx, bot.ID, APIQuotaRequest{DailyLimit: limit(100)})
+		require.NoError(t, err)
+		status := record(bot, &pat.ID, now)
+		assert.False(t, status.Exceeded)
+		assert.Equal(t, APIQuotaScopeDaily, status.Scope)
+		assert.Equal(t, int64(100-5), status.Remaining)
+
+		require.NoError(t, svc.DeleteUserQuota(ctx, bot.ID))
+		assert.True(t, record(bot, &pat.ID, now).Exceeded)
+		assert.ErrorIs(t, svc.DeleteUserQuota(ctx, bot.ID), ErrAPIQuotaNotFound)
+	})
+
+	t.Run("administrators are counted but never limited", func(t *testing.T) {
+		_, err := svc.SetRoleQuota(ctx, models.RoleAdministrator, APIQuotaRequest{DailyLimit: limit(1)})
+		assert.ErrorIs(t, err, ErrAPIQuotaAdministrator)
+		_, err = svc.SetUserQuota(ctx, admin.ID, APIQuotaRequest{DailyLimit: limit(1)})
+		assert.ErrorIs(t, err, ErrAPIQuotaAdministrator)
+
+		status := record(admin, nil, now)
+		assert.False(t, status.Limited)
+	})
+
+	t.Run("validates quotas", func(t *testing.T) {
+		_, err := svc.SetRoleQuota(ctx, "Guest", APIQuotaRequest{})
+		assert.ErrorIs(t, err, ErrInvalidAPIQuotaRole)
+		_, err = svc.SetRoleQuota(ctx, models.RoleCommenter, APIQuotaRequest{PATDailyLimit: limit(-1)})
+		assert.ErrorIs(t, err, ErrInvalidAPIQuotaLimit)
+		_, err = svc.SetUserQuota(ctx, uuid.New(), APIQuotaRequest{})
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		quotas, err := svc.ListQuotas(ctx)
+		require.NoError(t, err)
+		require.Len(t, quotas, 1)
+		assert.Equal(t, models.RoleUser, *quotas[0].Role)
+	})
+
+	t.Run("reports usage per user and token", func(t *testing.T) {
+		report, err := svc.GetUsage(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-10", report.Day)
+		assert.Equal(t, int64(7), report.Requests, "rejected requests are not counted")
+		require.Len(t, report.Users, 3)
+
+		botUsage := report.Users[0]
+		assert.Equal(t, "ci-bot", botUsage.Username)
+		assert.Equal(t, int64(5), botUsage.Requests)
+		assert.Equal(t, int64(3), botUsage.PATRequests)
+		assert.Equal(t, "role", botUsage.QuotaSource)
+		assert.Equal(t, int64(2), *botUsage.PATDailyLimit)
+		require.Len(t, botUsage.Tokens, 2)
+		assert.Equal(t, "CI pipeline", *botUsage.Tokens[0].TokenName)
+		assert.Equal(t, pat.ID, *botUsage.Tokens[0].TokenID)
+		assert.Nil(t, botUsage.Tokens[1].TokenID)
+
+		assert.Equal(t, "admin", report.Users[1].Username, "users with the same number of requests are ordered by name")
+		assert.Empty(t, report.Users[1].QuotaSource, "administrators have no quota")
+		assert.Equal(t, "role", report.Users[2].QuotaSource)
+
+		report, err = svc.GetUsage(ctx, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), report.Requests)
+	})
+}
+
+func TestAPIUsageService_ConcurrentRequests(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// One connection keeps the in-memory database shared; statements of concurrent requests still interleave
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.PersonalAccessToken{}, &models.APIUsageCounter{}, &models.APIQuota{}))
+
+	user := &models.User{Username: "ci-bot", Email: "bot@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+	svc := NewAPIUsageService(repository.NewRepositories(db, nil))
+	limit := int64(5)
+	_, err = svc.SetUserQuota(ctx, user.ID, APIQuotaRequest{DailyLimit: &limit})
+	require.NoError(t, err)
+
+	now := time.Now()
+	var wg sync.WaitGroup
+	var accepted atomic.Int64
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, err := svc.RecordRequest(ctx, APIRequest{UserID: user.ID, Role: user.Role, At: now})
+			if assert.NoError(t, err) && !status.Exceeded {
+				accepted.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, limit, accepted.Load(), "concurrent requests cannot overrun the quota")
+	report, err := svc.GetUsage(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, limit, report.Requests)
+}
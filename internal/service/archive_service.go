@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrArchiveUnsupported = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "only epics and user stories can be archived")
+)
+
+// ArchiveService defines the interface for archiving epics and user stories
+type ArchiveService interface {
+	SetArchived(ctx context.Context, entityType models.EntityType, idOrReference string, archived bool, userID uuid.UUID) (interface{}, error)
+}
+
+// archiveService implements ArchiveService interface
+type archiveService struct {
+	repos           *repository.Repositories
+	presenceService PresenceService
+}
+
+// NewArchiveService creates a new archive service instance
+func NewArchiveService(repos *repository.Repositories, presenceService PresenceService) ArchiveService {
+	return &archiveService{
+		repos:           repos,
+		presenceService: presenceService,
+	}
+}
+
+// SetArchived archives or restores an epic or user story and records the change in its activity.
+// Archived entities keep their children, comments and history; they are only left out of default lists and search.
+// Setting the state an entity already has is a no-op.
+func (s *archiveService) SetArchived(ctx context.Context, entityType models.EntityType, idOrReference string, archived bool, userID uuid.UUID) (interface{}, error) {
+	if entityType != models.EntityTypeEpic && entityType != models.EntityTypeUserStory {
+		return nil, ErrArchiveUnsupported
+	}
+
+	id, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	if s.presenceService != nil {
+		if err := s.presenceService.CheckEditAllowed(ctx, entityType, id, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	var entity interface{}
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		var changed bool
+		var err error
+		switch entityType {
+		case models.EntityTypeEpic:
+			entity, changed, err = setEpicArchived(ctx, tx, id, archived)
+		default:
+			entity, changed, err = setUserStoryArchived(ctx, tx, id, archived)
+		}
+		if err != nil || !changed {
+			return err
+		}
+
+		action := models.AuditActionArchived
+		if !archived {
+			action = models.AuditActionUnarchived
+		}
+		if err := tx.Audit.Create(ctx, &models.AuditEvent{
+			EntityType: entityType,
+			EntityID:   id,
+			Action:     action,
+			ActorID:    &userID,
+			Field:      "archived",
+			CreatedAt:  time.Now().UTC(),
+		}); err != nil {
+			return fmt.Errorf("failed to record archival: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func setEpicArchived(ctx context.Context, tx *repository.Repositories, id uuid.UUID, archived bool) (*models.Epic, bool, error) {
+	epic, err := tx.Epic.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, false, ErrEpicNotFound
+		}
+		return nil, false, fmt.Errorf("failed to get epic: %w", err)
+	}
+	if epic.Archived == archived {
+		return epic, false, nil
+	}
+
+	epic.Archived, epic.ArchivedAt = archived, archivedAt(archived)
+	if err := tx.Epic.Update(ctx, epic); err != nil {
+		return nil, false, fmt.Errorf("failed to update epic: %w", err)
+	}
+	return epic, true, nil
+}
+
+func setUserStoryArchived(ctx context.Context, tx *repository.Repositories, id uuid.UUID, archived bool) (*models.UserStory, bool, error) {
+	userStory, err := tx.UserStory.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, false, ErrUserStoryNotFound
+		}
+		return nil, false, fmt.Errorf("failed to get user story: %w", err)
+	}
+	if userStory.Archived == archived {
+		return userStory, false, nil
+	}
+
+	userStory.Archived, userStory.ArchivedAt = archived, archivedAt(archived)
+	if err := tx.UserStory.Update(ctx, userStory); err != nil {
+		return nil, false, fmt.Errorf("failed to update user story: %w", err)
+	}
+	return userStory, true, nil
+}
+
+// archivedAt returns the archival time to store for the given state
+func archivedAt(archived bool) *time.Time {
+	if !archived {
+		return nil
+	}
+	now := time.Now().UTC()
+	return &now
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestArchiveService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.ReferenceRedirect{}, &models.UserStory{},
+		&models.AcceptanceCriteria{}, &models.Requirement{}, &models.AuditEvent{}))
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epics := []models.Epic{
+		{ID: uuid.New(), ReferenceID: "EP-001", Title: "Launch"},
+		{ID: uuid.New(), ReferenceID: "EP-002", Title: "Growth"},
+	}
+	for i := range epics {
+		epics[i].Status, epics[i].Priority, epics[i].CreatorID, epics[i].AssigneeID = models.EpicStatusDone, models.PriorityHigh, user.ID, user.ID
+		require.NoError(t, session.Create(&epics[i]).Error)
+	}
+	story := models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: epics[0].ID, Title: "Landing page",
+		Status: models.UserStoryStatusDone, Priority: models.PriorityHigh, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(&story).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	entities := NewEntityServices(repos)
+	svc := NewArchiveService(repos, nil)
+
+	t.Run("only epics and user stories can be archived", func(t *testing.T) {
+		_, err := svc.SetArchived(ctx, models.EntityTypeRequirement, "REQ-001", true, user.ID)
+		assert.ErrorIs(t, err, ErrArchiveUnsupported)
+
+		_, err = svc.SetArchived(ctx, models.EntityTypeEpic, "EP-404", true, user.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("archived epics are left out of default lists", func(t *testing.T) {
+		entity, err := svc.SetArchived(ctx, models.EntityTypeEpic, "EP-001", true, user.ID)
+		require.NoError(t, err)
+		archived := entity.(*models.Epic)
+		assert.True(t, archived.Archived)
+		assert.NotNil(t, archived.ArchivedAt)
+
+		listed, total, err := entities.Epic.ListEpics(ctx, EpicFilters{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, listed, 1)
+		assert.Equal(t, "EP-002", listed[0].ReferenceID)
+
+		_, total, err = entities.Epic.ListEpics(ctx, EpicFilters{IncludeArchived: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+
+		// Archived epics stay reachable by ID along with their user stories
+		epic, err := entities.Epic.GetEpicByReferenceID(ctx, "EP-001")
+		require.NoError(t, err)
+		assert.True(t, epic.Archived)
+	})
+
+	t.Run("archiving twice is a no-op", func(t *testing.T) {
+		_, err := svc.SetArchived(ctx, models.EntityTypeEpic, epics[0].ID.String(), true, user.ID)
+		require.NoError(t, err)
+
+		var events []models.AuditEvent
+		require.NoError(t, db.Where("entity_id = ? AND action = ?", epics[0].ID, models.AuditActionArchived).Find(&events).Error)
+		require.Len(t, events, 1)
+		assert.Equal(t, user.ID, *events[0].ActorID)
+	})
+
+	t.Run("unarchived epics are listed again", func(t *testing.T) {
+		entity, err := svc.SetArchived(ctx, models.EntityTypeEpic, "EP-001", false, user.ID)
+		require.NoError(t, err)
+		assert.Nil(t, entity.(*models.Epic).ArchivedAt)
+
+		_, total, err := entities.Epic.ListEpics(ctx, EpicFilters{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("archives user stories", func(t *testing.T) {
+		_, err := svc.SetArchived(ctx, models.EntityTypeUserStory, "US-001", true, user.ID)
+		require.NoError(t, err)
+
+		_, total, err := entities.UserStory.ListUserStories(ctx, UserStoryFilters{EpicID: &epics[0].ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+
+		_, total, err = entities.UserStory.ListUserStories(ctx, UserStoryFilters{EpicID: &epics[0].ID, IncludeArchived: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrAssigneeSuggestionEntityType = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "assignees are suggested for epics, user stories and requirements only")
+)
+
+// Points that make up the score of a suggested assignee
+const (
+	suggestionBaseScore            = 40
+	suggestionUserRolePoints       = 10 // Users are preferred over administrators, who tend to oversee rather than implement
+	suggestionPointsPerEpicChange  = 5
+	suggestionMaxEpicChangePoints  = 50
+	suggestionPointsPerOpenItem    = 5
+	suggestionMaxWorkloadPoints    = 50
+	suggestionEpicActivityDays     = 90
+	defaultAssigneeSuggestionLimit = 5
+	maxAssigneeSuggestionLimit     = 20
+)
+
+// suggestionRoles are the roles that can edit entities and so be assigned to them
+var suggestionRoles = []models.UserRole{models.RoleUser, models.RoleAdministrator}
+
+// AssigneeSuggestion is a user suggested as the assignee of an entity, with the facts the score was computed from
+type AssigneeSuggestion struct {
+	UserID          uuid.UUID       `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username        string          `json:"username" example:"jdoe"`
+	Role            models.UserRole `json:"role" example:"User"`
+	Score           int             `json:"score" example:"75"`                      // From 0 to 100, higher is a better fit
+	OpenItems       int64           `json:"open_items" example:"3"`                  // Open epics, user stories and requirements assigned to the user, 5 points off each up to 50
+	EpicChanges     int64           `json:"epic_changes" example:"7"`                // Changes the user made in the same epic in the last 90 days, 5 points each up to 50
+	CurrentAssignee bool            `json:"current_assignee" example:"false"`        // Whether the user is assigned to the entity already
+	Reasons         []string        `json:"reasons" example:"3 open assigned items"` // Justification of the score, one line per factor
+}
+
+// AssigneeSuggestions is the ranked list of users suggested as the assignee of an entity
+// @Description Users who can edit the entity, best fit first. The score starts at 40, adds 10 for the User role, 5 per change in the same epic in the last 90 days up to 50, and subtracts 5 per open assigned item up to 50.
+type AssigneeSuggestions struct {
+	EntityType      models.EntityType    `json:"entity_type" example:"requirement"`
+	EntityID        uuid.UUID            `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	EpicID          uuid.UUID            `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174002"`
+	EpicReferenceID string               `json:"epic_reference_id" example:"EP-001"`
+	Suggestions     []AssigneeSuggestion `json:"suggestions"`
+}
+
+// AssigneeSuggestionService defines the interface for suggesting assignees by workload, activity and role, for triage
+type AssigneeSuggestionService interface {
+	SuggestAssignees(ctx context.Context, entityType models.EntityType, idOrReference string, limit int) (*AssigneeSuggestions, error)
+}
+
+// assigneeSuggestionService implements AssigneeSuggestionService interface
+type assigneeSuggestionService struct {
+	repos *repository.Repositories
+	now   func() time.Time
+}
+
+// NewAssigneeSuggestionService creates a new assignee suggestion service instance
+func NewAssigneeSuggestionService(repos *repository.Repositories) AssigneeSuggestionService {
+	return &assigneeSuggestionService{repos: repos, now: time.Now}
+}
+
+// SuggestAssignees ranks the active users who can edit an entity by score, highest first; ties go to the user
+// with fewer open items, then to the username. Commenters and deactivated users are never suggested.
+func (s *assigneeSuggestionService) SuggestAssignees(ctx context.Context, entityType models.EntityType, idOrReference string, limit int) (*AssigneeSuggestions, error) {
+	switch entityType {
+	case models.EntityTypeEpic, models.EntityTypeUserStory, models.EntityTypeRequirement:
+	default:
+		return nil, ErrAssigneeSuggestionEntityType
+	}
+	if limit <= 0 {
+		limit = defaultAssigneeSuggestionLimit
+	}
+	if limit > maxAssigneeSuggestionLimit {
+		limit = maxAssigneeSuggestionLimit
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	epic, assigneeID, err := s.getEpicAndAssignee(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := s.repos.AssigneeSuggestion.ListCandidates(ctx, suggestionRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignee candidates: %w", err)
+	}
+	since := s.now().UTC().AddDate(0, 0, -suggestionEpicActivityDays)
+	changes, err := s.repos.AssigneeSuggestion.CountEpicChanges(ctx, epic.ID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count epic changes: %w", err)
+	}
+
+	suggestions := make([]AssigneeSuggestion, 0, len(candidates))
+	for _, candidate := range candidates {
+		suggestion := scoreAssigneeCandidate(candidate, changes[candidate.UserID], epic.ReferenceID)
+		suggestion.CurrentAssignee = candidate.UserID == assigneeID
+		suggestions = append(suggestions, suggestion)
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].OpenItems < suggestions[j].OpenItems
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return &AssigneeSuggestions{
+		EntityType:      entityType,
+		EntityID:        entityID,
+		EpicID:          epic.ID,
+		EpicReferenceID: epic.ReferenceID,
+		Suggestions:     suggestions,
+	}, nil
+}
+
+// getEpicAndAssignee returns the epic an entity belongs to, or the epic itself, and the current assignee of the entity
+func (s *assigneeSuggestionService) getEpicAndAssignee(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.Epic, uuid.UUID, error) {
+	epicID := entityID
+	var assigneeID uuid.UUID
+	switch entityType {
+	case models.EntityTypeUserStory:
+		userStory, err := s.repos.UserStory.GetByID(ctx, entityID)
+		if err != nil {
+			return nil, uuid.Nil, s.notFound(err, "user story")
+		}
+		epicID, assigneeID = userStory.EpicID, userStory.AssigneeID
+	case models.EntityTypeRequirement:
+		requirement, err := s.repos.Requirement.GetByID(ctx, entityID)
+		if err != nil {
+			return nil, uuid.Nil, s.notFound(err, "requirement")
+		}
+		userStory, err := s.repos.UserStory.GetByID(ctx, requirement.UserStoryID)
+		if err != nil {
+			return nil, uuid.Nil, s.notFound(err, "user story")
+		}
+		epicID, assigneeID = userStory.EpicID, requirement.AssigneeID
+	}
+
+	epic, err := s.repos.Epic.GetByID(ctx, epicID)
+	if err != nil {
+		return nil, uuid.Nil, s.notFound(err, "epic")
+	}
+	if entityType == models.EntityTypeEpic {
+		assigneeID = epic.AssigneeID
+	}
+	return epic, assigneeID, nil
+}
+
+// notFound maps a missing entity to ErrNotFound and wraps other lookup failures
+func (s *assigneeSuggestionService) notFound(err error, entity string) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+	return fmt.Errorf("failed to get %s: %w", entity, err)
+}
+
+// scoreAssigneeCandidate computes the score of a candidate and explains each factor
+func scoreAssigneeCandidate(candidate repository.AssigneeCandidate, epicChanges int64, epicReferenceID string) AssigneeSuggestion {
+	score := suggestionBaseScore
+	reasons := make([]string, 0, 3)
+
+	if candidate.Role == models.RoleUser {
+		score += suggestionUserRolePoints
+		reasons = append(reasons, "User role")
+	} else {
+		reasons = append(reasons, string(candidate.Role)+" role")
+	}
+
+	if epicChanges > 0 {
+		activityPoints := int(epicChanges) * suggestionPointsPerEpicChange
+		if activityPoints > suggestionMaxEpicChangePoints {
+			activityPoints = suggestionMaxEpicChangePoints
+		}
+		score += activityPoints
+		reasons = append(reasons, fmt.Sprintf("%d changes in %s in the last %d days", epicChanges, epicReferenceID, suggestionEpicActivityDays))
+	} else {
+		reasons = append(reasons, fmt.Sprintf("No recent changes in %s", epicReferenceID))
+	}
+
+	workloadPoints := int(candidate.OpenItems) * suggestionPointsPerOpenItem
+	if workloadPoints > suggestionMaxWorkloadPoints {
+		workloadPoints = suggestionMaxWorkloadPoints
+	}
+	score -= workloadPoints
+	if score < 0 {
+		score = 0
+	}
+	reasons = append(reasons, fmt.Sprintf("%d open assigned items", candidate.OpenItems))
+
+	return AssigneeSuggestion{
+		UserID:      candidate.UserID,
+		Username:    candidate.Username,
+		Role:        candidate.Role,
+		Score:       score,
+		OpenItems:   candidate.OpenItems,
+		EpicChanges: epicChanges,
+		Reasons:     reasons,
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestScoreAssigneeCandidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		candidate   repository.AssigneeCandidate
+		epicChanges int64
+		score       int
+	}{
+		{"idle user", repository.AssigneeCandidate{Role: models.RoleUser}, 0, 50},
+		{"administrator", repository.AssigneeCandidate{Role: models.RoleAdministrator}, 0, 40},
+		{"active in the epic", repository.AssigneeCandidate{Role: models.RoleUser, OpenItems: 2}, 3, 55},
+		{"activity points are capped", repository.AssigneeCandidate{Role: models.RoleUser}, 40, 100},
+		{"score does not go below zero", repository.AssigneeCandidate{Role: models.RoleAdministrator, OpenItems: 30}, 0, 0},
+	}
+	for _, tt := range tests {
+		suggestion := scoreAssigneeCandidate(tt.candidate, tt.epicChanges, "EP-001")
+		assert.Equal(t, tt.score, suggestion.Score, tt.name)
+		assert.Len(t, suggestion.Reasons, 3, tt.name)
+	}
+}
+
+func TestAssigneeSuggestionService(t *testing.T) {
+	ctx := context.Background()
+	draft, active := models.RequirementStatusDraft, models.RequirementStatusActive
+	db, alice, epic, requirements := setupSupersessionTest(t, draft, active)
+	require.NoError(t, db.AutoMigrate(&models.AuditEvent{}))
+
+	// alice is assigned to EP-001, US-001 and both requirements
+	deactivatedAt := time.Now()
+	users := map[string]*models.User{
+		"bob":   {Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleUser},
+		"carol": {Username: "carol", Email: "carol@example.com", PasswordHash: "hash", Role: models.RoleAdministrator},
+		"dave":  {Username: "dave", Email: "dave@example.com", PasswordHash: "hash", Role: models.RoleCommenter},
+		"erin":  {Username: "erin", Email: "erin@example.com", PasswordHash: "hash", Role: models.RoleUser, DeactivatedAt: &deactivatedAt},
+	}
+	for _, user := range users {
+		require.NoError(t, db.Create(user).Error)
+	}
+
+	change := func(actor *models.User, entityType models.EntityType, entityID uuid.UUID, at time.Time) {
+		require.NoError(t, db.Create(&models.AuditEvent{EntityType: entityType, EntityID: entityID,
+			Action: models.AuditActionEdited, ActorID: &actor.ID, CreatedAt: at}).Error)
+	}
+	now := time.Now().UTC()
+	for i := 0; i < 12; i++ {
+		change(users["carol"], models.EntityTypeEpic, epic.ID, now.Add(-time.Hour))
+	}
+	change(alice, models.EntityTypeRequirement, requirements[0].ID, now.Add(-time.Hour))
+	change(alice, models.EntityTypeUserStory, requirements[0].UserStoryID, now.Add(-time.Hour))
+	change(users["bob"], models.EntityTypeRequirement, requirements[0].ID, now.AddDate(0, 0, -120))
+	change(users["bob"], models.EntityTypeEpic, uuid.New(), now.Add(-time.Hour))
+
+	svc := NewAssigneeSuggestionService(repository.NewRepositories(db, nil))
+
+	t.Run("ranks by workload, activity in the epic and role", func(t *testing.T) {
+		result, err := svc.SuggestAssignees(ctx, models.EntityTypeRequirement, "REQ-001", 0)
+		require.NoError(t, err)
+		assert.Equal(t, requirements[0].ID, result.EntityID)
+		assert.Equal(t, epic.ID, result.EpicID)
+		assert.Equal(t, "EP-001", result.EpicReferenceID)
+
+		require.Len(t, result.Suggestions, 3)
+		carol, bob, first := result.Suggestions[0], result.Suggestions[1], result.Suggestions[2]
+		assert.Equal(t, "carol", carol.Username)
+		assert.Equal(t, 90, carol.Score)
+		assert.Equal(t, int64(12), carol.EpicChanges)
+		assert.Equal(t, "bob", bob.Username)
+		assert.Equal(t, 50, bob.Score)
+		assert.Equal(t, int64(0), bob.EpicChanges)
+
+		assert.Equal(t, alice.ID, first.UserID)
+		assert.Equal(t, int64(4), first.OpenItems)
+		assert.Equal(t, int64(2), first.EpicChanges)
+		assert.Equal(t, 40, first.Score)
+		assert.True(t, first.CurrentAssignee)
+		assert.False(t, carol.CurrentAssignee)
+		assert.Equal(t, []string{"User role", "2 changes in EP-001 in the last 90 days", "4 open assigned items"}, first.Reasons)
+	})
+
+	t.Run("suggests assignees for epics and user stories", func(t *testing.T) {
+		result, err := svc.SuggestAssignees(ctx, models.EntityTypeEpic, "EP-001", 1)
+		require.NoError(t, err)
+		require.Len(t, result.Suggestions, 1)
+		assert.Equal(t, "carol", result.Suggestions[0].Username)
+
+		result, err = svc.SuggestAssignees(ctx, models.EntityTypeUserStory, requirements[0].UserStoryID.String(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, epic.ID, result.EpicID)
+		assert.Len(t, result.Suggestions, 3)
+	})
+
+	t.Run("rejects other entities", func(t *testing.T) {
+		_, err := svc.SuggestAssignees(ctx, models.EntityTypeAcceptanceCriteria, "AC-001", 0)
+		assert.ErrorIs(t, err, ErrAssigneeSuggestionEntityType)
+		_, err = svc.SuggestAssignees(ctx, models.EntityTypeRequirement, "REQ-404", 0)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
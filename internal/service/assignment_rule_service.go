@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrAssignmentRuleNotFound         = apperrors.New(apperrors.KindNotFound, "ASSIGNMENT_RULE_NOT_FOUND", "assignment rule not found")
+	ErrAssignmentRuleNameRequired     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "assignment rule name is required")
+	ErrAssignmentRuleEntityType       = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "entity_type must be one of: epic, user_story, requirement")
+	ErrAssignmentRuleEpicCondition    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "epic conditions apply to user stories and requirements only")
+	ErrAssignmentRuleTypeCondition    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "requirement type conditions apply to requirements only")
+	ErrAssignmentRuleCreatorRole      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "creator_role must be one of: Administrator, User, Commenter")
+	ErrAssignmentRuleAssigneeInactive = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "assignee is deactivated")
+)
+
+// AssignmentRuleEvaluator chooses the assignee of new entities created without one
+type AssignmentRuleEvaluator interface {
+	// DefaultAssignee returns the assignee of the first matching rule, or nil when no rule matches
+	DefaultAssignee(ctx context.Context, subject AssignmentSubject) (*uuid.UUID, error)
+}
+
+// AssignmentRuleService defines the interface for managing and evaluating assignment rules
+type AssignmentRuleService interface {
+	AssignmentRuleEvaluator
+	CreateRule(ctx context.Context, req AssignmentRuleRequest, creatorID uuid.UUID) (*models.AssignmentRule, error)
+	GetRule(ctx context.Context, id uuid.UUID) (*models.AssignmentRule, error)
+	UpdateRule(ctx context.Context, id uuid.UUID, req AssignmentRuleRequest) (*models.AssignmentRule, error)
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+	ListRules(ctx context.Context, entityType *models.EntityType) ([]models.AssignmentRule, error)
+	Evaluate(ctx context.Context, req EvaluateAssignmentRulesRequest) (*AssignmentRuleEvaluation, error)
+}
+
+// AssignmentRuleRequest represents the request to create or replace an assignment rule
+// @Description Assignment rule; conditions that are omitted match any entity. Updates replace the whole rule.
+type AssignmentRuleRequest struct {
+	Name              string            `json:"name" binding:"required,max=255" example:"Checkout requirements to Alice"`
+	EntityType        models.EntityType `json:"entity_type" binding:"required" example:"requirement"`
+	Priority          int               `json:"priority" example:"10"`                      // Lower values are evaluated first
+	Enabled           *bool             `json:"enabled,omitempty" example:"true"`           // Defaults to true
+	Epic              *string           `json:"epic,omitempty" example:"EP-001"`            // Epic UUID or reference ID
+	CreatorRole       *models.UserRole  `json:"creator_role,omitempty" example:"Commenter"` // Role of the creating user
+	RequirementTypeID *uuid.UUID        `json:"requirement_type_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`
+	AssigneeID        uuid.UUID         `json:"assignee_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174003"`
+}
+
+// EvaluateAssignmentRulesRequest describes an entity to evaluate the rules for without creating it
+// @Description Entity that would be created; user stories name their epic and requirements their user story
+type EvaluateAssignmentRulesRequest struct {
+	EntityType        models.EntityType `json:"entity_type" binding:"required" example:"requirement"`
+	CreatorID         uuid.UUID         `json:"creator_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174004"`
+	Epic              *string           `json:"epic,omitempty" example:"EP-001"`       // Epic UUID or reference ID, for user stories
+	UserStory         *string           `json:"user_story,omitempty" example:"US-001"` // User story UUID or reference ID, for requirements
+	RequirementTypeID *uuid.UUID        `json:"requirement_type_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`
+}
+
+// AssignmentSubject is a new entity that assignment rules are evaluated for
+type AssignmentSubject struct {
+	EntityType        models.EntityType
+	CreatorID         uuid.UUID
+	EpicID            *uuid.UUID // Epic of a user story
+	UserStoryID       *uuid.UUID // User story of a requirement; its epic is looked up
+	RequirementTypeID *uuid.UUID
+}
+
+// AssignmentRuleEvaluation is the outcome of a dry run of the assignment rules
+// @Description Assignee a new entity would get and why; without a matching rule the creator is the assignee
+type AssignmentRuleEvaluation struct {
+	AssigneeID  uuid.UUID                `json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174003"`
+	MatchedRule *models.AssignmentRule   `json:"matched_rule,omitempty"`
+	Rules       []AssignmentRuleDecision `json:"rules"`
+}
+
+// AssignmentRuleDecision is the result of one rule in evaluation order
+type AssignmentRuleDecision struct {
+	RuleID   uuid.UUID `json:"rule_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name     string    `json:"name" example:"Checkout requirements to Alice"`
+	Priority int       `json:"priority" example:"10"`
+	Matched  bool      `json:"matched" example:"false"`
+	Reason   string    `json:"reason,omitempty" example:"epic does not match"` // Why the rule was skipped
+}
+
+// assignmentRuleService implements AssignmentRuleService interface
+type assignmentRuleService struct {
+	repos *repository.Repositories
+}
+
+// NewAssignmentRuleService creates a new assignment rule service instance
+func NewAssignmentRuleService(repos *repository.Repositories) AssignmentRuleService {
+	return &assignmentRuleService{repos: repos}
+}
+
+// CreateRule adds an assignment rule
+func (s *assignmentRuleService) CreateRule(ctx context.Context, req AssignmentRuleRequest, creatorID uuid.UUID) (*models.AssignmentRule, error) {
+	rule := &models.AssignmentRule{CreatorID: creatorID}
+	if err := s.applyRequest(ctx, rule, req); err != nil {
+		return nil, err
+	}
+	if err := s.repos.AssignmentRule.Create(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to create assignment rule: %w", err)
+	}
+	return s.GetRule(ctx, rule.ID)
+}
+
+// GetRule retrieves an assignment rule by ID with its assignee
+func (s *assignmentRuleService) GetRule(ctx context.Context, id uuid.UUID) (*models.AssignmentRule, error) {
+	rule, err := s.repos.AssignmentRule.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrAssignmentRuleNotFound
+		}
+		return nil, fmt.Errorf("failed to get assignment rule: %w", err)
+	}
+	if rule.Assignee, err = s.repos.User.GetByID(ctx, rule.AssigneeID); err != nil {
+		return nil, fmt.Errorf("failed to get assignment rule assignee: %w", err)
+	}
+	return rule, nil
+}
+
+// UpdateRule replaces the conditions, order and assignee of an assignment rule
+func (s *assignmentRuleService) UpdateRule(ctx context.Context, id uuid.UUID, req AssignmentRuleRequest) (*models.AssignmentRule, error) {
+	rule, err := s.GetRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyRequest(ctx, rule, req); err != nil {
+		return nil, err
+	}
+	rule.Assignee = nil
+	if err := s.repos.AssignmentRule.Update(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to update assignment rule: %w", err)
+	}
+	return s.GetRule(ctx, rule.ID)
+}
+
+// DeleteRule removes an assignment rule
+func (s *assignmentRuleService) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetRule(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repos.AssignmentRule.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete assignment rule: %w", err)
+	}
+	return nil
+}
+
+// ListRules returns the rules in evaluation order, optionally of one entity type
+func (s *assignmentRuleService) ListRules(ctx context.Context, entityType *models.EntityType) ([]models.AssignmentRule, error) {
+	if entityType != nil && !isAssignableEntityType(*entityType) {
+		return nil, ErrAssignmentRuleEntityType
+	}
+	rules, err := s.repos.AssignmentRule.ListOrdered(ctx, entityType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignment rules: %w", err)
+	}
+	return rules, nil
+}
+
+// applyRequest validates a rule request and copies it to the rule
+func (s *assignmentRuleService) applyRequest(ctx context.Context, rule *models.AssignmentRule, req AssignmentRuleRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return ErrAssignmentRuleNameRequired
+	}
+	if !isAssignableEntityType(req.EntityType) {
+		return ErrAssignmentRuleEntityType
+	}
+	if req.CreatorRole != nil && !req.CreatorRole.IsValid() {
+		return ErrAssignmentRuleCreatorRole
+	}
+
+	var epicID *uuid.UUID
+	if req.Epic != nil && strings.TrimSpace(*req.Epic) != "" {
+		if req.EntityType == models.EntityTypeEpic {
+			return ErrAssignmentRuleEpicCondition
+		}
+		id, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, strings.TrimSpace(*req.Epic))
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return ErrEpicNotFound
+			}
+			return err
+		}
+		epicID = &id
+	}
+	if req.RequirementTypeID != nil {
+		if req.EntityType != models.EntityTypeRequirement {
+			return ErrAssignmentRuleTypeCondition
+		}
+		if exists, err := s.repos.RequirementType.Exists(ctx, *req.RequirementTypeID); err != nil {
+			return fmt.Errorf("failed to check requirement type existence: %w", err)
+		} else if !exists {
+			return ErrRequirementTypeNotFound
+		}
+	}
+
+	assignee, err := s.repos.User.GetByID(ctx, req.AssigneeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return fmt.Errorf("failed to get assignee: %w", err)
+	}
+	if assignee.DeactivatedAt != nil {
+		return ErrAssignmentRuleAssigneeInactive
+	}
+
+	rule.Name = name
+	rule.EntityType = req.EntityType
+	rule.Priority = req.Priority
+	rule.Enabled = req.Enabled == nil || *req.Enabled
+	rule.EpicID = epicID
+	rule.CreatorRole = req.CreatorRole
+	rule.RequirementTypeID = req.RequirementTypeID
+	rule.AssigneeID = req.AssigneeID
+	return nil
+}
+
+// Evaluate runs the rules for an entity without creating it and explains the decision of each rule
+func (s *assignmentRuleService) Evaluate(ctx context.Context, req EvaluateAssignmentRulesRequest) (*AssignmentRuleEvaluation, error) {
+	subject := AssignmentSubject{
+		EntityType:        req.EntityType,
+		CreatorID:         req.CreatorID,
+		RequirementTypeID: req.RequirementTypeID,
+	}
+	if req.Epic != nil && *req.Epic != "" {
+		id, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, *req.Epic)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil, ErrEpicNotFound
+			}
+			return nil, err
+		}
+		subject.EpicID = &id
+	}
+	if req.UserStory != nil && *req.UserStory != "" {
+		id, err := resolveEntityID(ctx, s.repos, models.EntityTypeUserStory, *req.UserStory)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil, ErrUserStoryNotFound
+			}
+			return nil, err
+		}
+		subject.UserStoryID = &id
+	}
+	return s.evaluate(ctx, subject)
+}
+
+// DefaultAssignee returns the assignee of the first matching rule, or nil when no rule matches
+func (s *assignmentRuleService) DefaultAssignee(ctx context.Context, subject AssignmentSubject) (*uuid.UUID, error) {
+	evaluation, err := s.evaluate(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	if evaluation.MatchedRule == nil {
+		return nil, nil
+	}
+	return &evaluation.AssigneeID, nil
+}
+
+// evaluate decides every rule of the subject's entity type in order; the first match assigns the entity
+func (s *assignmentRuleService) evaluate(ctx context.Context, subject AssignmentSubject) (*AssignmentRuleEvaluation, error) {
+	if !isAssignableEntityType(subject.EntityType) {
+		return nil, ErrAssignmentRuleEntityType
+	}
+	creator, err := s.repos.User.GetByID(ctx, subject.CreatorID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get creator: %w", err)
+	}
+	if subject.EpicID == nil && subject.UserStoryID != nil {
+		userStory, err := s.repos.UserStory.GetByID(ctx, *subject.UserStoryID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, ErrUserStoryNotFound
+			}
+			return nil, fmt.Errorf("failed to get user story: %w", err)
+		}
+		subject.EpicID = &userStory.EpicID
+	}
+
+	rules, err := s.repos.AssignmentRule.ListOrdered(ctx, &subject.EntityType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignment rules: %w", err)
+	}
+
+	evaluation := &AssignmentRuleEvaluation{AssigneeID: subject.CreatorID, Rules: []AssignmentRuleDecision{}}
+	for i := range rules {
+		rule := &rules[i]
+		decision := AssignmentRuleDecision{RuleID: rule.ID, Name: rule.Name, Priority: rule.Priority}
+		decision.Reason = assignmentRuleMismatch(rule, subject, creator.Role)
+		if decision.Reason == "" && evaluation.MatchedRule != nil {
+			decision.Reason = "an earlier rule matched"
+		}
+		if decision.Reason == "" {
+			decision.Matched = true
+			evaluation.MatchedRule = rule
+			evaluation.AssigneeID = rule.AssigneeID
+		}
+		evaluation.Rules = append(evaluation.Rules, decision)
+	}
+	return evaluation, nil
+}
+
+// assignmentRuleMismatch returns why a rule does not apply to the subject, or "" when it does
+func assignmentRuleMismatch(rule *models.AssignmentRule, subject AssignmentSubject, creatorRole models.UserRole) string {
+	switch {
+	case !rule.Enabled:
+		return "rule is disabled"
+	case rule.EpicID != nil && (subject.EpicID == nil || *rule.EpicID != *subject.EpicID):
+		return "epic does not match"
+	case rule.CreatorRole != nil && *rule.CreatorRole != creatorRole:
+		return "creator role does not match"
+	case rule.RequirementTypeID != nil && (subject.RequirementTypeID == nil || *rule.RequirementTypeID != *subject.RequirementTypeID):
+		return "requirement type does not match"
+	case rule.Assignee != nil && rule.Assignee.DeactivatedAt != nil:
+		return "assignee is deactivated"
+	}
+	return ""
+}
+
+// isAssignableEntityType reports whether entities of the type have an assignee
+func isAssignableEntityType(entityType models.EntityType) bool {
+	switch entityType {
+	case models.EntityTypeEpic, models.EntityTypeUserStory, models.EntityTypeRequirement:
+		return true
+	}
+	return false
+}
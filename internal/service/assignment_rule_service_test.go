@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestAssignmentRuleService(t *testing.T) {
+	ctx := context.Background()
+	useSequentialReferenceIDs(t)
+	db, alice, epic, _ := setupSupersessionTest(t)
+	require.NoError(t, db.AutoMigrate(&models.AssignmentRule{}, &models.RequirementType{}))
+	functional := &models.RequirementType{Name: "Functional"}
+	require.NoError(t, db.Create(functional).Error)
+
+	newUser := func(username string, role models.UserRole) *models.User {
+		user := &models.User{Username: username, Email: username + "@example.com", PasswordHash: "hash", Role: role}
+		require.NoError(t, db.Create(user).Error)
+		return user
+	}
+	bob, triage, carol := newUser("bob", models.RoleUser), newUser("triage", models.RoleUser), newUser("carol", models.RoleCommenter)
+
+	repos := repository.NewRepositories(db, nil)
+	svc := NewAssignmentRuleService(repos)
+	commenter, disabled := models.RoleCommenter, false
+	epicRef := "EP-001"
+
+	checkoutRule, err := svc.CreateRule(ctx, AssignmentRuleRequest{
+		Name: "Checkout requirements", EntityType: models.EntityTypeRequirement, Priority: 10, Epic: &epicRef, AssigneeID: bob.ID,
+	}, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, epic.ID, *checkoutRule.EpicID)
+	assert.True(t, checkoutRule.Enabled)
+	assert.Equal(t, "bob", checkoutRule.Assignee.Username)
+
+	_, err = svc.CreateRule(ctx, AssignmentRuleRequest{
+		Name: "Commenter stories to triage", EntityType: models.EntityTypeUserStory, Priority: 5, CreatorRole: &commenter, AssigneeID: triage.ID,
+	}, alice.ID)
+	require.NoError(t, err)
+	disabledRule, err := svc.CreateRule(ctx, AssignmentRuleRequest{
+		Name: "Paused", EntityType: models.EntityTypeRequirement, Priority: 1, Enabled: &disabled, AssigneeID: carol.ID,
+	}, alice.ID)
+	require.NoError(t, err)
+	assert.False(t, disabledRule.Enabled)
+
+	t.Run("assigns new entities without an assignee", func(t *testing.T) {
+		services := NewEntityServices(repos)
+		description := "As a shopper, I want to pay, so that I get my order"
+
+		story, err := services.UserStory.CreateUserStory(ctx, CreateUserStoryRequest{
+			EpicID: epic.ID, CreatorID: carol.ID, Priority: models.PriorityMedium, Title: "Pay", Description: &description,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, triage.ID, story.AssigneeID)
+
+		story, err = services.UserStory.CreateUserStory(ctx, CreateUserStoryRequest{
+			EpicID: epic.ID, CreatorID: alice.ID, Priority: models.PriorityMedium, Title: "Refund", Description: &description,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID, story.AssigneeID, "no rule matches, so the creator is the assignee")
+
+		requirement, err := services.Requirement.CreateRequirement(ctx, CreateRequirementRequest{
+			UserStoryID: story.ID, CreatorID: alice.ID, Priority: models.PriorityHigh, TypeID: functional.ID, Title: "Refund within 14 days",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, bob.ID, requirement.AssigneeID)
+
+		requirement, err = services.Requirement.CreateRequirement(ctx, CreateRequirementRequest{
+			UserStoryID: story.ID, CreatorID: alice.ID, AssigneeID: &alice.ID, Priority: models.PriorityHigh, TypeID: functional.ID, Title: "Refund audit",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID, requirement.AssigneeID, "an explicit assignee wins over the rules")
+	})
+
+	t.Run("explains the decision of each rule in a dry run", func(t *testing.T) {
+		userStory := "US-001"
+		evaluation, err := svc.Evaluate(ctx, EvaluateAssignmentRulesRequest{
+			EntityType: models.EntityTypeRequirement, CreatorID: alice.ID, UserStory: &userStory, RequirementTypeID: &functional.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, bob.ID, evaluation.AssigneeID)
+		require.NotNil(t, evaluation.MatchedRule)
+		assert.Equal(t, checkoutRule.ID, evaluation.MatchedRule.ID)
+		require.Len(t, evaluation.Rules, 2)
+		assert.Equal(t, AssignmentRuleDecision{RuleID: disabledRule.ID, Name: "Paused", Priority: 1, Reason: "rule is disabled"}, evaluation.Rules[0])
+		assert.True(t, evaluation.Rules[1].Matched)
+
+		evaluation, err = svc.Evaluate(ctx, EvaluateAssignmentRulesRequest{EntityType: models.EntityTypeRequirement, CreatorID: alice.ID})
+		require.NoError(t, err)
+		assert.Nil(t, evaluation.MatchedRule)
+		assert.Equal(t, alice.ID, evaluation.AssigneeID)
+		assert.Equal(t, "epic does not match", evaluation.Rules[1].Reason)
+	})
+
+	t.Run("skips rules of deactivated assignees", func(t *testing.T) {
+		require.NoError(t, db.Model(bob).Update("deactivated_at", time.Now()).Error)
+		t.Cleanup(func() { require.NoError(t, db.Model(bob).Update("deactivated_at", nil).Error) })
+
+		userStory := "US-001"
+		evaluation, err := svc.Evaluate(ctx, EvaluateAssignmentRulesRequest{EntityType: models.EntityTypeRequirement, CreatorID: alice.ID, UserStory: &userStory})
+		require.NoError(t, err)
+		assert.Nil(t, evaluation.MatchedRule)
+		assert.Equal(t, "assignee is deactivated", evaluation.Rules[1].Reason)
+	})
+
+	t.Run("lists rules in evaluation order", func(t *testing.T) {
+		rules, err := svc.ListRules(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, rules, 3)
+		assert.Equal(t, []string{"Paused", "Commenter stories to triage", "Checkout requirements"},
+			[]string{rules[0].Name, rules[1].Name, rules[2].Name})
+
+		storyType := models.EntityTypeUserStory
+		rules, err = svc.ListRules(ctx, &storyType)
+		require.NoError(t, err)
+		assert.Len(t, rules, 1)
+	})
+
+	t.Run("validates rules", func(t *testing.T) {
+		_, err := svc.CreateRule(ctx, AssignmentRuleRequest{Name: "Epics", EntityType: models.EntityTypeEpic, Epic: &epicRef, AssigneeID: bob.ID}, alice.ID)
+		assert.ErrorIs(t, err, ErrAssignmentRuleEpicCondition)
+		_, err = svc.CreateRule(ctx, AssignmentRuleRequest{Name: "Stories", EntityType: models.EntityTypeUserStory, RequirementTypeID: &functional.ID, AssigneeID: bob.ID}, alice.ID)
+		assert.ErrorIs(t, err, ErrAssignmentRuleTypeCondition)
+		_, err = svc.CreateRule(ctx, AssignmentRuleRequest{Name: "Criteria", EntityType: models.EntityTypeAcceptanceCriteria, AssigneeID: bob.ID}, alice.ID)
+		assert.ErrorIs(t, err, ErrAssignmentRuleEntityType)
+		_, err = svc.CreateRule(ctx, AssignmentRuleRequest{Name: "Nobody", EntityType: models.EntityTypeEpic, AssigneeID: uuid.New()}, alice.ID)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+		missing := "EP-404"
+		_, err = svc.CreateRule(ctx, AssignmentRuleRequest{Name: "Missing", EntityType: models.EntityTypeRequirement, Epic: &missing, AssigneeID: bob.ID}, alice.ID)
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+
+	t.Run("replaces and deletes rules", func(t *testing.T) {
+		updated, err := svc.UpdateRule(ctx, checkoutRule.ID, AssignmentRuleRequest{
+			Name: "All requirements", EntityType: models.EntityTypeRequirement, Priority: 20, AssigneeID: triage.ID,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, updated.EpicID, "omitted conditions are cleared")
+		assert.Equal(t, "triage", updated.Assignee.Username)
+
+		require.NoError(t, svc.DeleteRule(ctx, checkoutRule.ID))
+		_, err = svc.GetRule(ctx, checkoutRule.ID)
+		assert.ErrorIs(t, err, ErrAssignmentRuleNotFound)
+	})
+}
@@ -0,0 +1,443 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrBoardNotSupported    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "boards are only available for epics, user stories and requirements")
+	ErrInvalidBoardGroupBy  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "group_by must be one of: status, priority, assignee")
+	ErrBoardCardNotInColumn = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "before_id must reference another card in the target column")
+)
+
+// Board column groupings
+const (
+	BoardGroupByStatus   = "status"
+	BoardGroupByPriority = "priority"
+	BoardGroupByAssignee = "assignee"
+)
+
+// boardRankStep is the rank distance between consecutive cards after a column is renumbered
+const boardRankStep = 1024
+
+// BoardService defines the interface for Kanban board views of entities
+type BoardService interface {
+	GetBoard(ctx context.Context, entityType models.EntityType, query BoardQuery) (*Board, error)
+	MoveCard(ctx context.Context, req MoveBoardCardRequest) (*BoardCard, error)
+}
+
+// BoardQuery selects the cards of a board and how they are grouped into columns
+type BoardQuery struct {
+	GroupBy     string
+	EpicID      *uuid.UUID
+	UserStoryID *uuid.UUID
+	AssigneeID  *uuid.UUID
+}
+
+// Board is a set of entity cards grouped into columns
+// @Description Kanban board; with group_by=status the columns follow the entity type's default status model
+type Board struct {
+	EntityType models.EntityType `json:"entity_type" example:"user_story"`
+	GroupBy    string            `json:"group_by" example:"status"`
+	Columns    []BoardColumn     `json:"columns"`
+	TotalCount int               `json:"total_count" example:"12"`
+}
+
+// BoardColumn is a board column with its cards in board order
+// @Description Board column; wip_count is the number of cards in the column
+type BoardColumn struct {
+	Key      string      `json:"key" example:"In Progress"`
+	Name     string      `json:"name" example:"In Progress"`
+	Color    *string     `json:"color,omitempty" example:"#007bff"`
+	IsFinal  bool        `json:"is_final" example:"false"`
+	WIPCount int         `json:"wip_count" example:"3"`
+	Cards    []BoardCard `json:"cards"`
+}
+
+// BoardCard is an entity shown on a board
+// @Description Board card; cards that were never moved have no rank and follow ranked cards by priority and age
+type BoardCard struct {
+	ID          uuid.UUID       `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string          `json:"reference_id" example:"US-001"`
+	Title       string          `json:"title" example:"User login with email"`
+	Status      string          `json:"status" example:"In Progress"`
+	Priority    models.Priority `json:"priority" example:"2"`
+	AssigneeID  uuid.UUID       `json:"assignee_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Rank        *int            `json:"rank,omitempty" example:"2048"`
+	createdAt   time.Time
+}
+
+// MoveBoardCardRequest represents a request to move a card to a status column and position
+// @Description Move a card: change its status (optional) and place it before another card of the target column, or at the bottom when before_id is omitted
+type MoveBoardCardRequest struct {
+	EntityType models.EntityType `json:"entity_type" binding:"required" example:"user_story"`
+	EntityID   string            `json:"entity_id" binding:"required" example:"US-001"`
+	Status     string            `json:"status,omitempty" example:"In Progress"`
+	BeforeID   *uuid.UUID        `json:"before_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`
+}
+
+// boardService implements BoardService interface
+type boardService struct {
+	repos              *repository.Repositories
+	epicService        EpicService
+	userStoryService   UserStoryService
+	requirementService RequirementService
+}
+
+// NewBoardService creates a new board service instance
+func NewBoardService(
+	repos *repository.Repositories,
+	epicService EpicService,
+	userStoryService UserStoryService,
+	requirementService RequirementService,
+) BoardService {
+	return &boardService{
+		repos:              repos,
+		epicService:        epicService,
+		userStoryService:   userStoryService,
+		requirementService: requirementService,
+	}
+}
+
+// GetBoard returns the cards of an entity type grouped into columns
+func (s *boardService) GetBoard(ctx context.Context, entityType models.EntityType, query BoardQuery) (*Board, error) {
+	if query.GroupBy == "" {
+		query.GroupBy = BoardGroupByStatus
+	}
+
+	cards, err := s.loadCards(ctx, entityType, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []BoardColumn
+	switch query.GroupBy {
+	case BoardGroupByStatus:
+		columns, err = s.statusColumns(ctx, entityType, cards)
+	case BoardGroupByPriority:
+		columns = priorityColumns(cards)
+	case BoardGroupByAssignee:
+		columns, err = s.assigneeColumns(ctx, cards)
+	default:
+		return nil, ErrInvalidBoardGroupBy
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Board{
+		EntityType: entityType,
+		GroupBy:    query.GroupBy,
+		Columns:    columns,
+		TotalCount: len(cards),
+	}, nil
+}
+
+// MoveCard changes the status of a card if requested and places it before another card of the
+// target column, renumbering the column. Status changes follow the same rules as the status endpoints.
+func (s *boardService) MoveCard(ctx context.Context, req MoveBoardCardRequest) (*BoardCard, error) {
+	if !isBoardEntityType(req.EntityType) {
+		return nil, ErrBoardNotSupported
+	}
+	id, err := resolveEntityID(ctx, s.repos, req.EntityType, req.EntityID)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := s.loadCards(ctx, req.EntityType, BoardQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	var card *BoardCard
+	for i := range cards {
+		if cards[i].ID == id {
+			card = &cards[i]
+			break
+		}
+	}
+	if card == nil {
+		return nil, ErrNotFound
+	}
+
+	targetStatus := card.Status
+	if req.Status != "" {
+		targetStatus = req.Status
+	}
+
+	// Cards of the target column without the moved card, in board order
+	column := make([]BoardCard, 0)
+	for _, other := range cards {
+		if other.ID != id && other.Status == targetStatus {
+			column = append(column, other)
+		}
+	}
+	position := len(column)
+	if req.BeforeID != nil {
+		position = -1
+		for i, other := range column {
+			if other.ID == *req.BeforeID {
+				position = i
+				break
+			}
+		}
+		if position < 0 {
+			return nil, ErrBoardCardNotInColumn
+		}
+	}
+
+	if targetStatus != card.Status {
+		if err := s.changeStatus(ctx, req.EntityType, id, targetStatus); err != nil {
+			return nil, err
+		}
+		card.Status = targetStatus
+	}
+
+	column = append(column[:position], append([]BoardCard{*card}, column[position:]...)...)
+	ranks := make([]models.BoardRank, len(column))
+	for i := range column {
+		ranks[i] = models.BoardRank{EntityType: req.EntityType, EntityID: column[i].ID, Rank: (i + 1) * boardRankStep}
+	}
+	if err := s.repos.BoardRank.SaveAll(ctx, ranks); err != nil {
+		return nil, fmt.Errorf("failed to save board ranks: %w", err)
+	}
+
+	rank := (position + 1) * boardRankStep
+	card.Rank = &rank
+	return card, nil
+}
+
+// changeStatus changes the status of an entity through its service
+func (s *boardService) changeStatus(ctx context.Context, entityType models.EntityType, id uuid.UUID, status string) error {
+	var err error
+	switch entityType {
+	case models.EntityTypeEpic:
+		_, err = s.epicService.ChangeEpicStatus(ctx, id, models.EpicStatus(status))
+	case models.EntityTypeUserStory:
+		_, err = s.userStoryService.ChangeUserStoryStatus(ctx, id, models.UserStoryStatus(status))
+	case models.EntityTypeRequirement:
+		_, err = s.requirementService.ChangeRequirementStatus(ctx, id, models.RequirementStatus(status))
+	default:
+		return ErrBoardNotSupported
+	}
+	return err
+}
+
+// loadCards loads the cards matching the query in board order
+func (s *boardService) loadCards(ctx context.Context, entityType models.EntityType, query BoardQuery) ([]BoardCard, error) {
+	filters := make(map[string]interface{})
+	if query.AssigneeID != nil {
+		filters["assignee_id"] = *query.AssigneeID
+	}
+
+	var cards []BoardCard
+	switch entityType {
+	case models.EntityTypeEpic:
+		epics, err := s.repos.Epic.List(ctx, filters, "created_at ASC", 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list epics: %w", err)
+		}
+		for _, epic := range epics {
+			cards = append(cards, BoardCard{ID: epic.ID, ReferenceID: epic.ReferenceID, Title: epic.Title, Status: string(epic.Status),
+				Priority: epic.Priority, AssigneeID: epic.AssigneeID, createdAt: epic.CreatedAt})
+		}
+	case models.EntityTypeUserStory:
+		if query.EpicID != nil {
+			filters["epic_id"] = *query.EpicID
+		}
+		userStories, err := s.repos.UserStory.List(ctx, filters, "created_at ASC", 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user stories: %w", err)
+		}
+		for _, userStory := range userStories {
+			cards = append(cards, BoardCard{ID: userStory.ID, ReferenceID: userStory.ReferenceID, Title: userStory.Title, Status: string(userStory.Status),
+				Priority: userStory.Priority, AssigneeID: userStory.AssigneeID, createdAt: userStory.CreatedAt})
+		}
+	case models.EntityTypeRequirement:
+		userStoryIDs := []*uuid.UUID{query.UserStoryID}
+		if query.UserStoryID == nil && query.EpicID != nil {
+			userStories, err := s.repos.UserStory.List(ctx, map[string]interface{}{"epic_id": *query.EpicID}, "", 0, 0)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list user stories: %w", err)
+			}
+			userStoryIDs = make([]*uuid.UUID, len(userStories))
+			for i := range userStories {
+				userStoryIDs[i] = &userStories[i].ID
+			}
+		}
+		for _, userStoryID := range userStoryIDs {
+			if userStoryID != nil {
+				filters["user_story_id"] = *userStoryID
+			}
+			requirements, err := s.repos.Requirement.List(ctx, filters, "created_at ASC", 0, 0)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list requirements: %w", err)
+			}
+			for _, requirement := range requirements {
+				cards = append(cards, BoardCard{ID: requirement.ID, ReferenceID: requirement.ReferenceID, Title: requirement.Title, Status: string(requirement.Status),
+					Priority: requirement.Priority, AssigneeID: requirement.AssigneeID, createdAt: requirement.CreatedAt})
+			}
+		}
+	default:
+		return nil, ErrBoardNotSupported
+	}
+
+	ranks, err := s.repos.BoardRank.ListByEntityType(ctx, entityType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list board ranks: %w", err)
+	}
+	rankByID := make(map[uuid.UUID]int, len(ranks))
+	for _, rank := range ranks {
+		rankByID[rank.EntityID] = rank.Rank
+	}
+	for i := range cards {
+		if rank, ok := rankByID[cards[i].ID]; ok {
+			cards[i].Rank = &rank
+		}
+	}
+
+	sortBoardCards(cards)
+	return cards, nil
+}
+
+// sortBoardCards orders ranked cards by rank, followed by unranked cards by priority and age
+func sortBoardCards(cards []BoardCard) {
+	sort.SliceStable(cards, func(i, j int) bool {
+		a, b := cards[i], cards[j]
+		switch {
+		case a.Rank != nil && b.Rank != nil:
+			return *a.Rank < *b.Rank
+		case a.Rank != nil || b.Rank != nil:
+			return a.Rank != nil
+		case a.Priority != b.Priority:
+			return a.Priority < b.Priority
+		case !a.createdAt.Equal(b.createdAt):
+			return a.createdAt.Before(b.createdAt)
+		default:
+			return a.ReferenceID < b.ReferenceID
+		}
+	})
+}
+
+// statusColumns groups cards by the statuses of the default status model of the entity type.
+// Cards with a status missing from the model get a column after the model's statuses.
+func (s *boardService) statusColumns(ctx context.Context, entityType models.EntityType, cards []BoardCard) ([]BoardColumn, error) {
+	var statuses []models.Status
+	statusModel, err := s.repos.StatusModel.GetDefaultByEntityType(ctx, entityType)
+	switch {
+	case err == nil:
+		statuses = statusModel.Statuses
+	case errors.Is(err, repository.ErrNotFound):
+		statuses = defaultBoardStatuses(entityType)
+	default:
+		return nil, fmt.Errorf("failed to get status model: %w", err)
+	}
+	sort.SliceStable(statuses, func(i, j int) bool { return statuses[i].Order < statuses[j].Order })
+
+	columns := make([]BoardColumn, 0, len(statuses))
+	for _, status := range statuses {
+		columns = append(columns, BoardColumn{Key: status.Name, Name: status.Name, Color: status.Color, IsFinal: status.IsFinal})
+	}
+	return groupCards(columns, cards, func(card BoardCard) (string, string) { return card.Status, card.Status }), nil
+}
+
+// priorityColumns groups cards by priority, most urgent first
+func priorityColumns(cards []BoardCard) []BoardColumn {
+	columns := []BoardColumn{
+		{Key: strconv.Itoa(int(models.PriorityCritical)), Name: "Critical"},
+		{Key: strconv.Itoa(int(models.PriorityHigh)), Name: "High"},
+		{Key: strconv.Itoa(int(models.PriorityMedium)), Name: "Medium"},
+		{Key: strconv.Itoa(int(models.PriorityLow)), Name: "Low"},
+	}
+	return groupCards(columns, cards, func(card BoardCard) (string, string) {
+		key := strconv.Itoa(int(card.Priority))
+		return key, key
+	})
+}
+
+// assigneeColumns groups cards by assignee, in username order
+func (s *boardService) assigneeColumns(ctx context.Context, cards []BoardCard) ([]BoardColumn, error) {
+	names := make(map[uuid.UUID]string)
+	for _, card := range cards {
+		if _, ok := names[card.AssigneeID]; ok {
+			continue
+		}
+		names[card.AssigneeID] = "Unassigned"
+		if card.AssigneeID == uuid.Nil {
+			continue
+		}
+		user, err := s.repos.User.GetByID(ctx, card.AssigneeID)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get assignee: %w", err)
+		}
+		if user != nil {
+			names[card.AssigneeID] = user.Username
+		}
+	}
+
+	columns := make([]BoardColumn, 0, len(names))
+	for id, name := range names {
+		columns = append(columns, BoardColumn{Key: id.String(), Name: name})
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		if columns[i].Name != columns[j].Name {
+			return columns[i].Name < columns[j].Name
+		}
+		return columns[i].Key < columns[j].Key
+	})
+	return groupCards(columns, cards, func(card BoardCard) (string, string) {
+		return card.AssigneeID.String(), names[card.AssigneeID]
+	}), nil
+}
+
+// groupCards distributes cards, already in board order, over columns by key.
+// Cards whose key has no column get a new column named after it.
+func groupCards(columns []BoardColumn, cards []BoardCard, keyOf func(BoardCard) (key, name string)) []BoardColumn {
+	index := make(map[string]int, len(columns))
+	for i := range columns {
+		columns[i].Cards = make([]BoardCard, 0)
+		index[columns[i].Key] = i
+	}
+	for _, card := range cards {
+		key, name := keyOf(card)
+		i, ok := index[key]
+		if !ok {
+			i = len(columns)
+			index[key] = i
+			columns = append(columns, BoardColumn{Key: key, Name: name, Cards: make([]BoardCard, 0)})
+		}
+		columns[i].Cards = append(columns[i].Cards, card)
+	}
+	for i := range columns {
+		columns[i].WIPCount = len(columns[i].Cards)
+	}
+	return columns
+}
+
+// defaultBoardStatuses returns the built-in statuses used when no status model is configured
+func defaultBoardStatuses(entityType models.EntityType) []models.Status {
+	switch entityType {
+	case models.EntityTypeEpic:
+		return models.GetDefaultStatusesForEpic()
+	case models.EntityTypeUserStory:
+		return models.GetDefaultStatusesForUserStory()
+	default:
+		return models.GetDefaultStatusesForRequirement()
+	}
+}
+
+// isBoardEntityType reports whether entities of the type have a status and can be shown on a board
+func isBoardEntityType(entityType models.EntityType) bool {
+	return entityType == models.EntityTypeEpic || entityType == models.EntityTypeUserStory || entityType == models.EntityTypeRequirement
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestBoardService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.Requirement{},
+		&models.StatusModel{}, &models.Status{}, &models.StatusTransition{}, &models.BoardRank{}))
+
+	user := &models.User{Username: "planner", Email: "planner@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusInProgress,
+		Priority: models.PriorityHigh, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(&epic).Error)
+
+	created := time.Now().Add(-time.Hour)
+	stories := []models.UserStory{
+		{Title: "Pay by card", Status: models.UserStoryStatusBacklog, Priority: models.PriorityMedium},
+		{Title: "Pay by invoice", Status: models.UserStoryStatusBacklog, Priority: models.PriorityCritical},
+		{Title: "Show order summary", Status: models.UserStoryStatusInProgress, Priority: models.PriorityLow},
+		{Title: "Legacy import", Status: "Blocked", Priority: models.PriorityLow},
+	}
+	for i := range stories {
+		stories[i].ID = uuid.New()
+		stories[i].ReferenceID = fmt.Sprintf("US-%03d", i+1)
+		stories[i].EpicID = epic.ID
+		stories[i].CreatorID = user.ID
+		stories[i].AssigneeID = user.ID
+		stories[i].CreatedAt = created.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, session.Create(&stories[i]).Error)
+	}
+
+	repos := repository.NewRepositories(db, nil)
+	service := NewBoardService(repos, nil, NewUserStoryService(repos.UserStory, repos.Epic, repos.User), nil)
+
+	columnCards := func(t *testing.T, board *Board, key string) []string {
+		for _, column := range board.Columns {
+			if column.Key == key {
+				assert.Equal(t, len(column.Cards), column.WIPCount)
+				refs := make([]string, len(column.Cards))
+				for i, card := range column.Cards {
+					refs[i] = card.ReferenceID
+				}
+				return refs
+			}
+		}
+		t.Fatalf("column %q not found", key)
+		return nil
+	}
+
+	t.Run("group by status", func(t *testing.T) {
+		board, err := service.GetBoard(ctx, models.EntityTypeUserStory, BoardQuery{EpicID: &epic.ID})
+		require.NoError(t, err)
+		assert.Equal(t, BoardGroupByStatus, board.GroupBy)
+		assert.Equal(t, 4, board.TotalCount)
+
+		keys := make([]string, len(board.Columns))
+		for i, column := range board.Columns {
+			keys[i] = column.Key
+		}
+		assert.Equal(t, []string{"Backlog", "Draft", "In Progress", "Done", "Cancelled", "Blocked"}, keys)
+		assert.Equal(t, []string{"US-002", "US-001"}, columnCards(t, board, "Backlog"), "unranked cards are ordered by priority")
+		assert.Empty(t, columnCards(t, board, "Done"))
+		assert.True(t, board.Columns[3].IsFinal)
+	})
+
+	t.Run("group by priority and assignee", func(t *testing.T) {
+		board, err := service.GetBoard(ctx, models.EntityTypeUserStory, BoardQuery{GroupBy: BoardGroupByPriority})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"US-003", "US-004"}, columnCards(t, board, "4"))
+
+		board, err = service.GetBoard(ctx, models.EntityTypeUserStory, BoardQuery{GroupBy: BoardGroupByAssignee})
+		require.NoError(t, err)
+		require.Len(t, board.Columns, 1)
+		assert.Equal(t, "planner", board.Columns[0].Name)
+		assert.Equal(t, 4, board.Columns[0].WIPCount)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, err := service.GetBoard(ctx, models.EntityTypeUserStory, BoardQuery{GroupBy: "sprint"})
+		assert.ErrorIs(t, err, ErrInvalidBoardGroupBy)
+
+		_, err = service.GetBoard(ctx, models.EntityTypeAcceptanceCriteria, BoardQuery{})
+		assert.ErrorIs(t, err, ErrBoardNotSupported)
+	})
+
+	t.Run("move card to another column", func(t *testing.T) {
+		card, err := service.MoveCard(ctx, MoveBoardCardRequest{
+			EntityType: models.EntityTypeUserStory,
+			EntityID:   "US-001",
+			Status:     string(models.UserStoryStatusInProgress),
+			BeforeID:   &stories[2].ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "In Progress", card.Status)
+		require.NotNil(t, card.Rank)
+		assert.Equal(t, boardRankStep, *card.Rank)
+
+		stored, err := repos.UserStory.GetByID(ctx, stories[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.UserStoryStatusInProgress, stored.Status)
+
+		board, err := service.GetBoard(ctx, models.EntityTypeUserStory, BoardQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"US-001", "US-003"}, columnCards(t, board, "In Progress"))
+		assert.Equal(t, []string{"US-002"}, columnCards(t, board, "Backlog"))
+	})
+
+	t.Run("reorder within column", func(t *testing.T) {
+		card, err := service.MoveCard(ctx, MoveBoardCardRequest{EntityType: models.EntityTypeUserStory, EntityID: "US-001"})
+		require.NoError(t, err)
+		assert.Equal(t, 2*boardRankStep, *card.Rank)
+
+		board, err := service.GetBoard(ctx, models.EntityTypeUserStory, BoardQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"US-003", "US-001"}, columnCards(t, board, "In Progress"))
+	})
+
+	t.Run("invalid moves", func(t *testing.T) {
+		_, err := service.MoveCard(ctx, MoveBoardCardRequest{EntityType: models.EntityTypeUserStory, EntityID: "US-002", BeforeID: &stories[2].ID})
+		assert.ErrorIs(t, err, ErrBoardCardNotInColumn)
+
+		_, err = service.MoveCard(ctx, MoveBoardCardRequest{EntityType: models.EntityTypeUserStory, EntityID: "US-404"})
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = service.MoveCard(ctx, MoveBoardCardRequest{EntityType: models.EntityTypeUserStory, EntityID: "US-002", Status: "Shipped"})
+		assert.Error(t, err)
+
+		stored, err := repos.UserStory.GetByID(ctx, stories[1].ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.UserStoryStatusBacklog, stored.Status)
+	})
+}
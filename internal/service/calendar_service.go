@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrCalendarFeedNotFound = apperrors.New(apperrors.KindNotFound, "CALENDAR_FEED_NOT_FOUND", "calendar feed not found")
+)
+
+// calendarProductID identifies this application in generated iCal documents
+const calendarProductID = "-//Product Requirements Management//Calendar//EN"
+
+// Calendar event categories
+const (
+	CalendarCategoryMilestone = "Milestone"
+	CalendarCategoryDueDate   = "Due date"
+)
+
+// CalendarService defines the interface for iCal feeds of entity due dates and milestones
+type CalendarService interface {
+	UserCalendar(ctx context.Context, userID uuid.UUID) ([]byte, error)
+	EpicCalendar(ctx context.Context, epicIDOrRef string) ([]byte, error)
+	FeedCalendar(ctx context.Context, token string) ([]byte, error)
+	CreateFeed(ctx context.Context, userID uuid.UUID, req CreateCalendarFeedRequest) (*CalendarFeedCreated, error)
+	ListFeeds(ctx context.Context, userID uuid.UUID) ([]models.CalendarFeed, error)
+	RevokeFeed(ctx context.Context, userID, feedID uuid.UUID) error
+}
+
+// CreateCalendarFeedRequest represents the request to create a tokenized calendar feed URL
+// @Description Create a feed URL for calendar clients; omit epic_id for a feed of your own due dates
+type CreateCalendarFeedRequest struct {
+	EpicID string `json:"epic_id,omitempty" example:"EP-001"` // Epic UUID or reference ID
+}
+
+// CalendarFeedCreated is a newly created calendar feed with its secret URL
+// @Description Created calendar feed; the token and URL are only shown once
+type CalendarFeedCreated struct {
+	models.CalendarFeed
+	Token string `json:"token" example:"Xq3b9T0m1Yk..."`
+	URL   string `json:"url" example:"https://api.example.com/api/v1/calendar/Xq3b9T0m1Yk....ics"`
+}
+
+// calendarEvent is an all-day event of an iCal feed
+type calendarEvent struct {
+	UID         string
+	Date        time.Time
+	Summary     string
+	Description string
+	Category    string
+	Modified    time.Time
+}
+
+// calendarService implements CalendarService interface
+type calendarService struct {
+	repos          *repository.Repositories
+	tokenGenerator TokenGenerator
+	baseURL        string
+}
+
+// NewCalendarService creates a new calendar service instance
+// baseURL is the public API base URL used to build feed URLs
+func NewCalendarService(repos *repository.Repositories, baseURL string) CalendarService {
+	return &calendarService{
+		repos:          repos,
+		tokenGenerator: NewSecureTokenGenerator(),
+		baseURL:        strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// UserCalendar returns the due dates of the epics and user stories assigned to a user
+func (s *calendarService) UserCalendar(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	user, err := s.repos.User.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	filters := map[string]interface{}{"assignee_id": userID}
+	events, err := s.collectEvents(ctx, filters, filters)
+	if err != nil {
+		return nil, err
+	}
+	return renderCalendar(user.Username+" - due dates", events), nil
+}
+
+// EpicCalendar returns the milestone of an epic and the due dates of its user stories
+func (s *calendarService) EpicCalendar(ctx context.Context, epicIDOrRef string) ([]byte, error) {
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, err
+	}
+	return s.epicCalendar(ctx, epicID)
+}
+
+// FeedCalendar returns the calendar of a tokenized feed URL
+func (s *calendarService) FeedCalendar(ctx context.Context, token string) ([]byte, error) {
+	feed, err := s.repos.CalendarFeed.GetByTokenHash(ctx, hashFeedToken(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrCalendarFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	var calendar []byte
+	if feed.EpicID != nil {
+		calendar, err = s.epicCalendar(ctx, *feed.EpicID)
+	} else {
+		calendar, err = s.UserCalendar(ctx, feed.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.CalendarFeed.UpdateLastUsed(ctx, feed.ID, time.Now().UTC()); err != nil {
+		return nil, fmt.Errorf("failed to update calendar feed: %w", err)
+	}
+	return calendar, nil
+}
+
+// CreateFeed creates a tokenized feed URL that calendar clients can subscribe to without signing in
+func (s *calendarService) CreateFeed(ctx context.Context, userID uuid.UUID, req CreateCalendarFeedRequest) (*CalendarFeedCreated, error) {
+	feed := &models.CalendarFeed{UserID: userID}
+	if req.EpicID != "" {
+		epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, req.EpicID)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil, ErrEpicNotFound
+			}
+			return nil, err
+		}
+		feed.EpicID = &epicID
+	}
+
+	_, token, err := s.tokenGenerator.GenerateToken("", 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+	feed.TokenHash = hashFeedToken(token)
+
+	if err := s.repos.CalendarFeed.Create(ctx, feed); err != nil {
+		return nil, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	return &CalendarFeedCreated{
+		CalendarFeed: *feed,
+		Token:        token,
+		URL:          fmt.Sprintf("%s/api/v1/calendar/%s.ics", s.baseURL, token),
+	}, nil
+}
+
+// ListFeeds retrieves the calendar feeds of a user
+func (s *calendarService) ListFeeds(ctx context.Context, userID uuid.UUID) ([]models.CalendarFeed, error) {
+	feeds, err := s.repos.CalendarFeed.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendar feeds: %w", err)
+	}
+	return feeds, nil
+}
+
+// RevokeFeed deletes a calendar feed so its URL stops working
+func (s *calendarService) RevokeFeed(ctx context.Context, userID, feedID uuid.UUID) error {
+	if err := s.repos.CalendarFeed.DeleteForUser(ctx, feedID, userID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrCalendarFeedNotFound
+		}
+		return fmt.Errorf("failed to revoke calendar feed: %w", err)
+	}
+	return nil
+}
+
+// epicCalendar renders the calendar of an epic by ID
+func (s *calendarService) epicCalendar(ctx context.Context, epicID uuid.UUID) ([]byte, error) {
+	epic, err := s.repos.Epic.GetByID(ctx, epicID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+
+	events, err := s.collectEvents(ctx, map[string]interface{}{"id": epicID}, map[string]interface{}{"epic_id": epicID})
+	if err != nil {
+		return nil, err
+	}
+	return renderCalendar(epic.ReferenceID+" "+epic.Title, events), nil
+}
+
+// collectEvents builds milestone events from epic due dates and due date events from user story due dates
+func (s *calendarService) collectEvents(ctx context.Context, epicFilters, userStoryFilters map[string]interface{}) ([]calendarEvent, error) {
+	epics, err := s.repos.CalendarFeed.ListDueEpics(ctx, epicFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list epic due dates: %w", err)
+	}
+	userStories, err := s.repos.CalendarFeed.ListDueUserStories(ctx, userStoryFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user story due dates: %w", err)
+	}
+
+	events := make([]calendarEvent, 0, len(epics)+len(userStories))
+	for _, epic := range epics {
+		events = append(events, calendarEvent{
+			UID:         "epic-" + epic.ID.String(),
+			Date:        *epic.DueDate,
+			Summary:     fmt.Sprintf("%s %s", epic.ReferenceID, epic.Title),
+			Description: fmt.Sprintf("Epic milestone\nStatus: %s\nPriority: %s", epic.Status, epic.GetPriorityString()),
+			Category:    CalendarCategoryMilestone,
+			Modified:    epic.UpdatedAt,
+		})
+	}
+	for _, userStory := range userStories {
+		events = append(events, calendarEvent{
+			UID:         "user-story-" + userStory.ID.String(),
+			Date:        *userStory.DueDate,
+			Summary:     fmt.Sprintf("%s %s", userStory.ReferenceID, userStory.Title),
+			Description: fmt.Sprintf("User story due\nStatus: %s\nPriority: %s", userStory.Status, userStory.GetPriorityString()),
+			Category:    CalendarCategoryDueDate,
+			Modified:    userStory.UpdatedAt,
+		})
+	}
+	return events, nil
+}
+
+// renderCalendar renders events as an RFC 5545 iCalendar document of all-day events
+func renderCalendar(name string, events []calendarEvent) []byte {
+	var b strings.Builder
+	writeLine := func(line string) {
+		b.WriteString(foldCalendarLine(line))
+		b.WriteString("\r\n")
+	}
+
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:" + calendarProductID)
+	writeLine("CALSCALE:GREGORIAN")
+	writeLine("METHOD:PUBLISH")
+	writeLine("X-WR-CALNAME:" + escapeCalendarText(name))
+	for _, event := range events {
+		day := event.Date.UTC()
+		writeLine("BEGIN:VEVENT")
+		writeLine("UID:" + event.UID + "@product-requirements-management")
+		writeLine("DTSTAMP:" + stamp)
+		writeLine("DTSTART;VALUE=DATE:" + day.Format("20060102"))
+		writeLine("DTEND;VALUE=DATE:" + day.AddDate(0, 0, 1).Format("20060102"))
+		writeLine("SUMMARY:" + escapeCalendarText(event.Summary))
+		writeLine("DESCRIPTION:" + escapeCalendarText(event.Description))
+		writeLine("CATEGORIES:" + escapeCalendarText(event.Category))
+		if !event.Modified.IsZero() {
+			writeLine("LAST-MODIFIED:" + event.Modified.UTC().Format("20060102T150405Z"))
+		}
+		writeLine("TRANSP:TRANSPARENT")
+		writeLine("END:VEVENT")
+	}
+	writeLine("END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// escapeCalendarText escapes an iCalendar TEXT value
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(text)
+}
+
+// foldCalendarLine splits a content line into lines of at most 75 octets, without splitting UTF-8 characters
+func foldCalendarLine(line string) string {
+	const maxOctets = 75
+	if len(line) <= maxOctets {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > maxOctets {
+			// Continuation lines start with a space, which counts towards their length
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
+
+// hashFeedToken returns the hex SHA-256 hash under which a calendar or change feed token is stored
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
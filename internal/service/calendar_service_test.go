@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestCalendarService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.CalendarFeed{}))
+
+	user := &models.User{Username: "planner", Email: "planner@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	other := &models.User{Username: "other", Email: "other@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+	require.NoError(t, db.Create(other).Error)
+
+	date := func(day int) *time.Time {
+		d := time.Date(2024, time.March, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout, payments; refunds", Status: models.EpicStatusInProgress,
+		Priority: models.PriorityHigh, CreatorID: user.ID, AssigneeID: other.ID, DueDate: date(31)}
+	require.NoError(t, session.Create(&epic).Error)
+	stories := []models.UserStory{
+		{ID: uuid.New(), ReferenceID: "US-001", Title: "Pay by card", Status: models.UserStoryStatusBacklog, DueDate: date(15), AssigneeID: user.ID},
+		{ID: uuid.New(), ReferenceID: "US-002", Title: "Refund an order", Status: models.UserStoryStatusBacklog, DueDate: date(20), AssigneeID: other.ID},
+		{ID: uuid.New(), ReferenceID: "US-003", Title: "Undated story", Status: models.UserStoryStatusBacklog, AssigneeID: user.ID},
+	}
+	for i := range stories {
+		stories[i].EpicID = epic.ID
+		stories[i].CreatorID = user.ID
+		stories[i].Priority = models.PriorityMedium
+		require.NoError(t, session.Create(&stories[i]).Error)
+	}
+
+	repos := repository.NewRepositories(db, nil)
+	service := NewCalendarService(repos, "https://api.example.com/")
+
+	t.Run("user calendar", func(t *testing.T) {
+		calendar, err := service.UserCalendar(ctx, user.ID)
+		require.NoError(t, err)
+		text := string(calendar)
+
+		assert.True(t, strings.HasPrefix(text, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(text, "END:VCALENDAR\r\n"))
+		assert.Equal(t, 1, strings.Count(text, "BEGIN:VEVENT"))
+		assert.Contains(t, text, "UID:user-story-"+stories[0].ID.String())
+		assert.Contains(t, text, "DTSTART;VALUE=DATE:20240315\r\nDTEND;VALUE=DATE:20240316\r\n")
+		assert.Contains(t, text, "SUMMARY:US-001 Pay by card\r\n")
+		assert.Contains(t, text, "CATEGORIES:Due date\r\n")
+	})
+
+	t.Run("epic calendar", func(t *testing.T) {
+		calendar, err := service.EpicCalendar(ctx, "EP-001")
+		require.NoError(t, err)
+		text := string(calendar)
+
+		assert.Equal(t, 3, strings.Count(text, "BEGIN:VEVENT"))
+		assert.Contains(t, text, `SUMMARY:EP-001 Checkout\, payments\; refunds`)
+		assert.Contains(t, text, "CATEGORIES:Milestone\r\n")
+		assert.Contains(t, text, "DTSTART;VALUE=DATE:20240331\r\nDTEND;VALUE=DATE:20240401\r\n")
+		assert.Less(t, strings.Index(text, "US-001"), strings.Index(text, "US-002"))
+
+		_, err = service.EpicCalendar(ctx, "EP-404")
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+
+	t.Run("feed lifecycle", func(t *testing.T) {
+		created, err := service.CreateFeed(ctx, user.ID, CreateCalendarFeedRequest{EpicID: "EP-001"})
+		require.NoError(t, err)
+		require.NotNil(t, created.EpicID)
+		assert.Equal(t, epic.ID, *created.EpicID)
+		assert.Equal(t, "https://api.example.com/api/v1/calendar/"+created.Token+".ics", created.URL)
+		assert.NotEqual(t, created.Token, created.TokenHash)
+
+		calendar, err := service.FeedCalendar(ctx, created.Token)
+		require.NoError(t, err)
+		assert.Equal(t, 3, strings.Count(string(calendar), "BEGIN:VEVENT"))
+
+		feeds, err := service.ListFeeds(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, feeds, 1)
+		assert.NotNil(t, feeds[0].LastUsedAt)
+
+		assert.ErrorIs(t, service.RevokeFeed(ctx, other.ID, created.ID), ErrCalendarFeedNotFound)
+		require.NoError(t, service.RevokeFeed(ctx, user.ID, created.ID))
+
+		_, err = service.FeedCalendar(ctx, created.Token)
+		assert.ErrorIs(t, err, ErrCalendarFeedNotFound)
+
+		_, err = service.CreateFeed(ctx, user.ID, CreateCalendarFeedRequest{EpicID: "EP-404"})
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+
+	t.Run("personal feed", func(t *testing.T) {
+		created, err := service.CreateFeed(ctx, user.ID, CreateCalendarFeedRequest{})
+		require.NoError(t, err)
+		assert.Nil(t, created.EpicID)
+
+		calendar, err := service.FeedCalendar(ctx, created.Token)
+		require.NoError(t, err)
+		assert.Contains(t, string(calendar), "X-WR-CALNAME:planner - due dates\r\n")
+	})
+}
+
+func TestFoldCalendarLine(t *testing.T) {
+	short := "SUMMARY:short"
+	assert.Equal(t, short, foldCalendarLine(short))
+
+	long := "SUMMARY:" + strings.Repeat("é", 60)
+	folded := foldCalendarLine(long)
+	for _, line := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+	assert.Equal(t, long, strings.ReplaceAll(folded, "\r\n ", ""))
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Change feed size limits
+const (
+	DefaultChangeFeedLimit = 50
+	MaxChangeFeedLimit     = 200
+)
+
+// maxChangeFeedCommentLength caps the comment text included in comment entries
+const maxChangeFeedCommentLength = 500
+
+var (
+	ErrChangeFeedNotFound = apperrors.New(apperrors.KindNotFound, "CHANGE_FEED_NOT_FOUND", "change feed not found")
+)
+
+// ChangeFeedService defines the interface for Atom feeds of recent entity changes
+type ChangeFeedService interface {
+	WorkspaceFeed(ctx context.Context, limit int) ([]byte, error)
+	EpicFeed(ctx context.Context, epicIDOrRef string, limit int) ([]byte, error)
+	TokenFeed(ctx context.Context, token string, limit int) ([]byte, error)
+	CreateFeed(ctx context.Context, userID uuid.UUID, req CreateChangeFeedRequest) (*ChangeFeedCreated, error)
+	ListFeeds(ctx context.Context, userID uuid.UUID) ([]models.ChangeFeed, error)
+	RevokeFeed(ctx context.Context, userID, feedID uuid.UUID) error
+}
+
+// CreateChangeFeedRequest represents the request to create a tokenized change feed URL
+// @Description Create a feed URL for feed readers; omit epic_id for changes across the workspace
+type CreateChangeFeedRequest struct {
+	EpicID string `json:"epic_id,omitempty" example:"EP-001"` // Epic UUID or reference ID
+}
+
+// ChangeFeedCreated is a newly created change feed with its secret URL
+// @Description Created change feed; the token and URL are only shown once
+type ChangeFeedCreated struct {
+	models.ChangeFeed
+	Token string `json:"token" example:"Xq3b9T0m1Yk..."`
+	URL   string `json:"url" example:"https://api.example.com/api/v1/feeds/Xq3b9T0m1Yk....atom"`
+}
+
+// atomFeed is an RFC 4287 Atom feed document
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Link      atomLink    `xml:"link"`
+	Author    atomPerson  `xml:"author"`
+	Generator string      `xml:"generator"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+// atomEntry is a single change in an Atom feed
+type atomEntry struct {
+	ID       string       `xml:"id"`
+	Title    string       `xml:"title"`
+	Updated  string       `xml:"updated"`
+	Author   *atomPerson  `xml:"author,omitempty"`
+	Link     atomLink     `xml:"link"`
+	Category atomCategory `xml:"category"`
+	Summary  string       `xml:"summary,omitempty"`
+}
+
+// atomLink is an Atom link element
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+// atomPerson is an Atom author element
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+// atomCategory is an Atom category element
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// changeFeedService implements ChangeFeedService interface
+type changeFeedService struct {
+	repos          *repository.Repositories
+	tokenGenerator TokenGenerator
+	baseURL        string
+}
+
+// NewChangeFeedService creates a new change feed service instance
+// baseURL is the public API base URL used to build feed and entity URLs
+func NewChangeFeedService(repos *repository.Repositories, baseURL string) ChangeFeedService {
+	return &changeFeedService{
+		repos:          repos,
+		tokenGenerator: NewSecureTokenGenerator(),
+		baseURL:        strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// WorkspaceFeed returns the most recent changes to all entities
+func (s *changeFeedService) WorkspaceFeed(ctx context.Context, limit int) ([]byte, error) {
+	events, err := s.repos.Audit.ListRecent(ctx, normalizeChangeFeedLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changes: %w", err)
+	}
+	return s.render(ctx, s.baseURL+"/api/v1/changes.atom", "All changes", events)
+}
+
+// EpicFeed returns the most recent changes to an epic and the entities below it
+func (s *changeFeedService) EpicFeed(ctx context.Context, epicIDOrRef string, limit int) ([]byte, error) {
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, err
+	}
+	return s.epicFeed(ctx, epicID, limit)
+}
+
+// TokenFeed returns the feed behind a tokenized feed URL
+func (s *changeFeedService) TokenFeed(ctx context.Context, token string, limit int) ([]byte, error) {
+	feed, err := s.repos.ChangeFeed.GetByTokenHash(ctx, hashFeedToken(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrChangeFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to get change feed: %w", err)
+	}
+
+	var document []byte
+	if feed.EpicID != nil {
+		document, err = s.epicFeed(ctx, *feed.EpicID, limit)
+	} else {
+		document, err = s.WorkspaceFeed(ctx, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repos.ChangeFeed.UpdateLastUsed(ctx, feed.ID, time.Now().UTC()); err != nil {
+		return nil, fmt.Errorf("failed to update change feed: %w", err)
+	}
+	return document, nil
+}
+
+// CreateFeed creates a tokenized feed URL that feed readers can poll without signing in
+func (s *changeFeedService) CreateFeed(ctx context.Context, userID uuid.UUID, req CreateChangeFeedRequest) (*ChangeFeedCreated, error) {
+	feed := &models.ChangeFeed{UserID: userID}
+	if req.EpicID != "" {
+		epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, req.EpicID)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil, ErrEpicNotFound
+			}
+			return nil, err
+		}
+		feed.EpicID = &epicID
+	}
+
+	_, token, err := s.tokenGenerator.GenerateToken("", 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate change feed token: %w", err)
+	}
+	feed.TokenHash = hashFeedToken(token)
+
+	if err := s.repos.ChangeFeed.Create(ctx, feed); err != nil {
+		return nil, fmt.Errorf("failed to create change feed: %w", err)
+	}
+
+	return &ChangeFeedCreated{
+		ChangeFeed: *feed,
+		Token:      token,
+		URL:        fmt.Sprintf("%s/api/v1/feeds/%s.atom", s.baseURL, token),
+	}, nil
+}
+
+// ListFeeds retrieves the change feeds of a user
+func (s *changeFeedService) ListFeeds(ctx context.Context, userID uuid.UUID) ([]models.ChangeFeed, error) {
+	feeds, err := s.repos.ChangeFeed.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list change feeds: %w", err)
+	}
+	return feeds, nil
+}
+
+// RevokeFeed deletes a change feed so its URL stops working
+func (s *changeFeedService) RevokeFeed(ctx context.Context, userID, feedID uuid.UUID) error {
+	if err := s.repos.ChangeFeed.DeleteForUser(ctx, feedID, userID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrChangeFeedNotFound
+		}
+		return fmt.Errorf("failed to revoke change feed: %w", err)
+	}
+	return nil
+}
+
+// epicFeed renders the feed of an epic by ID
+func (s *changeFeedService) epicFeed(ctx context.Context, epicID uuid.UUID, limit int) ([]byte, error) {
+	epic, err := s.repos.Epic.GetByID(ctx, epicID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+
+	events, err := s.repos.Audit.ListForEpic(ctx, epicID, normalizeChangeFeedLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changes: %w", err)
+	}
+	return s.render(ctx, fmt.Sprintf("%s/api/v1/epics/%s/changes.atom", s.baseURL, epicID), "Changes to "+epic.ReferenceID+" "+epic.Title, events)
+}
+
+// render builds an Atom document from audit events, newest first. The canonical feed URL
+// identifies the feed so that tokenized URLs of the same feed are not exposed in it.
+func (s *changeFeedService) render(ctx context.Context, feedURL, title string, events []models.AuditEvent) ([]byte, error) {
+	feed := atomFeed{
+		ID:        feedURL,
+		Title:     title,
+		Updated:   time.Now().UTC().Format(time.RFC3339),
+		Link:      atomLink{Rel: "self", Href: feedURL},
+		Author:    atomPerson{Name: "Product Requirements Management"},
+		Generator: "Product Requirements Management",
+		Entries:   make([]atomEntry, 0, len(events)),
+	}
+	if len(events) > 0 {
+		feed.Updated = events[0].CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	labels := make(map[uuid.UUID]string)
+	for i := range events {
+		event := &events[i]
+		label, ok := labels[event.EntityID]
+		if !ok {
+			label = entityLabel(ctx, s.repos, event.EntityType, event.EntityID)
+			labels[event.EntityID] = label
+		}
+
+		entry := atomEntry{
+			ID:       "urn:uuid:" + event.ID.String(),
+			Title:    label + ": " + describeAuditEvent(event),
+			Updated:  event.CreatedAt.UTC().Format(time.RFC3339),
+			Link:     atomLink{Href: s.entityURL(event.EntityType, event.EntityID)},
+			Category: atomCategory{Term: string(event.Action)},
+			Summary:  s.summarize(ctx, event),
+		}
+		if event.Actor != nil {
+			entry.Author = &atomPerson{Name: event.Actor.Username}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	document, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to render change feed: %w", err)
+	}
+	return append([]byte(xml.Header), document...), nil
+}
+
+// summarize returns the entry summary: the comment text for comments and the changed values otherwise
+func (s *changeFeedService) summarize(ctx context.Context, event *models.AuditEvent) string {
+	if event.Action == models.AuditActionCommented && event.RelatedID != nil {
+		comment, err := s.repos.Comment.GetByID(ctx, *event.RelatedID)
+		if err != nil {
+			return ""
+		}
+		content := []rune(comment.Content)
+		if len(content) > maxChangeFeedCommentLength {
+			return string(content[:maxChangeFeedCommentLength]) + "…"
+		}
+		return comment.Content
+	}
+	if event.OldValue != nil || event.NewValue != nil {
+		return fmt.Sprintf("%s: %s → %s", event.Field, derefString(event.OldValue), derefString(event.NewValue))
+	}
+	return ""
+}
+
+// entityURL returns the API URL of an entity
+func (s *changeFeedService) entityURL(entityType models.EntityType, entityID uuid.UUID) string {
+	collection := map[models.EntityType]string{
+		models.EntityTypeEpic:               "epics",
+		models.EntityTypeUserStory:          "user-stories",
+		models.EntityTypeAcceptanceCriteria: "acceptance-criteria",
+		models.EntityTypeRequirement:        "requirements",
+	}[entityType]
+	return fmt.Sprintf("%s/api/v1/%s/%s", s.baseURL, collection, entityID)
+}
+
+// normalizeChangeFeedLimit applies the default and maximum feed size
+func normalizeChangeFeedLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultChangeFeedLimit
+	}
+	if limit > MaxChangeFeedLimit {
+		return MaxChangeFeedLimit
+	}
+	return limit
+}
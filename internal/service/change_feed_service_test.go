@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestChangeFeedService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.Comment{}, &models.AuditEvent{}, &models.ChangeFeed{}))
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	otherEpic := models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Reporting", Status: models.EpicStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	story := models.UserStory{ID: uuid.New(), ReferenceID: "US-001", Title: "Pay by card", EpicID: epic.ID, Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(&epic).Error)
+	require.NoError(t, session.Create(&otherEpic).Error)
+	require.NoError(t, session.Create(&story).Error)
+
+	comment := models.Comment{ID: uuid.New(), EntityType: models.EntityTypeUserStory, EntityID: story.ID, AuthorID: user.ID, Content: "Card payments need 3-D Secure <b>support</b>"}
+	require.NoError(t, session.Create(&comment).Error)
+
+	draft, active := "Backlog", "In Progress"
+	base := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	events := []models.AuditEvent{
+		{EntityType: models.EntityTypeEpic, EntityID: epic.ID, Action: models.AuditActionCreated, ActorID: &user.ID, CreatedAt: base},
+		{EntityType: models.EntityTypeUserStory, EntityID: story.ID, Action: models.AuditActionStatusChanged, ActorID: &user.ID,
+			Field: "status", OldValue: &draft, NewValue: &active, CreatedAt: base.Add(time.Minute)},
+		{EntityType: models.EntityTypeUserStory, EntityID: story.ID, Action: models.AuditActionCommented, ActorID: &user.ID,
+			RelatedID: &comment.ID, CreatedAt: base.Add(2 * time.Minute)},
+		{EntityType: models.EntityTypeEpic, EntityID: otherEpic.ID, Action: models.AuditActionCreated, CreatedAt: base.Add(3 * time.Minute)},
+	}
+	for i := range events {
+		require.NoError(t, db.Create(&events[i]).Error)
+	}
+
+	service := NewChangeFeedService(repository.NewRepositories(db, nil), "https://api.example.com")
+
+	parse := func(t *testing.T, document []byte) atomFeed {
+		var feed atomFeed
+		require.NoError(t, xml.Unmarshal(document, &feed))
+		return feed
+	}
+
+	t.Run("workspace feed", func(t *testing.T) {
+		document, err := service.WorkspaceFeed(ctx, 0)
+		require.NoError(t, err)
+		feed := parse(t, document)
+
+		assert.Equal(t, "https://api.example.com/api/v1/changes.atom", feed.ID)
+		require.Len(t, feed.Entries, 4)
+		assert.Equal(t, "EP-002 Reporting: created by someone", feed.Entries[0].Title)
+		assert.Nil(t, feed.Entries[0].Author)
+		assert.Equal(t, base.Add(3*time.Minute).Format(time.RFC3339), feed.Updated)
+
+		document, err = service.WorkspaceFeed(ctx, 2)
+		require.NoError(t, err)
+		assert.Len(t, parse(t, document).Entries, 2)
+	})
+
+	t.Run("epic feed", func(t *testing.T) {
+		document, err := service.EpicFeed(ctx, "EP-001", 0)
+		require.NoError(t, err)
+		feed := parse(t, document)
+
+		require.Len(t, feed.Entries, 3)
+		commented := feed.Entries[0]
+		assert.Equal(t, "US-001 Pay by card: alice commented", commented.Title)
+		assert.Equal(t, "Card payments need 3-D Secure <b>support</b>", commented.Summary)
+		assert.Equal(t, "https://api.example.com/api/v1/user-stories/"+story.ID.String(), commented.Link.Href)
+		assert.Equal(t, "commented", commented.Category.Term)
+		require.NotNil(t, commented.Author)
+		assert.Equal(t, "alice", commented.Author.Name)
+		assert.Equal(t, "status: Backlog → In Progress", feed.Entries[1].Summary)
+		assert.Equal(t, "urn:uuid:"+events[0].ID.String(), feed.Entries[2].ID)
+
+		_, err = service.EpicFeed(ctx, "EP-404", 0)
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+
+	t.Run("feed lifecycle", func(t *testing.T) {
+		created, err := service.CreateFeed(ctx, user.ID, CreateChangeFeedRequest{EpicID: "EP-001"})
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.example.com/api/v1/feeds/"+created.Token+".atom", created.URL)
+
+		document, err := service.TokenFeed(ctx, created.Token, 0)
+		require.NoError(t, err)
+		feed := parse(t, document)
+		assert.Len(t, feed.Entries, 3)
+		assert.NotContains(t, string(document), created.Token)
+
+		feeds, err := service.ListFeeds(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, feeds, 1)
+		assert.NotNil(t, feeds[0].LastUsedAt)
+
+		require.NoError(t, service.RevokeFeed(ctx, user.ID, created.ID))
+		assert.ErrorIs(t, service.RevokeFeed(ctx, user.ID, created.ID), ErrChangeFeedNotFound)
+		_, err = service.TokenFeed(ctx, created.Token, 0)
+		assert.ErrorIs(t, err, ErrChangeFeedNotFound)
+
+		workspace, err := service.CreateFeed(ctx, user.ID, CreateChangeFeedRequest{})
+		require.NoError(t, err)
+		document, err = service.TokenFeed(ctx, workspace.Token, 0)
+		require.NoError(t, err)
+		assert.Len(t, parse(t, document).Entries, 4)
+	})
+}
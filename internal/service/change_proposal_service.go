@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrChangeProposalNotFound         = apperrors.New(apperrors.KindNotFound, "CHANGE_PROPOSAL_NOT_FOUND", "change proposal not found")
+	ErrChangeProposalEmpty            = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "change proposal must change the title, description or priority")
+	ErrChangeProposalFieldUnsupported = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "acceptance criteria proposals only support a description")
+	ErrChangeProposalEmptyDescription = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "proposed description cannot be empty")
+	ErrChangeProposalEmptyReason      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "rejection reason cannot be empty")
+	ErrInvalidChangeProposalStatus    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid change proposal status")
+	ErrChangeProposalNotPending       = apperrors.New(apperrors.KindConflict, "CHANGE_PROPOSAL_CLOSED", "change proposal was already accepted, rejected or withdrawn")
+	ErrChangeProposalConflict         = apperrors.New(apperrors.KindConflict, "CHANGE_PROPOSAL_CONFLICT", "entity was modified after the change was proposed")
+	ErrChangeProposalNotProposer      = apperrors.New(apperrors.KindForbidden, apperrors.CodeInsufficientPermissions, "only the proposer can withdraw a change proposal")
+)
+
+// ChangeProposalService defines the interface for suggested edits reviewed by editors
+type ChangeProposalService interface {
+	CreateProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposerID uuid.UUID, req CreateChangeProposalRequest) (*models.ChangeProposal, error)
+	ListProposals(ctx context.Context, entityType models.EntityType, idOrReference string, status *models.ChangeProposalStatus) ([]models.ChangeProposal, error)
+	AcceptProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposalID, reviewerID uuid.UUID, force bool) (*AcceptChangeProposalResponse, error)
+	RejectProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposalID, reviewerID uuid.UUID, reason string) (*models.ChangeProposal, error)
+	WithdrawProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposalID, userID uuid.UUID) (*models.ChangeProposal, error)
+}
+
+// CreateChangeProposalRequest represents the request to propose a change to an entity
+// @Description Request payload for proposing a change; at least one of title, description and priority is required
+type CreateChangeProposalRequest struct {
+	// Title is the proposed title (not supported for acceptance criteria)
+	// @Description Proposed title (optional, max 500 characters, not supported for acceptance criteria)
+	// @MaxLength 500
+	// @Example "User authentication must support OAuth 2.0 and SAML"
+	Title *string `json:"title,omitempty" binding:"omitempty,max=500"`
+
+	// Description is the proposed description
+	// @Description Proposed description (optional, max 50000 characters)
+	// @MaxLength 50000
+	// @Example "The system shall support OAuth 2.0 and SAML authentication flows..."
+	Description *string `json:"description,omitempty" binding:"omitempty,max=50000"`
+
+	// Priority is the proposed priority (not supported for acceptance criteria)
+	// @Description Proposed priority (1=Critical, 2=High, 3=Medium, 4=Low) (optional, not supported for acceptance criteria)
+	// @Minimum 1
+	// @Maximum 4
+	// @Example 2
+	Priority *models.Priority `json:"priority,omitempty" binding:"omitempty,min=1,max=4"`
+
+	// Rationale explains the change to the reviewer
+	// @Description Why the change is proposed (optional, max 5000 characters)
+	// @MaxLength 5000
+	// @Example "SAML is required by enterprise customers"
+	Rationale string `json:"rationale,omitempty" binding:"max=5000"`
+}
+
+// RejectChangeProposalRequest represents the request to reject a change proposal
+// @Description Request payload for rejecting a change proposal
+type RejectChangeProposalRequest struct {
+	// Reason tells the proposer why the change was rejected
+	// @Description Reason for the rejection shown to the proposer (required, max 5000 characters)
+	// @MaxLength 5000
+	// @Example "Covered by REQ-012"
+	Reason string `json:"reason" binding:"required,max=5000"`
+}
+
+// AcceptChangeProposalResponse is the accepted proposal together with the updated entity
+// @Description Accepted change proposal and the entity after the change was applied
+type AcceptChangeProposalResponse struct {
+	Proposal *models.ChangeProposal `json:"proposal"`
+	Entity   interface{}            `json:"entity"`
+}
+
+// changeProposalService implements ChangeProposalService interface
+type changeProposalService struct {
+	proposalRepo              repository.ChangeProposalRepository
+	auditRepo                 repository.AuditRepository
+	repos                     *repository.Repositories
+	epicService               EpicService
+	userStoryService          UserStoryService
+	acceptanceCriteriaService AcceptanceCriteriaService
+	requirementService        RequirementService
+	commentService            CommentService
+	presenceService           PresenceService
+}
+
+// NewChangeProposalService creates a new change proposal service instance
+func NewChangeProposalService(
+	repos *repository.Repositories,
+	epicService EpicService,
+	userStoryService UserStoryService,
+	acceptanceCriteriaService AcceptanceCriteriaService,
+	requirementService RequirementService,
+	commentService CommentService,
+	presenceService PresenceService,
+) ChangeProposalService {
+	return &changeProposalService{
+		proposalRepo:              repos.ChangeProposal,
+		auditRepo:                 repos.Audit,
+		repos:                     repos,
+		epicService:               epicService,
+		userStoryService:          userStoryService,
+		acceptanceCriteriaService: acceptanceCriteriaService,
+		requirementService:        requirementService,
+		commentService:            commentService,
+		presenceService:           presenceService,
+	}
+}
+
+// CreateProposal records a suggested change to an entity for review
+func (s *changeProposalService) CreateProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposerID uuid.UUID, req CreateChangeProposalRequest) (*models.ChangeProposal, error) {
+	if req.Title == nil && req.Description == nil && req.Priority == nil {
+		return nil, ErrChangeProposalEmpty
+	}
+	if entityType == models.EntityTypeAcceptanceCriteria && (req.Title != nil || req.Priority != nil) {
+		return nil, ErrChangeProposalFieldUnsupported
+	}
+	if req.Description != nil && strings.TrimSpace(*req.Description) == "" {
+		return nil, ErrChangeProposalEmptyDescription
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	text, err := loadEntityText(ctx, s.repos, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &models.ChangeProposal{
+		EntityType:    entityType,
+		EntityID:      entityID,
+		ProposerID:    proposerID,
+		Title:         req.Title,
+		Description:   req.Description,
+		Priority:      req.Priority,
+		Rationale:     strings.TrimSpace(req.Rationale),
+		Status:        models.ChangeProposalPending,
+		BaseUpdatedAt: text.UpdatedAt,
+	}
+	if err := s.proposalRepo.Create(ctx, proposal); err != nil {
+		return nil, fmt.Errorf("failed to create change proposal: %w", err)
+	}
+	return proposal, nil
+}
+
+// ListProposals retrieves the proposals of an entity, oldest first, optionally with a single status
+func (s *changeProposalService) ListProposals(ctx context.Context, entityType models.EntityType, idOrReference string, status *models.ChangeProposalStatus) ([]models.ChangeProposal, error) {
+	if status != nil && !status.IsValid() {
+		return nil, ErrInvalidChangeProposalStatus
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, err := s.proposalRepo.ListByEntity(ctx, entityType, entityID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list change proposals: %w", err)
+	}
+	return proposals, nil
+}
+
+// AcceptProposal applies a pending proposal to the entity and records the proposer as the author of the change
+// Returns ErrChangeProposalConflict if the entity changed after the proposal was made, unless force is set
+func (s *changeProposalService) AcceptProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposalID, reviewerID uuid.UUID, force bool) (*AcceptChangeProposalResponse, error) {
+	proposal, err := s.getPendingProposal(ctx, entityType, idOrReference, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.presenceService != nil {
+		if err := s.presenceService.CheckEditAllowed(ctx, entityType, proposal.EntityID, reviewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	text, err := loadEntityText(ctx, s.repos, entityType, proposal.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	if !force && proposal.IsStale(text.UpdatedAt) {
+		return nil, ErrChangeProposalConflict
+	}
+
+	var entity interface{}
+	switch entityType {
+	case models.EntityTypeEpic:
+		entity, err = s.epicService.UpdateEpic(ctx, proposal.EntityID, UpdateEpicRequest{
+			Title: proposal.Title, Description: proposal.Description, Priority: proposal.Priority,
+		})
+	case models.EntityTypeUserStory:
+		entity, err = s.userStoryService.UpdateUserStory(ctx, proposal.EntityID, UpdateUserStoryRequest{
+			Title: proposal.Title, Description: proposal.Description, Priority: proposal.Priority,
+		})
+	case models.EntityTypeAcceptanceCriteria:
+		entity, err = s.acceptanceCriteriaService.UpdateAcceptanceCriteria(ctx, proposal.EntityID, UpdateAcceptanceCriteriaRequest{
+			Description: proposal.Description,
+		})
+	case models.EntityTypeRequirement:
+		entity, err = s.requirementService.UpdateRequirement(ctx, proposal.EntityID, UpdateRequirementRequest{
+			Title: proposal.Title, Description: proposal.Description, Priority: proposal.Priority,
+		})
+	default:
+		return nil, ErrInvalidEntityType
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Description != nil && text.Description != *proposal.Description {
+		if err := s.commentService.ValidateInlineCommentsAfterTextChange(ctx, entityType, proposal.EntityID, *proposal.Description); err != nil {
+			return nil, fmt.Errorf("failed to validate inline comments: %w", err)
+		}
+	}
+
+	if err := s.closeProposal(ctx, proposal, models.ChangeProposalAccepted, &reviewerID, nil); err != nil {
+		return nil, err
+	}
+
+	// The edit itself is recorded without an actor, so credit the proposer in the entity's activity
+	event := &models.AuditEvent{
+		EntityType: entityType,
+		EntityID:   proposal.EntityID,
+		Action:     models.AuditActionProposalAccepted,
+		ActorID:    &proposal.ProposerID,
+		RelatedID:  &proposal.ID,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := s.auditRepo.Create(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to record accepted change proposal: %w", err)
+	}
+
+	return &AcceptChangeProposalResponse{Proposal: proposal, Entity: entity}, nil
+}
+
+// RejectProposal closes a pending proposal without applying it
+func (s *changeProposalService) RejectProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposalID, reviewerID uuid.UUID, reason string) (*models.ChangeProposal, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrChangeProposalEmptyReason
+	}
+
+	proposal, err := s.getPendingProposal(ctx, entityType, idOrReference, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.closeProposal(ctx, proposal, models.ChangeProposalRejected, &reviewerID, &reason); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// WithdrawProposal lets the proposer close their own pending proposal
+func (s *changeProposalService) WithdrawProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposalID, userID uuid.UUID) (*models.ChangeProposal, error) {
+	proposal, err := s.getPendingProposal(ctx, entityType, idOrReference, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.ProposerID != userID {
+		return nil, ErrChangeProposalNotProposer
+	}
+	if err := s.closeProposal(ctx, proposal, models.ChangeProposalWithdrawn, nil, nil); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// getPendingProposal retrieves a proposal of the entity and checks it is still open for review
+func (s *changeProposalService) getPendingProposal(ctx context.Context, entityType models.EntityType, idOrReference string, proposalID uuid.UUID) (*models.ChangeProposal, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.proposalRepo.GetByID(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrChangeProposalNotFound
+		}
+		return nil, fmt.Errorf("failed to get change proposal: %w", err)
+	}
+	if proposal.EntityType != entityType || proposal.EntityID != entityID {
+		return nil, ErrChangeProposalNotFound
+	}
+	if proposal.Status != models.ChangeProposalPending {
+		return nil, ErrChangeProposalNotPending
+	}
+	return proposal, nil
+}
+
+// closeProposal records the outcome of a review
+func (s *changeProposalService) closeProposal(ctx context.Context, proposal *models.ChangeProposal, status models.ChangeProposalStatus, reviewerID *uuid.UUID, reason *string) error {
+	now := time.Now().UTC()
+	proposal.Status = status
+	proposal.ReviewerID = reviewerID
+	proposal.ReviewedAt = &now
+	proposal.RejectionReason = reason
+	if err := s.proposalRepo.Update(ctx, proposal); err != nil {
+		return fmt.Errorf("failed to update change proposal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestChangeProposalService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.Comment{}, &models.AuditEvent{}, &models.ChangeProposal{}))
+
+	commenter := &models.User{Username: "suggester", Email: "suggester@example.com", PasswordHash: "hash", Role: models.RoleCommenter}
+	editor := &models.User{Username: "editor", Email: "editor@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(commenter).Error)
+	require.NoError(t, db.Create(editor).Error)
+
+	description := "Users sign in with a password"
+	epic := models.Epic{
+		ID: uuid.New(), ReferenceID: "EP-001", Title: "User Authentication", Description: &description,
+		Status: models.EpicStatusBacklog, Priority: models.PriorityMedium, CreatorID: editor.ID, AssigneeID: editor.ID,
+		UpdatedAt: time.Now().Add(-time.Hour),
+	}
+	require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(&epic).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	service := NewChangeProposalService(repos, NewEpicService(repos.Epic, repos.User), nil, nil, nil, NewCommentService(repos, nil), nil)
+
+	newDescription := "Users sign in with OAuth 2.0 or SAML"
+	priority := models.PriorityHigh
+
+	t.Run("validates the proposed change", func(t *testing.T) {
+		_, err := service.CreateProposal(ctx, models.EntityTypeEpic, "EP-001", commenter.ID, CreateChangeProposalRequest{Rationale: "nothing"})
+		assert.ErrorIs(t, err, ErrChangeProposalEmpty)
+
+		blank := "  "
+		_, err = service.CreateProposal(ctx, models.EntityTypeEpic, "EP-001", commenter.ID, CreateChangeProposalRequest{Description: &blank})
+		assert.ErrorIs(t, err, ErrChangeProposalEmptyDescription)
+
+		title := "Title"
+		_, err = service.CreateProposal(ctx, models.EntityTypeAcceptanceCriteria, "AC-001", commenter.ID, CreateChangeProposalRequest{Title: &title})
+		assert.ErrorIs(t, err, ErrChangeProposalFieldUnsupported)
+
+		_, err = service.CreateProposal(ctx, models.EntityTypeEpic, "EP-404", commenter.ID, CreateChangeProposalRequest{Description: &newDescription})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	proposal, err := service.CreateProposal(ctx, models.EntityTypeEpic, "EP-001", commenter.ID, CreateChangeProposalRequest{
+		Description: &newDescription,
+		Priority:    &priority,
+		Rationale:   " SAML is required by enterprise customers ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.ChangeProposalPending, proposal.Status)
+	assert.Equal(t, "SAML is required by enterprise customers", proposal.Rationale)
+	assert.Equal(t, epic.UpdatedAt.Unix(), proposal.BaseUpdatedAt.Unix())
+
+	t.Run("lists the queue of the entity", func(t *testing.T) {
+		pending := models.ChangeProposalPending
+		proposals, err := service.ListProposals(ctx, models.EntityTypeEpic, epic.ID.String(), &pending)
+		require.NoError(t, err)
+		require.Len(t, proposals, 1)
+		require.NotNil(t, proposals[0].Proposer)
+		assert.Equal(t, "suggester", proposals[0].Proposer.Username)
+
+		invalid := models.ChangeProposalStatus("open")
+		_, err = service.ListProposals(ctx, models.EntityTypeEpic, "EP-001", &invalid)
+		assert.ErrorIs(t, err, ErrInvalidChangeProposalStatus)
+	})
+
+	t.Run("only the proposer can withdraw", func(t *testing.T) {
+		_, err := service.WithdrawProposal(ctx, models.EntityTypeEpic, "EP-001", proposal.ID, editor.ID)
+		assert.ErrorIs(t, err, ErrChangeProposalNotProposer)
+	})
+
+	t.Run("proposals belong to their entity", func(t *testing.T) {
+		other := models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Reporting", Status: models.EpicStatusBacklog, CreatorID: editor.ID, AssigneeID: editor.ID}
+		require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(&other).Error)
+		_, err := service.AcceptProposal(ctx, models.EntityTypeEpic, "EP-002", proposal.ID, editor.ID, false)
+		assert.ErrorIs(t, err, ErrChangeProposalNotFound)
+	})
+
+	t.Run("accepting applies the change and credits the proposer", func(t *testing.T) {
+		result, err := service.AcceptProposal(ctx, models.EntityTypeEpic, "EP-001", proposal.ID, editor.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, models.ChangeProposalAccepted, result.Proposal.Status)
+		assert.Equal(t, editor.ID, *result.Proposal.ReviewerID)
+		assert.NotNil(t, result.Proposal.ReviewedAt)
+
+		updated, err := repos.Epic.GetByID(ctx, epic.ID)
+		require.NoError(t, err)
+		assert.Equal(t, newDescription, *updated.Description)
+		assert.Equal(t, models.PriorityHigh, updated.Priority)
+		assert.Equal(t, "User Authentication", updated.Title, "fields not proposed are kept")
+
+		var event models.AuditEvent
+		require.NoError(t, db.Where("action = ?", models.AuditActionProposalAccepted).First(&event).Error)
+		assert.Equal(t, commenter.ID, *event.ActorID)
+		assert.Equal(t, proposal.ID, *event.RelatedID)
+
+		_, err = service.RejectProposal(ctx, models.EntityTypeEpic, "EP-001", proposal.ID, editor.ID, "too late")
+		assert.ErrorIs(t, err, ErrChangeProposalNotPending)
+	})
+
+	t.Run("detects changes made after the proposal", func(t *testing.T) {
+		title := "Authentication and Authorization"
+		stale, err := service.CreateProposal(ctx, models.EntityTypeEpic, "EP-001", commenter.ID, CreateChangeProposalRequest{Title: &title})
+		require.NoError(t, err)
+		require.NoError(t, db.Model(&models.Epic{}).Where("id = ?", epic.ID).UpdateColumn("updated_at", time.Now().Add(time.Minute)).Error)
+
+		_, err = service.AcceptProposal(ctx, models.EntityTypeEpic, "EP-001", stale.ID, editor.ID, false)
+		assert.ErrorIs(t, err, ErrChangeProposalConflict)
+
+		result, err := service.AcceptProposal(ctx, models.EntityTypeEpic, "EP-001", stale.ID, editor.ID, true)
+		require.NoError(t, err)
+		assert.Equal(t, models.ChangeProposalAccepted, result.Proposal.Status)
+	})
+
+	t.Run("rejecting requires a reason and keeps the entity", func(t *testing.T) {
+		other := "Users sign in with magic links"
+		rejected, err := service.CreateProposal(ctx, models.EntityTypeEpic, "EP-001", commenter.ID, CreateChangeProposalRequest{Description: &other})
+		require.NoError(t, err)
+
+		_, err = service.RejectProposal(ctx, models.EntityTypeEpic, "EP-001", rejected.ID, editor.ID, " ")
+		assert.ErrorIs(t, err, ErrChangeProposalEmptyReason)
+
+		result, err := service.RejectProposal(ctx, models.EntityTypeEpic, "EP-001", rejected.ID, editor.ID, "Magic links are out of scope")
+		require.NoError(t, err)
+		assert.Equal(t, models.ChangeProposalRejected, result.Status)
+		assert.Equal(t, "Magic links are out of scope", *result.RejectionReason)
+
+		updated, err := repos.Epic.GetByID(ctx, epic.ID)
+		require.NoError(t, err)
+		assert.Equal(t, newDescription, *updated.Description)
+	})
+
+	t.Run("proposers withdraw their own proposals", func(t *testing.T) {
+		withdrawn, err := service.CreateProposal(ctx, models.EntityTypeEpic, "EP-001", commenter.ID, CreateChangeProposalRequest{Priority: &priority})
+		require.NoError(t, err)
+		result, err := service.WithdrawProposal(ctx, models.EntityTypeEpic, "EP-001", withdrawn.ID, commenter.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.ChangeProposalWithdrawn, result.Status)
+		assert.Nil(t, result.ReviewerID)
+
+		all, err := service.ListProposals(ctx, models.EntityTypeEpic, "EP-001", nil)
+		require.NoError(t, err)
+		assert.Len(t, all, 4)
+	})
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// DefaultCommentDraftTTL is how long a comment draft is kept after it was last saved when no period is configured
+const DefaultCommentDraftTTL = 14 * 24 * time.Hour
+
+var (
+	ErrCommentDraftNotFound     = apperrors.New(apperrors.KindNotFound, "COMMENT_DRAFT_NOT_FOUND", "comment draft not found")
+	ErrCommentDraftEmptyContent = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "comment draft content cannot be empty")
+)
+
+// CommentDraftService defines the interface for autosaved, private drafts of comments and replies.
+// A user has one draft per entity for a new comment and one per comment thread for a reply.
+type CommentDraftService interface {
+	SaveDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, req SaveCommentDraftRequest, now time.Time) (*models.CommentDraft, error)
+	GetDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, parentCommentID *uuid.UUID, now time.Time) (*models.CommentDraft, error)
+	DiscardDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, parentCommentID *uuid.UUID) error
+	ListUserDrafts(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.CommentDraft, error)
+	CleanupExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// SaveCommentDraftRequest represents the request to autosave a comment draft
+// @Description Request payload for saving a private draft of a comment or reply
+type SaveCommentDraftRequest struct {
+	// Content is the drafted comment text; it is kept as typed, including surrounding whitespace
+	// @Description Drafted comment text (required, max 50000 characters)
+	// @MaxLength 50000
+	// @Example "I think the retry policy needs an upper bound because..."
+	Content string `json:"content" binding:"required,max=50000"`
+
+	// ParentCommentID is the comment the draft replies to
+	// @Description Comment of the entity the draft replies to; omit for a new top-level comment
+	// @Example "123e4567-e89b-12d3-a456-426614174003"
+	ParentCommentID *uuid.UUID `json:"parent_comment_id,omitempty"`
+}
+
+// commentDraftService implements CommentDraftService interface
+type commentDraftService struct {
+	repos *repository.Repositories
+	ttl   time.Duration
+}
+
+// NewCommentDraftService creates a new comment draft service instance
+// Drafts expire ttl after they were last saved; a non-positive ttl uses DefaultCommentDraftTTL
+func NewCommentDraftService(repos *repository.Repositories, ttl time.Duration) CommentDraftService {
+	if ttl <= 0 {
+		ttl = DefaultCommentDraftTTL
+	}
+	return &commentDraftService{repos: repos, ttl: ttl}
+}
+
+// SaveDraft creates or replaces the user's draft for an entity or one of its comment threads and extends its expiry
+func (s *commentDraftService) SaveDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, req SaveCommentDraftRequest, now time.Time) (*models.CommentDraft, error) {
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, ErrCommentDraftEmptyContent
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkParentComment(ctx, entityType, entityID, req.ParentCommentID); err != nil {
+		return nil, err
+	}
+
+	draft, err := s.repos.CommentDraft.Get(ctx, entityType, entityID, userID, req.ParentCommentID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get comment draft: %w", err)
+	}
+
+	expiresAt := now.UTC().Add(s.ttl)
+	if draft == nil {
+		draft = &models.CommentDraft{
+			EntityType:      entityType,
+			EntityID:        entityID,
+			UserID:          userID,
+			ParentCommentID: req.ParentCommentID,
+			Content:         req.Content,
+			ExpiresAt:       expiresAt,
+		}
+		if err := s.repos.CommentDraft.Create(ctx, draft); err != nil {
+			return nil, fmt.Errorf("failed to create comment draft: %w", err)
+		}
+	} else {
+		draft.Content = req.Content
+		draft.ExpiresAt = expiresAt
+		if err := s.repos.CommentDraft.Update(ctx, draft); err != nil {
+			return nil, fmt.Errorf("failed to update comment draft: %w", err)
+		}
+	}
+	return draft, nil
+}
+
+// GetDraft retrieves the user's unexpired draft for an entity or one of its comment threads
+func (s *commentDraftService) GetDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, parentCommentID *uuid.UUID, now time.Time) (*models.CommentDraft, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := s.repos.CommentDraft.Get(ctx, entityType, entityID, userID, parentCommentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrCommentDraftNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment draft: %w", err)
+	}
+	// Expired drafts count as gone even before the cleanup removes them
+	if !now.Before(draft.ExpiresAt) {
+		return nil, ErrCommentDraftNotFound
+	}
+	return draft, nil
+}
+
+// DiscardDraft deletes the user's draft for an entity or one of its comment threads, e.g. once the comment is posted
+func (s *commentDraftService) DiscardDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, parentCommentID *uuid.UUID) error {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repos.CommentDraft.Delete(ctx, entityType, entityID, userID, parentCommentID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrCommentDraftNotFound
+		}
+		return fmt.Errorf("failed to delete comment draft: %w", err)
+	}
+	return nil
+}
+
+// ListUserDrafts retrieves the unexpired comment drafts of a user, most recently saved first
+func (s *commentDraftService) ListUserDrafts(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.CommentDraft, error) {
+	drafts, err := s.repos.CommentDraft.ListByUser(ctx, userID, now.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comment drafts: %w", err)
+	}
+	return drafts, nil
+}
+
+// CleanupExpired deletes the comment drafts that were not saved within the expiry period
+func (s *commentDraftService) CleanupExpired(ctx context.Context, now time.Time) (int64, error) {
+	deleted, err := s.repos.CommentDraft.DeleteExpired(ctx, now.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired comment drafts: %w", err)
+	}
+	return deleted, nil
+}
+
+// checkParentComment checks that the comment a draft replies to belongs to the entity
+func (s *commentDraftService) checkParentComment(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, parentCommentID *uuid.UUID) error {
+	if parentCommentID == nil {
+		return nil
+	}
+	parent, err := s.repos.Comment.GetByID(ctx, *parentCommentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrParentCommentNotFound
+		}
+		return fmt.Errorf("failed to get parent comment: %w", err)
+	}
+	if parent.EntityType != entityType || parent.EntityID != entityID {
+		return ErrParentCommentWrongEntity
+	}
+	return nil
+}
+
+// RunCommentDraftCleanup deletes expired comment drafts every interval until ctx is cancelled
+func RunCommentDraftCleanup(ctx context.Context, commentDraftService CommentDraftService, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			deleted, err := commentDraftService.CleanupExpired(ctx, now)
+			if err != nil {
+				logger.WithError(err).Error("Comment draft cleanup failed")
+				continue
+			}
+			if deleted > 0 {
+				logger.WithField("drafts", deleted).Info("Expired comment drafts deleted")
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestCommentDraftService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.Comment{}, &models.CommentDraft{}))
+
+	alice := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleCommenter}
+	bob := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleCommenter}
+	require.NoError(t, db.Create(alice).Error)
+	require.NoError(t, db.Create(bob).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog,
+		Priority: models.PriorityHigh, CreatorID: alice.ID, AssigneeID: alice.ID}
+	other := models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Search", Status: models.EpicStatusBacklog,
+		Priority: models.PriorityHigh, CreatorID: alice.ID, AssigneeID: alice.ID}
+	require.NoError(t, session.Create(&epic).Error)
+	require.NoError(t, session.Create(&other).Error)
+	parent := models.Comment{ID: uuid.New(), EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: bob.ID, Content: "Why a retry limit?"}
+	require.NoError(t, session.Create(&parent).Error)
+
+	svc := NewCommentDraftService(repository.NewRepositories(db, nil), time.Hour)
+	now := time.Now().UTC()
+
+	t.Run("validates the draft", func(t *testing.T) {
+		_, err := svc.SaveDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, SaveCommentDraftRequest{Content: "  \n"}, now)
+		assert.ErrorIs(t, err, ErrCommentDraftEmptyContent)
+		_, err = svc.SaveDraft(ctx, models.EntityTypeEpic, "EP-404", alice.ID, SaveCommentDraftRequest{Content: "Draft"}, now)
+		assert.ErrorIs(t, err, ErrNotFound)
+		missing := uuid.New()
+		_, err = svc.SaveDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, SaveCommentDraftRequest{Content: "Draft", ParentCommentID: &missing}, now)
+		assert.ErrorIs(t, err, ErrParentCommentNotFound)
+		_, err = svc.SaveDraft(ctx, models.EntityTypeEpic, "EP-002", alice.ID, SaveCommentDraftRequest{Content: "Draft", ParentCommentID: &parent.ID}, now)
+		assert.ErrorIs(t, err, ErrParentCommentWrongEntity)
+	})
+
+	t.Run("keeps one draft per user and thread", func(t *testing.T) {
+		first, err := svc.SaveDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, SaveCommentDraftRequest{Content: "I think"}, now)
+		require.NoError(t, err)
+		saved, err := svc.SaveDraft(ctx, models.EntityTypeEpic, epic.ID.String(), alice.ID, SaveCommentDraftRequest{Content: "I think the limit is fine"}, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, saved.ID)
+		assert.Equal(t, now.Add(time.Minute+time.Hour), saved.ExpiresAt.UTC())
+
+		reply, err := svc.SaveDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, SaveCommentDraftRequest{Content: "Because of", ParentCommentID: &parent.ID}, now)
+		require.NoError(t, err)
+		assert.NotEqual(t, first.ID, reply.ID)
+
+		draft, err := svc.GetDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, nil, now)
+		require.NoError(t, err)
+		assert.Equal(t, "I think the limit is fine", draft.Content)
+		draft, err = svc.GetDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, &parent.ID, now)
+		require.NoError(t, err)
+		assert.Equal(t, "Because of", draft.Content)
+	})
+
+	t.Run("keeps drafts private", func(t *testing.T) {
+		_, err := svc.GetDraft(ctx, models.EntityTypeEpic, "EP-001", bob.ID, nil, now)
+		assert.ErrorIs(t, err, ErrCommentDraftNotFound)
+
+		drafts, err := svc.ListUserDrafts(ctx, bob.ID, now)
+		require.NoError(t, err)
+		assert.Empty(t, drafts)
+		drafts, err = svc.ListUserDrafts(ctx, alice.ID, now)
+		require.NoError(t, err)
+		assert.Len(t, drafts, 2)
+	})
+
+	t.Run("expires drafts", func(t *testing.T) {
+		// The reply draft was last saved a minute before the top-level draft
+		later := now.Add(time.Hour)
+		_, err := svc.GetDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, &parent.ID, later)
+		assert.ErrorIs(t, err, ErrCommentDraftNotFound)
+		drafts, err := svc.ListUserDrafts(ctx, alice.ID, later)
+		require.NoError(t, err)
+		require.Len(t, drafts, 1)
+		assert.Nil(t, drafts[0].ParentCommentID)
+
+		deleted, err := svc.CleanupExpired(ctx, later)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+	})
+
+	t.Run("discards drafts", func(t *testing.T) {
+		require.NoError(t, svc.DiscardDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, nil))
+		assert.ErrorIs(t, svc.DiscardDraft(ctx, models.EntityTypeEpic, "EP-001", alice.ID, nil), ErrCommentDraftNotFound)
+
+		drafts, err := svc.ListUserDrafts(ctx, alice.ID, now)
+		require.NoError(t, err)
+		assert.Empty(t, drafts)
+	})
+}
@@ -0,0 +1,450 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// removedCommentContent replaces the content of a published comment a moderator rejected; the original
+// content is kept in the moderation record
+const removedCommentContent = "[REMOVED: Rejected by a moderator]"
+
+var (
+	ErrCommentBlocked              = apperrors.New(apperrors.KindUnprocessable, "COMMENT_BLOCKED", "comment was blocked by content moderation")
+	ErrModerationRecordNotFound    = apperrors.New(apperrors.KindNotFound, "MODERATION_RECORD_NOT_FOUND", "moderation record not found")
+	ErrInvalidModerationStatus     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid moderation status")
+	ErrModerationAlreadyReviewed   = apperrors.New(apperrors.KindConflict, "MODERATION_REVIEWED", "comment was already approved or rejected")
+	ErrModerationNotAppealable     = apperrors.New(apperrors.KindConflict, "MODERATION_NOT_APPEALABLE", "only blocked or rejected comments can be appealed, once")
+	ErrModerationNotAuthor         = apperrors.New(apperrors.KindForbidden, apperrors.CodeInsufficientPermissions, "only the author can appeal a moderation decision")
+	ErrModerationEmptyAppealReason = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "appeal reason cannot be empty")
+)
+
+// ModerationDecision is what content moderation does with a comment
+type ModerationDecision string
+
+const (
+	ModerationAllow ModerationDecision = "allow" // Publish the comment
+	ModerationFlag  ModerationDecision = "flag"  // Publish the comment and queue it for review
+	ModerationBlock ModerationDecision = "block" // Do not publish the comment
+)
+
+// Sources of moderation verdicts
+const (
+	ModerationSourceKeywords = "keywords"
+	ModerationSourceAI       = "ai"
+)
+
+// severity orders decisions so the strictest verdict of several moderators wins
+func (d ModerationDecision) severity() int {
+	switch d {
+	case ModerationBlock:
+		return 2
+	case ModerationFlag:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ModerationVerdict is the decision of a moderator on a comment
+type ModerationVerdict struct {
+	Decision ModerationDecision
+	Reason   string // Why the comment was flagged or blocked
+	Source   string // Moderator that reached the decision
+}
+
+// CommentModerator decides whether comment content may be published
+type CommentModerator interface {
+	Moderate(ctx context.Context, content string, authorID uuid.UUID) ModerationVerdict
+}
+
+// keywordRule matches a blocked or flagged word or phrase
+type keywordRule struct {
+	keyword string
+	pattern *regexp.Regexp
+}
+
+// keywordModerator blocks or flags comments containing configured words or phrases
+type keywordModerator struct {
+	blocked []keywordRule
+	flagged []keywordRule
+}
+
+// NewKeywordModerator creates a moderator matching whole words and phrases case-insensitively; blocked
+// keywords take precedence over flagged ones
+func NewKeywordModerator(blocked, flagged []string) CommentModerator {
+	return &keywordModerator{
+		blocked: keywordRules(blocked),
+		flagged: keywordRules(flagged),
+	}
+}
+
+// keywordRules compiles keywords into whole-word patterns, skipping empty ones
+func keywordRules(keywords []string) []keywordRule {
+	rules := make([]keywordRule, 0, len(keywords))
+	for _, keyword := range keywords {
+		if keyword = strings.TrimSpace(keyword); keyword == "" {
+			continue
+		}
+		pattern := regexp.MustCompile(`(?i)(^|\W)` + regexp.QuoteMeta(keyword) + `($|\W)`)
+		rules = append(rules, keywordRule{keyword: keyword, pattern: pattern})
+	}
+	return rules
+}
+
+// Moderate checks the content against the blocked keywords, then the flagged ones
+func (m *keywordModerator) Moderate(ctx context.Context, content string, authorID uuid.UUID) ModerationVerdict {
+	for _, rule := range m.blocked {
+		if rule.pattern.MatchString(content) {
+			return ModerationVerdict{Decision: ModerationBlock, Reason: fmt.Sprintf("contains blocked keyword %q", rule.keyword), Source: ModerationSourceKeywords}
+		}
+	}
+	for _, rule := range m.flagged {
+		if rule.pattern.MatchString(content) {
+			return ModerationVerdict{Decision: ModerationFlag, Reason: fmt.Sprintf("contains flagged keyword %q", rule.keyword), Source: ModerationSourceKeywords}
+		}
+	}
+	return ModerationVerdict{Decision: ModerationAllow}
+}
+
+// commentModerationInstructions are the system instructions of the AI classifier
+const commentModerationInstructions = `You moderate comments in a requirements management tool used by product teams.
+Reply with a JSON object only, without any other text, with these fields:
+"decision": "allow" for acceptable comments, including blunt criticism of the work;
+"flag" for comments a moderator should look at, such as rude or personal remarks about colleagues;
+"block" for abusive, hateful, harassing, sexually explicit or spam content and for comments exposing secrets such as passwords,
+"reason": one short sentence explaining a flag or block, in the language of the comment.`
+
+// aiModerator classifies comments with the language model
+type aiModerator struct {
+	llm    LLMClient
+	logger *logrus.Logger
+}
+
+// NewAIModerator creates a moderator that asks the language model for a verdict. Comments are allowed when
+// the model fails or gives no usable verdict, so an outage of the provider does not stop discussions.
+func NewAIModerator(llm LLMClient, logger *logrus.Logger) CommentModerator {
+	return &aiModerator{llm: llm, logger: logger}
+}
+
+// Moderate asks the language model whether the comment may be published
+func (m *aiModerator) Moderate(ctx context.Context, content string, authorID uuid.UUID) ModerationVerdict {
+	completion, err := m.llm.Complete(ctx, LLMRequest{
+		Feature: LLMFeatureCommentModeration,
+		UserID:  &authorID,
+		System:  commentModerationInstructions,
+		Prompt:  content,
+	})
+	if err == nil {
+		var verdict ModerationVerdict
+		if verdict, err = parseModerationVerdict(completion.Text); err == nil {
+			return verdict
+		}
+	}
+	m.logger.WithFields(logrus.Fields{"author_id": authorID, "error": err.Error()}).Warn("AI comment moderation failed, comment allowed")
+	return ModerationVerdict{Decision: ModerationAllow}
+}
+
+// parseModerationVerdict extracts the verdict from the reply of the language model
+func parseModerationVerdict(reply string) (ModerationVerdict, error) {
+	var parsed struct {
+		Decision ModerationDecision `json:"decision"`
+		Reason   string             `json:"reason"`
+	}
+	start, end := strings.Index(reply, "{"), strings.LastIndex(reply, "}")
+	if start < 0 || end < start {
+		return ModerationVerdict{}, fmt.Errorf("%w: reply contains no JSON object", ErrLLMUnavailable)
+	}
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &parsed); err != nil {
+		return ModerationVerdict{}, fmt.Errorf("%w: invalid reply: %v", ErrLLMUnavailable, err)
+	}
+
+	switch parsed.Decision {
+	case ModerationAllow:
+		return ModerationVerdict{Decision: ModerationAllow}, nil
+	case ModerationFlag, ModerationBlock:
+		reason := strings.TrimSpace(parsed.Reason)
+		if reason == "" {
+			reason = "classified as inappropriate"
+		}
+		return ModerationVerdict{Decision: parsed.Decision, Reason: reason, Source: ModerationSourceAI}, nil
+	default:
+		return ModerationVerdict{}, fmt.Errorf("%w: unknown decision %q", ErrLLMUnavailable, parsed.Decision)
+	}
+}
+
+// moderationPipeline runs moderators in order and returns the strictest verdict
+type moderationPipeline []CommentModerator
+
+// NewModerationPipeline combines moderators, skipping nil ones; it returns nil when none is left, which
+// disables moderation. Put cheap moderators first: the pipeline stops at the first block.
+func NewModerationPipeline(moderators ...CommentModerator) CommentModerator {
+	var pipeline moderationPipeline
+	for _, moderator := range moderators {
+		if moderator != nil {
+			pipeline = append(pipeline, moderator)
+		}
+	}
+	if len(pipeline) == 0 {
+		return nil
+	}
+	return pipeline
+}
+
+// Moderate returns the strictest verdict of the moderators
+func (p moderationPipeline) Moderate(ctx context.Context, content string, authorID uuid.UUID) ModerationVerdict {
+	result := ModerationVerdict{Decision: ModerationAllow}
+	for _, moderator := range p {
+		verdict := moderator.Moderate(ctx, content, authorID)
+		if verdict.Decision.severity() > result.Decision.severity() {
+			result = verdict
+		}
+		if result.Decision == ModerationBlock {
+			break
+		}
+	}
+	return result
+}
+
+// CommentModerationService defines the interface for the review of moderated comments
+type CommentModerationService interface {
+	ListQueue(ctx context.Context, filters CommentModerationFilters) ([]models.CommentModeration, int64, error)
+	ListForAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.CommentModeration, int64, error)
+	Approve(ctx context.Context, id, reviewerID uuid.UUID, note string) (*models.CommentModeration, error)
+	Reject(ctx context.Context, id, reviewerID uuid.UUID, note string) (*models.CommentModeration, error)
+	Appeal(ctx context.Context, id, authorID uuid.UUID, reason string) (*models.CommentModeration, error)
+}
+
+// CommentModerationFilters represents filters for the moderation queue
+type CommentModerationFilters struct {
+	// Status limits the queue to one review state; nil lists the records waiting for a moderator,
+	// flagged and appealed
+	Status *models.ModerationStatus
+	Limit  int
+	Offset int
+}
+
+// ReviewModerationRequest represents the decision of a moderator on a comment
+// @Description Request payload for approving or rejecting a moderated comment
+type ReviewModerationRequest struct {
+	// Note tells the author why the comment was approved or rejected
+	// @Description Note shown to the author (optional, max 5000 characters)
+	// @MaxLength 5000
+	// @Example "Quoted feedback, fine in context"
+	Note string `json:"note,omitempty" binding:"max=5000"`
+}
+
+// AppealModerationRequest represents the appeal of an author against a moderation decision
+// @Description Request payload for appealing a blocked or rejected comment
+type AppealModerationRequest struct {
+	// Reason tells the moderator why the comment should be published
+	// @Description Why the comment should be published (required, max 5000 characters)
+	// @MaxLength 5000
+	// @Example "The word is quoted from customer feedback"
+	Reason string `json:"reason" binding:"required,max=5000"`
+}
+
+// commentModerationService implements CommentModerationService interface
+type commentModerationService struct {
+	moderationRepo repository.CommentModerationRepository
+	repos          *repository.Repositories
+}
+
+// NewCommentModerationService creates a new comment moderation service instance
+func NewCommentModerationService(repos *repository.Repositories) CommentModerationService {
+	return &commentModerationService{
+		moderationRepo: repos.CommentModeration,
+		repos:          repos,
+	}
+}
+
+// ListQueue lists moderation records, oldest first
+func (s *commentModerationService) ListQueue(ctx context.Context, filters CommentModerationFilters) ([]models.CommentModeration, int64, error) {
+	statuses := []models.ModerationStatus{models.ModerationFlagged, models.ModerationAppealed}
+	if filters.Status != nil {
+		if !filters.Status.IsValid() {
+			return nil, 0, ErrInvalidModerationStatus
+		}
+		statuses = []models.ModerationStatus{*filters.Status}
+	}
+
+	records, total, err := s.moderationRepo.ListWithFilters(ctx, repository.CommentModerationFilters{
+		Statuses: statuses,
+		Limit:    filters.Limit,
+		Offset:   filters.Offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list moderation records: %w", err)
+	}
+	return records, total, nil
+}
+
+// ListForAuthor lists the moderation records of an author's comments, so they can follow up and appeal
+func (s *commentModerationService) ListForAuthor(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]models.CommentModeration, int64, error) {
+	records, total, err := s.moderationRepo.ListWithFilters(ctx, repository.CommentModerationFilters{
+		AuthorID: &authorID,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list moderation records: %w", err)
+	}
+	return records, total, nil
+}
+
+// Approve publishes a moderated comment: a blocked comment or edit is applied and rejected content is
+// restored. Flagged comments are already published and only leave the queue.
+func (s *commentModerationService) Approve(ctx context.Context, id, reviewerID uuid.UUID, note string) (*models.CommentModeration, error) {
+	record, err := s.getReviewable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		// Published content is applied without moderation; the moderator has seen it
+		comments := NewCommentService(tx, nil)
+		switch {
+		case record.Request != "" && record.Action == models.ModerationActionCreate:
+			var req CreateCommentRequest
+			if err := json.Unmarshal([]byte(record.Request), &req); err != nil {
+				return fmt.Errorf("failed to decode blocked comment: %w", err)
+			}
+			comment, err := comments.CreateComment(ctx, req)
+			if err != nil {
+				return err
+			}
+			record.CommentID = &comment.ID
+		case record.Request != "":
+			var req UpdateCommentRequest
+			if err := json.Unmarshal([]byte(record.Request), &req); err != nil {
+				return fmt.Errorf("failed to decode blocked edit: %w", err)
+			}
+			if err := s.updateComment(ctx, comments, record, req); err != nil {
+				return err
+			}
+		case record.ReviewedAt != nil:
+			// A rejected comment was appealed; restore its content
+			if err := s.updateComment(ctx, comments, record, UpdateCommentRequest{Content: record.Content}); err != nil {
+				return err
+			}
+		}
+		record.Request = ""
+		return tx.CommentModeration.Update(ctx, s.review(record, models.ModerationApproved, reviewerID, note))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Reject keeps a moderated comment unpublished; the content of a published comment is replaced by a notice
+func (s *commentModerationService) Reject(ctx context.Context, id, reviewerID uuid.UUID, note string) (*models.CommentModeration, error) {
+	record, err := s.getReviewable(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		if record.Status == models.ModerationFlagged && record.CommentID != nil {
+			comment, err := tx.Comment.GetByID(ctx, *record.CommentID)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return fmt.Errorf("failed to get comment: %w", err)
+			}
+			if comment != nil {
+				comment.Content = removedCommentContent
+				if err := tx.Comment.Update(ctx, comment); err != nil {
+					return fmt.Errorf("failed to remove comment: %w", err)
+				}
+			}
+		}
+		return tx.CommentModeration.Update(ctx, s.review(record, models.ModerationRejected, reviewerID, note))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Appeal asks a moderator to review a blocked or rejected comment; every decision can be appealed once
+func (s *commentModerationService) Appeal(ctx context.Context, id, authorID uuid.UUID, reason string) (*models.CommentModeration, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrModerationEmptyAppealReason
+	}
+
+	record, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if record.AuthorID != authorID {
+		return nil, ErrModerationNotAuthor
+	}
+	if (record.Status != models.ModerationBlocked && record.Status != models.ModerationRejected) || record.AppealReason != nil {
+		return nil, ErrModerationNotAppealable
+	}
+
+	record.Status = models.ModerationAppealed
+	record.AppealReason = &reason
+	if err := s.moderationRepo.Update(ctx, record); err != nil {
+		return nil, fmt.Errorf("failed to appeal moderation decision: %w", err)
+	}
+	return record, nil
+}
+
+// get retrieves a moderation record by ID
+func (s *commentModerationService) get(ctx context.Context, id uuid.UUID) (*models.CommentModeration, error) {
+	record, err := s.moderationRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrModerationRecordNotFound
+		}
+		return nil, fmt.Errorf("failed to get moderation record: %w", err)
+	}
+	return record, nil
+}
+
+// getReviewable retrieves a moderation record a moderator can still approve or reject
+func (s *commentModerationService) getReviewable(ctx context.Context, id uuid.UUID) (*models.CommentModeration, error) {
+	record, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !record.Status.IsPending() && record.Status != models.ModerationBlocked {
+		return nil, ErrModerationAlreadyReviewed
+	}
+	return record, nil
+}
+
+// updateComment applies an edit to the moderated comment
+func (s *commentModerationService) updateComment(ctx context.Context, comments CommentService, record *models.CommentModeration, req UpdateCommentRequest) error {
+	if record.CommentID == nil {
+		return ErrCommentNotFound
+	}
+	_, err := comments.UpdateComment(ctx, *record.CommentID, req)
+	return err
+}
+
+// review records the decision of a moderator
+func (s *commentModerationService) review(record *models.CommentModeration, status models.ModerationStatus, reviewerID uuid.UUID, note string) *models.CommentModeration {
+	now := time.Now().UTC()
+	record.Status = status
+	record.ReviewerID = &reviewerID
+	record.ReviewedAt = &now
+	record.ReviewNote = nil
+	if note = strings.TrimSpace(note); note != "" {
+		record.ReviewNote = &note
+	}
+	return record
+}
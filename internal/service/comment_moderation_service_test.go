@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestKeywordModerator(t *testing.T) {
+	moderator := NewKeywordModerator([]string{"idiot", "password:", " "}, []string{"stupid", "waste of time", "idiot"})
+	author := uuid.New()
+
+	tests := []struct {
+		content  string
+		decision ModerationDecision
+		reason   string
+	}{
+		{"Looks good to me", ModerationAllow, ""},
+		{"What an IDIOT idea", ModerationBlock, `contains blocked keyword "idiot"`},
+		{"Admin password: hunter2", ModerationBlock, `contains blocked keyword "password:"`},
+		{"This is stupid.", ModerationFlag, `contains flagged keyword "stupid"`},
+		{"A complete Waste of Time", ModerationFlag, `contains flagged keyword "waste of time"`},
+		{"Idiotic, but only as a word part", ModerationAllow, ""},
+	}
+	for _, tt := range tests {
+		verdict := moderator.Moderate(context.Background(), tt.content, author)
+		assert.Equal(t, tt.decision, verdict.Decision, tt.content)
+		assert.Equal(t, tt.reason, verdict.Reason, tt.content)
+		if tt.decision != ModerationAllow {
+			assert.Equal(t, ModerationSourceKeywords, verdict.Source)
+		}
+	}
+}
+
+func TestAIModerator(t *testing.T) {
+	author := uuid.New()
+
+	t.Run("uses the verdict of the model", func(t *testing.T) {
+		llm := &fakeLLMClient{reply: "```json\n" + `{"decision": "block", "reason": "Personal insult"}` + "\n```"}
+		verdict := NewAIModerator(llm, logrus.New()).Moderate(context.Background(), "You are useless", author)
+		assert.Equal(t, ModerationVerdict{Decision: ModerationBlock, Reason: "Personal insult", Source: ModerationSourceAI}, verdict)
+		require.Len(t, llm.requests, 1)
+		assert.Equal(t, LLMFeatureCommentModeration, llm.requests[0].Feature)
+		assert.Equal(t, &author, llm.requests[0].UserID)
+		assert.Equal(t, "You are useless", llm.requests[0].Prompt)
+	})
+
+	t.Run("allows comments when the model fails", func(t *testing.T) {
+		for _, llm := range []*fakeLLMClient{
+			{err: ErrLLMUnavailable},
+			{reply: "I cannot decide"},
+			{reply: `{"decision": "maybe"}`},
+		} {
+			verdict := NewAIModerator(llm, logrus.New()).Moderate(context.Background(), "Hello", author)
+			assert.Equal(t, ModerationAllow, verdict.Decision)
+		}
+	})
+}
+
+// fixedModerator returns the same verdict for every comment and counts its calls
+type fixedModerator struct {
+	verdict ModerationVerdict
+	calls   int
+}
+
+func (m *fixedModerator) Moderate(ctx context.Context, content string, authorID uuid.UUID) ModerationVerdict {
+	m.calls++
+	return m.verdict
+}
+
+func TestModerationPipeline(t *testing.T) {
+	assert.Nil(t, NewModerationPipeline(nil, nil))
+
+	allow := &fixedModerator{verdict: ModerationVerdict{Decision: ModerationAllow}}
+	flag := &fixedModerator{verdict: ModerationVerdict{Decision: ModerationFlag, Reason: "rude", Source: ModerationSourceAI}}
+	block := &fixedModerator{verdict: ModerationVerdict{Decision: ModerationBlock, Reason: "spam", Source: ModerationSourceKeywords}}
+
+	assert.Equal(t, flag.verdict, NewModerationPipeline(allow, flag, nil).Moderate(context.Background(), "x", uuid.New()))
+	assert.Equal(t, block.verdict, NewModerationPipeline(block, flag).Moderate(context.Background(), "x", uuid.New()))
+	assert.Equal(t, 1, flag.calls, "the pipeline stops at the first block")
+}
+
+func TestCommentModeration(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.Comment{}, &models.CommentModeration{}, &models.CommentThreadLock{}))
+
+	author := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleCommenter}
+	admin := &models.User{Username: "admin", Email: "admin@example.com", PasswordHash: "hash", Role: models.RoleAdministrator}
+	require.NoError(t, db.Create(author).Error)
+	require.NoError(t, db.Create(admin).Error)
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog,
+		CreatorID: admin.ID, AssigneeID: admin.ID, Priority: models.PriorityHigh}
+	require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(&epic).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	comments := NewCommentService(repos, NewKeywordModerator([]string{"idiot"}, []string{"stupid"}))
+	moderation := NewCommentModerationService(repos)
+	create := func(content string) (*CommentResponse, error) {
+		return comments.CreateComment(ctx, CreateCommentRequest{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: author.ID, Content: content})
+	}
+	queue := func(status *models.ModerationStatus) []models.CommentModeration {
+		records, _, err := moderation.ListQueue(ctx, CommentModerationFilters{Status: status})
+		require.NoError(t, err)
+		return records
+	}
+	blocked := models.ModerationBlocked
+
+	t.Run("publishes allowed comments without a record", func(t *testing.T) {
+		_, err := create("Looks good")
+		require.NoError(t, err)
+		assert.Empty(t, queue(nil))
+	})
+
+	var flagged *CommentResponse
+	t.Run("publishes flagged comments and queues them", func(t *testing.T) {
+		flagged, err = create("This is stupid")
+		require.NoError(t, err)
+
+		records := queue(nil)
+		require.Len(t, records, 1)
+		assert.Equal(t, models.ModerationFlagged, records[0].Status)
+		assert.Equal(t, &flagged.ID, records[0].CommentID)
+		assert.Equal(t, models.ModerationActionCreate, records[0].Action)
+		assert.Equal(t, `contains flagged keyword "stupid"`, records[0].Reason)
+		assert.Equal(t, "alice", records[0].Author.Username)
+	})
+
+	t.Run("rejecting a flagged comment removes its content", func(t *testing.T) {
+		record, err := moderation.Reject(ctx, queue(nil)[0].ID, admin.ID, "Please stay polite")
+		require.NoError(t, err)
+		assert.Equal(t, models.ModerationRejected, record.Status)
+		assert.Equal(t, &admin.ID, record.ReviewerID)
+		assert.Equal(t, "Please stay polite", *record.ReviewNote)
+
+		comment, err := comments.GetComment(ctx, flagged.ID)
+		require.NoError(t, err)
+		assert.Equal(t, removedCommentContent, comment.Content)
+
+		_, err = moderation.Reject(ctx, record.ID, admin.ID, "")
+		assert.ErrorIs(t, err, ErrModerationAlreadyReviewed)
+	})
+
+	t.Run("an appealed rejection restores the content when approved", func(t *testing.T) {
+		records, _, err := moderation.ListForAuthor(ctx, author.ID, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+
+		_, err = moderation.Appeal(ctx, records[0].ID, admin.ID, "Not mine")
+		assert.ErrorIs(t, err, ErrModerationNotAuthor)
+		_, err = moderation.Appeal(ctx, records[0].ID, author.ID, " ")
+		assert.ErrorIs(t, err, ErrModerationEmptyAppealReason)
+
+		record, err := moderation.Appeal(ctx, records[0].ID, author.ID, "It was about the process")
+		require.NoError(t, err)
+		assert.Equal(t, models.ModerationAppealed, record.Status)
+		assert.Len(t, queue(nil), 1)
+
+		_, err = moderation.Approve(ctx, record.ID, admin.ID, "")
+		require.NoError(t, err)
+		comment, err := comments.GetComment(ctx, flagged.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "This is stupid", comment.Content)
+		assert.Empty(t, queue(nil))
+	})
+
+	var blockedRecord models.CommentModeration
+	t.Run("keeps blocked comments unpublished", func(t *testing.T) {
+		_, err := create("What an idiot")
+		assert.ErrorIs(t, err, ErrCommentBlocked)
+
+		records := queue(&blocked)
+		require.Len(t, records, 1)
+		blockedRecord = records[0]
+		assert.Contains(t, err.Error(), blockedRecord.ID.String())
+		assert.Nil(t, blockedRecord.CommentID)
+		assert.Equal(t, "What an idiot", blockedRecord.Content)
+
+		var count int64
+		require.NoError(t, db.Model(&models.Comment{}).Where("content = ?", "What an idiot").Count(&count).Error)
+		assert.Zero(t, count)
+	})
+
+	t.Run("approving an appealed block publishes the comment", func(t *testing.T) {
+		_, err := moderation.Appeal(ctx, blockedRecord.ID, author.ID, "Quoting a customer")
+		require.NoError(t, err)
+		_, err = moderation.Appeal(ctx, blockedRecord.ID, author.ID, "Again")
+		assert.ErrorIs(t, err, ErrModerationNotAppealable)
+
+		record, err := moderation.Approve(ctx, blockedRecord.ID, admin.ID, "Fine in context")
+		require.NoError(t, err)
+		require.NotNil(t, record.CommentID)
+		assert.Empty(t, record.Request)
+
+		comment, err := comments.GetComment(ctx, *record.CommentID)
+		require.NoError(t, err)
+		assert.Equal(t, "What an idiot", comment.Content)
+		assert.Equal(t, author.ID, comment.AuthorID)
+	})
+
+	t.Run("moderates edits", func(t *testing.T) {
+		comment, err := create("Fine comment")
+		require.NoError(t, err)
+
+		_, err = comments.UpdateComment(ctx, comment.ID, UpdateCommentRequest{Content: "Edited by an idiot"})
+		assert.ErrorIs(t, err, ErrCommentBlocked)
+		unchanged, err := comments.GetComment(ctx, comment.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Fine comment", unchanged.Content)
+
+		records := queue(&blocked)
+		require.Len(t, records, 1)
+		assert.Equal(t, models.ModerationActionUpdate, records[0].Action)
+		assert.Equal(t, &comment.ID, records[0].CommentID)
+
+		_, err = moderation.Approve(ctx, records[0].ID, admin.ID, "")
+		require.NoError(t, err)
+		edited, err := comments.GetComment(ctx, comment.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Edited by an idiot", edited.Content)
+	})
+
+	t.Run("validates filters and records", func(t *testing.T) {
+		invalid := models.ModerationStatus("pending")
+		_, _, err := moderation.ListQueue(ctx, CommentModerationFilters{Status: &invalid})
+		assert.ErrorIs(t, err, ErrInvalidModerationStatus)
+
+		_, err = moderation.Approve(ctx, uuid.New(), admin.ID, "")
+		assert.True(t, errors.Is(err, ErrModerationRecordNotFound))
+	})
+}
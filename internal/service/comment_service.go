@@ -1,46 +1,55 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 )
 
 var (
-	ErrCommentNotFound          = errors.New("comment not found")
-	ErrCommentHasReplies        = errors.New("comment has replies and cannot be deleted")
-	ErrCommentInvalidEntityType = errors.New("invalid entity type")
-	ErrCommentEntityNotFound    = errors.New("entity not found")
-	ErrCommentAuthorNotFound    = errors.New("author not found")
-	ErrParentCommentNotFound    = errors.New("parent comment not found")
-	ErrParentCommentWrongEntity = errors.New("parent comment must be on the same entity")
-	ErrEmptyContent             = errors.New("content cannot be empty")
-	ErrInvalidInlineCommentData = errors.New("inline comments require linked_text, text_position_start, and text_position_end")
-	ErrInvalidTextPosition      = errors.New("invalid text position: start must be >= 0 and end must be >= start")
-	ErrEmptyLinkedText          = errors.New("linked_text cannot be empty for inline comments")
+	ErrCommentNotFound          = apperrors.New(apperrors.KindNotFound, "COMMENT_NOT_FOUND", "comment not found")
+	ErrCommentHasReplies        = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "comment has replies and cannot be deleted")
+	ErrCommentInvalidEntityType = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid entity type")
+	ErrCommentEntityNotFound    = apperrors.New(apperrors.KindNotFound, apperrors.CodeEntityNotFound, "entity not found")
+	ErrCommentAuthorNotFound    = apperrors.New(apperrors.KindNotFound, "USER_NOT_FOUND", "author not found")
+	ErrParentCommentNotFound    = apperrors.New(apperrors.KindNotFound, "COMMENT_NOT_FOUND", "parent comment not found")
+	ErrParentCommentWrongEntity = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "parent comment must be on the same entity")
+	ErrEmptyContent             = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "content cannot be empty")
+	ErrInvalidInlineCommentData = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "inline comments require linked_text, text_position_start, and text_position_end")
+	ErrInvalidTextPosition      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid text position: start must be >= 0 and end must be >= start")
+	ErrEmptyLinkedText          = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "linked_text cannot be empty for inline comments")
+	ErrInvalidCommentCategory   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid comment category: must be question, blocker or suggestion")
 )
 
 // CommentService defines the interface for comment business logic
 type CommentService interface {
-	CreateComment(req CreateCommentRequest) (*CommentResponse, error)
-	GetComment(id uuid.UUID) (*CommentResponse, error)
-	UpdateComment(id uuid.UUID, req UpdateCommentRequest) (*CommentResponse, error)
-	DeleteComment(id uuid.UUID) error
-	GetCommentsByEntity(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
-	GetThreadedComments(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
-	GetCommentsByStatus(isResolved bool) ([]CommentResponse, error)
-	GetInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
-	GetVisibleInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
-	ValidateInlineCommentsAfterTextChange(entityType models.EntityType, entityID uuid.UUID, newDescription string) error
-	ResolveComment(id uuid.UUID) (*CommentResponse, error)
-	UnresolveComment(id uuid.UUID) (*CommentResponse, error)
-	GetCommentReplies(parentID uuid.UUID) ([]CommentResponse, error)
-	GetCommentRepliesWithPagination(parentID uuid.UUID, limit, offset int) ([]CommentResponse, int64, error)
+	CreateComment(ctx context.Context, req CreateCommentRequest) (*CommentResponse, error)
+	GetComment(ctx context.Context, id uuid.UUID) (*CommentResponse, error)
+	UpdateComment(ctx context.Context, id uuid.UUID, req UpdateCommentRequest) (*CommentResponse, error)
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+	GetCommentsByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
+	GetThreadedComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
+	GetCommentsByStatus(ctx context.Context, isResolved bool) ([]CommentResponse, error)
+	GetInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
+	GetVisibleInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error)
+	ValidateInlineCommentsAfterTextChange(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, newDescription string) error
+	ResolveComment(ctx context.Context, id uuid.UUID) (*CommentResponse, error)
+	UnresolveComment(ctx context.Context, id uuid.UUID) (*CommentResponse, error)
+	GetCommentReplies(ctx context.Context, parentID uuid.UUID) ([]CommentResponse, error)
+	GetCommentRepliesWithPagination(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]CommentResponse, int64, error)
+	ListComments(ctx context.Context, filters CommentFilters) ([]CommentResponse, int64, error)
+	GetCategoryCounts(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (map[models.CommentCategory]int64, error)
+	GetThreadLock(ctx context.Context, entityType models.EntityType, idOrReference string) (*models.CommentThreadLock, error)
+	LockThread(ctx context.Context, entityType models.EntityType, idOrReference, reason string, userID uuid.UUID) (*models.CommentThreadLock, error)
+	UnlockThread(ctx context.Context, entityType models.EntityType, idOrReference string) error
 }
 
 // commentService implements CommentService interface
@@ -48,14 +57,17 @@ type commentService struct {
 	commentRepo repository.CommentRepository
 	userRepo    repository.UserRepository
 	repos       *repository.Repositories
+	moderator   CommentModerator
 }
 
-// NewCommentService creates a new comment service instance
-func NewCommentService(repos *repository.Repositories) CommentService {
+// NewCommentService creates a new comment service instance. moderator checks new and edited content
+// before it is published; nil disables content moderation.
+func NewCommentService(repos *repository.Repositories, moderator CommentModerator) CommentService {
 	return &commentService{
 		commentRepo: repos.Comment,
 		userRepo:    repos.User,
 		repos:       repos,
+		moderator:   moderator,
 	}
 }
 
@@ -69,57 +81,90 @@ type CreateCommentRequest struct {
 	LinkedText        *string           `json:"linked_text"`
 	TextPositionStart *int              `json:"text_position_start"`
 	TextPositionEnd   *int              `json:"text_position_end"`
+	// Category is the optional comment category: question, blocker or suggestion
+	Category *models.CommentCategory `json:"category,omitempty" example:"blocker"`
 }
 
 // UpdateCommentRequest represents the request to update a comment
 type UpdateCommentRequest struct {
 	Content string `json:"content"`
+	// Category changes the comment category when provided; an empty string clears it
+	Category *models.CommentCategory `json:"category,omitempty" example:"question"`
+}
+
+// CommentFilters represents filters for listing comments across entities
+// @Description Filters and pagination options for listing comments across all entities
+type CommentFilters struct {
+	// EntityType filters comments by the type of entity they are attached to (optional)
+	EntityType *models.EntityType `json:"entity_type,omitempty"`
+	// Category filters comments by category (optional)
+	Category *models.CommentCategory `json:"category,omitempty"`
+	// IsResolved filters comments by resolution status (optional)
+	IsResolved *bool `json:"is_resolved,omitempty"`
+	// AuthorID filters comments by author (optional)
+	AuthorID *uuid.UUID `json:"author_id,omitempty"`
+	// EntityAssigneeID limits comments to entities assigned to this user (optional)
+	EntityAssigneeID *uuid.UUID `json:"entity_assignee_id,omitempty"`
+	// Limit is the maximum number of comments to return
+	Limit int `json:"limit,omitempty"`
+	// Offset is the number of comments to skip
+	Offset int `json:"offset,omitempty"`
 }
 
 // CommentResponse represents a comment in API responses
 type CommentResponse struct {
-	ID                uuid.UUID         `json:"id"`
-	EntityType        models.EntityType `json:"entity_type"`
-	EntityID          uuid.UUID         `json:"entity_id"`
-	ParentCommentID   *uuid.UUID        `json:"parent_comment_id"`
-	AuthorID          uuid.UUID         `json:"author_id"`
-	Author            *models.User      `json:"author,omitempty"`
-	CreatedAt         string            `json:"created_at"`
-	UpdatedAt         string            `json:"updated_at"`
-	Content           string            `json:"content"`
-	IsResolved        bool              `json:"is_resolved"`
-	LinkedText        *string           `json:"linked_text"`
-	TextPositionStart *int              `json:"text_position_start"`
-	TextPositionEnd   *int              `json:"text_position_end"`
-	Replies           []CommentResponse `json:"replies,omitempty"`
-	IsInline          bool              `json:"is_inline"`
-	IsReply           bool              `json:"is_reply"`
-	Depth             int               `json:"depth"`
+	ID                uuid.UUID               `json:"id"`
+	EntityType        models.EntityType       `json:"entity_type"`
+	EntityID          uuid.UUID               `json:"entity_id"`
+	ParentCommentID   *uuid.UUID              `json:"parent_comment_id"`
+	AuthorID          uuid.UUID               `json:"author_id"`
+	Author            *models.User            `json:"author,omitempty"`
+	CreatedAt         string                  `json:"created_at"`
+	UpdatedAt         string                  `json:"updated_at"`
+	Content           string                  `json:"content"`
+	IsResolved        bool                    `json:"is_resolved"`
+	IsSystem          bool                    `json:"is_system"`
+	Category          *models.CommentCategory `json:"category,omitempty"`
+	LinkedText        *string                 `json:"linked_text"`
+	TextPositionStart *int                    `json:"text_position_start"`
+	TextPositionEnd   *int                    `json:"text_position_end"`
+	Replies           []CommentResponse       `json:"replies,omitempty"`
+	IsInline          bool                    `json:"is_inline"`
+	IsReply           bool                    `json:"is_reply"`
+	Depth             int                     `json:"depth"`
 }
 
 // CreateComment creates a new comment
-func (s *commentService) CreateComment(req CreateCommentRequest) (*CommentResponse, error) {
+func (s *commentService) CreateComment(ctx context.Context, req CreateCommentRequest) (*CommentResponse, error) {
 	// Validate entity type
 	if !isValidEntityType(req.EntityType) {
 		return nil, ErrCommentInvalidEntityType
 	}
 
 	// Validate entity exists
-	if err := s.validateEntityExists(req.EntityType, req.EntityID); err != nil {
+	if err := s.validateEntityExists(ctx, req.EntityType, req.EntityID); err != nil {
 		return nil, err
 	}
 
 	// Validate author exists
-	if _, err := s.userRepo.GetByID(req.AuthorID); err != nil {
+	author, err := s.userRepo.GetByID(ctx, req.AuthorID)
+	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrCommentAuthorNotFound
 		}
 		return nil, fmt.Errorf("failed to validate author: %w", err)
 	}
 
+	// Locked threads only accept comments and replies from administrators
+	if !author.IsAdministrator() {
+		if err := s.checkThreadOpen(ctx, req.EntityType, req.EntityID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate parent comment if specified
 	if req.ParentCommentID != nil {
-		parentComment, err := s.commentRepo.GetByID(*req.ParentCommentID)
+		parentComment, err := s.commentRepo.GetByID(ctx, *req.ParentCommentID)
 		if err != nil {
 			if errors.Is(err, repository.ErrNotFound) {
 				return nil, ErrParentCommentNotFound
@@ -140,7 +185,7 @@ func (s *commentService) CreateComment(req CreateCommentRequest) (*CommentRespon
 
 	// For inline comments, validate that the linked text matches the actual text fragment
 	if req.LinkedText != nil && req.TextPositionStart != nil && req.TextPositionEnd != nil {
-		if err := s.validateTextFragment(req.EntityType, req.EntityID, *req.LinkedText, *req.TextPositionStart, *req.TextPositionEnd); err != nil {
+		if err := s.validateTextFragment(ctx, req.EntityType, req.EntityID, *req.LinkedText, *req.TextPositionStart, *req.TextPositionEnd); err != nil {
 			return nil, fmt.Errorf("text fragment validation failed: %w", err)
 		}
 	}
@@ -150,6 +195,11 @@ func (s *commentService) CreateComment(req CreateCommentRequest) (*CommentRespon
 		return nil, ErrEmptyContent
 	}
 
+	// Validate category
+	if req.Category != nil && !req.Category.IsValid() {
+		return nil, ErrInvalidCommentCategory
+	}
+
 	// Create comment
 	comment := &models.Comment{
 		EntityType:        req.EntityType,
@@ -158,12 +208,22 @@ func (s *commentService) CreateComment(req CreateCommentRequest) (*CommentRespon
 		AuthorID:          req.AuthorID,
 		Content:           strings.TrimSpace(req.Content),
 		IsResolved:        false,
+		Category:          req.Category,
 		LinkedText:        req.LinkedText,
 		TextPositionStart: req.TextPositionStart,
 		TextPositionEnd:   req.TextPositionEnd,
 	}
 
-	if err := s.commentRepo.Create(comment); err != nil {
+	// Moderate content
+	verdict := s.moderate(ctx, comment.Content, req.AuthorID)
+	record := newModerationRecord(comment, models.ModerationActionCreate, verdict)
+	if verdict.Decision == ModerationBlock {
+		return nil, s.block(ctx, record, req)
+	}
+
+	if err := s.saveModerated(ctx, comment, record, func(comments repository.CommentRepository) error {
+		return comments.Create(ctx, comment)
+	}); err != nil {
 		return nil, fmt.Errorf("failed to create comment: %w", err)
 	}
 
@@ -171,8 +231,8 @@ func (s *commentService) CreateComment(req CreateCommentRequest) (*CommentRespon
 }
 
 // GetComment retrieves a comment by ID
-func (s *commentService) GetComment(id uuid.UUID) (*CommentResponse, error) {
-	comment, err := s.commentRepo.GetByID(id)
+func (s *commentService) GetComment(ctx context.Context, id uuid.UUID) (*CommentResponse, error) {
+	comment, err := s.commentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrCommentNotFound
@@ -184,13 +244,18 @@ func (s *commentService) GetComment(id uuid.UUID) (*CommentResponse, error) {
 }
 
 // UpdateComment updates an existing comment
-func (s *commentService) UpdateComment(id uuid.UUID, req UpdateCommentRequest) (*CommentResponse, error) {
+func (s *commentService) UpdateComment(ctx context.Context, id uuid.UUID, req UpdateCommentRequest) (*CommentResponse, error) {
 	// Validate content
 	if strings.TrimSpace(req.Content) == "" {
 		return nil, ErrEmptyContent
 	}
 
-	comment, err := s.commentRepo.GetByID(id)
+	// Validate category; an empty category clears it
+	if req.Category != nil && *req.Category != "" && !req.Category.IsValid() {
+		return nil, ErrInvalidCommentCategory
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrCommentNotFound
@@ -198,19 +263,99 @@ func (s *commentService) UpdateComment(id uuid.UUID, req UpdateCommentRequest) (
 		return nil, fmt.Errorf("failed to get comment: %w", err)
 	}
 
+	// Moderate changed content
+	content := strings.TrimSpace(req.Content)
+	var record *models.CommentModeration
+	if content != comment.Content {
+		verdict := s.moderate(ctx, content, comment.AuthorID)
+		edited := *comment
+		edited.Content = content
+		record = newModerationRecord(&edited, models.ModerationActionUpdate, verdict)
+		if verdict.Decision == ModerationBlock {
+			return nil, s.block(ctx, record, req)
+		}
+	}
+
 	// Update comment
-	comment.Content = strings.TrimSpace(req.Content)
+	comment.Content = content
+	if req.Category != nil {
+		if *req.Category == "" {
+			comment.Category = nil
+		} else {
+			comment.Category = req.Category
+		}
+	}
 
-	if err := s.commentRepo.Update(comment); err != nil {
+	if err := s.saveModerated(ctx, comment, record, func(comments repository.CommentRepository) error {
+		return comments.Update(ctx, comment)
+	}); err != nil {
 		return nil, fmt.Errorf("failed to update comment: %w", err)
 	}
 
 	return s.toCommentResponse(comment), nil
 }
 
+// moderate checks content before it is published; everything is allowed without a moderator
+func (s *commentService) moderate(ctx context.Context, content string, authorID uuid.UUID) ModerationVerdict {
+	if s.moderator == nil {
+		return ModerationVerdict{Decision: ModerationAllow}
+	}
+	return s.moderator.Moderate(ctx, content, authorID)
+}
+
+// newModerationRecord creates the moderation record of a flagged or blocked comment, or nil for allowed ones
+func newModerationRecord(comment *models.Comment, action models.ModerationAction, verdict ModerationVerdict) *models.CommentModeration {
+	if verdict.Decision == ModerationAllow {
+		return nil
+	}
+	record := &models.CommentModeration{
+		AuthorID:   comment.AuthorID,
+		EntityType: comment.EntityType,
+		EntityID:   comment.EntityID,
+		Action:     action,
+		Content:    comment.Content,
+		Status:     models.ModerationFlagged,
+		Reason:     verdict.Reason,
+		Source:     verdict.Source,
+	}
+	if action == models.ModerationActionUpdate {
+		id := comment.ID
+		record.CommentID = &id
+	}
+	return record
+}
+
+// block records a blocked comment or edit with the request, which is applied if a moderator approves it
+func (s *commentService) block(ctx context.Context, record *models.CommentModeration, req interface{}) error {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to record blocked comment: %w", err)
+	}
+	record.Status = models.ModerationBlocked
+	record.Request = string(payload)
+	if err := s.repos.CommentModeration.Create(ctx, record); err != nil {
+		return fmt.Errorf("failed to record blocked comment: %w", err)
+	}
+	return fmt.Errorf("%w: %s; appeal moderation record %s for a review", ErrCommentBlocked, record.Reason, record.ID)
+}
+
+// saveModerated saves a comment, together with the moderation record when it was flagged
+func (s *commentService) saveModerated(ctx context.Context, comment *models.Comment, record *models.CommentModeration, save func(comments repository.CommentRepository) error) error {
+	if record == nil {
+		return save(s.commentRepo)
+	}
+	return s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		if err := save(tx.Comment); err != nil {
+			return err
+		}
+		record.CommentID = &comment.ID
+		return tx.CommentModeration.Create(ctx, record)
+	})
+}
+
 // DeleteComment deletes a comment
-func (s *commentService) DeleteComment(id uuid.UUID) error {
-	_, err := s.commentRepo.GetByID(id)
+func (s *commentService) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	_, err := s.commentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrCommentNotFound
@@ -219,7 +364,7 @@ func (s *commentService) DeleteComment(id uuid.UUID) error {
 	}
 
 	// Check if comment has replies
-	replies, err := s.commentRepo.GetByParent(id)
+	replies, err := s.commentRepo.GetByParent(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to check for replies: %w", err)
 	}
@@ -228,7 +373,7 @@ func (s *commentService) DeleteComment(id uuid.UUID) error {
 		return ErrCommentHasReplies
 	}
 
-	if err := s.commentRepo.Delete(id); err != nil {
+	if err := s.commentRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete comment: %w", err)
 	}
 
@@ -236,18 +381,18 @@ func (s *commentService) DeleteComment(id uuid.UUID) error {
 }
 
 // GetCommentsByEntity retrieves all comments for an entity
-func (s *commentService) GetCommentsByEntity(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
+func (s *commentService) GetCommentsByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
 	// Validate entity type
 	if !isValidEntityType(entityType) {
 		return nil, ErrCommentInvalidEntityType
 	}
 
 	// Validate entity exists
-	if err := s.validateEntityExists(entityType, entityID); err != nil {
+	if err := s.validateEntityExists(ctx, entityType, entityID); err != nil {
 		return nil, err
 	}
 
-	comments, err := s.commentRepo.GetByEntity(entityType, entityID)
+	comments, err := s.commentRepo.GetByEntity(ctx, entityType, entityID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get comments: %w", err)
 	}
@@ -261,18 +406,18 @@ func (s *commentService) GetCommentsByEntity(entityType models.EntityType, entit
 }
 
 // GetThreadedComments retrieves comments in threaded format for an entity
-func (s *commentService) GetThreadedComments(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
+func (s *commentService) GetThreadedComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
 	// Validate entity type
 	if !isValidEntityType(entityType) {
 		return nil, ErrCommentInvalidEntityType
 	}
 
 	// Validate entity exists
-	if err := s.validateEntityExists(entityType, entityID); err != nil {
+	if err := s.validateEntityExists(ctx, entityType, entityID); err != nil {
 		return nil, err
 	}
 
-	comments, err := s.commentRepo.GetThreaded(entityType, entityID)
+	comments, err := s.commentRepo.GetThreaded(ctx, entityType, entityID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get threaded comments: %w", err)
 	}
@@ -286,8 +431,8 @@ func (s *commentService) GetThreadedComments(entityType models.EntityType, entit
 }
 
 // GetCommentsByStatus retrieves comments by resolution status
-func (s *commentService) GetCommentsByStatus(isResolved bool) ([]CommentResponse, error) {
-	comments, err := s.commentRepo.GetByStatus(isResolved)
+func (s *commentService) GetCommentsByStatus(ctx context.Context, isResolved bool) ([]CommentResponse, error) {
+	comments, err := s.commentRepo.GetByStatus(ctx, isResolved)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get comments by status: %w", err)
 	}
@@ -301,18 +446,18 @@ func (s *commentService) GetCommentsByStatus(isResolved bool) ([]CommentResponse
 }
 
 // GetInlineComments retrieves inline comments for an entity
-func (s *commentService) GetInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
+func (s *commentService) GetInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
 	// Validate entity type
 	if !isValidEntityType(entityType) {
 		return nil, ErrCommentInvalidEntityType
 	}
 
 	// Validate entity exists
-	if err := s.validateEntityExists(entityType, entityID); err != nil {
+	if err := s.validateEntityExists(ctx, entityType, entityID); err != nil {
 		return nil, err
 	}
 
-	comments, err := s.commentRepo.GetInlineComments(entityType, entityID)
+	comments, err := s.commentRepo.GetInlineComments(ctx, entityType, entityID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get inline comments: %w", err)
 	}
@@ -326,8 +471,8 @@ func (s *commentService) GetInlineComments(entityType models.EntityType, entityI
 }
 
 // ResolveComment marks a comment as resolved
-func (s *commentService) ResolveComment(id uuid.UUID) (*CommentResponse, error) {
-	comment, err := s.commentRepo.GetByID(id)
+func (s *commentService) ResolveComment(ctx context.Context, id uuid.UUID) (*CommentResponse, error) {
+	comment, err := s.commentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrCommentNotFound
@@ -337,7 +482,7 @@ func (s *commentService) ResolveComment(id uuid.UUID) (*CommentResponse, error)
 
 	comment.MarkAsResolved()
 
-	if err := s.commentRepo.Update(comment); err != nil {
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
 		return nil, fmt.Errorf("failed to resolve comment: %w", err)
 	}
 
@@ -345,8 +490,8 @@ func (s *commentService) ResolveComment(id uuid.UUID) (*CommentResponse, error)
 }
 
 // UnresolveComment marks a comment as unresolved
-func (s *commentService) UnresolveComment(id uuid.UUID) (*CommentResponse, error) {
-	comment, err := s.commentRepo.GetByID(id)
+func (s *commentService) UnresolveComment(ctx context.Context, id uuid.UUID) (*CommentResponse, error) {
+	comment, err := s.commentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrCommentNotFound
@@ -356,7 +501,7 @@ func (s *commentService) UnresolveComment(id uuid.UUID) (*CommentResponse, error
 
 	comment.MarkAsUnresolved()
 
-	if err := s.commentRepo.Update(comment); err != nil {
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
 		return nil, fmt.Errorf("failed to unresolve comment: %w", err)
 	}
 
@@ -365,9 +510,9 @@ func (s *commentService) UnresolveComment(id uuid.UUID) (*CommentResponse, error
 
 // ValidateInlineCommentsAfterTextChange validates inline comments after entity description changes
 // This method should be called whenever an entity's description is updated
-func (s *commentService) ValidateInlineCommentsAfterTextChange(entityType models.EntityType, entityID uuid.UUID, newDescription string) error {
+func (s *commentService) ValidateInlineCommentsAfterTextChange(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, newDescription string) error {
 	// Get all inline comments for this entity
-	inlineComments, err := s.commentRepo.GetInlineComments(entityType, entityID)
+	inlineComments, err := s.commentRepo.GetInlineComments(ctx, entityType, entityID)
 	if err != nil {
 		return fmt.Errorf("failed to get inline comments: %w", err)
 	}
@@ -384,7 +529,7 @@ func (s *commentService) ValidateInlineCommentsAfterTextChange(entityType models
 		// Check if the text positions are still valid
 		if start < 0 || end > len(newDescription) || start > end {
 			// Hide the comment by marking it as invalid
-			if err := s.hideInlineComment(&comment); err != nil {
+			if err := s.hideInlineComment(ctx, &comment); err != nil {
 				return fmt.Errorf("failed to hide invalid comment %s: %w", comment.ID, err)
 			}
 			continue
@@ -394,7 +539,7 @@ func (s *commentService) ValidateInlineCommentsAfterTextChange(entityType models
 		actualFragment := newDescription[start:end]
 		if actualFragment != linkedText {
 			// Hide the comment because the text has changed
-			if err := s.hideInlineComment(&comment); err != nil {
+			if err := s.hideInlineComment(ctx, &comment); err != nil {
 				return fmt.Errorf("failed to hide changed comment %s: %w", comment.ID, err)
 			}
 		}
@@ -405,7 +550,7 @@ func (s *commentService) ValidateInlineCommentsAfterTextChange(entityType models
 
 // hideInlineComment hides an inline comment by setting special markers
 // We don't delete the comment to preserve audit trail, but mark it as hidden
-func (s *commentService) hideInlineComment(comment *models.Comment) error {
+func (s *commentService) hideInlineComment(ctx context.Context, comment *models.Comment) error {
 	// Mark the comment as hidden by setting linked text to null
 	// This effectively makes it invisible in inline comment queries
 	comment.LinkedText = nil
@@ -415,23 +560,23 @@ func (s *commentService) hideInlineComment(comment *models.Comment) error {
 	// Add a note to the content indicating why it was hidden
 	comment.Content = "[HIDDEN: Linked text was modified or deleted] " + comment.Content
 
-	return s.commentRepo.Update(comment)
+	return s.commentRepo.Update(ctx, comment)
 }
 
 // GetVisibleInlineComments retrieves only visible inline comments for an entity
-func (s *commentService) GetVisibleInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
+func (s *commentService) GetVisibleInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]CommentResponse, error) {
 	// Validate entity type
 	if !isValidEntityType(entityType) {
 		return nil, ErrCommentInvalidEntityType
 	}
 
 	// Validate entity exists
-	if err := s.validateEntityExists(entityType, entityID); err != nil {
+	if err := s.validateEntityExists(ctx, entityType, entityID); err != nil {
 		return nil, err
 	}
 
 	// Get inline comments (this already filters for non-null linked_text)
-	comments, err := s.commentRepo.GetInlineComments(entityType, entityID)
+	comments, err := s.commentRepo.GetInlineComments(ctx, entityType, entityID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get inline comments: %w", err)
 	}
@@ -445,28 +590,28 @@ func (s *commentService) GetVisibleInlineComments(entityType models.EntityType,
 }
 
 // validateEntityExists validates that the specified entity exists
-func (s *commentService) validateEntityExists(entityType models.EntityType, entityID uuid.UUID) error {
+func (s *commentService) validateEntityExists(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) error {
 	switch entityType {
 	case models.EntityTypeEpic:
-		if exists, err := s.repos.Epic.Exists(entityID); err != nil {
+		if exists, err := s.repos.Epic.Exists(ctx, entityID); err != nil {
 			return fmt.Errorf("failed to validate epic: %w", err)
 		} else if !exists {
 			return ErrCommentEntityNotFound
 		}
 	case models.EntityTypeUserStory:
-		if exists, err := s.repos.UserStory.Exists(entityID); err != nil {
+		if exists, err := s.repos.UserStory.Exists(ctx, entityID); err != nil {
 			return fmt.Errorf("failed to validate user story: %w", err)
 		} else if !exists {
 			return ErrCommentEntityNotFound
 		}
 	case models.EntityTypeAcceptanceCriteria:
-		if exists, err := s.repos.AcceptanceCriteria.Exists(entityID); err != nil {
+		if exists, err := s.repos.AcceptanceCriteria.Exists(ctx, entityID); err != nil {
 			return fmt.Errorf("failed to validate acceptance criteria: %w", err)
 		} else if !exists {
 			return ErrCommentEntityNotFound
 		}
 	case models.EntityTypeRequirement:
-		if exists, err := s.repos.Requirement.Exists(entityID); err != nil {
+		if exists, err := s.repos.Requirement.Exists(ctx, entityID); err != nil {
 			return fmt.Errorf("failed to validate requirement: %w", err)
 		} else if !exists {
 			return ErrCommentEntityNotFound
@@ -502,13 +647,13 @@ func (s *commentService) validateInlineCommentData(linkedText *string, start *in
 }
 
 // validateTextFragment validates that the linked text matches the actual text in the entity's description
-func (s *commentService) validateTextFragment(entityType models.EntityType, entityID uuid.UUID, linkedText string, start, end int) error {
+func (s *commentService) validateTextFragment(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, linkedText string, start, end int) error {
 	var description string
 
 	// Get the entity's description based on type
 	switch entityType {
 	case models.EntityTypeEpic:
-		epic, err := s.repos.Epic.GetByID(entityID)
+		epic, err := s.repos.Epic.GetByID(ctx, entityID)
 		if err != nil {
 			return fmt.Errorf("failed to get epic: %w", err)
 		}
@@ -516,7 +661,7 @@ func (s *commentService) validateTextFragment(entityType models.EntityType, enti
 			description = *epic.Description
 		}
 	case models.EntityTypeUserStory:
-		userStory, err := s.repos.UserStory.GetByID(entityID)
+		userStory, err := s.repos.UserStory.GetByID(ctx, entityID)
 		if err != nil {
 			return fmt.Errorf("failed to get user story: %w", err)
 		}
@@ -524,14 +669,14 @@ func (s *commentService) validateTextFragment(entityType models.EntityType, enti
 			description = *userStory.Description
 		}
 	case models.EntityTypeAcceptanceCriteria:
-		acceptanceCriteria, err := s.repos.AcceptanceCriteria.GetByID(entityID)
+		acceptanceCriteria, err := s.repos.AcceptanceCriteria.GetByID(ctx, entityID)
 		if err != nil {
 			return fmt.Errorf("failed to get acceptance criteria: %w", err)
 		}
 		// AcceptanceCriteria.Description is a string, not a pointer
 		description = acceptanceCriteria.Description
 	case models.EntityTypeRequirement:
-		requirement, err := s.repos.Requirement.GetByID(entityID)
+		requirement, err := s.repos.Requirement.GetByID(ctx, entityID)
 		if err != nil {
 			return fmt.Errorf("failed to get requirement: %w", err)
 		}
@@ -587,6 +732,8 @@ func (s *commentService) toCommentResponse(comment *models.Comment) *CommentResp
 		UpdatedAt:         comment.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		Content:           comment.Content,
 		IsResolved:        comment.IsResolved,
+		IsSystem:          comment.IsSystem,
+		Category:          comment.Category,
 		LinkedText:        comment.LinkedText,
 		TextPositionStart: comment.TextPositionStart,
 		TextPositionEnd:   comment.TextPositionEnd,
@@ -603,10 +750,54 @@ func (s *commentService) toCommentResponse(comment *models.Comment) *CommentResp
 	return response
 }
 
+// ListComments retrieves comments across all entities matching the given filters
+func (s *commentService) ListComments(ctx context.Context, filters CommentFilters) ([]CommentResponse, int64, error) {
+	if filters.EntityType != nil && !isValidEntityType(*filters.EntityType) {
+		return nil, 0, ErrCommentInvalidEntityType
+	}
+	if filters.Category != nil && !filters.Category.IsValid() {
+		return nil, 0, ErrInvalidCommentCategory
+	}
+
+	comments, totalCount, err := s.commentRepo.ListWithFilters(ctx, repository.CommentFilters{
+		EntityType:       filters.EntityType,
+		Category:         filters.Category,
+		IsResolved:       filters.IsResolved,
+		AuthorID:         filters.AuthorID,
+		EntityAssigneeID: filters.EntityAssigneeID,
+		Limit:            filters.Limit,
+		Offset:           filters.Offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	responses := make([]CommentResponse, len(comments))
+	for i, comment := range comments {
+		responses[i] = *s.toCommentResponse(&comment)
+	}
+
+	return responses, totalCount, nil
+}
+
+// GetCategoryCounts returns the number of comments per category on an entity
+func (s *commentService) GetCategoryCounts(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (map[models.CommentCategory]int64, error) {
+	if !isValidEntityType(entityType) {
+		return nil, ErrCommentInvalidEntityType
+	}
+
+	counts, err := s.commentRepo.CountByCategory(ctx, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments by category: %w", err)
+	}
+
+	return counts, nil
+}
+
 // GetCommentReplies retrieves all direct replies to a specific comment
-func (s *commentService) GetCommentReplies(parentID uuid.UUID) ([]CommentResponse, error) {
+func (s *commentService) GetCommentReplies(ctx context.Context, parentID uuid.UUID) ([]CommentResponse, error) {
 	// First verify the parent comment exists
-	_, err := s.commentRepo.GetByID(parentID)
+	_, err := s.commentRepo.GetByID(ctx, parentID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrCommentNotFound
@@ -615,7 +806,7 @@ func (s *commentService) GetCommentReplies(parentID uuid.UUID) ([]CommentRespons
 	}
 
 	// Get replies
-	replies, err := s.commentRepo.GetByParent(parentID)
+	replies, err := s.commentRepo.GetByParent(ctx, parentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get comment replies: %w", err)
 	}
@@ -629,9 +820,9 @@ func (s *commentService) GetCommentReplies(parentID uuid.UUID) ([]CommentRespons
 }
 
 // GetCommentRepliesWithPagination retrieves direct replies to a specific comment with pagination
-func (s *commentService) GetCommentRepliesWithPagination(parentID uuid.UUID, limit, offset int) ([]CommentResponse, int64, error) {
+func (s *commentService) GetCommentRepliesWithPagination(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]CommentResponse, int64, error) {
 	// First verify the parent comment exists
-	_, err := s.commentRepo.GetByID(parentID)
+	_, err := s.commentRepo.GetByID(ctx, parentID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, 0, ErrCommentNotFound
@@ -640,7 +831,7 @@ func (s *commentService) GetCommentRepliesWithPagination(parentID uuid.UUID, lim
 	}
 
 	// Get paginated replies
-	replies, totalCount, err := s.commentRepo.GetByParentWithPagination(parentID, limit, offset)
+	replies, totalCount, err := s.commentRepo.GetByParentWithPagination(ctx, parentID, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get comment replies: %w", err)
 	}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -173,3 +174,16 @@ func TestCommentService_ValidationErrors(t *testing.T) {
 		assert.Equal(t, "", strings.TrimSpace(req.Content))
 	})
 }
+
+func TestCommentService_ListComments_InvalidFilters(t *testing.T) {
+	ctx := context.Background()
+	service := &commentService{}
+
+	category := models.CommentCategory("nitpick")
+	_, _, err := service.ListComments(ctx, CommentFilters{Category: &category})
+	assert.ErrorIs(t, err, ErrInvalidCommentCategory)
+
+	entityType := models.EntityType("invalid")
+	_, _, err = service.ListComments(ctx, CommentFilters{EntityType: &entityType})
+	assert.ErrorIs(t, err, ErrCommentInvalidEntityType)
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// maxSummaryPromptChars limits the discussion sent to the language model; the oldest comments are left out first
+const maxSummaryPromptChars = 60000
+
+var (
+	ErrNoCommentsToSummarize = apperrors.New(apperrors.KindUnprocessable, "NO_COMMENTS", "entity has no comments to summarize")
+)
+
+// commentSummaryInstructions are the system instructions of the summary request
+const commentSummaryInstructions = `You summarize the discussion in the comments of a requirements management item.
+Reply with a JSON object only, without any other text, with these fields:
+"overview": two or three sentences on what the discussion is about and where it stands,
+"open_questions": questions that are still unanswered,
+"decisions": what the participants agreed on,
+"action_items": work someone still has to do, naming the person when the discussion does.
+Use empty lists when there is nothing to report. Write in the language of the discussion.`
+
+// CommentSummaryService defines the interface for AI summaries of the discussion on an entity
+type CommentSummaryService interface {
+	Summarize(ctx context.Context, entityType models.EntityType, idOrReference string, req SummarizeCommentsRequest, userID uuid.UUID) (*CommentSummary, error)
+}
+
+// SummarizeCommentsRequest represents the options of a discussion summary
+type SummarizeCommentsRequest struct {
+	// Post adds the summary to the entity as a resolved system comment
+	Post bool `json:"post" example:"false"`
+	// Refresh generates a new summary even when the discussion has not changed since the cached one
+	Refresh bool `json:"refresh" example:"false"`
+}
+
+// CommentSummary is a summary of the comments on an entity
+// @Description Discussion summary with open questions, decisions and action items
+type CommentSummary struct {
+	EntityType    models.EntityType `json:"entity_type" example:"requirement"`
+	EntityID      uuid.UUID         `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Overview      string            `json:"overview" example:"The team discussed the token lifetime and agreed on 15 minutes."`
+	OpenQuestions []string          `json:"open_questions"`
+	Decisions     []string          `json:"decisions"`
+	ActionItems   []string          `json:"action_items"`
+	// CommentCount is the number of comments summarized; system comments are not included
+	CommentCount int `json:"comment_count" example:"12"`
+	// LatestCommentAt is when the newest summarized comment was written or edited
+	LatestCommentAt time.Time `json:"latest_comment_at" example:"2023-01-01T10:00:00Z"`
+	GeneratedAt     time.Time `json:"generated_at" example:"2023-01-01T10:05:00Z"`
+	// Cached is true when the summary was generated earlier for the same discussion
+	Cached bool `json:"cached" example:"false"`
+	// Comment is the system comment holding the summary, when it was posted
+	Comment *CommentResponse `json:"comment,omitempty"`
+}
+
+// commentSummaryService implements CommentSummaryService
+type commentSummaryService struct {
+	repos          *repository.Repositories
+	commentService CommentService
+	llm            LLMClient
+	now            func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]*CommentSummary // Latest summary per entity
+}
+
+// NewCommentSummaryService creates a new comment summary service instance; llm may be nil when no provider is configured
+func NewCommentSummaryService(repos *repository.Repositories, commentService CommentService, llm LLMClient) CommentSummaryService {
+	return &commentSummaryService{
+		repos:          repos,
+		commentService: commentService,
+		llm:            llm,
+		now:            time.Now,
+		cache:          make(map[string]*CommentSummary),
+	}
+}
+
+// Summarize summarizes the comments on an entity. The summary is cached until a comment is added, edited
+// or deleted, so repeated requests for an unchanged discussion do not call the language model again.
+func (s *commentSummaryService) Summarize(ctx context.Context, entityType models.EntityType, idOrReference string, req SummarizeCommentsRequest, userID uuid.UUID) (*CommentSummary, error) {
+	if s.llm == nil {
+		return nil, ErrLLMNotConfigured
+	}
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	allComments, err := s.repos.Comment.GetByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	var comments []models.Comment
+	var latest time.Time
+	for _, comment := range allComments {
+		if comment.IsSystem {
+			continue
+		}
+		comments = append(comments, comment)
+		if comment.UpdatedAt.After(latest) {
+			latest = comment.UpdatedAt
+		}
+	}
+	if len(comments) == 0 {
+		return nil, ErrNoCommentsToSummarize
+	}
+
+	cacheKey := string(entityType) + ":" + entityID.String()
+	s.mu.Lock()
+	cached, ok := s.cache[cacheKey]
+	s.mu.Unlock()
+
+	var summary CommentSummary
+	if ok && !req.Refresh && cached.LatestCommentAt.Equal(latest) && cached.CommentCount == len(comments) {
+		summary = *cached
+		summary.Cached = true
+	} else {
+		reply, err := s.llm.Complete(ctx, LLMRequest{
+			Feature: LLMFeatureCommentSummary,
+			UserID:  &userID,
+			System:  commentSummaryInstructions,
+			Prompt:  buildCommentSummaryPrompt(entityType, comments),
+		})
+		if err != nil {
+			return nil, err
+		}
+		summary = parseCommentSummary(reply.Text)
+		summary.EntityType = entityType
+		summary.EntityID = entityID
+		summary.CommentCount = len(comments)
+		summary.LatestCommentAt = latest
+		summary.GeneratedAt = s.now()
+
+		stored := summary
+		s.mu.Lock()
+		s.cache[cacheKey] = &stored
+		s.mu.Unlock()
+	}
+
+	if req.Post {
+		comment := &models.Comment{
+			EntityType: entityType,
+			EntityID:   entityID,
+			AuthorID:   userID,
+			Content:    formatCommentSummary(&summary),
+			IsResolved: true,
+			IsSystem:   true,
+		}
+		if err := s.repos.Comment.Create(ctx, comment); err != nil {
+			return nil, fmt.Errorf("failed to post summary comment: %w", err)
+		}
+		if summary.Comment, err = s.commentService.GetComment(ctx, comment.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &summary, nil
+}
+
+// buildCommentSummaryPrompt lists the comments oldest first, leaving out the oldest ones when the discussion is too long
+func buildCommentSummaryPrompt(entityType models.EntityType, comments []models.Comment) string {
+	numbers := make(map[uuid.UUID]int, len(comments))
+	lines := make([]string, len(comments))
+	for i, comment := range comments {
+		numbers[comment.ID] = i + 1
+
+		var attributes []string
+		if comment.Category != nil {
+			attributes = append(attributes, string(*comment.Category))
+		}
+		if comment.IsResolved {
+			attributes = append(attributes, "resolved")
+		}
+		if comment.ParentCommentID != nil {
+			if parent, ok := numbers[*comment.ParentCommentID]; ok {
+				attributes = append(attributes, fmt.Sprintf("reply to #%d", parent))
+			}
+		}
+		if comment.LinkedText != nil {
+			attributes = append(attributes, fmt.Sprintf("on the text %q", *comment.LinkedText))
+		}
+
+		author := comment.Author.Username
+		if author == "" {
+			author = "unknown"
+		}
+		line := fmt.Sprintf("#%d %s %s", i+1, comment.CreatedAt.UTC().Format("2006-01-02 15:04"), author)
+		if len(attributes) > 0 {
+			line += " (" + strings.Join(attributes, ", ") + ")"
+		}
+		lines[i] = line + ":\n" + comment.Content
+	}
+
+	first, size := len(lines), 0
+	for first > 0 && size+len(lines[first-1]) <= maxSummaryPromptChars {
+		first--
+		size += len(lines[first]) + 2
+	}
+	if first == len(lines) {
+		// Even the newest comment is too long; send the start of it
+		first = len(lines) - 1
+		lines[first] = strings.ToValidUTF8(lines[first][:maxSummaryPromptChars], "")
+	}
+
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Comments on a %s, oldest first:\n\n", strings.ReplaceAll(string(entityType), "_", " "))
+	if first > 0 {
+		fmt.Fprintf(&prompt, "(%d earlier comments are left out)\n\n", first)
+	}
+	prompt.WriteString(strings.Join(lines[first:], "\n\n"))
+	return prompt.String()
+}
+
+// parseCommentSummary reads the JSON reply of the model. Models sometimes wrap it in a code block or
+// add text around it; a reply without a JSON object becomes the overview.
+func parseCommentSummary(reply string) CommentSummary {
+	var parsed struct {
+		Overview      string   `json:"overview"`
+		OpenQuestions []string `json:"open_questions"`
+		Decisions     []string `json:"decisions"`
+		ActionItems   []string `json:"action_items"`
+	}
+	start, end := strings.Index(reply, "{"), strings.LastIndex(reply, "}")
+	if start < 0 || end < start || json.Unmarshal([]byte(reply[start:end+1]), &parsed) != nil {
+		parsed.Overview = strings.TrimSpace(reply)
+	}
+	summary := CommentSummary{
+		Overview:      parsed.Overview,
+		OpenQuestions: parsed.OpenQuestions,
+		Decisions:     parsed.Decisions,
+		ActionItems:   parsed.ActionItems,
+	}
+
+	// Lists are always present in responses
+	for _, list := range []*[]string{&summary.OpenQuestions, &summary.Decisions, &summary.ActionItems} {
+		if *list == nil {
+			*list = []string{}
+		}
+	}
+	return summary
+}
+
+// formatCommentSummary renders a summary as the Markdown content of a comment
+func formatCommentSummary(summary *CommentSummary) string {
+	var content strings.Builder
+	content.WriteString("**Discussion summary**")
+	if summary.Overview != "" {
+		content.WriteString("\n\n" + summary.Overview)
+	}
+	sections := []struct {
+		title string
+		items []string
+	}{
+		{"Open questions", summary.OpenQuestions},
+		{"Decisions", summary.Decisions},
+		{"Action items", summary.ActionItems},
+	}
+	for _, section := range sections {
+		if len(section.items) == 0 {
+			continue
+		}
+		content.WriteString("\n\n**" + section.title + "**")
+		for _, item := range section.items {
+			content.WriteString("\n- " + item)
+		}
+	}
+	return content.String()
+}
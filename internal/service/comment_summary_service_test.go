@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// fakeLLMClient returns a fixed reply and records the prompts it received
+type fakeLLMClient struct {
+	reply    string
+	err      error
+	prompts  []string
+	requests []LLMRequest
+}
+
+func (c *fakeLLMClient) Complete(ctx context.Context, req LLMRequest) (*LLMCompletion, error) {
+	c.prompts = append(c.prompts, req.Prompt)
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &LLMCompletion{Text: c.reply, InputTokens: len(req.Prompt), OutputTokens: len(c.reply)}, nil
+}
+
+func (c *fakeLLMClient) Stream(ctx context.Context, req LLMRequest, onDelta func(text string) error) (*LLMCompletion, error) {
+	completion, err := c.Complete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := onDelta(completion.Text); err != nil {
+		return nil, err
+	}
+	return completion, nil
+}
+
+func TestCommentSummaryService_Summarize(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.Comment{}))
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog,
+		CreatorID: user.ID, AssigneeID: user.ID, Priority: models.PriorityHigh}
+	require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(&epic).Error)
+	empty := models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Search", Status: models.EpicStatusBacklog,
+		CreatorID: user.ID, AssigneeID: user.ID, Priority: models.PriorityHigh}
+	require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(&empty).Error)
+
+	question := models.CommentCategoryQuestion
+	first := &models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: user.ID,
+		Content: "Do we support guest checkout?", Category: &question}
+	require.NoError(t, db.Create(first).Error)
+	require.NoError(t, db.Create(&models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: user.ID,
+		ParentCommentID: &first.ID, Content: "Yes, agreed in the planning meeting"}).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	llm := &fakeLLMClient{reply: "```json\n" + `{"overview": "Guest checkout was discussed.", "decisions": ["Support guest checkout"], "action_items": []}` + "\n```"}
+	summaries := NewCommentSummaryService(repos, NewCommentService(repos, nil), llm)
+
+	t.Run("summarizes the discussion", func(t *testing.T) {
+		summary, err := summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-001", SummarizeCommentsRequest{}, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, epic.ID, summary.EntityID)
+		assert.Equal(t, "Guest checkout was discussed.", summary.Overview)
+		assert.Equal(t, []string{"Support guest checkout"}, summary.Decisions)
+		assert.Equal(t, []string{}, summary.OpenQuestions)
+		assert.Equal(t, 2, summary.CommentCount)
+		assert.False(t, summary.Cached)
+
+		require.Len(t, llm.prompts, 1)
+		assert.Equal(t, LLMFeatureCommentSummary, llm.requests[0].Feature)
+		assert.Equal(t, &user.ID, llm.requests[0].UserID)
+		assert.Contains(t, llm.prompts[0], "alice (question):\nDo we support guest checkout?")
+		assert.Contains(t, llm.prompts[0], "#2 ")
+		assert.Contains(t, llm.prompts[0], "(reply to #1)")
+	})
+
+	t.Run("serves the cached summary until the discussion changes", func(t *testing.T) {
+		summary, err := summaries.Summarize(context.Background(), models.EntityTypeEpic, epic.ID.String(), SummarizeCommentsRequest{}, user.ID)
+		require.NoError(t, err)
+		assert.True(t, summary.Cached)
+		assert.Len(t, llm.prompts, 1)
+
+		require.NoError(t, db.Create(&models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: user.ID,
+			Content: "Payment by invoice is still open", CreatedAt: time.Now().Add(time.Minute), UpdatedAt: time.Now().Add(time.Minute)}).Error)
+		summary, err = summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-001", SummarizeCommentsRequest{}, user.ID)
+		require.NoError(t, err)
+		assert.False(t, summary.Cached)
+		assert.Equal(t, 3, summary.CommentCount)
+		assert.Len(t, llm.prompts, 2)
+	})
+
+	t.Run("posts the summary as a resolved system comment", func(t *testing.T) {
+		summary, err := summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-001", SummarizeCommentsRequest{Post: true}, user.ID)
+		require.NoError(t, err)
+		assert.True(t, summary.Cached)
+		require.NotNil(t, summary.Comment)
+		assert.True(t, summary.Comment.IsSystem)
+		assert.True(t, summary.Comment.IsResolved)
+		assert.True(t, strings.HasPrefix(summary.Comment.Content, "**Discussion summary**\n\nGuest checkout was discussed."))
+		assert.Contains(t, summary.Comment.Content, "**Decisions**\n- Support guest checkout")
+
+		// The summary comment does not change the discussion
+		summary, err = summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-001", SummarizeCommentsRequest{}, user.ID)
+		require.NoError(t, err)
+		assert.True(t, summary.Cached)
+		assert.Equal(t, 3, summary.CommentCount)
+	})
+
+	t.Run("refresh and plain text replies", func(t *testing.T) {
+		llm.reply = "The team agreed on guest checkout."
+		summary, err := summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-001", SummarizeCommentsRequest{Refresh: true}, user.ID)
+		require.NoError(t, err)
+		assert.False(t, summary.Cached)
+		assert.Equal(t, "The team agreed on guest checkout.", summary.Overview)
+		assert.Empty(t, summary.Decisions)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-002", SummarizeCommentsRequest{}, user.ID)
+		assert.ErrorIs(t, err, ErrNoCommentsToSummarize)
+
+		_, err = summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-999", SummarizeCommentsRequest{}, user.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		llm.err = errors.New("timeout")
+		_, err = summaries.Summarize(context.Background(), models.EntityTypeEpic, "EP-001", SummarizeCommentsRequest{Refresh: true}, user.ID)
+		assert.EqualError(t, err, "timeout")
+
+		_, err = NewCommentSummaryService(repos, NewCommentService(repos, nil), nil).Summarize(context.Background(), models.EntityTypeEpic, "EP-001", SummarizeCommentsRequest{}, user.ID)
+		assert.ErrorIs(t, err, ErrLLMNotConfigured)
+	})
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestCommentThreadLock(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.Comment{}, &models.CommentThreadLock{}))
+
+	commenter := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleCommenter}
+	editor := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	admin := &models.User{Username: "admin", Email: "admin@example.com", PasswordHash: "hash", Role: models.RoleAdministrator}
+	for _, user := range []*models.User{commenter, editor, admin} {
+		require.NoError(t, db.Create(user).Error)
+	}
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog,
+		CreatorID: editor.ID, AssigneeID: editor.ID, Priority: models.PriorityHigh}
+	require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(&epic).Error)
+
+	comments := NewCommentService(repository.NewRepositories(db, nil), nil)
+	create := func(author *models.User, parentID *uuid.UUID) (*CommentResponse, error) {
+		return comments.CreateComment(ctx, CreateCommentRequest{EntityType: models.EntityTypeEpic, EntityID: epic.ID,
+			ParentCommentID: parentID, AuthorID: author.ID, Content: "Should we support PayPal?"})
+	}
+
+	question, err := create(commenter, nil)
+	require.NoError(t, err)
+
+	_, err = comments.GetThreadLock(ctx, models.EntityTypeEpic, "EP-001")
+	assert.ErrorIs(t, err, ErrCommentThreadNotLocked)
+
+	_, err = comments.LockThread(ctx, models.EntityTypeEpic, "EP-001", "  ", editor.ID)
+	assert.ErrorIs(t, err, ErrEmptyLockReason)
+
+	lock, err := comments.LockThread(ctx, models.EntityTypeEpic, "EP-001", "Decided: cards only", editor.ID)
+	require.NoError(t, err)
+	assert.Equal(t, epic.ID, lock.EntityID)
+	assert.Equal(t, "Decided: cards only", lock.Reason)
+	require.NotNil(t, lock.Locker)
+	assert.Equal(t, "bob", lock.Locker.Username)
+
+	t.Run("rejects comments and replies of non-administrators", func(t *testing.T) {
+		for _, author := range []*models.User{commenter, editor} {
+			_, err := create(author, nil)
+			assert.ErrorIs(t, err, ErrCommentThreadLocked)
+			assert.Equal(t, apperrors.KindLocked, apperrors.KindOf(err))
+			assert.Contains(t, err.Error(), "Decided: cards only")
+
+			_, err = create(author, &question.ID)
+			assert.ErrorIs(t, err, ErrCommentThreadLocked)
+		}
+	})
+
+	t.Run("accepts comments of administrators", func(t *testing.T) {
+		_, err := create(admin, &question.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("relocking replaces the reason", func(t *testing.T) {
+		_, err := comments.LockThread(ctx, models.EntityTypeEpic, epic.ID.String(), "Decided: cards and PayPal", admin.ID)
+		require.NoError(t, err)
+
+		lock, err := comments.GetThreadLock(ctx, models.EntityTypeEpic, "EP-001")
+		require.NoError(t, err)
+		assert.Equal(t, "Decided: cards and PayPal", lock.Reason)
+		assert.Equal(t, admin.ID, lock.LockedBy)
+	})
+
+	t.Run("unlocking reopens the thread", func(t *testing.T) {
+		require.NoError(t, comments.UnlockThread(ctx, models.EntityTypeEpic, "EP-001"))
+		_, err := create(commenter, &question.ID)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, comments.UnlockThread(ctx, models.EntityTypeEpic, "EP-001"), ErrCommentThreadNotLocked)
+	})
+
+	t.Run("unknown entity", func(t *testing.T) {
+		_, err := comments.LockThread(ctx, models.EntityTypeEpic, "EP-404", "Decided", editor.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"product-requirements-management/internal/models"
+)
+
+// EntityFormatCompact selects the token-efficient entity serialization for LLM agents
+const EntityFormatCompact = "compact"
+
+// compactDescriptionLength is the number of characters of a description kept in compact entities
+const compactDescriptionLength = 200
+
+// CompactEntity is a terse, null-free view of an entity keyed by reference ID.
+// UUIDs and timestamps are left out; descriptions are whitespace-collapsed and truncated.
+type CompactEntity struct {
+	Ref         string          `json:"ref" example:"REQ-001"`
+	Title       string          `json:"title,omitempty" example:"User authentication must support OAuth 2.0"`
+	Status      string          `json:"status,omitempty" example:"Draft"`
+	Priority    int             `json:"priority,omitempty" example:"2"`
+	Type        string          `json:"type,omitempty" example:"Functional"`
+	Assignee    string          `json:"assignee,omitempty" example:"alice"`
+	AC          string          `json:"ac,omitempty" example:"AC-001"`
+	Description string          `json:"desc,omitempty"`
+	Children    []CompactEntity `json:"children,omitempty"`
+}
+
+// CompactEpic converts an epic and its preloaded user stories to a compact entity
+func CompactEpic(epic *models.Epic) CompactEntity {
+	entity := CompactEntity{
+		Ref:         epic.ReferenceID,
+		Title:       epic.Title,
+		Status:      string(epic.Status),
+		Priority:    int(epic.Priority),
+		Assignee:    epic.Assignee.Username,
+		Description: compactDescription(safeStringValue(epic.Description)),
+	}
+	for i := range epic.UserStories {
+		entity.Children = append(entity.Children, CompactUserStory(&epic.UserStories[i]))
+	}
+	return entity
+}
+
+// CompactUserStory converts a user story and its preloaded acceptance criteria and requirements to a compact entity
+func CompactUserStory(userStory *models.UserStory) CompactEntity {
+	entity := CompactEntity{
+		Ref:         userStory.ReferenceID,
+		Title:       userStory.Title,
+		Status:      string(userStory.Status),
+		Priority:    int(userStory.Priority),
+		Assignee:    userStory.Assignee.Username,
+		Description: compactDescription(safeStringValue(userStory.Description)),
+	}
+	for i := range userStory.AcceptanceCriteria {
+		entity.Children = append(entity.Children, CompactAcceptanceCriteria(&userStory.AcceptanceCriteria[i]))
+	}
+	for i := range userStory.Requirements {
+		entity.Children = append(entity.Children, CompactRequirement(&userStory.Requirements[i]))
+	}
+	return entity
+}
+
+// CompactAcceptanceCriteria converts acceptance criteria to a compact entity
+func CompactAcceptanceCriteria(acceptanceCriteria *models.AcceptanceCriteria) CompactEntity {
+	return CompactEntity{
+		Ref:         acceptanceCriteria.ReferenceID,
+		Description: compactDescription(acceptanceCriteria.Description),
+	}
+}
+
+// CompactRequirement converts a requirement to a compact entity.
+// The type, assignee and acceptance criteria are named only when preloaded.
+func CompactRequirement(requirement *models.Requirement) CompactEntity {
+	entity := CompactEntity{
+		Ref:         requirement.ReferenceID,
+		Title:       requirement.Title,
+		Status:      string(requirement.Status),
+		Priority:    int(requirement.Priority),
+		Type:        requirement.Type.Name,
+		Assignee:    requirement.Assignee.Username,
+		Description: compactDescription(safeStringValue(requirement.Description)),
+	}
+	if requirement.AcceptanceCriteria != nil {
+		entity.AC = requirement.AcceptanceCriteria.ReferenceID
+	}
+	return entity
+}
+
+// CompactEpicHierarchy converts an epic hierarchy to a compact entity tree.
+// Acceptance criteria precede requirements under each user story, and requirements
+// name their linked acceptance criteria when it belongs to the same user story.
+func CompactEpicHierarchy(hierarchy *EpicHierarchy) CompactEntity {
+	entity := CompactEpic(&hierarchy.Epic)
+	entity.Children = nil
+	for _, userStory := range hierarchy.UserStories {
+		story := CompactUserStory(&userStory.UserStory)
+		story.Children = nil
+
+		acceptanceCriteriaRefs := make(map[string]string, len(userStory.AcceptanceCriteria))
+		for i := range userStory.AcceptanceCriteria {
+			acceptanceCriteria := &userStory.AcceptanceCriteria[i]
+			acceptanceCriteriaRefs[acceptanceCriteria.ID.String()] = acceptanceCriteria.ReferenceID
+			story.Children = append(story.Children, CompactAcceptanceCriteria(acceptanceCriteria))
+		}
+		for i := range userStory.Requirements {
+			requirement := CompactRequirement(&userStory.Requirements[i].Requirement)
+			if acceptanceCriteriaID := userStory.Requirements[i].AcceptanceCriteriaID; requirement.AC == "" && acceptanceCriteriaID != nil {
+				requirement.AC = acceptanceCriteriaRefs[acceptanceCriteriaID.String()]
+			}
+			story.Children = append(story.Children, requirement)
+		}
+
+		entity.Children = append(entity.Children, story)
+	}
+	return entity
+}
+
+// CompactEpics converts a list of epics to compact entities
+func CompactEpics(epics []models.Epic) []CompactEntity {
+	entities := make([]CompactEntity, len(epics))
+	for i := range epics {
+		entities[i] = CompactEpic(&epics[i])
+	}
+	return entities
+}
+
+// CompactUserStories converts a list of user stories to compact entities
+func CompactUserStories(userStories []models.UserStory) []CompactEntity {
+	entities := make([]CompactEntity, len(userStories))
+	for i := range userStories {
+		entities[i] = CompactUserStory(&userStories[i])
+	}
+	return entities
+}
+
+// CompactAcceptanceCriteriaList converts a list of acceptance criteria to compact entities
+func CompactAcceptanceCriteriaList(acceptanceCriteria []models.AcceptanceCriteria) []CompactEntity {
+	entities := make([]CompactEntity, len(acceptanceCriteria))
+	for i := range acceptanceCriteria {
+		entities[i] = CompactAcceptanceCriteria(&acceptanceCriteria[i])
+	}
+	return entities
+}
+
+// CompactRequirements converts a list of requirements to compact entities
+func CompactRequirements(requirements []models.Requirement) []CompactEntity {
+	entities := make([]CompactEntity, len(requirements))
+	for i := range requirements {
+		entities[i] = CompactRequirement(&requirements[i])
+	}
+	return entities
+}
+
+// compactDescription collapses whitespace and truncates a description to compactDescriptionLength characters
+func compactDescription(description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	if utf8.RuneCountInString(description) <= compactDescriptionLength {
+		return description
+	}
+	runes := []rune(description)
+	return strings.TrimRight(string(runes[:compactDescriptionLength]), " ") + "…"
+}
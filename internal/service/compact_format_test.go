@@ -0,0 +1,77 @@
+package service
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+)
+
+func TestCompactRequirement(t *testing.T) {
+	description := "The system SHALL\n\n  lock the account"
+	requirement := &models.Requirement{
+		ID:          uuid.New(),
+		ReferenceID: "REQ-001",
+		Title:       "Lockout",
+		Status:      models.RequirementStatusActive,
+		Priority:    models.PriorityCritical,
+		Description: &description,
+		Type:        models.RequirementType{Name: "Security"},
+		Assignee:    models.User{Username: "alice"},
+		AcceptanceCriteria: &models.AcceptanceCriteria{
+			ReferenceID: "AC-001",
+		},
+	}
+
+	data, err := json.Marshal(CompactRequirement(requirement))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ref":"REQ-001","title":"Lockout","status":"Active","priority":1,"type":"Security","assignee":"alice","ac":"AC-001","desc":"The system SHALL lock the account"}`, string(data))
+}
+
+func TestCompactRequirement_OmitsUnloadedRelations(t *testing.T) {
+	data, err := json.Marshal(CompactRequirement(&models.Requirement{ReferenceID: "REQ-002", Title: "Audit"}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ref":"REQ-002","title":"Audit"}`, string(data))
+}
+
+func TestCompactDescription_Truncates(t *testing.T) {
+	description := strings.Repeat("ä", compactDescriptionLength+10)
+
+	compact := compactDescription(description)
+
+	assert.Equal(t, strings.Repeat("ä", compactDescriptionLength)+"…", compact)
+	assert.Equal(t, "short", compactDescription("short"))
+}
+
+func TestCompactEpicHierarchy_LinksAcceptanceCriteria(t *testing.T) {
+	acceptanceCriteriaID := uuid.New()
+	hierarchy := &EpicHierarchy{
+		Epic: models.Epic{ReferenceID: "EP-001", Title: "Accounts", Status: models.EpicStatusDraft, Priority: models.PriorityHigh},
+		UserStories: []UserStoryHierarchy{
+			{
+				UserStory: models.UserStory{ReferenceID: "US-001", Title: "Sign up", Status: models.UserStoryStatusDraft, Priority: models.PriorityMedium},
+				AcceptanceCriteria: []models.AcceptanceCriteria{
+					{ID: acceptanceCriteriaID, ReferenceID: "AC-001", Description: "WHEN a user signs up THEN the system SHALL send an email"},
+				},
+				Requirements: []RequirementHierarchy{
+					{Requirement: models.Requirement{ReferenceID: "REQ-001", Title: "Email", AcceptanceCriteriaID: &acceptanceCriteriaID}},
+				},
+			},
+		},
+	}
+
+	compact := CompactEpicHierarchy(hierarchy)
+
+	require.Len(t, compact.Children, 1)
+	story := compact.Children[0]
+	assert.Equal(t, "US-001", story.Ref)
+	require.Len(t, story.Children, 2)
+	assert.Equal(t, "AC-001", story.Children[0].Ref)
+	assert.Equal(t, "REQ-001", story.Children[1].Ref)
+	assert.Equal(t, "AC-001", story.Children[1].AC)
+}
@@ -1,10 +1,15 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 )
@@ -12,49 +17,50 @@ import (
 // ConfigService defines the interface for configuration management operations
 type ConfigService interface {
 	// Requirement Type operations
-	CreateRequirementType(req CreateRequirementTypeRequest) (*models.RequirementType, error)
-	GetRequirementTypeByID(id uuid.UUID) (*models.RequirementType, error)
-	GetRequirementTypeByName(name string) (*models.RequirementType, error)
-	UpdateRequirementType(id uuid.UUID, req UpdateRequirementTypeRequest) (*models.RequirementType, error)
-	DeleteRequirementType(id uuid.UUID, force bool) error
-	ListRequirementTypes(filters RequirementTypeFilters) ([]models.RequirementType, int64, error)
+	CreateRequirementType(ctx context.Context, req CreateRequirementTypeRequest) (*models.RequirementType, error)
+	GetRequirementTypeByID(ctx context.Context, id uuid.UUID) (*models.RequirementType, error)
+	GetRequirementTypeByName(ctx context.Context, name string) (*models.RequirementType, error)
+	UpdateRequirementType(ctx context.Context, id uuid.UUID, req UpdateRequirementTypeRequest) (*models.RequirementType, error)
+	DeleteRequirementType(ctx context.Context, id uuid.UUID, force bool) error
+	ListRequirementTypes(ctx context.Context, filters RequirementTypeFilters) ([]models.RequirementType, int64, error)
 
 	// Relationship Type operations
-	CreateRelationshipType(req CreateRelationshipTypeRequest) (*models.RelationshipType, error)
-	GetRelationshipTypeByID(id uuid.UUID) (*models.RelationshipType, error)
-	GetRelationshipTypeByName(name string) (*models.RelationshipType, error)
-	UpdateRelationshipType(id uuid.UUID, req UpdateRelationshipTypeRequest) (*models.RelationshipType, error)
-	DeleteRelationshipType(id uuid.UUID, force bool) error
-	ListRelationshipTypes(filters RelationshipTypeFilters) ([]models.RelationshipType, int64, error)
+	CreateRelationshipType(ctx context.Context, req CreateRelationshipTypeRequest) (*models.RelationshipType, error)
+	GetRelationshipTypeByID(ctx context.Context, id uuid.UUID) (*models.RelationshipType, error)
+	GetRelationshipTypeByName(ctx context.Context, name string) (*models.RelationshipType, error)
+	UpdateRelationshipType(ctx context.Context, id uuid.UUID, req UpdateRelationshipTypeRequest) (*models.RelationshipType, error)
+	DeleteRelationshipType(ctx context.Context, id uuid.UUID, force bool) error
+	ListRelationshipTypes(ctx context.Context, filters RelationshipTypeFilters) ([]models.RelationshipType, int64, error)
 
 	// Status Model operations
-	CreateStatusModel(req CreateStatusModelRequest) (*models.StatusModel, error)
-	GetStatusModelByID(id uuid.UUID) (*models.StatusModel, error)
-	GetStatusModelByEntityTypeAndName(entityType models.EntityType, name string) (*models.StatusModel, error)
-	GetDefaultStatusModelByEntityType(entityType models.EntityType) (*models.StatusModel, error)
-	UpdateStatusModel(id uuid.UUID, req UpdateStatusModelRequest) (*models.StatusModel, error)
-	DeleteStatusModel(id uuid.UUID, force bool) error
-	ListStatusModels(filters StatusModelFilters) ([]models.StatusModel, int64, error)
-	ListStatusModelsByEntityType(entityType models.EntityType) ([]models.StatusModel, error)
+	CreateStatusModel(ctx context.Context, req CreateStatusModelRequest) (*models.StatusModel, error)
+	GetStatusModelByID(ctx context.Context, id uuid.UUID) (*models.StatusModel, error)
+	GetStatusModelByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (*models.StatusModel, error)
+	GetDefaultStatusModelByEntityType(ctx context.Context, entityType models.EntityType) (*models.StatusModel, error)
+	UpdateStatusModel(ctx context.Context, id uuid.UUID, req UpdateStatusModelRequest) (*models.StatusModel, error)
+	DeleteStatusModel(ctx context.Context, id uuid.UUID, force bool) error
+	ListStatusModels(ctx context.Context, filters StatusModelFilters) ([]models.StatusModel, int64, error)
+	ListStatusModelsByEntityType(ctx context.Context, entityType models.EntityType) ([]models.StatusModel, error)
 
 	// Status operations
-	CreateStatus(req CreateStatusRequest) (*models.Status, error)
-	GetStatusByID(id uuid.UUID) (*models.Status, error)
-	UpdateStatus(id uuid.UUID, req UpdateStatusRequest) (*models.Status, error)
-	DeleteStatus(id uuid.UUID, force bool) error
-	ListStatusesByModel(statusModelID uuid.UUID) ([]models.Status, int64, error)
+	CreateStatus(ctx context.Context, req CreateStatusRequest) (*models.Status, error)
+	GetStatusByID(ctx context.Context, id uuid.UUID) (*models.Status, error)
+	UpdateStatus(ctx context.Context, id uuid.UUID, req UpdateStatusRequest) (*models.Status, error)
+	DeleteStatus(ctx context.Context, id uuid.UUID, force bool) error
+	ListStatusesByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.Status, int64, error)
+	ReorderStatuses(ctx context.Context, statusModelID uuid.UUID, statusIDs []uuid.UUID) ([]models.Status, error)
 
 	// Status Transition operations
-	CreateStatusTransition(req CreateStatusTransitionRequest) (*models.StatusTransition, error)
-	GetStatusTransitionByID(id uuid.UUID) (*models.StatusTransition, error)
-	UpdateStatusTransition(id uuid.UUID, req UpdateStatusTransitionRequest) (*models.StatusTransition, error)
-	DeleteStatusTransition(id uuid.UUID) error
-	ListStatusTransitionsByModel(statusModelID uuid.UUID) ([]models.StatusTransition, int64, error)
+	CreateStatusTransition(ctx context.Context, req CreateStatusTransitionRequest) (*models.StatusTransition, error)
+	GetStatusTransitionByID(ctx context.Context, id uuid.UUID) (*models.StatusTransition, error)
+	UpdateStatusTransition(ctx context.Context, id uuid.UUID, req UpdateStatusTransitionRequest) (*models.StatusTransition, error)
+	DeleteStatusTransition(ctx context.Context, id uuid.UUID) error
+	ListStatusTransitionsByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.StatusTransition, int64, error)
 
 	// Validation operations
-	ValidateRequirementType(typeID uuid.UUID) error
-	ValidateRelationshipType(typeID uuid.UUID) error
-	ValidateStatusTransition(entityType models.EntityType, fromStatus, toStatus string) error
+	ValidateRequirementType(ctx context.Context, typeID uuid.UUID) error
+	ValidateRelationshipType(ctx context.Context, typeID uuid.UUID) error
+	ValidateStatusTransition(ctx context.Context, entityType models.EntityType, fromStatus, toStatus string) error
 }
 
 // configService implements ConfigService interface
@@ -109,11 +115,14 @@ type RequirementTypeFilters struct {
 type CreateRelationshipTypeRequest struct {
 	Name        string  `json:"name" binding:"required,max=255"`
 	Description *string `json:"description,omitempty"`
+	// AllowedLinks lists source:target entity type pairs, e.g. "epic:epic,user_story:*"; empty allows any pair
+	AllowedLinks string `json:"allowed_links,omitempty" example:"epic:epic,user_story:user_story"`
 }
 
 type UpdateRelationshipTypeRequest struct {
-	Name        *string `json:"name,omitempty" binding:"omitempty,max=255"`
-	Description *string `json:"description,omitempty"`
+	Name         *string `json:"name,omitempty" binding:"omitempty,max=255"`
+	Description  *string `json:"description,omitempty"`
+	AllowedLinks *string `json:"allowed_links,omitempty" example:"epic:epic,user_story:user_story"`
 }
 
 type RelationshipTypeFilters struct {
@@ -174,27 +183,57 @@ type UpdateStatusTransitionRequest struct {
 	Description *string `json:"description,omitempty"`
 }
 
+// CreateModelStatusRequest creates a status in the status model named by the request path
+type CreateModelStatusRequest struct {
+	Name        string  `json:"name" binding:"required,max=255"`
+	Description *string `json:"description,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	IsInitial   bool    `json:"is_initial,omitempty"`
+	IsFinal     bool    `json:"is_final,omitempty"`
+	Order       int     `json:"order,omitempty"`
+}
+
+// CreateModelStatusTransitionRequest creates a transition in the status model named by the request path
+type CreateModelStatusTransitionRequest struct {
+	FromStatusID uuid.UUID `json:"from_status_id" binding:"required"`
+	ToStatusID   uuid.UUID `json:"to_status_id" binding:"required"`
+	Name         *string   `json:"name,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+}
+
+// ReorderStatusesRequest lists every status of a status model in display order
+type ReorderStatusesRequest struct {
+	StatusIDs []uuid.UUID `json:"status_ids" binding:"required,min=1"`
+}
+
 // Config service specific errors
 var (
-	ErrRequirementTypeNameExists        = errors.New("requirement type name already exists")
-	ErrRequirementTypeHasRequirements   = errors.New("requirement type has associated requirements")
-	ErrRelationshipTypeNameExists       = errors.New("relationship type name already exists")
-	ErrRelationshipTypeHasRelationships = errors.New("relationship type has associated relationships")
-	ErrStatusModelNameExists            = errors.New("status model name already exists for this entity type")
-	ErrStatusModelNotFound              = errors.New("status model not found")
-	ErrStatusNotFound                   = errors.New("status not found")
-	ErrStatusTransitionNotFound         = errors.New("status transition not found")
-	ErrStatusNameExists                 = errors.New("status name already exists in this model")
-	ErrTransitionExists                 = errors.New("status transition already exists")
-	ErrInvalidEntityType                = errors.New("invalid entity type")
+	ErrRequirementTypeNameExists        = apperrors.New(apperrors.KindConflict, "REQUIREMENT_TYPE_EXISTS", "requirement type name already exists")
+	ErrRequirementTypeHasRequirements   = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "requirement type has associated requirements")
+	ErrRelationshipTypeNameExists       = apperrors.New(apperrors.KindConflict, "RELATIONSHIP_TYPE_EXISTS", "relationship type name already exists")
+	ErrRelationshipTypeHasRelationships = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "relationship type has associated relationships")
+	ErrInvalidAllowedLinks              = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid allowed links")
+	ErrStatusModelNameExists            = apperrors.New(apperrors.KindConflict, "STATUS_MODEL_EXISTS", "status model name already exists for this entity type")
+	ErrStatusModelNotFound              = apperrors.New(apperrors.KindNotFound, "STATUS_MODEL_NOT_FOUND", "status model not found")
+	ErrStatusNotFound                   = apperrors.New(apperrors.KindNotFound, "STATUS_NOT_FOUND", "status not found")
+	ErrStatusTransitionNotFound         = apperrors.New(apperrors.KindNotFound, "STATUS_TRANSITION_NOT_FOUND", "status transition not found")
+	ErrStatusNameExists                 = apperrors.New(apperrors.KindConflict, "STATUS_EXISTS", "status name already exists in this model")
+	ErrTransitionExists                 = apperrors.New(apperrors.KindConflict, "STATUS_TRANSITION_EXISTS", "status transition already exists")
+	ErrNoInitialStatus                  = apperrors.New(apperrors.KindConflict, apperrors.CodeConflict, "status model must have at least one initial status")
+	ErrInvalidStatusColor               = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "color must be a hex color code such as #28a745")
+	ErrInvalidStatusOrder               = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "status_ids must list every status of the model exactly once")
+	ErrInvalidEntityType                = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid entity type")
 )
 
+// statusColorPattern matches the #rrggbb hex color codes of statuses
+var statusColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
 // Requirement Type operations
 
 // CreateRequirementType creates a new requirement type
-func (s *configService) CreateRequirementType(req CreateRequirementTypeRequest) (*models.RequirementType, error) {
+func (s *configService) CreateRequirementType(ctx context.Context, req CreateRequirementTypeRequest) (*models.RequirementType, error) {
 	// Check if name already exists
-	exists, err := s.requirementTypeRepo.ExistsByName(req.Name)
+	exists, err := s.requirementTypeRepo.ExistsByName(ctx, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +246,7 @@ func (s *configService) CreateRequirementType(req CreateRequirementTypeRequest)
 		Description: req.Description,
 	}
 
-	if err := s.requirementTypeRepo.Create(requirementType); err != nil {
+	if err := s.requirementTypeRepo.Create(ctx, requirementType); err != nil {
 		return nil, err
 	}
 
@@ -215,8 +254,8 @@ func (s *configService) CreateRequirementType(req CreateRequirementTypeRequest)
 }
 
 // GetRequirementTypeByID retrieves a requirement type by ID
-func (s *configService) GetRequirementTypeByID(id uuid.UUID) (*models.RequirementType, error) {
-	requirementType, err := s.requirementTypeRepo.GetByID(id)
+func (s *configService) GetRequirementTypeByID(ctx context.Context, id uuid.UUID) (*models.RequirementType, error) {
+	requirementType, err := s.requirementTypeRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementTypeNotFound
@@ -227,8 +266,8 @@ func (s *configService) GetRequirementTypeByID(id uuid.UUID) (*models.Requiremen
 }
 
 // GetRequirementTypeByName retrieves a requirement type by name
-func (s *configService) GetRequirementTypeByName(name string) (*models.RequirementType, error) {
-	requirementType, err := s.requirementTypeRepo.GetByName(name)
+func (s *configService) GetRequirementTypeByName(ctx context.Context, name string) (*models.RequirementType, error) {
+	requirementType, err := s.requirementTypeRepo.GetByName(ctx, name)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementTypeNotFound
@@ -239,8 +278,8 @@ func (s *configService) GetRequirementTypeByName(name string) (*models.Requireme
 }
 
 // UpdateRequirementType updates an existing requirement type
-func (s *configService) UpdateRequirementType(id uuid.UUID, req UpdateRequirementTypeRequest) (*models.RequirementType, error) {
-	requirementType, err := s.requirementTypeRepo.GetByID(id)
+func (s *configService) UpdateRequirementType(ctx context.Context, id uuid.UUID, req UpdateRequirementTypeRequest) (*models.RequirementType, error) {
+	requirementType, err := s.requirementTypeRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementTypeNotFound
@@ -250,7 +289,7 @@ func (s *configService) UpdateRequirementType(id uuid.UUID, req UpdateRequiremen
 
 	// Check if new name already exists (if name is being changed)
 	if req.Name != nil && *req.Name != requirementType.Name {
-		exists, err := s.requirementTypeRepo.ExistsByName(*req.Name)
+		exists, err := s.requirementTypeRepo.ExistsByName(ctx, *req.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -264,7 +303,7 @@ func (s *configService) UpdateRequirementType(id uuid.UUID, req UpdateRequiremen
 		requirementType.Description = req.Description
 	}
 
-	if err := s.requirementTypeRepo.Update(requirementType); err != nil {
+	if err := s.requirementTypeRepo.Update(ctx, requirementType); err != nil {
 		return nil, err
 	}
 
@@ -272,8 +311,8 @@ func (s *configService) UpdateRequirementType(id uuid.UUID, req UpdateRequiremen
 }
 
 // DeleteRequirementType deletes a requirement type
-func (s *configService) DeleteRequirementType(id uuid.UUID, force bool) error {
-	_, err := s.requirementTypeRepo.GetByID(id)
+func (s *configService) DeleteRequirementType(ctx context.Context, id uuid.UUID, force bool) error {
+	_, err := s.requirementTypeRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrRequirementTypeNotFound
@@ -282,7 +321,7 @@ func (s *configService) DeleteRequirementType(id uuid.UUID, force bool) error {
 	}
 
 	// Check if requirement type has associated requirements
-	requirements, err := s.requirementRepo.GetByType(id)
+	requirements, err := s.requirementRepo.GetByType(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -298,11 +337,11 @@ func (s *configService) DeleteRequirementType(id uuid.UUID, force bool) error {
 		return ErrRequirementTypeHasRequirements
 	}
 
-	return s.requirementTypeRepo.Delete(id)
+	return s.requirementTypeRepo.Delete(ctx, id)
 }
 
 // ListRequirementTypes lists requirement types with optional filtering
-func (s *configService) ListRequirementTypes(filters RequirementTypeFilters) ([]models.RequirementType, int64, error) {
+func (s *configService) ListRequirementTypes(ctx context.Context, filters RequirementTypeFilters) ([]models.RequirementType, int64, error) {
 	filterMap := make(map[string]interface{})
 
 	orderBy := "name"
@@ -321,13 +360,13 @@ func (s *configService) ListRequirementTypes(filters RequirementTypeFilters) ([]
 	}
 
 	// Get the data
-	data, err := s.requirementTypeRepo.List(filterMap, orderBy, limit, offset)
+	data, err := s.requirementTypeRepo.List(ctx, filterMap, orderBy, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get the total count
-	totalCount, err := s.requirementTypeRepo.Count(filterMap)
+	totalCount, err := s.requirementTypeRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -338,9 +377,9 @@ func (s *configService) ListRequirementTypes(filters RequirementTypeFilters) ([]
 // Relationship Type operations
 
 // CreateRelationshipType creates a new relationship type
-func (s *configService) CreateRelationshipType(req CreateRelationshipTypeRequest) (*models.RelationshipType, error) {
+func (s *configService) CreateRelationshipType(ctx context.Context, req CreateRelationshipTypeRequest) (*models.RelationshipType, error) {
 	// Check if name already exists
-	exists, err := s.relationshipTypeRepo.ExistsByName(req.Name)
+	exists, err := s.relationshipTypeRepo.ExistsByName(ctx, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -348,12 +387,18 @@ func (s *configService) CreateRelationshipType(req CreateRelationshipTypeRequest
 		return nil, ErrRelationshipTypeNameExists
 	}
 
+	allowedLinks, err := normalizeAllowedLinks(req.AllowedLinks)
+	if err != nil {
+		return nil, err
+	}
+
 	relationshipType := &models.RelationshipType{
-		Name:        req.Name,
-		Description: req.Description,
+		Name:         req.Name,
+		Description:  req.Description,
+		AllowedLinks: allowedLinks,
 	}
 
-	if err := s.relationshipTypeRepo.Create(relationshipType); err != nil {
+	if err := s.relationshipTypeRepo.Create(ctx, relationshipType); err != nil {
 		return nil, err
 	}
 
@@ -361,8 +406,8 @@ func (s *configService) CreateRelationshipType(req CreateRelationshipTypeRequest
 }
 
 // GetRelationshipTypeByID retrieves a relationship type by ID
-func (s *configService) GetRelationshipTypeByID(id uuid.UUID) (*models.RelationshipType, error) {
-	relationshipType, err := s.relationshipTypeRepo.GetByID(id)
+func (s *configService) GetRelationshipTypeByID(ctx context.Context, id uuid.UUID) (*models.RelationshipType, error) {
+	relationshipType, err := s.relationshipTypeRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRelationshipTypeNotFound
@@ -373,8 +418,8 @@ func (s *configService) GetRelationshipTypeByID(id uuid.UUID) (*models.Relations
 }
 
 // GetRelationshipTypeByName retrieves a relationship type by name
-func (s *configService) GetRelationshipTypeByName(name string) (*models.RelationshipType, error) {
-	relationshipType, err := s.relationshipTypeRepo.GetByName(name)
+func (s *configService) GetRelationshipTypeByName(ctx context.Context, name string) (*models.RelationshipType, error) {
+	relationshipType, err := s.relationshipTypeRepo.GetByName(ctx, name)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRelationshipTypeNotFound
@@ -385,8 +430,8 @@ func (s *configService) GetRelationshipTypeByName(name string) (*models.Relation
 }
 
 // UpdateRelationshipType updates an existing relationship type
-func (s *configService) UpdateRelationshipType(id uuid.UUID, req UpdateRelationshipTypeRequest) (*models.RelationshipType, error) {
-	relationshipType, err := s.relationshipTypeRepo.GetByID(id)
+func (s *configService) UpdateRelationshipType(ctx context.Context, id uuid.UUID, req UpdateRelationshipTypeRequest) (*models.RelationshipType, error) {
+	relationshipType, err := s.relationshipTypeRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRelationshipTypeNotFound
@@ -396,7 +441,7 @@ func (s *configService) UpdateRelationshipType(id uuid.UUID, req UpdateRelations
 
 	// Check if new name already exists (if name is being changed)
 	if req.Name != nil && *req.Name != relationshipType.Name {
-		exists, err := s.relationshipTypeRepo.ExistsByName(*req.Name)
+		exists, err := s.relationshipTypeRepo.ExistsByName(ctx, *req.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -410,7 +455,15 @@ func (s *configService) UpdateRelationshipType(id uuid.UUID, req UpdateRelations
 		relationshipType.Description = req.Description
 	}
 
-	if err := s.relationshipTypeRepo.Update(relationshipType); err != nil {
+	if req.AllowedLinks != nil {
+		allowedLinks, err := normalizeAllowedLinks(*req.AllowedLinks)
+		if err != nil {
+			return nil, err
+		}
+		relationshipType.AllowedLinks = allowedLinks
+	}
+
+	if err := s.relationshipTypeRepo.Update(ctx, relationshipType); err != nil {
 		return nil, err
 	}
 
@@ -418,8 +471,8 @@ func (s *configService) UpdateRelationshipType(id uuid.UUID, req UpdateRelations
 }
 
 // DeleteRelationshipType deletes a relationship type
-func (s *configService) DeleteRelationshipType(id uuid.UUID, force bool) error {
-	_, err := s.relationshipTypeRepo.GetByID(id)
+func (s *configService) DeleteRelationshipType(ctx context.Context, id uuid.UUID, force bool) error {
+	_, err := s.relationshipTypeRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrRelationshipTypeNotFound
@@ -428,7 +481,7 @@ func (s *configService) DeleteRelationshipType(id uuid.UUID, force bool) error {
 	}
 
 	// Check if relationship type has associated relationships
-	relationships, err := s.requirementRelationRepo.GetByType(id)
+	relationships, err := s.requirementRelationRepo.GetByType(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -437,6 +490,15 @@ func (s *configService) DeleteRelationshipType(id uuid.UUID, force bool) error {
 		return ErrRelationshipTypeHasRelationships
 	}
 
+	// Links between other entity types restrict deletion the same way
+	entityLinks, err := s.relationshipTypeRepo.CountEntityRelationships(ctx, id)
+	if err != nil {
+		return err
+	}
+	if entityLinks > 0 {
+		return ErrRelationshipTypeHasRelationships
+	}
+
 	// If force is true, we need to handle the relationships
 	if force && len(relationships) > 0 {
 		// For now, we'll prevent deletion even with force if there are relationships
@@ -444,11 +506,24 @@ func (s *configService) DeleteRelationshipType(id uuid.UUID, force bool) error {
 		return ErrRelationshipTypeHasRelationships
 	}
 
-	return s.relationshipTypeRepo.Delete(id)
+	return s.relationshipTypeRepo.Delete(ctx, id)
+}
+
+// normalizeAllowedLinks validates allowed links and rewrites them as comma-separated source:target pairs
+func normalizeAllowedLinks(value string) (string, error) {
+	rules, err := models.ParseEntityLinkRules(value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidAllowedLinks, err)
+	}
+	pairs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		pairs = append(pairs, string(rule.SourceType)+":"+string(rule.TargetType))
+	}
+	return strings.Join(pairs, ","), nil
 }
 
 // ListRelationshipTypes lists relationship types with optional filtering
-func (s *configService) ListRelationshipTypes(filters RelationshipTypeFilters) ([]models.RelationshipType, int64, error) {
+func (s *configService) ListRelationshipTypes(ctx context.Context, filters RelationshipTypeFilters) ([]models.RelationshipType, int64, error) {
 	filterMap := make(map[string]interface{})
 
 	orderBy := "name"
@@ -467,13 +542,13 @@ func (s *configService) ListRelationshipTypes(filters RelationshipTypeFilters) (
 	}
 
 	// Get the data
-	data, err := s.relationshipTypeRepo.List(filterMap, orderBy, limit, offset)
+	data, err := s.relationshipTypeRepo.List(ctx, filterMap, orderBy, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get the total count
-	totalCount, err := s.relationshipTypeRepo.Count(filterMap)
+	totalCount, err := s.relationshipTypeRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -484,8 +559,8 @@ func (s *configService) ListRelationshipTypes(filters RelationshipTypeFilters) (
 // Validation operations
 
 // ValidateRequirementType validates that a requirement type exists
-func (s *configService) ValidateRequirementType(typeID uuid.UUID) error {
-	exists, err := s.requirementTypeRepo.Exists(typeID)
+func (s *configService) ValidateRequirementType(ctx context.Context, typeID uuid.UUID) error {
+	exists, err := s.requirementTypeRepo.Exists(ctx, typeID)
 	if err != nil {
 		return err
 	}
@@ -496,8 +571,8 @@ func (s *configService) ValidateRequirementType(typeID uuid.UUID) error {
 }
 
 // ValidateRelationshipType validates that a relationship type exists
-func (s *configService) ValidateRelationshipType(typeID uuid.UUID) error {
-	exists, err := s.relationshipTypeRepo.Exists(typeID)
+func (s *configService) ValidateRelationshipType(ctx context.Context, typeID uuid.UUID) error {
+	exists, err := s.relationshipTypeRepo.Exists(ctx, typeID)
 	if err != nil {
 		return err
 	}
@@ -510,14 +585,14 @@ func (s *configService) ValidateRelationshipType(typeID uuid.UUID) error {
 // Status Model operations
 
 // CreateStatusModel creates a new status model
-func (s *configService) CreateStatusModel(req CreateStatusModelRequest) (*models.StatusModel, error) {
+func (s *configService) CreateStatusModel(ctx context.Context, req CreateStatusModelRequest) (*models.StatusModel, error) {
 	// Validate entity type
 	if !models.IsValidEntityType(req.EntityType) {
 		return nil, ErrInvalidEntityType
 	}
 
 	// Check if name already exists for this entity type
-	exists, err := s.statusModelRepo.ExistsByEntityTypeAndName(req.EntityType, req.Name)
+	exists, err := s.statusModelRepo.ExistsByEntityTypeAndName(ctx, req.EntityType, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -532,7 +607,7 @@ func (s *configService) CreateStatusModel(req CreateStatusModelRequest) (*models
 		IsDefault:   req.IsDefault,
 	}
 
-	if err := s.statusModelRepo.Create(statusModel); err != nil {
+	if err := s.statusModelRepo.Create(ctx, statusModel); err != nil {
 		return nil, err
 	}
 
@@ -540,8 +615,8 @@ func (s *configService) CreateStatusModel(req CreateStatusModelRequest) (*models
 }
 
 // GetStatusModelByID retrieves a status model by ID
-func (s *configService) GetStatusModelByID(id uuid.UUID) (*models.StatusModel, error) {
-	statusModel, err := s.statusModelRepo.GetByID(id)
+func (s *configService) GetStatusModelByID(ctx context.Context, id uuid.UUID) (*models.StatusModel, error) {
+	statusModel, err := s.statusModelRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusModelNotFound
@@ -552,8 +627,8 @@ func (s *configService) GetStatusModelByID(id uuid.UUID) (*models.StatusModel, e
 }
 
 // GetStatusModelByEntityTypeAndName retrieves a status model by entity type and name
-func (s *configService) GetStatusModelByEntityTypeAndName(entityType models.EntityType, name string) (*models.StatusModel, error) {
-	statusModel, err := s.statusModelRepo.GetByEntityTypeAndName(entityType, name)
+func (s *configService) GetStatusModelByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (*models.StatusModel, error) {
+	statusModel, err := s.statusModelRepo.GetByEntityTypeAndName(ctx, entityType, name)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusModelNotFound
@@ -564,8 +639,8 @@ func (s *configService) GetStatusModelByEntityTypeAndName(entityType models.Enti
 }
 
 // GetDefaultStatusModelByEntityType retrieves the default status model for an entity type
-func (s *configService) GetDefaultStatusModelByEntityType(entityType models.EntityType) (*models.StatusModel, error) {
-	statusModel, err := s.statusModelRepo.GetDefaultByEntityType(entityType)
+func (s *configService) GetDefaultStatusModelByEntityType(ctx context.Context, entityType models.EntityType) (*models.StatusModel, error) {
+	statusModel, err := s.statusModelRepo.GetDefaultByEntityType(ctx, entityType)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusModelNotFound
@@ -576,8 +651,8 @@ func (s *configService) GetDefaultStatusModelByEntityType(entityType models.Enti
 }
 
 // UpdateStatusModel updates an existing status model
-func (s *configService) UpdateStatusModel(id uuid.UUID, req UpdateStatusModelRequest) (*models.StatusModel, error) {
-	statusModel, err := s.statusModelRepo.GetByID(id)
+func (s *configService) UpdateStatusModel(ctx context.Context, id uuid.UUID, req UpdateStatusModelRequest) (*models.StatusModel, error) {
+	statusModel, err := s.statusModelRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusModelNotFound
@@ -587,7 +662,7 @@ func (s *configService) UpdateStatusModel(id uuid.UUID, req UpdateStatusModelReq
 
 	// Check if new name already exists (if name is being changed)
 	if req.Name != nil && *req.Name != statusModel.Name {
-		exists, err := s.statusModelRepo.ExistsByEntityTypeAndName(statusModel.EntityType, *req.Name)
+		exists, err := s.statusModelRepo.ExistsByEntityTypeAndName(ctx, statusModel.EntityType, *req.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -605,7 +680,7 @@ func (s *configService) UpdateStatusModel(id uuid.UUID, req UpdateStatusModelReq
 		statusModel.IsDefault = *req.IsDefault
 	}
 
-	if err := s.statusModelRepo.Update(statusModel); err != nil {
+	if err := s.statusModelRepo.Update(ctx, statusModel); err != nil {
 		return nil, err
 	}
 
@@ -613,8 +688,8 @@ func (s *configService) UpdateStatusModel(id uuid.UUID, req UpdateStatusModelReq
 }
 
 // DeleteStatusModel deletes a status model
-func (s *configService) DeleteStatusModel(id uuid.UUID, force bool) error {
-	_, err := s.statusModelRepo.GetByID(id)
+func (s *configService) DeleteStatusModel(ctx context.Context, id uuid.UUID, force bool) error {
+	_, err := s.statusModelRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrStatusModelNotFound
@@ -624,11 +699,11 @@ func (s *configService) DeleteStatusModel(id uuid.UUID, force bool) error {
 
 	// For now, we'll allow deletion of status models
 	// In a production system, you might want to check if entities are using this status model
-	return s.statusModelRepo.Delete(id)
+	return s.statusModelRepo.Delete(ctx, id)
 }
 
 // ListStatusModels lists status models with optional filtering
-func (s *configService) ListStatusModels(filters StatusModelFilters) ([]models.StatusModel, int64, error) {
+func (s *configService) ListStatusModels(ctx context.Context, filters StatusModelFilters) ([]models.StatusModel, int64, error) {
 	filterMap := make(map[string]interface{})
 
 	if filters.EntityType != "" {
@@ -651,13 +726,13 @@ func (s *configService) ListStatusModels(filters StatusModelFilters) ([]models.S
 	}
 
 	// Get the data
-	data, err := s.statusModelRepo.List(filterMap, orderBy, limit, offset)
+	data, err := s.statusModelRepo.List(ctx, filterMap, orderBy, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get the total count
-	totalCount, err := s.statusModelRepo.Count(filterMap)
+	totalCount, err := s.statusModelRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -666,16 +741,16 @@ func (s *configService) ListStatusModels(filters StatusModelFilters) ([]models.S
 }
 
 // ListStatusModelsByEntityType lists status models for a specific entity type
-func (s *configService) ListStatusModelsByEntityType(entityType models.EntityType) ([]models.StatusModel, error) {
-	return s.statusModelRepo.ListByEntityType(entityType)
+func (s *configService) ListStatusModelsByEntityType(ctx context.Context, entityType models.EntityType) ([]models.StatusModel, error) {
+	return s.statusModelRepo.ListByEntityType(ctx, entityType)
 }
 
 // Status operations
 
 // CreateStatus creates a new status
-func (s *configService) CreateStatus(req CreateStatusRequest) (*models.Status, error) {
+func (s *configService) CreateStatus(ctx context.Context, req CreateStatusRequest) (*models.Status, error) {
 	// Validate that the status model exists
-	_, err := s.statusModelRepo.GetByID(req.StatusModelID)
+	_, err := s.statusModelRepo.GetByID(ctx, req.StatusModelID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusModelNotFound
@@ -684,7 +759,7 @@ func (s *configService) CreateStatus(req CreateStatusRequest) (*models.Status, e
 	}
 
 	// Check if name already exists in this status model
-	exists, err := s.statusRepo.ExistsByName(req.StatusModelID, req.Name)
+	exists, err := s.statusRepo.ExistsByName(ctx, req.StatusModelID, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -692,6 +767,21 @@ func (s *configService) CreateStatus(req CreateStatusRequest) (*models.Status, e
 		return nil, ErrStatusNameExists
 	}
 
+	if !isValidStatusColor(req.Color) {
+		return nil, ErrInvalidStatusColor
+	}
+
+	// The first status of a model must be initial so the model always has one
+	if !req.IsInitial {
+		initialCount, err := s.countInitialStatuses(ctx, req.StatusModelID, uuid.Nil)
+		if err != nil {
+			return nil, err
+		}
+		if initialCount == 0 {
+			return nil, ErrNoInitialStatus
+		}
+	}
+
 	status := &models.Status{
 		StatusModelID: req.StatusModelID,
 		Name:          req.Name,
@@ -702,7 +792,7 @@ func (s *configService) CreateStatus(req CreateStatusRequest) (*models.Status, e
 		Order:         req.Order,
 	}
 
-	if err := s.statusRepo.Create(status); err != nil {
+	if err := s.statusRepo.Create(ctx, status); err != nil {
 		return nil, err
 	}
 
@@ -710,8 +800,8 @@ func (s *configService) CreateStatus(req CreateStatusRequest) (*models.Status, e
 }
 
 // GetStatusByID retrieves a status by ID
-func (s *configService) GetStatusByID(id uuid.UUID) (*models.Status, error) {
-	status, err := s.statusRepo.GetByID(id)
+func (s *configService) GetStatusByID(ctx context.Context, id uuid.UUID) (*models.Status, error) {
+	status, err := s.statusRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusNotFound
@@ -722,8 +812,8 @@ func (s *configService) GetStatusByID(id uuid.UUID) (*models.Status, error) {
 }
 
 // UpdateStatus updates an existing status
-func (s *configService) UpdateStatus(id uuid.UUID, req UpdateStatusRequest) (*models.Status, error) {
-	status, err := s.statusRepo.GetByID(id)
+func (s *configService) UpdateStatus(ctx context.Context, id uuid.UUID, req UpdateStatusRequest) (*models.Status, error) {
+	status, err := s.statusRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusNotFound
@@ -733,7 +823,7 @@ func (s *configService) UpdateStatus(id uuid.UUID, req UpdateStatusRequest) (*mo
 
 	// Check if new name already exists (if name is being changed)
 	if req.Name != nil && *req.Name != status.Name {
-		exists, err := s.statusRepo.ExistsByName(status.StatusModelID, *req.Name)
+		exists, err := s.statusRepo.ExistsByName(ctx, status.StatusModelID, *req.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -748,10 +838,22 @@ func (s *configService) UpdateStatus(id uuid.UUID, req UpdateStatusRequest) (*mo
 	}
 
 	if req.Color != nil {
+		if !isValidStatusColor(req.Color) {
+			return nil, ErrInvalidStatusColor
+		}
 		status.Color = req.Color
 	}
 
 	if req.IsInitial != nil {
+		if status.IsInitial && !*req.IsInitial {
+			initialCount, err := s.countInitialStatuses(ctx, status.StatusModelID, status.ID)
+			if err != nil {
+				return nil, err
+			}
+			if initialCount == 0 {
+				return nil, ErrNoInitialStatus
+			}
+		}
 		status.IsInitial = *req.IsInitial
 	}
 
@@ -763,7 +865,7 @@ func (s *configService) UpdateStatus(id uuid.UUID, req UpdateStatusRequest) (*mo
 		status.Order = *req.Order
 	}
 
-	if err := s.statusRepo.Update(status); err != nil {
+	if err := s.statusRepo.Update(ctx, status); err != nil {
 		return nil, err
 	}
 
@@ -771,8 +873,8 @@ func (s *configService) UpdateStatus(id uuid.UUID, req UpdateStatusRequest) (*mo
 }
 
 // DeleteStatus deletes a status
-func (s *configService) DeleteStatus(id uuid.UUID, force bool) error {
-	_, err := s.statusRepo.GetByID(id)
+func (s *configService) DeleteStatus(ctx context.Context, id uuid.UUID, force bool) error {
+	status, err := s.statusRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrStatusNotFound
@@ -780,21 +882,36 @@ func (s *configService) DeleteStatus(id uuid.UUID, force bool) error {
 		return err
 	}
 
+	// The last initial status can only go together with the rest of the model's statuses
+	if status.IsInitial {
+		statuses, err := s.statusRepo.GetByStatusModelID(ctx, status.StatusModelID)
+		if err != nil {
+			return err
+		}
+		initialCount, err := s.countInitialStatuses(ctx, status.StatusModelID, status.ID)
+		if err != nil {
+			return err
+		}
+		if initialCount == 0 && len(statuses) > 1 {
+			return ErrNoInitialStatus
+		}
+	}
+
 	// For now, we'll allow deletion of statuses
 	// In a production system, you might want to check if entities are using this status
-	return s.statusRepo.Delete(id)
+	return s.statusRepo.Delete(ctx, id)
 }
 
 // ListStatusesByModel lists statuses for a specific status model
-func (s *configService) ListStatusesByModel(statusModelID uuid.UUID) ([]models.Status, int64, error) {
+func (s *configService) ListStatusesByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.Status, int64, error) {
 	// Get the data
-	data, err := s.statusRepo.GetByStatusModelID(statusModelID)
+	data, err := s.statusRepo.GetByStatusModelID(ctx, statusModelID)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get the total count
-	totalCount, err := s.statusRepo.CountByStatusModelID(statusModelID)
+	totalCount, err := s.statusRepo.CountByStatusModelID(ctx, statusModelID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -802,12 +919,66 @@ func (s *configService) ListStatusesByModel(statusModelID uuid.UUID) ([]models.S
 	return data, totalCount, nil
 }
 
+// ReorderStatuses sets the display order of a status model's statuses to their position in statusIDs.
+// statusIDs must list every status of the model exactly once.
+func (s *configService) ReorderStatuses(ctx context.Context, statusModelID uuid.UUID, statusIDs []uuid.UUID) ([]models.Status, error) {
+	if _, err := s.statusModelRepo.GetByID(ctx, statusModelID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrStatusModelNotFound
+		}
+		return nil, err
+	}
+
+	statuses, err := s.statusRepo.GetByStatusModelID(ctx, statusModelID)
+	if err != nil {
+		return nil, err
+	}
+	if len(statusIDs) != len(statuses) {
+		return nil, ErrInvalidStatusOrder
+	}
+	remaining := make(map[uuid.UUID]bool, len(statuses))
+	for _, status := range statuses {
+		remaining[status.ID] = true
+	}
+	for _, id := range statusIDs {
+		if !remaining[id] {
+			return nil, ErrInvalidStatusOrder
+		}
+		delete(remaining, id)
+	}
+
+	if err := s.statusRepo.UpdateOrder(ctx, statusModelID, statusIDs); err != nil {
+		return nil, err
+	}
+	return s.statusRepo.GetByStatusModelID(ctx, statusModelID)
+}
+
+// countInitialStatuses counts the initial statuses of a status model, leaving out the status with excludeID
+func (s *configService) countInitialStatuses(ctx context.Context, statusModelID, excludeID uuid.UUID) (int, error) {
+	statuses, err := s.statusRepo.GetByStatusModelID(ctx, statusModelID)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, status := range statuses {
+		if status.IsInitial && status.ID != excludeID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// isValidStatusColor reports whether a status color is unset or a #rrggbb hex color code
+func isValidStatusColor(color *string) bool {
+	return color == nil || statusColorPattern.MatchString(*color)
+}
+
 // Status Transition operations
 
 // CreateStatusTransition creates a new status transition
-func (s *configService) CreateStatusTransition(req CreateStatusTransitionRequest) (*models.StatusTransition, error) {
+func (s *configService) CreateStatusTransition(ctx context.Context, req CreateStatusTransitionRequest) (*models.StatusTransition, error) {
 	// Validate that the status model exists
-	_, err := s.statusModelRepo.GetByID(req.StatusModelID)
+	_, err := s.statusModelRepo.GetByID(ctx, req.StatusModelID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusModelNotFound
@@ -816,7 +987,7 @@ func (s *configService) CreateStatusTransition(req CreateStatusTransitionRequest
 	}
 
 	// Validate that both statuses exist and belong to the same status model
-	fromStatus, err := s.statusRepo.GetByID(req.FromStatusID)
+	fromStatus, err := s.statusRepo.GetByID(ctx, req.FromStatusID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusNotFound
@@ -824,7 +995,7 @@ func (s *configService) CreateStatusTransition(req CreateStatusTransitionRequest
 		return nil, err
 	}
 
-	toStatus, err := s.statusRepo.GetByID(req.ToStatusID)
+	toStatus, err := s.statusRepo.GetByID(ctx, req.ToStatusID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusNotFound
@@ -835,9 +1006,12 @@ func (s *configService) CreateStatusTransition(req CreateStatusTransitionRequest
 	if fromStatus.StatusModelID != req.StatusModelID || toStatus.StatusModelID != req.StatusModelID {
 		return nil, ErrInvalidStatusTransition
 	}
+	if fromStatus.ID == toStatus.ID {
+		return nil, ErrInvalidStatusTransition
+	}
 
 	// Check if transition already exists
-	exists, err := s.statusTransitionRepo.ExistsByTransition(req.StatusModelID, req.FromStatusID, req.ToStatusID)
+	exists, err := s.statusTransitionRepo.ExistsByTransition(ctx, req.StatusModelID, req.FromStatusID, req.ToStatusID)
 	if err != nil {
 		return nil, err
 	}
@@ -853,7 +1027,7 @@ func (s *configService) CreateStatusTransition(req CreateStatusTransitionRequest
 		Description:   req.Description,
 	}
 
-	if err := s.statusTransitionRepo.Create(transition); err != nil {
+	if err := s.statusTransitionRepo.Create(ctx, transition); err != nil {
 		return nil, err
 	}
 
@@ -861,8 +1035,8 @@ func (s *configService) CreateStatusTransition(req CreateStatusTransitionRequest
 }
 
 // GetStatusTransitionByID retrieves a status transition by ID
-func (s *configService) GetStatusTransitionByID(id uuid.UUID) (*models.StatusTransition, error) {
-	transition, err := s.statusTransitionRepo.GetByID(id)
+func (s *configService) GetStatusTransitionByID(ctx context.Context, id uuid.UUID) (*models.StatusTransition, error) {
+	transition, err := s.statusTransitionRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusTransitionNotFound
@@ -873,8 +1047,8 @@ func (s *configService) GetStatusTransitionByID(id uuid.UUID) (*models.StatusTra
 }
 
 // UpdateStatusTransition updates an existing status transition
-func (s *configService) UpdateStatusTransition(id uuid.UUID, req UpdateStatusTransitionRequest) (*models.StatusTransition, error) {
-	transition, err := s.statusTransitionRepo.GetByID(id)
+func (s *configService) UpdateStatusTransition(ctx context.Context, id uuid.UUID, req UpdateStatusTransitionRequest) (*models.StatusTransition, error) {
+	transition, err := s.statusTransitionRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrStatusTransitionNotFound
@@ -890,7 +1064,7 @@ func (s *configService) UpdateStatusTransition(id uuid.UUID, req UpdateStatusTra
 		transition.Description = req.Description
 	}
 
-	if err := s.statusTransitionRepo.Update(transition); err != nil {
+	if err := s.statusTransitionRepo.Update(ctx, transition); err != nil {
 		return nil, err
 	}
 
@@ -898,8 +1072,8 @@ func (s *configService) UpdateStatusTransition(id uuid.UUID, req UpdateStatusTra
 }
 
 // DeleteStatusTransition deletes a status transition
-func (s *configService) DeleteStatusTransition(id uuid.UUID) error {
-	_, err := s.statusTransitionRepo.GetByID(id)
+func (s *configService) DeleteStatusTransition(ctx context.Context, id uuid.UUID) error {
+	_, err := s.statusTransitionRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrStatusTransitionNotFound
@@ -907,19 +1081,19 @@ func (s *configService) DeleteStatusTransition(id uuid.UUID) error {
 		return err
 	}
 
-	return s.statusTransitionRepo.Delete(id)
+	return s.statusTransitionRepo.Delete(ctx, id)
 }
 
 // ListStatusTransitionsByModel lists status transitions for a specific status model
-func (s *configService) ListStatusTransitionsByModel(statusModelID uuid.UUID) ([]models.StatusTransition, int64, error) {
+func (s *configService) ListStatusTransitionsByModel(ctx context.Context, statusModelID uuid.UUID) ([]models.StatusTransition, int64, error) {
 	// Get the data
-	data, err := s.statusTransitionRepo.GetByStatusModelID(statusModelID)
+	data, err := s.statusTransitionRepo.GetByStatusModelID(ctx, statusModelID)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get the total count
-	totalCount, err := s.statusTransitionRepo.CountByStatusModelID(statusModelID)
+	totalCount, err := s.statusTransitionRepo.CountByStatusModelID(ctx, statusModelID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -928,9 +1102,9 @@ func (s *configService) ListStatusTransitionsByModel(statusModelID uuid.UUID) ([
 }
 
 // ValidateStatusTransition validates that a status transition is allowed
-func (s *configService) ValidateStatusTransition(entityType models.EntityType, fromStatus, toStatus string) error {
+func (s *configService) ValidateStatusTransition(ctx context.Context, entityType models.EntityType, fromStatus, toStatus string) error {
 	// Get the default status model for the entity type
-	statusModel, err := s.statusModelRepo.GetDefaultByEntityType(entityType)
+	statusModel, err := s.statusModelRepo.GetDefaultByEntityType(ctx, entityType)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			// If no status model is found, allow all transitions (default behavior)
@@ -940,7 +1114,7 @@ func (s *configService) ValidateStatusTransition(entityType models.EntityType, f
 	}
 
 	// Get the from and to status objects
-	fromStatusObj, err := s.statusRepo.GetByName(statusModel.ID, fromStatus)
+	fromStatusObj, err := s.statusRepo.GetByName(ctx, statusModel.ID, fromStatus)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrInvalidStatusTransition
@@ -948,7 +1122,7 @@ func (s *configService) ValidateStatusTransition(entityType models.EntityType, f
 		return err
 	}
 
-	toStatusObj, err := s.statusRepo.GetByName(statusModel.ID, toStatus)
+	toStatusObj, err := s.statusRepo.GetByName(ctx, statusModel.ID, toStatus)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrInvalidStatusTransition
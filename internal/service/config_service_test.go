@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -17,22 +18,22 @@ type MockConfigRequirementTypeRepository struct {
 	mock.Mock
 }
 
-func (m *MockConfigRequirementTypeRepository) Create(entity *models.RequirementType) error {
+func (m *MockConfigRequirementTypeRepository) Create(ctx context.Context, entity *models.RequirementType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockConfigRequirementTypeRepository) GetByID(id uuid.UUID) (*models.RequirementType, error) {
+func (m *MockConfigRequirementTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RequirementType, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) GetByReferenceID(referenceID string) (*models.RequirementType, error) {
+func (m *MockConfigRequirementTypeRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.RequirementType, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.RequirementType, error) {
+func (m *MockConfigRequirementTypeRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.RequirementType, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,37 +41,37 @@ func (m *MockConfigRequirementTypeRepository) GetByReferenceIDCaseInsensitive(re
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) Update(entity *models.RequirementType) error {
+func (m *MockConfigRequirementTypeRepository) Update(ctx context.Context, entity *models.RequirementType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockConfigRequirementTypeRepository) Delete(id uuid.UUID) error {
+func (m *MockConfigRequirementTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockConfigRequirementTypeRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementType, error) {
+func (m *MockConfigRequirementTypeRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementType, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockConfigRequirementTypeRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockConfigRequirementTypeRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockConfigRequirementTypeRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) WithTransaction(fn func(db *gorm.DB) error) error {
+func (m *MockConfigRequirementTypeRepository) WithTransaction(ctx context.Context, fn func(db *gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -80,12 +81,12 @@ func (m *MockConfigRequirementTypeRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockConfigRequirementTypeRepository) GetByName(name string) (*models.RequirementType, error) {
+func (m *MockConfigRequirementTypeRepository) GetByName(ctx context.Context, name string) (*models.RequirementType, error) {
 	args := m.Called(name)
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockConfigRequirementTypeRepository) ExistsByName(name string) (bool, error) {
+func (m *MockConfigRequirementTypeRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	args := m.Called(name)
 	return args.Bool(0), args.Error(1)
 }
@@ -94,22 +95,22 @@ type MockConfigRelationshipTypeRepository struct {
 	mock.Mock
 }
 
-func (m *MockConfigRelationshipTypeRepository) Create(entity *models.RelationshipType) error {
+func (m *MockConfigRelationshipTypeRepository) Create(ctx context.Context, entity *models.RelationshipType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockConfigRelationshipTypeRepository) GetByID(id uuid.UUID) (*models.RelationshipType, error) {
+func (m *MockConfigRelationshipTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RelationshipType, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) GetByReferenceID(referenceID string) (*models.RelationshipType, error) {
+func (m *MockConfigRelationshipTypeRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.RelationshipType, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.RelationshipType, error) {
+func (m *MockConfigRelationshipTypeRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.RelationshipType, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -117,37 +118,37 @@ func (m *MockConfigRelationshipTypeRepository) GetByReferenceIDCaseInsensitive(r
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) Update(entity *models.RelationshipType) error {
+func (m *MockConfigRelationshipTypeRepository) Update(ctx context.Context, entity *models.RelationshipType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockConfigRelationshipTypeRepository) Delete(id uuid.UUID) error {
+func (m *MockConfigRelationshipTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockConfigRelationshipTypeRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RelationshipType, error) {
+func (m *MockConfigRelationshipTypeRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RelationshipType, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockConfigRelationshipTypeRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockConfigRelationshipTypeRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockConfigRelationshipTypeRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) WithTransaction(fn func(db *gorm.DB) error) error {
+func (m *MockConfigRelationshipTypeRepository) WithTransaction(ctx context.Context, fn func(db *gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -157,153 +158,185 @@ func (m *MockConfigRelationshipTypeRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockConfigRelationshipTypeRepository) GetByName(name string) (*models.RelationshipType, error) {
+func (m *MockConfigRelationshipTypeRepository) GetByName(ctx context.Context, name string) (*models.RelationshipType, error) {
 	args := m.Called(name)
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockConfigRelationshipTypeRepository) ExistsByName(name string) (bool, error) {
+func (m *MockConfigRelationshipTypeRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	args := m.Called(name)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockConfigRelationshipTypeRepository) CountEntityRelationships(ctx context.Context, typeID uuid.UUID) (int64, error) {
+	args := m.Called(typeID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 type MockConfigRequirementRepository struct {
 	mock.Mock
 }
 
-func (m *MockConfigRequirementRepository) GetByType(typeID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByType(ctx context.Context, typeID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(typeID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
 // Add other required methods (simplified for brevity)
-func (m *MockConfigRequirementRepository) Create(entity *models.Requirement) error { return nil }
-func (m *MockConfigRequirementRepository) GetByID(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockConfigRequirementRepository) Create(ctx context.Context, entity *models.Requirement) error {
+	return nil
+}
+func (m *MockConfigRequirementRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByReferenceID(referenceID string) (*models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	return nil, nil
 }
 
-func (m *MockConfigRequirementRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) Update(entity *models.Requirement) error { return nil }
-func (m *MockConfigRequirementRepository) Delete(id uuid.UUID) error               { return nil }
-func (m *MockConfigRequirementRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) Update(ctx context.Context, entity *models.Requirement) error {
+	return nil
+}
+func (m *MockConfigRequirementRepository) Delete(ctx context.Context, id uuid.UUID) error { return nil }
+func (m *MockConfigRequirementRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockConfigRequirementRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	return 0, nil
 }
-func (m *MockConfigRequirementRepository) Exists(id uuid.UUID) (bool, error) { return false, nil }
-func (m *MockConfigRequirementRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockConfigRequirementRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return false, nil
+}
+func (m *MockConfigRequirementRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	return false, nil
 }
-func (m *MockConfigRequirementRepository) WithTransaction(fn func(db *gorm.DB) error) error {
+func (m *MockConfigRequirementRepository) WithTransaction(ctx context.Context, fn func(db *gorm.DB) error) error {
 	return nil
 }
 func (m *MockConfigRequirementRepository) GetDB() *gorm.DB { return nil }
-func (m *MockConfigRequirementRepository) GetWithRelationships(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetWithRelationships(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByUserStory(userStoryID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByAcceptanceCriteria(acceptanceCriteriaID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByAcceptanceCriteria(ctx context.Context, acceptanceCriteriaID uuid.UUID) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByCreator(creatorID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByStatus(status models.RequirementStatus) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByStatus(ctx context.Context, status models.RequirementStatus) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByPriority(priority models.Priority) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) HasRelationships(id uuid.UUID) (bool, error) {
+func (m *MockConfigRequirementRepository) HasRelationships(ctx context.Context, id uuid.UUID) (bool, error) {
 	return false, nil
 }
-func (m *MockConfigRequirementRepository) SearchByText(searchText string) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) SearchByText(ctx context.Context, searchText string) ([]models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) SearchByTextWithPagination(searchText string, limit, offset int) ([]models.Requirement, int64, error) {
+func (m *MockConfigRequirementRepository) SearchByTextWithPagination(ctx context.Context, searchText string, limit, offset int) ([]models.Requirement, int64, error) {
 	return nil, 0, nil
 }
-func (m *MockConfigRequirementRepository) GetByIDWithPreloads(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByIDWithPreloads(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) GetByReferenceIDWithPreloads(referenceID string) (*models.Requirement, error) {
+func (m *MockConfigRequirementRepository) GetByReferenceIDWithPreloads(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRepository) ListWithPreloads(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
+func (m *MockConfigRequirementRepository) ListWithPreloads(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
+	return nil, nil
+}
+
+func (m *MockConfigRequirementRepository) GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]models.AcceptanceCriteria, error) {
 	return nil, nil
 }
 
+func (m *MockConfigRequirementRepository) LinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error {
+	return nil
+}
+
+func (m *MockConfigRequirementRepository) UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error {
+	return nil
+}
+
 type MockConfigRequirementRelationshipRepository struct {
 	mock.Mock
 }
 
-func (m *MockConfigRequirementRelationshipRepository) GetByType(typeID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) GetByType(ctx context.Context, typeID uuid.UUID) ([]models.RequirementRelationship, error) {
 	args := m.Called(typeID)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
 // Add other required methods (simplified for brevity)
-func (m *MockConfigRequirementRelationshipRepository) Create(entity *models.RequirementRelationship) error {
+func (m *MockConfigRequirementRelationshipRepository) Create(ctx context.Context, entity *models.RequirementRelationship) error {
 	return nil
 }
-func (m *MockConfigRequirementRelationshipRepository) GetByID(id uuid.UUID) (*models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RequirementRelationship, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) GetByReferenceID(referenceID string) (*models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.RequirementRelationship, error) {
 	return nil, nil
 }
 
-func (m *MockConfigRequirementRelationshipRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.RequirementRelationship, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) Update(entity *models.RequirementRelationship) error {
+func (m *MockConfigRequirementRelationshipRepository) Update(ctx context.Context, entity *models.RequirementRelationship) error {
 	return nil
 }
-func (m *MockConfigRequirementRelationshipRepository) Delete(id uuid.UUID) error { return nil }
-func (m *MockConfigRequirementRelationshipRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+func (m *MockConfigRequirementRelationshipRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementRelationship, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockConfigRequirementRelationshipRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	return 0, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockConfigRequirementRelationshipRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	return false, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockConfigRequirementRelationshipRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	return false, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) WithTransaction(fn func(db *gorm.DB) error) error {
+func (m *MockConfigRequirementRelationshipRepository) WithTransaction(ctx context.Context, fn func(db *gorm.DB) error) error {
 	return nil
 }
 func (m *MockConfigRequirementRelationshipRepository) GetDB() *gorm.DB { return nil }
-func (m *MockConfigRequirementRelationshipRepository) GetBySourceRequirement(sourceID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) GetBySourceRequirement(ctx context.Context, sourceID uuid.UUID) ([]models.RequirementRelationship, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) GetByTargetRequirement(targetID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) GetByTargetRequirement(ctx context.Context, targetID uuid.UUID) ([]models.RequirementRelationship, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) GetByRequirement(requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockConfigRequirementRelationshipRepository) GetByRequirement(ctx context.Context, requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
 	return nil, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) ExistsRelationship(sourceID, targetID, typeID uuid.UUID) (bool, error) {
+func (m *MockConfigRequirementRelationshipRepository) ExistsRelationship(ctx context.Context, sourceID, targetID, typeID uuid.UUID) (bool, error) {
 	return false, nil
 }
-func (m *MockConfigRequirementRelationshipRepository) GetByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
+func (m *MockConfigRequirementRelationshipRepository) ListByRequirements(ctx context.Context, requirementIDs []uuid.UUID) ([]models.RequirementRelationship, error) {
+	return nil, nil
+}
+func (m *MockConfigRequirementRelationshipRepository) ListGraphNodes(ctx context.Context, filters map[string]interface{}) ([]repository.RequirementGraphNode, error) {
+	return nil, nil
+}
+func (m *MockConfigRequirementRelationshipRepository) GetByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
 	return nil, 0, nil
 }
 
 func TestConfigService_CreateRequirementType(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementTypeRepo := &MockConfigRequirementTypeRepository{}
 	mockRelationshipTypeRepo := &MockConfigRelationshipTypeRepository{}
 	mockRequirementRepo := &MockConfigRequirementRepository{}
@@ -328,7 +361,7 @@ func TestConfigService_CreateRequirementType(t *testing.T) {
 		mockRequirementTypeRepo.On("ExistsByName", "Test Type").Return(false, nil)
 		mockRequirementTypeRepo.On("Create", mock.AnythingOfType("*models.RequirementType")).Return(nil)
 
-		result, err := service.CreateRequirementType(req)
+		result, err := service.CreateRequirementType(ctx, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -344,7 +377,7 @@ func TestConfigService_CreateRequirementType(t *testing.T) {
 
 		mockRequirementTypeRepo.On("ExistsByName", "Existing Type").Return(true, nil)
 
-		result, err := service.CreateRequirementType(req)
+		result, err := service.CreateRequirementType(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -354,6 +387,7 @@ func TestConfigService_CreateRequirementType(t *testing.T) {
 }
 
 func TestConfigService_GetRequirementTypeByID(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementTypeRepo := &MockConfigRequirementTypeRepository{}
 	mockRelationshipTypeRepo := &MockConfigRelationshipTypeRepository{}
 	mockRequirementRepo := &MockConfigRequirementRepository{}
@@ -378,7 +412,7 @@ func TestConfigService_GetRequirementTypeByID(t *testing.T) {
 
 		mockRequirementTypeRepo.On("GetByID", id).Return(expectedType, nil)
 
-		result, err := service.GetRequirementTypeByID(id)
+		result, err := service.GetRequirementTypeByID(ctx, id)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -392,7 +426,7 @@ func TestConfigService_GetRequirementTypeByID(t *testing.T) {
 
 		mockRequirementTypeRepo.On("GetByID", id).Return((*models.RequirementType)(nil), repository.ErrNotFound)
 
-		result, err := service.GetRequirementTypeByID(id)
+		result, err := service.GetRequirementTypeByID(ctx, id)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -402,6 +436,7 @@ func TestConfigService_GetRequirementTypeByID(t *testing.T) {
 }
 
 func TestConfigService_DeleteRequirementType(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementTypeRepo := &MockConfigRequirementTypeRepository{}
 	mockRelationshipTypeRepo := &MockConfigRelationshipTypeRepository{}
 	mockRequirementRepo := &MockConfigRequirementRepository{}
@@ -428,7 +463,7 @@ func TestConfigService_DeleteRequirementType(t *testing.T) {
 		mockRequirementRepo.On("GetByType", id).Return([]models.Requirement{}, nil)
 		mockRequirementTypeRepo.On("Delete", id).Return(nil)
 
-		err := service.DeleteRequirementType(id, false)
+		err := service.DeleteRequirementType(ctx, id, false)
 
 		assert.NoError(t, err)
 		mockRequirementTypeRepo.AssertExpectations(t)
@@ -448,7 +483,7 @@ func TestConfigService_DeleteRequirementType(t *testing.T) {
 		mockRequirementTypeRepo.On("GetByID", id).Return(requirementType, nil)
 		mockRequirementRepo.On("GetByType", id).Return(requirements, nil)
 
-		err := service.DeleteRequirementType(id, false)
+		err := service.DeleteRequirementType(ctx, id, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrRequirementTypeHasRequirements, err)
@@ -461,7 +496,7 @@ func TestConfigService_DeleteRequirementType(t *testing.T) {
 
 		mockRequirementTypeRepo.On("GetByID", id).Return((*models.RequirementType)(nil), repository.ErrNotFound)
 
-		err := service.DeleteRequirementType(id, false)
+		err := service.DeleteRequirementType(ctx, id, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrRequirementTypeNotFound, err)
@@ -470,6 +505,7 @@ func TestConfigService_DeleteRequirementType(t *testing.T) {
 }
 
 func TestConfigService_ValidateRequirementType(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementTypeRepo := &MockConfigRequirementTypeRepository{}
 	mockRelationshipTypeRepo := &MockConfigRelationshipTypeRepository{}
 	mockRequirementRepo := &MockConfigRequirementRepository{}
@@ -490,7 +526,7 @@ func TestConfigService_ValidateRequirementType(t *testing.T) {
 
 		mockRequirementTypeRepo.On("Exists", id).Return(true, nil)
 
-		err := service.ValidateRequirementType(id)
+		err := service.ValidateRequirementType(ctx, id)
 
 		assert.NoError(t, err)
 		mockRequirementTypeRepo.AssertExpectations(t)
@@ -501,7 +537,7 @@ func TestConfigService_ValidateRequirementType(t *testing.T) {
 
 		mockRequirementTypeRepo.On("Exists", id).Return(false, nil)
 
-		err := service.ValidateRequirementType(id)
+		err := service.ValidateRequirementType(ctx, id)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrRequirementTypeNotFound, err)
@@ -510,6 +546,7 @@ func TestConfigService_ValidateRequirementType(t *testing.T) {
 }
 
 func TestConfigService_ValidateRelationshipType(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementTypeRepo := &MockConfigRequirementTypeRepository{}
 	mockRelationshipTypeRepo := &MockConfigRelationshipTypeRepository{}
 	mockRequirementRepo := &MockConfigRequirementRepository{}
@@ -530,7 +567,7 @@ func TestConfigService_ValidateRelationshipType(t *testing.T) {
 
 		mockRelationshipTypeRepo.On("Exists", id).Return(true, nil)
 
-		err := service.ValidateRelationshipType(id)
+		err := service.ValidateRelationshipType(ctx, id)
 
 		assert.NoError(t, err)
 		mockRelationshipTypeRepo.AssertExpectations(t)
@@ -541,7 +578,7 @@ func TestConfigService_ValidateRelationshipType(t *testing.T) {
 
 		mockRelationshipTypeRepo.On("Exists", id).Return(false, nil)
 
-		err := service.ValidateRelationshipType(id)
+		err := service.ValidateRelationshipType(ctx, id)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrRelationshipTypeNotFound, err)
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Coverage warning codes
+const (
+	CoverageWarningDependsOnObsolete = "DEPENDS_ON_OBSOLETE"
+)
+
+// CoverageService defines the interface for epic coverage reports
+type CoverageService interface {
+	GetEpicCoverage(ctx context.Context, epicIDOrRef string) (*CoverageReport, error)
+}
+
+// CoverageSummary counts the entities of an epic and how many of them are covered.
+// Obsolete requirements are excluded from the requirement counts.
+// @Description Entity counts of a coverage report
+type CoverageSummary struct {
+	UserStories                        int `json:"user_stories" example:"5"`
+	UserStoriesWithAcceptanceCriteria  int `json:"user_stories_with_acceptance_criteria" example:"4"`
+	AcceptanceCriteria                 int `json:"acceptance_criteria" example:"12"`
+	AcceptanceCriteriaWithRequirements int `json:"acceptance_criteria_with_requirements" example:"9"`
+	Requirements                       int `json:"requirements" example:"20"`
+	RequirementsWithAcceptanceCriteria int `json:"requirements_with_acceptance_criteria" example:"15"`
+	ObsoleteRequirements               int `json:"obsolete_requirements" example:"2"`
+	// RequirementsCoveringSeveralAcceptanceCriteria counts the requirements linked to more than one acceptance criteria
+	RequirementsCoveringSeveralAcceptanceCriteria int `json:"requirements_covering_several_acceptance_criteria" example:"3"`
+}
+
+// CoverageWarning is a problem found by a coverage report
+// @Description Coverage warning, such as an entity that still depends on an obsolete requirement
+type CoverageWarning struct {
+	Code     string       `json:"code" example:"DEPENDS_ON_OBSOLETE"`
+	Message  string       `json:"message" example:"US-002 depends on obsolete requirement REQ-004, superseded by REQ-009"`
+	Entity   LinkedEntity `json:"entity"`
+	Obsolete LinkedEntity `json:"obsolete"`
+	// SupersededBy is the requirement in effect at the end of the obsolete requirement's supersession chain
+	SupersededBy *LinkedEntity `json:"superseded_by,omitempty"`
+}
+
+// CoverageReport lists the gaps in an epic's acceptance criteria and requirements
+// @Description Coverage of an epic: uncovered user stories, acceptance criteria and requirements, and warnings
+type CoverageReport struct {
+	Epic                                  LinkedEntity      `json:"epic"`
+	Summary                               CoverageSummary   `json:"summary"`
+	UserStoriesWithoutAcceptanceCriteria  []LinkedEntity    `json:"user_stories_without_acceptance_criteria"`
+	UserStoriesWithoutRequirements        []LinkedEntity    `json:"user_stories_without_requirements"`
+	AcceptanceCriteriaWithoutRequirements []LinkedEntity    `json:"acceptance_criteria_without_requirements"`
+	RequirementsWithoutAcceptanceCriteria []LinkedEntity    `json:"requirements_without_acceptance_criteria"`
+	Warnings                              []CoverageWarning `json:"warnings"`
+}
+
+// coverageService implements CoverageService interface
+type coverageService struct {
+	repos        *repository.Repositories
+	supersession *supersessionService
+}
+
+// NewCoverageService creates a new coverage service instance
+func NewCoverageService(repos *repository.Repositories) CoverageService {
+	return &coverageService{
+		repos:        repos,
+		supersession: &supersessionService{repos: repos},
+	}
+}
+
+// GetEpicCoverage reports the user stories without acceptance criteria or requirements, the acceptance
+// criteria without requirements and the requirements without acceptance criteria of an epic. Entities
+// that still depend on obsolete requirements are reported as warnings.
+func (s *coverageService) GetEpicCoverage(ctx context.Context, epicIDOrRef string) (*CoverageReport, error) {
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, err
+	}
+
+	report := &CoverageReport{
+		UserStoriesWithoutAcceptanceCriteria:  []LinkedEntity{},
+		UserStoriesWithoutRequirements:        []LinkedEntity{},
+		AcceptanceCriteriaWithoutRequirements: []LinkedEntity{},
+		RequirementsWithoutAcceptanceCriteria: []LinkedEntity{},
+		Warnings:                              []CoverageWarning{},
+	}
+	if report.Epic, err = s.linkedEntity(ctx, models.EntityTypeEpic, epicID); err != nil {
+		return nil, err
+	}
+
+	userStories, err := s.repos.Coverage.ListUserStoryCoverage(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user story coverage: %w", err)
+	}
+	for _, item := range userStories {
+		report.Summary.UserStories++
+		if item.AcceptanceCriteriaCount > 0 {
+			report.Summary.UserStoriesWithAcceptanceCriteria++
+		} else {
+			report.UserStoriesWithoutAcceptanceCriteria = append(report.UserStoriesWithoutAcceptanceCriteria,
+				coverageEntity(models.EntityTypeUserStory, item))
+		}
+		if item.RequirementCount == 0 {
+			report.UserStoriesWithoutRequirements = append(report.UserStoriesWithoutRequirements,
+				coverageEntity(models.EntityTypeUserStory, item))
+		}
+	}
+
+	acceptanceCriteria, err := s.repos.Coverage.ListAcceptanceCriteriaCoverage(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acceptance criteria coverage: %w", err)
+	}
+	for _, item := range acceptanceCriteria {
+		report.Summary.AcceptanceCriteria++
+		if item.RequirementCount > 0 {
+			report.Summary.AcceptanceCriteriaWithRequirements++
+		} else {
+			report.AcceptanceCriteriaWithoutRequirements = append(report.AcceptanceCriteriaWithoutRequirements,
+				coverageEntity(models.EntityTypeAcceptanceCriteria, item))
+		}
+	}
+
+	requirements, err := s.repos.Coverage.ListRequirementCoverage(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirement coverage: %w", err)
+	}
+	for _, item := range requirements {
+		if item.Status == string(models.RequirementStatusObsolete) {
+			report.Summary.ObsoleteRequirements++
+			continue
+		}
+		report.Summary.Requirements++
+		if item.AcceptanceCriteriaCount > 1 {
+			report.Summary.RequirementsCoveringSeveralAcceptanceCriteria++
+		}
+		if item.AcceptanceCriteriaCount > 0 {
+			report.Summary.RequirementsWithAcceptanceCriteria++
+		} else {
+			report.RequirementsWithoutAcceptanceCriteria = append(report.RequirementsWithoutAcceptanceCriteria,
+				coverageEntity(models.EntityTypeRequirement, item))
+		}
+	}
+
+	if report.Warnings, err = s.obsoleteDependencyWarnings(ctx, epicID); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// obsoleteDependencyWarnings builds a warning for every entity of an epic that depends on an obsolete requirement
+func (s *coverageService) obsoleteDependencyWarnings(ctx context.Context, epicID uuid.UUID) ([]CoverageWarning, error) {
+	dependencies, err := s.repos.Coverage.ListObsoleteDependencies(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list obsolete dependencies: %w", err)
+	}
+
+	warnings := make([]CoverageWarning, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		warning := CoverageWarning{Code: CoverageWarningDependsOnObsolete}
+		if warning.Entity, err = s.linkedEntity(ctx, dependency.SourceType, dependency.SourceID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if warning.Obsolete, err = s.linkedEntity(ctx, models.EntityTypeRequirement, dependency.TargetID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		var message strings.Builder
+		fmt.Fprintf(&message, "%s depends on obsolete requirement %s", warning.Entity.ReferenceID, warning.Obsolete.ReferenceID)
+		if dependency.TargetSupersededByID != nil {
+			successors, err := s.supersession.successors(ctx, &models.Requirement{
+				ID:             dependency.TargetID,
+				SupersededByID: dependency.TargetSupersededByID,
+			})
+			if err != nil && !errors.Is(err, errSupersessionChainBroken) {
+				return nil, err
+			}
+			if len(successors) > 0 {
+				current := successors[len(successors)-1]
+				warning.SupersededBy = &LinkedEntity{
+					EntityType:  models.EntityTypeRequirement,
+					ID:          current.ID,
+					ReferenceID: current.ReferenceID,
+					Title:       current.Title,
+				}
+				fmt.Fprintf(&message, ", superseded by %s", current.ReferenceID)
+			}
+		}
+		warning.Message = message.String()
+		warnings = append(warnings, warning)
+	}
+	return warnings, nil
+}
+
+// linkedEntity loads the reference ID and title of an entity
+func (s *coverageService) linkedEntity(ctx context.Context, entityType models.EntityType, id uuid.UUID) (LinkedEntity, error) {
+	text, err := loadEntityText(ctx, s.repos, entityType, id)
+	if err != nil {
+		return LinkedEntity{}, err
+	}
+	return LinkedEntity{
+		EntityType:  entityType,
+		ID:          id,
+		ReferenceID: text.ReferenceID,
+		Title:       text.displayTitle(),
+	}, nil
+}
+
+// coverageEntity converts a coverage item to a linked entity
+func coverageEntity(entityType models.EntityType, item repository.CoverageItem) LinkedEntity {
+	text := entityText{ReferenceID: item.ReferenceID, Description: item.Title}
+	if entityType != models.EntityTypeAcceptanceCriteria {
+		text.Title = item.Title
+	}
+	return LinkedEntity{
+		EntityType:  entityType,
+		ID:          item.ID,
+		ReferenceID: item.ReferenceID,
+		Title:       text.displayTitle(),
+	}
+}
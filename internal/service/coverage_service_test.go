@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestCoverageService(t *testing.T) {
+	ctx := context.Background()
+	obsolete, active := models.RequirementStatusObsolete, models.RequirementStatusActive
+	db, user, epic, requirements := setupSupersessionTest(t, active, obsolete, active)
+	repos := repository.NewRepositories(db, nil)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	bareStory := &models.UserStory{ID: uuid.New(), ReferenceID: "US-002", EpicID: epic.ID, Title: "Sign in", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(bareStory).Error)
+	criteria := []models.AcceptanceCriteria{
+		{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: requirements[0].UserStoryID, AuthorID: user.ID, Description: "WHEN a password is shorter than 8 characters THEN the system SHALL reject it"},
+		{ID: uuid.New(), ReferenceID: "AC-002", UserStoryID: requirements[0].UserStoryID, AuthorID: user.ID, Description: "WHEN a password is reused THEN the system SHALL reject it"},
+	}
+	for i := range criteria {
+		require.NoError(t, session.Create(&criteria[i]).Error)
+	}
+	require.NoError(t, db.Model(&requirements[0]).Update("acceptance_criteria_id", criteria[0].ID).Error)
+
+	dependsOn, err := repos.RelationshipType.GetByName(ctx, "depends_on")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&models.RequirementRelationship{
+		SourceRequirementID: requirements[0].ID,
+		TargetRequirementID: requirements[1].ID,
+		RelationshipTypeID:  dependsOn.ID,
+		CreatedBy:           user.ID,
+	}).Error)
+	require.NoError(t, db.Create(&models.EntityRelationship{
+		SourceType:         models.EntityTypeUserStory,
+		SourceID:           bareStory.ID,
+		TargetType:         models.EntityTypeRequirement,
+		TargetID:           requirements[1].ID,
+		RelationshipTypeID: dependsOn.ID,
+		CreatedBy:          user.ID,
+	}).Error)
+
+	_, err = NewSupersessionService(repos).SetSupersededBy(ctx, "REQ-002", stringPtr("REQ-003"))
+	require.NoError(t, err)
+
+	svc := NewCoverageService(repos)
+	report, err := svc.GetEpicCoverage(ctx, "EP-001")
+	require.NoError(t, err)
+
+	assert.Equal(t, "EP-001", report.Epic.ReferenceID)
+	assert.Equal(t, CoverageSummary{
+		UserStories:                        2,
+		UserStoriesWithAcceptanceCriteria:  1,
+		AcceptanceCriteria:                 2,
+		AcceptanceCriteriaWithRequirements: 1,
+		Requirements:                       2,
+		RequirementsWithAcceptanceCriteria: 1,
+		ObsoleteRequirements:               1,
+	}, report.Summary)
+
+	require.Len(t, report.UserStoriesWithoutAcceptanceCriteria, 1)
+	assert.Equal(t, "US-002", report.UserStoriesWithoutAcceptanceCriteria[0].ReferenceID)
+	require.Len(t, report.UserStoriesWithoutRequirements, 1)
+	assert.Equal(t, "US-002", report.UserStoriesWithoutRequirements[0].ReferenceID)
+	require.Len(t, report.AcceptanceCriteriaWithoutRequirements, 1)
+	assert.Equal(t, criteria[1].Description, report.AcceptanceCriteriaWithoutRequirements[0].Title)
+	require.Len(t, report.RequirementsWithoutAcceptanceCriteria, 1)
+	assert.Equal(t, "REQ-003", report.RequirementsWithoutAcceptanceCriteria[0].ReferenceID)
+
+	require.Len(t, report.Warnings, 2)
+	for _, warning := range report.Warnings {
+		assert.Equal(t, CoverageWarningDependsOnObsolete, warning.Code)
+		assert.Equal(t, "REQ-002", warning.Obsolete.ReferenceID)
+		require.NotNil(t, warning.SupersededBy)
+		assert.Equal(t, "REQ-003", warning.SupersededBy.ReferenceID)
+	}
+	assert.Equal(t, "REQ-001 depends on obsolete requirement REQ-002, superseded by REQ-003", report.Warnings[0].Message)
+	assert.Equal(t, "US-002", report.Warnings[1].Entity.ReferenceID)
+
+	_, err = svc.GetEpicCoverage(ctx, "EP-404")
+	assert.ErrorIs(t, err, ErrEpicNotFound)
+}
+
+func TestCoverageService_RequirementCoveringSeveralAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
+	db, user, _, requirements := setupSupersessionTest(t, models.RequirementStatusActive)
+	repos := repository.NewRepositories(db, nil)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	criteria := []models.AcceptanceCriteria{
+		{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: requirements[0].UserStoryID, AuthorID: user.ID, Description: "WHEN a password is shorter than 8 characters THEN the system SHALL reject it"},
+		{ID: uuid.New(), ReferenceID: "AC-002", UserStoryID: requirements[0].UserStoryID, AuthorID: user.ID, Description: "WHEN a password is reused THEN the system SHALL reject it"},
+	}
+	for i := range criteria {
+		require.NoError(t, session.Create(&criteria[i]).Error)
+		require.NoError(t, repos.Requirement.LinkAcceptanceCriteria(ctx, requirements[0].ID, criteria[i].ID))
+	}
+
+	report, err := NewCoverageService(repos).GetEpicCoverage(ctx, "EP-001")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.Summary.AcceptanceCriteriaWithRequirements)
+	assert.Equal(t, 1, report.Summary.RequirementsWithAcceptanceCriteria)
+	assert.Equal(t, 1, report.Summary.RequirementsCoveringSeveralAcceptanceCriteria)
+	assert.Empty(t, report.AcceptanceCriteriaWithoutRequirements)
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronSchedule_Next(t *testing.T) {
+	// Wednesday
+	from := time.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		expected   time.Time
+	}{
+		{"every minute", "* * * * *", time.Date(2024, time.January, 10, 9, 31, 0, 0, time.UTC)},
+		{"every 15 minutes", "*/15 * * * *", time.Date(2024, time.January, 10, 9, 45, 0, 0, time.UTC)},
+		{"daily macro", "@daily", time.Date(2024, time.January, 11, 0, 0, 0, 0, time.UTC)},
+		{"mondays at 8", "0 8 * * 1", time.Date(2024, time.January, 15, 8, 0, 0, 0, time.UTC)},
+		{"sunday written as 7", "0 8 * * 7", time.Date(2024, time.January, 14, 8, 0, 0, 0, time.UTC)},
+		{"weekday range later today", "0 10-18/4 * * 1-5", time.Date(2024, time.January, 10, 10, 0, 0, 0, time.UTC)},
+		{"first of the month", "30 6 1 * *", time.Date(2024, time.February, 1, 6, 30, 0, 0, time.UTC)},
+		{"day of month or weekday", "0 0 20 * 5", time.Date(2024, time.January, 12, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"list", "5,50 9 * * *", time.Date(2024, time.January, 10, 9, 50, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCronExpression(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(from))
+		})
+	}
+
+	t.Run("never matching expressions end the search", func(t *testing.T) {
+		schedule, err := ParseCronExpression("0 0 31 2 *")
+		require.NoError(t, err)
+		assert.True(t, schedule.Next(from).IsZero())
+	})
+
+	t.Run("evaluates in the location of the given time", func(t *testing.T) {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		if err != nil {
+			t.Skip("time zone database not available")
+		}
+		schedule, err := ParseCronExpression("0 8 * * *")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, time.January, 11, 7, 0, 0, 0, time.UTC), schedule.Next(from.In(berlin)).UTC())
+	})
+}
+
+func TestParseCronExpression_Invalid(t *testing.T) {
+	for _, expression := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "*/0 * * * *", "5-1 * * * *", "a * * * *", "@yearly"} {
+		_, err := ParseCronExpression(expression)
+		assert.Error(t, err, expression)
+	}
+}
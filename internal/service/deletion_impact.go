@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Actions applied to the entities of a deletion impact tree
+const (
+	DeletionActionDelete = "delete" // The entity is removed together with its comments and links
+	DeletionActionUnlink = "unlink" // The entity is kept but loses its link to the deleted entity
+)
+
+// Kinds of links removed by a deletion
+const (
+	impactRequirementRelationship = "requirement_relationship"
+	impactEntityRelationship      = "entity_relationship"
+)
+
+// maxImpactTitleLength limits the titles derived from acceptance criteria descriptions
+const maxImpactTitleLength = 50
+
+// DeletionImpact is the full impact tree of deleting an entity
+// @Description Everything removed or unlinked by deleting an entity, in the same schema for all entity types
+type DeletionImpact struct {
+	Root                 DeletionImpactNode   `json:"root"`
+	Totals               DeletionImpactTotals `json:"totals"`
+	CascadeDepth         int                  `json:"cascade_depth" example:"2"` // Levels of deleted descendants below the entity
+	RequiresConfirmation bool                 `json:"requires_confirmation"`     // True when the deletion reaches beyond the entity itself
+}
+
+// DeletionImpactNode is an entity in a deletion impact tree
+type DeletionImpactNode struct {
+	EntityType    models.EntityType            `json:"entity_type" example:"user_story"`
+	EntityID      uuid.UUID                    `json:"entity_id"`
+	ReferenceID   string                       `json:"reference_id" example:"US-001"`
+	Title         string                       `json:"title"`
+	Action        string                       `json:"action" example:"delete"` // delete or unlink
+	Depth         int                          `json:"depth" example:"1"`       // 0 for the entity being deleted
+	Comments      int                          `json:"comments"`                // Comments removed with the entity
+	Relationships []DeletionImpactRelationship `json:"relationships,omitempty"` // Links removed with the entity
+	Children      []DeletionImpactNode         `json:"children,omitempty"`
+}
+
+// DeletionImpactRelationship is a link removed by a deletion
+// A link between two deleted entities is listed once, under the entity reached first
+type DeletionImpactRelationship struct {
+	ID                 uuid.UUID         `json:"id"`
+	Kind               string            `json:"kind" example:"entity_relationship"` // requirement_relationship or entity_relationship
+	RelationshipTypeID uuid.UUID         `json:"relationship_type_id"`
+	Direction          string            `json:"direction" example:"outgoing"` // outgoing or incoming, seen from the deleted entity
+	OtherEntityType    models.EntityType `json:"other_entity_type" example:"requirement"`
+	OtherEntityID      uuid.UUID         `json:"other_entity_id"`
+	OtherReferenceID   string            `json:"other_reference_id" example:"REQ-002"`
+}
+
+// DeletionImpactTotals counts the records affected by a deletion
+type DeletionImpactTotals struct {
+	Epics                int `json:"epics"`
+	UserStories          int `json:"user_stories"`
+	AcceptanceCriteria   int `json:"acceptance_criteria"`
+	Requirements         int `json:"requirements"`
+	Comments             int `json:"comments"`
+	Relationships        int `json:"relationships"`
+	UnlinkedRequirements int `json:"unlinked_requirements"` // Requirements kept without their acceptance criteria
+	Deleted              int `json:"deleted"`               // All records removed, including the entity itself
+}
+
+// deletionImpactBuilder walks the hierarchy below an entity in the order the deletion cascades
+type deletionImpactBuilder struct {
+	s                 *deletionService
+	impact            *DeletionImpact
+	seenRelationships map[uuid.UUID]bool
+}
+
+// GetDeletionImpact returns everything that deleting the entity would remove or unlink
+func (s *deletionService) GetDeletionImpact(ctx context.Context, entityType models.EntityType, idOrReference string) (*DeletionImpact, error) {
+	b := &deletionImpactBuilder{s: s, impact: &DeletionImpact{}, seenRelationships: make(map[uuid.UUID]bool)}
+
+	var (
+		root DeletionImpactNode
+		err  error
+	)
+	switch entityType {
+	case models.EntityTypeEpic:
+		var epic *models.Epic
+		if epic, err = lookupForImpact[models.Epic](ctx, s.epicRepo, idOrReference, ErrEpicNotFound); err == nil {
+			root, err = b.epicNode(ctx, epic, 0)
+		}
+	case models.EntityTypeUserStory:
+		var userStory *models.UserStory
+		if userStory, err = lookupForImpact[models.UserStory](ctx, s.userStoryRepo, idOrReference, ErrUserStoryNotFound); err == nil {
+			root, err = b.userStoryNode(ctx, userStory, 0)
+		}
+	case models.EntityTypeAcceptanceCriteria:
+		var acceptanceCriteria *models.AcceptanceCriteria
+		if acceptanceCriteria, err = lookupForImpact[models.AcceptanceCriteria](ctx, s.acceptanceCriteriaRepo, idOrReference, ErrAcceptanceCriteriaNotFound); err == nil {
+			root, err = b.acceptanceCriteriaNode(ctx, acceptanceCriteria, 0, true)
+		}
+	case models.EntityTypeRequirement:
+		var requirement *models.Requirement
+		if requirement, err = lookupForImpact[models.Requirement](ctx, s.requirementRepo, idOrReference, ErrRequirementNotFound); err == nil {
+			root, err = b.requirementNode(ctx, requirement, 0)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEntityType, entityType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	impact := b.impact
+	impact.Root = root
+	impact.RequiresConfirmation = impact.Totals.Deleted > 1 || impact.Totals.UnlinkedRequirements > 0
+	return impact, nil
+}
+
+// lookupForImpact gets an entity by UUID or reference ID
+func lookupForImpact[T any](ctx context.Context, repo repository.Repository[T], idOrReference string, notFound error) (*T, error) {
+	var (
+		entity *T
+		err    error
+	)
+	if id, parseErr := uuid.Parse(idOrReference); parseErr == nil {
+		entity, err = repo.GetByID(ctx, id)
+	} else {
+		entity, err = repo.GetByReferenceID(ctx, idOrReference)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, notFound
+		}
+		return nil, fmt.Errorf("failed to get entity: %w", err)
+	}
+	return entity, nil
+}
+
+func (b *deletionImpactBuilder) epicNode(ctx context.Context, epic *models.Epic, depth int) (DeletionImpactNode, error) {
+	node, err := b.deleteNode(ctx, models.EntityTypeEpic, epic.ID, epic.ReferenceID, epic.Title, depth)
+	if err != nil {
+		return node, err
+	}
+
+	userStories, err := b.s.userStoryRepo.GetByEpic(ctx, epic.ID)
+	if err != nil {
+		return node, fmt.Errorf("failed to get user stories for epic %s: %w", epic.ReferenceID, err)
+	}
+	for i := range userStories {
+		child, err := b.userStoryNode(ctx, &userStories[i], depth+1)
+		if err != nil {
+			return node, err
+		}
+		node.Children = append(node.Children, child)
+	}
+	return node, nil
+}
+
+func (b *deletionImpactBuilder) userStoryNode(ctx context.Context, userStory *models.UserStory, depth int) (DeletionImpactNode, error) {
+	node, err := b.deleteNode(ctx, models.EntityTypeUserStory, userStory.ID, userStory.ReferenceID, userStory.Title, depth)
+	if err != nil {
+		return node, err
+	}
+
+	acceptanceCriteria, err := b.s.acceptanceCriteriaRepo.GetByUserStory(ctx, userStory.ID)
+	if err != nil {
+		return node, fmt.Errorf("failed to get acceptance criteria for user story %s: %w", userStory.ReferenceID, err)
+	}
+	for i := range acceptanceCriteria {
+		// Linked requirements belong to the same user story and are deleted with it
+		child, err := b.acceptanceCriteriaNode(ctx, &acceptanceCriteria[i], depth+1, false)
+		if err != nil {
+			return node, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	requirements, err := b.s.requirementRepo.GetByUserStory(ctx, userStory.ID)
+	if err != nil {
+		return node, fmt.Errorf("failed to get requirements for user story %s: %w", userStory.ReferenceID, err)
+	}
+	for i := range requirements {
+		child, err := b.requirementNode(ctx, &requirements[i], depth+1)
+		if err != nil {
+			return node, err
+		}
+		node.Children = append(node.Children, child)
+	}
+	return node, nil
+}
+
+func (b *deletionImpactBuilder) acceptanceCriteriaNode(ctx context.Context, acceptanceCriteria *models.AcceptanceCriteria, depth int, unlinkRequirements bool) (DeletionImpactNode, error) {
+	node, err := b.deleteNode(ctx, models.EntityTypeAcceptanceCriteria, acceptanceCriteria.ID, acceptanceCriteria.ReferenceID,
+		impactTitle(acceptanceCriteria.Description), depth)
+	if err != nil || !unlinkRequirements {
+		return node, err
+	}
+
+	requirements, err := b.s.requirementRepo.GetByAcceptanceCriteria(ctx, acceptanceCriteria.ID)
+	if err != nil {
+		return node, fmt.Errorf("failed to get requirements for acceptance criteria %s: %w", acceptanceCriteria.ReferenceID, err)
+	}
+	for _, requirement := range requirements {
+		node.Children = append(node.Children, DeletionImpactNode{
+			EntityType:  models.EntityTypeRequirement,
+			EntityID:    requirement.ID,
+			ReferenceID: requirement.ReferenceID,
+			Title:       requirement.Title,
+			Action:      DeletionActionUnlink,
+			Depth:       depth + 1,
+		})
+		b.impact.Totals.UnlinkedRequirements++
+	}
+	return node, nil
+}
+
+func (b *deletionImpactBuilder) requirementNode(ctx context.Context, requirement *models.Requirement, depth int) (DeletionImpactNode, error) {
+	node, err := b.deleteNode(ctx, models.EntityTypeRequirement, requirement.ID, requirement.ReferenceID, requirement.Title, depth)
+	if err != nil {
+		return node, err
+	}
+
+	relationships, err := b.s.requirementRelationshipRepo.GetByRequirement(ctx, requirement.ID)
+	if err != nil {
+		return node, fmt.Errorf("failed to get relationships for requirement %s: %w", requirement.ReferenceID, err)
+	}
+	for _, rel := range relationships {
+		if b.seenRelationships[rel.ID] {
+			continue
+		}
+		b.seenRelationships[rel.ID] = true
+
+		direction, otherID := "outgoing", rel.TargetRequirementID
+		if rel.TargetRequirementID == requirement.ID {
+			direction, otherID = "incoming", rel.SourceRequirementID
+		}
+		node.Relationships = append(node.Relationships, DeletionImpactRelationship{
+			ID:                 rel.ID,
+			Kind:               impactRequirementRelationship,
+			RelationshipTypeID: rel.RelationshipTypeID,
+			Direction:          direction,
+			OtherEntityType:    models.EntityTypeRequirement,
+			OtherEntityID:      otherID,
+			OtherReferenceID:   b.referenceOf(ctx, models.EntityTypeRequirement, otherID),
+		})
+		b.addDeleted(&b.impact.Totals.Relationships, 1)
+	}
+	return node, nil
+}
+
+// deleteNode builds the node of a deleted entity with its comments and typed links, and counts it
+func (b *deletionImpactBuilder) deleteNode(ctx context.Context, entityType models.EntityType, id uuid.UUID, referenceID, title string, depth int) (DeletionImpactNode, error) {
+	node := DeletionImpactNode{
+		EntityType:  entityType,
+		EntityID:    id,
+		ReferenceID: referenceID,
+		Title:       title,
+		Action:      DeletionActionDelete,
+		Depth:       depth,
+	}
+
+	switch entityType {
+	case models.EntityTypeEpic:
+		b.addDeleted(&b.impact.Totals.Epics, 1)
+	case models.EntityTypeUserStory:
+		b.addDeleted(&b.impact.Totals.UserStories, 1)
+	case models.EntityTypeAcceptanceCriteria:
+		b.addDeleted(&b.impact.Totals.AcceptanceCriteria, 1)
+	case models.EntityTypeRequirement:
+		b.addDeleted(&b.impact.Totals.Requirements, 1)
+	}
+	if depth > b.impact.CascadeDepth {
+		b.impact.CascadeDepth = depth
+	}
+
+	comments, err := b.s.commentRepo.GetByEntity(ctx, entityType, id)
+	if err != nil {
+		return node, fmt.Errorf("failed to get comments for %s %s: %w", entityType, referenceID, err)
+	}
+	node.Comments = len(comments)
+	b.addDeleted(&b.impact.Totals.Comments, len(comments))
+
+	relationships, err := b.s.entityRelationshipRepo.ListByEntity(ctx, entityType, id)
+	if err != nil {
+		return node, fmt.Errorf("failed to get relationships for %s %s: %w", entityType, referenceID, err)
+	}
+	for _, rel := range relationships {
+		if b.seenRelationships[rel.ID] {
+			continue
+		}
+		b.seenRelationships[rel.ID] = true
+
+		direction, otherType, otherID := "outgoing", rel.TargetType, rel.TargetID
+		if rel.TargetType == entityType && rel.TargetID == id {
+			direction, otherType, otherID = "incoming", rel.SourceType, rel.SourceID
+		}
+		node.Relationships = append(node.Relationships, DeletionImpactRelationship{
+			ID:                 rel.ID,
+			Kind:               impactEntityRelationship,
+			RelationshipTypeID: rel.RelationshipTypeID,
+			Direction:          direction,
+			OtherEntityType:    otherType,
+			OtherEntityID:      otherID,
+			OtherReferenceID:   b.referenceOf(ctx, otherType, otherID),
+		})
+		b.addDeleted(&b.impact.Totals.Relationships, 1)
+	}
+
+	return node, nil
+}
+
+func (b *deletionImpactBuilder) addDeleted(total *int, n int) {
+	*total += n
+	b.impact.Totals.Deleted += n
+}
+
+// referenceOf returns the reference ID of the other entity of a link, or an empty string when it cannot be loaded
+func (b *deletionImpactBuilder) referenceOf(ctx context.Context, entityType models.EntityType, id uuid.UUID) string {
+	switch entityType {
+	case models.EntityTypeEpic:
+		if epic, err := b.s.epicRepo.GetByID(ctx, id); err == nil {
+			return epic.ReferenceID
+		}
+	case models.EntityTypeUserStory:
+		if userStory, err := b.s.userStoryRepo.GetByID(ctx, id); err == nil {
+			return userStory.ReferenceID
+		}
+	case models.EntityTypeAcceptanceCriteria:
+		if acceptanceCriteria, err := b.s.acceptanceCriteriaRepo.GetByID(ctx, id); err == nil {
+			return acceptanceCriteria.ReferenceID
+		}
+	case models.EntityTypeRequirement:
+		if requirement, err := b.s.requirementRepo.GetByID(ctx, id); err == nil {
+			return requirement.ReferenceID
+		}
+	}
+	return ""
+}
+
+// impactTitle shortens an acceptance criteria description to a title
+func impactTitle(description string) string {
+	if utf8.RuneCountInString(description) <= maxImpactTitleLength {
+		return description
+	}
+	return string([]rune(description)[:maxImpactTitleLength]) + "…"
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestDeletionService_GetDeletionImpact(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.RequirementAcceptanceCriteria{}, &models.RelationshipType{}, &models.RequirementRelationship{}, &models.EntityRelationship{}, &models.Comment{}))
+	for _, relationshipType := range models.GetDefaultRelationshipTypes() {
+		require.NoError(t, db.Create(&relationshipType).Error)
+	}
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epics := []models.Epic{
+		{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout"},
+		{ID: uuid.New(), ReferenceID: "EP-002", Title: "Invoicing"},
+	}
+	for i := range epics {
+		epics[i].Status, epics[i].CreatorID, epics[i].AssigneeID = models.EpicStatusBacklog, user.ID, user.ID
+		require.NoError(t, session.Create(&epics[i]).Error)
+	}
+	stories := []models.UserStory{
+		{ID: uuid.New(), ReferenceID: "US-001", EpicID: epics[0].ID, Title: "Pay by card"},
+		{ID: uuid.New(), ReferenceID: "US-002", EpicID: epics[1].ID, Title: "Send invoices"},
+	}
+	for i := range stories {
+		stories[i].Status, stories[i].CreatorID, stories[i].AssigneeID = models.UserStoryStatusBacklog, user.ID, user.ID
+		require.NoError(t, session.Create(&stories[i]).Error)
+	}
+	criteria := models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: stories[0].ID, AuthorID: user.ID,
+		Description: "WHEN the card is declined THEN the system SHALL show the reason given by the payment provider"}
+	require.NoError(t, session.Create(&criteria).Error)
+	requirements := []models.Requirement{
+		{ID: uuid.New(), ReferenceID: "REQ-001", Title: "Card validation", UserStoryID: stories[0].ID, AcceptanceCriteriaID: &criteria.ID},
+		{ID: uuid.New(), ReferenceID: "REQ-002", Title: "Card tokenization", UserStoryID: stories[0].ID},
+		{ID: uuid.New(), ReferenceID: "REQ-003", Title: "Invoice numbering", UserStoryID: stories[1].ID},
+	}
+	for i := range requirements {
+		requirements[i].CreatorID, requirements[i].AssigneeID = user.ID, user.ID
+		require.NoError(t, session.Create(&requirements[i]).Error)
+	}
+	require.NoError(t, session.Create(&models.RequirementAcceptanceCriteria{RequirementID: requirements[0].ID, AcceptanceCriteriaID: criteria.ID}).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	dependsOn, err := repos.RelationshipType.GetByName(ctx, "depends_on")
+	require.NoError(t, err)
+	relatesTo, err := repos.RelationshipType.GetByName(ctx, "relates_to")
+	require.NoError(t, err)
+
+	for _, rel := range []models.RequirementRelationship{
+		{SourceRequirementID: requirements[0].ID, TargetRequirementID: requirements[1].ID},
+		{SourceRequirementID: requirements[2].ID, TargetRequirementID: requirements[1].ID},
+	} {
+		rel.RelationshipTypeID, rel.CreatedBy = dependsOn.ID, user.ID
+		require.NoError(t, db.Create(&rel).Error)
+	}
+	link := models.EntityRelationship{SourceType: models.EntityTypeEpic, SourceID: epics[1].ID, TargetType: models.EntityTypeEpic,
+		TargetID: epics[0].ID, RelationshipTypeID: relatesTo.ID, CreatedBy: user.ID}
+	require.NoError(t, db.Create(&link).Error)
+	for _, comment := range []models.Comment{
+		{EntityType: models.EntityTypeUserStory, EntityID: stories[0].ID, Content: "3-D Secure?"},
+		{EntityType: models.EntityTypeUserStory, EntityID: stories[0].ID, Content: "Yes, for EU cards"},
+		{EntityType: models.EntityTypeAcceptanceCriteria, EntityID: criteria.ID, Content: "Which reasons are safe to show?"},
+	} {
+		comment.ID, comment.AuthorID = uuid.New(), user.ID
+		require.NoError(t, session.Create(&comment).Error)
+	}
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	svc := NewDeletionService(repos.Epic, repos.UserStory, repos.AcceptanceCriteria, repos.Requirement,
+		repos.RequirementRelationship, repos.Comment, repos.EntityRelationship, repos.User, logger)
+
+	t.Run("epic impact covers the whole hierarchy", func(t *testing.T) {
+		impact, err := svc.GetDeletionImpact(ctx, models.EntityTypeEpic, "EP-001")
+		require.NoError(t, err)
+
+		assert.Equal(t, DeletionImpactTotals{
+			Epics: 1, UserStories: 1, AcceptanceCriteria: 1, Requirements: 2, Comments: 3, Relationships: 3, Deleted: 11,
+		}, impact.Totals)
+		assert.Equal(t, 2, impact.CascadeDepth)
+		assert.True(t, impact.RequiresConfirmation)
+
+		root := impact.Root
+		assert.Equal(t, DeletionActionDelete, root.Action)
+		require.Len(t, root.Relationships, 1)
+		assert.Equal(t, "incoming", root.Relationships[0].Direction)
+		assert.Equal(t, "EP-002", root.Relationships[0].OtherReferenceID)
+
+		require.Len(t, root.Children, 1)
+		story := root.Children[0]
+		assert.Equal(t, "US-001", story.ReferenceID)
+		assert.Equal(t, 1, story.Depth)
+		assert.Equal(t, 2, story.Comments)
+		require.Len(t, story.Children, 3)
+		assert.Equal(t, "AC-001", story.Children[0].ReferenceID)
+		assert.Equal(t, "WHEN the card is declined THEN the system SHALL sh…", story.Children[0].Title)
+		assert.Empty(t, story.Children[0].Children, "requirements are deleted with the user story, not unlinked")
+
+		// The link between REQ-001 and REQ-002 is listed once
+		assert.Len(t, story.Children[1].Relationships, 1)
+		tokenization := story.Children[2]
+		require.Len(t, tokenization.Relationships, 1)
+		assert.Equal(t, "incoming", tokenization.Relationships[0].Direction)
+		assert.Equal(t, "REQ-003", tokenization.Relationships[0].OtherReferenceID)
+	})
+
+	t.Run("acceptance criteria impact unlinks requirements", func(t *testing.T) {
+		impact, err := svc.GetDeletionImpact(ctx, models.EntityTypeAcceptanceCriteria, criteria.ID.String())
+		require.NoError(t, err)
+
+		assert.Equal(t, DeletionImpactTotals{AcceptanceCriteria: 1, Comments: 1, UnlinkedRequirements: 1, Deleted: 2}, impact.Totals)
+		assert.Zero(t, impact.CascadeDepth)
+		assert.True(t, impact.RequiresConfirmation)
+		require.Len(t, impact.Root.Children, 1)
+		assert.Equal(t, DeletionActionUnlink, impact.Root.Children[0].Action)
+		assert.Equal(t, "REQ-001", impact.Root.Children[0].ReferenceID)
+	})
+
+	t.Run("requirement impact lists its relationships", func(t *testing.T) {
+		impact, err := svc.GetDeletionImpact(ctx, models.EntityTypeRequirement, "REQ-003")
+		require.NoError(t, err)
+
+		assert.Equal(t, DeletionImpactTotals{Requirements: 1, Relationships: 1, Deleted: 2}, impact.Totals)
+		require.Len(t, impact.Root.Relationships, 1)
+		assert.Equal(t, "outgoing", impact.Root.Relationships[0].Direction)
+		assert.Equal(t, impactRequirementRelationship, impact.Root.Relationships[0].Kind)
+	})
+
+	t.Run("unknown entities", func(t *testing.T) {
+		_, err := svc.GetDeletionImpact(ctx, models.EntityTypeUserStory, "US-404")
+		assert.ErrorIs(t, err, ErrUserStoryNotFound)
+
+		_, err = svc.GetDeletionImpact(ctx, models.EntityType("comment"), "C-001")
+		assert.ErrorIs(t, err, ErrInvalidEntityType)
+	})
+}
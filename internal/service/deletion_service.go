@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -9,35 +10,39 @@ import (
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 )
 
 var (
-	ErrDeletionCancelled         = errors.New("deletion cancelled by user")
-	ErrDeletionValidationFailed  = errors.New("deletion validation failed")
-	ErrDeletionTransactionFailed = errors.New("deletion transaction failed")
+	ErrDeletionCancelled         = apperrors.New(apperrors.KindConflict, apperrors.CodeConflict, "deletion cancelled by user")
+	ErrDeletionValidationFailed  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "deletion validation failed")
+	ErrDeletionTransactionFailed = apperrors.New(apperrors.KindInternal, apperrors.CodeInternal, "deletion transaction failed")
 )
 
 // DeletionService defines the interface for comprehensive deletion operations
 type DeletionService interface {
 	// Epic deletion
-	DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
+	DeleteEpicWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
 
 	// User Story deletion
-	DeleteUserStoryWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
+	DeleteUserStoryWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
 
 	// Acceptance Criteria deletion
-	DeleteAcceptanceCriteriaWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
+	DeleteAcceptanceCriteriaWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
 
 	// Requirement deletion
-	DeleteRequirementWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
+	DeleteRequirementWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error)
 
 	// Dependency validation
-	ValidateEpicDeletion(id uuid.UUID) (*DependencyInfo, error)
-	ValidateUserStoryDeletion(id uuid.UUID) (*DependencyInfo, error)
-	ValidateAcceptanceCriteriaDeletion(id uuid.UUID) (*DependencyInfo, error)
-	ValidateRequirementDeletion(id uuid.UUID) (*DependencyInfo, error)
+	ValidateEpicDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error)
+	ValidateUserStoryDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error)
+	ValidateAcceptanceCriteriaDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error)
+	ValidateRequirementDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error)
+
+	// Impact preview
+	GetDeletionImpact(ctx context.Context, entityType models.EntityType, idOrReference string) (*DeletionImpact, error)
 }
 
 // DeletionResult represents the result of a deletion operation
@@ -107,6 +112,7 @@ type deletionService struct {
 	requirementRepo             repository.RequirementRepository
 	requirementRelationshipRepo repository.RequirementRelationshipRepository
 	commentRepo                 repository.CommentRepository
+	entityRelationshipRepo      repository.EntityRelationshipRepository
 	userRepo                    repository.UserRepository
 
 	// Logger
@@ -121,6 +127,7 @@ func NewDeletionService(
 	requirementRepo repository.RequirementRepository,
 	requirementRelationshipRepo repository.RequirementRelationshipRepository,
 	commentRepo repository.CommentRepository,
+	entityRelationshipRepo repository.EntityRelationshipRepository,
 	userRepo repository.UserRepository,
 	logger *logrus.Logger,
 ) DeletionService {
@@ -131,6 +138,7 @@ func NewDeletionService(
 		requirementRepo:             requirementRepo,
 		requirementRelationshipRepo: requirementRelationshipRepo,
 		commentRepo:                 commentRepo,
+		entityRelationshipRepo:      entityRelationshipRepo,
 		userRepo:                    userRepo,
 		logger:                      logger,
 	}
@@ -160,7 +168,7 @@ func (s *deletionService) logAuditEntry(entityType string, entityID uuid.UUID, r
 }
 
 // DeleteEpicWithValidation deletes an epic with comprehensive validation and cascading
-func (s *deletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
+func (s *deletionService) DeleteEpicWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
 	transactionID := s.generateTransactionID()
 
 	s.logger.WithFields(logrus.Fields{
@@ -171,7 +179,7 @@ func (s *deletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUI
 	}).Info("Starting epic deletion with validation")
 
 	// Get epic details for audit logging
-	epic, err := s.epicRepo.GetByID(id)
+	epic, err := s.epicRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -180,7 +188,7 @@ func (s *deletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUI
 	}
 
 	// Validate dependencies
-	depInfo, err := s.ValidateEpicDeletion(id)
+	depInfo, err := s.ValidateEpicDeletion(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate epic deletion: %w", err)
 	}
@@ -197,19 +205,19 @@ func (s *deletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUI
 
 	// Perform deletion in transaction
 	var result *DeletionResult
-	err = s.epicRepo.WithTransaction(func(tx *gorm.DB) error {
+	err = s.epicRepo.WithTransaction(ctx, func(tx *gorm.DB) error {
 		cascadeDeleted := []CascadeDeletedEntity{}
 
 		if force {
 			// Delete all user stories and their dependencies
-			userStories, err := s.userStoryRepo.GetByEpic(id)
+			userStories, err := s.userStoryRepo.GetByEpic(ctx, id)
 			if err != nil {
 				return fmt.Errorf("failed to get user stories for epic: %w", err)
 			}
 
 			for _, userStory := range userStories {
 				// Delete user story with cascade
-				userStoryResult, err := s.deleteUserStoryInTransaction(tx, userStory.ID, userID, transactionID)
+				userStoryResult, err := s.deleteUserStoryInTransaction(ctx, tx, userStory.ID, userID, transactionID)
 				if err != nil {
 					return fmt.Errorf("failed to cascade delete user story %s: %w", userStory.ReferenceID, err)
 				}
@@ -231,6 +239,11 @@ func (s *deletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUI
 			return fmt.Errorf("failed to delete epic comments: %w", err)
 		}
 
+		// Delete links to and from the epic
+		if err := s.deleteEntityRelationshipsInTransaction(tx, models.EntityTypeEpic, id); err != nil {
+			return fmt.Errorf("failed to delete epic relationships: %w", err)
+		}
+
 		// Delete the epic itself
 		if err := tx.Delete(&models.Epic{}, id).Error; err != nil {
 			return fmt.Errorf("failed to delete epic: %w", err)
@@ -276,7 +289,7 @@ func (s *deletionService) DeleteEpicWithValidation(id uuid.UUID, userID uuid.UUI
 }
 
 // DeleteUserStoryWithValidation deletes a user story with comprehensive validation and cascading
-func (s *deletionService) DeleteUserStoryWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
+func (s *deletionService) DeleteUserStoryWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
 	transactionID := s.generateTransactionID()
 
 	s.logger.WithFields(logrus.Fields{
@@ -289,7 +302,7 @@ func (s *deletionService) DeleteUserStoryWithValidation(id uuid.UUID, userID uui
 	// Validate user story exists (done in ValidateUserStoryDeletion)
 
 	// Validate dependencies
-	depInfo, err := s.ValidateUserStoryDeletion(id)
+	depInfo, err := s.ValidateUserStoryDeletion(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate user story deletion: %w", err)
 	}
@@ -306,8 +319,8 @@ func (s *deletionService) DeleteUserStoryWithValidation(id uuid.UUID, userID uui
 
 	// Perform deletion in transaction
 	var result *DeletionResult
-	err = s.userStoryRepo.WithTransaction(func(tx *gorm.DB) error {
-		userStoryResult, err := s.deleteUserStoryInTransaction(tx, id, userID, transactionID)
+	err = s.userStoryRepo.WithTransaction(ctx, func(tx *gorm.DB) error {
+		userStoryResult, err := s.deleteUserStoryInTransaction(ctx, tx, id, userID, transactionID)
 		if err != nil {
 			return err
 		}
@@ -334,9 +347,9 @@ func (s *deletionService) DeleteUserStoryWithValidation(id uuid.UUID, userID uui
 }
 
 // deleteUserStoryInTransaction deletes a user story within a transaction (helper method)
-func (s *deletionService) deleteUserStoryInTransaction(tx *gorm.DB, id uuid.UUID, userID uuid.UUID, transactionID string) (*DeletionResult, error) {
+func (s *deletionService) deleteUserStoryInTransaction(ctx context.Context, tx *gorm.DB, id uuid.UUID, userID uuid.UUID, transactionID string) (*DeletionResult, error) {
 	// Get user story details
-	userStory, err := s.userStoryRepo.GetByID(id)
+	userStory, err := s.userStoryRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user story: %w", err)
 	}
@@ -344,13 +357,13 @@ func (s *deletionService) deleteUserStoryInTransaction(tx *gorm.DB, id uuid.UUID
 	cascadeDeleted := []CascadeDeletedEntity{}
 
 	// Delete all acceptance criteria
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(id)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get acceptance criteria for user story: %w", err)
 	}
 
 	for _, ac := range acceptanceCriteria {
-		acResult, err := s.deleteAcceptanceCriteriaInTransaction(tx, ac.ID, userID, transactionID)
+		acResult, err := s.deleteAcceptanceCriteriaInTransaction(ctx, tx, ac.ID, userID, transactionID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to cascade delete acceptance criteria %s: %w", ac.ReferenceID, err)
 		}
@@ -365,13 +378,13 @@ func (s *deletionService) deleteUserStoryInTransaction(tx *gorm.DB, id uuid.UUID
 	}
 
 	// Delete all requirements
-	requirements, err := s.requirementRepo.GetByUserStory(id)
+	requirements, err := s.requirementRepo.GetByUserStory(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requirements for user story: %w", err)
 	}
 
 	for _, req := range requirements {
-		reqResult, err := s.deleteRequirementInTransaction(tx, req.ID, userID, transactionID)
+		reqResult, err := s.deleteRequirementInTransaction(ctx, tx, req.ID, userID, transactionID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to cascade delete requirement %s: %w", req.ReferenceID, err)
 		}
@@ -390,6 +403,11 @@ func (s *deletionService) deleteUserStoryInTransaction(tx *gorm.DB, id uuid.UUID
 		return nil, fmt.Errorf("failed to delete user story comments: %w", err)
 	}
 
+	// Delete links to and from the user story
+	if err := s.deleteEntityRelationshipsInTransaction(tx, models.EntityTypeUserStory, id); err != nil {
+		return nil, fmt.Errorf("failed to delete user story relationships: %w", err)
+	}
+
 	// Delete the user story itself
 	if err := tx.Delete(&models.UserStory{}, id).Error; err != nil {
 		return nil, fmt.Errorf("failed to delete user story: %w", err)
@@ -415,7 +433,7 @@ func (s *deletionService) deleteUserStoryInTransaction(tx *gorm.DB, id uuid.UUID
 }
 
 // DeleteAcceptanceCriteriaWithValidation deletes acceptance criteria with comprehensive validation and cascading
-func (s *deletionService) DeleteAcceptanceCriteriaWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
+func (s *deletionService) DeleteAcceptanceCriteriaWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
 	transactionID := s.generateTransactionID()
 
 	s.logger.WithFields(logrus.Fields{
@@ -428,7 +446,7 @@ func (s *deletionService) DeleteAcceptanceCriteriaWithValidation(id uuid.UUID, u
 	// Validate acceptance criteria exists (done in ValidateAcceptanceCriteriaDeletion)
 
 	// Validate dependencies
-	depInfo, err := s.ValidateAcceptanceCriteriaDeletion(id)
+	depInfo, err := s.ValidateAcceptanceCriteriaDeletion(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate acceptance criteria deletion: %w", err)
 	}
@@ -445,8 +463,8 @@ func (s *deletionService) DeleteAcceptanceCriteriaWithValidation(id uuid.UUID, u
 
 	// Perform deletion in transaction
 	var result *DeletionResult
-	err = s.acceptanceCriteriaRepo.WithTransaction(func(tx *gorm.DB) error {
-		acResult, err := s.deleteAcceptanceCriteriaInTransaction(tx, id, userID, transactionID)
+	err = s.acceptanceCriteriaRepo.WithTransaction(ctx, func(tx *gorm.DB) error {
+		acResult, err := s.deleteAcceptanceCriteriaInTransaction(ctx, tx, id, userID, transactionID)
 		if err != nil {
 			return err
 		}
@@ -473,9 +491,9 @@ func (s *deletionService) DeleteAcceptanceCriteriaWithValidation(id uuid.UUID, u
 }
 
 // deleteAcceptanceCriteriaInTransaction deletes acceptance criteria within a transaction (helper method)
-func (s *deletionService) deleteAcceptanceCriteriaInTransaction(tx *gorm.DB, id uuid.UUID, userID uuid.UUID, transactionID string) (*DeletionResult, error) {
+func (s *deletionService) deleteAcceptanceCriteriaInTransaction(ctx context.Context, tx *gorm.DB, id uuid.UUID, userID uuid.UUID, transactionID string) (*DeletionResult, error) {
 	// Get acceptance criteria details
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(id)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get acceptance criteria: %w", err)
 	}
@@ -483,16 +501,30 @@ func (s *deletionService) deleteAcceptanceCriteriaInTransaction(tx *gorm.DB, id
 	cascadeDeleted := []CascadeDeletedEntity{}
 
 	// Delete all requirements linked to this acceptance criteria
-	requirements, err := s.requirementRepo.GetByAcceptanceCriteria(id)
+	requirements, err := s.requirementRepo.GetByAcceptanceCriteria(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requirements for acceptance criteria: %w", err)
 	}
 
 	for _, req := range requirements {
-		// Update requirement to remove acceptance criteria link instead of deleting
-		if err := tx.Model(&models.Requirement{}).Where("id = ?", req.ID).Update("acceptance_criteria_id", nil).Error; err != nil {
+		// Unlink the requirement instead of deleting it; requirements having the acceptance criteria as their
+		// primary one move to their earliest remaining acceptance criteria, if any
+		if err := tx.Where("requirement_id = ? AND acceptance_criteria_id = ?", req.ID, id).Delete(&models.RequirementAcceptanceCriteria{}).Error; err != nil {
 			return nil, fmt.Errorf("failed to unlink requirement %s from acceptance criteria: %w", req.ReferenceID, err)
 		}
+		if req.AcceptanceCriteriaID != nil && *req.AcceptanceCriteriaID == id {
+			var remaining []models.RequirementAcceptanceCriteria
+			if err := tx.Where("requirement_id = ?", req.ID).Order("created_at ASC, acceptance_criteria_id ASC").Limit(1).Find(&remaining).Error; err != nil {
+				return nil, fmt.Errorf("failed to get acceptance criteria of requirement %s: %w", req.ReferenceID, err)
+			}
+			var primaryID *uuid.UUID
+			if len(remaining) > 0 {
+				primaryID = &remaining[0].AcceptanceCriteriaID
+			}
+			if err := tx.Model(&models.Requirement{}).Where("id = ?", req.ID).Update("acceptance_criteria_id", primaryID).Error; err != nil {
+				return nil, fmt.Errorf("failed to unlink requirement %s from acceptance criteria: %w", req.ReferenceID, err)
+			}
+		}
 
 		s.logger.WithFields(logrus.Fields{
 			"requirement_id":         req.ID,
@@ -506,6 +538,11 @@ func (s *deletionService) deleteAcceptanceCriteriaInTransaction(tx *gorm.DB, id
 		return nil, fmt.Errorf("failed to delete acceptance criteria comments: %w", err)
 	}
 
+	// Delete links to and from the acceptance criteria
+	if err := s.deleteEntityRelationshipsInTransaction(tx, models.EntityTypeAcceptanceCriteria, id); err != nil {
+		return nil, fmt.Errorf("failed to delete acceptance criteria relationships: %w", err)
+	}
+
 	// Delete the acceptance criteria itself
 	if err := tx.Delete(&models.AcceptanceCriteria{}, id).Error; err != nil {
 		return nil, fmt.Errorf("failed to delete acceptance criteria: %w", err)
@@ -532,7 +569,7 @@ func (s *deletionService) deleteAcceptanceCriteriaInTransaction(tx *gorm.DB, id
 }
 
 // DeleteRequirementWithValidation deletes a requirement with comprehensive validation and cascading
-func (s *deletionService) DeleteRequirementWithValidation(id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
+func (s *deletionService) DeleteRequirementWithValidation(ctx context.Context, id uuid.UUID, userID uuid.UUID, force bool) (*DeletionResult, error) {
 	transactionID := s.generateTransactionID()
 
 	s.logger.WithFields(logrus.Fields{
@@ -545,7 +582,7 @@ func (s *deletionService) DeleteRequirementWithValidation(id uuid.UUID, userID u
 	// Validate requirement exists (done in ValidateRequirementDeletion)
 
 	// Validate dependencies
-	depInfo, err := s.ValidateRequirementDeletion(id)
+	depInfo, err := s.ValidateRequirementDeletion(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate requirement deletion: %w", err)
 	}
@@ -562,8 +599,8 @@ func (s *deletionService) DeleteRequirementWithValidation(id uuid.UUID, userID u
 
 	// Perform deletion in transaction
 	var result *DeletionResult
-	err = s.requirementRepo.WithTransaction(func(tx *gorm.DB) error {
-		reqResult, err := s.deleteRequirementInTransaction(tx, id, userID, transactionID)
+	err = s.requirementRepo.WithTransaction(ctx, func(tx *gorm.DB) error {
+		reqResult, err := s.deleteRequirementInTransaction(ctx, tx, id, userID, transactionID)
 		if err != nil {
 			return err
 		}
@@ -590,9 +627,9 @@ func (s *deletionService) DeleteRequirementWithValidation(id uuid.UUID, userID u
 }
 
 // deleteRequirementInTransaction deletes a requirement within a transaction (helper method)
-func (s *deletionService) deleteRequirementInTransaction(tx *gorm.DB, id uuid.UUID, userID uuid.UUID, transactionID string) (*DeletionResult, error) {
+func (s *deletionService) deleteRequirementInTransaction(ctx context.Context, tx *gorm.DB, id uuid.UUID, userID uuid.UUID, transactionID string) (*DeletionResult, error) {
 	// Get requirement details
-	requirement, err := s.requirementRepo.GetByID(id)
+	requirement, err := s.requirementRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requirement: %w", err)
 	}
@@ -600,7 +637,7 @@ func (s *deletionService) deleteRequirementInTransaction(tx *gorm.DB, id uuid.UU
 	cascadeDeleted := []CascadeDeletedEntity{}
 
 	// Delete all requirement relationships (both source and target)
-	relationships, err := s.requirementRelationshipRepo.GetByRequirement(id)
+	relationships, err := s.requirementRelationshipRepo.GetByRequirement(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get relationships for requirement: %w", err)
 	}
@@ -628,6 +665,11 @@ func (s *deletionService) deleteRequirementInTransaction(tx *gorm.DB, id uuid.UU
 		return nil, fmt.Errorf("failed to delete requirement comments: %w", err)
 	}
 
+	// Delete links to and from the requirement
+	if err := s.deleteEntityRelationshipsInTransaction(tx, models.EntityTypeRequirement, id); err != nil {
+		return nil, fmt.Errorf("failed to delete requirement relationships: %w", err)
+	}
+
 	// Delete the requirement itself
 	if err := tx.Delete(&models.Requirement{}, id).Error; err != nil {
 		return nil, fmt.Errorf("failed to delete requirement: %w", err)
@@ -670,14 +712,24 @@ func (s *deletionService) deleteCommentsInTransaction(tx *gorm.DB, entityType mo
 	return nil
 }
 
+// deleteEntityRelationshipsInTransaction deletes the links from and to an entity within a transaction
+func (s *deletionService) deleteEntityRelationshipsInTransaction(tx *gorm.DB, entityType models.EntityType, entityID uuid.UUID) error {
+	err := tx.Where("(source_type = ? AND source_id = ?) OR (target_type = ? AND target_id = ?)", entityType, entityID, entityType, entityID).
+		Delete(&models.EntityRelationship{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete relationships for entity %s %s: %w", entityType, entityID, err)
+	}
+	return nil
+}
+
 // ValidateEpicDeletion validates if an epic can be deleted and returns dependency information
-func (s *deletionService) ValidateEpicDeletion(id uuid.UUID) (*DependencyInfo, error) {
+func (s *deletionService) ValidateEpicDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error) {
 	s.logger.WithFields(logrus.Fields{
 		"epic_id": id,
 	}).Debug("Validating epic deletion")
 
 	// Check if epic exists
-	epic, err := s.epicRepo.GetByID(id)
+	epic, err := s.epicRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -690,7 +742,7 @@ func (s *deletionService) ValidateEpicDeletion(id uuid.UUID) (*DependencyInfo, e
 	cascadeEntities := []CascadeDeletePreview{}
 
 	// Check for user stories
-	userStories, err := s.userStoryRepo.GetByEpic(id)
+	userStories, err := s.userStoryRepo.GetByEpic(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user stories for epic: %w", err)
 	}
@@ -713,7 +765,7 @@ func (s *deletionService) ValidateEpicDeletion(id uuid.UUID) (*DependencyInfo, e
 			})
 
 			// Add nested dependencies (acceptance criteria and requirements)
-			acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(us.ID)
+			acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(ctx, us.ID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get acceptance criteria for user story %s: %w", us.ReferenceID, err)
 			}
@@ -727,7 +779,7 @@ func (s *deletionService) ValidateEpicDeletion(id uuid.UUID) (*DependencyInfo, e
 				})
 			}
 
-			requirements, err := s.requirementRepo.GetByUserStory(us.ID)
+			requirements, err := s.requirementRepo.GetByUserStory(ctx, us.ID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get requirements for user story %s: %w", us.ReferenceID, err)
 			}
@@ -756,13 +808,13 @@ func (s *deletionService) ValidateEpicDeletion(id uuid.UUID) (*DependencyInfo, e
 }
 
 // ValidateUserStoryDeletion validates if a user story can be deleted and returns dependency information
-func (s *deletionService) ValidateUserStoryDeletion(id uuid.UUID) (*DependencyInfo, error) {
+func (s *deletionService) ValidateUserStoryDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error) {
 	s.logger.WithFields(logrus.Fields{
 		"user_story_id": id,
 	}).Debug("Validating user story deletion")
 
 	// Check if user story exists
-	userStory, err := s.userStoryRepo.GetByID(id)
+	userStory, err := s.userStoryRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -775,7 +827,7 @@ func (s *deletionService) ValidateUserStoryDeletion(id uuid.UUID) (*DependencyIn
 	cascadeEntities := []CascadeDeletePreview{}
 
 	// Check for acceptance criteria
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(id)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get acceptance criteria for user story: %w", err)
 	}
@@ -800,7 +852,7 @@ func (s *deletionService) ValidateUserStoryDeletion(id uuid.UUID) (*DependencyIn
 	}
 
 	// Check for requirements
-	requirements, err := s.requirementRepo.GetByUserStory(id)
+	requirements, err := s.requirementRepo.GetByUserStory(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requirements for user story: %w", err)
 	}
@@ -837,13 +889,13 @@ func (s *deletionService) ValidateUserStoryDeletion(id uuid.UUID) (*DependencyIn
 }
 
 // ValidateAcceptanceCriteriaDeletion validates if acceptance criteria can be deleted and returns dependency information
-func (s *deletionService) ValidateAcceptanceCriteriaDeletion(id uuid.UUID) (*DependencyInfo, error) {
+func (s *deletionService) ValidateAcceptanceCriteriaDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error) {
 	s.logger.WithFields(logrus.Fields{
 		"acceptance_criteria_id": id,
 	}).Debug("Validating acceptance criteria deletion")
 
 	// Check if acceptance criteria exists
-	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(id)
+	acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrAcceptanceCriteriaNotFound
@@ -855,7 +907,7 @@ func (s *deletionService) ValidateAcceptanceCriteriaDeletion(id uuid.UUID) (*Dep
 	cascadeEntities := []CascadeDeletePreview{}
 
 	// Check if this is the last acceptance criteria for the user story
-	count, err := s.acceptanceCriteriaRepo.CountByUserStory(acceptanceCriteria.UserStoryID)
+	count, err := s.acceptanceCriteriaRepo.CountByUserStory(ctx, acceptanceCriteria.UserStoryID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count acceptance criteria for user story: %w", err)
 	}
@@ -871,7 +923,7 @@ func (s *deletionService) ValidateAcceptanceCriteriaDeletion(id uuid.UUID) (*Dep
 	}
 
 	// Check for linked requirements (these will be unlinked, not deleted)
-	requirements, err := s.requirementRepo.GetByAcceptanceCriteria(id)
+	requirements, err := s.requirementRepo.GetByAcceptanceCriteria(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requirements for acceptance criteria: %w", err)
 	}
@@ -900,13 +952,13 @@ func (s *deletionService) ValidateAcceptanceCriteriaDeletion(id uuid.UUID) (*Dep
 }
 
 // ValidateRequirementDeletion validates if a requirement can be deleted and returns dependency information
-func (s *deletionService) ValidateRequirementDeletion(id uuid.UUID) (*DependencyInfo, error) {
+func (s *deletionService) ValidateRequirementDeletion(ctx context.Context, id uuid.UUID) (*DependencyInfo, error) {
 	s.logger.WithFields(logrus.Fields{
 		"requirement_id": id,
 	}).Debug("Validating requirement deletion")
 
 	// Check if requirement exists
-	requirement, err := s.requirementRepo.GetByID(id)
+	requirement, err := s.requirementRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementNotFound
@@ -919,7 +971,7 @@ func (s *deletionService) ValidateRequirementDeletion(id uuid.UUID) (*Dependency
 	cascadeEntities := []CascadeDeletePreview{}
 
 	// Check for requirement relationships
-	relationships, err := s.requirementRelationshipRepo.GetByRequirement(id)
+	relationships, err := s.requirementRelationshipRepo.GetByRequirement(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get relationships for requirement: %w", err)
 	}
@@ -938,7 +990,7 @@ func (s *deletionService) ValidateRequirementDeletion(id uuid.UUID) (*Dependency
 				relationshipDirection = "incoming"
 			}
 
-			otherReq, err := s.requirementRepo.GetByID(otherReqID)
+			otherReq, err := s.requirementRepo.GetByID(ctx, otherReqID)
 			if err != nil {
 				continue // Skip if other requirement doesn't exist
 			}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -18,12 +19,12 @@ type MockCommentRepository struct {
 	mock.Mock
 }
 
-func (m *MockCommentRepository) Create(comment *models.Comment) error {
+func (m *MockCommentRepository) Create(ctx context.Context, comment *models.Comment) error {
 	args := m.Called(comment)
 	return args.Error(0)
 }
 
-func (m *MockCommentRepository) GetByID(id uuid.UUID) (*models.Comment, error) {
+func (m *MockCommentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Comment, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -31,32 +32,32 @@ func (m *MockCommentRepository) GetByID(id uuid.UUID) (*models.Comment, error) {
 	return args.Get(0).(*models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) Update(comment *models.Comment) error {
+func (m *MockCommentRepository) Update(ctx context.Context, comment *models.Comment) error {
 	args := m.Called(comment)
 	return args.Error(0)
 }
 
-func (m *MockCommentRepository) Delete(id uuid.UUID) error {
+func (m *MockCommentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockCommentRepository) GetByEntity(entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
+func (m *MockCommentRepository) GetByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
 	args := m.Called(entityType, entityID)
 	return args.Get(0).([]models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) GetByAuthor(authorID uuid.UUID) ([]models.Comment, error) {
+func (m *MockCommentRepository) GetByAuthor(ctx context.Context, authorID uuid.UUID) ([]models.Comment, error) {
 	args := m.Called(authorID)
 	return args.Get(0).([]models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) GetThreaded(entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
+func (m *MockCommentRepository) GetThreaded(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
 	args := m.Called(entityType, entityID)
 	return args.Get(0).([]models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) GetByReferenceID(referenceID string) (*models.Comment, error) {
+func (m *MockCommentRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.Comment, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -64,7 +65,7 @@ func (m *MockCommentRepository) GetByReferenceID(referenceID string) (*models.Co
 	return args.Get(0).(*models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.Comment, error) {
+func (m *MockCommentRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.Comment, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -72,27 +73,27 @@ func (m *MockCommentRepository) GetByReferenceIDCaseInsensitive(referenceID stri
 	return args.Get(0).(*models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Comment, error) {
+func (m *MockCommentRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Comment, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockCommentRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockCommentRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockCommentRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockCommentRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockCommentRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockCommentRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockCommentRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -102,30 +103,52 @@ func (m *MockCommentRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockCommentRepository) GetByParent(parentID uuid.UUID) ([]models.Comment, error) {
+func (m *MockCommentRepository) GetByParent(ctx context.Context, parentID uuid.UUID) ([]models.Comment, error) {
 	args := m.Called(parentID)
 	return args.Get(0).([]models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) GetByParentWithPagination(parentID uuid.UUID, limit, offset int) ([]models.Comment, int64, error) {
+func (m *MockCommentRepository) GetByParentWithPagination(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]models.Comment, int64, error) {
 	args := m.Called(parentID, limit, offset)
 	return args.Get(0).([]models.Comment), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockCommentRepository) GetByStatus(isResolved bool) ([]models.Comment, error) {
+func (m *MockCommentRepository) GetByStatus(ctx context.Context, isResolved bool) ([]models.Comment, error) {
 	args := m.Called(isResolved)
 	return args.Get(0).([]models.Comment), args.Error(1)
 }
 
-func (m *MockCommentRepository) GetInlineComments(entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
+func (m *MockCommentRepository) GetInlineComments(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]models.Comment, error) {
 	args := m.Called(entityType, entityID)
 	return args.Get(0).([]models.Comment), args.Error(1)
 }
 
+func (m *MockCommentRepository) ListWithFilters(ctx context.Context, filters repository.CommentFilters) ([]models.Comment, int64, error) {
+	args := m.Called(filters)
+	return args.Get(0).([]models.Comment), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockCommentRepository) CountByCategory(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (map[models.CommentCategory]int64, error) {
+	args := m.Called(entityType, entityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[models.CommentCategory]int64), args.Error(1)
+}
+
+func (m *MockCommentRepository) CountByEntities(ctx context.Context, entityType models.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]repository.CommentCounts, error) {
+	args := m.Called(entityType, entityIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]repository.CommentCounts), args.Error(1)
+}
+
 // Test comprehensive deletion scenarios using existing mocks from other test files
 
 // Test Epic Deletion with Dependencies - Validation Scenarios
 func TestDeletionIntegration_EpicValidation_WithDependencies(t *testing.T) {
+	ctx := context.Background()
 	// Create mocks using existing mock types from other test files
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserStoryRepo := new(MockUserStoryRepository)
@@ -145,6 +168,7 @@ func TestDeletionIntegration_EpicValidation_WithDependencies(t *testing.T) {
 		mockRequirementRepo,
 		mockRequirementRelationshipRepo,
 		mockCommentRepo,
+		nil,
 		mockUserRepo,
 		logger,
 	)
@@ -188,7 +212,7 @@ func TestDeletionIntegration_EpicValidation_WithDependencies(t *testing.T) {
 	mockRequirementRepo.On("GetByUserStory", userStoryID).Return([]models.Requirement{requirement}, nil)
 
 	// Test validation
-	depInfo, err := service.ValidateEpicDeletion(epicID)
+	depInfo, err := service.ValidateEpicDeletion(ctx, epicID)
 	assert.NoError(t, err)
 	assert.NotNil(t, depInfo)
 
@@ -237,6 +261,7 @@ func TestDeletionIntegration_EpicValidation_WithDependencies(t *testing.T) {
 
 // Test Epic Deletion without Dependencies
 func TestDeletionIntegration_EpicValidation_NoDependencies(t *testing.T) {
+	ctx := context.Background()
 	// Create mocks
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserStoryRepo := new(MockUserStoryRepository)
@@ -256,6 +281,7 @@ func TestDeletionIntegration_EpicValidation_NoDependencies(t *testing.T) {
 		mockRequirementRepo,
 		mockRequirementRelationshipRepo,
 		mockCommentRepo,
+		nil,
 		mockUserRepo,
 		logger,
 	)
@@ -273,7 +299,7 @@ func TestDeletionIntegration_EpicValidation_NoDependencies(t *testing.T) {
 	mockUserStoryRepo.On("GetByEpic", epicID).Return([]models.UserStory{}, nil)
 
 	// Test validation
-	depInfo, err := service.ValidateEpicDeletion(epicID)
+	depInfo, err := service.ValidateEpicDeletion(ctx, epicID)
 	assert.NoError(t, err)
 	assert.NotNil(t, depInfo)
 
@@ -290,6 +316,7 @@ func TestDeletionIntegration_EpicValidation_NoDependencies(t *testing.T) {
 
 // Test Entity Not Found Scenarios
 func TestDeletionIntegration_EntityNotFound(t *testing.T) {
+	ctx := context.Background()
 	// Create mocks
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserStoryRepo := new(MockUserStoryRepository)
@@ -309,6 +336,7 @@ func TestDeletionIntegration_EntityNotFound(t *testing.T) {
 		mockRequirementRepo,
 		mockRequirementRelationshipRepo,
 		mockCommentRepo,
+		nil,
 		mockUserRepo,
 		logger,
 	)
@@ -317,28 +345,28 @@ func TestDeletionIntegration_EntityNotFound(t *testing.T) {
 
 	// Test Epic Not Found
 	mockEpicRepo.On("GetByID", nonExistentID).Return(nil, repository.ErrNotFound)
-	depInfo, err := service.ValidateEpicDeletion(nonExistentID)
+	depInfo, err := service.ValidateEpicDeletion(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrEpicNotFound, err)
 	assert.Nil(t, depInfo)
 
 	// Test User Story Not Found
 	mockUserStoryRepo.On("GetByID", nonExistentID).Return(nil, repository.ErrNotFound)
-	depInfo, err = service.ValidateUserStoryDeletion(nonExistentID)
+	depInfo, err = service.ValidateUserStoryDeletion(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrUserStoryNotFound, err)
 	assert.Nil(t, depInfo)
 
 	// Test Acceptance Criteria Not Found
 	mockAcceptanceCriteriaRepo.On("GetByID", nonExistentID).Return(nil, repository.ErrNotFound)
-	depInfo, err = service.ValidateAcceptanceCriteriaDeletion(nonExistentID)
+	depInfo, err = service.ValidateAcceptanceCriteriaDeletion(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrAcceptanceCriteriaNotFound, err)
 	assert.Nil(t, depInfo)
 
 	// Test Requirement Not Found
 	mockRequirementRepo.On("GetByID", nonExistentID).Return(nil, repository.ErrNotFound)
-	depInfo, err = service.ValidateRequirementDeletion(nonExistentID)
+	depInfo, err = service.ValidateRequirementDeletion(ctx, nonExistentID)
 	assert.Error(t, err)
 	assert.Equal(t, ErrRequirementNotFound, err)
 	assert.Nil(t, depInfo)
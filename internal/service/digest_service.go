@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// maxDigestEvents caps the number of events listed in a single digest email
+const maxDigestEvents = 200
+
+var (
+	ErrInvalidDigestFrequency = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid digest frequency")
+)
+
+// DigestService defines the interface for activity digest preferences and delivery
+type DigestService interface {
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*models.DigestSubscription, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, frequency models.DigestFrequency) (*models.DigestSubscription, error)
+	Unsubscribe(ctx context.Context, token string) error
+	SendDueDigests(ctx context.Context, now time.Time) (int, error)
+}
+
+// UpdateDigestPreferencesRequest represents the request to change digest preferences
+type UpdateDigestPreferencesRequest struct {
+	Frequency models.DigestFrequency `json:"frequency" binding:"required" example:"weekly"`
+}
+
+// digestService implements DigestService interface
+type digestService struct {
+	subscriptionRepo repository.DigestSubscriptionRepository
+	auditRepo        repository.AuditRepository
+	repos            *repository.Repositories
+	mailer           Mailer
+	tokenGenerator   TokenGenerator
+	baseURL          string
+	logger           *logrus.Logger
+}
+
+// NewDigestService creates a new digest service instance
+// baseURL is the public API base URL used to build unsubscribe links
+func NewDigestService(repos *repository.Repositories, mailer Mailer, baseURL string, logger *logrus.Logger) DigestService {
+	return &digestService{
+		subscriptionRepo: repos.DigestSubscription,
+		auditRepo:        repos.Audit,
+		repos:            repos,
+		mailer:           mailer,
+		tokenGenerator:   NewSecureTokenGenerator(),
+		baseURL:          strings.TrimRight(baseURL, "/"),
+		logger:           logger,
+	}
+}
+
+// GetPreferences retrieves the digest preferences of a user
+// Users who never saved preferences get an unsaved subscription with digests disabled
+func (s *digestService) GetPreferences(ctx context.Context, userID uuid.UUID) (*models.DigestSubscription, error) {
+	subscription, err := s.subscriptionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return &models.DigestSubscription{UserID: userID, Frequency: models.DigestFrequencyNone}, nil
+		}
+		return nil, fmt.Errorf("failed to get digest preferences: %w", err)
+	}
+	return subscription, nil
+}
+
+// UpdatePreferences sets how often a user receives the digest
+func (s *digestService) UpdatePreferences(ctx context.Context, userID uuid.UUID, frequency models.DigestFrequency) (*models.DigestSubscription, error) {
+	if !frequency.IsValid() {
+		return nil, ErrInvalidDigestFrequency
+	}
+
+	subscription, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.UnsubscribeToken == "" {
+		_, token, err := s.tokenGenerator.GenerateToken("", 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate unsubscribe token: %w", err)
+		}
+		subscription.UnsubscribeToken = token
+	}
+	subscription.Frequency = frequency
+
+	if err := s.subscriptionRepo.Save(ctx, subscription); err != nil {
+		return nil, fmt.Errorf("failed to save digest preferences: %w", err)
+	}
+	return subscription, nil
+}
+
+// Unsubscribe disables digests for the subscription owning the token
+func (s *digestService) Unsubscribe(ctx context.Context, token string) error {
+	subscription, err := s.subscriptionRepo.GetByUnsubscribeToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to get digest subscription: %w", err)
+	}
+
+	subscription.Frequency = models.DigestFrequencyNone
+	if err := s.subscriptionRepo.Save(ctx, subscription); err != nil {
+		return fmt.Errorf("failed to save digest preferences: %w", err)
+	}
+	return nil
+}
+
+// SendDueDigests emails every subscriber whose digest period has elapsed and returns the number of emails sent
+// Failures for a single user are logged and retried on the next run
+func (s *digestService) SendDueDigests(ctx context.Context, now time.Time) (int, error) {
+	subscriptions, err := s.subscriptionRepo.ListActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list digest subscriptions: %w", err)
+	}
+
+	sent := 0
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		period := subscription.Frequency.Period()
+		if period == 0 || subscription.User == nil {
+			continue
+		}
+
+		since := now.Add(-period)
+		if subscription.LastSentAt != nil {
+			if now.Sub(*subscription.LastSentAt) < period {
+				continue
+			}
+			since = *subscription.LastSentAt
+		}
+
+		delivered, err := s.sendDigest(ctx, subscription, since)
+		if err != nil {
+			s.logger.WithFields(logrus.Fields{
+				"user_id": subscription.UserID,
+				"error":   err.Error(),
+			}).Error("Failed to send activity digest")
+			continue
+		}
+
+		// Periods without activity are skipped silently but still advance the schedule
+		subscription.LastSentAt = &now
+		if err := s.subscriptionRepo.Save(ctx, subscription); err != nil {
+			s.logger.WithFields(logrus.Fields{
+				"user_id": subscription.UserID,
+				"error":   err.Error(),
+			}).Error("Failed to record activity digest delivery")
+			continue
+		}
+		if delivered {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// sendDigest emails the activity since the given time; it reports false when there was nothing to send
+func (s *digestService) sendDigest(ctx context.Context, subscription *models.DigestSubscription, since time.Time) (bool, error) {
+	events, err := s.auditRepo.ListAssignedSince(ctx, subscription.UserID, since, maxDigestEvents)
+	if err != nil {
+		return false, fmt.Errorf("failed to list activity: %w", err)
+	}
+	if len(events) == 0 {
+		return false, nil
+	}
+
+	subject := fmt.Sprintf("Your %s activity digest", subscription.Frequency)
+	if err := s.mailer.Send(subscription.User.Email, subject, s.renderDigest(ctx, subscription, since, events)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// renderDigest builds the plain text digest body, grouping events by entity in order of first activity
+func (s *digestService) renderDigest(ctx context.Context, subscription *models.DigestSubscription, since time.Time, events []models.AuditEvent) string {
+	type entityKey struct {
+		entityType models.EntityType
+		entityID   uuid.UUID
+	}
+
+	var order []entityKey
+	grouped := make(map[entityKey][]models.AuditEvent)
+	for _, event := range events {
+		key := entityKey{event.EntityType, event.EntityID}
+		if _, ok := grouped[key]; !ok {
+			order = append(order, key)
+		}
+		grouped[key] = append(grouped[key], event)
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hello %s,\n\n", subscription.User.Username)
+	fmt.Fprintf(&body, "Here is the activity on entities assigned to you since %s:\n", since.UTC().Format("2006-01-02 15:04 MST"))
+
+	for _, key := range order {
+		body.WriteString("\n" + entityLabel(ctx, s.repos, key.entityType, key.entityID) + "\n")
+		for _, event := range grouped[key] {
+			fmt.Fprintf(&body, "  - %s %s\n", event.CreatedAt.UTC().Format("2006-01-02 15:04"), describeAuditEvent(&event))
+		}
+	}
+
+	if len(events) == maxDigestEvents {
+		fmt.Fprintf(&body, "\nOnly the first %d changes are listed.\n", maxDigestEvents)
+	}
+
+	fmt.Fprintf(&body, "\nTo stop receiving these emails, open %s/api/v1/digest/unsubscribe?token=%s\n",
+		s.baseURL, url.QueryEscape(subscription.UnsubscribeToken))
+	return body.String()
+}
+
+// describeAuditEvent returns a one-line human readable description of an audit event
+func describeAuditEvent(event *models.AuditEvent) string {
+	actor := "someone"
+	if event.Actor != nil {
+		actor = event.Actor.Username
+	}
+
+	switch event.Action {
+	case models.AuditActionCreated:
+		return "created by " + actor
+	case models.AuditActionCommented:
+		return actor + " commented"
+	case models.AuditActionStatusChanged:
+		return fmt.Sprintf("status changed from %s to %s", derefString(event.OldValue), derefString(event.NewValue))
+	case models.AuditActionAssigned:
+		return "assignee changed"
+	case models.AuditActionEdited:
+		return event.Field + " edited"
+	case models.AuditActionRelationshipAdded:
+		return "relationship added"
+	case models.AuditActionRelationshipRemoved:
+		return "relationship removed"
+	case models.AuditActionProposalAccepted:
+		return "change proposed by " + actor + " accepted"
+	case models.AuditActionArchived:
+		return "archived by " + actor
+	case models.AuditActionUnarchived:
+		return "unarchived by " + actor
+	case models.AuditActionFrozen:
+		return fmt.Sprintf("frozen by %s: %s", actor, derefString(event.NewValue))
+	case models.AuditActionUnfrozen:
+		return "unfrozen by " + actor
+	case models.AuditActionMerged:
+		return fmt.Sprintf("%s merged into %s by %s", derefString(event.OldValue), derefString(event.NewValue), actor)
+	default:
+		return string(event.Action)
+	}
+}
+
+// derefString returns the pointed-to string or an empty string for nil
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// RunDigestScheduler sends due digests every interval until the context is cancelled
+func RunDigestScheduler(ctx context.Context, digestService DigestService, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			sent, err := digestService.SendDueDigests(ctx, now)
+			if err != nil {
+				logger.WithError(err).Error("Activity digest run failed")
+				continue
+			}
+			if sent > 0 {
+				logger.WithField("sent", sent).Info("Activity digests sent")
+			}
+		}
+	}
+}
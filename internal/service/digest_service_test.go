@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// MockDigestSubscriptionRepository is a mock implementation of DigestSubscriptionRepository
+type MockDigestSubscriptionRepository struct {
+	mock.Mock
+}
+
+func (m *MockDigestSubscriptionRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.DigestSubscription, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DigestSubscription), args.Error(1)
+}
+
+func (m *MockDigestSubscriptionRepository) GetByUnsubscribeToken(ctx context.Context, token string) (*models.DigestSubscription, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.DigestSubscription), args.Error(1)
+}
+
+func (m *MockDigestSubscriptionRepository) Save(ctx context.Context, subscription *models.DigestSubscription) error {
+	args := m.Called(subscription)
+	return args.Error(0)
+}
+
+func (m *MockDigestSubscriptionRepository) ListActive(ctx context.Context) ([]models.DigestSubscription, error) {
+	args := m.Called()
+	return args.Get(0).([]models.DigestSubscription), args.Error(1)
+}
+
+func (m *MockDigestSubscriptionRepository) GetDB() *gorm.DB {
+	return nil
+}
+
+// MockAuditRepository is a mock implementation of AuditRepository
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) Create(ctx context.Context, event *models.AuditEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) ListByEntity(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, cursor *repository.AuditCursor, limit int) ([]models.AuditEvent, error) {
+	args := m.Called(entityType, entityID, cursor, limit)
+	return args.Get(0).([]models.AuditEvent), args.Error(1)
+}
+
+func (m *MockAuditRepository) ListForUser(ctx context.Context, userID uuid.UUID, cursor *repository.AuditCursor, limit int) ([]models.AuditEvent, error) {
+	args := m.Called(userID, cursor, limit)
+	return args.Get(0).([]models.AuditEvent), args.Error(1)
+}
+
+func (m *MockAuditRepository) ListAssignedSince(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]models.AuditEvent, error) {
+	args := m.Called(userID, since, limit)
+	return args.Get(0).([]models.AuditEvent), args.Error(1)
+}
+
+func (m *MockAuditRepository) ListRecent(ctx context.Context, limit int) ([]models.AuditEvent, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]models.AuditEvent), args.Error(1)
+}
+
+func (m *MockAuditRepository) ListForEpic(ctx context.Context, epicID uuid.UUID, limit int) ([]models.AuditEvent, error) {
+	args := m.Called(epicID, limit)
+	return args.Get(0).([]models.AuditEvent), args.Error(1)
+}
+
+func (m *MockAuditRepository) CreateToolCall(ctx context.Context, call *models.MCPToolCall) error {
+	args := m.Called(call)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) SummarizeToolCalls(ctx context.Context, since time.Time) ([]repository.MCPToolUsage, error) {
+	args := m.Called(since)
+	return args.Get(0).([]repository.MCPToolUsage), args.Error(1)
+}
+
+func (m *MockAuditRepository) SummarizeToolCallsByUser(ctx context.Context, since time.Time, limit int) ([]repository.MCPUserUsage, error) {
+	args := m.Called(since, limit)
+	return args.Get(0).([]repository.MCPUserUsage), args.Error(1)
+}
+
+func (m *MockAuditRepository) ListFailedToolCalls(ctx context.Context, since time.Time, limit int) ([]models.MCPToolCall, error) {
+	args := m.Called(since, limit)
+	return args.Get(0).([]models.MCPToolCall), args.Error(1)
+}
+
+func (m *MockAuditRepository) GetDB() *gorm.DB {
+	return nil
+}
+
+// recordingMailer records sent emails
+type recordingMailer struct {
+	to          []string
+	subject     []string
+	body        []string
+	attachments []MailAttachment
+	err         error
+}
+
+func (m *recordingMailer) Send(to, subject, body string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.to = append(m.to, to)
+	m.subject = append(m.subject, subject)
+	m.body = append(m.body, body)
+	return nil
+}
+
+func (m *recordingMailer) SendWithAttachment(to, subject, body string, attachment MailAttachment) error {
+	if err := m.Send(to, subject, body); err != nil {
+		return err
+	}
+	m.attachments = append(m.attachments, attachment)
+	return nil
+}
+
+func setupDigestService() (*digestService, *MockDigestSubscriptionRepository, *MockAuditRepository, *MockEpicRepository, *recordingMailer) {
+	subscriptionRepo := new(MockDigestSubscriptionRepository)
+	auditRepo := new(MockAuditRepository)
+	epicRepo := new(MockEpicRepository)
+	mailer := &recordingMailer{}
+
+	repos := &repository.Repositories{
+		DigestSubscription: subscriptionRepo,
+		Audit:              auditRepo,
+		Epic:               epicRepo,
+	}
+	service := NewDigestService(repos, mailer, "https://rms.example.com/", logrus.New()).(*digestService)
+	return service, subscriptionRepo, auditRepo, epicRepo, mailer
+}
+
+func TestDigestService_UpdatePreferences(t *testing.T) {
+	ctx := context.Background()
+	service, subscriptionRepo, _, _, _ := setupDigestService()
+	userID := uuid.New()
+
+	subscriptionRepo.On("GetByUserID", userID).Return(nil, repository.ErrNotFound)
+	subscriptionRepo.On("Save", mock.AnythingOfType("*models.DigestSubscription")).Return(nil)
+
+	subscription, err := service.UpdatePreferences(ctx, userID, models.DigestFrequencyDaily)
+	require.NoError(t, err)
+	assert.Equal(t, models.DigestFrequencyDaily, subscription.Frequency)
+	assert.NotEmpty(t, subscription.UnsubscribeToken)
+
+	_, err = service.UpdatePreferences(ctx, userID, "hourly")
+	assert.ErrorIs(t, err, ErrInvalidDigestFrequency)
+}
+
+func TestDigestService_Unsubscribe(t *testing.T) {
+	ctx := context.Background()
+	service, subscriptionRepo, _, _, _ := setupDigestService()
+	subscription := &models.DigestSubscription{UserID: uuid.New(), Frequency: models.DigestFrequencyWeekly, UnsubscribeToken: "token"}
+
+	subscriptionRepo.On("GetByUnsubscribeToken", "token").Return(subscription, nil)
+	subscriptionRepo.On("GetByUnsubscribeToken", "unknown").Return(nil, repository.ErrNotFound)
+	subscriptionRepo.On("Save", subscription).Return(nil)
+
+	require.NoError(t, service.Unsubscribe(ctx, "token"))
+	assert.Equal(t, models.DigestFrequencyNone, subscription.Frequency)
+
+	assert.ErrorIs(t, service.Unsubscribe(ctx, "unknown"), ErrNotFound)
+}
+
+func TestDigestService_SendDueDigests(t *testing.T) {
+	ctx := context.Background()
+	service, subscriptionRepo, auditRepo, epicRepo, mailer := setupDigestService()
+	now := time.Date(2023, 1, 8, 8, 0, 0, 0, time.UTC)
+
+	recentlySent := now.Add(-2 * time.Hour)
+	weekAgo := now.Add(-7 * 24 * time.Hour)
+	due := models.DigestSubscription{
+		UserID: uuid.New(), Frequency: models.DigestFrequencyWeekly, UnsubscribeToken: "due-token",
+		LastSentAt: &weekAgo, User: &models.User{Username: "alice", Email: "alice@example.com"},
+	}
+	notDue := models.DigestSubscription{
+		UserID: uuid.New(), Frequency: models.DigestFrequencyDaily, UnsubscribeToken: "not-due-token",
+		LastSentAt: &recentlySent, User: &models.User{Username: "bob", Email: "bob@example.com"},
+	}
+	quiet := models.DigestSubscription{
+		UserID: uuid.New(), Frequency: models.DigestFrequencyDaily, UnsubscribeToken: "quiet-token",
+		User: &models.User{Username: "carol", Email: "carol@example.com"},
+	}
+
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Authentication"}
+	oldStatus, newStatus := "Backlog", "In Progress"
+	events := []models.AuditEvent{
+		{EntityType: models.EntityTypeEpic, EntityID: epic.ID, Action: models.AuditActionStatusChanged,
+			OldValue: &oldStatus, NewValue: &newStatus, CreatedAt: now.Add(-time.Hour)},
+		{EntityType: models.EntityTypeEpic, EntityID: epic.ID, Action: models.AuditActionCommented,
+			Actor: &models.User{Username: "dave"}, CreatedAt: now.Add(-30 * time.Minute)},
+	}
+
+	subscriptionRepo.On("ListActive").Return([]models.DigestSubscription{due, notDue, quiet}, nil)
+	subscriptionRepo.On("Save", mock.AnythingOfType("*models.DigestSubscription")).Return(nil)
+	auditRepo.On("ListAssignedSince", due.UserID, weekAgo, maxDigestEvents).Return(events, nil)
+	auditRepo.On("ListAssignedSince", quiet.UserID, now.Add(-24*time.Hour), maxDigestEvents).Return([]models.AuditEvent{}, nil)
+	epicRepo.On("GetByID", epic.ID).Return(epic, nil)
+
+	sent, err := service.SendDueDigests(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	require.Len(t, mailer.to, 1)
+	assert.Equal(t, "alice@example.com", mailer.to[0])
+	assert.Equal(t, "Your weekly activity digest", mailer.subject[0])
+	assert.Contains(t, mailer.body[0], "EP-001 Authentication")
+	assert.Contains(t, mailer.body[0], "status changed from Backlog to In Progress")
+	assert.Contains(t, mailer.body[0], "dave commented")
+	assert.Contains(t, mailer.body[0], "https://rms.example.com/api/v1/digest/unsubscribe?token=due-token")
+
+	// Both checked subscriptions advance their schedule, the one not yet due is untouched
+	subscriptionRepo.AssertNumberOfCalls(t, "Save", 2)
+	auditRepo.AssertNotCalled(t, "ListAssignedSince", notDue.UserID, mock.Anything, mock.Anything)
+}
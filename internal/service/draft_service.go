@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrDraftNotFound          = apperrors.New(apperrors.KindNotFound, "DRAFT_NOT_FOUND", "draft not found")
+	ErrDraftConflict          = apperrors.New(apperrors.KindConflict, "DRAFT_CONFLICT", "entity was modified after the draft was started")
+	ErrDraftEmptyDescription  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "draft description cannot be empty")
+	ErrDraftTitleNotSupported = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "acceptance criteria drafts do not support a title")
+)
+
+// DraftService defines the interface for private per-user entity drafts
+type DraftService interface {
+	SaveDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, req SaveDraftRequest) (*DraftResponse, error)
+	GetDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) (*DraftResponse, error)
+	DiscardDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) error
+	ListUserDrafts(ctx context.Context, userID uuid.UUID) ([]models.EntityDraft, error)
+	PublishDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, force bool) (interface{}, error)
+}
+
+// SaveDraftRequest represents the request to create or replace a draft
+// @Description Request payload for saving a private draft of an entity's title and description
+type SaveDraftRequest struct {
+	// Title is the drafted title (ignored for acceptance criteria)
+	// @Description Drafted title (optional, max 500 characters, not supported for acceptance criteria)
+	// @MaxLength 500
+	// @Example "User authentication must support OAuth 2.0 and SAML"
+	Title *string `json:"title,omitempty" binding:"omitempty,max=500"`
+
+	// Description is the drafted description
+	// @Description Drafted description (required, max 50000 characters)
+	// @MaxLength 50000
+	// @Example "The system shall support OAuth 2.0 and SAML authentication flows..."
+	Description string `json:"description" binding:"required,max=50000"`
+}
+
+// DraftResponse represents a draft together with its staleness relative to the published entity
+// @Description Private draft with a flag telling whether the published entity changed since the draft was started
+type DraftResponse struct {
+	Draft *models.EntityDraft `json:"draft"`
+	// IsStale is true when the entity was updated by someone after this draft was started
+	IsStale bool `json:"is_stale" example:"false"`
+}
+
+// draftService implements DraftService interface
+type draftService struct {
+	draftRepo                 repository.DraftRepository
+	repos                     *repository.Repositories
+	epicService               EpicService
+	userStoryService          UserStoryService
+	acceptanceCriteriaService AcceptanceCriteriaService
+	requirementService        RequirementService
+	commentService            CommentService
+	presenceService           PresenceService
+}
+
+// NewDraftService creates a new draft service instance
+func NewDraftService(
+	repos *repository.Repositories,
+	epicService EpicService,
+	userStoryService UserStoryService,
+	acceptanceCriteriaService AcceptanceCriteriaService,
+	requirementService RequirementService,
+	commentService CommentService,
+	presenceService PresenceService,
+) DraftService {
+	return &draftService{
+		draftRepo:                 repos.Draft,
+		repos:                     repos,
+		epicService:               epicService,
+		userStoryService:          userStoryService,
+		acceptanceCriteriaService: acceptanceCriteriaService,
+		requirementService:        requirementService,
+		commentService:            commentService,
+		presenceService:           presenceService,
+	}
+}
+
+// SaveDraft creates or replaces the user's draft for an entity
+func (s *draftService) SaveDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, req SaveDraftRequest) (*DraftResponse, error) {
+	if strings.TrimSpace(req.Description) == "" {
+		return nil, ErrDraftEmptyDescription
+	}
+	if req.Title != nil && entityType == models.EntityTypeAcceptanceCriteria {
+		return nil, ErrDraftTitleNotSupported
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := loadEntityText(ctx, s.repos, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := s.draftRepo.Get(ctx, entityType, entityID, userID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+
+	if draft == nil {
+		draft = &models.EntityDraft{
+			EntityType:    entityType,
+			EntityID:      entityID,
+			UserID:        userID,
+			Title:         req.Title,
+			Description:   req.Description,
+			BaseUpdatedAt: text.UpdatedAt,
+		}
+		if err := s.draftRepo.Create(ctx, draft); err != nil {
+			return nil, fmt.Errorf("failed to create draft: %w", err)
+		}
+	} else {
+		// Keep the original base timestamp so conflicts with edits made meanwhile are still detected
+		draft.Title = req.Title
+		draft.Description = req.Description
+		if err := s.draftRepo.Update(ctx, draft); err != nil {
+			return nil, fmt.Errorf("failed to update draft: %w", err)
+		}
+	}
+
+	return &DraftResponse{Draft: draft, IsStale: draft.IsStale(text.UpdatedAt)}, nil
+}
+
+// GetDraft retrieves the user's draft for an entity
+func (s *draftService) GetDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) (*DraftResponse, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := s.getDraft(ctx, entityType, entityID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := loadEntityText(ctx, s.repos, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DraftResponse{Draft: draft, IsStale: draft.IsStale(text.UpdatedAt)}, nil
+}
+
+// DiscardDraft deletes the user's draft for an entity
+func (s *draftService) DiscardDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) error {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return err
+	}
+
+	if err := s.draftRepo.Delete(ctx, entityType, entityID, userID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrDraftNotFound
+		}
+		return fmt.Errorf("failed to delete draft: %w", err)
+	}
+	return nil
+}
+
+// ListUserDrafts retrieves all drafts owned by a user
+func (s *draftService) ListUserDrafts(ctx context.Context, userID uuid.UUID) ([]models.EntityDraft, error) {
+	drafts, err := s.draftRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts: %w", err)
+	}
+	return drafts, nil
+}
+
+// PublishDraft applies the user's draft to the entity, revalidates inline comments and removes the draft
+// Returns ErrDraftConflict if the entity changed after the draft was started, unless force is set
+func (s *draftService) PublishDraft(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, force bool) (interface{}, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := s.getDraft(ctx, entityType, entityID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.presenceService != nil {
+		if err := s.presenceService.CheckEditAllowed(ctx, entityType, entityID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	text, err := loadEntityText(ctx, s.repos, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if !force && draft.IsStale(text.UpdatedAt) {
+		return nil, ErrDraftConflict
+	}
+
+	description := draft.Description
+	var entity interface{}
+	switch entityType {
+	case models.EntityTypeEpic:
+		entity, err = s.epicService.UpdateEpic(ctx, entityID, UpdateEpicRequest{Title: draft.Title, Description: &description})
+	case models.EntityTypeUserStory:
+		entity, err = s.userStoryService.UpdateUserStory(ctx, entityID, UpdateUserStoryRequest{Title: draft.Title, Description: &description})
+	case models.EntityTypeAcceptanceCriteria:
+		entity, err = s.acceptanceCriteriaService.UpdateAcceptanceCriteria(ctx, entityID, UpdateAcceptanceCriteriaRequest{Description: &description})
+	case models.EntityTypeRequirement:
+		entity, err = s.requirementService.UpdateRequirement(ctx, entityID, UpdateRequirementRequest{Title: draft.Title, Description: &description})
+	default:
+		return nil, ErrInvalidEntityType
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Inline comments are only remapped once the new text is public
+	if text.Description != description {
+		if err := s.commentService.ValidateInlineCommentsAfterTextChange(ctx, entityType, entityID, description); err != nil {
+			return nil, fmt.Errorf("failed to validate inline comments: %w", err)
+		}
+	}
+
+	if err := s.draftRepo.Delete(ctx, entityType, entityID, userID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to delete published draft: %w", err)
+	}
+
+	return entity, nil
+}
+
+// getDraft retrieves a draft and maps repository errors to service errors
+func (s *draftService) getDraft(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) (*models.EntityDraft, error) {
+	draft, err := s.draftRepo.Get(ctx, entityType, entityID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrDraftNotFound
+		}
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+	return draft, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// MockDraftRepository is a mock implementation of DraftRepository
+type MockDraftRepository struct {
+	mock.Mock
+}
+
+func (m *MockDraftRepository) Get(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) (*models.EntityDraft, error) {
+	args := m.Called(entityType, entityID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.EntityDraft), args.Error(1)
+}
+
+func (m *MockDraftRepository) Create(ctx context.Context, draft *models.EntityDraft) error {
+	args := m.Called(draft)
+	return args.Error(0)
+}
+
+func (m *MockDraftRepository) Update(ctx context.Context, draft *models.EntityDraft) error {
+	args := m.Called(draft)
+	return args.Error(0)
+}
+
+func (m *MockDraftRepository) Delete(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) error {
+	args := m.Called(entityType, entityID, userID)
+	return args.Error(0)
+}
+
+func (m *MockDraftRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.EntityDraft, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]models.EntityDraft), args.Error(1)
+}
+
+func (m *MockDraftRepository) GetDB() *gorm.DB {
+	return nil
+}
+
+func newTestDraftService(draftRepo *MockDraftRepository, requirementRepo *MockRequirementRepository) *draftService {
+	return &draftService{
+		draftRepo: draftRepo,
+		repos: &repository.Repositories{
+			Draft:       draftRepo,
+			Requirement: requirementRepo,
+		},
+	}
+}
+
+func TestDraftService_SaveDraft(t *testing.T) {
+	ctx := context.Background()
+	entityID := uuid.New()
+	userID := uuid.New()
+	entityUpdatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	requirement := &models.Requirement{ID: entityID, ReferenceID: "REQ-001", Title: "Original", UpdatedAt: entityUpdatedAt}
+
+	t.Run("creates draft with entity base timestamp", func(t *testing.T) {
+		draftRepo := new(MockDraftRepository)
+		requirementRepo := new(MockRequirementRepository)
+		svc := newTestDraftService(draftRepo, requirementRepo)
+
+		requirementRepo.On("Exists", entityID).Return(true, nil)
+		requirementRepo.On("GetByID", entityID).Return(requirement, nil)
+		draftRepo.On("Get", models.EntityTypeRequirement, entityID, userID).Return(nil, repository.ErrNotFound)
+		draftRepo.On("Create", mock.AnythingOfType("*models.EntityDraft")).Return(nil)
+
+		title := "Rewritten"
+		response, err := svc.SaveDraft(ctx, models.EntityTypeRequirement, entityID.String(), userID, SaveDraftRequest{Title: &title, Description: "New text"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, entityUpdatedAt, response.Draft.BaseUpdatedAt)
+		assert.Equal(t, "New text", response.Draft.Description)
+		assert.False(t, response.IsStale)
+		draftRepo.AssertExpectations(t)
+	})
+
+	t.Run("updating keeps original base timestamp", func(t *testing.T) {
+		draftRepo := new(MockDraftRepository)
+		requirementRepo := new(MockRequirementRepository)
+		svc := newTestDraftService(draftRepo, requirementRepo)
+
+		existing := &models.EntityDraft{EntityType: models.EntityTypeRequirement, EntityID: entityID, UserID: userID, Description: "Old draft", BaseUpdatedAt: entityUpdatedAt.Add(-time.Hour)}
+		requirementRepo.On("Exists", entityID).Return(true, nil)
+		requirementRepo.On("GetByID", entityID).Return(requirement, nil)
+		draftRepo.On("Get", models.EntityTypeRequirement, entityID, userID).Return(existing, nil)
+		draftRepo.On("Update", existing).Return(nil)
+
+		response, err := svc.SaveDraft(ctx, models.EntityTypeRequirement, entityID.String(), userID, SaveDraftRequest{Description: "Second pass"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Second pass", response.Draft.Description)
+		assert.True(t, response.IsStale)
+	})
+
+	t.Run("rejects empty description", func(t *testing.T) {
+		svc := newTestDraftService(new(MockDraftRepository), new(MockRequirementRepository))
+
+		_, err := svc.SaveDraft(ctx, models.EntityTypeRequirement, entityID.String(), userID, SaveDraftRequest{Description: "   "})
+
+		assert.ErrorIs(t, err, ErrDraftEmptyDescription)
+	})
+
+	t.Run("rejects title for acceptance criteria", func(t *testing.T) {
+		svc := newTestDraftService(new(MockDraftRepository), new(MockRequirementRepository))
+
+		title := "Not allowed"
+		_, err := svc.SaveDraft(ctx, models.EntityTypeAcceptanceCriteria, entityID.String(), userID, SaveDraftRequest{Title: &title, Description: "WHEN ... THEN ..."})
+
+		assert.ErrorIs(t, err, ErrDraftTitleNotSupported)
+	})
+}
+
+func TestDraftService_PublishDraft_Conflict(t *testing.T) {
+	ctx := context.Background()
+	entityID := uuid.New()
+	userID := uuid.New()
+	entityUpdatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	draftRepo := new(MockDraftRepository)
+	requirementRepo := new(MockRequirementRepository)
+	svc := newTestDraftService(draftRepo, requirementRepo)
+
+	draft := &models.EntityDraft{EntityType: models.EntityTypeRequirement, EntityID: entityID, UserID: userID, Description: "Draft", BaseUpdatedAt: entityUpdatedAt.Add(-time.Minute)}
+	requirementRepo.On("Exists", entityID).Return(true, nil)
+	requirementRepo.On("GetByID", entityID).Return(&models.Requirement{ID: entityID, UpdatedAt: entityUpdatedAt}, nil)
+	draftRepo.On("Get", models.EntityTypeRequirement, entityID, userID).Return(draft, nil)
+
+	_, err := svc.PublishDraft(ctx, models.EntityTypeRequirement, entityID.String(), userID, false)
+
+	assert.ErrorIs(t, err, ErrDraftConflict)
+	draftRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDraftService_DiscardDraft_NotFound(t *testing.T) {
+	ctx := context.Background()
+	entityID := uuid.New()
+	userID := uuid.New()
+
+	draftRepo := new(MockDraftRepository)
+	requirementRepo := new(MockRequirementRepository)
+	svc := newTestDraftService(draftRepo, requirementRepo)
+
+	requirementRepo.On("Exists", entityID).Return(true, nil)
+	draftRepo.On("Delete", models.EntityTypeRequirement, entityID, userID).Return(repository.ErrNotFound)
+
+	err := svc.DiscardDraft(ctx, models.EntityTypeRequirement, entityID.String(), userID)
+
+	assert.ErrorIs(t, err, ErrDraftNotFound)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// EntityCountService defines the interface for aggregate counts embedded in entity responses with include=
+type EntityCountService interface {
+	RequestedCounts(ctx context.Context, entityType models.EntityType, includes []string) []string
+	Counts(ctx context.Context, entityType models.EntityType, ids []uuid.UUID, counts []string) (map[uuid.UUID]map[string]int64, error)
+	CommentCounts(ctx context.Context, entityType models.EntityType, ids []uuid.UUID) (map[uuid.UUID]repository.CommentCounts, error)
+}
+
+// EntityWithFields is an entity in a response together with fields requested with include=,
+// such as is_favorite or comments_count
+type EntityWithFields struct {
+	Entity json.Marshaler
+	Fields map[string]interface{}
+}
+
+// MarshalJSON implements custom JSON marshaling for EntityWithFields, adding the fields to the entity fields
+func (e EntityWithFields) MarshalJSON() ([]byte, error) {
+	return marshalWithFields(e.Entity, e.Fields)
+}
+
+// entityCountService implements EntityCountService interface
+type entityCountService struct {
+	repos *repository.Repositories
+}
+
+// NewEntityCountService creates a new entity count service instance
+func NewEntityCountService(repos *repository.Repositories) EntityCountService {
+	return &entityCountService{repos: repos}
+}
+
+// RequestedCounts returns the include values naming counts available for an entity type, in the order they are supported
+func (s *entityCountService) RequestedCounts(ctx context.Context, entityType models.EntityType, includes []string) []string {
+	requested := make(map[string]bool, len(includes))
+	for _, include := range includes {
+		requested[include] = true
+	}
+
+	var counts []string
+	for _, name := range s.repos.EntityCount.SupportedCounts(ctx, entityType) {
+		if requested[name] {
+			counts = append(counts, name)
+		}
+	}
+	return counts
+}
+
+// Counts computes the given counts of a list of entities in a single query. Every entity gets
+// every count, zero when the entity was not found.
+func (s *entityCountService) Counts(ctx context.Context, entityType models.EntityType, ids []uuid.UUID, counts []string) (map[uuid.UUID]map[string]int64, error) {
+	found, err := s.repos.EntityCount.CountAggregates(ctx, entityType, ids, counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count %s aggregates: %w", entityType, err)
+	}
+
+	result := make(map[uuid.UUID]map[string]int64, len(ids))
+	for _, id := range ids {
+		entityCounts := make(map[string]int64, len(counts))
+		for _, name := range counts {
+			entityCounts[name] = found[id][name]
+		}
+		result[id] = entityCounts
+	}
+	return result, nil
+}
+
+// CommentCounts returns the total and unresolved comment counts of a list of entities from a single
+// grouped query. Every entity gets counts, zero when it has no comments.
+func (s *entityCountService) CommentCounts(ctx context.Context, entityType models.EntityType, ids []uuid.UUID) (map[uuid.UUID]repository.CommentCounts, error) {
+	found, err := s.repos.Comment.CountByEntities(ctx, entityType, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count %s comments: %w", entityType, err)
+	}
+
+	result := make(map[uuid.UUID]repository.CommentCounts, len(ids))
+	for _, id := range ids {
+		result[id] = found[id]
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEntityCountService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, user, epic, requirements := setupSupersessionTest(t, active, active)
+	require.NoError(t, db.AutoMigrate(&models.Comment{}))
+	svc := NewEntityCountService(repository.NewRepositories(db, nil))
+
+	for _, resolved := range []bool{false, false, true} {
+		require.NoError(t, db.Create(&models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: user.ID,
+			Content: "Needs a rollout plan", IsResolved: resolved}).Error)
+	}
+	var dependsOn models.RelationshipType
+	require.NoError(t, db.Where("name = ?", "depends_on").First(&dependsOn).Error)
+	require.NoError(t, db.Create(&models.RequirementRelationship{SourceRequirementID: requirements[0].ID,
+		TargetRequirementID: requirements[1].ID, RelationshipTypeID: dependsOn.ID, CreatedBy: user.ID}).Error)
+
+	t.Run("filters includes to the counts of the entity type", func(t *testing.T) {
+		counts := svc.RequestedCounts(ctx, models.EntityTypeEpic, []string{"creator", "user_stories_count", "is_favorite", "comments_count", "requirements_count"})
+		assert.Equal(t, []string{"comments_count", "user_stories_count"}, counts)
+		assert.Empty(t, svc.RequestedCounts(ctx, models.EntityTypeRequirement, []string{"user_stories_count"}))
+	})
+
+	t.Run("counts aggregates of every listed entity", func(t *testing.T) {
+		missing := uuid.New()
+		counts, err := svc.Counts(ctx, models.EntityTypeEpic, []uuid.UUID{epic.ID, missing},
+			[]string{"comments_count", "unresolved_comments_count", "user_stories_count"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"comments_count": 3, "unresolved_comments_count": 2, "user_stories_count": 1}, counts[epic.ID])
+		assert.Equal(t, map[string]int64{"comments_count": 0, "unresolved_comments_count": 0, "user_stories_count": 0}, counts[missing])
+
+		counts, err = svc.Counts(ctx, models.EntityTypeRequirement, []uuid.UUID{requirements[0].ID, requirements[1].ID}, []string{"relationships_count"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), counts[requirements[0].ID]["relationships_count"])
+		assert.Equal(t, int64(1), counts[requirements[1].ID]["relationships_count"])
+
+		counts, err = svc.Counts(ctx, models.EntityTypeUserStory, []uuid.UUID{requirements[0].UserStoryID}, []string{"acceptance_criteria_count", "requirements_count"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"acceptance_criteria_count": 0, "requirements_count": 2}, counts[requirements[0].UserStoryID])
+	})
+
+	t.Run("counts comments of every listed entity", func(t *testing.T) {
+		missing := uuid.New()
+		counts, err := svc.CommentCounts(ctx, models.EntityTypeEpic, []uuid.UUID{epic.ID, missing})
+		require.NoError(t, err)
+		assert.Equal(t, repository.CommentCounts{Total: 3, Unresolved: 2}, counts[epic.ID])
+		assert.Equal(t, repository.CommentCounts{}, counts[missing])
+
+		counts, err = svc.CommentCounts(ctx, models.EntityTypeUserStory, []uuid.UUID{epic.ID})
+		require.NoError(t, err)
+		assert.Equal(t, repository.CommentCounts{}, counts[epic.ID], "comments are counted per entity type")
+	})
+
+	t.Run("embeds fields in the entity JSON", func(t *testing.T) {
+		data, err := json.Marshal(EntityWithFields{Entity: epic, Fields: map[string]interface{}{"comments_count": 3, "is_favorite": true}})
+		require.NoError(t, err)
+		var decoded map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, "EP-001", decoded["reference_id"])
+		assert.Equal(t, float64(3), decoded["comments_count"])
+		assert.Equal(t, true, decoded["is_favorite"])
+	})
+}
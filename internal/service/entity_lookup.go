@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// resolveEntityID resolves a UUID or reference ID (EP-001, US-001, AC-001, REQ-001) to the entity UUID
+// Returns ErrInvalidEntityType for unknown entity types and ErrNotFound if the entity does not exist
+func resolveEntityID(ctx context.Context, repos *repository.Repositories, entityType models.EntityType, idOrReference string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(idOrReference); err == nil {
+		if err := checkEntityExists(ctx, repos, entityType, id); err != nil {
+			return uuid.Nil, err
+		}
+		return id, nil
+	}
+
+	var (
+		id  uuid.UUID
+		err error
+	)
+	switch entityType {
+	case models.EntityTypeEpic:
+		var epic *models.Epic
+		if epic, err = repos.Epic.GetByReferenceID(ctx, idOrReference); err == nil {
+			id = epic.ID
+		}
+	case models.EntityTypeUserStory:
+		var userStory *models.UserStory
+		if userStory, err = repos.UserStory.GetByReferenceID(ctx, idOrReference); err == nil {
+			id = userStory.ID
+		}
+	case models.EntityTypeAcceptanceCriteria:
+		var acceptanceCriteria *models.AcceptanceCriteria
+		if acceptanceCriteria, err = repos.AcceptanceCriteria.GetByReferenceID(ctx, idOrReference); err == nil {
+			id = acceptanceCriteria.ID
+		}
+	case models.EntityTypeRequirement:
+		var requirement *models.Requirement
+		if requirement, err = repos.Requirement.GetByReferenceID(ctx, idOrReference); err == nil {
+			id = requirement.ID
+		}
+	default:
+		return uuid.Nil, ErrInvalidEntityType
+	}
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return uuid.Nil, ErrNotFound
+		}
+		return uuid.Nil, fmt.Errorf("failed to resolve %s reference ID: %w", entityType, err)
+	}
+	return id, nil
+}
+
+// checkEntityExists validates that an entity of the given type exists
+func checkEntityExists(ctx context.Context, repos *repository.Repositories, entityType models.EntityType, id uuid.UUID) error {
+	var (
+		exists bool
+		err    error
+	)
+	switch entityType {
+	case models.EntityTypeEpic:
+		exists, err = repos.Epic.Exists(ctx, id)
+	case models.EntityTypeUserStory:
+		exists, err = repos.UserStory.Exists(ctx, id)
+	case models.EntityTypeAcceptanceCriteria:
+		exists, err = repos.AcceptanceCriteria.Exists(ctx, id)
+	case models.EntityTypeRequirement:
+		exists, err = repos.Requirement.Exists(ctx, id)
+	default:
+		return ErrInvalidEntityType
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check %s existence: %w", entityType, err)
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// entityText holds the editable text fields shared by all commentable entities
+type entityText struct {
+	ReferenceID string
+	Title       string
+	Description string
+	UpdatedAt   time.Time
+}
+
+// displayTitleLength is the length acceptance criteria descriptions are cut to when used as titles
+const displayTitleLength = 100
+
+// displayTitle returns the title, or the start of the description for entities without one
+func (t *entityText) displayTitle() string {
+	if t.Title != "" {
+		return t.Title
+	}
+	title := strings.Join(strings.Fields(t.Description), " ")
+	if runes := []rune(title); len(runes) > displayTitleLength {
+		title = string(runes[:displayTitleLength-1]) + "…"
+	}
+	return title
+}
+
+// loadEntityText loads the reference ID, title and description of an entity
+// Acceptance criteria have no title; their Title is left empty
+func loadEntityText(ctx context.Context, repos *repository.Repositories, entityType models.EntityType, id uuid.UUID) (*entityText, error) {
+	text := &entityText{}
+	var err error
+
+	switch entityType {
+	case models.EntityTypeEpic:
+		var epic *models.Epic
+		if epic, err = repos.Epic.GetByID(ctx, id); err == nil {
+			text.ReferenceID, text.Title, text.UpdatedAt = epic.ReferenceID, epic.Title, epic.UpdatedAt
+			if epic.Description != nil {
+				text.Description = *epic.Description
+			}
+		}
+	case models.EntityTypeUserStory:
+		var userStory *models.UserStory
+		if userStory, err = repos.UserStory.GetByID(ctx, id); err == nil {
+			text.ReferenceID, text.Title, text.UpdatedAt = userStory.ReferenceID, userStory.Title, userStory.UpdatedAt
+			if userStory.Description != nil {
+				text.Description = *userStory.Description
+			}
+		}
+	case models.EntityTypeAcceptanceCriteria:
+		var acceptanceCriteria *models.AcceptanceCriteria
+		if acceptanceCriteria, err = repos.AcceptanceCriteria.GetByID(ctx, id); err == nil {
+			text.ReferenceID, text.UpdatedAt = acceptanceCriteria.ReferenceID, acceptanceCriteria.UpdatedAt
+			text.Description = acceptanceCriteria.Description
+		}
+	case models.EntityTypeRequirement:
+		var requirement *models.Requirement
+		if requirement, err = repos.Requirement.GetByID(ctx, id); err == nil {
+			text.ReferenceID, text.Title, text.UpdatedAt = requirement.ReferenceID, requirement.Title, requirement.UpdatedAt
+			if requirement.Description != nil {
+				text.Description = *requirement.Description
+			}
+		}
+	default:
+		return nil, ErrInvalidEntityType
+	}
+
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to load %s: %w", entityType, err)
+	}
+	return text, nil
+}
+
+// entityLabel returns the reference ID and title of an entity, falling back to its type and ID if it was deleted
+func entityLabel(ctx context.Context, repos *repository.Repositories, entityType models.EntityType, entityID uuid.UUID) string {
+	text, err := loadEntityText(ctx, repos, entityType, entityID)
+	if err != nil {
+		return fmt.Sprintf("%s %s", entityType, entityID)
+	}
+	if text.Title == "" {
+		return text.ReferenceID
+	}
+	return text.ReferenceID + " " + text.Title
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Link directions relative to the entity the links are listed for
+const (
+	LinkDirectionOutgoing = "outgoing"
+	LinkDirectionIncoming = "incoming"
+)
+
+var (
+	ErrSelfRelationship           = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "an entity cannot be linked to itself")
+	ErrRelationshipTypeNotAllowed = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "relationship type does not allow links between these entity types")
+	ErrEntityRelationshipNotFound = apperrors.New(apperrors.KindNotFound, "RELATIONSHIP_NOT_FOUND", "entity relationship not found")
+)
+
+// EntityRelationshipService defines the interface for typed links between entities of any type
+type EntityRelationshipService interface {
+	CreateLink(ctx context.Context, req CreateEntityLinkRequest) (*EntityLink, error)
+	ListLinks(ctx context.Context, entityType models.EntityType, idOrReference string) ([]EntityLink, error)
+	DeleteLink(ctx context.Context, id uuid.UUID) error
+}
+
+// CreateEntityLinkRequest represents the request to link two entities
+type CreateEntityLinkRequest struct {
+	SourceType models.EntityType `json:"source_type" binding:"required" example:"epic"`
+	SourceID   string            `json:"source_id" binding:"required" example:"EP-001"` // UUID or reference ID
+	TargetType models.EntityType `json:"target_type" binding:"required" example:"epic"`
+	TargetID   string            `json:"target_id" binding:"required" example:"EP-002"` // UUID or reference ID
+	// RelationshipType is the name or UUID of the relationship type
+	RelationshipType string    `json:"relationship_type" binding:"required" example:"duplicates"`
+	CreatedBy        uuid.UUID `json:"-"`
+}
+
+// LinkedEntity identifies one end of a link
+// @Description Entity at one end of a link
+type LinkedEntity struct {
+	EntityType  models.EntityType `json:"entity_type" example:"epic"`
+	ID          uuid.UUID         `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string            `json:"reference_id" example:"EP-001"`
+	Title       string            `json:"title" example:"User Authentication System"`
+}
+
+// EntityLink is a typed link between two entities
+// @Description Typed link between two entities; direction is relative to the entity the links were listed for
+type EntityLink struct {
+	ID                 uuid.UUID    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	RelationshipTypeID uuid.UUID    `json:"relationship_type_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	RelationshipType   string       `json:"relationship_type" example:"duplicates"`
+	Direction          string       `json:"direction" example:"outgoing"`
+	Source             LinkedEntity `json:"source"`
+	Target             LinkedEntity `json:"target"`
+	CreatedBy          uuid.UUID    `json:"created_by" example:"123e4567-e89b-12d3-a456-426614174002"`
+	CreatedAt          time.Time    `json:"created_at" example:"2023-01-01T10:00:00Z"`
+}
+
+// entityRelationshipService implements EntityRelationshipService interface
+type entityRelationshipService struct {
+	repos *repository.Repositories
+}
+
+// NewEntityRelationshipService creates a new entity relationship service instance
+func NewEntityRelationshipService(repos *repository.Repositories) EntityRelationshipService {
+	return &entityRelationshipService{repos: repos}
+}
+
+// CreateLink links two entities after checking that the relationship type allows their entity types.
+// Links between two requirements are stored as requirement relationships so the requirement
+// relationship endpoints keep seeing them.
+func (s *entityRelationshipService) CreateLink(ctx context.Context, req CreateEntityLinkRequest) (*EntityLink, error) {
+	if !models.IsValidEntityType(req.SourceType) || !models.IsValidEntityType(req.TargetType) {
+		return nil, ErrInvalidEntityType
+	}
+
+	relationshipType, err := s.relationshipType(ctx, req.RelationshipType)
+	if err != nil {
+		return nil, err
+	}
+	if !relationshipType.AllowsLink(req.SourceType, req.TargetType) {
+		return nil, ErrRelationshipTypeNotAllowed
+	}
+
+	sourceID, err := resolveEntityID(ctx, s.repos, req.SourceType, req.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := resolveEntityID(ctx, s.repos, req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if req.SourceType == req.TargetType && sourceID == targetID {
+		return nil, ErrSelfRelationship
+	}
+
+	link := EntityLink{
+		RelationshipTypeID: relationshipType.ID,
+		RelationshipType:   relationshipType.Name,
+		Direction:          LinkDirectionOutgoing,
+		CreatedBy:          req.CreatedBy,
+	}
+
+	if req.SourceType == models.EntityTypeRequirement && req.TargetType == models.EntityTypeRequirement {
+		exists, err := s.repos.RequirementRelationship.ExistsRelationship(ctx, sourceID, targetID, relationshipType.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing relationship: %w", err)
+		}
+		if exists {
+			return nil, ErrDuplicateRelationship
+		}
+
+		relationship := &models.RequirementRelationship{
+			SourceRequirementID: sourceID,
+			TargetRequirementID: targetID,
+			RelationshipTypeID:  relationshipType.ID,
+			CreatedBy:           req.CreatedBy,
+		}
+		if err := s.repos.RequirementRelationship.Create(ctx, relationship); err != nil {
+			return nil, fmt.Errorf("failed to create relationship: %w", err)
+		}
+		link.ID, link.CreatedAt = relationship.ID, relationship.CreatedAt
+	} else {
+		exists, err := s.repos.EntityRelationship.Exists(ctx, req.SourceType, sourceID, req.TargetType, targetID, relationshipType.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check existing relationship: %w", err)
+		}
+		if exists {
+			return nil, ErrDuplicateRelationship
+		}
+
+		relationship := &models.EntityRelationship{
+			SourceType:         req.SourceType,
+			SourceID:           sourceID,
+			TargetType:         req.TargetType,
+			TargetID:           targetID,
+			RelationshipTypeID: relationshipType.ID,
+			CreatedBy:          req.CreatedBy,
+		}
+		if err := s.repos.EntityRelationship.Create(ctx, relationship); err != nil {
+			return nil, fmt.Errorf("failed to create relationship: %w", err)
+		}
+		link.ID, link.CreatedAt = relationship.ID, relationship.CreatedAt
+	}
+
+	if link.Source, err = s.linkedEntity(ctx, req.SourceType, sourceID); err != nil {
+		return nil, err
+	}
+	if link.Target, err = s.linkedEntity(ctx, req.TargetType, targetID); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// ListLinks returns the links from and to an entity, including requirement relationships, oldest first.
+// Links whose other end no longer exists are skipped.
+func (s *entityRelationshipService) ListLinks(ctx context.Context, entityType models.EntityType, idOrReference string) ([]EntityLink, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	relationships, err := s.repos.EntityRelationship.ListByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relationships: %w", err)
+	}
+
+	links := make([]EntityLink, 0, len(relationships))
+	add := func(id uuid.UUID, relationshipType models.RelationshipType, sourceType models.EntityType, sourceID uuid.UUID,
+		targetType models.EntityType, targetID uuid.UUID, createdBy uuid.UUID, createdAt time.Time) error {
+		link := EntityLink{
+			ID:                 id,
+			RelationshipTypeID: relationshipType.ID,
+			RelationshipType:   relationshipType.Name,
+			Direction:          LinkDirectionOutgoing,
+			CreatedBy:          createdBy,
+			CreatedAt:          createdAt,
+		}
+		if targetType == entityType && targetID == entityID {
+			link.Direction = LinkDirectionIncoming
+		}
+
+		var err error
+		if link.Source, err = s.linkedEntity(ctx, sourceType, sourceID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		if link.Target, err = s.linkedEntity(ctx, targetType, targetID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		links = append(links, link)
+		return nil
+	}
+
+	for _, relationship := range relationships {
+		if err := add(relationship.ID, relationship.RelationshipType, relationship.SourceType, relationship.SourceID,
+			relationship.TargetType, relationship.TargetID, relationship.CreatedBy, relationship.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if entityType == models.EntityTypeRequirement {
+		requirementRelationships, err := s.repos.RequirementRelationship.GetByRequirement(ctx, entityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list requirement relationships: %w", err)
+		}
+		// Requirement relationships are loaded without their types
+		types := make(map[uuid.UUID]models.RelationshipType)
+		for _, relationship := range requirementRelationships {
+			relationshipType, ok := types[relationship.RelationshipTypeID]
+			if !ok {
+				loaded, err := s.repos.RelationshipType.GetByID(ctx, relationship.RelationshipTypeID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get relationship type: %w", err)
+				}
+				relationshipType = *loaded
+				types[relationship.RelationshipTypeID] = relationshipType
+			}
+			if err := add(relationship.ID, relationshipType, models.EntityTypeRequirement, relationship.SourceRequirementID,
+				models.EntityTypeRequirement, relationship.TargetRequirementID, relationship.CreatedBy, relationship.CreatedAt); err != nil {
+				return nil, err
+			}
+		}
+		sort.SliceStable(links, func(i, j int) bool {
+			return links[i].CreatedAt.Before(links[j].CreatedAt)
+		})
+	}
+
+	return links, nil
+}
+
+// DeleteLink removes a link, whether it is an entity relationship or a requirement relationship
+func (s *entityRelationshipService) DeleteLink(ctx context.Context, id uuid.UUID) error {
+	err := s.repos.EntityRelationship.Delete(ctx, id)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("failed to delete relationship: %w", err)
+	}
+
+	if _, err := s.repos.RequirementRelationship.GetByID(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrEntityRelationshipNotFound
+		}
+		return fmt.Errorf("failed to get relationship: %w", err)
+	}
+	if err := s.repos.RequirementRelationship.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete relationship: %w", err)
+	}
+	return nil
+}
+
+// relationshipType looks up a relationship type by UUID or name
+func (s *entityRelationshipService) relationshipType(ctx context.Context, idOrName string) (*models.RelationshipType, error) {
+	var (
+		relationshipType *models.RelationshipType
+		err              error
+	)
+	if id, parseErr := uuid.Parse(idOrName); parseErr == nil {
+		relationshipType, err = s.repos.RelationshipType.GetByID(ctx, id)
+	} else {
+		relationshipType, err = s.repos.RelationshipType.GetByName(ctx, idOrName)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrRelationshipTypeNotFound
+		}
+		return nil, fmt.Errorf("failed to get relationship type: %w", err)
+	}
+	return relationshipType, nil
+}
+
+// linkedEntity loads the reference ID and title of one end of a link
+func (s *entityRelationshipService) linkedEntity(ctx context.Context, entityType models.EntityType, id uuid.UUID) (LinkedEntity, error) {
+	text, err := loadEntityText(ctx, s.repos, entityType, id)
+	if err != nil {
+		return LinkedEntity{}, err
+	}
+	return LinkedEntity{
+		EntityType:  entityType,
+		ID:          id,
+		ReferenceID: text.ReferenceID,
+		Title:       text.displayTitle(),
+	}, nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEntityRelationshipService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.RelationshipType{}, &models.RequirementRelationship{}, &models.EntityRelationship{}))
+	for _, relationshipType := range models.GetDefaultRelationshipTypes() {
+		require.NoError(t, db.Create(&relationshipType).Error)
+	}
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epics := []models.Epic{
+		{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout"},
+		{ID: uuid.New(), ReferenceID: "EP-002", Title: "Checkout v2"},
+	}
+	for i := range epics {
+		epics[i].Status, epics[i].CreatorID, epics[i].AssigneeID = models.EpicStatusBacklog, user.ID, user.ID
+		require.NoError(t, session.Create(&epics[i]).Error)
+	}
+	story := &models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: epics[0].ID, Title: "Pay by card", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(story).Error)
+	requirements := []models.Requirement{
+		{ID: uuid.New(), ReferenceID: "REQ-001", Title: "Card validation"},
+		{ID: uuid.New(), ReferenceID: "REQ-002", Title: "Card tokenization"},
+	}
+	for i := range requirements {
+		requirements[i].UserStoryID, requirements[i].CreatorID, requirements[i].AssigneeID = story.ID, user.ID, user.ID
+		require.NoError(t, session.Create(&requirements[i]).Error)
+	}
+
+	svc := NewEntityRelationshipService(repository.NewRepositories(db, nil))
+
+	t.Run("links entities of any type", func(t *testing.T) {
+		link, err := svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeEpic, SourceID: "EP-002",
+			TargetType: models.EntityTypeEpic, TargetID: epics[0].ID.String(),
+			RelationshipType: "duplicates", CreatedBy: user.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "duplicates", link.RelationshipType)
+		assert.Equal(t, "EP-002", link.Source.ReferenceID)
+		assert.Equal(t, "Checkout", link.Target.Title)
+
+		_, err = svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeUserStory, SourceID: "US-001",
+			TargetType: models.EntityTypeRequirement, TargetID: "REQ-001",
+			RelationshipType: "depends_on", CreatedBy: user.ID,
+		})
+		require.NoError(t, err)
+
+		_, err = svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeEpic, SourceID: "EP-002",
+			TargetType: models.EntityTypeEpic, TargetID: "EP-001",
+			RelationshipType: "duplicates", CreatedBy: user.ID,
+		})
+		assert.ErrorIs(t, err, ErrDuplicateRelationship)
+	})
+
+	t.Run("enforces the relationship type rules", func(t *testing.T) {
+		_, err := svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeEpic, SourceID: "EP-001",
+			TargetType: models.EntityTypeUserStory, TargetID: "US-001",
+			RelationshipType: "duplicates", CreatedBy: user.ID,
+		})
+		assert.ErrorIs(t, err, ErrRelationshipTypeNotAllowed)
+
+		_, err = svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeEpic, SourceID: "EP-001",
+			TargetType: models.EntityTypeEpic, TargetID: "EP-001",
+			RelationshipType: "relates_to", CreatedBy: user.ID,
+		})
+		assert.ErrorIs(t, err, ErrSelfRelationship)
+
+		_, err = svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeEpic, SourceID: "EP-001",
+			TargetType: models.EntityTypeEpic, TargetID: "EP-404",
+			RelationshipType: "relates_to", CreatedBy: user.ID,
+		})
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeEpic, SourceID: "EP-001",
+			TargetType: models.EntityTypeEpic, TargetID: "EP-002",
+			RelationshipType: "supersedes", CreatedBy: user.ID,
+		})
+		assert.ErrorIs(t, err, ErrRelationshipTypeNotFound)
+	})
+
+	t.Run("stores requirement links as requirement relationships", func(t *testing.T) {
+		link, err := svc.CreateLink(ctx, CreateEntityLinkRequest{
+			SourceType: models.EntityTypeRequirement, SourceID: "REQ-002",
+			TargetType: models.EntityTypeRequirement, TargetID: "REQ-001",
+			RelationshipType: "derives_from", CreatedBy: user.ID,
+		})
+		require.NoError(t, err)
+
+		var count int64
+		require.NoError(t, db.Model(&models.RequirementRelationship{}).Where("id = ?", link.ID).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+
+		links, err := svc.ListLinks(ctx, models.EntityTypeRequirement, "REQ-001")
+		require.NoError(t, err)
+		require.Len(t, links, 2)
+		assert.Equal(t, "depends_on", links[0].RelationshipType)
+		assert.Equal(t, LinkDirectionIncoming, links[0].Direction)
+		assert.Equal(t, "US-001", links[0].Source.ReferenceID)
+		assert.Equal(t, "derives_from", links[1].RelationshipType)
+		assert.Equal(t, LinkDirectionIncoming, links[1].Direction)
+
+		require.NoError(t, svc.DeleteLink(ctx, link.ID))
+		links, err = svc.ListLinks(ctx, models.EntityTypeRequirement, "REQ-001")
+		require.NoError(t, err)
+		assert.Len(t, links, 1)
+	})
+
+	t.Run("lists and deletes links", func(t *testing.T) {
+		links, err := svc.ListLinks(ctx, models.EntityTypeEpic, "EP-002")
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, LinkDirectionOutgoing, links[0].Direction)
+
+		require.NoError(t, svc.DeleteLink(ctx, links[0].ID))
+		links, err = svc.ListLinks(ctx, models.EntityTypeEpic, "EP-001")
+		require.NoError(t, err)
+		assert.Empty(t, links)
+
+		assert.ErrorIs(t, svc.DeleteLink(ctx, uuid.New()), ErrEntityRelationshipNotFound)
+	})
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	"product-requirements-management/internal/repository"
+)
+
+// EntityServices are the services of the entity hierarchy, sharing one set of repositories
+type EntityServices struct {
+	Epic               EpicService
+	User               UserService
+	UserStory          UserStoryService
+	AcceptanceCriteria AcceptanceCriteriaService
+	Requirement        RequirementService
+}
+
+// NewEntityServices creates the entity services on the given repositories.
+// New epics, user stories and requirements without an assignee are assigned by the assignment rules,
+// and retired reference IDs, such as those of merged-away epics, resolve to their successors.
+func NewEntityServices(repos *repository.Repositories) *EntityServices {
+	services := &EntityServices{
+		Epic:               NewEpicService(repos.Epic, repos.User),
+		User:               NewUserService(repos.User),
+		UserStory:          NewUserStoryService(repos.UserStory, repos.Epic, repos.User),
+		AcceptanceCriteria: NewAcceptanceCriteriaService(repos.AcceptanceCriteria, repos.UserStory, repos.User),
+		Requirement: NewRequirementService(
+			repos.Requirement,
+			repos.RequirementType,
+			repos.RelationshipType,
+			repos.RequirementRelationship,
+			repos.UserStory,
+			repos.AcceptanceCriteria,
+			repos.User,
+		),
+	}
+
+	if repos.AssignmentRule != nil {
+		assignmentRules := NewAssignmentRuleService(repos)
+		services.Epic.(*epicService).assignmentRules = assignmentRules
+		services.UserStory.(*userStoryService).assignmentRules = assignmentRules
+		services.Requirement.(*requirementService).assignmentRules = assignmentRules
+	}
+	if repos.ReferenceRedirect != nil {
+		services.Epic.(*epicService).redirectRepo = repos.ReferenceRedirect
+		services.UserStory.(*userStoryService).redirectRepo = repos.ReferenceRedirect
+		services.AcceptanceCriteria.(*acceptanceCriteriaService).redirectRepo = repos.ReferenceRedirect
+		services.Requirement.(*requirementService).redirectRepo = repos.ReferenceRedirect
+	}
+	return services
+}
+
+// EntityUnitOfWork runs operations across several entity services atomically
+type EntityUnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context, services *EntityServices) error) error
+}
+
+// entityUnitOfWork implements EntityUnitOfWork interface
+type entityUnitOfWork struct {
+	uow repository.UnitOfWork
+}
+
+// NewEntityUnitOfWork creates a unit of work that binds entity services to its transactions
+func NewEntityUnitOfWork(uow repository.UnitOfWork) EntityUnitOfWork {
+	return &entityUnitOfWork{uow: uow}
+}
+
+// Do runs fn with entity services bound to the transaction of the unit of work.
+// A unit of work already running in ctx is joined, so nothing commits before the outermost one does.
+func (u *entityUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, services *EntityServices) error) error {
+	return u.uow.Do(ctx, func(ctx context.Context, tx *repository.Repositories) error {
+		return fn(ctx, NewEntityServices(tx))
+	})
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEntityUnitOfWork(t *testing.T) {
+	ctx := context.Background()
+	useSequentialReferenceIDs(t)
+	db, user, _, _ := setupSupersessionTest(t)
+	require.NoError(t, db.AutoMigrate(&models.AssignmentRule{}))
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	repos := repository.NewRepositories(db, nil)
+	uow := NewEntityUnitOfWork(repository.NewUnitOfWork(repos))
+
+	description := "As a customer, I want a feature, so that I get value"
+	createEpicWithStory := func(services *EntityServices, title string) (*models.Epic, *models.UserStory, error) {
+		epic, err := services.Epic.CreateEpic(ctx, CreateEpicRequest{CreatorID: user.ID, Priority: models.PriorityHigh, Title: title})
+		if err != nil {
+			return nil, nil, err
+		}
+		story, err := services.UserStory.CreateUserStory(ctx, CreateUserStoryRequest{
+			EpicID: epic.ID, CreatorID: user.ID, Priority: models.PriorityMedium, Title: title + " story", Description: &description,
+		})
+		return epic, story, err
+	}
+
+	t.Run("commits changes of all services together", func(t *testing.T) {
+		var epic *models.Epic
+		var story *models.UserStory
+		err := uow.Do(context.Background(), func(ctx context.Context, services *EntityServices) error {
+			var err error
+			epic, story, err = createEpicWithStory(services, "Billing")
+			return err
+		})
+		require.NoError(t, err)
+
+		_, err = repos.Epic.GetByID(ctx, epic.ID)
+		assert.NoError(t, err)
+		_, err = repos.UserStory.GetByID(ctx, story.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rolls back every service when one fails", func(t *testing.T) {
+		var epic *models.Epic
+		err := uow.Do(context.Background(), func(ctx context.Context, services *EntityServices) error {
+			var err error
+			epic, err = services.Epic.CreateEpic(ctx, CreateEpicRequest{CreatorID: user.ID, Priority: models.PriorityHigh, Title: "Reports"})
+			require.NoError(t, err)
+			_, err = services.UserStory.CreateUserStory(ctx, CreateUserStoryRequest{
+				EpicID: epic.ID, CreatorID: user.ID, Priority: models.Priority(9), Title: "Broken",
+			})
+			return err
+		})
+		assert.ErrorIs(t, err, ErrInvalidPriority)
+
+		_, err = repos.Epic.GetByID(ctx, epic.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	t.Run("nested units of work commit with the outermost one", func(t *testing.T) {
+		errAborted := errors.New("aborted")
+		var epic *models.Epic
+		err := uow.Do(context.Background(), func(ctx context.Context, _ *EntityServices) error {
+			err := uow.Do(ctx, func(ctx context.Context, services *EntityServices) error {
+				var err error
+				epic, _, err = createEpicWithStory(services, "Exports")
+				return err
+			})
+			require.NoError(t, err)
+			return errAborted
+		})
+		assert.ErrorIs(t, err, errAborted)
+
+		_, err = repos.Epic.GetByID(ctx, epic.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
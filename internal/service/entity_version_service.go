@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrVersionNotFound     = apperrors.New(apperrors.KindNotFound, "VERSION_NOT_FOUND", "version not found")
+	ErrInvalidVersionRange = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "from_version must be lower than to_version")
+)
+
+// EntityVersionService defines the interface for entity text version history and diffs
+type EntityVersionService interface {
+	ListVersions(ctx context.Context, entityType models.EntityType, idOrReference string) ([]models.EntityVersion, error)
+	DiffVersions(ctx context.Context, entityType models.EntityType, idOrReference string, fromVersion, toVersion *int) (*VersionDiffResponse, error)
+}
+
+// VersionDiffResponse represents a word-level diff of an entity's description between two versions
+// @Description Word-level diff of an entity's description between two versions. Version 0 stands for the empty text before the entity was created.
+type VersionDiffResponse struct {
+	EntityType models.EntityType `json:"entity_type" example:"requirement"`
+	EntityID   uuid.UUID         `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+
+	// FromVersion is the base version of the diff
+	FromVersion int `json:"from_version" example:"2"`
+	// ToVersion is the target version of the diff
+	ToVersion int `json:"to_version" example:"3"`
+	// FromCreatedAt is when the base version was recorded (omitted for version 0)
+	FromCreatedAt *time.Time `json:"from_created_at,omitempty" example:"2023-01-01T10:00:00Z"`
+	// ToCreatedAt is when the target version was recorded
+	ToCreatedAt time.Time `json:"to_created_at" example:"2023-01-02T10:00:00Z"`
+
+	// TitleChanged is true when the title differs between the two versions
+	TitleChanged bool   `json:"title_changed" example:"false"`
+	FromTitle    string `json:"from_title" example:"User authentication must support OAuth 2.0"`
+	ToTitle      string `json:"to_title" example:"User authentication must support OAuth 2.0"`
+
+	// Segments is the description diff as consecutive equal, insert and delete runs
+	Segments []DiffSegment `json:"segments"`
+	// WordsInserted is the number of words inserted into the description
+	WordsInserted int `json:"words_inserted" example:"4"`
+	// WordsDeleted is the number of words deleted from the description
+	WordsDeleted int `json:"words_deleted" example:"1"`
+}
+
+// entityVersionService implements EntityVersionService interface
+type entityVersionService struct {
+	versionRepo repository.EntityVersionRepository
+	repos       *repository.Repositories
+}
+
+// NewEntityVersionService creates a new entity version service instance
+func NewEntityVersionService(repos *repository.Repositories) EntityVersionService {
+	return &entityVersionService{
+		versionRepo: repos.EntityVersion,
+		repos:       repos,
+	}
+}
+
+// ListVersions retrieves all recorded versions of an entity, oldest first
+func (s *entityVersionService) ListVersions(ctx context.Context, entityType models.EntityType, idOrReference string) ([]models.EntityVersion, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.versionRepo.ListByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions: %w", err)
+	}
+	return versions, nil
+}
+
+// DiffVersions computes a word-level diff of the description between two versions
+// toVersion defaults to the latest version and fromVersion to the version preceding toVersion
+func (s *entityVersionService) DiffVersions(ctx context.Context, entityType models.EntityType, idOrReference string, fromVersion, toVersion *int) (*VersionDiffResponse, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	var to *models.EntityVersion
+	if toVersion != nil {
+		to, err = s.getVersion(ctx, entityType, entityID, *toVersion)
+	} else {
+		to, err = s.versionRepo.GetLatest(ctx, entityType, entityID)
+		if errors.Is(err, repository.ErrNotFound) {
+			err = ErrVersionNotFound
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fromNumber := to.VersionNumber - 1
+	if fromVersion != nil {
+		fromNumber = *fromVersion
+	}
+	if fromNumber < 0 || fromNumber >= to.VersionNumber {
+		return nil, ErrInvalidVersionRange
+	}
+
+	// Version 0 is the empty text before the entity existed
+	from := &models.EntityVersion{EntityType: entityType, EntityID: entityID}
+	if fromNumber > 0 {
+		if from, err = s.getVersion(ctx, entityType, entityID, fromNumber); err != nil {
+			return nil, err
+		}
+	}
+
+	segments := DiffWords(from.Description, to.Description)
+	inserted, deleted := CountDiffWords(segments)
+
+	response := &VersionDiffResponse{
+		EntityType:    entityType,
+		EntityID:      entityID,
+		FromVersion:   fromNumber,
+		ToVersion:     to.VersionNumber,
+		ToCreatedAt:   to.CreatedAt,
+		TitleChanged:  from.Title != to.Title,
+		FromTitle:     from.Title,
+		ToTitle:       to.Title,
+		Segments:      segments,
+		WordsInserted: inserted,
+		WordsDeleted:  deleted,
+	}
+	if fromNumber > 0 {
+		response.FromCreatedAt = &from.CreatedAt
+	}
+	return response, nil
+}
+
+// getVersion retrieves a version and maps repository errors to service errors
+func (s *entityVersionService) getVersion(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, versionNumber int) (*models.EntityVersion, error) {
+	version, err := s.versionRepo.GetByNumber(ctx, entityType, entityID, versionNumber)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrVersionNotFound
+		}
+		return nil, fmt.Errorf("failed to get version: %w", err)
+	}
+	return version, nil
+}
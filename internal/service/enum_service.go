@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// EnumService lists the enum values the API uses so that clients and SDK generators need not hard-code them
+type EnumService interface {
+	Enums(ctx context.Context) (*EnumRegistry, error)
+}
+
+// EnumRegistry holds the values of every enum the API uses, each list in the order clients should show it
+type EnumRegistry struct {
+	Statuses          map[models.EntityType][]EnumValue `json:"statuses"` // Statuses of the default status model of epics, user stories and requirements
+	Priorities        []PriorityEnumValue               `json:"priorities"`
+	EntityTypes       []EnumValue                       `json:"entity_types"`
+	RelationshipTypes []EnumValue                       `json:"relationship_types"`
+	CommentCategories []EnumValue                       `json:"comment_categories"`
+}
+
+// EnumValue is a value of an enum with its display label
+type EnumValue struct {
+	Value       string  `json:"value" example:"In Progress"`
+	Label       string  `json:"label" example:"In Progress"`
+	Order       int     `json:"order" example:"3"`
+	Description string  `json:"description,omitempty"`
+	Color       *string `json:"color,omitempty" example:"#007bff"` // Statuses only
+	IsInitial   bool    `json:"is_initial,omitempty"`              // Statuses only: the status new entities start in
+	IsFinal     bool    `json:"is_final,omitempty"`                // Statuses only: the status ends the workflow
+}
+
+// PriorityEnumValue is a priority with its display label
+type PriorityEnumValue struct {
+	Value models.Priority `json:"value" example:"1"`
+	Label string          `json:"label" example:"Critical"`
+	Order int             `json:"order" example:"1"`
+}
+
+// enumEntityTypeLabels are the display labels of the entity types
+var enumEntityTypeLabels = map[models.EntityType]string{
+	models.EntityTypeEpic:               "Epic",
+	models.EntityTypeUserStory:          "User Story",
+	models.EntityTypeAcceptanceCriteria: "Acceptance Criteria",
+	models.EntityTypeRequirement:        "Requirement",
+}
+
+// enumService implements EnumService
+type enumService struct {
+	repos *repository.Repositories
+}
+
+// NewEnumService creates a new enum service instance
+func NewEnumService(repos *repository.Repositories) EnumService {
+	return &enumService{repos: repos}
+}
+
+// Enums returns the current enum values. Statuses come from the default status model of each entity type,
+// or the built-in statuses when the type has none, and relationship types from their configuration.
+func (s *enumService) Enums(ctx context.Context) (*EnumRegistry, error) {
+	registry := &EnumRegistry{Statuses: make(map[models.EntityType][]EnumValue)}
+
+	for _, entityType := range []models.EntityType{models.EntityTypeEpic, models.EntityTypeUserStory, models.EntityTypeRequirement} {
+		statuses, err := s.statusValues(ctx, entityType)
+		if err != nil {
+			return nil, err
+		}
+		registry.Statuses[entityType] = statuses
+	}
+
+	for i, priority := range []models.Priority{models.PriorityCritical, models.PriorityHigh, models.PriorityMedium, models.PriorityLow} {
+		registry.Priorities = append(registry.Priorities, PriorityEnumValue{Value: priority, Label: models.GetPriorityString(priority), Order: i + 1})
+	}
+
+	for i, entityType := range models.GetAllValidEntityTypes() {
+		registry.EntityTypes = append(registry.EntityTypes, EnumValue{Value: string(entityType), Label: enumEntityTypeLabels[entityType], Order: i + 1})
+	}
+
+	relationshipTypes, err := s.repos.RelationshipType.List(ctx, nil, "name ASC", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relationship types: %w", err)
+	}
+	registry.RelationshipTypes = make([]EnumValue, 0, len(relationshipTypes))
+	for i, relationshipType := range relationshipTypes {
+		value := EnumValue{Value: relationshipType.Name, Label: enumLabel(relationshipType.Name), Order: i + 1}
+		if relationshipType.Description != nil {
+			value.Description = *relationshipType.Description
+		}
+		registry.RelationshipTypes = append(registry.RelationshipTypes, value)
+	}
+
+	for i, category := range []models.CommentCategory{models.CommentCategoryQuestion, models.CommentCategoryBlocker, models.CommentCategorySuggestion} {
+		registry.CommentCategories = append(registry.CommentCategories, EnumValue{Value: string(category), Label: enumLabel(string(category)), Order: i + 1})
+	}
+
+	return registry, nil
+}
+
+// statusValues returns the statuses of the default status model of an entity type in status order
+func (s *enumService) statusValues(ctx context.Context, entityType models.EntityType) ([]EnumValue, error) {
+	var statuses []models.Status
+	statusModel, err := s.repos.StatusModel.GetDefaultByEntityType(ctx, entityType)
+	switch {
+	case err == nil:
+		statuses = statusModel.Statuses
+	case errors.Is(err, repository.ErrNotFound):
+		statuses = defaultBoardStatuses(entityType)
+	default:
+		return nil, fmt.Errorf("failed to get %s status model: %w", entityType, err)
+	}
+	sort.SliceStable(statuses, func(i, j int) bool { return statuses[i].Order < statuses[j].Order })
+
+	values := make([]EnumValue, 0, len(statuses))
+	for i, status := range statuses {
+		value := EnumValue{
+			Value:     status.Name,
+			Label:     status.Name,
+			Order:     i + 1,
+			Color:     status.Color,
+			IsInitial: status.IsInitial,
+			IsFinal:   status.IsFinal,
+		}
+		if status.Description != nil {
+			value.Description = *status.Description
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// enumLabel turns a snake_case value such as depends_on into a label such as Depends on
+func enumLabel(value string) string {
+	label := strings.ReplaceAll(value, "_", " ")
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
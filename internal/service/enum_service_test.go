@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEnumService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.StatusModel{}, &models.Status{}, &models.StatusTransition{}, &models.RelationshipType{}))
+
+	description := "Cannot start before the target is done"
+	require.NoError(t, db.Create(&models.RelationshipType{Name: "depends_on", Description: &description}).Error)
+	require.NoError(t, db.Create(&models.RelationshipType{Name: "blocks"}).Error)
+
+	statusModel := &models.StatusModel{EntityType: models.EntityTypeRequirement, Name: "Review flow", IsDefault: true}
+	require.NoError(t, db.Create(statusModel).Error)
+	for _, status := range []models.Status{
+		{StatusModelID: statusModel.ID, Name: "Approved", IsFinal: true, Order: 3},
+		{StatusModelID: statusModel.ID, Name: "Proposed", IsInitial: true, Order: 1},
+		{StatusModelID: statusModel.ID, Name: "In Review", Order: 2},
+	} {
+		require.NoError(t, db.Create(&status).Error)
+	}
+
+	registry, err := NewEnumService(repository.NewRepositories(db, nil)).Enums(ctx)
+	require.NoError(t, err)
+
+	t.Run("statuses come from the default status model in status order", func(t *testing.T) {
+		statuses := registry.Statuses[models.EntityTypeRequirement]
+		require.Len(t, statuses, 3)
+		assert.Equal(t, EnumValue{Value: "Proposed", Label: "Proposed", Order: 1, IsInitial: true}, statuses[0])
+		assert.Equal(t, "In Review", statuses[1].Value)
+		assert.Equal(t, EnumValue{Value: "Approved", Label: "Approved", Order: 3, IsFinal: true}, statuses[2])
+	})
+
+	t.Run("entity types without a status model get the built-in statuses", func(t *testing.T) {
+		statuses := registry.Statuses[models.EntityTypeEpic]
+		require.Len(t, statuses, 5)
+		assert.Equal(t, "Backlog", statuses[0].Value)
+		assert.True(t, statuses[0].IsInitial)
+		assert.Equal(t, "Cancelled", statuses[4].Value)
+		assert.NotNil(t, statuses[4].Color)
+		assert.Len(t, registry.Statuses[models.EntityTypeUserStory], 5)
+		assert.NotContains(t, registry.Statuses, models.EntityTypeAcceptanceCriteria)
+	})
+
+	t.Run("lists fixed enums with labels", func(t *testing.T) {
+		assert.Equal(t, []PriorityEnumValue{
+			{Value: models.PriorityCritical, Label: "Critical", Order: 1},
+			{Value: models.PriorityHigh, Label: "High", Order: 2},
+			{Value: models.PriorityMedium, Label: "Medium", Order: 3},
+			{Value: models.PriorityLow, Label: "Low", Order: 4},
+		}, registry.Priorities)
+		require.Len(t, registry.EntityTypes, 4)
+		assert.Equal(t, EnumValue{Value: "user_story", Label: "User Story", Order: 2}, registry.EntityTypes[1])
+		require.Len(t, registry.CommentCategories, 3)
+		assert.Equal(t, EnumValue{Value: "blocker", Label: "Blocker", Order: 2}, registry.CommentCategories[1])
+	})
+
+	t.Run("lists relationship types by name", func(t *testing.T) {
+		assert.Equal(t, []EnumValue{
+			{Value: "blocks", Label: "Blocks", Order: 1},
+			{Value: "depends_on", Label: "Depends on", Order: 2, Description: description},
+		}, registry.RelationshipTypes)
+	})
+}
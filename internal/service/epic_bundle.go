@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+)
+
+// EpicBundleSchemaVersion is the version of the epic bundle format; it changes when the format does
+const EpicBundleSchemaVersion = 1
+
+// EpicBundle is a portable JSON copy of an epic hierarchy, optionally with its comments.
+// Entities are identified by the reference IDs they had in the source system.
+// @Description Epic hierarchy with optional comments, exported from one system and imported into another
+type EpicBundle struct {
+	SchemaVersion int             `json:"schema_version" example:"1"`
+	ExportedAt    time.Time       `json:"exported_at" example:"2023-01-01T00:00:00Z"`
+	Epic          BundleEpic      `json:"epic"`
+	Comments      []BundleComment `json:"comments,omitempty"`
+}
+
+// BundleEpic is the epic of a bundle
+type BundleEpic struct {
+	ReferenceID string            `json:"reference_id" example:"EP-001"`
+	Title       string            `json:"title" example:"User authentication"`
+	Description string            `json:"description,omitempty"`
+	Status      string            `json:"status" example:"Backlog"`
+	Priority    models.Priority   `json:"priority" example:"2"`
+	UserStories []BundleUserStory `json:"user_stories"`
+}
+
+// BundleUserStory is a user story of a bundle with its acceptance criteria and requirements
+type BundleUserStory struct {
+	ReferenceID        string                     `json:"reference_id" example:"US-001"`
+	Title              string                     `json:"title" example:"Sign up with email"`
+	Description        string                     `json:"description,omitempty"`
+	Status             string                     `json:"status" example:"Backlog"`
+	Priority           models.Priority            `json:"priority" example:"2"`
+	AcceptanceCriteria []BundleAcceptanceCriteria `json:"acceptance_criteria"`
+	Requirements       []BundleRequirement        `json:"requirements"`
+}
+
+// BundleAcceptanceCriteria is an acceptance criterion of a bundle
+type BundleAcceptanceCriteria struct {
+	ReferenceID string `json:"reference_id" example:"AC-001"`
+	Description string `json:"description" example:"WHEN the form is submitted THEN an account is created"`
+}
+
+// BundleRequirement is a requirement of a bundle; AcceptanceCriteria is the reference ID of its primary acceptance
+// criterion and LinkedAcceptanceCriteria those of the further acceptance criteria it covers
+type BundleRequirement struct {
+	ReferenceID              string          `json:"reference_id" example:"REQ-001"`
+	Title                    string          `json:"title" example:"Password rules"`
+	Description              string          `json:"description,omitempty"`
+	Status                   string          `json:"status" example:"Draft"`
+	Priority                 models.Priority `json:"priority" example:"2"`
+	Type                     string          `json:"type" example:"Functional"`
+	AcceptanceCriteria       string          `json:"acceptance_criteria,omitempty" example:"AC-001"`
+	LinkedAcceptanceCriteria []string        `json:"linked_acceptance_criteria,omitempty" example:"AC-002"`
+}
+
+// BundleComment is a general or inline comment of a bundle. ID and ParentID are the source comment IDs and only
+// link replies to their parents; EntityRef is the reference ID of the commented entity within the bundle.
+type BundleComment struct {
+	ID                uuid.UUID               `json:"id"`
+	ParentID          *uuid.UUID              `json:"parent_id,omitempty"`
+	EntityType        models.EntityType       `json:"entity_type" example:"user_story"`
+	EntityRef         string                  `json:"entity_ref" example:"US-001"`
+	Author            BundleAuthor            `json:"author"`
+	Content           string                  `json:"content"`
+	IsResolved        bool                    `json:"is_resolved"`
+	Category          *models.CommentCategory `json:"category,omitempty" example:"question"`
+	LinkedText        *string                 `json:"linked_text,omitempty" example:"email"`
+	TextPositionStart *int                    `json:"text_position_start,omitempty" example:"14"`
+	TextPositionEnd   *int                    `json:"text_position_end,omitempty" example:"19"`
+	CreatedAt         time.Time               `json:"created_at"`
+	UpdatedAt         time.Time               `json:"updated_at"`
+}
+
+// BundleAuthor identifies the author of a comment across systems
+type BundleAuthor struct {
+	Username string `json:"username" example:"john_doe"`
+	Email    string `json:"email" example:"john.doe@example.com"`
+}
+
+// writeBundle builds the bundle of an epic, with the comments of all its entities when includeComments is set
+func (s *epicExportService) writeBundle(ctx context.Context, epic *models.Epic, includeComments bool) (*EpicBundle, error) {
+	bundle := &EpicBundle{
+		SchemaVersion: EpicBundleSchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		Epic: BundleEpic{
+			ReferenceID: epic.ReferenceID,
+			Title:       epic.Title,
+			Description: safeStringValue(epic.Description),
+			Status:      string(epic.Status),
+			Priority:    epic.Priority,
+			UserStories: []BundleUserStory{},
+		},
+	}
+	commented := []bundleEntity{{models.EntityTypeEpic, epic.ID, epic.ReferenceID}}
+
+	userStories, err := s.repos.UserStory.GetByEpic(ctx, epic.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user stories: %w", err)
+	}
+	sort.SliceStable(userStories, func(i, j int) bool {
+		return userStories[i].CreatedAt.Before(userStories[j].CreatedAt)
+	})
+	for _, userStory := range userStories {
+		bundleStory := BundleUserStory{
+			ReferenceID:        userStory.ReferenceID,
+			Title:              userStory.Title,
+			Description:        safeStringValue(userStory.Description),
+			Status:             string(userStory.Status),
+			Priority:           userStory.Priority,
+			AcceptanceCriteria: []BundleAcceptanceCriteria{},
+			Requirements:       []BundleRequirement{},
+		}
+		commented = append(commented, bundleEntity{models.EntityTypeUserStory, userStory.ID, userStory.ReferenceID})
+
+		acceptanceCriteria, err := s.repos.AcceptanceCriteria.GetByUserStory(ctx, userStory.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+		}
+		sort.SliceStable(acceptanceCriteria, func(i, j int) bool {
+			return acceptanceCriteria[i].CreatedAt.Before(acceptanceCriteria[j].CreatedAt)
+		})
+		acceptanceCriteriaRefs := make(map[uuid.UUID]string, len(acceptanceCriteria))
+		for _, ac := range acceptanceCriteria {
+			acceptanceCriteriaRefs[ac.ID] = ac.ReferenceID
+			bundleStory.AcceptanceCriteria = append(bundleStory.AcceptanceCriteria, BundleAcceptanceCriteria{
+				ReferenceID: ac.ReferenceID,
+				Description: ac.Description,
+			})
+			commented = append(commented, bundleEntity{models.EntityTypeAcceptanceCriteria, ac.ID, ac.ReferenceID})
+		}
+
+		requirements, err := s.repos.Requirement.GetByUserStory(ctx, userStory.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list requirements: %w", err)
+		}
+		sort.SliceStable(requirements, func(i, j int) bool {
+			return requirements[i].CreatedAt.Before(requirements[j].CreatedAt)
+		})
+		for _, requirement := range requirements {
+			bundleRequirement := BundleRequirement{
+				ReferenceID: requirement.ReferenceID,
+				Title:       requirement.Title,
+				Description: safeStringValue(requirement.Description),
+				Status:      string(requirement.Status),
+				Priority:    requirement.Priority,
+			}
+			if requirementType, err := s.repos.RequirementType.GetByID(ctx, requirement.TypeID); err == nil {
+				bundleRequirement.Type = requirementType.Name
+			}
+			if requirement.AcceptanceCriteriaID != nil {
+				bundleRequirement.AcceptanceCriteria = acceptanceCriteriaRefs[*requirement.AcceptanceCriteriaID]
+			}
+			linked, err := s.repos.Requirement.GetLinkedAcceptanceCriteria(ctx, requirement.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list linked acceptance criteria: %w", err)
+			}
+			for _, ac := range linked {
+				if reference := acceptanceCriteriaRefs[ac.ID]; reference != "" && reference != bundleRequirement.AcceptanceCriteria {
+					bundleRequirement.LinkedAcceptanceCriteria = append(bundleRequirement.LinkedAcceptanceCriteria, reference)
+				}
+			}
+			bundleStory.Requirements = append(bundleStory.Requirements, bundleRequirement)
+			commented = append(commented, bundleEntity{models.EntityTypeRequirement, requirement.ID, requirement.ReferenceID})
+		}
+		bundle.Epic.UserStories = append(bundle.Epic.UserStories, bundleStory)
+	}
+
+	if includeComments {
+		for _, entity := range commented {
+			comments, err := s.repos.Comment.GetByEntity(ctx, entity.entityType, entity.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list comments: %w", err)
+			}
+			for _, comment := range comments {
+				bundle.Comments = append(bundle.Comments, BundleComment{
+					ID:                comment.ID,
+					ParentID:          comment.ParentCommentID,
+					EntityType:        entity.entityType,
+					EntityRef:         entity.referenceID,
+					Author:            BundleAuthor{Username: comment.Author.Username, Email: comment.Author.Email},
+					Content:           comment.Content,
+					IsResolved:        comment.IsResolved,
+					Category:          comment.Category,
+					LinkedText:        comment.LinkedText,
+					TextPositionStart: comment.TextPositionStart,
+					TextPositionEnd:   comment.TextPositionEnd,
+					CreatedAt:         comment.CreatedAt,
+					UpdatedAt:         comment.UpdatedAt,
+				})
+			}
+		}
+	}
+	return bundle, nil
+}
+
+// bundleEntity is an entity of a bundle that comments may be attached to
+type bundleEntity struct {
+	entityType  models.EntityType
+	id          uuid.UUID
+	referenceID string
+}
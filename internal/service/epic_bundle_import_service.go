@@ -0,0 +1,510 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/validation"
+)
+
+const (
+	// ImportedUserUsername is the account that authors imported comments whose author has no account here
+	ImportedUserUsername = "imported"
+	// importedUserEmail is the address of the imported user; the .invalid domain never receives mail
+	importedUserEmail = "imported@system.invalid"
+	// maxBundleProblems limits the validation problems reported for one bundle
+	maxBundleProblems = 20
+)
+
+var (
+	ErrBundleRequired           = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "bundle is required")
+	ErrUnsupportedBundleVersion = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, fmt.Sprintf("bundle schema_version must be %d", EpicBundleSchemaVersion))
+	ErrBundleInvalid            = apperrors.New(apperrors.KindUnprocessable, "BUNDLE_INVALID", "bundle has validation errors")
+)
+
+// EpicBundleImportService defines the interface for importing epic bundles
+type EpicBundleImportService interface {
+	Import(ctx context.Context, bundle *EpicBundle, creatorID uuid.UUID, options EpicBundleImportOptions) (*EpicBundleImportResult, error)
+}
+
+// EpicBundleImportOptions controls what is imported from a bundle
+type EpicBundleImportOptions struct {
+	IncludeComments bool
+}
+
+// EpicBundleImportResult describes the entities created from a bundle
+// @Description Entities created from an epic bundle, with the reference IDs they were given
+type EpicBundleImportResult struct {
+	EpicID       uuid.UUID               `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceIDs map[string]string       `json:"reference_ids"` // Source reference ID to created reference ID
+	Summary      EpicBundleImportSummary `json:"summary"`
+}
+
+// EpicBundleImportSummary counts the records created from a bundle
+type EpicBundleImportSummary struct {
+	UserStories        int `json:"user_stories" example:"2"`
+	AcceptanceCriteria int `json:"acceptance_criteria" example:"4"`
+	Requirements       int `json:"requirements" example:"3"`
+	Comments           int `json:"comments" example:"5"`
+	UnmatchedAuthors   int `json:"unmatched_authors" example:"1"` // Comments attributed to the imported user
+}
+
+// epicBundleImportService implements EpicBundleImportService interface
+type epicBundleImportService struct {
+	repos *repository.Repositories
+}
+
+// NewEpicBundleImportService creates a new epic bundle import service instance
+func NewEpicBundleImportService(repos *repository.Repositories) EpicBundleImportService {
+	return &epicBundleImportService{repos: repos}
+}
+
+// bundleImport is a validated bundle with its resolved values
+type bundleImport struct {
+	bundle           *EpicBundle
+	requirementTypes map[string]uuid.UUID // Requirement reference ID to type ID
+	entityTypes      map[string]models.EntityType
+	comments         []BundleComment // Parents before their replies
+	problems         []string
+}
+
+// Import creates the epic hierarchy of a bundle in one transaction, owned by and assigned to the creator.
+// Entities get new reference IDs. With IncludeComments, the bundle's comments are recreated with their threads,
+// resolution state, inline positions and timestamps; authors are matched to existing users by username,
+// then by email, and comments of unknown authors are attributed to the imported user.
+func (s *epicBundleImportService) Import(ctx context.Context, bundle *EpicBundle, creatorID uuid.UUID, options EpicBundleImportOptions) (*EpicBundleImportResult, error) {
+	if bundle == nil {
+		return nil, ErrBundleRequired
+	}
+	if bundle.SchemaVersion != EpicBundleSchemaVersion {
+		return nil, ErrUnsupportedBundleVersion
+	}
+
+	plan, err := s.validate(ctx, bundle, options.IncludeComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrBundleInvalid, strings.Join(plan.problems, "; "))
+	}
+
+	result := &EpicBundleImportResult{ReferenceIDs: make(map[string]string)}
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		entityIDs, err := createBundleEntities(ctx, tx, plan, creatorID, result)
+		if err != nil {
+			return err
+		}
+		return createBundleComments(ctx, tx, plan.comments, entityIDs, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// validate checks a bundle and resolves its requirement types and comment order
+func (s *epicBundleImportService) validate(ctx context.Context, bundle *EpicBundle, includeComments bool) (*bundleImport, error) {
+	types, err := s.repos.RequirementType.List(ctx, nil, "name", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirement types: %w", err)
+	}
+	typeIDs := make(map[string]uuid.UUID, len(types))
+	for _, requirementType := range types {
+		typeIDs[strings.ToLower(requirementType.Name)] = requirementType.ID
+	}
+
+	plan := &bundleImport{
+		bundle:           bundle,
+		requirementTypes: make(map[string]uuid.UUID),
+		entityTypes:      make(map[string]models.EntityType),
+	}
+	plan.addEntity(models.EntityTypeEpic, bundle.Epic.ReferenceID)
+	plan.checkText(bundle.Epic.ReferenceID, bundle.Epic.Title, bundle.Epic.Description)
+	plan.checkStatus(bundle.Epic.ReferenceID, bundle.Epic.Status, validation.GetValidEpicStatuses())
+	plan.checkPriority(bundle.Epic.ReferenceID, bundle.Epic.Priority)
+
+	for _, userStory := range bundle.Epic.UserStories {
+		plan.addEntity(models.EntityTypeUserStory, userStory.ReferenceID)
+		plan.checkText(userStory.ReferenceID, userStory.Title, userStory.Description)
+		plan.checkStatus(userStory.ReferenceID, userStory.Status, validation.GetValidUserStoryStatuses())
+		plan.checkPriority(userStory.ReferenceID, userStory.Priority)
+
+		acceptanceCriteria := make(map[string]bool, len(userStory.AcceptanceCriteria))
+		for _, ac := range userStory.AcceptanceCriteria {
+			plan.addEntity(models.EntityTypeAcceptanceCriteria, ac.ReferenceID)
+			acceptanceCriteria[ac.ReferenceID] = true
+			if strings.TrimSpace(ac.Description) == "" {
+				plan.addProblem("%s: description is required", ac.ReferenceID)
+			}
+		}
+		for _, requirement := range userStory.Requirements {
+			plan.addEntity(models.EntityTypeRequirement, requirement.ReferenceID)
+			plan.checkText(requirement.ReferenceID, requirement.Title, requirement.Description)
+			plan.checkStatus(requirement.ReferenceID, requirement.Status, validation.GetValidRequirementStatuses())
+			plan.checkPriority(requirement.ReferenceID, requirement.Priority)
+
+			typeName := requirement.Type
+			if typeName == "" {
+				typeName = defaultImportRequirementType
+			}
+			if typeID, ok := typeIDs[strings.ToLower(typeName)]; ok {
+				plan.requirementTypes[requirement.ReferenceID] = typeID
+			} else {
+				plan.addProblem("%s: unknown requirement type %q", requirement.ReferenceID, typeName)
+			}
+			if requirement.AcceptanceCriteria != "" && !acceptanceCriteria[requirement.AcceptanceCriteria] {
+				plan.addProblem("%s: acceptance criteria %s is not part of its user story", requirement.ReferenceID, requirement.AcceptanceCriteria)
+			}
+			for _, linked := range requirement.LinkedAcceptanceCriteria {
+				if !acceptanceCriteria[linked] {
+					plan.addProblem("%s: acceptance criteria %s is not part of its user story", requirement.ReferenceID, linked)
+				}
+			}
+		}
+	}
+
+	if includeComments {
+		plan.orderComments()
+	}
+	return plan, nil
+}
+
+// addEntity registers the reference ID of a bundle entity, which must be present and unique
+func (p *bundleImport) addEntity(entityType models.EntityType, referenceID string) {
+	if referenceID == "" {
+		p.addProblem("%s without reference_id", entityType)
+		return
+	}
+	if _, exists := p.entityTypes[referenceID]; exists {
+		p.addProblem("%s: duplicate reference_id", referenceID)
+		return
+	}
+	p.entityTypes[referenceID] = entityType
+}
+
+// checkText checks the title and description limits of an entity
+func (p *bundleImport) checkText(referenceID, title, description string) {
+	if strings.TrimSpace(title) == "" {
+		p.addProblem("%s: title is required", referenceID)
+	} else if utf8.RuneCountInString(title) > maxImportTitleLength {
+		p.addProblem("%s: title must not exceed %d characters", referenceID, maxImportTitleLength)
+	}
+	if utf8.RuneCountInString(description) > maxImportDescriptionLength {
+		p.addProblem("%s: description must not exceed %d characters", referenceID, maxImportDescriptionLength)
+	}
+}
+
+// checkStatus checks that a status is empty or one of the valid statuses
+func (p *bundleImport) checkStatus(referenceID, status string, valid []string) {
+	if _, ok := bundleStatus(status, valid); !ok {
+		p.addProblem("%s: invalid status %q", referenceID, status)
+	}
+}
+
+// checkPriority checks that a priority is unset or one of the defined levels
+func (p *bundleImport) checkPriority(referenceID string, priority models.Priority) {
+	if priority != 0 && (priority < models.PriorityCritical || priority > models.PriorityLow) {
+		p.addProblem("%s: invalid priority %d", referenceID, priority)
+	}
+}
+
+// orderComments checks the comments and orders them so that every parent precedes its replies
+func (p *bundleImport) orderComments() {
+	byID := make(map[uuid.UUID]BundleComment, len(p.bundle.Comments))
+	for _, comment := range p.bundle.Comments {
+		if _, exists := byID[comment.ID]; exists || comment.ID == uuid.Nil {
+			p.addProblem("comment %s: missing or duplicate id", comment.ID)
+			continue
+		}
+		byID[comment.ID] = comment
+
+		if entityType, ok := p.entityTypes[comment.EntityRef]; !ok || entityType != comment.EntityType {
+			p.addProblem("comment %s: %s %s is not part of the bundle", comment.ID, comment.EntityType, comment.EntityRef)
+		}
+		if strings.TrimSpace(comment.Content) == "" {
+			p.addProblem("comment %s: content is required", comment.ID)
+		}
+		if comment.Category != nil && !comment.Category.IsValid() {
+			p.addProblem("comment %s: invalid category %q", comment.ID, *comment.Category)
+		}
+		inlineFields := 0
+		for _, set := range []bool{comment.LinkedText != nil, comment.TextPositionStart != nil, comment.TextPositionEnd != nil} {
+			if set {
+				inlineFields++
+			}
+		}
+		if inlineFields == 3 && (*comment.TextPositionStart < 0 || *comment.TextPositionEnd < *comment.TextPositionStart) {
+			p.addProblem("comment %s: invalid text positions", comment.ID)
+		} else if inlineFields != 0 && inlineFields != 3 {
+			p.addProblem("comment %s: inline comments need linked_text, text_position_start and text_position_end", comment.ID)
+		}
+	}
+	for _, comment := range p.bundle.Comments {
+		if comment.ParentID == nil {
+			continue
+		}
+		parent, ok := byID[*comment.ParentID]
+		if !ok {
+			p.addProblem("comment %s: parent %s is not part of the bundle", comment.ID, *comment.ParentID)
+		} else if parent.EntityRef != comment.EntityRef {
+			p.addProblem("comment %s: reply to a comment on another entity", comment.ID)
+		}
+	}
+	if len(p.problems) > 0 {
+		return
+	}
+
+	// Place comments whose parent is placed until no more can be; the rest form cycles
+	placed := make(map[uuid.UUID]bool, len(p.bundle.Comments))
+	remaining := p.bundle.Comments
+	for len(remaining) > 0 {
+		var next []BundleComment
+		for _, comment := range remaining {
+			if comment.ParentID == nil || placed[*comment.ParentID] {
+				placed[comment.ID] = true
+				p.comments = append(p.comments, comment)
+			} else {
+				next = append(next, comment)
+			}
+		}
+		if len(next) == len(remaining) {
+			p.addProblem("comment %s: reply chain forms a cycle", next[0].ID)
+			return
+		}
+		remaining = next
+	}
+}
+
+// addProblem records a validation problem, up to maxBundleProblems
+func (p *bundleImport) addProblem(format string, args ...interface{}) {
+	if len(p.problems) < maxBundleProblems {
+		p.problems = append(p.problems, fmt.Sprintf(format, args...))
+	}
+}
+
+// bundleStatus returns the canonical form of a status; an empty status is the first valid status
+func bundleStatus(status string, valid []string) (string, bool) {
+	if status == "" {
+		return valid[0], true
+	}
+	return canonicalStatus(status, valid)
+}
+
+// canonicalStatus returns the valid status matching the given one case-insensitively
+func canonicalStatus(status string, valid []string) (string, bool) {
+	for _, candidate := range valid {
+		if strings.EqualFold(strings.TrimSpace(status), candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// bundlePriority returns the priority of a bundle entity, defaulting to medium
+func bundlePriority(priority models.Priority) models.Priority {
+	if priority == 0 {
+		return models.PriorityMedium
+	}
+	return priority
+}
+
+// createBundleEntities creates the epic hierarchy and returns the created entity IDs by source reference ID
+func createBundleEntities(ctx context.Context, tx *repository.Repositories, plan *bundleImport, creatorID uuid.UUID, result *EpicBundleImportResult) (map[string]uuid.UUID, error) {
+	entityIDs := make(map[string]uuid.UUID, len(plan.entityTypes))
+	source := plan.bundle.Epic
+
+	epicStatus, _ := bundleStatus(source.Status, validation.GetValidEpicStatuses())
+	epic := &models.Epic{
+		CreatorID:   creatorID,
+		AssigneeID:  creatorID,
+		Priority:    bundlePriority(source.Priority),
+		Status:      models.EpicStatus(epicStatus),
+		Title:       source.Title,
+		Description: optionalImportText(source.Description),
+	}
+	if err := tx.Epic.Create(ctx, epic); err != nil {
+		return nil, fmt.Errorf("failed to create epic %s: %w", source.ReferenceID, err)
+	}
+	entityIDs[source.ReferenceID] = epic.ID
+	result.EpicID = epic.ID
+	result.ReferenceIDs[source.ReferenceID] = epic.ReferenceID
+
+	for _, sourceStory := range source.UserStories {
+		storyStatus, _ := bundleStatus(sourceStory.Status, validation.GetValidUserStoryStatuses())
+		userStory := &models.UserStory{
+			EpicID:      epic.ID,
+			CreatorID:   creatorID,
+			AssigneeID:  creatorID,
+			Priority:    bundlePriority(sourceStory.Priority),
+			Status:      models.UserStoryStatus(storyStatus),
+			Title:       sourceStory.Title,
+			Description: optionalImportText(sourceStory.Description),
+		}
+		if err := tx.UserStory.Create(ctx, userStory); err != nil {
+			return nil, fmt.Errorf("failed to create user story %s: %w", sourceStory.ReferenceID, err)
+		}
+		entityIDs[sourceStory.ReferenceID] = userStory.ID
+		result.ReferenceIDs[sourceStory.ReferenceID] = userStory.ReferenceID
+		result.Summary.UserStories++
+
+		for _, sourceAC := range sourceStory.AcceptanceCriteria {
+			acceptanceCriteria := &models.AcceptanceCriteria{
+				UserStoryID: userStory.ID,
+				AuthorID:    creatorID,
+				Description: sourceAC.Description,
+			}
+			if err := tx.AcceptanceCriteria.Create(ctx, acceptanceCriteria); err != nil {
+				return nil, fmt.Errorf("failed to create acceptance criteria %s: %w", sourceAC.ReferenceID, err)
+			}
+			entityIDs[sourceAC.ReferenceID] = acceptanceCriteria.ID
+			result.ReferenceIDs[sourceAC.ReferenceID] = acceptanceCriteria.ReferenceID
+			result.Summary.AcceptanceCriteria++
+		}
+
+		for _, sourceRequirement := range sourceStory.Requirements {
+			requirementStatus, _ := bundleStatus(sourceRequirement.Status, validation.GetValidRequirementStatuses())
+			requirement := &models.Requirement{
+				UserStoryID: userStory.ID,
+				CreatorID:   creatorID,
+				AssigneeID:  creatorID,
+				Priority:    bundlePriority(sourceRequirement.Priority),
+				Status:      models.RequirementStatus(requirementStatus),
+				TypeID:      plan.requirementTypes[sourceRequirement.ReferenceID],
+				Title:       sourceRequirement.Title,
+				Description: optionalImportText(sourceRequirement.Description),
+			}
+			if sourceRequirement.AcceptanceCriteria != "" {
+				acceptanceCriteriaID := entityIDs[sourceRequirement.AcceptanceCriteria]
+				requirement.AcceptanceCriteriaID = &acceptanceCriteriaID
+			}
+			if err := tx.Requirement.Create(ctx, requirement); err != nil {
+				return nil, fmt.Errorf("failed to create requirement %s: %w", sourceRequirement.ReferenceID, err)
+			}
+			for _, linked := range sourceRequirement.LinkedAcceptanceCriteria {
+				if err := tx.Requirement.LinkAcceptanceCriteria(ctx, requirement.ID, entityIDs[linked]); err != nil {
+					return nil, fmt.Errorf("failed to link requirement %s to acceptance criteria %s: %w", sourceRequirement.ReferenceID, linked, err)
+				}
+			}
+			entityIDs[sourceRequirement.ReferenceID] = requirement.ID
+			result.ReferenceIDs[sourceRequirement.ReferenceID] = requirement.ReferenceID
+			result.Summary.Requirements++
+		}
+	}
+	return entityIDs, nil
+}
+
+// createBundleComments recreates the comments, which are ordered parents first, on the created entities
+func createBundleComments(ctx context.Context, tx *repository.Repositories, comments []BundleComment, entityIDs map[string]uuid.UUID, result *EpicBundleImportResult) error {
+	authors := &bundleAuthorResolver{users: tx.User, cache: make(map[BundleAuthor]uuid.UUID)}
+	commentIDs := make(map[uuid.UUID]uuid.UUID, len(comments))
+
+	for _, source := range comments {
+		authorID, matched, err := authors.resolve(ctx, source.Author)
+		if err != nil {
+			return err
+		}
+		comment := &models.Comment{
+			EntityType:        source.EntityType,
+			EntityID:          entityIDs[source.EntityRef],
+			AuthorID:          authorID,
+			Content:           source.Content,
+			IsResolved:        source.IsResolved,
+			Category:          source.Category,
+			LinkedText:        source.LinkedText,
+			TextPositionStart: source.TextPositionStart,
+			TextPositionEnd:   source.TextPositionEnd,
+			CreatedAt:         source.CreatedAt,
+			UpdatedAt:         source.UpdatedAt,
+		}
+		if source.ParentID != nil {
+			parentID := commentIDs[*source.ParentID]
+			comment.ParentCommentID = &parentID
+		}
+		if err := tx.Comment.Create(ctx, comment); err != nil {
+			return fmt.Errorf("failed to create comment %s: %w", source.ID, err)
+		}
+		commentIDs[source.ID] = comment.ID
+		result.Summary.Comments++
+		if !matched {
+			result.Summary.UnmatchedAuthors++
+		}
+	}
+	return nil
+}
+
+// bundleAuthorResolver maps bundle authors to local users
+type bundleAuthorResolver struct {
+	users        repository.UserRepository
+	cache        map[BundleAuthor]uuid.UUID
+	importedUser uuid.UUID
+}
+
+// resolve returns the user matching the author by username, then by email, or the imported user.
+// matched reports whether an existing user was found.
+func (r *bundleAuthorResolver) resolve(ctx context.Context, author BundleAuthor) (id uuid.UUID, matched bool, err error) {
+	if id, ok := r.cache[author]; ok {
+		return id, id != r.importedUser, nil
+	}
+
+	lookups := []struct {
+		value string
+		find  func(context.Context, string) (*models.User, error)
+	}{
+		{author.Username, r.users.GetByUsername},
+		{author.Email, r.users.GetByEmail},
+	}
+	for _, lookup := range lookups {
+		if lookup.value == "" {
+			continue
+		}
+		user, err := lookup.find(ctx, lookup.value)
+		if err == nil {
+			r.cache[author] = user.ID
+			return user.ID, true, nil
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return uuid.Nil, false, fmt.Errorf("failed to look up comment author: %w", err)
+		}
+	}
+
+	importedUser, err := r.imported(ctx)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	r.cache[author] = importedUser
+	return importedUser, false, nil
+}
+
+// imported returns the imported user, creating it as a deactivated account that cannot sign in
+func (r *bundleAuthorResolver) imported(ctx context.Context) (uuid.UUID, error) {
+	if r.importedUser != uuid.Nil {
+		return r.importedUser, nil
+	}
+
+	user, err := r.users.GetByUsername(ctx, ImportedUserUsername)
+	if errors.Is(err, repository.ErrNotFound) {
+		deactivatedAt := time.Now().UTC()
+		user = &models.User{
+			Username:      ImportedUserUsername,
+			Email:         importedUserEmail,
+			PasswordHash:  "!", // Never matches a bcrypt hash
+			Role:          models.RoleCommenter,
+			DeactivatedAt: &deactivatedAt,
+		}
+		err = r.users.Create(ctx, user)
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get the imported user: %w", err)
+	}
+	r.importedUser = user.ID
+	return user.ID, nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEpicBundleRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, alice, epic, requirements := setupSupersessionTest(t, active)
+	require.NoError(t, db.AutoMigrate(&models.Comment{}, &models.RequirementType{}))
+	functional := &models.RequirementType{Name: "Functional"}
+	require.NoError(t, db.Create(functional).Error)
+	require.NoError(t, db.Model(&requirements[0]).Update("type_id", functional.ID).Error)
+	useSequentialReferenceIDs(t)
+
+	bob := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(bob).Error)
+
+	question := models.CommentCategoryQuestion
+	linkedText, start, end := "rule", 9, 13
+	thread := &models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: alice.ID,
+		Content: "Is the scope final?", IsResolved: true}
+	require.NoError(t, db.Create(thread).Error)
+	require.NoError(t, db.Create(&models.Comment{EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: bob.ID,
+		ParentCommentID: &thread.ID, Content: "Yes"}).Error)
+	require.NoError(t, db.Create(&models.Comment{EntityType: models.EntityTypeRequirement, EntityID: requirements[0].ID,
+		AuthorID: alice.ID, Content: "Which rule?", Category: &question,
+		LinkedText: &linkedText, TextPositionStart: &start, TextPositionEnd: &end}).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	export, err := NewEpicExportService(repos).ExportEpic(ctx, "EP-001", EpicExportOptions{Format: EpicExportFormatBundle, IncludeComments: true})
+	require.NoError(t, err)
+	assert.Equal(t, "json", export.Extension)
+
+	var bundle EpicBundle
+	require.NoError(t, json.Unmarshal([]byte(export.Content), &bundle))
+	assert.Equal(t, EpicBundleSchemaVersion, bundle.SchemaVersion)
+	require.Len(t, bundle.Epic.UserStories, 1)
+	require.Len(t, bundle.Epic.UserStories[0].Requirements, 1)
+	assert.Equal(t, "Functional", bundle.Epic.UserStories[0].Requirements[0].Type)
+	require.Len(t, bundle.Comments, 3)
+	assert.Equal(t, "REQ-001", bundle.Comments[2].EntityRef)
+	assert.Equal(t, BundleAuthor{Username: "bob", Email: "bob@example.com"}, bundle.Comments[1].Author)
+
+	// In the target system bob has another username, and the author of the inline comment is unknown
+	require.NoError(t, db.Model(bob).Update("username", "robert").Error)
+	bundle.Comments[2].Author = BundleAuthor{Username: "ghost", Email: "ghost@example.com"}
+	// Replies may precede their parents
+	bundle.Comments[0], bundle.Comments[1] = bundle.Comments[1], bundle.Comments[0]
+
+	svc := NewEpicBundleImportService(repos)
+	result, err := svc.Import(ctx, &bundle, alice.ID, EpicBundleImportOptions{IncludeComments: true})
+	require.NoError(t, err)
+	assert.Equal(t, "EP-101", result.ReferenceIDs["EP-001"])
+	assert.Equal(t, "REQ-101", result.ReferenceIDs["REQ-001"])
+	assert.Equal(t, EpicBundleImportSummary{UserStories: 1, Requirements: 1, Comments: 3, UnmatchedAuthors: 1}, result.Summary)
+
+	imported, err := repos.Requirement.GetByReferenceID(ctx, "REQ-101")
+	require.NoError(t, err)
+	assert.Equal(t, models.RequirementStatusActive, imported.Status)
+	assert.Equal(t, functional.ID, imported.TypeID)
+
+	epicComments, err := repos.Comment.GetByEntity(ctx, models.EntityTypeEpic, result.EpicID)
+	require.NoError(t, err)
+	require.Len(t, epicComments, 2)
+	assert.True(t, epicComments[0].IsResolved)
+	assert.Equal(t, alice.ID, epicComments[0].AuthorID)
+	require.NotNil(t, epicComments[1].ParentCommentID)
+	assert.Equal(t, epicComments[0].ID, *epicComments[1].ParentCommentID)
+	assert.Equal(t, bob.ID, epicComments[1].AuthorID, "matched by email")
+
+	inline, err := repos.Comment.GetInlineComments(ctx, models.EntityTypeRequirement, imported.ID)
+	require.NoError(t, err)
+	require.Len(t, inline, 1)
+	assert.Equal(t, "rule", *inline[0].LinkedText)
+	assert.Equal(t, 9, *inline[0].TextPositionStart)
+	assert.Equal(t, 13, *inline[0].TextPositionEnd)
+	assert.Equal(t, &question, inline[0].Category)
+	assert.Equal(t, ImportedUserUsername, inline[0].Author.Username)
+	assert.NotNil(t, inline[0].Author.DeactivatedAt, "the imported user cannot sign in")
+
+	t.Run("imports without comments", func(t *testing.T) {
+		result, err := svc.Import(ctx, &bundle, alice.ID, EpicBundleImportOptions{})
+		require.NoError(t, err)
+		assert.Zero(t, result.Summary.Comments)
+	})
+
+	t.Run("reuses the imported user", func(t *testing.T) {
+		_, err := svc.Import(ctx, &bundle, alice.ID, EpicBundleImportOptions{IncludeComments: true})
+		require.NoError(t, err)
+		var count int64
+		require.NoError(t, db.Model(&models.User{}).Where("username = ?", ImportedUserUsername).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+}
+
+func TestEpicBundleImport_Validation(t *testing.T) {
+	ctx := context.Background()
+	db, alice, _, _ := setupSupersessionTest(t)
+	require.NoError(t, db.AutoMigrate(&models.Comment{}, &models.RequirementType{}))
+	svc := NewEpicBundleImportService(repository.NewRepositories(db, nil))
+
+	_, err := svc.Import(ctx, &EpicBundle{SchemaVersion: 2}, alice.ID, EpicBundleImportOptions{})
+	assert.ErrorIs(t, err, ErrUnsupportedBundleVersion)
+
+	orphanParent := uuid.New()
+	bundle := &EpicBundle{
+		SchemaVersion: EpicBundleSchemaVersion,
+		Epic: BundleEpic{ReferenceID: "EP-001", Title: "Accounts", Status: "Unknown", UserStories: []BundleUserStory{{
+			ReferenceID:  "US-001",
+			Title:        "Sign up",
+			Requirements: []BundleRequirement{{ReferenceID: "REQ-001", Title: "Rule", Type: "Legal", AcceptanceCriteria: "AC-009"}},
+		}}},
+		Comments: []BundleComment{
+			{ID: uuid.New(), EntityType: models.EntityTypeEpic, EntityRef: "EP-001", Content: "Orphan", ParentID: &orphanParent},
+			{ID: uuid.New(), EntityType: models.EntityTypeUserStory, EntityRef: "US-404", Content: "Lost"},
+		},
+	}
+	_, err = svc.Import(ctx, bundle, alice.ID, EpicBundleImportOptions{IncludeComments: true})
+	require.ErrorIs(t, err, ErrBundleInvalid)
+	for _, problem := range []string{`EP-001: invalid status "Unknown"`, `REQ-001: unknown requirement type "Legal"`,
+		"acceptance criteria AC-009 is not part of its user story", "user_story US-404 is not part of the bundle",
+		"parent " + orphanParent.String() + " is not part of the bundle"} {
+		assert.Contains(t, err.Error(), problem)
+	}
+
+	var epics int64
+	require.NoError(t, db.Model(&models.Epic{}).Count(&epics).Error)
+	assert.Equal(t, int64(1), epics, "an invalid bundle creates nothing")
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// maxDecompositionSteeringChars limits how much of each steering document is sent to the language model
+const maxDecompositionSteeringChars = 10000
+
+var (
+	ErrDecompositionEmpty   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "at least one user story is required")
+	ErrDecompositionInvalid = apperrors.New(apperrors.KindUnprocessable, "DECOMPOSITION_INVALID", "proposed user stories have validation errors")
+)
+
+// epicDecompositionInstructions are the system instructions of the decomposition request
+const epicDecompositionInstructions = `You break an epic of a requirements management system down into user stories.
+Reply with a JSON object only, without any other text, in this form:
+{"user_stories": [{"title": "...", "description": "As a <role>, I want <function>, so that <goal>", "priority": 3, "acceptance_criteria": ["WHEN <trigger> THEN the system SHALL <response>"]}]}
+Propose between 3 and 10 user stories that together cover the epic and do not repeat the existing user stories.
+Priority is 1 (critical), 2 (high), 3 (medium) or 4 (low). Write two to five acceptance criteria per user story in EARS format.
+Follow the steering documents. Write in the language of the epic.`
+
+// EpicDecompositionService defines the interface for AI-assisted breakdown of epics into user stories
+type EpicDecompositionService interface {
+	Decompose(ctx context.Context, epicIDOrReference string, userID uuid.UUID) (*EpicDecomposition, error)
+	Accept(ctx context.Context, epicIDOrReference string, req AcceptDecompositionRequest, creatorID uuid.UUID) (*AcceptedDecomposition, error)
+}
+
+// EpicDecomposition is a draft set of user stories proposed for an epic; nothing is created until it is accepted
+// @Description User stories with acceptance criteria proposed by the language model for an epic
+type EpicDecomposition struct {
+	EpicID uuid.UUID `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// Model is the provider and model that made the proposal
+	Model string `json:"model" example:"openai/gpt-4o"`
+	// SteeringDocuments are the reference IDs of the linked steering documents the model followed
+	SteeringDocuments []string            `json:"steering_documents"`
+	UserStories       []ProposedUserStory `json:"user_stories"`
+}
+
+// ProposedUserStory is a user story of a decomposition, which may be edited before it is accepted
+type ProposedUserStory struct {
+	Title       string          `json:"title" example:"Guest checkout"`
+	Description string          `json:"description" example:"As a shopper, I want to check out without an account, so that I can buy quickly"`
+	Priority    models.Priority `json:"priority" example:"3"`
+	// AcceptanceCriteria are the EARS descriptions of the acceptance criteria of the user story
+	AcceptanceCriteria []string `json:"acceptance_criteria"`
+}
+
+// AcceptDecompositionRequest represents the proposed user stories to create, as returned by the decomposition or edited
+type AcceptDecompositionRequest struct {
+	UserStories []ProposedUserStory `json:"user_stories"`
+}
+
+// AcceptedDecomposition lists the user stories created from a decomposition, with their acceptance criteria
+type AcceptedDecomposition struct {
+	EpicID      uuid.UUID          `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	UserStories []models.UserStory `json:"user_stories"`
+}
+
+// epicDecompositionService implements EpicDecompositionService
+type epicDecompositionService struct {
+	repos *repository.Repositories
+	llm   LLMClient
+	model string
+}
+
+// NewEpicDecompositionService creates a new epic decomposition service instance. model names the provider and
+// model of llm for the provenance of created entities; llm may be nil when no provider is configured.
+func NewEpicDecompositionService(repos *repository.Repositories, llm LLMClient, model string) EpicDecompositionService {
+	return &epicDecompositionService{
+		repos: repos,
+		llm:   llm,
+		model: model,
+	}
+}
+
+// Decompose asks the language model for user stories covering the epic, given its description, linked
+// steering documents and existing user stories
+func (s *epicDecompositionService) Decompose(ctx context.Context, epicIDOrReference string, userID uuid.UUID) (*EpicDecomposition, error) {
+	if s.llm == nil {
+		return nil, ErrLLMNotConfigured
+	}
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrReference)
+	if err != nil {
+		return nil, err
+	}
+	epic, err := s.repos.Epic.GetByID(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+	documents, err := s.repos.SteeringDocument.GetByEpicID(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get steering documents: %w", err)
+	}
+	existing, err := s.repos.UserStory.GetByEpic(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user stories: %w", err)
+	}
+
+	reply, err := s.llm.Complete(ctx, LLMRequest{
+		Feature: LLMFeatureEpicDecomposition,
+		UserID:  &userID,
+		System:  epicDecompositionInstructions,
+		Prompt:  buildDecompositionPrompt(epic, documents, existing),
+	})
+	if err != nil {
+		return nil, err
+	}
+	stories, err := parseDecomposition(reply.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	decomposition := &EpicDecomposition{
+		EpicID:            epicID,
+		Model:             s.model,
+		SteeringDocuments: make([]string, 0, len(documents)),
+		UserStories:       stories,
+	}
+	for _, document := range documents {
+		decomposition.SteeringDocuments = append(decomposition.SteeringDocuments, document.ReferenceID)
+	}
+	return decomposition, nil
+}
+
+// Accept creates the proposed user stories and their acceptance criteria in the epic in one transaction,
+// marked as generated by the configured model
+func (s *epicDecompositionService) Accept(ctx context.Context, epicIDOrReference string, req AcceptDecompositionRequest, creatorID uuid.UUID) (*AcceptedDecomposition, error) {
+	if len(req.UserStories) == 0 {
+		return nil, ErrDecompositionEmpty
+	}
+	if err := validateProposedUserStories(req.UserStories); err != nil {
+		return nil, err
+	}
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	var model *string
+	if s.model != "" {
+		model = &s.model
+	}
+	accepted := &AcceptedDecomposition{EpicID: epicID, UserStories: make([]models.UserStory, 0, len(req.UserStories))}
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		for _, proposed := range req.UserStories {
+			userStory := models.UserStory{
+				EpicID:      epicID,
+				CreatorID:   creatorID,
+				AssigneeID:  creatorID,
+				Priority:    proposed.Priority,
+				Status:      models.UserStoryStatusBacklog,
+				Title:       strings.TrimSpace(proposed.Title),
+				Description: optionalImportText(strings.TrimSpace(proposed.Description)),
+				AIGenerated: true,
+				AIModel:     model,
+			}
+			if err := tx.UserStory.Create(ctx, &userStory); err != nil {
+				return fmt.Errorf("failed to create user story %q: %w", userStory.Title, err)
+			}
+
+			for _, description := range proposed.AcceptanceCriteria {
+				acceptanceCriteria := models.AcceptanceCriteria{
+					UserStoryID: userStory.ID,
+					AuthorID:    creatorID,
+					Description: strings.TrimSpace(description),
+					AIGenerated: true,
+					AIModel:     model,
+				}
+				if err := tx.AcceptanceCriteria.Create(ctx, &acceptanceCriteria); err != nil {
+					return fmt.Errorf("failed to create acceptance criteria for %q: %w", userStory.Title, err)
+				}
+				userStory.AcceptanceCriteria = append(userStory.AcceptanceCriteria, acceptanceCriteria)
+			}
+			accepted.UserStories = append(accepted.UserStories, userStory)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accepted, nil
+}
+
+// validateProposedUserStories applies the entity model limits to proposed user stories
+func validateProposedUserStories(stories []ProposedUserStory) error {
+	var problems []string
+	for i, story := range stories {
+		title := strings.TrimSpace(story.Title)
+		switch {
+		case title == "":
+			problems = append(problems, fmt.Sprintf("user story %d: title is required", i+1))
+		case utf8.RuneCountInString(title) > maxImportTitleLength:
+			problems = append(problems, fmt.Sprintf("user story %d: title must not exceed %d characters", i+1, maxImportTitleLength))
+		}
+		if utf8.RuneCountInString(story.Description) > maxImportDescriptionLength {
+			problems = append(problems, fmt.Sprintf("user story %d: description must not exceed %d characters", i+1, maxImportDescriptionLength))
+		}
+		if story.Priority < models.PriorityCritical || story.Priority > models.PriorityLow {
+			problems = append(problems, fmt.Sprintf("user story %d: priority must be between 1 and 4", i+1))
+		}
+		for j, description := range story.AcceptanceCriteria {
+			if strings.TrimSpace(description) == "" {
+				problems = append(problems, fmt.Sprintf("user story %d: acceptance criteria %d is empty", i+1, j+1))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrDecompositionInvalid, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// buildDecompositionPrompt describes the epic with its steering documents and existing user stories
+func buildDecompositionPrompt(epic *models.Epic, documents []models.SteeringDocument, existing []models.UserStory) string {
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Epic %s: %s\n", epic.ReferenceID, epic.Title)
+	if epic.Description != nil && *epic.Description != "" {
+		prompt.WriteString("\n" + *epic.Description + "\n")
+	}
+
+	if len(documents) > 0 {
+		prompt.WriteString("\nSteering documents:\n")
+		for _, document := range documents {
+			fmt.Fprintf(&prompt, "\n%s: %s\n", document.ReferenceID, document.Title)
+			if document.Description != nil && *document.Description != "" {
+				text := *document.Description
+				if len(text) > maxDecompositionSteeringChars {
+					text = strings.ToValidUTF8(text[:maxDecompositionSteeringChars], "") + "\n(truncated)"
+				}
+				prompt.WriteString(text + "\n")
+			}
+		}
+	}
+
+	if len(existing) > 0 {
+		prompt.WriteString("\nExisting user stories:\n")
+		for _, userStory := range existing {
+			fmt.Fprintf(&prompt, "- %s: %s\n", userStory.ReferenceID, userStory.Title)
+		}
+	}
+	return prompt.String()
+}
+
+// parseDecomposition reads the user stories from the JSON reply of the model. Stories without a title are
+// dropped and priorities out of range become medium, so the proposal can be accepted as it is.
+func parseDecomposition(reply string) ([]ProposedUserStory, error) {
+	var parsed struct {
+		UserStories []ProposedUserStory `json:"user_stories"`
+	}
+	start, end := strings.Index(reply, "{"), strings.LastIndex(reply, "}")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("%w: reply contains no JSON object", ErrLLMUnavailable)
+	}
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &parsed); err != nil {
+		return nil, fmt.Errorf("%w: invalid reply: %v", ErrLLMUnavailable, err)
+	}
+
+	stories := make([]ProposedUserStory, 0, len(parsed.UserStories))
+	for _, story := range parsed.UserStories {
+		story.Title = strings.TrimSpace(story.Title)
+		if story.Title == "" {
+			continue
+		}
+		if story.Priority < models.PriorityCritical || story.Priority > models.PriorityLow {
+			story.Priority = models.PriorityMedium
+		}
+		criteria := make([]string, 0, len(story.AcceptanceCriteria))
+		for _, description := range story.AcceptanceCriteria {
+			if description = strings.TrimSpace(description); description != "" {
+				criteria = append(criteria, description)
+			}
+		}
+		story.AcceptanceCriteria = criteria
+		stories = append(stories, story)
+	}
+	if len(stories) == 0 {
+		return nil, fmt.Errorf("%w: reply contains no user stories", ErrLLMUnavailable)
+	}
+	return stories, nil
+}
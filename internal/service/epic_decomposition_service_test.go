@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEpicDecompositionService(t *testing.T) {
+	ctx := context.Background()
+	useSequentialReferenceIDs(t)
+	db, user, epic, _ := setupSupersessionTest(t)
+	require.NoError(t, db.AutoMigrate(&models.SteeringDocument{}))
+	// Migrating epics creates a bare join table; replace it with the link model
+	require.NoError(t, db.Migrator().DropTable(&models.EpicSteeringDocument{}))
+	require.NoError(t, db.Migrator().CreateTable(&models.EpicSteeringDocument{}))
+	repos := repository.NewRepositories(db, nil)
+
+	guidelines := "Every user story needs a measurable goal."
+	document := &models.SteeringDocument{ReferenceID: "STD-001", Title: "Writing guidelines", Description: &guidelines, CreatorID: user.ID}
+	require.NoError(t, repos.SteeringDocument.Create(ctx, document))
+	require.NoError(t, repos.SteeringDocument.LinkToEpic(ctx, document.ID, epic.ID))
+
+	llm := &fakeLLMClient{reply: "```json\n" + `{"user_stories": [
+		{"title": "Reset password", "description": "As a user, I want to reset my password", "priority": 2,
+		 "acceptance_criteria": ["WHEN a user requests a reset THEN the system SHALL send a link", " "]},
+		{"title": "Delete account", "priority": 9, "acceptance_criteria": []},
+		{"title": " "}
+	]}` + "\n```"}
+	svc := NewEpicDecompositionService(repos, llm, "openai/gpt-test")
+
+	var decomposition *EpicDecomposition
+	t.Run("proposes user stories without creating them", func(t *testing.T) {
+		var err error
+		decomposition, err = svc.Decompose(context.Background(), "EP-001", user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, epic.ID, decomposition.EpicID)
+		assert.Equal(t, "openai/gpt-test", decomposition.Model)
+		assert.Equal(t, []string{"STD-001"}, decomposition.SteeringDocuments)
+		require.Len(t, decomposition.UserStories, 2)
+		assert.Equal(t, models.PriorityHigh, decomposition.UserStories[0].Priority)
+		assert.Equal(t, []string{"WHEN a user requests a reset THEN the system SHALL send a link"}, decomposition.UserStories[0].AcceptanceCriteria)
+		assert.Equal(t, models.PriorityMedium, decomposition.UserStories[1].Priority)
+
+		require.Len(t, llm.prompts, 1)
+		assert.Equal(t, LLMFeatureEpicDecomposition, llm.requests[0].Feature)
+		assert.Contains(t, llm.prompts[0], "Epic EP-001: Accounts")
+		assert.Contains(t, llm.prompts[0], "STD-001: Writing guidelines\n"+guidelines)
+		assert.Contains(t, llm.prompts[0], "- US-001: Sign up")
+
+		stories, err := repos.UserStory.GetByEpic(ctx, epic.ID)
+		require.NoError(t, err)
+		assert.Len(t, stories, 1)
+	})
+
+	t.Run("accepting creates the stories marked as AI-generated", func(t *testing.T) {
+		accepted, err := svc.Accept(ctx, epic.ID.String(), AcceptDecompositionRequest{UserStories: decomposition.UserStories}, user.ID)
+		require.NoError(t, err)
+		require.Len(t, accepted.UserStories, 2)
+		created := accepted.UserStories[0]
+		assert.Equal(t, "US-101", created.ReferenceID)
+		assert.Equal(t, models.UserStoryStatusBacklog, created.Status)
+		require.Len(t, created.AcceptanceCriteria, 1)
+
+		var stored models.UserStory
+		require.NoError(t, db.Preload("AcceptanceCriteria").First(&stored, "id = ?", created.ID).Error)
+		assert.True(t, stored.AIGenerated)
+		require.NotNil(t, stored.AIModel)
+		assert.Equal(t, "openai/gpt-test", *stored.AIModel)
+		require.Len(t, stored.AcceptanceCriteria, 1)
+		assert.True(t, stored.AcceptanceCriteria[0].AIGenerated)
+		assert.Equal(t, user.ID, stored.AcceptanceCriteria[0].AuthorID)
+	})
+
+	t.Run("invalid proposals create nothing", func(t *testing.T) {
+		_, err := svc.Accept(ctx, "EP-001", AcceptDecompositionRequest{}, user.ID)
+		assert.ErrorIs(t, err, ErrDecompositionEmpty)
+
+		_, err = svc.Accept(ctx, "EP-001", AcceptDecompositionRequest{UserStories: []ProposedUserStory{
+			{Title: "Valid", Priority: models.PriorityLow},
+			{Title: "", Priority: 7},
+		}}, user.ID)
+		assert.ErrorIs(t, err, ErrDecompositionInvalid)
+		assert.Contains(t, err.Error(), "user story 2: title is required")
+		assert.Contains(t, err.Error(), "user story 2: priority must be between 1 and 4")
+
+		stories, err := repos.UserStory.GetByEpic(ctx, epic.ID)
+		require.NoError(t, err)
+		assert.Len(t, stories, 3)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := svc.Decompose(context.Background(), "EP-999", user.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		llm.reply = "I cannot help with that."
+		_, err = svc.Decompose(context.Background(), "EP-001", user.ID)
+		assert.ErrorIs(t, err, ErrLLMUnavailable)
+
+		_, err = NewEpicDecompositionService(repos, nil, "").Decompose(context.Background(), "EP-001", user.ID)
+		assert.ErrorIs(t, err, ErrLLMNotConfigured)
+	})
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Epic export formats
+const (
+	EpicExportFormatMarkdown = "markdown"
+	EpicExportFormatBundle   = "bundle"
+)
+
+var (
+	ErrInvalidExportFormat = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "format must be one of: markdown, bundle")
+)
+
+// EpicExportService defines the interface for exporting an epic hierarchy as a document
+type EpicExportService interface {
+	ExportEpic(ctx context.Context, epicIDOrRef string, options EpicExportOptions) (*EpicExport, error)
+}
+
+// EpicExportOptions controls the format and content of an epic export
+type EpicExportOptions struct {
+	Format          string
+	IncludeComments bool
+}
+
+// EpicExport is a rendered epic document
+type EpicExport struct {
+	Name        string
+	Extension   string
+	ContentType string
+	Content     string
+}
+
+// epicExportService implements EpicExportService interface
+type epicExportService struct {
+	repos *repository.Repositories
+}
+
+// NewEpicExportService creates a new epic export service instance
+func NewEpicExportService(repos *repository.Repositories) EpicExportService {
+	return &epicExportService{repos: repos}
+}
+
+// ExportEpic renders an epic with its user stories, acceptance criteria and requirements.
+// With IncludeComments, a Markdown export anchors unresolved inline comments as footnotes after their
+// linked text, and a bundle export carries all general and inline comments with their threads.
+func (s *epicExportService) ExportEpic(ctx context.Context, epicIDOrRef string, options EpicExportOptions) (*EpicExport, error) {
+	if options.Format != EpicExportFormatMarkdown && options.Format != EpicExportFormatBundle {
+		return nil, ErrInvalidExportFormat
+	}
+
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, err
+	}
+	epic, err := s.repos.Epic.GetByID(ctx, epicID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+
+	if options.Format == EpicExportFormatBundle {
+		bundle, err := s.writeBundle(ctx, epic, options.IncludeComments)
+		if err != nil {
+			return nil, err
+		}
+		content, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode bundle: %w", err)
+		}
+		return &EpicExport{Name: epic.ReferenceID, Extension: "json", ContentType: "application/json; charset=utf-8", Content: string(content)}, nil
+	}
+
+	w := &markdownWriter{includeComments: options.IncludeComments, comments: s.repos.Comment}
+	if err := s.writeEpic(ctx, w, epic); err != nil {
+		return nil, err
+	}
+	w.writeFootnotes()
+
+	return &EpicExport{Name: epic.ReferenceID, Extension: "md", ContentType: "text/markdown; charset=utf-8", Content: w.String()}, nil
+}
+
+// writeEpic writes the epic section followed by a section per user story
+func (s *epicExportService) writeEpic(ctx context.Context, w *markdownWriter, epic *models.Epic) error {
+	fmt.Fprintf(w, "# %s: %s\n\n", epic.ReferenceID, markdownLine(epic.Title))
+	fmt.Fprintf(w, "**Status:** %s · **Priority:** %s\n\n", epic.Status, models.GetPriorityString(epic.Priority))
+	if err := w.writeDescription(ctx, models.EntityTypeEpic, epic.ID, safeStringValue(epic.Description)); err != nil {
+		return err
+	}
+
+	userStories, err := s.repos.UserStory.GetByEpic(ctx, epic.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list user stories: %w", err)
+	}
+	sort.SliceStable(userStories, func(i, j int) bool {
+		return userStories[i].CreatedAt.Before(userStories[j].CreatedAt)
+	})
+	for i := range userStories {
+		if err := s.writeUserStory(ctx, w, &userStories[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeUserStory writes a user story with its acceptance criteria and requirements
+func (s *epicExportService) writeUserStory(ctx context.Context, w *markdownWriter, userStory *models.UserStory) error {
+	fmt.Fprintf(w, "## %s: %s\n\n", userStory.ReferenceID, markdownLine(userStory.Title))
+	fmt.Fprintf(w, "**Status:** %s · **Priority:** %s\n\n", userStory.Status, models.GetPriorityString(userStory.Priority))
+	if err := w.writeDescription(ctx, models.EntityTypeUserStory, userStory.ID, safeStringValue(userStory.Description)); err != nil {
+		return err
+	}
+
+	acceptanceCriteria, err := s.repos.AcceptanceCriteria.GetByUserStory(ctx, userStory.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list acceptance criteria: %w", err)
+	}
+	sort.SliceStable(acceptanceCriteria, func(i, j int) bool {
+		return acceptanceCriteria[i].CreatedAt.Before(acceptanceCriteria[j].CreatedAt)
+	})
+	acceptanceCriteriaRefs := make(map[uuid.UUID]string, len(acceptanceCriteria))
+	if len(acceptanceCriteria) > 0 {
+		w.WriteString("### Acceptance Criteria\n\n")
+	}
+	for _, ac := range acceptanceCriteria {
+		acceptanceCriteriaRefs[ac.ID] = ac.ReferenceID
+		fmt.Fprintf(w, "#### %s\n\n", ac.ReferenceID)
+		if err := w.writeDescription(ctx, models.EntityTypeAcceptanceCriteria, ac.ID, ac.Description); err != nil {
+			return err
+		}
+	}
+
+	requirements, err := s.repos.Requirement.GetByUserStory(ctx, userStory.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list requirements: %w", err)
+	}
+	sort.SliceStable(requirements, func(i, j int) bool {
+		return requirements[i].CreatedAt.Before(requirements[j].CreatedAt)
+	})
+	if len(requirements) > 0 {
+		w.WriteString("### Requirements\n\n")
+	}
+	for _, requirement := range requirements {
+		fmt.Fprintf(w, "#### %s: %s\n\n", requirement.ReferenceID, markdownLine(requirement.Title))
+		fmt.Fprintf(w, "**Status:** %s · **Priority:** %s", requirement.Status, models.GetPriorityString(requirement.Priority))
+		linked, err := s.repos.Requirement.GetLinkedAcceptanceCriteria(ctx, requirement.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list linked acceptance criteria: %w", err)
+		}
+		var references []string
+		for _, ac := range linked {
+			if reference, ok := acceptanceCriteriaRefs[ac.ID]; ok {
+				references = append(references, reference)
+			}
+		}
+		if len(references) > 0 {
+			fmt.Fprintf(w, " · **Acceptance criteria:** %s", strings.Join(references, ", "))
+		}
+		w.WriteString("\n\n")
+		if err := w.writeDescription(ctx, models.EntityTypeRequirement, requirement.ID, safeStringValue(requirement.Description)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// markdownWriter accumulates an exported document and the footnotes referenced from it
+type markdownWriter struct {
+	strings.Builder
+	includeComments bool
+	comments        repository.CommentRepository
+	footnotes       []string
+}
+
+// footnoteAnchor is a comment to reference from a description at a byte offset
+type footnoteAnchor struct {
+	position int
+	comment  models.Comment
+}
+
+// writeDescription writes a description paragraph, marking unresolved inline comments when requested
+func (w *markdownWriter) writeDescription(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, description string) error {
+	description = strings.TrimRight(description, " \t\r\n")
+
+	if w.includeComments {
+		comments, err := w.comments.GetInlineComments(ctx, entityType, entityID)
+		if err != nil {
+			return fmt.Errorf("failed to list inline comments: %w", err)
+		}
+		description = w.annotate(description, comments)
+	}
+
+	if description == "" {
+		return nil
+	}
+	w.WriteString(description)
+	w.WriteString("\n\n")
+	return nil
+}
+
+// annotate inserts a footnote reference after the linked text of each unresolved inline comment,
+// numbering footnotes in document order.
+// Stale positions fall back to the first occurrence of the linked text, then to the end of the description.
+func (w *markdownWriter) annotate(description string, comments []models.Comment) string {
+	var anchors []footnoteAnchor
+	for _, comment := range comments {
+		if comment.IsResolved || !comment.IsInlineComment() {
+			continue
+		}
+
+		position := len(description)
+		linkedText := *comment.LinkedText
+		start, end := *comment.TextPositionStart, *comment.TextPositionEnd
+		if start >= 0 && end <= len(description) && start <= end && description[start:end] == linkedText {
+			position = end
+		} else if index := strings.Index(description, linkedText); linkedText != "" && index >= 0 {
+			position = index + len(linkedText)
+		}
+
+		anchors = append(anchors, footnoteAnchor{position: position, comment: comment})
+	}
+	if len(anchors) == 0 {
+		return description
+	}
+
+	sort.SliceStable(anchors, func(i, j int) bool {
+		return anchors[i].position < anchors[j].position
+	})
+	var b strings.Builder
+	previous := 0
+	for _, anchor := range anchors {
+		w.footnotes = append(w.footnotes, footnoteText(anchor.comment))
+		b.WriteString(description[previous:anchor.position])
+		fmt.Fprintf(&b, "[^%d]", len(w.footnotes))
+		previous = anchor.position
+	}
+	b.WriteString(description[previous:])
+	return b.String()
+}
+
+// footnoteText is the author and content of an inline comment, with continuation lines indented
+func footnoteText(comment models.Comment) string {
+	content := strings.TrimSpace(strings.ReplaceAll(comment.Content, "\r", ""))
+	content = strings.ReplaceAll(content, "\n", "\n    ")
+	if comment.Author.Username == "" {
+		return content
+	}
+	return fmt.Sprintf("**%s:** %s", comment.Author.Username, content)
+}
+
+// writeFootnotes writes the collected footnote definitions at the end of the document
+func (w *markdownWriter) writeFootnotes() {
+	if len(w.footnotes) == 0 {
+		return
+	}
+	w.WriteString("---\n\n")
+	for i, footnote := range w.footnotes {
+		fmt.Fprintf(w, "[^%d]: %s\n", i+1, footnote)
+	}
+}
+
+// markdownLine flattens line breaks so a title stays on its heading line
+func markdownLine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEpicExportService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, user, epic, requirements := setupSupersessionTest(t, active, active)
+	require.NoError(t, db.AutoMigrate(&models.Comment{}))
+
+	description := "Users sign up with email and password"
+	require.NoError(t, db.Model(epic).Update("description", description).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	ac := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: requirements[0].UserStoryID,
+		AuthorID: user.ID, Description: "WHEN the form is submitted THEN an account is created"}
+	require.NoError(t, session.Create(ac).Error)
+	require.NoError(t, db.Model(&requirements[0]).Update("acceptance_criteria_id", ac.ID).Error)
+
+	comment := func(linkedText string, start int, resolved bool, content string) {
+		end := start + len(linkedText)
+		require.NoError(t, db.Create(&models.Comment{
+			EntityType: models.EntityTypeEpic, EntityID: epic.ID, AuthorID: user.ID, Content: content,
+			IsResolved: resolved, LinkedText: &linkedText, TextPositionStart: &start, TextPositionEnd: &end,
+		}).Error)
+	}
+	comment("email", 14, false, "Which email providers?\nCorporate only?")
+	comment("password", 0, false, "Stale position falls back to the text")
+	comment("Users", 0, true, "Already resolved")
+
+	svc := NewEpicExportService(repository.NewRepositories(db, nil))
+
+	t.Run("renders the hierarchy", func(t *testing.T) {
+		export, err := svc.ExportEpic(ctx, "EP-001", EpicExportOptions{Format: EpicExportFormatMarkdown})
+		require.NoError(t, err)
+		assert.Equal(t, "EP-001", export.Name)
+		assert.Contains(t, export.Content, "# EP-001: Accounts\n")
+		assert.Contains(t, export.Content, "\n"+description+"\n")
+		assert.Contains(t, export.Content, "## US-001: Sign up\n")
+		assert.Contains(t, export.Content, "#### AC-001\n\nWHEN the form is submitted")
+		assert.Contains(t, export.Content, "#### REQ-001: Password rule A\n\n**Status:** Active · **Priority:** Unknown · **Acceptance criteria:** AC-001\n")
+		assert.Contains(t, export.Content, "#### REQ-002: Password rule B\n")
+		assert.NotContains(t, export.Content, "[^")
+	})
+
+	t.Run("anchors unresolved inline comments as footnotes", func(t *testing.T) {
+		export, err := svc.ExportEpic(ctx, epic.ID.String(), EpicExportOptions{Format: EpicExportFormatMarkdown, IncludeComments: true})
+		require.NoError(t, err)
+		assert.Contains(t, export.Content, "Users sign up with email[^1] and password[^2]\n")
+		assert.Contains(t, export.Content, "[^1]: **alice:** Which email providers?\n    Corporate only?\n")
+		assert.Contains(t, export.Content, "[^2]: **alice:** Stale position falls back to the text\n")
+		assert.NotContains(t, export.Content, "Already resolved")
+	})
+
+	t.Run("rejects unknown formats and epics", func(t *testing.T) {
+		_, err := svc.ExportEpic(ctx, "EP-001", EpicExportOptions{Format: "pdf"})
+		assert.ErrorIs(t, err, ErrInvalidExportFormat)
+		_, err = svc.ExportEpic(ctx, "EP-999", EpicExportOptions{Format: EpicExportFormatMarkdown})
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+}
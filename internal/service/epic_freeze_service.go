@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrEpicFrozen          = repository.ErrEpicFrozen
+	ErrEmptyFreezeReason   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "freeze reason cannot be empty")
+	ErrFreezeReasonTooLong = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "freeze reason cannot exceed 1000 characters")
+)
+
+// maxFreezeReasonLength is the maximum length of a freeze reason
+const maxFreezeReasonLength = 1000
+
+// FreezeEpicRequest represents a request to freeze the requirements of an epic
+// @Description Request payload for freezing the requirements of an epic
+type FreezeEpicRequest struct {
+	// Reason tells users whose changes are rejected why the epic is frozen
+	// @Description Why the epic is frozen, e.g. the release being stabilized (max 1000 characters)
+	// @Example "Release 2.4 stabilization"
+	Reason string `json:"reason" binding:"required"`
+}
+
+// EpicFreeze describes the freeze that rejected a change
+// @Description Freeze of the epic that owns the changed entity
+type EpicFreeze struct {
+	EpicID      uuid.UUID  `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174000"`             // ID of the frozen epic
+	ReferenceID string     `json:"reference_id" example:"EP-001"`                                      // Reference ID of the frozen epic
+	Reason      string     `json:"reason" example:"Release 2.4 stabilization"`                         // Why the epic is frozen
+	FrozenBy    *uuid.UUID `json:"frozen_by,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"` // User who froze the epic
+	FrozenAt    *time.Time `json:"frozen_at,omitempty" example:"2023-06-01T09:00:00Z"`                 // When the epic was frozen
+}
+
+// EpicFreezeService defines the interface for freezing the requirements of epics during release stabilization
+type EpicFreezeService interface {
+	Freeze(ctx context.Context, epicIDOrRef, reason string, userID uuid.UUID) (*models.Epic, error)
+	Unfreeze(ctx context.Context, epicIDOrRef string, userID uuid.UUID) (*models.Epic, error)
+}
+
+// epicFreezeService implements EpicFreezeService interface
+type epicFreezeService struct {
+	repos *repository.Repositories
+}
+
+// NewEpicFreezeService creates a new epic freeze service instance
+func NewEpicFreezeService(repos *repository.Repositories) EpicFreezeService {
+	return &epicFreezeService{
+		repos: repos,
+	}
+}
+
+// Freeze blocks changes to the user stories, acceptance criteria and requirements of an epic by anyone but administrators
+// (see repository.RegisterFreezeCallbacks), and records the freeze in the activity of the epic. Freezing a frozen epic replaces its reason.
+func (s *epicFreezeService) Freeze(ctx context.Context, epicIDOrRef, reason string, userID uuid.UUID) (*models.Epic, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrEmptyFreezeReason
+	}
+	if len(reason) > maxFreezeReasonLength {
+		return nil, ErrFreezeReasonTooLong
+	}
+
+	epicID, err := s.resolveEpicID(ctx, epicIDOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var epic *models.Epic
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		var err error
+		if epic, err = getEpicForFreeze(ctx, tx, epicID); err != nil {
+			return err
+		}
+		if epic.Frozen && epic.FreezeReason != nil && *epic.FreezeReason == reason {
+			return nil
+		}
+
+		oldReason := epic.FreezeReason
+		now := time.Now().UTC()
+		epic.Frozen, epic.FrozenAt, epic.FrozenBy, epic.FreezeReason = true, &now, &userID, &reason
+		if err := tx.Epic.Update(ctx, epic); err != nil {
+			return fmt.Errorf("failed to freeze epic: %w", err)
+		}
+		return recordFreezeEvent(ctx, tx, epic.ID, models.AuditActionFrozen, userID, oldReason, &reason, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+// Unfreeze lifts the freeze of an epic and records it in the activity of the epic.
+// Unfreezing an epic that is not frozen changes nothing.
+func (s *epicFreezeService) Unfreeze(ctx context.Context, epicIDOrRef string, userID uuid.UUID) (*models.Epic, error) {
+	epicID, err := s.resolveEpicID(ctx, epicIDOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var epic *models.Epic
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		var err error
+		if epic, err = getEpicForFreeze(ctx, tx, epicID); err != nil {
+			return err
+		}
+		if !epic.Frozen {
+			return nil
+		}
+
+		oldReason := epic.FreezeReason
+		epic.Frozen, epic.FrozenAt, epic.FrozenBy, epic.FreezeReason = false, nil, nil, nil
+		if err := tx.Epic.Update(ctx, epic); err != nil {
+			return fmt.Errorf("failed to unfreeze epic: %w", err)
+		}
+		return recordFreezeEvent(ctx, tx, epic.ID, models.AuditActionUnfrozen, userID, oldReason, nil, time.Now().UTC())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+// resolveEpicID resolves an epic by UUID or reference ID
+func (s *epicFreezeService) resolveEpicID(ctx context.Context, epicIDOrRef string) (uuid.UUID, error) {
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return uuid.Nil, ErrEpicNotFound
+		}
+		return uuid.Nil, err
+	}
+	return epicID, nil
+}
+
+func getEpicForFreeze(ctx context.Context, tx *repository.Repositories, id uuid.UUID) (*models.Epic, error) {
+	epic, err := tx.Epic.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+	return epic, nil
+}
+
+// recordFreezeEvent records a freeze or unfreeze in the activity of the epic
+func recordFreezeEvent(ctx context.Context, tx *repository.Repositories, epicID uuid.UUID, action models.AuditAction, userID uuid.UUID, oldReason, newReason *string, now time.Time) error {
+	if err := tx.Audit.Create(ctx, &models.AuditEvent{
+		EntityType: models.EntityTypeEpic,
+		EntityID:   epicID,
+		Action:     action,
+		ActorID:    &userID,
+		Field:      "frozen",
+		OldValue:   oldReason,
+		NewValue:   newReason,
+		CreatedAt:  now,
+	}); err != nil {
+		return fmt.Errorf("failed to record freeze: %w", err)
+	}
+	return nil
+}
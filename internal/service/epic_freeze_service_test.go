@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEpicFreezeService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, user, epic, requirements := setupSupersessionTest(t, active)
+	require.NoError(t, db.AutoMigrate(&models.AuditEvent{}))
+	require.NoError(t, repository.RegisterFreezeCallbacks(db))
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	ac := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: requirements[0].UserStoryID,
+		AuthorID: user.ID, Description: "WHEN the form is submitted THEN the system SHALL create an account"}
+	require.NoError(t, session.Create(ac).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	svc := NewEpicFreezeService(repos)
+
+	t.Run("validates the freeze", func(t *testing.T) {
+		_, err := svc.Freeze(ctx, "EP-001", "  ", user.ID)
+		assert.ErrorIs(t, err, ErrEmptyFreezeReason)
+		_, err = svc.Freeze(ctx, "EP-404", "Release 2.4", user.ID)
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+
+	requirement := &requirements[0]
+	t.Run("children of unfrozen epics can be changed", func(t *testing.T) {
+		requirement.Title = "Password rule"
+		assert.NoError(t, repos.Requirement.Update(ctx, requirement))
+	})
+
+	t.Run("freezing blocks changes to the children", func(t *testing.T) {
+		frozen, err := svc.Freeze(ctx, epic.ID.String(), " Release 2.4 stabilization ", user.ID)
+		require.NoError(t, err)
+		assert.True(t, frozen.Frozen)
+		assert.Equal(t, "Release 2.4 stabilization", *frozen.FreezeReason)
+		assert.Equal(t, user.ID, *frozen.FrozenBy)
+		assert.NotNil(t, frozen.FrozenAt)
+
+		var frozenErr *repository.EpicFrozenError
+		err = repos.Requirement.Update(ctx, requirement)
+		require.ErrorAs(t, err, &frozenErr)
+		assert.ErrorIs(t, err, ErrEpicFrozen)
+		assert.Equal(t, "EP-001", frozenErr.ReferenceID)
+		assert.Equal(t, "Release 2.4 stabilization", frozenErr.Reason)
+
+		ac.Description = "WHEN the form is submitted THEN the system SHALL send a confirmation"
+		assert.ErrorIs(t, repos.AcceptanceCriteria.Update(ctx, ac), ErrEpicFrozen)
+		story := &models.UserStory{ReferenceID: "US-002", EpicID: epic.ID, Title: "Sign in", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+		assert.ErrorIs(t, repos.UserStory.Create(ctx, story), ErrEpicFrozen)
+
+		assert.NoError(t, repos.Requirement.Update(repository.WithFreezeExemption(ctx), requirement), "administrators are exempt")
+	})
+
+	t.Run("freezing again replaces the reason", func(t *testing.T) {
+		_, err := svc.Freeze(ctx, "EP-001", "Release 2.4 stabilization", user.ID)
+		require.NoError(t, err)
+		frozen, err := svc.Freeze(ctx, "EP-001", "Release 2.4 hotfix window", user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Release 2.4 hotfix window", *frozen.FreezeReason)
+	})
+
+	t.Run("unfreezing lifts the freeze", func(t *testing.T) {
+		unfrozen, err := svc.Unfreeze(ctx, "EP-001", user.ID)
+		require.NoError(t, err)
+		assert.False(t, unfrozen.Frozen)
+		assert.Nil(t, unfrozen.FreezeReason)
+		assert.Nil(t, unfrozen.FrozenAt)
+
+		assert.NoError(t, repos.Requirement.Update(ctx, requirement))
+
+		_, err = svc.Unfreeze(ctx, "EP-001", user.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("records the freezes in the activity of the epic", func(t *testing.T) {
+		events, err := repos.Audit.ListByEntity(ctx, models.EntityTypeEpic, epic.ID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		actions := map[models.AuditAction]int{}
+		for _, event := range events {
+			actions[event.Action]++
+			assert.Equal(t, user.ID, *event.ActorID)
+		}
+		assert.Equal(t, map[models.AuditAction]int{models.AuditActionFrozen: 2, models.AuditActionUnfrozen: 1}, actions)
+	})
+}
@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrEpicMergeSameEpic = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "an epic cannot be merged into itself")
+)
+
+// EpicMergeService defines the interface for finding and merging duplicate epics
+type EpicMergeService interface {
+	FindDuplicateTitles(ctx context.Context) ([]EpicDuplicateGroup, error)
+	MergeEpics(ctx context.Context, req MergeEpicsRequest, userID uuid.UUID) (*EpicMergeResult, error)
+}
+
+// MergeEpicsRequest represents the request to merge one epic into another
+// @Description Request payload for merging a duplicate epic into the epic that is kept
+type MergeEpicsRequest struct {
+	// SourceEpicID is the epic that is merged away
+	// @Description UUID or reference ID of the epic that is merged away and deleted
+	// @Example "EP-005"
+	SourceEpicID string `json:"source_epic_id" binding:"required"`
+
+	// TargetEpicID is the epic that is kept
+	// @Description UUID or reference ID of the epic that receives the user stories, steering documents and comments
+	// @Example "EP-001"
+	TargetEpicID string `json:"target_epic_id" binding:"required"`
+
+	// DryRun previews the merge without changing anything
+	// @Description Return what would be moved without merging (optional, default: false)
+	// @Example true
+	DryRun bool `json:"dry_run"`
+}
+
+// EpicMergeItem is an entity moved or affected by a merge
+// @Description Entity moved or affected by an epic merge
+type EpicMergeItem struct {
+	ID          uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string    `json:"reference_id" example:"US-012"`
+	Title       string    `json:"title" example:"Pay by card"`
+}
+
+// EpicMergeResult describes what a merge moved, or would move for a dry run
+// @Description Everything an epic merge moves from the merged-away epic to the kept epic
+type EpicMergeResult struct {
+	DryRun bool          `json:"dry_run" example:"true"`
+	Source EpicMergeItem `json:"source"`
+	Target EpicMergeItem `json:"target"`
+	// UserStories are moved to the target epic
+	UserStories []EpicMergeItem `json:"user_stories"`
+	// SteeringDocuments are relinked to the target epic
+	SteeringDocuments []EpicMergeItem `json:"steering_documents"`
+	// AlreadyLinkedSteeringDocuments are linked to both epics; their links to the source epic are dropped
+	AlreadyLinkedSteeringDocuments []EpicMergeItem `json:"already_linked_steering_documents"`
+	// Comments is the number of comments and replies moved to the target epic
+	Comments int `json:"comments" example:"4"`
+	// Relationships is the number of links to and from the source epic that are relinked to the target epic
+	Relationships int `json:"relationships" example:"2"`
+	// DroppedRelationships is the number of links between the two epics or duplicating a link of the target epic
+	DroppedRelationships int `json:"dropped_relationships" example:"1"`
+	// Redirects are the reference IDs that resolve to the target epic after the merge
+	Redirects []string `json:"redirects" example:"EP-005"`
+}
+
+// EpicDuplicateGroup is a set of epics whose titles only differ in case, spacing or punctuation
+// @Description Epics with the same normalized title
+type EpicDuplicateGroup struct {
+	Title string          `json:"title" example:"user authentication"`
+	Epics []EpicMergeItem `json:"epics"`
+}
+
+// epicMergePlan holds the rows a merge changes
+type epicMergePlan struct {
+	source, target         *models.Epic
+	userStories            []models.UserStory
+	steeringLinks          []models.EpicSteeringDocument
+	duplicateLinks         []models.EpicSteeringDocument
+	steeringDocuments      map[uuid.UUID]models.SteeringDocument
+	comments               []models.Comment
+	relinkedRelationships  []models.EntityRelationship
+	droppedRelationshipIDs []uuid.UUID
+	redirects              []models.ReferenceRedirect
+}
+
+// epicMergeService implements EpicMergeService interface
+type epicMergeService struct {
+	repos           *repository.Repositories
+	presenceService PresenceService
+}
+
+// NewEpicMergeService creates a new epic merge service instance
+func NewEpicMergeService(repos *repository.Repositories, presenceService PresenceService) EpicMergeService {
+	return &epicMergeService{
+		repos:           repos,
+		presenceService: presenceService,
+	}
+}
+
+// FindDuplicateTitles groups the epics whose titles only differ in case, spacing or punctuation, oldest epic first
+func (s *epicMergeService) FindDuplicateTitles(ctx context.Context) ([]EpicDuplicateGroup, error) {
+	var epics []models.Epic
+	if err := s.repos.Epic.GetDB().Select("id", "reference_id", "title", "created_at").
+		Order("created_at ASC, reference_id ASC").Find(&epics).Error; err != nil {
+		return nil, fmt.Errorf("failed to list epics: %w", err)
+	}
+
+	groups := make(map[string]*EpicDuplicateGroup)
+	var order []string
+	for _, epic := range epics {
+		key := normalizeEpicTitle(epic.Title)
+		if key == "" {
+			continue
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &EpicDuplicateGroup{Title: key}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.Epics = append(group.Epics, EpicMergeItem{ID: epic.ID, ReferenceID: epic.ReferenceID, Title: epic.Title})
+	}
+
+	duplicates := []EpicDuplicateGroup{}
+	for _, key := range order {
+		if len(groups[key].Epics) > 1 {
+			duplicates = append(duplicates, *groups[key])
+		}
+	}
+	return duplicates, nil
+}
+
+// normalizeEpicTitle lower-cases a title and collapses everything but letters and digits to single spaces
+func normalizeEpicTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// MergeEpics moves the user stories, steering document links, comments and links of the source epic to the
+// target epic, deletes the source epic and leaves a redirect from its reference ID to the target epic.
+// With DryRun set nothing is changed and the result previews the merge.
+func (s *epicMergeService) MergeEpics(ctx context.Context, req MergeEpicsRequest, userID uuid.UUID) (*EpicMergeResult, error) {
+	sourceID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, req.SourceEpicID)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, req.TargetEpicID)
+	if err != nil {
+		return nil, err
+	}
+	if sourceID == targetID {
+		return nil, ErrEpicMergeSameEpic
+	}
+
+	if req.DryRun {
+		plan, err := s.plan(ctx, s.repos, sourceID, targetID)
+		if err != nil {
+			return nil, err
+		}
+		return plan.result(true), nil
+	}
+
+	if s.presenceService != nil {
+		for _, epicID := range []uuid.UUID{sourceID, targetID} {
+			if err := s.presenceService.CheckEditAllowed(ctx, models.EntityTypeEpic, epicID, userID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var result *EpicMergeResult
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		plan, err := s.plan(ctx, tx, sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		if err := plan.apply(ctx, tx, userID); err != nil {
+			return err
+		}
+		result = plan.result(false)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// plan loads everything that a merge of the source epic into the target epic changes
+func (s *epicMergeService) plan(ctx context.Context, repos *repository.Repositories, sourceID, targetID uuid.UUID) (*epicMergePlan, error) {
+	db := repos.Epic.GetDB()
+	plan := &epicMergePlan{steeringDocuments: make(map[uuid.UUID]models.SteeringDocument)}
+
+	var err error
+	if plan.source, err = getEpicForMerge(ctx, repos, sourceID); err != nil {
+		return nil, err
+	}
+	if plan.target, err = getEpicForMerge(ctx, repos, targetID); err != nil {
+		return nil, err
+	}
+
+	if err := db.Where("epic_id = ?", sourceID).Order("created_at ASC").Find(&plan.userStories).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user stories: %w", err)
+	}
+
+	var links []models.EpicSteeringDocument
+	if err := db.Where("epic_id IN ?", []uuid.UUID{sourceID, targetID}).Order("created_at ASC").Find(&links).Error; err != nil {
+		return nil, fmt.Errorf("failed to get steering document links: %w", err)
+	}
+	linkedToTarget := make(map[uuid.UUID]bool)
+	documentIDs := []uuid.UUID{}
+	for _, link := range links {
+		if link.EpicID == targetID {
+			linkedToTarget[link.SteeringDocumentID] = true
+		}
+	}
+	for _, link := range links {
+		if link.EpicID != sourceID {
+			continue
+		}
+		if linkedToTarget[link.SteeringDocumentID] {
+			plan.duplicateLinks = append(plan.duplicateLinks, link)
+		} else {
+			plan.steeringLinks = append(plan.steeringLinks, link)
+		}
+		documentIDs = append(documentIDs, link.SteeringDocumentID)
+	}
+	if len(documentIDs) > 0 {
+		var documents []models.SteeringDocument
+		if err := db.Where("id IN ?", documentIDs).Find(&documents).Error; err != nil {
+			return nil, fmt.Errorf("failed to get steering documents: %w", err)
+		}
+		for _, document := range documents {
+			plan.steeringDocuments[document.ID] = document
+		}
+	}
+
+	if err := db.Where("entity_type = ? AND entity_id = ?", models.EntityTypeEpic, sourceID).
+		Order("created_at ASC").Find(&plan.comments).Error; err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	if err := s.planRelationships(db, plan); err != nil {
+		return nil, err
+	}
+
+	if plan.redirects, err = repos.ReferenceRedirect.ListByEntity(ctx, models.EntityTypeEpic, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to get epic redirects: %w", err)
+	}
+	return plan, nil
+}
+
+// planRelationships relinks the links of the source epic to the target epic. Links that would connect the
+// target epic to itself or duplicate one of its links are dropped.
+func (s *epicMergeService) planRelationships(db *gorm.DB, plan *epicMergePlan) error {
+	sourceID, targetID := plan.source.ID, plan.target.ID
+
+	var relationships []models.EntityRelationship
+	if err := db.Where("(source_type = ? AND source_id IN ?) OR (target_type = ? AND target_id IN ?)",
+		models.EntityTypeEpic, []uuid.UUID{sourceID, targetID}, models.EntityTypeEpic, []uuid.UUID{sourceID, targetID}).
+		Order("created_at ASC").Find(&relationships).Error; err != nil {
+		return fmt.Errorf("failed to get entity relationships: %w", err)
+	}
+
+	key := func(r models.EntityRelationship) string {
+		return fmt.Sprintf("%s/%s/%s/%s/%s", r.SourceType, r.SourceID, r.TargetType, r.TargetID, r.RelationshipTypeID)
+	}
+	isSource := func(entityType models.EntityType, id uuid.UUID) bool {
+		return entityType == models.EntityTypeEpic && id == sourceID
+	}
+
+	existing := make(map[string]bool)
+	for _, relationship := range relationships {
+		if !isSource(relationship.SourceType, relationship.SourceID) && !isSource(relationship.TargetType, relationship.TargetID) {
+			existing[key(relationship)] = true
+		}
+	}
+	for _, relationship := range relationships {
+		if !isSource(relationship.SourceType, relationship.SourceID) && !isSource(relationship.TargetType, relationship.TargetID) {
+			continue
+		}
+		if isSource(relationship.SourceType, relationship.SourceID) {
+			relationship.SourceID = targetID
+		}
+		if isSource(relationship.TargetType, relationship.TargetID) {
+			relationship.TargetID = targetID
+		}
+		selfLink := relationship.SourceType == relationship.TargetType && relationship.SourceID == relationship.TargetID
+		if selfLink || existing[key(relationship)] {
+			plan.droppedRelationshipIDs = append(plan.droppedRelationshipIDs, relationship.ID)
+			continue
+		}
+		existing[key(relationship)] = true
+		plan.relinkedRelationships = append(plan.relinkedRelationships, relationship)
+	}
+	return nil
+}
+
+func getEpicForMerge(ctx context.Context, repos *repository.Repositories, id uuid.UUID) (*models.Epic, error) {
+	epic, err := repos.Epic.GetByID(ctx, id)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil, ErrEpicNotFound
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+	return epic, nil
+}
+
+// apply performs the planned merge within the transaction of repos
+func (p *epicMergePlan) apply(ctx context.Context, repos *repository.Repositories, userID uuid.UUID) error {
+	db := repos.Epic.GetDB()
+	sourceID, targetID := p.source.ID, p.target.ID
+
+	if len(p.userStories) > 0 {
+		if err := db.Model(&models.UserStory{}).Where("epic_id = ?", sourceID).Update("epic_id", targetID).Error; err != nil {
+			return fmt.Errorf("failed to move user stories: %w", err)
+		}
+	}
+
+	for _, link := range p.duplicateLinks {
+		if err := db.Delete(&models.EpicSteeringDocument{}, "id = ?", link.ID).Error; err != nil {
+			return fmt.Errorf("failed to drop steering document link: %w", err)
+		}
+	}
+	for _, link := range p.steeringLinks {
+		if err := db.Model(&models.EpicSteeringDocument{}).Where("id = ?", link.ID).Update("epic_id", targetID).Error; err != nil {
+			return fmt.Errorf("failed to relink steering document: %w", err)
+		}
+	}
+
+	if err := p.moveComments(db); err != nil {
+		return err
+	}
+
+	if len(p.droppedRelationshipIDs) > 0 {
+		if err := db.Delete(&models.EntityRelationship{}, "id IN ?", p.droppedRelationshipIDs).Error; err != nil {
+			return fmt.Errorf("failed to drop entity relationships: %w", err)
+		}
+	}
+	for _, relationship := range p.relinkedRelationships {
+		if err := db.Model(&models.EntityRelationship{}).Where("id = ?", relationship.ID).Updates(map[string]interface{}{
+			"source_id": relationship.SourceID,
+			"target_id": relationship.TargetID,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to relink entity relationship: %w", err)
+		}
+	}
+
+	// Reference IDs of epics merged into the source epic earlier now resolve to the target epic as well
+	if err := repos.ReferenceRedirect.Retarget(ctx, models.EntityTypeEpic, sourceID, targetID); err != nil {
+		return fmt.Errorf("failed to retarget epic redirects: %w", err)
+	}
+	if err := db.Delete(&models.Epic{}, "id = ?", sourceID).Error; err != nil {
+		return fmt.Errorf("failed to delete merged epic: %w", err)
+	}
+	now := time.Now().UTC()
+	if err := repos.ReferenceRedirect.Create(ctx, &models.ReferenceRedirect{
+		ReferenceID: p.source.ReferenceID,
+		EntityType:  models.EntityTypeEpic,
+		EntityID:    targetID,
+		SourceID:    &sourceID,
+		Reason:      models.RedirectReasonMerged,
+		CreatedBy:   &userID,
+		CreatedAt:   now,
+	}); err != nil {
+		return fmt.Errorf("failed to create epic redirect: %w", err)
+	}
+
+	oldValue, newValue := p.source.ReferenceID, p.target.ReferenceID
+	if err := repos.Audit.Create(ctx, &models.AuditEvent{
+		EntityType: models.EntityTypeEpic,
+		EntityID:   targetID,
+		Action:     models.AuditActionMerged,
+		ActorID:    &userID,
+		Field:      "reference_id",
+		OldValue:   &oldValue,
+		NewValue:   &newValue,
+		RelatedID:  &sourceID,
+		CreatedAt:  now,
+	}); err != nil {
+		return fmt.Errorf("failed to record epic merge: %w", err)
+	}
+	return nil
+}
+
+// moveComments moves the comments of the source epic to the target epic. Each thread starts with a note on
+// where it came from, and inline comments become general comments because they anchor to the source description.
+func (p *epicMergePlan) moveComments(db *gorm.DB) error {
+	if len(p.comments) == 0 {
+		return nil
+	}
+	if err := db.Model(&models.Comment{}).Where("entity_type = ? AND entity_id = ?", models.EntityTypeEpic, p.source.ID).
+		Update("entity_id", p.target.ID).Error; err != nil {
+		return fmt.Errorf("failed to move comments: %w", err)
+	}
+
+	for _, comment := range p.comments {
+		if comment.ParentCommentID != nil {
+			continue
+		}
+		note := fmt.Sprintf("_Moved from %s “%s” when it was merged into %s._", p.source.ReferenceID, impactTitle(p.source.Title), p.target.ReferenceID)
+		if comment.LinkedText != nil {
+			note += fmt.Sprintf(" _Originally an inline comment on “%s”._", impactTitle(*comment.LinkedText))
+		}
+		if err := db.Model(&models.Comment{}).Where("id = ?", comment.ID).Updates(map[string]interface{}{
+			"content":             note + "\n\n" + comment.Content,
+			"linked_text":         nil,
+			"text_position_start": nil,
+			"text_position_end":   nil,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to annotate moved comment: %w", err)
+		}
+	}
+	return nil
+}
+
+// result describes the plan
+func (p *epicMergePlan) result(dryRun bool) *EpicMergeResult {
+	result := &EpicMergeResult{
+		DryRun:                         dryRun,
+		Source:                         EpicMergeItem{ID: p.source.ID, ReferenceID: p.source.ReferenceID, Title: p.source.Title},
+		Target:                         EpicMergeItem{ID: p.target.ID, ReferenceID: p.target.ReferenceID, Title: p.target.Title},
+		UserStories:                    []EpicMergeItem{},
+		SteeringDocuments:              p.steeringItems(p.steeringLinks),
+		AlreadyLinkedSteeringDocuments: p.steeringItems(p.duplicateLinks),
+		Comments:                       len(p.comments),
+		Relationships:                  len(p.relinkedRelationships),
+		DroppedRelationships:           len(p.droppedRelationshipIDs),
+		Redirects:                      []string{p.source.ReferenceID},
+	}
+	for _, userStory := range p.userStories {
+		result.UserStories = append(result.UserStories, EpicMergeItem{ID: userStory.ID, ReferenceID: userStory.ReferenceID, Title: userStory.Title})
+	}
+	for _, redirect := range p.redirects {
+		result.Redirects = append(result.Redirects, redirect.ReferenceID)
+	}
+	sort.Strings(result.Redirects)
+	return result
+}
+
+func (p *epicMergePlan) steeringItems(links []models.EpicSteeringDocument) []EpicMergeItem {
+	items := []EpicMergeItem{}
+	for _, link := range links {
+		document := p.steeringDocuments[link.SteeringDocumentID]
+		items = append(items, EpicMergeItem{ID: link.SteeringDocumentID, ReferenceID: document.ReferenceID, Title: document.Title})
+	}
+	return items
+}
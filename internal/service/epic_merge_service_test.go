@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEpicMergeService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.ReferenceRedirect{}, &models.UserStory{},
+		&models.SteeringDocument{}, &models.EpicSteeringDocument{}, &models.Comment{}, &models.RelationshipType{},
+		&models.EntityRelationship{}, &models.AuditEvent{}))
+	var relates models.RelationshipType
+	for _, relationshipType := range models.GetDefaultRelationshipTypes() {
+		require.NoError(t, db.Create(&relationshipType).Error)
+		if relationshipType.Name == "relates_to" {
+			relates = relationshipType
+		}
+	}
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epics := []models.Epic{
+		{ID: uuid.New(), ReferenceID: "EP-001", Title: "User Authentication"},
+		{ID: uuid.New(), ReferenceID: "EP-002", Title: "user authentication!"},
+		{ID: uuid.New(), ReferenceID: "EP-003", Title: "Checkout"},
+	}
+	for i := range epics {
+		epics[i].Status, epics[i].CreatorID, epics[i].AssigneeID = models.EpicStatusBacklog, user.ID, user.ID
+		require.NoError(t, session.Create(&epics[i]).Error)
+	}
+	target, source, other := epics[0], epics[1], epics[2]
+
+	story := models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: source.ID, Title: "Sign in with SSO",
+		Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(&story).Error)
+
+	documents := []models.SteeringDocument{
+		{ID: uuid.New(), ReferenceID: "STD-001", Title: "Security baseline", CreatorID: user.ID},
+		{ID: uuid.New(), ReferenceID: "STD-002", Title: "Identity providers", CreatorID: user.ID},
+	}
+	for i := range documents {
+		require.NoError(t, session.Create(&documents[i]).Error)
+	}
+	for _, link := range []models.EpicSteeringDocument{
+		{ID: uuid.New(), EpicID: target.ID, SteeringDocumentID: documents[0].ID},
+		{ID: uuid.New(), EpicID: source.ID, SteeringDocumentID: documents[0].ID},
+		{ID: uuid.New(), EpicID: source.ID, SteeringDocumentID: documents[1].ID},
+	} {
+		require.NoError(t, session.Create(&link).Error)
+	}
+
+	linkedText := "single sign-on"
+	start, end := 0, 14
+	root := models.Comment{ID: uuid.New(), EntityType: models.EntityTypeEpic, EntityID: source.ID, AuthorID: user.ID,
+		Content: "Which providers?", LinkedText: &linkedText, TextPositionStart: &start, TextPositionEnd: &end}
+	reply := models.Comment{ID: uuid.New(), EntityType: models.EntityTypeEpic, EntityID: source.ID, AuthorID: user.ID,
+		Content: "Okta first", ParentCommentID: &root.ID}
+	require.NoError(t, session.Create(&root).Error)
+	require.NoError(t, session.Create(&reply).Error)
+
+	for _, link := range []models.EntityRelationship{
+		{ID: uuid.New(), SourceType: models.EntityTypeEpic, SourceID: source.ID, TargetType: models.EntityTypeEpic, TargetID: target.ID},
+		{ID: uuid.New(), SourceType: models.EntityTypeEpic, SourceID: source.ID, TargetType: models.EntityTypeEpic, TargetID: other.ID},
+	} {
+		link.RelationshipTypeID, link.CreatedBy = relates.ID, user.ID
+		require.NoError(t, session.Create(&link).Error)
+	}
+
+	repos := repository.NewRepositories(db, nil)
+	svc := NewEpicMergeService(repos, nil)
+
+	t.Run("finds epics with duplicate titles", func(t *testing.T) {
+		groups, err := svc.FindDuplicateTitles(ctx)
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+		assert.Equal(t, "user authentication", groups[0].Title)
+		require.Len(t, groups[0].Epics, 2)
+	})
+
+	t.Run("rejects merging an epic into itself", func(t *testing.T) {
+		_, err := svc.MergeEpics(ctx, MergeEpicsRequest{SourceEpicID: "EP-001", TargetEpicID: target.ID.String()}, user.ID)
+		assert.ErrorIs(t, err, ErrEpicMergeSameEpic)
+
+		_, err = svc.MergeEpics(ctx, MergeEpicsRequest{SourceEpicID: "EP-404", TargetEpicID: "EP-001"}, user.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("dry run previews the merge without changing anything", func(t *testing.T) {
+		result, err := svc.MergeEpics(ctx, MergeEpicsRequest{SourceEpicID: "EP-002", TargetEpicID: "EP-001", DryRun: true}, user.ID)
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		require.Len(t, result.UserStories, 1)
+		assert.Equal(t, "US-001", result.UserStories[0].ReferenceID)
+		require.Len(t, result.SteeringDocuments, 1)
+		assert.Equal(t, "STD-002", result.SteeringDocuments[0].ReferenceID)
+		require.Len(t, result.AlreadyLinkedSteeringDocuments, 1)
+		assert.Equal(t, "STD-001", result.AlreadyLinkedSteeringDocuments[0].ReferenceID)
+		assert.Equal(t, 2, result.Comments)
+		assert.Equal(t, 1, result.Relationships)
+		assert.Equal(t, 1, result.DroppedRelationships)
+		assert.Equal(t, []string{"EP-002"}, result.Redirects)
+
+		var count int64
+		require.NoError(t, db.Model(&models.Epic{}).Where("id = ?", source.ID).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("merges the source epic into the target epic", func(t *testing.T) {
+		result, err := svc.MergeEpics(ctx, MergeEpicsRequest{SourceEpicID: "EP-002", TargetEpicID: "EP-001"}, user.ID)
+		require.NoError(t, err)
+		assert.False(t, result.DryRun)
+
+		var moved models.UserStory
+		require.NoError(t, db.First(&moved, "id = ?", story.ID).Error)
+		assert.Equal(t, target.ID, moved.EpicID)
+
+		var links []models.EpicSteeringDocument
+		require.NoError(t, db.Find(&links).Error)
+		require.Len(t, links, 2)
+		for _, link := range links {
+			assert.Equal(t, target.ID, link.EpicID)
+		}
+
+		var movedRoot, movedReply models.Comment
+		require.NoError(t, db.First(&movedRoot, "id = ?", root.ID).Error)
+		require.NoError(t, db.First(&movedReply, "id = ?", reply.ID).Error)
+		assert.Equal(t, target.ID, movedRoot.EntityID)
+		assert.Contains(t, movedRoot.Content, "Moved from EP-002")
+		assert.Contains(t, movedRoot.Content, "“single sign-on”")
+		assert.Nil(t, movedRoot.LinkedText)
+		assert.Equal(t, target.ID, movedReply.EntityID)
+		assert.Equal(t, "Okta first", movedReply.Content)
+
+		var relationships []models.EntityRelationship
+		require.NoError(t, db.Find(&relationships).Error)
+		require.Len(t, relationships, 1)
+		assert.Equal(t, target.ID, relationships[0].SourceID)
+		assert.Equal(t, other.ID, relationships[0].TargetID)
+
+		var events []models.AuditEvent
+		require.NoError(t, db.Where("action = ?", models.AuditActionMerged).Find(&events).Error)
+		require.Len(t, events, 1)
+		assert.Equal(t, target.ID, events[0].EntityID)
+
+		epic, err := NewEntityServices(repos).Epic.GetEpicByReferenceID(ctx, "EP-002")
+		require.NoError(t, err)
+		assert.Equal(t, target.ID, epic.ID)
+	})
+
+	t.Run("earlier redirects follow later merges", func(t *testing.T) {
+		_, err := svc.MergeEpics(ctx, MergeEpicsRequest{SourceEpicID: "EP-001", TargetEpicID: "EP-003"}, user.ID)
+		require.NoError(t, err)
+
+		epic, err := NewEntityServices(repos).Epic.GetEpicByReferenceID(ctx, "EP-002")
+		require.NoError(t, err)
+		assert.Equal(t, other.ID, epic.ID)
+	})
+}
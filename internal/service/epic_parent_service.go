@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrSelfParentEpic      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "an epic cannot be nested under itself")
+	ErrCircularEpicNesting = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "nesting would create a cycle")
+	ErrParentEpicNotFound  = apperrors.New(apperrors.KindNotFound, "PARENT_EPIC_NOT_FOUND", "parent epic not found")
+)
+
+// SetParentEpicRequest represents the request to nest an epic under a parent epic
+// @Description Parent epic of an epic; null makes the epic a top-level epic
+type SetParentEpicRequest struct {
+	ParentEpicID *string `json:"parent_epic_id" example:"EP-001"` // UUID or reference ID
+}
+
+// EpicParentService defines the interface for nesting epics under parent epics
+type EpicParentService interface {
+	SetParent(ctx context.Context, epicIDOrRef string, parentIDOrRef *string, userID uuid.UUID) (*models.Epic, error)
+}
+
+// epicParentService implements EpicParentService interface
+type epicParentService struct {
+	repos *repository.Repositories
+}
+
+// NewEpicParentService creates a new epic parent service instance
+func NewEpicParentService(repos *repository.Repositories) EpicParentService {
+	return &epicParentService{repos: repos}
+}
+
+// SetParent nests an epic under a parent epic, or makes it a top-level epic when parentIDOrRef is nil, and records
+// the change in the activity of the epic. An epic cannot be nested under itself or under an epic nested under it.
+func (s *epicParentService) SetParent(ctx context.Context, epicIDOrRef string, parentIDOrRef *string, userID uuid.UUID) (*models.Epic, error) {
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, err
+	}
+
+	var parentID *uuid.UUID
+	if parentIDOrRef != nil {
+		id, err := s.resolveParentID(ctx, epicID, *parentIDOrRef)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &id
+	}
+
+	var epic *models.Epic
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		// Serialize changes of parents, so concurrent changes cannot each pass the check and together form a cycle,
+		// and lock both epics against other changes until the update
+		if err := tx.Epic.LockNesting(ctx); err != nil {
+			return fmt.Errorf("failed to lock epic nesting: %w", err)
+		}
+		ids := []uuid.UUID{epicID}
+		if parentID != nil {
+			ids = append(ids, *parentID)
+		}
+		locked, err := tx.Epic.LockForUpdate(ctx, ids...)
+		if err != nil {
+			return fmt.Errorf("failed to lock epics: %w", err)
+		}
+		var parent *models.Epic
+		for i := range locked {
+			if locked[i].ID == epicID {
+				epic = &locked[i]
+			} else {
+				parent = &locked[i]
+			}
+		}
+		if epic == nil {
+			return ErrEpicNotFound
+		}
+		if parentID != nil {
+			if parent == nil {
+				return ErrParentEpicNotFound
+			}
+			if err := checkEpicNesting(ctx, tx, epicID, parent.ID); err != nil {
+				return err
+			}
+		}
+
+		oldParentID := epic.ParentEpicID
+		if sameEpicID(oldParentID, parentID) {
+			return nil
+		}
+
+		oldValue, err := epicReferenceID(ctx, tx, oldParentID)
+		if err != nil {
+			return err
+		}
+		epic.ParentEpicID = parentID
+		if err := tx.Epic.Update(ctx, epic); err != nil {
+			return fmt.Errorf("failed to update epic: %w", err)
+		}
+
+		event := &models.AuditEvent{
+			EntityType: models.EntityTypeEpic,
+			EntityID:   epic.ID,
+			Action:     models.AuditActionEdited,
+			ActorID:    &userID,
+			Field:      "parent_epic",
+			OldValue:   oldValue,
+			CreatedAt:  time.Now().UTC(),
+		}
+		if parent != nil {
+			event.NewValue = &parent.ReferenceID
+		}
+		if err := tx.Audit.Create(ctx, event); err != nil {
+			return fmt.Errorf("failed to record parent epic change: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+// resolveParentID resolves the epic an epic would be nested under, rejecting the epic itself
+func (s *epicParentService) resolveParentID(ctx context.Context, epicID uuid.UUID, parentIDOrRef string) (uuid.UUID, error) {
+	parentID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, parentIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return uuid.Nil, ErrParentEpicNotFound
+		}
+		return uuid.Nil, err
+	}
+	if parentID == epicID {
+		return uuid.Nil, ErrSelfParentEpic
+	}
+	return parentID, nil
+}
+
+// checkEpicNesting rejects nesting an epic under a parent that is nested under the epic
+func checkEpicNesting(ctx context.Context, tx *repository.Repositories, epicID, parentID uuid.UUID) error {
+	ancestors, err := tx.Hierarchy.ListEpicAncestors(ctx, parentID)
+	if err != nil {
+		return fmt.Errorf("failed to list parent epics: %w", err)
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == epicID {
+			return ErrCircularEpicNesting
+		}
+	}
+	return nil
+}
+
+// sameEpicID reports whether two optional epic IDs are equal
+func sameEpicID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// epicReferenceID returns the reference ID of an optional epic for the audit log
+func epicReferenceID(ctx context.Context, tx *repository.Repositories, epicID *uuid.UUID) (*string, error) {
+	if epicID == nil {
+		return nil, nil
+	}
+	epic, err := tx.Epic.GetByID(ctx, *epicID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get parent epic: %w", err)
+	}
+	return &epic.ReferenceID, nil
+}
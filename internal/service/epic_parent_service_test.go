@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestEpicParentService(t *testing.T) {
+	ctx := context.Background()
+	navigation, epic, criteria, requirements := setupNavigationTest(t)
+	db := navigation.(*navigationService).hierarchyRepo.GetDB()
+	require.NoError(t, db.AutoMigrate(&models.AuditEvent{}))
+	repos := repository.NewRepositories(db, nil)
+	svc := NewEpicParentService(repos)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	later := time.Now().Add(time.Hour)
+	initiative := &models.Epic{ID: uuid.New(), ReferenceID: "EP-010", Title: "Identity initiative", Status: models.EpicStatusBacklog,
+		CreatorID: epic.CreatorID, AssigneeID: epic.AssigneeID, CreatedAt: later}
+	sibling := &models.Epic{ID: uuid.New(), ReferenceID: "EP-011", Title: "Single sign-on", Status: models.EpicStatusInProgress,
+		CreatorID: epic.CreatorID, AssigneeID: epic.AssigneeID, CreatedAt: later.Add(time.Minute)}
+	for _, e := range []*models.Epic{initiative, sibling} {
+		require.NoError(t, session.Create(e).Error)
+	}
+	story := &models.UserStory{ID: uuid.New(), ReferenceID: "US-011", EpicID: sibling.ID, Title: "SAML login",
+		Status: models.UserStoryStatusDone, CreatorID: epic.CreatorID, AssigneeID: epic.AssigneeID}
+	require.NoError(t, session.Create(story).Error)
+
+	t.Run("nests epics under a parent epic", func(t *testing.T) {
+		parent := "EP-010"
+		nested, err := svc.SetParent(ctx, "EP-001", &parent, epic.CreatorID)
+		require.NoError(t, err)
+		require.NotNil(t, nested.ParentEpicID)
+		assert.Equal(t, initiative.ID, *nested.ParentEpicID)
+
+		parent = initiative.ID.String()
+		_, err = svc.SetParent(ctx, "EP-011", &parent, epic.CreatorID)
+		require.NoError(t, err)
+
+		events, err := repos.Audit.ListByEntity(ctx, models.EntityTypeEpic, epic.ID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "parent_epic", events[0].Field)
+		assert.Nil(t, events[0].OldValue)
+		assert.Equal(t, "EP-010", *events[0].NewValue)
+	})
+
+	t.Run("prevents cycles", func(t *testing.T) {
+		parent := "EP-001"
+		_, err := svc.SetParent(ctx, "EP-010", &parent, epic.CreatorID)
+		assert.ErrorIs(t, err, ErrCircularEpicNesting)
+
+		parent = "EP-010"
+		_, err = svc.SetParent(ctx, "EP-010", &parent, epic.CreatorID)
+		assert.ErrorIs(t, err, ErrSelfParentEpic)
+
+		parent = "EP-404"
+		_, err = svc.SetParent(ctx, "EP-001", &parent, epic.CreatorID)
+		assert.ErrorIs(t, err, ErrParentEpicNotFound)
+		_, err = svc.SetParent(ctx, "EP-404", nil, epic.CreatorID)
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+
+	_, err := repos.Hierarchy.RebuildEntries(ctx)
+	require.NoError(t, err)
+
+	t.Run("hierarchy includes the parent epics", func(t *testing.T) {
+		roots, total, err := navigation.ListEpicNodes(ctx, HierarchyFilters{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, roots, 1)
+		assert.Equal(t, "EP-010", roots[0].ReferenceID)
+		assert.Equal(t, int64(2), roots[0].ChildrenCount)
+
+		children, err := navigation.ListChildNodes(ctx, "epic", initiative.ID)
+		require.NoError(t, err)
+		require.Len(t, children, 2)
+		assert.Equal(t, "EP-001", children[0].ReferenceID)
+		assert.Equal(t, "epic", children[0].Type)
+		assert.Equal(t, "EP-011", children[1].ReferenceID)
+
+		path, err := navigation.GetEntityPath(ctx, "requirement", requirements[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"EP-010", "EP-001", "US-001", "AC-001", "REQ-001"}, pathReferenceIDs(path))
+
+		outline, err := navigation.GetOutline(ctx, "epic", initiative.ID)
+		require.NoError(t, err)
+		require.Len(t, outline.Children, 2)
+		assert.Equal(t, "EP-001", outline.Children[0].ReferenceID)
+		require.Len(t, outline.Children[0].Children, 1)
+		assert.Equal(t, "US-001", outline.Children[0].Children[0].ReferenceID)
+		assert.Equal(t, criteria.ID, outline.Children[0].Children[0].Children[0].ID)
+		assert.Equal(t, "US-011", outline.Children[1].Children[0].ReferenceID)
+	})
+
+	t.Run("progress rolls up the nested epics", func(t *testing.T) {
+		progress, err := navigation.GetProgress(ctx, "epic", initiative.ID)
+		require.NoError(t, err)
+		assert.Equal(t, HierarchyProgressCount{Total: 2, ByStatus: map[string]int{"Backlog": 1, "In Progress": 1}}, progress.Descendants["epic"])
+		assert.Equal(t, 2, progress.Descendants["user_story"].Total)
+		assert.Equal(t, 2, progress.Descendants["requirement"].Total)
+		require.NotNil(t, progress.CompletedPercent)
+		assert.Equal(t, 50.0, *progress.CompletedPercent)
+
+		progress, err = navigation.GetProgress(ctx, "epic", sibling.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 100.0, *progress.CompletedPercent)
+	})
+
+	t.Run("clearing the parent makes the epic top-level", func(t *testing.T) {
+		unnested, err := svc.SetParent(ctx, "EP-011", nil, epic.CreatorID)
+		require.NoError(t, err)
+		assert.Nil(t, unnested.ParentEpicID)
+
+		_, total, err := navigation.ListEpicNodes(ctx, HierarchyFilters{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+}
@@ -30,7 +30,7 @@ func (p *EpicResourceProvider) GetResourceDescriptors(ctx context.Context) ([]Re
 	p.logger.WithContext(ctx).Debug("Getting epic resource descriptors")
 
 	// Get epics with reasonable limit (1000 items max as per design)
-	epics, err := p.epicRepo.List(nil, "created_at ASC", 1000, 0)
+	epics, err := p.epicRepo.List(ctx, nil, "created_at ASC", 1000, 0)
 	if err != nil {
 		p.logger.WithContext(ctx).WithError(err).Error("Failed to get epics for resource descriptors")
 		return nil, fmt.Errorf("failed to get epics: %w", err)
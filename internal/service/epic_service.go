@@ -1,36 +1,39 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 	"product-requirements-management/internal/validation"
 )
 
 var (
-	ErrEpicNotFound       = errors.New("epic not found")
-	ErrEpicHasUserStories = errors.New("epic has associated user stories and cannot be deleted")
-	ErrInvalidEpicStatus  = errors.New("invalid epic status")
-	ErrInvalidPriority    = errors.New("invalid priority")
-	ErrUserNotFound       = errors.New("user not found")
+	ErrEpicNotFound       = apperrors.New(apperrors.KindNotFound, "EPIC_NOT_FOUND", "epic not found")
+	ErrEpicHasUserStories = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "epic has associated user stories and cannot be deleted")
+	ErrInvalidEpicStatus  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid epic status")
+	ErrInvalidPriority    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid priority")
+	ErrUserNotFound       = apperrors.New(apperrors.KindNotFound, "USER_NOT_FOUND", "user not found")
 )
 
 // EpicService defines the interface for epic business logic
 type EpicService interface {
-	CreateEpic(req CreateEpicRequest) (*models.Epic, error)
-	GetEpicByID(id uuid.UUID) (*models.Epic, error)
-	GetEpicByReferenceID(referenceID string) (*models.Epic, error)
-	UpdateEpic(id uuid.UUID, req UpdateEpicRequest) (*models.Epic, error)
-	DeleteEpic(id uuid.UUID, force bool) error
-	ListEpics(filters EpicFilters) ([]models.Epic, int64, error)
-	GetEpicWithUserStories(id uuid.UUID) (*models.Epic, error)
-	GetEpicWithCompleteHierarchy(id uuid.UUID) (*models.Epic, error)
-	ChangeEpicStatus(id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error)
-	AssignEpic(id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error)
+	CreateEpic(ctx context.Context, req CreateEpicRequest) (*models.Epic, error)
+	GetEpicByID(ctx context.Context, id uuid.UUID) (*models.Epic, error)
+	GetEpicByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error)
+	UpdateEpic(ctx context.Context, id uuid.UUID, req UpdateEpicRequest) (*models.Epic, error)
+	DeleteEpic(ctx context.Context, id uuid.UUID, force bool) error
+	ListEpics(ctx context.Context, filters EpicFilters) ([]models.Epic, int64, error)
+	GetEpicWithUserStories(ctx context.Context, id uuid.UUID) (*models.Epic, error)
+	GetEpicWithCompleteHierarchy(ctx context.Context, id uuid.UUID) (*models.Epic, error)
+	ChangeEpicStatus(ctx context.Context, id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error)
+	AssignEpic(ctx context.Context, id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error)
 }
 
 // CreateEpicRequest represents the request to create an epic
@@ -64,6 +67,11 @@ type CreateEpicRequest struct {
 	// @MaxLength 50000
 	// @Example "Implement a comprehensive user authentication and authorization system with JWT tokens, role-based access control, and secure password management."
 	Description *string `json:"description,omitempty"`
+
+	// DueDate is the planned delivery date of the epic
+	// @Description Planned delivery date of the epic (optional)
+	// @Example "2023-03-31T00:00:00Z"
+	DueDate *time.Time `json:"due_date,omitempty"`
 }
 
 // UpdateEpicRequest represents the request to update an epic
@@ -98,6 +106,11 @@ type UpdateEpicRequest struct {
 	// @MaxLength 50000
 	// @Example "Enhanced implementation with multi-factor authentication and advanced security features."
 	Description *string `json:"description,omitempty"`
+
+	// DueDate is the planned delivery date of the epic
+	// @Description Planned delivery date of the epic (optional)
+	// @Example "2023-04-30T00:00:00Z"
+	DueDate *time.Time `json:"due_date,omitempty"`
 }
 
 // EpicFilters represents filters for listing epics
@@ -126,6 +139,11 @@ type EpicFilters struct {
 	// @Example 1
 	Priority *models.Priority `json:"priority,omitempty"`
 
+	// IncludeArchived lists archived epics as well
+	// @Description Include archived epics (optional, default: false)
+	// @Example true
+	IncludeArchived bool `json:"include_archived,omitempty"`
+
 	// Include specifies which related entities to include
 	// @Description Comma-separated list of related entities to include (optional)
 	// @Example "creator,assignee,user_stories,comments"
@@ -136,6 +154,11 @@ type EpicFilters struct {
 	// @Example "created_at DESC"
 	OrderBy string `json:"order_by,omitempty"`
 
+	// Sort specifies several sort keys with directions and takes precedence over OrderBy
+	// @Description Comma-separated sort keys with optional direction (optional); ties are broken by ID
+	// @Example "priority:asc,updated_at:desc"
+	Sort string `json:"sort,omitempty"`
+
 	// Limit specifies the maximum number of results
 	// @Description Maximum number of results to return (optional, default: 50, max: 100)
 	// @Minimum 1
@@ -174,6 +197,9 @@ type epicService struct {
 	epicRepo        repository.EpicRepository
 	userRepo        repository.UserRepository
 	statusValidator validation.StatusValidator
+	assignmentRules AssignmentRuleEvaluator
+	// redirectRepo resolves retired reference IDs, such as those of merged-away epics, when set
+	redirectRepo repository.ReferenceRedirectRepository
 }
 
 // NewEpicService creates a new epic service instance
@@ -186,29 +212,37 @@ func NewEpicService(epicRepo repository.EpicRepository, userRepo repository.User
 }
 
 // CreateEpic creates a new epic
-func (s *epicService) CreateEpic(req CreateEpicRequest) (*models.Epic, error) {
+func (s *epicService) CreateEpic(ctx context.Context, req CreateEpicRequest) (*models.Epic, error) {
 	// Validate priority first
 	if req.Priority < models.PriorityCritical || req.Priority > models.PriorityLow {
 		return nil, ErrInvalidPriority
 	}
 
 	// Validate creator exists (creator ID is set from authenticated context)
-	if exists, err := s.userRepo.Exists(req.CreatorID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, req.CreatorID); err != nil {
 		return nil, fmt.Errorf("failed to check creator existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
 	}
 
-	// Set assignee to creator if not specified
+	// Set assignee to creator if not specified and no assignment rule matches
 	assigneeID := req.CreatorID
 	if req.AssigneeID != nil {
 		assigneeID = *req.AssigneeID
 		// Validate assignee exists
-		if exists, err := s.userRepo.Exists(assigneeID); err != nil {
+		if exists, err := s.userRepo.Exists(ctx, assigneeID); err != nil {
 			return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 		} else if !exists {
 			return nil, ErrUserNotFound
 		}
+	} else if s.assignmentRules != nil {
+		ruleAssigneeID, err := s.assignmentRules.DefaultAssignee(ctx, AssignmentSubject{EntityType: models.EntityTypeEpic, CreatorID: req.CreatorID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate assignment rules: %w", err)
+		}
+		if ruleAssigneeID != nil {
+			assigneeID = *ruleAssigneeID
+		}
 	}
 
 	epic := &models.Epic{
@@ -219,9 +253,10 @@ func (s *epicService) CreateEpic(req CreateEpicRequest) (*models.Epic, error) {
 		Status:      models.EpicStatusBacklog, // Default status
 		Title:       req.Title,
 		Description: req.Description,
+		DueDate:     req.DueDate,
 	}
 
-	if err := s.epicRepo.Create(epic); err != nil {
+	if err := s.epicRepo.Create(ctx, epic); err != nil {
 		return nil, fmt.Errorf("failed to create epic: %w", err)
 	}
 
@@ -229,8 +264,8 @@ func (s *epicService) CreateEpic(req CreateEpicRequest) (*models.Epic, error) {
 }
 
 // GetEpicByID retrieves an epic by its ID with creator and assignee preloaded
-func (s *epicService) GetEpicByID(id uuid.UUID) (*models.Epic, error) {
-	epic, err := s.epicRepo.GetByIDWithUsers(id)
+func (s *epicService) GetEpicByID(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
+	epic, err := s.epicRepo.GetByIDWithUsers(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -240,12 +275,13 @@ func (s *epicService) GetEpicByID(id uuid.UUID) (*models.Epic, error) {
 	return epic, nil
 }
 
-// GetEpicByReferenceID retrieves an epic by its reference ID with creator and assignee preloaded
-func (s *epicService) GetEpicByReferenceID(referenceID string) (*models.Epic, error) {
-	epic, err := s.epicRepo.GetByReferenceIDWithUsers(referenceID)
+// GetEpicByReferenceID retrieves an epic by its reference ID with creator and assignee preloaded.
+// A retired reference ID, such as the one of a merged-away epic, resolves to its successor.
+func (s *epicService) GetEpicByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error) {
+	epic, err := s.epicRepo.GetByReferenceIDWithUsers(ctx, referenceID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, ErrEpicNotFound
+			return getRedirected(ctx, s.redirectRepo, models.EntityTypeEpic, referenceID, ErrEpicNotFound, s.GetEpicByID)
 		}
 		return nil, fmt.Errorf("failed to get epic: %w", err)
 	}
@@ -253,8 +289,8 @@ func (s *epicService) GetEpicByReferenceID(referenceID string) (*models.Epic, er
 }
 
 // UpdateEpic updates an existing epic
-func (s *epicService) UpdateEpic(id uuid.UUID, req UpdateEpicRequest) (*models.Epic, error) {
-	epic, err := s.epicRepo.GetByID(id)
+func (s *epicService) UpdateEpic(ctx context.Context, id uuid.UUID, req UpdateEpicRequest) (*models.Epic, error) {
+	epic, err := s.epicRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -265,7 +301,7 @@ func (s *epicService) UpdateEpic(id uuid.UUID, req UpdateEpicRequest) (*models.E
 	// Update fields if provided
 	if req.AssigneeID != nil {
 		// Validate assignee exists
-		if exists, err := s.userRepo.Exists(*req.AssigneeID); err != nil {
+		if exists, err := s.userRepo.Exists(ctx, *req.AssigneeID); err != nil {
 			return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 		} else if !exists {
 			return nil, ErrUserNotFound
@@ -301,18 +337,22 @@ func (s *epicService) UpdateEpic(id uuid.UUID, req UpdateEpicRequest) (*models.E
 		epic.Description = req.Description
 	}
 
-	if err := s.epicRepo.Update(epic); err != nil {
+	if req.DueDate != nil {
+		epic.DueDate = req.DueDate
+	}
+
+	if err := s.epicRepo.Update(ctx, epic); err != nil {
 		return nil, fmt.Errorf("failed to update epic: %w", err)
 	}
 
 	// Reload with preloads to return complete data
-	return s.epicRepo.GetByIDWithUsers(id)
+	return s.epicRepo.GetByIDWithUsers(ctx, id)
 }
 
 // DeleteEpic deletes an epic with dependency validation
-func (s *epicService) DeleteEpic(id uuid.UUID, force bool) error {
+func (s *epicService) DeleteEpic(ctx context.Context, id uuid.UUID, force bool) error {
 	// Check if epic exists
-	_, err := s.epicRepo.GetByID(id)
+	_, err := s.epicRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrEpicNotFound
@@ -322,7 +362,7 @@ func (s *epicService) DeleteEpic(id uuid.UUID, force bool) error {
 
 	// Check for user stories unless force delete
 	if !force {
-		hasUserStories, err := s.epicRepo.HasUserStories(id)
+		hasUserStories, err := s.epicRepo.HasUserStories(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to check user stories: %w", err)
 		}
@@ -332,7 +372,7 @@ func (s *epicService) DeleteEpic(id uuid.UUID, force bool) error {
 	}
 
 	// Delete the epic (cascade will handle user stories if force=true)
-	if err := s.epicRepo.Delete(id); err != nil {
+	if err := s.epicRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete epic: %w", err)
 	}
 
@@ -340,7 +380,12 @@ func (s *epicService) DeleteEpic(id uuid.UUID, force bool) error {
 }
 
 // ListEpics retrieves epics with optional filtering
-func (s *epicService) ListEpics(filters EpicFilters) ([]models.Epic, int64, error) {
+func (s *epicService) ListEpics(ctx context.Context, filters EpicFilters) ([]models.Epic, int64, error) {
+	orderBy, err := epicSortFields.orderClause(sortOrFallback(filters.Sort, filters.OrderBy), "created_at DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// Build filter map
 	filterMap := make(map[string]interface{})
 
@@ -356,19 +401,16 @@ func (s *epicService) ListEpics(filters EpicFilters) ([]models.Epic, int64, erro
 	if filters.Priority != nil {
 		filterMap["priority"] = *filters.Priority
 	}
+	if !filters.IncludeArchived {
+		filterMap["archived"] = false
+	}
 
 	// Get total count with filters
-	totalCount, err := s.epicRepo.Count(filterMap)
+	totalCount, err := s.epicRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count epics: %w", err)
 	}
 
-	// Set default ordering
-	orderBy := "created_at DESC"
-	if filters.OrderBy != "" {
-		orderBy = filters.OrderBy
-	}
-
 	// Set default limit
 	limit := 50
 	if filters.Limit > 0 {
@@ -394,7 +436,7 @@ func (s *epicService) ListEpics(filters EpicFilters) ([]models.Epic, int64, erro
 	}
 
 	// Always use the method with includes since we have default preloads
-	epics, err := s.epicRepo.ListWithIncludes(filterMap, finalIncludes, orderBy, limit, filters.Offset)
+	epics, err := s.epicRepo.ListWithIncludes(ctx, filterMap, finalIncludes, orderBy, limit, filters.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list epics: %w", err)
 	}
@@ -403,8 +445,8 @@ func (s *epicService) ListEpics(filters EpicFilters) ([]models.Epic, int64, erro
 }
 
 // GetEpicWithUserStories retrieves an epic with its user stories
-func (s *epicService) GetEpicWithUserStories(id uuid.UUID) (*models.Epic, error) {
-	epic, err := s.epicRepo.GetWithUserStories(id)
+func (s *epicService) GetEpicWithUserStories(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
+	epic, err := s.epicRepo.GetWithUserStories(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -417,8 +459,8 @@ func (s *epicService) GetEpicWithUserStories(id uuid.UUID) (*models.Epic, error)
 // GetEpicWithCompleteHierarchy retrieves an epic with complete hierarchy
 // This includes: Epic → UserStories → [Requirements, AcceptanceCriteria]
 // Requirements and AcceptanceCriteria are loaded at the same level under each UserStory
-func (s *epicService) GetEpicWithCompleteHierarchy(id uuid.UUID) (*models.Epic, error) {
-	epic, err := s.epicRepo.GetCompleteHierarchy(id)
+func (s *epicService) GetEpicWithCompleteHierarchy(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
+	epic, err := s.epicRepo.GetCompleteHierarchy(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -429,8 +471,8 @@ func (s *epicService) GetEpicWithCompleteHierarchy(id uuid.UUID) (*models.Epic,
 }
 
 // ChangeEpicStatus changes the status of an epic
-func (s *epicService) ChangeEpicStatus(id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error) {
-	epic, err := s.epicRepo.GetByID(id)
+func (s *epicService) ChangeEpicStatus(ctx context.Context, id uuid.UUID, newStatus models.EpicStatus) (*models.Epic, error) {
+	epic, err := s.epicRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -448,26 +490,26 @@ func (s *epicService) ChangeEpicStatus(id uuid.UUID, newStatus models.EpicStatus
 	}
 
 	epic.Status = newStatus
-	if err := s.epicRepo.Update(epic); err != nil {
+	if err := s.epicRepo.Update(ctx, epic); err != nil {
 		return nil, fmt.Errorf("failed to update epic status: %w", err)
 	}
 
 	// Reload with preloads to return complete data
-	return s.epicRepo.GetByIDWithUsers(id)
+	return s.epicRepo.GetByIDWithUsers(ctx, id)
 }
 
 // AssignEpic assigns an epic to a user or unassigns it
-func (s *epicService) AssignEpic(id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error) {
+func (s *epicService) AssignEpic(ctx context.Context, id uuid.UUID, assigneeID *uuid.UUID) (*models.Epic, error) {
 	// Validate assignee exists if provided
 	if assigneeID != nil {
-		if exists, err := s.userRepo.Exists(*assigneeID); err != nil {
+		if exists, err := s.userRepo.Exists(ctx, *assigneeID); err != nil {
 			return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 		} else if !exists {
 			return nil, ErrUserNotFound
 		}
 	}
 
-	epic, err := s.epicRepo.GetByID(id)
+	epic, err := s.epicRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -483,10 +525,10 @@ func (s *epicService) AssignEpic(id uuid.UUID, assigneeID *uuid.UUID) (*models.E
 		epic.AssigneeID = epic.CreatorID
 	}
 
-	if err := s.epicRepo.Update(epic); err != nil {
+	if err := s.epicRepo.Update(ctx, epic); err != nil {
 		return nil, fmt.Errorf("failed to assign epic: %w", err)
 	}
 
 	// Reload with preloads to return complete data
-	return s.epicRepo.GetByIDWithUsers(id)
+	return s.epicRepo.GetByIDWithUsers(ctx, id)
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -19,12 +20,12 @@ type MockEpicRepository struct {
 	mock.Mock
 }
 
-func (m *MockEpicRepository) Create(epic *models.Epic) error {
+func (m *MockEpicRepository) Create(ctx context.Context, epic *models.Epic) error {
 	args := m.Called(epic)
 	return args.Error(0)
 }
 
-func (m *MockEpicRepository) GetByID(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -32,7 +33,7 @@ func (m *MockEpicRepository) GetByID(id uuid.UUID) (*models.Epic, error) {
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByReferenceID(referenceID string) (*models.Epic, error) {
+func (m *MockEpicRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,7 +41,7 @@ func (m *MockEpicRepository) GetByReferenceID(referenceID string) (*models.Epic,
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.Epic, error) {
+func (m *MockEpicRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.Epic, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -48,37 +49,37 @@ func (m *MockEpicRepository) GetByReferenceIDCaseInsensitive(referenceID string)
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) Update(epic *models.Epic) error {
+func (m *MockEpicRepository) Update(ctx context.Context, epic *models.Epic) error {
 	args := m.Called(epic)
 	return args.Error(0)
 }
 
-func (m *MockEpicRepository) Delete(id uuid.UUID) error {
+func (m *MockEpicRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockEpicRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Epic, error) {
+func (m *MockEpicRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Epic, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockEpicRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockEpicRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockEpicRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockEpicRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockEpicRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockEpicRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockEpicRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -91,7 +92,7 @@ func (m *MockEpicRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockEpicRepository) GetWithUserStories(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicRepository) GetWithUserStories(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -99,32 +100,32 @@ func (m *MockEpicRepository) GetWithUserStories(id uuid.UUID) (*models.Epic, err
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByCreator(creatorID uuid.UUID) ([]models.Epic, error) {
+func (m *MockEpicRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.Epic, error) {
 	args := m.Called(creatorID)
 	return args.Get(0).([]models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.Epic, error) {
+func (m *MockEpicRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.Epic, error) {
 	args := m.Called(assigneeID)
 	return args.Get(0).([]models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByStatus(status models.EpicStatus) ([]models.Epic, error) {
+func (m *MockEpicRepository) GetByStatus(ctx context.Context, status models.EpicStatus) ([]models.Epic, error) {
 	args := m.Called(status)
 	return args.Get(0).([]models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByPriority(priority models.Priority) ([]models.Epic, error) {
+func (m *MockEpicRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.Epic, error) {
 	args := m.Called(priority)
 	return args.Get(0).([]models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) HasUserStories(id uuid.UUID) (bool, error) {
+func (m *MockEpicRepository) HasUserStories(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByIDWithUsers(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicRepository) GetByIDWithUsers(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -132,7 +133,7 @@ func (m *MockEpicRepository) GetByIDWithUsers(id uuid.UUID) (*models.Epic, error
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByReferenceIDWithUsers(referenceID string) (*models.Epic, error) {
+func (m *MockEpicRepository) GetByReferenceIDWithUsers(ctx context.Context, referenceID string) (*models.Epic, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -140,7 +141,7 @@ func (m *MockEpicRepository) GetByReferenceIDWithUsers(referenceID string) (*mod
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetByReferenceIDWithUsersCaseInsensitive(referenceID string) (*models.Epic, error) {
+func (m *MockEpicRepository) GetByReferenceIDWithUsersCaseInsensitive(ctx context.Context, referenceID string) (*models.Epic, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -148,12 +149,12 @@ func (m *MockEpicRepository) GetByReferenceIDWithUsersCaseInsensitive(referenceI
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) ListWithIncludes(filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.Epic, error) {
+func (m *MockEpicRepository) ListWithIncludes(ctx context.Context, filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.Epic, error) {
 	args := m.Called(filters, includes, orderBy, limit, offset)
 	return args.Get(0).([]models.Epic), args.Error(1)
 }
 
-func (m *MockEpicRepository) GetCompleteHierarchy(id uuid.UUID) (*models.Epic, error) {
+func (m *MockEpicRepository) GetCompleteHierarchy(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -161,17 +162,27 @@ func (m *MockEpicRepository) GetCompleteHierarchy(id uuid.UUID) (*models.Epic, e
 	return args.Get(0).(*models.Epic), args.Error(1)
 }
 
+func (m *MockEpicRepository) LockForUpdate(ctx context.Context, ids ...uuid.UUID) ([]models.Epic, error) {
+	args := m.Called(ids)
+	return args.Get(0).([]models.Epic), args.Error(1)
+}
+
+func (m *MockEpicRepository) LockNesting(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 // MockUserRepository is a mock implementation of UserRepository
 type MockUserRepository struct {
 	mock.Mock
 }
 
-func (m *MockUserRepository) Create(user *models.User) error {
+func (m *MockUserRepository) Create(ctx context.Context, user *models.User) error {
 	args := m.Called(user)
 	return args.Error(0)
 }
 
-func (m *MockUserRepository) GetByID(id uuid.UUID) (*models.User, error) {
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -179,7 +190,7 @@ func (m *MockUserRepository) GetByID(id uuid.UUID) (*models.User, error) {
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) GetByReferenceID(referenceID string) (*models.User, error) {
+func (m *MockUserRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.User, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -187,7 +198,7 @@ func (m *MockUserRepository) GetByReferenceID(referenceID string) (*models.User,
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.User, error) {
+func (m *MockUserRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.User, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -195,37 +206,37 @@ func (m *MockUserRepository) GetByReferenceIDCaseInsensitive(referenceID string)
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) Update(user *models.User) error {
+func (m *MockUserRepository) Update(ctx context.Context, user *models.User) error {
 	args := m.Called(user)
 	return args.Error(0)
 }
 
-func (m *MockUserRepository) Delete(id uuid.UUID) error {
+func (m *MockUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockUserRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.User, error) {
+func (m *MockUserRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.User, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockUserRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockUserRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockUserRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockUserRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockUserRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -238,7 +249,7 @@ func (m *MockUserRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockUserRepository) GetByUsername(username string) (*models.User, error) {
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
 	args := m.Called(username)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -246,7 +257,7 @@ func (m *MockUserRepository) GetByUsername(username string) (*models.User, error
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) GetByEmail(email string) (*models.User, error) {
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	args := m.Called(email)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -254,17 +265,18 @@ func (m *MockUserRepository) GetByEmail(email string) (*models.User, error) {
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) ExistsByUsername(username string) (bool, error) {
+func (m *MockUserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
 	args := m.Called(username)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserRepository) ExistsByEmail(email string) (bool, error) {
+func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	args := m.Called(email)
 	return args.Bool(0), args.Error(1)
 }
 
 func TestEpicService_CreateEpic(t *testing.T) {
+	ctx := context.Background()
 	tests := []struct {
 		name          string
 		request       CreateEpicRequest
@@ -320,7 +332,7 @@ func TestEpicService_CreateEpic(t *testing.T) {
 
 			service := NewEpicService(epicRepo, userRepo)
 
-			epic, err := service.CreateEpic(tt.request)
+			epic, err := service.CreateEpic(ctx, tt.request)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -341,6 +353,7 @@ func TestEpicService_CreateEpic(t *testing.T) {
 }
 
 func TestEpicService_GetEpicByID(t *testing.T) {
+	ctx := context.Background()
 	tests := []struct {
 		name          string
 		epicID        uuid.UUID
@@ -378,7 +391,7 @@ func TestEpicService_GetEpicByID(t *testing.T) {
 
 			service := NewEpicService(epicRepo, userRepo)
 
-			epic, err := service.GetEpicByID(tt.epicID)
+			epic, err := service.GetEpicByID(ctx, tt.epicID)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -396,6 +409,7 @@ func TestEpicService_GetEpicByID(t *testing.T) {
 }
 
 func TestEpicService_DeleteEpic(t *testing.T) {
+	ctx := context.Background()
 	tests := []struct {
 		name          string
 		epicID        uuid.UUID
@@ -457,7 +471,7 @@ func TestEpicService_DeleteEpic(t *testing.T) {
 
 			service := NewEpicService(epicRepo, userRepo)
 
-			err := service.DeleteEpic(tt.epicID, tt.force)
+			err := service.DeleteEpic(ctx, tt.epicID, tt.force)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -473,6 +487,7 @@ func TestEpicService_DeleteEpic(t *testing.T) {
 }
 
 func TestEpicService_ChangeEpicStatus(t *testing.T) {
+	ctx := context.Background()
 	tests := []struct {
 		name          string
 		epicID        uuid.UUID
@@ -519,7 +534,7 @@ func TestEpicService_ChangeEpicStatus(t *testing.T) {
 
 			service := NewEpicService(epicRepo, userRepo)
 
-			epic, err := service.ChangeEpicStatus(tt.epicID, tt.newStatus)
+			epic, err := service.ChangeEpicStatus(ctx, tt.epicID, tt.newStatus)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -548,6 +563,7 @@ func stringPtr(s string) *string {
 }
 
 func TestEpicService_GetEpicWithCompleteHierarchy(t *testing.T) {
+	ctx := context.Background()
 	tests := []struct {
 		name          string
 		epicID        uuid.UUID
@@ -616,7 +632,7 @@ func TestEpicService_GetEpicWithCompleteHierarchy(t *testing.T) {
 
 			service := NewEpicService(epicRepo, userRepo)
 
-			epic, err := service.GetEpicWithCompleteHierarchy(tt.epicID)
+			epic, err := service.GetEpicWithCompleteHierarchy(ctx, tt.epicID)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -1,32 +1,32 @@
 package service
 
-import "errors"
+import "product-requirements-management/internal/apperrors"
 
 // Common service errors used across multiple services
 var (
 	// Requirement Type errors
-	ErrRequirementTypeNotFound = errors.New("requirement type not found")
+	ErrRequirementTypeNotFound = apperrors.New(apperrors.KindNotFound, "REQUIREMENT_TYPE_NOT_FOUND", "requirement type not found")
 
 	// Relationship Type errors
-	ErrRelationshipTypeNotFound = errors.New("relationship type not found")
+	ErrRelationshipTypeNotFound = apperrors.New(apperrors.KindNotFound, "RELATIONSHIP_TYPE_NOT_FOUND", "relationship type not found")
 
 	// Status transition errors
-	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	ErrInvalidStatusTransition = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid status transition")
 
 	// Steering Document errors
-	ErrSteeringDocumentNotFound = errors.New("steering document not found")
-	ErrLinkAlreadyExists        = errors.New("link already exists")
-	ErrUnauthorizedAccess       = errors.New("unauthorized access")
+	ErrSteeringDocumentNotFound = apperrors.New(apperrors.KindNotFound, "STEERING_DOCUMENT_NOT_FOUND", "steering document not found")
+	ErrLinkAlreadyExists        = apperrors.New(apperrors.KindConflict, "LINK_EXISTS", "link already exists")
+	ErrUnauthorizedAccess       = apperrors.New(apperrors.KindForbidden, apperrors.CodeInsufficientPermissions, "unauthorized access")
 
 	// General validation and permission errors
-	ErrValidation              = errors.New("validation error")
-	ErrInsufficientPermissions = errors.New("insufficient permissions")
+	ErrValidation              = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "validation error")
+	ErrInsufficientPermissions = apperrors.New(apperrors.KindForbidden, apperrors.CodeInsufficientPermissions, "insufficient permissions")
 
 	// Common CRUD errors
-	ErrNotFound       = errors.New("entity not found")
-	ErrDuplicateEntry = errors.New("duplicate entry")
+	ErrNotFound       = apperrors.New(apperrors.KindNotFound, apperrors.CodeEntityNotFound, "entity not found")
+	ErrDuplicateEntry = apperrors.New(apperrors.KindConflict, "DUPLICATE_ENTRY", "duplicate entry")
 
 	// Resource service errors
-	ErrResourceProviderFailed = errors.New("resource provider failed")
-	ErrNoResourceProviders    = errors.New("no resource providers registered")
+	ErrResourceProviderFailed = apperrors.New(apperrors.KindInternal, apperrors.CodeInternal, "resource provider failed")
+	ErrNoResourceProviders    = apperrors.New(apperrors.KindInternal, apperrors.CodeInternal, "no resource providers registered")
 )
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Event dispatch defaults
+const (
+	DefaultEventBatchSize   = 100
+	DefaultEventTopicPrefix = "rms"
+)
+
+// EventDispatcher defines the interface for publishing the entity events recorded in the outbox
+type EventDispatcher interface {
+	DispatchPending(ctx context.Context) (int, error)
+}
+
+// eventDispatcher implements EventDispatcher interface
+type eventDispatcher struct {
+	outbox      repository.OutboxRepository
+	publisher   EventPublisher
+	topicPrefix string
+	batchSize   int
+	retention   time.Duration
+	now         func() time.Time
+}
+
+// NewEventDispatcher creates a dispatcher that publishes outbox events to the topic <topicPrefix>.<entity type>,
+// keyed by entity ID. Published events are removed after the retention period; zero keeps them.
+func NewEventDispatcher(outbox repository.OutboxRepository, publisher EventPublisher, topicPrefix string, batchSize int, retention time.Duration) EventDispatcher {
+	if topicPrefix == "" {
+		topicPrefix = DefaultEventTopicPrefix
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultEventBatchSize
+	}
+	return &eventDispatcher{
+		outbox:      outbox,
+		publisher:   publisher,
+		topicPrefix: topicPrefix,
+		batchSize:   batchSize,
+		retention:   retention,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// DispatchPending publishes pending events in outbox order until none are left and returns their number.
+// It stops at the first failure, so later events of the same entity are never published before earlier ones;
+// the failed event is retried on the next run. Delivery is at least once: consumers drop duplicates by event ID.
+func (d *eventDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		events, err := d.outbox.ListPending(ctx, d.batchSize)
+		if err != nil {
+			return published, fmt.Errorf("failed to list pending events: %w", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		count, err := d.publishBatch(ctx, events)
+		published += count
+		if err != nil {
+			return published, err
+		}
+		if len(events) < d.batchSize {
+			break
+		}
+	}
+
+	if d.retention > 0 {
+		if _, err := d.outbox.DeletePublishedBefore(ctx, d.now().Add(-d.retention)); err != nil {
+			return published, fmt.Errorf("failed to remove published events: %w", err)
+		}
+	}
+	return published, nil
+}
+
+// publishBatch publishes events in order and marks the published ones
+func (d *eventDispatcher) publishBatch(ctx context.Context, events []models.OutboxEvent) (int, error) {
+	ids := make([]int64, 0, len(events))
+	var publishErr error
+	for _, event := range events {
+		message := EventMessage{
+			Topic:   d.topicPrefix + "." + string(event.EntityType),
+			Key:     event.EntityID.String(),
+			Payload: []byte(event.Payload),
+		}
+		if err := d.publisher.Publish(ctx, message); err != nil {
+			publishErr = fmt.Errorf("failed to publish event %s: %w", event.EventID, err)
+			if recordErr := d.outbox.RecordFailure(ctx, event.ID, err.Error()); recordErr != nil {
+				publishErr = fmt.Errorf("%w (recording the failure failed: %v)", publishErr, recordErr)
+			}
+			break
+		}
+		ids = append(ids, event.ID)
+	}
+
+	if err := d.outbox.MarkPublished(ctx, ids, d.now()); err != nil {
+		return 0, fmt.Errorf("failed to mark events as published: %w", err)
+	}
+	return len(ids), publishErr
+}
+
+// RunEventDispatcher publishes pending events every interval until the context is cancelled
+func RunEventDispatcher(ctx context.Context, dispatcher EventDispatcher, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			published, err := dispatcher.DispatchPending(ctx)
+			if err != nil {
+				logger.WithError(err).WithField("published", published).Warn("Event dispatch run failed")
+				continue
+			}
+			if published > 0 {
+				logger.WithField("published", published).Debug("Entity events published")
+			}
+		}
+	}
+}
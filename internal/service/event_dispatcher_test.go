@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// recordingEventPublisher records published messages and fails for the configured keys
+type recordingEventPublisher struct {
+	messages []EventMessage
+	failKeys map[string]bool
+}
+
+func (p *recordingEventPublisher) Publish(_ context.Context, message EventMessage) error {
+	if p.failKeys[message.Key] {
+		return errors.New("broker unavailable")
+	}
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+func (p *recordingEventPublisher) Close() error {
+	return nil
+}
+
+func TestEventDispatcher_DispatchPending(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.OutboxEvent{}))
+	require.NoError(t, repository.RegisterOutboxCallbacks(db))
+	useSequentialReferenceIDs(t)
+
+	user := &models.User{Username: "publisher", Email: "publisher@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+	newEpic := func(title string) *models.Epic {
+		epic := &models.Epic{CreatorID: user.ID, AssigneeID: user.ID, Priority: models.PriorityHigh, Status: models.EpicStatusBacklog, Title: title}
+		require.NoError(t, db.Create(epic).Error)
+		return epic
+	}
+	first, second, third := newEpic("First"), newEpic("Second"), newEpic("Third")
+	require.NoError(t, db.Model(first).Update("title", "First renamed").Error)
+
+	outbox := repository.NewOutboxRepository(db)
+	publisher := &recordingEventPublisher{failKeys: map[string]bool{third.ID.String(): true}}
+	dispatcher := NewEventDispatcher(outbox, publisher, "analytics", 2, 24*time.Hour).(*eventDispatcher)
+
+	published, err := dispatcher.DispatchPending(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "broker unavailable")
+	assert.Equal(t, 2, published, "dispatch stops at the failed event to keep the order")
+	require.Len(t, publisher.messages, 2)
+	assert.Equal(t, "analytics.epic", publisher.messages[0].Topic)
+	assert.Equal(t, first.ID.String(), publisher.messages[0].Key)
+	assert.Equal(t, second.ID.String(), publisher.messages[1].Key)
+
+	var event models.EntityEvent
+	require.NoError(t, json.Unmarshal(publisher.messages[0].Payload, &event))
+	assert.Equal(t, "epic.created", event.Type)
+
+	pending, err := outbox.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, third.ID, pending[0].EntityID)
+	assert.Equal(t, 1, pending[0].Attempts)
+	assert.Contains(t, pending[0].LastError, "broker unavailable")
+
+	// The failed event is retried first on the next run
+	publisher.failKeys = nil
+	published, err = dispatcher.DispatchPending(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	require.Len(t, publisher.messages, 4)
+	assert.Equal(t, third.ID.String(), publisher.messages[2].Key)
+	require.NoError(t, json.Unmarshal(publisher.messages[3].Payload, &event))
+	assert.Equal(t, "epic.updated", event.Type)
+
+	count, err := outbox.CountPending(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// Published events are removed after the retention period
+	dispatcher.now = func() time.Time { return time.Now().UTC().Add(48 * time.Hour) }
+	_, err = dispatcher.DispatchPending(context.Background())
+	require.NoError(t, err)
+	var remaining int64
+	require.NoError(t, db.Model(&models.OutboxEvent{}).Count(&remaining).Error)
+	assert.Zero(t, remaining)
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaRESTPublisher_Publish(t *testing.T) {
+	var received struct {
+		Records []struct {
+			Key   string          `json:"key"`
+			Value json.RawMessage `json:"value"`
+		} `json:"records"`
+	}
+	var path, contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &received))
+		if strings.HasSuffix(r.URL.Path, "rms.missing") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code":40401,"message":"Topic not found"}`))
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "rms.rejected") {
+			_, _ = w.Write([]byte(`{"offsets":[{"partition":null,"offset":null,"error_code":1,"error":"record too large"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":7,"error_code":null,"error":null}]}`))
+	}))
+	defer server.Close()
+
+	publisher := NewKafkaRESTPublisher(server.URL+"/", time.Second)
+	defer publisher.Close()
+
+	err := publisher.Publish(context.Background(), EventMessage{Topic: "rms.requirement", Key: "entity-1", Payload: []byte(`{"type":"requirement.created"}`)})
+	require.NoError(t, err)
+	assert.Equal(t, "/topics/rms.requirement", path)
+	assert.Equal(t, "application/vnd.kafka.json.v2+json", contentType)
+	require.Len(t, received.Records, 1)
+	assert.Equal(t, "entity-1", received.Records[0].Key)
+	assert.JSONEq(t, `{"type":"requirement.created"}`, string(received.Records[0].Value))
+
+	err = publisher.Publish(context.Background(), EventMessage{Topic: "rms.missing", Payload: []byte(`{}`)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+
+	err = publisher.Publish(context.Background(), EventMessage{Topic: "rms.rejected", Payload: []byte(`{}`)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "record too large")
+}
+
+// fakeNATSServer accepts NATS connections and records the published messages
+type fakeNATSServer struct {
+	listener  net.Listener
+	connects  chan string
+	published chan string
+	reject    string // Subject answered with -ERR
+}
+
+func newFakeNATSServer(t *testing.T) *fakeNATSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeNATSServer{listener: listener, connects: make(chan string, 10), published: make(chan string, 10)}
+	go server.serve()
+	t.Cleanup(func() { listener.Close() })
+	return server
+}
+
+func (s *fakeNATSServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeNATSServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	fmt.Fprint(conn, "INFO {\"server_id\":\"test\",\"max_payload\":1048576}\r\n")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(line, "CONNECT "):
+			s.connects <- strings.TrimPrefix(line, "CONNECT ")
+		case line == "PING":
+			// Ask the client for a PONG first, as servers do to check liveness
+			fmt.Fprint(conn, "PING\r\nPONG\r\n")
+		case line == "PONG":
+		case strings.HasPrefix(line, "PUB "):
+			fields := strings.Fields(line)
+			size, _ := strconv.Atoi(fields[2])
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(reader, payload); err != nil {
+				return
+			}
+			if fields[1] == s.reject {
+				fmt.Fprint(conn, "-ERR 'Permissions Violation for Publish to "+fields[1]+"'\r\n")
+				return
+			}
+			s.published <- fields[1] + " " + string(payload[:size])
+		}
+	}
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	server := newFakeNATSServer(t)
+	server.reject = "rms.forbidden"
+
+	publisher, err := NewNATSPublisher("nats://analytics:secret@"+server.listener.Addr().String(), time.Second)
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	require.NoError(t, publisher.Publish(context.Background(), EventMessage{Topic: "rms.epic", Key: "entity-1", Payload: []byte(`{"type":"epic.created"}`)}))
+	require.NoError(t, publisher.Publish(context.Background(), EventMessage{Topic: "rms.epic", Payload: []byte(`{"type":"epic.updated"}`)}))
+	assert.Equal(t, `rms.epic {"type":"epic.created"}`, <-server.published)
+	assert.Equal(t, `rms.epic {"type":"epic.updated"}`, <-server.published)
+
+	var options map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(<-server.connects), &options))
+	assert.Equal(t, "analytics", options["user"])
+	assert.Equal(t, "secret", options["pass"])
+	assert.Len(t, server.connects, 0, "the connection is reused")
+
+	err = publisher.Publish(context.Background(), EventMessage{Topic: "rms.forbidden", Payload: []byte(`{}`)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Permissions Violation")
+
+	// The publisher reconnects after a failure
+	require.NoError(t, publisher.Publish(context.Background(), EventMessage{Topic: "rms.epic", Payload: []byte(`{"type":"epic.deleted"}`)}))
+	assert.Equal(t, `rms.epic {"type":"epic.deleted"}`, <-server.published)
+	assert.Len(t, server.connects, 1)
+
+	err = publisher.Publish(context.Background(), EventMessage{Topic: "rms epic", Payload: []byte(`{}`)})
+	assert.Error(t, err)
+}
+
+func TestNewNATSPublisher_InvalidURL(t *testing.T) {
+	_, err := NewNATSPublisher("http://localhost:4222", time.Second)
+	assert.Error(t, err)
+	_, err = NewNATSPublisher("localhost", time.Second)
+	assert.Error(t, err)
+
+	publisher, err := NewNATSPublisher("nats://s3cr3t@localhost", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:4222", publisher.(*natsPublisher).addr)
+	assert.Equal(t, "s3cr3t", publisher.(*natsPublisher).token)
+}
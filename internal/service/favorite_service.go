@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrFavoriteNotFound = apperrors.New(apperrors.KindNotFound, "FAVORITE_NOT_FOUND", "entity is not a favorite")
+)
+
+// FavoriteService defines the interface for entities users pin for quick access
+type FavoriteService interface {
+	AddFavorite(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) (*FavoriteItem, error)
+	RemoveFavorite(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) error
+	ListFavorites(ctx context.Context, userID uuid.UUID) ([]FavoriteItem, error)
+	FavoriteIDs(ctx context.Context, userID uuid.UUID, entityType models.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+}
+
+// FavoriteItem is an entity the user pinned
+// @Description Pinned entity; acceptance criteria use the start of their description as title
+type FavoriteItem struct {
+	EntityType  models.EntityType `json:"entity_type" example:"epic"`
+	EntityID    uuid.UUID         `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string            `json:"reference_id" example:"EP-001"`
+	Title       string            `json:"title" example:"User Authentication System"`
+	FavoritedAt time.Time         `json:"favorited_at" example:"2023-01-01T10:00:00Z"`
+}
+
+// favoriteService implements FavoriteService interface
+type favoriteService struct {
+	favoriteRepo repository.FavoriteRepository
+	repos        *repository.Repositories
+}
+
+// NewFavoriteService creates a new favorite service instance
+func NewFavoriteService(repos *repository.Repositories) FavoriteService {
+	return &favoriteService{
+		favoriteRepo: repos.Favorite,
+		repos:        repos,
+	}
+}
+
+// AddFavorite pins an entity for the user; pinning an already pinned entity keeps the original timestamp
+func (s *favoriteService) AddFavorite(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) (*FavoriteItem, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	text, err := loadEntityText(ctx, s.repos, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	favorite := &models.Favorite{
+		EntityType: entityType,
+		EntityID:   entityID,
+		UserID:     userID,
+	}
+	if err := s.favoriteRepo.Create(ctx, favorite); err != nil {
+		return nil, fmt.Errorf("failed to add favorite: %w", err)
+	}
+	if favorite, err = s.favoriteRepo.Get(ctx, entityType, entityID, userID); err != nil {
+		return nil, fmt.Errorf("failed to get favorite: %w", err)
+	}
+
+	return newFavoriteItem(favorite, text), nil
+}
+
+// RemoveFavorite unpins an entity for the user
+func (s *favoriteService) RemoveFavorite(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) error {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return err
+	}
+
+	if err := s.favoriteRepo.Delete(ctx, entityType, entityID, userID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrFavoriteNotFound
+		}
+		return fmt.Errorf("failed to remove favorite: %w", err)
+	}
+	return nil
+}
+
+// ListFavorites returns the entities the user pinned, most recently pinned first.
+// Entities deleted since they were pinned are left out.
+func (s *favoriteService) ListFavorites(ctx context.Context, userID uuid.UUID) ([]FavoriteItem, error) {
+	favorites, err := s.favoriteRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorites: %w", err)
+	}
+
+	items := make([]FavoriteItem, 0, len(favorites))
+	for i := range favorites {
+		text, err := loadEntityText(ctx, s.repos, favorites[i].EntityType, favorites[i].EntityID)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidEntityType) {
+				continue
+			}
+			return nil, err
+		}
+		items = append(items, *newFavoriteItem(&favorites[i], text))
+	}
+	return items, nil
+}
+
+// FavoriteIDs returns the set of the given entities the user pinned
+func (s *favoriteService) FavoriteIDs(ctx context.Context, userID uuid.UUID, entityType models.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	ids, err := s.favoriteRepo.ListFavoriteEntityIDs(ctx, userID, entityType, entityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorites: %w", err)
+	}
+
+	favorites := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		favorites[id] = true
+	}
+	return favorites, nil
+}
+
+// newFavoriteItem builds a favorite item from a favorite and the pinned entity's text
+func newFavoriteItem(favorite *models.Favorite, text *entityText) *FavoriteItem {
+	return &FavoriteItem{
+		EntityType:  favorite.EntityType,
+		EntityID:    favorite.EntityID,
+		ReferenceID: text.ReferenceID,
+		Title:       text.displayTitle(),
+		FavoritedAt: favorite.CreatedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestFavoriteService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.Requirement{}, &models.Favorite{}))
+
+	user := &models.User{Username: "pinner", Email: "pinner@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "User Authentication", Status: models.EpicStatusBacklog}
+	requirement := models.Requirement{ID: uuid.New(), ReferenceID: "REQ-001", Title: "Support OAuth 2.0"}
+	otherRequirement := models.Requirement{ID: uuid.New(), ReferenceID: "REQ-002", Title: "Support SAML"}
+	require.NoError(t, session.Create(&epic).Error)
+	require.NoError(t, session.Create(&requirement).Error)
+	require.NoError(t, session.Create(&otherRequirement).Error)
+
+	service := NewFavoriteService(repository.NewRepositories(db, nil))
+
+	item, err := service.AddFavorite(ctx, models.EntityTypeEpic, "EP-001", user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, epic.ID, item.EntityID)
+	assert.Equal(t, "User Authentication", item.Title)
+	assert.False(t, item.FavoritedAt.IsZero())
+
+	again, err := service.AddFavorite(ctx, models.EntityTypeEpic, epic.ID.String(), user.ID)
+	require.NoError(t, err, "pinning twice is a no-op")
+	assert.Equal(t, item.FavoritedAt.Unix(), again.FavoritedAt.Unix())
+
+	_, err = service.AddFavorite(ctx, models.EntityTypeRequirement, "REQ-001", user.ID)
+	require.NoError(t, err)
+
+	_, err = service.AddFavorite(ctx, models.EntityTypeRequirement, "REQ-404", user.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	t.Run("list favorites", func(t *testing.T) {
+		items, err := service.ListFavorites(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+
+		items, err = service.ListFavorites(ctx, uuid.New())
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+
+	t.Run("favorite IDs", func(t *testing.T) {
+		ids, err := service.FavoriteIDs(ctx, user.ID, models.EntityTypeRequirement, []uuid.UUID{requirement.ID, otherRequirement.ID, epic.ID})
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]bool{requirement.ID: true}, ids)
+	})
+
+	t.Run("remove favorite", func(t *testing.T) {
+		require.NoError(t, service.RemoveFavorite(ctx, models.EntityTypeRequirement, "REQ-001", user.ID))
+		assert.ErrorIs(t, service.RemoveFavorite(ctx, models.EntityTypeRequirement, "REQ-001", user.ID), ErrFavoriteNotFound)
+
+		items, err := service.ListFavorites(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "EP-001", items[0].ReferenceID)
+	})
+}
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrGlossaryTermNotFound = apperrors.New(apperrors.KindNotFound, "GLOSSARY_TERM_NOT_FOUND", "glossary term not found")
+	ErrGlossaryTermExists   = apperrors.New(apperrors.KindConflict, "GLOSSARY_TERM_EXISTS", "glossary term already exists")
+	ErrGlossaryTermEmpty    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "glossary term and definition cannot be empty")
+)
+
+// GlossaryService defines the interface for glossary management and term recognition
+type GlossaryService interface {
+	CreateTerm(ctx context.Context, req CreateGlossaryTermRequest, creatorID uuid.UUID) (*models.GlossaryTerm, error)
+	GetTerm(ctx context.Context, id uuid.UUID) (*models.GlossaryTerm, error)
+	UpdateTerm(ctx context.Context, id uuid.UUID, req UpdateGlossaryTermRequest) (*models.GlossaryTerm, error)
+	DeleteTerm(ctx context.Context, id uuid.UUID) error
+	ListTerms(ctx context.Context) ([]models.GlossaryTerm, error)
+	AnnotateRequirement(ctx context.Context, requirement *models.Requirement) (*AnnotatedRequirement, error)
+	GetUndefinedTermsReport(ctx context.Context) (*UndefinedTermsReport, error)
+}
+
+// CreateGlossaryTermRequest represents the request to add a glossary term
+// @Description Request payload for adding a term to the glossary
+type CreateGlossaryTermRequest struct {
+	Term       string `json:"term" binding:"required,max=255" example:"Service Level Agreement"`                                  // Term as it should be written
+	Definition string `json:"definition" binding:"required" example:"Contractual commitment on availability and response times."` // Meaning of the term
+}
+
+// UpdateGlossaryTermRequest represents the request to change a glossary term
+// @Description Request payload for changing a glossary term; only provided fields are updated
+type UpdateGlossaryTermRequest struct {
+	Term       *string `json:"term,omitempty" binding:"omitempty,max=255" example:"Service Level Agreement"`
+	Definition *string `json:"definition,omitempty" example:"Contractual commitment on availability and response times."`
+}
+
+// TermAnnotation marks an occurrence of a glossary term in an entity field
+// @Description Occurrence of a glossary term; start and end are byte offsets into the field, like inline comment positions
+type TermAnnotation struct {
+	TermID     uuid.UUID `json:"term_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Term       string    `json:"term" example:"Service Level Agreement"`
+	Definition string    `json:"definition" example:"Contractual commitment on availability and response times."`
+	Field      string    `json:"field" example:"description"` // title or description
+	Start      int       `json:"start" example:"12"`
+	End        int       `json:"end" example:"35"`
+	Text       string    `json:"text" example:"service level agreement"` // The occurrence as written in the field
+}
+
+// AnnotatedRequirement is a requirement together with the glossary terms found in its text
+// @Description Requirement with the occurrences of glossary terms in its title and description
+type AnnotatedRequirement struct {
+	models.Requirement
+	TermAnnotations []TermAnnotation `json:"term_annotations"`
+}
+
+// MarshalJSON implements custom JSON marshaling for AnnotatedRequirement.
+// The embedded requirement's MarshalJSON would otherwise hide the annotations.
+func (ar AnnotatedRequirement) MarshalJSON() ([]byte, error) {
+	return marshalWithFields(&ar.Requirement, map[string]interface{}{
+		"term_annotations": ar.TermAnnotations,
+	})
+}
+
+// UndefinedTerm is a capitalized term that is used in requirements but missing from the glossary
+// @Description Capitalized term used in requirements without a glossary definition
+type UndefinedTerm struct {
+	Term         string   `json:"term" example:"Payment Gateway"`
+	Occurrences  int      `json:"occurrences" example:"4"`
+	Requirements []string `json:"requirements" example:"REQ-001,REQ-007"` // Reference IDs of the requirements using the term
+}
+
+// UndefinedTermsReport is the glossary coverage quality report
+// @Description Capitalized terms used in requirement titles and descriptions that are not defined in the glossary
+type UndefinedTermsReport struct {
+	Terms                []UndefinedTerm `json:"terms"`
+	RequirementsScanned  int             `json:"requirements_scanned" example:"120"`
+	GlossaryTermsDefined int             `json:"glossary_terms_defined" example:"35"`
+}
+
+// glossaryService implements GlossaryService interface
+type glossaryService struct {
+	glossaryRepo    repository.GlossaryTermRepository
+	requirementRepo repository.RequirementRepository
+}
+
+// NewGlossaryService creates a new glossary service instance
+func NewGlossaryService(repos *repository.Repositories) GlossaryService {
+	return &glossaryService{
+		glossaryRepo:    repos.GlossaryTerm,
+		requirementRepo: repos.Requirement,
+	}
+}
+
+// CreateTerm adds a term to the glossary
+func (s *glossaryService) CreateTerm(ctx context.Context, req CreateGlossaryTermRequest, creatorID uuid.UUID) (*models.GlossaryTerm, error) {
+	term := &models.GlossaryTerm{
+		Term:       strings.TrimSpace(req.Term),
+		Definition: strings.TrimSpace(req.Definition),
+		CreatorID:  creatorID,
+	}
+	if term.Term == "" || term.Definition == "" {
+		return nil, ErrGlossaryTermEmpty
+	}
+	if err := s.ensureTermAvailable(ctx, term.Term, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.glossaryRepo.Create(ctx, term); err != nil {
+		return nil, fmt.Errorf("failed to create glossary term: %w", err)
+	}
+	return term, nil
+}
+
+// GetTerm retrieves a glossary term by ID
+func (s *glossaryService) GetTerm(ctx context.Context, id uuid.UUID) (*models.GlossaryTerm, error) {
+	term, err := s.glossaryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrGlossaryTermNotFound
+		}
+		return nil, fmt.Errorf("failed to get glossary term: %w", err)
+	}
+	return term, nil
+}
+
+// UpdateTerm changes the text or definition of a glossary term
+func (s *glossaryService) UpdateTerm(ctx context.Context, id uuid.UUID, req UpdateGlossaryTermRequest) (*models.GlossaryTerm, error) {
+	term, err := s.GetTerm(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Term != nil {
+		term.Term = strings.TrimSpace(*req.Term)
+	}
+	if req.Definition != nil {
+		term.Definition = strings.TrimSpace(*req.Definition)
+	}
+	if term.Term == "" || term.Definition == "" {
+		return nil, ErrGlossaryTermEmpty
+	}
+	if err := s.ensureTermAvailable(ctx, term.Term, term.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.glossaryRepo.Update(ctx, term); err != nil {
+		return nil, fmt.Errorf("failed to update glossary term: %w", err)
+	}
+	return term, nil
+}
+
+// DeleteTerm removes a term from the glossary
+func (s *glossaryService) DeleteTerm(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetTerm(ctx, id); err != nil {
+		return err
+	}
+	if err := s.glossaryRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete glossary term: %w", err)
+	}
+	return nil
+}
+
+// ListTerms returns all glossary terms in alphabetical order
+func (s *glossaryService) ListTerms(ctx context.Context) ([]models.GlossaryTerm, error) {
+	terms, err := s.glossaryRepo.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list glossary terms: %w", err)
+	}
+	return terms, nil
+}
+
+// AnnotateRequirement finds the glossary terms used in a requirement's title and description
+func (s *glossaryService) AnnotateRequirement(ctx context.Context, requirement *models.Requirement) (*AnnotatedRequirement, error) {
+	terms, err := s.ListTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := annotateTerms(terms, "title", requirement.Title)
+	if requirement.Description != nil {
+		annotations = append(annotations, annotateTerms(terms, "description", *requirement.Description)...)
+	}
+
+	return &AnnotatedRequirement{
+		Requirement:     *requirement,
+		TermAnnotations: annotations,
+	}, nil
+}
+
+// GetUndefinedTermsReport lists capitalized terms used in requirements that the glossary does not define
+func (s *glossaryService) GetUndefinedTermsReport(ctx context.Context) (*UndefinedTermsReport, error) {
+	terms, err := s.ListTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requirements, err := s.requirementRepo.List(ctx, nil, "reference_id ASC", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirements: %w", err)
+	}
+
+	defined := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		defined[strings.ToLower(term.Term)] = true
+	}
+
+	found := make(map[string]*UndefinedTerm)
+	for _, requirement := range requirements {
+		texts := []string{requirement.Title}
+		if requirement.Description != nil {
+			texts = append(texts, *requirement.Description)
+		}
+
+		for _, text := range texts {
+			for _, candidate := range findCapitalizedTerms(maskTerms(text, annotateTerms(terms, "", text))) {
+				key := strings.ToLower(candidate)
+				if defined[key] {
+					continue
+				}
+				entry, ok := found[key]
+				if !ok {
+					entry = &UndefinedTerm{Term: candidate, Requirements: make([]string, 0)}
+					found[key] = entry
+				}
+				entry.Occurrences++
+				if n := len(entry.Requirements); n == 0 || entry.Requirements[n-1] != requirement.ReferenceID {
+					entry.Requirements = append(entry.Requirements, requirement.ReferenceID)
+				}
+			}
+		}
+	}
+
+	report := &UndefinedTermsReport{
+		Terms:                make([]UndefinedTerm, 0, len(found)),
+		RequirementsScanned:  len(requirements),
+		GlossaryTermsDefined: len(terms),
+	}
+	for _, entry := range found {
+		report.Terms = append(report.Terms, *entry)
+	}
+	sort.Slice(report.Terms, func(i, j int) bool {
+		if report.Terms[i].Occurrences != report.Terms[j].Occurrences {
+			return report.Terms[i].Occurrences > report.Terms[j].Occurrences
+		}
+		return report.Terms[i].Term < report.Terms[j].Term
+	})
+	return report, nil
+}
+
+// ensureTermAvailable checks that no other glossary term has the same text
+func (s *glossaryService) ensureTermAvailable(ctx context.Context, term string, currentID uuid.UUID) error {
+	existing, err := s.glossaryRepo.GetByTerm(ctx, term)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to check glossary term: %w", err)
+	}
+	if existing.ID != currentID {
+		return ErrGlossaryTermExists
+	}
+	return nil
+}
+
+// annotateTerms finds whole-word, case-insensitive occurrences of glossary terms in text.
+// Longer terms win over shorter terms they contain, and occurrences never overlap.
+func annotateTerms(terms []models.GlossaryTerm, field, text string) []TermAnnotation {
+	annotations := make([]TermAnnotation, 0)
+	if text == "" || len(terms) == 0 {
+		return annotations
+	}
+
+	ordered := make([]models.GlossaryTerm, len(terms))
+	copy(ordered, terms)
+	sort.SliceStable(ordered, func(i, j int) bool { return len(ordered[i].Term) > len(ordered[j].Term) })
+
+	claimed := make([]bool, len(text))
+	for _, term := range ordered {
+		pattern, err := regexp.Compile("(?i)" + regexp.QuoteMeta(term.Term))
+		if err != nil {
+			continue
+		}
+		for _, match := range pattern.FindAllStringIndex(text, -1) {
+			start, end := match[0], match[1]
+			if !isWordBoundary(text, start, end) || isClaimed(claimed, start, end) {
+				continue
+			}
+			for i := start; i < end; i++ {
+				claimed[i] = true
+			}
+			annotations = append(annotations, TermAnnotation{
+				TermID:     term.ID,
+				Term:       term.Term,
+				Definition: term.Definition,
+				Field:      field,
+				Start:      start,
+				End:        end,
+				Text:       text[start:end],
+			})
+		}
+	}
+
+	sort.Slice(annotations, func(i, j int) bool { return annotations[i].Start < annotations[j].Start })
+	return annotations
+}
+
+// isWordBoundary reports whether text[start:end] is not part of a longer word
+func isWordBoundary(text string, start, end int) bool {
+	if start > 0 {
+		if r, _ := utf8.DecodeLastRuneInString(text[:start]); isWordRune(r) {
+			return false
+		}
+	}
+	if end < len(text) {
+		if r, _ := utf8.DecodeRuneInString(text[end:]); isWordRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isClaimed(claimed []bool, start, end int) bool {
+	for i := start; i < end; i++ {
+		if claimed[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// maskTerms blanks out annotated occurrences so they are not reported as undefined
+func maskTerms(text string, annotations []TermAnnotation) string {
+	if len(annotations) == 0 {
+		return text
+	}
+	var masked strings.Builder
+	last := 0
+	for _, annotation := range annotations {
+		masked.WriteString(text[last:annotation.Start])
+		masked.WriteString(strings.Repeat(" ", annotation.End-annotation.Start))
+		last = annotation.End
+	}
+	masked.WriteString(text[last:])
+	return masked.String()
+}
+
+// referenceIDPattern matches entity reference IDs such as REQ-001, which are not terms
+var referenceIDPattern = regexp.MustCompile(`^[A-Z]+-\d+$`)
+
+// findCapitalizedTerms extracts runs of capitalized words, such as "Payment Gateway" or "SLA",
+// as candidate domain terms. A capitalized word starting a sentence is ordinary prose, so it is
+// dropped from the start of a run.
+func findCapitalizedTerms(text string) []string {
+	var (
+		terms         []string
+		run           []string
+		runAtSentence bool
+		sentenceStart = true
+	)
+
+	flush := func() {
+		words := run
+		if runAtSentence && len(words) > 0 {
+			words = words[1:]
+		}
+		if len(words) > 0 {
+			if term := strings.Join(words, " "); utf8.RuneCountInString(term) > 1 {
+				terms = append(terms, term)
+			}
+		}
+		run = nil
+	}
+
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		if !isWordRune(r) {
+			if r != ' ' {
+				flush()
+			}
+			if r == '.' || r == '!' || r == '?' || r == ':' || r == ';' || r == '\n' {
+				sentenceStart = true
+			}
+			text = text[size:]
+			continue
+		}
+
+		end := 0
+		for end < len(text) {
+			wr, wsize := utf8.DecodeRuneInString(text[end:])
+			if !isWordRune(wr) && wr != '-' && wr != '\'' {
+				break
+			}
+			end += wsize
+		}
+		word := strings.TrimRight(text[:end], "-'")
+		text = text[len(word):]
+
+		if unicode.IsUpper(r) && !referenceIDPattern.MatchString(word) {
+			if len(run) == 0 {
+				runAtSentence = sentenceStart
+			}
+			run = append(run, word)
+		} else {
+			flush()
+		}
+		sentenceStart = false
+	}
+	flush()
+
+	return terms
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestAnnotateTerms(t *testing.T) {
+	terms := []models.GlossaryTerm{
+		{ID: uuid.New(), Term: "Order", Definition: "A purchase request"},
+		{ID: uuid.New(), Term: "Order Line", Definition: "One product in an order"},
+		{ID: uuid.New(), Term: "Заказ", Definition: "Order in Russian"},
+	}
+
+	text := "Each order line belongs to an Order. Orders are archived. Заказ сохраняется."
+	annotations := annotateTerms(terms, "description", text)
+
+	require.Len(t, annotations, 3)
+	assert.Equal(t, "Order Line", annotations[0].Term)
+	assert.Equal(t, "order line", annotations[0].Text)
+	assert.Equal(t, text[annotations[0].Start:annotations[0].End], annotations[0].Text)
+	assert.Equal(t, "Order", annotations[1].Term)
+	assert.Equal(t, "Order", annotations[1].Text)
+	assert.Equal(t, "Заказ", annotations[2].Text)
+	assert.Equal(t, "description", annotations[2].Field)
+}
+
+func TestFindCapitalizedTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "multi-word term mid-sentence",
+			text:     "The system shall notify the Payment Gateway when an order is paid.",
+			expected: []string{"Payment Gateway"},
+		},
+		{
+			name:     "sentence-initial word is dropped from run",
+			text:     "The Payment Gateway retries. Each retry is logged.",
+			expected: []string{"Payment Gateway"},
+		},
+		{
+			name:     "acronyms are terms",
+			text:     "Responses must meet the SLA defined with Acme.",
+			expected: []string{"SLA", "Acme"},
+		},
+		{
+			name:     "reference IDs are ignored",
+			text:     "This refines REQ-001 and US-12.",
+			expected: nil,
+		},
+		{
+			name:     "punctuation splits runs",
+			text:     "Supports Visa, Mastercard and PayPal.",
+			expected: []string{"Visa", "Mastercard", "PayPal"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, findCapitalizedTerms(tt.text))
+		})
+	}
+}
+
+func setupGlossaryTestDB(t *testing.T) (*gorm.DB, *repository.Repositories, *models.User) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.GlossaryTerm{}, &models.Requirement{}))
+
+	user := &models.User{Username: "author", Email: "author@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	return db, repository.NewRepositories(db, nil), user
+}
+
+func TestGlossaryService(t *testing.T) {
+	ctx := context.Background()
+	db, repos, user := setupGlossaryTestDB(t)
+	service := NewGlossaryService(repos)
+
+	term, err := service.CreateTerm(ctx, CreateGlossaryTermRequest{Term: " Payment Gateway ", Definition: "External card processor"}, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Payment Gateway", term.Term)
+
+	_, err = service.CreateTerm(ctx, CreateGlossaryTermRequest{Term: "payment gateway", Definition: "Duplicate"}, user.ID)
+	assert.ErrorIs(t, err, ErrGlossaryTermExists)
+
+	_, err = service.GetTerm(ctx, uuid.New())
+	assert.ErrorIs(t, err, ErrGlossaryTermNotFound)
+
+	definition := "Card processor used for checkout"
+	updated, err := service.UpdateTerm(ctx, term.ID, UpdateGlossaryTermRequest{Definition: &definition})
+	require.NoError(t, err)
+	assert.Equal(t, definition, updated.Definition)
+
+	description := "The Payment Gateway confirms charges within the agreed SLA."
+	requirement := models.Requirement{
+		ID:          uuid.New(),
+		ReferenceID: "REQ-001",
+		Title:       "Confirm charges through the Payment Gateway",
+		Description: &description,
+	}
+	require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(&requirement).Error)
+
+	t.Run("annotate requirement", func(t *testing.T) {
+		annotated, err := service.AnnotateRequirement(ctx, &requirement)
+		require.NoError(t, err)
+		require.Len(t, annotated.TermAnnotations, 2)
+		assert.Equal(t, "title", annotated.TermAnnotations[0].Field)
+		assert.Equal(t, "description", annotated.TermAnnotations[1].Field)
+
+		data, err := json.Marshal(annotated)
+		require.NoError(t, err)
+		var decoded map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, "REQ-001", decoded["reference_id"])
+		assert.Len(t, decoded["term_annotations"], 2)
+	})
+
+	t.Run("undefined terms report", func(t *testing.T) {
+		report, err := service.GetUndefinedTermsReport(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.RequirementsScanned)
+		assert.Equal(t, 1, report.GlossaryTermsDefined)
+		require.Len(t, report.Terms, 1)
+		assert.Equal(t, "SLA", report.Terms[0].Term)
+		assert.Equal(t, []string{"REQ-001"}, report.Terms[0].Requirements)
+	})
+
+	require.NoError(t, service.DeleteTerm(ctx, term.ID))
+	assert.ErrorIs(t, service.DeleteTerm(ctx, term.ID), ErrGlossaryTermNotFound)
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+const (
+	// DefaultGuestInvitationDays is how long an invitation is valid when no expiry is requested
+	DefaultGuestInvitationDays = 7
+	// MaxGuestInvitationDays is the longest an invitation can be valid
+	MaxGuestInvitationDays = 30
+)
+
+// guestPasswordHash is stored for guest accounts; it is not a bcrypt hash, so guests cannot sign in with a password
+const guestPasswordHash = "!"
+
+var (
+	ErrGuestInvitationNotFound       = apperrors.New(apperrors.KindNotFound, "GUEST_INVITATION_NOT_FOUND", "guest invitation not found")
+	ErrGuestInvitationInvalid        = apperrors.New(apperrors.KindUnauthenticated, "GUEST_INVITATION_INVALID", "guest invitation is unknown, revoked or expired")
+	ErrGuestInvitationAlreadyRevoked = apperrors.New(apperrors.KindConflict, "GUEST_INVITATION_REVOKED", "guest invitation is already revoked")
+	ErrGuestEmailRegistered          = apperrors.New(apperrors.KindConflict, "GUEST_EMAIL_REGISTERED", "email belongs to a registered user; guests must use another address")
+	ErrInvalidGuestEmail             = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "email must be a valid email address")
+	ErrInvalidGuestInvitationExpiry  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, fmt.Sprintf("expires_in_days must be between 1 and %d", MaxGuestInvitationDays))
+)
+
+// GuestInvitationService defines the interface for inviting external reviewers to comment on a single epic.
+// Every invited email gets a Commenter account that can only sign in with its invitation tokens.
+type GuestInvitationService interface {
+	CreateInvitation(ctx context.Context, epicIDOrRef string, req CreateGuestInvitationRequest, inviterID uuid.UUID, now time.Time) (*GuestInvitationCreated, error)
+	ListInvitations(ctx context.Context, epicIDOrRef string, now time.Time) ([]models.GuestInvitation, error)
+	RevokeInvitation(ctx context.Context, epicIDOrRef string, invitationID uuid.UUID, now time.Time) error
+	AcceptInvitation(ctx context.Context, token string, now time.Time) (*models.GuestInvitation, error)
+	CheckAccess(ctx context.Context, invitationID uuid.UUID, now time.Time) (*models.GuestInvitation, error)
+	CleanupExpiredGuests(ctx context.Context, now time.Time) (int64, error)
+}
+
+// CreateGuestInvitationRequest represents a request to invite an external reviewer to an epic
+// @Description Request payload for inviting an external reviewer to comment on an epic
+type CreateGuestInvitationRequest struct {
+	Email         string `json:"email" binding:"required" example:"reviewer@partner.example.com"` // Email address the invitation is sent to
+	ExpiresInDays int    `json:"expires_in_days,omitempty" example:"7"`                           // Days until the invitation expires (1-30, default 7)
+}
+
+// GuestInvitationCreated is a newly created guest invitation with its secret token
+// @Description Created guest invitation; the token is only shown once
+type GuestInvitationCreated struct {
+	models.GuestInvitation
+	Token     string `json:"token" example:"Xq3b9T0m1Yk..."` // Invitation token the guest signs in with
+	EmailSent bool   `json:"email_sent" example:"true"`      // Whether the invitation email was sent; share the token yourself otherwise
+}
+
+// guestInvitationService implements GuestInvitationService interface
+type guestInvitationService struct {
+	repos          *repository.Repositories
+	mailer         Mailer
+	tokenGenerator TokenGenerator
+	baseURL        string
+	logger         *logrus.Logger
+}
+
+// NewGuestInvitationService creates a new guest invitation service instance
+// baseURL is the public API base URL the invitation email tells guests to sign in at
+func NewGuestInvitationService(repos *repository.Repositories, mailer Mailer, baseURL string, logger *logrus.Logger) GuestInvitationService {
+	return &guestInvitationService{
+		repos:          repos,
+		mailer:         mailer,
+		tokenGenerator: NewSecureTokenGenerator(),
+		baseURL:        strings.TrimRight(baseURL, "/"),
+		logger:         logger,
+	}
+}
+
+// CreateInvitation invites an email address to read and comment on an epic and emails it the invitation token.
+// The guest account of the address is created on its first invitation and reactivated by later ones;
+// addresses of registered users cannot be invited. A failure to send the email is logged and reported in EmailSent.
+func (s *guestInvitationService) CreateInvitation(ctx context.Context, epicIDOrRef string, req CreateGuestInvitationRequest, inviterID uuid.UUID, now time.Time) (*GuestInvitationCreated, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+	if err != nil {
+		return nil, ErrInvalidGuestEmail
+	}
+	email := strings.ToLower(address.Address)
+
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = DefaultGuestInvitationDays
+	}
+	if days < 1 || days > MaxGuestInvitationDays {
+		return nil, ErrInvalidGuestInvitationExpiry
+	}
+
+	epic, err := s.getEpic(ctx, epicIDOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	_, token, err := s.tokenGenerator.GenerateToken("", 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate guest invitation token: %w", err)
+	}
+
+	invitation := &models.GuestInvitation{
+		EpicID:    epic.ID,
+		Email:     email,
+		TokenHash: hashFeedToken(token),
+		InvitedBy: inviterID,
+		ExpiresAt: now.UTC().AddDate(0, 0, days),
+		CreatedAt: now.UTC(),
+	}
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		guest, err := s.guestAccount(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+		invitation.GuestID = guest.ID
+		if err := tx.GuestInvitation.Create(ctx, invitation); err != nil {
+			return fmt.Errorf("failed to create guest invitation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repos.GuestInvitation.GetByID(ctx, invitation.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guest invitation: %w", err)
+	}
+	created.Status = created.StatusAt(now)
+
+	emailSent := true
+	subject := fmt.Sprintf("You are invited to review %s: %s", epic.ReferenceID, epic.Title)
+	if err := s.mailer.Send(email, subject, s.renderInvitation(created, epic, token)); err != nil {
+		emailSent = false
+		s.logger.WithError(err).WithField("invitation_id", created.ID).Error("Failed to send guest invitation email")
+	}
+
+	return &GuestInvitationCreated{GuestInvitation: *created, Token: token, EmailSent: emailSent}, nil
+}
+
+// guestAccount returns the guest account of an email address, creating it or reactivating it as needed
+func (s *guestInvitationService) guestAccount(ctx context.Context, tx *repository.Repositories, email string) (*models.User, error) {
+	user, err := tx.User.GetByEmail(ctx, email)
+	if errors.Is(err, repository.ErrNotFound) {
+		guestID := uuid.New()
+		user = &models.User{
+			ID:           guestID,
+			Username:     "guest-" + strings.ReplaceAll(guestID.String(), "-", "")[:12],
+			Email:        email,
+			PasswordHash: guestPasswordHash,
+			Role:         models.RoleCommenter,
+		}
+		if err := tx.User.Create(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to create guest account: %w", err)
+		}
+		return user, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	isGuest, err := tx.GuestInvitation.IsGuest(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check guest account: %w", err)
+	}
+	if !isGuest {
+		return nil, ErrGuestEmailRegistered
+	}
+	if !user.IsActive() {
+		user.DeactivatedAt = nil
+		if err := tx.User.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to reactivate guest account: %w", err)
+		}
+	}
+	return user, nil
+}
+
+// renderInvitation builds the plain text invitation email
+func (s *guestInvitationService) renderInvitation(invitation *models.GuestInvitation, epic *models.Epic, token string) string {
+	var b strings.Builder
+	inviter := "A team member"
+	if invitation.Inviter != nil {
+		inviter = invitation.Inviter.Username
+	}
+	fmt.Fprintf(&b, "%s invited you to review and comment on epic %s: %s.\n\n", inviter, epic.ReferenceID, epic.Title)
+	fmt.Fprintf(&b, "Your invitation token is:\n\n%s\n\n", token)
+	fmt.Fprintf(&b, "Sign in by sending it to POST %s/auth/invitations/accept. ", s.baseURL)
+	fmt.Fprintf(&b, "The invitation expires on %s and only gives access to this epic.\n", invitation.ExpiresAt.Format("2006-01-02 15:04 MST"))
+	return b.String()
+}
+
+// ListInvitations returns the invitations of an epic, newest first
+func (s *guestInvitationService) ListInvitations(ctx context.Context, epicIDOrRef string, now time.Time) ([]models.GuestInvitation, error) {
+	epic, err := s.getEpic(ctx, epicIDOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.repos.GuestInvitation.ListByEpic(ctx, epic.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guest invitations: %w", err)
+	}
+	for i := range invitations {
+		invitations[i].Status = invitations[i].StatusAt(now)
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation revokes an invitation of an epic; tokens issued for it stop working immediately
+func (s *guestInvitationService) RevokeInvitation(ctx context.Context, epicIDOrRef string, invitationID uuid.UUID, now time.Time) error {
+	epic, err := s.getEpic(ctx, epicIDOrRef)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := s.repos.GuestInvitation.GetByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrGuestInvitationNotFound
+		}
+		return fmt.Errorf("failed to get guest invitation: %w", err)
+	}
+	if invitation.EpicID != epic.ID {
+		return ErrGuestInvitationNotFound
+	}
+	if invitation.RevokedAt != nil {
+		return ErrGuestInvitationAlreadyRevoked
+	}
+
+	if err := s.repos.GuestInvitation.Revoke(ctx, invitationID, now.UTC()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrGuestInvitationAlreadyRevoked
+		}
+		return fmt.Errorf("failed to revoke guest invitation: %w", err)
+	}
+	return nil
+}
+
+// AcceptInvitation signs a guest in with an invitation token and returns the invitation with its epic and guest.
+// The token can be used again until the invitation expires or is revoked.
+func (s *guestInvitationService) AcceptInvitation(ctx context.Context, token string, now time.Time) (*models.GuestInvitation, error) {
+	invitation, err := s.repos.GuestInvitation.GetByTokenHash(ctx, hashFeedToken(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrGuestInvitationInvalid
+		}
+		return nil, fmt.Errorf("failed to get guest invitation: %w", err)
+	}
+	if err := checkGuestInvitation(invitation, now); err != nil {
+		return nil, err
+	}
+
+	if invitation.AcceptedAt == nil {
+		acceptedAt := now.UTC()
+		if err := s.repos.GuestInvitation.MarkAccepted(ctx, invitation.ID, acceptedAt); err != nil {
+			return nil, fmt.Errorf("failed to accept guest invitation: %w", err)
+		}
+		invitation.AcceptedAt = &acceptedAt
+	}
+	invitation.Status = invitation.StatusAt(now)
+	return invitation, nil
+}
+
+// CheckAccess returns the invitation a guest token was issued for with its epic, or ErrGuestInvitationInvalid
+// once the invitation is revoked or expired or the guest account is deactivated
+func (s *guestInvitationService) CheckAccess(ctx context.Context, invitationID uuid.UUID, now time.Time) (*models.GuestInvitation, error) {
+	invitation, err := s.repos.GuestInvitation.GetByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrGuestInvitationInvalid
+		}
+		return nil, fmt.Errorf("failed to get guest invitation: %w", err)
+	}
+	if err := checkGuestInvitation(invitation, now); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// CleanupExpiredGuests deactivates the guest accounts none of whose invitations still grants access
+func (s *guestInvitationService) CleanupExpiredGuests(ctx context.Context, now time.Time) (int64, error) {
+	deactivated, err := s.repos.GuestInvitation.DeactivateExpiredGuests(ctx, now.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to deactivate expired guests: %w", err)
+	}
+	return deactivated, nil
+}
+
+// getEpic resolves an epic by UUID or reference ID
+func (s *guestInvitationService) getEpic(ctx context.Context, epicIDOrRef string) (*models.Epic, error) {
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, err
+	}
+	epic, err := s.repos.Epic.GetByID(ctx, epicID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+	return epic, nil
+}
+
+// checkGuestInvitation returns ErrGuestInvitationInvalid unless an invitation grants access to an active guest
+func checkGuestInvitation(invitation *models.GuestInvitation, now time.Time) error {
+	if !invitation.IsActive(now) || invitation.Guest == nil || !invitation.Guest.IsActive() {
+		return ErrGuestInvitationInvalid
+	}
+	return nil
+}
+
+// RunGuestCleanup deactivates expired guests every interval until ctx is cancelled
+func RunGuestCleanup(ctx context.Context, guestInvitationService GuestInvitationService, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			deactivated, err := guestInvitationService.CleanupExpiredGuests(ctx, now)
+			if err != nil {
+				logger.WithError(err).Error("Guest cleanup failed")
+				continue
+			}
+			if deactivated > 0 {
+				logger.WithField("guests", deactivated).Info("Expired guests deactivated")
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestGuestInvitationService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.GuestInvitation{}))
+
+	owner := &models.User{Username: "owner", Email: "owner@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(owner).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog,
+		Priority: models.PriorityHigh, CreatorID: owner.ID, AssigneeID: owner.ID}
+	other := models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Search", Status: models.EpicStatusBacklog,
+		Priority: models.PriorityHigh, CreatorID: owner.ID, AssigneeID: owner.ID}
+	require.NoError(t, session.Create(&epic).Error)
+	require.NoError(t, session.Create(&other).Error)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	mailer := &recordingMailer{}
+	svc := NewGuestInvitationService(repository.NewRepositories(db, nil), mailer, "https://api.example.com/", logger)
+	now := time.Now().UTC()
+
+	t.Run("validates the invitation", func(t *testing.T) {
+		_, err := svc.CreateInvitation(ctx, "EP-001", CreateGuestInvitationRequest{Email: "not an email"}, owner.ID, now)
+		assert.ErrorIs(t, err, ErrInvalidGuestEmail)
+		_, err = svc.CreateInvitation(ctx, "EP-001", CreateGuestInvitationRequest{Email: "a@partner.example.com", ExpiresInDays: MaxGuestInvitationDays + 1}, owner.ID, now)
+		assert.ErrorIs(t, err, ErrInvalidGuestInvitationExpiry)
+		_, err = svc.CreateInvitation(ctx, "EP-404", CreateGuestInvitationRequest{Email: "a@partner.example.com"}, owner.ID, now)
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+		_, err = svc.CreateInvitation(ctx, "EP-001", CreateGuestInvitationRequest{Email: "Owner@Example.com"}, owner.ID, now)
+		assert.ErrorIs(t, err, ErrGuestEmailRegistered)
+	})
+
+	created, err := svc.CreateInvitation(ctx, "EP-001", CreateGuestInvitationRequest{Email: "Reviewer <Reviewer@Partner.example.com>"}, owner.ID, now)
+	require.NoError(t, err)
+
+	t.Run("creates a commenter account and emails the token", func(t *testing.T) {
+		assert.NotEmpty(t, created.Token)
+		assert.True(t, created.EmailSent)
+		assert.Equal(t, "reviewer@partner.example.com", created.Email)
+		assert.Equal(t, models.GuestInvitationPending, created.Status)
+		assert.Equal(t, now.AddDate(0, 0, DefaultGuestInvitationDays), created.ExpiresAt.UTC())
+		require.NotNil(t, created.Guest)
+		assert.Equal(t, models.RoleCommenter, created.Guest.Role)
+		assert.Equal(t, "owner", created.Inviter.Username)
+
+		require.Len(t, mailer.to, 1)
+		assert.Equal(t, "reviewer@partner.example.com", mailer.to[0])
+		assert.Contains(t, mailer.subject[0], "EP-001")
+		assert.Contains(t, mailer.body[0], created.Token)
+		assert.Contains(t, mailer.body[0], "POST https://api.example.com/auth/invitations/accept")
+	})
+
+	t.Run("reuses the guest account", func(t *testing.T) {
+		mailer.err = errors.New("smtp unavailable")
+		defer func() { mailer.err = nil }()
+
+		again, err := svc.CreateInvitation(ctx, other.ID.String(), CreateGuestInvitationRequest{Email: "reviewer@partner.example.com", ExpiresInDays: 1}, owner.ID, now)
+		require.NoError(t, err)
+		assert.Equal(t, created.GuestID, again.GuestID)
+		assert.False(t, again.EmailSent)
+		assert.NotEqual(t, created.Token, again.Token)
+	})
+
+	t.Run("accepts tokens until the invitation expires", func(t *testing.T) {
+		_, err := svc.AcceptInvitation(ctx, "unknown", now)
+		assert.ErrorIs(t, err, ErrGuestInvitationInvalid)
+
+		accepted, err := svc.AcceptInvitation(ctx, created.Token, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, epic.ID, accepted.EpicID)
+		assert.Equal(t, "EP-001", accepted.Epic.ReferenceID)
+		assert.Equal(t, models.GuestInvitationAccepted, accepted.Status)
+
+		again, err := svc.AcceptInvitation(ctx, created.Token, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, accepted.AcceptedAt.Unix(), again.AcceptedAt.Unix())
+
+		_, err = svc.AcceptInvitation(ctx, created.Token, created.ExpiresAt)
+		assert.ErrorIs(t, err, ErrGuestInvitationInvalid)
+	})
+
+	t.Run("lists the invitations of an epic", func(t *testing.T) {
+		invitations, err := svc.ListInvitations(ctx, "EP-001", now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, invitations, 1)
+		assert.Equal(t, created.ID, invitations[0].ID)
+		assert.Equal(t, models.GuestInvitationAccepted, invitations[0].Status)
+		assert.Equal(t, "reviewer@partner.example.com", invitations[0].Guest.Email)
+	})
+
+	t.Run("revokes invitations", func(t *testing.T) {
+		assert.ErrorIs(t, svc.RevokeInvitation(ctx, "EP-002", created.ID, now), ErrGuestInvitationNotFound)
+		assert.ErrorIs(t, svc.RevokeInvitation(ctx, "EP-001", uuid.New(), now), ErrGuestInvitationNotFound)
+
+		_, err := svc.CheckAccess(ctx, created.ID, now)
+		require.NoError(t, err)
+		require.NoError(t, svc.RevokeInvitation(ctx, "EP-001", created.ID, now))
+		assert.ErrorIs(t, svc.RevokeInvitation(ctx, "EP-001", created.ID, now), ErrGuestInvitationAlreadyRevoked)
+
+		_, err = svc.CheckAccess(ctx, created.ID, now)
+		assert.ErrorIs(t, err, ErrGuestInvitationInvalid)
+		_, err = svc.AcceptInvitation(ctx, created.Token, now)
+		assert.ErrorIs(t, err, ErrGuestInvitationInvalid)
+
+		invitations, err := svc.ListInvitations(ctx, "EP-001", now)
+		require.NoError(t, err)
+		assert.Equal(t, models.GuestInvitationRevoked, invitations[0].Status)
+	})
+
+	t.Run("deactivates guests once their invitations expire", func(t *testing.T) {
+		// The invitation to EP-002 is still active
+		deactivated, err := svc.CleanupExpiredGuests(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), deactivated)
+
+		deactivated, err = svc.CleanupExpiredGuests(ctx, now.AddDate(0, 0, 2))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deactivated)
+
+		var guest models.User
+		require.NoError(t, db.First(&guest, "id = ?", created.GuestID).Error)
+		assert.False(t, guest.IsActive())
+		var user models.User
+		require.NoError(t, db.First(&user, "id = ?", owner.ID).Error)
+		assert.True(t, user.IsActive())
+	})
+
+	t.Run("reactivates guests invited again", func(t *testing.T) {
+		invitation, err := svc.CreateInvitation(ctx, "EP-001", CreateGuestInvitationRequest{Email: "reviewer@partner.example.com"}, owner.ID, now)
+		require.NoError(t, err)
+		assert.Equal(t, created.GuestID, invitation.GuestID)
+
+		accepted, err := svc.AcceptInvitation(ctx, invitation.Token, now)
+		require.NoError(t, err)
+		assert.True(t, accepted.Guest.IsActive())
+	})
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// hierarchyCacheSampleSize limits how many inconsistent entities a check reports
+const hierarchyCacheSampleSize = 20
+
+// HierarchyCacheService checks and rebuilds the denormalized hierarchy cache that breadcrumb, outline and
+// progress endpoints read from. Database triggers keep the cache up to date; the check catches rows that
+// drifted, for example after writes that disabled triggers.
+type HierarchyCacheService interface {
+	Check(ctx context.Context) (*HierarchyCacheReport, error)
+	Rebuild(ctx context.Context) (*HierarchyCacheRebuild, error)
+}
+
+// HierarchyCacheReport compares the hierarchy cache with the entities it is built from
+type HierarchyCacheReport struct {
+	Consistent bool                        `json:"consistent"`
+	Entries    int                         `json:"entries"`  // Entities that should be cached
+	Missing    int                         `json:"missing"`  // Entities without a cache row
+	Stale      int                         `json:"stale"`    // Cache rows that differ from their entity
+	Orphaned   int                         `json:"orphaned"` // Cache rows of entities that no longer exist
+	Samples    []HierarchyCacheDiscrepancy `json:"samples,omitempty"`
+	CheckedAt  time.Time                   `json:"checked_at"`
+}
+
+// HierarchyCacheDiscrepancy is an inconsistent entity found by a hierarchy cache check
+type HierarchyCacheDiscrepancy struct {
+	EntityType  models.EntityType `json:"entity_type"`
+	EntityID    string            `json:"entity_id"`
+	ReferenceID string            `json:"reference_id"`
+	Problem     string            `json:"problem"` // missing, stale or orphaned
+}
+
+// HierarchyCacheRebuild describes a hierarchy cache rebuild
+type HierarchyCacheRebuild struct {
+	Entries    int64     `json:"entries"`
+	DurationMs int64     `json:"duration_ms"`
+	RebuiltAt  time.Time `json:"rebuilt_at"`
+}
+
+// hierarchyCacheService implements HierarchyCacheService
+type hierarchyCacheService struct {
+	repos *repository.Repositories
+}
+
+// NewHierarchyCacheService creates a new hierarchy cache service instance
+func NewHierarchyCacheService(repos *repository.Repositories) HierarchyCacheService {
+	return &hierarchyCacheService{repos: repos}
+}
+
+// hierarchyEntryKey identifies a hierarchy cache row
+type hierarchyEntryKey struct {
+	entityType models.EntityType
+	entityID   string
+}
+
+// Check compares every hierarchy cache row with the row built from the cached entities
+func (s *hierarchyCacheService) Check(ctx context.Context) (*HierarchyCacheReport, error) {
+	expected, err := s.repos.Hierarchy.ComputeEntries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute hierarchy entries: %w", err)
+	}
+	cached, err := s.repos.Hierarchy.ListEntries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list hierarchy entries: %w", err)
+	}
+
+	report := &HierarchyCacheReport{Entries: len(expected), CheckedAt: time.Now().UTC()}
+	record := func(entry models.HierarchyEntry, problem string) {
+		if len(report.Samples) < hierarchyCacheSampleSize {
+			report.Samples = append(report.Samples, HierarchyCacheDiscrepancy{
+				EntityType:  entry.EntityType,
+				EntityID:    entry.EntityID.String(),
+				ReferenceID: entry.ReferenceID,
+				Problem:     problem,
+			})
+		}
+	}
+
+	cachedByKey := make(map[hierarchyEntryKey]models.HierarchyEntry, len(cached))
+	for _, entry := range cached {
+		cachedByKey[hierarchyEntryKey{entry.EntityType, entry.EntityID.String()}] = entry
+	}
+	for _, entry := range expected {
+		key := hierarchyEntryKey{entry.EntityType, entry.EntityID.String()}
+		cachedEntry, ok := cachedByKey[key]
+		delete(cachedByKey, key)
+		switch {
+		case !ok:
+			report.Missing++
+			record(entry, "missing")
+		case !sameHierarchyEntry(entry, cachedEntry):
+			report.Stale++
+			record(entry, "stale")
+		}
+	}
+	for _, entry := range cachedByKey {
+		report.Orphaned++
+		record(entry, "orphaned")
+	}
+
+	report.Consistent = report.Missing == 0 && report.Stale == 0 && report.Orphaned == 0
+	return report, nil
+}
+
+// Rebuild replaces the hierarchy cache with rows built from the cached entities
+func (s *hierarchyCacheService) Rebuild(ctx context.Context) (*HierarchyCacheRebuild, error) {
+	startedAt := time.Now()
+	entries, err := s.repos.Hierarchy.RebuildEntries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild hierarchy entries: %w", err)
+	}
+	return &HierarchyCacheRebuild{
+		Entries:    entries,
+		DurationMs: time.Since(startedAt).Milliseconds(),
+		RebuiltAt:  time.Now().UTC(),
+	}, nil
+}
+
+// sameHierarchyEntry reports whether two hierarchy cache rows of the same entity agree
+func sameHierarchyEntry(a, b models.HierarchyEntry) bool {
+	return a.ReferenceID == b.ReferenceID &&
+		a.Title == b.Title &&
+		a.Status == b.Status &&
+		a.EpicID == b.EpicID &&
+		sameOptionalID(a.UserStoryID, b.UserStoryID) &&
+		sameOptionalID(a.AcceptanceCriteriaID, b.AcceptanceCriteriaID) &&
+		a.CreatedAt.Equal(b.CreatedAt) &&
+		a.UpdatedAt.Equal(b.UpdatedAt)
+}
+
+// sameOptionalID reports whether two optional IDs are both unset or equal
+func sameOptionalID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// RunHierarchyCacheChecker checks the hierarchy cache every interval until the context is cancelled,
+// rebuilding it when it is inconsistent and repair is enabled
+func RunHierarchyCacheChecker(ctx context.Context, hierarchyCacheService HierarchyCacheService, interval time.Duration, repair bool, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := hierarchyCacheService.Check(ctx)
+			if err != nil {
+				logger.WithError(err).Error("Hierarchy cache check failed")
+				continue
+			}
+			if report.Consistent {
+				continue
+			}
+			logger.WithFields(logrus.Fields{
+				"missing":  report.Missing,
+				"stale":    report.Stale,
+				"orphaned": report.Orphaned,
+			}).Warn("Hierarchy cache is inconsistent")
+			if !repair {
+				continue
+			}
+			rebuild, err := hierarchyCacheService.Rebuild(ctx)
+			if err != nil {
+				logger.WithError(err).Error("Hierarchy cache rebuild failed")
+				continue
+			}
+			logger.WithFields(logrus.Fields{
+				"entries":     rebuild.Entries,
+				"duration_ms": rebuild.DurationMs,
+			}).Info("Hierarchy cache rebuilt")
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestHierarchyCacheService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, _, epic, requirements := setupSupersessionTest(t, active, active)
+	require.NoError(t, db.AutoMigrate(&models.HierarchyEntry{}))
+	svc := NewHierarchyCacheService(repository.NewRepositories(db, nil))
+
+	report, err := svc.Check(ctx)
+	require.NoError(t, err)
+	assert.False(t, report.Consistent)
+	assert.Equal(t, 4, report.Entries)
+	assert.Equal(t, 4, report.Missing)
+
+	rebuild, err := svc.Rebuild(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), rebuild.Entries)
+
+	report, err = svc.Check(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.Consistent)
+	assert.Empty(t, report.Samples)
+
+	// Drift the cache: a renamed requirement, a missing epic and a deleted entity
+	require.NoError(t, db.Model(&models.Requirement{}).Where("id = ?", requirements[0].ID).UpdateColumn("title", "Renamed").Error)
+	require.NoError(t, db.Where("entity_id = ?", epic.ID).Delete(&models.HierarchyEntry{}).Error)
+	require.NoError(t, db.Create(&models.HierarchyEntry{EntityType: models.EntityTypeRequirement, EntityID: uuid.New(), ReferenceID: "REQ-009",
+		Title: "Deleted", EpicID: epic.ID, CreatedAt: time.Now(), UpdatedAt: time.Now()}).Error)
+
+	report, err = svc.Check(ctx)
+	require.NoError(t, err)
+	assert.False(t, report.Consistent)
+	assert.Equal(t, 1, report.Missing)
+	assert.Equal(t, 1, report.Stale)
+	assert.Equal(t, 1, report.Orphaned)
+	problems := map[string]string{}
+	for _, sample := range report.Samples {
+		problems[sample.ReferenceID] = sample.Problem
+	}
+	assert.Equal(t, map[string]string{"EP-001": "missing", "REQ-001": "stale", "REQ-009": "orphaned"}, problems)
+
+	_, err = svc.Rebuild(ctx)
+	require.NoError(t, err)
+	report, err = svc.Check(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.Consistent)
+}
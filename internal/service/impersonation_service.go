@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// ImpersonationTokenDuration is how long an impersonation token is valid; it cannot be refreshed
+const ImpersonationTokenDuration = 15 * time.Minute
+
+// maxImpersonationReasonLength is the longest reason an impersonation session can be started with
+const maxImpersonationReasonLength = 500
+
+var (
+	ErrImpersonationSessionNotFound = apperrors.New(apperrors.KindNotFound, "IMPERSONATION_SESSION_NOT_FOUND", "impersonation session not found")
+	ErrImpersonationUserNotFound    = apperrors.New(apperrors.KindNotFound, "USER_NOT_FOUND", "user not found")
+	ErrImpersonationReason          = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, fmt.Sprintf("reason is required and must not exceed %d characters", maxImpersonationReasonLength))
+	ErrImpersonateSelf              = apperrors.New(apperrors.KindInvalid, "IMPERSONATION_NOT_ALLOWED", "administrators cannot impersonate themselves")
+	ErrImpersonateAdministrator     = apperrors.New(apperrors.KindForbidden, "IMPERSONATION_NOT_ALLOWED", "administrators cannot be impersonated")
+	ErrImpersonateDeactivated       = apperrors.New(apperrors.KindInvalid, "IMPERSONATION_NOT_ALLOWED", "deactivated users cannot be impersonated")
+)
+
+// ImpersonationService defines the interface for administrators acting as other users to reproduce the
+// permission and visibility problems they report. Every session and every request made in it is recorded.
+type ImpersonationService interface {
+	StartSession(ctx context.Context, req StartImpersonationRequest, now time.Time) (*models.ImpersonationSession, error)
+	RecordRequest(ctx context.Context, sessionID uuid.UUID, method, path string, statusCode int, at time.Time) error
+	GetSession(ctx context.Context, id uuid.UUID) (*models.ImpersonationSession, error)
+	ListSessions(ctx context.Context, filter repository.ImpersonationSessionFilter) ([]models.ImpersonationSession, int64, error)
+	ListRequests(ctx context.Context, sessionID uuid.UUID, limit, offset int) ([]models.ImpersonatedRequest, int64, error)
+}
+
+// StartImpersonationRequest starts an impersonation session
+type StartImpersonationRequest struct {
+	AdminID   uuid.UUID
+	UserID    uuid.UUID
+	Reason    string
+	ClientIP  string
+	UserAgent string
+}
+
+// impersonationService implements ImpersonationService interface
+type impersonationService struct {
+	repos *repository.Repositories
+}
+
+// NewImpersonationService creates a new impersonation service instance
+func NewImpersonationService(repos *repository.Repositories) ImpersonationService {
+	return &impersonationService{repos: repos}
+}
+
+// StartSession records an impersonation session of an active, non-administrator user.
+// The returned session has the administrator and the user set.
+func (s *impersonationService) StartSession(ctx context.Context, req StartImpersonationRequest, now time.Time) (*models.ImpersonationSession, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" || len(reason) > maxImpersonationReasonLength {
+		return nil, ErrImpersonationReason
+	}
+	if req.AdminID == req.UserID {
+		return nil, ErrImpersonateSelf
+	}
+
+	admin, err := s.repos.User.GetByID(ctx, req.AdminID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrImpersonationUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get administrator: %w", err)
+	}
+	user, err := s.repos.User.GetByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrImpersonationUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Role == models.RoleAdministrator {
+		return nil, ErrImpersonateAdministrator
+	}
+	if !user.IsActive() {
+		return nil, ErrImpersonateDeactivated
+	}
+
+	session := &models.ImpersonationSession{
+		AdminID:   admin.ID,
+		UserID:    user.ID,
+		Reason:    reason,
+		ClientIP:  req.ClientIP,
+		UserAgent: req.UserAgent,
+		StartedAt: now.UTC(),
+		ExpiresAt: now.UTC().Add(ImpersonationTokenDuration),
+	}
+	if err := s.repos.Impersonation.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to create impersonation session: %w", err)
+	}
+	session.Admin = admin
+	session.User = user
+	return session, nil
+}
+
+// RecordRequest records a request made with the token of an impersonation session
+func (s *impersonationService) RecordRequest(ctx context.Context, sessionID uuid.UUID, method, path string, statusCode int, at time.Time) error {
+	request := &models.ImpersonatedRequest{
+		SessionID:  sessionID,
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+		CreatedAt:  at.UTC(),
+	}
+	if err := s.repos.Impersonation.CreateRequest(ctx, request); err != nil {
+		return fmt.Errorf("failed to record impersonated request: %w", err)
+	}
+	return nil
+}
+
+// GetSession retrieves an impersonation session with the administrator and the user
+func (s *impersonationService) GetSession(ctx context.Context, id uuid.UUID) (*models.ImpersonationSession, error) {
+	session, err := s.repos.Impersonation.GetSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrImpersonationSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to get impersonation session: %w", err)
+	}
+	return session, nil
+}
+
+// ListSessions returns the impersonation sessions, newest first
+func (s *impersonationService) ListSessions(ctx context.Context, filter repository.ImpersonationSessionFilter) ([]models.ImpersonationSession, int64, error) {
+	sessions, total, err := s.repos.Impersonation.ListSessions(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list impersonation sessions: %w", err)
+	}
+	return sessions, total, nil
+}
+
+// ListRequests returns the requests made in an impersonation session, oldest first
+func (s *impersonationService) ListRequests(ctx context.Context, sessionID uuid.UUID, limit, offset int) ([]models.ImpersonatedRequest, int64, error) {
+	if _, err := s.GetSession(ctx, sessionID); err != nil {
+		return nil, 0, err
+	}
+	requests, total, err := s.repos.Impersonation.ListRequests(ctx, sessionID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list impersonated requests: %w", err)
+	}
+	return requests, total, nil
+}
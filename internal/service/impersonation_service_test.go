@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestImpersonationService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.ImpersonationSession{}, &models.ImpersonatedRequest{}))
+
+	createUser := func(username string, role models.UserRole) *models.User {
+		user := &models.User{Username: username, Email: username + "@example.com", PasswordHash: "hash", Role: role}
+		require.NoError(t, db.Create(user).Error)
+		return user
+	}
+	admin := createUser("admin", models.RoleAdministrator)
+	otherAdmin := createUser("root", models.RoleAdministrator)
+	alice := createUser("alice", models.RoleCommenter)
+	bob := createUser("bob", models.RoleUser)
+	deactivatedAt := time.Now()
+	require.NoError(t, db.Model(bob).Update("deactivated_at", &deactivatedAt).Error)
+
+	svc := NewImpersonationService(repository.NewRepositories(db, nil))
+	now := time.Now().UTC()
+
+	t.Run("validates the session", func(t *testing.T) {
+		_, err := svc.StartSession(ctx, StartImpersonationRequest{AdminID: admin.ID, UserID: alice.ID, Reason: " "}, now)
+		assert.ErrorIs(t, err, ErrImpersonationReason)
+		_, err = svc.StartSession(ctx, StartImpersonationRequest{AdminID: admin.ID, UserID: admin.ID, Reason: "Testing"}, now)
+		assert.ErrorIs(t, err, ErrImpersonateSelf)
+		_, err = svc.StartSession(ctx, StartImpersonationRequest{AdminID: admin.ID, UserID: otherAdmin.ID, Reason: "Testing"}, now)
+		assert.ErrorIs(t, err, ErrImpersonateAdministrator)
+		_, err = svc.StartSession(ctx, StartImpersonationRequest{AdminID: admin.ID, UserID: bob.ID, Reason: "Testing"}, now)
+		assert.ErrorIs(t, err, ErrImpersonateDeactivated)
+		_, err = svc.StartSession(ctx, StartImpersonationRequest{AdminID: admin.ID, UserID: uuid.New(), Reason: "Testing"}, now)
+		assert.ErrorIs(t, err, ErrImpersonationUserNotFound)
+	})
+
+	session, err := svc.StartSession(ctx, StartImpersonationRequest{AdminID: admin.ID, UserID: alice.ID,
+		Reason: " Ticket 4711: cannot see EP-012 ", ClientIP: "203.0.113.7", UserAgent: "curl"}, now)
+	require.NoError(t, err)
+
+	t.Run("starts short-lived sessions", func(t *testing.T) {
+		assert.Equal(t, "Ticket 4711: cannot see EP-012", session.Reason)
+		assert.Equal(t, now.Add(ImpersonationTokenDuration), session.ExpiresAt)
+		assert.Equal(t, "alice", session.User.Username)
+		assert.Equal(t, "admin", session.Admin.Username)
+	})
+
+	t.Run("records the requests of a session", func(t *testing.T) {
+		require.NoError(t, svc.RecordRequest(ctx, session.ID, "GET", "/api/v1/epics/EP-012", 404, now.Add(time.Minute)))
+		require.NoError(t, svc.RecordRequest(ctx, session.ID, "GET", "/api/v1/epics", 200, now.Add(2*time.Minute)))
+
+		requests, total, err := svc.ListRequests(ctx, session.ID, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, requests, 2)
+		assert.Equal(t, "/api/v1/epics/EP-012", requests[0].Path)
+		assert.Equal(t, 404, requests[0].StatusCode)
+
+		_, _, err = svc.ListRequests(ctx, uuid.New(), 50, 0)
+		assert.ErrorIs(t, err, ErrImpersonationSessionNotFound)
+	})
+
+	t.Run("lists sessions", func(t *testing.T) {
+		sessions, total, err := svc.ListSessions(ctx, repository.ImpersonationSessionFilter{UserID: &alice.ID, Limit: 50})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, "alice", sessions[0].User.Username)
+
+		_, total, err = svc.ListSessions(ctx, repository.ImpersonationSessionFilter{AdminID: &otherAdmin.ID, Limit: 50})
+		require.NoError(t, err)
+		assert.Zero(t, total)
+
+		got, err := svc.GetSession(ctx, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "203.0.113.7", got.ClientIP)
+		_, err = svc.GetSession(ctx, uuid.New())
+		assert.ErrorIs(t, err, ErrImpersonationSessionNotFound)
+	})
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/ai"
+)
+
+var (
+	ErrLLMNotConfigured = ai.ErrNotConfigured
+	ErrLLMUnavailable   = ai.ErrUnavailable
+)
+
+// AI features, as recorded in the language model usage log and named in per-feature model overrides
+const (
+	LLMFeatureCommentSummary    = "comment_summary"
+	LLMFeatureEpicDecomposition = "epic_decomposition"
+	LLMFeatureCommentModeration = "comment_moderation"
+)
+
+// LLMClient defines the interface AI features use to generate text with the configured language model
+type LLMClient interface {
+	// Complete returns the reply of the model to the prompt, following the system instructions
+	Complete(ctx context.Context, req LLMRequest) (*LLMCompletion, error)
+	// Stream passes the reply to onDelta as the model generates it and returns the whole reply at the end
+	Stream(ctx context.Context, req LLMRequest, onDelta func(text string) error) (*LLMCompletion, error)
+}
+
+// LLMRequest is a prompt for a language model
+type LLMRequest struct {
+	Feature string     // AI feature making the request, for model selection and usage reports
+	UserID  *uuid.UUID // User the request is made for
+	System  string     // System instructions
+	Prompt  string
+}
+
+// LLMCompletion is the reply of a language model with the tokens the provider billed for it
+type LLMCompletion = ai.Completion
+
+// LLMModels selects the model of each AI feature
+type LLMModels struct {
+	Default  string            // Model of features without an override
+	Features map[string]string // Model per feature, such as a smaller model for comment summaries
+}
+
+// For returns the model of a feature
+func (m LLMModels) For(feature string) string {
+	if model, ok := m.Features[feature]; ok && model != "" {
+		return model
+	}
+	return m.Default
+}
+
+// llmClient sends the requests of AI features to a provider and records them in the usage log
+type llmClient struct {
+	provider ai.Provider
+	models   LLMModels
+	usage    LLMUsageService
+	logger   *logrus.Logger
+}
+
+// NewLLMClient creates a client for AI features on top of a provider. usage may be nil to skip usage
+// records; failures to record are logged and do not fail the request.
+func NewLLMClient(provider ai.Provider, models LLMModels, usage LLMUsageService, logger *logrus.Logger) LLMClient {
+	return &llmClient{
+		provider: provider,
+		models:   models,
+		usage:    usage,
+		logger:   logger,
+	}
+}
+
+// Complete sends the request to the model of its feature
+func (c *llmClient) Complete(ctx context.Context, req LLMRequest) (*LLMCompletion, error) {
+	model := c.models.For(req.Feature)
+	start := time.Now()
+	completion, err := c.provider.Complete(ctx, ai.Request{Model: model, System: req.System, Prompt: req.Prompt})
+	c.record(ctx, req, model, completion, err, time.Since(start))
+	return completion, err
+}
+
+// Stream sends the request to the model of its feature and passes the reply on as it is generated
+func (c *llmClient) Stream(ctx context.Context, req LLMRequest, onDelta func(text string) error) (*LLMCompletion, error) {
+	model := c.models.For(req.Feature)
+	start := time.Now()
+	completion, err := c.provider.Stream(ctx, ai.Request{Model: model, System: req.System, Prompt: req.Prompt}, onDelta)
+	c.record(ctx, req, model, completion, err, time.Since(start))
+	return completion, err
+}
+
+// record stores a request in the usage log
+func (c *llmClient) record(ctx context.Context, req LLMRequest, model string, completion *LLMCompletion, err error, latency time.Duration) {
+	if c.usage == nil {
+		return
+	}
+	record := LLMCallRecord{
+		Request:    req,
+		Completion: completion,
+		Provider:   c.provider.Name(),
+		Model:      model,
+		Latency:    latency,
+		Err:        err,
+	}
+	if recordErr := c.usage.RecordCall(ctx, record); recordErr != nil {
+		fields := logrus.Fields{"feature": req.Feature, "error": recordErr.Error()}
+		if req.UserID != nil {
+			fields["user_id"] = *req.UserID
+		}
+		c.logger.WithFields(fields).Error("Failed to record language model call")
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/ai"
+)
+
+// fakeLLMProvider returns a fixed reply, streamed word by word, and records the requests it received
+type fakeLLMProvider struct {
+	reply    string
+	err      error
+	requests []ai.Request
+}
+
+func (p *fakeLLMProvider) Name() string {
+	return ai.ProviderOpenAI
+}
+
+func (p *fakeLLMProvider) Complete(ctx context.Context, req ai.Request) (*ai.Completion, error) {
+	p.requests = append(p.requests, req)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &ai.Completion{Model: req.Model, Text: p.reply, InputTokens: len(req.Prompt), OutputTokens: len(p.reply)}, nil
+}
+
+func (p *fakeLLMProvider) Stream(ctx context.Context, req ai.Request, onDelta func(text string) error) (*ai.Completion, error) {
+	completion, err := p.Complete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, word := range strings.SplitAfter(p.reply, " ") {
+		if err := onDelta(word); err != nil {
+			return nil, err
+		}
+	}
+	return completion, nil
+}
+
+// recordingUsageService keeps the calls it is asked to record
+type recordingUsageService struct {
+	LLMUsageService
+	records []LLMCallRecord
+}
+
+func (s *recordingUsageService) RecordCall(ctx context.Context, record LLMCallRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func TestLLMModels_For(t *testing.T) {
+	models := LLMModels{Default: "large", Features: map[string]string{LLMFeatureCommentSummary: "small", "other": ""}}
+	assert.Equal(t, "small", models.For(LLMFeatureCommentSummary))
+	assert.Equal(t, "large", models.For(LLMFeatureEpicDecomposition))
+	assert.Equal(t, "large", models.For("other"))
+	assert.Equal(t, "large", LLMModels{Default: "large"}.For(LLMFeatureCommentSummary))
+}
+
+func TestLLMClient(t *testing.T) {
+	provider := &fakeLLMProvider{reply: "three short words"}
+	usage := &recordingUsageService{}
+	client := NewLLMClient(provider, LLMModels{Default: "large", Features: map[string]string{LLMFeatureCommentSummary: "small"}}, usage, logrus.New())
+	userID := uuid.New()
+
+	t.Run("uses the model of the feature", func(t *testing.T) {
+		completion, err := client.Complete(context.Background(), LLMRequest{Feature: LLMFeatureCommentSummary, UserID: &userID, System: "be brief", Prompt: "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "three short words", completion.Text)
+		assert.Equal(t, ai.Request{Model: "small", System: "be brief", Prompt: "hello"}, provider.requests[0])
+
+		_, err = client.Complete(context.Background(), LLMRequest{Feature: LLMFeatureEpicDecomposition, Prompt: "epic"})
+		require.NoError(t, err)
+		assert.Equal(t, "large", provider.requests[1].Model)
+
+		require.Len(t, usage.records, 2)
+		assert.Equal(t, ai.ProviderOpenAI, usage.records[0].Provider)
+		assert.Equal(t, "small", usage.records[0].Model)
+		assert.Equal(t, &userID, usage.records[0].Request.UserID)
+		assert.Equal(t, "large", usage.records[1].Model)
+	})
+
+	t.Run("streams the reply", func(t *testing.T) {
+		var deltas []string
+		completion, err := client.Stream(context.Background(), LLMRequest{Feature: LLMFeatureEpicDecomposition, Prompt: "epic"}, func(text string) error {
+			deltas = append(deltas, text)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"three ", "short ", "words"}, deltas)
+		assert.Equal(t, "three short words", completion.Text)
+		require.Len(t, usage.records, 3)
+		assert.Equal(t, completion, usage.records[2].Completion)
+	})
+
+	t.Run("records failures", func(t *testing.T) {
+		provider.err = ErrLLMUnavailable
+		_, err := client.Stream(context.Background(), LLMRequest{Feature: LLMFeatureCommentSummary}, func(text string) error { return nil })
+		assert.ErrorIs(t, err, ErrLLMUnavailable)
+		require.Len(t, usage.records, 4)
+		assert.ErrorIs(t, usage.records[3].Err, ErrLLMUnavailable)
+	})
+
+	t.Run("works without a usage log", func(t *testing.T) {
+		provider.err = nil
+		client := NewLLMClient(provider, LLMModels{Default: "large"}, nil, logrus.New())
+		_, err := client.Complete(context.Background(), LLMRequest{Prompt: "hello"})
+		assert.NoError(t, err)
+	})
+}
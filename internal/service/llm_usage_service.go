@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Language model usage query defaults and limits
+const (
+	DefaultLLMUsageTop   = 10
+	MaxLLMUsageTop       = 100
+	DefaultLLMCallsLimit = 50
+	MaxLLMCallsLimit     = 500
+)
+
+// LLMUsageService defines the interface for recording language model calls, reporting on their cost
+// and warning administrators when the monthly budget is used up
+type LLMUsageService interface {
+	RecordCall(ctx context.Context, call LLMCallRecord) error
+	GetUsage(ctx context.Context, query LLMUsageQuery) (*LLMUsageReport, error)
+	ListCalls(ctx context.Context, filter repository.LLMCallFilter) ([]models.LLMCall, error)
+}
+
+// LLMUsageSettings configures cost estimates and budget alerts; prices are in US dollars per million tokens
+type LLMUsageSettings struct {
+	InputPricePerMTokens  float64
+	OutputPricePerMTokens float64
+	MonthlyBudget         float64 // US dollars per calendar month (UTC); 0 disables budget alerts
+	BudgetAlertPercent    int     // Share of the budget at which administrators are warned, besides 100%
+	LogContent            bool    // Store prompts and responses with the calls
+}
+
+// LLMCallRecord describes a completed language model call
+type LLMCallRecord struct {
+	Request    LLMRequest
+	Completion *LLMCompletion // nil for failed calls
+	Provider   string
+	Model      string
+	Latency    time.Duration
+	Err        error
+}
+
+// LLMUsageQuery selects the month and list size of the language model usage report
+type LLMUsageQuery struct {
+	Month time.Time // Any time in the reported calendar month (UTC); zero for the current month
+	Top   int       // Number of most expensive users
+}
+
+// LLMUsageReport is an aggregate report of language model calls in a calendar month
+// @Description Calls, tokens and estimated cost per AI feature and per user, with the state of the monthly budget
+type LLMUsageReport struct {
+	Month        string                       `json:"month" example:"2023-01"`
+	Calls        int64                        `json:"calls" example:"250"`
+	Failures     int64                        `json:"failures" example:"3"`
+	InputTokens  int64                        `json:"input_tokens" example:"310000"`
+	OutputTokens int64                        `json:"output_tokens" example:"72000"`
+	CostUSD      float64                      `json:"cost_usd" example:"1.855"`
+	Budget       *LLMBudgetStatus             `json:"budget,omitempty"` // Omitted when no monthly budget is configured
+	Features     []repository.LLMFeatureUsage `json:"features"`
+	Users        []repository.LLMUserUsage    `json:"users"`
+}
+
+// LLMBudgetStatus is the share of the monthly budget used by the calls of a month
+type LLMBudgetStatus struct {
+	LimitUSD     float64 `json:"limit_usd" example:"50"`
+	RemainingUSD float64 `json:"remaining_usd" example:"48.145"` // Negative when the budget is exceeded
+	UsedPercent  float64 `json:"used_percent" example:"3.71"`
+	AlertPercent int     `json:"alert_percent" example:"80"`
+}
+
+// llmUsageService implements LLMUsageService interface
+type llmUsageService struct {
+	repos    *repository.Repositories
+	settings LLMUsageSettings
+	mailer   Mailer
+	logger   *logrus.Logger
+	now      func() time.Time
+}
+
+// NewLLMUsageService creates a new language model usage service instance
+func NewLLMUsageService(repos *repository.Repositories, settings LLMUsageSettings, mailer Mailer, logger *logrus.Logger) LLMUsageService {
+	return &llmUsageService{
+		repos:    repos,
+		settings: settings,
+		mailer:   mailer,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// RecordCall stores a call with its estimated cost and warns administrators when the call crosses a
+// budget threshold of the month
+func (s *llmUsageService) RecordCall(ctx context.Context, call LLMCallRecord) error {
+	record := &models.LLMCall{
+		Feature:   call.Request.Feature,
+		UserID:    call.Request.UserID,
+		Provider:  call.Provider,
+		Model:     call.Model,
+		LatencyMs: call.Latency.Milliseconds(),
+		Success:   call.Err == nil,
+		CreatedAt: s.now().UTC(),
+	}
+	if call.Completion != nil {
+		record.InputTokens = call.Completion.InputTokens
+		record.OutputTokens = call.Completion.OutputTokens
+		record.CostUSD = (float64(record.InputTokens)*s.settings.InputPricePerMTokens +
+			float64(record.OutputTokens)*s.settings.OutputPricePerMTokens) / 1e6
+	}
+	if call.Err != nil {
+		record.ErrorMessage = truncateToolCallError(call.Err.Error())
+	}
+	if s.settings.LogContent {
+		prompt := call.Request.System + "\n\n" + call.Request.Prompt
+		record.Prompt = &prompt
+		if call.Completion != nil {
+			record.Response = &call.Completion.Text
+		}
+	}
+
+	if err := s.repos.LLMUsage.CreateCall(ctx, record); err != nil {
+		return fmt.Errorf("failed to record language model call: %w", err)
+	}
+	if s.settings.MonthlyBudget > 0 && record.CostUSD > 0 {
+		return s.checkBudget(ctx, record.CreatedAt, record.CostUSD)
+	}
+	return nil
+}
+
+// GetUsage aggregates the calls made in a calendar month
+func (s *llmUsageService) GetUsage(ctx context.Context, query LLMUsageQuery) (*LLMUsageReport, error) {
+	month := query.Month
+	if month.IsZero() {
+		month = s.now()
+	}
+	since, until := llmUsageMonth(month)
+	top := clampStatisticsParam(query.Top, DefaultLLMUsageTop, MaxLLMUsageTop)
+
+	features, err := s.repos.LLMUsage.SummarizeByFeature(ctx, since, until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize language model calls: %w", err)
+	}
+	users, err := s.repos.LLMUsage.SummarizeByUser(ctx, since, until, top)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize language model calls by user: %w", err)
+	}
+
+	report := &LLMUsageReport{
+		Month:    since.Format("2006-01"),
+		Features: features,
+		Users:    users,
+	}
+	for _, feature := range features {
+		report.Calls += feature.Calls
+		report.Failures += feature.Failures
+		report.InputTokens += feature.InputTokens
+		report.OutputTokens += feature.OutputTokens
+		report.CostUSD += feature.CostUSD
+	}
+	if s.settings.MonthlyBudget > 0 {
+		report.Budget = &LLMBudgetStatus{
+			LimitUSD:     s.settings.MonthlyBudget,
+			RemainingUSD: s.settings.MonthlyBudget - report.CostUSD,
+			UsedPercent:  report.CostUSD / s.settings.MonthlyBudget * 100,
+			AlertPercent: s.settings.BudgetAlertPercent,
+		}
+	}
+	if report.Features == nil {
+		report.Features = []repository.LLMFeatureUsage{}
+	}
+	if report.Users == nil {
+		report.Users = []repository.LLMUserUsage{}
+	}
+	return report, nil
+}
+
+// ListCalls retrieves the most recent calls with their prompts and responses, newest first
+func (s *llmUsageService) ListCalls(ctx context.Context, filter repository.LLMCallFilter) ([]models.LLMCall, error) {
+	filter.Limit = clampStatisticsParam(filter.Limit, DefaultLLMCallsLimit, MaxLLMCallsLimit)
+	calls, err := s.repos.LLMUsage.ListCalls(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list language model calls: %w", err)
+	}
+	if calls == nil {
+		calls = []models.LLMCall{}
+	}
+	return calls, nil
+}
+
+// checkBudget emails the active administrators when the call of the given cost made the spending of the
+// month reach the alert threshold or the whole budget. Each threshold is crossed by exactly one call.
+func (s *llmUsageService) checkBudget(ctx context.Context, at time.Time, cost float64) error {
+	since, until := llmUsageMonth(at)
+	spent, err := s.repos.LLMUsage.SumCost(ctx, since, until)
+	if err != nil {
+		return fmt.Errorf("failed to sum language model cost: %w", err)
+	}
+
+	before := spent - cost
+	percent := 0
+	for _, threshold := range []int{s.settings.BudgetAlertPercent, 100} {
+		limit := s.settings.MonthlyBudget * float64(threshold) / 100
+		if before < limit && spent >= limit {
+			percent = threshold
+		}
+	}
+	if percent == 0 {
+		return nil
+	}
+
+	month := since.Format("January 2006")
+	s.logger.WithFields(logrus.Fields{
+		"month":     since.Format("2006-01"),
+		"spent_usd": spent,
+		"budget":    s.settings.MonthlyBudget,
+	}).Warnf("Language model spending reached %d%% of the monthly budget", percent)
+
+	administrators, err := s.repos.User.List(ctx, map[string]interface{}{"role": models.RoleAdministrator}, "username", 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to list administrators: %w", err)
+	}
+	subject := fmt.Sprintf("AI usage reached %d%% of the %s budget", percent, month)
+	var body strings.Builder
+	fmt.Fprintf(&body, "Language model calls cost an estimated $%.2f in %s, %d%% of the monthly budget of $%.2f.\n",
+		spent, month, int(spent/s.settings.MonthlyBudget*100), s.settings.MonthlyBudget)
+	if percent >= 100 {
+		body.WriteString("\nAI features keep working; raise LLM_MONTHLY_BUDGET or disable the provider to stop further spending.\n")
+	}
+	body.WriteString("\nSee GET /api/v1/admin/llm-usage for the usage per feature and user.\n")
+
+	for _, administrator := range administrators {
+		if !administrator.IsActive() {
+			continue
+		}
+		if err := s.mailer.Send(administrator.Email, subject, body.String()); err != nil {
+			s.logger.WithFields(logrus.Fields{"user_id": administrator.ID, "error": err.Error()}).Error("Failed to send language model budget alert")
+		}
+	}
+	return nil
+}
+
+// llmUsageMonth returns the start of the UTC calendar month of t and the start of the next month
+func llmUsageMonth(t time.Time) (time.Time, time.Time) {
+	t = t.UTC()
+	since := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return since, since.AddDate(0, 1, 0)
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestLLMUsageService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.LLMCall{}))
+
+	alice := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	admin := &models.User{Username: "admin", Email: "admin@example.com", PasswordHash: "hash", Role: models.RoleAdministrator}
+	require.NoError(t, db.Create(alice).Error)
+	require.NoError(t, db.Create(admin).Error)
+
+	now := time.Date(2024, time.March, 13, 15, 0, 0, 0, time.UTC)
+	mailer := &recordingMailer{}
+	// $1 per million input tokens and $2 per million output tokens, with a budget of $1 a month
+	svc := NewLLMUsageService(repository.NewRepositories(db, nil), LLMUsageSettings{
+		InputPricePerMTokens:  1,
+		OutputPricePerMTokens: 2,
+		MonthlyBudget:         1,
+		BudgetAlertPercent:    50,
+		LogContent:            true,
+	}, mailer, logrus.New()).(*llmUsageService)
+	svc.now = func() time.Time { return now }
+
+	fake := &fakeLLMProvider{reply: "summary"}
+	client := NewLLMClient(fake, LLMModels{Default: "gpt-test"}, svc, logrus.New())
+
+	t.Run("records calls with their cost", func(t *testing.T) {
+		completion, err := client.Complete(context.Background(), LLMRequest{Feature: LLMFeatureCommentSummary, UserID: &alice.ID, System: "be brief", Prompt: "hello"})
+		require.NoError(t, err)
+		assert.Equal(t, "summary", completion.Text)
+
+		calls, err := svc.ListCalls(ctx, repository.LLMCallFilter{})
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, "openai", calls[0].Provider)
+		assert.Equal(t, "gpt-test", calls[0].Model)
+		assert.Equal(t, 5, calls[0].InputTokens)
+		assert.Equal(t, 7, calls[0].OutputTokens)
+		assert.InDelta(t, 19e-6, calls[0].CostUSD, 1e-12)
+		require.NotNil(t, calls[0].Prompt)
+		assert.Equal(t, "be brief\n\nhello", *calls[0].Prompt)
+		assert.Equal(t, "summary", *calls[0].Response)
+		assert.Equal(t, "alice", calls[0].User.Username)
+	})
+
+	t.Run("records failed calls", func(t *testing.T) {
+		fake.err = errors.New("timeout")
+		_, err := client.Complete(context.Background(), LLMRequest{Feature: LLMFeatureEpicDecomposition, UserID: &alice.ID, Prompt: "epic"})
+		assert.EqualError(t, err, "timeout")
+		fake.err = nil
+
+		calls, err := svc.ListCalls(ctx, repository.LLMCallFilter{Feature: LLMFeatureEpicDecomposition})
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.False(t, calls[0].Success)
+		assert.Equal(t, "timeout", calls[0].ErrorMessage)
+		assert.Nil(t, calls[0].Response)
+	})
+
+	t.Run("alerts administrators once per budget threshold", func(t *testing.T) {
+		record := func(inputTokens int) {
+			require.NoError(t, svc.RecordCall(ctx, LLMCallRecord{
+				Request:    LLMRequest{Feature: LLMFeatureCommentSummary, UserID: &alice.ID},
+				Completion: &LLMCompletion{InputTokens: inputTokens},
+				Provider:   "openai",
+				Model:      "gpt-test",
+			}))
+		}
+		record(400000)
+		assert.Empty(t, mailer.subject)
+
+		record(200000)
+		require.Len(t, mailer.subject, 1)
+		assert.Equal(t, []string{"admin@example.com"}, mailer.to)
+		assert.Equal(t, "AI usage reached 50% of the March 2024 budget", mailer.subject[0])
+
+		record(100000)
+		assert.Len(t, mailer.subject, 1)
+
+		record(400000)
+		require.Len(t, mailer.subject, 2)
+		assert.Equal(t, "AI usage reached 100% of the March 2024 budget", mailer.subject[1])
+		assert.Contains(t, mailer.body[1], "raise LLM_MONTHLY_BUDGET")
+	})
+
+	t.Run("reports usage of a month", func(t *testing.T) {
+		require.NoError(t, db.Create(&models.LLMCall{Feature: LLMFeatureCommentSummary, Provider: "openai", Model: "gpt-test",
+			InputTokens: 1000, CostUSD: 5, Success: true, CreatedAt: now.AddDate(0, -1, 0)}).Error)
+
+		report, err := svc.GetUsage(ctx, LLMUsageQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03", report.Month)
+		assert.Equal(t, int64(6), report.Calls)
+		assert.Equal(t, int64(1), report.Failures)
+		assert.InDelta(t, 1.100019, report.CostUSD, 1e-9)
+		require.NotNil(t, report.Budget)
+		assert.InDelta(t, -0.100019, report.Budget.RemainingUSD, 1e-9)
+
+		require.Len(t, report.Features, 2)
+		assert.Equal(t, LLMFeatureCommentSummary, report.Features[0].Feature)
+		assert.Equal(t, int64(5), report.Features[0].Calls)
+		require.Len(t, report.Users, 1)
+		assert.Equal(t, "alice", report.Users[0].Username)
+		assert.Equal(t, int64(6), report.Users[0].Calls)
+
+		report, err = svc.GetUsage(ctx, LLMUsageQuery{Month: now.AddDate(0, -1, 0)})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-02", report.Month)
+		assert.Equal(t, int64(1), report.Calls)
+		assert.Empty(t, report.Users)
+	})
+
+	t.Run("content logging can be disabled", func(t *testing.T) {
+		svc.settings.LogContent = false
+		_, err := client.Complete(context.Background(), LLMRequest{Feature: "private", Prompt: "secret"})
+		require.NoError(t, err)
+
+		calls, err := svc.ListCalls(ctx, repository.LLMCallFilter{Feature: "private"})
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.Nil(t, calls[0].Prompt)
+		assert.Nil(t, calls[0].Response)
+	})
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+)
+
+var (
+	ErrMailQueueFull   = apperrors.New(apperrors.KindUnavailable, "MAIL_QUEUE_FULL", "too many emails are waiting to be sent")
+	ErrMailQueueClosed = apperrors.New(apperrors.KindUnavailable, "MAIL_QUEUE_CLOSED", "the server is shutting down and sends no more email")
+)
+
+// queuedMail is an email waiting to be sent
+type queuedMail struct {
+	to         string
+	subject    string
+	body       string
+	attachment *MailAttachment
+}
+
+// MailQueue is a Mailer that sends emails in the background, so requests that send many emails do not wait for
+// the SMTP server. Send only queues the email; emails that fail later are logged. RunMailQueue sends the emails
+// and Drain sends the rest when the server shuts down. The queue lives in memory: emails still queued when the
+// process is killed are lost.
+type MailQueue struct {
+	mailer Mailer
+	mails  chan queuedMail
+	done   chan struct{} // Closed when RunMailQueue returns
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewMailQueue creates a queue of up to size emails sent through mailer
+func NewMailQueue(mailer Mailer, size int) *MailQueue {
+	return &MailQueue{mailer: mailer, mails: make(chan queuedMail, size), done: make(chan struct{})}
+}
+
+// Send queues a plain text email
+func (q *MailQueue) Send(to, subject, body string) error {
+	return q.enqueue(queuedMail{to: to, subject: subject, body: body})
+}
+
+// SendWithAttachment queues a plain text email with one attachment
+func (q *MailQueue) SendWithAttachment(to, subject, body string, attachment MailAttachment) error {
+	return q.enqueue(queuedMail{to: to, subject: subject, body: body, attachment: &attachment})
+}
+
+// enqueue adds an email to the queue without waiting for room
+func (q *MailQueue) enqueue(mail queuedMail) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrMailQueueClosed
+	}
+	select {
+	case q.mails <- mail:
+		return nil
+	default:
+		return ErrMailQueueFull
+	}
+}
+
+// Drain stops queueing emails and waits until RunMailQueue has sent the queued ones or ctx is done
+func (q *MailQueue) Drain(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.mails)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%d queued emails were not sent: %w", len(q.mails), ctx.Err())
+	}
+}
+
+// RunMailQueue sends the queued emails one at a time until the queue is drained or the context is cancelled.
+// It must run once per queue.
+func RunMailQueue(ctx context.Context, queue *MailQueue, logger *logrus.Logger) {
+	defer close(queue.done)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case mail, ok := <-queue.mails:
+			if !ok {
+				return
+			}
+			var err error
+			if mail.attachment != nil {
+				err = queue.mailer.SendWithAttachment(mail.to, mail.subject, mail.body, *mail.attachment)
+			} else {
+				err = queue.mailer.Send(mail.to, mail.subject, mail.body)
+			}
+			if err != nil {
+				logger.WithError(err).WithFields(logrus.Fields{
+					"to":      mail.to,
+					"subject": mail.subject,
+				}).Error("Failed to send queued email")
+			}
+		}
+	}
+}
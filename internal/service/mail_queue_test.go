@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailQueue(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	t.Run("rejects emails once the queue is full", func(t *testing.T) {
+		queue := NewMailQueue(&recordingMailer{}, 1)
+		require.NoError(t, queue.Send("jane@example.com", "Welcome", "Hello"))
+		assert.ErrorIs(t, queue.Send("john@example.com", "Welcome", "Hello"), ErrMailQueueFull)
+	})
+
+	t.Run("sends queued emails in the background", func(t *testing.T) {
+		mailer := &recordingMailer{}
+		queue := NewMailQueue(mailer, 2)
+		require.NoError(t, queue.Send("jane@example.com", "Welcome", "Hello"))
+		require.NoError(t, queue.SendWithAttachment("john@example.com", "Report", "Attached", MailAttachment{Filename: "report.pdf"}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			RunMailQueue(ctx, queue, logger)
+			close(done)
+		}()
+		assert.Eventually(t, func() bool { return len(queue.mails) == 0 }, time.Second, time.Millisecond)
+		cancel()
+		<-done
+
+		assert.Equal(t, []string{"jane@example.com", "john@example.com"}, mailer.to)
+		require.Len(t, mailer.attachments, 1)
+		assert.Equal(t, "report.pdf", mailer.attachments[0].Filename)
+	})
+
+	t.Run("sends the queued emails before shutting down", func(t *testing.T) {
+		mailer := &recordingMailer{}
+		queue := NewMailQueue(mailer, 2)
+		require.NoError(t, queue.Send("jane@example.com", "Welcome", "Hello"))
+		require.NoError(t, queue.Send("john@example.com", "Welcome", "Hello"))
+
+		go RunMailQueue(context.Background(), queue, logger)
+		require.NoError(t, queue.Drain(context.Background()))
+		assert.Equal(t, []string{"jane@example.com", "john@example.com"}, mailer.to)
+		assert.ErrorIs(t, queue.Send("kim@example.com", "Welcome", "Hello"), ErrMailQueueClosed)
+	})
+
+	t.Run("gives up draining when the shutdown times out", func(t *testing.T) {
+		queue := NewMailQueue(&recordingMailer{}, 1)
+		require.NoError(t, queue.Send("jane@example.com", "Welcome", "Hello"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := queue.Drain(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Contains(t, err.Error(), "1 queued emails were not sent")
+	})
+
+	t.Run("keeps sending after a failed email", func(t *testing.T) {
+		mailer := &recordingMailer{err: errors.New("smtp unavailable")}
+		queue := NewMailQueue(mailer, 1)
+		require.NoError(t, queue.Send("jane@example.com", "Welcome", "Hello"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go RunMailQueue(ctx, queue, logger)
+		assert.Eventually(t, func() bool { return len(queue.mails) == 0 }, time.Second, time.Millisecond)
+		assert.NoError(t, queue.Send("john@example.com", "Welcome", "Hello"))
+	})
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Mailer defines the interface for sending plain text emails
+type Mailer interface {
+	Send(to, subject, body string) error
+	SendWithAttachment(to, subject, body string, attachment MailAttachment) error
+}
+
+// MailAttachment is a file attached to an email
+type MailAttachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// smtpMailer sends emails through an SMTP server
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTPMailer creates a mailer that sends through the given SMTP server
+// Authentication is skipped when username is empty
+func NewSMTPMailer(host, port, username, password, from string) Mailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &smtpMailer{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+// Send sends a plain text email
+func (m *smtpMailer) Send(to, subject, body string) error {
+	message := strings.Join([]string{
+		"From: " + m.from,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	return m.deliver(to, message)
+}
+
+// SendWithAttachment sends a plain text email with one base64 encoded attachment
+func (m *smtpMailer) SendWithAttachment(to, subject, body string, attachment MailAttachment) error {
+	var random [12]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return fmt.Errorf("failed to generate MIME boundary: %w", err)
+	}
+	boundary := "rms-" + hex.EncodeToString(random[:])
+
+	var message strings.Builder
+	message.WriteString(strings.Join([]string{
+		"From: " + m.from,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/mixed; boundary=" + boundary,
+		"",
+		"--" + boundary,
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+		"--" + boundary,
+		"Content-Type: " + attachment.ContentType,
+		"Content-Transfer-Encoding: base64",
+		fmt.Sprintf("Content-Disposition: attachment; filename=%q", attachment.Filename),
+		"",
+		"",
+	}, "\r\n"))
+
+	// Base64 lines must not exceed 76 characters
+	encoded := base64.StdEncoding.EncodeToString(attachment.Content)
+	for len(encoded) > 76 {
+		message.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	message.WriteString(encoded + "\r\n--" + boundary + "--\r\n")
+
+	return m.deliver(to, message.String())
+}
+
+// deliver hands a complete message to the SMTP server
+func (m *smtpMailer) deliver(to, message string) error {
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{to}, []byte(message)); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", to, err)
+	}
+	return nil
+}
+
+// logMailer writes emails to the log instead of sending them
+type logMailer struct {
+	logger *logrus.Logger
+}
+
+// NewLogMailer creates a mailer that only logs emails, for environments without SMTP
+func NewLogMailer(logger *logrus.Logger) Mailer {
+	return &logMailer{logger: logger}
+}
+
+// Send logs the email
+func (m *logMailer) Send(to, subject, body string) error {
+	m.logger.WithFields(logrus.Fields{
+		"to":      to,
+		"subject": subject,
+	}).Info("Email not sent: SMTP is not configured")
+	return nil
+}
+
+// SendWithAttachment logs the email and the name and size of its attachment
+func (m *logMailer) SendWithAttachment(to, subject, body string, attachment MailAttachment) error {
+	m.logger.WithFields(logrus.Fields{
+		"to":         to,
+		"subject":    subject,
+		"attachment": attachment.Filename,
+		"size":       len(attachment.Content),
+	}).Info("Email not sent: SMTP is not configured")
+	return nil
+}
+
+// discardMailer drops every email
+type discardMailer struct{}
+
+// NewDiscardMailer creates a mailer that sends nothing, for servers such as demo sessions whose users must not send email
+func NewDiscardMailer() Mailer {
+	return discardMailer{}
+}
+
+// Send drops the email
+func (discardMailer) Send(to, subject, body string) error {
+	return nil
+}
+
+// SendWithAttachment drops the email
+func (discardMailer) SendWithAttachment(to, subject, body string, attachment MailAttachment) error {
+	return nil
+}
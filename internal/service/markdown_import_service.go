@@ -0,0 +1,563 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/validation"
+)
+
+const (
+	// maxImportContentLength is the largest Markdown document accepted for import, in bytes
+	maxImportContentLength = 1 << 20
+	// maxImportTitleLength and maxImportDescriptionLength mirror the entity model limits
+	maxImportTitleLength       = 500
+	maxImportDescriptionLength = 50000
+	// defaultImportRequirementType is used for requirement rows without a type
+	defaultImportRequirementType = "Functional"
+)
+
+var (
+	ErrImportContentRequired = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "markdown content is required")
+	ErrImportContentTooLarge = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "markdown content must not exceed 1 MiB")
+	ErrImportInvalid         = apperrors.New(apperrors.KindUnprocessable, "IMPORT_INVALID", "markdown import has validation errors")
+)
+
+var (
+	// importReferencePrefix matches a leading reference ID such as "EP-001: " left over from an export
+	importReferencePrefix = regexp.MustCompile(`^[A-Z]+-\d+:\s+`)
+	// importMetadataField matches a "**Name:** value" field of a metadata line
+	importMetadataField = regexp.MustCompile(`\*\*([^*:]+):\*\*\s*([^·|]*)`)
+	// importListItem matches a bullet or numbered list item
+	importListItem = regexp.MustCompile(`^(?:[-*+]|\d+[.)])\s+(.*)$`)
+	// importTableSeparator matches a table header separator row such as "|---|:---:|"
+	importTableSeparator = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?$`)
+)
+
+// MarkdownImportService defines the interface for creating entity hierarchies from structured Markdown
+type MarkdownImportService interface {
+	Preview(ctx context.Context, content string) (*MarkdownImportPlan, error)
+	Import(ctx context.Context, content string, creatorID uuid.UUID) (*MarkdownImportPlan, error)
+}
+
+// MarkdownImportPlan is the entity tree parsed from a Markdown document
+// @Description Entities parsed from a Markdown outline with per-node validation results; after an import the nodes carry the created IDs
+type MarkdownImportPlan struct {
+	Valid   bool                  `json:"valid" example:"true"`
+	Errors  []MarkdownImportIssue `json:"errors"`
+	Summary MarkdownImportSummary `json:"summary"`
+	Epics   []*MarkdownImportNode `json:"epics"`
+}
+
+// MarkdownImportIssue is a document-level problem that does not belong to a single node
+type MarkdownImportIssue struct {
+	Line    int    `json:"line" example:"3"`
+	Message string `json:"message" example:"Content before the first epic heading is not imported"`
+}
+
+// MarkdownImportSummary counts the entities of a plan
+type MarkdownImportSummary struct {
+	Epics              int `json:"epics" example:"1"`
+	UserStories        int `json:"user_stories" example:"2"`
+	AcceptanceCriteria int `json:"acceptance_criteria" example:"4"`
+	Requirements       int `json:"requirements" example:"3"`
+}
+
+// MarkdownImportNode is an entity parsed from the document.
+// Errors block the import; warnings such as EARS lint findings do not.
+type MarkdownImportNode struct {
+	Type            models.EntityType     `json:"type" example:"user_story"`
+	Line            int                   `json:"line" example:"5"`
+	Title           string                `json:"title,omitempty" example:"Sign up with email"`
+	Description     string                `json:"description,omitempty"`
+	Priority        models.Priority       `json:"priority,omitempty" example:"3"`
+	RequirementType string                `json:"requirement_type,omitempty" example:"Functional"`
+	ID              *uuid.UUID            `json:"id,omitempty"`
+	ReferenceID     string                `json:"reference_id,omitempty" example:"US-012"`
+	Errors          []string              `json:"errors,omitempty"`
+	Warnings        []string              `json:"warnings,omitempty"`
+	Children        []*MarkdownImportNode `json:"children,omitempty"`
+
+	typeID uuid.UUID
+}
+
+// markdownImportService implements MarkdownImportService interface
+type markdownImportService struct {
+	repos  *repository.Repositories
+	linter validation.EARSLinter
+}
+
+// NewMarkdownImportService creates a new Markdown import service instance
+func NewMarkdownImportService(repos *repository.Repositories, linter validation.EARSLinter) MarkdownImportService {
+	return &markdownImportService{
+		repos:  repos,
+		linter: linter,
+	}
+}
+
+// Preview parses and validates a Markdown outline without creating anything
+func (s *markdownImportService) Preview(ctx context.Context, content string) (*MarkdownImportPlan, error) {
+	if err := validateImportContent(content); err != nil {
+		return nil, err
+	}
+
+	plan := parseMarkdownOutline(content)
+	if err := s.validatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Import creates the entities of a Markdown outline in one transaction.
+// An invalid outline creates nothing and is returned with ErrImportInvalid.
+func (s *markdownImportService) Import(ctx context.Context, content string, creatorID uuid.UUID) (*MarkdownImportPlan, error) {
+	plan, err := s.Preview(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Valid {
+		return plan, ErrImportInvalid
+	}
+
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		for _, epicNode := range plan.Epics {
+			if err := createImportedEpic(ctx, tx, epicNode, creatorID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// validateImportContent checks the size of a document before parsing
+func validateImportContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return ErrImportContentRequired
+	}
+	if len(content) > maxImportContentLength {
+		return ErrImportContentTooLarge
+	}
+	return nil
+}
+
+// validatePlan checks every node, resolves requirement type names and counts the entities of a plan
+func (s *markdownImportService) validatePlan(ctx context.Context, plan *MarkdownImportPlan) error {
+	types, err := s.repos.RequirementType.List(ctx, nil, "name", 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to list requirement types: %w", err)
+	}
+	requirementTypes := make(map[string]models.RequirementType, len(types))
+	for _, requirementType := range types {
+		requirementTypes[strings.ToLower(requirementType.Name)] = requirementType
+	}
+
+	valid := len(plan.Errors) == 0
+	var visit func(node *MarkdownImportNode)
+	visit = func(node *MarkdownImportNode) {
+		switch node.Type {
+		case models.EntityTypeEpic:
+			plan.Summary.Epics++
+			validateImportTitle(node)
+		case models.EntityTypeUserStory:
+			plan.Summary.UserStories++
+			validateImportTitle(node)
+		case models.EntityTypeAcceptanceCriteria:
+			plan.Summary.AcceptanceCriteria++
+			for _, finding := range s.linter.Lint(node.Description).Findings {
+				if finding.Severity == validation.LintSeverityError {
+					node.Warnings = append(node.Warnings, finding.Message)
+				}
+			}
+		case models.EntityTypeRequirement:
+			plan.Summary.Requirements++
+			validateImportTitle(node)
+			if node.RequirementType == "" {
+				node.RequirementType = defaultImportRequirementType
+			}
+			requirementType, ok := requirementTypes[strings.ToLower(node.RequirementType)]
+			if !ok {
+				node.Errors = append(node.Errors, fmt.Sprintf("Unknown requirement type %q", node.RequirementType))
+			} else {
+				node.RequirementType, node.typeID = requirementType.Name, requirementType.ID
+			}
+		}
+		if utf8.RuneCountInString(node.Description) > maxImportDescriptionLength {
+			node.Errors = append(node.Errors, fmt.Sprintf("Description must not exceed %d characters", maxImportDescriptionLength))
+		}
+		if len(node.Errors) > 0 {
+			valid = false
+		}
+
+		for _, child := range node.Children {
+			visit(child)
+		}
+	}
+	for _, epicNode := range plan.Epics {
+		visit(epicNode)
+	}
+
+	if len(plan.Epics) == 0 {
+		plan.Errors = append(plan.Errors, MarkdownImportIssue{Line: 1, Message: "The document has no epic heading"})
+		valid = false
+	}
+	plan.Valid = valid
+	return nil
+}
+
+// validateImportTitle checks the title of an epic, user story or requirement
+func validateImportTitle(node *MarkdownImportNode) {
+	if node.Title == "" {
+		node.Errors = append(node.Errors, "Title is required")
+	} else if utf8.RuneCountInString(node.Title) > maxImportTitleLength {
+		node.Errors = append(node.Errors, fmt.Sprintf("Title must not exceed %d characters", maxImportTitleLength))
+	}
+}
+
+// createImportedEpic creates an epic and its descendants, recording the created IDs on the nodes
+func createImportedEpic(ctx context.Context, tx *repository.Repositories, node *MarkdownImportNode, creatorID uuid.UUID) error {
+	epic := &models.Epic{
+		CreatorID:   creatorID,
+		AssigneeID:  creatorID,
+		Priority:    node.Priority,
+		Status:      models.EpicStatusBacklog,
+		Title:       node.Title,
+		Description: optionalImportText(node.Description),
+	}
+	if err := tx.Epic.Create(ctx, epic); err != nil {
+		return fmt.Errorf("failed to create epic %q: %w", node.Title, err)
+	}
+	node.ID, node.ReferenceID = &epic.ID, epic.ReferenceID
+
+	for _, storyNode := range node.Children {
+		userStory := &models.UserStory{
+			EpicID:      epic.ID,
+			CreatorID:   creatorID,
+			AssigneeID:  creatorID,
+			Priority:    storyNode.Priority,
+			Status:      models.UserStoryStatusBacklog,
+			Title:       storyNode.Title,
+			Description: optionalImportText(storyNode.Description),
+		}
+		if err := tx.UserStory.Create(ctx, userStory); err != nil {
+			return fmt.Errorf("failed to create user story %q: %w", storyNode.Title, err)
+		}
+		storyNode.ID, storyNode.ReferenceID = &userStory.ID, userStory.ReferenceID
+
+		for _, child := range storyNode.Children {
+			switch child.Type {
+			case models.EntityTypeAcceptanceCriteria:
+				acceptanceCriteria := &models.AcceptanceCriteria{
+					UserStoryID: userStory.ID,
+					AuthorID:    creatorID,
+					Description: child.Description,
+				}
+				if err := tx.AcceptanceCriteria.Create(ctx, acceptanceCriteria); err != nil {
+					return fmt.Errorf("failed to create acceptance criteria for %q: %w", storyNode.Title, err)
+				}
+				child.ID, child.ReferenceID = &acceptanceCriteria.ID, acceptanceCriteria.ReferenceID
+
+			case models.EntityTypeRequirement:
+				requirement := &models.Requirement{
+					UserStoryID: userStory.ID,
+					CreatorID:   creatorID,
+					AssigneeID:  creatorID,
+					Priority:    child.Priority,
+					Status:      models.RequirementStatusDraft,
+					TypeID:      child.typeID,
+					Title:       child.Title,
+					Description: optionalImportText(child.Description),
+				}
+				if err := tx.Requirement.Create(ctx, requirement); err != nil {
+					return fmt.Errorf("failed to create requirement %q: %w", child.Title, err)
+				}
+				child.ID, child.ReferenceID = &requirement.ID, requirement.ReferenceID
+			}
+		}
+	}
+	return nil
+}
+
+// optionalImportText returns nil for an empty description
+func optionalImportText(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// markdownOutlineParser keeps the position of a parse within the entity tree
+type markdownOutlineParser struct {
+	plan        *MarkdownImportPlan
+	epic        *MarkdownImportNode
+	userStory   *MarkdownImportNode
+	description *MarkdownImportNode
+	paragraph   []string
+	listItem    *MarkdownImportNode
+	table       *markdownImportTable
+	preamble    bool
+}
+
+// markdownImportTable is the column layout of a requirements table being parsed
+type markdownImportTable struct {
+	columns   map[string]int
+	separated bool
+}
+
+// parseMarkdownOutline turns a Markdown outline into an entity tree:
+// "#" headings are epics, "##" headings are user stories, list items under a user story are
+// acceptance criteria and table rows under a user story are requirements. Paragraphs become the
+// description of the epic or user story above them; "**Priority:** High" lines set its priority.
+func parseMarkdownOutline(content string) *MarkdownImportPlan {
+	p := &markdownOutlineParser{
+		plan: &MarkdownImportPlan{Errors: []MarkdownImportIssue{}, Epics: []*MarkdownImportNode{}},
+	}
+
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	inFence := false
+	for i, raw := range lines {
+		lineNumber := i + 1
+		line := strings.TrimSpace(raw)
+
+		// Fenced code blocks are kept verbatim in the description
+		if strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~") {
+			inFence = !inFence
+			p.endBlock()
+			p.addText(lineNumber, raw)
+			continue
+		}
+		if inFence {
+			p.addText(lineNumber, raw)
+			continue
+		}
+
+		switch {
+		case line == "":
+			p.endBlock()
+			if p.description != nil && len(p.paragraph) > 0 && p.paragraph[len(p.paragraph)-1] != "" {
+				p.paragraph = append(p.paragraph, "")
+			}
+
+		case isImportHeading(line):
+			p.endBlock()
+			p.heading(lineNumber, line)
+
+		case strings.HasPrefix(line, "|"):
+			p.listItem = nil
+			p.tableRow(lineNumber, line)
+
+		case importListItem.MatchString(line) && p.userStory != nil:
+			p.table = nil
+			p.listItem = &MarkdownImportNode{
+				Type:        models.EntityTypeAcceptanceCriteria,
+				Line:        lineNumber,
+				Description: strings.TrimSpace(importListItem.FindStringSubmatch(line)[1]),
+			}
+			p.userStory.Children = append(p.userStory.Children, p.listItem)
+
+		case p.listItem != nil && raw != line:
+			// An indented line continues the current list item
+			p.listItem.Description += " " + line
+
+		case strings.HasPrefix(line, "**") && importMetadataField.MatchString(line) && p.description != nil:
+			p.endBlock()
+			p.metadata(lineNumber, line)
+
+		default:
+			p.endBlock()
+			p.addText(lineNumber, raw)
+		}
+	}
+	p.flushDescription()
+	return p.plan
+}
+
+// heading starts an epic or user story; deeper headings only label sections
+func (p *markdownOutlineParser) heading(lineNumber int, line string) {
+	level := len(line) - len(strings.TrimLeft(line, "#"))
+	title := strings.TrimSpace(line[level:])
+	title = importReferencePrefix.ReplaceAllString(title, "")
+
+	switch level {
+	case 1:
+		p.flushDescription()
+		p.epic = &MarkdownImportNode{Type: models.EntityTypeEpic, Line: lineNumber, Title: title, Priority: models.PriorityMedium}
+		p.userStory = nil
+		p.description = p.epic
+		p.plan.Epics = append(p.plan.Epics, p.epic)
+
+	case 2:
+		p.flushDescription()
+		p.userStory = &MarkdownImportNode{Type: models.EntityTypeUserStory, Line: lineNumber, Title: title, Priority: models.PriorityMedium}
+		p.description = p.userStory
+		if p.epic == nil {
+			p.userStory.Errors = append(p.userStory.Errors, "User story heading must follow an epic heading")
+			p.plan.Errors = append(p.plan.Errors, MarkdownImportIssue{Line: lineNumber, Message: "User story heading must follow an epic heading"})
+			return
+		}
+		p.epic.Children = append(p.epic.Children, p.userStory)
+
+	default:
+		// Deeper headings such as "### Acceptance Criteria" only label the sections of a user story
+	}
+}
+
+// isImportHeading reports whether a line is an ATX heading such as "## Sign up"
+func isImportHeading(line string) bool {
+	level := len(line) - len(strings.TrimLeft(line, "#"))
+	return level > 0 && level <= 6 && (level == len(line) || line[level] == ' ' || line[level] == '\t')
+}
+
+// tableRow reads a requirements table header, separator or row
+func (p *markdownOutlineParser) tableRow(lineNumber int, line string) {
+	cells := splitImportTableRow(line)
+
+	if p.table == nil {
+		p.table = &markdownImportTable{columns: make(map[string]int)}
+		for i, cell := range cells {
+			p.table.columns[strings.ToLower(cell)] = i
+		}
+		if _, ok := p.table.columns["title"]; !ok {
+			p.plan.Errors = append(p.plan.Errors, MarkdownImportIssue{Line: lineNumber, Message: "Requirements table must have a Title column"})
+		}
+		return
+	}
+	if !p.table.separated && importTableSeparator.MatchString(line) {
+		p.table.separated = true
+		return
+	}
+
+	cell := func(column string) string {
+		if index, ok := p.table.columns[column]; ok && index < len(cells) {
+			return cells[index]
+		}
+		return ""
+	}
+	node := &MarkdownImportNode{
+		Type:            models.EntityTypeRequirement,
+		Line:            lineNumber,
+		Title:           importReferencePrefix.ReplaceAllString(cell("title"), ""),
+		Description:     cell("description"),
+		RequirementType: cell("type"),
+		Priority:        models.PriorityMedium,
+	}
+	if value := cell("priority"); value != "" {
+		priority, ok := parseImportPriority(value)
+		if !ok {
+			node.Errors = append(node.Errors, fmt.Sprintf("Unknown priority %q", value))
+		}
+		node.Priority = priority
+	}
+	if len(cells) != len(p.table.columns) {
+		node.Errors = append(node.Errors, fmt.Sprintf("Row has %d cells but the table has %d columns", len(cells), len(p.table.columns)))
+	}
+
+	if p.userStory == nil {
+		node.Errors = append(node.Errors, "Requirement rows must follow a user story heading")
+		p.plan.Errors = append(p.plan.Errors, MarkdownImportIssue{Line: lineNumber, Message: "Requirement rows must follow a user story heading"})
+		return
+	}
+	p.userStory.Children = append(p.userStory.Children, node)
+}
+
+// metadata applies "**Priority:** High · **Status:** Draft" fields to the current epic or user story
+func (p *markdownOutlineParser) metadata(lineNumber int, line string) {
+	for _, match := range importMetadataField.FindAllStringSubmatch(line, -1) {
+		if !strings.EqualFold(strings.TrimSpace(match[1]), "priority") {
+			continue
+		}
+		value := strings.TrimSpace(match[2])
+		priority, ok := parseImportPriority(value)
+		if !ok {
+			p.description.Errors = append(p.description.Errors, fmt.Sprintf("Unknown priority %q on line %d", value, lineNumber))
+			continue
+		}
+		p.description.Priority = priority
+	}
+}
+
+// addText adds a line to the description of the current epic or user story
+func (p *markdownOutlineParser) addText(lineNumber int, raw string) {
+	if p.description == nil {
+		if !p.preamble {
+			p.preamble = true
+			p.plan.Errors = append(p.plan.Errors, MarkdownImportIssue{Line: lineNumber, Message: "Content before the first epic heading is not imported"})
+		}
+		return
+	}
+	p.paragraph = append(p.paragraph, strings.TrimRight(raw, " \t"))
+}
+
+// endBlock ends the current list item and table
+func (p *markdownOutlineParser) endBlock() {
+	p.listItem = nil
+	p.table = nil
+}
+
+// flushDescription stores the collected paragraphs as the description of the current node
+func (p *markdownOutlineParser) flushDescription() {
+	if p.description != nil {
+		text := strings.TrimSpace(strings.Join(p.paragraph, "\n"))
+		if text != "" {
+			if p.description.Description != "" {
+				text = p.description.Description + "\n\n" + text
+			}
+			p.description.Description = text
+		}
+	}
+	p.paragraph = nil
+	p.description = nil
+}
+
+// splitImportTableRow splits a Markdown table row into trimmed cells, honoring escaped pipes
+func splitImportTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = strings.TrimSuffix(line, "|")
+	}
+
+	var (
+		cells   []string
+		current strings.Builder
+	)
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			current.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(current.String()))
+			current.Reset()
+		default:
+			current.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(current.String()))
+}
+
+// parseImportPriority reads a priority name such as "High" or a number from 1 to 4
+func parseImportPriority(value string) (models.Priority, bool) {
+	if number, err := strconv.Atoi(value); err == nil {
+		priority := models.Priority(number)
+		return priority, models.ValidatePriority(priority)
+	}
+	for priority := models.PriorityCritical; priority <= models.PriorityLow; priority++ {
+		if strings.EqualFold(value, models.GetPriorityString(priority)) {
+			return priority, true
+		}
+	}
+	return models.PriorityMedium, false
+}
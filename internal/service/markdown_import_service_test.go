@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/validation"
+)
+
+const importOutline = `# EP-001: Accounts
+**Priority:** High
+
+People need accounts to save their work.
+
+## Sign up with email
+
+Visitors create an account with an email address.
+
+### Acceptance Criteria
+
+- WHEN a visitor submits the sign up form THEN the system SHALL create an account
+- Accounts should be created quickly
+  even on slow networks
+
+### Requirements
+
+| Title | Type | Priority | Description |
+|-------|------|----------|-------------|
+| Password rules | functional | 2 | At least 12 characters \| no reuse |
+| Sign up audit | | Low | |
+`
+
+// sequentialReferenceIDGenerator numbers reference IDs in memory from 101, above the fixture IDs,
+// since sqlite lacks the PostgreSQL functions of the production generators
+type sequentialReferenceIDGenerator struct {
+	prefix string
+	next   int
+}
+
+func (g *sequentialReferenceIDGenerator) Generate(tx *gorm.DB, model interface{}) (string, error) {
+	g.next++
+	return fmt.Sprintf("%s-%03d", g.prefix, g.next+100), nil
+}
+
+// useSequentialReferenceIDs swaps in sequential generators for the hierarchy entities until the test ends
+func useSequentialReferenceIDs(t *testing.T) {
+	epic, userStory := models.GetEpicGenerator(), models.GetUserStoryGenerator()
+	acceptanceCriteria, requirement := models.GetAcceptanceCriteriaGenerator(), models.GetRequirementGenerator()
+	t.Cleanup(func() {
+		models.SetEpicGenerator(epic)
+		models.SetUserStoryGenerator(userStory)
+		models.SetAcceptanceCriteriaGenerator(acceptanceCriteria)
+		models.SetRequirementGenerator(requirement)
+	})
+
+	models.SetEpicGenerator(&sequentialReferenceIDGenerator{prefix: "EP"})
+	models.SetUserStoryGenerator(&sequentialReferenceIDGenerator{prefix: "US"})
+	models.SetAcceptanceCriteriaGenerator(&sequentialReferenceIDGenerator{prefix: "AC"})
+	models.SetRequirementGenerator(&sequentialReferenceIDGenerator{prefix: "REQ"})
+}
+
+func TestParseMarkdownOutline(t *testing.T) {
+	plan := parseMarkdownOutline(importOutline)
+	require.Empty(t, plan.Errors)
+	require.Len(t, plan.Epics, 1)
+
+	epic := plan.Epics[0]
+	assert.Equal(t, "Accounts", epic.Title)
+	assert.Equal(t, models.PriorityHigh, epic.Priority)
+	assert.Equal(t, "People need accounts to save their work.", epic.Description)
+	require.Len(t, epic.Children, 1)
+
+	story := epic.Children[0]
+	assert.Equal(t, "Sign up with email", story.Title)
+	assert.Equal(t, 6, story.Line)
+	assert.Equal(t, "Visitors create an account with an email address.", story.Description)
+	require.Len(t, story.Children, 4)
+
+	assert.Equal(t, models.EntityTypeAcceptanceCriteria, story.Children[0].Type)
+	assert.Equal(t, "Accounts should be created quickly even on slow networks", story.Children[1].Description)
+
+	requirement := story.Children[2]
+	assert.Equal(t, models.EntityTypeRequirement, requirement.Type)
+	assert.Equal(t, "Password rules", requirement.Title)
+	assert.Equal(t, "functional", requirement.RequirementType)
+	assert.Equal(t, models.PriorityHigh, requirement.Priority)
+	assert.Equal(t, "At least 12 characters | no reuse", requirement.Description)
+	assert.Equal(t, models.PriorityLow, story.Children[3].Priority)
+}
+
+func TestParseMarkdownOutline_StructureErrors(t *testing.T) {
+	plan := parseMarkdownOutline("Intro text\n\n## Orphan story\n\n| Title |\n|---|\n| Orphan requirement |\n")
+	require.Len(t, plan.Errors, 2)
+	assert.Equal(t, 1, plan.Errors[0].Line)
+	assert.Equal(t, 3, plan.Errors[1].Line)
+	assert.Empty(t, plan.Epics)
+
+	plan = parseMarkdownOutline("# Epic\n\n| Title |\n|---|\n| Orphan requirement |\n")
+	require.Len(t, plan.Errors, 1)
+	assert.Equal(t, 5, plan.Errors[0].Line)
+}
+
+func TestMarkdownImportService(t *testing.T) {
+	ctx := context.Background()
+	useSequentialReferenceIDs(t)
+	db, user, _, _ := setupSupersessionTest(t)
+	require.NoError(t, db.AutoMigrate(&models.RequirementType{}))
+	for _, requirementType := range models.GetDefaultRequirementTypes() {
+		require.NoError(t, db.Create(&requirementType).Error)
+	}
+	repos := repository.NewRepositories(db, nil)
+	svc := NewMarkdownImportService(repos, validation.NewEARSLinter())
+
+	t.Run("preview validates nodes without creating anything", func(t *testing.T) {
+		plan, err := svc.Preview(ctx, importOutline)
+		require.NoError(t, err)
+		assert.True(t, plan.Valid)
+		assert.Equal(t, MarkdownImportSummary{Epics: 1, UserStories: 1, AcceptanceCriteria: 2, Requirements: 2}, plan.Summary)
+
+		story := plan.Epics[0].Children[0]
+		assert.Empty(t, story.Children[0].Warnings)
+		assert.NotEmpty(t, story.Children[1].Warnings)
+		assert.Empty(t, story.Children[1].Errors)
+		assert.Equal(t, "Functional", story.Children[2].RequirementType)
+		assert.Equal(t, "Functional", story.Children[3].RequirementType)
+		assert.Nil(t, story.ID)
+
+		count, err := repos.Epic.Count(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("rejects unknown types and priorities", func(t *testing.T) {
+		plan, err := svc.Import(ctx, "# Billing\n\n## Invoices\n\n| Title | Type | Priority |\n|---|---|---|\n| PDF invoices | Security | Bogus |\n| | Data | 1 |\n", user.ID)
+		assert.ErrorIs(t, err, ErrImportInvalid)
+		require.NotNil(t, plan)
+		rows := plan.Epics[0].Children[0].Children
+		assert.Equal(t, []string{`Unknown priority "Bogus"`, `Unknown requirement type "Security"`}, rows[0].Errors)
+		assert.Equal(t, []string{"Title is required"}, rows[1].Errors)
+
+		count, err := repos.Epic.Count(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("imports the hierarchy", func(t *testing.T) {
+		plan, err := svc.Import(ctx, importOutline, user.ID)
+		require.NoError(t, err)
+		require.True(t, plan.Valid)
+
+		epicNode := plan.Epics[0]
+		require.NotNil(t, epicNode.ID)
+		epic, err := repos.Epic.GetByID(ctx, *epicNode.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Accounts", epic.Title)
+		assert.Equal(t, models.PriorityHigh, epic.Priority)
+
+		storyNode := epicNode.Children[0]
+		acceptanceCriteria, err := repos.AcceptanceCriteria.GetByUserStory(ctx, *storyNode.ID)
+		require.NoError(t, err)
+		assert.Len(t, acceptanceCriteria, 2)
+		requirements, err := repos.Requirement.GetByUserStory(ctx, *storyNode.ID)
+		require.NoError(t, err)
+		require.Len(t, requirements, 2)
+		assert.Equal(t, user.ID, requirements[0].CreatorID)
+		assert.NotEmpty(t, storyNode.Children[2].ReferenceID)
+	})
+
+	t.Run("rejects empty content", func(t *testing.T) {
+		_, err := svc.Preview(ctx, "  \n")
+		assert.ErrorIs(t, err, ErrImportContentRequired)
+	})
+}
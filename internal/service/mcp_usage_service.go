@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// MCP usage query defaults and limits
+const (
+	DefaultMCPUsageDays = 7
+	MaxMCPUsageDays     = 365
+	DefaultMCPUsageTop  = 10
+	MaxMCPUsageTop      = 100
+)
+
+// maxToolCallErrorLength is the number of characters of a tool call error kept in the audit log
+const maxToolCallErrorLength = 1000
+
+// MCPUsageService defines the interface for auditing MCP tool calls and reporting on them
+type MCPUsageService interface {
+	RecordToolCall(ctx context.Context, call ToolCallRecord) error
+	GetUsage(ctx context.Context, query MCPUsageQuery) (*MCPUsage, error)
+}
+
+// ToolCallRecord describes a handled MCP tool call
+type ToolCallRecord struct {
+	ToolName  string
+	Arguments map[string]interface{}
+	UserID    *uuid.UUID
+	Duration  time.Duration
+	Err       error // Error returned to the agent; nil for successful calls
+}
+
+// MCPUsageQuery selects the time window and list sizes of the MCP usage report
+type MCPUsageQuery struct {
+	Days int // Days covered by the report
+	Top  int // Number of most active users and recent failures
+}
+
+// MCPUsage is an aggregate report of MCP tool calls
+// @Description Tool call counts, failures and latencies per tool, the most active users and the latest failed calls
+type MCPUsage struct {
+	Since          time.Time                 `json:"since" example:"2023-01-01T10:00:00Z"`
+	TotalCalls     int64                     `json:"total_calls" example:"250"`
+	FailedCalls    int64                     `json:"failed_calls" example:"4"`
+	Tools          []repository.MCPToolUsage `json:"tools"`
+	Users          []repository.MCPUserUsage `json:"users"`
+	RecentFailures []models.MCPToolCall      `json:"recent_failures"`
+}
+
+// mcpUsageService implements MCPUsageService interface
+type mcpUsageService struct {
+	auditRepo repository.AuditRepository
+	now       func() time.Time
+}
+
+// NewMCPUsageService creates a new MCP usage service instance
+func NewMCPUsageService(repos *repository.Repositories) MCPUsageService {
+	return &mcpUsageService{
+		auditRepo: repos.Audit,
+		now:       time.Now,
+	}
+}
+
+// RecordToolCall stores a tool call in the audit log with a digest of its arguments instead of their values
+func (s *mcpUsageService) RecordToolCall(ctx context.Context, call ToolCallRecord) error {
+	digest, err := toolCallArgsDigest(call.Arguments)
+	if err != nil {
+		return err
+	}
+
+	record := &models.MCPToolCall{
+		ToolName:   call.ToolName,
+		ArgsDigest: digest,
+		UserID:     call.UserID,
+		DurationMs: call.Duration.Milliseconds(),
+		Success:    call.Err == nil,
+		CreatedAt:  s.now().UTC(),
+	}
+	if call.Err != nil {
+		record.ErrorMessage = truncateToolCallError(call.Err.Error())
+	}
+
+	if err := s.auditRepo.CreateToolCall(ctx, record); err != nil {
+		return fmt.Errorf("failed to record tool call: %w", err)
+	}
+	return nil
+}
+
+// GetUsage aggregates the tool calls made in the last query.Days days
+func (s *mcpUsageService) GetUsage(ctx context.Context, query MCPUsageQuery) (*MCPUsage, error) {
+	days := clampStatisticsParam(query.Days, DefaultMCPUsageDays, MaxMCPUsageDays)
+	top := clampStatisticsParam(query.Top, DefaultMCPUsageTop, MaxMCPUsageTop)
+	since := s.now().UTC().AddDate(0, 0, -days)
+
+	tools, err := s.auditRepo.SummarizeToolCalls(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize tool calls: %w", err)
+	}
+	users, err := s.auditRepo.SummarizeToolCallsByUser(ctx, since, top)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize tool calls by user: %w", err)
+	}
+	failures, err := s.auditRepo.ListFailedToolCalls(ctx, since, top)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed tool calls: %w", err)
+	}
+
+	usage := &MCPUsage{
+		Since:          since,
+		Tools:          tools,
+		Users:          users,
+		RecentFailures: failures,
+	}
+	for _, tool := range tools {
+		usage.TotalCalls += tool.Calls
+		usage.FailedCalls += tool.Failures
+	}
+	if usage.Tools == nil {
+		usage.Tools = []repository.MCPToolUsage{}
+	}
+	if usage.Users == nil {
+		usage.Users = []repository.MCPUserUsage{}
+	}
+	if usage.RecentFailures == nil {
+		usage.RecentFailures = []models.MCPToolCall{}
+	}
+	return usage, nil
+}
+
+// toolCallArgsDigest is the SHA-256 of the arguments as JSON with sorted keys, so repeated calls share a digest
+func toolCallArgsDigest(arguments map[string]interface{}) (string, error) {
+	if arguments == nil {
+		arguments = map[string]interface{}{}
+	}
+	data, err := json.Marshal(arguments)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode tool call arguments: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// truncateToolCallError limits an error message to maxToolCallErrorLength characters
+func truncateToolCallError(message string) string {
+	if utf8.RuneCountInString(message) <= maxToolCallErrorLength {
+		return message
+	}
+	return string([]rune(message)[:maxToolCallErrorLength]) + "…"
+}
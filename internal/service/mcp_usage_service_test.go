@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestMCPUsageService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.MCPToolCall{}))
+
+	alice := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	bob := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(alice).Error)
+	require.NoError(t, db.Create(bob).Error)
+
+	now := time.Date(2024, time.March, 13, 15, 0, 0, 0, time.UTC)
+	svc := &mcpUsageService{auditRepo: repository.NewAuditRepository(db), now: func() time.Time { return now }}
+
+	record := func(at time.Time, call ToolCallRecord) {
+		svc.now = func() time.Time { return at }
+		require.NoError(t, svc.RecordToolCall(ctx, call))
+	}
+	args := map[string]interface{}{"epic": "EP-001", "format": "compact"}
+	record(now.Add(-time.Hour), ToolCallRecord{ToolName: "epic_hierarchy", Arguments: args, UserID: &alice.ID, Duration: 20 * time.Millisecond})
+	record(now.Add(-30*time.Minute), ToolCallRecord{ToolName: "epic_hierarchy", Arguments: map[string]interface{}{"format": "compact", "epic": "EP-001"}, UserID: &alice.ID, Duration: 40 * time.Millisecond})
+	record(now.Add(-20*time.Minute), ToolCallRecord{ToolName: "create_requirement", UserID: &bob.ID, Duration: 5 * time.Millisecond, Err: errors.New("Invalid params")})
+	record(now.Add(-10*time.Minute), ToolCallRecord{ToolName: "epic_hierarchy", UserID: &alice.ID, Duration: 30 * time.Millisecond, Err: errors.New("Epic not found")})
+	record(now.AddDate(0, 0, -10), ToolCallRecord{ToolName: "list_epics", UserID: &bob.ID, Duration: time.Millisecond})
+	svc.now = func() time.Time { return now }
+
+	var calls []models.MCPToolCall
+	require.NoError(t, db.Order("created_at").Find(&calls).Error)
+	require.Len(t, calls, 5)
+	assert.Len(t, calls[0].ArgsDigest, 64)
+	assert.Equal(t, calls[1].ArgsDigest, calls[2].ArgsDigest, "key order must not change the digest")
+	assert.Equal(t, calls[3].ArgsDigest, calls[4].ArgsDigest, "missing arguments digest as an empty object")
+	assert.NotEqual(t, calls[1].ArgsDigest, calls[3].ArgsDigest)
+
+	usage, err := svc.GetUsage(ctx, MCPUsageQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, now.AddDate(0, 0, -DefaultMCPUsageDays), usage.Since)
+	assert.Equal(t, int64(4), usage.TotalCalls)
+	assert.Equal(t, int64(2), usage.FailedCalls)
+
+	require.Len(t, usage.Tools, 2)
+	assert.Equal(t, "epic_hierarchy", usage.Tools[0].ToolName)
+	assert.Equal(t, int64(3), usage.Tools[0].Calls)
+	assert.Equal(t, int64(1), usage.Tools[0].Failures)
+	assert.InDelta(t, 30, usage.Tools[0].AvgDurationMs, 0.001)
+	assert.Equal(t, int64(40), usage.Tools[0].MaxDurationMs)
+	assert.Equal(t, "create_requirement", usage.Tools[1].ToolName)
+
+	require.Len(t, usage.Users, 2)
+	assert.Equal(t, "alice", usage.Users[0].Username)
+	assert.Equal(t, int64(3), usage.Users[0].Calls)
+	assert.Equal(t, int64(1), usage.Users[1].Calls)
+
+	require.Len(t, usage.RecentFailures, 2)
+	assert.Equal(t, "Epic not found", usage.RecentFailures[0].ErrorMessage)
+	assert.Equal(t, "Invalid params", usage.RecentFailures[1].ErrorMessage)
+	require.NotNil(t, usage.RecentFailures[1].User)
+	assert.Equal(t, "bob", usage.RecentFailures[1].User.Username)
+
+	usage, err = svc.GetUsage(ctx, MCPUsageQuery{Days: 30, Top: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), usage.TotalCalls)
+	assert.Len(t, usage.Users, 1)
+	assert.Len(t, usage.RecentFailures, 1)
+}
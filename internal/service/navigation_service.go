@@ -1,30 +1,40 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 )
 
 var (
-	ErrInvalidNavigationEntityType = errors.New("invalid entity type")
+	ErrInvalidNavigationEntityType = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid entity type")
 )
 
 // NavigationService defines the interface for hierarchical navigation business logic
 type NavigationService interface {
-	GetHierarchy(filters HierarchyFilters) (*HierarchyResponse, error)
-	GetEpicHierarchy(epicID uuid.UUID, expand, orderBy, orderDirection string) (*EpicHierarchy, error)
-	GetUserStoryHierarchy(userStoryID uuid.UUID, expand, orderBy, orderDirection string) (*UserStoryHierarchy, error)
-	GetEntityPath(entityType string, entityID uuid.UUID) ([]PathElement, error)
-	GetEpicByReferenceID(referenceID string) (*models.Epic, error)
-	GetUserStoryByReferenceID(referenceID string) (*models.UserStory, error)
-	ResolveReferenceID(entityType, referenceID string) (uuid.UUID, error)
+	GetHierarchy(ctx context.Context, filters HierarchyFilters) (*HierarchyResponse, error)
+	GetEpicHierarchy(ctx context.Context, epicID uuid.UUID, expand, orderBy, orderDirection string) (*EpicHierarchy, error)
+	GetUserStoryHierarchy(ctx context.Context, userStoryID uuid.UUID, expand, orderBy, orderDirection string) (*UserStoryHierarchy, error)
+	GetEntityPath(ctx context.Context, entityType string, entityID uuid.UUID) ([]PathElement, error)
+	GetEpicByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error)
+	GetUserStoryByReferenceID(ctx context.Context, referenceID string) (*models.UserStory, error)
+	ResolveReferenceID(ctx context.Context, entityType, referenceID string) (uuid.UUID, error)
+	ListEpicNodes(ctx context.Context, filters HierarchyFilters) ([]HierarchyNode, int64, error)
+	ListChildNodes(ctx context.Context, entityType string, entityID uuid.UUID) ([]HierarchyNode, error)
+	GetLastModified(ctx context.Context, entityType string, entityID uuid.UUID) (time.Time, error)
+	GetOutline(ctx context.Context, entityType string, entityID uuid.UUID) (*HierarchyOutline, error)
+	GetProgress(ctx context.Context, entityType string, entityID uuid.UUID) (*HierarchyProgress, error)
 }
 
 // HierarchyFilters represents filters for hierarchy queries
@@ -50,12 +60,14 @@ type HierarchyResponse struct {
 // EpicHierarchy represents an epic with its hierarchical children
 type EpicHierarchy struct {
 	models.Epic
-	UserStories []UserStoryHierarchy `json:"user_stories"`
+	DisplayNumber string               `json:"display_number,omitempty"` // Hierarchical number such as 1, set when expand includes display_number
+	UserStories   []UserStoryHierarchy `json:"user_stories"`
 }
 
 // UserStoryHierarchy represents a user story with its hierarchical children
 type UserStoryHierarchy struct {
 	models.UserStory
+	DisplayNumber      string                      `json:"display_number,omitempty"` // Hierarchical number such as 1.2, set when expand includes display_number
 	AcceptanceCriteria []models.AcceptanceCriteria `json:"acceptance_criteria"`
 	Requirements       []RequirementHierarchy      `json:"requirements"`
 }
@@ -63,9 +75,62 @@ type UserStoryHierarchy struct {
 // RequirementHierarchy represents a requirement with its relationships
 type RequirementHierarchy struct {
 	models.Requirement
+	DisplayNumber string                           `json:"display_number,omitempty"` // Hierarchical number such as 1.2.3, set when expand includes display_number
 	Relationships []models.RequirementRelationship `json:"relationships"`
 }
 
+// MarshalJSON implements custom JSON marshaling for EpicHierarchy.
+// The embedded entity's MarshalJSON would otherwise hide the hierarchy fields.
+func (eh EpicHierarchy) MarshalJSON() ([]byte, error) {
+	return marshalWithHierarchyFields(&eh.Epic, eh.DisplayNumber, map[string]interface{}{
+		"user_stories": eh.UserStories,
+	})
+}
+
+// MarshalJSON implements custom JSON marshaling for UserStoryHierarchy
+func (ush UserStoryHierarchy) MarshalJSON() ([]byte, error) {
+	return marshalWithHierarchyFields(&ush.UserStory, ush.DisplayNumber, map[string]interface{}{
+		"acceptance_criteria": ush.AcceptanceCriteria,
+		"requirements":        ush.Requirements,
+	})
+}
+
+// MarshalJSON implements custom JSON marshaling for RequirementHierarchy
+func (rh RequirementHierarchy) MarshalJSON() ([]byte, error) {
+	return marshalWithHierarchyFields(&rh.Requirement, rh.DisplayNumber, map[string]interface{}{
+		"relationships": rh.Relationships,
+	})
+}
+
+// marshalWithHierarchyFields marshals an entity and adds its display number and children
+func marshalWithHierarchyFields(entity json.Marshaler, displayNumber string, fields map[string]interface{}) ([]byte, error) {
+	if displayNumber != "" {
+		fields["display_number"] = displayNumber
+	}
+	return marshalWithFields(entity, fields)
+}
+
+// marshalWithFields marshals an entity with its own MarshalJSON and adds extra top-level fields
+func marshalWithFields(entity json.Marshaler, fields map[string]interface{}) ([]byte, error) {
+	data, err := entity.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	for key, value := range fields {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		merged[key] = raw
+	}
+	return json.Marshal(merged)
+}
+
 // UnmarshalJSON implements custom JSON unmarshaling for EpicHierarchy
 func (eh *EpicHierarchy) UnmarshalJSON(data []byte) error {
 	type Alias EpicHierarchy
@@ -140,6 +205,45 @@ type PathElement struct {
 	Title       string    `json:"title"`
 }
 
+// HierarchyNode is an entity of a lazily loaded hierarchy tree. Its children are loaded
+// separately; only their number is included.
+type HierarchyNode struct {
+	ID            uuid.UUID `json:"id"`
+	ReferenceID   string    `json:"reference_id"`
+	Type          string    `json:"type"`
+	Title         string    `json:"title"`
+	Status        string    `json:"status,omitempty"`
+	ChildrenCount int64     `json:"children_count"`
+	HasChildren   bool      `json:"has_children"`
+}
+
+// HierarchyOutline is an entity with its whole subtree, read from the hierarchy cache.
+// Requirements linked to acceptance criteria are children of the acceptance criteria, not the user story.
+type HierarchyOutline struct {
+	ID          uuid.UUID          `json:"id"`
+	ReferenceID string             `json:"reference_id"`
+	Type        string             `json:"type"`
+	Title       string             `json:"title"`
+	Status      string             `json:"status,omitempty"`
+	Children    []HierarchyOutline `json:"children"`
+}
+
+// HierarchyProgress counts the descendants of an entity by type and status, read from the hierarchy cache
+type HierarchyProgress struct {
+	ID          uuid.UUID                         `json:"id"`
+	ReferenceID string                            `json:"reference_id"`
+	Type        string                            `json:"type"`
+	Descendants map[string]HierarchyProgressCount `json:"descendants"` // Keyed by entity type
+	// CompletedPercent is the share of done user stories among those not cancelled, for epics with any
+	CompletedPercent *float64 `json:"completed_percent,omitempty"`
+}
+
+// HierarchyProgressCount counts the descendants of one entity type
+type HierarchyProgressCount struct {
+	Total    int            `json:"total"`
+	ByStatus map[string]int `json:"by_status,omitempty"`
+}
+
 // navigationService implements NavigationService
 type navigationService struct {
 	epicRepo               repository.EpicRepository
@@ -148,6 +252,8 @@ type navigationService struct {
 	requirementRepo        repository.RequirementRepository
 	relationshipRepo       repository.RequirementRelationshipRepository
 	userRepo               repository.UserRepository
+	hierarchyRepo          repository.HierarchyRepository
+	numbering              NumberingService
 }
 
 // NewNavigationService creates a new navigation service instance
@@ -158,6 +264,7 @@ func NewNavigationService(
 	requirementRepo repository.RequirementRepository,
 	relationshipRepo repository.RequirementRelationshipRepository,
 	userRepo repository.UserRepository,
+	hierarchyRepo repository.HierarchyRepository,
 ) NavigationService {
 	return &navigationService{
 		epicRepo:               epicRepo,
@@ -166,11 +273,13 @@ func NewNavigationService(
 		requirementRepo:        requirementRepo,
 		relationshipRepo:       relationshipRepo,
 		userRepo:               userRepo,
+		hierarchyRepo:          hierarchyRepo,
+		numbering:              NewNumberingService(epicRepo, userStoryRepo, requirementRepo),
 	}
 }
 
 // GetHierarchy returns the complete hierarchy with filtering and sorting
-func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyResponse, error) {
+func (s *navigationService) GetHierarchy(ctx context.Context, filters HierarchyFilters) (*HierarchyResponse, error) {
 	// Convert filters to epic filters
 	epicFilters := EpicFilters{
 		CreatorID:  filters.CreatorID,
@@ -216,7 +325,7 @@ func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyRe
 	}
 
 	// Get epics with the filters
-	epics, err := s.epicRepo.List(filterMap, orderBy, limit, epicFilters.Offset)
+	epics, err := s.epicRepo.List(ctx, filterMap, orderBy, limit, epicFilters.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list epics: %w", err)
 	}
@@ -232,7 +341,7 @@ func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyRe
 
 		// Check if we should expand user stories
 		if shouldExpand(filters.Expand, "user_stories") {
-			userStories, err := s.getUserStoriesForEpic(epic.ID, filters.OrderBy, filters.OrderDirection)
+			userStories, err := s.getUserStoriesForEpic(ctx, epic.ID, filters.OrderBy, filters.OrderDirection)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get user stories for epic %s: %w", epic.ID, err)
 			}
@@ -247,7 +356,7 @@ func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyRe
 
 				// Check if we should expand requirements and acceptance criteria
 				if shouldExpand(filters.Expand, "requirements") {
-					requirements, err := s.getRequirementsForUserStory(userStory.ID, filters.OrderBy, filters.OrderDirection)
+					requirements, err := s.getRequirementsForUserStory(ctx, userStory.ID, filters.OrderBy, filters.OrderDirection)
 					if err != nil {
 						return nil, fmt.Errorf("failed to get requirements for user story %s: %w", userStory.ID, err)
 					}
@@ -260,7 +369,7 @@ func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyRe
 						}
 
 						if shouldExpand(filters.Expand, "relationships") {
-							relationships, err := s.relationshipRepo.GetByRequirement(requirement.ID)
+							relationships, err := s.relationshipRepo.GetByRequirement(ctx, requirement.ID)
 							if err != nil {
 								return nil, fmt.Errorf("failed to get relationships for requirement %s: %w", requirement.ID, err)
 							}
@@ -272,7 +381,7 @@ func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyRe
 				}
 
 				if shouldExpand(filters.Expand, "acceptance_criteria") {
-					acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(userStory.ID)
+					acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(ctx, userStory.ID)
 					if err != nil {
 						return nil, fmt.Errorf("failed to get acceptance criteria for user story %s: %w", userStory.ID, err)
 					}
@@ -286,6 +395,16 @@ func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyRe
 		hierarchyEpics = append(hierarchyEpics, epicHierarchy)
 	}
 
+	if shouldExpand(filters.Expand, "display_number") {
+		numbers, err := s.numbering.GetDisplayNumbers(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to number hierarchy: %w", err)
+		}
+		for i := range hierarchyEpics {
+			hierarchyEpics[i].applyDisplayNumbers(numbers)
+		}
+	}
+
 	return &HierarchyResponse{
 		Epics: hierarchyEpics,
 		Total: len(hierarchyEpics), // In a real implementation, this would be the total count without pagination
@@ -294,8 +413,8 @@ func (s *navigationService) GetHierarchy(filters HierarchyFilters) (*HierarchyRe
 }
 
 // GetEpicHierarchy returns a single epic with its complete hierarchy
-func (s *navigationService) GetEpicHierarchy(epicID uuid.UUID, expand, orderBy, orderDirection string) (*EpicHierarchy, error) {
-	epic, err := s.epicRepo.GetByIDWithUsers(epicID)
+func (s *navigationService) GetEpicHierarchy(ctx context.Context, epicID uuid.UUID, expand, orderBy, orderDirection string) (*EpicHierarchy, error) {
+	epic, err := s.epicRepo.GetByIDWithUsers(ctx, epicID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -309,7 +428,7 @@ func (s *navigationService) GetEpicHierarchy(epicID uuid.UUID, expand, orderBy,
 	}
 
 	// Always expand user stories for single epic view
-	userStories, err := s.getUserStoriesForEpic(epicID, orderBy, orderDirection)
+	userStories, err := s.getUserStoriesForEpic(ctx, epicID, orderBy, orderDirection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user stories: %w", err)
 	}
@@ -323,7 +442,7 @@ func (s *navigationService) GetEpicHierarchy(epicID uuid.UUID, expand, orderBy,
 
 		// Expand requirements if requested
 		if shouldExpand(expand, "requirements") {
-			requirements, err := s.getRequirementsForUserStory(userStory.ID, orderBy, orderDirection)
+			requirements, err := s.getRequirementsForUserStory(ctx, userStory.ID, orderBy, orderDirection)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get requirements: %w", err)
 			}
@@ -335,7 +454,7 @@ func (s *navigationService) GetEpicHierarchy(epicID uuid.UUID, expand, orderBy,
 				}
 
 				if shouldExpand(expand, "relationships") {
-					relationships, err := s.relationshipRepo.GetByRequirement(requirement.ID)
+					relationships, err := s.relationshipRepo.GetByRequirement(ctx, requirement.ID)
 					if err != nil {
 						return nil, fmt.Errorf("failed to get relationships: %w", err)
 					}
@@ -348,7 +467,7 @@ func (s *navigationService) GetEpicHierarchy(epicID uuid.UUID, expand, orderBy,
 
 		// Expand acceptance criteria if requested
 		if shouldExpand(expand, "acceptance_criteria") {
-			acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(userStory.ID)
+			acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(ctx, userStory.ID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get acceptance criteria: %w", err)
 			}
@@ -358,12 +477,20 @@ func (s *navigationService) GetEpicHierarchy(epicID uuid.UUID, expand, orderBy,
 		epicHierarchy.UserStories = append(epicHierarchy.UserStories, userStoryHierarchy)
 	}
 
+	if shouldExpand(expand, "display_number") {
+		numbers, err := s.numbering.GetEpicDisplayNumbers(ctx, epicID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to number epic: %w", err)
+		}
+		epicHierarchy.applyDisplayNumbers(numbers)
+	}
+
 	return epicHierarchy, nil
 }
 
 // GetUserStoryHierarchy returns a single user story with its complete hierarchy
-func (s *navigationService) GetUserStoryHierarchy(userStoryID uuid.UUID, expand, orderBy, orderDirection string) (*UserStoryHierarchy, error) {
-	userStory, err := s.userStoryRepo.GetByIDWithUsers(userStoryID)
+func (s *navigationService) GetUserStoryHierarchy(ctx context.Context, userStoryID uuid.UUID, expand, orderBy, orderDirection string) (*UserStoryHierarchy, error) {
+	userStory, err := s.userStoryRepo.GetByIDWithUsers(ctx, userStoryID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -379,7 +506,7 @@ func (s *navigationService) GetUserStoryHierarchy(userStoryID uuid.UUID, expand,
 
 	// Expand requirements if requested
 	if shouldExpand(expand, "requirements") {
-		requirements, err := s.getRequirementsForUserStory(userStoryID, orderBy, orderDirection)
+		requirements, err := s.getRequirementsForUserStory(ctx, userStoryID, orderBy, orderDirection)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get requirements: %w", err)
 		}
@@ -391,7 +518,7 @@ func (s *navigationService) GetUserStoryHierarchy(userStoryID uuid.UUID, expand,
 			}
 
 			if shouldExpand(expand, "relationships") {
-				relationships, err := s.relationshipRepo.GetByRequirement(requirement.ID)
+				relationships, err := s.relationshipRepo.GetByRequirement(ctx, requirement.ID)
 				if err != nil {
 					return nil, fmt.Errorf("failed to get relationships: %w", err)
 				}
@@ -404,28 +531,145 @@ func (s *navigationService) GetUserStoryHierarchy(userStoryID uuid.UUID, expand,
 
 	// Expand acceptance criteria if requested
 	if shouldExpand(expand, "acceptance_criteria") {
-		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(userStoryID)
+		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByUserStory(ctx, userStoryID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get acceptance criteria: %w", err)
 		}
 		userStoryHierarchy.AcceptanceCriteria = acceptanceCriteria
 	}
 
+	if shouldExpand(expand, "display_number") {
+		numbers, err := s.numbering.GetEpicDisplayNumbers(ctx, userStory.EpicID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to number user story: %w", err)
+		}
+		userStoryHierarchy.applyDisplayNumbers(numbers)
+	}
+
 	return userStoryHierarchy, nil
 }
 
-// GetEntityPath returns the hierarchical path to an entity
-func (s *navigationService) GetEntityPath(entityType string, entityID uuid.UUID) ([]PathElement, error) {
+// GetEntityPath returns the ancestor chain of an entity, top-level epic first and the entity itself last.
+// Epics nested under parent epics follow their parents. A requirement linked to acceptance criteria has the
+// acceptance criteria between its user story and itself.
+func (s *navigationService) GetEntityPath(ctx context.Context, entityType string, entityID uuid.UUID) ([]PathElement, error) {
+	if !isHierarchyEntityType(entityType) {
+		return nil, ErrInvalidNavigationEntityType
+	}
+	path, ok, err := s.cachedEntityPath(ctx, models.EntityType(entityType), entityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if path, err = s.loadEntityPath(ctx, entityType, entityID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Parent epics are not cached; the path starts at the epic of the entity
+	ancestors, err := s.hierarchyRepo.ListEpicAncestors(ctx, path[0].ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parent epics: %w", err)
+	}
+	epicPath := make([]PathElement, 0, len(ancestors)+len(path))
+	for _, epic := range ancestors {
+		epicPath = append(epicPath, PathElement{
+			ID:          epic.ID,
+			ReferenceID: epic.ReferenceID,
+			Type:        "epic",
+			Title:       epic.Title,
+		})
+	}
+	return append(epicPath, path...), nil
+}
+
+// cachedEntityPath reads the path to an entity from the hierarchy cache. It reports false when
+// the entity or one of its ancestors is not cached, leaving the path to be loaded from the entities.
+func (s *navigationService) cachedEntityPath(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) ([]PathElement, bool, error) {
+	entry, err := s.hierarchyRepo.GetEntry(ctx, entityType, entityID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get hierarchy entry: %w", err)
+	}
+
+	// Ancestors from the root down; a requirement is under its acceptance criteria when linked to one
+	var ancestors []hierarchyEntryKey
+	var ids []uuid.UUID
+	addAncestor := func(ancestorType models.EntityType, ancestorID uuid.UUID) {
+		ancestors = append(ancestors, hierarchyEntryKey{ancestorType, ancestorID.String()})
+		ids = append(ids, ancestorID)
+	}
+	if entityType != models.EntityTypeEpic {
+		addAncestor(models.EntityTypeEpic, entry.EpicID)
+	}
+	if entry.UserStoryID != nil && entityType != models.EntityTypeUserStory {
+		addAncestor(models.EntityTypeUserStory, *entry.UserStoryID)
+	}
+	if entry.AcceptanceCriteriaID != nil && entityType == models.EntityTypeRequirement {
+		addAncestor(models.EntityTypeAcceptanceCriteria, *entry.AcceptanceCriteriaID)
+	}
+
+	entries, err := s.hierarchyRepo.ListEntriesByIDs(ctx, ids)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list hierarchy entries: %w", err)
+	}
+	byKey := make(map[hierarchyEntryKey]models.HierarchyEntry, len(entries))
+	for _, ancestor := range entries {
+		byKey[hierarchyEntryKey{ancestor.EntityType, ancestor.EntityID.String()}] = ancestor
+	}
+
+	path := make([]PathElement, 0, len(ancestors)+1)
+	for _, key := range ancestors {
+		ancestor, ok := byKey[key]
+		if !ok {
+			return nil, false, nil
+		}
+		path = append(path, hierarchyEntryPathElement(&ancestor))
+	}
+	return append(path, hierarchyEntryPathElement(entry)), true, nil
+}
+
+// hierarchyEntryPathElement builds the path element of a hierarchy cache row
+func hierarchyEntryPathElement(entry *models.HierarchyEntry) PathElement {
+	return PathElement{
+		ID:          entry.EntityID,
+		ReferenceID: entry.ReferenceID,
+		Type:        string(entry.EntityType),
+		Title:       hierarchyEntryTitle(entry),
+	}
+}
+
+// hierarchyEntryTitle returns the title of a hierarchy cache row, shortening the description cached for acceptance criteria
+func hierarchyEntryTitle(entry *models.HierarchyEntry) string {
+	if entry.EntityType == models.EntityTypeAcceptanceCriteria {
+		text := entityText{Description: entry.Title}
+		return text.displayTitle()
+	}
+	return entry.Title
+}
+
+// isHierarchyEntityType reports whether entities of the type are part of the epic hierarchy
+func isHierarchyEntityType(entityType string) bool {
+	switch models.EntityType(entityType) {
+	case models.EntityTypeEpic, models.EntityTypeUserStory, models.EntityTypeAcceptanceCriteria, models.EntityTypeRequirement:
+		return true
+	}
+	return false
+}
+
+// loadEntityPath loads the path to an entity from the entities themselves
+func (s *navigationService) loadEntityPath(ctx context.Context, entityType string, entityID uuid.UUID) ([]PathElement, error) {
 	var path []PathElement
+	var userStoryID, epicID uuid.UUID
 
 	switch entityType {
 	case "requirement":
-		requirement, err := s.requirementRepo.GetByID(entityID)
+		requirement, err := s.requirementRepo.GetByID(ctx, entityID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get requirement: %w", err)
+			return nil, pathLookupError("requirement", err)
 		}
-
-		// Add requirement to path
 		path = append(path, PathElement{
 			ID:          requirement.ID,
 			ReferenceID: requirement.ReferenceID,
@@ -433,128 +677,85 @@ func (s *navigationService) GetEntityPath(entityType string, entityID uuid.UUID)
 			Title:       requirement.Title,
 		})
 
-		// Get user story
-		userStory, err := s.userStoryRepo.GetByID(requirement.UserStoryID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user story: %w", err)
-		}
-
-		// Add user story to path
-		path = append([]PathElement{{
-			ID:          userStory.ID,
-			ReferenceID: userStory.ReferenceID,
-			Type:        "user_story",
-			Title:       userStory.Title,
-		}}, path...)
-
-		// Get epic
-		epic, err := s.epicRepo.GetByID(userStory.EpicID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get epic: %w", err)
+		if requirement.AcceptanceCriteriaID != nil {
+			acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, *requirement.AcceptanceCriteriaID)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return nil, fmt.Errorf("failed to get acceptance criteria: %w", err)
+			}
+			if err == nil {
+				path = append([]PathElement{acceptanceCriteriaPathElement(acceptanceCriteria)}, path...)
+			}
 		}
-
-		// Add epic to path
-		path = append([]PathElement{{
-			ID:          epic.ID,
-			ReferenceID: epic.ReferenceID,
-			Type:        "epic",
-			Title:       epic.Title,
-		}}, path...)
+		userStoryID = requirement.UserStoryID
 
 	case "acceptance_criteria":
-		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(entityID)
+		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, entityID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get acceptance criteria: %w", err)
+			return nil, pathLookupError("acceptance criteria", err)
 		}
+		path = append(path, acceptanceCriteriaPathElement(acceptanceCriteria))
+		userStoryID = acceptanceCriteria.UserStoryID
 
-		// Add acceptance criteria to path
-		path = append(path, PathElement{
-			ID:          acceptanceCriteria.ID,
-			ReferenceID: acceptanceCriteria.ReferenceID,
-			Type:        "acceptance_criteria",
-			Title:       acceptanceCriteria.Description[:minInt(50, len(acceptanceCriteria.Description))] + "...",
-		})
+	case "user_story":
+		userStoryID = entityID
 
-		// Get user story
-		userStory, err := s.userStoryRepo.GetByID(acceptanceCriteria.UserStoryID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user story: %w", err)
-		}
+	case "epic":
+		epicID = entityID
 
-		// Add user story to path
-		path = append([]PathElement{{
-			ID:          userStory.ID,
-			ReferenceID: userStory.ReferenceID,
-			Type:        "user_story",
-			Title:       userStory.Title,
-		}}, path...)
+	default:
+		return nil, ErrInvalidNavigationEntityType
+	}
 
-		// Get epic
-		epic, err := s.epicRepo.GetByID(userStory.EpicID)
+	if userStoryID != uuid.Nil {
+		userStory, err := s.userStoryRepo.GetByID(ctx, userStoryID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get epic: %w", err)
+			return nil, pathLookupError("user story", err)
 		}
-
-		// Add epic to path
 		path = append([]PathElement{{
-			ID:          epic.ID,
-			ReferenceID: epic.ReferenceID,
-			Type:        "epic",
-			Title:       epic.Title,
-		}}, path...)
-
-	case "user_story":
-		userStory, err := s.userStoryRepo.GetByID(entityID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user story: %w", err)
-		}
-
-		// Add user story to path
-		path = append(path, PathElement{
 			ID:          userStory.ID,
 			ReferenceID: userStory.ReferenceID,
 			Type:        "user_story",
 			Title:       userStory.Title,
-		})
-
-		// Get epic
-		epic, err := s.epicRepo.GetByID(userStory.EpicID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get epic: %w", err)
-		}
-
-		// Add epic to path
-		path = append([]PathElement{{
-			ID:          epic.ID,
-			ReferenceID: epic.ReferenceID,
-			Type:        "epic",
-			Title:       epic.Title,
 		}}, path...)
+		epicID = userStory.EpicID
+	}
 
-	case "epic":
-		epic, err := s.epicRepo.GetByID(entityID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get epic: %w", err)
-		}
+	epic, err := s.epicRepo.GetByID(ctx, epicID)
+	if err != nil {
+		return nil, pathLookupError("epic", err)
+	}
+	path = append([]PathElement{{
+		ID:          epic.ID,
+		ReferenceID: epic.ReferenceID,
+		Type:        "epic",
+		Title:       epic.Title,
+	}}, path...)
 
-		// Add epic to path
-		path = append(path, PathElement{
-			ID:          epic.ID,
-			ReferenceID: epic.ReferenceID,
-			Type:        "epic",
-			Title:       epic.Title,
-		})
+	return path, nil
+}
 
-	default:
-		return nil, ErrInvalidNavigationEntityType
+// acceptanceCriteriaPathElement builds the path element of acceptance criteria, titled by its shortened description
+func acceptanceCriteriaPathElement(acceptanceCriteria *models.AcceptanceCriteria) PathElement {
+	text := entityText{Description: acceptanceCriteria.Description}
+	return PathElement{
+		ID:          acceptanceCriteria.ID,
+		ReferenceID: acceptanceCriteria.ReferenceID,
+		Type:        "acceptance_criteria",
+		Title:       text.displayTitle(),
 	}
+}
 
-	return path, nil
+// pathLookupError maps a repository error of an entity on a path, reporting missing entities as ErrNotFound
+func pathLookupError(entity string, err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+	return fmt.Errorf("failed to get %s: %w", entity, err)
 }
 
 // GetEpicByReferenceID gets an epic by its reference ID
-func (s *navigationService) GetEpicByReferenceID(referenceID string) (*models.Epic, error) {
-	epic, err := s.epicRepo.GetByReferenceID(referenceID)
+func (s *navigationService) GetEpicByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error) {
+	epic, err := s.epicRepo.GetByReferenceID(ctx, referenceID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -565,8 +766,8 @@ func (s *navigationService) GetEpicByReferenceID(referenceID string) (*models.Ep
 }
 
 // GetUserStoryByReferenceID gets a user story by its reference ID
-func (s *navigationService) GetUserStoryByReferenceID(referenceID string) (*models.UserStory, error) {
-	userStory, err := s.userStoryRepo.GetByReferenceID(referenceID)
+func (s *navigationService) GetUserStoryByReferenceID(ctx context.Context, referenceID string) (*models.UserStory, error) {
+	userStory, err := s.userStoryRepo.GetByReferenceID(ctx, referenceID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -577,28 +778,28 @@ func (s *navigationService) GetUserStoryByReferenceID(referenceID string) (*mode
 }
 
 // ResolveReferenceID resolves a reference ID to UUID based on entity type
-func (s *navigationService) ResolveReferenceID(entityType, referenceID string) (uuid.UUID, error) {
+func (s *navigationService) ResolveReferenceID(ctx context.Context, entityType, referenceID string) (uuid.UUID, error) {
 	switch entityType {
 	case "epic":
-		epic, err := s.epicRepo.GetByReferenceID(referenceID)
+		epic, err := s.epicRepo.GetByReferenceID(ctx, referenceID)
 		if err != nil {
 			return uuid.Nil, err
 		}
 		return epic.ID, nil
 	case "user_story":
-		userStory, err := s.userStoryRepo.GetByReferenceID(referenceID)
+		userStory, err := s.userStoryRepo.GetByReferenceID(ctx, referenceID)
 		if err != nil {
 			return uuid.Nil, err
 		}
 		return userStory.ID, nil
 	case "acceptance_criteria":
-		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByReferenceID(referenceID)
+		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByReferenceID(ctx, referenceID)
 		if err != nil {
 			return uuid.Nil, err
 		}
 		return acceptanceCriteria.ID, nil
 	case "requirement":
-		requirement, err := s.requirementRepo.GetByReferenceID(referenceID)
+		requirement, err := s.requirementRepo.GetByReferenceID(ctx, referenceID)
 		if err != nil {
 			return uuid.Nil, err
 		}
@@ -608,17 +809,346 @@ func (s *navigationService) ResolveReferenceID(entityType, referenceID string) (
 	}
 }
 
+// ListEpicNodes returns a page of epics as hierarchy tree nodes with their user story counts
+func (s *navigationService) ListEpicNodes(ctx context.Context, filters HierarchyFilters) ([]HierarchyNode, int64, error) {
+	filterMap := make(map[string]interface{})
+	if filters.CreatorID != nil {
+		filterMap["creator_id"] = *filters.CreatorID
+	}
+	if filters.AssigneeID != nil {
+		filterMap["assignee_id"] = *filters.AssigneeID
+	}
+	if filters.Status != nil {
+		filterMap["status"] = *filters.Status
+	}
+	if filters.Priority != nil {
+		filterMap["priority"] = *filters.Priority
+	}
+
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+
+	rows, total, err := s.hierarchyRepo.ListEpicNodes(ctx, filterMap, limit, filters.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list epics: %w", err)
+	}
+	return hierarchyNodes("epic", rows), total, nil
+}
+
+// ListChildNodes returns the direct children of an entity as hierarchy tree nodes: the epics nested under an epic
+// followed by its user stories, the acceptance criteria of a user story followed by its requirements not linked to acceptance criteria, and the
+// requirements linked to acceptance criteria. Requirements have no children.
+func (s *navigationService) ListChildNodes(ctx context.Context, entityType string, entityID uuid.UUID) ([]HierarchyNode, error) {
+	switch entityType {
+	case "epic":
+		if _, err := s.epicRepo.GetByID(ctx, entityID); err != nil {
+			return nil, pathLookupError("epic", err)
+		}
+		epics, err := s.hierarchyRepo.ListChildEpicNodes(ctx, entityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list child epics: %w", err)
+		}
+		userStories, err := s.hierarchyRepo.ListUserStoryNodes(ctx, entityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user stories: %w", err)
+		}
+		return append(hierarchyNodes("epic", epics), hierarchyNodes("user_story", userStories)...), nil
+
+	case "user_story":
+		if _, err := s.userStoryRepo.GetByID(ctx, entityID); err != nil {
+			return nil, pathLookupError("user story", err)
+		}
+		acceptanceCriteria, err := s.hierarchyRepo.ListAcceptanceCriteriaNodes(ctx, entityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+		}
+		for i := range acceptanceCriteria {
+			text := entityText{Description: acceptanceCriteria[i].Title}
+			acceptanceCriteria[i].Title = text.displayTitle()
+		}
+		requirements, err := s.hierarchyRepo.ListRequirementNodes(ctx, entityID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list requirements: %w", err)
+		}
+		return append(hierarchyNodes("acceptance_criteria", acceptanceCriteria), hierarchyNodes("requirement", requirements)...), nil
+
+	case "acceptance_criteria":
+		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return nil, pathLookupError("acceptance criteria", err)
+		}
+		rows, err := s.hierarchyRepo.ListRequirementNodes(ctx, acceptanceCriteria.UserStoryID, &acceptanceCriteria.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list requirements: %w", err)
+		}
+		return hierarchyNodes("requirement", rows), nil
+
+	case "requirement":
+		if _, err := s.requirementRepo.GetByID(ctx, entityID); err != nil {
+			return nil, pathLookupError("requirement", err)
+		}
+		return []HierarchyNode{}, nil
+
+	default:
+		return nil, ErrInvalidNavigationEntityType
+	}
+}
+
+// GetLastModified returns when an entity or any of its descendants in the hierarchy last changed,
+// including children that were moved away or deleted and epics nested under an epic
+func (s *navigationService) GetLastModified(ctx context.Context, entityType string, entityID uuid.UUID) (time.Time, error) {
+	var updatedAt time.Time
+	switch entityType {
+	case "epic":
+		epic, err := s.epicRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return time.Time{}, pathLookupError("epic", err)
+		}
+		updatedAt = epic.UpdatedAt
+	case "user_story":
+		userStory, err := s.userStoryRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return time.Time{}, pathLookupError("user story", err)
+		}
+		updatedAt = userStory.UpdatedAt
+	case "acceptance_criteria":
+		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return time.Time{}, pathLookupError("acceptance criteria", err)
+		}
+		updatedAt = acceptanceCriteria.UpdatedAt
+	case "requirement":
+		requirement, err := s.requirementRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return time.Time{}, pathLookupError("requirement", err)
+		}
+		return requirement.UpdatedAt, nil
+	default:
+		return time.Time{}, ErrInvalidNavigationEntityType
+	}
+
+	changedAt, err := s.hierarchyRepo.GetLastChange(ctx, models.EntityType(entityType), entityID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get last hierarchy change: %w", err)
+	}
+	if changedAt != nil && changedAt.After(updatedAt) {
+		return *changedAt, nil
+	}
+	return updatedAt, nil
+}
+
+// GetOutline returns an entity with its whole subtree from the hierarchy cache
+func (s *navigationService) GetOutline(ctx context.Context, entityType string, entityID uuid.UUID) (*HierarchyOutline, error) {
+	entries, root, err := s.subtreeEntries(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parent epics are not cached; they are needed to place the epics nested under the root
+	var epicIDs []uuid.UUID
+	for i := range entries {
+		if entries[i].EntityType == models.EntityTypeEpic && &entries[i] != root {
+			epicIDs = append(epicIDs, entries[i].EntityID)
+		}
+	}
+	parentEpics, err := s.hierarchyRepo.ListEpicParents(ctx, epicIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parent epics: %w", err)
+	}
+
+	// Entries are oldest first; like the lazily loaded tree, child epics come before user stories
+	// and acceptance criteria before requirements
+	children := make(map[hierarchyEntryKey][]*models.HierarchyEntry)
+	byKey := make(map[hierarchyEntryKey]bool, len(entries))
+	for i := range entries {
+		byKey[hierarchyEntryKey{entries[i].EntityType, entries[i].EntityID.String()}] = true
+	}
+	for i := range entries {
+		entry := &entries[i]
+		if entry == root {
+			continue
+		}
+		if parent, ok := outlineParent(entry, byKey, parentEpics); ok {
+			children[parent] = append(children[parent], entry)
+		}
+	}
+	for _, siblings := range children {
+		sort.SliceStable(siblings, func(i, j int) bool {
+			return outlineRank(siblings[i].EntityType) < outlineRank(siblings[j].EntityType)
+		})
+	}
+
+	var build func(entry *models.HierarchyEntry) HierarchyOutline
+	build = func(entry *models.HierarchyEntry) HierarchyOutline {
+		node := HierarchyOutline{
+			ID:          entry.EntityID,
+			ReferenceID: entry.ReferenceID,
+			Type:        string(entry.EntityType),
+			Title:       hierarchyEntryTitle(entry),
+			Status:      entry.Status,
+			Children:    make([]HierarchyOutline, 0),
+		}
+		for _, child := range children[hierarchyEntryKey{entry.EntityType, entry.EntityID.String()}] {
+			node.Children = append(node.Children, build(child))
+		}
+		return node
+	}
+	outline := build(root)
+	return &outline, nil
+}
+
+// outlineParent returns the parent of a hierarchy cache row within a subtree. Epics are placed under their
+// parent epic. Requirements are placed under their acceptance criteria when it is part of the subtree, and
+// under their user story otherwise.
+func outlineParent(entry *models.HierarchyEntry, subtree map[hierarchyEntryKey]bool, parentEpics map[uuid.UUID]uuid.UUID) (hierarchyEntryKey, bool) {
+	var candidates []hierarchyEntryKey
+	switch entry.EntityType {
+	case models.EntityTypeEpic:
+		if parentID, ok := parentEpics[entry.EntityID]; ok {
+			candidates = append(candidates, hierarchyEntryKey{models.EntityTypeEpic, parentID.String()})
+		}
+	case models.EntityTypeUserStory:
+		candidates = append(candidates, hierarchyEntryKey{models.EntityTypeEpic, entry.EpicID.String()})
+	case models.EntityTypeAcceptanceCriteria:
+		if entry.UserStoryID != nil {
+			candidates = append(candidates, hierarchyEntryKey{models.EntityTypeUserStory, entry.UserStoryID.String()})
+		}
+	case models.EntityTypeRequirement:
+		if entry.AcceptanceCriteriaID != nil {
+			candidates = append(candidates, hierarchyEntryKey{models.EntityTypeAcceptanceCriteria, entry.AcceptanceCriteriaID.String()})
+		}
+		if entry.UserStoryID != nil {
+			candidates = append(candidates, hierarchyEntryKey{models.EntityTypeUserStory, entry.UserStoryID.String()})
+		}
+	}
+	for _, candidate := range candidates {
+		if subtree[candidate] {
+			return candidate, true
+		}
+	}
+	return hierarchyEntryKey{}, false
+}
+
+// outlineRank orders the children of an outline node by type: child epics, user stories, acceptance criteria, requirements
+func outlineRank(entityType models.EntityType) int {
+	switch entityType {
+	case models.EntityTypeEpic:
+		return 0
+	case models.EntityTypeAcceptanceCriteria:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// GetProgress counts the descendants of an entity by type and status from the hierarchy cache.
+// The progress of an epic rolls up the epics nested under it.
+func (s *navigationService) GetProgress(ctx context.Context, entityType string, entityID uuid.UUID) (*HierarchyProgress, error) {
+	entries, root, err := s.subtreeEntries(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &HierarchyProgress{
+		ID:          root.EntityID,
+		ReferenceID: root.ReferenceID,
+		Type:        string(root.EntityType),
+		Descendants: make(map[string]HierarchyProgressCount),
+	}
+	for i := range entries {
+		entry := &entries[i]
+		if entry == root {
+			continue
+		}
+		count := progress.Descendants[string(entry.EntityType)]
+		count.Total++
+		if entry.Status != "" {
+			if count.ByStatus == nil {
+				count.ByStatus = make(map[string]int)
+			}
+			count.ByStatus[entry.Status]++
+		}
+		progress.Descendants[string(entry.EntityType)] = count
+	}
+
+	if userStories, ok := progress.Descendants[string(models.EntityTypeUserStory)]; ok {
+		planned := userStories.Total - userStories.ByStatus[string(models.UserStoryStatusCancelled)]
+		if planned > 0 {
+			percent := math.Round(float64(userStories.ByStatus[string(models.UserStoryStatusDone)])*1000/float64(planned)) / 10
+			progress.CompletedPercent = &percent
+		}
+	}
+	return progress, nil
+}
+
+// subtreeEntries reads an entity and its descendants from the hierarchy cache and returns the entity's own row
+func (s *navigationService) subtreeEntries(ctx context.Context, entityType string, entityID uuid.UUID) ([]models.HierarchyEntry, *models.HierarchyEntry, error) {
+	if !isHierarchyEntityType(entityType) {
+		return nil, nil, ErrInvalidNavigationEntityType
+	}
+	entries, err := s.hierarchyRepo.ListSubtreeEntries(ctx, models.EntityType(entityType), entityID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list hierarchy entries: %w", err)
+	}
+	for i := range entries {
+		if entries[i].EntityType == models.EntityType(entityType) && entries[i].EntityID == entityID {
+			return entries, &entries[i], nil
+		}
+	}
+
+	// Tell a missing entity from one missing in the cache
+	if _, err := s.loadEntityPath(ctx, entityType, entityID); err != nil {
+		return nil, nil, err
+	}
+	return nil, nil, fmt.Errorf("hierarchy cache has no entry for %s %s; rebuild it with cmd/hierarchy-cache", entityType, entityID)
+}
+
 // Helper functions
 
+// hierarchyNodes converts hierarchy rows of one entity type to tree nodes
+func hierarchyNodes(entityType string, rows []repository.HierarchyNodeRow) []HierarchyNode {
+	nodes := make([]HierarchyNode, len(rows))
+	for i, row := range rows {
+		nodes[i] = HierarchyNode{
+			ID:            row.ID,
+			ReferenceID:   row.ReferenceID,
+			Type:          entityType,
+			Title:         row.Title,
+			Status:        row.Status,
+			ChildrenCount: row.ChildrenCount,
+			HasChildren:   row.ChildrenCount > 0,
+		}
+	}
+	return nodes
+}
+
+// applyDisplayNumbers sets the display numbers of an epic and its expanded descendants
+func (eh *EpicHierarchy) applyDisplayNumbers(numbers *DisplayNumbers) {
+	eh.DisplayNumber = numbers.Get(eh.ID)
+	for i := range eh.UserStories {
+		eh.UserStories[i].applyDisplayNumbers(numbers)
+	}
+}
+
+// applyDisplayNumbers sets the display numbers of a user story and its expanded requirements
+func (ush *UserStoryHierarchy) applyDisplayNumbers(numbers *DisplayNumbers) {
+	ush.DisplayNumber = numbers.Get(ush.ID)
+	for i := range ush.Requirements {
+		ush.Requirements[i].DisplayNumber = numbers.Get(ush.Requirements[i].ID)
+	}
+}
+
 // getUserStoriesForEpic gets user stories for an epic with sorting
-func (s *navigationService) getUserStoriesForEpic(epicID uuid.UUID, _, _ string) ([]models.UserStory, error) {
+func (s *navigationService) getUserStoriesForEpic(ctx context.Context, epicID uuid.UUID, _, _ string) ([]models.UserStory, error) {
 	// Get user stories by epic ID - this will return user stories with populated users
 	// since they're being used in hierarchy context where user info is expected
-	return s.userStoryRepo.GetByEpic(epicID)
+	return s.userStoryRepo.GetByEpic(ctx, epicID)
 }
 
 // getRequirementsForUserStory gets requirements for a user story with sorting
-func (s *navigationService) getRequirementsForUserStory(userStoryID uuid.UUID, orderBy, orderDirection string) ([]models.Requirement, error) {
+func (s *navigationService) getRequirementsForUserStory(ctx context.Context, userStoryID uuid.UUID, orderBy, orderDirection string) ([]models.Requirement, error) {
 	// Use the repository method directly
 	filterMap := make(map[string]interface{})
 	filterMap["user_story_id"] = userStoryID
@@ -631,7 +1161,7 @@ func (s *navigationService) getRequirementsForUserStory(userStoryID uuid.UUID, o
 		}
 	}
 
-	return s.requirementRepo.List(filterMap, orderByClause, 100, 0)
+	return s.requirementRepo.List(ctx, filterMap, orderByClause, 100, 0)
 }
 
 // shouldExpand checks if a specific field should be expanded
@@ -650,11 +1180,3 @@ func shouldExpand(expand, field string) bool {
 
 	return false
 }
-
-// minInt returns the minimum of two integers
-func minInt(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// setupNavigationTest creates an epic with one user story holding acceptance criteria AC-001,
+// REQ-001 linked to it and REQ-002 not linked to any acceptance criteria, and builds the hierarchy cache
+func setupNavigationTest(t *testing.T) (NavigationService, *models.Epic, *models.AcceptanceCriteria, []models.Requirement) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, user, epic, requirements := setupSupersessionTest(t, active, active)
+	session := db.Session(&gorm.Session{SkipHooks: true})
+
+	criteria := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: requirements[0].UserStoryID, AuthorID: user.ID,
+		Description: "WHEN a user signs up with a weak password THEN the system SHALL reject the password and explain the rules"}
+	require.NoError(t, session.Create(criteria).Error)
+	require.NoError(t, session.Model(&requirements[0]).Update("acceptance_criteria_id", criteria.ID).Error)
+	require.NoError(t, session.Create(&models.RequirementAcceptanceCriteria{RequirementID: requirements[0].ID, AcceptanceCriteriaID: criteria.ID}).Error)
+
+	require.NoError(t, db.AutoMigrate(&models.HierarchyChange{}, &models.HierarchyEntry{}))
+	repos := repository.NewRepositories(db, nil)
+	_, err := repos.Hierarchy.RebuildEntries(ctx)
+	require.NoError(t, err)
+	svc := NewNavigationService(repos.Epic, repos.UserStory, repos.AcceptanceCriteria, repos.Requirement,
+		repos.RequirementRelationship, repos.User, repos.Hierarchy)
+	return svc, epic, criteria, requirements
+}
+
+// pathReferenceIDs returns the reference IDs of a path in order
+func pathReferenceIDs(path []PathElement) []string {
+	ids := make([]string, len(path))
+	for i, element := range path {
+		ids[i] = element.ReferenceID
+	}
+	return ids
+}
+
+func TestNavigationService_GetEntityPath(t *testing.T) {
+	ctx := context.Background()
+	svc, epic, criteria, requirements := setupNavigationTest(t)
+
+	path, err := svc.GetEntityPath(ctx, "requirement", requirements[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EP-001", "US-001", "AC-001", "REQ-001"}, pathReferenceIDs(path))
+	assert.Equal(t, "acceptance_criteria", path[2].Type)
+	assert.LessOrEqual(t, len([]rune(path[2].Title)), displayTitleLength)
+
+	path, err = svc.GetEntityPath(ctx, "requirement", requirements[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EP-001", "US-001", "REQ-002"}, pathReferenceIDs(path))
+
+	path, err = svc.GetEntityPath(ctx, "acceptance_criteria", criteria.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EP-001", "US-001", "AC-001"}, pathReferenceIDs(path))
+
+	path, err = svc.GetEntityPath(ctx, "epic", epic.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EP-001"}, pathReferenceIDs(path))
+
+	_, err = svc.GetEntityPath(ctx, "requirement", uuid.New())
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = svc.GetEntityPath(ctx, "comment", epic.ID)
+	assert.ErrorIs(t, err, ErrInvalidNavigationEntityType)
+}
+
+func TestNavigationService_HierarchyTree(t *testing.T) {
+	ctx := context.Background()
+	svc, epic, criteria, requirements := setupNavigationTest(t)
+
+	epics, total, err := svc.ListEpicNodes(ctx, HierarchyFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, epics, 1)
+	assert.Equal(t, HierarchyNode{ID: epic.ID, ReferenceID: "EP-001", Type: "epic", Title: epic.Title,
+		Status: string(epic.Status), ChildrenCount: 1, HasChildren: true}, epics[0])
+
+	status := "Done"
+	epics, total, err = svc.ListEpicNodes(ctx, HierarchyFilters{Status: &status})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, epics)
+
+	stories, err := svc.ListChildNodes(ctx, "epic", epic.ID)
+	require.NoError(t, err)
+	require.Len(t, stories, 1)
+	assert.Equal(t, "user_story", stories[0].Type)
+	assert.Equal(t, int64(2), stories[0].ChildrenCount) // AC-001 and the unlinked REQ-002
+
+	children, err := svc.ListChildNodes(ctx, "user_story", stories[0].ID)
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+	assert.Equal(t, "AC-001", children[0].ReferenceID)
+	assert.Equal(t, int64(1), children[0].ChildrenCount)
+	assert.Equal(t, "REQ-002", children[1].ReferenceID)
+	assert.False(t, children[1].HasChildren)
+
+	children, err = svc.ListChildNodes(ctx, "acceptance_criteria", criteria.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	assert.Equal(t, requirements[0].ID, children[0].ID)
+
+	children, err = svc.ListChildNodes(ctx, "requirement", requirements[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, children)
+
+	_, err = svc.ListChildNodes(ctx, "epic", uuid.New())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestNavigationService_GetLastModified(t *testing.T) {
+	ctx := context.Background()
+	svc, epic, criteria, requirements := setupNavigationTest(t)
+	db := svc.(*navigationService).hierarchyRepo.GetDB()
+
+	lastModified, err := svc.GetLastModified(ctx, "epic", epic.ID)
+	require.NoError(t, err)
+	assert.True(t, lastModified.Equal(epic.UpdatedAt))
+
+	// A child removed after the epic was last updated
+	changedAt := epic.UpdatedAt.Add(time.Hour)
+	require.NoError(t, db.Create(&models.HierarchyChange{EntityType: models.EntityTypeEpic, EntityID: epic.ID, ChangedAt: changedAt}).Error)
+	lastModified, err = svc.GetLastModified(ctx, "epic", epic.ID)
+	require.NoError(t, err)
+	assert.True(t, lastModified.Equal(changedAt))
+
+	// Changes older than the entity itself do not matter
+	require.NoError(t, db.Create(&models.HierarchyChange{EntityType: models.EntityTypeAcceptanceCriteria, EntityID: criteria.ID,
+		ChangedAt: criteria.UpdatedAt.Add(-time.Hour)}).Error)
+	lastModified, err = svc.GetLastModified(ctx, "acceptance_criteria", criteria.ID)
+	require.NoError(t, err)
+	assert.True(t, lastModified.Equal(criteria.UpdatedAt))
+
+	lastModified, err = svc.GetLastModified(ctx, "requirement", requirements[0].ID)
+	require.NoError(t, err)
+	assert.False(t, lastModified.IsZero())
+
+	_, err = svc.GetLastModified(ctx, "user_story", uuid.New())
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = svc.GetLastModified(ctx, "comment", epic.ID)
+	assert.ErrorIs(t, err, ErrInvalidNavigationEntityType)
+}
+
+func TestNavigationService_HierarchyCache(t *testing.T) {
+	ctx := context.Background()
+	svc, epic, criteria, requirements := setupNavigationTest(t)
+	db := svc.(*navigationService).hierarchyRepo.GetDB()
+
+	t.Run("outline", func(t *testing.T) {
+		outline, err := svc.GetOutline(ctx, "epic", epic.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "EP-001", outline.ReferenceID)
+		require.Len(t, outline.Children, 1)
+		story := outline.Children[0]
+		assert.Equal(t, "US-001", story.ReferenceID)
+		require.Len(t, story.Children, 2)
+		assert.Equal(t, "AC-001", story.Children[0].ReferenceID)
+		assert.LessOrEqual(t, len([]rune(story.Children[0].Title)), displayTitleLength)
+		require.Len(t, story.Children[0].Children, 1)
+		assert.Equal(t, requirements[0].ID, story.Children[0].Children[0].ID)
+		assert.Equal(t, "REQ-002", story.Children[1].ReferenceID)
+		assert.Empty(t, story.Children[1].Children)
+
+		outline, err = svc.GetOutline(ctx, "acceptance_criteria", criteria.ID)
+		require.NoError(t, err)
+		require.Len(t, outline.Children, 1)
+		assert.Equal(t, "REQ-001", outline.Children[0].ReferenceID)
+
+		_, err = svc.GetOutline(ctx, "epic", uuid.New())
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = svc.GetOutline(ctx, "comment", epic.ID)
+		assert.ErrorIs(t, err, ErrInvalidNavigationEntityType)
+	})
+
+	t.Run("progress", func(t *testing.T) {
+		progress, err := svc.GetProgress(ctx, "epic", epic.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "EP-001", progress.ReferenceID)
+		assert.Equal(t, HierarchyProgressCount{Total: 1, ByStatus: map[string]int{"Backlog": 1}}, progress.Descendants["user_story"])
+		assert.Equal(t, HierarchyProgressCount{Total: 1}, progress.Descendants["acceptance_criteria"])
+		assert.Equal(t, HierarchyProgressCount{Total: 2, ByStatus: map[string]int{"Active": 2}}, progress.Descendants["requirement"])
+		require.NotNil(t, progress.CompletedPercent)
+		assert.Zero(t, *progress.CompletedPercent)
+
+		require.NoError(t, db.Model(&models.HierarchyEntry{}).Where("entity_type = ?", "user_story").Update("status", "Done").Error)
+		progress, err = svc.GetProgress(ctx, "epic", epic.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 100.0, *progress.CompletedPercent)
+
+		progress, err = svc.GetProgress(ctx, "requirement", requirements[0].ID)
+		require.NoError(t, err)
+		assert.Empty(t, progress.Descendants)
+		assert.Nil(t, progress.CompletedPercent)
+	})
+
+	t.Run("paths are read from the cache and loaded when it misses", func(t *testing.T) {
+		require.NoError(t, db.Model(&models.HierarchyEntry{}).Where("entity_id = ?", epic.ID).Update("title", "Cached accounts").Error)
+		path, err := svc.GetEntityPath(ctx, "requirement", requirements[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"EP-001", "US-001", "AC-001", "REQ-001"}, pathReferenceIDs(path))
+		assert.Equal(t, "Cached accounts", path[0].Title)
+
+		require.NoError(t, db.Where("entity_id = ?", epic.ID).Delete(&models.HierarchyEntry{}).Error)
+		path, err = svc.GetEntityPath(ctx, "requirement", requirements[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"EP-001", "US-001", "AC-001", "REQ-001"}, pathReferenceIDs(path))
+		assert.Equal(t, epic.Title, path[0].Title)
+
+		_, err = svc.GetOutline(ctx, "epic", epic.ID)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// numberingOrder orders siblings for numbering; reference IDs break ties between items created together
+const numberingOrder = "created_at ASC, reference_id ASC"
+
+// DisplayNumbers maps epics, user stories and requirements to hierarchical display numbers such as 1.2.3
+type DisplayNumbers struct {
+	numbers map[uuid.UUID]string
+}
+
+// Get returns the display number of an entity, or an empty string if it is not numbered
+func (d *DisplayNumbers) Get(id uuid.UUID) string {
+	if d == nil {
+		return ""
+	}
+	return d.numbers[id]
+}
+
+// Len returns the number of numbered entities
+func (d *DisplayNumbers) Len() int {
+	if d == nil {
+		return 0
+	}
+	return len(d.numbers)
+}
+
+// NumberingService derives hierarchical display numbers from the epic → user story → requirement structure.
+// An epic is numbered by its position among all epics, a user story by its position within its epic and a
+// requirement by its position within its user story. Numbers are computed on read rather than stored, so
+// they follow the current structure: moving, adding or deleting an item renumbers its later siblings.
+type NumberingService interface {
+	// GetDisplayNumbers numbers the whole hierarchy, for exporting complete specifications
+	GetDisplayNumbers(ctx context.Context) (*DisplayNumbers, error)
+	// GetEpicDisplayNumbers numbers a single epic and its descendants
+	GetEpicDisplayNumbers(ctx context.Context, epicID uuid.UUID) (*DisplayNumbers, error)
+}
+
+// numberingService implements NumberingService
+type numberingService struct {
+	epicRepo        repository.EpicRepository
+	userStoryRepo   repository.UserStoryRepository
+	requirementRepo repository.RequirementRepository
+}
+
+// NewNumberingService creates a new numbering service instance
+func NewNumberingService(
+	epicRepo repository.EpicRepository,
+	userStoryRepo repository.UserStoryRepository,
+	requirementRepo repository.RequirementRepository,
+) NumberingService {
+	return &numberingService{
+		epicRepo:        epicRepo,
+		userStoryRepo:   userStoryRepo,
+		requirementRepo: requirementRepo,
+	}
+}
+
+// GetDisplayNumbers numbers every epic, user story and requirement
+func (s *numberingService) GetDisplayNumbers(ctx context.Context) (*DisplayNumbers, error) {
+	epics, err := s.epicRepo.List(ctx, nil, numberingOrder, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list epics: %w", err)
+	}
+	userStories, err := s.userStoryRepo.List(ctx, nil, numberingOrder, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user stories: %w", err)
+	}
+	requirements, err := s.requirementRepo.List(ctx, nil, numberingOrder, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirements: %w", err)
+	}
+
+	numbers := &DisplayNumbers{numbers: make(map[uuid.UUID]string)}
+	for i, epic := range epics {
+		numbers.numbers[epic.ID] = strconv.Itoa(i + 1)
+	}
+	addChildren(numbers, userStories, func(us models.UserStory) (uuid.UUID, uuid.UUID) { return us.ID, us.EpicID })
+	addChildren(numbers, requirements, func(r models.Requirement) (uuid.UUID, uuid.UUID) { return r.ID, r.UserStoryID })
+	return numbers, nil
+}
+
+// GetEpicDisplayNumbers numbers a single epic, its user stories and their requirements
+func (s *numberingService) GetEpicDisplayNumbers(ctx context.Context, epicID uuid.UUID) (*DisplayNumbers, error) {
+	epics, err := s.epicRepo.List(ctx, nil, numberingOrder, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list epics: %w", err)
+	}
+
+	numbers := &DisplayNumbers{numbers: make(map[uuid.UUID]string)}
+	for i, epic := range epics {
+		if epic.ID == epicID {
+			numbers.numbers[epic.ID] = strconv.Itoa(i + 1)
+			break
+		}
+	}
+	if numbers.Len() == 0 {
+		return nil, ErrEpicNotFound
+	}
+
+	userStories, err := s.userStoryRepo.List(ctx, map[string]interface{}{"epic_id": epicID}, numberingOrder, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user stories: %w", err)
+	}
+	addChildren(numbers, userStories, func(us models.UserStory) (uuid.UUID, uuid.UUID) { return us.ID, us.EpicID })
+
+	for _, userStory := range userStories {
+		requirements, err := s.requirementRepo.List(ctx, map[string]interface{}{"user_story_id": userStory.ID}, numberingOrder, 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list requirements: %w", err)
+		}
+		addChildren(numbers, requirements, func(r models.Requirement) (uuid.UUID, uuid.UUID) { return r.ID, r.UserStoryID })
+	}
+	return numbers, nil
+}
+
+// addChildren numbers ordered children below their already numbered parents
+func addChildren[T any](d *DisplayNumbers, children []T, ids func(T) (id, parentID uuid.UUID)) {
+	positions := make(map[uuid.UUID]int)
+	for _, child := range children {
+		id, parentID := ids(child)
+		parentNumber, ok := d.numbers[parentID]
+		if !ok {
+			continue
+		}
+		positions[parentID]++
+		d.numbers[id] = parentNumber + "." + strconv.Itoa(positions[parentID])
+	}
+}
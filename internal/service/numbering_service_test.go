@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+)
+
+func TestNumberingService_GetDisplayNumbers(t *testing.T) {
+	ctx := context.Background()
+	epicRepo := new(MockEpicRepository)
+	userStoryRepo := new(MockUserStoryRepository)
+	requirementRepo := new(MockRequirementRepository)
+	service := NewNumberingService(epicRepo, userStoryRepo, requirementRepo)
+
+	epic1, epic2 := models.Epic{ID: uuid.New()}, models.Epic{ID: uuid.New()}
+	story1 := models.UserStory{ID: uuid.New(), EpicID: epic1.ID}
+	story2 := models.UserStory{ID: uuid.New(), EpicID: epic2.ID}
+	story3 := models.UserStory{ID: uuid.New(), EpicID: epic2.ID}
+	req1 := models.Requirement{ID: uuid.New(), UserStoryID: story3.ID}
+	req2 := models.Requirement{ID: uuid.New(), UserStoryID: story3.ID}
+
+	epicRepo.On("List", mock.Anything, numberingOrder, 0, 0).Return([]models.Epic{epic1, epic2}, nil)
+	userStoryRepo.On("List", mock.Anything, numberingOrder, 0, 0).Return([]models.UserStory{story1, story2, story3}, nil)
+	requirementRepo.On("List", mock.Anything, numberingOrder, 0, 0).Return([]models.Requirement{req1, req2}, nil)
+
+	numbers, err := service.GetDisplayNumbers(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1", numbers.Get(epic1.ID))
+	assert.Equal(t, "2", numbers.Get(epic2.ID))
+	assert.Equal(t, "1.1", numbers.Get(story1.ID))
+	assert.Equal(t, "2.1", numbers.Get(story2.ID))
+	assert.Equal(t, "2.2", numbers.Get(story3.ID))
+	assert.Equal(t, "2.2.1", numbers.Get(req1.ID))
+	assert.Equal(t, "2.2.2", numbers.Get(req2.ID))
+	assert.Equal(t, "", numbers.Get(uuid.New()))
+}
+
+func TestNumberingService_GetEpicDisplayNumbers(t *testing.T) {
+	ctx := context.Background()
+	epicRepo := new(MockEpicRepository)
+	userStoryRepo := new(MockUserStoryRepository)
+	requirementRepo := new(MockRequirementRepository)
+	service := NewNumberingService(epicRepo, userStoryRepo, requirementRepo)
+
+	epic1, epic2 := models.Epic{ID: uuid.New()}, models.Epic{ID: uuid.New()}
+	story := models.UserStory{ID: uuid.New(), EpicID: epic2.ID}
+	req := models.Requirement{ID: uuid.New(), UserStoryID: story.ID}
+
+	epicRepo.On("List", mock.Anything, numberingOrder, 0, 0).Return([]models.Epic{epic1, epic2}, nil)
+	userStoryRepo.On("List", map[string]interface{}{"epic_id": epic2.ID}, numberingOrder, 0, 0).Return([]models.UserStory{story}, nil)
+	requirementRepo.On("List", map[string]interface{}{"user_story_id": story.ID}, numberingOrder, 0, 0).Return([]models.Requirement{req}, nil)
+
+	numbers, err := service.GetEpicDisplayNumbers(ctx, epic2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "2", numbers.Get(epic2.ID))
+	assert.Equal(t, "2.1", numbers.Get(story.ID))
+	assert.Equal(t, "2.1.1", numbers.Get(req.ID))
+
+	_, err = service.GetEpicDisplayNumbers(ctx, uuid.New())
+	assert.ErrorIs(t, err, ErrEpicNotFound)
+}
+
+func TestEpicHierarchy_MarshalJSON(t *testing.T) {
+	hierarchy := EpicHierarchy{
+		Epic:          models.Epic{ID: uuid.New(), Title: "Epic"},
+		DisplayNumber: "1",
+		UserStories: []UserStoryHierarchy{{
+			UserStory:          models.UserStory{ID: uuid.New(), Title: "Story"},
+			DisplayNumber:      "1.1",
+			AcceptanceCriteria: []models.AcceptanceCriteria{},
+			Requirements:       []RequirementHierarchy{},
+		}},
+	}
+
+	data, err := json.Marshal(&hierarchy)
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "Epic", decoded["title"])
+	assert.Equal(t, "1", decoded["display_number"])
+
+	stories, ok := decoded["user_stories"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, stories, 1)
+	assert.Equal(t, "1.1", stories[0].(map[string]interface{})["display_number"])
+}
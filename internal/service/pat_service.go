@@ -2,27 +2,28 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 )
 
 var (
-	ErrPATNotFound          = errors.New("personal access token not found")
-	ErrPATExpired           = errors.New("personal access token has expired")
-	ErrPATInvalidToken      = errors.New("invalid token format")
-	ErrPATInvalidPrefix     = errors.New("invalid token prefix")
-	ErrPATDuplicateName     = errors.New("token name already exists for user")
-	ErrPATUserNotFound      = errors.New("user not found")
-	ErrPATUnauthorized      = errors.New("unauthorized access to token")
-	ErrPATInvalidScopes     = errors.New("invalid scopes specified")
-	ErrPATTokenHashMismatch = errors.New("token does not match stored hash")
+	ErrPATNotFound          = apperrors.New(apperrors.KindNotFound, apperrors.CodeEntityNotFound, "personal access token not found")
+	ErrPATExpired           = apperrors.New(apperrors.KindUnauthenticated, "TOKEN_EXPIRED", "personal access token has expired")
+	ErrPATInvalidToken      = apperrors.New(apperrors.KindUnauthenticated, "INVALID_TOKEN", "invalid token format")
+	ErrPATInvalidPrefix     = apperrors.New(apperrors.KindUnauthenticated, "INVALID_TOKEN", "invalid token prefix")
+	ErrPATDuplicateName     = apperrors.New(apperrors.KindConflict, "TOKEN_NAME_EXISTS", "token name already exists for user")
+	ErrPATUserNotFound      = apperrors.New(apperrors.KindUnauthenticated, "INVALID_TOKEN", "user not found")
+	ErrPATUserDeactivated   = apperrors.New(apperrors.KindUnauthenticated, "USER_DEACTIVATED", "user account is deactivated")
+	ErrPATUnauthorized      = apperrors.New(apperrors.KindForbidden, apperrors.CodeInsufficientPermissions, "unauthorized access to token")
+	ErrPATInvalidScopes     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid scopes specified")
+	ErrPATTokenHashMismatch = apperrors.New(apperrors.KindUnauthenticated, "INVALID_TOKEN", "token does not match stored hash")
 )
 
 // PATService defines the interface for Personal Access Token business logic
@@ -35,6 +36,7 @@ type PATService interface {
 
 	// Authentication
 	ValidateToken(ctx context.Context, token string) (*models.User, error)
+	AuthenticateToken(ctx context.Context, token string) (*models.User, *models.PersonalAccessToken, error)
 	UpdateLastUsed(ctx context.Context, patID uuid.UUID) error
 
 	// Maintenance
@@ -97,7 +99,7 @@ func NewPATService(
 // CreatePAT creates a new personal access token for a user
 func (s *patService) CreatePAT(ctx context.Context, userID uuid.UUID, req CreatePATRequest) (*PATCreateResponse, error) {
 	// Validate user exists
-	user, err := s.userRepo.GetByID(userID)
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
@@ -106,7 +108,7 @@ func (s *patService) CreatePAT(ctx context.Context, userID uuid.UUID, req Create
 	}
 
 	// Check if token name already exists for this user
-	exists, err := s.patRepo.ExistsByUserIDAndName(userID, req.Name)
+	exists, err := s.patRepo.ExistsByUserIDAndName(ctx, userID, req.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check token name uniqueness: %w", err)
 	}
@@ -146,7 +148,7 @@ func (s *patService) CreatePAT(ctx context.Context, userID uuid.UUID, req Create
 	}
 
 	// Save to database
-	if err := s.patRepo.Create(pat); err != nil {
+	if err := s.patRepo.Create(ctx, pat); err != nil {
 		return nil, fmt.Errorf("failed to create PAT: %w", err)
 	}
 
@@ -160,7 +162,7 @@ func (s *patService) CreatePAT(ctx context.Context, userID uuid.UUID, req Create
 // ListUserPATs retrieves all PATs for a user with pagination
 func (s *patService) ListUserPATs(ctx context.Context, userID uuid.UUID, limit, offset int) (*ListResponse[models.PersonalAccessToken], error) {
 	// Validate user exists
-	user, err := s.userRepo.GetByID(userID)
+	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
@@ -177,7 +179,7 @@ func (s *patService) ListUserPATs(ctx context.Context, userID uuid.UUID, limit,
 	}
 
 	// Get paginated tokens with User preloaded
-	tokens, total, err := s.patRepo.GetByUserIDWithPaginationAndPreloads(userID, limit, offset)
+	tokens, total, err := s.patRepo.GetByUserIDWithPaginationAndPreloads(ctx, userID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list user PATs: %w", err)
 	}
@@ -192,7 +194,7 @@ func (s *patService) ListUserPATs(ctx context.Context, userID uuid.UUID, limit,
 
 // GetPAT retrieves a specific PAT by ID for a user
 func (s *patService) GetPAT(ctx context.Context, patID, userID uuid.UUID) (*models.PersonalAccessToken, error) {
-	pat, err := s.patRepo.GetByID(patID)
+	pat, err := s.patRepo.GetByID(ctx, patID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get PAT: %w", err)
 	}
@@ -217,7 +219,7 @@ func (s *patService) RevokePAT(ctx context.Context, patID, userID uuid.UUID) err
 	}
 
 	// Delete the PAT
-	if err := s.patRepo.Delete(pat.ID); err != nil {
+	if err := s.patRepo.Delete(ctx, pat.ID); err != nil {
 		return fmt.Errorf("failed to revoke PAT: %w", err)
 	}
 
@@ -259,27 +261,33 @@ func (s *patService) scopesToJSON(scopes []string) string {
 
 // ValidateToken validates a PAT and returns the associated user
 func (s *patService) ValidateToken(ctx context.Context, token string) (*models.User, error) {
+	user, _, err := s.AuthenticateToken(ctx, token)
+	return user, err
+}
+
+// AuthenticateToken validates a PAT and returns the associated user and the matched token
+func (s *patService) AuthenticateToken(ctx context.Context, token string) (*models.User, *models.PersonalAccessToken, error) {
 	// Validate token format
 	if token == "" {
-		return nil, ErrPATInvalidToken
+		return nil, nil, ErrPATInvalidToken
 	}
 
 	// Extract prefix and validate
 	const expectedPrefix = "mcp_pat_"
 	if !strings.HasPrefix(token, expectedPrefix) {
-		return nil, ErrPATInvalidPrefix
+		return nil, nil, ErrPATInvalidPrefix
 	}
 
 	// Extract secret part
 	if len(token) <= len(expectedPrefix) {
-		return nil, ErrPATInvalidToken
+		return nil, nil, ErrPATInvalidToken
 	}
 	secretPart := token[len(expectedPrefix):]
 
 	// Get all tokens with this prefix
-	tokens, err := s.patRepo.GetHashesByPrefix(expectedPrefix)
+	tokens, err := s.patRepo.GetHashesByPrefix(ctx, expectedPrefix)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tokens by prefix: %w", err)
+		return nil, nil, fmt.Errorf("failed to get tokens by prefix: %w", err)
 	}
 
 	// Try to match the token against stored hashes
@@ -292,34 +300,37 @@ func (s *patService) ValidateToken(ctx context.Context, token string) (*models.U
 		// Compare token with hash using constant-time comparison
 		if err := s.hashService.CompareTokenWithHash(secretPart, pat.TokenHash); err == nil {
 			// Token matches, get the user
-			user, err := s.userRepo.GetByID(pat.UserID)
+			user, err := s.userRepo.GetByID(ctx, pat.UserID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get user for PAT: %w", err)
+				return nil, nil, fmt.Errorf("failed to get user for PAT: %w", err)
 			}
 			if user == nil {
-				return nil, ErrPATUserNotFound
+				return nil, nil, ErrPATUserNotFound
+			}
+			if !user.IsActive() {
+				return nil, nil, ErrPATUserDeactivated
 			}
 
 			// Update last used timestamp (in production this could be async)
 			now := time.Now()
-			if updateErr := s.patRepo.UpdateLastUsed(pat.ID, &now); updateErr != nil {
+			if updateErr := s.patRepo.UpdateLastUsed(ctx, pat.ID, &now); updateErr != nil {
 				// Log error but don't fail the authentication
 				// In a real application, you'd use a proper logger here
 				fmt.Printf("Warning: failed to update last used timestamp for PAT %s: %v\n", pat.ID, updateErr)
 			}
 
-			return user, nil
+			return user, &pat, nil
 		}
 	}
 
 	// No matching token found
-	return nil, ErrPATTokenHashMismatch
+	return nil, nil, ErrPATTokenHashMismatch
 }
 
 // UpdateLastUsed updates the last used timestamp for a PAT
 func (s *patService) UpdateLastUsed(ctx context.Context, patID uuid.UUID) error {
 	now := time.Now()
-	if err := s.patRepo.UpdateLastUsed(patID, &now); err != nil {
+	if err := s.patRepo.UpdateLastUsed(ctx, patID, &now); err != nil {
 		return fmt.Errorf("failed to update last used timestamp: %w", err)
 	}
 	return nil
@@ -327,7 +338,7 @@ func (s *patService) UpdateLastUsed(ctx context.Context, patID uuid.UUID) error
 
 // CleanupExpiredTokens removes all expired tokens and returns the count
 func (s *patService) CleanupExpiredTokens(ctx context.Context) (int, error) {
-	count, err := s.patRepo.DeleteExpired()
+	count, err := s.patRepo.DeleteExpired(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to cleanup expired tokens: %w", err)
 	}
@@ -19,12 +19,12 @@ type MockPATRepository struct {
 	mock.Mock
 }
 
-func (m *MockPATRepository) Create(pat *models.PersonalAccessToken) error {
+func (m *MockPATRepository) Create(ctx context.Context, pat *models.PersonalAccessToken) error {
 	args := m.Called(pat)
 	return args.Error(0)
 }
 
-func (m *MockPATRepository) GetByID(id uuid.UUID) (*models.PersonalAccessToken, error) {
+func (m *MockPATRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.PersonalAccessToken, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -32,7 +32,7 @@ func (m *MockPATRepository) GetByID(id uuid.UUID) (*models.PersonalAccessToken,
 	return args.Get(0).(*models.PersonalAccessToken), args.Error(1)
 }
 
-func (m *MockPATRepository) GetByReferenceID(referenceID string) (*models.PersonalAccessToken, error) {
+func (m *MockPATRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.PersonalAccessToken, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,7 +40,7 @@ func (m *MockPATRepository) GetByReferenceID(referenceID string) (*models.Person
 	return args.Get(0).(*models.PersonalAccessToken), args.Error(1)
 }
 
-func (m *MockPATRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.PersonalAccessToken, error) {
+func (m *MockPATRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.PersonalAccessToken, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -48,37 +48,37 @@ func (m *MockPATRepository) GetByReferenceIDCaseInsensitive(referenceID string)
 	return args.Get(0).(*models.PersonalAccessToken), args.Error(1)
 }
 
-func (m *MockPATRepository) Update(pat *models.PersonalAccessToken) error {
+func (m *MockPATRepository) Update(ctx context.Context, pat *models.PersonalAccessToken) error {
 	args := m.Called(pat)
 	return args.Error(0)
 }
 
-func (m *MockPATRepository) Delete(id uuid.UUID) error {
+func (m *MockPATRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockPATRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.PersonalAccessToken, error) {
+func (m *MockPATRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.PersonalAccessToken, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.PersonalAccessToken), args.Error(1)
 }
 
-func (m *MockPATRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockPATRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockPATRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockPATRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockPATRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockPATRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockPATRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockPATRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -91,37 +91,37 @@ func (m *MockPATRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockPATRepository) GetByUserID(userID uuid.UUID) ([]models.PersonalAccessToken, error) {
+func (m *MockPATRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.PersonalAccessToken, error) {
 	args := m.Called(userID)
 	return args.Get(0).([]models.PersonalAccessToken), args.Error(1)
 }
 
-func (m *MockPATRepository) GetByUserIDWithPagination(userID uuid.UUID, limit, offset int) ([]models.PersonalAccessToken, int64, error) {
+func (m *MockPATRepository) GetByUserIDWithPagination(ctx context.Context, userID uuid.UUID, limit, offset int) ([]models.PersonalAccessToken, int64, error) {
 	args := m.Called(userID, limit, offset)
 	return args.Get(0).([]models.PersonalAccessToken), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockPATRepository) GetHashesByPrefix(prefix string) ([]models.PersonalAccessToken, error) {
+func (m *MockPATRepository) GetHashesByPrefix(ctx context.Context, prefix string) ([]models.PersonalAccessToken, error) {
 	args := m.Called(prefix)
 	return args.Get(0).([]models.PersonalAccessToken), args.Error(1)
 }
 
-func (m *MockPATRepository) UpdateLastUsed(id uuid.UUID, lastUsedAt *time.Time) error {
+func (m *MockPATRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, lastUsedAt *time.Time) error {
 	args := m.Called(id, lastUsedAt)
 	return args.Error(0)
 }
 
-func (m *MockPATRepository) DeleteExpired() (int64, error) {
+func (m *MockPATRepository) DeleteExpired(ctx context.Context) (int64, error) {
 	args := m.Called()
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockPATRepository) ExistsByUserIDAndName(userID uuid.UUID, name string) (bool, error) {
+func (m *MockPATRepository) ExistsByUserIDAndName(ctx context.Context, userID uuid.UUID, name string) (bool, error) {
 	args := m.Called(userID, name)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockPATRepository) GetByUserIDWithPaginationAndPreloads(userID uuid.UUID, limit, offset int) ([]models.PersonalAccessToken, int64, error) {
+func (m *MockPATRepository) GetByUserIDWithPaginationAndPreloads(ctx context.Context, userID uuid.UUID, limit, offset int) ([]models.PersonalAccessToken, int64, error) {
 	args := m.Called(userID, limit, offset)
 	return args.Get(0).([]models.PersonalAccessToken), args.Get(1).(int64), args.Error(2)
 }
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Portfolio query defaults and limits
+const (
+	DefaultPortfolioDays  = 30
+	MaxPortfolioDays      = 365
+	DefaultPortfolioLimit = 10
+	MaxPortfolioLimit     = 50
+)
+
+var (
+	ErrInvalidPortfolioStatus   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "status must be one of: Backlog, Draft, In Progress, Done, Cancelled")
+	ErrInvalidPortfolioPriority = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "priority must be between 1 and 4")
+)
+
+// portfolioEpicStatuses are the epic statuses reported in the portfolio, in workflow order
+var portfolioEpicStatuses = []models.EpicStatus{
+	models.EpicStatusBacklog,
+	models.EpicStatusDraft,
+	models.EpicStatusInProgress,
+	models.EpicStatusDone,
+	models.EpicStatusCancelled,
+}
+
+// PortfolioService defines the interface for the portfolio view aggregating the status of epics for dashboards
+type PortfolioService interface {
+	GetPortfolio(ctx context.Context, query PortfolioQuery) (*Portfolio, error)
+}
+
+// PortfolioQuery selects the epics of the portfolio and the size of its lists
+type PortfolioQuery struct {
+	Status          *models.EpicStatus
+	Priority        *models.Priority
+	AssigneeID      *uuid.UUID
+	CreatorID       *uuid.UUID
+	IncludeArchived bool
+	Days            int // Days ahead covered by upcoming due dates
+	Limit           int // Number of activity events, risks and due dates
+}
+
+// Portfolio is the aggregated status of the epics matching a query
+// @Description Epic counts by status and priority, recent activity, the riskiest requirements and upcoming due dates of the selected epics
+type Portfolio struct {
+	GeneratedAt      time.Time                     `json:"generated_at" example:"2023-01-01T10:00:00Z"`
+	Epics            int64                         `json:"epics" example:"12"`
+	ByStatus         map[models.EpicStatus]int64   `json:"by_status"`
+	ByPriority       map[models.Priority]int64     `json:"by_priority"`
+	RecentActivity   []models.AuditEvent           `json:"recent_activity"`
+	TopRisks         []RequirementRiskEntry        `json:"top_risks"`
+	UpcomingDueDates []repository.PortfolioDueDate `json:"upcoming_due_dates"`
+}
+
+// portfolioService implements PortfolioService interface
+type portfolioService struct {
+	portfolioRepo repository.PortfolioRepository
+	riskService   RequirementRiskService
+	now           func() time.Time
+}
+
+// NewPortfolioService creates a new portfolio service instance
+func NewPortfolioService(repos *repository.Repositories) PortfolioService {
+	return &portfolioService{
+		portfolioRepo: repos.Portfolio,
+		riskService:   NewRequirementRiskService(repos),
+		now:           time.Now,
+	}
+}
+
+// GetPortfolio aggregates the epics matching the query: counts per status and priority, the latest changes below them,
+// their highest-risk Draft and Active requirements, and their open epics and user stories due in the coming days
+func (s *portfolioService) GetPortfolio(ctx context.Context, query PortfolioQuery) (*Portfolio, error) {
+	if query.Status != nil && !(&models.Epic{}).IsValidStatus(*query.Status) {
+		return nil, ErrInvalidPortfolioStatus
+	}
+	if query.Priority != nil && (*query.Priority < models.PriorityCritical || *query.Priority > models.PriorityLow) {
+		return nil, ErrInvalidPortfolioPriority
+	}
+	days := clampStatisticsParam(query.Days, DefaultPortfolioDays, MaxPortfolioDays)
+	limit := clampStatisticsParam(query.Limit, DefaultPortfolioLimit, MaxPortfolioLimit)
+
+	filter := repository.PortfolioFilter{
+		Status:          query.Status,
+		Priority:        query.Priority,
+		AssigneeID:      query.AssigneeID,
+		CreatorID:       query.CreatorID,
+		IncludeArchived: query.IncludeArchived,
+	}
+	now := s.now().UTC()
+	portfolio := &Portfolio{
+		GeneratedAt: now,
+		ByStatus:    make(map[models.EpicStatus]int64, len(portfolioEpicStatuses)),
+		ByPriority:  make(map[models.Priority]int64, 4),
+	}
+	for _, status := range portfolioEpicStatuses {
+		portfolio.ByStatus[status] = 0
+	}
+	for priority := models.PriorityCritical; priority <= models.PriorityLow; priority++ {
+		portfolio.ByPriority[priority] = 0
+	}
+
+	counts, err := s.portfolioRepo.CountEpics(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count portfolio epics: %w", err)
+	}
+	for _, count := range counts {
+		portfolio.Epics += count.Count
+		portfolio.ByStatus[count.Status] += count.Count
+		portfolio.ByPriority[count.Priority] += count.Count
+	}
+
+	portfolio.RecentActivity, err = s.portfolioRepo.ListRecentActivity(ctx, filter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolio activity: %w", err)
+	}
+	portfolio.TopRisks, err = s.riskService.GetRiskReport(ctx, RequirementRiskReportFilter{Portfolio: &filter, Limit: limit}, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolio risks: %w", err)
+	}
+	today := truncateToDay(now)
+	portfolio.UpcomingDueDates, err = s.portfolioRepo.ListDueDates(ctx, filter, today, today.AddDate(0, 0, days+1), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolio due dates: %w", err)
+	}
+
+	if portfolio.RecentActivity == nil {
+		portfolio.RecentActivity = []models.AuditEvent{}
+	}
+	if portfolio.TopRisks == nil {
+		portfolio.TopRisks = []RequirementRiskEntry{}
+	}
+	if portfolio.UpcomingDueDates == nil {
+		portfolio.UpcomingDueDates = []repository.PortfolioDueDate{}
+	}
+	return portfolio, nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestPortfolioService(t *testing.T) {
+	ctx := context.Background()
+	draft, active := models.RequirementStatusDraft, models.RequirementStatusActive
+	db, alice, epic, requirements := setupSupersessionTest(t, draft, active)
+	require.NoError(t, db.AutoMigrate(&models.Comment{}, &models.AuditEvent{}))
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	today := truncateToDay(time.Now())
+	dueIn := func(days int) *time.Time {
+		due := today.AddDate(0, 0, days)
+		return &due
+	}
+
+	bob := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(bob).Error)
+
+	// EP-001 is a critical Backlog epic due in five days; its first story is due after the default window
+	require.NoError(t, db.Model(epic).Updates(map[string]interface{}{"priority": models.PriorityCritical, "due_date": dueIn(5)}).Error)
+	require.NoError(t, db.Model(&models.UserStory{}).Where("reference_id = ?", "US-001").UpdateColumn("due_date", dueIn(40)).Error)
+	stories := []*models.UserStory{
+		{ID: uuid.New(), ReferenceID: "US-002", EpicID: epic.ID, Title: "Sign in", Status: models.UserStoryStatusDone,
+			CreatorID: alice.ID, AssigneeID: alice.ID, DueDate: dueIn(3)},
+	}
+	// EP-002 is a high priority epic in progress assigned to bob; EP-003 is archived
+	payments := &models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Payments", Status: models.EpicStatusInProgress,
+		Priority: models.PriorityHigh, CreatorID: alice.ID, AssigneeID: bob.ID}
+	legacy := &models.Epic{ID: uuid.New(), ReferenceID: "EP-003", Title: "Legacy import", Status: models.EpicStatusDone,
+		Priority: models.PriorityLow, CreatorID: alice.ID, AssigneeID: alice.ID, Archived: true}
+	for _, e := range []*models.Epic{payments, legacy} {
+		require.NoError(t, session.Create(e).Error)
+	}
+	stories = append(stories, &models.UserStory{ID: uuid.New(), ReferenceID: "US-003", EpicID: payments.ID, Title: "Refunds",
+		Status: models.UserStoryStatusInProgress, CreatorID: alice.ID, AssigneeID: bob.ID, DueDate: dueIn(1)})
+	for _, story := range stories {
+		require.NoError(t, session.Create(story).Error)
+	}
+
+	now := time.Now().UTC()
+	for i, event := range []models.AuditEvent{
+		{EntityType: models.EntityTypeEpic, EntityID: epic.ID, Action: models.AuditActionEdited, ActorID: &alice.ID},
+		{EntityType: models.EntityTypeRequirement, EntityID: requirements[0].ID, Action: models.AuditActionEdited, ActorID: &alice.ID},
+		{EntityType: models.EntityTypeEpic, EntityID: legacy.ID, Action: models.AuditActionEdited, ActorID: &alice.ID},
+	} {
+		event.CreatedAt = now.Add(time.Duration(i-10) * time.Minute)
+		require.NoError(t, db.Create(&event).Error)
+	}
+
+	svc := NewPortfolioService(repository.NewRepositories(db, nil))
+
+	t.Run("aggregates the epics that are not archived", func(t *testing.T) {
+		portfolio, err := svc.GetPortfolio(ctx, PortfolioQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), portfolio.Epics)
+		assert.Equal(t, map[models.EpicStatus]int64{models.EpicStatusBacklog: 1, models.EpicStatusDraft: 0,
+			models.EpicStatusInProgress: 1, models.EpicStatusDone: 0, models.EpicStatusCancelled: 0}, portfolio.ByStatus)
+		assert.Equal(t, map[models.Priority]int64{models.PriorityCritical: 1, models.PriorityHigh: 1,
+			models.PriorityMedium: 0, models.PriorityLow: 0}, portfolio.ByPriority)
+
+		require.Len(t, portfolio.RecentActivity, 2)
+		assert.Equal(t, requirements[0].ID, portfolio.RecentActivity[0].EntityID)
+		assert.Equal(t, epic.ID, portfolio.RecentActivity[1].EntityID)
+
+		require.Len(t, portfolio.TopRisks, 2)
+		assert.Equal(t, "REQ-001", portfolio.TopRisks[0].ReferenceID)
+
+		require.Len(t, portfolio.UpcomingDueDates, 2)
+		assert.Equal(t, "US-003", portfolio.UpcomingDueDates[0].ReferenceID)
+		assert.Equal(t, models.EntityTypeUserStory, portfolio.UpcomingDueDates[0].EntityType)
+		assert.Equal(t, payments.ID, portfolio.UpcomingDueDates[0].EpicID)
+		assert.Equal(t, "EP-001", portfolio.UpcomingDueDates[1].ReferenceID)
+		assert.Equal(t, models.EntityTypeEpic, portfolio.UpcomingDueDates[1].EntityType)
+	})
+
+	t.Run("includes archived epics on request", func(t *testing.T) {
+		portfolio, err := svc.GetPortfolio(ctx, PortfolioQuery{IncludeArchived: true, Days: 60, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), portfolio.Epics)
+		assert.Equal(t, int64(1), portfolio.ByStatus[models.EpicStatusDone])
+		assert.Equal(t, legacy.ID, portfolio.RecentActivity[0].EntityID)
+		assert.Len(t, portfolio.RecentActivity, 2)
+		assert.Len(t, portfolio.UpcomingDueDates, 2)
+	})
+
+	t.Run("filters the epics", func(t *testing.T) {
+		portfolio, err := svc.GetPortfolio(ctx, PortfolioQuery{AssigneeID: &bob.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), portfolio.Epics)
+		assert.Empty(t, portfolio.RecentActivity)
+		assert.Empty(t, portfolio.TopRisks)
+		require.Len(t, portfolio.UpcomingDueDates, 1)
+		assert.Equal(t, "US-003", portfolio.UpcomingDueDates[0].ReferenceID)
+
+		status := models.EpicStatusBacklog
+		portfolio, err = svc.GetPortfolio(ctx, PortfolioQuery{Status: &status, Days: 60})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), portfolio.Epics)
+		require.Len(t, portfolio.UpcomingDueDates, 2)
+		assert.Equal(t, "EP-001", portfolio.UpcomingDueDates[0].ReferenceID)
+		assert.Equal(t, "US-001", portfolio.UpcomingDueDates[1].ReferenceID)
+	})
+
+	t.Run("validates the filters", func(t *testing.T) {
+		status := models.EpicStatus("Shipped")
+		_, err := svc.GetPortfolio(ctx, PortfolioQuery{Status: &status})
+		assert.ErrorIs(t, err, ErrInvalidPortfolioStatus)
+
+		priority := models.Priority(7)
+		_, err = svc.GetPortfolio(ctx, PortfolioQuery{Priority: &priority})
+		assert.ErrorIs(t, err, ErrInvalidPortfolioPriority)
+	})
+}
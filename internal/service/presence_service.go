@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+const (
+	// DefaultPresenceTTL is how long a presence heartbeat stays valid
+	DefaultPresenceTTL = 60 * time.Second
+	// DefaultEditLockTTL is the lock duration used when the client does not request one
+	DefaultEditLockTTL = 5 * time.Minute
+	// MaxEditLockTTL caps how long a single acquire/refresh can hold a lock
+	MaxEditLockTTL = 30 * time.Minute
+)
+
+var (
+	ErrInvalidPresenceMode = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid presence mode")
+	ErrEditLockHeld        = apperrors.New(apperrors.KindLocked, apperrors.CodeEntityLocked, "entity is locked for editing by another user")
+	ErrEditLockNotFound    = apperrors.New(apperrors.KindNotFound, "LOCK_NOT_FOUND", "edit lock not found")
+	ErrEditLockNotOwned    = apperrors.New(apperrors.KindForbidden, apperrors.CodeInsufficientPermissions, "edit lock is held by another user")
+)
+
+// PresenceService defines the interface for presence and soft edit lock business logic
+type PresenceService interface {
+	Heartbeat(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, mode models.PresenceMode) (*models.EntityPresence, error)
+	Leave(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) error
+	GetPresence(ctx context.Context, entityType models.EntityType, idOrReference string) (*PresenceResponse, error)
+	AcquireLock(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, ttl time.Duration) (*models.EditLock, error)
+	ReleaseLock(ctx context.Context, entityType models.EntityType, idOrReference string, currentUser *models.User) error
+	CheckEditAllowed(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, userID uuid.UUID) error
+	CleanupExpired(ctx context.Context) (int64, error)
+}
+
+// PresenceRequest represents a presence heartbeat sent by a client
+// @Description Request payload for registering or refreshing presence on an entity
+type PresenceRequest struct {
+	// Mode describes what the user is doing with the entity
+	// @Description Presence mode (viewing or editing, default: viewing)
+	// @Example "editing"
+	Mode models.PresenceMode `json:"mode,omitempty" enums:"viewing,editing"`
+}
+
+// EditLockRequest represents a request to acquire or refresh an edit lock
+// @Description Request payload for acquiring or refreshing a soft edit lock
+type EditLockRequest struct {
+	// TTLSeconds is the requested lock duration in seconds
+	// @Description Lock duration in seconds (optional, default: 300, max: 1800)
+	// @Minimum 1
+	// @Maximum 1800
+	// @Example 300
+	TTLSeconds int `json:"ttl_seconds,omitempty" binding:"omitempty,min=1,max=1800"`
+}
+
+// PresenceResponse represents the active users and edit lock for an entity
+// @Description Active viewers/editors of an entity together with its current edit lock, if any
+type PresenceResponse struct {
+	EntityType models.EntityType       `json:"entity_type" example:"requirement"`
+	EntityID   uuid.UUID               `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Users      []models.EntityPresence `json:"users"`
+	Lock       *models.EditLock        `json:"lock,omitempty"`
+}
+
+// presenceService implements PresenceService interface
+type presenceService struct {
+	presenceRepo repository.PresenceRepository
+	repos        *repository.Repositories
+	now          func() time.Time
+}
+
+// NewPresenceService creates a new presence service instance
+func NewPresenceService(repos *repository.Repositories) PresenceService {
+	return &presenceService{
+		presenceRepo: repos.Presence,
+		repos:        repos,
+		now:          func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Heartbeat registers or refreshes the user's presence on an entity
+func (s *presenceService) Heartbeat(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, mode models.PresenceMode) (*models.EntityPresence, error) {
+	if mode == "" {
+		mode = models.PresenceModeViewing
+	}
+	if mode != models.PresenceModeViewing && mode != models.PresenceModeEditing {
+		return nil, ErrInvalidPresenceMode
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	presence := &models.EntityPresence{
+		EntityType: entityType,
+		EntityID:   entityID,
+		UserID:     userID,
+		Mode:       mode,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(DefaultPresenceTTL),
+	}
+
+	if err := s.presenceRepo.UpsertPresence(ctx, presence); err != nil {
+		return nil, fmt.Errorf("failed to record presence: %w", err)
+	}
+
+	return presence, nil
+}
+
+// Leave removes the user's presence from an entity
+func (s *presenceService) Leave(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) error {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return err
+	}
+
+	if err := s.presenceRepo.DeletePresence(ctx, entityType, entityID, userID); err != nil {
+		return fmt.Errorf("failed to remove presence: %w", err)
+	}
+	return nil
+}
+
+// GetPresence returns all users currently active on an entity and its active edit lock
+func (s *presenceService) GetPresence(ctx context.Context, entityType models.EntityType, idOrReference string) (*PresenceResponse, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	presences, err := s.presenceRepo.GetActivePresence(ctx, entityType, entityID, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presence: %w", err)
+	}
+
+	response := &PresenceResponse{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Users:      presences,
+	}
+
+	lock, err := s.getActiveLock(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	response.Lock = lock
+
+	return response, nil
+}
+
+// AcquireLock acquires or refreshes a soft edit lock on an entity
+// Returns ErrEditLockHeld if another user holds an unexpired lock
+func (s *presenceService) AcquireLock(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID, ttl time.Duration) (*models.EditLock, error) {
+	if ttl <= 0 {
+		ttl = DefaultEditLockTTL
+	}
+	if ttl > MaxEditLockTTL {
+		ttl = MaxEditLockTTL
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	lock, err := s.presenceRepo.GetLock(ctx, entityType, entityID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get edit lock: %w", err)
+	}
+
+	if lock == nil {
+		lock = &models.EditLock{
+			EntityType: entityType,
+			EntityID:   entityID,
+			UserID:     userID,
+			AcquiredAt: now,
+			ExpiresAt:  now.Add(ttl),
+		}
+		if err := s.presenceRepo.CreateLock(ctx, lock); err != nil {
+			// Another user may have acquired the lock concurrently
+			if existing, getErr := s.presenceRepo.GetLock(ctx, entityType, entityID); getErr == nil && existing.UserID != userID {
+				return existing, ErrEditLockHeld
+			}
+			return nil, fmt.Errorf("failed to create edit lock: %w", err)
+		}
+		return lock, nil
+	}
+
+	if lock.UserID != userID && lock.ExpiresAt.After(now) {
+		return lock, ErrEditLockHeld
+	}
+
+	// Take over an expired lock or refresh our own
+	if lock.UserID != userID {
+		lock.UserID = userID
+		lock.User = nil
+		lock.AcquiredAt = now
+	}
+	lock.ExpiresAt = now.Add(ttl)
+
+	if err := s.presenceRepo.UpdateLock(ctx, lock); err != nil {
+		return nil, fmt.Errorf("failed to update edit lock: %w", err)
+	}
+	return lock, nil
+}
+
+// ReleaseLock releases the edit lock on an entity
+// Only the lock holder or an administrator can release an active lock
+func (s *presenceService) ReleaseLock(ctx context.Context, entityType models.EntityType, idOrReference string, currentUser *models.User) error {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return err
+	}
+
+	lock, err := s.presenceRepo.GetLock(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrEditLockNotFound
+		}
+		return fmt.Errorf("failed to get edit lock: %w", err)
+	}
+
+	if lock.UserID != currentUser.ID && lock.ExpiresAt.After(s.now()) && !currentUser.IsAdministrator() {
+		return ErrEditLockNotOwned
+	}
+
+	if err := s.presenceRepo.DeleteLock(ctx, entityType, entityID); err != nil {
+		return fmt.Errorf("failed to release edit lock: %w", err)
+	}
+	return nil
+}
+
+// CheckEditAllowed returns ErrEditLockHeld if another user holds an active lock on the entity
+func (s *presenceService) CheckEditAllowed(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, userID uuid.UUID) error {
+	lock, err := s.getActiveLock(ctx, entityType, entityID)
+	if err != nil {
+		return err
+	}
+	if lock != nil && lock.UserID != userID {
+		return ErrEditLockHeld
+	}
+	return nil
+}
+
+// CleanupExpired removes stale presence records and expired edit locks
+func (s *presenceService) CleanupExpired(ctx context.Context) (int64, error) {
+	removed, err := s.presenceRepo.DeleteExpired(ctx, s.now())
+	if err != nil {
+		return removed, fmt.Errorf("failed to clean up expired presence: %w", err)
+	}
+	return removed, nil
+}
+
+// getActiveLock returns the entity's lock if it exists and has not expired
+func (s *presenceService) getActiveLock(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.EditLock, error) {
+	lock, err := s.presenceRepo.GetLock(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get edit lock: %w", err)
+	}
+	if !lock.ExpiresAt.After(s.now()) {
+		return nil, nil
+	}
+	return lock, nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// MockPresenceRepository is a mock implementation of PresenceRepository
+type MockPresenceRepository struct {
+	mock.Mock
+}
+
+func (m *MockPresenceRepository) UpsertPresence(ctx context.Context, presence *models.EntityPresence) error {
+	args := m.Called(presence)
+	return args.Error(0)
+}
+
+func (m *MockPresenceRepository) DeletePresence(ctx context.Context, entityType models.EntityType, entityID, userID uuid.UUID) error {
+	args := m.Called(entityType, entityID, userID)
+	return args.Error(0)
+}
+
+func (m *MockPresenceRepository) GetActivePresence(ctx context.Context, entityType models.EntityType, entityID uuid.UUID, now time.Time) ([]models.EntityPresence, error) {
+	args := m.Called(entityType, entityID, now)
+	return args.Get(0).([]models.EntityPresence), args.Error(1)
+}
+
+func (m *MockPresenceRepository) GetLock(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) (*models.EditLock, error) {
+	args := m.Called(entityType, entityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.EditLock), args.Error(1)
+}
+
+func (m *MockPresenceRepository) CreateLock(ctx context.Context, lock *models.EditLock) error {
+	args := m.Called(lock)
+	return args.Error(0)
+}
+
+func (m *MockPresenceRepository) UpdateLock(ctx context.Context, lock *models.EditLock) error {
+	args := m.Called(lock)
+	return args.Error(0)
+}
+
+func (m *MockPresenceRepository) DeleteLock(ctx context.Context, entityType models.EntityType, entityID uuid.UUID) error {
+	args := m.Called(entityType, entityID)
+	return args.Error(0)
+}
+
+func (m *MockPresenceRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockPresenceRepository) GetDB() *gorm.DB {
+	return nil
+}
+
+func newTestPresenceService(presenceRepo *MockPresenceRepository, requirementRepo *MockRequirementRepository, now time.Time) *presenceService {
+	repos := &repository.Repositories{
+		Presence:    presenceRepo,
+		Requirement: requirementRepo,
+	}
+	return &presenceService{
+		presenceRepo: presenceRepo,
+		repos:        repos,
+		now:          func() time.Time { return now },
+	}
+}
+
+func TestPresenceService_Heartbeat(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	entityID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("defaults to viewing mode", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		requirementRepo := new(MockRequirementRepository)
+		svc := newTestPresenceService(presenceRepo, requirementRepo, now)
+
+		requirementRepo.On("Exists", entityID).Return(true, nil)
+		presenceRepo.On("UpsertPresence", mock.AnythingOfType("*models.EntityPresence")).Return(nil)
+
+		presence, err := svc.Heartbeat(ctx, models.EntityTypeRequirement, entityID.String(), userID, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.PresenceModeViewing, presence.Mode)
+		assert.Equal(t, now.Add(DefaultPresenceTTL), presence.ExpiresAt)
+		presenceRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown mode", func(t *testing.T) {
+		svc := newTestPresenceService(new(MockPresenceRepository), new(MockRequirementRepository), now)
+
+		_, err := svc.Heartbeat(ctx, models.EntityTypeRequirement, entityID.String(), userID, "typing")
+
+		assert.ErrorIs(t, err, ErrInvalidPresenceMode)
+	})
+
+	t.Run("entity not found", func(t *testing.T) {
+		requirementRepo := new(MockRequirementRepository)
+		svc := newTestPresenceService(new(MockPresenceRepository), requirementRepo, now)
+
+		requirementRepo.On("Exists", entityID).Return(false, nil)
+
+		_, err := svc.Heartbeat(ctx, models.EntityTypeRequirement, entityID.String(), userID, models.PresenceModeEditing)
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestPresenceService_AcquireLock(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	entityID := uuid.New()
+	userID := uuid.New()
+	otherUserID := uuid.New()
+
+	t.Run("creates new lock with default TTL", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		requirementRepo := new(MockRequirementRepository)
+		svc := newTestPresenceService(presenceRepo, requirementRepo, now)
+
+		requirementRepo.On("Exists", entityID).Return(true, nil)
+		presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(nil, repository.ErrNotFound)
+		presenceRepo.On("CreateLock", mock.AnythingOfType("*models.EditLock")).Return(nil)
+
+		lock, err := svc.AcquireLock(ctx, models.EntityTypeRequirement, entityID.String(), userID, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, userID, lock.UserID)
+		assert.Equal(t, now.Add(DefaultEditLockTTL), lock.ExpiresAt)
+	})
+
+	t.Run("rejects when held by another user", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		requirementRepo := new(MockRequirementRepository)
+		svc := newTestPresenceService(presenceRepo, requirementRepo, now)
+
+		existing := &models.EditLock{EntityType: models.EntityTypeRequirement, EntityID: entityID, UserID: otherUserID, ExpiresAt: now.Add(time.Minute)}
+		requirementRepo.On("Exists", entityID).Return(true, nil)
+		presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(existing, nil)
+
+		lock, err := svc.AcquireLock(ctx, models.EntityTypeRequirement, entityID.String(), userID, time.Minute)
+
+		assert.ErrorIs(t, err, ErrEditLockHeld)
+		assert.Equal(t, otherUserID, lock.UserID)
+		presenceRepo.AssertNotCalled(t, "UpdateLock", mock.Anything)
+	})
+
+	t.Run("takes over expired lock and caps TTL", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		requirementRepo := new(MockRequirementRepository)
+		svc := newTestPresenceService(presenceRepo, requirementRepo, now)
+
+		existing := &models.EditLock{EntityType: models.EntityTypeRequirement, EntityID: entityID, UserID: otherUserID, ExpiresAt: now.Add(-time.Second)}
+		requirementRepo.On("Exists", entityID).Return(true, nil)
+		presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(existing, nil)
+		presenceRepo.On("UpdateLock", existing).Return(nil)
+
+		lock, err := svc.AcquireLock(ctx, models.EntityTypeRequirement, entityID.String(), userID, 2*time.Hour)
+
+		assert.NoError(t, err)
+		assert.Equal(t, userID, lock.UserID)
+		assert.Equal(t, now, lock.AcquiredAt)
+		assert.Equal(t, now.Add(MaxEditLockTTL), lock.ExpiresAt)
+	})
+}
+
+func TestPresenceService_ReleaseLock(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	entityID := uuid.New()
+	holder := &models.User{ID: uuid.New(), Role: models.RoleUser}
+	other := &models.User{ID: uuid.New(), Role: models.RoleUser}
+	admin := &models.User{ID: uuid.New(), Role: models.RoleAdministrator}
+
+	tests := []struct {
+		name        string
+		currentUser *models.User
+		expectedErr error
+	}{
+		{name: "holder can release", currentUser: holder},
+		{name: "administrator can release", currentUser: admin},
+		{name: "other user cannot release", currentUser: other, expectedErr: ErrEditLockNotOwned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			presenceRepo := new(MockPresenceRepository)
+			requirementRepo := new(MockRequirementRepository)
+			svc := newTestPresenceService(presenceRepo, requirementRepo, now)
+
+			lock := &models.EditLock{EntityType: models.EntityTypeRequirement, EntityID: entityID, UserID: holder.ID, ExpiresAt: now.Add(time.Minute)}
+			requirementRepo.On("Exists", entityID).Return(true, nil)
+			presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(lock, nil)
+			presenceRepo.On("DeleteLock", models.EntityTypeRequirement, entityID).Return(nil)
+
+			err := svc.ReleaseLock(ctx, models.EntityTypeRequirement, entityID.String(), tt.currentUser)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				presenceRepo.AssertNotCalled(t, "DeleteLock", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPresenceService_CheckEditAllowed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	entityID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("allowed when unlocked", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		svc := newTestPresenceService(presenceRepo, new(MockRequirementRepository), now)
+		presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(nil, repository.ErrNotFound)
+
+		assert.NoError(t, svc.CheckEditAllowed(ctx, models.EntityTypeRequirement, entityID, userID))
+	})
+
+	t.Run("allowed for lock holder", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		svc := newTestPresenceService(presenceRepo, new(MockRequirementRepository), now)
+		presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(&models.EditLock{UserID: userID, ExpiresAt: now.Add(time.Minute)}, nil)
+
+		assert.NoError(t, svc.CheckEditAllowed(ctx, models.EntityTypeRequirement, entityID, userID))
+	})
+
+	t.Run("rejected when locked by another user", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		svc := newTestPresenceService(presenceRepo, new(MockRequirementRepository), now)
+		presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(&models.EditLock{UserID: uuid.New(), ExpiresAt: now.Add(time.Minute)}, nil)
+
+		assert.ErrorIs(t, svc.CheckEditAllowed(ctx, models.EntityTypeRequirement, entityID, userID), ErrEditLockHeld)
+	})
+
+	t.Run("expired lock is ignored", func(t *testing.T) {
+		presenceRepo := new(MockPresenceRepository)
+		svc := newTestPresenceService(presenceRepo, new(MockRequirementRepository), now)
+		presenceRepo.On("GetLock", models.EntityTypeRequirement, entityID).Return(&models.EditLock{UserID: uuid.New(), ExpiresAt: now.Add(-time.Minute)}, nil)
+
+		assert.NoError(t, svc.CheckEditAllowed(ctx, models.EntityTypeRequirement, entityID, userID))
+	})
+}
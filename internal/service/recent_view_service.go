@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+const (
+	// DefaultRecentItemsLimit is the number of recent items returned when no limit is given
+	DefaultRecentItemsLimit = 20
+	// MaxRecentItems is the number of recent views kept per user
+	MaxRecentItems = 50
+)
+
+// RecentViewService defines the interface for tracking the entities a user viewed recently
+type RecentViewService interface {
+	RecordView(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) (*RecentItem, error)
+	ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]RecentItem, error)
+}
+
+// RecentItem is an entity the user viewed recently
+// @Description Recently viewed entity; acceptance criteria use the start of their description as title
+type RecentItem struct {
+	EntityType  models.EntityType `json:"entity_type" example:"requirement"`
+	EntityID    uuid.UUID         `json:"entity_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string            `json:"reference_id" example:"REQ-001"`
+	Title       string            `json:"title" example:"User authentication must support OAuth 2.0"`
+	ViewedAt    time.Time         `json:"viewed_at" example:"2023-01-01T10:00:00Z"`
+}
+
+// recentViewService implements RecentViewService interface
+type recentViewService struct {
+	recentViewRepo repository.RecentViewRepository
+	repos          *repository.Repositories
+	now            func() time.Time
+}
+
+// NewRecentViewService creates a new recent view service instance
+func NewRecentViewService(repos *repository.Repositories) RecentViewService {
+	return &recentViewService{
+		recentViewRepo: repos.RecentView,
+		repos:          repos,
+		now:            time.Now,
+	}
+}
+
+// RecordView records that the user viewed an entity, keeping only the user's latest MaxRecentItems views
+func (s *recentViewService) RecordView(ctx context.Context, entityType models.EntityType, idOrReference string, userID uuid.UUID) (*RecentItem, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	text, err := loadEntityText(ctx, s.repos, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &models.RecentView{
+		EntityType: entityType,
+		EntityID:   entityID,
+		UserID:     userID,
+		ViewedAt:   s.now().UTC(),
+	}
+	if err := s.recentViewRepo.Upsert(ctx, view); err != nil {
+		return nil, fmt.Errorf("failed to record view: %w", err)
+	}
+	if err := s.recentViewRepo.DeleteAllButLatest(ctx, userID, MaxRecentItems); err != nil {
+		return nil, fmt.Errorf("failed to prune recent views: %w", err)
+	}
+
+	return newRecentItem(view, text), nil
+}
+
+// ListRecent returns the distinct entities the user viewed most recently, newest first.
+// Entities deleted since they were viewed are left out.
+func (s *recentViewService) ListRecent(ctx context.Context, userID uuid.UUID, limit int) ([]RecentItem, error) {
+	if limit <= 0 {
+		limit = DefaultRecentItemsLimit
+	}
+	if limit > MaxRecentItems {
+		limit = MaxRecentItems
+	}
+
+	views, err := s.recentViewRepo.ListByUser(ctx, userID, MaxRecentItems)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent views: %w", err)
+	}
+
+	items := make([]RecentItem, 0, limit)
+	for i := range views {
+		if len(items) == limit {
+			break
+		}
+		text, err := loadEntityText(ctx, s.repos, views[i].EntityType, views[i].EntityID)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidEntityType) {
+				continue
+			}
+			return nil, err
+		}
+		items = append(items, *newRecentItem(&views[i], text))
+	}
+	return items, nil
+}
+
+// newRecentItem builds a recent item from a view and the viewed entity's text
+func newRecentItem(view *models.RecentView, text *entityText) *RecentItem {
+	return &RecentItem{
+		EntityType:  view.EntityType,
+		EntityID:    view.EntityID,
+		ReferenceID: text.ReferenceID,
+		Title:       text.displayTitle(),
+		ViewedAt:    view.ViewedAt,
+	}
+}
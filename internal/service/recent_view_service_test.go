@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestRecentViewService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.AcceptanceCriteria{}, &models.Requirement{}, &models.RecentView{}))
+
+	user := &models.User{Username: "viewer", Email: "viewer@example.com", PasswordHash: "hash", Role: models.RoleCommenter}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "User Authentication", Status: models.EpicStatusBacklog}
+	requirement := models.Requirement{ID: uuid.New(), ReferenceID: "REQ-001", Title: "Support OAuth 2.0"}
+	acceptanceCriteria := models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", Description: "WHEN a user logs in THEN the system SHALL   issue a token"}
+	require.NoError(t, session.Create(&epic).Error)
+	require.NoError(t, session.Create(&requirement).Error)
+	require.NoError(t, session.Create(&acceptanceCriteria).Error)
+
+	svc := NewRecentViewService(repository.NewRepositories(db, nil)).(*recentViewService)
+	clock := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time {
+		clock = clock.Add(time.Minute)
+		return clock
+	}
+
+	item, err := svc.RecordView(ctx, models.EntityTypeEpic, "EP-001", user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, epic.ID, item.EntityID)
+	assert.Equal(t, "User Authentication", item.Title)
+
+	_, err = svc.RecordView(ctx, models.EntityTypeRequirement, requirement.ID.String(), user.ID)
+	require.NoError(t, err)
+	_, err = svc.RecordView(ctx, models.EntityTypeAcceptanceCriteria, "AC-001", user.ID)
+	require.NoError(t, err)
+	_, err = svc.RecordView(ctx, models.EntityTypeEpic, epic.ID.String(), user.ID)
+	require.NoError(t, err)
+
+	_, err = svc.RecordView(ctx, models.EntityTypeRequirement, "REQ-404", user.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	t.Run("distinct entities newest first", func(t *testing.T) {
+		items, err := svc.ListRecent(ctx, user.ID, 0)
+		require.NoError(t, err)
+		require.Len(t, items, 3)
+		assert.Equal(t, []string{"EP-001", "AC-001", "REQ-001"}, []string{items[0].ReferenceID, items[1].ReferenceID, items[2].ReferenceID})
+		assert.Equal(t, "WHEN a user logs in THEN the system SHALL issue a token", items[1].Title)
+		assert.True(t, items[0].ViewedAt.After(items[1].ViewedAt))
+
+		items, err = svc.ListRecent(ctx, user.ID, 1)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+	})
+
+	t.Run("deleted entities are skipped", func(t *testing.T) {
+		require.NoError(t, db.Delete(&models.Requirement{}, "id = ?", requirement.ID).Error)
+		items, err := svc.ListRecent(ctx, user.ID, 10)
+		require.NoError(t, err)
+		assert.Len(t, items, 2)
+	})
+
+	t.Run("keeps only the latest views", func(t *testing.T) {
+		for i := 0; i < MaxRecentItems; i++ {
+			require.NoError(t, session.Create(&models.Epic{ID: uuid.New(), ReferenceID: fmt.Sprintf("EP-%03d", i+100), Title: "Epic", Status: models.EpicStatusBacklog}).Error)
+		}
+		var epics []models.Epic
+		require.NoError(t, db.Where("reference_id <> ?", "EP-001").Find(&epics).Error)
+		for _, e := range epics {
+			_, err := svc.RecordView(ctx, models.EntityTypeEpic, e.ID.String(), user.ID)
+			require.NoError(t, err)
+		}
+
+		var count int64
+		require.NoError(t, db.Model(&models.RecentView{}).Where("user_id = ?", user.ID).Count(&count).Error)
+		assert.Equal(t, int64(MaxRecentItems), count)
+	})
+}
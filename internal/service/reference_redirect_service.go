@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// maxRedirectReferenceIDLength is the longest reference ID that can be redirected
+const maxRedirectReferenceIDLength = 50
+
+var (
+	ErrReferenceRedirectNotFound  = apperrors.New(apperrors.KindNotFound, "REFERENCE_REDIRECT_NOT_FOUND", "reference redirect not found")
+	ErrRedirectReferenceIDInvalid = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, fmt.Sprintf("reference_id is required and must not exceed %d characters", maxRedirectReferenceIDLength))
+	ErrRedirectEntityType         = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "entity_type must be one of: epic, user_story, acceptance_criteria, requirement")
+	ErrRedirectTargetNotFound     = apperrors.New(apperrors.KindNotFound, "REDIRECT_TARGET_NOT_FOUND", "successor entity not found")
+	ErrReferenceIDInUse           = apperrors.New(apperrors.KindConflict, "REFERENCE_ID_IN_USE", "reference ID belongs to an existing entity")
+	ErrReferenceRedirectExists    = apperrors.New(apperrors.KindConflict, "REFERENCE_REDIRECT_EXISTS", "reference ID is already redirected")
+)
+
+// ReferenceRedirectService defines the interface for managing the redirects of retired reference IDs.
+// Redirects of merged epics are recorded by the merge; administrators add redirects for migrated entities.
+type ReferenceRedirectService interface {
+	CreateRedirect(ctx context.Context, req CreateReferenceRedirectRequest, userID uuid.UUID) (*models.ReferenceRedirect, error)
+	ListRedirects(ctx context.Context, entityType *models.EntityType, limit, offset int) ([]models.ReferenceRedirect, int64, error)
+	DeleteRedirect(ctx context.Context, referenceID string) error
+}
+
+// CreateReferenceRedirectRequest points a retired reference ID to its successor
+type CreateReferenceRedirectRequest struct {
+	ReferenceID string            `json:"reference_id" binding:"required" example:"REQ-104"`    // Retired reference ID, such as one of a migrated entity
+	EntityType  models.EntityType `json:"entity_type" binding:"required" example:"requirement"` // Type of the successor
+	Target      string            `json:"target" binding:"required" example:"REQ-212"`          // UUID or reference ID of the successor
+}
+
+// referenceRedirectService implements ReferenceRedirectService interface
+type referenceRedirectService struct {
+	repos *repository.Repositories
+}
+
+// NewReferenceRedirectService creates a new reference redirect service instance
+func NewReferenceRedirectService(repos *repository.Repositories) ReferenceRedirectService {
+	return &referenceRedirectService{repos: repos}
+}
+
+// CreateRedirect records a migrated reference ID. A target that is itself a retired reference ID resolves
+// to its successor, so redirects never chain.
+func (s *referenceRedirectService) CreateRedirect(ctx context.Context, req CreateReferenceRedirectRequest, userID uuid.UUID) (*models.ReferenceRedirect, error) {
+	referenceID := normalizeReferenceID(req.ReferenceID)
+	if referenceID == "" || len(referenceID) > maxRedirectReferenceIDLength {
+		return nil, ErrRedirectReferenceIDInvalid
+	}
+
+	target := strings.TrimSpace(req.Target)
+	targetID, err := resolveEntityID(ctx, s.repos, req.EntityType, target)
+	if errors.Is(err, ErrNotFound) {
+		targetID, err = resolveRedirectedID(ctx, s.repos, req.EntityType, target)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidEntityType):
+			return nil, ErrRedirectEntityType
+		case errors.Is(err, ErrNotFound):
+			return nil, ErrRedirectTargetNotFound
+		}
+		return nil, err
+	}
+
+	matches, err := s.repos.Reference.FindByReferenceIDs(ctx, []string{referenceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check reference ID: %w", err)
+	}
+	if len(matches) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrReferenceIDInUse, referenceID)
+	}
+	if _, err := s.repos.ReferenceRedirect.GetByReferenceID(ctx, referenceID); err == nil {
+		return nil, fmt.Errorf("%w: %s", ErrReferenceRedirectExists, referenceID)
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get reference redirect: %w", err)
+	}
+
+	redirect := &models.ReferenceRedirect{
+		ReferenceID: referenceID,
+		EntityType:  req.EntityType,
+		EntityID:    targetID,
+		Reason:      models.RedirectReasonMigrated,
+		CreatedBy:   &userID,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := s.repos.ReferenceRedirect.Create(ctx, redirect); err != nil {
+		return nil, fmt.Errorf("failed to create reference redirect: %w", err)
+	}
+	return redirect, nil
+}
+
+// ListRedirects returns the redirects, optionally of one entity type, newest first
+func (s *referenceRedirectService) ListRedirects(ctx context.Context, entityType *models.EntityType, limit, offset int) ([]models.ReferenceRedirect, int64, error) {
+	if entityType != nil {
+		switch *entityType {
+		case models.EntityTypeEpic, models.EntityTypeUserStory, models.EntityTypeAcceptanceCriteria, models.EntityTypeRequirement:
+		default:
+			return nil, 0, ErrRedirectEntityType
+		}
+	}
+	redirects, total, err := s.repos.ReferenceRedirect.List(ctx, entityType, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list reference redirects: %w", err)
+	}
+	return redirects, total, nil
+}
+
+// DeleteRedirect removes the redirect of a reference ID, which then no longer resolves
+func (s *referenceRedirectService) DeleteRedirect(ctx context.Context, referenceID string) error {
+	if err := s.repos.ReferenceRedirect.Delete(ctx, normalizeReferenceID(referenceID)); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrReferenceRedirectNotFound
+		}
+		return fmt.Errorf("failed to delete reference redirect: %w", err)
+	}
+	return nil
+}
+
+// normalizeReferenceID trims and upper-cases a reference ID the way reference IDs are stored
+func normalizeReferenceID(referenceID string) string {
+	return strings.ToUpper(strings.TrimSpace(referenceID))
+}
+
+// getRedirected gets the successor of a retired reference ID of an entity type. It returns notFound
+// when the reference ID is not redirected, is redirected to another entity type or the successor is gone.
+func getRedirected[T any](ctx context.Context, redirects repository.ReferenceRedirectRepository, entityType models.EntityType, referenceID string, notFound error, get func(ctx context.Context, id uuid.UUID) (*T, error)) (*T, error) {
+	if redirects == nil {
+		return nil, notFound
+	}
+	redirect, err := redirects.GetByReferenceID(ctx, normalizeReferenceID(referenceID))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, notFound
+		}
+		return nil, fmt.Errorf("failed to get reference redirect: %w", err)
+	}
+	if redirect.EntityType != entityType {
+		return nil, notFound
+	}
+	return get(ctx, redirect.EntityID)
+}
+
+// resolveRedirectedID resolves a retired reference ID to the UUID of its successor of the entity type
+func resolveRedirectedID(ctx context.Context, repos *repository.Repositories, entityType models.EntityType, referenceID string) (uuid.UUID, error) {
+	successor, err := getRedirected(ctx, repos.ReferenceRedirect, entityType, referenceID, ErrNotFound, func(ctx context.Context, id uuid.UUID) (*uuid.UUID, error) {
+		if err := checkEntityExists(ctx, repos, entityType, id); err != nil {
+			return nil, err
+		}
+		return &id, nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return *successor, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestReferenceRedirectService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, alice, _, requirements := setupSupersessionTest(t, active, active)
+	require.NoError(t, db.AutoMigrate(&models.RequirementType{}, &models.ReferenceRedirect{}))
+	repos := repository.NewRepositories(db, nil)
+	svc := NewReferenceRedirectService(repos)
+	requirementService := NewEntityServices(repos).Requirement
+
+	t.Run("redirects a migrated reference ID", func(t *testing.T) {
+		redirect, err := svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: " req-104 ", EntityType: models.EntityTypeRequirement, Target: "REQ-002"}, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "REQ-104", redirect.ReferenceID)
+		assert.Equal(t, requirements[1].ID, redirect.EntityID)
+		assert.Equal(t, models.RedirectReasonMigrated, redirect.Reason)
+
+		requirement, err := requirementService.GetRequirementByReferenceID(ctx, "REQ-104")
+		require.NoError(t, err)
+		assert.Equal(t, "REQ-002", requirement.ReferenceID)
+	})
+
+	t.Run("flattens redirects to retired reference IDs", func(t *testing.T) {
+		redirect, err := svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: "REQ-103", EntityType: models.EntityTypeRequirement, Target: "REQ-104"}, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, requirements[1].ID, redirect.EntityID)
+	})
+
+	t.Run("rejects invalid redirects", func(t *testing.T) {
+		_, err := svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: "REQ-001", EntityType: models.EntityTypeRequirement, Target: "REQ-002"}, alice.ID)
+		assert.ErrorIs(t, err, ErrReferenceIDInUse)
+		_, err = svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: "REQ-104", EntityType: models.EntityTypeRequirement, Target: "REQ-001"}, alice.ID)
+		assert.ErrorIs(t, err, ErrReferenceRedirectExists)
+		_, err = svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: "REQ-105", EntityType: models.EntityTypeRequirement, Target: uuid.NewString()}, alice.ID)
+		assert.ErrorIs(t, err, ErrRedirectTargetNotFound)
+		_, err = svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: "REQ-105", EntityType: models.EntityTypeUserStory, Target: "REQ-002"}, alice.ID)
+		assert.ErrorIs(t, err, ErrRedirectTargetNotFound)
+		_, err = svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: "REQ-105", EntityType: models.EntityType("document"), Target: "REQ-002"}, alice.ID)
+		assert.ErrorIs(t, err, ErrRedirectEntityType)
+		_, err = svc.CreateRedirect(ctx, CreateReferenceRedirectRequest{ReferenceID: " ", EntityType: models.EntityTypeRequirement, Target: "REQ-002"}, alice.ID)
+		assert.ErrorIs(t, err, ErrRedirectReferenceIDInvalid)
+	})
+
+	t.Run("lists and deletes redirects", func(t *testing.T) {
+		epic := models.EntityTypeEpic
+		redirects, total, err := svc.ListRedirects(ctx, &epic, 50, 0)
+		require.NoError(t, err)
+		assert.Empty(t, redirects)
+		assert.Zero(t, total)
+		redirects, total, err = svc.ListRedirects(ctx, nil, 50, 0)
+		require.NoError(t, err)
+		assert.Len(t, redirects, 2)
+		assert.Equal(t, int64(2), total)
+
+		require.NoError(t, svc.DeleteRedirect(ctx, "req-104"))
+		assert.ErrorIs(t, svc.DeleteRedirect(ctx, "REQ-104"), ErrReferenceRedirectNotFound)
+		_, err = requirementService.GetRequirementByReferenceID(ctx, "REQ-104")
+		assert.ErrorIs(t, err, ErrRequirementNotFound)
+	})
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// MaxResolveReferenceIDs is the largest number of reference IDs resolved in one request
+const MaxResolveReferenceIDs = 500
+
+var (
+	ErrNoReferenceIDs      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "at least one reference ID is required")
+	ErrTooManyReferenceIDs = fmt.Errorf("at most %d reference IDs can be resolved at once", MaxResolveReferenceIDs)
+)
+
+// ReferenceService defines the interface for resolving reference IDs of any entity type
+type ReferenceService interface {
+	Resolve(ctx context.Context, referenceIDs []string) (*ResolveResult, error)
+}
+
+// ResolvedReference is the entity a reference ID resolved to
+// @Description Entity matching a reference ID; only reference_id and found are set when nothing matched
+type ResolvedReference struct {
+	ReferenceID string     `json:"reference_id" example:"US-001"`
+	Found       bool       `json:"found" example:"true"`
+	EntityType  string     `json:"entity_type,omitempty" example:"user_story"`
+	ID          *uuid.UUID `json:"id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title       string     `json:"title,omitempty" example:"User login"`
+	Status      string     `json:"status,omitempty" example:"Backlog"`
+	RedirectTo  string     `json:"redirect_to,omitempty" example:"US-014"` // Current reference ID when the requested one is retired
+}
+
+// ResolveResult lists the resolved reference IDs in request order
+// @Description Reference IDs in request order (duplicates removed) with found and not found counts
+type ResolveResult struct {
+	Results  []ResolvedReference `json:"results"`
+	Found    int                 `json:"found" example:"2"`
+	NotFound int                 `json:"not_found" example:"1"`
+}
+
+// referenceService implements ReferenceService interface
+type referenceService struct {
+	repos *repository.Repositories
+}
+
+// NewReferenceService creates a new reference service instance
+func NewReferenceService(repos *repository.Repositories) ReferenceService {
+	return &referenceService{repos: repos}
+}
+
+// Resolve looks up epics, user stories, requirements, acceptance criteria and steering documents
+// by reference ID in one pass. Reference IDs are matched case-insensitively; retired reference IDs
+// resolve to their successor with redirect_to set, and unknown prefixes and missing entities are
+// reported as not found.
+func (s *referenceService) Resolve(ctx context.Context, referenceIDs []string) (*ResolveResult, error) {
+	normalized := make([]string, 0, len(referenceIDs))
+	seen := make(map[string]bool)
+	for _, referenceID := range referenceIDs {
+		referenceID = strings.ToUpper(strings.TrimSpace(referenceID))
+		if referenceID == "" || seen[referenceID] {
+			continue
+		}
+		seen[referenceID] = true
+		normalized = append(normalized, referenceID)
+	}
+	if len(normalized) == 0 {
+		return nil, ErrNoReferenceIDs
+	}
+	if len(normalized) > MaxResolveReferenceIDs {
+		return nil, ErrTooManyReferenceIDs
+	}
+
+	matches, err := s.repos.Reference.FindByReferenceIDs(ctx, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve reference IDs: %w", err)
+	}
+	byReference := make(map[string]repository.ReferenceMatch, len(matches))
+	for _, match := range matches {
+		byReference[match.ReferenceID] = match
+	}
+	if err := s.followRedirects(ctx, normalized, byReference); err != nil {
+		return nil, err
+	}
+
+	result := &ResolveResult{Results: make([]ResolvedReference, 0, len(normalized))}
+	for _, referenceID := range normalized {
+		match, ok := byReference[referenceID]
+		if !ok {
+			result.Results = append(result.Results, ResolvedReference{ReferenceID: referenceID})
+			result.NotFound++
+			continue
+		}
+
+		id := match.ID
+		title := match.Title
+		if match.EntityType == string(models.EntityTypeAcceptanceCriteria) {
+			title = (&entityText{Description: match.Title}).displayTitle()
+		}
+		resolved := ResolvedReference{
+			ReferenceID: referenceID,
+			Found:       true,
+			EntityType:  match.EntityType,
+			ID:          &id,
+			Title:       title,
+			Status:      match.Status,
+		}
+		if match.ReferenceID != referenceID {
+			resolved.RedirectTo = match.ReferenceID
+		}
+		result.Results = append(result.Results, resolved)
+		result.Found++
+	}
+	return result, nil
+}
+
+// followRedirects adds the successors of the retired reference IDs among the ones that did not match
+// to byReference, keyed by the retired reference ID
+func (s *referenceService) followRedirects(ctx context.Context, referenceIDs []string, byReference map[string]repository.ReferenceMatch) error {
+	var missing []string
+	for _, referenceID := range referenceIDs {
+		if _, ok := byReference[referenceID]; !ok {
+			missing = append(missing, referenceID)
+		}
+	}
+	if len(missing) == 0 || s.repos.ReferenceRedirect == nil {
+		return nil
+	}
+
+	redirects, err := s.repos.ReferenceRedirect.ListByReferenceIDs(ctx, missing)
+	if err != nil {
+		return fmt.Errorf("failed to list reference redirects: %w", err)
+	}
+	idsByType := make(map[models.EntityType][]uuid.UUID)
+	for _, redirect := range redirects {
+		idsByType[redirect.EntityType] = append(idsByType[redirect.EntityType], redirect.EntityID)
+	}
+	successors := make(map[uuid.UUID]repository.ReferenceMatch)
+	for entityType, ids := range idsByType {
+		matches, err := s.repos.Reference.FindByIDs(ctx, string(entityType), ids)
+		if err != nil {
+			return fmt.Errorf("failed to resolve reference redirects: %w", err)
+		}
+		for _, match := range matches {
+			successors[match.ID] = match
+		}
+	}
+	for _, redirect := range redirects {
+		if successor, ok := successors[redirect.EntityID]; ok && successor.EntityType == string(redirect.EntityType) {
+			byReference[redirect.ReferenceID] = successor
+		}
+	}
+	return nil
+}
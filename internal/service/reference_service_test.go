@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestReferenceService_Resolve(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.SteeringDocument{}, &models.ReferenceRedirect{}))
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", Status: models.EpicStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(epic).Error)
+	story := &models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: epic.ID, Title: "Pay by card", Status: models.UserStoryStatusInProgress, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(story).Error)
+	criteria := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: story.ID, AuthorID: user.ID, Description: "WHEN the card is declined THEN the system SHALL " + strings.Repeat("explain why ", 20)}
+	require.NoError(t, session.Create(criteria).Error)
+	document := &models.SteeringDocument{ID: uuid.New(), ReferenceID: "STD-001", Title: "Coding standards", CreatorID: user.ID}
+	require.NoError(t, session.Create(document).Error)
+
+	require.NoError(t, db.Create(&models.ReferenceRedirect{ReferenceID: "US-000", EntityType: models.EntityTypeUserStory, EntityID: story.ID, Reason: models.RedirectReasonMigrated}).Error)
+	require.NoError(t, db.Create(&models.ReferenceRedirect{ReferenceID: "EP-000", EntityType: models.EntityTypeEpic, EntityID: uuid.New(), Reason: models.RedirectReasonMerged}).Error)
+
+	svc := NewReferenceService(repository.NewRepositories(db, nil))
+
+	t.Run("resolves mixed types in request order", func(t *testing.T) {
+		result, err := svc.Resolve(ctx, []string{"us-001", "EP-001", "REQ-404", "AC-001", "EP-001", "STD-001", "FOO-1"})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 6)
+		assert.Equal(t, 4, result.Found)
+		assert.Equal(t, 2, result.NotFound)
+
+		assert.Equal(t, "US-001", result.Results[0].ReferenceID)
+		assert.True(t, result.Results[0].Found)
+		assert.Equal(t, "user_story", result.Results[0].EntityType)
+		assert.Equal(t, story.ID, *result.Results[0].ID)
+		assert.Equal(t, "Pay by card", result.Results[0].Title)
+		assert.Equal(t, string(models.UserStoryStatusInProgress), result.Results[0].Status)
+
+		assert.Equal(t, "epic", result.Results[1].EntityType)
+		assert.Equal(t, epic.ID, *result.Results[1].ID)
+
+		assert.Equal(t, ResolvedReference{ReferenceID: "REQ-404"}, result.Results[2])
+
+		assert.Equal(t, "acceptance_criteria", result.Results[3].EntityType)
+		assert.True(t, strings.HasPrefix(result.Results[3].Title, "WHEN the card is declined"))
+		assert.LessOrEqual(t, len([]rune(result.Results[3].Title)), displayTitleLength)
+		assert.Empty(t, result.Results[3].Status)
+
+		assert.Equal(t, "steering_document", result.Results[4].EntityType)
+		assert.Equal(t, ResolvedReference{ReferenceID: "FOO-1"}, result.Results[5])
+	})
+
+	t.Run("resolves retired reference IDs to their successor", func(t *testing.T) {
+		result, err := svc.Resolve(ctx, []string{"us-000", "US-001", "EP-000"})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 3)
+		assert.Equal(t, 2, result.Found)
+
+		assert.Equal(t, "US-000", result.Results[0].ReferenceID)
+		assert.Equal(t, "US-001", result.Results[0].RedirectTo)
+		assert.Equal(t, story.ID, *result.Results[0].ID)
+		assert.Empty(t, result.Results[1].RedirectTo)
+		// The successor of EP-000 is gone
+		assert.Equal(t, ResolvedReference{ReferenceID: "EP-000"}, result.Results[2])
+	})
+
+	t.Run("rejects empty and oversized requests", func(t *testing.T) {
+		_, err := svc.Resolve(ctx, []string{" ", ""})
+		assert.ErrorIs(t, err, ErrNoReferenceIDs)
+
+		referenceIDs := make([]string, MaxResolveReferenceIDs+1)
+		for i := range referenceIDs {
+			referenceIDs[i] = "EP-" + uuid.NewString()
+		}
+		_, err = svc.Resolve(ctx, referenceIDs)
+		assert.ErrorIs(t, err, ErrTooManyReferenceIDs)
+	})
+}
@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Relationship graph export formats
+const (
+	RelationshipGraphFormatDOT     = "dot"
+	RelationshipGraphFormatMermaid = "mermaid"
+)
+
+// Neighborhood depth limits of requirement relationship graphs
+const (
+	DefaultRelationshipGraphDepth = 1
+	MaxRelationshipGraphDepth     = 3
+)
+
+var (
+	ErrInvalidGraphFormat = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "format must be one of: dot, mermaid")
+	ErrInvalidGraphDepth  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "depth must be between 1 and 3")
+)
+
+// RelationshipGraphService defines the interface for exporting requirement relationship graphs as diagrams
+type RelationshipGraphService interface {
+	EpicGraph(ctx context.Context, epicIDOrRef string) (*RelationshipGraph, error)
+	RequirementGraph(ctx context.Context, requirementIDOrRef string, depth int) (*RelationshipGraph, error)
+}
+
+// RelationshipGraph is a set of requirements and the relationships between them
+type RelationshipGraph struct {
+	Name  string
+	Nodes []RelationshipGraphNode
+	Edges []RelationshipGraphEdge
+}
+
+// RelationshipGraphNode is a requirement of a relationship graph.
+// External nodes belong to another epic and are only shown because a relationship reaches them.
+type RelationshipGraphNode struct {
+	ID                   uuid.UUID
+	ReferenceID          string
+	Title                string
+	Status               string
+	UserStoryReferenceID string
+	UserStoryTitle       string
+	External             bool
+}
+
+// RelationshipGraphEdge is a typed relationship between two requirements of a graph
+type RelationshipGraphEdge struct {
+	SourceReferenceID string
+	TargetReferenceID string
+	RelationshipType  string
+}
+
+// relationshipGraphService implements RelationshipGraphService interface
+type relationshipGraphService struct {
+	repos *repository.Repositories
+}
+
+// NewRelationshipGraphService creates a new relationship graph service instance
+func NewRelationshipGraphService(repos *repository.Repositories) RelationshipGraphService {
+	return &relationshipGraphService{repos: repos}
+}
+
+// EpicGraph returns the requirements of an epic grouped by user story, with every relationship
+// touching them. Requirements of other epics reached by a relationship are marked external.
+func (s *relationshipGraphService) EpicGraph(ctx context.Context, epicIDOrRef string) (*RelationshipGraph, error) {
+	epicID, err := resolveEntityID(ctx, s.repos, models.EntityTypeEpic, epicIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, err
+	}
+	epic, err := s.repos.Epic.GetByID(ctx, epicID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrEpicNotFound
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+
+	nodes, err := s.repos.RequirementRelationship.ListGraphNodes(ctx, map[string]interface{}{"user_stories.epic_id": epicID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirements: %w", err)
+	}
+	ids := make([]uuid.UUID, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+	relationships, err := s.repos.RequirementRelationship.ListByRequirements(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relationships: %w", err)
+	}
+
+	return s.buildGraph(ctx, epic.ReferenceID, nodes, relationships)
+}
+
+// RequirementGraph returns the requirements within depth relationships of a requirement
+// and the relationships followed to reach them
+func (s *relationshipGraphService) RequirementGraph(ctx context.Context, requirementIDOrRef string, depth int) (*RelationshipGraph, error) {
+	if depth < 1 || depth > MaxRelationshipGraphDepth {
+		return nil, ErrInvalidGraphDepth
+	}
+	requirementID, err := resolveEntityID(ctx, s.repos, models.EntityTypeRequirement, requirementIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrRequirementNotFound
+		}
+		return nil, err
+	}
+
+	visited := map[uuid.UUID]bool{requirementID: true}
+	frontier := []uuid.UUID{requirementID}
+	var relationships []models.RequirementRelationship
+	for level := 0; level < depth && len(frontier) > 0; level++ {
+		found, err := s.repos.RequirementRelationship.ListByRequirements(ctx, frontier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list relationships: %w", err)
+		}
+		frontier = nil
+		for _, relationship := range found {
+			for _, id := range []uuid.UUID{relationship.SourceRequirementID, relationship.TargetRequirementID} {
+				if !visited[id] {
+					visited[id] = true
+					frontier = append(frontier, id)
+				}
+			}
+		}
+		relationships = append(relationships, found...)
+	}
+
+	ids := make([]uuid.UUID, 0, len(visited))
+	for id := range visited {
+		ids = append(ids, id)
+	}
+	nodes, err := s.repos.RequirementRelationship.ListGraphNodes(ctx, map[string]interface{}{"requirements.id": ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirements: %w", err)
+	}
+
+	graph, err := s.buildGraph(ctx, "", nodes, relationships)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		if node.ID == requirementID {
+			graph.Name = node.ReferenceID
+		}
+	}
+	return graph, nil
+}
+
+// buildGraph turns the requirements in scope and the relationships touching them into a graph.
+// Relationship ends outside scope are loaded and marked external; duplicate relationships are dropped.
+func (s *relationshipGraphService) buildGraph(ctx context.Context, name string, nodes []repository.RequirementGraphNode,
+	relationships []models.RequirementRelationship) (*RelationshipGraph, error) {
+	graph := &RelationshipGraph{
+		Name:  name,
+		Nodes: make([]RelationshipGraphNode, 0, len(nodes)),
+		Edges: make([]RelationshipGraphEdge, 0, len(relationships)),
+	}
+
+	referenceIDs := make(map[uuid.UUID]string, len(nodes))
+	for _, node := range nodes {
+		referenceIDs[node.ID] = node.ReferenceID
+		graph.Nodes = append(graph.Nodes, newRelationshipGraphNode(node, false))
+	}
+
+	var externalIDs []uuid.UUID
+	for _, relationship := range relationships {
+		for _, id := range []uuid.UUID{relationship.SourceRequirementID, relationship.TargetRequirementID} {
+			if _, ok := referenceIDs[id]; !ok {
+				referenceIDs[id] = ""
+				externalIDs = append(externalIDs, id)
+			}
+		}
+	}
+	if len(externalIDs) > 0 {
+		external, err := s.repos.RequirementRelationship.ListGraphNodes(ctx, map[string]interface{}{"requirements.id": externalIDs})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list related requirements: %w", err)
+		}
+		for _, node := range external {
+			referenceIDs[node.ID] = node.ReferenceID
+			graph.Nodes = append(graph.Nodes, newRelationshipGraphNode(node, true))
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(relationships))
+	for _, relationship := range relationships {
+		if seen[relationship.ID] {
+			continue
+		}
+		seen[relationship.ID] = true
+		graph.Edges = append(graph.Edges, RelationshipGraphEdge{
+			SourceReferenceID: referenceIDs[relationship.SourceRequirementID],
+			TargetReferenceID: referenceIDs[relationship.TargetRequirementID],
+			RelationshipType:  relationship.RelationshipType.Name,
+		})
+	}
+
+	sort.SliceStable(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ReferenceID < graph.Nodes[j].ReferenceID
+	})
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].SourceReferenceID != graph.Edges[j].SourceReferenceID {
+			return graph.Edges[i].SourceReferenceID < graph.Edges[j].SourceReferenceID
+		}
+		return graph.Edges[i].TargetReferenceID < graph.Edges[j].TargetReferenceID
+	})
+	return graph, nil
+}
+
+// newRelationshipGraphNode converts a repository graph node
+func newRelationshipGraphNode(node repository.RequirementGraphNode, external bool) RelationshipGraphNode {
+	return RelationshipGraphNode{
+		ID:                   node.ID,
+		ReferenceID:          node.ReferenceID,
+		Title:                node.Title,
+		Status:               node.Status,
+		UserStoryReferenceID: node.UserStoryReferenceID,
+		UserStoryTitle:       node.UserStoryTitle,
+		External:             external,
+	}
+}
+
+// RenderRelationshipGraph renders a graph as Graphviz DOT or a Mermaid flowchart.
+// Requirements in scope are grouped by user story; external requirements are drawn dashed.
+func RenderRelationshipGraph(graph *RelationshipGraph, format string) (string, error) {
+	switch format {
+	case RelationshipGraphFormatDOT:
+		return renderDOT(graph), nil
+	case RelationshipGraphFormatMermaid:
+		return renderMermaid(graph), nil
+	}
+	return "", ErrInvalidGraphFormat
+}
+
+// userStoryCluster is the requirements in scope that belong to one user story
+type userStoryCluster struct {
+	ReferenceID string
+	Title       string
+	Nodes       []RelationshipGraphNode
+}
+
+// clusterNodes groups the nodes in scope by user story and returns the external nodes separately
+func clusterNodes(nodes []RelationshipGraphNode) ([]*userStoryCluster, []RelationshipGraphNode) {
+	var (
+		clusters []*userStoryCluster
+		external []RelationshipGraphNode
+	)
+	byUserStory := make(map[string]*userStoryCluster)
+	for _, node := range nodes {
+		if node.External {
+			external = append(external, node)
+			continue
+		}
+		cluster, ok := byUserStory[node.UserStoryReferenceID]
+		if !ok {
+			cluster = &userStoryCluster{ReferenceID: node.UserStoryReferenceID, Title: node.UserStoryTitle}
+			byUserStory[node.UserStoryReferenceID] = cluster
+			clusters = append(clusters, cluster)
+		}
+		cluster.Nodes = append(cluster.Nodes, node)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].ReferenceID < clusters[j].ReferenceID
+	})
+	return clusters, external
+}
+
+// graphNodeLabel is the reference ID and shortened title shown in a node
+func graphNodeLabel(node RelationshipGraphNode) string {
+	title := node.Title
+	if runes := []rune(title); len(runes) > displayTitleLength {
+		title = string(runes[:displayTitleLength-1]) + "…"
+	}
+	return node.ReferenceID + ": " + title
+}
+
+// renderDOT renders a graph as a Graphviz digraph
+func renderDOT(graph *RelationshipGraph) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(graph.Name))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+
+	clusters, external := clusterNodes(graph.Nodes)
+	for _, cluster := range clusters {
+		fmt.Fprintf(&b, "  subgraph %s {\n", dotQuote("cluster_"+cluster.ReferenceID))
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(cluster.ReferenceID+": "+cluster.Title))
+		for _, node := range cluster.Nodes {
+			fmt.Fprintf(&b, "    %s [label=%s];\n", dotQuote(node.ReferenceID), dotQuote(graphNodeLabel(node)))
+		}
+		b.WriteString("  }\n")
+	}
+	for _, node := range external {
+		fmt.Fprintf(&b, "  %s [label=%s, style=\"rounded,dashed\"];\n", dotQuote(node.ReferenceID), dotQuote(graphNodeLabel(node)))
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n",
+			dotQuote(edge.SourceReferenceID), dotQuote(edge.TargetReferenceID), dotQuote(edge.RelationshipType))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotQuote quotes a DOT identifier, escaping backslashes, quotes and line breaks
+func dotQuote(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`).Replace(value)
+	return `"` + value + `"`
+}
+
+// renderMermaid renders a graph as a left-to-right Mermaid flowchart
+func renderMermaid(graph *RelationshipGraph) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	clusters, external := clusterNodes(graph.Nodes)
+	for _, cluster := range clusters {
+		fmt.Fprintf(&b, "  subgraph %s[%s]\n", mermaidID(cluster.ReferenceID), mermaidQuote(cluster.ReferenceID+": "+cluster.Title))
+		for _, node := range cluster.Nodes {
+			fmt.Fprintf(&b, "    %s[%s]\n", mermaidID(node.ReferenceID), mermaidQuote(graphNodeLabel(node)))
+		}
+		b.WriteString("  end\n")
+	}
+	for _, node := range external {
+		fmt.Fprintf(&b, "  %s[%s]:::external\n", mermaidID(node.ReferenceID), mermaidQuote(graphNodeLabel(node)))
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n",
+			mermaidID(edge.SourceReferenceID), mermaidQuote(edge.RelationshipType), mermaidID(edge.TargetReferenceID))
+	}
+	if len(external) > 0 {
+		b.WriteString("  classDef external stroke-dasharray: 5 5\n")
+	}
+	return b.String()
+}
+
+// mermaidID turns a reference ID into a Mermaid node ID, replacing anything but letters and digits with underscores
+func mermaidID(referenceID string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, referenceID)
+}
+
+// mermaidQuote quotes Mermaid label text, escaping quotes as entities and flattening line breaks
+func mermaidQuote(value string) string {
+	value = strings.NewReplacer(`"`, "#quot;", "\r", "", "\n", " ").Replace(value)
+	return `"` + value + `"`
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestRelationshipGraphService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{},
+		&models.Requirement{}, &models.RelationshipType{}, &models.RequirementRelationship{}))
+	types := make(map[string]uuid.UUID)
+	for _, relationshipType := range models.GetDefaultRelationshipTypes() {
+		require.NoError(t, db.Create(&relationshipType).Error)
+		types[relationshipType.Name] = relationshipType.ID
+	}
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epics := []models.Epic{
+		{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout"},
+		{ID: uuid.New(), ReferenceID: "EP-002", Title: "Payments"},
+	}
+	for i := range epics {
+		epics[i].Status, epics[i].CreatorID, epics[i].AssigneeID = models.EpicStatusBacklog, user.ID, user.ID
+		require.NoError(t, session.Create(&epics[i]).Error)
+	}
+	stories := []models.UserStory{
+		{ID: uuid.New(), ReferenceID: "US-001", EpicID: epics[0].ID, Title: "Pay by card"},
+		{ID: uuid.New(), ReferenceID: "US-002", EpicID: epics[1].ID, Title: "Tokenize \"cards\""},
+	}
+	for i := range stories {
+		stories[i].Status, stories[i].CreatorID, stories[i].AssigneeID = models.UserStoryStatusBacklog, user.ID, user.ID
+		require.NoError(t, session.Create(&stories[i]).Error)
+	}
+	requirements := []models.Requirement{
+		{ID: uuid.New(), ReferenceID: "REQ-001", UserStoryID: stories[0].ID, Title: "Card validation"},
+		{ID: uuid.New(), ReferenceID: "REQ-002", UserStoryID: stories[0].ID, Title: "Card form"},
+		{ID: uuid.New(), ReferenceID: "REQ-003", UserStoryID: stories[1].ID, Title: "Token vault"},
+		{ID: uuid.New(), ReferenceID: "REQ-004", UserStoryID: stories[1].ID, Title: "Key rotation"},
+	}
+	for i := range requirements {
+		requirements[i].CreatorID, requirements[i].AssigneeID = user.ID, user.ID
+		require.NoError(t, session.Create(&requirements[i]).Error)
+	}
+	link := func(source, target int, relationshipType string) {
+		require.NoError(t, db.Create(&models.RequirementRelationship{
+			SourceRequirementID: requirements[source].ID,
+			TargetRequirementID: requirements[target].ID,
+			RelationshipTypeID:  types[relationshipType],
+			CreatedBy:           user.ID,
+		}).Error)
+	}
+	link(1, 0, "depends_on")
+	link(0, 2, "depends_on")
+	link(2, 3, "blocks")
+
+	svc := NewRelationshipGraphService(repository.NewRepositories(db, nil))
+
+	t.Run("epic graph marks requirements of other epics external", func(t *testing.T) {
+		graph, err := svc.EpicGraph(ctx, "EP-001")
+		require.NoError(t, err)
+		assert.Equal(t, "EP-001", graph.Name)
+		require.Len(t, graph.Nodes, 3)
+		assert.False(t, graph.Nodes[0].External)
+		assert.False(t, graph.Nodes[1].External)
+		assert.True(t, graph.Nodes[2].External)
+		assert.Equal(t, []RelationshipGraphEdge{
+			{SourceReferenceID: "REQ-001", TargetReferenceID: "REQ-003", RelationshipType: "depends_on"},
+			{SourceReferenceID: "REQ-002", TargetReferenceID: "REQ-001", RelationshipType: "depends_on"},
+		}, graph.Edges)
+
+		_, err = svc.EpicGraph(ctx, "EP-404")
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+
+	t.Run("requirement graph follows relationships up to depth", func(t *testing.T) {
+		graph, err := svc.RequirementGraph(ctx, "REQ-001", 1)
+		require.NoError(t, err)
+		assert.Equal(t, "REQ-001", graph.Name)
+		assert.Len(t, graph.Nodes, 3)
+		assert.Len(t, graph.Edges, 2)
+
+		graph, err = svc.RequirementGraph(ctx, "REQ-001", 2)
+		require.NoError(t, err)
+		assert.Len(t, graph.Nodes, 4)
+		assert.Len(t, graph.Edges, 3)
+
+		_, err = svc.RequirementGraph(ctx, "REQ-001", 4)
+		assert.ErrorIs(t, err, ErrInvalidGraphDepth)
+		_, err = svc.RequirementGraph(ctx, "REQ-404", 1)
+		assert.ErrorIs(t, err, ErrRequirementNotFound)
+	})
+
+	t.Run("renders DOT and Mermaid", func(t *testing.T) {
+		graph, err := svc.EpicGraph(ctx, "EP-001")
+		require.NoError(t, err)
+
+		dot, err := RenderRelationshipGraph(graph, RelationshipGraphFormatDOT)
+		require.NoError(t, err)
+		assert.Contains(t, dot, `digraph "EP-001" {`)
+		assert.Contains(t, dot, `subgraph "cluster_US-001" {`)
+		assert.Contains(t, dot, `"REQ-003" [label="REQ-003: Token vault", style="rounded,dashed"];`)
+		assert.Contains(t, dot, `"REQ-002" -> "REQ-001" [label="depends_on"];`)
+
+		mermaid, err := RenderRelationshipGraph(graph, RelationshipGraphFormatMermaid)
+		require.NoError(t, err)
+		assert.Contains(t, mermaid, "flowchart LR\n")
+		assert.Contains(t, mermaid, `subgraph US_001["US-001: Pay by card"]`)
+		assert.Contains(t, mermaid, `REQ_003["REQ-003: Token vault"]:::external`)
+		assert.Contains(t, mermaid, `REQ_002 -->|"depends_on"| REQ_001`)
+
+		_, err = RenderRelationshipGraph(graph, "svg")
+		assert.ErrorIs(t, err, ErrInvalidGraphFormat)
+	})
+
+	t.Run("escapes labels", func(t *testing.T) {
+		graph, err := svc.EpicGraph(ctx, "EP-002")
+		require.NoError(t, err)
+
+		dot, err := RenderRelationshipGraph(graph, RelationshipGraphFormatDOT)
+		require.NoError(t, err)
+		assert.Contains(t, dot, `label="US-002: Tokenize \"cards\"";`)
+
+		mermaid, err := RenderRelationshipGraph(graph, RelationshipGraphFormatMermaid)
+		require.NoError(t, err)
+		assert.Contains(t, mermaid, `subgraph US_002["US-002: Tokenize #quot;cards#quot;"]`)
+	})
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrReportNotFound = apperrors.New(apperrors.KindNotFound, "REPORT_NOT_FOUND", "report not found")
+)
+
+// Report IDs of the reports that can be scheduled
+const (
+	ReportIDStale          = "stale"
+	ReportIDUndefinedTerms = "undefined-terms"
+	ReportIDSpelling       = "spelling"
+	ReportIDTranslations   = "translation-completeness"
+	ReportIDUnsigned       = "unsigned-requirements"
+)
+
+// ReportDefinition describes a report that can be delivered on a schedule
+type ReportDefinition struct {
+	ID          string `json:"id" example:"stale"`
+	Name        string `json:"name" example:"Stale items"`
+	Description string `json:"description" example:"Entities marked stale by the staleness policies, least recently updated first"`
+}
+
+// ReportTable is a report rendered as rows of text cells, ready to be written as CSV or PDF
+type ReportTable struct {
+	Title       string
+	GeneratedAt time.Time
+	Header      []string
+	Rows        [][]string
+}
+
+// ReportCatalog defines the interface for listing and building the reports that can be scheduled
+type ReportCatalog interface {
+	ListReports() []ReportDefinition
+	GetReport(id string) (*ReportDefinition, error)
+	BuildReport(ctx context.Context, id string, now time.Time) (*ReportTable, error)
+}
+
+// reportCatalog implements ReportCatalog interface on top of the report services
+type reportCatalog struct {
+	stalenessService   StalenessService
+	glossaryService    GlossaryService
+	spellingService    SpellingService
+	translationService TranslationService
+	signOffService     SignOffService
+}
+
+// NewReportCatalog creates a report catalog covering the stale report, the undefined glossary terms report,
+// the spelling report, the translation completeness report and the unsigned requirements report
+func NewReportCatalog(stalenessService StalenessService, glossaryService GlossaryService, spellingService SpellingService, translationService TranslationService, signOffService SignOffService) ReportCatalog {
+	return &reportCatalog{
+		stalenessService:   stalenessService,
+		glossaryService:    glossaryService,
+		spellingService:    spellingService,
+		translationService: translationService,
+		signOffService:     signOffService,
+	}
+}
+
+// reportDefinitions lists the reports in the order they are presented
+var reportDefinitions = []ReportDefinition{
+	{
+		ID:          ReportIDStale,
+		Name:        "Stale items",
+		Description: "Entities marked stale by the staleness policies, least recently updated first",
+	},
+	{
+		ID:          ReportIDUndefinedTerms,
+		Name:        "Undefined glossary terms",
+		Description: "Capitalized terms used in requirements that are not defined in the glossary",
+	},
+	{
+		ID:          ReportIDSpelling,
+		Name:        "Spelling and terminology",
+		Description: "Misspelled words and terms written in several ways, such as \"log in\" and \"login\", per epic",
+	},
+	{
+		ID:          ReportIDTranslations,
+		Name:        "Translation completeness",
+		Description: "Translated, partially translated and untranslated entities per locale and entity type",
+	},
+	{
+		ID:          ReportIDUnsigned,
+		Name:        "Unsigned requirements",
+		Description: "Active requirements that were never signed off or were changed since their latest sign-off",
+	},
+}
+
+// ListReports returns the reports that can be scheduled
+func (c *reportCatalog) ListReports() []ReportDefinition {
+	return append([]ReportDefinition(nil), reportDefinitions...)
+}
+
+// GetReport returns the definition of a report
+func (c *reportCatalog) GetReport(id string) (*ReportDefinition, error) {
+	for i := range reportDefinitions {
+		if reportDefinitions[i].ID == id {
+			definition := reportDefinitions[i]
+			return &definition, nil
+		}
+	}
+	return nil, ErrReportNotFound
+}
+
+// BuildReport generates the current content of a report
+func (c *reportCatalog) BuildReport(ctx context.Context, id string, now time.Time) (*ReportTable, error) {
+	definition, err := c.GetReport(id)
+	if err != nil {
+		return nil, err
+	}
+	table := &ReportTable{Title: definition.Name, GeneratedAt: now}
+
+	switch id {
+	case ReportIDStale:
+		entries, err := c.stalenessService.GetStaleReport(ctx, repository.StaleReportFilter{}, now)
+		if err != nil {
+			return nil, err
+		}
+		table.Header = []string{"Reference", "Type", "Title", "Status", "Assignee", "Last updated", "Idle days", "Policy", "Stale since", "Escalated"}
+		for _, entry := range entries {
+			escalated := ""
+			if entry.EscalatedAt != nil {
+				escalated = entry.EscalatedAt.UTC().Format("2006-01-02")
+			}
+			table.Rows = append(table.Rows, []string{
+				entry.ReferenceID, string(entry.EntityType), entry.Title, entry.Status, entry.AssigneeUsername,
+				entry.LastUpdatedAt.UTC().Format("2006-01-02"), strconv.Itoa(entry.IdleDays), entry.PolicyName,
+				entry.DetectedAt.UTC().Format("2006-01-02"), escalated,
+			})
+		}
+	case ReportIDUndefinedTerms:
+		report, err := c.glossaryService.GetUndefinedTermsReport(ctx)
+		if err != nil {
+			return nil, err
+		}
+		table.Header = []string{"Term", "Occurrences", "Requirements"}
+		for _, term := range report.Terms {
+			table.Rows = append(table.Rows, []string{term.Term, strconv.Itoa(term.Occurrences), strings.Join(term.Requirements, " ")})
+		}
+	case ReportIDSpelling:
+		report, err := c.spellingService.GetReport(ctx, now)
+		if err != nil {
+			return nil, err
+		}
+		table.Header = []string{"Epic", "Finding", "Text", "Suggestion", "Occurrences", "Entities"}
+		for _, epic := range report.Epics {
+			for _, misspelling := range epic.Misspellings {
+				table.Rows = append(table.Rows, []string{
+					epic.ReferenceID, "misspelling", misspelling.Word, misspelling.Suggestion,
+					strconv.Itoa(misspelling.Occurrences), strings.Join(misspelling.Entities, " "),
+				})
+			}
+			for _, term := range epic.InconsistentTerms {
+				for _, variant := range term.Variants {
+					if strings.EqualFold(variant.Form, term.Preferred) {
+						continue
+					}
+					table.Rows = append(table.Rows, []string{
+						epic.ReferenceID, "terminology", variant.Form, term.Preferred,
+						strconv.Itoa(variant.Occurrences), strings.Join(variant.Entities, " "),
+					})
+				}
+			}
+		}
+	case ReportIDTranslations:
+		report, err := c.translationService.GetCompletenessReport(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		table.Header = []string{"Locale", "Type", "Entities", "Translated", "Partial", "Missing", "Complete %"}
+		for _, entry := range report {
+			table.Rows = append(table.Rows, []string{
+				entry.Locale, string(entry.EntityType), strconv.FormatInt(entry.Total, 10), strconv.FormatInt(entry.Translated, 10),
+				strconv.FormatInt(entry.Partial, 10), strconv.FormatInt(entry.Missing, 10), strconv.FormatFloat(entry.PercentComplete, 'f', 1, 64),
+			})
+		}
+	case ReportIDUnsigned:
+		entries, err := c.signOffService.GetUnsignedReport(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		table.Header = []string{"Reference", "Title", "Assignee", "Current version", "Signed version", "Last signed", "Reason"}
+		for _, entry := range entries {
+			signedVersion, lastSigned := "", ""
+			if entry.SignedVersion != nil {
+				signedVersion = strconv.Itoa(*entry.SignedVersion)
+			}
+			if entry.LastSignedAt != nil {
+				lastSigned = entry.LastSignedAt.UTC().Format("2006-01-02")
+			}
+			table.Rows = append(table.Rows, []string{
+				entry.ReferenceID, entry.Title, entry.AssigneeUsername, strconv.Itoa(entry.CurrentVersion), signedVersion, lastSigned, entry.Reason,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("report %s has no builder", id)
+	}
+	return table, nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bytes"
+	"compress/zlib"
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// reportFontData is DejaVu Sans Mono, a monospaced TrueType font covering Latin, Greek and Cyrillic,
+// embedded in PDF reports so their text is readable in any language the font covers (see fonts/LICENSE)
+//
+//go:embed fonts/DejaVuSansMono.ttf
+var reportFontData []byte
+
+// reportFontName is the PostScript name of the embedded font
+const reportFontName = "DejaVuSansMono"
+
+// trueTypeFont holds the metrics and character map of a TrueType font needed to embed it in a PDF
+type trueTypeFont struct {
+	data        []byte
+	compressed  []byte
+	glyphs      map[rune]uint16
+	unitsPerEm  int
+	advance     int // advance width of every glyph, in 1/1000 em; the font is monospaced
+	ascent      int
+	descent     int
+	boundingBox [4]int
+}
+
+var (
+	reportFontOnce   sync.Once
+	reportFontParsed *trueTypeFont
+	reportFontErr    error
+)
+
+// loadReportFont parses the embedded font once
+func loadReportFont() (*trueTypeFont, error) {
+	reportFontOnce.Do(func() {
+		reportFontParsed, reportFontErr = parseTrueTypeFont(reportFontData)
+	})
+	return reportFontParsed, reportFontErr
+}
+
+// glyph returns the glyph of a character, or of '?' when the font has none
+func (f *trueTypeFont) glyph(r rune) (uint16, bool) {
+	if glyph, ok := f.glyphs[r]; ok {
+		return glyph, true
+	}
+	return f.glyphs['?'], false
+}
+
+// scale converts font units to the 1/1000 em units of PDF glyph space
+func (f *trueTypeFont) scale(value int) int {
+	return value * 1000 / f.unitsPerEm
+}
+
+// parseTrueTypeFont reads the tables of a TrueType font needed for PDF embedding: head, hhea, hmtx and cmap
+func parseTrueTypeFont(data []byte) (*trueTypeFont, error) {
+	if len(data) < 12 {
+		return nil, errors.New("font file is too short")
+	}
+	tables := make(map[string][]byte)
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < numTables; i++ {
+		record := 12 + 16*i
+		if record+16 > len(data) {
+			return nil, errors.New("font table directory is truncated")
+		}
+		offset := int(binary.BigEndian.Uint32(data[record+8:]))
+		length := int(binary.BigEndian.Uint32(data[record+12:]))
+		if offset+length > len(data) {
+			return nil, fmt.Errorf("font table %q is truncated", data[record:record+4])
+		}
+		tables[string(data[record:record+4])] = data[offset : offset+length]
+	}
+	for _, tag := range []string{"head", "hhea", "hmtx", "cmap"} {
+		if _, ok := tables[tag]; !ok {
+			return nil, fmt.Errorf("font has no %s table", tag)
+		}
+	}
+
+	head, hhea := tables["head"], tables["hhea"]
+	if len(head) < 54 || len(hhea) < 36 || len(tables["hmtx"]) < 4 {
+		return nil, errors.New("font metrics are truncated")
+	}
+	font := &trueTypeFont{data: data, unitsPerEm: int(binary.BigEndian.Uint16(head[18:]))}
+	if font.unitsPerEm == 0 {
+		return nil, errors.New("font has no units per em")
+	}
+	for i := range font.boundingBox {
+		font.boundingBox[i] = font.scale(int(int16(binary.BigEndian.Uint16(head[36+2*i:]))))
+	}
+	font.ascent = font.scale(int(int16(binary.BigEndian.Uint16(hhea[4:]))))
+	font.descent = font.scale(int(int16(binary.BigEndian.Uint16(hhea[6:]))))
+	font.advance = font.scale(int(binary.BigEndian.Uint16(tables["hmtx"][0:])))
+
+	glyphs, err := parseCharacterMap(tables["cmap"])
+	if err != nil {
+		return nil, err
+	}
+	font.glyphs = glyphs
+
+	var buf bytes.Buffer
+	writer := zlib.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	font.compressed = buf.Bytes()
+	return font, nil
+}
+
+// parseCharacterMap reads the Unicode character map of a font, preferring the full
+// Unicode subtable (format 12) over the Basic Multilingual Plane one (format 4)
+func parseCharacterMap(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, errors.New("font character map is truncated")
+	}
+	var format4, format12 []byte
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := 0; i < numTables; i++ {
+		record := 4 + 8*i
+		if record+8 > len(cmap) {
+			break
+		}
+		platform := binary.BigEndian.Uint16(cmap[record:])
+		encoding := binary.BigEndian.Uint16(cmap[record+2:])
+		offset := int(binary.BigEndian.Uint32(cmap[record+4:]))
+		if offset+2 > len(cmap) || (platform != 0 && !(platform == 3 && (encoding == 1 || encoding == 10))) {
+			continue
+		}
+		switch binary.BigEndian.Uint16(cmap[offset:]) {
+		case 4:
+			format4 = cmap[offset:]
+		case 12:
+			format12 = cmap[offset:]
+		}
+	}
+
+	glyphs := make(map[rune]uint16)
+	switch {
+	case len(format12) >= 16:
+		groups := int(binary.BigEndian.Uint32(format12[12:]))
+		for i := 0; i < groups && 16+12*i+12 <= len(format12); i++ {
+			group := format12[16+12*i:]
+			start, end := binary.BigEndian.Uint32(group), binary.BigEndian.Uint32(group[4:])
+			glyph := binary.BigEndian.Uint32(group[8:])
+			for c := start; c <= end && c <= 0x10ffff; c++ {
+				glyphs[rune(c)] = uint16(glyph + c - start)
+			}
+		}
+	case len(format4) >= 14:
+		segments := int(binary.BigEndian.Uint16(format4[6:])) / 2
+		if len(format4) < 16+8*segments {
+			return nil, errors.New("font character map is truncated")
+		}
+		endCodes := 14
+		startCodes := endCodes + 2*segments + 2
+		deltas := startCodes + 2*segments
+		rangeOffsets := deltas + 2*segments
+		for s := 0; s < segments; s++ {
+			start := int(binary.BigEndian.Uint16(format4[startCodes+2*s:]))
+			end := int(binary.BigEndian.Uint16(format4[endCodes+2*s:]))
+			delta := binary.BigEndian.Uint16(format4[deltas+2*s:])
+			rangeOffset := int(binary.BigEndian.Uint16(format4[rangeOffsets+2*s:]))
+			for c := start; c <= end && c != 0xffff; c++ {
+				glyph := uint16(c) + delta
+				if rangeOffset != 0 {
+					at := rangeOffsets + 2*s + rangeOffset + 2*(c-start)
+					if at+2 > len(format4) {
+						continue
+					}
+					if glyph = binary.BigEndian.Uint16(format4[at:]); glyph != 0 {
+						glyph += delta
+					}
+				}
+				if glyph != 0 {
+					glyphs[rune(c)] = glyph
+				}
+			}
+		}
+	default:
+		return nil, errors.New("font has no Unicode character map")
+	}
+	if _, ok := glyphs['?']; !ok {
+		return nil, errors.New("font has no glyph for '?'")
+	}
+	return glyphs, nil
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"product-requirements-management/internal/models"
+)
+
+// PDF page layout: A4 landscape with a monospaced font, so columns line up by character count
+const (
+	pdfPageWidth     = 842
+	pdfPageHeight    = 595
+	pdfMargin        = 36
+	pdfFontSize      = 8
+	pdfLineHeight    = 10
+	pdfCharsPerLine  = (pdfPageWidth - 2*pdfMargin) * 10 / (pdfFontSize * 6) // DejaVu Sans Mono glyphs are 0.6 em wide
+	pdfLinesPerPage  = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+	pdfMaxCellLength = 48
+)
+
+// renderReport writes a report table in the given format and returns its content type
+func renderReport(table *ReportTable, format models.ReportFormat) ([]byte, string, error) {
+	switch format {
+	case models.ReportFormatCSV:
+		content, err := renderReportCSV(table)
+		return content, "text/csv; charset=utf-8", err
+	case models.ReportFormatPDF:
+		content, err := renderReportPDF(table)
+		return content, "application/pdf", err
+	default:
+		return nil, "", fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+// renderReportCSV writes the header and rows as CSV
+func renderReportCSV(table *ReportTable) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(table.Header); err != nil {
+		return nil, err
+	}
+	if err := writer.WriteAll(table.Rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderReportPDF writes the report as a plain PDF table in DejaVu Sans Mono, which is embedded so the text
+// shows in any script the font covers. Cells are truncated to keep rows on one line.
+func renderReportPDF(table *ReportTable) ([]byte, error) {
+	font, err := loadReportFont()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the report font: %w", err)
+	}
+	text := func(value string) []rune {
+		return pdfText(font, value)
+	}
+
+	widths := make([]int, len(table.Header))
+	for i, cell := range table.Header {
+		widths[i] = len(text(cell))
+	}
+	for _, row := range table.Rows {
+		for i, cell := range row {
+			if i < len(widths) && len(text(cell)) > widths[i] {
+				widths[i] = len(text(cell))
+			}
+		}
+	}
+	for i := range widths {
+		if widths[i] > pdfMaxCellLength {
+			widths[i] = pdfMaxCellLength
+		}
+	}
+
+	formatRow := func(cells []string) []rune {
+		parts := make([]string, len(widths))
+		for i, width := range widths {
+			var cell []rune
+			if i < len(cells) {
+				cell = text(cells[i])
+			}
+			if len(cell) > width {
+				cell = append(cell[:width-3:width-3], []rune("...")...)
+			}
+			parts[i] = string(cell) + strings.Repeat(" ", width-len(cell))
+		}
+		line := []rune(strings.TrimRight(strings.Join(parts, "  "), " "))
+		if len(line) > pdfCharsPerLine {
+			line = line[:pdfCharsPerLine]
+		}
+		return line
+	}
+
+	header := formatRow(table.Header)
+	heading := [][]rune{
+		text(table.Title),
+		[]rune("Generated " + table.GeneratedAt.UTC().Format("2006-01-02 15:04 MST")),
+		nil,
+		header,
+		[]rune(strings.Repeat("-", len(header))),
+	}
+	var lines [][]rune
+	for _, row := range table.Rows {
+		lines = append(lines, formatRow(row))
+	}
+	if len(lines) == 0 {
+		lines = [][]rune{[]rune("No entries.")}
+	}
+
+	// Every page repeats the heading so printed pages can be read on their own
+	perPage := pdfLinesPerPage - len(heading)
+	var pages [][][]rune
+	for start := 0; start < len(lines); start += perPage {
+		end := start + perPage
+		if end > len(lines) {
+			end = len(lines)
+		}
+		pages = append(pages, append(append([][]rune(nil), heading...), lines[start:end]...))
+	}
+	return writePDF(font, pages), nil
+}
+
+// writePDF assembles a PDF document with one content stream per page of text lines. The font is embedded
+// as a CID font addressed by glyph, with a ToUnicode map so the text can be searched and copied.
+func writePDF(font *trueTypeFont, pages [][][]rune) []byte {
+	// Objects: 1 catalog, 2 page tree, 3 font, then a page and its content stream per page,
+	// then the CID font, its descriptor, the font file and the ToUnicode map
+	cidFont := 4 + 2*len(pages)
+	descriptor, fontFile, toUnicode := cidFont+1, cidFont+2, cidFont+3
+
+	var objects []string
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+			reportFontName, cidFont, toUnicode),
+	)
+
+	used := make(map[uint16]rune)
+	for i, lines := range pages {
+		var stream strings.Builder
+		fmt.Fprintf(&stream, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+		for _, line := range lines {
+			stream.WriteByte('<')
+			for _, r := range line {
+				glyph, _ := font.glyph(r)
+				if _, ok := used[glyph]; !ok {
+					used[glyph] = r
+				}
+				fmt.Fprintf(&stream, "%04X", glyph)
+			}
+			stream.WriteString("> Tj T*\n")
+		}
+		stream.WriteString("ET")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, 5+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", stream.Len(), stream.String()),
+		)
+	}
+
+	objects = append(objects,
+		fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /DW %d /CIDToGIDMap /Identity >>",
+			reportFontName, descriptor, font.advance),
+		fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 33 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+			reportFontName, font.boundingBox[0], font.boundingBox[1], font.boundingBox[2], font.boundingBox[3],
+			font.ascent, font.descent, font.ascent, fontFile),
+		fmt.Sprintf("<< /Length %d /Length1 %d /Filter /FlateDecode >>\nstream\n%s\nendstream", len(font.compressed), len(font.data), font.compressed),
+	)
+	toUnicodeMap := pdfToUnicodeMap(used)
+	objects = append(objects, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(toUnicodeMap), toUnicodeMap))
+
+	var buf bytes.Buffer
+	// The comment of non-ASCII bytes marks the file as binary for transfer programs
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// pdfToUnicodeMap writes the CMap mapping the glyphs of a document back to their characters
+func pdfToUnicodeMap(used map[uint16]rune) string {
+	glyphs := make([]int, 0, len(used))
+	for glyph := range used {
+		glyphs = append(glyphs, int(glyph))
+	}
+	sort.Ints(glyphs)
+
+	var b strings.Builder
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n")
+	b.WriteString("/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n")
+	b.WriteString("/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n")
+	b.WriteString("1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// A bfchar section holds at most 100 mappings
+	for start := 0; start < len(glyphs); start += 100 {
+		end := min(start+100, len(glyphs))
+		fmt.Fprintf(&b, "%d beginbfchar\n", end-start)
+		for _, glyph := range glyphs[start:end] {
+			fmt.Fprintf(&b, "<%04X> <", glyph)
+			for _, unit := range utf16.Encode([]rune{used[uint16(glyph)]}) {
+				fmt.Fprintf(&b, "%04X", unit)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend")
+	return b.String()
+}
+
+// pdfText flattens whitespace and replaces control characters and characters the font has no glyph for
+func pdfText(font *trueTypeFont, value string) []rune {
+	var runes []rune
+	for _, r := range value {
+		switch {
+		case r == '\n' || r == '\r' || r == '\t':
+			runes = append(runes, ' ')
+		case r < 0x20 || r == 0x7f:
+			runes = append(runes, '?')
+		default:
+			if _, ok := font.glyph(r); !ok {
+				r = '?'
+			}
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
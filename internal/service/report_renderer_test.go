@@ -0,0 +1,67 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pdfGlyphs returns the hex glyph string the PDF renderer writes for text
+func pdfGlyphs(t *testing.T, text string) string {
+	font, err := loadReportFont()
+	require.NoError(t, err)
+	var b strings.Builder
+	for _, r := range text {
+		glyph, ok := font.glyph(r)
+		require.True(t, ok, "the report font has no glyph for %q", r)
+		fmt.Fprintf(&b, "%04X", glyph)
+	}
+	return b.String()
+}
+
+func TestReportFont(t *testing.T) {
+	font, err := loadReportFont()
+	require.NoError(t, err)
+	assert.Equal(t, 2048, font.unitsPerEm)
+	assert.Equal(t, 602, font.advance)
+
+	glyph, ok := font.glyph('A')
+	assert.True(t, ok)
+	assert.Equal(t, uint16(36), glyph)
+	for _, r := range "ЖжЁёΩé€" {
+		_, ok := font.glyph(r)
+		assert.True(t, ok, "no glyph for %q", r)
+	}
+	question, _ := font.glyph('?')
+	glyph, ok = font.glyph('漢')
+	assert.False(t, ok)
+	assert.Equal(t, question, glyph)
+}
+
+func TestRenderReportPDF(t *testing.T) {
+	table := &ReportTable{
+		Title:       "Устаревшие требования",
+		GeneratedAt: time.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC),
+		Header:      []string{"Reference", "Title"},
+		Rows:        [][]string{{"REQ-001", "Пароль\tне короче 12 символов"}, {"REQ-002", "漢字"}},
+	}
+
+	content, contentType, err := renderReport(table, "pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", contentType)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(content, []byte("%%EOF\n")))
+
+	pdf := string(content)
+	assert.Contains(t, pdf, "/Subtype /Type0 /BaseFont /DejaVuSansMono /Encoding /Identity-H")
+	assert.Contains(t, pdf, "/FontFile2")
+	assert.Contains(t, pdf, "<"+pdfGlyphs(t, "Устаревшие требования")+"> Tj")
+	assert.Contains(t, pdf, pdfGlyphs(t, "Пароль не короче 12 символов"))
+	assert.Contains(t, pdf, pdfGlyphs(t, "REQ-002    ??"), "characters without a glyph are replaced")
+	assert.Contains(t, pdf, fmt.Sprintf("<%s> <0423>", pdfGlyphs(t, "У")), "the ToUnicode map restores the characters")
+}
@@ -0,0 +1,434 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// maxReportRecipients caps the number of email recipients of one schedule
+const maxReportRecipients = 20
+
+var (
+	ErrReportScheduleNotFound     = apperrors.New(apperrors.KindNotFound, "REPORT_SCHEDULE_NOT_FOUND", "report schedule not found")
+	ErrReportScheduleNameRequired = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "report schedule name is required")
+	ErrInvalidCronExpression      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid cron expression")
+	ErrInvalidReportTimezone      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid time zone")
+	ErrInvalidReportFormat        = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "format must be one of: csv, pdf")
+	ErrInvalidReportChannel       = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "channel must be one of: email, webhook")
+	ErrInvalidReportRecipients    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "email schedules need between 1 and 20 valid recipient addresses")
+	ErrInvalidReportWebhookURL    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "webhook schedules need an absolute http or https webhook_url")
+)
+
+// ReportScheduleService defines the interface for scheduled report delivery
+type ReportScheduleService interface {
+	CreateSchedule(ctx context.Context, reportID string, req ReportScheduleRequest, ownerID uuid.UUID) (*models.ReportSchedule, error)
+	GetSchedule(ctx context.Context, reportID string, id uuid.UUID) (*models.ReportSchedule, error)
+	UpdateSchedule(ctx context.Context, reportID string, id uuid.UUID, req ReportScheduleRequest, currentUser *models.User) (*models.ReportSchedule, error)
+	DeleteSchedule(ctx context.Context, reportID string, id uuid.UUID, currentUser *models.User) error
+	ListSchedules(ctx context.Context, reportID string) ([]models.ReportSchedule, error)
+	RunSchedule(ctx context.Context, reportID string, id uuid.UUID, currentUser *models.User) (*models.ReportSchedule, error)
+	DeliverDueSchedules(ctx context.Context, now time.Time) (int, error)
+}
+
+// ReportScheduleRequest represents the request to create or replace a report schedule
+// @Description Report schedule; email schedules need recipients and webhook schedules a webhook_url. Updates replace the whole schedule.
+type ReportScheduleRequest struct {
+	Name           string                       `json:"name" binding:"required,max=255" example:"Weekly stale items for ops"`
+	CronExpression string                       `json:"cron_expression" binding:"required" example:"0 8 * * 1"`
+	Timezone       string                       `json:"timezone,omitempty" example:"Europe/Berlin"` // Defaults to UTC
+	Format         models.ReportFormat          `json:"format" binding:"required" example:"csv"`
+	Channel        models.ReportDeliveryChannel `json:"channel" binding:"required" example:"email"`
+	Recipients     []string                     `json:"recipients,omitempty" example:"ops@example.com"`
+	WebhookURL     *string                      `json:"webhook_url,omitempty" example:"https://hooks.example.com/reports"`
+	Enabled        *bool                        `json:"enabled,omitempty" example:"true"` // Defaults to true
+}
+
+// reportScheduleService implements ReportScheduleService interface
+type reportScheduleService struct {
+	repos   *repository.Repositories
+	catalog ReportCatalog
+	mailer  Mailer
+	client  *http.Client
+	logger  *logrus.Logger
+}
+
+// NewReportScheduleService creates a new report schedule service instance that posts webhooks with client
+func NewReportScheduleService(repos *repository.Repositories, catalog ReportCatalog, mailer Mailer, client *http.Client, logger *logrus.Logger) ReportScheduleService {
+	return &reportScheduleService{
+		repos:   repos,
+		catalog: catalog,
+		mailer:  mailer,
+		client:  client,
+		logger:  logger,
+	}
+}
+
+// CreateSchedule adds a delivery schedule to a report
+func (s *reportScheduleService) CreateSchedule(ctx context.Context, reportID string, req ReportScheduleRequest, ownerID uuid.UUID) (*models.ReportSchedule, error) {
+	if _, err := s.catalog.GetReport(reportID); err != nil {
+		return nil, err
+	}
+
+	schedule := &models.ReportSchedule{ReportID: reportID, OwnerID: ownerID}
+	if err := applyReportScheduleRequest(schedule, req, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repos.ReportSchedule.Create(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to create report schedule: %w", err)
+	}
+	return s.GetSchedule(ctx, reportID, schedule.ID)
+}
+
+// GetSchedule retrieves a schedule of a report with its owner
+func (s *reportScheduleService) GetSchedule(ctx context.Context, reportID string, id uuid.UUID) (*models.ReportSchedule, error) {
+	if _, err := s.catalog.GetReport(reportID); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.repos.ReportSchedule.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrReportScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get report schedule: %w", err)
+	}
+	if schedule.ReportID != reportID {
+		return nil, ErrReportScheduleNotFound
+	}
+	if schedule.Owner, err = s.repos.User.GetByID(ctx, schedule.OwnerID); err != nil {
+		return nil, fmt.Errorf("failed to get report schedule owner: %w", err)
+	}
+	return schedule, nil
+}
+
+// UpdateSchedule replaces a schedule; only its owner and administrators may change it
+func (s *reportScheduleService) UpdateSchedule(ctx context.Context, reportID string, id uuid.UUID, req ReportScheduleRequest, currentUser *models.User) (*models.ReportSchedule, error) {
+	schedule, err := s.getOwnedSchedule(ctx, reportID, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyReportScheduleRequest(schedule, req, time.Now()); err != nil {
+		return nil, err
+	}
+	schedule.Owner = nil
+	if err := s.repos.ReportSchedule.Update(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to update report schedule: %w", err)
+	}
+	return s.GetSchedule(ctx, reportID, schedule.ID)
+}
+
+// DeleteSchedule removes a schedule; only its owner and administrators may delete it
+func (s *reportScheduleService) DeleteSchedule(ctx context.Context, reportID string, id uuid.UUID, currentUser *models.User) error {
+	if _, err := s.getOwnedSchedule(ctx, reportID, id, currentUser); err != nil {
+		return err
+	}
+	if err := s.repos.ReportSchedule.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete report schedule: %w", err)
+	}
+	return nil
+}
+
+// ListSchedules returns the schedules of a report in creation order
+func (s *reportScheduleService) ListSchedules(ctx context.Context, reportID string) ([]models.ReportSchedule, error) {
+	if _, err := s.catalog.GetReport(reportID); err != nil {
+		return nil, err
+	}
+	schedules, err := s.repos.ReportSchedule.ListByReport(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// RunSchedule delivers a report immediately, for example to test a new webhook. The outcome is recorded
+// like a scheduled run, so a failure is returned in the schedule rather than as an error.
+func (s *reportScheduleService) RunSchedule(ctx context.Context, reportID string, id uuid.UUID, currentUser *models.User) (*models.ReportSchedule, error) {
+	schedule, err := s.getOwnedSchedule(ctx, reportID, id, currentUser)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.run(ctx, schedule, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.GetSchedule(ctx, reportID, id)
+}
+
+// getOwnedSchedule retrieves a schedule the current user may change
+func (s *reportScheduleService) getOwnedSchedule(ctx context.Context, reportID string, id uuid.UUID, currentUser *models.User) (*models.ReportSchedule, error) {
+	schedule, err := s.GetSchedule(ctx, reportID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !currentUser.IsAdministrator() && schedule.OwnerID != currentUser.ID {
+		return nil, ErrUnauthorizedAccess
+	}
+	return schedule, nil
+}
+
+// DeliverDueSchedules runs every enabled schedule whose next run is due and returns the number of successful deliveries.
+// Failed deliveries are recorded on the schedule and retried at its next scheduled time.
+func (s *reportScheduleService) DeliverDueSchedules(ctx context.Context, now time.Time) (int, error) {
+	schedules, err := s.repos.ReportSchedule.ListDue(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due report schedules: %w", err)
+	}
+
+	delivered := 0
+	for i := range schedules {
+		schedule := &schedules[i]
+		if err := s.run(ctx, schedule, now); err != nil {
+			s.logger.WithFields(logrus.Fields{
+				"schedule_id": schedule.ID,
+				"error":       err.Error(),
+			}).Error("Failed to record report schedule run")
+			continue
+		}
+		if schedule.ConsecutiveFailures == 0 {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// run delivers a schedule, records the outcome and the next run time, and alerts the owner when deliveries start failing
+func (s *reportScheduleService) run(ctx context.Context, schedule *models.ReportSchedule, now time.Time) error {
+	deliveryErr := s.deliver(ctx, schedule, now)
+
+	schedule.LastRunAt = &now
+	status := models.ReportRunSucceeded
+	if deliveryErr != nil {
+		status = models.ReportRunFailed
+		message := deliveryErr.Error()
+		schedule.LastError = &message
+		schedule.ConsecutiveFailures++
+	} else {
+		schedule.LastError = nil
+		schedule.ConsecutiveFailures = 0
+	}
+	schedule.LastStatus = &status
+	schedule.NextRunAt = nextReportRun(schedule, now)
+
+	owner := schedule.Owner
+	schedule.Owner = nil
+	if err := s.repos.ReportSchedule.Update(ctx, schedule); err != nil {
+		return fmt.Errorf("failed to record report schedule run: %w", err)
+	}
+	schedule.Owner = owner
+
+	// One alert per failure streak; the schedule keeps being retried
+	if deliveryErr != nil && schedule.ConsecutiveFailures == 1 {
+		s.alertOwner(ctx, schedule, deliveryErr)
+	}
+	return nil
+}
+
+// deliver builds the report and sends it through the channel of the schedule
+func (s *reportScheduleService) deliver(ctx context.Context, schedule *models.ReportSchedule, now time.Time) error {
+	table, err := s.catalog.BuildReport(ctx, schedule.ReportID, now)
+	if err != nil {
+		return fmt.Errorf("failed to build report: %w", err)
+	}
+	content, contentType, err := renderReport(table, schedule.Format)
+	if err != nil {
+		return err
+	}
+	attachment := MailAttachment{
+		Filename:    fmt.Sprintf("%s-%s.%s", schedule.ReportID, now.UTC().Format("20060102-1504"), schedule.Format),
+		ContentType: contentType,
+		Content:     content,
+	}
+
+	switch schedule.Channel {
+	case models.ReportDeliveryEmail:
+		subject := fmt.Sprintf("%s: %s", schedule.Name, table.Title)
+		body := fmt.Sprintf("The %s report generated at %s is attached (%d rows).\n\nThis email is sent by the report schedule %q.\n",
+			table.Title, now.UTC().Format("2006-01-02 15:04 MST"), len(table.Rows), schedule.Name)
+		var failures []error
+		for _, recipient := range schedule.Recipients {
+			if err := s.mailer.SendWithAttachment(recipient, subject, body, attachment); err != nil {
+				failures = append(failures, err)
+			}
+		}
+		return errors.Join(failures...)
+	case models.ReportDeliveryWebhook:
+		if schedule.WebhookURL == nil {
+			return errors.New("webhook URL is not set")
+		}
+		return s.postWebhook(ctx, *schedule.WebhookURL, schedule, attachment)
+	default:
+		return fmt.Errorf("unsupported delivery channel %q", schedule.Channel)
+	}
+}
+
+// postWebhook posts the report file as the request body
+func (s *reportScheduleService) postWebhook(ctx context.Context, webhookURL string, schedule *models.ReportSchedule, attachment MailAttachment) error {
+	ctx, cancel := context.WithTimeout(ctx, s.client.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(attachment.Content))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", attachment.ContentType)
+	req.Header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", attachment.Filename))
+	req.Header.Set("X-Report-ID", schedule.ReportID)
+	req.Header.Set("X-Report-Schedule-ID", schedule.ID.String())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post report to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// alertOwner emails the owner of a schedule that its delivery failed
+func (s *reportScheduleService) alertOwner(ctx context.Context, schedule *models.ReportSchedule, deliveryErr error) {
+	owner := schedule.Owner
+	if owner == nil {
+		var err error
+		if owner, err = s.repos.User.GetByID(ctx, schedule.OwnerID); err != nil {
+			return
+		}
+	}
+	if !owner.IsActive() {
+		return
+	}
+
+	next := "it is not scheduled to run again"
+	if schedule.NextRunAt != nil {
+		next = "it will be retried at " + schedule.NextRunAt.UTC().Format("2006-01-02 15:04 MST")
+	}
+	body := fmt.Sprintf("Hello %s,\n\nThe report schedule %q could not deliver the %s report:\n\n  %s\n\nThe schedule stays enabled and %s. You will not be alerted again until a delivery succeeds.\n",
+		owner.Username, schedule.Name, schedule.ReportID, deliveryErr.Error(), next)
+	if err := s.mailer.Send(owner.Email, fmt.Sprintf("Report delivery failed: %s", schedule.Name), body); err != nil {
+		s.logger.WithFields(logrus.Fields{
+			"schedule_id": schedule.ID,
+			"error":       err.Error(),
+		}).Error("Failed to send report delivery failure alert")
+	}
+}
+
+// applyReportScheduleRequest validates a schedule request, copies it to the schedule and computes the next run
+func applyReportScheduleRequest(schedule *models.ReportSchedule, req ReportScheduleRequest, now time.Time) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return ErrReportScheduleNameRequired
+	}
+	cronExpression := strings.Join(strings.Fields(req.CronExpression), " ")
+	if _, err := ParseCronExpression(cronExpression); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCronExpression, err.Error())
+	}
+	timezone := strings.TrimSpace(req.Timezone)
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidReportTimezone, timezone)
+	}
+	if !req.Format.IsValid() {
+		return ErrInvalidReportFormat
+	}
+
+	var recipients []string
+	var webhookURL *string
+	switch req.Channel {
+	case models.ReportDeliveryEmail:
+		if len(req.Recipients) == 0 || len(req.Recipients) > maxReportRecipients {
+			return ErrInvalidReportRecipients
+		}
+		for _, recipient := range req.Recipients {
+			address, err := mail.ParseAddress(strings.TrimSpace(recipient))
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrInvalidReportRecipients, recipient)
+			}
+			recipients = append(recipients, address.Address)
+		}
+	case models.ReportDeliveryWebhook:
+		if req.WebhookURL == nil {
+			return ErrInvalidReportWebhookURL
+		}
+		parsed, err := url.Parse(strings.TrimSpace(*req.WebhookURL))
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return ErrInvalidReportWebhookURL
+		}
+		value := parsed.String()
+		webhookURL = &value
+	default:
+		return ErrInvalidReportChannel
+	}
+
+	schedule.Name = name
+	schedule.CronExpression = cronExpression
+	schedule.Timezone = timezone
+	schedule.Format = req.Format
+	schedule.Channel = req.Channel
+	schedule.Recipients = recipients
+	schedule.WebhookURL = webhookURL
+	schedule.Enabled = req.Enabled == nil || *req.Enabled
+	schedule.NextRunAt = nextReportRun(schedule, now)
+	return nil
+}
+
+// nextReportRun returns the next run of an enabled schedule after the given time, evaluated in its time zone
+func nextReportRun(schedule *models.ReportSchedule, after time.Time) *time.Time {
+	if !schedule.Enabled {
+		return nil
+	}
+	cron, err := ParseCronExpression(schedule.CronExpression)
+	if err != nil {
+		return nil
+	}
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	next := cron.Next(after.In(location))
+	if next.IsZero() {
+		return nil
+	}
+	next = next.UTC()
+	return &next
+}
+
+// RunReportScheduler delivers due report schedules every interval until the context is cancelled
+func RunReportScheduler(ctx context.Context, scheduleService ReportScheduleService, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			delivered, err := scheduleService.DeliverDueSchedules(ctx, now)
+			if err != nil {
+				logger.WithError(err).Error("Scheduled report run failed")
+				continue
+			}
+			if delivered > 0 {
+				logger.WithField("delivered", delivered).Info("Scheduled reports delivered")
+			}
+		}
+	}
+}
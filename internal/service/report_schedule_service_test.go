@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestReportScheduleService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, alice, _, requirements := setupSupersessionTest(t, active)
+	require.NoError(t, db.AutoMigrate(&models.StalenessPolicy{}, &models.StaleEntity{}, &models.ReportSchedule{}, &models.GlossaryTerm{}))
+	bob := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(bob).Error)
+
+	now := time.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC)
+	require.NoError(t, db.Model(&models.Requirement{}).Where("id = ?", requirements[0].ID).UpdateColumn("updated_at", now.AddDate(0, 0, -30)).Error)
+
+	mailer := &recordingMailer{}
+	repos := repository.NewRepositories(db, nil)
+	stalenessService := NewStalenessService(repos, mailer, logrus.New())
+	_, err := stalenessService.CreatePolicy(ctx, StalenessPolicyRequest{Name: "Idle requirements", EntityType: models.EntityTypeRequirement, MaxIdleDays: 14}, alice.ID)
+	require.NoError(t, err)
+	_, err = stalenessService.EvaluatePolicies(ctx, now)
+	require.NoError(t, err)
+	mailer.to, mailer.subject, mailer.body = nil, nil, nil
+
+	svc := NewReportScheduleService(repos, NewReportCatalog(stalenessService, NewGlossaryService(repos), NewSpellingService(repos, nil), nil, nil), mailer, &http.Client{Timeout: 30 * time.Second}, logrus.New())
+
+	emailSchedule, err := svc.CreateSchedule(ctx, ReportIDStale, ReportScheduleRequest{
+		Name: "Weekly stale items", CronExpression: "0  8 * * 1", Format: models.ReportFormatCSV,
+		Channel: models.ReportDeliveryEmail, Recipients: []string{"Ops <ops@example.com>"},
+	}, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "0 8 * * 1", emailSchedule.CronExpression)
+	assert.Equal(t, "UTC", emailSchedule.Timezone)
+	assert.Equal(t, []string{"ops@example.com"}, emailSchedule.Recipients)
+	require.NotNil(t, emailSchedule.NextRunAt)
+	assert.Equal(t, time.Monday, emailSchedule.NextRunAt.Weekday())
+
+	var webhookBody []byte
+	var webhookHeaders http.Header
+	webhookStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookBody, _ = io.ReadAll(r.Body)
+		webhookHeaders = r.Header
+		w.WriteHeader(webhookStatus)
+	}))
+	defer server.Close()
+
+	webhookURL := server.URL + "/reports"
+	webhookSchedule, err := svc.CreateSchedule(ctx, ReportIDStale, ReportScheduleRequest{
+		Name: "Hourly PDF", CronExpression: "@hourly", Format: models.ReportFormatPDF,
+		Channel: models.ReportDeliveryWebhook, WebhookURL: &webhookURL,
+	}, bob.ID)
+	require.NoError(t, err)
+
+	// Both schedules are due a week later
+	for _, schedule := range []*models.ReportSchedule{emailSchedule, webhookSchedule} {
+		require.NoError(t, db.Model(schedule).UpdateColumn("next_run_at", now).Error)
+	}
+
+	t.Run("delivers due schedules by email and webhook", func(t *testing.T) {
+		delivered, err := svc.DeliverDueSchedules(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 2, delivered)
+
+		require.Len(t, mailer.attachments, 1)
+		assert.Equal(t, "ops@example.com", mailer.to[0])
+		assert.Equal(t, "Weekly stale items: Stale items", mailer.subject[0])
+		assert.Equal(t, "stale-20240110-0930.csv", mailer.attachments[0].Filename)
+		assert.Contains(t, string(mailer.attachments[0].Content), "Reference,Type,Title,Status,Assignee,Last updated,Idle days,Policy,Stale since,Escalated\n")
+		assert.Contains(t, string(mailer.attachments[0].Content), "REQ-001,requirement,Password rule A,Active,alice,2023-12-11,30,Idle requirements,2024-01-10,\n")
+
+		assert.True(t, bytes.HasPrefix(webhookBody, []byte("%PDF-1.4")))
+		assert.Contains(t, string(webhookBody), pdfGlyphs(t, "REQ-001"))
+		assert.Equal(t, "application/pdf", webhookHeaders.Get("Content-Type"))
+		assert.Equal(t, ReportIDStale, webhookHeaders.Get("X-Report-ID"))
+
+		schedule, err := svc.GetSchedule(ctx, ReportIDStale, emailSchedule.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.ReportRunSucceeded, *schedule.LastStatus)
+		assert.Equal(t, time.Date(2024, time.January, 15, 8, 0, 0, 0, time.UTC), schedule.NextRunAt.UTC())
+
+		delivered, err = svc.DeliverDueSchedules(ctx, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Zero(t, delivered, "nothing is due until the next run")
+	})
+
+	t.Run("records failures and alerts the owner once", func(t *testing.T) {
+		webhookStatus = http.StatusServiceUnavailable
+		mailer.to, mailer.subject, mailer.body = nil, nil, nil
+
+		schedule, err := svc.RunSchedule(ctx, ReportIDStale, webhookSchedule.ID, bob)
+		require.NoError(t, err)
+		assert.Equal(t, models.ReportRunFailed, *schedule.LastStatus)
+		assert.Equal(t, "webhook responded with status 503", *schedule.LastError)
+		assert.Equal(t, 1, schedule.ConsecutiveFailures)
+		require.Len(t, mailer.to, 1)
+		assert.Equal(t, "bob@example.com", mailer.to[0])
+		assert.Equal(t, "Report delivery failed: Hourly PDF", mailer.subject[0])
+
+		schedule, err = svc.RunSchedule(ctx, ReportIDStale, webhookSchedule.ID, bob)
+		require.NoError(t, err)
+		assert.Equal(t, 2, schedule.ConsecutiveFailures)
+		assert.Len(t, mailer.to, 1, "the owner is alerted once per failure streak")
+
+		webhookStatus = http.StatusOK
+		schedule, err = svc.RunSchedule(ctx, ReportIDStale, webhookSchedule.ID, bob)
+		require.NoError(t, err)
+		assert.Zero(t, schedule.ConsecutiveFailures)
+		assert.Nil(t, schedule.LastError)
+	})
+
+	t.Run("records email failures", func(t *testing.T) {
+		mailer.err = errors.New("connection refused")
+		defer func() { mailer.err = nil }()
+
+		schedule, err := svc.RunSchedule(ctx, ReportIDStale, emailSchedule.ID, alice)
+		require.NoError(t, err)
+		assert.Equal(t, models.ReportRunFailed, *schedule.LastStatus)
+		assert.Equal(t, "connection refused", *schedule.LastError)
+	})
+
+	t.Run("refuses webhooks to non-public addresses", func(t *testing.T) {
+		svc.(*reportScheduleService).client = NewWebhookClient(time.Second)
+		defer func() { svc.(*reportScheduleService).client = &http.Client{Timeout: 30 * time.Second} }()
+		webhookBody = nil
+
+		schedule, err := svc.RunSchedule(ctx, ReportIDStale, webhookSchedule.ID, bob)
+		require.NoError(t, err)
+		assert.Equal(t, models.ReportRunFailed, *schedule.LastStatus)
+		assert.Contains(t, *schedule.LastError, "webhook host does not resolve to a public address: 127.0.0.1")
+		assert.Nil(t, webhookBody, "the webhook is not contacted")
+	})
+
+	t.Run("refuses every webhook when delivery is disabled", func(t *testing.T) {
+		svc.(*reportScheduleService).client = NewDisabledWebhookClient(time.Second)
+		defer func() { svc.(*reportScheduleService).client = &http.Client{Timeout: 30 * time.Second} }()
+		webhookBody = nil
+
+		schedule, err := svc.RunSchedule(ctx, ReportIDStale, webhookSchedule.ID, bob)
+		require.NoError(t, err)
+		assert.Equal(t, models.ReportRunFailed, *schedule.LastStatus)
+		assert.Contains(t, *schedule.LastError, "webhook delivery is disabled on this server")
+		assert.Nil(t, webhookBody, "the webhook is not contacted")
+	})
+
+	t.Run("only owners and administrators change schedules", func(t *testing.T) {
+		_, err := svc.RunSchedule(ctx, ReportIDStale, webhookSchedule.ID, alice)
+		assert.ErrorIs(t, err, ErrUnauthorizedAccess)
+		assert.ErrorIs(t, svc.DeleteSchedule(ctx, ReportIDStale, webhookSchedule.ID, alice), ErrUnauthorizedAccess)
+
+		admin := &models.User{Username: "admin", Role: models.RoleAdministrator}
+		disabled := false
+		schedule, err := svc.UpdateSchedule(ctx, ReportIDStale, webhookSchedule.ID, ReportScheduleRequest{
+			Name: "Paused", CronExpression: "@daily", Format: models.ReportFormatCSV,
+			Channel: models.ReportDeliveryWebhook, WebhookURL: &webhookURL, Enabled: &disabled,
+		}, admin)
+		require.NoError(t, err)
+		assert.False(t, schedule.Enabled)
+		assert.Nil(t, schedule.NextRunAt)
+	})
+
+	t.Run("validates schedules", func(t *testing.T) {
+		valid := ReportScheduleRequest{Name: "Daily", CronExpression: "@daily", Format: models.ReportFormatCSV,
+			Channel: models.ReportDeliveryEmail, Recipients: []string{"ops@example.com"}}
+
+		_, err := svc.CreateSchedule(ctx, "velocity", valid, alice.ID)
+		assert.ErrorIs(t, err, ErrReportNotFound)
+
+		invalid := valid
+		invalid.CronExpression = "0 25 * * *"
+		_, err = svc.CreateSchedule(ctx, ReportIDUndefinedTerms, invalid, alice.ID)
+		assert.ErrorIs(t, err, ErrInvalidCronExpression)
+
+		invalid = valid
+		invalid.Timezone = "Mars/Olympus"
+		_, err = svc.CreateSchedule(ctx, ReportIDUndefinedTerms, invalid, alice.ID)
+		assert.ErrorIs(t, err, ErrInvalidReportTimezone)
+
+		invalid = valid
+		invalid.Format = "xlsx"
+		_, err = svc.CreateSchedule(ctx, ReportIDUndefinedTerms, invalid, alice.ID)
+		assert.ErrorIs(t, err, ErrInvalidReportFormat)
+
+		invalid = valid
+		invalid.Recipients = []string{"not an address"}
+		_, err = svc.CreateSchedule(ctx, ReportIDUndefinedTerms, invalid, alice.ID)
+		assert.ErrorIs(t, err, ErrInvalidReportRecipients)
+
+		ftp := "ftp://example.com/reports"
+		invalid = valid
+		invalid.Channel, invalid.WebhookURL = models.ReportDeliveryWebhook, &ftp
+		_, err = svc.CreateSchedule(ctx, ReportIDUndefinedTerms, invalid, alice.ID)
+		assert.ErrorIs(t, err, ErrInvalidReportWebhookURL)
+
+		_, err = svc.GetSchedule(ctx, ReportIDUndefinedTerms, emailSchedule.ID)
+		assert.ErrorIs(t, err, ErrReportScheduleNotFound, "schedules are scoped to their report")
+	})
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for address, public := range map[string]bool{
+		"93.184.216.34":          true,
+		"2606:2800:220:1::1":     true,
+		"127.0.0.1":              false,
+		"10.1.2.3":               false,
+		"172.16.0.1":             false,
+		"192.168.1.1":            false,
+		"169.254.169.254":        false,
+		"100.64.0.1":             false,
+		"0.0.0.0":                false,
+		"255.255.255.255":        false,
+		"::1":                    false,
+		"fd00::1":                false,
+		"fe80::1":                false,
+		"::ffff:127.0.0.1":       false,
+		"::ffff:169.254.169.254": false,
+		"64:ff9b::a00:1":         false,
+	} {
+		assert.Equal(t, public, isPublicAddress(netip.MustParseAddr(address)), address)
+	}
+}
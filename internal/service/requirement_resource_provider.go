@@ -30,7 +30,7 @@ func (p *RequirementResourceProvider) GetResourceDescriptors(ctx context.Context
 	p.logger.WithContext(ctx).Debug("Getting requirement resource descriptors")
 
 	// Get requirements with reasonable limit (1000 items max as per design)
-	requirements, err := p.requirementRepo.List(nil, "created_at ASC", 1000, 0)
+	requirements, err := p.requirementRepo.List(ctx, nil, "created_at ASC", 1000, 0)
 	if err != nil {
 		p.logger.WithContext(ctx).WithError(err).Error("Failed to get requirements for resource descriptors")
 		return nil, fmt.Errorf("failed to get requirements: %w", err)
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrRiskReportStatus   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "status must be one of: Draft, Active, Obsolete")
+	ErrRiskReportMinScore = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "min_score must not be negative")
+)
+
+// Points each risk factor adds to the score of a requirement, and the scores where the levels start
+const (
+	riskPointsPerBlockingRelationship   = 10
+	riskPointsPerUnresolvedBlocker      = 20
+	riskPointsMissingAcceptanceCriteria = 15
+	riskPointsPerDraftWeek              = 5
+	riskMaxDraftPoints                  = 30
+	riskMediumScore                     = 25
+	riskHighScore                       = 50
+)
+
+// Number of requirements in the risk report by default and at most
+const (
+	defaultRiskReportLimit = 50
+	maxRiskReportLimit     = 200
+)
+
+// RiskLevel buckets risk scores for display
+// @Description Risk level of a requirement: low below 25 points, medium below 50 and high from 50
+// @Example "high"
+type RiskLevel string
+
+const (
+	RiskLevelLow    RiskLevel = "low"
+	RiskLevelMedium RiskLevel = "medium"
+	RiskLevelHigh   RiskLevel = "high"
+)
+
+// RequirementRisk is the risk score of a requirement with the factors it was computed from
+type RequirementRisk struct {
+	Score                     int       `json:"score" example:"55"`                          // Sum of the points of the factors
+	Level                     RiskLevel `json:"level" example:"high"`                        // low, medium or high
+	BlockingRelationships     int64     `json:"blocking_relationships" example:"2"`          // "blocks" relationships the requirement takes part in, 10 points each
+	UnresolvedBlockers        int64     `json:"unresolved_blocker_comments" example:"1"`     // Unresolved comments of the blocker category, 20 points each
+	DraftDays                 int       `json:"draft_days" example:"21"`                     // Whole days in Draft, 5 points per week up to 30; 0 when not Draft
+	MissingAcceptanceCriteria bool      `json:"missing_acceptance_criteria" example:"false"` // Not linked to acceptance criteria, 15 points
+}
+
+// RequirementRiskEntry is a requirement in the risk report
+type RequirementRiskEntry struct {
+	repository.RequirementRiskFactors
+	Risk RequirementRisk `json:"risk"`
+}
+
+// RequirementRiskReportFilter narrows the risk report
+type RequirementRiskReportFilter struct {
+	Status     *models.RequirementStatus // Draft and Active requirements when nil
+	EpicID     *uuid.UUID
+	AssigneeID *uuid.UUID
+	MinScore   int
+	Limit      int                         // 50 when zero, at most 200
+	Portfolio  *repository.PortfolioFilter // Only requirements of the epics in the portfolio
+}
+
+// RequirementRiskService defines the interface for scoring requirements by the risk they carry, to prioritize reviews
+type RequirementRiskService interface {
+	Scores(ctx context.Context, ids []uuid.UUID, now time.Time) (map[uuid.UUID]RequirementRisk, error)
+	GetRiskReport(ctx context.Context, filter RequirementRiskReportFilter, now time.Time) ([]RequirementRiskEntry, error)
+}
+
+// requirementRiskService implements RequirementRiskService interface
+type requirementRiskService struct {
+	repos *repository.Repositories
+}
+
+// NewRequirementRiskService creates a new requirement risk service instance
+func NewRequirementRiskService(repos *repository.Repositories) RequirementRiskService {
+	return &requirementRiskService{repos: repos}
+}
+
+// Scores computes the risk of the given requirements; requirements that were not found are left out
+func (s *requirementRiskService) Scores(ctx context.Context, ids []uuid.UUID, now time.Time) (map[uuid.UUID]RequirementRisk, error) {
+	if ids == nil {
+		ids = []uuid.UUID{}
+	}
+	items, err := s.repos.RequirementRisk.ListRiskFactors(ctx, repository.RequirementRiskFilter{IDs: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirement risk factors: %w", err)
+	}
+
+	scores := make(map[uuid.UUID]RequirementRisk, len(items))
+	for _, item := range items {
+		scores[item.RequirementID] = scoreRequirementRisk(item, now)
+	}
+	return scores, nil
+}
+
+// GetRiskReport ranks requirements by risk score, highest first; ties go to the higher priority, then the reference ID
+func (s *requirementRiskService) GetRiskReport(ctx context.Context, filter RequirementRiskReportFilter, now time.Time) ([]RequirementRiskEntry, error) {
+	statuses := []models.RequirementStatus{models.RequirementStatusDraft, models.RequirementStatusActive}
+	if filter.Status != nil {
+		switch *filter.Status {
+		case models.RequirementStatusDraft, models.RequirementStatusActive, models.RequirementStatusObsolete:
+			statuses = []models.RequirementStatus{*filter.Status}
+		default:
+			return nil, ErrRiskReportStatus
+		}
+	}
+	if filter.MinScore < 0 {
+		return nil, ErrRiskReportMinScore
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultRiskReportLimit
+	}
+	if limit > maxRiskReportLimit {
+		limit = maxRiskReportLimit
+	}
+
+	items, err := s.repos.RequirementRisk.ListRiskFactors(ctx, repository.RequirementRiskFilter{
+		Statuses:   statuses,
+		EpicID:     filter.EpicID,
+		AssigneeID: filter.AssigneeID,
+		Portfolio:  filter.Portfolio,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirement risk factors: %w", err)
+	}
+
+	entries := make([]RequirementRiskEntry, 0, len(items))
+	for _, item := range items {
+		risk := scoreRequirementRisk(item, now)
+		if risk.Score >= filter.MinScore {
+			entries = append(entries, RequirementRiskEntry{RequirementRiskFactors: item, Risk: risk})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Risk.Score != entries[j].Risk.Score {
+			return entries[i].Risk.Score > entries[j].Risk.Score
+		}
+		return entries[i].Priority < entries[j].Priority
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
+
+// scoreRequirementRisk adds up the points of the risk factors of a requirement
+func scoreRequirementRisk(item repository.RequirementRiskFactors, now time.Time) RequirementRisk {
+	risk := RequirementRisk{
+		BlockingRelationships:     item.BlockingRelationships,
+		UnresolvedBlockers:        item.UnresolvedBlockers,
+		MissingAcceptanceCriteria: item.MissingAcceptanceCriteria,
+	}
+	risk.Score = int(item.BlockingRelationships)*riskPointsPerBlockingRelationship +
+		int(item.UnresolvedBlockers)*riskPointsPerUnresolvedBlocker
+
+	if item.MissingAcceptanceCriteria {
+		risk.Score += riskPointsMissingAcceptanceCriteria
+	}
+	if item.Status == models.RequirementStatusDraft && now.After(item.DraftSince) {
+		risk.DraftDays = idleDays(item.DraftSince, now)
+		draftPoints := risk.DraftDays / 7 * riskPointsPerDraftWeek
+		if draftPoints > riskMaxDraftPoints {
+			draftPoints = riskMaxDraftPoints
+		}
+		risk.Score += draftPoints
+	}
+
+	switch {
+	case risk.Score >= riskHighScore:
+		risk.Level = RiskLevelHigh
+	case risk.Score >= riskMediumScore:
+		risk.Level = RiskLevelMedium
+	default:
+		risk.Level = RiskLevelLow
+	}
+	return risk
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestScoreRequirementRisk(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name  string
+		item  repository.RequirementRiskFactors
+		score int
+		level RiskLevel
+		days  int
+	}{
+		{"nothing at risk", repository.RequirementRiskFactors{Status: models.RequirementStatusActive}, 0, RiskLevelLow, 0},
+		{"missing acceptance criteria", repository.RequirementRiskFactors{Status: models.RequirementStatusActive, MissingAcceptanceCriteria: true}, 15, RiskLevelLow, 0},
+		{"blocking relationships and blockers", repository.RequirementRiskFactors{Status: models.RequirementStatusActive, BlockingRelationships: 1, UnresolvedBlockers: 1}, 30, RiskLevelMedium, 0},
+		{"weeks in Draft", repository.RequirementRiskFactors{Status: models.RequirementStatusDraft, DraftSince: now.AddDate(0, 0, -15)}, 10, RiskLevelLow, 15},
+		{"Draft points are capped", repository.RequirementRiskFactors{Status: models.RequirementStatusDraft, DraftSince: now.AddDate(0, -6, 0), UnresolvedBlockers: 1}, 50, RiskLevelHigh, int(now.Sub(now.AddDate(0, -6, 0)).Hours() / 24)},
+	}
+	for _, tt := range tests {
+		risk := scoreRequirementRisk(tt.item, now)
+		assert.Equal(t, tt.score, risk.Score, tt.name)
+		assert.Equal(t, tt.level, risk.Level, tt.name)
+		assert.Equal(t, tt.days, risk.DraftDays, tt.name)
+	}
+}
+
+func TestRequirementRiskService(t *testing.T) {
+	ctx := context.Background()
+	draft, active, obsolete := models.RequirementStatusDraft, models.RequirementStatusActive, models.RequirementStatusObsolete
+	db, alice, epic, requirements := setupSupersessionTest(t, draft, draft, active, obsolete)
+	require.NoError(t, db.AutoMigrate(&models.Comment{}, &models.AuditEvent{}))
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	now := time.Now()
+
+	relationshipType := func(name string) uuid.UUID {
+		var relationshipType models.RelationshipType
+		require.NoError(t, db.Where("name = ?", name).First(&relationshipType).Error)
+		return relationshipType.ID
+	}
+	relate := func(source, target models.Requirement, typeName string) {
+		require.NoError(t, session.Create(&models.RequirementRelationship{ID: uuid.New(), SourceRequirementID: source.ID,
+			TargetRequirementID: target.ID, RelationshipTypeID: relationshipType(typeName), CreatedBy: alice.ID}).Error)
+	}
+	comment := func(requirement models.Requirement, category models.CommentCategory, resolved bool) {
+		require.NoError(t, session.Create(&models.Comment{ID: uuid.New(), EntityType: models.EntityTypeRequirement, EntityID: requirement.ID,
+			AuthorID: alice.ID, Content: "Needs work", Category: &category, IsResolved: resolved}).Error)
+	}
+	linkAcceptanceCriteria := func(requirement models.Requirement) {
+		require.NoError(t, db.Model(&models.Requirement{}).Where("id = ?", requirement.ID).UpdateColumn("acceptance_criteria_id", uuid.New()).Error)
+	}
+
+	// REQ-001 was created a month ago and went back to Draft ten days ago: 15 (no acceptance criteria) + 10 (blocks) + 5 (one week)
+	require.NoError(t, db.Model(&models.Requirement{}).Where("id = ?", requirements[0].ID).UpdateColumn("created_at", now.AddDate(0, -1, 0)).Error)
+	newValue := string(models.RequirementStatusDraft)
+	require.NoError(t, db.Create(&models.AuditEvent{EntityType: models.EntityTypeRequirement, EntityID: requirements[0].ID,
+		Action: models.AuditActionStatusChanged, Field: "status", NewValue: &newValue, CreatedAt: now.AddDate(0, 0, -10)}).Error)
+	relate(requirements[0], requirements[2], "blocks")
+	// REQ-002 has acceptance criteria and three unresolved blocker comments: 60
+	linkAcceptanceCriteria(requirements[1])
+	for i := 0; i < 3; i++ {
+		comment(requirements[1], models.CommentCategoryBlocker, false)
+	}
+	comment(requirements[1], models.CommentCategoryBlocker, true)
+	comment(requirements[1], models.CommentCategoryQuestion, false)
+	relate(requirements[1], requirements[2], "relates_to")
+	// REQ-003 is blocked by REQ-001 and has no acceptance criteria: 25
+
+	svc := NewRequirementRiskService(repository.NewRepositories(db, nil))
+
+	t.Run("scores requirements by ID", func(t *testing.T) {
+		scores, err := svc.Scores(ctx, []uuid.UUID{requirements[0].ID, requirements[2].ID, uuid.New()}, now)
+		require.NoError(t, err)
+		require.Len(t, scores, 2)
+		assert.Equal(t, RequirementRisk{Score: 30, Level: RiskLevelMedium, BlockingRelationships: 1, DraftDays: 10, MissingAcceptanceCriteria: true}, scores[requirements[0].ID])
+		assert.Equal(t, RequirementRisk{Score: 25, Level: RiskLevelMedium, BlockingRelationships: 1, MissingAcceptanceCriteria: true}, scores[requirements[2].ID])
+
+		scores, err = svc.Scores(ctx, nil, now)
+		require.NoError(t, err)
+		assert.Empty(t, scores)
+	})
+
+	t.Run("ranks Draft and Active requirements by score", func(t *testing.T) {
+		entries, err := svc.GetRiskReport(ctx, RequirementRiskReportFilter{}, now)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "REQ-002", entries[0].ReferenceID)
+		assert.Equal(t, 60, entries[0].Risk.Score)
+		assert.Equal(t, RiskLevelHigh, entries[0].Risk.Level)
+		assert.Equal(t, int64(3), entries[0].Risk.UnresolvedBlockers)
+		assert.False(t, entries[0].Risk.MissingAcceptanceCriteria)
+		assert.Equal(t, "REQ-001", entries[1].ReferenceID)
+		assert.Equal(t, "REQ-003", entries[2].ReferenceID)
+		assert.Equal(t, "alice", entries[2].AssigneeUsername)
+		assert.Equal(t, epic.ID, entries[2].EpicID)
+	})
+
+	t.Run("filters the report", func(t *testing.T) {
+		entries, err := svc.GetRiskReport(ctx, RequirementRiskReportFilter{MinScore: 30, Limit: 1}, now)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "REQ-002", entries[0].ReferenceID)
+
+		entries, err = svc.GetRiskReport(ctx, RequirementRiskReportFilter{Status: &obsolete}, now)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "REQ-004", entries[0].ReferenceID)
+
+		otherEpic := uuid.New()
+		entries, err = svc.GetRiskReport(ctx, RequirementRiskReportFilter{EpicID: &otherEpic}, now)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("validates the filter", func(t *testing.T) {
+		invalid := models.RequirementStatus("Approved")
+		_, err := svc.GetRiskReport(ctx, RequirementRiskReportFilter{Status: &invalid}, now)
+		assert.ErrorIs(t, err, ErrRiskReportStatus)
+		_, err = svc.GetRiskReport(ctx, RequirementRiskReportFilter{MinScore: -1}, now)
+		assert.ErrorIs(t, err, ErrRiskReportMinScore)
+	})
+}
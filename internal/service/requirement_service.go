@@ -1,43 +1,51 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 	"product-requirements-management/internal/validation"
 )
 
 var (
-	ErrRequirementNotFound         = errors.New("requirement not found")
-	ErrRequirementHasRelationships = errors.New("requirement has associated relationships and cannot be deleted")
-	ErrInvalidRequirementStatus    = errors.New("invalid requirement status")
+	ErrRequirementNotFound         = apperrors.New(apperrors.KindNotFound, "REQUIREMENT_NOT_FOUND", "requirement not found")
+	ErrRequirementHasRelationships = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "requirement has associated relationships and cannot be deleted")
+	ErrInvalidRequirementStatus    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid requirement status")
 
-	ErrCircularRelationship  = errors.New("circular relationship detected")
-	ErrDuplicateRelationship = errors.New("relationship already exists")
+	ErrCircularRelationship  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "circular relationship detected")
+	ErrDuplicateRelationship = apperrors.New(apperrors.KindConflict, "RELATIONSHIP_EXISTS", "relationship already exists")
+
+	ErrAcceptanceCriteriaOfOtherUserStory = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "acceptance criteria belongs to another user story")
+	ErrAcceptanceCriteriaNotLinked        = apperrors.New(apperrors.KindNotFound, "ACCEPTANCE_CRITERIA_LINK_NOT_FOUND", "requirement is not linked to the acceptance criteria")
 )
 
 // RequirementService defines the interface for requirement business logic
 type RequirementService interface {
-	CreateRequirement(req CreateRequirementRequest) (*models.Requirement, error)
-	GetRequirementByID(id uuid.UUID) (*models.Requirement, error)
-	GetRequirementByReferenceID(referenceID string) (*models.Requirement, error)
-	UpdateRequirement(id uuid.UUID, req UpdateRequirementRequest) (*models.Requirement, error)
-	DeleteRequirement(id uuid.UUID, force bool) error
-	ListRequirements(filters RequirementFilters) ([]models.Requirement, int64, error)
-	GetRequirementWithRelationships(id uuid.UUID) (*models.Requirement, error)
-	GetRequirementsByUserStory(userStoryID uuid.UUID) ([]models.Requirement, error)
-	ChangeRequirementStatus(id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error)
-	AssignRequirement(id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error)
-	CreateRelationship(req CreateRelationshipRequest) (*models.RequirementRelationship, error)
-	DeleteRelationship(id uuid.UUID) error
-	GetRelationshipsByRequirement(requirementID uuid.UUID) ([]models.RequirementRelationship, error)
-	GetRelationshipsByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error)
-	SearchRequirements(searchText string) ([]models.Requirement, error)
-	SearchRequirementsWithPagination(searchText string, limit, offset int) ([]models.Requirement, int64, error)
+	CreateRequirement(ctx context.Context, req CreateRequirementRequest) (*models.Requirement, error)
+	GetRequirementByID(ctx context.Context, id uuid.UUID) (*models.Requirement, error)
+	GetRequirementByReferenceID(ctx context.Context, referenceID string) (*models.Requirement, error)
+	UpdateRequirement(ctx context.Context, id uuid.UUID, req UpdateRequirementRequest) (*models.Requirement, error)
+	DeleteRequirement(ctx context.Context, id uuid.UUID, force bool) error
+	ListRequirements(ctx context.Context, filters RequirementFilters) ([]models.Requirement, int64, error)
+	GetRequirementWithRelationships(ctx context.Context, id uuid.UUID) (*models.Requirement, error)
+	GetRequirementsByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error)
+	ChangeRequirementStatus(ctx context.Context, id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error)
+	AssignRequirement(ctx context.Context, id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error)
+	CreateRelationship(ctx context.Context, req CreateRelationshipRequest) (*models.RequirementRelationship, error)
+	DeleteRelationship(ctx context.Context, id uuid.UUID) error
+	GetRelationshipsByRequirement(ctx context.Context, requirementID uuid.UUID) ([]models.RequirementRelationship, error)
+	GetRelationshipsByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error)
+	SearchRequirements(ctx context.Context, searchText string) ([]models.Requirement, error)
+	SearchRequirementsWithPagination(ctx context.Context, searchText string, limit, offset int) ([]models.Requirement, int64, error)
+	GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]models.AcceptanceCriteria, error)
+	LinkAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID, acceptanceCriteriaIDs []uuid.UUID) (*models.Requirement, error)
+	UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) (*models.Requirement, error)
 }
 
 // CreateRequirementRequest represents the request to create a requirement
@@ -52,7 +60,8 @@ type CreateRequirementRequest struct {
 	Description          *string         `json:"description,omitempty"`
 }
 
-// UpdateRequirementRequest represents the request to update a requirement
+// UpdateRequirementRequest represents the request to update a requirement. AcceptanceCriteriaID changes the
+// primary acceptance criteria; the previous one stays linked.
 type UpdateRequirementRequest struct {
 	AcceptanceCriteriaID *uuid.UUID                `json:"acceptance_criteria_id,omitempty"`
 	AssigneeID           *uuid.UUID                `json:"assignee_id,omitempty"`
@@ -73,10 +82,16 @@ type RequirementFilters struct {
 	Priority             *models.Priority          `json:"priority,omitempty"`
 	TypeID               *uuid.UUID                `json:"type_id,omitempty"`
 	OrderBy              string                    `json:"order_by,omitempty"`
+	Sort                 string                    `json:"sort,omitempty"` // Sort keys such as priority:asc,updated_at:desc; takes precedence over OrderBy
 	Limit                int                       `json:"limit,omitempty"`
 	Offset               int                       `json:"offset,omitempty"`
 }
 
+// LinkAcceptanceCriteriaRequest represents the request to link acceptance criteria to a requirement
+type LinkAcceptanceCriteriaRequest struct {
+	AcceptanceCriteriaIDs []uuid.UUID `json:"acceptance_criteria_ids" binding:"required,min=1"`
+}
+
 // CreateRelationshipRequest represents the request to create a requirement relationship
 type CreateRelationshipRequest struct {
 	SourceRequirementID uuid.UUID `json:"source_requirement_id" binding:"required"`
@@ -95,6 +110,9 @@ type requirementService struct {
 	acceptanceCriteriaRepo      repository.AcceptanceCriteriaRepository
 	userRepo                    repository.UserRepository
 	statusValidator             validation.StatusValidator
+	assignmentRules             AssignmentRuleEvaluator
+	// redirectRepo resolves retired reference IDs when set
+	redirectRepo repository.ReferenceRedirectRepository
 }
 
 // NewRequirementService creates a new requirement service instance
@@ -120,48 +138,61 @@ func NewRequirementService(
 }
 
 // CreateRequirement creates a new requirement
-func (s *requirementService) CreateRequirement(req CreateRequirementRequest) (*models.Requirement, error) {
+func (s *requirementService) CreateRequirement(ctx context.Context, req CreateRequirementRequest) (*models.Requirement, error) {
 	// Validate priority
 	if req.Priority < models.PriorityCritical || req.Priority > models.PriorityLow {
 		return nil, ErrInvalidPriority
 	}
 
 	// Validate user story exists
-	if exists, err := s.userStoryRepo.Exists(req.UserStoryID); err != nil {
+	if exists, err := s.userStoryRepo.Exists(ctx, req.UserStoryID); err != nil {
 		return nil, fmt.Errorf("failed to check user story existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserStoryNotFound
 	}
 
 	// Validate requirement type exists
-	if exists, err := s.requirementTypeRepo.Exists(req.TypeID); err != nil {
+	if exists, err := s.requirementTypeRepo.Exists(ctx, req.TypeID); err != nil {
 		return nil, fmt.Errorf("failed to check requirement type existence: %w", err)
 	} else if !exists {
 		return nil, ErrRequirementTypeNotFound
 	}
 
 	// Validate creator exists
-	if exists, err := s.userRepo.Exists(req.CreatorID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, req.CreatorID); err != nil {
 		return nil, fmt.Errorf("failed to check creator existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
 	}
 
-	// Set assignee to creator if not specified
+	// Set assignee to creator if not specified and no assignment rule matches
 	assigneeID := req.CreatorID
 	if req.AssigneeID != nil {
 		assigneeID = *req.AssigneeID
 		// Validate assignee exists
-		if exists, err := s.userRepo.Exists(assigneeID); err != nil {
+		if exists, err := s.userRepo.Exists(ctx, assigneeID); err != nil {
 			return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 		} else if !exists {
 			return nil, ErrUserNotFound
 		}
+	} else if s.assignmentRules != nil {
+		ruleAssigneeID, err := s.assignmentRules.DefaultAssignee(ctx, AssignmentSubject{
+			EntityType:        models.EntityTypeRequirement,
+			CreatorID:         req.CreatorID,
+			UserStoryID:       &req.UserStoryID,
+			RequirementTypeID: &req.TypeID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate assignment rules: %w", err)
+		}
+		if ruleAssigneeID != nil {
+			assigneeID = *ruleAssigneeID
+		}
 	}
 
 	// Validate acceptance criteria if provided
 	if req.AcceptanceCriteriaID != nil {
-		if exists, err := s.acceptanceCriteriaRepo.Exists(*req.AcceptanceCriteriaID); err != nil {
+		if exists, err := s.acceptanceCriteriaRepo.Exists(ctx, *req.AcceptanceCriteriaID); err != nil {
 			return nil, fmt.Errorf("failed to check acceptance criteria existence: %w", err)
 		} else if !exists {
 			return nil, ErrAcceptanceCriteriaNotFound
@@ -181,7 +212,7 @@ func (s *requirementService) CreateRequirement(req CreateRequirementRequest) (*m
 		Description:          req.Description,
 	}
 
-	if err := s.requirementRepo.Create(requirement); err != nil {
+	if err := s.requirementRepo.Create(ctx, requirement); err != nil {
 		return nil, fmt.Errorf("failed to create requirement: %w", err)
 	}
 
@@ -189,8 +220,8 @@ func (s *requirementService) CreateRequirement(req CreateRequirementRequest) (*m
 }
 
 // GetRequirementByID retrieves a requirement by its ID with all relationships preloaded
-func (s *requirementService) GetRequirementByID(id uuid.UUID) (*models.Requirement, error) {
-	requirement, err := s.requirementRepo.GetByIDWithPreloads(id)
+func (s *requirementService) GetRequirementByID(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
+	requirement, err := s.requirementRepo.GetByIDWithPreloads(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementNotFound
@@ -200,12 +231,13 @@ func (s *requirementService) GetRequirementByID(id uuid.UUID) (*models.Requireme
 	return requirement, nil
 }
 
-// GetRequirementByReferenceID retrieves a requirement by its reference ID with all relationships preloaded
-func (s *requirementService) GetRequirementByReferenceID(referenceID string) (*models.Requirement, error) {
-	requirement, err := s.requirementRepo.GetByReferenceIDWithPreloads(referenceID)
+// GetRequirementByReferenceID retrieves a requirement by its reference ID with all relationships preloaded.
+// A retired reference ID resolves to its successor.
+func (s *requirementService) GetRequirementByReferenceID(ctx context.Context, referenceID string) (*models.Requirement, error) {
+	requirement, err := s.requirementRepo.GetByReferenceIDWithPreloads(ctx, referenceID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, ErrRequirementNotFound
+			return getRedirected(ctx, s.redirectRepo, models.EntityTypeRequirement, referenceID, ErrRequirementNotFound, s.GetRequirementByID)
 		}
 		return nil, fmt.Errorf("failed to get requirement: %w", err)
 	}
@@ -213,8 +245,8 @@ func (s *requirementService) GetRequirementByReferenceID(referenceID string) (*m
 }
 
 // UpdateRequirement updates an existing requirement
-func (s *requirementService) UpdateRequirement(id uuid.UUID, req UpdateRequirementRequest) (*models.Requirement, error) {
-	requirement, err := s.requirementRepo.GetByID(id)
+func (s *requirementService) UpdateRequirement(ctx context.Context, id uuid.UUID, req UpdateRequirementRequest) (*models.Requirement, error) {
+	requirement, err := s.requirementRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementNotFound
@@ -225,7 +257,7 @@ func (s *requirementService) UpdateRequirement(id uuid.UUID, req UpdateRequireme
 	// Update fields if provided
 	if req.AcceptanceCriteriaID != nil {
 		// Validate acceptance criteria exists
-		if exists, err := s.acceptanceCriteriaRepo.Exists(*req.AcceptanceCriteriaID); err != nil {
+		if exists, err := s.acceptanceCriteriaRepo.Exists(ctx, *req.AcceptanceCriteriaID); err != nil {
 			return nil, fmt.Errorf("failed to check acceptance criteria existence: %w", err)
 		} else if !exists {
 			return nil, ErrAcceptanceCriteriaNotFound
@@ -235,7 +267,7 @@ func (s *requirementService) UpdateRequirement(id uuid.UUID, req UpdateRequireme
 
 	if req.AssigneeID != nil {
 		// Validate assignee exists
-		if exists, err := s.userRepo.Exists(*req.AssigneeID); err != nil {
+		if exists, err := s.userRepo.Exists(ctx, *req.AssigneeID); err != nil {
 			return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 		} else if !exists {
 			return nil, ErrUserNotFound
@@ -261,11 +293,15 @@ func (s *requirementService) UpdateRequirement(id uuid.UUID, req UpdateRequireme
 			return nil, ErrInvalidStatusTransition
 		}
 		requirement.Status = *req.Status
+		if requirement.Status != models.RequirementStatusObsolete {
+			// Only obsolete requirements are superseded
+			requirement.SupersededByID = nil
+		}
 	}
 
 	if req.TypeID != nil {
 		// Validate requirement type exists
-		if exists, err := s.requirementTypeRepo.Exists(*req.TypeID); err != nil {
+		if exists, err := s.requirementTypeRepo.Exists(ctx, *req.TypeID); err != nil {
 			return nil, fmt.Errorf("failed to check requirement type existence: %w", err)
 		} else if !exists {
 			return nil, ErrRequirementTypeNotFound
@@ -281,7 +317,7 @@ func (s *requirementService) UpdateRequirement(id uuid.UUID, req UpdateRequireme
 		requirement.Description = req.Description
 	}
 
-	if err := s.requirementRepo.Update(requirement); err != nil {
+	if err := s.requirementRepo.Update(ctx, requirement); err != nil {
 		return nil, fmt.Errorf("failed to update requirement: %w", err)
 	}
 
@@ -289,9 +325,9 @@ func (s *requirementService) UpdateRequirement(id uuid.UUID, req UpdateRequireme
 }
 
 // DeleteRequirement deletes a requirement with dependency validation
-func (s *requirementService) DeleteRequirement(id uuid.UUID, force bool) error {
+func (s *requirementService) DeleteRequirement(ctx context.Context, id uuid.UUID, force bool) error {
 	// Check if requirement exists
-	_, err := s.requirementRepo.GetByID(id)
+	_, err := s.requirementRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrRequirementNotFound
@@ -301,7 +337,7 @@ func (s *requirementService) DeleteRequirement(id uuid.UUID, force bool) error {
 
 	// Check for relationships unless force delete
 	if !force {
-		hasRelationships, err := s.requirementRepo.HasRelationships(id)
+		hasRelationships, err := s.requirementRepo.HasRelationships(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to check relationships: %w", err)
 		}
@@ -311,7 +347,7 @@ func (s *requirementService) DeleteRequirement(id uuid.UUID, force bool) error {
 	}
 
 	// Delete the requirement (cascade will handle relationships if force=true)
-	if err := s.requirementRepo.Delete(id); err != nil {
+	if err := s.requirementRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete requirement: %w", err)
 	}
 
@@ -319,7 +355,12 @@ func (s *requirementService) DeleteRequirement(id uuid.UUID, force bool) error {
 }
 
 // ListRequirements retrieves requirements with optional filtering and all relationships preloaded
-func (s *requirementService) ListRequirements(filters RequirementFilters) ([]models.Requirement, int64, error) {
+func (s *requirementService) ListRequirements(ctx context.Context, filters RequirementFilters) ([]models.Requirement, int64, error) {
+	orderBy, err := requirementSortFields.orderClause(sortOrFallback(filters.Sort, filters.OrderBy), "created_at DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// Build filter map
 	filterMap := make(map[string]interface{})
 
@@ -346,17 +387,11 @@ func (s *requirementService) ListRequirements(filters RequirementFilters) ([]mod
 	}
 
 	// Get total count with filters
-	totalCount, err := s.requirementRepo.Count(filterMap)
+	totalCount, err := s.requirementRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count requirements: %w", err)
 	}
 
-	// Set default ordering
-	orderBy := "created_at DESC"
-	if filters.OrderBy != "" {
-		orderBy = filters.OrderBy
-	}
-
 	// Set default limit
 	limit := 50
 	if filters.Limit > 0 {
@@ -364,7 +399,7 @@ func (s *requirementService) ListRequirements(filters RequirementFilters) ([]mod
 	}
 
 	// Always use the method with preloads to include all relationships by default
-	requirements, err := s.requirementRepo.ListWithPreloads(filterMap, orderBy, limit, filters.Offset)
+	requirements, err := s.requirementRepo.ListWithPreloads(ctx, filterMap, orderBy, limit, filters.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list requirements: %w", err)
 	}
@@ -373,8 +408,8 @@ func (s *requirementService) ListRequirements(filters RequirementFilters) ([]mod
 }
 
 // GetRequirementWithRelationships retrieves a requirement with its relationships
-func (s *requirementService) GetRequirementWithRelationships(id uuid.UUID) (*models.Requirement, error) {
-	requirement, err := s.requirementRepo.GetWithRelationships(id)
+func (s *requirementService) GetRequirementWithRelationships(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
+	requirement, err := s.requirementRepo.GetWithRelationships(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementNotFound
@@ -385,15 +420,15 @@ func (s *requirementService) GetRequirementWithRelationships(id uuid.UUID) (*mod
 }
 
 // GetRequirementsByUserStory retrieves requirements by user story ID
-func (s *requirementService) GetRequirementsByUserStory(userStoryID uuid.UUID) ([]models.Requirement, error) {
+func (s *requirementService) GetRequirementsByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
 	// Validate user story exists
-	if exists, err := s.userStoryRepo.Exists(userStoryID); err != nil {
+	if exists, err := s.userStoryRepo.Exists(ctx, userStoryID); err != nil {
 		return nil, fmt.Errorf("failed to check user story existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserStoryNotFound
 	}
 
-	requirements, err := s.requirementRepo.GetByUserStory(userStoryID)
+	requirements, err := s.requirementRepo.GetByUserStory(ctx, userStoryID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requirements by user story: %w", err)
 	}
@@ -402,8 +437,8 @@ func (s *requirementService) GetRequirementsByUserStory(userStoryID uuid.UUID) (
 }
 
 // ChangeRequirementStatus changes the status of a requirement
-func (s *requirementService) ChangeRequirementStatus(id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error) {
-	requirement, err := s.requirementRepo.GetByID(id)
+func (s *requirementService) ChangeRequirementStatus(ctx context.Context, id uuid.UUID, newStatus models.RequirementStatus) (*models.Requirement, error) {
+	requirement, err := s.requirementRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementNotFound
@@ -421,7 +456,11 @@ func (s *requirementService) ChangeRequirementStatus(id uuid.UUID, newStatus mod
 	}
 
 	requirement.Status = newStatus
-	if err := s.requirementRepo.Update(requirement); err != nil {
+	if newStatus != models.RequirementStatusObsolete {
+		// Only obsolete requirements are superseded
+		requirement.SupersededByID = nil
+	}
+	if err := s.requirementRepo.Update(ctx, requirement); err != nil {
 		return nil, fmt.Errorf("failed to update requirement status: %w", err)
 	}
 
@@ -429,15 +468,15 @@ func (s *requirementService) ChangeRequirementStatus(id uuid.UUID, newStatus mod
 }
 
 // AssignRequirement assigns a requirement to a user
-func (s *requirementService) AssignRequirement(id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error) {
+func (s *requirementService) AssignRequirement(ctx context.Context, id uuid.UUID, assigneeID uuid.UUID) (*models.Requirement, error) {
 	// Validate assignee exists
-	if exists, err := s.userRepo.Exists(assigneeID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, assigneeID); err != nil {
 		return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
 	}
 
-	requirement, err := s.requirementRepo.GetByID(id)
+	requirement, err := s.requirementRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrRequirementNotFound
@@ -446,7 +485,7 @@ func (s *requirementService) AssignRequirement(id uuid.UUID, assigneeID uuid.UUI
 	}
 
 	requirement.AssigneeID = assigneeID
-	if err := s.requirementRepo.Update(requirement); err != nil {
+	if err := s.requirementRepo.Update(ctx, requirement); err != nil {
 		return nil, fmt.Errorf("failed to assign requirement: %w", err)
 	}
 
@@ -454,42 +493,42 @@ func (s *requirementService) AssignRequirement(id uuid.UUID, assigneeID uuid.UUI
 }
 
 // CreateRelationship creates a relationship between two requirements
-func (s *requirementService) CreateRelationship(req CreateRelationshipRequest) (*models.RequirementRelationship, error) {
+func (s *requirementService) CreateRelationship(ctx context.Context, req CreateRelationshipRequest) (*models.RequirementRelationship, error) {
 	// Validate that source and target are different
 	if req.SourceRequirementID == req.TargetRequirementID {
 		return nil, ErrCircularRelationship
 	}
 
 	// Validate source requirement exists
-	if exists, err := s.requirementRepo.Exists(req.SourceRequirementID); err != nil {
+	if exists, err := s.requirementRepo.Exists(ctx, req.SourceRequirementID); err != nil {
 		return nil, fmt.Errorf("failed to check source requirement existence: %w", err)
 	} else if !exists {
 		return nil, ErrRequirementNotFound
 	}
 
 	// Validate target requirement exists
-	if exists, err := s.requirementRepo.Exists(req.TargetRequirementID); err != nil {
+	if exists, err := s.requirementRepo.Exists(ctx, req.TargetRequirementID); err != nil {
 		return nil, fmt.Errorf("failed to check target requirement existence: %w", err)
 	} else if !exists {
 		return nil, ErrRequirementNotFound
 	}
 
 	// Validate relationship type exists
-	if exists, err := s.relationshipTypeRepo.Exists(req.RelationshipTypeID); err != nil {
+	if exists, err := s.relationshipTypeRepo.Exists(ctx, req.RelationshipTypeID); err != nil {
 		return nil, fmt.Errorf("failed to check relationship type existence: %w", err)
 	} else if !exists {
 		return nil, ErrRelationshipTypeNotFound
 	}
 
 	// Validate creator exists
-	if exists, err := s.userRepo.Exists(req.CreatedBy); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, req.CreatedBy); err != nil {
 		return nil, fmt.Errorf("failed to check creator existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
 	}
 
 	// Check if relationship already exists
-	exists, err := s.requirementRelationshipRepo.ExistsRelationship(
+	exists, err := s.requirementRelationshipRepo.ExistsRelationship(ctx,
 		req.SourceRequirementID, req.TargetRequirementID, req.RelationshipTypeID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check existing relationship: %w", err)
@@ -506,7 +545,7 @@ func (s *requirementService) CreateRelationship(req CreateRelationshipRequest) (
 		CreatedBy:           req.CreatedBy,
 	}
 
-	if err := s.requirementRelationshipRepo.Create(relationship); err != nil {
+	if err := s.requirementRelationshipRepo.Create(ctx, relationship); err != nil {
 		return nil, fmt.Errorf("failed to create relationship: %w", err)
 	}
 
@@ -514,9 +553,9 @@ func (s *requirementService) CreateRelationship(req CreateRelationshipRequest) (
 }
 
 // DeleteRelationship deletes a requirement relationship
-func (s *requirementService) DeleteRelationship(id uuid.UUID) error {
+func (s *requirementService) DeleteRelationship(ctx context.Context, id uuid.UUID) error {
 	// Check if relationship exists
-	_, err := s.requirementRelationshipRepo.GetByID(id)
+	_, err := s.requirementRelationshipRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrRequirementNotFound
@@ -524,7 +563,7 @@ func (s *requirementService) DeleteRelationship(id uuid.UUID) error {
 		return fmt.Errorf("failed to get relationship: %w", err)
 	}
 
-	if err := s.requirementRelationshipRepo.Delete(id); err != nil {
+	if err := s.requirementRelationshipRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete relationship: %w", err)
 	}
 
@@ -532,15 +571,15 @@ func (s *requirementService) DeleteRelationship(id uuid.UUID) error {
 }
 
 // GetRelationshipsByRequirement retrieves all relationships for a requirement
-func (s *requirementService) GetRelationshipsByRequirement(requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (s *requirementService) GetRelationshipsByRequirement(ctx context.Context, requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
 	// Validate requirement exists
-	if exists, err := s.requirementRepo.Exists(requirementID); err != nil {
+	if exists, err := s.requirementRepo.Exists(ctx, requirementID); err != nil {
 		return nil, fmt.Errorf("failed to check requirement existence: %w", err)
 	} else if !exists {
 		return nil, ErrRequirementNotFound
 	}
 
-	relationships, err := s.requirementRelationshipRepo.GetByRequirement(requirementID)
+	relationships, err := s.requirementRelationshipRepo.GetByRequirement(ctx, requirementID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get relationships: %w", err)
 	}
@@ -549,15 +588,15 @@ func (s *requirementService) GetRelationshipsByRequirement(requirementID uuid.UU
 }
 
 // GetRelationshipsByRequirementWithPagination retrieves relationships for a requirement with pagination
-func (s *requirementService) GetRelationshipsByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
+func (s *requirementService) GetRelationshipsByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
 	// Validate requirement exists
-	if exists, err := s.requirementRepo.Exists(requirementID); err != nil {
+	if exists, err := s.requirementRepo.Exists(ctx, requirementID); err != nil {
 		return nil, 0, fmt.Errorf("failed to check requirement existence: %w", err)
 	} else if !exists {
 		return nil, 0, ErrRequirementNotFound
 	}
 
-	relationships, totalCount, err := s.requirementRelationshipRepo.GetByRequirementWithPagination(requirementID, limit, offset)
+	relationships, totalCount, err := s.requirementRelationshipRepo.GetByRequirementWithPagination(ctx, requirementID, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get relationships: %w", err)
 	}
@@ -566,12 +605,12 @@ func (s *requirementService) GetRelationshipsByRequirementWithPagination(require
 }
 
 // SearchRequirements performs full-text search on requirements
-func (s *requirementService) SearchRequirements(searchText string) ([]models.Requirement, error) {
+func (s *requirementService) SearchRequirements(ctx context.Context, searchText string) ([]models.Requirement, error) {
 	if searchText == "" {
 		return []models.Requirement{}, nil
 	}
 
-	requirements, err := s.requirementRepo.SearchByText(searchText)
+	requirements, err := s.requirementRepo.SearchByText(ctx, searchText)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search requirements: %w", err)
 	}
@@ -580,15 +619,82 @@ func (s *requirementService) SearchRequirements(searchText string) ([]models.Req
 }
 
 // SearchRequirementsWithPagination performs full-text search on requirements with pagination
-func (s *requirementService) SearchRequirementsWithPagination(searchText string, limit, offset int) ([]models.Requirement, int64, error) {
+func (s *requirementService) SearchRequirementsWithPagination(ctx context.Context, searchText string, limit, offset int) ([]models.Requirement, int64, error) {
 	if searchText == "" {
 		return []models.Requirement{}, 0, nil
 	}
 
-	requirements, totalCount, err := s.requirementRepo.SearchByTextWithPagination(searchText, limit, offset)
+	requirements, totalCount, err := s.requirementRepo.SearchByTextWithPagination(ctx, searchText, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to search requirements: %w", err)
 	}
 
 	return requirements, totalCount, nil
 }
+
+// GetLinkedAcceptanceCriteria retrieves the acceptance criteria a requirement covers in the order they were linked
+func (s *requirementService) GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+	if exists, err := s.requirementRepo.Exists(ctx, requirementID); err != nil {
+		return nil, fmt.Errorf("failed to check requirement existence: %w", err)
+	} else if !exists {
+		return nil, ErrRequirementNotFound
+	}
+
+	acceptanceCriteria, err := s.requirementRepo.GetLinkedAcceptanceCriteria(ctx, requirementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get linked acceptance criteria: %w", err)
+	}
+	return acceptanceCriteria, nil
+}
+
+// LinkAcceptanceCriteria links acceptance criteria of the requirement's user story to a requirement. Linking an
+// already linked acceptance criteria has no effect; a requirement without acceptance criteria gets the first one
+// as its primary acceptance criteria.
+func (s *requirementService) LinkAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID, acceptanceCriteriaIDs []uuid.UUID) (*models.Requirement, error) {
+	requirement, err := s.requirementRepo.GetByID(ctx, requirementID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrRequirementNotFound
+		}
+		return nil, fmt.Errorf("failed to get requirement: %w", err)
+	}
+
+	// Validate all acceptance criteria before linking any
+	for _, acceptanceCriteriaID := range acceptanceCriteriaIDs {
+		acceptanceCriteria, err := s.acceptanceCriteriaRepo.GetByID(ctx, acceptanceCriteriaID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, ErrAcceptanceCriteriaNotFound
+			}
+			return nil, fmt.Errorf("failed to get acceptance criteria: %w", err)
+		}
+		if acceptanceCriteria.UserStoryID != requirement.UserStoryID {
+			return nil, fmt.Errorf("%w: %s", ErrAcceptanceCriteriaOfOtherUserStory, acceptanceCriteria.ReferenceID)
+		}
+	}
+
+	for _, acceptanceCriteriaID := range acceptanceCriteriaIDs {
+		if err := s.requirementRepo.LinkAcceptanceCriteria(ctx, requirementID, acceptanceCriteriaID); err != nil {
+			return nil, fmt.Errorf("failed to link acceptance criteria: %w", err)
+		}
+	}
+	return s.GetRequirementByID(ctx, requirementID)
+}
+
+// UnlinkAcceptanceCriteria removes the link between a requirement and an acceptance criteria. Unlinking the
+// primary acceptance criteria makes the earliest remaining one the primary acceptance criteria.
+func (s *requirementService) UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) (*models.Requirement, error) {
+	if exists, err := s.requirementRepo.Exists(ctx, requirementID); err != nil {
+		return nil, fmt.Errorf("failed to check requirement existence: %w", err)
+	} else if !exists {
+		return nil, ErrRequirementNotFound
+	}
+
+	if err := s.requirementRepo.UnlinkAcceptanceCriteria(ctx, requirementID, acceptanceCriteriaID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrAcceptanceCriteriaNotLinked
+		}
+		return nil, fmt.Errorf("failed to unlink acceptance criteria: %w", err)
+	}
+	return s.GetRequirementByID(ctx, requirementID)
+}
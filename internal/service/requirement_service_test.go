@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -17,12 +18,12 @@ type MockRequirementRepository struct {
 	mock.Mock
 }
 
-func (m *MockRequirementRepository) Create(entity *models.Requirement) error {
+func (m *MockRequirementRepository) Create(ctx context.Context, entity *models.Requirement) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementRepository) GetByID(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -30,7 +31,7 @@ func (m *MockRequirementRepository) GetByID(id uuid.UUID) (*models.Requirement,
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByReferenceID(referenceID string) (*models.Requirement, error) {
+func (m *MockRequirementRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -38,7 +39,7 @@ func (m *MockRequirementRepository) GetByReferenceID(referenceID string) (*model
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.Requirement, error) {
+func (m *MockRequirementRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -46,37 +47,37 @@ func (m *MockRequirementRepository) GetByReferenceIDCaseInsensitive(referenceID
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) Update(entity *models.Requirement) error {
+func (m *MockRequirementRepository) Update(ctx context.Context, entity *models.Requirement) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementRepository) Delete(id uuid.UUID) error {
+func (m *MockRequirementRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockRequirementRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockRequirementRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockRequirementRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockRequirementRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockRequirementRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockRequirementRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -89,7 +90,7 @@ func (m *MockRequirementRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockRequirementRepository) GetWithRelationships(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementRepository) GetWithRelationships(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -97,57 +98,57 @@ func (m *MockRequirementRepository) GetWithRelationships(id uuid.UUID) (*models.
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByUserStory(userStoryID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) GetByUserStory(ctx context.Context, userStoryID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(userStoryID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByAcceptanceCriteria(acceptanceCriteriaID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) GetByAcceptanceCriteria(ctx context.Context, acceptanceCriteriaID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(acceptanceCriteriaID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByCreator(creatorID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(creatorID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(assigneeID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByStatus(status models.RequirementStatus) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) GetByStatus(ctx context.Context, status models.RequirementStatus) ([]models.Requirement, error) {
 	args := m.Called(status)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByPriority(priority models.Priority) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.Requirement, error) {
 	args := m.Called(priority)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByType(typeID uuid.UUID) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) GetByType(ctx context.Context, typeID uuid.UUID) ([]models.Requirement, error) {
 	args := m.Called(typeID)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) HasRelationships(id uuid.UUID) (bool, error) {
+func (m *MockRequirementRepository) HasRelationships(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementRepository) SearchByText(searchText string) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) SearchByText(ctx context.Context, searchText string) ([]models.Requirement, error) {
 	args := m.Called(searchText)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) SearchByTextWithPagination(searchText string, limit, offset int) ([]models.Requirement, int64, error) {
+func (m *MockRequirementRepository) SearchByTextWithPagination(ctx context.Context, searchText string, limit, offset int) ([]models.Requirement, int64, error) {
 	args := m.Called(searchText, limit, offset)
 	return args.Get(0).([]models.Requirement), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockRequirementRepository) GetByIDWithPreloads(id uuid.UUID) (*models.Requirement, error) {
+func (m *MockRequirementRepository) GetByIDWithPreloads(ctx context.Context, id uuid.UUID) (*models.Requirement, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -155,7 +156,7 @@ func (m *MockRequirementRepository) GetByIDWithPreloads(id uuid.UUID) (*models.R
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) GetByReferenceIDWithPreloads(referenceID string) (*models.Requirement, error) {
+func (m *MockRequirementRepository) GetByReferenceIDWithPreloads(ctx context.Context, referenceID string) (*models.Requirement, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -163,22 +164,37 @@ func (m *MockRequirementRepository) GetByReferenceIDWithPreloads(referenceID str
 	return args.Get(0).(*models.Requirement), args.Error(1)
 }
 
-func (m *MockRequirementRepository) ListWithPreloads(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
+func (m *MockRequirementRepository) ListWithPreloads(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Requirement, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.Requirement), args.Error(1)
 }
 
+func (m *MockRequirementRepository) GetLinkedAcceptanceCriteria(ctx context.Context, requirementID uuid.UUID) ([]models.AcceptanceCriteria, error) {
+	args := m.Called(requirementID)
+	return args.Get(0).([]models.AcceptanceCriteria), args.Error(1)
+}
+
+func (m *MockRequirementRepository) LinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error {
+	args := m.Called(requirementID, acceptanceCriteriaID)
+	return args.Error(0)
+}
+
+func (m *MockRequirementRepository) UnlinkAcceptanceCriteria(ctx context.Context, requirementID, acceptanceCriteriaID uuid.UUID) error {
+	args := m.Called(requirementID, acceptanceCriteriaID)
+	return args.Error(0)
+}
+
 // MockRequirementTypeRepository is a mock implementation of RequirementTypeRepository
 type MockRequirementTypeRepository struct {
 	mock.Mock
 }
 
-func (m *MockRequirementTypeRepository) Create(entity *models.RequirementType) error {
+func (m *MockRequirementTypeRepository) Create(ctx context.Context, entity *models.RequirementType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementTypeRepository) GetByID(id uuid.UUID) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RequirementType, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -186,7 +202,7 @@ func (m *MockRequirementTypeRepository) GetByID(id uuid.UUID) (*models.Requireme
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) GetByReferenceID(referenceID string) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.RequirementType, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -194,7 +210,7 @@ func (m *MockRequirementTypeRepository) GetByReferenceID(referenceID string) (*m
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.RequirementType, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -202,37 +218,37 @@ func (m *MockRequirementTypeRepository) GetByReferenceIDCaseInsensitive(referenc
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) Update(entity *models.RequirementType) error {
+func (m *MockRequirementTypeRepository) Update(ctx context.Context, entity *models.RequirementType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementTypeRepository) Delete(id uuid.UUID) error {
+func (m *MockRequirementTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockRequirementTypeRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementType, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockRequirementTypeRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockRequirementTypeRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockRequirementTypeRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockRequirementTypeRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -245,7 +261,7 @@ func (m *MockRequirementTypeRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockRequirementTypeRepository) GetByName(name string) (*models.RequirementType, error) {
+func (m *MockRequirementTypeRepository) GetByName(ctx context.Context, name string) (*models.RequirementType, error) {
 	args := m.Called(name)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -253,7 +269,7 @@ func (m *MockRequirementTypeRepository) GetByName(name string) (*models.Requirem
 	return args.Get(0).(*models.RequirementType), args.Error(1)
 }
 
-func (m *MockRequirementTypeRepository) ExistsByName(name string) (bool, error) {
+func (m *MockRequirementTypeRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	args := m.Called(name)
 	return args.Bool(0), args.Error(1)
 }
@@ -263,12 +279,12 @@ type MockRelationshipTypeRepository struct {
 	mock.Mock
 }
 
-func (m *MockRelationshipTypeRepository) Create(entity *models.RelationshipType) error {
+func (m *MockRelationshipTypeRepository) Create(ctx context.Context, entity *models.RelationshipType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRelationshipTypeRepository) GetByID(id uuid.UUID) (*models.RelationshipType, error) {
+func (m *MockRelationshipTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RelationshipType, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -276,7 +292,7 @@ func (m *MockRelationshipTypeRepository) GetByID(id uuid.UUID) (*models.Relation
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) GetByReferenceID(referenceID string) (*models.RelationshipType, error) {
+func (m *MockRelationshipTypeRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.RelationshipType, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -284,7 +300,7 @@ func (m *MockRelationshipTypeRepository) GetByReferenceID(referenceID string) (*
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.RelationshipType, error) {
+func (m *MockRelationshipTypeRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.RelationshipType, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -292,37 +308,37 @@ func (m *MockRelationshipTypeRepository) GetByReferenceIDCaseInsensitive(referen
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) Update(entity *models.RelationshipType) error {
+func (m *MockRelationshipTypeRepository) Update(ctx context.Context, entity *models.RelationshipType) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRelationshipTypeRepository) Delete(id uuid.UUID) error {
+func (m *MockRelationshipTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockRelationshipTypeRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RelationshipType, error) {
+func (m *MockRelationshipTypeRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RelationshipType, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.RelationshipType), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockRelationshipTypeRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockRelationshipTypeRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockRelationshipTypeRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockRelationshipTypeRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -335,7 +351,7 @@ func (m *MockRelationshipTypeRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockRelationshipTypeRepository) GetByName(name string) (*models.RelationshipType, error) {
+func (m *MockRelationshipTypeRepository) GetByName(ctx context.Context, name string) (*models.RelationshipType, error) {
 	args := m.Called(name)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -343,22 +359,27 @@ func (m *MockRelationshipTypeRepository) GetByName(name string) (*models.Relatio
 	return args.Get(0).(*models.RelationshipType), args.Error(1)
 }
 
-func (m *MockRelationshipTypeRepository) ExistsByName(name string) (bool, error) {
+func (m *MockRelationshipTypeRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	args := m.Called(name)
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockRelationshipTypeRepository) CountEntityRelationships(ctx context.Context, typeID uuid.UUID) (int64, error) {
+	args := m.Called(typeID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 // MockRequirementRelationshipRepository is a mock implementation of RequirementRelationshipRepository
 type MockRequirementRelationshipRepository struct {
 	mock.Mock
 }
 
-func (m *MockRequirementRelationshipRepository) Create(entity *models.RequirementRelationship) error {
+func (m *MockRequirementRelationshipRepository) Create(ctx context.Context, entity *models.RequirementRelationship) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementRelationshipRepository) GetByID(id uuid.UUID) (*models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RequirementRelationship, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -366,7 +387,7 @@ func (m *MockRequirementRelationshipRepository) GetByID(id uuid.UUID) (*models.R
 	return args.Get(0).(*models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) GetByReferenceID(referenceID string) (*models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.RequirementRelationship, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -374,7 +395,7 @@ func (m *MockRequirementRelationshipRepository) GetByReferenceID(referenceID str
 	return args.Get(0).(*models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.RequirementRelationship, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -382,37 +403,37 @@ func (m *MockRequirementRelationshipRepository) GetByReferenceIDCaseInsensitive(
 	return args.Get(0).(*models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) Update(entity *models.RequirementRelationship) error {
+func (m *MockRequirementRelationshipRepository) Update(ctx context.Context, entity *models.RequirementRelationship) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockRequirementRelationshipRepository) Delete(id uuid.UUID) error {
+func (m *MockRequirementRelationshipRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockRequirementRelationshipRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.RequirementRelationship, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockRequirementRelationshipRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockRequirementRelationshipRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockRequirementRelationshipRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockRequirementRelationshipRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -425,37 +446,48 @@ func (m *MockRequirementRelationshipRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockRequirementRelationshipRepository) GetBySourceRequirement(sourceID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) GetBySourceRequirement(ctx context.Context, sourceID uuid.UUID) ([]models.RequirementRelationship, error) {
 	args := m.Called(sourceID)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) GetByTargetRequirement(targetID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) GetByTargetRequirement(ctx context.Context, targetID uuid.UUID) ([]models.RequirementRelationship, error) {
 	args := m.Called(targetID)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) GetByRequirement(requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) GetByRequirement(ctx context.Context, requirementID uuid.UUID) ([]models.RequirementRelationship, error) {
 	args := m.Called(requirementID)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) GetByType(typeID uuid.UUID) ([]models.RequirementRelationship, error) {
+func (m *MockRequirementRelationshipRepository) GetByType(ctx context.Context, typeID uuid.UUID) ([]models.RequirementRelationship, error) {
 	args := m.Called(typeID)
 	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) ExistsRelationship(sourceID, targetID, typeID uuid.UUID) (bool, error) {
+func (m *MockRequirementRelationshipRepository) ExistsRelationship(ctx context.Context, sourceID, targetID, typeID uuid.UUID) (bool, error) {
 	args := m.Called(sourceID, targetID, typeID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockRequirementRelationshipRepository) GetByRequirementWithPagination(requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
+func (m *MockRequirementRelationshipRepository) ListByRequirements(ctx context.Context, requirementIDs []uuid.UUID) ([]models.RequirementRelationship, error) {
+	args := m.Called(requirementIDs)
+	return args.Get(0).([]models.RequirementRelationship), args.Error(1)
+}
+
+func (m *MockRequirementRelationshipRepository) ListGraphNodes(ctx context.Context, filters map[string]interface{}) ([]repository.RequirementGraphNode, error) {
+	args := m.Called(filters)
+	return args.Get(0).([]repository.RequirementGraphNode), args.Error(1)
+}
+
+func (m *MockRequirementRelationshipRepository) GetByRequirementWithPagination(ctx context.Context, requirementID uuid.UUID, limit, offset int) ([]models.RequirementRelationship, int64, error) {
 	args := m.Called(requirementID, limit, offset)
 	return args.Get(0).([]models.RequirementRelationship), args.Get(1).(int64), args.Error(2)
 }
 
 func TestRequirementService_CreateRequirement(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementRepo := new(MockRequirementRepository)
 	mockRequirementTypeRepo := new(MockRequirementTypeRepository)
 	mockRelationshipTypeRepo := new(MockRelationshipTypeRepository)
@@ -494,7 +526,7 @@ func TestRequirementService_CreateRequirement(t *testing.T) {
 		mockUserRepo.On("Exists", creatorID).Return(true, nil)
 		mockRequirementRepo.On("Create", mock.AnythingOfType("*models.Requirement")).Return(nil)
 
-		result, err := service.CreateRequirement(req)
+		result, err := service.CreateRequirement(ctx, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -521,7 +553,7 @@ func TestRequirementService_CreateRequirement(t *testing.T) {
 			Title:       "Test Requirement",
 		}
 
-		result, err := service.CreateRequirement(req)
+		result, err := service.CreateRequirement(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -541,7 +573,7 @@ func TestRequirementService_CreateRequirement(t *testing.T) {
 
 		mockUserStoryRepo.On("Exists", userStoryID).Return(false, nil)
 
-		result, err := service.CreateRequirement(req)
+		result, err := service.CreateRequirement(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -565,7 +597,7 @@ func TestRequirementService_CreateRequirement(t *testing.T) {
 		mockUserStoryRepo.On("Exists", userStoryID).Return(true, nil)
 		mockRequirementTypeRepo.On("Exists", typeID).Return(false, nil)
 
-		result, err := service.CreateRequirement(req)
+		result, err := service.CreateRequirement(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -577,6 +609,7 @@ func TestRequirementService_CreateRequirement(t *testing.T) {
 }
 
 func TestRequirementService_GetRequirementByID(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementRepo := new(MockRequirementRepository)
 	mockRequirementTypeRepo := new(MockRequirementTypeRepository)
 	mockRelationshipTypeRepo := new(MockRelationshipTypeRepository)
@@ -604,7 +637,7 @@ func TestRequirementService_GetRequirementByID(t *testing.T) {
 
 		mockRequirementRepo.On("GetByIDWithPreloads", requirementID).Return(expectedRequirement, nil)
 
-		result, err := service.GetRequirementByID(requirementID)
+		result, err := service.GetRequirementByID(ctx, requirementID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -619,7 +652,7 @@ func TestRequirementService_GetRequirementByID(t *testing.T) {
 
 		mockRequirementRepo.On("GetByIDWithPreloads", requirementID).Return(nil, repository.ErrNotFound)
 
-		result, err := service.GetRequirementByID(requirementID)
+		result, err := service.GetRequirementByID(ctx, requirementID)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -630,6 +663,7 @@ func TestRequirementService_GetRequirementByID(t *testing.T) {
 }
 
 func TestRequirementService_CreateRelationship(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementRepo := new(MockRequirementRepository)
 	mockRequirementTypeRepo := new(MockRequirementTypeRepository)
 	mockRelationshipTypeRepo := new(MockRelationshipTypeRepository)
@@ -669,7 +703,7 @@ func TestRequirementService_CreateRelationship(t *testing.T) {
 		mockRequirementRelationshipRepo.On("ExistsRelationship", sourceID, targetID, relationshipTypeID).Return(false, nil)
 		mockRequirementRelationshipRepo.On("Create", mock.AnythingOfType("*models.RequirementRelationship")).Return(nil)
 
-		result, err := service.CreateRelationship(req)
+		result, err := service.CreateRelationship(ctx, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -694,7 +728,7 @@ func TestRequirementService_CreateRelationship(t *testing.T) {
 			CreatedBy:           uuid.New(),
 		}
 
-		result, err := service.CreateRelationship(req)
+		result, err := service.CreateRelationship(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -721,7 +755,7 @@ func TestRequirementService_CreateRelationship(t *testing.T) {
 		mockUserRepo.On("Exists", creatorID).Return(true, nil)
 		mockRequirementRelationshipRepo.On("ExistsRelationship", sourceID, targetID, relationshipTypeID).Return(true, nil)
 
-		result, err := service.CreateRelationship(req)
+		result, err := service.CreateRelationship(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -735,6 +769,7 @@ func TestRequirementService_CreateRelationship(t *testing.T) {
 }
 
 func TestRequirementService_DeleteRequirement(t *testing.T) {
+	ctx := context.Background()
 	mockRequirementRepo := new(MockRequirementRepository)
 	mockRequirementTypeRepo := new(MockRequirementTypeRepository)
 	mockRelationshipTypeRepo := new(MockRelationshipTypeRepository)
@@ -761,7 +796,7 @@ func TestRequirementService_DeleteRequirement(t *testing.T) {
 		mockRequirementRepo.On("HasRelationships", requirementID).Return(false, nil)
 		mockRequirementRepo.On("Delete", requirementID).Return(nil)
 
-		err := service.DeleteRequirement(requirementID, false)
+		err := service.DeleteRequirement(ctx, requirementID, false)
 
 		assert.NoError(t, err)
 
@@ -775,7 +810,7 @@ func TestRequirementService_DeleteRequirement(t *testing.T) {
 		mockRequirementRepo.On("GetByID", requirementID).Return(requirement, nil)
 		mockRequirementRepo.On("HasRelationships", requirementID).Return(true, nil)
 
-		err := service.DeleteRequirement(requirementID, false)
+		err := service.DeleteRequirement(ctx, requirementID, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrRequirementHasRelationships, err)
@@ -790,7 +825,7 @@ func TestRequirementService_DeleteRequirement(t *testing.T) {
 		mockRequirementRepo.On("GetByID", requirementID).Return(requirement, nil)
 		mockRequirementRepo.On("Delete", requirementID).Return(nil)
 
-		err := service.DeleteRequirement(requirementID, true)
+		err := service.DeleteRequirement(ctx, requirementID, true)
 
 		assert.NoError(t, err)
 
@@ -802,7 +837,7 @@ func TestRequirementService_DeleteRequirement(t *testing.T) {
 
 		mockRequirementRepo.On("GetByID", requirementID).Return(nil, repository.ErrNotFound)
 
-		err := service.DeleteRequirement(requirementID, false)
+		err := service.DeleteRequirement(ctx, requirementID, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrRequirementNotFound, err)
@@ -810,3 +845,94 @@ func TestRequirementService_DeleteRequirement(t *testing.T) {
 		mockRequirementRepo.AssertExpectations(t)
 	})
 }
+
+func TestRequirementService_LinkAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
+	mockRequirementRepo := new(MockRequirementRepository)
+	mockAcceptanceCriteriaRepo := new(MockAcceptanceCriteriaRepository)
+
+	service := NewRequirementService(
+		mockRequirementRepo,
+		new(MockRequirementTypeRepository),
+		new(MockRelationshipTypeRepository),
+		new(MockRequirementRelationshipRepository),
+		new(MockUserStoryRepository),
+		mockAcceptanceCriteriaRepo,
+		new(MockUserRepository),
+	)
+
+	userStoryID := uuid.New()
+
+	t.Run("links all acceptance criteria", func(t *testing.T) {
+		requirementID, ac1ID, ac2ID := uuid.New(), uuid.New(), uuid.New()
+		requirement := &models.Requirement{ID: requirementID, UserStoryID: userStoryID}
+
+		mockRequirementRepo.On("GetByID", requirementID).Return(requirement, nil)
+		mockAcceptanceCriteriaRepo.On("GetByID", ac1ID).Return(&models.AcceptanceCriteria{ID: ac1ID, UserStoryID: userStoryID}, nil)
+		mockAcceptanceCriteriaRepo.On("GetByID", ac2ID).Return(&models.AcceptanceCriteria{ID: ac2ID, UserStoryID: userStoryID}, nil)
+		mockRequirementRepo.On("LinkAcceptanceCriteria", requirementID, ac1ID).Return(nil)
+		mockRequirementRepo.On("LinkAcceptanceCriteria", requirementID, ac2ID).Return(nil)
+		mockRequirementRepo.On("GetByIDWithPreloads", requirementID).Return(requirement, nil)
+
+		result, err := service.LinkAcceptanceCriteria(ctx, requirementID, []uuid.UUID{ac1ID, ac2ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, requirementID, result.ID)
+		mockRequirementRepo.AssertExpectations(t)
+		mockAcceptanceCriteriaRepo.AssertExpectations(t)
+	})
+
+	t.Run("acceptance criteria of another user story", func(t *testing.T) {
+		requirementID, acID := uuid.New(), uuid.New()
+
+		mockRequirementRepo.On("GetByID", requirementID).Return(&models.Requirement{ID: requirementID, UserStoryID: userStoryID}, nil)
+		mockAcceptanceCriteriaRepo.On("GetByID", acID).Return(&models.AcceptanceCriteria{ID: acID, UserStoryID: uuid.New(), ReferenceID: "AC-009"}, nil)
+
+		result, err := service.LinkAcceptanceCriteria(ctx, requirementID, []uuid.UUID{acID})
+
+		assert.ErrorIs(t, err, ErrAcceptanceCriteriaOfOtherUserStory)
+		assert.Nil(t, result)
+		mockRequirementRepo.AssertNotCalled(t, "LinkAcceptanceCriteria", requirementID, acID)
+	})
+}
+
+func TestRequirementService_UnlinkAcceptanceCriteria(t *testing.T) {
+	ctx := context.Background()
+	mockRequirementRepo := new(MockRequirementRepository)
+
+	service := NewRequirementService(
+		mockRequirementRepo,
+		new(MockRequirementTypeRepository),
+		new(MockRelationshipTypeRepository),
+		new(MockRequirementRelationshipRepository),
+		new(MockUserStoryRepository),
+		new(MockAcceptanceCriteriaRepository),
+		new(MockUserRepository),
+	)
+
+	t.Run("successful unlink", func(t *testing.T) {
+		requirementID, acID := uuid.New(), uuid.New()
+
+		mockRequirementRepo.On("Exists", requirementID).Return(true, nil)
+		mockRequirementRepo.On("UnlinkAcceptanceCriteria", requirementID, acID).Return(nil)
+		mockRequirementRepo.On("GetByIDWithPreloads", requirementID).Return(&models.Requirement{ID: requirementID}, nil)
+
+		result, err := service.UnlinkAcceptanceCriteria(ctx, requirementID, acID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, requirementID, result.ID)
+		mockRequirementRepo.AssertExpectations(t)
+	})
+
+	t.Run("acceptance criteria not linked", func(t *testing.T) {
+		requirementID, acID := uuid.New(), uuid.New()
+
+		mockRequirementRepo.On("Exists", requirementID).Return(true, nil)
+		mockRequirementRepo.On("UnlinkAcceptanceCriteria", requirementID, acID).Return(repository.ErrNotFound)
+
+		result, err := service.UnlinkAcceptanceCriteria(ctx, requirementID, acID)
+
+		assert.ErrorIs(t, err, ErrAcceptanceCriteriaNotLinked)
+		assert.Nil(t, result)
+	})
+}
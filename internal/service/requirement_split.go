@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// derivesFromRelationshipType links each part of a split requirement to the requirement it was split from
+const derivesFromRelationshipType = "derives_from"
+
+// SplitRequirementRequest represents the request to split a requirement into several requirements
+// @Description Requirements replacing the split requirement, at least two
+type SplitRequirementRequest struct {
+	Parts []RequirementSplitPart `json:"parts" binding:"required,min=2,max=20,dive"`
+}
+
+// RequirementSplitPart describes one of the requirements a requirement is split into
+// @Description New requirement created by a split. Type and priority default to the split requirement's.
+type RequirementSplitPart struct {
+	Title       string           `json:"title" binding:"required,max=500" example:"Passwords must be at least 12 characters"`
+	Description *string          `json:"description,omitempty" example:"Applies to all local accounts"`
+	TypeID      *uuid.UUID       `json:"type_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174005"`
+	Priority    *models.Priority `json:"priority,omitempty" example:"2"`
+	// Relationships are the IDs of the split requirement's relationships to copy onto this part
+	Relationships []uuid.UUID `json:"relationships,omitempty"`
+}
+
+// RequirementSplit is the outcome of a split
+// @Description Split requirement, now obsolete and superseded by the first part, and the parts created from it
+type RequirementSplit struct {
+	Original *models.Requirement  `json:"original"`
+	Parts    []models.Requirement `json:"parts"`
+}
+
+// Split replaces a requirement with the given parts. The parts are created in the same user story and linked to
+// the same acceptance criteria, each derives_from the original, and copies the relationships listed for it. The original becomes
+// obsolete and is superseded by the first part.
+func (s *supersessionService) Split(ctx context.Context, requirementIDOrRef string, req SplitRequirementRequest, userID uuid.UUID) (*RequirementSplit, error) {
+	original, err := s.getRequirement(ctx, requirementIDOrRef)
+	if err != nil {
+		return nil, err
+	}
+	if original.Status == models.RequirementStatusObsolete {
+		return nil, ErrSplitObsolete
+	}
+
+	relationships, err := s.repos.RequirementRelationship.GetByRequirement(ctx, original.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get requirement relationships: %w", err)
+	}
+	acceptanceCriteria, err := s.repos.Requirement.GetLinkedAcceptanceCriteria(ctx, original.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get linked acceptance criteria: %w", err)
+	}
+	relationshipsByID := make(map[uuid.UUID]models.RequirementRelationship, len(relationships))
+	for _, relationship := range relationships {
+		relationshipsByID[relationship.ID] = relationship
+	}
+
+	for _, part := range req.Parts {
+		if part.Priority != nil && (*part.Priority < models.PriorityCritical || *part.Priority > models.PriorityLow) {
+			return nil, ErrInvalidPriority
+		}
+		if part.TypeID != nil {
+			if exists, err := s.repos.RequirementType.Exists(ctx, *part.TypeID); err != nil {
+				return nil, fmt.Errorf("failed to check requirement type existence: %w", err)
+			} else if !exists {
+				return nil, ErrRequirementTypeNotFound
+			}
+		}
+		for _, relationshipID := range part.Relationships {
+			if _, ok := relationshipsByID[relationshipID]; !ok {
+				return nil, ErrSplitUnknownLink
+			}
+		}
+	}
+
+	derivesFrom, err := s.repos.RelationshipType.GetByName(ctx, derivesFromRelationshipType)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrRelationshipTypeNotFound
+		}
+		return nil, fmt.Errorf("failed to get relationship type: %w", err)
+	}
+
+	split := &RequirementSplit{Parts: make([]models.Requirement, 0, len(req.Parts))}
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		for _, part := range req.Parts {
+			requirement := models.Requirement{
+				ID:                   uuid.New(),
+				UserStoryID:          original.UserStoryID,
+				AcceptanceCriteriaID: original.AcceptanceCriteriaID,
+				CreatorID:            userID,
+				AssigneeID:           original.AssigneeID,
+				Priority:             original.Priority,
+				Status:               models.RequirementStatusDraft,
+				TypeID:               original.TypeID,
+				Title:                part.Title,
+				Description:          part.Description,
+			}
+			if part.Priority != nil {
+				requirement.Priority = *part.Priority
+			}
+			if part.TypeID != nil {
+				requirement.TypeID = *part.TypeID
+			}
+			if err := tx.Requirement.Create(ctx, &requirement); err != nil {
+				return fmt.Errorf("failed to create requirement: %w", err)
+			}
+			for _, linked := range acceptanceCriteria {
+				if err := tx.Requirement.LinkAcceptanceCriteria(ctx, requirement.ID, linked.ID); err != nil {
+					return fmt.Errorf("failed to link acceptance criteria: %w", err)
+				}
+			}
+
+			links := []models.RequirementRelationship{{
+				SourceRequirementID: requirement.ID,
+				TargetRequirementID: original.ID,
+				RelationshipTypeID:  derivesFrom.ID,
+			}}
+			copied := make(map[uuid.UUID]bool)
+			for _, relationshipID := range part.Relationships {
+				if copied[relationshipID] {
+					continue
+				}
+				copied[relationshipID] = true
+				link := relationshipsByID[relationshipID]
+				if link.SourceRequirementID == original.ID {
+					link.SourceRequirementID = requirement.ID
+				}
+				if link.TargetRequirementID == original.ID {
+					link.TargetRequirementID = requirement.ID
+				}
+				links = append(links, models.RequirementRelationship{
+					SourceRequirementID: link.SourceRequirementID,
+					TargetRequirementID: link.TargetRequirementID,
+					RelationshipTypeID:  link.RelationshipTypeID,
+				})
+			}
+			for i := range links {
+				links[i].ID, links[i].CreatedBy = uuid.New(), userID
+				if err := tx.RequirementRelationship.Create(ctx, &links[i]); err != nil {
+					return fmt.Errorf("failed to create requirement relationship: %w", err)
+				}
+			}
+
+			split.Parts = append(split.Parts, requirement)
+		}
+
+		original.Status = models.RequirementStatusObsolete
+		original.SupersededByID = &split.Parts[0].ID
+		if err := tx.Requirement.Update(ctx, original); err != nil {
+			return fmt.Errorf("failed to update requirement: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	split.Original = original
+	return split, nil
+}
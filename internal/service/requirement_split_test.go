@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestSupersessionService_Split(t *testing.T) {
+	ctx := context.Background()
+	useSequentialReferenceIDs(t)
+	active := models.RequirementStatusActive
+	db, user, _, requirements := setupSupersessionTest(t, active, active, models.RequirementStatusObsolete)
+	repos := repository.NewRepositories(db, nil)
+	svc := NewSupersessionService(repos)
+
+	relationshipTypes := map[string]uuid.UUID{}
+	var types []models.RelationshipType
+	require.NoError(t, db.Find(&types).Error)
+	for _, relationshipType := range types {
+		relationshipTypes[relationshipType.Name] = relationshipType.ID
+	}
+	blocks := models.RequirementRelationship{ID: uuid.New(), SourceRequirementID: requirements[0].ID, TargetRequirementID: requirements[1].ID,
+		RelationshipTypeID: relationshipTypes["blocks"], CreatedBy: user.ID}
+	require.NoError(t, db.Create(&blocks).Error)
+
+	t.Run("validates the split", func(t *testing.T) {
+		_, err := svc.Split(ctx, "REQ-003", SplitRequirementRequest{Parts: []RequirementSplitPart{{Title: "A"}, {Title: "B"}}}, user.ID)
+		assert.ErrorIs(t, err, ErrSplitObsolete)
+
+		_, err = svc.Split(ctx, "REQ-001", SplitRequirementRequest{Parts: []RequirementSplitPart{{Title: "A", Relationships: []uuid.UUID{uuid.New()}}, {Title: "B"}}}, user.ID)
+		assert.ErrorIs(t, err, ErrSplitUnknownLink)
+
+		_, err = svc.Split(ctx, "REQ-404", SplitRequirementRequest{Parts: []RequirementSplitPart{{Title: "A"}, {Title: "B"}}}, user.ID)
+		assert.ErrorIs(t, err, ErrRequirementNotFound)
+	})
+
+	high := models.PriorityHigh
+	split, err := svc.Split(ctx, "REQ-001", SplitRequirementRequest{Parts: []RequirementSplitPart{
+		{Title: "Minimum length", Priority: &high, Relationships: []uuid.UUID{blocks.ID}},
+		{Title: "Character classes"},
+	}}, user.ID)
+	require.NoError(t, err)
+
+	t.Run("creates the parts in the original's user story", func(t *testing.T) {
+		require.Len(t, split.Parts, 2)
+		for _, part := range split.Parts {
+			assert.NotEmpty(t, part.ReferenceID)
+			assert.Equal(t, requirements[0].UserStoryID, part.UserStoryID)
+			assert.Equal(t, models.RequirementStatusDraft, part.Status)
+			assert.Equal(t, user.ID, part.CreatorID)
+		}
+		assert.Equal(t, models.PriorityHigh, split.Parts[0].Priority)
+		assert.Equal(t, requirements[0].Priority, split.Parts[1].Priority)
+	})
+
+	t.Run("supersedes the original with the first part", func(t *testing.T) {
+		original, err := repos.Requirement.GetByID(ctx, requirements[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RequirementStatusObsolete, original.Status)
+		require.NotNil(t, original.SupersededByID)
+		assert.Equal(t, split.Parts[0].ID, *original.SupersededByID)
+	})
+
+	t.Run("derives the parts from the original and copies only the listed relationships", func(t *testing.T) {
+		for i, part := range split.Parts {
+			relationships, err := repos.RequirementRelationship.GetBySourceRequirement(ctx, part.ID)
+			require.NoError(t, err)
+			targets := map[uuid.UUID]uuid.UUID{}
+			for _, relationship := range relationships {
+				targets[relationship.RelationshipTypeID] = relationship.TargetRequirementID
+			}
+			assert.Equal(t, requirements[0].ID, targets[relationshipTypes["derives_from"]])
+			if i == 0 {
+				assert.Len(t, relationships, 2)
+				assert.Equal(t, requirements[1].ID, targets[relationshipTypes["blocks"]])
+			} else {
+				assert.Len(t, relationships, 1)
+			}
+		}
+	})
+}
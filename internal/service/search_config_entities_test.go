@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func TestSearchService_FilterSteeringDocumentsAndConfigTypes(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.SteeringDocument{}, &models.RequirementType{}, &models.RelationshipType{}))
+
+	creatorID, otherCreatorID := uuid.New(), uuid.New()
+	description := "Standards every review must follow"
+	require.NoError(t, db.Create(&models.SteeringDocument{ReferenceID: "STD-001", Title: "Code Review Standards", Description: &description, CreatorID: creatorID}).Error)
+	require.NoError(t, db.Create(&models.SteeringDocument{ReferenceID: "STD-002", Title: "Release Checklist", CreatorID: otherCreatorID}).Error)
+	require.NoError(t, db.Create(&models.RequirementType{Name: "Functional"}).Error)
+	require.NoError(t, db.Create(&models.RelationshipType{Name: "depends_on"}).Error)
+
+	svc := NewSearchService(db, nil, nil, nil, nil, nil, nil)
+
+	response, err := svc.Search(context.Background(), SearchOptions{
+		EntityTypes: []string{"steering_document", "requirement_type", "relationship_type"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), response.Total)
+
+	byType := make(map[string][]SearchResult)
+	for _, result := range response.Results {
+		byType[result.Type] = append(byType[result.Type], result)
+	}
+	require.Len(t, byType["steering_document"], 2)
+	require.Len(t, byType["requirement_type"], 1)
+	assert.Equal(t, "Functional", byType["requirement_type"][0].Title)
+	assert.Empty(t, byType["requirement_type"][0].ReferenceID)
+	require.Len(t, byType["relationship_type"], 1)
+	assert.Equal(t, "depends_on", byType["relationship_type"][0].Title)
+
+	// The creator filter narrows steering documents
+	response, err = svc.Search(context.Background(), SearchOptions{
+		EntityTypes: []string{"steering_document"},
+		Filters:     SearchFilters{CreatorID: &creatorID},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+	assert.Equal(t, "STD-001", response.Results[0].ReferenceID)
+	assert.Equal(t, description, response.Results[0].Description)
+}
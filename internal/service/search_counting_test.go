@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func TestSearchService_SetCounting(t *testing.T) {
+	svc := NewSearchService(nil, nil, nil, nil, nil, nil, nil)
+	assert.Equal(t, SearchCountExact, svc.countMode)
+	assert.Equal(t, defaultSearchMaxResults, svc.maxResults)
+
+	svc.SetCounting(SearchCountEstimated, 200, time.Minute)
+	assert.Equal(t, SearchCountEstimated, svc.countMode)
+	assert.Equal(t, 200, svc.maxResults)
+	assert.Equal(t, time.Minute, svc.countCacheTTL)
+
+	svc.SetCounting("approximate", 0, 0)
+	assert.Equal(t, SearchCountExact, svc.countMode)
+	assert.Equal(t, defaultSearchMaxResults, svc.maxResults)
+}
+
+func TestSearchService_ResultCap(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.RequirementType{}, &models.RelationshipType{}))
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, db.Create(&models.RequirementType{Name: fmt.Sprintf("Type %d", i)}).Error)
+	}
+	require.NoError(t, db.Create(&models.RelationshipType{Name: "depends_on"}).Error)
+	require.NoError(t, db.Create(&models.RelationshipType{Name: "blocks"}).Error)
+
+	svc := NewSearchService(db, nil, nil, nil, nil, nil, nil)
+	svc.SetCounting(SearchCountExact, 4, 0)
+	entityTypes := []string{"requirement_type", "relationship_type"}
+
+	t.Run("first page", func(t *testing.T) {
+		response, err := svc.Search(context.Background(), SearchOptions{EntityTypes: entityTypes, Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, response.Results, 2)
+		assert.Equal(t, int64(7), response.Total)
+		assert.False(t, response.TotalEstimated)
+		assert.True(t, response.HasMore)
+		assert.True(t, response.Capped)
+	})
+
+	t.Run("page reaching the cap", func(t *testing.T) {
+		response, err := svc.Search(context.Background(), SearchOptions{EntityTypes: entityTypes, Limit: 3, Offset: 2})
+		require.NoError(t, err)
+		assert.Len(t, response.Results, 2)
+		assert.Equal(t, int64(7), response.Total)
+		assert.False(t, response.HasMore)
+		assert.True(t, response.Capped)
+	})
+
+	t.Run("page past the cap", func(t *testing.T) {
+		response, err := svc.Search(context.Background(), SearchOptions{EntityTypes: entityTypes, Limit: 2, Offset: 4})
+		require.NoError(t, err)
+		assert.Empty(t, response.Results)
+		assert.NotNil(t, response.Results)
+		assert.False(t, response.HasMore)
+	})
+
+	t.Run("narrow query", func(t *testing.T) {
+		response, err := svc.Search(context.Background(), SearchOptions{EntityTypes: []string{"relationship_type"}, Limit: 1})
+		require.NoError(t, err)
+		assert.Len(t, response.Results, 1)
+		assert.Equal(t, int64(2), response.Total)
+		assert.True(t, response.HasMore)
+		assert.False(t, response.Capped)
+	})
+
+	t.Run("estimates fall back to counts outside PostgreSQL", func(t *testing.T) {
+		svc.SetCounting(SearchCountEstimated, 4, 0)
+		response, err := svc.Search(context.Background(), SearchOptions{EntityTypes: entityTypes, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), response.Total)
+		assert.False(t, response.TotalEstimated)
+	})
+
+	t.Run("queries stop with the request", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := svc.Search(ctx, SearchOptions{EntityTypes: entityTypes, Limit: 1})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestSearchService_CountCache(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.RequirementType{}, &models.RelationshipType{}))
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, db.Create(&models.RequirementType{Name: fmt.Sprintf("Type %d", i)}).Error)
+	}
+
+	svc := NewSearchService(db, nil, nil, nil, nil, nil, nil)
+	svc.SetCounting(SearchCountExact, 100, time.Minute)
+	options := SearchOptions{EntityTypes: []string{"requirement_type"}, Limit: 2}
+
+	response, err := svc.Search(context.Background(), options)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), response.Total)
+
+	// Later pages of the same query reuse the total
+	require.NoError(t, db.Create(&models.RequirementType{Name: "Type 6"}).Error)
+	options.Offset = 2
+	response, err = svc.Search(context.Background(), options)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), response.Total)
+
+	// So do other page sizes and orders
+	response, err = svc.Search(context.Background(), SearchOptions{EntityTypes: []string{"requirement_type"}, SortOrder: "asc", Limit: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), response.Total)
+
+	// Other queries are counted
+	response, err = svc.Search(context.Background(), SearchOptions{EntityTypes: []string{"requirement_type", "relationship_type"}, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), response.Total)
+
+	// Invalidating the cache counts again
+	require.NoError(t, svc.InvalidateCache(context.Background()))
+	response, err = svc.Search(context.Background(), options)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), response.Total)
+}
@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/repository"
+)
+
+// Reindex run states
+const (
+	ReindexStatusRunning   = "running"
+	ReindexStatusCompleted = "completed"
+	ReindexStatusFailed    = "failed"
+)
+
+// Search index health states
+const (
+	SearchIndexHealthy   = "healthy"
+	SearchIndexDegraded  = "degraded"
+	SearchIndexUnhealthy = "unhealthy"
+)
+
+// Reindex throttling defaults and limits
+const (
+	DefaultReindexThrottle = 500 * time.Millisecond
+	MaxReindexThrottle     = time.Minute
+)
+
+// Thresholds above which the search indexes are reported as degraded
+const (
+	// MaxPendingSearchDocuments is the number of documents in the GIN pending lists of all search indexes
+	MaxPendingSearchDocuments = 1000
+	// MaxStaleSearchRowsRatio is the share of rows of a table modified since its statistics were refreshed
+	MaxStaleSearchRowsRatio = 0.2
+)
+
+var (
+	ErrReindexInProgress  = apperrors.New(apperrors.KindConflict, "REINDEX_IN_PROGRESS", "a search reindex is already running")
+	ErrReindexNotStarted  = apperrors.New(apperrors.KindNotFound, "REINDEX_NOT_STARTED", "no search reindex has been started")
+	ErrUnknownSearchTable = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "table has no full-text search indexes")
+	ErrInvalidThrottle    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "throttle must be between 0 and 60000 milliseconds")
+)
+
+// SearchCacheInvalidator clears cached search results
+type SearchCacheInvalidator interface {
+	InvalidateCache(ctx context.Context) error
+}
+
+// SearchIndexService defines the interface for rebuilding the full-text search indexes and reporting their health
+type SearchIndexService interface {
+	Reindex(ctx context.Context, options ReindexOptions, progress func(ReindexRun)) (*ReindexRun, error)
+	StartReindex(ctx context.Context, options ReindexOptions) (*ReindexRun, error)
+	ReindexStatus(ctx context.Context) (*ReindexRun, error)
+	Health(ctx context.Context) (*SearchIndexHealth, error)
+}
+
+// ReindexOptions selects the indexes to rebuild and the pause between them
+type ReindexOptions struct {
+	Tables   []string      // Tables whose search indexes are rebuilt; empty means all
+	Throttle time.Duration // Pause between two index rebuilds to limit the load on the database
+}
+
+// ReindexRun is the progress of a search reindex
+// @Description State of a search reindex; indexes are listed as they are rebuilt
+type ReindexRun struct {
+	Status     string           `json:"status" example:"running"`
+	StartedAt  time.Time        `json:"started_at" example:"2023-01-01T10:00:00Z"`
+	FinishedAt *time.Time       `json:"finished_at,omitempty" example:"2023-01-01T10:02:00Z"`
+	ThrottleMs int64            `json:"throttle_ms" example:"500"`
+	Total      int              `json:"total" example:"13"`
+	Completed  int              `json:"completed" example:"4"`
+	Failed     int              `json:"failed" example:"0"`
+	Indexes    []ReindexedIndex `json:"indexes"`
+	Error      string           `json:"error,omitempty" example:""`
+}
+
+// ReindexedIndex is the result of rebuilding one search index
+type ReindexedIndex struct {
+	IndexName  string `json:"index_name" example:"idx_requirements_search"`
+	TableName  string `json:"table_name" example:"requirements"`
+	DurationMs int64  `json:"duration_ms" example:"840"`
+	Error      string `json:"error,omitempty" example:""`
+}
+
+// SearchIndexHealth reports the state of the full-text search indexes
+// @Description Index validity and sizes, documents not yet merged into the indexes and rows changed since the statistics were refreshed
+type SearchIndexHealth struct {
+	Status                      string                           `json:"status" example:"healthy"`
+	CheckedAt                   time.Time                        `json:"checked_at" example:"2023-01-01T10:00:00Z"`
+	PendingDocuments            *int64                           `json:"pending_documents,omitempty" example:"12"`
+	PendingDocumentsUnavailable bool                             `json:"pending_documents_unavailable" example:"false"`
+	ModifiedSinceAnalyze        int64                            `json:"modified_since_analyze" example:"35"`
+	Problems                    []string                         `json:"problems"`
+	Indexes                     []SearchIndexState               `json:"indexes"`
+	Tables                      []repository.SearchTableActivity `json:"tables"`
+	LastReindex                 *ReindexRun                      `json:"last_reindex,omitempty"`
+}
+
+// SearchIndexState is the state of one search index
+type SearchIndexState struct {
+	repository.SearchIndex
+	PendingDocuments *int64 `json:"pending_documents,omitempty" example:"3"`
+}
+
+// searchIndexService implements SearchIndexService interface
+type searchIndexService struct {
+	repo  repository.SearchIndexRepository
+	cache SearchCacheInvalidator
+
+	mu      sync.Mutex
+	lastRun *ReindexRun
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// NewSearchIndexService creates a new search index service instance; cache may be nil
+func NewSearchIndexService(repo repository.SearchIndexRepository, cache SearchCacheInvalidator) SearchIndexService {
+	return &searchIndexService{
+		repo:  repo,
+		cache: cache,
+		sleep: sleepContext,
+	}
+}
+
+// Reindex rebuilds the selected search indexes one at a time, pausing between them, and refreshes the
+// statistics of their tables. A failed index does not stop the run; the returned error reports the failures.
+// progress, if not nil, receives a snapshot of the run after every index.
+func (s *searchIndexService) Reindex(ctx context.Context, options ReindexOptions, progress func(ReindexRun)) (*ReindexRun, error) {
+	indexes, err := s.selectIndexes(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.begin(options, len(indexes))
+	if err != nil {
+		return nil, err
+	}
+	return s.execute(ctx, run, indexes, options.Throttle, progress)
+}
+
+// StartReindex validates the options and rebuilds the search indexes in the background.
+// Use ReindexStatus to follow its progress.
+func (s *searchIndexService) StartReindex(ctx context.Context, options ReindexOptions) (*ReindexRun, error) {
+	indexes, err := s.selectIndexes(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.begin(options, len(indexes))
+	if err != nil {
+		return nil, err
+	}
+	snapshot := s.snapshot(run)
+
+	go func() {
+		// Errors are recorded in the run
+		_, _ = s.execute(context.Background(), run, indexes, options.Throttle, nil)
+	}()
+	return snapshot, nil
+}
+
+// ReindexStatus returns the progress of the running or most recent reindex
+func (s *searchIndexService) ReindexStatus(ctx context.Context) (*ReindexRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastRun == nil {
+		return nil, ErrReindexNotStarted
+	}
+	return s.copyRun(s.lastRun), nil
+}
+
+// Health reports whether every search index is valid, how many documents wait in the pending lists of the
+// indexes and how many rows changed since the statistics of their tables were refreshed
+func (s *searchIndexService) Health(ctx context.Context) (*SearchIndexHealth, error) {
+	indexes, err := s.repo.ListSearchIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search indexes: %w", err)
+	}
+
+	health := &SearchIndexHealth{
+		Status:    SearchIndexHealthy,
+		CheckedAt: time.Now().UTC(),
+		Problems:  []string{},
+		Indexes:   make([]SearchIndexState, 0, len(indexes)),
+	}
+	if len(indexes) == 0 {
+		health.Status = SearchIndexUnhealthy
+		health.Problems = append(health.Problems, "no full-text search indexes found; run the database migrations")
+	}
+
+	var pendingTotal int64
+	inspectable := len(indexes) > 0
+	tables := []string{}
+	seenTables := map[string]bool{}
+	for _, index := range indexes {
+		state := SearchIndexState{SearchIndex: index}
+		if !index.Valid {
+			health.Status = SearchIndexUnhealthy
+			health.Problems = append(health.Problems, fmt.Sprintf("index %s is invalid; rebuild it with a reindex", index.IndexName))
+		}
+		if inspectable {
+			pending, ok, err := s.repo.PendingEntries(ctx, index.IndexName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to inspect search index %s: %w", index.IndexName, err)
+			}
+			if ok {
+				state.PendingDocuments = &pending
+				pendingTotal += pending
+			} else {
+				inspectable = false
+			}
+		}
+		health.Indexes = append(health.Indexes, state)
+		if !seenTables[index.TableName] {
+			seenTables[index.TableName] = true
+			tables = append(tables, index.TableName)
+		}
+	}
+
+	if inspectable {
+		health.PendingDocuments = &pendingTotal
+		if pendingTotal > MaxPendingSearchDocuments {
+			s.degrade(health, fmt.Sprintf("%d documents are not yet merged into the search indexes", pendingTotal))
+		}
+	} else if len(indexes) > 0 {
+		// Pending lists can only be read with the pgstattuple extension
+		health.PendingDocumentsUnavailable = true
+		for i := range health.Indexes {
+			health.Indexes[i].PendingDocuments = nil
+		}
+	}
+
+	health.Tables, err = s.repo.TableActivity(ctx, tables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read search table activity: %w", err)
+	}
+	for _, table := range health.Tables {
+		health.ModifiedSinceAnalyze += table.ModifiedSinceAnalyze
+		if table.LiveRows > 0 && float64(table.ModifiedSinceAnalyze) > float64(table.LiveRows)*MaxStaleSearchRowsRatio {
+			s.degrade(health, fmt.Sprintf("%d of %d rows of %s changed since its statistics were refreshed",
+				table.ModifiedSinceAnalyze, table.LiveRows, table.TableName))
+		}
+	}
+
+	if run, err := s.ReindexStatus(ctx); err == nil {
+		health.LastReindex = run
+		if run.Status == ReindexStatusFailed {
+			s.degrade(health, "the last search reindex failed: "+run.Error)
+		}
+	}
+	return health, nil
+}
+
+// selectIndexes validates the options and returns the search indexes of the selected tables
+func (s *searchIndexService) selectIndexes(ctx context.Context, options ReindexOptions) ([]repository.SearchIndex, error) {
+	if options.Throttle < 0 || options.Throttle > MaxReindexThrottle {
+		return nil, ErrInvalidThrottle
+	}
+
+	indexes, err := s.repo.ListSearchIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search indexes: %w", err)
+	}
+	if len(options.Tables) == 0 {
+		return indexes, nil
+	}
+
+	selected := map[string]bool{}
+	for _, table := range options.Tables {
+		selected[table] = false
+	}
+	filtered := []repository.SearchIndex{}
+	for _, index := range indexes {
+		if _, ok := selected[index.TableName]; ok {
+			selected[index.TableName] = true
+			filtered = append(filtered, index)
+		}
+	}
+	for _, table := range options.Tables {
+		if !selected[table] {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownSearchTable, table)
+		}
+	}
+	return filtered, nil
+}
+
+// begin records a new run as the current run unless another run is in progress
+func (s *searchIndexService) begin(options ReindexOptions, total int) (*ReindexRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastRun != nil && s.lastRun.Status == ReindexStatusRunning {
+		return nil, ErrReindexInProgress
+	}
+	s.lastRun = &ReindexRun{
+		Status:     ReindexStatusRunning,
+		StartedAt:  time.Now().UTC(),
+		ThrottleMs: options.Throttle.Milliseconds(),
+		Total:      total,
+		Indexes:    []ReindexedIndex{},
+	}
+	return s.lastRun, nil
+}
+
+// execute rebuilds the indexes of run and analyzes each table after its last index
+func (s *searchIndexService) execute(ctx context.Context, run *ReindexRun, indexes []repository.SearchIndex, throttle time.Duration, progress func(ReindexRun)) (*ReindexRun, error) {
+	var runErr error
+	for i, index := range indexes {
+		if i > 0 && throttle > 0 {
+			if err := s.sleep(ctx, throttle); err != nil {
+				runErr = err
+				break
+			}
+		}
+
+		started := time.Now()
+		err := s.repo.RebuildIndex(ctx, index.IndexName)
+		if err == nil && (i == len(indexes)-1 || indexes[i+1].TableName != index.TableName) {
+			err = s.repo.AnalyzeTable(ctx, index.TableName)
+		}
+
+		result := ReindexedIndex{
+			IndexName:  index.IndexName,
+			TableName:  index.TableName,
+			DurationMs: time.Since(started).Milliseconds(),
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		s.mu.Lock()
+		run.Indexes = append(run.Indexes, result)
+		run.Completed++
+		if err != nil {
+			run.Failed++
+		}
+		s.mu.Unlock()
+
+		if progress != nil {
+			progress(*s.snapshot(run))
+		}
+		if ctx.Err() != nil {
+			runErr = ctx.Err()
+			break
+		}
+	}
+
+	if runErr == nil && run.Failed > 0 {
+		runErr = fmt.Errorf("%d of %d search indexes failed to rebuild", run.Failed, run.Total)
+	}
+	if runErr == nil && s.cache != nil {
+		if err := s.cache.InvalidateCache(ctx); err != nil {
+			runErr = fmt.Errorf("failed to invalidate search cache: %w", err)
+		}
+	}
+
+	finished := time.Now().UTC()
+	s.mu.Lock()
+	run.FinishedAt = &finished
+	run.Status = ReindexStatusCompleted
+	if runErr != nil {
+		run.Status = ReindexStatusFailed
+		run.Error = runErr.Error()
+	}
+	s.mu.Unlock()
+	return s.snapshot(run), runErr
+}
+
+// degrade marks healthy indexes as degraded and records the problem
+func (s *searchIndexService) degrade(health *SearchIndexHealth, problem string) {
+	if health.Status == SearchIndexHealthy {
+		health.Status = SearchIndexDegraded
+	}
+	health.Problems = append(health.Problems, problem)
+}
+
+// snapshot copies run while holding the lock
+func (s *searchIndexService) snapshot(run *ReindexRun) *ReindexRun {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.copyRun(run)
+}
+
+// copyRun copies run; the caller holds the lock
+func (s *searchIndexService) copyRun(run *ReindexRun) *ReindexRun {
+	copied := *run
+	copied.Indexes = append([]ReindexedIndex{}, run.Indexes...)
+	return &copied
+}
+
+// sleepContext pauses for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/repository"
+)
+
+// fakeSearchIndexRepository records maintenance statements instead of running them
+type fakeSearchIndexRepository struct {
+	repository.SearchIndexRepository
+	indexes    []repository.SearchIndex
+	activity   []repository.SearchTableActivity
+	pending    map[string]int64
+	failing    map[string]bool
+	statements []string
+}
+
+func (r *fakeSearchIndexRepository) ListSearchIndexes(_ context.Context) ([]repository.SearchIndex, error) {
+	return r.indexes, nil
+}
+
+func (r *fakeSearchIndexRepository) TableActivity(_ context.Context, _ []string) ([]repository.SearchTableActivity, error) {
+	return r.activity, nil
+}
+
+func (r *fakeSearchIndexRepository) PendingEntries(_ context.Context, index string) (int64, bool, error) {
+	if r.pending == nil {
+		return 0, false, nil
+	}
+	return r.pending[index], true, nil
+}
+
+func (r *fakeSearchIndexRepository) RebuildIndex(_ context.Context, index string) error {
+	r.statements = append(r.statements, "reindex "+index)
+	if r.failing[index] {
+		return errors.New("could not create unique index")
+	}
+	return nil
+}
+
+func (r *fakeSearchIndexRepository) AnalyzeTable(_ context.Context, table string) error {
+	r.statements = append(r.statements, "analyze "+table)
+	return nil
+}
+
+type countingSearchCache struct {
+	invalidations int
+}
+
+func (c *countingSearchCache) InvalidateCache(_ context.Context) error {
+	c.invalidations++
+	return nil
+}
+
+func newFakeSearchIndexRepository() *fakeSearchIndexRepository {
+	return &fakeSearchIndexRepository{
+		indexes: []repository.SearchIndex{
+			{IndexName: "idx_epics_search", TableName: "epics", Valid: true},
+			{IndexName: "idx_requirements_search", TableName: "requirements", Valid: true},
+			{IndexName: "idx_steering_documents_search", TableName: "steering_documents", Valid: true},
+			{IndexName: "idx_steering_documents_title", TableName: "steering_documents", Valid: true},
+		},
+	}
+}
+
+func TestSearchIndexService_Reindex(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeSearchIndexRepository()
+	cache := &countingSearchCache{}
+	svc := NewSearchIndexService(repo, cache).(*searchIndexService)
+	var pauses []time.Duration
+	svc.sleep = func(_ context.Context, d time.Duration) error {
+		pauses = append(pauses, d)
+		return nil
+	}
+
+	var progress []int
+	run, err := svc.Reindex(context.Background(), ReindexOptions{Throttle: time.Second}, func(run ReindexRun) {
+		progress = append(progress, run.Completed)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, ReindexStatusCompleted, run.Status)
+	assert.Equal(t, 4, run.Total)
+	assert.Equal(t, 4, run.Completed)
+	assert.NotNil(t, run.FinishedAt)
+	assert.Equal(t, []int{1, 2, 3, 4}, progress)
+	assert.Equal(t, []time.Duration{time.Second, time.Second, time.Second}, pauses, "pauses between indexes only")
+	assert.Equal(t, []string{
+		"reindex idx_epics_search", "analyze epics",
+		"reindex idx_requirements_search", "analyze requirements",
+		"reindex idx_steering_documents_search", "reindex idx_steering_documents_title", "analyze steering_documents",
+	}, repo.statements, "each table is analyzed once after its last index")
+	assert.Equal(t, 1, cache.invalidations)
+
+	status, err := svc.ReindexStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, run, status)
+}
+
+func TestSearchIndexService_ReindexSelectedTables(t *testing.T) {
+	repo := newFakeSearchIndexRepository()
+	svc := NewSearchIndexService(repo, nil)
+
+	run, err := svc.Reindex(context.Background(), ReindexOptions{Tables: []string{"requirements"}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, run.Total)
+	assert.Equal(t, []string{"reindex idx_requirements_search", "analyze requirements"}, repo.statements)
+
+	_, err = svc.Reindex(context.Background(), ReindexOptions{Tables: []string{"requirements", "comments"}}, nil)
+	assert.ErrorIs(t, err, ErrUnknownSearchTable)
+	assert.Contains(t, err.Error(), "comments")
+
+	_, err = svc.Reindex(context.Background(), ReindexOptions{Throttle: 2 * time.Minute}, nil)
+	assert.ErrorIs(t, err, ErrInvalidThrottle)
+}
+
+func TestSearchIndexService_ReindexContinuesAfterFailure(t *testing.T) {
+	repo := newFakeSearchIndexRepository()
+	repo.failing = map[string]bool{"idx_epics_search": true}
+	cache := &countingSearchCache{}
+	svc := NewSearchIndexService(repo, cache)
+
+	run, err := svc.Reindex(context.Background(), ReindexOptions{}, nil)
+	require.Error(t, err)
+	assert.Equal(t, "1 of 4 search indexes failed to rebuild", err.Error())
+	assert.Equal(t, ReindexStatusFailed, run.Status)
+	assert.Equal(t, 4, run.Completed)
+	assert.Equal(t, 1, run.Failed)
+	assert.NotEmpty(t, run.Indexes[0].Error)
+	assert.NotContains(t, repo.statements, "analyze epics", "a table is not analyzed when its index failed")
+	assert.Equal(t, 0, cache.invalidations)
+
+	health, err := svc.Health(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, SearchIndexDegraded, health.Status)
+}
+
+func TestSearchIndexService_ReindexStopsWhenCancelled(t *testing.T) {
+	repo := newFakeSearchIndexRepository()
+	svc := NewSearchIndexService(repo, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	run, err := svc.Reindex(ctx, ReindexOptions{}, func(ReindexRun) { cancel() })
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, ReindexStatusFailed, run.Status)
+	assert.Equal(t, 1, run.Completed)
+}
+
+func TestSearchIndexService_StartReindex(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeSearchIndexRepository()
+	svc := NewSearchIndexService(repo, nil).(*searchIndexService)
+	release := make(chan struct{})
+	svc.sleep = func(_ context.Context, _ time.Duration) error {
+		<-release
+		return nil
+	}
+
+	_, err := svc.ReindexStatus(ctx)
+	assert.ErrorIs(t, err, ErrReindexNotStarted)
+
+	run, err := svc.StartReindex(ctx, ReindexOptions{Throttle: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, ReindexStatusRunning, run.Status)
+
+	_, err = svc.StartReindex(ctx, ReindexOptions{})
+	assert.ErrorIs(t, err, ErrReindexInProgress)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		status, err := svc.ReindexStatus(ctx)
+		return err == nil && status.Status == ReindexStatusCompleted
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSearchIndexService_Health(t *testing.T) {
+	t.Run("healthy", func(t *testing.T) {
+		repo := newFakeSearchIndexRepository()
+		repo.pending = map[string]int64{"idx_epics_search": 3, "idx_requirements_search": 4}
+		repo.activity = []repository.SearchTableActivity{{TableName: "requirements", LiveRows: 100, ModifiedSinceAnalyze: 10}}
+
+		health, err := NewSearchIndexService(repo, nil).Health(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, SearchIndexHealthy, health.Status)
+		assert.Empty(t, health.Problems)
+		require.NotNil(t, health.PendingDocuments)
+		assert.Equal(t, int64(7), *health.PendingDocuments)
+		assert.False(t, health.PendingDocumentsUnavailable)
+		assert.Equal(t, int64(10), health.ModifiedSinceAnalyze)
+		require.NotNil(t, health.Indexes[0].PendingDocuments)
+		assert.Equal(t, int64(3), *health.Indexes[0].PendingDocuments)
+	})
+
+	t.Run("degraded by pending documents and stale statistics", func(t *testing.T) {
+		repo := newFakeSearchIndexRepository()
+		repo.pending = map[string]int64{"idx_requirements_search": MaxPendingSearchDocuments + 1}
+		repo.activity = []repository.SearchTableActivity{{TableName: "requirements", LiveRows: 100, ModifiedSinceAnalyze: 50}}
+
+		health, err := NewSearchIndexService(repo, nil).Health(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, SearchIndexDegraded, health.Status)
+		assert.Len(t, health.Problems, 2)
+	})
+
+	t.Run("unhealthy with an invalid index", func(t *testing.T) {
+		repo := newFakeSearchIndexRepository()
+		repo.indexes[1].Valid = false
+
+		health, err := NewSearchIndexService(repo, nil).Health(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, SearchIndexUnhealthy, health.Status)
+		assert.Contains(t, health.Problems[0], "idx_requirements_search")
+		assert.Nil(t, health.PendingDocuments)
+		assert.True(t, health.PendingDocumentsUnavailable)
+	})
+
+	t.Run("unhealthy without indexes", func(t *testing.T) {
+		health, err := NewSearchIndexService(&fakeSearchIndexRepository{}, nil).Health(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, SearchIndexUnhealthy, health.Status)
+		assert.False(t, health.PendingDocumentsUnavailable)
+	})
+}
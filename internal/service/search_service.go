@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
@@ -32,14 +36,17 @@ type SearchFilters struct {
 	AcceptanceCriteriaID *uuid.UUID `json:"acceptance_criteria_id,omitempty"`
 	RequirementTypeID    *uuid.UUID `json:"requirement_type_id,omitempty"`
 	AuthorID             *uuid.UUID `json:"author_id,omitempty"`
+
+	// IncludeArchived also matches archived epics and user stories
+	IncludeArchived bool `json:"include_archived,omitempty"`
 }
 
 // SearchOptions represents search configuration options
 type SearchOptions struct {
 	Query       string        `json:"query"`
-	EntityTypes []string      `json:"entity_types,omitempty"` // epic, user_story, acceptance_criteria, requirement
+	EntityTypes []string      `json:"entity_types,omitempty"` // epic, user_story, acceptance_criteria, requirement, steering_document, requirement_type, relationship_type
 	Filters     SearchFilters `json:"filters"`
-	SortBy      string        `json:"sort_by"`    // priority, created_at, updated_at, title
+	SortBy      string        `json:"sort_by"`    // priority, created_at, updated_at, title, relevance
 	SortOrder   string        `json:"sort_order"` // asc, desc
 	Limit       int           `json:"limit"`
 	Offset      int           `json:"offset"`
@@ -48,8 +55,8 @@ type SearchOptions struct {
 // SearchResult represents a single search result
 type SearchResult struct {
 	ID          uuid.UUID `json:"id"`
-	ReferenceID string    `json:"reference_id"`
-	Type        string    `json:"type"` // epic, user_story, acceptance_criteria, requirement
+	ReferenceID string    `json:"reference_id,omitempty"` // empty for requirement and relationship types
+	Type        string    `json:"type"`                   // epic, user_story, acceptance_criteria, requirement, steering_document, requirement_type, relationship_type
 	Title       string    `json:"title"`
 	Description string    `json:"description,omitempty"`
 	Priority    *int      `json:"priority,omitempty"`
@@ -58,6 +65,17 @@ type SearchResult struct {
 	Relevance   float64   `json:"relevance,omitempty"`
 }
 
+// searchableEntityTypes lists the entity types covered by search, in the order they are searched
+var searchableEntityTypes = []string{
+	"epic",
+	"user_story",
+	"acceptance_criteria",
+	"requirement",
+	"steering_document",
+	"requirement_type",
+	"relationship_type",
+}
+
 // SearchServiceInterface defines the interface for search operations
 type SearchServiceInterface interface {
 	Search(ctx context.Context, options SearchOptions) (*SearchResponse, error)
@@ -67,12 +85,50 @@ type SearchServiceInterface interface {
 
 // SearchResponse represents the complete search response
 type SearchResponse struct {
-	Results    []SearchResult `json:"results"`
-	Total      int64          `json:"total"`
-	Limit      int            `json:"limit"`
-	Offset     int            `json:"offset"`
-	Query      string         `json:"query"`
-	ExecutedAt time.Time      `json:"executed_at"`
+	Results        []SearchResult `json:"results"`
+	Total          int64          `json:"total"`
+	TotalEstimated bool           `json:"total_estimated"` // Total is the database planner's estimate rather than a count
+	HasMore        bool           `json:"has_more"`        // Another page of results can be fetched
+	Capped         bool           `json:"capped"`          // More results match than the result cap lets a search page through
+	Limit          int            `json:"limit"`
+	Offset         int            `json:"offset"`
+	Query          string         `json:"query"`
+	ExecutedAt     time.Time      `json:"executed_at"`
+}
+
+// Search count modes
+const (
+	SearchCountExact     = "exact"     // Totals of broad queries are counted
+	SearchCountEstimated = "estimated" // Totals of broad queries are estimated by the database planner
+)
+
+// defaultSearchMaxResults caps the results a search pages through unless SetCounting is called
+const defaultSearchMaxResults = 1000
+
+// searchPage is a page of search results with the total it was taken from
+type searchPage struct {
+	results   []SearchResult
+	total     int64
+	estimated bool
+	hasMore   bool
+	capped    bool
+}
+
+// matchScope tells findMatches how many matches of an entity type to load and in which order, and whether to
+// total them. findMatches sets the total.
+type matchScope struct {
+	limit     int    // Matches to load; zero loads none
+	order     string // ORDER BY of the loaded matches; empty keeps the database order
+	count     bool
+	total     int64
+	estimated bool
+}
+
+// searchCount is a cached total of a search
+type searchCount struct {
+	total     int64
+	estimated bool
+	expiresAt time.Time
 }
 
 // SearchService provides search and filtering functionality
@@ -85,8 +141,21 @@ type SearchService struct {
 	reqRepo       repository.RequirementRepository
 	steeringRepo  repository.SteeringDocumentRepository
 	refIDDetector *ReferenceIDDetector
+	languages     []string
+
+	countMode     string
+	maxResults    int
+	countCacheTTL time.Duration
+	countsMu      sync.Mutex
+	counts        map[string]searchCount
 }
 
+// defaultSearchLanguages are the text search configurations used unless SetLanguages is called
+var defaultSearchLanguages = []string{"english"}
+
+// searchLanguagePattern matches text search configuration names, which are written into the SQL as literals
+var searchLanguagePattern = regexp.MustCompile(`^[a-z_]+$`)
+
 // NewSearchService creates a new search service
 func NewSearchService(
 	db *gorm.DB,
@@ -106,7 +175,42 @@ func NewSearchService(
 		reqRepo:       reqRepo,
 		steeringRepo:  steeringRepo,
 		refIDDetector: NewReferenceIDDetector(),
+		languages:     defaultSearchLanguages,
+		countMode:     SearchCountExact,
+		maxResults:    defaultSearchMaxResults,
+	}
+}
+
+// SetLanguages sets the PostgreSQL text search configurations, such as english and russian, that full-text queries
+// are matched in. An entity matches when it matches in any of them and is ranked by its best match.
+// Names that are not plain configuration names are ignored; with none left the default applies.
+func (s *SearchService) SetLanguages(languages []string) {
+	valid := make([]string, 0, len(languages))
+	for _, language := range languages {
+		if searchLanguagePattern.MatchString(language) {
+			valid = append(valid, language)
+		}
+	}
+	if len(valid) == 0 {
+		valid = defaultSearchLanguages
 	}
+	s.languages = valid
+}
+
+// SetCounting sets how search totals are computed. Queries with more matches than a page loads are counted in
+// exact mode and estimated by the database planner in estimated mode. A search pages through at most maxResults
+// results; totals of repeated identical queries are reused for countCacheTTL, zero disables the reuse.
+// An unknown mode counts exactly and a maxResults below one keeps the default cap.
+func (s *SearchService) SetCounting(mode string, maxResults int, countCacheTTL time.Duration) {
+	s.countMode = SearchCountExact
+	if mode == SearchCountEstimated {
+		s.countMode = SearchCountEstimated
+	}
+	s.maxResults = defaultSearchMaxResults
+	if maxResults > 0 {
+		s.maxResults = maxResults
+	}
+	s.countCacheTTL = countCacheTTL
 }
 
 // Helper function to safely convert pointer to string to string
@@ -143,21 +247,20 @@ func (s *SearchService) validateSearchOptions(options SearchOptions) error {
 		"created_at": true,
 		"updated_at": true,
 		"title":      true,
+		"relevance":  true,
 	}
 	if options.SortBy != "" && !validSortFields[options.SortBy] {
 		return fmt.Errorf("invalid sort_by field: %s", options.SortBy)
 	}
 
 	// Validate entity types
-	validEntityTypes := map[string]bool{
-		"epic":                true,
-		"user_story":          true,
-		"acceptance_criteria": true,
-		"requirement":         true,
+	validEntityTypes := make(map[string]bool, len(searchableEntityTypes))
+	for _, entityType := range searchableEntityTypes {
+		validEntityTypes[entityType] = true
 	}
 	for _, entityType := range options.EntityTypes {
 		if !validEntityTypes[entityType] {
-			return fmt.Errorf("invalid entity_type: %s. Valid types are: epic, user_story, acceptance_criteria, requirement", entityType)
+			return fmt.Errorf("invalid entity_type: %s. Valid types are: %s", entityType, strings.Join(searchableEntityTypes, ", "))
 		}
 	}
 
@@ -195,35 +298,35 @@ func (s *SearchService) Search(ctx context.Context, options SearchOptions) (*Sea
 		options.SortOrder = "desc"
 	}
 
-	var results []SearchResult
-	var total int64
+	var page *searchPage
 
 	// Perform search based on query
 	if options.Query != "" {
 		// Full-text search
-		searchResults, searchTotal, err := s.performFullTextSearch(ctx, options)
+		textPage, err := s.performFullTextSearch(ctx, options)
 		if err != nil {
 			return nil, fmt.Errorf("full-text search failed: %w", err)
 		}
-		results = searchResults
-		total = searchTotal
+		page = textPage
 	} else {
 		// Filter-only search
-		filterResults, filterTotal, err := s.performFilterSearch(ctx, options)
+		filterPage, err := s.performFilterSearch(ctx, options)
 		if err != nil {
 			return nil, fmt.Errorf("filter search failed: %w", err)
 		}
-		results = filterResults
-		total = filterTotal
+		page = filterPage
 	}
 
 	response := &SearchResponse{
-		Results:    results,
-		Total:      total,
-		Limit:      options.Limit,
-		Offset:     options.Offset,
-		Query:      options.Query,
-		ExecutedAt: time.Now(),
+		Results:        page.results,
+		Total:          page.total,
+		TotalEstimated: page.estimated,
+		HasMore:        page.hasMore,
+		Capped:         page.capped,
+		Limit:          options.Limit,
+		Offset:         options.Offset,
+		Query:          options.Query,
+		ExecutedAt:     time.Now(),
 	}
 
 	// Cache the result
@@ -235,142 +338,251 @@ func (s *SearchService) Search(ctx context.Context, options SearchOptions) (*Sea
 }
 
 // performFullTextSearch performs PostgreSQL full-text search
-func (s *SearchService) performFullTextSearch(_ context.Context, options SearchOptions) ([]SearchResult, int64, error) {
-	var results []SearchResult
-	var total int64
-
+func (s *SearchService) performFullTextSearch(ctx context.Context, options SearchOptions) (*searchPage, error) {
 	// Prepare search query - escape special characters and create tsquery
 	searchQuery := s.prepareSearchQuery(options.Query)
 
-	// Determine which entity types to search
-	entityTypes := options.EntityTypes
-	if len(entityTypes) == 0 {
-		// Default to all entity types if none specified
-		entityTypes = []string{"epic", "user_story", "acceptance_criteria", "requirement"}
-	}
-
-	// Search in each specified entity type
-	for _, entityType := range entityTypes {
+	return s.collectMatches(options, func(entityType string, scope *matchScope) ([]SearchResult, error) {
 		switch entityType {
 		case "epic":
-			epicResults, err := s.searchEpics(searchQuery, options)
+			epicResults, err := s.searchEpics(ctx, searchQuery, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("epic search failed: %w", err)
+				return nil, fmt.Errorf("epic search failed: %w", err)
 			}
-			results = append(results, epicResults...)
+			return epicResults, nil
 
 		case "user_story":
-			userStoryResults, err := s.searchUserStories(searchQuery, options)
+			userStoryResults, err := s.searchUserStories(ctx, searchQuery, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("user story search failed: %w", err)
+				return nil, fmt.Errorf("user story search failed: %w", err)
 			}
-			results = append(results, userStoryResults...)
+			return userStoryResults, nil
 
 		case "acceptance_criteria":
-			acResults, err := s.searchAcceptanceCriteria(searchQuery, options)
+			acResults, err := s.searchAcceptanceCriteria(ctx, searchQuery, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("acceptance criteria search failed: %w", err)
+				return nil, fmt.Errorf("acceptance criteria search failed: %w", err)
 			}
-			results = append(results, acResults...)
+			return acResults, nil
 
 		case "requirement":
-			reqResults, err := s.searchRequirements(searchQuery, options)
+			reqResults, err := s.searchRequirements(ctx, searchQuery, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("requirement search failed: %w", err)
+				return nil, fmt.Errorf("requirement search failed: %w", err)
 			}
-			results = append(results, reqResults...)
-		}
-	}
+			return reqResults, nil
 
-	// Sort results by relevance and other criteria
-	results = s.sortResults(results, options.SortBy, options.SortOrder)
+		case "steering_document":
+			steeringResults, err := s.searchSteeringDocuments(ctx, searchQuery, options, scope)
+			if err != nil {
+				return nil, fmt.Errorf("steering document search failed: %w", err)
+			}
+			return steeringResults, nil
 
-	// Apply pagination
-	total = int64(len(results))
-	start := options.Offset
-	end := start + options.Limit
-
-	// Ensure safe pagination bounds
-	if start < 0 {
-		start = 0
-	}
-	if start >= len(results) {
-		results = []SearchResult{}
-	} else if end > len(results) {
-		results = results[start:]
-	} else {
-		results = results[start:end]
-	}
+		case "requirement_type":
+			typeResults, err := s.searchRequirementTypes(ctx, searchQuery, options, scope)
+			if err != nil {
+				return nil, fmt.Errorf("requirement type search failed: %w", err)
+			}
+			return typeResults, nil
 
-	return results, total, nil
+		case "relationship_type":
+			typeResults, err := s.searchRelationshipTypes(ctx, searchQuery, options, scope)
+			if err != nil {
+				return nil, fmt.Errorf("relationship type search failed: %w", err)
+			}
+			return typeResults, nil
+		}
+		return nil, nil
+	})
 }
 
 // performFilterSearch performs filtering without full-text search
-func (s *SearchService) performFilterSearch(_ context.Context, options SearchOptions) ([]SearchResult, int64, error) {
-	var results []SearchResult
-	var total int64
-
-	// Determine which entity types to search
-	entityTypes := options.EntityTypes
-	if len(entityTypes) == 0 {
-		// Default to all entity types if none specified
-		entityTypes = []string{"epic", "user_story", "acceptance_criteria", "requirement"}
-	}
-
-	// Filter each specified entity type
-	for _, entityType := range entityTypes {
+func (s *SearchService) performFilterSearch(ctx context.Context, options SearchOptions) (*searchPage, error) {
+	return s.collectMatches(options, func(entityType string, scope *matchScope) ([]SearchResult, error) {
 		switch entityType {
 		case "epic":
-			epicResults, err := s.filterEpics(options)
+			epicResults, err := s.filterEpics(ctx, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("epic filtering failed: %w", err)
+				return nil, fmt.Errorf("epic filtering failed: %w", err)
 			}
-			results = append(results, epicResults...)
+			return epicResults, nil
 
 		case "user_story":
-			userStoryResults, err := s.filterUserStories(options)
+			userStoryResults, err := s.filterUserStories(ctx, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("user story filtering failed: %w", err)
+				return nil, fmt.Errorf("user story filtering failed: %w", err)
 			}
-			results = append(results, userStoryResults...)
+			return userStoryResults, nil
 
 		case "acceptance_criteria":
-			acResults, err := s.filterAcceptanceCriteria(options)
+			acResults, err := s.filterAcceptanceCriteria(ctx, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("acceptance criteria filtering failed: %w", err)
+				return nil, fmt.Errorf("acceptance criteria filtering failed: %w", err)
 			}
-			results = append(results, acResults...)
+			return acResults, nil
 
 		case "requirement":
-			reqResults, err := s.filterRequirements(options)
+			reqResults, err := s.filterRequirements(ctx, options, scope)
+			if err != nil {
+				return nil, fmt.Errorf("requirement filtering failed: %w", err)
+			}
+			return reqResults, nil
+
+		case "steering_document":
+			steeringResults, err := s.filterSteeringDocuments(ctx, options, scope)
+			if err != nil {
+				return nil, fmt.Errorf("steering document filtering failed: %w", err)
+			}
+			return steeringResults, nil
+
+		case "requirement_type":
+			typeResults, err := s.filterRequirementTypes(ctx, options, scope)
 			if err != nil {
-				return nil, 0, fmt.Errorf("requirement filtering failed: %w", err)
+				return nil, fmt.Errorf("requirement type filtering failed: %w", err)
+			}
+			return typeResults, nil
+
+		case "relationship_type":
+			typeResults, err := s.filterRelationshipTypes(ctx, options, scope)
+			if err != nil {
+				return nil, fmt.Errorf("relationship type filtering failed: %w", err)
+			}
+			return typeResults, nil
+		}
+		return nil, nil
+	})
+}
+
+// collectMatches loads the matches of each searched entity type up to the end of the requested page, never past
+// the result cap, and returns the page with the total of all matches. Totals of repeated queries are taken from
+// the count cache; otherwise an entity type is only counted when it has more matches than were loaded.
+func (s *SearchService) collectMatches(options SearchOptions, find func(entityType string, scope *matchScope) ([]SearchResult, error)) (*searchPage, error) {
+	// Determine which entity types to search
+	entityTypes := options.EntityTypes
+	if len(entityTypes) == 0 {
+		// Default to all entity types if none specified
+		entityTypes = searchableEntityTypes
+	}
+
+	maxResults := s.maxResults
+	if maxResults <= 0 {
+		maxResults = defaultSearchMaxResults
+	}
+	end := min(options.Offset+options.Limit, maxResults)
+
+	countKey := s.countCacheKey(options)
+	cachedCount, counted := s.getCachedCount(countKey)
+
+	var results []SearchResult
+	var total int64
+	estimated := false
+	for _, entityType := range entityTypes {
+		// One match past the page tells whether another page follows
+		scope := &matchScope{limit: end + 1, count: !counted}
+		if options.SortBy == "relevance" && options.Query != "" {
+			// Matches are merged by relevance, so every entity type loads its best matches
+			scope.order = "relevance DESC"
+			if options.SortOrder == "asc" {
+				scope.order = "relevance ASC"
 			}
-			results = append(results, reqResults...)
+		} else {
+			// Other criteria keep the order of the entity type searches, so later types only fill the rest of the page
+			scope.limit = max(end+1-len(results), 0)
 		}
+
+		typeResults, err := find(entityType, scope)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, typeResults...)
+		total += scope.total
+		estimated = estimated || scope.estimated
 	}
 
-	// Sort results
+	// Sort results by relevance and other criteria
 	results = s.sortResults(results, options.SortBy, options.SortOrder)
 
-	// Apply pagination
-	total = int64(len(results))
-	start := options.Offset
-	end := start + options.Limit
-
-	// Ensure safe pagination bounds
-	if start < 0 {
-		start = 0
-	}
-	if start >= len(results) {
-		results = []SearchResult{}
-	} else if end > len(results) {
-		results = results[start:]
+	if counted {
+		total, estimated = cachedCount.total, cachedCount.estimated
 	} else {
-		results = results[start:end]
+		s.cacheCount(countKey, total, estimated)
 	}
+	// Matches added since the total was counted or a low estimate must not hide loaded results
+	total = max(total, int64(len(results)))
 
-	return results, total, nil
+	page := &searchPage{
+		total:     total,
+		estimated: estimated,
+		hasMore:   len(results) > end && end < maxResults,
+		capped:    total > int64(maxResults),
+	}
+
+	// Apply pagination
+	start := min(options.Offset, len(results))
+	page.results = results[start:min(end, len(results))]
+	if len(page.results) == 0 {
+		page.results = []SearchResult{}
+	}
+
+	return page, nil
+}
+
+// findMatches loads the matches of an entity type query allowed by the scope into dest and, when the scope asks
+// for it, counts them. Only queries with more matches than the scope loads run a count or an estimate.
+func (s *SearchService) findMatches(ctx context.Context, query *gorm.DB, dest interface{}, scope *matchScope) error {
+	query = query.Session(&gorm.Session{})
+
+	var loaded int64
+	if scope.limit > 0 {
+		find := query.Limit(scope.limit)
+		if scope.order != "" {
+			find = find.Order(scope.order)
+		}
+		result := find.Find(dest)
+		if result.Error != nil {
+			return result.Error
+		}
+		loaded = result.RowsAffected
+	}
+
+	if !scope.count {
+		return nil
+	}
+	if loaded < int64(scope.limit) {
+		scope.total = loaded
+		return nil
+	}
+	if s.countMode == SearchCountEstimated {
+		if estimate, ok := s.estimateMatches(ctx, query); ok {
+			scope.total = max(estimate, loaded)
+			scope.estimated = true
+			return nil
+		}
+	}
+	return s.db.WithContext(ctx).Table("(?) AS matches", query).Count(&scope.total).Error
+}
+
+// estimateMatches returns the planner's estimate of the rows a query matches. Estimates are only available
+// from PostgreSQL; other databases report false so that the matches are counted.
+func (s *SearchService) estimateMatches(ctx context.Context, query *gorm.DB) (int64, bool) {
+	if s.db.Dialector.Name() != "postgres" {
+		return 0, false
+	}
+
+	var plan string
+	if err := s.db.WithContext(ctx).Raw("EXPLAIN (FORMAT JSON) ?", query).Row().Scan(&plan); err != nil {
+		return 0, false
+	}
+	var explained []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explained); err != nil || len(explained) == 0 {
+		return 0, false
+	}
+	return int64(explained[0].Plan.Rows), true
 }
 
 // prepareSearchQuery prepares the search query for PostgreSQL full-text search
@@ -393,6 +605,33 @@ func (s *SearchService) prepareSearchQuery(query string) string {
 	return strings.Join(words, " & ")
 }
 
+// textSearch returns the condition matching a document expression against a prepared tsquery and the expression
+// ranking the match. With several search languages the document matches in any of them and ranks by its best match.
+// The expressions mirror the search indexes, so they must stay in sync with the migrations.
+func (s *SearchService) textSearch(document, searchQuery string) (match, rank clause.Expr) {
+	languages := s.languages
+	if len(languages) == 0 {
+		languages = defaultSearchLanguages
+	}
+
+	matches := make([]string, len(languages))
+	ranks := make([]string, len(languages))
+	vars := make([]interface{}, len(languages))
+	for i, language := range languages {
+		vector := fmt.Sprintf("to_tsvector('%s', %s)", language, document)
+		tsquery := fmt.Sprintf("to_tsquery('%s', ?)", language)
+		matches[i] = vector + " @@ " + tsquery
+		ranks[i] = "ts_rank(" + vector + ", " + tsquery + ")"
+		vars[i] = searchQuery
+	}
+
+	if len(languages) == 1 {
+		return clause.Expr{SQL: matches[0], Vars: vars}, clause.Expr{SQL: ranks[0], Vars: vars}
+	}
+	return clause.Expr{SQL: "(" + strings.Join(matches, " OR ") + ")", Vars: vars},
+		clause.Expr{SQL: "GREATEST(" + strings.Join(ranks, ", ") + ")", Vars: vars}
+}
+
 // generateCacheKey generates a cache key for the search options
 func (s *SearchService) generateCacheKey(options SearchOptions) string {
 	// Create a hash of the search options including entity types
@@ -426,8 +665,54 @@ func (s *SearchService) cacheResult(ctx context.Context, key string, response *S
 	s.redisClient.Set(ctx, key, data, 5*time.Minute)
 }
 
+// countCacheKey identifies the matches of a search regardless of the page and order asked for
+func (s *SearchService) countCacheKey(options SearchOptions) string {
+	options.Limit, options.Offset, options.SortBy, options.SortOrder = 0, 0, "", ""
+	data, _ := json.Marshal(options)
+	return string(data)
+}
+
+// getCachedCount returns the total of a search counted within the count cache TTL
+func (s *SearchService) getCachedCount(key string) (searchCount, bool) {
+	if s.countCacheTTL <= 0 {
+		return searchCount{}, false
+	}
+
+	s.countsMu.Lock()
+	defer s.countsMu.Unlock()
+	count, ok := s.counts[key]
+	if !ok || time.Now().After(count.expiresAt) {
+		return searchCount{}, false
+	}
+	return count, true
+}
+
+// cacheCount stores the total of a search for the count cache TTL, dropping expired totals
+func (s *SearchService) cacheCount(key string, total int64, estimated bool) {
+	if s.countCacheTTL <= 0 {
+		return
+	}
+
+	s.countsMu.Lock()
+	defer s.countsMu.Unlock()
+	now := time.Now()
+	if s.counts == nil {
+		s.counts = make(map[string]searchCount)
+	}
+	for cachedKey, count := range s.counts {
+		if now.After(count.expiresAt) {
+			delete(s.counts, cachedKey)
+		}
+	}
+	s.counts[key] = searchCount{total: total, estimated: estimated, expiresAt: now.Add(s.countCacheTTL)}
+}
+
 // InvalidateCache invalidates search cache (called when entities are modified)
 func (s *SearchService) InvalidateCache(ctx context.Context) error {
+	s.countsMu.Lock()
+	s.counts = nil
+	s.countsMu.Unlock()
+
 	if s.redisClient == nil {
 		return nil
 	}
@@ -479,13 +764,13 @@ func (s *SearchService) SearchByReferenceID(ctx context.Context, referenceID str
 }
 
 // searchByDirectReferenceID performs direct reference ID lookup
-func (s *SearchService) searchByDirectReferenceID(_ context.Context, pattern ReferenceIDPattern) (*SearchResponse, error) {
+func (s *SearchService) searchByDirectReferenceID(ctx context.Context, pattern ReferenceIDPattern) (*SearchResponse, error) {
 	var results []SearchResult
 
 	// Search for the entity based on its type
 	switch pattern.EntityType {
 	case "epic":
-		epic, err := s.epicRepo.GetByReferenceIDCaseInsensitive(pattern.OriginalQuery)
+		epic, err := s.epicRepo.GetByReferenceIDCaseInsensitive(ctx, pattern.OriginalQuery)
 		if err != nil {
 			if errors.Is(err, repository.ErrNotFound) {
 				// Not found is not an error for search, just return empty results
@@ -508,7 +793,7 @@ func (s *SearchService) searchByDirectReferenceID(_ context.Context, pattern Ref
 		results = append(results, result)
 
 	case "user_story":
-		userStory, err := s.userStoryRepo.GetByReferenceIDCaseInsensitive(pattern.OriginalQuery)
+		userStory, err := s.userStoryRepo.GetByReferenceIDCaseInsensitive(ctx, pattern.OriginalQuery)
 		if err != nil {
 			if errors.Is(err, repository.ErrNotFound) {
 				// Not found is not an error for search, just return empty results
@@ -531,7 +816,7 @@ func (s *SearchService) searchByDirectReferenceID(_ context.Context, pattern Ref
 		results = append(results, result)
 
 	case "requirement":
-		requirement, err := s.reqRepo.GetByReferenceIDCaseInsensitive(pattern.OriginalQuery)
+		requirement, err := s.reqRepo.GetByReferenceIDCaseInsensitive(ctx, pattern.OriginalQuery)
 		if err != nil {
 			if errors.Is(err, repository.ErrNotFound) {
 				// Not found is not an error for search, just return empty results
@@ -554,7 +839,7 @@ func (s *SearchService) searchByDirectReferenceID(_ context.Context, pattern Ref
 		results = append(results, result)
 
 	case "acceptance_criteria":
-		acceptanceCriteria, err := s.acRepo.GetByReferenceIDCaseInsensitive(pattern.OriginalQuery)
+		acceptanceCriteria, err := s.acRepo.GetByReferenceIDCaseInsensitive(ctx, pattern.OriginalQuery)
 		if err != nil {
 			if errors.Is(err, repository.ErrNotFound) {
 				// Not found is not an error for search, just return empty results
@@ -576,7 +861,7 @@ func (s *SearchService) searchByDirectReferenceID(_ context.Context, pattern Ref
 		results = append(results, result)
 
 	case "steering_document":
-		steeringDoc, err := s.steeringRepo.GetByReferenceIDCaseInsensitive(pattern.OriginalQuery)
+		steeringDoc, err := s.steeringRepo.GetByReferenceIDCaseInsensitive(ctx, pattern.OriginalQuery)
 		if err != nil {
 			if errors.Is(err, repository.ErrNotFound) {
 				// Not found is not an error for search, just return empty results
@@ -609,19 +894,21 @@ func (s *SearchService) searchByDirectReferenceID(_ context.Context, pattern Ref
 }
 
 // searchEpics performs full-text search on epics
-func (s *SearchService) searchEpics(searchQuery string, options SearchOptions) ([]SearchResult, error) {
-	var epics []models.Epic
+func (s *SearchService) searchEpics(ctx context.Context, searchQuery string, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var epics []struct {
+		models.Epic
+		Relevance float64
+	}
 
-	query := s.db.Model(&models.Epic{}).
-		Select("id, reference_id, title, description, priority, status, created_at, "+
-			"ts_rank(to_tsvector('english', reference_id || ' ' || title || ' ' || COALESCE(description, '')), "+
-			"to_tsquery('english', ?)) as relevance", searchQuery).
-		Where("to_tsvector('english', reference_id || ' ' || title || ' ' || COALESCE(description, '')) @@ to_tsquery('english', ?)", searchQuery)
+	match, rank := s.textSearch("reference_id || ' ' || title || ' ' || COALESCE(description, '')", searchQuery)
+	query := s.db.WithContext(ctx).Model(&models.Epic{}).
+		Select("id, reference_id, title, description, priority, status, created_at, ? as relevance", rank).
+		Where(match)
 
 	// Apply filters
 	query = s.applyEpicFilters(query, options.Filters)
 
-	if err := query.Find(&epics).Error; err != nil {
+	if err := s.findMatches(ctx, query, &epics, scope); err != nil {
 		return nil, err
 	}
 
@@ -636,6 +923,7 @@ func (s *SearchService) searchEpics(searchQuery string, options SearchOptions) (
 			Priority:    (*int)(&epic.Priority),
 			Status:      string(epic.Status),
 			CreatedAt:   epic.CreatedAt,
+			Relevance:   epic.Relevance,
 		}
 		results = append(results, result)
 	}
@@ -644,19 +932,21 @@ func (s *SearchService) searchEpics(searchQuery string, options SearchOptions) (
 }
 
 // searchUserStories performs full-text search on user stories
-func (s *SearchService) searchUserStories(searchQuery string, options SearchOptions) ([]SearchResult, error) {
-	var userStories []models.UserStory
+func (s *SearchService) searchUserStories(ctx context.Context, searchQuery string, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var userStories []struct {
+		models.UserStory
+		Relevance float64
+	}
 
-	query := s.db.Model(&models.UserStory{}).
-		Select("id, reference_id, title, description, priority, status, created_at, "+
-			"ts_rank(to_tsvector('english', reference_id || ' ' || title || ' ' || COALESCE(description, '')), "+
-			"to_tsquery('english', ?)) as relevance", searchQuery).
-		Where("to_tsvector('english', reference_id || ' ' || title || ' ' || COALESCE(description, '')) @@ to_tsquery('english', ?)", searchQuery)
+	match, rank := s.textSearch("reference_id || ' ' || title || ' ' || COALESCE(description, '')", searchQuery)
+	query := s.db.WithContext(ctx).Model(&models.UserStory{}).
+		Select("id, reference_id, title, description, priority, status, created_at, ? as relevance", rank).
+		Where(match)
 
 	// Apply filters
 	query = s.applyUserStoryFilters(query, options.Filters)
 
-	if err := query.Find(&userStories).Error; err != nil {
+	if err := s.findMatches(ctx, query, &userStories, scope); err != nil {
 		return nil, err
 	}
 
@@ -671,6 +961,7 @@ func (s *SearchService) searchUserStories(searchQuery string, options SearchOpti
 			Priority:    (*int)(&userStory.Priority),
 			Status:      string(userStory.Status),
 			CreatedAt:   userStory.CreatedAt,
+			Relevance:   userStory.Relevance,
 		}
 		results = append(results, result)
 	}
@@ -679,19 +970,21 @@ func (s *SearchService) searchUserStories(searchQuery string, options SearchOpti
 }
 
 // searchAcceptanceCriteria performs full-text search on acceptance criteria
-func (s *SearchService) searchAcceptanceCriteria(searchQuery string, options SearchOptions) ([]SearchResult, error) {
-	var acceptanceCriteria []models.AcceptanceCriteria
+func (s *SearchService) searchAcceptanceCriteria(ctx context.Context, searchQuery string, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var acceptanceCriteria []struct {
+		models.AcceptanceCriteria
+		Relevance float64
+	}
 
-	query := s.db.Model(&models.AcceptanceCriteria{}).
-		Select("id, reference_id, description, created_at, "+
-			"ts_rank(to_tsvector('english', reference_id || ' ' || COALESCE(description, '')), "+
-			"to_tsquery('english', ?)) as relevance", searchQuery).
-		Where("to_tsvector('english', reference_id || ' ' || COALESCE(description, '')) @@ to_tsquery('english', ?)", searchQuery)
+	match, rank := s.textSearch("reference_id || ' ' || COALESCE(description, '')", searchQuery)
+	query := s.db.WithContext(ctx).Model(&models.AcceptanceCriteria{}).
+		Select("id, reference_id, description, created_at, ? as relevance", rank).
+		Where(match)
 
 	// Apply filters
 	query = s.applyAcceptanceCriteriaFilters(query, options.Filters)
 
-	if err := query.Find(&acceptanceCriteria).Error; err != nil {
+	if err := s.findMatches(ctx, query, &acceptanceCriteria, scope); err != nil {
 		return nil, err
 	}
 
@@ -705,6 +998,7 @@ func (s *SearchService) searchAcceptanceCriteria(searchQuery string, options Sea
 			Description: ac.Description,
 			Status:      "active", // AC doesn't have status, use default
 			CreatedAt:   ac.CreatedAt,
+			Relevance:   ac.Relevance,
 		}
 		results = append(results, result)
 	}
@@ -713,19 +1007,21 @@ func (s *SearchService) searchAcceptanceCriteria(searchQuery string, options Sea
 }
 
 // searchRequirements performs full-text search on requirements
-func (s *SearchService) searchRequirements(searchQuery string, options SearchOptions) ([]SearchResult, error) {
-	var requirements []models.Requirement
+func (s *SearchService) searchRequirements(ctx context.Context, searchQuery string, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var requirements []struct {
+		models.Requirement
+		Relevance float64
+	}
 
-	query := s.db.Model(&models.Requirement{}).
-		Select("id, reference_id, title, description, priority, status, created_at, "+
-			"ts_rank(to_tsvector('english', reference_id || ' ' || title || ' ' || COALESCE(description, '')), "+
-			"to_tsquery('english', ?)) as relevance", searchQuery).
-		Where("to_tsvector('english', reference_id || ' ' || title || ' ' || COALESCE(description, '')) @@ to_tsquery('english', ?)", searchQuery)
+	match, rank := s.textSearch("reference_id || ' ' || title || ' ' || COALESCE(description, '')", searchQuery)
+	query := s.db.WithContext(ctx).Model(&models.Requirement{}).
+		Select("id, reference_id, title, description, priority, status, created_at, ? as relevance", rank).
+		Where(match)
 
 	// Apply filters
 	query = s.applyRequirementFilters(query, options.Filters)
 
-	if err := query.Find(&requirements).Error; err != nil {
+	if err := s.findMatches(ctx, query, &requirements, scope); err != nil {
 		return nil, err
 	}
 
@@ -740,6 +1036,7 @@ func (s *SearchService) searchRequirements(searchQuery string, options SearchOpt
 			Priority:    (*int)(&req.Priority),
 			Status:      string(req.Status),
 			CreatedAt:   req.CreatedAt,
+			Relevance:   req.Relevance,
 		}
 		results = append(results, result)
 	}
@@ -747,17 +1044,106 @@ func (s *SearchService) searchRequirements(searchQuery string, options SearchOpt
 	return results, nil
 }
 
+// searchSteeringDocuments performs full-text search on steering documents
+func (s *SearchService) searchSteeringDocuments(ctx context.Context, searchQuery string, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var steeringDocuments []struct {
+		models.SteeringDocument
+		Relevance float64
+	}
+
+	match, rank := s.textSearch("reference_id || ' ' || title || ' ' || COALESCE(description, '')", searchQuery)
+	query := s.db.WithContext(ctx).Model(&models.SteeringDocument{}).
+		Select("id, reference_id, title, description, created_at, ? as relevance", rank).
+		Where(match)
+
+	// Apply filters
+	query = s.applySteeringDocumentFilters(query, options.Filters)
+
+	if err := s.findMatches(ctx, query, &steeringDocuments, scope); err != nil {
+		return nil, err
+	}
+
+	documents := make([]models.SteeringDocument, len(steeringDocuments))
+	for i, doc := range steeringDocuments {
+		documents[i] = doc.SteeringDocument
+	}
+	results := steeringDocumentResults(documents)
+	for i := range results {
+		results[i].Relevance = steeringDocuments[i].Relevance
+	}
+
+	return results, nil
+}
+
+// searchRequirementTypes performs full-text search on requirement type names and descriptions
+func (s *SearchService) searchRequirementTypes(ctx context.Context, searchQuery string, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var requirementTypes []struct {
+		models.RequirementType
+		Relevance float64
+	}
+
+	match, rank := s.textSearch("name || ' ' || COALESCE(description, '')", searchQuery)
+	query := s.db.WithContext(ctx).Model(&models.RequirementType{}).
+		Select("id, name, description, created_at, ? as relevance", rank).
+		Where(match)
+
+	// Apply filters
+	query = s.applyConfigTypeFilters(query, options.Filters)
+
+	if err := s.findMatches(ctx, query, &requirementTypes, scope); err != nil {
+		return nil, err
+	}
+
+	var results []SearchResult
+	for _, requirementType := range requirementTypes {
+		result := configTypeResult("requirement_type", requirementType.ID, requirementType.Name, requirementType.Description, requirementType.CreatedAt)
+		result.Relevance = requirementType.Relevance
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// searchRelationshipTypes performs full-text search on relationship type names and descriptions
+func (s *SearchService) searchRelationshipTypes(ctx context.Context, searchQuery string, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var relationshipTypes []struct {
+		models.RelationshipType
+		Relevance float64
+	}
+
+	match, rank := s.textSearch("name || ' ' || COALESCE(description, '')", searchQuery)
+	query := s.db.WithContext(ctx).Model(&models.RelationshipType{}).
+		Select("id, name, description, created_at, ? as relevance", rank).
+		Where(match)
+
+	// Apply filters
+	query = s.applyConfigTypeFilters(query, options.Filters)
+
+	if err := s.findMatches(ctx, query, &relationshipTypes, scope); err != nil {
+		return nil, err
+	}
+
+	var results []SearchResult
+	for _, relationshipType := range relationshipTypes {
+		result := configTypeResult("relationship_type", relationshipType.ID, relationshipType.Name, relationshipType.Description, relationshipType.CreatedAt)
+		result.Relevance = relationshipType.Relevance
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 // filterEpics performs filtering on epics without full-text search
-func (s *SearchService) filterEpics(options SearchOptions) ([]SearchResult, error) {
+func (s *SearchService) filterEpics(ctx context.Context, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
 	var epics []models.Epic
 
-	query := s.db.Model(&models.Epic{}).
+	query := s.db.WithContext(ctx).Model(&models.Epic{}).
 		Select("id, reference_id, title, description, priority, status, created_at")
 
 	// Apply filters
 	query = s.applyEpicFilters(query, options.Filters)
 
-	if err := query.Find(&epics).Error; err != nil {
+	if err := s.findMatches(ctx, query, &epics, scope); err != nil {
 		return nil, err
 	}
 
@@ -780,16 +1166,16 @@ func (s *SearchService) filterEpics(options SearchOptions) ([]SearchResult, erro
 }
 
 // filterUserStories performs filtering on user stories without full-text search
-func (s *SearchService) filterUserStories(options SearchOptions) ([]SearchResult, error) {
+func (s *SearchService) filterUserStories(ctx context.Context, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
 	var userStories []models.UserStory
 
-	query := s.db.Model(&models.UserStory{}).
+	query := s.db.WithContext(ctx).Model(&models.UserStory{}).
 		Select("id, reference_id, title, description, priority, status, created_at")
 
 	// Apply filters
 	query = s.applyUserStoryFilters(query, options.Filters)
 
-	if err := query.Find(&userStories).Error; err != nil {
+	if err := s.findMatches(ctx, query, &userStories, scope); err != nil {
 		return nil, err
 	}
 
@@ -812,16 +1198,16 @@ func (s *SearchService) filterUserStories(options SearchOptions) ([]SearchResult
 }
 
 // filterAcceptanceCriteria performs filtering on acceptance criteria without full-text search
-func (s *SearchService) filterAcceptanceCriteria(options SearchOptions) ([]SearchResult, error) {
+func (s *SearchService) filterAcceptanceCriteria(ctx context.Context, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
 	var acceptanceCriteria []models.AcceptanceCriteria
 
-	query := s.db.Model(&models.AcceptanceCriteria{}).
+	query := s.db.WithContext(ctx).Model(&models.AcceptanceCriteria{}).
 		Select("id, reference_id, description, created_at")
 
 	// Apply filters
 	query = s.applyAcceptanceCriteriaFilters(query, options.Filters)
 
-	if err := query.Find(&acceptanceCriteria).Error; err != nil {
+	if err := s.findMatches(ctx, query, &acceptanceCriteria, scope); err != nil {
 		return nil, err
 	}
 
@@ -843,16 +1229,16 @@ func (s *SearchService) filterAcceptanceCriteria(options SearchOptions) ([]Searc
 }
 
 // filterRequirements performs filtering on requirements without full-text search
-func (s *SearchService) filterRequirements(options SearchOptions) ([]SearchResult, error) {
+func (s *SearchService) filterRequirements(ctx context.Context, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
 	var requirements []models.Requirement
 
-	query := s.db.Model(&models.Requirement{}).
+	query := s.db.WithContext(ctx).Model(&models.Requirement{}).
 		Select("id, reference_id, title, description, priority, status, created_at")
 
 	// Apply filters
 	query = s.applyRequirementFilters(query, options.Filters)
 
-	if err := query.Find(&requirements).Error; err != nil {
+	if err := s.findMatches(ctx, query, &requirements, scope); err != nil {
 		return nil, err
 	}
 
@@ -874,6 +1260,99 @@ func (s *SearchService) filterRequirements(options SearchOptions) ([]SearchResul
 	return results, nil
 }
 
+// filterSteeringDocuments performs filtering on steering documents without full-text search
+func (s *SearchService) filterSteeringDocuments(ctx context.Context, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var steeringDocuments []models.SteeringDocument
+
+	query := s.db.WithContext(ctx).Model(&models.SteeringDocument{}).
+		Select("id, reference_id, title, description, created_at")
+
+	// Apply filters
+	query = s.applySteeringDocumentFilters(query, options.Filters)
+
+	if err := s.findMatches(ctx, query, &steeringDocuments, scope); err != nil {
+		return nil, err
+	}
+
+	return steeringDocumentResults(steeringDocuments), nil
+}
+
+// filterRequirementTypes performs filtering on requirement types without full-text search
+func (s *SearchService) filterRequirementTypes(ctx context.Context, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var requirementTypes []models.RequirementType
+
+	query := s.db.WithContext(ctx).Model(&models.RequirementType{}).
+		Select("id, name, description, created_at")
+
+	// Apply filters
+	query = s.applyConfigTypeFilters(query, options.Filters)
+
+	if err := s.findMatches(ctx, query, &requirementTypes, scope); err != nil {
+		return nil, err
+	}
+
+	var results []SearchResult
+	for _, requirementType := range requirementTypes {
+		results = append(results, configTypeResult("requirement_type", requirementType.ID, requirementType.Name,
+			requirementType.Description, requirementType.CreatedAt))
+	}
+
+	return results, nil
+}
+
+// filterRelationshipTypes performs filtering on relationship types without full-text search
+func (s *SearchService) filterRelationshipTypes(ctx context.Context, options SearchOptions, scope *matchScope) ([]SearchResult, error) {
+	var relationshipTypes []models.RelationshipType
+
+	query := s.db.WithContext(ctx).Model(&models.RelationshipType{}).
+		Select("id, name, description, created_at")
+
+	// Apply filters
+	query = s.applyConfigTypeFilters(query, options.Filters)
+
+	if err := s.findMatches(ctx, query, &relationshipTypes, scope); err != nil {
+		return nil, err
+	}
+
+	var results []SearchResult
+	for _, relationshipType := range relationshipTypes {
+		results = append(results, configTypeResult("relationship_type", relationshipType.ID, relationshipType.Name,
+			relationshipType.Description, relationshipType.CreatedAt))
+	}
+
+	return results, nil
+}
+
+// steeringDocumentResults converts steering documents to search results
+func steeringDocumentResults(steeringDocuments []models.SteeringDocument) []SearchResult {
+	var results []SearchResult
+	for _, doc := range steeringDocuments {
+		result := SearchResult{
+			ID:          doc.ID,
+			ReferenceID: doc.ReferenceID,
+			Type:        "steering_document",
+			Title:       doc.Title,
+			Description: safeStringValue(doc.Description),
+			Status:      "active", // Steering documents don't have status, use default
+			CreatedAt:   doc.CreatedAt,
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// configTypeResult converts a requirement or relationship type to a search result titled by its name
+func configTypeResult(resultType string, id uuid.UUID, name string, description *string, createdAt time.Time) SearchResult {
+	return SearchResult{
+		ID:          id,
+		Type:        resultType,
+		Title:       name,
+		Description: safeStringValue(description),
+		Status:      "active", // Configuration types don't have status, use default
+		CreatedAt:   createdAt,
+	}
+}
+
 // applyEpicFilters applies filters to epic queries
 func (s *SearchService) applyEpicFilters(query *gorm.DB, filters SearchFilters) *gorm.DB {
 	if filters.CreatorID != nil {
@@ -894,6 +1373,9 @@ func (s *SearchService) applyEpicFilters(query *gorm.DB, filters SearchFilters)
 	if filters.CreatedTo != nil {
 		query = query.Where("created_at <= ?", *filters.CreatedTo)
 	}
+	if !filters.IncludeArchived {
+		query = query.Where("archived = ?", false)
+	}
 	return query
 }
 
@@ -920,6 +1402,9 @@ func (s *SearchService) applyUserStoryFilters(query *gorm.DB, filters SearchFilt
 	if filters.EpicID != nil {
 		query = query.Where("epic_id = ?", *filters.EpicID)
 	}
+	if !filters.IncludeArchived {
+		query = query.Where("archived = ?", false)
+	}
 	return query
 }
 
@@ -964,7 +1449,7 @@ func (s *SearchService) applyRequirementFilters(query *gorm.DB, filters SearchFi
 		query = query.Where("user_story_id = ?", *filters.UserStoryID)
 	}
 	if filters.AcceptanceCriteriaID != nil {
-		query = query.Where("acceptance_criteria_id = ?", *filters.AcceptanceCriteriaID)
+		query = query.Where("id IN (SELECT requirement_id FROM requirement_acceptance_criteria WHERE acceptance_criteria_id = ?)", *filters.AcceptanceCriteriaID)
 	}
 	if filters.RequirementTypeID != nil {
 		query = query.Where("type_id = ?", *filters.RequirementTypeID)
@@ -972,13 +1457,49 @@ func (s *SearchService) applyRequirementFilters(query *gorm.DB, filters SearchFi
 	return query
 }
 
+// applySteeringDocumentFilters applies filters to steering document queries
+func (s *SearchService) applySteeringDocumentFilters(query *gorm.DB, filters SearchFilters) *gorm.DB {
+	if filters.CreatorID != nil {
+		query = query.Where("creator_id = ?", *filters.CreatorID)
+	}
+	if filters.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filters.CreatedFrom)
+	}
+	if filters.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *filters.CreatedTo)
+	}
+	return query
+}
+
+// applyConfigTypeFilters applies filters to requirement and relationship type queries
+func (s *SearchService) applyConfigTypeFilters(query *gorm.DB, filters SearchFilters) *gorm.DB {
+	if filters.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filters.CreatedFrom)
+	}
+	if filters.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *filters.CreatedTo)
+	}
+	return query
+}
+
 // sortResults sorts search results based on the specified criteria
-func (s *SearchService) sortResults(results []SearchResult, _, _ string) []SearchResult {
+func (s *SearchService) sortResults(results []SearchResult, sortBy, sortOrder string) []SearchResult {
 	if len(results) == 0 {
 		return results
 	}
 
-	// For simplicity, we'll implement basic sorting
+	// Results from different entity types are merged by relevance, best match first unless asked otherwise
+	if sortBy == "relevance" {
+		sort.SliceStable(results, func(i, j int) bool {
+			if sortOrder == "asc" {
+				return results[i].Relevance < results[j].Relevance
+			}
+			return results[i].Relevance > results[j].Relevance
+		})
+		return results
+	}
+
+	// For simplicity, other criteria keep the order of the entity type searches
 	// In a production system, you might want to use a more sophisticated sorting library
 
 	// Note: This is a basic implementation. For better performance with large datasets,
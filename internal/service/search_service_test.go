@@ -317,6 +317,14 @@ func TestSearchService_validateSearchOptions(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "valid sort fields - relevance",
+			options: SearchOptions{
+				Query:  "test",
+				SortBy: "relevance",
+			},
+			expectError: false,
+		},
 		{
 			name: "valid entity types",
 			options: SearchOptions{
@@ -346,6 +354,13 @@ func TestSearchService_validateSearchOptions(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "steering document and configuration types",
+			options: SearchOptions{
+				EntityTypes: []string{"steering_document", "requirement_type", "relationship_type"},
+			},
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -361,3 +376,62 @@ func TestSearchService_validateSearchOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchService_textSearch(t *testing.T) {
+	document := "name || ' ' || COALESCE(description, '')"
+
+	t.Run("single language", func(t *testing.T) {
+		service := NewSearchService(nil, nil, nil, nil, nil, nil, nil)
+
+		match, rank := service.textSearch(document, "auth:*")
+		assert.Equal(t, "to_tsvector('english', name || ' ' || COALESCE(description, '')) @@ to_tsquery('english', ?)", match.SQL)
+		assert.Equal(t, []interface{}{"auth:*"}, match.Vars)
+		assert.Equal(t, "ts_rank(to_tsvector('english', name || ' ' || COALESCE(description, '')), to_tsquery('english', ?))", rank.SQL)
+		assert.Equal(t, []interface{}{"auth:*"}, rank.Vars)
+	})
+
+	t.Run("several languages match in any and rank by the best match", func(t *testing.T) {
+		service := NewSearchService(nil, nil, nil, nil, nil, nil, nil)
+		service.SetLanguages([]string{"english", "russian"})
+
+		match, rank := service.textSearch(document, "вход:*")
+		assert.Equal(t, "(to_tsvector('english', name || ' ' || COALESCE(description, '')) @@ to_tsquery('english', ?) OR "+
+			"to_tsvector('russian', name || ' ' || COALESCE(description, '')) @@ to_tsquery('russian', ?))", match.SQL)
+		assert.Equal(t, []interface{}{"вход:*", "вход:*"}, match.Vars)
+		assert.Equal(t, "GREATEST(ts_rank(to_tsvector('english', name || ' ' || COALESCE(description, '')), to_tsquery('english', ?)), "+
+			"ts_rank(to_tsvector('russian', name || ' ' || COALESCE(description, '')), to_tsquery('russian', ?)))", rank.SQL)
+		assert.Equal(t, []interface{}{"вход:*", "вход:*"}, rank.Vars)
+	})
+}
+
+func TestSearchService_SetLanguages(t *testing.T) {
+	service := NewSearchService(nil, nil, nil, nil, nil, nil, nil)
+
+	service.SetLanguages([]string{"russian", "english'); DROP TABLE epics; --"})
+	assert.Equal(t, []string{"russian"}, service.languages)
+
+	service.SetLanguages(nil)
+	assert.Equal(t, []string{"english"}, service.languages)
+}
+
+func TestSearchService_sortResults(t *testing.T) {
+	service := &SearchService{}
+	results := func() []SearchResult {
+		return []SearchResult{
+			{Title: "Epic", Type: "epic", Relevance: 0.2},
+			{Title: "Requirement", Type: "requirement", Relevance: 0.9},
+			{Title: "Steering document", Type: "steering_document", Relevance: 0.5},
+		}
+	}
+	titles := func(results []SearchResult) []string {
+		var titles []string
+		for _, result := range results {
+			titles = append(titles, result.Title)
+		}
+		return titles
+	}
+
+	assert.Equal(t, []string{"Requirement", "Steering document", "Epic"}, titles(service.sortResults(results(), "relevance", "desc")))
+	assert.Equal(t, []string{"Epic", "Steering document", "Requirement"}, titles(service.sortResults(results(), "relevance", "asc")))
+	assert.Equal(t, []string{"Epic", "Requirement", "Steering document"}, titles(service.sortResults(results(), "created_at", "desc")))
+}
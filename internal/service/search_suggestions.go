@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+)
+
+// Suggestion match kinds, strongest first
+const (
+	SuggestionMatchExact   = "exact"
+	SuggestionMatchPrefix  = "prefix"
+	SuggestionMatchTypo    = "typo"
+	SuggestionMatchTrigram = "trigram"
+)
+
+// minTrigramSimilarity is the lowest trigram similarity reported as a suggestion, matching the pg_trgm default
+const minTrigramSimilarity = 0.3
+
+// SearchSuggestion is a ranked entity matching a partial search query
+// @Description Entity suggested for a partial query, ranked by score
+type SearchSuggestion struct {
+	EntityType  string    `json:"entity_type" example:"epic"`
+	ID          uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string    `json:"reference_id" example:"EP-001"`
+	Title       string    `json:"title" example:"User Authentication System"`
+	Status      string    `json:"status" example:"Backlog"`
+	MatchedOn   string    `json:"matched_on" example:"title"`
+	Match       string    `json:"match" example:"prefix"`
+	Score       float64   `json:"score" example:"0.9"`
+}
+
+// suggestionCandidate is the subset of entity columns suggestions are built from
+type suggestionCandidate struct {
+	ID          uuid.UUID
+	ReferenceID string
+	Title       string
+	Status      string
+}
+
+// Suggest returns entities whose title or reference ID matches a partial query.
+// Matching is case-insensitive and ranks exact and prefix matches first, then
+// words within one typo (insertion, deletion, substitution or transposition),
+// then titles with a trigram similarity of at least 0.3.
+func (s *SearchService) Suggest(_ context.Context, query string, limit int) ([]SearchSuggestion, error) {
+	query = normalizeSuggestionText(query)
+	if query == "" || limit <= 0 {
+		return []SearchSuggestion{}, nil
+	}
+
+	sources := []struct {
+		entityType string
+		model      interface{}
+		columns    string
+	}{
+		{"epic", &models.Epic{}, "id, reference_id, title, status"},
+		{"user_story", &models.UserStory{}, "id, reference_id, title, status"},
+		{"requirement", &models.Requirement{}, "id, reference_id, title, status"},
+		// Acceptance criteria have no title or status; they are suggested by reference ID only
+		{"acceptance_criteria", &models.AcceptanceCriteria{}, "id, reference_id, reference_id AS title, 'active' AS status"},
+	}
+
+	suggestions := make([]SearchSuggestion, 0)
+	for _, source := range sources {
+		var candidates []suggestionCandidate
+		if err := s.db.Model(source.model).Select(source.columns).Scan(&candidates).Error; err != nil {
+			return nil, fmt.Errorf("failed to load %s suggestions: %w", source.entityType, err)
+		}
+
+		for _, candidate := range candidates {
+			suggestion, ok := scoreSuggestion(query, candidate)
+			if !ok {
+				continue
+			}
+			suggestion.EntityType = source.entityType
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		if len(suggestions[i].Title) != len(suggestions[j].Title) {
+			return len(suggestions[i].Title) < len(suggestions[j].Title)
+		}
+		return suggestions[i].ReferenceID < suggestions[j].ReferenceID
+	})
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// scoreSuggestion scores a candidate against a normalized query, keeping the better of the reference ID and title matches
+func scoreSuggestion(query string, candidate suggestionCandidate) (SearchSuggestion, bool) {
+	suggestion := SearchSuggestion{
+		ID:          candidate.ID,
+		ReferenceID: candidate.ReferenceID,
+		Title:       candidate.Title,
+		Status:      candidate.Status,
+	}
+
+	refMatch, refScore := scoreReferenceID(query, strings.ToLower(candidate.ReferenceID))
+	titleMatch, titleScore := scoreTitle(query, normalizeSuggestionText(candidate.Title))
+
+	switch {
+	case refScore == 0 && titleScore == 0:
+		return suggestion, false
+	case refScore >= titleScore:
+		suggestion.MatchedOn, suggestion.Match, suggestion.Score = "reference_id", refMatch, refScore
+	default:
+		suggestion.MatchedOn, suggestion.Match, suggestion.Score = "title", titleMatch, titleScore
+	}
+	return suggestion, true
+}
+
+// scoreReferenceID matches a query such as "us-01" or "us01" against a lower-cased reference ID
+func scoreReferenceID(query, referenceID string) (string, float64) {
+	compactQuery := strings.ReplaceAll(strings.ReplaceAll(query, " ", ""), "-", "")
+	compactRef := strings.ReplaceAll(referenceID, "-", "")
+
+	switch {
+	case query == referenceID || compactQuery == compactRef:
+		return SuggestionMatchExact, 1.0
+	case strings.HasPrefix(referenceID, query) || (len(compactQuery) > 1 && strings.HasPrefix(compactRef, compactQuery)):
+		return SuggestionMatchPrefix, 0.9
+	case len(compactQuery) >= 4 && withinOneEdit(compactQuery, compactRef):
+		return SuggestionMatchTypo, 0.7
+	}
+	return "", 0
+}
+
+// scoreTitle matches a query against a normalized title. Multi-word queries
+// match when every query word matches a title word.
+func scoreTitle(query, title string) (string, float64) {
+	if title == "" {
+		return "", 0
+	}
+	switch {
+	case query == title:
+		return SuggestionMatchExact, 0.95
+	case strings.HasPrefix(title, query):
+		return SuggestionMatchPrefix, 0.85
+	}
+
+	titleWords := strings.Fields(title)
+	queryWords := strings.Fields(query)
+	match, total := SuggestionMatchPrefix, 0.0
+	for _, queryWord := range queryWords {
+		best := 0.0
+		for _, titleWord := range titleWords {
+			switch {
+			case titleWord == queryWord || strings.HasPrefix(titleWord, queryWord):
+				best = 1.0
+			case best < 0.8 && len([]rune(queryWord)) >= 3 && typoMatchesWord(queryWord, titleWord):
+				best = 0.8
+			}
+			if best == 1.0 {
+				break
+			}
+		}
+		if best == 0 {
+			total = 0
+			break
+		}
+		if best < 1.0 {
+			match = SuggestionMatchTypo
+		}
+		total += best
+	}
+	if total > 0 {
+		// Word matches rank below whole-title prefixes; typos rank below exact words
+		return match, 0.75 * total / float64(len(queryWords))
+	}
+
+	if similarity := trigramSimilarity(query, title); similarity >= minTrigramSimilarity {
+		return SuggestionMatchTrigram, 0.5 * similarity
+	}
+	return "", 0
+}
+
+// typoMatchesWord reports whether a query word is one typo away from a word or from a prefix of it
+func typoMatchesWord(queryWord, word string) bool {
+	if withinOneEdit(queryWord, word) {
+		return true
+	}
+	runes := []rune(word)
+	queryLen := len([]rune(queryWord))
+	for _, n := range []int{queryLen - 1, queryLen, queryLen + 1} {
+		if n > 0 && n < len(runes) && withinOneEdit(queryWord, string(runes[:n])) {
+			return true
+		}
+	}
+	return false
+}
+
+// withinOneEdit reports whether a and b differ by at most one insertion,
+// deletion, substitution or transposition of adjacent characters
+func withinOneEdit(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	if len(ra)-len(rb) > 1 {
+		return false
+	}
+
+	i := 0
+	for i < len(rb) && ra[i] == rb[i] {
+		i++
+	}
+	if i == len(rb) {
+		return true
+	}
+
+	if len(ra) == len(rb) {
+		if string(ra[i+1:]) == string(rb[i+1:]) {
+			return true
+		}
+		return i+1 < len(ra) && ra[i] == rb[i+1] && ra[i+1] == rb[i] && string(ra[i+2:]) == string(rb[i+2:])
+	}
+	return string(ra[i+1:]) == string(rb[i:])
+}
+
+// trigramSimilarity computes the pg_trgm similarity of two texts: the number of
+// shared trigrams divided by the number of distinct trigrams in either text
+func trigramSimilarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range ta {
+		if tb[trigram] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+// trigrams returns the set of trigrams of each word padded with two leading and one trailing space
+func trigrams(text string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(text) {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			set[string(runes[i:i+3])] = true
+		}
+	}
+	return set
+}
+
+// normalizeSuggestionText lower-cases text and replaces punctuation with spaces, keeping hyphens inside words
+func normalizeSuggestionText(text string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+)
+
+func TestWithinOneEdit(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"login", "login", true},
+		{"lgin", "login", true},   // deletion
+		{"loggin", "login", true}, // insertion
+		{"lagin", "login", true},  // substitution
+		{"lgoin", "login", true},  // transposition
+		{"lgn", "login", false},   // two deletions
+		{"nigol", "login", false}, // reversed
+		{"заказы", "заказ", true}, // multi-byte runes
+		{"authentcation", "authentication", true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, withinOneEdit(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestTrigramSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, trigramSimilarity("login", "login"))
+	assert.Equal(t, 0.0, trigramSimilarity("login", ""))
+	assert.InDelta(t, 0.363636, trigramSimilarity("word", "two words"), 0.0001) // same as pg_trgm
+}
+
+func TestScoreSuggestion(t *testing.T) {
+	candidate := func(referenceID, title string) suggestionCandidate {
+		return suggestionCandidate{ID: uuid.New(), ReferenceID: referenceID, Title: title, Status: "Backlog"}
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		candidate     suggestionCandidate
+		expectMatch   bool
+		expectedOn    string
+		expectedMatch string
+	}{
+		{"exact reference ID", "ep-001", candidate("EP-001", "User Authentication"), true, "reference_id", SuggestionMatchExact},
+		{"reference ID without hyphen", "ep001", candidate("EP-001", "User Authentication"), true, "reference_id", SuggestionMatchExact},
+		{"reference ID prefix", "req-", candidate("REQ-012", "Password policy"), true, "reference_id", SuggestionMatchPrefix},
+		{"reference ID typo", "req-021", candidate("REQ-012", "Password policy"), true, "reference_id", SuggestionMatchTypo},
+		{"title prefix", "user auth", candidate("EP-001", "User Authentication"), true, "title", SuggestionMatchPrefix},
+		{"word prefix", "auth", candidate("EP-001", "User Authentication"), true, "title", SuggestionMatchPrefix},
+		{"word typo", "authentcation", candidate("EP-001", "User Authentication"), true, "title", SuggestionMatchTypo},
+		{"typo in word prefix", "pasword", candidate("REQ-012", "Password policy"), true, "title", SuggestionMatchTypo},
+		{"no match", "billing", candidate("EP-001", "User Authentication"), false, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suggestion, ok := scoreSuggestion(normalizeSuggestionText(tt.query), tt.candidate)
+			assert.Equal(t, tt.expectMatch, ok)
+			if tt.expectMatch {
+				assert.Equal(t, tt.expectedOn, suggestion.MatchedOn)
+				assert.Equal(t, tt.expectedMatch, suggestion.Match)
+				assert.True(t, suggestion.Score > 0 && suggestion.Score <= 1)
+			}
+		})
+	}
+}
+
+func TestSearchService_Suggest(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Epic{}, &models.UserStory{}, &models.Requirement{}, &models.AcceptanceCriteria{}))
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	require.NoError(t, session.Create(&models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "User Authentication", Status: models.EpicStatusBacklog}).Error)
+	require.NoError(t, session.Create(&models.Epic{ID: uuid.New(), ReferenceID: "EP-002", Title: "Billing", Status: models.EpicStatusDraft}).Error)
+	require.NoError(t, session.Create(&models.UserStory{ID: uuid.New(), ReferenceID: "US-001", Title: "Authenticate with SSO", Status: models.UserStoryStatusBacklog}).Error)
+
+	service := NewSearchService(db, nil, nil, nil, nil, nil, nil)
+
+	suggestions, err := service.Suggest(context.Background(), "authentcation", 10)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "epic", suggestions[0].EntityType)
+	assert.Equal(t, "EP-001", suggestions[0].ReferenceID)
+	assert.Equal(t, "Backlog", suggestions[0].Status)
+
+	suggestions, err = service.Suggest(context.Background(), "Auth", 10)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, "US-001", suggestions[0].ReferenceID, "whole-title prefix ranks first")
+	assert.Equal(t, "user_story", suggestions[0].EntityType)
+
+	suggestions, err = service.Suggest(context.Background(), "EP-00", 1)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, SuggestionMatchPrefix, suggestions[0].Match)
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// maxSignOffRoleLength is the longest role a stakeholder can sign off in
+const maxSignOffRoleLength = 100
+
+// Reasons a requirement appears in the unsigned requirements report
+const (
+	UnsignedReasonNeverSigned = "never_signed"
+	UnsignedReasonChanged     = "changed_since_sign_off"
+)
+
+var (
+	ErrSignOffStakeholderNotFound = apperrors.New(apperrors.KindNotFound, "SIGN_OFF_STAKEHOLDER_NOT_FOUND", "sign-off stakeholder not found")
+	ErrSignOffStakeholderExists   = apperrors.New(apperrors.KindConflict, "SIGN_OFF_STAKEHOLDER_EXISTS", "user is already designated in this role")
+	ErrSignOffRole                = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, fmt.Sprintf("role is required and must not exceed %d characters", maxSignOffRoleLength))
+	ErrSignOffEntityType          = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "only requirements and user stories can be signed off")
+	ErrSignOffSignatureHash       = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "signature_hash must be a hex-encoded SHA-256 or SHA-512 digest")
+	ErrSignOffNotStakeholder      = apperrors.New(apperrors.KindForbidden, apperrors.CodeInsufficientPermissions, "user is not designated to sign off in this role")
+	ErrSignOffVersionOutdated     = apperrors.New(apperrors.KindConflict, "SIGN_OFF_VERSION_OUTDATED", "entity was changed after the version being signed off")
+	ErrSignOffExists              = apperrors.New(apperrors.KindConflict, "SIGN_OFF_EXISTS", "version was already signed off in this role")
+)
+
+// SignOffService defines the interface for the formal acceptance of requirements and user stories
+// by stakeholders that administrators designate
+type SignOffService interface {
+	AddStakeholder(ctx context.Context, req AddSignOffStakeholderRequest, adminID uuid.UUID) (*models.SignOffStakeholder, error)
+	ListStakeholders(ctx context.Context, userID *uuid.UUID) ([]models.SignOffStakeholder, error)
+	RemoveStakeholder(ctx context.Context, id uuid.UUID) error
+	SignOff(ctx context.Context, entityType models.EntityType, idOrReference string, signerID uuid.UUID, req SignOffRequest) (*models.SignOff, error)
+	ListSignOffs(ctx context.Context, entityType models.EntityType, idOrReference string) ([]models.SignOff, error)
+	ListLog(ctx context.Context, filter repository.SignOffFilter) ([]models.SignOff, int64, error)
+	GetUnsignedReport(ctx context.Context, epicID *uuid.UUID) ([]UnsignedRequirement, error)
+}
+
+// AddSignOffStakeholderRequest designates a user to sign off in a role
+type AddSignOffStakeholderRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Role   string    `json:"role" binding:"required" example:"Product Owner"` // Role the user signs off in, such as Product Owner or Compliance Officer
+}
+
+// SignOffRequest represents the request to formally accept the current version of an entity
+// @Description Request payload for signing off a requirement or user story
+type SignOffRequest struct {
+	// Role is the designated role the stakeholder signs off in
+	// @Description Role the signer is designated in (required, max 100 characters)
+	// @Example "Product Owner"
+	Role string `json:"role" binding:"required" example:"Product Owner"`
+
+	// Version guards against signing off a text the signer has not seen
+	// @Description Version the signer reviewed; the sign-off is refused when the entity changed since (optional, defaults to the current version)
+	// @Example 3
+	Version *int `json:"version,omitempty" binding:"omitempty,min=0" example:"3"`
+
+	// SignatureHash is the digest of a digital signature made outside the system
+	// @Description Hex-encoded SHA-256 or SHA-512 digest of a digital signature of the accepted text (optional)
+	// @Example "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	SignatureHash *string `json:"signature_hash,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+
+	// Comment holds the remarks of the signer
+	// @Description Remarks of the signer (optional, max 5000 characters)
+	// @MaxLength 5000
+	// @Example "Accepted for release 2.1"
+	Comment string `json:"comment,omitempty" binding:"max=5000" example:"Accepted for release 2.1"`
+}
+
+// UnsignedRequirement is an Active requirement whose current version has not been signed off
+type UnsignedRequirement struct {
+	repository.RequirementSignOffState
+	Reason string `json:"reason" example:"changed_since_sign_off"` // never_signed, or changed_since_sign_off when an earlier version was signed off
+}
+
+// signOffService implements SignOffService interface
+type signOffService struct {
+	repos *repository.Repositories
+}
+
+// NewSignOffService creates a new sign-off service instance
+func NewSignOffService(repos *repository.Repositories) SignOffService {
+	return &signOffService{repos: repos}
+}
+
+// AddStakeholder designates a user to sign off in a role
+func (s *signOffService) AddStakeholder(ctx context.Context, req AddSignOffStakeholderRequest, adminID uuid.UUID) (*models.SignOffStakeholder, error) {
+	role, err := normalizeSignOffRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repos.User.GetByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if _, err := s.repos.SignOff.GetStakeholder(ctx, user.ID, role); err == nil {
+		return nil, ErrSignOffStakeholderExists
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get sign-off stakeholder: %w", err)
+	}
+
+	stakeholder := &models.SignOffStakeholder{
+		UserID:    user.ID,
+		Role:      role,
+		CreatedBy: adminID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.repos.SignOff.CreateStakeholder(ctx, stakeholder); err != nil {
+		return nil, fmt.Errorf("failed to create sign-off stakeholder: %w", err)
+	}
+	stakeholder.User = user
+	return stakeholder, nil
+}
+
+// ListStakeholders returns the designations, optionally of one user, ordered by role
+func (s *signOffService) ListStakeholders(ctx context.Context, userID *uuid.UUID) ([]models.SignOffStakeholder, error) {
+	stakeholders, err := s.repos.SignOff.ListStakeholders(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sign-off stakeholders: %w", err)
+	}
+	return stakeholders, nil
+}
+
+// RemoveStakeholder revokes a designation; the sign-offs made in it stay in the log
+func (s *signOffService) RemoveStakeholder(ctx context.Context, id uuid.UUID) error {
+	if err := s.repos.SignOff.DeleteStakeholder(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrSignOffStakeholderNotFound
+		}
+		return fmt.Errorf("failed to delete sign-off stakeholder: %w", err)
+	}
+	return nil
+}
+
+// SignOff records the acceptance of the current version of a requirement or user story by a designated stakeholder
+func (s *signOffService) SignOff(ctx context.Context, entityType models.EntityType, idOrReference string, signerID uuid.UUID, req SignOffRequest) (*models.SignOff, error) {
+	if entityType != models.EntityTypeRequirement && entityType != models.EntityTypeUserStory {
+		return nil, ErrSignOffEntityType
+	}
+	role, err := normalizeSignOffRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	var signatureHash *string
+	if req.SignatureHash != nil {
+		hash := strings.ToLower(strings.TrimSpace(*req.SignatureHash))
+		if _, err := hex.DecodeString(hash); err != nil || (len(hash) != 64 && len(hash) != 128) {
+			return nil, ErrSignOffSignatureHash
+		}
+		signatureHash = &hash
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repos.SignOff.GetStakeholder(ctx, signerID, role); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrSignOffNotStakeholder
+		}
+		return nil, fmt.Errorf("failed to get sign-off stakeholder: %w", err)
+	}
+
+	// Entities whose text predates the version history are at version 0
+	version := 0
+	latest, err := s.repos.EntityVersion.GetLatest(ctx, entityType, entityID)
+	if err == nil {
+		version = latest.VersionNumber
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get latest version: %w", err)
+	}
+	if req.Version != nil && *req.Version != version {
+		return nil, fmt.Errorf("%w: version %d was reviewed, the current version is %d", ErrSignOffVersionOutdated, *req.Version, version)
+	}
+
+	exists, err := s.repos.SignOff.Exists(ctx, entityType, entityID, signerID, role, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check sign-off: %w", err)
+	}
+	if exists {
+		return nil, ErrSignOffExists
+	}
+
+	signOff := &models.SignOff{
+		EntityType:    entityType,
+		EntityID:      entityID,
+		VersionNumber: version,
+		SignerID:      signerID,
+		Role:          role,
+		SignatureHash: signatureHash,
+		Comment:       strings.TrimSpace(req.Comment),
+		SignedAt:      time.Now().UTC(),
+	}
+	if err := s.repos.SignOff.Create(ctx, signOff); err != nil {
+		return nil, fmt.Errorf("failed to create sign-off: %w", err)
+	}
+	return signOff, nil
+}
+
+// ListSignOffs returns the sign-offs of a requirement or user story, oldest first
+func (s *signOffService) ListSignOffs(ctx context.Context, entityType models.EntityType, idOrReference string) ([]models.SignOff, error) {
+	if entityType != models.EntityTypeRequirement && entityType != models.EntityTypeUserStory {
+		return nil, ErrSignOffEntityType
+	}
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	signOffs, err := s.repos.SignOff.ListByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sign-offs: %w", err)
+	}
+	return signOffs, nil
+}
+
+// ListLog returns the sign-offs matching the filter, newest first. Sign-offs of deleted entities are kept.
+func (s *signOffService) ListLog(ctx context.Context, filter repository.SignOffFilter) ([]models.SignOff, int64, error) {
+	if filter.EntityType != nil && *filter.EntityType != models.EntityTypeRequirement && *filter.EntityType != models.EntityTypeUserStory {
+		return nil, 0, ErrSignOffEntityType
+	}
+	signOffs, total, err := s.repos.SignOff.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list sign-offs: %w", err)
+	}
+	return signOffs, total, nil
+}
+
+// GetUnsignedReport returns the Active requirements, optionally of one epic, that were never signed off
+// or were changed since their latest sign-off, ordered by reference ID
+func (s *signOffService) GetUnsignedReport(ctx context.Context, epicID *uuid.UUID) ([]UnsignedRequirement, error) {
+	states, err := s.repos.SignOff.ListSignOffStates(ctx, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirement sign-offs: %w", err)
+	}
+
+	unsigned := make([]UnsignedRequirement, 0, len(states))
+	for _, state := range states {
+		switch {
+		case state.SignedVersion == nil:
+			unsigned = append(unsigned, UnsignedRequirement{RequirementSignOffState: state, Reason: UnsignedReasonNeverSigned})
+		case *state.SignedVersion < state.CurrentVersion:
+			unsigned = append(unsigned, UnsignedRequirement{RequirementSignOffState: state, Reason: UnsignedReasonChanged})
+		}
+	}
+	return unsigned, nil
+}
+
+// normalizeSignOffRole trims a role and checks its length
+func normalizeSignOffRole(role string) (string, error) {
+	role = strings.TrimSpace(role)
+	if role == "" || len(role) > maxSignOffRoleLength {
+		return "", ErrSignOffRole
+	}
+	return role, nil
+}
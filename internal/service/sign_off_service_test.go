@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestSignOffService(t *testing.T) {
+	ctx := context.Background()
+	active, draft := models.RequirementStatusActive, models.RequirementStatusDraft
+	db, alice, epic, requirements := setupSupersessionTest(t, active, active, active, draft)
+	require.NoError(t, db.AutoMigrate(&models.EntityVersion{}, &models.SignOffStakeholder{}, &models.SignOff{}))
+	bob := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleCommenter}
+	require.NoError(t, db.Create(bob).Error)
+	version := func(requirement models.Requirement, number int) {
+		require.NoError(t, db.Create(&models.EntityVersion{EntityType: models.EntityTypeRequirement, EntityID: requirement.ID,
+			VersionNumber: number, Title: requirement.Title}).Error)
+	}
+	// REQ-001 is at version 2, REQ-002 at version 1 and REQ-003 predates the version history
+	version(requirements[0], 1)
+	version(requirements[0], 2)
+	version(requirements[1], 1)
+
+	svc := NewSignOffService(repository.NewRepositories(db, nil))
+	var stakeholder *models.SignOffStakeholder
+
+	t.Run("designates stakeholders", func(t *testing.T) {
+		var err error
+		stakeholder, err = svc.AddStakeholder(ctx, AddSignOffStakeholderRequest{UserID: bob.ID, Role: " Product Owner "}, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Product Owner", stakeholder.Role)
+		assert.Equal(t, "bob", stakeholder.User.Username)
+
+		_, err = svc.AddStakeholder(ctx, AddSignOffStakeholderRequest{UserID: bob.ID, Role: "Product Owner"}, alice.ID)
+		assert.ErrorIs(t, err, ErrSignOffStakeholderExists)
+		_, err = svc.AddStakeholder(ctx, AddSignOffStakeholderRequest{UserID: bob.ID, Role: " "}, alice.ID)
+		assert.ErrorIs(t, err, ErrSignOffRole)
+		_, err = svc.AddStakeholder(ctx, AddSignOffStakeholderRequest{UserID: uuid.New(), Role: "Product Owner"}, alice.ID)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		_, err = svc.AddStakeholder(ctx, AddSignOffStakeholderRequest{UserID: bob.ID, Role: "Compliance Officer"}, alice.ID)
+		require.NoError(t, err)
+		stakeholders, err := svc.ListStakeholders(ctx, &bob.ID)
+		require.NoError(t, err)
+		require.Len(t, stakeholders, 2)
+		assert.Equal(t, "Compliance Officer", stakeholders[0].Role)
+	})
+
+	t.Run("signs off the current version", func(t *testing.T) {
+		hash := strings.Repeat("AB", 32)
+		signOff, err := svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-001", bob.ID, SignOffRequest{Role: "Product Owner", SignatureHash: &hash, Comment: " Accepted "})
+		require.NoError(t, err)
+		assert.Equal(t, 2, signOff.VersionNumber)
+		assert.Equal(t, strings.Repeat("ab", 32), *signOff.SignatureHash)
+		assert.Equal(t, "Accepted", signOff.Comment)
+
+		reviewed := 0
+		signOff, err = svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-003", bob.ID, SignOffRequest{Role: "Product Owner", Version: &reviewed})
+		require.NoError(t, err)
+		assert.Equal(t, 0, signOff.VersionNumber)
+
+		signOff, err = svc.SignOff(ctx, models.EntityTypeUserStory, "US-001", bob.ID, SignOffRequest{Role: "Compliance Officer"})
+		require.NoError(t, err)
+		assert.Equal(t, models.EntityTypeUserStory, signOff.EntityType)
+	})
+
+	t.Run("refuses invalid sign-offs", func(t *testing.T) {
+		_, err := svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-001", bob.ID, SignOffRequest{Role: "Product Owner"})
+		assert.ErrorIs(t, err, ErrSignOffExists)
+		reviewed := 1
+		_, err = svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-001", bob.ID, SignOffRequest{Role: "Compliance Officer", Version: &reviewed})
+		assert.ErrorIs(t, err, ErrSignOffVersionOutdated)
+		_, err = svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-002", alice.ID, SignOffRequest{Role: "Product Owner"})
+		assert.ErrorIs(t, err, ErrSignOffNotStakeholder)
+		_, err = svc.SignOff(ctx, models.EntityTypeEpic, "EP-001", bob.ID, SignOffRequest{Role: "Product Owner"})
+		assert.ErrorIs(t, err, ErrSignOffEntityType)
+		hash := "not-a-digest"
+		_, err = svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-002", bob.ID, SignOffRequest{Role: "Product Owner", SignatureHash: &hash})
+		assert.ErrorIs(t, err, ErrSignOffSignatureHash)
+		_, err = svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-404", bob.ID, SignOffRequest{Role: "Product Owner"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("reports unsigned Active requirements", func(t *testing.T) {
+		// REQ-001 changes after its sign-off
+		version(requirements[0], 3)
+
+		entries, err := svc.GetUnsignedReport(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "REQ-001", entries[0].ReferenceID)
+		assert.Equal(t, UnsignedReasonChanged, entries[0].Reason)
+		assert.Equal(t, 3, entries[0].CurrentVersion)
+		require.NotNil(t, entries[0].SignedVersion)
+		assert.Equal(t, 2, *entries[0].SignedVersion)
+		assert.NotNil(t, entries[0].LastSignedAt)
+		assert.Equal(t, "REQ-002", entries[1].ReferenceID)
+		assert.Equal(t, UnsignedReasonNeverSigned, entries[1].Reason)
+		assert.Equal(t, "alice", entries[1].AssigneeUsername)
+
+		otherEpic := uuid.New()
+		entries, err = svc.GetUnsignedReport(ctx, &otherEpic)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+		entries, err = svc.GetUnsignedReport(ctx, &epic.ID)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+
+	t.Run("keeps the sign-off log when a designation is revoked", func(t *testing.T) {
+		require.NoError(t, svc.RemoveStakeholder(ctx, stakeholder.ID))
+		assert.ErrorIs(t, svc.RemoveStakeholder(ctx, stakeholder.ID), ErrSignOffStakeholderNotFound)
+		_, err := svc.SignOff(ctx, models.EntityTypeRequirement, "REQ-002", bob.ID, SignOffRequest{Role: "Product Owner"})
+		assert.ErrorIs(t, err, ErrSignOffNotStakeholder)
+
+		signOffs, err := svc.ListSignOffs(ctx, models.EntityTypeRequirement, "REQ-001")
+		require.NoError(t, err)
+		require.Len(t, signOffs, 1)
+		assert.Equal(t, "bob", signOffs[0].Signer.Username)
+
+		entityType := models.EntityTypeRequirement
+		log, total, err := svc.ListLog(ctx, repository.SignOffFilter{EntityType: &entityType, SignerID: &bob.ID, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Len(t, log, 1)
+		_, total, err = svc.ListLog(ctx, repository.SignOffFilter{Limit: 50})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+}
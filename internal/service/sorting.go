@@ -0,0 +1,127 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"product-requirements-management/internal/apperrors"
+)
+
+// ErrInvalidSort is returned for sort keys that name an unknown field or direction
+var ErrInvalidSort = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid sort")
+
+// sortKey is a field a list is sorted by
+type sortKey struct {
+	Field      string
+	Descending bool
+}
+
+// sortFields maps the field names a list can be sorted by to their columns
+type sortFields map[string]string
+
+var (
+	epicSortFields = sortFields{
+		"reference_id": "reference_id",
+		"title":        "title",
+		"priority":     "priority",
+		"status":       "status",
+		"due_date":     "due_date",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+	}
+	userStorySortFields = sortFields{
+		"reference_id": "reference_id",
+		"title":        "title",
+		"priority":     "priority",
+		"status":       "status",
+		"due_date":     "due_date",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+	}
+	requirementSortFields = sortFields{
+		"reference_id": "reference_id",
+		"title":        "title",
+		"priority":     "priority",
+		"status":       "status",
+		"created_at":   "created_at",
+		"updated_at":   "updated_at",
+	}
+)
+
+// parseSort parses comma-separated sort keys such as "priority:asc,updated_at:desc". The order_by form
+// "priority ASC, updated_at DESC" is accepted as well. Keys without a direction sort ascending.
+func parseSort(value string) ([]sortKey, error) {
+	var keys []sortKey
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		field, direction := part, ""
+		if i := strings.IndexAny(part, ": "); i >= 0 {
+			field, direction = part[:i], strings.TrimSpace(part[i+1:])
+		}
+		key := sortKey{Field: strings.ToLower(field)}
+		switch strings.ToLower(direction) {
+		case "", "asc":
+		case "desc":
+			key.Descending = true
+		default:
+			return nil, fmt.Errorf("%w: direction of %s must be asc or desc", ErrInvalidSort, field)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// orderClause builds the ORDER BY clause for sort keys, or for fallback without any. Fields outside the
+// allowlist are rejected. The id tiebreaker keeps rows with equal sort keys in the same order on every page.
+func (f sortFields) orderClause(value, fallback string) (string, error) {
+	keys, err := parseSort(value)
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		return fallback + ", id ASC", nil
+	}
+
+	clauses := make([]string, 0, len(keys)+1)
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		column, ok := f[key.Field]
+		if !ok {
+			return "", fmt.Errorf("%w: cannot sort by %s; sortable fields are %s", ErrInvalidSort, key.Field, strings.Join(f.names(), ", "))
+		}
+		if seen[key.Field] {
+			return "", fmt.Errorf("%w: %s is given more than once", ErrInvalidSort, key.Field)
+		}
+		seen[key.Field] = true
+
+		direction := "ASC"
+		if key.Descending {
+			direction = "DESC"
+		}
+		clauses = append(clauses, column+" "+direction)
+	}
+	clauses = append(clauses, "id ASC")
+	return strings.Join(clauses, ", "), nil
+}
+
+// sortOrFallback returns the sort keys of a list request; sort takes precedence over the older order_by
+func sortOrFallback(sort, orderBy string) string {
+	if sort != "" {
+		return sort
+	}
+	return orderBy
+}
+
+func (f sortFields) names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestSortFieldsOrderClause(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"default", "", "created_at DESC, id ASC"},
+		{"sort keys", "priority:asc,updated_at:desc", "priority ASC, updated_at DESC, id ASC"},
+		{"without direction", "title", "title ASC, id ASC"},
+		{"order_by form", "priority ASC, created_at DESC", "priority ASC, created_at DESC, id ASC"},
+		{"case and spaces", " Priority:DESC , ", "priority DESC, id ASC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, err := epicSortFields.orderClause(tt.value, "created_at DESC")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, clause)
+		})
+	}
+
+	invalid := []struct {
+		value   string
+		message string
+	}{
+		{"description:asc", "cannot sort by description; sortable fields are created_at, due_date, priority, reference_id, status, title, updated_at"},
+		{"priority:sideways", "direction of priority must be asc or desc"},
+		{"priority:asc,priority:desc", "priority is given more than once"},
+		{"created_at; DROP TABLE epics", "direction of created_at; must be asc or desc"},
+		{"id:asc", "cannot sort by id"},
+	}
+	for _, tt := range invalid {
+		_, err := epicSortFields.orderClause(tt.value, "created_at DESC")
+		assert.ErrorIs(t, err, ErrInvalidSort, tt.value)
+		assert.ErrorContains(t, err, tt.message, tt.value)
+	}
+
+	_, err := requirementSortFields.orderClause("due_date:asc", "created_at DESC")
+	assert.ErrorIs(t, err, ErrInvalidSort, "requirements have no due date")
+}
+
+func TestListEpicsPaginatesStably(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}))
+
+	user := &models.User{Username: "planner", Email: "planner@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	// Epics with equal sort keys differ only in their ID
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+	var ids []string
+	for i := 0; i < 7; i++ {
+		priority := models.PriorityHigh
+		if i == 0 {
+			priority = models.PriorityCritical
+		}
+		epic := models.Epic{ID: uuid.New(), ReferenceID: fmt.Sprintf("EP-%03d", i+1), Title: "Epic", Status: models.EpicStatusBacklog,
+			Priority: priority, CreatorID: user.ID, AssigneeID: user.ID, CreatedAt: created, UpdatedAt: created}
+		require.NoError(t, session.Create(&epic).Error)
+		if i > 0 {
+			ids = append(ids, epic.ID.String())
+		}
+	}
+	sort.Strings(ids)
+
+	repos := repository.NewRepositories(db, nil)
+	service := NewEpicService(repos.Epic, repos.User)
+
+	var listed []string
+	for offset := 0; offset < 7; offset += 3 {
+		epics, total, err := service.ListEpics(ctx, EpicFilters{Sort: "priority:asc,updated_at:desc", Limit: 3, Offset: offset})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), total)
+		for _, epic := range epics {
+			listed = append(listed, epic.ID.String())
+		}
+	}
+
+	require.Len(t, listed, 7)
+	assert.Equal(t, ids, listed[1:], "ties are broken by ID so that pages neither repeat nor skip epics")
+
+	_, _, err = service.ListEpics(ctx, EpicFilters{OrderBy: "title; DELETE FROM epics"})
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
@@ -0,0 +1,473 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// SpellingService defines the interface for the spelling and terminology consistency report
+type SpellingService interface {
+	Analyze(ctx context.Context, now time.Time) (*SpellingReport, error)
+	GetReport(ctx context.Context, now time.Time) (*SpellingReport, error)
+}
+
+// SpellingReport lists misspellings and inconsistently written terms in the texts of each epic
+// @Description Misspelled words and terms written in several ways, per epic. Misspellings are only checked when a dictionary is configured.
+type SpellingReport struct {
+	GeneratedAt     time.Time              `json:"generated_at"`
+	EntitiesScanned int                    `json:"entities_scanned" example:"240"`
+	DictionaryWords int                    `json:"dictionary_words" example:"48000"` // 0 when no dictionary is configured
+	Epics           []EpicSpellingFindings `json:"epics"`
+}
+
+// EpicSpellingFindings are the findings in an epic and its user stories, acceptance criteria and requirements
+// @Description Spelling and terminology findings of an epic; epics without findings are left out
+type EpicSpellingFindings struct {
+	EpicID            uuid.UUID          `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID       string             `json:"reference_id" example:"EP-001"`
+	Title             string             `json:"title" example:"User Authentication"`
+	Misspellings      []Misspelling      `json:"misspellings"`
+	InconsistentTerms []InconsistentTerm `json:"inconsistent_terms"`
+}
+
+// Misspelling is a word found in neither the dictionary nor the glossary
+// @Description Word that is not in the dictionary or the glossary, with the closest dictionary word when there is one
+type Misspelling struct {
+	Word        string   `json:"word" example:"recieve"`
+	Suggestion  string   `json:"suggestion,omitempty" example:"receive"`
+	Occurrences int      `json:"occurrences" example:"3"`
+	Entities    []string `json:"entities" example:"US-004,REQ-012"` // Reference IDs of the entities using the word
+}
+
+// InconsistentTerm is a term written in more than one way, such as "log in", "log-in" and "login"
+// @Description Term written in several ways. The preferred form is the glossary spelling, or else the most frequent form.
+type InconsistentTerm struct {
+	Preferred string        `json:"preferred" example:"log in"`
+	Variants  []TermVariant `json:"variants"`
+}
+
+// TermVariant is one way a term is written
+type TermVariant struct {
+	Form        string   `json:"form" example:"login"`
+	Occurrences int      `json:"occurrences" example:"2"`
+	Entities    []string `json:"entities" example:"REQ-003"`
+}
+
+// spellingService implements SpellingService interface
+type spellingService struct {
+	repos      *repository.Repositories
+	dictionary map[string]bool
+	// byFirstRune groups the dictionary words by their first letter to look up suggestions
+	byFirstRune map[rune][]string
+
+	mu     sync.Mutex
+	latest *SpellingReport
+}
+
+// NewSpellingService creates a new spelling service checking words against the given dictionary and the glossary
+func NewSpellingService(repos *repository.Repositories, dictionary []string) SpellingService {
+	s := &spellingService{
+		repos:       repos,
+		dictionary:  make(map[string]bool, len(dictionary)),
+		byFirstRune: make(map[rune][]string),
+	}
+	for _, word := range dictionary {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word == "" || s.dictionary[word] {
+			continue
+		}
+		s.dictionary[word] = true
+		first, _ := utf8.DecodeRuneInString(word)
+		s.byFirstRune[first] = append(s.byFirstRune[first], word)
+	}
+	for first := range s.byFirstRune {
+		sort.Strings(s.byFirstRune[first])
+	}
+	return s
+}
+
+// LoadSpellingDictionary reads a word list with one word per line, such as /usr/share/dict/words.
+// Hunspell .dic files work too: the leading word count and the affix flags after a slash are ignored.
+func LoadSpellingDictionary(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open spelling dictionary: %w", err)
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.IndexByte(line, '/'); i >= 0 {
+			line = line[:i]
+		}
+		if line == "" || strings.HasPrefix(line, "#") || strings.IndexFunc(line, unicode.IsLetter) < 0 {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read spelling dictionary: %w", err)
+	}
+	return words, nil
+}
+
+// GetReport returns the report of the last analysis, analyzing now when there has been none yet
+func (s *spellingService) GetReport(ctx context.Context, now time.Time) (*SpellingReport, error) {
+	s.mu.Lock()
+	latest := s.latest
+	s.mu.Unlock()
+	if latest != nil {
+		return latest, nil
+	}
+	return s.Analyze(ctx, now)
+}
+
+// spellingText is a title or description of an entity
+type spellingText struct {
+	referenceID string
+	text        string
+}
+
+// glossarySpelling holds what the glossary contributes to the spelling report
+type glossarySpelling struct {
+	words     map[string]bool   // Lowercase words of the glossary terms, accepted as correctly spelled
+	preferred map[string]string // Glossary terms by compound key, the preferred way to write them
+}
+
+// Analyze checks the titles and descriptions of all epics, user stories, acceptance criteria and requirements,
+// groups the findings per epic and keeps the report as the latest one
+func (s *spellingService) Analyze(ctx context.Context, now time.Time) (*SpellingReport, error) {
+	terms, err := s.repos.GlossaryTerm.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list glossary terms: %w", err)
+	}
+	epics, err := s.repos.Epic.List(ctx, nil, "reference_id ASC", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list epics: %w", err)
+	}
+	userStories, err := s.repos.UserStory.List(ctx, nil, "reference_id ASC", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user stories: %w", err)
+	}
+	acceptanceCriteria, err := s.repos.AcceptanceCriteria.List(ctx, nil, "reference_id ASC", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+	}
+	requirements, err := s.repos.Requirement.List(ctx, nil, "reference_id ASC", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list requirements: %w", err)
+	}
+
+	texts := make(map[uuid.UUID][]spellingText, len(epics))
+	add := func(epicID uuid.UUID, referenceID string, title string, description *string) {
+		if title != "" {
+			texts[epicID] = append(texts[epicID], spellingText{referenceID: referenceID, text: title})
+		}
+		if description != nil {
+			texts[epicID] = append(texts[epicID], spellingText{referenceID: referenceID, text: *description})
+		}
+	}
+	for _, epic := range epics {
+		add(epic.ID, epic.ReferenceID, epic.Title, epic.Description)
+	}
+	storyEpics := make(map[uuid.UUID]uuid.UUID, len(userStories))
+	for _, userStory := range userStories {
+		storyEpics[userStory.ID] = userStory.EpicID
+		add(userStory.EpicID, userStory.ReferenceID, userStory.Title, userStory.Description)
+	}
+	for _, criteria := range acceptanceCriteria {
+		add(storyEpics[criteria.UserStoryID], criteria.ReferenceID, "", &criteria.Description)
+	}
+	for _, requirement := range requirements {
+		add(storyEpics[requirement.UserStoryID], requirement.ReferenceID, requirement.Title, requirement.Description)
+	}
+
+	glossary := glossarySpelling{words: make(map[string]bool), preferred: make(map[string]string, len(terms))}
+	for _, term := range terms {
+		for _, word := range wordPattern.FindAllString(strings.ReplaceAll(term.Term, "-", " "), -1) {
+			glossary.words[strings.ToLower(word)] = true
+		}
+		glossary.preferred[compoundKey(term.Term)] = term.Term
+	}
+
+	report := &SpellingReport{
+		GeneratedAt:     now,
+		EntitiesScanned: len(epics) + len(userStories) + len(acceptanceCriteria) + len(requirements),
+		DictionaryWords: len(s.dictionary),
+		Epics:           make([]EpicSpellingFindings, 0),
+	}
+	for _, epic := range epics {
+		findings := s.analyzeEpic(epic, texts[epic.ID], glossary)
+		if len(findings.Misspellings) > 0 || len(findings.InconsistentTerms) > 0 {
+			report.Epics = append(report.Epics, findings)
+		}
+	}
+
+	s.mu.Lock()
+	s.latest = report
+	s.mu.Unlock()
+	return report, nil
+}
+
+// wordPattern matches words, keeping hyphenated words and contractions together
+var wordPattern = regexp.MustCompile(`\p{L}+(?:['’-]\p{L}+)*`)
+
+// ignoredSpansPattern matches URLs, inline code and reference IDs, whose words are not prose
+var ignoredSpansPattern = regexp.MustCompile("\\S+://\\S+|`[^`]*`|\\b[A-Z]+-\\d+\\b")
+
+// compoundStopWords are words that are not joined into a compound with their neighbour,
+// so that "in to" and "into" or "may be" and "maybe" are not reported as variants of a term
+var compoundStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "to": true, "of": true, "be": true, "by": true,
+	"at": true, "as": true, "is": true, "it": true, "or": true, "and": true, "any": true, "every": true,
+	"some": true, "no": true, "one": true, "may": true, "can": true, "not": true, "all": true, "for": true,
+}
+
+// termVariantCount counts the occurrences of one way of writing a term
+type termVariantCount struct {
+	form     string
+	count    int
+	entities []string
+}
+
+// analyzeEpic collects the misspellings and inconsistent terms in the texts of one epic
+func (s *spellingService) analyzeEpic(epic models.Epic, texts []spellingText, glossary glossarySpelling) EpicSpellingFindings {
+	misspellings := make(map[string]*Misspelling)
+	unigrams := make(map[string]map[string]*termVariantCount)
+	bigrams := make(map[string]map[string]*termVariantCount)
+	count := func(variants map[string]map[string]*termVariantCount, form, referenceID string) {
+		key := compoundKey(form)
+		if variants[key] == nil {
+			variants[key] = make(map[string]*termVariantCount)
+		}
+		lower := strings.ToLower(form)
+		variant, ok := variants[key][lower]
+		if !ok {
+			variant = &termVariantCount{form: lower}
+			variants[key][lower] = variant
+		}
+		variant.count++
+		variant.entities = appendEntity(variant.entities, referenceID)
+	}
+
+	for _, text := range texts {
+		prose := ignoredSpansPattern.ReplaceAllStringFunc(text.text, func(span string) string {
+			return strings.Repeat(" ", len(span))
+		})
+		matches := wordPattern.FindAllStringIndex(prose, -1)
+		for i, match := range matches {
+			word := prose[match[0]:match[1]]
+			count(unigrams, word, text.referenceID)
+			if i > 0 {
+				previous := matches[i-1]
+				gap := prose[previous[1]:match[0]]
+				first := prose[previous[0]:previous[1]]
+				if gap == " " && !compoundStopWords[strings.ToLower(first)] && !compoundStopWords[strings.ToLower(word)] {
+					count(bigrams, first+" "+word, text.referenceID)
+				}
+			}
+
+			if len(s.dictionary) == 0 || !isSpellCheckable(word) {
+				continue
+			}
+			lower := strings.ToLower(word)
+			if s.isKnown(lower, glossary.words) {
+				continue
+			}
+			entry, ok := misspellings[lower]
+			if !ok {
+				entry = &Misspelling{Word: lower, Suggestion: s.suggest(lower), Entities: make([]string, 0)}
+				misspellings[lower] = entry
+			}
+			entry.Occurrences++
+			entry.Entities = appendEntity(entry.Entities, text.referenceID)
+		}
+	}
+
+	findings := EpicSpellingFindings{
+		EpicID:            epic.ID,
+		ReferenceID:       epic.ReferenceID,
+		Title:             epic.Title,
+		Misspellings:      make([]Misspelling, 0, len(misspellings)),
+		InconsistentTerms: make([]InconsistentTerm, 0),
+	}
+	for _, entry := range misspellings {
+		findings.Misspellings = append(findings.Misspellings, *entry)
+	}
+	sort.Slice(findings.Misspellings, func(i, j int) bool {
+		return findings.Misspellings[i].Word < findings.Misspellings[j].Word
+	})
+
+	// Two-word forms only count as variants of a term that is also written as one word or is in the glossary
+	for key, forms := range bigrams {
+		if unigrams[key] == nil {
+			if _, inGlossary := glossary.preferred[key]; !inGlossary {
+				continue
+			}
+			unigrams[key] = make(map[string]*termVariantCount)
+		}
+		for lower, variant := range forms {
+			unigrams[key][lower] = variant
+		}
+	}
+	for key, forms := range unigrams {
+		glossaryForm, inGlossary := glossary.preferred[key]
+		_, usesGlossaryForm := forms[strings.ToLower(glossaryForm)]
+		if len(forms) < 2 && (!inGlossary || usesGlossaryForm) {
+			continue
+		}
+
+		term := InconsistentTerm{Variants: make([]TermVariant, 0, len(forms))}
+		for _, variant := range forms {
+			term.Variants = append(term.Variants, TermVariant{Form: variant.form, Occurrences: variant.count, Entities: variant.entities})
+		}
+		sort.Slice(term.Variants, func(i, j int) bool {
+			if term.Variants[i].Occurrences != term.Variants[j].Occurrences {
+				return term.Variants[i].Occurrences > term.Variants[j].Occurrences
+			}
+			return term.Variants[i].Form < term.Variants[j].Form
+		})
+		term.Preferred = term.Variants[0].Form
+		if inGlossary {
+			term.Preferred = glossaryForm
+		}
+		findings.InconsistentTerms = append(findings.InconsistentTerms, term)
+	}
+	sort.Slice(findings.InconsistentTerms, func(i, j int) bool {
+		return strings.ToLower(findings.InconsistentTerms[i].Preferred) < strings.ToLower(findings.InconsistentTerms[j].Preferred)
+	})
+
+	return findings
+}
+
+// isKnown reports whether a lowercase word is in the dictionary or the glossary.
+// Hyphenated words are also known when each of their parts is.
+func (s *spellingService) isKnown(word string, glossaryWords map[string]bool) bool {
+	if s.dictionary[word] || glossaryWords[word] {
+		return true
+	}
+	if word = strings.ReplaceAll(word, "’", "'"); s.dictionary[word] {
+		return true
+	}
+	parts := strings.Split(word, "-")
+	if len(parts) < 2 {
+		return false
+	}
+	for _, part := range parts {
+		if !s.dictionary[part] && !glossaryWords[part] {
+			return false
+		}
+	}
+	return true
+}
+
+// suggest returns the dictionary word with the same first letter closest to a misspelled word,
+// or an empty string when none is within two edits
+func (s *spellingService) suggest(word string) string {
+	first, _ := utf8.DecodeRuneInString(word)
+	length := utf8.RuneCountInString(word)
+	best, bestDistance := "", 3
+	for _, candidate := range s.byFirstRune[first] {
+		if diff := utf8.RuneCountInString(candidate) - length; diff > 2 || diff < -2 {
+			continue
+		}
+		if distance := editDistance(word, candidate); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best
+}
+
+// isSpellCheckable reports whether a word is prose worth checking: acronyms such as SLA and mixed-case
+// names such as GitHub are left alone, and so are words of less than three letters
+func isSpellCheckable(word string) bool {
+	if utf8.RuneCountInString(word) < 3 {
+		return false
+	}
+	for i, r := range word {
+		if i > 0 && unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// compoundKey identifies the ways of writing a term: "Log in", "log-in" and "login" share the key "login"
+func compoundKey(form string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, form)
+}
+
+// appendEntity adds a reference ID to a list unless it is already the last one
+func appendEntity(entities []string, referenceID string) []string {
+	if n := len(entities); n > 0 && entities[n-1] == referenceID {
+		return entities
+	}
+	return append(entities, referenceID)
+}
+
+// editDistance returns the Levenshtein distance between two words
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	previous := make([]int, len(br)+1)
+	current := make([]int, len(br)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		current[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			current[j] = min(min(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(br)]
+}
+
+// RunSpellingScheduler refreshes the spelling report every interval until the context is cancelled
+func RunSpellingScheduler(ctx context.Context, spellingService SpellingService, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			report, err := spellingService.Analyze(ctx, now)
+			if err != nil {
+				logger.WithError(err).Error("Spelling analysis failed")
+				continue
+			}
+			logger.WithFields(logrus.Fields{
+				"entities": report.EntitiesScanned,
+				"epics":    len(report.Epics),
+			}).Debug("Spelling report refreshed")
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestSpellingService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.GlossaryTerm{}))
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+	require.NoError(t, db.Create(&models.GlossaryTerm{Term: "sign-in", Definition: "Starting a session", CreatorID: user.ID}).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	description := func(text string) *string { return &text }
+	epics := []models.Epic{
+		{ID: uuid.New(), ReferenceID: "EP-001", Title: "Account access", Description: description("Users log in with a password.")},
+		{ID: uuid.New(), ReferenceID: "EP-002", Title: "Checkout", Description: description("The order is paid.")},
+	}
+	for i := range epics {
+		epics[i].Status, epics[i].CreatorID, epics[i].AssigneeID = models.EpicStatusBacklog, user.ID, user.ID
+		require.NoError(t, session.Create(&epics[i]).Error)
+	}
+	story := models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: epics[0].ID, Title: "Login page",
+		Description: description("As a user I want to recieve a link after a failed login. See REQ-001 and https://example.com/sso."),
+		Status:      models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(&story).Error)
+	criteria := models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: story.ID, AuthorID: user.ID,
+		Description: "WHEN the user signs in via sign in THEN the SLA applies"}
+	require.NoError(t, session.Create(&criteria).Error)
+	requirement := models.Requirement{ID: uuid.New(), ReferenceID: "REQ-001", UserStoryID: story.ID, CreatorID: user.ID,
+		Title: "Log-in throttling", Status: models.RequirementStatusDraft, Priority: models.PriorityHigh}
+	require.NoError(t, session.Create(&requirement).Error)
+
+	dictionary := []string{"account", "access", "users", "user", "log", "in", "with", "password", "checkout", "the", "order",
+		"is", "paid", "login", "page", "as", "want", "to", "receive", "link", "after", "failed", "see", "and", "when",
+		"signs", "via", "then", "applies", "throttling"}
+	repos := repository.NewRepositories(db, nil)
+
+	t.Run("reports misspellings and terminology variants per epic", func(t *testing.T) {
+		report, err := NewSpellingService(repos, dictionary).Analyze(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 5, report.EntitiesScanned)
+		require.Len(t, report.Epics, 1)
+		findings := report.Epics[0]
+		assert.Equal(t, "EP-001", findings.ReferenceID)
+
+		require.Len(t, findings.Misspellings, 1)
+		assert.Equal(t, "recieve", findings.Misspellings[0].Word)
+		assert.Equal(t, "receive", findings.Misspellings[0].Suggestion)
+		assert.Equal(t, []string{"US-001"}, findings.Misspellings[0].Entities)
+
+		require.Len(t, findings.InconsistentTerms, 2)
+		login := findings.InconsistentTerms[0]
+		assert.Equal(t, "login", login.Preferred)
+		forms := make(map[string]int)
+		for _, variant := range login.Variants {
+			forms[variant.Form] = variant.Occurrences
+		}
+		assert.Equal(t, map[string]int{"login": 2, "log in": 1, "log-in": 1}, forms)
+
+		signIn := findings.InconsistentTerms[1]
+		assert.Equal(t, "sign-in", signIn.Preferred)
+		require.Len(t, signIn.Variants, 1)
+		assert.Equal(t, "sign in", signIn.Variants[0].Form)
+		assert.Equal(t, []string{"AC-001"}, signIn.Variants[0].Entities)
+	})
+
+	t.Run("checks only terminology without a dictionary", func(t *testing.T) {
+		svc := NewSpellingService(repos, nil)
+		report, err := svc.GetReport(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Zero(t, report.DictionaryWords)
+		require.Len(t, report.Epics, 1)
+		assert.Empty(t, report.Epics[0].Misspellings)
+		assert.Len(t, report.Epics[0].InconsistentTerms, 2)
+
+		again, err := svc.GetReport(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Same(t, report, again)
+	})
+
+	t.Run("builds the report table", func(t *testing.T) {
+		catalog := NewReportCatalog(nil, nil, NewSpellingService(repos, dictionary), nil, nil)
+		table, err := catalog.BuildReport(ctx, ReportIDSpelling, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"EP-001", "misspelling", "recieve", "receive", "1", "US-001"}, table.Rows[0])
+		assert.Len(t, table.Rows, 4)
+	})
+}
+
+func TestLoadSpellingDictionary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "en_US.dic")
+	require.NoError(t, os.WriteFile(path, []byte("3\nreceive/DSMG\n# comment\nlog\n\nlogin/M\n"), 0o600))
+
+	words, err := LoadSpellingDictionary(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"receive", "log", "login"}, words)
+
+	_, err = LoadSpellingDictionary(filepath.Join(t.TempDir(), "missing.dic"))
+	assert.Error(t, err)
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("login", "login"))
+	assert.Equal(t, 2, editDistance("recieve", "receive"))
+	assert.Equal(t, 1, editDistance("заказ", "заказы"))
+}
@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+	"product-requirements-management/internal/validation"
+)
+
+var (
+	ErrStalenessPolicyNotFound       = apperrors.New(apperrors.KindNotFound, "STALENESS_POLICY_NOT_FOUND", "staleness policy not found")
+	ErrStalenessPolicyNameRequired   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "staleness policy name is required")
+	ErrStalenessPolicyEntityType     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "entity_type must be one of: epic, user_story, requirement")
+	ErrStalenessPolicyStatus         = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid status for the entity type")
+	ErrStalenessPolicyMaxIdleDays    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "max_idle_days must be greater than zero")
+	ErrStalenessPolicyEscalation     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "escalate_after_days and escalate_to_id must be set together and escalate_after_days must not be negative")
+	ErrStalenessPolicyEscalateToUser = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "escalation user is deactivated")
+)
+
+// StalenessService defines the interface for staleness policies, their evaluation and the stale report
+type StalenessService interface {
+	CreatePolicy(ctx context.Context, req StalenessPolicyRequest, creatorID uuid.UUID) (*models.StalenessPolicy, error)
+	GetPolicy(ctx context.Context, id uuid.UUID) (*models.StalenessPolicy, error)
+	UpdatePolicy(ctx context.Context, id uuid.UUID, req StalenessPolicyRequest) (*models.StalenessPolicy, error)
+	DeletePolicy(ctx context.Context, id uuid.UUID) error
+	ListPolicies(ctx context.Context) ([]models.StalenessPolicy, error)
+	EvaluatePolicies(ctx context.Context, now time.Time) (*StalenessRunSummary, error)
+	GetStaleReport(ctx context.Context, filter repository.StaleReportFilter, now time.Time) ([]StaleReportEntry, error)
+}
+
+// StalenessPolicyRequest represents the request to create or replace a staleness policy
+// @Description Staleness policy; updates replace the whole policy. Escalation is optional and needs both escalate_after_days and escalate_to_id.
+type StalenessPolicyRequest struct {
+	Name              string            `json:"name" binding:"required,max=255" example:"Idle active requirements"`
+	EntityType        models.EntityType `json:"entity_type" binding:"required" example:"requirement"`
+	Status            *string           `json:"status,omitempty" example:"Active"` // Omit to check entities in every status
+	MaxIdleDays       int               `json:"max_idle_days" binding:"required" example:"14"`
+	EscalateAfterDays *int              `json:"escalate_after_days,omitempty" example:"7"` // Days an entity stays stale before it is escalated
+	EscalateToID      *uuid.UUID        `json:"escalate_to_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`
+	Enabled           *bool             `json:"enabled,omitempty" example:"true"` // Defaults to true
+}
+
+// StalenessRunSummary reports the outcome of one evaluation of the staleness policies
+type StalenessRunSummary struct {
+	Policies   int `json:"policies" example:"2"`    // Enabled policies evaluated
+	Stale      int `json:"stale" example:"12"`      // Entities stale after the run, counted once per policy
+	NewlyStale int `json:"newly_stale" example:"3"` // Entities marked stale by this run
+	Resolved   int `json:"resolved" example:"1"`    // Stale marks removed because the entity was updated or no longer matches
+	Notified   int `json:"notified" example:"3"`    // Stale entities whose assignee was emailed
+	Escalated  int `json:"escalated" example:"1"`   // Stale entities escalated
+}
+
+// StaleReportEntry is a stale entity in the stale report
+type StaleReportEntry struct {
+	repository.StaleReportItem
+	IdleDays int `json:"idle_days" example:"15"` // Whole days since the entity was last updated
+}
+
+// staleNotice is a stale entity to email about
+type staleNotice struct {
+	mark      *models.StaleEntity
+	candidate repository.StaleCandidate
+	policy    *models.StalenessPolicy
+}
+
+// stalenessService implements StalenessService interface
+type stalenessService struct {
+	repos  *repository.Repositories
+	mailer Mailer
+	logger *logrus.Logger
+}
+
+// NewStalenessService creates a new staleness service instance
+func NewStalenessService(repos *repository.Repositories, mailer Mailer, logger *logrus.Logger) StalenessService {
+	return &stalenessService{
+		repos:  repos,
+		mailer: mailer,
+		logger: logger,
+	}
+}
+
+// CreatePolicy adds a staleness policy
+func (s *stalenessService) CreatePolicy(ctx context.Context, req StalenessPolicyRequest, creatorID uuid.UUID) (*models.StalenessPolicy, error) {
+	policy := &models.StalenessPolicy{CreatorID: creatorID}
+	if err := s.applyRequest(ctx, policy, req); err != nil {
+		return nil, err
+	}
+	if err := s.repos.StalenessPolicy.Create(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to create staleness policy: %w", err)
+	}
+	return s.GetPolicy(ctx, policy.ID)
+}
+
+// GetPolicy retrieves a staleness policy by ID with its escalation user
+func (s *stalenessService) GetPolicy(ctx context.Context, id uuid.UUID) (*models.StalenessPolicy, error) {
+	policy, err := s.repos.StalenessPolicy.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrStalenessPolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get staleness policy: %w", err)
+	}
+	if policy.EscalateToID != nil {
+		if policy.EscalateTo, err = s.repos.User.GetByID(ctx, *policy.EscalateToID); err != nil {
+			return nil, fmt.Errorf("failed to get staleness policy escalation user: %w", err)
+		}
+	}
+	return policy, nil
+}
+
+// UpdatePolicy replaces the conditions and escalation of a staleness policy.
+// Stale marks that no longer match are removed on the next evaluation.
+func (s *stalenessService) UpdatePolicy(ctx context.Context, id uuid.UUID, req StalenessPolicyRequest) (*models.StalenessPolicy, error) {
+	policy, err := s.GetPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyRequest(ctx, policy, req); err != nil {
+		return nil, err
+	}
+	policy.EscalateTo = nil
+	if err := s.repos.StalenessPolicy.Update(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to update staleness policy: %w", err)
+	}
+	return s.GetPolicy(ctx, policy.ID)
+}
+
+// DeletePolicy removes a staleness policy together with its stale marks
+func (s *stalenessService) DeletePolicy(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetPolicy(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repos.StaleEntity.DeleteByPolicy(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete stale marks: %w", err)
+	}
+	if err := s.repos.StalenessPolicy.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete staleness policy: %w", err)
+	}
+	return nil
+}
+
+// ListPolicies returns every staleness policy ordered by name
+func (s *stalenessService) ListPolicies(ctx context.Context) ([]models.StalenessPolicy, error) {
+	policies, err := s.repos.StalenessPolicy.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staleness policies: %w", err)
+	}
+	return policies, nil
+}
+
+// applyRequest validates a policy request and copies it to the policy
+func (s *stalenessService) applyRequest(ctx context.Context, policy *models.StalenessPolicy, req StalenessPolicyRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return ErrStalenessPolicyNameRequired
+	}
+	valid := stalenessStatuses(req.EntityType)
+	if valid == nil {
+		return ErrStalenessPolicyEntityType
+	}
+	if req.MaxIdleDays <= 0 {
+		return ErrStalenessPolicyMaxIdleDays
+	}
+
+	var status *string
+	if req.Status != nil && strings.TrimSpace(*req.Status) != "" {
+		canonical, ok := canonicalStatus(*req.Status, valid)
+		if !ok {
+			return fmt.Errorf("%w: %s must be one of: %s", ErrStalenessPolicyStatus, req.EntityType, strings.Join(valid, ", "))
+		}
+		status = &canonical
+	}
+
+	if (req.EscalateAfterDays == nil) != (req.EscalateToID == nil) || (req.EscalateAfterDays != nil && *req.EscalateAfterDays < 0) {
+		return ErrStalenessPolicyEscalation
+	}
+	if req.EscalateToID != nil {
+		user, err := s.repos.User.GetByID(ctx, *req.EscalateToID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("failed to get escalation user: %w", err)
+		}
+		if !user.IsActive() {
+			return ErrStalenessPolicyEscalateToUser
+		}
+	}
+
+	policy.Name = name
+	policy.EntityType = req.EntityType
+	policy.Status = status
+	policy.MaxIdleDays = req.MaxIdleDays
+	policy.EscalateAfterDays = req.EscalateAfterDays
+	policy.EscalateToID = req.EscalateToID
+	policy.Enabled = req.Enabled == nil || *req.Enabled
+	return nil
+}
+
+// EvaluatePolicies marks the entities matching the enabled policies as stale, removes the marks of entities
+// that were updated since, emails assignees about new stale entities and escalates entities that stayed stale.
+// A failed email is logged and retried on the next run.
+func (s *stalenessService) EvaluatePolicies(ctx context.Context, now time.Time) (*StalenessRunSummary, error) {
+	policies, err := s.repos.StalenessPolicy.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staleness policies: %w", err)
+	}
+
+	summary := &StalenessRunSummary{}
+	notices := make(map[uuid.UUID][]staleNotice)
+	escalations := make(map[uuid.UUID][]staleNotice)
+	for i := range policies {
+		policy := &policies[i]
+		if !policy.Enabled {
+			// Disabled policies keep nothing stale
+			if err := s.repos.StaleEntity.DeleteByPolicy(ctx, policy.ID); err != nil {
+				return nil, fmt.Errorf("failed to clear stale marks of policy %s: %w", policy.Name, err)
+			}
+			continue
+		}
+
+		summary.Policies++
+		if err := s.evaluatePolicy(ctx, policy, now, summary, notices, escalations); err != nil {
+			return nil, fmt.Errorf("failed to evaluate staleness policy %s: %w", policy.Name, err)
+		}
+	}
+
+	for recipientID, recipientNotices := range notices {
+		summary.Notified += s.sendNotices(ctx, recipientID, recipientNotices, now, false)
+	}
+	for recipientID, recipientNotices := range escalations {
+		summary.Escalated += s.sendNotices(ctx, recipientID, recipientNotices, now, true)
+	}
+	return summary, nil
+}
+
+// evaluatePolicy updates the stale marks of one policy and collects the notifications and escalations to send
+func (s *stalenessService) evaluatePolicy(ctx context.Context, policy *models.StalenessPolicy, now time.Time, summary *StalenessRunSummary,
+	notices, escalations map[uuid.UUID][]staleNotice) error {
+	candidates, err := s.repos.StaleEntity.ListCandidates(ctx, policy.EntityType, policy.Status, now.AddDate(0, 0, -policy.MaxIdleDays))
+	if err != nil {
+		return err
+	}
+	marks, err := s.repos.StaleEntity.ListByPolicy(ctx, policy.ID)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[uuid.UUID]*models.StaleEntity, len(marks))
+	for i := range marks {
+		existing[marks[i].EntityID] = &marks[i]
+	}
+
+	for _, candidate := range candidates {
+		mark, ok := existing[candidate.ID]
+		if ok {
+			delete(existing, candidate.ID)
+		} else {
+			mark = &models.StaleEntity{PolicyID: policy.ID, EntityType: policy.EntityType, EntityID: candidate.ID, DetectedAt: now}
+			if err := s.repos.StaleEntity.Create(ctx, mark); err != nil {
+				return err
+			}
+			summary.NewlyStale++
+		}
+		summary.Stale++
+
+		notice := staleNotice{mark: mark, candidate: candidate, policy: policy}
+		if mark.NotifiedAt == nil {
+			notices[candidate.AssigneeID] = append(notices[candidate.AssigneeID], notice)
+		}
+		if policy.EscalateToID != nil && policy.EscalateAfterDays != nil && mark.EscalatedAt == nil &&
+			!now.Before(mark.DetectedAt.AddDate(0, 0, *policy.EscalateAfterDays)) {
+			escalations[*policy.EscalateToID] = append(escalations[*policy.EscalateToID], notice)
+		}
+	}
+
+	// Entities left over were updated, changed status or were deleted since they were marked
+	for _, mark := range existing {
+		if err := s.repos.StaleEntity.Delete(ctx, mark.ID); err != nil {
+			return err
+		}
+		summary.Resolved++
+	}
+	return nil
+}
+
+// sendNotices emails one recipient about stale entities, records the delivery on the marks and returns
+// the number of entities covered. Deactivated recipients are skipped.
+func (s *stalenessService) sendNotices(ctx context.Context, recipientID uuid.UUID, notices []staleNotice, now time.Time, escalation bool) int {
+	recipient, err := s.repos.User.GetByID(ctx, recipientID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			s.logger.WithFields(logrus.Fields{"user_id": recipientID, "error": err.Error()}).Error("Failed to get stale entity notification recipient")
+		}
+		return 0
+	}
+	if !recipient.IsActive() {
+		return 0
+	}
+
+	sort.Slice(notices, func(i, j int) bool {
+		return notices[i].candidate.UpdatedAt.Before(notices[j].candidate.UpdatedAt)
+	})
+
+	subject := fmt.Sprintf("%d stale items assigned to you", len(notices))
+	if escalation {
+		subject = fmt.Sprintf("%d stale items escalated to you", len(notices))
+	}
+	if err := s.mailer.Send(recipient.Email, subject, s.renderNotices(ctx, recipient, notices, now, escalation)); err != nil {
+		s.logger.WithFields(logrus.Fields{"user_id": recipientID, "error": err.Error()}).Error("Failed to send stale entity notification")
+		return 0
+	}
+
+	for _, notice := range notices {
+		if escalation {
+			notice.mark.EscalatedAt = &now
+		} else {
+			notice.mark.NotifiedAt = &now
+		}
+		if err := s.repos.StaleEntity.Update(ctx, notice.mark); err != nil {
+			s.logger.WithFields(logrus.Fields{"entity_id": notice.mark.EntityID, "error": err.Error()}).Error("Failed to record stale entity notification")
+		}
+	}
+	return len(notices)
+}
+
+// renderNotices builds the plain text body of a stale entity notification or escalation
+func (s *stalenessService) renderNotices(ctx context.Context, recipient *models.User, notices []staleNotice, now time.Time, escalation bool) string {
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hello %s,\n\n", recipient.Username)
+	if escalation {
+		body.WriteString("The following items are still stale after the escalation period of their staleness policy:\n")
+	} else {
+		body.WriteString("The following items assigned to you have had no update for a while:\n")
+	}
+
+	assignees := make(map[uuid.UUID]string)
+	for _, notice := range notices {
+		candidate := notice.candidate
+		fmt.Fprintf(&body, "\n%s %s\n", candidate.ReferenceID, candidate.Title)
+		details := fmt.Sprintf("%s, no update for %d days (policy: %s)", candidate.Status, idleDays(candidate.UpdatedAt, now), notice.policy.Name)
+		if escalation {
+			details = fmt.Sprintf("%s, assigned to %s", details, s.assigneeName(ctx, assignees, candidate.AssigneeID))
+		}
+		body.WriteString("  " + details + "\n")
+	}
+
+	if !escalation {
+		body.WriteString("\nPlease update them or move them to another status.\n")
+	}
+	return body.String()
+}
+
+// assigneeName returns the username of an assignee, caching lookups for one email
+func (s *stalenessService) assigneeName(ctx context.Context, cache map[uuid.UUID]string, userID uuid.UUID) string {
+	if name, ok := cache[userID]; ok {
+		return name
+	}
+	name := "unknown user"
+	if user, err := s.repos.User.GetByID(ctx, userID); err == nil {
+		name = user.Username
+	}
+	cache[userID] = name
+	return name
+}
+
+// GetStaleReport returns the stale entities, least recently updated first
+func (s *stalenessService) GetStaleReport(ctx context.Context, filter repository.StaleReportFilter, now time.Time) ([]StaleReportEntry, error) {
+	if filter.EntityType != nil && stalenessStatuses(*filter.EntityType) == nil {
+		return nil, ErrStalenessPolicyEntityType
+	}
+
+	items, err := s.repos.StaleEntity.ListReport(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stale entities: %w", err)
+	}
+
+	entries := make([]StaleReportEntry, len(items))
+	for i, item := range items {
+		entries[i] = StaleReportEntry{StaleReportItem: item, IdleDays: idleDays(item.LastUpdatedAt, now)}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].LastUpdatedAt.Before(entries[j].LastUpdatedAt)
+	})
+	return entries, nil
+}
+
+// RunStalenessScheduler evaluates the staleness policies every interval until the context is cancelled
+func RunStalenessScheduler(ctx context.Context, stalenessService StalenessService, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			summary, err := stalenessService.EvaluatePolicies(ctx, now)
+			if err != nil {
+				logger.WithError(err).Error("Staleness policy run failed")
+				continue
+			}
+			if summary.NewlyStale > 0 || summary.Resolved > 0 || summary.Escalated > 0 {
+				logger.WithFields(logrus.Fields{
+					"stale":       summary.Stale,
+					"newly_stale": summary.NewlyStale,
+					"resolved":    summary.Resolved,
+					"notified":    summary.Notified,
+					"escalated":   summary.Escalated,
+				}).Info("Staleness policies evaluated")
+			}
+		}
+	}
+}
+
+// stalenessStatuses returns the statuses of an entity type staleness policies apply to, or nil for other types
+func stalenessStatuses(entityType models.EntityType) []string {
+	switch entityType {
+	case models.EntityTypeEpic:
+		return validation.GetValidEpicStatuses()
+	case models.EntityTypeUserStory:
+		return validation.GetValidUserStoryStatuses()
+	case models.EntityTypeRequirement:
+		return validation.GetValidRequirementStatuses()
+	}
+	return nil
+}
+
+// idleDays returns the whole days between the last update and now
+func idleDays(updatedAt, now time.Time) int {
+	return int(now.Sub(updatedAt).Hours() / 24)
+}
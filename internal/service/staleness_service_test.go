@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestStalenessService(t *testing.T) {
+	ctx := context.Background()
+	active, draft := models.RequirementStatusActive, models.RequirementStatusDraft
+	db, alice, _, requirements := setupSupersessionTest(t, active, active, draft)
+	require.NoError(t, db.AutoMigrate(&models.StalenessPolicy{}, &models.StaleEntity{}))
+	lead := &models.User{Username: "lead", Email: "lead@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(lead).Error)
+
+	now := time.Now()
+	setUpdatedAt := func(requirement models.Requirement, updatedAt time.Time) {
+		require.NoError(t, db.Model(&models.Requirement{}).Where("id = ?", requirement.ID).UpdateColumn("updated_at", updatedAt).Error)
+	}
+	setUpdatedAt(requirements[0], now.AddDate(0, 0, -20))
+	setUpdatedAt(requirements[1], now.AddDate(0, 0, -3))
+	setUpdatedAt(requirements[2], now.AddDate(0, 0, -30))
+
+	mailer := &recordingMailer{}
+	repos := repository.NewRepositories(db, nil)
+	svc := NewStalenessService(repos, mailer, logrus.New())
+
+	status, escalateAfter := "active", 7
+	policy, err := svc.CreatePolicy(ctx, StalenessPolicyRequest{
+		Name: "Idle active requirements", EntityType: models.EntityTypeRequirement, Status: &status, MaxIdleDays: 14,
+		EscalateAfterDays: &escalateAfter, EscalateToID: &lead.ID,
+	}, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Active", *policy.Status)
+	assert.True(t, policy.Enabled)
+	assert.Equal(t, "lead", policy.EscalateTo.Username)
+
+	t.Run("marks stale entities and notifies their assignees once", func(t *testing.T) {
+		summary, err := svc.EvaluatePolicies(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, StalenessRunSummary{Policies: 1, Stale: 1, NewlyStale: 1, Notified: 1}, *summary)
+		require.Len(t, mailer.to, 1)
+		assert.Equal(t, "alice@example.com", mailer.to[0])
+		assert.Equal(t, "1 stale items assigned to you", mailer.subject[0])
+		assert.Contains(t, mailer.body[0], "REQ-001 Password rule A")
+		assert.Contains(t, mailer.body[0], "Active, no update for 20 days (policy: Idle active requirements)")
+
+		summary, err = svc.EvaluatePolicies(ctx, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, StalenessRunSummary{Policies: 1, Stale: 1}, *summary)
+		assert.Len(t, mailer.to, 1)
+	})
+
+	t.Run("escalates entities that stay stale", func(t *testing.T) {
+		summary, err := svc.EvaluatePolicies(ctx, now.AddDate(0, 0, 8))
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Escalated)
+		require.Len(t, mailer.to, 2)
+		assert.Equal(t, "lead@example.com", mailer.to[1])
+		assert.Contains(t, mailer.body[1], "assigned to alice")
+
+		summary, err = svc.EvaluatePolicies(ctx, now.AddDate(0, 0, 9))
+		require.NoError(t, err)
+		assert.Zero(t, summary.Escalated)
+	})
+
+	t.Run("reports stale entities", func(t *testing.T) {
+		entries, err := svc.GetStaleReport(ctx, repository.StaleReportFilter{}, now)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "REQ-001", entries[0].ReferenceID)
+		assert.Equal(t, "alice", entries[0].AssigneeUsername)
+		assert.Equal(t, "Idle active requirements", entries[0].PolicyName)
+		assert.Equal(t, 20, entries[0].IdleDays)
+		assert.NotNil(t, entries[0].NotifiedAt)
+		assert.NotNil(t, entries[0].EscalatedAt)
+
+		epicType := models.EntityTypeEpic
+		entries, err = svc.GetStaleReport(ctx, repository.StaleReportFilter{EntityType: &epicType}, now)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("removes the mark once the entity is updated", func(t *testing.T) {
+		setUpdatedAt(requirements[0], now)
+		summary, err := svc.EvaluatePolicies(ctx, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, StalenessRunSummary{Policies: 1, Resolved: 1}, *summary)
+
+		entries, err := svc.GetStaleReport(ctx, repository.StaleReportFilter{}, now)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("disabled policies mark nothing", func(t *testing.T) {
+		disabled := false
+		_, err := svc.CreatePolicy(ctx, StalenessPolicyRequest{
+			Name: "Idle drafts", EntityType: models.EntityTypeRequirement, MaxIdleDays: 7, Enabled: &disabled,
+		}, alice.ID)
+		require.NoError(t, err)
+
+		summary, err := svc.EvaluatePolicies(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Policies)
+		assert.Zero(t, summary.Stale)
+	})
+
+	t.Run("validates policies", func(t *testing.T) {
+		inProgress := "In Progress"
+		_, err := svc.CreatePolicy(ctx, StalenessPolicyRequest{Name: "Busy", EntityType: models.EntityTypeRequirement, Status: &inProgress, MaxIdleDays: 14}, alice.ID)
+		assert.ErrorIs(t, err, ErrStalenessPolicyStatus)
+		_, err = svc.CreatePolicy(ctx, StalenessPolicyRequest{Name: "Never", EntityType: models.EntityTypeEpic, MaxIdleDays: 0}, alice.ID)
+		assert.ErrorIs(t, err, ErrStalenessPolicyMaxIdleDays)
+		_, err = svc.CreatePolicy(ctx, StalenessPolicyRequest{Name: "Criteria", EntityType: models.EntityTypeAcceptanceCriteria, MaxIdleDays: 14}, alice.ID)
+		assert.ErrorIs(t, err, ErrStalenessPolicyEntityType)
+		_, err = svc.CreatePolicy(ctx, StalenessPolicyRequest{Name: "Half", EntityType: models.EntityTypeEpic, MaxIdleDays: 14, EscalateAfterDays: &escalateAfter}, alice.ID)
+		assert.ErrorIs(t, err, ErrStalenessPolicyEscalation)
+		nobody := uuid.New()
+		_, err = svc.CreatePolicy(ctx, StalenessPolicyRequest{Name: "Nobody", EntityType: models.EntityTypeEpic, MaxIdleDays: 14, EscalateAfterDays: &escalateAfter, EscalateToID: &nobody}, alice.ID)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("deletes policies with their marks", func(t *testing.T) {
+		_, err := svc.EvaluatePolicies(ctx, now.AddDate(0, 0, 20))
+		require.NoError(t, err)
+		require.NoError(t, svc.DeletePolicy(ctx, policy.ID))
+		_, err = svc.GetPolicy(ctx, policy.ID)
+		assert.ErrorIs(t, err, ErrStalenessPolicyNotFound)
+
+		var marks int64
+		require.NoError(t, db.Model(&models.StaleEntity{}).Count(&marks).Error)
+		assert.Zero(t, marks)
+	})
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Growth bucket intervals
+const (
+	StatisticsIntervalDay   = "day"
+	StatisticsIntervalWeek  = "week"
+	StatisticsIntervalMonth = "month"
+)
+
+// Statistics query defaults and limits
+const (
+	DefaultStatisticsPeriods = 12
+	MaxStatisticsPeriods     = 100
+	DefaultStatisticsDays    = 30
+	MaxStatisticsDays        = 365
+	DefaultStatisticsTop     = 10
+	MaxStatisticsTop         = 50
+)
+
+// statisticsCacheTTL is how long computed statistics are served from memory
+const statisticsCacheTTL = 5 * time.Minute
+
+var (
+	ErrInvalidStatisticsInterval = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "interval must be one of: day, week, month")
+)
+
+// StatisticsService defines the interface for usage statistics of the workspace
+type StatisticsService interface {
+	GetStatistics(ctx context.Context, query StatisticsQuery) (*Statistics, error)
+}
+
+// StatisticsQuery selects the time ranges and list sizes of the statistics
+type StatisticsQuery struct {
+	Interval string // Growth bucket size: day, week or month
+	Periods  int    // Number of growth buckets, ending with the current one
+	Days     int    // Days covered by comments per day and top contributors
+	Top      int    // Number of top contributors and largest epics
+	Refresh  bool   // Bypass the cache
+}
+
+// Statistics is a snapshot of workspace usage for operational dashboards
+// @Description Entity counts, growth over time, comment activity, most active users and largest epics
+type Statistics struct {
+	GeneratedAt     time.Time                        `json:"generated_at" example:"2023-01-01T10:00:00Z"`
+	Cached          bool                             `json:"cached" example:"false"`
+	Totals          map[string]int64                 `json:"totals"`
+	Growth          StatisticsGrowth                 `json:"growth"`
+	CommentsPerDay  []DailyCount                     `json:"comments_per_day"`
+	TopContributors []repository.ContributorActivity `json:"top_contributors"`
+	LargestEpics    []repository.EpicSize            `json:"largest_epics"`
+}
+
+// StatisticsGrowth is the number of entities created per time bucket
+// @Description Entities created per bucket and running totals at the end of each bucket; the last bucket is in progress
+type StatisticsGrowth struct {
+	Interval string         `json:"interval" example:"week"`
+	Buckets  []GrowthBucket `json:"buckets"`
+}
+
+// GrowthBucket is one time bucket of entity growth
+type GrowthBucket struct {
+	Start   time.Time        `json:"start" example:"2023-01-02T00:00:00Z"`
+	End     time.Time        `json:"end" example:"2023-01-09T00:00:00Z"`
+	Created map[string]int64 `json:"created"`
+	Total   map[string]int64 `json:"total"`
+}
+
+// DailyCount is a count for one UTC day
+type DailyCount struct {
+	Date  string `json:"date" example:"2023-01-01"`
+	Count int64  `json:"count" example:"5"`
+}
+
+// statisticsSource is a counted table; Growth marks the entity types included in growth buckets
+type statisticsSource struct {
+	Key    string
+	Model  interface{}
+	Growth bool
+}
+
+// statisticsSources are the tables counted in totals, in response order
+var statisticsSources = []statisticsSource{
+	{"epics", &models.Epic{}, true},
+	{"user_stories", &models.UserStory{}, true},
+	{"acceptance_criteria", &models.AcceptanceCriteria{}, true},
+	{"requirements", &models.Requirement{}, true},
+	{"comments", &models.Comment{}, true},
+	{"steering_documents", &models.SteeringDocument{}, false},
+	{"users", &models.User{}, false},
+}
+
+// statisticsService implements StatisticsService interface
+type statisticsService struct {
+	statisticsRepo repository.StatisticsRepository
+	now            func() time.Time
+
+	mu    sync.Mutex
+	cache map[StatisticsQuery]*Statistics
+}
+
+// NewStatisticsService creates a new statistics service instance
+func NewStatisticsService(repos *repository.Repositories) StatisticsService {
+	return &statisticsService{
+		statisticsRepo: repos.Statistics,
+		now:            time.Now,
+		cache:          make(map[StatisticsQuery]*Statistics),
+	}
+}
+
+// GetStatistics computes the statistics, serving results younger than five minutes from memory unless a refresh is requested
+func (s *statisticsService) GetStatistics(ctx context.Context, query StatisticsQuery) (*Statistics, error) {
+	query, err := normalizeStatisticsQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	key := query
+	key.Refresh = false
+
+	if !query.Refresh {
+		s.mu.Lock()
+		cached, ok := s.cache[key]
+		s.mu.Unlock()
+		if ok && now.Sub(cached.GeneratedAt) < statisticsCacheTTL {
+			result := *cached
+			result.Cached = true
+			return &result, nil
+		}
+	}
+
+	stats, err := s.compute(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	for cachedKey, cached := range s.cache {
+		if now.Sub(cached.GeneratedAt) >= statisticsCacheTTL {
+			delete(s.cache, cachedKey)
+		}
+	}
+	s.cache[key] = stats
+	s.mu.Unlock()
+	return stats, nil
+}
+
+// compute runs the aggregate queries for a normalized query
+func (s *statisticsService) compute(ctx context.Context, query StatisticsQuery, now time.Time) (*Statistics, error) {
+	stats := &Statistics{
+		GeneratedAt: now,
+		Totals:      make(map[string]int64, len(statisticsSources)),
+		Growth:      StatisticsGrowth{Interval: query.Interval},
+	}
+
+	for _, source := range statisticsSources {
+		count, err := s.statisticsRepo.CountAll(ctx, source.Model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", source.Key, err)
+		}
+		stats.Totals[source.Key] = count
+	}
+
+	boundaries := growthBoundaries(query.Interval, query.Periods, now)
+	stats.Growth.Buckets = make([]GrowthBucket, query.Periods)
+	for i := range stats.Growth.Buckets {
+		stats.Growth.Buckets[i] = GrowthBucket{
+			Start:   boundaries[i],
+			End:     boundaries[i+1],
+			Created: make(map[string]int64),
+			Total:   make(map[string]int64),
+		}
+	}
+	for _, source := range statisticsSources {
+		if !source.Growth {
+			continue
+		}
+		total, err := s.statisticsRepo.CountCreatedBefore(ctx, source.Model, boundaries[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s growth: %w", source.Key, err)
+		}
+		created, err := s.statisticsRepo.CountCreatedInBuckets(ctx, source.Model, boundaries)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s growth: %w", source.Key, err)
+		}
+		for i, count := range created {
+			total += count
+			stats.Growth.Buckets[i].Created[source.Key] = count
+			stats.Growth.Buckets[i].Total[source.Key] = total
+		}
+	}
+
+	today := truncateToDay(now)
+	days := make([]time.Time, query.Days+1)
+	for i := range days {
+		days[i] = today.AddDate(0, 0, i-query.Days+1)
+	}
+	comments, err := s.statisticsRepo.CountCreatedInBuckets(ctx, &models.Comment{}, days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments per day: %w", err)
+	}
+	stats.CommentsPerDay = make([]DailyCount, len(comments))
+	for i, count := range comments {
+		stats.CommentsPerDay[i] = DailyCount{Date: days[i].Format("2006-01-02"), Count: count}
+	}
+
+	stats.TopContributors, err = s.statisticsRepo.TopContributors(ctx, days[0], query.Top)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list top contributors: %w", err)
+	}
+	stats.LargestEpics, err = s.statisticsRepo.LargestEpics(ctx, query.Top)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list largest epics: %w", err)
+	}
+	return stats, nil
+}
+
+// normalizeStatisticsQuery validates the interval and applies defaults and limits
+func normalizeStatisticsQuery(query StatisticsQuery) (StatisticsQuery, error) {
+	switch query.Interval {
+	case "":
+		query.Interval = StatisticsIntervalWeek
+	case StatisticsIntervalDay, StatisticsIntervalWeek, StatisticsIntervalMonth:
+	default:
+		return query, ErrInvalidStatisticsInterval
+	}
+	query.Periods = clampStatisticsParam(query.Periods, DefaultStatisticsPeriods, MaxStatisticsPeriods)
+	query.Days = clampStatisticsParam(query.Days, DefaultStatisticsDays, MaxStatisticsDays)
+	query.Top = clampStatisticsParam(query.Top, DefaultStatisticsTop, MaxStatisticsTop)
+	return query, nil
+}
+
+// clampStatisticsParam replaces non-positive values with the default and caps values at the maximum
+func clampStatisticsParam(value, defaultValue, maxValue int) int {
+	if value <= 0 {
+		return defaultValue
+	}
+	if value > maxValue {
+		return maxValue
+	}
+	return value
+}
+
+// growthBoundaries returns periods+1 ascending UTC bucket boundaries; the last bucket contains now
+func growthBoundaries(interval string, periods int, now time.Time) []time.Time {
+	var current time.Time
+	var step func(t time.Time, n int) time.Time
+	switch interval {
+	case StatisticsIntervalDay:
+		current = truncateToDay(now)
+		step = func(t time.Time, n int) time.Time { return t.AddDate(0, 0, n) }
+	case StatisticsIntervalMonth:
+		current = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		step = func(t time.Time, n int) time.Time { return t.AddDate(0, n, 0) }
+	default:
+		// Weeks start on Monday
+		day := truncateToDay(now)
+		current = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		step = func(t time.Time, n int) time.Time { return t.AddDate(0, 0, 7*n) }
+	}
+
+	boundaries := make([]time.Time, periods+1)
+	for i := range boundaries {
+		boundaries[i] = step(current, i-periods+1)
+	}
+	return boundaries
+}
+
+// truncateToDay returns midnight UTC of the day containing t
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
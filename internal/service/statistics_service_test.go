@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestStatisticsService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.Comment{}, &models.SteeringDocument{}, &models.AuditEvent{}))
+
+	// Wednesday, so the current week started two days ago
+	now := time.Date(2024, time.March, 13, 15, 0, 0, 0, time.UTC)
+
+	alice := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	bob := &models.User{Username: "bob", Email: "bob@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(alice).Error)
+	require.NoError(t, db.Create(bob).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epics := []models.Epic{
+		{ID: uuid.New(), ReferenceID: "EP-001", Title: "Checkout", CreatedAt: now.AddDate(0, 0, -30)},
+		{ID: uuid.New(), ReferenceID: "EP-002", Title: "Reporting", CreatedAt: now.AddDate(0, 0, -8)},
+		{ID: uuid.New(), ReferenceID: "EP-003", Title: "Search", CreatedAt: now.Add(-time.Hour)},
+	}
+	for i := range epics {
+		epics[i].Status, epics[i].CreatorID, epics[i].AssigneeID = models.EpicStatusBacklog, alice.ID, alice.ID
+		require.NoError(t, session.Create(&epics[i]).Error)
+	}
+	stories := []models.UserStory{
+		{ID: uuid.New(), ReferenceID: "US-001", EpicID: epics[1].ID, CreatedAt: now.AddDate(0, 0, -1)},
+		{ID: uuid.New(), ReferenceID: "US-002", EpicID: epics[1].ID, CreatedAt: now.AddDate(0, 0, -1)},
+		{ID: uuid.New(), ReferenceID: "US-003", EpicID: epics[0].ID, CreatedAt: now.AddDate(0, 0, -1)},
+	}
+	for i := range stories {
+		stories[i].Title, stories[i].Status, stories[i].CreatorID, stories[i].AssigneeID = "Story", models.UserStoryStatusBacklog, alice.ID, alice.ID
+		require.NoError(t, session.Create(&stories[i]).Error)
+	}
+	requirements := []models.Requirement{
+		{ID: uuid.New(), ReferenceID: "REQ-001", UserStoryID: stories[2].ID},
+		{ID: uuid.New(), ReferenceID: "REQ-002", UserStoryID: stories[2].ID},
+		{ID: uuid.New(), ReferenceID: "REQ-003", UserStoryID: stories[2].ID},
+	}
+	for i := range requirements {
+		requirements[i].Title, requirements[i].CreatedAt = "Requirement", now.AddDate(0, 0, -1)
+		require.NoError(t, session.Create(&requirements[i]).Error)
+	}
+	for _, createdAt := range []time.Time{now.AddDate(0, 0, -2), now.Add(-2 * time.Hour), now.Add(-time.Hour), now.AddDate(0, 0, -60)} {
+		comment := models.Comment{ID: uuid.New(), EntityType: models.EntityTypeEpic, EntityID: epics[0].ID, AuthorID: bob.ID, Content: "Note", CreatedAt: createdAt}
+		require.NoError(t, session.Create(&comment).Error)
+	}
+	for i, actor := range []uuid.UUID{alice.ID, bob.ID, bob.ID, bob.ID} {
+		action := models.AuditActionEdited
+		if i == 3 {
+			action = models.AuditActionCommented
+		}
+		event := models.AuditEvent{EntityType: models.EntityTypeEpic, EntityID: epics[0].ID, Action: action, ActorID: &actor, CreatedAt: now.AddDate(0, 0, -1)}
+		require.NoError(t, db.Create(&event).Error)
+	}
+	old := models.AuditEvent{EntityType: models.EntityTypeEpic, EntityID: epics[0].ID, Action: models.AuditActionEdited, ActorID: &alice.ID, CreatedAt: now.AddDate(0, 0, -90)}
+	require.NoError(t, db.Create(&old).Error)
+
+	svc := NewStatisticsService(repository.NewRepositories(db, nil)).(*statisticsService)
+	svc.now = func() time.Time { return now }
+
+	stats, err := svc.GetStatistics(ctx, StatisticsQuery{Periods: 3, Days: 7, Top: 2})
+	require.NoError(t, err)
+	assert.False(t, stats.Cached)
+
+	assert.Equal(t, int64(3), stats.Totals["epics"])
+	assert.Equal(t, int64(3), stats.Totals["user_stories"])
+	assert.Equal(t, int64(4), stats.Totals["comments"])
+	assert.Equal(t, int64(2), stats.Totals["users"])
+
+	t.Run("growth", func(t *testing.T) {
+		assert.Equal(t, StatisticsIntervalWeek, stats.Growth.Interval)
+		require.Len(t, stats.Growth.Buckets, 3)
+		current := stats.Growth.Buckets[2]
+		assert.Equal(t, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), current.Start)
+		assert.Equal(t, time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC), current.End)
+		assert.Equal(t, int64(1), current.Created["epics"])
+		assert.Equal(t, int64(3), current.Created["user_stories"])
+		assert.Equal(t, int64(3), current.Total["epics"])
+
+		previous := stats.Growth.Buckets[1]
+		assert.Equal(t, int64(1), previous.Created["epics"])
+		assert.Equal(t, int64(2), previous.Total["epics"])
+		assert.Equal(t, int64(1), stats.Growth.Buckets[0].Total["epics"], "totals include entities created before the first bucket")
+	})
+
+	t.Run("comments per day", func(t *testing.T) {
+		require.Len(t, stats.CommentsPerDay, 7)
+		assert.Equal(t, DailyCount{Date: "2024-03-13", Count: 2}, stats.CommentsPerDay[6])
+		assert.Equal(t, DailyCount{Date: "2024-03-11", Count: 1}, stats.CommentsPerDay[4])
+		assert.Equal(t, "2024-03-07", stats.CommentsPerDay[0].Date)
+	})
+
+	t.Run("top contributors and largest epics", func(t *testing.T) {
+		require.Len(t, stats.TopContributors, 2)
+		assert.Equal(t, "bob", stats.TopContributors[0].Username)
+		assert.Equal(t, int64(3), stats.TopContributors[0].Changes)
+		assert.Equal(t, int64(1), stats.TopContributors[0].Comments)
+		assert.Equal(t, int64(1), stats.TopContributors[1].Changes, "changes before the window are not counted")
+
+		require.Len(t, stats.LargestEpics, 2)
+		assert.Equal(t, "EP-002", stats.LargestEpics[0].ReferenceID)
+		assert.Equal(t, int64(2), stats.LargestEpics[0].UserStories)
+		assert.Equal(t, "EP-001", stats.LargestEpics[1].ReferenceID)
+		assert.Equal(t, int64(3), stats.LargestEpics[1].Requirements)
+	})
+
+	t.Run("cache", func(t *testing.T) {
+		require.NoError(t, session.Create(&models.Epic{ID: uuid.New(), ReferenceID: "EP-004", Title: "New", Status: models.EpicStatusBacklog,
+			CreatorID: alice.ID, AssigneeID: alice.ID, CreatedAt: now}).Error)
+
+		cached, err := svc.GetStatistics(ctx, StatisticsQuery{Periods: 3, Days: 7, Top: 2})
+		require.NoError(t, err)
+		assert.True(t, cached.Cached)
+		assert.Equal(t, int64(3), cached.Totals["epics"])
+
+		refreshed, err := svc.GetStatistics(ctx, StatisticsQuery{Periods: 3, Days: 7, Top: 2, Refresh: true})
+		require.NoError(t, err)
+		assert.False(t, refreshed.Cached)
+		assert.Equal(t, int64(4), refreshed.Totals["epics"])
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		_, err := svc.GetStatistics(ctx, StatisticsQuery{Interval: "year"})
+		assert.ErrorIs(t, err, ErrInvalidStatisticsInterval)
+	})
+}
+
+func TestGrowthBoundaries(t *testing.T) {
+	now := time.Date(2024, time.March, 13, 15, 0, 0, 0, time.UTC)
+
+	months := growthBoundaries(StatisticsIntervalMonth, 2, now)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+	}, months)
+
+	days := growthBoundaries(StatisticsIntervalDay, 1, now)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, time.March, 13, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC),
+	}, days)
+
+	sunday := time.Date(2024, time.March, 17, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), growthBoundaries(StatisticsIntervalWeek, 1, sunday)[0])
+}
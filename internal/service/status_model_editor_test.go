@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// setupStatusModelEditorTest creates an empty status model and a config service backed by sqlite
+func setupStatusModelEditorTest(t *testing.T) (ConfigService, *models.StatusModel) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.StatusModel{}, &models.Status{}, &models.StatusTransition{}))
+
+	model := &models.StatusModel{EntityType: models.EntityTypeRequirement, Name: "Review workflow"}
+	require.NoError(t, db.Create(model).Error)
+
+	repos := repository.NewRepositories(db, nil)
+	svc := NewConfigService(repos.RequirementType, repos.RelationshipType, repos.Requirement,
+		repos.RequirementRelationship, repos.StatusModel, repos.Status, repos.StatusTransition)
+	return svc, model
+}
+
+func TestStatusModelEditor_InitialStatus(t *testing.T) {
+	ctx := context.Background()
+	svc, model := setupStatusModelEditorTest(t)
+	initial, notInitial := true, false
+
+	_, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: "Review"})
+	assert.ErrorIs(t, err, ErrNoInitialStatus)
+
+	draft, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: "Draft", IsInitial: true, Order: 1})
+	require.NoError(t, err)
+	review, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: "Review", Order: 2})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateStatus(ctx, draft.ID, UpdateStatusRequest{IsInitial: &notInitial})
+	assert.ErrorIs(t, err, ErrNoInitialStatus)
+	assert.ErrorIs(t, svc.DeleteStatus(ctx, draft.ID, false), ErrNoInitialStatus)
+
+	// Once another status is initial, the first one can be unset
+	_, err = svc.UpdateStatus(ctx, review.ID, UpdateStatusRequest{IsInitial: &initial})
+	require.NoError(t, err)
+	updated, err := svc.UpdateStatus(ctx, draft.ID, UpdateStatusRequest{IsInitial: &notInitial})
+	require.NoError(t, err)
+	assert.False(t, updated.IsInitial)
+
+	// The last remaining status may be deleted even when it is initial
+	require.NoError(t, svc.DeleteStatus(ctx, draft.ID, false))
+	require.NoError(t, svc.DeleteStatus(ctx, review.ID, false))
+}
+
+func TestStatusModelEditor_Color(t *testing.T) {
+	ctx := context.Background()
+	svc, model := setupStatusModelEditorTest(t)
+
+	_, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: "Draft", IsInitial: true, Color: stringPtr("green")})
+	assert.ErrorIs(t, err, ErrInvalidStatusColor)
+
+	status, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: "Draft", IsInitial: true, Color: stringPtr("#28a745")})
+	require.NoError(t, err)
+	assert.Equal(t, "#28a745", *status.Color)
+
+	_, err = svc.UpdateStatus(ctx, status.ID, UpdateStatusRequest{Color: stringPtr("#28a7")})
+	assert.ErrorIs(t, err, ErrInvalidStatusColor)
+}
+
+func TestStatusModelEditor_ReorderStatuses(t *testing.T) {
+	ctx := context.Background()
+	svc, model := setupStatusModelEditorTest(t)
+
+	var ids []uuid.UUID
+	for i, name := range []string{"Draft", "Review", "Approved"} {
+		status, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: name, IsInitial: i == 0, Order: i + 1})
+		require.NoError(t, err)
+		ids = append(ids, status.ID)
+	}
+
+	statuses, err := svc.ReorderStatuses(ctx, model.ID, []uuid.UUID{ids[2], ids[0], ids[1]})
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	assert.Equal(t, "Approved", statuses[0].Name)
+	assert.Equal(t, 1, statuses[0].Order)
+	assert.Equal(t, "Review", statuses[2].Name)
+	assert.Equal(t, 3, statuses[2].Order)
+
+	_, err = svc.ReorderStatuses(ctx, model.ID, []uuid.UUID{ids[0], ids[1]})
+	assert.ErrorIs(t, err, ErrInvalidStatusOrder)
+	_, err = svc.ReorderStatuses(ctx, model.ID, []uuid.UUID{ids[0], ids[0], ids[1]})
+	assert.ErrorIs(t, err, ErrInvalidStatusOrder)
+	_, err = svc.ReorderStatuses(ctx, uuid.New(), ids)
+	assert.ErrorIs(t, err, ErrStatusModelNotFound)
+}
+
+func TestStatusModelEditor_Transitions(t *testing.T) {
+	ctx := context.Background()
+	svc, model := setupStatusModelEditorTest(t)
+
+	draft, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: "Draft", IsInitial: true})
+	require.NoError(t, err)
+	review, err := svc.CreateStatus(ctx, CreateStatusRequest{StatusModelID: model.ID, Name: "Review"})
+	require.NoError(t, err)
+
+	_, err = svc.CreateStatusTransition(ctx, CreateStatusTransitionRequest{StatusModelID: model.ID, FromStatusID: draft.ID, ToStatusID: draft.ID})
+	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	_, err = svc.CreateStatusTransition(ctx, CreateStatusTransitionRequest{StatusModelID: model.ID, FromStatusID: draft.ID, ToStatusID: review.ID})
+	require.NoError(t, err)
+	_, err = svc.CreateStatusTransition(ctx, CreateStatusTransitionRequest{StatusModelID: model.ID, FromStatusID: draft.ID, ToStatusID: review.ID})
+	assert.ErrorIs(t, err, ErrTransitionExists)
+}
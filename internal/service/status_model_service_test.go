@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -16,57 +17,57 @@ type MockStatusModelRepository struct {
 	mock.Mock
 }
 
-func (m *MockStatusModelRepository) Create(statusModel *models.StatusModel) error {
+func (m *MockStatusModelRepository) Create(ctx context.Context, statusModel *models.StatusModel) error {
 	args := m.Called(statusModel)
 	return args.Error(0)
 }
 
-func (m *MockStatusModelRepository) GetByID(id uuid.UUID) (*models.StatusModel, error) {
+func (m *MockStatusModelRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.StatusModel, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockStatusModelRepository) GetByEntityTypeAndName(entityType models.EntityType, name string) (*models.StatusModel, error) {
+func (m *MockStatusModelRepository) GetByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (*models.StatusModel, error) {
 	args := m.Called(entityType, name)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockStatusModelRepository) GetDefaultByEntityType(entityType models.EntityType) (*models.StatusModel, error) {
+func (m *MockStatusModelRepository) GetDefaultByEntityType(ctx context.Context, entityType models.EntityType) (*models.StatusModel, error) {
 	args := m.Called(entityType)
 	return args.Get(0).(*models.StatusModel), args.Error(1)
 }
 
-func (m *MockStatusModelRepository) Update(statusModel *models.StatusModel) error {
+func (m *MockStatusModelRepository) Update(ctx context.Context, statusModel *models.StatusModel) error {
 	args := m.Called(statusModel)
 	return args.Error(0)
 }
 
-func (m *MockStatusModelRepository) Delete(id uuid.UUID) error {
+func (m *MockStatusModelRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockStatusModelRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusModel, error) {
+func (m *MockStatusModelRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusModel, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.StatusModel), args.Error(1)
 }
 
-func (m *MockStatusModelRepository) ListByEntityType(entityType models.EntityType) ([]models.StatusModel, error) {
+func (m *MockStatusModelRepository) ListByEntityType(ctx context.Context, entityType models.EntityType) ([]models.StatusModel, error) {
 	args := m.Called(entityType)
 	return args.Get(0).([]models.StatusModel), args.Error(1)
 }
 
-func (m *MockStatusModelRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockStatusModelRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockStatusModelRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockStatusModelRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockStatusModelRepository) ExistsByEntityTypeAndName(entityType models.EntityType, name string) (bool, error) {
+func (m *MockStatusModelRepository) ExistsByEntityTypeAndName(ctx context.Context, entityType models.EntityType, name string) (bool, error) {
 	args := m.Called(entityType, name)
 	return args.Bool(0), args.Error(1)
 }
@@ -75,52 +76,57 @@ type MockStatusRepository struct {
 	mock.Mock
 }
 
-func (m *MockStatusRepository) Create(status *models.Status) error {
+func (m *MockStatusRepository) Create(ctx context.Context, status *models.Status) error {
 	args := m.Called(status)
 	return args.Error(0)
 }
 
-func (m *MockStatusRepository) GetByID(id uuid.UUID) (*models.Status, error) {
+func (m *MockStatusRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Status, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockStatusRepository) GetByStatusModelID(statusModelID uuid.UUID) ([]models.Status, error) {
+func (m *MockStatusRepository) GetByStatusModelID(ctx context.Context, statusModelID uuid.UUID) ([]models.Status, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).([]models.Status), args.Error(1)
 }
 
-func (m *MockStatusRepository) GetByName(statusModelID uuid.UUID, name string) (*models.Status, error) {
+func (m *MockStatusRepository) GetByName(ctx context.Context, statusModelID uuid.UUID, name string) (*models.Status, error) {
 	args := m.Called(statusModelID, name)
 	return args.Get(0).(*models.Status), args.Error(1)
 }
 
-func (m *MockStatusRepository) Update(status *models.Status) error {
+func (m *MockStatusRepository) Update(ctx context.Context, status *models.Status) error {
 	args := m.Called(status)
 	return args.Error(0)
 }
 
-func (m *MockStatusRepository) Delete(id uuid.UUID) error {
+func (m *MockStatusRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockStatusRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Status, error) {
+func (m *MockStatusRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Status, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.Status), args.Error(1)
 }
 
-func (m *MockStatusRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockStatusRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockStatusRepository) CountByStatusModelID(statusModelID uuid.UUID) (int64, error) {
+func (m *MockStatusRepository) CountByStatusModelID(ctx context.Context, statusModelID uuid.UUID) (int64, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockStatusRepository) ExistsByName(statusModelID uuid.UUID, name string) (bool, error) {
+func (m *MockStatusRepository) UpdateOrder(ctx context.Context, statusModelID uuid.UUID, statusIDs []uuid.UUID) error {
+	args := m.Called(statusModelID, statusIDs)
+	return args.Error(0)
+}
+
+func (m *MockStatusRepository) ExistsByName(ctx context.Context, statusModelID uuid.UUID, name string) (bool, error) {
 	args := m.Called(statusModelID, name)
 	return args.Bool(0), args.Error(1)
 }
@@ -129,57 +135,58 @@ type MockStatusTransitionRepository struct {
 	mock.Mock
 }
 
-func (m *MockStatusTransitionRepository) Create(transition *models.StatusTransition) error {
+func (m *MockStatusTransitionRepository) Create(ctx context.Context, transition *models.StatusTransition) error {
 	args := m.Called(transition)
 	return args.Error(0)
 }
 
-func (m *MockStatusTransitionRepository) GetByID(id uuid.UUID) (*models.StatusTransition, error) {
+func (m *MockStatusTransitionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.StatusTransition, error) {
 	args := m.Called(id)
 	return args.Get(0).(*models.StatusTransition), args.Error(1)
 }
 
-func (m *MockStatusTransitionRepository) GetByStatusModelID(statusModelID uuid.UUID) ([]models.StatusTransition, error) {
+func (m *MockStatusTransitionRepository) GetByStatusModelID(ctx context.Context, statusModelID uuid.UUID) ([]models.StatusTransition, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).([]models.StatusTransition), args.Error(1)
 }
 
-func (m *MockStatusTransitionRepository) GetByFromStatus(fromStatusID uuid.UUID) ([]models.StatusTransition, error) {
+func (m *MockStatusTransitionRepository) GetByFromStatus(ctx context.Context, fromStatusID uuid.UUID) ([]models.StatusTransition, error) {
 	args := m.Called(fromStatusID)
 	return args.Get(0).([]models.StatusTransition), args.Error(1)
 }
 
-func (m *MockStatusTransitionRepository) Update(transition *models.StatusTransition) error {
+func (m *MockStatusTransitionRepository) Update(ctx context.Context, transition *models.StatusTransition) error {
 	args := m.Called(transition)
 	return args.Error(0)
 }
 
-func (m *MockStatusTransitionRepository) Delete(id uuid.UUID) error {
+func (m *MockStatusTransitionRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockStatusTransitionRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusTransition, error) {
+func (m *MockStatusTransitionRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.StatusTransition, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.StatusTransition), args.Error(1)
 }
 
-func (m *MockStatusTransitionRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockStatusTransitionRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockStatusTransitionRepository) CountByStatusModelID(statusModelID uuid.UUID) (int64, error) {
+func (m *MockStatusTransitionRepository) CountByStatusModelID(ctx context.Context, statusModelID uuid.UUID) (int64, error) {
 	args := m.Called(statusModelID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockStatusTransitionRepository) ExistsByTransition(statusModelID, fromStatusID, toStatusID uuid.UUID) (bool, error) {
+func (m *MockStatusTransitionRepository) ExistsByTransition(ctx context.Context, statusModelID, fromStatusID, toStatusID uuid.UUID) (bool, error) {
 	args := m.Called(statusModelID, fromStatusID, toStatusID)
 	return args.Bool(0), args.Error(1)
 }
 
 func TestCreateStatusModel(t *testing.T) {
+	ctx := context.Background()
 	t.Run("successful creation", func(t *testing.T) {
 		mockStatusModelRepo := new(MockStatusModelRepository)
 		mockStatusRepo := new(MockStatusRepository)
@@ -209,7 +216,7 @@ func TestCreateStatusModel(t *testing.T) {
 		mockStatusModelRepo.On("ExistsByEntityTypeAndName", models.EntityTypeEpic, "Test Status Model").Return(false, nil)
 		mockStatusModelRepo.On("Create", mock.AnythingOfType("*models.StatusModel")).Return(nil)
 
-		result, err := service.CreateStatusModel(req)
+		result, err := service.CreateStatusModel(ctx, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -244,7 +251,7 @@ func TestCreateStatusModel(t *testing.T) {
 			Name:       "Test Status Model",
 		}
 
-		result, err := service.CreateStatusModel(req)
+		result, err := service.CreateStatusModel(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -277,7 +284,7 @@ func TestCreateStatusModel(t *testing.T) {
 
 		mockStatusModelRepo.On("ExistsByEntityTypeAndName", models.EntityTypeEpic, "Test Status Model").Return(true, nil)
 
-		result, err := service.CreateStatusModel(req)
+		result, err := service.CreateStatusModel(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -288,6 +295,7 @@ func TestCreateStatusModel(t *testing.T) {
 }
 
 func TestGetStatusModelByID(t *testing.T) {
+	ctx := context.Background()
 	t.Run("successful retrieval", func(t *testing.T) {
 		mockStatusModelRepo := new(MockStatusModelRepository)
 		mockStatusRepo := new(MockStatusRepository)
@@ -316,7 +324,7 @@ func TestGetStatusModelByID(t *testing.T) {
 
 		mockStatusModelRepo.On("GetByID", id).Return(expectedStatusModel, nil)
 
-		result, err := service.GetStatusModelByID(id)
+		result, err := service.GetStatusModelByID(ctx, id)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -349,7 +357,7 @@ func TestGetStatusModelByID(t *testing.T) {
 
 		mockStatusModelRepo.On("GetByID", id).Return((*models.StatusModel)(nil), repository.ErrNotFound)
 
-		result, err := service.GetStatusModelByID(id)
+		result, err := service.GetStatusModelByID(ctx, id)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -360,6 +368,7 @@ func TestGetStatusModelByID(t *testing.T) {
 }
 
 func TestValidateStatusTransition(t *testing.T) {
+	ctx := context.Background()
 	t.Run("valid transition with no explicit transitions defined", func(t *testing.T) {
 		mockStatusModelRepo := new(MockStatusModelRepository)
 		mockStatusRepo := new(MockStatusRepository)
@@ -402,7 +411,7 @@ func TestValidateStatusTransition(t *testing.T) {
 		mockStatusRepo.On("GetByName", statusModel.ID, "Backlog").Return(fromStatus, nil)
 		mockStatusRepo.On("GetByName", statusModel.ID, "In Progress").Return(toStatus, nil)
 
-		err := service.ValidateStatusTransition(models.EntityTypeEpic, "Backlog", "In Progress")
+		err := service.ValidateStatusTransition(ctx, models.EntityTypeEpic, "Backlog", "In Progress")
 
 		assert.NoError(t, err)
 
@@ -431,7 +440,7 @@ func TestValidateStatusTransition(t *testing.T) {
 
 		mockStatusModelRepo.On("GetDefaultByEntityType", models.EntityTypeEpic).Return((*models.StatusModel)(nil), repository.ErrNotFound)
 
-		err := service.ValidateStatusTransition(models.EntityTypeEpic, "Backlog", "In Progress")
+		err := service.ValidateStatusTransition(ctx, models.EntityTypeEpic, "Backlog", "In Progress")
 
 		assert.NoError(t, err)
 
@@ -466,7 +475,7 @@ func TestValidateStatusTransition(t *testing.T) {
 		mockStatusModelRepo.On("GetDefaultByEntityType", models.EntityTypeEpic).Return(statusModel, nil)
 		mockStatusRepo.On("GetByName", statusModel.ID, "InvalidStatus").Return((*models.Status)(nil), repository.ErrNotFound)
 
-		err := service.ValidateStatusTransition(models.EntityTypeEpic, "InvalidStatus", "In Progress")
+		err := service.ValidateStatusTransition(ctx, models.EntityTypeEpic, "InvalidStatus", "In Progress")
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrInvalidStatusTransition, err)
@@ -0,0 +1,542 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// The status page shows incidents resolved in the last week and the next maintenance windows. It is computed
+// at most once per cache period, so that public traffic does not translate into database checks.
+const (
+	statusPageIncidentDays     = 7
+	statusPageMaxIncidents     = 20
+	statusPageMaxMaintenance   = 20
+	statusPageCachePeriod      = 30 * time.Second
+	maxStatusPageTitleLength   = 255
+	statusPageComponentTimeout = 3 * time.Second
+)
+
+var (
+	ErrIncidentNotFound          = apperrors.New(apperrors.KindNotFound, "INCIDENT_NOT_FOUND", "incident not found")
+	ErrMaintenanceWindowNotFound = apperrors.New(apperrors.KindNotFound, "MAINTENANCE_WINDOW_NOT_FOUND", "maintenance window not found")
+	ErrStatusPageTitle           = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, fmt.Sprintf("title is required and must not exceed %d characters", maxStatusPageTitleLength))
+	ErrIncidentStatus            = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "status must be one of: investigating, identified, monitoring, resolved")
+	ErrIncidentImpact            = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "impact must be one of: minor, major, critical")
+	ErrIncidentMessage           = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "message is required")
+	ErrStatusPageComponents      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "components must list at least one known status page component")
+	ErrMaintenanceWindowPeriod   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "ends_at must be after starts_at")
+)
+
+// ComponentStatus is the availability of a status page component
+// @Description Availability of a component, from best to worst: operational, under_maintenance, degraded_performance, partial_outage, major_outage
+// @Example "operational"
+type ComponentStatus string
+
+const (
+	ComponentOperational         ComponentStatus = "operational"
+	ComponentUnderMaintenance    ComponentStatus = "under_maintenance"
+	ComponentDegradedPerformance ComponentStatus = "degraded_performance"
+	ComponentPartialOutage       ComponentStatus = "partial_outage"
+	ComponentMajorOutage         ComponentStatus = "major_outage"
+)
+
+// componentStatusSeverity orders the component statuses from best to worst
+var componentStatusSeverity = map[ComponentStatus]int{
+	ComponentOperational:         0,
+	ComponentUnderMaintenance:    1,
+	ComponentDegradedPerformance: 2,
+	ComponentPartialOutage:       3,
+	ComponentMajorOutage:         4,
+}
+
+// incidentImpactStatus is the status an open incident gives the components it affects
+var incidentImpactStatus = map[models.IncidentImpact]ComponentStatus{
+	models.IncidentImpactMinor:    ComponentDegradedPerformance,
+	models.IncidentImpactMajor:    ComponentPartialOutage,
+	models.IncidentImpactCritical: ComponentMajorOutage,
+}
+
+// StatusComponent is a part of the service whose availability is shown on the status page
+type StatusComponent struct {
+	ID    string
+	Name  string
+	Check func(ctx context.Context) bool // Reports whether the component is up; nil when it is up whenever the API answers
+}
+
+// StatusPage is the public status of the service
+// @Description Public status of the service: component availability, open and recent incidents and upcoming maintenance
+type StatusPage struct {
+	Status               ComponentStatus         `json:"status" example:"operational"` // Worst status of the components
+	UpdatedAt            time.Time               `json:"updated_at" example:"2023-01-01T10:00:00Z"`
+	Components           []StatusPageComponent   `json:"components"`
+	Incidents            []StatusPageIncident    `json:"incidents"`
+	ScheduledMaintenance []StatusPageMaintenance `json:"scheduled_maintenance"`
+}
+
+// StatusPageComponent is the availability of a component on the status page
+type StatusPageComponent struct {
+	ID     string          `json:"id" example:"api"`
+	Name   string          `json:"name" example:"API"`
+	Status ComponentStatus `json:"status" example:"operational"`
+}
+
+// StatusPageIncident is an incident as shown on the status page
+type StatusPageIncident struct {
+	ID         uuid.UUID                  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title      string                     `json:"title" example:"Elevated API error rates"`
+	Status     models.IncidentStatus      `json:"status" example:"identified"`
+	Impact     models.IncidentImpact      `json:"impact" example:"major"`
+	Components []string                   `json:"components" example:"api"`
+	StartedAt  time.Time                  `json:"started_at" example:"2023-01-01T10:00:00Z"`
+	ResolvedAt *time.Time                 `json:"resolved_at,omitempty" example:"2023-01-01T11:30:00Z"`
+	Updates    []StatusPageIncidentUpdate `json:"updates"`
+}
+
+// StatusPageIncidentUpdate is a message of the timeline of an incident as shown on the status page
+type StatusPageIncidentUpdate struct {
+	Status    models.IncidentStatus `json:"status" example:"identified"`
+	Message   string                `json:"message" example:"A faulty deployment was identified and is rolled back"`
+	CreatedAt time.Time             `json:"created_at" example:"2023-01-01T10:20:00Z"`
+}
+
+// StatusPageMaintenance is a maintenance window as shown on the status page
+type StatusPageMaintenance struct {
+	ID          uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174003"`
+	Title       string    `json:"title" example:"Database upgrade"`
+	Description string    `json:"description,omitempty" example:"Writes are paused for up to 10 minutes"`
+	Components  []string  `json:"components" example:"database"`
+	StartsAt    time.Time `json:"starts_at" example:"2023-01-07T02:00:00Z"`
+	EndsAt      time.Time `json:"ends_at" example:"2023-01-07T03:00:00Z"`
+	InProgress  bool      `json:"in_progress" example:"false"`
+}
+
+// IncidentRequest represents the request to replace the details of an incident
+// @Description Public details of an incident; updates replace all of them
+type IncidentRequest struct {
+	Title      string                `json:"title" binding:"required" example:"Elevated API error rates"`
+	Impact     models.IncidentImpact `json:"impact" binding:"required" example:"major"`
+	Components []string              `json:"components" binding:"required" example:"api"` // IDs of the affected status page components
+}
+
+// CreateIncidentRequest represents the request to open an incident with its first update
+// @Description Request payload for opening an incident
+type CreateIncidentRequest struct {
+	IncidentRequest
+	Status    models.IncidentStatus `json:"status,omitempty" example:"investigating"`                             // Defaults to investigating
+	Message   string                `json:"message" binding:"required" example:"We are investigating API errors"` // First update of the timeline
+	StartedAt *time.Time            `json:"started_at,omitempty" example:"2023-01-01T10:00:00Z"`                  // Defaults to now
+}
+
+// IncidentUpdateRequest represents the request to post an update to an incident
+// @Description Request payload for posting an update to the timeline of an incident; resolved closes the incident
+type IncidentUpdateRequest struct {
+	Status  models.IncidentStatus `json:"status" binding:"required" example:"identified"`
+	Message string                `json:"message" binding:"required" example:"A faulty deployment was identified and is rolled back"`
+}
+
+// MaintenanceWindowRequest represents the request to schedule or replace a maintenance window
+// @Description Maintenance window; updates replace the whole window
+type MaintenanceWindowRequest struct {
+	Title       string    `json:"title" binding:"required" example:"Database upgrade"`
+	Description string    `json:"description,omitempty" binding:"max=5000" example:"Writes are paused for up to 10 minutes"`
+	Components  []string  `json:"components" binding:"required" example:"database"` // IDs of the status page components under maintenance
+	StartsAt    time.Time `json:"starts_at" binding:"required" example:"2023-01-07T02:00:00Z"`
+	EndsAt      time.Time `json:"ends_at" binding:"required" example:"2023-01-07T03:00:00Z"`
+}
+
+// StatusPageService defines the interface for the public status page and the incidents and maintenance
+// windows that administrators announce on it. It is separate from the internal health checks.
+type StatusPageService interface {
+	GetStatusPage(ctx context.Context, now time.Time) *StatusPage
+	CreateIncident(ctx context.Context, req CreateIncidentRequest, userID uuid.UUID) (*models.Incident, error)
+	GetIncident(ctx context.Context, id uuid.UUID) (*models.Incident, error)
+	ListIncidents(ctx context.Context, resolved *bool, limit, offset int) ([]models.Incident, int64, error)
+	UpdateIncident(ctx context.Context, id uuid.UUID, req IncidentRequest) (*models.Incident, error)
+	PostIncidentUpdate(ctx context.Context, id uuid.UUID, req IncidentUpdateRequest, userID uuid.UUID) (*models.Incident, error)
+	DeleteIncident(ctx context.Context, id uuid.UUID) error
+	CreateMaintenanceWindow(ctx context.Context, req MaintenanceWindowRequest, userID uuid.UUID) (*models.MaintenanceWindow, error)
+	GetMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, endingAfter *time.Time, limit, offset int) ([]models.MaintenanceWindow, int64, error)
+	UpdateMaintenanceWindow(ctx context.Context, id uuid.UUID, req MaintenanceWindowRequest) (*models.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, id uuid.UUID) error
+}
+
+// statusPageService implements StatusPageService interface
+type statusPageService struct {
+	repos      *repository.Repositories
+	components []StatusComponent
+
+	mu       sync.Mutex
+	cached   *StatusPage
+	cachedAt time.Time
+}
+
+// NewStatusPageService creates a new status page service showing the given components in order
+func NewStatusPageService(repos *repository.Repositories, components []StatusComponent) StatusPageService {
+	return &statusPageService{
+		repos:      repos,
+		components: components,
+	}
+}
+
+// GetStatusPage returns the status of the components with the incidents and maintenance windows affecting them.
+// The page is recomputed at most once per cache period or when incidents or maintenance windows change.
+// When the incidents cannot be read, such as during a database outage, the page still reports the components
+// and is not cached.
+func (s *statusPageService) GetStatusPage(ctx context.Context, now time.Time) *StatusPage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cached != nil && now.Sub(s.cachedAt) < statusPageCachePeriod && !now.Before(s.cachedAt) {
+		return s.cached
+	}
+
+	incidents, incidentsErr := s.repos.Incident.ListForStatusPage(ctx, now.AddDate(0, 0, -statusPageIncidentDays), statusPageMaxIncidents)
+	windows, _, windowsErr := s.repos.MaintenanceWindow.ListWithFilters(ctx, &now, statusPageMaxMaintenance, 0)
+
+	page := &StatusPage{
+		Status:               ComponentOperational,
+		UpdatedAt:            now,
+		Components:           make([]StatusPageComponent, 0, len(s.components)),
+		Incidents:            make([]StatusPageIncident, 0, len(incidents)),
+		ScheduledMaintenance: make([]StatusPageMaintenance, 0, len(windows)),
+	}
+	statuses := make(map[string]ComponentStatus, len(s.components))
+	worsen := func(componentIDs []string, status ComponentStatus) {
+		for _, id := range componentIDs {
+			if current, ok := statuses[id]; ok && componentStatusSeverity[status] > componentStatusSeverity[current] {
+				statuses[id] = status
+			}
+		}
+	}
+
+	for _, component := range s.components {
+		statuses[component.ID] = ComponentOperational
+		if component.Check != nil {
+			checkCtx, cancel := context.WithTimeout(ctx, statusPageComponentTimeout)
+			up := component.Check(checkCtx)
+			cancel()
+			if !up {
+				statuses[component.ID] = ComponentMajorOutage
+			}
+		}
+	}
+	for _, window := range windows {
+		inProgress := !now.Before(window.StartsAt)
+		if inProgress {
+			worsen(window.Components, ComponentUnderMaintenance)
+		}
+		page.ScheduledMaintenance = append(page.ScheduledMaintenance, StatusPageMaintenance{
+			ID:          window.ID,
+			Title:       window.Title,
+			Description: window.Description,
+			Components:  window.Components,
+			StartsAt:    window.StartsAt,
+			EndsAt:      window.EndsAt,
+			InProgress:  inProgress,
+		})
+	}
+	for _, incident := range incidents {
+		if incident.Status != models.IncidentResolved {
+			worsen(incident.Components, incidentImpactStatus[incident.Impact])
+		}
+		entry := StatusPageIncident{
+			ID:         incident.ID,
+			Title:      incident.Title,
+			Status:     incident.Status,
+			Impact:     incident.Impact,
+			Components: incident.Components,
+			StartedAt:  incident.StartedAt,
+			ResolvedAt: incident.ResolvedAt,
+			Updates:    make([]StatusPageIncidentUpdate, 0, len(incident.Updates)),
+		}
+		// Newest update first, as status pages are read
+		for i := len(incident.Updates) - 1; i >= 0; i-- {
+			update := incident.Updates[i]
+			entry.Updates = append(entry.Updates, StatusPageIncidentUpdate{Status: update.Status, Message: update.Message, CreatedAt: update.CreatedAt})
+		}
+		page.Incidents = append(page.Incidents, entry)
+	}
+
+	for _, component := range s.components {
+		status := statuses[component.ID]
+		page.Components = append(page.Components, StatusPageComponent{ID: component.ID, Name: component.Name, Status: status})
+		if componentStatusSeverity[status] > componentStatusSeverity[page.Status] {
+			page.Status = status
+		}
+	}
+
+	if incidentsErr == nil && windowsErr == nil {
+		s.cached, s.cachedAt = page, now
+	}
+	return page
+}
+
+// CreateIncident opens an incident with its first update
+func (s *statusPageService) CreateIncident(ctx context.Context, req CreateIncidentRequest, userID uuid.UUID) (*models.Incident, error) {
+	incident := &models.Incident{Status: models.IncidentInvestigating, CreatedBy: userID, StartedAt: time.Now().UTC()}
+	if err := s.applyIncidentRequest(incident, req.IncidentRequest); err != nil {
+		return nil, err
+	}
+	if req.Status != "" {
+		incident.Status = req.Status
+	}
+	if !incident.Status.IsValid() {
+		return nil, ErrIncidentStatus
+	}
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return nil, ErrIncidentMessage
+	}
+	if req.StartedAt != nil {
+		incident.StartedAt = req.StartedAt.UTC()
+	}
+	if incident.Status == models.IncidentResolved {
+		resolvedAt := time.Now().UTC()
+		incident.ResolvedAt = &resolvedAt
+	}
+
+	err := s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		if err := tx.Incident.Create(ctx, incident); err != nil {
+			return err
+		}
+		return tx.Incident.CreateUpdate(ctx, &models.IncidentUpdate{IncidentID: incident.ID, Status: incident.Status, Message: message, CreatedBy: userID})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create incident: %w", err)
+	}
+	s.invalidate()
+	return s.GetIncident(ctx, incident.ID)
+}
+
+// GetIncident retrieves an incident with its timeline
+func (s *statusPageService) GetIncident(ctx context.Context, id uuid.UUID) (*models.Incident, error) {
+	incident, err := s.repos.Incident.GetWithUpdates(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrIncidentNotFound
+		}
+		return nil, fmt.Errorf("failed to get incident: %w", err)
+	}
+	return incident, nil
+}
+
+// ListIncidents returns incidents, optionally only open or resolved ones, most recently started first
+func (s *statusPageService) ListIncidents(ctx context.Context, resolved *bool, limit, offset int) ([]models.Incident, int64, error) {
+	incidents, total, err := s.repos.Incident.ListWithFilters(ctx, resolved, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list incidents: %w", err)
+	}
+	return incidents, total, nil
+}
+
+// UpdateIncident replaces the title, impact and components of an incident
+func (s *statusPageService) UpdateIncident(ctx context.Context, id uuid.UUID, req IncidentRequest) (*models.Incident, error) {
+	incident, err := s.GetIncident(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyIncidentRequest(incident, req); err != nil {
+		return nil, err
+	}
+	updates := incident.Updates
+	incident.Updates = nil
+	if err := s.repos.Incident.Update(ctx, incident); err != nil {
+		return nil, fmt.Errorf("failed to update incident: %w", err)
+	}
+	incident.Updates = updates
+	s.invalidate()
+	return incident, nil
+}
+
+// PostIncidentUpdate adds an update to the timeline of an incident and moves the incident to its status.
+// Resolving an incident records when it was resolved; an update of a resolved incident reopens it.
+func (s *statusPageService) PostIncidentUpdate(ctx context.Context, id uuid.UUID, req IncidentUpdateRequest, userID uuid.UUID) (*models.Incident, error) {
+	if !req.Status.IsValid() {
+		return nil, ErrIncidentStatus
+	}
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return nil, ErrIncidentMessage
+	}
+	incident, err := s.GetIncident(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	switch {
+	case req.Status == models.IncidentResolved && incident.Status != models.IncidentResolved:
+		incident.ResolvedAt = &now
+	case req.Status != models.IncidentResolved:
+		incident.ResolvedAt = nil
+	}
+	incident.Status = req.Status
+	incident.Updates = nil
+
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		if err := tx.Incident.Update(ctx, incident); err != nil {
+			return err
+		}
+		return tx.Incident.CreateUpdate(ctx, &models.IncidentUpdate{IncidentID: incident.ID, Status: req.Status, Message: message, CreatedBy: userID, CreatedAt: now})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to post incident update: %w", err)
+	}
+	s.invalidate()
+	return s.GetIncident(ctx, id)
+}
+
+// DeleteIncident removes an incident opened by mistake together with its timeline
+func (s *statusPageService) DeleteIncident(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetIncident(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repos.Incident.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete incident: %w", err)
+	}
+	s.invalidate()
+	return nil
+}
+
+// CreateMaintenanceWindow schedules a maintenance window
+func (s *statusPageService) CreateMaintenanceWindow(ctx context.Context, req MaintenanceWindowRequest, userID uuid.UUID) (*models.MaintenanceWindow, error) {
+	window := &models.MaintenanceWindow{CreatedBy: userID}
+	if err := s.applyMaintenanceWindowRequest(window, req); err != nil {
+		return nil, err
+	}
+	if err := s.repos.MaintenanceWindow.Create(ctx, window); err != nil {
+		return nil, fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+	s.invalidate()
+	return window, nil
+}
+
+// GetMaintenanceWindow retrieves a maintenance window
+func (s *statusPageService) GetMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	window, err := s.repos.MaintenanceWindow.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrMaintenanceWindowNotFound
+		}
+		return nil, fmt.Errorf("failed to get maintenance window: %w", err)
+	}
+	return window, nil
+}
+
+// ListMaintenanceWindows returns maintenance windows, optionally only those not over by a time, earliest first
+func (s *statusPageService) ListMaintenanceWindows(ctx context.Context, endingAfter *time.Time, limit, offset int) ([]models.MaintenanceWindow, int64, error) {
+	windows, total, err := s.repos.MaintenanceWindow.ListWithFilters(ctx, endingAfter, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+	return windows, total, nil
+}
+
+// UpdateMaintenanceWindow replaces a maintenance window
+func (s *statusPageService) UpdateMaintenanceWindow(ctx context.Context, id uuid.UUID, req MaintenanceWindowRequest) (*models.MaintenanceWindow, error) {
+	window, err := s.GetMaintenanceWindow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyMaintenanceWindowRequest(window, req); err != nil {
+		return nil, err
+	}
+	if err := s.repos.MaintenanceWindow.Update(ctx, window); err != nil {
+		return nil, fmt.Errorf("failed to update maintenance window: %w", err)
+	}
+	s.invalidate()
+	return window, nil
+}
+
+// DeleteMaintenanceWindow cancels a maintenance window
+func (s *statusPageService) DeleteMaintenanceWindow(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetMaintenanceWindow(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repos.MaintenanceWindow.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+	s.invalidate()
+	return nil
+}
+
+// applyIncidentRequest validates the details of an incident and copies them to it
+func (s *statusPageService) applyIncidentRequest(incident *models.Incident, req IncidentRequest) error {
+	title, err := normalizeStatusPageTitle(req.Title)
+	if err != nil {
+		return err
+	}
+	if !req.Impact.IsValid() {
+		return ErrIncidentImpact
+	}
+	components, err := s.normalizeComponents(req.Components)
+	if err != nil {
+		return err
+	}
+	incident.Title, incident.Impact, incident.Components = title, req.Impact, components
+	return nil
+}
+
+// applyMaintenanceWindowRequest validates a maintenance window and copies it to the window
+func (s *statusPageService) applyMaintenanceWindowRequest(window *models.MaintenanceWindow, req MaintenanceWindowRequest) error {
+	title, err := normalizeStatusPageTitle(req.Title)
+	if err != nil {
+		return err
+	}
+	components, err := s.normalizeComponents(req.Components)
+	if err != nil {
+		return err
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return ErrMaintenanceWindowPeriod
+	}
+	window.Title, window.Description, window.Components = title, strings.TrimSpace(req.Description), components
+	window.StartsAt, window.EndsAt = req.StartsAt.UTC(), req.EndsAt.UTC()
+	return nil
+}
+
+// normalizeComponents checks that component IDs are known and removes duplicates, keeping the order of the page
+func (s *statusPageService) normalizeComponents(ids []string) ([]string, error) {
+	for _, id := range ids {
+		if !slices.ContainsFunc(s.components, func(component StatusComponent) bool { return component.ID == id }) {
+			return nil, fmt.Errorf("%w: unknown component %q", ErrStatusPageComponents, id)
+		}
+	}
+	var components []string
+	for _, component := range s.components {
+		if slices.Contains(ids, component.ID) {
+			components = append(components, component.ID)
+		}
+	}
+	if len(components) == 0 {
+		return nil, ErrStatusPageComponents
+	}
+	return components, nil
+}
+
+// invalidate drops the cached status page, so that changes show up on the next request
+func (s *statusPageService) invalidate() {
+	s.mu.Lock()
+	s.cached = nil
+	s.mu.Unlock()
+}
+
+// normalizeStatusPageTitle trims a title and checks its length
+func normalizeStatusPageTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || len(title) > maxStatusPageTitleLength {
+		return "", ErrStatusPageTitle
+	}
+	return title, nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestStatusPageService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Incident{}, &models.IncidentUpdate{}, &models.MaintenanceWindow{}))
+	admin := &models.User{Username: "admin", Email: "admin@example.com", PasswordHash: "hash", Role: models.RoleAdministrator}
+	require.NoError(t, db.Create(admin).Error)
+
+	cacheUp := true
+	svc := NewStatusPageService(repository.NewRepositories(db, nil), []StatusComponent{
+		{ID: "api", Name: "API"},
+		{ID: "database", Name: "Database", Check: func(ctx context.Context) bool { return true }},
+		{ID: "cache", Name: "Cache", Check: func(ctx context.Context) bool { return cacheUp }},
+	})
+	now := time.Now().UTC()
+	componentStatuses := func(page *StatusPage) map[string]ComponentStatus {
+		statuses := make(map[string]ComponentStatus)
+		for _, component := range page.Components {
+			statuses[component.ID] = component.Status
+		}
+		return statuses
+	}
+
+	t.Run("reports operational components", func(t *testing.T) {
+		page := svc.GetStatusPage(context.Background(), now)
+		assert.Equal(t, ComponentOperational, page.Status)
+		require.Len(t, page.Components, 3)
+		assert.Equal(t, "API", page.Components[0].Name)
+		assert.Empty(t, page.Incidents)
+		assert.Empty(t, page.ScheduledMaintenance)
+
+		// The page is cached, so a failing check shows up after the cache period
+		cacheUp = false
+		assert.Equal(t, ComponentOperational, svc.GetStatusPage(context.Background(), now.Add(time.Second)).Status)
+		page = svc.GetStatusPage(context.Background(), now.Add(statusPageCachePeriod))
+		assert.Equal(t, ComponentMajorOutage, page.Status)
+		assert.Equal(t, ComponentMajorOutage, componentStatuses(page)["cache"])
+		cacheUp = true
+	})
+
+	var incidentID = func() *models.Incident {
+		incident, err := svc.CreateIncident(ctx, CreateIncidentRequest{
+			IncidentRequest: IncidentRequest{Title: " Elevated API error rates ", Impact: models.IncidentImpactMajor, Components: []string{"database", "api", "api"}},
+			Message:         "We are investigating API errors",
+		}, admin.ID)
+		require.NoError(t, err)
+		return incident
+	}()
+
+	t.Run("opens incidents that lower the affected components", func(t *testing.T) {
+		assert.Equal(t, "Elevated API error rates", incidentID.Title)
+		assert.Equal(t, models.IncidentInvestigating, incidentID.Status)
+		assert.Equal(t, []string{"api", "database"}, incidentID.Components)
+		require.Len(t, incidentID.Updates, 1)
+
+		page := svc.GetStatusPage(context.Background(), now)
+		assert.Equal(t, ComponentPartialOutage, page.Status)
+		statuses := componentStatuses(page)
+		assert.Equal(t, ComponentPartialOutage, statuses["api"])
+		assert.Equal(t, ComponentOperational, statuses["cache"])
+		require.Len(t, page.Incidents, 1)
+		assert.Equal(t, "We are investigating API errors", page.Incidents[0].Updates[0].Message)
+	})
+
+	t.Run("validates incidents", func(t *testing.T) {
+		request := IncidentRequest{Title: "Outage", Impact: models.IncidentImpactMinor, Components: []string{"api"}}
+		_, err := svc.CreateIncident(ctx, CreateIncidentRequest{IncidentRequest: IncidentRequest{Title: " ", Impact: models.IncidentImpactMinor, Components: []string{"api"}}, Message: "Down"}, admin.ID)
+		assert.ErrorIs(t, err, ErrStatusPageTitle)
+		_, err = svc.CreateIncident(ctx, CreateIncidentRequest{IncidentRequest: IncidentRequest{Title: "Outage", Impact: "severe", Components: []string{"api"}}, Message: "Down"}, admin.ID)
+		assert.ErrorIs(t, err, ErrIncidentImpact)
+		_, err = svc.CreateIncident(ctx, CreateIncidentRequest{IncidentRequest: IncidentRequest{Title: "Outage", Impact: models.IncidentImpactMinor, Components: []string{"search"}}, Message: "Down"}, admin.ID)
+		assert.ErrorIs(t, err, ErrStatusPageComponents)
+		_, err = svc.CreateIncident(ctx, CreateIncidentRequest{IncidentRequest: request, Status: "fixed", Message: "Down"}, admin.ID)
+		assert.ErrorIs(t, err, ErrIncidentStatus)
+		_, err = svc.CreateIncident(ctx, CreateIncidentRequest{IncidentRequest: request, Message: " "}, admin.ID)
+		assert.ErrorIs(t, err, ErrIncidentMessage)
+	})
+
+	t.Run("resolves and reopens incidents through updates", func(t *testing.T) {
+		incident, err := svc.PostIncidentUpdate(ctx, incidentID.ID, IncidentUpdateRequest{Status: models.IncidentResolved, Message: "Fixed"}, admin.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.IncidentResolved, incident.Status)
+		require.NotNil(t, incident.ResolvedAt)
+		assert.Len(t, incident.Updates, 2)
+
+		page := svc.GetStatusPage(context.Background(), now)
+		assert.Equal(t, ComponentOperational, page.Status)
+		require.Len(t, page.Incidents, 1)
+		assert.Equal(t, "Fixed", page.Incidents[0].Updates[0].Message)
+
+		// Resolved incidents leave the page after a week
+		assert.Empty(t, svc.GetStatusPage(context.Background(), now.AddDate(0, 0, statusPageIncidentDays+1)).Incidents)
+
+		incident, err = svc.PostIncidentUpdate(ctx, incidentID.ID, IncidentUpdateRequest{Status: models.IncidentMonitoring, Message: "Errors are back"}, admin.ID)
+		require.NoError(t, err)
+		assert.Nil(t, incident.ResolvedAt)
+
+		resolved := false
+		incidents, total, err := svc.ListIncidents(ctx, &resolved, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, incidents, 1)
+	})
+
+	t.Run("updates and deletes incidents", func(t *testing.T) {
+		incident, err := svc.UpdateIncident(ctx, incidentID.ID, IncidentRequest{Title: "Slow API", Impact: models.IncidentImpactMinor, Components: []string{"api"}})
+		require.NoError(t, err)
+		assert.Equal(t, "Slow API", incident.Title)
+		assert.Len(t, incident.Updates, 3)
+		assert.Equal(t, ComponentDegradedPerformance, svc.GetStatusPage(context.Background(), now).Status)
+
+		require.NoError(t, svc.DeleteIncident(ctx, incidentID.ID))
+		assert.ErrorIs(t, svc.DeleteIncident(ctx, incidentID.ID), ErrIncidentNotFound)
+		assert.Equal(t, ComponentOperational, svc.GetStatusPage(context.Background(), now).Status)
+	})
+
+	t.Run("announces maintenance windows", func(t *testing.T) {
+		window, err := svc.CreateMaintenanceWindow(ctx, MaintenanceWindowRequest{Title: "Database upgrade", Components: []string{"database"},
+			StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}, admin.ID)
+		require.NoError(t, err)
+		_, err = svc.CreateMaintenanceWindow(ctx, MaintenanceWindowRequest{Title: "Past", Components: []string{"cache"},
+			StartsAt: now.Add(-3 * time.Hour), EndsAt: now.Add(-2 * time.Hour)}, admin.ID)
+		require.NoError(t, err)
+
+		page := svc.GetStatusPage(context.Background(), now)
+		assert.Equal(t, ComponentOperational, page.Status)
+		require.Len(t, page.ScheduledMaintenance, 1)
+		assert.False(t, page.ScheduledMaintenance[0].InProgress)
+
+		page = svc.GetStatusPage(context.Background(), now.Add(90*time.Minute))
+		assert.Equal(t, ComponentUnderMaintenance, page.Status)
+		assert.Equal(t, ComponentUnderMaintenance, componentStatuses(page)["database"])
+		assert.True(t, page.ScheduledMaintenance[0].InProgress)
+
+		_, err = svc.UpdateMaintenanceWindow(ctx, window.ID, MaintenanceWindowRequest{Title: "Database upgrade", Components: []string{"database"},
+			StartsAt: now.Add(2 * time.Hour), EndsAt: now.Add(time.Hour)})
+		assert.ErrorIs(t, err, ErrMaintenanceWindowPeriod)
+
+		windows, total, err := svc.ListMaintenanceWindows(ctx, &now, 50, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, window.ID, windows[0].ID)
+
+		require.NoError(t, svc.DeleteMaintenanceWindow(ctx, window.ID))
+		_, err = svc.GetMaintenanceWindow(ctx, window.ID)
+		assert.ErrorIs(t, err, ErrMaintenanceWindowNotFound)
+	})
+}
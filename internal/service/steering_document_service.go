@@ -1,34 +1,36 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 )
 
 // Steering document specific errors (additional to common errors in errors.go)
 var (
-	ErrInvalidCreator = errors.New("invalid creator")
+	ErrInvalidCreator = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid creator")
 )
 
 // SteeringDocumentService defines the interface for steering document business logic
 type SteeringDocumentService interface {
-	CreateSteeringDocument(req CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error)
-	GetSteeringDocumentByID(id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error)
-	GetSteeringDocumentByReferenceID(referenceID string, currentUser *models.User) (*models.SteeringDocument, error)
-	UpdateSteeringDocument(id uuid.UUID, req UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error)
-	DeleteSteeringDocument(id uuid.UUID, currentUser *models.User) error
-	ListSteeringDocuments(filters SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error)
-	SearchSteeringDocuments(query string, currentUser *models.User) ([]models.SteeringDocument, error)
-	GetSteeringDocumentsByEpicID(epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error)
-	GetSteeringDocumentsByEpicIDWithPagination(epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error)
-	LinkSteeringDocumentToEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error
-	UnlinkSteeringDocumentFromEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error
+	CreateSteeringDocument(ctx context.Context, req CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error)
+	GetSteeringDocumentByID(ctx context.Context, id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error)
+	GetSteeringDocumentByReferenceID(ctx context.Context, referenceID string, currentUser *models.User) (*models.SteeringDocument, error)
+	UpdateSteeringDocument(ctx context.Context, id uuid.UUID, req UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error)
+	DeleteSteeringDocument(ctx context.Context, id uuid.UUID, currentUser *models.User) error
+	ListSteeringDocuments(ctx context.Context, filters SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error)
+	SearchSteeringDocuments(ctx context.Context, query string, currentUser *models.User) ([]models.SteeringDocument, error)
+	GetSteeringDocumentsByEpicID(ctx context.Context, epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error)
+	GetSteeringDocumentsByEpicIDWithPagination(ctx context.Context, epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error)
+	LinkSteeringDocumentToEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error
+	UnlinkSteeringDocumentFromEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error
 }
 
 // CreateSteeringDocumentRequest represents the request to create a steering document
@@ -121,7 +123,7 @@ func NewSteeringDocumentService(
 }
 
 // CreateSteeringDocument creates a new steering document
-func (s *steeringDocumentService) CreateSteeringDocument(req CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
+func (s *steeringDocumentService) CreateSteeringDocument(ctx context.Context, req CreateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
 	// Authorization check: Only Administrator and User roles can create steering documents
 	if !currentUser.CanEdit() {
 		return nil, ErrUnauthorizedAccess
@@ -133,7 +135,7 @@ func (s *steeringDocumentService) CreateSteeringDocument(req CreateSteeringDocum
 	}
 
 	// Validate creator exists (should be the current user)
-	if exists, err := s.userRepo.Exists(currentUser.ID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, currentUser.ID); err != nil {
 		return nil, fmt.Errorf("failed to check creator existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
@@ -145,7 +147,7 @@ func (s *steeringDocumentService) CreateSteeringDocument(req CreateSteeringDocum
 		// Try to parse as UUID first
 		if parsedUUID, err := uuid.Parse(*req.EpicID); err == nil {
 			// It's a UUID, check if it exists
-			if exists, err := s.epicRepo.Exists(parsedUUID); err != nil {
+			if exists, err := s.epicRepo.Exists(ctx, parsedUUID); err != nil {
 				return nil, fmt.Errorf("failed to check epic existence: %w", err)
 			} else if !exists {
 				return nil, ErrEpicNotFound
@@ -153,7 +155,7 @@ func (s *steeringDocumentService) CreateSteeringDocument(req CreateSteeringDocum
 			epicUUID = &parsedUUID
 		} else {
 			// Try to find by reference ID (EP-XXX)
-			epic, err := s.epicRepo.GetByReferenceID(*req.EpicID)
+			epic, err := s.epicRepo.GetByReferenceID(ctx, *req.EpicID)
 			if err != nil {
 				if errors.Is(err, repository.ErrNotFound) {
 					return nil, ErrEpicNotFound
@@ -171,32 +173,32 @@ func (s *steeringDocumentService) CreateSteeringDocument(req CreateSteeringDocum
 		CreatorID:   currentUser.ID,
 	}
 
-	if err := s.steeringDocumentRepo.Create(doc); err != nil {
+	if err := s.steeringDocumentRepo.Create(ctx, doc); err != nil {
 		return nil, fmt.Errorf("failed to create steering document: %w", err)
 	}
 
 	// If epic_id is provided, create the link
 	if epicUUID != nil {
-		if err := s.steeringDocumentRepo.LinkToEpic(doc.ID, *epicUUID); err != nil {
+		if err := s.steeringDocumentRepo.LinkToEpic(ctx, doc.ID, *epicUUID); err != nil {
 			// If linking fails, we should still return the created document
 			// but log the error for debugging
 			// Note: In a production system, you might want to rollback the document creation
 			// or handle this differently based on business requirements
-			return s.steeringDocumentRepo.GetByID(doc.ID)
+			return s.steeringDocumentRepo.GetByID(ctx, doc.ID)
 		}
 	}
 
-	return s.steeringDocumentRepo.GetByID(doc.ID)
+	return s.steeringDocumentRepo.GetByID(ctx, doc.ID)
 }
 
 // GetSteeringDocumentByID retrieves a steering document by its ID
-func (s *steeringDocumentService) GetSteeringDocumentByID(id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error) {
+func (s *steeringDocumentService) GetSteeringDocumentByID(ctx context.Context, id uuid.UUID, currentUser *models.User) (*models.SteeringDocument, error) {
 	// Authorization check: Only Administrator and User roles can read steering documents
 	if !currentUser.CanRead() {
 		return nil, ErrUnauthorizedAccess
 	}
 
-	doc, err := s.steeringDocumentRepo.GetByID(id)
+	doc, err := s.steeringDocumentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrSteeringDocumentNotFound
@@ -207,13 +209,13 @@ func (s *steeringDocumentService) GetSteeringDocumentByID(id uuid.UUID, currentU
 }
 
 // GetSteeringDocumentByReferenceID retrieves a steering document by its reference ID
-func (s *steeringDocumentService) GetSteeringDocumentByReferenceID(referenceID string, currentUser *models.User) (*models.SteeringDocument, error) {
+func (s *steeringDocumentService) GetSteeringDocumentByReferenceID(ctx context.Context, referenceID string, currentUser *models.User) (*models.SteeringDocument, error) {
 	// Authorization check: Only Administrator and User roles can read steering documents
 	if !currentUser.CanRead() {
 		return nil, ErrUnauthorizedAccess
 	}
 
-	doc, err := s.steeringDocumentRepo.GetByReferenceID(referenceID)
+	doc, err := s.steeringDocumentRepo.GetByReferenceID(ctx, referenceID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrSteeringDocumentNotFound
@@ -224,9 +226,9 @@ func (s *steeringDocumentService) GetSteeringDocumentByReferenceID(referenceID s
 }
 
 // UpdateSteeringDocument updates an existing steering document
-func (s *steeringDocumentService) UpdateSteeringDocument(id uuid.UUID, req UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
+func (s *steeringDocumentService) UpdateSteeringDocument(ctx context.Context, id uuid.UUID, req UpdateSteeringDocumentRequest, currentUser *models.User) (*models.SteeringDocument, error) {
 	// Get the existing document
-	doc, err := s.steeringDocumentRepo.GetByID(id)
+	doc, err := s.steeringDocumentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrSteeringDocumentNotFound
@@ -248,17 +250,17 @@ func (s *steeringDocumentService) UpdateSteeringDocument(id uuid.UUID, req Updat
 		doc.Description = req.Description
 	}
 
-	if err := s.steeringDocumentRepo.Update(doc); err != nil {
+	if err := s.steeringDocumentRepo.Update(ctx, doc); err != nil {
 		return nil, fmt.Errorf("failed to update steering document: %w", err)
 	}
 
-	return s.steeringDocumentRepo.GetByID(id)
+	return s.steeringDocumentRepo.GetByID(ctx, id)
 }
 
 // DeleteSteeringDocument deletes a steering document
-func (s *steeringDocumentService) DeleteSteeringDocument(id uuid.UUID, currentUser *models.User) error {
+func (s *steeringDocumentService) DeleteSteeringDocument(ctx context.Context, id uuid.UUID, currentUser *models.User) error {
 	// Get the existing document
-	doc, err := s.steeringDocumentRepo.GetByID(id)
+	doc, err := s.steeringDocumentRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrSteeringDocumentNotFound
@@ -271,7 +273,7 @@ func (s *steeringDocumentService) DeleteSteeringDocument(id uuid.UUID, currentUs
 		return ErrUnauthorizedAccess
 	}
 
-	if err := s.steeringDocumentRepo.Delete(id); err != nil {
+	if err := s.steeringDocumentRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete steering document: %w", err)
 	}
 
@@ -279,7 +281,7 @@ func (s *steeringDocumentService) DeleteSteeringDocument(id uuid.UUID, currentUs
 }
 
 // ListSteeringDocuments retrieves steering documents with optional filtering
-func (s *steeringDocumentService) ListSteeringDocuments(filters SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
+func (s *steeringDocumentService) ListSteeringDocuments(ctx context.Context, filters SteeringDocumentFilters, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
 	// Authorization check: Only Administrator and User roles can list steering documents
 	if !currentUser.CanRead() {
 		return nil, 0, ErrUnauthorizedAccess
@@ -309,21 +311,21 @@ func (s *steeringDocumentService) ListSteeringDocuments(filters SteeringDocument
 		repoFilters.Limit = 100
 	}
 
-	return s.steeringDocumentRepo.ListWithFilters(repoFilters)
+	return s.steeringDocumentRepo.ListWithFilters(ctx, repoFilters)
 }
 
 // SearchSteeringDocuments performs full-text search on steering documents
-func (s *steeringDocumentService) SearchSteeringDocuments(query string, currentUser *models.User) ([]models.SteeringDocument, error) {
+func (s *steeringDocumentService) SearchSteeringDocuments(ctx context.Context, query string, currentUser *models.User) ([]models.SteeringDocument, error) {
 	// All authenticated users can search steering documents
-	return s.steeringDocumentRepo.Search(query)
+	return s.steeringDocumentRepo.Search(ctx, query)
 }
 
 // GetSteeringDocumentsByEpicID retrieves all steering documents linked to a specific epic
-func (s *steeringDocumentService) GetSteeringDocumentsByEpicID(epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error) {
+func (s *steeringDocumentService) GetSteeringDocumentsByEpicID(ctx context.Context, epicID uuid.UUID, currentUser *models.User) ([]models.SteeringDocument, error) {
 	// All authenticated users can view steering documents linked to epics
 
 	// Verify epic exists
-	_, err := s.epicRepo.GetByID(epicID)
+	_, err := s.epicRepo.GetByID(ctx, epicID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrEpicNotFound
@@ -331,18 +333,18 @@ func (s *steeringDocumentService) GetSteeringDocumentsByEpicID(epicID uuid.UUID,
 		return nil, fmt.Errorf("failed to get epic: %w", err)
 	}
 
-	return s.steeringDocumentRepo.GetByEpicID(epicID)
+	return s.steeringDocumentRepo.GetByEpicID(ctx, epicID)
 }
 
 // GetSteeringDocumentsByEpicIDWithPagination retrieves steering documents linked to a specific epic with pagination
-func (s *steeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination(epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
+func (s *steeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination(ctx context.Context, epicID uuid.UUID, limit, offset int, currentUser *models.User) ([]models.SteeringDocument, int64, error) {
 	// Authorization check: Only authenticated users can view steering documents
 	if !currentUser.CanRead() {
 		return nil, 0, ErrUnauthorizedAccess
 	}
 
 	// Verify epic exists
-	_, err := s.epicRepo.GetByID(epicID)
+	_, err := s.epicRepo.GetByID(ctx, epicID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, 0, ErrEpicNotFound
@@ -365,18 +367,18 @@ func (s *steeringDocumentService) GetSteeringDocumentsByEpicIDWithPagination(epi
 		offset = 0
 	}
 
-	return s.steeringDocumentRepo.GetByEpicIDWithPagination(epicID, limit, offset)
+	return s.steeringDocumentRepo.GetByEpicIDWithPagination(ctx, epicID, limit, offset)
 }
 
 // LinkSteeringDocumentToEpic creates a link between a steering document and an epic
-func (s *steeringDocumentService) LinkSteeringDocumentToEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
+func (s *steeringDocumentService) LinkSteeringDocumentToEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
 	// Authorization check: Only Administrator and User roles can link documents to epics
 	if !currentUser.CanEdit() {
 		return ErrUnauthorizedAccess
 	}
 
 	// Verify steering document exists
-	doc, err := s.steeringDocumentRepo.GetByID(steeringDocumentID)
+	doc, err := s.steeringDocumentRepo.GetByID(ctx, steeringDocumentID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrSteeringDocumentNotFound
@@ -390,14 +392,14 @@ func (s *steeringDocumentService) LinkSteeringDocumentToEpic(steeringDocumentID,
 	}
 
 	// Verify epic exists
-	if exists, err := s.epicRepo.Exists(epicID); err != nil {
+	if exists, err := s.epicRepo.Exists(ctx, epicID); err != nil {
 		return fmt.Errorf("failed to check epic existence: %w", err)
 	} else if !exists {
 		return ErrEpicNotFound
 	}
 
 	// Create the link
-	if err := s.steeringDocumentRepo.LinkToEpic(steeringDocumentID, epicID); err != nil {
+	if err := s.steeringDocumentRepo.LinkToEpic(ctx, steeringDocumentID, epicID); err != nil {
 		// Check if it's a "link already exists" error
 		if err.Error() == fmt.Sprintf("link already exists between steering document %s and epic %s", steeringDocumentID, epicID) {
 			return ErrLinkAlreadyExists
@@ -409,14 +411,14 @@ func (s *steeringDocumentService) LinkSteeringDocumentToEpic(steeringDocumentID,
 }
 
 // UnlinkSteeringDocumentFromEpic removes the link between a steering document and an epic
-func (s *steeringDocumentService) UnlinkSteeringDocumentFromEpic(steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
+func (s *steeringDocumentService) UnlinkSteeringDocumentFromEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID, currentUser *models.User) error {
 	// Authorization check: Only Administrator and User roles can unlink documents from epics
 	if !currentUser.CanEdit() {
 		return ErrUnauthorizedAccess
 	}
 
 	// Verify steering document exists
-	doc, err := s.steeringDocumentRepo.GetByID(steeringDocumentID)
+	doc, err := s.steeringDocumentRepo.GetByID(ctx, steeringDocumentID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrSteeringDocumentNotFound
@@ -430,7 +432,7 @@ func (s *steeringDocumentService) UnlinkSteeringDocumentFromEpic(steeringDocumen
 	}
 
 	// Verify epic exists (optional check, but good for consistency)
-	_, err = s.epicRepo.GetByID(epicID)
+	_, err = s.epicRepo.GetByID(ctx, epicID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrEpicNotFound
@@ -439,7 +441,7 @@ func (s *steeringDocumentService) UnlinkSteeringDocumentFromEpic(steeringDocumen
 	}
 
 	// Remove the link
-	if err := s.steeringDocumentRepo.UnlinkFromEpic(steeringDocumentID, epicID); err != nil {
+	if err := s.steeringDocumentRepo.UnlinkFromEpic(ctx, steeringDocumentID, epicID); err != nil {
 		return fmt.Errorf("failed to unlink steering document from epic: %w", err)
 	}
 
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -17,12 +18,12 @@ type MockSteeringDocumentRepository struct {
 	mock.Mock
 }
 
-func (m *MockSteeringDocumentRepository) Create(entity *models.SteeringDocument) error {
+func (m *MockSteeringDocumentRepository) Create(ctx context.Context, entity *models.SteeringDocument) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentRepository) GetByID(id uuid.UUID) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SteeringDocument, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -30,7 +31,7 @@ func (m *MockSteeringDocumentRepository) GetByID(id uuid.UUID) (*models.Steering
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) GetByReferenceID(referenceID string) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.SteeringDocument, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -38,7 +39,7 @@ func (m *MockSteeringDocumentRepository) GetByReferenceID(referenceID string) (*
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.SteeringDocument, error) {
+func (m *MockSteeringDocumentRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.SteeringDocument, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -46,67 +47,67 @@ func (m *MockSteeringDocumentRepository) GetByReferenceIDCaseInsensitive(referen
 	return args.Get(0).(*models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) Update(entity *models.SteeringDocument) error {
+func (m *MockSteeringDocumentRepository) Update(ctx context.Context, entity *models.SteeringDocument) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentRepository) Delete(id uuid.UUID) error {
+func (m *MockSteeringDocumentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentRepository) ListWithFilters(filters repository.SteeringDocumentFilters) ([]models.SteeringDocument, int64, error) {
+func (m *MockSteeringDocumentRepository) ListWithFilters(ctx context.Context, filters repository.SteeringDocumentFilters) ([]models.SteeringDocument, int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).([]models.SteeringDocument), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockSteeringDocumentRepository) Search(query string) ([]models.SteeringDocument, error) {
+func (m *MockSteeringDocumentRepository) Search(ctx context.Context, query string) ([]models.SteeringDocument, error) {
 	args := m.Called(query)
 	return args.Get(0).([]models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) GetByEpicID(epicID uuid.UUID) ([]models.SteeringDocument, error) {
+func (m *MockSteeringDocumentRepository) GetByEpicID(ctx context.Context, epicID uuid.UUID) ([]models.SteeringDocument, error) {
 	args := m.Called(epicID)
 	return args.Get(0).([]models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) GetByEpicIDWithPagination(epicID uuid.UUID, limit, offset int) ([]models.SteeringDocument, int64, error) {
+func (m *MockSteeringDocumentRepository) GetByEpicIDWithPagination(ctx context.Context, epicID uuid.UUID, limit, offset int) ([]models.SteeringDocument, int64, error) {
 	args := m.Called(epicID, limit, offset)
 	return args.Get(0).([]models.SteeringDocument), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockSteeringDocumentRepository) LinkToEpic(steeringDocumentID, epicID uuid.UUID) error {
+func (m *MockSteeringDocumentRepository) LinkToEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID) error {
 	args := m.Called(steeringDocumentID, epicID)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentRepository) UnlinkFromEpic(steeringDocumentID, epicID uuid.UUID) error {
+func (m *MockSteeringDocumentRepository) UnlinkFromEpic(ctx context.Context, steeringDocumentID, epicID uuid.UUID) error {
 	args := m.Called(steeringDocumentID, epicID)
 	return args.Error(0)
 }
 
-func (m *MockSteeringDocumentRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockSteeringDocumentRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.SteeringDocument, error) {
+func (m *MockSteeringDocumentRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.SteeringDocument, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.SteeringDocument), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockSteeringDocumentRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockSteeringDocumentRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockSteeringDocumentRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockSteeringDocumentRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -121,7 +122,7 @@ type MockSteeringUserRepository struct {
 	mock.Mock
 }
 
-func (m *MockSteeringUserRepository) GetByID(id uuid.UUID) (*models.User, error) {
+func (m *MockSteeringUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -129,114 +130,136 @@ func (m *MockSteeringUserRepository) GetByID(id uuid.UUID) (*models.User, error)
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
-func (m *MockSteeringUserRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockSteeringUserRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
 // Add other required methods as stubs
-func (m *MockSteeringUserRepository) Create(entity *models.User) error { return nil }
-func (m *MockSteeringUserRepository) GetByReferenceID(referenceID string) (*models.User, error) {
+func (m *MockSteeringUserRepository) Create(ctx context.Context, entity *models.User) error {
+	return nil
+}
+func (m *MockSteeringUserRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.User, error) {
 	return nil, nil
 }
 
-func (m *MockSteeringUserRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.User, error) {
+func (m *MockSteeringUserRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.User, error) {
 	return nil, nil
 }
-func (m *MockSteeringUserRepository) Update(entity *models.User) error { return nil }
-func (m *MockSteeringUserRepository) Delete(id uuid.UUID) error        { return nil }
-func (m *MockSteeringUserRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.User, error) {
+func (m *MockSteeringUserRepository) Update(ctx context.Context, entity *models.User) error {
+	return nil
+}
+func (m *MockSteeringUserRepository) Delete(ctx context.Context, id uuid.UUID) error { return nil }
+func (m *MockSteeringUserRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.User, error) {
 	return nil, nil
 }
-func (m *MockSteeringUserRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockSteeringUserRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	return 0, nil
 }
-func (m *MockSteeringUserRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockSteeringUserRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	return false, nil
 }
-func (m *MockSteeringUserRepository) WithTransaction(fn func(*gorm.DB) error) error { return nil }
-func (m *MockSteeringUserRepository) GetDB() *gorm.DB                               { return nil }
-func (m *MockSteeringUserRepository) GetByUsername(username string) (*models.User, error) {
+func (m *MockSteeringUserRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
+	return nil
+}
+func (m *MockSteeringUserRepository) GetDB() *gorm.DB { return nil }
+func (m *MockSteeringUserRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
 	return nil, nil
 }
-func (m *MockSteeringUserRepository) GetByEmail(email string) (*models.User, error) { return nil, nil }
-func (m *MockSteeringUserRepository) ExistsByUsername(username string) (bool, error) {
+func (m *MockSteeringUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	return nil, nil
+}
+func (m *MockSteeringUserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
+	return false, nil
+}
+func (m *MockSteeringUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	return false, nil
 }
-func (m *MockSteeringUserRepository) ExistsByEmail(email string) (bool, error) { return false, nil }
 
 // MockSteeringEpicRepository is a mock implementation of EpicRepository for steering document tests
 type MockSteeringEpicRepository struct {
 	mock.Mock
 }
 
-func (m *MockSteeringEpicRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockSteeringEpicRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
 // Add other required methods as stubs
-func (m *MockSteeringEpicRepository) Create(entity *models.Epic) error { return nil }
-func (m *MockSteeringEpicRepository) GetByID(id uuid.UUID) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) Create(ctx context.Context, entity *models.Epic) error {
+	return nil
+}
+func (m *MockSteeringEpicRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) GetByReferenceID(referenceID string) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.Epic, error) {
 	return nil, nil
 }
 
-func (m *MockSteeringEpicRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) Update(entity *models.Epic) error { return nil }
-func (m *MockSteeringEpicRepository) Delete(id uuid.UUID) error        { return nil }
-func (m *MockSteeringEpicRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Epic, error) {
+func (m *MockSteeringEpicRepository) Update(ctx context.Context, entity *models.Epic) error {
+	return nil
+}
+func (m *MockSteeringEpicRepository) Delete(ctx context.Context, id uuid.UUID) error { return nil }
+func (m *MockSteeringEpicRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockSteeringEpicRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	return 0, nil
 }
-func (m *MockSteeringEpicRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockSteeringEpicRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	return false, nil
 }
-func (m *MockSteeringEpicRepository) WithTransaction(fn func(*gorm.DB) error) error { return nil }
-func (m *MockSteeringEpicRepository) GetDB() *gorm.DB                               { return nil }
-func (m *MockSteeringEpicRepository) GetWithUserStories(id uuid.UUID) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
+	return nil
+}
+func (m *MockSteeringEpicRepository) GetDB() *gorm.DB { return nil }
+func (m *MockSteeringEpicRepository) GetWithUserStories(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	return nil, nil
 }
 func (m *MockSteeringEpicRepository) GetUserStoriesByEpicID(epicID uuid.UUID) ([]models.UserStory, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) GetByCreator(creatorID uuid.UUID) ([]models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) GetByStatus(status models.EpicStatus) ([]models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByStatus(ctx context.Context, status models.EpicStatus) ([]models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) GetByPriority(priority models.Priority) ([]models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) HasUserStories(id uuid.UUID) (bool, error) {
+func (m *MockSteeringEpicRepository) HasUserStories(ctx context.Context, id uuid.UUID) (bool, error) {
 	return false, nil
 }
-func (m *MockSteeringEpicRepository) GetByIDWithUsers(id uuid.UUID) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByIDWithUsers(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) GetByReferenceIDWithUsers(referenceID string) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByReferenceIDWithUsers(ctx context.Context, referenceID string) (*models.Epic, error) {
 	return nil, nil
 }
 
-func (m *MockSteeringEpicRepository) GetByReferenceIDWithUsersCaseInsensitive(referenceID string) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetByReferenceIDWithUsersCaseInsensitive(ctx context.Context, referenceID string) (*models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) ListWithIncludes(filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.Epic, error) {
+func (m *MockSteeringEpicRepository) ListWithIncludes(ctx context.Context, filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.Epic, error) {
 	return nil, nil
 }
-func (m *MockSteeringEpicRepository) GetCompleteHierarchy(id uuid.UUID) (*models.Epic, error) {
+func (m *MockSteeringEpicRepository) GetCompleteHierarchy(ctx context.Context, id uuid.UUID) (*models.Epic, error) {
 	return nil, nil
 }
+func (m *MockSteeringEpicRepository) LockForUpdate(ctx context.Context, ids ...uuid.UUID) ([]models.Epic, error) {
+	return nil, nil
+}
+func (m *MockSteeringEpicRepository) LockNesting(ctx context.Context) error {
+	return nil
+}
 
 func TestNewSteeringDocumentService(t *testing.T) {
 	mockRepo := &MockSteeringDocumentRepository{}
@@ -249,6 +272,7 @@ func TestNewSteeringDocumentService(t *testing.T) {
 }
 
 func TestSteeringDocumentService_CreateSteeringDocument_Success(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -282,7 +306,7 @@ func TestSteeringDocumentService_CreateSteeringDocument_Success(t *testing.T) {
 	}, nil)
 
 	// Execute
-	result, err := service.CreateSteeringDocument(req, user)
+	result, err := service.CreateSteeringDocument(ctx, req, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -294,6 +318,7 @@ func TestSteeringDocumentService_CreateSteeringDocument_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentService_CreateSteeringDocument_ValidationError(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -328,7 +353,7 @@ func TestSteeringDocumentService_CreateSteeringDocument_ValidationError(t *testi
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := service.CreateSteeringDocument(tt.request, user)
+			result, err := service.CreateSteeringDocument(ctx, tt.request, user)
 			assert.Error(t, err)
 			assert.Nil(t, result)
 			assert.Contains(t, err.Error(), tt.wantErr)
@@ -337,6 +362,7 @@ func TestSteeringDocumentService_CreateSteeringDocument_ValidationError(t *testi
 }
 
 func TestSteeringDocumentService_GetSteeringDocumentByID_Success(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -356,7 +382,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_Success(t *testing.T) {
 	mockRepo.On("GetByID", docID).Return(doc, nil)
 
 	// Execute
-	result, err := service.GetSteeringDocumentByID(docID, user)
+	result, err := service.GetSteeringDocumentByID(ctx, docID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -365,6 +391,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentService_GetSteeringDocumentByID_NotFound(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -377,7 +404,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_NotFound(t *testing.T)
 	mockRepo.On("GetByID", docID).Return(nil, repository.ErrNotFound)
 
 	// Execute
-	result, err := service.GetSteeringDocumentByID(docID, user)
+	result, err := service.GetSteeringDocumentByID(ctx, docID, user)
 
 	// Assert
 	assert.Error(t, err)
@@ -387,6 +414,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_NotFound(t *testing.T)
 }
 
 func TestSteeringDocumentService_GetSteeringDocumentByID_AccessDenied(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -400,7 +428,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_AccessDenied(t *testing
 	// No mock expectations needed - authorization check happens first
 
 	// Execute
-	result, err := service.GetSteeringDocumentByID(docID, user)
+	result, err := service.GetSteeringDocumentByID(ctx, docID, user)
 
 	// Assert
 	assert.Error(t, err)
@@ -409,6 +437,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_AccessDenied(t *testing
 }
 
 func TestSteeringDocumentService_GetSteeringDocumentByID_AdminAccess(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -429,7 +458,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_AdminAccess(t *testing.
 	mockRepo.On("GetByID", docID).Return(doc, nil)
 
 	// Execute
-	result, err := service.GetSteeringDocumentByID(docID, admin)
+	result, err := service.GetSteeringDocumentByID(ctx, docID, admin)
 
 	// Assert
 	assert.NoError(t, err)
@@ -438,6 +467,7 @@ func TestSteeringDocumentService_GetSteeringDocumentByID_AdminAccess(t *testing.
 }
 
 func TestSteeringDocumentService_UpdateSteeringDocument_Success(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -463,7 +493,7 @@ func TestSteeringDocumentService_UpdateSteeringDocument_Success(t *testing.T) {
 	mockRepo.On("Update", mock.AnythingOfType("*models.SteeringDocument")).Return(nil)
 
 	// Execute
-	result, err := service.UpdateSteeringDocument(docID, req, user)
+	result, err := service.UpdateSteeringDocument(ctx, docID, req, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -474,6 +504,7 @@ func TestSteeringDocumentService_UpdateSteeringDocument_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentService_UpdateSteeringDocument_AccessDenied(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -498,7 +529,7 @@ func TestSteeringDocumentService_UpdateSteeringDocument_AccessDenied(t *testing.
 	mockRepo.On("GetByID", docID).Return(doc, nil)
 
 	// Execute
-	result, err := service.UpdateSteeringDocument(docID, req, user)
+	result, err := service.UpdateSteeringDocument(ctx, docID, req, user)
 
 	// Assert
 	assert.Error(t, err)
@@ -508,6 +539,7 @@ func TestSteeringDocumentService_UpdateSteeringDocument_AccessDenied(t *testing.
 }
 
 func TestSteeringDocumentService_DeleteSteeringDocument_Success(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -528,7 +560,7 @@ func TestSteeringDocumentService_DeleteSteeringDocument_Success(t *testing.T) {
 	mockRepo.On("Delete", docID).Return(nil)
 
 	// Execute
-	err := service.DeleteSteeringDocument(docID, user)
+	err := service.DeleteSteeringDocument(ctx, docID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -536,6 +568,7 @@ func TestSteeringDocumentService_DeleteSteeringDocument_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentService_LinkSteeringDocumentToEpic_Success(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -559,7 +592,7 @@ func TestSteeringDocumentService_LinkSteeringDocumentToEpic_Success(t *testing.T
 	mockRepo.On("LinkToEpic", docID, epicID).Return(nil)
 
 	// Execute
-	err := service.LinkSteeringDocumentToEpic(docID, epicID, user)
+	err := service.LinkSteeringDocumentToEpic(ctx, docID, epicID, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -568,6 +601,7 @@ func TestSteeringDocumentService_LinkSteeringDocumentToEpic_Success(t *testing.T
 }
 
 func TestSteeringDocumentService_LinkSteeringDocumentToEpic_EpicNotFound(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -590,7 +624,7 @@ func TestSteeringDocumentService_LinkSteeringDocumentToEpic_EpicNotFound(t *test
 	mockEpicRepo.On("Exists", epicID).Return(false, nil)
 
 	// Execute
-	err := service.LinkSteeringDocumentToEpic(docID, epicID, user)
+	err := service.LinkSteeringDocumentToEpic(ctx, docID, epicID, user)
 
 	// Assert
 	assert.Error(t, err)
@@ -600,6 +634,7 @@ func TestSteeringDocumentService_LinkSteeringDocumentToEpic_EpicNotFound(t *test
 }
 
 func TestSteeringDocumentService_ListSteeringDocuments_Success(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -628,7 +663,7 @@ func TestSteeringDocumentService_ListSteeringDocuments_Success(t *testing.T) {
 	mockRepo.On("ListWithFilters", expectedRepoFilters).Return(docs, int64(2), nil)
 
 	// Execute
-	result, total, err := service.ListSteeringDocuments(filters, user)
+	result, total, err := service.ListSteeringDocuments(ctx, filters, user)
 
 	// Assert
 	assert.NoError(t, err)
@@ -638,6 +673,7 @@ func TestSteeringDocumentService_ListSteeringDocuments_Success(t *testing.T) {
 }
 
 func TestSteeringDocumentService_ListSteeringDocuments_AdminAccess(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := &MockSteeringDocumentRepository{}
 	mockUserRepo := &MockSteeringUserRepository{}
 	mockEpicRepo := &MockSteeringEpicRepository{}
@@ -666,7 +702,7 @@ func TestSteeringDocumentService_ListSteeringDocuments_AdminAccess(t *testing.T)
 	mockRepo.On("ListWithFilters", expectedRepoFilters).Return(docs, int64(2), nil)
 
 	// Execute
-	result, total, err := service.ListSteeringDocuments(filters, admin)
+	result, total, err := service.ListSteeringDocuments(ctx, filters, admin)
 
 	// Assert
 	assert.NoError(t, err)
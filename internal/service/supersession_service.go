@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrRequirementNotObsolete  = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "only obsolete requirements can be superseded")
+	ErrSelfSupersession        = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "a requirement cannot supersede itself")
+	ErrCircularSupersession    = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "supersession would create a cycle")
+	ErrSupersedingNotFound     = apperrors.New(apperrors.KindNotFound, "SUPERSEDING_REQUIREMENT_NOT_FOUND", "superseding requirement not found")
+	ErrSupersedingObsolete     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "superseding requirement is obsolete")
+	ErrSplitObsolete           = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "obsolete requirements cannot be split")
+	ErrSplitUnknownLink        = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "carried over relationship does not belong to the split requirement")
+	errSupersessionChainBroken = errors.New("supersession chain contains a cycle")
+)
+
+// SupersessionService defines the interface for recording and following requirement supersession
+type SupersessionService interface {
+	ResolveSuccessor(ctx context.Context, requirementID uuid.UUID, successorIDOrRef string) (uuid.UUID, error)
+	SetSupersededBy(ctx context.Context, requirementIDOrRef string, successorIDOrRef *string) (*models.Requirement, error)
+	GetChain(ctx context.Context, requirementIDOrRef string) (*SupersessionChain, error)
+	Split(ctx context.Context, requirementIDOrRef string, req SplitRequirementRequest, userID uuid.UUID) (*RequirementSplit, error)
+}
+
+// SetSupersessionRequest represents the request to record which requirement supersedes an obsolete one
+// @Description Superseding requirement of an obsolete requirement; null clears it
+type SetSupersessionRequest struct {
+	SupersededBy *string `json:"superseded_by" example:"REQ-010"` // UUID or reference ID
+}
+
+// SupersessionEntry is a requirement of a supersession chain
+// @Description Requirement of a supersession chain
+type SupersessionEntry struct {
+	ID          uuid.UUID                `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReferenceID string                   `json:"reference_id" example:"REQ-001"`
+	Title       string                   `json:"title" example:"Passwords must be at least 8 characters"`
+	Status      models.RequirementStatus `json:"status" example:"Obsolete"`
+}
+
+// SupersessionChain is the supersession history around a requirement
+// @Description Requirements superseded by a requirement and the requirements superseding it.
+// @Description current is the requirement in effect at the end of the chain.
+type SupersessionChain struct {
+	Requirement  SupersessionEntry   `json:"requirement"`
+	Supersedes   []SupersessionEntry `json:"supersedes"`    // Requirements this one replaced, nearest first
+	SupersededBy []SupersessionEntry `json:"superseded_by"` // Requirements replacing this one, nearest first
+	Current      SupersessionEntry   `json:"current"`
+}
+
+// supersessionService implements SupersessionService interface
+type supersessionService struct {
+	repos *repository.Repositories
+}
+
+// NewSupersessionService creates a new supersession service instance
+func NewSupersessionService(repos *repository.Repositories) SupersessionService {
+	return &supersessionService{repos: repos}
+}
+
+// ResolveSuccessor resolves the requirement that would supersede a requirement,
+// rejecting the requirement itself, obsolete successors and successors whose own chain leads back to it
+func (s *supersessionService) ResolveSuccessor(ctx context.Context, requirementID uuid.UUID, successorIDOrRef string) (uuid.UUID, error) {
+	successorID, err := resolveEntityID(ctx, s.repos, models.EntityTypeRequirement, successorIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return uuid.Nil, ErrSupersedingNotFound
+		}
+		return uuid.Nil, err
+	}
+	if successorID == requirementID {
+		return uuid.Nil, ErrSelfSupersession
+	}
+
+	successor, err := s.repos.Requirement.GetByID(ctx, successorID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get superseding requirement: %w", err)
+	}
+	// An obsolete successor is fine as long as its chain leads on to a requirement in effect
+	if successor.Status == models.RequirementStatusObsolete && successor.SupersededByID == nil {
+		return uuid.Nil, ErrSupersedingObsolete
+	}
+
+	successors, err := s.successors(ctx, successor)
+	if err != nil && !errors.Is(err, errSupersessionChainBroken) {
+		return uuid.Nil, err
+	}
+	for _, entry := range successors {
+		if entry.ID == requirementID {
+			return uuid.Nil, ErrCircularSupersession
+		}
+	}
+	return successorID, nil
+}
+
+// SetSupersededBy records the requirement superseding an obsolete requirement, or clears it when successorIDOrRef is nil
+func (s *supersessionService) SetSupersededBy(ctx context.Context, requirementIDOrRef string, successorIDOrRef *string) (*models.Requirement, error) {
+	requirement, err := s.getRequirement(ctx, requirementIDOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if successorIDOrRef == nil || *successorIDOrRef == "" {
+		requirement.SupersededByID = nil
+	} else {
+		if requirement.Status != models.RequirementStatusObsolete {
+			return nil, ErrRequirementNotObsolete
+		}
+		successorID, err := s.ResolveSuccessor(ctx, requirement.ID, *successorIDOrRef)
+		if err != nil {
+			return nil, err
+		}
+		requirement.SupersededByID = &successorID
+	}
+
+	if err := s.repos.Requirement.Update(ctx, requirement); err != nil {
+		return nil, fmt.Errorf("failed to update requirement: %w", err)
+	}
+	return requirement, nil
+}
+
+// GetChain returns the requirements a requirement superseded, directly or transitively,
+// and the chain of requirements superseding it
+func (s *supersessionService) GetChain(ctx context.Context, requirementIDOrRef string) (*SupersessionChain, error) {
+	requirement, err := s.getRequirement(ctx, requirementIDOrRef)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &SupersessionChain{
+		Requirement: newSupersessionEntry(requirement),
+		Supersedes:  []SupersessionEntry{},
+	}
+	if chain.SupersededBy, err = s.successors(ctx, requirement); err != nil && !errors.Is(err, errSupersessionChainBroken) {
+		return nil, err
+	}
+	chain.Current = chain.Requirement
+	if len(chain.SupersededBy) > 0 {
+		chain.Current = chain.SupersededBy[len(chain.SupersededBy)-1]
+	}
+
+	visited := map[uuid.UUID]bool{requirement.ID: true}
+	for _, entry := range chain.SupersededBy {
+		visited[entry.ID] = true
+	}
+	queue := []uuid.UUID{requirement.ID}
+	for len(queue) > 0 {
+		predecessors, err := s.repos.Requirement.List(ctx, map[string]interface{}{"superseded_by_id": queue[0]}, "reference_id ASC", 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list superseded requirements: %w", err)
+		}
+		queue = queue[1:]
+		for i := range predecessors {
+			if visited[predecessors[i].ID] {
+				continue
+			}
+			visited[predecessors[i].ID] = true
+			chain.Supersedes = append(chain.Supersedes, newSupersessionEntry(&predecessors[i]))
+			queue = append(queue, predecessors[i].ID)
+		}
+	}
+
+	return chain, nil
+}
+
+// successors follows superseded_by links from a requirement, nearest first.
+// Chains are acyclic when written through this service; a cycle ends the walk with errSupersessionChainBroken.
+func (s *supersessionService) successors(ctx context.Context, requirement *models.Requirement) ([]SupersessionEntry, error) {
+	successors := []SupersessionEntry{}
+	visited := map[uuid.UUID]bool{requirement.ID: true}
+	for next := requirement.SupersededByID; next != nil; {
+		if visited[*next] {
+			return successors, errSupersessionChainBroken
+		}
+		visited[*next] = true
+
+		successor, err := s.repos.Requirement.GetByID(ctx, *next)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				break
+			}
+			return nil, fmt.Errorf("failed to get superseding requirement: %w", err)
+		}
+		successors = append(successors, newSupersessionEntry(successor))
+		next = successor.SupersededByID
+	}
+	return successors, nil
+}
+
+// getRequirement loads a requirement by UUID or reference ID
+func (s *supersessionService) getRequirement(ctx context.Context, idOrRef string) (*models.Requirement, error) {
+	id, err := resolveEntityID(ctx, s.repos, models.EntityTypeRequirement, idOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrRequirementNotFound
+		}
+		return nil, err
+	}
+	requirement, err := s.repos.Requirement.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrRequirementNotFound
+		}
+		return nil, fmt.Errorf("failed to get requirement: %w", err)
+	}
+	return requirement, nil
+}
+
+// newSupersessionEntry summarizes a requirement for a supersession chain
+func newSupersessionEntry(requirement *models.Requirement) SupersessionEntry {
+	return SupersessionEntry{
+		ID:          requirement.ID,
+		ReferenceID: requirement.ReferenceID,
+		Title:       requirement.Title,
+		Status:      requirement.Status,
+	}
+}
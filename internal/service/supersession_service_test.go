@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// setupSupersessionTest creates an epic with one user story holding the given requirements
+func setupSupersessionTest(t *testing.T, statuses ...models.RequirementStatus) (*gorm.DB, *models.User, *models.Epic, []models.Requirement) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.Epic{}, &models.UserStory{}, &models.AcceptanceCriteria{},
+		&models.Requirement{}, &models.RequirementAcceptanceCriteria{}, &models.RelationshipType{}, &models.RequirementRelationship{}, &models.EntityRelationship{}))
+	for _, relationshipType := range models.GetDefaultRelationshipTypes() {
+		require.NoError(t, db.Create(&relationshipType).Error)
+	}
+
+	user := &models.User{Username: "alice", Email: "alice@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	epic := &models.Epic{ID: uuid.New(), ReferenceID: "EP-001", Title: "Accounts", Status: models.EpicStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(epic).Error)
+	story := &models.UserStory{ID: uuid.New(), ReferenceID: "US-001", EpicID: epic.ID, Title: "Sign up", Status: models.UserStoryStatusBacklog, CreatorID: user.ID, AssigneeID: user.ID}
+	require.NoError(t, session.Create(story).Error)
+
+	requirements := make([]models.Requirement, len(statuses))
+	for i, status := range statuses {
+		requirements[i] = models.Requirement{
+			ID:          uuid.New(),
+			ReferenceID: "REQ-00" + string(rune('1'+i)),
+			UserStoryID: story.ID,
+			Title:       "Password rule " + string(rune('A'+i)),
+			Status:      status,
+			CreatorID:   user.ID,
+			AssigneeID:  user.ID,
+		}
+		require.NoError(t, session.Create(&requirements[i]).Error)
+	}
+	return db, user, epic, requirements
+}
+
+func TestSupersessionService(t *testing.T) {
+	ctx := context.Background()
+	obsolete, active := models.RequirementStatusObsolete, models.RequirementStatusActive
+	db, _, _, requirements := setupSupersessionTest(t, obsolete, obsolete, active, active)
+	svc := NewSupersessionService(repository.NewRepositories(db, nil))
+
+	t.Run("records a supersession chain", func(t *testing.T) {
+		_, err := svc.SetSupersededBy(ctx, "REQ-002", stringPtr("REQ-003"))
+		require.NoError(t, err)
+		requirement, err := svc.SetSupersededBy(ctx, "REQ-001", stringPtr("REQ-002"))
+		require.NoError(t, err)
+		require.NotNil(t, requirement.SupersededByID)
+		assert.Equal(t, requirements[1].ID, *requirement.SupersededByID)
+
+		chain, err := svc.GetChain(ctx, "REQ-001")
+		require.NoError(t, err)
+		assert.Empty(t, chain.Supersedes)
+		require.Len(t, chain.SupersededBy, 2)
+		assert.Equal(t, "REQ-002", chain.SupersededBy[0].ReferenceID)
+		assert.Equal(t, "REQ-003", chain.Current.ReferenceID)
+
+		chain, err = svc.GetChain(ctx, "REQ-003")
+		require.NoError(t, err)
+		assert.Empty(t, chain.SupersededBy)
+		assert.Equal(t, "REQ-003", chain.Current.ReferenceID)
+		require.Len(t, chain.Supersedes, 2)
+		assert.Equal(t, "REQ-002", chain.Supersedes[0].ReferenceID)
+		assert.Equal(t, "REQ-001", chain.Supersedes[1].ReferenceID)
+	})
+
+	t.Run("rejects invalid supersession", func(t *testing.T) {
+		_, err := svc.SetSupersededBy(ctx, "REQ-003", stringPtr("REQ-004"))
+		assert.ErrorIs(t, err, ErrRequirementNotObsolete)
+
+		_, err = svc.SetSupersededBy(ctx, "REQ-001", stringPtr("REQ-001"))
+		assert.ErrorIs(t, err, ErrSelfSupersession)
+
+		_, err = svc.SetSupersededBy(ctx, "REQ-001", stringPtr("REQ-404"))
+		assert.ErrorIs(t, err, ErrSupersedingNotFound)
+
+		// REQ-001 is superseded by REQ-002, so it cannot supersede REQ-002 in turn
+		_, err = svc.ResolveSuccessor(ctx, requirements[1].ID, "REQ-001")
+		assert.ErrorIs(t, err, ErrCircularSupersession)
+
+		_, err = svc.GetChain(ctx, "REQ-404")
+		assert.ErrorIs(t, err, ErrRequirementNotFound)
+	})
+
+	t.Run("clears the superseding requirement", func(t *testing.T) {
+		requirement, err := svc.SetSupersededBy(ctx, "REQ-001", nil)
+		require.NoError(t, err)
+		assert.Nil(t, requirement.SupersededByID)
+
+		_, err = svc.SetSupersededBy(ctx, "REQ-001", stringPtr("REQ-001"))
+		assert.ErrorIs(t, err, ErrSelfSupersession)
+		// REQ-001 is obsolete and no longer superseded, so it cannot supersede anything
+		_, err = svc.ResolveSuccessor(ctx, requirements[1].ID, "REQ-001")
+		assert.ErrorIs(t, err, ErrSupersedingObsolete)
+	})
+}
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Test result import formats
+const (
+	TestResultsFormatJUnit = "junit"
+	TestResultsFormatJSON  = "json"
+)
+
+// DefaultTestRunName names imported runs that were not given a name
+const DefaultTestRunName = "Imported test results"
+
+var (
+	ErrInvalidTestResultsFormat = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "format must be one of: junit, json")
+	ErrInvalidTestResults       = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid test results")
+	ErrInvalidTestRunName       = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "test run name must be at most 255 characters")
+	ErrTestRunNotFound          = apperrors.New(apperrors.KindNotFound, "TEST_RUN_NOT_FOUND", "test run not found")
+	ErrVerificationNotFound     = apperrors.New(apperrors.KindNotFound, "VERIFICATION_NOT_FOUND", "no test results imported for the entity")
+)
+
+// testReferencePattern matches the acceptance criteria and requirement references named by a test
+var testReferencePattern = regexp.MustCompile(`(?i)\b(AC|REQ)-\d+\b`)
+
+// TestResultService defines the interface for importing CI test results as verification of
+// acceptance criteria and requirements
+type TestResultService interface {
+	ImportResults(ctx context.Context, req ImportTestResultsRequest, importerID uuid.UUID, now time.Time) (*TestResultsImport, error)
+	GetRun(ctx context.Context, id uuid.UUID) (*models.TestRun, error)
+	ListRuns(ctx context.Context, limit, offset int) ([]models.TestRun, int64, error)
+	GetVerification(ctx context.Context, entityType models.EntityType, idOrReference string) (*models.EntityVerification, error)
+}
+
+// ImportTestResultsRequest is a test report with the metadata of its run
+type ImportTestResultsRequest struct {
+	Format    string
+	Content   []byte
+	Name      string
+	CommitSHA string
+	Branch    string
+	BuildURL  string
+}
+
+// TestResultsImport is an imported test run with the references that matched no entity
+// @Description Imported test run with its results and the references that matched no acceptance criteria or requirement
+type TestResultsImport struct {
+	models.TestRun
+	UnknownReferences []string `json:"unknown_references"` // References named by tests that do not exist
+}
+
+// testOutcome is a test case of a report with the references it names
+type testOutcome struct {
+	name       string
+	status     models.TestResultStatus
+	message    string
+	references []string
+}
+
+// testResultService implements TestResultService interface
+type testResultService struct {
+	repos *repository.Repositories
+}
+
+// NewTestResultService creates a new test result service instance
+func NewTestResultService(repos *repository.Repositories) TestResultService {
+	return &testResultService{repos: repos}
+}
+
+// ImportResults records a test run and sets the verification state of the acceptance criteria and
+// requirements its tests reference: failed when one of their tests failed, verified when their tests
+// passed. Entities with only skipped tests keep their state.
+func (s *testResultService) ImportResults(ctx context.Context, req ImportTestResultsRequest, importerID uuid.UUID, now time.Time) (*TestResultsImport, error) {
+	var (
+		outcomes []testOutcome
+		err      error
+	)
+	switch req.Format {
+	case TestResultsFormatJUnit:
+		outcomes, err = parseJUnitResults(req.Content)
+	case TestResultsFormatJSON:
+		outcomes, err = parseJSONResults(req.Content)
+	default:
+		return nil, ErrInvalidTestResultsFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(outcomes) == 0 {
+		return nil, fmt.Errorf("%w: the report has no test cases", ErrInvalidTestResults)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = DefaultTestRunName
+	}
+	if len(name) > 255 {
+		return nil, ErrInvalidTestRunName
+	}
+
+	run := &models.TestRun{
+		ID:         uuid.New(),
+		Name:       name,
+		Format:     req.Format,
+		CommitSHA:  optionalTestRunText(req.CommitSHA),
+		Branch:     optionalTestRunText(req.Branch),
+		BuildURL:   optionalTestRunText(req.BuildURL),
+		ImportedBy: importerID,
+		ImportedAt: now.UTC(),
+	}
+	unknown := make(map[string]bool)
+	for _, outcome := range outcomes {
+		matched := false
+		for _, reference := range outcome.references {
+			entityType, entityID, err := s.resolveTestReference(ctx, reference)
+			if err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					unknown[reference] = true
+					continue
+				}
+				return nil, err
+			}
+			matched = true
+			run.Results = append(run.Results, models.TestResult{
+				TestRunID:   run.ID,
+				EntityType:  entityType,
+				EntityID:    entityID,
+				ReferenceID: reference,
+				TestName:    outcome.name,
+				Status:      outcome.status,
+				Message:     outcome.message,
+			})
+			switch outcome.status {
+			case models.TestResultPassed:
+				run.Passed++
+			case models.TestResultFailed:
+				run.Failed++
+			default:
+				run.Skipped++
+			}
+		}
+		if !matched {
+			run.Unmatched++
+		}
+	}
+
+	verifications := testVerifications(run)
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		if err := tx.TestRun.Create(ctx, run); err != nil {
+			return fmt.Errorf("failed to create test run: %w", err)
+		}
+		for i := range verifications {
+			if err := tx.TestRun.UpsertVerification(ctx, &verifications[i]); err != nil {
+				return fmt.Errorf("failed to update verification: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TestResultsImport{TestRun: *run, UnknownReferences: make([]string, 0, len(unknown))}
+	for reference := range unknown {
+		result.UnknownReferences = append(result.UnknownReferences, reference)
+	}
+	sort.Strings(result.UnknownReferences)
+	return result, nil
+}
+
+// GetRun retrieves an imported test run with its results
+func (s *testResultService) GetRun(ctx context.Context, id uuid.UUID) (*models.TestRun, error) {
+	run, err := s.repos.TestRun.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrTestRunNotFound
+		}
+		return nil, fmt.Errorf("failed to get test run: %w", err)
+	}
+	return run, nil
+}
+
+// ListRuns retrieves imported test runs without their results, newest first
+func (s *testResultService) ListRuns(ctx context.Context, limit, offset int) ([]models.TestRun, int64, error) {
+	runs, total, err := s.repos.TestRun.List(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list test runs: %w", err)
+	}
+	return runs, total, nil
+}
+
+// GetVerification retrieves the verification state of an acceptance criteria or requirement
+func (s *testResultService) GetVerification(ctx context.Context, entityType models.EntityType, idOrReference string) (*models.EntityVerification, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := s.repos.TestRun.GetVerification(ctx, entityType, entityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+	return verification, nil
+}
+
+// resolveTestReference finds the acceptance criteria or requirement a test reference names
+func (s *testResultService) resolveTestReference(ctx context.Context, reference string) (models.EntityType, uuid.UUID, error) {
+	if strings.HasPrefix(reference, "AC-") {
+		ac, err := s.repos.AcceptanceCriteria.GetByReferenceID(ctx, reference)
+		if err != nil {
+			return "", uuid.Nil, err
+		}
+		return models.EntityTypeAcceptanceCriteria, ac.ID, nil
+	}
+	requirement, err := s.repos.Requirement.GetByReferenceID(ctx, reference)
+	if err != nil {
+		return "", uuid.Nil, err
+	}
+	return models.EntityTypeRequirement, requirement.ID, nil
+}
+
+// optionalTestRunText returns nil for empty run metadata
+func optionalTestRunText(value string) *string {
+	if value = strings.TrimSpace(value); value == "" {
+		return nil
+	}
+	return &value
+}
+
+// testVerifications derives the verification state of each entity with a passed or failed result in a run
+func testVerifications(run *models.TestRun) []models.EntityVerification {
+	var verifications []models.EntityVerification
+	index := make(map[uuid.UUID]int)
+	for _, result := range run.Results {
+		if result.Status == models.TestResultSkipped {
+			continue
+		}
+		i, ok := index[result.EntityID]
+		if !ok {
+			i = len(verifications)
+			index[result.EntityID] = i
+			verifications = append(verifications, models.EntityVerification{
+				EntityType: result.EntityType,
+				EntityID:   result.EntityID,
+				Status:     models.VerificationVerified,
+				TestRunID:  run.ID,
+				VerifiedAt: run.ImportedAt,
+			})
+		}
+		if result.Status == models.TestResultFailed {
+			verifications[i].Status = models.VerificationFailed
+		}
+	}
+	return verifications
+}
+
+// junitTestSuite is a <testsuites> or <testsuite> element; suites may be nested
+type junitTestSuite struct {
+	Suites []junitTestSuite `xml:"testsuite"`
+	Cases  []junitTestCase  `xml:"testcase"`
+}
+
+// junitTestCase is a <testcase> element
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Failure    *junitMessage   `xml:"failure"`
+	Error      *junitMessage   `xml:"error"`
+	Skipped    *junitMessage   `xml:"skipped"`
+	Properties []junitProperty `xml:"properties>property"`
+}
+
+// junitMessage is a <failure>, <error> or <skipped> element
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitProperty is a <property> of a test case, e.g. <property name="requirement" value="REQ-001"/>
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// parseJUnitResults reads the test cases of a JUnit XML report with the references in their class
+// names, names and property values
+func parseJUnitResults(content []byte) ([]testOutcome, error) {
+	var root junitTestSuite
+	if err := xml.NewDecoder(bytes.NewReader(content)).Decode(&root); err != nil {
+		return nil, fmt.Errorf("%w: malformed JUnit XML: %v", ErrInvalidTestResults, err)
+	}
+
+	var outcomes []testOutcome
+	var collect func(suite junitTestSuite)
+	collect = func(suite junitTestSuite) {
+		for _, testCase := range suite.Cases {
+			outcome := testOutcome{name: testCase.Name, status: models.TestResultPassed}
+			if testCase.ClassName != "" {
+				outcome.name = testCase.ClassName + "." + testCase.Name
+			}
+			switch {
+			case testCase.Failure != nil:
+				outcome.status, outcome.message = models.TestResultFailed, testCase.Failure.text()
+			case testCase.Error != nil:
+				outcome.status, outcome.message = models.TestResultFailed, testCase.Error.text()
+			case testCase.Skipped != nil:
+				outcome.status, outcome.message = models.TestResultSkipped, testCase.Skipped.text()
+			}
+
+			texts := []string{testCase.ClassName, testCase.Name}
+			for _, property := range testCase.Properties {
+				texts = append(texts, property.Value)
+			}
+			outcome.references = testReferences(texts...)
+			outcomes = append(outcomes, outcome)
+		}
+		for _, nested := range suite.Suites {
+			collect(nested)
+		}
+	}
+	collect(root)
+	return outcomes, nil
+}
+
+// text is the message attribute of a JUnit outcome, or its body when there is none
+func (m *junitMessage) text() string {
+	if message := strings.TrimSpace(m.Message); message != "" {
+		return message
+	}
+	return strings.TrimSpace(m.Text)
+}
+
+// parseJSONResults reads a JSON object mapping references to pass, fail or skip
+func parseJSONResults(content []byte) ([]testOutcome, error) {
+	var results map[string]string
+	if err := json.Unmarshal(content, &results); err != nil {
+		return nil, fmt.Errorf("%w: expected an object mapping references to pass or fail: %v", ErrInvalidTestResults, err)
+	}
+
+	outcomes := make([]testOutcome, 0, len(results))
+	for key, value := range results {
+		outcome := testOutcome{name: key, references: testReferences(key)}
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "pass", "passed":
+			outcome.status = models.TestResultPassed
+		case "fail", "failed":
+			outcome.status = models.TestResultFailed
+		case "skip", "skipped":
+			outcome.status = models.TestResultSkipped
+		default:
+			return nil, fmt.Errorf("%w: result of %s must be pass, fail or skip", ErrInvalidTestResults, key)
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		return outcomes[i].name < outcomes[j].name
+	})
+	return outcomes, nil
+}
+
+// testReferences returns the distinct upper-cased references named in texts, in order of appearance
+func testReferences(texts ...string) []string {
+	var references []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, match := range testReferencePattern.FindAllString(text, -1) {
+			reference := strings.ToUpper(match)
+			if !seen[reference] {
+				seen[reference] = true
+				references = append(references, reference)
+			}
+		}
+	}
+	return references
+}
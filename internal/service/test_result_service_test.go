@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+const testJUnitReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="checkout">
+  <testsuite name="SignUpTest">
+    <testcase classname="SignUpTest" name="AC-001 creates the account"/>
+    <testcase classname="SignUpTest" name="ac-001 rejects taken emails">
+      <failure message="expected 409, got 201">stack trace</failure>
+    </testcase>
+    <testsuite name="PasswordTest">
+      <testcase classname="PasswordTest" name="enforces the length">
+        <properties><property name="requirement" value="REQ-001"/></properties>
+      </testcase>
+      <testcase classname="PasswordTest" name="REQ-002 is pending"><skipped/></testcase>
+      <testcase classname="PasswordTest" name="REQ-404 does not exist"/>
+      <testcase classname="PasswordTest" name="has no reference"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+
+func TestTestResultService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, user, _, requirements := setupSupersessionTest(t, active, active)
+	require.NoError(t, db.AutoMigrate(&models.TestRun{}, &models.TestResult{}, &models.EntityVerification{}))
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	ac := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: requirements[0].UserStoryID,
+		AuthorID: user.ID, Description: "WHEN the form is submitted THEN the system SHALL create an account"}
+	require.NoError(t, session.Create(ac).Error)
+
+	svc := NewTestResultService(repository.NewRepositories(db, nil))
+	now := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("validates the report", func(t *testing.T) {
+		_, err := svc.ImportResults(ctx, ImportTestResultsRequest{Format: "tap", Content: []byte("ok 1")}, user.ID, now)
+		assert.ErrorIs(t, err, ErrInvalidTestResultsFormat)
+		_, err = svc.ImportResults(ctx, ImportTestResultsRequest{Format: TestResultsFormatJUnit, Content: []byte("<testsuite>")}, user.ID, now)
+		assert.ErrorIs(t, err, ErrInvalidTestResults)
+		_, err = svc.ImportResults(ctx, ImportTestResultsRequest{Format: TestResultsFormatJUnit, Content: []byte("<testsuites/>")}, user.ID, now)
+		assert.ErrorIs(t, err, ErrInvalidTestResults)
+		_, err = svc.ImportResults(ctx, ImportTestResultsRequest{Format: TestResultsFormatJSON, Content: []byte(`{"AC-001": "green"}`)}, user.ID, now)
+		assert.ErrorIs(t, err, ErrInvalidTestResults)
+
+		_, err = svc.GetVerification(ctx, models.EntityTypeAcceptanceCriteria, "AC-001")
+		assert.ErrorIs(t, err, ErrVerificationNotFound)
+	})
+
+	var runID uuid.UUID
+	t.Run("imports JUnit reports", func(t *testing.T) {
+		imported, err := svc.ImportResults(ctx, ImportTestResultsRequest{
+			Format: TestResultsFormatJUnit, Content: []byte(testJUnitReport), Name: "checkout #12", CommitSHA: "abc123", Branch: "main",
+		}, user.ID, now)
+		require.NoError(t, err)
+		runID = imported.ID
+		assert.Equal(t, "checkout #12", imported.Name)
+		assert.Equal(t, "abc123", *imported.CommitSHA)
+		assert.Nil(t, imported.BuildURL)
+		assert.Equal(t, 2, imported.Passed)
+		assert.Equal(t, 1, imported.Failed)
+		assert.Equal(t, 1, imported.Skipped)
+		assert.Equal(t, 2, imported.Unmatched)
+		assert.Equal(t, []string{"REQ-404"}, imported.UnknownReferences)
+
+		run, err := svc.GetRun(ctx, runID)
+		require.NoError(t, err)
+		require.Len(t, run.Results, 4)
+		assert.Equal(t, "AC-001", run.Results[0].ReferenceID)
+		assert.Equal(t, "SignUpTest.AC-001 creates the account", run.Results[0].TestName)
+		assert.Equal(t, models.TestResultFailed, run.Results[1].Status)
+		assert.Equal(t, "expected 409, got 201", run.Results[1].Message)
+		assert.Equal(t, models.EntityTypeRequirement, run.Results[2].EntityType)
+		assert.Equal(t, "alice", run.Importer.Username)
+	})
+
+	t.Run("sets the verification state", func(t *testing.T) {
+		verification, err := svc.GetVerification(ctx, models.EntityTypeAcceptanceCriteria, "AC-001")
+		require.NoError(t, err)
+		assert.Equal(t, models.VerificationFailed, verification.Status)
+		assert.Equal(t, runID, verification.TestRunID)
+		assert.Equal(t, "checkout #12", verification.TestRun.Name)
+
+		verification, err = svc.GetVerification(ctx, models.EntityTypeRequirement, "REQ-001")
+		require.NoError(t, err)
+		assert.Equal(t, models.VerificationVerified, verification.Status)
+
+		// Only skipped tests reference REQ-002
+		_, err = svc.GetVerification(ctx, models.EntityTypeRequirement, requirements[1].ID.String())
+		assert.ErrorIs(t, err, ErrVerificationNotFound)
+	})
+
+	t.Run("later runs replace the verification state", func(t *testing.T) {
+		imported, err := svc.ImportResults(ctx, ImportTestResultsRequest{
+			Format: TestResultsFormatJSON, Content: []byte(`{"AC-001": "pass", "req-001": "FAILED", "REQ-002": "skip"}`),
+		}, user.ID, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, DefaultTestRunName, imported.Name)
+		assert.Equal(t, TestResultsFormatJSON, imported.Format)
+		assert.Empty(t, imported.UnknownReferences)
+
+		verification, err := svc.GetVerification(ctx, models.EntityTypeAcceptanceCriteria, "AC-001")
+		require.NoError(t, err)
+		assert.Equal(t, models.VerificationVerified, verification.Status)
+		assert.Equal(t, imported.ID, verification.TestRunID)
+		verification, err = svc.GetVerification(ctx, models.EntityTypeRequirement, "REQ-001")
+		require.NoError(t, err)
+		assert.Equal(t, models.VerificationFailed, verification.Status)
+
+		runs, total, err := svc.ListRuns(ctx, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, imported.ID, runs[0].ID)
+		assert.Empty(t, runs[0].Results)
+	})
+
+	t.Run("unknown runs", func(t *testing.T) {
+		_, err := svc.GetRun(ctx, uuid.New())
+		assert.ErrorIs(t, err, ErrTestRunNotFound)
+	})
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DiffOperation identifies how a diff segment changed between two texts
+type DiffOperation string
+
+const (
+	DiffOperationEqual  DiffOperation = "equal"
+	DiffOperationInsert DiffOperation = "insert"
+	DiffOperationDelete DiffOperation = "delete"
+)
+
+// maxDiffEdits bounds the work spent on a word diff; texts that differ by more
+// edits than this are reported as a whole-text replacement
+const maxDiffEdits = 2000
+
+// DiffSegment represents a run of text that is unchanged, inserted or deleted
+// @Description Contiguous run of text annotated with how it changed
+type DiffSegment struct {
+	// Operation is how the text changed: equal, insert or delete
+	// @Description Change annotation for this run of text
+	// @Enum equal,insert,delete
+	// @Example "insert"
+	Operation DiffOperation `json:"operation" example:"insert"`
+
+	// Text is the run of text, including whitespace
+	// @Description Text of this run, whitespace included so concatenating equal and insert (or equal and delete) segments reproduces the new (or old) text
+	// @Example "SAML "
+	Text string `json:"text" example:"SAML "`
+}
+
+// DiffWords computes a word-level diff between two texts
+// Words, whitespace runs and punctuation characters are compared as separate tokens
+func DiffWords(oldText, newText string) []DiffSegment {
+	return diffTokens(tokenizeWords(oldText), tokenizeWords(newText))
+}
+
+// CountDiffWords counts the inserted and deleted words in a diff, ignoring whitespace
+func CountDiffWords(segments []DiffSegment) (inserted, deleted int) {
+	for _, segment := range segments {
+		words := len(strings.Fields(segment.Text))
+		switch segment.Operation {
+		case DiffOperationInsert:
+			inserted += words
+		case DiffOperationDelete:
+			deleted += words
+		}
+	}
+	return inserted, deleted
+}
+
+// tokenizeWords splits text into word, whitespace and punctuation tokens
+func tokenizeWords(text string) []string {
+	var tokens []string
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		end := start + 1
+		switch {
+		case isWordRune(runes[start]):
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+		case unicode.IsSpace(runes[start]):
+			for end < len(runes) && unicode.IsSpace(runes[end]) {
+				end++
+			}
+		}
+		tokens = append(tokens, string(runes[start:end]))
+		start = end
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// diffTokens diffs two token sequences using Myers' algorithm after trimming the common prefix and suffix
+func diffTokens(a, b []string) []DiffSegment {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var builder diffBuilder
+	builder.add(DiffOperationEqual, a[:prefix]...)
+	builder.addMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	builder.add(DiffOperationEqual, a[len(a)-suffix:]...)
+	return builder.segments
+}
+
+// diffBuilder accumulates tokens into segments, merging adjacent tokens with the same operation
+type diffBuilder struct {
+	segments []DiffSegment
+}
+
+func (b *diffBuilder) add(operation DiffOperation, tokens ...string) {
+	if len(tokens) == 0 {
+		return
+	}
+	text := strings.Join(tokens, "")
+	if last := len(b.segments) - 1; last >= 0 && b.segments[last].Operation == operation {
+		b.segments[last].Text += text
+		return
+	}
+	b.segments = append(b.segments, DiffSegment{Operation: operation, Text: text})
+}
+
+// addMiddle diffs the part of the texts between the common prefix and suffix
+func (b *diffBuilder) addMiddle(a, c []string) {
+	ops, ok := myersDiff(a, c)
+	if !ok {
+		b.add(DiffOperationDelete, a...)
+		b.add(DiffOperationInsert, c...)
+		return
+	}
+	for _, op := range ops {
+		b.add(op.operation, op.token)
+	}
+}
+
+type tokenOp struct {
+	operation DiffOperation
+	token     string
+}
+
+// myersDiff returns the shortest edit script turning a into b
+// Returns false if more than maxDiffEdits edits are needed
+func myersDiff(a, b []string) ([]tokenOp, bool) {
+	n, m := len(a), len(b)
+	maxD := n + m
+	if maxD > maxDiffEdits {
+		maxD = maxDiffEdits
+	}
+
+	offset := maxD + 1
+	v := make([]int, 2*offset+1)
+	// trace[d] holds v[-d-1..d+1] as it was before step d, for backtracking
+	var trace [][]int
+
+	found := -1
+	for d := 0; d <= maxD && found < 0; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = d
+				break
+			}
+		}
+	}
+	if found < 0 {
+		return nil, false
+	}
+
+	ops := make([]tokenOp, 0, n+m)
+	x, y := n, m
+	for d := found; d >= 0; d-- {
+		snapshot := trace[d]
+		at := func(k int) int { return snapshot[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			ops = append(ops, tokenOp{operation: DiffOperationEqual, token: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, tokenOp{operation: DiffOperationInsert, token: b[y-1]})
+				y--
+			} else {
+				ops = append(ops, tokenOp{operation: DiffOperationDelete, token: a[x-1]})
+				x--
+			}
+		}
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops, true
+}
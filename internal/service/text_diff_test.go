@@ -0,0 +1,99 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// joinDiff reconstructs the old or new text from diff segments
+func joinDiff(segments []DiffSegment, skip DiffOperation) string {
+	var builder strings.Builder
+	for _, segment := range segments {
+		if segment.Operation != skip {
+			builder.WriteString(segment.Text)
+		}
+	}
+	return builder.String()
+}
+
+func TestDiffWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldText  string
+		newText  string
+		expected []DiffSegment
+	}{
+		{
+			name:     "identical texts",
+			oldText:  "The system shall log in users.",
+			newText:  "The system shall log in users.",
+			expected: []DiffSegment{{Operation: DiffOperationEqual, Text: "The system shall log in users."}},
+		},
+		{
+			name:    "word replaced",
+			oldText: "The system shall support OAuth.",
+			newText: "The system shall support SAML.",
+			expected: []DiffSegment{
+				{Operation: DiffOperationEqual, Text: "The system shall support "},
+				{Operation: DiffOperationDelete, Text: "OAuth"},
+				{Operation: DiffOperationInsert, Text: "SAML"},
+				{Operation: DiffOperationEqual, Text: "."},
+			},
+		},
+		{
+			name:    "words inserted",
+			oldText: "Users log in.",
+			newText: "Users securely log in.",
+			expected: []DiffSegment{
+				{Operation: DiffOperationEqual, Text: "Users "},
+				{Operation: DiffOperationInsert, Text: "securely "},
+				{Operation: DiffOperationEqual, Text: "log in."},
+			},
+		},
+		{
+			name:     "from empty text",
+			oldText:  "",
+			newText:  "New text",
+			expected: []DiffSegment{{Operation: DiffOperationInsert, Text: "New text"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments := DiffWords(tt.oldText, tt.newText)
+			assert.Equal(t, tt.expected, segments)
+		})
+	}
+}
+
+func TestDiffWords_ReconstructsBothTexts(t *testing.T) {
+	oldText := "WHEN a user enters valid credentials THEN the system SHALL authenticate the user and redirect to the dashboard"
+	newText := "WHEN an administrator enters valid credentials and a TOTP code THEN the system SHALL authenticate the administrator"
+
+	segments := DiffWords(oldText, newText)
+
+	assert.Equal(t, oldText, joinDiff(segments, DiffOperationInsert))
+	assert.Equal(t, newText, joinDiff(segments, DiffOperationDelete))
+
+	inserted, deleted := CountDiffWords(segments)
+	assert.Greater(t, inserted, 0)
+	assert.Greater(t, deleted, 0)
+}
+
+func TestDiffWords_TooManyEditsFallsBackToReplacement(t *testing.T) {
+	var oldWords, newWords []string
+	for i := 0; i < maxDiffEdits; i++ {
+		oldWords = append(oldWords, "old")
+		newWords = append(newWords, "new")
+	}
+	oldText := strings.Join(oldWords, " ")
+	newText := strings.Join(newWords, ";")
+
+	segments := DiffWords(oldText, newText)
+
+	assert.Len(t, segments, 2)
+	assert.Equal(t, oldText, joinDiff(segments, DiffOperationInsert))
+	assert.Equal(t, newText, joinDiff(segments, DiffOperationDelete))
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+var (
+	ErrTranslationNotFound            = apperrors.New(apperrors.KindNotFound, "TRANSLATION_NOT_FOUND", "translation not found")
+	ErrTranslationLocale              = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "locale must be a language code such as ru, optionally with a region such as pt-BR")
+	ErrTranslationContentLocale       = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "entities are written in the language of the content locale; translations must use another language")
+	ErrTranslationTitleRequired       = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "translation title cannot be empty")
+	ErrTranslationTitleNotSupported   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "acceptance criteria translations do not support a title")
+	ErrTranslationDescriptionRequired = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "acceptance criteria translations require a description")
+)
+
+// localePattern matches a lower-cased language code with an optional region
+var localePattern = regexp.MustCompile(`^([a-z]{2,3})(?:-([a-z]{2}))?$`)
+
+// translatedEntityTypes lists the entity types that can be translated, in report order
+var translatedEntityTypes = []models.EntityType{
+	models.EntityTypeEpic,
+	models.EntityTypeUserStory,
+	models.EntityTypeAcceptanceCriteria,
+	models.EntityTypeRequirement,
+}
+
+// TranslationService defines the interface for translations of entity titles and descriptions
+type TranslationService interface {
+	SaveTranslation(ctx context.Context, entityType models.EntityType, idOrReference, locale string, req SaveTranslationRequest, userID uuid.UUID) (*models.EntityTranslation, error)
+	GetTranslation(ctx context.Context, entityType models.EntityType, idOrReference, locale string) (*models.EntityTranslation, error)
+	ListTranslations(ctx context.Context, entityType models.EntityType, idOrReference string) ([]models.EntityTranslation, error)
+	DeleteTranslation(ctx context.Context, entityType models.EntityType, idOrReference, locale string) error
+	Translations(ctx context.Context, entityType models.EntityType, ids []uuid.UUID, accepted []string) (map[uuid.UUID]*models.EntityTranslation, error)
+	ContentLocale(ctx context.Context) string
+	GetCompletenessReport(ctx context.Context, locale string) ([]TranslationCompleteness, error)
+}
+
+// SaveTranslationRequest represents the request to create or replace a translation
+// @Description Request payload for translating an entity's title and description into a locale
+type SaveTranslationRequest struct {
+	// Title is the translated title
+	// @Description Translated title (required, max 500 characters, not supported for acceptance criteria)
+	// @MaxLength 500
+	// @Example "Аутентификация пользователей должна поддерживать OAuth 2.0"
+	Title *string `json:"title,omitempty" binding:"omitempty,max=500"`
+
+	// Description is the translated description
+	// @Description Translated description (optional, max 50000 characters; required for acceptance criteria)
+	// @MaxLength 50000
+	// @Example "Система должна поддерживать аутентификацию OAuth 2.0..."
+	Description *string `json:"description,omitempty" binding:"omitempty,max=50000"`
+}
+
+// TranslationCompleteness tells how far the entities of a type are translated into a locale
+// @Description Translation progress of one entity type in one locale
+type TranslationCompleteness struct {
+	Locale          string            `json:"locale" example:"ru"`
+	EntityType      models.EntityType `json:"entity_type" example:"requirement"`
+	Total           int64             `json:"total" example:"120"`           // Entities of the type; archived epics and user stories are left out
+	Translated      int64             `json:"translated" example:"90"`       // Entities whose title and description are both translated
+	Partial         int64             `json:"partial" example:"10"`          // Entities whose translation misses the title or the description
+	Missing         int64             `json:"missing" example:"20"`          // Entities without a translation
+	PercentComplete float64           `json:"percent_complete" example:"75"` // Translated entities out of all, rounded to one decimal; 100 when there are none
+}
+
+// translationService implements TranslationService interface
+type translationService struct {
+	repos         *repository.Repositories
+	contentLocale string
+	locales       []string
+}
+
+// NewTranslationService creates a new translation service instance. Entities are written in contentLocale;
+// the completeness report covers locales and every other locale entities were translated into.
+func NewTranslationService(repos *repository.Repositories, contentLocale string, locales []string) TranslationService {
+	s := &translationService{repos: repos, contentLocale: "en"}
+	if normalized, ok := normalizeLocale(contentLocale); ok {
+		s.contentLocale = normalized
+	}
+	for _, locale := range locales {
+		if normalized, ok := normalizeLocale(locale); ok && languageOf(normalized) != languageOf(s.contentLocale) && !slices.Contains(s.locales, normalized) {
+			s.locales = append(s.locales, normalized)
+		}
+	}
+	return s
+}
+
+// SaveTranslation creates or replaces the translation of an entity into a locale
+func (s *translationService) SaveTranslation(ctx context.Context, entityType models.EntityType, idOrReference, locale string, req SaveTranslationRequest, userID uuid.UUID) (*models.EntityTranslation, error) {
+	locale, err := s.translationLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if entityType == models.EntityTypeAcceptanceCriteria {
+		if req.Title != nil {
+			return nil, ErrTranslationTitleNotSupported
+		}
+		if req.Description == nil || strings.TrimSpace(*req.Description) == "" {
+			return nil, ErrTranslationDescriptionRequired
+		}
+	} else if req.Title == nil || strings.TrimSpace(*req.Title) == "" {
+		return nil, ErrTranslationTitleRequired
+	}
+
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	translation, err := s.repos.EntityTranslation.Get(ctx, entityType, entityID, locale)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get translation: %w", err)
+	}
+
+	if translation == nil {
+		translation = &models.EntityTranslation{
+			EntityType:  entityType,
+			EntityID:    entityID,
+			Locale:      locale,
+			Title:       req.Title,
+			Description: req.Description,
+			UpdatedBy:   userID,
+		}
+		if err := s.repos.EntityTranslation.Create(ctx, translation); err != nil {
+			return nil, fmt.Errorf("failed to create translation: %w", err)
+		}
+	} else {
+		translation.Title = req.Title
+		translation.Description = req.Description
+		translation.UpdatedBy = userID
+		if err := s.repos.EntityTranslation.Update(ctx, translation); err != nil {
+			return nil, fmt.Errorf("failed to update translation: %w", err)
+		}
+	}
+	return translation, nil
+}
+
+// GetTranslation retrieves the translation of an entity into a locale
+func (s *translationService) GetTranslation(ctx context.Context, entityType models.EntityType, idOrReference, locale string) (*models.EntityTranslation, error) {
+	locale, err := s.translationLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	translation, err := s.repos.EntityTranslation.Get(ctx, entityType, entityID, locale)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrTranslationNotFound
+		}
+		return nil, fmt.Errorf("failed to get translation: %w", err)
+	}
+	return translation, nil
+}
+
+// ListTranslations retrieves every translation of an entity ordered by locale
+func (s *translationService) ListTranslations(ctx context.Context, entityType models.EntityType, idOrReference string) ([]models.EntityTranslation, error) {
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.repos.EntityTranslation.ListByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list translations: %w", err)
+	}
+	return translations, nil
+}
+
+// DeleteTranslation removes the translation of an entity into a locale
+func (s *translationService) DeleteTranslation(ctx context.Context, entityType models.EntityType, idOrReference, locale string) error {
+	locale, err := s.translationLocale(locale)
+	if err != nil {
+		return err
+	}
+	entityID, err := resolveEntityID(ctx, s.repos, entityType, idOrReference)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repos.EntityTranslation.Delete(ctx, entityType, entityID, locale); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrTranslationNotFound
+		}
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+	return nil
+}
+
+// Translations picks the translation of each entity into the most preferred of the accepted locales, best
+// first as in Accept-Language. A region falls back to its language, so pt-BR also accepts pt. Locales after
+// the content locale or the * wildcard are ignored, since the original text is preferred to them; entities
+// without a preferred translation are left out.
+func (s *translationService) Translations(ctx context.Context, entityType models.EntityType, ids []uuid.UUID, accepted []string) (map[uuid.UUID]*models.EntityTranslation, error) {
+	var preferred []string
+	for _, tag := range accepted {
+		if strings.TrimSpace(tag) == "*" {
+			break
+		}
+		locale, ok := normalizeLocale(tag)
+		if !ok {
+			continue
+		}
+		if languageOf(locale) == languageOf(s.contentLocale) {
+			break
+		}
+		for _, candidate := range []string{locale, languageOf(locale)} {
+			if !slices.Contains(preferred, candidate) {
+				preferred = append(preferred, candidate)
+			}
+		}
+	}
+
+	result := make(map[uuid.UUID]*models.EntityTranslation)
+	if len(preferred) == 0 || len(ids) == 0 {
+		return result, nil
+	}
+	translations, err := s.repos.EntityTranslation.ListByEntities(ctx, entityType, ids, preferred)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list translations: %w", err)
+	}
+	for i := range translations {
+		translation := &translations[i]
+		if current, ok := result[translation.EntityID]; !ok ||
+			slices.Index(preferred, translation.Locale) < slices.Index(preferred, current.Locale) {
+			result[translation.EntityID] = translation
+		}
+	}
+	return result, nil
+}
+
+// ContentLocale returns the locale entities are written in
+func (s *translationService) ContentLocale(ctx context.Context) string {
+	return s.contentLocale
+}
+
+// GetCompletenessReport counts translated, partially translated and untranslated entities per locale and
+// entity type. Without a locale it covers the configured translation locales, then every other locale
+// entities were translated into.
+func (s *translationService) GetCompletenessReport(ctx context.Context, locale string) ([]TranslationCompleteness, error) {
+	locales := s.locales
+	if locale != "" {
+		normalized, err := s.translationLocale(locale)
+		if err != nil {
+			return nil, err
+		}
+		locales = []string{normalized}
+	} else {
+		used, err := s.repos.EntityTranslation.ListLocales(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list translation locales: %w", err)
+		}
+		locales = slices.Clone(locales)
+		for _, usedLocale := range used {
+			if !slices.Contains(locales, usedLocale) {
+				locales = append(locales, usedLocale)
+			}
+		}
+	}
+
+	report := make([]TranslationCompleteness, 0, len(locales)*len(translatedEntityTypes))
+	for _, reportLocale := range locales {
+		for _, entityType := range translatedEntityTypes {
+			counts, err := s.repos.EntityTranslation.CountCompleteness(ctx, entityType, reportLocale)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count translations: %w", err)
+			}
+			entry := TranslationCompleteness{
+				Locale:          reportLocale,
+				EntityType:      entityType,
+				Total:           counts.Total,
+				Translated:      counts.Translated,
+				Partial:         counts.Partial,
+				Missing:         counts.Total - counts.Translated - counts.Partial,
+				PercentComplete: 100,
+			}
+			if counts.Total > 0 {
+				entry.PercentComplete = math.Round(float64(counts.Translated)*1000/float64(counts.Total)) / 10
+			}
+			report = append(report, entry)
+		}
+	}
+	return report, nil
+}
+
+// translationLocale normalizes the locale of a translation, which must differ in language from the content locale
+func (s *translationService) translationLocale(locale string) (string, error) {
+	normalized, ok := normalizeLocale(locale)
+	if !ok {
+		return "", ErrTranslationLocale
+	}
+	if languageOf(normalized) == languageOf(s.contentLocale) {
+		return "", ErrTranslationContentLocale
+	}
+	return normalized, nil
+}
+
+// normalizeLocale writes a locale such as RU or pt_br as ru and pt-BR; it reports false for anything else
+func normalizeLocale(locale string) (string, bool) {
+	match := localePattern.FindStringSubmatch(strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")))
+	if match == nil {
+		return "", false
+	}
+	if match[2] != "" {
+		return match[1] + "-" + strings.ToUpper(match[2]), true
+	}
+	return match[1], true
+}
+
+// languageOf returns the language of a normalized locale, such as pt for pt-BR
+func languageOf(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	return language
+}
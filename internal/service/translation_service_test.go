@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	for input, expected := range map[string]string{"ru": "ru", " RU ": "ru", "pt_br": "pt-BR", "en-us": "en-US"} {
+		locale, ok := normalizeLocale(input)
+		assert.True(t, ok, input)
+		assert.Equal(t, expected, locale, input)
+	}
+	for _, input := range []string{"", "russian", "r", "en-", "zh-Hant-TW", "*"} {
+		_, ok := normalizeLocale(input)
+		assert.False(t, ok, input)
+	}
+}
+
+func TestTranslationService(t *testing.T) {
+	ctx := context.Background()
+	active := models.RequirementStatusActive
+	db, alice, epic, requirements := setupSupersessionTest(t, active, active, active)
+	require.NoError(t, db.AutoMigrate(&models.EntityTranslation{}))
+	description := "The password must be long"
+	require.NoError(t, db.Model(&models.Requirement{}).Where("id = ?", requirements[0].ID).UpdateColumn("description", description).Error)
+	var story models.UserStory
+	require.NoError(t, db.Where("epic_id = ?", epic.ID).First(&story).Error)
+	criteria := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: story.ID, AuthorID: alice.ID, Description: "WHEN the password is short THEN the system SHALL reject it"}
+	require.NoError(t, db.Session(&gorm.Session{SkipHooks: true}).Create(criteria).Error)
+
+	svc := NewTranslationService(repository.NewRepositories(db, nil), "en", []string{"ru", "DE"})
+	text := func(value string) *string { return &value }
+
+	t.Run("saves and replaces translations", func(t *testing.T) {
+		translation, err := svc.SaveTranslation(ctx, models.EntityTypeRequirement, "REQ-001", "RU", SaveTranslationRequest{Title: text("Правило пароля")}, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "ru", translation.Locale)
+		assert.Equal(t, requirements[0].ID, translation.EntityID)
+
+		translation, err = svc.SaveTranslation(ctx, models.EntityTypeRequirement, requirements[0].ID.String(), "ru", SaveTranslationRequest{Title: text("Правило пароля"), Description: text("Пароль должен быть длинным")}, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Пароль должен быть длинным", *translation.Description)
+
+		translations, err := svc.ListTranslations(ctx, models.EntityTypeRequirement, "REQ-001")
+		require.NoError(t, err)
+		assert.Len(t, translations, 1)
+
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeRequirement, "REQ-002", "pt-BR", SaveTranslationRequest{Title: text("Regra de senha")}, alice.ID)
+		require.NoError(t, err)
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeAcceptanceCriteria, "AC-001", "ru", SaveTranslationRequest{Description: text("ЕСЛИ пароль короткий ТО система ДОЛЖНА его отклонить")}, alice.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("validates translations", func(t *testing.T) {
+		_, err := svc.SaveTranslation(ctx, models.EntityTypeRequirement, "REQ-001", "russian", SaveTranslationRequest{Title: text("Правило")}, alice.ID)
+		assert.ErrorIs(t, err, ErrTranslationLocale)
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeRequirement, "REQ-001", "en-GB", SaveTranslationRequest{Title: text("Password rule")}, alice.ID)
+		assert.ErrorIs(t, err, ErrTranslationContentLocale)
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeRequirement, "REQ-001", "ru", SaveTranslationRequest{Description: text("Описание")}, alice.ID)
+		assert.ErrorIs(t, err, ErrTranslationTitleRequired)
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeAcceptanceCriteria, "AC-001", "ru", SaveTranslationRequest{Title: text("Заголовок"), Description: text("Описание")}, alice.ID)
+		assert.ErrorIs(t, err, ErrTranslationTitleNotSupported)
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeAcceptanceCriteria, "AC-001", "ru", SaveTranslationRequest{}, alice.ID)
+		assert.ErrorIs(t, err, ErrTranslationDescriptionRequired)
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeRequirement, "REQ-404", "ru", SaveTranslationRequest{Title: text("Правило")}, alice.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("picks the most preferred translation", func(t *testing.T) {
+		ids := []uuid.UUID{requirements[0].ID, requirements[1].ID, requirements[2].ID}
+
+		found, err := svc.Translations(ctx, models.EntityTypeRequirement, ids, []string{"pt-BR", "ru"})
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		assert.Equal(t, "ru", found[requirements[0].ID].Locale)
+		assert.Equal(t, "pt-BR", found[requirements[1].ID].Locale)
+
+		found, err = svc.Translations(ctx, models.EntityTypeRequirement, ids, []string{"ru-RU"})
+		require.NoError(t, err)
+		assert.Equal(t, "ru", found[requirements[0].ID].Locale)
+		assert.Len(t, found, 1)
+
+		// The original text is preferred to the locales after the content locale
+		found, err = svc.Translations(ctx, models.EntityTypeRequirement, ids, []string{"en-US", "ru"})
+		require.NoError(t, err)
+		assert.Empty(t, found)
+		found, err = svc.Translations(ctx, models.EntityTypeRequirement, ids, []string{"*", "ru"})
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("reports completeness per locale and entity type", func(t *testing.T) {
+		report, err := svc.GetCompletenessReport(ctx, "")
+		require.NoError(t, err)
+		require.Len(t, report, 12)
+		assert.Equal(t, "ru", report[0].Locale)
+		assert.Equal(t, "de", report[4].Locale)
+		assert.Equal(t, "pt-BR", report[8].Locale)
+
+		assert.Equal(t, TranslationCompleteness{Locale: "ru", EntityType: models.EntityTypeEpic, Total: 1, Missing: 1}, report[0])
+		assert.Equal(t, TranslationCompleteness{Locale: "ru", EntityType: models.EntityTypeAcceptanceCriteria, Total: 1, Translated: 1, PercentComplete: 100}, report[2])
+		assert.Equal(t, TranslationCompleteness{Locale: "ru", EntityType: models.EntityTypeRequirement, Total: 3, Translated: 1, Missing: 2, PercentComplete: 33.3}, report[3])
+		assert.Equal(t, TranslationCompleteness{Locale: "pt-BR", EntityType: models.EntityTypeRequirement, Total: 3, Translated: 1, Missing: 2, PercentComplete: 33.3}, report[11])
+
+		// A translation without the description of the entity is partial
+		_, err = svc.SaveTranslation(ctx, models.EntityTypeRequirement, "REQ-001", "de", SaveTranslationRequest{Title: text("Passwortregel")}, alice.ID)
+		require.NoError(t, err)
+		report, err = svc.GetCompletenessReport(ctx, "DE")
+		require.NoError(t, err)
+		require.Len(t, report, 4)
+		assert.Equal(t, int64(1), report[3].Partial)
+		assert.Equal(t, int64(0), report[3].Translated)
+
+		_, err = svc.GetCompletenessReport(ctx, "en")
+		assert.ErrorIs(t, err, ErrTranslationContentLocale)
+	})
+
+	t.Run("deletes translations", func(t *testing.T) {
+		require.NoError(t, svc.DeleteTranslation(ctx, models.EntityTypeRequirement, "REQ-002", "pt-br"))
+		assert.ErrorIs(t, svc.DeleteTranslation(ctx, models.EntityTypeRequirement, "REQ-002", "pt-BR"), ErrTranslationNotFound)
+		_, err := svc.GetTranslation(ctx, models.EntityTypeRequirement, "REQ-002", "pt-BR")
+		assert.ErrorIs(t, err, ErrTranslationNotFound)
+	})
+}
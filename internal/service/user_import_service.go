@@ -0,0 +1,398 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// User import delivery modes of the temporary passwords
+const (
+	UserImportDeliveryEmail    = "email"
+	UserImportDeliveryResponse = "response"
+)
+
+// User import row statuses
+const (
+	UserImportRowCreated = "created"
+	UserImportRowFailed  = "failed"
+)
+
+const (
+	// MaxUserImportRows is the most users a single CSV file can import
+	MaxUserImportRows = 1000
+	// maxTeamLength matches the size of the team column
+	maxTeamLength = 100
+	// temporaryPasswordBytes is the entropy of generated passwords, 16 bytes encode to 22 characters
+	temporaryPasswordBytes = 16
+)
+
+var (
+	ErrInvalidUserImportCSV      = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid user import CSV")
+	ErrInvalidUserImportDelivery = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "delivery must be one of: email, response")
+	ErrInvalidUserImportRole     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "roles must be one of: Administrator, User, Commenter")
+	ErrTooManyUserImportRows     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, fmt.Sprintf("a user import is limited to %d rows", MaxUserImportRows))
+)
+
+// UserImportService defines the interface for creating user accounts in bulk from a CSV file
+type UserImportService interface {
+	ImportUsers(ctx context.Context, req ImportUsersRequest) (*UserImport, error)
+}
+
+// ImportUsersRequest is a CSV file of users with the options of its import
+type ImportUsersRequest struct {
+	Content     []byte
+	Delivery    string                     // How temporary passwords reach the users, email by default
+	ActorID     uuid.UUID                  // Administrator importing the users, recorded with the passwords returned to them
+	DefaultRole models.UserRole            // Role of rows without one, User by default
+	RoleMap     map[string]models.UserRole // Role column values, such as job titles of another system, mapped to roles
+}
+
+// UserImportRow is the outcome of a row of an imported CSV file
+type UserImportRow struct {
+	Row               int             `json:"row" example:"2"`                                                  // Line of the row in the file; the header is line 1
+	Username          string          `json:"username" example:"jane_doe"`                                      // Username of the row
+	Email             string          `json:"email" example:"jane@example.com"`                                 // Email address of the row
+	Role              models.UserRole `json:"role,omitempty" example:"User"`                                    // Role the row mapped to
+	Team              string          `json:"team,omitempty" example:"Payments"`                                // Team of the row
+	Status            string          `json:"status" example:"created"`                                         // created or failed
+	UserID            *uuid.UUID      `json:"user_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"` // Created user
+	TemporaryPassword string          `json:"temporary_password,omitempty" example:"q8Xb2mT0kLz9WcVr4NdA1g"`    // Password of the created user with the response delivery
+	EmailQueued       bool            `json:"email_queued" example:"true"`                                      // Whether the email with the temporary password was queued; it is sent in the background, delivery is not confirmed
+	Errors            []string        `json:"errors,omitempty" example:"email already belongs to a user"`       // Why the row failed
+}
+
+// UserImport is the outcome of a user import
+// @Description Outcome of a CSV user import, one result per row. Valid rows are created even when other rows fail.
+type UserImport struct {
+	Created int             `json:"created" example:"48"` // Users created
+	Failed  int             `json:"failed" example:"2"`   // Rows that failed validation or could not be created
+	Rows    []UserImportRow `json:"rows"`
+}
+
+// userImportService implements UserImportService interface
+type userImportService struct {
+	repos          *repository.Repositories
+	hashService    HashService
+	mailer         Mailer
+	tokenGenerator TokenGenerator
+	baseURL        string
+	logger         *logrus.Logger
+}
+
+// NewUserImportService creates a new user import service instance
+// mailer should send in the background, such as a MailQueue, as an import can email a thousand users.
+// baseURL is the public API base URL the account email tells users to sign in at.
+func NewUserImportService(repos *repository.Repositories, hashService HashService, mailer Mailer, baseURL string, logger *logrus.Logger) UserImportService {
+	return &userImportService{
+		repos:          repos,
+		hashService:    hashService,
+		mailer:         mailer,
+		tokenGenerator: NewSecureTokenGenerator(),
+		baseURL:        strings.TrimRight(baseURL, "/"),
+		logger:         logger,
+	}
+}
+
+// ImportUsers creates an account with a temporary password for each valid row of a CSV file.
+// The header names the columns: username and email are required, role and team are optional.
+// Invalid rows and rows of taken usernames or emails fail without stopping the import. Temporary passwords
+// are emailed, or returned in the rows when the delivery is response; every returned password is recorded in
+// the audit log of its user.
+func (s *userImportService) ImportUsers(ctx context.Context, req ImportUsersRequest) (*UserImport, error) {
+	delivery := req.Delivery
+	if delivery == "" {
+		delivery = UserImportDeliveryEmail
+	}
+	if delivery != UserImportDeliveryEmail && delivery != UserImportDeliveryResponse {
+		return nil, ErrInvalidUserImportDelivery
+	}
+	defaultRole := req.DefaultRole
+	if defaultRole == "" {
+		defaultRole = models.RoleUser
+	}
+	if !defaultRole.IsValid() {
+		return nil, ErrInvalidUserImportRole
+	}
+	roleMap := make(map[string]models.UserRole, len(req.RoleMap))
+	for value, role := range req.RoleMap {
+		if !role.IsValid() {
+			return nil, ErrInvalidUserImportRole
+		}
+		roleMap[strings.ToLower(strings.TrimSpace(value))] = role
+	}
+
+	rows, err := parseUserImportCSV(req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserImport{Rows: make([]UserImportRow, 0, len(rows))}
+	usernames := make(map[string]int)
+	emails := make(map[string]int)
+	for _, row := range rows {
+		s.validateRow(ctx, &row, defaultRole, roleMap, usernames, emails)
+		if len(row.Errors) == 0 {
+			s.createUser(ctx, &row, delivery, req.ActorID)
+		}
+		if len(row.Errors) > 0 {
+			row.Status = UserImportRowFailed
+			result.Failed++
+		} else {
+			row.Status = UserImportRowCreated
+			result.Created++
+		}
+		result.Rows = append(result.Rows, row.UserImportRow)
+	}
+	return result, nil
+}
+
+// userImportRecord is a parsed row of an import file before its role is mapped
+type userImportRecord struct {
+	UserImportRow
+	role string
+}
+
+// parseUserImportCSV reads the rows of an import file by the column names of its header
+func parseUserImportCSV(content []byte) ([]userImportRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: the file is empty", ErrInvalidUserImportCSV)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidUserImportCSV, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"username", "email"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: the header must name a %s column", ErrInvalidUserImportCSV, required)
+		}
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var records []userImportRecord
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidUserImportCSV, err)
+		}
+		line, _ := reader.FieldPos(0)
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		if len(records) == MaxUserImportRows {
+			return nil, ErrTooManyUserImportRows
+		}
+		records = append(records, userImportRecord{
+			UserImportRow: UserImportRow{
+				Row:      line,
+				Username: field(record, "username"),
+				Email:    field(record, "email"),
+				Team:     field(record, "team"),
+			},
+			role: field(record, "role"),
+		})
+	}
+	return records, nil
+}
+
+// validateRow maps the role of a row and records its problems, including usernames and emails taken by
+// existing users or by earlier rows of the file
+func (s *userImportService) validateRow(ctx context.Context, row *userImportRecord, defaultRole models.UserRole, roleMap map[string]models.UserRole, usernames, emails map[string]int) {
+	addError := func(format string, args ...interface{}) {
+		row.Errors = append(row.Errors, fmt.Sprintf(format, args...))
+	}
+
+	switch length := utf8.RuneCountInString(row.Username); {
+	case length == 0:
+		addError("username is required")
+	case length < 3 || length > 50:
+		addError("username must be between 3 and 50 characters")
+	}
+
+	if row.Email == "" {
+		addError("email is required")
+	} else if address, err := mail.ParseAddress(row.Email); err != nil || address.Address != row.Email {
+		addError("email must be a valid email address")
+	} else {
+		row.Email = strings.ToLower(row.Email)
+	}
+
+	row.Role = mapImportRole(row.role, defaultRole, roleMap)
+	if row.Role == "" {
+		addError("role %q is neither a role nor mapped to one", row.role)
+	}
+	if utf8.RuneCountInString(row.Team) > maxTeamLength {
+		addError("team must not exceed %d characters", maxTeamLength)
+	}
+	if len(row.Errors) > 0 {
+		return
+	}
+
+	if first, ok := usernames[row.Username]; ok {
+		addError("username is repeated from row %d", first)
+	} else {
+		usernames[row.Username] = row.Row
+	}
+	if first, ok := emails[row.Email]; ok {
+		addError("email is repeated from row %d", first)
+	} else {
+		emails[row.Email] = row.Row
+	}
+	if len(row.Errors) > 0 {
+		return
+	}
+
+	if exists, err := s.repos.User.ExistsByUsername(ctx, row.Username); err != nil {
+		s.logger.WithError(err).WithField("row", row.Row).Error("Failed to check imported username")
+		addError("username could not be checked")
+	} else if exists {
+		addError("username already belongs to a user")
+	}
+	if exists, err := s.repos.User.ExistsByEmail(ctx, row.Email); err != nil {
+		s.logger.WithError(err).WithField("row", row.Row).Error("Failed to check imported email")
+		addError("email could not be checked")
+	} else if exists {
+		addError("email already belongs to a user")
+	}
+}
+
+// mapImportRole maps the role column of a row to a role: empty values get the default role, mapped
+// values their mapping and role names, in any case, the role itself. Unknown values map to no role.
+func mapImportRole(value string, defaultRole models.UserRole, roleMap map[string]models.UserRole) models.UserRole {
+	if value == "" {
+		return defaultRole
+	}
+	key := strings.ToLower(value)
+	if role, ok := roleMap[key]; ok {
+		return role
+	}
+	for _, role := range []models.UserRole{models.RoleAdministrator, models.RoleUser, models.RoleCommenter} {
+		if key == strings.ToLower(string(role)) {
+			return role
+		}
+	}
+	return ""
+}
+
+// createUser creates the account of a valid row with a temporary password and delivers the password. A returned
+// password is recorded in the audit log of the user in the transaction creating the user; an emailed one is queued
+// after the user is committed.
+func (s *userImportService) createUser(ctx context.Context, row *userImportRecord, delivery string, actorID uuid.UUID) {
+	_, password, err := s.tokenGenerator.GenerateToken("", temporaryPasswordBytes)
+	if err != nil {
+		s.logger.WithError(err).WithField("row", row.Row).Error("Failed to generate temporary password")
+		row.Errors = append(row.Errors, "user could not be created")
+		return
+	}
+	passwordHash, err := s.hashService.HashToken(password)
+	if err != nil {
+		s.logger.WithError(err).WithField("row", row.Row).Error("Failed to hash temporary password")
+		row.Errors = append(row.Errors, "user could not be created")
+		return
+	}
+
+	user := &models.User{
+		ID:           uuid.New(),
+		Username:     row.Username,
+		Email:        row.Email,
+		PasswordHash: passwordHash,
+		Role:         row.Role,
+	}
+	if row.Team != "" {
+		team := row.Team
+		user.Team = &team
+	}
+	err = s.repos.WithTransaction(ctx, func(tx *repository.Repositories) error {
+		if err := tx.User.Create(ctx, user); err != nil {
+			return err
+		}
+		if delivery != UserImportDeliveryResponse {
+			return nil
+		}
+		event := &models.AuditEvent{
+			EntityType: models.EntityTypeUser,
+			EntityID:   user.ID,
+			Action:     models.AuditActionPasswordIssued,
+			Field:      "delivery",
+			NewValue:   &delivery,
+			CreatedAt:  time.Now().UTC(),
+		}
+		if actorID != uuid.Nil {
+			event.ActorID = &actorID
+		}
+		if err := tx.Audit.Create(ctx, event); err != nil {
+			return fmt.Errorf("failed to record issued password: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicateKey) {
+			row.Errors = append(row.Errors, "username or email already belongs to a user")
+			return
+		}
+		s.logger.WithError(err).WithField("row", row.Row).Error("Failed to create imported user")
+		row.Errors = append(row.Errors, "user could not be created")
+		return
+	}
+
+	if delivery == UserImportDeliveryResponse {
+		row.UserID = &user.ID
+		row.TemporaryPassword = password
+		return
+	}
+
+	// The email is queued once the user is committed, so no email names an account that does not exist.
+	// A user whose email cannot be queued has no way to sign in and is removed again.
+	if err := s.mailer.Send(user.Email, "Your account has been created", s.renderAccountEmail(user, password)); err != nil {
+		s.logger.WithError(err).WithField("user_id", user.ID).Error("Failed to queue account email")
+		if err := s.repos.User.Delete(ctx, user.ID); err != nil {
+			s.logger.WithError(err).WithField("user_id", user.ID).Error("Failed to remove imported user without account email")
+			row.UserID = &user.ID
+			row.Errors = append(row.Errors, "account email could not be queued, set a new password for the user")
+			return
+		}
+		row.Errors = append(row.Errors, "account email could not be queued, import the row again later")
+		return
+	}
+	row.UserID = &user.ID
+	row.EmailQueued = true
+}
+
+// renderAccountEmail builds the plain text email telling an imported user their username and temporary password
+func (s *userImportService) renderAccountEmail(user *models.User, password string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "An account with the %s role has been created for you.\n\n", user.Role)
+	fmt.Fprintf(&b, "Username: %s\nTemporary password: %s\n\n", user.Username, password)
+	fmt.Fprintf(&b, "Sign in at %s with your username and temporary password, then choose a password of your own.\n", s.baseURL)
+	return b.String()
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestUserImportService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.AuditEvent{}))
+	admin := &models.User{Username: "admin", Email: "admin@example.com", PasswordHash: "hash", Role: models.RoleAdministrator}
+	require.NoError(t, db.Create(admin).Error)
+	repos := repository.NewRepositories(db, nil)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	hashService, err := NewBcryptHashService(bcrypt.MinCost)
+	require.NoError(t, err)
+	mailer := &recordingMailer{}
+	svc := NewUserImportService(repos, hashService, mailer, "https://api.example.com/", logger)
+
+	t.Run("rejects malformed files and options", func(t *testing.T) {
+		_, err := svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("")})
+		assert.ErrorIs(t, err, ErrInvalidUserImportCSV)
+		_, err = svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,role\njdoe,User\n")})
+		assert.ErrorIs(t, err, ErrInvalidUserImportCSV)
+		_, err = svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,email\n\"jdoe,jdoe@example.com\n")})
+		assert.ErrorIs(t, err, ErrInvalidUserImportCSV)
+		_, err = svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,email\n"), Delivery: "sms"})
+		assert.ErrorIs(t, err, ErrInvalidUserImportDelivery)
+		_, err = svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,email\n"), DefaultRole: "Owner"})
+		assert.ErrorIs(t, err, ErrInvalidUserImportRole)
+		_, err = svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,email\n"), RoleMap: map[string]models.UserRole{"Engineer": "Owner"}})
+		assert.ErrorIs(t, err, ErrInvalidUserImportRole)
+
+		content := "username,email\n" + strings.Repeat("jdoe,jdoe@example.com\n", MaxUserImportRows+1)
+		_, err = svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte(content)})
+		assert.ErrorIs(t, err, ErrTooManyUserImportRows)
+
+		var count int64
+		require.NoError(t, db.Model(&models.User{}).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("creates valid rows and reports the others", func(t *testing.T) {
+		content := "\xef\xbb\xbfEmail, Username, Role, Team\n" +
+			"Jane@Example.com, jane_doe, administrator, Payments\n" +
+			"john@example.com, john_doe, Engineer,\n" +
+			"\n" +
+			"kim@example.com, kim_lee, , Search\n" +
+			"not an email, bo, Owner\n" +
+			"JANE@example.com, jane_two, User\n" +
+			"admin@example.com, other_admin, User\n"
+		result, err := svc.ImportUsers(ctx, ImportUsersRequest{
+			Content:     []byte(content),
+			DefaultRole: models.RoleCommenter,
+			RoleMap:     map[string]models.UserRole{"engineer": models.RoleUser},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Created)
+		assert.Equal(t, 3, result.Failed)
+		require.Len(t, result.Rows, 6)
+
+		jane := result.Rows[0]
+		assert.Equal(t, 2, jane.Row)
+		assert.Equal(t, UserImportRowCreated, jane.Status)
+		assert.Equal(t, "jane@example.com", jane.Email)
+		assert.Equal(t, models.RoleAdministrator, jane.Role)
+		assert.True(t, jane.EmailQueued)
+		assert.Empty(t, jane.TemporaryPassword)
+		require.NotNil(t, jane.UserID)
+
+		assert.Equal(t, models.RoleUser, result.Rows[1].Role)
+		assert.Equal(t, 5, result.Rows[2].Row)
+		assert.Equal(t, models.RoleCommenter, result.Rows[2].Role)
+
+		assert.Equal(t, UserImportRowFailed, result.Rows[3].Status)
+		assert.Equal(t, []string{"username must be between 3 and 50 characters", "email must be a valid email address",
+			`role "Owner" is neither a role nor mapped to one`}, result.Rows[3].Errors)
+		assert.Equal(t, []string{"email is repeated from row 2"}, result.Rows[4].Errors)
+		assert.Equal(t, []string{"email already belongs to a user"}, result.Rows[5].Errors)
+		assert.Nil(t, result.Rows[5].UserID)
+
+		var user models.User
+		require.NoError(t, db.First(&user, "id = ?", *jane.UserID).Error)
+		require.NotNil(t, user.Team)
+		assert.Equal(t, "Payments", *user.Team)
+
+		require.Len(t, mailer.to, 3)
+		assert.Equal(t, "jane@example.com", mailer.to[0])
+		assert.Contains(t, mailer.body[0], "Username: jane_doe")
+		assert.Contains(t, mailer.body[0], "Sign in at https://api.example.com with your username and temporary password")
+		assert.NotContains(t, mailer.body[0], "POST")
+		password := strings.TrimSpace(strings.SplitN(strings.SplitN(mailer.body[0], "Temporary password: ", 2)[1], "\n", 2)[0])
+		assert.NoError(t, hashService.CompareTokenWithHash(password, user.PasswordHash))
+	})
+
+	t.Run("returns temporary passwords with the response delivery and audits them", func(t *testing.T) {
+		mailer.to = nil
+		result, err := svc.ImportUsers(ctx, ImportUsersRequest{
+			Content:  []byte("username,email\nrita,rita@example.com\njane_doe,jane3@example.com\n"),
+			Delivery: UserImportDeliveryResponse,
+			ActorID:  admin.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, []string{"username already belongs to a user"}, result.Rows[1].Errors)
+		assert.NotEmpty(t, result.Rows[0].TemporaryPassword)
+		assert.False(t, result.Rows[0].EmailQueued)
+		assert.Empty(t, mailer.to)
+
+		require.NotNil(t, result.Rows[0].UserID)
+		events, err := repos.Audit.ListByEntity(ctx, models.EntityTypeUser, *result.Rows[0].UserID, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.AuditActionPasswordIssued, events[0].Action)
+		require.NotNil(t, events[0].ActorID)
+		assert.Equal(t, admin.ID, *events[0].ActorID)
+		assert.Equal(t, UserImportDeliveryResponse, *events[0].NewValue)
+	})
+
+	t.Run("queues emails once their user is committed", func(t *testing.T) {
+		mailer := &committedUserMailer{users: repos.User}
+		svc := NewUserImportService(repos, hashService, mailer, "https://api.example.com", logger)
+		result, err := svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,email\nlena,lena@example.com\n")})
+		require.NoError(t, err)
+		assert.True(t, result.Rows[0].EmailQueued)
+		assert.Equal(t, []bool{true}, mailer.committed)
+	})
+
+	t.Run("removes users whose email could not be queued", func(t *testing.T) {
+		mailer.err = ErrMailQueueFull
+		result, err := svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,email\nsam,sam@example.com\n")})
+		require.NoError(t, err)
+		assert.Equal(t, UserImportRowFailed, result.Rows[0].Status)
+		assert.Equal(t, []string{"account email could not be queued, import the row again later"}, result.Rows[0].Errors)
+		assert.Empty(t, result.Rows[0].TemporaryPassword)
+		assert.Nil(t, result.Rows[0].UserID)
+		assert.False(t, result.Rows[0].EmailQueued)
+
+		exists, err := repos.User.ExistsByUsername(ctx, "sam")
+		require.NoError(t, err)
+		assert.False(t, exists, "the user is removed again")
+
+		mailer.err = nil
+		result, err = svc.ImportUsers(ctx, ImportUsersRequest{Content: []byte("username,email\nsam,sam@example.com\n")})
+		require.NoError(t, err)
+		assert.Equal(t, UserImportRowCreated, result.Rows[0].Status, "the row can be imported again")
+	})
+}
+
+// committedUserMailer records whether the user an email is sent to was committed when the email was sent
+type committedUserMailer struct {
+	recordingMailer
+	users     repository.UserRepository
+	committed []bool
+}
+
+func (m *committedUserMailer) Send(to, subject, body string) error {
+	exists, err := m.users.ExistsByEmail(context.Background(), to)
+	if err != nil {
+		return err
+	}
+	m.committed = append(m.committed, exists)
+	return m.recordingMailer.Send(to, subject, body)
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Limits of the preferences stored per user
+const (
+	maxPreferenceNamespaces   = 50        // Namespaces per user
+	maxPreferenceValueSize    = 16 * 1024 // Bytes of a single namespace's compacted JSON
+	maxPreferencesTotalSize   = 64 * 1024 // Bytes of all namespaces of a user
+	maxPreferenceNamespaceLen = 64
+)
+
+var (
+	ErrInvalidPreferenceNamespace = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid preference namespace")
+	ErrInvalidPreferenceValue     = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "preference value must be a JSON object")
+	ErrPreferencesTooLarge        = apperrors.New(apperrors.KindInvalid, "PREFERENCES_TOO_LARGE", "preferences exceed the size limit")
+)
+
+// preferenceNamespacePattern accepts lower case dot-separated namespaces such as web.board or mcp
+var preferenceNamespacePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*(\.[a-z0-9_-]+)*$`)
+
+// UserPreferenceService defines the interface for the client preferences of users
+type UserPreferenceService interface {
+	GetPreferences(ctx context.Context, userID uuid.UUID, namespaces []string) (*UserPreferences, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, req UpdateUserPreferencesRequest) (*UserPreferences, error)
+}
+
+// UserPreferences holds the preferences of a user by namespace
+type UserPreferences struct {
+	Preferences map[string]json.RawMessage `json:"preferences" swaggertype:"object"` // Preferences by namespace
+	Size        int                        `json:"size" example:"2048"`              // Bytes used by all namespaces of the user
+	Limits      UserPreferenceLimits       `json:"limits"`
+}
+
+// UserPreferenceLimits describes the size limits of stored preferences
+type UserPreferenceLimits struct {
+	MaxNamespaces int `json:"max_namespaces" example:"50"`    // Namespaces per user
+	MaxValueSize  int `json:"max_value_size" example:"16384"` // Bytes of the JSON object of a single namespace
+	MaxTotalSize  int `json:"max_total_size" example:"65536"` // Bytes of all namespaces of a user
+}
+
+// UpdateUserPreferencesRequest represents the request to save preferences
+// Namespaces left out of the request are kept; a null value removes a namespace
+type UpdateUserPreferencesRequest struct {
+	Preferences map[string]json.RawMessage `json:"preferences" binding:"required" swaggertype:"object"`
+}
+
+// userPreferenceService implements UserPreferenceService interface
+type userPreferenceService struct {
+	preferenceRepo repository.UserPreferenceRepository
+}
+
+// NewUserPreferenceService creates a new user preference service instance
+func NewUserPreferenceService(repos *repository.Repositories) UserPreferenceService {
+	return &userPreferenceService{
+		preferenceRepo: repos.UserPreference,
+	}
+}
+
+// GetPreferences retrieves the preferences of a user, limited to the given namespaces when there are any
+func (s *userPreferenceService) GetPreferences(ctx context.Context, userID uuid.UUID, namespaces []string) (*UserPreferences, error) {
+	stored, err := s.preferenceRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+
+	result := newUserPreferences(stored)
+	if len(namespaces) > 0 {
+		selected := make(map[string]json.RawMessage)
+		for _, namespace := range namespaces {
+			if value, ok := result.Preferences[namespace]; ok {
+				selected[namespace] = value
+			}
+		}
+		result.Preferences = selected
+	}
+	return result, nil
+}
+
+// UpdatePreferences saves and removes the namespaces of the request and returns all preferences of the user
+func (s *userPreferenceService) UpdatePreferences(ctx context.Context, userID uuid.UUID, req UpdateUserPreferencesRequest) (*UserPreferences, error) {
+	stored, err := s.preferenceRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+	merged := newUserPreferences(stored).Preferences
+
+	// Sorted for a deterministic order of validation errors and writes
+	namespaces := make([]string, 0, len(req.Preferences))
+	for namespace := range req.Preferences {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	var save []models.UserPreference
+	var remove []string
+	for _, namespace := range namespaces {
+		if len(namespace) > maxPreferenceNamespaceLen || !preferenceNamespacePattern.MatchString(namespace) {
+			return nil, fmt.Errorf("%w %q: use up to %d lower case letters, digits, '-' and '_' in dot-separated segments",
+				ErrInvalidPreferenceNamespace, namespace, maxPreferenceNamespaceLen)
+		}
+
+		raw := bytes.TrimSpace(req.Preferences[namespace])
+		if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+			if _, ok := merged[namespace]; ok {
+				remove = append(remove, namespace)
+				delete(merged, namespace)
+			}
+			continue
+		}
+		if raw[0] != '{' {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPreferenceValue, namespace)
+		}
+
+		var value bytes.Buffer
+		if err := json.Compact(&value, raw); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPreferenceValue, namespace)
+		}
+		if value.Len() > maxPreferenceValueSize {
+			return nil, fmt.Errorf("%w: %s is %d bytes, the limit is %d bytes per namespace",
+				ErrPreferencesTooLarge, namespace, value.Len(), maxPreferenceValueSize)
+		}
+		merged[namespace] = value.Bytes()
+		save = append(save, models.UserPreference{Namespace: namespace, Value: value.Bytes()})
+	}
+
+	if len(merged) > maxPreferenceNamespaces {
+		return nil, fmt.Errorf("%w: %d namespaces, the limit is %d", ErrPreferencesTooLarge, len(merged), maxPreferenceNamespaces)
+	}
+	if size := preferencesSize(merged); size > maxPreferencesTotalSize {
+		return nil, fmt.Errorf("%w: %d bytes in total, the limit is %d bytes", ErrPreferencesTooLarge, size, maxPreferencesTotalSize)
+	}
+
+	if len(save) > 0 || len(remove) > 0 {
+		if err := s.preferenceRepo.Apply(ctx, userID, save, remove); err != nil {
+			return nil, fmt.Errorf("failed to save preferences: %w", err)
+		}
+	}
+
+	return &UserPreferences{
+		Preferences: merged,
+		Size:        preferencesSize(merged),
+		Limits:      defaultUserPreferenceLimits(),
+	}, nil
+}
+
+// newUserPreferences builds the response of stored preference namespaces
+func newUserPreferences(stored []models.UserPreference) *UserPreferences {
+	preferences := make(map[string]json.RawMessage, len(stored))
+	for _, preference := range stored {
+		preferences[preference.Namespace] = preference.Value
+	}
+	return &UserPreferences{
+		Preferences: preferences,
+		Size:        preferencesSize(preferences),
+		Limits:      defaultUserPreferenceLimits(),
+	}
+}
+
+// preferencesSize returns the bytes counted against the total size limit
+func preferencesSize(preferences map[string]json.RawMessage) int {
+	size := 0
+	for namespace, value := range preferences {
+		size += len(namespace) + len(value)
+	}
+	return size
+}
+
+func defaultUserPreferenceLimits() UserPreferenceLimits {
+	return UserPreferenceLimits{
+		MaxNamespaces: maxPreferenceNamespaces,
+		MaxValueSize:  maxPreferenceValueSize,
+		MaxTotalSize:  maxPreferencesTotalSize,
+	}
+}
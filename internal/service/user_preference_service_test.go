@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestUserPreferenceService(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}, &models.UserPreference{}))
+
+	user := &models.User{Username: "prefs", Email: "prefs@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	other := &models.User{Username: "other", Email: "other@example.com", PasswordHash: "hash", Role: models.RoleUser}
+	require.NoError(t, db.Create(user).Error)
+	require.NoError(t, db.Create(other).Error)
+
+	service := NewUserPreferenceService(repository.NewRepositories(db, nil))
+	update := func(userID uuid.UUID, body string) (*UserPreferences, error) {
+		var req UpdateUserPreferencesRequest
+		require.NoError(t, json.Unmarshal([]byte(body), &req))
+		return service.UpdatePreferences(ctx, userID, req)
+	}
+
+	t.Run("users without preferences get an empty set", func(t *testing.T) {
+		preferences, err := service.GetPreferences(ctx, user.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, preferences.Preferences)
+		assert.Zero(t, preferences.Size)
+		assert.Equal(t, maxPreferencesTotalSize, preferences.Limits.MaxTotalSize)
+	})
+
+	t.Run("saves namespaces compacted", func(t *testing.T) {
+		preferences, err := update(user.ID, `{"preferences": {
+			"web.board": {"columns": ["Draft", "Active"], "swimlanes": false},
+			"cli": {"output": "table"}
+		}}`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"columns":["Draft","Active"],"swimlanes":false}`, string(preferences.Preferences["web.board"]))
+		assert.Equal(t, `{"output":"table"}`, string(preferences.Preferences["cli"]))
+
+		stored, err := service.GetPreferences(ctx, user.ID, nil)
+		require.NoError(t, err)
+		assert.Len(t, stored.Preferences, 2)
+		assert.Equal(t, preferences.Size, stored.Size)
+
+		others, err := service.GetPreferences(ctx, other.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, others.Preferences)
+	})
+
+	t.Run("keeps namespaces left out, replaces and removes the others", func(t *testing.T) {
+		preferences, err := update(user.ID, `{"preferences": {"cli": {"output": "json"}, "web.board": null, "mcp": null}}`)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]json.RawMessage{"cli": json.RawMessage(`{"output":"json"}`)}, preferences.Preferences)
+
+		_, err = update(user.ID, `{"preferences": {"web.filters": {"status": "Active"}}}`)
+		require.NoError(t, err)
+		stored, err := service.GetPreferences(ctx, user.ID, nil)
+		require.NoError(t, err)
+		assert.Len(t, stored.Preferences, 2)
+		assert.Equal(t, `{"output":"json"}`, string(stored.Preferences["cli"]))
+	})
+
+	t.Run("filters by namespace", func(t *testing.T) {
+		preferences, err := service.GetPreferences(ctx, user.ID, []string{"web.filters", "unknown"})
+		require.NoError(t, err)
+		assert.Len(t, preferences.Preferences, 1)
+		assert.Contains(t, preferences.Preferences, "web.filters")
+	})
+
+	t.Run("validates namespaces and values", func(t *testing.T) {
+		for _, namespace := range []string{"Web", "web..board", ".web", "1web", "web board", strings.Repeat("a", 65)} {
+			_, err := update(user.ID, fmt.Sprintf(`{"preferences": {%q: {}}}`, namespace))
+			assert.ErrorIs(t, err, ErrInvalidPreferenceNamespace, namespace)
+		}
+		for _, value := range []string{`[]`, `"dark"`, `42`, `true`} {
+			_, err := update(user.ID, `{"preferences": {"web": `+value+`}}`)
+			assert.ErrorIs(t, err, ErrInvalidPreferenceValue, value)
+		}
+	})
+
+	t.Run("enforces size limits", func(t *testing.T) {
+		large := fmt.Sprintf(`{"notes": %q}`, strings.Repeat("x", maxPreferenceValueSize))
+		_, err := update(user.ID, `{"preferences": {"web.notes": `+large+`}}`)
+		assert.ErrorIs(t, err, ErrPreferencesTooLarge)
+
+		value := fmt.Sprintf(`{"notes": %q}`, strings.Repeat("x", maxPreferenceValueSize-100))
+		var namespaces []string
+		for i := 0; i < maxPreferencesTotalSize/maxPreferenceValueSize+1; i++ {
+			namespaces = append(namespaces, fmt.Sprintf(`"big.n%d": %s`, i, value))
+		}
+		_, err = update(other.ID, `{"preferences": {`+strings.Join(namespaces, ",")+`}}`)
+		assert.ErrorIs(t, err, ErrPreferencesTooLarge)
+
+		namespaces = nil
+		for i := 0; i <= maxPreferenceNamespaces; i++ {
+			namespaces = append(namespaces, fmt.Sprintf(`"n%d": {}`, i))
+		}
+		_, err = update(other.ID, `{"preferences": {`+strings.Join(namespaces, ",")+`}}`)
+		assert.ErrorIs(t, err, ErrPreferencesTooLarge)
+
+		stored, err := service.GetPreferences(ctx, other.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, stored.Preferences, "rejected updates save nothing")
+	})
+}
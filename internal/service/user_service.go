@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -12,7 +13,7 @@ import (
 // UserService defines operations for retrieving users
 type UserService interface {
 	// GetByName finds a user by username or email
-	GetByName(name string) (*models.User, error)
+	GetByName(ctx context.Context, name string) (*models.User, error)
 }
 
 // userService provides user-related operations backed by a repository
@@ -28,7 +29,7 @@ func NewUserService(userRepo repository.UserRepository) UserService {
 }
 
 // GetByName looks up a user by username or email (if the value contains '@')
-func (s *userService) GetByName(name string) (*models.User, error) {
+func (s *userService) GetByName(ctx context.Context, name string) (*models.User, error) {
 	name = strings.TrimSpace(name)
 	if name == "" {
 		return nil, fmt.Errorf("user name cannot be empty: %w", ErrValidation)
@@ -40,9 +41,9 @@ func (s *userService) GetByName(name string) (*models.User, error) {
 	)
 
 	if strings.Contains(name, "@") {
-		user, err = s.userRepo.GetByEmail(name)
+		user, err = s.userRepo.GetByEmail(ctx, name)
 	} else {
-		user, err = s.userRepo.GetByUsername(name)
+		user, err = s.userRepo.GetByUsername(ctx, name)
 	}
 
 	if err != nil {
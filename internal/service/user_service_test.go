@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 )
 
 func TestUserService_GetByName_Username(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := new(MockUserRepository)
 	expectedUser := &models.User{Username: "john_doe"}
 
@@ -19,7 +21,7 @@ func TestUserService_GetByName_Username(t *testing.T) {
 
 	service := NewUserService(mockRepo)
 
-	user, err := service.GetByName("john_doe")
+	user, err := service.GetByName(ctx, "john_doe")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUser, user)
@@ -28,6 +30,7 @@ func TestUserService_GetByName_Username(t *testing.T) {
 }
 
 func TestUserService_GetByName_Email(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := new(MockUserRepository)
 	expectedUser := &models.User{Email: "john@example.com"}
 
@@ -35,7 +38,7 @@ func TestUserService_GetByName_Email(t *testing.T) {
 
 	service := NewUserService(mockRepo)
 
-	user, err := service.GetByName("john@example.com")
+	user, err := service.GetByName(ctx, "john@example.com")
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUser, user)
@@ -44,13 +47,14 @@ func TestUserService_GetByName_Email(t *testing.T) {
 }
 
 func TestUserService_GetByName_NotFound(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := new(MockUserRepository)
 
 	mockRepo.On("GetByUsername", "missing").Return((*models.User)(nil), repository.ErrNotFound).Once()
 
 	service := NewUserService(mockRepo)
 
-	user, err := service.GetByName("missing")
+	user, err := service.GetByName(ctx, "missing")
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, ErrUserNotFound))
@@ -59,6 +63,7 @@ func TestUserService_GetByName_NotFound(t *testing.T) {
 }
 
 func TestUserService_GetByName_RepositoryError(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := new(MockUserRepository)
 	repoErr := errors.New("database unavailable")
 
@@ -66,7 +71,7 @@ func TestUserService_GetByName_RepositoryError(t *testing.T) {
 
 	service := NewUserService(mockRepo)
 
-	user, err := service.GetByName("john_doe")
+	user, err := service.GetByName(ctx, "john_doe")
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, repoErr))
@@ -75,11 +80,12 @@ func TestUserService_GetByName_RepositoryError(t *testing.T) {
 }
 
 func TestUserService_GetByName_EmptyName(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := new(MockUserRepository)
 
 	service := NewUserService(mockRepo)
 
-	user, err := service.GetByName("   ")
+	user, err := service.GetByName(ctx, "   ")
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, ErrValidation))
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"product-requirements-management/internal/apperrors"
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// User story export formats
+const (
+	UserStoryExportFormatGherkin = "gherkin"
+)
+
+var (
+	ErrInvalidUserStoryExportFormat = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "format must be one of: gherkin")
+)
+
+// UserStoryExportService defines the interface for exporting a user story as a test specification
+type UserStoryExportService interface {
+	ExportUserStory(ctx context.Context, userStoryIDOrRef string, format string) (*UserStoryExport, error)
+}
+
+// UserStoryExport is a rendered user story document
+type UserStoryExport struct {
+	Name        string
+	Extension   string
+	ContentType string
+	Content     string
+}
+
+// userStoryExportService implements UserStoryExportService interface
+type userStoryExportService struct {
+	repos *repository.Repositories
+}
+
+// NewUserStoryExportService creates a new user story export service instance
+func NewUserStoryExportService(repos *repository.Repositories) UserStoryExportService {
+	return &userStoryExportService{repos: repos}
+}
+
+// ExportUserStory renders a user story as a Gherkin feature with a scenario per acceptance criteria.
+// The EARS clauses of a criteria become its steps: WHILE and WHERE clauses Given steps, WHEN and IF
+// clauses When steps, and the SHALL response a Then step.
+func (s *userStoryExportService) ExportUserStory(ctx context.Context, userStoryIDOrRef string, format string) (*UserStoryExport, error) {
+	if format != UserStoryExportFormatGherkin {
+		return nil, ErrInvalidUserStoryExportFormat
+	}
+
+	userStoryID, err := resolveEntityID(ctx, s.repos, models.EntityTypeUserStory, userStoryIDOrRef)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrUserStoryNotFound
+		}
+		return nil, err
+	}
+	userStory, err := s.repos.UserStory.GetByID(ctx, userStoryID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrUserStoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get user story: %w", err)
+	}
+	epic, err := s.repos.Epic.GetByID(ctx, userStory.EpicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get epic: %w", err)
+	}
+
+	acceptanceCriteria, err := s.repos.AcceptanceCriteria.GetByUserStory(ctx, userStory.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list acceptance criteria: %w", err)
+	}
+	sort.SliceStable(acceptanceCriteria, func(i, j int) bool {
+		return acceptanceCriteria[i].CreatedAt.Before(acceptanceCriteria[j].CreatedAt)
+	})
+
+	var w strings.Builder
+	fmt.Fprintf(&w, "@%s @%s\n", epic.ReferenceID, userStory.ReferenceID)
+	fmt.Fprintf(&w, "Feature: %s\n", markdownLine(userStory.Title))
+	for _, line := range strings.Split(safeStringValue(userStory.Description), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			fmt.Fprintf(&w, "  %s\n", line)
+		}
+	}
+	for _, ac := range acceptanceCriteria {
+		steps, ok := gherkinSteps(ac.Description)
+		fmt.Fprintf(&w, "\n  @%s\n", ac.ReferenceID)
+		fmt.Fprintf(&w, "  Scenario: %s\n", markdownLine(ac.Description))
+		if !ok {
+			w.WriteString("    # Not written in EARS; review the steps\n")
+		}
+		for _, step := range steps {
+			fmt.Fprintf(&w, "    %s %s\n", step.keyword, step.text)
+		}
+	}
+
+	return &UserStoryExport{Name: userStory.ReferenceID, Extension: "feature", ContentType: "text/x-gherkin; charset=utf-8", Content: w.String()}, nil
+}
+
+// gherkinStep is a step of a scenario
+type gherkinStep struct {
+	keyword string
+	text    string
+}
+
+var (
+	// earsShallPattern matches the SHALL separating the preconditions and system from the response
+	earsShallPattern = regexp.MustCompile(`(?i)\bshall\b`)
+	// earsClausePattern matches a precondition keyword starting the text or following a comma
+	earsClausePattern = regexp.MustCompile(`(?i)(?:^|,)\s*(when|while|if|where)\b`)
+	// earsThenPattern and earsArticlePattern match where the system name starts after the last precondition
+	earsThenPattern    = regexp.MustCompile(`(?i)\bthen\b`)
+	earsArticlePattern = regexp.MustCompile(`(?i)\bthe\b`)
+)
+
+// gherkinSteps translates an EARS text into Given, When and Then steps.
+// Texts without SHALL become a single Then step and are reported as not EARS.
+func gherkinSteps(description string) ([]gherkinStep, bool) {
+	text := strings.TrimRight(markdownLine(description), ".")
+	shall := earsShallPattern.FindStringIndex(text)
+	if shall == nil {
+		return []gherkinStep{{keyword: "Then", text: text}}, false
+	}
+	preconditions, response := text[:shall[0]], strings.TrimSpace(text[shall[1]:])
+
+	var givens, whens []string
+	subject := preconditions
+	clauses := earsClausePattern.FindAllStringSubmatchIndex(preconditions, -1)
+	for i, clause := range clauses {
+		end := len(preconditions)
+		if i+1 < len(clauses) {
+			end = clauses[i+1][0]
+		}
+		condition := preconditions[clause[3]:end]
+		if i == len(clauses)-1 {
+			condition, subject = splitEARSSubject(condition)
+		}
+		condition = strings.TrimSpace(strings.TrimRight(strings.TrimSpace(condition), ","))
+
+		switch strings.ToLower(preconditions[clause[2]:clause[3]]) {
+		case "while", "where":
+			givens = append(givens, condition)
+		default:
+			whens = append(whens, condition)
+		}
+	}
+	if subject = strings.TrimSpace(subject); subject == "" {
+		subject = "the system"
+	}
+
+	steps := make([]gherkinStep, 0, len(givens)+len(whens)+1)
+	for i, given := range givens {
+		steps = append(steps, gherkinStep{keyword: andKeyword(i, "Given"), text: given})
+	}
+	for i, when := range whens {
+		steps = append(steps, gherkinStep{keyword: andKeyword(i, "When"), text: when})
+	}
+	steps = append(steps, gherkinStep{keyword: "Then", text: subject + " shall " + response})
+	return steps, true
+}
+
+// splitEARSSubject splits the last precondition from the system name that follows it after THEN or a comma,
+// falling back to the last "the" for texts without either
+func splitEARSSubject(condition string) (string, string) {
+	if separators := earsThenPattern.FindAllStringIndex(condition, -1); len(separators) > 0 {
+		separator := separators[len(separators)-1]
+		return condition[:separator[0]], condition[separator[1]:]
+	}
+	if index := strings.LastIndex(condition, ","); index >= 0 {
+		return condition[:index], condition[index+1:]
+	}
+	if articles := earsArticlePattern.FindAllStringIndex(condition, -1); len(articles) > 0 {
+		if article := articles[len(articles)-1]; strings.TrimSpace(condition[:article[0]]) != "" {
+			return condition[:article[0]], condition[article[0]:]
+		}
+	}
+	return condition, ""
+}
+
+// andKeyword continues a run of steps of the same kind with And
+func andKeyword(index int, keyword string) string {
+	if index > 0 {
+		return "And"
+	}
+	return keyword
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+func TestGherkinSteps(t *testing.T) {
+	tests := []struct {
+		description string
+		steps       []gherkinStep
+		ears        bool
+	}{
+		{
+			"WHEN a user enters valid credentials THEN the system SHALL authenticate the user.",
+			[]gherkinStep{{"When", "a user enters valid credentials"}, {"Then", "the system shall authenticate the user"}},
+			true,
+		},
+		{
+			"The system SHALL log every failed login",
+			[]gherkinStep{{"Then", "The system shall log every failed login"}},
+			true,
+		},
+		{
+			"WHILE the cart is open, WHEN the user removes an item, the checkout service SHALL update the total",
+			[]gherkinStep{{"Given", "the cart is open"}, {"When", "the user removes an item"}, {"Then", "the checkout service shall update the total"}},
+			true,
+		},
+		{
+			"WHERE two-factor login is enabled, WHILE the session is new, IF the code is wrong THEN the system SHALL lock the account",
+			[]gherkinStep{{"Given", "two-factor login is enabled"}, {"And", "the session is new"}, {"When", "the code is wrong"}, {"Then", "the system shall lock the account"}},
+			true,
+		},
+		{
+			"when the form is submitted the\napi shall return 201",
+			[]gherkinStep{{"When", "the form is submitted"}, {"Then", "the api shall return 201"}},
+			true,
+		},
+		{
+			"Login should be fast",
+			[]gherkinStep{{"Then", "Login should be fast"}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		steps, ears := gherkinSteps(tt.description)
+		assert.Equal(t, tt.steps, steps, tt.description)
+		assert.Equal(t, tt.ears, ears, tt.description)
+	}
+}
+
+func TestUserStoryExportService(t *testing.T) {
+	ctx := context.Background()
+	db, user, _, _ := setupSupersessionTest(t)
+	var story models.UserStory
+	require.NoError(t, db.First(&story, "reference_id = ?", "US-001").Error)
+	require.NoError(t, db.Model(&story).Update("description", "As a visitor\nI want an account\n").Error)
+
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	created := time.Now()
+	for i, description := range []string{"WHEN the form is submitted THEN the system SHALL create an account", "Emails must be unique"} {
+		require.NoError(t, session.Create(&models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-00" + string(rune('1'+i)),
+			UserStoryID: story.ID, AuthorID: user.ID, Description: description, CreatedAt: created.Add(time.Duration(i) * time.Second)}).Error)
+	}
+
+	svc := NewUserStoryExportService(repository.NewRepositories(db, nil))
+
+	t.Run("renders a feature file", func(t *testing.T) {
+		export, err := svc.ExportUserStory(ctx, "US-001", UserStoryExportFormatGherkin)
+		require.NoError(t, err)
+		assert.Equal(t, "US-001", export.Name)
+		assert.Equal(t, "feature", export.Extension)
+		assert.Equal(t, "@EP-001 @US-001\n"+
+			"Feature: Sign up\n"+
+			"  As a visitor\n"+
+			"  I want an account\n"+
+			"\n"+
+			"  @AC-001\n"+
+			"  Scenario: WHEN the form is submitted THEN the system SHALL create an account\n"+
+			"    When the form is submitted\n"+
+			"    Then the system shall create an account\n"+
+			"\n"+
+			"  @AC-002\n"+
+			"  Scenario: Emails must be unique\n"+
+			"    # Not written in EARS; review the steps\n"+
+			"    Then Emails must be unique\n", export.Content)
+	})
+
+	t.Run("rejects unknown formats and user stories", func(t *testing.T) {
+		_, err := svc.ExportUserStory(ctx, "US-001", "markdown")
+		assert.ErrorIs(t, err, ErrInvalidUserStoryExportFormat)
+		_, err = svc.ExportUserStory(ctx, "US-999", UserStoryExportFormatGherkin)
+		assert.ErrorIs(t, err, ErrUserStoryNotFound)
+	})
+}
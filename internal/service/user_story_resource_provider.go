@@ -30,7 +30,7 @@ func (p *UserStoryResourceProvider) GetResourceDescriptors(ctx context.Context)
 	p.logger.WithContext(ctx).Debug("Getting user story resource descriptors")
 
 	// Get user stories with reasonable limit (1000 items max as per design)
-	userStories, err := p.userStoryRepo.List(nil, "created_at ASC", 1000, 0)
+	userStories, err := p.userStoryRepo.List(ctx, nil, "created_at ASC", 1000, 0)
 	if err != nil {
 		p.logger.WithContext(ctx).WithError(err).Error("Failed to get user stories for resource descriptors")
 		return nil, fmt.Errorf("failed to get user stories: %w", err)
@@ -1,39 +1,42 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
+	"product-requirements-management/internal/apperrors"
 	"product-requirements-management/internal/models"
 	"product-requirements-management/internal/repository"
 	"product-requirements-management/internal/validation"
 )
 
 var (
-	ErrUserStoryNotFound        = errors.New("user story not found")
-	ErrUserStoryHasRequirements = errors.New("user story has associated requirements and cannot be deleted")
-	ErrInvalidUserStoryStatus   = errors.New("invalid user story status")
-	ErrInvalidUserStoryTemplate = errors.New("user story description must follow template: 'As [role], I want [function], so that [goal]'")
+	ErrUserStoryNotFound        = apperrors.New(apperrors.KindNotFound, "USER_STORY_NOT_FOUND", "user story not found")
+	ErrUserStoryHasRequirements = apperrors.New(apperrors.KindConflict, "DELETION_CONFLICT", "user story has associated requirements and cannot be deleted")
+	ErrInvalidUserStoryStatus   = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "invalid user story status")
+	ErrInvalidUserStoryTemplate = apperrors.New(apperrors.KindInvalid, apperrors.CodeValidation, "user story description must follow template: 'As [role], I want [function], so that [goal]'")
 )
 
 // UserStoryService defines the interface for user story business logic
 type UserStoryService interface {
-	CreateUserStory(req CreateUserStoryRequest) (*models.UserStory, error)
-	GetUserStoryByID(id uuid.UUID) (*models.UserStory, error)
-	GetUserStoryByReferenceID(referenceID string) (*models.UserStory, error)
-	UpdateUserStory(id uuid.UUID, req UpdateUserStoryRequest) (*models.UserStory, error)
-	DeleteUserStory(id uuid.UUID, force bool) error
-	ListUserStories(filters UserStoryFilters) ([]models.UserStory, int64, error)
-	GetUserStoryWithAcceptanceCriteria(id uuid.UUID) (*models.UserStory, error)
-	GetUserStoryWithRequirements(id uuid.UUID) (*models.UserStory, error)
-	GetUserStoriesByEpic(epicID uuid.UUID) ([]models.UserStory, error)
-	ChangeUserStoryStatus(id uuid.UUID, newStatus models.UserStoryStatus) (*models.UserStory, error)
-	AssignUserStory(id uuid.UUID, assigneeID uuid.UUID) (*models.UserStory, error)
-	GetUUIDByReferenceID(referenceID string) (uuid.UUID, error)
+	CreateUserStory(ctx context.Context, req CreateUserStoryRequest) (*models.UserStory, error)
+	GetUserStoryByID(ctx context.Context, id uuid.UUID) (*models.UserStory, error)
+	GetUserStoryByReferenceID(ctx context.Context, referenceID string) (*models.UserStory, error)
+	UpdateUserStory(ctx context.Context, id uuid.UUID, req UpdateUserStoryRequest) (*models.UserStory, error)
+	DeleteUserStory(ctx context.Context, id uuid.UUID, force bool) error
+	ListUserStories(ctx context.Context, filters UserStoryFilters) ([]models.UserStory, int64, error)
+	GetUserStoryWithAcceptanceCriteria(ctx context.Context, id uuid.UUID) (*models.UserStory, error)
+	GetUserStoryWithRequirements(ctx context.Context, id uuid.UUID) (*models.UserStory, error)
+	GetUserStoriesByEpic(ctx context.Context, epicID uuid.UUID) ([]models.UserStory, error)
+	ChangeUserStoryStatus(ctx context.Context, id uuid.UUID, newStatus models.UserStoryStatus) (*models.UserStory, error)
+	AssignUserStory(ctx context.Context, id uuid.UUID, assigneeID uuid.UUID) (*models.UserStory, error)
+	GetUUIDByReferenceID(ctx context.Context, referenceID string) (uuid.UUID, error)
 }
 
 // CreateUserStoryRequest represents the request to create a user story
@@ -72,6 +75,11 @@ type CreateUserStoryRequest struct {
 	// @MaxLength 50000
 	// @Example "As a registered user, I want to log in with my email and password, so that I can access my personalized dashboard and account features."
 	Description *string `json:"description,omitempty"`
+
+	// DueDate is the date the user story is due
+	// @Description Date the user story is due (optional)
+	// @Example "2023-02-28T00:00:00Z"
+	DueDate *time.Time `json:"due_date,omitempty"`
 }
 
 // UpdateUserStoryRequest represents the request to update a user story
@@ -106,6 +114,11 @@ type UpdateUserStoryRequest struct {
 	// @MaxLength 50000
 	// @Example "As a security-conscious user, I want to enable two-factor authentication on my account, so that I can protect my personal information from unauthorized access."
 	Description *string `json:"description,omitempty"`
+
+	// DueDate is the date the user story is due
+	// @Description Date the user story is due (optional)
+	// @Example "2023-03-15T00:00:00Z"
+	DueDate *time.Time `json:"due_date,omitempty"`
 }
 
 // UserStoryFilters represents filters for listing user stories
@@ -139,6 +152,11 @@ type UserStoryFilters struct {
 	// @Example 2
 	Priority *models.Priority `json:"priority,omitempty"`
 
+	// IncludeArchived lists archived user stories as well
+	// @Description Include archived user stories (optional, default: false)
+	// @Example true
+	IncludeArchived bool `json:"include_archived,omitempty"`
+
 	// Include specifies which related entities to include
 	// @Description Comma-separated list of related entities to include (optional)
 	// @Example "epic,creator,assignee,acceptance_criteria,requirements,comments"
@@ -151,6 +169,11 @@ type UserStoryFilters struct {
 	// @Example "title ASC"
 	OrderBy string `json:"order_by,omitempty"`
 
+	// Sort specifies several sort keys with directions and takes precedence over OrderBy
+	// @Description Comma-separated sort keys with optional direction (optional); ties are broken by ID
+	// @Example "priority:asc,updated_at:desc"
+	Sort string `json:"sort,omitempty"`
+
 	// Limit specifies the maximum number of results
 	// @Description Maximum number of results to return (optional, default: 50, max: 100)
 	// @Minimum 1
@@ -171,6 +194,9 @@ type userStoryService struct {
 	epicRepo        repository.EpicRepository
 	userRepo        repository.UserRepository
 	statusValidator validation.StatusValidator
+	assignmentRules AssignmentRuleEvaluator
+	// redirectRepo resolves retired reference IDs when set
+	redirectRepo repository.ReferenceRedirectRepository
 }
 
 // NewUserStoryService creates a new user story service instance
@@ -208,7 +234,7 @@ func (s *userStoryService) validateUserStoryTemplate(description *string) error
 }
 
 // CreateUserStory creates a new user story
-func (s *userStoryService) CreateUserStory(req CreateUserStoryRequest) (*models.UserStory, error) {
+func (s *userStoryService) CreateUserStory(ctx context.Context, req CreateUserStoryRequest) (*models.UserStory, error) {
 	// Validate required fields
 	if req.EpicID == uuid.Nil {
 		return nil, fmt.Errorf("epic_id is required")
@@ -220,29 +246,41 @@ func (s *userStoryService) CreateUserStory(req CreateUserStoryRequest) (*models.
 	}
 
 	// Validate epic exists
-	if exists, err := s.epicRepo.Exists(req.EpicID); err != nil {
+	if exists, err := s.epicRepo.Exists(ctx, req.EpicID); err != nil {
 		return nil, fmt.Errorf("failed to check epic existence: %w", err)
 	} else if !exists {
 		return nil, ErrEpicNotFound
 	}
 
 	// Validate creator exists
-	if exists, err := s.userRepo.Exists(req.CreatorID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, req.CreatorID); err != nil {
 		return nil, fmt.Errorf("failed to check creator existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
 	}
 
-	// Set assignee to creator if not specified
+	// Set assignee to creator if not specified and no assignment rule matches
 	assigneeID := req.CreatorID
 	if req.AssigneeID != nil {
 		assigneeID = *req.AssigneeID
 		// Validate assignee exists
-		if exists, err := s.userRepo.Exists(assigneeID); err != nil {
+		if exists, err := s.userRepo.Exists(ctx, assigneeID); err != nil {
 			return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 		} else if !exists {
 			return nil, ErrUserNotFound
 		}
+	} else if s.assignmentRules != nil {
+		ruleAssigneeID, err := s.assignmentRules.DefaultAssignee(ctx, AssignmentSubject{
+			EntityType: models.EntityTypeUserStory,
+			CreatorID:  req.CreatorID,
+			EpicID:     &req.EpicID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate assignment rules: %w", err)
+		}
+		if ruleAssigneeID != nil {
+			assigneeID = *ruleAssigneeID
+		}
 	}
 
 	// Validate user story template format if description is provided
@@ -259,9 +297,10 @@ func (s *userStoryService) CreateUserStory(req CreateUserStoryRequest) (*models.
 		Status:      models.UserStoryStatusBacklog, // Default status
 		Title:       req.Title,
 		Description: req.Description,
+		DueDate:     req.DueDate,
 	}
 
-	if err := s.userStoryRepo.Create(userStory); err != nil {
+	if err := s.userStoryRepo.Create(ctx, userStory); err != nil {
 		return nil, fmt.Errorf("failed to create user story: %w", err)
 	}
 
@@ -269,8 +308,8 @@ func (s *userStoryService) CreateUserStory(req CreateUserStoryRequest) (*models.
 }
 
 // GetUserStoryByID retrieves a user story by its ID with creator, assignee, and epic populated
-func (s *userStoryService) GetUserStoryByID(id uuid.UUID) (*models.UserStory, error) {
-	userStory, err := s.userStoryRepo.GetByIDWithUsers(id)
+func (s *userStoryService) GetUserStoryByID(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
+	userStory, err := s.userStoryRepo.GetByIDWithUsers(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -280,12 +319,13 @@ func (s *userStoryService) GetUserStoryByID(id uuid.UUID) (*models.UserStory, er
 	return userStory, nil
 }
 
-// GetUserStoryByReferenceID retrieves a user story by its reference ID with creator, assignee, and epic populated
-func (s *userStoryService) GetUserStoryByReferenceID(referenceID string) (*models.UserStory, error) {
-	userStory, err := s.userStoryRepo.GetByReferenceIDWithUsers(referenceID)
+// GetUserStoryByReferenceID retrieves a user story by its reference ID with creator, assignee, and epic populated.
+// A retired reference ID resolves to its successor.
+func (s *userStoryService) GetUserStoryByReferenceID(ctx context.Context, referenceID string) (*models.UserStory, error) {
+	userStory, err := s.userStoryRepo.GetByReferenceIDWithUsers(ctx, referenceID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, ErrUserStoryNotFound
+			return getRedirected(ctx, s.redirectRepo, models.EntityTypeUserStory, referenceID, ErrUserStoryNotFound, s.GetUserStoryByID)
 		}
 		return nil, fmt.Errorf("failed to get user story: %w", err)
 	}
@@ -293,8 +333,8 @@ func (s *userStoryService) GetUserStoryByReferenceID(referenceID string) (*model
 }
 
 // UpdateUserStory updates an existing user story
-func (s *userStoryService) UpdateUserStory(id uuid.UUID, req UpdateUserStoryRequest) (*models.UserStory, error) {
-	userStory, err := s.userStoryRepo.GetByID(id)
+func (s *userStoryService) UpdateUserStory(ctx context.Context, id uuid.UUID, req UpdateUserStoryRequest) (*models.UserStory, error) {
+	userStory, err := s.userStoryRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -305,7 +345,7 @@ func (s *userStoryService) UpdateUserStory(id uuid.UUID, req UpdateUserStoryRequ
 	// Update fields if provided
 	if req.AssigneeID != nil {
 		// Validate assignee exists
-		if exists, err := s.userRepo.Exists(*req.AssigneeID); err != nil {
+		if exists, err := s.userRepo.Exists(ctx, *req.AssigneeID); err != nil {
 			return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 		} else if !exists {
 			return nil, ErrUserNotFound
@@ -345,7 +385,11 @@ func (s *userStoryService) UpdateUserStory(id uuid.UUID, req UpdateUserStoryRequ
 		userStory.Description = req.Description
 	}
 
-	if err := s.userStoryRepo.Update(userStory); err != nil {
+	if req.DueDate != nil {
+		userStory.DueDate = req.DueDate
+	}
+
+	if err := s.userStoryRepo.Update(ctx, userStory); err != nil {
 		return nil, fmt.Errorf("failed to update user story: %w", err)
 	}
 
@@ -353,9 +397,9 @@ func (s *userStoryService) UpdateUserStory(id uuid.UUID, req UpdateUserStoryRequ
 }
 
 // DeleteUserStory deletes a user story with dependency validation
-func (s *userStoryService) DeleteUserStory(id uuid.UUID, force bool) error {
+func (s *userStoryService) DeleteUserStory(ctx context.Context, id uuid.UUID, force bool) error {
 	// Check if user story exists
-	_, err := s.userStoryRepo.GetByID(id)
+	_, err := s.userStoryRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return ErrUserStoryNotFound
@@ -365,7 +409,7 @@ func (s *userStoryService) DeleteUserStory(id uuid.UUID, force bool) error {
 
 	// Check for requirements unless force delete
 	if !force {
-		hasRequirements, err := s.userStoryRepo.HasRequirements(id)
+		hasRequirements, err := s.userStoryRepo.HasRequirements(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to check requirements: %w", err)
 		}
@@ -375,7 +419,7 @@ func (s *userStoryService) DeleteUserStory(id uuid.UUID, force bool) error {
 	}
 
 	// Delete the user story (cascade will handle acceptance criteria and requirements if force=true)
-	if err := s.userStoryRepo.Delete(id); err != nil {
+	if err := s.userStoryRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete user story: %w", err)
 	}
 
@@ -383,7 +427,12 @@ func (s *userStoryService) DeleteUserStory(id uuid.UUID, force bool) error {
 }
 
 // ListUserStories retrieves user stories with optional filtering
-func (s *userStoryService) ListUserStories(filters UserStoryFilters) ([]models.UserStory, int64, error) {
+func (s *userStoryService) ListUserStories(ctx context.Context, filters UserStoryFilters) ([]models.UserStory, int64, error) {
+	orderBy, err := userStorySortFields.orderClause(sortOrFallback(filters.Sort, filters.OrderBy), "created_at DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// Build filter map
 	filterMap := make(map[string]interface{})
 
@@ -402,19 +451,16 @@ func (s *userStoryService) ListUserStories(filters UserStoryFilters) ([]models.U
 	if filters.Priority != nil {
 		filterMap["priority"] = *filters.Priority
 	}
+	if !filters.IncludeArchived {
+		filterMap["archived"] = false
+	}
 
 	// Get total count with filters
-	totalCount, err := s.userStoryRepo.Count(filterMap)
+	totalCount, err := s.userStoryRepo.Count(ctx, filterMap)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count user stories: %w", err)
 	}
 
-	// Set default ordering
-	orderBy := "created_at DESC"
-	if filters.OrderBy != "" {
-		orderBy = filters.OrderBy
-	}
-
 	// Set default limit
 	limit := 50
 	if filters.Limit > 0 {
@@ -443,7 +489,7 @@ func (s *userStoryService) ListUserStories(filters UserStoryFilters) ([]models.U
 	sort.Strings(finalIncludes)
 
 	// Always use the method with includes since we have default preloads
-	userStories, err := s.userStoryRepo.ListWithIncludes(filterMap, finalIncludes, orderBy, limit, filters.Offset)
+	userStories, err := s.userStoryRepo.ListWithIncludes(ctx, filterMap, finalIncludes, orderBy, limit, filters.Offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list user stories: %w", err)
 	}
@@ -452,8 +498,8 @@ func (s *userStoryService) ListUserStories(filters UserStoryFilters) ([]models.U
 }
 
 // GetUserStoryWithAcceptanceCriteria retrieves a user story with its acceptance criteria
-func (s *userStoryService) GetUserStoryWithAcceptanceCriteria(id uuid.UUID) (*models.UserStory, error) {
-	userStory, err := s.userStoryRepo.GetWithAcceptanceCriteria(id)
+func (s *userStoryService) GetUserStoryWithAcceptanceCriteria(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
+	userStory, err := s.userStoryRepo.GetWithAcceptanceCriteria(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -464,8 +510,8 @@ func (s *userStoryService) GetUserStoryWithAcceptanceCriteria(id uuid.UUID) (*mo
 }
 
 // GetUserStoryWithRequirements retrieves a user story with its requirements
-func (s *userStoryService) GetUserStoryWithRequirements(id uuid.UUID) (*models.UserStory, error) {
-	userStory, err := s.userStoryRepo.GetWithRequirements(id)
+func (s *userStoryService) GetUserStoryWithRequirements(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
+	userStory, err := s.userStoryRepo.GetWithRequirements(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -476,15 +522,15 @@ func (s *userStoryService) GetUserStoryWithRequirements(id uuid.UUID) (*models.U
 }
 
 // GetUserStoriesByEpic retrieves user stories by epic ID
-func (s *userStoryService) GetUserStoriesByEpic(epicID uuid.UUID) ([]models.UserStory, error) {
+func (s *userStoryService) GetUserStoriesByEpic(ctx context.Context, epicID uuid.UUID) ([]models.UserStory, error) {
 	// Validate epic exists
-	if exists, err := s.epicRepo.Exists(epicID); err != nil {
+	if exists, err := s.epicRepo.Exists(ctx, epicID); err != nil {
 		return nil, fmt.Errorf("failed to check epic existence: %w", err)
 	} else if !exists {
 		return nil, ErrEpicNotFound
 	}
 
-	userStories, err := s.userStoryRepo.GetByEpic(epicID)
+	userStories, err := s.userStoryRepo.GetByEpic(ctx, epicID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user stories by epic: %w", err)
 	}
@@ -493,8 +539,8 @@ func (s *userStoryService) GetUserStoriesByEpic(epicID uuid.UUID) ([]models.User
 }
 
 // ChangeUserStoryStatus changes the status of a user story
-func (s *userStoryService) ChangeUserStoryStatus(id uuid.UUID, newStatus models.UserStoryStatus) (*models.UserStory, error) {
-	userStory, err := s.userStoryRepo.GetByID(id)
+func (s *userStoryService) ChangeUserStoryStatus(ctx context.Context, id uuid.UUID, newStatus models.UserStoryStatus) (*models.UserStory, error) {
+	userStory, err := s.userStoryRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -512,7 +558,7 @@ func (s *userStoryService) ChangeUserStoryStatus(id uuid.UUID, newStatus models.
 	}
 
 	userStory.Status = newStatus
-	if err := s.userStoryRepo.Update(userStory); err != nil {
+	if err := s.userStoryRepo.Update(ctx, userStory); err != nil {
 		return nil, fmt.Errorf("failed to update user story status: %w", err)
 	}
 
@@ -520,15 +566,15 @@ func (s *userStoryService) ChangeUserStoryStatus(id uuid.UUID, newStatus models.
 }
 
 // AssignUserStory assigns a user story to a user
-func (s *userStoryService) AssignUserStory(id uuid.UUID, assigneeID uuid.UUID) (*models.UserStory, error) {
+func (s *userStoryService) AssignUserStory(ctx context.Context, id uuid.UUID, assigneeID uuid.UUID) (*models.UserStory, error) {
 	// Validate assignee exists
-	if exists, err := s.userRepo.Exists(assigneeID); err != nil {
+	if exists, err := s.userRepo.Exists(ctx, assigneeID); err != nil {
 		return nil, fmt.Errorf("failed to check assignee existence: %w", err)
 	} else if !exists {
 		return nil, ErrUserNotFound
 	}
 
-	userStory, err := s.userStoryRepo.GetByID(id)
+	userStory, err := s.userStoryRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, ErrUserStoryNotFound
@@ -537,7 +583,7 @@ func (s *userStoryService) AssignUserStory(id uuid.UUID, assigneeID uuid.UUID) (
 	}
 
 	userStory.AssigneeID = assigneeID
-	if err := s.userStoryRepo.Update(userStory); err != nil {
+	if err := s.userStoryRepo.Update(ctx, userStory); err != nil {
 		return nil, fmt.Errorf("failed to assign user story: %w", err)
 	}
 
@@ -545,6 +591,6 @@ func (s *userStoryService) AssignUserStory(id uuid.UUID, assigneeID uuid.UUID) (
 }
 
 // GetUUIDByReferenceID resolves a user story reference ID to UUID with Redis caching support
-func (s *userStoryService) GetUUIDByReferenceID(referenceID string) (uuid.UUID, error) {
-	return s.userStoryRepo.GetUUIDByReferenceID(referenceID)
+func (s *userStoryService) GetUUIDByReferenceID(ctx context.Context, referenceID string) (uuid.UUID, error) {
+	return s.userStoryRepo.GetUUIDByReferenceID(ctx, referenceID)
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -17,12 +18,12 @@ type MockUserStoryRepository struct {
 	mock.Mock
 }
 
-func (m *MockUserStoryRepository) Create(entity *models.UserStory) error {
+func (m *MockUserStoryRepository) Create(ctx context.Context, entity *models.UserStory) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockUserStoryRepository) GetByID(id uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -30,7 +31,7 @@ func (m *MockUserStoryRepository) GetByID(id uuid.UUID) (*models.UserStory, erro
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByReferenceID(referenceID string) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByReferenceID(ctx context.Context, referenceID string) (*models.UserStory, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -38,7 +39,7 @@ func (m *MockUserStoryRepository) GetByReferenceID(referenceID string) (*models.
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByReferenceIDCaseInsensitive(referenceID string) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByReferenceIDCaseInsensitive(ctx context.Context, referenceID string) (*models.UserStory, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -46,37 +47,37 @@ func (m *MockUserStoryRepository) GetByReferenceIDCaseInsensitive(referenceID st
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) Update(entity *models.UserStory) error {
+func (m *MockUserStoryRepository) Update(ctx context.Context, entity *models.UserStory) error {
 	args := m.Called(entity)
 	return args.Error(0)
 }
 
-func (m *MockUserStoryRepository) Delete(id uuid.UUID) error {
+func (m *MockUserStoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
-func (m *MockUserStoryRepository) List(filters map[string]interface{}, orderBy string, limit, offset int) ([]models.UserStory, error) {
+func (m *MockUserStoryRepository) List(ctx context.Context, filters map[string]interface{}, orderBy string, limit, offset int) ([]models.UserStory, error) {
 	args := m.Called(filters, orderBy, limit, offset)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) Count(filters map[string]interface{}) (int64, error) {
+func (m *MockUserStoryRepository) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	args := m.Called(filters)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) Exists(id uuid.UUID) (bool, error) {
+func (m *MockUserStoryRepository) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) ExistsByReferenceID(referenceID string) (bool, error) {
+func (m *MockUserStoryRepository) ExistsByReferenceID(ctx context.Context, referenceID string) (bool, error) {
 	args := m.Called(referenceID)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) WithTransaction(fn func(*gorm.DB) error) error {
+func (m *MockUserStoryRepository) WithTransaction(ctx context.Context, fn func(*gorm.DB) error) error {
 	args := m.Called(fn)
 	return args.Error(0)
 }
@@ -89,7 +90,7 @@ func (m *MockUserStoryRepository) GetDB() *gorm.DB {
 	return args.Get(0).(*gorm.DB)
 }
 
-func (m *MockUserStoryRepository) GetWithAcceptanceCriteria(id uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetWithAcceptanceCriteria(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -97,7 +98,7 @@ func (m *MockUserStoryRepository) GetWithAcceptanceCriteria(id uuid.UUID) (*mode
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetWithRequirements(id uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetWithRequirements(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -105,42 +106,42 @@ func (m *MockUserStoryRepository) GetWithRequirements(id uuid.UUID) (*models.Use
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByEpic(epicID uuid.UUID) ([]models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByEpic(ctx context.Context, epicID uuid.UUID) ([]models.UserStory, error) {
 	args := m.Called(epicID)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByCreator(creatorID uuid.UUID) ([]models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID) ([]models.UserStory, error) {
 	args := m.Called(creatorID)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByAssignee(assigneeID uuid.UUID) ([]models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByAssignee(ctx context.Context, assigneeID uuid.UUID) ([]models.UserStory, error) {
 	args := m.Called(assigneeID)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByStatus(status models.UserStoryStatus) ([]models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByStatus(ctx context.Context, status models.UserStoryStatus) ([]models.UserStory, error) {
 	args := m.Called(status)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByPriority(priority models.Priority) ([]models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByPriority(ctx context.Context, priority models.Priority) ([]models.UserStory, error) {
 	args := m.Called(priority)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) HasAcceptanceCriteria(id uuid.UUID) (bool, error) {
+func (m *MockUserStoryRepository) HasAcceptanceCriteria(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) HasRequirements(id uuid.UUID) (bool, error) {
+func (m *MockUserStoryRepository) HasRequirements(ctx context.Context, id uuid.UUID) (bool, error) {
 	args := m.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByIDWithUsers(id uuid.UUID) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByIDWithUsers(ctx context.Context, id uuid.UUID) (*models.UserStory, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -148,7 +149,7 @@ func (m *MockUserStoryRepository) GetByIDWithUsers(id uuid.UUID) (*models.UserSt
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByReferenceIDWithUsers(referenceID string) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByReferenceIDWithUsers(ctx context.Context, referenceID string) (*models.UserStory, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -156,7 +157,7 @@ func (m *MockUserStoryRepository) GetByReferenceIDWithUsers(referenceID string)
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetByReferenceIDWithUsersCaseInsensitive(referenceID string) (*models.UserStory, error) {
+func (m *MockUserStoryRepository) GetByReferenceIDWithUsersCaseInsensitive(ctx context.Context, referenceID string) (*models.UserStory, error) {
 	args := m.Called(referenceID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -164,17 +165,18 @@ func (m *MockUserStoryRepository) GetByReferenceIDWithUsersCaseInsensitive(refer
 	return args.Get(0).(*models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) ListWithIncludes(filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.UserStory, error) {
+func (m *MockUserStoryRepository) ListWithIncludes(ctx context.Context, filters map[string]interface{}, includes []string, orderBy string, limit, offset int) ([]models.UserStory, error) {
 	args := m.Called(filters, includes, orderBy, limit, offset)
 	return args.Get(0).([]models.UserStory), args.Error(1)
 }
 
-func (m *MockUserStoryRepository) GetUUIDByReferenceID(referenceID string) (uuid.UUID, error) {
+func (m *MockUserStoryRepository) GetUUIDByReferenceID(ctx context.Context, referenceID string) (uuid.UUID, error) {
 	args := m.Called(referenceID)
 	return args.Get(0).(uuid.UUID), args.Error(1)
 }
 
 func TestUserStoryService_CreateUserStory(t *testing.T) {
+	ctx := context.Background()
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -198,7 +200,7 @@ func TestUserStoryService_CreateUserStory(t *testing.T) {
 		mockUserRepo.On("Exists", creatorID).Return(true, nil)
 		mockUserStoryRepo.On("Create", mock.AnythingOfType("*models.UserStory")).Return(nil)
 
-		result, err := service.CreateUserStory(req)
+		result, err := service.CreateUserStory(ctx, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -223,7 +225,7 @@ func TestUserStoryService_CreateUserStory(t *testing.T) {
 			Title:     "Test User Story",
 		}
 
-		result, err := service.CreateUserStory(req)
+		result, err := service.CreateUserStory(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -243,7 +245,7 @@ func TestUserStoryService_CreateUserStory(t *testing.T) {
 
 		mockEpicRepo.On("Exists", epicID).Return(false, nil)
 
-		result, err := service.CreateUserStory(req)
+		result, err := service.CreateUserStory(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -266,7 +268,7 @@ func TestUserStoryService_CreateUserStory(t *testing.T) {
 		mockEpicRepo.On("Exists", epicID).Return(true, nil)
 		mockUserRepo.On("Exists", creatorID).Return(false, nil)
 
-		result, err := service.CreateUserStory(req)
+		result, err := service.CreateUserStory(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -292,7 +294,7 @@ func TestUserStoryService_CreateUserStory(t *testing.T) {
 		mockEpicRepo.On("Exists", epicID).Return(true, nil)
 		mockUserRepo.On("Exists", creatorID).Return(true, nil)
 
-		result, err := service.CreateUserStory(req)
+		result, err := service.CreateUserStory(ctx, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -304,6 +306,7 @@ func TestUserStoryService_CreateUserStory(t *testing.T) {
 }
 
 func TestUserStoryService_GetUserStoryByID(t *testing.T) {
+	ctx := context.Background()
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -319,7 +322,7 @@ func TestUserStoryService_GetUserStoryByID(t *testing.T) {
 
 		mockUserStoryRepo.On("GetByIDWithUsers", userStoryID).Return(expectedUserStory, nil)
 
-		result, err := service.GetUserStoryByID(userStoryID)
+		result, err := service.GetUserStoryByID(ctx, userStoryID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedUserStory, result)
@@ -332,7 +335,7 @@ func TestUserStoryService_GetUserStoryByID(t *testing.T) {
 
 		mockUserStoryRepo.On("GetByIDWithUsers", userStoryID).Return(nil, repository.ErrNotFound)
 
-		result, err := service.GetUserStoryByID(userStoryID)
+		result, err := service.GetUserStoryByID(ctx, userStoryID)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -343,6 +346,7 @@ func TestUserStoryService_GetUserStoryByID(t *testing.T) {
 }
 
 func TestUserStoryService_UpdateUserStory(t *testing.T) {
+	ctx := context.Background()
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -376,7 +380,7 @@ func TestUserStoryService_UpdateUserStory(t *testing.T) {
 		mockUserRepo.On("Exists", assigneeID).Return(true, nil)
 		mockUserStoryRepo.On("Update", mock.AnythingOfType("*models.UserStory")).Return(nil)
 
-		result, err := service.UpdateUserStory(userStoryID, req)
+		result, err := service.UpdateUserStory(ctx, userStoryID, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -396,7 +400,7 @@ func TestUserStoryService_UpdateUserStory(t *testing.T) {
 
 		mockUserStoryRepo.On("GetByID", userStoryID).Return(nil, repository.ErrNotFound)
 
-		result, err := service.UpdateUserStory(userStoryID, req)
+		result, err := service.UpdateUserStory(ctx, userStoryID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -420,7 +424,7 @@ func TestUserStoryService_UpdateUserStory(t *testing.T) {
 
 		mockUserStoryRepo.On("GetByID", userStoryID).Return(existingUserStory, nil)
 
-		result, err := service.UpdateUserStory(userStoryID, req)
+		result, err := service.UpdateUserStory(ctx, userStoryID, req)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -431,6 +435,7 @@ func TestUserStoryService_UpdateUserStory(t *testing.T) {
 }
 
 func TestUserStoryService_DeleteUserStory(t *testing.T) {
+	ctx := context.Background()
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -448,7 +453,7 @@ func TestUserStoryService_DeleteUserStory(t *testing.T) {
 		mockUserStoryRepo.On("HasRequirements", userStoryID).Return(false, nil)
 		mockUserStoryRepo.On("Delete", userStoryID).Return(nil)
 
-		err := service.DeleteUserStory(userStoryID, false)
+		err := service.DeleteUserStory(ctx, userStoryID, false)
 
 		assert.NoError(t, err)
 
@@ -465,7 +470,7 @@ func TestUserStoryService_DeleteUserStory(t *testing.T) {
 		mockUserStoryRepo.On("GetByID", userStoryID).Return(existingUserStory, nil)
 		mockUserStoryRepo.On("HasRequirements", userStoryID).Return(true, nil)
 
-		err := service.DeleteUserStory(userStoryID, false)
+		err := service.DeleteUserStory(ctx, userStoryID, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrUserStoryHasRequirements, err)
@@ -483,7 +488,7 @@ func TestUserStoryService_DeleteUserStory(t *testing.T) {
 		mockUserStoryRepo.On("GetByID", userStoryID).Return(existingUserStory, nil)
 		mockUserStoryRepo.On("Delete", userStoryID).Return(nil)
 
-		err := service.DeleteUserStory(userStoryID, true)
+		err := service.DeleteUserStory(ctx, userStoryID, true)
 
 		assert.NoError(t, err)
 
@@ -495,7 +500,7 @@ func TestUserStoryService_DeleteUserStory(t *testing.T) {
 
 		mockUserStoryRepo.On("GetByID", userStoryID).Return(nil, repository.ErrNotFound)
 
-		err := service.DeleteUserStory(userStoryID, false)
+		err := service.DeleteUserStory(ctx, userStoryID, false)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrUserStoryNotFound, err)
@@ -505,6 +510,7 @@ func TestUserStoryService_DeleteUserStory(t *testing.T) {
 }
 
 func TestUserStoryService_ListUserStories(t *testing.T) {
+	ctx := context.Background()
 	mockUserStoryRepo := new(MockUserStoryRepository)
 	mockEpicRepo := new(MockEpicRepository)
 	mockUserRepo := new(MockUserRepository)
@@ -534,12 +540,13 @@ func TestUserStoryService_ListUserStories(t *testing.T) {
 			"epic_id":  epicID,
 			"status":   status,
 			"priority": priority,
+			"archived": false,
 		}
 
 		mockUserStoryRepo.On("Count", expectedFilters).Return(int64(2), nil)
-		mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Assignee", "Creator", "Epic"}, "priority ASC", 10, 0).Return(expectedUserStories, nil)
+		mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Assignee", "Creator", "Epic"}, "priority ASC, id ASC", 10, 0).Return(expectedUserStories, nil)
 
-		result, count, err := service.ListUserStories(filters)
+		result, count, err := service.ListUserStories(ctx, filters)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedUserStories, result)
@@ -555,17 +562,17 @@ func TestUserStoryService_ListUserStories(t *testing.T) {
 			{ID: uuid.New(), Title: "User Story 1"},
 		}
 
-		expectedFilters := map[string]interface{}{}
+		expectedFilters := map[string]interface{}{"archived": false}
 
 		mockUserStoryRepo.On("Count", expectedFilters).Return(int64(1), nil)
 		// mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Assignee", "Epic", "Creator"}, "created_at DESC", 50, 0).Return(expectedUserStories, nil)
-		mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Assignee", "Creator", "Epic"}, "created_at DESC", 50, 0).Return(expectedUserStories, nil)
+		mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Assignee", "Creator", "Epic"}, "created_at DESC, id ASC", 50, 0).Return(expectedUserStories, nil)
 		// mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Creator", "Assignee", "Epic"}, "created_at DESC", 50, 0).Return(expectedUserStories, nil)
 		// mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Assignee", "Creator", "Epic"}, "created_at DESC", 50, 0).Return(expectedUserStories, nil)
 		// mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Assignee", "Epic", "Creator"}, "created_at DESC", 50, 0).Return(expectedUserStories, nil)
 		// mockUserStoryRepo.On("ListWithIncludes", expectedFilters, []string{"Creator", "Epic", "Assignee"}, "created_at DESC", 50, 0).Return(expectedUserStories, nil)
 
-		result, count, err := service.ListUserStories(filters)
+		result, count, err := service.ListUserStories(ctx, filters)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedUserStories, result)
@@ -573,6 +580,13 @@ func TestUserStoryService_ListUserStories(t *testing.T) {
 
 		mockUserStoryRepo.AssertExpectations(t)
 	})
+
+	t.Run("invalid sort field", func(t *testing.T) {
+		_, _, err := service.ListUserStories(ctx, UserStoryFilters{Sort: "priority:asc,description:desc"})
+
+		assert.ErrorIs(t, err, ErrInvalidSort)
+		assert.Contains(t, err.Error(), "cannot sort by description")
+	})
 }
 
 func TestUserStoryService_ValidateUserStoryTemplate(t *testing.T) {
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// Validation warning codes
+const (
+	WarningCodeShortDescription     = "SHORT_DESCRIPTION"
+	WarningCodeNoAcceptanceCriteria = "NO_ACCEPTANCE_CRITERIA"
+	WarningCodePossibleDuplicate    = "POSSIBLE_DUPLICATE"
+)
+
+const (
+	// minDescriptionLength is the description length below which a description is reported as very short
+	minDescriptionLength = 30
+	// minDuplicateSimilarity is the lowest title trigram similarity reported as a possible duplicate
+	minDuplicateSimilarity = 0.8
+)
+
+// ValidationWarningService defines the interface for non-blocking quality checks of saved entities
+type ValidationWarningService interface {
+	Check(ctx context.Context, entityType models.EntityType, id uuid.UUID) ([]ValidationWarning, error)
+}
+
+// ValidationWarning is a quality hint about a saved entity that does not fail the request
+// @Description Non-blocking quality hint returned with a created or updated entity
+type ValidationWarning struct {
+	Code    string        `json:"code" example:"POSSIBLE_DUPLICATE"`
+	Field   string        `json:"field,omitempty" example:"title"`
+	Message string        `json:"message" example:"Possible duplicate of REQ-042"`
+	Related *LinkedEntity `json:"related,omitempty"`
+}
+
+// EntityWithWarnings is a saved entity returned together with its validation warnings
+type EntityWithWarnings struct {
+	Entity   json.Marshaler
+	Warnings []ValidationWarning
+}
+
+// MarshalJSON adds the warnings array to the entity's JSON object
+func (e EntityWithWarnings) MarshalJSON() ([]byte, error) {
+	return marshalWithFields(e.Entity, map[string]interface{}{
+		"warnings": e.Warnings,
+	})
+}
+
+// validationWarningService implements ValidationWarningService interface
+type validationWarningService struct {
+	repos *repository.Repositories
+}
+
+// NewValidationWarningService creates a new validation warning service instance
+func NewValidationWarningService(repos *repository.Repositories) ValidationWarningService {
+	return &validationWarningService{repos: repos}
+}
+
+// Check returns the quality warnings of a saved epic, user story, acceptance criteria or requirement:
+// very short descriptions, user stories without acceptance criteria, and user stories or requirements
+// whose title closely matches a sibling's
+func (s *validationWarningService) Check(ctx context.Context, entityType models.EntityType, id uuid.UUID) ([]ValidationWarning, error) {
+	warnings := []ValidationWarning{}
+
+	switch entityType {
+	case models.EntityTypeEpic:
+		epic, err := s.repos.Epic.GetByID(ctx, id)
+		if err != nil {
+			return nil, s.wrapError(err)
+		}
+		warnings = appendShortDescription(warnings, epic.Description)
+
+	case models.EntityTypeUserStory:
+		userStory, err := s.repos.UserStory.GetByID(ctx, id)
+		if err != nil {
+			return nil, s.wrapError(err)
+		}
+		warnings = appendShortDescription(warnings, userStory.Description)
+
+		hasAcceptanceCriteria, err := s.repos.UserStory.HasAcceptanceCriteria(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check acceptance criteria: %w", err)
+		}
+		if !hasAcceptanceCriteria {
+			warnings = append(warnings, ValidationWarning{
+				Code:    WarningCodeNoAcceptanceCriteria,
+				Message: "User story has no acceptance criteria",
+			})
+		}
+
+		siblings, err := s.repos.UserStory.GetByEpic(ctx, userStory.EpicID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user stories: %w", err)
+		}
+		for _, sibling := range siblings {
+			if sibling.ID != id && isPossibleDuplicate(userStory.Title, sibling.Title) {
+				warnings = append(warnings, duplicateWarning(models.EntityTypeUserStory, sibling.ID, sibling.ReferenceID, sibling.Title))
+			}
+		}
+
+	case models.EntityTypeAcceptanceCriteria:
+		acceptanceCriteria, err := s.repos.AcceptanceCriteria.GetByID(ctx, id)
+		if err != nil {
+			return nil, s.wrapError(err)
+		}
+		warnings = appendShortDescription(warnings, &acceptanceCriteria.Description)
+
+	case models.EntityTypeRequirement:
+		requirement, err := s.repos.Requirement.GetByID(ctx, id)
+		if err != nil {
+			return nil, s.wrapError(err)
+		}
+		warnings = appendShortDescription(warnings, requirement.Description)
+
+		siblings, err := s.repos.Requirement.GetByUserStory(ctx, requirement.UserStoryID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list requirements: %w", err)
+		}
+		for _, sibling := range siblings {
+			if sibling.ID == id || sibling.Status == models.RequirementStatusObsolete {
+				continue
+			}
+			if isPossibleDuplicate(requirement.Title, sibling.Title) {
+				warnings = append(warnings, duplicateWarning(models.EntityTypeRequirement, sibling.ID, sibling.ReferenceID, sibling.Title))
+			}
+		}
+
+	default:
+		return nil, ErrInvalidEntityType
+	}
+
+	return warnings, nil
+}
+
+// wrapError maps a repository lookup error of the checked entity
+func (s *validationWarningService) wrapError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return ErrNotFound
+	}
+	return fmt.Errorf("failed to load entity: %w", err)
+}
+
+// appendShortDescription adds a warning when a description is missing or very short
+func appendShortDescription(warnings []ValidationWarning, description *string) []ValidationWarning {
+	if description != nil && utf8.RuneCountInString(strings.TrimSpace(*description)) >= minDescriptionLength {
+		return warnings
+	}
+	return append(warnings, ValidationWarning{
+		Code:    WarningCodeShortDescription,
+		Field:   "description",
+		Message: fmt.Sprintf("Description is missing or shorter than %d characters", minDescriptionLength),
+	})
+}
+
+// isPossibleDuplicate reports whether two titles are close enough to be the same item written twice
+func isPossibleDuplicate(title, other string) bool {
+	return trigramSimilarity(strings.ToLower(title), strings.ToLower(other)) >= minDuplicateSimilarity
+}
+
+// duplicateWarning builds a warning pointing at a sibling entity with a near-identical title
+func duplicateWarning(entityType models.EntityType, id uuid.UUID, referenceID, title string) ValidationWarning {
+	return ValidationWarning{
+		Code:    WarningCodePossibleDuplicate,
+		Field:   "title",
+		Message: "Possible duplicate of " + referenceID,
+		Related: &LinkedEntity{
+			EntityType:  entityType,
+			ID:          id,
+			ReferenceID: referenceID,
+			Title:       title,
+		},
+	}
+}
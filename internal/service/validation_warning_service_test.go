@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"product-requirements-management/internal/models"
+	"product-requirements-management/internal/repository"
+)
+
+// warningCodes returns the codes of validation warnings in order
+func warningCodes(warnings []ValidationWarning) []string {
+	codes := make([]string, len(warnings))
+	for i, warning := range warnings {
+		codes[i] = warning.Code
+	}
+	return codes
+}
+
+func TestValidationWarningService_Check(t *testing.T) {
+	ctx := context.Background()
+	active, obsolete := models.RequirementStatusActive, models.RequirementStatusObsolete
+	db, user, epic, requirements := setupSupersessionTest(t, active, active, obsolete, active)
+	session := db.Session(&gorm.Session{SkipHooks: true})
+	svc := NewValidationWarningService(repository.NewRepositories(db, nil))
+
+	// REQ-001 and REQ-002 share a title; REQ-003 has the same title but is obsolete
+	longDescription := "Passwords must contain letters, digits and symbols"
+	for i, title := range []string{"Password must be strong", "Password must be strong", "Password must be strong", "Sessions expire after 30 minutes"} {
+		updates := map[string]interface{}{"title": title}
+		if i == 3 {
+			updates["description"] = longDescription
+		}
+		require.NoError(t, session.Model(&requirements[i]).Updates(updates).Error)
+	}
+
+	t.Run("reports short descriptions and active duplicates", func(t *testing.T) {
+		warnings, err := svc.Check(ctx, models.EntityTypeRequirement, requirements[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{WarningCodeShortDescription, WarningCodePossibleDuplicate}, warningCodes(warnings))
+		require.NotNil(t, warnings[1].Related)
+		assert.Equal(t, "REQ-002", warnings[1].Related.ReferenceID)
+		assert.Equal(t, "Possible duplicate of REQ-002", warnings[1].Message)
+	})
+
+	t.Run("returns an empty list for a clean requirement", func(t *testing.T) {
+		warnings, err := svc.Check(ctx, models.EntityTypeRequirement, requirements[3].ID)
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+		assert.NotNil(t, warnings)
+	})
+
+	t.Run("reports user stories without acceptance criteria", func(t *testing.T) {
+		story, err := repository.NewRepositories(db, nil).UserStory.GetByReferenceID(ctx, "US-001")
+		require.NoError(t, err)
+		warnings, err := svc.Check(ctx, models.EntityTypeUserStory, story.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{WarningCodeShortDescription, WarningCodeNoAcceptanceCriteria}, warningCodes(warnings))
+
+		criteria := &models.AcceptanceCriteria{ID: uuid.New(), ReferenceID: "AC-001", UserStoryID: story.ID, AuthorID: user.ID,
+			Description: "WHEN a password is weak THEN the system SHALL reject it"}
+		require.NoError(t, session.Create(criteria).Error)
+		warnings, err = svc.Check(ctx, models.EntityTypeUserStory, story.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{WarningCodeShortDescription}, warningCodes(warnings))
+
+		warnings, err = svc.Check(ctx, models.EntityTypeAcceptanceCriteria, criteria.ID)
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("rejects unknown entities", func(t *testing.T) {
+		_, err := svc.Check(ctx, models.EntityTypeEpic, uuid.New())
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = svc.Check(ctx, models.EntityTypeEpic, epic.ID)
+		assert.NoError(t, err)
+		_, err = svc.Check(ctx, models.EntityType("comment"), epic.ID)
+		assert.ErrorIs(t, err, ErrInvalidEntityType)
+	})
+}
+
+func TestEntityWithWarnings_MarshalJSON(t *testing.T) {
+	requirement := &models.Requirement{ID: uuid.New(), ReferenceID: "REQ-001", Title: "Password must be strong"}
+	data, err := json.Marshal(EntityWithWarnings{
+		Entity:   requirement,
+		Warnings: []ValidationWarning{{Code: WarningCodeShortDescription, Field: "description", Message: "too short"}},
+	})
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "REQ-001", decoded["reference_id"])
+	require.Len(t, decoded["warnings"], 1)
+	assert.Equal(t, WarningCodeShortDescription, decoded["warnings"].([]interface{})[0].(map[string]interface{})["code"])
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// errWebhooksDisabled is returned for every webhook of a server that delivers none
+var errWebhooksDisabled = errors.New("webhook delivery is disabled on this server")
+
+// errNonPublicWebhookAddress is returned when a webhook host resolves to an address of the server's own network
+var errNonPublicWebhookAddress = errors.New("webhook host does not resolve to a public address")
+
+// nonPublicPrefixes are special-purpose ranges that netip classifies as neither private nor non-unicast
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),  // shared address space (carrier-grade NAT)
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, may translate to private IPv4 addresses
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("100::/64"),       // discard-only
+	netip.MustParsePrefix("2001::/23"),      // IETF protocol assignments, including Teredo
+	netip.MustParsePrefix("2001:db8::/32"),  // documentation
+	netip.MustParsePrefix("2002::/16"),      // 6to4, may embed private IPv4 addresses
+	netip.MustParsePrefix("fec0::/10"),      // deprecated site-local
+}
+
+// isPublicAddress reports whether ip is a global unicast address outside the private and special-purpose ranges
+func isPublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// rejectNonPublicAddress refuses connections to non-public addresses. It runs after the host name is resolved,
+// for every connection, so redirects and DNS records pointing into the server's network are refused too.
+func rejectNonPublicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !isPublicAddress(ip) {
+		return fmt.Errorf("%w: %s", errNonPublicWebhookAddress, ip)
+	}
+	return nil
+}
+
+// NewWebhookClient returns an HTTP client for user-supplied webhook URLs; it only connects to public addresses
+// and ignores proxy settings, so the address check applies to the webhook host itself
+func NewWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   rejectNonPublicAddress,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+}
+
+// disabledTransport fails every request without connecting anywhere
+type disabledTransport struct{}
+
+// RoundTrip refuses the request
+func (disabledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, errWebhooksDisabled
+}
+
+// NewDisabledWebhookClient returns an HTTP client that refuses every webhook, for servers such as demo
+// sessions whose users must not reach other hosts
+func NewDisabledWebhookClient(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: disabledTransport{}}
+}
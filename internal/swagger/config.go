@@ -18,9 +18,14 @@ type SwaggerConfig struct {
 	Version        string         `json:"version"`
 	Description    string         `json:"description"`
 	Host           string         `json:"host"`
+	SiteDir        string         `json:"site_dir"`
+	AuthConsole    bool           `json:"auth_console"`
 	SecurityConfig SecurityConfig `json:"security"`
 }
 
+// SitePath is where the static documentation site generated by scripts/generate-api-docs is served
+const SitePath = "/docs/site"
+
 // SecurityConfig holds security-related Swagger configuration
 type SecurityConfig struct {
 	RequireAuth      bool `json:"require_auth"`
@@ -51,6 +56,8 @@ func DefaultSwaggerConfig() *SwaggerConfig {
 		Version:     getEnvString("SWAGGER_VERSION", "1.0.0"),
 		Description: getEnvString("SWAGGER_DESCRIPTION", "API for managing product requirements"),
 		Host:        getEnvString("SWAGGER_HOST", "localhost:8080"),
+		SiteDir:     getEnvString("SWAGGER_SITE_DIR", "docs/generated/site"),
+		AuthConsole: getEnvBool("SWAGGER_AUTH_CONSOLE", true),
 		SecurityConfig: SecurityConfig{
 			RequireAuth:      getEnvBool("SWAGGER_REQUIRE_AUTH", false),
 			HideInProduction: getEnvBool("SWAGGER_HIDE_IN_PRODUCTION", true),
@@ -120,9 +127,26 @@ func RegisterSwaggerRoutes(router *gin.Engine, cfg *SwaggerConfig) {
 		return
 	}
 
-	// Configure Swagger UI
+	// Configure Swagger UI; the authorization is kept across reloads so a login lasts until it expires
 	url := ginSwagger.URL(fmt.Sprintf("%s/doc.json", cfg.BasePath))
-	router.GET(fmt.Sprintf("%s/*any", cfg.BasePath), ginSwagger.WrapHandler(swaggerFiles.Handler, url))
+	handler := ginSwagger.WrapHandler(swaggerFiles.Handler, url, ginSwagger.PersistAuthorization(true))
+	if cfg.AuthConsole {
+		handler = withAuthConsole(handler)
+	}
+	router.GET(fmt.Sprintf("%s/*any", cfg.BasePath), handler)
+
+	if cfg.SiteAvailable() {
+		router.Static(SitePath, cfg.SiteDir)
+	}
+}
+
+// SiteAvailable reports whether a generated documentation site exists in SiteDir
+func (c *SwaggerConfig) SiteAvailable() bool {
+	if c.SiteDir == "" {
+		return false
+	}
+	info, err := os.Stat(c.SiteDir)
+	return err == nil && info.IsDir()
 }
 
 // GetEnvironmentStatus returns the current environment status
@@ -0,0 +1,213 @@
+package swagger
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Files of the authentication console added to the Swagger UI, served next to its own assets
+const (
+	authConsoleScript     = "auth-console.js"
+	authConsoleStylesheet = "auth-console.css"
+)
+
+// authConsoleTags are inserted into the Swagger UI index page after its own scripts
+const authConsoleTags = `<link rel="stylesheet" type="text/css" href="./` + authConsoleStylesheet + `">
+<script src="./` + authConsoleScript + `"></script>
+`
+
+// withAuthConsole wraps the Swagger UI handler so its index page gets a login panel. The panel signs in
+// through /auth/login or accepts a personal access token, authorizes the try-it requests of the Swagger UI
+// and shows the current user and role.
+func withAuthConsole(swaggerHandler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch path := c.Request.URL.Path; {
+		case strings.HasSuffix(path, "/"+authConsoleScript):
+			c.Data(http.StatusOK, "application/javascript", []byte(authConsoleJS))
+		case strings.HasSuffix(path, "/"+authConsoleStylesheet):
+			c.Data(http.StatusOK, "text/css; charset=utf-8", []byte(authConsoleCSS))
+		case strings.HasSuffix(path, "/index.html"):
+			writer := &bufferedResponseWriter{ResponseWriter: c.Writer}
+			c.Writer = writer
+			swaggerHandler(c)
+			c.Writer = writer.ResponseWriter
+
+			body := writer.body.Bytes()
+			if index := bytes.LastIndex(body, []byte("</body>")); index >= 0 {
+				body = append(body[:index:index], append([]byte(authConsoleTags), body[index:]...)...)
+			}
+			c.Writer.WriteHeader(writer.Status())
+			_, _ = c.Writer.Write(body)
+		default:
+			swaggerHandler(c)
+		}
+	}
+}
+
+// bufferedResponseWriter holds back the body of a response so it can be changed before it is sent
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(data string) (int, error) {
+	return w.body.WriteString(data)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return false
+}
+
+const authConsoleCSS = `.auth-console { position: sticky; top: 0; z-index: 100; display: flex; flex-wrap: wrap; align-items: center; gap: 8px; padding: 8px 20px; background: #1b1b1b; color: #fff; font-family: sans-serif; font-size: 14px; }
+.auth-console form { display: flex; flex-wrap: wrap; align-items: center; gap: 8px; margin: 0; }
+.auth-console input { padding: 4px 8px; border: 1px solid #555; border-radius: 4px; }
+.auth-console button { padding: 4px 12px; border: 0; border-radius: 4px; background: #49cc90; color: #fff; cursor: pointer; }
+.auth-console button.secondary { background: #555; }
+.auth-console .role { padding: 2px 8px; border-radius: 10px; background: #61affe; }
+.auth-console .error { color: #f93e3e; }
+`
+
+const authConsoleJS = `(function () {
+  'use strict';
+
+  // Must match the security definition of the Swagger specification
+  var scheme = 'BearerAuth';
+  var patPrefix = 'mcp_pat_';
+
+  function element(tag, attributes, text) {
+    var node = document.createElement(tag);
+    Object.keys(attributes || {}).forEach(function (name) { node.setAttribute(name, attributes[name]); });
+    if (text) {
+      node.textContent = text;
+    }
+    return node;
+  }
+
+  // storedToken reads the authorization the Swagger UI persists in local storage
+  function storedToken() {
+    try {
+      var authorized = JSON.parse(localStorage.getItem('authorized') || '{}');
+      var value = authorized[scheme] && authorized[scheme].value;
+      return value ? value.replace(/^Bearer /, '') : '';
+    } catch (e) {
+      return '';
+    }
+  }
+
+  function start(ui) {
+    var panel = element('div', { 'class': 'auth-console' });
+    document.body.insertBefore(panel, document.body.firstChild);
+
+    function authorize(token) {
+      ui.preauthorizeApiKey(scheme, 'Bearer ' + token);
+    }
+
+    function logout(message) {
+      ui.authActions.logout([scheme]);
+      renderLogin(message);
+    }
+
+    function showProfile(token) {
+      return fetch('/auth/profile', { headers: { 'Authorization': 'Bearer ' + token } }).then(function (response) {
+        if (!response.ok) {
+          throw new Error(response.status === 401 ? 'The token is invalid or expired' : 'Could not load the profile');
+        }
+        return response.json();
+      }).then(function (user) {
+        panel.textContent = '';
+        panel.appendChild(element('span', {}, 'Signed in as ' + user.username));
+        panel.appendChild(element('span', { 'class': 'role' }, user.role));
+        if (token.indexOf(patPrefix) === 0) {
+          panel.appendChild(element('span', {}, '(personal access token, accepted only by endpoints that allow it)'));
+        }
+        var button = element('button', { 'type': 'button', 'class': 'secondary' }, 'Log out');
+        button.addEventListener('click', function () { logout(); });
+        panel.appendChild(button);
+      });
+    }
+
+    function renderLogin(message) {
+      panel.textContent = '';
+      var form = element('form');
+      var username = element('input', { 'name': 'username', 'placeholder': 'Username', 'autocomplete': 'username' });
+      var password = element('input', { 'name': 'password', 'type': 'password', 'placeholder': 'Password', 'autocomplete': 'current-password' });
+      form.appendChild(username);
+      form.appendChild(password);
+      form.appendChild(element('button', { 'type': 'submit' }, 'Log in'));
+      form.appendChild(element('span', {}, 'or'));
+      var pat = element('input', { 'name': 'pat', 'type': 'password', 'placeholder': patPrefix + '...', 'autocomplete': 'off' });
+      form.appendChild(pat);
+      form.appendChild(element('button', { 'type': 'button', 'class': 'secondary' }, 'Use token'));
+      if (message) {
+        form.appendChild(element('span', { 'class': 'error' }, message));
+      }
+      panel.appendChild(form);
+
+      function useToken(token) {
+        authorize(token);
+        showProfile(token).catch(function (error) { logout(error.message); });
+      }
+
+      form.addEventListener('submit', function (event) {
+        event.preventDefault();
+        fetch('/auth/login', {
+          method: 'POST',
+          headers: { 'Content-Type': 'application/json' },
+          body: JSON.stringify({ username: username.value, password: password.value })
+        }).then(function (response) {
+          return response.json().then(function (body) {
+            if (!response.ok) {
+              throw new Error(body.error && body.error.message ? body.error.message : body.error || 'Login failed');
+            }
+            return body.token;
+          });
+        }).then(useToken).catch(function (error) { renderLogin(error.message); });
+      });
+      form.querySelector('button[type="button"]').addEventListener('click', function () {
+        if (pat.value) {
+          useToken(pat.value.replace(/^Bearer /, ''));
+        }
+      });
+    }
+
+    var token = storedToken();
+    if (token) {
+      showProfile(token).catch(function (error) { logout(error.message); });
+    } else {
+      renderLogin();
+    }
+  }
+
+  // The Swagger UI is created on window load; wait until it is available
+  window.addEventListener('load', function () {
+    var attempts = 0;
+    var timer = setInterval(function () {
+      if (window.ui && window.ui.preauthorizeApiKey) {
+        clearInterval(timer);
+        start(window.ui);
+      } else if (++attempts > 50) {
+        clearInterval(timer);
+      }
+    }, 100);
+  });
+})();
+`
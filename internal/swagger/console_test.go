@@ -0,0 +1,43 @@
+package swagger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterSwaggerRoutes_AuthConsole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serve := func(router *gin.Engine, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	router := gin.New()
+	RegisterSwaggerRoutes(router, &SwaggerConfig{Enabled: true, BasePath: "/swagger", AuthConsole: true})
+
+	w := serve(router, "/swagger/index.html")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, w.Body.String(), `<div id="swagger-ui"></div>`)
+	assert.Contains(t, w.Body.String(), authConsoleTags+"</body>")
+
+	w = serve(router, "/swagger/swagger-initializer.js")
+	assert.Contains(t, w.Body.String(), "persistAuthorization: true")
+
+	w = serve(router, "/swagger/auth-console.js")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "/auth/login")
+	assert.Equal(t, http.StatusOK, serve(router, "/swagger/auth-console.css").Code)
+
+	// Without the console the Swagger UI is served unchanged
+	router = gin.New()
+	RegisterSwaggerRoutes(router, &SwaggerConfig{Enabled: true, BasePath: "/swagger"})
+	assert.NotContains(t, serve(router, "/swagger/index.html").Body.String(), authConsoleScript)
+	assert.Equal(t, http.StatusNotFound, serve(router, "/swagger/auth-console.js").Code)
+}
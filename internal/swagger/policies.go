@@ -0,0 +1,78 @@
+package swagger
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"product-requirements-management/internal/auth"
+	"product-requirements-management/internal/logger"
+
+	"github.com/swaggo/swag"
+)
+
+// pathParamPattern matches gin path parameters such as :id
+var pathParamPattern = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// DocumentRoutePolicies rewrites the registered Swagger specification so that the documented
+// authentication and role requirements of every operation come from the enforced route policies.
+// Each operation gets an x-required-role extension, a matching security requirement and a sentence
+// appended to its description.
+func DocumentRoutePolicies(policies []auth.RoutePolicy) {
+	spec, ok := swag.GetSwagger(swag.Name).(*swag.Spec)
+	if !ok || spec == nil {
+		return
+	}
+
+	document, err := applyRoutePolicies(spec.ReadDoc(), policies)
+	if err != nil {
+		if logger.Logger != nil {
+			logger.Logger.WithError(err).Warn("Failed to document route policies in Swagger specification")
+		}
+		return
+	}
+
+	// The specification is rendered as a text/template; escape template delimiters in the generated JSON
+	spec.SwaggerTemplate = strings.ReplaceAll(document, "{{", `{{"{{"}}`)
+}
+
+// applyRoutePolicies annotates the operations of a Swagger 2.0 JSON document
+func applyRoutePolicies(document string, policies []auth.RoutePolicy) (string, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return "", err
+	}
+
+	paths, _ := doc["paths"].(map[string]interface{})
+	for _, policy := range policies {
+		pathItem, ok := paths[swaggerPath(policy.Path)].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		operation, ok := pathItem[strings.ToLower(policy.Method)].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		description, _ := operation["description"].(string)
+		operation["description"] = strings.TrimSpace(description + "\n\n**Authorization:** " + policy.Description())
+		if policy.Public {
+			operation["x-required-role"] = "none"
+			operation["security"] = []interface{}{}
+		} else {
+			operation["x-required-role"] = string(policy.Role)
+			operation["security"] = []interface{}{map[string]interface{}{"BearerAuth": []interface{}{}}}
+		}
+	}
+
+	annotated, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(annotated), nil
+}
+
+// swaggerPath converts a gin route path to Swagger path template syntax
+func swaggerPath(ginPath string) string {
+	return pathParamPattern.ReplaceAllString(ginPath, "{$1}")
+}
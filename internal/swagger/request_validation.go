@@ -0,0 +1,521 @@
+package swagger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-openapi/spec"
+	"github.com/google/uuid"
+	"github.com/swaggo/swag"
+)
+
+// maxSchemaDepth stops the validation of self-referencing schemas
+const maxSchemaDepth = 32
+
+// Violation is a part of a request that deviates from the documented API contract
+type Violation struct {
+	// Location is path, query, header or body
+	Location string `json:"location"`
+	// Field is the parameter name, or the JSON path of a body field such as items[0].title
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// RequestValidator checks requests against the operations of a Swagger 2.0 document
+type RequestValidator struct {
+	definitions spec.Definitions
+	paths       []documentedPath
+	operations  sync.Map // "METHOD route" -> *Operation, nil for undocumented routes
+	patterns    sync.Map // pattern -> *regexp.Regexp
+}
+
+type documentedPath struct {
+	segments []string
+	item     spec.PathItem
+}
+
+// Operation is a documented operation matched to a gin route
+type Operation struct {
+	validator  *RequestValidator
+	parameters []spec.Parameter
+	// pathValues maps documented path parameters to the gin parameter or literal segment they match
+	pathValues map[string]pathValue
+}
+
+type pathValue struct {
+	param   string
+	literal string
+}
+
+// NewRegisteredRequestValidator creates a validator for the Swagger document registered by the generated docs package
+func NewRegisteredRequestValidator() (*RequestValidator, error) {
+	document, err := swag.ReadDoc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Swagger specification: %w", err)
+	}
+	return NewRequestValidator(document)
+}
+
+// NewRequestValidator parses a Swagger 2.0 JSON document
+func NewRequestValidator(document string) (*RequestValidator, error) {
+	var swagger spec.Swagger
+	if err := json.Unmarshal([]byte(document), &swagger); err != nil {
+		return nil, fmt.Errorf("failed to parse Swagger specification: %w", err)
+	}
+
+	validator := &RequestValidator{definitions: swagger.Definitions}
+	if swagger.Paths != nil {
+		for path, item := range swagger.Paths.Paths {
+			validator.paths = append(validator.paths, documentedPath{segments: strings.Split(path, "/"), item: item})
+		}
+	}
+	return validator, nil
+}
+
+// Operation returns the documented operation of a gin route such as /api/v1/epics/:id, or nil when the
+// route is not documented. A documented literal segment wins over a documented path parameter.
+func (v *RequestValidator) Operation(method, route string) *Operation {
+	key := method + " " + route
+	if cached, ok := v.operations.Load(key); ok {
+		return cached.(*Operation)
+	}
+
+	var operation *Operation
+	routeSegments := strings.Split(route, "/")
+	bestScore := -1
+	for _, path := range v.paths {
+		pathValues, score, ok := matchPath(path.segments, routeSegments)
+		if !ok || score <= bestScore {
+			continue
+		}
+		documented := pathOperation(path.item, method)
+		if documented == nil {
+			continue
+		}
+		bestScore = score
+		operation = &Operation{
+			validator:  v,
+			parameters: mergeParameters(path.item.Parameters, documented.Parameters),
+			pathValues: pathValues,
+		}
+	}
+
+	v.operations.Store(key, operation)
+	return operation
+}
+
+// matchPath matches the segments of a documented path to a gin route and counts the matching literal segments
+func matchPath(documented, route []string) (map[string]pathValue, int, bool) {
+	if len(documented) != len(route) {
+		return nil, 0, false
+	}
+	values := make(map[string]pathValue)
+	score := 0
+	for i, segment := range documented {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := segment[1 : len(segment)-1]
+			if strings.HasPrefix(route[i], ":") || strings.HasPrefix(route[i], "*") {
+				values[name] = pathValue{param: route[i][1:]}
+			} else {
+				values[name] = pathValue{literal: route[i]}
+			}
+			continue
+		}
+		if segment != route[i] {
+			return nil, 0, false
+		}
+		score++
+	}
+	return values, score, true
+}
+
+func pathOperation(item spec.PathItem, method string) *spec.Operation {
+	switch strings.ToUpper(method) {
+	case "GET":
+		return item.Get
+	case "POST":
+		return item.Post
+	case "PUT":
+		return item.Put
+	case "PATCH":
+		return item.Patch
+	case "DELETE":
+		return item.Delete
+	case "HEAD":
+		return item.Head
+	case "OPTIONS":
+		return item.Options
+	default:
+		return nil
+	}
+}
+
+// mergeParameters applies the operation parameters over the parameters shared by all operations of a path
+func mergeParameters(shared, own []spec.Parameter) []spec.Parameter {
+	parameters := append([]spec.Parameter(nil), own...)
+	for _, parameter := range shared {
+		overridden := false
+		for _, ownParameter := range own {
+			if ownParameter.Name == parameter.Name && ownParameter.In == parameter.In {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters
+}
+
+// HasBody reports whether the operation documents a JSON request body
+func (o *Operation) HasBody() bool {
+	return o.bodyParameter() != nil
+}
+
+func (o *Operation) bodyParameter() *spec.Parameter {
+	for i := range o.parameters {
+		if o.parameters[i].In == "body" {
+			return &o.parameters[i]
+		}
+	}
+	return nil
+}
+
+// Validate checks the path and query parameters, headers and JSON body of a request. pathParam returns the
+// value of a gin path parameter. Undocumented query parameters and headers are ignored; form data is not checked.
+func (o *Operation) Validate(pathParam func(name string) string, query url.Values, header func(name string) string, body []byte) []Violation {
+	var violations []Violation
+	for _, parameter := range o.parameters {
+		switch parameter.In {
+		case "path":
+			value, ok := o.pathValues[parameter.Name]
+			if !ok {
+				continue
+			}
+			raw := value.literal
+			if value.param != "" {
+				raw = strings.TrimPrefix(pathParam(value.param), "/")
+			}
+			violations = o.validateSimpleParameter(parameter, []string{raw}, violations)
+		case "query":
+			violations = o.validateSimpleParameter(parameter, query[parameter.Name], violations)
+		case "header":
+			var values []string
+			if value := header(parameter.Name); value != "" {
+				values = []string{value}
+			}
+			violations = o.validateSimpleParameter(parameter, values, violations)
+		}
+	}
+
+	if parameter := o.bodyParameter(); parameter != nil {
+		violations = o.validateBody(parameter, body, violations)
+	}
+	return violations
+}
+
+// validateSimpleParameter converts the raw values of a path, query or header parameter to its documented type
+func (o *Operation) validateSimpleParameter(parameter spec.Parameter, raw []string, violations []Violation) []Violation {
+	if len(raw) == 0 || (len(raw) == 1 && raw[0] == "" && !parameter.AllowEmptyValue) {
+		if parameter.Required {
+			violations = append(violations, Violation{Location: parameter.In, Field: parameter.Name, Message: "is required"})
+		}
+		return violations
+	}
+
+	schema := &spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:      spec.StringOrArray{parameter.Type},
+		Format:    parameter.Format,
+		Enum:      parameter.Enum,
+		Minimum:   parameter.Minimum,
+		Maximum:   parameter.Maximum,
+		MinLength: parameter.MinLength,
+		MaxLength: parameter.MaxLength,
+		Pattern:   parameter.Pattern,
+		MinItems:  parameter.MinItems,
+		MaxItems:  parameter.MaxItems,
+	}}
+
+	var value interface{}
+	if parameter.Type == "array" {
+		if parameter.CollectionFormat != "multi" {
+			raw = splitCollection(raw[0], parameter.CollectionFormat)
+		}
+		items := make([]interface{}, 0, len(raw))
+		for _, item := range raw {
+			converted, ok := convertSimpleValue(parameter.Items, item)
+			if !ok {
+				return append(violations, Violation{Location: parameter.In, Field: parameter.Name,
+					Message: fmt.Sprintf("%q is not a valid %s", item, parameter.Items.Type)})
+			}
+			items = append(items, converted)
+		}
+		if parameter.Items != nil {
+			schema.Items = &spec.SchemaOrArray{Schema: &spec.Schema{SchemaProps: spec.SchemaProps{
+				Type:    spec.StringOrArray{parameter.Items.Type},
+				Format:  parameter.Items.Format,
+				Enum:    parameter.Items.Enum,
+				Minimum: parameter.Items.Minimum,
+				Maximum: parameter.Items.Maximum,
+			}}}
+		}
+		value = items
+	} else {
+		converted, ok := convertSimpleValue(&spec.Items{SimpleSchema: parameter.SimpleSchema}, raw[0])
+		if !ok {
+			return append(violations, Violation{Location: parameter.In, Field: parameter.Name,
+				Message: fmt.Sprintf("%q is not a valid %s", raw[0], parameter.Type)})
+		}
+		value = converted
+	}
+
+	for _, violation := range o.validator.validateValue(schema, value, parameter.Name, 0) {
+		violation.Location = parameter.In
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+func splitCollection(value, format string) []string {
+	separator := ","
+	switch format {
+	case "ssv":
+		separator = " "
+	case "tsv":
+		separator = "\t"
+	case "pipes":
+		separator = "|"
+	}
+	return strings.Split(value, separator)
+}
+
+// convertSimpleValue converts a raw parameter value to the JSON type of its documented type
+func convertSimpleValue(items *spec.Items, raw string) (interface{}, bool) {
+	if items == nil {
+		return raw, true
+	}
+	switch items.Type {
+	case "integer":
+		value, err := strconv.ParseInt(raw, 10, 64)
+		return float64(value), err == nil
+	case "number":
+		value, err := strconv.ParseFloat(raw, 64)
+		return value, err == nil
+	case "boolean":
+		value, err := strconv.ParseBool(raw)
+		return value, err == nil
+	default:
+		return raw, true
+	}
+}
+
+func (o *Operation) validateBody(parameter *spec.Parameter, body []byte, violations []Violation) []Violation {
+	if len(strings.TrimSpace(string(body))) == 0 {
+		if parameter.Required {
+			violations = append(violations, Violation{Location: "body", Message: "request body is required"})
+		}
+		return violations
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return append(violations, Violation{Location: "body", Message: "request body is not valid JSON"})
+	}
+	if parameter.Schema == nil {
+		return violations
+	}
+	for _, violation := range o.validator.validateValue(parameter.Schema, value, "", 0) {
+		violation.Location = "body"
+		violations = append(violations, violation)
+	}
+	return violations
+}
+
+// validateValue checks a decoded JSON value against a schema. Null is accepted for every schema because the
+// handlers bind optional fields to pointers; required properties must be present and not null.
+func (v *RequestValidator) validateValue(schema *spec.Schema, value interface{}, field string, depth int) []Violation {
+	if schema == nil || value == nil || depth > maxSchemaDepth {
+		return nil
+	}
+
+	if ref := schema.Ref.String(); ref != "" {
+		definition, ok := v.definitions[strings.TrimPrefix(ref, "#/definitions/")]
+		if !ok {
+			return nil
+		}
+		return v.validateValue(&definition, value, field, depth+1)
+	}
+
+	var violations []Violation
+	for i := range schema.AllOf {
+		violations = append(violations, v.validateValue(&schema.AllOf[i], value, field, depth+1)...)
+	}
+
+	invalid := func(format string, args ...interface{}) []Violation {
+		return append(violations, Violation{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		return invalid("must be one of %s", formatEnum(schema.Enum))
+	}
+
+	schemaType := ""
+	if len(schema.Type) > 0 {
+		schemaType = schema.Type[0]
+	}
+	switch schemaType {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return invalid("must be an object")
+		}
+		return append(violations, v.validateObject(schema, object, field, depth)...)
+
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return invalid("must be an array")
+		}
+		if schema.MinItems != nil && int64(len(items)) < *schema.MinItems {
+			return invalid("must have at least %d items", *schema.MinItems)
+		}
+		if schema.MaxItems != nil && int64(len(items)) > *schema.MaxItems {
+			return invalid("must have at most %d items", *schema.MaxItems)
+		}
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range items {
+				violations = append(violations, v.validateValue(schema.Items.Schema, item, fmt.Sprintf("%s[%d]", field, i), depth+1)...)
+			}
+		}
+
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return invalid("must be a string")
+		}
+		length := int64(utf8.RuneCountInString(text))
+		if schema.MinLength != nil && length < *schema.MinLength {
+			return invalid("must be at least %d characters", *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			return invalid("must be at most %d characters", *schema.MaxLength)
+		}
+		if message := checkFormat(schema.Format, text); message != "" {
+			return invalid("%s", message)
+		}
+		if schema.Pattern != "" && !v.pattern(schema.Pattern).MatchString(text) {
+			return invalid("must match %s", schema.Pattern)
+		}
+
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok {
+			return invalid("must be a %s", schemaType)
+		}
+		if schemaType == "integer" && number != float64(int64(number)) {
+			return invalid("must be an integer")
+		}
+		if schema.Minimum != nil && number < *schema.Minimum {
+			return invalid("must be at least %v", *schema.Minimum)
+		}
+		if schema.Maximum != nil && number > *schema.Maximum {
+			return invalid("must be at most %v", *schema.Maximum)
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return invalid("must be a boolean")
+		}
+	}
+	return violations
+}
+
+// validateObject checks the required and documented properties of an object. Properties that are not
+// documented are rejected unless the schema allows additional properties or documents no properties at all.
+func (v *RequestValidator) validateObject(schema *spec.Schema, object map[string]interface{}, field string, depth int) []Violation {
+	var violations []Violation
+	for _, name := range schema.Required {
+		if object[name] == nil {
+			violations = append(violations, Violation{Field: joinField(field, name), Message: "is required"})
+		}
+	}
+
+	for name, value := range object {
+		if property, ok := schema.Properties[name]; ok {
+			violations = append(violations, v.validateValue(&property, value, joinField(field, name), depth+1)...)
+			continue
+		}
+		additional := schema.AdditionalProperties
+		switch {
+		case additional != nil && additional.Schema != nil:
+			violations = append(violations, v.validateValue(additional.Schema, value, joinField(field, name), depth+1)...)
+		case additional != nil && additional.Allows, additional == nil && len(schema.Properties) == 0:
+		default:
+			violations = append(violations, Violation{Field: joinField(field, name), Message: "is not a documented field"})
+		}
+	}
+	return violations
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func checkFormat(format, value string) string {
+	switch format {
+	case "uuid":
+		if _, err := uuid.Parse(value); err != nil {
+			return "must be a UUID"
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return "must be an RFC 3339 date-time"
+		}
+	case "date":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return "must be a date (YYYY-MM-DD)"
+		}
+	}
+	return ""
+}
+
+func (v *RequestValidator) pattern(expression string) *regexp.Regexp {
+	if cached, ok := v.patterns.Load(expression); ok {
+		return cached.(*regexp.Regexp)
+	}
+	pattern, err := regexp.Compile(expression)
+	if err != nil {
+		// An invalid documented pattern matches everything instead of rejecting every request
+		pattern = regexp.MustCompile("")
+	}
+	v.patterns.Store(expression, pattern)
+	return pattern
+}
+
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatEnum(enum []interface{}) string {
+	values := make([]string, len(enum))
+	for i, value := range enum {
+		values[i] = fmt.Sprint(value)
+	}
+	return strings.Join(values, ", ")
+}
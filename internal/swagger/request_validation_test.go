@@ -0,0 +1,102 @@
+package swagger
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "product-requirements-management/docs"
+)
+
+func noPathParams(string) string { return "" }
+func noHeaders(string) string    { return "" }
+
+func violatedFields(violations []Violation) []string {
+	fields := make([]string, len(violations))
+	for i, violation := range violations {
+		fields[i] = violation.Location + ":" + violation.Field
+	}
+	return fields
+}
+
+func TestRequestValidator_RegisteredSpecification(t *testing.T) {
+	validator, err := NewRegisteredRequestValidator()
+	require.NoError(t, err)
+
+	t.Run("undocumented route", func(t *testing.T) {
+		assert.Nil(t, validator.Operation("GET", "/api/v1/undocumented"))
+		assert.Nil(t, validator.Operation("PATCH", "/api/v1/epics"))
+	})
+
+	t.Run("valid body", func(t *testing.T) {
+		operation := validator.Operation("POST", "/api/v1/epics")
+		require.NotNil(t, operation)
+		assert.True(t, operation.HasBody())
+
+		violations := operation.Validate(noPathParams, url.Values{}, noHeaders,
+			[]byte(`{"title":"Checkout","priority":2,"description":null}`))
+		assert.Empty(t, violations)
+	})
+
+	t.Run("body deviating from the contract", func(t *testing.T) {
+		operation := validator.Operation("POST", "/api/v1/epics")
+		violations := operation.Validate(noPathParams, url.Values{}, noHeaders,
+			[]byte(`{"priority":7,"owner":"alice"}`))
+		assert.ElementsMatch(t, []string{"body:title", "body:priority", "body:owner"}, violatedFields(violations))
+	})
+
+	t.Run("missing and malformed body", func(t *testing.T) {
+		operation := validator.Operation("POST", "/api/v1/epics")
+		assert.Equal(t, []Violation{{Location: "body", Message: "request body is required"}},
+			operation.Validate(noPathParams, url.Values{}, noHeaders, nil))
+		assert.Equal(t, []Violation{{Location: "body", Message: "request body is not valid JSON"}},
+			operation.Validate(noPathParams, url.Values{}, noHeaders, []byte(`{"title":`)))
+	})
+
+	t.Run("query parameters", func(t *testing.T) {
+		operation := validator.Operation("GET", "/api/v1/epics")
+		require.NotNil(t, operation)
+
+		assert.Empty(t, operation.Validate(noPathParams, url.Values{"limit": {"20"}, "status": {"Draft"}, "debug": {"1"}}, noHeaders, nil))
+
+		violations := operation.Validate(noPathParams, url.Values{"limit": {"500"}, "priority": {"high"}, "status": {"Open"}}, noHeaders, nil)
+		assert.ElementsMatch(t, []string{"query:limit", "query:priority", "query:status"}, violatedFields(violations))
+	})
+
+	t.Run("path parameters", func(t *testing.T) {
+		operation := validator.Operation("GET", "/api/v1/epics/:id")
+		require.NotNil(t, operation)
+		assert.Empty(t, operation.Validate(func(string) string { return "EP-001" }, url.Values{}, noHeaders, nil))
+	})
+}
+
+func TestRequestValidator_PathMatching(t *testing.T) {
+	validator, err := NewRequestValidator(`{
+		"swagger": "2.0",
+		"paths": {
+			"/api/v1/{entityType}/{id}/comments": {
+				"get": {"parameters": [
+					{"name": "entityType", "in": "path", "required": true, "type": "string", "enum": ["epics", "requirements"]},
+					{"name": "id", "in": "path", "required": true, "type": "string", "format": "uuid"}
+				]}
+			},
+			"/api/v1/epics/{id}/comments": {
+				"get": {"parameters": [{"name": "id", "in": "path", "required": true, "type": "string"}]}
+			}
+		}
+	}`)
+	require.NoError(t, err)
+
+	// The literal documented path wins over the generic one
+	operation := validator.Operation("GET", "/api/v1/epics/:id/comments")
+	require.NotNil(t, operation)
+	assert.Empty(t, operation.Validate(func(string) string { return "EP-001" }, url.Values{}, noHeaders, nil))
+
+	// Literal route segments are validated against the documented path parameter
+	operation = validator.Operation("GET", "/api/v1/user-stories/:id/comments")
+	require.NotNil(t, operation)
+	violations := operation.Validate(func(string) string { return "US-001" }, url.Values{}, noHeaders, nil)
+	assert.ElementsMatch(t, []string{"path:entityType", "path:id"}, violatedFields(violations))
+}
@@ -684,7 +684,7 @@ func validatePublicEndpointsMarked(t *testing.T, spec map[string]interface{}) {
 		return
 	}
 
-	publicEndpoints := []string{"/auth/login", "/ready", "/live"}
+	publicEndpoints := []string{"/auth/login", "/auth/invitations/accept", "/ready", "/live"}
 
 	for _, endpoint := range publicEndpoints {
 		if pathValue, exists := paths[endpoint]; exists {
@@ -756,7 +756,7 @@ func validateAuthenticationRequirementsConsistent(t *testing.T, spec map[string]
 		return
 	}
 
-	publicEndpoints := []string{"/auth/login", "/ready", "/live"}
+	publicEndpoints := []string{"/auth/login", "/auth/invitations/accept", "/ready", "/live"}
 
 	for pathName, pathValue := range paths {
 		pathMap, ok := pathValue.(map[string]interface{})
@@ -0,0 +1,239 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// EARSPattern identifies the EARS (Easy Approach to Requirements Syntax) template a text follows
+type EARSPattern string
+
+const (
+	EARSPatternUbiquitous       EARSPattern = "ubiquitous"        // The <system> shall <response>
+	EARSPatternEventDriven      EARSPattern = "event-driven"      // WHEN <trigger> THEN the <system> shall <response>
+	EARSPatternStateDriven      EARSPattern = "state-driven"      // WHILE <state> the <system> shall <response>
+	EARSPatternUnwantedBehavior EARSPattern = "unwanted-behavior" // IF <condition> THEN the <system> shall <response>
+	EARSPatternOptionalFeature  EARSPattern = "optional-feature"  // WHERE <feature> the <system> shall <response>
+	EARSPatternComplex          EARSPattern = "complex"           // Combination of the keywords above
+	EARSPatternUnknown          EARSPattern = "unknown"           // No EARS template recognized
+)
+
+// LintSeverity is the severity of a lint finding
+type LintSeverity string
+
+const (
+	LintSeverityError   LintSeverity = "error"   // The text does not follow EARS
+	LintSeverityWarning LintSeverity = "warning" // The text follows EARS but is likely ambiguous
+	LintSeverityInfo    LintSeverity = "info"    // Style suggestion
+)
+
+// LintFinding is a single problem found in a text
+// @Description Lint finding; start and end are byte offsets of the offending text, like inline comment positions
+type LintFinding struct {
+	Rule     string       `json:"rule" example:"missing-system"`
+	Severity LintSeverity `json:"severity" example:"error"`
+	Message  string       `json:"message" example:"Name the system responsible before SHALL"`
+	Start    int          `json:"start" example:"0"`
+	End      int          `json:"end" example:"4"`
+}
+
+// EARSLintResult is the outcome of linting a text against the EARS patterns
+// @Description EARS lint result; the text is valid when there are no error findings
+type EARSLintResult struct {
+	Pattern  EARSPattern   `json:"pattern" example:"event-driven"`
+	Valid    bool          `json:"valid" example:"true"`
+	Findings []LintFinding `json:"findings"`
+}
+
+// EARSLinter defines the interface for checking texts against EARS patterns
+type EARSLinter interface {
+	// Lint checks a text such as "WHEN a user submits the form THEN the system SHALL save the order"
+	Lint(text string) EARSLintResult
+}
+
+// earsLinter implements the EARSLinter interface
+type earsLinter struct{}
+
+// NewEARSLinter creates a new instance of EARSLinter
+func NewEARSLinter() EARSLinter {
+	return &earsLinter{}
+}
+
+// earsWordPattern matches words, keeping hyphenated words together
+var earsWordPattern = regexp.MustCompile(`[\p{L}\p{N}]+(?:[-'][\p{L}\p{N}]+)*`)
+
+// earsPreconditions maps the EARS precondition keywords to their patterns
+var earsPreconditions = map[string]EARSPattern{
+	"when":  EARSPatternEventDriven,
+	"while": EARSPatternStateDriven,
+	"if":    EARSPatternUnwantedBehavior,
+	"where": EARSPatternOptionalFeature,
+}
+
+// earsWeakModals are modal verbs often used where SHALL is meant
+var earsWeakModals = map[string]bool{
+	"should": true, "will": true, "must": true, "may": true, "can": true, "could": true, "might": true,
+}
+
+// earsVagueTerms are words and phrases that make criteria hard to test
+var earsVagueTerms = []string{
+	"as appropriate", "as needed", "if possible", "and/or", "etc",
+	"fast", "quickly", "easy", "easily", "user-friendly", "appropriate", "adequate", "sufficient",
+	"efficient", "flexible", "robust", "normally", "usually", "approximately", "several",
+}
+
+type earsWord struct {
+	text       string // lower-cased
+	start, end int
+}
+
+// Lint checks a text against the EARS patterns
+func (l *earsLinter) Lint(text string) EARSLintResult {
+	result := EARSLintResult{Pattern: EARSPatternUnknown, Findings: make([]LintFinding, 0)}
+	add := func(rule string, severity LintSeverity, message string, start, end int) {
+		result.Findings = append(result.Findings, LintFinding{Rule: rule, Severity: severity, Message: message, Start: start, End: end})
+	}
+
+	if strings.TrimSpace(text) == "" {
+		add("empty", LintSeverityError, "Acceptance criteria text is empty", 0, 0)
+		return result
+	}
+
+	var words []earsWord
+	for _, match := range earsWordPattern.FindAllStringIndex(text, -1) {
+		words = append(words, earsWord{text: strings.ToLower(text[match[0]:match[1]]), start: match[0], end: match[1]})
+	}
+
+	shalls := make([]int, 0)
+	for i, word := range words {
+		if word.text == "shall" {
+			shalls = append(shalls, i)
+		}
+	}
+
+	if len(shalls) == 0 {
+		for _, word := range words {
+			if earsWeakModals[word.text] {
+				add("weak-modal", LintSeverityError,
+					fmt.Sprintf("Use SHALL instead of %q to state a mandatory response", text[word.start:word.end]), word.start, word.end)
+				return l.finish(result)
+			}
+		}
+		add("missing-shall", LintSeverityError, "State the required response as '<system> SHALL <response>'", 0, len(text))
+		return l.finish(result)
+	}
+	shall := shalls[0]
+
+	// Precondition clauses start the text or follow a comma before SHALL
+	var keywords []earsWord
+	for i := 0; i < shall; i++ {
+		if _, ok := earsPreconditions[words[i].text]; ok && (i == 0 || strings.HasSuffix(strings.TrimSpace(text[words[i-1].end:words[i].start]), ",")) {
+			keywords = append(keywords, words[i])
+		}
+	}
+
+	switch {
+	case len(keywords) == 0:
+		result.Pattern = EARSPatternUbiquitous
+		if words[0].text == "then" {
+			add("missing-precondition", LintSeverityError, "THEN must follow a WHEN or IF clause", words[0].start, words[0].end)
+		}
+	case len(keywords) == 1:
+		result.Pattern = earsPreconditions[keywords[0].text]
+	default:
+		result.Pattern = EARSPatternComplex
+	}
+	if len(keywords) > 0 && keywords[0].start != words[0].start {
+		add("precondition-first", LintSeverityWarning,
+			fmt.Sprintf("Start with the %s clause", strings.ToUpper(keywords[0].text)), keywords[0].start, keywords[0].end)
+	}
+
+	thenIndex := -1
+	for i := 0; i < shall; i++ {
+		if words[i].text == "then" {
+			thenIndex = i
+			break
+		}
+	}
+
+	// Every precondition keyword needs a clause before the next keyword, THEN or SHALL
+	for _, keyword := range keywords {
+		index := wordIndex(words, keyword.start)
+		next := words[index+1:]
+		if len(next) == 0 || next[0].text == "then" || next[0].text == "shall" || earsPreconditions[next[0].text] != "" ||
+			strings.HasPrefix(strings.TrimSpace(text[keyword.end:]), ",") {
+			add("empty-precondition", LintSeverityError,
+				fmt.Sprintf("Describe the %s condition", strings.ToUpper(keyword.text)), keyword.start, keyword.end)
+		}
+	}
+
+	if result.Pattern == EARSPatternUnwantedBehavior && thenIndex < 0 {
+		add("missing-then", LintSeverityError, "IF clauses need THEN before the system response", words[shall].start, words[shall].end)
+	}
+
+	if shall == 0 || words[shall-1].text == "then" || earsPreconditions[words[shall-1].text] != "" {
+		add("missing-system", LintSeverityError, "Name the system responsible before SHALL", words[shall].start, words[shall].end)
+	}
+
+	response := shall + 1
+	if response < len(words) && words[response].text == "not" {
+		add("negative-response", LintSeverityInfo, "Prefer stating the required behavior positively", words[shall].start, words[response].end)
+		response++
+	}
+	if response >= len(words) {
+		add("missing-response", LintSeverityError, "Describe the response after SHALL", words[shall].start, words[shall].end)
+	}
+
+	if len(shalls) > 1 {
+		extra := words[shalls[1]]
+		add("multiple-responses", LintSeverityWarning, "Split compound criteria so each states a single SHALL response", extra.start, extra.end)
+	}
+
+	lower := strings.ToLower(text)
+	for _, term := range earsVagueTerms {
+		for offset := 0; ; {
+			index := strings.Index(lower[offset:], term)
+			if index < 0 {
+				break
+			}
+			start, end := offset+index, offset+index+len(term)
+			if isWholeWord(lower, start, end) {
+				add("vague-term", LintSeverityWarning,
+					fmt.Sprintf("%q is not measurable; state a testable condition", text[start:end]), start, end)
+			}
+			offset = end
+		}
+	}
+
+	return l.finish(result)
+}
+
+// finish sets whether the text is valid
+func (l *earsLinter) finish(result EARSLintResult) EARSLintResult {
+	result.Valid = true
+	for _, finding := range result.Findings {
+		if finding.Severity == LintSeverityError {
+			result.Valid = false
+			break
+		}
+	}
+	return result
+}
+
+func wordIndex(words []earsWord, start int) int {
+	for i, word := range words {
+		if word.start == start {
+			return i
+		}
+	}
+	return -1
+}
+
+// isWholeWord reports whether text[start:end] is not part of a longer word
+func isWholeWord(text string, start, end int) bool {
+	isWordByte := func(b byte) bool {
+		return b == '-' || b == '_' || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') || b >= 0x80
+	}
+	return (start == 0 || !isWordByte(text[start-1])) && (end == len(text) || !isWordByte(text[end]))
+}
@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEARSLinter_Lint(t *testing.T) {
+	linter := NewEARSLinter()
+
+	tests := []struct {
+		name            string
+		text            string
+		expectedPattern EARSPattern
+		expectValid     bool
+		expectedRules   []string
+	}{
+		{
+			name:            "event-driven with THEN",
+			text:            "WHEN a user enters valid credentials THEN the system SHALL authenticate the user and redirect to the dashboard",
+			expectedPattern: EARSPatternEventDriven,
+			expectValid:     true,
+		},
+		{
+			name:            "state-driven with comma",
+			text:            "While the order is unpaid, the system shall hold the reserved stock.",
+			expectedPattern: EARSPatternStateDriven,
+			expectValid:     true,
+		},
+		{
+			name:            "ubiquitous",
+			text:            "The system shall log every failed login attempt.",
+			expectedPattern: EARSPatternUbiquitous,
+			expectValid:     true,
+		},
+		{
+			name:            "complex",
+			text:            "WHILE maintenance mode is active, WHEN a user logs in THEN the system SHALL show a banner",
+			expectedPattern: EARSPatternComplex,
+			expectValid:     true,
+		},
+		{
+			name:            "unwanted behavior without THEN",
+			text:            "IF the payment fails the system SHALL notify the customer",
+			expectedPattern: EARSPatternUnwantedBehavior,
+			expectValid:     false,
+			expectedRules:   []string{"missing-then"},
+		},
+		{
+			name:            "weak modal",
+			text:            "WHEN the session expires THEN the system should log the user out",
+			expectedPattern: EARSPatternUnknown,
+			expectValid:     false,
+			expectedRules:   []string{"weak-modal"},
+		},
+		{
+			name:            "missing system",
+			text:            "WHEN the form is submitted THEN SHALL save it",
+			expectedPattern: EARSPatternEventDriven,
+			expectValid:     false,
+			expectedRules:   []string{"missing-system"},
+		},
+		{
+			name:            "empty precondition and missing response",
+			text:            "WHEN THEN the system SHALL",
+			expectedPattern: EARSPatternEventDriven,
+			expectValid:     false,
+			expectedRules:   []string{"empty-precondition", "missing-response"},
+		},
+		{
+			name:            "compound and vague",
+			text:            "WHEN a report is requested THEN the system SHALL respond quickly and SHALL be user-friendly",
+			expectedPattern: EARSPatternEventDriven,
+			expectValid:     true,
+			expectedRules:   []string{"multiple-responses", "vague-term", "vague-term"},
+		},
+		{
+			name:            "empty text",
+			text:            "   ",
+			expectedPattern: EARSPatternUnknown,
+			expectValid:     false,
+			expectedRules:   []string{"empty"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := linter.Lint(tt.text)
+			assert.Equal(t, tt.expectedPattern, result.Pattern)
+			assert.Equal(t, tt.expectValid, result.Valid)
+
+			rules := make([]string, 0, len(result.Findings))
+			for _, finding := range result.Findings {
+				rules = append(rules, finding.Rule)
+				assert.True(t, finding.Start >= 0 && finding.End <= len(tt.text) && finding.Start <= finding.End)
+			}
+			if tt.expectedRules == nil {
+				tt.expectedRules = []string{}
+			}
+			assert.ElementsMatch(t, tt.expectedRules, rules)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_edit_locks_expires_at;
+DROP INDEX IF EXISTS idx_edit_locks_user_id;
+DROP INDEX IF EXISTS idx_entity_presences_expires_at;
+
+-- Drop the presence and lock tables
+DROP TABLE IF EXISTS edit_locks;
+DROP TABLE IF EXISTS entity_presences;
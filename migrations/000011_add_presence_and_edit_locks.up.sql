@@ -0,0 +1,37 @@
+-- Migration to add collaborative editing presence and soft edit locks
+
+-- Create entity_presences table for viewing/editing heartbeats
+CREATE TABLE IF NOT EXISTS entity_presences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    mode VARCHAR(20) NOT NULL CHECK (mode IN ('viewing', 'editing')),
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT idx_entity_presences_entity_user UNIQUE (entity_type, entity_id, user_id)
+);
+
+-- Create index on expires_at for efficient cleanup of stale presence
+CREATE INDEX IF NOT EXISTS idx_entity_presences_expires_at
+    ON entity_presences(expires_at);
+
+-- Create edit_locks table for soft edit locks with TTL
+CREATE TABLE IF NOT EXISTS edit_locks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    acquired_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- Only one lock per entity
+    CONSTRAINT idx_edit_locks_entity UNIQUE (entity_type, entity_id)
+);
+
+-- Create indexes for lock holder lookup and expiry cleanup
+CREATE INDEX IF NOT EXISTS idx_edit_locks_user_id
+    ON edit_locks(user_id);
+CREATE INDEX IF NOT EXISTS idx_edit_locks_expires_at
+    ON edit_locks(expires_at);
@@ -0,0 +1,5 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_entity_drafts_user_id;
+
+-- Drop the entity_drafts table
+DROP TABLE IF EXISTS entity_drafts;
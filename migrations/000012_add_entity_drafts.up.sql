@@ -0,0 +1,20 @@
+-- Migration to add private per-user drafts of entity titles and descriptions
+
+CREATE TABLE IF NOT EXISTS entity_drafts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(500),
+    description TEXT NOT NULL,
+    base_updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- One draft per user per entity
+    CONSTRAINT idx_entity_drafts_entity_user UNIQUE (entity_type, entity_id, user_id)
+);
+
+-- Create index on user_id for listing a user's drafts
+CREATE INDEX IF NOT EXISTS idx_entity_drafts_user_id
+    ON entity_drafts(user_id);
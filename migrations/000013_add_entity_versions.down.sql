@@ -0,0 +1,2 @@
+-- Drop the entity_versions table
+DROP TABLE IF EXISTS entity_versions;
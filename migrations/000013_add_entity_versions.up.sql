@@ -0,0 +1,14 @@
+-- Migration to add text version history for epics, user stories, acceptance criteria and requirements
+
+CREATE TABLE IF NOT EXISTS entity_versions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    version_number INTEGER NOT NULL,
+    title VARCHAR(500),
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Version numbers are sequential per entity
+    CONSTRAINT idx_entity_versions_entity_version UNIQUE (entity_type, entity_id, version_number)
+);
@@ -0,0 +1,5 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_comments_category;
+
+-- Drop the category column
+ALTER TABLE comments DROP COLUMN IF EXISTS category;
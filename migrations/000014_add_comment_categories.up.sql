@@ -0,0 +1,10 @@
+-- Migration to add optional categories (question, blocker, suggestion) to comments
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS category VARCHAR(20)
+    CONSTRAINT chk_comments_category CHECK (category IN ('question', 'blocker', 'suggestion'));
+
+-- Create index on category for cross-entity filtering
+CREATE INDEX IF NOT EXISTS idx_comments_category
+    ON comments(category)
+    WHERE category IS NOT NULL;
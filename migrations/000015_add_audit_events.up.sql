@@ -0,0 +1,26 @@
+-- Migration to add the audit log of changes to epics, user stories, acceptance criteria and requirements
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    field VARCHAR(50),
+    old_value TEXT,
+    new_value TEXT,
+    related_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for per-entity feeds
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity
+    ON audit_events(entity_type, entity_id);
+
+-- Create index for per-user feeds
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id
+    ON audit_events(actor_id);
+
+-- Create index for chronological cursor pagination
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at
+    ON audit_events(created_at DESC, id DESC);
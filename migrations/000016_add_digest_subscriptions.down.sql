@@ -0,0 +1,7 @@
+-- Drop trigger and indexes first
+DROP TRIGGER IF EXISTS update_digest_subscriptions_updated_at ON digest_subscriptions;
+DROP INDEX IF EXISTS idx_digest_subscriptions_frequency;
+DROP INDEX IF EXISTS idx_digest_subscriptions_unsubscribe_token;
+
+-- Drop the digest_subscriptions table
+DROP TABLE IF EXISTS digest_subscriptions;
@@ -0,0 +1,26 @@
+-- Migration to add per-user activity digest email preferences
+
+CREATE TABLE IF NOT EXISTS digest_subscriptions (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    frequency VARCHAR(20) NOT NULL DEFAULT 'none'
+        CHECK (frequency IN ('none', 'daily', 'weekly')),
+    unsubscribe_token VARCHAR(255) NOT NULL,
+    last_sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create unique index for unsubscribe links
+CREATE UNIQUE INDEX IF NOT EXISTS idx_digest_subscriptions_unsubscribe_token
+    ON digest_subscriptions(unsubscribe_token);
+
+-- Create index for the digest job
+CREATE INDEX IF NOT EXISTS idx_digest_subscriptions_frequency
+    ON digest_subscriptions(frequency)
+    WHERE frequency <> 'none';
+
+-- Add updated_at trigger for digest_subscriptions table
+CREATE TRIGGER update_digest_subscriptions_updated_at
+    BEFORE UPDATE ON digest_subscriptions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_users_deactivated_at;
+
+-- Remove the deactivation column
+ALTER TABLE users DROP COLUMN IF EXISTS deactivated_at;
@@ -0,0 +1,6 @@
+-- Migration to allow deactivating user accounts without deleting them
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deactivated_at TIMESTAMP WITH TIME ZONE;
+
+-- Create index for filtering active users
+CREATE INDEX IF NOT EXISTS idx_users_deactivated_at ON users(deactivated_at);
@@ -0,0 +1,6 @@
+-- Drop trigger and indexes first
+DROP TRIGGER IF EXISTS update_glossary_terms_updated_at ON glossary_terms;
+DROP INDEX IF EXISTS idx_glossary_terms_term_lower;
+
+-- Drop the glossary_terms table
+DROP TABLE IF EXISTS glossary_terms;
@@ -0,0 +1,20 @@
+-- Migration to add the glossary of defined terms
+
+CREATE TABLE IF NOT EXISTS glossary_terms (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    term VARCHAR(255) NOT NULL,
+    definition TEXT NOT NULL,
+    creator_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Terms are unique regardless of case
+CREATE UNIQUE INDEX IF NOT EXISTS idx_glossary_terms_term_lower
+    ON glossary_terms(LOWER(term));
+
+-- Add updated_at trigger for glossary_terms table
+CREATE TRIGGER update_glossary_terms_updated_at
+    BEFORE UPDATE ON glossary_terms
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_recent_views_user_viewed_at;
+
+-- Drop the recent_views table
+DROP TABLE IF EXISTS recent_views;
@@ -0,0 +1,16 @@
+-- Migration to track the entities each user viewed most recently
+
+CREATE TABLE IF NOT EXISTS recent_views (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- One record per user per entity; repeated views refresh viewed_at
+    CONSTRAINT idx_recent_views_user_entity UNIQUE (entity_type, entity_id, user_id)
+);
+
+-- Create index for listing a user's views, most recent first
+CREATE INDEX IF NOT EXISTS idx_recent_views_user_viewed_at
+    ON recent_views(user_id, viewed_at DESC);
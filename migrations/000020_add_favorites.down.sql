@@ -0,0 +1,5 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_favorites_user_id;
+
+-- Drop the favorites table
+DROP TABLE IF EXISTS favorites;
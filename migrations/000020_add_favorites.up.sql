@@ -0,0 +1,16 @@
+-- Migration to let users pin entities as favorites
+
+CREATE TABLE IF NOT EXISTS favorites (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- An entity is pinned at most once per user
+    CONSTRAINT idx_favorites_user_entity UNIQUE (entity_type, entity_id, user_id)
+);
+
+-- Create index on user_id for listing a user's favorites
+CREATE INDEX IF NOT EXISTS idx_favorites_user_id
+    ON favorites(user_id);
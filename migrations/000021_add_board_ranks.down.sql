@@ -0,0 +1,5 @@
+-- Drop trigger first
+DROP TRIGGER IF EXISTS update_board_ranks_updated_at ON board_ranks;
+
+-- Drop the board_ranks table
+DROP TABLE IF EXISTS board_ranks;
@@ -0,0 +1,18 @@
+-- Migration to store the order of entity cards on Kanban boards
+
+CREATE TABLE IF NOT EXISTS board_ranks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    rank INTEGER NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- One rank per entity
+    CONSTRAINT idx_board_ranks_entity UNIQUE (entity_type, entity_id)
+);
+
+-- Create trigger for updated_at
+CREATE TRIGGER update_board_ranks_updated_at
+    BEFORE UPDATE ON board_ranks
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,11 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_calendar_feeds_user_id;
+DROP INDEX IF EXISTS idx_user_stories_due_date;
+DROP INDEX IF EXISTS idx_epics_due_date;
+
+-- Drop the calendar_feeds table
+DROP TABLE IF EXISTS calendar_feeds;
+
+-- Remove the due date columns
+ALTER TABLE user_stories DROP COLUMN IF EXISTS due_date;
+ALTER TABLE epics DROP COLUMN IF EXISTS due_date;
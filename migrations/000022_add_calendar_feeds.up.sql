@@ -0,0 +1,24 @@
+-- Migration to add due dates and revocable iCal calendar feeds
+
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS due_date DATE;
+ALTER TABLE user_stories ADD COLUMN IF NOT EXISTS due_date DATE;
+
+-- Create indexes for collecting calendar events
+CREATE INDEX IF NOT EXISTS idx_epics_due_date ON epics(due_date);
+CREATE INDEX IF NOT EXISTS idx_user_stories_due_date ON user_stories(due_date);
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    epic_id UUID REFERENCES epics(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Feed tokens are looked up by hash
+    CONSTRAINT idx_calendar_feeds_token_hash UNIQUE (token_hash)
+);
+
+-- Create index on user_id for listing a user's feeds
+CREATE INDEX IF NOT EXISTS idx_calendar_feeds_user_id
+    ON calendar_feeds(user_id);
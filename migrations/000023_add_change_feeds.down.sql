@@ -0,0 +1,5 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_change_feeds_user_id;
+
+-- Drop the change_feeds table
+DROP TABLE IF EXISTS change_feeds;
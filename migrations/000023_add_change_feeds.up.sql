@@ -0,0 +1,17 @@
+-- Migration to add revocable Atom feeds of recent changes
+
+CREATE TABLE IF NOT EXISTS change_feeds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    epic_id UUID REFERENCES epics(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- Feed tokens are looked up by hash
+    CONSTRAINT idx_change_feeds_token_hash UNIQUE (token_hash)
+);
+
+-- Create index on user_id for listing a user's feeds
+CREATE INDEX IF NOT EXISTS idx_change_feeds_user_id
+    ON change_feeds(user_id);
@@ -0,0 +1,13 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_entity_relationships_target;
+DROP INDEX IF EXISTS idx_entity_relationships_source;
+
+-- Drop the entity_relationships table
+DROP TABLE IF EXISTS entity_relationships;
+
+-- Remove the relationship type added for entity links unless requirements still use it
+DELETE FROM relationship_types
+WHERE name = 'duplicates'
+  AND NOT EXISTS (SELECT 1 FROM requirement_relationships WHERE relationship_type_id = relationship_types.id);
+
+ALTER TABLE relationship_types DROP COLUMN IF EXISTS allowed_links;
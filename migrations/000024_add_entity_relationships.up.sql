@@ -0,0 +1,30 @@
+-- Migration to add relationships between entities of any type
+
+-- Allowed source:target entity type pairs per relationship type; empty allows any pair
+ALTER TABLE relationship_types ADD COLUMN IF NOT EXISTS allowed_links TEXT NOT NULL DEFAULT '';
+
+INSERT INTO relationship_types (name, description, allowed_links) VALUES
+    ('duplicates', 'This entity duplicates another entity of the same type',
+     'epic:epic,user_story:user_story,acceptance_criteria:acceptance_criteria,requirement:requirement')
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS entity_relationships (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    source_type VARCHAR(50) NOT NULL,
+    source_id UUID NOT NULL,
+    target_type VARCHAR(50) NOT NULL,
+    target_id UUID NOT NULL,
+    relationship_type_id UUID NOT NULL REFERENCES relationship_types(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+
+    -- An entity can be linked to another only once per relationship type
+    CONSTRAINT idx_entity_relationships_unique UNIQUE (source_type, source_id, target_type, target_id, relationship_type_id),
+    CONSTRAINT chk_entity_relationships_not_self CHECK (source_type <> target_type OR source_id <> target_id)
+);
+
+-- Create indexes for listing the links of an entity in either direction
+CREATE INDEX IF NOT EXISTS idx_entity_relationships_source
+    ON entity_relationships(source_type, source_id);
+CREATE INDEX IF NOT EXISTS idx_entity_relationships_target
+    ON entity_relationships(target_type, target_id);
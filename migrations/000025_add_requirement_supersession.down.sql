@@ -0,0 +1,6 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_requirements_superseded_by_id;
+
+-- Drop the supersession column and its constraint
+ALTER TABLE requirements DROP CONSTRAINT IF EXISTS chk_requirements_not_self_superseded;
+ALTER TABLE requirements DROP COLUMN IF EXISTS superseded_by_id;
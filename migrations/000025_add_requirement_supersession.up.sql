@@ -0,0 +1,12 @@
+-- Migration to record which requirement supersedes an obsolete requirement
+
+ALTER TABLE requirements
+    ADD COLUMN IF NOT EXISTS superseded_by_id UUID REFERENCES requirements(id) ON DELETE SET NULL;
+
+-- A requirement cannot supersede itself
+ALTER TABLE requirements
+    ADD CONSTRAINT chk_requirements_not_self_superseded CHECK (superseded_by_id IS NULL OR superseded_by_id <> id);
+
+-- Create index on superseded_by_id for walking supersession chains backwards
+CREATE INDEX IF NOT EXISTS idx_requirements_superseded_by_id
+    ON requirements(superseded_by_id);
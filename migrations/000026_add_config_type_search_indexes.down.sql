@@ -0,0 +1,3 @@
+-- Drop the configuration type search indexes
+DROP INDEX IF EXISTS idx_relationship_types_search;
+DROP INDEX IF EXISTS idx_requirement_types_search;
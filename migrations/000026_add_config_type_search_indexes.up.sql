@@ -0,0 +1,7 @@
+-- Migration to make requirement and relationship type names searchable
+
+CREATE INDEX IF NOT EXISTS idx_requirement_types_search
+    ON requirement_types USING gin(to_tsvector('english', name || ' ' || COALESCE(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_relationship_types_search
+    ON relationship_types USING gin(to_tsvector('english', name || ' ' || COALESCE(description, '')));
@@ -0,0 +1,8 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_mcp_tool_calls_created_at;
+DROP INDEX IF EXISTS idx_mcp_tool_calls_success;
+DROP INDEX IF EXISTS idx_mcp_tool_calls_user_id;
+DROP INDEX IF EXISTS idx_mcp_tool_calls_tool_name;
+
+-- Drop the mcp_tool_calls table
+DROP TABLE IF EXISTS mcp_tool_calls;
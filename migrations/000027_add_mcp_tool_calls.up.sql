@@ -0,0 +1,28 @@
+-- Migration to add the audit log of MCP tool calls made by agents
+
+CREATE TABLE IF NOT EXISTS mcp_tool_calls (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tool_name VARCHAR(100) NOT NULL,
+    args_digest VARCHAR(64) NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    duration_ms BIGINT NOT NULL,
+    success BOOLEAN NOT NULL,
+    error_message TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for per-tool aggregates
+CREATE INDEX IF NOT EXISTS idx_mcp_tool_calls_tool_name
+    ON mcp_tool_calls(tool_name);
+
+-- Create index for per-user aggregates
+CREATE INDEX IF NOT EXISTS idx_mcp_tool_calls_user_id
+    ON mcp_tool_calls(user_id);
+
+-- Create index for failed call listings
+CREATE INDEX IF NOT EXISTS idx_mcp_tool_calls_success
+    ON mcp_tool_calls(success);
+
+-- Create index for time window aggregates
+CREATE INDEX IF NOT EXISTS idx_mcp_tool_calls_created_at
+    ON mcp_tool_calls(created_at DESC);
@@ -0,0 +1,26 @@
+-- Migration to add the outbox of entity lifecycle events published to the event bus
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create unique index so consumers can rely on event IDs
+CREATE UNIQUE INDEX IF NOT EXISTS idx_outbox_events_event_id
+    ON outbox_events(event_id);
+
+-- Create partial index for the dispatcher, which reads pending events in order
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending
+    ON outbox_events(id) WHERE published_at IS NULL;
+
+-- Create index for the removal of published events
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at
+    ON outbox_events(published_at);
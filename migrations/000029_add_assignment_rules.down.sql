@@ -0,0 +1,6 @@
+-- Drop trigger and indexes first
+DROP TRIGGER IF EXISTS update_assignment_rules_updated_at ON assignment_rules;
+DROP INDEX IF EXISTS idx_assignment_rules_entity_type_priority;
+
+-- Drop the assignment_rules table
+DROP TABLE IF EXISTS assignment_rules;
@@ -0,0 +1,26 @@
+-- Migration to add rules assigning new entities created without an assignee
+
+CREATE TABLE IF NOT EXISTS assignment_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL CHECK (entity_type IN ('epic', 'user_story', 'requirement')),
+    priority INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    epic_id UUID REFERENCES epics(id) ON DELETE CASCADE,
+    creator_role VARCHAR(50) CHECK (creator_role IN ('Administrator', 'User', 'Commenter')),
+    requirement_type_id UUID REFERENCES requirement_types(id) ON DELETE CASCADE,
+    assignee_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    creator_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for evaluation, which reads the rules of an entity type in priority order
+CREATE INDEX IF NOT EXISTS idx_assignment_rules_entity_type_priority
+    ON assignment_rules(entity_type, priority, created_at);
+
+-- Add updated_at trigger for assignment_rules table
+CREATE TRIGGER update_assignment_rules_updated_at
+    BEFORE UPDATE ON assignment_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
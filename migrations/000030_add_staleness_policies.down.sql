@@ -0,0 +1,11 @@
+-- Drop stale marks first, they reference the policies
+DROP INDEX IF EXISTS idx_stale_entities_entity;
+DROP INDEX IF EXISTS idx_stale_entities_policy_entity;
+DROP TABLE IF EXISTS stale_entities;
+
+-- Drop trigger and indexes of staleness_policies
+DROP TRIGGER IF EXISTS update_staleness_policies_updated_at ON staleness_policies;
+DROP INDEX IF EXISTS idx_staleness_policies_entity_type;
+
+-- Drop the staleness_policies table
+DROP TABLE IF EXISTS staleness_policies;
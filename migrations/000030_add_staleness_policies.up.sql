@@ -0,0 +1,39 @@
+-- Migration to add staleness policies and the stale marks set by the staleness job
+
+CREATE TABLE IF NOT EXISTS staleness_policies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL CHECK (entity_type IN ('epic', 'user_story', 'requirement')),
+    status VARCHAR(50),
+    max_idle_days INTEGER NOT NULL CHECK (max_idle_days > 0),
+    escalate_after_days INTEGER CHECK (escalate_after_days >= 0),
+    escalate_to_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    creator_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_staleness_policies_entity_type ON staleness_policies(entity_type);
+
+-- Add updated_at trigger for staleness_policies table
+CREATE TRIGGER update_staleness_policies_updated_at
+    BEFORE UPDATE ON staleness_policies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS stale_entities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    policy_id UUID NOT NULL REFERENCES staleness_policies(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL CHECK (entity_type IN ('epic', 'user_story', 'requirement')),
+    entity_id UUID NOT NULL,
+    detected_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    notified_at TIMESTAMP WITH TIME ZONE,
+    escalated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- An entity is marked at most once per policy
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stale_entities_policy_entity ON stale_entities(policy_id, entity_id);
+
+-- Create index for the stale report, which filters by entity type
+CREATE INDEX IF NOT EXISTS idx_stale_entities_entity ON stale_entities(entity_type, entity_id);
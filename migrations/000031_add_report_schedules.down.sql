@@ -0,0 +1,7 @@
+-- Drop trigger and indexes first
+DROP TRIGGER IF EXISTS update_report_schedules_updated_at ON report_schedules;
+DROP INDEX IF EXISTS idx_report_schedules_next_run_at;
+DROP INDEX IF EXISTS idx_report_schedules_report_id;
+
+-- Drop the report_schedules table
+DROP TABLE IF EXISTS report_schedules;
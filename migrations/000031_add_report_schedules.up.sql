@@ -0,0 +1,33 @@
+-- Migration to add scheduled report delivery
+
+CREATE TABLE IF NOT EXISTS report_schedules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    report_id VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    cron_expression VARCHAR(100) NOT NULL,
+    timezone VARCHAR(100) NOT NULL DEFAULT 'UTC',
+    format VARCHAR(10) NOT NULL CHECK (format IN ('csv', 'pdf')),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('email', 'webhook')),
+    recipients TEXT,
+    webhook_url TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_status VARCHAR(20) CHECK (last_status IN ('succeeded', 'failed')),
+    last_error TEXT,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_report_schedules_webhook CHECK (channel <> 'webhook' OR webhook_url IS NOT NULL)
+);
+
+-- Create indexes for listing the schedules of a report and finding due schedules
+CREATE INDEX IF NOT EXISTS idx_report_schedules_report_id ON report_schedules(report_id);
+CREATE INDEX IF NOT EXISTS idx_report_schedules_next_run_at ON report_schedules(next_run_at) WHERE enabled;
+
+-- Add updated_at trigger for report_schedules table
+CREATE TRIGGER update_report_schedules_updated_at
+    BEFORE UPDATE ON report_schedules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,8 @@
+-- Drop trigger and indexes first
+DROP TRIGGER IF EXISTS update_api_quotas_updated_at ON api_quotas;
+DROP INDEX IF EXISTS idx_api_usage_counters_day;
+DROP INDEX IF EXISTS idx_api_usage_counters_user_token_day;
+
+-- Drop the API quota and usage tables
+DROP TABLE IF EXISTS api_quotas;
+DROP TABLE IF EXISTS api_usage_counters;
@@ -0,0 +1,33 @@
+-- Migration to add per-user API usage counters and daily quotas
+
+CREATE TABLE IF NOT EXISTS api_usage_counters (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- Personal access token the requests were made with; the nil UUID for JWT sessions.
+    -- Not a foreign key so usage stays visible after a token is revoked.
+    token_id UUID NOT NULL,
+    day DATE NOT NULL,
+    requests BIGINT NOT NULL DEFAULT 0,
+    last_request_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Create indexes for counting a user's requests of a day and for the daily usage report
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_usage_counters_user_token_day ON api_usage_counters(user_id, token_id, day);
+CREATE INDEX IF NOT EXISTS idx_api_usage_counters_day ON api_usage_counters(day);
+
+CREATE TABLE IF NOT EXISTS api_quotas (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    role VARCHAR(20) UNIQUE CHECK (role IN ('User', 'Commenter')),
+    user_id UUID UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    daily_limit BIGINT CHECK (daily_limit >= 0),
+    pat_daily_limit BIGINT CHECK (pat_daily_limit >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_api_quotas_subject CHECK ((role IS NULL) <> (user_id IS NULL))
+);
+
+-- Add updated_at trigger for api_quotas table
+CREATE TRIGGER update_api_quotas_updated_at
+    BEFORE UPDATE ON api_quotas
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
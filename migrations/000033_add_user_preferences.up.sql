@@ -0,0 +1,9 @@
+-- Migration to add namespaced client preferences of users
+
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    namespace VARCHAR(64) NOT NULL,
+    value JSONB NOT NULL CHECK (jsonb_typeof(value) = 'object'),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, namespace)
+);
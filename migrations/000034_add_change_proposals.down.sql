@@ -0,0 +1,7 @@
+-- Drop trigger and indexes first
+DROP TRIGGER IF EXISTS update_change_proposals_updated_at ON change_proposals;
+DROP INDEX IF EXISTS idx_change_proposals_proposer_id;
+DROP INDEX IF EXISTS idx_change_proposals_entity;
+
+-- Drop the change proposals table
+DROP TABLE IF EXISTS change_proposals;
@@ -0,0 +1,30 @@
+-- Migration to add change proposals suggested by users who may not edit an entity directly
+
+CREATE TABLE IF NOT EXISTS change_proposals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL CHECK (entity_type IN ('epic', 'user_story', 'acceptance_criteria', 'requirement')),
+    entity_id UUID NOT NULL,
+    proposer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(500),
+    description TEXT,
+    priority INTEGER CHECK (priority BETWEEN 1 AND 4),
+    rationale TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'rejected', 'withdrawn')),
+    base_updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reviewer_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    rejection_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_change_proposals_change CHECK (title IS NOT NULL OR description IS NOT NULL OR priority IS NOT NULL)
+);
+
+-- Create indexes for the review queue of an entity and the proposals of a user
+CREATE INDEX IF NOT EXISTS idx_change_proposals_entity ON change_proposals(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_change_proposals_proposer_id ON change_proposals(proposer_id);
+
+-- Add updated_at trigger for change_proposals table
+CREATE TRIGGER update_change_proposals_updated_at
+    BEFORE UPDATE ON change_proposals
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
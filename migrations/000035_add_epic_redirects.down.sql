@@ -0,0 +1,5 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_epic_redirects_epic_id;
+
+-- Drop the epic redirects table
+DROP TABLE IF EXISTS epic_redirects;
@@ -0,0 +1,12 @@
+-- Migration to keep the reference IDs of merged-away epics resolving to the epic they were merged into
+
+CREATE TABLE IF NOT EXISTS epic_redirects (
+    reference_id VARCHAR(20) PRIMARY KEY,
+    epic_id UUID NOT NULL REFERENCES epics(id) ON DELETE CASCADE,
+    source_id UUID NOT NULL,
+    merged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for retargeting the redirects of an epic when it is merged away itself
+CREATE INDEX IF NOT EXISTS idx_epic_redirects_epic_id ON epic_redirects(epic_id);
@@ -0,0 +1,9 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_user_stories_archived;
+DROP INDEX IF EXISTS idx_epics_archived;
+
+-- Remove the archival columns
+ALTER TABLE user_stories DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE user_stories DROP COLUMN IF EXISTS archived;
+ALTER TABLE epics DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE epics DROP COLUMN IF EXISTS archived;
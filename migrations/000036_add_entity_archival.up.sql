@@ -0,0 +1,10 @@
+-- Migration to archive epics and user stories without deleting them
+
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE user_stories ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE user_stories ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+
+-- Create indexes for excluding archived entities from default lists and search
+CREATE INDEX IF NOT EXISTS idx_epics_archived ON epics(archived);
+CREATE INDEX IF NOT EXISTS idx_user_stories_archived ON user_stories(archived);
@@ -0,0 +1,7 @@
+-- Drop the Russian search indexes
+DROP INDEX IF EXISTS idx_relationship_types_search_russian;
+DROP INDEX IF EXISTS idx_requirement_types_search_russian;
+DROP INDEX IF EXISTS idx_steering_documents_search_russian;
+DROP INDEX IF EXISTS idx_requirements_search_russian;
+DROP INDEX IF EXISTS idx_user_stories_search_russian;
+DROP INDEX IF EXISTS idx_epics_search_russian;
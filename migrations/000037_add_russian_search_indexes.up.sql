@@ -0,0 +1,20 @@
+-- Migration to index searchable text for Russian as well as English, so that
+-- SEARCH_LANGUAGES=english,russian matches Cyrillic queries without sequential scans
+
+CREATE INDEX IF NOT EXISTS idx_epics_search_russian
+    ON epics USING gin(to_tsvector('russian', reference_id || ' ' || title || ' ' || COALESCE(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_user_stories_search_russian
+    ON user_stories USING gin(to_tsvector('russian', reference_id || ' ' || title || ' ' || COALESCE(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_requirements_search_russian
+    ON requirements USING gin(to_tsvector('russian', reference_id || ' ' || title || ' ' || COALESCE(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_steering_documents_search_russian
+    ON steering_documents USING gin(to_tsvector('russian', reference_id || ' ' || title || ' ' || COALESCE(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_requirement_types_search_russian
+    ON requirement_types USING gin(to_tsvector('russian', name || ' ' || COALESCE(description, '')));
+
+CREATE INDEX IF NOT EXISTS idx_relationship_types_search_russian
+    ON relationship_types USING gin(to_tsvector('russian', name || ' ' || COALESCE(description, '')));
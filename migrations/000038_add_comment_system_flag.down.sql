@@ -0,0 +1,2 @@
+-- Remove the system comment flag
+ALTER TABLE comments DROP COLUMN IF EXISTS is_system;
@@ -0,0 +1,3 @@
+-- Migration to mark comments generated by the system, such as discussion summaries
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS is_system BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+-- Remove the AI provenance columns
+ALTER TABLE acceptance_criteria DROP COLUMN IF EXISTS ai_model;
+ALTER TABLE acceptance_criteria DROP COLUMN IF EXISTS ai_generated;
+ALTER TABLE user_stories DROP COLUMN IF EXISTS ai_model;
+ALTER TABLE user_stories DROP COLUMN IF EXISTS ai_generated;
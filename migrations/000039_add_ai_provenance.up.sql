@@ -0,0 +1,6 @@
+-- Migration to record which user stories and acceptance criteria were generated by a language model
+
+ALTER TABLE user_stories ADD COLUMN IF NOT EXISTS ai_generated BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE user_stories ADD COLUMN IF NOT EXISTS ai_model VARCHAR(255);
+ALTER TABLE acceptance_criteria ADD COLUMN IF NOT EXISTS ai_generated BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE acceptance_criteria ADD COLUMN IF NOT EXISTS ai_model VARCHAR(255);
@@ -0,0 +1,7 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_llm_calls_created_at;
+DROP INDEX IF EXISTS idx_llm_calls_user_id;
+DROP INDEX IF EXISTS idx_llm_calls_feature;
+
+-- Drop the llm_calls table
+DROP TABLE IF EXISTS llm_calls;
@@ -0,0 +1,30 @@
+-- Migration to add the usage log of language model requests made by AI features
+
+CREATE TABLE IF NOT EXISTS llm_calls (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    feature VARCHAR(100) NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    provider VARCHAR(50) NOT NULL,
+    model VARCHAR(255) NOT NULL,
+    input_tokens INTEGER NOT NULL DEFAULT 0,
+    output_tokens INTEGER NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL,
+    cost_usd DOUBLE PRECISION NOT NULL DEFAULT 0,
+    success BOOLEAN NOT NULL,
+    error_message TEXT,
+    prompt TEXT,
+    response TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for per-feature aggregates
+CREATE INDEX IF NOT EXISTS idx_llm_calls_feature
+    ON llm_calls(feature);
+
+-- Create index for per-user aggregates
+CREATE INDEX IF NOT EXISTS idx_llm_calls_user_id
+    ON llm_calls(user_id);
+
+-- Create index for monthly aggregates and budget checks
+CREATE INDEX IF NOT EXISTS idx_llm_calls_created_at
+    ON llm_calls(created_at DESC);
@@ -0,0 +1,7 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_comment_moderations_comment_id;
+DROP INDEX IF EXISTS idx_comment_moderations_author_id;
+DROP INDEX IF EXISTS idx_comment_moderations_status;
+
+-- Drop the comment_moderations table
+DROP TABLE IF EXISTS comment_moderations;
@@ -0,0 +1,35 @@
+-- Migration to add the moderation queue of comments caught by keyword rules or the AI classifier
+
+CREATE TABLE IF NOT EXISTS comment_moderations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    comment_id UUID REFERENCES comments(id) ON DELETE SET NULL,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    content TEXT NOT NULL,
+    request TEXT,
+    status VARCHAR(20) NOT NULL,
+    reason TEXT NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    appeal_reason TEXT,
+    reviewer_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_comment_moderations_status CHECK (status IN ('flagged', 'blocked', 'appealed', 'approved', 'rejected')),
+    CONSTRAINT chk_comment_moderations_action CHECK (action IN ('create', 'update'))
+);
+
+-- Create index for the review queue
+CREATE INDEX IF NOT EXISTS idx_comment_moderations_status
+    ON comment_moderations(status, created_at);
+
+-- Create index for the records of an author
+CREATE INDEX IF NOT EXISTS idx_comment_moderations_author_id
+    ON comment_moderations(author_id);
+
+-- Create index for the records of a comment
+CREATE INDEX IF NOT EXISTS idx_comment_moderations_comment_id
+    ON comment_moderations(comment_id);
@@ -0,0 +1,25 @@
+-- Restore the epic redirects table
+CREATE TABLE IF NOT EXISTS epic_redirects (
+    reference_id VARCHAR(20) PRIMARY KEY,
+    epic_id UUID NOT NULL REFERENCES epics(id) ON DELETE CASCADE,
+    source_id UUID NOT NULL,
+    merged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_epic_redirects_epic_id ON epic_redirects(epic_id);
+
+-- Only merged epics fit the epic redirects; other redirects are lost
+INSERT INTO epic_redirects (reference_id, epic_id, source_id, merged_by, created_at)
+SELECT reference_redirects.reference_id, reference_redirects.entity_id, reference_redirects.source_id,
+       reference_redirects.created_by, reference_redirects.created_at
+FROM reference_redirects
+JOIN epics ON epics.id = reference_redirects.entity_id
+WHERE reference_redirects.entity_type = 'epic' AND reference_redirects.reason = 'merged'
+  AND reference_redirects.source_id IS NOT NULL;
+
+-- Drop index first
+DROP INDEX IF EXISTS idx_reference_redirects_entity;
+
+-- Drop the reference redirects table
+DROP TABLE IF EXISTS reference_redirects;
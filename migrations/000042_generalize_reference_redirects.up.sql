@@ -0,0 +1,23 @@
+-- Migration to keep retired reference IDs of any entity type resolving to their successor, replacing the
+-- redirects of merged-away epics
+
+CREATE TABLE IF NOT EXISTS reference_redirects (
+    reference_id VARCHAR(50) PRIMARY KEY,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    source_id UUID,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('merged', 'migrated')),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create index for the redirects of an entity, retargeted when the entity is merged away itself
+CREATE INDEX IF NOT EXISTS idx_reference_redirects_entity ON reference_redirects(entity_type, entity_id);
+
+-- Keep the redirects of merged-away epics
+INSERT INTO reference_redirects (reference_id, entity_type, entity_id, source_id, reason, created_by, created_at)
+SELECT reference_id, 'epic', epic_id, source_id, 'merged', merged_by, created_at FROM epic_redirects
+ON CONFLICT (reference_id) DO NOTHING;
+
+DROP INDEX IF EXISTS idx_epic_redirects_epic_id;
+DROP TABLE IF EXISTS epic_redirects;
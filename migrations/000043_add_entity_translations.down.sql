@@ -0,0 +1,5 @@
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_entity_translations_locale;
+
+-- Drop the entity_translations table
+DROP TABLE IF EXISTS entity_translations;
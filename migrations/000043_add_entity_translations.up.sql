@@ -0,0 +1,20 @@
+-- Migration to add translations of entity titles and descriptions per locale
+
+CREATE TABLE IF NOT EXISTS entity_translations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    locale VARCHAR(20) NOT NULL,
+    title VARCHAR(500),
+    description TEXT,
+    updated_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+
+    -- One translation per entity per locale
+    CONSTRAINT idx_entity_translations_entity_locale UNIQUE (entity_type, entity_id, locale)
+);
+
+-- Create index on locale for the translation completeness report
+CREATE INDEX IF NOT EXISTS idx_entity_translations_locale
+    ON entity_translations(locale);
@@ -0,0 +1,12 @@
+-- Drop the trigger keeping sign-offs immutable
+DROP TRIGGER IF EXISTS prevent_sign_off_change ON sign_offs;
+DROP FUNCTION IF EXISTS reject_sign_off_change();
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_sign_offs_signed_at;
+DROP INDEX IF EXISTS idx_sign_offs_signer_id;
+DROP INDEX IF EXISTS idx_sign_offs_entity;
+
+-- Drop the sign-off tables
+DROP TABLE IF EXISTS sign_offs;
+DROP TABLE IF EXISTS sign_off_stakeholders;
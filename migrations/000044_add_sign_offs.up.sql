@@ -0,0 +1,51 @@
+-- Migration to add formal sign-offs of requirements and user stories by designated stakeholders
+
+CREATE TABLE IF NOT EXISTS sign_off_stakeholders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(100) NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- A user is designated once per role
+    CONSTRAINT idx_sign_off_stakeholders_user_role UNIQUE (user_id, role)
+);
+
+CREATE TABLE IF NOT EXISTS sign_offs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    version_number INTEGER NOT NULL,
+    signer_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    role VARCHAR(100) NOT NULL,
+    signature_hash VARCHAR(128),
+    comment TEXT,
+    signed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_sign_offs_entity_type CHECK (entity_type IN ('requirement', 'user_story')),
+    CONSTRAINT chk_sign_offs_version_number CHECK (version_number >= 0),
+
+    -- A stakeholder signs off a version once per role
+    CONSTRAINT idx_sign_offs_entity_signer_version UNIQUE (entity_type, entity_id, signer_id, role, version_number)
+);
+
+-- Create index for the sign-offs of an entity
+CREATE INDEX IF NOT EXISTS idx_sign_offs_entity
+    ON sign_offs(entity_type, entity_id);
+
+-- Create index for the sign-offs of a stakeholder
+CREATE INDEX IF NOT EXISTS idx_sign_offs_signer_id
+    ON sign_offs(signer_id);
+
+-- Create index for the sign-off log
+CREATE INDEX IF NOT EXISTS idx_sign_offs_signed_at
+    ON sign_offs(signed_at);
+
+-- Sign-offs are an audit record: reject any change or removal
+CREATE OR REPLACE FUNCTION reject_sign_off_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'sign-offs cannot be changed or removed';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER prevent_sign_off_change BEFORE UPDATE OR DELETE ON sign_offs FOR EACH ROW EXECUTE FUNCTION reject_sign_off_change();
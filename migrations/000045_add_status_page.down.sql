@@ -0,0 +1,15 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_maintenance_windows_updated_at ON maintenance_windows;
+DROP TRIGGER IF EXISTS update_incidents_updated_at ON incidents;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_maintenance_windows_ends_at;
+DROP INDEX IF EXISTS idx_maintenance_windows_starts_at;
+DROP INDEX IF EXISTS idx_incident_updates_incident_id;
+DROP INDEX IF EXISTS idx_incidents_started_at;
+DROP INDEX IF EXISTS idx_incidents_status;
+
+-- Drop the status page tables
+DROP TABLE IF EXISTS maintenance_windows;
+DROP TABLE IF EXISTS incident_updates;
+DROP TABLE IF EXISTS incidents;
@@ -0,0 +1,58 @@
+-- Migration to add the incidents and maintenance windows of the public status page
+
+CREATE TABLE IF NOT EXISTS incidents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    impact VARCHAR(20) NOT NULL,
+    components TEXT,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_incidents_status CHECK (status IN ('investigating', 'identified', 'monitoring', 'resolved')),
+    CONSTRAINT chk_incidents_impact CHECK (impact IN ('minor', 'major', 'critical'))
+);
+
+CREATE TABLE IF NOT EXISTS incident_updates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    message TEXT NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_incident_updates_status CHECK (status IN ('investigating', 'identified', 'monitoring', 'resolved'))
+);
+
+CREATE TABLE IF NOT EXISTS maintenance_windows (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    components TEXT,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_maintenance_windows_period CHECK (ends_at > starts_at)
+);
+
+-- Create indexes for the open and recent incidents
+CREATE INDEX IF NOT EXISTS idx_incidents_status
+    ON incidents(status);
+CREATE INDEX IF NOT EXISTS idx_incidents_started_at
+    ON incidents(started_at);
+
+-- Create index for the timeline of an incident
+CREATE INDEX IF NOT EXISTS idx_incident_updates_incident_id
+    ON incident_updates(incident_id, created_at);
+
+-- Create indexes for the upcoming maintenance windows
+CREATE INDEX IF NOT EXISTS idx_maintenance_windows_starts_at
+    ON maintenance_windows(starts_at);
+CREATE INDEX IF NOT EXISTS idx_maintenance_windows_ends_at
+    ON maintenance_windows(ends_at);
+
+CREATE TRIGGER update_incidents_updated_at BEFORE UPDATE ON incidents FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+CREATE TRIGGER update_maintenance_windows_updated_at BEFORE UPDATE ON maintenance_windows FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
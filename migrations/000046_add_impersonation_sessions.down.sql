@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_impersonated_requests_session;
+DROP INDEX IF EXISTS idx_impersonation_sessions_started_at;
+DROP INDEX IF EXISTS idx_impersonation_sessions_user_id;
+DROP INDEX IF EXISTS idx_impersonation_sessions_admin_id;
+
+-- Drop the impersonation tables
+DROP TABLE IF EXISTS impersonated_requests;
+DROP TABLE IF EXISTS impersonation_sessions;
@@ -0,0 +1,35 @@
+-- Migration to add the audit log of administrators impersonating users
+
+CREATE TABLE IF NOT EXISTS impersonation_sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    admin_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    reason TEXT NOT NULL,
+    client_ip VARCHAR(64),
+    user_agent TEXT,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT chk_impersonation_sessions_users CHECK (admin_id <> user_id),
+    CONSTRAINT chk_impersonation_sessions_period CHECK (expires_at > started_at)
+);
+
+CREATE TABLE IF NOT EXISTS impersonated_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    session_id UUID NOT NULL REFERENCES impersonation_sessions(id) ON DELETE CASCADE,
+    method VARCHAR(10) NOT NULL,
+    path TEXT NOT NULL,
+    status_code INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes for the sessions of an administrator or user
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_admin_id
+    ON impersonation_sessions(admin_id);
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_user_id
+    ON impersonation_sessions(user_id);
+CREATE INDEX IF NOT EXISTS idx_impersonation_sessions_started_at
+    ON impersonation_sessions(started_at);
+
+-- Create index for the requests of a session
+CREATE INDEX IF NOT EXISTS idx_impersonated_requests_session
+    ON impersonated_requests(session_id, created_at);
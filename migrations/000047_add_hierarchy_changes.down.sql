@@ -0,0 +1,12 @@
+-- Drop the triggers recording hierarchy changes
+DROP TRIGGER IF EXISTS record_requirements_hierarchy_change ON requirements;
+DROP TRIGGER IF EXISTS record_acceptance_criteria_hierarchy_change ON acceptance_criteria;
+DROP TRIGGER IF EXISTS record_user_stories_hierarchy_change ON user_stories;
+DROP FUNCTION IF EXISTS record_requirement_change();
+DROP FUNCTION IF EXISTS record_acceptance_criteria_change();
+DROP FUNCTION IF EXISTS record_user_story_change();
+DROP FUNCTION IF EXISTS record_user_story_tree_change(UUID);
+DROP FUNCTION IF EXISTS record_hierarchy_change(VARCHAR, UUID);
+
+-- Drop the hierarchy_changes table
+DROP TABLE IF EXISTS hierarchy_changes;
@@ -0,0 +1,80 @@
+-- Migration to track when the descendants of epics, user stories and acceptance criteria last changed,
+-- so hierarchy endpoints can answer conditional requests when children are added, changed or removed
+
+CREATE TABLE IF NOT EXISTS hierarchy_changes (
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (entity_type, entity_id),
+    CONSTRAINT chk_hierarchy_changes_entity_type CHECK (entity_type IN ('epic', 'user_story', 'acceptance_criteria'))
+);
+
+-- Record a change below an entity of the hierarchy
+CREATE OR REPLACE FUNCTION record_hierarchy_change(change_entity_type VARCHAR, change_entity_id UUID)
+RETURNS VOID AS $$
+BEGIN
+    IF change_entity_id IS NULL THEN
+        RETURN;
+    END IF;
+    INSERT INTO hierarchy_changes (entity_type, entity_id, changed_at)
+    VALUES (change_entity_type, change_entity_id, NOW())
+    ON CONFLICT (entity_type, entity_id) DO UPDATE SET changed_at = EXCLUDED.changed_at;
+END;
+$$ language 'plpgsql';
+
+-- A user story changes its epic
+CREATE OR REPLACE FUNCTION record_user_story_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM record_hierarchy_change('epic', NEW.epic_id);
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM record_hierarchy_change('epic', OLD.epic_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+-- Record a change below a user story and its epic
+CREATE OR REPLACE FUNCTION record_user_story_tree_change(change_user_story_id UUID)
+RETURNS VOID AS $$
+BEGIN
+    PERFORM record_hierarchy_change('user_story', change_user_story_id);
+    PERFORM record_hierarchy_change('epic', (SELECT epic_id FROM user_stories WHERE id = change_user_story_id));
+END;
+$$ language 'plpgsql';
+
+-- Acceptance criteria change their user story and its epic
+CREATE OR REPLACE FUNCTION record_acceptance_criteria_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM record_user_story_tree_change(NEW.user_story_id);
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM record_user_story_tree_change(OLD.user_story_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+-- Requirements change their acceptance criteria, user story and epic
+CREATE OR REPLACE FUNCTION record_requirement_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM record_hierarchy_change('acceptance_criteria', NEW.acceptance_criteria_id);
+        PERFORM record_user_story_tree_change(NEW.user_story_id);
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM record_hierarchy_change('acceptance_criteria', OLD.acceptance_criteria_id);
+        PERFORM record_user_story_tree_change(OLD.user_story_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER record_user_stories_hierarchy_change AFTER INSERT OR UPDATE OR DELETE ON user_stories FOR EACH ROW EXECUTE FUNCTION record_user_story_change();
+CREATE TRIGGER record_acceptance_criteria_hierarchy_change AFTER INSERT OR UPDATE OR DELETE ON acceptance_criteria FOR EACH ROW EXECUTE FUNCTION record_acceptance_criteria_change();
+CREATE TRIGGER record_requirements_hierarchy_change AFTER INSERT OR UPDATE OR DELETE ON requirements FOR EACH ROW EXECUTE FUNCTION record_requirement_change();
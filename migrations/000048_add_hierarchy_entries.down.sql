@@ -0,0 +1,18 @@
+-- Drop the triggers maintaining the hierarchy cache
+DROP TRIGGER IF EXISTS sync_requirements_hierarchy_entry ON requirements;
+DROP TRIGGER IF EXISTS sync_acceptance_criteria_hierarchy_entry ON acceptance_criteria;
+DROP TRIGGER IF EXISTS sync_user_stories_hierarchy_entry ON user_stories;
+DROP TRIGGER IF EXISTS sync_epics_hierarchy_entry ON epics;
+DROP FUNCTION IF EXISTS sync_requirement_hierarchy_entry();
+DROP FUNCTION IF EXISTS sync_acceptance_criteria_hierarchy_entry();
+DROP FUNCTION IF EXISTS sync_user_story_hierarchy_entry();
+DROP FUNCTION IF EXISTS sync_epic_hierarchy_entry();
+DROP FUNCTION IF EXISTS upsert_hierarchy_entry(VARCHAR, UUID, VARCHAR, TEXT, VARCHAR, UUID, UUID, UUID, TIMESTAMP WITH TIME ZONE, TIMESTAMP WITH TIME ZONE);
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_hierarchy_entries_acceptance_criteria_id;
+DROP INDEX IF EXISTS idx_hierarchy_entries_user_story_id;
+DROP INDEX IF EXISTS idx_hierarchy_entries_epic_id;
+
+-- Drop the hierarchy_entries table
+DROP TABLE IF EXISTS hierarchy_entries;
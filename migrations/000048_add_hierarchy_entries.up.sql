@@ -0,0 +1,150 @@
+-- Migration to add the hierarchy cache: one denormalized row per epic, user story, acceptance criteria and
+-- requirement naming all of its ancestors, so breadcrumbs, outlines and progress are read with a single query.
+-- Each row names itself as its own user story or acceptance criteria, so the subtree of any entity is selected
+-- by one column. Triggers keep the rows up to date; cmd/hierarchy-cache rebuilds them.
+
+CREATE TABLE IF NOT EXISTS hierarchy_entries (
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    reference_id VARCHAR(20) NOT NULL,
+    title TEXT NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT '',
+    epic_id UUID NOT NULL,
+    user_story_id UUID,
+    acceptance_criteria_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (entity_type, entity_id),
+    CONSTRAINT chk_hierarchy_entries_entity_type CHECK (entity_type IN ('epic', 'user_story', 'acceptance_criteria', 'requirement'))
+);
+
+-- Create indexes for the subtrees of epics, user stories and acceptance criteria
+CREATE INDEX IF NOT EXISTS idx_hierarchy_entries_epic_id
+    ON hierarchy_entries(epic_id);
+
+CREATE INDEX IF NOT EXISTS idx_hierarchy_entries_user_story_id
+    ON hierarchy_entries(user_story_id);
+
+CREATE INDEX IF NOT EXISTS idx_hierarchy_entries_acceptance_criteria_id
+    ON hierarchy_entries(acceptance_criteria_id);
+
+-- Write the cache row of an entity
+CREATE OR REPLACE FUNCTION upsert_hierarchy_entry(
+    entry_entity_type VARCHAR, entry_entity_id UUID, entry_reference_id VARCHAR, entry_title TEXT, entry_status VARCHAR,
+    entry_epic_id UUID, entry_user_story_id UUID, entry_acceptance_criteria_id UUID,
+    entry_created_at TIMESTAMP WITH TIME ZONE, entry_updated_at TIMESTAMP WITH TIME ZONE)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO hierarchy_entries (entity_type, entity_id, reference_id, title, status, epic_id, user_story_id,
+        acceptance_criteria_id, created_at, updated_at)
+    VALUES (entry_entity_type, entry_entity_id, entry_reference_id, entry_title, COALESCE(entry_status, ''), entry_epic_id,
+        entry_user_story_id, entry_acceptance_criteria_id, entry_created_at, entry_updated_at)
+    ON CONFLICT (entity_type, entity_id) DO UPDATE SET
+        reference_id = EXCLUDED.reference_id,
+        title = EXCLUDED.title,
+        status = EXCLUDED.status,
+        epic_id = EXCLUDED.epic_id,
+        user_story_id = EXCLUDED.user_story_id,
+        acceptance_criteria_id = EXCLUDED.acceptance_criteria_id,
+        created_at = EXCLUDED.created_at,
+        updated_at = EXCLUDED.updated_at;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION sync_epic_hierarchy_entry()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        DELETE FROM hierarchy_entries WHERE epic_id = OLD.id;
+        RETURN NULL;
+    END IF;
+    PERFORM upsert_hierarchy_entry('epic', NEW.id, NEW.reference_id, NEW.title, NEW.status, NEW.id, NULL, NULL,
+        NEW.created_at, NEW.updated_at);
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+-- Moving a user story to another epic moves its subtree
+CREATE OR REPLACE FUNCTION sync_user_story_hierarchy_entry()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        DELETE FROM hierarchy_entries WHERE user_story_id = OLD.id;
+        RETURN NULL;
+    END IF;
+    PERFORM upsert_hierarchy_entry('user_story', NEW.id, NEW.reference_id, NEW.title, NEW.status, NEW.epic_id, NEW.id, NULL,
+        NEW.created_at, NEW.updated_at);
+    IF TG_OP = 'UPDATE' AND OLD.epic_id IS DISTINCT FROM NEW.epic_id THEN
+        UPDATE hierarchy_entries SET epic_id = NEW.epic_id WHERE user_story_id = NEW.id;
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+-- Acceptance criteria have no title or status; their description is cached as the title
+CREATE OR REPLACE FUNCTION sync_acceptance_criteria_hierarchy_entry()
+RETURNS TRIGGER AS $$
+DECLARE
+    parent_epic_id UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        DELETE FROM hierarchy_entries WHERE entity_type = 'acceptance_criteria' AND entity_id = OLD.id;
+        RETURN NULL;
+    END IF;
+    SELECT epic_id INTO parent_epic_id FROM user_stories WHERE id = NEW.user_story_id;
+    IF parent_epic_id IS NULL THEN
+        -- The user story is being deleted
+        RETURN NULL;
+    END IF;
+    PERFORM upsert_hierarchy_entry('acceptance_criteria', NEW.id, NEW.reference_id, NEW.description, '', parent_epic_id,
+        NEW.user_story_id, NEW.id, NEW.created_at, NEW.updated_at);
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION sync_requirement_hierarchy_entry()
+RETURNS TRIGGER AS $$
+DECLARE
+    parent_epic_id UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        DELETE FROM hierarchy_entries WHERE entity_type = 'requirement' AND entity_id = OLD.id;
+        RETURN NULL;
+    END IF;
+    SELECT epic_id INTO parent_epic_id FROM user_stories WHERE id = NEW.user_story_id;
+    IF parent_epic_id IS NULL THEN
+        -- The user story is being deleted
+        RETURN NULL;
+    END IF;
+    PERFORM upsert_hierarchy_entry('requirement', NEW.id, NEW.reference_id, NEW.title, NEW.status, parent_epic_id,
+        NEW.user_story_id, NEW.acceptance_criteria_id, NEW.created_at, NEW.updated_at);
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER sync_epics_hierarchy_entry AFTER INSERT OR UPDATE OR DELETE ON epics FOR EACH ROW EXECUTE FUNCTION sync_epic_hierarchy_entry();
+CREATE TRIGGER sync_user_stories_hierarchy_entry AFTER INSERT OR UPDATE OR DELETE ON user_stories FOR EACH ROW EXECUTE FUNCTION sync_user_story_hierarchy_entry();
+CREATE TRIGGER sync_acceptance_criteria_hierarchy_entry AFTER INSERT OR UPDATE OR DELETE ON acceptance_criteria FOR EACH ROW EXECUTE FUNCTION sync_acceptance_criteria_hierarchy_entry();
+CREATE TRIGGER sync_requirements_hierarchy_entry AFTER INSERT OR UPDATE OR DELETE ON requirements FOR EACH ROW EXECUTE FUNCTION sync_requirement_hierarchy_entry();
+
+-- Fill the cache from the existing entities
+INSERT INTO hierarchy_entries (entity_type, entity_id, reference_id, title, status, epic_id, user_story_id,
+    acceptance_criteria_id, created_at, updated_at)
+SELECT 'epic', epics.id, epics.reference_id, epics.title, epics.status, epics.id, NULL, NULL,
+    epics.created_at, epics.updated_at
+FROM epics
+UNION ALL
+SELECT 'user_story', user_stories.id, user_stories.reference_id, user_stories.title, user_stories.status,
+    user_stories.epic_id, user_stories.id, NULL, user_stories.created_at, user_stories.updated_at
+FROM user_stories
+UNION ALL
+SELECT 'acceptance_criteria', acceptance_criteria.id, acceptance_criteria.reference_id, acceptance_criteria.description, '',
+    user_stories.epic_id, acceptance_criteria.user_story_id, acceptance_criteria.id,
+    acceptance_criteria.created_at, acceptance_criteria.updated_at
+FROM acceptance_criteria JOIN user_stories ON user_stories.id = acceptance_criteria.user_story_id
+UNION ALL
+SELECT 'requirement', requirements.id, requirements.reference_id, requirements.title, requirements.status,
+    user_stories.epic_id, requirements.user_story_id, requirements.acceptance_criteria_id,
+    requirements.created_at, requirements.updated_at
+FROM requirements JOIN user_stories ON user_stories.id = requirements.user_story_id
+ON CONFLICT (entity_type, entity_id) DO NOTHING;
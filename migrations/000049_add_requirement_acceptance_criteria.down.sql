@@ -0,0 +1,6 @@
+-- Drop the trigger recording requirement acceptance criteria link changes
+DROP TRIGGER IF EXISTS record_requirement_acceptance_criteria_hierarchy_change ON requirement_acceptance_criteria;
+DROP FUNCTION IF EXISTS record_requirement_acceptance_criteria_change();
+
+-- Drop the requirement_acceptance_criteria table; requirements keep their primary acceptance criteria
+DROP TABLE IF EXISTS requirement_acceptance_criteria;
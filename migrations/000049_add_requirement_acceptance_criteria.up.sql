@@ -0,0 +1,36 @@
+-- Migration to let a requirement cover several acceptance criteria. requirements.acceptance_criteria_id
+-- remains the primary acceptance criteria placing the requirement in the hierarchy; it is always linked too.
+
+CREATE TABLE IF NOT EXISTS requirement_acceptance_criteria (
+    requirement_id UUID NOT NULL REFERENCES requirements(id) ON DELETE CASCADE,
+    acceptance_criteria_id UUID NOT NULL REFERENCES acceptance_criteria(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (requirement_id, acceptance_criteria_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_requirement_acceptance_criteria_acceptance_criteria_id
+    ON requirement_acceptance_criteria(acceptance_criteria_id);
+
+-- Link every requirement to the acceptance criteria it referenced so far
+INSERT INTO requirement_acceptance_criteria (requirement_id, acceptance_criteria_id, created_at)
+SELECT id, acceptance_criteria_id, updated_at
+FROM requirements
+WHERE acceptance_criteria_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- Linking or unlinking a requirement changes the children of the acceptance criteria and its user story
+CREATE OR REPLACE FUNCTION record_requirement_acceptance_criteria_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM record_hierarchy_change('acceptance_criteria', NEW.acceptance_criteria_id);
+        PERFORM record_user_story_tree_change((SELECT user_story_id FROM acceptance_criteria WHERE id = NEW.acceptance_criteria_id));
+    ELSE
+        PERFORM record_hierarchy_change('acceptance_criteria', OLD.acceptance_criteria_id);
+        PERFORM record_user_story_tree_change((SELECT user_story_id FROM acceptance_criteria WHERE id = OLD.acceptance_criteria_id));
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER record_requirement_acceptance_criteria_hierarchy_change AFTER INSERT OR DELETE ON requirement_acceptance_criteria FOR EACH ROW EXECUTE FUNCTION record_requirement_acceptance_criteria_change();
@@ -0,0 +1,2 @@
+-- Drop the comment_thread_locks table
+DROP TABLE IF EXISTS comment_thread_locks;
@@ -0,0 +1,13 @@
+-- Migration to add locks that close the comment thread of an entity to new comments
+
+CREATE TABLE IF NOT EXISTS comment_thread_locks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    reason TEXT NOT NULL,
+    locked_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    locked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Only one lock per thread
+    CONSTRAINT idx_comment_thread_locks_entity UNIQUE (entity_type, entity_id)
+);
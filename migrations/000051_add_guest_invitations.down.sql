@@ -0,0 +1,2 @@
+-- Drop the guest_invitations table
+DROP TABLE IF EXISTS guest_invitations;
@@ -0,0 +1,25 @@
+-- Migration to add invitations granting external reviewers Commenter access to a single epic
+
+CREATE TABLE IF NOT EXISTS guest_invitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    epic_id UUID NOT NULL REFERENCES epics(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    guest_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    invited_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_guest_invitations_period CHECK (expires_at > created_at)
+);
+
+-- Create indexes for the invitations of an epic and of a guest
+CREATE INDEX IF NOT EXISTS idx_guest_invitations_epic_id
+    ON guest_invitations(epic_id);
+CREATE INDEX IF NOT EXISTS idx_guest_invitations_guest_id
+    ON guest_invitations(guest_id);
+
+-- Create index for the cleanup of expired guests
+CREATE INDEX IF NOT EXISTS idx_guest_invitations_expires_at
+    ON guest_invitations(expires_at);
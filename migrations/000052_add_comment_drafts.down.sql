@@ -0,0 +1,2 @@
+-- Drop the comment_drafts table
+DROP TABLE IF EXISTS comment_drafts;
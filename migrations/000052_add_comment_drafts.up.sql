@@ -0,0 +1,27 @@
+-- Migration to add autosaved drafts of comments and replies
+
+CREATE TABLE IF NOT EXISTS comment_drafts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    parent_comment_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- One draft per user for each entity and each comment thread; top-level drafts have no parent comment
+CREATE UNIQUE INDEX IF NOT EXISTS idx_comment_drafts_entity_user_thread
+    ON comment_drafts(entity_type, entity_id, user_id, COALESCE(parent_comment_id, '00000000-0000-0000-0000-000000000000'::uuid));
+
+-- Create indexes for the drafts of an entity and of a user
+CREATE INDEX IF NOT EXISTS idx_comment_drafts_entity
+    ON comment_drafts(entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_comment_drafts_user_id
+    ON comment_drafts(user_id, updated_at DESC);
+
+-- Create index for the cleanup of expired drafts
+CREATE INDEX IF NOT EXISTS idx_comment_drafts_expires_at
+    ON comment_drafts(expires_at);
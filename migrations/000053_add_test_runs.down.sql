@@ -0,0 +1,4 @@
+-- Drop the test run tables
+DROP TABLE IF EXISTS entity_verifications;
+DROP TABLE IF EXISTS test_results;
+DROP TABLE IF EXISTS test_runs;
@@ -0,0 +1,44 @@
+-- Migration to add test runs imported from CI pipelines and the verification state they set
+
+CREATE TABLE IF NOT EXISTS test_runs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    format VARCHAR(20) NOT NULL,
+    commit_sha VARCHAR(64),
+    branch VARCHAR(255),
+    build_url TEXT,
+    imported_by UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    imported_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    passed INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    skipped INTEGER NOT NULL DEFAULT 0,
+    unmatched INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_test_runs_imported_at ON test_runs(imported_at);
+
+CREATE TABLE IF NOT EXISTS test_results (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    test_run_id UUID NOT NULL REFERENCES test_runs(id) ON DELETE CASCADE,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    reference_id VARCHAR(50) NOT NULL,
+    test_name TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('passed', 'failed', 'skipped')),
+    message TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_test_results_test_run_id ON test_results(test_run_id);
+CREATE INDEX IF NOT EXISTS idx_test_results_entity ON test_results(entity_type, entity_id);
+
+CREATE TABLE IF NOT EXISTS entity_verifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('verified', 'failed')),
+    test_run_id UUID NOT NULL REFERENCES test_runs(id) ON DELETE CASCADE,
+    verified_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- One verification state per entity
+    CONSTRAINT idx_entity_verifications_entity UNIQUE (entity_type, entity_id)
+);
@@ -0,0 +1,5 @@
+-- Remove the freeze columns
+ALTER TABLE epics DROP COLUMN IF EXISTS freeze_reason;
+ALTER TABLE epics DROP COLUMN IF EXISTS frozen_by;
+ALTER TABLE epics DROP COLUMN IF EXISTS frozen_at;
+ALTER TABLE epics DROP COLUMN IF EXISTS frozen;
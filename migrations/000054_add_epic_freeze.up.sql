@@ -0,0 +1,6 @@
+-- Migration to freeze the requirements of an epic during release stabilization
+
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS frozen BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS frozen_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS frozen_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS freeze_reason TEXT;
@@ -0,0 +1,6 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_epics_parent_epic_id;
+
+-- Remove the parent epic column
+ALTER TABLE epics DROP CONSTRAINT IF EXISTS chk_epics_parent_not_self;
+ALTER TABLE epics DROP COLUMN IF EXISTS parent_epic_id;
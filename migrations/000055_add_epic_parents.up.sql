@@ -0,0 +1,7 @@
+-- Migration to nest epics under parent epics for initiatives spanning several epics
+
+ALTER TABLE epics ADD COLUMN IF NOT EXISTS parent_epic_id UUID REFERENCES epics(id) ON DELETE SET NULL;
+ALTER TABLE epics ADD CONSTRAINT chk_epics_parent_not_self CHECK (parent_epic_id IS NULL OR parent_epic_id <> id);
+
+-- Create index for listing the child epics of an epic
+CREATE INDEX IF NOT EXISTS idx_epics_parent_epic_id ON epics(parent_epic_id);
@@ -0,0 +1,5 @@
+-- Drop index first
+DROP INDEX IF EXISTS idx_users_team;
+
+-- Remove the team column
+ALTER TABLE users DROP COLUMN IF EXISTS team;
@@ -0,0 +1,6 @@
+-- Migration to record the team of a user, set when users are imported in bulk
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS team VARCHAR(100);
+
+-- Create index for finding the members of a team
+CREATE INDEX IF NOT EXISTS idx_users_team ON users(team);
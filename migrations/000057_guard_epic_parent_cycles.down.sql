@@ -0,0 +1,4 @@
+-- Drop the trigger keeping the parents of epics free of cycles
+DROP TRIGGER IF EXISTS check_epics_parent_cycle_update ON epics;
+DROP TRIGGER IF EXISTS check_epics_parent_cycle_insert ON epics;
+DROP FUNCTION IF EXISTS check_epic_parent_cycle();
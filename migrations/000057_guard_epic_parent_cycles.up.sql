@@ -0,0 +1,30 @@
+-- Migration to keep the parents of epics free of cycles, whichever client changes them
+
+-- Reject nesting an epic under an epic nested under it. Changes of parents are serialized by a transaction
+-- advisory lock, the one the application takes before its own check, so two concurrent changes cannot each
+-- pass the check and together form a cycle.
+CREATE OR REPLACE FUNCTION check_epic_parent_cycle()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('epic_parents'));
+    IF EXISTS (
+        WITH RECURSIVE ancestors(id) AS (
+            SELECT NEW.parent_epic_id
+            UNION
+            SELECT epics.parent_epic_id FROM epics JOIN ancestors ON epics.id = ancestors.id
+            WHERE epics.parent_epic_id IS NOT NULL
+        )
+        SELECT 1 FROM ancestors WHERE id = NEW.id
+    ) THEN
+        RAISE EXCEPTION 'nesting epic % under epic % would create a cycle', NEW.id, NEW.parent_epic_id
+            USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER check_epics_parent_cycle_insert BEFORE INSERT ON epics
+    FOR EACH ROW WHEN (NEW.parent_epic_id IS NOT NULL) EXECUTE FUNCTION check_epic_parent_cycle();
+CREATE TRIGGER check_epics_parent_cycle_update BEFORE UPDATE OF parent_epic_id ON epics
+    FOR EACH ROW WHEN (NEW.parent_epic_id IS NOT NULL AND NEW.parent_epic_id IS DISTINCT FROM OLD.parent_epic_id)
+    EXECUTE FUNCTION check_epic_parent_cycle();
@@ -0,0 +1,16 @@
+-- Drop the trigger recording changes of nested epics
+DROP TRIGGER IF EXISTS record_epics_hierarchy_change ON epics;
+DROP FUNCTION IF EXISTS record_epic_change();
+
+-- Record changes below an entity for the entity alone again
+CREATE OR REPLACE FUNCTION record_hierarchy_change(change_entity_type VARCHAR, change_entity_id UUID)
+RETURNS VOID AS $$
+BEGIN
+    IF change_entity_id IS NULL THEN
+        RETURN;
+    END IF;
+    INSERT INTO hierarchy_changes (entity_type, entity_id, changed_at)
+    VALUES (change_entity_type, change_entity_id, NOW())
+    ON CONFLICT (entity_type, entity_id) DO UPDATE SET changed_at = EXCLUDED.changed_at;
+END;
+$$ language 'plpgsql';
@@ -0,0 +1,43 @@
+-- Migration to record changes below nested epics for the epics they are nested under, whose outline,
+-- progress and children include the nested epics, so their conditional requests see those changes
+
+-- Record a change below an entity of the hierarchy; a change below an epic is also below its ancestors
+CREATE OR REPLACE FUNCTION record_hierarchy_change(change_entity_type VARCHAR, change_entity_id UUID)
+RETURNS VOID AS $$
+BEGIN
+    IF change_entity_id IS NULL THEN
+        RETURN;
+    END IF;
+    IF change_entity_type <> 'epic' THEN
+        INSERT INTO hierarchy_changes (entity_type, entity_id, changed_at)
+        VALUES (change_entity_type, change_entity_id, NOW())
+        ON CONFLICT (entity_type, entity_id) DO UPDATE SET changed_at = EXCLUDED.changed_at;
+        RETURN;
+    END IF;
+    WITH RECURSIVE ancestors(id) AS (
+        SELECT change_entity_id
+        UNION
+        SELECT epics.parent_epic_id FROM epics JOIN ancestors ON epics.id = ancestors.id
+        WHERE epics.parent_epic_id IS NOT NULL
+    )
+    INSERT INTO hierarchy_changes (entity_type, entity_id, changed_at)
+    SELECT 'epic', id, NOW() FROM ancestors
+    ON CONFLICT (entity_type, entity_id) DO UPDATE SET changed_at = EXCLUDED.changed_at;
+END;
+$$ language 'plpgsql';
+
+-- A nested epic changes the epic it is nested under, and the epic it is moved from or to
+CREATE OR REPLACE FUNCTION record_epic_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM record_hierarchy_change('epic', NEW.parent_epic_id);
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM record_hierarchy_change('epic', OLD.parent_epic_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER record_epics_hierarchy_change AFTER INSERT OR UPDATE OR DELETE ON epics FOR EACH ROW EXECUTE FUNCTION record_epic_change();
@@ -13,8 +13,13 @@ import (
 	"text/template"
 
 	"gopkg.in/yaml.v3"
+
+	"product-requirements-management/internal/deprecation"
 )
 
+// deprecations are the deprecated routes of the server, badged in the generated documentation
+var deprecations = deprecation.Endpoints()
+
 // OpenAPI specification structures
 type OpenAPISpec struct {
 	OpenAPI    string                `yaml:"openapi" json:"openapi"`
@@ -55,9 +60,29 @@ type PathItem struct {
 	Put    *Operation `yaml:"put,omitempty" json:"put,omitempty"`
 	Delete *Operation `yaml:"delete,omitempty" json:"delete,omitempty"`
 	Patch  *Operation `yaml:"patch,omitempty" json:"patch,omitempty"`
+	// Parameters apply to every operation of the path
+	Parameters []Parameter `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+}
+
+// operation returns the operation of a lowercase HTTP method, or nil when the path has none
+func (p PathItem) operation(method string) *Operation {
+	switch method {
+	case "get":
+		return p.Get
+	case "post":
+		return p.Post
+	case "put":
+		return p.Put
+	case "delete":
+		return p.Delete
+	case "patch":
+		return p.Patch
+	}
+	return nil
 }
 
 type Operation struct {
+	OperationID string                `yaml:"operationId,omitempty" json:"operationId,omitempty"`
 	Tags        []string              `yaml:"tags,omitempty" json:"tags,omitempty"`
 	Summary     string                `yaml:"summary,omitempty" json:"summary,omitempty"`
 	Description string                `yaml:"description,omitempty" json:"description,omitempty"`
@@ -65,9 +90,11 @@ type Operation struct {
 	RequestBody *RequestBody          `yaml:"requestBody,omitempty" json:"requestBody,omitempty"`
 	Responses   map[string]Response   `yaml:"responses,omitempty" json:"responses,omitempty"`
 	Security    []map[string][]string `yaml:"security,omitempty" json:"security,omitempty"`
+	Deprecated  bool                  `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
 }
 
 type Parameter struct {
+	Ref         string      `yaml:"$ref,omitempty" json:"$ref,omitempty"`
 	Name        string      `yaml:"name" json:"name"`
 	In          string      `yaml:"in" json:"in"`
 	Description string      `yaml:"description,omitempty" json:"description,omitempty"`
@@ -82,10 +109,12 @@ type RequestBody struct {
 }
 
 type MediaTypeObject struct {
-	Schema interface{} `yaml:"schema,omitempty" json:"schema,omitempty"`
+	Schema  interface{} `yaml:"schema,omitempty" json:"schema,omitempty"`
+	Example interface{} `yaml:"example,omitempty" json:"example,omitempty"`
 }
 
 type Response struct {
+	Ref         string                     `yaml:"$ref,omitempty" json:"$ref,omitempty"`
 	Description string                     `yaml:"description" json:"description"`
 	Content     map[string]MediaTypeObject `yaml:"content,omitempty" json:"content,omitempty"`
 }
@@ -108,6 +137,9 @@ type EndpointDoc struct {
 	RequestBody *RequestBody
 	Responses   map[string]Response
 	Security    []map[string][]string
+	Deprecated  bool
+	// Deprecation describes the deprecation from the server's deprecation registry; empty without an entry
+	Deprecation string
 }
 
 type TagGroup struct {
@@ -119,7 +151,10 @@ func main() {
 	var (
 		inputFile = flag.String("input", "docs/openapi-v3.yaml", "Input OpenAPI specification file")
 		outputDir = flag.String("output", "docs/generated", "Output directory for generated documentation")
-		format    = flag.String("format", "all", "Output format: html, markdown, typescript, json, all")
+		format    = flag.String("format", "all", "Output format: html, markdown, typescript, json, site, all")
+		baseURL   = flag.String("base-url", "", "API base URL used by the try-it console of the site (defaults to the first server)")
+		version   = flag.String("site-version", "", "Version directory of the site bundle (defaults to the specification version)")
+		pages     = flag.Bool("markdown-pages", false, "Also write the Markdown documentation as an index, a page per tag and a schema reference to <output>/markdown")
 		verbose   = flag.Bool("verbose", false, "Enable verbose output")
 	)
 	flag.Parse()
@@ -139,6 +174,8 @@ func main() {
 		log.Fatalf("Failed to load OpenAPI specification: %v", err)
 	}
 
+	site := siteOptions{BaseURL: *baseURL, Version: *version}
+
 	// Generate documentation in requested formats
 	switch *format {
 	case "html":
@@ -146,7 +183,7 @@ func main() {
 			log.Fatalf("Failed to generate HTML documentation: %v", err)
 		}
 	case "markdown":
-		if err := generateMarkdownDocs(spec, *outputDir, *verbose); err != nil {
+		if err := generateMarkdownDocs(spec, *outputDir, *pages, *verbose); err != nil {
 			log.Fatalf("Failed to generate Markdown documentation: %v", err)
 		}
 	case "typescript":
@@ -157,12 +194,16 @@ func main() {
 		if err := generateJSONDocs(spec, *outputDir, *verbose); err != nil {
 			log.Fatalf("Failed to generate JSON documentation: %v", err)
 		}
+	case "site":
+		if err := generateSiteDocs(spec, *outputDir, site, *verbose); err != nil {
+			log.Fatalf("Failed to generate documentation site: %v", err)
+		}
 	case "all":
-		if err := generateAllDocs(spec, *outputDir, *verbose); err != nil {
+		if err := generateAllDocs(spec, *outputDir, site, *pages, *verbose); err != nil {
 			log.Fatalf("Failed to generate documentation: %v", err)
 		}
 	default:
-		log.Fatalf("Unknown format: %s. Use html, markdown, typescript, json, or all", *format)
+		log.Fatalf("Unknown format: %s. Use html, markdown, typescript, json, site, or all", *format)
 	}
 
 	if *verbose {
@@ -190,11 +231,11 @@ func loadOpenAPISpec(filename string) (*OpenAPISpec, error) {
 	return &spec, nil
 }
 
-func generateAllDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
+func generateAllDocs(spec *OpenAPISpec, outputDir string, site siteOptions, markdownPages, verbose bool) error {
 	if err := generateHTMLDocs(spec, outputDir, verbose); err != nil {
 		return err
 	}
-	if err := generateMarkdownDocs(spec, outputDir, verbose); err != nil {
+	if err := generateMarkdownDocs(spec, outputDir, markdownPages, verbose); err != nil {
 		return err
 	}
 	if err := generateTypeScriptDocs(spec, outputDir, verbose); err != nil {
@@ -203,6 +244,9 @@ func generateAllDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
 	if err := generateJSONDocs(spec, outputDir, verbose); err != nil {
 		return err
 	}
+	if err := generateSiteDocs(spec, outputDir, site, verbose); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -239,6 +283,8 @@ func generateHTMLDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
         .method.patch { background: #e2e3e5; color: #383d41; }
         .path { font-family: 'Monaco', 'Menlo', monospace; font-weight: bold; }
         .summary { margin: 10px 0 5px 0; font-weight: 600; }
+        .deprecated-badge { display: inline-block; margin-left: 10px; padding: 2px 8px; border-radius: 4px; background: #6c757d; color: white; font-size: 0.75em; text-transform: uppercase; }
+        .deprecation-note { margin: 5px 0; padding: 8px 12px; border-left: 4px solid #6c757d; background: #f1f3f5; }
         .endpoint-description { color: #6c757d; margin: 5px 0; }
         .endpoint-details { padding: 20px; }
         .parameters, .responses { margin: 15px 0; }
@@ -281,7 +327,9 @@ func generateHTMLDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
                 <div class="endpoint-header">
                     <span class="method {{.Method}}">{{.Method | upper}}</span>
                     <span class="path">{{.Path}}</span>
+                    {{if .Deprecated}}<span class="deprecated-badge">Deprecated</span>{{end}}
                     <div class="summary">{{.Summary}}</div>
+                    {{if .Deprecation}}<div class="deprecation-note">{{.Deprecation}}</div>{{end}}
                     {{if .Description}}<div class="endpoint-description">{{.Description}}</div>{{end}}
                 </div>
                 <div class="endpoint-details">
@@ -374,7 +422,9 @@ func generateHTMLDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
 
 	return nil
 }
-func generateMarkdownDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
+
+// generateMarkdownDocs writes api-documentation.md and, with pages, the multi-file Markdown documentation
+func generateMarkdownDocs(spec *OpenAPISpec, outputDir string, pages, verbose bool) error {
 	if verbose {
 		log.Printf("Generating Markdown documentation...")
 	}
@@ -399,8 +449,11 @@ func generateMarkdownDocs(spec *OpenAPISpec, outputDir string, verbose bool) err
 		"{{range .TagGroups}}" +
 		"## {{.Name}}\n\n" +
 		"{{range .Endpoints}}" +
-		"### {{.Method | upper}} {{.Path}}\n\n" +
+		"### {{.Method | upper}} {{.Path}}{{if .Deprecated}} `deprecated`{{end}}\n\n" +
 		"{{.Summary}}\n\n" +
+		"{{if .Deprecation}}" +
+		"> {{.Deprecation}}\n\n" +
+		"{{end}}" +
 		"{{if .Description}}" +
 		"{{.Description}}\n" +
 		"{{end}}\n\n" +
@@ -480,654 +533,9 @@ func generateMarkdownDocs(spec *OpenAPISpec, outputDir string, verbose bool) err
 		log.Printf("Markdown documentation generated: %s", outputFile)
 	}
 
-	return nil
-}
-
-func generateTypeScriptDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
-	if verbose {
-		log.Printf("Generating TypeScript documentation...")
-	}
-
-	// Generate TypeScript interfaces from OpenAPI schemas
-	tsContent := `// Generated TypeScript interfaces from OpenAPI specification
-// Version: ` + spec.Info.Version + `
-// Generated on: ` + fmt.Sprintf("%s", "auto-generated") + `
-
-/**
- * ` + spec.Info.Title + `
- * ` + spec.Info.Description + `
- */
-
-// Base API Configuration
-export interface ApiConfig {
-  baseUrl: string;
-  apiKey?: string;
-  timeout?: number;
-}
-
-// Standard API Response wrapper
-export interface ApiResponse<T = any> {
-  data?: T;
-  error?: {
-    code: string;
-    message: string;
-  };
-}
-
-// Pagination wrapper for list responses
-export interface ListResponse<T> {
-  data: T[];
-  total_count: number;
-  limit: number;
-  offset: number;
-}
-
-// Error response format
-export interface ErrorResponse {
-  error: {
-    code: string;
-    message: string;
-  };
-}
-
-// Authentication types
-export interface LoginRequest {
-  username: string;
-  password: string;
-}
-
-export interface LoginResponse {
-  token: string;
-  expires_at: string;
-  user: User;
-}
-
-export interface ChangePasswordRequest {
-  current_password: string;
-  new_password: string;
-}
-
-// User types
-export interface User {
-  id: string;
-  username: string;
-  email: string;
-  role: 'Administrator' | 'User' | 'Commenter';
-  created_at: string;
-  updated_at: string;
-}
-
-export interface CreateUserRequest {
-  username: string;
-  email: string;
-  password: string;
-  role: 'Administrator' | 'User' | 'Commenter';
-}
-
-export interface UpdateUserRequest {
-  username?: string;
-  email?: string;
-  role?: 'Administrator' | 'User' | 'Commenter';
-}
-
-// Epic types
-export type EpicStatus = 'Backlog' | 'Draft' | 'In Progress' | 'Done' | 'Cancelled';
-export type Priority = 1 | 2 | 3 | 4;
-
-export interface Epic {
-  id: string;
-  reference_id: string;
-  title: string;
-  description?: string;
-  status: EpicStatus;
-  priority: Priority;
-  creator_id: string;
-  assignee_id?: string;
-  created_at: string;
-  updated_at: string;
-  
-  // Optional populated fields
-  creator?: User;
-  assignee?: User;
-  user_stories?: UserStory[];
-  comments?: Comment[];
-}
-
-export interface CreateEpicRequest {
-  title: string;
-  description?: string;
-  priority: Priority;
-  creator_id: string;
-  assignee_id?: string;
-}
-
-export interface UpdateEpicRequest {
-  title?: string;
-  description?: string;
-  priority?: Priority;
-  assignee_id?: string;
-}
-
-// User Story types
-export type UserStoryStatus = 'Backlog' | 'Draft' | 'In Progress' | 'Done' | 'Cancelled';
-
-export interface UserStory {
-  id: string;
-  reference_id: string;
-  title: string;
-  description?: string;
-  status: UserStoryStatus;
-  priority: Priority;
-  epic_id: string;
-  creator_id: string;
-  assignee_id?: string;
-  created_at: string;
-  updated_at: string;
-  
-  // Optional populated fields
-  epic?: Epic;
-  creator?: User;
-  assignee?: User;
-  acceptance_criteria?: AcceptanceCriteria[];
-  requirements?: Requirement[];
-  comments?: Comment[];
-}
-
-export interface CreateUserStoryRequest {
-  title: string;
-  description?: string;
-  priority: Priority;
-  epic_id: string;
-  assignee_id?: string;
-}
-
-export interface UpdateUserStoryRequest {
-  title?: string;
-  description?: string;
-  priority?: Priority;
-  assignee_id?: string;
-}
-
-// Acceptance Criteria types
-export interface AcceptanceCriteria {
-  id: string;
-  reference_id: string;
-  description: string;
-  user_story_id: string;
-  author_id: string;
-  created_at: string;
-  updated_at: string;
-  
-  // Optional populated fields
-  user_story?: UserStory;
-  author?: User;
-  requirements?: Requirement[];
-  comments?: Comment[];
-}
-
-export interface CreateAcceptanceCriteriaRequest {
-  description: string;
-  user_story_id: string;
-}
-
-export interface UpdateAcceptanceCriteriaRequest {
-  description?: string;
-}
-
-// Requirement types
-export type RequirementStatus = 'Draft' | 'Active' | 'Obsolete';
-
-export interface Requirement {
-  id: string;
-  reference_id: string;
-  title: string;
-  description?: string;
-  status: RequirementStatus;
-  priority: Priority;
-  user_story_id: string;
-  acceptance_criteria_id?: string;
-  type_id: string;
-  creator_id: string;
-  assignee_id?: string;
-  created_at: string;
-  updated_at: string;
-  
-  // Optional populated fields
-  user_story?: UserStory;
-  acceptance_criteria?: AcceptanceCriteria;
-  type?: RequirementType;
-  creator?: User;
-  assignee?: User;
-  source_relationships?: RequirementRelationship[];
-  target_relationships?: RequirementRelationship[];
-  comments?: Comment[];
-}
-
-export interface CreateRequirementRequest {
-  title: string;
-  description?: string;
-  priority: Priority;
-  user_story_id: string;
-  acceptance_criteria_id?: string;
-  type_id: string;
-  assignee_id?: string;
-}
-
-export interface UpdateRequirementRequest {
-  title?: string;
-  description?: string;
-  priority?: Priority;
-  assignee_id?: string;
-}
-
-// Comment types
-export type EntityType = 'epic' | 'user_story' | 'acceptance_criteria' | 'requirement';
-
-export interface Comment {
-  id: string;
-  content: string;
-  entity_type: EntityType;
-  entity_id: string;
-  author_id: string;
-  parent_comment_id?: string;
-  is_resolved: boolean;
-  linked_text?: string;
-  text_position_start?: number;
-  text_position_end?: number;
-  created_at: string;
-  updated_at: string;
-  
-  // Optional populated fields
-  author?: User;
-  parent_comment?: Comment;
-  replies?: Comment[];
-}
-
-export interface CreateCommentRequest {
-  content: string;
-  parent_comment_id?: string;
-}
-
-export interface CreateInlineCommentRequest {
-  content: string;
-  linked_text: string;
-  text_position_start: number;
-  text_position_end: number;
-}
-
-export interface UpdateCommentRequest {
-  content: string;
-}
-
-// Inline comment validation types
-export interface InlineCommentValidationRequest {
-  comments: InlineCommentPosition[];
-}
-
-export interface InlineCommentPosition {
-  comment_id: string;
-  text_position_start: number;
-  text_position_end: number;
-}
-
-export interface ValidationResponse {
-  valid: boolean;
-  errors: string[];
-}
-
-// Configuration types
-export interface RequirementType {
-  id: string;
-  name: string;
-  description?: string;
-  created_at: string;
-  updated_at: string;
-}
-
-export interface RelationshipType {
-  id: string;
-  name: string;
-  description?: string;
-  created_at: string;
-  updated_at: string;
-}
-
-// Steering Document types
-export interface SteeringDocument {
-  id: string;
-  reference_id: string;
-  title: string;
-  description?: string;
-  creator_id: string;
-  created_at: string;
-  updated_at: string;
-  
-  // Optional populated fields
-  creator?: User;
-  epics?: Epic[];
-}
-
-export interface CreateSteeringDocumentRequest {
-  title: string;
-  description?: string;
-}
-
-export interface UpdateSteeringDocumentRequest {
-  title?: string;
-  description?: string;
-}
-
-export interface SteeringDocumentFilters {
-  creator_id?: string;
-  search?: string;
-  order_by?: string;
-  limit?: number;
-  offset?: number;
-}
-
-export interface RequirementRelationship {
-  id: string;
-  source_requirement_id: string;
-  target_requirement_id: string;
-  relationship_type_id: string;
-  created_by: string;
-  created_at: string;
-  
-  // Optional populated fields
-  source_requirement?: Requirement;
-  target_requirement?: Requirement;
-  relationship_type?: RelationshipType;
-  creator?: User;
-}
-
-export interface CreateRelationshipRequest {
-  source_requirement_id: string;
-  target_requirement_id: string;
-  relationship_type_id: string;
-}
-
-// Deletion workflow types
-export interface DependencyInfo {
-  can_delete: boolean;
-  dependencies: DependencyItem[];
-  warnings: string[];
-}
-
-export interface DependencyItem {
-  entity_type: string;
-  entity_id: string;
-  reference_id: string;
-  title: string;
-  dependency_type: string;
-}
-
-export interface DeletionResult {
-  success: boolean;
-  deleted_entities: DeletedEntity[];
-  message: string;
-}
-
-export interface DeletedEntity {
-  entity_type: string;
-  entity_id: string;
-  reference_id: string;
-}
-
-// Search types
-export interface SearchResult {
-  entity_type: EntityType;
-  entity_id: string;
-  reference_id: string;
-  title: string;
-  description?: string;
-  highlight?: string;
-  rank: number;
-}
-
-export interface SearchResponse {
-  results: SearchResult[];
-  total_count: number;
-  query: string;
-  entity_types: string[];
-  limit: number;
-  offset: number;
-}
-
-export interface SearchSuggestionsResponse {
-  titles: string[];
-  reference_ids: string[];
-  statuses: string[];
-}
-
-// Hierarchy types
-export interface HierarchyNode {
-  entity_type: EntityType;
-  entity_id: string;
-  reference_id: string;
-  title: string;
-  status: string;
-  children?: HierarchyNode[];
-}
-
-export interface EntityPath {
-  entity_type: EntityType;
-  entity_id: string;
-  reference_id: string;
-  title: string;
-}
-
-// Status management types
-export interface StatusChangeRequest {
-  status: string;
-}
-
-export interface AssignmentRequest {
-  assignee_id?: string;
-}
-
-// Health check types
-export interface HealthCheckResponse {
-  status: string;
-  reason?: string;
-}
-
-// List response types
-export interface UserListResponse extends ListResponse<User> {}
-export interface EpicListResponse extends ListResponse<Epic> {}
-export interface UserStoryListResponse extends ListResponse<UserStory> {}
-export interface AcceptanceCriteriaListResponse extends ListResponse<AcceptanceCriteria> {}
-export interface RequirementListResponse extends ListResponse<Requirement> {}
-export interface CommentListResponse extends ListResponse<Comment> {}
-export interface RequirementTypeListResponse extends ListResponse<RequirementType> {}
-export interface RelationshipTypeListResponse extends ListResponse<RelationshipType> {}
-export interface SteeringDocumentListResponse extends ListResponse<SteeringDocument> {}
-
-// API Client interface
-export interface ApiClient {
-  // Authentication
-  login(credentials: LoginRequest): Promise<LoginResponse>;
-  getProfile(): Promise<User>;
-  changePassword(request: ChangePasswordRequest): Promise<void>;
-  
-  // User management (Admin only)
-  createUser(user: CreateUserRequest): Promise<User>;
-  getUsers(params?: { limit?: number; offset?: number }): Promise<UserListResponse>;
-  getUser(id: string): Promise<User>;
-  updateUser(id: string, user: UpdateUserRequest): Promise<User>;
-  deleteUser(id: string): Promise<void>;
-  
-  // Epics
-  createEpic(epic: CreateEpicRequest): Promise<Epic>;
-  getEpics(params?: {
-    creator_id?: string;
-    assignee_id?: string;
-    status?: EpicStatus;
-    priority?: Priority;
-    limit?: number;
-    offset?: number;
-    include?: string;
-  }): Promise<EpicListResponse>;
-  getEpic(id: string): Promise<Epic>;
-  updateEpic(id: string, epic: UpdateEpicRequest): Promise<Epic>;
-  deleteEpic(id: string): Promise<void>;
-  changeEpicStatus(id: string, status: StatusChangeRequest): Promise<Epic>;
-  assignEpic(id: string, assignment: AssignmentRequest): Promise<Epic>;
-  validateEpicDeletion(id: string): Promise<DependencyInfo>;
-  deleteEpicComprehensive(id: string): Promise<DeletionResult>;
-  
-  // User Stories
-  createUserStory(userStory: CreateUserStoryRequest): Promise<UserStory>;
-  getUserStories(params?: {
-    epic_id?: string;
-    creator_id?: string;
-    assignee_id?: string;
-    status?: UserStoryStatus;
-    priority?: Priority;
-    limit?: number;
-    offset?: number;
-    include?: string;
-  }): Promise<UserStoryListResponse>;
-  getUserStory(id: string): Promise<UserStory>;
-  updateUserStory(id: string, userStory: UpdateUserStoryRequest): Promise<UserStory>;
-  deleteUserStory(id: string): Promise<void>;
-  
-  // Requirements
-  createRequirement(requirement: CreateRequirementRequest): Promise<Requirement>;
-  getRequirements(params?: {
-    user_story_id?: string;
-    acceptance_criteria_id?: string;
-    type_id?: string;
-    creator_id?: string;
-    assignee_id?: string;
-    status?: RequirementStatus;
-    priority?: Priority;
-    limit?: number;
-    offset?: number;
-    include?: string;
-  }): Promise<RequirementListResponse>;
-  getRequirement(id: string): Promise<Requirement>;
-  updateRequirement(id: string, requirement: UpdateRequirementRequest): Promise<Requirement>;
-  deleteRequirement(id: string): Promise<void>;
-  
-  // Comments
-  getComments(entityType: EntityType, entityId: string, params?: {
-    limit?: number;
-    offset?: number;
-  }): Promise<CommentListResponse>;
-  createComment(entityType: EntityType, entityId: string, comment: CreateCommentRequest): Promise<Comment>;
-  createInlineComment(entityType: EntityType, entityId: string, comment: CreateInlineCommentRequest): Promise<Comment>;
-  updateComment(id: string, comment: UpdateCommentRequest): Promise<Comment>;
-  deleteComment(id: string): Promise<void>;
-  resolveComment(id: string): Promise<Comment>;
-  unresolveComment(id: string): Promise<Comment>;
-  
-  // Steering Documents
-  createSteeringDocument(doc: CreateSteeringDocumentRequest): Promise<SteeringDocument>;
-  getSteeringDocuments(params?: SteeringDocumentFilters): Promise<SteeringDocumentListResponse>;
-  getSteeringDocument(id: string): Promise<SteeringDocument>;
-  updateSteeringDocument(id: string, doc: UpdateSteeringDocumentRequest): Promise<SteeringDocument>;
-  deleteSteeringDocument(id: string): Promise<void>;
-  getEpicSteeringDocuments(epicId: string): Promise<SteeringDocument[]>;
-  linkSteeringDocumentToEpic(epicId: string, docId: string): Promise<void>;
-  unlinkSteeringDocumentFromEpic(epicId: string, docId: string): Promise<void>;
-  
-  // Search
-  search(params: {
-    q: string;
-    entity_types?: string;
-    limit?: number;
-    offset?: number;
-  }): Promise<SearchResponse>;
-  getSearchSuggestions(params: {
-    query: string;
-    limit?: number;
-  }): Promise<SearchSuggestionsResponse>;
-  
-  // Health checks
-  readinessCheck(): Promise<HealthCheckResponse>;
-  livenessCheck(): Promise<HealthCheckResponse>;
-}
-
-// HTTP client configuration
-export interface HttpClientConfig {
-  baseURL: string;
-  timeout?: number;
-  headers?: Record<string, string>;
-  interceptors?: {
-    request?: (config: any) => any;
-    response?: (response: any) => any;
-    error?: (error: any) => any;
-  };
-}
-
-// Utility types for API parameters
-export type QueryParams = Record<string, string | number | boolean | undefined>;
-export type PathParams = Record<string, string>;
-export type RequestHeaders = Record<string, string>;
-
-// API endpoint definitions
-export const API_ENDPOINTS = {
-  // Authentication
-  LOGIN: '/auth/login',
-  PROFILE: '/auth/profile',
-  CHANGE_PASSWORD: '/auth/change-password',
-  
-  // User management
-  USERS: '/auth/users',
-  USER: '/auth/users/{id}',
-  
-  // Epics
-  EPICS: '/api/v1/epics',
-  EPIC: '/api/v1/epics/{id}',
-  EPIC_USER_STORIES: '/api/v1/epics/{id}/user-stories',
-  EPIC_STATUS: '/api/v1/epics/{id}/status',
-  EPIC_ASSIGN: '/api/v1/epics/{id}/assign',
-  EPIC_VALIDATE_DELETION: '/api/v1/epics/{id}/validate-deletion',
-  EPIC_DELETE: '/api/v1/epics/{id}/delete',
-  EPIC_COMMENTS: '/api/v1/epics/{id}/comments',
-  
-  // User Stories
-  USER_STORIES: '/api/v1/user-stories',
-  USER_STORY: '/api/v1/user-stories/{id}',
-  USER_STORY_ACCEPTANCE_CRITERIA: '/api/v1/user-stories/{id}/acceptance-criteria',
-  USER_STORY_REQUIREMENTS: '/api/v1/user-stories/{id}/requirements',
-  USER_STORY_STATUS: '/api/v1/user-stories/{id}/status',
-  USER_STORY_ASSIGN: '/api/v1/user-stories/{id}/assign',
-  
-  // Requirements
-  REQUIREMENTS: '/api/v1/requirements',
-  REQUIREMENT: '/api/v1/requirements/{id}',
-  REQUIREMENT_RELATIONSHIPS: '/api/v1/requirements/{id}/relationships',
-  REQUIREMENT_STATUS: '/api/v1/requirements/{id}/status',
-  REQUIREMENT_ASSIGN: '/api/v1/requirements/{id}/assign',
-  
-  // Search
-  SEARCH: '/api/v1/search',
-  SEARCH_SUGGESTIONS: '/api/v1/search/suggestions',
-  
-  // Steering Documents
-  STEERING_DOCUMENTS: '/api/v1/steering-documents',
-  STEERING_DOCUMENT: '/api/v1/steering-documents/{id}',
-  EPIC_STEERING_DOCUMENTS: '/api/v1/epics/{id}/steering-documents',
-  EPIC_STEERING_DOCUMENT_LINK: '/api/v1/epics/{epic_id}/steering-documents/{doc_id}',
-  
-  // Health
-  READY: '/ready',
-  LIVE: '/live',
-} as const;
-
-export type ApiEndpoint = typeof API_ENDPOINTS[keyof typeof API_ENDPOINTS];
-`
-
-	outputFile := filepath.Join(outputDir, "api-types.ts")
-	if err := ioutil.WriteFile(outputFile, []byte(tsContent), 0644); err != nil {
-		return fmt.Errorf("failed to write TypeScript file: %w", err)
-	}
-
-	if verbose {
-		log.Printf("TypeScript documentation generated: %s", outputFile)
+	if pages {
+		return generateMarkdownPages(spec, outputDir, verbose)
 	}
-
 	return nil
 }
 
@@ -1176,69 +584,24 @@ func extractEndpoints(spec *OpenAPISpec) []EndpointDoc {
 	var endpoints []EndpointDoc
 
 	for path, pathItem := range spec.Paths {
-		if pathItem.Get != nil {
-			endpoints = append(endpoints, EndpointDoc{
-				Method:      "get",
-				Path:        path,
-				Summary:     pathItem.Get.Summary,
-				Description: pathItem.Get.Description,
-				Tags:        pathItem.Get.Tags,
-				Parameters:  pathItem.Get.Parameters,
-				RequestBody: pathItem.Get.RequestBody,
-				Responses:   pathItem.Get.Responses,
-				Security:    pathItem.Get.Security,
-			})
-		}
-		if pathItem.Post != nil {
-			endpoints = append(endpoints, EndpointDoc{
-				Method:      "post",
-				Path:        path,
-				Summary:     pathItem.Post.Summary,
-				Description: pathItem.Post.Description,
-				Tags:        pathItem.Post.Tags,
-				Parameters:  pathItem.Post.Parameters,
-				RequestBody: pathItem.Post.RequestBody,
-				Responses:   pathItem.Post.Responses,
-				Security:    pathItem.Post.Security,
-			})
-		}
-		if pathItem.Put != nil {
-			endpoints = append(endpoints, EndpointDoc{
-				Method:      "put",
-				Path:        path,
-				Summary:     pathItem.Put.Summary,
-				Description: pathItem.Put.Description,
-				Tags:        pathItem.Put.Tags,
-				Parameters:  pathItem.Put.Parameters,
-				RequestBody: pathItem.Put.RequestBody,
-				Responses:   pathItem.Put.Responses,
-				Security:    pathItem.Put.Security,
-			})
-		}
-		if pathItem.Delete != nil {
-			endpoints = append(endpoints, EndpointDoc{
-				Method:      "delete",
-				Path:        path,
-				Summary:     pathItem.Delete.Summary,
-				Description: pathItem.Delete.Description,
-				Tags:        pathItem.Delete.Tags,
-				Parameters:  pathItem.Delete.Parameters,
-				RequestBody: pathItem.Delete.RequestBody,
-				Responses:   pathItem.Delete.Responses,
-				Security:    pathItem.Delete.Security,
-			})
-		}
-		if pathItem.Patch != nil {
+		for _, method := range []string{"get", "post", "put", "delete", "patch"} {
+			operation := pathItem.operation(method)
+			if operation == nil {
+				continue
+			}
+			deprecated, deprecationNote := endpointDeprecation(method, path, operation)
 			endpoints = append(endpoints, EndpointDoc{
-				Method:      "patch",
+				Method:      method,
 				Path:        path,
-				Summary:     pathItem.Patch.Summary,
-				Description: pathItem.Patch.Description,
-				Tags:        pathItem.Patch.Tags,
-				Parameters:  pathItem.Patch.Parameters,
-				RequestBody: pathItem.Patch.RequestBody,
-				Responses:   pathItem.Patch.Responses,
-				Security:    pathItem.Patch.Security,
+				Summary:     operation.Summary,
+				Description: operation.Description,
+				Tags:        operation.Tags,
+				Parameters:  resolveParameters(spec, pathItem.Parameters, operation.Parameters),
+				RequestBody: operation.RequestBody,
+				Responses:   operation.Responses,
+				Security:    operation.Security,
+				Deprecated:  deprecated,
+				Deprecation: deprecationNote,
 			})
 		}
 	}
@@ -1246,6 +609,26 @@ func extractEndpoints(spec *OpenAPISpec) []EndpointDoc {
 	return endpoints
 }
 
+// endpointDeprecation reports whether an operation is deprecated, either in the deprecation registry of the server
+// or by the deprecated flag of the specification, and describes the deprecation when the registry knows it
+func endpointDeprecation(method, path string, operation *Operation) (bool, string) {
+	if endpoint, ok := deprecations.Lookup(strings.ToUpper(method), ginPath(path)); ok {
+		return true, endpoint.Description()
+	}
+	return operation.Deprecated, ""
+}
+
+// ginPath converts an OpenAPI path such as /epics/{id} to the gin route path /epics/:id
+func ginPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = ":" + strings.Trim(segment, "{}")
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
 func groupEndpointsByTag(endpoints []EndpointDoc) []TagGroup {
 	tagMap := make(map[string][]EndpointDoc)
 
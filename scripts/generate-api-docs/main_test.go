@@ -0,0 +1,34 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"product-requirements-management/internal/deprecation"
+)
+
+func TestGinPath(t *testing.T) {
+	assert.Equal(t, "/api/v1/widgets/:widget_id/attachments", ginPath("/api/v1/widgets/{widget_id}/attachments"))
+	assert.Equal(t, "/health", ginPath("/health"))
+}
+
+func TestEndpointDeprecation(t *testing.T) {
+	registry := deprecation.NewRegistry()
+	registry.Deprecate(http.MethodGet, "/widgets/:id", "2026-10-17", "2027-04-30", "GET /widgets?id=")
+	original := deprecations
+	deprecations = registry
+	t.Cleanup(func() { deprecations = original })
+
+	deprecated, note := endpointDeprecation("get", "/widgets/{id}", &Operation{})
+	assert.True(t, deprecated)
+	assert.Equal(t, "Deprecated since 2026-10-17. Will be removed on 2027-04-30. Use GET /widgets?id= instead.", note)
+
+	deprecated, note = endpointDeprecation("delete", "/widgets/{id}", &Operation{Deprecated: true})
+	assert.True(t, deprecated, "the deprecated flag of the specification is honored without a registry entry")
+	assert.Empty(t, note)
+
+	deprecated, _ = endpointDeprecation("put", "/widgets/{id}", &Operation{})
+	assert.False(t, deprecated)
+}
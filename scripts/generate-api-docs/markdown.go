@@ -0,0 +1,276 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// markdownPagesDir is the directory under the output directory holding the multi-file Markdown documentation
+const markdownPagesDir = "markdown"
+
+// generateMarkdownPages writes the Markdown documentation to <outputDir>/markdown as an index, a page per tag
+// and a schema reference, with a SUMMARY.md table of contents for publishing to GitBook or a similar portal
+func generateMarkdownPages(spec *OpenAPISpec, outputDir string, verbose bool) error {
+	pagesDir := filepath.Join(outputDir, markdownPagesDir)
+	pages := renderMarkdownPages(spec)
+	for _, name := range sortedKeys(pages) {
+		file := filepath.Join(pagesDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return fmt.Errorf("failed to create Markdown pages directory: %w", err)
+		}
+		if err := os.WriteFile(file, []byte(pages[name]), 0644); err != nil {
+			return fmt.Errorf("failed to write Markdown page %s: %w", name, err)
+		}
+	}
+
+	if verbose {
+		log.Printf("Markdown pages generated: %s (%d files)", pagesDir, len(pages))
+	}
+
+	return nil
+}
+
+// renderMarkdownPages renders the multi-file Markdown documentation, keyed by slash-separated file path
+func renderMarkdownPages(spec *OpenAPISpec) map[string]string {
+	site := buildSiteData(spec, siteOptions{})
+	pages := map[string]string{
+		"README.md":  renderMarkdownIndex(spec, site),
+		"SUMMARY.md": renderMarkdownSummary(site),
+		"schemas.md": renderMarkdownSchemas(spec, site),
+	}
+	for i := range site.Tags {
+		pages["tags/"+site.Tags[i].Slug+".md"] = renderMarkdownTag(&site.Tags[i])
+	}
+	return pages
+}
+
+// renderMarkdownIndex renders README.md, which links to the tag pages and the schema reference
+func renderMarkdownIndex(spec *OpenAPISpec, site *siteData) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", site.Info.Title)
+	if description := strings.TrimSpace(site.Info.Description); description != "" {
+		b.WriteString(description + "\n\n")
+	}
+	fmt.Fprintf(&b, "**Version:** %s\n\n", site.Version)
+
+	if len(spec.Servers) > 0 {
+		b.WriteString("## Base URLs\n\n")
+		for _, server := range spec.Servers {
+			if server.Description != "" {
+				fmt.Fprintf(&b, "- **%s**: %s\n", server.Description, server.URL)
+			} else {
+				fmt.Fprintf(&b, "- %s\n", server.URL)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Authentication\n\n")
+	b.WriteString("Unless an endpoint says otherwise, include a JWT or personal access token in the Authorization header:\n\n")
+	b.WriteString("```\nAuthorization: Bearer <token>\n```\n\n")
+
+	b.WriteString("## Endpoints\n\n")
+	for _, tag := range site.Tags {
+		fmt.Fprintf(&b, "- [%s](tags/%s.md) (%d %s)\n", tag.Name, tag.Slug, len(tag.Endpoints), plural(len(tag.Endpoints), "endpoint"))
+	}
+
+	// An endpoint with several tags is listed once, under its first tag
+	var deprecated []string
+	listed := make(map[string]bool)
+	for _, tag := range site.Tags {
+		for _, endpoint := range tag.Endpoints {
+			if !endpoint.Deprecated || listed[endpoint.Anchor] {
+				continue
+			}
+			listed[endpoint.Anchor] = true
+			item := fmt.Sprintf("- [%s %s](tags/%s.md#%s)", strings.ToUpper(endpoint.Method), endpoint.Path, tag.Slug, endpoint.Anchor)
+			if endpoint.Deprecation != "" {
+				item += " - " + endpoint.Deprecation
+			}
+			deprecated = append(deprecated, item)
+		}
+	}
+	if len(deprecated) > 0 {
+		b.WriteString("\n## Deprecated endpoints\n\n")
+		b.WriteString(strings.Join(deprecated, "\n") + "\n")
+	}
+	b.WriteString("\n## Reference\n\n")
+	fmt.Fprintf(&b, "- [Schemas](schemas.md) (%d %s)\n", len(site.Schemas), plural(len(site.Schemas), "schema"))
+	return b.String()
+}
+
+// renderMarkdownSummary renders SUMMARY.md, the table of contents GitBook builds its navigation from
+func renderMarkdownSummary(site *siteData) string {
+	var b strings.Builder
+	b.WriteString("# Summary\n\n")
+	b.WriteString("* [Introduction](README.md)\n\n")
+	b.WriteString("## Endpoints\n\n")
+	for _, tag := range site.Tags {
+		fmt.Fprintf(&b, "* [%s](tags/%s.md)\n", tag.Name, tag.Slug)
+	}
+	b.WriteString("\n## Reference\n\n")
+	b.WriteString("* [Schemas](schemas.md)\n")
+	return b.String()
+}
+
+// renderMarkdownTag renders the page of a tag with its endpoints, their parameters, bodies and responses
+func renderMarkdownTag(tag *siteTag) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", tag.Name)
+	b.WriteString("[Index](../README.md) · [Schemas](../schemas.md)\n\n")
+	for _, endpoint := range tag.Endpoints {
+		fmt.Fprintf(&b, "- [%s %s](#%s)", strings.ToUpper(endpoint.Method), endpoint.Path, endpoint.Anchor)
+		if endpoint.Summary != "" {
+			b.WriteString(" - " + endpoint.Summary)
+		}
+		if endpoint.Deprecated {
+			b.WriteString(" (deprecated)")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	for _, endpoint := range tag.Endpoints {
+		fmt.Fprintf(&b, "<a id=\"%s\"></a>\n\n", endpoint.Anchor)
+		fmt.Fprintf(&b, "## %s %s", strings.ToUpper(endpoint.Method), endpoint.Path)
+		if endpoint.Deprecated {
+			b.WriteString(" `deprecated`")
+		}
+		b.WriteString("\n\n")
+		if endpoint.Summary != "" {
+			fmt.Fprintf(&b, "**%s**\n\n", endpoint.Summary)
+		}
+		if endpoint.Deprecation != "" {
+			fmt.Fprintf(&b, "> %s\n\n", endpoint.Deprecation)
+		}
+		if description := strings.TrimSpace(endpoint.Description); description != "" {
+			b.WriteString(description + "\n\n")
+		}
+		if endpoint.Public {
+			b.WriteString("No authentication required.\n\n")
+		}
+
+		if len(endpoint.Parameters) > 0 {
+			b.WriteString("### Parameters\n\n")
+			b.WriteString("| Name | In | Type | Required | Description |\n")
+			b.WriteString("|------|----|------|----------|-------------|\n")
+			for _, parameter := range endpoint.Parameters {
+				fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n", parameter.Name, parameter.In,
+					markdownType(parameter.Type, parameter.Ref, "../"), yesNo(parameter.Required), markdownCell(parameter.Description))
+			}
+			b.WriteString("\n")
+		}
+
+		if body := endpoint.Body; body != nil {
+			b.WriteString("### Request Body\n\n")
+			fmt.Fprintf(&b, "`%s`: %s", body.ContentType, markdownType(body.Type, body.Ref, "../"))
+			if body.Required {
+				b.WriteString(" (required)")
+			}
+			b.WriteString("\n\n")
+			writeMarkdownExample(&b, body.Example)
+		}
+
+		b.WriteString("### Responses\n\n")
+		b.WriteString("| Status | Description | Type |\n")
+		b.WriteString("|--------|-------------|------|\n")
+		for _, response := range endpoint.Responses {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", response.Code, markdownCell(response.Description), markdownType(response.Type, response.Ref, "../"))
+		}
+		b.WriteString("\n")
+		for _, response := range endpoint.Responses {
+			if response.Example == "" || !strings.HasPrefix(response.Code, "2") {
+				continue
+			}
+			fmt.Fprintf(&b, "Example %s response:\n\n", response.Code)
+			writeMarkdownExample(&b, response.Example)
+		}
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// renderMarkdownSchemas renders schemas.md with the properties, enum values and an example of every schema
+func renderMarkdownSchemas(spec *OpenAPISpec, site *siteData) string {
+	var b strings.Builder
+	b.WriteString("# Schemas\n\n")
+	b.WriteString("[Index](README.md)\n\n")
+	for _, schema := range site.Schemas {
+		fmt.Fprintf(&b, "- [%s](#%s)\n", schema.Name, slugify(schema.Name))
+	}
+	b.WriteString("\n")
+
+	for _, schema := range site.Schemas {
+		fmt.Fprintf(&b, "<a id=\"%s\"></a>\n\n", slugify(schema.Name))
+		fmt.Fprintf(&b, "## %s\n\n", schema.Name)
+		if description := strings.TrimSpace(schema.Description); description != "" {
+			b.WriteString(description + "\n\n")
+		}
+		if len(schema.Enum) > 0 {
+			values := make([]string, len(schema.Enum))
+			for i, value := range schema.Enum {
+				values[i] = "`" + value + "`"
+			}
+			fmt.Fprintf(&b, "One of: %s\n\n", strings.Join(values, ", "))
+		}
+		if len(schema.Properties) > 0 {
+			b.WriteString("| Property | Type | Required | Description |\n")
+			b.WriteString("|----------|------|----------|-------------|\n")
+			for _, property := range schema.Properties {
+				fmt.Fprintf(&b, "| `%s` | %s | %s | %s |\n", property.Name,
+					markdownType(property.Type, property.Ref, ""), yesNo(property.Required), markdownCell(property.Description))
+			}
+			b.WriteString("\n")
+		}
+
+		// Only objects get an example; for the others the type and enum values say it all
+		value := exampleValue(spec, spec.Components.Schemas[schema.Name], 0)
+		if object, ok := value.(map[string]interface{}); ok && len(object) > 0 {
+			if example, err := json.MarshalIndent(object, "", "  "); err == nil {
+				writeMarkdownExample(&b, string(example))
+			}
+		}
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// writeMarkdownExample writes a JSON example as a fenced code block
+func writeMarkdownExample(b *strings.Builder, example string) {
+	if example == "" {
+		return
+	}
+	fmt.Fprintf(b, "```json\n%s\n```\n\n", example)
+}
+
+// markdownType renders a schema type, linking the component it refers to in the schema reference at root
+func markdownType(typ, ref, root string) string {
+	if typ == "" {
+		return "-"
+	}
+	if ref == "" {
+		return typ
+	}
+	return strings.Replace(typ, ref, fmt.Sprintf("[%s](%sschemas.md#%s)", ref, root, slugify(ref)), 1)
+}
+
+// markdownCell flattens text into a table cell
+func markdownCell(text string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(text), " "), "|", `\|`)
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "Yes"
+	}
+	return "No"
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return noun
+	}
+	return noun + "s"
+}
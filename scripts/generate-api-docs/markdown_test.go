@@ -0,0 +1,83 @@
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderMarkdownPages_Golden(t *testing.T) {
+	spec, err := loadOpenAPISpec(filepath.Join("testdata", "openapi.yaml"))
+	require.NoError(t, err)
+
+	pages := renderMarkdownPages(spec)
+
+	goldenDir := filepath.Join("testdata", "markdown")
+	if *updateGolden {
+		require.NoError(t, os.RemoveAll(goldenDir))
+		for name, content := range pages {
+			file := filepath.Join(goldenDir, filepath.FromSlash(name))
+			require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+			require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+		}
+	}
+
+	want := make(map[string]string)
+	require.NoError(t, filepath.WalkDir(goldenDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(goldenDir, path)
+		want[filepath.ToSlash(name)] = string(content)
+		return err
+	}))
+
+	assert.Equal(t, sortedKeys(want), sortedKeys(pages), "generated pages differ from %s", goldenDir)
+	for name, content := range pages {
+		assert.Equal(t, want[name], content, "page %s differs from %s; run go test ./scripts/generate-api-docs -update to accept it", name, goldenDir)
+	}
+}
+
+func TestGenerateMarkdownDocs_Pages(t *testing.T) {
+	spec, err := loadOpenAPISpec(filepath.Join("testdata", "openapi.yaml"))
+	require.NoError(t, err)
+
+	t.Run("single file by default", func(t *testing.T) {
+		outputDir := t.TempDir()
+		require.NoError(t, generateMarkdownDocs(spec, outputDir, false, false))
+
+		assert.FileExists(t, filepath.Join(outputDir, "api-documentation.md"))
+		assert.NoDirExists(t, filepath.Join(outputDir, markdownPagesDir))
+	})
+
+	t.Run("pages on request", func(t *testing.T) {
+		outputDir := t.TempDir()
+		require.NoError(t, generateMarkdownDocs(spec, outputDir, true, false))
+
+		assert.FileExists(t, filepath.Join(outputDir, "api-documentation.md"))
+		for name, content := range renderMarkdownPages(spec) {
+			written, err := os.ReadFile(filepath.Join(outputDir, markdownPagesDir, filepath.FromSlash(name)))
+			require.NoError(t, err)
+			assert.Equal(t, content, string(written), name)
+		}
+	})
+}
+
+func TestMarkdownType(t *testing.T) {
+	assert.Equal(t, "-", markdownType("", "", ""))
+	assert.Equal(t, "string (uuid)", markdownType("string (uuid)", "", "../"))
+	assert.Equal(t, "array of [Widget](../schemas.md#widget)", markdownType("array of Widget", "Widget", "../"))
+	assert.Equal(t, "[service.SearchResponse](schemas.md#service-searchresponse)", markdownType("service.SearchResponse", "service.SearchResponse", ""))
+}
+
+func TestMarkdownCell(t *testing.T) {
+	assert.Equal(t, `code \| message on two lines`, markdownCell("code | message\non  two lines "))
+}
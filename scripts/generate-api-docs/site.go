@@ -0,0 +1,793 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// siteOptions configures the static documentation site
+type siteOptions struct {
+	BaseURL string // API base URL the try-it console sends requests to
+	Version string // Version directory of the bundle; defaults to the specification version
+}
+
+// Static site structures
+type siteData struct {
+	Info      Info
+	Version   string
+	BaseURL   string
+	Tags      []siteTag
+	Schemas   []siteSchema
+	Endpoints int
+}
+
+type siteTag struct {
+	Name      string
+	Slug      string
+	Endpoints []siteEndpoint
+}
+
+type siteEndpoint struct {
+	Anchor      string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Public      bool
+	Deprecated  bool
+	Deprecation string
+	Parameters  []siteField
+	Body        *siteBody
+	Responses   []siteResponse
+}
+
+type siteField struct {
+	Name        string
+	In          string
+	Type        string
+	Ref         string
+	Description string
+	Required    bool
+}
+
+type siteBody struct {
+	ContentType string
+	Type        string
+	Ref         string
+	Required    bool
+	Example     string
+}
+
+type siteResponse struct {
+	Code        string
+	Description string
+	Type        string
+	Ref         string
+	Example     string
+}
+
+type siteSchema struct {
+	Name        string
+	Description string
+	Properties  []siteField
+	Enum        []string
+}
+
+type sitePage struct {
+	Title string
+	Root  string // Relative path from the page to the root of the version bundle
+	Site  *siteData
+	Tag   *siteTag
+}
+
+type searchEntry struct {
+	Title string `json:"title"`
+	Kind  string `json:"kind"`
+	URL   string `json:"url"`
+	Text  string `json:"text"`
+}
+
+// maxExampleDepth bounds the nesting of generated request body examples, so recursive schemas terminate
+const maxExampleDepth = 4
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// generateSiteDocs writes a self-contained multi-page documentation site to <outputDir>/site/<version>,
+// and an index of all generated versions to <outputDir>/site/index.html
+func generateSiteDocs(spec *OpenAPISpec, outputDir string, opts siteOptions, verbose bool) error {
+	if verbose {
+		log.Printf("Generating static documentation site...")
+	}
+
+	site := buildSiteData(spec, opts)
+	siteDir := filepath.Join(outputDir, "site")
+	versionDir := filepath.Join(siteDir, site.Version)
+	for _, dir := range []string{filepath.Join(versionDir, "tags"), filepath.Join(versionDir, "assets")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create site directory: %w", err)
+		}
+	}
+
+	base, err := template.New("layout").Funcs(template.FuncMap{"upper": strings.ToUpper}).Parse(siteLayoutTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse site layout template: %w", err)
+	}
+	render := func(file, content string, page sitePage) error {
+		tmpl, err := template.Must(base.Clone()).Parse(content)
+		if err != nil {
+			return fmt.Errorf("failed to parse template for %s: %w", file, err)
+		}
+		out, err := os.Create(filepath.Join(versionDir, file))
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", file, err)
+		}
+		defer out.Close()
+		if err := tmpl.ExecuteTemplate(out, "layout", page); err != nil {
+			return fmt.Errorf("failed to render %s: %w", file, err)
+		}
+		return nil
+	}
+
+	if err := render("index.html", siteIndexTemplate, sitePage{Title: "Overview", Site: site}); err != nil {
+		return err
+	}
+	if err := render("schemas.html", siteSchemasTemplate, sitePage{Title: "Schemas", Site: site}); err != nil {
+		return err
+	}
+	for i := range site.Tags {
+		tag := &site.Tags[i]
+		page := sitePage{Title: tag.Name, Root: "../", Site: site, Tag: tag}
+		if err := render(filepath.Join("tags", tag.Slug+".html"), siteTagTemplate, page); err != nil {
+			return err
+		}
+	}
+
+	index, err := json.Marshal(buildSearchIndex(site))
+	if err != nil {
+		return fmt.Errorf("failed to marshal search index: %w", err)
+	}
+	assets := map[string]string{
+		"site.css": siteCSS,
+		"site.js":  siteJS,
+		// The index is a script rather than JSON so the site also works when opened from the file system
+		"search-index.js": "window.API_DOCS_SEARCH_INDEX = " + string(index) + ";\n",
+	}
+	for name, content := range assets {
+		if err := os.WriteFile(filepath.Join(versionDir, "assets", name), []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	if err := writeSiteVersionIndex(siteDir, site); err != nil {
+		return err
+	}
+
+	if verbose {
+		log.Printf("Documentation site generated: %s", versionDir)
+	}
+
+	return nil
+}
+
+func buildSiteData(spec *OpenAPISpec, opts siteOptions) *siteData {
+	site := &siteData{Info: spec.Info, Version: opts.Version, BaseURL: opts.BaseURL}
+	if site.Version == "" {
+		site.Version = spec.Info.Version
+	}
+	if site.Version == "" {
+		site.Version = "latest"
+	}
+	if site.BaseURL == "" && len(spec.Servers) > 0 {
+		site.BaseURL = spec.Servers[0].URL
+	}
+	site.BaseURL = strings.TrimSuffix(site.BaseURL, "/")
+
+	endpoints := extractEndpoints(spec)
+	site.Endpoints = len(endpoints)
+	for _, group := range groupEndpointsByTag(endpoints) {
+		sort.Slice(group.Endpoints, func(i, j int) bool {
+			if group.Endpoints[i].Path != group.Endpoints[j].Path {
+				return group.Endpoints[i].Path < group.Endpoints[j].Path
+			}
+			return methodOrder(group.Endpoints[i].Method) < methodOrder(group.Endpoints[j].Method)
+		})
+		tag := siteTag{Name: group.Name, Slug: slugify(group.Name)}
+		for _, endpoint := range group.Endpoints {
+			tag.Endpoints = append(tag.Endpoints, buildSiteEndpoint(spec, endpoint))
+		}
+		site.Tags = append(site.Tags, tag)
+	}
+
+	var names []string
+	for name := range spec.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		site.Schemas = append(site.Schemas, buildSiteSchema(name, spec.Components.Schemas[name]))
+	}
+
+	return site
+}
+
+func buildSiteEndpoint(spec *OpenAPISpec, endpoint EndpointDoc) siteEndpoint {
+	doc := siteEndpoint{
+		Anchor:      slugify(endpoint.Method + " " + endpoint.Path),
+		Method:      endpoint.Method,
+		Path:        endpoint.Path,
+		Summary:     endpoint.Summary,
+		Description: endpoint.Description,
+		// An explicit empty security requirement marks a public endpoint
+		Public:      endpoint.Security != nil && len(endpoint.Security) == 0,
+		Deprecated:  endpoint.Deprecated,
+		Deprecation: endpoint.Deprecation,
+	}
+
+	for _, param := range endpoint.Parameters {
+		if param.Ref != "" {
+			param = spec.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+		}
+		typ, ref := schemaType(param.Schema)
+		doc.Parameters = append(doc.Parameters, siteField{
+			Name:        param.Name,
+			In:          param.In,
+			Type:        typ,
+			Ref:         ref,
+			Description: param.Description,
+			Required:    param.Required || param.In == "path",
+		})
+	}
+
+	if endpoint.RequestBody != nil {
+		for _, contentType := range sortedKeys(endpoint.RequestBody.Content) {
+			schema := endpoint.RequestBody.Content[contentType].Schema
+			typ, ref := schemaType(schema)
+			doc.Body = &siteBody{ContentType: contentType, Type: typ, Ref: ref, Required: endpoint.RequestBody.Required}
+			if contentType == "application/json" {
+				doc.Body.Example = mediaExample(spec, endpoint.RequestBody.Content[contentType])
+				break
+			}
+		}
+	}
+
+	for _, code := range sortedKeys(endpoint.Responses) {
+		specResponse := endpoint.Responses[code]
+		if specResponse.Ref != "" {
+			specResponse = spec.Components.Responses[strings.TrimPrefix(specResponse.Ref, "#/components/responses/")]
+		}
+		response := siteResponse{Code: code, Description: specResponse.Description}
+		if media, ok := specResponse.Content["application/json"]; ok {
+			response.Type, response.Ref = schemaType(media.Schema)
+			response.Example = mediaExample(spec, media)
+		}
+		doc.Responses = append(doc.Responses, response)
+	}
+
+	return doc
+}
+
+func buildSiteSchema(name string, schema interface{}) siteSchema {
+	doc := siteSchema{Name: name}
+	fields, _ := schema.(map[string]interface{})
+	doc.Description, _ = fields["description"].(string)
+	for _, value := range asSlice(fields["enum"]) {
+		doc.Enum = append(doc.Enum, fmt.Sprint(value))
+	}
+
+	required := make(map[string]bool)
+	for _, value := range asSlice(fields["required"]) {
+		required[fmt.Sprint(value)] = true
+	}
+	properties, _ := fields["properties"].(map[string]interface{})
+	for _, property := range sortedKeys(properties) {
+		typ, ref := schemaType(properties[property])
+		description := ""
+		if propertyFields, ok := properties[property].(map[string]interface{}); ok {
+			description, _ = propertyFields["description"].(string)
+		}
+		doc.Properties = append(doc.Properties, siteField{
+			Name:        property,
+			Type:        typ,
+			Ref:         ref,
+			Description: description,
+			Required:    required[property],
+		})
+	}
+	return doc
+}
+
+// schemaType describes a schema in a few words and returns the component it refers to, if any
+func schemaType(schema interface{}) (string, string) {
+	fields, ok := schema.(map[string]interface{})
+	if !ok {
+		return "", ""
+	}
+	if ref, ok := fields["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		return name, name
+	}
+	for _, key := range []string{"allOf", "oneOf", "anyOf"} {
+		if variants := asSlice(fields[key]); len(variants) > 0 {
+			return schemaType(variants[0])
+		}
+	}
+
+	typ, _ := fields["type"].(string)
+	switch typ {
+	case "array":
+		itemType, ref := schemaType(fields["items"])
+		if itemType == "" {
+			return "array", ""
+		}
+		return "array of " + itemType, ref
+	case "":
+		return "object", ""
+	}
+	if format, ok := fields["format"].(string); ok {
+		return typ + " (" + format + ")", ""
+	}
+	return typ, ""
+}
+
+// mediaExample renders the example of a media type as indented JSON, built from its schema when the
+// specification gives none
+func mediaExample(spec *OpenAPISpec, media MediaTypeObject) string {
+	value := media.Example
+	if value == nil {
+		value = exampleValue(spec, media.Schema, 0)
+	}
+	if value == nil {
+		return ""
+	}
+	example, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(example)
+}
+
+// exampleValue builds an example value for a schema, preferring the examples of the specification
+func exampleValue(spec *OpenAPISpec, schema interface{}, depth int) interface{} {
+	fields, ok := schema.(map[string]interface{})
+	if !ok || depth > maxExampleDepth {
+		return nil
+	}
+	if example, ok := fields["example"]; ok {
+		return example
+	}
+	if ref, ok := fields["$ref"].(string); ok {
+		return exampleValue(spec, spec.Components.Schemas[strings.TrimPrefix(ref, "#/components/schemas/")], depth+1)
+	}
+	// allOf combines the properties of its variants; other variants are only the first one
+	if variants := asSlice(fields["allOf"]); len(variants) > 1 {
+		merged := make(map[string]interface{})
+		for _, variant := range variants {
+			object, ok := exampleValue(spec, variant, depth+1).(map[string]interface{})
+			if !ok {
+				return exampleValue(spec, variants[0], depth+1)
+			}
+			for name, value := range object {
+				merged[name] = value
+			}
+		}
+		return merged
+	}
+	for _, key := range []string{"allOf", "oneOf", "anyOf"} {
+		if variants := asSlice(fields[key]); len(variants) > 0 {
+			return exampleValue(spec, variants[0], depth+1)
+		}
+	}
+	if values := asSlice(fields["enum"]); len(values) > 0 {
+		return values[0]
+	}
+
+	switch fields["type"] {
+	case "array":
+		if item := exampleValue(spec, fields["items"], depth+1); item != nil {
+			return []interface{}{item}
+		}
+		return []interface{}{}
+	case "string":
+		switch fields["format"] {
+		case "uuid":
+			return "123e4567-e89b-12d3-a456-426614174000"
+		case "date-time":
+			return "2024-01-01T00:00:00Z"
+		}
+		return "string"
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	}
+
+	example := make(map[string]interface{})
+	properties, _ := fields["properties"].(map[string]interface{})
+	for name, property := range properties {
+		if value := exampleValue(spec, property, depth+1); value != nil {
+			example[name] = value
+		}
+	}
+	return example
+}
+
+func buildSearchIndex(site *siteData) []searchEntry {
+	var entries []searchEntry
+	for _, tag := range site.Tags {
+		entries = append(entries, searchEntry{Title: tag.Name, Kind: "tag", URL: "tags/" + tag.Slug + ".html"})
+		for _, endpoint := range tag.Endpoints {
+			entries = append(entries, searchEntry{
+				Title: strings.ToUpper(endpoint.Method) + " " + endpoint.Path,
+				Kind:  "endpoint",
+				URL:   "tags/" + tag.Slug + ".html#" + endpoint.Anchor,
+				Text:  endpoint.Summary + " " + endpoint.Description,
+			})
+		}
+	}
+	for _, schema := range site.Schemas {
+		var properties []string
+		for _, property := range schema.Properties {
+			properties = append(properties, property.Name)
+		}
+		entries = append(entries, searchEntry{
+			Title: schema.Name,
+			Kind:  "schema",
+			URL:   "schemas.html#" + schema.Name,
+			Text:  schema.Description + " " + strings.Join(properties, " "),
+		})
+	}
+	return entries
+}
+
+// writeSiteVersionIndex lists every version bundle under siteDir and forwards to the one just generated
+func writeSiteVersionIndex(siteDir string, site *siteData) error {
+	entries, err := os.ReadDir(siteDir)
+	if err != nil {
+		return fmt.Errorf("failed to list site versions: %w", err)
+	}
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(versions)))
+
+	tmpl, err := template.New("versions").Parse(siteVersionsTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse versions template: %w", err)
+	}
+	out, err := os.Create(filepath.Join(siteDir, "index.html"))
+	if err != nil {
+		return fmt.Errorf("failed to create versions index: %w", err)
+	}
+	defer out.Close()
+
+	data := map[string]interface{}{"Info": site.Info, "Latest": site.Version, "Versions": versions}
+	if err := tmpl.Execute(out, data); err != nil {
+		return fmt.Errorf("failed to render versions index: %w", err)
+	}
+	return nil
+}
+
+func slugify(value string) string {
+	return strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(value), "-"), "-")
+}
+
+func methodOrder(method string) int {
+	for i, m := range []string{"get", "post", "put", "patch", "delete"} {
+		if m == method {
+			return i
+		}
+	}
+	return len(method)
+}
+
+func asSlice(value interface{}) []interface{} {
+	values, _ := value.([]interface{})
+	return values
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+const siteLayoutTemplate = `{{define "layout"}}<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <meta name="api-base-url" content="{{.Site.BaseURL}}">
+    <title>{{.Title}} - {{.Site.Info.Title}} {{.Site.Version}}</title>
+    <link rel="stylesheet" href="{{.Root}}assets/site.css">
+</head>
+<body data-root="{{.Root}}">
+    <nav class="sidebar">
+        <a class="brand" href="{{.Root}}index.html">{{.Site.Info.Title}}</a>
+        <span class="version">v{{.Site.Version}}</span>
+        <input id="search" type="search" placeholder="Search endpoints and schemas" autocomplete="off">
+        <ul id="search-results" class="search-results"></ul>
+        <h3>Tags</h3>
+        <ul>
+            {{range .Site.Tags}}<li><a href="{{$.Root}}tags/{{.Slug}}.html">{{.Name}}</a> <span class="count">{{len .Endpoints}}</span></li>
+            {{end}}
+        </ul>
+        <h3><a href="{{.Root}}schemas.html">Schemas</a></h3>
+        <h3><a href="{{.Root}}../index.html">All versions</a></h3>
+    </nav>
+    <main class="content">
+        {{template "content" .}}
+    </main>
+    <script src="{{.Root}}assets/search-index.js"></script>
+    <script src="{{.Root}}assets/site.js"></script>
+</body>
+</html>
+{{end}}`
+
+const siteIndexTemplate = `{{define "content"}}
+<h1>{{.Site.Info.Title}}</h1>
+<p class="description">{{.Site.Info.Description}}</p>
+<p>Version <strong>{{.Site.Version}}</strong> &middot; {{.Site.Endpoints}} endpoints &middot; {{len .Site.Schemas}} schemas</p>
+<div class="console-settings">
+    <label>API base URL <input id="base-url" type="url" placeholder="{{.Site.BaseURL}}"></label>
+    <label>Bearer token <input id="token" type="password" autocomplete="off"></label>
+    <p class="hint">Used by the try-it console on every page. The token is kept for this browser session only; without one, the login of the Swagger UI is used.</p>
+</div>
+<h2>Tags</h2>
+<table class="table">
+    <tr><th>Tag</th><th>Endpoints</th></tr>
+    {{range .Site.Tags}}<tr><td><a href="tags/{{.Slug}}.html">{{.Name}}</a></td><td>{{len .Endpoints}}</td></tr>
+    {{end}}
+</table>
+{{end}}`
+
+const siteTagTemplate = `{{define "content"}}
+<h1>{{.Tag.Name}}</h1>
+{{range .Tag.Endpoints}}
+<section class="endpoint" id="{{.Anchor}}">
+    <h2><span class="method {{.Method}}">{{upper .Method}}</span> <code>{{.Path}}</code>{{if .Deprecated}} <span class="deprecated">Deprecated</span>{{end}}</h2>
+    {{if .Summary}}<p class="summary">{{.Summary}}</p>{{end}}
+    {{if .Deprecation}}<p class="deprecation">{{.Deprecation}}</p>{{end}}
+    {{if .Description}}<p class="description">{{.Description}}</p>{{end}}
+    <p class="auth">{{if .Public}}No authentication required.{{else}}Requires a bearer token.{{end}}</p>
+    {{if .Parameters}}
+    <h3>Parameters</h3>
+    <table class="table">
+        <tr><th>Name</th><th>In</th><th>Type</th><th>Description</th></tr>
+        {{range .Parameters}}<tr><td><code>{{.Name}}</code>{{if .Required}} <span class="required">*</span>{{end}}</td><td>{{.In}}</td><td>{{template "type" .}}</td><td>{{.Description}}</td></tr>
+        {{end}}
+    </table>
+    {{end}}
+    {{with .Body}}
+    <h3>Request body</h3>
+    <p><code>{{.ContentType}}</code> {{template "type" .}}{{if .Required}} <span class="required">*</span>{{end}}</p>
+    {{end}}
+    <h3>Responses</h3>
+    <table class="table">
+        <tr><th>Code</th><th>Description</th><th>Schema</th></tr>
+        {{range .Responses}}<tr><td>{{.Code}}</td><td>{{.Description}}</td><td>{{template "type" .}}</td></tr>
+        {{end}}
+    </table>
+    <details class="try-it">
+        <summary>Try it</summary>
+        <form data-method="{{.Method}}" data-path="{{.Path}}">
+            {{range .Parameters}}{{if or (eq .In "path") (eq .In "query")}}<label>{{.Name}} <small>{{.In}}</small> <input name="{{.Name}}" data-in="{{.In}}"{{if .Required}} required{{end}}></label>
+            {{end}}{{end}}
+            {{with .Body}}{{if eq .ContentType "application/json"}}<label>Body <textarea name="body" rows="8">{{.Example}}</textarea></label>{{end}}{{end}}
+            <button type="submit">Send request</button>
+        </form>
+        <pre class="response" hidden></pre>
+    </details>
+</section>
+{{end}}
+{{end}}
+{{define "type"}}{{if .Ref}}<a href="../schemas.html#{{.Ref}}">{{.Type}}</a>{{else}}{{.Type}}{{end}}{{end}}`
+
+const siteSchemasTemplate = `{{define "content"}}
+<h1>Schemas</h1>
+<input id="schema-filter" type="search" placeholder="Filter schemas" autocomplete="off">
+{{range .Site.Schemas}}
+<section class="schema" id="{{.Name}}">
+    <h2>{{.Name}}</h2>
+    {{if .Description}}<p class="description">{{.Description}}</p>{{end}}
+    {{if .Enum}}<p>One of: {{range $i, $value := .Enum}}{{if $i}}, {{end}}<code>{{$value}}</code>{{end}}</p>{{end}}
+    {{if .Properties}}
+    <table class="table">
+        <tr><th>Property</th><th>Type</th><th>Description</th></tr>
+        {{range .Properties}}<tr><td><code>{{.Name}}</code>{{if .Required}} <span class="required">*</span>{{end}}</td><td>{{if .Ref}}<a href="#{{.Ref}}">{{.Type}}</a>{{else}}{{.Type}}{{end}}</td><td>{{.Description}}</td></tr>
+        {{end}}
+    </table>
+    {{end}}
+</section>
+{{end}}
+{{end}}`
+
+const siteVersionsTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{.Info.Title}} - API Documentation</title>
+    <link rel="stylesheet" href="{{.Latest}}/assets/site.css">
+</head>
+<body>
+    <main class="content">
+        <h1>{{.Info.Title}}</h1>
+        <p><a href="{{.Latest}}/index.html">Open the documentation for version {{.Latest}}</a></p>
+        <h2>All versions</h2>
+        <ul>
+            {{range .Versions}}<li><a href="{{.}}/index.html">{{.}}</a></li>
+            {{end}}
+        </ul>
+    </main>
+</body>
+</html>
+`
+
+const siteCSS = `body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; margin: 0; display: flex; background: #f8f9fa; color: #2c3e50; }
+a { color: #007bff; text-decoration: none; }
+a:hover { text-decoration: underline; }
+code, pre { font-family: 'Monaco', 'Menlo', monospace; }
+.sidebar { width: 280px; min-height: 100vh; padding: 20px; background: white; border-right: 1px solid #dee2e6; box-sizing: border-box; position: sticky; top: 0; align-self: flex-start; max-height: 100vh; overflow-y: auto; }
+.sidebar ul { list-style: none; padding-left: 0; }
+.sidebar li { margin: 4px 0; }
+.brand { font-weight: bold; font-size: 1.1em; display: block; }
+.version { background: #007bff; color: white; padding: 2px 10px; border-radius: 20px; font-size: 0.8em; display: inline-block; margin: 8px 0; }
+.count { color: #6c757d; font-size: 0.85em; }
+#search, #schema-filter { width: 100%; padding: 6px 8px; box-sizing: border-box; }
+.search-results li { font-size: 0.9em; }
+.search-results small { color: #6c757d; }
+.content { flex: 1; padding: 30px; max-width: 1100px; }
+.description, .hint { color: #6c757d; line-height: 1.6; }
+.table { width: 100%; border-collapse: collapse; margin: 10px 0; background: white; }
+.table th, .table td { padding: 8px 12px; text-align: left; border-bottom: 1px solid #dee2e6; vertical-align: top; }
+.table th { background: #f1f3f5; }
+.endpoint, .schema { background: white; border: 1px solid #dee2e6; border-radius: 6px; padding: 10px 20px; margin: 20px 0; }
+.method { display: inline-block; padding: 2px 10px; border-radius: 4px; font-size: 0.8em; }
+.method.get { background: #d4edda; color: #155724; }
+.method.post { background: #d1ecf1; color: #0c5460; }
+.method.put { background: #fff3cd; color: #856404; }
+.method.delete { background: #f8d7da; color: #721c24; }
+.method.patch { background: #e2e3e5; color: #383d41; }
+.deprecated { display: inline-block; padding: 2px 8px; border-radius: 4px; background: #6c757d; color: #fff; font-size: 0.6em; text-transform: uppercase; vertical-align: middle; }
+.deprecation { padding: 8px 12px; border-left: 4px solid #6c757d; background: #f1f3f5; }
+.required { color: #dc3545; font-weight: bold; }
+.console-settings label, .try-it label { display: block; margin: 8px 0; }
+.console-settings input, .try-it input, .try-it textarea { width: 100%; padding: 6px 8px; box-sizing: border-box; }
+.try-it { margin: 15px 0; }
+.response { background: #212529; color: #f8f9fa; padding: 12px; border-radius: 4px; overflow-x: auto; white-space: pre-wrap; }
+`
+
+const siteJS = `(function () {
+  'use strict';
+
+  var root = document.body.getAttribute('data-root') || '';
+  var defaultBaseURL = document.querySelector('meta[name="api-base-url"]').getAttribute('content');
+
+  function baseURL() {
+    return (localStorage.getItem('apiDocsBaseURL') || defaultBaseURL).replace(/\/$/, '');
+  }
+
+  // swaggerToken reuses a login made in the Swagger UI served by the same API
+  function swaggerToken() {
+    try {
+      var authorized = JSON.parse(localStorage.getItem('authorized') || '{}');
+      return authorized.BearerAuth ? authorized.BearerAuth.value.replace(/^Bearer /, '') : '';
+    } catch (e) {
+      return '';
+    }
+  }
+
+  // Console settings are shared by all pages of the site
+  var baseInput = document.getElementById('base-url');
+  if (baseInput) {
+    baseInput.value = localStorage.getItem('apiDocsBaseURL') || '';
+    baseInput.addEventListener('change', function () {
+      if (baseInput.value) {
+        localStorage.setItem('apiDocsBaseURL', baseInput.value);
+      } else {
+        localStorage.removeItem('apiDocsBaseURL');
+      }
+    });
+  }
+  var tokenInput = document.getElementById('token');
+  if (tokenInput) {
+    tokenInput.value = sessionStorage.getItem('apiDocsToken') || '';
+    tokenInput.addEventListener('change', function () {
+      sessionStorage.setItem('apiDocsToken', tokenInput.value);
+    });
+  }
+
+  // Client-side search over the generated index
+  var search = document.getElementById('search');
+  var results = document.getElementById('search-results');
+  var index = window.API_DOCS_SEARCH_INDEX || [];
+  search.addEventListener('input', function () {
+    var terms = search.value.toLowerCase().split(/\s+/).filter(Boolean);
+    results.innerHTML = '';
+    if (terms.length === 0) {
+      return;
+    }
+    index.filter(function (entry) {
+      var haystack = (entry.title + ' ' + entry.text).toLowerCase();
+      return terms.every(function (term) { return haystack.indexOf(term) !== -1; });
+    }).slice(0, 20).forEach(function (entry) {
+      var item = document.createElement('li');
+      var link = document.createElement('a');
+      link.href = root + entry.url;
+      link.textContent = entry.title;
+      var kind = document.createElement('small');
+      kind.textContent = ' ' + entry.kind;
+      item.appendChild(link);
+      item.appendChild(kind);
+      results.appendChild(item);
+    });
+  });
+
+  var schemaFilter = document.getElementById('schema-filter');
+  if (schemaFilter) {
+    schemaFilter.addEventListener('input', function () {
+      var term = schemaFilter.value.toLowerCase();
+      document.querySelectorAll('.schema').forEach(function (section) {
+        section.hidden = term !== '' && section.id.toLowerCase().indexOf(term) === -1;
+      });
+    });
+  }
+
+  // Try-it console
+  document.querySelectorAll('.try-it form').forEach(function (form) {
+    form.addEventListener('submit', function (event) {
+      event.preventDefault();
+      var output = form.parentNode.querySelector('.response');
+      var path = form.getAttribute('data-path');
+      var query = new URLSearchParams();
+      form.querySelectorAll('input[data-in]').forEach(function (input) {
+        if (input.getAttribute('data-in') === 'path') {
+          path = path.replace('{' + input.name + '}', encodeURIComponent(input.value));
+        } else if (input.value !== '') {
+          query.append(input.name, input.value);
+        }
+      });
+
+      var options = { method: form.getAttribute('data-method').toUpperCase(), headers: {} };
+      var token = sessionStorage.getItem('apiDocsToken') || swaggerToken();
+      if (token) {
+        options.headers['Authorization'] = 'Bearer ' + token;
+      }
+      var body = form.querySelector('textarea[name="body"]');
+      if (body && body.value.trim() !== '') {
+        options.headers['Content-Type'] = 'application/json';
+        options.body = body.value;
+      }
+
+      var url = baseURL() + path + (query.toString() ? '?' + query.toString() : '');
+      output.hidden = false;
+      output.textContent = options.method + ' ' + url + '\n\n...';
+      fetch(url, options).then(function (response) {
+        return response.text().then(function (text) {
+          try {
+            text = JSON.stringify(JSON.parse(text), null, 2);
+          } catch (e) {
+            // Not JSON; show the body as is
+          }
+          output.textContent = options.method + ' ' + url + '\n' + response.status + ' ' + response.statusText + '\n\n' + text;
+        });
+      }).catch(function (error) {
+        output.textContent = options.method + ' ' + url + '\n\n' + error;
+      });
+    });
+  });
+})();
+`
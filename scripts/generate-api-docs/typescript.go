@@ -0,0 +1,587 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// tsIndent indents the generated TypeScript
+const tsIndent = "  "
+
+// tsIdentifierPattern matches property names that need no quotes
+var tsIdentifierPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// tsWordPattern matches the words of a name such as user-stories, epic_id or service.SearchResponse
+var tsWordPattern = regexp.MustCompile(`[A-Za-z0-9]+`)
+
+// tsVersionSegment matches the path segments naming the API version, left out of derived method names
+var tsVersionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// tsReservedWords cannot name a method parameter
+var tsReservedWords = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true, "continue": true, "debugger": true,
+	"default": true, "delete": true, "do": true, "else": true, "enum": true, "export": true, "extends": true,
+	"false": true, "finally": true, "for": true, "function": true, "if": true, "import": true, "in": true,
+	"instanceof": true, "new": true, "null": true, "return": true, "super": true, "switch": true, "this": true,
+	"throw": true, "true": true, "try": true, "typeof": true, "var": true, "void": true, "while": true, "with": true,
+}
+
+// tsClientRuntime is the transport shared by the generated API classes
+const tsClientRuntime = `// Client
+
+export interface ApiConfig {
+  /** Base URL of the API, such as http://localhost:8080 */
+  baseUrl: string;
+  /** Bearer token, a JWT or a personal access token, sent with every request */
+  token?: string;
+  /** Headers sent with every request */
+  headers?: Record<string, string>;
+  /** fetch implementation; defaults to the global fetch */
+  fetch?: typeof fetch;
+}
+
+/** Error thrown for responses with a status outside 200-299 */
+export class ApiError extends Error {
+  constructor(readonly status: number, readonly body: unknown) {
+    super('Request failed with status ' + status);
+    this.name = 'ApiError';
+  }
+}
+
+export interface RequestOptions {
+  query?: object;
+  body?: unknown;
+}
+
+/** Sends requests to the API and decodes JSON responses */
+export class HttpClient {
+  constructor(private readonly config: ApiConfig) {}
+
+  async request<T>(method: string, path: string, options: RequestOptions = {}): Promise<T> {
+    const url = new URL(path, this.config.baseUrl);
+    for (const [name, value] of Object.entries(options.query ?? {})) {
+      if (value === undefined || value === null) {
+        continue;
+      }
+      for (const item of Array.isArray(value) ? value : [value]) {
+        url.searchParams.append(name, String(item));
+      }
+    }
+
+    const headers: Record<string, string> = { Accept: 'application/json', ...this.config.headers };
+    if (this.config.token) {
+      headers.Authorization = 'Bearer ' + this.config.token;
+    }
+    let body: BodyInit | undefined;
+    if (options.body instanceof FormData) {
+      body = options.body;
+    } else if (options.body !== undefined) {
+      headers['Content-Type'] = 'application/json';
+      body = JSON.stringify(options.body);
+    }
+
+    const response = await (this.config.fetch ?? fetch)(url.toString(), { method, headers, body });
+    const text = await response.text();
+    const contentType = response.headers.get('Content-Type') ?? '';
+    const data = text && contentType.includes('json') ? JSON.parse(text) : text;
+    if (!response.ok) {
+      throw new ApiError(response.status, data);
+    }
+    return data as T;
+  }
+}
+`
+
+// tsOperation is an operation of the specification with its path-level and operation parameters resolved
+type tsOperation struct {
+	Method     string
+	Path       string
+	Operation  *Operation
+	Parameters []Parameter
+}
+
+// tsArgument is a parameter of a generated client method
+type tsArgument struct {
+	Name     string
+	Type     string
+	Optional bool
+}
+
+// generateTypeScriptDocs writes api-types.ts with the types of components.schemas and an API client
+// with a class per tag whose methods call the operations in paths
+func generateTypeScriptDocs(spec *OpenAPISpec, outputDir string, verbose bool) error {
+	if verbose {
+		log.Printf("Generating TypeScript documentation...")
+	}
+
+	outputFile := filepath.Join(outputDir, "api-types.ts")
+	if err := os.WriteFile(outputFile, []byte(renderTypeScript(spec)), 0644); err != nil {
+		return fmt.Errorf("failed to write TypeScript file: %w", err)
+	}
+
+	if verbose {
+		log.Printf("TypeScript documentation generated: %s", outputFile)
+	}
+
+	return nil
+}
+
+// renderTypeScript renders the TypeScript types and API client of a specification
+func renderTypeScript(spec *OpenAPISpec) string {
+	var b strings.Builder
+	b.WriteString("// Code generated from the OpenAPI specification by scripts/generate-api-docs. DO NOT EDIT.\n")
+	fmt.Fprintf(&b, "// %s %s\n\n", spec.Info.Title, spec.Info.Version)
+
+	b.WriteString("// Schemas\n\n")
+	for _, name := range sortedKeys(spec.Components.Schemas) {
+		writeTSSchema(&b, name, spec.Components.Schemas[name])
+	}
+
+	b.WriteString(tsClientRuntime)
+
+	groups := tsOperationsByTag(spec)
+	tags := sortedKeys(groups)
+	for _, tag := range tags {
+		b.WriteString("\n")
+		writeTSClientClass(&b, spec, tag, groups[tag])
+	}
+
+	b.WriteString("\n/** Client for every API operation, grouped by tag */\n")
+	b.WriteString("export class ApiClient {\n")
+	for _, tag := range tags {
+		fmt.Fprintf(&b, "%sreadonly %s: %sApi;\n", tsIndent, tsCamelCase(tag), tsPascalCase(tag))
+	}
+	b.WriteString("\n" + tsIndent + "constructor(config: ApiConfig) {\n")
+	b.WriteString(tsIndent + tsIndent + "const http = new HttpClient(config);\n")
+	for _, tag := range tags {
+		fmt.Fprintf(&b, "%sthis.%s = new %sApi(http);\n", tsIndent+tsIndent, tsCamelCase(tag), tsPascalCase(tag))
+	}
+	b.WriteString(tsIndent + "}\n}\n")
+
+	return b.String()
+}
+
+// writeTSSchema writes a component schema as an interface when it is a plain object and as a type otherwise
+func writeTSSchema(b *strings.Builder, name string, schema interface{}) {
+	fields, _ := schema.(map[string]interface{})
+	description, _ := fields["description"].(string)
+	writeTSDoc(b, "", description)
+
+	if isTSInterface(fields) {
+		fmt.Fprintf(b, "export interface %s %s\n\n", tsPascalCase(name), tsObject(fields, ""))
+		return
+	}
+	fmt.Fprintf(b, "export type %s = %s;\n\n", tsPascalCase(name), tsType(schema, ""))
+}
+
+// isTSInterface reports whether a schema is an object with properties and nothing that needs a type alias
+func isTSInterface(fields map[string]interface{}) bool {
+	properties, _ := fields["properties"].(map[string]interface{})
+	if len(properties) == 0 || fields["$ref"] != nil {
+		return false
+	}
+	for _, key := range []string{"allOf", "oneOf", "anyOf", "enum"} {
+		if fields[key] != nil {
+			return false
+		}
+	}
+	nullable, _ := fields["nullable"].(bool)
+	return !nullable
+}
+
+// tsType converts a schema to a TypeScript type; inline objects are indented after indent
+func tsType(schema interface{}, indent string) string {
+	fields, ok := schema.(map[string]interface{})
+	if !ok {
+		return "unknown"
+	}
+	typ := tsBaseType(fields, indent)
+	if nullable, _ := fields["nullable"].(bool); nullable && typ != "unknown" {
+		return tsGroup(typ) + " | null"
+	}
+	return typ
+}
+
+// tsBaseType converts a schema to a TypeScript type, ignoring whether it is nullable
+func tsBaseType(fields map[string]interface{}, indent string) string {
+	if ref, ok := fields["$ref"].(string); ok {
+		return tsPascalCase(ref[strings.LastIndex(ref, "/")+1:])
+	}
+	if variants := asSlice(fields["allOf"]); len(variants) > 0 {
+		return tsCombine(variants, " & ", indent)
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if variants := asSlice(fields[key]); len(variants) > 0 {
+			return tsCombine(variants, " | ", indent)
+		}
+	}
+	if values := asSlice(fields["enum"]); len(values) > 0 {
+		literals := make([]string, len(values))
+		for i, value := range values {
+			literals[i] = tsLiteral(value)
+		}
+		return strings.Join(literals, " | ")
+	}
+
+	switch typ, _ := fields["type"].(string); typ {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		if fields["items"] == nil {
+			return "unknown[]"
+		}
+		return tsGroup(tsType(fields["items"], indent)) + "[]"
+	case "object", "":
+		if properties, _ := fields["properties"].(map[string]interface{}); len(properties) > 0 {
+			return tsObject(fields, indent)
+		}
+		if additional, ok := fields["additionalProperties"].(map[string]interface{}); ok && len(additional) > 0 {
+			return "Record<string, " + tsType(additional, indent) + ">"
+		}
+		if typ == "object" || fields["additionalProperties"] != nil {
+			return "Record<string, unknown>"
+		}
+	}
+	return "unknown"
+}
+
+// tsCombine joins the types of schema variants with an intersection or union operator
+func tsCombine(variants []interface{}, operator, indent string) string {
+	types := make([]string, len(variants))
+	for i, variant := range variants {
+		types[i] = tsGroup(tsType(variant, indent))
+	}
+	return strings.Join(types, operator)
+}
+
+// tsGroup parenthesizes unions and intersections so that they can be combined with other operators
+func tsGroup(typ string) string {
+	if strings.HasPrefix(typ, "{") && strings.HasSuffix(typ, "}") {
+		return typ
+	}
+	if strings.Contains(typ, " | ") || strings.Contains(typ, " & ") {
+		return "(" + typ + ")"
+	}
+	return typ
+}
+
+// tsObject renders the properties of an object schema as a type literal in name order
+func tsObject(fields map[string]interface{}, indent string) string {
+	properties, _ := fields["properties"].(map[string]interface{})
+	required := make(map[string]bool)
+	for _, name := range asSlice(fields["required"]) {
+		required[fmt.Sprint(name)] = true
+	}
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	inner := indent + tsIndent
+	for _, name := range sortedKeys(properties) {
+		property, _ := properties[name].(map[string]interface{})
+		description, _ := property["description"].(string)
+		writeTSDoc(&b, inner, description)
+		optional := "?"
+		if required[name] {
+			optional = ""
+		}
+		fmt.Fprintf(&b, "%s%s%s: %s;\n", inner, tsPropertyName(name), optional, tsType(properties[name], inner))
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// tsLiteral renders an enum value as a TypeScript literal type
+func tsLiteral(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+	}
+	return fmt.Sprint(value)
+}
+
+// writeTSDoc writes a JSDoc comment unless the text is empty
+func writeTSDoc(b *strings.Builder, indent, text string) {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "*/", "*\\/"))
+	if text == "" {
+		return
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		fmt.Fprintf(b, "%s/** %s */\n", indent, text)
+		return
+	}
+	b.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			b.WriteString(indent + " *\n")
+			continue
+		}
+		fmt.Fprintf(b, "%s * %s\n", indent, line)
+	}
+	b.WriteString(indent + " */\n")
+}
+
+// tsOperationsByTag collects the operations of the specification by their first tag, in path and method order
+func tsOperationsByTag(spec *OpenAPISpec) map[string][]tsOperation {
+	groups := make(map[string][]tsOperation)
+	for _, path := range sortedKeys(spec.Paths) {
+		item := spec.Paths[path]
+		for _, method := range []string{"get", "post", "put", "patch", "delete"} {
+			operation := item.operation(method)
+			if operation == nil {
+				continue
+			}
+			tag := "Default"
+			if len(operation.Tags) > 0 {
+				tag = operation.Tags[0]
+			}
+			groups[tag] = append(groups[tag], tsOperation{
+				Method:     method,
+				Path:       path,
+				Operation:  operation,
+				Parameters: resolveParameters(spec, item.Parameters, operation.Parameters),
+			})
+		}
+	}
+	return groups
+}
+
+// resolveParameters resolves parameter references and merges path-level parameters with those of an
+// operation, which override path-level parameters of the same name and location
+func resolveParameters(spec *OpenAPISpec, pathParameters, operationParameters []Parameter) []Parameter {
+	var parameters []Parameter
+	index := make(map[string]int)
+	for _, parameter := range append(append([]Parameter{}, pathParameters...), operationParameters...) {
+		if parameter.Ref != "" {
+			resolved, ok := spec.Components.Parameters[parameter.Ref[strings.LastIndex(parameter.Ref, "/")+1:]]
+			if !ok {
+				continue
+			}
+			parameter = resolved
+		}
+		key := parameter.In + ":" + parameter.Name
+		if i, ok := index[key]; ok {
+			parameters[i] = parameter
+			continue
+		}
+		index[key] = len(parameters)
+		parameters = append(parameters, parameter)
+	}
+	return parameters
+}
+
+// writeTSClientClass writes the API class of a tag with a method per operation
+func writeTSClientClass(b *strings.Builder, spec *OpenAPISpec, tag string, operations []tsOperation) {
+	fmt.Fprintf(b, "/** Operations tagged %s */\n", tag)
+	fmt.Fprintf(b, "export class %sApi {\n", tsPascalCase(tag))
+	b.WriteString(tsIndent + "constructor(private readonly http: HttpClient) {}\n")
+
+	used := make(map[string]int)
+	for _, operation := range operations {
+		name := tsMethodName(operation)
+		used[name]++
+		if used[name] > 1 {
+			name += fmt.Sprint(used[name])
+		}
+		b.WriteString("\n")
+		writeTSMethod(b, spec, name, operation)
+	}
+	b.WriteString("}\n")
+}
+
+// writeTSMethod writes the client method calling an operation
+func writeTSMethod(b *strings.Builder, spec *OpenAPISpec, name string, operation tsOperation) {
+	indent := tsIndent + tsIndent
+	var arguments []tsArgument
+	var options []string
+
+	// Path parameters in the order they appear in the path
+	path := "'" + operation.Path + "'"
+	for _, segment := range strings.Split(operation.Path, "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+		parameterName := strings.Trim(segment, "{}")
+		typ := "string"
+		for _, parameter := range operation.Parameters {
+			if parameter.In == "path" && parameter.Name == parameterName && parameter.Schema != nil {
+				typ = tsType(parameter.Schema, tsIndent)
+			}
+		}
+		argument := tsArgumentName(parameterName)
+		arguments = append(arguments, tsArgument{Name: argument, Type: typ})
+		path = strings.Replace(path, segment, "' + encodeURIComponent(String("+argument+")) + '", 1)
+	}
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "'' + "), " + ''")
+
+	if body := operation.Operation.RequestBody; body != nil {
+		typ := "unknown"
+		if media, ok := body.Content["application/json"]; ok {
+			typ = tsType(media.Schema, tsIndent)
+		} else if _, ok := body.Content["multipart/form-data"]; ok {
+			typ = "FormData"
+		}
+		arguments = append(arguments, tsArgument{Name: "body", Type: typ, Optional: !body.Required})
+		options = append(options, "body")
+	}
+
+	var query []Parameter
+	queryRequired := false
+	for _, parameter := range operation.Parameters {
+		if parameter.In == "query" {
+			query = append(query, parameter)
+			queryRequired = queryRequired || parameter.Required
+		}
+	}
+	if len(query) > 0 {
+		arguments = append(arguments, tsArgument{Name: "query", Type: tsQueryType(query), Optional: !queryRequired})
+		options = append(options, "query")
+	}
+
+	// Optional arguments followed by required ones take undefined instead
+	required := false
+	for i := len(arguments) - 1; i >= 0; i-- {
+		if !arguments[i].Optional {
+			required = true
+		} else if required {
+			arguments[i].Optional = false
+			arguments[i].Type += " | undefined"
+		}
+	}
+
+	signature := make([]string, len(arguments))
+	for i, argument := range arguments {
+		optional := ""
+		if argument.Optional {
+			optional = "?"
+		}
+		signature[i] = argument.Name + optional + ": " + argument.Type
+	}
+
+	returnType := tsResponseType(spec, operation.Operation)
+	summary := operation.Operation.Summary
+	if summary != "" {
+		summary += " "
+	}
+	doc := summary + "(" + strings.ToUpper(operation.Method) + " " + operation.Path + ")"
+	if deprecated, note := endpointDeprecation(operation.Method, operation.Path, operation.Operation); deprecated {
+		doc += "\n@deprecated " + note
+	}
+	writeTSDoc(b, tsIndent, doc)
+	fmt.Fprintf(b, "%s%s(%s): Promise<%s> {\n", tsIndent, name, strings.Join(signature, ", "), returnType)
+	call := fmt.Sprintf("this.http.request<%s>('%s', %s", returnType, strings.ToUpper(operation.Method), path)
+	if len(options) > 0 {
+		call += ", { " + strings.Join(options, ", ") + " }"
+	}
+	fmt.Fprintf(b, "%sreturn %s);\n", indent, call)
+	b.WriteString(tsIndent + "}\n")
+}
+
+// tsQueryType renders the query parameters of an operation as a type literal
+func tsQueryType(parameters []Parameter) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	inner := tsIndent + tsIndent
+	for _, parameter := range parameters {
+		writeTSDoc(&b, inner, parameter.Description)
+		optional := "?"
+		if parameter.Required {
+			optional = ""
+		}
+		typ := "string"
+		if parameter.Schema != nil {
+			typ = tsType(parameter.Schema, inner)
+		}
+		fmt.Fprintf(&b, "%s%s%s: %s;\n", inner, tsPropertyName(parameter.Name), optional, typ)
+	}
+	b.WriteString(tsIndent + "}")
+	return b.String()
+}
+
+// tsResponseType returns the type of the JSON body of the first successful response of an operation,
+// or void when it has none
+func tsResponseType(spec *OpenAPISpec, operation *Operation) string {
+	for _, code := range sortedKeys(operation.Responses) {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		response := operation.Responses[code]
+		if response.Ref != "" {
+			response = spec.Components.Responses[response.Ref[strings.LastIndex(response.Ref, "/")+1:]]
+		}
+		if media, ok := response.Content["application/json"]; ok && media.Schema != nil {
+			return tsType(media.Schema, tsIndent)
+		}
+		if len(response.Content) > 0 {
+			return "string"
+		}
+		return "void"
+	}
+	return "void"
+}
+
+// tsMethodName names the client method of an operation after its operationId or, without one, after its
+// method and path, such as getEpicsById for GET /api/v1/epics/{id}
+func tsMethodName(operation tsOperation) string {
+	if operation.Operation.OperationID != "" {
+		return tsArgumentName(operation.Operation.OperationID)
+	}
+	name := operation.Method
+	for i, segment := range strings.Split(strings.Trim(operation.Path, "/"), "/") {
+		switch {
+		case i == 0 && segment == "api", tsVersionSegment.MatchString(segment):
+			continue
+		case strings.HasPrefix(segment, "{"):
+			name += "By" + tsPascalCase(segment)
+		default:
+			name += tsPascalCase(segment)
+		}
+	}
+	return name
+}
+
+// tsArgumentName converts a name such as epic_id to a camel case identifier that can name a parameter
+func tsArgumentName(name string) string {
+	identifier := tsCamelCase(name)
+	if identifier == "" || tsReservedWords[identifier] || (identifier[0] >= '0' && identifier[0] <= '9') {
+		return "_" + identifier
+	}
+	return identifier
+}
+
+// tsPropertyName quotes property names that are not identifiers
+func tsPropertyName(name string) string {
+	if tsIdentifierPattern.MatchString(name) {
+		return name
+	}
+	return "'" + strings.ReplaceAll(name, "'", `\'`) + "'"
+}
+
+// tsPascalCase converts a name such as user-stories or service.SearchResponse to UserStories or ServiceSearchResponse
+func tsPascalCase(name string) string {
+	var b strings.Builder
+	for _, word := range tsWordPattern.FindAllString(name, -1) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// tsCamelCase converts a name such as user-stories to userStories
+func tsCamelCase(name string) string {
+	pascal := tsPascalCase(name)
+	if pascal == "" {
+		return ""
+	}
+	return strings.ToLower(pascal[:1]) + pascal[1:]
+}
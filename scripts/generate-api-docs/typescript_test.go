@@ -0,0 +1,88 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "Rewrite the golden files with the generator output")
+
+func TestRenderTypeScript_Golden(t *testing.T) {
+	spec, err := loadOpenAPISpec(filepath.Join("testdata", "openapi.yaml"))
+	require.NoError(t, err)
+
+	got := renderTypeScript(spec)
+
+	golden := filepath.Join("testdata", "typescript", "api-types.ts")
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(golden, []byte(got), 0644))
+	}
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), got, "generator output differs from %s; run go test ./scripts/generate-api-docs -update to accept it", golden)
+}
+
+func TestGenerateTypeScriptDocs(t *testing.T) {
+	spec, err := loadOpenAPISpec(filepath.Join("testdata", "openapi.yaml"))
+	require.NoError(t, err)
+	outputDir := t.TempDir()
+
+	require.NoError(t, generateTypeScriptDocs(spec, outputDir, false))
+
+	written, err := os.ReadFile(filepath.Join(outputDir, "api-types.ts"))
+	require.NoError(t, err)
+	assert.Equal(t, renderTypeScript(spec), string(written))
+}
+
+func TestTSType(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema interface{}
+		want   string
+	}{
+		{"reference", map[string]interface{}{"$ref": "#/components/schemas/service.SearchResponse"}, "ServiceSearchResponse"},
+		{"integer", map[string]interface{}{"type": "integer"}, "number"},
+		{"nullable string", map[string]interface{}{"type": "string", "nullable": true}, "string | null"},
+		{"string enum", map[string]interface{}{"type": "string", "enum": []interface{}{"a", "b"}}, "'a' | 'b'"},
+		{"array of union", map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string", "nullable": true}}, "(string | null)[]"},
+		{"array without items", map[string]interface{}{"type": "array"}, "unknown[]"},
+		{"map", map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": "boolean"}}, "Record<string, boolean>"},
+		{"free-form object", map[string]interface{}{"type": "object"}, "Record<string, unknown>"},
+		{"intersection of union", map[string]interface{}{"allOf": []interface{}{
+			map[string]interface{}{"$ref": "#/components/schemas/A"},
+			map[string]interface{}{"oneOf": []interface{}{map[string]interface{}{"$ref": "#/components/schemas/B"}, map[string]interface{}{"type": "string"}}},
+		}}, "A & (B | string)"},
+		{"empty schema", map[string]interface{}{}, "unknown"},
+		{"not a schema", "string", "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tsType(tt.schema, ""))
+		})
+	}
+}
+
+func TestTSMethodName(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation tsOperation
+		want      string
+	}{
+		{"operation ID", tsOperation{Method: "post", Path: "/api/v1/epics", Operation: &Operation{OperationID: "create-epic"}}, "createEpic"},
+		{"collection", tsOperation{Method: "get", Path: "/api/v1/user-stories", Operation: &Operation{}}, "getUserStories"},
+		{"path parameter", tsOperation{Method: "delete", Path: "/api/v1/epics/{id}/comments/{comment_id}", Operation: &Operation{}}, "deleteEpicsByIdCommentsByCommentId"},
+		{"unversioned path", tsOperation{Method: "get", Path: "/auth/profile", Operation: &Operation{}}, "getAuthProfile"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tsMethodName(tt.operation))
+		})
+	}
+}
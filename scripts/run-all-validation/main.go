@@ -1,13 +1,18 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"log"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
 	"time"
+
+	"product-requirements-management/internal/docs"
 )
 
 // TestResult represents the result of a validation test
@@ -21,365 +26,321 @@ type TestResult struct {
 
 // TestSuite represents a collection of related tests
 type TestSuite struct {
+	Key      string
+	Name     string
+	Tests    []TestResult
+	Passed   int
+	Total    int
+	Duration time.Duration // Sum of the durations of the tests
+}
+
+// suiteDefinition lists the checks of a validation suite
+type suiteDefinition struct {
+	Key    string // Name used by -suite
 	Name   string
-	Tests  []TestResult
-	Passed int
-	Total  int
+	Checks []validationCheck
 }
 
-func main() {
-	fmt.Println("=== Comprehensive API Documentation Validation ===\n")
-
-	// Define all validation test suites
-	suites := []TestSuite{
-		{
-			Name: "Route Implementation vs Documentation",
-			Tests: []TestResult{
-				runTest("TestOpenAPIRouteCompleteness", "./internal/validation"),
-			},
-		},
-		{
-			Name: "Response Schema Validation",
-			Tests: []TestResult{
-				runTest("TestResponseSchemaValidation", "./internal/validation"),
-			},
-		},
-		{
-			Name: "Authentication Documentation",
-			Tests: []TestResult{
-				runTest("TestAuthenticationDocumentation", "./internal/validation"),
-			},
-		},
-		{
-			Name: "Documentation Completeness",
-			Tests: []TestResult{
-				runTest("TestDocumentationCompleteness", "./internal/validation"),
-			},
-		},
-		{
-			Name: "Existing OpenAPI Validation",
-			Tests: []TestResult{
-				runTest("TestOpenAPISchemaCompliance", "./internal/docs"),
-				runTest("TestSwaggerSpecificationCompleteness", "./internal/docs"),
-			},
+// validationCheck is a go test run or a validation script
+type validationCheck struct {
+	Test    string // Test name pattern passed to go test -run
+	Package string // Package of the test
+	Script  string // Directory of a validation script run with go run instead of a test
+}
+
+// thresholds are the minimum docs-metrics percentages; with any of them set, they alone decide the exit status
+type thresholds struct {
+	Quality            float64
+	AnnotationCoverage float64
+	EndpointCoverage   float64
+	ExampleCoverage    float64
+}
+
+// thresholdResult compares a docs-metrics percentage with its minimum
+type thresholdResult struct {
+	Metric  string  `json:"metric"`
+	Actual  float64 `json:"actual"`
+	Minimum float64 `json:"minimum"`
+	Passed  bool    `json:"passed"`
+}
+
+// runSummary aggregates the results of a validation run
+type runSummary struct {
+	Suites     []TestSuite
+	Total      int
+	Passed     int
+	Duration   time.Duration // Wall-clock time of the run
+	TestTime   time.Duration // Sum of the durations of all tests
+	Parallel   int
+	Thresholds []thresholdResult
+}
+
+// suiteDefinitions are all validation suites in the order they are reported
+var suiteDefinitions = []suiteDefinition{
+	{
+		Key:    "routes",
+		Name:   "Route Implementation vs Documentation",
+		Checks: []validationCheck{{Test: "TestOpenAPIRouteCompleteness", Package: "./internal/validation"}},
+	},
+	{
+		Key:    "schemas",
+		Name:   "Response Schema Validation",
+		Checks: []validationCheck{{Test: "TestResponseSchemaValidation", Package: "./internal/validation"}},
+	},
+	{
+		Key:    "auth",
+		Name:   "Authentication Documentation",
+		Checks: []validationCheck{{Test: "TestAuthenticationDocumentation", Package: "./internal/validation"}},
+	},
+	{
+		Key:    "completeness",
+		Name:   "Documentation Completeness",
+		Checks: []validationCheck{{Test: "TestDocumentationCompleteness", Package: "./internal/validation"}},
+	},
+	{
+		Key:  "openapi",
+		Name: "Existing OpenAPI Validation",
+		Checks: []validationCheck{
+			{Test: "TestOpenAPISchemaCompliance", Package: "./internal/docs"},
+			{Test: "TestSwaggerSpecificationCompleteness", Package: "./internal/docs"},
 		},
-		{
-			Name: "Legacy Validation Scripts",
-			Tests: []TestResult{
-				runLegacyScript("validate_api_completeness.go"),
-				runLegacyScript("validate_openapi_completeness.go"),
-				runLegacyScript("validate_schemas_and_parameters.go"),
-			},
+	},
+	{
+		Key:  "scripts",
+		Name: "Validation Scripts",
+		Checks: []validationCheck{
+			{Script: "scripts/validate-api-completeness"},
+			{Script: "scripts/validate-openapi"},
+			{Script: "scripts/validate-schemas"},
 		},
-	}
+	},
+}
 
-	// Calculate totals for each suite
-	for i := range suites {
-		suite := &suites[i]
-		suite.Total = len(suite.Tests)
-		suite.Passed = 0
-		for _, test := range suite.Tests {
-			if test.Passed {
-				suite.Passed++
-			}
+func main() {
+	var (
+		format   = flag.String("format", "text", "Output format: text, json or junit")
+		output   = flag.String("output", "", "File for json or junit output (defaults to standard output)")
+		suite    = flag.String("suite", "", "Comma-separated suites to run, by key or name (defaults to all; see -list)")
+		list     = flag.Bool("list", false, "List the suites and exit")
+		parallel = flag.Int("parallel", runtime.NumCPU(), "Number of tests run at the same time")
+		limits   thresholds
+	)
+	flag.Float64Var(&limits.Quality, "min-quality", 0, "Minimum docs-metrics overall quality score in percent")
+	flag.Float64Var(&limits.AnnotationCoverage, "min-annotation-coverage", 0, "Minimum docs-metrics annotation coverage in percent")
+	flag.Float64Var(&limits.EndpointCoverage, "min-endpoint-coverage", 0, "Minimum docs-metrics endpoint coverage in percent")
+	flag.Float64Var(&limits.ExampleCoverage, "min-example-coverage", 0, "Minimum docs-metrics example coverage in percent")
+	flag.Parse()
+
+	if *list {
+		for _, definition := range suiteDefinitions {
+			fmt.Printf("%-14s %s (%d %s)\n", definition.Key, definition.Name, len(definition.Checks), plural(len(definition.Checks), "test"))
 		}
+		return
 	}
-
-	// Display results
-	displayResults(suites)
-
-	// Generate comprehensive report
-	generateReport(suites)
-
-	// Calculate overall statistics
-	totalTests := 0
-	passedTests := 0
-	for _, suite := range suites {
-		totalTests += suite.Total
-		passedTests += suite.Passed
+	if *format != "text" && *format != "json" && *format != "junit" {
+		fmt.Fprintf(os.Stderr, "❌ Unknown format: %s. Use text, json or junit\n", *format)
+		os.Exit(2)
 	}
-
-	// Exit with appropriate code
-	if passedTests == totalTests {
-		fmt.Println("🎉 All documentation validation tests passed!")
-		os.Exit(0)
-	} else {
-		fmt.Printf("⚠️  %d out of %d tests failed. Check the validation report for details.\n", totalTests-passedTests, totalTests)
-		os.Exit(1)
+	if *parallel < 1 {
+		*parallel = 1
 	}
-}
 
-func runTest(testName, packagePath string) TestResult {
-	start := time.Now()
+	checkProjectRoot()
 
-	cmd := exec.Command("go", "test", "-v", packagePath, "-run", testName)
-	output, err := cmd.CombinedOutput()
-	duration := time.Since(start)
-
-	result := TestResult{
-		Name:     testName,
-		Passed:   err == nil,
-		Duration: duration,
-		Output:   string(output),
+	definitions, err := selectSuites(suiteDefinitions, *suite)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(2)
 	}
 
-	if err != nil {
-		result.Error = err.Error()
+	// Progress and the text report go to standard error when standard output carries json or junit
+	status := io.Writer(os.Stdout)
+	if *format != "text" && *output == "" {
+		status = os.Stderr
 	}
 
-	return result
-}
+	fmt.Fprintf(status, "=== Comprehensive API Documentation Validation ===\n\n")
+	summary := runSuites(definitions, *parallel, runCheck)
 
-func runLegacyScript(scriptName string) TestResult {
-	start := time.Now()
+	if limits.enabled() {
+		metrics, err := docs.GenerateDocumentationMetrics()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to generate documentation metrics: %v\n", err)
+			os.Exit(1)
+		}
+		summary.Thresholds = checkThresholds(metrics, limits)
+	}
 
-	scriptPath := filepath.Join("scripts", scriptName)
-	cmd := exec.Command("go", "run", scriptPath)
-	output, err := cmd.CombinedOutput()
-	duration := time.Since(start)
+	displayResults(status, summary)
+	generateReport(status, summary)
 
-	result := TestResult{
-		Name:     strings.TrimSuffix(scriptName, ".go"),
-		Passed:   err == nil,
-		Duration: duration,
-		Output:   string(output),
+	if *format != "text" {
+		if err := writeMachineReport(*format, *output, summary); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to write %s report: %v\n", *format, err)
+			os.Exit(1)
+		}
 	}
 
-	if err != nil {
-		result.Error = err.Error()
+	// Exit with appropriate code
+	switch {
+	case limits.enabled() && thresholdsPassed(summary.Thresholds):
+		fmt.Fprintf(status, "🎉 Documentation metrics meet the configured thresholds (%d of %d tests passed)\n", summary.Passed, summary.Total)
+	case limits.enabled():
+		fmt.Fprintf(status, "⚠️  Documentation metrics are below the configured thresholds\n")
+		os.Exit(1)
+	case summary.Passed == summary.Total:
+		fmt.Fprintf(status, "🎉 All documentation validation tests passed!\n")
+	default:
+		fmt.Fprintf(status, "⚠️  %d out of %d tests failed. Check the validation report for details.\n", summary.Total-summary.Passed, summary.Total)
+		os.Exit(1)
 	}
-
-	return result
 }
 
-func displayResults(suites []TestSuite) {
-	for _, suite := range suites {
-		fmt.Printf("📋 %s:\n", suite.Name)
-		fmt.Println(strings.Repeat("=", 60))
+// selectSuites returns the suites named by a comma-separated list of keys or names, or all suites for an empty list
+func selectSuites(definitions []suiteDefinition, selection string) ([]suiteDefinition, error) {
+	if strings.TrimSpace(selection) == "" {
+		return definitions, nil
+	}
 
-		for _, test := range suite.Tests {
-			status := "❌ FAILED"
-			if test.Passed {
-				status = "✅ PASSED"
+	selected := make(map[int]bool)
+	for _, name := range strings.Split(selection, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		found := false
+		for i, definition := range definitions {
+			if strings.EqualFold(name, definition.Key) || strings.EqualFold(name, definition.Name) {
+				selected[i] = true
+				found = true
 			}
-
-			fmt.Printf("  %s %s (%.2fs)\n", status, test.Name, test.Duration.Seconds())
-
-			if !test.Passed && test.Error != "" {
-				// Show first few lines of error for quick overview
-				errorLines := strings.Split(test.Error, "\n")
-				for i, line := range errorLines {
-					if i >= 3 { // Limit to first 3 lines
-						fmt.Printf("    ... (see full report for details)\n")
-						break
-					}
-					if strings.TrimSpace(line) != "" {
-						fmt.Printf("    %s\n", strings.TrimSpace(line))
-					}
-				}
+		}
+		if !found {
+			keys := make([]string, len(definitions))
+			for i, definition := range definitions {
+				keys[i] = definition.Key
 			}
+			return nil, fmt.Errorf("unknown suite %q; available suites: %s", name, strings.Join(keys, ", "))
 		}
-
-		fmt.Printf("\n  Suite Summary: %d/%d passed (%.1f%%)\n\n",
-			suite.Passed, suite.Total, float64(suite.Passed)/float64(suite.Total)*100)
 	}
-}
-
-func generateReport(suites []TestSuite) {
-	reportPath := "docs/validation-report.md"
 
-	file, err := os.Create(reportPath)
-	if err != nil {
-		log.Printf("Failed to create validation report: %v", err)
-		return
-	}
-	defer file.Close()
-
-	// Write report header
-	fmt.Fprintf(file, "# Comprehensive API Documentation Validation Report\n\n")
-	fmt.Fprintf(file, "Generated: %s\n\n", time.Now().Format("2006-01-02 15:04:05"))
-
-	// Calculate overall statistics
-	totalTests := 0
-	passedTests := 0
-	for _, suite := range suites {
-		totalTests += suite.Total
-		passedTests += suite.Passed
+	var result []suiteDefinition
+	for i, definition := range definitions {
+		if selected[i] {
+			result = append(result, definition)
+		}
 	}
+	return result, nil
+}
 
-	// Write executive summary
-	fmt.Fprintf(file, "## Executive Summary\n\n")
-	fmt.Fprintf(file, "- **Total Tests**: %d\n", totalTests)
-	fmt.Fprintf(file, "- **Passed**: %d\n", passedTests)
-	fmt.Fprintf(file, "- **Failed**: %d\n", totalTests-passedTests)
-	fmt.Fprintf(file, "- **Success Rate**: %.1f%%\n\n", float64(passedTests)/float64(totalTests)*100)
-
-	if passedTests == totalTests {
-		fmt.Fprintf(file, "🎉 **All validation tests passed!** The API documentation is accurate and complete.\n\n")
-	} else {
-		fmt.Fprintf(file, "⚠️ **Issues Found**: %d tests failed. Review the detailed results below.\n\n", totalTests-passedTests)
+// runSuites runs the checks of all suites, up to parallel at a time, and aggregates their results in suite order
+func runSuites(definitions []suiteDefinition, parallel int, run func(validationCheck) TestResult) runSummary {
+	summary := runSummary{Parallel: parallel, Suites: make([]TestSuite, len(definitions))}
+	for i, definition := range definitions {
+		summary.Suites[i] = TestSuite{Key: definition.Key, Name: definition.Name, Tests: make([]TestResult, len(definition.Checks))}
 	}
 
-	// Write suite summaries
-	fmt.Fprintf(file, "## Test Suite Summary\n\n")
-	fmt.Fprintf(file, "| Suite | Passed | Total | Success Rate |\n")
-	fmt.Fprintf(file, "|-------|--------|-------|-------------|\n")
-
-	for _, suite := range suites {
-		successRate := float64(suite.Passed) / float64(suite.Total) * 100
-		status := "✅"
-		if suite.Passed < suite.Total {
-			status = "❌"
+	start := time.Now()
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, parallel)
+	for i, definition := range definitions {
+		for j, check := range definition.Checks {
+			wg.Add(1)
+			slots <- struct{}{}
+			go func(i, j int, check validationCheck) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				summary.Suites[i].Tests[j] = run(check)
+			}(i, j, check)
 		}
-		fmt.Fprintf(file, "| %s %s | %d | %d | %.1f%% |\n",
-			status, suite.Name, suite.Passed, suite.Total, successRate)
 	}
-	fmt.Fprintf(file, "\n")
-
-	// Write detailed results
-	fmt.Fprintf(file, "## Detailed Test Results\n\n")
-
-	for _, suite := range suites {
-		fmt.Fprintf(file, "### %s\n\n", suite.Name)
+	wg.Wait()
+	summary.Duration = time.Since(start)
 
+	// Calculate totals for each suite
+	for i := range summary.Suites {
+		suite := &summary.Suites[i]
+		suite.Total = len(suite.Tests)
 		for _, test := range suite.Tests {
-			status := "❌ FAILED"
 			if test.Passed {
-				status = "✅ PASSED"
-			}
-
-			fmt.Fprintf(file, "#### %s %s\n\n", status, test.Name)
-			fmt.Fprintf(file, "- **Duration**: %.2fs\n", test.Duration.Seconds())
-
-			if !test.Passed {
-				fmt.Fprintf(file, "- **Error**: %s\n", strings.TrimSpace(test.Error))
-
-				// Include relevant output (truncated for readability)
-				if len(test.Output) > 0 {
-					fmt.Fprintf(file, "\n**Output:**\n```\n")
-					output := test.Output
-					if len(output) > 3000 {
-						output = output[:3000] + "\n... (truncated for readability)"
-					}
-					fmt.Fprintf(file, "%s\n```\n\n", output)
-				}
-			} else {
-				fmt.Fprintf(file, "- **Status**: Test passed successfully\n")
-
-				// Include summary output for passed tests
-				if len(test.Output) > 0 {
-					lines := strings.Split(test.Output, "\n")
-					summaryLines := []string{}
-					for _, line := range lines {
-						if strings.Contains(line, "✅") || strings.Contains(line, "PASS") {
-							summaryLines = append(summaryLines, strings.TrimSpace(line))
-						}
-					}
-					if len(summaryLines) > 0 {
-						fmt.Fprintf(file, "\n**Summary:**\n```\n")
-						for _, line := range summaryLines {
-							if len(summaryLines) <= 10 || len(line) <= 100 {
-								fmt.Fprintf(file, "%s\n", line)
-							}
-						}
-						fmt.Fprintf(file, "```\n\n")
-					}
-				}
+				suite.Passed++
 			}
+			suite.Duration += test.Duration
 		}
+		summary.Total += suite.Total
+		summary.Passed += suite.Passed
+		summary.TestTime += suite.Duration
 	}
+	return summary
+}
 
-	// Write recommendations
-	fmt.Fprintf(file, "## Recommendations\n\n")
+// runCheck runs a validation test with go test or a validation script with go run
+func runCheck(check validationCheck) TestResult {
+	if check.Script != "" {
+		return runCommand(filepath.Base(check.Script), "go", "run", "./"+filepath.ToSlash(check.Script))
+	}
+	return runCommand(check.Test, "go", "test", "-v", check.Package, "-run", check.Test)
+}
 
-	hasFailures := passedTests < totalTests
-	if hasFailures {
-		fmt.Fprintf(file, "### Issues to Address\n\n")
+func runCommand(name string, command string, args ...string) TestResult {
+	start := time.Now()
 
-		for _, suite := range suites {
-			if suite.Passed < suite.Total {
-				fmt.Fprintf(file, "#### %s\n\n", suite.Name)
+	cmd := exec.Command(command, args...)
+	output, err := cmd.CombinedOutput()
+	duration := time.Since(start)
 
-				for _, test := range suite.Tests {
-					if !test.Passed {
-						fmt.Fprintf(file, "- **%s**: %s\n", test.Name, extractMainError(test.Error, test.Output))
-					}
-				}
-				fmt.Fprintf(file, "\n")
-			}
-		}
+	result := TestResult{
+		Name:     name,
+		Passed:   err == nil,
+		Duration: duration,
+		Output:   string(output),
+	}
 
-		fmt.Fprintf(file, "### Action Items\n\n")
-		fmt.Fprintf(file, "1. **Route Documentation**: Update OpenAPI specification to match actual route implementations\n")
-		fmt.Fprintf(file, "2. **Schema Validation**: Ensure all response schemas are properly defined and consistent\n")
-		fmt.Fprintf(file, "3. **Authentication**: Verify authentication requirements are correctly documented\n")
-		fmt.Fprintf(file, "4. **Completeness**: Add missing descriptions, examples, and parameter documentation\n")
-		fmt.Fprintf(file, "5. **Testing**: Re-run validation tests after making corrections\n\n")
-
-		fmt.Fprintf(file, "### Commands to Fix Issues\n\n")
-		fmt.Fprintf(file, "```bash\n")
-		fmt.Fprintf(file, "# Update OpenAPI specification\n")
-		fmt.Fprintf(file, "make swagger\n\n")
-		fmt.Fprintf(file, "# Generate updated documentation\n")
-		fmt.Fprintf(file, "make docs-generate\n\n")
-		fmt.Fprintf(file, "# Re-run validation\n")
-		fmt.Fprintf(file, "make docs-validate-all\n")
-		fmt.Fprintf(file, "```\n\n")
-	} else {
-		fmt.Fprintf(file, "### Maintenance\n\n")
-		fmt.Fprintf(file, "✅ All validation tests are currently passing. To maintain documentation quality:\n\n")
-		fmt.Fprintf(file, "1. Run validation tests regularly: `make docs-validate-all`\n")
-		fmt.Fprintf(file, "2. Update documentation when adding new endpoints\n")
-		fmt.Fprintf(file, "3. Ensure new schemas are properly documented\n")
-		fmt.Fprintf(file, "4. Maintain authentication documentation accuracy\n\n")
+	if err != nil {
+		result.Error = err.Error()
 	}
 
-	// Write validation commands reference
-	fmt.Fprintf(file, "## Validation Commands Reference\n\n")
-	fmt.Fprintf(file, "| Command | Description |\n")
-	fmt.Fprintf(file, "|---------|-------------|\n")
-	fmt.Fprintf(file, "| `make docs-validate` | Run comprehensive validation script |\n")
-	fmt.Fprintf(file, "| `make docs-validate-routes` | Validate route implementation vs documentation |\n")
-	fmt.Fprintf(file, "| `make docs-validate-schemas` | Validate response schema consistency |\n")
-	fmt.Fprintf(file, "| `make docs-validate-auth` | Validate authentication documentation |\n")
-	fmt.Fprintf(file, "| `make docs-validate-completeness` | Validate documentation completeness |\n")
-	fmt.Fprintf(file, "| `make docs-validate-all` | Run all validation tests |\n")
-	fmt.Fprintf(file, "| `go run scripts/run_all_validation_tests.go` | Run this comprehensive validation |\n\n")
-
-	fmt.Printf("📄 Comprehensive validation report generated: %s\n", reportPath)
+	return result
 }
 
-func extractMainError(errorOutput, testOutput string) string {
-	// Try to extract meaningful error from error output first
-	if errorOutput != "" {
-		lines := strings.Split(errorOutput, "\n")
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			if strings.Contains(line, "FAIL:") || strings.Contains(line, "Error:") {
-				return line
-			}
-		}
+func (t thresholds) enabled() bool {
+	return t.Quality > 0 || t.AnnotationCoverage > 0 || t.EndpointCoverage > 0 || t.ExampleCoverage > 0
+}
+
+// checkThresholds compares the docs-metrics percentages with the thresholds that are set
+func checkThresholds(metrics *docs.DocumentationMetrics, limits thresholds) []thresholdResult {
+	candidates := []thresholdResult{
+		{Metric: "quality", Actual: metrics.QualityScore.OverallScore, Minimum: limits.Quality},
+		{Metric: "annotation_coverage", Actual: metrics.AnnotationCoverage.CoveragePercentage, Minimum: limits.AnnotationCoverage},
+		{Metric: "endpoint_coverage", Actual: metrics.EndpointCoverage.CoveragePercentage, Minimum: limits.EndpointCoverage},
+		{Metric: "example_coverage", Actual: metrics.ExampleCoverage.ExampleCoveragePercent, Minimum: limits.ExampleCoverage},
 	}
 
-	// Try to extract from test output
-	if testOutput != "" {
-		lines := strings.Split(testOutput, "\n")
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			if strings.Contains(line, "❌") && len(line) < 200 {
-				return line
-			}
+	var results []thresholdResult
+	for _, result := range candidates {
+		if result.Minimum <= 0 {
+			continue
 		}
+		result.Passed = result.Actual >= result.Minimum
+		results = append(results, result)
 	}
+	return results
+}
 
-	// Return generic message if no specific error found
-	return "Test failed - see detailed output above"
+func thresholdsPassed(results []thresholdResult) bool {
+	for _, result := range results {
+		if !result.Passed {
+			return false
+		}
+	}
+	return true
 }
 
-func init() {
+// checkProjectRoot exits unless the runner is started from the project root
+func checkProjectRoot() {
 	// Ensure we're in the right directory
 	if _, err := os.Stat("go.mod"); os.IsNotExist(err) {
-		fmt.Println("❌ This script must be run from the project root directory")
+		fmt.Fprintln(os.Stderr, "❌ This script must be run from the project root directory")
 		os.Exit(1)
 	}
 
@@ -391,9 +352,16 @@ func init() {
 
 	for _, file := range requiredFiles {
 		if _, err := os.Stat(file); os.IsNotExist(err) {
-			fmt.Printf("❌ Required file not found: %s\n", file)
-			fmt.Println("Please ensure the project is properly set up before running validation.")
+			fmt.Fprintf(os.Stderr, "❌ Required file not found: %s\n", file)
+			fmt.Fprintln(os.Stderr, "Please ensure the project is properly set up before running validation.")
 			os.Exit(1)
 		}
 	}
 }
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return noun
+	}
+	return noun + "s"
+}
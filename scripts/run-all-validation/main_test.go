@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/xml"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"product-requirements-management/internal/docs"
+)
+
+func TestSelectSuites(t *testing.T) {
+	t.Run("all suites without a selection", func(t *testing.T) {
+		selected, err := selectSuites(suiteDefinitions, " ")
+		require.NoError(t, err)
+		assert.Equal(t, suiteDefinitions, selected)
+	})
+
+	t.Run("by key and name in definition order", func(t *testing.T) {
+		selected, err := selectSuites(suiteDefinitions, "openapi, AUTH,route implementation vs documentation")
+		require.NoError(t, err)
+		require.Len(t, selected, 3)
+		assert.Equal(t, "routes", selected[0].Key)
+		assert.Equal(t, "auth", selected[1].Key)
+		assert.Equal(t, "openapi", selected[2].Key)
+	})
+
+	t.Run("unknown suite", func(t *testing.T) {
+		_, err := selectSuites(suiteDefinitions, "routes,lint")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown suite "lint"`)
+		assert.Contains(t, err.Error(), "routes, schemas, auth")
+	})
+}
+
+func TestRunSuites(t *testing.T) {
+	definitions := []suiteDefinition{
+		{Key: "one", Name: "One", Checks: []validationCheck{{Test: "TestA"}, {Test: "TestB"}}},
+		{Key: "two", Name: "Two", Checks: []validationCheck{{Script: "scripts/validate-c"}}},
+	}
+
+	var running, maxRunning int32
+	run := func(check validationCheck) TestResult {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			seen := atomic.LoadInt32(&maxRunning)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+
+		name := check.Test
+		if name == "" {
+			name = check.Script
+		}
+		return TestResult{Name: name, Passed: name != "TestB", Duration: 10 * time.Second}
+	}
+
+	summary := runSuites(definitions, 2, run)
+
+	assert.LessOrEqual(t, maxRunning, int32(2))
+	assert.Equal(t, 3, summary.Total)
+	assert.Equal(t, 2, summary.Passed)
+	assert.Equal(t, 2, summary.Parallel)
+	assert.Equal(t, 30*time.Second, summary.TestTime)
+	require.Len(t, summary.Suites, 2)
+	assert.Equal(t, []string{"TestA", "TestB"}, []string{summary.Suites[0].Tests[0].Name, summary.Suites[0].Tests[1].Name})
+	assert.Equal(t, 1, summary.Suites[0].Passed)
+	assert.Equal(t, 20*time.Second, summary.Suites[0].Duration)
+	assert.Equal(t, "scripts/validate-c", summary.Suites[1].Tests[0].Name)
+	assert.Equal(t, 1, summary.Suites[1].Passed)
+}
+
+func TestCheckThresholds(t *testing.T) {
+	metrics := &docs.DocumentationMetrics{
+		QualityScore:       docs.QualityScoreMetrics{OverallScore: 72.5},
+		AnnotationCoverage: docs.AnnotationCoverageMetrics{CoveragePercentage: 95},
+		EndpointCoverage:   docs.EndpointCoverageMetrics{CoveragePercentage: 100},
+		ExampleCoverage:    docs.ExampleCoverageMetrics{ExampleCoveragePercent: 40},
+	}
+
+	assert.False(t, thresholds{}.enabled())
+
+	results := checkThresholds(metrics, thresholds{Quality: 80, EndpointCoverage: 100})
+	assert.Equal(t, []thresholdResult{
+		{Metric: "quality", Actual: 72.5, Minimum: 80, Passed: false},
+		{Metric: "endpoint_coverage", Actual: 100, Minimum: 100, Passed: true},
+	}, results)
+	assert.False(t, thresholdsPassed(results))
+
+	results = checkThresholds(metrics, thresholds{AnnotationCoverage: 90, ExampleCoverage: 40})
+	assert.True(t, thresholdsPassed(results))
+}
+
+func TestMachineReports(t *testing.T) {
+	summary := runSummary{
+		Suites: []TestSuite{
+			{
+				Key: "routes", Name: "Routes", Passed: 1, Total: 2, Duration: 3 * time.Second,
+				Tests: []TestResult{
+					{Name: "TestOK", Passed: true, Duration: time.Second, Output: "PASS"},
+					{Name: "TestBroken", Duration: 2 * time.Second, Error: "exit status 1", Output: "--- FAIL: TestBroken"},
+				},
+			},
+		},
+		Total:    2,
+		Passed:   1,
+		Duration: 2 * time.Second,
+		TestTime: 3 * time.Second,
+		Parallel: 2,
+	}
+
+	t.Run("json", func(t *testing.T) {
+		report := buildJSONReport(summary, time.Time{})
+
+		assert.False(t, report.Passed)
+		assert.Equal(t, 1, report.FailedTests)
+		assert.Equal(t, 2.0, report.DurationSeconds)
+		assert.Equal(t, 3.0, report.TestDurationSeconds)
+		require.Len(t, report.Suites, 1)
+		assert.Empty(t, report.Suites[0].Tests[0].Output, "output of passed tests is left out")
+		assert.Equal(t, "--- FAIL: TestBroken", report.Suites[0].Tests[1].Output)
+	})
+
+	t.Run("json passes on thresholds", func(t *testing.T) {
+		withThresholds := summary
+		withThresholds.Thresholds = []thresholdResult{{Metric: "quality", Actual: 90, Minimum: 80, Passed: true}}
+
+		assert.True(t, buildJSONReport(withThresholds, time.Time{}).Passed)
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		withThresholds := summary
+		withThresholds.Thresholds = []thresholdResult{{Metric: "quality", Actual: 70, Minimum: 80}}
+
+		data, err := xml.Marshal(buildJUnitReport(withThresholds))
+		require.NoError(t, err)
+
+		var parsed junitTestSuites
+		require.NoError(t, xml.Unmarshal(data, &parsed))
+		assert.Equal(t, 3, parsed.Tests)
+		assert.Equal(t, 2, parsed.Failures)
+		require.Len(t, parsed.Suites, 2)
+		assert.Equal(t, "3.000", parsed.Suites[0].Time)
+		assert.Nil(t, parsed.Suites[0].Cases[0].Failure)
+		require.NotNil(t, parsed.Suites[0].Cases[1].Failure)
+		assert.Equal(t, "--- FAIL: TestBroken", parsed.Suites[0].Cases[1].Failure.Message)
+		assert.Equal(t, "Documentation Metrics", parsed.Suites[1].Name)
+		require.NotNil(t, parsed.Suites[1].Cases[0].Failure)
+		assert.Equal(t, "quality is 70.0%, below the minimum of 80.0%", parsed.Suites[1].Cases[0].Failure.Message)
+	})
+}
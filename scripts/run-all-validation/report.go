@@ -0,0 +1,441 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// jsonReport is the -format json output
+type jsonReport struct {
+	GeneratedAt         time.Time         `json:"generated_at"`
+	Passed              bool              `json:"passed"`
+	Total               int               `json:"total"`
+	PassedTests         int               `json:"passed_tests"`
+	FailedTests         int               `json:"failed_tests"`
+	DurationSeconds     float64           `json:"duration_seconds"`      // Wall-clock time of the run
+	TestDurationSeconds float64           `json:"test_duration_seconds"` // Sum of the durations of all tests
+	Parallel            int               `json:"parallel"`
+	Suites              []jsonSuite       `json:"suites"`
+	Thresholds          []thresholdResult `json:"thresholds,omitempty"`
+}
+
+type jsonSuite struct {
+	Key             string     `json:"key"`
+	Name            string     `json:"name"`
+	Passed          int        `json:"passed"`
+	Total           int        `json:"total"`
+	DurationSeconds float64    `json:"duration_seconds"`
+	Tests           []jsonTest `json:"tests"`
+}
+
+type jsonTest struct {
+	Name            string  `json:"name"`
+	Passed          bool    `json:"passed"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Error           string  `json:"error,omitempty"`
+	Output          string  `json:"output,omitempty"` // Failed tests only
+}
+
+// JUnit XML structures, as read by CI systems
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Output  string `xml:",chardata"`
+}
+
+// writeMachineReport writes the json or junit report to a file, or to standard output without one
+func writeMachineReport(format, output string, summary runSummary) error {
+	var data []byte
+	var err error
+	switch format {
+	case "json":
+		data, err = json.MarshalIndent(buildJSONReport(summary, time.Now()), "", "  ")
+	case "junit":
+		data, err = xml.MarshalIndent(buildJUnitReport(summary), "", "  ")
+		data = append([]byte(xml.Header), data...)
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(output, data, 0644)
+}
+
+func buildJSONReport(summary runSummary, generatedAt time.Time) jsonReport {
+	report := jsonReport{
+		GeneratedAt:         generatedAt,
+		Passed:              summaryPassed(summary),
+		Total:               summary.Total,
+		PassedTests:         summary.Passed,
+		FailedTests:         summary.Total - summary.Passed,
+		DurationSeconds:     seconds(summary.Duration),
+		TestDurationSeconds: seconds(summary.TestTime),
+		Parallel:            summary.Parallel,
+		Suites:              make([]jsonSuite, 0, len(summary.Suites)),
+		Thresholds:          summary.Thresholds,
+	}
+	for _, suite := range summary.Suites {
+		jsonSuite := jsonSuite{
+			Key:             suite.Key,
+			Name:            suite.Name,
+			Passed:          suite.Passed,
+			Total:           suite.Total,
+			DurationSeconds: seconds(suite.Duration),
+			Tests:           make([]jsonTest, 0, len(suite.Tests)),
+		}
+		for _, test := range suite.Tests {
+			result := jsonTest{Name: test.Name, Passed: test.Passed, DurationSeconds: seconds(test.Duration), Error: test.Error}
+			if !test.Passed {
+				result.Output = test.Output
+			}
+			jsonSuite.Tests = append(jsonSuite.Tests, result)
+		}
+		report.Suites = append(report.Suites, jsonSuite)
+	}
+	return report
+}
+
+// buildJUnitReport converts the results to JUnit XML; thresholds become test cases of a Documentation Metrics suite
+func buildJUnitReport(summary runSummary) junitTestSuites {
+	report := junitTestSuites{Name: "Documentation Validation", Time: junitTime(summary.Duration)}
+	for _, suite := range summary.Suites {
+		junitSuite := junitTestSuite{Name: suite.Name, Tests: suite.Total, Failures: suite.Total - suite.Passed, Time: junitTime(suite.Duration)}
+		for _, test := range suite.Tests {
+			testCase := junitTestCase{Name: test.Name, ClassName: suite.Key, Time: junitTime(test.Duration)}
+			if !test.Passed {
+				testCase.Failure = &junitFailure{Message: extractMainError(test.Error, test.Output), Output: test.Output}
+			}
+			junitSuite.Cases = append(junitSuite.Cases, testCase)
+		}
+		report.Suites = append(report.Suites, junitSuite)
+	}
+
+	if len(summary.Thresholds) > 0 {
+		junitSuite := junitTestSuite{Name: "Documentation Metrics", Tests: len(summary.Thresholds), Time: junitTime(0)}
+		for _, threshold := range summary.Thresholds {
+			testCase := junitTestCase{Name: threshold.Metric, ClassName: "metrics", Time: junitTime(0)}
+			if !threshold.Passed {
+				junitSuite.Failures++
+				testCase.Failure = &junitFailure{Message: fmt.Sprintf("%s is %.1f%%, below the minimum of %.1f%%", threshold.Metric, threshold.Actual, threshold.Minimum)}
+			}
+			junitSuite.Cases = append(junitSuite.Cases, testCase)
+		}
+		report.Suites = append(report.Suites, junitSuite)
+	}
+
+	for _, suite := range report.Suites {
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+	}
+	return report
+}
+
+// summaryPassed reports whether the run passed: the thresholds decide when any are set, the tests otherwise
+func summaryPassed(summary runSummary) bool {
+	if len(summary.Thresholds) > 0 {
+		return thresholdsPassed(summary.Thresholds)
+	}
+	return summary.Passed == summary.Total
+}
+
+func seconds(duration time.Duration) float64 {
+	return float64(duration.Milliseconds()) / 1000
+}
+
+func junitTime(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
+
+func displayResults(w io.Writer, summary runSummary) {
+	for _, suite := range summary.Suites {
+		fmt.Fprintf(w, "📋 %s:\n", suite.Name)
+		fmt.Fprintln(w, strings.Repeat("=", 60))
+
+		for _, test := range suite.Tests {
+			status := "❌ FAILED"
+			if test.Passed {
+				status = "✅ PASSED"
+			}
+
+			fmt.Fprintf(w, "  %s %s (%.2fs)\n", status, test.Name, test.Duration.Seconds())
+
+			if !test.Passed && test.Error != "" {
+				// Show first few lines of error for quick overview
+				errorLines := strings.Split(test.Error, "\n")
+				for i, line := range errorLines {
+					if i >= 3 { // Limit to first 3 lines
+						fmt.Fprintf(w, "    ... (see full report for details)\n")
+						break
+					}
+					if strings.TrimSpace(line) != "" {
+						fmt.Fprintf(w, "    %s\n", strings.TrimSpace(line))
+					}
+				}
+			}
+		}
+
+		fmt.Fprintf(w, "\n  Suite Summary: %d/%d passed (%.1f%%) in %.2fs\n\n",
+			suite.Passed, suite.Total, percentage(suite.Passed, suite.Total), suite.Duration.Seconds())
+	}
+
+	fmt.Fprintf(w, "⏱️  %d %s in %.2fs (%.2fs of test time, up to %d in parallel)\n\n",
+		summary.Total, plural(summary.Total, "test"), summary.Duration.Seconds(), summary.TestTime.Seconds(), summary.Parallel)
+
+	if len(summary.Thresholds) > 0 {
+		fmt.Fprintf(w, "📊 Documentation Metrics Thresholds:\n")
+		fmt.Fprintln(w, strings.Repeat("=", 60))
+		for _, threshold := range summary.Thresholds {
+			status := "❌ BELOW"
+			if threshold.Passed {
+				status = "✅ MET"
+			}
+			fmt.Fprintf(w, "  %s %s: %.1f%% (minimum %.1f%%)\n", status, threshold.Metric, threshold.Actual, threshold.Minimum)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func generateReport(w io.Writer, summary runSummary) {
+	reportPath := "docs/validation-report.md"
+
+	file, err := os.Create(reportPath)
+	if err != nil {
+		log.Printf("Failed to create validation report: %v", err)
+		return
+	}
+	defer file.Close()
+
+	suites := summary.Suites
+	totalTests := summary.Total
+	passedTests := summary.Passed
+
+	// Write report header
+	fmt.Fprintf(file, "# Comprehensive API Documentation Validation Report\n\n")
+	fmt.Fprintf(file, "Generated: %s\n\n", time.Now().Format("2006-01-02 15:04:05"))
+
+	// Write executive summary
+	fmt.Fprintf(file, "## Executive Summary\n\n")
+	fmt.Fprintf(file, "- **Total Tests**: %d\n", totalTests)
+	fmt.Fprintf(file, "- **Passed**: %d\n", passedTests)
+	fmt.Fprintf(file, "- **Failed**: %d\n", totalTests-passedTests)
+	fmt.Fprintf(file, "- **Success Rate**: %.1f%%\n", percentage(passedTests, totalTests))
+	fmt.Fprintf(file, "- **Duration**: %.2fs (%.2fs of test time, up to %d in parallel)\n\n", summary.Duration.Seconds(), summary.TestTime.Seconds(), summary.Parallel)
+
+	if passedTests == totalTests {
+		fmt.Fprintf(file, "🎉 **All validation tests passed!** The API documentation is accurate and complete.\n\n")
+	} else {
+		fmt.Fprintf(file, "⚠️ **Issues Found**: %d tests failed. Review the detailed results below.\n\n", totalTests-passedTests)
+	}
+
+	if len(summary.Thresholds) > 0 {
+		fmt.Fprintf(file, "## Documentation Metrics Thresholds\n\n")
+		fmt.Fprintf(file, "| Metric | Actual | Minimum | Status |\n")
+		fmt.Fprintf(file, "|--------|--------|---------|--------|\n")
+		for _, threshold := range summary.Thresholds {
+			status := "❌"
+			if threshold.Passed {
+				status = "✅"
+			}
+			fmt.Fprintf(file, "| %s | %.1f%% | %.1f%% | %s |\n", threshold.Metric, threshold.Actual, threshold.Minimum, status)
+		}
+		fmt.Fprintf(file, "\n")
+	}
+
+	// Write suite summaries
+	fmt.Fprintf(file, "## Test Suite Summary\n\n")
+	fmt.Fprintf(file, "| Suite | Passed | Total | Success Rate | Duration |\n")
+	fmt.Fprintf(file, "|-------|--------|-------|--------------|----------|\n")
+
+	for _, suite := range suites {
+		status := "✅"
+		if suite.Passed < suite.Total {
+			status = "❌"
+		}
+		fmt.Fprintf(file, "| %s %s | %d | %d | %.1f%% | %.2fs |\n",
+			status, suite.Name, suite.Passed, suite.Total, percentage(suite.Passed, suite.Total), suite.Duration.Seconds())
+	}
+	fmt.Fprintf(file, "\n")
+
+	// Write detailed results
+	fmt.Fprintf(file, "## Detailed Test Results\n\n")
+
+	for _, suite := range suites {
+		fmt.Fprintf(file, "### %s\n\n", suite.Name)
+
+		for _, test := range suite.Tests {
+			status := "❌ FAILED"
+			if test.Passed {
+				status = "✅ PASSED"
+			}
+
+			fmt.Fprintf(file, "#### %s %s\n\n", status, test.Name)
+			fmt.Fprintf(file, "- **Duration**: %.2fs\n", test.Duration.Seconds())
+
+			if !test.Passed {
+				fmt.Fprintf(file, "- **Error**: %s\n", strings.TrimSpace(test.Error))
+
+				// Include relevant output (truncated for readability)
+				if len(test.Output) > 0 {
+					fmt.Fprintf(file, "\n**Output:**\n```\n")
+					output := test.Output
+					if len(output) > 3000 {
+						output = output[:3000] + "\n... (truncated for readability)"
+					}
+					fmt.Fprintf(file, "%s\n```\n\n", output)
+				}
+			} else {
+				fmt.Fprintf(file, "- **Status**: Test passed successfully\n")
+
+				// Include summary output for passed tests
+				if len(test.Output) > 0 {
+					lines := strings.Split(test.Output, "\n")
+					summaryLines := []string{}
+					for _, line := range lines {
+						if strings.Contains(line, "✅") || strings.Contains(line, "PASS") {
+							summaryLines = append(summaryLines, strings.TrimSpace(line))
+						}
+					}
+					if len(summaryLines) > 0 {
+						fmt.Fprintf(file, "\n**Summary:**\n```\n")
+						for _, line := range summaryLines {
+							if len(summaryLines) <= 10 || len(line) <= 100 {
+								fmt.Fprintf(file, "%s\n", line)
+							}
+						}
+						fmt.Fprintf(file, "```\n\n")
+					}
+				}
+			}
+		}
+	}
+
+	// Write recommendations
+	fmt.Fprintf(file, "## Recommendations\n\n")
+
+	hasFailures := passedTests < totalTests
+	if hasFailures {
+		fmt.Fprintf(file, "### Issues to Address\n\n")
+
+		for _, suite := range suites {
+			if suite.Passed < suite.Total {
+				fmt.Fprintf(file, "#### %s\n\n", suite.Name)
+
+				for _, test := range suite.Tests {
+					if !test.Passed {
+						fmt.Fprintf(file, "- **%s**: %s\n", test.Name, extractMainError(test.Error, test.Output))
+					}
+				}
+				fmt.Fprintf(file, "\n")
+			}
+		}
+
+		fmt.Fprintf(file, "### Action Items\n\n")
+		fmt.Fprintf(file, "1. **Route Documentation**: Update OpenAPI specification to match actual route implementations\n")
+		fmt.Fprintf(file, "2. **Schema Validation**: Ensure all response schemas are properly defined and consistent\n")
+		fmt.Fprintf(file, "3. **Authentication**: Verify authentication requirements are correctly documented\n")
+		fmt.Fprintf(file, "4. **Completeness**: Add missing descriptions, examples, and parameter documentation\n")
+		fmt.Fprintf(file, "5. **Testing**: Re-run validation tests after making corrections\n\n")
+
+		fmt.Fprintf(file, "### Commands to Fix Issues\n\n")
+		fmt.Fprintf(file, "```bash\n")
+		fmt.Fprintf(file, "# Update OpenAPI specification\n")
+		fmt.Fprintf(file, "make swagger\n\n")
+		fmt.Fprintf(file, "# Generate updated documentation\n")
+		fmt.Fprintf(file, "make docs-generate\n\n")
+		fmt.Fprintf(file, "# Re-run validation\n")
+		fmt.Fprintf(file, "make docs-validate-all\n")
+		fmt.Fprintf(file, "```\n\n")
+	} else {
+		fmt.Fprintf(file, "### Maintenance\n\n")
+		fmt.Fprintf(file, "✅ All validation tests are currently passing. To maintain documentation quality:\n\n")
+		fmt.Fprintf(file, "1. Run validation tests regularly: `make docs-validate-all`\n")
+		fmt.Fprintf(file, "2. Update documentation when adding new endpoints\n")
+		fmt.Fprintf(file, "3. Ensure new schemas are properly documented\n")
+		fmt.Fprintf(file, "4. Maintain authentication documentation accuracy\n\n")
+	}
+
+	// Write validation commands reference
+	fmt.Fprintf(file, "## Validation Commands Reference\n\n")
+	fmt.Fprintf(file, "| Command | Description |\n")
+	fmt.Fprintf(file, "|---------|-------------|\n")
+	fmt.Fprintf(file, "| `make docs-validate` | Run comprehensive validation script |\n")
+	fmt.Fprintf(file, "| `make docs-validate-routes` | Validate route implementation vs documentation |\n")
+	fmt.Fprintf(file, "| `make docs-validate-schemas` | Validate response schema consistency |\n")
+	fmt.Fprintf(file, "| `make docs-validate-auth` | Validate authentication documentation |\n")
+	fmt.Fprintf(file, "| `make docs-validate-completeness` | Validate documentation completeness |\n")
+	fmt.Fprintf(file, "| `make docs-validate-all` | Run all validation tests |\n")
+	fmt.Fprintf(file, "| `go run ./scripts/run-all-validation` | Run this comprehensive validation |\n")
+	fmt.Fprintf(file, "| `go run ./scripts/run-all-validation -suite routes,auth -format junit -output reports/validation.xml` | Run selected suites and write JUnit XML for CI |\n\n")
+
+	fmt.Fprintf(w, "📄 Comprehensive validation report generated: %s\n", reportPath)
+}
+
+func extractMainError(errorOutput, testOutput string) string {
+	// Try to extract meaningful error from error output first
+	if errorOutput != "" {
+		lines := strings.Split(errorOutput, "\n")
+		for _, line := range lines {
+			line = strings.TrimSpace(line)
+			if strings.Contains(line, "FAIL:") || strings.Contains(line, "Error:") {
+				return line
+			}
+		}
+	}
+
+	// Try to extract from test output
+	if testOutput != "" {
+		lines := strings.Split(testOutput, "\n")
+		for _, line := range lines {
+			line = strings.TrimSpace(line)
+			if (strings.HasPrefix(line, "--- FAIL:") || strings.Contains(line, "❌")) && len(line) < 200 {
+				return line
+			}
+		}
+	}
+
+	// Return generic message if no specific error found
+	return "Test failed - see detailed output above"
+}
+
+func percentage(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}